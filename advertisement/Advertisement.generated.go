@@ -8,6 +8,7 @@ import (
 	"github.com/terracefi/enum"
 	"github.com/terracefi/field"
 	"github.com/terracefi/fix44"
+	"github.com/terracefi/fix44/components"
 	"github.com/terracefi/quickfix"
 	"github.com/terracefi/tag"
 )
@@ -62,6 +63,26 @@ func Route(router RouteOut) (string, string, quickfix.MessageRoute) {
 	return "FIX.4.4", "7", r
 }
 
+//Validate checks m against the FIX 4.4 definition of Advertisement, see fix44.MessageDef.Validate
+func (m Advertisement) Validate() fix44.ValidationErrors {
+	return fix44.MessageDefs["7"].Validate(&m.Body.FieldMap)
+}
+
+//MarshalJSON encodes the Advertisement as JSON with fields keyed by name, see fix44.MarshalMessageJSON
+func (m Advertisement) MarshalJSON() ([]byte, error) {
+	return fix44.MarshalMessageJSON(m.Message)
+}
+
+//UnmarshalJSON builds the Advertisement from JSON produced by MarshalJSON
+func (m *Advertisement) UnmarshalJSON(b []byte) error {
+	msg, err := fix44.UnmarshalMessageJSON(b, "7")
+	if err != nil {
+		return err
+	}
+	*m = FromMessage(msg)
+	return nil
+}
+
 //SetAdvId sets AdvId, Tag 2
 func (m Advertisement) SetAdvId(v string) {
 	m.Set(field.NewAdvId(v))
@@ -1088,6 +1109,21 @@ func (m Advertisement) HasEncodedIssuer() bool {
 	return m.Has(tag.EncodedIssuer)
 }
 
+//SetEncodedIssuerBytes sets EncodedIssuer, Tag 349, and EncodedIssuerLen, Tag 348, from v, which may hold any bytes including SOH
+func (m Advertisement) SetEncodedIssuerBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedIssuerLen, tag.EncodedIssuer, v)
+}
+
+//GetEncodedIssuerBytes gets EncodedIssuer, Tag 349, checking its length against EncodedIssuerLen, Tag 348
+func (m Advertisement) GetEncodedIssuerBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedIssuerLen, tag.EncodedIssuer)
+}
+
+//GetEncodedIssuerDecoded gets EncodedIssuer, Tag 349, decoded to UTF-8 using the MessageEncoding of the Header
+func (m Advertisement) GetEncodedIssuerDecoded() (string, error) {
+	return fix44.DecodeRawData(m.Header, m, tag.EncodedIssuerLen, tag.EncodedIssuer)
+}
+
 //HasEncodedSecurityDescLen returns true if EncodedSecurityDescLen is present, Tag 350
 func (m Advertisement) HasEncodedSecurityDescLen() bool {
 	return m.Has(tag.EncodedSecurityDescLen)
@@ -1098,6 +1134,21 @@ func (m Advertisement) HasEncodedSecurityDesc() bool {
 	return m.Has(tag.EncodedSecurityDesc)
 }
 
+//SetEncodedSecurityDescBytes sets EncodedSecurityDesc, Tag 351, and EncodedSecurityDescLen, Tag 350, from v, which may hold any bytes including SOH
+func (m Advertisement) SetEncodedSecurityDescBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedSecurityDescLen, tag.EncodedSecurityDesc, v)
+}
+
+//GetEncodedSecurityDescBytes gets EncodedSecurityDesc, Tag 351, checking its length against EncodedSecurityDescLen, Tag 350
+func (m Advertisement) GetEncodedSecurityDescBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedSecurityDescLen, tag.EncodedSecurityDesc)
+}
+
+//GetEncodedSecurityDescDecoded gets EncodedSecurityDesc, Tag 351, decoded to UTF-8 using the MessageEncoding of the Header
+func (m Advertisement) GetEncodedSecurityDescDecoded() (string, error) {
+	return fix44.DecodeRawData(m.Header, m, tag.EncodedSecurityDescLen, tag.EncodedSecurityDesc)
+}
+
 //HasEncodedTextLen returns true if EncodedTextLen is present, Tag 354
 func (m Advertisement) HasEncodedTextLen() bool {
 	return m.Has(tag.EncodedTextLen)
@@ -1108,6 +1159,21 @@ func (m Advertisement) HasEncodedText() bool {
 	return m.Has(tag.EncodedText)
 }
 
+//SetEncodedTextBytes sets EncodedText, Tag 355, and EncodedTextLen, Tag 354, from v, which may hold any bytes including SOH
+func (m Advertisement) SetEncodedTextBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedTextLen, tag.EncodedText, v)
+}
+
+//GetEncodedTextBytes gets EncodedText, Tag 355, checking its length against EncodedTextLen, Tag 354
+func (m Advertisement) GetEncodedTextBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedTextLen, tag.EncodedText)
+}
+
+//GetEncodedTextDecoded gets EncodedText, Tag 355, decoded to UTF-8 using the MessageEncoding of the Header
+func (m Advertisement) GetEncodedTextDecoded() (string, error) {
+	return fix44.DecodeRawData(m.Header, m, tag.EncodedTextLen, tag.EncodedText)
+}
+
 //HasNoSecurityAltID returns true if NoSecurityAltID is present, Tag 454
 func (m Advertisement) HasNoSecurityAltID() bool {
 	return m.Has(tag.NoSecurityAltID)
@@ -1213,71 +1279,29 @@ func (m Advertisement) HasStrikeCurrency() bool {
 	return m.Has(tag.StrikeCurrency)
 }
 
-//NoSecurityAltID is a repeating group element, Tag 454
-type NoSecurityAltID struct {
-	*quickfix.Group
-}
-
-//SetSecurityAltID sets SecurityAltID, Tag 455
-func (m NoSecurityAltID) SetSecurityAltID(v string) {
-	m.Set(field.NewSecurityAltID(v))
-}
-
-//SetSecurityAltIDSource sets SecurityAltIDSource, Tag 456
-func (m NoSecurityAltID) SetSecurityAltIDSource(v string) {
-	m.Set(field.NewSecurityAltIDSource(v))
+//GetInstrument gets the Instrument component
+func (m Advertisement) GetInstrument() components.Instrument {
+	return components.Instrument{&m.Body.FieldMap}
 }
 
-//GetSecurityAltID gets SecurityAltID, Tag 455
-func (m NoSecurityAltID) GetSecurityAltID() (v string, err quickfix.MessageRejectError) {
-	var f field.SecurityAltIDField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetSecurityAltIDSource gets SecurityAltIDSource, Tag 456
-func (m NoSecurityAltID) GetSecurityAltIDSource() (v string, err quickfix.MessageRejectError) {
-	var f field.SecurityAltIDSourceField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//HasSecurityAltID returns true if SecurityAltID is present, Tag 455
-func (m NoSecurityAltID) HasSecurityAltID() bool {
-	return m.Has(tag.SecurityAltID)
+//SetInstrument sets the Instrument component, copying the fields present in c
+func (m Advertisement) SetInstrument(c components.Instrument) quickfix.MessageRejectError {
+	return c.CopyInto(&m.Body.FieldMap)
 }
 
-//HasSecurityAltIDSource returns true if SecurityAltIDSource is present, Tag 456
-func (m NoSecurityAltID) HasSecurityAltIDSource() bool {
-	return m.Has(tag.SecurityAltIDSource)
-}
+//NoSecurityAltID is a repeating group element, Tag 454
+type NoSecurityAltID = components.NoSecurityAltID
 
 //NoSecurityAltIDRepeatingGroup is a repeating group, Tag 454
-type NoSecurityAltIDRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
+type NoSecurityAltIDRepeatingGroup = components.NoSecurityAltIDRepeatingGroup
 
 //NewNoSecurityAltIDRepeatingGroup returns an initialized, NoSecurityAltIDRepeatingGroup
 func NewNoSecurityAltIDRepeatingGroup() NoSecurityAltIDRepeatingGroup {
-	return NoSecurityAltIDRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoSecurityAltID,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.SecurityAltID), quickfix.GroupElement(tag.SecurityAltIDSource)})}
+	return components.NewNoSecurityAltIDRepeatingGroup()
 }
 
-//Add create and append a new NoSecurityAltID to this group
-func (m NoSecurityAltIDRepeatingGroup) Add() NoSecurityAltID {
-	g := m.RepeatingGroup.Add()
-	return NoSecurityAltID{g}
-}
-
-//Get returns the ith NoSecurityAltID in the NoSecurityAltIDRepeatinGroup
-func (m NoSecurityAltIDRepeatingGroup) Get(i int) NoSecurityAltID {
-	return NoSecurityAltID{m.RepeatingGroup.Get(i)}
-}
+//NoSecurityAltIDStruct is a plain Go representation of a NoSecurityAltID group element
+type NoSecurityAltIDStruct = components.NoSecurityAltIDStruct
 
 //NoLegs is a repeating group element, Tag 555
 type NoLegs struct {
@@ -2030,6 +2054,21 @@ func (m NoLegs) HasEncodedLegIssuer() bool {
 	return m.Has(tag.EncodedLegIssuer)
 }
 
+//SetEncodedLegIssuerBytes sets EncodedLegIssuer, Tag 619, and EncodedLegIssuerLen, Tag 618, from v, which may hold any bytes including SOH
+func (m NoLegs) SetEncodedLegIssuerBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedLegIssuerLen, tag.EncodedLegIssuer, v)
+}
+
+//GetEncodedLegIssuerBytes gets EncodedLegIssuer, Tag 619, checking its length against EncodedLegIssuerLen, Tag 618
+func (m NoLegs) GetEncodedLegIssuerBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedLegIssuerLen, tag.EncodedLegIssuer)
+}
+
+//GetEncodedLegIssuerDecoded gets EncodedLegIssuer, Tag 619, decoded to UTF-8 using the MessageEncoding of h, the Header of the message holding it
+func (m NoLegs) GetEncodedLegIssuerDecoded(h fix44.Header) (string, error) {
+	return fix44.DecodeRawData(h, m, tag.EncodedLegIssuerLen, tag.EncodedLegIssuer)
+}
+
 //HasLegSecurityDesc returns true if LegSecurityDesc is present, Tag 620
 func (m NoLegs) HasLegSecurityDesc() bool {
 	return m.Has(tag.LegSecurityDesc)
@@ -2045,6 +2084,21 @@ func (m NoLegs) HasEncodedLegSecurityDesc() bool {
 	return m.Has(tag.EncodedLegSecurityDesc)
 }
 
+//SetEncodedLegSecurityDescBytes sets EncodedLegSecurityDesc, Tag 622, and EncodedLegSecurityDescLen, Tag 621, from v, which may hold any bytes including SOH
+func (m NoLegs) SetEncodedLegSecurityDescBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedLegSecurityDescLen, tag.EncodedLegSecurityDesc, v)
+}
+
+//GetEncodedLegSecurityDescBytes gets EncodedLegSecurityDesc, Tag 622, checking its length against EncodedLegSecurityDescLen, Tag 621
+func (m NoLegs) GetEncodedLegSecurityDescBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedLegSecurityDescLen, tag.EncodedLegSecurityDesc)
+}
+
+//GetEncodedLegSecurityDescDecoded gets EncodedLegSecurityDesc, Tag 622, decoded to UTF-8 using the MessageEncoding of h, the Header of the message holding it
+func (m NoLegs) GetEncodedLegSecurityDescDecoded(h fix44.Header) (string, error) {
+	return fix44.DecodeRawData(h, m, tag.EncodedLegSecurityDescLen, tag.EncodedLegSecurityDesc)
+}
+
 //HasLegRatioQty returns true if LegRatioQty is present, Tag 623
 func (m NoLegs) HasLegRatioQty() bool {
 	return m.Has(tag.LegRatioQty)
@@ -2080,71 +2134,29 @@ func (m NoLegs) HasLegInterestAccrualDate() bool {
 	return m.Has(tag.LegInterestAccrualDate)
 }
 
-//NoLegSecurityAltID is a repeating group element, Tag 604
-type NoLegSecurityAltID struct {
-	*quickfix.Group
-}
-
-//SetLegSecurityAltID sets LegSecurityAltID, Tag 605
-func (m NoLegSecurityAltID) SetLegSecurityAltID(v string) {
-	m.Set(field.NewLegSecurityAltID(v))
-}
-
-//SetLegSecurityAltIDSource sets LegSecurityAltIDSource, Tag 606
-func (m NoLegSecurityAltID) SetLegSecurityAltIDSource(v string) {
-	m.Set(field.NewLegSecurityAltIDSource(v))
+//GetInstrumentLeg gets the InstrumentLeg component
+func (m NoLegs) GetInstrumentLeg() components.InstrumentLeg {
+	return components.InstrumentLeg{&m.Group.FieldMap}
 }
 
-//GetLegSecurityAltID gets LegSecurityAltID, Tag 605
-func (m NoLegSecurityAltID) GetLegSecurityAltID() (v string, err quickfix.MessageRejectError) {
-	var f field.LegSecurityAltIDField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
+//SetInstrumentLeg sets the InstrumentLeg component, copying the fields present in c
+func (m NoLegs) SetInstrumentLeg(c components.InstrumentLeg) quickfix.MessageRejectError {
+	return c.CopyInto(&m.Group.FieldMap)
 }
 
-//GetLegSecurityAltIDSource gets LegSecurityAltIDSource, Tag 606
-func (m NoLegSecurityAltID) GetLegSecurityAltIDSource() (v string, err quickfix.MessageRejectError) {
-	var f field.LegSecurityAltIDSourceField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//HasLegSecurityAltID returns true if LegSecurityAltID is present, Tag 605
-func (m NoLegSecurityAltID) HasLegSecurityAltID() bool {
-	return m.Has(tag.LegSecurityAltID)
-}
-
-//HasLegSecurityAltIDSource returns true if LegSecurityAltIDSource is present, Tag 606
-func (m NoLegSecurityAltID) HasLegSecurityAltIDSource() bool {
-	return m.Has(tag.LegSecurityAltIDSource)
-}
+//NoLegSecurityAltID is a repeating group element, Tag 604
+type NoLegSecurityAltID = components.NoLegSecurityAltID
 
 //NoLegSecurityAltIDRepeatingGroup is a repeating group, Tag 604
-type NoLegSecurityAltIDRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
+type NoLegSecurityAltIDRepeatingGroup = components.NoLegSecurityAltIDRepeatingGroup
 
 //NewNoLegSecurityAltIDRepeatingGroup returns an initialized, NoLegSecurityAltIDRepeatingGroup
 func NewNoLegSecurityAltIDRepeatingGroup() NoLegSecurityAltIDRepeatingGroup {
-	return NoLegSecurityAltIDRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoLegSecurityAltID,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.LegSecurityAltID), quickfix.GroupElement(tag.LegSecurityAltIDSource)})}
+	return components.NewNoLegSecurityAltIDRepeatingGroup()
 }
 
-//Add create and append a new NoLegSecurityAltID to this group
-func (m NoLegSecurityAltIDRepeatingGroup) Add() NoLegSecurityAltID {
-	g := m.RepeatingGroup.Add()
-	return NoLegSecurityAltID{g}
-}
-
-//Get returns the ith NoLegSecurityAltID in the NoLegSecurityAltIDRepeatinGroup
-func (m NoLegSecurityAltIDRepeatingGroup) Get(i int) NoLegSecurityAltID {
-	return NoLegSecurityAltID{m.RepeatingGroup.Get(i)}
-}
+//NoLegSecurityAltIDStruct is a plain Go representation of a NoLegSecurityAltID group element
+type NoLegSecurityAltIDStruct = components.NoLegSecurityAltIDStruct
 
 //NoLegsRepeatingGroup is a repeating group, Tag 555
 type NoLegsRepeatingGroup struct {
@@ -2974,6 +2986,21 @@ func (m NoUnderlyings) HasEncodedUnderlyingIssuer() bool {
 	return m.Has(tag.EncodedUnderlyingIssuer)
 }
 
+//SetEncodedUnderlyingIssuerBytes sets EncodedUnderlyingIssuer, Tag 363, and EncodedUnderlyingIssuerLen, Tag 362, from v, which may hold any bytes including SOH
+func (m NoUnderlyings) SetEncodedUnderlyingIssuerBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedUnderlyingIssuerLen, tag.EncodedUnderlyingIssuer, v)
+}
+
+//GetEncodedUnderlyingIssuerBytes gets EncodedUnderlyingIssuer, Tag 363, checking its length against EncodedUnderlyingIssuerLen, Tag 362
+func (m NoUnderlyings) GetEncodedUnderlyingIssuerBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedUnderlyingIssuerLen, tag.EncodedUnderlyingIssuer)
+}
+
+//GetEncodedUnderlyingIssuerDecoded gets EncodedUnderlyingIssuer, Tag 363, decoded to UTF-8 using the MessageEncoding of h, the Header of the message holding it
+func (m NoUnderlyings) GetEncodedUnderlyingIssuerDecoded(h fix44.Header) (string, error) {
+	return fix44.DecodeRawData(h, m, tag.EncodedUnderlyingIssuerLen, tag.EncodedUnderlyingIssuer)
+}
+
 //HasUnderlyingSecurityDesc returns true if UnderlyingSecurityDesc is present, Tag 307
 func (m NoUnderlyings) HasUnderlyingSecurityDesc() bool {
 	return m.Has(tag.UnderlyingSecurityDesc)
@@ -2989,6 +3016,21 @@ func (m NoUnderlyings) HasEncodedUnderlyingSecurityDesc() bool {
 	return m.Has(tag.EncodedUnderlyingSecurityDesc)
 }
 
+//SetEncodedUnderlyingSecurityDescBytes sets EncodedUnderlyingSecurityDesc, Tag 365, and EncodedUnderlyingSecurityDescLen, Tag 364, from v, which may hold any bytes including SOH
+func (m NoUnderlyings) SetEncodedUnderlyingSecurityDescBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedUnderlyingSecurityDescLen, tag.EncodedUnderlyingSecurityDesc, v)
+}
+
+//GetEncodedUnderlyingSecurityDescBytes gets EncodedUnderlyingSecurityDesc, Tag 365, checking its length against EncodedUnderlyingSecurityDescLen, Tag 364
+func (m NoUnderlyings) GetEncodedUnderlyingSecurityDescBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedUnderlyingSecurityDescLen, tag.EncodedUnderlyingSecurityDesc)
+}
+
+//GetEncodedUnderlyingSecurityDescDecoded gets EncodedUnderlyingSecurityDesc, Tag 365, decoded to UTF-8 using the MessageEncoding of h, the Header of the message holding it
+func (m NoUnderlyings) GetEncodedUnderlyingSecurityDescDecoded(h fix44.Header) (string, error) {
+	return fix44.DecodeRawData(h, m, tag.EncodedUnderlyingSecurityDescLen, tag.EncodedUnderlyingSecurityDesc)
+}
+
 //HasUnderlyingCPProgram returns true if UnderlyingCPProgram is present, Tag 877
 func (m NoUnderlyings) HasUnderlyingCPProgram() bool {
 	return m.Has(tag.UnderlyingCPProgram)
@@ -3044,137 +3086,43 @@ func (m NoUnderlyings) HasNoUnderlyingStips() bool {
 	return m.Has(tag.NoUnderlyingStips)
 }
 
-//NoUnderlyingSecurityAltID is a repeating group element, Tag 457
-type NoUnderlyingSecurityAltID struct {
-	*quickfix.Group
-}
-
-//SetUnderlyingSecurityAltID sets UnderlyingSecurityAltID, Tag 458
-func (m NoUnderlyingSecurityAltID) SetUnderlyingSecurityAltID(v string) {
-	m.Set(field.NewUnderlyingSecurityAltID(v))
-}
-
-//SetUnderlyingSecurityAltIDSource sets UnderlyingSecurityAltIDSource, Tag 459
-func (m NoUnderlyingSecurityAltID) SetUnderlyingSecurityAltIDSource(v string) {
-	m.Set(field.NewUnderlyingSecurityAltIDSource(v))
-}
-
-//GetUnderlyingSecurityAltID gets UnderlyingSecurityAltID, Tag 458
-func (m NoUnderlyingSecurityAltID) GetUnderlyingSecurityAltID() (v string, err quickfix.MessageRejectError) {
-	var f field.UnderlyingSecurityAltIDField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
+//GetUnderlyingInstrument gets the UnderlyingInstrument component
+func (m NoUnderlyings) GetUnderlyingInstrument() components.UnderlyingInstrument {
+	return components.UnderlyingInstrument{&m.Group.FieldMap}
 }
 
-//GetUnderlyingSecurityAltIDSource gets UnderlyingSecurityAltIDSource, Tag 459
-func (m NoUnderlyingSecurityAltID) GetUnderlyingSecurityAltIDSource() (v string, err quickfix.MessageRejectError) {
-	var f field.UnderlyingSecurityAltIDSourceField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//HasUnderlyingSecurityAltID returns true if UnderlyingSecurityAltID is present, Tag 458
-func (m NoUnderlyingSecurityAltID) HasUnderlyingSecurityAltID() bool {
-	return m.Has(tag.UnderlyingSecurityAltID)
+//SetUnderlyingInstrument sets the UnderlyingInstrument component, copying the fields present in c
+func (m NoUnderlyings) SetUnderlyingInstrument(c components.UnderlyingInstrument) quickfix.MessageRejectError {
+	return c.CopyInto(&m.Group.FieldMap)
 }
 
-//HasUnderlyingSecurityAltIDSource returns true if UnderlyingSecurityAltIDSource is present, Tag 459
-func (m NoUnderlyingSecurityAltID) HasUnderlyingSecurityAltIDSource() bool {
-	return m.Has(tag.UnderlyingSecurityAltIDSource)
-}
+//NoUnderlyingSecurityAltID is a repeating group element, Tag 457
+type NoUnderlyingSecurityAltID = components.NoUnderlyingSecurityAltID
 
 //NoUnderlyingSecurityAltIDRepeatingGroup is a repeating group, Tag 457
-type NoUnderlyingSecurityAltIDRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
+type NoUnderlyingSecurityAltIDRepeatingGroup = components.NoUnderlyingSecurityAltIDRepeatingGroup
 
 //NewNoUnderlyingSecurityAltIDRepeatingGroup returns an initialized, NoUnderlyingSecurityAltIDRepeatingGroup
 func NewNoUnderlyingSecurityAltIDRepeatingGroup() NoUnderlyingSecurityAltIDRepeatingGroup {
-	return NoUnderlyingSecurityAltIDRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoUnderlyingSecurityAltID,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.UnderlyingSecurityAltID), quickfix.GroupElement(tag.UnderlyingSecurityAltIDSource)})}
+	return components.NewNoUnderlyingSecurityAltIDRepeatingGroup()
 }
 
-//Add create and append a new NoUnderlyingSecurityAltID to this group
-func (m NoUnderlyingSecurityAltIDRepeatingGroup) Add() NoUnderlyingSecurityAltID {
-	g := m.RepeatingGroup.Add()
-	return NoUnderlyingSecurityAltID{g}
-}
-
-//Get returns the ith NoUnderlyingSecurityAltID in the NoUnderlyingSecurityAltIDRepeatinGroup
-func (m NoUnderlyingSecurityAltIDRepeatingGroup) Get(i int) NoUnderlyingSecurityAltID {
-	return NoUnderlyingSecurityAltID{m.RepeatingGroup.Get(i)}
-}
+//NoUnderlyingSecurityAltIDStruct is a plain Go representation of a NoUnderlyingSecurityAltID group element
+type NoUnderlyingSecurityAltIDStruct = components.NoUnderlyingSecurityAltIDStruct
 
 //NoUnderlyingStips is a repeating group element, Tag 887
-type NoUnderlyingStips struct {
-	*quickfix.Group
-}
-
-//SetUnderlyingStipType sets UnderlyingStipType, Tag 888
-func (m NoUnderlyingStips) SetUnderlyingStipType(v string) {
-	m.Set(field.NewUnderlyingStipType(v))
-}
-
-//SetUnderlyingStipValue sets UnderlyingStipValue, Tag 889
-func (m NoUnderlyingStips) SetUnderlyingStipValue(v string) {
-	m.Set(field.NewUnderlyingStipValue(v))
-}
-
-//GetUnderlyingStipType gets UnderlyingStipType, Tag 888
-func (m NoUnderlyingStips) GetUnderlyingStipType() (v string, err quickfix.MessageRejectError) {
-	var f field.UnderlyingStipTypeField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetUnderlyingStipValue gets UnderlyingStipValue, Tag 889
-func (m NoUnderlyingStips) GetUnderlyingStipValue() (v string, err quickfix.MessageRejectError) {
-	var f field.UnderlyingStipValueField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//HasUnderlyingStipType returns true if UnderlyingStipType is present, Tag 888
-func (m NoUnderlyingStips) HasUnderlyingStipType() bool {
-	return m.Has(tag.UnderlyingStipType)
-}
-
-//HasUnderlyingStipValue returns true if UnderlyingStipValue is present, Tag 889
-func (m NoUnderlyingStips) HasUnderlyingStipValue() bool {
-	return m.Has(tag.UnderlyingStipValue)
-}
+type NoUnderlyingStips = components.NoUnderlyingStips
 
 //NoUnderlyingStipsRepeatingGroup is a repeating group, Tag 887
-type NoUnderlyingStipsRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
+type NoUnderlyingStipsRepeatingGroup = components.NoUnderlyingStipsRepeatingGroup
 
 //NewNoUnderlyingStipsRepeatingGroup returns an initialized, NoUnderlyingStipsRepeatingGroup
 func NewNoUnderlyingStipsRepeatingGroup() NoUnderlyingStipsRepeatingGroup {
-	return NoUnderlyingStipsRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoUnderlyingStips,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.UnderlyingStipType), quickfix.GroupElement(tag.UnderlyingStipValue)})}
-}
-
-//Add create and append a new NoUnderlyingStips to this group
-func (m NoUnderlyingStipsRepeatingGroup) Add() NoUnderlyingStips {
-	g := m.RepeatingGroup.Add()
-	return NoUnderlyingStips{g}
+	return components.NewNoUnderlyingStipsRepeatingGroup()
 }
 
-//Get returns the ith NoUnderlyingStips in the NoUnderlyingStipsRepeatinGroup
-func (m NoUnderlyingStipsRepeatingGroup) Get(i int) NoUnderlyingStips {
-	return NoUnderlyingStips{m.RepeatingGroup.Get(i)}
-}
+//NoUnderlyingStipsStruct is a plain Go representation of a NoUnderlyingStips group element
+type NoUnderlyingStipsStruct = components.NoUnderlyingStipsStruct
 
 //NoUnderlyingsRepeatingGroup is a repeating group, Tag 711
 type NoUnderlyingsRepeatingGroup struct {
@@ -3200,105 +3148,1716 @@ func (m NoUnderlyingsRepeatingGroup) Get(i int) NoUnderlyings {
 }
 
 //NoEvents is a repeating group element, Tag 864
-type NoEvents struct {
-	*quickfix.Group
-}
-
-//SetEventType sets EventType, Tag 865
-func (m NoEvents) SetEventType(v enum.EventType) {
-	m.Set(field.NewEventType(v))
-}
-
-//SetEventDate sets EventDate, Tag 866
-func (m NoEvents) SetEventDate(v string) {
-	m.Set(field.NewEventDate(v))
-}
-
-//SetEventPx sets EventPx, Tag 867
-func (m NoEvents) SetEventPx(value decimal.Decimal, scale int32) {
-	m.Set(field.NewEventPx(value, scale))
-}
+type NoEvents = components.NoEvents
 
-//SetEventText sets EventText, Tag 868
-func (m NoEvents) SetEventText(v string) {
-	m.Set(field.NewEventText(v))
-}
+//NoEventsRepeatingGroup is a repeating group, Tag 864
+type NoEventsRepeatingGroup = components.NoEventsRepeatingGroup
 
-//GetEventType gets EventType, Tag 865
-func (m NoEvents) GetEventType() (v enum.EventType, err quickfix.MessageRejectError) {
-	var f field.EventTypeField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
+//NewNoEventsRepeatingGroup returns an initialized, NoEventsRepeatingGroup
+func NewNoEventsRepeatingGroup() NoEventsRepeatingGroup {
+	return components.NewNoEventsRepeatingGroup()
+}
+
+//NoEventsStruct is a plain Go representation of a NoEvents group element
+type NoEventsStruct = components.NoEventsStruct
+
+//Struct is a plain Go representation of the Advertisement body, optional fields are nil when absent
+type Struct struct {
+	AdvId                      string
+	AdvRefID                   *string
+	AdvSide                    enum.AdvSide
+	AdvTransType               enum.AdvTransType
+	Currency                   *string
+	SecurityIDSource           *enum.SecurityIDSource
+	LastMkt                    *string
+	Price                      *decimal.Decimal
+	SecurityID                 *string
+	Quantity                   decimal.Decimal
+	Symbol                     *string
+	Text                       *string
+	TransactTime               *time.Time
+	SymbolSfx                  *enum.SymbolSfx
+	TradeDate                  *string
+	Issuer                     *string
+	SecurityDesc               *string
+	URLLink                    *string
+	SecurityType               *enum.SecurityType
+	MaturityMonthYear          *string
+	StrikePrice                *decimal.Decimal
+	OptAttribute               *string
+	SecurityExchange           *string
+	CouponRate                 *decimal.Decimal
+	CouponPaymentDate          *string
+	IssueDate                  *string
+	RepurchaseTerm             *int
+	RepurchaseRate             *decimal.Decimal
+	Factor                     *decimal.Decimal
+	ContractMultiplier         *decimal.Decimal
+	RepoCollateralSecurityType *int
+	RedemptionDate             *string
+	CreditRating               *string
+	TradingSessionID           *enum.TradingSessionID
+	EncodedIssuerLen           *int
+	EncodedIssuer              *string
+	EncodedSecurityDescLen     *int
+	EncodedSecurityDesc        *string
+	EncodedTextLen             *int
+	EncodedText                *string
+	NoSecurityAltID            []NoSecurityAltIDStruct
+	Product                    *enum.Product
+	CFICode                    *string
+	CountryOfIssue             *string
+	StateOrProvinceOfIssue     *string
+	LocaleOfIssue              *string
+	MaturityDate               *string
+	InstrRegistry              *enum.InstrRegistry
+	NoLegs                     []NoLegsStruct
+	TradingSessionSubID        *enum.TradingSessionSubID
+	ContractSettlMonth         *string
+	Pool                       *string
+	NoUnderlyings              []NoUnderlyingsStruct
+	SecuritySubType            *string
+	QtyType                    *enum.QtyType
+	NoEvents                   []NoEventsStruct
+	DatedDate                  *string
+	InterestAccrualDate        *string
+	CPProgram                  *enum.CPProgram
+	CPRegType                  *string
+	StrikeCurrency             *string
+}
+
+//Marshal copies the fields present in msg into a Struct
+func Marshal(msg Advertisement) (Struct, quickfix.MessageRejectError) {
+	var s Struct
+	if msg.HasAdvId() {
+		v, err := msg.GetAdvId()
+		if err != nil {
+			return s, err
+		}
+		s.AdvId = v
+	}
+	if msg.HasAdvRefID() {
+		v, err := msg.GetAdvRefID()
+		if err != nil {
+			return s, err
+		}
+		s.AdvRefID = &v
+	}
+	if msg.HasAdvSide() {
+		v, err := msg.GetAdvSide()
+		if err != nil {
+			return s, err
+		}
+		s.AdvSide = v
+	}
+	if msg.HasAdvTransType() {
+		v, err := msg.GetAdvTransType()
+		if err != nil {
+			return s, err
+		}
+		s.AdvTransType = v
+	}
+	if msg.HasCurrency() {
+		v, err := msg.GetCurrency()
+		if err != nil {
+			return s, err
+		}
+		s.Currency = &v
+	}
+	if msg.HasSecurityIDSource() {
+		v, err := msg.GetSecurityIDSource()
+		if err != nil {
+			return s, err
+		}
+		s.SecurityIDSource = &v
+	}
+	if msg.HasLastMkt() {
+		v, err := msg.GetLastMkt()
+		if err != nil {
+			return s, err
+		}
+		s.LastMkt = &v
+	}
+	if msg.HasPrice() {
+		v, err := msg.GetPrice()
+		if err != nil {
+			return s, err
+		}
+		s.Price = &v
+	}
+	if msg.HasSecurityID() {
+		v, err := msg.GetSecurityID()
+		if err != nil {
+			return s, err
+		}
+		s.SecurityID = &v
+	}
+	if msg.HasQuantity() {
+		v, err := msg.GetQuantity()
+		if err != nil {
+			return s, err
+		}
+		s.Quantity = v
+	}
+	if msg.HasSymbol() {
+		v, err := msg.GetSymbol()
+		if err != nil {
+			return s, err
+		}
+		s.Symbol = &v
+	}
+	if msg.HasText() {
+		v, err := msg.GetText()
+		if err != nil {
+			return s, err
+		}
+		s.Text = &v
+	}
+	if msg.HasTransactTime() {
+		v, err := msg.GetTransactTime()
+		if err != nil {
+			return s, err
+		}
+		s.TransactTime = &v
+	}
+	if msg.HasSymbolSfx() {
+		v, err := msg.GetSymbolSfx()
+		if err != nil {
+			return s, err
+		}
+		s.SymbolSfx = &v
+	}
+	if msg.HasTradeDate() {
+		v, err := msg.GetTradeDate()
+		if err != nil {
+			return s, err
+		}
+		s.TradeDate = &v
+	}
+	if msg.HasIssuer() {
+		v, err := msg.GetIssuer()
+		if err != nil {
+			return s, err
+		}
+		s.Issuer = &v
+	}
+	if msg.HasSecurityDesc() {
+		v, err := msg.GetSecurityDesc()
+		if err != nil {
+			return s, err
+		}
+		s.SecurityDesc = &v
+	}
+	if msg.HasURLLink() {
+		v, err := msg.GetURLLink()
+		if err != nil {
+			return s, err
+		}
+		s.URLLink = &v
+	}
+	if msg.HasSecurityType() {
+		v, err := msg.GetSecurityType()
+		if err != nil {
+			return s, err
+		}
+		s.SecurityType = &v
+	}
+	if msg.HasMaturityMonthYear() {
+		v, err := msg.GetMaturityMonthYear()
+		if err != nil {
+			return s, err
+		}
+		s.MaturityMonthYear = &v
+	}
+	if msg.HasStrikePrice() {
+		v, err := msg.GetStrikePrice()
+		if err != nil {
+			return s, err
+		}
+		s.StrikePrice = &v
+	}
+	if msg.HasOptAttribute() {
+		v, err := msg.GetOptAttribute()
+		if err != nil {
+			return s, err
+		}
+		s.OptAttribute = &v
+	}
+	if msg.HasSecurityExchange() {
+		v, err := msg.GetSecurityExchange()
+		if err != nil {
+			return s, err
+		}
+		s.SecurityExchange = &v
+	}
+	if msg.HasCouponRate() {
+		v, err := msg.GetCouponRate()
+		if err != nil {
+			return s, err
+		}
+		s.CouponRate = &v
+	}
+	if msg.HasCouponPaymentDate() {
+		v, err := msg.GetCouponPaymentDate()
+		if err != nil {
+			return s, err
+		}
+		s.CouponPaymentDate = &v
+	}
+	if msg.HasIssueDate() {
+		v, err := msg.GetIssueDate()
+		if err != nil {
+			return s, err
+		}
+		s.IssueDate = &v
+	}
+	if msg.HasRepurchaseTerm() {
+		v, err := msg.GetRepurchaseTerm()
+		if err != nil {
+			return s, err
+		}
+		s.RepurchaseTerm = &v
+	}
+	if msg.HasRepurchaseRate() {
+		v, err := msg.GetRepurchaseRate()
+		if err != nil {
+			return s, err
+		}
+		s.RepurchaseRate = &v
+	}
+	if msg.HasFactor() {
+		v, err := msg.GetFactor()
+		if err != nil {
+			return s, err
+		}
+		s.Factor = &v
+	}
+	if msg.HasContractMultiplier() {
+		v, err := msg.GetContractMultiplier()
+		if err != nil {
+			return s, err
+		}
+		s.ContractMultiplier = &v
+	}
+	if msg.HasRepoCollateralSecurityType() {
+		v, err := msg.GetRepoCollateralSecurityType()
+		if err != nil {
+			return s, err
+		}
+		s.RepoCollateralSecurityType = &v
+	}
+	if msg.HasRedemptionDate() {
+		v, err := msg.GetRedemptionDate()
+		if err != nil {
+			return s, err
+		}
+		s.RedemptionDate = &v
+	}
+	if msg.HasCreditRating() {
+		v, err := msg.GetCreditRating()
+		if err != nil {
+			return s, err
+		}
+		s.CreditRating = &v
+	}
+	if msg.HasTradingSessionID() {
+		v, err := msg.GetTradingSessionID()
+		if err != nil {
+			return s, err
+		}
+		s.TradingSessionID = &v
+	}
+	if msg.HasEncodedIssuerLen() {
+		v, err := msg.GetEncodedIssuerLen()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedIssuerLen = &v
+	}
+	if msg.HasEncodedIssuer() {
+		v, err := msg.GetEncodedIssuer()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedIssuer = &v
+	}
+	if msg.HasEncodedSecurityDescLen() {
+		v, err := msg.GetEncodedSecurityDescLen()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedSecurityDescLen = &v
+	}
+	if msg.HasEncodedSecurityDesc() {
+		v, err := msg.GetEncodedSecurityDesc()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedSecurityDesc = &v
+	}
+	if msg.HasEncodedTextLen() {
+		v, err := msg.GetEncodedTextLen()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedTextLen = &v
+	}
+	if msg.HasEncodedText() {
+		v, err := msg.GetEncodedText()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedText = &v
+	}
+	if msg.HasNoSecurityAltID() {
+		g, err := msg.GetNoSecurityAltID()
+		if err != nil {
+			return s, err
+		}
+		if s.NoSecurityAltID, err = components.MarshalNoSecurityAltID(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasProduct() {
+		v, err := msg.GetProduct()
+		if err != nil {
+			return s, err
+		}
+		s.Product = &v
+	}
+	if msg.HasCFICode() {
+		v, err := msg.GetCFICode()
+		if err != nil {
+			return s, err
+		}
+		s.CFICode = &v
+	}
+	if msg.HasCountryOfIssue() {
+		v, err := msg.GetCountryOfIssue()
+		if err != nil {
+			return s, err
+		}
+		s.CountryOfIssue = &v
+	}
+	if msg.HasStateOrProvinceOfIssue() {
+		v, err := msg.GetStateOrProvinceOfIssue()
+		if err != nil {
+			return s, err
+		}
+		s.StateOrProvinceOfIssue = &v
+	}
+	if msg.HasLocaleOfIssue() {
+		v, err := msg.GetLocaleOfIssue()
+		if err != nil {
+			return s, err
+		}
+		s.LocaleOfIssue = &v
+	}
+	if msg.HasMaturityDate() {
+		v, err := msg.GetMaturityDate()
+		if err != nil {
+			return s, err
+		}
+		s.MaturityDate = &v
+	}
+	if msg.HasInstrRegistry() {
+		v, err := msg.GetInstrRegistry()
+		if err != nil {
+			return s, err
+		}
+		s.InstrRegistry = &v
+	}
+	if msg.HasNoLegs() {
+		g, err := msg.GetNoLegs()
+		if err != nil {
+			return s, err
+		}
+		if s.NoLegs, err = MarshalNoLegs(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasTradingSessionSubID() {
+		v, err := msg.GetTradingSessionSubID()
+		if err != nil {
+			return s, err
+		}
+		s.TradingSessionSubID = &v
+	}
+	if msg.HasContractSettlMonth() {
+		v, err := msg.GetContractSettlMonth()
+		if err != nil {
+			return s, err
+		}
+		s.ContractSettlMonth = &v
+	}
+	if msg.HasPool() {
+		v, err := msg.GetPool()
+		if err != nil {
+			return s, err
+		}
+		s.Pool = &v
+	}
+	if msg.HasNoUnderlyings() {
+		g, err := msg.GetNoUnderlyings()
+		if err != nil {
+			return s, err
+		}
+		if s.NoUnderlyings, err = MarshalNoUnderlyings(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasSecuritySubType() {
+		v, err := msg.GetSecuritySubType()
+		if err != nil {
+			return s, err
+		}
+		s.SecuritySubType = &v
+	}
+	if msg.HasQtyType() {
+		v, err := msg.GetQtyType()
+		if err != nil {
+			return s, err
+		}
+		s.QtyType = &v
+	}
+	if msg.HasNoEvents() {
+		g, err := msg.GetNoEvents()
+		if err != nil {
+			return s, err
+		}
+		if s.NoEvents, err = components.MarshalNoEvents(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasDatedDate() {
+		v, err := msg.GetDatedDate()
+		if err != nil {
+			return s, err
+		}
+		s.DatedDate = &v
+	}
+	if msg.HasInterestAccrualDate() {
+		v, err := msg.GetInterestAccrualDate()
+		if err != nil {
+			return s, err
+		}
+		s.InterestAccrualDate = &v
+	}
+	if msg.HasCPProgram() {
+		v, err := msg.GetCPProgram()
+		if err != nil {
+			return s, err
+		}
+		s.CPProgram = &v
+	}
+	if msg.HasCPRegType() {
+		v, err := msg.GetCPRegType()
+		if err != nil {
+			return s, err
+		}
+		s.CPRegType = &v
+	}
+	if msg.HasStrikeCurrency() {
+		v, err := msg.GetStrikeCurrency()
+		if err != nil {
+			return s, err
+		}
+		s.StrikeCurrency = &v
+	}
+	return s, nil
+}
+
+//Unmarshal builds a Advertisement from s
+func Unmarshal(s Struct) Advertisement {
+	m := New(field.NewAdvId(s.AdvId), field.NewAdvTransType(s.AdvTransType), field.NewAdvSide(s.AdvSide), field.NewQuantity(s.Quantity, fix44.DecimalScale(s.Quantity)))
+	if s.AdvRefID != nil {
+		m.SetAdvRefID(*s.AdvRefID)
+	}
+	if s.Currency != nil {
+		m.SetCurrency(*s.Currency)
 	}
-	return
-}
-
-//GetEventDate gets EventDate, Tag 866
-func (m NoEvents) GetEventDate() (v string, err quickfix.MessageRejectError) {
-	var f field.EventDateField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
+	if s.SecurityIDSource != nil {
+		m.SetSecurityIDSource(*s.SecurityIDSource)
 	}
-	return
-}
-
-//GetEventPx gets EventPx, Tag 867
-func (m NoEvents) GetEventPx() (v decimal.Decimal, err quickfix.MessageRejectError) {
-	var f field.EventPxField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
+	if s.LastMkt != nil {
+		m.SetLastMkt(*s.LastMkt)
 	}
-	return
-}
-
-//GetEventText gets EventText, Tag 868
-func (m NoEvents) GetEventText() (v string, err quickfix.MessageRejectError) {
-	var f field.EventTextField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
+	if s.Price != nil {
+		m.SetPrice(*s.Price, fix44.DecimalScale(*s.Price))
 	}
-	return
-}
-
-//HasEventType returns true if EventType is present, Tag 865
-func (m NoEvents) HasEventType() bool {
-	return m.Has(tag.EventType)
-}
-
-//HasEventDate returns true if EventDate is present, Tag 866
-func (m NoEvents) HasEventDate() bool {
-	return m.Has(tag.EventDate)
-}
-
-//HasEventPx returns true if EventPx is present, Tag 867
-func (m NoEvents) HasEventPx() bool {
-	return m.Has(tag.EventPx)
-}
-
-//HasEventText returns true if EventText is present, Tag 868
-func (m NoEvents) HasEventText() bool {
-	return m.Has(tag.EventText)
-}
-
-//NoEventsRepeatingGroup is a repeating group, Tag 864
-type NoEventsRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
-
-//NewNoEventsRepeatingGroup returns an initialized, NoEventsRepeatingGroup
-func NewNoEventsRepeatingGroup() NoEventsRepeatingGroup {
-	return NoEventsRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoEvents,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.EventType), quickfix.GroupElement(tag.EventDate), quickfix.GroupElement(tag.EventPx), quickfix.GroupElement(tag.EventText)})}
-}
-
-//Add create and append a new NoEvents to this group
-func (m NoEventsRepeatingGroup) Add() NoEvents {
-	g := m.RepeatingGroup.Add()
-	return NoEvents{g}
-}
-
-//Get returns the ith NoEvents in the NoEventsRepeatinGroup
-func (m NoEventsRepeatingGroup) Get(i int) NoEvents {
-	return NoEvents{m.RepeatingGroup.Get(i)}
+	if s.SecurityID != nil {
+		m.SetSecurityID(*s.SecurityID)
+	}
+	if s.Symbol != nil {
+		m.SetSymbol(*s.Symbol)
+	}
+	if s.Text != nil {
+		m.SetText(*s.Text)
+	}
+	if s.TransactTime != nil {
+		m.SetTransactTime(*s.TransactTime)
+	}
+	if s.SymbolSfx != nil {
+		m.SetSymbolSfx(*s.SymbolSfx)
+	}
+	if s.TradeDate != nil {
+		m.SetTradeDate(*s.TradeDate)
+	}
+	if s.Issuer != nil {
+		m.SetIssuer(*s.Issuer)
+	}
+	if s.SecurityDesc != nil {
+		m.SetSecurityDesc(*s.SecurityDesc)
+	}
+	if s.URLLink != nil {
+		m.SetURLLink(*s.URLLink)
+	}
+	if s.SecurityType != nil {
+		m.SetSecurityType(*s.SecurityType)
+	}
+	if s.MaturityMonthYear != nil {
+		m.SetMaturityMonthYear(*s.MaturityMonthYear)
+	}
+	if s.StrikePrice != nil {
+		m.SetStrikePrice(*s.StrikePrice, fix44.DecimalScale(*s.StrikePrice))
+	}
+	if s.OptAttribute != nil {
+		m.SetOptAttribute(*s.OptAttribute)
+	}
+	if s.SecurityExchange != nil {
+		m.SetSecurityExchange(*s.SecurityExchange)
+	}
+	if s.CouponRate != nil {
+		m.SetCouponRate(*s.CouponRate, fix44.DecimalScale(*s.CouponRate))
+	}
+	if s.CouponPaymentDate != nil {
+		m.SetCouponPaymentDate(*s.CouponPaymentDate)
+	}
+	if s.IssueDate != nil {
+		m.SetIssueDate(*s.IssueDate)
+	}
+	if s.RepurchaseTerm != nil {
+		m.SetRepurchaseTerm(*s.RepurchaseTerm)
+	}
+	if s.RepurchaseRate != nil {
+		m.SetRepurchaseRate(*s.RepurchaseRate, fix44.DecimalScale(*s.RepurchaseRate))
+	}
+	if s.Factor != nil {
+		m.SetFactor(*s.Factor, fix44.DecimalScale(*s.Factor))
+	}
+	if s.ContractMultiplier != nil {
+		m.SetContractMultiplier(*s.ContractMultiplier, fix44.DecimalScale(*s.ContractMultiplier))
+	}
+	if s.RepoCollateralSecurityType != nil {
+		m.SetRepoCollateralSecurityType(*s.RepoCollateralSecurityType)
+	}
+	if s.RedemptionDate != nil {
+		m.SetRedemptionDate(*s.RedemptionDate)
+	}
+	if s.CreditRating != nil {
+		m.SetCreditRating(*s.CreditRating)
+	}
+	if s.TradingSessionID != nil {
+		m.SetTradingSessionID(*s.TradingSessionID)
+	}
+	if s.EncodedIssuerLen != nil {
+		m.SetEncodedIssuerLen(*s.EncodedIssuerLen)
+	}
+	if s.EncodedIssuer != nil {
+		m.SetEncodedIssuer(*s.EncodedIssuer)
+	}
+	if s.EncodedSecurityDescLen != nil {
+		m.SetEncodedSecurityDescLen(*s.EncodedSecurityDescLen)
+	}
+	if s.EncodedSecurityDesc != nil {
+		m.SetEncodedSecurityDesc(*s.EncodedSecurityDesc)
+	}
+	if s.EncodedTextLen != nil {
+		m.SetEncodedTextLen(*s.EncodedTextLen)
+	}
+	if s.EncodedText != nil {
+		m.SetEncodedText(*s.EncodedText)
+	}
+	if s.NoSecurityAltID != nil {
+		m.SetNoSecurityAltID(components.UnmarshalNoSecurityAltID(s.NoSecurityAltID))
+	}
+	if s.Product != nil {
+		m.SetProduct(*s.Product)
+	}
+	if s.CFICode != nil {
+		m.SetCFICode(*s.CFICode)
+	}
+	if s.CountryOfIssue != nil {
+		m.SetCountryOfIssue(*s.CountryOfIssue)
+	}
+	if s.StateOrProvinceOfIssue != nil {
+		m.SetStateOrProvinceOfIssue(*s.StateOrProvinceOfIssue)
+	}
+	if s.LocaleOfIssue != nil {
+		m.SetLocaleOfIssue(*s.LocaleOfIssue)
+	}
+	if s.MaturityDate != nil {
+		m.SetMaturityDate(*s.MaturityDate)
+	}
+	if s.InstrRegistry != nil {
+		m.SetInstrRegistry(*s.InstrRegistry)
+	}
+	if s.NoLegs != nil {
+		m.SetNoLegs(UnmarshalNoLegs(s.NoLegs))
+	}
+	if s.TradingSessionSubID != nil {
+		m.SetTradingSessionSubID(*s.TradingSessionSubID)
+	}
+	if s.ContractSettlMonth != nil {
+		m.SetContractSettlMonth(*s.ContractSettlMonth)
+	}
+	if s.Pool != nil {
+		m.SetPool(*s.Pool)
+	}
+	if s.NoUnderlyings != nil {
+		m.SetNoUnderlyings(UnmarshalNoUnderlyings(s.NoUnderlyings))
+	}
+	if s.SecuritySubType != nil {
+		m.SetSecuritySubType(*s.SecuritySubType)
+	}
+	if s.QtyType != nil {
+		m.SetQtyType(*s.QtyType)
+	}
+	if s.NoEvents != nil {
+		m.SetNoEvents(components.UnmarshalNoEvents(s.NoEvents))
+	}
+	if s.DatedDate != nil {
+		m.SetDatedDate(*s.DatedDate)
+	}
+	if s.InterestAccrualDate != nil {
+		m.SetInterestAccrualDate(*s.InterestAccrualDate)
+	}
+	if s.CPProgram != nil {
+		m.SetCPProgram(*s.CPProgram)
+	}
+	if s.CPRegType != nil {
+		m.SetCPRegType(*s.CPRegType)
+	}
+	if s.StrikeCurrency != nil {
+		m.SetStrikeCurrency(*s.StrikeCurrency)
+	}
+	return m
+}
+
+//NoLegsStruct is a plain Go representation of a NoLegs group element, optional fields are nil when absent
+type NoLegsStruct struct {
+	LegSymbol                     *string
+	LegSymbolSfx                  *string
+	LegSecurityID                 *string
+	LegSecurityIDSource           *string
+	NoLegSecurityAltID            []NoLegSecurityAltIDStruct
+	LegProduct                    *int
+	LegCFICode                    *string
+	LegSecurityType               *string
+	LegSecuritySubType            *string
+	LegMaturityMonthYear          *string
+	LegMaturityDate               *string
+	LegCouponPaymentDate          *string
+	LegIssueDate                  *string
+	LegRepoCollateralSecurityType *int
+	LegRepurchaseTerm             *int
+	LegRepurchaseRate             *decimal.Decimal
+	LegFactor                     *decimal.Decimal
+	LegCreditRating               *string
+	LegInstrRegistry              *string
+	LegCountryOfIssue             *string
+	LegStateOrProvinceOfIssue     *string
+	LegLocaleOfIssue              *string
+	LegRedemptionDate             *string
+	LegStrikePrice                *decimal.Decimal
+	LegStrikeCurrency             *string
+	LegOptAttribute               *string
+	LegContractMultiplier         *decimal.Decimal
+	LegCouponRate                 *decimal.Decimal
+	LegSecurityExchange           *string
+	LegIssuer                     *string
+	EncodedLegIssuerLen           *int
+	EncodedLegIssuer              *string
+	LegSecurityDesc               *string
+	EncodedLegSecurityDescLen     *int
+	EncodedLegSecurityDesc        *string
+	LegRatioQty                   *decimal.Decimal
+	LegSide                       *string
+	LegCurrency                   *string
+	LegPool                       *string
+	LegDatedDate                  *string
+	LegContractSettlMonth         *string
+	LegInterestAccrualDate        *string
+}
+
+//MarshalNoLegs copies the elements of g into a slice of NoLegsStruct
+func MarshalNoLegs(g NoLegsRepeatingGroup) ([]NoLegsStruct, quickfix.MessageRejectError) {
+	s := make([]NoLegsStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasLegSymbol() {
+			v, err := m.GetLegSymbol()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSymbol = &v
+		}
+		if m.HasLegSymbolSfx() {
+			v, err := m.GetLegSymbolSfx()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSymbolSfx = &v
+		}
+		if m.HasLegSecurityID() {
+			v, err := m.GetLegSecurityID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSecurityID = &v
+		}
+		if m.HasLegSecurityIDSource() {
+			v, err := m.GetLegSecurityIDSource()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSecurityIDSource = &v
+		}
+		if m.HasNoLegSecurityAltID() {
+			g, err := m.GetNoLegSecurityAltID()
+			if err != nil {
+				return nil, err
+			}
+			if s[i].NoLegSecurityAltID, err = components.MarshalNoLegSecurityAltID(g); err != nil {
+				return nil, err
+			}
+		}
+		if m.HasLegProduct() {
+			v, err := m.GetLegProduct()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegProduct = &v
+		}
+		if m.HasLegCFICode() {
+			v, err := m.GetLegCFICode()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegCFICode = &v
+		}
+		if m.HasLegSecurityType() {
+			v, err := m.GetLegSecurityType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSecurityType = &v
+		}
+		if m.HasLegSecuritySubType() {
+			v, err := m.GetLegSecuritySubType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSecuritySubType = &v
+		}
+		if m.HasLegMaturityMonthYear() {
+			v, err := m.GetLegMaturityMonthYear()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegMaturityMonthYear = &v
+		}
+		if m.HasLegMaturityDate() {
+			v, err := m.GetLegMaturityDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegMaturityDate = &v
+		}
+		if m.HasLegCouponPaymentDate() {
+			v, err := m.GetLegCouponPaymentDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegCouponPaymentDate = &v
+		}
+		if m.HasLegIssueDate() {
+			v, err := m.GetLegIssueDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegIssueDate = &v
+		}
+		if m.HasLegRepoCollateralSecurityType() {
+			v, err := m.GetLegRepoCollateralSecurityType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegRepoCollateralSecurityType = &v
+		}
+		if m.HasLegRepurchaseTerm() {
+			v, err := m.GetLegRepurchaseTerm()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegRepurchaseTerm = &v
+		}
+		if m.HasLegRepurchaseRate() {
+			v, err := m.GetLegRepurchaseRate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegRepurchaseRate = &v
+		}
+		if m.HasLegFactor() {
+			v, err := m.GetLegFactor()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegFactor = &v
+		}
+		if m.HasLegCreditRating() {
+			v, err := m.GetLegCreditRating()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegCreditRating = &v
+		}
+		if m.HasLegInstrRegistry() {
+			v, err := m.GetLegInstrRegistry()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegInstrRegistry = &v
+		}
+		if m.HasLegCountryOfIssue() {
+			v, err := m.GetLegCountryOfIssue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegCountryOfIssue = &v
+		}
+		if m.HasLegStateOrProvinceOfIssue() {
+			v, err := m.GetLegStateOrProvinceOfIssue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegStateOrProvinceOfIssue = &v
+		}
+		if m.HasLegLocaleOfIssue() {
+			v, err := m.GetLegLocaleOfIssue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegLocaleOfIssue = &v
+		}
+		if m.HasLegRedemptionDate() {
+			v, err := m.GetLegRedemptionDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegRedemptionDate = &v
+		}
+		if m.HasLegStrikePrice() {
+			v, err := m.GetLegStrikePrice()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegStrikePrice = &v
+		}
+		if m.HasLegStrikeCurrency() {
+			v, err := m.GetLegStrikeCurrency()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegStrikeCurrency = &v
+		}
+		if m.HasLegOptAttribute() {
+			v, err := m.GetLegOptAttribute()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegOptAttribute = &v
+		}
+		if m.HasLegContractMultiplier() {
+			v, err := m.GetLegContractMultiplier()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegContractMultiplier = &v
+		}
+		if m.HasLegCouponRate() {
+			v, err := m.GetLegCouponRate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegCouponRate = &v
+		}
+		if m.HasLegSecurityExchange() {
+			v, err := m.GetLegSecurityExchange()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSecurityExchange = &v
+		}
+		if m.HasLegIssuer() {
+			v, err := m.GetLegIssuer()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegIssuer = &v
+		}
+		if m.HasEncodedLegIssuerLen() {
+			v, err := m.GetEncodedLegIssuerLen()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedLegIssuerLen = &v
+		}
+		if m.HasEncodedLegIssuer() {
+			v, err := m.GetEncodedLegIssuer()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedLegIssuer = &v
+		}
+		if m.HasLegSecurityDesc() {
+			v, err := m.GetLegSecurityDesc()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSecurityDesc = &v
+		}
+		if m.HasEncodedLegSecurityDescLen() {
+			v, err := m.GetEncodedLegSecurityDescLen()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedLegSecurityDescLen = &v
+		}
+		if m.HasEncodedLegSecurityDesc() {
+			v, err := m.GetEncodedLegSecurityDesc()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedLegSecurityDesc = &v
+		}
+		if m.HasLegRatioQty() {
+			v, err := m.GetLegRatioQty()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegRatioQty = &v
+		}
+		if m.HasLegSide() {
+			v, err := m.GetLegSide()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSide = &v
+		}
+		if m.HasLegCurrency() {
+			v, err := m.GetLegCurrency()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegCurrency = &v
+		}
+		if m.HasLegPool() {
+			v, err := m.GetLegPool()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegPool = &v
+		}
+		if m.HasLegDatedDate() {
+			v, err := m.GetLegDatedDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegDatedDate = &v
+		}
+		if m.HasLegContractSettlMonth() {
+			v, err := m.GetLegContractSettlMonth()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegContractSettlMonth = &v
+		}
+		if m.HasLegInterestAccrualDate() {
+			v, err := m.GetLegInterestAccrualDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegInterestAccrualDate = &v
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoLegs builds a NoLegsRepeatingGroup from s
+func UnmarshalNoLegs(s []NoLegsStruct) NoLegsRepeatingGroup {
+	g := NewNoLegsRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.LegSymbol != nil {
+			m.SetLegSymbol(*e.LegSymbol)
+		}
+		if e.LegSymbolSfx != nil {
+			m.SetLegSymbolSfx(*e.LegSymbolSfx)
+		}
+		if e.LegSecurityID != nil {
+			m.SetLegSecurityID(*e.LegSecurityID)
+		}
+		if e.LegSecurityIDSource != nil {
+			m.SetLegSecurityIDSource(*e.LegSecurityIDSource)
+		}
+		if e.NoLegSecurityAltID != nil {
+			m.SetNoLegSecurityAltID(components.UnmarshalNoLegSecurityAltID(e.NoLegSecurityAltID))
+		}
+		if e.LegProduct != nil {
+			m.SetLegProduct(*e.LegProduct)
+		}
+		if e.LegCFICode != nil {
+			m.SetLegCFICode(*e.LegCFICode)
+		}
+		if e.LegSecurityType != nil {
+			m.SetLegSecurityType(*e.LegSecurityType)
+		}
+		if e.LegSecuritySubType != nil {
+			m.SetLegSecuritySubType(*e.LegSecuritySubType)
+		}
+		if e.LegMaturityMonthYear != nil {
+			m.SetLegMaturityMonthYear(*e.LegMaturityMonthYear)
+		}
+		if e.LegMaturityDate != nil {
+			m.SetLegMaturityDate(*e.LegMaturityDate)
+		}
+		if e.LegCouponPaymentDate != nil {
+			m.SetLegCouponPaymentDate(*e.LegCouponPaymentDate)
+		}
+		if e.LegIssueDate != nil {
+			m.SetLegIssueDate(*e.LegIssueDate)
+		}
+		if e.LegRepoCollateralSecurityType != nil {
+			m.SetLegRepoCollateralSecurityType(*e.LegRepoCollateralSecurityType)
+		}
+		if e.LegRepurchaseTerm != nil {
+			m.SetLegRepurchaseTerm(*e.LegRepurchaseTerm)
+		}
+		if e.LegRepurchaseRate != nil {
+			m.SetLegRepurchaseRate(*e.LegRepurchaseRate, fix44.DecimalScale(*e.LegRepurchaseRate))
+		}
+		if e.LegFactor != nil {
+			m.SetLegFactor(*e.LegFactor, fix44.DecimalScale(*e.LegFactor))
+		}
+		if e.LegCreditRating != nil {
+			m.SetLegCreditRating(*e.LegCreditRating)
+		}
+		if e.LegInstrRegistry != nil {
+			m.SetLegInstrRegistry(*e.LegInstrRegistry)
+		}
+		if e.LegCountryOfIssue != nil {
+			m.SetLegCountryOfIssue(*e.LegCountryOfIssue)
+		}
+		if e.LegStateOrProvinceOfIssue != nil {
+			m.SetLegStateOrProvinceOfIssue(*e.LegStateOrProvinceOfIssue)
+		}
+		if e.LegLocaleOfIssue != nil {
+			m.SetLegLocaleOfIssue(*e.LegLocaleOfIssue)
+		}
+		if e.LegRedemptionDate != nil {
+			m.SetLegRedemptionDate(*e.LegRedemptionDate)
+		}
+		if e.LegStrikePrice != nil {
+			m.SetLegStrikePrice(*e.LegStrikePrice, fix44.DecimalScale(*e.LegStrikePrice))
+		}
+		if e.LegStrikeCurrency != nil {
+			m.SetLegStrikeCurrency(*e.LegStrikeCurrency)
+		}
+		if e.LegOptAttribute != nil {
+			m.SetLegOptAttribute(*e.LegOptAttribute)
+		}
+		if e.LegContractMultiplier != nil {
+			m.SetLegContractMultiplier(*e.LegContractMultiplier, fix44.DecimalScale(*e.LegContractMultiplier))
+		}
+		if e.LegCouponRate != nil {
+			m.SetLegCouponRate(*e.LegCouponRate, fix44.DecimalScale(*e.LegCouponRate))
+		}
+		if e.LegSecurityExchange != nil {
+			m.SetLegSecurityExchange(*e.LegSecurityExchange)
+		}
+		if e.LegIssuer != nil {
+			m.SetLegIssuer(*e.LegIssuer)
+		}
+		if e.EncodedLegIssuerLen != nil {
+			m.SetEncodedLegIssuerLen(*e.EncodedLegIssuerLen)
+		}
+		if e.EncodedLegIssuer != nil {
+			m.SetEncodedLegIssuer(*e.EncodedLegIssuer)
+		}
+		if e.LegSecurityDesc != nil {
+			m.SetLegSecurityDesc(*e.LegSecurityDesc)
+		}
+		if e.EncodedLegSecurityDescLen != nil {
+			m.SetEncodedLegSecurityDescLen(*e.EncodedLegSecurityDescLen)
+		}
+		if e.EncodedLegSecurityDesc != nil {
+			m.SetEncodedLegSecurityDesc(*e.EncodedLegSecurityDesc)
+		}
+		if e.LegRatioQty != nil {
+			m.SetLegRatioQty(*e.LegRatioQty, fix44.DecimalScale(*e.LegRatioQty))
+		}
+		if e.LegSide != nil {
+			m.SetLegSide(*e.LegSide)
+		}
+		if e.LegCurrency != nil {
+			m.SetLegCurrency(*e.LegCurrency)
+		}
+		if e.LegPool != nil {
+			m.SetLegPool(*e.LegPool)
+		}
+		if e.LegDatedDate != nil {
+			m.SetLegDatedDate(*e.LegDatedDate)
+		}
+		if e.LegContractSettlMonth != nil {
+			m.SetLegContractSettlMonth(*e.LegContractSettlMonth)
+		}
+		if e.LegInterestAccrualDate != nil {
+			m.SetLegInterestAccrualDate(*e.LegInterestAccrualDate)
+		}
+	}
+	return g
+}
+
+//NoUnderlyingsStruct is a plain Go representation of a NoUnderlyings group element, optional fields are nil when absent
+type NoUnderlyingsStruct struct {
+	UnderlyingSymbol                     *string
+	UnderlyingSymbolSfx                  *string
+	UnderlyingSecurityID                 *string
+	UnderlyingSecurityIDSource           *string
+	NoUnderlyingSecurityAltID            []NoUnderlyingSecurityAltIDStruct
+	UnderlyingProduct                    *int
+	UnderlyingCFICode                    *string
+	UnderlyingSecurityType               *string
+	UnderlyingSecuritySubType            *string
+	UnderlyingMaturityMonthYear          *string
+	UnderlyingMaturityDate               *string
+	UnderlyingCouponPaymentDate          *string
+	UnderlyingIssueDate                  *string
+	UnderlyingRepoCollateralSecurityType *int
+	UnderlyingRepurchaseTerm             *int
+	UnderlyingRepurchaseRate             *decimal.Decimal
+	UnderlyingFactor                     *decimal.Decimal
+	UnderlyingCreditRating               *string
+	UnderlyingInstrRegistry              *string
+	UnderlyingCountryOfIssue             *string
+	UnderlyingStateOrProvinceOfIssue     *string
+	UnderlyingLocaleOfIssue              *string
+	UnderlyingRedemptionDate             *string
+	UnderlyingStrikePrice                *decimal.Decimal
+	UnderlyingStrikeCurrency             *string
+	UnderlyingOptAttribute               *string
+	UnderlyingContractMultiplier         *decimal.Decimal
+	UnderlyingCouponRate                 *decimal.Decimal
+	UnderlyingSecurityExchange           *string
+	UnderlyingIssuer                     *string
+	EncodedUnderlyingIssuerLen           *int
+	EncodedUnderlyingIssuer              *string
+	UnderlyingSecurityDesc               *string
+	EncodedUnderlyingSecurityDescLen     *int
+	EncodedUnderlyingSecurityDesc        *string
+	UnderlyingCPProgram                  *string
+	UnderlyingCPRegType                  *string
+	UnderlyingCurrency                   *string
+	UnderlyingQty                        *decimal.Decimal
+	UnderlyingPx                         *decimal.Decimal
+	UnderlyingDirtyPrice                 *decimal.Decimal
+	UnderlyingEndPrice                   *decimal.Decimal
+	UnderlyingStartValue                 *decimal.Decimal
+	UnderlyingCurrentValue               *decimal.Decimal
+	UnderlyingEndValue                   *decimal.Decimal
+	NoUnderlyingStips                    []NoUnderlyingStipsStruct
+}
+
+//MarshalNoUnderlyings copies the elements of g into a slice of NoUnderlyingsStruct
+func MarshalNoUnderlyings(g NoUnderlyingsRepeatingGroup) ([]NoUnderlyingsStruct, quickfix.MessageRejectError) {
+	s := make([]NoUnderlyingsStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasUnderlyingSymbol() {
+			v, err := m.GetUnderlyingSymbol()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingSymbol = &v
+		}
+		if m.HasUnderlyingSymbolSfx() {
+			v, err := m.GetUnderlyingSymbolSfx()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingSymbolSfx = &v
+		}
+		if m.HasUnderlyingSecurityID() {
+			v, err := m.GetUnderlyingSecurityID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingSecurityID = &v
+		}
+		if m.HasUnderlyingSecurityIDSource() {
+			v, err := m.GetUnderlyingSecurityIDSource()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingSecurityIDSource = &v
+		}
+		if m.HasNoUnderlyingSecurityAltID() {
+			g, err := m.GetNoUnderlyingSecurityAltID()
+			if err != nil {
+				return nil, err
+			}
+			if s[i].NoUnderlyingSecurityAltID, err = components.MarshalNoUnderlyingSecurityAltID(g); err != nil {
+				return nil, err
+			}
+		}
+		if m.HasUnderlyingProduct() {
+			v, err := m.GetUnderlyingProduct()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingProduct = &v
+		}
+		if m.HasUnderlyingCFICode() {
+			v, err := m.GetUnderlyingCFICode()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCFICode = &v
+		}
+		if m.HasUnderlyingSecurityType() {
+			v, err := m.GetUnderlyingSecurityType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingSecurityType = &v
+		}
+		if m.HasUnderlyingSecuritySubType() {
+			v, err := m.GetUnderlyingSecuritySubType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingSecuritySubType = &v
+		}
+		if m.HasUnderlyingMaturityMonthYear() {
+			v, err := m.GetUnderlyingMaturityMonthYear()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingMaturityMonthYear = &v
+		}
+		if m.HasUnderlyingMaturityDate() {
+			v, err := m.GetUnderlyingMaturityDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingMaturityDate = &v
+		}
+		if m.HasUnderlyingCouponPaymentDate() {
+			v, err := m.GetUnderlyingCouponPaymentDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCouponPaymentDate = &v
+		}
+		if m.HasUnderlyingIssueDate() {
+			v, err := m.GetUnderlyingIssueDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingIssueDate = &v
+		}
+		if m.HasUnderlyingRepoCollateralSecurityType() {
+			v, err := m.GetUnderlyingRepoCollateralSecurityType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingRepoCollateralSecurityType = &v
+		}
+		if m.HasUnderlyingRepurchaseTerm() {
+			v, err := m.GetUnderlyingRepurchaseTerm()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingRepurchaseTerm = &v
+		}
+		if m.HasUnderlyingRepurchaseRate() {
+			v, err := m.GetUnderlyingRepurchaseRate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingRepurchaseRate = &v
+		}
+		if m.HasUnderlyingFactor() {
+			v, err := m.GetUnderlyingFactor()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingFactor = &v
+		}
+		if m.HasUnderlyingCreditRating() {
+			v, err := m.GetUnderlyingCreditRating()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCreditRating = &v
+		}
+		if m.HasUnderlyingInstrRegistry() {
+			v, err := m.GetUnderlyingInstrRegistry()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingInstrRegistry = &v
+		}
+		if m.HasUnderlyingCountryOfIssue() {
+			v, err := m.GetUnderlyingCountryOfIssue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCountryOfIssue = &v
+		}
+		if m.HasUnderlyingStateOrProvinceOfIssue() {
+			v, err := m.GetUnderlyingStateOrProvinceOfIssue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingStateOrProvinceOfIssue = &v
+		}
+		if m.HasUnderlyingLocaleOfIssue() {
+			v, err := m.GetUnderlyingLocaleOfIssue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingLocaleOfIssue = &v
+		}
+		if m.HasUnderlyingRedemptionDate() {
+			v, err := m.GetUnderlyingRedemptionDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingRedemptionDate = &v
+		}
+		if m.HasUnderlyingStrikePrice() {
+			v, err := m.GetUnderlyingStrikePrice()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingStrikePrice = &v
+		}
+		if m.HasUnderlyingStrikeCurrency() {
+			v, err := m.GetUnderlyingStrikeCurrency()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingStrikeCurrency = &v
+		}
+		if m.HasUnderlyingOptAttribute() {
+			v, err := m.GetUnderlyingOptAttribute()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingOptAttribute = &v
+		}
+		if m.HasUnderlyingContractMultiplier() {
+			v, err := m.GetUnderlyingContractMultiplier()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingContractMultiplier = &v
+		}
+		if m.HasUnderlyingCouponRate() {
+			v, err := m.GetUnderlyingCouponRate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCouponRate = &v
+		}
+		if m.HasUnderlyingSecurityExchange() {
+			v, err := m.GetUnderlyingSecurityExchange()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingSecurityExchange = &v
+		}
+		if m.HasUnderlyingIssuer() {
+			v, err := m.GetUnderlyingIssuer()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingIssuer = &v
+		}
+		if m.HasEncodedUnderlyingIssuerLen() {
+			v, err := m.GetEncodedUnderlyingIssuerLen()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedUnderlyingIssuerLen = &v
+		}
+		if m.HasEncodedUnderlyingIssuer() {
+			v, err := m.GetEncodedUnderlyingIssuer()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedUnderlyingIssuer = &v
+		}
+		if m.HasUnderlyingSecurityDesc() {
+			v, err := m.GetUnderlyingSecurityDesc()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingSecurityDesc = &v
+		}
+		if m.HasEncodedUnderlyingSecurityDescLen() {
+			v, err := m.GetEncodedUnderlyingSecurityDescLen()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedUnderlyingSecurityDescLen = &v
+		}
+		if m.HasEncodedUnderlyingSecurityDesc() {
+			v, err := m.GetEncodedUnderlyingSecurityDesc()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedUnderlyingSecurityDesc = &v
+		}
+		if m.HasUnderlyingCPProgram() {
+			v, err := m.GetUnderlyingCPProgram()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCPProgram = &v
+		}
+		if m.HasUnderlyingCPRegType() {
+			v, err := m.GetUnderlyingCPRegType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCPRegType = &v
+		}
+		if m.HasUnderlyingCurrency() {
+			v, err := m.GetUnderlyingCurrency()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCurrency = &v
+		}
+		if m.HasUnderlyingQty() {
+			v, err := m.GetUnderlyingQty()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingQty = &v
+		}
+		if m.HasUnderlyingPx() {
+			v, err := m.GetUnderlyingPx()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingPx = &v
+		}
+		if m.HasUnderlyingDirtyPrice() {
+			v, err := m.GetUnderlyingDirtyPrice()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingDirtyPrice = &v
+		}
+		if m.HasUnderlyingEndPrice() {
+			v, err := m.GetUnderlyingEndPrice()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingEndPrice = &v
+		}
+		if m.HasUnderlyingStartValue() {
+			v, err := m.GetUnderlyingStartValue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingStartValue = &v
+		}
+		if m.HasUnderlyingCurrentValue() {
+			v, err := m.GetUnderlyingCurrentValue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCurrentValue = &v
+		}
+		if m.HasUnderlyingEndValue() {
+			v, err := m.GetUnderlyingEndValue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingEndValue = &v
+		}
+		if m.HasNoUnderlyingStips() {
+			g, err := m.GetNoUnderlyingStips()
+			if err != nil {
+				return nil, err
+			}
+			if s[i].NoUnderlyingStips, err = components.MarshalNoUnderlyingStips(g); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoUnderlyings builds a NoUnderlyingsRepeatingGroup from s
+func UnmarshalNoUnderlyings(s []NoUnderlyingsStruct) NoUnderlyingsRepeatingGroup {
+	g := NewNoUnderlyingsRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.UnderlyingSymbol != nil {
+			m.SetUnderlyingSymbol(*e.UnderlyingSymbol)
+		}
+		if e.UnderlyingSymbolSfx != nil {
+			m.SetUnderlyingSymbolSfx(*e.UnderlyingSymbolSfx)
+		}
+		if e.UnderlyingSecurityID != nil {
+			m.SetUnderlyingSecurityID(*e.UnderlyingSecurityID)
+		}
+		if e.UnderlyingSecurityIDSource != nil {
+			m.SetUnderlyingSecurityIDSource(*e.UnderlyingSecurityIDSource)
+		}
+		if e.NoUnderlyingSecurityAltID != nil {
+			m.SetNoUnderlyingSecurityAltID(components.UnmarshalNoUnderlyingSecurityAltID(e.NoUnderlyingSecurityAltID))
+		}
+		if e.UnderlyingProduct != nil {
+			m.SetUnderlyingProduct(*e.UnderlyingProduct)
+		}
+		if e.UnderlyingCFICode != nil {
+			m.SetUnderlyingCFICode(*e.UnderlyingCFICode)
+		}
+		if e.UnderlyingSecurityType != nil {
+			m.SetUnderlyingSecurityType(*e.UnderlyingSecurityType)
+		}
+		if e.UnderlyingSecuritySubType != nil {
+			m.SetUnderlyingSecuritySubType(*e.UnderlyingSecuritySubType)
+		}
+		if e.UnderlyingMaturityMonthYear != nil {
+			m.SetUnderlyingMaturityMonthYear(*e.UnderlyingMaturityMonthYear)
+		}
+		if e.UnderlyingMaturityDate != nil {
+			m.SetUnderlyingMaturityDate(*e.UnderlyingMaturityDate)
+		}
+		if e.UnderlyingCouponPaymentDate != nil {
+			m.SetUnderlyingCouponPaymentDate(*e.UnderlyingCouponPaymentDate)
+		}
+		if e.UnderlyingIssueDate != nil {
+			m.SetUnderlyingIssueDate(*e.UnderlyingIssueDate)
+		}
+		if e.UnderlyingRepoCollateralSecurityType != nil {
+			m.SetUnderlyingRepoCollateralSecurityType(*e.UnderlyingRepoCollateralSecurityType)
+		}
+		if e.UnderlyingRepurchaseTerm != nil {
+			m.SetUnderlyingRepurchaseTerm(*e.UnderlyingRepurchaseTerm)
+		}
+		if e.UnderlyingRepurchaseRate != nil {
+			m.SetUnderlyingRepurchaseRate(*e.UnderlyingRepurchaseRate, fix44.DecimalScale(*e.UnderlyingRepurchaseRate))
+		}
+		if e.UnderlyingFactor != nil {
+			m.SetUnderlyingFactor(*e.UnderlyingFactor, fix44.DecimalScale(*e.UnderlyingFactor))
+		}
+		if e.UnderlyingCreditRating != nil {
+			m.SetUnderlyingCreditRating(*e.UnderlyingCreditRating)
+		}
+		if e.UnderlyingInstrRegistry != nil {
+			m.SetUnderlyingInstrRegistry(*e.UnderlyingInstrRegistry)
+		}
+		if e.UnderlyingCountryOfIssue != nil {
+			m.SetUnderlyingCountryOfIssue(*e.UnderlyingCountryOfIssue)
+		}
+		if e.UnderlyingStateOrProvinceOfIssue != nil {
+			m.SetUnderlyingStateOrProvinceOfIssue(*e.UnderlyingStateOrProvinceOfIssue)
+		}
+		if e.UnderlyingLocaleOfIssue != nil {
+			m.SetUnderlyingLocaleOfIssue(*e.UnderlyingLocaleOfIssue)
+		}
+		if e.UnderlyingRedemptionDate != nil {
+			m.SetUnderlyingRedemptionDate(*e.UnderlyingRedemptionDate)
+		}
+		if e.UnderlyingStrikePrice != nil {
+			m.SetUnderlyingStrikePrice(*e.UnderlyingStrikePrice, fix44.DecimalScale(*e.UnderlyingStrikePrice))
+		}
+		if e.UnderlyingStrikeCurrency != nil {
+			m.SetUnderlyingStrikeCurrency(*e.UnderlyingStrikeCurrency)
+		}
+		if e.UnderlyingOptAttribute != nil {
+			m.SetUnderlyingOptAttribute(*e.UnderlyingOptAttribute)
+		}
+		if e.UnderlyingContractMultiplier != nil {
+			m.SetUnderlyingContractMultiplier(*e.UnderlyingContractMultiplier, fix44.DecimalScale(*e.UnderlyingContractMultiplier))
+		}
+		if e.UnderlyingCouponRate != nil {
+			m.SetUnderlyingCouponRate(*e.UnderlyingCouponRate, fix44.DecimalScale(*e.UnderlyingCouponRate))
+		}
+		if e.UnderlyingSecurityExchange != nil {
+			m.SetUnderlyingSecurityExchange(*e.UnderlyingSecurityExchange)
+		}
+		if e.UnderlyingIssuer != nil {
+			m.SetUnderlyingIssuer(*e.UnderlyingIssuer)
+		}
+		if e.EncodedUnderlyingIssuerLen != nil {
+			m.SetEncodedUnderlyingIssuerLen(*e.EncodedUnderlyingIssuerLen)
+		}
+		if e.EncodedUnderlyingIssuer != nil {
+			m.SetEncodedUnderlyingIssuer(*e.EncodedUnderlyingIssuer)
+		}
+		if e.UnderlyingSecurityDesc != nil {
+			m.SetUnderlyingSecurityDesc(*e.UnderlyingSecurityDesc)
+		}
+		if e.EncodedUnderlyingSecurityDescLen != nil {
+			m.SetEncodedUnderlyingSecurityDescLen(*e.EncodedUnderlyingSecurityDescLen)
+		}
+		if e.EncodedUnderlyingSecurityDesc != nil {
+			m.SetEncodedUnderlyingSecurityDesc(*e.EncodedUnderlyingSecurityDesc)
+		}
+		if e.UnderlyingCPProgram != nil {
+			m.SetUnderlyingCPProgram(*e.UnderlyingCPProgram)
+		}
+		if e.UnderlyingCPRegType != nil {
+			m.SetUnderlyingCPRegType(*e.UnderlyingCPRegType)
+		}
+		if e.UnderlyingCurrency != nil {
+			m.SetUnderlyingCurrency(*e.UnderlyingCurrency)
+		}
+		if e.UnderlyingQty != nil {
+			m.SetUnderlyingQty(*e.UnderlyingQty, fix44.DecimalScale(*e.UnderlyingQty))
+		}
+		if e.UnderlyingPx != nil {
+			m.SetUnderlyingPx(*e.UnderlyingPx, fix44.DecimalScale(*e.UnderlyingPx))
+		}
+		if e.UnderlyingDirtyPrice != nil {
+			m.SetUnderlyingDirtyPrice(*e.UnderlyingDirtyPrice, fix44.DecimalScale(*e.UnderlyingDirtyPrice))
+		}
+		if e.UnderlyingEndPrice != nil {
+			m.SetUnderlyingEndPrice(*e.UnderlyingEndPrice, fix44.DecimalScale(*e.UnderlyingEndPrice))
+		}
+		if e.UnderlyingStartValue != nil {
+			m.SetUnderlyingStartValue(*e.UnderlyingStartValue, fix44.DecimalScale(*e.UnderlyingStartValue))
+		}
+		if e.UnderlyingCurrentValue != nil {
+			m.SetUnderlyingCurrentValue(*e.UnderlyingCurrentValue, fix44.DecimalScale(*e.UnderlyingCurrentValue))
+		}
+		if e.UnderlyingEndValue != nil {
+			m.SetUnderlyingEndValue(*e.UnderlyingEndValue, fix44.DecimalScale(*e.UnderlyingEndValue))
+		}
+		if e.NoUnderlyingStips != nil {
+			m.SetNoUnderlyingStips(components.UnmarshalNoUnderlyingStips(e.NoUnderlyingStips))
+		}
+	}
+	return g
 }
@@ -0,0 +1,217 @@
+package allocation
+
+import (
+	"github.com/terracefi/enum"
+	"github.com/terracefi/fix44/allocationinstruction"
+	"github.com/terracefi/fix44/allocationreport"
+	"github.com/terracefi/fix44/confirmation"
+	"github.com/terracefi/fix44/internal/fixutil"
+)
+
+//AllocTransType values, FIX 4.4
+const (
+	TransNew                          enum.AllocTransType = "0"
+	TransReplace                      enum.AllocTransType = "1"
+	TransCancel                       enum.AllocTransType = "2"
+	TransPreliminary                  enum.AllocTransType = "3"
+	TransCalculated                   enum.AllocTransType = "4"
+	TransCalculatedWithoutPreliminary enum.AllocTransType = "5"
+)
+
+//AllocStatus values, FIX 4.4
+const (
+	AllocAccepted               enum.AllocStatus = "0"
+	AllocBlockLevelReject       enum.AllocStatus = "1"
+	AllocAccountLevelReject     enum.AllocStatus = "2"
+	AllocReceived               enum.AllocStatus = "3"
+	AllocIncomplete             enum.AllocStatus = "4"
+	AllocRejectedByIntermediary enum.AllocStatus = "5"
+)
+
+//ConfirmTransType values, FIX 4.4
+const (
+	ConfirmNew     enum.ConfirmTransType = "0"
+	ConfirmReplace enum.ConfirmTransType = "1"
+	ConfirmCancel  enum.ConfirmTransType = "2"
+)
+
+//AffirmStatus values, FIX 4.4
+const (
+	AffirmReceived        enum.AffirmStatus = "1"
+	AffirmConfirmRejected enum.AffirmStatus = "2"
+	AffirmAffirmed        enum.AffirmStatus = "3"
+)
+
+//State is the state of an allocation
+type State int
+
+//State values
+const (
+	StatePending State = iota
+	StateReceived
+	StateAccepted
+	StateBlockRejected
+	StateAccountRejected
+	StateIncomplete
+	StateRejectedByIntermediary
+	StateCanceled
+)
+
+func (s State) String() string {
+	switch s {
+	case StatePending:
+		return "Pending"
+	case StateReceived:
+		return "Received"
+	case StateAccepted:
+		return "Accepted"
+	case StateBlockRejected:
+		return "BlockRejected"
+	case StateAccountRejected:
+		return "AccountRejected"
+	case StateIncomplete:
+		return "Incomplete"
+	case StateRejectedByIntermediary:
+		return "RejectedByIntermediary"
+	case StateCanceled:
+		return "Canceled"
+	}
+	return "Unknown"
+}
+
+//stateOf returns the State an AllocStatus moves an allocation to
+func stateOf(s enum.AllocStatus) State {
+	switch s {
+	case AllocAccepted:
+		return StateAccepted
+	case AllocBlockLevelReject:
+		return StateBlockRejected
+	case AllocAccountLevelReject:
+		return StateAccountRejected
+	case AllocReceived:
+		return StateReceived
+	case AllocIncomplete:
+		return StateIncomplete
+	case AllocRejectedByIntermediary:
+		return StateRejectedByIntermediary
+	}
+	return StatePending
+}
+
+//Allocation is the state of an allocation as seen by a Tracker
+type Allocation struct {
+	//AllocID is the AllocID of the instruction or report currently in force
+	AllocID string
+	//AllocIDs holds every AllocID the allocation has been sent under, oldest first
+	AllocIDs []string
+
+	State State
+	//Complete is false while fragments of the instruction are outstanding
+	Complete bool
+
+	//Instruction is the last AllocationInstruction applied, Report the last AllocationReport applied
+	Instruction *allocationinstruction.Struct
+	Report      *allocationreport.Struct
+
+	//RejCode and Text are set from the last ack or report, AccountRejects holds the IndividualAllocRejCode of the
+	//rejected accounts of the last ack keyed by AllocAccount
+	RejCode        *enum.AllocRejCode
+	Text           string
+	AccountRejects map[string]int
+
+	//Confirmations maps each AllocAccount to the ConfirmID of its current Confirmation
+	Confirmations map[string]string
+}
+
+//Accounts returns the AllocAccounts of the NoAllocs of the allocation, taken from the last report if there is one
+func (a Allocation) Accounts() []string {
+	var accounts []string
+	switch {
+	case a.Report != nil:
+		for _, n := range a.Report.NoAllocs {
+			if n.AllocAccount != nil {
+				accounts = append(accounts, *n.AllocAccount)
+			}
+		}
+	case a.Instruction != nil:
+		for _, n := range a.Instruction.NoAllocs {
+			if n.AllocAccount != nil {
+				accounts = append(accounts, *n.AllocAccount)
+			}
+		}
+	}
+	return accounts
+}
+
+func (a *Allocation) clone() Allocation {
+	c := *a
+	c.AllocIDs = append([]string(nil), a.AllocIDs...)
+	c.AccountRejects = make(map[string]int, len(a.AccountRejects))
+	for k, v := range a.AccountRejects {
+		c.AccountRejects[k] = v
+	}
+	c.Confirmations = make(map[string]string, len(a.Confirmations))
+	for k, v := range a.Confirmations {
+		c.Confirmations[k] = v
+	}
+	return c
+}
+
+func (a *Allocation) addID(id string) {
+	for _, i := range a.AllocIDs {
+		if i == id {
+			return
+		}
+	}
+	a.AllocIDs = append(a.AllocIDs, id)
+}
+
+//setStatus applies the AllocStatus of an ack or report. A canceled allocation stays canceled.
+func (a *Allocation) setStatus(status enum.AllocStatus, rejCode *enum.AllocRejCode, text *string) {
+	if a.State != StateCanceled {
+		a.State = stateOf(status)
+	}
+	a.RejCode = rejCode
+	a.Text = fixutil.Deref(text)
+}
+
+//Confirmation is the state of the confirmation of one allocation account
+type Confirmation struct {
+	//ConfirmID is the ConfirmID of the confirmation currently in force
+	ConfirmID string
+	//ConfirmIDs holds every ConfirmID the confirmation has been sent under, oldest first
+	ConfirmIDs []string
+
+	AllocID      string
+	AllocAccount string
+	Canceled     bool
+	//Confirmation is the last Confirmation applied, its ConfirmStatus is the status of the confirmation
+	Confirmation confirmation.Struct
+
+	//AffirmStatus, RejReason and Text are set from the last ConfirmationAck
+	AffirmStatus *enum.AffirmStatus
+	RejReason    *enum.ConfirmRejReason
+	Text         string
+}
+
+//Affirmed returns true if the confirmation has been affirmed and not canceled since
+func (c Confirmation) Affirmed() bool {
+	return !c.Canceled && c.AffirmStatus != nil && *c.AffirmStatus == AffirmAffirmed
+}
+
+func (c *Confirmation) clone() Confirmation {
+	cl := *c
+	cl.ConfirmIDs = append([]string(nil), c.ConfirmIDs...)
+	return cl
+}
+
+func reportAllocID(s allocationreport.Struct) string {
+	if s.AllocID != nil {
+		return *s.AllocID
+	}
+	return s.AllocReportID
+}
+
+func lastFragment(v *bool) bool {
+	return v == nil || *v
+}
@@ -0,0 +1,84 @@
+package allocation
+
+import (
+	"github.com/shopspring/decimal"
+
+	"github.com/terracefi/fix44/allocationinstruction"
+	"github.com/terracefi/fix44/allocationreport"
+)
+
+//fill is the LastQty and LastPx of a NoExecs entry
+type fill struct {
+	qty, px decimal.Decimal
+}
+
+//Check verifies that the AllocQty of the NoAllocs of s add up to its Quantity, and that its AvgPx matches the
+//average price of its NoExecs fills. The average is compared at AvgPxPrecision decimal places, or at the places of
+//AvgPx when AvgPxPrecision is absent. Either check is skipped when s has no NoAllocs or no priced NoExecs. s must
+//hold every fragment of a fragmented instruction.
+func Check(s allocationinstruction.Struct) error {
+	var allocs []*decimal.Decimal
+	for _, a := range s.NoAllocs {
+		allocs = append(allocs, a.AllocQty)
+	}
+	var fills []fill
+	for _, e := range s.NoExecs {
+		if e.LastQty != nil && e.LastPx != nil {
+			fills = append(fills, fill{*e.LastQty, *e.LastPx})
+		}
+	}
+	return check(s.AllocID, s.Quantity, s.AvgPx, s.AvgPxPrecision, allocs, fills)
+}
+
+//CheckReport verifies an AllocationReport the same way Check verifies an AllocationInstruction
+func CheckReport(s allocationreport.Struct) error {
+	var allocs []*decimal.Decimal
+	for _, a := range s.NoAllocs {
+		allocs = append(allocs, a.AllocQty)
+	}
+	var fills []fill
+	for _, e := range s.NoExecs {
+		if e.LastQty != nil && e.LastPx != nil {
+			fills = append(fills, fill{*e.LastQty, *e.LastPx})
+		}
+	}
+	return check(reportAllocID(s), s.Quantity, s.AvgPx, s.AvgPxPrecision, allocs, fills)
+}
+
+func check(allocID string, qty, avgPx decimal.Decimal, precision *int, allocs []*decimal.Decimal, fills []fill) error {
+	if len(allocs) > 0 {
+		allocated := decimal.Zero
+		for _, a := range allocs {
+			if a != nil {
+				allocated = allocated.Add(*a)
+			}
+		}
+		if !allocated.Equal(qty) {
+			return &QuantityError{allocID, qty, allocated}
+		}
+	}
+
+	if len(fills) > 0 {
+		filled, notional := decimal.Zero, decimal.Zero
+		for _, f := range fills {
+			filled = filled.Add(f.qty)
+			notional = notional.Add(f.qty.Mul(f.px))
+		}
+		if filled.IsZero() {
+			return nil
+		}
+
+		places := -avgPx.Exponent()
+		if precision != nil {
+			places = int32(*precision)
+		}
+		if places < 0 {
+			places = 0
+		}
+		fillAvgPx := notional.Div(filled).Round(places)
+		if !fillAvgPx.Equal(avgPx.Round(places)) {
+			return &AvgPxError{allocID, avgPx, fillAvgPx}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,18 @@
+/*
+Package allocation tracks post-trade allocations and the confirmations of their accounts.
+
+A Tracker follows each allocation through its AllocTransType chain. An AllocationInstruction or AllocationReport
+with AllocTransType New starts an allocation, and Replace and Cancel messages refer to it through RefAllocID, so an
+allocation can be looked up by any AllocID it has been sent under. The AllocStatus of the acks and reports moves the
+allocation between the States of this package. Instructions fragmented with TotNoAllocs and LastFragment are
+gathered under their AllocID until the last fragment arrives.
+
+Before an instruction or report is applied, Check verifies that its NoAllocs quantities add up to the block
+Quantity and that its AvgPx matches the average price of its NoExecs fills. Messages that fail the check are not
+applied, so the caller can reject them.
+
+Each Confirmation is tracked by ConfirmID and attached to the allocation account it confirms. A ConfirmationAck
+with AffirmStatus Affirmed completes the account, and Tracker.Affirmed reports when every account of an allocation
+has been affirmed.
+*/
+package allocation
@@ -0,0 +1,65 @@
+package allocation
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+//UnknownAllocationError is returned for a message that refers to an AllocID the Tracker does not know
+type UnknownAllocationError struct {
+	AllocID string
+}
+
+func (e *UnknownAllocationError) Error() string {
+	return fmt.Sprintf("allocation: unknown AllocID %v", e.AllocID)
+}
+
+//DuplicateAllocationError is returned for a New message that reuses an AllocID
+type DuplicateAllocationError struct {
+	AllocID string
+}
+
+func (e *DuplicateAllocationError) Error() string {
+	return fmt.Sprintf("allocation: duplicate AllocID %v", e.AllocID)
+}
+
+//UnknownConfirmationError is returned for a message that refers to a ConfirmID the Tracker does not know
+type UnknownConfirmationError struct {
+	ConfirmID string
+}
+
+func (e *UnknownConfirmationError) Error() string {
+	return fmt.Sprintf("allocation: unknown ConfirmID %v", e.ConfirmID)
+}
+
+//DuplicateConfirmationError is returned for a New Confirmation that reuses a ConfirmID
+type DuplicateConfirmationError struct {
+	ConfirmID string
+}
+
+func (e *DuplicateConfirmationError) Error() string {
+	return fmt.Sprintf("allocation: duplicate ConfirmID %v", e.ConfirmID)
+}
+
+//QuantityError is returned when the AllocQty of the NoAllocs of an allocation do not add up to its Quantity
+type QuantityError struct {
+	AllocID   string
+	Quantity  decimal.Decimal
+	Allocated decimal.Decimal
+}
+
+func (e *QuantityError) Error() string {
+	return fmt.Sprintf("allocation: AllocID %v allocates %v of Quantity %v", e.AllocID, e.Allocated, e.Quantity)
+}
+
+//AvgPxError is returned when the AvgPx of an allocation does not match the average price of its NoExecs fills
+type AvgPxError struct {
+	AllocID   string
+	AvgPx     decimal.Decimal
+	FillAvgPx decimal.Decimal
+}
+
+func (e *AvgPxError) Error() string {
+	return fmt.Sprintf("allocation: AllocID %v has AvgPx %v but its fills average %v", e.AllocID, e.AvgPx, e.FillAvgPx)
+}
@@ -0,0 +1,313 @@
+package allocation
+
+import (
+	"sync"
+
+	"github.com/terracefi/enum"
+	"github.com/terracefi/fix44/allocationinstruction"
+	"github.com/terracefi/fix44/allocationinstructionack"
+	"github.com/terracefi/fix44/allocationreport"
+	"github.com/terracefi/fix44/allocationreportack"
+	"github.com/terracefi/fix44/confirmation"
+	"github.com/terracefi/fix44/confirmationack"
+	"github.com/terracefi/fix44/internal/fixutil"
+)
+
+//Tracker follows allocations and their confirmations. It is safe for concurrent use.
+type Tracker struct {
+	mu            sync.Mutex
+	allocations   map[string]*Allocation
+	reports       map[string]*Allocation
+	confirmations map[string]*Confirmation
+}
+
+//New returns an empty Tracker
+func New() *Tracker {
+	return &Tracker{
+		allocations:   make(map[string]*Allocation),
+		reports:       make(map[string]*Allocation),
+		confirmations: make(map[string]*Confirmation),
+	}
+}
+
+//Allocation returns the allocation that has, or has had, the given AllocID
+func (t *Tracker) Allocation(allocID string) (Allocation, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	a, ok := t.allocations[allocID]
+	if !ok {
+		return Allocation{}, false
+	}
+	return a.clone(), true
+}
+
+//Confirmation returns the confirmation that has, or has had, the given ConfirmID
+func (t *Tracker) Confirmation(confirmID string) (Confirmation, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	c, ok := t.confirmations[confirmID]
+	if !ok {
+		return Confirmation{}, false
+	}
+	return c.clone(), true
+}
+
+//Affirmed returns true when every account of the allocation with the given AllocID has an affirmed confirmation
+func (t *Tracker) Affirmed(allocID string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	a, ok := t.allocations[allocID]
+	if !ok {
+		return false
+	}
+	accounts := a.Accounts()
+	if len(accounts) == 0 {
+		return false
+	}
+	for _, acct := range accounts {
+		c, ok := t.confirmations[a.Confirmations[acct]]
+		if !ok || !c.Affirmed() {
+			return false
+		}
+	}
+	return true
+}
+
+//OnInstruction applies an AllocationInstruction, sent or received. A fragment is added to the NoAllocs of the
+//fragments before it, and the instruction is checked with Check once its last fragment has arrived. An instruction
+//that fails the check is not applied.
+func (t *Tracker) OnInstruction(msg allocationinstruction.AllocationInstruction) (Allocation, error) {
+	s, err := allocationinstruction.Marshal(msg)
+	if err != nil {
+		return Allocation{}, err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if a, ok := t.allocations[s.AllocID]; ok {
+		if a.Complete || a.Instruction == nil || a.Instruction.AllocID != s.AllocID {
+			return Allocation{}, &DuplicateAllocationError{s.AllocID}
+		}
+
+		merged := *a.Instruction
+		merged.NoAllocs = append(append([]allocationinstruction.NoAllocsStruct(nil), merged.NoAllocs...), s.NoAllocs...)
+		merged.LastFragment = s.LastFragment
+		if lastFragment(merged.LastFragment) {
+			if err := Check(merged); err != nil {
+				return a.clone(), err
+			}
+			a.Complete = true
+		}
+		a.Instruction = &merged
+		return a.clone(), nil
+	}
+
+	complete := lastFragment(s.LastFragment)
+	if complete && s.AllocTransType != TransCancel {
+		if err := Check(s); err != nil {
+			return Allocation{}, err
+		}
+	}
+
+	a, refErr := t.apply(s.AllocID, s.AllocTransType, s.RefAllocID)
+	if refErr != nil {
+		return Allocation{}, refErr
+	}
+	a.Instruction = &s
+	a.Complete = complete || s.AllocTransType == TransCancel
+	return a.clone(), nil
+}
+
+//OnInstructionAck applies the AllocStatus of an AllocationInstructionAck to the allocation of its AllocID
+func (t *Tracker) OnInstructionAck(msg allocationinstructionack.AllocationInstructionAck) (Allocation, error) {
+	ack, err := allocationinstructionack.Marshal(msg)
+	if err != nil {
+		return Allocation{}, err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	a, ok := t.allocations[ack.AllocID]
+	if !ok {
+		return Allocation{}, &UnknownAllocationError{ack.AllocID}
+	}
+	a.setStatus(ack.AllocStatus, ack.AllocRejCode, ack.Text)
+	a.AccountRejects = make(map[string]int)
+	for _, n := range ack.NoAllocs {
+		if n.AllocAccount != nil && n.IndividualAllocRejCode != nil {
+			a.AccountRejects[*n.AllocAccount] = *n.IndividualAllocRejCode
+		}
+	}
+	return a.clone(), nil
+}
+
+//OnReport applies an AllocationReport. A report refers to an earlier report through AllocReportRefID, or to an
+//instruction through AllocID, and otherwise starts an allocation of its own. A report that fails CheckReport is not
+//applied.
+func (t *Tracker) OnReport(msg allocationreport.AllocationReport) (Allocation, error) {
+	s, err := allocationreport.Marshal(msg)
+	if err != nil {
+		return Allocation{}, err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if _, ok := t.reports[s.AllocReportID]; ok {
+		return Allocation{}, &DuplicateAllocationError{s.AllocReportID}
+	}
+	if lastFragment(s.LastFragment) && s.AllocTransType != TransCancel {
+		if err := CheckReport(s); err != nil {
+			return Allocation{}, err
+		}
+	}
+
+	var a *Allocation
+	switch {
+	case s.AllocReportRefID != nil:
+		if a = t.reports[*s.AllocReportRefID]; a == nil {
+			return Allocation{}, &UnknownAllocationError{*s.AllocReportRefID}
+		}
+	case s.AllocID != nil:
+		a = t.allocations[*s.AllocID]
+	}
+	if a == nil {
+		if s.AllocTransType == TransReplace || s.AllocTransType == TransCancel {
+			return Allocation{}, &UnknownAllocationError{reportAllocID(s)}
+		}
+		a = &Allocation{AllocID: reportAllocID(s), Complete: true, Confirmations: make(map[string]string)}
+	}
+
+	a.Report = &s
+	if s.AllocTransType == TransCancel {
+		a.State = StateCanceled
+	}
+	a.setStatus(s.AllocStatus, s.AllocRejCode, s.Text)
+	t.reports[s.AllocReportID] = a
+	if s.AllocID != nil {
+		a.addID(*s.AllocID)
+		t.allocations[*s.AllocID] = a
+	}
+	return a.clone(), nil
+}
+
+//OnReportAck applies the AllocStatus of an AllocationReportAck to the allocation of its AllocID
+func (t *Tracker) OnReportAck(msg allocationreportack.AllocationReportAck) (Allocation, error) {
+	ack, err := allocationreportack.Marshal(msg)
+	if err != nil {
+		return Allocation{}, err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	a, ok := t.allocations[ack.AllocID]
+	if !ok {
+		return Allocation{}, &UnknownAllocationError{ack.AllocID}
+	}
+	a.setStatus(ack.AllocStatus, ack.AllocRejCode, ack.Text)
+	return a.clone(), nil
+}
+
+//OnConfirmation applies a Confirmation. A New confirmation starts the confirmation of its AllocAccount, Replace
+//and Cancel confirmations refer to it through ConfirmRefID. The confirmation is attached to the allocation of its
+//AllocID, if the Tracker knows it.
+func (t *Tracker) OnConfirmation(msg confirmation.Confirmation) (Confirmation, error) {
+	s, err := confirmation.Marshal(msg)
+	if err != nil {
+		return Confirmation{}, err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if _, ok := t.confirmations[s.ConfirmID]; ok {
+		return Confirmation{}, &DuplicateConfirmationError{s.ConfirmID}
+	}
+
+	var c *Confirmation
+	switch s.ConfirmTransType {
+	case ConfirmReplace, ConfirmCancel:
+		ref := fixutil.Deref(s.ConfirmRefID)
+		if c = t.confirmations[ref]; c == nil {
+			return Confirmation{}, &UnknownConfirmationError{ref}
+		}
+		if s.ConfirmTransType == ConfirmCancel {
+			c.Canceled = true
+		} else {
+			c.ConfirmID = s.ConfirmID
+			c.AffirmStatus, c.RejReason, c.Text = nil, nil, ""
+		}
+	default:
+		c = &Confirmation{ConfirmID: s.ConfirmID}
+	}
+
+	c.ConfirmIDs = append(c.ConfirmIDs, s.ConfirmID)
+	c.AllocID = fixutil.Deref(s.AllocID)
+	c.AllocAccount = s.AllocAccount
+	c.Confirmation = s
+	t.confirmations[s.ConfirmID] = c
+
+	if a, ok := t.allocations[c.AllocID]; ok {
+		if c.Canceled {
+			if a.Confirmations[c.AllocAccount] == c.ConfirmID {
+				delete(a.Confirmations, c.AllocAccount)
+			}
+		} else {
+			a.Confirmations[c.AllocAccount] = c.ConfirmID
+		}
+	}
+	return c.clone(), nil
+}
+
+//OnConfirmationAck applies the AffirmStatus of a ConfirmationAck to the confirmation of its ConfirmID
+func (t *Tracker) OnConfirmationAck(msg confirmationack.ConfirmationAck) (Confirmation, error) {
+	ack, err := confirmationack.Marshal(msg)
+	if err != nil {
+		return Confirmation{}, err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	c, ok := t.confirmations[ack.ConfirmID]
+	if !ok {
+		return Confirmation{}, &UnknownConfirmationError{ack.ConfirmID}
+	}
+	status := ack.AffirmStatus
+	c.AffirmStatus = &status
+	c.RejReason = ack.ConfirmRejReason
+	c.Text = fixutil.Deref(ack.Text)
+	return c.clone(), nil
+}
+
+//apply starts an allocation for a New, Preliminary or Calculated message, or moves the allocation named by
+//refAllocID for a Replace or Cancel. A Calculated message with a RefAllocID replaces the Preliminary it refers to.
+func (t *Tracker) apply(allocID string, transType enum.AllocTransType, refAllocID *string) (*Allocation, error) {
+	var a *Allocation
+	switch {
+	case transType == TransReplace || transType == TransCancel || (transType == TransCalculated && refAllocID != nil):
+		ref := fixutil.Deref(refAllocID)
+		if a = t.allocations[ref]; a == nil {
+			return nil, &UnknownAllocationError{ref}
+		}
+		if transType == TransCancel {
+			a.State = StateCanceled
+		} else {
+			a.AllocID = allocID
+			a.State = StatePending
+			a.RejCode, a.Text, a.AccountRejects = nil, "", nil
+		}
+	default:
+		a = &Allocation{AllocID: allocID, State: StatePending, Confirmations: make(map[string]string)}
+	}
+
+	a.addID(allocID)
+	t.allocations[allocID] = a
+	return a, nil
+}
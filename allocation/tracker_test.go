@@ -0,0 +1,433 @@
+package allocation
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/terracefi/enum"
+	"github.com/terracefi/fix44/allocationinstruction"
+	"github.com/terracefi/fix44/allocationinstructionack"
+	"github.com/terracefi/fix44/allocationreport"
+	"github.com/terracefi/fix44/confirmation"
+	"github.com/terracefi/fix44/confirmationack"
+	"github.com/terracefi/fix44/internal/fixutil"
+)
+
+type alloc struct {
+	account string
+	qty     int64
+}
+
+type instruction struct {
+	allocID, refAllocID string
+	transType           enum.AllocTransType
+	quantity            int64
+	allocs              []alloc
+	lastFragment        *bool
+}
+
+func (i instruction) Struct() allocationinstruction.Struct {
+	s := allocationinstruction.Struct{
+		AllocID:           i.allocID,
+		AllocTransType:    i.transType,
+		AllocType:         "1",
+		AllocNoOrdersType: "0",
+		Side:              "1",
+		Quantity:          decimal.NewFromInt(i.quantity),
+		AvgPx:             decimal.NewFromInt(10),
+		TradeDate:         "20240102",
+		LastFragment:      i.lastFragment,
+	}
+	if i.refAllocID != "" {
+		s.RefAllocID = fixutil.Ptr(i.refAllocID)
+	}
+	for _, a := range i.allocs {
+		s.NoAllocs = append(s.NoAllocs, allocationinstruction.NoAllocsStruct{
+			AllocAccount: fixutil.Ptr(a.account),
+			AllocQty:     fixutil.Ptr(decimal.NewFromInt(a.qty)),
+		})
+	}
+	return s
+}
+
+type step func(t *Tracker) (Allocation, error)
+
+func (i instruction) step(t *Tracker) (Allocation, error) {
+	return t.OnInstruction(allocationinstruction.Unmarshal(i.Struct()))
+}
+
+func instructionAck(allocID string, status enum.AllocStatus, rejects map[string]int) step {
+	return func(t *Tracker) (Allocation, error) {
+		ack := allocationinstructionack.Struct{AllocID: allocID, AllocStatus: status, TransactTime: time.Now()}
+		for account, code := range rejects {
+			ack.NoAllocs = append(ack.NoAllocs, allocationinstructionack.NoAllocsStruct{
+				AllocAccount:           fixutil.Ptr(account),
+				IndividualAllocRejCode: fixutil.Ptr(code),
+			})
+		}
+		return t.OnInstructionAck(allocationinstructionack.Unmarshal(ack))
+	}
+}
+
+func report(allocReportID, allocID string, transType enum.AllocTransType, status enum.AllocStatus) step {
+	return func(t *Tracker) (Allocation, error) {
+		return t.OnReport(allocationreport.Unmarshal(allocationreport.Struct{
+			AllocReportID:     allocReportID,
+			AllocID:           fixutil.Ptr(allocID),
+			AllocTransType:    transType,
+			AllocStatus:       status,
+			AllocReportType:   "3",
+			AllocNoOrdersType: "0",
+			Side:              "1",
+			Quantity:          decimal.NewFromInt(100),
+			AvgPx:             decimal.NewFromInt(10),
+			TradeDate:         "20240102",
+		}))
+	}
+}
+
+func TestTracker(t *testing.T) {
+	split := []alloc{{"X", 60}, {"Y", 40}}
+
+	tests := []struct {
+		name     string
+		steps    []step
+		wantErr  error
+		allocID  string
+		allocIDs []string
+		state    State
+		complete bool
+		accounts []string
+		rejects  map[string]int
+	}{
+		{
+			name:    "new",
+			steps:   []step{instruction{"A1", "", TransNew, 100, split, nil}.step},
+			allocID: "A1", allocIDs: []string{"A1"}, state: StatePending, complete: true,
+			accounts: []string{"X", "Y"},
+		},
+		{
+			name:    "quantities do not add up",
+			steps:   []step{instruction{"A1", "", TransNew, 90, split, nil}.step},
+			wantErr: &QuantityError{},
+		},
+		{
+			name: "first fragment",
+			steps: []step{
+				instruction{"A1", "", TransNew, 100, []alloc{{"X", 60}}, fixutil.Ptr(false)}.step},
+			allocID: "A1", allocIDs: []string{"A1"}, state: StatePending,
+			accounts: []string{"X"},
+		},
+		{
+			name: "fragments",
+			steps: []step{
+				instruction{"A1", "", TransNew, 100, []alloc{{"X", 60}}, fixutil.Ptr(false)}.step,
+				instruction{"A1", "", TransNew, 100, []alloc{{"Y", 40}}, fixutil.Ptr(true)}.step},
+			allocID: "A1", allocIDs: []string{"A1"}, state: StatePending, complete: true,
+			accounts: []string{"X", "Y"},
+		},
+		{
+			name: "fragments do not add up",
+			steps: []step{
+				instruction{"A1", "", TransNew, 100, []alloc{{"X", 60}}, fixutil.Ptr(false)}.step,
+				instruction{"A1", "", TransNew, 100, []alloc{{"Y", 30}}, fixutil.Ptr(true)}.step},
+			wantErr: &QuantityError{},
+			allocID: "A1", allocIDs: []string{"A1"}, state: StatePending,
+			accounts: []string{"X"},
+		},
+		{
+			name: "duplicate AllocID",
+			steps: []step{instruction{"A1", "", TransNew, 100, split, nil}.step,
+				instruction{"A1", "", TransNew, 100, split, nil}.step},
+			wantErr: &DuplicateAllocationError{},
+			allocID: "A1", allocIDs: []string{"A1"}, state: StatePending, complete: true,
+			accounts: []string{"X", "Y"},
+		},
+		{
+			name: "replace then cancel",
+			steps: []step{instruction{"A1", "", TransNew, 100, split, nil}.step,
+				instruction{"A2", "A1", TransReplace, 100, []alloc{{"X", 100}}, nil}.step,
+				instruction{"A3", "A2", TransCancel, 100, nil, nil}.step},
+			allocID: "A2", allocIDs: []string{"A1", "A2", "A3"}, state: StateCanceled, complete: true,
+		},
+		{
+			name:    "replace of an unknown allocation",
+			steps:   []step{instruction{"A2", "A1", TransReplace, 100, split, nil}.step},
+			wantErr: &UnknownAllocationError{},
+		},
+		{
+			name: "preliminary then calculated",
+			steps: []step{instruction{"A1", "", TransPreliminary, 100, split, nil}.step,
+				instruction{"A2", "A1", TransCalculated, 100, split, nil}.step},
+			allocID: "A2", allocIDs: []string{"A1", "A2"}, state: StatePending, complete: true,
+			accounts: []string{"X", "Y"},
+		},
+		{
+			name: "accepted",
+			steps: []step{instruction{"A1", "", TransNew, 100, split, nil}.step,
+				instructionAck("A1", AllocAccepted, nil)},
+			allocID: "A1", allocIDs: []string{"A1"}, state: StateAccepted, complete: true,
+			accounts: []string{"X", "Y"},
+		},
+		{
+			name: "account rejected",
+			steps: []step{instruction{"A1", "", TransNew, 100, split, nil}.step,
+				instructionAck("A1", AllocAccountLevelReject, map[string]int{"Y": 2})},
+			allocID: "A1", allocIDs: []string{"A1"}, state: StateAccountRejected, complete: true,
+			accounts: []string{"X", "Y"}, rejects: map[string]int{"Y": 2},
+		},
+		{
+			name: "replace clears the rejects",
+			steps: []step{instruction{"A1", "", TransNew, 100, split, nil}.step,
+				instructionAck("A1", AllocAccountLevelReject, map[string]int{"Y": 2}),
+				instruction{"A2", "A1", TransReplace, 100, []alloc{{"X", 100}}, nil}.step},
+			allocID: "A2", allocIDs: []string{"A1", "A2"}, state: StatePending, complete: true,
+			accounts: []string{"X"},
+		},
+		{
+			name:    "ack of an unknown allocation",
+			steps:   []step{instructionAck("A1", AllocAccepted, nil)},
+			wantErr: &UnknownAllocationError{},
+		},
+		{
+			name: "report",
+			steps: []step{instruction{"A1", "", TransNew, 100, split, nil}.step,
+				report("R1", "A1", TransNew, AllocAccepted)},
+			allocID: "A1", allocIDs: []string{"A1"}, state: StateAccepted, complete: true,
+		},
+		{
+			name: "duplicate report",
+			steps: []step{report("R1", "A1", TransNew, AllocAccepted),
+				report("R1", "A1", TransNew, AllocAccepted)},
+			wantErr: &DuplicateAllocationError{},
+			allocID: "A1", allocIDs: []string{"A1"}, state: StateAccepted, complete: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tr := New()
+			var err error
+			for i, s := range tt.steps {
+				_, err = s(tr)
+				if i < len(tt.steps)-1 && err != nil {
+					t.Fatalf("step %v: %v", i, err)
+				}
+			}
+			if reflect.TypeOf(err) != reflect.TypeOf(tt.wantErr) {
+				t.Fatalf("error = %v, want %T", err, tt.wantErr)
+			}
+
+			a, ok := tr.Allocation("A1")
+			if ok != (tt.allocIDs != nil) {
+				t.Fatalf("Allocation() found = %v, want %v", ok, tt.allocIDs != nil)
+			}
+			if !ok {
+				return
+			}
+			if a.AllocID != tt.allocID {
+				t.Errorf("AllocID = %v, want %v", a.AllocID, tt.allocID)
+			}
+			if !reflect.DeepEqual(a.AllocIDs, tt.allocIDs) {
+				t.Errorf("AllocIDs = %v, want %v", a.AllocIDs, tt.allocIDs)
+			}
+			if a.State != tt.state {
+				t.Errorf("State = %v, want %v", a.State, tt.state)
+			}
+			if a.Complete != tt.complete {
+				t.Errorf("Complete = %v, want %v", a.Complete, tt.complete)
+			}
+			if a.Report == nil && !reflect.DeepEqual(a.Accounts(), tt.accounts) {
+				t.Errorf("Accounts() = %v, want %v", a.Accounts(), tt.accounts)
+			}
+			if (len(a.AccountRejects) > 0 || len(tt.rejects) > 0) && !reflect.DeepEqual(a.AccountRejects, tt.rejects) {
+				t.Errorf("AccountRejects = %v, want %v", a.AccountRejects, tt.rejects)
+			}
+		})
+	}
+}
+
+func TestCheck(t *testing.T) {
+	exec := func(qty, px string) allocationinstruction.NoExecsStruct {
+		return allocationinstruction.NoExecsStruct{
+			LastQty: fixutil.Ptr(decimal.RequireFromString(qty)),
+			LastPx:  fixutil.Ptr(decimal.RequireFromString(px)),
+		}
+	}
+
+	tests := []struct {
+		name      string
+		allocs    []alloc
+		execs     []allocationinstruction.NoExecsStruct
+		avgPx     string
+		precision *int
+		wantErr   error
+	}{
+		{"no allocs or fills", nil, nil, "10", nil, nil},
+		{"allocs add up", []alloc{{"X", 60}, {"Y", 40}}, nil, "10", nil, nil},
+		{"allocs short", []alloc{{"X", 60}, {"Y", 30}}, nil, "10", nil, &QuantityError{}},
+		{"avg px", nil, []allocationinstruction.NoExecsStruct{exec("50", "10"), exec("50", "11")}, "10.5", nil, nil},
+		{"avg px off", nil, []allocationinstruction.NoExecsStruct{exec("50", "10"), exec("50", "11")}, "10.4", nil,
+			&AvgPxError{}},
+		{"avg px at AvgPx places", nil, []allocationinstruction.NoExecsStruct{exec("1", "10"), exec("2", "11")},
+			"10.67", nil, nil},
+		{"avg px at AvgPxPrecision", nil, []allocationinstruction.NoExecsStruct{exec("1", "10"), exec("2", "11")},
+			"10.7", fixutil.Ptr(1), nil},
+		{"avg px at a finer AvgPxPrecision", nil, []allocationinstruction.NoExecsStruct{exec("1", "10"),
+			exec("2", "11")}, "10.7", fixutil.Ptr(3), &AvgPxError{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := instruction{"A1", "", TransNew, 100, tt.allocs, nil}.Struct()
+			s.NoExecs = tt.execs
+			s.AvgPx = decimal.RequireFromString(tt.avgPx)
+			s.AvgPxPrecision = tt.precision
+
+			err := Check(s)
+			if reflect.TypeOf(err) != reflect.TypeOf(tt.wantErr) {
+				t.Errorf("Check() = %v, want %T", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+type confirmStep func(t *Tracker) (Confirmation, error)
+
+func confirm(confirmID, refID string, transType enum.ConfirmTransType, account string) confirmStep {
+	return func(t *Tracker) (Confirmation, error) {
+		s := confirmation.Struct{
+			ConfirmID:        confirmID,
+			ConfirmTransType: transType,
+			ConfirmType:      "2",
+			ConfirmStatus:    "1",
+			AllocID:          fixutil.Ptr("A1"),
+			AllocAccount:     account,
+			AllocQty:         decimal.NewFromInt(50),
+			Side:             "1",
+			AvgPx:            decimal.NewFromInt(10),
+			GrossTradeAmt:    decimal.NewFromInt(500),
+			NetMoney:         decimal.NewFromInt(500),
+			TransactTime:     time.Now(),
+			TradeDate:        "20240102",
+		}
+		if refID != "" {
+			s.ConfirmRefID = fixutil.Ptr(refID)
+		}
+		return t.OnConfirmation(confirmation.Unmarshal(s))
+	}
+}
+
+func affirm(confirmID string, status enum.AffirmStatus) confirmStep {
+	return func(t *Tracker) (Confirmation, error) {
+		return t.OnConfirmationAck(confirmationack.Unmarshal(confirmationack.Struct{
+			ConfirmID:    confirmID,
+			AffirmStatus: status,
+			TradeDate:    "20240102",
+			TransactTime: time.Now(),
+		}))
+	}
+}
+
+func TestConfirmations(t *testing.T) {
+	tests := []struct {
+		name     string
+		steps    []confirmStep
+		wantErr  error
+		affirmed bool
+		confirms []string
+	}{
+		{
+			name:     "unconfirmed",
+			steps:    []confirmStep{confirm("C1", "", ConfirmNew, "X")},
+			confirms: []string{"C1"},
+		},
+		{
+			name: "affirmed",
+			steps: []confirmStep{confirm("C1", "", ConfirmNew, "X"),
+				confirm("C2", "", ConfirmNew, "Y"), affirm("C1", AffirmAffirmed), affirm("C2", AffirmAffirmed)},
+			affirmed: true,
+			confirms: []string{"C1", "C2"},
+		},
+		{
+			name: "one account rejected",
+			steps: []confirmStep{confirm("C1", "", ConfirmNew, "X"),
+				confirm("C2", "", ConfirmNew, "Y"), affirm("C1", AffirmAffirmed), affirm("C2", AffirmConfirmRejected)},
+			confirms: []string{"C1", "C2"},
+		},
+		{
+			name: "replaced after the affirmation",
+			steps: []confirmStep{confirm("C1", "", ConfirmNew, "X"),
+				confirm("C2", "", ConfirmNew, "Y"), affirm("C1", AffirmAffirmed), affirm("C2", AffirmAffirmed),
+				confirm("C3", "C2", ConfirmReplace, "Y")},
+			confirms: []string{"C1", "C3"},
+		},
+		{
+			name: "replacement affirmed",
+			steps: []confirmStep{confirm("C1", "", ConfirmNew, "X"),
+				confirm("C2", "", ConfirmNew, "Y"), confirm("C3", "C2", ConfirmReplace, "Y"),
+				affirm("C1", AffirmAffirmed), affirm("C3", AffirmAffirmed)},
+			affirmed: true,
+			confirms: []string{"C1", "C3"},
+		},
+		{
+			name: "canceled",
+			steps: []confirmStep{confirm("C1", "", ConfirmNew, "X"),
+				confirm("C2", "", ConfirmNew, "Y"), affirm("C1", AffirmAffirmed), affirm("C2", AffirmAffirmed),
+				confirm("C3", "C2", ConfirmCancel, "Y")},
+			confirms: []string{"C1"},
+		},
+		{
+			name: "duplicate ConfirmID",
+			steps: []confirmStep{confirm("C1", "", ConfirmNew, "X"),
+				confirm("C1", "", ConfirmNew, "Y")},
+			wantErr:  &DuplicateConfirmationError{},
+			confirms: []string{"C1"},
+		},
+		{
+			name:     "replace of an unknown confirmation",
+			steps:    []confirmStep{confirm("C2", "C1", ConfirmReplace, "X")},
+			wantErr:  &UnknownConfirmationError{},
+			confirms: []string{},
+		},
+		{
+			name:     "ack of an unknown confirmation",
+			steps:    []confirmStep{affirm("C1", AffirmAffirmed)},
+			wantErr:  &UnknownConfirmationError{},
+			confirms: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tr := New()
+			i := instruction{"A1", "", TransNew, 100, []alloc{{"X", 50}, {"Y", 50}}, nil}
+			if _, err := i.step(tr); err != nil {
+				t.Fatal(err)
+			}
+			var err error
+			for i, s := range tt.steps {
+				_, err = s(tr)
+				if i < len(tt.steps)-1 && err != nil {
+					t.Fatalf("step %v: %v", i, err)
+				}
+			}
+			if reflect.TypeOf(err) != reflect.TypeOf(tt.wantErr) {
+				t.Fatalf("error = %v, want %T", err, tt.wantErr)
+			}
+
+			if got := tr.Affirmed("A1"); got != tt.affirmed {
+				t.Errorf("Affirmed() = %v, want %v", got, tt.affirmed)
+			}
+			a, _ := tr.Allocation("A1")
+			confirms := []string{}
+			for _, id := range a.Confirmations {
+				confirms = append(confirms, id)
+			}
+			sort.Strings(confirms)
+			if !reflect.DeepEqual(confirms, tt.confirms) {
+				t.Errorf("Confirmations = %v, want %v", confirms, tt.confirms)
+			}
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/terracefi/enum"
 	"github.com/terracefi/field"
 	"github.com/terracefi/fix44"
+	"github.com/terracefi/fix44/components"
 	"github.com/terracefi/quickfix"
 	"github.com/terracefi/tag"
 )
@@ -66,6 +67,26 @@ func Route(router RouteOut) (string, string, quickfix.MessageRoute) {
 	return "FIX.4.4", "J", r
 }
 
+//Validate checks m against the FIX 4.4 definition of AllocationInstruction, see fix44.MessageDef.Validate
+func (m AllocationInstruction) Validate() fix44.ValidationErrors {
+	return fix44.MessageDefs["J"].Validate(&m.Body.FieldMap)
+}
+
+//MarshalJSON encodes the AllocationInstruction as JSON with fields keyed by name, see fix44.MarshalMessageJSON
+func (m AllocationInstruction) MarshalJSON() ([]byte, error) {
+	return fix44.MarshalMessageJSON(m.Message)
+}
+
+//UnmarshalJSON builds the AllocationInstruction from JSON produced by MarshalJSON
+func (m *AllocationInstruction) UnmarshalJSON(b []byte) error {
+	msg, err := fix44.UnmarshalMessageJSON(b, "J")
+	if err != nil {
+		return err
+	}
+	*m = FromMessage(msg)
+	return nil
+}
+
 //SetAvgPx sets AvgPx, Tag 6
 func (m AllocationInstruction) SetAvgPx(value decimal.Decimal, scale int32) {
 	m.Set(field.NewAvgPx(value, scale))
@@ -2100,6 +2121,21 @@ func (m AllocationInstruction) HasEncodedIssuer() bool {
 	return m.Has(tag.EncodedIssuer)
 }
 
+//SetEncodedIssuerBytes sets EncodedIssuer, Tag 349, and EncodedIssuerLen, Tag 348, from v, which may hold any bytes including SOH
+func (m AllocationInstruction) SetEncodedIssuerBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedIssuerLen, tag.EncodedIssuer, v)
+}
+
+//GetEncodedIssuerBytes gets EncodedIssuer, Tag 349, checking its length against EncodedIssuerLen, Tag 348
+func (m AllocationInstruction) GetEncodedIssuerBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedIssuerLen, tag.EncodedIssuer)
+}
+
+//GetEncodedIssuerDecoded gets EncodedIssuer, Tag 349, decoded to UTF-8 using the MessageEncoding of the Header
+func (m AllocationInstruction) GetEncodedIssuerDecoded() (string, error) {
+	return fix44.DecodeRawData(m.Header, m, tag.EncodedIssuerLen, tag.EncodedIssuer)
+}
+
 //HasEncodedSecurityDescLen returns true if EncodedSecurityDescLen is present, Tag 350
 func (m AllocationInstruction) HasEncodedSecurityDescLen() bool {
 	return m.Has(tag.EncodedSecurityDescLen)
@@ -2110,6 +2146,21 @@ func (m AllocationInstruction) HasEncodedSecurityDesc() bool {
 	return m.Has(tag.EncodedSecurityDesc)
 }
 
+//SetEncodedSecurityDescBytes sets EncodedSecurityDesc, Tag 351, and EncodedSecurityDescLen, Tag 350, from v, which may hold any bytes including SOH
+func (m AllocationInstruction) SetEncodedSecurityDescBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedSecurityDescLen, tag.EncodedSecurityDesc, v)
+}
+
+//GetEncodedSecurityDescBytes gets EncodedSecurityDesc, Tag 351, checking its length against EncodedSecurityDescLen, Tag 350
+func (m AllocationInstruction) GetEncodedSecurityDescBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedSecurityDescLen, tag.EncodedSecurityDesc)
+}
+
+//GetEncodedSecurityDescDecoded gets EncodedSecurityDesc, Tag 351, decoded to UTF-8 using the MessageEncoding of the Header
+func (m AllocationInstruction) GetEncodedSecurityDescDecoded() (string, error) {
+	return fix44.DecodeRawData(m.Header, m, tag.EncodedSecurityDescLen, tag.EncodedSecurityDesc)
+}
+
 //HasEncodedTextLen returns true if EncodedTextLen is present, Tag 354
 func (m AllocationInstruction) HasEncodedTextLen() bool {
 	return m.Has(tag.EncodedTextLen)
@@ -2120,6 +2171,21 @@ func (m AllocationInstruction) HasEncodedText() bool {
 	return m.Has(tag.EncodedText)
 }
 
+//SetEncodedTextBytes sets EncodedText, Tag 355, and EncodedTextLen, Tag 354, from v, which may hold any bytes including SOH
+func (m AllocationInstruction) SetEncodedTextBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedTextLen, tag.EncodedText, v)
+}
+
+//GetEncodedTextBytes gets EncodedText, Tag 355, checking its length against EncodedTextLen, Tag 354
+func (m AllocationInstruction) GetEncodedTextBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedTextLen, tag.EncodedText)
+}
+
+//GetEncodedTextDecoded gets EncodedText, Tag 355, decoded to UTF-8 using the MessageEncoding of the Header
+func (m AllocationInstruction) GetEncodedTextDecoded() (string, error) {
+	return fix44.DecodeRawData(m.Header, m, tag.EncodedTextLen, tag.EncodedText)
+}
+
 //HasGrossTradeAmt returns true if GrossTradeAmt is present, Tag 381
 func (m AllocationInstruction) HasGrossTradeAmt() bool {
 	return m.Has(tag.GrossTradeAmt)
@@ -2440,6 +2506,46 @@ func (m AllocationInstruction) HasStrikeCurrency() bool {
 	return m.Has(tag.StrikeCurrency)
 }
 
+//GetInstrument gets the Instrument component
+func (m AllocationInstruction) GetInstrument() components.Instrument {
+	return components.Instrument{&m.Body.FieldMap}
+}
+
+//SetInstrument sets the Instrument component, copying the fields present in c
+func (m AllocationInstruction) SetInstrument(c components.Instrument) quickfix.MessageRejectError {
+	return c.CopyInto(&m.Body.FieldMap)
+}
+
+//GetParties gets the Parties component
+func (m AllocationInstruction) GetParties() components.Parties {
+	return components.Parties{&m.Body.FieldMap}
+}
+
+//SetParties sets the Parties component, copying the fields present in c
+func (m AllocationInstruction) SetParties(c components.Parties) quickfix.MessageRejectError {
+	return c.CopyInto(&m.Body.FieldMap)
+}
+
+//GetStipulations gets the Stipulations component
+func (m AllocationInstruction) GetStipulations() components.Stipulations {
+	return components.Stipulations{&m.Body.FieldMap}
+}
+
+//SetStipulations sets the Stipulations component, copying the fields present in c
+func (m AllocationInstruction) SetStipulations(c components.Stipulations) quickfix.MessageRejectError {
+	return c.CopyInto(&m.Body.FieldMap)
+}
+
+//GetSpreadOrBenchmarkCurveData gets the SpreadOrBenchmarkCurveData component
+func (m AllocationInstruction) GetSpreadOrBenchmarkCurveData() components.SpreadOrBenchmarkCurveData {
+	return components.SpreadOrBenchmarkCurveData{&m.Body.FieldMap}
+}
+
+//SetSpreadOrBenchmarkCurveData sets the SpreadOrBenchmarkCurveData component, copying the fields present in c
+func (m AllocationInstruction) SetSpreadOrBenchmarkCurveData(c components.SpreadOrBenchmarkCurveData) quickfix.MessageRejectError {
+	return c.CopyInto(&m.Body.FieldMap)
+}
+
 //NoOrders is a repeating group element, Tag 73
 type NoOrders struct {
 	*quickfix.Group
@@ -3371,6 +3477,21 @@ func (m NoAllocs) HasEncodedAllocText() bool {
 	return m.Has(tag.EncodedAllocText)
 }
 
+//SetEncodedAllocTextBytes sets EncodedAllocText, Tag 361, and EncodedAllocTextLen, Tag 360, from v, which may hold any bytes including SOH
+func (m NoAllocs) SetEncodedAllocTextBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedAllocTextLen, tag.EncodedAllocText, v)
+}
+
+//GetEncodedAllocTextBytes gets EncodedAllocText, Tag 361, checking its length against EncodedAllocTextLen, Tag 360
+func (m NoAllocs) GetEncodedAllocTextBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedAllocTextLen, tag.EncodedAllocText)
+}
+
+//GetEncodedAllocTextDecoded gets EncodedAllocText, Tag 361, decoded to UTF-8 using the MessageEncoding of h, the Header of the message holding it
+func (m NoAllocs) GetEncodedAllocTextDecoded(h fix44.Header) (string, error) {
+	return fix44.DecodeRawData(h, m, tag.EncodedAllocTextLen, tag.EncodedAllocText)
+}
+
 //HasCommission returns true if Commission is present, Tag 12
 func (m NoAllocs) HasCommission() bool {
 	return m.Has(tag.Commission)
@@ -3486,173 +3607,53 @@ func (m NoAllocs) HasNoDlvyInst() bool {
 	return m.Has(tag.NoDlvyInst)
 }
 
-//NoNestedPartyIDs is a repeating group element, Tag 539
-type NoNestedPartyIDs struct {
-	*quickfix.Group
-}
-
-//SetNestedPartyID sets NestedPartyID, Tag 524
-func (m NoNestedPartyIDs) SetNestedPartyID(v string) {
-	m.Set(field.NewNestedPartyID(v))
-}
-
-//SetNestedPartyIDSource sets NestedPartyIDSource, Tag 525
-func (m NoNestedPartyIDs) SetNestedPartyIDSource(v string) {
-	m.Set(field.NewNestedPartyIDSource(v))
-}
-
-//SetNestedPartyRole sets NestedPartyRole, Tag 538
-func (m NoNestedPartyIDs) SetNestedPartyRole(v int) {
-	m.Set(field.NewNestedPartyRole(v))
-}
-
-//SetNoNestedPartySubIDs sets NoNestedPartySubIDs, Tag 804
-func (m NoNestedPartyIDs) SetNoNestedPartySubIDs(f NoNestedPartySubIDsRepeatingGroup) {
-	m.SetGroup(f)
-}
-
-//GetNestedPartyID gets NestedPartyID, Tag 524
-func (m NoNestedPartyIDs) GetNestedPartyID() (v string, err quickfix.MessageRejectError) {
-	var f field.NestedPartyIDField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
+//GetNestedParties gets the NestedParties component
+func (m NoAllocs) GetNestedParties() components.NestedParties {
+	return components.NestedParties{&m.Group.FieldMap}
 }
 
-//GetNestedPartyIDSource gets NestedPartyIDSource, Tag 525
-func (m NoNestedPartyIDs) GetNestedPartyIDSource() (v string, err quickfix.MessageRejectError) {
-	var f field.NestedPartyIDSourceField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
+//SetNestedParties sets the NestedParties component, copying the fields present in c
+func (m NoAllocs) SetNestedParties(c components.NestedParties) quickfix.MessageRejectError {
+	return c.CopyInto(&m.Group.FieldMap)
 }
 
-//GetNestedPartyRole gets NestedPartyRole, Tag 538
-func (m NoNestedPartyIDs) GetNestedPartyRole() (v int, err quickfix.MessageRejectError) {
-	var f field.NestedPartyRoleField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
+//GetCommissionData gets the CommissionData component
+func (m NoAllocs) GetCommissionData() components.CommissionData {
+	return components.CommissionData{&m.Group.FieldMap}
 }
 
-//GetNoNestedPartySubIDs gets NoNestedPartySubIDs, Tag 804
-func (m NoNestedPartyIDs) GetNoNestedPartySubIDs() (NoNestedPartySubIDsRepeatingGroup, quickfix.MessageRejectError) {
-	f := NewNoNestedPartySubIDsRepeatingGroup()
-	err := m.GetGroup(f)
-	return f, err
+//SetCommissionData sets the CommissionData component, copying the fields present in c
+func (m NoAllocs) SetCommissionData(c components.CommissionData) quickfix.MessageRejectError {
+	return c.CopyInto(&m.Group.FieldMap)
 }
 
-//HasNestedPartyID returns true if NestedPartyID is present, Tag 524
-func (m NoNestedPartyIDs) HasNestedPartyID() bool {
-	return m.Has(tag.NestedPartyID)
-}
+//NoNestedPartyIDs is a repeating group element, Tag 539
+type NoNestedPartyIDs = components.NoNestedPartyIDs
 
-//HasNestedPartyIDSource returns true if NestedPartyIDSource is present, Tag 525
-func (m NoNestedPartyIDs) HasNestedPartyIDSource() bool {
-	return m.Has(tag.NestedPartyIDSource)
-}
+//NoNestedPartyIDsRepeatingGroup is a repeating group, Tag 539
+type NoNestedPartyIDsRepeatingGroup = components.NoNestedPartyIDsRepeatingGroup
 
-//HasNestedPartyRole returns true if NestedPartyRole is present, Tag 538
-func (m NoNestedPartyIDs) HasNestedPartyRole() bool {
-	return m.Has(tag.NestedPartyRole)
+//NewNoNestedPartyIDsRepeatingGroup returns an initialized, NoNestedPartyIDsRepeatingGroup
+func NewNoNestedPartyIDsRepeatingGroup() NoNestedPartyIDsRepeatingGroup {
+	return components.NewNoNestedPartyIDsRepeatingGroup()
 }
 
-//HasNoNestedPartySubIDs returns true if NoNestedPartySubIDs is present, Tag 804
-func (m NoNestedPartyIDs) HasNoNestedPartySubIDs() bool {
-	return m.Has(tag.NoNestedPartySubIDs)
-}
+//NoNestedPartyIDsStruct is a plain Go representation of a NoNestedPartyIDs group element
+type NoNestedPartyIDsStruct = components.NoNestedPartyIDsStruct
 
 //NoNestedPartySubIDs is a repeating group element, Tag 804
-type NoNestedPartySubIDs struct {
-	*quickfix.Group
-}
-
-//SetNestedPartySubID sets NestedPartySubID, Tag 545
-func (m NoNestedPartySubIDs) SetNestedPartySubID(v string) {
-	m.Set(field.NewNestedPartySubID(v))
-}
-
-//SetNestedPartySubIDType sets NestedPartySubIDType, Tag 805
-func (m NoNestedPartySubIDs) SetNestedPartySubIDType(v int) {
-	m.Set(field.NewNestedPartySubIDType(v))
-}
-
-//GetNestedPartySubID gets NestedPartySubID, Tag 545
-func (m NoNestedPartySubIDs) GetNestedPartySubID() (v string, err quickfix.MessageRejectError) {
-	var f field.NestedPartySubIDField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetNestedPartySubIDType gets NestedPartySubIDType, Tag 805
-func (m NoNestedPartySubIDs) GetNestedPartySubIDType() (v int, err quickfix.MessageRejectError) {
-	var f field.NestedPartySubIDTypeField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//HasNestedPartySubID returns true if NestedPartySubID is present, Tag 545
-func (m NoNestedPartySubIDs) HasNestedPartySubID() bool {
-	return m.Has(tag.NestedPartySubID)
-}
-
-//HasNestedPartySubIDType returns true if NestedPartySubIDType is present, Tag 805
-func (m NoNestedPartySubIDs) HasNestedPartySubIDType() bool {
-	return m.Has(tag.NestedPartySubIDType)
-}
+type NoNestedPartySubIDs = components.NoNestedPartySubIDs
 
 //NoNestedPartySubIDsRepeatingGroup is a repeating group, Tag 804
-type NoNestedPartySubIDsRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
+type NoNestedPartySubIDsRepeatingGroup = components.NoNestedPartySubIDsRepeatingGroup
 
 //NewNoNestedPartySubIDsRepeatingGroup returns an initialized, NoNestedPartySubIDsRepeatingGroup
 func NewNoNestedPartySubIDsRepeatingGroup() NoNestedPartySubIDsRepeatingGroup {
-	return NoNestedPartySubIDsRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoNestedPartySubIDs,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.NestedPartySubID), quickfix.GroupElement(tag.NestedPartySubIDType)})}
-}
-
-//Add create and append a new NoNestedPartySubIDs to this group
-func (m NoNestedPartySubIDsRepeatingGroup) Add() NoNestedPartySubIDs {
-	g := m.RepeatingGroup.Add()
-	return NoNestedPartySubIDs{g}
-}
-
-//Get returns the ith NoNestedPartySubIDs in the NoNestedPartySubIDsRepeatinGroup
-func (m NoNestedPartySubIDsRepeatingGroup) Get(i int) NoNestedPartySubIDs {
-	return NoNestedPartySubIDs{m.RepeatingGroup.Get(i)}
-}
-
-//NoNestedPartyIDsRepeatingGroup is a repeating group, Tag 539
-type NoNestedPartyIDsRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
-
-//NewNoNestedPartyIDsRepeatingGroup returns an initialized, NoNestedPartyIDsRepeatingGroup
-func NewNoNestedPartyIDsRepeatingGroup() NoNestedPartyIDsRepeatingGroup {
-	return NoNestedPartyIDsRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoNestedPartyIDs,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.NestedPartyID), quickfix.GroupElement(tag.NestedPartyIDSource), quickfix.GroupElement(tag.NestedPartyRole), NewNoNestedPartySubIDsRepeatingGroup()})}
-}
-
-//Add create and append a new NoNestedPartyIDs to this group
-func (m NoNestedPartyIDsRepeatingGroup) Add() NoNestedPartyIDs {
-	g := m.RepeatingGroup.Add()
-	return NoNestedPartyIDs{g}
+	return components.NewNoNestedPartySubIDsRepeatingGroup()
 }
 
-//Get returns the ith NoNestedPartyIDs in the NoNestedPartyIDsRepeatinGroup
-func (m NoNestedPartyIDsRepeatingGroup) Get(i int) NoNestedPartyIDs {
-	return NoNestedPartyIDs{m.RepeatingGroup.Get(i)}
-}
+//NoNestedPartySubIDsStruct is a plain Go representation of a NoNestedPartySubIDs group element
+type NoNestedPartySubIDsStruct = components.NoNestedPartySubIDsStruct
 
 //NoMiscFees is a repeating group element, Tag 136
 type NoMiscFees struct {
@@ -4222,403 +4223,159 @@ func (m NoExecsRepeatingGroup) Get(i int) NoExecs {
 }
 
 //NoStipulations is a repeating group element, Tag 232
-type NoStipulations struct {
-	*quickfix.Group
-}
+type NoStipulations = components.NoStipulations
 
-//SetStipulationType sets StipulationType, Tag 233
-func (m NoStipulations) SetStipulationType(v enum.StipulationType) {
-	m.Set(field.NewStipulationType(v))
-}
+//NoStipulationsRepeatingGroup is a repeating group, Tag 232
+type NoStipulationsRepeatingGroup = components.NoStipulationsRepeatingGroup
 
-//SetStipulationValue sets StipulationValue, Tag 234
-func (m NoStipulations) SetStipulationValue(v string) {
-	m.Set(field.NewStipulationValue(v))
+//NewNoStipulationsRepeatingGroup returns an initialized, NoStipulationsRepeatingGroup
+func NewNoStipulationsRepeatingGroup() NoStipulationsRepeatingGroup {
+	return components.NewNoStipulationsRepeatingGroup()
 }
 
-//GetStipulationType gets StipulationType, Tag 233
-func (m NoStipulations) GetStipulationType() (v enum.StipulationType, err quickfix.MessageRejectError) {
-	var f field.StipulationTypeField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
+//NoStipulationsStruct is a plain Go representation of a NoStipulations group element
+type NoStipulationsStruct = components.NoStipulationsStruct
 
-//GetStipulationValue gets StipulationValue, Tag 234
-func (m NoStipulations) GetStipulationValue() (v string, err quickfix.MessageRejectError) {
-	var f field.StipulationValueField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
+//NoPartyIDs is a repeating group element, Tag 453
+type NoPartyIDs = components.NoPartyIDs
 
-//HasStipulationType returns true if StipulationType is present, Tag 233
-func (m NoStipulations) HasStipulationType() bool {
-	return m.Has(tag.StipulationType)
-}
+//NoPartyIDsRepeatingGroup is a repeating group, Tag 453
+type NoPartyIDsRepeatingGroup = components.NoPartyIDsRepeatingGroup
 
-//HasStipulationValue returns true if StipulationValue is present, Tag 234
-func (m NoStipulations) HasStipulationValue() bool {
-	return m.Has(tag.StipulationValue)
+//NewNoPartyIDsRepeatingGroup returns an initialized, NoPartyIDsRepeatingGroup
+func NewNoPartyIDsRepeatingGroup() NoPartyIDsRepeatingGroup {
+	return components.NewNoPartyIDsRepeatingGroup()
 }
 
-//NoStipulationsRepeatingGroup is a repeating group, Tag 232
-type NoStipulationsRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
+//NoPartyIDsStruct is a plain Go representation of a NoPartyIDs group element
+type NoPartyIDsStruct = components.NoPartyIDsStruct
 
-//NewNoStipulationsRepeatingGroup returns an initialized, NoStipulationsRepeatingGroup
-func NewNoStipulationsRepeatingGroup() NoStipulationsRepeatingGroup {
-	return NoStipulationsRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoStipulations,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.StipulationType), quickfix.GroupElement(tag.StipulationValue)})}
-}
+//NoPartySubIDs is a repeating group element, Tag 802
+type NoPartySubIDs = components.NoPartySubIDs
 
-//Add create and append a new NoStipulations to this group
-func (m NoStipulationsRepeatingGroup) Add() NoStipulations {
-	g := m.RepeatingGroup.Add()
-	return NoStipulations{g}
-}
+//NoPartySubIDsRepeatingGroup is a repeating group, Tag 802
+type NoPartySubIDsRepeatingGroup = components.NoPartySubIDsRepeatingGroup
 
-//Get returns the ith NoStipulations in the NoStipulationsRepeatinGroup
-func (m NoStipulationsRepeatingGroup) Get(i int) NoStipulations {
-	return NoStipulations{m.RepeatingGroup.Get(i)}
+//NewNoPartySubIDsRepeatingGroup returns an initialized, NoPartySubIDsRepeatingGroup
+func NewNoPartySubIDsRepeatingGroup() NoPartySubIDsRepeatingGroup {
+	return components.NewNoPartySubIDsRepeatingGroup()
 }
 
-//NoPartyIDs is a repeating group element, Tag 453
-type NoPartyIDs struct {
-	*quickfix.Group
-}
+//NoPartySubIDsStruct is a plain Go representation of a NoPartySubIDs group element
+type NoPartySubIDsStruct = components.NoPartySubIDsStruct
 
-//SetPartyID sets PartyID, Tag 448
-func (m NoPartyIDs) SetPartyID(v string) {
-	m.Set(field.NewPartyID(v))
-}
+//NoSecurityAltID is a repeating group element, Tag 454
+type NoSecurityAltID = components.NoSecurityAltID
 
-//SetPartyIDSource sets PartyIDSource, Tag 447
-func (m NoPartyIDs) SetPartyIDSource(v enum.PartyIDSource) {
-	m.Set(field.NewPartyIDSource(v))
-}
+//NoSecurityAltIDRepeatingGroup is a repeating group, Tag 454
+type NoSecurityAltIDRepeatingGroup = components.NoSecurityAltIDRepeatingGroup
 
-//SetPartyRole sets PartyRole, Tag 452
-func (m NoPartyIDs) SetPartyRole(v enum.PartyRole) {
-	m.Set(field.NewPartyRole(v))
+//NewNoSecurityAltIDRepeatingGroup returns an initialized, NoSecurityAltIDRepeatingGroup
+func NewNoSecurityAltIDRepeatingGroup() NoSecurityAltIDRepeatingGroup {
+	return components.NewNoSecurityAltIDRepeatingGroup()
 }
 
-//SetNoPartySubIDs sets NoPartySubIDs, Tag 802
-func (m NoPartyIDs) SetNoPartySubIDs(f NoPartySubIDsRepeatingGroup) {
-	m.SetGroup(f)
+//NoSecurityAltIDStruct is a plain Go representation of a NoSecurityAltID group element
+type NoSecurityAltIDStruct = components.NoSecurityAltIDStruct
+
+//NoLegs is a repeating group element, Tag 555
+type NoLegs struct {
+	*quickfix.Group
 }
 
-//GetPartyID gets PartyID, Tag 448
-func (m NoPartyIDs) GetPartyID() (v string, err quickfix.MessageRejectError) {
-	var f field.PartyIDField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
+//SetLegSymbol sets LegSymbol, Tag 600
+func (m NoLegs) SetLegSymbol(v string) {
+	m.Set(field.NewLegSymbol(v))
 }
 
-//GetPartyIDSource gets PartyIDSource, Tag 447
-func (m NoPartyIDs) GetPartyIDSource() (v enum.PartyIDSource, err quickfix.MessageRejectError) {
-	var f field.PartyIDSourceField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
+//SetLegSymbolSfx sets LegSymbolSfx, Tag 601
+func (m NoLegs) SetLegSymbolSfx(v string) {
+	m.Set(field.NewLegSymbolSfx(v))
 }
 
-//GetPartyRole gets PartyRole, Tag 452
-func (m NoPartyIDs) GetPartyRole() (v enum.PartyRole, err quickfix.MessageRejectError) {
-	var f field.PartyRoleField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
+//SetLegSecurityID sets LegSecurityID, Tag 602
+func (m NoLegs) SetLegSecurityID(v string) {
+	m.Set(field.NewLegSecurityID(v))
 }
 
-//GetNoPartySubIDs gets NoPartySubIDs, Tag 802
-func (m NoPartyIDs) GetNoPartySubIDs() (NoPartySubIDsRepeatingGroup, quickfix.MessageRejectError) {
-	f := NewNoPartySubIDsRepeatingGroup()
-	err := m.GetGroup(f)
-	return f, err
+//SetLegSecurityIDSource sets LegSecurityIDSource, Tag 603
+func (m NoLegs) SetLegSecurityIDSource(v string) {
+	m.Set(field.NewLegSecurityIDSource(v))
 }
 
-//HasPartyID returns true if PartyID is present, Tag 448
-func (m NoPartyIDs) HasPartyID() bool {
-	return m.Has(tag.PartyID)
+//SetNoLegSecurityAltID sets NoLegSecurityAltID, Tag 604
+func (m NoLegs) SetNoLegSecurityAltID(f NoLegSecurityAltIDRepeatingGroup) {
+	m.SetGroup(f)
 }
 
-//HasPartyIDSource returns true if PartyIDSource is present, Tag 447
-func (m NoPartyIDs) HasPartyIDSource() bool {
-	return m.Has(tag.PartyIDSource)
+//SetLegProduct sets LegProduct, Tag 607
+func (m NoLegs) SetLegProduct(v int) {
+	m.Set(field.NewLegProduct(v))
 }
 
-//HasPartyRole returns true if PartyRole is present, Tag 452
-func (m NoPartyIDs) HasPartyRole() bool {
-	return m.Has(tag.PartyRole)
+//SetLegCFICode sets LegCFICode, Tag 608
+func (m NoLegs) SetLegCFICode(v string) {
+	m.Set(field.NewLegCFICode(v))
 }
 
-//HasNoPartySubIDs returns true if NoPartySubIDs is present, Tag 802
-func (m NoPartyIDs) HasNoPartySubIDs() bool {
-	return m.Has(tag.NoPartySubIDs)
+//SetLegSecurityType sets LegSecurityType, Tag 609
+func (m NoLegs) SetLegSecurityType(v string) {
+	m.Set(field.NewLegSecurityType(v))
 }
 
-//NoPartySubIDs is a repeating group element, Tag 802
-type NoPartySubIDs struct {
-	*quickfix.Group
+//SetLegSecuritySubType sets LegSecuritySubType, Tag 764
+func (m NoLegs) SetLegSecuritySubType(v string) {
+	m.Set(field.NewLegSecuritySubType(v))
 }
 
-//SetPartySubID sets PartySubID, Tag 523
-func (m NoPartySubIDs) SetPartySubID(v string) {
-	m.Set(field.NewPartySubID(v))
+//SetLegMaturityMonthYear sets LegMaturityMonthYear, Tag 610
+func (m NoLegs) SetLegMaturityMonthYear(v string) {
+	m.Set(field.NewLegMaturityMonthYear(v))
 }
 
-//SetPartySubIDType sets PartySubIDType, Tag 803
-func (m NoPartySubIDs) SetPartySubIDType(v enum.PartySubIDType) {
-	m.Set(field.NewPartySubIDType(v))
+//SetLegMaturityDate sets LegMaturityDate, Tag 611
+func (m NoLegs) SetLegMaturityDate(v string) {
+	m.Set(field.NewLegMaturityDate(v))
 }
 
-//GetPartySubID gets PartySubID, Tag 523
-func (m NoPartySubIDs) GetPartySubID() (v string, err quickfix.MessageRejectError) {
-	var f field.PartySubIDField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
+//SetLegCouponPaymentDate sets LegCouponPaymentDate, Tag 248
+func (m NoLegs) SetLegCouponPaymentDate(v string) {
+	m.Set(field.NewLegCouponPaymentDate(v))
 }
 
-//GetPartySubIDType gets PartySubIDType, Tag 803
-func (m NoPartySubIDs) GetPartySubIDType() (v enum.PartySubIDType, err quickfix.MessageRejectError) {
-	var f field.PartySubIDTypeField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
+//SetLegIssueDate sets LegIssueDate, Tag 249
+func (m NoLegs) SetLegIssueDate(v string) {
+	m.Set(field.NewLegIssueDate(v))
 }
 
-//HasPartySubID returns true if PartySubID is present, Tag 523
-func (m NoPartySubIDs) HasPartySubID() bool {
-	return m.Has(tag.PartySubID)
+//SetLegRepoCollateralSecurityType sets LegRepoCollateralSecurityType, Tag 250
+func (m NoLegs) SetLegRepoCollateralSecurityType(v int) {
+	m.Set(field.NewLegRepoCollateralSecurityType(v))
 }
 
-//HasPartySubIDType returns true if PartySubIDType is present, Tag 803
-func (m NoPartySubIDs) HasPartySubIDType() bool {
-	return m.Has(tag.PartySubIDType)
+//SetLegRepurchaseTerm sets LegRepurchaseTerm, Tag 251
+func (m NoLegs) SetLegRepurchaseTerm(v int) {
+	m.Set(field.NewLegRepurchaseTerm(v))
 }
 
-//NoPartySubIDsRepeatingGroup is a repeating group, Tag 802
-type NoPartySubIDsRepeatingGroup struct {
-	*quickfix.RepeatingGroup
+//SetLegRepurchaseRate sets LegRepurchaseRate, Tag 252
+func (m NoLegs) SetLegRepurchaseRate(value decimal.Decimal, scale int32) {
+	m.Set(field.NewLegRepurchaseRate(value, scale))
 }
 
-//NewNoPartySubIDsRepeatingGroup returns an initialized, NoPartySubIDsRepeatingGroup
-func NewNoPartySubIDsRepeatingGroup() NoPartySubIDsRepeatingGroup {
-	return NoPartySubIDsRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoPartySubIDs,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.PartySubID), quickfix.GroupElement(tag.PartySubIDType)})}
+//SetLegFactor sets LegFactor, Tag 253
+func (m NoLegs) SetLegFactor(value decimal.Decimal, scale int32) {
+	m.Set(field.NewLegFactor(value, scale))
 }
 
-//Add create and append a new NoPartySubIDs to this group
-func (m NoPartySubIDsRepeatingGroup) Add() NoPartySubIDs {
-	g := m.RepeatingGroup.Add()
-	return NoPartySubIDs{g}
+//SetLegCreditRating sets LegCreditRating, Tag 257
+func (m NoLegs) SetLegCreditRating(v string) {
+	m.Set(field.NewLegCreditRating(v))
 }
 
-//Get returns the ith NoPartySubIDs in the NoPartySubIDsRepeatinGroup
-func (m NoPartySubIDsRepeatingGroup) Get(i int) NoPartySubIDs {
-	return NoPartySubIDs{m.RepeatingGroup.Get(i)}
-}
-
-//NoPartyIDsRepeatingGroup is a repeating group, Tag 453
-type NoPartyIDsRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
-
-//NewNoPartyIDsRepeatingGroup returns an initialized, NoPartyIDsRepeatingGroup
-func NewNoPartyIDsRepeatingGroup() NoPartyIDsRepeatingGroup {
-	return NoPartyIDsRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoPartyIDs,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.PartyID), quickfix.GroupElement(tag.PartyIDSource), quickfix.GroupElement(tag.PartyRole), NewNoPartySubIDsRepeatingGroup()})}
-}
-
-//Add create and append a new NoPartyIDs to this group
-func (m NoPartyIDsRepeatingGroup) Add() NoPartyIDs {
-	g := m.RepeatingGroup.Add()
-	return NoPartyIDs{g}
-}
-
-//Get returns the ith NoPartyIDs in the NoPartyIDsRepeatinGroup
-func (m NoPartyIDsRepeatingGroup) Get(i int) NoPartyIDs {
-	return NoPartyIDs{m.RepeatingGroup.Get(i)}
-}
-
-//NoSecurityAltID is a repeating group element, Tag 454
-type NoSecurityAltID struct {
-	*quickfix.Group
-}
-
-//SetSecurityAltID sets SecurityAltID, Tag 455
-func (m NoSecurityAltID) SetSecurityAltID(v string) {
-	m.Set(field.NewSecurityAltID(v))
-}
-
-//SetSecurityAltIDSource sets SecurityAltIDSource, Tag 456
-func (m NoSecurityAltID) SetSecurityAltIDSource(v string) {
-	m.Set(field.NewSecurityAltIDSource(v))
-}
-
-//GetSecurityAltID gets SecurityAltID, Tag 455
-func (m NoSecurityAltID) GetSecurityAltID() (v string, err quickfix.MessageRejectError) {
-	var f field.SecurityAltIDField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetSecurityAltIDSource gets SecurityAltIDSource, Tag 456
-func (m NoSecurityAltID) GetSecurityAltIDSource() (v string, err quickfix.MessageRejectError) {
-	var f field.SecurityAltIDSourceField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//HasSecurityAltID returns true if SecurityAltID is present, Tag 455
-func (m NoSecurityAltID) HasSecurityAltID() bool {
-	return m.Has(tag.SecurityAltID)
-}
-
-//HasSecurityAltIDSource returns true if SecurityAltIDSource is present, Tag 456
-func (m NoSecurityAltID) HasSecurityAltIDSource() bool {
-	return m.Has(tag.SecurityAltIDSource)
-}
-
-//NoSecurityAltIDRepeatingGroup is a repeating group, Tag 454
-type NoSecurityAltIDRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
-
-//NewNoSecurityAltIDRepeatingGroup returns an initialized, NoSecurityAltIDRepeatingGroup
-func NewNoSecurityAltIDRepeatingGroup() NoSecurityAltIDRepeatingGroup {
-	return NoSecurityAltIDRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoSecurityAltID,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.SecurityAltID), quickfix.GroupElement(tag.SecurityAltIDSource)})}
-}
-
-//Add create and append a new NoSecurityAltID to this group
-func (m NoSecurityAltIDRepeatingGroup) Add() NoSecurityAltID {
-	g := m.RepeatingGroup.Add()
-	return NoSecurityAltID{g}
-}
-
-//Get returns the ith NoSecurityAltID in the NoSecurityAltIDRepeatinGroup
-func (m NoSecurityAltIDRepeatingGroup) Get(i int) NoSecurityAltID {
-	return NoSecurityAltID{m.RepeatingGroup.Get(i)}
-}
-
-//NoLegs is a repeating group element, Tag 555
-type NoLegs struct {
-	*quickfix.Group
-}
-
-//SetLegSymbol sets LegSymbol, Tag 600
-func (m NoLegs) SetLegSymbol(v string) {
-	m.Set(field.NewLegSymbol(v))
-}
-
-//SetLegSymbolSfx sets LegSymbolSfx, Tag 601
-func (m NoLegs) SetLegSymbolSfx(v string) {
-	m.Set(field.NewLegSymbolSfx(v))
-}
-
-//SetLegSecurityID sets LegSecurityID, Tag 602
-func (m NoLegs) SetLegSecurityID(v string) {
-	m.Set(field.NewLegSecurityID(v))
-}
-
-//SetLegSecurityIDSource sets LegSecurityIDSource, Tag 603
-func (m NoLegs) SetLegSecurityIDSource(v string) {
-	m.Set(field.NewLegSecurityIDSource(v))
-}
-
-//SetNoLegSecurityAltID sets NoLegSecurityAltID, Tag 604
-func (m NoLegs) SetNoLegSecurityAltID(f NoLegSecurityAltIDRepeatingGroup) {
-	m.SetGroup(f)
-}
-
-//SetLegProduct sets LegProduct, Tag 607
-func (m NoLegs) SetLegProduct(v int) {
-	m.Set(field.NewLegProduct(v))
-}
-
-//SetLegCFICode sets LegCFICode, Tag 608
-func (m NoLegs) SetLegCFICode(v string) {
-	m.Set(field.NewLegCFICode(v))
-}
-
-//SetLegSecurityType sets LegSecurityType, Tag 609
-func (m NoLegs) SetLegSecurityType(v string) {
-	m.Set(field.NewLegSecurityType(v))
-}
-
-//SetLegSecuritySubType sets LegSecuritySubType, Tag 764
-func (m NoLegs) SetLegSecuritySubType(v string) {
-	m.Set(field.NewLegSecuritySubType(v))
-}
-
-//SetLegMaturityMonthYear sets LegMaturityMonthYear, Tag 610
-func (m NoLegs) SetLegMaturityMonthYear(v string) {
-	m.Set(field.NewLegMaturityMonthYear(v))
-}
-
-//SetLegMaturityDate sets LegMaturityDate, Tag 611
-func (m NoLegs) SetLegMaturityDate(v string) {
-	m.Set(field.NewLegMaturityDate(v))
-}
-
-//SetLegCouponPaymentDate sets LegCouponPaymentDate, Tag 248
-func (m NoLegs) SetLegCouponPaymentDate(v string) {
-	m.Set(field.NewLegCouponPaymentDate(v))
-}
-
-//SetLegIssueDate sets LegIssueDate, Tag 249
-func (m NoLegs) SetLegIssueDate(v string) {
-	m.Set(field.NewLegIssueDate(v))
-}
-
-//SetLegRepoCollateralSecurityType sets LegRepoCollateralSecurityType, Tag 250
-func (m NoLegs) SetLegRepoCollateralSecurityType(v int) {
-	m.Set(field.NewLegRepoCollateralSecurityType(v))
-}
-
-//SetLegRepurchaseTerm sets LegRepurchaseTerm, Tag 251
-func (m NoLegs) SetLegRepurchaseTerm(v int) {
-	m.Set(field.NewLegRepurchaseTerm(v))
-}
-
-//SetLegRepurchaseRate sets LegRepurchaseRate, Tag 252
-func (m NoLegs) SetLegRepurchaseRate(value decimal.Decimal, scale int32) {
-	m.Set(field.NewLegRepurchaseRate(value, scale))
-}
-
-//SetLegFactor sets LegFactor, Tag 253
-func (m NoLegs) SetLegFactor(value decimal.Decimal, scale int32) {
-	m.Set(field.NewLegFactor(value, scale))
-}
-
-//SetLegCreditRating sets LegCreditRating, Tag 257
-func (m NoLegs) SetLegCreditRating(v string) {
-	m.Set(field.NewLegCreditRating(v))
-}
-
-//SetLegInstrRegistry sets LegInstrRegistry, Tag 599
-func (m NoLegs) SetLegInstrRegistry(v string) {
-	m.Set(field.NewLegInstrRegistry(v))
+//SetLegInstrRegistry sets LegInstrRegistry, Tag 599
+func (m NoLegs) SetLegInstrRegistry(v string) {
+	m.Set(field.NewLegInstrRegistry(v))
 }
 
 //SetLegCountryOfIssue sets LegCountryOfIssue, Tag 596
@@ -5272,6 +5029,21 @@ func (m NoLegs) HasEncodedLegIssuer() bool {
 	return m.Has(tag.EncodedLegIssuer)
 }
 
+//SetEncodedLegIssuerBytes sets EncodedLegIssuer, Tag 619, and EncodedLegIssuerLen, Tag 618, from v, which may hold any bytes including SOH
+func (m NoLegs) SetEncodedLegIssuerBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedLegIssuerLen, tag.EncodedLegIssuer, v)
+}
+
+//GetEncodedLegIssuerBytes gets EncodedLegIssuer, Tag 619, checking its length against EncodedLegIssuerLen, Tag 618
+func (m NoLegs) GetEncodedLegIssuerBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedLegIssuerLen, tag.EncodedLegIssuer)
+}
+
+//GetEncodedLegIssuerDecoded gets EncodedLegIssuer, Tag 619, decoded to UTF-8 using the MessageEncoding of h, the Header of the message holding it
+func (m NoLegs) GetEncodedLegIssuerDecoded(h fix44.Header) (string, error) {
+	return fix44.DecodeRawData(h, m, tag.EncodedLegIssuerLen, tag.EncodedLegIssuer)
+}
+
 //HasLegSecurityDesc returns true if LegSecurityDesc is present, Tag 620
 func (m NoLegs) HasLegSecurityDesc() bool {
 	return m.Has(tag.LegSecurityDesc)
@@ -5287,6 +5059,21 @@ func (m NoLegs) HasEncodedLegSecurityDesc() bool {
 	return m.Has(tag.EncodedLegSecurityDesc)
 }
 
+//SetEncodedLegSecurityDescBytes sets EncodedLegSecurityDesc, Tag 622, and EncodedLegSecurityDescLen, Tag 621, from v, which may hold any bytes including SOH
+func (m NoLegs) SetEncodedLegSecurityDescBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedLegSecurityDescLen, tag.EncodedLegSecurityDesc, v)
+}
+
+//GetEncodedLegSecurityDescBytes gets EncodedLegSecurityDesc, Tag 622, checking its length against EncodedLegSecurityDescLen, Tag 621
+func (m NoLegs) GetEncodedLegSecurityDescBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedLegSecurityDescLen, tag.EncodedLegSecurityDesc)
+}
+
+//GetEncodedLegSecurityDescDecoded gets EncodedLegSecurityDesc, Tag 622, decoded to UTF-8 using the MessageEncoding of h, the Header of the message holding it
+func (m NoLegs) GetEncodedLegSecurityDescDecoded(h fix44.Header) (string, error) {
+	return fix44.DecodeRawData(h, m, tag.EncodedLegSecurityDescLen, tag.EncodedLegSecurityDesc)
+}
+
 //HasLegRatioQty returns true if LegRatioQty is present, Tag 623
 func (m NoLegs) HasLegRatioQty() bool {
 	return m.Has(tag.LegRatioQty)
@@ -5322,71 +5109,29 @@ func (m NoLegs) HasLegInterestAccrualDate() bool {
 	return m.Has(tag.LegInterestAccrualDate)
 }
 
-//NoLegSecurityAltID is a repeating group element, Tag 604
-type NoLegSecurityAltID struct {
-	*quickfix.Group
-}
-
-//SetLegSecurityAltID sets LegSecurityAltID, Tag 605
-func (m NoLegSecurityAltID) SetLegSecurityAltID(v string) {
-	m.Set(field.NewLegSecurityAltID(v))
-}
-
-//SetLegSecurityAltIDSource sets LegSecurityAltIDSource, Tag 606
-func (m NoLegSecurityAltID) SetLegSecurityAltIDSource(v string) {
-	m.Set(field.NewLegSecurityAltIDSource(v))
+//GetInstrumentLeg gets the InstrumentLeg component
+func (m NoLegs) GetInstrumentLeg() components.InstrumentLeg {
+	return components.InstrumentLeg{&m.Group.FieldMap}
 }
 
-//GetLegSecurityAltID gets LegSecurityAltID, Tag 605
-func (m NoLegSecurityAltID) GetLegSecurityAltID() (v string, err quickfix.MessageRejectError) {
-	var f field.LegSecurityAltIDField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
+//SetInstrumentLeg sets the InstrumentLeg component, copying the fields present in c
+func (m NoLegs) SetInstrumentLeg(c components.InstrumentLeg) quickfix.MessageRejectError {
+	return c.CopyInto(&m.Group.FieldMap)
 }
 
-//GetLegSecurityAltIDSource gets LegSecurityAltIDSource, Tag 606
-func (m NoLegSecurityAltID) GetLegSecurityAltIDSource() (v string, err quickfix.MessageRejectError) {
-	var f field.LegSecurityAltIDSourceField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//HasLegSecurityAltID returns true if LegSecurityAltID is present, Tag 605
-func (m NoLegSecurityAltID) HasLegSecurityAltID() bool {
-	return m.Has(tag.LegSecurityAltID)
-}
-
-//HasLegSecurityAltIDSource returns true if LegSecurityAltIDSource is present, Tag 606
-func (m NoLegSecurityAltID) HasLegSecurityAltIDSource() bool {
-	return m.Has(tag.LegSecurityAltIDSource)
-}
+//NoLegSecurityAltID is a repeating group element, Tag 604
+type NoLegSecurityAltID = components.NoLegSecurityAltID
 
 //NoLegSecurityAltIDRepeatingGroup is a repeating group, Tag 604
-type NoLegSecurityAltIDRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
+type NoLegSecurityAltIDRepeatingGroup = components.NoLegSecurityAltIDRepeatingGroup
 
 //NewNoLegSecurityAltIDRepeatingGroup returns an initialized, NoLegSecurityAltIDRepeatingGroup
 func NewNoLegSecurityAltIDRepeatingGroup() NoLegSecurityAltIDRepeatingGroup {
-	return NoLegSecurityAltIDRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoLegSecurityAltID,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.LegSecurityAltID), quickfix.GroupElement(tag.LegSecurityAltIDSource)})}
+	return components.NewNoLegSecurityAltIDRepeatingGroup()
 }
 
-//Add create and append a new NoLegSecurityAltID to this group
-func (m NoLegSecurityAltIDRepeatingGroup) Add() NoLegSecurityAltID {
-	g := m.RepeatingGroup.Add()
-	return NoLegSecurityAltID{g}
-}
-
-//Get returns the ith NoLegSecurityAltID in the NoLegSecurityAltIDRepeatinGroup
-func (m NoLegSecurityAltIDRepeatingGroup) Get(i int) NoLegSecurityAltID {
-	return NoLegSecurityAltID{m.RepeatingGroup.Get(i)}
-}
+//NoLegSecurityAltIDStruct is a plain Go representation of a NoLegSecurityAltID group element
+type NoLegSecurityAltIDStruct = components.NoLegSecurityAltIDStruct
 
 //NoLegsRepeatingGroup is a repeating group, Tag 555
 type NoLegsRepeatingGroup struct {
@@ -6216,6 +5961,21 @@ func (m NoUnderlyings) HasEncodedUnderlyingIssuer() bool {
 	return m.Has(tag.EncodedUnderlyingIssuer)
 }
 
+//SetEncodedUnderlyingIssuerBytes sets EncodedUnderlyingIssuer, Tag 363, and EncodedUnderlyingIssuerLen, Tag 362, from v, which may hold any bytes including SOH
+func (m NoUnderlyings) SetEncodedUnderlyingIssuerBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedUnderlyingIssuerLen, tag.EncodedUnderlyingIssuer, v)
+}
+
+//GetEncodedUnderlyingIssuerBytes gets EncodedUnderlyingIssuer, Tag 363, checking its length against EncodedUnderlyingIssuerLen, Tag 362
+func (m NoUnderlyings) GetEncodedUnderlyingIssuerBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedUnderlyingIssuerLen, tag.EncodedUnderlyingIssuer)
+}
+
+//GetEncodedUnderlyingIssuerDecoded gets EncodedUnderlyingIssuer, Tag 363, decoded to UTF-8 using the MessageEncoding of h, the Header of the message holding it
+func (m NoUnderlyings) GetEncodedUnderlyingIssuerDecoded(h fix44.Header) (string, error) {
+	return fix44.DecodeRawData(h, m, tag.EncodedUnderlyingIssuerLen, tag.EncodedUnderlyingIssuer)
+}
+
 //HasUnderlyingSecurityDesc returns true if UnderlyingSecurityDesc is present, Tag 307
 func (m NoUnderlyings) HasUnderlyingSecurityDesc() bool {
 	return m.Has(tag.UnderlyingSecurityDesc)
@@ -6231,6 +5991,21 @@ func (m NoUnderlyings) HasEncodedUnderlyingSecurityDesc() bool {
 	return m.Has(tag.EncodedUnderlyingSecurityDesc)
 }
 
+//SetEncodedUnderlyingSecurityDescBytes sets EncodedUnderlyingSecurityDesc, Tag 365, and EncodedUnderlyingSecurityDescLen, Tag 364, from v, which may hold any bytes including SOH
+func (m NoUnderlyings) SetEncodedUnderlyingSecurityDescBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedUnderlyingSecurityDescLen, tag.EncodedUnderlyingSecurityDesc, v)
+}
+
+//GetEncodedUnderlyingSecurityDescBytes gets EncodedUnderlyingSecurityDesc, Tag 365, checking its length against EncodedUnderlyingSecurityDescLen, Tag 364
+func (m NoUnderlyings) GetEncodedUnderlyingSecurityDescBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedUnderlyingSecurityDescLen, tag.EncodedUnderlyingSecurityDesc)
+}
+
+//GetEncodedUnderlyingSecurityDescDecoded gets EncodedUnderlyingSecurityDesc, Tag 365, decoded to UTF-8 using the MessageEncoding of h, the Header of the message holding it
+func (m NoUnderlyings) GetEncodedUnderlyingSecurityDescDecoded(h fix44.Header) (string, error) {
+	return fix44.DecodeRawData(h, m, tag.EncodedUnderlyingSecurityDescLen, tag.EncodedUnderlyingSecurityDesc)
+}
+
 //HasUnderlyingCPProgram returns true if UnderlyingCPProgram is present, Tag 877
 func (m NoUnderlyings) HasUnderlyingCPProgram() bool {
 	return m.Has(tag.UnderlyingCPProgram)
@@ -6286,137 +6061,43 @@ func (m NoUnderlyings) HasNoUnderlyingStips() bool {
 	return m.Has(tag.NoUnderlyingStips)
 }
 
-//NoUnderlyingSecurityAltID is a repeating group element, Tag 457
-type NoUnderlyingSecurityAltID struct {
-	*quickfix.Group
-}
-
-//SetUnderlyingSecurityAltID sets UnderlyingSecurityAltID, Tag 458
-func (m NoUnderlyingSecurityAltID) SetUnderlyingSecurityAltID(v string) {
-	m.Set(field.NewUnderlyingSecurityAltID(v))
+//GetUnderlyingInstrument gets the UnderlyingInstrument component
+func (m NoUnderlyings) GetUnderlyingInstrument() components.UnderlyingInstrument {
+	return components.UnderlyingInstrument{&m.Group.FieldMap}
 }
 
-//SetUnderlyingSecurityAltIDSource sets UnderlyingSecurityAltIDSource, Tag 459
-func (m NoUnderlyingSecurityAltID) SetUnderlyingSecurityAltIDSource(v string) {
-	m.Set(field.NewUnderlyingSecurityAltIDSource(v))
+//SetUnderlyingInstrument sets the UnderlyingInstrument component, copying the fields present in c
+func (m NoUnderlyings) SetUnderlyingInstrument(c components.UnderlyingInstrument) quickfix.MessageRejectError {
+	return c.CopyInto(&m.Group.FieldMap)
 }
 
-//GetUnderlyingSecurityAltID gets UnderlyingSecurityAltID, Tag 458
-func (m NoUnderlyingSecurityAltID) GetUnderlyingSecurityAltID() (v string, err quickfix.MessageRejectError) {
-	var f field.UnderlyingSecurityAltIDField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetUnderlyingSecurityAltIDSource gets UnderlyingSecurityAltIDSource, Tag 459
-func (m NoUnderlyingSecurityAltID) GetUnderlyingSecurityAltIDSource() (v string, err quickfix.MessageRejectError) {
-	var f field.UnderlyingSecurityAltIDSourceField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//HasUnderlyingSecurityAltID returns true if UnderlyingSecurityAltID is present, Tag 458
-func (m NoUnderlyingSecurityAltID) HasUnderlyingSecurityAltID() bool {
-	return m.Has(tag.UnderlyingSecurityAltID)
-}
-
-//HasUnderlyingSecurityAltIDSource returns true if UnderlyingSecurityAltIDSource is present, Tag 459
-func (m NoUnderlyingSecurityAltID) HasUnderlyingSecurityAltIDSource() bool {
-	return m.Has(tag.UnderlyingSecurityAltIDSource)
-}
+//NoUnderlyingSecurityAltID is a repeating group element, Tag 457
+type NoUnderlyingSecurityAltID = components.NoUnderlyingSecurityAltID
 
 //NoUnderlyingSecurityAltIDRepeatingGroup is a repeating group, Tag 457
-type NoUnderlyingSecurityAltIDRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
+type NoUnderlyingSecurityAltIDRepeatingGroup = components.NoUnderlyingSecurityAltIDRepeatingGroup
 
 //NewNoUnderlyingSecurityAltIDRepeatingGroup returns an initialized, NoUnderlyingSecurityAltIDRepeatingGroup
 func NewNoUnderlyingSecurityAltIDRepeatingGroup() NoUnderlyingSecurityAltIDRepeatingGroup {
-	return NoUnderlyingSecurityAltIDRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoUnderlyingSecurityAltID,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.UnderlyingSecurityAltID), quickfix.GroupElement(tag.UnderlyingSecurityAltIDSource)})}
+	return components.NewNoUnderlyingSecurityAltIDRepeatingGroup()
 }
 
-//Add create and append a new NoUnderlyingSecurityAltID to this group
-func (m NoUnderlyingSecurityAltIDRepeatingGroup) Add() NoUnderlyingSecurityAltID {
-	g := m.RepeatingGroup.Add()
-	return NoUnderlyingSecurityAltID{g}
-}
-
-//Get returns the ith NoUnderlyingSecurityAltID in the NoUnderlyingSecurityAltIDRepeatinGroup
-func (m NoUnderlyingSecurityAltIDRepeatingGroup) Get(i int) NoUnderlyingSecurityAltID {
-	return NoUnderlyingSecurityAltID{m.RepeatingGroup.Get(i)}
-}
+//NoUnderlyingSecurityAltIDStruct is a plain Go representation of a NoUnderlyingSecurityAltID group element
+type NoUnderlyingSecurityAltIDStruct = components.NoUnderlyingSecurityAltIDStruct
 
 //NoUnderlyingStips is a repeating group element, Tag 887
-type NoUnderlyingStips struct {
-	*quickfix.Group
-}
-
-//SetUnderlyingStipType sets UnderlyingStipType, Tag 888
-func (m NoUnderlyingStips) SetUnderlyingStipType(v string) {
-	m.Set(field.NewUnderlyingStipType(v))
-}
-
-//SetUnderlyingStipValue sets UnderlyingStipValue, Tag 889
-func (m NoUnderlyingStips) SetUnderlyingStipValue(v string) {
-	m.Set(field.NewUnderlyingStipValue(v))
-}
-
-//GetUnderlyingStipType gets UnderlyingStipType, Tag 888
-func (m NoUnderlyingStips) GetUnderlyingStipType() (v string, err quickfix.MessageRejectError) {
-	var f field.UnderlyingStipTypeField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetUnderlyingStipValue gets UnderlyingStipValue, Tag 889
-func (m NoUnderlyingStips) GetUnderlyingStipValue() (v string, err quickfix.MessageRejectError) {
-	var f field.UnderlyingStipValueField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//HasUnderlyingStipType returns true if UnderlyingStipType is present, Tag 888
-func (m NoUnderlyingStips) HasUnderlyingStipType() bool {
-	return m.Has(tag.UnderlyingStipType)
-}
-
-//HasUnderlyingStipValue returns true if UnderlyingStipValue is present, Tag 889
-func (m NoUnderlyingStips) HasUnderlyingStipValue() bool {
-	return m.Has(tag.UnderlyingStipValue)
-}
+type NoUnderlyingStips = components.NoUnderlyingStips
 
 //NoUnderlyingStipsRepeatingGroup is a repeating group, Tag 887
-type NoUnderlyingStipsRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
+type NoUnderlyingStipsRepeatingGroup = components.NoUnderlyingStipsRepeatingGroup
 
 //NewNoUnderlyingStipsRepeatingGroup returns an initialized, NoUnderlyingStipsRepeatingGroup
 func NewNoUnderlyingStipsRepeatingGroup() NoUnderlyingStipsRepeatingGroup {
-	return NoUnderlyingStipsRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoUnderlyingStips,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.UnderlyingStipType), quickfix.GroupElement(tag.UnderlyingStipValue)})}
-}
-
-//Add create and append a new NoUnderlyingStips to this group
-func (m NoUnderlyingStipsRepeatingGroup) Add() NoUnderlyingStips {
-	g := m.RepeatingGroup.Add()
-	return NoUnderlyingStips{g}
+	return components.NewNoUnderlyingStipsRepeatingGroup()
 }
 
-//Get returns the ith NoUnderlyingStips in the NoUnderlyingStipsRepeatinGroup
-func (m NoUnderlyingStipsRepeatingGroup) Get(i int) NoUnderlyingStips {
-	return NoUnderlyingStips{m.RepeatingGroup.Get(i)}
-}
+//NoUnderlyingStipsStruct is a plain Go representation of a NoUnderlyingStips group element
+type NoUnderlyingStipsStruct = components.NoUnderlyingStipsStruct
 
 //NoUnderlyingsRepeatingGroup is a repeating group, Tag 711
 type NoUnderlyingsRepeatingGroup struct {
@@ -6442,108 +6123,18 @@ func (m NoUnderlyingsRepeatingGroup) Get(i int) NoUnderlyings {
 }
 
 //NoEvents is a repeating group element, Tag 864
-type NoEvents struct {
-	*quickfix.Group
-}
-
-//SetEventType sets EventType, Tag 865
-func (m NoEvents) SetEventType(v enum.EventType) {
-	m.Set(field.NewEventType(v))
-}
-
-//SetEventDate sets EventDate, Tag 866
-func (m NoEvents) SetEventDate(v string) {
-	m.Set(field.NewEventDate(v))
-}
-
-//SetEventPx sets EventPx, Tag 867
-func (m NoEvents) SetEventPx(value decimal.Decimal, scale int32) {
-	m.Set(field.NewEventPx(value, scale))
-}
-
-//SetEventText sets EventText, Tag 868
-func (m NoEvents) SetEventText(v string) {
-	m.Set(field.NewEventText(v))
-}
-
-//GetEventType gets EventType, Tag 865
-func (m NoEvents) GetEventType() (v enum.EventType, err quickfix.MessageRejectError) {
-	var f field.EventTypeField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetEventDate gets EventDate, Tag 866
-func (m NoEvents) GetEventDate() (v string, err quickfix.MessageRejectError) {
-	var f field.EventDateField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetEventPx gets EventPx, Tag 867
-func (m NoEvents) GetEventPx() (v decimal.Decimal, err quickfix.MessageRejectError) {
-	var f field.EventPxField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetEventText gets EventText, Tag 868
-func (m NoEvents) GetEventText() (v string, err quickfix.MessageRejectError) {
-	var f field.EventTextField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//HasEventType returns true if EventType is present, Tag 865
-func (m NoEvents) HasEventType() bool {
-	return m.Has(tag.EventType)
-}
-
-//HasEventDate returns true if EventDate is present, Tag 866
-func (m NoEvents) HasEventDate() bool {
-	return m.Has(tag.EventDate)
-}
-
-//HasEventPx returns true if EventPx is present, Tag 867
-func (m NoEvents) HasEventPx() bool {
-	return m.Has(tag.EventPx)
-}
-
-//HasEventText returns true if EventText is present, Tag 868
-func (m NoEvents) HasEventText() bool {
-	return m.Has(tag.EventText)
-}
+type NoEvents = components.NoEvents
 
 //NoEventsRepeatingGroup is a repeating group, Tag 864
-type NoEventsRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
+type NoEventsRepeatingGroup = components.NoEventsRepeatingGroup
 
 //NewNoEventsRepeatingGroup returns an initialized, NoEventsRepeatingGroup
 func NewNoEventsRepeatingGroup() NoEventsRepeatingGroup {
-	return NoEventsRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoEvents,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.EventType), quickfix.GroupElement(tag.EventDate), quickfix.GroupElement(tag.EventPx), quickfix.GroupElement(tag.EventText)})}
-}
-
-//Add create and append a new NoEvents to this group
-func (m NoEventsRepeatingGroup) Add() NoEvents {
-	g := m.RepeatingGroup.Add()
-	return NoEvents{g}
+	return components.NewNoEventsRepeatingGroup()
 }
 
-//Get returns the ith NoEvents in the NoEventsRepeatinGroup
-func (m NoEventsRepeatingGroup) Get(i int) NoEvents {
-	return NoEvents{m.RepeatingGroup.Get(i)}
-}
+//NoEventsStruct is a plain Go representation of a NoEvents group element
+type NoEventsStruct = components.NoEventsStruct
 
 //NoInstrAttrib is a repeating group element, Tag 870
 type NoInstrAttrib struct {
@@ -6610,3 +6201,3480 @@ func (m NoInstrAttribRepeatingGroup) Add() NoInstrAttrib {
 func (m NoInstrAttribRepeatingGroup) Get(i int) NoInstrAttrib {
 	return NoInstrAttrib{m.RepeatingGroup.Get(i)}
 }
+
+//Struct is a plain Go representation of the AllocationInstruction body, optional fields are nil when absent
+type Struct struct {
+	AvgPx                      decimal.Decimal
+	Currency                   *string
+	SecurityIDSource           *enum.SecurityIDSource
+	LastMkt                    *string
+	SecurityID                 *string
+	Quantity                   decimal.Decimal
+	Side                       enum.Side
+	Symbol                     *string
+	Text                       *string
+	TransactTime               *time.Time
+	SettlType                  *enum.SettlType
+	SettlDate                  *string
+	SymbolSfx                  *enum.SymbolSfx
+	AllocID                    string
+	AllocTransType             enum.AllocTransType
+	RefAllocID                 *string
+	NoOrders                   []NoOrdersStruct
+	AvgPxPrecision             *int
+	TradeDate                  string
+	PositionEffect             *enum.PositionEffect
+	NoAllocs                   []NoAllocsStruct
+	Issuer                     *string
+	SecurityDesc               *string
+	NetMoney                   *decimal.Decimal
+	NoExecs                    []NoExecsStruct
+	NumDaysInterest            *int
+	AccruedInterestRate        *decimal.Decimal
+	AccruedInterestAmt         *decimal.Decimal
+	SecurityType               *enum.SecurityType
+	AllocLinkID                *string
+	AllocLinkType              *enum.AllocLinkType
+	MaturityMonthYear          *string
+	StrikePrice                *decimal.Decimal
+	OptAttribute               *string
+	SecurityExchange           *string
+	Spread                     *decimal.Decimal
+	BenchmarkCurveCurrency     *string
+	BenchmarkCurveName         *enum.BenchmarkCurveName
+	BenchmarkCurvePoint        *string
+	CouponRate                 *decimal.Decimal
+	CouponPaymentDate          *string
+	IssueDate                  *string
+	RepurchaseTerm             *int
+	RepurchaseRate             *decimal.Decimal
+	Factor                     *decimal.Decimal
+	TradeOriginationDate       *string
+	ContractMultiplier         *decimal.Decimal
+	NoStipulations             []NoStipulationsStruct
+	YieldType                  *enum.YieldType
+	Yield                      *decimal.Decimal
+	TotalTakedown              *decimal.Decimal
+	Concession                 *decimal.Decimal
+	RepoCollateralSecurityType *int
+	RedemptionDate             *string
+	CreditRating               *string
+	TradingSessionID           *enum.TradingSessionID
+	EncodedIssuerLen           *int
+	EncodedIssuer              *string
+	EncodedSecurityDescLen     *int
+	EncodedSecurityDesc        *string
+	EncodedTextLen             *int
+	EncodedText                *string
+	GrossTradeAmt              *decimal.Decimal
+	PriceType                  *enum.PriceType
+	NoPartyIDs                 []NoPartyIDsStruct
+	NoSecurityAltID            []NoSecurityAltIDStruct
+	Product                    *enum.Product
+	CFICode                    *string
+	BookingRefID               *string
+	CountryOfIssue             *string
+	StateOrProvinceOfIssue     *string
+	LocaleOfIssue              *string
+	TotalAccruedInterestAmt    *decimal.Decimal
+	MaturityDate               *string
+	InstrRegistry              *enum.InstrRegistry
+	NoLegs                     []NoLegsStruct
+	PreviouslyReported         *bool
+	MatchType                  *enum.MatchType
+	TradingSessionSubID        *enum.TradingSessionSubID
+	AllocType                  enum.AllocType
+	LegalConfirm               *bool
+	BenchmarkPrice             *decimal.Decimal
+	BenchmarkPriceType         *int
+	ContractSettlMonth         *string
+	DeliveryForm               *enum.DeliveryForm
+	Pool                       *string
+	YieldRedemptionDate        *string
+	YieldRedemptionPrice       *decimal.Decimal
+	YieldRedemptionPriceType   *int
+	BenchmarkSecurityID        *string
+	ReversalIndicator          *bool
+	YieldCalcDate              *string
+	NoUnderlyings              []NoUnderlyingsStruct
+	InterestAtMaturity         *decimal.Decimal
+	AutoAcceptIndicator        *bool
+	BenchmarkSecurityIDSource  *string
+	SecuritySubType            *string
+	BookingType                *enum.BookingType
+	TerminationType            *enum.TerminationType
+	SecondaryAllocID           *string
+	AllocCancReplaceReason     *enum.AllocCancReplaceReason
+	AllocIntermedReqType       *enum.AllocIntermedReqType
+	QtyType                    *enum.QtyType
+	AllocNoOrdersType          enum.AllocNoOrdersType
+	AvgParPx                   *decimal.Decimal
+	NoEvents                   []NoEventsStruct
+	PctAtRisk                  *decimal.Decimal
+	NoInstrAttrib              []NoInstrAttribStruct
+	DatedDate                  *string
+	InterestAccrualDate        *string
+	CPProgram                  *enum.CPProgram
+	CPRegType                  *string
+	TotNoAllocs                *int
+	LastFragment               *bool
+	MarginRatio                *decimal.Decimal
+	AgreementDesc              *string
+	AgreementID                *string
+	AgreementDate              *string
+	StartDate                  *string
+	EndDate                    *string
+	AgreementCurrency          *string
+	DeliveryType               *enum.DeliveryType
+	EndAccruedInterestAmt      *decimal.Decimal
+	StartCash                  *decimal.Decimal
+	EndCash                    *decimal.Decimal
+	StrikeCurrency             *string
+}
+
+//Marshal copies the fields present in msg into a Struct
+func Marshal(msg AllocationInstruction) (Struct, quickfix.MessageRejectError) {
+	var s Struct
+	if msg.HasAvgPx() {
+		v, err := msg.GetAvgPx()
+		if err != nil {
+			return s, err
+		}
+		s.AvgPx = v
+	}
+	if msg.HasCurrency() {
+		v, err := msg.GetCurrency()
+		if err != nil {
+			return s, err
+		}
+		s.Currency = &v
+	}
+	if msg.HasSecurityIDSource() {
+		v, err := msg.GetSecurityIDSource()
+		if err != nil {
+			return s, err
+		}
+		s.SecurityIDSource = &v
+	}
+	if msg.HasLastMkt() {
+		v, err := msg.GetLastMkt()
+		if err != nil {
+			return s, err
+		}
+		s.LastMkt = &v
+	}
+	if msg.HasSecurityID() {
+		v, err := msg.GetSecurityID()
+		if err != nil {
+			return s, err
+		}
+		s.SecurityID = &v
+	}
+	if msg.HasQuantity() {
+		v, err := msg.GetQuantity()
+		if err != nil {
+			return s, err
+		}
+		s.Quantity = v
+	}
+	if msg.HasSide() {
+		v, err := msg.GetSide()
+		if err != nil {
+			return s, err
+		}
+		s.Side = v
+	}
+	if msg.HasSymbol() {
+		v, err := msg.GetSymbol()
+		if err != nil {
+			return s, err
+		}
+		s.Symbol = &v
+	}
+	if msg.HasText() {
+		v, err := msg.GetText()
+		if err != nil {
+			return s, err
+		}
+		s.Text = &v
+	}
+	if msg.HasTransactTime() {
+		v, err := msg.GetTransactTime()
+		if err != nil {
+			return s, err
+		}
+		s.TransactTime = &v
+	}
+	if msg.HasSettlType() {
+		v, err := msg.GetSettlType()
+		if err != nil {
+			return s, err
+		}
+		s.SettlType = &v
+	}
+	if msg.HasSettlDate() {
+		v, err := msg.GetSettlDate()
+		if err != nil {
+			return s, err
+		}
+		s.SettlDate = &v
+	}
+	if msg.HasSymbolSfx() {
+		v, err := msg.GetSymbolSfx()
+		if err != nil {
+			return s, err
+		}
+		s.SymbolSfx = &v
+	}
+	if msg.HasAllocID() {
+		v, err := msg.GetAllocID()
+		if err != nil {
+			return s, err
+		}
+		s.AllocID = v
+	}
+	if msg.HasAllocTransType() {
+		v, err := msg.GetAllocTransType()
+		if err != nil {
+			return s, err
+		}
+		s.AllocTransType = v
+	}
+	if msg.HasRefAllocID() {
+		v, err := msg.GetRefAllocID()
+		if err != nil {
+			return s, err
+		}
+		s.RefAllocID = &v
+	}
+	if msg.HasNoOrders() {
+		g, err := msg.GetNoOrders()
+		if err != nil {
+			return s, err
+		}
+		if s.NoOrders, err = MarshalNoOrders(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasAvgPxPrecision() {
+		v, err := msg.GetAvgPxPrecision()
+		if err != nil {
+			return s, err
+		}
+		s.AvgPxPrecision = &v
+	}
+	if msg.HasTradeDate() {
+		v, err := msg.GetTradeDate()
+		if err != nil {
+			return s, err
+		}
+		s.TradeDate = v
+	}
+	if msg.HasPositionEffect() {
+		v, err := msg.GetPositionEffect()
+		if err != nil {
+			return s, err
+		}
+		s.PositionEffect = &v
+	}
+	if msg.HasNoAllocs() {
+		g, err := msg.GetNoAllocs()
+		if err != nil {
+			return s, err
+		}
+		if s.NoAllocs, err = MarshalNoAllocs(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasIssuer() {
+		v, err := msg.GetIssuer()
+		if err != nil {
+			return s, err
+		}
+		s.Issuer = &v
+	}
+	if msg.HasSecurityDesc() {
+		v, err := msg.GetSecurityDesc()
+		if err != nil {
+			return s, err
+		}
+		s.SecurityDesc = &v
+	}
+	if msg.HasNetMoney() {
+		v, err := msg.GetNetMoney()
+		if err != nil {
+			return s, err
+		}
+		s.NetMoney = &v
+	}
+	if msg.HasNoExecs() {
+		g, err := msg.GetNoExecs()
+		if err != nil {
+			return s, err
+		}
+		if s.NoExecs, err = MarshalNoExecs(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasNumDaysInterest() {
+		v, err := msg.GetNumDaysInterest()
+		if err != nil {
+			return s, err
+		}
+		s.NumDaysInterest = &v
+	}
+	if msg.HasAccruedInterestRate() {
+		v, err := msg.GetAccruedInterestRate()
+		if err != nil {
+			return s, err
+		}
+		s.AccruedInterestRate = &v
+	}
+	if msg.HasAccruedInterestAmt() {
+		v, err := msg.GetAccruedInterestAmt()
+		if err != nil {
+			return s, err
+		}
+		s.AccruedInterestAmt = &v
+	}
+	if msg.HasSecurityType() {
+		v, err := msg.GetSecurityType()
+		if err != nil {
+			return s, err
+		}
+		s.SecurityType = &v
+	}
+	if msg.HasAllocLinkID() {
+		v, err := msg.GetAllocLinkID()
+		if err != nil {
+			return s, err
+		}
+		s.AllocLinkID = &v
+	}
+	if msg.HasAllocLinkType() {
+		v, err := msg.GetAllocLinkType()
+		if err != nil {
+			return s, err
+		}
+		s.AllocLinkType = &v
+	}
+	if msg.HasMaturityMonthYear() {
+		v, err := msg.GetMaturityMonthYear()
+		if err != nil {
+			return s, err
+		}
+		s.MaturityMonthYear = &v
+	}
+	if msg.HasStrikePrice() {
+		v, err := msg.GetStrikePrice()
+		if err != nil {
+			return s, err
+		}
+		s.StrikePrice = &v
+	}
+	if msg.HasOptAttribute() {
+		v, err := msg.GetOptAttribute()
+		if err != nil {
+			return s, err
+		}
+		s.OptAttribute = &v
+	}
+	if msg.HasSecurityExchange() {
+		v, err := msg.GetSecurityExchange()
+		if err != nil {
+			return s, err
+		}
+		s.SecurityExchange = &v
+	}
+	if msg.HasSpread() {
+		v, err := msg.GetSpread()
+		if err != nil {
+			return s, err
+		}
+		s.Spread = &v
+	}
+	if msg.HasBenchmarkCurveCurrency() {
+		v, err := msg.GetBenchmarkCurveCurrency()
+		if err != nil {
+			return s, err
+		}
+		s.BenchmarkCurveCurrency = &v
+	}
+	if msg.HasBenchmarkCurveName() {
+		v, err := msg.GetBenchmarkCurveName()
+		if err != nil {
+			return s, err
+		}
+		s.BenchmarkCurveName = &v
+	}
+	if msg.HasBenchmarkCurvePoint() {
+		v, err := msg.GetBenchmarkCurvePoint()
+		if err != nil {
+			return s, err
+		}
+		s.BenchmarkCurvePoint = &v
+	}
+	if msg.HasCouponRate() {
+		v, err := msg.GetCouponRate()
+		if err != nil {
+			return s, err
+		}
+		s.CouponRate = &v
+	}
+	if msg.HasCouponPaymentDate() {
+		v, err := msg.GetCouponPaymentDate()
+		if err != nil {
+			return s, err
+		}
+		s.CouponPaymentDate = &v
+	}
+	if msg.HasIssueDate() {
+		v, err := msg.GetIssueDate()
+		if err != nil {
+			return s, err
+		}
+		s.IssueDate = &v
+	}
+	if msg.HasRepurchaseTerm() {
+		v, err := msg.GetRepurchaseTerm()
+		if err != nil {
+			return s, err
+		}
+		s.RepurchaseTerm = &v
+	}
+	if msg.HasRepurchaseRate() {
+		v, err := msg.GetRepurchaseRate()
+		if err != nil {
+			return s, err
+		}
+		s.RepurchaseRate = &v
+	}
+	if msg.HasFactor() {
+		v, err := msg.GetFactor()
+		if err != nil {
+			return s, err
+		}
+		s.Factor = &v
+	}
+	if msg.HasTradeOriginationDate() {
+		v, err := msg.GetTradeOriginationDate()
+		if err != nil {
+			return s, err
+		}
+		s.TradeOriginationDate = &v
+	}
+	if msg.HasContractMultiplier() {
+		v, err := msg.GetContractMultiplier()
+		if err != nil {
+			return s, err
+		}
+		s.ContractMultiplier = &v
+	}
+	if msg.HasNoStipulations() {
+		g, err := msg.GetNoStipulations()
+		if err != nil {
+			return s, err
+		}
+		if s.NoStipulations, err = components.MarshalNoStipulations(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasYieldType() {
+		v, err := msg.GetYieldType()
+		if err != nil {
+			return s, err
+		}
+		s.YieldType = &v
+	}
+	if msg.HasYield() {
+		v, err := msg.GetYield()
+		if err != nil {
+			return s, err
+		}
+		s.Yield = &v
+	}
+	if msg.HasTotalTakedown() {
+		v, err := msg.GetTotalTakedown()
+		if err != nil {
+			return s, err
+		}
+		s.TotalTakedown = &v
+	}
+	if msg.HasConcession() {
+		v, err := msg.GetConcession()
+		if err != nil {
+			return s, err
+		}
+		s.Concession = &v
+	}
+	if msg.HasRepoCollateralSecurityType() {
+		v, err := msg.GetRepoCollateralSecurityType()
+		if err != nil {
+			return s, err
+		}
+		s.RepoCollateralSecurityType = &v
+	}
+	if msg.HasRedemptionDate() {
+		v, err := msg.GetRedemptionDate()
+		if err != nil {
+			return s, err
+		}
+		s.RedemptionDate = &v
+	}
+	if msg.HasCreditRating() {
+		v, err := msg.GetCreditRating()
+		if err != nil {
+			return s, err
+		}
+		s.CreditRating = &v
+	}
+	if msg.HasTradingSessionID() {
+		v, err := msg.GetTradingSessionID()
+		if err != nil {
+			return s, err
+		}
+		s.TradingSessionID = &v
+	}
+	if msg.HasEncodedIssuerLen() {
+		v, err := msg.GetEncodedIssuerLen()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedIssuerLen = &v
+	}
+	if msg.HasEncodedIssuer() {
+		v, err := msg.GetEncodedIssuer()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedIssuer = &v
+	}
+	if msg.HasEncodedSecurityDescLen() {
+		v, err := msg.GetEncodedSecurityDescLen()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedSecurityDescLen = &v
+	}
+	if msg.HasEncodedSecurityDesc() {
+		v, err := msg.GetEncodedSecurityDesc()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedSecurityDesc = &v
+	}
+	if msg.HasEncodedTextLen() {
+		v, err := msg.GetEncodedTextLen()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedTextLen = &v
+	}
+	if msg.HasEncodedText() {
+		v, err := msg.GetEncodedText()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedText = &v
+	}
+	if msg.HasGrossTradeAmt() {
+		v, err := msg.GetGrossTradeAmt()
+		if err != nil {
+			return s, err
+		}
+		s.GrossTradeAmt = &v
+	}
+	if msg.HasPriceType() {
+		v, err := msg.GetPriceType()
+		if err != nil {
+			return s, err
+		}
+		s.PriceType = &v
+	}
+	if msg.HasNoPartyIDs() {
+		g, err := msg.GetNoPartyIDs()
+		if err != nil {
+			return s, err
+		}
+		if s.NoPartyIDs, err = components.MarshalNoPartyIDs(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasNoSecurityAltID() {
+		g, err := msg.GetNoSecurityAltID()
+		if err != nil {
+			return s, err
+		}
+		if s.NoSecurityAltID, err = components.MarshalNoSecurityAltID(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasProduct() {
+		v, err := msg.GetProduct()
+		if err != nil {
+			return s, err
+		}
+		s.Product = &v
+	}
+	if msg.HasCFICode() {
+		v, err := msg.GetCFICode()
+		if err != nil {
+			return s, err
+		}
+		s.CFICode = &v
+	}
+	if msg.HasBookingRefID() {
+		v, err := msg.GetBookingRefID()
+		if err != nil {
+			return s, err
+		}
+		s.BookingRefID = &v
+	}
+	if msg.HasCountryOfIssue() {
+		v, err := msg.GetCountryOfIssue()
+		if err != nil {
+			return s, err
+		}
+		s.CountryOfIssue = &v
+	}
+	if msg.HasStateOrProvinceOfIssue() {
+		v, err := msg.GetStateOrProvinceOfIssue()
+		if err != nil {
+			return s, err
+		}
+		s.StateOrProvinceOfIssue = &v
+	}
+	if msg.HasLocaleOfIssue() {
+		v, err := msg.GetLocaleOfIssue()
+		if err != nil {
+			return s, err
+		}
+		s.LocaleOfIssue = &v
+	}
+	if msg.HasTotalAccruedInterestAmt() {
+		v, err := msg.GetTotalAccruedInterestAmt()
+		if err != nil {
+			return s, err
+		}
+		s.TotalAccruedInterestAmt = &v
+	}
+	if msg.HasMaturityDate() {
+		v, err := msg.GetMaturityDate()
+		if err != nil {
+			return s, err
+		}
+		s.MaturityDate = &v
+	}
+	if msg.HasInstrRegistry() {
+		v, err := msg.GetInstrRegistry()
+		if err != nil {
+			return s, err
+		}
+		s.InstrRegistry = &v
+	}
+	if msg.HasNoLegs() {
+		g, err := msg.GetNoLegs()
+		if err != nil {
+			return s, err
+		}
+		if s.NoLegs, err = MarshalNoLegs(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasPreviouslyReported() {
+		v, err := msg.GetPreviouslyReported()
+		if err != nil {
+			return s, err
+		}
+		s.PreviouslyReported = &v
+	}
+	if msg.HasMatchType() {
+		v, err := msg.GetMatchType()
+		if err != nil {
+			return s, err
+		}
+		s.MatchType = &v
+	}
+	if msg.HasTradingSessionSubID() {
+		v, err := msg.GetTradingSessionSubID()
+		if err != nil {
+			return s, err
+		}
+		s.TradingSessionSubID = &v
+	}
+	if msg.HasAllocType() {
+		v, err := msg.GetAllocType()
+		if err != nil {
+			return s, err
+		}
+		s.AllocType = v
+	}
+	if msg.HasLegalConfirm() {
+		v, err := msg.GetLegalConfirm()
+		if err != nil {
+			return s, err
+		}
+		s.LegalConfirm = &v
+	}
+	if msg.HasBenchmarkPrice() {
+		v, err := msg.GetBenchmarkPrice()
+		if err != nil {
+			return s, err
+		}
+		s.BenchmarkPrice = &v
+	}
+	if msg.HasBenchmarkPriceType() {
+		v, err := msg.GetBenchmarkPriceType()
+		if err != nil {
+			return s, err
+		}
+		s.BenchmarkPriceType = &v
+	}
+	if msg.HasContractSettlMonth() {
+		v, err := msg.GetContractSettlMonth()
+		if err != nil {
+			return s, err
+		}
+		s.ContractSettlMonth = &v
+	}
+	if msg.HasDeliveryForm() {
+		v, err := msg.GetDeliveryForm()
+		if err != nil {
+			return s, err
+		}
+		s.DeliveryForm = &v
+	}
+	if msg.HasPool() {
+		v, err := msg.GetPool()
+		if err != nil {
+			return s, err
+		}
+		s.Pool = &v
+	}
+	if msg.HasYieldRedemptionDate() {
+		v, err := msg.GetYieldRedemptionDate()
+		if err != nil {
+			return s, err
+		}
+		s.YieldRedemptionDate = &v
+	}
+	if msg.HasYieldRedemptionPrice() {
+		v, err := msg.GetYieldRedemptionPrice()
+		if err != nil {
+			return s, err
+		}
+		s.YieldRedemptionPrice = &v
+	}
+	if msg.HasYieldRedemptionPriceType() {
+		v, err := msg.GetYieldRedemptionPriceType()
+		if err != nil {
+			return s, err
+		}
+		s.YieldRedemptionPriceType = &v
+	}
+	if msg.HasBenchmarkSecurityID() {
+		v, err := msg.GetBenchmarkSecurityID()
+		if err != nil {
+			return s, err
+		}
+		s.BenchmarkSecurityID = &v
+	}
+	if msg.HasReversalIndicator() {
+		v, err := msg.GetReversalIndicator()
+		if err != nil {
+			return s, err
+		}
+		s.ReversalIndicator = &v
+	}
+	if msg.HasYieldCalcDate() {
+		v, err := msg.GetYieldCalcDate()
+		if err != nil {
+			return s, err
+		}
+		s.YieldCalcDate = &v
+	}
+	if msg.HasNoUnderlyings() {
+		g, err := msg.GetNoUnderlyings()
+		if err != nil {
+			return s, err
+		}
+		if s.NoUnderlyings, err = MarshalNoUnderlyings(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasInterestAtMaturity() {
+		v, err := msg.GetInterestAtMaturity()
+		if err != nil {
+			return s, err
+		}
+		s.InterestAtMaturity = &v
+	}
+	if msg.HasAutoAcceptIndicator() {
+		v, err := msg.GetAutoAcceptIndicator()
+		if err != nil {
+			return s, err
+		}
+		s.AutoAcceptIndicator = &v
+	}
+	if msg.HasBenchmarkSecurityIDSource() {
+		v, err := msg.GetBenchmarkSecurityIDSource()
+		if err != nil {
+			return s, err
+		}
+		s.BenchmarkSecurityIDSource = &v
+	}
+	if msg.HasSecuritySubType() {
+		v, err := msg.GetSecuritySubType()
+		if err != nil {
+			return s, err
+		}
+		s.SecuritySubType = &v
+	}
+	if msg.HasBookingType() {
+		v, err := msg.GetBookingType()
+		if err != nil {
+			return s, err
+		}
+		s.BookingType = &v
+	}
+	if msg.HasTerminationType() {
+		v, err := msg.GetTerminationType()
+		if err != nil {
+			return s, err
+		}
+		s.TerminationType = &v
+	}
+	if msg.HasSecondaryAllocID() {
+		v, err := msg.GetSecondaryAllocID()
+		if err != nil {
+			return s, err
+		}
+		s.SecondaryAllocID = &v
+	}
+	if msg.HasAllocCancReplaceReason() {
+		v, err := msg.GetAllocCancReplaceReason()
+		if err != nil {
+			return s, err
+		}
+		s.AllocCancReplaceReason = &v
+	}
+	if msg.HasAllocIntermedReqType() {
+		v, err := msg.GetAllocIntermedReqType()
+		if err != nil {
+			return s, err
+		}
+		s.AllocIntermedReqType = &v
+	}
+	if msg.HasQtyType() {
+		v, err := msg.GetQtyType()
+		if err != nil {
+			return s, err
+		}
+		s.QtyType = &v
+	}
+	if msg.HasAllocNoOrdersType() {
+		v, err := msg.GetAllocNoOrdersType()
+		if err != nil {
+			return s, err
+		}
+		s.AllocNoOrdersType = v
+	}
+	if msg.HasAvgParPx() {
+		v, err := msg.GetAvgParPx()
+		if err != nil {
+			return s, err
+		}
+		s.AvgParPx = &v
+	}
+	if msg.HasNoEvents() {
+		g, err := msg.GetNoEvents()
+		if err != nil {
+			return s, err
+		}
+		if s.NoEvents, err = components.MarshalNoEvents(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasPctAtRisk() {
+		v, err := msg.GetPctAtRisk()
+		if err != nil {
+			return s, err
+		}
+		s.PctAtRisk = &v
+	}
+	if msg.HasNoInstrAttrib() {
+		g, err := msg.GetNoInstrAttrib()
+		if err != nil {
+			return s, err
+		}
+		if s.NoInstrAttrib, err = MarshalNoInstrAttrib(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasDatedDate() {
+		v, err := msg.GetDatedDate()
+		if err != nil {
+			return s, err
+		}
+		s.DatedDate = &v
+	}
+	if msg.HasInterestAccrualDate() {
+		v, err := msg.GetInterestAccrualDate()
+		if err != nil {
+			return s, err
+		}
+		s.InterestAccrualDate = &v
+	}
+	if msg.HasCPProgram() {
+		v, err := msg.GetCPProgram()
+		if err != nil {
+			return s, err
+		}
+		s.CPProgram = &v
+	}
+	if msg.HasCPRegType() {
+		v, err := msg.GetCPRegType()
+		if err != nil {
+			return s, err
+		}
+		s.CPRegType = &v
+	}
+	if msg.HasTotNoAllocs() {
+		v, err := msg.GetTotNoAllocs()
+		if err != nil {
+			return s, err
+		}
+		s.TotNoAllocs = &v
+	}
+	if msg.HasLastFragment() {
+		v, err := msg.GetLastFragment()
+		if err != nil {
+			return s, err
+		}
+		s.LastFragment = &v
+	}
+	if msg.HasMarginRatio() {
+		v, err := msg.GetMarginRatio()
+		if err != nil {
+			return s, err
+		}
+		s.MarginRatio = &v
+	}
+	if msg.HasAgreementDesc() {
+		v, err := msg.GetAgreementDesc()
+		if err != nil {
+			return s, err
+		}
+		s.AgreementDesc = &v
+	}
+	if msg.HasAgreementID() {
+		v, err := msg.GetAgreementID()
+		if err != nil {
+			return s, err
+		}
+		s.AgreementID = &v
+	}
+	if msg.HasAgreementDate() {
+		v, err := msg.GetAgreementDate()
+		if err != nil {
+			return s, err
+		}
+		s.AgreementDate = &v
+	}
+	if msg.HasStartDate() {
+		v, err := msg.GetStartDate()
+		if err != nil {
+			return s, err
+		}
+		s.StartDate = &v
+	}
+	if msg.HasEndDate() {
+		v, err := msg.GetEndDate()
+		if err != nil {
+			return s, err
+		}
+		s.EndDate = &v
+	}
+	if msg.HasAgreementCurrency() {
+		v, err := msg.GetAgreementCurrency()
+		if err != nil {
+			return s, err
+		}
+		s.AgreementCurrency = &v
+	}
+	if msg.HasDeliveryType() {
+		v, err := msg.GetDeliveryType()
+		if err != nil {
+			return s, err
+		}
+		s.DeliveryType = &v
+	}
+	if msg.HasEndAccruedInterestAmt() {
+		v, err := msg.GetEndAccruedInterestAmt()
+		if err != nil {
+			return s, err
+		}
+		s.EndAccruedInterestAmt = &v
+	}
+	if msg.HasStartCash() {
+		v, err := msg.GetStartCash()
+		if err != nil {
+			return s, err
+		}
+		s.StartCash = &v
+	}
+	if msg.HasEndCash() {
+		v, err := msg.GetEndCash()
+		if err != nil {
+			return s, err
+		}
+		s.EndCash = &v
+	}
+	if msg.HasStrikeCurrency() {
+		v, err := msg.GetStrikeCurrency()
+		if err != nil {
+			return s, err
+		}
+		s.StrikeCurrency = &v
+	}
+	return s, nil
+}
+
+//Unmarshal builds a AllocationInstruction from s
+func Unmarshal(s Struct) AllocationInstruction {
+	m := New(field.NewAllocID(s.AllocID), field.NewAllocTransType(s.AllocTransType), field.NewAllocType(s.AllocType), field.NewAllocNoOrdersType(s.AllocNoOrdersType), field.NewSide(s.Side), field.NewQuantity(s.Quantity, fix44.DecimalScale(s.Quantity)), field.NewAvgPx(s.AvgPx, fix44.DecimalScale(s.AvgPx)), field.NewTradeDate(s.TradeDate))
+	if s.Currency != nil {
+		m.SetCurrency(*s.Currency)
+	}
+	if s.SecurityIDSource != nil {
+		m.SetSecurityIDSource(*s.SecurityIDSource)
+	}
+	if s.LastMkt != nil {
+		m.SetLastMkt(*s.LastMkt)
+	}
+	if s.SecurityID != nil {
+		m.SetSecurityID(*s.SecurityID)
+	}
+	if s.Symbol != nil {
+		m.SetSymbol(*s.Symbol)
+	}
+	if s.Text != nil {
+		m.SetText(*s.Text)
+	}
+	if s.TransactTime != nil {
+		m.SetTransactTime(*s.TransactTime)
+	}
+	if s.SettlType != nil {
+		m.SetSettlType(*s.SettlType)
+	}
+	if s.SettlDate != nil {
+		m.SetSettlDate(*s.SettlDate)
+	}
+	if s.SymbolSfx != nil {
+		m.SetSymbolSfx(*s.SymbolSfx)
+	}
+	if s.RefAllocID != nil {
+		m.SetRefAllocID(*s.RefAllocID)
+	}
+	if s.NoOrders != nil {
+		m.SetNoOrders(UnmarshalNoOrders(s.NoOrders))
+	}
+	if s.AvgPxPrecision != nil {
+		m.SetAvgPxPrecision(*s.AvgPxPrecision)
+	}
+	if s.PositionEffect != nil {
+		m.SetPositionEffect(*s.PositionEffect)
+	}
+	if s.NoAllocs != nil {
+		m.SetNoAllocs(UnmarshalNoAllocs(s.NoAllocs))
+	}
+	if s.Issuer != nil {
+		m.SetIssuer(*s.Issuer)
+	}
+	if s.SecurityDesc != nil {
+		m.SetSecurityDesc(*s.SecurityDesc)
+	}
+	if s.NetMoney != nil {
+		m.SetNetMoney(*s.NetMoney, fix44.DecimalScale(*s.NetMoney))
+	}
+	if s.NoExecs != nil {
+		m.SetNoExecs(UnmarshalNoExecs(s.NoExecs))
+	}
+	if s.NumDaysInterest != nil {
+		m.SetNumDaysInterest(*s.NumDaysInterest)
+	}
+	if s.AccruedInterestRate != nil {
+		m.SetAccruedInterestRate(*s.AccruedInterestRate, fix44.DecimalScale(*s.AccruedInterestRate))
+	}
+	if s.AccruedInterestAmt != nil {
+		m.SetAccruedInterestAmt(*s.AccruedInterestAmt, fix44.DecimalScale(*s.AccruedInterestAmt))
+	}
+	if s.SecurityType != nil {
+		m.SetSecurityType(*s.SecurityType)
+	}
+	if s.AllocLinkID != nil {
+		m.SetAllocLinkID(*s.AllocLinkID)
+	}
+	if s.AllocLinkType != nil {
+		m.SetAllocLinkType(*s.AllocLinkType)
+	}
+	if s.MaturityMonthYear != nil {
+		m.SetMaturityMonthYear(*s.MaturityMonthYear)
+	}
+	if s.StrikePrice != nil {
+		m.SetStrikePrice(*s.StrikePrice, fix44.DecimalScale(*s.StrikePrice))
+	}
+	if s.OptAttribute != nil {
+		m.SetOptAttribute(*s.OptAttribute)
+	}
+	if s.SecurityExchange != nil {
+		m.SetSecurityExchange(*s.SecurityExchange)
+	}
+	if s.Spread != nil {
+		m.SetSpread(*s.Spread, fix44.DecimalScale(*s.Spread))
+	}
+	if s.BenchmarkCurveCurrency != nil {
+		m.SetBenchmarkCurveCurrency(*s.BenchmarkCurveCurrency)
+	}
+	if s.BenchmarkCurveName != nil {
+		m.SetBenchmarkCurveName(*s.BenchmarkCurveName)
+	}
+	if s.BenchmarkCurvePoint != nil {
+		m.SetBenchmarkCurvePoint(*s.BenchmarkCurvePoint)
+	}
+	if s.CouponRate != nil {
+		m.SetCouponRate(*s.CouponRate, fix44.DecimalScale(*s.CouponRate))
+	}
+	if s.CouponPaymentDate != nil {
+		m.SetCouponPaymentDate(*s.CouponPaymentDate)
+	}
+	if s.IssueDate != nil {
+		m.SetIssueDate(*s.IssueDate)
+	}
+	if s.RepurchaseTerm != nil {
+		m.SetRepurchaseTerm(*s.RepurchaseTerm)
+	}
+	if s.RepurchaseRate != nil {
+		m.SetRepurchaseRate(*s.RepurchaseRate, fix44.DecimalScale(*s.RepurchaseRate))
+	}
+	if s.Factor != nil {
+		m.SetFactor(*s.Factor, fix44.DecimalScale(*s.Factor))
+	}
+	if s.TradeOriginationDate != nil {
+		m.SetTradeOriginationDate(*s.TradeOriginationDate)
+	}
+	if s.ContractMultiplier != nil {
+		m.SetContractMultiplier(*s.ContractMultiplier, fix44.DecimalScale(*s.ContractMultiplier))
+	}
+	if s.NoStipulations != nil {
+		m.SetNoStipulations(components.UnmarshalNoStipulations(s.NoStipulations))
+	}
+	if s.YieldType != nil {
+		m.SetYieldType(*s.YieldType)
+	}
+	if s.Yield != nil {
+		m.SetYield(*s.Yield, fix44.DecimalScale(*s.Yield))
+	}
+	if s.TotalTakedown != nil {
+		m.SetTotalTakedown(*s.TotalTakedown, fix44.DecimalScale(*s.TotalTakedown))
+	}
+	if s.Concession != nil {
+		m.SetConcession(*s.Concession, fix44.DecimalScale(*s.Concession))
+	}
+	if s.RepoCollateralSecurityType != nil {
+		m.SetRepoCollateralSecurityType(*s.RepoCollateralSecurityType)
+	}
+	if s.RedemptionDate != nil {
+		m.SetRedemptionDate(*s.RedemptionDate)
+	}
+	if s.CreditRating != nil {
+		m.SetCreditRating(*s.CreditRating)
+	}
+	if s.TradingSessionID != nil {
+		m.SetTradingSessionID(*s.TradingSessionID)
+	}
+	if s.EncodedIssuerLen != nil {
+		m.SetEncodedIssuerLen(*s.EncodedIssuerLen)
+	}
+	if s.EncodedIssuer != nil {
+		m.SetEncodedIssuer(*s.EncodedIssuer)
+	}
+	if s.EncodedSecurityDescLen != nil {
+		m.SetEncodedSecurityDescLen(*s.EncodedSecurityDescLen)
+	}
+	if s.EncodedSecurityDesc != nil {
+		m.SetEncodedSecurityDesc(*s.EncodedSecurityDesc)
+	}
+	if s.EncodedTextLen != nil {
+		m.SetEncodedTextLen(*s.EncodedTextLen)
+	}
+	if s.EncodedText != nil {
+		m.SetEncodedText(*s.EncodedText)
+	}
+	if s.GrossTradeAmt != nil {
+		m.SetGrossTradeAmt(*s.GrossTradeAmt, fix44.DecimalScale(*s.GrossTradeAmt))
+	}
+	if s.PriceType != nil {
+		m.SetPriceType(*s.PriceType)
+	}
+	if s.NoPartyIDs != nil {
+		m.SetNoPartyIDs(components.UnmarshalNoPartyIDs(s.NoPartyIDs))
+	}
+	if s.NoSecurityAltID != nil {
+		m.SetNoSecurityAltID(components.UnmarshalNoSecurityAltID(s.NoSecurityAltID))
+	}
+	if s.Product != nil {
+		m.SetProduct(*s.Product)
+	}
+	if s.CFICode != nil {
+		m.SetCFICode(*s.CFICode)
+	}
+	if s.BookingRefID != nil {
+		m.SetBookingRefID(*s.BookingRefID)
+	}
+	if s.CountryOfIssue != nil {
+		m.SetCountryOfIssue(*s.CountryOfIssue)
+	}
+	if s.StateOrProvinceOfIssue != nil {
+		m.SetStateOrProvinceOfIssue(*s.StateOrProvinceOfIssue)
+	}
+	if s.LocaleOfIssue != nil {
+		m.SetLocaleOfIssue(*s.LocaleOfIssue)
+	}
+	if s.TotalAccruedInterestAmt != nil {
+		m.SetTotalAccruedInterestAmt(*s.TotalAccruedInterestAmt, fix44.DecimalScale(*s.TotalAccruedInterestAmt))
+	}
+	if s.MaturityDate != nil {
+		m.SetMaturityDate(*s.MaturityDate)
+	}
+	if s.InstrRegistry != nil {
+		m.SetInstrRegistry(*s.InstrRegistry)
+	}
+	if s.NoLegs != nil {
+		m.SetNoLegs(UnmarshalNoLegs(s.NoLegs))
+	}
+	if s.PreviouslyReported != nil {
+		m.SetPreviouslyReported(*s.PreviouslyReported)
+	}
+	if s.MatchType != nil {
+		m.SetMatchType(*s.MatchType)
+	}
+	if s.TradingSessionSubID != nil {
+		m.SetTradingSessionSubID(*s.TradingSessionSubID)
+	}
+	if s.LegalConfirm != nil {
+		m.SetLegalConfirm(*s.LegalConfirm)
+	}
+	if s.BenchmarkPrice != nil {
+		m.SetBenchmarkPrice(*s.BenchmarkPrice, fix44.DecimalScale(*s.BenchmarkPrice))
+	}
+	if s.BenchmarkPriceType != nil {
+		m.SetBenchmarkPriceType(*s.BenchmarkPriceType)
+	}
+	if s.ContractSettlMonth != nil {
+		m.SetContractSettlMonth(*s.ContractSettlMonth)
+	}
+	if s.DeliveryForm != nil {
+		m.SetDeliveryForm(*s.DeliveryForm)
+	}
+	if s.Pool != nil {
+		m.SetPool(*s.Pool)
+	}
+	if s.YieldRedemptionDate != nil {
+		m.SetYieldRedemptionDate(*s.YieldRedemptionDate)
+	}
+	if s.YieldRedemptionPrice != nil {
+		m.SetYieldRedemptionPrice(*s.YieldRedemptionPrice, fix44.DecimalScale(*s.YieldRedemptionPrice))
+	}
+	if s.YieldRedemptionPriceType != nil {
+		m.SetYieldRedemptionPriceType(*s.YieldRedemptionPriceType)
+	}
+	if s.BenchmarkSecurityID != nil {
+		m.SetBenchmarkSecurityID(*s.BenchmarkSecurityID)
+	}
+	if s.ReversalIndicator != nil {
+		m.SetReversalIndicator(*s.ReversalIndicator)
+	}
+	if s.YieldCalcDate != nil {
+		m.SetYieldCalcDate(*s.YieldCalcDate)
+	}
+	if s.NoUnderlyings != nil {
+		m.SetNoUnderlyings(UnmarshalNoUnderlyings(s.NoUnderlyings))
+	}
+	if s.InterestAtMaturity != nil {
+		m.SetInterestAtMaturity(*s.InterestAtMaturity, fix44.DecimalScale(*s.InterestAtMaturity))
+	}
+	if s.AutoAcceptIndicator != nil {
+		m.SetAutoAcceptIndicator(*s.AutoAcceptIndicator)
+	}
+	if s.BenchmarkSecurityIDSource != nil {
+		m.SetBenchmarkSecurityIDSource(*s.BenchmarkSecurityIDSource)
+	}
+	if s.SecuritySubType != nil {
+		m.SetSecuritySubType(*s.SecuritySubType)
+	}
+	if s.BookingType != nil {
+		m.SetBookingType(*s.BookingType)
+	}
+	if s.TerminationType != nil {
+		m.SetTerminationType(*s.TerminationType)
+	}
+	if s.SecondaryAllocID != nil {
+		m.SetSecondaryAllocID(*s.SecondaryAllocID)
+	}
+	if s.AllocCancReplaceReason != nil {
+		m.SetAllocCancReplaceReason(*s.AllocCancReplaceReason)
+	}
+	if s.AllocIntermedReqType != nil {
+		m.SetAllocIntermedReqType(*s.AllocIntermedReqType)
+	}
+	if s.QtyType != nil {
+		m.SetQtyType(*s.QtyType)
+	}
+	if s.AvgParPx != nil {
+		m.SetAvgParPx(*s.AvgParPx, fix44.DecimalScale(*s.AvgParPx))
+	}
+	if s.NoEvents != nil {
+		m.SetNoEvents(components.UnmarshalNoEvents(s.NoEvents))
+	}
+	if s.PctAtRisk != nil {
+		m.SetPctAtRisk(*s.PctAtRisk, fix44.DecimalScale(*s.PctAtRisk))
+	}
+	if s.NoInstrAttrib != nil {
+		m.SetNoInstrAttrib(UnmarshalNoInstrAttrib(s.NoInstrAttrib))
+	}
+	if s.DatedDate != nil {
+		m.SetDatedDate(*s.DatedDate)
+	}
+	if s.InterestAccrualDate != nil {
+		m.SetInterestAccrualDate(*s.InterestAccrualDate)
+	}
+	if s.CPProgram != nil {
+		m.SetCPProgram(*s.CPProgram)
+	}
+	if s.CPRegType != nil {
+		m.SetCPRegType(*s.CPRegType)
+	}
+	if s.TotNoAllocs != nil {
+		m.SetTotNoAllocs(*s.TotNoAllocs)
+	}
+	if s.LastFragment != nil {
+		m.SetLastFragment(*s.LastFragment)
+	}
+	if s.MarginRatio != nil {
+		m.SetMarginRatio(*s.MarginRatio, fix44.DecimalScale(*s.MarginRatio))
+	}
+	if s.AgreementDesc != nil {
+		m.SetAgreementDesc(*s.AgreementDesc)
+	}
+	if s.AgreementID != nil {
+		m.SetAgreementID(*s.AgreementID)
+	}
+	if s.AgreementDate != nil {
+		m.SetAgreementDate(*s.AgreementDate)
+	}
+	if s.StartDate != nil {
+		m.SetStartDate(*s.StartDate)
+	}
+	if s.EndDate != nil {
+		m.SetEndDate(*s.EndDate)
+	}
+	if s.AgreementCurrency != nil {
+		m.SetAgreementCurrency(*s.AgreementCurrency)
+	}
+	if s.DeliveryType != nil {
+		m.SetDeliveryType(*s.DeliveryType)
+	}
+	if s.EndAccruedInterestAmt != nil {
+		m.SetEndAccruedInterestAmt(*s.EndAccruedInterestAmt, fix44.DecimalScale(*s.EndAccruedInterestAmt))
+	}
+	if s.StartCash != nil {
+		m.SetStartCash(*s.StartCash, fix44.DecimalScale(*s.StartCash))
+	}
+	if s.EndCash != nil {
+		m.SetEndCash(*s.EndCash, fix44.DecimalScale(*s.EndCash))
+	}
+	if s.StrikeCurrency != nil {
+		m.SetStrikeCurrency(*s.StrikeCurrency)
+	}
+	return m
+}
+
+//NoOrdersStruct is a plain Go representation of a NoOrders group element, optional fields are nil when absent
+type NoOrdersStruct struct {
+	ClOrdID           *string
+	OrderID           *string
+	SecondaryOrderID  *string
+	SecondaryClOrdID  *string
+	ListID            *string
+	NoNested2PartyIDs []NoNested2PartyIDsStruct
+	OrderQty          *decimal.Decimal
+	OrderAvgPx        *decimal.Decimal
+	OrderBookingQty   *decimal.Decimal
+}
+
+//MarshalNoOrders copies the elements of g into a slice of NoOrdersStruct
+func MarshalNoOrders(g NoOrdersRepeatingGroup) ([]NoOrdersStruct, quickfix.MessageRejectError) {
+	s := make([]NoOrdersStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasClOrdID() {
+			v, err := m.GetClOrdID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].ClOrdID = &v
+		}
+		if m.HasOrderID() {
+			v, err := m.GetOrderID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].OrderID = &v
+		}
+		if m.HasSecondaryOrderID() {
+			v, err := m.GetSecondaryOrderID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SecondaryOrderID = &v
+		}
+		if m.HasSecondaryClOrdID() {
+			v, err := m.GetSecondaryClOrdID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SecondaryClOrdID = &v
+		}
+		if m.HasListID() {
+			v, err := m.GetListID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].ListID = &v
+		}
+		if m.HasNoNested2PartyIDs() {
+			g, err := m.GetNoNested2PartyIDs()
+			if err != nil {
+				return nil, err
+			}
+			if s[i].NoNested2PartyIDs, err = MarshalNoNested2PartyIDs(g); err != nil {
+				return nil, err
+			}
+		}
+		if m.HasOrderQty() {
+			v, err := m.GetOrderQty()
+			if err != nil {
+				return nil, err
+			}
+			s[i].OrderQty = &v
+		}
+		if m.HasOrderAvgPx() {
+			v, err := m.GetOrderAvgPx()
+			if err != nil {
+				return nil, err
+			}
+			s[i].OrderAvgPx = &v
+		}
+		if m.HasOrderBookingQty() {
+			v, err := m.GetOrderBookingQty()
+			if err != nil {
+				return nil, err
+			}
+			s[i].OrderBookingQty = &v
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoOrders builds a NoOrdersRepeatingGroup from s
+func UnmarshalNoOrders(s []NoOrdersStruct) NoOrdersRepeatingGroup {
+	g := NewNoOrdersRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.ClOrdID != nil {
+			m.SetClOrdID(*e.ClOrdID)
+		}
+		if e.OrderID != nil {
+			m.SetOrderID(*e.OrderID)
+		}
+		if e.SecondaryOrderID != nil {
+			m.SetSecondaryOrderID(*e.SecondaryOrderID)
+		}
+		if e.SecondaryClOrdID != nil {
+			m.SetSecondaryClOrdID(*e.SecondaryClOrdID)
+		}
+		if e.ListID != nil {
+			m.SetListID(*e.ListID)
+		}
+		if e.NoNested2PartyIDs != nil {
+			m.SetNoNested2PartyIDs(UnmarshalNoNested2PartyIDs(e.NoNested2PartyIDs))
+		}
+		if e.OrderQty != nil {
+			m.SetOrderQty(*e.OrderQty, fix44.DecimalScale(*e.OrderQty))
+		}
+		if e.OrderAvgPx != nil {
+			m.SetOrderAvgPx(*e.OrderAvgPx, fix44.DecimalScale(*e.OrderAvgPx))
+		}
+		if e.OrderBookingQty != nil {
+			m.SetOrderBookingQty(*e.OrderBookingQty, fix44.DecimalScale(*e.OrderBookingQty))
+		}
+	}
+	return g
+}
+
+//NoNested2PartyIDsStruct is a plain Go representation of a NoNested2PartyIDs group element, optional fields are nil when absent
+type NoNested2PartyIDsStruct struct {
+	Nested2PartyID       *string
+	Nested2PartyIDSource *string
+	Nested2PartyRole     *int
+	NoNested2PartySubIDs []NoNested2PartySubIDsStruct
+}
+
+//MarshalNoNested2PartyIDs copies the elements of g into a slice of NoNested2PartyIDsStruct
+func MarshalNoNested2PartyIDs(g NoNested2PartyIDsRepeatingGroup) ([]NoNested2PartyIDsStruct, quickfix.MessageRejectError) {
+	s := make([]NoNested2PartyIDsStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasNested2PartyID() {
+			v, err := m.GetNested2PartyID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].Nested2PartyID = &v
+		}
+		if m.HasNested2PartyIDSource() {
+			v, err := m.GetNested2PartyIDSource()
+			if err != nil {
+				return nil, err
+			}
+			s[i].Nested2PartyIDSource = &v
+		}
+		if m.HasNested2PartyRole() {
+			v, err := m.GetNested2PartyRole()
+			if err != nil {
+				return nil, err
+			}
+			s[i].Nested2PartyRole = &v
+		}
+		if m.HasNoNested2PartySubIDs() {
+			g, err := m.GetNoNested2PartySubIDs()
+			if err != nil {
+				return nil, err
+			}
+			if s[i].NoNested2PartySubIDs, err = MarshalNoNested2PartySubIDs(g); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoNested2PartyIDs builds a NoNested2PartyIDsRepeatingGroup from s
+func UnmarshalNoNested2PartyIDs(s []NoNested2PartyIDsStruct) NoNested2PartyIDsRepeatingGroup {
+	g := NewNoNested2PartyIDsRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.Nested2PartyID != nil {
+			m.SetNested2PartyID(*e.Nested2PartyID)
+		}
+		if e.Nested2PartyIDSource != nil {
+			m.SetNested2PartyIDSource(*e.Nested2PartyIDSource)
+		}
+		if e.Nested2PartyRole != nil {
+			m.SetNested2PartyRole(*e.Nested2PartyRole)
+		}
+		if e.NoNested2PartySubIDs != nil {
+			m.SetNoNested2PartySubIDs(UnmarshalNoNested2PartySubIDs(e.NoNested2PartySubIDs))
+		}
+	}
+	return g
+}
+
+//NoNested2PartySubIDsStruct is a plain Go representation of a NoNested2PartySubIDs group element, optional fields are nil when absent
+type NoNested2PartySubIDsStruct struct {
+	Nested2PartySubID     *string
+	Nested2PartySubIDType *int
+}
+
+//MarshalNoNested2PartySubIDs copies the elements of g into a slice of NoNested2PartySubIDsStruct
+func MarshalNoNested2PartySubIDs(g NoNested2PartySubIDsRepeatingGroup) ([]NoNested2PartySubIDsStruct, quickfix.MessageRejectError) {
+	s := make([]NoNested2PartySubIDsStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasNested2PartySubID() {
+			v, err := m.GetNested2PartySubID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].Nested2PartySubID = &v
+		}
+		if m.HasNested2PartySubIDType() {
+			v, err := m.GetNested2PartySubIDType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].Nested2PartySubIDType = &v
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoNested2PartySubIDs builds a NoNested2PartySubIDsRepeatingGroup from s
+func UnmarshalNoNested2PartySubIDs(s []NoNested2PartySubIDsStruct) NoNested2PartySubIDsRepeatingGroup {
+	g := NewNoNested2PartySubIDsRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.Nested2PartySubID != nil {
+			m.SetNested2PartySubID(*e.Nested2PartySubID)
+		}
+		if e.Nested2PartySubIDType != nil {
+			m.SetNested2PartySubIDType(*e.Nested2PartySubIDType)
+		}
+	}
+	return g
+}
+
+//NoAllocsStruct is a plain Go representation of a NoAllocs group element, optional fields are nil when absent
+type NoAllocsStruct struct {
+	AllocAccount            *string
+	AllocAcctIDSource       *int
+	MatchStatus             *enum.MatchStatus
+	AllocPrice              *decimal.Decimal
+	AllocQty                *decimal.Decimal
+	IndividualAllocID       *string
+	ProcessCode             *enum.ProcessCode
+	NoNestedPartyIDs        []NoNestedPartyIDsStruct
+	NotifyBrokerOfCredit    *bool
+	AllocHandlInst          *enum.AllocHandlInst
+	AllocText               *string
+	EncodedAllocTextLen     *int
+	EncodedAllocText        *string
+	Commission              *decimal.Decimal
+	CommType                *enum.CommType
+	CommCurrency            *string
+	FundRenewWaiv           *enum.FundRenewWaiv
+	AllocAvgPx              *decimal.Decimal
+	AllocNetMoney           *decimal.Decimal
+	SettlCurrAmt            *decimal.Decimal
+	AllocSettlCurrAmt       *decimal.Decimal
+	SettlCurrency           *string
+	AllocSettlCurrency      *string
+	SettlCurrFxRate         *decimal.Decimal
+	SettlCurrFxRateCalc     *enum.SettlCurrFxRateCalc
+	AllocAccruedInterestAmt *decimal.Decimal
+	AllocInterestAtMaturity *decimal.Decimal
+	NoMiscFees              []NoMiscFeesStruct
+	NoClearingInstructions  []NoClearingInstructionsStruct
+	ClearingFeeIndicator    *enum.ClearingFeeIndicator
+	AllocSettlInstType      *enum.AllocSettlInstType
+	SettlDeliveryType       *enum.SettlDeliveryType
+	StandInstDbType         *enum.StandInstDbType
+	StandInstDbName         *string
+	StandInstDbID           *string
+	NoDlvyInst              []NoDlvyInstStruct
+}
+
+//MarshalNoAllocs copies the elements of g into a slice of NoAllocsStruct
+func MarshalNoAllocs(g NoAllocsRepeatingGroup) ([]NoAllocsStruct, quickfix.MessageRejectError) {
+	s := make([]NoAllocsStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasAllocAccount() {
+			v, err := m.GetAllocAccount()
+			if err != nil {
+				return nil, err
+			}
+			s[i].AllocAccount = &v
+		}
+		if m.HasAllocAcctIDSource() {
+			v, err := m.GetAllocAcctIDSource()
+			if err != nil {
+				return nil, err
+			}
+			s[i].AllocAcctIDSource = &v
+		}
+		if m.HasMatchStatus() {
+			v, err := m.GetMatchStatus()
+			if err != nil {
+				return nil, err
+			}
+			s[i].MatchStatus = &v
+		}
+		if m.HasAllocPrice() {
+			v, err := m.GetAllocPrice()
+			if err != nil {
+				return nil, err
+			}
+			s[i].AllocPrice = &v
+		}
+		if m.HasAllocQty() {
+			v, err := m.GetAllocQty()
+			if err != nil {
+				return nil, err
+			}
+			s[i].AllocQty = &v
+		}
+		if m.HasIndividualAllocID() {
+			v, err := m.GetIndividualAllocID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].IndividualAllocID = &v
+		}
+		if m.HasProcessCode() {
+			v, err := m.GetProcessCode()
+			if err != nil {
+				return nil, err
+			}
+			s[i].ProcessCode = &v
+		}
+		if m.HasNoNestedPartyIDs() {
+			g, err := m.GetNoNestedPartyIDs()
+			if err != nil {
+				return nil, err
+			}
+			if s[i].NoNestedPartyIDs, err = components.MarshalNoNestedPartyIDs(g); err != nil {
+				return nil, err
+			}
+		}
+		if m.HasNotifyBrokerOfCredit() {
+			v, err := m.GetNotifyBrokerOfCredit()
+			if err != nil {
+				return nil, err
+			}
+			s[i].NotifyBrokerOfCredit = &v
+		}
+		if m.HasAllocHandlInst() {
+			v, err := m.GetAllocHandlInst()
+			if err != nil {
+				return nil, err
+			}
+			s[i].AllocHandlInst = &v
+		}
+		if m.HasAllocText() {
+			v, err := m.GetAllocText()
+			if err != nil {
+				return nil, err
+			}
+			s[i].AllocText = &v
+		}
+		if m.HasEncodedAllocTextLen() {
+			v, err := m.GetEncodedAllocTextLen()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedAllocTextLen = &v
+		}
+		if m.HasEncodedAllocText() {
+			v, err := m.GetEncodedAllocText()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedAllocText = &v
+		}
+		if m.HasCommission() {
+			v, err := m.GetCommission()
+			if err != nil {
+				return nil, err
+			}
+			s[i].Commission = &v
+		}
+		if m.HasCommType() {
+			v, err := m.GetCommType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].CommType = &v
+		}
+		if m.HasCommCurrency() {
+			v, err := m.GetCommCurrency()
+			if err != nil {
+				return nil, err
+			}
+			s[i].CommCurrency = &v
+		}
+		if m.HasFundRenewWaiv() {
+			v, err := m.GetFundRenewWaiv()
+			if err != nil {
+				return nil, err
+			}
+			s[i].FundRenewWaiv = &v
+		}
+		if m.HasAllocAvgPx() {
+			v, err := m.GetAllocAvgPx()
+			if err != nil {
+				return nil, err
+			}
+			s[i].AllocAvgPx = &v
+		}
+		if m.HasAllocNetMoney() {
+			v, err := m.GetAllocNetMoney()
+			if err != nil {
+				return nil, err
+			}
+			s[i].AllocNetMoney = &v
+		}
+		if m.HasSettlCurrAmt() {
+			v, err := m.GetSettlCurrAmt()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SettlCurrAmt = &v
+		}
+		if m.HasAllocSettlCurrAmt() {
+			v, err := m.GetAllocSettlCurrAmt()
+			if err != nil {
+				return nil, err
+			}
+			s[i].AllocSettlCurrAmt = &v
+		}
+		if m.HasSettlCurrency() {
+			v, err := m.GetSettlCurrency()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SettlCurrency = &v
+		}
+		if m.HasAllocSettlCurrency() {
+			v, err := m.GetAllocSettlCurrency()
+			if err != nil {
+				return nil, err
+			}
+			s[i].AllocSettlCurrency = &v
+		}
+		if m.HasSettlCurrFxRate() {
+			v, err := m.GetSettlCurrFxRate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SettlCurrFxRate = &v
+		}
+		if m.HasSettlCurrFxRateCalc() {
+			v, err := m.GetSettlCurrFxRateCalc()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SettlCurrFxRateCalc = &v
+		}
+		if m.HasAllocAccruedInterestAmt() {
+			v, err := m.GetAllocAccruedInterestAmt()
+			if err != nil {
+				return nil, err
+			}
+			s[i].AllocAccruedInterestAmt = &v
+		}
+		if m.HasAllocInterestAtMaturity() {
+			v, err := m.GetAllocInterestAtMaturity()
+			if err != nil {
+				return nil, err
+			}
+			s[i].AllocInterestAtMaturity = &v
+		}
+		if m.HasNoMiscFees() {
+			g, err := m.GetNoMiscFees()
+			if err != nil {
+				return nil, err
+			}
+			if s[i].NoMiscFees, err = MarshalNoMiscFees(g); err != nil {
+				return nil, err
+			}
+		}
+		if m.HasNoClearingInstructions() {
+			g, err := m.GetNoClearingInstructions()
+			if err != nil {
+				return nil, err
+			}
+			if s[i].NoClearingInstructions, err = MarshalNoClearingInstructions(g); err != nil {
+				return nil, err
+			}
+		}
+		if m.HasClearingFeeIndicator() {
+			v, err := m.GetClearingFeeIndicator()
+			if err != nil {
+				return nil, err
+			}
+			s[i].ClearingFeeIndicator = &v
+		}
+		if m.HasAllocSettlInstType() {
+			v, err := m.GetAllocSettlInstType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].AllocSettlInstType = &v
+		}
+		if m.HasSettlDeliveryType() {
+			v, err := m.GetSettlDeliveryType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SettlDeliveryType = &v
+		}
+		if m.HasStandInstDbType() {
+			v, err := m.GetStandInstDbType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].StandInstDbType = &v
+		}
+		if m.HasStandInstDbName() {
+			v, err := m.GetStandInstDbName()
+			if err != nil {
+				return nil, err
+			}
+			s[i].StandInstDbName = &v
+		}
+		if m.HasStandInstDbID() {
+			v, err := m.GetStandInstDbID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].StandInstDbID = &v
+		}
+		if m.HasNoDlvyInst() {
+			g, err := m.GetNoDlvyInst()
+			if err != nil {
+				return nil, err
+			}
+			if s[i].NoDlvyInst, err = MarshalNoDlvyInst(g); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoAllocs builds a NoAllocsRepeatingGroup from s
+func UnmarshalNoAllocs(s []NoAllocsStruct) NoAllocsRepeatingGroup {
+	g := NewNoAllocsRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.AllocAccount != nil {
+			m.SetAllocAccount(*e.AllocAccount)
+		}
+		if e.AllocAcctIDSource != nil {
+			m.SetAllocAcctIDSource(*e.AllocAcctIDSource)
+		}
+		if e.MatchStatus != nil {
+			m.SetMatchStatus(*e.MatchStatus)
+		}
+		if e.AllocPrice != nil {
+			m.SetAllocPrice(*e.AllocPrice, fix44.DecimalScale(*e.AllocPrice))
+		}
+		if e.AllocQty != nil {
+			m.SetAllocQty(*e.AllocQty, fix44.DecimalScale(*e.AllocQty))
+		}
+		if e.IndividualAllocID != nil {
+			m.SetIndividualAllocID(*e.IndividualAllocID)
+		}
+		if e.ProcessCode != nil {
+			m.SetProcessCode(*e.ProcessCode)
+		}
+		if e.NoNestedPartyIDs != nil {
+			m.SetNoNestedPartyIDs(components.UnmarshalNoNestedPartyIDs(e.NoNestedPartyIDs))
+		}
+		if e.NotifyBrokerOfCredit != nil {
+			m.SetNotifyBrokerOfCredit(*e.NotifyBrokerOfCredit)
+		}
+		if e.AllocHandlInst != nil {
+			m.SetAllocHandlInst(*e.AllocHandlInst)
+		}
+		if e.AllocText != nil {
+			m.SetAllocText(*e.AllocText)
+		}
+		if e.EncodedAllocTextLen != nil {
+			m.SetEncodedAllocTextLen(*e.EncodedAllocTextLen)
+		}
+		if e.EncodedAllocText != nil {
+			m.SetEncodedAllocText(*e.EncodedAllocText)
+		}
+		if e.Commission != nil {
+			m.SetCommission(*e.Commission, fix44.DecimalScale(*e.Commission))
+		}
+		if e.CommType != nil {
+			m.SetCommType(*e.CommType)
+		}
+		if e.CommCurrency != nil {
+			m.SetCommCurrency(*e.CommCurrency)
+		}
+		if e.FundRenewWaiv != nil {
+			m.SetFundRenewWaiv(*e.FundRenewWaiv)
+		}
+		if e.AllocAvgPx != nil {
+			m.SetAllocAvgPx(*e.AllocAvgPx, fix44.DecimalScale(*e.AllocAvgPx))
+		}
+		if e.AllocNetMoney != nil {
+			m.SetAllocNetMoney(*e.AllocNetMoney, fix44.DecimalScale(*e.AllocNetMoney))
+		}
+		if e.SettlCurrAmt != nil {
+			m.SetSettlCurrAmt(*e.SettlCurrAmt, fix44.DecimalScale(*e.SettlCurrAmt))
+		}
+		if e.AllocSettlCurrAmt != nil {
+			m.SetAllocSettlCurrAmt(*e.AllocSettlCurrAmt, fix44.DecimalScale(*e.AllocSettlCurrAmt))
+		}
+		if e.SettlCurrency != nil {
+			m.SetSettlCurrency(*e.SettlCurrency)
+		}
+		if e.AllocSettlCurrency != nil {
+			m.SetAllocSettlCurrency(*e.AllocSettlCurrency)
+		}
+		if e.SettlCurrFxRate != nil {
+			m.SetSettlCurrFxRate(*e.SettlCurrFxRate, fix44.DecimalScale(*e.SettlCurrFxRate))
+		}
+		if e.SettlCurrFxRateCalc != nil {
+			m.SetSettlCurrFxRateCalc(*e.SettlCurrFxRateCalc)
+		}
+		if e.AllocAccruedInterestAmt != nil {
+			m.SetAllocAccruedInterestAmt(*e.AllocAccruedInterestAmt, fix44.DecimalScale(*e.AllocAccruedInterestAmt))
+		}
+		if e.AllocInterestAtMaturity != nil {
+			m.SetAllocInterestAtMaturity(*e.AllocInterestAtMaturity, fix44.DecimalScale(*e.AllocInterestAtMaturity))
+		}
+		if e.NoMiscFees != nil {
+			m.SetNoMiscFees(UnmarshalNoMiscFees(e.NoMiscFees))
+		}
+		if e.NoClearingInstructions != nil {
+			m.SetNoClearingInstructions(UnmarshalNoClearingInstructions(e.NoClearingInstructions))
+		}
+		if e.ClearingFeeIndicator != nil {
+			m.SetClearingFeeIndicator(*e.ClearingFeeIndicator)
+		}
+		if e.AllocSettlInstType != nil {
+			m.SetAllocSettlInstType(*e.AllocSettlInstType)
+		}
+		if e.SettlDeliveryType != nil {
+			m.SetSettlDeliveryType(*e.SettlDeliveryType)
+		}
+		if e.StandInstDbType != nil {
+			m.SetStandInstDbType(*e.StandInstDbType)
+		}
+		if e.StandInstDbName != nil {
+			m.SetStandInstDbName(*e.StandInstDbName)
+		}
+		if e.StandInstDbID != nil {
+			m.SetStandInstDbID(*e.StandInstDbID)
+		}
+		if e.NoDlvyInst != nil {
+			m.SetNoDlvyInst(UnmarshalNoDlvyInst(e.NoDlvyInst))
+		}
+	}
+	return g
+}
+
+//NoMiscFeesStruct is a plain Go representation of a NoMiscFees group element, optional fields are nil when absent
+type NoMiscFeesStruct struct {
+	MiscFeeAmt   *decimal.Decimal
+	MiscFeeCurr  *string
+	MiscFeeType  *enum.MiscFeeType
+	MiscFeeBasis *enum.MiscFeeBasis
+}
+
+//MarshalNoMiscFees copies the elements of g into a slice of NoMiscFeesStruct
+func MarshalNoMiscFees(g NoMiscFeesRepeatingGroup) ([]NoMiscFeesStruct, quickfix.MessageRejectError) {
+	s := make([]NoMiscFeesStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasMiscFeeAmt() {
+			v, err := m.GetMiscFeeAmt()
+			if err != nil {
+				return nil, err
+			}
+			s[i].MiscFeeAmt = &v
+		}
+		if m.HasMiscFeeCurr() {
+			v, err := m.GetMiscFeeCurr()
+			if err != nil {
+				return nil, err
+			}
+			s[i].MiscFeeCurr = &v
+		}
+		if m.HasMiscFeeType() {
+			v, err := m.GetMiscFeeType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].MiscFeeType = &v
+		}
+		if m.HasMiscFeeBasis() {
+			v, err := m.GetMiscFeeBasis()
+			if err != nil {
+				return nil, err
+			}
+			s[i].MiscFeeBasis = &v
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoMiscFees builds a NoMiscFeesRepeatingGroup from s
+func UnmarshalNoMiscFees(s []NoMiscFeesStruct) NoMiscFeesRepeatingGroup {
+	g := NewNoMiscFeesRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.MiscFeeAmt != nil {
+			m.SetMiscFeeAmt(*e.MiscFeeAmt, fix44.DecimalScale(*e.MiscFeeAmt))
+		}
+		if e.MiscFeeCurr != nil {
+			m.SetMiscFeeCurr(*e.MiscFeeCurr)
+		}
+		if e.MiscFeeType != nil {
+			m.SetMiscFeeType(*e.MiscFeeType)
+		}
+		if e.MiscFeeBasis != nil {
+			m.SetMiscFeeBasis(*e.MiscFeeBasis)
+		}
+	}
+	return g
+}
+
+//NoClearingInstructionsStruct is a plain Go representation of a NoClearingInstructions group element, optional fields are nil when absent
+type NoClearingInstructionsStruct struct {
+	ClearingInstruction *enum.ClearingInstruction
+}
+
+//MarshalNoClearingInstructions copies the elements of g into a slice of NoClearingInstructionsStruct
+func MarshalNoClearingInstructions(g NoClearingInstructionsRepeatingGroup) ([]NoClearingInstructionsStruct, quickfix.MessageRejectError) {
+	s := make([]NoClearingInstructionsStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasClearingInstruction() {
+			v, err := m.GetClearingInstruction()
+			if err != nil {
+				return nil, err
+			}
+			s[i].ClearingInstruction = &v
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoClearingInstructions builds a NoClearingInstructionsRepeatingGroup from s
+func UnmarshalNoClearingInstructions(s []NoClearingInstructionsStruct) NoClearingInstructionsRepeatingGroup {
+	g := NewNoClearingInstructionsRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.ClearingInstruction != nil {
+			m.SetClearingInstruction(*e.ClearingInstruction)
+		}
+	}
+	return g
+}
+
+//NoDlvyInstStruct is a plain Go representation of a NoDlvyInst group element, optional fields are nil when absent
+type NoDlvyInstStruct struct {
+	SettlInstSource *enum.SettlInstSource
+	DlvyInstType    *enum.DlvyInstType
+	NoSettlPartyIDs []NoSettlPartyIDsStruct
+}
+
+//MarshalNoDlvyInst copies the elements of g into a slice of NoDlvyInstStruct
+func MarshalNoDlvyInst(g NoDlvyInstRepeatingGroup) ([]NoDlvyInstStruct, quickfix.MessageRejectError) {
+	s := make([]NoDlvyInstStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasSettlInstSource() {
+			v, err := m.GetSettlInstSource()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SettlInstSource = &v
+		}
+		if m.HasDlvyInstType() {
+			v, err := m.GetDlvyInstType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].DlvyInstType = &v
+		}
+		if m.HasNoSettlPartyIDs() {
+			g, err := m.GetNoSettlPartyIDs()
+			if err != nil {
+				return nil, err
+			}
+			if s[i].NoSettlPartyIDs, err = MarshalNoSettlPartyIDs(g); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoDlvyInst builds a NoDlvyInstRepeatingGroup from s
+func UnmarshalNoDlvyInst(s []NoDlvyInstStruct) NoDlvyInstRepeatingGroup {
+	g := NewNoDlvyInstRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.SettlInstSource != nil {
+			m.SetSettlInstSource(*e.SettlInstSource)
+		}
+		if e.DlvyInstType != nil {
+			m.SetDlvyInstType(*e.DlvyInstType)
+		}
+		if e.NoSettlPartyIDs != nil {
+			m.SetNoSettlPartyIDs(UnmarshalNoSettlPartyIDs(e.NoSettlPartyIDs))
+		}
+	}
+	return g
+}
+
+//NoSettlPartyIDsStruct is a plain Go representation of a NoSettlPartyIDs group element, optional fields are nil when absent
+type NoSettlPartyIDsStruct struct {
+	SettlPartyID       *string
+	SettlPartyIDSource *string
+	SettlPartyRole     *int
+	NoSettlPartySubIDs []NoSettlPartySubIDsStruct
+}
+
+//MarshalNoSettlPartyIDs copies the elements of g into a slice of NoSettlPartyIDsStruct
+func MarshalNoSettlPartyIDs(g NoSettlPartyIDsRepeatingGroup) ([]NoSettlPartyIDsStruct, quickfix.MessageRejectError) {
+	s := make([]NoSettlPartyIDsStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasSettlPartyID() {
+			v, err := m.GetSettlPartyID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SettlPartyID = &v
+		}
+		if m.HasSettlPartyIDSource() {
+			v, err := m.GetSettlPartyIDSource()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SettlPartyIDSource = &v
+		}
+		if m.HasSettlPartyRole() {
+			v, err := m.GetSettlPartyRole()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SettlPartyRole = &v
+		}
+		if m.HasNoSettlPartySubIDs() {
+			g, err := m.GetNoSettlPartySubIDs()
+			if err != nil {
+				return nil, err
+			}
+			if s[i].NoSettlPartySubIDs, err = MarshalNoSettlPartySubIDs(g); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoSettlPartyIDs builds a NoSettlPartyIDsRepeatingGroup from s
+func UnmarshalNoSettlPartyIDs(s []NoSettlPartyIDsStruct) NoSettlPartyIDsRepeatingGroup {
+	g := NewNoSettlPartyIDsRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.SettlPartyID != nil {
+			m.SetSettlPartyID(*e.SettlPartyID)
+		}
+		if e.SettlPartyIDSource != nil {
+			m.SetSettlPartyIDSource(*e.SettlPartyIDSource)
+		}
+		if e.SettlPartyRole != nil {
+			m.SetSettlPartyRole(*e.SettlPartyRole)
+		}
+		if e.NoSettlPartySubIDs != nil {
+			m.SetNoSettlPartySubIDs(UnmarshalNoSettlPartySubIDs(e.NoSettlPartySubIDs))
+		}
+	}
+	return g
+}
+
+//NoSettlPartySubIDsStruct is a plain Go representation of a NoSettlPartySubIDs group element, optional fields are nil when absent
+type NoSettlPartySubIDsStruct struct {
+	SettlPartySubID     *string
+	SettlPartySubIDType *int
+}
+
+//MarshalNoSettlPartySubIDs copies the elements of g into a slice of NoSettlPartySubIDsStruct
+func MarshalNoSettlPartySubIDs(g NoSettlPartySubIDsRepeatingGroup) ([]NoSettlPartySubIDsStruct, quickfix.MessageRejectError) {
+	s := make([]NoSettlPartySubIDsStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasSettlPartySubID() {
+			v, err := m.GetSettlPartySubID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SettlPartySubID = &v
+		}
+		if m.HasSettlPartySubIDType() {
+			v, err := m.GetSettlPartySubIDType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SettlPartySubIDType = &v
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoSettlPartySubIDs builds a NoSettlPartySubIDsRepeatingGroup from s
+func UnmarshalNoSettlPartySubIDs(s []NoSettlPartySubIDsStruct) NoSettlPartySubIDsRepeatingGroup {
+	g := NewNoSettlPartySubIDsRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.SettlPartySubID != nil {
+			m.SetSettlPartySubID(*e.SettlPartySubID)
+		}
+		if e.SettlPartySubIDType != nil {
+			m.SetSettlPartySubIDType(*e.SettlPartySubIDType)
+		}
+	}
+	return g
+}
+
+//NoExecsStruct is a plain Go representation of a NoExecs group element, optional fields are nil when absent
+type NoExecsStruct struct {
+	LastQty         *decimal.Decimal
+	ExecID          *string
+	SecondaryExecID *string
+	LastPx          *decimal.Decimal
+	LastParPx       *decimal.Decimal
+	LastCapacity    *enum.LastCapacity
+}
+
+//MarshalNoExecs copies the elements of g into a slice of NoExecsStruct
+func MarshalNoExecs(g NoExecsRepeatingGroup) ([]NoExecsStruct, quickfix.MessageRejectError) {
+	s := make([]NoExecsStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasLastQty() {
+			v, err := m.GetLastQty()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LastQty = &v
+		}
+		if m.HasExecID() {
+			v, err := m.GetExecID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].ExecID = &v
+		}
+		if m.HasSecondaryExecID() {
+			v, err := m.GetSecondaryExecID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SecondaryExecID = &v
+		}
+		if m.HasLastPx() {
+			v, err := m.GetLastPx()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LastPx = &v
+		}
+		if m.HasLastParPx() {
+			v, err := m.GetLastParPx()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LastParPx = &v
+		}
+		if m.HasLastCapacity() {
+			v, err := m.GetLastCapacity()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LastCapacity = &v
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoExecs builds a NoExecsRepeatingGroup from s
+func UnmarshalNoExecs(s []NoExecsStruct) NoExecsRepeatingGroup {
+	g := NewNoExecsRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.LastQty != nil {
+			m.SetLastQty(*e.LastQty, fix44.DecimalScale(*e.LastQty))
+		}
+		if e.ExecID != nil {
+			m.SetExecID(*e.ExecID)
+		}
+		if e.SecondaryExecID != nil {
+			m.SetSecondaryExecID(*e.SecondaryExecID)
+		}
+		if e.LastPx != nil {
+			m.SetLastPx(*e.LastPx, fix44.DecimalScale(*e.LastPx))
+		}
+		if e.LastParPx != nil {
+			m.SetLastParPx(*e.LastParPx, fix44.DecimalScale(*e.LastParPx))
+		}
+		if e.LastCapacity != nil {
+			m.SetLastCapacity(*e.LastCapacity)
+		}
+	}
+	return g
+}
+
+//NoLegsStruct is a plain Go representation of a NoLegs group element, optional fields are nil when absent
+type NoLegsStruct struct {
+	LegSymbol                     *string
+	LegSymbolSfx                  *string
+	LegSecurityID                 *string
+	LegSecurityIDSource           *string
+	NoLegSecurityAltID            []NoLegSecurityAltIDStruct
+	LegProduct                    *int
+	LegCFICode                    *string
+	LegSecurityType               *string
+	LegSecuritySubType            *string
+	LegMaturityMonthYear          *string
+	LegMaturityDate               *string
+	LegCouponPaymentDate          *string
+	LegIssueDate                  *string
+	LegRepoCollateralSecurityType *int
+	LegRepurchaseTerm             *int
+	LegRepurchaseRate             *decimal.Decimal
+	LegFactor                     *decimal.Decimal
+	LegCreditRating               *string
+	LegInstrRegistry              *string
+	LegCountryOfIssue             *string
+	LegStateOrProvinceOfIssue     *string
+	LegLocaleOfIssue              *string
+	LegRedemptionDate             *string
+	LegStrikePrice                *decimal.Decimal
+	LegStrikeCurrency             *string
+	LegOptAttribute               *string
+	LegContractMultiplier         *decimal.Decimal
+	LegCouponRate                 *decimal.Decimal
+	LegSecurityExchange           *string
+	LegIssuer                     *string
+	EncodedLegIssuerLen           *int
+	EncodedLegIssuer              *string
+	LegSecurityDesc               *string
+	EncodedLegSecurityDescLen     *int
+	EncodedLegSecurityDesc        *string
+	LegRatioQty                   *decimal.Decimal
+	LegSide                       *string
+	LegCurrency                   *string
+	LegPool                       *string
+	LegDatedDate                  *string
+	LegContractSettlMonth         *string
+	LegInterestAccrualDate        *string
+}
+
+//MarshalNoLegs copies the elements of g into a slice of NoLegsStruct
+func MarshalNoLegs(g NoLegsRepeatingGroup) ([]NoLegsStruct, quickfix.MessageRejectError) {
+	s := make([]NoLegsStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasLegSymbol() {
+			v, err := m.GetLegSymbol()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSymbol = &v
+		}
+		if m.HasLegSymbolSfx() {
+			v, err := m.GetLegSymbolSfx()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSymbolSfx = &v
+		}
+		if m.HasLegSecurityID() {
+			v, err := m.GetLegSecurityID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSecurityID = &v
+		}
+		if m.HasLegSecurityIDSource() {
+			v, err := m.GetLegSecurityIDSource()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSecurityIDSource = &v
+		}
+		if m.HasNoLegSecurityAltID() {
+			g, err := m.GetNoLegSecurityAltID()
+			if err != nil {
+				return nil, err
+			}
+			if s[i].NoLegSecurityAltID, err = components.MarshalNoLegSecurityAltID(g); err != nil {
+				return nil, err
+			}
+		}
+		if m.HasLegProduct() {
+			v, err := m.GetLegProduct()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegProduct = &v
+		}
+		if m.HasLegCFICode() {
+			v, err := m.GetLegCFICode()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegCFICode = &v
+		}
+		if m.HasLegSecurityType() {
+			v, err := m.GetLegSecurityType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSecurityType = &v
+		}
+		if m.HasLegSecuritySubType() {
+			v, err := m.GetLegSecuritySubType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSecuritySubType = &v
+		}
+		if m.HasLegMaturityMonthYear() {
+			v, err := m.GetLegMaturityMonthYear()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegMaturityMonthYear = &v
+		}
+		if m.HasLegMaturityDate() {
+			v, err := m.GetLegMaturityDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegMaturityDate = &v
+		}
+		if m.HasLegCouponPaymentDate() {
+			v, err := m.GetLegCouponPaymentDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegCouponPaymentDate = &v
+		}
+		if m.HasLegIssueDate() {
+			v, err := m.GetLegIssueDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegIssueDate = &v
+		}
+		if m.HasLegRepoCollateralSecurityType() {
+			v, err := m.GetLegRepoCollateralSecurityType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegRepoCollateralSecurityType = &v
+		}
+		if m.HasLegRepurchaseTerm() {
+			v, err := m.GetLegRepurchaseTerm()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegRepurchaseTerm = &v
+		}
+		if m.HasLegRepurchaseRate() {
+			v, err := m.GetLegRepurchaseRate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegRepurchaseRate = &v
+		}
+		if m.HasLegFactor() {
+			v, err := m.GetLegFactor()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegFactor = &v
+		}
+		if m.HasLegCreditRating() {
+			v, err := m.GetLegCreditRating()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegCreditRating = &v
+		}
+		if m.HasLegInstrRegistry() {
+			v, err := m.GetLegInstrRegistry()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegInstrRegistry = &v
+		}
+		if m.HasLegCountryOfIssue() {
+			v, err := m.GetLegCountryOfIssue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegCountryOfIssue = &v
+		}
+		if m.HasLegStateOrProvinceOfIssue() {
+			v, err := m.GetLegStateOrProvinceOfIssue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegStateOrProvinceOfIssue = &v
+		}
+		if m.HasLegLocaleOfIssue() {
+			v, err := m.GetLegLocaleOfIssue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegLocaleOfIssue = &v
+		}
+		if m.HasLegRedemptionDate() {
+			v, err := m.GetLegRedemptionDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegRedemptionDate = &v
+		}
+		if m.HasLegStrikePrice() {
+			v, err := m.GetLegStrikePrice()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegStrikePrice = &v
+		}
+		if m.HasLegStrikeCurrency() {
+			v, err := m.GetLegStrikeCurrency()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegStrikeCurrency = &v
+		}
+		if m.HasLegOptAttribute() {
+			v, err := m.GetLegOptAttribute()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegOptAttribute = &v
+		}
+		if m.HasLegContractMultiplier() {
+			v, err := m.GetLegContractMultiplier()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegContractMultiplier = &v
+		}
+		if m.HasLegCouponRate() {
+			v, err := m.GetLegCouponRate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegCouponRate = &v
+		}
+		if m.HasLegSecurityExchange() {
+			v, err := m.GetLegSecurityExchange()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSecurityExchange = &v
+		}
+		if m.HasLegIssuer() {
+			v, err := m.GetLegIssuer()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegIssuer = &v
+		}
+		if m.HasEncodedLegIssuerLen() {
+			v, err := m.GetEncodedLegIssuerLen()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedLegIssuerLen = &v
+		}
+		if m.HasEncodedLegIssuer() {
+			v, err := m.GetEncodedLegIssuer()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedLegIssuer = &v
+		}
+		if m.HasLegSecurityDesc() {
+			v, err := m.GetLegSecurityDesc()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSecurityDesc = &v
+		}
+		if m.HasEncodedLegSecurityDescLen() {
+			v, err := m.GetEncodedLegSecurityDescLen()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedLegSecurityDescLen = &v
+		}
+		if m.HasEncodedLegSecurityDesc() {
+			v, err := m.GetEncodedLegSecurityDesc()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedLegSecurityDesc = &v
+		}
+		if m.HasLegRatioQty() {
+			v, err := m.GetLegRatioQty()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegRatioQty = &v
+		}
+		if m.HasLegSide() {
+			v, err := m.GetLegSide()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSide = &v
+		}
+		if m.HasLegCurrency() {
+			v, err := m.GetLegCurrency()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegCurrency = &v
+		}
+		if m.HasLegPool() {
+			v, err := m.GetLegPool()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegPool = &v
+		}
+		if m.HasLegDatedDate() {
+			v, err := m.GetLegDatedDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegDatedDate = &v
+		}
+		if m.HasLegContractSettlMonth() {
+			v, err := m.GetLegContractSettlMonth()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegContractSettlMonth = &v
+		}
+		if m.HasLegInterestAccrualDate() {
+			v, err := m.GetLegInterestAccrualDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegInterestAccrualDate = &v
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoLegs builds a NoLegsRepeatingGroup from s
+func UnmarshalNoLegs(s []NoLegsStruct) NoLegsRepeatingGroup {
+	g := NewNoLegsRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.LegSymbol != nil {
+			m.SetLegSymbol(*e.LegSymbol)
+		}
+		if e.LegSymbolSfx != nil {
+			m.SetLegSymbolSfx(*e.LegSymbolSfx)
+		}
+		if e.LegSecurityID != nil {
+			m.SetLegSecurityID(*e.LegSecurityID)
+		}
+		if e.LegSecurityIDSource != nil {
+			m.SetLegSecurityIDSource(*e.LegSecurityIDSource)
+		}
+		if e.NoLegSecurityAltID != nil {
+			m.SetNoLegSecurityAltID(components.UnmarshalNoLegSecurityAltID(e.NoLegSecurityAltID))
+		}
+		if e.LegProduct != nil {
+			m.SetLegProduct(*e.LegProduct)
+		}
+		if e.LegCFICode != nil {
+			m.SetLegCFICode(*e.LegCFICode)
+		}
+		if e.LegSecurityType != nil {
+			m.SetLegSecurityType(*e.LegSecurityType)
+		}
+		if e.LegSecuritySubType != nil {
+			m.SetLegSecuritySubType(*e.LegSecuritySubType)
+		}
+		if e.LegMaturityMonthYear != nil {
+			m.SetLegMaturityMonthYear(*e.LegMaturityMonthYear)
+		}
+		if e.LegMaturityDate != nil {
+			m.SetLegMaturityDate(*e.LegMaturityDate)
+		}
+		if e.LegCouponPaymentDate != nil {
+			m.SetLegCouponPaymentDate(*e.LegCouponPaymentDate)
+		}
+		if e.LegIssueDate != nil {
+			m.SetLegIssueDate(*e.LegIssueDate)
+		}
+		if e.LegRepoCollateralSecurityType != nil {
+			m.SetLegRepoCollateralSecurityType(*e.LegRepoCollateralSecurityType)
+		}
+		if e.LegRepurchaseTerm != nil {
+			m.SetLegRepurchaseTerm(*e.LegRepurchaseTerm)
+		}
+		if e.LegRepurchaseRate != nil {
+			m.SetLegRepurchaseRate(*e.LegRepurchaseRate, fix44.DecimalScale(*e.LegRepurchaseRate))
+		}
+		if e.LegFactor != nil {
+			m.SetLegFactor(*e.LegFactor, fix44.DecimalScale(*e.LegFactor))
+		}
+		if e.LegCreditRating != nil {
+			m.SetLegCreditRating(*e.LegCreditRating)
+		}
+		if e.LegInstrRegistry != nil {
+			m.SetLegInstrRegistry(*e.LegInstrRegistry)
+		}
+		if e.LegCountryOfIssue != nil {
+			m.SetLegCountryOfIssue(*e.LegCountryOfIssue)
+		}
+		if e.LegStateOrProvinceOfIssue != nil {
+			m.SetLegStateOrProvinceOfIssue(*e.LegStateOrProvinceOfIssue)
+		}
+		if e.LegLocaleOfIssue != nil {
+			m.SetLegLocaleOfIssue(*e.LegLocaleOfIssue)
+		}
+		if e.LegRedemptionDate != nil {
+			m.SetLegRedemptionDate(*e.LegRedemptionDate)
+		}
+		if e.LegStrikePrice != nil {
+			m.SetLegStrikePrice(*e.LegStrikePrice, fix44.DecimalScale(*e.LegStrikePrice))
+		}
+		if e.LegStrikeCurrency != nil {
+			m.SetLegStrikeCurrency(*e.LegStrikeCurrency)
+		}
+		if e.LegOptAttribute != nil {
+			m.SetLegOptAttribute(*e.LegOptAttribute)
+		}
+		if e.LegContractMultiplier != nil {
+			m.SetLegContractMultiplier(*e.LegContractMultiplier, fix44.DecimalScale(*e.LegContractMultiplier))
+		}
+		if e.LegCouponRate != nil {
+			m.SetLegCouponRate(*e.LegCouponRate, fix44.DecimalScale(*e.LegCouponRate))
+		}
+		if e.LegSecurityExchange != nil {
+			m.SetLegSecurityExchange(*e.LegSecurityExchange)
+		}
+		if e.LegIssuer != nil {
+			m.SetLegIssuer(*e.LegIssuer)
+		}
+		if e.EncodedLegIssuerLen != nil {
+			m.SetEncodedLegIssuerLen(*e.EncodedLegIssuerLen)
+		}
+		if e.EncodedLegIssuer != nil {
+			m.SetEncodedLegIssuer(*e.EncodedLegIssuer)
+		}
+		if e.LegSecurityDesc != nil {
+			m.SetLegSecurityDesc(*e.LegSecurityDesc)
+		}
+		if e.EncodedLegSecurityDescLen != nil {
+			m.SetEncodedLegSecurityDescLen(*e.EncodedLegSecurityDescLen)
+		}
+		if e.EncodedLegSecurityDesc != nil {
+			m.SetEncodedLegSecurityDesc(*e.EncodedLegSecurityDesc)
+		}
+		if e.LegRatioQty != nil {
+			m.SetLegRatioQty(*e.LegRatioQty, fix44.DecimalScale(*e.LegRatioQty))
+		}
+		if e.LegSide != nil {
+			m.SetLegSide(*e.LegSide)
+		}
+		if e.LegCurrency != nil {
+			m.SetLegCurrency(*e.LegCurrency)
+		}
+		if e.LegPool != nil {
+			m.SetLegPool(*e.LegPool)
+		}
+		if e.LegDatedDate != nil {
+			m.SetLegDatedDate(*e.LegDatedDate)
+		}
+		if e.LegContractSettlMonth != nil {
+			m.SetLegContractSettlMonth(*e.LegContractSettlMonth)
+		}
+		if e.LegInterestAccrualDate != nil {
+			m.SetLegInterestAccrualDate(*e.LegInterestAccrualDate)
+		}
+	}
+	return g
+}
+
+//NoUnderlyingsStruct is a plain Go representation of a NoUnderlyings group element, optional fields are nil when absent
+type NoUnderlyingsStruct struct {
+	UnderlyingSymbol                     *string
+	UnderlyingSymbolSfx                  *string
+	UnderlyingSecurityID                 *string
+	UnderlyingSecurityIDSource           *string
+	NoUnderlyingSecurityAltID            []NoUnderlyingSecurityAltIDStruct
+	UnderlyingProduct                    *int
+	UnderlyingCFICode                    *string
+	UnderlyingSecurityType               *string
+	UnderlyingSecuritySubType            *string
+	UnderlyingMaturityMonthYear          *string
+	UnderlyingMaturityDate               *string
+	UnderlyingCouponPaymentDate          *string
+	UnderlyingIssueDate                  *string
+	UnderlyingRepoCollateralSecurityType *int
+	UnderlyingRepurchaseTerm             *int
+	UnderlyingRepurchaseRate             *decimal.Decimal
+	UnderlyingFactor                     *decimal.Decimal
+	UnderlyingCreditRating               *string
+	UnderlyingInstrRegistry              *string
+	UnderlyingCountryOfIssue             *string
+	UnderlyingStateOrProvinceOfIssue     *string
+	UnderlyingLocaleOfIssue              *string
+	UnderlyingRedemptionDate             *string
+	UnderlyingStrikePrice                *decimal.Decimal
+	UnderlyingStrikeCurrency             *string
+	UnderlyingOptAttribute               *string
+	UnderlyingContractMultiplier         *decimal.Decimal
+	UnderlyingCouponRate                 *decimal.Decimal
+	UnderlyingSecurityExchange           *string
+	UnderlyingIssuer                     *string
+	EncodedUnderlyingIssuerLen           *int
+	EncodedUnderlyingIssuer              *string
+	UnderlyingSecurityDesc               *string
+	EncodedUnderlyingSecurityDescLen     *int
+	EncodedUnderlyingSecurityDesc        *string
+	UnderlyingCPProgram                  *string
+	UnderlyingCPRegType                  *string
+	UnderlyingCurrency                   *string
+	UnderlyingQty                        *decimal.Decimal
+	UnderlyingPx                         *decimal.Decimal
+	UnderlyingDirtyPrice                 *decimal.Decimal
+	UnderlyingEndPrice                   *decimal.Decimal
+	UnderlyingStartValue                 *decimal.Decimal
+	UnderlyingCurrentValue               *decimal.Decimal
+	UnderlyingEndValue                   *decimal.Decimal
+	NoUnderlyingStips                    []NoUnderlyingStipsStruct
+}
+
+//MarshalNoUnderlyings copies the elements of g into a slice of NoUnderlyingsStruct
+func MarshalNoUnderlyings(g NoUnderlyingsRepeatingGroup) ([]NoUnderlyingsStruct, quickfix.MessageRejectError) {
+	s := make([]NoUnderlyingsStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasUnderlyingSymbol() {
+			v, err := m.GetUnderlyingSymbol()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingSymbol = &v
+		}
+		if m.HasUnderlyingSymbolSfx() {
+			v, err := m.GetUnderlyingSymbolSfx()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingSymbolSfx = &v
+		}
+		if m.HasUnderlyingSecurityID() {
+			v, err := m.GetUnderlyingSecurityID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingSecurityID = &v
+		}
+		if m.HasUnderlyingSecurityIDSource() {
+			v, err := m.GetUnderlyingSecurityIDSource()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingSecurityIDSource = &v
+		}
+		if m.HasNoUnderlyingSecurityAltID() {
+			g, err := m.GetNoUnderlyingSecurityAltID()
+			if err != nil {
+				return nil, err
+			}
+			if s[i].NoUnderlyingSecurityAltID, err = components.MarshalNoUnderlyingSecurityAltID(g); err != nil {
+				return nil, err
+			}
+		}
+		if m.HasUnderlyingProduct() {
+			v, err := m.GetUnderlyingProduct()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingProduct = &v
+		}
+		if m.HasUnderlyingCFICode() {
+			v, err := m.GetUnderlyingCFICode()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCFICode = &v
+		}
+		if m.HasUnderlyingSecurityType() {
+			v, err := m.GetUnderlyingSecurityType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingSecurityType = &v
+		}
+		if m.HasUnderlyingSecuritySubType() {
+			v, err := m.GetUnderlyingSecuritySubType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingSecuritySubType = &v
+		}
+		if m.HasUnderlyingMaturityMonthYear() {
+			v, err := m.GetUnderlyingMaturityMonthYear()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingMaturityMonthYear = &v
+		}
+		if m.HasUnderlyingMaturityDate() {
+			v, err := m.GetUnderlyingMaturityDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingMaturityDate = &v
+		}
+		if m.HasUnderlyingCouponPaymentDate() {
+			v, err := m.GetUnderlyingCouponPaymentDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCouponPaymentDate = &v
+		}
+		if m.HasUnderlyingIssueDate() {
+			v, err := m.GetUnderlyingIssueDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingIssueDate = &v
+		}
+		if m.HasUnderlyingRepoCollateralSecurityType() {
+			v, err := m.GetUnderlyingRepoCollateralSecurityType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingRepoCollateralSecurityType = &v
+		}
+		if m.HasUnderlyingRepurchaseTerm() {
+			v, err := m.GetUnderlyingRepurchaseTerm()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingRepurchaseTerm = &v
+		}
+		if m.HasUnderlyingRepurchaseRate() {
+			v, err := m.GetUnderlyingRepurchaseRate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingRepurchaseRate = &v
+		}
+		if m.HasUnderlyingFactor() {
+			v, err := m.GetUnderlyingFactor()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingFactor = &v
+		}
+		if m.HasUnderlyingCreditRating() {
+			v, err := m.GetUnderlyingCreditRating()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCreditRating = &v
+		}
+		if m.HasUnderlyingInstrRegistry() {
+			v, err := m.GetUnderlyingInstrRegistry()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingInstrRegistry = &v
+		}
+		if m.HasUnderlyingCountryOfIssue() {
+			v, err := m.GetUnderlyingCountryOfIssue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCountryOfIssue = &v
+		}
+		if m.HasUnderlyingStateOrProvinceOfIssue() {
+			v, err := m.GetUnderlyingStateOrProvinceOfIssue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingStateOrProvinceOfIssue = &v
+		}
+		if m.HasUnderlyingLocaleOfIssue() {
+			v, err := m.GetUnderlyingLocaleOfIssue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingLocaleOfIssue = &v
+		}
+		if m.HasUnderlyingRedemptionDate() {
+			v, err := m.GetUnderlyingRedemptionDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingRedemptionDate = &v
+		}
+		if m.HasUnderlyingStrikePrice() {
+			v, err := m.GetUnderlyingStrikePrice()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingStrikePrice = &v
+		}
+		if m.HasUnderlyingStrikeCurrency() {
+			v, err := m.GetUnderlyingStrikeCurrency()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingStrikeCurrency = &v
+		}
+		if m.HasUnderlyingOptAttribute() {
+			v, err := m.GetUnderlyingOptAttribute()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingOptAttribute = &v
+		}
+		if m.HasUnderlyingContractMultiplier() {
+			v, err := m.GetUnderlyingContractMultiplier()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingContractMultiplier = &v
+		}
+		if m.HasUnderlyingCouponRate() {
+			v, err := m.GetUnderlyingCouponRate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCouponRate = &v
+		}
+		if m.HasUnderlyingSecurityExchange() {
+			v, err := m.GetUnderlyingSecurityExchange()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingSecurityExchange = &v
+		}
+		if m.HasUnderlyingIssuer() {
+			v, err := m.GetUnderlyingIssuer()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingIssuer = &v
+		}
+		if m.HasEncodedUnderlyingIssuerLen() {
+			v, err := m.GetEncodedUnderlyingIssuerLen()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedUnderlyingIssuerLen = &v
+		}
+		if m.HasEncodedUnderlyingIssuer() {
+			v, err := m.GetEncodedUnderlyingIssuer()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedUnderlyingIssuer = &v
+		}
+		if m.HasUnderlyingSecurityDesc() {
+			v, err := m.GetUnderlyingSecurityDesc()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingSecurityDesc = &v
+		}
+		if m.HasEncodedUnderlyingSecurityDescLen() {
+			v, err := m.GetEncodedUnderlyingSecurityDescLen()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedUnderlyingSecurityDescLen = &v
+		}
+		if m.HasEncodedUnderlyingSecurityDesc() {
+			v, err := m.GetEncodedUnderlyingSecurityDesc()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedUnderlyingSecurityDesc = &v
+		}
+		if m.HasUnderlyingCPProgram() {
+			v, err := m.GetUnderlyingCPProgram()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCPProgram = &v
+		}
+		if m.HasUnderlyingCPRegType() {
+			v, err := m.GetUnderlyingCPRegType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCPRegType = &v
+		}
+		if m.HasUnderlyingCurrency() {
+			v, err := m.GetUnderlyingCurrency()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCurrency = &v
+		}
+		if m.HasUnderlyingQty() {
+			v, err := m.GetUnderlyingQty()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingQty = &v
+		}
+		if m.HasUnderlyingPx() {
+			v, err := m.GetUnderlyingPx()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingPx = &v
+		}
+		if m.HasUnderlyingDirtyPrice() {
+			v, err := m.GetUnderlyingDirtyPrice()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingDirtyPrice = &v
+		}
+		if m.HasUnderlyingEndPrice() {
+			v, err := m.GetUnderlyingEndPrice()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingEndPrice = &v
+		}
+		if m.HasUnderlyingStartValue() {
+			v, err := m.GetUnderlyingStartValue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingStartValue = &v
+		}
+		if m.HasUnderlyingCurrentValue() {
+			v, err := m.GetUnderlyingCurrentValue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCurrentValue = &v
+		}
+		if m.HasUnderlyingEndValue() {
+			v, err := m.GetUnderlyingEndValue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingEndValue = &v
+		}
+		if m.HasNoUnderlyingStips() {
+			g, err := m.GetNoUnderlyingStips()
+			if err != nil {
+				return nil, err
+			}
+			if s[i].NoUnderlyingStips, err = components.MarshalNoUnderlyingStips(g); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoUnderlyings builds a NoUnderlyingsRepeatingGroup from s
+func UnmarshalNoUnderlyings(s []NoUnderlyingsStruct) NoUnderlyingsRepeatingGroup {
+	g := NewNoUnderlyingsRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.UnderlyingSymbol != nil {
+			m.SetUnderlyingSymbol(*e.UnderlyingSymbol)
+		}
+		if e.UnderlyingSymbolSfx != nil {
+			m.SetUnderlyingSymbolSfx(*e.UnderlyingSymbolSfx)
+		}
+		if e.UnderlyingSecurityID != nil {
+			m.SetUnderlyingSecurityID(*e.UnderlyingSecurityID)
+		}
+		if e.UnderlyingSecurityIDSource != nil {
+			m.SetUnderlyingSecurityIDSource(*e.UnderlyingSecurityIDSource)
+		}
+		if e.NoUnderlyingSecurityAltID != nil {
+			m.SetNoUnderlyingSecurityAltID(components.UnmarshalNoUnderlyingSecurityAltID(e.NoUnderlyingSecurityAltID))
+		}
+		if e.UnderlyingProduct != nil {
+			m.SetUnderlyingProduct(*e.UnderlyingProduct)
+		}
+		if e.UnderlyingCFICode != nil {
+			m.SetUnderlyingCFICode(*e.UnderlyingCFICode)
+		}
+		if e.UnderlyingSecurityType != nil {
+			m.SetUnderlyingSecurityType(*e.UnderlyingSecurityType)
+		}
+		if e.UnderlyingSecuritySubType != nil {
+			m.SetUnderlyingSecuritySubType(*e.UnderlyingSecuritySubType)
+		}
+		if e.UnderlyingMaturityMonthYear != nil {
+			m.SetUnderlyingMaturityMonthYear(*e.UnderlyingMaturityMonthYear)
+		}
+		if e.UnderlyingMaturityDate != nil {
+			m.SetUnderlyingMaturityDate(*e.UnderlyingMaturityDate)
+		}
+		if e.UnderlyingCouponPaymentDate != nil {
+			m.SetUnderlyingCouponPaymentDate(*e.UnderlyingCouponPaymentDate)
+		}
+		if e.UnderlyingIssueDate != nil {
+			m.SetUnderlyingIssueDate(*e.UnderlyingIssueDate)
+		}
+		if e.UnderlyingRepoCollateralSecurityType != nil {
+			m.SetUnderlyingRepoCollateralSecurityType(*e.UnderlyingRepoCollateralSecurityType)
+		}
+		if e.UnderlyingRepurchaseTerm != nil {
+			m.SetUnderlyingRepurchaseTerm(*e.UnderlyingRepurchaseTerm)
+		}
+		if e.UnderlyingRepurchaseRate != nil {
+			m.SetUnderlyingRepurchaseRate(*e.UnderlyingRepurchaseRate, fix44.DecimalScale(*e.UnderlyingRepurchaseRate))
+		}
+		if e.UnderlyingFactor != nil {
+			m.SetUnderlyingFactor(*e.UnderlyingFactor, fix44.DecimalScale(*e.UnderlyingFactor))
+		}
+		if e.UnderlyingCreditRating != nil {
+			m.SetUnderlyingCreditRating(*e.UnderlyingCreditRating)
+		}
+		if e.UnderlyingInstrRegistry != nil {
+			m.SetUnderlyingInstrRegistry(*e.UnderlyingInstrRegistry)
+		}
+		if e.UnderlyingCountryOfIssue != nil {
+			m.SetUnderlyingCountryOfIssue(*e.UnderlyingCountryOfIssue)
+		}
+		if e.UnderlyingStateOrProvinceOfIssue != nil {
+			m.SetUnderlyingStateOrProvinceOfIssue(*e.UnderlyingStateOrProvinceOfIssue)
+		}
+		if e.UnderlyingLocaleOfIssue != nil {
+			m.SetUnderlyingLocaleOfIssue(*e.UnderlyingLocaleOfIssue)
+		}
+		if e.UnderlyingRedemptionDate != nil {
+			m.SetUnderlyingRedemptionDate(*e.UnderlyingRedemptionDate)
+		}
+		if e.UnderlyingStrikePrice != nil {
+			m.SetUnderlyingStrikePrice(*e.UnderlyingStrikePrice, fix44.DecimalScale(*e.UnderlyingStrikePrice))
+		}
+		if e.UnderlyingStrikeCurrency != nil {
+			m.SetUnderlyingStrikeCurrency(*e.UnderlyingStrikeCurrency)
+		}
+		if e.UnderlyingOptAttribute != nil {
+			m.SetUnderlyingOptAttribute(*e.UnderlyingOptAttribute)
+		}
+		if e.UnderlyingContractMultiplier != nil {
+			m.SetUnderlyingContractMultiplier(*e.UnderlyingContractMultiplier, fix44.DecimalScale(*e.UnderlyingContractMultiplier))
+		}
+		if e.UnderlyingCouponRate != nil {
+			m.SetUnderlyingCouponRate(*e.UnderlyingCouponRate, fix44.DecimalScale(*e.UnderlyingCouponRate))
+		}
+		if e.UnderlyingSecurityExchange != nil {
+			m.SetUnderlyingSecurityExchange(*e.UnderlyingSecurityExchange)
+		}
+		if e.UnderlyingIssuer != nil {
+			m.SetUnderlyingIssuer(*e.UnderlyingIssuer)
+		}
+		if e.EncodedUnderlyingIssuerLen != nil {
+			m.SetEncodedUnderlyingIssuerLen(*e.EncodedUnderlyingIssuerLen)
+		}
+		if e.EncodedUnderlyingIssuer != nil {
+			m.SetEncodedUnderlyingIssuer(*e.EncodedUnderlyingIssuer)
+		}
+		if e.UnderlyingSecurityDesc != nil {
+			m.SetUnderlyingSecurityDesc(*e.UnderlyingSecurityDesc)
+		}
+		if e.EncodedUnderlyingSecurityDescLen != nil {
+			m.SetEncodedUnderlyingSecurityDescLen(*e.EncodedUnderlyingSecurityDescLen)
+		}
+		if e.EncodedUnderlyingSecurityDesc != nil {
+			m.SetEncodedUnderlyingSecurityDesc(*e.EncodedUnderlyingSecurityDesc)
+		}
+		if e.UnderlyingCPProgram != nil {
+			m.SetUnderlyingCPProgram(*e.UnderlyingCPProgram)
+		}
+		if e.UnderlyingCPRegType != nil {
+			m.SetUnderlyingCPRegType(*e.UnderlyingCPRegType)
+		}
+		if e.UnderlyingCurrency != nil {
+			m.SetUnderlyingCurrency(*e.UnderlyingCurrency)
+		}
+		if e.UnderlyingQty != nil {
+			m.SetUnderlyingQty(*e.UnderlyingQty, fix44.DecimalScale(*e.UnderlyingQty))
+		}
+		if e.UnderlyingPx != nil {
+			m.SetUnderlyingPx(*e.UnderlyingPx, fix44.DecimalScale(*e.UnderlyingPx))
+		}
+		if e.UnderlyingDirtyPrice != nil {
+			m.SetUnderlyingDirtyPrice(*e.UnderlyingDirtyPrice, fix44.DecimalScale(*e.UnderlyingDirtyPrice))
+		}
+		if e.UnderlyingEndPrice != nil {
+			m.SetUnderlyingEndPrice(*e.UnderlyingEndPrice, fix44.DecimalScale(*e.UnderlyingEndPrice))
+		}
+		if e.UnderlyingStartValue != nil {
+			m.SetUnderlyingStartValue(*e.UnderlyingStartValue, fix44.DecimalScale(*e.UnderlyingStartValue))
+		}
+		if e.UnderlyingCurrentValue != nil {
+			m.SetUnderlyingCurrentValue(*e.UnderlyingCurrentValue, fix44.DecimalScale(*e.UnderlyingCurrentValue))
+		}
+		if e.UnderlyingEndValue != nil {
+			m.SetUnderlyingEndValue(*e.UnderlyingEndValue, fix44.DecimalScale(*e.UnderlyingEndValue))
+		}
+		if e.NoUnderlyingStips != nil {
+			m.SetNoUnderlyingStips(components.UnmarshalNoUnderlyingStips(e.NoUnderlyingStips))
+		}
+	}
+	return g
+}
+
+//NoInstrAttribStruct is a plain Go representation of a NoInstrAttrib group element, optional fields are nil when absent
+type NoInstrAttribStruct struct {
+	InstrAttribType  *enum.InstrAttribType
+	InstrAttribValue *string
+}
+
+//MarshalNoInstrAttrib copies the elements of g into a slice of NoInstrAttribStruct
+func MarshalNoInstrAttrib(g NoInstrAttribRepeatingGroup) ([]NoInstrAttribStruct, quickfix.MessageRejectError) {
+	s := make([]NoInstrAttribStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasInstrAttribType() {
+			v, err := m.GetInstrAttribType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].InstrAttribType = &v
+		}
+		if m.HasInstrAttribValue() {
+			v, err := m.GetInstrAttribValue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].InstrAttribValue = &v
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoInstrAttrib builds a NoInstrAttribRepeatingGroup from s
+func UnmarshalNoInstrAttrib(s []NoInstrAttribStruct) NoInstrAttribRepeatingGroup {
+	g := NewNoInstrAttribRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.InstrAttribType != nil {
+			m.SetInstrAttribType(*e.InstrAttribType)
+		}
+		if e.InstrAttribValue != nil {
+			m.SetInstrAttribValue(*e.InstrAttribValue)
+		}
+	}
+	return g
+}
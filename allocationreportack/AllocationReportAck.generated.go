@@ -8,6 +8,7 @@ import (
 	"github.com/terracefi/enum"
 	"github.com/terracefi/field"
 	"github.com/terracefi/fix44"
+	"github.com/terracefi/fix44/components"
 	"github.com/terracefi/quickfix"
 	"github.com/terracefi/tag"
 )
@@ -62,6 +63,26 @@ func Route(router RouteOut) (string, string, quickfix.MessageRoute) {
 	return "FIX.4.4", "AT", r
 }
 
+//Validate checks m against the FIX 4.4 definition of AllocationReportAck, see fix44.MessageDef.Validate
+func (m AllocationReportAck) Validate() fix44.ValidationErrors {
+	return fix44.MessageDefs["AT"].Validate(&m.Body.FieldMap)
+}
+
+//MarshalJSON encodes the AllocationReportAck as JSON with fields keyed by name, see fix44.MarshalMessageJSON
+func (m AllocationReportAck) MarshalJSON() ([]byte, error) {
+	return fix44.MarshalMessageJSON(m.Message)
+}
+
+//UnmarshalJSON builds the AllocationReportAck from JSON produced by MarshalJSON
+func (m *AllocationReportAck) UnmarshalJSON(b []byte) error {
+	msg, err := fix44.UnmarshalMessageJSON(b, "AT")
+	if err != nil {
+		return err
+	}
+	*m = FromMessage(msg)
+	return nil
+}
+
 //SetText sets Text, Tag 58
 func (m AllocationReportAck) SetText(v string) {
 	m.Set(field.NewText(v))
@@ -346,6 +367,21 @@ func (m AllocationReportAck) HasEncodedText() bool {
 	return m.Has(tag.EncodedText)
 }
 
+//SetEncodedTextBytes sets EncodedText, Tag 355, and EncodedTextLen, Tag 354, from v, which may hold any bytes including SOH
+func (m AllocationReportAck) SetEncodedTextBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedTextLen, tag.EncodedText, v)
+}
+
+//GetEncodedTextBytes gets EncodedText, Tag 355, checking its length against EncodedTextLen, Tag 354
+func (m AllocationReportAck) GetEncodedTextBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedTextLen, tag.EncodedText)
+}
+
+//GetEncodedTextDecoded gets EncodedText, Tag 355, decoded to UTF-8 using the MessageEncoding of the Header
+func (m AllocationReportAck) GetEncodedTextDecoded() (string, error) {
+	return fix44.DecodeRawData(m.Header, m, tag.EncodedTextLen, tag.EncodedText)
+}
+
 //HasNoPartyIDs returns true if NoPartyIDs is present, Tag 453
 func (m AllocationReportAck) HasNoPartyIDs() bool {
 	return m.Has(tag.NoPartyIDs)
@@ -381,6 +417,16 @@ func (m AllocationReportAck) HasAllocIntermedReqType() bool {
 	return m.Has(tag.AllocIntermedReqType)
 }
 
+//GetParties gets the Parties component
+func (m AllocationReportAck) GetParties() components.Parties {
+	return components.Parties{&m.Body.FieldMap}
+}
+
+//SetParties sets the Parties component, copying the fields present in c
+func (m AllocationReportAck) SetParties(c components.Parties) quickfix.MessageRejectError {
+	return c.CopyInto(&m.Body.FieldMap)
+}
+
 //NoAllocs is a repeating group element, Tag 78
 type NoAllocs struct {
 	*quickfix.Group
@@ -538,6 +584,21 @@ func (m NoAllocs) HasEncodedAllocText() bool {
 	return m.Has(tag.EncodedAllocText)
 }
 
+//SetEncodedAllocTextBytes sets EncodedAllocText, Tag 361, and EncodedAllocTextLen, Tag 360, from v, which may hold any bytes including SOH
+func (m NoAllocs) SetEncodedAllocTextBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedAllocTextLen, tag.EncodedAllocText, v)
+}
+
+//GetEncodedAllocTextBytes gets EncodedAllocText, Tag 361, checking its length against EncodedAllocTextLen, Tag 360
+func (m NoAllocs) GetEncodedAllocTextBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedAllocTextLen, tag.EncodedAllocText)
+}
+
+//GetEncodedAllocTextDecoded gets EncodedAllocText, Tag 361, decoded to UTF-8 using the MessageEncoding of h, the Header of the message holding it
+func (m NoAllocs) GetEncodedAllocTextDecoded(h fix44.Header) (string, error) {
+	return fix44.DecodeRawData(h, m, tag.EncodedAllocTextLen, tag.EncodedAllocText)
+}
+
 //NoAllocsRepeatingGroup is a repeating group, Tag 78
 type NoAllocsRepeatingGroup struct {
 	*quickfix.RepeatingGroup
@@ -562,169 +623,334 @@ func (m NoAllocsRepeatingGroup) Get(i int) NoAllocs {
 }
 
 //NoPartyIDs is a repeating group element, Tag 453
-type NoPartyIDs struct {
-	*quickfix.Group
-}
-
-//SetPartyID sets PartyID, Tag 448
-func (m NoPartyIDs) SetPartyID(v string) {
-	m.Set(field.NewPartyID(v))
-}
-
-//SetPartyIDSource sets PartyIDSource, Tag 447
-func (m NoPartyIDs) SetPartyIDSource(v enum.PartyIDSource) {
-	m.Set(field.NewPartyIDSource(v))
-}
-
-//SetPartyRole sets PartyRole, Tag 452
-func (m NoPartyIDs) SetPartyRole(v enum.PartyRole) {
-	m.Set(field.NewPartyRole(v))
-}
-
-//SetNoPartySubIDs sets NoPartySubIDs, Tag 802
-func (m NoPartyIDs) SetNoPartySubIDs(f NoPartySubIDsRepeatingGroup) {
-	m.SetGroup(f)
-}
+type NoPartyIDs = components.NoPartyIDs
 
-//GetPartyID gets PartyID, Tag 448
-func (m NoPartyIDs) GetPartyID() (v string, err quickfix.MessageRejectError) {
-	var f field.PartyIDField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetPartyIDSource gets PartyIDSource, Tag 447
-func (m NoPartyIDs) GetPartyIDSource() (v enum.PartyIDSource, err quickfix.MessageRejectError) {
-	var f field.PartyIDSourceField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetPartyRole gets PartyRole, Tag 452
-func (m NoPartyIDs) GetPartyRole() (v enum.PartyRole, err quickfix.MessageRejectError) {
-	var f field.PartyRoleField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetNoPartySubIDs gets NoPartySubIDs, Tag 802
-func (m NoPartyIDs) GetNoPartySubIDs() (NoPartySubIDsRepeatingGroup, quickfix.MessageRejectError) {
-	f := NewNoPartySubIDsRepeatingGroup()
-	err := m.GetGroup(f)
-	return f, err
-}
-
-//HasPartyID returns true if PartyID is present, Tag 448
-func (m NoPartyIDs) HasPartyID() bool {
-	return m.Has(tag.PartyID)
-}
-
-//HasPartyIDSource returns true if PartyIDSource is present, Tag 447
-func (m NoPartyIDs) HasPartyIDSource() bool {
-	return m.Has(tag.PartyIDSource)
-}
+//NoPartyIDsRepeatingGroup is a repeating group, Tag 453
+type NoPartyIDsRepeatingGroup = components.NoPartyIDsRepeatingGroup
 
-//HasPartyRole returns true if PartyRole is present, Tag 452
-func (m NoPartyIDs) HasPartyRole() bool {
-	return m.Has(tag.PartyRole)
+//NewNoPartyIDsRepeatingGroup returns an initialized, NoPartyIDsRepeatingGroup
+func NewNoPartyIDsRepeatingGroup() NoPartyIDsRepeatingGroup {
+	return components.NewNoPartyIDsRepeatingGroup()
 }
 
-//HasNoPartySubIDs returns true if NoPartySubIDs is present, Tag 802
-func (m NoPartyIDs) HasNoPartySubIDs() bool {
-	return m.Has(tag.NoPartySubIDs)
-}
+//NoPartyIDsStruct is a plain Go representation of a NoPartyIDs group element
+type NoPartyIDsStruct = components.NoPartyIDsStruct
 
 //NoPartySubIDs is a repeating group element, Tag 802
-type NoPartySubIDs struct {
-	*quickfix.Group
-}
-
-//SetPartySubID sets PartySubID, Tag 523
-func (m NoPartySubIDs) SetPartySubID(v string) {
-	m.Set(field.NewPartySubID(v))
-}
-
-//SetPartySubIDType sets PartySubIDType, Tag 803
-func (m NoPartySubIDs) SetPartySubIDType(v enum.PartySubIDType) {
-	m.Set(field.NewPartySubIDType(v))
-}
-
-//GetPartySubID gets PartySubID, Tag 523
-func (m NoPartySubIDs) GetPartySubID() (v string, err quickfix.MessageRejectError) {
-	var f field.PartySubIDField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetPartySubIDType gets PartySubIDType, Tag 803
-func (m NoPartySubIDs) GetPartySubIDType() (v enum.PartySubIDType, err quickfix.MessageRejectError) {
-	var f field.PartySubIDTypeField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//HasPartySubID returns true if PartySubID is present, Tag 523
-func (m NoPartySubIDs) HasPartySubID() bool {
-	return m.Has(tag.PartySubID)
-}
-
-//HasPartySubIDType returns true if PartySubIDType is present, Tag 803
-func (m NoPartySubIDs) HasPartySubIDType() bool {
-	return m.Has(tag.PartySubIDType)
-}
+type NoPartySubIDs = components.NoPartySubIDs
 
 //NoPartySubIDsRepeatingGroup is a repeating group, Tag 802
-type NoPartySubIDsRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
+type NoPartySubIDsRepeatingGroup = components.NoPartySubIDsRepeatingGroup
 
 //NewNoPartySubIDsRepeatingGroup returns an initialized, NoPartySubIDsRepeatingGroup
 func NewNoPartySubIDsRepeatingGroup() NoPartySubIDsRepeatingGroup {
-	return NoPartySubIDsRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoPartySubIDs,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.PartySubID), quickfix.GroupElement(tag.PartySubIDType)})}
-}
-
-//Add create and append a new NoPartySubIDs to this group
-func (m NoPartySubIDsRepeatingGroup) Add() NoPartySubIDs {
-	g := m.RepeatingGroup.Add()
-	return NoPartySubIDs{g}
-}
-
-//Get returns the ith NoPartySubIDs in the NoPartySubIDsRepeatinGroup
-func (m NoPartySubIDsRepeatingGroup) Get(i int) NoPartySubIDs {
-	return NoPartySubIDs{m.RepeatingGroup.Get(i)}
-}
-
-//NoPartyIDsRepeatingGroup is a repeating group, Tag 453
-type NoPartyIDsRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
-
-//NewNoPartyIDsRepeatingGroup returns an initialized, NoPartyIDsRepeatingGroup
-func NewNoPartyIDsRepeatingGroup() NoPartyIDsRepeatingGroup {
-	return NoPartyIDsRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoPartyIDs,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.PartyID), quickfix.GroupElement(tag.PartyIDSource), quickfix.GroupElement(tag.PartyRole), NewNoPartySubIDsRepeatingGroup()})}
-}
-
-//Add create and append a new NoPartyIDs to this group
-func (m NoPartyIDsRepeatingGroup) Add() NoPartyIDs {
-	g := m.RepeatingGroup.Add()
-	return NoPartyIDs{g}
+	return components.NewNoPartySubIDsRepeatingGroup()
+}
+
+//NoPartySubIDsStruct is a plain Go representation of a NoPartySubIDs group element
+type NoPartySubIDsStruct = components.NoPartySubIDsStruct
+
+//Struct is a plain Go representation of the AllocationReportAck body, optional fields are nil when absent
+type Struct struct {
+	Text                 *string
+	TransactTime         time.Time
+	AllocID              string
+	TradeDate            *string
+	NoAllocs             []NoAllocsStruct
+	AllocStatus          enum.AllocStatus
+	AllocRejCode         *enum.AllocRejCode
+	SecurityType         *enum.SecurityType
+	EncodedTextLen       *int
+	EncodedText          *string
+	NoPartyIDs           []NoPartyIDsStruct
+	Product              *enum.Product
+	MatchStatus          *enum.MatchStatus
+	AllocReportID        string
+	SecondaryAllocID     *string
+	AllocReportType      *enum.AllocReportType
+	AllocIntermedReqType *enum.AllocIntermedReqType
+}
+
+//Marshal copies the fields present in msg into a Struct
+func Marshal(msg AllocationReportAck) (Struct, quickfix.MessageRejectError) {
+	var s Struct
+	if msg.HasText() {
+		v, err := msg.GetText()
+		if err != nil {
+			return s, err
+		}
+		s.Text = &v
+	}
+	if msg.HasTransactTime() {
+		v, err := msg.GetTransactTime()
+		if err != nil {
+			return s, err
+		}
+		s.TransactTime = v
+	}
+	if msg.HasAllocID() {
+		v, err := msg.GetAllocID()
+		if err != nil {
+			return s, err
+		}
+		s.AllocID = v
+	}
+	if msg.HasTradeDate() {
+		v, err := msg.GetTradeDate()
+		if err != nil {
+			return s, err
+		}
+		s.TradeDate = &v
+	}
+	if msg.HasNoAllocs() {
+		g, err := msg.GetNoAllocs()
+		if err != nil {
+			return s, err
+		}
+		if s.NoAllocs, err = MarshalNoAllocs(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasAllocStatus() {
+		v, err := msg.GetAllocStatus()
+		if err != nil {
+			return s, err
+		}
+		s.AllocStatus = v
+	}
+	if msg.HasAllocRejCode() {
+		v, err := msg.GetAllocRejCode()
+		if err != nil {
+			return s, err
+		}
+		s.AllocRejCode = &v
+	}
+	if msg.HasSecurityType() {
+		v, err := msg.GetSecurityType()
+		if err != nil {
+			return s, err
+		}
+		s.SecurityType = &v
+	}
+	if msg.HasEncodedTextLen() {
+		v, err := msg.GetEncodedTextLen()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedTextLen = &v
+	}
+	if msg.HasEncodedText() {
+		v, err := msg.GetEncodedText()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedText = &v
+	}
+	if msg.HasNoPartyIDs() {
+		g, err := msg.GetNoPartyIDs()
+		if err != nil {
+			return s, err
+		}
+		if s.NoPartyIDs, err = components.MarshalNoPartyIDs(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasProduct() {
+		v, err := msg.GetProduct()
+		if err != nil {
+			return s, err
+		}
+		s.Product = &v
+	}
+	if msg.HasMatchStatus() {
+		v, err := msg.GetMatchStatus()
+		if err != nil {
+			return s, err
+		}
+		s.MatchStatus = &v
+	}
+	if msg.HasAllocReportID() {
+		v, err := msg.GetAllocReportID()
+		if err != nil {
+			return s, err
+		}
+		s.AllocReportID = v
+	}
+	if msg.HasSecondaryAllocID() {
+		v, err := msg.GetSecondaryAllocID()
+		if err != nil {
+			return s, err
+		}
+		s.SecondaryAllocID = &v
+	}
+	if msg.HasAllocReportType() {
+		v, err := msg.GetAllocReportType()
+		if err != nil {
+			return s, err
+		}
+		s.AllocReportType = &v
+	}
+	if msg.HasAllocIntermedReqType() {
+		v, err := msg.GetAllocIntermedReqType()
+		if err != nil {
+			return s, err
+		}
+		s.AllocIntermedReqType = &v
+	}
+	return s, nil
 }
 
-//Get returns the ith NoPartyIDs in the NoPartyIDsRepeatinGroup
-func (m NoPartyIDsRepeatingGroup) Get(i int) NoPartyIDs {
-	return NoPartyIDs{m.RepeatingGroup.Get(i)}
+//Unmarshal builds a AllocationReportAck from s
+func Unmarshal(s Struct) AllocationReportAck {
+	m := New(field.NewAllocReportID(s.AllocReportID), field.NewAllocID(s.AllocID), field.NewTransactTime(s.TransactTime), field.NewAllocStatus(s.AllocStatus))
+	if s.Text != nil {
+		m.SetText(*s.Text)
+	}
+	if s.TradeDate != nil {
+		m.SetTradeDate(*s.TradeDate)
+	}
+	if s.NoAllocs != nil {
+		m.SetNoAllocs(UnmarshalNoAllocs(s.NoAllocs))
+	}
+	if s.AllocRejCode != nil {
+		m.SetAllocRejCode(*s.AllocRejCode)
+	}
+	if s.SecurityType != nil {
+		m.SetSecurityType(*s.SecurityType)
+	}
+	if s.EncodedTextLen != nil {
+		m.SetEncodedTextLen(*s.EncodedTextLen)
+	}
+	if s.EncodedText != nil {
+		m.SetEncodedText(*s.EncodedText)
+	}
+	if s.NoPartyIDs != nil {
+		m.SetNoPartyIDs(components.UnmarshalNoPartyIDs(s.NoPartyIDs))
+	}
+	if s.Product != nil {
+		m.SetProduct(*s.Product)
+	}
+	if s.MatchStatus != nil {
+		m.SetMatchStatus(*s.MatchStatus)
+	}
+	if s.SecondaryAllocID != nil {
+		m.SetSecondaryAllocID(*s.SecondaryAllocID)
+	}
+	if s.AllocReportType != nil {
+		m.SetAllocReportType(*s.AllocReportType)
+	}
+	if s.AllocIntermedReqType != nil {
+		m.SetAllocIntermedReqType(*s.AllocIntermedReqType)
+	}
+	return m
+}
+
+//NoAllocsStruct is a plain Go representation of a NoAllocs group element, optional fields are nil when absent
+type NoAllocsStruct struct {
+	AllocAccount           *string
+	AllocAcctIDSource      *int
+	AllocPrice             *decimal.Decimal
+	IndividualAllocID      *string
+	IndividualAllocRejCode *int
+	AllocText              *string
+	EncodedAllocTextLen    *int
+	EncodedAllocText       *string
+}
+
+//MarshalNoAllocs copies the elements of g into a slice of NoAllocsStruct
+func MarshalNoAllocs(g NoAllocsRepeatingGroup) ([]NoAllocsStruct, quickfix.MessageRejectError) {
+	s := make([]NoAllocsStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasAllocAccount() {
+			v, err := m.GetAllocAccount()
+			if err != nil {
+				return nil, err
+			}
+			s[i].AllocAccount = &v
+		}
+		if m.HasAllocAcctIDSource() {
+			v, err := m.GetAllocAcctIDSource()
+			if err != nil {
+				return nil, err
+			}
+			s[i].AllocAcctIDSource = &v
+		}
+		if m.HasAllocPrice() {
+			v, err := m.GetAllocPrice()
+			if err != nil {
+				return nil, err
+			}
+			s[i].AllocPrice = &v
+		}
+		if m.HasIndividualAllocID() {
+			v, err := m.GetIndividualAllocID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].IndividualAllocID = &v
+		}
+		if m.HasIndividualAllocRejCode() {
+			v, err := m.GetIndividualAllocRejCode()
+			if err != nil {
+				return nil, err
+			}
+			s[i].IndividualAllocRejCode = &v
+		}
+		if m.HasAllocText() {
+			v, err := m.GetAllocText()
+			if err != nil {
+				return nil, err
+			}
+			s[i].AllocText = &v
+		}
+		if m.HasEncodedAllocTextLen() {
+			v, err := m.GetEncodedAllocTextLen()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedAllocTextLen = &v
+		}
+		if m.HasEncodedAllocText() {
+			v, err := m.GetEncodedAllocText()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedAllocText = &v
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoAllocs builds a NoAllocsRepeatingGroup from s
+func UnmarshalNoAllocs(s []NoAllocsStruct) NoAllocsRepeatingGroup {
+	g := NewNoAllocsRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.AllocAccount != nil {
+			m.SetAllocAccount(*e.AllocAccount)
+		}
+		if e.AllocAcctIDSource != nil {
+			m.SetAllocAcctIDSource(*e.AllocAcctIDSource)
+		}
+		if e.AllocPrice != nil {
+			m.SetAllocPrice(*e.AllocPrice, fix44.DecimalScale(*e.AllocPrice))
+		}
+		if e.IndividualAllocID != nil {
+			m.SetIndividualAllocID(*e.IndividualAllocID)
+		}
+		if e.IndividualAllocRejCode != nil {
+			m.SetIndividualAllocRejCode(*e.IndividualAllocRejCode)
+		}
+		if e.AllocText != nil {
+			m.SetAllocText(*e.AllocText)
+		}
+		if e.EncodedAllocTextLen != nil {
+			m.SetEncodedAllocTextLen(*e.EncodedAllocTextLen)
+		}
+		if e.EncodedAllocText != nil {
+			m.SetEncodedAllocText(*e.EncodedAllocText)
+		}
+	}
+	return g
 }
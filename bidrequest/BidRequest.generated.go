@@ -64,6 +64,26 @@ func Route(router RouteOut) (string, string, quickfix.MessageRoute) {
 	return "FIX.4.4", "k", r
 }
 
+//Validate checks m against the FIX 4.4 definition of BidRequest, see fix44.MessageDef.Validate
+func (m BidRequest) Validate() fix44.ValidationErrors {
+	return fix44.MessageDefs["k"].Validate(&m.Body.FieldMap)
+}
+
+//MarshalJSON encodes the BidRequest as JSON with fields keyed by name, see fix44.MarshalMessageJSON
+func (m BidRequest) MarshalJSON() ([]byte, error) {
+	return fix44.MarshalMessageJSON(m.Message)
+}
+
+//UnmarshalJSON builds the BidRequest from JSON produced by MarshalJSON
+func (m *BidRequest) UnmarshalJSON(b []byte) error {
+	msg, err := fix44.UnmarshalMessageJSON(b, "k")
+	if err != nil {
+		return err
+	}
+	*m = FromMessage(msg)
+	return nil
+}
+
 //SetCurrency sets Currency, Tag 15
 func (m BidRequest) SetCurrency(v string) {
 	m.Set(field.NewCurrency(v))
@@ -496,6 +516,21 @@ func (m BidRequest) HasEncodedText() bool {
 	return m.Has(tag.EncodedText)
 }
 
+//SetEncodedTextBytes sets EncodedText, Tag 355, and EncodedTextLen, Tag 354, from v, which may hold any bytes including SOH
+func (m BidRequest) SetEncodedTextBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedTextLen, tag.EncodedText, v)
+}
+
+//GetEncodedTextBytes gets EncodedText, Tag 355, checking its length against EncodedTextLen, Tag 354
+func (m BidRequest) GetEncodedTextBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedTextLen, tag.EncodedText)
+}
+
+//GetEncodedTextDecoded gets EncodedText, Tag 355, decoded to UTF-8 using the MessageEncoding of the Header
+func (m BidRequest) GetEncodedTextDecoded() (string, error) {
+	return fix44.DecodeRawData(m.Header, m, tag.EncodedTextLen, tag.EncodedText)
+}
+
 //HasBidRequestTransType returns true if BidRequestTransType is present, Tag 374
 func (m BidRequest) HasBidRequestTransType() bool {
 	return m.Has(tag.BidRequestTransType)
@@ -1046,3 +1081,588 @@ func (m NoBidComponentsRepeatingGroup) Add() NoBidComponents {
 func (m NoBidComponentsRepeatingGroup) Get(i int) NoBidComponents {
 	return NoBidComponents{m.RepeatingGroup.Get(i)}
 }
+
+//Struct is a plain Go representation of the BidRequest body, optional fields are nil when absent
+type Struct struct {
+	Currency            *string
+	Text                *string
+	TradeDate           *string
+	ForexReq            *bool
+	EncodedTextLen      *int
+	EncodedText         *string
+	BidRequestTransType enum.BidRequestTransType
+	BidID               *string
+	ClientBidID         string
+	ListName            *string
+	TotNoRelatedSym     int
+	BidType             enum.BidType
+	NumTickets          *int
+	SideValue1          *decimal.Decimal
+	SideValue2          *decimal.Decimal
+	NoBidDescriptors    []NoBidDescriptorsStruct
+	LiquidityIndType    *enum.LiquidityIndType
+	WtAverageLiquidity  *decimal.Decimal
+	ExchangeForPhysical *bool
+	OutMainCntryUIndex  *decimal.Decimal
+	CrossPercent        *decimal.Decimal
+	ProgRptReqs         *enum.ProgRptReqs
+	ProgPeriodInterval  *int
+	IncTaxInd           *enum.IncTaxInd
+	NumBidders          *int
+	BidTradeType        enum.BidTradeType
+	BasisPxType         enum.BasisPxType
+	NoBidComponents     []NoBidComponentsStruct
+	StrikeTime          *time.Time
+}
+
+//Marshal copies the fields present in msg into a Struct
+func Marshal(msg BidRequest) (Struct, quickfix.MessageRejectError) {
+	var s Struct
+	if msg.HasCurrency() {
+		v, err := msg.GetCurrency()
+		if err != nil {
+			return s, err
+		}
+		s.Currency = &v
+	}
+	if msg.HasText() {
+		v, err := msg.GetText()
+		if err != nil {
+			return s, err
+		}
+		s.Text = &v
+	}
+	if msg.HasTradeDate() {
+		v, err := msg.GetTradeDate()
+		if err != nil {
+			return s, err
+		}
+		s.TradeDate = &v
+	}
+	if msg.HasForexReq() {
+		v, err := msg.GetForexReq()
+		if err != nil {
+			return s, err
+		}
+		s.ForexReq = &v
+	}
+	if msg.HasEncodedTextLen() {
+		v, err := msg.GetEncodedTextLen()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedTextLen = &v
+	}
+	if msg.HasEncodedText() {
+		v, err := msg.GetEncodedText()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedText = &v
+	}
+	if msg.HasBidRequestTransType() {
+		v, err := msg.GetBidRequestTransType()
+		if err != nil {
+			return s, err
+		}
+		s.BidRequestTransType = v
+	}
+	if msg.HasBidID() {
+		v, err := msg.GetBidID()
+		if err != nil {
+			return s, err
+		}
+		s.BidID = &v
+	}
+	if msg.HasClientBidID() {
+		v, err := msg.GetClientBidID()
+		if err != nil {
+			return s, err
+		}
+		s.ClientBidID = v
+	}
+	if msg.HasListName() {
+		v, err := msg.GetListName()
+		if err != nil {
+			return s, err
+		}
+		s.ListName = &v
+	}
+	if msg.HasTotNoRelatedSym() {
+		v, err := msg.GetTotNoRelatedSym()
+		if err != nil {
+			return s, err
+		}
+		s.TotNoRelatedSym = v
+	}
+	if msg.HasBidType() {
+		v, err := msg.GetBidType()
+		if err != nil {
+			return s, err
+		}
+		s.BidType = v
+	}
+	if msg.HasNumTickets() {
+		v, err := msg.GetNumTickets()
+		if err != nil {
+			return s, err
+		}
+		s.NumTickets = &v
+	}
+	if msg.HasSideValue1() {
+		v, err := msg.GetSideValue1()
+		if err != nil {
+			return s, err
+		}
+		s.SideValue1 = &v
+	}
+	if msg.HasSideValue2() {
+		v, err := msg.GetSideValue2()
+		if err != nil {
+			return s, err
+		}
+		s.SideValue2 = &v
+	}
+	if msg.HasNoBidDescriptors() {
+		g, err := msg.GetNoBidDescriptors()
+		if err != nil {
+			return s, err
+		}
+		if s.NoBidDescriptors, err = MarshalNoBidDescriptors(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasLiquidityIndType() {
+		v, err := msg.GetLiquidityIndType()
+		if err != nil {
+			return s, err
+		}
+		s.LiquidityIndType = &v
+	}
+	if msg.HasWtAverageLiquidity() {
+		v, err := msg.GetWtAverageLiquidity()
+		if err != nil {
+			return s, err
+		}
+		s.WtAverageLiquidity = &v
+	}
+	if msg.HasExchangeForPhysical() {
+		v, err := msg.GetExchangeForPhysical()
+		if err != nil {
+			return s, err
+		}
+		s.ExchangeForPhysical = &v
+	}
+	if msg.HasOutMainCntryUIndex() {
+		v, err := msg.GetOutMainCntryUIndex()
+		if err != nil {
+			return s, err
+		}
+		s.OutMainCntryUIndex = &v
+	}
+	if msg.HasCrossPercent() {
+		v, err := msg.GetCrossPercent()
+		if err != nil {
+			return s, err
+		}
+		s.CrossPercent = &v
+	}
+	if msg.HasProgRptReqs() {
+		v, err := msg.GetProgRptReqs()
+		if err != nil {
+			return s, err
+		}
+		s.ProgRptReqs = &v
+	}
+	if msg.HasProgPeriodInterval() {
+		v, err := msg.GetProgPeriodInterval()
+		if err != nil {
+			return s, err
+		}
+		s.ProgPeriodInterval = &v
+	}
+	if msg.HasIncTaxInd() {
+		v, err := msg.GetIncTaxInd()
+		if err != nil {
+			return s, err
+		}
+		s.IncTaxInd = &v
+	}
+	if msg.HasNumBidders() {
+		v, err := msg.GetNumBidders()
+		if err != nil {
+			return s, err
+		}
+		s.NumBidders = &v
+	}
+	if msg.HasBidTradeType() {
+		v, err := msg.GetBidTradeType()
+		if err != nil {
+			return s, err
+		}
+		s.BidTradeType = v
+	}
+	if msg.HasBasisPxType() {
+		v, err := msg.GetBasisPxType()
+		if err != nil {
+			return s, err
+		}
+		s.BasisPxType = v
+	}
+	if msg.HasNoBidComponents() {
+		g, err := msg.GetNoBidComponents()
+		if err != nil {
+			return s, err
+		}
+		if s.NoBidComponents, err = MarshalNoBidComponents(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasStrikeTime() {
+		v, err := msg.GetStrikeTime()
+		if err != nil {
+			return s, err
+		}
+		s.StrikeTime = &v
+	}
+	return s, nil
+}
+
+//Unmarshal builds a BidRequest from s
+func Unmarshal(s Struct) BidRequest {
+	m := New(field.NewClientBidID(s.ClientBidID), field.NewBidRequestTransType(s.BidRequestTransType), field.NewTotNoRelatedSym(s.TotNoRelatedSym), field.NewBidType(s.BidType), field.NewBidTradeType(s.BidTradeType), field.NewBasisPxType(s.BasisPxType))
+	if s.Currency != nil {
+		m.SetCurrency(*s.Currency)
+	}
+	if s.Text != nil {
+		m.SetText(*s.Text)
+	}
+	if s.TradeDate != nil {
+		m.SetTradeDate(*s.TradeDate)
+	}
+	if s.ForexReq != nil {
+		m.SetForexReq(*s.ForexReq)
+	}
+	if s.EncodedTextLen != nil {
+		m.SetEncodedTextLen(*s.EncodedTextLen)
+	}
+	if s.EncodedText != nil {
+		m.SetEncodedText(*s.EncodedText)
+	}
+	if s.BidID != nil {
+		m.SetBidID(*s.BidID)
+	}
+	if s.ListName != nil {
+		m.SetListName(*s.ListName)
+	}
+	if s.NumTickets != nil {
+		m.SetNumTickets(*s.NumTickets)
+	}
+	if s.SideValue1 != nil {
+		m.SetSideValue1(*s.SideValue1, fix44.DecimalScale(*s.SideValue1))
+	}
+	if s.SideValue2 != nil {
+		m.SetSideValue2(*s.SideValue2, fix44.DecimalScale(*s.SideValue2))
+	}
+	if s.NoBidDescriptors != nil {
+		m.SetNoBidDescriptors(UnmarshalNoBidDescriptors(s.NoBidDescriptors))
+	}
+	if s.LiquidityIndType != nil {
+		m.SetLiquidityIndType(*s.LiquidityIndType)
+	}
+	if s.WtAverageLiquidity != nil {
+		m.SetWtAverageLiquidity(*s.WtAverageLiquidity, fix44.DecimalScale(*s.WtAverageLiquidity))
+	}
+	if s.ExchangeForPhysical != nil {
+		m.SetExchangeForPhysical(*s.ExchangeForPhysical)
+	}
+	if s.OutMainCntryUIndex != nil {
+		m.SetOutMainCntryUIndex(*s.OutMainCntryUIndex, fix44.DecimalScale(*s.OutMainCntryUIndex))
+	}
+	if s.CrossPercent != nil {
+		m.SetCrossPercent(*s.CrossPercent, fix44.DecimalScale(*s.CrossPercent))
+	}
+	if s.ProgRptReqs != nil {
+		m.SetProgRptReqs(*s.ProgRptReqs)
+	}
+	if s.ProgPeriodInterval != nil {
+		m.SetProgPeriodInterval(*s.ProgPeriodInterval)
+	}
+	if s.IncTaxInd != nil {
+		m.SetIncTaxInd(*s.IncTaxInd)
+	}
+	if s.NumBidders != nil {
+		m.SetNumBidders(*s.NumBidders)
+	}
+	if s.NoBidComponents != nil {
+		m.SetNoBidComponents(UnmarshalNoBidComponents(s.NoBidComponents))
+	}
+	if s.StrikeTime != nil {
+		m.SetStrikeTime(*s.StrikeTime)
+	}
+	return m
+}
+
+//NoBidDescriptorsStruct is a plain Go representation of a NoBidDescriptors group element, optional fields are nil when absent
+type NoBidDescriptorsStruct struct {
+	BidDescriptorType      *enum.BidDescriptorType
+	BidDescriptor          *string
+	SideValueInd           *enum.SideValueInd
+	LiquidityValue         *decimal.Decimal
+	LiquidityNumSecurities *int
+	LiquidityPctLow        *decimal.Decimal
+	LiquidityPctHigh       *decimal.Decimal
+	EFPTrackingError       *decimal.Decimal
+	FairValue              *decimal.Decimal
+	OutsideIndexPct        *decimal.Decimal
+	ValueOfFutures         *decimal.Decimal
+}
+
+//MarshalNoBidDescriptors copies the elements of g into a slice of NoBidDescriptorsStruct
+func MarshalNoBidDescriptors(g NoBidDescriptorsRepeatingGroup) ([]NoBidDescriptorsStruct, quickfix.MessageRejectError) {
+	s := make([]NoBidDescriptorsStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasBidDescriptorType() {
+			v, err := m.GetBidDescriptorType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].BidDescriptorType = &v
+		}
+		if m.HasBidDescriptor() {
+			v, err := m.GetBidDescriptor()
+			if err != nil {
+				return nil, err
+			}
+			s[i].BidDescriptor = &v
+		}
+		if m.HasSideValueInd() {
+			v, err := m.GetSideValueInd()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SideValueInd = &v
+		}
+		if m.HasLiquidityValue() {
+			v, err := m.GetLiquidityValue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LiquidityValue = &v
+		}
+		if m.HasLiquidityNumSecurities() {
+			v, err := m.GetLiquidityNumSecurities()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LiquidityNumSecurities = &v
+		}
+		if m.HasLiquidityPctLow() {
+			v, err := m.GetLiquidityPctLow()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LiquidityPctLow = &v
+		}
+		if m.HasLiquidityPctHigh() {
+			v, err := m.GetLiquidityPctHigh()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LiquidityPctHigh = &v
+		}
+		if m.HasEFPTrackingError() {
+			v, err := m.GetEFPTrackingError()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EFPTrackingError = &v
+		}
+		if m.HasFairValue() {
+			v, err := m.GetFairValue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].FairValue = &v
+		}
+		if m.HasOutsideIndexPct() {
+			v, err := m.GetOutsideIndexPct()
+			if err != nil {
+				return nil, err
+			}
+			s[i].OutsideIndexPct = &v
+		}
+		if m.HasValueOfFutures() {
+			v, err := m.GetValueOfFutures()
+			if err != nil {
+				return nil, err
+			}
+			s[i].ValueOfFutures = &v
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoBidDescriptors builds a NoBidDescriptorsRepeatingGroup from s
+func UnmarshalNoBidDescriptors(s []NoBidDescriptorsStruct) NoBidDescriptorsRepeatingGroup {
+	g := NewNoBidDescriptorsRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.BidDescriptorType != nil {
+			m.SetBidDescriptorType(*e.BidDescriptorType)
+		}
+		if e.BidDescriptor != nil {
+			m.SetBidDescriptor(*e.BidDescriptor)
+		}
+		if e.SideValueInd != nil {
+			m.SetSideValueInd(*e.SideValueInd)
+		}
+		if e.LiquidityValue != nil {
+			m.SetLiquidityValue(*e.LiquidityValue, fix44.DecimalScale(*e.LiquidityValue))
+		}
+		if e.LiquidityNumSecurities != nil {
+			m.SetLiquidityNumSecurities(*e.LiquidityNumSecurities)
+		}
+		if e.LiquidityPctLow != nil {
+			m.SetLiquidityPctLow(*e.LiquidityPctLow, fix44.DecimalScale(*e.LiquidityPctLow))
+		}
+		if e.LiquidityPctHigh != nil {
+			m.SetLiquidityPctHigh(*e.LiquidityPctHigh, fix44.DecimalScale(*e.LiquidityPctHigh))
+		}
+		if e.EFPTrackingError != nil {
+			m.SetEFPTrackingError(*e.EFPTrackingError, fix44.DecimalScale(*e.EFPTrackingError))
+		}
+		if e.FairValue != nil {
+			m.SetFairValue(*e.FairValue, fix44.DecimalScale(*e.FairValue))
+		}
+		if e.OutsideIndexPct != nil {
+			m.SetOutsideIndexPct(*e.OutsideIndexPct, fix44.DecimalScale(*e.OutsideIndexPct))
+		}
+		if e.ValueOfFutures != nil {
+			m.SetValueOfFutures(*e.ValueOfFutures, fix44.DecimalScale(*e.ValueOfFutures))
+		}
+	}
+	return g
+}
+
+//NoBidComponentsStruct is a plain Go representation of a NoBidComponents group element, optional fields are nil when absent
+type NoBidComponentsStruct struct {
+	ListID              *string
+	Side                *enum.Side
+	TradingSessionID    *enum.TradingSessionID
+	TradingSessionSubID *enum.TradingSessionSubID
+	NetGrossInd         *enum.NetGrossInd
+	SettlType           *enum.SettlType
+	SettlDate           *string
+	Account             *string
+	AcctIDSource        *enum.AcctIDSource
+}
+
+//MarshalNoBidComponents copies the elements of g into a slice of NoBidComponentsStruct
+func MarshalNoBidComponents(g NoBidComponentsRepeatingGroup) ([]NoBidComponentsStruct, quickfix.MessageRejectError) {
+	s := make([]NoBidComponentsStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasListID() {
+			v, err := m.GetListID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].ListID = &v
+		}
+		if m.HasSide() {
+			v, err := m.GetSide()
+			if err != nil {
+				return nil, err
+			}
+			s[i].Side = &v
+		}
+		if m.HasTradingSessionID() {
+			v, err := m.GetTradingSessionID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].TradingSessionID = &v
+		}
+		if m.HasTradingSessionSubID() {
+			v, err := m.GetTradingSessionSubID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].TradingSessionSubID = &v
+		}
+		if m.HasNetGrossInd() {
+			v, err := m.GetNetGrossInd()
+			if err != nil {
+				return nil, err
+			}
+			s[i].NetGrossInd = &v
+		}
+		if m.HasSettlType() {
+			v, err := m.GetSettlType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SettlType = &v
+		}
+		if m.HasSettlDate() {
+			v, err := m.GetSettlDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SettlDate = &v
+		}
+		if m.HasAccount() {
+			v, err := m.GetAccount()
+			if err != nil {
+				return nil, err
+			}
+			s[i].Account = &v
+		}
+		if m.HasAcctIDSource() {
+			v, err := m.GetAcctIDSource()
+			if err != nil {
+				return nil, err
+			}
+			s[i].AcctIDSource = &v
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoBidComponents builds a NoBidComponentsRepeatingGroup from s
+func UnmarshalNoBidComponents(s []NoBidComponentsStruct) NoBidComponentsRepeatingGroup {
+	g := NewNoBidComponentsRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.ListID != nil {
+			m.SetListID(*e.ListID)
+		}
+		if e.Side != nil {
+			m.SetSide(*e.Side)
+		}
+		if e.TradingSessionID != nil {
+			m.SetTradingSessionID(*e.TradingSessionID)
+		}
+		if e.TradingSessionSubID != nil {
+			m.SetTradingSessionSubID(*e.TradingSessionSubID)
+		}
+		if e.NetGrossInd != nil {
+			m.SetNetGrossInd(*e.NetGrossInd)
+		}
+		if e.SettlType != nil {
+			m.SetSettlType(*e.SettlType)
+		}
+		if e.SettlDate != nil {
+			m.SetSettlDate(*e.SettlDate)
+		}
+		if e.Account != nil {
+			m.SetAccount(*e.Account)
+		}
+		if e.AcctIDSource != nil {
+			m.SetAcctIDSource(*e.AcctIDSource)
+		}
+	}
+	return g
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/terracefi/enum"
 	"github.com/terracefi/field"
 	"github.com/terracefi/fix44"
+	"github.com/terracefi/fix44/components"
 	"github.com/terracefi/quickfix"
 	"github.com/terracefi/tag"
 )
@@ -56,6 +57,26 @@ func Route(router RouteOut) (string, string, quickfix.MessageRoute) {
 	return "FIX.4.4", "l", r
 }
 
+//Validate checks m against the FIX 4.4 definition of BidResponse, see fix44.MessageDef.Validate
+func (m BidResponse) Validate() fix44.ValidationErrors {
+	return fix44.MessageDefs["l"].Validate(&m.Body.FieldMap)
+}
+
+//MarshalJSON encodes the BidResponse as JSON with fields keyed by name, see fix44.MarshalMessageJSON
+func (m BidResponse) MarshalJSON() ([]byte, error) {
+	return fix44.MarshalMessageJSON(m.Message)
+}
+
+//UnmarshalJSON builds the BidResponse from JSON produced by MarshalJSON
+func (m *BidResponse) UnmarshalJSON(b []byte) error {
+	msg, err := fix44.UnmarshalMessageJSON(b, "l")
+	if err != nil {
+		return err
+	}
+	*m = FromMessage(msg)
+	return nil
+}
+
 //SetBidID sets BidID, Tag 390
 func (m BidResponse) SetBidID(v string) {
 	m.Set(field.NewBidID(v))
@@ -458,6 +479,31 @@ func (m NoBidComponents) HasEncodedText() bool {
 	return m.Has(tag.EncodedText)
 }
 
+//SetEncodedTextBytes sets EncodedText, Tag 355, and EncodedTextLen, Tag 354, from v, which may hold any bytes including SOH
+func (m NoBidComponents) SetEncodedTextBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedTextLen, tag.EncodedText, v)
+}
+
+//GetEncodedTextBytes gets EncodedText, Tag 355, checking its length against EncodedTextLen, Tag 354
+func (m NoBidComponents) GetEncodedTextBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedTextLen, tag.EncodedText)
+}
+
+//GetEncodedTextDecoded gets EncodedText, Tag 355, decoded to UTF-8 using the MessageEncoding of h, the Header of the message holding it
+func (m NoBidComponents) GetEncodedTextDecoded(h fix44.Header) (string, error) {
+	return fix44.DecodeRawData(h, m, tag.EncodedTextLen, tag.EncodedText)
+}
+
+//GetCommissionData gets the CommissionData component
+func (m NoBidComponents) GetCommissionData() components.CommissionData {
+	return components.CommissionData{&m.Group.FieldMap}
+}
+
+//SetCommissionData sets the CommissionData component, copying the fields present in c
+func (m NoBidComponents) SetCommissionData(c components.CommissionData) quickfix.MessageRejectError {
+	return c.CopyInto(&m.Group.FieldMap)
+}
+
 //NoBidComponentsRepeatingGroup is a repeating group, Tag 420
 type NoBidComponentsRepeatingGroup struct {
 	*quickfix.RepeatingGroup
@@ -480,3 +526,274 @@ func (m NoBidComponentsRepeatingGroup) Add() NoBidComponents {
 func (m NoBidComponentsRepeatingGroup) Get(i int) NoBidComponents {
 	return NoBidComponents{m.RepeatingGroup.Get(i)}
 }
+
+//Struct is a plain Go representation of the BidResponse body, optional fields are nil when absent
+type Struct struct {
+	BidID           *string
+	ClientBidID     *string
+	NoBidComponents []NoBidComponentsStruct
+}
+
+//Marshal copies the fields present in msg into a Struct
+func Marshal(msg BidResponse) (Struct, quickfix.MessageRejectError) {
+	var s Struct
+	if msg.HasBidID() {
+		v, err := msg.GetBidID()
+		if err != nil {
+			return s, err
+		}
+		s.BidID = &v
+	}
+	if msg.HasClientBidID() {
+		v, err := msg.GetClientBidID()
+		if err != nil {
+			return s, err
+		}
+		s.ClientBidID = &v
+	}
+	if msg.HasNoBidComponents() {
+		g, err := msg.GetNoBidComponents()
+		if err != nil {
+			return s, err
+		}
+		if s.NoBidComponents, err = MarshalNoBidComponents(g); err != nil {
+			return s, err
+		}
+	}
+	return s, nil
+}
+
+//Unmarshal builds a BidResponse from s
+func Unmarshal(s Struct) BidResponse {
+	m := New()
+	if s.BidID != nil {
+		m.SetBidID(*s.BidID)
+	}
+	if s.ClientBidID != nil {
+		m.SetClientBidID(*s.ClientBidID)
+	}
+	if s.NoBidComponents != nil {
+		m.SetNoBidComponents(UnmarshalNoBidComponents(s.NoBidComponents))
+	}
+	return m
+}
+
+//NoBidComponentsStruct is a plain Go representation of a NoBidComponents group element, optional fields are nil when absent
+type NoBidComponentsStruct struct {
+	Commission          *decimal.Decimal
+	CommType            *enum.CommType
+	CommCurrency        *string
+	FundRenewWaiv       *enum.FundRenewWaiv
+	ListID              *string
+	Country             *string
+	Side                *enum.Side
+	Price               *decimal.Decimal
+	PriceType           *enum.PriceType
+	FairValue           *decimal.Decimal
+	NetGrossInd         *enum.NetGrossInd
+	SettlType           *enum.SettlType
+	SettlDate           *string
+	TradingSessionID    *enum.TradingSessionID
+	TradingSessionSubID *enum.TradingSessionSubID
+	Text                *string
+	EncodedTextLen      *int
+	EncodedText         *string
+}
+
+//MarshalNoBidComponents copies the elements of g into a slice of NoBidComponentsStruct
+func MarshalNoBidComponents(g NoBidComponentsRepeatingGroup) ([]NoBidComponentsStruct, quickfix.MessageRejectError) {
+	s := make([]NoBidComponentsStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasCommission() {
+			v, err := m.GetCommission()
+			if err != nil {
+				return nil, err
+			}
+			s[i].Commission = &v
+		}
+		if m.HasCommType() {
+			v, err := m.GetCommType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].CommType = &v
+		}
+		if m.HasCommCurrency() {
+			v, err := m.GetCommCurrency()
+			if err != nil {
+				return nil, err
+			}
+			s[i].CommCurrency = &v
+		}
+		if m.HasFundRenewWaiv() {
+			v, err := m.GetFundRenewWaiv()
+			if err != nil {
+				return nil, err
+			}
+			s[i].FundRenewWaiv = &v
+		}
+		if m.HasListID() {
+			v, err := m.GetListID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].ListID = &v
+		}
+		if m.HasCountry() {
+			v, err := m.GetCountry()
+			if err != nil {
+				return nil, err
+			}
+			s[i].Country = &v
+		}
+		if m.HasSide() {
+			v, err := m.GetSide()
+			if err != nil {
+				return nil, err
+			}
+			s[i].Side = &v
+		}
+		if m.HasPrice() {
+			v, err := m.GetPrice()
+			if err != nil {
+				return nil, err
+			}
+			s[i].Price = &v
+		}
+		if m.HasPriceType() {
+			v, err := m.GetPriceType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].PriceType = &v
+		}
+		if m.HasFairValue() {
+			v, err := m.GetFairValue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].FairValue = &v
+		}
+		if m.HasNetGrossInd() {
+			v, err := m.GetNetGrossInd()
+			if err != nil {
+				return nil, err
+			}
+			s[i].NetGrossInd = &v
+		}
+		if m.HasSettlType() {
+			v, err := m.GetSettlType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SettlType = &v
+		}
+		if m.HasSettlDate() {
+			v, err := m.GetSettlDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SettlDate = &v
+		}
+		if m.HasTradingSessionID() {
+			v, err := m.GetTradingSessionID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].TradingSessionID = &v
+		}
+		if m.HasTradingSessionSubID() {
+			v, err := m.GetTradingSessionSubID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].TradingSessionSubID = &v
+		}
+		if m.HasText() {
+			v, err := m.GetText()
+			if err != nil {
+				return nil, err
+			}
+			s[i].Text = &v
+		}
+		if m.HasEncodedTextLen() {
+			v, err := m.GetEncodedTextLen()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedTextLen = &v
+		}
+		if m.HasEncodedText() {
+			v, err := m.GetEncodedText()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedText = &v
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoBidComponents builds a NoBidComponentsRepeatingGroup from s
+func UnmarshalNoBidComponents(s []NoBidComponentsStruct) NoBidComponentsRepeatingGroup {
+	g := NewNoBidComponentsRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.Commission != nil {
+			m.SetCommission(*e.Commission, fix44.DecimalScale(*e.Commission))
+		}
+		if e.CommType != nil {
+			m.SetCommType(*e.CommType)
+		}
+		if e.CommCurrency != nil {
+			m.SetCommCurrency(*e.CommCurrency)
+		}
+		if e.FundRenewWaiv != nil {
+			m.SetFundRenewWaiv(*e.FundRenewWaiv)
+		}
+		if e.ListID != nil {
+			m.SetListID(*e.ListID)
+		}
+		if e.Country != nil {
+			m.SetCountry(*e.Country)
+		}
+		if e.Side != nil {
+			m.SetSide(*e.Side)
+		}
+		if e.Price != nil {
+			m.SetPrice(*e.Price, fix44.DecimalScale(*e.Price))
+		}
+		if e.PriceType != nil {
+			m.SetPriceType(*e.PriceType)
+		}
+		if e.FairValue != nil {
+			m.SetFairValue(*e.FairValue, fix44.DecimalScale(*e.FairValue))
+		}
+		if e.NetGrossInd != nil {
+			m.SetNetGrossInd(*e.NetGrossInd)
+		}
+		if e.SettlType != nil {
+			m.SetSettlType(*e.SettlType)
+		}
+		if e.SettlDate != nil {
+			m.SetSettlDate(*e.SettlDate)
+		}
+		if e.TradingSessionID != nil {
+			m.SetTradingSessionID(*e.TradingSessionID)
+		}
+		if e.TradingSessionSubID != nil {
+			m.SetTradingSessionSubID(*e.TradingSessionSubID)
+		}
+		if e.Text != nil {
+			m.SetText(*e.Text)
+		}
+		if e.EncodedTextLen != nil {
+			m.SetEncodedTextLen(*e.EncodedTextLen)
+		}
+		if e.EncodedText != nil {
+			m.SetEncodedText(*e.EncodedText)
+		}
+	}
+	return g
+}
@@ -56,6 +56,26 @@ func Route(router RouteOut) (string, string, quickfix.MessageRoute) {
 	return "FIX.4.4", "j", r
 }
 
+//Validate checks m against the FIX 4.4 definition of BusinessMessageReject, see fix44.MessageDef.Validate
+func (m BusinessMessageReject) Validate() fix44.ValidationErrors {
+	return fix44.MessageDefs["j"].Validate(&m.Body.FieldMap)
+}
+
+//MarshalJSON encodes the BusinessMessageReject as JSON with fields keyed by name, see fix44.MarshalMessageJSON
+func (m BusinessMessageReject) MarshalJSON() ([]byte, error) {
+	return fix44.MarshalMessageJSON(m.Message)
+}
+
+//UnmarshalJSON builds the BusinessMessageReject from JSON produced by MarshalJSON
+func (m *BusinessMessageReject) UnmarshalJSON(b []byte) error {
+	msg, err := fix44.UnmarshalMessageJSON(b, "j")
+	if err != nil {
+		return err
+	}
+	*m = FromMessage(msg)
+	return nil
+}
+
 //SetRefSeqNum sets RefSeqNum, Tag 45
 func (m BusinessMessageReject) SetRefSeqNum(v int) {
 	m.Set(field.NewRefSeqNum(v))
@@ -174,6 +194,21 @@ func (m BusinessMessageReject) HasEncodedText() bool {
 	return m.Has(tag.EncodedText)
 }
 
+//SetEncodedTextBytes sets EncodedText, Tag 355, and EncodedTextLen, Tag 354, from v, which may hold any bytes including SOH
+func (m BusinessMessageReject) SetEncodedTextBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedTextLen, tag.EncodedText, v)
+}
+
+//GetEncodedTextBytes gets EncodedText, Tag 355, checking its length against EncodedTextLen, Tag 354
+func (m BusinessMessageReject) GetEncodedTextBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedTextLen, tag.EncodedText)
+}
+
+//GetEncodedTextDecoded gets EncodedText, Tag 355, decoded to UTF-8 using the MessageEncoding of the Header
+func (m BusinessMessageReject) GetEncodedTextDecoded() (string, error) {
+	return fix44.DecodeRawData(m.Header, m, tag.EncodedTextLen, tag.EncodedText)
+}
+
 //HasRefMsgType returns true if RefMsgType is present, Tag 372
 func (m BusinessMessageReject) HasRefMsgType() bool {
 	return m.Has(tag.RefMsgType)
@@ -188,3 +223,90 @@ func (m BusinessMessageReject) HasBusinessRejectRefID() bool {
 func (m BusinessMessageReject) HasBusinessRejectReason() bool {
 	return m.Has(tag.BusinessRejectReason)
 }
+
+//Struct is a plain Go representation of the BusinessMessageReject body, optional fields are nil when absent
+type Struct struct {
+	RefSeqNum            *int
+	Text                 *string
+	EncodedTextLen       *int
+	EncodedText          *string
+	RefMsgType           string
+	BusinessRejectRefID  *string
+	BusinessRejectReason enum.BusinessRejectReason
+}
+
+//Marshal copies the fields present in msg into a Struct
+func Marshal(msg BusinessMessageReject) (Struct, quickfix.MessageRejectError) {
+	var s Struct
+	if msg.HasRefSeqNum() {
+		v, err := msg.GetRefSeqNum()
+		if err != nil {
+			return s, err
+		}
+		s.RefSeqNum = &v
+	}
+	if msg.HasText() {
+		v, err := msg.GetText()
+		if err != nil {
+			return s, err
+		}
+		s.Text = &v
+	}
+	if msg.HasEncodedTextLen() {
+		v, err := msg.GetEncodedTextLen()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedTextLen = &v
+	}
+	if msg.HasEncodedText() {
+		v, err := msg.GetEncodedText()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedText = &v
+	}
+	if msg.HasRefMsgType() {
+		v, err := msg.GetRefMsgType()
+		if err != nil {
+			return s, err
+		}
+		s.RefMsgType = v
+	}
+	if msg.HasBusinessRejectRefID() {
+		v, err := msg.GetBusinessRejectRefID()
+		if err != nil {
+			return s, err
+		}
+		s.BusinessRejectRefID = &v
+	}
+	if msg.HasBusinessRejectReason() {
+		v, err := msg.GetBusinessRejectReason()
+		if err != nil {
+			return s, err
+		}
+		s.BusinessRejectReason = v
+	}
+	return s, nil
+}
+
+//Unmarshal builds a BusinessMessageReject from s
+func Unmarshal(s Struct) BusinessMessageReject {
+	m := New(field.NewRefMsgType(s.RefMsgType), field.NewBusinessRejectReason(s.BusinessRejectReason))
+	if s.RefSeqNum != nil {
+		m.SetRefSeqNum(*s.RefSeqNum)
+	}
+	if s.Text != nil {
+		m.SetText(*s.Text)
+	}
+	if s.EncodedTextLen != nil {
+		m.SetEncodedTextLen(*s.EncodedTextLen)
+	}
+	if s.EncodedText != nil {
+		m.SetEncodedText(*s.EncodedText)
+	}
+	if s.BusinessRejectRefID != nil {
+		m.SetBusinessRejectRefID(*s.BusinessRejectRefID)
+	}
+	return m
+}
@@ -0,0 +1,28 @@
+package businessmessagereject
+
+import (
+	"strconv"
+
+	"github.com/terracefi/enum"
+	"github.com/terracefi/field"
+	"github.com/terracefi/quickfix"
+	"github.com/terracefi/tag"
+)
+
+//FromError returns the BusinessMessageReject that answers msg for the business level error rej. RefSeqNum and
+//RefMsgType are taken from the Header of msg, BusinessRejectReason, BusinessRejectRefID and Text from rej.
+func FromError(msg *quickfix.Message, rej quickfix.MessageRejectError) BusinessMessageReject {
+	refMsgType, _ := msg.Header.GetString(tag.MsgType)
+	reason := enum.BusinessRejectReason(strconv.Itoa(rej.RejectReason()))
+	m := New(field.NewRefMsgType(refMsgType), field.NewBusinessRejectReason(reason))
+
+	if refSeqNum, err := msg.Header.GetInt(tag.MsgSeqNum); err == nil {
+		m.SetRefSeqNum(refSeqNum)
+	}
+	if refID := rej.BusinessRejectRefID(); refID != "" {
+		m.SetBusinessRejectRefID(refID)
+	}
+	m.SetText(rej.Error())
+
+	return m
+}
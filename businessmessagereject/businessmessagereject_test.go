@@ -0,0 +1,50 @@
+package businessmessagereject
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/terracefi/enum"
+	"github.com/terracefi/quickfix"
+	"github.com/terracefi/tag"
+)
+
+func TestFromError(t *testing.T) {
+	tests := []struct {
+		name      string
+		seqNum    int
+		rej       quickfix.MessageRejectError
+		wantRefID string
+	}{
+		{"reason", 7, quickfix.UnsupportedMessageType(), ""},
+		{"ref id", 7, quickfix.NewBusinessMessageRejectErrorWithRefID("unknown", 1, "ORD1", nil), "ORD1"},
+		{"no MsgSeqNum", 0, quickfix.UnsupportedMessageType(), ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := quickfix.NewMessage()
+			msg.Header.SetString(tag.MsgType, "D")
+			if tt.seqNum > 0 {
+				msg.Header.SetInt(tag.MsgSeqNum, tt.seqNum)
+			}
+
+			m := FromError(msg, tt.rej)
+			if v, err := m.GetRefMsgType(); err != nil || v != "D" {
+				t.Errorf("RefMsgType = %q, %v, want D", v, err)
+			}
+			want := enum.BusinessRejectReason(fmt.Sprint(tt.rej.RejectReason()))
+			if v, err := m.GetBusinessRejectReason(); err != nil || v != want {
+				t.Errorf("BusinessRejectReason = %q, %v, want %q", v, err, want)
+			}
+			if m.HasRefSeqNum() != (tt.seqNum > 0) {
+				t.Errorf("HasRefSeqNum() = %v, want %v", m.HasRefSeqNum(), tt.seqNum > 0)
+			}
+			if v, _ := m.GetBusinessRejectRefID(); v != tt.wantRefID {
+				t.Errorf("BusinessRejectRefID = %q, want %q", v, tt.wantRefID)
+			}
+			if v, err := m.GetText(); err != nil || v != tt.rej.Error() {
+				t.Errorf("Text = %q, %v, want %q", v, err, tt.rej.Error())
+			}
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/terracefi/enum"
 	"github.com/terracefi/field"
 	"github.com/terracefi/fix44"
+	"github.com/terracefi/fix44/components"
 	"github.com/terracefi/quickfix"
 	"github.com/terracefi/tag"
 )
@@ -62,6 +63,26 @@ func Route(router RouteOut) (string, string, quickfix.MessageRoute) {
 	return "FIX.4.4", "AY", r
 }
 
+//Validate checks m against the FIX 4.4 definition of CollateralAssignment, see fix44.MessageDef.Validate
+func (m CollateralAssignment) Validate() fix44.ValidationErrors {
+	return fix44.MessageDefs["AY"].Validate(&m.Body.FieldMap)
+}
+
+//MarshalJSON encodes the CollateralAssignment as JSON with fields keyed by name, see fix44.MarshalMessageJSON
+func (m CollateralAssignment) MarshalJSON() ([]byte, error) {
+	return fix44.MarshalMessageJSON(m.Message)
+}
+
+//UnmarshalJSON builds the CollateralAssignment from JSON produced by MarshalJSON
+func (m *CollateralAssignment) UnmarshalJSON(b []byte) error {
+	msg, err := fix44.UnmarshalMessageJSON(b, "AY")
+	if err != nil {
+		return err
+	}
+	*m = FromMessage(msg)
+	return nil
+}
+
 //SetAccount sets Account, Tag 1
 func (m CollateralAssignment) SetAccount(v string) {
 	m.Set(field.NewAccount(v))
@@ -1783,6 +1804,21 @@ func (m CollateralAssignment) HasEncodedIssuer() bool {
 	return m.Has(tag.EncodedIssuer)
 }
 
+//SetEncodedIssuerBytes sets EncodedIssuer, Tag 349, and EncodedIssuerLen, Tag 348, from v, which may hold any bytes including SOH
+func (m CollateralAssignment) SetEncodedIssuerBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedIssuerLen, tag.EncodedIssuer, v)
+}
+
+//GetEncodedIssuerBytes gets EncodedIssuer, Tag 349, checking its length against EncodedIssuerLen, Tag 348
+func (m CollateralAssignment) GetEncodedIssuerBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedIssuerLen, tag.EncodedIssuer)
+}
+
+//GetEncodedIssuerDecoded gets EncodedIssuer, Tag 349, decoded to UTF-8 using the MessageEncoding of the Header
+func (m CollateralAssignment) GetEncodedIssuerDecoded() (string, error) {
+	return fix44.DecodeRawData(m.Header, m, tag.EncodedIssuerLen, tag.EncodedIssuer)
+}
+
 //HasEncodedSecurityDescLen returns true if EncodedSecurityDescLen is present, Tag 350
 func (m CollateralAssignment) HasEncodedSecurityDescLen() bool {
 	return m.Has(tag.EncodedSecurityDescLen)
@@ -1793,6 +1829,21 @@ func (m CollateralAssignment) HasEncodedSecurityDesc() bool {
 	return m.Has(tag.EncodedSecurityDesc)
 }
 
+//SetEncodedSecurityDescBytes sets EncodedSecurityDesc, Tag 351, and EncodedSecurityDescLen, Tag 350, from v, which may hold any bytes including SOH
+func (m CollateralAssignment) SetEncodedSecurityDescBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedSecurityDescLen, tag.EncodedSecurityDesc, v)
+}
+
+//GetEncodedSecurityDescBytes gets EncodedSecurityDesc, Tag 351, checking its length against EncodedSecurityDescLen, Tag 350
+func (m CollateralAssignment) GetEncodedSecurityDescBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedSecurityDescLen, tag.EncodedSecurityDesc)
+}
+
+//GetEncodedSecurityDescDecoded gets EncodedSecurityDesc, Tag 351, decoded to UTF-8 using the MessageEncoding of the Header
+func (m CollateralAssignment) GetEncodedSecurityDescDecoded() (string, error) {
+	return fix44.DecodeRawData(m.Header, m, tag.EncodedSecurityDescLen, tag.EncodedSecurityDesc)
+}
+
 //HasEncodedTextLen returns true if EncodedTextLen is present, Tag 354
 func (m CollateralAssignment) HasEncodedTextLen() bool {
 	return m.Has(tag.EncodedTextLen)
@@ -1803,6 +1854,21 @@ func (m CollateralAssignment) HasEncodedText() bool {
 	return m.Has(tag.EncodedText)
 }
 
+//SetEncodedTextBytes sets EncodedText, Tag 355, and EncodedTextLen, Tag 354, from v, which may hold any bytes including SOH
+func (m CollateralAssignment) SetEncodedTextBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedTextLen, tag.EncodedText, v)
+}
+
+//GetEncodedTextBytes gets EncodedText, Tag 355, checking its length against EncodedTextLen, Tag 354
+func (m CollateralAssignment) GetEncodedTextBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedTextLen, tag.EncodedText)
+}
+
+//GetEncodedTextDecoded gets EncodedText, Tag 355, decoded to UTF-8 using the MessageEncoding of the Header
+func (m CollateralAssignment) GetEncodedTextDecoded() (string, error) {
+	return fix44.DecodeRawData(m.Header, m, tag.EncodedTextLen, tag.EncodedText)
+}
+
 //HasPriceType returns true if PriceType is present, Tag 423
 func (m CollateralAssignment) HasPriceType() bool {
 	return m.Has(tag.PriceType)
@@ -2073,6 +2139,46 @@ func (m CollateralAssignment) HasStrikeCurrency() bool {
 	return m.Has(tag.StrikeCurrency)
 }
 
+//GetInstrument gets the Instrument component
+func (m CollateralAssignment) GetInstrument() components.Instrument {
+	return components.Instrument{&m.Body.FieldMap}
+}
+
+//SetInstrument sets the Instrument component, copying the fields present in c
+func (m CollateralAssignment) SetInstrument(c components.Instrument) quickfix.MessageRejectError {
+	return c.CopyInto(&m.Body.FieldMap)
+}
+
+//GetParties gets the Parties component
+func (m CollateralAssignment) GetParties() components.Parties {
+	return components.Parties{&m.Body.FieldMap}
+}
+
+//SetParties sets the Parties component, copying the fields present in c
+func (m CollateralAssignment) SetParties(c components.Parties) quickfix.MessageRejectError {
+	return c.CopyInto(&m.Body.FieldMap)
+}
+
+//GetStipulations gets the Stipulations component
+func (m CollateralAssignment) GetStipulations() components.Stipulations {
+	return components.Stipulations{&m.Body.FieldMap}
+}
+
+//SetStipulations sets the Stipulations component, copying the fields present in c
+func (m CollateralAssignment) SetStipulations(c components.Stipulations) quickfix.MessageRejectError {
+	return c.CopyInto(&m.Body.FieldMap)
+}
+
+//GetSpreadOrBenchmarkCurveData gets the SpreadOrBenchmarkCurveData component
+func (m CollateralAssignment) GetSpreadOrBenchmarkCurveData() components.SpreadOrBenchmarkCurveData {
+	return components.SpreadOrBenchmarkCurveData{&m.Body.FieldMap}
+}
+
+//SetSpreadOrBenchmarkCurveData sets the SpreadOrBenchmarkCurveData component, copying the fields present in c
+func (m CollateralAssignment) SetSpreadOrBenchmarkCurveData(c components.SpreadOrBenchmarkCurveData) quickfix.MessageRejectError {
+	return c.CopyInto(&m.Body.FieldMap)
+}
+
 //NoDlvyInst is a repeating group element, Tag 85
 type NoDlvyInst struct {
 	*quickfix.Group
@@ -2476,304 +2582,60 @@ func (m NoMiscFeesRepeatingGroup) Get(i int) NoMiscFees {
 }
 
 //NoStipulations is a repeating group element, Tag 232
-type NoStipulations struct {
-	*quickfix.Group
-}
-
-//SetStipulationType sets StipulationType, Tag 233
-func (m NoStipulations) SetStipulationType(v enum.StipulationType) {
-	m.Set(field.NewStipulationType(v))
-}
-
-//SetStipulationValue sets StipulationValue, Tag 234
-func (m NoStipulations) SetStipulationValue(v string) {
-	m.Set(field.NewStipulationValue(v))
-}
-
-//GetStipulationType gets StipulationType, Tag 233
-func (m NoStipulations) GetStipulationType() (v enum.StipulationType, err quickfix.MessageRejectError) {
-	var f field.StipulationTypeField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetStipulationValue gets StipulationValue, Tag 234
-func (m NoStipulations) GetStipulationValue() (v string, err quickfix.MessageRejectError) {
-	var f field.StipulationValueField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//HasStipulationType returns true if StipulationType is present, Tag 233
-func (m NoStipulations) HasStipulationType() bool {
-	return m.Has(tag.StipulationType)
-}
-
-//HasStipulationValue returns true if StipulationValue is present, Tag 234
-func (m NoStipulations) HasStipulationValue() bool {
-	return m.Has(tag.StipulationValue)
-}
+type NoStipulations = components.NoStipulations
 
 //NoStipulationsRepeatingGroup is a repeating group, Tag 232
-type NoStipulationsRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
+type NoStipulationsRepeatingGroup = components.NoStipulationsRepeatingGroup
 
 //NewNoStipulationsRepeatingGroup returns an initialized, NoStipulationsRepeatingGroup
 func NewNoStipulationsRepeatingGroup() NoStipulationsRepeatingGroup {
-	return NoStipulationsRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoStipulations,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.StipulationType), quickfix.GroupElement(tag.StipulationValue)})}
+	return components.NewNoStipulationsRepeatingGroup()
 }
 
-//Add create and append a new NoStipulations to this group
-func (m NoStipulationsRepeatingGroup) Add() NoStipulations {
-	g := m.RepeatingGroup.Add()
-	return NoStipulations{g}
-}
-
-//Get returns the ith NoStipulations in the NoStipulationsRepeatinGroup
-func (m NoStipulationsRepeatingGroup) Get(i int) NoStipulations {
-	return NoStipulations{m.RepeatingGroup.Get(i)}
-}
+//NoStipulationsStruct is a plain Go representation of a NoStipulations group element
+type NoStipulationsStruct = components.NoStipulationsStruct
 
 //NoPartyIDs is a repeating group element, Tag 453
-type NoPartyIDs struct {
-	*quickfix.Group
-}
-
-//SetPartyID sets PartyID, Tag 448
-func (m NoPartyIDs) SetPartyID(v string) {
-	m.Set(field.NewPartyID(v))
-}
-
-//SetPartyIDSource sets PartyIDSource, Tag 447
-func (m NoPartyIDs) SetPartyIDSource(v enum.PartyIDSource) {
-	m.Set(field.NewPartyIDSource(v))
-}
-
-//SetPartyRole sets PartyRole, Tag 452
-func (m NoPartyIDs) SetPartyRole(v enum.PartyRole) {
-	m.Set(field.NewPartyRole(v))
-}
-
-//SetNoPartySubIDs sets NoPartySubIDs, Tag 802
-func (m NoPartyIDs) SetNoPartySubIDs(f NoPartySubIDsRepeatingGroup) {
-	m.SetGroup(f)
-}
-
-//GetPartyID gets PartyID, Tag 448
-func (m NoPartyIDs) GetPartyID() (v string, err quickfix.MessageRejectError) {
-	var f field.PartyIDField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetPartyIDSource gets PartyIDSource, Tag 447
-func (m NoPartyIDs) GetPartyIDSource() (v enum.PartyIDSource, err quickfix.MessageRejectError) {
-	var f field.PartyIDSourceField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
+type NoPartyIDs = components.NoPartyIDs
 
-//GetPartyRole gets PartyRole, Tag 452
-func (m NoPartyIDs) GetPartyRole() (v enum.PartyRole, err quickfix.MessageRejectError) {
-	var f field.PartyRoleField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetNoPartySubIDs gets NoPartySubIDs, Tag 802
-func (m NoPartyIDs) GetNoPartySubIDs() (NoPartySubIDsRepeatingGroup, quickfix.MessageRejectError) {
-	f := NewNoPartySubIDsRepeatingGroup()
-	err := m.GetGroup(f)
-	return f, err
-}
-
-//HasPartyID returns true if PartyID is present, Tag 448
-func (m NoPartyIDs) HasPartyID() bool {
-	return m.Has(tag.PartyID)
-}
-
-//HasPartyIDSource returns true if PartyIDSource is present, Tag 447
-func (m NoPartyIDs) HasPartyIDSource() bool {
-	return m.Has(tag.PartyIDSource)
-}
+//NoPartyIDsRepeatingGroup is a repeating group, Tag 453
+type NoPartyIDsRepeatingGroup = components.NoPartyIDsRepeatingGroup
 
-//HasPartyRole returns true if PartyRole is present, Tag 452
-func (m NoPartyIDs) HasPartyRole() bool {
-	return m.Has(tag.PartyRole)
+//NewNoPartyIDsRepeatingGroup returns an initialized, NoPartyIDsRepeatingGroup
+func NewNoPartyIDsRepeatingGroup() NoPartyIDsRepeatingGroup {
+	return components.NewNoPartyIDsRepeatingGroup()
 }
 
-//HasNoPartySubIDs returns true if NoPartySubIDs is present, Tag 802
-func (m NoPartyIDs) HasNoPartySubIDs() bool {
-	return m.Has(tag.NoPartySubIDs)
-}
+//NoPartyIDsStruct is a plain Go representation of a NoPartyIDs group element
+type NoPartyIDsStruct = components.NoPartyIDsStruct
 
 //NoPartySubIDs is a repeating group element, Tag 802
-type NoPartySubIDs struct {
-	*quickfix.Group
-}
-
-//SetPartySubID sets PartySubID, Tag 523
-func (m NoPartySubIDs) SetPartySubID(v string) {
-	m.Set(field.NewPartySubID(v))
-}
-
-//SetPartySubIDType sets PartySubIDType, Tag 803
-func (m NoPartySubIDs) SetPartySubIDType(v enum.PartySubIDType) {
-	m.Set(field.NewPartySubIDType(v))
-}
-
-//GetPartySubID gets PartySubID, Tag 523
-func (m NoPartySubIDs) GetPartySubID() (v string, err quickfix.MessageRejectError) {
-	var f field.PartySubIDField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetPartySubIDType gets PartySubIDType, Tag 803
-func (m NoPartySubIDs) GetPartySubIDType() (v enum.PartySubIDType, err quickfix.MessageRejectError) {
-	var f field.PartySubIDTypeField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//HasPartySubID returns true if PartySubID is present, Tag 523
-func (m NoPartySubIDs) HasPartySubID() bool {
-	return m.Has(tag.PartySubID)
-}
-
-//HasPartySubIDType returns true if PartySubIDType is present, Tag 803
-func (m NoPartySubIDs) HasPartySubIDType() bool {
-	return m.Has(tag.PartySubIDType)
-}
+type NoPartySubIDs = components.NoPartySubIDs
 
 //NoPartySubIDsRepeatingGroup is a repeating group, Tag 802
-type NoPartySubIDsRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
+type NoPartySubIDsRepeatingGroup = components.NoPartySubIDsRepeatingGroup
 
 //NewNoPartySubIDsRepeatingGroup returns an initialized, NoPartySubIDsRepeatingGroup
 func NewNoPartySubIDsRepeatingGroup() NoPartySubIDsRepeatingGroup {
-	return NoPartySubIDsRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoPartySubIDs,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.PartySubID), quickfix.GroupElement(tag.PartySubIDType)})}
-}
-
-//Add create and append a new NoPartySubIDs to this group
-func (m NoPartySubIDsRepeatingGroup) Add() NoPartySubIDs {
-	g := m.RepeatingGroup.Add()
-	return NoPartySubIDs{g}
-}
-
-//Get returns the ith NoPartySubIDs in the NoPartySubIDsRepeatinGroup
-func (m NoPartySubIDsRepeatingGroup) Get(i int) NoPartySubIDs {
-	return NoPartySubIDs{m.RepeatingGroup.Get(i)}
-}
-
-//NoPartyIDsRepeatingGroup is a repeating group, Tag 453
-type NoPartyIDsRepeatingGroup struct {
-	*quickfix.RepeatingGroup
+	return components.NewNoPartySubIDsRepeatingGroup()
 }
 
-//NewNoPartyIDsRepeatingGroup returns an initialized, NoPartyIDsRepeatingGroup
-func NewNoPartyIDsRepeatingGroup() NoPartyIDsRepeatingGroup {
-	return NoPartyIDsRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoPartyIDs,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.PartyID), quickfix.GroupElement(tag.PartyIDSource), quickfix.GroupElement(tag.PartyRole), NewNoPartySubIDsRepeatingGroup()})}
-}
-
-//Add create and append a new NoPartyIDs to this group
-func (m NoPartyIDsRepeatingGroup) Add() NoPartyIDs {
-	g := m.RepeatingGroup.Add()
-	return NoPartyIDs{g}
-}
-
-//Get returns the ith NoPartyIDs in the NoPartyIDsRepeatinGroup
-func (m NoPartyIDsRepeatingGroup) Get(i int) NoPartyIDs {
-	return NoPartyIDs{m.RepeatingGroup.Get(i)}
-}
+//NoPartySubIDsStruct is a plain Go representation of a NoPartySubIDs group element
+type NoPartySubIDsStruct = components.NoPartySubIDsStruct
 
 //NoSecurityAltID is a repeating group element, Tag 454
-type NoSecurityAltID struct {
-	*quickfix.Group
-}
-
-//SetSecurityAltID sets SecurityAltID, Tag 455
-func (m NoSecurityAltID) SetSecurityAltID(v string) {
-	m.Set(field.NewSecurityAltID(v))
-}
-
-//SetSecurityAltIDSource sets SecurityAltIDSource, Tag 456
-func (m NoSecurityAltID) SetSecurityAltIDSource(v string) {
-	m.Set(field.NewSecurityAltIDSource(v))
-}
-
-//GetSecurityAltID gets SecurityAltID, Tag 455
-func (m NoSecurityAltID) GetSecurityAltID() (v string, err quickfix.MessageRejectError) {
-	var f field.SecurityAltIDField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetSecurityAltIDSource gets SecurityAltIDSource, Tag 456
-func (m NoSecurityAltID) GetSecurityAltIDSource() (v string, err quickfix.MessageRejectError) {
-	var f field.SecurityAltIDSourceField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//HasSecurityAltID returns true if SecurityAltID is present, Tag 455
-func (m NoSecurityAltID) HasSecurityAltID() bool {
-	return m.Has(tag.SecurityAltID)
-}
-
-//HasSecurityAltIDSource returns true if SecurityAltIDSource is present, Tag 456
-func (m NoSecurityAltID) HasSecurityAltIDSource() bool {
-	return m.Has(tag.SecurityAltIDSource)
-}
+type NoSecurityAltID = components.NoSecurityAltID
 
 //NoSecurityAltIDRepeatingGroup is a repeating group, Tag 454
-type NoSecurityAltIDRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
+type NoSecurityAltIDRepeatingGroup = components.NoSecurityAltIDRepeatingGroup
 
 //NewNoSecurityAltIDRepeatingGroup returns an initialized, NoSecurityAltIDRepeatingGroup
 func NewNoSecurityAltIDRepeatingGroup() NoSecurityAltIDRepeatingGroup {
-	return NoSecurityAltIDRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoSecurityAltID,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.SecurityAltID), quickfix.GroupElement(tag.SecurityAltIDSource)})}
+	return components.NewNoSecurityAltIDRepeatingGroup()
 }
 
-//Add create and append a new NoSecurityAltID to this group
-func (m NoSecurityAltIDRepeatingGroup) Add() NoSecurityAltID {
-	g := m.RepeatingGroup.Add()
-	return NoSecurityAltID{g}
-}
-
-//Get returns the ith NoSecurityAltID in the NoSecurityAltIDRepeatinGroup
-func (m NoSecurityAltIDRepeatingGroup) Get(i int) NoSecurityAltID {
-	return NoSecurityAltID{m.RepeatingGroup.Get(i)}
-}
+//NoSecurityAltIDStruct is a plain Go representation of a NoSecurityAltID group element
+type NoSecurityAltIDStruct = components.NoSecurityAltIDStruct
 
 //NoLegs is a repeating group element, Tag 555
 type NoLegs struct {
@@ -3526,6 +3388,21 @@ func (m NoLegs) HasEncodedLegIssuer() bool {
 	return m.Has(tag.EncodedLegIssuer)
 }
 
+//SetEncodedLegIssuerBytes sets EncodedLegIssuer, Tag 619, and EncodedLegIssuerLen, Tag 618, from v, which may hold any bytes including SOH
+func (m NoLegs) SetEncodedLegIssuerBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedLegIssuerLen, tag.EncodedLegIssuer, v)
+}
+
+//GetEncodedLegIssuerBytes gets EncodedLegIssuer, Tag 619, checking its length against EncodedLegIssuerLen, Tag 618
+func (m NoLegs) GetEncodedLegIssuerBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedLegIssuerLen, tag.EncodedLegIssuer)
+}
+
+//GetEncodedLegIssuerDecoded gets EncodedLegIssuer, Tag 619, decoded to UTF-8 using the MessageEncoding of h, the Header of the message holding it
+func (m NoLegs) GetEncodedLegIssuerDecoded(h fix44.Header) (string, error) {
+	return fix44.DecodeRawData(h, m, tag.EncodedLegIssuerLen, tag.EncodedLegIssuer)
+}
+
 //HasLegSecurityDesc returns true if LegSecurityDesc is present, Tag 620
 func (m NoLegs) HasLegSecurityDesc() bool {
 	return m.Has(tag.LegSecurityDesc)
@@ -3541,6 +3418,21 @@ func (m NoLegs) HasEncodedLegSecurityDesc() bool {
 	return m.Has(tag.EncodedLegSecurityDesc)
 }
 
+//SetEncodedLegSecurityDescBytes sets EncodedLegSecurityDesc, Tag 622, and EncodedLegSecurityDescLen, Tag 621, from v, which may hold any bytes including SOH
+func (m NoLegs) SetEncodedLegSecurityDescBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedLegSecurityDescLen, tag.EncodedLegSecurityDesc, v)
+}
+
+//GetEncodedLegSecurityDescBytes gets EncodedLegSecurityDesc, Tag 622, checking its length against EncodedLegSecurityDescLen, Tag 621
+func (m NoLegs) GetEncodedLegSecurityDescBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedLegSecurityDescLen, tag.EncodedLegSecurityDesc)
+}
+
+//GetEncodedLegSecurityDescDecoded gets EncodedLegSecurityDesc, Tag 622, decoded to UTF-8 using the MessageEncoding of h, the Header of the message holding it
+func (m NoLegs) GetEncodedLegSecurityDescDecoded(h fix44.Header) (string, error) {
+	return fix44.DecodeRawData(h, m, tag.EncodedLegSecurityDescLen, tag.EncodedLegSecurityDesc)
+}
+
 //HasLegRatioQty returns true if LegRatioQty is present, Tag 623
 func (m NoLegs) HasLegRatioQty() bool {
 	return m.Has(tag.LegRatioQty)
@@ -3576,71 +3468,29 @@ func (m NoLegs) HasLegInterestAccrualDate() bool {
 	return m.Has(tag.LegInterestAccrualDate)
 }
 
-//NoLegSecurityAltID is a repeating group element, Tag 604
-type NoLegSecurityAltID struct {
-	*quickfix.Group
-}
-
-//SetLegSecurityAltID sets LegSecurityAltID, Tag 605
-func (m NoLegSecurityAltID) SetLegSecurityAltID(v string) {
-	m.Set(field.NewLegSecurityAltID(v))
-}
-
-//SetLegSecurityAltIDSource sets LegSecurityAltIDSource, Tag 606
-func (m NoLegSecurityAltID) SetLegSecurityAltIDSource(v string) {
-	m.Set(field.NewLegSecurityAltIDSource(v))
+//GetInstrumentLeg gets the InstrumentLeg component
+func (m NoLegs) GetInstrumentLeg() components.InstrumentLeg {
+	return components.InstrumentLeg{&m.Group.FieldMap}
 }
 
-//GetLegSecurityAltID gets LegSecurityAltID, Tag 605
-func (m NoLegSecurityAltID) GetLegSecurityAltID() (v string, err quickfix.MessageRejectError) {
-	var f field.LegSecurityAltIDField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
+//SetInstrumentLeg sets the InstrumentLeg component, copying the fields present in c
+func (m NoLegs) SetInstrumentLeg(c components.InstrumentLeg) quickfix.MessageRejectError {
+	return c.CopyInto(&m.Group.FieldMap)
 }
 
-//GetLegSecurityAltIDSource gets LegSecurityAltIDSource, Tag 606
-func (m NoLegSecurityAltID) GetLegSecurityAltIDSource() (v string, err quickfix.MessageRejectError) {
-	var f field.LegSecurityAltIDSourceField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//HasLegSecurityAltID returns true if LegSecurityAltID is present, Tag 605
-func (m NoLegSecurityAltID) HasLegSecurityAltID() bool {
-	return m.Has(tag.LegSecurityAltID)
-}
-
-//HasLegSecurityAltIDSource returns true if LegSecurityAltIDSource is present, Tag 606
-func (m NoLegSecurityAltID) HasLegSecurityAltIDSource() bool {
-	return m.Has(tag.LegSecurityAltIDSource)
-}
+//NoLegSecurityAltID is a repeating group element, Tag 604
+type NoLegSecurityAltID = components.NoLegSecurityAltID
 
 //NoLegSecurityAltIDRepeatingGroup is a repeating group, Tag 604
-type NoLegSecurityAltIDRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
+type NoLegSecurityAltIDRepeatingGroup = components.NoLegSecurityAltIDRepeatingGroup
 
 //NewNoLegSecurityAltIDRepeatingGroup returns an initialized, NoLegSecurityAltIDRepeatingGroup
 func NewNoLegSecurityAltIDRepeatingGroup() NoLegSecurityAltIDRepeatingGroup {
-	return NoLegSecurityAltIDRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoLegSecurityAltID,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.LegSecurityAltID), quickfix.GroupElement(tag.LegSecurityAltIDSource)})}
-}
-
-//Add create and append a new NoLegSecurityAltID to this group
-func (m NoLegSecurityAltIDRepeatingGroup) Add() NoLegSecurityAltID {
-	g := m.RepeatingGroup.Add()
-	return NoLegSecurityAltID{g}
+	return components.NewNoLegSecurityAltIDRepeatingGroup()
 }
 
-//Get returns the ith NoLegSecurityAltID in the NoLegSecurityAltIDRepeatinGroup
-func (m NoLegSecurityAltIDRepeatingGroup) Get(i int) NoLegSecurityAltID {
-	return NoLegSecurityAltID{m.RepeatingGroup.Get(i)}
-}
+//NoLegSecurityAltIDStruct is a plain Go representation of a NoLegSecurityAltID group element
+type NoLegSecurityAltIDStruct = components.NoLegSecurityAltIDStruct
 
 //NoLegsRepeatingGroup is a repeating group, Tag 555
 type NoLegsRepeatingGroup struct {
@@ -4484,6 +4334,21 @@ func (m NoUnderlyings) HasEncodedUnderlyingIssuer() bool {
 	return m.Has(tag.EncodedUnderlyingIssuer)
 }
 
+//SetEncodedUnderlyingIssuerBytes sets EncodedUnderlyingIssuer, Tag 363, and EncodedUnderlyingIssuerLen, Tag 362, from v, which may hold any bytes including SOH
+func (m NoUnderlyings) SetEncodedUnderlyingIssuerBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedUnderlyingIssuerLen, tag.EncodedUnderlyingIssuer, v)
+}
+
+//GetEncodedUnderlyingIssuerBytes gets EncodedUnderlyingIssuer, Tag 363, checking its length against EncodedUnderlyingIssuerLen, Tag 362
+func (m NoUnderlyings) GetEncodedUnderlyingIssuerBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedUnderlyingIssuerLen, tag.EncodedUnderlyingIssuer)
+}
+
+//GetEncodedUnderlyingIssuerDecoded gets EncodedUnderlyingIssuer, Tag 363, decoded to UTF-8 using the MessageEncoding of h, the Header of the message holding it
+func (m NoUnderlyings) GetEncodedUnderlyingIssuerDecoded(h fix44.Header) (string, error) {
+	return fix44.DecodeRawData(h, m, tag.EncodedUnderlyingIssuerLen, tag.EncodedUnderlyingIssuer)
+}
+
 //HasUnderlyingSecurityDesc returns true if UnderlyingSecurityDesc is present, Tag 307
 func (m NoUnderlyings) HasUnderlyingSecurityDesc() bool {
 	return m.Has(tag.UnderlyingSecurityDesc)
@@ -4499,6 +4364,21 @@ func (m NoUnderlyings) HasEncodedUnderlyingSecurityDesc() bool {
 	return m.Has(tag.EncodedUnderlyingSecurityDesc)
 }
 
+//SetEncodedUnderlyingSecurityDescBytes sets EncodedUnderlyingSecurityDesc, Tag 365, and EncodedUnderlyingSecurityDescLen, Tag 364, from v, which may hold any bytes including SOH
+func (m NoUnderlyings) SetEncodedUnderlyingSecurityDescBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedUnderlyingSecurityDescLen, tag.EncodedUnderlyingSecurityDesc, v)
+}
+
+//GetEncodedUnderlyingSecurityDescBytes gets EncodedUnderlyingSecurityDesc, Tag 365, checking its length against EncodedUnderlyingSecurityDescLen, Tag 364
+func (m NoUnderlyings) GetEncodedUnderlyingSecurityDescBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedUnderlyingSecurityDescLen, tag.EncodedUnderlyingSecurityDesc)
+}
+
+//GetEncodedUnderlyingSecurityDescDecoded gets EncodedUnderlyingSecurityDesc, Tag 365, decoded to UTF-8 using the MessageEncoding of h, the Header of the message holding it
+func (m NoUnderlyings) GetEncodedUnderlyingSecurityDescDecoded(h fix44.Header) (string, error) {
+	return fix44.DecodeRawData(h, m, tag.EncodedUnderlyingSecurityDescLen, tag.EncodedUnderlyingSecurityDesc)
+}
+
 //HasUnderlyingCPProgram returns true if UnderlyingCPProgram is present, Tag 877
 func (m NoUnderlyings) HasUnderlyingCPProgram() bool {
 	return m.Has(tag.UnderlyingCPProgram)
@@ -4559,137 +4439,43 @@ func (m NoUnderlyings) HasCollAction() bool {
 	return m.Has(tag.CollAction)
 }
 
-//NoUnderlyingSecurityAltID is a repeating group element, Tag 457
-type NoUnderlyingSecurityAltID struct {
-	*quickfix.Group
-}
-
-//SetUnderlyingSecurityAltID sets UnderlyingSecurityAltID, Tag 458
-func (m NoUnderlyingSecurityAltID) SetUnderlyingSecurityAltID(v string) {
-	m.Set(field.NewUnderlyingSecurityAltID(v))
-}
-
-//SetUnderlyingSecurityAltIDSource sets UnderlyingSecurityAltIDSource, Tag 459
-func (m NoUnderlyingSecurityAltID) SetUnderlyingSecurityAltIDSource(v string) {
-	m.Set(field.NewUnderlyingSecurityAltIDSource(v))
-}
-
-//GetUnderlyingSecurityAltID gets UnderlyingSecurityAltID, Tag 458
-func (m NoUnderlyingSecurityAltID) GetUnderlyingSecurityAltID() (v string, err quickfix.MessageRejectError) {
-	var f field.UnderlyingSecurityAltIDField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetUnderlyingSecurityAltIDSource gets UnderlyingSecurityAltIDSource, Tag 459
-func (m NoUnderlyingSecurityAltID) GetUnderlyingSecurityAltIDSource() (v string, err quickfix.MessageRejectError) {
-	var f field.UnderlyingSecurityAltIDSourceField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
+//GetUnderlyingInstrument gets the UnderlyingInstrument component
+func (m NoUnderlyings) GetUnderlyingInstrument() components.UnderlyingInstrument {
+	return components.UnderlyingInstrument{&m.Group.FieldMap}
 }
 
-//HasUnderlyingSecurityAltID returns true if UnderlyingSecurityAltID is present, Tag 458
-func (m NoUnderlyingSecurityAltID) HasUnderlyingSecurityAltID() bool {
-	return m.Has(tag.UnderlyingSecurityAltID)
+//SetUnderlyingInstrument sets the UnderlyingInstrument component, copying the fields present in c
+func (m NoUnderlyings) SetUnderlyingInstrument(c components.UnderlyingInstrument) quickfix.MessageRejectError {
+	return c.CopyInto(&m.Group.FieldMap)
 }
 
-//HasUnderlyingSecurityAltIDSource returns true if UnderlyingSecurityAltIDSource is present, Tag 459
-func (m NoUnderlyingSecurityAltID) HasUnderlyingSecurityAltIDSource() bool {
-	return m.Has(tag.UnderlyingSecurityAltIDSource)
-}
+//NoUnderlyingSecurityAltID is a repeating group element, Tag 457
+type NoUnderlyingSecurityAltID = components.NoUnderlyingSecurityAltID
 
 //NoUnderlyingSecurityAltIDRepeatingGroup is a repeating group, Tag 457
-type NoUnderlyingSecurityAltIDRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
+type NoUnderlyingSecurityAltIDRepeatingGroup = components.NoUnderlyingSecurityAltIDRepeatingGroup
 
 //NewNoUnderlyingSecurityAltIDRepeatingGroup returns an initialized, NoUnderlyingSecurityAltIDRepeatingGroup
 func NewNoUnderlyingSecurityAltIDRepeatingGroup() NoUnderlyingSecurityAltIDRepeatingGroup {
-	return NoUnderlyingSecurityAltIDRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoUnderlyingSecurityAltID,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.UnderlyingSecurityAltID), quickfix.GroupElement(tag.UnderlyingSecurityAltIDSource)})}
+	return components.NewNoUnderlyingSecurityAltIDRepeatingGroup()
 }
 
-//Add create and append a new NoUnderlyingSecurityAltID to this group
-func (m NoUnderlyingSecurityAltIDRepeatingGroup) Add() NoUnderlyingSecurityAltID {
-	g := m.RepeatingGroup.Add()
-	return NoUnderlyingSecurityAltID{g}
-}
-
-//Get returns the ith NoUnderlyingSecurityAltID in the NoUnderlyingSecurityAltIDRepeatinGroup
-func (m NoUnderlyingSecurityAltIDRepeatingGroup) Get(i int) NoUnderlyingSecurityAltID {
-	return NoUnderlyingSecurityAltID{m.RepeatingGroup.Get(i)}
-}
+//NoUnderlyingSecurityAltIDStruct is a plain Go representation of a NoUnderlyingSecurityAltID group element
+type NoUnderlyingSecurityAltIDStruct = components.NoUnderlyingSecurityAltIDStruct
 
 //NoUnderlyingStips is a repeating group element, Tag 887
-type NoUnderlyingStips struct {
-	*quickfix.Group
-}
-
-//SetUnderlyingStipType sets UnderlyingStipType, Tag 888
-func (m NoUnderlyingStips) SetUnderlyingStipType(v string) {
-	m.Set(field.NewUnderlyingStipType(v))
-}
-
-//SetUnderlyingStipValue sets UnderlyingStipValue, Tag 889
-func (m NoUnderlyingStips) SetUnderlyingStipValue(v string) {
-	m.Set(field.NewUnderlyingStipValue(v))
-}
-
-//GetUnderlyingStipType gets UnderlyingStipType, Tag 888
-func (m NoUnderlyingStips) GetUnderlyingStipType() (v string, err quickfix.MessageRejectError) {
-	var f field.UnderlyingStipTypeField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetUnderlyingStipValue gets UnderlyingStipValue, Tag 889
-func (m NoUnderlyingStips) GetUnderlyingStipValue() (v string, err quickfix.MessageRejectError) {
-	var f field.UnderlyingStipValueField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//HasUnderlyingStipType returns true if UnderlyingStipType is present, Tag 888
-func (m NoUnderlyingStips) HasUnderlyingStipType() bool {
-	return m.Has(tag.UnderlyingStipType)
-}
-
-//HasUnderlyingStipValue returns true if UnderlyingStipValue is present, Tag 889
-func (m NoUnderlyingStips) HasUnderlyingStipValue() bool {
-	return m.Has(tag.UnderlyingStipValue)
-}
+type NoUnderlyingStips = components.NoUnderlyingStips
 
 //NoUnderlyingStipsRepeatingGroup is a repeating group, Tag 887
-type NoUnderlyingStipsRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
+type NoUnderlyingStipsRepeatingGroup = components.NoUnderlyingStipsRepeatingGroup
 
 //NewNoUnderlyingStipsRepeatingGroup returns an initialized, NoUnderlyingStipsRepeatingGroup
 func NewNoUnderlyingStipsRepeatingGroup() NoUnderlyingStipsRepeatingGroup {
-	return NoUnderlyingStipsRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoUnderlyingStips,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.UnderlyingStipType), quickfix.GroupElement(tag.UnderlyingStipValue)})}
+	return components.NewNoUnderlyingStipsRepeatingGroup()
 }
 
-//Add create and append a new NoUnderlyingStips to this group
-func (m NoUnderlyingStipsRepeatingGroup) Add() NoUnderlyingStips {
-	g := m.RepeatingGroup.Add()
-	return NoUnderlyingStips{g}
-}
-
-//Get returns the ith NoUnderlyingStips in the NoUnderlyingStipsRepeatinGroup
-func (m NoUnderlyingStipsRepeatingGroup) Get(i int) NoUnderlyingStips {
-	return NoUnderlyingStips{m.RepeatingGroup.Get(i)}
-}
+//NoUnderlyingStipsStruct is a plain Go representation of a NoUnderlyingStips group element
+type NoUnderlyingStipsStruct = components.NoUnderlyingStipsStruct
 
 //NoUnderlyingsRepeatingGroup is a repeating group, Tag 711
 type NoUnderlyingsRepeatingGroup struct {
@@ -4800,108 +4586,18 @@ func (m NoTrdRegTimestampsRepeatingGroup) Get(i int) NoTrdRegTimestamps {
 }
 
 //NoEvents is a repeating group element, Tag 864
-type NoEvents struct {
-	*quickfix.Group
-}
-
-//SetEventType sets EventType, Tag 865
-func (m NoEvents) SetEventType(v enum.EventType) {
-	m.Set(field.NewEventType(v))
-}
-
-//SetEventDate sets EventDate, Tag 866
-func (m NoEvents) SetEventDate(v string) {
-	m.Set(field.NewEventDate(v))
-}
-
-//SetEventPx sets EventPx, Tag 867
-func (m NoEvents) SetEventPx(value decimal.Decimal, scale int32) {
-	m.Set(field.NewEventPx(value, scale))
-}
-
-//SetEventText sets EventText, Tag 868
-func (m NoEvents) SetEventText(v string) {
-	m.Set(field.NewEventText(v))
-}
-
-//GetEventType gets EventType, Tag 865
-func (m NoEvents) GetEventType() (v enum.EventType, err quickfix.MessageRejectError) {
-	var f field.EventTypeField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetEventDate gets EventDate, Tag 866
-func (m NoEvents) GetEventDate() (v string, err quickfix.MessageRejectError) {
-	var f field.EventDateField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetEventPx gets EventPx, Tag 867
-func (m NoEvents) GetEventPx() (v decimal.Decimal, err quickfix.MessageRejectError) {
-	var f field.EventPxField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetEventText gets EventText, Tag 868
-func (m NoEvents) GetEventText() (v string, err quickfix.MessageRejectError) {
-	var f field.EventTextField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//HasEventType returns true if EventType is present, Tag 865
-func (m NoEvents) HasEventType() bool {
-	return m.Has(tag.EventType)
-}
-
-//HasEventDate returns true if EventDate is present, Tag 866
-func (m NoEvents) HasEventDate() bool {
-	return m.Has(tag.EventDate)
-}
-
-//HasEventPx returns true if EventPx is present, Tag 867
-func (m NoEvents) HasEventPx() bool {
-	return m.Has(tag.EventPx)
-}
-
-//HasEventText returns true if EventText is present, Tag 868
-func (m NoEvents) HasEventText() bool {
-	return m.Has(tag.EventText)
-}
+type NoEvents = components.NoEvents
 
 //NoEventsRepeatingGroup is a repeating group, Tag 864
-type NoEventsRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
+type NoEventsRepeatingGroup = components.NoEventsRepeatingGroup
 
 //NewNoEventsRepeatingGroup returns an initialized, NoEventsRepeatingGroup
 func NewNoEventsRepeatingGroup() NoEventsRepeatingGroup {
-	return NoEventsRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoEvents,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.EventType), quickfix.GroupElement(tag.EventDate), quickfix.GroupElement(tag.EventPx), quickfix.GroupElement(tag.EventText)})}
+	return components.NewNoEventsRepeatingGroup()
 }
 
-//Add create and append a new NoEvents to this group
-func (m NoEventsRepeatingGroup) Add() NoEvents {
-	g := m.RepeatingGroup.Add()
-	return NoEvents{g}
-}
-
-//Get returns the ith NoEvents in the NoEventsRepeatinGroup
-func (m NoEventsRepeatingGroup) Get(i int) NoEvents {
-	return NoEvents{m.RepeatingGroup.Get(i)}
-}
+//NoEventsStruct is a plain Go representation of a NoEvents group element
+type NoEventsStruct = components.NoEventsStruct
 
 //NoTrades is a repeating group element, Tag 897
 type NoTrades struct {
@@ -4968,3 +4664,2602 @@ func (m NoTradesRepeatingGroup) Add() NoTrades {
 func (m NoTradesRepeatingGroup) Get(i int) NoTrades {
 	return NoTrades{m.RepeatingGroup.Get(i)}
 }
+
+//Struct is a plain Go representation of the CollateralAssignment body, optional fields are nil when absent
+type Struct struct {
+	Account                    *string
+	ClOrdID                    *string
+	Currency                   *string
+	SecurityIDSource           *enum.SecurityIDSource
+	OrderID                    *string
+	Price                      *decimal.Decimal
+	SecurityID                 *string
+	Quantity                   *decimal.Decimal
+	Side                       *enum.Side
+	Symbol                     *string
+	Text                       *string
+	TransactTime               time.Time
+	SettlDate                  *string
+	SymbolSfx                  *enum.SymbolSfx
+	NoDlvyInst                 []NoDlvyInstStruct
+	Issuer                     *string
+	SecurityDesc               *string
+	NoExecs                    []NoExecsStruct
+	ExpireTime                 *time.Time
+	NoMiscFees                 []NoMiscFeesStruct
+	AccruedInterestAmt         *decimal.Decimal
+	SecurityType               *enum.SecurityType
+	StandInstDbType            *enum.StandInstDbType
+	StandInstDbName            *string
+	StandInstDbID              *string
+	SettlDeliveryType          *enum.SettlDeliveryType
+	SecondaryOrderID           *string
+	MaturityMonthYear          *string
+	StrikePrice                *decimal.Decimal
+	OptAttribute               *string
+	SecurityExchange           *string
+	Spread                     *decimal.Decimal
+	BenchmarkCurveCurrency     *string
+	BenchmarkCurveName         *enum.BenchmarkCurveName
+	BenchmarkCurvePoint        *string
+	CouponRate                 *decimal.Decimal
+	CouponPaymentDate          *string
+	IssueDate                  *string
+	RepurchaseTerm             *int
+	RepurchaseRate             *decimal.Decimal
+	Factor                     *decimal.Decimal
+	ContractMultiplier         *decimal.Decimal
+	NoStipulations             []NoStipulationsStruct
+	RepoCollateralSecurityType *int
+	RedemptionDate             *string
+	CreditRating               *string
+	TradingSessionID           *enum.TradingSessionID
+	EncodedIssuerLen           *int
+	EncodedIssuer              *string
+	EncodedSecurityDescLen     *int
+	EncodedSecurityDesc        *string
+	EncodedTextLen             *int
+	EncodedText                *string
+	PriceType                  *enum.PriceType
+	NoPartyIDs                 []NoPartyIDsStruct
+	NoSecurityAltID            []NoSecurityAltIDStruct
+	Product                    *enum.Product
+	CFICode                    *string
+	CountryOfIssue             *string
+	StateOrProvinceOfIssue     *string
+	LocaleOfIssue              *string
+	SecondaryClOrdID           *string
+	MaturityDate               *string
+	InstrRegistry              *enum.InstrRegistry
+	NoLegs                     []NoLegsStruct
+	AccountType                *enum.AccountType
+	TradingSessionSubID        *enum.TradingSessionSubID
+	BenchmarkPrice             *decimal.Decimal
+	BenchmarkPriceType         *int
+	ContractSettlMonth         *string
+	Pool                       *string
+	BenchmarkSecurityID        *string
+	NoUnderlyings              []NoUnderlyingsStruct
+	ClearingBusinessDate       *string
+	SettlSessID                *enum.SettlSessID
+	SettlSessSubID             *string
+	BenchmarkSecurityIDSource  *string
+	SecuritySubType            *string
+	NoTrdRegTimestamps         []NoTrdRegTimestampsStruct
+	TerminationType            *enum.TerminationType
+	QtyType                    *enum.QtyType
+	NoEvents                   []NoEventsStruct
+	DatedDate                  *string
+	InterestAccrualDate        *string
+	CPProgram                  *enum.CPProgram
+	CPRegType                  *string
+	CollReqID                  *string
+	CollAsgnReason             enum.CollAsgnReason
+	NoTrades                   []NoTradesStruct
+	MarginRatio                *decimal.Decimal
+	MarginExcess               *decimal.Decimal
+	TotalNetValue              *decimal.Decimal
+	CashOutstanding            *decimal.Decimal
+	CollAsgnID                 string
+	CollAsgnTransType          enum.CollAsgnTransType
+	CollAsgnRefID              *string
+	AgreementDesc              *string
+	AgreementID                *string
+	AgreementDate              *string
+	StartDate                  *string
+	EndDate                    *string
+	AgreementCurrency          *string
+	DeliveryType               *enum.DeliveryType
+	EndAccruedInterestAmt      *decimal.Decimal
+	StartCash                  *decimal.Decimal
+	EndCash                    *decimal.Decimal
+	StrikeCurrency             *string
+}
+
+//Marshal copies the fields present in msg into a Struct
+func Marshal(msg CollateralAssignment) (Struct, quickfix.MessageRejectError) {
+	var s Struct
+	if msg.HasAccount() {
+		v, err := msg.GetAccount()
+		if err != nil {
+			return s, err
+		}
+		s.Account = &v
+	}
+	if msg.HasClOrdID() {
+		v, err := msg.GetClOrdID()
+		if err != nil {
+			return s, err
+		}
+		s.ClOrdID = &v
+	}
+	if msg.HasCurrency() {
+		v, err := msg.GetCurrency()
+		if err != nil {
+			return s, err
+		}
+		s.Currency = &v
+	}
+	if msg.HasSecurityIDSource() {
+		v, err := msg.GetSecurityIDSource()
+		if err != nil {
+			return s, err
+		}
+		s.SecurityIDSource = &v
+	}
+	if msg.HasOrderID() {
+		v, err := msg.GetOrderID()
+		if err != nil {
+			return s, err
+		}
+		s.OrderID = &v
+	}
+	if msg.HasPrice() {
+		v, err := msg.GetPrice()
+		if err != nil {
+			return s, err
+		}
+		s.Price = &v
+	}
+	if msg.HasSecurityID() {
+		v, err := msg.GetSecurityID()
+		if err != nil {
+			return s, err
+		}
+		s.SecurityID = &v
+	}
+	if msg.HasQuantity() {
+		v, err := msg.GetQuantity()
+		if err != nil {
+			return s, err
+		}
+		s.Quantity = &v
+	}
+	if msg.HasSide() {
+		v, err := msg.GetSide()
+		if err != nil {
+			return s, err
+		}
+		s.Side = &v
+	}
+	if msg.HasSymbol() {
+		v, err := msg.GetSymbol()
+		if err != nil {
+			return s, err
+		}
+		s.Symbol = &v
+	}
+	if msg.HasText() {
+		v, err := msg.GetText()
+		if err != nil {
+			return s, err
+		}
+		s.Text = &v
+	}
+	if msg.HasTransactTime() {
+		v, err := msg.GetTransactTime()
+		if err != nil {
+			return s, err
+		}
+		s.TransactTime = v
+	}
+	if msg.HasSettlDate() {
+		v, err := msg.GetSettlDate()
+		if err != nil {
+			return s, err
+		}
+		s.SettlDate = &v
+	}
+	if msg.HasSymbolSfx() {
+		v, err := msg.GetSymbolSfx()
+		if err != nil {
+			return s, err
+		}
+		s.SymbolSfx = &v
+	}
+	if msg.HasNoDlvyInst() {
+		g, err := msg.GetNoDlvyInst()
+		if err != nil {
+			return s, err
+		}
+		if s.NoDlvyInst, err = MarshalNoDlvyInst(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasIssuer() {
+		v, err := msg.GetIssuer()
+		if err != nil {
+			return s, err
+		}
+		s.Issuer = &v
+	}
+	if msg.HasSecurityDesc() {
+		v, err := msg.GetSecurityDesc()
+		if err != nil {
+			return s, err
+		}
+		s.SecurityDesc = &v
+	}
+	if msg.HasNoExecs() {
+		g, err := msg.GetNoExecs()
+		if err != nil {
+			return s, err
+		}
+		if s.NoExecs, err = MarshalNoExecs(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasExpireTime() {
+		v, err := msg.GetExpireTime()
+		if err != nil {
+			return s, err
+		}
+		s.ExpireTime = &v
+	}
+	if msg.HasNoMiscFees() {
+		g, err := msg.GetNoMiscFees()
+		if err != nil {
+			return s, err
+		}
+		if s.NoMiscFees, err = MarshalNoMiscFees(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasAccruedInterestAmt() {
+		v, err := msg.GetAccruedInterestAmt()
+		if err != nil {
+			return s, err
+		}
+		s.AccruedInterestAmt = &v
+	}
+	if msg.HasSecurityType() {
+		v, err := msg.GetSecurityType()
+		if err != nil {
+			return s, err
+		}
+		s.SecurityType = &v
+	}
+	if msg.HasStandInstDbType() {
+		v, err := msg.GetStandInstDbType()
+		if err != nil {
+			return s, err
+		}
+		s.StandInstDbType = &v
+	}
+	if msg.HasStandInstDbName() {
+		v, err := msg.GetStandInstDbName()
+		if err != nil {
+			return s, err
+		}
+		s.StandInstDbName = &v
+	}
+	if msg.HasStandInstDbID() {
+		v, err := msg.GetStandInstDbID()
+		if err != nil {
+			return s, err
+		}
+		s.StandInstDbID = &v
+	}
+	if msg.HasSettlDeliveryType() {
+		v, err := msg.GetSettlDeliveryType()
+		if err != nil {
+			return s, err
+		}
+		s.SettlDeliveryType = &v
+	}
+	if msg.HasSecondaryOrderID() {
+		v, err := msg.GetSecondaryOrderID()
+		if err != nil {
+			return s, err
+		}
+		s.SecondaryOrderID = &v
+	}
+	if msg.HasMaturityMonthYear() {
+		v, err := msg.GetMaturityMonthYear()
+		if err != nil {
+			return s, err
+		}
+		s.MaturityMonthYear = &v
+	}
+	if msg.HasStrikePrice() {
+		v, err := msg.GetStrikePrice()
+		if err != nil {
+			return s, err
+		}
+		s.StrikePrice = &v
+	}
+	if msg.HasOptAttribute() {
+		v, err := msg.GetOptAttribute()
+		if err != nil {
+			return s, err
+		}
+		s.OptAttribute = &v
+	}
+	if msg.HasSecurityExchange() {
+		v, err := msg.GetSecurityExchange()
+		if err != nil {
+			return s, err
+		}
+		s.SecurityExchange = &v
+	}
+	if msg.HasSpread() {
+		v, err := msg.GetSpread()
+		if err != nil {
+			return s, err
+		}
+		s.Spread = &v
+	}
+	if msg.HasBenchmarkCurveCurrency() {
+		v, err := msg.GetBenchmarkCurveCurrency()
+		if err != nil {
+			return s, err
+		}
+		s.BenchmarkCurveCurrency = &v
+	}
+	if msg.HasBenchmarkCurveName() {
+		v, err := msg.GetBenchmarkCurveName()
+		if err != nil {
+			return s, err
+		}
+		s.BenchmarkCurveName = &v
+	}
+	if msg.HasBenchmarkCurvePoint() {
+		v, err := msg.GetBenchmarkCurvePoint()
+		if err != nil {
+			return s, err
+		}
+		s.BenchmarkCurvePoint = &v
+	}
+	if msg.HasCouponRate() {
+		v, err := msg.GetCouponRate()
+		if err != nil {
+			return s, err
+		}
+		s.CouponRate = &v
+	}
+	if msg.HasCouponPaymentDate() {
+		v, err := msg.GetCouponPaymentDate()
+		if err != nil {
+			return s, err
+		}
+		s.CouponPaymentDate = &v
+	}
+	if msg.HasIssueDate() {
+		v, err := msg.GetIssueDate()
+		if err != nil {
+			return s, err
+		}
+		s.IssueDate = &v
+	}
+	if msg.HasRepurchaseTerm() {
+		v, err := msg.GetRepurchaseTerm()
+		if err != nil {
+			return s, err
+		}
+		s.RepurchaseTerm = &v
+	}
+	if msg.HasRepurchaseRate() {
+		v, err := msg.GetRepurchaseRate()
+		if err != nil {
+			return s, err
+		}
+		s.RepurchaseRate = &v
+	}
+	if msg.HasFactor() {
+		v, err := msg.GetFactor()
+		if err != nil {
+			return s, err
+		}
+		s.Factor = &v
+	}
+	if msg.HasContractMultiplier() {
+		v, err := msg.GetContractMultiplier()
+		if err != nil {
+			return s, err
+		}
+		s.ContractMultiplier = &v
+	}
+	if msg.HasNoStipulations() {
+		g, err := msg.GetNoStipulations()
+		if err != nil {
+			return s, err
+		}
+		if s.NoStipulations, err = components.MarshalNoStipulations(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasRepoCollateralSecurityType() {
+		v, err := msg.GetRepoCollateralSecurityType()
+		if err != nil {
+			return s, err
+		}
+		s.RepoCollateralSecurityType = &v
+	}
+	if msg.HasRedemptionDate() {
+		v, err := msg.GetRedemptionDate()
+		if err != nil {
+			return s, err
+		}
+		s.RedemptionDate = &v
+	}
+	if msg.HasCreditRating() {
+		v, err := msg.GetCreditRating()
+		if err != nil {
+			return s, err
+		}
+		s.CreditRating = &v
+	}
+	if msg.HasTradingSessionID() {
+		v, err := msg.GetTradingSessionID()
+		if err != nil {
+			return s, err
+		}
+		s.TradingSessionID = &v
+	}
+	if msg.HasEncodedIssuerLen() {
+		v, err := msg.GetEncodedIssuerLen()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedIssuerLen = &v
+	}
+	if msg.HasEncodedIssuer() {
+		v, err := msg.GetEncodedIssuer()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedIssuer = &v
+	}
+	if msg.HasEncodedSecurityDescLen() {
+		v, err := msg.GetEncodedSecurityDescLen()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedSecurityDescLen = &v
+	}
+	if msg.HasEncodedSecurityDesc() {
+		v, err := msg.GetEncodedSecurityDesc()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedSecurityDesc = &v
+	}
+	if msg.HasEncodedTextLen() {
+		v, err := msg.GetEncodedTextLen()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedTextLen = &v
+	}
+	if msg.HasEncodedText() {
+		v, err := msg.GetEncodedText()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedText = &v
+	}
+	if msg.HasPriceType() {
+		v, err := msg.GetPriceType()
+		if err != nil {
+			return s, err
+		}
+		s.PriceType = &v
+	}
+	if msg.HasNoPartyIDs() {
+		g, err := msg.GetNoPartyIDs()
+		if err != nil {
+			return s, err
+		}
+		if s.NoPartyIDs, err = components.MarshalNoPartyIDs(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasNoSecurityAltID() {
+		g, err := msg.GetNoSecurityAltID()
+		if err != nil {
+			return s, err
+		}
+		if s.NoSecurityAltID, err = components.MarshalNoSecurityAltID(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasProduct() {
+		v, err := msg.GetProduct()
+		if err != nil {
+			return s, err
+		}
+		s.Product = &v
+	}
+	if msg.HasCFICode() {
+		v, err := msg.GetCFICode()
+		if err != nil {
+			return s, err
+		}
+		s.CFICode = &v
+	}
+	if msg.HasCountryOfIssue() {
+		v, err := msg.GetCountryOfIssue()
+		if err != nil {
+			return s, err
+		}
+		s.CountryOfIssue = &v
+	}
+	if msg.HasStateOrProvinceOfIssue() {
+		v, err := msg.GetStateOrProvinceOfIssue()
+		if err != nil {
+			return s, err
+		}
+		s.StateOrProvinceOfIssue = &v
+	}
+	if msg.HasLocaleOfIssue() {
+		v, err := msg.GetLocaleOfIssue()
+		if err != nil {
+			return s, err
+		}
+		s.LocaleOfIssue = &v
+	}
+	if msg.HasSecondaryClOrdID() {
+		v, err := msg.GetSecondaryClOrdID()
+		if err != nil {
+			return s, err
+		}
+		s.SecondaryClOrdID = &v
+	}
+	if msg.HasMaturityDate() {
+		v, err := msg.GetMaturityDate()
+		if err != nil {
+			return s, err
+		}
+		s.MaturityDate = &v
+	}
+	if msg.HasInstrRegistry() {
+		v, err := msg.GetInstrRegistry()
+		if err != nil {
+			return s, err
+		}
+		s.InstrRegistry = &v
+	}
+	if msg.HasNoLegs() {
+		g, err := msg.GetNoLegs()
+		if err != nil {
+			return s, err
+		}
+		if s.NoLegs, err = MarshalNoLegs(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasAccountType() {
+		v, err := msg.GetAccountType()
+		if err != nil {
+			return s, err
+		}
+		s.AccountType = &v
+	}
+	if msg.HasTradingSessionSubID() {
+		v, err := msg.GetTradingSessionSubID()
+		if err != nil {
+			return s, err
+		}
+		s.TradingSessionSubID = &v
+	}
+	if msg.HasBenchmarkPrice() {
+		v, err := msg.GetBenchmarkPrice()
+		if err != nil {
+			return s, err
+		}
+		s.BenchmarkPrice = &v
+	}
+	if msg.HasBenchmarkPriceType() {
+		v, err := msg.GetBenchmarkPriceType()
+		if err != nil {
+			return s, err
+		}
+		s.BenchmarkPriceType = &v
+	}
+	if msg.HasContractSettlMonth() {
+		v, err := msg.GetContractSettlMonth()
+		if err != nil {
+			return s, err
+		}
+		s.ContractSettlMonth = &v
+	}
+	if msg.HasPool() {
+		v, err := msg.GetPool()
+		if err != nil {
+			return s, err
+		}
+		s.Pool = &v
+	}
+	if msg.HasBenchmarkSecurityID() {
+		v, err := msg.GetBenchmarkSecurityID()
+		if err != nil {
+			return s, err
+		}
+		s.BenchmarkSecurityID = &v
+	}
+	if msg.HasNoUnderlyings() {
+		g, err := msg.GetNoUnderlyings()
+		if err != nil {
+			return s, err
+		}
+		if s.NoUnderlyings, err = MarshalNoUnderlyings(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasClearingBusinessDate() {
+		v, err := msg.GetClearingBusinessDate()
+		if err != nil {
+			return s, err
+		}
+		s.ClearingBusinessDate = &v
+	}
+	if msg.HasSettlSessID() {
+		v, err := msg.GetSettlSessID()
+		if err != nil {
+			return s, err
+		}
+		s.SettlSessID = &v
+	}
+	if msg.HasSettlSessSubID() {
+		v, err := msg.GetSettlSessSubID()
+		if err != nil {
+			return s, err
+		}
+		s.SettlSessSubID = &v
+	}
+	if msg.HasBenchmarkSecurityIDSource() {
+		v, err := msg.GetBenchmarkSecurityIDSource()
+		if err != nil {
+			return s, err
+		}
+		s.BenchmarkSecurityIDSource = &v
+	}
+	if msg.HasSecuritySubType() {
+		v, err := msg.GetSecuritySubType()
+		if err != nil {
+			return s, err
+		}
+		s.SecuritySubType = &v
+	}
+	if msg.HasNoTrdRegTimestamps() {
+		g, err := msg.GetNoTrdRegTimestamps()
+		if err != nil {
+			return s, err
+		}
+		if s.NoTrdRegTimestamps, err = MarshalNoTrdRegTimestamps(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasTerminationType() {
+		v, err := msg.GetTerminationType()
+		if err != nil {
+			return s, err
+		}
+		s.TerminationType = &v
+	}
+	if msg.HasQtyType() {
+		v, err := msg.GetQtyType()
+		if err != nil {
+			return s, err
+		}
+		s.QtyType = &v
+	}
+	if msg.HasNoEvents() {
+		g, err := msg.GetNoEvents()
+		if err != nil {
+			return s, err
+		}
+		if s.NoEvents, err = components.MarshalNoEvents(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasDatedDate() {
+		v, err := msg.GetDatedDate()
+		if err != nil {
+			return s, err
+		}
+		s.DatedDate = &v
+	}
+	if msg.HasInterestAccrualDate() {
+		v, err := msg.GetInterestAccrualDate()
+		if err != nil {
+			return s, err
+		}
+		s.InterestAccrualDate = &v
+	}
+	if msg.HasCPProgram() {
+		v, err := msg.GetCPProgram()
+		if err != nil {
+			return s, err
+		}
+		s.CPProgram = &v
+	}
+	if msg.HasCPRegType() {
+		v, err := msg.GetCPRegType()
+		if err != nil {
+			return s, err
+		}
+		s.CPRegType = &v
+	}
+	if msg.HasCollReqID() {
+		v, err := msg.GetCollReqID()
+		if err != nil {
+			return s, err
+		}
+		s.CollReqID = &v
+	}
+	if msg.HasCollAsgnReason() {
+		v, err := msg.GetCollAsgnReason()
+		if err != nil {
+			return s, err
+		}
+		s.CollAsgnReason = v
+	}
+	if msg.HasNoTrades() {
+		g, err := msg.GetNoTrades()
+		if err != nil {
+			return s, err
+		}
+		if s.NoTrades, err = MarshalNoTrades(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasMarginRatio() {
+		v, err := msg.GetMarginRatio()
+		if err != nil {
+			return s, err
+		}
+		s.MarginRatio = &v
+	}
+	if msg.HasMarginExcess() {
+		v, err := msg.GetMarginExcess()
+		if err != nil {
+			return s, err
+		}
+		s.MarginExcess = &v
+	}
+	if msg.HasTotalNetValue() {
+		v, err := msg.GetTotalNetValue()
+		if err != nil {
+			return s, err
+		}
+		s.TotalNetValue = &v
+	}
+	if msg.HasCashOutstanding() {
+		v, err := msg.GetCashOutstanding()
+		if err != nil {
+			return s, err
+		}
+		s.CashOutstanding = &v
+	}
+	if msg.HasCollAsgnID() {
+		v, err := msg.GetCollAsgnID()
+		if err != nil {
+			return s, err
+		}
+		s.CollAsgnID = v
+	}
+	if msg.HasCollAsgnTransType() {
+		v, err := msg.GetCollAsgnTransType()
+		if err != nil {
+			return s, err
+		}
+		s.CollAsgnTransType = v
+	}
+	if msg.HasCollAsgnRefID() {
+		v, err := msg.GetCollAsgnRefID()
+		if err != nil {
+			return s, err
+		}
+		s.CollAsgnRefID = &v
+	}
+	if msg.HasAgreementDesc() {
+		v, err := msg.GetAgreementDesc()
+		if err != nil {
+			return s, err
+		}
+		s.AgreementDesc = &v
+	}
+	if msg.HasAgreementID() {
+		v, err := msg.GetAgreementID()
+		if err != nil {
+			return s, err
+		}
+		s.AgreementID = &v
+	}
+	if msg.HasAgreementDate() {
+		v, err := msg.GetAgreementDate()
+		if err != nil {
+			return s, err
+		}
+		s.AgreementDate = &v
+	}
+	if msg.HasStartDate() {
+		v, err := msg.GetStartDate()
+		if err != nil {
+			return s, err
+		}
+		s.StartDate = &v
+	}
+	if msg.HasEndDate() {
+		v, err := msg.GetEndDate()
+		if err != nil {
+			return s, err
+		}
+		s.EndDate = &v
+	}
+	if msg.HasAgreementCurrency() {
+		v, err := msg.GetAgreementCurrency()
+		if err != nil {
+			return s, err
+		}
+		s.AgreementCurrency = &v
+	}
+	if msg.HasDeliveryType() {
+		v, err := msg.GetDeliveryType()
+		if err != nil {
+			return s, err
+		}
+		s.DeliveryType = &v
+	}
+	if msg.HasEndAccruedInterestAmt() {
+		v, err := msg.GetEndAccruedInterestAmt()
+		if err != nil {
+			return s, err
+		}
+		s.EndAccruedInterestAmt = &v
+	}
+	if msg.HasStartCash() {
+		v, err := msg.GetStartCash()
+		if err != nil {
+			return s, err
+		}
+		s.StartCash = &v
+	}
+	if msg.HasEndCash() {
+		v, err := msg.GetEndCash()
+		if err != nil {
+			return s, err
+		}
+		s.EndCash = &v
+	}
+	if msg.HasStrikeCurrency() {
+		v, err := msg.GetStrikeCurrency()
+		if err != nil {
+			return s, err
+		}
+		s.StrikeCurrency = &v
+	}
+	return s, nil
+}
+
+//Unmarshal builds a CollateralAssignment from s
+func Unmarshal(s Struct) CollateralAssignment {
+	m := New(field.NewCollAsgnID(s.CollAsgnID), field.NewCollAsgnReason(s.CollAsgnReason), field.NewCollAsgnTransType(s.CollAsgnTransType), field.NewTransactTime(s.TransactTime))
+	if s.Account != nil {
+		m.SetAccount(*s.Account)
+	}
+	if s.ClOrdID != nil {
+		m.SetClOrdID(*s.ClOrdID)
+	}
+	if s.Currency != nil {
+		m.SetCurrency(*s.Currency)
+	}
+	if s.SecurityIDSource != nil {
+		m.SetSecurityIDSource(*s.SecurityIDSource)
+	}
+	if s.OrderID != nil {
+		m.SetOrderID(*s.OrderID)
+	}
+	if s.Price != nil {
+		m.SetPrice(*s.Price, fix44.DecimalScale(*s.Price))
+	}
+	if s.SecurityID != nil {
+		m.SetSecurityID(*s.SecurityID)
+	}
+	if s.Quantity != nil {
+		m.SetQuantity(*s.Quantity, fix44.DecimalScale(*s.Quantity))
+	}
+	if s.Side != nil {
+		m.SetSide(*s.Side)
+	}
+	if s.Symbol != nil {
+		m.SetSymbol(*s.Symbol)
+	}
+	if s.Text != nil {
+		m.SetText(*s.Text)
+	}
+	if s.SettlDate != nil {
+		m.SetSettlDate(*s.SettlDate)
+	}
+	if s.SymbolSfx != nil {
+		m.SetSymbolSfx(*s.SymbolSfx)
+	}
+	if s.NoDlvyInst != nil {
+		m.SetNoDlvyInst(UnmarshalNoDlvyInst(s.NoDlvyInst))
+	}
+	if s.Issuer != nil {
+		m.SetIssuer(*s.Issuer)
+	}
+	if s.SecurityDesc != nil {
+		m.SetSecurityDesc(*s.SecurityDesc)
+	}
+	if s.NoExecs != nil {
+		m.SetNoExecs(UnmarshalNoExecs(s.NoExecs))
+	}
+	if s.ExpireTime != nil {
+		m.SetExpireTime(*s.ExpireTime)
+	}
+	if s.NoMiscFees != nil {
+		m.SetNoMiscFees(UnmarshalNoMiscFees(s.NoMiscFees))
+	}
+	if s.AccruedInterestAmt != nil {
+		m.SetAccruedInterestAmt(*s.AccruedInterestAmt, fix44.DecimalScale(*s.AccruedInterestAmt))
+	}
+	if s.SecurityType != nil {
+		m.SetSecurityType(*s.SecurityType)
+	}
+	if s.StandInstDbType != nil {
+		m.SetStandInstDbType(*s.StandInstDbType)
+	}
+	if s.StandInstDbName != nil {
+		m.SetStandInstDbName(*s.StandInstDbName)
+	}
+	if s.StandInstDbID != nil {
+		m.SetStandInstDbID(*s.StandInstDbID)
+	}
+	if s.SettlDeliveryType != nil {
+		m.SetSettlDeliveryType(*s.SettlDeliveryType)
+	}
+	if s.SecondaryOrderID != nil {
+		m.SetSecondaryOrderID(*s.SecondaryOrderID)
+	}
+	if s.MaturityMonthYear != nil {
+		m.SetMaturityMonthYear(*s.MaturityMonthYear)
+	}
+	if s.StrikePrice != nil {
+		m.SetStrikePrice(*s.StrikePrice, fix44.DecimalScale(*s.StrikePrice))
+	}
+	if s.OptAttribute != nil {
+		m.SetOptAttribute(*s.OptAttribute)
+	}
+	if s.SecurityExchange != nil {
+		m.SetSecurityExchange(*s.SecurityExchange)
+	}
+	if s.Spread != nil {
+		m.SetSpread(*s.Spread, fix44.DecimalScale(*s.Spread))
+	}
+	if s.BenchmarkCurveCurrency != nil {
+		m.SetBenchmarkCurveCurrency(*s.BenchmarkCurveCurrency)
+	}
+	if s.BenchmarkCurveName != nil {
+		m.SetBenchmarkCurveName(*s.BenchmarkCurveName)
+	}
+	if s.BenchmarkCurvePoint != nil {
+		m.SetBenchmarkCurvePoint(*s.BenchmarkCurvePoint)
+	}
+	if s.CouponRate != nil {
+		m.SetCouponRate(*s.CouponRate, fix44.DecimalScale(*s.CouponRate))
+	}
+	if s.CouponPaymentDate != nil {
+		m.SetCouponPaymentDate(*s.CouponPaymentDate)
+	}
+	if s.IssueDate != nil {
+		m.SetIssueDate(*s.IssueDate)
+	}
+	if s.RepurchaseTerm != nil {
+		m.SetRepurchaseTerm(*s.RepurchaseTerm)
+	}
+	if s.RepurchaseRate != nil {
+		m.SetRepurchaseRate(*s.RepurchaseRate, fix44.DecimalScale(*s.RepurchaseRate))
+	}
+	if s.Factor != nil {
+		m.SetFactor(*s.Factor, fix44.DecimalScale(*s.Factor))
+	}
+	if s.ContractMultiplier != nil {
+		m.SetContractMultiplier(*s.ContractMultiplier, fix44.DecimalScale(*s.ContractMultiplier))
+	}
+	if s.NoStipulations != nil {
+		m.SetNoStipulations(components.UnmarshalNoStipulations(s.NoStipulations))
+	}
+	if s.RepoCollateralSecurityType != nil {
+		m.SetRepoCollateralSecurityType(*s.RepoCollateralSecurityType)
+	}
+	if s.RedemptionDate != nil {
+		m.SetRedemptionDate(*s.RedemptionDate)
+	}
+	if s.CreditRating != nil {
+		m.SetCreditRating(*s.CreditRating)
+	}
+	if s.TradingSessionID != nil {
+		m.SetTradingSessionID(*s.TradingSessionID)
+	}
+	if s.EncodedIssuerLen != nil {
+		m.SetEncodedIssuerLen(*s.EncodedIssuerLen)
+	}
+	if s.EncodedIssuer != nil {
+		m.SetEncodedIssuer(*s.EncodedIssuer)
+	}
+	if s.EncodedSecurityDescLen != nil {
+		m.SetEncodedSecurityDescLen(*s.EncodedSecurityDescLen)
+	}
+	if s.EncodedSecurityDesc != nil {
+		m.SetEncodedSecurityDesc(*s.EncodedSecurityDesc)
+	}
+	if s.EncodedTextLen != nil {
+		m.SetEncodedTextLen(*s.EncodedTextLen)
+	}
+	if s.EncodedText != nil {
+		m.SetEncodedText(*s.EncodedText)
+	}
+	if s.PriceType != nil {
+		m.SetPriceType(*s.PriceType)
+	}
+	if s.NoPartyIDs != nil {
+		m.SetNoPartyIDs(components.UnmarshalNoPartyIDs(s.NoPartyIDs))
+	}
+	if s.NoSecurityAltID != nil {
+		m.SetNoSecurityAltID(components.UnmarshalNoSecurityAltID(s.NoSecurityAltID))
+	}
+	if s.Product != nil {
+		m.SetProduct(*s.Product)
+	}
+	if s.CFICode != nil {
+		m.SetCFICode(*s.CFICode)
+	}
+	if s.CountryOfIssue != nil {
+		m.SetCountryOfIssue(*s.CountryOfIssue)
+	}
+	if s.StateOrProvinceOfIssue != nil {
+		m.SetStateOrProvinceOfIssue(*s.StateOrProvinceOfIssue)
+	}
+	if s.LocaleOfIssue != nil {
+		m.SetLocaleOfIssue(*s.LocaleOfIssue)
+	}
+	if s.SecondaryClOrdID != nil {
+		m.SetSecondaryClOrdID(*s.SecondaryClOrdID)
+	}
+	if s.MaturityDate != nil {
+		m.SetMaturityDate(*s.MaturityDate)
+	}
+	if s.InstrRegistry != nil {
+		m.SetInstrRegistry(*s.InstrRegistry)
+	}
+	if s.NoLegs != nil {
+		m.SetNoLegs(UnmarshalNoLegs(s.NoLegs))
+	}
+	if s.AccountType != nil {
+		m.SetAccountType(*s.AccountType)
+	}
+	if s.TradingSessionSubID != nil {
+		m.SetTradingSessionSubID(*s.TradingSessionSubID)
+	}
+	if s.BenchmarkPrice != nil {
+		m.SetBenchmarkPrice(*s.BenchmarkPrice, fix44.DecimalScale(*s.BenchmarkPrice))
+	}
+	if s.BenchmarkPriceType != nil {
+		m.SetBenchmarkPriceType(*s.BenchmarkPriceType)
+	}
+	if s.ContractSettlMonth != nil {
+		m.SetContractSettlMonth(*s.ContractSettlMonth)
+	}
+	if s.Pool != nil {
+		m.SetPool(*s.Pool)
+	}
+	if s.BenchmarkSecurityID != nil {
+		m.SetBenchmarkSecurityID(*s.BenchmarkSecurityID)
+	}
+	if s.NoUnderlyings != nil {
+		m.SetNoUnderlyings(UnmarshalNoUnderlyings(s.NoUnderlyings))
+	}
+	if s.ClearingBusinessDate != nil {
+		m.SetClearingBusinessDate(*s.ClearingBusinessDate)
+	}
+	if s.SettlSessID != nil {
+		m.SetSettlSessID(*s.SettlSessID)
+	}
+	if s.SettlSessSubID != nil {
+		m.SetSettlSessSubID(*s.SettlSessSubID)
+	}
+	if s.BenchmarkSecurityIDSource != nil {
+		m.SetBenchmarkSecurityIDSource(*s.BenchmarkSecurityIDSource)
+	}
+	if s.SecuritySubType != nil {
+		m.SetSecuritySubType(*s.SecuritySubType)
+	}
+	if s.NoTrdRegTimestamps != nil {
+		m.SetNoTrdRegTimestamps(UnmarshalNoTrdRegTimestamps(s.NoTrdRegTimestamps))
+	}
+	if s.TerminationType != nil {
+		m.SetTerminationType(*s.TerminationType)
+	}
+	if s.QtyType != nil {
+		m.SetQtyType(*s.QtyType)
+	}
+	if s.NoEvents != nil {
+		m.SetNoEvents(components.UnmarshalNoEvents(s.NoEvents))
+	}
+	if s.DatedDate != nil {
+		m.SetDatedDate(*s.DatedDate)
+	}
+	if s.InterestAccrualDate != nil {
+		m.SetInterestAccrualDate(*s.InterestAccrualDate)
+	}
+	if s.CPProgram != nil {
+		m.SetCPProgram(*s.CPProgram)
+	}
+	if s.CPRegType != nil {
+		m.SetCPRegType(*s.CPRegType)
+	}
+	if s.CollReqID != nil {
+		m.SetCollReqID(*s.CollReqID)
+	}
+	if s.NoTrades != nil {
+		m.SetNoTrades(UnmarshalNoTrades(s.NoTrades))
+	}
+	if s.MarginRatio != nil {
+		m.SetMarginRatio(*s.MarginRatio, fix44.DecimalScale(*s.MarginRatio))
+	}
+	if s.MarginExcess != nil {
+		m.SetMarginExcess(*s.MarginExcess, fix44.DecimalScale(*s.MarginExcess))
+	}
+	if s.TotalNetValue != nil {
+		m.SetTotalNetValue(*s.TotalNetValue, fix44.DecimalScale(*s.TotalNetValue))
+	}
+	if s.CashOutstanding != nil {
+		m.SetCashOutstanding(*s.CashOutstanding, fix44.DecimalScale(*s.CashOutstanding))
+	}
+	if s.CollAsgnRefID != nil {
+		m.SetCollAsgnRefID(*s.CollAsgnRefID)
+	}
+	if s.AgreementDesc != nil {
+		m.SetAgreementDesc(*s.AgreementDesc)
+	}
+	if s.AgreementID != nil {
+		m.SetAgreementID(*s.AgreementID)
+	}
+	if s.AgreementDate != nil {
+		m.SetAgreementDate(*s.AgreementDate)
+	}
+	if s.StartDate != nil {
+		m.SetStartDate(*s.StartDate)
+	}
+	if s.EndDate != nil {
+		m.SetEndDate(*s.EndDate)
+	}
+	if s.AgreementCurrency != nil {
+		m.SetAgreementCurrency(*s.AgreementCurrency)
+	}
+	if s.DeliveryType != nil {
+		m.SetDeliveryType(*s.DeliveryType)
+	}
+	if s.EndAccruedInterestAmt != nil {
+		m.SetEndAccruedInterestAmt(*s.EndAccruedInterestAmt, fix44.DecimalScale(*s.EndAccruedInterestAmt))
+	}
+	if s.StartCash != nil {
+		m.SetStartCash(*s.StartCash, fix44.DecimalScale(*s.StartCash))
+	}
+	if s.EndCash != nil {
+		m.SetEndCash(*s.EndCash, fix44.DecimalScale(*s.EndCash))
+	}
+	if s.StrikeCurrency != nil {
+		m.SetStrikeCurrency(*s.StrikeCurrency)
+	}
+	return m
+}
+
+//NoDlvyInstStruct is a plain Go representation of a NoDlvyInst group element, optional fields are nil when absent
+type NoDlvyInstStruct struct {
+	SettlInstSource *enum.SettlInstSource
+	DlvyInstType    *enum.DlvyInstType
+	NoSettlPartyIDs []NoSettlPartyIDsStruct
+}
+
+//MarshalNoDlvyInst copies the elements of g into a slice of NoDlvyInstStruct
+func MarshalNoDlvyInst(g NoDlvyInstRepeatingGroup) ([]NoDlvyInstStruct, quickfix.MessageRejectError) {
+	s := make([]NoDlvyInstStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasSettlInstSource() {
+			v, err := m.GetSettlInstSource()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SettlInstSource = &v
+		}
+		if m.HasDlvyInstType() {
+			v, err := m.GetDlvyInstType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].DlvyInstType = &v
+		}
+		if m.HasNoSettlPartyIDs() {
+			g, err := m.GetNoSettlPartyIDs()
+			if err != nil {
+				return nil, err
+			}
+			if s[i].NoSettlPartyIDs, err = MarshalNoSettlPartyIDs(g); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoDlvyInst builds a NoDlvyInstRepeatingGroup from s
+func UnmarshalNoDlvyInst(s []NoDlvyInstStruct) NoDlvyInstRepeatingGroup {
+	g := NewNoDlvyInstRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.SettlInstSource != nil {
+			m.SetSettlInstSource(*e.SettlInstSource)
+		}
+		if e.DlvyInstType != nil {
+			m.SetDlvyInstType(*e.DlvyInstType)
+		}
+		if e.NoSettlPartyIDs != nil {
+			m.SetNoSettlPartyIDs(UnmarshalNoSettlPartyIDs(e.NoSettlPartyIDs))
+		}
+	}
+	return g
+}
+
+//NoSettlPartyIDsStruct is a plain Go representation of a NoSettlPartyIDs group element, optional fields are nil when absent
+type NoSettlPartyIDsStruct struct {
+	SettlPartyID       *string
+	SettlPartyIDSource *string
+	SettlPartyRole     *int
+	NoSettlPartySubIDs []NoSettlPartySubIDsStruct
+}
+
+//MarshalNoSettlPartyIDs copies the elements of g into a slice of NoSettlPartyIDsStruct
+func MarshalNoSettlPartyIDs(g NoSettlPartyIDsRepeatingGroup) ([]NoSettlPartyIDsStruct, quickfix.MessageRejectError) {
+	s := make([]NoSettlPartyIDsStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasSettlPartyID() {
+			v, err := m.GetSettlPartyID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SettlPartyID = &v
+		}
+		if m.HasSettlPartyIDSource() {
+			v, err := m.GetSettlPartyIDSource()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SettlPartyIDSource = &v
+		}
+		if m.HasSettlPartyRole() {
+			v, err := m.GetSettlPartyRole()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SettlPartyRole = &v
+		}
+		if m.HasNoSettlPartySubIDs() {
+			g, err := m.GetNoSettlPartySubIDs()
+			if err != nil {
+				return nil, err
+			}
+			if s[i].NoSettlPartySubIDs, err = MarshalNoSettlPartySubIDs(g); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoSettlPartyIDs builds a NoSettlPartyIDsRepeatingGroup from s
+func UnmarshalNoSettlPartyIDs(s []NoSettlPartyIDsStruct) NoSettlPartyIDsRepeatingGroup {
+	g := NewNoSettlPartyIDsRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.SettlPartyID != nil {
+			m.SetSettlPartyID(*e.SettlPartyID)
+		}
+		if e.SettlPartyIDSource != nil {
+			m.SetSettlPartyIDSource(*e.SettlPartyIDSource)
+		}
+		if e.SettlPartyRole != nil {
+			m.SetSettlPartyRole(*e.SettlPartyRole)
+		}
+		if e.NoSettlPartySubIDs != nil {
+			m.SetNoSettlPartySubIDs(UnmarshalNoSettlPartySubIDs(e.NoSettlPartySubIDs))
+		}
+	}
+	return g
+}
+
+//NoSettlPartySubIDsStruct is a plain Go representation of a NoSettlPartySubIDs group element, optional fields are nil when absent
+type NoSettlPartySubIDsStruct struct {
+	SettlPartySubID     *string
+	SettlPartySubIDType *int
+}
+
+//MarshalNoSettlPartySubIDs copies the elements of g into a slice of NoSettlPartySubIDsStruct
+func MarshalNoSettlPartySubIDs(g NoSettlPartySubIDsRepeatingGroup) ([]NoSettlPartySubIDsStruct, quickfix.MessageRejectError) {
+	s := make([]NoSettlPartySubIDsStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasSettlPartySubID() {
+			v, err := m.GetSettlPartySubID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SettlPartySubID = &v
+		}
+		if m.HasSettlPartySubIDType() {
+			v, err := m.GetSettlPartySubIDType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SettlPartySubIDType = &v
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoSettlPartySubIDs builds a NoSettlPartySubIDsRepeatingGroup from s
+func UnmarshalNoSettlPartySubIDs(s []NoSettlPartySubIDsStruct) NoSettlPartySubIDsRepeatingGroup {
+	g := NewNoSettlPartySubIDsRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.SettlPartySubID != nil {
+			m.SetSettlPartySubID(*e.SettlPartySubID)
+		}
+		if e.SettlPartySubIDType != nil {
+			m.SetSettlPartySubIDType(*e.SettlPartySubIDType)
+		}
+	}
+	return g
+}
+
+//NoExecsStruct is a plain Go representation of a NoExecs group element, optional fields are nil when absent
+type NoExecsStruct struct {
+	ExecID *string
+}
+
+//MarshalNoExecs copies the elements of g into a slice of NoExecsStruct
+func MarshalNoExecs(g NoExecsRepeatingGroup) ([]NoExecsStruct, quickfix.MessageRejectError) {
+	s := make([]NoExecsStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasExecID() {
+			v, err := m.GetExecID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].ExecID = &v
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoExecs builds a NoExecsRepeatingGroup from s
+func UnmarshalNoExecs(s []NoExecsStruct) NoExecsRepeatingGroup {
+	g := NewNoExecsRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.ExecID != nil {
+			m.SetExecID(*e.ExecID)
+		}
+	}
+	return g
+}
+
+//NoMiscFeesStruct is a plain Go representation of a NoMiscFees group element, optional fields are nil when absent
+type NoMiscFeesStruct struct {
+	MiscFeeAmt   *decimal.Decimal
+	MiscFeeCurr  *string
+	MiscFeeType  *enum.MiscFeeType
+	MiscFeeBasis *enum.MiscFeeBasis
+}
+
+//MarshalNoMiscFees copies the elements of g into a slice of NoMiscFeesStruct
+func MarshalNoMiscFees(g NoMiscFeesRepeatingGroup) ([]NoMiscFeesStruct, quickfix.MessageRejectError) {
+	s := make([]NoMiscFeesStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasMiscFeeAmt() {
+			v, err := m.GetMiscFeeAmt()
+			if err != nil {
+				return nil, err
+			}
+			s[i].MiscFeeAmt = &v
+		}
+		if m.HasMiscFeeCurr() {
+			v, err := m.GetMiscFeeCurr()
+			if err != nil {
+				return nil, err
+			}
+			s[i].MiscFeeCurr = &v
+		}
+		if m.HasMiscFeeType() {
+			v, err := m.GetMiscFeeType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].MiscFeeType = &v
+		}
+		if m.HasMiscFeeBasis() {
+			v, err := m.GetMiscFeeBasis()
+			if err != nil {
+				return nil, err
+			}
+			s[i].MiscFeeBasis = &v
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoMiscFees builds a NoMiscFeesRepeatingGroup from s
+func UnmarshalNoMiscFees(s []NoMiscFeesStruct) NoMiscFeesRepeatingGroup {
+	g := NewNoMiscFeesRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.MiscFeeAmt != nil {
+			m.SetMiscFeeAmt(*e.MiscFeeAmt, fix44.DecimalScale(*e.MiscFeeAmt))
+		}
+		if e.MiscFeeCurr != nil {
+			m.SetMiscFeeCurr(*e.MiscFeeCurr)
+		}
+		if e.MiscFeeType != nil {
+			m.SetMiscFeeType(*e.MiscFeeType)
+		}
+		if e.MiscFeeBasis != nil {
+			m.SetMiscFeeBasis(*e.MiscFeeBasis)
+		}
+	}
+	return g
+}
+
+//NoLegsStruct is a plain Go representation of a NoLegs group element, optional fields are nil when absent
+type NoLegsStruct struct {
+	LegSymbol                     *string
+	LegSymbolSfx                  *string
+	LegSecurityID                 *string
+	LegSecurityIDSource           *string
+	NoLegSecurityAltID            []NoLegSecurityAltIDStruct
+	LegProduct                    *int
+	LegCFICode                    *string
+	LegSecurityType               *string
+	LegSecuritySubType            *string
+	LegMaturityMonthYear          *string
+	LegMaturityDate               *string
+	LegCouponPaymentDate          *string
+	LegIssueDate                  *string
+	LegRepoCollateralSecurityType *int
+	LegRepurchaseTerm             *int
+	LegRepurchaseRate             *decimal.Decimal
+	LegFactor                     *decimal.Decimal
+	LegCreditRating               *string
+	LegInstrRegistry              *string
+	LegCountryOfIssue             *string
+	LegStateOrProvinceOfIssue     *string
+	LegLocaleOfIssue              *string
+	LegRedemptionDate             *string
+	LegStrikePrice                *decimal.Decimal
+	LegStrikeCurrency             *string
+	LegOptAttribute               *string
+	LegContractMultiplier         *decimal.Decimal
+	LegCouponRate                 *decimal.Decimal
+	LegSecurityExchange           *string
+	LegIssuer                     *string
+	EncodedLegIssuerLen           *int
+	EncodedLegIssuer              *string
+	LegSecurityDesc               *string
+	EncodedLegSecurityDescLen     *int
+	EncodedLegSecurityDesc        *string
+	LegRatioQty                   *decimal.Decimal
+	LegSide                       *string
+	LegCurrency                   *string
+	LegPool                       *string
+	LegDatedDate                  *string
+	LegContractSettlMonth         *string
+	LegInterestAccrualDate        *string
+}
+
+//MarshalNoLegs copies the elements of g into a slice of NoLegsStruct
+func MarshalNoLegs(g NoLegsRepeatingGroup) ([]NoLegsStruct, quickfix.MessageRejectError) {
+	s := make([]NoLegsStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasLegSymbol() {
+			v, err := m.GetLegSymbol()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSymbol = &v
+		}
+		if m.HasLegSymbolSfx() {
+			v, err := m.GetLegSymbolSfx()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSymbolSfx = &v
+		}
+		if m.HasLegSecurityID() {
+			v, err := m.GetLegSecurityID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSecurityID = &v
+		}
+		if m.HasLegSecurityIDSource() {
+			v, err := m.GetLegSecurityIDSource()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSecurityIDSource = &v
+		}
+		if m.HasNoLegSecurityAltID() {
+			g, err := m.GetNoLegSecurityAltID()
+			if err != nil {
+				return nil, err
+			}
+			if s[i].NoLegSecurityAltID, err = components.MarshalNoLegSecurityAltID(g); err != nil {
+				return nil, err
+			}
+		}
+		if m.HasLegProduct() {
+			v, err := m.GetLegProduct()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegProduct = &v
+		}
+		if m.HasLegCFICode() {
+			v, err := m.GetLegCFICode()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegCFICode = &v
+		}
+		if m.HasLegSecurityType() {
+			v, err := m.GetLegSecurityType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSecurityType = &v
+		}
+		if m.HasLegSecuritySubType() {
+			v, err := m.GetLegSecuritySubType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSecuritySubType = &v
+		}
+		if m.HasLegMaturityMonthYear() {
+			v, err := m.GetLegMaturityMonthYear()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegMaturityMonthYear = &v
+		}
+		if m.HasLegMaturityDate() {
+			v, err := m.GetLegMaturityDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegMaturityDate = &v
+		}
+		if m.HasLegCouponPaymentDate() {
+			v, err := m.GetLegCouponPaymentDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegCouponPaymentDate = &v
+		}
+		if m.HasLegIssueDate() {
+			v, err := m.GetLegIssueDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegIssueDate = &v
+		}
+		if m.HasLegRepoCollateralSecurityType() {
+			v, err := m.GetLegRepoCollateralSecurityType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegRepoCollateralSecurityType = &v
+		}
+		if m.HasLegRepurchaseTerm() {
+			v, err := m.GetLegRepurchaseTerm()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegRepurchaseTerm = &v
+		}
+		if m.HasLegRepurchaseRate() {
+			v, err := m.GetLegRepurchaseRate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegRepurchaseRate = &v
+		}
+		if m.HasLegFactor() {
+			v, err := m.GetLegFactor()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegFactor = &v
+		}
+		if m.HasLegCreditRating() {
+			v, err := m.GetLegCreditRating()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegCreditRating = &v
+		}
+		if m.HasLegInstrRegistry() {
+			v, err := m.GetLegInstrRegistry()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegInstrRegistry = &v
+		}
+		if m.HasLegCountryOfIssue() {
+			v, err := m.GetLegCountryOfIssue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegCountryOfIssue = &v
+		}
+		if m.HasLegStateOrProvinceOfIssue() {
+			v, err := m.GetLegStateOrProvinceOfIssue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegStateOrProvinceOfIssue = &v
+		}
+		if m.HasLegLocaleOfIssue() {
+			v, err := m.GetLegLocaleOfIssue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegLocaleOfIssue = &v
+		}
+		if m.HasLegRedemptionDate() {
+			v, err := m.GetLegRedemptionDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegRedemptionDate = &v
+		}
+		if m.HasLegStrikePrice() {
+			v, err := m.GetLegStrikePrice()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegStrikePrice = &v
+		}
+		if m.HasLegStrikeCurrency() {
+			v, err := m.GetLegStrikeCurrency()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegStrikeCurrency = &v
+		}
+		if m.HasLegOptAttribute() {
+			v, err := m.GetLegOptAttribute()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegOptAttribute = &v
+		}
+		if m.HasLegContractMultiplier() {
+			v, err := m.GetLegContractMultiplier()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegContractMultiplier = &v
+		}
+		if m.HasLegCouponRate() {
+			v, err := m.GetLegCouponRate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegCouponRate = &v
+		}
+		if m.HasLegSecurityExchange() {
+			v, err := m.GetLegSecurityExchange()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSecurityExchange = &v
+		}
+		if m.HasLegIssuer() {
+			v, err := m.GetLegIssuer()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegIssuer = &v
+		}
+		if m.HasEncodedLegIssuerLen() {
+			v, err := m.GetEncodedLegIssuerLen()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedLegIssuerLen = &v
+		}
+		if m.HasEncodedLegIssuer() {
+			v, err := m.GetEncodedLegIssuer()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedLegIssuer = &v
+		}
+		if m.HasLegSecurityDesc() {
+			v, err := m.GetLegSecurityDesc()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSecurityDesc = &v
+		}
+		if m.HasEncodedLegSecurityDescLen() {
+			v, err := m.GetEncodedLegSecurityDescLen()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedLegSecurityDescLen = &v
+		}
+		if m.HasEncodedLegSecurityDesc() {
+			v, err := m.GetEncodedLegSecurityDesc()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedLegSecurityDesc = &v
+		}
+		if m.HasLegRatioQty() {
+			v, err := m.GetLegRatioQty()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegRatioQty = &v
+		}
+		if m.HasLegSide() {
+			v, err := m.GetLegSide()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSide = &v
+		}
+		if m.HasLegCurrency() {
+			v, err := m.GetLegCurrency()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegCurrency = &v
+		}
+		if m.HasLegPool() {
+			v, err := m.GetLegPool()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegPool = &v
+		}
+		if m.HasLegDatedDate() {
+			v, err := m.GetLegDatedDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegDatedDate = &v
+		}
+		if m.HasLegContractSettlMonth() {
+			v, err := m.GetLegContractSettlMonth()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegContractSettlMonth = &v
+		}
+		if m.HasLegInterestAccrualDate() {
+			v, err := m.GetLegInterestAccrualDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegInterestAccrualDate = &v
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoLegs builds a NoLegsRepeatingGroup from s
+func UnmarshalNoLegs(s []NoLegsStruct) NoLegsRepeatingGroup {
+	g := NewNoLegsRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.LegSymbol != nil {
+			m.SetLegSymbol(*e.LegSymbol)
+		}
+		if e.LegSymbolSfx != nil {
+			m.SetLegSymbolSfx(*e.LegSymbolSfx)
+		}
+		if e.LegSecurityID != nil {
+			m.SetLegSecurityID(*e.LegSecurityID)
+		}
+		if e.LegSecurityIDSource != nil {
+			m.SetLegSecurityIDSource(*e.LegSecurityIDSource)
+		}
+		if e.NoLegSecurityAltID != nil {
+			m.SetNoLegSecurityAltID(components.UnmarshalNoLegSecurityAltID(e.NoLegSecurityAltID))
+		}
+		if e.LegProduct != nil {
+			m.SetLegProduct(*e.LegProduct)
+		}
+		if e.LegCFICode != nil {
+			m.SetLegCFICode(*e.LegCFICode)
+		}
+		if e.LegSecurityType != nil {
+			m.SetLegSecurityType(*e.LegSecurityType)
+		}
+		if e.LegSecuritySubType != nil {
+			m.SetLegSecuritySubType(*e.LegSecuritySubType)
+		}
+		if e.LegMaturityMonthYear != nil {
+			m.SetLegMaturityMonthYear(*e.LegMaturityMonthYear)
+		}
+		if e.LegMaturityDate != nil {
+			m.SetLegMaturityDate(*e.LegMaturityDate)
+		}
+		if e.LegCouponPaymentDate != nil {
+			m.SetLegCouponPaymentDate(*e.LegCouponPaymentDate)
+		}
+		if e.LegIssueDate != nil {
+			m.SetLegIssueDate(*e.LegIssueDate)
+		}
+		if e.LegRepoCollateralSecurityType != nil {
+			m.SetLegRepoCollateralSecurityType(*e.LegRepoCollateralSecurityType)
+		}
+		if e.LegRepurchaseTerm != nil {
+			m.SetLegRepurchaseTerm(*e.LegRepurchaseTerm)
+		}
+		if e.LegRepurchaseRate != nil {
+			m.SetLegRepurchaseRate(*e.LegRepurchaseRate, fix44.DecimalScale(*e.LegRepurchaseRate))
+		}
+		if e.LegFactor != nil {
+			m.SetLegFactor(*e.LegFactor, fix44.DecimalScale(*e.LegFactor))
+		}
+		if e.LegCreditRating != nil {
+			m.SetLegCreditRating(*e.LegCreditRating)
+		}
+		if e.LegInstrRegistry != nil {
+			m.SetLegInstrRegistry(*e.LegInstrRegistry)
+		}
+		if e.LegCountryOfIssue != nil {
+			m.SetLegCountryOfIssue(*e.LegCountryOfIssue)
+		}
+		if e.LegStateOrProvinceOfIssue != nil {
+			m.SetLegStateOrProvinceOfIssue(*e.LegStateOrProvinceOfIssue)
+		}
+		if e.LegLocaleOfIssue != nil {
+			m.SetLegLocaleOfIssue(*e.LegLocaleOfIssue)
+		}
+		if e.LegRedemptionDate != nil {
+			m.SetLegRedemptionDate(*e.LegRedemptionDate)
+		}
+		if e.LegStrikePrice != nil {
+			m.SetLegStrikePrice(*e.LegStrikePrice, fix44.DecimalScale(*e.LegStrikePrice))
+		}
+		if e.LegStrikeCurrency != nil {
+			m.SetLegStrikeCurrency(*e.LegStrikeCurrency)
+		}
+		if e.LegOptAttribute != nil {
+			m.SetLegOptAttribute(*e.LegOptAttribute)
+		}
+		if e.LegContractMultiplier != nil {
+			m.SetLegContractMultiplier(*e.LegContractMultiplier, fix44.DecimalScale(*e.LegContractMultiplier))
+		}
+		if e.LegCouponRate != nil {
+			m.SetLegCouponRate(*e.LegCouponRate, fix44.DecimalScale(*e.LegCouponRate))
+		}
+		if e.LegSecurityExchange != nil {
+			m.SetLegSecurityExchange(*e.LegSecurityExchange)
+		}
+		if e.LegIssuer != nil {
+			m.SetLegIssuer(*e.LegIssuer)
+		}
+		if e.EncodedLegIssuerLen != nil {
+			m.SetEncodedLegIssuerLen(*e.EncodedLegIssuerLen)
+		}
+		if e.EncodedLegIssuer != nil {
+			m.SetEncodedLegIssuer(*e.EncodedLegIssuer)
+		}
+		if e.LegSecurityDesc != nil {
+			m.SetLegSecurityDesc(*e.LegSecurityDesc)
+		}
+		if e.EncodedLegSecurityDescLen != nil {
+			m.SetEncodedLegSecurityDescLen(*e.EncodedLegSecurityDescLen)
+		}
+		if e.EncodedLegSecurityDesc != nil {
+			m.SetEncodedLegSecurityDesc(*e.EncodedLegSecurityDesc)
+		}
+		if e.LegRatioQty != nil {
+			m.SetLegRatioQty(*e.LegRatioQty, fix44.DecimalScale(*e.LegRatioQty))
+		}
+		if e.LegSide != nil {
+			m.SetLegSide(*e.LegSide)
+		}
+		if e.LegCurrency != nil {
+			m.SetLegCurrency(*e.LegCurrency)
+		}
+		if e.LegPool != nil {
+			m.SetLegPool(*e.LegPool)
+		}
+		if e.LegDatedDate != nil {
+			m.SetLegDatedDate(*e.LegDatedDate)
+		}
+		if e.LegContractSettlMonth != nil {
+			m.SetLegContractSettlMonth(*e.LegContractSettlMonth)
+		}
+		if e.LegInterestAccrualDate != nil {
+			m.SetLegInterestAccrualDate(*e.LegInterestAccrualDate)
+		}
+	}
+	return g
+}
+
+//NoUnderlyingsStruct is a plain Go representation of a NoUnderlyings group element, optional fields are nil when absent
+type NoUnderlyingsStruct struct {
+	UnderlyingSymbol                     *string
+	UnderlyingSymbolSfx                  *string
+	UnderlyingSecurityID                 *string
+	UnderlyingSecurityIDSource           *string
+	NoUnderlyingSecurityAltID            []NoUnderlyingSecurityAltIDStruct
+	UnderlyingProduct                    *int
+	UnderlyingCFICode                    *string
+	UnderlyingSecurityType               *string
+	UnderlyingSecuritySubType            *string
+	UnderlyingMaturityMonthYear          *string
+	UnderlyingMaturityDate               *string
+	UnderlyingCouponPaymentDate          *string
+	UnderlyingIssueDate                  *string
+	UnderlyingRepoCollateralSecurityType *int
+	UnderlyingRepurchaseTerm             *int
+	UnderlyingRepurchaseRate             *decimal.Decimal
+	UnderlyingFactor                     *decimal.Decimal
+	UnderlyingCreditRating               *string
+	UnderlyingInstrRegistry              *string
+	UnderlyingCountryOfIssue             *string
+	UnderlyingStateOrProvinceOfIssue     *string
+	UnderlyingLocaleOfIssue              *string
+	UnderlyingRedemptionDate             *string
+	UnderlyingStrikePrice                *decimal.Decimal
+	UnderlyingStrikeCurrency             *string
+	UnderlyingOptAttribute               *string
+	UnderlyingContractMultiplier         *decimal.Decimal
+	UnderlyingCouponRate                 *decimal.Decimal
+	UnderlyingSecurityExchange           *string
+	UnderlyingIssuer                     *string
+	EncodedUnderlyingIssuerLen           *int
+	EncodedUnderlyingIssuer              *string
+	UnderlyingSecurityDesc               *string
+	EncodedUnderlyingSecurityDescLen     *int
+	EncodedUnderlyingSecurityDesc        *string
+	UnderlyingCPProgram                  *string
+	UnderlyingCPRegType                  *string
+	UnderlyingCurrency                   *string
+	UnderlyingQty                        *decimal.Decimal
+	UnderlyingPx                         *decimal.Decimal
+	UnderlyingDirtyPrice                 *decimal.Decimal
+	UnderlyingEndPrice                   *decimal.Decimal
+	UnderlyingStartValue                 *decimal.Decimal
+	UnderlyingCurrentValue               *decimal.Decimal
+	UnderlyingEndValue                   *decimal.Decimal
+	NoUnderlyingStips                    []NoUnderlyingStipsStruct
+	CollAction                           *enum.CollAction
+}
+
+//MarshalNoUnderlyings copies the elements of g into a slice of NoUnderlyingsStruct
+func MarshalNoUnderlyings(g NoUnderlyingsRepeatingGroup) ([]NoUnderlyingsStruct, quickfix.MessageRejectError) {
+	s := make([]NoUnderlyingsStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasUnderlyingSymbol() {
+			v, err := m.GetUnderlyingSymbol()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingSymbol = &v
+		}
+		if m.HasUnderlyingSymbolSfx() {
+			v, err := m.GetUnderlyingSymbolSfx()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingSymbolSfx = &v
+		}
+		if m.HasUnderlyingSecurityID() {
+			v, err := m.GetUnderlyingSecurityID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingSecurityID = &v
+		}
+		if m.HasUnderlyingSecurityIDSource() {
+			v, err := m.GetUnderlyingSecurityIDSource()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingSecurityIDSource = &v
+		}
+		if m.HasNoUnderlyingSecurityAltID() {
+			g, err := m.GetNoUnderlyingSecurityAltID()
+			if err != nil {
+				return nil, err
+			}
+			if s[i].NoUnderlyingSecurityAltID, err = components.MarshalNoUnderlyingSecurityAltID(g); err != nil {
+				return nil, err
+			}
+		}
+		if m.HasUnderlyingProduct() {
+			v, err := m.GetUnderlyingProduct()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingProduct = &v
+		}
+		if m.HasUnderlyingCFICode() {
+			v, err := m.GetUnderlyingCFICode()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCFICode = &v
+		}
+		if m.HasUnderlyingSecurityType() {
+			v, err := m.GetUnderlyingSecurityType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingSecurityType = &v
+		}
+		if m.HasUnderlyingSecuritySubType() {
+			v, err := m.GetUnderlyingSecuritySubType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingSecuritySubType = &v
+		}
+		if m.HasUnderlyingMaturityMonthYear() {
+			v, err := m.GetUnderlyingMaturityMonthYear()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingMaturityMonthYear = &v
+		}
+		if m.HasUnderlyingMaturityDate() {
+			v, err := m.GetUnderlyingMaturityDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingMaturityDate = &v
+		}
+		if m.HasUnderlyingCouponPaymentDate() {
+			v, err := m.GetUnderlyingCouponPaymentDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCouponPaymentDate = &v
+		}
+		if m.HasUnderlyingIssueDate() {
+			v, err := m.GetUnderlyingIssueDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingIssueDate = &v
+		}
+		if m.HasUnderlyingRepoCollateralSecurityType() {
+			v, err := m.GetUnderlyingRepoCollateralSecurityType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingRepoCollateralSecurityType = &v
+		}
+		if m.HasUnderlyingRepurchaseTerm() {
+			v, err := m.GetUnderlyingRepurchaseTerm()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingRepurchaseTerm = &v
+		}
+		if m.HasUnderlyingRepurchaseRate() {
+			v, err := m.GetUnderlyingRepurchaseRate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingRepurchaseRate = &v
+		}
+		if m.HasUnderlyingFactor() {
+			v, err := m.GetUnderlyingFactor()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingFactor = &v
+		}
+		if m.HasUnderlyingCreditRating() {
+			v, err := m.GetUnderlyingCreditRating()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCreditRating = &v
+		}
+		if m.HasUnderlyingInstrRegistry() {
+			v, err := m.GetUnderlyingInstrRegistry()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingInstrRegistry = &v
+		}
+		if m.HasUnderlyingCountryOfIssue() {
+			v, err := m.GetUnderlyingCountryOfIssue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCountryOfIssue = &v
+		}
+		if m.HasUnderlyingStateOrProvinceOfIssue() {
+			v, err := m.GetUnderlyingStateOrProvinceOfIssue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingStateOrProvinceOfIssue = &v
+		}
+		if m.HasUnderlyingLocaleOfIssue() {
+			v, err := m.GetUnderlyingLocaleOfIssue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingLocaleOfIssue = &v
+		}
+		if m.HasUnderlyingRedemptionDate() {
+			v, err := m.GetUnderlyingRedemptionDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingRedemptionDate = &v
+		}
+		if m.HasUnderlyingStrikePrice() {
+			v, err := m.GetUnderlyingStrikePrice()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingStrikePrice = &v
+		}
+		if m.HasUnderlyingStrikeCurrency() {
+			v, err := m.GetUnderlyingStrikeCurrency()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingStrikeCurrency = &v
+		}
+		if m.HasUnderlyingOptAttribute() {
+			v, err := m.GetUnderlyingOptAttribute()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingOptAttribute = &v
+		}
+		if m.HasUnderlyingContractMultiplier() {
+			v, err := m.GetUnderlyingContractMultiplier()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingContractMultiplier = &v
+		}
+		if m.HasUnderlyingCouponRate() {
+			v, err := m.GetUnderlyingCouponRate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCouponRate = &v
+		}
+		if m.HasUnderlyingSecurityExchange() {
+			v, err := m.GetUnderlyingSecurityExchange()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingSecurityExchange = &v
+		}
+		if m.HasUnderlyingIssuer() {
+			v, err := m.GetUnderlyingIssuer()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingIssuer = &v
+		}
+		if m.HasEncodedUnderlyingIssuerLen() {
+			v, err := m.GetEncodedUnderlyingIssuerLen()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedUnderlyingIssuerLen = &v
+		}
+		if m.HasEncodedUnderlyingIssuer() {
+			v, err := m.GetEncodedUnderlyingIssuer()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedUnderlyingIssuer = &v
+		}
+		if m.HasUnderlyingSecurityDesc() {
+			v, err := m.GetUnderlyingSecurityDesc()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingSecurityDesc = &v
+		}
+		if m.HasEncodedUnderlyingSecurityDescLen() {
+			v, err := m.GetEncodedUnderlyingSecurityDescLen()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedUnderlyingSecurityDescLen = &v
+		}
+		if m.HasEncodedUnderlyingSecurityDesc() {
+			v, err := m.GetEncodedUnderlyingSecurityDesc()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedUnderlyingSecurityDesc = &v
+		}
+		if m.HasUnderlyingCPProgram() {
+			v, err := m.GetUnderlyingCPProgram()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCPProgram = &v
+		}
+		if m.HasUnderlyingCPRegType() {
+			v, err := m.GetUnderlyingCPRegType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCPRegType = &v
+		}
+		if m.HasUnderlyingCurrency() {
+			v, err := m.GetUnderlyingCurrency()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCurrency = &v
+		}
+		if m.HasUnderlyingQty() {
+			v, err := m.GetUnderlyingQty()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingQty = &v
+		}
+		if m.HasUnderlyingPx() {
+			v, err := m.GetUnderlyingPx()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingPx = &v
+		}
+		if m.HasUnderlyingDirtyPrice() {
+			v, err := m.GetUnderlyingDirtyPrice()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingDirtyPrice = &v
+		}
+		if m.HasUnderlyingEndPrice() {
+			v, err := m.GetUnderlyingEndPrice()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingEndPrice = &v
+		}
+		if m.HasUnderlyingStartValue() {
+			v, err := m.GetUnderlyingStartValue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingStartValue = &v
+		}
+		if m.HasUnderlyingCurrentValue() {
+			v, err := m.GetUnderlyingCurrentValue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCurrentValue = &v
+		}
+		if m.HasUnderlyingEndValue() {
+			v, err := m.GetUnderlyingEndValue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingEndValue = &v
+		}
+		if m.HasNoUnderlyingStips() {
+			g, err := m.GetNoUnderlyingStips()
+			if err != nil {
+				return nil, err
+			}
+			if s[i].NoUnderlyingStips, err = components.MarshalNoUnderlyingStips(g); err != nil {
+				return nil, err
+			}
+		}
+		if m.HasCollAction() {
+			v, err := m.GetCollAction()
+			if err != nil {
+				return nil, err
+			}
+			s[i].CollAction = &v
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoUnderlyings builds a NoUnderlyingsRepeatingGroup from s
+func UnmarshalNoUnderlyings(s []NoUnderlyingsStruct) NoUnderlyingsRepeatingGroup {
+	g := NewNoUnderlyingsRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.UnderlyingSymbol != nil {
+			m.SetUnderlyingSymbol(*e.UnderlyingSymbol)
+		}
+		if e.UnderlyingSymbolSfx != nil {
+			m.SetUnderlyingSymbolSfx(*e.UnderlyingSymbolSfx)
+		}
+		if e.UnderlyingSecurityID != nil {
+			m.SetUnderlyingSecurityID(*e.UnderlyingSecurityID)
+		}
+		if e.UnderlyingSecurityIDSource != nil {
+			m.SetUnderlyingSecurityIDSource(*e.UnderlyingSecurityIDSource)
+		}
+		if e.NoUnderlyingSecurityAltID != nil {
+			m.SetNoUnderlyingSecurityAltID(components.UnmarshalNoUnderlyingSecurityAltID(e.NoUnderlyingSecurityAltID))
+		}
+		if e.UnderlyingProduct != nil {
+			m.SetUnderlyingProduct(*e.UnderlyingProduct)
+		}
+		if e.UnderlyingCFICode != nil {
+			m.SetUnderlyingCFICode(*e.UnderlyingCFICode)
+		}
+		if e.UnderlyingSecurityType != nil {
+			m.SetUnderlyingSecurityType(*e.UnderlyingSecurityType)
+		}
+		if e.UnderlyingSecuritySubType != nil {
+			m.SetUnderlyingSecuritySubType(*e.UnderlyingSecuritySubType)
+		}
+		if e.UnderlyingMaturityMonthYear != nil {
+			m.SetUnderlyingMaturityMonthYear(*e.UnderlyingMaturityMonthYear)
+		}
+		if e.UnderlyingMaturityDate != nil {
+			m.SetUnderlyingMaturityDate(*e.UnderlyingMaturityDate)
+		}
+		if e.UnderlyingCouponPaymentDate != nil {
+			m.SetUnderlyingCouponPaymentDate(*e.UnderlyingCouponPaymentDate)
+		}
+		if e.UnderlyingIssueDate != nil {
+			m.SetUnderlyingIssueDate(*e.UnderlyingIssueDate)
+		}
+		if e.UnderlyingRepoCollateralSecurityType != nil {
+			m.SetUnderlyingRepoCollateralSecurityType(*e.UnderlyingRepoCollateralSecurityType)
+		}
+		if e.UnderlyingRepurchaseTerm != nil {
+			m.SetUnderlyingRepurchaseTerm(*e.UnderlyingRepurchaseTerm)
+		}
+		if e.UnderlyingRepurchaseRate != nil {
+			m.SetUnderlyingRepurchaseRate(*e.UnderlyingRepurchaseRate, fix44.DecimalScale(*e.UnderlyingRepurchaseRate))
+		}
+		if e.UnderlyingFactor != nil {
+			m.SetUnderlyingFactor(*e.UnderlyingFactor, fix44.DecimalScale(*e.UnderlyingFactor))
+		}
+		if e.UnderlyingCreditRating != nil {
+			m.SetUnderlyingCreditRating(*e.UnderlyingCreditRating)
+		}
+		if e.UnderlyingInstrRegistry != nil {
+			m.SetUnderlyingInstrRegistry(*e.UnderlyingInstrRegistry)
+		}
+		if e.UnderlyingCountryOfIssue != nil {
+			m.SetUnderlyingCountryOfIssue(*e.UnderlyingCountryOfIssue)
+		}
+		if e.UnderlyingStateOrProvinceOfIssue != nil {
+			m.SetUnderlyingStateOrProvinceOfIssue(*e.UnderlyingStateOrProvinceOfIssue)
+		}
+		if e.UnderlyingLocaleOfIssue != nil {
+			m.SetUnderlyingLocaleOfIssue(*e.UnderlyingLocaleOfIssue)
+		}
+		if e.UnderlyingRedemptionDate != nil {
+			m.SetUnderlyingRedemptionDate(*e.UnderlyingRedemptionDate)
+		}
+		if e.UnderlyingStrikePrice != nil {
+			m.SetUnderlyingStrikePrice(*e.UnderlyingStrikePrice, fix44.DecimalScale(*e.UnderlyingStrikePrice))
+		}
+		if e.UnderlyingStrikeCurrency != nil {
+			m.SetUnderlyingStrikeCurrency(*e.UnderlyingStrikeCurrency)
+		}
+		if e.UnderlyingOptAttribute != nil {
+			m.SetUnderlyingOptAttribute(*e.UnderlyingOptAttribute)
+		}
+		if e.UnderlyingContractMultiplier != nil {
+			m.SetUnderlyingContractMultiplier(*e.UnderlyingContractMultiplier, fix44.DecimalScale(*e.UnderlyingContractMultiplier))
+		}
+		if e.UnderlyingCouponRate != nil {
+			m.SetUnderlyingCouponRate(*e.UnderlyingCouponRate, fix44.DecimalScale(*e.UnderlyingCouponRate))
+		}
+		if e.UnderlyingSecurityExchange != nil {
+			m.SetUnderlyingSecurityExchange(*e.UnderlyingSecurityExchange)
+		}
+		if e.UnderlyingIssuer != nil {
+			m.SetUnderlyingIssuer(*e.UnderlyingIssuer)
+		}
+		if e.EncodedUnderlyingIssuerLen != nil {
+			m.SetEncodedUnderlyingIssuerLen(*e.EncodedUnderlyingIssuerLen)
+		}
+		if e.EncodedUnderlyingIssuer != nil {
+			m.SetEncodedUnderlyingIssuer(*e.EncodedUnderlyingIssuer)
+		}
+		if e.UnderlyingSecurityDesc != nil {
+			m.SetUnderlyingSecurityDesc(*e.UnderlyingSecurityDesc)
+		}
+		if e.EncodedUnderlyingSecurityDescLen != nil {
+			m.SetEncodedUnderlyingSecurityDescLen(*e.EncodedUnderlyingSecurityDescLen)
+		}
+		if e.EncodedUnderlyingSecurityDesc != nil {
+			m.SetEncodedUnderlyingSecurityDesc(*e.EncodedUnderlyingSecurityDesc)
+		}
+		if e.UnderlyingCPProgram != nil {
+			m.SetUnderlyingCPProgram(*e.UnderlyingCPProgram)
+		}
+		if e.UnderlyingCPRegType != nil {
+			m.SetUnderlyingCPRegType(*e.UnderlyingCPRegType)
+		}
+		if e.UnderlyingCurrency != nil {
+			m.SetUnderlyingCurrency(*e.UnderlyingCurrency)
+		}
+		if e.UnderlyingQty != nil {
+			m.SetUnderlyingQty(*e.UnderlyingQty, fix44.DecimalScale(*e.UnderlyingQty))
+		}
+		if e.UnderlyingPx != nil {
+			m.SetUnderlyingPx(*e.UnderlyingPx, fix44.DecimalScale(*e.UnderlyingPx))
+		}
+		if e.UnderlyingDirtyPrice != nil {
+			m.SetUnderlyingDirtyPrice(*e.UnderlyingDirtyPrice, fix44.DecimalScale(*e.UnderlyingDirtyPrice))
+		}
+		if e.UnderlyingEndPrice != nil {
+			m.SetUnderlyingEndPrice(*e.UnderlyingEndPrice, fix44.DecimalScale(*e.UnderlyingEndPrice))
+		}
+		if e.UnderlyingStartValue != nil {
+			m.SetUnderlyingStartValue(*e.UnderlyingStartValue, fix44.DecimalScale(*e.UnderlyingStartValue))
+		}
+		if e.UnderlyingCurrentValue != nil {
+			m.SetUnderlyingCurrentValue(*e.UnderlyingCurrentValue, fix44.DecimalScale(*e.UnderlyingCurrentValue))
+		}
+		if e.UnderlyingEndValue != nil {
+			m.SetUnderlyingEndValue(*e.UnderlyingEndValue, fix44.DecimalScale(*e.UnderlyingEndValue))
+		}
+		if e.NoUnderlyingStips != nil {
+			m.SetNoUnderlyingStips(components.UnmarshalNoUnderlyingStips(e.NoUnderlyingStips))
+		}
+		if e.CollAction != nil {
+			m.SetCollAction(*e.CollAction)
+		}
+	}
+	return g
+}
+
+//NoTrdRegTimestampsStruct is a plain Go representation of a NoTrdRegTimestamps group element, optional fields are nil when absent
+type NoTrdRegTimestampsStruct struct {
+	TrdRegTimestamp       *time.Time
+	TrdRegTimestampType   *enum.TrdRegTimestampType
+	TrdRegTimestampOrigin *string
+}
+
+//MarshalNoTrdRegTimestamps copies the elements of g into a slice of NoTrdRegTimestampsStruct
+func MarshalNoTrdRegTimestamps(g NoTrdRegTimestampsRepeatingGroup) ([]NoTrdRegTimestampsStruct, quickfix.MessageRejectError) {
+	s := make([]NoTrdRegTimestampsStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasTrdRegTimestamp() {
+			v, err := m.GetTrdRegTimestamp()
+			if err != nil {
+				return nil, err
+			}
+			s[i].TrdRegTimestamp = &v
+		}
+		if m.HasTrdRegTimestampType() {
+			v, err := m.GetTrdRegTimestampType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].TrdRegTimestampType = &v
+		}
+		if m.HasTrdRegTimestampOrigin() {
+			v, err := m.GetTrdRegTimestampOrigin()
+			if err != nil {
+				return nil, err
+			}
+			s[i].TrdRegTimestampOrigin = &v
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoTrdRegTimestamps builds a NoTrdRegTimestampsRepeatingGroup from s
+func UnmarshalNoTrdRegTimestamps(s []NoTrdRegTimestampsStruct) NoTrdRegTimestampsRepeatingGroup {
+	g := NewNoTrdRegTimestampsRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.TrdRegTimestamp != nil {
+			m.SetTrdRegTimestamp(*e.TrdRegTimestamp)
+		}
+		if e.TrdRegTimestampType != nil {
+			m.SetTrdRegTimestampType(*e.TrdRegTimestampType)
+		}
+		if e.TrdRegTimestampOrigin != nil {
+			m.SetTrdRegTimestampOrigin(*e.TrdRegTimestampOrigin)
+		}
+	}
+	return g
+}
+
+//NoTradesStruct is a plain Go representation of a NoTrades group element, optional fields are nil when absent
+type NoTradesStruct struct {
+	TradeReportID          *string
+	SecondaryTradeReportID *string
+}
+
+//MarshalNoTrades copies the elements of g into a slice of NoTradesStruct
+func MarshalNoTrades(g NoTradesRepeatingGroup) ([]NoTradesStruct, quickfix.MessageRejectError) {
+	s := make([]NoTradesStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasTradeReportID() {
+			v, err := m.GetTradeReportID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].TradeReportID = &v
+		}
+		if m.HasSecondaryTradeReportID() {
+			v, err := m.GetSecondaryTradeReportID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SecondaryTradeReportID = &v
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoTrades builds a NoTradesRepeatingGroup from s
+func UnmarshalNoTrades(s []NoTradesStruct) NoTradesRepeatingGroup {
+	g := NewNoTradesRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.TradeReportID != nil {
+			m.SetTradeReportID(*e.TradeReportID)
+		}
+		if e.SecondaryTradeReportID != nil {
+			m.SetSecondaryTradeReportID(*e.SecondaryTradeReportID)
+		}
+	}
+	return g
+}
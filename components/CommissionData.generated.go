@@ -0,0 +1,108 @@
+package components
+
+import (
+	"github.com/shopspring/decimal"
+
+	"github.com/terracefi/enum"
+	"github.com/terracefi/field"
+	"github.com/terracefi/quickfix"
+	"github.com/terracefi/tag"
+)
+
+//CommissionData is the fix44 CommissionData component
+type CommissionData struct {
+	*quickfix.FieldMap
+}
+
+//NewCommissionData returns a new, empty CommissionData component
+func NewCommissionData() CommissionData {
+	return CommissionData{&quickfix.NewMessage().Body.FieldMap}
+}
+
+//CopyInto copies the CommissionData fields present in m into fm
+func (m CommissionData) CopyInto(fm *quickfix.FieldMap) quickfix.MessageRejectError {
+	return copyFields(m.FieldMap, fm, commissionDataTags)
+}
+
+//SetCommission sets Commission, Tag 12
+func (m CommissionData) SetCommission(value decimal.Decimal, scale int32) {
+	m.Set(field.NewCommission(value, scale))
+}
+
+//SetCommType sets CommType, Tag 13
+func (m CommissionData) SetCommType(v enum.CommType) {
+	m.Set(field.NewCommType(v))
+}
+
+//SetCommCurrency sets CommCurrency, Tag 479
+func (m CommissionData) SetCommCurrency(v string) {
+	m.Set(field.NewCommCurrency(v))
+}
+
+//SetFundRenewWaiv sets FundRenewWaiv, Tag 497
+func (m CommissionData) SetFundRenewWaiv(v enum.FundRenewWaiv) {
+	m.Set(field.NewFundRenewWaiv(v))
+}
+
+//GetCommission gets Commission, Tag 12
+func (m CommissionData) GetCommission() (v decimal.Decimal, err quickfix.MessageRejectError) {
+	var f field.CommissionField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetCommType gets CommType, Tag 13
+func (m CommissionData) GetCommType() (v enum.CommType, err quickfix.MessageRejectError) {
+	var f field.CommTypeField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetCommCurrency gets CommCurrency, Tag 479
+func (m CommissionData) GetCommCurrency() (v string, err quickfix.MessageRejectError) {
+	var f field.CommCurrencyField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetFundRenewWaiv gets FundRenewWaiv, Tag 497
+func (m CommissionData) GetFundRenewWaiv() (v enum.FundRenewWaiv, err quickfix.MessageRejectError) {
+	var f field.FundRenewWaivField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//HasCommission returns true if Commission is present, Tag 12
+func (m CommissionData) HasCommission() bool {
+	return m.Has(tag.Commission)
+}
+
+//HasCommType returns true if CommType is present, Tag 13
+func (m CommissionData) HasCommType() bool {
+	return m.Has(tag.CommType)
+}
+
+//HasCommCurrency returns true if CommCurrency is present, Tag 479
+func (m CommissionData) HasCommCurrency() bool {
+	return m.Has(tag.CommCurrency)
+}
+
+//HasFundRenewWaiv returns true if FundRenewWaiv is present, Tag 497
+func (m CommissionData) HasFundRenewWaiv() bool {
+	return m.Has(tag.FundRenewWaiv)
+}
+
+var commissionDataTags = []quickfix.Tag{
+	tag.Commission,
+	tag.CommType,
+	tag.CommCurrency,
+	tag.FundRenewWaiv,
+}
@@ -0,0 +1,1173 @@
+package components
+
+import (
+	"github.com/shopspring/decimal"
+
+	"github.com/terracefi/enum"
+	"github.com/terracefi/field"
+	"github.com/terracefi/fix44"
+	"github.com/terracefi/quickfix"
+	"github.com/terracefi/tag"
+)
+
+//Instrument is the fix44 Instrument component
+type Instrument struct {
+	*quickfix.FieldMap
+}
+
+//NewInstrument returns a new, empty Instrument component
+func NewInstrument() Instrument {
+	return Instrument{&quickfix.NewMessage().Body.FieldMap}
+}
+
+//CopyInto copies the Instrument fields present in m into fm
+func (m Instrument) CopyInto(fm *quickfix.FieldMap) quickfix.MessageRejectError {
+	return copyFields(m.FieldMap, fm, instrumentTags, NewNoSecurityAltIDRepeatingGroup(), NewNoEventsRepeatingGroup())
+}
+
+//SetSymbol sets Symbol, Tag 55
+func (m Instrument) SetSymbol(v string) {
+	m.Set(field.NewSymbol(v))
+}
+
+//SetSymbolSfx sets SymbolSfx, Tag 65
+func (m Instrument) SetSymbolSfx(v enum.SymbolSfx) {
+	m.Set(field.NewSymbolSfx(v))
+}
+
+//SetSecurityID sets SecurityID, Tag 48
+func (m Instrument) SetSecurityID(v string) {
+	m.Set(field.NewSecurityID(v))
+}
+
+//SetSecurityIDSource sets SecurityIDSource, Tag 22
+func (m Instrument) SetSecurityIDSource(v enum.SecurityIDSource) {
+	m.Set(field.NewSecurityIDSource(v))
+}
+
+//SetNoSecurityAltID sets NoSecurityAltID, Tag 454
+func (m Instrument) SetNoSecurityAltID(f NoSecurityAltIDRepeatingGroup) {
+	m.SetGroup(f)
+}
+
+//SetProduct sets Product, Tag 460
+func (m Instrument) SetProduct(v enum.Product) {
+	m.Set(field.NewProduct(v))
+}
+
+//SetCFICode sets CFICode, Tag 461
+func (m Instrument) SetCFICode(v string) {
+	m.Set(field.NewCFICode(v))
+}
+
+//SetSecurityType sets SecurityType, Tag 167
+func (m Instrument) SetSecurityType(v enum.SecurityType) {
+	m.Set(field.NewSecurityType(v))
+}
+
+//SetSecuritySubType sets SecuritySubType, Tag 762
+func (m Instrument) SetSecuritySubType(v string) {
+	m.Set(field.NewSecuritySubType(v))
+}
+
+//SetMaturityMonthYear sets MaturityMonthYear, Tag 200
+func (m Instrument) SetMaturityMonthYear(v string) {
+	m.Set(field.NewMaturityMonthYear(v))
+}
+
+//SetMaturityDate sets MaturityDate, Tag 541
+func (m Instrument) SetMaturityDate(v string) {
+	m.Set(field.NewMaturityDate(v))
+}
+
+//SetCouponPaymentDate sets CouponPaymentDate, Tag 224
+func (m Instrument) SetCouponPaymentDate(v string) {
+	m.Set(field.NewCouponPaymentDate(v))
+}
+
+//SetIssueDate sets IssueDate, Tag 225
+func (m Instrument) SetIssueDate(v string) {
+	m.Set(field.NewIssueDate(v))
+}
+
+//SetRepoCollateralSecurityType sets RepoCollateralSecurityType, Tag 239
+func (m Instrument) SetRepoCollateralSecurityType(v int) {
+	m.Set(field.NewRepoCollateralSecurityType(v))
+}
+
+//SetRepurchaseTerm sets RepurchaseTerm, Tag 226
+func (m Instrument) SetRepurchaseTerm(v int) {
+	m.Set(field.NewRepurchaseTerm(v))
+}
+
+//SetRepurchaseRate sets RepurchaseRate, Tag 227
+func (m Instrument) SetRepurchaseRate(value decimal.Decimal, scale int32) {
+	m.Set(field.NewRepurchaseRate(value, scale))
+}
+
+//SetFactor sets Factor, Tag 228
+func (m Instrument) SetFactor(value decimal.Decimal, scale int32) {
+	m.Set(field.NewFactor(value, scale))
+}
+
+//SetCreditRating sets CreditRating, Tag 255
+func (m Instrument) SetCreditRating(v string) {
+	m.Set(field.NewCreditRating(v))
+}
+
+//SetInstrRegistry sets InstrRegistry, Tag 543
+func (m Instrument) SetInstrRegistry(v enum.InstrRegistry) {
+	m.Set(field.NewInstrRegistry(v))
+}
+
+//SetCountryOfIssue sets CountryOfIssue, Tag 470
+func (m Instrument) SetCountryOfIssue(v string) {
+	m.Set(field.NewCountryOfIssue(v))
+}
+
+//SetStateOrProvinceOfIssue sets StateOrProvinceOfIssue, Tag 471
+func (m Instrument) SetStateOrProvinceOfIssue(v string) {
+	m.Set(field.NewStateOrProvinceOfIssue(v))
+}
+
+//SetLocaleOfIssue sets LocaleOfIssue, Tag 472
+func (m Instrument) SetLocaleOfIssue(v string) {
+	m.Set(field.NewLocaleOfIssue(v))
+}
+
+//SetRedemptionDate sets RedemptionDate, Tag 240
+func (m Instrument) SetRedemptionDate(v string) {
+	m.Set(field.NewRedemptionDate(v))
+}
+
+//SetStrikePrice sets StrikePrice, Tag 202
+func (m Instrument) SetStrikePrice(value decimal.Decimal, scale int32) {
+	m.Set(field.NewStrikePrice(value, scale))
+}
+
+//SetStrikeCurrency sets StrikeCurrency, Tag 947
+func (m Instrument) SetStrikeCurrency(v string) {
+	m.Set(field.NewStrikeCurrency(v))
+}
+
+//SetOptAttribute sets OptAttribute, Tag 206
+func (m Instrument) SetOptAttribute(v string) {
+	m.Set(field.NewOptAttribute(v))
+}
+
+//SetContractMultiplier sets ContractMultiplier, Tag 231
+func (m Instrument) SetContractMultiplier(value decimal.Decimal, scale int32) {
+	m.Set(field.NewContractMultiplier(value, scale))
+}
+
+//SetCouponRate sets CouponRate, Tag 223
+func (m Instrument) SetCouponRate(value decimal.Decimal, scale int32) {
+	m.Set(field.NewCouponRate(value, scale))
+}
+
+//SetSecurityExchange sets SecurityExchange, Tag 207
+func (m Instrument) SetSecurityExchange(v string) {
+	m.Set(field.NewSecurityExchange(v))
+}
+
+//SetIssuer sets Issuer, Tag 106
+func (m Instrument) SetIssuer(v string) {
+	m.Set(field.NewIssuer(v))
+}
+
+//SetEncodedIssuerLen sets EncodedIssuerLen, Tag 348
+func (m Instrument) SetEncodedIssuerLen(v int) {
+	m.Set(field.NewEncodedIssuerLen(v))
+}
+
+//SetEncodedIssuer sets EncodedIssuer, Tag 349
+func (m Instrument) SetEncodedIssuer(v string) {
+	m.Set(field.NewEncodedIssuer(v))
+}
+
+//SetSecurityDesc sets SecurityDesc, Tag 107
+func (m Instrument) SetSecurityDesc(v string) {
+	m.Set(field.NewSecurityDesc(v))
+}
+
+//SetEncodedSecurityDescLen sets EncodedSecurityDescLen, Tag 350
+func (m Instrument) SetEncodedSecurityDescLen(v int) {
+	m.Set(field.NewEncodedSecurityDescLen(v))
+}
+
+//SetEncodedSecurityDesc sets EncodedSecurityDesc, Tag 351
+func (m Instrument) SetEncodedSecurityDesc(v string) {
+	m.Set(field.NewEncodedSecurityDesc(v))
+}
+
+//SetPool sets Pool, Tag 691
+func (m Instrument) SetPool(v string) {
+	m.Set(field.NewPool(v))
+}
+
+//SetContractSettlMonth sets ContractSettlMonth, Tag 667
+func (m Instrument) SetContractSettlMonth(v string) {
+	m.Set(field.NewContractSettlMonth(v))
+}
+
+//SetCPProgram sets CPProgram, Tag 875
+func (m Instrument) SetCPProgram(v enum.CPProgram) {
+	m.Set(field.NewCPProgram(v))
+}
+
+//SetCPRegType sets CPRegType, Tag 876
+func (m Instrument) SetCPRegType(v string) {
+	m.Set(field.NewCPRegType(v))
+}
+
+//SetNoEvents sets NoEvents, Tag 864
+func (m Instrument) SetNoEvents(f NoEventsRepeatingGroup) {
+	m.SetGroup(f)
+}
+
+//SetDatedDate sets DatedDate, Tag 873
+func (m Instrument) SetDatedDate(v string) {
+	m.Set(field.NewDatedDate(v))
+}
+
+//SetInterestAccrualDate sets InterestAccrualDate, Tag 874
+func (m Instrument) SetInterestAccrualDate(v string) {
+	m.Set(field.NewInterestAccrualDate(v))
+}
+
+//GetSymbol gets Symbol, Tag 55
+func (m Instrument) GetSymbol() (v string, err quickfix.MessageRejectError) {
+	var f field.SymbolField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetSymbolSfx gets SymbolSfx, Tag 65
+func (m Instrument) GetSymbolSfx() (v enum.SymbolSfx, err quickfix.MessageRejectError) {
+	var f field.SymbolSfxField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetSecurityID gets SecurityID, Tag 48
+func (m Instrument) GetSecurityID() (v string, err quickfix.MessageRejectError) {
+	var f field.SecurityIDField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetSecurityIDSource gets SecurityIDSource, Tag 22
+func (m Instrument) GetSecurityIDSource() (v enum.SecurityIDSource, err quickfix.MessageRejectError) {
+	var f field.SecurityIDSourceField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetNoSecurityAltID gets NoSecurityAltID, Tag 454
+func (m Instrument) GetNoSecurityAltID() (NoSecurityAltIDRepeatingGroup, quickfix.MessageRejectError) {
+	f := NewNoSecurityAltIDRepeatingGroup()
+	err := m.GetGroup(f)
+	return f, err
+}
+
+//GetProduct gets Product, Tag 460
+func (m Instrument) GetProduct() (v enum.Product, err quickfix.MessageRejectError) {
+	var f field.ProductField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetCFICode gets CFICode, Tag 461
+func (m Instrument) GetCFICode() (v string, err quickfix.MessageRejectError) {
+	var f field.CFICodeField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetSecurityType gets SecurityType, Tag 167
+func (m Instrument) GetSecurityType() (v enum.SecurityType, err quickfix.MessageRejectError) {
+	var f field.SecurityTypeField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetSecuritySubType gets SecuritySubType, Tag 762
+func (m Instrument) GetSecuritySubType() (v string, err quickfix.MessageRejectError) {
+	var f field.SecuritySubTypeField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetMaturityMonthYear gets MaturityMonthYear, Tag 200
+func (m Instrument) GetMaturityMonthYear() (v string, err quickfix.MessageRejectError) {
+	var f field.MaturityMonthYearField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetMaturityDate gets MaturityDate, Tag 541
+func (m Instrument) GetMaturityDate() (v string, err quickfix.MessageRejectError) {
+	var f field.MaturityDateField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetCouponPaymentDate gets CouponPaymentDate, Tag 224
+func (m Instrument) GetCouponPaymentDate() (v string, err quickfix.MessageRejectError) {
+	var f field.CouponPaymentDateField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetIssueDate gets IssueDate, Tag 225
+func (m Instrument) GetIssueDate() (v string, err quickfix.MessageRejectError) {
+	var f field.IssueDateField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetRepoCollateralSecurityType gets RepoCollateralSecurityType, Tag 239
+func (m Instrument) GetRepoCollateralSecurityType() (v int, err quickfix.MessageRejectError) {
+	var f field.RepoCollateralSecurityTypeField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetRepurchaseTerm gets RepurchaseTerm, Tag 226
+func (m Instrument) GetRepurchaseTerm() (v int, err quickfix.MessageRejectError) {
+	var f field.RepurchaseTermField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetRepurchaseRate gets RepurchaseRate, Tag 227
+func (m Instrument) GetRepurchaseRate() (v decimal.Decimal, err quickfix.MessageRejectError) {
+	var f field.RepurchaseRateField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetFactor gets Factor, Tag 228
+func (m Instrument) GetFactor() (v decimal.Decimal, err quickfix.MessageRejectError) {
+	var f field.FactorField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetCreditRating gets CreditRating, Tag 255
+func (m Instrument) GetCreditRating() (v string, err quickfix.MessageRejectError) {
+	var f field.CreditRatingField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetInstrRegistry gets InstrRegistry, Tag 543
+func (m Instrument) GetInstrRegistry() (v enum.InstrRegistry, err quickfix.MessageRejectError) {
+	var f field.InstrRegistryField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetCountryOfIssue gets CountryOfIssue, Tag 470
+func (m Instrument) GetCountryOfIssue() (v string, err quickfix.MessageRejectError) {
+	var f field.CountryOfIssueField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetStateOrProvinceOfIssue gets StateOrProvinceOfIssue, Tag 471
+func (m Instrument) GetStateOrProvinceOfIssue() (v string, err quickfix.MessageRejectError) {
+	var f field.StateOrProvinceOfIssueField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetLocaleOfIssue gets LocaleOfIssue, Tag 472
+func (m Instrument) GetLocaleOfIssue() (v string, err quickfix.MessageRejectError) {
+	var f field.LocaleOfIssueField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetRedemptionDate gets RedemptionDate, Tag 240
+func (m Instrument) GetRedemptionDate() (v string, err quickfix.MessageRejectError) {
+	var f field.RedemptionDateField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetStrikePrice gets StrikePrice, Tag 202
+func (m Instrument) GetStrikePrice() (v decimal.Decimal, err quickfix.MessageRejectError) {
+	var f field.StrikePriceField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetStrikeCurrency gets StrikeCurrency, Tag 947
+func (m Instrument) GetStrikeCurrency() (v string, err quickfix.MessageRejectError) {
+	var f field.StrikeCurrencyField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetOptAttribute gets OptAttribute, Tag 206
+func (m Instrument) GetOptAttribute() (v string, err quickfix.MessageRejectError) {
+	var f field.OptAttributeField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetContractMultiplier gets ContractMultiplier, Tag 231
+func (m Instrument) GetContractMultiplier() (v decimal.Decimal, err quickfix.MessageRejectError) {
+	var f field.ContractMultiplierField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetCouponRate gets CouponRate, Tag 223
+func (m Instrument) GetCouponRate() (v decimal.Decimal, err quickfix.MessageRejectError) {
+	var f field.CouponRateField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetSecurityExchange gets SecurityExchange, Tag 207
+func (m Instrument) GetSecurityExchange() (v string, err quickfix.MessageRejectError) {
+	var f field.SecurityExchangeField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetIssuer gets Issuer, Tag 106
+func (m Instrument) GetIssuer() (v string, err quickfix.MessageRejectError) {
+	var f field.IssuerField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetEncodedIssuerLen gets EncodedIssuerLen, Tag 348
+func (m Instrument) GetEncodedIssuerLen() (v int, err quickfix.MessageRejectError) {
+	var f field.EncodedIssuerLenField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetEncodedIssuer gets EncodedIssuer, Tag 349
+func (m Instrument) GetEncodedIssuer() (v string, err quickfix.MessageRejectError) {
+	var f field.EncodedIssuerField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetSecurityDesc gets SecurityDesc, Tag 107
+func (m Instrument) GetSecurityDesc() (v string, err quickfix.MessageRejectError) {
+	var f field.SecurityDescField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetEncodedSecurityDescLen gets EncodedSecurityDescLen, Tag 350
+func (m Instrument) GetEncodedSecurityDescLen() (v int, err quickfix.MessageRejectError) {
+	var f field.EncodedSecurityDescLenField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetEncodedSecurityDesc gets EncodedSecurityDesc, Tag 351
+func (m Instrument) GetEncodedSecurityDesc() (v string, err quickfix.MessageRejectError) {
+	var f field.EncodedSecurityDescField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetPool gets Pool, Tag 691
+func (m Instrument) GetPool() (v string, err quickfix.MessageRejectError) {
+	var f field.PoolField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetContractSettlMonth gets ContractSettlMonth, Tag 667
+func (m Instrument) GetContractSettlMonth() (v string, err quickfix.MessageRejectError) {
+	var f field.ContractSettlMonthField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetCPProgram gets CPProgram, Tag 875
+func (m Instrument) GetCPProgram() (v enum.CPProgram, err quickfix.MessageRejectError) {
+	var f field.CPProgramField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetCPRegType gets CPRegType, Tag 876
+func (m Instrument) GetCPRegType() (v string, err quickfix.MessageRejectError) {
+	var f field.CPRegTypeField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetNoEvents gets NoEvents, Tag 864
+func (m Instrument) GetNoEvents() (NoEventsRepeatingGroup, quickfix.MessageRejectError) {
+	f := NewNoEventsRepeatingGroup()
+	err := m.GetGroup(f)
+	return f, err
+}
+
+//GetDatedDate gets DatedDate, Tag 873
+func (m Instrument) GetDatedDate() (v string, err quickfix.MessageRejectError) {
+	var f field.DatedDateField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetInterestAccrualDate gets InterestAccrualDate, Tag 874
+func (m Instrument) GetInterestAccrualDate() (v string, err quickfix.MessageRejectError) {
+	var f field.InterestAccrualDateField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//HasSymbol returns true if Symbol is present, Tag 55
+func (m Instrument) HasSymbol() bool {
+	return m.Has(tag.Symbol)
+}
+
+//HasSymbolSfx returns true if SymbolSfx is present, Tag 65
+func (m Instrument) HasSymbolSfx() bool {
+	return m.Has(tag.SymbolSfx)
+}
+
+//HasSecurityID returns true if SecurityID is present, Tag 48
+func (m Instrument) HasSecurityID() bool {
+	return m.Has(tag.SecurityID)
+}
+
+//HasSecurityIDSource returns true if SecurityIDSource is present, Tag 22
+func (m Instrument) HasSecurityIDSource() bool {
+	return m.Has(tag.SecurityIDSource)
+}
+
+//HasNoSecurityAltID returns true if NoSecurityAltID is present, Tag 454
+func (m Instrument) HasNoSecurityAltID() bool {
+	return m.Has(tag.NoSecurityAltID)
+}
+
+//HasProduct returns true if Product is present, Tag 460
+func (m Instrument) HasProduct() bool {
+	return m.Has(tag.Product)
+}
+
+//HasCFICode returns true if CFICode is present, Tag 461
+func (m Instrument) HasCFICode() bool {
+	return m.Has(tag.CFICode)
+}
+
+//HasSecurityType returns true if SecurityType is present, Tag 167
+func (m Instrument) HasSecurityType() bool {
+	return m.Has(tag.SecurityType)
+}
+
+//HasSecuritySubType returns true if SecuritySubType is present, Tag 762
+func (m Instrument) HasSecuritySubType() bool {
+	return m.Has(tag.SecuritySubType)
+}
+
+//HasMaturityMonthYear returns true if MaturityMonthYear is present, Tag 200
+func (m Instrument) HasMaturityMonthYear() bool {
+	return m.Has(tag.MaturityMonthYear)
+}
+
+//HasMaturityDate returns true if MaturityDate is present, Tag 541
+func (m Instrument) HasMaturityDate() bool {
+	return m.Has(tag.MaturityDate)
+}
+
+//HasCouponPaymentDate returns true if CouponPaymentDate is present, Tag 224
+func (m Instrument) HasCouponPaymentDate() bool {
+	return m.Has(tag.CouponPaymentDate)
+}
+
+//HasIssueDate returns true if IssueDate is present, Tag 225
+func (m Instrument) HasIssueDate() bool {
+	return m.Has(tag.IssueDate)
+}
+
+//HasRepoCollateralSecurityType returns true if RepoCollateralSecurityType is present, Tag 239
+func (m Instrument) HasRepoCollateralSecurityType() bool {
+	return m.Has(tag.RepoCollateralSecurityType)
+}
+
+//HasRepurchaseTerm returns true if RepurchaseTerm is present, Tag 226
+func (m Instrument) HasRepurchaseTerm() bool {
+	return m.Has(tag.RepurchaseTerm)
+}
+
+//HasRepurchaseRate returns true if RepurchaseRate is present, Tag 227
+func (m Instrument) HasRepurchaseRate() bool {
+	return m.Has(tag.RepurchaseRate)
+}
+
+//HasFactor returns true if Factor is present, Tag 228
+func (m Instrument) HasFactor() bool {
+	return m.Has(tag.Factor)
+}
+
+//HasCreditRating returns true if CreditRating is present, Tag 255
+func (m Instrument) HasCreditRating() bool {
+	return m.Has(tag.CreditRating)
+}
+
+//HasInstrRegistry returns true if InstrRegistry is present, Tag 543
+func (m Instrument) HasInstrRegistry() bool {
+	return m.Has(tag.InstrRegistry)
+}
+
+//HasCountryOfIssue returns true if CountryOfIssue is present, Tag 470
+func (m Instrument) HasCountryOfIssue() bool {
+	return m.Has(tag.CountryOfIssue)
+}
+
+//HasStateOrProvinceOfIssue returns true if StateOrProvinceOfIssue is present, Tag 471
+func (m Instrument) HasStateOrProvinceOfIssue() bool {
+	return m.Has(tag.StateOrProvinceOfIssue)
+}
+
+//HasLocaleOfIssue returns true if LocaleOfIssue is present, Tag 472
+func (m Instrument) HasLocaleOfIssue() bool {
+	return m.Has(tag.LocaleOfIssue)
+}
+
+//HasRedemptionDate returns true if RedemptionDate is present, Tag 240
+func (m Instrument) HasRedemptionDate() bool {
+	return m.Has(tag.RedemptionDate)
+}
+
+//HasStrikePrice returns true if StrikePrice is present, Tag 202
+func (m Instrument) HasStrikePrice() bool {
+	return m.Has(tag.StrikePrice)
+}
+
+//HasStrikeCurrency returns true if StrikeCurrency is present, Tag 947
+func (m Instrument) HasStrikeCurrency() bool {
+	return m.Has(tag.StrikeCurrency)
+}
+
+//HasOptAttribute returns true if OptAttribute is present, Tag 206
+func (m Instrument) HasOptAttribute() bool {
+	return m.Has(tag.OptAttribute)
+}
+
+//HasContractMultiplier returns true if ContractMultiplier is present, Tag 231
+func (m Instrument) HasContractMultiplier() bool {
+	return m.Has(tag.ContractMultiplier)
+}
+
+//HasCouponRate returns true if CouponRate is present, Tag 223
+func (m Instrument) HasCouponRate() bool {
+	return m.Has(tag.CouponRate)
+}
+
+//HasSecurityExchange returns true if SecurityExchange is present, Tag 207
+func (m Instrument) HasSecurityExchange() bool {
+	return m.Has(tag.SecurityExchange)
+}
+
+//HasIssuer returns true if Issuer is present, Tag 106
+func (m Instrument) HasIssuer() bool {
+	return m.Has(tag.Issuer)
+}
+
+//HasEncodedIssuerLen returns true if EncodedIssuerLen is present, Tag 348
+func (m Instrument) HasEncodedIssuerLen() bool {
+	return m.Has(tag.EncodedIssuerLen)
+}
+
+//HasEncodedIssuer returns true if EncodedIssuer is present, Tag 349
+func (m Instrument) HasEncodedIssuer() bool {
+	return m.Has(tag.EncodedIssuer)
+}
+
+//SetEncodedIssuerBytes sets EncodedIssuer, Tag 349, and EncodedIssuerLen, Tag 348, from v, which may hold any bytes including SOH
+func (m Instrument) SetEncodedIssuerBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedIssuerLen, tag.EncodedIssuer, v)
+}
+
+//GetEncodedIssuerBytes gets EncodedIssuer, Tag 349, checking its length against EncodedIssuerLen, Tag 348
+func (m Instrument) GetEncodedIssuerBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedIssuerLen, tag.EncodedIssuer)
+}
+
+//GetEncodedIssuerDecoded gets EncodedIssuer, Tag 349, decoded to UTF-8 using the MessageEncoding of h, the Header of the message holding it
+func (m Instrument) GetEncodedIssuerDecoded(h fix44.Header) (string, error) {
+	return fix44.DecodeRawData(h, m, tag.EncodedIssuerLen, tag.EncodedIssuer)
+}
+
+//HasSecurityDesc returns true if SecurityDesc is present, Tag 107
+func (m Instrument) HasSecurityDesc() bool {
+	return m.Has(tag.SecurityDesc)
+}
+
+//HasEncodedSecurityDescLen returns true if EncodedSecurityDescLen is present, Tag 350
+func (m Instrument) HasEncodedSecurityDescLen() bool {
+	return m.Has(tag.EncodedSecurityDescLen)
+}
+
+//HasEncodedSecurityDesc returns true if EncodedSecurityDesc is present, Tag 351
+func (m Instrument) HasEncodedSecurityDesc() bool {
+	return m.Has(tag.EncodedSecurityDesc)
+}
+
+//SetEncodedSecurityDescBytes sets EncodedSecurityDesc, Tag 351, and EncodedSecurityDescLen, Tag 350, from v, which may hold any bytes including SOH
+func (m Instrument) SetEncodedSecurityDescBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedSecurityDescLen, tag.EncodedSecurityDesc, v)
+}
+
+//GetEncodedSecurityDescBytes gets EncodedSecurityDesc, Tag 351, checking its length against EncodedSecurityDescLen, Tag 350
+func (m Instrument) GetEncodedSecurityDescBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedSecurityDescLen, tag.EncodedSecurityDesc)
+}
+
+//GetEncodedSecurityDescDecoded gets EncodedSecurityDesc, Tag 351, decoded to UTF-8 using the MessageEncoding of h, the Header of the message holding it
+func (m Instrument) GetEncodedSecurityDescDecoded(h fix44.Header) (string, error) {
+	return fix44.DecodeRawData(h, m, tag.EncodedSecurityDescLen, tag.EncodedSecurityDesc)
+}
+
+//HasPool returns true if Pool is present, Tag 691
+func (m Instrument) HasPool() bool {
+	return m.Has(tag.Pool)
+}
+
+//HasContractSettlMonth returns true if ContractSettlMonth is present, Tag 667
+func (m Instrument) HasContractSettlMonth() bool {
+	return m.Has(tag.ContractSettlMonth)
+}
+
+//HasCPProgram returns true if CPProgram is present, Tag 875
+func (m Instrument) HasCPProgram() bool {
+	return m.Has(tag.CPProgram)
+}
+
+//HasCPRegType returns true if CPRegType is present, Tag 876
+func (m Instrument) HasCPRegType() bool {
+	return m.Has(tag.CPRegType)
+}
+
+//HasNoEvents returns true if NoEvents is present, Tag 864
+func (m Instrument) HasNoEvents() bool {
+	return m.Has(tag.NoEvents)
+}
+
+//HasDatedDate returns true if DatedDate is present, Tag 873
+func (m Instrument) HasDatedDate() bool {
+	return m.Has(tag.DatedDate)
+}
+
+//HasInterestAccrualDate returns true if InterestAccrualDate is present, Tag 874
+func (m Instrument) HasInterestAccrualDate() bool {
+	return m.Has(tag.InterestAccrualDate)
+}
+
+//NoSecurityAltID is a repeating group element, Tag 454
+type NoSecurityAltID struct {
+	*quickfix.Group
+}
+
+//SetSecurityAltID sets SecurityAltID, Tag 455
+func (m NoSecurityAltID) SetSecurityAltID(v string) {
+	m.Set(field.NewSecurityAltID(v))
+}
+
+//SetSecurityAltIDSource sets SecurityAltIDSource, Tag 456
+func (m NoSecurityAltID) SetSecurityAltIDSource(v string) {
+	m.Set(field.NewSecurityAltIDSource(v))
+}
+
+//GetSecurityAltID gets SecurityAltID, Tag 455
+func (m NoSecurityAltID) GetSecurityAltID() (v string, err quickfix.MessageRejectError) {
+	var f field.SecurityAltIDField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetSecurityAltIDSource gets SecurityAltIDSource, Tag 456
+func (m NoSecurityAltID) GetSecurityAltIDSource() (v string, err quickfix.MessageRejectError) {
+	var f field.SecurityAltIDSourceField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//HasSecurityAltID returns true if SecurityAltID is present, Tag 455
+func (m NoSecurityAltID) HasSecurityAltID() bool {
+	return m.Has(tag.SecurityAltID)
+}
+
+//HasSecurityAltIDSource returns true if SecurityAltIDSource is present, Tag 456
+func (m NoSecurityAltID) HasSecurityAltIDSource() bool {
+	return m.Has(tag.SecurityAltIDSource)
+}
+
+//NoSecurityAltIDRepeatingGroup is a repeating group, Tag 454
+type NoSecurityAltIDRepeatingGroup struct {
+	*quickfix.RepeatingGroup
+}
+
+//NewNoSecurityAltIDRepeatingGroup returns an initialized, NoSecurityAltIDRepeatingGroup
+func NewNoSecurityAltIDRepeatingGroup() NoSecurityAltIDRepeatingGroup {
+	return NoSecurityAltIDRepeatingGroup{
+		quickfix.NewRepeatingGroup(tag.NoSecurityAltID,
+			quickfix.GroupTemplate{quickfix.GroupElement(tag.SecurityAltID), quickfix.GroupElement(tag.SecurityAltIDSource)})}
+}
+
+//Add create and append a new NoSecurityAltID to this group
+func (m NoSecurityAltIDRepeatingGroup) Add() NoSecurityAltID {
+	g := m.RepeatingGroup.Add()
+	return NoSecurityAltID{g}
+}
+
+//Get returns the ith NoSecurityAltID in the NoSecurityAltIDRepeatinGroup
+func (m NoSecurityAltIDRepeatingGroup) Get(i int) NoSecurityAltID {
+	return NoSecurityAltID{m.RepeatingGroup.Get(i)}
+}
+
+//NoEvents is a repeating group element, Tag 864
+type NoEvents struct {
+	*quickfix.Group
+}
+
+//SetEventType sets EventType, Tag 865
+func (m NoEvents) SetEventType(v enum.EventType) {
+	m.Set(field.NewEventType(v))
+}
+
+//SetEventDate sets EventDate, Tag 866
+func (m NoEvents) SetEventDate(v string) {
+	m.Set(field.NewEventDate(v))
+}
+
+//SetEventPx sets EventPx, Tag 867
+func (m NoEvents) SetEventPx(value decimal.Decimal, scale int32) {
+	m.Set(field.NewEventPx(value, scale))
+}
+
+//SetEventText sets EventText, Tag 868
+func (m NoEvents) SetEventText(v string) {
+	m.Set(field.NewEventText(v))
+}
+
+//GetEventType gets EventType, Tag 865
+func (m NoEvents) GetEventType() (v enum.EventType, err quickfix.MessageRejectError) {
+	var f field.EventTypeField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetEventDate gets EventDate, Tag 866
+func (m NoEvents) GetEventDate() (v string, err quickfix.MessageRejectError) {
+	var f field.EventDateField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetEventPx gets EventPx, Tag 867
+func (m NoEvents) GetEventPx() (v decimal.Decimal, err quickfix.MessageRejectError) {
+	var f field.EventPxField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetEventText gets EventText, Tag 868
+func (m NoEvents) GetEventText() (v string, err quickfix.MessageRejectError) {
+	var f field.EventTextField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//HasEventType returns true if EventType is present, Tag 865
+func (m NoEvents) HasEventType() bool {
+	return m.Has(tag.EventType)
+}
+
+//HasEventDate returns true if EventDate is present, Tag 866
+func (m NoEvents) HasEventDate() bool {
+	return m.Has(tag.EventDate)
+}
+
+//HasEventPx returns true if EventPx is present, Tag 867
+func (m NoEvents) HasEventPx() bool {
+	return m.Has(tag.EventPx)
+}
+
+//HasEventText returns true if EventText is present, Tag 868
+func (m NoEvents) HasEventText() bool {
+	return m.Has(tag.EventText)
+}
+
+//NoEventsRepeatingGroup is a repeating group, Tag 864
+type NoEventsRepeatingGroup struct {
+	*quickfix.RepeatingGroup
+}
+
+//NewNoEventsRepeatingGroup returns an initialized, NoEventsRepeatingGroup
+func NewNoEventsRepeatingGroup() NoEventsRepeatingGroup {
+	return NoEventsRepeatingGroup{
+		quickfix.NewRepeatingGroup(tag.NoEvents,
+			quickfix.GroupTemplate{quickfix.GroupElement(tag.EventType), quickfix.GroupElement(tag.EventDate), quickfix.GroupElement(tag.EventPx), quickfix.GroupElement(tag.EventText)})}
+}
+
+//Add create and append a new NoEvents to this group
+func (m NoEventsRepeatingGroup) Add() NoEvents {
+	g := m.RepeatingGroup.Add()
+	return NoEvents{g}
+}
+
+//Get returns the ith NoEvents in the NoEventsRepeatinGroup
+func (m NoEventsRepeatingGroup) Get(i int) NoEvents {
+	return NoEvents{m.RepeatingGroup.Get(i)}
+}
+
+var instrumentTags = []quickfix.Tag{
+	tag.Symbol,
+	tag.SymbolSfx,
+	tag.SecurityID,
+	tag.SecurityIDSource,
+	tag.Product,
+	tag.CFICode,
+	tag.SecurityType,
+	tag.SecuritySubType,
+	tag.MaturityMonthYear,
+	tag.MaturityDate,
+	tag.CouponPaymentDate,
+	tag.IssueDate,
+	tag.RepoCollateralSecurityType,
+	tag.RepurchaseTerm,
+	tag.RepurchaseRate,
+	tag.Factor,
+	tag.CreditRating,
+	tag.InstrRegistry,
+	tag.CountryOfIssue,
+	tag.StateOrProvinceOfIssue,
+	tag.LocaleOfIssue,
+	tag.RedemptionDate,
+	tag.StrikePrice,
+	tag.StrikeCurrency,
+	tag.OptAttribute,
+	tag.ContractMultiplier,
+	tag.CouponRate,
+	tag.SecurityExchange,
+	tag.Issuer,
+	tag.EncodedIssuerLen,
+	tag.EncodedIssuer,
+	tag.SecurityDesc,
+	tag.EncodedSecurityDescLen,
+	tag.EncodedSecurityDesc,
+	tag.Pool,
+	tag.ContractSettlMonth,
+	tag.CPProgram,
+	tag.CPRegType,
+	tag.DatedDate,
+	tag.InterestAccrualDate,
+}
+
+//NoSecurityAltIDStruct is a plain Go representation of a NoSecurityAltID group element, optional fields are nil when absent
+type NoSecurityAltIDStruct struct {
+	SecurityAltID       *string
+	SecurityAltIDSource *string
+}
+
+//MarshalNoSecurityAltID copies the elements of g into a slice of NoSecurityAltIDStruct
+func MarshalNoSecurityAltID(g NoSecurityAltIDRepeatingGroup) ([]NoSecurityAltIDStruct, quickfix.MessageRejectError) {
+	s := make([]NoSecurityAltIDStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasSecurityAltID() {
+			v, err := m.GetSecurityAltID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SecurityAltID = &v
+		}
+		if m.HasSecurityAltIDSource() {
+			v, err := m.GetSecurityAltIDSource()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SecurityAltIDSource = &v
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoSecurityAltID builds a NoSecurityAltIDRepeatingGroup from s
+func UnmarshalNoSecurityAltID(s []NoSecurityAltIDStruct) NoSecurityAltIDRepeatingGroup {
+	g := NewNoSecurityAltIDRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.SecurityAltID != nil {
+			m.SetSecurityAltID(*e.SecurityAltID)
+		}
+		if e.SecurityAltIDSource != nil {
+			m.SetSecurityAltIDSource(*e.SecurityAltIDSource)
+		}
+	}
+	return g
+}
+
+//NoEventsStruct is a plain Go representation of a NoEvents group element, optional fields are nil when absent
+type NoEventsStruct struct {
+	EventType *enum.EventType
+	EventDate *string
+	EventPx   *decimal.Decimal
+	EventText *string
+}
+
+//MarshalNoEvents copies the elements of g into a slice of NoEventsStruct
+func MarshalNoEvents(g NoEventsRepeatingGroup) ([]NoEventsStruct, quickfix.MessageRejectError) {
+	s := make([]NoEventsStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasEventType() {
+			v, err := m.GetEventType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EventType = &v
+		}
+		if m.HasEventDate() {
+			v, err := m.GetEventDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EventDate = &v
+		}
+		if m.HasEventPx() {
+			v, err := m.GetEventPx()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EventPx = &v
+		}
+		if m.HasEventText() {
+			v, err := m.GetEventText()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EventText = &v
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoEvents builds a NoEventsRepeatingGroup from s
+func UnmarshalNoEvents(s []NoEventsStruct) NoEventsRepeatingGroup {
+	g := NewNoEventsRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.EventType != nil {
+			m.SetEventType(*e.EventType)
+		}
+		if e.EventDate != nil {
+			m.SetEventDate(*e.EventDate)
+		}
+		if e.EventPx != nil {
+			m.SetEventPx(*e.EventPx, fix44.DecimalScale(*e.EventPx))
+		}
+		if e.EventText != nil {
+			m.SetEventText(*e.EventText)
+		}
+	}
+	return g
+}
@@ -0,0 +1,1005 @@
+package components
+
+import (
+	"github.com/shopspring/decimal"
+
+	"github.com/terracefi/field"
+	"github.com/terracefi/fix44"
+	"github.com/terracefi/quickfix"
+	"github.com/terracefi/tag"
+)
+
+//InstrumentLeg is the fix44 InstrumentLeg component
+type InstrumentLeg struct {
+	*quickfix.FieldMap
+}
+
+//NewInstrumentLeg returns a new, empty InstrumentLeg component
+func NewInstrumentLeg() InstrumentLeg {
+	return InstrumentLeg{&quickfix.NewMessage().Body.FieldMap}
+}
+
+//CopyInto copies the InstrumentLeg fields present in m into fm
+func (m InstrumentLeg) CopyInto(fm *quickfix.FieldMap) quickfix.MessageRejectError {
+	return copyFields(m.FieldMap, fm, instrumentLegTags, NewNoLegSecurityAltIDRepeatingGroup())
+}
+
+//SetLegSymbol sets LegSymbol, Tag 600
+func (m InstrumentLeg) SetLegSymbol(v string) {
+	m.Set(field.NewLegSymbol(v))
+}
+
+//SetLegSymbolSfx sets LegSymbolSfx, Tag 601
+func (m InstrumentLeg) SetLegSymbolSfx(v string) {
+	m.Set(field.NewLegSymbolSfx(v))
+}
+
+//SetLegSecurityID sets LegSecurityID, Tag 602
+func (m InstrumentLeg) SetLegSecurityID(v string) {
+	m.Set(field.NewLegSecurityID(v))
+}
+
+//SetLegSecurityIDSource sets LegSecurityIDSource, Tag 603
+func (m InstrumentLeg) SetLegSecurityIDSource(v string) {
+	m.Set(field.NewLegSecurityIDSource(v))
+}
+
+//SetNoLegSecurityAltID sets NoLegSecurityAltID, Tag 604
+func (m InstrumentLeg) SetNoLegSecurityAltID(f NoLegSecurityAltIDRepeatingGroup) {
+	m.SetGroup(f)
+}
+
+//SetLegProduct sets LegProduct, Tag 607
+func (m InstrumentLeg) SetLegProduct(v int) {
+	m.Set(field.NewLegProduct(v))
+}
+
+//SetLegCFICode sets LegCFICode, Tag 608
+func (m InstrumentLeg) SetLegCFICode(v string) {
+	m.Set(field.NewLegCFICode(v))
+}
+
+//SetLegSecurityType sets LegSecurityType, Tag 609
+func (m InstrumentLeg) SetLegSecurityType(v string) {
+	m.Set(field.NewLegSecurityType(v))
+}
+
+//SetLegSecuritySubType sets LegSecuritySubType, Tag 764
+func (m InstrumentLeg) SetLegSecuritySubType(v string) {
+	m.Set(field.NewLegSecuritySubType(v))
+}
+
+//SetLegMaturityMonthYear sets LegMaturityMonthYear, Tag 610
+func (m InstrumentLeg) SetLegMaturityMonthYear(v string) {
+	m.Set(field.NewLegMaturityMonthYear(v))
+}
+
+//SetLegMaturityDate sets LegMaturityDate, Tag 611
+func (m InstrumentLeg) SetLegMaturityDate(v string) {
+	m.Set(field.NewLegMaturityDate(v))
+}
+
+//SetLegCouponPaymentDate sets LegCouponPaymentDate, Tag 248
+func (m InstrumentLeg) SetLegCouponPaymentDate(v string) {
+	m.Set(field.NewLegCouponPaymentDate(v))
+}
+
+//SetLegIssueDate sets LegIssueDate, Tag 249
+func (m InstrumentLeg) SetLegIssueDate(v string) {
+	m.Set(field.NewLegIssueDate(v))
+}
+
+//SetLegRepoCollateralSecurityType sets LegRepoCollateralSecurityType, Tag 250
+func (m InstrumentLeg) SetLegRepoCollateralSecurityType(v int) {
+	m.Set(field.NewLegRepoCollateralSecurityType(v))
+}
+
+//SetLegRepurchaseTerm sets LegRepurchaseTerm, Tag 251
+func (m InstrumentLeg) SetLegRepurchaseTerm(v int) {
+	m.Set(field.NewLegRepurchaseTerm(v))
+}
+
+//SetLegRepurchaseRate sets LegRepurchaseRate, Tag 252
+func (m InstrumentLeg) SetLegRepurchaseRate(value decimal.Decimal, scale int32) {
+	m.Set(field.NewLegRepurchaseRate(value, scale))
+}
+
+//SetLegFactor sets LegFactor, Tag 253
+func (m InstrumentLeg) SetLegFactor(value decimal.Decimal, scale int32) {
+	m.Set(field.NewLegFactor(value, scale))
+}
+
+//SetLegCreditRating sets LegCreditRating, Tag 257
+func (m InstrumentLeg) SetLegCreditRating(v string) {
+	m.Set(field.NewLegCreditRating(v))
+}
+
+//SetLegInstrRegistry sets LegInstrRegistry, Tag 599
+func (m InstrumentLeg) SetLegInstrRegistry(v string) {
+	m.Set(field.NewLegInstrRegistry(v))
+}
+
+//SetLegCountryOfIssue sets LegCountryOfIssue, Tag 596
+func (m InstrumentLeg) SetLegCountryOfIssue(v string) {
+	m.Set(field.NewLegCountryOfIssue(v))
+}
+
+//SetLegStateOrProvinceOfIssue sets LegStateOrProvinceOfIssue, Tag 597
+func (m InstrumentLeg) SetLegStateOrProvinceOfIssue(v string) {
+	m.Set(field.NewLegStateOrProvinceOfIssue(v))
+}
+
+//SetLegLocaleOfIssue sets LegLocaleOfIssue, Tag 598
+func (m InstrumentLeg) SetLegLocaleOfIssue(v string) {
+	m.Set(field.NewLegLocaleOfIssue(v))
+}
+
+//SetLegRedemptionDate sets LegRedemptionDate, Tag 254
+func (m InstrumentLeg) SetLegRedemptionDate(v string) {
+	m.Set(field.NewLegRedemptionDate(v))
+}
+
+//SetLegStrikePrice sets LegStrikePrice, Tag 612
+func (m InstrumentLeg) SetLegStrikePrice(value decimal.Decimal, scale int32) {
+	m.Set(field.NewLegStrikePrice(value, scale))
+}
+
+//SetLegStrikeCurrency sets LegStrikeCurrency, Tag 942
+func (m InstrumentLeg) SetLegStrikeCurrency(v string) {
+	m.Set(field.NewLegStrikeCurrency(v))
+}
+
+//SetLegOptAttribute sets LegOptAttribute, Tag 613
+func (m InstrumentLeg) SetLegOptAttribute(v string) {
+	m.Set(field.NewLegOptAttribute(v))
+}
+
+//SetLegContractMultiplier sets LegContractMultiplier, Tag 614
+func (m InstrumentLeg) SetLegContractMultiplier(value decimal.Decimal, scale int32) {
+	m.Set(field.NewLegContractMultiplier(value, scale))
+}
+
+//SetLegCouponRate sets LegCouponRate, Tag 615
+func (m InstrumentLeg) SetLegCouponRate(value decimal.Decimal, scale int32) {
+	m.Set(field.NewLegCouponRate(value, scale))
+}
+
+//SetLegSecurityExchange sets LegSecurityExchange, Tag 616
+func (m InstrumentLeg) SetLegSecurityExchange(v string) {
+	m.Set(field.NewLegSecurityExchange(v))
+}
+
+//SetLegIssuer sets LegIssuer, Tag 617
+func (m InstrumentLeg) SetLegIssuer(v string) {
+	m.Set(field.NewLegIssuer(v))
+}
+
+//SetEncodedLegIssuerLen sets EncodedLegIssuerLen, Tag 618
+func (m InstrumentLeg) SetEncodedLegIssuerLen(v int) {
+	m.Set(field.NewEncodedLegIssuerLen(v))
+}
+
+//SetEncodedLegIssuer sets EncodedLegIssuer, Tag 619
+func (m InstrumentLeg) SetEncodedLegIssuer(v string) {
+	m.Set(field.NewEncodedLegIssuer(v))
+}
+
+//SetLegSecurityDesc sets LegSecurityDesc, Tag 620
+func (m InstrumentLeg) SetLegSecurityDesc(v string) {
+	m.Set(field.NewLegSecurityDesc(v))
+}
+
+//SetEncodedLegSecurityDescLen sets EncodedLegSecurityDescLen, Tag 621
+func (m InstrumentLeg) SetEncodedLegSecurityDescLen(v int) {
+	m.Set(field.NewEncodedLegSecurityDescLen(v))
+}
+
+//SetEncodedLegSecurityDesc sets EncodedLegSecurityDesc, Tag 622
+func (m InstrumentLeg) SetEncodedLegSecurityDesc(v string) {
+	m.Set(field.NewEncodedLegSecurityDesc(v))
+}
+
+//SetLegRatioQty sets LegRatioQty, Tag 623
+func (m InstrumentLeg) SetLegRatioQty(value decimal.Decimal, scale int32) {
+	m.Set(field.NewLegRatioQty(value, scale))
+}
+
+//SetLegSide sets LegSide, Tag 624
+func (m InstrumentLeg) SetLegSide(v string) {
+	m.Set(field.NewLegSide(v))
+}
+
+//SetLegCurrency sets LegCurrency, Tag 556
+func (m InstrumentLeg) SetLegCurrency(v string) {
+	m.Set(field.NewLegCurrency(v))
+}
+
+//SetLegPool sets LegPool, Tag 740
+func (m InstrumentLeg) SetLegPool(v string) {
+	m.Set(field.NewLegPool(v))
+}
+
+//SetLegDatedDate sets LegDatedDate, Tag 739
+func (m InstrumentLeg) SetLegDatedDate(v string) {
+	m.Set(field.NewLegDatedDate(v))
+}
+
+//SetLegContractSettlMonth sets LegContractSettlMonth, Tag 955
+func (m InstrumentLeg) SetLegContractSettlMonth(v string) {
+	m.Set(field.NewLegContractSettlMonth(v))
+}
+
+//SetLegInterestAccrualDate sets LegInterestAccrualDate, Tag 956
+func (m InstrumentLeg) SetLegInterestAccrualDate(v string) {
+	m.Set(field.NewLegInterestAccrualDate(v))
+}
+
+//GetLegSymbol gets LegSymbol, Tag 600
+func (m InstrumentLeg) GetLegSymbol() (v string, err quickfix.MessageRejectError) {
+	var f field.LegSymbolField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetLegSymbolSfx gets LegSymbolSfx, Tag 601
+func (m InstrumentLeg) GetLegSymbolSfx() (v string, err quickfix.MessageRejectError) {
+	var f field.LegSymbolSfxField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetLegSecurityID gets LegSecurityID, Tag 602
+func (m InstrumentLeg) GetLegSecurityID() (v string, err quickfix.MessageRejectError) {
+	var f field.LegSecurityIDField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetLegSecurityIDSource gets LegSecurityIDSource, Tag 603
+func (m InstrumentLeg) GetLegSecurityIDSource() (v string, err quickfix.MessageRejectError) {
+	var f field.LegSecurityIDSourceField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetNoLegSecurityAltID gets NoLegSecurityAltID, Tag 604
+func (m InstrumentLeg) GetNoLegSecurityAltID() (NoLegSecurityAltIDRepeatingGroup, quickfix.MessageRejectError) {
+	f := NewNoLegSecurityAltIDRepeatingGroup()
+	err := m.GetGroup(f)
+	return f, err
+}
+
+//GetLegProduct gets LegProduct, Tag 607
+func (m InstrumentLeg) GetLegProduct() (v int, err quickfix.MessageRejectError) {
+	var f field.LegProductField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetLegCFICode gets LegCFICode, Tag 608
+func (m InstrumentLeg) GetLegCFICode() (v string, err quickfix.MessageRejectError) {
+	var f field.LegCFICodeField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetLegSecurityType gets LegSecurityType, Tag 609
+func (m InstrumentLeg) GetLegSecurityType() (v string, err quickfix.MessageRejectError) {
+	var f field.LegSecurityTypeField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetLegSecuritySubType gets LegSecuritySubType, Tag 764
+func (m InstrumentLeg) GetLegSecuritySubType() (v string, err quickfix.MessageRejectError) {
+	var f field.LegSecuritySubTypeField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetLegMaturityMonthYear gets LegMaturityMonthYear, Tag 610
+func (m InstrumentLeg) GetLegMaturityMonthYear() (v string, err quickfix.MessageRejectError) {
+	var f field.LegMaturityMonthYearField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetLegMaturityDate gets LegMaturityDate, Tag 611
+func (m InstrumentLeg) GetLegMaturityDate() (v string, err quickfix.MessageRejectError) {
+	var f field.LegMaturityDateField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetLegCouponPaymentDate gets LegCouponPaymentDate, Tag 248
+func (m InstrumentLeg) GetLegCouponPaymentDate() (v string, err quickfix.MessageRejectError) {
+	var f field.LegCouponPaymentDateField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetLegIssueDate gets LegIssueDate, Tag 249
+func (m InstrumentLeg) GetLegIssueDate() (v string, err quickfix.MessageRejectError) {
+	var f field.LegIssueDateField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetLegRepoCollateralSecurityType gets LegRepoCollateralSecurityType, Tag 250
+func (m InstrumentLeg) GetLegRepoCollateralSecurityType() (v int, err quickfix.MessageRejectError) {
+	var f field.LegRepoCollateralSecurityTypeField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetLegRepurchaseTerm gets LegRepurchaseTerm, Tag 251
+func (m InstrumentLeg) GetLegRepurchaseTerm() (v int, err quickfix.MessageRejectError) {
+	var f field.LegRepurchaseTermField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetLegRepurchaseRate gets LegRepurchaseRate, Tag 252
+func (m InstrumentLeg) GetLegRepurchaseRate() (v decimal.Decimal, err quickfix.MessageRejectError) {
+	var f field.LegRepurchaseRateField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetLegFactor gets LegFactor, Tag 253
+func (m InstrumentLeg) GetLegFactor() (v decimal.Decimal, err quickfix.MessageRejectError) {
+	var f field.LegFactorField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetLegCreditRating gets LegCreditRating, Tag 257
+func (m InstrumentLeg) GetLegCreditRating() (v string, err quickfix.MessageRejectError) {
+	var f field.LegCreditRatingField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetLegInstrRegistry gets LegInstrRegistry, Tag 599
+func (m InstrumentLeg) GetLegInstrRegistry() (v string, err quickfix.MessageRejectError) {
+	var f field.LegInstrRegistryField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetLegCountryOfIssue gets LegCountryOfIssue, Tag 596
+func (m InstrumentLeg) GetLegCountryOfIssue() (v string, err quickfix.MessageRejectError) {
+	var f field.LegCountryOfIssueField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetLegStateOrProvinceOfIssue gets LegStateOrProvinceOfIssue, Tag 597
+func (m InstrumentLeg) GetLegStateOrProvinceOfIssue() (v string, err quickfix.MessageRejectError) {
+	var f field.LegStateOrProvinceOfIssueField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetLegLocaleOfIssue gets LegLocaleOfIssue, Tag 598
+func (m InstrumentLeg) GetLegLocaleOfIssue() (v string, err quickfix.MessageRejectError) {
+	var f field.LegLocaleOfIssueField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetLegRedemptionDate gets LegRedemptionDate, Tag 254
+func (m InstrumentLeg) GetLegRedemptionDate() (v string, err quickfix.MessageRejectError) {
+	var f field.LegRedemptionDateField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetLegStrikePrice gets LegStrikePrice, Tag 612
+func (m InstrumentLeg) GetLegStrikePrice() (v decimal.Decimal, err quickfix.MessageRejectError) {
+	var f field.LegStrikePriceField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetLegStrikeCurrency gets LegStrikeCurrency, Tag 942
+func (m InstrumentLeg) GetLegStrikeCurrency() (v string, err quickfix.MessageRejectError) {
+	var f field.LegStrikeCurrencyField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetLegOptAttribute gets LegOptAttribute, Tag 613
+func (m InstrumentLeg) GetLegOptAttribute() (v string, err quickfix.MessageRejectError) {
+	var f field.LegOptAttributeField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetLegContractMultiplier gets LegContractMultiplier, Tag 614
+func (m InstrumentLeg) GetLegContractMultiplier() (v decimal.Decimal, err quickfix.MessageRejectError) {
+	var f field.LegContractMultiplierField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetLegCouponRate gets LegCouponRate, Tag 615
+func (m InstrumentLeg) GetLegCouponRate() (v decimal.Decimal, err quickfix.MessageRejectError) {
+	var f field.LegCouponRateField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetLegSecurityExchange gets LegSecurityExchange, Tag 616
+func (m InstrumentLeg) GetLegSecurityExchange() (v string, err quickfix.MessageRejectError) {
+	var f field.LegSecurityExchangeField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetLegIssuer gets LegIssuer, Tag 617
+func (m InstrumentLeg) GetLegIssuer() (v string, err quickfix.MessageRejectError) {
+	var f field.LegIssuerField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetEncodedLegIssuerLen gets EncodedLegIssuerLen, Tag 618
+func (m InstrumentLeg) GetEncodedLegIssuerLen() (v int, err quickfix.MessageRejectError) {
+	var f field.EncodedLegIssuerLenField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetEncodedLegIssuer gets EncodedLegIssuer, Tag 619
+func (m InstrumentLeg) GetEncodedLegIssuer() (v string, err quickfix.MessageRejectError) {
+	var f field.EncodedLegIssuerField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetLegSecurityDesc gets LegSecurityDesc, Tag 620
+func (m InstrumentLeg) GetLegSecurityDesc() (v string, err quickfix.MessageRejectError) {
+	var f field.LegSecurityDescField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetEncodedLegSecurityDescLen gets EncodedLegSecurityDescLen, Tag 621
+func (m InstrumentLeg) GetEncodedLegSecurityDescLen() (v int, err quickfix.MessageRejectError) {
+	var f field.EncodedLegSecurityDescLenField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetEncodedLegSecurityDesc gets EncodedLegSecurityDesc, Tag 622
+func (m InstrumentLeg) GetEncodedLegSecurityDesc() (v string, err quickfix.MessageRejectError) {
+	var f field.EncodedLegSecurityDescField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetLegRatioQty gets LegRatioQty, Tag 623
+func (m InstrumentLeg) GetLegRatioQty() (v decimal.Decimal, err quickfix.MessageRejectError) {
+	var f field.LegRatioQtyField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetLegSide gets LegSide, Tag 624
+func (m InstrumentLeg) GetLegSide() (v string, err quickfix.MessageRejectError) {
+	var f field.LegSideField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetLegCurrency gets LegCurrency, Tag 556
+func (m InstrumentLeg) GetLegCurrency() (v string, err quickfix.MessageRejectError) {
+	var f field.LegCurrencyField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetLegPool gets LegPool, Tag 740
+func (m InstrumentLeg) GetLegPool() (v string, err quickfix.MessageRejectError) {
+	var f field.LegPoolField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetLegDatedDate gets LegDatedDate, Tag 739
+func (m InstrumentLeg) GetLegDatedDate() (v string, err quickfix.MessageRejectError) {
+	var f field.LegDatedDateField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetLegContractSettlMonth gets LegContractSettlMonth, Tag 955
+func (m InstrumentLeg) GetLegContractSettlMonth() (v string, err quickfix.MessageRejectError) {
+	var f field.LegContractSettlMonthField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetLegInterestAccrualDate gets LegInterestAccrualDate, Tag 956
+func (m InstrumentLeg) GetLegInterestAccrualDate() (v string, err quickfix.MessageRejectError) {
+	var f field.LegInterestAccrualDateField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//HasLegSymbol returns true if LegSymbol is present, Tag 600
+func (m InstrumentLeg) HasLegSymbol() bool {
+	return m.Has(tag.LegSymbol)
+}
+
+//HasLegSymbolSfx returns true if LegSymbolSfx is present, Tag 601
+func (m InstrumentLeg) HasLegSymbolSfx() bool {
+	return m.Has(tag.LegSymbolSfx)
+}
+
+//HasLegSecurityID returns true if LegSecurityID is present, Tag 602
+func (m InstrumentLeg) HasLegSecurityID() bool {
+	return m.Has(tag.LegSecurityID)
+}
+
+//HasLegSecurityIDSource returns true if LegSecurityIDSource is present, Tag 603
+func (m InstrumentLeg) HasLegSecurityIDSource() bool {
+	return m.Has(tag.LegSecurityIDSource)
+}
+
+//HasNoLegSecurityAltID returns true if NoLegSecurityAltID is present, Tag 604
+func (m InstrumentLeg) HasNoLegSecurityAltID() bool {
+	return m.Has(tag.NoLegSecurityAltID)
+}
+
+//HasLegProduct returns true if LegProduct is present, Tag 607
+func (m InstrumentLeg) HasLegProduct() bool {
+	return m.Has(tag.LegProduct)
+}
+
+//HasLegCFICode returns true if LegCFICode is present, Tag 608
+func (m InstrumentLeg) HasLegCFICode() bool {
+	return m.Has(tag.LegCFICode)
+}
+
+//HasLegSecurityType returns true if LegSecurityType is present, Tag 609
+func (m InstrumentLeg) HasLegSecurityType() bool {
+	return m.Has(tag.LegSecurityType)
+}
+
+//HasLegSecuritySubType returns true if LegSecuritySubType is present, Tag 764
+func (m InstrumentLeg) HasLegSecuritySubType() bool {
+	return m.Has(tag.LegSecuritySubType)
+}
+
+//HasLegMaturityMonthYear returns true if LegMaturityMonthYear is present, Tag 610
+func (m InstrumentLeg) HasLegMaturityMonthYear() bool {
+	return m.Has(tag.LegMaturityMonthYear)
+}
+
+//HasLegMaturityDate returns true if LegMaturityDate is present, Tag 611
+func (m InstrumentLeg) HasLegMaturityDate() bool {
+	return m.Has(tag.LegMaturityDate)
+}
+
+//HasLegCouponPaymentDate returns true if LegCouponPaymentDate is present, Tag 248
+func (m InstrumentLeg) HasLegCouponPaymentDate() bool {
+	return m.Has(tag.LegCouponPaymentDate)
+}
+
+//HasLegIssueDate returns true if LegIssueDate is present, Tag 249
+func (m InstrumentLeg) HasLegIssueDate() bool {
+	return m.Has(tag.LegIssueDate)
+}
+
+//HasLegRepoCollateralSecurityType returns true if LegRepoCollateralSecurityType is present, Tag 250
+func (m InstrumentLeg) HasLegRepoCollateralSecurityType() bool {
+	return m.Has(tag.LegRepoCollateralSecurityType)
+}
+
+//HasLegRepurchaseTerm returns true if LegRepurchaseTerm is present, Tag 251
+func (m InstrumentLeg) HasLegRepurchaseTerm() bool {
+	return m.Has(tag.LegRepurchaseTerm)
+}
+
+//HasLegRepurchaseRate returns true if LegRepurchaseRate is present, Tag 252
+func (m InstrumentLeg) HasLegRepurchaseRate() bool {
+	return m.Has(tag.LegRepurchaseRate)
+}
+
+//HasLegFactor returns true if LegFactor is present, Tag 253
+func (m InstrumentLeg) HasLegFactor() bool {
+	return m.Has(tag.LegFactor)
+}
+
+//HasLegCreditRating returns true if LegCreditRating is present, Tag 257
+func (m InstrumentLeg) HasLegCreditRating() bool {
+	return m.Has(tag.LegCreditRating)
+}
+
+//HasLegInstrRegistry returns true if LegInstrRegistry is present, Tag 599
+func (m InstrumentLeg) HasLegInstrRegistry() bool {
+	return m.Has(tag.LegInstrRegistry)
+}
+
+//HasLegCountryOfIssue returns true if LegCountryOfIssue is present, Tag 596
+func (m InstrumentLeg) HasLegCountryOfIssue() bool {
+	return m.Has(tag.LegCountryOfIssue)
+}
+
+//HasLegStateOrProvinceOfIssue returns true if LegStateOrProvinceOfIssue is present, Tag 597
+func (m InstrumentLeg) HasLegStateOrProvinceOfIssue() bool {
+	return m.Has(tag.LegStateOrProvinceOfIssue)
+}
+
+//HasLegLocaleOfIssue returns true if LegLocaleOfIssue is present, Tag 598
+func (m InstrumentLeg) HasLegLocaleOfIssue() bool {
+	return m.Has(tag.LegLocaleOfIssue)
+}
+
+//HasLegRedemptionDate returns true if LegRedemptionDate is present, Tag 254
+func (m InstrumentLeg) HasLegRedemptionDate() bool {
+	return m.Has(tag.LegRedemptionDate)
+}
+
+//HasLegStrikePrice returns true if LegStrikePrice is present, Tag 612
+func (m InstrumentLeg) HasLegStrikePrice() bool {
+	return m.Has(tag.LegStrikePrice)
+}
+
+//HasLegStrikeCurrency returns true if LegStrikeCurrency is present, Tag 942
+func (m InstrumentLeg) HasLegStrikeCurrency() bool {
+	return m.Has(tag.LegStrikeCurrency)
+}
+
+//HasLegOptAttribute returns true if LegOptAttribute is present, Tag 613
+func (m InstrumentLeg) HasLegOptAttribute() bool {
+	return m.Has(tag.LegOptAttribute)
+}
+
+//HasLegContractMultiplier returns true if LegContractMultiplier is present, Tag 614
+func (m InstrumentLeg) HasLegContractMultiplier() bool {
+	return m.Has(tag.LegContractMultiplier)
+}
+
+//HasLegCouponRate returns true if LegCouponRate is present, Tag 615
+func (m InstrumentLeg) HasLegCouponRate() bool {
+	return m.Has(tag.LegCouponRate)
+}
+
+//HasLegSecurityExchange returns true if LegSecurityExchange is present, Tag 616
+func (m InstrumentLeg) HasLegSecurityExchange() bool {
+	return m.Has(tag.LegSecurityExchange)
+}
+
+//HasLegIssuer returns true if LegIssuer is present, Tag 617
+func (m InstrumentLeg) HasLegIssuer() bool {
+	return m.Has(tag.LegIssuer)
+}
+
+//HasEncodedLegIssuerLen returns true if EncodedLegIssuerLen is present, Tag 618
+func (m InstrumentLeg) HasEncodedLegIssuerLen() bool {
+	return m.Has(tag.EncodedLegIssuerLen)
+}
+
+//HasEncodedLegIssuer returns true if EncodedLegIssuer is present, Tag 619
+func (m InstrumentLeg) HasEncodedLegIssuer() bool {
+	return m.Has(tag.EncodedLegIssuer)
+}
+
+//SetEncodedLegIssuerBytes sets EncodedLegIssuer, Tag 619, and EncodedLegIssuerLen, Tag 618, from v, which may hold any bytes including SOH
+func (m InstrumentLeg) SetEncodedLegIssuerBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedLegIssuerLen, tag.EncodedLegIssuer, v)
+}
+
+//GetEncodedLegIssuerBytes gets EncodedLegIssuer, Tag 619, checking its length against EncodedLegIssuerLen, Tag 618
+func (m InstrumentLeg) GetEncodedLegIssuerBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedLegIssuerLen, tag.EncodedLegIssuer)
+}
+
+//GetEncodedLegIssuerDecoded gets EncodedLegIssuer, Tag 619, decoded to UTF-8 using the MessageEncoding of h, the Header of the message holding it
+func (m InstrumentLeg) GetEncodedLegIssuerDecoded(h fix44.Header) (string, error) {
+	return fix44.DecodeRawData(h, m, tag.EncodedLegIssuerLen, tag.EncodedLegIssuer)
+}
+
+//HasLegSecurityDesc returns true if LegSecurityDesc is present, Tag 620
+func (m InstrumentLeg) HasLegSecurityDesc() bool {
+	return m.Has(tag.LegSecurityDesc)
+}
+
+//HasEncodedLegSecurityDescLen returns true if EncodedLegSecurityDescLen is present, Tag 621
+func (m InstrumentLeg) HasEncodedLegSecurityDescLen() bool {
+	return m.Has(tag.EncodedLegSecurityDescLen)
+}
+
+//HasEncodedLegSecurityDesc returns true if EncodedLegSecurityDesc is present, Tag 622
+func (m InstrumentLeg) HasEncodedLegSecurityDesc() bool {
+	return m.Has(tag.EncodedLegSecurityDesc)
+}
+
+//SetEncodedLegSecurityDescBytes sets EncodedLegSecurityDesc, Tag 622, and EncodedLegSecurityDescLen, Tag 621, from v, which may hold any bytes including SOH
+func (m InstrumentLeg) SetEncodedLegSecurityDescBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedLegSecurityDescLen, tag.EncodedLegSecurityDesc, v)
+}
+
+//GetEncodedLegSecurityDescBytes gets EncodedLegSecurityDesc, Tag 622, checking its length against EncodedLegSecurityDescLen, Tag 621
+func (m InstrumentLeg) GetEncodedLegSecurityDescBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedLegSecurityDescLen, tag.EncodedLegSecurityDesc)
+}
+
+//GetEncodedLegSecurityDescDecoded gets EncodedLegSecurityDesc, Tag 622, decoded to UTF-8 using the MessageEncoding of h, the Header of the message holding it
+func (m InstrumentLeg) GetEncodedLegSecurityDescDecoded(h fix44.Header) (string, error) {
+	return fix44.DecodeRawData(h, m, tag.EncodedLegSecurityDescLen, tag.EncodedLegSecurityDesc)
+}
+
+//HasLegRatioQty returns true if LegRatioQty is present, Tag 623
+func (m InstrumentLeg) HasLegRatioQty() bool {
+	return m.Has(tag.LegRatioQty)
+}
+
+//HasLegSide returns true if LegSide is present, Tag 624
+func (m InstrumentLeg) HasLegSide() bool {
+	return m.Has(tag.LegSide)
+}
+
+//HasLegCurrency returns true if LegCurrency is present, Tag 556
+func (m InstrumentLeg) HasLegCurrency() bool {
+	return m.Has(tag.LegCurrency)
+}
+
+//HasLegPool returns true if LegPool is present, Tag 740
+func (m InstrumentLeg) HasLegPool() bool {
+	return m.Has(tag.LegPool)
+}
+
+//HasLegDatedDate returns true if LegDatedDate is present, Tag 739
+func (m InstrumentLeg) HasLegDatedDate() bool {
+	return m.Has(tag.LegDatedDate)
+}
+
+//HasLegContractSettlMonth returns true if LegContractSettlMonth is present, Tag 955
+func (m InstrumentLeg) HasLegContractSettlMonth() bool {
+	return m.Has(tag.LegContractSettlMonth)
+}
+
+//HasLegInterestAccrualDate returns true if LegInterestAccrualDate is present, Tag 956
+func (m InstrumentLeg) HasLegInterestAccrualDate() bool {
+	return m.Has(tag.LegInterestAccrualDate)
+}
+
+//NoLegSecurityAltID is a repeating group element, Tag 604
+type NoLegSecurityAltID struct {
+	*quickfix.Group
+}
+
+//SetLegSecurityAltID sets LegSecurityAltID, Tag 605
+func (m NoLegSecurityAltID) SetLegSecurityAltID(v string) {
+	m.Set(field.NewLegSecurityAltID(v))
+}
+
+//SetLegSecurityAltIDSource sets LegSecurityAltIDSource, Tag 606
+func (m NoLegSecurityAltID) SetLegSecurityAltIDSource(v string) {
+	m.Set(field.NewLegSecurityAltIDSource(v))
+}
+
+//GetLegSecurityAltID gets LegSecurityAltID, Tag 605
+func (m NoLegSecurityAltID) GetLegSecurityAltID() (v string, err quickfix.MessageRejectError) {
+	var f field.LegSecurityAltIDField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetLegSecurityAltIDSource gets LegSecurityAltIDSource, Tag 606
+func (m NoLegSecurityAltID) GetLegSecurityAltIDSource() (v string, err quickfix.MessageRejectError) {
+	var f field.LegSecurityAltIDSourceField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//HasLegSecurityAltID returns true if LegSecurityAltID is present, Tag 605
+func (m NoLegSecurityAltID) HasLegSecurityAltID() bool {
+	return m.Has(tag.LegSecurityAltID)
+}
+
+//HasLegSecurityAltIDSource returns true if LegSecurityAltIDSource is present, Tag 606
+func (m NoLegSecurityAltID) HasLegSecurityAltIDSource() bool {
+	return m.Has(tag.LegSecurityAltIDSource)
+}
+
+//NoLegSecurityAltIDRepeatingGroup is a repeating group, Tag 604
+type NoLegSecurityAltIDRepeatingGroup struct {
+	*quickfix.RepeatingGroup
+}
+
+//NewNoLegSecurityAltIDRepeatingGroup returns an initialized, NoLegSecurityAltIDRepeatingGroup
+func NewNoLegSecurityAltIDRepeatingGroup() NoLegSecurityAltIDRepeatingGroup {
+	return NoLegSecurityAltIDRepeatingGroup{
+		quickfix.NewRepeatingGroup(tag.NoLegSecurityAltID,
+			quickfix.GroupTemplate{quickfix.GroupElement(tag.LegSecurityAltID), quickfix.GroupElement(tag.LegSecurityAltIDSource)})}
+}
+
+//Add create and append a new NoLegSecurityAltID to this group
+func (m NoLegSecurityAltIDRepeatingGroup) Add() NoLegSecurityAltID {
+	g := m.RepeatingGroup.Add()
+	return NoLegSecurityAltID{g}
+}
+
+//Get returns the ith NoLegSecurityAltID in the NoLegSecurityAltIDRepeatinGroup
+func (m NoLegSecurityAltIDRepeatingGroup) Get(i int) NoLegSecurityAltID {
+	return NoLegSecurityAltID{m.RepeatingGroup.Get(i)}
+}
+
+var instrumentLegTags = []quickfix.Tag{
+	tag.LegSymbol,
+	tag.LegSymbolSfx,
+	tag.LegSecurityID,
+	tag.LegSecurityIDSource,
+	tag.LegProduct,
+	tag.LegCFICode,
+	tag.LegSecurityType,
+	tag.LegSecuritySubType,
+	tag.LegMaturityMonthYear,
+	tag.LegMaturityDate,
+	tag.LegCouponPaymentDate,
+	tag.LegIssueDate,
+	tag.LegRepoCollateralSecurityType,
+	tag.LegRepurchaseTerm,
+	tag.LegRepurchaseRate,
+	tag.LegFactor,
+	tag.LegCreditRating,
+	tag.LegInstrRegistry,
+	tag.LegCountryOfIssue,
+	tag.LegStateOrProvinceOfIssue,
+	tag.LegLocaleOfIssue,
+	tag.LegRedemptionDate,
+	tag.LegStrikePrice,
+	tag.LegStrikeCurrency,
+	tag.LegOptAttribute,
+	tag.LegContractMultiplier,
+	tag.LegCouponRate,
+	tag.LegSecurityExchange,
+	tag.LegIssuer,
+	tag.EncodedLegIssuerLen,
+	tag.EncodedLegIssuer,
+	tag.LegSecurityDesc,
+	tag.EncodedLegSecurityDescLen,
+	tag.EncodedLegSecurityDesc,
+	tag.LegRatioQty,
+	tag.LegSide,
+	tag.LegCurrency,
+	tag.LegPool,
+	tag.LegDatedDate,
+	tag.LegContractSettlMonth,
+	tag.LegInterestAccrualDate,
+}
+
+//NoLegSecurityAltIDStruct is a plain Go representation of a NoLegSecurityAltID group element, optional fields are nil when absent
+type NoLegSecurityAltIDStruct struct {
+	LegSecurityAltID       *string
+	LegSecurityAltIDSource *string
+}
+
+//MarshalNoLegSecurityAltID copies the elements of g into a slice of NoLegSecurityAltIDStruct
+func MarshalNoLegSecurityAltID(g NoLegSecurityAltIDRepeatingGroup) ([]NoLegSecurityAltIDStruct, quickfix.MessageRejectError) {
+	s := make([]NoLegSecurityAltIDStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasLegSecurityAltID() {
+			v, err := m.GetLegSecurityAltID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSecurityAltID = &v
+		}
+		if m.HasLegSecurityAltIDSource() {
+			v, err := m.GetLegSecurityAltIDSource()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSecurityAltIDSource = &v
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoLegSecurityAltID builds a NoLegSecurityAltIDRepeatingGroup from s
+func UnmarshalNoLegSecurityAltID(s []NoLegSecurityAltIDStruct) NoLegSecurityAltIDRepeatingGroup {
+	g := NewNoLegSecurityAltIDRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.LegSecurityAltID != nil {
+			m.SetLegSecurityAltID(*e.LegSecurityAltID)
+		}
+		if e.LegSecurityAltIDSource != nil {
+			m.SetLegSecurityAltIDSource(*e.LegSecurityAltIDSource)
+		}
+	}
+	return g
+}
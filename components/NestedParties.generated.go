@@ -0,0 +1,319 @@
+package components
+
+import (
+	"github.com/terracefi/field"
+	"github.com/terracefi/quickfix"
+	"github.com/terracefi/tag"
+)
+
+//NestedParties is the fix44 NestedParties component
+type NestedParties struct {
+	*quickfix.FieldMap
+}
+
+//NewNestedParties returns a new, empty NestedParties component
+func NewNestedParties() NestedParties {
+	return NestedParties{&quickfix.NewMessage().Body.FieldMap}
+}
+
+//CopyInto copies the NestedParties fields present in m into fm
+func (m NestedParties) CopyInto(fm *quickfix.FieldMap) quickfix.MessageRejectError {
+	return copyFields(m.FieldMap, fm, nil, NewNoNestedPartyIDsRepeatingGroup())
+}
+
+//SetNoNestedPartyIDs sets NoNestedPartyIDs, Tag 539
+func (m NestedParties) SetNoNestedPartyIDs(f NoNestedPartyIDsRepeatingGroup) {
+	m.SetGroup(f)
+}
+
+//GetNoNestedPartyIDs gets NoNestedPartyIDs, Tag 539
+func (m NestedParties) GetNoNestedPartyIDs() (NoNestedPartyIDsRepeatingGroup, quickfix.MessageRejectError) {
+	f := NewNoNestedPartyIDsRepeatingGroup()
+	err := m.GetGroup(f)
+	return f, err
+}
+
+//HasNoNestedPartyIDs returns true if NoNestedPartyIDs is present, Tag 539
+func (m NestedParties) HasNoNestedPartyIDs() bool {
+	return m.Has(tag.NoNestedPartyIDs)
+}
+
+//NoNestedPartyIDs is a repeating group element, Tag 539
+type NoNestedPartyIDs struct {
+	*quickfix.Group
+}
+
+//SetNestedPartyID sets NestedPartyID, Tag 524
+func (m NoNestedPartyIDs) SetNestedPartyID(v string) {
+	m.Set(field.NewNestedPartyID(v))
+}
+
+//SetNestedPartyIDSource sets NestedPartyIDSource, Tag 525
+func (m NoNestedPartyIDs) SetNestedPartyIDSource(v string) {
+	m.Set(field.NewNestedPartyIDSource(v))
+}
+
+//SetNestedPartyRole sets NestedPartyRole, Tag 538
+func (m NoNestedPartyIDs) SetNestedPartyRole(v int) {
+	m.Set(field.NewNestedPartyRole(v))
+}
+
+//SetNoNestedPartySubIDs sets NoNestedPartySubIDs, Tag 804
+func (m NoNestedPartyIDs) SetNoNestedPartySubIDs(f NoNestedPartySubIDsRepeatingGroup) {
+	m.SetGroup(f)
+}
+
+//GetNestedPartyID gets NestedPartyID, Tag 524
+func (m NoNestedPartyIDs) GetNestedPartyID() (v string, err quickfix.MessageRejectError) {
+	var f field.NestedPartyIDField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetNestedPartyIDSource gets NestedPartyIDSource, Tag 525
+func (m NoNestedPartyIDs) GetNestedPartyIDSource() (v string, err quickfix.MessageRejectError) {
+	var f field.NestedPartyIDSourceField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetNestedPartyRole gets NestedPartyRole, Tag 538
+func (m NoNestedPartyIDs) GetNestedPartyRole() (v int, err quickfix.MessageRejectError) {
+	var f field.NestedPartyRoleField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetNoNestedPartySubIDs gets NoNestedPartySubIDs, Tag 804
+func (m NoNestedPartyIDs) GetNoNestedPartySubIDs() (NoNestedPartySubIDsRepeatingGroup, quickfix.MessageRejectError) {
+	f := NewNoNestedPartySubIDsRepeatingGroup()
+	err := m.GetGroup(f)
+	return f, err
+}
+
+//HasNestedPartyID returns true if NestedPartyID is present, Tag 524
+func (m NoNestedPartyIDs) HasNestedPartyID() bool {
+	return m.Has(tag.NestedPartyID)
+}
+
+//HasNestedPartyIDSource returns true if NestedPartyIDSource is present, Tag 525
+func (m NoNestedPartyIDs) HasNestedPartyIDSource() bool {
+	return m.Has(tag.NestedPartyIDSource)
+}
+
+//HasNestedPartyRole returns true if NestedPartyRole is present, Tag 538
+func (m NoNestedPartyIDs) HasNestedPartyRole() bool {
+	return m.Has(tag.NestedPartyRole)
+}
+
+//HasNoNestedPartySubIDs returns true if NoNestedPartySubIDs is present, Tag 804
+func (m NoNestedPartyIDs) HasNoNestedPartySubIDs() bool {
+	return m.Has(tag.NoNestedPartySubIDs)
+}
+
+//NoNestedPartyIDsRepeatingGroup is a repeating group, Tag 539
+type NoNestedPartyIDsRepeatingGroup struct {
+	*quickfix.RepeatingGroup
+}
+
+//NewNoNestedPartyIDsRepeatingGroup returns an initialized, NoNestedPartyIDsRepeatingGroup
+func NewNoNestedPartyIDsRepeatingGroup() NoNestedPartyIDsRepeatingGroup {
+	return NoNestedPartyIDsRepeatingGroup{
+		quickfix.NewRepeatingGroup(tag.NoNestedPartyIDs,
+			quickfix.GroupTemplate{quickfix.GroupElement(tag.NestedPartyID), quickfix.GroupElement(tag.NestedPartyIDSource), quickfix.GroupElement(tag.NestedPartyRole), NewNoNestedPartySubIDsRepeatingGroup()})}
+}
+
+//Add create and append a new NoNestedPartyIDs to this group
+func (m NoNestedPartyIDsRepeatingGroup) Add() NoNestedPartyIDs {
+	g := m.RepeatingGroup.Add()
+	return NoNestedPartyIDs{g}
+}
+
+//Get returns the ith NoNestedPartyIDs in the NoNestedPartyIDsRepeatinGroup
+func (m NoNestedPartyIDsRepeatingGroup) Get(i int) NoNestedPartyIDs {
+	return NoNestedPartyIDs{m.RepeatingGroup.Get(i)}
+}
+
+//NoNestedPartySubIDs is a repeating group element, Tag 804
+type NoNestedPartySubIDs struct {
+	*quickfix.Group
+}
+
+//SetNestedPartySubID sets NestedPartySubID, Tag 545
+func (m NoNestedPartySubIDs) SetNestedPartySubID(v string) {
+	m.Set(field.NewNestedPartySubID(v))
+}
+
+//SetNestedPartySubIDType sets NestedPartySubIDType, Tag 805
+func (m NoNestedPartySubIDs) SetNestedPartySubIDType(v int) {
+	m.Set(field.NewNestedPartySubIDType(v))
+}
+
+//GetNestedPartySubID gets NestedPartySubID, Tag 545
+func (m NoNestedPartySubIDs) GetNestedPartySubID() (v string, err quickfix.MessageRejectError) {
+	var f field.NestedPartySubIDField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetNestedPartySubIDType gets NestedPartySubIDType, Tag 805
+func (m NoNestedPartySubIDs) GetNestedPartySubIDType() (v int, err quickfix.MessageRejectError) {
+	var f field.NestedPartySubIDTypeField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//HasNestedPartySubID returns true if NestedPartySubID is present, Tag 545
+func (m NoNestedPartySubIDs) HasNestedPartySubID() bool {
+	return m.Has(tag.NestedPartySubID)
+}
+
+//HasNestedPartySubIDType returns true if NestedPartySubIDType is present, Tag 805
+func (m NoNestedPartySubIDs) HasNestedPartySubIDType() bool {
+	return m.Has(tag.NestedPartySubIDType)
+}
+
+//NoNestedPartySubIDsRepeatingGroup is a repeating group, Tag 804
+type NoNestedPartySubIDsRepeatingGroup struct {
+	*quickfix.RepeatingGroup
+}
+
+//NewNoNestedPartySubIDsRepeatingGroup returns an initialized, NoNestedPartySubIDsRepeatingGroup
+func NewNoNestedPartySubIDsRepeatingGroup() NoNestedPartySubIDsRepeatingGroup {
+	return NoNestedPartySubIDsRepeatingGroup{
+		quickfix.NewRepeatingGroup(tag.NoNestedPartySubIDs,
+			quickfix.GroupTemplate{quickfix.GroupElement(tag.NestedPartySubID), quickfix.GroupElement(tag.NestedPartySubIDType)})}
+}
+
+//Add create and append a new NoNestedPartySubIDs to this group
+func (m NoNestedPartySubIDsRepeatingGroup) Add() NoNestedPartySubIDs {
+	g := m.RepeatingGroup.Add()
+	return NoNestedPartySubIDs{g}
+}
+
+//Get returns the ith NoNestedPartySubIDs in the NoNestedPartySubIDsRepeatinGroup
+func (m NoNestedPartySubIDsRepeatingGroup) Get(i int) NoNestedPartySubIDs {
+	return NoNestedPartySubIDs{m.RepeatingGroup.Get(i)}
+}
+
+//NoNestedPartyIDsStruct is a plain Go representation of a NoNestedPartyIDs group element, optional fields are nil when absent
+type NoNestedPartyIDsStruct struct {
+	NestedPartyID       *string
+	NestedPartyIDSource *string
+	NestedPartyRole     *int
+	NoNestedPartySubIDs []NoNestedPartySubIDsStruct
+}
+
+//MarshalNoNestedPartyIDs copies the elements of g into a slice of NoNestedPartyIDsStruct
+func MarshalNoNestedPartyIDs(g NoNestedPartyIDsRepeatingGroup) ([]NoNestedPartyIDsStruct, quickfix.MessageRejectError) {
+	s := make([]NoNestedPartyIDsStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasNestedPartyID() {
+			v, err := m.GetNestedPartyID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].NestedPartyID = &v
+		}
+		if m.HasNestedPartyIDSource() {
+			v, err := m.GetNestedPartyIDSource()
+			if err != nil {
+				return nil, err
+			}
+			s[i].NestedPartyIDSource = &v
+		}
+		if m.HasNestedPartyRole() {
+			v, err := m.GetNestedPartyRole()
+			if err != nil {
+				return nil, err
+			}
+			s[i].NestedPartyRole = &v
+		}
+		if m.HasNoNestedPartySubIDs() {
+			g, err := m.GetNoNestedPartySubIDs()
+			if err != nil {
+				return nil, err
+			}
+			if s[i].NoNestedPartySubIDs, err = MarshalNoNestedPartySubIDs(g); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoNestedPartyIDs builds a NoNestedPartyIDsRepeatingGroup from s
+func UnmarshalNoNestedPartyIDs(s []NoNestedPartyIDsStruct) NoNestedPartyIDsRepeatingGroup {
+	g := NewNoNestedPartyIDsRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.NestedPartyID != nil {
+			m.SetNestedPartyID(*e.NestedPartyID)
+		}
+		if e.NestedPartyIDSource != nil {
+			m.SetNestedPartyIDSource(*e.NestedPartyIDSource)
+		}
+		if e.NestedPartyRole != nil {
+			m.SetNestedPartyRole(*e.NestedPartyRole)
+		}
+		if e.NoNestedPartySubIDs != nil {
+			m.SetNoNestedPartySubIDs(UnmarshalNoNestedPartySubIDs(e.NoNestedPartySubIDs))
+		}
+	}
+	return g
+}
+
+//NoNestedPartySubIDsStruct is a plain Go representation of a NoNestedPartySubIDs group element, optional fields are nil when absent
+type NoNestedPartySubIDsStruct struct {
+	NestedPartySubID     *string
+	NestedPartySubIDType *int
+}
+
+//MarshalNoNestedPartySubIDs copies the elements of g into a slice of NoNestedPartySubIDsStruct
+func MarshalNoNestedPartySubIDs(g NoNestedPartySubIDsRepeatingGroup) ([]NoNestedPartySubIDsStruct, quickfix.MessageRejectError) {
+	s := make([]NoNestedPartySubIDsStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasNestedPartySubID() {
+			v, err := m.GetNestedPartySubID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].NestedPartySubID = &v
+		}
+		if m.HasNestedPartySubIDType() {
+			v, err := m.GetNestedPartySubIDType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].NestedPartySubIDType = &v
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoNestedPartySubIDs builds a NoNestedPartySubIDsRepeatingGroup from s
+func UnmarshalNoNestedPartySubIDs(s []NoNestedPartySubIDsStruct) NoNestedPartySubIDsRepeatingGroup {
+	g := NewNoNestedPartySubIDsRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.NestedPartySubID != nil {
+			m.SetNestedPartySubID(*e.NestedPartySubID)
+		}
+		if e.NestedPartySubIDType != nil {
+			m.SetNestedPartySubIDType(*e.NestedPartySubIDType)
+		}
+	}
+	return g
+}
@@ -0,0 +1,128 @@
+package components
+
+import (
+	"github.com/shopspring/decimal"
+
+	"github.com/terracefi/enum"
+	"github.com/terracefi/field"
+	"github.com/terracefi/quickfix"
+	"github.com/terracefi/tag"
+)
+
+//OrderQtyData is the fix44 OrderQtyData component
+type OrderQtyData struct {
+	*quickfix.FieldMap
+}
+
+//NewOrderQtyData returns a new, empty OrderQtyData component
+func NewOrderQtyData() OrderQtyData {
+	return OrderQtyData{&quickfix.NewMessage().Body.FieldMap}
+}
+
+//CopyInto copies the OrderQtyData fields present in m into fm
+func (m OrderQtyData) CopyInto(fm *quickfix.FieldMap) quickfix.MessageRejectError {
+	return copyFields(m.FieldMap, fm, orderQtyDataTags)
+}
+
+//SetOrderQty sets OrderQty, Tag 38
+func (m OrderQtyData) SetOrderQty(value decimal.Decimal, scale int32) {
+	m.Set(field.NewOrderQty(value, scale))
+}
+
+//SetCashOrderQty sets CashOrderQty, Tag 152
+func (m OrderQtyData) SetCashOrderQty(value decimal.Decimal, scale int32) {
+	m.Set(field.NewCashOrderQty(value, scale))
+}
+
+//SetOrderPercent sets OrderPercent, Tag 516
+func (m OrderQtyData) SetOrderPercent(value decimal.Decimal, scale int32) {
+	m.Set(field.NewOrderPercent(value, scale))
+}
+
+//SetRoundingDirection sets RoundingDirection, Tag 468
+func (m OrderQtyData) SetRoundingDirection(v enum.RoundingDirection) {
+	m.Set(field.NewRoundingDirection(v))
+}
+
+//SetRoundingModulus sets RoundingModulus, Tag 469
+func (m OrderQtyData) SetRoundingModulus(value decimal.Decimal, scale int32) {
+	m.Set(field.NewRoundingModulus(value, scale))
+}
+
+//GetOrderQty gets OrderQty, Tag 38
+func (m OrderQtyData) GetOrderQty() (v decimal.Decimal, err quickfix.MessageRejectError) {
+	var f field.OrderQtyField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetCashOrderQty gets CashOrderQty, Tag 152
+func (m OrderQtyData) GetCashOrderQty() (v decimal.Decimal, err quickfix.MessageRejectError) {
+	var f field.CashOrderQtyField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetOrderPercent gets OrderPercent, Tag 516
+func (m OrderQtyData) GetOrderPercent() (v decimal.Decimal, err quickfix.MessageRejectError) {
+	var f field.OrderPercentField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetRoundingDirection gets RoundingDirection, Tag 468
+func (m OrderQtyData) GetRoundingDirection() (v enum.RoundingDirection, err quickfix.MessageRejectError) {
+	var f field.RoundingDirectionField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetRoundingModulus gets RoundingModulus, Tag 469
+func (m OrderQtyData) GetRoundingModulus() (v decimal.Decimal, err quickfix.MessageRejectError) {
+	var f field.RoundingModulusField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//HasOrderQty returns true if OrderQty is present, Tag 38
+func (m OrderQtyData) HasOrderQty() bool {
+	return m.Has(tag.OrderQty)
+}
+
+//HasCashOrderQty returns true if CashOrderQty is present, Tag 152
+func (m OrderQtyData) HasCashOrderQty() bool {
+	return m.Has(tag.CashOrderQty)
+}
+
+//HasOrderPercent returns true if OrderPercent is present, Tag 516
+func (m OrderQtyData) HasOrderPercent() bool {
+	return m.Has(tag.OrderPercent)
+}
+
+//HasRoundingDirection returns true if RoundingDirection is present, Tag 468
+func (m OrderQtyData) HasRoundingDirection() bool {
+	return m.Has(tag.RoundingDirection)
+}
+
+//HasRoundingModulus returns true if RoundingModulus is present, Tag 469
+func (m OrderQtyData) HasRoundingModulus() bool {
+	return m.Has(tag.RoundingModulus)
+}
+
+var orderQtyDataTags = []quickfix.Tag{
+	tag.OrderQty,
+	tag.CashOrderQty,
+	tag.OrderPercent,
+	tag.RoundingDirection,
+	tag.RoundingModulus,
+}
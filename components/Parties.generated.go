@@ -0,0 +1,320 @@
+package components
+
+import (
+	"github.com/terracefi/enum"
+	"github.com/terracefi/field"
+	"github.com/terracefi/quickfix"
+	"github.com/terracefi/tag"
+)
+
+//Parties is the fix44 Parties component
+type Parties struct {
+	*quickfix.FieldMap
+}
+
+//NewParties returns a new, empty Parties component
+func NewParties() Parties {
+	return Parties{&quickfix.NewMessage().Body.FieldMap}
+}
+
+//CopyInto copies the Parties fields present in m into fm
+func (m Parties) CopyInto(fm *quickfix.FieldMap) quickfix.MessageRejectError {
+	return copyFields(m.FieldMap, fm, nil, NewNoPartyIDsRepeatingGroup())
+}
+
+//SetNoPartyIDs sets NoPartyIDs, Tag 453
+func (m Parties) SetNoPartyIDs(f NoPartyIDsRepeatingGroup) {
+	m.SetGroup(f)
+}
+
+//GetNoPartyIDs gets NoPartyIDs, Tag 453
+func (m Parties) GetNoPartyIDs() (NoPartyIDsRepeatingGroup, quickfix.MessageRejectError) {
+	f := NewNoPartyIDsRepeatingGroup()
+	err := m.GetGroup(f)
+	return f, err
+}
+
+//HasNoPartyIDs returns true if NoPartyIDs is present, Tag 453
+func (m Parties) HasNoPartyIDs() bool {
+	return m.Has(tag.NoPartyIDs)
+}
+
+//NoPartyIDs is a repeating group element, Tag 453
+type NoPartyIDs struct {
+	*quickfix.Group
+}
+
+//SetPartyID sets PartyID, Tag 448
+func (m NoPartyIDs) SetPartyID(v string) {
+	m.Set(field.NewPartyID(v))
+}
+
+//SetPartyIDSource sets PartyIDSource, Tag 447
+func (m NoPartyIDs) SetPartyIDSource(v enum.PartyIDSource) {
+	m.Set(field.NewPartyIDSource(v))
+}
+
+//SetPartyRole sets PartyRole, Tag 452
+func (m NoPartyIDs) SetPartyRole(v enum.PartyRole) {
+	m.Set(field.NewPartyRole(v))
+}
+
+//SetNoPartySubIDs sets NoPartySubIDs, Tag 802
+func (m NoPartyIDs) SetNoPartySubIDs(f NoPartySubIDsRepeatingGroup) {
+	m.SetGroup(f)
+}
+
+//GetPartyID gets PartyID, Tag 448
+func (m NoPartyIDs) GetPartyID() (v string, err quickfix.MessageRejectError) {
+	var f field.PartyIDField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetPartyIDSource gets PartyIDSource, Tag 447
+func (m NoPartyIDs) GetPartyIDSource() (v enum.PartyIDSource, err quickfix.MessageRejectError) {
+	var f field.PartyIDSourceField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetPartyRole gets PartyRole, Tag 452
+func (m NoPartyIDs) GetPartyRole() (v enum.PartyRole, err quickfix.MessageRejectError) {
+	var f field.PartyRoleField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetNoPartySubIDs gets NoPartySubIDs, Tag 802
+func (m NoPartyIDs) GetNoPartySubIDs() (NoPartySubIDsRepeatingGroup, quickfix.MessageRejectError) {
+	f := NewNoPartySubIDsRepeatingGroup()
+	err := m.GetGroup(f)
+	return f, err
+}
+
+//HasPartyID returns true if PartyID is present, Tag 448
+func (m NoPartyIDs) HasPartyID() bool {
+	return m.Has(tag.PartyID)
+}
+
+//HasPartyIDSource returns true if PartyIDSource is present, Tag 447
+func (m NoPartyIDs) HasPartyIDSource() bool {
+	return m.Has(tag.PartyIDSource)
+}
+
+//HasPartyRole returns true if PartyRole is present, Tag 452
+func (m NoPartyIDs) HasPartyRole() bool {
+	return m.Has(tag.PartyRole)
+}
+
+//HasNoPartySubIDs returns true if NoPartySubIDs is present, Tag 802
+func (m NoPartyIDs) HasNoPartySubIDs() bool {
+	return m.Has(tag.NoPartySubIDs)
+}
+
+//NoPartyIDsRepeatingGroup is a repeating group, Tag 453
+type NoPartyIDsRepeatingGroup struct {
+	*quickfix.RepeatingGroup
+}
+
+//NewNoPartyIDsRepeatingGroup returns an initialized, NoPartyIDsRepeatingGroup
+func NewNoPartyIDsRepeatingGroup() NoPartyIDsRepeatingGroup {
+	return NoPartyIDsRepeatingGroup{
+		quickfix.NewRepeatingGroup(tag.NoPartyIDs,
+			quickfix.GroupTemplate{quickfix.GroupElement(tag.PartyID), quickfix.GroupElement(tag.PartyIDSource), quickfix.GroupElement(tag.PartyRole), NewNoPartySubIDsRepeatingGroup()})}
+}
+
+//Add create and append a new NoPartyIDs to this group
+func (m NoPartyIDsRepeatingGroup) Add() NoPartyIDs {
+	g := m.RepeatingGroup.Add()
+	return NoPartyIDs{g}
+}
+
+//Get returns the ith NoPartyIDs in the NoPartyIDsRepeatinGroup
+func (m NoPartyIDsRepeatingGroup) Get(i int) NoPartyIDs {
+	return NoPartyIDs{m.RepeatingGroup.Get(i)}
+}
+
+//NoPartySubIDs is a repeating group element, Tag 802
+type NoPartySubIDs struct {
+	*quickfix.Group
+}
+
+//SetPartySubID sets PartySubID, Tag 523
+func (m NoPartySubIDs) SetPartySubID(v string) {
+	m.Set(field.NewPartySubID(v))
+}
+
+//SetPartySubIDType sets PartySubIDType, Tag 803
+func (m NoPartySubIDs) SetPartySubIDType(v enum.PartySubIDType) {
+	m.Set(field.NewPartySubIDType(v))
+}
+
+//GetPartySubID gets PartySubID, Tag 523
+func (m NoPartySubIDs) GetPartySubID() (v string, err quickfix.MessageRejectError) {
+	var f field.PartySubIDField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetPartySubIDType gets PartySubIDType, Tag 803
+func (m NoPartySubIDs) GetPartySubIDType() (v enum.PartySubIDType, err quickfix.MessageRejectError) {
+	var f field.PartySubIDTypeField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//HasPartySubID returns true if PartySubID is present, Tag 523
+func (m NoPartySubIDs) HasPartySubID() bool {
+	return m.Has(tag.PartySubID)
+}
+
+//HasPartySubIDType returns true if PartySubIDType is present, Tag 803
+func (m NoPartySubIDs) HasPartySubIDType() bool {
+	return m.Has(tag.PartySubIDType)
+}
+
+//NoPartySubIDsRepeatingGroup is a repeating group, Tag 802
+type NoPartySubIDsRepeatingGroup struct {
+	*quickfix.RepeatingGroup
+}
+
+//NewNoPartySubIDsRepeatingGroup returns an initialized, NoPartySubIDsRepeatingGroup
+func NewNoPartySubIDsRepeatingGroup() NoPartySubIDsRepeatingGroup {
+	return NoPartySubIDsRepeatingGroup{
+		quickfix.NewRepeatingGroup(tag.NoPartySubIDs,
+			quickfix.GroupTemplate{quickfix.GroupElement(tag.PartySubID), quickfix.GroupElement(tag.PartySubIDType)})}
+}
+
+//Add create and append a new NoPartySubIDs to this group
+func (m NoPartySubIDsRepeatingGroup) Add() NoPartySubIDs {
+	g := m.RepeatingGroup.Add()
+	return NoPartySubIDs{g}
+}
+
+//Get returns the ith NoPartySubIDs in the NoPartySubIDsRepeatinGroup
+func (m NoPartySubIDsRepeatingGroup) Get(i int) NoPartySubIDs {
+	return NoPartySubIDs{m.RepeatingGroup.Get(i)}
+}
+
+//NoPartyIDsStruct is a plain Go representation of a NoPartyIDs group element, optional fields are nil when absent
+type NoPartyIDsStruct struct {
+	PartyID       *string
+	PartyIDSource *enum.PartyIDSource
+	PartyRole     *enum.PartyRole
+	NoPartySubIDs []NoPartySubIDsStruct
+}
+
+//MarshalNoPartyIDs copies the elements of g into a slice of NoPartyIDsStruct
+func MarshalNoPartyIDs(g NoPartyIDsRepeatingGroup) ([]NoPartyIDsStruct, quickfix.MessageRejectError) {
+	s := make([]NoPartyIDsStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasPartyID() {
+			v, err := m.GetPartyID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].PartyID = &v
+		}
+		if m.HasPartyIDSource() {
+			v, err := m.GetPartyIDSource()
+			if err != nil {
+				return nil, err
+			}
+			s[i].PartyIDSource = &v
+		}
+		if m.HasPartyRole() {
+			v, err := m.GetPartyRole()
+			if err != nil {
+				return nil, err
+			}
+			s[i].PartyRole = &v
+		}
+		if m.HasNoPartySubIDs() {
+			g, err := m.GetNoPartySubIDs()
+			if err != nil {
+				return nil, err
+			}
+			if s[i].NoPartySubIDs, err = MarshalNoPartySubIDs(g); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoPartyIDs builds a NoPartyIDsRepeatingGroup from s
+func UnmarshalNoPartyIDs(s []NoPartyIDsStruct) NoPartyIDsRepeatingGroup {
+	g := NewNoPartyIDsRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.PartyID != nil {
+			m.SetPartyID(*e.PartyID)
+		}
+		if e.PartyIDSource != nil {
+			m.SetPartyIDSource(*e.PartyIDSource)
+		}
+		if e.PartyRole != nil {
+			m.SetPartyRole(*e.PartyRole)
+		}
+		if e.NoPartySubIDs != nil {
+			m.SetNoPartySubIDs(UnmarshalNoPartySubIDs(e.NoPartySubIDs))
+		}
+	}
+	return g
+}
+
+//NoPartySubIDsStruct is a plain Go representation of a NoPartySubIDs group element, optional fields are nil when absent
+type NoPartySubIDsStruct struct {
+	PartySubID     *string
+	PartySubIDType *enum.PartySubIDType
+}
+
+//MarshalNoPartySubIDs copies the elements of g into a slice of NoPartySubIDsStruct
+func MarshalNoPartySubIDs(g NoPartySubIDsRepeatingGroup) ([]NoPartySubIDsStruct, quickfix.MessageRejectError) {
+	s := make([]NoPartySubIDsStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasPartySubID() {
+			v, err := m.GetPartySubID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].PartySubID = &v
+		}
+		if m.HasPartySubIDType() {
+			v, err := m.GetPartySubIDType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].PartySubIDType = &v
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoPartySubIDs builds a NoPartySubIDsRepeatingGroup from s
+func UnmarshalNoPartySubIDs(s []NoPartySubIDsStruct) NoPartySubIDsRepeatingGroup {
+	g := NewNoPartySubIDsRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.PartySubID != nil {
+			m.SetPartySubID(*e.PartySubID)
+		}
+		if e.PartySubIDType != nil {
+			m.SetPartySubIDType(*e.PartySubIDType)
+		}
+	}
+	return g
+}
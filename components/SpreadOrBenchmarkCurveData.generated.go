@@ -0,0 +1,188 @@
+package components
+
+import (
+	"github.com/shopspring/decimal"
+
+	"github.com/terracefi/enum"
+	"github.com/terracefi/field"
+	"github.com/terracefi/quickfix"
+	"github.com/terracefi/tag"
+)
+
+//SpreadOrBenchmarkCurveData is the fix44 SpreadOrBenchmarkCurveData component
+type SpreadOrBenchmarkCurveData struct {
+	*quickfix.FieldMap
+}
+
+//NewSpreadOrBenchmarkCurveData returns a new, empty SpreadOrBenchmarkCurveData component
+func NewSpreadOrBenchmarkCurveData() SpreadOrBenchmarkCurveData {
+	return SpreadOrBenchmarkCurveData{&quickfix.NewMessage().Body.FieldMap}
+}
+
+//CopyInto copies the SpreadOrBenchmarkCurveData fields present in m into fm
+func (m SpreadOrBenchmarkCurveData) CopyInto(fm *quickfix.FieldMap) quickfix.MessageRejectError {
+	return copyFields(m.FieldMap, fm, spreadOrBenchmarkCurveDataTags)
+}
+
+//SetSpread sets Spread, Tag 218
+func (m SpreadOrBenchmarkCurveData) SetSpread(value decimal.Decimal, scale int32) {
+	m.Set(field.NewSpread(value, scale))
+}
+
+//SetBenchmarkCurveCurrency sets BenchmarkCurveCurrency, Tag 220
+func (m SpreadOrBenchmarkCurveData) SetBenchmarkCurveCurrency(v string) {
+	m.Set(field.NewBenchmarkCurveCurrency(v))
+}
+
+//SetBenchmarkCurveName sets BenchmarkCurveName, Tag 221
+func (m SpreadOrBenchmarkCurveData) SetBenchmarkCurveName(v enum.BenchmarkCurveName) {
+	m.Set(field.NewBenchmarkCurveName(v))
+}
+
+//SetBenchmarkCurvePoint sets BenchmarkCurvePoint, Tag 222
+func (m SpreadOrBenchmarkCurveData) SetBenchmarkCurvePoint(v string) {
+	m.Set(field.NewBenchmarkCurvePoint(v))
+}
+
+//SetBenchmarkPrice sets BenchmarkPrice, Tag 662
+func (m SpreadOrBenchmarkCurveData) SetBenchmarkPrice(value decimal.Decimal, scale int32) {
+	m.Set(field.NewBenchmarkPrice(value, scale))
+}
+
+//SetBenchmarkPriceType sets BenchmarkPriceType, Tag 663
+func (m SpreadOrBenchmarkCurveData) SetBenchmarkPriceType(v int) {
+	m.Set(field.NewBenchmarkPriceType(v))
+}
+
+//SetBenchmarkSecurityID sets BenchmarkSecurityID, Tag 699
+func (m SpreadOrBenchmarkCurveData) SetBenchmarkSecurityID(v string) {
+	m.Set(field.NewBenchmarkSecurityID(v))
+}
+
+//SetBenchmarkSecurityIDSource sets BenchmarkSecurityIDSource, Tag 761
+func (m SpreadOrBenchmarkCurveData) SetBenchmarkSecurityIDSource(v string) {
+	m.Set(field.NewBenchmarkSecurityIDSource(v))
+}
+
+//GetSpread gets Spread, Tag 218
+func (m SpreadOrBenchmarkCurveData) GetSpread() (v decimal.Decimal, err quickfix.MessageRejectError) {
+	var f field.SpreadField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetBenchmarkCurveCurrency gets BenchmarkCurveCurrency, Tag 220
+func (m SpreadOrBenchmarkCurveData) GetBenchmarkCurveCurrency() (v string, err quickfix.MessageRejectError) {
+	var f field.BenchmarkCurveCurrencyField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetBenchmarkCurveName gets BenchmarkCurveName, Tag 221
+func (m SpreadOrBenchmarkCurveData) GetBenchmarkCurveName() (v enum.BenchmarkCurveName, err quickfix.MessageRejectError) {
+	var f field.BenchmarkCurveNameField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetBenchmarkCurvePoint gets BenchmarkCurvePoint, Tag 222
+func (m SpreadOrBenchmarkCurveData) GetBenchmarkCurvePoint() (v string, err quickfix.MessageRejectError) {
+	var f field.BenchmarkCurvePointField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetBenchmarkPrice gets BenchmarkPrice, Tag 662
+func (m SpreadOrBenchmarkCurveData) GetBenchmarkPrice() (v decimal.Decimal, err quickfix.MessageRejectError) {
+	var f field.BenchmarkPriceField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetBenchmarkPriceType gets BenchmarkPriceType, Tag 663
+func (m SpreadOrBenchmarkCurveData) GetBenchmarkPriceType() (v int, err quickfix.MessageRejectError) {
+	var f field.BenchmarkPriceTypeField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetBenchmarkSecurityID gets BenchmarkSecurityID, Tag 699
+func (m SpreadOrBenchmarkCurveData) GetBenchmarkSecurityID() (v string, err quickfix.MessageRejectError) {
+	var f field.BenchmarkSecurityIDField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetBenchmarkSecurityIDSource gets BenchmarkSecurityIDSource, Tag 761
+func (m SpreadOrBenchmarkCurveData) GetBenchmarkSecurityIDSource() (v string, err quickfix.MessageRejectError) {
+	var f field.BenchmarkSecurityIDSourceField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//HasSpread returns true if Spread is present, Tag 218
+func (m SpreadOrBenchmarkCurveData) HasSpread() bool {
+	return m.Has(tag.Spread)
+}
+
+//HasBenchmarkCurveCurrency returns true if BenchmarkCurveCurrency is present, Tag 220
+func (m SpreadOrBenchmarkCurveData) HasBenchmarkCurveCurrency() bool {
+	return m.Has(tag.BenchmarkCurveCurrency)
+}
+
+//HasBenchmarkCurveName returns true if BenchmarkCurveName is present, Tag 221
+func (m SpreadOrBenchmarkCurveData) HasBenchmarkCurveName() bool {
+	return m.Has(tag.BenchmarkCurveName)
+}
+
+//HasBenchmarkCurvePoint returns true if BenchmarkCurvePoint is present, Tag 222
+func (m SpreadOrBenchmarkCurveData) HasBenchmarkCurvePoint() bool {
+	return m.Has(tag.BenchmarkCurvePoint)
+}
+
+//HasBenchmarkPrice returns true if BenchmarkPrice is present, Tag 662
+func (m SpreadOrBenchmarkCurveData) HasBenchmarkPrice() bool {
+	return m.Has(tag.BenchmarkPrice)
+}
+
+//HasBenchmarkPriceType returns true if BenchmarkPriceType is present, Tag 663
+func (m SpreadOrBenchmarkCurveData) HasBenchmarkPriceType() bool {
+	return m.Has(tag.BenchmarkPriceType)
+}
+
+//HasBenchmarkSecurityID returns true if BenchmarkSecurityID is present, Tag 699
+func (m SpreadOrBenchmarkCurveData) HasBenchmarkSecurityID() bool {
+	return m.Has(tag.BenchmarkSecurityID)
+}
+
+//HasBenchmarkSecurityIDSource returns true if BenchmarkSecurityIDSource is present, Tag 761
+func (m SpreadOrBenchmarkCurveData) HasBenchmarkSecurityIDSource() bool {
+	return m.Has(tag.BenchmarkSecurityIDSource)
+}
+
+var spreadOrBenchmarkCurveDataTags = []quickfix.Tag{
+	tag.Spread,
+	tag.BenchmarkCurveCurrency,
+	tag.BenchmarkCurveName,
+	tag.BenchmarkCurvePoint,
+	tag.BenchmarkPrice,
+	tag.BenchmarkPriceType,
+	tag.BenchmarkSecurityID,
+	tag.BenchmarkSecurityIDSource,
+}
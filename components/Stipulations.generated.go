@@ -0,0 +1,150 @@
+package components
+
+import (
+	"github.com/terracefi/enum"
+	"github.com/terracefi/field"
+	"github.com/terracefi/quickfix"
+	"github.com/terracefi/tag"
+)
+
+//Stipulations is the fix44 Stipulations component
+type Stipulations struct {
+	*quickfix.FieldMap
+}
+
+//NewStipulations returns a new, empty Stipulations component
+func NewStipulations() Stipulations {
+	return Stipulations{&quickfix.NewMessage().Body.FieldMap}
+}
+
+//CopyInto copies the Stipulations fields present in m into fm
+func (m Stipulations) CopyInto(fm *quickfix.FieldMap) quickfix.MessageRejectError {
+	return copyFields(m.FieldMap, fm, nil, NewNoStipulationsRepeatingGroup())
+}
+
+//SetNoStipulations sets NoStipulations, Tag 232
+func (m Stipulations) SetNoStipulations(f NoStipulationsRepeatingGroup) {
+	m.SetGroup(f)
+}
+
+//GetNoStipulations gets NoStipulations, Tag 232
+func (m Stipulations) GetNoStipulations() (NoStipulationsRepeatingGroup, quickfix.MessageRejectError) {
+	f := NewNoStipulationsRepeatingGroup()
+	err := m.GetGroup(f)
+	return f, err
+}
+
+//HasNoStipulations returns true if NoStipulations is present, Tag 232
+func (m Stipulations) HasNoStipulations() bool {
+	return m.Has(tag.NoStipulations)
+}
+
+//NoStipulations is a repeating group element, Tag 232
+type NoStipulations struct {
+	*quickfix.Group
+}
+
+//SetStipulationType sets StipulationType, Tag 233
+func (m NoStipulations) SetStipulationType(v enum.StipulationType) {
+	m.Set(field.NewStipulationType(v))
+}
+
+//SetStipulationValue sets StipulationValue, Tag 234
+func (m NoStipulations) SetStipulationValue(v string) {
+	m.Set(field.NewStipulationValue(v))
+}
+
+//GetStipulationType gets StipulationType, Tag 233
+func (m NoStipulations) GetStipulationType() (v enum.StipulationType, err quickfix.MessageRejectError) {
+	var f field.StipulationTypeField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetStipulationValue gets StipulationValue, Tag 234
+func (m NoStipulations) GetStipulationValue() (v string, err quickfix.MessageRejectError) {
+	var f field.StipulationValueField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//HasStipulationType returns true if StipulationType is present, Tag 233
+func (m NoStipulations) HasStipulationType() bool {
+	return m.Has(tag.StipulationType)
+}
+
+//HasStipulationValue returns true if StipulationValue is present, Tag 234
+func (m NoStipulations) HasStipulationValue() bool {
+	return m.Has(tag.StipulationValue)
+}
+
+//NoStipulationsRepeatingGroup is a repeating group, Tag 232
+type NoStipulationsRepeatingGroup struct {
+	*quickfix.RepeatingGroup
+}
+
+//NewNoStipulationsRepeatingGroup returns an initialized, NoStipulationsRepeatingGroup
+func NewNoStipulationsRepeatingGroup() NoStipulationsRepeatingGroup {
+	return NoStipulationsRepeatingGroup{
+		quickfix.NewRepeatingGroup(tag.NoStipulations,
+			quickfix.GroupTemplate{quickfix.GroupElement(tag.StipulationType), quickfix.GroupElement(tag.StipulationValue)})}
+}
+
+//Add create and append a new NoStipulations to this group
+func (m NoStipulationsRepeatingGroup) Add() NoStipulations {
+	g := m.RepeatingGroup.Add()
+	return NoStipulations{g}
+}
+
+//Get returns the ith NoStipulations in the NoStipulationsRepeatinGroup
+func (m NoStipulationsRepeatingGroup) Get(i int) NoStipulations {
+	return NoStipulations{m.RepeatingGroup.Get(i)}
+}
+
+//NoStipulationsStruct is a plain Go representation of a NoStipulations group element, optional fields are nil when absent
+type NoStipulationsStruct struct {
+	StipulationType  *enum.StipulationType
+	StipulationValue *string
+}
+
+//MarshalNoStipulations copies the elements of g into a slice of NoStipulationsStruct
+func MarshalNoStipulations(g NoStipulationsRepeatingGroup) ([]NoStipulationsStruct, quickfix.MessageRejectError) {
+	s := make([]NoStipulationsStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasStipulationType() {
+			v, err := m.GetStipulationType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].StipulationType = &v
+		}
+		if m.HasStipulationValue() {
+			v, err := m.GetStipulationValue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].StipulationValue = &v
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoStipulations builds a NoStipulationsRepeatingGroup from s
+func UnmarshalNoStipulations(s []NoStipulationsStruct) NoStipulationsRepeatingGroup {
+	g := NewNoStipulationsRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.StipulationType != nil {
+			m.SetStipulationType(*e.StipulationType)
+		}
+		if e.StipulationValue != nil {
+			m.SetStipulationValue(*e.StipulationValue)
+		}
+	}
+	return g
+}
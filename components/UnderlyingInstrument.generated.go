@@ -0,0 +1,1192 @@
+package components
+
+import (
+	"github.com/shopspring/decimal"
+
+	"github.com/terracefi/field"
+	"github.com/terracefi/fix44"
+	"github.com/terracefi/quickfix"
+	"github.com/terracefi/tag"
+)
+
+//UnderlyingInstrument is the fix44 UnderlyingInstrument component
+type UnderlyingInstrument struct {
+	*quickfix.FieldMap
+}
+
+//NewUnderlyingInstrument returns a new, empty UnderlyingInstrument component
+func NewUnderlyingInstrument() UnderlyingInstrument {
+	return UnderlyingInstrument{&quickfix.NewMessage().Body.FieldMap}
+}
+
+//CopyInto copies the UnderlyingInstrument fields present in m into fm
+func (m UnderlyingInstrument) CopyInto(fm *quickfix.FieldMap) quickfix.MessageRejectError {
+	return copyFields(m.FieldMap, fm, underlyingInstrumentTags, NewNoUnderlyingSecurityAltIDRepeatingGroup(), NewNoUnderlyingStipsRepeatingGroup())
+}
+
+//SetUnderlyingSymbol sets UnderlyingSymbol, Tag 311
+func (m UnderlyingInstrument) SetUnderlyingSymbol(v string) {
+	m.Set(field.NewUnderlyingSymbol(v))
+}
+
+//SetUnderlyingSymbolSfx sets UnderlyingSymbolSfx, Tag 312
+func (m UnderlyingInstrument) SetUnderlyingSymbolSfx(v string) {
+	m.Set(field.NewUnderlyingSymbolSfx(v))
+}
+
+//SetUnderlyingSecurityID sets UnderlyingSecurityID, Tag 309
+func (m UnderlyingInstrument) SetUnderlyingSecurityID(v string) {
+	m.Set(field.NewUnderlyingSecurityID(v))
+}
+
+//SetUnderlyingSecurityIDSource sets UnderlyingSecurityIDSource, Tag 305
+func (m UnderlyingInstrument) SetUnderlyingSecurityIDSource(v string) {
+	m.Set(field.NewUnderlyingSecurityIDSource(v))
+}
+
+//SetNoUnderlyingSecurityAltID sets NoUnderlyingSecurityAltID, Tag 457
+func (m UnderlyingInstrument) SetNoUnderlyingSecurityAltID(f NoUnderlyingSecurityAltIDRepeatingGroup) {
+	m.SetGroup(f)
+}
+
+//SetUnderlyingProduct sets UnderlyingProduct, Tag 462
+func (m UnderlyingInstrument) SetUnderlyingProduct(v int) {
+	m.Set(field.NewUnderlyingProduct(v))
+}
+
+//SetUnderlyingCFICode sets UnderlyingCFICode, Tag 463
+func (m UnderlyingInstrument) SetUnderlyingCFICode(v string) {
+	m.Set(field.NewUnderlyingCFICode(v))
+}
+
+//SetUnderlyingSecurityType sets UnderlyingSecurityType, Tag 310
+func (m UnderlyingInstrument) SetUnderlyingSecurityType(v string) {
+	m.Set(field.NewUnderlyingSecurityType(v))
+}
+
+//SetUnderlyingSecuritySubType sets UnderlyingSecuritySubType, Tag 763
+func (m UnderlyingInstrument) SetUnderlyingSecuritySubType(v string) {
+	m.Set(field.NewUnderlyingSecuritySubType(v))
+}
+
+//SetUnderlyingMaturityMonthYear sets UnderlyingMaturityMonthYear, Tag 313
+func (m UnderlyingInstrument) SetUnderlyingMaturityMonthYear(v string) {
+	m.Set(field.NewUnderlyingMaturityMonthYear(v))
+}
+
+//SetUnderlyingMaturityDate sets UnderlyingMaturityDate, Tag 542
+func (m UnderlyingInstrument) SetUnderlyingMaturityDate(v string) {
+	m.Set(field.NewUnderlyingMaturityDate(v))
+}
+
+//SetUnderlyingCouponPaymentDate sets UnderlyingCouponPaymentDate, Tag 241
+func (m UnderlyingInstrument) SetUnderlyingCouponPaymentDate(v string) {
+	m.Set(field.NewUnderlyingCouponPaymentDate(v))
+}
+
+//SetUnderlyingIssueDate sets UnderlyingIssueDate, Tag 242
+func (m UnderlyingInstrument) SetUnderlyingIssueDate(v string) {
+	m.Set(field.NewUnderlyingIssueDate(v))
+}
+
+//SetUnderlyingRepoCollateralSecurityType sets UnderlyingRepoCollateralSecurityType, Tag 243
+func (m UnderlyingInstrument) SetUnderlyingRepoCollateralSecurityType(v int) {
+	m.Set(field.NewUnderlyingRepoCollateralSecurityType(v))
+}
+
+//SetUnderlyingRepurchaseTerm sets UnderlyingRepurchaseTerm, Tag 244
+func (m UnderlyingInstrument) SetUnderlyingRepurchaseTerm(v int) {
+	m.Set(field.NewUnderlyingRepurchaseTerm(v))
+}
+
+//SetUnderlyingRepurchaseRate sets UnderlyingRepurchaseRate, Tag 245
+func (m UnderlyingInstrument) SetUnderlyingRepurchaseRate(value decimal.Decimal, scale int32) {
+	m.Set(field.NewUnderlyingRepurchaseRate(value, scale))
+}
+
+//SetUnderlyingFactor sets UnderlyingFactor, Tag 246
+func (m UnderlyingInstrument) SetUnderlyingFactor(value decimal.Decimal, scale int32) {
+	m.Set(field.NewUnderlyingFactor(value, scale))
+}
+
+//SetUnderlyingCreditRating sets UnderlyingCreditRating, Tag 256
+func (m UnderlyingInstrument) SetUnderlyingCreditRating(v string) {
+	m.Set(field.NewUnderlyingCreditRating(v))
+}
+
+//SetUnderlyingInstrRegistry sets UnderlyingInstrRegistry, Tag 595
+func (m UnderlyingInstrument) SetUnderlyingInstrRegistry(v string) {
+	m.Set(field.NewUnderlyingInstrRegistry(v))
+}
+
+//SetUnderlyingCountryOfIssue sets UnderlyingCountryOfIssue, Tag 592
+func (m UnderlyingInstrument) SetUnderlyingCountryOfIssue(v string) {
+	m.Set(field.NewUnderlyingCountryOfIssue(v))
+}
+
+//SetUnderlyingStateOrProvinceOfIssue sets UnderlyingStateOrProvinceOfIssue, Tag 593
+func (m UnderlyingInstrument) SetUnderlyingStateOrProvinceOfIssue(v string) {
+	m.Set(field.NewUnderlyingStateOrProvinceOfIssue(v))
+}
+
+//SetUnderlyingLocaleOfIssue sets UnderlyingLocaleOfIssue, Tag 594
+func (m UnderlyingInstrument) SetUnderlyingLocaleOfIssue(v string) {
+	m.Set(field.NewUnderlyingLocaleOfIssue(v))
+}
+
+//SetUnderlyingRedemptionDate sets UnderlyingRedemptionDate, Tag 247
+func (m UnderlyingInstrument) SetUnderlyingRedemptionDate(v string) {
+	m.Set(field.NewUnderlyingRedemptionDate(v))
+}
+
+//SetUnderlyingStrikePrice sets UnderlyingStrikePrice, Tag 316
+func (m UnderlyingInstrument) SetUnderlyingStrikePrice(value decimal.Decimal, scale int32) {
+	m.Set(field.NewUnderlyingStrikePrice(value, scale))
+}
+
+//SetUnderlyingStrikeCurrency sets UnderlyingStrikeCurrency, Tag 941
+func (m UnderlyingInstrument) SetUnderlyingStrikeCurrency(v string) {
+	m.Set(field.NewUnderlyingStrikeCurrency(v))
+}
+
+//SetUnderlyingOptAttribute sets UnderlyingOptAttribute, Tag 317
+func (m UnderlyingInstrument) SetUnderlyingOptAttribute(v string) {
+	m.Set(field.NewUnderlyingOptAttribute(v))
+}
+
+//SetUnderlyingContractMultiplier sets UnderlyingContractMultiplier, Tag 436
+func (m UnderlyingInstrument) SetUnderlyingContractMultiplier(value decimal.Decimal, scale int32) {
+	m.Set(field.NewUnderlyingContractMultiplier(value, scale))
+}
+
+//SetUnderlyingCouponRate sets UnderlyingCouponRate, Tag 435
+func (m UnderlyingInstrument) SetUnderlyingCouponRate(value decimal.Decimal, scale int32) {
+	m.Set(field.NewUnderlyingCouponRate(value, scale))
+}
+
+//SetUnderlyingSecurityExchange sets UnderlyingSecurityExchange, Tag 308
+func (m UnderlyingInstrument) SetUnderlyingSecurityExchange(v string) {
+	m.Set(field.NewUnderlyingSecurityExchange(v))
+}
+
+//SetUnderlyingIssuer sets UnderlyingIssuer, Tag 306
+func (m UnderlyingInstrument) SetUnderlyingIssuer(v string) {
+	m.Set(field.NewUnderlyingIssuer(v))
+}
+
+//SetEncodedUnderlyingIssuerLen sets EncodedUnderlyingIssuerLen, Tag 362
+func (m UnderlyingInstrument) SetEncodedUnderlyingIssuerLen(v int) {
+	m.Set(field.NewEncodedUnderlyingIssuerLen(v))
+}
+
+//SetEncodedUnderlyingIssuer sets EncodedUnderlyingIssuer, Tag 363
+func (m UnderlyingInstrument) SetEncodedUnderlyingIssuer(v string) {
+	m.Set(field.NewEncodedUnderlyingIssuer(v))
+}
+
+//SetUnderlyingSecurityDesc sets UnderlyingSecurityDesc, Tag 307
+func (m UnderlyingInstrument) SetUnderlyingSecurityDesc(v string) {
+	m.Set(field.NewUnderlyingSecurityDesc(v))
+}
+
+//SetEncodedUnderlyingSecurityDescLen sets EncodedUnderlyingSecurityDescLen, Tag 364
+func (m UnderlyingInstrument) SetEncodedUnderlyingSecurityDescLen(v int) {
+	m.Set(field.NewEncodedUnderlyingSecurityDescLen(v))
+}
+
+//SetEncodedUnderlyingSecurityDesc sets EncodedUnderlyingSecurityDesc, Tag 365
+func (m UnderlyingInstrument) SetEncodedUnderlyingSecurityDesc(v string) {
+	m.Set(field.NewEncodedUnderlyingSecurityDesc(v))
+}
+
+//SetUnderlyingCPProgram sets UnderlyingCPProgram, Tag 877
+func (m UnderlyingInstrument) SetUnderlyingCPProgram(v string) {
+	m.Set(field.NewUnderlyingCPProgram(v))
+}
+
+//SetUnderlyingCPRegType sets UnderlyingCPRegType, Tag 878
+func (m UnderlyingInstrument) SetUnderlyingCPRegType(v string) {
+	m.Set(field.NewUnderlyingCPRegType(v))
+}
+
+//SetUnderlyingCurrency sets UnderlyingCurrency, Tag 318
+func (m UnderlyingInstrument) SetUnderlyingCurrency(v string) {
+	m.Set(field.NewUnderlyingCurrency(v))
+}
+
+//SetUnderlyingQty sets UnderlyingQty, Tag 879
+func (m UnderlyingInstrument) SetUnderlyingQty(value decimal.Decimal, scale int32) {
+	m.Set(field.NewUnderlyingQty(value, scale))
+}
+
+//SetUnderlyingPx sets UnderlyingPx, Tag 810
+func (m UnderlyingInstrument) SetUnderlyingPx(value decimal.Decimal, scale int32) {
+	m.Set(field.NewUnderlyingPx(value, scale))
+}
+
+//SetUnderlyingDirtyPrice sets UnderlyingDirtyPrice, Tag 882
+func (m UnderlyingInstrument) SetUnderlyingDirtyPrice(value decimal.Decimal, scale int32) {
+	m.Set(field.NewUnderlyingDirtyPrice(value, scale))
+}
+
+//SetUnderlyingEndPrice sets UnderlyingEndPrice, Tag 883
+func (m UnderlyingInstrument) SetUnderlyingEndPrice(value decimal.Decimal, scale int32) {
+	m.Set(field.NewUnderlyingEndPrice(value, scale))
+}
+
+//SetUnderlyingStartValue sets UnderlyingStartValue, Tag 884
+func (m UnderlyingInstrument) SetUnderlyingStartValue(value decimal.Decimal, scale int32) {
+	m.Set(field.NewUnderlyingStartValue(value, scale))
+}
+
+//SetUnderlyingCurrentValue sets UnderlyingCurrentValue, Tag 885
+func (m UnderlyingInstrument) SetUnderlyingCurrentValue(value decimal.Decimal, scale int32) {
+	m.Set(field.NewUnderlyingCurrentValue(value, scale))
+}
+
+//SetUnderlyingEndValue sets UnderlyingEndValue, Tag 886
+func (m UnderlyingInstrument) SetUnderlyingEndValue(value decimal.Decimal, scale int32) {
+	m.Set(field.NewUnderlyingEndValue(value, scale))
+}
+
+//SetNoUnderlyingStips sets NoUnderlyingStips, Tag 887
+func (m UnderlyingInstrument) SetNoUnderlyingStips(f NoUnderlyingStipsRepeatingGroup) {
+	m.SetGroup(f)
+}
+
+//GetUnderlyingSymbol gets UnderlyingSymbol, Tag 311
+func (m UnderlyingInstrument) GetUnderlyingSymbol() (v string, err quickfix.MessageRejectError) {
+	var f field.UnderlyingSymbolField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetUnderlyingSymbolSfx gets UnderlyingSymbolSfx, Tag 312
+func (m UnderlyingInstrument) GetUnderlyingSymbolSfx() (v string, err quickfix.MessageRejectError) {
+	var f field.UnderlyingSymbolSfxField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetUnderlyingSecurityID gets UnderlyingSecurityID, Tag 309
+func (m UnderlyingInstrument) GetUnderlyingSecurityID() (v string, err quickfix.MessageRejectError) {
+	var f field.UnderlyingSecurityIDField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetUnderlyingSecurityIDSource gets UnderlyingSecurityIDSource, Tag 305
+func (m UnderlyingInstrument) GetUnderlyingSecurityIDSource() (v string, err quickfix.MessageRejectError) {
+	var f field.UnderlyingSecurityIDSourceField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetNoUnderlyingSecurityAltID gets NoUnderlyingSecurityAltID, Tag 457
+func (m UnderlyingInstrument) GetNoUnderlyingSecurityAltID() (NoUnderlyingSecurityAltIDRepeatingGroup, quickfix.MessageRejectError) {
+	f := NewNoUnderlyingSecurityAltIDRepeatingGroup()
+	err := m.GetGroup(f)
+	return f, err
+}
+
+//GetUnderlyingProduct gets UnderlyingProduct, Tag 462
+func (m UnderlyingInstrument) GetUnderlyingProduct() (v int, err quickfix.MessageRejectError) {
+	var f field.UnderlyingProductField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetUnderlyingCFICode gets UnderlyingCFICode, Tag 463
+func (m UnderlyingInstrument) GetUnderlyingCFICode() (v string, err quickfix.MessageRejectError) {
+	var f field.UnderlyingCFICodeField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetUnderlyingSecurityType gets UnderlyingSecurityType, Tag 310
+func (m UnderlyingInstrument) GetUnderlyingSecurityType() (v string, err quickfix.MessageRejectError) {
+	var f field.UnderlyingSecurityTypeField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetUnderlyingSecuritySubType gets UnderlyingSecuritySubType, Tag 763
+func (m UnderlyingInstrument) GetUnderlyingSecuritySubType() (v string, err quickfix.MessageRejectError) {
+	var f field.UnderlyingSecuritySubTypeField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetUnderlyingMaturityMonthYear gets UnderlyingMaturityMonthYear, Tag 313
+func (m UnderlyingInstrument) GetUnderlyingMaturityMonthYear() (v string, err quickfix.MessageRejectError) {
+	var f field.UnderlyingMaturityMonthYearField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetUnderlyingMaturityDate gets UnderlyingMaturityDate, Tag 542
+func (m UnderlyingInstrument) GetUnderlyingMaturityDate() (v string, err quickfix.MessageRejectError) {
+	var f field.UnderlyingMaturityDateField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetUnderlyingCouponPaymentDate gets UnderlyingCouponPaymentDate, Tag 241
+func (m UnderlyingInstrument) GetUnderlyingCouponPaymentDate() (v string, err quickfix.MessageRejectError) {
+	var f field.UnderlyingCouponPaymentDateField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetUnderlyingIssueDate gets UnderlyingIssueDate, Tag 242
+func (m UnderlyingInstrument) GetUnderlyingIssueDate() (v string, err quickfix.MessageRejectError) {
+	var f field.UnderlyingIssueDateField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetUnderlyingRepoCollateralSecurityType gets UnderlyingRepoCollateralSecurityType, Tag 243
+func (m UnderlyingInstrument) GetUnderlyingRepoCollateralSecurityType() (v int, err quickfix.MessageRejectError) {
+	var f field.UnderlyingRepoCollateralSecurityTypeField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetUnderlyingRepurchaseTerm gets UnderlyingRepurchaseTerm, Tag 244
+func (m UnderlyingInstrument) GetUnderlyingRepurchaseTerm() (v int, err quickfix.MessageRejectError) {
+	var f field.UnderlyingRepurchaseTermField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetUnderlyingRepurchaseRate gets UnderlyingRepurchaseRate, Tag 245
+func (m UnderlyingInstrument) GetUnderlyingRepurchaseRate() (v decimal.Decimal, err quickfix.MessageRejectError) {
+	var f field.UnderlyingRepurchaseRateField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetUnderlyingFactor gets UnderlyingFactor, Tag 246
+func (m UnderlyingInstrument) GetUnderlyingFactor() (v decimal.Decimal, err quickfix.MessageRejectError) {
+	var f field.UnderlyingFactorField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetUnderlyingCreditRating gets UnderlyingCreditRating, Tag 256
+func (m UnderlyingInstrument) GetUnderlyingCreditRating() (v string, err quickfix.MessageRejectError) {
+	var f field.UnderlyingCreditRatingField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetUnderlyingInstrRegistry gets UnderlyingInstrRegistry, Tag 595
+func (m UnderlyingInstrument) GetUnderlyingInstrRegistry() (v string, err quickfix.MessageRejectError) {
+	var f field.UnderlyingInstrRegistryField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetUnderlyingCountryOfIssue gets UnderlyingCountryOfIssue, Tag 592
+func (m UnderlyingInstrument) GetUnderlyingCountryOfIssue() (v string, err quickfix.MessageRejectError) {
+	var f field.UnderlyingCountryOfIssueField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetUnderlyingStateOrProvinceOfIssue gets UnderlyingStateOrProvinceOfIssue, Tag 593
+func (m UnderlyingInstrument) GetUnderlyingStateOrProvinceOfIssue() (v string, err quickfix.MessageRejectError) {
+	var f field.UnderlyingStateOrProvinceOfIssueField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetUnderlyingLocaleOfIssue gets UnderlyingLocaleOfIssue, Tag 594
+func (m UnderlyingInstrument) GetUnderlyingLocaleOfIssue() (v string, err quickfix.MessageRejectError) {
+	var f field.UnderlyingLocaleOfIssueField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetUnderlyingRedemptionDate gets UnderlyingRedemptionDate, Tag 247
+func (m UnderlyingInstrument) GetUnderlyingRedemptionDate() (v string, err quickfix.MessageRejectError) {
+	var f field.UnderlyingRedemptionDateField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetUnderlyingStrikePrice gets UnderlyingStrikePrice, Tag 316
+func (m UnderlyingInstrument) GetUnderlyingStrikePrice() (v decimal.Decimal, err quickfix.MessageRejectError) {
+	var f field.UnderlyingStrikePriceField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetUnderlyingStrikeCurrency gets UnderlyingStrikeCurrency, Tag 941
+func (m UnderlyingInstrument) GetUnderlyingStrikeCurrency() (v string, err quickfix.MessageRejectError) {
+	var f field.UnderlyingStrikeCurrencyField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetUnderlyingOptAttribute gets UnderlyingOptAttribute, Tag 317
+func (m UnderlyingInstrument) GetUnderlyingOptAttribute() (v string, err quickfix.MessageRejectError) {
+	var f field.UnderlyingOptAttributeField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetUnderlyingContractMultiplier gets UnderlyingContractMultiplier, Tag 436
+func (m UnderlyingInstrument) GetUnderlyingContractMultiplier() (v decimal.Decimal, err quickfix.MessageRejectError) {
+	var f field.UnderlyingContractMultiplierField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetUnderlyingCouponRate gets UnderlyingCouponRate, Tag 435
+func (m UnderlyingInstrument) GetUnderlyingCouponRate() (v decimal.Decimal, err quickfix.MessageRejectError) {
+	var f field.UnderlyingCouponRateField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetUnderlyingSecurityExchange gets UnderlyingSecurityExchange, Tag 308
+func (m UnderlyingInstrument) GetUnderlyingSecurityExchange() (v string, err quickfix.MessageRejectError) {
+	var f field.UnderlyingSecurityExchangeField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetUnderlyingIssuer gets UnderlyingIssuer, Tag 306
+func (m UnderlyingInstrument) GetUnderlyingIssuer() (v string, err quickfix.MessageRejectError) {
+	var f field.UnderlyingIssuerField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetEncodedUnderlyingIssuerLen gets EncodedUnderlyingIssuerLen, Tag 362
+func (m UnderlyingInstrument) GetEncodedUnderlyingIssuerLen() (v int, err quickfix.MessageRejectError) {
+	var f field.EncodedUnderlyingIssuerLenField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetEncodedUnderlyingIssuer gets EncodedUnderlyingIssuer, Tag 363
+func (m UnderlyingInstrument) GetEncodedUnderlyingIssuer() (v string, err quickfix.MessageRejectError) {
+	var f field.EncodedUnderlyingIssuerField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetUnderlyingSecurityDesc gets UnderlyingSecurityDesc, Tag 307
+func (m UnderlyingInstrument) GetUnderlyingSecurityDesc() (v string, err quickfix.MessageRejectError) {
+	var f field.UnderlyingSecurityDescField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetEncodedUnderlyingSecurityDescLen gets EncodedUnderlyingSecurityDescLen, Tag 364
+func (m UnderlyingInstrument) GetEncodedUnderlyingSecurityDescLen() (v int, err quickfix.MessageRejectError) {
+	var f field.EncodedUnderlyingSecurityDescLenField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetEncodedUnderlyingSecurityDesc gets EncodedUnderlyingSecurityDesc, Tag 365
+func (m UnderlyingInstrument) GetEncodedUnderlyingSecurityDesc() (v string, err quickfix.MessageRejectError) {
+	var f field.EncodedUnderlyingSecurityDescField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetUnderlyingCPProgram gets UnderlyingCPProgram, Tag 877
+func (m UnderlyingInstrument) GetUnderlyingCPProgram() (v string, err quickfix.MessageRejectError) {
+	var f field.UnderlyingCPProgramField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetUnderlyingCPRegType gets UnderlyingCPRegType, Tag 878
+func (m UnderlyingInstrument) GetUnderlyingCPRegType() (v string, err quickfix.MessageRejectError) {
+	var f field.UnderlyingCPRegTypeField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetUnderlyingCurrency gets UnderlyingCurrency, Tag 318
+func (m UnderlyingInstrument) GetUnderlyingCurrency() (v string, err quickfix.MessageRejectError) {
+	var f field.UnderlyingCurrencyField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetUnderlyingQty gets UnderlyingQty, Tag 879
+func (m UnderlyingInstrument) GetUnderlyingQty() (v decimal.Decimal, err quickfix.MessageRejectError) {
+	var f field.UnderlyingQtyField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetUnderlyingPx gets UnderlyingPx, Tag 810
+func (m UnderlyingInstrument) GetUnderlyingPx() (v decimal.Decimal, err quickfix.MessageRejectError) {
+	var f field.UnderlyingPxField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetUnderlyingDirtyPrice gets UnderlyingDirtyPrice, Tag 882
+func (m UnderlyingInstrument) GetUnderlyingDirtyPrice() (v decimal.Decimal, err quickfix.MessageRejectError) {
+	var f field.UnderlyingDirtyPriceField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetUnderlyingEndPrice gets UnderlyingEndPrice, Tag 883
+func (m UnderlyingInstrument) GetUnderlyingEndPrice() (v decimal.Decimal, err quickfix.MessageRejectError) {
+	var f field.UnderlyingEndPriceField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetUnderlyingStartValue gets UnderlyingStartValue, Tag 884
+func (m UnderlyingInstrument) GetUnderlyingStartValue() (v decimal.Decimal, err quickfix.MessageRejectError) {
+	var f field.UnderlyingStartValueField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetUnderlyingCurrentValue gets UnderlyingCurrentValue, Tag 885
+func (m UnderlyingInstrument) GetUnderlyingCurrentValue() (v decimal.Decimal, err quickfix.MessageRejectError) {
+	var f field.UnderlyingCurrentValueField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetUnderlyingEndValue gets UnderlyingEndValue, Tag 886
+func (m UnderlyingInstrument) GetUnderlyingEndValue() (v decimal.Decimal, err quickfix.MessageRejectError) {
+	var f field.UnderlyingEndValueField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetNoUnderlyingStips gets NoUnderlyingStips, Tag 887
+func (m UnderlyingInstrument) GetNoUnderlyingStips() (NoUnderlyingStipsRepeatingGroup, quickfix.MessageRejectError) {
+	f := NewNoUnderlyingStipsRepeatingGroup()
+	err := m.GetGroup(f)
+	return f, err
+}
+
+//HasUnderlyingSymbol returns true if UnderlyingSymbol is present, Tag 311
+func (m UnderlyingInstrument) HasUnderlyingSymbol() bool {
+	return m.Has(tag.UnderlyingSymbol)
+}
+
+//HasUnderlyingSymbolSfx returns true if UnderlyingSymbolSfx is present, Tag 312
+func (m UnderlyingInstrument) HasUnderlyingSymbolSfx() bool {
+	return m.Has(tag.UnderlyingSymbolSfx)
+}
+
+//HasUnderlyingSecurityID returns true if UnderlyingSecurityID is present, Tag 309
+func (m UnderlyingInstrument) HasUnderlyingSecurityID() bool {
+	return m.Has(tag.UnderlyingSecurityID)
+}
+
+//HasUnderlyingSecurityIDSource returns true if UnderlyingSecurityIDSource is present, Tag 305
+func (m UnderlyingInstrument) HasUnderlyingSecurityIDSource() bool {
+	return m.Has(tag.UnderlyingSecurityIDSource)
+}
+
+//HasNoUnderlyingSecurityAltID returns true if NoUnderlyingSecurityAltID is present, Tag 457
+func (m UnderlyingInstrument) HasNoUnderlyingSecurityAltID() bool {
+	return m.Has(tag.NoUnderlyingSecurityAltID)
+}
+
+//HasUnderlyingProduct returns true if UnderlyingProduct is present, Tag 462
+func (m UnderlyingInstrument) HasUnderlyingProduct() bool {
+	return m.Has(tag.UnderlyingProduct)
+}
+
+//HasUnderlyingCFICode returns true if UnderlyingCFICode is present, Tag 463
+func (m UnderlyingInstrument) HasUnderlyingCFICode() bool {
+	return m.Has(tag.UnderlyingCFICode)
+}
+
+//HasUnderlyingSecurityType returns true if UnderlyingSecurityType is present, Tag 310
+func (m UnderlyingInstrument) HasUnderlyingSecurityType() bool {
+	return m.Has(tag.UnderlyingSecurityType)
+}
+
+//HasUnderlyingSecuritySubType returns true if UnderlyingSecuritySubType is present, Tag 763
+func (m UnderlyingInstrument) HasUnderlyingSecuritySubType() bool {
+	return m.Has(tag.UnderlyingSecuritySubType)
+}
+
+//HasUnderlyingMaturityMonthYear returns true if UnderlyingMaturityMonthYear is present, Tag 313
+func (m UnderlyingInstrument) HasUnderlyingMaturityMonthYear() bool {
+	return m.Has(tag.UnderlyingMaturityMonthYear)
+}
+
+//HasUnderlyingMaturityDate returns true if UnderlyingMaturityDate is present, Tag 542
+func (m UnderlyingInstrument) HasUnderlyingMaturityDate() bool {
+	return m.Has(tag.UnderlyingMaturityDate)
+}
+
+//HasUnderlyingCouponPaymentDate returns true if UnderlyingCouponPaymentDate is present, Tag 241
+func (m UnderlyingInstrument) HasUnderlyingCouponPaymentDate() bool {
+	return m.Has(tag.UnderlyingCouponPaymentDate)
+}
+
+//HasUnderlyingIssueDate returns true if UnderlyingIssueDate is present, Tag 242
+func (m UnderlyingInstrument) HasUnderlyingIssueDate() bool {
+	return m.Has(tag.UnderlyingIssueDate)
+}
+
+//HasUnderlyingRepoCollateralSecurityType returns true if UnderlyingRepoCollateralSecurityType is present, Tag 243
+func (m UnderlyingInstrument) HasUnderlyingRepoCollateralSecurityType() bool {
+	return m.Has(tag.UnderlyingRepoCollateralSecurityType)
+}
+
+//HasUnderlyingRepurchaseTerm returns true if UnderlyingRepurchaseTerm is present, Tag 244
+func (m UnderlyingInstrument) HasUnderlyingRepurchaseTerm() bool {
+	return m.Has(tag.UnderlyingRepurchaseTerm)
+}
+
+//HasUnderlyingRepurchaseRate returns true if UnderlyingRepurchaseRate is present, Tag 245
+func (m UnderlyingInstrument) HasUnderlyingRepurchaseRate() bool {
+	return m.Has(tag.UnderlyingRepurchaseRate)
+}
+
+//HasUnderlyingFactor returns true if UnderlyingFactor is present, Tag 246
+func (m UnderlyingInstrument) HasUnderlyingFactor() bool {
+	return m.Has(tag.UnderlyingFactor)
+}
+
+//HasUnderlyingCreditRating returns true if UnderlyingCreditRating is present, Tag 256
+func (m UnderlyingInstrument) HasUnderlyingCreditRating() bool {
+	return m.Has(tag.UnderlyingCreditRating)
+}
+
+//HasUnderlyingInstrRegistry returns true if UnderlyingInstrRegistry is present, Tag 595
+func (m UnderlyingInstrument) HasUnderlyingInstrRegistry() bool {
+	return m.Has(tag.UnderlyingInstrRegistry)
+}
+
+//HasUnderlyingCountryOfIssue returns true if UnderlyingCountryOfIssue is present, Tag 592
+func (m UnderlyingInstrument) HasUnderlyingCountryOfIssue() bool {
+	return m.Has(tag.UnderlyingCountryOfIssue)
+}
+
+//HasUnderlyingStateOrProvinceOfIssue returns true if UnderlyingStateOrProvinceOfIssue is present, Tag 593
+func (m UnderlyingInstrument) HasUnderlyingStateOrProvinceOfIssue() bool {
+	return m.Has(tag.UnderlyingStateOrProvinceOfIssue)
+}
+
+//HasUnderlyingLocaleOfIssue returns true if UnderlyingLocaleOfIssue is present, Tag 594
+func (m UnderlyingInstrument) HasUnderlyingLocaleOfIssue() bool {
+	return m.Has(tag.UnderlyingLocaleOfIssue)
+}
+
+//HasUnderlyingRedemptionDate returns true if UnderlyingRedemptionDate is present, Tag 247
+func (m UnderlyingInstrument) HasUnderlyingRedemptionDate() bool {
+	return m.Has(tag.UnderlyingRedemptionDate)
+}
+
+//HasUnderlyingStrikePrice returns true if UnderlyingStrikePrice is present, Tag 316
+func (m UnderlyingInstrument) HasUnderlyingStrikePrice() bool {
+	return m.Has(tag.UnderlyingStrikePrice)
+}
+
+//HasUnderlyingStrikeCurrency returns true if UnderlyingStrikeCurrency is present, Tag 941
+func (m UnderlyingInstrument) HasUnderlyingStrikeCurrency() bool {
+	return m.Has(tag.UnderlyingStrikeCurrency)
+}
+
+//HasUnderlyingOptAttribute returns true if UnderlyingOptAttribute is present, Tag 317
+func (m UnderlyingInstrument) HasUnderlyingOptAttribute() bool {
+	return m.Has(tag.UnderlyingOptAttribute)
+}
+
+//HasUnderlyingContractMultiplier returns true if UnderlyingContractMultiplier is present, Tag 436
+func (m UnderlyingInstrument) HasUnderlyingContractMultiplier() bool {
+	return m.Has(tag.UnderlyingContractMultiplier)
+}
+
+//HasUnderlyingCouponRate returns true if UnderlyingCouponRate is present, Tag 435
+func (m UnderlyingInstrument) HasUnderlyingCouponRate() bool {
+	return m.Has(tag.UnderlyingCouponRate)
+}
+
+//HasUnderlyingSecurityExchange returns true if UnderlyingSecurityExchange is present, Tag 308
+func (m UnderlyingInstrument) HasUnderlyingSecurityExchange() bool {
+	return m.Has(tag.UnderlyingSecurityExchange)
+}
+
+//HasUnderlyingIssuer returns true if UnderlyingIssuer is present, Tag 306
+func (m UnderlyingInstrument) HasUnderlyingIssuer() bool {
+	return m.Has(tag.UnderlyingIssuer)
+}
+
+//HasEncodedUnderlyingIssuerLen returns true if EncodedUnderlyingIssuerLen is present, Tag 362
+func (m UnderlyingInstrument) HasEncodedUnderlyingIssuerLen() bool {
+	return m.Has(tag.EncodedUnderlyingIssuerLen)
+}
+
+//HasEncodedUnderlyingIssuer returns true if EncodedUnderlyingIssuer is present, Tag 363
+func (m UnderlyingInstrument) HasEncodedUnderlyingIssuer() bool {
+	return m.Has(tag.EncodedUnderlyingIssuer)
+}
+
+//SetEncodedUnderlyingIssuerBytes sets EncodedUnderlyingIssuer, Tag 363, and EncodedUnderlyingIssuerLen, Tag 362, from v, which may hold any bytes including SOH
+func (m UnderlyingInstrument) SetEncodedUnderlyingIssuerBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedUnderlyingIssuerLen, tag.EncodedUnderlyingIssuer, v)
+}
+
+//GetEncodedUnderlyingIssuerBytes gets EncodedUnderlyingIssuer, Tag 363, checking its length against EncodedUnderlyingIssuerLen, Tag 362
+func (m UnderlyingInstrument) GetEncodedUnderlyingIssuerBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedUnderlyingIssuerLen, tag.EncodedUnderlyingIssuer)
+}
+
+//GetEncodedUnderlyingIssuerDecoded gets EncodedUnderlyingIssuer, Tag 363, decoded to UTF-8 using the MessageEncoding of h, the Header of the message holding it
+func (m UnderlyingInstrument) GetEncodedUnderlyingIssuerDecoded(h fix44.Header) (string, error) {
+	return fix44.DecodeRawData(h, m, tag.EncodedUnderlyingIssuerLen, tag.EncodedUnderlyingIssuer)
+}
+
+//HasUnderlyingSecurityDesc returns true if UnderlyingSecurityDesc is present, Tag 307
+func (m UnderlyingInstrument) HasUnderlyingSecurityDesc() bool {
+	return m.Has(tag.UnderlyingSecurityDesc)
+}
+
+//HasEncodedUnderlyingSecurityDescLen returns true if EncodedUnderlyingSecurityDescLen is present, Tag 364
+func (m UnderlyingInstrument) HasEncodedUnderlyingSecurityDescLen() bool {
+	return m.Has(tag.EncodedUnderlyingSecurityDescLen)
+}
+
+//HasEncodedUnderlyingSecurityDesc returns true if EncodedUnderlyingSecurityDesc is present, Tag 365
+func (m UnderlyingInstrument) HasEncodedUnderlyingSecurityDesc() bool {
+	return m.Has(tag.EncodedUnderlyingSecurityDesc)
+}
+
+//SetEncodedUnderlyingSecurityDescBytes sets EncodedUnderlyingSecurityDesc, Tag 365, and EncodedUnderlyingSecurityDescLen, Tag 364, from v, which may hold any bytes including SOH
+func (m UnderlyingInstrument) SetEncodedUnderlyingSecurityDescBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedUnderlyingSecurityDescLen, tag.EncodedUnderlyingSecurityDesc, v)
+}
+
+//GetEncodedUnderlyingSecurityDescBytes gets EncodedUnderlyingSecurityDesc, Tag 365, checking its length against EncodedUnderlyingSecurityDescLen, Tag 364
+func (m UnderlyingInstrument) GetEncodedUnderlyingSecurityDescBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedUnderlyingSecurityDescLen, tag.EncodedUnderlyingSecurityDesc)
+}
+
+//GetEncodedUnderlyingSecurityDescDecoded gets EncodedUnderlyingSecurityDesc, Tag 365, decoded to UTF-8 using the MessageEncoding of h, the Header of the message holding it
+func (m UnderlyingInstrument) GetEncodedUnderlyingSecurityDescDecoded(h fix44.Header) (string, error) {
+	return fix44.DecodeRawData(h, m, tag.EncodedUnderlyingSecurityDescLen, tag.EncodedUnderlyingSecurityDesc)
+}
+
+//HasUnderlyingCPProgram returns true if UnderlyingCPProgram is present, Tag 877
+func (m UnderlyingInstrument) HasUnderlyingCPProgram() bool {
+	return m.Has(tag.UnderlyingCPProgram)
+}
+
+//HasUnderlyingCPRegType returns true if UnderlyingCPRegType is present, Tag 878
+func (m UnderlyingInstrument) HasUnderlyingCPRegType() bool {
+	return m.Has(tag.UnderlyingCPRegType)
+}
+
+//HasUnderlyingCurrency returns true if UnderlyingCurrency is present, Tag 318
+func (m UnderlyingInstrument) HasUnderlyingCurrency() bool {
+	return m.Has(tag.UnderlyingCurrency)
+}
+
+//HasUnderlyingQty returns true if UnderlyingQty is present, Tag 879
+func (m UnderlyingInstrument) HasUnderlyingQty() bool {
+	return m.Has(tag.UnderlyingQty)
+}
+
+//HasUnderlyingPx returns true if UnderlyingPx is present, Tag 810
+func (m UnderlyingInstrument) HasUnderlyingPx() bool {
+	return m.Has(tag.UnderlyingPx)
+}
+
+//HasUnderlyingDirtyPrice returns true if UnderlyingDirtyPrice is present, Tag 882
+func (m UnderlyingInstrument) HasUnderlyingDirtyPrice() bool {
+	return m.Has(tag.UnderlyingDirtyPrice)
+}
+
+//HasUnderlyingEndPrice returns true if UnderlyingEndPrice is present, Tag 883
+func (m UnderlyingInstrument) HasUnderlyingEndPrice() bool {
+	return m.Has(tag.UnderlyingEndPrice)
+}
+
+//HasUnderlyingStartValue returns true if UnderlyingStartValue is present, Tag 884
+func (m UnderlyingInstrument) HasUnderlyingStartValue() bool {
+	return m.Has(tag.UnderlyingStartValue)
+}
+
+//HasUnderlyingCurrentValue returns true if UnderlyingCurrentValue is present, Tag 885
+func (m UnderlyingInstrument) HasUnderlyingCurrentValue() bool {
+	return m.Has(tag.UnderlyingCurrentValue)
+}
+
+//HasUnderlyingEndValue returns true if UnderlyingEndValue is present, Tag 886
+func (m UnderlyingInstrument) HasUnderlyingEndValue() bool {
+	return m.Has(tag.UnderlyingEndValue)
+}
+
+//HasNoUnderlyingStips returns true if NoUnderlyingStips is present, Tag 887
+func (m UnderlyingInstrument) HasNoUnderlyingStips() bool {
+	return m.Has(tag.NoUnderlyingStips)
+}
+
+//NoUnderlyingSecurityAltID is a repeating group element, Tag 457
+type NoUnderlyingSecurityAltID struct {
+	*quickfix.Group
+}
+
+//SetUnderlyingSecurityAltID sets UnderlyingSecurityAltID, Tag 458
+func (m NoUnderlyingSecurityAltID) SetUnderlyingSecurityAltID(v string) {
+	m.Set(field.NewUnderlyingSecurityAltID(v))
+}
+
+//SetUnderlyingSecurityAltIDSource sets UnderlyingSecurityAltIDSource, Tag 459
+func (m NoUnderlyingSecurityAltID) SetUnderlyingSecurityAltIDSource(v string) {
+	m.Set(field.NewUnderlyingSecurityAltIDSource(v))
+}
+
+//GetUnderlyingSecurityAltID gets UnderlyingSecurityAltID, Tag 458
+func (m NoUnderlyingSecurityAltID) GetUnderlyingSecurityAltID() (v string, err quickfix.MessageRejectError) {
+	var f field.UnderlyingSecurityAltIDField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetUnderlyingSecurityAltIDSource gets UnderlyingSecurityAltIDSource, Tag 459
+func (m NoUnderlyingSecurityAltID) GetUnderlyingSecurityAltIDSource() (v string, err quickfix.MessageRejectError) {
+	var f field.UnderlyingSecurityAltIDSourceField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//HasUnderlyingSecurityAltID returns true if UnderlyingSecurityAltID is present, Tag 458
+func (m NoUnderlyingSecurityAltID) HasUnderlyingSecurityAltID() bool {
+	return m.Has(tag.UnderlyingSecurityAltID)
+}
+
+//HasUnderlyingSecurityAltIDSource returns true if UnderlyingSecurityAltIDSource is present, Tag 459
+func (m NoUnderlyingSecurityAltID) HasUnderlyingSecurityAltIDSource() bool {
+	return m.Has(tag.UnderlyingSecurityAltIDSource)
+}
+
+//NoUnderlyingSecurityAltIDRepeatingGroup is a repeating group, Tag 457
+type NoUnderlyingSecurityAltIDRepeatingGroup struct {
+	*quickfix.RepeatingGroup
+}
+
+//NewNoUnderlyingSecurityAltIDRepeatingGroup returns an initialized, NoUnderlyingSecurityAltIDRepeatingGroup
+func NewNoUnderlyingSecurityAltIDRepeatingGroup() NoUnderlyingSecurityAltIDRepeatingGroup {
+	return NoUnderlyingSecurityAltIDRepeatingGroup{
+		quickfix.NewRepeatingGroup(tag.NoUnderlyingSecurityAltID,
+			quickfix.GroupTemplate{quickfix.GroupElement(tag.UnderlyingSecurityAltID), quickfix.GroupElement(tag.UnderlyingSecurityAltIDSource)})}
+}
+
+//Add create and append a new NoUnderlyingSecurityAltID to this group
+func (m NoUnderlyingSecurityAltIDRepeatingGroup) Add() NoUnderlyingSecurityAltID {
+	g := m.RepeatingGroup.Add()
+	return NoUnderlyingSecurityAltID{g}
+}
+
+//Get returns the ith NoUnderlyingSecurityAltID in the NoUnderlyingSecurityAltIDRepeatinGroup
+func (m NoUnderlyingSecurityAltIDRepeatingGroup) Get(i int) NoUnderlyingSecurityAltID {
+	return NoUnderlyingSecurityAltID{m.RepeatingGroup.Get(i)}
+}
+
+//NoUnderlyingStips is a repeating group element, Tag 887
+type NoUnderlyingStips struct {
+	*quickfix.Group
+}
+
+//SetUnderlyingStipType sets UnderlyingStipType, Tag 888
+func (m NoUnderlyingStips) SetUnderlyingStipType(v string) {
+	m.Set(field.NewUnderlyingStipType(v))
+}
+
+//SetUnderlyingStipValue sets UnderlyingStipValue, Tag 889
+func (m NoUnderlyingStips) SetUnderlyingStipValue(v string) {
+	m.Set(field.NewUnderlyingStipValue(v))
+}
+
+//GetUnderlyingStipType gets UnderlyingStipType, Tag 888
+func (m NoUnderlyingStips) GetUnderlyingStipType() (v string, err quickfix.MessageRejectError) {
+	var f field.UnderlyingStipTypeField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//GetUnderlyingStipValue gets UnderlyingStipValue, Tag 889
+func (m NoUnderlyingStips) GetUnderlyingStipValue() (v string, err quickfix.MessageRejectError) {
+	var f field.UnderlyingStipValueField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
+//HasUnderlyingStipType returns true if UnderlyingStipType is present, Tag 888
+func (m NoUnderlyingStips) HasUnderlyingStipType() bool {
+	return m.Has(tag.UnderlyingStipType)
+}
+
+//HasUnderlyingStipValue returns true if UnderlyingStipValue is present, Tag 889
+func (m NoUnderlyingStips) HasUnderlyingStipValue() bool {
+	return m.Has(tag.UnderlyingStipValue)
+}
+
+//NoUnderlyingStipsRepeatingGroup is a repeating group, Tag 887
+type NoUnderlyingStipsRepeatingGroup struct {
+	*quickfix.RepeatingGroup
+}
+
+//NewNoUnderlyingStipsRepeatingGroup returns an initialized, NoUnderlyingStipsRepeatingGroup
+func NewNoUnderlyingStipsRepeatingGroup() NoUnderlyingStipsRepeatingGroup {
+	return NoUnderlyingStipsRepeatingGroup{
+		quickfix.NewRepeatingGroup(tag.NoUnderlyingStips,
+			quickfix.GroupTemplate{quickfix.GroupElement(tag.UnderlyingStipType), quickfix.GroupElement(tag.UnderlyingStipValue)})}
+}
+
+//Add create and append a new NoUnderlyingStips to this group
+func (m NoUnderlyingStipsRepeatingGroup) Add() NoUnderlyingStips {
+	g := m.RepeatingGroup.Add()
+	return NoUnderlyingStips{g}
+}
+
+//Get returns the ith NoUnderlyingStips in the NoUnderlyingStipsRepeatinGroup
+func (m NoUnderlyingStipsRepeatingGroup) Get(i int) NoUnderlyingStips {
+	return NoUnderlyingStips{m.RepeatingGroup.Get(i)}
+}
+
+var underlyingInstrumentTags = []quickfix.Tag{
+	tag.UnderlyingSymbol,
+	tag.UnderlyingSymbolSfx,
+	tag.UnderlyingSecurityID,
+	tag.UnderlyingSecurityIDSource,
+	tag.UnderlyingProduct,
+	tag.UnderlyingCFICode,
+	tag.UnderlyingSecurityType,
+	tag.UnderlyingSecuritySubType,
+	tag.UnderlyingMaturityMonthYear,
+	tag.UnderlyingMaturityDate,
+	tag.UnderlyingCouponPaymentDate,
+	tag.UnderlyingIssueDate,
+	tag.UnderlyingRepoCollateralSecurityType,
+	tag.UnderlyingRepurchaseTerm,
+	tag.UnderlyingRepurchaseRate,
+	tag.UnderlyingFactor,
+	tag.UnderlyingCreditRating,
+	tag.UnderlyingInstrRegistry,
+	tag.UnderlyingCountryOfIssue,
+	tag.UnderlyingStateOrProvinceOfIssue,
+	tag.UnderlyingLocaleOfIssue,
+	tag.UnderlyingRedemptionDate,
+	tag.UnderlyingStrikePrice,
+	tag.UnderlyingStrikeCurrency,
+	tag.UnderlyingOptAttribute,
+	tag.UnderlyingContractMultiplier,
+	tag.UnderlyingCouponRate,
+	tag.UnderlyingSecurityExchange,
+	tag.UnderlyingIssuer,
+	tag.EncodedUnderlyingIssuerLen,
+	tag.EncodedUnderlyingIssuer,
+	tag.UnderlyingSecurityDesc,
+	tag.EncodedUnderlyingSecurityDescLen,
+	tag.EncodedUnderlyingSecurityDesc,
+	tag.UnderlyingCPProgram,
+	tag.UnderlyingCPRegType,
+	tag.UnderlyingCurrency,
+	tag.UnderlyingQty,
+	tag.UnderlyingPx,
+	tag.UnderlyingDirtyPrice,
+	tag.UnderlyingEndPrice,
+	tag.UnderlyingStartValue,
+	tag.UnderlyingCurrentValue,
+	tag.UnderlyingEndValue,
+}
+
+//NoUnderlyingSecurityAltIDStruct is a plain Go representation of a NoUnderlyingSecurityAltID group element, optional fields are nil when absent
+type NoUnderlyingSecurityAltIDStruct struct {
+	UnderlyingSecurityAltID       *string
+	UnderlyingSecurityAltIDSource *string
+}
+
+//MarshalNoUnderlyingSecurityAltID copies the elements of g into a slice of NoUnderlyingSecurityAltIDStruct
+func MarshalNoUnderlyingSecurityAltID(g NoUnderlyingSecurityAltIDRepeatingGroup) ([]NoUnderlyingSecurityAltIDStruct, quickfix.MessageRejectError) {
+	s := make([]NoUnderlyingSecurityAltIDStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasUnderlyingSecurityAltID() {
+			v, err := m.GetUnderlyingSecurityAltID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingSecurityAltID = &v
+		}
+		if m.HasUnderlyingSecurityAltIDSource() {
+			v, err := m.GetUnderlyingSecurityAltIDSource()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingSecurityAltIDSource = &v
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoUnderlyingSecurityAltID builds a NoUnderlyingSecurityAltIDRepeatingGroup from s
+func UnmarshalNoUnderlyingSecurityAltID(s []NoUnderlyingSecurityAltIDStruct) NoUnderlyingSecurityAltIDRepeatingGroup {
+	g := NewNoUnderlyingSecurityAltIDRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.UnderlyingSecurityAltID != nil {
+			m.SetUnderlyingSecurityAltID(*e.UnderlyingSecurityAltID)
+		}
+		if e.UnderlyingSecurityAltIDSource != nil {
+			m.SetUnderlyingSecurityAltIDSource(*e.UnderlyingSecurityAltIDSource)
+		}
+	}
+	return g
+}
+
+//NoUnderlyingStipsStruct is a plain Go representation of a NoUnderlyingStips group element, optional fields are nil when absent
+type NoUnderlyingStipsStruct struct {
+	UnderlyingStipType  *string
+	UnderlyingStipValue *string
+}
+
+//MarshalNoUnderlyingStips copies the elements of g into a slice of NoUnderlyingStipsStruct
+func MarshalNoUnderlyingStips(g NoUnderlyingStipsRepeatingGroup) ([]NoUnderlyingStipsStruct, quickfix.MessageRejectError) {
+	s := make([]NoUnderlyingStipsStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasUnderlyingStipType() {
+			v, err := m.GetUnderlyingStipType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingStipType = &v
+		}
+		if m.HasUnderlyingStipValue() {
+			v, err := m.GetUnderlyingStipValue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingStipValue = &v
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoUnderlyingStips builds a NoUnderlyingStipsRepeatingGroup from s
+func UnmarshalNoUnderlyingStips(s []NoUnderlyingStipsStruct) NoUnderlyingStipsRepeatingGroup {
+	g := NewNoUnderlyingStipsRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.UnderlyingStipType != nil {
+			m.SetUnderlyingStipType(*e.UnderlyingStipType)
+		}
+		if e.UnderlyingStipValue != nil {
+			m.SetUnderlyingStipValue(*e.UnderlyingStipValue)
+		}
+	}
+	return g
+}
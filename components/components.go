@@ -0,0 +1,27 @@
+package components
+
+import (
+	"github.com/terracefi/quickfix"
+)
+
+//copyFields copies the fields and repeating groups present in from into to
+func copyFields(from, to *quickfix.FieldMap, tags []quickfix.Tag, groups ...quickfix.FieldGroup) quickfix.MessageRejectError {
+	for _, t := range tags {
+		var v quickfix.FIXString
+		if from.GetField(t, &v) == nil {
+			to.SetField(t, v)
+		}
+	}
+
+	for _, g := range groups {
+		if !from.Has(g.Tag()) {
+			continue
+		}
+		if err := from.GetGroup(g); err != nil {
+			return err
+		}
+		to.SetGroup(g)
+	}
+
+	return nil
+}
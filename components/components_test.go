@@ -0,0 +1,167 @@
+package components_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/terracefi/enum"
+	"github.com/terracefi/field"
+	"github.com/terracefi/fix44/components"
+	"github.com/terracefi/fix44/internal/fixutil"
+	"github.com/terracefi/fix44/newordersingle"
+	"github.com/terracefi/fix44/ordercancelrequest"
+	"github.com/terracefi/quickfix"
+)
+
+func newOrderSingle() newordersingle.NewOrderSingle {
+	return newordersingle.New(field.NewClOrdID("A"), field.NewSide("1"), field.NewTransactTime(time.Unix(0, 0).UTC()),
+		field.NewOrdType("2"))
+}
+
+func newOrderCancelRequest() ordercancelrequest.OrderCancelRequest {
+	return ordercancelrequest.New(field.NewOrigClOrdID("A"), field.NewClOrdID("B"), field.NewSide("1"),
+		field.NewTransactTime(time.Unix(0, 0).UTC()))
+}
+
+//instrument describes the Symbol and alt IDs of c, as read through its accessors
+func instrument(t *testing.T, c components.Instrument) (string, []components.NoSecurityAltIDStruct) {
+	var symbol string
+	if c.HasSymbol() {
+		var err quickfix.MessageRejectError
+		if symbol, err = c.GetSymbol(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if !c.HasNoSecurityAltID() {
+		return symbol, nil
+	}
+	g, err := c.GetNoSecurityAltID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	alts, err := components.MarshalNoSecurityAltID(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return symbol, alts
+}
+
+func parties(t *testing.T, c components.Parties) []components.NoPartyIDsStruct {
+	if !c.HasNoPartyIDs() {
+		return nil
+	}
+	g, err := c.GetNoPartyIDs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := components.MarshalNoPartyIDs(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestInstrumentRoundTrip(t *testing.T) {
+	tests := []struct {
+		name   string
+		symbol string
+		alts   []components.NoSecurityAltIDStruct
+	}{
+		{"empty", "", nil},
+		{"fields", "ABC", nil},
+		{"repeating group", "ABC", []components.NoSecurityAltIDStruct{
+			{SecurityAltID: fixutil.Ptr("GB0000000001"), SecurityAltIDSource: fixutil.Ptr("4")},
+			{SecurityAltID: fixutil.Ptr("0000001"), SecurityAltIDSource: fixutil.Ptr("2")},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order := newOrderSingle()
+			in := order.GetInstrument()
+			if tt.symbol != "" {
+				in.SetSymbol(tt.symbol)
+			}
+			if tt.alts != nil {
+				in.SetNoSecurityAltID(components.UnmarshalNoSecurityAltID(tt.alts))
+			}
+
+			cancel := newOrderCancelRequest()
+			if err := cancel.SetInstrument(order.GetInstrument()); err != nil {
+				t.Fatal(err)
+			}
+			symbol, alts := instrument(t, cancel.GetInstrument())
+			if symbol != tt.symbol || !reflect.DeepEqual(alts, tt.alts) {
+				t.Errorf("Get after Set = %q, %v, want %q, %v", symbol, alts, tt.symbol, tt.alts)
+			}
+
+			copied := components.NewInstrument()
+			if err := order.GetInstrument().CopyInto(copied.FieldMap); err != nil {
+				t.Fatal(err)
+			}
+			symbol, alts = instrument(t, copied)
+			if symbol != tt.symbol || !reflect.DeepEqual(alts, tt.alts) {
+				t.Errorf("CopyInto = %q, %v, want %q, %v", symbol, alts, tt.symbol, tt.alts)
+			}
+		})
+	}
+}
+
+func TestPartiesRoundTrip(t *testing.T) {
+	tests := []struct {
+		name    string
+		parties []components.NoPartyIDsStruct
+	}{
+		{"empty", nil},
+		{"one party", []components.NoPartyIDsStruct{{PartyID: fixutil.Ptr("BRKR"),
+			PartyRole: fixutil.Ptr(enum.PartyRole("1"))}}},
+		{"nested repeating group", []components.NoPartyIDsStruct{
+			{PartyID: fixutil.Ptr("BRKR"), PartyIDSource: fixutil.Ptr(enum.PartyIDSource("D")),
+				PartyRole: fixutil.Ptr(enum.PartyRole("1")), NoPartySubIDs: []components.NoPartySubIDsStruct{
+					{PartySubID: fixutil.Ptr("DESK1"), PartySubIDType: fixutil.Ptr(enum.PartySubIDType("24"))},
+					{PartySubID: fixutil.Ptr("J SMITH"), PartySubIDType: fixutil.Ptr(enum.PartySubIDType("2"))},
+				}},
+			{PartyID: fixutil.Ptr("ACC"), PartyRole: fixutil.Ptr(enum.PartyRole("24"))},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order := newOrderSingle()
+			if tt.parties != nil {
+				order.GetParties().SetNoPartyIDs(components.UnmarshalNoPartyIDs(tt.parties))
+			}
+
+			cancel := newOrderCancelRequest()
+			if err := cancel.SetParties(order.GetParties()); err != nil {
+				t.Fatal(err)
+			}
+			if got := parties(t, cancel.GetParties()); !reflect.DeepEqual(got, tt.parties) {
+				t.Errorf("Get after Set = %v, want %v", got, tt.parties)
+			}
+
+			copied := components.NewParties()
+			if err := order.GetParties().CopyInto(copied.FieldMap); err != nil {
+				t.Fatal(err)
+			}
+			if got := parties(t, copied); !reflect.DeepEqual(got, tt.parties) {
+				t.Errorf("CopyInto = %v, want %v", got, tt.parties)
+			}
+		})
+	}
+}
+
+func TestSetComponentKeepsOtherFields(t *testing.T) {
+	order := newOrderSingle()
+	order.GetInstrument().SetSymbol("ABC")
+
+	cancel := newOrderCancelRequest()
+	if err := cancel.SetInstrument(order.GetInstrument()); err != nil {
+		t.Fatal(err)
+	}
+	if v, err := cancel.GetClOrdID(); err != nil || v != "B" {
+		t.Errorf("GetClOrdID() = %q, %v, want B", v, err)
+	}
+	if order.GetInstrument().HasNoSecurityAltID() || cancel.GetInstrument().HasNoSecurityAltID() {
+		t.Error("SetInstrument added a NoSecurityAltID absent from the component")
+	}
+}
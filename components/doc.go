@@ -0,0 +1,7 @@
+/*
+Package components contains the fix44 components that are shared between message types.
+
+A component value is a view over the FieldMap that holds its fields, so the Instrument of an ExecutionReport
+can be read with GetInstrument and handed directly to the SetInstrument of a NewOrderSingle.
+*/
+package components
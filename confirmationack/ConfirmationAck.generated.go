@@ -60,6 +60,26 @@ func Route(router RouteOut) (string, string, quickfix.MessageRoute) {
 	return "FIX.4.4", "AU", r
 }
 
+//Validate checks m against the FIX 4.4 definition of ConfirmationAck, see fix44.MessageDef.Validate
+func (m ConfirmationAck) Validate() fix44.ValidationErrors {
+	return fix44.MessageDefs["AU"].Validate(&m.Body.FieldMap)
+}
+
+//MarshalJSON encodes the ConfirmationAck as JSON with fields keyed by name, see fix44.MarshalMessageJSON
+func (m ConfirmationAck) MarshalJSON() ([]byte, error) {
+	return fix44.MarshalMessageJSON(m.Message)
+}
+
+//UnmarshalJSON builds the ConfirmationAck from JSON produced by MarshalJSON
+func (m *ConfirmationAck) UnmarshalJSON(b []byte) error {
+	msg, err := fix44.UnmarshalMessageJSON(b, "AU")
+	if err != nil {
+		return err
+	}
+	*m = FromMessage(msg)
+	return nil
+}
+
 //SetText sets Text, Tag 58
 func (m ConfirmationAck) SetText(v string) {
 	m.Set(field.NewText(v))
@@ -211,6 +231,21 @@ func (m ConfirmationAck) HasEncodedText() bool {
 	return m.Has(tag.EncodedText)
 }
 
+//SetEncodedTextBytes sets EncodedText, Tag 355, and EncodedTextLen, Tag 354, from v, which may hold any bytes including SOH
+func (m ConfirmationAck) SetEncodedTextBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedTextLen, tag.EncodedText, v)
+}
+
+//GetEncodedTextBytes gets EncodedText, Tag 355, checking its length against EncodedTextLen, Tag 354
+func (m ConfirmationAck) GetEncodedTextBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedTextLen, tag.EncodedText)
+}
+
+//GetEncodedTextDecoded gets EncodedText, Tag 355, decoded to UTF-8 using the MessageEncoding of the Header
+func (m ConfirmationAck) GetEncodedTextDecoded() (string, error) {
+	return fix44.DecodeRawData(m.Header, m, tag.EncodedTextLen, tag.EncodedText)
+}
+
 //HasMatchStatus returns true if MatchStatus is present, Tag 573
 func (m ConfirmationAck) HasMatchStatus() bool {
 	return m.Has(tag.MatchStatus)
@@ -230,3 +265,106 @@ func (m ConfirmationAck) HasConfirmRejReason() bool {
 func (m ConfirmationAck) HasAffirmStatus() bool {
 	return m.Has(tag.AffirmStatus)
 }
+
+//Struct is a plain Go representation of the ConfirmationAck body, optional fields are nil when absent
+type Struct struct {
+	Text             *string
+	TransactTime     time.Time
+	TradeDate        string
+	EncodedTextLen   *int
+	EncodedText      *string
+	MatchStatus      *enum.MatchStatus
+	ConfirmID        string
+	ConfirmRejReason *enum.ConfirmRejReason
+	AffirmStatus     enum.AffirmStatus
+}
+
+//Marshal copies the fields present in msg into a Struct
+func Marshal(msg ConfirmationAck) (Struct, quickfix.MessageRejectError) {
+	var s Struct
+	if msg.HasText() {
+		v, err := msg.GetText()
+		if err != nil {
+			return s, err
+		}
+		s.Text = &v
+	}
+	if msg.HasTransactTime() {
+		v, err := msg.GetTransactTime()
+		if err != nil {
+			return s, err
+		}
+		s.TransactTime = v
+	}
+	if msg.HasTradeDate() {
+		v, err := msg.GetTradeDate()
+		if err != nil {
+			return s, err
+		}
+		s.TradeDate = v
+	}
+	if msg.HasEncodedTextLen() {
+		v, err := msg.GetEncodedTextLen()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedTextLen = &v
+	}
+	if msg.HasEncodedText() {
+		v, err := msg.GetEncodedText()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedText = &v
+	}
+	if msg.HasMatchStatus() {
+		v, err := msg.GetMatchStatus()
+		if err != nil {
+			return s, err
+		}
+		s.MatchStatus = &v
+	}
+	if msg.HasConfirmID() {
+		v, err := msg.GetConfirmID()
+		if err != nil {
+			return s, err
+		}
+		s.ConfirmID = v
+	}
+	if msg.HasConfirmRejReason() {
+		v, err := msg.GetConfirmRejReason()
+		if err != nil {
+			return s, err
+		}
+		s.ConfirmRejReason = &v
+	}
+	if msg.HasAffirmStatus() {
+		v, err := msg.GetAffirmStatus()
+		if err != nil {
+			return s, err
+		}
+		s.AffirmStatus = v
+	}
+	return s, nil
+}
+
+//Unmarshal builds a ConfirmationAck from s
+func Unmarshal(s Struct) ConfirmationAck {
+	m := New(field.NewConfirmID(s.ConfirmID), field.NewTradeDate(s.TradeDate), field.NewTransactTime(s.TransactTime), field.NewAffirmStatus(s.AffirmStatus))
+	if s.Text != nil {
+		m.SetText(*s.Text)
+	}
+	if s.EncodedTextLen != nil {
+		m.SetEncodedTextLen(*s.EncodedTextLen)
+	}
+	if s.EncodedText != nil {
+		m.SetEncodedText(*s.EncodedText)
+	}
+	if s.MatchStatus != nil {
+		m.SetMatchStatus(*s.MatchStatus)
+	}
+	if s.ConfirmRejReason != nil {
+		m.SetConfirmRejReason(*s.ConfirmRejReason)
+	}
+	return m
+}
@@ -61,6 +61,26 @@ func Route(router RouteOut) (string, string, quickfix.MessageRoute) {
 	return "FIX.4.4", "BH", r
 }
 
+//Validate checks m against the FIX 4.4 definition of ConfirmationRequest, see fix44.MessageDef.Validate
+func (m ConfirmationRequest) Validate() fix44.ValidationErrors {
+	return fix44.MessageDefs["BH"].Validate(&m.Body.FieldMap)
+}
+
+//MarshalJSON encodes the ConfirmationRequest as JSON with fields keyed by name, see fix44.MarshalMessageJSON
+func (m ConfirmationRequest) MarshalJSON() ([]byte, error) {
+	return fix44.MarshalMessageJSON(m.Message)
+}
+
+//UnmarshalJSON builds the ConfirmationRequest from JSON produced by MarshalJSON
+func (m *ConfirmationRequest) UnmarshalJSON(b []byte) error {
+	msg, err := fix44.UnmarshalMessageJSON(b, "BH")
+	if err != nil {
+		return err
+	}
+	*m = FromMessage(msg)
+	return nil
+}
+
 //SetText sets Text, Tag 58
 func (m ConfirmationRequest) SetText(v string) {
 	m.Set(field.NewText(v))
@@ -276,6 +296,21 @@ func (m ConfirmationRequest) HasEncodedText() bool {
 	return m.Has(tag.EncodedText)
 }
 
+//SetEncodedTextBytes sets EncodedText, Tag 355, and EncodedTextLen, Tag 354, from v, which may hold any bytes including SOH
+func (m ConfirmationRequest) SetEncodedTextBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedTextLen, tag.EncodedText, v)
+}
+
+//GetEncodedTextBytes gets EncodedText, Tag 355, checking its length against EncodedTextLen, Tag 354
+func (m ConfirmationRequest) GetEncodedTextBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedTextLen, tag.EncodedText)
+}
+
+//GetEncodedTextDecoded gets EncodedText, Tag 355, decoded to UTF-8 using the MessageEncoding of the Header
+func (m ConfirmationRequest) GetEncodedTextDecoded() (string, error) {
+	return fix44.DecodeRawData(m.Header, m, tag.EncodedTextLen, tag.EncodedText)
+}
+
 //HasIndividualAllocID returns true if IndividualAllocID is present, Tag 467
 func (m ConfirmationRequest) HasIndividualAllocID() bool {
 	return m.Has(tag.IndividualAllocID)
@@ -670,3 +705,390 @@ func (m NoOrdersRepeatingGroup) Add() NoOrders {
 func (m NoOrdersRepeatingGroup) Get(i int) NoOrders {
 	return NoOrders{m.RepeatingGroup.Get(i)}
 }
+
+//Struct is a plain Go representation of the ConfirmationRequest body, optional fields are nil when absent
+type Struct struct {
+	Text              *string
+	TransactTime      time.Time
+	AllocID           *string
+	NoOrders          []NoOrdersStruct
+	AllocAccount      *string
+	EncodedTextLen    *int
+	EncodedText       *string
+	IndividualAllocID *string
+	AllocAcctIDSource *int
+	ConfirmType       enum.ConfirmType
+	SecondaryAllocID  *string
+	AllocAccountType  *enum.AllocAccountType
+	ConfirmReqID      string
+}
+
+//Marshal copies the fields present in msg into a Struct
+func Marshal(msg ConfirmationRequest) (Struct, quickfix.MessageRejectError) {
+	var s Struct
+	if msg.HasText() {
+		v, err := msg.GetText()
+		if err != nil {
+			return s, err
+		}
+		s.Text = &v
+	}
+	if msg.HasTransactTime() {
+		v, err := msg.GetTransactTime()
+		if err != nil {
+			return s, err
+		}
+		s.TransactTime = v
+	}
+	if msg.HasAllocID() {
+		v, err := msg.GetAllocID()
+		if err != nil {
+			return s, err
+		}
+		s.AllocID = &v
+	}
+	if msg.HasNoOrders() {
+		g, err := msg.GetNoOrders()
+		if err != nil {
+			return s, err
+		}
+		if s.NoOrders, err = MarshalNoOrders(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasAllocAccount() {
+		v, err := msg.GetAllocAccount()
+		if err != nil {
+			return s, err
+		}
+		s.AllocAccount = &v
+	}
+	if msg.HasEncodedTextLen() {
+		v, err := msg.GetEncodedTextLen()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedTextLen = &v
+	}
+	if msg.HasEncodedText() {
+		v, err := msg.GetEncodedText()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedText = &v
+	}
+	if msg.HasIndividualAllocID() {
+		v, err := msg.GetIndividualAllocID()
+		if err != nil {
+			return s, err
+		}
+		s.IndividualAllocID = &v
+	}
+	if msg.HasAllocAcctIDSource() {
+		v, err := msg.GetAllocAcctIDSource()
+		if err != nil {
+			return s, err
+		}
+		s.AllocAcctIDSource = &v
+	}
+	if msg.HasConfirmType() {
+		v, err := msg.GetConfirmType()
+		if err != nil {
+			return s, err
+		}
+		s.ConfirmType = v
+	}
+	if msg.HasSecondaryAllocID() {
+		v, err := msg.GetSecondaryAllocID()
+		if err != nil {
+			return s, err
+		}
+		s.SecondaryAllocID = &v
+	}
+	if msg.HasAllocAccountType() {
+		v, err := msg.GetAllocAccountType()
+		if err != nil {
+			return s, err
+		}
+		s.AllocAccountType = &v
+	}
+	if msg.HasConfirmReqID() {
+		v, err := msg.GetConfirmReqID()
+		if err != nil {
+			return s, err
+		}
+		s.ConfirmReqID = v
+	}
+	return s, nil
+}
+
+//Unmarshal builds a ConfirmationRequest from s
+func Unmarshal(s Struct) ConfirmationRequest {
+	m := New(field.NewConfirmReqID(s.ConfirmReqID), field.NewConfirmType(s.ConfirmType), field.NewTransactTime(s.TransactTime))
+	if s.Text != nil {
+		m.SetText(*s.Text)
+	}
+	if s.AllocID != nil {
+		m.SetAllocID(*s.AllocID)
+	}
+	if s.NoOrders != nil {
+		m.SetNoOrders(UnmarshalNoOrders(s.NoOrders))
+	}
+	if s.AllocAccount != nil {
+		m.SetAllocAccount(*s.AllocAccount)
+	}
+	if s.EncodedTextLen != nil {
+		m.SetEncodedTextLen(*s.EncodedTextLen)
+	}
+	if s.EncodedText != nil {
+		m.SetEncodedText(*s.EncodedText)
+	}
+	if s.IndividualAllocID != nil {
+		m.SetIndividualAllocID(*s.IndividualAllocID)
+	}
+	if s.AllocAcctIDSource != nil {
+		m.SetAllocAcctIDSource(*s.AllocAcctIDSource)
+	}
+	if s.SecondaryAllocID != nil {
+		m.SetSecondaryAllocID(*s.SecondaryAllocID)
+	}
+	if s.AllocAccountType != nil {
+		m.SetAllocAccountType(*s.AllocAccountType)
+	}
+	return m
+}
+
+//NoOrdersStruct is a plain Go representation of a NoOrders group element, optional fields are nil when absent
+type NoOrdersStruct struct {
+	ClOrdID           *string
+	OrderID           *string
+	SecondaryOrderID  *string
+	SecondaryClOrdID  *string
+	ListID            *string
+	NoNested2PartyIDs []NoNested2PartyIDsStruct
+	OrderQty          *decimal.Decimal
+	OrderAvgPx        *decimal.Decimal
+	OrderBookingQty   *decimal.Decimal
+}
+
+//MarshalNoOrders copies the elements of g into a slice of NoOrdersStruct
+func MarshalNoOrders(g NoOrdersRepeatingGroup) ([]NoOrdersStruct, quickfix.MessageRejectError) {
+	s := make([]NoOrdersStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasClOrdID() {
+			v, err := m.GetClOrdID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].ClOrdID = &v
+		}
+		if m.HasOrderID() {
+			v, err := m.GetOrderID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].OrderID = &v
+		}
+		if m.HasSecondaryOrderID() {
+			v, err := m.GetSecondaryOrderID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SecondaryOrderID = &v
+		}
+		if m.HasSecondaryClOrdID() {
+			v, err := m.GetSecondaryClOrdID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SecondaryClOrdID = &v
+		}
+		if m.HasListID() {
+			v, err := m.GetListID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].ListID = &v
+		}
+		if m.HasNoNested2PartyIDs() {
+			g, err := m.GetNoNested2PartyIDs()
+			if err != nil {
+				return nil, err
+			}
+			if s[i].NoNested2PartyIDs, err = MarshalNoNested2PartyIDs(g); err != nil {
+				return nil, err
+			}
+		}
+		if m.HasOrderQty() {
+			v, err := m.GetOrderQty()
+			if err != nil {
+				return nil, err
+			}
+			s[i].OrderQty = &v
+		}
+		if m.HasOrderAvgPx() {
+			v, err := m.GetOrderAvgPx()
+			if err != nil {
+				return nil, err
+			}
+			s[i].OrderAvgPx = &v
+		}
+		if m.HasOrderBookingQty() {
+			v, err := m.GetOrderBookingQty()
+			if err != nil {
+				return nil, err
+			}
+			s[i].OrderBookingQty = &v
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoOrders builds a NoOrdersRepeatingGroup from s
+func UnmarshalNoOrders(s []NoOrdersStruct) NoOrdersRepeatingGroup {
+	g := NewNoOrdersRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.ClOrdID != nil {
+			m.SetClOrdID(*e.ClOrdID)
+		}
+		if e.OrderID != nil {
+			m.SetOrderID(*e.OrderID)
+		}
+		if e.SecondaryOrderID != nil {
+			m.SetSecondaryOrderID(*e.SecondaryOrderID)
+		}
+		if e.SecondaryClOrdID != nil {
+			m.SetSecondaryClOrdID(*e.SecondaryClOrdID)
+		}
+		if e.ListID != nil {
+			m.SetListID(*e.ListID)
+		}
+		if e.NoNested2PartyIDs != nil {
+			m.SetNoNested2PartyIDs(UnmarshalNoNested2PartyIDs(e.NoNested2PartyIDs))
+		}
+		if e.OrderQty != nil {
+			m.SetOrderQty(*e.OrderQty, fix44.DecimalScale(*e.OrderQty))
+		}
+		if e.OrderAvgPx != nil {
+			m.SetOrderAvgPx(*e.OrderAvgPx, fix44.DecimalScale(*e.OrderAvgPx))
+		}
+		if e.OrderBookingQty != nil {
+			m.SetOrderBookingQty(*e.OrderBookingQty, fix44.DecimalScale(*e.OrderBookingQty))
+		}
+	}
+	return g
+}
+
+//NoNested2PartyIDsStruct is a plain Go representation of a NoNested2PartyIDs group element, optional fields are nil when absent
+type NoNested2PartyIDsStruct struct {
+	Nested2PartyID       *string
+	Nested2PartyIDSource *string
+	Nested2PartyRole     *int
+	NoNested2PartySubIDs []NoNested2PartySubIDsStruct
+}
+
+//MarshalNoNested2PartyIDs copies the elements of g into a slice of NoNested2PartyIDsStruct
+func MarshalNoNested2PartyIDs(g NoNested2PartyIDsRepeatingGroup) ([]NoNested2PartyIDsStruct, quickfix.MessageRejectError) {
+	s := make([]NoNested2PartyIDsStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasNested2PartyID() {
+			v, err := m.GetNested2PartyID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].Nested2PartyID = &v
+		}
+		if m.HasNested2PartyIDSource() {
+			v, err := m.GetNested2PartyIDSource()
+			if err != nil {
+				return nil, err
+			}
+			s[i].Nested2PartyIDSource = &v
+		}
+		if m.HasNested2PartyRole() {
+			v, err := m.GetNested2PartyRole()
+			if err != nil {
+				return nil, err
+			}
+			s[i].Nested2PartyRole = &v
+		}
+		if m.HasNoNested2PartySubIDs() {
+			g, err := m.GetNoNested2PartySubIDs()
+			if err != nil {
+				return nil, err
+			}
+			if s[i].NoNested2PartySubIDs, err = MarshalNoNested2PartySubIDs(g); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoNested2PartyIDs builds a NoNested2PartyIDsRepeatingGroup from s
+func UnmarshalNoNested2PartyIDs(s []NoNested2PartyIDsStruct) NoNested2PartyIDsRepeatingGroup {
+	g := NewNoNested2PartyIDsRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.Nested2PartyID != nil {
+			m.SetNested2PartyID(*e.Nested2PartyID)
+		}
+		if e.Nested2PartyIDSource != nil {
+			m.SetNested2PartyIDSource(*e.Nested2PartyIDSource)
+		}
+		if e.Nested2PartyRole != nil {
+			m.SetNested2PartyRole(*e.Nested2PartyRole)
+		}
+		if e.NoNested2PartySubIDs != nil {
+			m.SetNoNested2PartySubIDs(UnmarshalNoNested2PartySubIDs(e.NoNested2PartySubIDs))
+		}
+	}
+	return g
+}
+
+//NoNested2PartySubIDsStruct is a plain Go representation of a NoNested2PartySubIDs group element, optional fields are nil when absent
+type NoNested2PartySubIDsStruct struct {
+	Nested2PartySubID     *string
+	Nested2PartySubIDType *int
+}
+
+//MarshalNoNested2PartySubIDs copies the elements of g into a slice of NoNested2PartySubIDsStruct
+func MarshalNoNested2PartySubIDs(g NoNested2PartySubIDsRepeatingGroup) ([]NoNested2PartySubIDsStruct, quickfix.MessageRejectError) {
+	s := make([]NoNested2PartySubIDsStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasNested2PartySubID() {
+			v, err := m.GetNested2PartySubID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].Nested2PartySubID = &v
+		}
+		if m.HasNested2PartySubIDType() {
+			v, err := m.GetNested2PartySubIDType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].Nested2PartySubIDType = &v
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoNested2PartySubIDs builds a NoNested2PartySubIDsRepeatingGroup from s
+func UnmarshalNoNested2PartySubIDs(s []NoNested2PartySubIDsStruct) NoNested2PartySubIDsRepeatingGroup {
+	g := NewNoNested2PartySubIDsRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.Nested2PartySubID != nil {
+			m.SetNested2PartySubID(*e.Nested2PartySubID)
+		}
+		if e.Nested2PartySubIDType != nil {
+			m.SetNested2PartySubIDType(*e.Nested2PartySubIDType)
+		}
+	}
+	return g
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/terracefi/enum"
 	"github.com/terracefi/field"
 	"github.com/terracefi/fix44"
+	"github.com/terracefi/fix44/components"
 	"github.com/terracefi/quickfix"
 	"github.com/terracefi/tag"
 )
@@ -64,6 +65,26 @@ func Route(router RouteOut) (string, string, quickfix.MessageRoute) {
 	return "FIX.4.4", "t", r
 }
 
+//Validate checks m against the FIX 4.4 definition of CrossOrderCancelReplaceRequest, see fix44.MessageDef.Validate
+func (m CrossOrderCancelReplaceRequest) Validate() fix44.ValidationErrors {
+	return fix44.MessageDefs["t"].Validate(&m.Body.FieldMap)
+}
+
+//MarshalJSON encodes the CrossOrderCancelReplaceRequest as JSON with fields keyed by name, see fix44.MarshalMessageJSON
+func (m CrossOrderCancelReplaceRequest) MarshalJSON() ([]byte, error) {
+	return fix44.MarshalMessageJSON(m.Message)
+}
+
+//UnmarshalJSON builds the CrossOrderCancelReplaceRequest from JSON produced by MarshalJSON
+func (m *CrossOrderCancelReplaceRequest) UnmarshalJSON(b []byte) error {
+	msg, err := fix44.UnmarshalMessageJSON(b, "t")
+	if err != nil {
+		return err
+	}
+	*m = FromMessage(msg)
+	return nil
+}
+
 //SetCurrency sets Currency, Tag 15
 func (m CrossOrderCancelReplaceRequest) SetCurrency(v string) {
 	m.Set(field.NewCurrency(v))
@@ -1869,6 +1890,21 @@ func (m CrossOrderCancelReplaceRequest) HasEncodedIssuer() bool {
 	return m.Has(tag.EncodedIssuer)
 }
 
+//SetEncodedIssuerBytes sets EncodedIssuer, Tag 349, and EncodedIssuerLen, Tag 348, from v, which may hold any bytes including SOH
+func (m CrossOrderCancelReplaceRequest) SetEncodedIssuerBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedIssuerLen, tag.EncodedIssuer, v)
+}
+
+//GetEncodedIssuerBytes gets EncodedIssuer, Tag 349, checking its length against EncodedIssuerLen, Tag 348
+func (m CrossOrderCancelReplaceRequest) GetEncodedIssuerBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedIssuerLen, tag.EncodedIssuer)
+}
+
+//GetEncodedIssuerDecoded gets EncodedIssuer, Tag 349, decoded to UTF-8 using the MessageEncoding of the Header
+func (m CrossOrderCancelReplaceRequest) GetEncodedIssuerDecoded() (string, error) {
+	return fix44.DecodeRawData(m.Header, m, tag.EncodedIssuerLen, tag.EncodedIssuer)
+}
+
 //HasEncodedSecurityDescLen returns true if EncodedSecurityDescLen is present, Tag 350
 func (m CrossOrderCancelReplaceRequest) HasEncodedSecurityDescLen() bool {
 	return m.Has(tag.EncodedSecurityDescLen)
@@ -1879,6 +1915,21 @@ func (m CrossOrderCancelReplaceRequest) HasEncodedSecurityDesc() bool {
 	return m.Has(tag.EncodedSecurityDesc)
 }
 
+//SetEncodedSecurityDescBytes sets EncodedSecurityDesc, Tag 351, and EncodedSecurityDescLen, Tag 350, from v, which may hold any bytes including SOH
+func (m CrossOrderCancelReplaceRequest) SetEncodedSecurityDescBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedSecurityDescLen, tag.EncodedSecurityDesc, v)
+}
+
+//GetEncodedSecurityDescBytes gets EncodedSecurityDesc, Tag 351, checking its length against EncodedSecurityDescLen, Tag 350
+func (m CrossOrderCancelReplaceRequest) GetEncodedSecurityDescBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedSecurityDescLen, tag.EncodedSecurityDesc)
+}
+
+//GetEncodedSecurityDescDecoded gets EncodedSecurityDesc, Tag 351, decoded to UTF-8 using the MessageEncoding of the Header
+func (m CrossOrderCancelReplaceRequest) GetEncodedSecurityDescDecoded() (string, error) {
+	return fix44.DecodeRawData(m.Header, m, tag.EncodedSecurityDescLen, tag.EncodedSecurityDesc)
+}
+
 //HasComplianceID returns true if ComplianceID is present, Tag 376
 func (m CrossOrderCancelReplaceRequest) HasComplianceID() bool {
 	return m.Has(tag.ComplianceID)
@@ -2159,71 +2210,49 @@ func (m CrossOrderCancelReplaceRequest) HasStrikeCurrency() bool {
 	return m.Has(tag.StrikeCurrency)
 }
 
-//NoStipulations is a repeating group element, Tag 232
-type NoStipulations struct {
-	*quickfix.Group
+//GetInstrument gets the Instrument component
+func (m CrossOrderCancelReplaceRequest) GetInstrument() components.Instrument {
+	return components.Instrument{&m.Body.FieldMap}
 }
 
-//SetStipulationType sets StipulationType, Tag 233
-func (m NoStipulations) SetStipulationType(v enum.StipulationType) {
-	m.Set(field.NewStipulationType(v))
+//SetInstrument sets the Instrument component, copying the fields present in c
+func (m CrossOrderCancelReplaceRequest) SetInstrument(c components.Instrument) quickfix.MessageRejectError {
+	return c.CopyInto(&m.Body.FieldMap)
 }
 
-//SetStipulationValue sets StipulationValue, Tag 234
-func (m NoStipulations) SetStipulationValue(v string) {
-	m.Set(field.NewStipulationValue(v))
+//GetStipulations gets the Stipulations component
+func (m CrossOrderCancelReplaceRequest) GetStipulations() components.Stipulations {
+	return components.Stipulations{&m.Body.FieldMap}
 }
 
-//GetStipulationType gets StipulationType, Tag 233
-func (m NoStipulations) GetStipulationType() (v enum.StipulationType, err quickfix.MessageRejectError) {
-	var f field.StipulationTypeField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
+//SetStipulations sets the Stipulations component, copying the fields present in c
+func (m CrossOrderCancelReplaceRequest) SetStipulations(c components.Stipulations) quickfix.MessageRejectError {
+	return c.CopyInto(&m.Body.FieldMap)
 }
 
-//GetStipulationValue gets StipulationValue, Tag 234
-func (m NoStipulations) GetStipulationValue() (v string, err quickfix.MessageRejectError) {
-	var f field.StipulationValueField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
+//GetSpreadOrBenchmarkCurveData gets the SpreadOrBenchmarkCurveData component
+func (m CrossOrderCancelReplaceRequest) GetSpreadOrBenchmarkCurveData() components.SpreadOrBenchmarkCurveData {
+	return components.SpreadOrBenchmarkCurveData{&m.Body.FieldMap}
 }
 
-//HasStipulationType returns true if StipulationType is present, Tag 233
-func (m NoStipulations) HasStipulationType() bool {
-	return m.Has(tag.StipulationType)
+//SetSpreadOrBenchmarkCurveData sets the SpreadOrBenchmarkCurveData component, copying the fields present in c
+func (m CrossOrderCancelReplaceRequest) SetSpreadOrBenchmarkCurveData(c components.SpreadOrBenchmarkCurveData) quickfix.MessageRejectError {
+	return c.CopyInto(&m.Body.FieldMap)
 }
 
-//HasStipulationValue returns true if StipulationValue is present, Tag 234
-func (m NoStipulations) HasStipulationValue() bool {
-	return m.Has(tag.StipulationValue)
-}
+//NoStipulations is a repeating group element, Tag 232
+type NoStipulations = components.NoStipulations
 
 //NoStipulationsRepeatingGroup is a repeating group, Tag 232
-type NoStipulationsRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
+type NoStipulationsRepeatingGroup = components.NoStipulationsRepeatingGroup
 
 //NewNoStipulationsRepeatingGroup returns an initialized, NoStipulationsRepeatingGroup
 func NewNoStipulationsRepeatingGroup() NoStipulationsRepeatingGroup {
-	return NoStipulationsRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoStipulations,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.StipulationType), quickfix.GroupElement(tag.StipulationValue)})}
-}
-
-//Add create and append a new NoStipulations to this group
-func (m NoStipulationsRepeatingGroup) Add() NoStipulations {
-	g := m.RepeatingGroup.Add()
-	return NoStipulations{g}
+	return components.NewNoStipulationsRepeatingGroup()
 }
 
-//Get returns the ith NoStipulations in the NoStipulationsRepeatinGroup
-func (m NoStipulationsRepeatingGroup) Get(i int) NoStipulations {
-	return NoStipulations{m.RepeatingGroup.Get(i)}
-}
+//NoStipulationsStruct is a plain Go representation of a NoStipulations group element
+type NoStipulationsStruct = components.NoStipulationsStruct
 
 //NoTradingSessions is a repeating group element, Tag 386
 type NoTradingSessions struct {
@@ -2292,70 +2321,18 @@ func (m NoTradingSessionsRepeatingGroup) Get(i int) NoTradingSessions {
 }
 
 //NoSecurityAltID is a repeating group element, Tag 454
-type NoSecurityAltID struct {
-	*quickfix.Group
-}
-
-//SetSecurityAltID sets SecurityAltID, Tag 455
-func (m NoSecurityAltID) SetSecurityAltID(v string) {
-	m.Set(field.NewSecurityAltID(v))
-}
-
-//SetSecurityAltIDSource sets SecurityAltIDSource, Tag 456
-func (m NoSecurityAltID) SetSecurityAltIDSource(v string) {
-	m.Set(field.NewSecurityAltIDSource(v))
-}
-
-//GetSecurityAltID gets SecurityAltID, Tag 455
-func (m NoSecurityAltID) GetSecurityAltID() (v string, err quickfix.MessageRejectError) {
-	var f field.SecurityAltIDField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetSecurityAltIDSource gets SecurityAltIDSource, Tag 456
-func (m NoSecurityAltID) GetSecurityAltIDSource() (v string, err quickfix.MessageRejectError) {
-	var f field.SecurityAltIDSourceField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//HasSecurityAltID returns true if SecurityAltID is present, Tag 455
-func (m NoSecurityAltID) HasSecurityAltID() bool {
-	return m.Has(tag.SecurityAltID)
-}
-
-//HasSecurityAltIDSource returns true if SecurityAltIDSource is present, Tag 456
-func (m NoSecurityAltID) HasSecurityAltIDSource() bool {
-	return m.Has(tag.SecurityAltIDSource)
-}
+type NoSecurityAltID = components.NoSecurityAltID
 
 //NoSecurityAltIDRepeatingGroup is a repeating group, Tag 454
-type NoSecurityAltIDRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
+type NoSecurityAltIDRepeatingGroup = components.NoSecurityAltIDRepeatingGroup
 
 //NewNoSecurityAltIDRepeatingGroup returns an initialized, NoSecurityAltIDRepeatingGroup
 func NewNoSecurityAltIDRepeatingGroup() NoSecurityAltIDRepeatingGroup {
-	return NoSecurityAltIDRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoSecurityAltID,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.SecurityAltID), quickfix.GroupElement(tag.SecurityAltIDSource)})}
-}
-
-//Add create and append a new NoSecurityAltID to this group
-func (m NoSecurityAltIDRepeatingGroup) Add() NoSecurityAltID {
-	g := m.RepeatingGroup.Add()
-	return NoSecurityAltID{g}
+	return components.NewNoSecurityAltIDRepeatingGroup()
 }
 
-//Get returns the ith NoSecurityAltID in the NoSecurityAltIDRepeatinGroup
-func (m NoSecurityAltIDRepeatingGroup) Get(i int) NoSecurityAltID {
-	return NoSecurityAltID{m.RepeatingGroup.Get(i)}
-}
+//NoSecurityAltIDStruct is a plain Go representation of a NoSecurityAltID group element
+type NoSecurityAltIDStruct = components.NoSecurityAltIDStruct
 
 //NoSides is a repeating group element, Tag 552
 type NoSides struct {
@@ -3126,6 +3103,21 @@ func (m NoSides) HasEncodedText() bool {
 	return m.Has(tag.EncodedText)
 }
 
+//SetEncodedTextBytes sets EncodedText, Tag 355, and EncodedTextLen, Tag 354, from v, which may hold any bytes including SOH
+func (m NoSides) SetEncodedTextBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedTextLen, tag.EncodedText, v)
+}
+
+//GetEncodedTextBytes gets EncodedText, Tag 355, checking its length against EncodedTextLen, Tag 354
+func (m NoSides) GetEncodedTextBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedTextLen, tag.EncodedText)
+}
+
+//GetEncodedTextDecoded gets EncodedText, Tag 355, decoded to UTF-8 using the MessageEncoding of h, the Header of the message holding it
+func (m NoSides) GetEncodedTextDecoded(h fix44.Header) (string, error) {
+	return fix44.DecodeRawData(h, m, tag.EncodedTextLen, tag.EncodedText)
+}
+
 //HasPositionEffect returns true if PositionEffect is present, Tag 77
 func (m NoSides) HasPositionEffect() bool {
 	return m.Has(tag.PositionEffect)
@@ -3156,173 +3148,63 @@ func (m NoSides) HasSideComplianceID() bool {
 	return m.Has(tag.SideComplianceID)
 }
 
-//NoPartyIDs is a repeating group element, Tag 453
-type NoPartyIDs struct {
-	*quickfix.Group
-}
-
-//SetPartyID sets PartyID, Tag 448
-func (m NoPartyIDs) SetPartyID(v string) {
-	m.Set(field.NewPartyID(v))
+//GetParties gets the Parties component
+func (m NoSides) GetParties() components.Parties {
+	return components.Parties{&m.Group.FieldMap}
 }
 
-//SetPartyIDSource sets PartyIDSource, Tag 447
-func (m NoPartyIDs) SetPartyIDSource(v enum.PartyIDSource) {
-	m.Set(field.NewPartyIDSource(v))
+//SetParties sets the Parties component, copying the fields present in c
+func (m NoSides) SetParties(c components.Parties) quickfix.MessageRejectError {
+	return c.CopyInto(&m.Group.FieldMap)
 }
 
-//SetPartyRole sets PartyRole, Tag 452
-func (m NoPartyIDs) SetPartyRole(v enum.PartyRole) {
-	m.Set(field.NewPartyRole(v))
+//GetOrderQtyData gets the OrderQtyData component
+func (m NoSides) GetOrderQtyData() components.OrderQtyData {
+	return components.OrderQtyData{&m.Group.FieldMap}
 }
 
-//SetNoPartySubIDs sets NoPartySubIDs, Tag 802
-func (m NoPartyIDs) SetNoPartySubIDs(f NoPartySubIDsRepeatingGroup) {
-	m.SetGroup(f)
+//SetOrderQtyData sets the OrderQtyData component, copying the fields present in c
+func (m NoSides) SetOrderQtyData(c components.OrderQtyData) quickfix.MessageRejectError {
+	return c.CopyInto(&m.Group.FieldMap)
 }
 
-//GetPartyID gets PartyID, Tag 448
-func (m NoPartyIDs) GetPartyID() (v string, err quickfix.MessageRejectError) {
-	var f field.PartyIDField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
+//GetCommissionData gets the CommissionData component
+func (m NoSides) GetCommissionData() components.CommissionData {
+	return components.CommissionData{&m.Group.FieldMap}
 }
 
-//GetPartyIDSource gets PartyIDSource, Tag 447
-func (m NoPartyIDs) GetPartyIDSource() (v enum.PartyIDSource, err quickfix.MessageRejectError) {
-	var f field.PartyIDSourceField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
+//SetCommissionData sets the CommissionData component, copying the fields present in c
+func (m NoSides) SetCommissionData(c components.CommissionData) quickfix.MessageRejectError {
+	return c.CopyInto(&m.Group.FieldMap)
 }
 
-//GetPartyRole gets PartyRole, Tag 452
-func (m NoPartyIDs) GetPartyRole() (v enum.PartyRole, err quickfix.MessageRejectError) {
-	var f field.PartyRoleField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetNoPartySubIDs gets NoPartySubIDs, Tag 802
-func (m NoPartyIDs) GetNoPartySubIDs() (NoPartySubIDsRepeatingGroup, quickfix.MessageRejectError) {
-	f := NewNoPartySubIDsRepeatingGroup()
-	err := m.GetGroup(f)
-	return f, err
-}
-
-//HasPartyID returns true if PartyID is present, Tag 448
-func (m NoPartyIDs) HasPartyID() bool {
-	return m.Has(tag.PartyID)
-}
+//NoPartyIDs is a repeating group element, Tag 453
+type NoPartyIDs = components.NoPartyIDs
 
-//HasPartyIDSource returns true if PartyIDSource is present, Tag 447
-func (m NoPartyIDs) HasPartyIDSource() bool {
-	return m.Has(tag.PartyIDSource)
-}
+//NoPartyIDsRepeatingGroup is a repeating group, Tag 453
+type NoPartyIDsRepeatingGroup = components.NoPartyIDsRepeatingGroup
 
-//HasPartyRole returns true if PartyRole is present, Tag 452
-func (m NoPartyIDs) HasPartyRole() bool {
-	return m.Has(tag.PartyRole)
+//NewNoPartyIDsRepeatingGroup returns an initialized, NoPartyIDsRepeatingGroup
+func NewNoPartyIDsRepeatingGroup() NoPartyIDsRepeatingGroup {
+	return components.NewNoPartyIDsRepeatingGroup()
 }
 
-//HasNoPartySubIDs returns true if NoPartySubIDs is present, Tag 802
-func (m NoPartyIDs) HasNoPartySubIDs() bool {
-	return m.Has(tag.NoPartySubIDs)
-}
+//NoPartyIDsStruct is a plain Go representation of a NoPartyIDs group element
+type NoPartyIDsStruct = components.NoPartyIDsStruct
 
 //NoPartySubIDs is a repeating group element, Tag 802
-type NoPartySubIDs struct {
-	*quickfix.Group
-}
-
-//SetPartySubID sets PartySubID, Tag 523
-func (m NoPartySubIDs) SetPartySubID(v string) {
-	m.Set(field.NewPartySubID(v))
-}
-
-//SetPartySubIDType sets PartySubIDType, Tag 803
-func (m NoPartySubIDs) SetPartySubIDType(v enum.PartySubIDType) {
-	m.Set(field.NewPartySubIDType(v))
-}
-
-//GetPartySubID gets PartySubID, Tag 523
-func (m NoPartySubIDs) GetPartySubID() (v string, err quickfix.MessageRejectError) {
-	var f field.PartySubIDField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetPartySubIDType gets PartySubIDType, Tag 803
-func (m NoPartySubIDs) GetPartySubIDType() (v enum.PartySubIDType, err quickfix.MessageRejectError) {
-	var f field.PartySubIDTypeField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//HasPartySubID returns true if PartySubID is present, Tag 523
-func (m NoPartySubIDs) HasPartySubID() bool {
-	return m.Has(tag.PartySubID)
-}
-
-//HasPartySubIDType returns true if PartySubIDType is present, Tag 803
-func (m NoPartySubIDs) HasPartySubIDType() bool {
-	return m.Has(tag.PartySubIDType)
-}
+type NoPartySubIDs = components.NoPartySubIDs
 
 //NoPartySubIDsRepeatingGroup is a repeating group, Tag 802
-type NoPartySubIDsRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
+type NoPartySubIDsRepeatingGroup = components.NoPartySubIDsRepeatingGroup
 
 //NewNoPartySubIDsRepeatingGroup returns an initialized, NoPartySubIDsRepeatingGroup
 func NewNoPartySubIDsRepeatingGroup() NoPartySubIDsRepeatingGroup {
-	return NoPartySubIDsRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoPartySubIDs,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.PartySubID), quickfix.GroupElement(tag.PartySubIDType)})}
-}
-
-//Add create and append a new NoPartySubIDs to this group
-func (m NoPartySubIDsRepeatingGroup) Add() NoPartySubIDs {
-	g := m.RepeatingGroup.Add()
-	return NoPartySubIDs{g}
-}
-
-//Get returns the ith NoPartySubIDs in the NoPartySubIDsRepeatinGroup
-func (m NoPartySubIDsRepeatingGroup) Get(i int) NoPartySubIDs {
-	return NoPartySubIDs{m.RepeatingGroup.Get(i)}
-}
-
-//NoPartyIDsRepeatingGroup is a repeating group, Tag 453
-type NoPartyIDsRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
-
-//NewNoPartyIDsRepeatingGroup returns an initialized, NoPartyIDsRepeatingGroup
-func NewNoPartyIDsRepeatingGroup() NoPartyIDsRepeatingGroup {
-	return NoPartyIDsRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoPartyIDs,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.PartyID), quickfix.GroupElement(tag.PartyIDSource), quickfix.GroupElement(tag.PartyRole), NewNoPartySubIDsRepeatingGroup()})}
+	return components.NewNoPartySubIDsRepeatingGroup()
 }
 
-//Add create and append a new NoPartyIDs to this group
-func (m NoPartyIDsRepeatingGroup) Add() NoPartyIDs {
-	g := m.RepeatingGroup.Add()
-	return NoPartyIDs{g}
-}
-
-//Get returns the ith NoPartyIDs in the NoPartyIDsRepeatinGroup
-func (m NoPartyIDsRepeatingGroup) Get(i int) NoPartyIDs {
-	return NoPartyIDs{m.RepeatingGroup.Get(i)}
-}
+//NoPartySubIDsStruct is a plain Go representation of a NoPartySubIDs group element
+type NoPartySubIDsStruct = components.NoPartySubIDsStruct
 
 //NoAllocs is a repeating group element, Tag 78
 type NoAllocs struct {
@@ -3441,173 +3323,43 @@ func (m NoAllocs) HasAllocQty() bool {
 	return m.Has(tag.AllocQty)
 }
 
-//NoNestedPartyIDs is a repeating group element, Tag 539
-type NoNestedPartyIDs struct {
-	*quickfix.Group
-}
-
-//SetNestedPartyID sets NestedPartyID, Tag 524
-func (m NoNestedPartyIDs) SetNestedPartyID(v string) {
-	m.Set(field.NewNestedPartyID(v))
-}
-
-//SetNestedPartyIDSource sets NestedPartyIDSource, Tag 525
-func (m NoNestedPartyIDs) SetNestedPartyIDSource(v string) {
-	m.Set(field.NewNestedPartyIDSource(v))
+//GetNestedParties gets the NestedParties component
+func (m NoAllocs) GetNestedParties() components.NestedParties {
+	return components.NestedParties{&m.Group.FieldMap}
 }
 
-//SetNestedPartyRole sets NestedPartyRole, Tag 538
-func (m NoNestedPartyIDs) SetNestedPartyRole(v int) {
-	m.Set(field.NewNestedPartyRole(v))
+//SetNestedParties sets the NestedParties component, copying the fields present in c
+func (m NoAllocs) SetNestedParties(c components.NestedParties) quickfix.MessageRejectError {
+	return c.CopyInto(&m.Group.FieldMap)
 }
 
-//SetNoNestedPartySubIDs sets NoNestedPartySubIDs, Tag 804
-func (m NoNestedPartyIDs) SetNoNestedPartySubIDs(f NoNestedPartySubIDsRepeatingGroup) {
-	m.SetGroup(f)
-}
-
-//GetNestedPartyID gets NestedPartyID, Tag 524
-func (m NoNestedPartyIDs) GetNestedPartyID() (v string, err quickfix.MessageRejectError) {
-	var f field.NestedPartyIDField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetNestedPartyIDSource gets NestedPartyIDSource, Tag 525
-func (m NoNestedPartyIDs) GetNestedPartyIDSource() (v string, err quickfix.MessageRejectError) {
-	var f field.NestedPartyIDSourceField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetNestedPartyRole gets NestedPartyRole, Tag 538
-func (m NoNestedPartyIDs) GetNestedPartyRole() (v int, err quickfix.MessageRejectError) {
-	var f field.NestedPartyRoleField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetNoNestedPartySubIDs gets NoNestedPartySubIDs, Tag 804
-func (m NoNestedPartyIDs) GetNoNestedPartySubIDs() (NoNestedPartySubIDsRepeatingGroup, quickfix.MessageRejectError) {
-	f := NewNoNestedPartySubIDsRepeatingGroup()
-	err := m.GetGroup(f)
-	return f, err
-}
-
-//HasNestedPartyID returns true if NestedPartyID is present, Tag 524
-func (m NoNestedPartyIDs) HasNestedPartyID() bool {
-	return m.Has(tag.NestedPartyID)
-}
+//NoNestedPartyIDs is a repeating group element, Tag 539
+type NoNestedPartyIDs = components.NoNestedPartyIDs
 
-//HasNestedPartyIDSource returns true if NestedPartyIDSource is present, Tag 525
-func (m NoNestedPartyIDs) HasNestedPartyIDSource() bool {
-	return m.Has(tag.NestedPartyIDSource)
-}
+//NoNestedPartyIDsRepeatingGroup is a repeating group, Tag 539
+type NoNestedPartyIDsRepeatingGroup = components.NoNestedPartyIDsRepeatingGroup
 
-//HasNestedPartyRole returns true if NestedPartyRole is present, Tag 538
-func (m NoNestedPartyIDs) HasNestedPartyRole() bool {
-	return m.Has(tag.NestedPartyRole)
+//NewNoNestedPartyIDsRepeatingGroup returns an initialized, NoNestedPartyIDsRepeatingGroup
+func NewNoNestedPartyIDsRepeatingGroup() NoNestedPartyIDsRepeatingGroup {
+	return components.NewNoNestedPartyIDsRepeatingGroup()
 }
 
-//HasNoNestedPartySubIDs returns true if NoNestedPartySubIDs is present, Tag 804
-func (m NoNestedPartyIDs) HasNoNestedPartySubIDs() bool {
-	return m.Has(tag.NoNestedPartySubIDs)
-}
+//NoNestedPartyIDsStruct is a plain Go representation of a NoNestedPartyIDs group element
+type NoNestedPartyIDsStruct = components.NoNestedPartyIDsStruct
 
 //NoNestedPartySubIDs is a repeating group element, Tag 804
-type NoNestedPartySubIDs struct {
-	*quickfix.Group
-}
-
-//SetNestedPartySubID sets NestedPartySubID, Tag 545
-func (m NoNestedPartySubIDs) SetNestedPartySubID(v string) {
-	m.Set(field.NewNestedPartySubID(v))
-}
-
-//SetNestedPartySubIDType sets NestedPartySubIDType, Tag 805
-func (m NoNestedPartySubIDs) SetNestedPartySubIDType(v int) {
-	m.Set(field.NewNestedPartySubIDType(v))
-}
-
-//GetNestedPartySubID gets NestedPartySubID, Tag 545
-func (m NoNestedPartySubIDs) GetNestedPartySubID() (v string, err quickfix.MessageRejectError) {
-	var f field.NestedPartySubIDField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetNestedPartySubIDType gets NestedPartySubIDType, Tag 805
-func (m NoNestedPartySubIDs) GetNestedPartySubIDType() (v int, err quickfix.MessageRejectError) {
-	var f field.NestedPartySubIDTypeField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//HasNestedPartySubID returns true if NestedPartySubID is present, Tag 545
-func (m NoNestedPartySubIDs) HasNestedPartySubID() bool {
-	return m.Has(tag.NestedPartySubID)
-}
-
-//HasNestedPartySubIDType returns true if NestedPartySubIDType is present, Tag 805
-func (m NoNestedPartySubIDs) HasNestedPartySubIDType() bool {
-	return m.Has(tag.NestedPartySubIDType)
-}
+type NoNestedPartySubIDs = components.NoNestedPartySubIDs
 
 //NoNestedPartySubIDsRepeatingGroup is a repeating group, Tag 804
-type NoNestedPartySubIDsRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
+type NoNestedPartySubIDsRepeatingGroup = components.NoNestedPartySubIDsRepeatingGroup
 
 //NewNoNestedPartySubIDsRepeatingGroup returns an initialized, NoNestedPartySubIDsRepeatingGroup
 func NewNoNestedPartySubIDsRepeatingGroup() NoNestedPartySubIDsRepeatingGroup {
-	return NoNestedPartySubIDsRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoNestedPartySubIDs,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.NestedPartySubID), quickfix.GroupElement(tag.NestedPartySubIDType)})}
-}
-
-//Add create and append a new NoNestedPartySubIDs to this group
-func (m NoNestedPartySubIDsRepeatingGroup) Add() NoNestedPartySubIDs {
-	g := m.RepeatingGroup.Add()
-	return NoNestedPartySubIDs{g}
-}
-
-//Get returns the ith NoNestedPartySubIDs in the NoNestedPartySubIDsRepeatinGroup
-func (m NoNestedPartySubIDsRepeatingGroup) Get(i int) NoNestedPartySubIDs {
-	return NoNestedPartySubIDs{m.RepeatingGroup.Get(i)}
-}
-
-//NoNestedPartyIDsRepeatingGroup is a repeating group, Tag 539
-type NoNestedPartyIDsRepeatingGroup struct {
-	*quickfix.RepeatingGroup
+	return components.NewNoNestedPartySubIDsRepeatingGroup()
 }
 
-//NewNoNestedPartyIDsRepeatingGroup returns an initialized, NoNestedPartyIDsRepeatingGroup
-func NewNoNestedPartyIDsRepeatingGroup() NoNestedPartyIDsRepeatingGroup {
-	return NoNestedPartyIDsRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoNestedPartyIDs,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.NestedPartyID), quickfix.GroupElement(tag.NestedPartyIDSource), quickfix.GroupElement(tag.NestedPartyRole), NewNoNestedPartySubIDsRepeatingGroup()})}
-}
-
-//Add create and append a new NoNestedPartyIDs to this group
-func (m NoNestedPartyIDsRepeatingGroup) Add() NoNestedPartyIDs {
-	g := m.RepeatingGroup.Add()
-	return NoNestedPartyIDs{g}
-}
-
-//Get returns the ith NoNestedPartyIDs in the NoNestedPartyIDsRepeatinGroup
-func (m NoNestedPartyIDsRepeatingGroup) Get(i int) NoNestedPartyIDs {
-	return NoNestedPartyIDs{m.RepeatingGroup.Get(i)}
-}
+//NoNestedPartySubIDsStruct is a plain Go representation of a NoNestedPartySubIDs group element
+type NoNestedPartySubIDsStruct = components.NoNestedPartySubIDsStruct
 
 //NoAllocsRepeatingGroup is a repeating group, Tag 78
 type NoAllocsRepeatingGroup struct {
@@ -4406,6 +4158,21 @@ func (m NoLegs) HasEncodedLegIssuer() bool {
 	return m.Has(tag.EncodedLegIssuer)
 }
 
+//SetEncodedLegIssuerBytes sets EncodedLegIssuer, Tag 619, and EncodedLegIssuerLen, Tag 618, from v, which may hold any bytes including SOH
+func (m NoLegs) SetEncodedLegIssuerBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedLegIssuerLen, tag.EncodedLegIssuer, v)
+}
+
+//GetEncodedLegIssuerBytes gets EncodedLegIssuer, Tag 619, checking its length against EncodedLegIssuerLen, Tag 618
+func (m NoLegs) GetEncodedLegIssuerBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedLegIssuerLen, tag.EncodedLegIssuer)
+}
+
+//GetEncodedLegIssuerDecoded gets EncodedLegIssuer, Tag 619, decoded to UTF-8 using the MessageEncoding of h, the Header of the message holding it
+func (m NoLegs) GetEncodedLegIssuerDecoded(h fix44.Header) (string, error) {
+	return fix44.DecodeRawData(h, m, tag.EncodedLegIssuerLen, tag.EncodedLegIssuer)
+}
+
 //HasLegSecurityDesc returns true if LegSecurityDesc is present, Tag 620
 func (m NoLegs) HasLegSecurityDesc() bool {
 	return m.Has(tag.LegSecurityDesc)
@@ -4421,6 +4188,21 @@ func (m NoLegs) HasEncodedLegSecurityDesc() bool {
 	return m.Has(tag.EncodedLegSecurityDesc)
 }
 
+//SetEncodedLegSecurityDescBytes sets EncodedLegSecurityDesc, Tag 622, and EncodedLegSecurityDescLen, Tag 621, from v, which may hold any bytes including SOH
+func (m NoLegs) SetEncodedLegSecurityDescBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedLegSecurityDescLen, tag.EncodedLegSecurityDesc, v)
+}
+
+//GetEncodedLegSecurityDescBytes gets EncodedLegSecurityDesc, Tag 622, checking its length against EncodedLegSecurityDescLen, Tag 621
+func (m NoLegs) GetEncodedLegSecurityDescBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedLegSecurityDescLen, tag.EncodedLegSecurityDesc)
+}
+
+//GetEncodedLegSecurityDescDecoded gets EncodedLegSecurityDesc, Tag 622, decoded to UTF-8 using the MessageEncoding of h, the Header of the message holding it
+func (m NoLegs) GetEncodedLegSecurityDescDecoded(h fix44.Header) (string, error) {
+	return fix44.DecodeRawData(h, m, tag.EncodedLegSecurityDescLen, tag.EncodedLegSecurityDesc)
+}
+
 //HasLegRatioQty returns true if LegRatioQty is present, Tag 623
 func (m NoLegs) HasLegRatioQty() bool {
 	return m.Has(tag.LegRatioQty)
@@ -4456,71 +4238,29 @@ func (m NoLegs) HasLegInterestAccrualDate() bool {
 	return m.Has(tag.LegInterestAccrualDate)
 }
 
-//NoLegSecurityAltID is a repeating group element, Tag 604
-type NoLegSecurityAltID struct {
-	*quickfix.Group
-}
-
-//SetLegSecurityAltID sets LegSecurityAltID, Tag 605
-func (m NoLegSecurityAltID) SetLegSecurityAltID(v string) {
-	m.Set(field.NewLegSecurityAltID(v))
-}
-
-//SetLegSecurityAltIDSource sets LegSecurityAltIDSource, Tag 606
-func (m NoLegSecurityAltID) SetLegSecurityAltIDSource(v string) {
-	m.Set(field.NewLegSecurityAltIDSource(v))
-}
-
-//GetLegSecurityAltID gets LegSecurityAltID, Tag 605
-func (m NoLegSecurityAltID) GetLegSecurityAltID() (v string, err quickfix.MessageRejectError) {
-	var f field.LegSecurityAltIDField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetLegSecurityAltIDSource gets LegSecurityAltIDSource, Tag 606
-func (m NoLegSecurityAltID) GetLegSecurityAltIDSource() (v string, err quickfix.MessageRejectError) {
-	var f field.LegSecurityAltIDSourceField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
+//GetInstrumentLeg gets the InstrumentLeg component
+func (m NoLegs) GetInstrumentLeg() components.InstrumentLeg {
+	return components.InstrumentLeg{&m.Group.FieldMap}
 }
 
-//HasLegSecurityAltID returns true if LegSecurityAltID is present, Tag 605
-func (m NoLegSecurityAltID) HasLegSecurityAltID() bool {
-	return m.Has(tag.LegSecurityAltID)
+//SetInstrumentLeg sets the InstrumentLeg component, copying the fields present in c
+func (m NoLegs) SetInstrumentLeg(c components.InstrumentLeg) quickfix.MessageRejectError {
+	return c.CopyInto(&m.Group.FieldMap)
 }
 
-//HasLegSecurityAltIDSource returns true if LegSecurityAltIDSource is present, Tag 606
-func (m NoLegSecurityAltID) HasLegSecurityAltIDSource() bool {
-	return m.Has(tag.LegSecurityAltIDSource)
-}
+//NoLegSecurityAltID is a repeating group element, Tag 604
+type NoLegSecurityAltID = components.NoLegSecurityAltID
 
 //NoLegSecurityAltIDRepeatingGroup is a repeating group, Tag 604
-type NoLegSecurityAltIDRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
+type NoLegSecurityAltIDRepeatingGroup = components.NoLegSecurityAltIDRepeatingGroup
 
 //NewNoLegSecurityAltIDRepeatingGroup returns an initialized, NoLegSecurityAltIDRepeatingGroup
 func NewNoLegSecurityAltIDRepeatingGroup() NoLegSecurityAltIDRepeatingGroup {
-	return NoLegSecurityAltIDRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoLegSecurityAltID,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.LegSecurityAltID), quickfix.GroupElement(tag.LegSecurityAltIDSource)})}
+	return components.NewNoLegSecurityAltIDRepeatingGroup()
 }
 
-//Add create and append a new NoLegSecurityAltID to this group
-func (m NoLegSecurityAltIDRepeatingGroup) Add() NoLegSecurityAltID {
-	g := m.RepeatingGroup.Add()
-	return NoLegSecurityAltID{g}
-}
-
-//Get returns the ith NoLegSecurityAltID in the NoLegSecurityAltIDRepeatinGroup
-func (m NoLegSecurityAltIDRepeatingGroup) Get(i int) NoLegSecurityAltID {
-	return NoLegSecurityAltID{m.RepeatingGroup.Get(i)}
-}
+//NoLegSecurityAltIDStruct is a plain Go representation of a NoLegSecurityAltID group element
+type NoLegSecurityAltIDStruct = components.NoLegSecurityAltIDStruct
 
 //NoLegsRepeatingGroup is a repeating group, Tag 555
 type NoLegsRepeatingGroup struct {
@@ -5350,6 +5090,21 @@ func (m NoUnderlyings) HasEncodedUnderlyingIssuer() bool {
 	return m.Has(tag.EncodedUnderlyingIssuer)
 }
 
+//SetEncodedUnderlyingIssuerBytes sets EncodedUnderlyingIssuer, Tag 363, and EncodedUnderlyingIssuerLen, Tag 362, from v, which may hold any bytes including SOH
+func (m NoUnderlyings) SetEncodedUnderlyingIssuerBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedUnderlyingIssuerLen, tag.EncodedUnderlyingIssuer, v)
+}
+
+//GetEncodedUnderlyingIssuerBytes gets EncodedUnderlyingIssuer, Tag 363, checking its length against EncodedUnderlyingIssuerLen, Tag 362
+func (m NoUnderlyings) GetEncodedUnderlyingIssuerBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedUnderlyingIssuerLen, tag.EncodedUnderlyingIssuer)
+}
+
+//GetEncodedUnderlyingIssuerDecoded gets EncodedUnderlyingIssuer, Tag 363, decoded to UTF-8 using the MessageEncoding of h, the Header of the message holding it
+func (m NoUnderlyings) GetEncodedUnderlyingIssuerDecoded(h fix44.Header) (string, error) {
+	return fix44.DecodeRawData(h, m, tag.EncodedUnderlyingIssuerLen, tag.EncodedUnderlyingIssuer)
+}
+
 //HasUnderlyingSecurityDesc returns true if UnderlyingSecurityDesc is present, Tag 307
 func (m NoUnderlyings) HasUnderlyingSecurityDesc() bool {
 	return m.Has(tag.UnderlyingSecurityDesc)
@@ -5365,6 +5120,21 @@ func (m NoUnderlyings) HasEncodedUnderlyingSecurityDesc() bool {
 	return m.Has(tag.EncodedUnderlyingSecurityDesc)
 }
 
+//SetEncodedUnderlyingSecurityDescBytes sets EncodedUnderlyingSecurityDesc, Tag 365, and EncodedUnderlyingSecurityDescLen, Tag 364, from v, which may hold any bytes including SOH
+func (m NoUnderlyings) SetEncodedUnderlyingSecurityDescBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedUnderlyingSecurityDescLen, tag.EncodedUnderlyingSecurityDesc, v)
+}
+
+//GetEncodedUnderlyingSecurityDescBytes gets EncodedUnderlyingSecurityDesc, Tag 365, checking its length against EncodedUnderlyingSecurityDescLen, Tag 364
+func (m NoUnderlyings) GetEncodedUnderlyingSecurityDescBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedUnderlyingSecurityDescLen, tag.EncodedUnderlyingSecurityDesc)
+}
+
+//GetEncodedUnderlyingSecurityDescDecoded gets EncodedUnderlyingSecurityDesc, Tag 365, decoded to UTF-8 using the MessageEncoding of h, the Header of the message holding it
+func (m NoUnderlyings) GetEncodedUnderlyingSecurityDescDecoded(h fix44.Header) (string, error) {
+	return fix44.DecodeRawData(h, m, tag.EncodedUnderlyingSecurityDescLen, tag.EncodedUnderlyingSecurityDesc)
+}
+
 //HasUnderlyingCPProgram returns true if UnderlyingCPProgram is present, Tag 877
 func (m NoUnderlyings) HasUnderlyingCPProgram() bool {
 	return m.Has(tag.UnderlyingCPProgram)
@@ -5420,137 +5190,43 @@ func (m NoUnderlyings) HasNoUnderlyingStips() bool {
 	return m.Has(tag.NoUnderlyingStips)
 }
 
-//NoUnderlyingSecurityAltID is a repeating group element, Tag 457
-type NoUnderlyingSecurityAltID struct {
-	*quickfix.Group
+//GetUnderlyingInstrument gets the UnderlyingInstrument component
+func (m NoUnderlyings) GetUnderlyingInstrument() components.UnderlyingInstrument {
+	return components.UnderlyingInstrument{&m.Group.FieldMap}
 }
 
-//SetUnderlyingSecurityAltID sets UnderlyingSecurityAltID, Tag 458
-func (m NoUnderlyingSecurityAltID) SetUnderlyingSecurityAltID(v string) {
-	m.Set(field.NewUnderlyingSecurityAltID(v))
+//SetUnderlyingInstrument sets the UnderlyingInstrument component, copying the fields present in c
+func (m NoUnderlyings) SetUnderlyingInstrument(c components.UnderlyingInstrument) quickfix.MessageRejectError {
+	return c.CopyInto(&m.Group.FieldMap)
 }
 
-//SetUnderlyingSecurityAltIDSource sets UnderlyingSecurityAltIDSource, Tag 459
-func (m NoUnderlyingSecurityAltID) SetUnderlyingSecurityAltIDSource(v string) {
-	m.Set(field.NewUnderlyingSecurityAltIDSource(v))
-}
-
-//GetUnderlyingSecurityAltID gets UnderlyingSecurityAltID, Tag 458
-func (m NoUnderlyingSecurityAltID) GetUnderlyingSecurityAltID() (v string, err quickfix.MessageRejectError) {
-	var f field.UnderlyingSecurityAltIDField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetUnderlyingSecurityAltIDSource gets UnderlyingSecurityAltIDSource, Tag 459
-func (m NoUnderlyingSecurityAltID) GetUnderlyingSecurityAltIDSource() (v string, err quickfix.MessageRejectError) {
-	var f field.UnderlyingSecurityAltIDSourceField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//HasUnderlyingSecurityAltID returns true if UnderlyingSecurityAltID is present, Tag 458
-func (m NoUnderlyingSecurityAltID) HasUnderlyingSecurityAltID() bool {
-	return m.Has(tag.UnderlyingSecurityAltID)
-}
-
-//HasUnderlyingSecurityAltIDSource returns true if UnderlyingSecurityAltIDSource is present, Tag 459
-func (m NoUnderlyingSecurityAltID) HasUnderlyingSecurityAltIDSource() bool {
-	return m.Has(tag.UnderlyingSecurityAltIDSource)
-}
+//NoUnderlyingSecurityAltID is a repeating group element, Tag 457
+type NoUnderlyingSecurityAltID = components.NoUnderlyingSecurityAltID
 
 //NoUnderlyingSecurityAltIDRepeatingGroup is a repeating group, Tag 457
-type NoUnderlyingSecurityAltIDRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
+type NoUnderlyingSecurityAltIDRepeatingGroup = components.NoUnderlyingSecurityAltIDRepeatingGroup
 
 //NewNoUnderlyingSecurityAltIDRepeatingGroup returns an initialized, NoUnderlyingSecurityAltIDRepeatingGroup
 func NewNoUnderlyingSecurityAltIDRepeatingGroup() NoUnderlyingSecurityAltIDRepeatingGroup {
-	return NoUnderlyingSecurityAltIDRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoUnderlyingSecurityAltID,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.UnderlyingSecurityAltID), quickfix.GroupElement(tag.UnderlyingSecurityAltIDSource)})}
-}
-
-//Add create and append a new NoUnderlyingSecurityAltID to this group
-func (m NoUnderlyingSecurityAltIDRepeatingGroup) Add() NoUnderlyingSecurityAltID {
-	g := m.RepeatingGroup.Add()
-	return NoUnderlyingSecurityAltID{g}
+	return components.NewNoUnderlyingSecurityAltIDRepeatingGroup()
 }
 
-//Get returns the ith NoUnderlyingSecurityAltID in the NoUnderlyingSecurityAltIDRepeatinGroup
-func (m NoUnderlyingSecurityAltIDRepeatingGroup) Get(i int) NoUnderlyingSecurityAltID {
-	return NoUnderlyingSecurityAltID{m.RepeatingGroup.Get(i)}
-}
+//NoUnderlyingSecurityAltIDStruct is a plain Go representation of a NoUnderlyingSecurityAltID group element
+type NoUnderlyingSecurityAltIDStruct = components.NoUnderlyingSecurityAltIDStruct
 
 //NoUnderlyingStips is a repeating group element, Tag 887
-type NoUnderlyingStips struct {
-	*quickfix.Group
-}
-
-//SetUnderlyingStipType sets UnderlyingStipType, Tag 888
-func (m NoUnderlyingStips) SetUnderlyingStipType(v string) {
-	m.Set(field.NewUnderlyingStipType(v))
-}
-
-//SetUnderlyingStipValue sets UnderlyingStipValue, Tag 889
-func (m NoUnderlyingStips) SetUnderlyingStipValue(v string) {
-	m.Set(field.NewUnderlyingStipValue(v))
-}
-
-//GetUnderlyingStipType gets UnderlyingStipType, Tag 888
-func (m NoUnderlyingStips) GetUnderlyingStipType() (v string, err quickfix.MessageRejectError) {
-	var f field.UnderlyingStipTypeField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetUnderlyingStipValue gets UnderlyingStipValue, Tag 889
-func (m NoUnderlyingStips) GetUnderlyingStipValue() (v string, err quickfix.MessageRejectError) {
-	var f field.UnderlyingStipValueField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//HasUnderlyingStipType returns true if UnderlyingStipType is present, Tag 888
-func (m NoUnderlyingStips) HasUnderlyingStipType() bool {
-	return m.Has(tag.UnderlyingStipType)
-}
-
-//HasUnderlyingStipValue returns true if UnderlyingStipValue is present, Tag 889
-func (m NoUnderlyingStips) HasUnderlyingStipValue() bool {
-	return m.Has(tag.UnderlyingStipValue)
-}
+type NoUnderlyingStips = components.NoUnderlyingStips
 
 //NoUnderlyingStipsRepeatingGroup is a repeating group, Tag 887
-type NoUnderlyingStipsRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
+type NoUnderlyingStipsRepeatingGroup = components.NoUnderlyingStipsRepeatingGroup
 
 //NewNoUnderlyingStipsRepeatingGroup returns an initialized, NoUnderlyingStipsRepeatingGroup
 func NewNoUnderlyingStipsRepeatingGroup() NoUnderlyingStipsRepeatingGroup {
-	return NoUnderlyingStipsRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoUnderlyingStips,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.UnderlyingStipType), quickfix.GroupElement(tag.UnderlyingStipValue)})}
+	return components.NewNoUnderlyingStipsRepeatingGroup()
 }
 
-//Add create and append a new NoUnderlyingStips to this group
-func (m NoUnderlyingStipsRepeatingGroup) Add() NoUnderlyingStips {
-	g := m.RepeatingGroup.Add()
-	return NoUnderlyingStips{g}
-}
-
-//Get returns the ith NoUnderlyingStips in the NoUnderlyingStipsRepeatinGroup
-func (m NoUnderlyingStipsRepeatingGroup) Get(i int) NoUnderlyingStips {
-	return NoUnderlyingStips{m.RepeatingGroup.Get(i)}
-}
+//NoUnderlyingStipsStruct is a plain Go representation of a NoUnderlyingStips group element
+type NoUnderlyingStipsStruct = components.NoUnderlyingStipsStruct
 
 //NoUnderlyingsRepeatingGroup is a repeating group, Tag 711
 type NoUnderlyingsRepeatingGroup struct {
@@ -5576,105 +5252,2888 @@ func (m NoUnderlyingsRepeatingGroup) Get(i int) NoUnderlyings {
 }
 
 //NoEvents is a repeating group element, Tag 864
-type NoEvents struct {
-	*quickfix.Group
-}
-
-//SetEventType sets EventType, Tag 865
-func (m NoEvents) SetEventType(v enum.EventType) {
-	m.Set(field.NewEventType(v))
-}
-
-//SetEventDate sets EventDate, Tag 866
-func (m NoEvents) SetEventDate(v string) {
-	m.Set(field.NewEventDate(v))
-}
+type NoEvents = components.NoEvents
 
-//SetEventPx sets EventPx, Tag 867
-func (m NoEvents) SetEventPx(value decimal.Decimal, scale int32) {
-	m.Set(field.NewEventPx(value, scale))
-}
-
-//SetEventText sets EventText, Tag 868
-func (m NoEvents) SetEventText(v string) {
-	m.Set(field.NewEventText(v))
-}
+//NoEventsRepeatingGroup is a repeating group, Tag 864
+type NoEventsRepeatingGroup = components.NoEventsRepeatingGroup
 
-//GetEventType gets EventType, Tag 865
-func (m NoEvents) GetEventType() (v enum.EventType, err quickfix.MessageRejectError) {
-	var f field.EventTypeField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
+//NewNoEventsRepeatingGroup returns an initialized, NoEventsRepeatingGroup
+func NewNoEventsRepeatingGroup() NoEventsRepeatingGroup {
+	return components.NewNoEventsRepeatingGroup()
+}
+
+//NoEventsStruct is a plain Go representation of a NoEvents group element
+type NoEventsStruct = components.NoEventsStruct
+
+//Struct is a plain Go representation of the CrossOrderCancelReplaceRequest body, optional fields are nil when absent
+type Struct struct {
+	Currency                   *string
+	ExecInst                   *enum.ExecInst
+	HandlInst                  *enum.HandlInst
+	SecurityIDSource           *enum.SecurityIDSource
+	IOIID                      *string
+	OrderID                    *string
+	OrdType                    enum.OrdType
+	Price                      *decimal.Decimal
+	SecurityID                 *string
+	Symbol                     *string
+	TimeInForce                *enum.TimeInForce
+	TransactTime               time.Time
+	SettlType                  *enum.SettlType
+	SettlDate                  *string
+	SymbolSfx                  *enum.SymbolSfx
+	ProcessCode                *enum.ProcessCode
+	StopPx                     *decimal.Decimal
+	ExDestination              *enum.ExDestination
+	Issuer                     *string
+	SecurityDesc               *string
+	MinQty                     *decimal.Decimal
+	MaxFloor                   *decimal.Decimal
+	LocateReqd                 *bool
+	QuoteID                    *string
+	ExpireTime                 *time.Time
+	PrevClosePx                *decimal.Decimal
+	SecurityType               *enum.SecurityType
+	EffectiveTime              *time.Time
+	MaturityMonthYear          *string
+	StrikePrice                *decimal.Decimal
+	OptAttribute               *string
+	SecurityExchange           *string
+	MaxShow                    *decimal.Decimal
+	PegOffsetValue             *decimal.Decimal
+	Spread                     *decimal.Decimal
+	BenchmarkCurveCurrency     *string
+	BenchmarkCurveName         *enum.BenchmarkCurveName
+	BenchmarkCurvePoint        *string
+	CouponRate                 *decimal.Decimal
+	CouponPaymentDate          *string
+	IssueDate                  *string
+	RepurchaseTerm             *int
+	RepurchaseRate             *decimal.Decimal
+	Factor                     *decimal.Decimal
+	ContractMultiplier         *decimal.Decimal
+	NoStipulations             []NoStipulationsStruct
+	YieldType                  *enum.YieldType
+	Yield                      *decimal.Decimal
+	RepoCollateralSecurityType *int
+	RedemptionDate             *string
+	CreditRating               *string
+	EncodedIssuerLen           *int
+	EncodedIssuer              *string
+	EncodedSecurityDescLen     *int
+	EncodedSecurityDesc        *string
+	ComplianceID               *string
+	NoTradingSessions          []NoTradingSessionsStruct
+	DiscretionInst             *enum.DiscretionInst
+	DiscretionOffsetValue      *decimal.Decimal
+	PriceType                  *enum.PriceType
+	GTBookingInst              *enum.GTBookingInst
+	ExpireDate                 *string
+	NoSecurityAltID            []NoSecurityAltIDStruct
+	Product                    *enum.Product
+	CFICode                    *string
+	CountryOfIssue             *string
+	StateOrProvinceOfIssue     *string
+	LocaleOfIssue              *string
+	CancellationRights         *enum.CancellationRights
+	MoneyLaunderingStatus      *enum.MoneyLaunderingStatus
+	Designation                *string
+	RegistID                   *string
+	MaturityDate               *string
+	InstrRegistry              *enum.InstrRegistry
+	CrossID                    string
+	CrossType                  enum.CrossType
+	CrossPrioritization        enum.CrossPrioritization
+	OrigCrossID                string
+	NoSides                    []NoSidesStruct
+	NoLegs                     []NoLegsStruct
+	BenchmarkPrice             *decimal.Decimal
+	BenchmarkPriceType         *int
+	ContractSettlMonth         *string
+	Pool                       *string
+	YieldRedemptionDate        *string
+	YieldRedemptionPrice       *decimal.Decimal
+	YieldRedemptionPriceType   *int
+	BenchmarkSecurityID        *string
+	YieldCalcDate              *string
+	NoUnderlyings              []NoUnderlyingsStruct
+	BenchmarkSecurityIDSource  *string
+	SecuritySubType            *string
+	PegMoveType                *enum.PegMoveType
+	PegOffsetType              *enum.PegOffsetType
+	PegLimitType               *enum.PegLimitType
+	PegRoundDirection          *enum.PegRoundDirection
+	PegScope                   *enum.PegScope
+	DiscretionMoveType         *enum.DiscretionMoveType
+	DiscretionOffsetType       *enum.DiscretionOffsetType
+	DiscretionLimitType        *enum.DiscretionLimitType
+	DiscretionRoundDirection   *enum.DiscretionRoundDirection
+	DiscretionScope            *enum.DiscretionScope
+	TargetStrategy             *enum.TargetStrategy
+	TargetStrategyParameters   *string
+	ParticipationRate          *decimal.Decimal
+	NoEvents                   []NoEventsStruct
+	DatedDate                  *string
+	InterestAccrualDate        *string
+	CPProgram                  *enum.CPProgram
+	CPRegType                  *string
+	StrikeCurrency             *string
+}
+
+//Marshal copies the fields present in msg into a Struct
+func Marshal(msg CrossOrderCancelReplaceRequest) (Struct, quickfix.MessageRejectError) {
+	var s Struct
+	if msg.HasCurrency() {
+		v, err := msg.GetCurrency()
+		if err != nil {
+			return s, err
+		}
+		s.Currency = &v
+	}
+	if msg.HasExecInst() {
+		v, err := msg.GetExecInst()
+		if err != nil {
+			return s, err
+		}
+		s.ExecInst = &v
+	}
+	if msg.HasHandlInst() {
+		v, err := msg.GetHandlInst()
+		if err != nil {
+			return s, err
+		}
+		s.HandlInst = &v
+	}
+	if msg.HasSecurityIDSource() {
+		v, err := msg.GetSecurityIDSource()
+		if err != nil {
+			return s, err
+		}
+		s.SecurityIDSource = &v
+	}
+	if msg.HasIOIID() {
+		v, err := msg.GetIOIID()
+		if err != nil {
+			return s, err
+		}
+		s.IOIID = &v
+	}
+	if msg.HasOrderID() {
+		v, err := msg.GetOrderID()
+		if err != nil {
+			return s, err
+		}
+		s.OrderID = &v
+	}
+	if msg.HasOrdType() {
+		v, err := msg.GetOrdType()
+		if err != nil {
+			return s, err
+		}
+		s.OrdType = v
+	}
+	if msg.HasPrice() {
+		v, err := msg.GetPrice()
+		if err != nil {
+			return s, err
+		}
+		s.Price = &v
+	}
+	if msg.HasSecurityID() {
+		v, err := msg.GetSecurityID()
+		if err != nil {
+			return s, err
+		}
+		s.SecurityID = &v
+	}
+	if msg.HasSymbol() {
+		v, err := msg.GetSymbol()
+		if err != nil {
+			return s, err
+		}
+		s.Symbol = &v
+	}
+	if msg.HasTimeInForce() {
+		v, err := msg.GetTimeInForce()
+		if err != nil {
+			return s, err
+		}
+		s.TimeInForce = &v
+	}
+	if msg.HasTransactTime() {
+		v, err := msg.GetTransactTime()
+		if err != nil {
+			return s, err
+		}
+		s.TransactTime = v
+	}
+	if msg.HasSettlType() {
+		v, err := msg.GetSettlType()
+		if err != nil {
+			return s, err
+		}
+		s.SettlType = &v
+	}
+	if msg.HasSettlDate() {
+		v, err := msg.GetSettlDate()
+		if err != nil {
+			return s, err
+		}
+		s.SettlDate = &v
+	}
+	if msg.HasSymbolSfx() {
+		v, err := msg.GetSymbolSfx()
+		if err != nil {
+			return s, err
+		}
+		s.SymbolSfx = &v
+	}
+	if msg.HasProcessCode() {
+		v, err := msg.GetProcessCode()
+		if err != nil {
+			return s, err
+		}
+		s.ProcessCode = &v
+	}
+	if msg.HasStopPx() {
+		v, err := msg.GetStopPx()
+		if err != nil {
+			return s, err
+		}
+		s.StopPx = &v
+	}
+	if msg.HasExDestination() {
+		v, err := msg.GetExDestination()
+		if err != nil {
+			return s, err
+		}
+		s.ExDestination = &v
+	}
+	if msg.HasIssuer() {
+		v, err := msg.GetIssuer()
+		if err != nil {
+			return s, err
+		}
+		s.Issuer = &v
+	}
+	if msg.HasSecurityDesc() {
+		v, err := msg.GetSecurityDesc()
+		if err != nil {
+			return s, err
+		}
+		s.SecurityDesc = &v
+	}
+	if msg.HasMinQty() {
+		v, err := msg.GetMinQty()
+		if err != nil {
+			return s, err
+		}
+		s.MinQty = &v
+	}
+	if msg.HasMaxFloor() {
+		v, err := msg.GetMaxFloor()
+		if err != nil {
+			return s, err
+		}
+		s.MaxFloor = &v
+	}
+	if msg.HasLocateReqd() {
+		v, err := msg.GetLocateReqd()
+		if err != nil {
+			return s, err
+		}
+		s.LocateReqd = &v
+	}
+	if msg.HasQuoteID() {
+		v, err := msg.GetQuoteID()
+		if err != nil {
+			return s, err
+		}
+		s.QuoteID = &v
+	}
+	if msg.HasExpireTime() {
+		v, err := msg.GetExpireTime()
+		if err != nil {
+			return s, err
+		}
+		s.ExpireTime = &v
+	}
+	if msg.HasPrevClosePx() {
+		v, err := msg.GetPrevClosePx()
+		if err != nil {
+			return s, err
+		}
+		s.PrevClosePx = &v
+	}
+	if msg.HasSecurityType() {
+		v, err := msg.GetSecurityType()
+		if err != nil {
+			return s, err
+		}
+		s.SecurityType = &v
+	}
+	if msg.HasEffectiveTime() {
+		v, err := msg.GetEffectiveTime()
+		if err != nil {
+			return s, err
+		}
+		s.EffectiveTime = &v
+	}
+	if msg.HasMaturityMonthYear() {
+		v, err := msg.GetMaturityMonthYear()
+		if err != nil {
+			return s, err
+		}
+		s.MaturityMonthYear = &v
+	}
+	if msg.HasStrikePrice() {
+		v, err := msg.GetStrikePrice()
+		if err != nil {
+			return s, err
+		}
+		s.StrikePrice = &v
+	}
+	if msg.HasOptAttribute() {
+		v, err := msg.GetOptAttribute()
+		if err != nil {
+			return s, err
+		}
+		s.OptAttribute = &v
+	}
+	if msg.HasSecurityExchange() {
+		v, err := msg.GetSecurityExchange()
+		if err != nil {
+			return s, err
+		}
+		s.SecurityExchange = &v
+	}
+	if msg.HasMaxShow() {
+		v, err := msg.GetMaxShow()
+		if err != nil {
+			return s, err
+		}
+		s.MaxShow = &v
+	}
+	if msg.HasPegOffsetValue() {
+		v, err := msg.GetPegOffsetValue()
+		if err != nil {
+			return s, err
+		}
+		s.PegOffsetValue = &v
+	}
+	if msg.HasSpread() {
+		v, err := msg.GetSpread()
+		if err != nil {
+			return s, err
+		}
+		s.Spread = &v
+	}
+	if msg.HasBenchmarkCurveCurrency() {
+		v, err := msg.GetBenchmarkCurveCurrency()
+		if err != nil {
+			return s, err
+		}
+		s.BenchmarkCurveCurrency = &v
+	}
+	if msg.HasBenchmarkCurveName() {
+		v, err := msg.GetBenchmarkCurveName()
+		if err != nil {
+			return s, err
+		}
+		s.BenchmarkCurveName = &v
+	}
+	if msg.HasBenchmarkCurvePoint() {
+		v, err := msg.GetBenchmarkCurvePoint()
+		if err != nil {
+			return s, err
+		}
+		s.BenchmarkCurvePoint = &v
+	}
+	if msg.HasCouponRate() {
+		v, err := msg.GetCouponRate()
+		if err != nil {
+			return s, err
+		}
+		s.CouponRate = &v
+	}
+	if msg.HasCouponPaymentDate() {
+		v, err := msg.GetCouponPaymentDate()
+		if err != nil {
+			return s, err
+		}
+		s.CouponPaymentDate = &v
+	}
+	if msg.HasIssueDate() {
+		v, err := msg.GetIssueDate()
+		if err != nil {
+			return s, err
+		}
+		s.IssueDate = &v
+	}
+	if msg.HasRepurchaseTerm() {
+		v, err := msg.GetRepurchaseTerm()
+		if err != nil {
+			return s, err
+		}
+		s.RepurchaseTerm = &v
+	}
+	if msg.HasRepurchaseRate() {
+		v, err := msg.GetRepurchaseRate()
+		if err != nil {
+			return s, err
+		}
+		s.RepurchaseRate = &v
+	}
+	if msg.HasFactor() {
+		v, err := msg.GetFactor()
+		if err != nil {
+			return s, err
+		}
+		s.Factor = &v
+	}
+	if msg.HasContractMultiplier() {
+		v, err := msg.GetContractMultiplier()
+		if err != nil {
+			return s, err
+		}
+		s.ContractMultiplier = &v
+	}
+	if msg.HasNoStipulations() {
+		g, err := msg.GetNoStipulations()
+		if err != nil {
+			return s, err
+		}
+		if s.NoStipulations, err = components.MarshalNoStipulations(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasYieldType() {
+		v, err := msg.GetYieldType()
+		if err != nil {
+			return s, err
+		}
+		s.YieldType = &v
+	}
+	if msg.HasYield() {
+		v, err := msg.GetYield()
+		if err != nil {
+			return s, err
+		}
+		s.Yield = &v
+	}
+	if msg.HasRepoCollateralSecurityType() {
+		v, err := msg.GetRepoCollateralSecurityType()
+		if err != nil {
+			return s, err
+		}
+		s.RepoCollateralSecurityType = &v
+	}
+	if msg.HasRedemptionDate() {
+		v, err := msg.GetRedemptionDate()
+		if err != nil {
+			return s, err
+		}
+		s.RedemptionDate = &v
+	}
+	if msg.HasCreditRating() {
+		v, err := msg.GetCreditRating()
+		if err != nil {
+			return s, err
+		}
+		s.CreditRating = &v
+	}
+	if msg.HasEncodedIssuerLen() {
+		v, err := msg.GetEncodedIssuerLen()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedIssuerLen = &v
+	}
+	if msg.HasEncodedIssuer() {
+		v, err := msg.GetEncodedIssuer()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedIssuer = &v
+	}
+	if msg.HasEncodedSecurityDescLen() {
+		v, err := msg.GetEncodedSecurityDescLen()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedSecurityDescLen = &v
+	}
+	if msg.HasEncodedSecurityDesc() {
+		v, err := msg.GetEncodedSecurityDesc()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedSecurityDesc = &v
+	}
+	if msg.HasComplianceID() {
+		v, err := msg.GetComplianceID()
+		if err != nil {
+			return s, err
+		}
+		s.ComplianceID = &v
+	}
+	if msg.HasNoTradingSessions() {
+		g, err := msg.GetNoTradingSessions()
+		if err != nil {
+			return s, err
+		}
+		if s.NoTradingSessions, err = MarshalNoTradingSessions(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasDiscretionInst() {
+		v, err := msg.GetDiscretionInst()
+		if err != nil {
+			return s, err
+		}
+		s.DiscretionInst = &v
+	}
+	if msg.HasDiscretionOffsetValue() {
+		v, err := msg.GetDiscretionOffsetValue()
+		if err != nil {
+			return s, err
+		}
+		s.DiscretionOffsetValue = &v
+	}
+	if msg.HasPriceType() {
+		v, err := msg.GetPriceType()
+		if err != nil {
+			return s, err
+		}
+		s.PriceType = &v
+	}
+	if msg.HasGTBookingInst() {
+		v, err := msg.GetGTBookingInst()
+		if err != nil {
+			return s, err
+		}
+		s.GTBookingInst = &v
+	}
+	if msg.HasExpireDate() {
+		v, err := msg.GetExpireDate()
+		if err != nil {
+			return s, err
+		}
+		s.ExpireDate = &v
+	}
+	if msg.HasNoSecurityAltID() {
+		g, err := msg.GetNoSecurityAltID()
+		if err != nil {
+			return s, err
+		}
+		if s.NoSecurityAltID, err = components.MarshalNoSecurityAltID(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasProduct() {
+		v, err := msg.GetProduct()
+		if err != nil {
+			return s, err
+		}
+		s.Product = &v
+	}
+	if msg.HasCFICode() {
+		v, err := msg.GetCFICode()
+		if err != nil {
+			return s, err
+		}
+		s.CFICode = &v
+	}
+	if msg.HasCountryOfIssue() {
+		v, err := msg.GetCountryOfIssue()
+		if err != nil {
+			return s, err
+		}
+		s.CountryOfIssue = &v
+	}
+	if msg.HasStateOrProvinceOfIssue() {
+		v, err := msg.GetStateOrProvinceOfIssue()
+		if err != nil {
+			return s, err
+		}
+		s.StateOrProvinceOfIssue = &v
+	}
+	if msg.HasLocaleOfIssue() {
+		v, err := msg.GetLocaleOfIssue()
+		if err != nil {
+			return s, err
+		}
+		s.LocaleOfIssue = &v
+	}
+	if msg.HasCancellationRights() {
+		v, err := msg.GetCancellationRights()
+		if err != nil {
+			return s, err
+		}
+		s.CancellationRights = &v
+	}
+	if msg.HasMoneyLaunderingStatus() {
+		v, err := msg.GetMoneyLaunderingStatus()
+		if err != nil {
+			return s, err
+		}
+		s.MoneyLaunderingStatus = &v
+	}
+	if msg.HasDesignation() {
+		v, err := msg.GetDesignation()
+		if err != nil {
+			return s, err
+		}
+		s.Designation = &v
+	}
+	if msg.HasRegistID() {
+		v, err := msg.GetRegistID()
+		if err != nil {
+			return s, err
+		}
+		s.RegistID = &v
+	}
+	if msg.HasMaturityDate() {
+		v, err := msg.GetMaturityDate()
+		if err != nil {
+			return s, err
+		}
+		s.MaturityDate = &v
+	}
+	if msg.HasInstrRegistry() {
+		v, err := msg.GetInstrRegistry()
+		if err != nil {
+			return s, err
+		}
+		s.InstrRegistry = &v
+	}
+	if msg.HasCrossID() {
+		v, err := msg.GetCrossID()
+		if err != nil {
+			return s, err
+		}
+		s.CrossID = v
+	}
+	if msg.HasCrossType() {
+		v, err := msg.GetCrossType()
+		if err != nil {
+			return s, err
+		}
+		s.CrossType = v
+	}
+	if msg.HasCrossPrioritization() {
+		v, err := msg.GetCrossPrioritization()
+		if err != nil {
+			return s, err
+		}
+		s.CrossPrioritization = v
+	}
+	if msg.HasOrigCrossID() {
+		v, err := msg.GetOrigCrossID()
+		if err != nil {
+			return s, err
+		}
+		s.OrigCrossID = v
+	}
+	if msg.HasNoSides() {
+		g, err := msg.GetNoSides()
+		if err != nil {
+			return s, err
+		}
+		if s.NoSides, err = MarshalNoSides(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasNoLegs() {
+		g, err := msg.GetNoLegs()
+		if err != nil {
+			return s, err
+		}
+		if s.NoLegs, err = MarshalNoLegs(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasBenchmarkPrice() {
+		v, err := msg.GetBenchmarkPrice()
+		if err != nil {
+			return s, err
+		}
+		s.BenchmarkPrice = &v
+	}
+	if msg.HasBenchmarkPriceType() {
+		v, err := msg.GetBenchmarkPriceType()
+		if err != nil {
+			return s, err
+		}
+		s.BenchmarkPriceType = &v
+	}
+	if msg.HasContractSettlMonth() {
+		v, err := msg.GetContractSettlMonth()
+		if err != nil {
+			return s, err
+		}
+		s.ContractSettlMonth = &v
+	}
+	if msg.HasPool() {
+		v, err := msg.GetPool()
+		if err != nil {
+			return s, err
+		}
+		s.Pool = &v
+	}
+	if msg.HasYieldRedemptionDate() {
+		v, err := msg.GetYieldRedemptionDate()
+		if err != nil {
+			return s, err
+		}
+		s.YieldRedemptionDate = &v
+	}
+	if msg.HasYieldRedemptionPrice() {
+		v, err := msg.GetYieldRedemptionPrice()
+		if err != nil {
+			return s, err
+		}
+		s.YieldRedemptionPrice = &v
+	}
+	if msg.HasYieldRedemptionPriceType() {
+		v, err := msg.GetYieldRedemptionPriceType()
+		if err != nil {
+			return s, err
+		}
+		s.YieldRedemptionPriceType = &v
+	}
+	if msg.HasBenchmarkSecurityID() {
+		v, err := msg.GetBenchmarkSecurityID()
+		if err != nil {
+			return s, err
+		}
+		s.BenchmarkSecurityID = &v
+	}
+	if msg.HasYieldCalcDate() {
+		v, err := msg.GetYieldCalcDate()
+		if err != nil {
+			return s, err
+		}
+		s.YieldCalcDate = &v
+	}
+	if msg.HasNoUnderlyings() {
+		g, err := msg.GetNoUnderlyings()
+		if err != nil {
+			return s, err
+		}
+		if s.NoUnderlyings, err = MarshalNoUnderlyings(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasBenchmarkSecurityIDSource() {
+		v, err := msg.GetBenchmarkSecurityIDSource()
+		if err != nil {
+			return s, err
+		}
+		s.BenchmarkSecurityIDSource = &v
+	}
+	if msg.HasSecuritySubType() {
+		v, err := msg.GetSecuritySubType()
+		if err != nil {
+			return s, err
+		}
+		s.SecuritySubType = &v
+	}
+	if msg.HasPegMoveType() {
+		v, err := msg.GetPegMoveType()
+		if err != nil {
+			return s, err
+		}
+		s.PegMoveType = &v
+	}
+	if msg.HasPegOffsetType() {
+		v, err := msg.GetPegOffsetType()
+		if err != nil {
+			return s, err
+		}
+		s.PegOffsetType = &v
+	}
+	if msg.HasPegLimitType() {
+		v, err := msg.GetPegLimitType()
+		if err != nil {
+			return s, err
+		}
+		s.PegLimitType = &v
+	}
+	if msg.HasPegRoundDirection() {
+		v, err := msg.GetPegRoundDirection()
+		if err != nil {
+			return s, err
+		}
+		s.PegRoundDirection = &v
+	}
+	if msg.HasPegScope() {
+		v, err := msg.GetPegScope()
+		if err != nil {
+			return s, err
+		}
+		s.PegScope = &v
+	}
+	if msg.HasDiscretionMoveType() {
+		v, err := msg.GetDiscretionMoveType()
+		if err != nil {
+			return s, err
+		}
+		s.DiscretionMoveType = &v
+	}
+	if msg.HasDiscretionOffsetType() {
+		v, err := msg.GetDiscretionOffsetType()
+		if err != nil {
+			return s, err
+		}
+		s.DiscretionOffsetType = &v
+	}
+	if msg.HasDiscretionLimitType() {
+		v, err := msg.GetDiscretionLimitType()
+		if err != nil {
+			return s, err
+		}
+		s.DiscretionLimitType = &v
+	}
+	if msg.HasDiscretionRoundDirection() {
+		v, err := msg.GetDiscretionRoundDirection()
+		if err != nil {
+			return s, err
+		}
+		s.DiscretionRoundDirection = &v
+	}
+	if msg.HasDiscretionScope() {
+		v, err := msg.GetDiscretionScope()
+		if err != nil {
+			return s, err
+		}
+		s.DiscretionScope = &v
+	}
+	if msg.HasTargetStrategy() {
+		v, err := msg.GetTargetStrategy()
+		if err != nil {
+			return s, err
+		}
+		s.TargetStrategy = &v
+	}
+	if msg.HasTargetStrategyParameters() {
+		v, err := msg.GetTargetStrategyParameters()
+		if err != nil {
+			return s, err
+		}
+		s.TargetStrategyParameters = &v
+	}
+	if msg.HasParticipationRate() {
+		v, err := msg.GetParticipationRate()
+		if err != nil {
+			return s, err
+		}
+		s.ParticipationRate = &v
+	}
+	if msg.HasNoEvents() {
+		g, err := msg.GetNoEvents()
+		if err != nil {
+			return s, err
+		}
+		if s.NoEvents, err = components.MarshalNoEvents(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasDatedDate() {
+		v, err := msg.GetDatedDate()
+		if err != nil {
+			return s, err
+		}
+		s.DatedDate = &v
+	}
+	if msg.HasInterestAccrualDate() {
+		v, err := msg.GetInterestAccrualDate()
+		if err != nil {
+			return s, err
+		}
+		s.InterestAccrualDate = &v
+	}
+	if msg.HasCPProgram() {
+		v, err := msg.GetCPProgram()
+		if err != nil {
+			return s, err
+		}
+		s.CPProgram = &v
+	}
+	if msg.HasCPRegType() {
+		v, err := msg.GetCPRegType()
+		if err != nil {
+			return s, err
+		}
+		s.CPRegType = &v
+	}
+	if msg.HasStrikeCurrency() {
+		v, err := msg.GetStrikeCurrency()
+		if err != nil {
+			return s, err
+		}
+		s.StrikeCurrency = &v
+	}
+	return s, nil
+}
+
+//Unmarshal builds a CrossOrderCancelReplaceRequest from s
+func Unmarshal(s Struct) CrossOrderCancelReplaceRequest {
+	m := New(field.NewCrossID(s.CrossID), field.NewOrigCrossID(s.OrigCrossID), field.NewCrossType(s.CrossType), field.NewCrossPrioritization(s.CrossPrioritization), field.NewTransactTime(s.TransactTime), field.NewOrdType(s.OrdType))
+	if s.Currency != nil {
+		m.SetCurrency(*s.Currency)
+	}
+	if s.ExecInst != nil {
+		m.SetExecInst(*s.ExecInst)
 	}
-	return
-}
-
-//GetEventDate gets EventDate, Tag 866
-func (m NoEvents) GetEventDate() (v string, err quickfix.MessageRejectError) {
-	var f field.EventDateField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
+	if s.HandlInst != nil {
+		m.SetHandlInst(*s.HandlInst)
 	}
-	return
-}
-
-//GetEventPx gets EventPx, Tag 867
-func (m NoEvents) GetEventPx() (v decimal.Decimal, err quickfix.MessageRejectError) {
-	var f field.EventPxField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
+	if s.SecurityIDSource != nil {
+		m.SetSecurityIDSource(*s.SecurityIDSource)
 	}
-	return
-}
-
-//GetEventText gets EventText, Tag 868
-func (m NoEvents) GetEventText() (v string, err quickfix.MessageRejectError) {
-	var f field.EventTextField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
+	if s.IOIID != nil {
+		m.SetIOIID(*s.IOIID)
 	}
-	return
-}
-
-//HasEventType returns true if EventType is present, Tag 865
-func (m NoEvents) HasEventType() bool {
-	return m.Has(tag.EventType)
-}
-
-//HasEventDate returns true if EventDate is present, Tag 866
-func (m NoEvents) HasEventDate() bool {
-	return m.Has(tag.EventDate)
-}
-
-//HasEventPx returns true if EventPx is present, Tag 867
-func (m NoEvents) HasEventPx() bool {
-	return m.Has(tag.EventPx)
-}
-
-//HasEventText returns true if EventText is present, Tag 868
-func (m NoEvents) HasEventText() bool {
-	return m.Has(tag.EventText)
-}
-
-//NoEventsRepeatingGroup is a repeating group, Tag 864
-type NoEventsRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
-
-//NewNoEventsRepeatingGroup returns an initialized, NoEventsRepeatingGroup
-func NewNoEventsRepeatingGroup() NoEventsRepeatingGroup {
-	return NoEventsRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoEvents,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.EventType), quickfix.GroupElement(tag.EventDate), quickfix.GroupElement(tag.EventPx), quickfix.GroupElement(tag.EventText)})}
-}
-
-//Add create and append a new NoEvents to this group
-func (m NoEventsRepeatingGroup) Add() NoEvents {
-	g := m.RepeatingGroup.Add()
-	return NoEvents{g}
-}
-
-//Get returns the ith NoEvents in the NoEventsRepeatinGroup
-func (m NoEventsRepeatingGroup) Get(i int) NoEvents {
-	return NoEvents{m.RepeatingGroup.Get(i)}
+	if s.OrderID != nil {
+		m.SetOrderID(*s.OrderID)
+	}
+	if s.Price != nil {
+		m.SetPrice(*s.Price, fix44.DecimalScale(*s.Price))
+	}
+	if s.SecurityID != nil {
+		m.SetSecurityID(*s.SecurityID)
+	}
+	if s.Symbol != nil {
+		m.SetSymbol(*s.Symbol)
+	}
+	if s.TimeInForce != nil {
+		m.SetTimeInForce(*s.TimeInForce)
+	}
+	if s.SettlType != nil {
+		m.SetSettlType(*s.SettlType)
+	}
+	if s.SettlDate != nil {
+		m.SetSettlDate(*s.SettlDate)
+	}
+	if s.SymbolSfx != nil {
+		m.SetSymbolSfx(*s.SymbolSfx)
+	}
+	if s.ProcessCode != nil {
+		m.SetProcessCode(*s.ProcessCode)
+	}
+	if s.StopPx != nil {
+		m.SetStopPx(*s.StopPx, fix44.DecimalScale(*s.StopPx))
+	}
+	if s.ExDestination != nil {
+		m.SetExDestination(*s.ExDestination)
+	}
+	if s.Issuer != nil {
+		m.SetIssuer(*s.Issuer)
+	}
+	if s.SecurityDesc != nil {
+		m.SetSecurityDesc(*s.SecurityDesc)
+	}
+	if s.MinQty != nil {
+		m.SetMinQty(*s.MinQty, fix44.DecimalScale(*s.MinQty))
+	}
+	if s.MaxFloor != nil {
+		m.SetMaxFloor(*s.MaxFloor, fix44.DecimalScale(*s.MaxFloor))
+	}
+	if s.LocateReqd != nil {
+		m.SetLocateReqd(*s.LocateReqd)
+	}
+	if s.QuoteID != nil {
+		m.SetQuoteID(*s.QuoteID)
+	}
+	if s.ExpireTime != nil {
+		m.SetExpireTime(*s.ExpireTime)
+	}
+	if s.PrevClosePx != nil {
+		m.SetPrevClosePx(*s.PrevClosePx, fix44.DecimalScale(*s.PrevClosePx))
+	}
+	if s.SecurityType != nil {
+		m.SetSecurityType(*s.SecurityType)
+	}
+	if s.EffectiveTime != nil {
+		m.SetEffectiveTime(*s.EffectiveTime)
+	}
+	if s.MaturityMonthYear != nil {
+		m.SetMaturityMonthYear(*s.MaturityMonthYear)
+	}
+	if s.StrikePrice != nil {
+		m.SetStrikePrice(*s.StrikePrice, fix44.DecimalScale(*s.StrikePrice))
+	}
+	if s.OptAttribute != nil {
+		m.SetOptAttribute(*s.OptAttribute)
+	}
+	if s.SecurityExchange != nil {
+		m.SetSecurityExchange(*s.SecurityExchange)
+	}
+	if s.MaxShow != nil {
+		m.SetMaxShow(*s.MaxShow, fix44.DecimalScale(*s.MaxShow))
+	}
+	if s.PegOffsetValue != nil {
+		m.SetPegOffsetValue(*s.PegOffsetValue, fix44.DecimalScale(*s.PegOffsetValue))
+	}
+	if s.Spread != nil {
+		m.SetSpread(*s.Spread, fix44.DecimalScale(*s.Spread))
+	}
+	if s.BenchmarkCurveCurrency != nil {
+		m.SetBenchmarkCurveCurrency(*s.BenchmarkCurveCurrency)
+	}
+	if s.BenchmarkCurveName != nil {
+		m.SetBenchmarkCurveName(*s.BenchmarkCurveName)
+	}
+	if s.BenchmarkCurvePoint != nil {
+		m.SetBenchmarkCurvePoint(*s.BenchmarkCurvePoint)
+	}
+	if s.CouponRate != nil {
+		m.SetCouponRate(*s.CouponRate, fix44.DecimalScale(*s.CouponRate))
+	}
+	if s.CouponPaymentDate != nil {
+		m.SetCouponPaymentDate(*s.CouponPaymentDate)
+	}
+	if s.IssueDate != nil {
+		m.SetIssueDate(*s.IssueDate)
+	}
+	if s.RepurchaseTerm != nil {
+		m.SetRepurchaseTerm(*s.RepurchaseTerm)
+	}
+	if s.RepurchaseRate != nil {
+		m.SetRepurchaseRate(*s.RepurchaseRate, fix44.DecimalScale(*s.RepurchaseRate))
+	}
+	if s.Factor != nil {
+		m.SetFactor(*s.Factor, fix44.DecimalScale(*s.Factor))
+	}
+	if s.ContractMultiplier != nil {
+		m.SetContractMultiplier(*s.ContractMultiplier, fix44.DecimalScale(*s.ContractMultiplier))
+	}
+	if s.NoStipulations != nil {
+		m.SetNoStipulations(components.UnmarshalNoStipulations(s.NoStipulations))
+	}
+	if s.YieldType != nil {
+		m.SetYieldType(*s.YieldType)
+	}
+	if s.Yield != nil {
+		m.SetYield(*s.Yield, fix44.DecimalScale(*s.Yield))
+	}
+	if s.RepoCollateralSecurityType != nil {
+		m.SetRepoCollateralSecurityType(*s.RepoCollateralSecurityType)
+	}
+	if s.RedemptionDate != nil {
+		m.SetRedemptionDate(*s.RedemptionDate)
+	}
+	if s.CreditRating != nil {
+		m.SetCreditRating(*s.CreditRating)
+	}
+	if s.EncodedIssuerLen != nil {
+		m.SetEncodedIssuerLen(*s.EncodedIssuerLen)
+	}
+	if s.EncodedIssuer != nil {
+		m.SetEncodedIssuer(*s.EncodedIssuer)
+	}
+	if s.EncodedSecurityDescLen != nil {
+		m.SetEncodedSecurityDescLen(*s.EncodedSecurityDescLen)
+	}
+	if s.EncodedSecurityDesc != nil {
+		m.SetEncodedSecurityDesc(*s.EncodedSecurityDesc)
+	}
+	if s.ComplianceID != nil {
+		m.SetComplianceID(*s.ComplianceID)
+	}
+	if s.NoTradingSessions != nil {
+		m.SetNoTradingSessions(UnmarshalNoTradingSessions(s.NoTradingSessions))
+	}
+	if s.DiscretionInst != nil {
+		m.SetDiscretionInst(*s.DiscretionInst)
+	}
+	if s.DiscretionOffsetValue != nil {
+		m.SetDiscretionOffsetValue(*s.DiscretionOffsetValue, fix44.DecimalScale(*s.DiscretionOffsetValue))
+	}
+	if s.PriceType != nil {
+		m.SetPriceType(*s.PriceType)
+	}
+	if s.GTBookingInst != nil {
+		m.SetGTBookingInst(*s.GTBookingInst)
+	}
+	if s.ExpireDate != nil {
+		m.SetExpireDate(*s.ExpireDate)
+	}
+	if s.NoSecurityAltID != nil {
+		m.SetNoSecurityAltID(components.UnmarshalNoSecurityAltID(s.NoSecurityAltID))
+	}
+	if s.Product != nil {
+		m.SetProduct(*s.Product)
+	}
+	if s.CFICode != nil {
+		m.SetCFICode(*s.CFICode)
+	}
+	if s.CountryOfIssue != nil {
+		m.SetCountryOfIssue(*s.CountryOfIssue)
+	}
+	if s.StateOrProvinceOfIssue != nil {
+		m.SetStateOrProvinceOfIssue(*s.StateOrProvinceOfIssue)
+	}
+	if s.LocaleOfIssue != nil {
+		m.SetLocaleOfIssue(*s.LocaleOfIssue)
+	}
+	if s.CancellationRights != nil {
+		m.SetCancellationRights(*s.CancellationRights)
+	}
+	if s.MoneyLaunderingStatus != nil {
+		m.SetMoneyLaunderingStatus(*s.MoneyLaunderingStatus)
+	}
+	if s.Designation != nil {
+		m.SetDesignation(*s.Designation)
+	}
+	if s.RegistID != nil {
+		m.SetRegistID(*s.RegistID)
+	}
+	if s.MaturityDate != nil {
+		m.SetMaturityDate(*s.MaturityDate)
+	}
+	if s.InstrRegistry != nil {
+		m.SetInstrRegistry(*s.InstrRegistry)
+	}
+	if s.NoSides != nil {
+		m.SetNoSides(UnmarshalNoSides(s.NoSides))
+	}
+	if s.NoLegs != nil {
+		m.SetNoLegs(UnmarshalNoLegs(s.NoLegs))
+	}
+	if s.BenchmarkPrice != nil {
+		m.SetBenchmarkPrice(*s.BenchmarkPrice, fix44.DecimalScale(*s.BenchmarkPrice))
+	}
+	if s.BenchmarkPriceType != nil {
+		m.SetBenchmarkPriceType(*s.BenchmarkPriceType)
+	}
+	if s.ContractSettlMonth != nil {
+		m.SetContractSettlMonth(*s.ContractSettlMonth)
+	}
+	if s.Pool != nil {
+		m.SetPool(*s.Pool)
+	}
+	if s.YieldRedemptionDate != nil {
+		m.SetYieldRedemptionDate(*s.YieldRedemptionDate)
+	}
+	if s.YieldRedemptionPrice != nil {
+		m.SetYieldRedemptionPrice(*s.YieldRedemptionPrice, fix44.DecimalScale(*s.YieldRedemptionPrice))
+	}
+	if s.YieldRedemptionPriceType != nil {
+		m.SetYieldRedemptionPriceType(*s.YieldRedemptionPriceType)
+	}
+	if s.BenchmarkSecurityID != nil {
+		m.SetBenchmarkSecurityID(*s.BenchmarkSecurityID)
+	}
+	if s.YieldCalcDate != nil {
+		m.SetYieldCalcDate(*s.YieldCalcDate)
+	}
+	if s.NoUnderlyings != nil {
+		m.SetNoUnderlyings(UnmarshalNoUnderlyings(s.NoUnderlyings))
+	}
+	if s.BenchmarkSecurityIDSource != nil {
+		m.SetBenchmarkSecurityIDSource(*s.BenchmarkSecurityIDSource)
+	}
+	if s.SecuritySubType != nil {
+		m.SetSecuritySubType(*s.SecuritySubType)
+	}
+	if s.PegMoveType != nil {
+		m.SetPegMoveType(*s.PegMoveType)
+	}
+	if s.PegOffsetType != nil {
+		m.SetPegOffsetType(*s.PegOffsetType)
+	}
+	if s.PegLimitType != nil {
+		m.SetPegLimitType(*s.PegLimitType)
+	}
+	if s.PegRoundDirection != nil {
+		m.SetPegRoundDirection(*s.PegRoundDirection)
+	}
+	if s.PegScope != nil {
+		m.SetPegScope(*s.PegScope)
+	}
+	if s.DiscretionMoveType != nil {
+		m.SetDiscretionMoveType(*s.DiscretionMoveType)
+	}
+	if s.DiscretionOffsetType != nil {
+		m.SetDiscretionOffsetType(*s.DiscretionOffsetType)
+	}
+	if s.DiscretionLimitType != nil {
+		m.SetDiscretionLimitType(*s.DiscretionLimitType)
+	}
+	if s.DiscretionRoundDirection != nil {
+		m.SetDiscretionRoundDirection(*s.DiscretionRoundDirection)
+	}
+	if s.DiscretionScope != nil {
+		m.SetDiscretionScope(*s.DiscretionScope)
+	}
+	if s.TargetStrategy != nil {
+		m.SetTargetStrategy(*s.TargetStrategy)
+	}
+	if s.TargetStrategyParameters != nil {
+		m.SetTargetStrategyParameters(*s.TargetStrategyParameters)
+	}
+	if s.ParticipationRate != nil {
+		m.SetParticipationRate(*s.ParticipationRate, fix44.DecimalScale(*s.ParticipationRate))
+	}
+	if s.NoEvents != nil {
+		m.SetNoEvents(components.UnmarshalNoEvents(s.NoEvents))
+	}
+	if s.DatedDate != nil {
+		m.SetDatedDate(*s.DatedDate)
+	}
+	if s.InterestAccrualDate != nil {
+		m.SetInterestAccrualDate(*s.InterestAccrualDate)
+	}
+	if s.CPProgram != nil {
+		m.SetCPProgram(*s.CPProgram)
+	}
+	if s.CPRegType != nil {
+		m.SetCPRegType(*s.CPRegType)
+	}
+	if s.StrikeCurrency != nil {
+		m.SetStrikeCurrency(*s.StrikeCurrency)
+	}
+	return m
+}
+
+//NoTradingSessionsStruct is a plain Go representation of a NoTradingSessions group element, optional fields are nil when absent
+type NoTradingSessionsStruct struct {
+	TradingSessionID    *enum.TradingSessionID
+	TradingSessionSubID *enum.TradingSessionSubID
+}
+
+//MarshalNoTradingSessions copies the elements of g into a slice of NoTradingSessionsStruct
+func MarshalNoTradingSessions(g NoTradingSessionsRepeatingGroup) ([]NoTradingSessionsStruct, quickfix.MessageRejectError) {
+	s := make([]NoTradingSessionsStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasTradingSessionID() {
+			v, err := m.GetTradingSessionID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].TradingSessionID = &v
+		}
+		if m.HasTradingSessionSubID() {
+			v, err := m.GetTradingSessionSubID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].TradingSessionSubID = &v
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoTradingSessions builds a NoTradingSessionsRepeatingGroup from s
+func UnmarshalNoTradingSessions(s []NoTradingSessionsStruct) NoTradingSessionsRepeatingGroup {
+	g := NewNoTradingSessionsRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.TradingSessionID != nil {
+			m.SetTradingSessionID(*e.TradingSessionID)
+		}
+		if e.TradingSessionSubID != nil {
+			m.SetTradingSessionSubID(*e.TradingSessionSubID)
+		}
+	}
+	return g
+}
+
+//NoSidesStruct is a plain Go representation of a NoSides group element, optional fields are nil when absent
+type NoSidesStruct struct {
+	Side                 *enum.Side
+	OrigClOrdID          *string
+	ClOrdID              *string
+	SecondaryClOrdID     *string
+	ClOrdLinkID          *string
+	OrigOrdModTime       *time.Time
+	NoPartyIDs           []NoPartyIDsStruct
+	TradeOriginationDate *string
+	TradeDate            *string
+	Account              *string
+	AcctIDSource         *enum.AcctIDSource
+	AccountType          *enum.AccountType
+	DayBookingInst       *enum.DayBookingInst
+	BookingUnit          *enum.BookingUnit
+	PreallocMethod       *enum.PreallocMethod
+	AllocID              *string
+	NoAllocs             []NoAllocsStruct
+	QtyType              *enum.QtyType
+	OrderQty             *decimal.Decimal
+	CashOrderQty         *decimal.Decimal
+	OrderPercent         *decimal.Decimal
+	RoundingDirection    *enum.RoundingDirection
+	RoundingModulus      *decimal.Decimal
+	Commission           *decimal.Decimal
+	CommType             *enum.CommType
+	CommCurrency         *string
+	FundRenewWaiv        *enum.FundRenewWaiv
+	OrderCapacity        *enum.OrderCapacity
+	OrderRestrictions    *enum.OrderRestrictions
+	CustOrderCapacity    *enum.CustOrderCapacity
+	ForexReq             *bool
+	SettlCurrency        *string
+	BookingType          *enum.BookingType
+	Text                 *string
+	EncodedTextLen       *int
+	EncodedText          *string
+	PositionEffect       *enum.PositionEffect
+	CoveredOrUncovered   *enum.CoveredOrUncovered
+	CashMargin           *enum.CashMargin
+	ClearingFeeIndicator *enum.ClearingFeeIndicator
+	SolicitedFlag        *bool
+	SideComplianceID     *string
+}
+
+//MarshalNoSides copies the elements of g into a slice of NoSidesStruct
+func MarshalNoSides(g NoSidesRepeatingGroup) ([]NoSidesStruct, quickfix.MessageRejectError) {
+	s := make([]NoSidesStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasSide() {
+			v, err := m.GetSide()
+			if err != nil {
+				return nil, err
+			}
+			s[i].Side = &v
+		}
+		if m.HasOrigClOrdID() {
+			v, err := m.GetOrigClOrdID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].OrigClOrdID = &v
+		}
+		if m.HasClOrdID() {
+			v, err := m.GetClOrdID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].ClOrdID = &v
+		}
+		if m.HasSecondaryClOrdID() {
+			v, err := m.GetSecondaryClOrdID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SecondaryClOrdID = &v
+		}
+		if m.HasClOrdLinkID() {
+			v, err := m.GetClOrdLinkID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].ClOrdLinkID = &v
+		}
+		if m.HasOrigOrdModTime() {
+			v, err := m.GetOrigOrdModTime()
+			if err != nil {
+				return nil, err
+			}
+			s[i].OrigOrdModTime = &v
+		}
+		if m.HasNoPartyIDs() {
+			g, err := m.GetNoPartyIDs()
+			if err != nil {
+				return nil, err
+			}
+			if s[i].NoPartyIDs, err = components.MarshalNoPartyIDs(g); err != nil {
+				return nil, err
+			}
+		}
+		if m.HasTradeOriginationDate() {
+			v, err := m.GetTradeOriginationDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].TradeOriginationDate = &v
+		}
+		if m.HasTradeDate() {
+			v, err := m.GetTradeDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].TradeDate = &v
+		}
+		if m.HasAccount() {
+			v, err := m.GetAccount()
+			if err != nil {
+				return nil, err
+			}
+			s[i].Account = &v
+		}
+		if m.HasAcctIDSource() {
+			v, err := m.GetAcctIDSource()
+			if err != nil {
+				return nil, err
+			}
+			s[i].AcctIDSource = &v
+		}
+		if m.HasAccountType() {
+			v, err := m.GetAccountType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].AccountType = &v
+		}
+		if m.HasDayBookingInst() {
+			v, err := m.GetDayBookingInst()
+			if err != nil {
+				return nil, err
+			}
+			s[i].DayBookingInst = &v
+		}
+		if m.HasBookingUnit() {
+			v, err := m.GetBookingUnit()
+			if err != nil {
+				return nil, err
+			}
+			s[i].BookingUnit = &v
+		}
+		if m.HasPreallocMethod() {
+			v, err := m.GetPreallocMethod()
+			if err != nil {
+				return nil, err
+			}
+			s[i].PreallocMethod = &v
+		}
+		if m.HasAllocID() {
+			v, err := m.GetAllocID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].AllocID = &v
+		}
+		if m.HasNoAllocs() {
+			g, err := m.GetNoAllocs()
+			if err != nil {
+				return nil, err
+			}
+			if s[i].NoAllocs, err = MarshalNoAllocs(g); err != nil {
+				return nil, err
+			}
+		}
+		if m.HasQtyType() {
+			v, err := m.GetQtyType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].QtyType = &v
+		}
+		if m.HasOrderQty() {
+			v, err := m.GetOrderQty()
+			if err != nil {
+				return nil, err
+			}
+			s[i].OrderQty = &v
+		}
+		if m.HasCashOrderQty() {
+			v, err := m.GetCashOrderQty()
+			if err != nil {
+				return nil, err
+			}
+			s[i].CashOrderQty = &v
+		}
+		if m.HasOrderPercent() {
+			v, err := m.GetOrderPercent()
+			if err != nil {
+				return nil, err
+			}
+			s[i].OrderPercent = &v
+		}
+		if m.HasRoundingDirection() {
+			v, err := m.GetRoundingDirection()
+			if err != nil {
+				return nil, err
+			}
+			s[i].RoundingDirection = &v
+		}
+		if m.HasRoundingModulus() {
+			v, err := m.GetRoundingModulus()
+			if err != nil {
+				return nil, err
+			}
+			s[i].RoundingModulus = &v
+		}
+		if m.HasCommission() {
+			v, err := m.GetCommission()
+			if err != nil {
+				return nil, err
+			}
+			s[i].Commission = &v
+		}
+		if m.HasCommType() {
+			v, err := m.GetCommType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].CommType = &v
+		}
+		if m.HasCommCurrency() {
+			v, err := m.GetCommCurrency()
+			if err != nil {
+				return nil, err
+			}
+			s[i].CommCurrency = &v
+		}
+		if m.HasFundRenewWaiv() {
+			v, err := m.GetFundRenewWaiv()
+			if err != nil {
+				return nil, err
+			}
+			s[i].FundRenewWaiv = &v
+		}
+		if m.HasOrderCapacity() {
+			v, err := m.GetOrderCapacity()
+			if err != nil {
+				return nil, err
+			}
+			s[i].OrderCapacity = &v
+		}
+		if m.HasOrderRestrictions() {
+			v, err := m.GetOrderRestrictions()
+			if err != nil {
+				return nil, err
+			}
+			s[i].OrderRestrictions = &v
+		}
+		if m.HasCustOrderCapacity() {
+			v, err := m.GetCustOrderCapacity()
+			if err != nil {
+				return nil, err
+			}
+			s[i].CustOrderCapacity = &v
+		}
+		if m.HasForexReq() {
+			v, err := m.GetForexReq()
+			if err != nil {
+				return nil, err
+			}
+			s[i].ForexReq = &v
+		}
+		if m.HasSettlCurrency() {
+			v, err := m.GetSettlCurrency()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SettlCurrency = &v
+		}
+		if m.HasBookingType() {
+			v, err := m.GetBookingType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].BookingType = &v
+		}
+		if m.HasText() {
+			v, err := m.GetText()
+			if err != nil {
+				return nil, err
+			}
+			s[i].Text = &v
+		}
+		if m.HasEncodedTextLen() {
+			v, err := m.GetEncodedTextLen()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedTextLen = &v
+		}
+		if m.HasEncodedText() {
+			v, err := m.GetEncodedText()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedText = &v
+		}
+		if m.HasPositionEffect() {
+			v, err := m.GetPositionEffect()
+			if err != nil {
+				return nil, err
+			}
+			s[i].PositionEffect = &v
+		}
+		if m.HasCoveredOrUncovered() {
+			v, err := m.GetCoveredOrUncovered()
+			if err != nil {
+				return nil, err
+			}
+			s[i].CoveredOrUncovered = &v
+		}
+		if m.HasCashMargin() {
+			v, err := m.GetCashMargin()
+			if err != nil {
+				return nil, err
+			}
+			s[i].CashMargin = &v
+		}
+		if m.HasClearingFeeIndicator() {
+			v, err := m.GetClearingFeeIndicator()
+			if err != nil {
+				return nil, err
+			}
+			s[i].ClearingFeeIndicator = &v
+		}
+		if m.HasSolicitedFlag() {
+			v, err := m.GetSolicitedFlag()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SolicitedFlag = &v
+		}
+		if m.HasSideComplianceID() {
+			v, err := m.GetSideComplianceID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SideComplianceID = &v
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoSides builds a NoSidesRepeatingGroup from s
+func UnmarshalNoSides(s []NoSidesStruct) NoSidesRepeatingGroup {
+	g := NewNoSidesRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.Side != nil {
+			m.SetSide(*e.Side)
+		}
+		if e.OrigClOrdID != nil {
+			m.SetOrigClOrdID(*e.OrigClOrdID)
+		}
+		if e.ClOrdID != nil {
+			m.SetClOrdID(*e.ClOrdID)
+		}
+		if e.SecondaryClOrdID != nil {
+			m.SetSecondaryClOrdID(*e.SecondaryClOrdID)
+		}
+		if e.ClOrdLinkID != nil {
+			m.SetClOrdLinkID(*e.ClOrdLinkID)
+		}
+		if e.OrigOrdModTime != nil {
+			m.SetOrigOrdModTime(*e.OrigOrdModTime)
+		}
+		if e.NoPartyIDs != nil {
+			m.SetNoPartyIDs(components.UnmarshalNoPartyIDs(e.NoPartyIDs))
+		}
+		if e.TradeOriginationDate != nil {
+			m.SetTradeOriginationDate(*e.TradeOriginationDate)
+		}
+		if e.TradeDate != nil {
+			m.SetTradeDate(*e.TradeDate)
+		}
+		if e.Account != nil {
+			m.SetAccount(*e.Account)
+		}
+		if e.AcctIDSource != nil {
+			m.SetAcctIDSource(*e.AcctIDSource)
+		}
+		if e.AccountType != nil {
+			m.SetAccountType(*e.AccountType)
+		}
+		if e.DayBookingInst != nil {
+			m.SetDayBookingInst(*e.DayBookingInst)
+		}
+		if e.BookingUnit != nil {
+			m.SetBookingUnit(*e.BookingUnit)
+		}
+		if e.PreallocMethod != nil {
+			m.SetPreallocMethod(*e.PreallocMethod)
+		}
+		if e.AllocID != nil {
+			m.SetAllocID(*e.AllocID)
+		}
+		if e.NoAllocs != nil {
+			m.SetNoAllocs(UnmarshalNoAllocs(e.NoAllocs))
+		}
+		if e.QtyType != nil {
+			m.SetQtyType(*e.QtyType)
+		}
+		if e.OrderQty != nil {
+			m.SetOrderQty(*e.OrderQty, fix44.DecimalScale(*e.OrderQty))
+		}
+		if e.CashOrderQty != nil {
+			m.SetCashOrderQty(*e.CashOrderQty, fix44.DecimalScale(*e.CashOrderQty))
+		}
+		if e.OrderPercent != nil {
+			m.SetOrderPercent(*e.OrderPercent, fix44.DecimalScale(*e.OrderPercent))
+		}
+		if e.RoundingDirection != nil {
+			m.SetRoundingDirection(*e.RoundingDirection)
+		}
+		if e.RoundingModulus != nil {
+			m.SetRoundingModulus(*e.RoundingModulus, fix44.DecimalScale(*e.RoundingModulus))
+		}
+		if e.Commission != nil {
+			m.SetCommission(*e.Commission, fix44.DecimalScale(*e.Commission))
+		}
+		if e.CommType != nil {
+			m.SetCommType(*e.CommType)
+		}
+		if e.CommCurrency != nil {
+			m.SetCommCurrency(*e.CommCurrency)
+		}
+		if e.FundRenewWaiv != nil {
+			m.SetFundRenewWaiv(*e.FundRenewWaiv)
+		}
+		if e.OrderCapacity != nil {
+			m.SetOrderCapacity(*e.OrderCapacity)
+		}
+		if e.OrderRestrictions != nil {
+			m.SetOrderRestrictions(*e.OrderRestrictions)
+		}
+		if e.CustOrderCapacity != nil {
+			m.SetCustOrderCapacity(*e.CustOrderCapacity)
+		}
+		if e.ForexReq != nil {
+			m.SetForexReq(*e.ForexReq)
+		}
+		if e.SettlCurrency != nil {
+			m.SetSettlCurrency(*e.SettlCurrency)
+		}
+		if e.BookingType != nil {
+			m.SetBookingType(*e.BookingType)
+		}
+		if e.Text != nil {
+			m.SetText(*e.Text)
+		}
+		if e.EncodedTextLen != nil {
+			m.SetEncodedTextLen(*e.EncodedTextLen)
+		}
+		if e.EncodedText != nil {
+			m.SetEncodedText(*e.EncodedText)
+		}
+		if e.PositionEffect != nil {
+			m.SetPositionEffect(*e.PositionEffect)
+		}
+		if e.CoveredOrUncovered != nil {
+			m.SetCoveredOrUncovered(*e.CoveredOrUncovered)
+		}
+		if e.CashMargin != nil {
+			m.SetCashMargin(*e.CashMargin)
+		}
+		if e.ClearingFeeIndicator != nil {
+			m.SetClearingFeeIndicator(*e.ClearingFeeIndicator)
+		}
+		if e.SolicitedFlag != nil {
+			m.SetSolicitedFlag(*e.SolicitedFlag)
+		}
+		if e.SideComplianceID != nil {
+			m.SetSideComplianceID(*e.SideComplianceID)
+		}
+	}
+	return g
+}
+
+//NoAllocsStruct is a plain Go representation of a NoAllocs group element, optional fields are nil when absent
+type NoAllocsStruct struct {
+	AllocAccount       *string
+	AllocAcctIDSource  *int
+	AllocSettlCurrency *string
+	IndividualAllocID  *string
+	NoNestedPartyIDs   []NoNestedPartyIDsStruct
+	AllocQty           *decimal.Decimal
+}
+
+//MarshalNoAllocs copies the elements of g into a slice of NoAllocsStruct
+func MarshalNoAllocs(g NoAllocsRepeatingGroup) ([]NoAllocsStruct, quickfix.MessageRejectError) {
+	s := make([]NoAllocsStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasAllocAccount() {
+			v, err := m.GetAllocAccount()
+			if err != nil {
+				return nil, err
+			}
+			s[i].AllocAccount = &v
+		}
+		if m.HasAllocAcctIDSource() {
+			v, err := m.GetAllocAcctIDSource()
+			if err != nil {
+				return nil, err
+			}
+			s[i].AllocAcctIDSource = &v
+		}
+		if m.HasAllocSettlCurrency() {
+			v, err := m.GetAllocSettlCurrency()
+			if err != nil {
+				return nil, err
+			}
+			s[i].AllocSettlCurrency = &v
+		}
+		if m.HasIndividualAllocID() {
+			v, err := m.GetIndividualAllocID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].IndividualAllocID = &v
+		}
+		if m.HasNoNestedPartyIDs() {
+			g, err := m.GetNoNestedPartyIDs()
+			if err != nil {
+				return nil, err
+			}
+			if s[i].NoNestedPartyIDs, err = components.MarshalNoNestedPartyIDs(g); err != nil {
+				return nil, err
+			}
+		}
+		if m.HasAllocQty() {
+			v, err := m.GetAllocQty()
+			if err != nil {
+				return nil, err
+			}
+			s[i].AllocQty = &v
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoAllocs builds a NoAllocsRepeatingGroup from s
+func UnmarshalNoAllocs(s []NoAllocsStruct) NoAllocsRepeatingGroup {
+	g := NewNoAllocsRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.AllocAccount != nil {
+			m.SetAllocAccount(*e.AllocAccount)
+		}
+		if e.AllocAcctIDSource != nil {
+			m.SetAllocAcctIDSource(*e.AllocAcctIDSource)
+		}
+		if e.AllocSettlCurrency != nil {
+			m.SetAllocSettlCurrency(*e.AllocSettlCurrency)
+		}
+		if e.IndividualAllocID != nil {
+			m.SetIndividualAllocID(*e.IndividualAllocID)
+		}
+		if e.NoNestedPartyIDs != nil {
+			m.SetNoNestedPartyIDs(components.UnmarshalNoNestedPartyIDs(e.NoNestedPartyIDs))
+		}
+		if e.AllocQty != nil {
+			m.SetAllocQty(*e.AllocQty, fix44.DecimalScale(*e.AllocQty))
+		}
+	}
+	return g
+}
+
+//NoLegsStruct is a plain Go representation of a NoLegs group element, optional fields are nil when absent
+type NoLegsStruct struct {
+	LegSymbol                     *string
+	LegSymbolSfx                  *string
+	LegSecurityID                 *string
+	LegSecurityIDSource           *string
+	NoLegSecurityAltID            []NoLegSecurityAltIDStruct
+	LegProduct                    *int
+	LegCFICode                    *string
+	LegSecurityType               *string
+	LegSecuritySubType            *string
+	LegMaturityMonthYear          *string
+	LegMaturityDate               *string
+	LegCouponPaymentDate          *string
+	LegIssueDate                  *string
+	LegRepoCollateralSecurityType *int
+	LegRepurchaseTerm             *int
+	LegRepurchaseRate             *decimal.Decimal
+	LegFactor                     *decimal.Decimal
+	LegCreditRating               *string
+	LegInstrRegistry              *string
+	LegCountryOfIssue             *string
+	LegStateOrProvinceOfIssue     *string
+	LegLocaleOfIssue              *string
+	LegRedemptionDate             *string
+	LegStrikePrice                *decimal.Decimal
+	LegStrikeCurrency             *string
+	LegOptAttribute               *string
+	LegContractMultiplier         *decimal.Decimal
+	LegCouponRate                 *decimal.Decimal
+	LegSecurityExchange           *string
+	LegIssuer                     *string
+	EncodedLegIssuerLen           *int
+	EncodedLegIssuer              *string
+	LegSecurityDesc               *string
+	EncodedLegSecurityDescLen     *int
+	EncodedLegSecurityDesc        *string
+	LegRatioQty                   *decimal.Decimal
+	LegSide                       *string
+	LegCurrency                   *string
+	LegPool                       *string
+	LegDatedDate                  *string
+	LegContractSettlMonth         *string
+	LegInterestAccrualDate        *string
+}
+
+//MarshalNoLegs copies the elements of g into a slice of NoLegsStruct
+func MarshalNoLegs(g NoLegsRepeatingGroup) ([]NoLegsStruct, quickfix.MessageRejectError) {
+	s := make([]NoLegsStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasLegSymbol() {
+			v, err := m.GetLegSymbol()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSymbol = &v
+		}
+		if m.HasLegSymbolSfx() {
+			v, err := m.GetLegSymbolSfx()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSymbolSfx = &v
+		}
+		if m.HasLegSecurityID() {
+			v, err := m.GetLegSecurityID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSecurityID = &v
+		}
+		if m.HasLegSecurityIDSource() {
+			v, err := m.GetLegSecurityIDSource()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSecurityIDSource = &v
+		}
+		if m.HasNoLegSecurityAltID() {
+			g, err := m.GetNoLegSecurityAltID()
+			if err != nil {
+				return nil, err
+			}
+			if s[i].NoLegSecurityAltID, err = components.MarshalNoLegSecurityAltID(g); err != nil {
+				return nil, err
+			}
+		}
+		if m.HasLegProduct() {
+			v, err := m.GetLegProduct()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegProduct = &v
+		}
+		if m.HasLegCFICode() {
+			v, err := m.GetLegCFICode()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegCFICode = &v
+		}
+		if m.HasLegSecurityType() {
+			v, err := m.GetLegSecurityType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSecurityType = &v
+		}
+		if m.HasLegSecuritySubType() {
+			v, err := m.GetLegSecuritySubType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSecuritySubType = &v
+		}
+		if m.HasLegMaturityMonthYear() {
+			v, err := m.GetLegMaturityMonthYear()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegMaturityMonthYear = &v
+		}
+		if m.HasLegMaturityDate() {
+			v, err := m.GetLegMaturityDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegMaturityDate = &v
+		}
+		if m.HasLegCouponPaymentDate() {
+			v, err := m.GetLegCouponPaymentDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegCouponPaymentDate = &v
+		}
+		if m.HasLegIssueDate() {
+			v, err := m.GetLegIssueDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegIssueDate = &v
+		}
+		if m.HasLegRepoCollateralSecurityType() {
+			v, err := m.GetLegRepoCollateralSecurityType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegRepoCollateralSecurityType = &v
+		}
+		if m.HasLegRepurchaseTerm() {
+			v, err := m.GetLegRepurchaseTerm()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegRepurchaseTerm = &v
+		}
+		if m.HasLegRepurchaseRate() {
+			v, err := m.GetLegRepurchaseRate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegRepurchaseRate = &v
+		}
+		if m.HasLegFactor() {
+			v, err := m.GetLegFactor()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegFactor = &v
+		}
+		if m.HasLegCreditRating() {
+			v, err := m.GetLegCreditRating()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegCreditRating = &v
+		}
+		if m.HasLegInstrRegistry() {
+			v, err := m.GetLegInstrRegistry()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegInstrRegistry = &v
+		}
+		if m.HasLegCountryOfIssue() {
+			v, err := m.GetLegCountryOfIssue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegCountryOfIssue = &v
+		}
+		if m.HasLegStateOrProvinceOfIssue() {
+			v, err := m.GetLegStateOrProvinceOfIssue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegStateOrProvinceOfIssue = &v
+		}
+		if m.HasLegLocaleOfIssue() {
+			v, err := m.GetLegLocaleOfIssue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegLocaleOfIssue = &v
+		}
+		if m.HasLegRedemptionDate() {
+			v, err := m.GetLegRedemptionDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegRedemptionDate = &v
+		}
+		if m.HasLegStrikePrice() {
+			v, err := m.GetLegStrikePrice()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegStrikePrice = &v
+		}
+		if m.HasLegStrikeCurrency() {
+			v, err := m.GetLegStrikeCurrency()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegStrikeCurrency = &v
+		}
+		if m.HasLegOptAttribute() {
+			v, err := m.GetLegOptAttribute()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegOptAttribute = &v
+		}
+		if m.HasLegContractMultiplier() {
+			v, err := m.GetLegContractMultiplier()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegContractMultiplier = &v
+		}
+		if m.HasLegCouponRate() {
+			v, err := m.GetLegCouponRate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegCouponRate = &v
+		}
+		if m.HasLegSecurityExchange() {
+			v, err := m.GetLegSecurityExchange()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSecurityExchange = &v
+		}
+		if m.HasLegIssuer() {
+			v, err := m.GetLegIssuer()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegIssuer = &v
+		}
+		if m.HasEncodedLegIssuerLen() {
+			v, err := m.GetEncodedLegIssuerLen()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedLegIssuerLen = &v
+		}
+		if m.HasEncodedLegIssuer() {
+			v, err := m.GetEncodedLegIssuer()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedLegIssuer = &v
+		}
+		if m.HasLegSecurityDesc() {
+			v, err := m.GetLegSecurityDesc()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSecurityDesc = &v
+		}
+		if m.HasEncodedLegSecurityDescLen() {
+			v, err := m.GetEncodedLegSecurityDescLen()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedLegSecurityDescLen = &v
+		}
+		if m.HasEncodedLegSecurityDesc() {
+			v, err := m.GetEncodedLegSecurityDesc()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedLegSecurityDesc = &v
+		}
+		if m.HasLegRatioQty() {
+			v, err := m.GetLegRatioQty()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegRatioQty = &v
+		}
+		if m.HasLegSide() {
+			v, err := m.GetLegSide()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSide = &v
+		}
+		if m.HasLegCurrency() {
+			v, err := m.GetLegCurrency()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegCurrency = &v
+		}
+		if m.HasLegPool() {
+			v, err := m.GetLegPool()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegPool = &v
+		}
+		if m.HasLegDatedDate() {
+			v, err := m.GetLegDatedDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegDatedDate = &v
+		}
+		if m.HasLegContractSettlMonth() {
+			v, err := m.GetLegContractSettlMonth()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegContractSettlMonth = &v
+		}
+		if m.HasLegInterestAccrualDate() {
+			v, err := m.GetLegInterestAccrualDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegInterestAccrualDate = &v
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoLegs builds a NoLegsRepeatingGroup from s
+func UnmarshalNoLegs(s []NoLegsStruct) NoLegsRepeatingGroup {
+	g := NewNoLegsRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.LegSymbol != nil {
+			m.SetLegSymbol(*e.LegSymbol)
+		}
+		if e.LegSymbolSfx != nil {
+			m.SetLegSymbolSfx(*e.LegSymbolSfx)
+		}
+		if e.LegSecurityID != nil {
+			m.SetLegSecurityID(*e.LegSecurityID)
+		}
+		if e.LegSecurityIDSource != nil {
+			m.SetLegSecurityIDSource(*e.LegSecurityIDSource)
+		}
+		if e.NoLegSecurityAltID != nil {
+			m.SetNoLegSecurityAltID(components.UnmarshalNoLegSecurityAltID(e.NoLegSecurityAltID))
+		}
+		if e.LegProduct != nil {
+			m.SetLegProduct(*e.LegProduct)
+		}
+		if e.LegCFICode != nil {
+			m.SetLegCFICode(*e.LegCFICode)
+		}
+		if e.LegSecurityType != nil {
+			m.SetLegSecurityType(*e.LegSecurityType)
+		}
+		if e.LegSecuritySubType != nil {
+			m.SetLegSecuritySubType(*e.LegSecuritySubType)
+		}
+		if e.LegMaturityMonthYear != nil {
+			m.SetLegMaturityMonthYear(*e.LegMaturityMonthYear)
+		}
+		if e.LegMaturityDate != nil {
+			m.SetLegMaturityDate(*e.LegMaturityDate)
+		}
+		if e.LegCouponPaymentDate != nil {
+			m.SetLegCouponPaymentDate(*e.LegCouponPaymentDate)
+		}
+		if e.LegIssueDate != nil {
+			m.SetLegIssueDate(*e.LegIssueDate)
+		}
+		if e.LegRepoCollateralSecurityType != nil {
+			m.SetLegRepoCollateralSecurityType(*e.LegRepoCollateralSecurityType)
+		}
+		if e.LegRepurchaseTerm != nil {
+			m.SetLegRepurchaseTerm(*e.LegRepurchaseTerm)
+		}
+		if e.LegRepurchaseRate != nil {
+			m.SetLegRepurchaseRate(*e.LegRepurchaseRate, fix44.DecimalScale(*e.LegRepurchaseRate))
+		}
+		if e.LegFactor != nil {
+			m.SetLegFactor(*e.LegFactor, fix44.DecimalScale(*e.LegFactor))
+		}
+		if e.LegCreditRating != nil {
+			m.SetLegCreditRating(*e.LegCreditRating)
+		}
+		if e.LegInstrRegistry != nil {
+			m.SetLegInstrRegistry(*e.LegInstrRegistry)
+		}
+		if e.LegCountryOfIssue != nil {
+			m.SetLegCountryOfIssue(*e.LegCountryOfIssue)
+		}
+		if e.LegStateOrProvinceOfIssue != nil {
+			m.SetLegStateOrProvinceOfIssue(*e.LegStateOrProvinceOfIssue)
+		}
+		if e.LegLocaleOfIssue != nil {
+			m.SetLegLocaleOfIssue(*e.LegLocaleOfIssue)
+		}
+		if e.LegRedemptionDate != nil {
+			m.SetLegRedemptionDate(*e.LegRedemptionDate)
+		}
+		if e.LegStrikePrice != nil {
+			m.SetLegStrikePrice(*e.LegStrikePrice, fix44.DecimalScale(*e.LegStrikePrice))
+		}
+		if e.LegStrikeCurrency != nil {
+			m.SetLegStrikeCurrency(*e.LegStrikeCurrency)
+		}
+		if e.LegOptAttribute != nil {
+			m.SetLegOptAttribute(*e.LegOptAttribute)
+		}
+		if e.LegContractMultiplier != nil {
+			m.SetLegContractMultiplier(*e.LegContractMultiplier, fix44.DecimalScale(*e.LegContractMultiplier))
+		}
+		if e.LegCouponRate != nil {
+			m.SetLegCouponRate(*e.LegCouponRate, fix44.DecimalScale(*e.LegCouponRate))
+		}
+		if e.LegSecurityExchange != nil {
+			m.SetLegSecurityExchange(*e.LegSecurityExchange)
+		}
+		if e.LegIssuer != nil {
+			m.SetLegIssuer(*e.LegIssuer)
+		}
+		if e.EncodedLegIssuerLen != nil {
+			m.SetEncodedLegIssuerLen(*e.EncodedLegIssuerLen)
+		}
+		if e.EncodedLegIssuer != nil {
+			m.SetEncodedLegIssuer(*e.EncodedLegIssuer)
+		}
+		if e.LegSecurityDesc != nil {
+			m.SetLegSecurityDesc(*e.LegSecurityDesc)
+		}
+		if e.EncodedLegSecurityDescLen != nil {
+			m.SetEncodedLegSecurityDescLen(*e.EncodedLegSecurityDescLen)
+		}
+		if e.EncodedLegSecurityDesc != nil {
+			m.SetEncodedLegSecurityDesc(*e.EncodedLegSecurityDesc)
+		}
+		if e.LegRatioQty != nil {
+			m.SetLegRatioQty(*e.LegRatioQty, fix44.DecimalScale(*e.LegRatioQty))
+		}
+		if e.LegSide != nil {
+			m.SetLegSide(*e.LegSide)
+		}
+		if e.LegCurrency != nil {
+			m.SetLegCurrency(*e.LegCurrency)
+		}
+		if e.LegPool != nil {
+			m.SetLegPool(*e.LegPool)
+		}
+		if e.LegDatedDate != nil {
+			m.SetLegDatedDate(*e.LegDatedDate)
+		}
+		if e.LegContractSettlMonth != nil {
+			m.SetLegContractSettlMonth(*e.LegContractSettlMonth)
+		}
+		if e.LegInterestAccrualDate != nil {
+			m.SetLegInterestAccrualDate(*e.LegInterestAccrualDate)
+		}
+	}
+	return g
+}
+
+//NoUnderlyingsStruct is a plain Go representation of a NoUnderlyings group element, optional fields are nil when absent
+type NoUnderlyingsStruct struct {
+	UnderlyingSymbol                     *string
+	UnderlyingSymbolSfx                  *string
+	UnderlyingSecurityID                 *string
+	UnderlyingSecurityIDSource           *string
+	NoUnderlyingSecurityAltID            []NoUnderlyingSecurityAltIDStruct
+	UnderlyingProduct                    *int
+	UnderlyingCFICode                    *string
+	UnderlyingSecurityType               *string
+	UnderlyingSecuritySubType            *string
+	UnderlyingMaturityMonthYear          *string
+	UnderlyingMaturityDate               *string
+	UnderlyingCouponPaymentDate          *string
+	UnderlyingIssueDate                  *string
+	UnderlyingRepoCollateralSecurityType *int
+	UnderlyingRepurchaseTerm             *int
+	UnderlyingRepurchaseRate             *decimal.Decimal
+	UnderlyingFactor                     *decimal.Decimal
+	UnderlyingCreditRating               *string
+	UnderlyingInstrRegistry              *string
+	UnderlyingCountryOfIssue             *string
+	UnderlyingStateOrProvinceOfIssue     *string
+	UnderlyingLocaleOfIssue              *string
+	UnderlyingRedemptionDate             *string
+	UnderlyingStrikePrice                *decimal.Decimal
+	UnderlyingStrikeCurrency             *string
+	UnderlyingOptAttribute               *string
+	UnderlyingContractMultiplier         *decimal.Decimal
+	UnderlyingCouponRate                 *decimal.Decimal
+	UnderlyingSecurityExchange           *string
+	UnderlyingIssuer                     *string
+	EncodedUnderlyingIssuerLen           *int
+	EncodedUnderlyingIssuer              *string
+	UnderlyingSecurityDesc               *string
+	EncodedUnderlyingSecurityDescLen     *int
+	EncodedUnderlyingSecurityDesc        *string
+	UnderlyingCPProgram                  *string
+	UnderlyingCPRegType                  *string
+	UnderlyingCurrency                   *string
+	UnderlyingQty                        *decimal.Decimal
+	UnderlyingPx                         *decimal.Decimal
+	UnderlyingDirtyPrice                 *decimal.Decimal
+	UnderlyingEndPrice                   *decimal.Decimal
+	UnderlyingStartValue                 *decimal.Decimal
+	UnderlyingCurrentValue               *decimal.Decimal
+	UnderlyingEndValue                   *decimal.Decimal
+	NoUnderlyingStips                    []NoUnderlyingStipsStruct
+}
+
+//MarshalNoUnderlyings copies the elements of g into a slice of NoUnderlyingsStruct
+func MarshalNoUnderlyings(g NoUnderlyingsRepeatingGroup) ([]NoUnderlyingsStruct, quickfix.MessageRejectError) {
+	s := make([]NoUnderlyingsStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasUnderlyingSymbol() {
+			v, err := m.GetUnderlyingSymbol()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingSymbol = &v
+		}
+		if m.HasUnderlyingSymbolSfx() {
+			v, err := m.GetUnderlyingSymbolSfx()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingSymbolSfx = &v
+		}
+		if m.HasUnderlyingSecurityID() {
+			v, err := m.GetUnderlyingSecurityID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingSecurityID = &v
+		}
+		if m.HasUnderlyingSecurityIDSource() {
+			v, err := m.GetUnderlyingSecurityIDSource()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingSecurityIDSource = &v
+		}
+		if m.HasNoUnderlyingSecurityAltID() {
+			g, err := m.GetNoUnderlyingSecurityAltID()
+			if err != nil {
+				return nil, err
+			}
+			if s[i].NoUnderlyingSecurityAltID, err = components.MarshalNoUnderlyingSecurityAltID(g); err != nil {
+				return nil, err
+			}
+		}
+		if m.HasUnderlyingProduct() {
+			v, err := m.GetUnderlyingProduct()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingProduct = &v
+		}
+		if m.HasUnderlyingCFICode() {
+			v, err := m.GetUnderlyingCFICode()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCFICode = &v
+		}
+		if m.HasUnderlyingSecurityType() {
+			v, err := m.GetUnderlyingSecurityType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingSecurityType = &v
+		}
+		if m.HasUnderlyingSecuritySubType() {
+			v, err := m.GetUnderlyingSecuritySubType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingSecuritySubType = &v
+		}
+		if m.HasUnderlyingMaturityMonthYear() {
+			v, err := m.GetUnderlyingMaturityMonthYear()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingMaturityMonthYear = &v
+		}
+		if m.HasUnderlyingMaturityDate() {
+			v, err := m.GetUnderlyingMaturityDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingMaturityDate = &v
+		}
+		if m.HasUnderlyingCouponPaymentDate() {
+			v, err := m.GetUnderlyingCouponPaymentDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCouponPaymentDate = &v
+		}
+		if m.HasUnderlyingIssueDate() {
+			v, err := m.GetUnderlyingIssueDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingIssueDate = &v
+		}
+		if m.HasUnderlyingRepoCollateralSecurityType() {
+			v, err := m.GetUnderlyingRepoCollateralSecurityType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingRepoCollateralSecurityType = &v
+		}
+		if m.HasUnderlyingRepurchaseTerm() {
+			v, err := m.GetUnderlyingRepurchaseTerm()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingRepurchaseTerm = &v
+		}
+		if m.HasUnderlyingRepurchaseRate() {
+			v, err := m.GetUnderlyingRepurchaseRate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingRepurchaseRate = &v
+		}
+		if m.HasUnderlyingFactor() {
+			v, err := m.GetUnderlyingFactor()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingFactor = &v
+		}
+		if m.HasUnderlyingCreditRating() {
+			v, err := m.GetUnderlyingCreditRating()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCreditRating = &v
+		}
+		if m.HasUnderlyingInstrRegistry() {
+			v, err := m.GetUnderlyingInstrRegistry()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingInstrRegistry = &v
+		}
+		if m.HasUnderlyingCountryOfIssue() {
+			v, err := m.GetUnderlyingCountryOfIssue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCountryOfIssue = &v
+		}
+		if m.HasUnderlyingStateOrProvinceOfIssue() {
+			v, err := m.GetUnderlyingStateOrProvinceOfIssue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingStateOrProvinceOfIssue = &v
+		}
+		if m.HasUnderlyingLocaleOfIssue() {
+			v, err := m.GetUnderlyingLocaleOfIssue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingLocaleOfIssue = &v
+		}
+		if m.HasUnderlyingRedemptionDate() {
+			v, err := m.GetUnderlyingRedemptionDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingRedemptionDate = &v
+		}
+		if m.HasUnderlyingStrikePrice() {
+			v, err := m.GetUnderlyingStrikePrice()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingStrikePrice = &v
+		}
+		if m.HasUnderlyingStrikeCurrency() {
+			v, err := m.GetUnderlyingStrikeCurrency()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingStrikeCurrency = &v
+		}
+		if m.HasUnderlyingOptAttribute() {
+			v, err := m.GetUnderlyingOptAttribute()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingOptAttribute = &v
+		}
+		if m.HasUnderlyingContractMultiplier() {
+			v, err := m.GetUnderlyingContractMultiplier()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingContractMultiplier = &v
+		}
+		if m.HasUnderlyingCouponRate() {
+			v, err := m.GetUnderlyingCouponRate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCouponRate = &v
+		}
+		if m.HasUnderlyingSecurityExchange() {
+			v, err := m.GetUnderlyingSecurityExchange()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingSecurityExchange = &v
+		}
+		if m.HasUnderlyingIssuer() {
+			v, err := m.GetUnderlyingIssuer()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingIssuer = &v
+		}
+		if m.HasEncodedUnderlyingIssuerLen() {
+			v, err := m.GetEncodedUnderlyingIssuerLen()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedUnderlyingIssuerLen = &v
+		}
+		if m.HasEncodedUnderlyingIssuer() {
+			v, err := m.GetEncodedUnderlyingIssuer()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedUnderlyingIssuer = &v
+		}
+		if m.HasUnderlyingSecurityDesc() {
+			v, err := m.GetUnderlyingSecurityDesc()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingSecurityDesc = &v
+		}
+		if m.HasEncodedUnderlyingSecurityDescLen() {
+			v, err := m.GetEncodedUnderlyingSecurityDescLen()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedUnderlyingSecurityDescLen = &v
+		}
+		if m.HasEncodedUnderlyingSecurityDesc() {
+			v, err := m.GetEncodedUnderlyingSecurityDesc()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedUnderlyingSecurityDesc = &v
+		}
+		if m.HasUnderlyingCPProgram() {
+			v, err := m.GetUnderlyingCPProgram()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCPProgram = &v
+		}
+		if m.HasUnderlyingCPRegType() {
+			v, err := m.GetUnderlyingCPRegType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCPRegType = &v
+		}
+		if m.HasUnderlyingCurrency() {
+			v, err := m.GetUnderlyingCurrency()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCurrency = &v
+		}
+		if m.HasUnderlyingQty() {
+			v, err := m.GetUnderlyingQty()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingQty = &v
+		}
+		if m.HasUnderlyingPx() {
+			v, err := m.GetUnderlyingPx()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingPx = &v
+		}
+		if m.HasUnderlyingDirtyPrice() {
+			v, err := m.GetUnderlyingDirtyPrice()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingDirtyPrice = &v
+		}
+		if m.HasUnderlyingEndPrice() {
+			v, err := m.GetUnderlyingEndPrice()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingEndPrice = &v
+		}
+		if m.HasUnderlyingStartValue() {
+			v, err := m.GetUnderlyingStartValue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingStartValue = &v
+		}
+		if m.HasUnderlyingCurrentValue() {
+			v, err := m.GetUnderlyingCurrentValue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCurrentValue = &v
+		}
+		if m.HasUnderlyingEndValue() {
+			v, err := m.GetUnderlyingEndValue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingEndValue = &v
+		}
+		if m.HasNoUnderlyingStips() {
+			g, err := m.GetNoUnderlyingStips()
+			if err != nil {
+				return nil, err
+			}
+			if s[i].NoUnderlyingStips, err = components.MarshalNoUnderlyingStips(g); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoUnderlyings builds a NoUnderlyingsRepeatingGroup from s
+func UnmarshalNoUnderlyings(s []NoUnderlyingsStruct) NoUnderlyingsRepeatingGroup {
+	g := NewNoUnderlyingsRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.UnderlyingSymbol != nil {
+			m.SetUnderlyingSymbol(*e.UnderlyingSymbol)
+		}
+		if e.UnderlyingSymbolSfx != nil {
+			m.SetUnderlyingSymbolSfx(*e.UnderlyingSymbolSfx)
+		}
+		if e.UnderlyingSecurityID != nil {
+			m.SetUnderlyingSecurityID(*e.UnderlyingSecurityID)
+		}
+		if e.UnderlyingSecurityIDSource != nil {
+			m.SetUnderlyingSecurityIDSource(*e.UnderlyingSecurityIDSource)
+		}
+		if e.NoUnderlyingSecurityAltID != nil {
+			m.SetNoUnderlyingSecurityAltID(components.UnmarshalNoUnderlyingSecurityAltID(e.NoUnderlyingSecurityAltID))
+		}
+		if e.UnderlyingProduct != nil {
+			m.SetUnderlyingProduct(*e.UnderlyingProduct)
+		}
+		if e.UnderlyingCFICode != nil {
+			m.SetUnderlyingCFICode(*e.UnderlyingCFICode)
+		}
+		if e.UnderlyingSecurityType != nil {
+			m.SetUnderlyingSecurityType(*e.UnderlyingSecurityType)
+		}
+		if e.UnderlyingSecuritySubType != nil {
+			m.SetUnderlyingSecuritySubType(*e.UnderlyingSecuritySubType)
+		}
+		if e.UnderlyingMaturityMonthYear != nil {
+			m.SetUnderlyingMaturityMonthYear(*e.UnderlyingMaturityMonthYear)
+		}
+		if e.UnderlyingMaturityDate != nil {
+			m.SetUnderlyingMaturityDate(*e.UnderlyingMaturityDate)
+		}
+		if e.UnderlyingCouponPaymentDate != nil {
+			m.SetUnderlyingCouponPaymentDate(*e.UnderlyingCouponPaymentDate)
+		}
+		if e.UnderlyingIssueDate != nil {
+			m.SetUnderlyingIssueDate(*e.UnderlyingIssueDate)
+		}
+		if e.UnderlyingRepoCollateralSecurityType != nil {
+			m.SetUnderlyingRepoCollateralSecurityType(*e.UnderlyingRepoCollateralSecurityType)
+		}
+		if e.UnderlyingRepurchaseTerm != nil {
+			m.SetUnderlyingRepurchaseTerm(*e.UnderlyingRepurchaseTerm)
+		}
+		if e.UnderlyingRepurchaseRate != nil {
+			m.SetUnderlyingRepurchaseRate(*e.UnderlyingRepurchaseRate, fix44.DecimalScale(*e.UnderlyingRepurchaseRate))
+		}
+		if e.UnderlyingFactor != nil {
+			m.SetUnderlyingFactor(*e.UnderlyingFactor, fix44.DecimalScale(*e.UnderlyingFactor))
+		}
+		if e.UnderlyingCreditRating != nil {
+			m.SetUnderlyingCreditRating(*e.UnderlyingCreditRating)
+		}
+		if e.UnderlyingInstrRegistry != nil {
+			m.SetUnderlyingInstrRegistry(*e.UnderlyingInstrRegistry)
+		}
+		if e.UnderlyingCountryOfIssue != nil {
+			m.SetUnderlyingCountryOfIssue(*e.UnderlyingCountryOfIssue)
+		}
+		if e.UnderlyingStateOrProvinceOfIssue != nil {
+			m.SetUnderlyingStateOrProvinceOfIssue(*e.UnderlyingStateOrProvinceOfIssue)
+		}
+		if e.UnderlyingLocaleOfIssue != nil {
+			m.SetUnderlyingLocaleOfIssue(*e.UnderlyingLocaleOfIssue)
+		}
+		if e.UnderlyingRedemptionDate != nil {
+			m.SetUnderlyingRedemptionDate(*e.UnderlyingRedemptionDate)
+		}
+		if e.UnderlyingStrikePrice != nil {
+			m.SetUnderlyingStrikePrice(*e.UnderlyingStrikePrice, fix44.DecimalScale(*e.UnderlyingStrikePrice))
+		}
+		if e.UnderlyingStrikeCurrency != nil {
+			m.SetUnderlyingStrikeCurrency(*e.UnderlyingStrikeCurrency)
+		}
+		if e.UnderlyingOptAttribute != nil {
+			m.SetUnderlyingOptAttribute(*e.UnderlyingOptAttribute)
+		}
+		if e.UnderlyingContractMultiplier != nil {
+			m.SetUnderlyingContractMultiplier(*e.UnderlyingContractMultiplier, fix44.DecimalScale(*e.UnderlyingContractMultiplier))
+		}
+		if e.UnderlyingCouponRate != nil {
+			m.SetUnderlyingCouponRate(*e.UnderlyingCouponRate, fix44.DecimalScale(*e.UnderlyingCouponRate))
+		}
+		if e.UnderlyingSecurityExchange != nil {
+			m.SetUnderlyingSecurityExchange(*e.UnderlyingSecurityExchange)
+		}
+		if e.UnderlyingIssuer != nil {
+			m.SetUnderlyingIssuer(*e.UnderlyingIssuer)
+		}
+		if e.EncodedUnderlyingIssuerLen != nil {
+			m.SetEncodedUnderlyingIssuerLen(*e.EncodedUnderlyingIssuerLen)
+		}
+		if e.EncodedUnderlyingIssuer != nil {
+			m.SetEncodedUnderlyingIssuer(*e.EncodedUnderlyingIssuer)
+		}
+		if e.UnderlyingSecurityDesc != nil {
+			m.SetUnderlyingSecurityDesc(*e.UnderlyingSecurityDesc)
+		}
+		if e.EncodedUnderlyingSecurityDescLen != nil {
+			m.SetEncodedUnderlyingSecurityDescLen(*e.EncodedUnderlyingSecurityDescLen)
+		}
+		if e.EncodedUnderlyingSecurityDesc != nil {
+			m.SetEncodedUnderlyingSecurityDesc(*e.EncodedUnderlyingSecurityDesc)
+		}
+		if e.UnderlyingCPProgram != nil {
+			m.SetUnderlyingCPProgram(*e.UnderlyingCPProgram)
+		}
+		if e.UnderlyingCPRegType != nil {
+			m.SetUnderlyingCPRegType(*e.UnderlyingCPRegType)
+		}
+		if e.UnderlyingCurrency != nil {
+			m.SetUnderlyingCurrency(*e.UnderlyingCurrency)
+		}
+		if e.UnderlyingQty != nil {
+			m.SetUnderlyingQty(*e.UnderlyingQty, fix44.DecimalScale(*e.UnderlyingQty))
+		}
+		if e.UnderlyingPx != nil {
+			m.SetUnderlyingPx(*e.UnderlyingPx, fix44.DecimalScale(*e.UnderlyingPx))
+		}
+		if e.UnderlyingDirtyPrice != nil {
+			m.SetUnderlyingDirtyPrice(*e.UnderlyingDirtyPrice, fix44.DecimalScale(*e.UnderlyingDirtyPrice))
+		}
+		if e.UnderlyingEndPrice != nil {
+			m.SetUnderlyingEndPrice(*e.UnderlyingEndPrice, fix44.DecimalScale(*e.UnderlyingEndPrice))
+		}
+		if e.UnderlyingStartValue != nil {
+			m.SetUnderlyingStartValue(*e.UnderlyingStartValue, fix44.DecimalScale(*e.UnderlyingStartValue))
+		}
+		if e.UnderlyingCurrentValue != nil {
+			m.SetUnderlyingCurrentValue(*e.UnderlyingCurrentValue, fix44.DecimalScale(*e.UnderlyingCurrentValue))
+		}
+		if e.UnderlyingEndValue != nil {
+			m.SetUnderlyingEndValue(*e.UnderlyingEndValue, fix44.DecimalScale(*e.UnderlyingEndValue))
+		}
+		if e.NoUnderlyingStips != nil {
+			m.SetNoUnderlyingStips(components.UnmarshalNoUnderlyingStips(e.NoUnderlyingStips))
+		}
+	}
+	return g
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/terracefi/enum"
 	"github.com/terracefi/field"
 	"github.com/terracefi/fix44"
+	"github.com/terracefi/fix44/components"
 	"github.com/terracefi/quickfix"
 	"github.com/terracefi/tag"
 )
@@ -59,6 +60,26 @@ func Route(router RouteOut) (string, string, quickfix.MessageRoute) {
 	return "FIX.4.4", "AA", r
 }
 
+//Validate checks m against the FIX 4.4 definition of DerivativeSecurityList, see fix44.MessageDef.Validate
+func (m DerivativeSecurityList) Validate() fix44.ValidationErrors {
+	return fix44.MessageDefs["AA"].Validate(&m.Body.FieldMap)
+}
+
+//MarshalJSON encodes the DerivativeSecurityList as JSON with fields keyed by name, see fix44.MarshalMessageJSON
+func (m DerivativeSecurityList) MarshalJSON() ([]byte, error) {
+	return fix44.MarshalMessageJSON(m.Message)
+}
+
+//UnmarshalJSON builds the DerivativeSecurityList from JSON produced by MarshalJSON
+func (m *DerivativeSecurityList) UnmarshalJSON(b []byte) error {
+	msg, err := fix44.UnmarshalMessageJSON(b, "AA")
+	if err != nil {
+		return err
+	}
+	*m = FromMessage(msg)
+	return nil
+}
+
 //SetNoRelatedSym sets NoRelatedSym, Tag 146
 func (m DerivativeSecurityList) SetNoRelatedSym(f NoRelatedSymRepeatingGroup) {
 	m.SetGroup(f)
@@ -906,6 +927,21 @@ func (m DerivativeSecurityList) HasEncodedUnderlyingIssuer() bool {
 	return m.Has(tag.EncodedUnderlyingIssuer)
 }
 
+//SetEncodedUnderlyingIssuerBytes sets EncodedUnderlyingIssuer, Tag 363, and EncodedUnderlyingIssuerLen, Tag 362, from v, which may hold any bytes including SOH
+func (m DerivativeSecurityList) SetEncodedUnderlyingIssuerBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedUnderlyingIssuerLen, tag.EncodedUnderlyingIssuer, v)
+}
+
+//GetEncodedUnderlyingIssuerBytes gets EncodedUnderlyingIssuer, Tag 363, checking its length against EncodedUnderlyingIssuerLen, Tag 362
+func (m DerivativeSecurityList) GetEncodedUnderlyingIssuerBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedUnderlyingIssuerLen, tag.EncodedUnderlyingIssuer)
+}
+
+//GetEncodedUnderlyingIssuerDecoded gets EncodedUnderlyingIssuer, Tag 363, decoded to UTF-8 using the MessageEncoding of the Header
+func (m DerivativeSecurityList) GetEncodedUnderlyingIssuerDecoded() (string, error) {
+	return fix44.DecodeRawData(m.Header, m, tag.EncodedUnderlyingIssuerLen, tag.EncodedUnderlyingIssuer)
+}
+
 //HasEncodedUnderlyingSecurityDescLen returns true if EncodedUnderlyingSecurityDescLen is present, Tag 364
 func (m DerivativeSecurityList) HasEncodedUnderlyingSecurityDescLen() bool {
 	return m.Has(tag.EncodedUnderlyingSecurityDescLen)
@@ -916,6 +952,21 @@ func (m DerivativeSecurityList) HasEncodedUnderlyingSecurityDesc() bool {
 	return m.Has(tag.EncodedUnderlyingSecurityDesc)
 }
 
+//SetEncodedUnderlyingSecurityDescBytes sets EncodedUnderlyingSecurityDesc, Tag 365, and EncodedUnderlyingSecurityDescLen, Tag 364, from v, which may hold any bytes including SOH
+func (m DerivativeSecurityList) SetEncodedUnderlyingSecurityDescBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedUnderlyingSecurityDescLen, tag.EncodedUnderlyingSecurityDesc, v)
+}
+
+//GetEncodedUnderlyingSecurityDescBytes gets EncodedUnderlyingSecurityDesc, Tag 365, checking its length against EncodedUnderlyingSecurityDescLen, Tag 364
+func (m DerivativeSecurityList) GetEncodedUnderlyingSecurityDescBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedUnderlyingSecurityDescLen, tag.EncodedUnderlyingSecurityDesc)
+}
+
+//GetEncodedUnderlyingSecurityDescDecoded gets EncodedUnderlyingSecurityDesc, Tag 365, decoded to UTF-8 using the MessageEncoding of the Header
+func (m DerivativeSecurityList) GetEncodedUnderlyingSecurityDescDecoded() (string, error) {
+	return fix44.DecodeRawData(m.Header, m, tag.EncodedUnderlyingSecurityDescLen, tag.EncodedUnderlyingSecurityDesc)
+}
+
 //HasTotNoRelatedSym returns true if TotNoRelatedSym is present, Tag 393
 func (m DerivativeSecurityList) HasTotNoRelatedSym() bool {
 	return m.Has(tag.TotNoRelatedSym)
@@ -1041,6 +1092,16 @@ func (m DerivativeSecurityList) HasUnderlyingStrikeCurrency() bool {
 	return m.Has(tag.UnderlyingStrikeCurrency)
 }
 
+//GetUnderlyingInstrument gets the UnderlyingInstrument component
+func (m DerivativeSecurityList) GetUnderlyingInstrument() components.UnderlyingInstrument {
+	return components.UnderlyingInstrument{&m.Body.FieldMap}
+}
+
+//SetUnderlyingInstrument sets the UnderlyingInstrument component, copying the fields present in c
+func (m DerivativeSecurityList) SetUnderlyingInstrument(c components.UnderlyingInstrument) quickfix.MessageRejectError {
+	return c.CopyInto(&m.Body.FieldMap)
+}
+
 //NoRelatedSym is a repeating group element, Tag 146
 type NoRelatedSym struct {
 	*quickfix.Group
@@ -1940,6 +2001,21 @@ func (m NoRelatedSym) HasEncodedIssuer() bool {
 	return m.Has(tag.EncodedIssuer)
 }
 
+//SetEncodedIssuerBytes sets EncodedIssuer, Tag 349, and EncodedIssuerLen, Tag 348, from v, which may hold any bytes including SOH
+func (m NoRelatedSym) SetEncodedIssuerBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedIssuerLen, tag.EncodedIssuer, v)
+}
+
+//GetEncodedIssuerBytes gets EncodedIssuer, Tag 349, checking its length against EncodedIssuerLen, Tag 348
+func (m NoRelatedSym) GetEncodedIssuerBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedIssuerLen, tag.EncodedIssuer)
+}
+
+//GetEncodedIssuerDecoded gets EncodedIssuer, Tag 349, decoded to UTF-8 using the MessageEncoding of h, the Header of the message holding it
+func (m NoRelatedSym) GetEncodedIssuerDecoded(h fix44.Header) (string, error) {
+	return fix44.DecodeRawData(h, m, tag.EncodedIssuerLen, tag.EncodedIssuer)
+}
+
 //HasSecurityDesc returns true if SecurityDesc is present, Tag 107
 func (m NoRelatedSym) HasSecurityDesc() bool {
 	return m.Has(tag.SecurityDesc)
@@ -1955,6 +2031,21 @@ func (m NoRelatedSym) HasEncodedSecurityDesc() bool {
 	return m.Has(tag.EncodedSecurityDesc)
 }
 
+//SetEncodedSecurityDescBytes sets EncodedSecurityDesc, Tag 351, and EncodedSecurityDescLen, Tag 350, from v, which may hold any bytes including SOH
+func (m NoRelatedSym) SetEncodedSecurityDescBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedSecurityDescLen, tag.EncodedSecurityDesc, v)
+}
+
+//GetEncodedSecurityDescBytes gets EncodedSecurityDesc, Tag 351, checking its length against EncodedSecurityDescLen, Tag 350
+func (m NoRelatedSym) GetEncodedSecurityDescBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedSecurityDescLen, tag.EncodedSecurityDesc)
+}
+
+//GetEncodedSecurityDescDecoded gets EncodedSecurityDesc, Tag 351, decoded to UTF-8 using the MessageEncoding of h, the Header of the message holding it
+func (m NoRelatedSym) GetEncodedSecurityDescDecoded(h fix44.Header) (string, error) {
+	return fix44.DecodeRawData(h, m, tag.EncodedSecurityDescLen, tag.EncodedSecurityDesc)
+}
+
 //HasPool returns true if Pool is present, Tag 691
 func (m NoRelatedSym) HasPool() bool {
 	return m.Has(tag.Pool)
@@ -2045,175 +2136,58 @@ func (m NoRelatedSym) HasEncodedText() bool {
 	return m.Has(tag.EncodedText)
 }
 
-//NoSecurityAltID is a repeating group element, Tag 454
-type NoSecurityAltID struct {
-	*quickfix.Group
+//SetEncodedTextBytes sets EncodedText, Tag 355, and EncodedTextLen, Tag 354, from v, which may hold any bytes including SOH
+func (m NoRelatedSym) SetEncodedTextBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedTextLen, tag.EncodedText, v)
 }
 
-//SetSecurityAltID sets SecurityAltID, Tag 455
-func (m NoSecurityAltID) SetSecurityAltID(v string) {
-	m.Set(field.NewSecurityAltID(v))
+//GetEncodedTextBytes gets EncodedText, Tag 355, checking its length against EncodedTextLen, Tag 354
+func (m NoRelatedSym) GetEncodedTextBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedTextLen, tag.EncodedText)
 }
 
-//SetSecurityAltIDSource sets SecurityAltIDSource, Tag 456
-func (m NoSecurityAltID) SetSecurityAltIDSource(v string) {
-	m.Set(field.NewSecurityAltIDSource(v))
+//GetEncodedTextDecoded gets EncodedText, Tag 355, decoded to UTF-8 using the MessageEncoding of h, the Header of the message holding it
+func (m NoRelatedSym) GetEncodedTextDecoded(h fix44.Header) (string, error) {
+	return fix44.DecodeRawData(h, m, tag.EncodedTextLen, tag.EncodedText)
 }
 
-//GetSecurityAltID gets SecurityAltID, Tag 455
-func (m NoSecurityAltID) GetSecurityAltID() (v string, err quickfix.MessageRejectError) {
-	var f field.SecurityAltIDField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
+//GetInstrument gets the Instrument component
+func (m NoRelatedSym) GetInstrument() components.Instrument {
+	return components.Instrument{&m.Group.FieldMap}
 }
 
-//GetSecurityAltIDSource gets SecurityAltIDSource, Tag 456
-func (m NoSecurityAltID) GetSecurityAltIDSource() (v string, err quickfix.MessageRejectError) {
-	var f field.SecurityAltIDSourceField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
+//SetInstrument sets the Instrument component, copying the fields present in c
+func (m NoRelatedSym) SetInstrument(c components.Instrument) quickfix.MessageRejectError {
+	return c.CopyInto(&m.Group.FieldMap)
 }
 
-//HasSecurityAltID returns true if SecurityAltID is present, Tag 455
-func (m NoSecurityAltID) HasSecurityAltID() bool {
-	return m.Has(tag.SecurityAltID)
-}
-
-//HasSecurityAltIDSource returns true if SecurityAltIDSource is present, Tag 456
-func (m NoSecurityAltID) HasSecurityAltIDSource() bool {
-	return m.Has(tag.SecurityAltIDSource)
-}
+//NoSecurityAltID is a repeating group element, Tag 454
+type NoSecurityAltID = components.NoSecurityAltID
 
 //NoSecurityAltIDRepeatingGroup is a repeating group, Tag 454
-type NoSecurityAltIDRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
+type NoSecurityAltIDRepeatingGroup = components.NoSecurityAltIDRepeatingGroup
 
 //NewNoSecurityAltIDRepeatingGroup returns an initialized, NoSecurityAltIDRepeatingGroup
 func NewNoSecurityAltIDRepeatingGroup() NoSecurityAltIDRepeatingGroup {
-	return NoSecurityAltIDRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoSecurityAltID,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.SecurityAltID), quickfix.GroupElement(tag.SecurityAltIDSource)})}
-}
-
-//Add create and append a new NoSecurityAltID to this group
-func (m NoSecurityAltIDRepeatingGroup) Add() NoSecurityAltID {
-	g := m.RepeatingGroup.Add()
-	return NoSecurityAltID{g}
+	return components.NewNoSecurityAltIDRepeatingGroup()
 }
 
-//Get returns the ith NoSecurityAltID in the NoSecurityAltIDRepeatinGroup
-func (m NoSecurityAltIDRepeatingGroup) Get(i int) NoSecurityAltID {
-	return NoSecurityAltID{m.RepeatingGroup.Get(i)}
-}
+//NoSecurityAltIDStruct is a plain Go representation of a NoSecurityAltID group element
+type NoSecurityAltIDStruct = components.NoSecurityAltIDStruct
 
 //NoEvents is a repeating group element, Tag 864
-type NoEvents struct {
-	*quickfix.Group
-}
-
-//SetEventType sets EventType, Tag 865
-func (m NoEvents) SetEventType(v enum.EventType) {
-	m.Set(field.NewEventType(v))
-}
-
-//SetEventDate sets EventDate, Tag 866
-func (m NoEvents) SetEventDate(v string) {
-	m.Set(field.NewEventDate(v))
-}
-
-//SetEventPx sets EventPx, Tag 867
-func (m NoEvents) SetEventPx(value decimal.Decimal, scale int32) {
-	m.Set(field.NewEventPx(value, scale))
-}
-
-//SetEventText sets EventText, Tag 868
-func (m NoEvents) SetEventText(v string) {
-	m.Set(field.NewEventText(v))
-}
-
-//GetEventType gets EventType, Tag 865
-func (m NoEvents) GetEventType() (v enum.EventType, err quickfix.MessageRejectError) {
-	var f field.EventTypeField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetEventDate gets EventDate, Tag 866
-func (m NoEvents) GetEventDate() (v string, err quickfix.MessageRejectError) {
-	var f field.EventDateField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetEventPx gets EventPx, Tag 867
-func (m NoEvents) GetEventPx() (v decimal.Decimal, err quickfix.MessageRejectError) {
-	var f field.EventPxField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetEventText gets EventText, Tag 868
-func (m NoEvents) GetEventText() (v string, err quickfix.MessageRejectError) {
-	var f field.EventTextField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//HasEventType returns true if EventType is present, Tag 865
-func (m NoEvents) HasEventType() bool {
-	return m.Has(tag.EventType)
-}
-
-//HasEventDate returns true if EventDate is present, Tag 866
-func (m NoEvents) HasEventDate() bool {
-	return m.Has(tag.EventDate)
-}
-
-//HasEventPx returns true if EventPx is present, Tag 867
-func (m NoEvents) HasEventPx() bool {
-	return m.Has(tag.EventPx)
-}
-
-//HasEventText returns true if EventText is present, Tag 868
-func (m NoEvents) HasEventText() bool {
-	return m.Has(tag.EventText)
-}
+type NoEvents = components.NoEvents
 
 //NoEventsRepeatingGroup is a repeating group, Tag 864
-type NoEventsRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
+type NoEventsRepeatingGroup = components.NoEventsRepeatingGroup
 
 //NewNoEventsRepeatingGroup returns an initialized, NoEventsRepeatingGroup
 func NewNoEventsRepeatingGroup() NoEventsRepeatingGroup {
-	return NoEventsRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoEvents,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.EventType), quickfix.GroupElement(tag.EventDate), quickfix.GroupElement(tag.EventPx), quickfix.GroupElement(tag.EventText)})}
+	return components.NewNoEventsRepeatingGroup()
 }
 
-//Add create and append a new NoEvents to this group
-func (m NoEventsRepeatingGroup) Add() NoEvents {
-	g := m.RepeatingGroup.Add()
-	return NoEvents{g}
-}
-
-//Get returns the ith NoEvents in the NoEventsRepeatinGroup
-func (m NoEventsRepeatingGroup) Get(i int) NoEvents {
-	return NoEvents{m.RepeatingGroup.Get(i)}
-}
+//NoEventsStruct is a plain Go representation of a NoEvents group element
+type NoEventsStruct = components.NoEventsStruct
 
 //NoInstrAttrib is a repeating group element, Tag 870
 type NoInstrAttrib struct {
@@ -3032,6 +3006,21 @@ func (m NoLegs) HasEncodedLegIssuer() bool {
 	return m.Has(tag.EncodedLegIssuer)
 }
 
+//SetEncodedLegIssuerBytes sets EncodedLegIssuer, Tag 619, and EncodedLegIssuerLen, Tag 618, from v, which may hold any bytes including SOH
+func (m NoLegs) SetEncodedLegIssuerBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedLegIssuerLen, tag.EncodedLegIssuer, v)
+}
+
+//GetEncodedLegIssuerBytes gets EncodedLegIssuer, Tag 619, checking its length against EncodedLegIssuerLen, Tag 618
+func (m NoLegs) GetEncodedLegIssuerBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedLegIssuerLen, tag.EncodedLegIssuer)
+}
+
+//GetEncodedLegIssuerDecoded gets EncodedLegIssuer, Tag 619, decoded to UTF-8 using the MessageEncoding of h, the Header of the message holding it
+func (m NoLegs) GetEncodedLegIssuerDecoded(h fix44.Header) (string, error) {
+	return fix44.DecodeRawData(h, m, tag.EncodedLegIssuerLen, tag.EncodedLegIssuer)
+}
+
 //HasLegSecurityDesc returns true if LegSecurityDesc is present, Tag 620
 func (m NoLegs) HasLegSecurityDesc() bool {
 	return m.Has(tag.LegSecurityDesc)
@@ -3047,6 +3036,21 @@ func (m NoLegs) HasEncodedLegSecurityDesc() bool {
 	return m.Has(tag.EncodedLegSecurityDesc)
 }
 
+//SetEncodedLegSecurityDescBytes sets EncodedLegSecurityDesc, Tag 622, and EncodedLegSecurityDescLen, Tag 621, from v, which may hold any bytes including SOH
+func (m NoLegs) SetEncodedLegSecurityDescBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedLegSecurityDescLen, tag.EncodedLegSecurityDesc, v)
+}
+
+//GetEncodedLegSecurityDescBytes gets EncodedLegSecurityDesc, Tag 622, checking its length against EncodedLegSecurityDescLen, Tag 621
+func (m NoLegs) GetEncodedLegSecurityDescBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedLegSecurityDescLen, tag.EncodedLegSecurityDesc)
+}
+
+//GetEncodedLegSecurityDescDecoded gets EncodedLegSecurityDesc, Tag 622, decoded to UTF-8 using the MessageEncoding of h, the Header of the message holding it
+func (m NoLegs) GetEncodedLegSecurityDescDecoded(h fix44.Header) (string, error) {
+	return fix44.DecodeRawData(h, m, tag.EncodedLegSecurityDescLen, tag.EncodedLegSecurityDesc)
+}
+
 //HasLegRatioQty returns true if LegRatioQty is present, Tag 623
 func (m NoLegs) HasLegRatioQty() bool {
 	return m.Has(tag.LegRatioQty)
@@ -3082,71 +3086,29 @@ func (m NoLegs) HasLegInterestAccrualDate() bool {
 	return m.Has(tag.LegInterestAccrualDate)
 }
 
-//NoLegSecurityAltID is a repeating group element, Tag 604
-type NoLegSecurityAltID struct {
-	*quickfix.Group
-}
-
-//SetLegSecurityAltID sets LegSecurityAltID, Tag 605
-func (m NoLegSecurityAltID) SetLegSecurityAltID(v string) {
-	m.Set(field.NewLegSecurityAltID(v))
-}
-
-//SetLegSecurityAltIDSource sets LegSecurityAltIDSource, Tag 606
-func (m NoLegSecurityAltID) SetLegSecurityAltIDSource(v string) {
-	m.Set(field.NewLegSecurityAltIDSource(v))
-}
-
-//GetLegSecurityAltID gets LegSecurityAltID, Tag 605
-func (m NoLegSecurityAltID) GetLegSecurityAltID() (v string, err quickfix.MessageRejectError) {
-	var f field.LegSecurityAltIDField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetLegSecurityAltIDSource gets LegSecurityAltIDSource, Tag 606
-func (m NoLegSecurityAltID) GetLegSecurityAltIDSource() (v string, err quickfix.MessageRejectError) {
-	var f field.LegSecurityAltIDSourceField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
+//GetInstrumentLeg gets the InstrumentLeg component
+func (m NoLegs) GetInstrumentLeg() components.InstrumentLeg {
+	return components.InstrumentLeg{&m.Group.FieldMap}
 }
 
-//HasLegSecurityAltID returns true if LegSecurityAltID is present, Tag 605
-func (m NoLegSecurityAltID) HasLegSecurityAltID() bool {
-	return m.Has(tag.LegSecurityAltID)
+//SetInstrumentLeg sets the InstrumentLeg component, copying the fields present in c
+func (m NoLegs) SetInstrumentLeg(c components.InstrumentLeg) quickfix.MessageRejectError {
+	return c.CopyInto(&m.Group.FieldMap)
 }
 
-//HasLegSecurityAltIDSource returns true if LegSecurityAltIDSource is present, Tag 606
-func (m NoLegSecurityAltID) HasLegSecurityAltIDSource() bool {
-	return m.Has(tag.LegSecurityAltIDSource)
-}
+//NoLegSecurityAltID is a repeating group element, Tag 604
+type NoLegSecurityAltID = components.NoLegSecurityAltID
 
 //NoLegSecurityAltIDRepeatingGroup is a repeating group, Tag 604
-type NoLegSecurityAltIDRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
+type NoLegSecurityAltIDRepeatingGroup = components.NoLegSecurityAltIDRepeatingGroup
 
 //NewNoLegSecurityAltIDRepeatingGroup returns an initialized, NoLegSecurityAltIDRepeatingGroup
 func NewNoLegSecurityAltIDRepeatingGroup() NoLegSecurityAltIDRepeatingGroup {
-	return NoLegSecurityAltIDRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoLegSecurityAltID,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.LegSecurityAltID), quickfix.GroupElement(tag.LegSecurityAltIDSource)})}
+	return components.NewNoLegSecurityAltIDRepeatingGroup()
 }
 
-//Add create and append a new NoLegSecurityAltID to this group
-func (m NoLegSecurityAltIDRepeatingGroup) Add() NoLegSecurityAltID {
-	g := m.RepeatingGroup.Add()
-	return NoLegSecurityAltID{g}
-}
-
-//Get returns the ith NoLegSecurityAltID in the NoLegSecurityAltIDRepeatinGroup
-func (m NoLegSecurityAltIDRepeatingGroup) Get(i int) NoLegSecurityAltID {
-	return NoLegSecurityAltID{m.RepeatingGroup.Get(i)}
-}
+//NoLegSecurityAltIDStruct is a plain Go representation of a NoLegSecurityAltID group element
+type NoLegSecurityAltIDStruct = components.NoLegSecurityAltIDStruct
 
 //NoLegsRepeatingGroup is a repeating group, Tag 555
 type NoLegsRepeatingGroup struct {
@@ -3195,133 +3157,1757 @@ func (m NoRelatedSymRepeatingGroup) Get(i int) NoRelatedSym {
 }
 
 //NoUnderlyingSecurityAltID is a repeating group element, Tag 457
-type NoUnderlyingSecurityAltID struct {
-	*quickfix.Group
-}
-
-//SetUnderlyingSecurityAltID sets UnderlyingSecurityAltID, Tag 458
-func (m NoUnderlyingSecurityAltID) SetUnderlyingSecurityAltID(v string) {
-	m.Set(field.NewUnderlyingSecurityAltID(v))
-}
-
-//SetUnderlyingSecurityAltIDSource sets UnderlyingSecurityAltIDSource, Tag 459
-func (m NoUnderlyingSecurityAltID) SetUnderlyingSecurityAltIDSource(v string) {
-	m.Set(field.NewUnderlyingSecurityAltIDSource(v))
-}
-
-//GetUnderlyingSecurityAltID gets UnderlyingSecurityAltID, Tag 458
-func (m NoUnderlyingSecurityAltID) GetUnderlyingSecurityAltID() (v string, err quickfix.MessageRejectError) {
-	var f field.UnderlyingSecurityAltIDField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetUnderlyingSecurityAltIDSource gets UnderlyingSecurityAltIDSource, Tag 459
-func (m NoUnderlyingSecurityAltID) GetUnderlyingSecurityAltIDSource() (v string, err quickfix.MessageRejectError) {
-	var f field.UnderlyingSecurityAltIDSourceField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//HasUnderlyingSecurityAltID returns true if UnderlyingSecurityAltID is present, Tag 458
-func (m NoUnderlyingSecurityAltID) HasUnderlyingSecurityAltID() bool {
-	return m.Has(tag.UnderlyingSecurityAltID)
-}
-
-//HasUnderlyingSecurityAltIDSource returns true if UnderlyingSecurityAltIDSource is present, Tag 459
-func (m NoUnderlyingSecurityAltID) HasUnderlyingSecurityAltIDSource() bool {
-	return m.Has(tag.UnderlyingSecurityAltIDSource)
-}
+type NoUnderlyingSecurityAltID = components.NoUnderlyingSecurityAltID
 
 //NoUnderlyingSecurityAltIDRepeatingGroup is a repeating group, Tag 457
-type NoUnderlyingSecurityAltIDRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
+type NoUnderlyingSecurityAltIDRepeatingGroup = components.NoUnderlyingSecurityAltIDRepeatingGroup
 
 //NewNoUnderlyingSecurityAltIDRepeatingGroup returns an initialized, NoUnderlyingSecurityAltIDRepeatingGroup
 func NewNoUnderlyingSecurityAltIDRepeatingGroup() NoUnderlyingSecurityAltIDRepeatingGroup {
-	return NoUnderlyingSecurityAltIDRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoUnderlyingSecurityAltID,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.UnderlyingSecurityAltID), quickfix.GroupElement(tag.UnderlyingSecurityAltIDSource)})}
+	return components.NewNoUnderlyingSecurityAltIDRepeatingGroup()
 }
 
-//Add create and append a new NoUnderlyingSecurityAltID to this group
-func (m NoUnderlyingSecurityAltIDRepeatingGroup) Add() NoUnderlyingSecurityAltID {
-	g := m.RepeatingGroup.Add()
-	return NoUnderlyingSecurityAltID{g}
-}
-
-//Get returns the ith NoUnderlyingSecurityAltID in the NoUnderlyingSecurityAltIDRepeatinGroup
-func (m NoUnderlyingSecurityAltIDRepeatingGroup) Get(i int) NoUnderlyingSecurityAltID {
-	return NoUnderlyingSecurityAltID{m.RepeatingGroup.Get(i)}
-}
+//NoUnderlyingSecurityAltIDStruct is a plain Go representation of a NoUnderlyingSecurityAltID group element
+type NoUnderlyingSecurityAltIDStruct = components.NoUnderlyingSecurityAltIDStruct
 
 //NoUnderlyingStips is a repeating group element, Tag 887
-type NoUnderlyingStips struct {
-	*quickfix.Group
-}
-
-//SetUnderlyingStipType sets UnderlyingStipType, Tag 888
-func (m NoUnderlyingStips) SetUnderlyingStipType(v string) {
-	m.Set(field.NewUnderlyingStipType(v))
-}
-
-//SetUnderlyingStipValue sets UnderlyingStipValue, Tag 889
-func (m NoUnderlyingStips) SetUnderlyingStipValue(v string) {
-	m.Set(field.NewUnderlyingStipValue(v))
-}
-
-//GetUnderlyingStipType gets UnderlyingStipType, Tag 888
-func (m NoUnderlyingStips) GetUnderlyingStipType() (v string, err quickfix.MessageRejectError) {
-	var f field.UnderlyingStipTypeField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetUnderlyingStipValue gets UnderlyingStipValue, Tag 889
-func (m NoUnderlyingStips) GetUnderlyingStipValue() (v string, err quickfix.MessageRejectError) {
-	var f field.UnderlyingStipValueField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//HasUnderlyingStipType returns true if UnderlyingStipType is present, Tag 888
-func (m NoUnderlyingStips) HasUnderlyingStipType() bool {
-	return m.Has(tag.UnderlyingStipType)
-}
-
-//HasUnderlyingStipValue returns true if UnderlyingStipValue is present, Tag 889
-func (m NoUnderlyingStips) HasUnderlyingStipValue() bool {
-	return m.Has(tag.UnderlyingStipValue)
-}
+type NoUnderlyingStips = components.NoUnderlyingStips
 
 //NoUnderlyingStipsRepeatingGroup is a repeating group, Tag 887
-type NoUnderlyingStipsRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
+type NoUnderlyingStipsRepeatingGroup = components.NoUnderlyingStipsRepeatingGroup
 
 //NewNoUnderlyingStipsRepeatingGroup returns an initialized, NoUnderlyingStipsRepeatingGroup
 func NewNoUnderlyingStipsRepeatingGroup() NoUnderlyingStipsRepeatingGroup {
-	return NoUnderlyingStipsRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoUnderlyingStips,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.UnderlyingStipType), quickfix.GroupElement(tag.UnderlyingStipValue)})}
-}
-
-//Add create and append a new NoUnderlyingStips to this group
-func (m NoUnderlyingStipsRepeatingGroup) Add() NoUnderlyingStips {
-	g := m.RepeatingGroup.Add()
-	return NoUnderlyingStips{g}
-}
-
-//Get returns the ith NoUnderlyingStips in the NoUnderlyingStipsRepeatinGroup
-func (m NoUnderlyingStipsRepeatingGroup) Get(i int) NoUnderlyingStips {
-	return NoUnderlyingStips{m.RepeatingGroup.Get(i)}
+	return components.NewNoUnderlyingStipsRepeatingGroup()
+}
+
+//NoUnderlyingStipsStruct is a plain Go representation of a NoUnderlyingStips group element
+type NoUnderlyingStipsStruct = components.NoUnderlyingStipsStruct
+
+//Struct is a plain Go representation of the DerivativeSecurityList body, optional fields are nil when absent
+type Struct struct {
+	NoRelatedSym                         []NoRelatedSymStruct
+	UnderlyingCouponPaymentDate          *string
+	UnderlyingIssueDate                  *string
+	UnderlyingRepoCollateralSecurityType *int
+	UnderlyingRepurchaseTerm             *int
+	UnderlyingRepurchaseRate             *decimal.Decimal
+	UnderlyingFactor                     *decimal.Decimal
+	UnderlyingRedemptionDate             *string
+	UnderlyingCreditRating               *string
+	UnderlyingSecurityIDSource           *string
+	UnderlyingIssuer                     *string
+	UnderlyingSecurityDesc               *string
+	UnderlyingSecurityExchange           *string
+	UnderlyingSecurityID                 *string
+	UnderlyingSecurityType               *string
+	UnderlyingSymbol                     *string
+	UnderlyingSymbolSfx                  *string
+	UnderlyingMaturityMonthYear          *string
+	UnderlyingStrikePrice                *decimal.Decimal
+	UnderlyingOptAttribute               *string
+	UnderlyingCurrency                   *string
+	SecurityReqID                        string
+	SecurityResponseID                   string
+	EncodedUnderlyingIssuerLen           *int
+	EncodedUnderlyingIssuer              *string
+	EncodedUnderlyingSecurityDescLen     *int
+	EncodedUnderlyingSecurityDesc        *string
+	TotNoRelatedSym                      *int
+	UnderlyingCouponRate                 *decimal.Decimal
+	UnderlyingContractMultiplier         *decimal.Decimal
+	NoUnderlyingSecurityAltID            []NoUnderlyingSecurityAltIDStruct
+	UnderlyingProduct                    *int
+	UnderlyingCFICode                    *string
+	UnderlyingMaturityDate               *string
+	SecurityRequestResult                enum.SecurityRequestResult
+	UnderlyingCountryOfIssue             *string
+	UnderlyingStateOrProvinceOfIssue     *string
+	UnderlyingLocaleOfIssue              *string
+	UnderlyingInstrRegistry              *string
+	UnderlyingSecuritySubType            *string
+	UnderlyingPx                         *decimal.Decimal
+	UnderlyingCPProgram                  *string
+	UnderlyingCPRegType                  *string
+	UnderlyingQty                        *decimal.Decimal
+	UnderlyingDirtyPrice                 *decimal.Decimal
+	UnderlyingEndPrice                   *decimal.Decimal
+	UnderlyingStartValue                 *decimal.Decimal
+	UnderlyingCurrentValue               *decimal.Decimal
+	UnderlyingEndValue                   *decimal.Decimal
+	NoUnderlyingStips                    []NoUnderlyingStipsStruct
+	LastFragment                         *bool
+	UnderlyingStrikeCurrency             *string
+}
+
+//Marshal copies the fields present in msg into a Struct
+func Marshal(msg DerivativeSecurityList) (Struct, quickfix.MessageRejectError) {
+	var s Struct
+	if msg.HasNoRelatedSym() {
+		g, err := msg.GetNoRelatedSym()
+		if err != nil {
+			return s, err
+		}
+		if s.NoRelatedSym, err = MarshalNoRelatedSym(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasUnderlyingCouponPaymentDate() {
+		v, err := msg.GetUnderlyingCouponPaymentDate()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingCouponPaymentDate = &v
+	}
+	if msg.HasUnderlyingIssueDate() {
+		v, err := msg.GetUnderlyingIssueDate()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingIssueDate = &v
+	}
+	if msg.HasUnderlyingRepoCollateralSecurityType() {
+		v, err := msg.GetUnderlyingRepoCollateralSecurityType()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingRepoCollateralSecurityType = &v
+	}
+	if msg.HasUnderlyingRepurchaseTerm() {
+		v, err := msg.GetUnderlyingRepurchaseTerm()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingRepurchaseTerm = &v
+	}
+	if msg.HasUnderlyingRepurchaseRate() {
+		v, err := msg.GetUnderlyingRepurchaseRate()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingRepurchaseRate = &v
+	}
+	if msg.HasUnderlyingFactor() {
+		v, err := msg.GetUnderlyingFactor()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingFactor = &v
+	}
+	if msg.HasUnderlyingRedemptionDate() {
+		v, err := msg.GetUnderlyingRedemptionDate()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingRedemptionDate = &v
+	}
+	if msg.HasUnderlyingCreditRating() {
+		v, err := msg.GetUnderlyingCreditRating()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingCreditRating = &v
+	}
+	if msg.HasUnderlyingSecurityIDSource() {
+		v, err := msg.GetUnderlyingSecurityIDSource()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingSecurityIDSource = &v
+	}
+	if msg.HasUnderlyingIssuer() {
+		v, err := msg.GetUnderlyingIssuer()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingIssuer = &v
+	}
+	if msg.HasUnderlyingSecurityDesc() {
+		v, err := msg.GetUnderlyingSecurityDesc()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingSecurityDesc = &v
+	}
+	if msg.HasUnderlyingSecurityExchange() {
+		v, err := msg.GetUnderlyingSecurityExchange()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingSecurityExchange = &v
+	}
+	if msg.HasUnderlyingSecurityID() {
+		v, err := msg.GetUnderlyingSecurityID()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingSecurityID = &v
+	}
+	if msg.HasUnderlyingSecurityType() {
+		v, err := msg.GetUnderlyingSecurityType()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingSecurityType = &v
+	}
+	if msg.HasUnderlyingSymbol() {
+		v, err := msg.GetUnderlyingSymbol()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingSymbol = &v
+	}
+	if msg.HasUnderlyingSymbolSfx() {
+		v, err := msg.GetUnderlyingSymbolSfx()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingSymbolSfx = &v
+	}
+	if msg.HasUnderlyingMaturityMonthYear() {
+		v, err := msg.GetUnderlyingMaturityMonthYear()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingMaturityMonthYear = &v
+	}
+	if msg.HasUnderlyingStrikePrice() {
+		v, err := msg.GetUnderlyingStrikePrice()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingStrikePrice = &v
+	}
+	if msg.HasUnderlyingOptAttribute() {
+		v, err := msg.GetUnderlyingOptAttribute()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingOptAttribute = &v
+	}
+	if msg.HasUnderlyingCurrency() {
+		v, err := msg.GetUnderlyingCurrency()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingCurrency = &v
+	}
+	if msg.HasSecurityReqID() {
+		v, err := msg.GetSecurityReqID()
+		if err != nil {
+			return s, err
+		}
+		s.SecurityReqID = v
+	}
+	if msg.HasSecurityResponseID() {
+		v, err := msg.GetSecurityResponseID()
+		if err != nil {
+			return s, err
+		}
+		s.SecurityResponseID = v
+	}
+	if msg.HasEncodedUnderlyingIssuerLen() {
+		v, err := msg.GetEncodedUnderlyingIssuerLen()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedUnderlyingIssuerLen = &v
+	}
+	if msg.HasEncodedUnderlyingIssuer() {
+		v, err := msg.GetEncodedUnderlyingIssuer()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedUnderlyingIssuer = &v
+	}
+	if msg.HasEncodedUnderlyingSecurityDescLen() {
+		v, err := msg.GetEncodedUnderlyingSecurityDescLen()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedUnderlyingSecurityDescLen = &v
+	}
+	if msg.HasEncodedUnderlyingSecurityDesc() {
+		v, err := msg.GetEncodedUnderlyingSecurityDesc()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedUnderlyingSecurityDesc = &v
+	}
+	if msg.HasTotNoRelatedSym() {
+		v, err := msg.GetTotNoRelatedSym()
+		if err != nil {
+			return s, err
+		}
+		s.TotNoRelatedSym = &v
+	}
+	if msg.HasUnderlyingCouponRate() {
+		v, err := msg.GetUnderlyingCouponRate()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingCouponRate = &v
+	}
+	if msg.HasUnderlyingContractMultiplier() {
+		v, err := msg.GetUnderlyingContractMultiplier()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingContractMultiplier = &v
+	}
+	if msg.HasNoUnderlyingSecurityAltID() {
+		g, err := msg.GetNoUnderlyingSecurityAltID()
+		if err != nil {
+			return s, err
+		}
+		if s.NoUnderlyingSecurityAltID, err = components.MarshalNoUnderlyingSecurityAltID(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasUnderlyingProduct() {
+		v, err := msg.GetUnderlyingProduct()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingProduct = &v
+	}
+	if msg.HasUnderlyingCFICode() {
+		v, err := msg.GetUnderlyingCFICode()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingCFICode = &v
+	}
+	if msg.HasUnderlyingMaturityDate() {
+		v, err := msg.GetUnderlyingMaturityDate()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingMaturityDate = &v
+	}
+	if msg.HasSecurityRequestResult() {
+		v, err := msg.GetSecurityRequestResult()
+		if err != nil {
+			return s, err
+		}
+		s.SecurityRequestResult = v
+	}
+	if msg.HasUnderlyingCountryOfIssue() {
+		v, err := msg.GetUnderlyingCountryOfIssue()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingCountryOfIssue = &v
+	}
+	if msg.HasUnderlyingStateOrProvinceOfIssue() {
+		v, err := msg.GetUnderlyingStateOrProvinceOfIssue()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingStateOrProvinceOfIssue = &v
+	}
+	if msg.HasUnderlyingLocaleOfIssue() {
+		v, err := msg.GetUnderlyingLocaleOfIssue()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingLocaleOfIssue = &v
+	}
+	if msg.HasUnderlyingInstrRegistry() {
+		v, err := msg.GetUnderlyingInstrRegistry()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingInstrRegistry = &v
+	}
+	if msg.HasUnderlyingSecuritySubType() {
+		v, err := msg.GetUnderlyingSecuritySubType()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingSecuritySubType = &v
+	}
+	if msg.HasUnderlyingPx() {
+		v, err := msg.GetUnderlyingPx()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingPx = &v
+	}
+	if msg.HasUnderlyingCPProgram() {
+		v, err := msg.GetUnderlyingCPProgram()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingCPProgram = &v
+	}
+	if msg.HasUnderlyingCPRegType() {
+		v, err := msg.GetUnderlyingCPRegType()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingCPRegType = &v
+	}
+	if msg.HasUnderlyingQty() {
+		v, err := msg.GetUnderlyingQty()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingQty = &v
+	}
+	if msg.HasUnderlyingDirtyPrice() {
+		v, err := msg.GetUnderlyingDirtyPrice()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingDirtyPrice = &v
+	}
+	if msg.HasUnderlyingEndPrice() {
+		v, err := msg.GetUnderlyingEndPrice()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingEndPrice = &v
+	}
+	if msg.HasUnderlyingStartValue() {
+		v, err := msg.GetUnderlyingStartValue()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingStartValue = &v
+	}
+	if msg.HasUnderlyingCurrentValue() {
+		v, err := msg.GetUnderlyingCurrentValue()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingCurrentValue = &v
+	}
+	if msg.HasUnderlyingEndValue() {
+		v, err := msg.GetUnderlyingEndValue()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingEndValue = &v
+	}
+	if msg.HasNoUnderlyingStips() {
+		g, err := msg.GetNoUnderlyingStips()
+		if err != nil {
+			return s, err
+		}
+		if s.NoUnderlyingStips, err = components.MarshalNoUnderlyingStips(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasLastFragment() {
+		v, err := msg.GetLastFragment()
+		if err != nil {
+			return s, err
+		}
+		s.LastFragment = &v
+	}
+	if msg.HasUnderlyingStrikeCurrency() {
+		v, err := msg.GetUnderlyingStrikeCurrency()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingStrikeCurrency = &v
+	}
+	return s, nil
+}
+
+//Unmarshal builds a DerivativeSecurityList from s
+func Unmarshal(s Struct) DerivativeSecurityList {
+	m := New(field.NewSecurityReqID(s.SecurityReqID), field.NewSecurityResponseID(s.SecurityResponseID), field.NewSecurityRequestResult(s.SecurityRequestResult))
+	if s.NoRelatedSym != nil {
+		m.SetNoRelatedSym(UnmarshalNoRelatedSym(s.NoRelatedSym))
+	}
+	if s.UnderlyingCouponPaymentDate != nil {
+		m.SetUnderlyingCouponPaymentDate(*s.UnderlyingCouponPaymentDate)
+	}
+	if s.UnderlyingIssueDate != nil {
+		m.SetUnderlyingIssueDate(*s.UnderlyingIssueDate)
+	}
+	if s.UnderlyingRepoCollateralSecurityType != nil {
+		m.SetUnderlyingRepoCollateralSecurityType(*s.UnderlyingRepoCollateralSecurityType)
+	}
+	if s.UnderlyingRepurchaseTerm != nil {
+		m.SetUnderlyingRepurchaseTerm(*s.UnderlyingRepurchaseTerm)
+	}
+	if s.UnderlyingRepurchaseRate != nil {
+		m.SetUnderlyingRepurchaseRate(*s.UnderlyingRepurchaseRate, fix44.DecimalScale(*s.UnderlyingRepurchaseRate))
+	}
+	if s.UnderlyingFactor != nil {
+		m.SetUnderlyingFactor(*s.UnderlyingFactor, fix44.DecimalScale(*s.UnderlyingFactor))
+	}
+	if s.UnderlyingRedemptionDate != nil {
+		m.SetUnderlyingRedemptionDate(*s.UnderlyingRedemptionDate)
+	}
+	if s.UnderlyingCreditRating != nil {
+		m.SetUnderlyingCreditRating(*s.UnderlyingCreditRating)
+	}
+	if s.UnderlyingSecurityIDSource != nil {
+		m.SetUnderlyingSecurityIDSource(*s.UnderlyingSecurityIDSource)
+	}
+	if s.UnderlyingIssuer != nil {
+		m.SetUnderlyingIssuer(*s.UnderlyingIssuer)
+	}
+	if s.UnderlyingSecurityDesc != nil {
+		m.SetUnderlyingSecurityDesc(*s.UnderlyingSecurityDesc)
+	}
+	if s.UnderlyingSecurityExchange != nil {
+		m.SetUnderlyingSecurityExchange(*s.UnderlyingSecurityExchange)
+	}
+	if s.UnderlyingSecurityID != nil {
+		m.SetUnderlyingSecurityID(*s.UnderlyingSecurityID)
+	}
+	if s.UnderlyingSecurityType != nil {
+		m.SetUnderlyingSecurityType(*s.UnderlyingSecurityType)
+	}
+	if s.UnderlyingSymbol != nil {
+		m.SetUnderlyingSymbol(*s.UnderlyingSymbol)
+	}
+	if s.UnderlyingSymbolSfx != nil {
+		m.SetUnderlyingSymbolSfx(*s.UnderlyingSymbolSfx)
+	}
+	if s.UnderlyingMaturityMonthYear != nil {
+		m.SetUnderlyingMaturityMonthYear(*s.UnderlyingMaturityMonthYear)
+	}
+	if s.UnderlyingStrikePrice != nil {
+		m.SetUnderlyingStrikePrice(*s.UnderlyingStrikePrice, fix44.DecimalScale(*s.UnderlyingStrikePrice))
+	}
+	if s.UnderlyingOptAttribute != nil {
+		m.SetUnderlyingOptAttribute(*s.UnderlyingOptAttribute)
+	}
+	if s.UnderlyingCurrency != nil {
+		m.SetUnderlyingCurrency(*s.UnderlyingCurrency)
+	}
+	if s.EncodedUnderlyingIssuerLen != nil {
+		m.SetEncodedUnderlyingIssuerLen(*s.EncodedUnderlyingIssuerLen)
+	}
+	if s.EncodedUnderlyingIssuer != nil {
+		m.SetEncodedUnderlyingIssuer(*s.EncodedUnderlyingIssuer)
+	}
+	if s.EncodedUnderlyingSecurityDescLen != nil {
+		m.SetEncodedUnderlyingSecurityDescLen(*s.EncodedUnderlyingSecurityDescLen)
+	}
+	if s.EncodedUnderlyingSecurityDesc != nil {
+		m.SetEncodedUnderlyingSecurityDesc(*s.EncodedUnderlyingSecurityDesc)
+	}
+	if s.TotNoRelatedSym != nil {
+		m.SetTotNoRelatedSym(*s.TotNoRelatedSym)
+	}
+	if s.UnderlyingCouponRate != nil {
+		m.SetUnderlyingCouponRate(*s.UnderlyingCouponRate, fix44.DecimalScale(*s.UnderlyingCouponRate))
+	}
+	if s.UnderlyingContractMultiplier != nil {
+		m.SetUnderlyingContractMultiplier(*s.UnderlyingContractMultiplier, fix44.DecimalScale(*s.UnderlyingContractMultiplier))
+	}
+	if s.NoUnderlyingSecurityAltID != nil {
+		m.SetNoUnderlyingSecurityAltID(components.UnmarshalNoUnderlyingSecurityAltID(s.NoUnderlyingSecurityAltID))
+	}
+	if s.UnderlyingProduct != nil {
+		m.SetUnderlyingProduct(*s.UnderlyingProduct)
+	}
+	if s.UnderlyingCFICode != nil {
+		m.SetUnderlyingCFICode(*s.UnderlyingCFICode)
+	}
+	if s.UnderlyingMaturityDate != nil {
+		m.SetUnderlyingMaturityDate(*s.UnderlyingMaturityDate)
+	}
+	if s.UnderlyingCountryOfIssue != nil {
+		m.SetUnderlyingCountryOfIssue(*s.UnderlyingCountryOfIssue)
+	}
+	if s.UnderlyingStateOrProvinceOfIssue != nil {
+		m.SetUnderlyingStateOrProvinceOfIssue(*s.UnderlyingStateOrProvinceOfIssue)
+	}
+	if s.UnderlyingLocaleOfIssue != nil {
+		m.SetUnderlyingLocaleOfIssue(*s.UnderlyingLocaleOfIssue)
+	}
+	if s.UnderlyingInstrRegistry != nil {
+		m.SetUnderlyingInstrRegistry(*s.UnderlyingInstrRegistry)
+	}
+	if s.UnderlyingSecuritySubType != nil {
+		m.SetUnderlyingSecuritySubType(*s.UnderlyingSecuritySubType)
+	}
+	if s.UnderlyingPx != nil {
+		m.SetUnderlyingPx(*s.UnderlyingPx, fix44.DecimalScale(*s.UnderlyingPx))
+	}
+	if s.UnderlyingCPProgram != nil {
+		m.SetUnderlyingCPProgram(*s.UnderlyingCPProgram)
+	}
+	if s.UnderlyingCPRegType != nil {
+		m.SetUnderlyingCPRegType(*s.UnderlyingCPRegType)
+	}
+	if s.UnderlyingQty != nil {
+		m.SetUnderlyingQty(*s.UnderlyingQty, fix44.DecimalScale(*s.UnderlyingQty))
+	}
+	if s.UnderlyingDirtyPrice != nil {
+		m.SetUnderlyingDirtyPrice(*s.UnderlyingDirtyPrice, fix44.DecimalScale(*s.UnderlyingDirtyPrice))
+	}
+	if s.UnderlyingEndPrice != nil {
+		m.SetUnderlyingEndPrice(*s.UnderlyingEndPrice, fix44.DecimalScale(*s.UnderlyingEndPrice))
+	}
+	if s.UnderlyingStartValue != nil {
+		m.SetUnderlyingStartValue(*s.UnderlyingStartValue, fix44.DecimalScale(*s.UnderlyingStartValue))
+	}
+	if s.UnderlyingCurrentValue != nil {
+		m.SetUnderlyingCurrentValue(*s.UnderlyingCurrentValue, fix44.DecimalScale(*s.UnderlyingCurrentValue))
+	}
+	if s.UnderlyingEndValue != nil {
+		m.SetUnderlyingEndValue(*s.UnderlyingEndValue, fix44.DecimalScale(*s.UnderlyingEndValue))
+	}
+	if s.NoUnderlyingStips != nil {
+		m.SetNoUnderlyingStips(components.UnmarshalNoUnderlyingStips(s.NoUnderlyingStips))
+	}
+	if s.LastFragment != nil {
+		m.SetLastFragment(*s.LastFragment)
+	}
+	if s.UnderlyingStrikeCurrency != nil {
+		m.SetUnderlyingStrikeCurrency(*s.UnderlyingStrikeCurrency)
+	}
+	return m
+}
+
+//NoRelatedSymStruct is a plain Go representation of a NoRelatedSym group element, optional fields are nil when absent
+type NoRelatedSymStruct struct {
+	Symbol                     *string
+	SymbolSfx                  *enum.SymbolSfx
+	SecurityID                 *string
+	SecurityIDSource           *enum.SecurityIDSource
+	NoSecurityAltID            []NoSecurityAltIDStruct
+	Product                    *enum.Product
+	CFICode                    *string
+	SecurityType               *enum.SecurityType
+	SecuritySubType            *string
+	MaturityMonthYear          *string
+	MaturityDate               *string
+	CouponPaymentDate          *string
+	IssueDate                  *string
+	RepoCollateralSecurityType *int
+	RepurchaseTerm             *int
+	RepurchaseRate             *decimal.Decimal
+	Factor                     *decimal.Decimal
+	CreditRating               *string
+	InstrRegistry              *enum.InstrRegistry
+	CountryOfIssue             *string
+	StateOrProvinceOfIssue     *string
+	LocaleOfIssue              *string
+	RedemptionDate             *string
+	StrikePrice                *decimal.Decimal
+	StrikeCurrency             *string
+	OptAttribute               *string
+	ContractMultiplier         *decimal.Decimal
+	CouponRate                 *decimal.Decimal
+	SecurityExchange           *string
+	Issuer                     *string
+	EncodedIssuerLen           *int
+	EncodedIssuer              *string
+	SecurityDesc               *string
+	EncodedSecurityDescLen     *int
+	EncodedSecurityDesc        *string
+	Pool                       *string
+	ContractSettlMonth         *string
+	CPProgram                  *enum.CPProgram
+	CPRegType                  *string
+	NoEvents                   []NoEventsStruct
+	DatedDate                  *string
+	InterestAccrualDate        *string
+	Currency                   *string
+	ExpirationCycle            *enum.ExpirationCycle
+	DeliveryForm               *enum.DeliveryForm
+	PctAtRisk                  *decimal.Decimal
+	NoInstrAttrib              []NoInstrAttribStruct
+	NoLegs                     []NoLegsStruct
+	TradingSessionID           *enum.TradingSessionID
+	TradingSessionSubID        *enum.TradingSessionSubID
+	Text                       *string
+	EncodedTextLen             *int
+	EncodedText                *string
+}
+
+//MarshalNoRelatedSym copies the elements of g into a slice of NoRelatedSymStruct
+func MarshalNoRelatedSym(g NoRelatedSymRepeatingGroup) ([]NoRelatedSymStruct, quickfix.MessageRejectError) {
+	s := make([]NoRelatedSymStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasSymbol() {
+			v, err := m.GetSymbol()
+			if err != nil {
+				return nil, err
+			}
+			s[i].Symbol = &v
+		}
+		if m.HasSymbolSfx() {
+			v, err := m.GetSymbolSfx()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SymbolSfx = &v
+		}
+		if m.HasSecurityID() {
+			v, err := m.GetSecurityID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SecurityID = &v
+		}
+		if m.HasSecurityIDSource() {
+			v, err := m.GetSecurityIDSource()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SecurityIDSource = &v
+		}
+		if m.HasNoSecurityAltID() {
+			g, err := m.GetNoSecurityAltID()
+			if err != nil {
+				return nil, err
+			}
+			if s[i].NoSecurityAltID, err = components.MarshalNoSecurityAltID(g); err != nil {
+				return nil, err
+			}
+		}
+		if m.HasProduct() {
+			v, err := m.GetProduct()
+			if err != nil {
+				return nil, err
+			}
+			s[i].Product = &v
+		}
+		if m.HasCFICode() {
+			v, err := m.GetCFICode()
+			if err != nil {
+				return nil, err
+			}
+			s[i].CFICode = &v
+		}
+		if m.HasSecurityType() {
+			v, err := m.GetSecurityType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SecurityType = &v
+		}
+		if m.HasSecuritySubType() {
+			v, err := m.GetSecuritySubType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SecuritySubType = &v
+		}
+		if m.HasMaturityMonthYear() {
+			v, err := m.GetMaturityMonthYear()
+			if err != nil {
+				return nil, err
+			}
+			s[i].MaturityMonthYear = &v
+		}
+		if m.HasMaturityDate() {
+			v, err := m.GetMaturityDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].MaturityDate = &v
+		}
+		if m.HasCouponPaymentDate() {
+			v, err := m.GetCouponPaymentDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].CouponPaymentDate = &v
+		}
+		if m.HasIssueDate() {
+			v, err := m.GetIssueDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].IssueDate = &v
+		}
+		if m.HasRepoCollateralSecurityType() {
+			v, err := m.GetRepoCollateralSecurityType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].RepoCollateralSecurityType = &v
+		}
+		if m.HasRepurchaseTerm() {
+			v, err := m.GetRepurchaseTerm()
+			if err != nil {
+				return nil, err
+			}
+			s[i].RepurchaseTerm = &v
+		}
+		if m.HasRepurchaseRate() {
+			v, err := m.GetRepurchaseRate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].RepurchaseRate = &v
+		}
+		if m.HasFactor() {
+			v, err := m.GetFactor()
+			if err != nil {
+				return nil, err
+			}
+			s[i].Factor = &v
+		}
+		if m.HasCreditRating() {
+			v, err := m.GetCreditRating()
+			if err != nil {
+				return nil, err
+			}
+			s[i].CreditRating = &v
+		}
+		if m.HasInstrRegistry() {
+			v, err := m.GetInstrRegistry()
+			if err != nil {
+				return nil, err
+			}
+			s[i].InstrRegistry = &v
+		}
+		if m.HasCountryOfIssue() {
+			v, err := m.GetCountryOfIssue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].CountryOfIssue = &v
+		}
+		if m.HasStateOrProvinceOfIssue() {
+			v, err := m.GetStateOrProvinceOfIssue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].StateOrProvinceOfIssue = &v
+		}
+		if m.HasLocaleOfIssue() {
+			v, err := m.GetLocaleOfIssue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LocaleOfIssue = &v
+		}
+		if m.HasRedemptionDate() {
+			v, err := m.GetRedemptionDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].RedemptionDate = &v
+		}
+		if m.HasStrikePrice() {
+			v, err := m.GetStrikePrice()
+			if err != nil {
+				return nil, err
+			}
+			s[i].StrikePrice = &v
+		}
+		if m.HasStrikeCurrency() {
+			v, err := m.GetStrikeCurrency()
+			if err != nil {
+				return nil, err
+			}
+			s[i].StrikeCurrency = &v
+		}
+		if m.HasOptAttribute() {
+			v, err := m.GetOptAttribute()
+			if err != nil {
+				return nil, err
+			}
+			s[i].OptAttribute = &v
+		}
+		if m.HasContractMultiplier() {
+			v, err := m.GetContractMultiplier()
+			if err != nil {
+				return nil, err
+			}
+			s[i].ContractMultiplier = &v
+		}
+		if m.HasCouponRate() {
+			v, err := m.GetCouponRate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].CouponRate = &v
+		}
+		if m.HasSecurityExchange() {
+			v, err := m.GetSecurityExchange()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SecurityExchange = &v
+		}
+		if m.HasIssuer() {
+			v, err := m.GetIssuer()
+			if err != nil {
+				return nil, err
+			}
+			s[i].Issuer = &v
+		}
+		if m.HasEncodedIssuerLen() {
+			v, err := m.GetEncodedIssuerLen()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedIssuerLen = &v
+		}
+		if m.HasEncodedIssuer() {
+			v, err := m.GetEncodedIssuer()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedIssuer = &v
+		}
+		if m.HasSecurityDesc() {
+			v, err := m.GetSecurityDesc()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SecurityDesc = &v
+		}
+		if m.HasEncodedSecurityDescLen() {
+			v, err := m.GetEncodedSecurityDescLen()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedSecurityDescLen = &v
+		}
+		if m.HasEncodedSecurityDesc() {
+			v, err := m.GetEncodedSecurityDesc()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedSecurityDesc = &v
+		}
+		if m.HasPool() {
+			v, err := m.GetPool()
+			if err != nil {
+				return nil, err
+			}
+			s[i].Pool = &v
+		}
+		if m.HasContractSettlMonth() {
+			v, err := m.GetContractSettlMonth()
+			if err != nil {
+				return nil, err
+			}
+			s[i].ContractSettlMonth = &v
+		}
+		if m.HasCPProgram() {
+			v, err := m.GetCPProgram()
+			if err != nil {
+				return nil, err
+			}
+			s[i].CPProgram = &v
+		}
+		if m.HasCPRegType() {
+			v, err := m.GetCPRegType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].CPRegType = &v
+		}
+		if m.HasNoEvents() {
+			g, err := m.GetNoEvents()
+			if err != nil {
+				return nil, err
+			}
+			if s[i].NoEvents, err = components.MarshalNoEvents(g); err != nil {
+				return nil, err
+			}
+		}
+		if m.HasDatedDate() {
+			v, err := m.GetDatedDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].DatedDate = &v
+		}
+		if m.HasInterestAccrualDate() {
+			v, err := m.GetInterestAccrualDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].InterestAccrualDate = &v
+		}
+		if m.HasCurrency() {
+			v, err := m.GetCurrency()
+			if err != nil {
+				return nil, err
+			}
+			s[i].Currency = &v
+		}
+		if m.HasExpirationCycle() {
+			v, err := m.GetExpirationCycle()
+			if err != nil {
+				return nil, err
+			}
+			s[i].ExpirationCycle = &v
+		}
+		if m.HasDeliveryForm() {
+			v, err := m.GetDeliveryForm()
+			if err != nil {
+				return nil, err
+			}
+			s[i].DeliveryForm = &v
+		}
+		if m.HasPctAtRisk() {
+			v, err := m.GetPctAtRisk()
+			if err != nil {
+				return nil, err
+			}
+			s[i].PctAtRisk = &v
+		}
+		if m.HasNoInstrAttrib() {
+			g, err := m.GetNoInstrAttrib()
+			if err != nil {
+				return nil, err
+			}
+			if s[i].NoInstrAttrib, err = MarshalNoInstrAttrib(g); err != nil {
+				return nil, err
+			}
+		}
+		if m.HasNoLegs() {
+			g, err := m.GetNoLegs()
+			if err != nil {
+				return nil, err
+			}
+			if s[i].NoLegs, err = MarshalNoLegs(g); err != nil {
+				return nil, err
+			}
+		}
+		if m.HasTradingSessionID() {
+			v, err := m.GetTradingSessionID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].TradingSessionID = &v
+		}
+		if m.HasTradingSessionSubID() {
+			v, err := m.GetTradingSessionSubID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].TradingSessionSubID = &v
+		}
+		if m.HasText() {
+			v, err := m.GetText()
+			if err != nil {
+				return nil, err
+			}
+			s[i].Text = &v
+		}
+		if m.HasEncodedTextLen() {
+			v, err := m.GetEncodedTextLen()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedTextLen = &v
+		}
+		if m.HasEncodedText() {
+			v, err := m.GetEncodedText()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedText = &v
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoRelatedSym builds a NoRelatedSymRepeatingGroup from s
+func UnmarshalNoRelatedSym(s []NoRelatedSymStruct) NoRelatedSymRepeatingGroup {
+	g := NewNoRelatedSymRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.Symbol != nil {
+			m.SetSymbol(*e.Symbol)
+		}
+		if e.SymbolSfx != nil {
+			m.SetSymbolSfx(*e.SymbolSfx)
+		}
+		if e.SecurityID != nil {
+			m.SetSecurityID(*e.SecurityID)
+		}
+		if e.SecurityIDSource != nil {
+			m.SetSecurityIDSource(*e.SecurityIDSource)
+		}
+		if e.NoSecurityAltID != nil {
+			m.SetNoSecurityAltID(components.UnmarshalNoSecurityAltID(e.NoSecurityAltID))
+		}
+		if e.Product != nil {
+			m.SetProduct(*e.Product)
+		}
+		if e.CFICode != nil {
+			m.SetCFICode(*e.CFICode)
+		}
+		if e.SecurityType != nil {
+			m.SetSecurityType(*e.SecurityType)
+		}
+		if e.SecuritySubType != nil {
+			m.SetSecuritySubType(*e.SecuritySubType)
+		}
+		if e.MaturityMonthYear != nil {
+			m.SetMaturityMonthYear(*e.MaturityMonthYear)
+		}
+		if e.MaturityDate != nil {
+			m.SetMaturityDate(*e.MaturityDate)
+		}
+		if e.CouponPaymentDate != nil {
+			m.SetCouponPaymentDate(*e.CouponPaymentDate)
+		}
+		if e.IssueDate != nil {
+			m.SetIssueDate(*e.IssueDate)
+		}
+		if e.RepoCollateralSecurityType != nil {
+			m.SetRepoCollateralSecurityType(*e.RepoCollateralSecurityType)
+		}
+		if e.RepurchaseTerm != nil {
+			m.SetRepurchaseTerm(*e.RepurchaseTerm)
+		}
+		if e.RepurchaseRate != nil {
+			m.SetRepurchaseRate(*e.RepurchaseRate, fix44.DecimalScale(*e.RepurchaseRate))
+		}
+		if e.Factor != nil {
+			m.SetFactor(*e.Factor, fix44.DecimalScale(*e.Factor))
+		}
+		if e.CreditRating != nil {
+			m.SetCreditRating(*e.CreditRating)
+		}
+		if e.InstrRegistry != nil {
+			m.SetInstrRegistry(*e.InstrRegistry)
+		}
+		if e.CountryOfIssue != nil {
+			m.SetCountryOfIssue(*e.CountryOfIssue)
+		}
+		if e.StateOrProvinceOfIssue != nil {
+			m.SetStateOrProvinceOfIssue(*e.StateOrProvinceOfIssue)
+		}
+		if e.LocaleOfIssue != nil {
+			m.SetLocaleOfIssue(*e.LocaleOfIssue)
+		}
+		if e.RedemptionDate != nil {
+			m.SetRedemptionDate(*e.RedemptionDate)
+		}
+		if e.StrikePrice != nil {
+			m.SetStrikePrice(*e.StrikePrice, fix44.DecimalScale(*e.StrikePrice))
+		}
+		if e.StrikeCurrency != nil {
+			m.SetStrikeCurrency(*e.StrikeCurrency)
+		}
+		if e.OptAttribute != nil {
+			m.SetOptAttribute(*e.OptAttribute)
+		}
+		if e.ContractMultiplier != nil {
+			m.SetContractMultiplier(*e.ContractMultiplier, fix44.DecimalScale(*e.ContractMultiplier))
+		}
+		if e.CouponRate != nil {
+			m.SetCouponRate(*e.CouponRate, fix44.DecimalScale(*e.CouponRate))
+		}
+		if e.SecurityExchange != nil {
+			m.SetSecurityExchange(*e.SecurityExchange)
+		}
+		if e.Issuer != nil {
+			m.SetIssuer(*e.Issuer)
+		}
+		if e.EncodedIssuerLen != nil {
+			m.SetEncodedIssuerLen(*e.EncodedIssuerLen)
+		}
+		if e.EncodedIssuer != nil {
+			m.SetEncodedIssuer(*e.EncodedIssuer)
+		}
+		if e.SecurityDesc != nil {
+			m.SetSecurityDesc(*e.SecurityDesc)
+		}
+		if e.EncodedSecurityDescLen != nil {
+			m.SetEncodedSecurityDescLen(*e.EncodedSecurityDescLen)
+		}
+		if e.EncodedSecurityDesc != nil {
+			m.SetEncodedSecurityDesc(*e.EncodedSecurityDesc)
+		}
+		if e.Pool != nil {
+			m.SetPool(*e.Pool)
+		}
+		if e.ContractSettlMonth != nil {
+			m.SetContractSettlMonth(*e.ContractSettlMonth)
+		}
+		if e.CPProgram != nil {
+			m.SetCPProgram(*e.CPProgram)
+		}
+		if e.CPRegType != nil {
+			m.SetCPRegType(*e.CPRegType)
+		}
+		if e.NoEvents != nil {
+			m.SetNoEvents(components.UnmarshalNoEvents(e.NoEvents))
+		}
+		if e.DatedDate != nil {
+			m.SetDatedDate(*e.DatedDate)
+		}
+		if e.InterestAccrualDate != nil {
+			m.SetInterestAccrualDate(*e.InterestAccrualDate)
+		}
+		if e.Currency != nil {
+			m.SetCurrency(*e.Currency)
+		}
+		if e.ExpirationCycle != nil {
+			m.SetExpirationCycle(*e.ExpirationCycle)
+		}
+		if e.DeliveryForm != nil {
+			m.SetDeliveryForm(*e.DeliveryForm)
+		}
+		if e.PctAtRisk != nil {
+			m.SetPctAtRisk(*e.PctAtRisk, fix44.DecimalScale(*e.PctAtRisk))
+		}
+		if e.NoInstrAttrib != nil {
+			m.SetNoInstrAttrib(UnmarshalNoInstrAttrib(e.NoInstrAttrib))
+		}
+		if e.NoLegs != nil {
+			m.SetNoLegs(UnmarshalNoLegs(e.NoLegs))
+		}
+		if e.TradingSessionID != nil {
+			m.SetTradingSessionID(*e.TradingSessionID)
+		}
+		if e.TradingSessionSubID != nil {
+			m.SetTradingSessionSubID(*e.TradingSessionSubID)
+		}
+		if e.Text != nil {
+			m.SetText(*e.Text)
+		}
+		if e.EncodedTextLen != nil {
+			m.SetEncodedTextLen(*e.EncodedTextLen)
+		}
+		if e.EncodedText != nil {
+			m.SetEncodedText(*e.EncodedText)
+		}
+	}
+	return g
+}
+
+//NoInstrAttribStruct is a plain Go representation of a NoInstrAttrib group element, optional fields are nil when absent
+type NoInstrAttribStruct struct {
+	InstrAttribType  *enum.InstrAttribType
+	InstrAttribValue *string
+}
+
+//MarshalNoInstrAttrib copies the elements of g into a slice of NoInstrAttribStruct
+func MarshalNoInstrAttrib(g NoInstrAttribRepeatingGroup) ([]NoInstrAttribStruct, quickfix.MessageRejectError) {
+	s := make([]NoInstrAttribStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasInstrAttribType() {
+			v, err := m.GetInstrAttribType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].InstrAttribType = &v
+		}
+		if m.HasInstrAttribValue() {
+			v, err := m.GetInstrAttribValue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].InstrAttribValue = &v
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoInstrAttrib builds a NoInstrAttribRepeatingGroup from s
+func UnmarshalNoInstrAttrib(s []NoInstrAttribStruct) NoInstrAttribRepeatingGroup {
+	g := NewNoInstrAttribRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.InstrAttribType != nil {
+			m.SetInstrAttribType(*e.InstrAttribType)
+		}
+		if e.InstrAttribValue != nil {
+			m.SetInstrAttribValue(*e.InstrAttribValue)
+		}
+	}
+	return g
+}
+
+//NoLegsStruct is a plain Go representation of a NoLegs group element, optional fields are nil when absent
+type NoLegsStruct struct {
+	LegSymbol                     *string
+	LegSymbolSfx                  *string
+	LegSecurityID                 *string
+	LegSecurityIDSource           *string
+	NoLegSecurityAltID            []NoLegSecurityAltIDStruct
+	LegProduct                    *int
+	LegCFICode                    *string
+	LegSecurityType               *string
+	LegSecuritySubType            *string
+	LegMaturityMonthYear          *string
+	LegMaturityDate               *string
+	LegCouponPaymentDate          *string
+	LegIssueDate                  *string
+	LegRepoCollateralSecurityType *int
+	LegRepurchaseTerm             *int
+	LegRepurchaseRate             *decimal.Decimal
+	LegFactor                     *decimal.Decimal
+	LegCreditRating               *string
+	LegInstrRegistry              *string
+	LegCountryOfIssue             *string
+	LegStateOrProvinceOfIssue     *string
+	LegLocaleOfIssue              *string
+	LegRedemptionDate             *string
+	LegStrikePrice                *decimal.Decimal
+	LegStrikeCurrency             *string
+	LegOptAttribute               *string
+	LegContractMultiplier         *decimal.Decimal
+	LegCouponRate                 *decimal.Decimal
+	LegSecurityExchange           *string
+	LegIssuer                     *string
+	EncodedLegIssuerLen           *int
+	EncodedLegIssuer              *string
+	LegSecurityDesc               *string
+	EncodedLegSecurityDescLen     *int
+	EncodedLegSecurityDesc        *string
+	LegRatioQty                   *decimal.Decimal
+	LegSide                       *string
+	LegCurrency                   *string
+	LegPool                       *string
+	LegDatedDate                  *string
+	LegContractSettlMonth         *string
+	LegInterestAccrualDate        *string
+}
+
+//MarshalNoLegs copies the elements of g into a slice of NoLegsStruct
+func MarshalNoLegs(g NoLegsRepeatingGroup) ([]NoLegsStruct, quickfix.MessageRejectError) {
+	s := make([]NoLegsStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasLegSymbol() {
+			v, err := m.GetLegSymbol()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSymbol = &v
+		}
+		if m.HasLegSymbolSfx() {
+			v, err := m.GetLegSymbolSfx()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSymbolSfx = &v
+		}
+		if m.HasLegSecurityID() {
+			v, err := m.GetLegSecurityID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSecurityID = &v
+		}
+		if m.HasLegSecurityIDSource() {
+			v, err := m.GetLegSecurityIDSource()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSecurityIDSource = &v
+		}
+		if m.HasNoLegSecurityAltID() {
+			g, err := m.GetNoLegSecurityAltID()
+			if err != nil {
+				return nil, err
+			}
+			if s[i].NoLegSecurityAltID, err = components.MarshalNoLegSecurityAltID(g); err != nil {
+				return nil, err
+			}
+		}
+		if m.HasLegProduct() {
+			v, err := m.GetLegProduct()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegProduct = &v
+		}
+		if m.HasLegCFICode() {
+			v, err := m.GetLegCFICode()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegCFICode = &v
+		}
+		if m.HasLegSecurityType() {
+			v, err := m.GetLegSecurityType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSecurityType = &v
+		}
+		if m.HasLegSecuritySubType() {
+			v, err := m.GetLegSecuritySubType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSecuritySubType = &v
+		}
+		if m.HasLegMaturityMonthYear() {
+			v, err := m.GetLegMaturityMonthYear()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegMaturityMonthYear = &v
+		}
+		if m.HasLegMaturityDate() {
+			v, err := m.GetLegMaturityDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegMaturityDate = &v
+		}
+		if m.HasLegCouponPaymentDate() {
+			v, err := m.GetLegCouponPaymentDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegCouponPaymentDate = &v
+		}
+		if m.HasLegIssueDate() {
+			v, err := m.GetLegIssueDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegIssueDate = &v
+		}
+		if m.HasLegRepoCollateralSecurityType() {
+			v, err := m.GetLegRepoCollateralSecurityType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegRepoCollateralSecurityType = &v
+		}
+		if m.HasLegRepurchaseTerm() {
+			v, err := m.GetLegRepurchaseTerm()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegRepurchaseTerm = &v
+		}
+		if m.HasLegRepurchaseRate() {
+			v, err := m.GetLegRepurchaseRate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegRepurchaseRate = &v
+		}
+		if m.HasLegFactor() {
+			v, err := m.GetLegFactor()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegFactor = &v
+		}
+		if m.HasLegCreditRating() {
+			v, err := m.GetLegCreditRating()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegCreditRating = &v
+		}
+		if m.HasLegInstrRegistry() {
+			v, err := m.GetLegInstrRegistry()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegInstrRegistry = &v
+		}
+		if m.HasLegCountryOfIssue() {
+			v, err := m.GetLegCountryOfIssue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegCountryOfIssue = &v
+		}
+		if m.HasLegStateOrProvinceOfIssue() {
+			v, err := m.GetLegStateOrProvinceOfIssue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegStateOrProvinceOfIssue = &v
+		}
+		if m.HasLegLocaleOfIssue() {
+			v, err := m.GetLegLocaleOfIssue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegLocaleOfIssue = &v
+		}
+		if m.HasLegRedemptionDate() {
+			v, err := m.GetLegRedemptionDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegRedemptionDate = &v
+		}
+		if m.HasLegStrikePrice() {
+			v, err := m.GetLegStrikePrice()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegStrikePrice = &v
+		}
+		if m.HasLegStrikeCurrency() {
+			v, err := m.GetLegStrikeCurrency()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegStrikeCurrency = &v
+		}
+		if m.HasLegOptAttribute() {
+			v, err := m.GetLegOptAttribute()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegOptAttribute = &v
+		}
+		if m.HasLegContractMultiplier() {
+			v, err := m.GetLegContractMultiplier()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegContractMultiplier = &v
+		}
+		if m.HasLegCouponRate() {
+			v, err := m.GetLegCouponRate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegCouponRate = &v
+		}
+		if m.HasLegSecurityExchange() {
+			v, err := m.GetLegSecurityExchange()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSecurityExchange = &v
+		}
+		if m.HasLegIssuer() {
+			v, err := m.GetLegIssuer()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegIssuer = &v
+		}
+		if m.HasEncodedLegIssuerLen() {
+			v, err := m.GetEncodedLegIssuerLen()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedLegIssuerLen = &v
+		}
+		if m.HasEncodedLegIssuer() {
+			v, err := m.GetEncodedLegIssuer()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedLegIssuer = &v
+		}
+		if m.HasLegSecurityDesc() {
+			v, err := m.GetLegSecurityDesc()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSecurityDesc = &v
+		}
+		if m.HasEncodedLegSecurityDescLen() {
+			v, err := m.GetEncodedLegSecurityDescLen()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedLegSecurityDescLen = &v
+		}
+		if m.HasEncodedLegSecurityDesc() {
+			v, err := m.GetEncodedLegSecurityDesc()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedLegSecurityDesc = &v
+		}
+		if m.HasLegRatioQty() {
+			v, err := m.GetLegRatioQty()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegRatioQty = &v
+		}
+		if m.HasLegSide() {
+			v, err := m.GetLegSide()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSide = &v
+		}
+		if m.HasLegCurrency() {
+			v, err := m.GetLegCurrency()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegCurrency = &v
+		}
+		if m.HasLegPool() {
+			v, err := m.GetLegPool()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegPool = &v
+		}
+		if m.HasLegDatedDate() {
+			v, err := m.GetLegDatedDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegDatedDate = &v
+		}
+		if m.HasLegContractSettlMonth() {
+			v, err := m.GetLegContractSettlMonth()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegContractSettlMonth = &v
+		}
+		if m.HasLegInterestAccrualDate() {
+			v, err := m.GetLegInterestAccrualDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegInterestAccrualDate = &v
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoLegs builds a NoLegsRepeatingGroup from s
+func UnmarshalNoLegs(s []NoLegsStruct) NoLegsRepeatingGroup {
+	g := NewNoLegsRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.LegSymbol != nil {
+			m.SetLegSymbol(*e.LegSymbol)
+		}
+		if e.LegSymbolSfx != nil {
+			m.SetLegSymbolSfx(*e.LegSymbolSfx)
+		}
+		if e.LegSecurityID != nil {
+			m.SetLegSecurityID(*e.LegSecurityID)
+		}
+		if e.LegSecurityIDSource != nil {
+			m.SetLegSecurityIDSource(*e.LegSecurityIDSource)
+		}
+		if e.NoLegSecurityAltID != nil {
+			m.SetNoLegSecurityAltID(components.UnmarshalNoLegSecurityAltID(e.NoLegSecurityAltID))
+		}
+		if e.LegProduct != nil {
+			m.SetLegProduct(*e.LegProduct)
+		}
+		if e.LegCFICode != nil {
+			m.SetLegCFICode(*e.LegCFICode)
+		}
+		if e.LegSecurityType != nil {
+			m.SetLegSecurityType(*e.LegSecurityType)
+		}
+		if e.LegSecuritySubType != nil {
+			m.SetLegSecuritySubType(*e.LegSecuritySubType)
+		}
+		if e.LegMaturityMonthYear != nil {
+			m.SetLegMaturityMonthYear(*e.LegMaturityMonthYear)
+		}
+		if e.LegMaturityDate != nil {
+			m.SetLegMaturityDate(*e.LegMaturityDate)
+		}
+		if e.LegCouponPaymentDate != nil {
+			m.SetLegCouponPaymentDate(*e.LegCouponPaymentDate)
+		}
+		if e.LegIssueDate != nil {
+			m.SetLegIssueDate(*e.LegIssueDate)
+		}
+		if e.LegRepoCollateralSecurityType != nil {
+			m.SetLegRepoCollateralSecurityType(*e.LegRepoCollateralSecurityType)
+		}
+		if e.LegRepurchaseTerm != nil {
+			m.SetLegRepurchaseTerm(*e.LegRepurchaseTerm)
+		}
+		if e.LegRepurchaseRate != nil {
+			m.SetLegRepurchaseRate(*e.LegRepurchaseRate, fix44.DecimalScale(*e.LegRepurchaseRate))
+		}
+		if e.LegFactor != nil {
+			m.SetLegFactor(*e.LegFactor, fix44.DecimalScale(*e.LegFactor))
+		}
+		if e.LegCreditRating != nil {
+			m.SetLegCreditRating(*e.LegCreditRating)
+		}
+		if e.LegInstrRegistry != nil {
+			m.SetLegInstrRegistry(*e.LegInstrRegistry)
+		}
+		if e.LegCountryOfIssue != nil {
+			m.SetLegCountryOfIssue(*e.LegCountryOfIssue)
+		}
+		if e.LegStateOrProvinceOfIssue != nil {
+			m.SetLegStateOrProvinceOfIssue(*e.LegStateOrProvinceOfIssue)
+		}
+		if e.LegLocaleOfIssue != nil {
+			m.SetLegLocaleOfIssue(*e.LegLocaleOfIssue)
+		}
+		if e.LegRedemptionDate != nil {
+			m.SetLegRedemptionDate(*e.LegRedemptionDate)
+		}
+		if e.LegStrikePrice != nil {
+			m.SetLegStrikePrice(*e.LegStrikePrice, fix44.DecimalScale(*e.LegStrikePrice))
+		}
+		if e.LegStrikeCurrency != nil {
+			m.SetLegStrikeCurrency(*e.LegStrikeCurrency)
+		}
+		if e.LegOptAttribute != nil {
+			m.SetLegOptAttribute(*e.LegOptAttribute)
+		}
+		if e.LegContractMultiplier != nil {
+			m.SetLegContractMultiplier(*e.LegContractMultiplier, fix44.DecimalScale(*e.LegContractMultiplier))
+		}
+		if e.LegCouponRate != nil {
+			m.SetLegCouponRate(*e.LegCouponRate, fix44.DecimalScale(*e.LegCouponRate))
+		}
+		if e.LegSecurityExchange != nil {
+			m.SetLegSecurityExchange(*e.LegSecurityExchange)
+		}
+		if e.LegIssuer != nil {
+			m.SetLegIssuer(*e.LegIssuer)
+		}
+		if e.EncodedLegIssuerLen != nil {
+			m.SetEncodedLegIssuerLen(*e.EncodedLegIssuerLen)
+		}
+		if e.EncodedLegIssuer != nil {
+			m.SetEncodedLegIssuer(*e.EncodedLegIssuer)
+		}
+		if e.LegSecurityDesc != nil {
+			m.SetLegSecurityDesc(*e.LegSecurityDesc)
+		}
+		if e.EncodedLegSecurityDescLen != nil {
+			m.SetEncodedLegSecurityDescLen(*e.EncodedLegSecurityDescLen)
+		}
+		if e.EncodedLegSecurityDesc != nil {
+			m.SetEncodedLegSecurityDesc(*e.EncodedLegSecurityDesc)
+		}
+		if e.LegRatioQty != nil {
+			m.SetLegRatioQty(*e.LegRatioQty, fix44.DecimalScale(*e.LegRatioQty))
+		}
+		if e.LegSide != nil {
+			m.SetLegSide(*e.LegSide)
+		}
+		if e.LegCurrency != nil {
+			m.SetLegCurrency(*e.LegCurrency)
+		}
+		if e.LegPool != nil {
+			m.SetLegPool(*e.LegPool)
+		}
+		if e.LegDatedDate != nil {
+			m.SetLegDatedDate(*e.LegDatedDate)
+		}
+		if e.LegContractSettlMonth != nil {
+			m.SetLegContractSettlMonth(*e.LegContractSettlMonth)
+		}
+		if e.LegInterestAccrualDate != nil {
+			m.SetLegInterestAccrualDate(*e.LegInterestAccrualDate)
+		}
+	}
+	return g
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/terracefi/enum"
 	"github.com/terracefi/field"
 	"github.com/terracefi/fix44"
+	"github.com/terracefi/fix44/components"
 	"github.com/terracefi/quickfix"
 	"github.com/terracefi/tag"
 )
@@ -58,6 +59,26 @@ func Route(router RouteOut) (string, string, quickfix.MessageRoute) {
 	return "FIX.4.4", "z", r
 }
 
+//Validate checks m against the FIX 4.4 definition of DerivativeSecurityListRequest, see fix44.MessageDef.Validate
+func (m DerivativeSecurityListRequest) Validate() fix44.ValidationErrors {
+	return fix44.MessageDefs["z"].Validate(&m.Body.FieldMap)
+}
+
+//MarshalJSON encodes the DerivativeSecurityListRequest as JSON with fields keyed by name, see fix44.MarshalMessageJSON
+func (m DerivativeSecurityListRequest) MarshalJSON() ([]byte, error) {
+	return fix44.MarshalMessageJSON(m.Message)
+}
+
+//UnmarshalJSON builds the DerivativeSecurityListRequest from JSON produced by MarshalJSON
+func (m *DerivativeSecurityListRequest) UnmarshalJSON(b []byte) error {
+	msg, err := fix44.UnmarshalMessageJSON(b, "z")
+	if err != nil {
+		return err
+	}
+	*m = FromMessage(msg)
+	return nil
+}
+
 //SetCurrency sets Currency, Tag 15
 func (m DerivativeSecurityListRequest) SetCurrency(v string) {
 	m.Set(field.NewCurrency(v))
@@ -973,6 +994,21 @@ func (m DerivativeSecurityListRequest) HasEncodedText() bool {
 	return m.Has(tag.EncodedText)
 }
 
+//SetEncodedTextBytes sets EncodedText, Tag 355, and EncodedTextLen, Tag 354, from v, which may hold any bytes including SOH
+func (m DerivativeSecurityListRequest) SetEncodedTextBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedTextLen, tag.EncodedText, v)
+}
+
+//GetEncodedTextBytes gets EncodedText, Tag 355, checking its length against EncodedTextLen, Tag 354
+func (m DerivativeSecurityListRequest) GetEncodedTextBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedTextLen, tag.EncodedText)
+}
+
+//GetEncodedTextDecoded gets EncodedText, Tag 355, decoded to UTF-8 using the MessageEncoding of the Header
+func (m DerivativeSecurityListRequest) GetEncodedTextDecoded() (string, error) {
+	return fix44.DecodeRawData(m.Header, m, tag.EncodedTextLen, tag.EncodedText)
+}
+
 //HasEncodedUnderlyingIssuerLen returns true if EncodedUnderlyingIssuerLen is present, Tag 362
 func (m DerivativeSecurityListRequest) HasEncodedUnderlyingIssuerLen() bool {
 	return m.Has(tag.EncodedUnderlyingIssuerLen)
@@ -983,6 +1019,21 @@ func (m DerivativeSecurityListRequest) HasEncodedUnderlyingIssuer() bool {
 	return m.Has(tag.EncodedUnderlyingIssuer)
 }
 
+//SetEncodedUnderlyingIssuerBytes sets EncodedUnderlyingIssuer, Tag 363, and EncodedUnderlyingIssuerLen, Tag 362, from v, which may hold any bytes including SOH
+func (m DerivativeSecurityListRequest) SetEncodedUnderlyingIssuerBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedUnderlyingIssuerLen, tag.EncodedUnderlyingIssuer, v)
+}
+
+//GetEncodedUnderlyingIssuerBytes gets EncodedUnderlyingIssuer, Tag 363, checking its length against EncodedUnderlyingIssuerLen, Tag 362
+func (m DerivativeSecurityListRequest) GetEncodedUnderlyingIssuerBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedUnderlyingIssuerLen, tag.EncodedUnderlyingIssuer)
+}
+
+//GetEncodedUnderlyingIssuerDecoded gets EncodedUnderlyingIssuer, Tag 363, decoded to UTF-8 using the MessageEncoding of the Header
+func (m DerivativeSecurityListRequest) GetEncodedUnderlyingIssuerDecoded() (string, error) {
+	return fix44.DecodeRawData(m.Header, m, tag.EncodedUnderlyingIssuerLen, tag.EncodedUnderlyingIssuer)
+}
+
 //HasEncodedUnderlyingSecurityDescLen returns true if EncodedUnderlyingSecurityDescLen is present, Tag 364
 func (m DerivativeSecurityListRequest) HasEncodedUnderlyingSecurityDescLen() bool {
 	return m.Has(tag.EncodedUnderlyingSecurityDescLen)
@@ -993,6 +1044,21 @@ func (m DerivativeSecurityListRequest) HasEncodedUnderlyingSecurityDesc() bool {
 	return m.Has(tag.EncodedUnderlyingSecurityDesc)
 }
 
+//SetEncodedUnderlyingSecurityDescBytes sets EncodedUnderlyingSecurityDesc, Tag 365, and EncodedUnderlyingSecurityDescLen, Tag 364, from v, which may hold any bytes including SOH
+func (m DerivativeSecurityListRequest) SetEncodedUnderlyingSecurityDescBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedUnderlyingSecurityDescLen, tag.EncodedUnderlyingSecurityDesc, v)
+}
+
+//GetEncodedUnderlyingSecurityDescBytes gets EncodedUnderlyingSecurityDesc, Tag 365, checking its length against EncodedUnderlyingSecurityDescLen, Tag 364
+func (m DerivativeSecurityListRequest) GetEncodedUnderlyingSecurityDescBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedUnderlyingSecurityDescLen, tag.EncodedUnderlyingSecurityDesc)
+}
+
+//GetEncodedUnderlyingSecurityDescDecoded gets EncodedUnderlyingSecurityDesc, Tag 365, decoded to UTF-8 using the MessageEncoding of the Header
+func (m DerivativeSecurityListRequest) GetEncodedUnderlyingSecurityDescDecoded() (string, error) {
+	return fix44.DecodeRawData(m.Header, m, tag.EncodedUnderlyingSecurityDescLen, tag.EncodedUnderlyingSecurityDesc)
+}
+
 //HasUnderlyingCouponRate returns true if UnderlyingCouponRate is present, Tag 435
 func (m DerivativeSecurityListRequest) HasUnderlyingCouponRate() bool {
 	return m.Has(tag.UnderlyingCouponRate)
@@ -1118,134 +1184,670 @@ func (m DerivativeSecurityListRequest) HasUnderlyingStrikeCurrency() bool {
 	return m.Has(tag.UnderlyingStrikeCurrency)
 }
 
-//NoUnderlyingSecurityAltID is a repeating group element, Tag 457
-type NoUnderlyingSecurityAltID struct {
-	*quickfix.Group
-}
-
-//SetUnderlyingSecurityAltID sets UnderlyingSecurityAltID, Tag 458
-func (m NoUnderlyingSecurityAltID) SetUnderlyingSecurityAltID(v string) {
-	m.Set(field.NewUnderlyingSecurityAltID(v))
-}
-
-//SetUnderlyingSecurityAltIDSource sets UnderlyingSecurityAltIDSource, Tag 459
-func (m NoUnderlyingSecurityAltID) SetUnderlyingSecurityAltIDSource(v string) {
-	m.Set(field.NewUnderlyingSecurityAltIDSource(v))
+//GetUnderlyingInstrument gets the UnderlyingInstrument component
+func (m DerivativeSecurityListRequest) GetUnderlyingInstrument() components.UnderlyingInstrument {
+	return components.UnderlyingInstrument{&m.Body.FieldMap}
 }
 
-//GetUnderlyingSecurityAltID gets UnderlyingSecurityAltID, Tag 458
-func (m NoUnderlyingSecurityAltID) GetUnderlyingSecurityAltID() (v string, err quickfix.MessageRejectError) {
-	var f field.UnderlyingSecurityAltIDField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
+//SetUnderlyingInstrument sets the UnderlyingInstrument component, copying the fields present in c
+func (m DerivativeSecurityListRequest) SetUnderlyingInstrument(c components.UnderlyingInstrument) quickfix.MessageRejectError {
+	return c.CopyInto(&m.Body.FieldMap)
 }
 
-//GetUnderlyingSecurityAltIDSource gets UnderlyingSecurityAltIDSource, Tag 459
-func (m NoUnderlyingSecurityAltID) GetUnderlyingSecurityAltIDSource() (v string, err quickfix.MessageRejectError) {
-	var f field.UnderlyingSecurityAltIDSourceField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//HasUnderlyingSecurityAltID returns true if UnderlyingSecurityAltID is present, Tag 458
-func (m NoUnderlyingSecurityAltID) HasUnderlyingSecurityAltID() bool {
-	return m.Has(tag.UnderlyingSecurityAltID)
-}
-
-//HasUnderlyingSecurityAltIDSource returns true if UnderlyingSecurityAltIDSource is present, Tag 459
-func (m NoUnderlyingSecurityAltID) HasUnderlyingSecurityAltIDSource() bool {
-	return m.Has(tag.UnderlyingSecurityAltIDSource)
-}
+//NoUnderlyingSecurityAltID is a repeating group element, Tag 457
+type NoUnderlyingSecurityAltID = components.NoUnderlyingSecurityAltID
 
 //NoUnderlyingSecurityAltIDRepeatingGroup is a repeating group, Tag 457
-type NoUnderlyingSecurityAltIDRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
+type NoUnderlyingSecurityAltIDRepeatingGroup = components.NoUnderlyingSecurityAltIDRepeatingGroup
 
 //NewNoUnderlyingSecurityAltIDRepeatingGroup returns an initialized, NoUnderlyingSecurityAltIDRepeatingGroup
 func NewNoUnderlyingSecurityAltIDRepeatingGroup() NoUnderlyingSecurityAltIDRepeatingGroup {
-	return NoUnderlyingSecurityAltIDRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoUnderlyingSecurityAltID,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.UnderlyingSecurityAltID), quickfix.GroupElement(tag.UnderlyingSecurityAltIDSource)})}
+	return components.NewNoUnderlyingSecurityAltIDRepeatingGroup()
 }
 
-//Add create and append a new NoUnderlyingSecurityAltID to this group
-func (m NoUnderlyingSecurityAltIDRepeatingGroup) Add() NoUnderlyingSecurityAltID {
-	g := m.RepeatingGroup.Add()
-	return NoUnderlyingSecurityAltID{g}
-}
-
-//Get returns the ith NoUnderlyingSecurityAltID in the NoUnderlyingSecurityAltIDRepeatinGroup
-func (m NoUnderlyingSecurityAltIDRepeatingGroup) Get(i int) NoUnderlyingSecurityAltID {
-	return NoUnderlyingSecurityAltID{m.RepeatingGroup.Get(i)}
-}
+//NoUnderlyingSecurityAltIDStruct is a plain Go representation of a NoUnderlyingSecurityAltID group element
+type NoUnderlyingSecurityAltIDStruct = components.NoUnderlyingSecurityAltIDStruct
 
 //NoUnderlyingStips is a repeating group element, Tag 887
-type NoUnderlyingStips struct {
-	*quickfix.Group
-}
-
-//SetUnderlyingStipType sets UnderlyingStipType, Tag 888
-func (m NoUnderlyingStips) SetUnderlyingStipType(v string) {
-	m.Set(field.NewUnderlyingStipType(v))
-}
-
-//SetUnderlyingStipValue sets UnderlyingStipValue, Tag 889
-func (m NoUnderlyingStips) SetUnderlyingStipValue(v string) {
-	m.Set(field.NewUnderlyingStipValue(v))
-}
-
-//GetUnderlyingStipType gets UnderlyingStipType, Tag 888
-func (m NoUnderlyingStips) GetUnderlyingStipType() (v string, err quickfix.MessageRejectError) {
-	var f field.UnderlyingStipTypeField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetUnderlyingStipValue gets UnderlyingStipValue, Tag 889
-func (m NoUnderlyingStips) GetUnderlyingStipValue() (v string, err quickfix.MessageRejectError) {
-	var f field.UnderlyingStipValueField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//HasUnderlyingStipType returns true if UnderlyingStipType is present, Tag 888
-func (m NoUnderlyingStips) HasUnderlyingStipType() bool {
-	return m.Has(tag.UnderlyingStipType)
-}
-
-//HasUnderlyingStipValue returns true if UnderlyingStipValue is present, Tag 889
-func (m NoUnderlyingStips) HasUnderlyingStipValue() bool {
-	return m.Has(tag.UnderlyingStipValue)
-}
+type NoUnderlyingStips = components.NoUnderlyingStips
 
 //NoUnderlyingStipsRepeatingGroup is a repeating group, Tag 887
-type NoUnderlyingStipsRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
+type NoUnderlyingStipsRepeatingGroup = components.NoUnderlyingStipsRepeatingGroup
 
 //NewNoUnderlyingStipsRepeatingGroup returns an initialized, NoUnderlyingStipsRepeatingGroup
 func NewNoUnderlyingStipsRepeatingGroup() NoUnderlyingStipsRepeatingGroup {
-	return NoUnderlyingStipsRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoUnderlyingStips,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.UnderlyingStipType), quickfix.GroupElement(tag.UnderlyingStipValue)})}
-}
-
-//Add create and append a new NoUnderlyingStips to this group
-func (m NoUnderlyingStipsRepeatingGroup) Add() NoUnderlyingStips {
-	g := m.RepeatingGroup.Add()
-	return NoUnderlyingStips{g}
+	return components.NewNoUnderlyingStipsRepeatingGroup()
+}
+
+//NoUnderlyingStipsStruct is a plain Go representation of a NoUnderlyingStips group element
+type NoUnderlyingStipsStruct = components.NoUnderlyingStipsStruct
+
+//Struct is a plain Go representation of the DerivativeSecurityListRequest body, optional fields are nil when absent
+type Struct struct {
+	Currency                             *string
+	Text                                 *string
+	UnderlyingCouponPaymentDate          *string
+	UnderlyingIssueDate                  *string
+	UnderlyingRepoCollateralSecurityType *int
+	UnderlyingRepurchaseTerm             *int
+	UnderlyingRepurchaseRate             *decimal.Decimal
+	UnderlyingFactor                     *decimal.Decimal
+	UnderlyingRedemptionDate             *string
+	UnderlyingCreditRating               *string
+	SubscriptionRequestType              *enum.SubscriptionRequestType
+	UnderlyingSecurityIDSource           *string
+	UnderlyingIssuer                     *string
+	UnderlyingSecurityDesc               *string
+	UnderlyingSecurityExchange           *string
+	UnderlyingSecurityID                 *string
+	UnderlyingSecurityType               *string
+	UnderlyingSymbol                     *string
+	UnderlyingSymbolSfx                  *string
+	UnderlyingMaturityMonthYear          *string
+	UnderlyingStrikePrice                *decimal.Decimal
+	UnderlyingOptAttribute               *string
+	UnderlyingCurrency                   *string
+	SecurityReqID                        string
+	TradingSessionID                     *enum.TradingSessionID
+	EncodedTextLen                       *int
+	EncodedText                          *string
+	EncodedUnderlyingIssuerLen           *int
+	EncodedUnderlyingIssuer              *string
+	EncodedUnderlyingSecurityDescLen     *int
+	EncodedUnderlyingSecurityDesc        *string
+	UnderlyingCouponRate                 *decimal.Decimal
+	UnderlyingContractMultiplier         *decimal.Decimal
+	NoUnderlyingSecurityAltID            []NoUnderlyingSecurityAltIDStruct
+	UnderlyingProduct                    *int
+	UnderlyingCFICode                    *string
+	UnderlyingMaturityDate               *string
+	SecurityListRequestType              enum.SecurityListRequestType
+	UnderlyingCountryOfIssue             *string
+	UnderlyingStateOrProvinceOfIssue     *string
+	UnderlyingLocaleOfIssue              *string
+	UnderlyingInstrRegistry              *string
+	TradingSessionSubID                  *enum.TradingSessionSubID
+	SecuritySubType                      *string
+	UnderlyingSecuritySubType            *string
+	UnderlyingPx                         *decimal.Decimal
+	UnderlyingCPProgram                  *string
+	UnderlyingCPRegType                  *string
+	UnderlyingQty                        *decimal.Decimal
+	UnderlyingDirtyPrice                 *decimal.Decimal
+	UnderlyingEndPrice                   *decimal.Decimal
+	UnderlyingStartValue                 *decimal.Decimal
+	UnderlyingCurrentValue               *decimal.Decimal
+	UnderlyingEndValue                   *decimal.Decimal
+	NoUnderlyingStips                    []NoUnderlyingStipsStruct
+	UnderlyingStrikeCurrency             *string
+}
+
+//Marshal copies the fields present in msg into a Struct
+func Marshal(msg DerivativeSecurityListRequest) (Struct, quickfix.MessageRejectError) {
+	var s Struct
+	if msg.HasCurrency() {
+		v, err := msg.GetCurrency()
+		if err != nil {
+			return s, err
+		}
+		s.Currency = &v
+	}
+	if msg.HasText() {
+		v, err := msg.GetText()
+		if err != nil {
+			return s, err
+		}
+		s.Text = &v
+	}
+	if msg.HasUnderlyingCouponPaymentDate() {
+		v, err := msg.GetUnderlyingCouponPaymentDate()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingCouponPaymentDate = &v
+	}
+	if msg.HasUnderlyingIssueDate() {
+		v, err := msg.GetUnderlyingIssueDate()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingIssueDate = &v
+	}
+	if msg.HasUnderlyingRepoCollateralSecurityType() {
+		v, err := msg.GetUnderlyingRepoCollateralSecurityType()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingRepoCollateralSecurityType = &v
+	}
+	if msg.HasUnderlyingRepurchaseTerm() {
+		v, err := msg.GetUnderlyingRepurchaseTerm()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingRepurchaseTerm = &v
+	}
+	if msg.HasUnderlyingRepurchaseRate() {
+		v, err := msg.GetUnderlyingRepurchaseRate()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingRepurchaseRate = &v
+	}
+	if msg.HasUnderlyingFactor() {
+		v, err := msg.GetUnderlyingFactor()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingFactor = &v
+	}
+	if msg.HasUnderlyingRedemptionDate() {
+		v, err := msg.GetUnderlyingRedemptionDate()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingRedemptionDate = &v
+	}
+	if msg.HasUnderlyingCreditRating() {
+		v, err := msg.GetUnderlyingCreditRating()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingCreditRating = &v
+	}
+	if msg.HasSubscriptionRequestType() {
+		v, err := msg.GetSubscriptionRequestType()
+		if err != nil {
+			return s, err
+		}
+		s.SubscriptionRequestType = &v
+	}
+	if msg.HasUnderlyingSecurityIDSource() {
+		v, err := msg.GetUnderlyingSecurityIDSource()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingSecurityIDSource = &v
+	}
+	if msg.HasUnderlyingIssuer() {
+		v, err := msg.GetUnderlyingIssuer()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingIssuer = &v
+	}
+	if msg.HasUnderlyingSecurityDesc() {
+		v, err := msg.GetUnderlyingSecurityDesc()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingSecurityDesc = &v
+	}
+	if msg.HasUnderlyingSecurityExchange() {
+		v, err := msg.GetUnderlyingSecurityExchange()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingSecurityExchange = &v
+	}
+	if msg.HasUnderlyingSecurityID() {
+		v, err := msg.GetUnderlyingSecurityID()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingSecurityID = &v
+	}
+	if msg.HasUnderlyingSecurityType() {
+		v, err := msg.GetUnderlyingSecurityType()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingSecurityType = &v
+	}
+	if msg.HasUnderlyingSymbol() {
+		v, err := msg.GetUnderlyingSymbol()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingSymbol = &v
+	}
+	if msg.HasUnderlyingSymbolSfx() {
+		v, err := msg.GetUnderlyingSymbolSfx()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingSymbolSfx = &v
+	}
+	if msg.HasUnderlyingMaturityMonthYear() {
+		v, err := msg.GetUnderlyingMaturityMonthYear()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingMaturityMonthYear = &v
+	}
+	if msg.HasUnderlyingStrikePrice() {
+		v, err := msg.GetUnderlyingStrikePrice()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingStrikePrice = &v
+	}
+	if msg.HasUnderlyingOptAttribute() {
+		v, err := msg.GetUnderlyingOptAttribute()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingOptAttribute = &v
+	}
+	if msg.HasUnderlyingCurrency() {
+		v, err := msg.GetUnderlyingCurrency()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingCurrency = &v
+	}
+	if msg.HasSecurityReqID() {
+		v, err := msg.GetSecurityReqID()
+		if err != nil {
+			return s, err
+		}
+		s.SecurityReqID = v
+	}
+	if msg.HasTradingSessionID() {
+		v, err := msg.GetTradingSessionID()
+		if err != nil {
+			return s, err
+		}
+		s.TradingSessionID = &v
+	}
+	if msg.HasEncodedTextLen() {
+		v, err := msg.GetEncodedTextLen()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedTextLen = &v
+	}
+	if msg.HasEncodedText() {
+		v, err := msg.GetEncodedText()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedText = &v
+	}
+	if msg.HasEncodedUnderlyingIssuerLen() {
+		v, err := msg.GetEncodedUnderlyingIssuerLen()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedUnderlyingIssuerLen = &v
+	}
+	if msg.HasEncodedUnderlyingIssuer() {
+		v, err := msg.GetEncodedUnderlyingIssuer()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedUnderlyingIssuer = &v
+	}
+	if msg.HasEncodedUnderlyingSecurityDescLen() {
+		v, err := msg.GetEncodedUnderlyingSecurityDescLen()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedUnderlyingSecurityDescLen = &v
+	}
+	if msg.HasEncodedUnderlyingSecurityDesc() {
+		v, err := msg.GetEncodedUnderlyingSecurityDesc()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedUnderlyingSecurityDesc = &v
+	}
+	if msg.HasUnderlyingCouponRate() {
+		v, err := msg.GetUnderlyingCouponRate()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingCouponRate = &v
+	}
+	if msg.HasUnderlyingContractMultiplier() {
+		v, err := msg.GetUnderlyingContractMultiplier()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingContractMultiplier = &v
+	}
+	if msg.HasNoUnderlyingSecurityAltID() {
+		g, err := msg.GetNoUnderlyingSecurityAltID()
+		if err != nil {
+			return s, err
+		}
+		if s.NoUnderlyingSecurityAltID, err = components.MarshalNoUnderlyingSecurityAltID(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasUnderlyingProduct() {
+		v, err := msg.GetUnderlyingProduct()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingProduct = &v
+	}
+	if msg.HasUnderlyingCFICode() {
+		v, err := msg.GetUnderlyingCFICode()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingCFICode = &v
+	}
+	if msg.HasUnderlyingMaturityDate() {
+		v, err := msg.GetUnderlyingMaturityDate()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingMaturityDate = &v
+	}
+	if msg.HasSecurityListRequestType() {
+		v, err := msg.GetSecurityListRequestType()
+		if err != nil {
+			return s, err
+		}
+		s.SecurityListRequestType = v
+	}
+	if msg.HasUnderlyingCountryOfIssue() {
+		v, err := msg.GetUnderlyingCountryOfIssue()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingCountryOfIssue = &v
+	}
+	if msg.HasUnderlyingStateOrProvinceOfIssue() {
+		v, err := msg.GetUnderlyingStateOrProvinceOfIssue()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingStateOrProvinceOfIssue = &v
+	}
+	if msg.HasUnderlyingLocaleOfIssue() {
+		v, err := msg.GetUnderlyingLocaleOfIssue()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingLocaleOfIssue = &v
+	}
+	if msg.HasUnderlyingInstrRegistry() {
+		v, err := msg.GetUnderlyingInstrRegistry()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingInstrRegistry = &v
+	}
+	if msg.HasTradingSessionSubID() {
+		v, err := msg.GetTradingSessionSubID()
+		if err != nil {
+			return s, err
+		}
+		s.TradingSessionSubID = &v
+	}
+	if msg.HasSecuritySubType() {
+		v, err := msg.GetSecuritySubType()
+		if err != nil {
+			return s, err
+		}
+		s.SecuritySubType = &v
+	}
+	if msg.HasUnderlyingSecuritySubType() {
+		v, err := msg.GetUnderlyingSecuritySubType()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingSecuritySubType = &v
+	}
+	if msg.HasUnderlyingPx() {
+		v, err := msg.GetUnderlyingPx()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingPx = &v
+	}
+	if msg.HasUnderlyingCPProgram() {
+		v, err := msg.GetUnderlyingCPProgram()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingCPProgram = &v
+	}
+	if msg.HasUnderlyingCPRegType() {
+		v, err := msg.GetUnderlyingCPRegType()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingCPRegType = &v
+	}
+	if msg.HasUnderlyingQty() {
+		v, err := msg.GetUnderlyingQty()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingQty = &v
+	}
+	if msg.HasUnderlyingDirtyPrice() {
+		v, err := msg.GetUnderlyingDirtyPrice()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingDirtyPrice = &v
+	}
+	if msg.HasUnderlyingEndPrice() {
+		v, err := msg.GetUnderlyingEndPrice()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingEndPrice = &v
+	}
+	if msg.HasUnderlyingStartValue() {
+		v, err := msg.GetUnderlyingStartValue()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingStartValue = &v
+	}
+	if msg.HasUnderlyingCurrentValue() {
+		v, err := msg.GetUnderlyingCurrentValue()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingCurrentValue = &v
+	}
+	if msg.HasUnderlyingEndValue() {
+		v, err := msg.GetUnderlyingEndValue()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingEndValue = &v
+	}
+	if msg.HasNoUnderlyingStips() {
+		g, err := msg.GetNoUnderlyingStips()
+		if err != nil {
+			return s, err
+		}
+		if s.NoUnderlyingStips, err = components.MarshalNoUnderlyingStips(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasUnderlyingStrikeCurrency() {
+		v, err := msg.GetUnderlyingStrikeCurrency()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingStrikeCurrency = &v
+	}
+	return s, nil
 }
 
-//Get returns the ith NoUnderlyingStips in the NoUnderlyingStipsRepeatinGroup
-func (m NoUnderlyingStipsRepeatingGroup) Get(i int) NoUnderlyingStips {
-	return NoUnderlyingStips{m.RepeatingGroup.Get(i)}
+//Unmarshal builds a DerivativeSecurityListRequest from s
+func Unmarshal(s Struct) DerivativeSecurityListRequest {
+	m := New(field.NewSecurityReqID(s.SecurityReqID), field.NewSecurityListRequestType(s.SecurityListRequestType))
+	if s.Currency != nil {
+		m.SetCurrency(*s.Currency)
+	}
+	if s.Text != nil {
+		m.SetText(*s.Text)
+	}
+	if s.UnderlyingCouponPaymentDate != nil {
+		m.SetUnderlyingCouponPaymentDate(*s.UnderlyingCouponPaymentDate)
+	}
+	if s.UnderlyingIssueDate != nil {
+		m.SetUnderlyingIssueDate(*s.UnderlyingIssueDate)
+	}
+	if s.UnderlyingRepoCollateralSecurityType != nil {
+		m.SetUnderlyingRepoCollateralSecurityType(*s.UnderlyingRepoCollateralSecurityType)
+	}
+	if s.UnderlyingRepurchaseTerm != nil {
+		m.SetUnderlyingRepurchaseTerm(*s.UnderlyingRepurchaseTerm)
+	}
+	if s.UnderlyingRepurchaseRate != nil {
+		m.SetUnderlyingRepurchaseRate(*s.UnderlyingRepurchaseRate, fix44.DecimalScale(*s.UnderlyingRepurchaseRate))
+	}
+	if s.UnderlyingFactor != nil {
+		m.SetUnderlyingFactor(*s.UnderlyingFactor, fix44.DecimalScale(*s.UnderlyingFactor))
+	}
+	if s.UnderlyingRedemptionDate != nil {
+		m.SetUnderlyingRedemptionDate(*s.UnderlyingRedemptionDate)
+	}
+	if s.UnderlyingCreditRating != nil {
+		m.SetUnderlyingCreditRating(*s.UnderlyingCreditRating)
+	}
+	if s.SubscriptionRequestType != nil {
+		m.SetSubscriptionRequestType(*s.SubscriptionRequestType)
+	}
+	if s.UnderlyingSecurityIDSource != nil {
+		m.SetUnderlyingSecurityIDSource(*s.UnderlyingSecurityIDSource)
+	}
+	if s.UnderlyingIssuer != nil {
+		m.SetUnderlyingIssuer(*s.UnderlyingIssuer)
+	}
+	if s.UnderlyingSecurityDesc != nil {
+		m.SetUnderlyingSecurityDesc(*s.UnderlyingSecurityDesc)
+	}
+	if s.UnderlyingSecurityExchange != nil {
+		m.SetUnderlyingSecurityExchange(*s.UnderlyingSecurityExchange)
+	}
+	if s.UnderlyingSecurityID != nil {
+		m.SetUnderlyingSecurityID(*s.UnderlyingSecurityID)
+	}
+	if s.UnderlyingSecurityType != nil {
+		m.SetUnderlyingSecurityType(*s.UnderlyingSecurityType)
+	}
+	if s.UnderlyingSymbol != nil {
+		m.SetUnderlyingSymbol(*s.UnderlyingSymbol)
+	}
+	if s.UnderlyingSymbolSfx != nil {
+		m.SetUnderlyingSymbolSfx(*s.UnderlyingSymbolSfx)
+	}
+	if s.UnderlyingMaturityMonthYear != nil {
+		m.SetUnderlyingMaturityMonthYear(*s.UnderlyingMaturityMonthYear)
+	}
+	if s.UnderlyingStrikePrice != nil {
+		m.SetUnderlyingStrikePrice(*s.UnderlyingStrikePrice, fix44.DecimalScale(*s.UnderlyingStrikePrice))
+	}
+	if s.UnderlyingOptAttribute != nil {
+		m.SetUnderlyingOptAttribute(*s.UnderlyingOptAttribute)
+	}
+	if s.UnderlyingCurrency != nil {
+		m.SetUnderlyingCurrency(*s.UnderlyingCurrency)
+	}
+	if s.TradingSessionID != nil {
+		m.SetTradingSessionID(*s.TradingSessionID)
+	}
+	if s.EncodedTextLen != nil {
+		m.SetEncodedTextLen(*s.EncodedTextLen)
+	}
+	if s.EncodedText != nil {
+		m.SetEncodedText(*s.EncodedText)
+	}
+	if s.EncodedUnderlyingIssuerLen != nil {
+		m.SetEncodedUnderlyingIssuerLen(*s.EncodedUnderlyingIssuerLen)
+	}
+	if s.EncodedUnderlyingIssuer != nil {
+		m.SetEncodedUnderlyingIssuer(*s.EncodedUnderlyingIssuer)
+	}
+	if s.EncodedUnderlyingSecurityDescLen != nil {
+		m.SetEncodedUnderlyingSecurityDescLen(*s.EncodedUnderlyingSecurityDescLen)
+	}
+	if s.EncodedUnderlyingSecurityDesc != nil {
+		m.SetEncodedUnderlyingSecurityDesc(*s.EncodedUnderlyingSecurityDesc)
+	}
+	if s.UnderlyingCouponRate != nil {
+		m.SetUnderlyingCouponRate(*s.UnderlyingCouponRate, fix44.DecimalScale(*s.UnderlyingCouponRate))
+	}
+	if s.UnderlyingContractMultiplier != nil {
+		m.SetUnderlyingContractMultiplier(*s.UnderlyingContractMultiplier, fix44.DecimalScale(*s.UnderlyingContractMultiplier))
+	}
+	if s.NoUnderlyingSecurityAltID != nil {
+		m.SetNoUnderlyingSecurityAltID(components.UnmarshalNoUnderlyingSecurityAltID(s.NoUnderlyingSecurityAltID))
+	}
+	if s.UnderlyingProduct != nil {
+		m.SetUnderlyingProduct(*s.UnderlyingProduct)
+	}
+	if s.UnderlyingCFICode != nil {
+		m.SetUnderlyingCFICode(*s.UnderlyingCFICode)
+	}
+	if s.UnderlyingMaturityDate != nil {
+		m.SetUnderlyingMaturityDate(*s.UnderlyingMaturityDate)
+	}
+	if s.UnderlyingCountryOfIssue != nil {
+		m.SetUnderlyingCountryOfIssue(*s.UnderlyingCountryOfIssue)
+	}
+	if s.UnderlyingStateOrProvinceOfIssue != nil {
+		m.SetUnderlyingStateOrProvinceOfIssue(*s.UnderlyingStateOrProvinceOfIssue)
+	}
+	if s.UnderlyingLocaleOfIssue != nil {
+		m.SetUnderlyingLocaleOfIssue(*s.UnderlyingLocaleOfIssue)
+	}
+	if s.UnderlyingInstrRegistry != nil {
+		m.SetUnderlyingInstrRegistry(*s.UnderlyingInstrRegistry)
+	}
+	if s.TradingSessionSubID != nil {
+		m.SetTradingSessionSubID(*s.TradingSessionSubID)
+	}
+	if s.SecuritySubType != nil {
+		m.SetSecuritySubType(*s.SecuritySubType)
+	}
+	if s.UnderlyingSecuritySubType != nil {
+		m.SetUnderlyingSecuritySubType(*s.UnderlyingSecuritySubType)
+	}
+	if s.UnderlyingPx != nil {
+		m.SetUnderlyingPx(*s.UnderlyingPx, fix44.DecimalScale(*s.UnderlyingPx))
+	}
+	if s.UnderlyingCPProgram != nil {
+		m.SetUnderlyingCPProgram(*s.UnderlyingCPProgram)
+	}
+	if s.UnderlyingCPRegType != nil {
+		m.SetUnderlyingCPRegType(*s.UnderlyingCPRegType)
+	}
+	if s.UnderlyingQty != nil {
+		m.SetUnderlyingQty(*s.UnderlyingQty, fix44.DecimalScale(*s.UnderlyingQty))
+	}
+	if s.UnderlyingDirtyPrice != nil {
+		m.SetUnderlyingDirtyPrice(*s.UnderlyingDirtyPrice, fix44.DecimalScale(*s.UnderlyingDirtyPrice))
+	}
+	if s.UnderlyingEndPrice != nil {
+		m.SetUnderlyingEndPrice(*s.UnderlyingEndPrice, fix44.DecimalScale(*s.UnderlyingEndPrice))
+	}
+	if s.UnderlyingStartValue != nil {
+		m.SetUnderlyingStartValue(*s.UnderlyingStartValue, fix44.DecimalScale(*s.UnderlyingStartValue))
+	}
+	if s.UnderlyingCurrentValue != nil {
+		m.SetUnderlyingCurrentValue(*s.UnderlyingCurrentValue, fix44.DecimalScale(*s.UnderlyingCurrentValue))
+	}
+	if s.UnderlyingEndValue != nil {
+		m.SetUnderlyingEndValue(*s.UnderlyingEndValue, fix44.DecimalScale(*s.UnderlyingEndValue))
+	}
+	if s.NoUnderlyingStips != nil {
+		m.SetNoUnderlyingStips(components.UnmarshalNoUnderlyingStips(s.NoUnderlyingStips))
+	}
+	if s.UnderlyingStrikeCurrency != nil {
+		m.SetUnderlyingStrikeCurrency(*s.UnderlyingStrikeCurrency)
+	}
+	return m
 }
@@ -0,0 +1,763 @@
+package dispatch
+
+import (
+	"github.com/terracefi/fix44/advertisement"
+	"github.com/terracefi/fix44/allocationinstruction"
+	"github.com/terracefi/fix44/allocationinstructionack"
+	"github.com/terracefi/fix44/allocationreport"
+	"github.com/terracefi/fix44/allocationreportack"
+	"github.com/terracefi/fix44/assignmentreport"
+	"github.com/terracefi/fix44/bidrequest"
+	"github.com/terracefi/fix44/bidresponse"
+	"github.com/terracefi/fix44/businessmessagereject"
+	"github.com/terracefi/fix44/collateralassignment"
+	"github.com/terracefi/fix44/collateralinquiry"
+	"github.com/terracefi/fix44/collateralinquiryack"
+	"github.com/terracefi/fix44/collateralreport"
+	"github.com/terracefi/fix44/collateralrequest"
+	"github.com/terracefi/fix44/collateralresponse"
+	"github.com/terracefi/fix44/confirmation"
+	"github.com/terracefi/fix44/confirmationack"
+	"github.com/terracefi/fix44/confirmationrequest"
+	"github.com/terracefi/fix44/crossordercancelreplacerequest"
+	"github.com/terracefi/fix44/crossordercancelrequest"
+	"github.com/terracefi/fix44/derivativesecuritylist"
+	"github.com/terracefi/fix44/derivativesecuritylistrequest"
+	"github.com/terracefi/fix44/dontknowtrade"
+	"github.com/terracefi/fix44/email"
+	"github.com/terracefi/fix44/executionreport"
+	"github.com/terracefi/fix44/heartbeat"
+	"github.com/terracefi/fix44/ioi"
+	"github.com/terracefi/fix44/listcancelrequest"
+	"github.com/terracefi/fix44/listexecute"
+	"github.com/terracefi/fix44/liststatus"
+	"github.com/terracefi/fix44/liststatusrequest"
+	"github.com/terracefi/fix44/liststrikeprice"
+	"github.com/terracefi/fix44/logon"
+	"github.com/terracefi/fix44/logout"
+	"github.com/terracefi/fix44/marketdataincrementalrefresh"
+	"github.com/terracefi/fix44/marketdatarequest"
+	"github.com/terracefi/fix44/marketdatarequestreject"
+	"github.com/terracefi/fix44/marketdatasnapshotfullrefresh"
+	"github.com/terracefi/fix44/massquote"
+	"github.com/terracefi/fix44/massquoteacknowledgement"
+	"github.com/terracefi/fix44/multilegordercancelreplace"
+	"github.com/terracefi/fix44/networkcounterpartysystemstatusrequest"
+	"github.com/terracefi/fix44/networkcounterpartysystemstatusresponse"
+	"github.com/terracefi/fix44/newordercross"
+	"github.com/terracefi/fix44/neworderlist"
+	"github.com/terracefi/fix44/newordermultileg"
+	"github.com/terracefi/fix44/newordersingle"
+	"github.com/terracefi/fix44/news"
+	"github.com/terracefi/fix44/ordercancelreject"
+	"github.com/terracefi/fix44/ordercancelreplacerequest"
+	"github.com/terracefi/fix44/ordercancelrequest"
+	"github.com/terracefi/fix44/ordermasscancelreport"
+	"github.com/terracefi/fix44/ordermasscancelrequest"
+	"github.com/terracefi/fix44/ordermassstatusrequest"
+	"github.com/terracefi/fix44/orderstatusrequest"
+	"github.com/terracefi/fix44/positionmaintenancereport"
+	"github.com/terracefi/fix44/positionmaintenancerequest"
+	"github.com/terracefi/fix44/positionreport"
+	"github.com/terracefi/fix44/quote"
+	"github.com/terracefi/fix44/quotecancel"
+	"github.com/terracefi/fix44/quoterequest"
+	"github.com/terracefi/fix44/quoterequestreject"
+	"github.com/terracefi/fix44/quoteresponse"
+	"github.com/terracefi/fix44/quotestatusreport"
+	"github.com/terracefi/fix44/quotestatusrequest"
+	"github.com/terracefi/fix44/registrationinstructions"
+	"github.com/terracefi/fix44/registrationinstructionsresponse"
+	"github.com/terracefi/fix44/reject"
+	"github.com/terracefi/fix44/requestforpositions"
+	"github.com/terracefi/fix44/requestforpositionsack"
+	"github.com/terracefi/fix44/resendrequest"
+	"github.com/terracefi/fix44/rfqrequest"
+	"github.com/terracefi/fix44/securitydefinition"
+	"github.com/terracefi/fix44/securitydefinitionrequest"
+	"github.com/terracefi/fix44/securitylist"
+	"github.com/terracefi/fix44/securitylistrequest"
+	"github.com/terracefi/fix44/securitystatus"
+	"github.com/terracefi/fix44/securitystatusrequest"
+	"github.com/terracefi/fix44/securitytyperequest"
+	"github.com/terracefi/fix44/securitytypes"
+	"github.com/terracefi/fix44/sequencereset"
+	"github.com/terracefi/fix44/settlementinstructionrequest"
+	"github.com/terracefi/fix44/settlementinstructions"
+	"github.com/terracefi/fix44/testrequest"
+	"github.com/terracefi/fix44/tradecapturereport"
+	"github.com/terracefi/fix44/tradecapturereportack"
+	"github.com/terracefi/fix44/tradecapturereportrequest"
+	"github.com/terracefi/fix44/tradecapturereportrequestack"
+	"github.com/terracefi/fix44/tradingsessionstatus"
+	"github.com/terracefi/fix44/tradingsessionstatusrequest"
+	"github.com/terracefi/fix44/userrequest"
+	"github.com/terracefi/fix44/userresponse"
+
+	"github.com/terracefi/quickfix"
+)
+
+//Dispatcher routes inbound fix44 messages to the typed callback registered for their MsgType. Messages without a
+//callback are passed to Default.
+type Dispatcher struct {
+	//OnAdvertisement handles Advertisement, MsgType = 7
+	OnAdvertisement advertisement.RouteOut
+	//OnAllocationInstruction handles AllocationInstruction, MsgType = J
+	OnAllocationInstruction allocationinstruction.RouteOut
+	//OnAllocationInstructionAck handles AllocationInstructionAck, MsgType = P
+	OnAllocationInstructionAck allocationinstructionack.RouteOut
+	//OnAllocationReport handles AllocationReport, MsgType = AS
+	OnAllocationReport allocationreport.RouteOut
+	//OnAllocationReportAck handles AllocationReportAck, MsgType = AT
+	OnAllocationReportAck allocationreportack.RouteOut
+	//OnAssignmentReport handles AssignmentReport, MsgType = AW
+	OnAssignmentReport assignmentreport.RouteOut
+	//OnBidRequest handles BidRequest, MsgType = k
+	OnBidRequest bidrequest.RouteOut
+	//OnBidResponse handles BidResponse, MsgType = l
+	OnBidResponse bidresponse.RouteOut
+	//OnBusinessMessageReject handles BusinessMessageReject, MsgType = j
+	OnBusinessMessageReject businessmessagereject.RouteOut
+	//OnCollateralAssignment handles CollateralAssignment, MsgType = AY
+	OnCollateralAssignment collateralassignment.RouteOut
+	//OnCollateralInquiry handles CollateralInquiry, MsgType = BB
+	OnCollateralInquiry collateralinquiry.RouteOut
+	//OnCollateralInquiryAck handles CollateralInquiryAck, MsgType = BG
+	OnCollateralInquiryAck collateralinquiryack.RouteOut
+	//OnCollateralReport handles CollateralReport, MsgType = BA
+	OnCollateralReport collateralreport.RouteOut
+	//OnCollateralRequest handles CollateralRequest, MsgType = AX
+	OnCollateralRequest collateralrequest.RouteOut
+	//OnCollateralResponse handles CollateralResponse, MsgType = AZ
+	OnCollateralResponse collateralresponse.RouteOut
+	//OnConfirmation handles Confirmation, MsgType = AK
+	OnConfirmation confirmation.RouteOut
+	//OnConfirmationAck handles ConfirmationAck, MsgType = AU
+	OnConfirmationAck confirmationack.RouteOut
+	//OnConfirmationRequest handles ConfirmationRequest, MsgType = BH
+	OnConfirmationRequest confirmationrequest.RouteOut
+	//OnCrossOrderCancelReplaceRequest handles CrossOrderCancelReplaceRequest, MsgType = t
+	OnCrossOrderCancelReplaceRequest crossordercancelreplacerequest.RouteOut
+	//OnCrossOrderCancelRequest handles CrossOrderCancelRequest, MsgType = u
+	OnCrossOrderCancelRequest crossordercancelrequest.RouteOut
+	//OnDerivativeSecurityList handles DerivativeSecurityList, MsgType = AA
+	OnDerivativeSecurityList derivativesecuritylist.RouteOut
+	//OnDerivativeSecurityListRequest handles DerivativeSecurityListRequest, MsgType = z
+	OnDerivativeSecurityListRequest derivativesecuritylistrequest.RouteOut
+	//OnDontKnowTrade handles DontKnowTrade, MsgType = Q
+	OnDontKnowTrade dontknowtrade.RouteOut
+	//OnEmail handles Email, MsgType = C
+	OnEmail email.RouteOut
+	//OnExecutionReport handles ExecutionReport, MsgType = 8
+	OnExecutionReport executionreport.RouteOut
+	//OnHeartbeat handles Heartbeat, MsgType = 0
+	OnHeartbeat heartbeat.RouteOut
+	//OnIOI handles IOI, MsgType = 6
+	OnIOI ioi.RouteOut
+	//OnListCancelRequest handles ListCancelRequest, MsgType = K
+	OnListCancelRequest listcancelrequest.RouteOut
+	//OnListExecute handles ListExecute, MsgType = L
+	OnListExecute listexecute.RouteOut
+	//OnListStatus handles ListStatus, MsgType = N
+	OnListStatus liststatus.RouteOut
+	//OnListStatusRequest handles ListStatusRequest, MsgType = M
+	OnListStatusRequest liststatusrequest.RouteOut
+	//OnListStrikePrice handles ListStrikePrice, MsgType = m
+	OnListStrikePrice liststrikeprice.RouteOut
+	//OnLogon handles Logon, MsgType = A
+	OnLogon logon.RouteOut
+	//OnLogout handles Logout, MsgType = 5
+	OnLogout logout.RouteOut
+	//OnMarketDataIncrementalRefresh handles MarketDataIncrementalRefresh, MsgType = X
+	OnMarketDataIncrementalRefresh marketdataincrementalrefresh.RouteOut
+	//OnMarketDataRequest handles MarketDataRequest, MsgType = V
+	OnMarketDataRequest marketdatarequest.RouteOut
+	//OnMarketDataRequestReject handles MarketDataRequestReject, MsgType = Y
+	OnMarketDataRequestReject marketdatarequestreject.RouteOut
+	//OnMarketDataSnapshotFullRefresh handles MarketDataSnapshotFullRefresh, MsgType = W
+	OnMarketDataSnapshotFullRefresh marketdatasnapshotfullrefresh.RouteOut
+	//OnMassQuote handles MassQuote, MsgType = i
+	OnMassQuote massquote.RouteOut
+	//OnMassQuoteAcknowledgement handles MassQuoteAcknowledgement, MsgType = b
+	OnMassQuoteAcknowledgement massquoteacknowledgement.RouteOut
+	//OnMultilegOrderCancelReplace handles MultilegOrderCancelReplace, MsgType = AC
+	OnMultilegOrderCancelReplace multilegordercancelreplace.RouteOut
+	//OnNetworkCounterpartySystemStatusRequest handles NetworkCounterpartySystemStatusRequest, MsgType = BC
+	OnNetworkCounterpartySystemStatusRequest networkcounterpartysystemstatusrequest.RouteOut
+	//OnNetworkCounterpartySystemStatusResponse handles NetworkCounterpartySystemStatusResponse, MsgType = BD
+	OnNetworkCounterpartySystemStatusResponse networkcounterpartysystemstatusresponse.RouteOut
+	//OnNewOrderCross handles NewOrderCross, MsgType = s
+	OnNewOrderCross newordercross.RouteOut
+	//OnNewOrderList handles NewOrderList, MsgType = E
+	OnNewOrderList neworderlist.RouteOut
+	//OnNewOrderMultileg handles NewOrderMultileg, MsgType = AB
+	OnNewOrderMultileg newordermultileg.RouteOut
+	//OnNewOrderSingle handles NewOrderSingle, MsgType = D
+	OnNewOrderSingle newordersingle.RouteOut
+	//OnNews handles News, MsgType = B
+	OnNews news.RouteOut
+	//OnOrderCancelReject handles OrderCancelReject, MsgType = 9
+	OnOrderCancelReject ordercancelreject.RouteOut
+	//OnOrderCancelReplaceRequest handles OrderCancelReplaceRequest, MsgType = G
+	OnOrderCancelReplaceRequest ordercancelreplacerequest.RouteOut
+	//OnOrderCancelRequest handles OrderCancelRequest, MsgType = F
+	OnOrderCancelRequest ordercancelrequest.RouteOut
+	//OnOrderMassCancelReport handles OrderMassCancelReport, MsgType = r
+	OnOrderMassCancelReport ordermasscancelreport.RouteOut
+	//OnOrderMassCancelRequest handles OrderMassCancelRequest, MsgType = q
+	OnOrderMassCancelRequest ordermasscancelrequest.RouteOut
+	//OnOrderMassStatusRequest handles OrderMassStatusRequest, MsgType = AF
+	OnOrderMassStatusRequest ordermassstatusrequest.RouteOut
+	//OnOrderStatusRequest handles OrderStatusRequest, MsgType = H
+	OnOrderStatusRequest orderstatusrequest.RouteOut
+	//OnPositionMaintenanceReport handles PositionMaintenanceReport, MsgType = AM
+	OnPositionMaintenanceReport positionmaintenancereport.RouteOut
+	//OnPositionMaintenanceRequest handles PositionMaintenanceRequest, MsgType = AL
+	OnPositionMaintenanceRequest positionmaintenancerequest.RouteOut
+	//OnPositionReport handles PositionReport, MsgType = AP
+	OnPositionReport positionreport.RouteOut
+	//OnQuote handles Quote, MsgType = S
+	OnQuote quote.RouteOut
+	//OnQuoteCancel handles QuoteCancel, MsgType = Z
+	OnQuoteCancel quotecancel.RouteOut
+	//OnQuoteRequest handles QuoteRequest, MsgType = R
+	OnQuoteRequest quoterequest.RouteOut
+	//OnQuoteRequestReject handles QuoteRequestReject, MsgType = AG
+	OnQuoteRequestReject quoterequestreject.RouteOut
+	//OnQuoteResponse handles QuoteResponse, MsgType = AJ
+	OnQuoteResponse quoteresponse.RouteOut
+	//OnQuoteStatusReport handles QuoteStatusReport, MsgType = AI
+	OnQuoteStatusReport quotestatusreport.RouteOut
+	//OnQuoteStatusRequest handles QuoteStatusRequest, MsgType = a
+	OnQuoteStatusRequest quotestatusrequest.RouteOut
+	//OnRFQRequest handles RFQRequest, MsgType = AH
+	OnRFQRequest rfqrequest.RouteOut
+	//OnRegistrationInstructions handles RegistrationInstructions, MsgType = o
+	OnRegistrationInstructions registrationinstructions.RouteOut
+	//OnRegistrationInstructionsResponse handles RegistrationInstructionsResponse, MsgType = p
+	OnRegistrationInstructionsResponse registrationinstructionsresponse.RouteOut
+	//OnReject handles Reject, MsgType = 3
+	OnReject reject.RouteOut
+	//OnRequestForPositions handles RequestForPositions, MsgType = AN
+	OnRequestForPositions requestforpositions.RouteOut
+	//OnRequestForPositionsAck handles RequestForPositionsAck, MsgType = AO
+	OnRequestForPositionsAck requestforpositionsack.RouteOut
+	//OnResendRequest handles ResendRequest, MsgType = 2
+	OnResendRequest resendrequest.RouteOut
+	//OnSecurityDefinition handles SecurityDefinition, MsgType = d
+	OnSecurityDefinition securitydefinition.RouteOut
+	//OnSecurityDefinitionRequest handles SecurityDefinitionRequest, MsgType = c
+	OnSecurityDefinitionRequest securitydefinitionrequest.RouteOut
+	//OnSecurityList handles SecurityList, MsgType = y
+	OnSecurityList securitylist.RouteOut
+	//OnSecurityListRequest handles SecurityListRequest, MsgType = x
+	OnSecurityListRequest securitylistrequest.RouteOut
+	//OnSecurityStatus handles SecurityStatus, MsgType = f
+	OnSecurityStatus securitystatus.RouteOut
+	//OnSecurityStatusRequest handles SecurityStatusRequest, MsgType = e
+	OnSecurityStatusRequest securitystatusrequest.RouteOut
+	//OnSecurityTypeRequest handles SecurityTypeRequest, MsgType = v
+	OnSecurityTypeRequest securitytyperequest.RouteOut
+	//OnSecurityTypes handles SecurityTypes, MsgType = w
+	OnSecurityTypes securitytypes.RouteOut
+	//OnSequenceReset handles SequenceReset, MsgType = 4
+	OnSequenceReset sequencereset.RouteOut
+	//OnSettlementInstructionRequest handles SettlementInstructionRequest, MsgType = AV
+	OnSettlementInstructionRequest settlementinstructionrequest.RouteOut
+	//OnSettlementInstructions handles SettlementInstructions, MsgType = T
+	OnSettlementInstructions settlementinstructions.RouteOut
+	//OnTestRequest handles TestRequest, MsgType = 1
+	OnTestRequest testrequest.RouteOut
+	//OnTradeCaptureReport handles TradeCaptureReport, MsgType = AE
+	OnTradeCaptureReport tradecapturereport.RouteOut
+	//OnTradeCaptureReportAck handles TradeCaptureReportAck, MsgType = AR
+	OnTradeCaptureReportAck tradecapturereportack.RouteOut
+	//OnTradeCaptureReportRequest handles TradeCaptureReportRequest, MsgType = AD
+	OnTradeCaptureReportRequest tradecapturereportrequest.RouteOut
+	//OnTradeCaptureReportRequestAck handles TradeCaptureReportRequestAck, MsgType = AQ
+	OnTradeCaptureReportRequestAck tradecapturereportrequestack.RouteOut
+	//OnTradingSessionStatus handles TradingSessionStatus, MsgType = h
+	OnTradingSessionStatus tradingsessionstatus.RouteOut
+	//OnTradingSessionStatusRequest handles TradingSessionStatusRequest, MsgType = g
+	OnTradingSessionStatusRequest tradingsessionstatusrequest.RouteOut
+	//OnUserRequest handles UserRequest, MsgType = BE
+	OnUserRequest userrequest.RouteOut
+	//OnUserResponse handles UserResponse, MsgType = BF
+	OnUserResponse userresponse.RouteOut
+
+	//Default handles the application messages that have no callback set, a nil Default rejects them with
+	//RejectUnsupported
+	Default quickfix.MessageRoute
+
+	middleware []Middleware
+}
+
+//msgTypes lists the MsgType of every callback slot
+var msgTypes = []string{"7", "J", "P", "AS", "AT", "AW", "k", "l", "j", "AY", "BB", "BG", "BA", "AX", "AZ", "AK", "AU", "BH", "t", "u", "AA", "z", "Q", "C", "8", "0", "6", "K", "L", "N", "M", "m", "A", "5", "X", "V", "Y", "W", "i", "b", "AC", "BC", "BD", "s", "E", "AB", "D", "B", "9", "G", "F", "r", "q", "AF", "H", "AM", "AL", "AP", "S", "Z", "R", "AG", "AJ", "AI", "a", "AH", "o", "p", "3", "AN", "AO", "2", "d", "c", "y", "x", "f", "e", "v", "w", "4", "AV", "T", "1", "AE", "AR", "AD", "AQ", "h", "g", "BE", "BF"}
+
+//route returns the MessageRoute for the callback registered for msgType, or nil if there is none
+func (d *Dispatcher) route(msgType string) quickfix.MessageRoute {
+	switch msgType {
+	case "7":
+		if d.OnAdvertisement != nil {
+			_, _, r := advertisement.Route(d.OnAdvertisement)
+			return r
+		}
+	case "J":
+		if d.OnAllocationInstruction != nil {
+			_, _, r := allocationinstruction.Route(d.OnAllocationInstruction)
+			return r
+		}
+	case "P":
+		if d.OnAllocationInstructionAck != nil {
+			_, _, r := allocationinstructionack.Route(d.OnAllocationInstructionAck)
+			return r
+		}
+	case "AS":
+		if d.OnAllocationReport != nil {
+			_, _, r := allocationreport.Route(d.OnAllocationReport)
+			return r
+		}
+	case "AT":
+		if d.OnAllocationReportAck != nil {
+			_, _, r := allocationreportack.Route(d.OnAllocationReportAck)
+			return r
+		}
+	case "AW":
+		if d.OnAssignmentReport != nil {
+			_, _, r := assignmentreport.Route(d.OnAssignmentReport)
+			return r
+		}
+	case "k":
+		if d.OnBidRequest != nil {
+			_, _, r := bidrequest.Route(d.OnBidRequest)
+			return r
+		}
+	case "l":
+		if d.OnBidResponse != nil {
+			_, _, r := bidresponse.Route(d.OnBidResponse)
+			return r
+		}
+	case "j":
+		if d.OnBusinessMessageReject != nil {
+			_, _, r := businessmessagereject.Route(d.OnBusinessMessageReject)
+			return r
+		}
+	case "AY":
+		if d.OnCollateralAssignment != nil {
+			_, _, r := collateralassignment.Route(d.OnCollateralAssignment)
+			return r
+		}
+	case "BB":
+		if d.OnCollateralInquiry != nil {
+			_, _, r := collateralinquiry.Route(d.OnCollateralInquiry)
+			return r
+		}
+	case "BG":
+		if d.OnCollateralInquiryAck != nil {
+			_, _, r := collateralinquiryack.Route(d.OnCollateralInquiryAck)
+			return r
+		}
+	case "BA":
+		if d.OnCollateralReport != nil {
+			_, _, r := collateralreport.Route(d.OnCollateralReport)
+			return r
+		}
+	case "AX":
+		if d.OnCollateralRequest != nil {
+			_, _, r := collateralrequest.Route(d.OnCollateralRequest)
+			return r
+		}
+	case "AZ":
+		if d.OnCollateralResponse != nil {
+			_, _, r := collateralresponse.Route(d.OnCollateralResponse)
+			return r
+		}
+	case "AK":
+		if d.OnConfirmation != nil {
+			_, _, r := confirmation.Route(d.OnConfirmation)
+			return r
+		}
+	case "AU":
+		if d.OnConfirmationAck != nil {
+			_, _, r := confirmationack.Route(d.OnConfirmationAck)
+			return r
+		}
+	case "BH":
+		if d.OnConfirmationRequest != nil {
+			_, _, r := confirmationrequest.Route(d.OnConfirmationRequest)
+			return r
+		}
+	case "t":
+		if d.OnCrossOrderCancelReplaceRequest != nil {
+			_, _, r := crossordercancelreplacerequest.Route(d.OnCrossOrderCancelReplaceRequest)
+			return r
+		}
+	case "u":
+		if d.OnCrossOrderCancelRequest != nil {
+			_, _, r := crossordercancelrequest.Route(d.OnCrossOrderCancelRequest)
+			return r
+		}
+	case "AA":
+		if d.OnDerivativeSecurityList != nil {
+			_, _, r := derivativesecuritylist.Route(d.OnDerivativeSecurityList)
+			return r
+		}
+	case "z":
+		if d.OnDerivativeSecurityListRequest != nil {
+			_, _, r := derivativesecuritylistrequest.Route(d.OnDerivativeSecurityListRequest)
+			return r
+		}
+	case "Q":
+		if d.OnDontKnowTrade != nil {
+			_, _, r := dontknowtrade.Route(d.OnDontKnowTrade)
+			return r
+		}
+	case "C":
+		if d.OnEmail != nil {
+			_, _, r := email.Route(d.OnEmail)
+			return r
+		}
+	case "8":
+		if d.OnExecutionReport != nil {
+			_, _, r := executionreport.Route(d.OnExecutionReport)
+			return r
+		}
+	case "0":
+		if d.OnHeartbeat != nil {
+			_, _, r := heartbeat.Route(d.OnHeartbeat)
+			return r
+		}
+	case "6":
+		if d.OnIOI != nil {
+			_, _, r := ioi.Route(d.OnIOI)
+			return r
+		}
+	case "K":
+		if d.OnListCancelRequest != nil {
+			_, _, r := listcancelrequest.Route(d.OnListCancelRequest)
+			return r
+		}
+	case "L":
+		if d.OnListExecute != nil {
+			_, _, r := listexecute.Route(d.OnListExecute)
+			return r
+		}
+	case "N":
+		if d.OnListStatus != nil {
+			_, _, r := liststatus.Route(d.OnListStatus)
+			return r
+		}
+	case "M":
+		if d.OnListStatusRequest != nil {
+			_, _, r := liststatusrequest.Route(d.OnListStatusRequest)
+			return r
+		}
+	case "m":
+		if d.OnListStrikePrice != nil {
+			_, _, r := liststrikeprice.Route(d.OnListStrikePrice)
+			return r
+		}
+	case "A":
+		if d.OnLogon != nil {
+			_, _, r := logon.Route(d.OnLogon)
+			return r
+		}
+	case "5":
+		if d.OnLogout != nil {
+			_, _, r := logout.Route(d.OnLogout)
+			return r
+		}
+	case "X":
+		if d.OnMarketDataIncrementalRefresh != nil {
+			_, _, r := marketdataincrementalrefresh.Route(d.OnMarketDataIncrementalRefresh)
+			return r
+		}
+	case "V":
+		if d.OnMarketDataRequest != nil {
+			_, _, r := marketdatarequest.Route(d.OnMarketDataRequest)
+			return r
+		}
+	case "Y":
+		if d.OnMarketDataRequestReject != nil {
+			_, _, r := marketdatarequestreject.Route(d.OnMarketDataRequestReject)
+			return r
+		}
+	case "W":
+		if d.OnMarketDataSnapshotFullRefresh != nil {
+			_, _, r := marketdatasnapshotfullrefresh.Route(d.OnMarketDataSnapshotFullRefresh)
+			return r
+		}
+	case "i":
+		if d.OnMassQuote != nil {
+			_, _, r := massquote.Route(d.OnMassQuote)
+			return r
+		}
+	case "b":
+		if d.OnMassQuoteAcknowledgement != nil {
+			_, _, r := massquoteacknowledgement.Route(d.OnMassQuoteAcknowledgement)
+			return r
+		}
+	case "AC":
+		if d.OnMultilegOrderCancelReplace != nil {
+			_, _, r := multilegordercancelreplace.Route(d.OnMultilegOrderCancelReplace)
+			return r
+		}
+	case "BC":
+		if d.OnNetworkCounterpartySystemStatusRequest != nil {
+			_, _, r := networkcounterpartysystemstatusrequest.Route(d.OnNetworkCounterpartySystemStatusRequest)
+			return r
+		}
+	case "BD":
+		if d.OnNetworkCounterpartySystemStatusResponse != nil {
+			_, _, r := networkcounterpartysystemstatusresponse.Route(d.OnNetworkCounterpartySystemStatusResponse)
+			return r
+		}
+	case "s":
+		if d.OnNewOrderCross != nil {
+			_, _, r := newordercross.Route(d.OnNewOrderCross)
+			return r
+		}
+	case "E":
+		if d.OnNewOrderList != nil {
+			_, _, r := neworderlist.Route(d.OnNewOrderList)
+			return r
+		}
+	case "AB":
+		if d.OnNewOrderMultileg != nil {
+			_, _, r := newordermultileg.Route(d.OnNewOrderMultileg)
+			return r
+		}
+	case "D":
+		if d.OnNewOrderSingle != nil {
+			_, _, r := newordersingle.Route(d.OnNewOrderSingle)
+			return r
+		}
+	case "B":
+		if d.OnNews != nil {
+			_, _, r := news.Route(d.OnNews)
+			return r
+		}
+	case "9":
+		if d.OnOrderCancelReject != nil {
+			_, _, r := ordercancelreject.Route(d.OnOrderCancelReject)
+			return r
+		}
+	case "G":
+		if d.OnOrderCancelReplaceRequest != nil {
+			_, _, r := ordercancelreplacerequest.Route(d.OnOrderCancelReplaceRequest)
+			return r
+		}
+	case "F":
+		if d.OnOrderCancelRequest != nil {
+			_, _, r := ordercancelrequest.Route(d.OnOrderCancelRequest)
+			return r
+		}
+	case "r":
+		if d.OnOrderMassCancelReport != nil {
+			_, _, r := ordermasscancelreport.Route(d.OnOrderMassCancelReport)
+			return r
+		}
+	case "q":
+		if d.OnOrderMassCancelRequest != nil {
+			_, _, r := ordermasscancelrequest.Route(d.OnOrderMassCancelRequest)
+			return r
+		}
+	case "AF":
+		if d.OnOrderMassStatusRequest != nil {
+			_, _, r := ordermassstatusrequest.Route(d.OnOrderMassStatusRequest)
+			return r
+		}
+	case "H":
+		if d.OnOrderStatusRequest != nil {
+			_, _, r := orderstatusrequest.Route(d.OnOrderStatusRequest)
+			return r
+		}
+	case "AM":
+		if d.OnPositionMaintenanceReport != nil {
+			_, _, r := positionmaintenancereport.Route(d.OnPositionMaintenanceReport)
+			return r
+		}
+	case "AL":
+		if d.OnPositionMaintenanceRequest != nil {
+			_, _, r := positionmaintenancerequest.Route(d.OnPositionMaintenanceRequest)
+			return r
+		}
+	case "AP":
+		if d.OnPositionReport != nil {
+			_, _, r := positionreport.Route(d.OnPositionReport)
+			return r
+		}
+	case "S":
+		if d.OnQuote != nil {
+			_, _, r := quote.Route(d.OnQuote)
+			return r
+		}
+	case "Z":
+		if d.OnQuoteCancel != nil {
+			_, _, r := quotecancel.Route(d.OnQuoteCancel)
+			return r
+		}
+	case "R":
+		if d.OnQuoteRequest != nil {
+			_, _, r := quoterequest.Route(d.OnQuoteRequest)
+			return r
+		}
+	case "AG":
+		if d.OnQuoteRequestReject != nil {
+			_, _, r := quoterequestreject.Route(d.OnQuoteRequestReject)
+			return r
+		}
+	case "AJ":
+		if d.OnQuoteResponse != nil {
+			_, _, r := quoteresponse.Route(d.OnQuoteResponse)
+			return r
+		}
+	case "AI":
+		if d.OnQuoteStatusReport != nil {
+			_, _, r := quotestatusreport.Route(d.OnQuoteStatusReport)
+			return r
+		}
+	case "a":
+		if d.OnQuoteStatusRequest != nil {
+			_, _, r := quotestatusrequest.Route(d.OnQuoteStatusRequest)
+			return r
+		}
+	case "AH":
+		if d.OnRFQRequest != nil {
+			_, _, r := rfqrequest.Route(d.OnRFQRequest)
+			return r
+		}
+	case "o":
+		if d.OnRegistrationInstructions != nil {
+			_, _, r := registrationinstructions.Route(d.OnRegistrationInstructions)
+			return r
+		}
+	case "p":
+		if d.OnRegistrationInstructionsResponse != nil {
+			_, _, r := registrationinstructionsresponse.Route(d.OnRegistrationInstructionsResponse)
+			return r
+		}
+	case "3":
+		if d.OnReject != nil {
+			_, _, r := reject.Route(d.OnReject)
+			return r
+		}
+	case "AN":
+		if d.OnRequestForPositions != nil {
+			_, _, r := requestforpositions.Route(d.OnRequestForPositions)
+			return r
+		}
+	case "AO":
+		if d.OnRequestForPositionsAck != nil {
+			_, _, r := requestforpositionsack.Route(d.OnRequestForPositionsAck)
+			return r
+		}
+	case "2":
+		if d.OnResendRequest != nil {
+			_, _, r := resendrequest.Route(d.OnResendRequest)
+			return r
+		}
+	case "d":
+		if d.OnSecurityDefinition != nil {
+			_, _, r := securitydefinition.Route(d.OnSecurityDefinition)
+			return r
+		}
+	case "c":
+		if d.OnSecurityDefinitionRequest != nil {
+			_, _, r := securitydefinitionrequest.Route(d.OnSecurityDefinitionRequest)
+			return r
+		}
+	case "y":
+		if d.OnSecurityList != nil {
+			_, _, r := securitylist.Route(d.OnSecurityList)
+			return r
+		}
+	case "x":
+		if d.OnSecurityListRequest != nil {
+			_, _, r := securitylistrequest.Route(d.OnSecurityListRequest)
+			return r
+		}
+	case "f":
+		if d.OnSecurityStatus != nil {
+			_, _, r := securitystatus.Route(d.OnSecurityStatus)
+			return r
+		}
+	case "e":
+		if d.OnSecurityStatusRequest != nil {
+			_, _, r := securitystatusrequest.Route(d.OnSecurityStatusRequest)
+			return r
+		}
+	case "v":
+		if d.OnSecurityTypeRequest != nil {
+			_, _, r := securitytyperequest.Route(d.OnSecurityTypeRequest)
+			return r
+		}
+	case "w":
+		if d.OnSecurityTypes != nil {
+			_, _, r := securitytypes.Route(d.OnSecurityTypes)
+			return r
+		}
+	case "4":
+		if d.OnSequenceReset != nil {
+			_, _, r := sequencereset.Route(d.OnSequenceReset)
+			return r
+		}
+	case "AV":
+		if d.OnSettlementInstructionRequest != nil {
+			_, _, r := settlementinstructionrequest.Route(d.OnSettlementInstructionRequest)
+			return r
+		}
+	case "T":
+		if d.OnSettlementInstructions != nil {
+			_, _, r := settlementinstructions.Route(d.OnSettlementInstructions)
+			return r
+		}
+	case "1":
+		if d.OnTestRequest != nil {
+			_, _, r := testrequest.Route(d.OnTestRequest)
+			return r
+		}
+	case "AE":
+		if d.OnTradeCaptureReport != nil {
+			_, _, r := tradecapturereport.Route(d.OnTradeCaptureReport)
+			return r
+		}
+	case "AR":
+		if d.OnTradeCaptureReportAck != nil {
+			_, _, r := tradecapturereportack.Route(d.OnTradeCaptureReportAck)
+			return r
+		}
+	case "AD":
+		if d.OnTradeCaptureReportRequest != nil {
+			_, _, r := tradecapturereportrequest.Route(d.OnTradeCaptureReportRequest)
+			return r
+		}
+	case "AQ":
+		if d.OnTradeCaptureReportRequestAck != nil {
+			_, _, r := tradecapturereportrequestack.Route(d.OnTradeCaptureReportRequestAck)
+			return r
+		}
+	case "h":
+		if d.OnTradingSessionStatus != nil {
+			_, _, r := tradingsessionstatus.Route(d.OnTradingSessionStatus)
+			return r
+		}
+	case "g":
+		if d.OnTradingSessionStatusRequest != nil {
+			_, _, r := tradingsessionstatusrequest.Route(d.OnTradingSessionStatusRequest)
+			return r
+		}
+	case "BE":
+		if d.OnUserRequest != nil {
+			_, _, r := userrequest.Route(d.OnUserRequest)
+			return r
+		}
+	case "BF":
+		if d.OnUserResponse != nil {
+			_, _, r := userresponse.Route(d.OnUserResponse)
+			return r
+		}
+	}
+	return nil
+}
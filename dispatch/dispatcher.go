@@ -0,0 +1,78 @@
+package dispatch
+
+import (
+	"github.com/terracefi/quickfix"
+	"github.com/terracefi/tag"
+)
+
+const beginString = "FIX.4.4"
+
+//New returns a Dispatcher with no callbacks set, every application message is rejected by RejectUnsupported until
+//callbacks are added
+func New() *Dispatcher {
+	return &Dispatcher{Default: RejectUnsupported}
+}
+
+//RejectUnsupported is the default handler for messages that have no callback. The returned error makes the session
+//reply with a BusinessMessageReject carrying BusinessRejectReason = UnsupportedMessageType (3).
+func RejectUnsupported(msg *quickfix.Message, sessionID quickfix.SessionID) quickfix.MessageRejectError {
+	return quickfix.UnsupportedMessageType()
+}
+
+//Use appends middleware to the Dispatcher. Middleware wraps both the typed callbacks and the Default handler, the
+//first one added is the outermost.
+func (d *Dispatcher) Use(mw ...Middleware) {
+	d.middleware = append(d.middleware, mw...)
+}
+
+//Route passes msg to the callback registered for its MsgType, or to Default if there is none. An admin message
+//without a callback is accepted and never reaches Default, so Route can be called directly from FromAdmin as well as
+//from FromApp: it has the same signature as quickfix.MessageRouter.Route.
+func (d *Dispatcher) Route(msg *quickfix.Message, sessionID quickfix.SessionID) quickfix.MessageRejectError {
+	h := d.handler(msg)
+	for i := len(d.middleware) - 1; i >= 0; i-- {
+		h = d.middleware[i](h)
+	}
+	return h(msg, sessionID)
+}
+
+//AddRoutes adds the callbacks that are set on d to router. Default and middleware are not used by router, so Route
+//should be preferred when they matter.
+func (d *Dispatcher) AddRoutes(router *quickfix.MessageRouter) {
+	for _, msgType := range msgTypes {
+		if r := d.route(msgType); r != nil {
+			router.AddRoute(beginString, msgType, r)
+		}
+	}
+}
+
+func (d *Dispatcher) handler(msg *quickfix.Message) quickfix.MessageRoute {
+	var h quickfix.MessageRoute
+	var admin bool
+	if begin, err := msg.Header.GetString(tag.BeginString); err == nil && begin == beginString {
+		if msgType, err := msg.Header.GetString(tag.MsgType); err == nil {
+			h, admin = d.route(msgType), adminMsgTypes[msgType]
+		}
+	}
+
+	switch {
+	case h != nil:
+		return h
+	case admin:
+		return acceptAdmin
+	case d.Default != nil:
+		return d.Default
+	default:
+		return RejectUnsupported
+	}
+}
+
+//adminMsgTypes are the MsgTypes of the session level messages: Heartbeat, TestRequest, ResendRequest, Reject,
+//SequenceReset, Logout and Logon
+var adminMsgTypes = map[string]bool{"0": true, "1": true, "2": true, "3": true, "4": true, "5": true, "A": true}
+
+//acceptAdmin handles the admin messages that have no callback, the session has already processed them so they are
+//accepted like quickfix.MessageRouter does
+func acceptAdmin(msg *quickfix.Message, sessionID quickfix.SessionID) quickfix.MessageRejectError {
+	return nil
+}
@@ -0,0 +1,112 @@
+package dispatch_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/terracefi/field"
+	"github.com/terracefi/fix44/dispatch"
+	"github.com/terracefi/fix44/heartbeat"
+	"github.com/terracefi/fix44/logon"
+	"github.com/terracefi/fix44/newordersingle"
+	"github.com/terracefi/quickfix"
+)
+
+var (
+	session = quickfix.SessionID{BeginString: "FIX.4.4", SenderCompID: "A", TargetCompID: "B"}
+	other   = quickfix.SessionID{BeginString: "FIX.4.4", SenderCompID: "A", TargetCompID: "C"}
+)
+
+func newOrderSingle() *quickfix.Message {
+	return newordersingle.New(field.NewClOrdID("A"), field.NewSide("1"), field.NewTransactTime(time.Unix(0, 0).UTC()),
+		field.NewOrdType("1")).ToMessage()
+}
+
+func TestRoute(t *testing.T) {
+	var called []string
+	d := dispatch.New()
+	d.OnNewOrderSingle = func(msg newordersingle.NewOrderSingle, s quickfix.SessionID) quickfix.MessageRejectError {
+		called = append(called, "D")
+		if v, err := msg.GetClOrdID(); err != nil || v != "A" {
+			t.Errorf("GetClOrdID() = %q, %v, want A", v, err)
+		}
+		if s != session {
+			t.Errorf("sessionID = %v, want %v", s, session)
+		}
+		return nil
+	}
+	d.OnLogon = func(logon.Logon, quickfix.SessionID) quickfix.MessageRejectError {
+		called = append(called, "A")
+		return nil
+	}
+
+	logonMsg := logon.New(field.NewEncryptMethod("0"), field.NewHeartBtInt(30)).ToMessage()
+	wrongVersion := newOrderSingle()
+	wrongVersion.Header.SetString(8, "FIX.4.2")
+	tests := []struct {
+		name       string
+		msg        *quickfix.Message
+		wantCalled []string
+		wantReason int
+	}{
+		{"application callback", newOrderSingle(), []string{"D"}, 0},
+		{"admin callback", logonMsg, []string{"A"}, 0},
+		{"admin pass through", heartbeat.New().ToMessage(), nil, 0},
+		{"other begin string", wrongVersion, nil, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called = nil
+			err := d.Route(tt.msg, session)
+			if fmt.Sprint(called) != fmt.Sprint(tt.wantCalled) {
+				t.Errorf("called %v, want %v", called, tt.wantCalled)
+			}
+			if tt.wantReason == 0 {
+				if err != nil {
+					t.Errorf("Route() = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || err.RejectReason() != tt.wantReason || !err.IsBusinessReject() {
+				t.Errorf("Route() = %v, want a BusinessMessageReject with reason %v", err, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestRejectUnsupported(t *testing.T) {
+	d := dispatch.New()
+	err := d.Route(newOrderSingle(), session)
+	if err == nil || err.RejectReason() != 3 || !err.IsBusinessReject() {
+		t.Errorf("Route() = %v, want a BusinessMessageReject with reason UnsupportedMessageType", err)
+	}
+	if err := d.Route(heartbeat.New().ToMessage(), session); err != nil {
+		t.Errorf("Route(Heartbeat) = %v, want nil", err)
+	}
+
+	d.Default = func(msg *quickfix.Message, sessionID quickfix.SessionID) quickfix.MessageRejectError {
+		return nil
+	}
+	if err := d.Route(newOrderSingle(), session); err != nil {
+		t.Errorf("Route() with a Default = %v, want nil", err)
+	}
+	d.Default = nil
+	if err := d.Route(newOrderSingle(), session); err == nil || err.RejectReason() != 3 {
+		t.Errorf("Route() with a nil Default = %v, want UnsupportedMessageType", err)
+	}
+}
+
+func TestAddRoutes(t *testing.T) {
+	var called bool
+	d := dispatch.New()
+	d.OnNewOrderSingle = func(newordersingle.NewOrderSingle, quickfix.SessionID) quickfix.MessageRejectError {
+		called = true
+		return nil
+	}
+	router := quickfix.NewMessageRouter()
+	d.AddRoutes(router)
+	if err := router.Route(newOrderSingle(), session); err != nil || !called {
+		t.Errorf("router.Route() = %v, called %v, want nil, true", err, called)
+	}
+}
@@ -0,0 +1,26 @@
+/*
+Package dispatch routes inbound fix44 messages to typed callbacks.
+
+A Dispatcher has one callback slot per message type, so instead of calling AddRoute on a quickfix.MessageRouter
+for every type an application sets the callbacks it needs and forwards FromApp, and FromAdmin if it wants admin
+callbacks such as OnLogon, to Route:
+
+	d := dispatch.New()
+	d.OnNewOrderSingle = func(msg newordersingle.NewOrderSingle, sessionID quickfix.SessionID) quickfix.MessageRejectError {
+		...
+	}
+	d.Use(dispatch.Recover(nil), dispatch.Logging(log.Printf))
+
+	func (a *App) FromApp(msg *quickfix.Message, sessionID quickfix.SessionID) quickfix.MessageRejectError {
+		return a.dispatcher.Route(msg, sessionID)
+	}
+
+	func (a *App) FromAdmin(msg *quickfix.Message, sessionID quickfix.SessionID) quickfix.MessageRejectError {
+		return a.dispatcher.Route(msg, sessionID)
+	}
+
+Application messages without a callback go to the Default handler, which rejects them with BusinessRejectReason
+UnsupportedMessageType unless it is replaced. Admin messages without a callback are accepted, as a
+quickfix.MessageRouter does, so forwarding FromAdmin never rejects a Heartbeat or a Logon.
+*/
+package dispatch
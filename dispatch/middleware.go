@@ -0,0 +1,69 @@
+package dispatch
+
+import (
+	"github.com/terracefi/quickfix"
+	"github.com/terracefi/tag"
+)
+
+//Middleware wraps the handler of a message, see Dispatcher.Use
+type Middleware func(next quickfix.MessageRoute) quickfix.MessageRoute
+
+//Logging logs each message that is dispatched together with the reject it produced, if any. logf is usually
+//log.Printf.
+func Logging(logf func(format string, v ...interface{})) Middleware {
+	return func(next quickfix.MessageRoute) quickfix.MessageRoute {
+		return func(msg *quickfix.Message, sessionID quickfix.SessionID) quickfix.MessageRejectError {
+			msgType, _ := msg.Header.GetString(tag.MsgType)
+			err := next(msg, sessionID)
+			if err != nil {
+				logf("%v: MsgType %v rejected: %v", sessionID, msgType, err)
+			} else {
+				logf("%v: MsgType %v handled", sessionID, msgType)
+			}
+			return err
+		}
+	}
+}
+
+//Recover stops a panic in a handler from taking down the session. The message is rejected with a
+//BusinessMessageReject carrying BusinessRejectReason = ApplicationNotAvailable (4), and onPanic, if not nil, is
+//called with the recovered value.
+func Recover(onPanic func(p interface{}, msg *quickfix.Message, sessionID quickfix.SessionID)) Middleware {
+	return func(next quickfix.MessageRoute) quickfix.MessageRoute {
+		return func(msg *quickfix.Message, sessionID quickfix.SessionID) (err quickfix.MessageRejectError) {
+			defer func() {
+				if p := recover(); p != nil {
+					if onPanic != nil {
+						onPanic(p, msg, sessionID)
+					}
+					err = quickfix.NewBusinessMessageRejectError("Application not available", 4, nil)
+				}
+			}()
+			return next(msg, sessionID)
+		}
+	}
+}
+
+//FilterSessions only passes on the messages received on sessions for which allow returns true, messages from other
+//sessions are dropped
+func FilterSessions(allow func(sessionID quickfix.SessionID) bool) Middleware {
+	return func(next quickfix.MessageRoute) quickfix.MessageRoute {
+		return func(msg *quickfix.Message, sessionID quickfix.SessionID) quickfix.MessageRejectError {
+			if !allow(sessionID) {
+				return nil
+			}
+			return next(msg, sessionID)
+		}
+	}
+}
+
+//Sessions is a FilterSessions that allows the given sessions only
+func Sessions(sessionIDs ...quickfix.SessionID) Middleware {
+	allowed := make(map[quickfix.SessionID]bool, len(sessionIDs))
+	for _, s := range sessionIDs {
+		allowed[s] = true
+	}
+	return FilterSessions(func(sessionID quickfix.SessionID) bool {
+		return allowed[sessionID]
+	})
+}
@@ -0,0 +1,118 @@
+package dispatch_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/terracefi/fix44/dispatch"
+	"github.com/terracefi/fix44/heartbeat"
+	"github.com/terracefi/fix44/newordersingle"
+	"github.com/terracefi/quickfix"
+)
+
+func TestLogging(t *testing.T) {
+	var logged []string
+	logf := func(format string, v ...interface{}) { logged = append(logged, fmt.Sprintf(format, v...)) }
+	d := dispatch.New()
+	d.Use(dispatch.Logging(logf))
+
+	if err := d.Route(heartbeat.New().ToMessage(), session); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Route(newOrderSingle(), session); err == nil {
+		t.Fatal("Route() = nil, want UnsupportedMessageType")
+	}
+	want := []string{
+		fmt.Sprintf("%v: MsgType 0 handled", session),
+		fmt.Sprintf("%v: MsgType D rejected: %v", session, quickfix.UnsupportedMessageType()),
+	}
+	if !reflect.DeepEqual(logged, want) {
+		t.Errorf("logged %q, want %q", logged, want)
+	}
+}
+
+func TestRecover(t *testing.T) {
+	var recovered interface{}
+	d := dispatch.New()
+	d.OnNewOrderSingle = func(newordersingle.NewOrderSingle, quickfix.SessionID) quickfix.MessageRejectError {
+		panic("boom")
+	}
+	d.Use(dispatch.Recover(func(p interface{}, msg *quickfix.Message, sessionID quickfix.SessionID) {
+		recovered = p
+	}))
+
+	err := d.Route(newOrderSingle(), session)
+	if err == nil || err.RejectReason() != 4 || !err.IsBusinessReject() {
+		t.Errorf("Route() = %v, want a BusinessMessageReject with reason ApplicationNotAvailable", err)
+	}
+	if recovered != "boom" {
+		t.Errorf("onPanic got %v, want boom", recovered)
+	}
+
+	d = dispatch.New()
+	d.OnNewOrderSingle = func(newordersingle.NewOrderSingle, quickfix.SessionID) quickfix.MessageRejectError {
+		panic("boom")
+	}
+	d.Use(dispatch.Recover(nil))
+	if err := d.Route(newOrderSingle(), session); err == nil || err.RejectReason() != 4 {
+		t.Errorf("Route() with a nil onPanic = %v, want ApplicationNotAvailable", err)
+	}
+}
+
+func TestFilterSessions(t *testing.T) {
+	onlySession := func(s quickfix.SessionID) bool { return s == session }
+	tests := []struct {
+		name      string
+		mw        dispatch.Middleware
+		sessionID quickfix.SessionID
+		want      bool
+	}{
+		{"filter allows", dispatch.FilterSessions(onlySession), session, true},
+		{"filter drops", dispatch.FilterSessions(onlySession), other, false},
+		{"listed session", dispatch.Sessions(session), session, true},
+		{"unlisted session", dispatch.Sessions(session), other, false},
+		{"no sessions", dispatch.Sessions(), session, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var called bool
+			d := dispatch.New()
+			d.OnNewOrderSingle = func(newordersingle.NewOrderSingle, quickfix.SessionID) quickfix.MessageRejectError {
+				called = true
+				return nil
+			}
+			d.Use(tt.mw)
+			if err := d.Route(newOrderSingle(), tt.sessionID); err != nil {
+				t.Errorf("Route() = %v, want nil", err)
+			}
+			if called != tt.want {
+				t.Errorf("called = %v, want %v", called, tt.want)
+			}
+		})
+	}
+}
+
+func TestUseOrder(t *testing.T) {
+	var order []string
+	mw := func(name string) dispatch.Middleware {
+		return func(next quickfix.MessageRoute) quickfix.MessageRoute {
+			return func(msg *quickfix.Message, sessionID quickfix.SessionID) quickfix.MessageRejectError {
+				order = append(order, name)
+				return next(msg, sessionID)
+			}
+		}
+	}
+	d := dispatch.New()
+	d.Use(mw("outer"), mw("inner"))
+	d.Default = func(msg *quickfix.Message, sessionID quickfix.SessionID) quickfix.MessageRejectError {
+		order = append(order, "default")
+		return nil
+	}
+	if err := d.Route(newOrderSingle(), session); err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"outer", "inner", "default"}; !reflect.DeepEqual(order, want) {
+		t.Errorf("order = %v, want %v", order, want)
+	}
+}
@@ -0,0 +1,2181 @@
+package fix44
+
+import (
+	"github.com/terracefi/quickfix"
+	"github.com/terracefi/tag"
+)
+
+//enumValues holds the values FIX 4.4 defines for its enumerated fields
+var enumValues = map[quickfix.Tag][]EnumValue{
+	tag.AccountType: {
+		{"1", "ACCOUNT_IS_CARRIED_ON_CUSTOMER_SIDE_OF_BOOKS"},
+		{"2", "ACCOUNT_IS_CARRIED_ON_NON_CUSTOMER_SIDE_OF_BOOKS"},
+		{"3", "HOUSE_TRADER"},
+		{"4", "FLOOR_TRADER"},
+		{"6", "ACCOUNT_IS_CARRIED_ON_NON_CUSTOMER_SIDE_OF_BOOKS_AND_IS_CROSS_MARGINED"},
+		{"7", "ACCOUNT_IS_HOUSE_TRADER_AND_IS_CROSS_MARGINED"},
+		{"8", "JOINT_BACKOFFICE_ACCOUNT"},
+	},
+	tag.AcctIDSource: {
+		{"1", "BIC"},
+		{"2", "SID_CODE"},
+		{"3", "TFM"},
+		{"4", "OMGEO"},
+		{"5", "DTCC_CODE"},
+		{"99", "OTHER"},
+	},
+	tag.Adjustment: {
+		{"1", "CANCEL"},
+		{"2", "ERROR"},
+		{"3", "CORRECTION"},
+	},
+	tag.AdjustmentType: {
+		{"0", "PROCESS_REQUEST_AS_MARGIN_DISPOSITION"},
+		{"1", "DELTA_PLUS"},
+		{"2", "DELTA_MINUS"},
+		{"3", "FINAL"},
+	},
+	tag.AdvSide: {
+		{"B", "BUY"},
+		{"S", "SELL"},
+		{"X", "CROSS"},
+		{"T", "TRADE"},
+	},
+	tag.AdvTransType: {
+		{"N", "NEW"},
+		{"C", "CANCEL"},
+		{"R", "REPLACE"},
+	},
+	tag.AffirmStatus: {
+		{"1", "RECEIVED"},
+		{"2", "CONFIRM_REJECTED"},
+		{"3", "AFFIRMED"},
+	},
+	tag.AllocAccountType: {
+		{"1", "ACCOUNT_IS_CARRIED_ON_CUSTOMER_SIDE_OF_BOOKS"},
+		{"2", "ACCOUNT_IS_CARRIED_ON_NON_CUSTOMER_SIDE_OF_BOOKS"},
+		{"3", "HOUSE_TRADER"},
+		{"4", "FLOOR_TRADER"},
+		{"6", "ACCOUNT_IS_CARRIED_ON_NON_CUSTOMER_SIDE_OF_BOOKS_AND_IS_CROSS_MARGINED"},
+		{"7", "ACCOUNT_IS_HOUSE_TRADER_AND_IS_CROSS_MARGINED"},
+		{"8", "JOINT_BACKOFFICE_ACCOUNT"},
+	},
+	tag.AllocCancReplaceReason: {
+		{"1", "ORIGINAL_DETAILS_INCOMPLETE_INCORRECT"},
+		{"2", "CHANGE_IN_UNDERLYING_ORDER_DETAILS"},
+		{"99", "OTHER"},
+	},
+	tag.AllocHandlInst: {
+		{"1", "MATCH"},
+		{"2", "FORWARD"},
+		{"3", "FORWARD_AND_MATCH"},
+	},
+	tag.AllocIntermedReqType: {
+		{"1", "PENDING_ACCEPT"},
+		{"2", "PENDING_RELEASE"},
+		{"3", "PENDING_REVERSAL"},
+		{"4", "ACCEPT"},
+		{"5", "BLOCK_LEVEL_REJECT"},
+		{"6", "ACCOUNT_LEVEL_REJECT"},
+	},
+	tag.AllocLinkType: {
+		{"0", "F_X_NETTING"},
+		{"1", "F_X_SWAP"},
+	},
+	tag.AllocNoOrdersType: {
+		{"0", "NOT_SPECIFIED"},
+		{"1", "EXPLICIT_LIST_PROVIDED"},
+	},
+	tag.AllocRejCode: {
+		{"0", "UNKNOWN_ACCOUNT"},
+		{"1", "INCORRECT_QUANTITY"},
+		{"2", "INCORRECT_AVERAGE_PRICE"},
+		{"3", "UNKNOWN_EXECUTING_BROKER_MNEMONIC"},
+		{"4", "COMMISSION_DIFFERENCE"},
+		{"5", "UNKNOWN_ORDERID"},
+		{"6", "UNKNOWN_LISTID"},
+		{"7", "OTHER"},
+		{"8", "INCORRECT_ALLOCATED_QUANTITY"},
+		{"9", "CALCULATION_DIFFERENCE"},
+		{"10", "UNKNOWN_OR_STALE_EXECID"},
+		{"11", "MISMATCHED_DATA_VALUE"},
+		{"12", "UNKNOWN_CLORDID"},
+		{"13", "WAREHOUSE_REQUEST_REJECTED"},
+	},
+	tag.AllocReportType: {
+		{"3", "SELLSIDE_CALCULATED_USING_PRELIMINARY"},
+		{"4", "SELLSIDE_CALCULATED_WITHOUT_PRELIMINARY"},
+		{"5", "WAREHOUSE_RECAP"},
+		{"8", "REQUEST_TO_INTERMEDIARY"},
+	},
+	tag.AllocSettlInstType: {
+		{"0", "USE_DEFAULT_INSTRUCTIONS"},
+		{"1", "DERIVE_FROM_PARAMETERS_PROVIDED"},
+		{"2", "FULL_DETAILS_PROVIDED"},
+		{"3", "SSI_DB_IDS_PROVIDED"},
+		{"4", "PHONE_FOR_INSTRUCTIONS"},
+	},
+	tag.AllocStatus: {
+		{"0", "ACCEPTED"},
+		{"1", "BLOCK_LEVEL_REJECT"},
+		{"2", "ACCOUNT_LEVEL_REJECT"},
+		{"3", "RECEIVED"},
+		{"4", "INCOMPLETE"},
+		{"5", "REJECTED_BY_INTERMEDIARY"},
+	},
+	tag.AllocTransType: {
+		{"0", "NEW"},
+		{"1", "REPLACE"},
+		{"2", "CANCEL"},
+	},
+	tag.AllocType: {
+		{"1", "CALCULATED"},
+		{"2", "PRELIMINARY"},
+		{"3", "SELLSIDE_CALCULATED_USING_PRELIMINARY"},
+		{"4", "SELLSIDE_CALCULATED_WITHOUT_PRELIMINARY"},
+		{"5", "READY_TO_BOOK"},
+		{"6", "BUYSIDE_READY_TO_BOOK"},
+		{"7", "WAREHOUSE_INSTRUCTION"},
+		{"8", "REQUEST_TO_INTERMEDIARY"},
+	},
+	tag.ApplQueueAction: {
+		{"0", "NO_ACTION_TAKEN"},
+		{"1", "QUEUE_FLUSHED"},
+		{"2", "OVERLAY_LAST"},
+		{"3", "END_SESSION"},
+	},
+	tag.ApplQueueResolution: {
+		{"0", "NO_ACTION_TAKEN"},
+		{"1", "QUEUE_FLUSHED"},
+		{"2", "OVERLAY_LAST"},
+		{"3", "END_SESSION"},
+	},
+	tag.AssignmentMethod: {
+		{"R", "RANDOM"},
+		{"P", "PRORATA"},
+	},
+	tag.AvgPxIndicator: {
+		{"0", "NO_AVERAGE_PRICING"},
+		{"1", "TRADE_IS_PART_OF_AN_AVERAGE_PRICE_GROUP_IDENTIFIED_BY_THE_TRADELINKID"},
+		{"2", "LAST_TRADE_IN_THE_AVERAGE_PRICE_GROUP_IDENTIFIED_BY_THE_TRADELINKID"},
+	},
+	tag.BasisPxType: {
+		{"2", "CLOSING_PRICE_AT_MORNING_SESSION"},
+		{"3", "CLOSING_PRICE"},
+		{"4", "CURRENT_PRICE"},
+		{"5", "SQ"},
+		{"6", "VWAP_THROUGH_A_DAY"},
+		{"7", "VWAP_THROUGH_A_MORNING_SESSION"},
+		{"8", "VWAP_THROUGH_AN_AFTERNOON_SESSION"},
+		{"9", "VWAP_THROUGH_A_DAY_EXCEPT_YORI"},
+		{"A", "VWAP_THROUGH_A_MORNING_SESSION_EXCEPT_YORI"},
+		{"B", "VWAP_THROUGH_AN_AFTERNOON_SESSION_EXCEPT_YORI"},
+		{"C", "STRIKE"},
+		{"D", "OPEN"},
+		{"Z", "OTHERS"},
+	},
+	tag.BenchmarkCurveName: {
+		{"MuniAAA", "MUNIAAA"},
+		{"FutureSWAP", "FUTURESWAP"},
+		{"LIBID", "LIBID"},
+		{"LIBOR", "LIBOR"},
+		{"OTHER", "OTHER"},
+		{"SWAP", "SWAP"},
+		{"Treasury", "TREASURY"},
+		{"Euribor", "EURIBOR"},
+		{"Pfandbriefe", "PFANDBRIEFE"},
+		{"EONIA", "EONIA"},
+		{"SONIA", "SONIA"},
+		{"EUREPO", "EUREPO"},
+	},
+	tag.BidDescriptorType: {
+		{"1", "SECTOR"},
+		{"2", "COUNTRY"},
+		{"3", "INDEX"},
+	},
+	tag.BidRequestTransType: {
+		{"N", "NEW"},
+		{"C", "CANCEL"},
+	},
+	tag.BidTradeType: {
+		{"R", "RISK_TRADE"},
+		{"G", "VWAP_GUARANTEE"},
+		{"A", "AGENCY"},
+		{"J", "GUARANTEED_CLOSE"},
+	},
+	tag.BidType: {
+		{"1", "NON_DISCLOSED"},
+		{"2", "DISCLOSED"},
+		{"3", "NO_BIDDING_PROCESS"},
+	},
+	tag.BookingType: {
+		{"0", "REGULAR_BOOKING"},
+		{"1", "CFD"},
+		{"2", "TOTAL_RETURN_SWAP"},
+	},
+	tag.BookingUnit: {
+		{"0", "EACH_PARTIAL_EXECUTION_IS_A_BOOKABLE_UNIT"},
+		{"1", "AGGREGATE_PARTIAL_EXECUTIONS_ON_THIS_ORDER_AND_BOOK_ONE_TRADE_PER_ORDER"},
+		{"2", "AGGREGATE_EXECUTIONS_FOR_THIS_SYMBOL_SIDE_AND_SETTLEMENT_DATE"},
+	},
+	tag.BusinessRejectReason: {
+		{"0", "OTHER"},
+		{"1", "UNKNOWN_ID"},
+		{"2", "UNKNOWN_SECURITY"},
+		{"3", "UNSUPPORTED_MESSAGE_TYPE"},
+		{"4", "APPLICATION_NOT_AVAILABLE"},
+		{"5", "CONDITIONALLY_REQUIRED_FIELD_MISSING"},
+		{"6", "NOT_AUTHORIZED"},
+		{"7", "DELIVERTO_FIRM_NOT_AVAILABLE_AT_THIS_TIME"},
+		{"18", "INVALID_PRICE_INCREMENT"},
+	},
+	tag.CPProgram: {
+		{"1", "3A3"},
+		{"2", "42"},
+		{"99", "OTHER"},
+	},
+	tag.CancellationRights: {
+		{"Y", "YES"},
+		{"N", "NO_EXECUTION_ONLY"},
+		{"M", "NO_WAIVER_AGREEMENT"},
+		{"O", "NO_INSTITUTIONAL"},
+	},
+	tag.CashMargin: {
+		{"1", "CASH"},
+		{"2", "MARGIN_OPEN"},
+		{"3", "MARGIN_CLOSE"},
+	},
+	tag.ClearingFeeIndicator: {
+		{"B", "CBOE_MEMBER"},
+		{"C", "NON_MEMBER_AND_CUSTOMER"},
+		{"E", "EQUITY_MEMBER_AND_CLEARING_MEMBER"},
+		{"F", "FULL_AND_ASSOCIATE_MEMBER_TRADING_FOR_OWN_ACCOUNT_AND_AS_FLOOR_BROKERS"},
+		{"H", "106H_AND_106J_FIRMS"},
+		{"I", "GIM_IDEM_AND_COM_MEMBERSHIP_INTEREST_HOLDERS"},
+		{"L", "LESSEE_106F_EMPLOYEES"},
+		{"M", "ALL_OTHER_OWNERSHIP_TYPES"},
+		{"1", "1ST_YEAR_DELEGATE_TRADING_FOR_OWN_ACCOUNT"},
+		{"2", "2ND_YEAR_DELEGATE_TRADING_FOR_OWN_ACCOUNT"},
+		{"3", "3RD_YEAR_DELEGATE_TRADING_FOR_OWN_ACCOUNT"},
+		{"4", "4TH_YEAR_DELEGATE_TRADING_FOR_OWN_ACCOUNT"},
+		{"5", "5TH_YEAR_DELEGATE_TRADING_FOR_OWN_ACCOUNT"},
+		{"9", "6TH_YEAR_DELEGATE_TRADING_FOR_OWN_ACCOUNT"},
+	},
+	tag.ClearingInstruction: {
+		{"0", "PROCESS_NORMALLY"},
+		{"1", "EXCLUDE_FROM_ALL_NETTING"},
+		{"2", "BILATERAL_NETTING_ONLY"},
+		{"3", "EX_CLEARING"},
+		{"4", "SPECIAL_TRADE"},
+		{"5", "MULTILATERAL_NETTING"},
+		{"6", "CLEAR_AGAINST_CENTRAL_COUNTERPARTY"},
+		{"7", "EXCLUDE_FROM_CENTRAL_COUNTERPARTY"},
+		{"8", "MANUAL_MODE"},
+		{"9", "AUTOMATIC_POSTING_MODE"},
+		{"10", "AUTOMATIC_GIVE_UP_MODE"},
+		{"11", "QUALIFIED_SERVICE_REPRESENTATIVE"},
+		{"12", "CUSTOMER_TRADE"},
+		{"13", "SELF_CLEARING"},
+	},
+	tag.CollAction: {
+		{"0", "RETAIN"},
+		{"1", "ADD"},
+		{"2", "REMOVE"},
+	},
+	tag.CollAsgnReason: {
+		{"0", "INITIAL"},
+		{"1", "SCHEDULED"},
+		{"2", "TIME_WARNING"},
+		{"3", "MARGIN_DEFICIENCY"},
+		{"4", "MARGIN_EXCESS"},
+		{"5", "FORWARD_COLLATERAL_DEMAND"},
+		{"6", "EVENT_OF_DEFAULT"},
+		{"7", "ADVERSE_TAX_EVENT"},
+	},
+	tag.CollAsgnRejectReason: {
+		{"0", "UNKNOWN_DEAL"},
+		{"1", "UNKNOWN_OR_INVALID_INSTRUMENT"},
+		{"2", "UNAUTHORIZED_TRANSACTION"},
+		{"3", "INSUFFICIENT_COLLATERAL"},
+		{"4", "INVALID_TYPE_OF_COLLATERAL"},
+		{"5", "EXCESSIVE_SUBSTITUTION"},
+		{"99", "OTHER"},
+	},
+	tag.CollAsgnRespType: {
+		{"0", "RECEIVED"},
+		{"1", "ACCEPTED"},
+		{"2", "DECLINED"},
+		{"3", "REJECTED"},
+	},
+	tag.CollAsgnTransType: {
+		{"0", "NEW"},
+		{"1", "REPLACE"},
+		{"2", "CANCEL"},
+		{"3", "RELEASE"},
+		{"4", "REVERSE"},
+	},
+	tag.CollInquiryQualifier: {
+		{"0", "TRADEDATE"},
+		{"1", "GC_INSTRUMENT"},
+		{"2", "COLLATERALINSTRUMENT"},
+		{"3", "SUBSTITUTION_ELIGIBLE"},
+		{"4", "NOT_ASSIGNED"},
+		{"5", "PARTIALLY_ASSIGNED"},
+		{"6", "FULLY_ASSIGNED"},
+		{"7", "OUTSTANDING_TRADES"},
+	},
+	tag.CollInquiryResult: {
+		{"0", "SUCCESSFUL"},
+		{"1", "INVALID_OR_UNKNOWN_INSTRUMENT"},
+		{"2", "INVALID_OR_UNKNOWN_COLLATERAL_TYPE"},
+		{"3", "INVALID_PARTIES"},
+		{"4", "INVALID_TRANSPORT_TYPE_REQUESTED"},
+		{"5", "INVALID_DESTINATION_REQUESTED"},
+		{"6", "NO_COLLATERAL_FOUND_FOR_THE_TRADE_SPECIFIED"},
+		{"7", "NO_COLLATERAL_FOUND_FOR_THE_ORDER_SPECIFIED"},
+		{"8", "COLLATERAL_INQUIRY_TYPE_NOT_SUPPORTED"},
+		{"9", "UNAUTHORIZED_FOR_COLLATERAL_INQUIRY"},
+		{"99", "OTHER"},
+	},
+	tag.CollInquiryStatus: {
+		{"0", "ACCEPTED"},
+		{"1", "ACCEPTED_WITH_WARNINGS"},
+		{"2", "COMPLETED"},
+		{"3", "COMPLETED_WITH_WARNINGS"},
+		{"4", "REJECTED"},
+	},
+	tag.CollStatus: {
+		{"0", "UNASSIGNED"},
+		{"1", "PARTIALLY_ASSIGNED"},
+		{"2", "ASSIGNMENT_PROPOSED"},
+		{"3", "ASSIGNED"},
+		{"4", "CHALLENGED"},
+	},
+	tag.CommType: {
+		{"1", "PER_UNIT"},
+		{"2", "PERCENTAGE"},
+		{"3", "ABSOLUTE"},
+		{"4", "PERCENTAGE_WAIVED_CASH_DISCOUNT"},
+		{"5", "PERCENTAGE_WAIVED_ENHANCED_UNITS"},
+		{"6", "POINTS_PER_BOND_OR_CONTRACT"},
+	},
+	tag.ConfirmRejReason: {
+		{"1", "MISMATCHED_ACCOUNT"},
+		{"2", "MISSING_SETTLEMENT_INSTRUCTIONS"},
+		{"99", "OTHER"},
+	},
+	tag.ConfirmStatus: {
+		{"1", "RECEIVED"},
+		{"2", "MISMATCHED_ACCOUNT"},
+		{"3", "MISSING_SETTLEMENT_INSTRUCTIONS"},
+		{"4", "CONFIRMED"},
+		{"5", "REQUEST_REJECTED"},
+	},
+	tag.ConfirmTransType: {
+		{"0", "NEW"},
+		{"1", "REPLACE"},
+		{"2", "CANCEL"},
+	},
+	tag.ConfirmType: {
+		{"1", "STATUS"},
+		{"2", "CONFIRMATION"},
+		{"3", "CONFIRMATION_REQUEST_REJECTED"},
+	},
+	tag.ContAmtType: {
+		{"1", "COMMISSION_AMOUNT"},
+		{"2", "COMMISSION_PERCENT"},
+		{"3", "INITIAL_CHARGE_AMOUNT"},
+		{"4", "INITIAL_CHARGE_PERCENT"},
+		{"5", "DISCOUNT_AMOUNT"},
+		{"6", "DISCOUNT_PERCENT"},
+		{"7", "DILUTION_LEVY_AMOUNT"},
+		{"8", "DILUTION_LEVY_PERCENT"},
+		{"9", "EXIT_CHARGE_AMOUNT"},
+		{"10", "EXIT_CHARGE_PERCENT"},
+		{"11", "FUND_BASED_RENEWAL_COMMISSION_PERCENT"},
+		{"12", "PROJECTED_FUND_VALUE"},
+		{"13", "FUND_BASED_RENEWAL_COMMISSION_AMOUNT_13"},
+		{"14", "FUND_BASED_RENEWAL_COMMISSION_AMOUNT_14"},
+		{"15", "NET_SETTLEMENT_AMOUNT"},
+	},
+	tag.CorporateAction: {
+		{"A", "EX_DIVIDEND"},
+		{"B", "EX_DISTRIBUTION"},
+		{"C", "EX_RIGHTS"},
+		{"D", "NEW"},
+		{"E", "EX_INTEREST"},
+	},
+	tag.CoveredOrUncovered: {
+		{"0", "COVERED"},
+		{"1", "UNCOVERED"},
+	},
+	tag.CrossPrioritization: {
+		{"0", "NONE"},
+		{"1", "BUY_SIDE_IS_PRIORITIZED"},
+		{"2", "SELL_SIDE_IS_PRIORITIZED"},
+	},
+	tag.CrossType: {
+		{"1", "CROSS_TRADE_WHICH_IS_EXECUTED_COMPLETELY_OR_NOT"},
+		{"2", "CROSS_TRADE_WHICH_IS_EXECUTED_PARTIALLY_AND_THE_REST_IS_CANCELLED"},
+		{"3", "CROSS_TRADE_WHICH_IS_PARTIALLY_EXECUTED_WITH_THE_UNFILLED_PORTIONS_REMAINING_ACTIVE"},
+		{"4", "CROSS_TRADE_IS_EXECUTED_WITH_EXISTING_ORDERS_WITH_THE_SAME_PRICE"},
+	},
+	tag.CustOrderCapacity: {
+		{"1", "MEMBER_TRADING_FOR_THEIR_OWN_ACCOUNT"},
+		{"2", "CLEARING_FIRM_TRADING_FOR_ITS_PROPRIETARY_ACCOUNT"},
+		{"3", "MEMBER_TRADING_FOR_ANOTHER_MEMBER"},
+		{"4", "ALL_OTHER"},
+	},
+	tag.CxlRejReason: {
+		{"0", "TOO_LATE_TO_CANCEL"},
+		{"1", "UNKNOWN_ORDER"},
+		{"2", "BROKER_CREDIT"},
+		{"3", "ORDER_ALREADY_IN_PENDING_CANCEL_OR_PENDING_REPLACE_STATUS"},
+		{"4", "UNABLE_TO_PROCESS_ORDER_MASS_CANCEL_REQUEST"},
+		{"5", "ORIGORDMODTIME_DID_NOT_MATCH_LAST_TRANSACTTIME_OF_ORDER"},
+		{"6", "DUPLICATE_CLORDID_RECEIVED"},
+		{"99", "OTHER"},
+	},
+	tag.CxlRejResponseTo: {
+		{"1", "ORDER_CANCEL_REQUEST"},
+		{"2", "ORDER_CANCEL_REPLACE_REQUEST"},
+	},
+	tag.DKReason: {
+		{"A", "UNKNOWN_SYMBOL"},
+		{"B", "WRONG_SIDE"},
+		{"C", "QUANTITY_EXCEEDS_ORDER"},
+		{"D", "NO_MATCHING_ORDER"},
+		{"E", "PRICE_EXCEEDS_LIMIT"},
+		{"F", "CALCULATION_DIFFERENCE"},
+		{"Z", "OTHER"},
+	},
+	tag.DayBookingInst: {
+		{"0", "CAN_TRIGGER_BOOKING_WITHOUT_REFERENCE_TO_THE_ORDER_INITIATOR"},
+		{"1", "SPEAK_WITH_ORDER_INITIATOR_BEFORE_BOOKING"},
+		{"2", "ACCUMULATE"},
+	},
+	tag.DeleteReason: {
+		{"0", "CANCELATION"},
+		{"1", "ERROR"},
+	},
+	tag.DeliveryForm: {
+		{"1", "BOOKENTRY"},
+		{"2", "BEARER"},
+	},
+	tag.DeliveryType: {
+		{"0", "VERSUS_PAYMENT"},
+		{"1", "FREE"},
+		{"2", "TRI_PARTY"},
+		{"3", "HOLD_IN_CUSTODY"},
+	},
+	tag.DiscretionInst: {
+		{"0", "RELATED_TO_DISPLAYED_PRICE"},
+		{"1", "RELATED_TO_MARKET_PRICE"},
+		{"2", "RELATED_TO_PRIMARY_PRICE"},
+		{"3", "RELATED_TO_LOCAL_PRIMARY_PRICE"},
+		{"4", "RELATED_TO_MIDPOINT_PRICE"},
+		{"5", "RELATED_TO_LAST_TRADE_PRICE"},
+		{"6", "RELATED_TO_VWAP"},
+	},
+	tag.DiscretionLimitType: {
+		{"0", "OR_BETTER"},
+		{"1", "STRICT"},
+		{"2", "OR_WORSE"},
+	},
+	tag.DiscretionMoveType: {
+		{"0", "FLOATING"},
+		{"1", "FIXED"},
+	},
+	tag.DiscretionOffsetType: {
+		{"0", "PRICE"},
+		{"1", "BASIS_POINTS"},
+		{"2", "TICKS"},
+		{"3", "PRICE_TIER"},
+	},
+	tag.DiscretionRoundDirection: {
+		{"1", "MORE_AGGRESSIVE"},
+		{"2", "MORE_PASSIVE"},
+	},
+	tag.DiscretionScope: {
+		{"1", "LOCAL"},
+		{"2", "NATIONAL"},
+		{"3", "GLOBAL"},
+		{"4", "NATIONAL_EXCLUDING_LOCAL"},
+	},
+	tag.DistribPaymentMethod: {
+		{"1", "CREST"},
+		{"2", "NSCC"},
+		{"3", "EUROCLEAR"},
+		{"4", "CLEARSTREAM"},
+		{"5", "CHEQUE"},
+		{"6", "TELEGRAPHIC_TRANSFER"},
+		{"7", "FEDWIRE"},
+		{"8", "DIRECT_CREDIT"},
+		{"9", "ACH_CREDIT"},
+		{"10", "BPAY"},
+		{"11", "HIGH_VALUE_CLEARING_SYSTEM"},
+		{"12", "REINVEST_IN_FUND"},
+	},
+	tag.DlvyInstType: {
+		{"S", "SECURITIES"},
+		{"C", "CASH"},
+	},
+	tag.EmailType: {
+		{"0", "NEW"},
+		{"1", "REPLY"},
+		{"2", "ADMIN_REPLY"},
+	},
+	tag.EncryptMethod: {
+		{"0", "NONE_OTHER"},
+		{"1", "PKCS"},
+		{"2", "DES"},
+		{"3", "PKCS_DES"},
+		{"4", "PGP_DES"},
+		{"5", "PGP_DES_MD5"},
+		{"6", "PEM_DES_MD5"},
+	},
+	tag.EventType: {
+		{"1", "PUT"},
+		{"2", "CALL"},
+		{"3", "TENDER"},
+		{"4", "SINKING_FUND_CALL"},
+		{"99", "OTHER"},
+	},
+	tag.ExecInst: {
+		{"0", "STAY_ON_OFFERSIDE"},
+		{"1", "NOT_HELD"},
+		{"2", "WORK"},
+		{"3", "GO_ALONG"},
+		{"4", "OVER_THE_DAY"},
+		{"5", "HELD"},
+		{"6", "PARTICIPATE_DONT_INITIATE"},
+		{"7", "STRICT_SCALE"},
+		{"8", "TRY_TO_SCALE"},
+		{"9", "STAY_ON_BIDSIDE"},
+		{"A", "NO_CROSS"},
+		{"B", "OK_TO_CROSS"},
+		{"C", "CALL_FIRST"},
+		{"D", "PERCENT_OF_VOLUME"},
+		{"E", "DO_NOT_INCREASE"},
+		{"F", "DO_NOT_REDUCE"},
+		{"G", "ALL_OR_NONE"},
+		{"H", "REINSTATE_ON_SYSTEM_FAILURE"},
+		{"I", "INSTITUTIONS_ONLY"},
+		{"J", "REINSTATE_ON_TRADING_HALT"},
+		{"K", "CANCEL_ON_TRADING_HALT"},
+		{"L", "LAST_PEG"},
+		{"M", "MID_PRICE_PEG"},
+		{"N", "NON_NEGOTIABLE"},
+		{"O", "OPENING_PEG"},
+		{"P", "MARKET_PEG"},
+		{"Q", "CANCEL_ON_SYSTEM_FAILURE"},
+		{"R", "PRIMARY_PEG"},
+		{"S", "SUSPEND"},
+		{"T", "FIXED_PEG_TO_LOCAL_BEST_BID_OR_OFFER_AT_TIME_OF_ORDER"},
+		{"U", "CUSTOMER_DISPLAY_INSTRUCTION"},
+		{"V", "NETTING"},
+		{"W", "PEG_TO_VWAP"},
+		{"X", "TRADE_ALONG"},
+		{"Y", "TRY_TO_STOP"},
+		{"Z", "CANCEL_IF_NOT_BEST"},
+		{"a", "TRAILING_STOP_PEG"},
+		{"b", "STRICT_LIMIT"},
+		{"c", "IGNORE_PRICE_VALIDITY_CHECKS"},
+		{"d", "PEG_TO_LIMIT_PRICE"},
+		{"e", "WORK_TO_TARGET_STRATEGY"},
+	},
+	tag.ExecPriceType: {
+		{"B", "BID_PRICE"},
+		{"C", "CREATION_PRICE"},
+		{"D", "CREATION_PRICE_PLUS_ADJUSTMENT_PERCENT"},
+		{"E", "CREATION_PRICE_PLUS_ADJUSTMENT_AMOUNT"},
+		{"O", "OFFER_PRICE"},
+		{"P", "OFFER_PRICE_MINUS_ADJUSTMENT_PERCENT"},
+		{"Q", "OFFER_PRICE_MINUS_ADJUSTMENT_AMOUNT"},
+		{"S", "SINGLE_PRICE"},
+	},
+	tag.ExecRestatementReason: {
+		{"0", "GT_CORPORATE_ACTION"},
+		{"1", "GT_RENEWAL_RESTATEMENT"},
+		{"2", "VERBAL_CHANGE"},
+		{"3", "REPRICING_OF_ORDER"},
+		{"4", "BROKER_OPTION"},
+		{"5", "PARTIAL_DECLINE_OF_ORDERQTY"},
+		{"6", "CANCEL_ON_TRADING_HALT"},
+		{"7", "CANCEL_ON_SYSTEM_FAILURE"},
+		{"8", "MARKET_OPTION"},
+		{"9", "CANCELED_NOT_BEST"},
+		{"10", "WAREHOUSE_RECAP"},
+		{"99", "OTHER"},
+	},
+	tag.ExecType: {
+		{"0", "NEW"},
+		{"3", "DONE_FOR_DAY"},
+		{"4", "CANCELED"},
+		{"5", "REPLACED"},
+		{"6", "PENDING_CANCEL"},
+		{"7", "STOPPED"},
+		{"8", "REJECTED"},
+		{"9", "SUSPENDED"},
+		{"A", "PENDING_NEW"},
+		{"B", "CALCULATED"},
+		{"C", "EXPIRED"},
+		{"D", "RESTATED"},
+		{"E", "PENDING_REPLACE"},
+		{"F", "TRADE"},
+		{"G", "TRADE_CORRECT"},
+		{"H", "TRADE_CANCEL"},
+		{"I", "ORDER_STATUS"},
+	},
+	tag.ExerciseMethod: {
+		{"A", "AUTOMATIC"},
+		{"M", "MANUAL"},
+	},
+	tag.ExpirationCycle: {
+		{"0", "EXPIRE_ON_TRADING_SESSION_CLOSE"},
+		{"1", "EXPIRE_ON_TRADING_SESSION_OPEN"},
+	},
+	tag.FinancialStatus: {
+		{"1", "BANKRUPT"},
+		{"2", "PENDING_DELISTING"},
+	},
+	tag.FundRenewWaiv: {
+		{"Y", "YES"},
+		{"N", "NO"},
+	},
+	tag.GTBookingInst: {
+		{"0", "BOOK_OUT_ALL_TRADES_ON_DAY_OF_EXECUTION"},
+		{"1", "ACCUMULATE_EXECUTIONS_UNTIL_ORDER_IS_FILLED_OR_EXPIRES"},
+		{"2", "ACCUMULATE_UNTIL_VERBALLY_NOTIFIED_OTHERWISE"},
+	},
+	tag.HaltReasonChar: {
+		{"I", "ORDER_IMBALANCE"},
+		{"X", "EQUIPMENT_CHANGEOVER"},
+		{"P", "NEWS_PENDING"},
+		{"D", "NEWS_DISSEMINATION"},
+		{"E", "ORDER_INFLUX"},
+		{"M", "ADDITIONAL_INFORMATION"},
+	},
+	tag.HandlInst: {
+		{"1", "AUTOMATED_EXECUTION_ORDER_PRIVATE_NO_BROKER_INTERVENTION"},
+		{"2", "AUTOMATED_EXECUTION_ORDER_PUBLIC_BROKER_INTERVENTION_OK"},
+		{"3", "MANUAL_ORDER_BEST_EXECUTION"},
+	},
+	tag.IOIQltyInd: {
+		{"L", "LOW"},
+		{"M", "MEDIUM"},
+		{"H", "HIGH"},
+	},
+	tag.IOIQty: {
+		{"S", "SMALL"},
+		{"M", "MEDIUM"},
+		{"L", "LARGE"},
+	},
+	tag.IOIQualifier: {
+		{"A", "ALL_OR_NONE"},
+		{"B", "MARKET_ON_CLOSE"},
+		{"C", "AT_THE_CLOSE"},
+		{"D", "VWAP"},
+		{"I", "IN_TOUCH_WITH"},
+		{"L", "LIMIT"},
+		{"M", "MORE_BEHIND"},
+		{"O", "AT_THE_OPEN"},
+		{"P", "TAKING_A_POSITION"},
+		{"Q", "AT_THE_MARKET"},
+		{"R", "READY_TO_TRADE"},
+		{"S", "PORTFOLIO_SHOWN"},
+		{"T", "THROUGH_THE_DAY"},
+		{"V", "VERSUS"},
+		{"W", "INDICATION"},
+		{"X", "CROSSING_OPPORTUNITY"},
+		{"Y", "AT_THE_MIDPOINT"},
+		{"Z", "PRE_OPEN"},
+	},
+	tag.IOITransType: {
+		{"N", "NEW"},
+		{"C", "CANCEL"},
+		{"R", "REPLACE"},
+	},
+	tag.IncTaxInd: {
+		{"1", "NET"},
+		{"2", "GROSS"},
+	},
+	tag.InstrAttribType: {
+		{"1", "FLAT"},
+		{"2", "ZERO_COUPON"},
+		{"3", "INTEREST_BEARING"},
+		{"4", "NO_PERIODIC_PAYMENTS"},
+		{"5", "VARIABLE_RATE"},
+		{"6", "LESS_FEE_FOR_PUT"},
+		{"7", "STEPPED_COUPON"},
+		{"8", "COUPON_PERIOD"},
+		{"9", "WHEN_AND_IF_ISSUED"},
+		{"10", "ORIGINAL_ISSUE_DISCOUNT"},
+		{"11", "CALLABLE_PUTTABLE"},
+		{"12", "ESCROWED_TO_MATURITY"},
+		{"13", "ESCROWED_TO_REDEMPTION_DATE"},
+		{"14", "PRE_REFUNDED"},
+		{"15", "IN_DEFAULT"},
+		{"16", "UNRATED"},
+		{"17", "TAXABLE"},
+		{"18", "INDEXED"},
+		{"19", "SUBJECT_TO_ALTERNATIVE_MINIMUM_TAX"},
+		{"20", "ORIGINAL_ISSUE_DISCOUNT_PRICE"},
+		{"21", "CALLABLE_BELOW_MATURITY_VALUE"},
+		{"22", "CALLABLE_WITHOUT_NOTICE_BY_MAIL_TO_HOLDER_UNLESS_REGISTERED"},
+		{"99", "TEXT"},
+	},
+	tag.InstrRegistry: {
+		{"BIC", "CUSTODIAN"},
+		{"ISO_Country_Code", "COUNTRY"},
+		{"ZZ", "PHYSICAL"},
+	},
+	tag.LastCapacity: {
+		{"1", "AGENT"},
+		{"2", "CROSS_AS_AGENT"},
+		{"3", "CROSS_AS_PRINCIPAL"},
+		{"4", "PRINCIPAL"},
+	},
+	tag.LastLiquidityInd: {
+		{"1", "ADDED_LIQUIDITY"},
+		{"2", "REMOVED_LIQUIDITY"},
+		{"3", "LIQUIDITY_ROUTED_OUT"},
+	},
+	tag.LegSwapType: {
+		{"1", "PAR_FOR_PAR"},
+		{"2", "MODIFIED_DURATION"},
+		{"4", "RISK"},
+		{"5", "PROCEEDS"},
+	},
+	tag.LiquidityIndType: {
+		{"1", "5_DAY_MOVING_AVERAGE"},
+		{"2", "20_DAY_MOVING_AVERAGE"},
+		{"3", "NORMAL_MARKET_SIZE"},
+		{"4", "OTHER"},
+	},
+	tag.ListExecInstType: {
+		{"1", "IMMEDIATE"},
+		{"2", "WAIT_FOR_EXECUTE_INSTRUCTION"},
+		{"3", "EXCHANGE_SWITCH_CIV_ORDER_SELL_DRIVEN"},
+		{"4", "EXCHANGE_SWITCH_CIV_ORDER_BUY_DRIVEN_CASH_TOP_UP"},
+		{"5", "EXCHANGE_SWITCH_CIV_ORDER_BUY_DRIVEN_CASH_WITHDRAW"},
+	},
+	tag.ListOrderStatus: {
+		{"1", "IN_BIDDING_PROCESS"},
+		{"2", "RECEIVED_FOR_EXECUTION"},
+		{"3", "EXECUTING"},
+		{"4", "CANCELLING"},
+		{"5", "ALERT"},
+		{"6", "ALL_DONE"},
+		{"7", "REJECT"},
+	},
+	tag.ListStatusType: {
+		{"1", "ACK"},
+		{"2", "RESPONSE"},
+		{"3", "TIMED"},
+		{"4", "EXEC_STARTED"},
+		{"5", "ALL_DONE"},
+		{"6", "ALERT"},
+	},
+	tag.MDEntryType: {
+		{"0", "BID"},
+		{"1", "OFFER"},
+		{"2", "TRADE"},
+		{"3", "INDEX_VALUE"},
+		{"4", "OPENING_PRICE"},
+		{"5", "CLOSING_PRICE"},
+		{"6", "SETTLEMENT_PRICE"},
+		{"7", "TRADING_SESSION_HIGH_PRICE"},
+		{"8", "TRADING_SESSION_LOW_PRICE"},
+		{"9", "TRADING_SESSION_VWAP_PRICE"},
+		{"A", "IMBALANCE"},
+		{"B", "TRADE_VOLUME"},
+		{"C", "OPEN_INTEREST"},
+	},
+	tag.MDReqRejReason: {
+		{"0", "UNKNOWN_SYMBOL"},
+		{"1", "DUPLICATE_MDREQID"},
+		{"2", "INSUFFICIENT_BANDWIDTH"},
+		{"3", "INSUFFICIENT_PERMISSIONS"},
+		{"4", "UNSUPPORTED_SUBSCRIPTIONREQUESTTYPE"},
+		{"5", "UNSUPPORTED_MARKETDEPTH"},
+		{"6", "UNSUPPORTED_MDUPDATETYPE"},
+		{"7", "UNSUPPORTED_AGGREGATEDBOOK"},
+		{"8", "UNSUPPORTED_MDENTRYTYPE"},
+		{"9", "UNSUPPORTED_TRADINGSESSIONID"},
+		{"A", "UNSUPPORTED_SCOPE"},
+		{"B", "UNSUPPORTED_OPENCLOSESETTLEFLAG"},
+		{"C", "UNSUPPORTED_MDIMPLICITDELETE"},
+	},
+	tag.MDUpdateAction: {
+		{"0", "NEW"},
+		{"1", "CHANGE"},
+		{"2", "DELETE"},
+	},
+	tag.MDUpdateType: {
+		{"0", "FULL_REFRESH"},
+		{"1", "INCREMENTAL_REFRESH"},
+	},
+	tag.MassCancelRejectReason: {
+		{"0", "MASS_CANCEL_NOT_SUPPORTED"},
+		{"1", "INVALID_OR_UNKNOWN_SECURITY"},
+		{"2", "INVALID_OR_UNKNOWN_UNDERLYING"},
+		{"3", "INVALID_OR_UNKNOWN_PRODUCT"},
+		{"4", "INVALID_OR_UNKNOWN_CFICODE"},
+		{"5", "INVALID_OR_UNKNOWN_SECURITY_TYPE"},
+		{"6", "INVALID_OR_UNKNOWN_TRADING_SESSION"},
+		{"99", "OTHER"},
+	},
+	tag.MassCancelRequestType: {
+		{"1", "CANCEL_ORDERS_FOR_A_SECURITY"},
+		{"2", "CANCEL_ORDERS_FOR_AN_UNDERLYING_SECURITY"},
+		{"3", "CANCEL_ORDERS_FOR_A_PRODUCT"},
+		{"4", "CANCEL_ORDERS_FOR_A_CFICODE"},
+		{"5", "CANCEL_ORDERS_FOR_A_SECURITYTYPE"},
+		{"6", "CANCEL_ORDERS_FOR_A_TRADING_SESSION"},
+		{"7", "CANCEL_ALL_ORDERS"},
+	},
+	tag.MassCancelResponse: {
+		{"0", "CANCEL_REQUEST_REJECTED"},
+		{"1", "CANCEL_ORDERS_FOR_A_SECURITY"},
+		{"2", "CANCEL_ORDERS_FOR_AN_UNDERLYING_SECURITY"},
+		{"3", "CANCEL_ORDERS_FOR_A_PRODUCT"},
+		{"4", "CANCEL_ORDERS_FOR_A_CFICODE"},
+		{"5", "CANCEL_ORDERS_FOR_A_SECURITYTYPE"},
+		{"6", "CANCEL_ORDERS_FOR_A_TRADING_SESSION"},
+		{"7", "CANCEL_ALL_ORDERS"},
+	},
+	tag.MassStatusReqType: {
+		{"1", "STATUS_FOR_ORDERS_FOR_A_SECURITY"},
+		{"2", "STATUS_FOR_ORDERS_FOR_AN_UNDERLYING_SECURITY"},
+		{"3", "STATUS_FOR_ORDERS_FOR_A_PRODUCT"},
+		{"4", "STATUS_FOR_ORDERS_FOR_A_CFICODE"},
+		{"5", "STATUS_FOR_ORDERS_FOR_A_SECURITYTYPE"},
+		{"6", "STATUS_FOR_ORDERS_FOR_A_TRADING_SESSION"},
+		{"7", "STATUS_FOR_ALL_ORDERS"},
+		{"8", "STATUS_FOR_ORDERS_FOR_A_PARTYID"},
+	},
+	tag.MatchStatus: {
+		{"0", "COMPARED_MATCHED_OR_AFFIRMED"},
+		{"1", "UNCOMPARED_UNMATCHED_OR_UNAFFIRMED"},
+		{"2", "ADVISORY_OR_ALERT"},
+	},
+	tag.MatchType: {
+		{"A1", "EXACT_MATCH_ON_TRADE_DATE_STOCK_SYMBOL_QUANTITY_PRICE_TRADE_TYPE_AND_SPECIAL_TRADE_INDICATOR_PLUS_FOUR_BADGES_AND_EXECUTION_TIME"},
+		{"A2", "EXACT_MATCH_ON_TRADE_DATE_STOCK_SYMBOL_QUANTITY_PRICE_TRADE_TYPE_AND_SPECIAL_TRADE_INDICATOR_PLUS_FOUR_BADGES"},
+		{"A3", "EXACT_MATCH_ON_TRADE_DATE_STOCK_SYMBOL_QUANTITY_PRICE_TRADE_TYPE_AND_SPECIAL_TRADE_INDICATOR_PLUS_TWO_BADGES_AND_EXECUTION_TIME"},
+		{"A4", "EXACT_MATCH_ON_TRADE_DATE_STOCK_SYMBOL_QUANTITY_PRICE_TRADE_TYPE_AND_SPECIAL_TRADE_INDICATOR_PLUS_TWO_BADGES"},
+		{"A5", "EXACT_MATCH_ON_TRADE_DATE_STOCK_SYMBOL_QUANTITY_PRICE_TRADE_TYPE_AND_SPECIAL_TRADE_INDICATOR_PLUS_EXECUTION_TIME"},
+		{"AQ", "COMPARED_RECORDS_RESULTING_FROM_STAMPED_ADVISORIES_OR_SPECIALIST_ACCEPTS_PAIR_OFFS"},
+		{"S1", "SUMMARIZED_MATCH_USING_A1_EXACT_MATCH_CRITERIA_EXCEPT_QUANTITY_IS_SUMMARIZED"},
+		{"S2", "SUMMARIZED_MATCH_USING_A2_EXACT_MATCH_CRITERIA_EXCEPT_QUANTITY_IS_SUMMARIZED"},
+		{"S3", "SUMMARIZED_MATCH_USING_A3_EXACT_MATCH_CRITERIA_EXCEPT_QUANTITY_IS_SUMMARIZED"},
+		{"S4", "SUMMARIZED_MATCH_USING_A4_EXACT_MATCH_CRITERIA_EXCEPT_QUANTITY_IS_SUMMARIZED"},
+		{"S5", "SUMMARIZED_MATCH_USING_A5_EXACT_MATCH_CRITERIA_EXCEPT_QUANTITY_IS_SUMMARIZED"},
+		{"M1", "EXACT_MATCH_ON_TRADE_DATE_STOCK_SYMBOL_QUANTITY_PRICE_TRADE_TYPE_AND_SPECIAL_TRADE_INDICATOR_MINUS_BADGES_AND_TIMES"},
+		{"M2", "SUMMARIZED_MATCH_MINUS_BADGES_AND_TIMES"},
+		{"MT", "OCS_LOCKED_IN"},
+		{"M3", "ACT_ACCEPTED_TRADE"},
+		{"M4", "ACT_DEFAULT_TRADE"},
+		{"M5", "ACT_DEFAULT_AFTER_M2"},
+		{"M6", "ACT_M6_MATCH"},
+	},
+	tag.MessageEncoding: {
+		{"ISO-2022-JP", "ISO_2022_JP"},
+		{"EUC-JP", "EUC_JP"},
+		{"Shift_JIS", "SHIFT_JIS"},
+		{"UTF-8", "UTF_8"},
+	},
+	tag.MiscFeeBasis: {
+		{"0", "ABSOLUTE"},
+		{"1", "PER_UNIT"},
+		{"2", "PERCENTAGE"},
+	},
+	tag.MiscFeeType: {
+		{"1", "REGULATORY"},
+		{"2", "TAX"},
+		{"3", "LOCAL_COMMISSION"},
+		{"4", "EXCHANGE_FEES"},
+		{"5", "STAMP"},
+		{"6", "LEVY"},
+		{"7", "OTHER"},
+		{"8", "MARKUP"},
+		{"9", "CONSUMPTION_TAX"},
+		{"10", "PER_TRANSACTION"},
+		{"11", "CONVERSION"},
+		{"12", "AGENT"},
+	},
+	tag.MoneyLaunderingStatus: {
+		{"Y", "PASSED"},
+		{"N", "NOT_CHECKED"},
+		{"1", "EXEMPT_BELOW_THE_LIMIT"},
+		{"2", "EXEMPT_CLIENT_MONEY_TYPE_EXEMPTION"},
+		{"3", "EXEMPT_AUTHORISED_CREDIT_OR_FINANCIAL_INSTITUTION"},
+	},
+	tag.MsgDirection: {
+		{"S", "SEND"},
+		{"R", "RECEIVE"},
+	},
+	tag.MsgType: {
+		{"0", "HEARTBEAT"},
+		{"1", "TEST_REQUEST"},
+		{"2", "RESEND_REQUEST"},
+		{"3", "REJECT"},
+		{"4", "SEQUENCE_RESET"},
+		{"5", "LOGOUT"},
+		{"6", "INDICATION_OF_INTEREST"},
+		{"7", "ADVERTISEMENT"},
+		{"8", "EXECUTION_REPORT"},
+		{"9", "ORDER_CANCEL_REJECT"},
+		{"A", "LOGON"},
+		{"B", "NEWS"},
+		{"C", "EMAIL"},
+		{"D", "ORDER_SINGLE"},
+		{"E", "ORDER_LIST"},
+		{"F", "ORDER_CANCEL_REQUEST"},
+		{"G", "ORDER_CANCEL_REPLACE_REQUEST"},
+		{"H", "ORDER_STATUS_REQUEST"},
+		{"J", "ALLOCATION_INSTRUCTION"},
+		{"K", "LIST_CANCEL_REQUEST"},
+		{"L", "LIST_EXECUTE"},
+		{"M", "LIST_STATUS_REQUEST"},
+		{"N", "LIST_STATUS"},
+		{"P", "ALLOCATION_INSTRUCTION_ACK"},
+		{"Q", "DONT_KNOW_TRADE"},
+		{"R", "QUOTE_REQUEST"},
+		{"S", "QUOTE"},
+		{"T", "SETTLEMENT_INSTRUCTIONS"},
+		{"V", "MARKET_DATA_REQUEST"},
+		{"W", "MARKET_DATA_SNAPSHOT_FULL_REFRESH"},
+		{"X", "MARKET_DATA_INCREMENTAL_REFRESH"},
+		{"Y", "MARKET_DATA_REQUEST_REJECT"},
+		{"Z", "QUOTE_CANCEL"},
+		{"a", "QUOTE_STATUS_REQUEST"},
+		{"b", "MASS_QUOTE_ACKNOWLEDGEMENT"},
+		{"c", "SECURITY_DEFINITION_REQUEST"},
+		{"d", "SECURITY_DEFINITION"},
+		{"e", "SECURITY_STATUS_REQUEST"},
+		{"f", "SECURITY_STATUS"},
+		{"g", "TRADING_SESSION_STATUS_REQUEST"},
+		{"h", "TRADING_SESSION_STATUS"},
+		{"i", "MASS_QUOTE"},
+		{"j", "BUSINESS_MESSAGE_REJECT"},
+		{"k", "BID_REQUEST"},
+		{"l", "BID_RESPONSE"},
+		{"m", "LIST_STRIKE_PRICE"},
+		{"n", "XML_MESSAGE"},
+		{"o", "REGISTRATION_INSTRUCTIONS"},
+		{"p", "REGISTRATION_INSTRUCTIONS_RESPONSE"},
+		{"q", "ORDER_MASS_CANCEL_REQUEST"},
+		{"r", "ORDER_MASS_CANCEL_REPORT"},
+		{"s", "NEW_ORDER_CROSS"},
+		{"t", "CROSS_ORDER_CANCEL_REPLACE_REQUEST"},
+		{"u", "CROSS_ORDER_CANCEL_REQUEST"},
+		{"v", "SECURITY_TYPE_REQUEST"},
+		{"w", "SECURITY_TYPES"},
+		{"x", "SECURITY_LIST_REQUEST"},
+		{"y", "SECURITY_LIST"},
+		{"z", "DERIVATIVE_SECURITY_LIST_REQUEST"},
+		{"AA", "DERIVATIVE_SECURITY_LIST"},
+		{"AB", "NEW_ORDER_MULTILEG"},
+		{"AC", "MULTILEG_ORDER_CANCEL_REPLACE"},
+		{"AD", "TRADE_CAPTURE_REPORT_REQUEST"},
+		{"AE", "TRADE_CAPTURE_REPORT"},
+		{"AF", "ORDER_MASS_STATUS_REQUEST"},
+		{"AG", "QUOTE_REQUEST_REJECT"},
+		{"AH", "RFQ_REQUEST"},
+		{"AI", "QUOTE_STATUS_REPORT"},
+		{"AJ", "QUOTE_RESPONSE"},
+		{"AK", "CONFIRMATION"},
+		{"AL", "POSITION_MAINTENANCE_REQUEST"},
+		{"AM", "POSITION_MAINTENANCE_REPORT"},
+		{"AN", "REQUEST_FOR_POSITIONS"},
+		{"AO", "REQUEST_FOR_POSITIONS_ACK"},
+		{"AP", "POSITION_REPORT"},
+		{"AQ", "TRADE_CAPTURE_REPORT_REQUEST_ACK"},
+		{"AR", "TRADE_CAPTURE_REPORT_ACK"},
+		{"AS", "ALLOCATION_REPORT"},
+		{"AT", "ALLOCATION_REPORT_ACK"},
+		{"AU", "CONFIRMATION_ACK"},
+		{"AV", "SETTLEMENT_INSTRUCTION_REQUEST"},
+		{"AW", "ASSIGNMENT_REPORT"},
+		{"AX", "COLLATERAL_REQUEST"},
+		{"AY", "COLLATERAL_ASSIGNMENT"},
+		{"AZ", "COLLATERAL_RESPONSE"},
+		{"BA", "COLLATERAL_REPORT"},
+		{"BB", "COLLATERAL_INQUIRY"},
+		{"BC", "NETWORK_COUNTERPARTY_SYSTEM_STATUS_REQUEST"},
+		{"BD", "NETWORK_COUNTERPARTY_SYSTEM_STATUS_RESPONSE"},
+		{"BE", "USER_REQUEST"},
+		{"BF", "USER_RESPONSE"},
+		{"BG", "COLLATERAL_INQUIRY_ACK"},
+		{"BH", "CONFIRMATION_REQUEST"},
+	},
+	tag.MultiLegReportingType: {
+		{"1", "SINGLE_SECURITY"},
+		{"2", "INDIVIDUAL_LEG_OF_A_MULTI_LEG_SECURITY"},
+		{"3", "MULTI_LEG_SECURITY"},
+	},
+	tag.MultiLegRptTypeReq: {
+		{"0", "REPORT_BY_MULITLEG_SECURITY_ONLY"},
+		{"1", "REPORT_BY_MULTILEG_SECURITY_AND_BY_INSTRUMENT_LEGS_BELONGING_TO_THE_MULTILEG_SECURITY"},
+		{"2", "REPORT_BY_INSTRUMENT_LEGS_BELONGING_TO_THE_MULTILEG_SECURITY_ONLY"},
+	},
+	tag.NetGrossInd: {
+		{"1", "NET"},
+		{"2", "GROSS"},
+	},
+	tag.NetworkRequestType: {
+		{"1", "SNAPSHOT"},
+		{"2", "SUBSCRIBE"},
+		{"4", "STOP_SUBSCRIBING"},
+		{"8", "LEVEL_OF_DETAIL"},
+	},
+	tag.NetworkStatusResponseType: {
+		{"1", "FULL"},
+		{"2", "INCREMENTAL_UPDATE"},
+	},
+	tag.OpenCloseSettlFlag: {
+		{"0", "DAILY_OPEN"},
+		{"1", "SESSION_OPEN"},
+		{"2", "DELIVERY_SETTLEMENT_ENTRY"},
+		{"3", "EXPECTED_ENTRY"},
+		{"4", "ENTRY_FROM_PREVIOUS_BUSINESS_DAY"},
+		{"5", "THEORETICAL_PRICE_VALUE"},
+	},
+	tag.OrdRejReason: {
+		{"0", "BROKER_CREDIT"},
+		{"1", "UNKNOWN_SYMBOL"},
+		{"2", "EXCHANGE_CLOSED"},
+		{"3", "ORDER_EXCEEDS_LIMIT"},
+		{"4", "TOO_LATE_TO_ENTER"},
+		{"5", "UNKNOWN_ORDER"},
+		{"6", "DUPLICATE_ORDER"},
+		{"7", "DUPLICATE_OF_A_VERBALLY_COMMUNICATED_ORDER"},
+		{"8", "STALE_ORDER"},
+		{"9", "TRADE_ALONG_REQUIRED"},
+		{"10", "INVALID_INVESTOR_ID"},
+		{"11", "UNSUPPORTED_ORDER_CHARACTERISTIC"},
+		{"12", "SURVEILLENCE_OPTION"},
+		{"13", "INCORRECT_QUANTITY"},
+		{"14", "INCORRECT_ALLOCATED_QUANTITY"},
+		{"15", "UNKNOWN_ACCOUNT"},
+		{"99", "OTHER"},
+	},
+	tag.OrdStatus: {
+		{"0", "NEW"},
+		{"1", "PARTIALLY_FILLED"},
+		{"2", "FILLED"},
+		{"3", "DONE_FOR_DAY"},
+		{"4", "CANCELED"},
+		{"5", "REPLACED"},
+		{"6", "PENDING_CANCEL"},
+		{"7", "STOPPED"},
+		{"8", "REJECTED"},
+		{"9", "SUSPENDED"},
+		{"A", "PENDING_NEW"},
+		{"B", "CALCULATED"},
+		{"C", "EXPIRED"},
+		{"D", "ACCEPTED_FOR_BIDDING"},
+		{"E", "PENDING_REPLACE"},
+	},
+	tag.OrdType: {
+		{"1", "MARKET"},
+		{"2", "LIMIT"},
+		{"3", "STOP"},
+		{"4", "STOP_LIMIT"},
+		{"6", "WITH_OR_WITHOUT"},
+		{"7", "LIMIT_OR_BETTER"},
+		{"8", "LIMIT_WITH_OR_WITHOUT"},
+		{"9", "ON_BASIS"},
+		{"D", "PREVIOUSLY_QUOTED"},
+		{"E", "PREVIOUSLY_INDICATED"},
+		{"G", "FOREX_SWAP"},
+		{"I", "FUNARI"},
+		{"J", "MARKET_IF_TOUCHED"},
+		{"K", "MARKET_WITH_LEFTOVER_AS_LIMIT"},
+		{"L", "PREVIOUS_FUND_VALUATION_POINT"},
+		{"M", "NEXT_FUND_VALUATION_POINT"},
+		{"P", "PEGGED"},
+	},
+	tag.OrderCapacity: {
+		{"A", "AGENCY"},
+		{"G", "PROPRIETARY"},
+		{"I", "INDIVIDUAL"},
+		{"P", "PRINCIPAL"},
+		{"R", "RISKLESS_PRINCIPAL"},
+		{"W", "AGENT_FOR_OTHER_MEMBER"},
+	},
+	tag.OrderRestrictions: {
+		{"1", "PROGRAM_TRADE"},
+		{"2", "INDEX_ARBITRAGE"},
+		{"3", "NON_INDEX_ARBITRAGE"},
+		{"4", "COMPETING_MARKET_MAKER"},
+		{"5", "ACTING_AS_MARKET_MAKER_OR_SPECIALIST_IN_THE_SECURITY"},
+		{"6", "ACTING_AS_MARKET_MAKER_OR_SPECIALIST_IN_THE_UNDERLYING_SECURITY_OF_A_DERIVATIVE_SECURITY"},
+		{"7", "FOREIGN_ENTITY"},
+		{"8", "EXTERNAL_MARKET_PARTICIPANT"},
+		{"9", "EXTERNAL_INTER_CONNECTED_MARKET_LINKAGE"},
+		{"A", "RISKLESS_ARBITRAGE"},
+	},
+	tag.OwnerType: {
+		{"1", "INDIVIDUAL_INVESTOR"},
+		{"2", "PUBLIC_COMPANY"},
+		{"3", "PRIVATE_COMPANY"},
+		{"4", "INDIVIDUAL_TRUSTEE"},
+		{"5", "COMPANY_TRUSTEE"},
+		{"6", "PENSION_PLAN"},
+		{"7", "CUSTODIAN_UNDER_GIFTS_TO_MINORS_ACT"},
+		{"8", "TRUSTS"},
+		{"9", "FIDUCIARIES"},
+		{"10", "NETWORKING_SUB_ACCOUNT"},
+		{"11", "NON_PROFIT_ORGANIZATION"},
+		{"12", "CORPORATE_BODY"},
+		{"13", "NOMINEE"},
+	},
+	tag.OwnershipType: {
+		{"J", "JOINT_INVESTORS"},
+		{"T", "TENANTS_IN_COMMON"},
+		{"2", "JOINT_TRUSTEES"},
+	},
+	tag.PartyIDSource: {
+		{"B", "BIC"},
+		{"C", "GENERALLY_ACCEPTED_MARKET_PARTICIPANT_IDENTIFIER"},
+		{"D", "PROPRIETARY_CUSTOM_CODE"},
+		{"E", "ISO_COUNTRY_CODE"},
+		{"F", "SETTLEMENT_ENTITY_LOCATION"},
+		{"G", "MIC"},
+		{"H", "CSD_PARTICIPANT_MEMBER_CODE"},
+		{"I", "DIRECTED_BROKER"},
+		{"1", "KOREAN_INVESTOR_ID"},
+		{"2", "TAIWANESE_QUALIFIED_FOREIGN_INVESTOR_ID_QFII_FID"},
+		{"3", "TAIWANESE_TRADING_ACCOUNT"},
+		{"4", "MALAYSIAN_CENTRAL_DEPOSITORY"},
+		{"5", "CHINESE_B_SHARE"},
+		{"6", "UK_NATIONAL_INSURANCE_OR_PENSION_NUMBER"},
+		{"7", "US_SOCIAL_SECURITY_NUMBER"},
+		{"8", "US_EMPLOYER_IDENTIFICATION_NUMBER"},
+		{"9", "AUSTRALIAN_BUSINESS_NUMBER"},
+		{"A", "AUSTRALIAN_TAX_FILE_NUMBER"},
+	},
+	tag.PartyRole: {
+		{"1", "EXECUTING_FIRM"},
+		{"2", "BROKER_OF_CREDIT"},
+		{"3", "CLIENT_ID"},
+		{"4", "CLEARING_FIRM"},
+		{"5", "INVESTOR_ID"},
+		{"6", "INTRODUCING_FIRM"},
+		{"7", "ENTERING_FIRM"},
+		{"8", "LOCATE"},
+		{"9", "FUND_MANAGER_CLIENT_ID"},
+		{"10", "SETTLEMENT_LOCATION"},
+		{"11", "ORDER_ORIGINATION_TRADER"},
+		{"12", "EXECUTING_TRADER"},
+		{"13", "ORDER_ORIGINATION_FIRM"},
+		{"14", "GIVEUP_CLEARING_FIRM"},
+		{"15", "CORRESPONDANT_CLEARING_FIRM"},
+		{"16", "EXECUTING_SYSTEM"},
+		{"17", "CONTRA_FIRM"},
+		{"18", "CONTRA_CLEARING_FIRM"},
+		{"19", "SPONSORING_FIRM"},
+		{"20", "UNDERLYING_CONTRA_FIRM"},
+		{"21", "CLEARING_ORGANIZATION"},
+		{"22", "EXCHANGE"},
+		{"24", "CUSTOMER_ACCOUNT"},
+		{"25", "CORRESPONDENT_CLEARING_ORGANIZATION"},
+		{"26", "CORRESPONDENT_BROKER"},
+		{"27", "BUYER_SELLER"},
+		{"28", "CUSTODIAN"},
+		{"29", "INTERMEDIARY"},
+		{"30", "AGENT"},
+		{"31", "SUB_CUSTODIAN"},
+		{"32", "BENEFICIARY"},
+		{"33", "INTERESTED_PARTY"},
+		{"34", "REGULATORY_BODY"},
+		{"35", "LIQUIDITY_PROVIDER"},
+		{"36", "ENTERING_TRADER"},
+		{"37", "CONTRA_TRADER"},
+		{"38", "POSITION_ACCOUNT"},
+	},
+	tag.PartySubIDType: {
+		{"1", "FIRM"},
+		{"2", "PERSON"},
+		{"3", "SYSTEM"},
+		{"4", "APPLICATION"},
+		{"5", "FULL_LEGAL_NAME_OF_FIRM"},
+		{"6", "POSTAL_ADDRESS"},
+		{"7", "PHONE_NUMBER"},
+		{"8", "EMAIL_ADDRESS"},
+		{"9", "CONTACT_NAME"},
+		{"10", "SECURITIES_ACCOUNT_NUMBER"},
+		{"11", "REGISTRATION_NUMBER"},
+		{"12", "REGISTERED_ADDRESS_12"},
+		{"13", "REGULATORY_STATUS"},
+		{"14", "REGISTRATION_NAME"},
+		{"15", "CASH_ACCOUNT_NUMBER"},
+		{"16", "BIC"},
+		{"17", "CSD_PARTICIPANT_MEMBER_CODE"},
+		{"18", "REGISTERED_ADDRESS_18"},
+		{"19", "FUND_ACCOUNT_NAME"},
+		{"20", "TELEX_NUMBER"},
+		{"21", "FAX_NUMBER"},
+		{"22", "SECURITIES_ACCOUNT_NAME"},
+		{"23", "CASH_ACCOUNT_NAME"},
+		{"24", "DEPARTMENT"},
+		{"25", "LOCATION_DESK"},
+		{"26", "POSITION_ACCOUNT_TYPE"},
+	},
+	tag.PaymentMethod: {
+		{"1", "CREST"},
+		{"2", "NSCC"},
+		{"3", "EUROCLEAR"},
+		{"4", "CLEARSTREAM"},
+		{"5", "CHEQUE"},
+		{"6", "TELEGRAPHIC_TRANSFER"},
+		{"7", "FEDWIRE"},
+		{"8", "DEBIT_CARD"},
+		{"9", "DIRECT_DEBIT"},
+		{"10", "DIRECT_CREDIT"},
+		{"11", "CREDIT_CARD"},
+		{"12", "ACH_DEBIT"},
+		{"13", "ACH_CREDIT"},
+		{"14", "BPAY"},
+		{"15", "HIGH_VALUE_CLEARING_SYSTEM"},
+	},
+	tag.PegLimitType: {
+		{"0", "OR_BETTER"},
+		{"1", "STRICT"},
+		{"2", "OR_WORSE"},
+	},
+	tag.PegMoveType: {
+		{"0", "FLOATING"},
+		{"1", "FIXED"},
+	},
+	tag.PegOffsetType: {
+		{"0", "PRICE"},
+		{"1", "BASIS_POINTS"},
+		{"2", "TICKS"},
+		{"3", "PRICE_TIER"},
+	},
+	tag.PegRoundDirection: {
+		{"1", "MORE_AGGRESSIVE"},
+		{"2", "MORE_PASSIVE"},
+	},
+	tag.PegScope: {
+		{"1", "LOCAL"},
+		{"2", "NATIONAL"},
+		{"3", "GLOBAL"},
+		{"4", "NATIONAL_EXCLUDING_LOCAL"},
+	},
+	tag.PosAmtType: {
+		{"FMTM", "FINAL_MARK_TO_MARKET_AMOUNT"},
+		{"IMTM", "INCREMENTAL_MARK_TO_MARKET_AMOUNT"},
+		{"TVAR", "TRADE_VARIATION_AMOUNT"},
+		{"SMTM", "START_OF_DAY_MARK_TO_MARKET_AMOUNT"},
+		{"PREM", "PREMIUM_AMOUNT"},
+		{"CRES", "CASH_RESIDUAL_AMOUNT"},
+		{"CASH", "CASH_AMOUNT"},
+		{"VADJ", "VALUE_ADJUSTED_AMOUNT"},
+	},
+	tag.PosMaintAction: {
+		{"1", "NEW"},
+		{"2", "REPLACE"},
+		{"3", "CANCEL"},
+	},
+	tag.PosMaintResult: {
+		{"0", "SUCCESSFUL_COMPLETION"},
+		{"1", "REJECTED"},
+		{"99", "OTHER"},
+	},
+	tag.PosMaintStatus: {
+		{"0", "ACCEPTED"},
+		{"1", "ACCEPTED_WITH_WARNINGS"},
+		{"2", "REJECTED"},
+		{"3", "COMPLETED"},
+		{"4", "COMPLETED_WITH_WARNINGS"},
+	},
+	tag.PosQtyStatus: {
+		{"0", "SUBMITTED"},
+		{"1", "ACCEPTED"},
+		{"2", "REJECTED"},
+	},
+	tag.PosReqResult: {
+		{"0", "VALID_REQUEST"},
+		{"1", "INVALID_OR_UNSUPPORTED_REQUEST"},
+		{"2", "NO_POSITIONS_FOUND_THAT_MATCH_CRITERIA"},
+		{"3", "NOT_AUTHORIZED_TO_REQUEST_POSITIONS"},
+		{"4", "REQUEST_FOR_POSITION_NOT_SUPPORTED"},
+		{"99", "OTHER"},
+	},
+	tag.PosReqStatus: {
+		{"0", "COMPLETED"},
+		{"1", "COMPLETED_WITH_WARNINGS"},
+		{"2", "REJECTED"},
+	},
+	tag.PosReqType: {
+		{"0", "POSITIONS"},
+		{"1", "TRADES"},
+		{"2", "EXERCISES"},
+		{"3", "ASSIGNMENTS"},
+	},
+	tag.PosTransType: {
+		{"1", "EXERCISE"},
+		{"2", "DO_NOT_EXERCISE"},
+		{"3", "POSITION_ADJUSTMENT"},
+		{"4", "POSITION_CHANGE_SUBMISSION_MARGIN_DISPOSITION"},
+		{"5", "PLEDGE"},
+	},
+	tag.PosType: {
+		{"TQ", "TRANSACTION_QUANTITY"},
+		{"IAS", "INTRA_SPREAD_QTY"},
+		{"IES", "INTER_SPREAD_QTY"},
+		{"FIN", "END_OF_DAY_QTY"},
+		{"SOD", "START_OF_DAY_QTY"},
+		{"EX", "OPTION_EXERCISE_QTY"},
+		{"AS", "OPTION_ASSIGNMENT"},
+		{"TX", "TRANSACTION_FROM_EXERCISE"},
+		{"TA", "TRANSACTION_FROM_ASSIGNMENT"},
+		{"PIT", "PIT_TRADE_QTY"},
+		{"TRF", "TRANSFER_TRADE_QTY"},
+		{"ETR", "ELECTRONIC_TRADE_QTY"},
+		{"ALC", "ALLOCATION_TRADE_QTY"},
+		{"PA", "ADJUSTMENT_QTY"},
+		{"ASF", "AS_OF_TRADE_QTY"},
+		{"DLV", "DELIVERY_QTY"},
+		{"TOT", "TOTAL_TRANSACTION_QTY"},
+		{"XM", "CROSS_MARGIN_QTY"},
+		{"SPL", "INTEGRAL_SPLIT"},
+	},
+	tag.PositionEffect: {
+		{"O", "OPEN"},
+		{"C", "CLOSE"},
+		{"R", "ROLLED"},
+		{"F", "FIFO"},
+	},
+	tag.PreallocMethod: {
+		{"0", "PRO_RATA"},
+		{"1", "DO_NOT_PRO_RATA"},
+	},
+	tag.PriceType: {
+		{"1", "PERCENTAGE"},
+		{"2", "PER_UNIT"},
+		{"3", "FIXED_AMOUNT"},
+		{"4", "DISCOUNT"},
+		{"5", "PREMIUM"},
+		{"6", "SPREAD"},
+		{"7", "TED_PRICE"},
+		{"8", "TED_YIELD"},
+		{"9", "YIELD"},
+		{"10", "FIXED_CABINET_TRADE_PRICE"},
+		{"11", "VARIABLE_CABINET_TRADE_PRICE"},
+	},
+	tag.PriorityIndicator: {
+		{"0", "PRIORITY_UNCHANGED"},
+		{"1", "LOST_PRIORITY_AS_RESULT_OF_ORDER_CHANGE"},
+	},
+	tag.ProcessCode: {
+		{"0", "REGULAR"},
+		{"1", "SOFT_DOLLAR"},
+		{"2", "STEP_IN"},
+		{"3", "STEP_OUT"},
+		{"4", "SOFT_DOLLAR_STEP_IN"},
+		{"5", "SOFT_DOLLAR_STEP_OUT"},
+		{"6", "PLAN_SPONSOR"},
+	},
+	tag.Product: {
+		{"1", "AGENCY"},
+		{"2", "COMMODITY"},
+		{"3", "CORPORATE"},
+		{"4", "CURRENCY"},
+		{"5", "EQUITY"},
+		{"6", "GOVERNMENT"},
+		{"7", "INDEX"},
+		{"8", "LOAN"},
+		{"9", "MONEYMARKET"},
+		{"10", "MORTGAGE"},
+		{"11", "MUNICIPAL"},
+		{"12", "OTHER"},
+		{"13", "FINANCING"},
+	},
+	tag.ProgRptReqs: {
+		{"1", "BUYSIDE_EXPLICITLY_REQUESTS_STATUS_USING_STATUSREQUEST"},
+		{"2", "SELLSIDE_PERIODICALLY_SENDS_STATUS_USING_LISTSTATUS"},
+		{"3", "REAL_TIME_EXECUTION_REPORTS"},
+	},
+	tag.QtyType: {
+		{"0", "UNITS"},
+		{"1", "CONTRACTS"},
+	},
+	tag.QuoteCancelType: {
+		{"1", "CANCEL_FOR_ONE_OR_MORE_SECURITIES"},
+		{"2", "CANCEL_FOR_SECURITY_TYPE"},
+		{"3", "CANCEL_FOR_UNDERLYING_SECURITY"},
+		{"4", "CANCEL_ALL_QUOTES"},
+	},
+	tag.QuoteCondition: {
+		{"A", "OPEN"},
+		{"B", "CLOSED"},
+		{"C", "EXCHANGE_BEST"},
+		{"D", "CONSOLIDATED_BEST"},
+		{"E", "LOCKED"},
+		{"F", "CROSSED"},
+		{"G", "DEPTH"},
+		{"H", "FAST_TRADING"},
+		{"I", "NON_FIRM"},
+	},
+	tag.QuoteEntryRejectReason: {
+		{"1", "UNKNOWN_SYMBOL"},
+		{"2", "EXCHANGE_CLOSED"},
+		{"3", "QUOTE_EXCEEDS_LIMIT"},
+		{"4", "TOO_LATE_TO_ENTER"},
+		{"5", "UNKNOWN_QUOTE"},
+		{"6", "DUPLICATE_QUOTE"},
+		{"7", "INVALID_BID_ASK_SPREAD"},
+		{"8", "INVALID_PRICE"},
+		{"9", "NOT_AUTHORIZED_TO_QUOTE_SECURITY"},
+		{"99", "OTHER"},
+	},
+	tag.QuotePriceType: {
+		{"1", "PERCENT"},
+		{"2", "PER_SHARE"},
+		{"3", "FIXED_AMOUNT"},
+		{"4", "DISCOUNT"},
+		{"5", "PREMIUM"},
+		{"6", "BASIS_POINTS_RELATIVE_TO_BENCHMARK"},
+		{"7", "TED_PRICE"},
+		{"8", "TED_YIELD"},
+		{"9", "YIELD_SPREAD"},
+		{"10", "YIELD"},
+	},
+	tag.QuoteRejectReason: {
+		{"1", "UNKNOWN_SYMBOL"},
+		{"2", "EXCHANGE_CLOSED"},
+		{"3", "QUOTE_REQUEST_EXCEEDS_LIMIT"},
+		{"4", "TOO_LATE_TO_ENTER"},
+		{"5", "UNKNOWN_QUOTE"},
+		{"6", "DUPLICATE_QUOTE"},
+		{"7", "INVALID_BID_ASK_SPREAD"},
+		{"8", "INVALID_PRICE"},
+		{"9", "NOT_AUTHORIZED_TO_QUOTE_SECURITY"},
+		{"99", "OTHER"},
+	},
+	tag.QuoteRequestRejectReason: {
+		{"1", "UNKNOWN_SYMBOL"},
+		{"2", "EXCHANGE_CLOSED"},
+		{"3", "QUOTE_REQUEST_EXCEEDS_LIMIT"},
+		{"4", "TOO_LATE_TO_ENTER"},
+		{"5", "INVALID_PRICE"},
+		{"6", "NOT_AUTHORIZED_TO_REQUEST_QUOTE"},
+		{"7", "NO_MATCH_FOR_INQUIRY"},
+		{"8", "NO_MARKET_FOR_INSTRUMENT"},
+		{"9", "NO_INVENTORY"},
+		{"10", "PASS"},
+		{"99", "OTHER"},
+	},
+	tag.QuoteRequestType: {
+		{"1", "MANUAL"},
+		{"2", "AUTOMATIC"},
+	},
+	tag.QuoteRespType: {
+		{"1", "HIT_LIFT"},
+		{"2", "COUNTER"},
+		{"3", "EXPIRED"},
+		{"4", "COVER"},
+		{"5", "DONE_AWAY"},
+		{"6", "PASS"},
+	},
+	tag.QuoteResponseLevel: {
+		{"0", "NO_ACKNOWLEDGEMENT"},
+		{"1", "ACKNOWLEDGE_ONLY_NEGATIVE_OR_ERRONEOUS_QUOTES"},
+		{"2", "ACKNOWLEDGE_EACH_QUOTE_MESSAGES"},
+	},
+	tag.QuoteStatus: {
+		{"0", "ACCEPTED"},
+		{"1", "CANCELED_FOR_SYMBOL"},
+		{"2", "CANCELED_FOR_SECURITY_TYPE"},
+		{"3", "CANCELED_FOR_UNDERLYING"},
+		{"4", "CANCELED_ALL"},
+		{"5", "REJECTED"},
+		{"6", "REMOVED_FROM_MARKET"},
+		{"7", "EXPIRED"},
+		{"8", "QUERY"},
+		{"9", "QUOTE_NOT_FOUND"},
+		{"10", "PENDING"},
+		{"11", "PASS"},
+		{"12", "LOCKED_MARKET_WARNING"},
+		{"13", "CROSS_MARKET_WARNING"},
+		{"14", "CANCELED_DUE_TO_LOCK_MARKET"},
+		{"15", "CANCELED_DUE_TO_CROSS_MARKET"},
+	},
+	tag.QuoteType: {
+		{"0", "INDICATIVE"},
+		{"1", "TRADEABLE"},
+		{"2", "RESTRICTED_TRADEABLE"},
+		{"3", "COUNTER"},
+	},
+	tag.RegistRejReasonCode: {
+		{"1", "INVALID_UNACCEPTABLE_ACCOUNT_TYPE"},
+		{"2", "INVALID_UNACCEPTABLE_TAX_EXEMPT_TYPE"},
+		{"3", "INVALID_UNACCEPTABLE_OWNERSHIP_TYPE"},
+		{"4", "INVALID_UNACCEPTABLE_NO_REG_DETLS"},
+		{"5", "INVALID_UNACCEPTABLE_REG_SEQ_NO"},
+		{"6", "INVALID_UNACCEPTABLE_REG_DTLS"},
+		{"7", "INVALID_UNACCEPTABLE_MAILING_DTLS"},
+		{"8", "INVALID_UNACCEPTABLE_MAILING_INST"},
+		{"9", "INVALID_UNACCEPTABLE_INVESTOR_ID"},
+		{"10", "INVALID_UNACCEPTABLE_INVESTOR_ID_SOURCE"},
+		{"11", "INVALID_UNACCEPTABLE_DATE_OF_BIRTH"},
+		{"12", "INVALID_UNACCEPTABLE_INVESTOR_COUNTRY_OF_RESIDENCE"},
+		{"13", "INVALID_UNACCEPTABLE_NO_DISTRIB_INSTNS"},
+		{"14", "INVALID_UNACCEPTABLE_DISTRIB_PERCENTAGE"},
+		{"15", "INVALID_UNACCEPTABLE_DISTRIB_PAYMENT_METHOD"},
+		{"16", "INVALID_UNACCEPTABLE_CASH_DISTRIB_AGENT_ACCT_NAME"},
+		{"17", "INVALID_UNACCEPTABLE_CASH_DISTRIB_AGENT_CODE"},
+		{"18", "INVALID_UNACCEPTABLE_CASH_DISTRIB_AGENT_ACCT_NUM"},
+		{"99", "OTHER"},
+	},
+	tag.RegistStatus: {
+		{"A", "ACCEPTED"},
+		{"R", "REJECTED"},
+		{"H", "HELD"},
+		{"N", "REMINDER"},
+	},
+	tag.RegistTransType: {
+		{"0", "NEW"},
+		{"1", "REPLACE"},
+		{"2", "CANCEL"},
+	},
+	tag.ResponseTransportType: {
+		{"0", "INBAND"},
+		{"1", "OUT_OF_BAND"},
+	},
+	tag.RoundingDirection: {
+		{"0", "ROUND_TO_NEAREST"},
+		{"1", "ROUND_DOWN"},
+		{"2", "ROUND_UP"},
+	},
+	tag.RoutingType: {
+		{"1", "TARGET_FIRM"},
+		{"2", "TARGET_LIST"},
+		{"3", "BLOCK_FIRM"},
+		{"4", "BLOCK_LIST"},
+	},
+	tag.Scope: {
+		{"1", "LOCAL"},
+		{"2", "NATIONAL"},
+		{"3", "GLOBAL"},
+	},
+	tag.SecurityIDSource: {
+		{"1", "CUSIP"},
+		{"2", "SEDOL"},
+		{"3", "QUIK"},
+		{"4", "ISIN_NUMBER"},
+		{"5", "RIC_CODE"},
+		{"6", "ISO_CURRENCY_CODE"},
+		{"7", "ISO_COUNTRY_CODE"},
+		{"8", "EXCHANGE_SYMBOL"},
+		{"9", "CONSOLIDATED_TAPE_ASSOCIATION"},
+		{"A", "BLOOMBERG_SYMBOL"},
+		{"B", "WERTPAPIER"},
+		{"C", "DUTCH"},
+		{"D", "VALOREN"},
+		{"E", "SICOVAM"},
+		{"F", "BELGIAN"},
+		{"G", "COMMON"},
+		{"H", "CLEARING_HOUSE"},
+		{"I", "ISDA_FPML_PRODUCT_SPECIFICATION"},
+		{"J", "OPTIONS_PRICE_REPORTING_AUTHORITY"},
+	},
+	tag.SecurityListRequestType: {
+		{"0", "SYMBOL"},
+		{"1", "SECURITYTYPE_AND_OR_CFICODE"},
+		{"2", "PRODUCT"},
+		{"3", "TRADINGSESSIONID"},
+		{"4", "ALL_SECURITIES"},
+	},
+	tag.SecurityRequestResult: {
+		{"0", "VALID_REQUEST"},
+		{"1", "INVALID_OR_UNSUPPORTED_REQUEST"},
+		{"2", "NO_INSTRUMENTS_FOUND_THAT_MATCH_SELECTION_CRITERIA"},
+		{"3", "NOT_AUTHORIZED_TO_RETRIEVE_INSTRUMENT_DATA"},
+		{"4", "INSTRUMENT_DATA_TEMPORARILY_UNAVAILABLE"},
+		{"5", "REQUEST_FOR_INSTRUMENT_DATA_NOT_SUPPORTED"},
+	},
+	tag.SecurityRequestType: {
+		{"0", "REQUEST_SECURITY_IDENTITY_AND_SPECIFICATIONS"},
+		{"1", "REQUEST_SECURITY_IDENTITY_FOR_THE_SPECIFICATIONS_PROVIDED"},
+		{"2", "REQUEST_LIST_SECURITY_TYPES"},
+		{"3", "REQUEST_LIST_SECURITIES"},
+	},
+	tag.SecurityResponseType: {
+		{"1", "ACCEPT_SECURITY_PROPOSAL_AS_IS"},
+		{"2", "ACCEPT_SECURITY_PROPOSAL_WITH_REVISIONS_AS_INDICATED_IN_THE_MESSAGE"},
+		{"3", "LIST_OF_SECURITY_TYPES_RETURNED_PER_REQUEST"},
+		{"4", "LIST_OF_SECURITIES_RETURNED_PER_REQUEST"},
+		{"5", "REJECT_SECURITY_PROPOSAL"},
+		{"6", "CAN_NOT_MATCH_SELECTION_CRITERIA"},
+	},
+	tag.SecurityTradingStatus: {
+		{"1", "OPENING_DELAY"},
+		{"2", "TRADING_HALT"},
+		{"3", "RESUME"},
+		{"4", "NO_OPEN_NO_RESUME"},
+		{"5", "PRICE_INDICATION"},
+		{"6", "TRADING_RANGE_INDICATION"},
+		{"7", "MARKET_IMBALANCE_BUY"},
+		{"8", "MARKET_IMBALANCE_SELL"},
+		{"9", "MARKET_ON_CLOSE_IMBALANCE_BUY"},
+		{"10", "MARKET_ON_CLOSE_IMBALANCE_SELL"},
+		{"12", "NO_MARKET_IMBALANCE"},
+		{"13", "NO_MARKET_ON_CLOSE_IMBALANCE"},
+		{"14", "ITS_PRE_OPENING"},
+		{"15", "NEW_PRICE_INDICATION"},
+		{"16", "TRADE_DISSEMINATION_TIME"},
+		{"17", "READY_TO_TRADE"},
+		{"18", "NOT_AVAILABLE_FOR_TRADING"},
+		{"19", "NOT_TRADED_ON_THIS_MARKET"},
+		{"20", "UNKNOWN_OR_INVALID"},
+		{"21", "PRE_OPEN"},
+		{"22", "OPENING_ROTATION"},
+		{"23", "FAST_MARKET"},
+	},
+	tag.SecurityType: {
+		{"EUSUPRA", "EURO_SUPRANATIONAL_COUPONS"},
+		{"FAC", "FEDERAL_AGENCY_COUPON"},
+		{"FADN", "FEDERAL_AGENCY_DISCOUNT_NOTE"},
+		{"PEF", "PRIVATE_EXPORT_FUNDING"},
+		{"SUPRA", "USD_SUPRANATIONAL_COUPONS"},
+		{"FUT", "FUTURE"},
+		{"OPT", "OPTION"},
+		{"CORP", "CORPORATE_BOND"},
+		{"CPP", "CORPORATE_PRIVATE_PLACEMENT"},
+		{"CB", "CONVERTIBLE_BOND"},
+		{"DUAL", "DUAL_CURRENCY"},
+		{"EUCORP", "EURO_CORPORATE_BOND"},
+		{"XLINKD", "INDEXED_LINKED"},
+		{"STRUCT", "STRUCTURED_NOTES"},
+		{"YANK", "YANKEE_CORPORATE_BOND"},
+		{"FOR", "FOREIGN_EXCHANGE_CONTRACT"},
+		{"CS", "COMMON_STOCK"},
+		{"PS", "PREFERRED_STOCK"},
+		{"BRADY", "BRADY_BOND"},
+		{"EUSOV", "EURO_SOVEREIGNS"},
+		{"TBOND", "US_TREASURY_BOND"},
+		{"TINT", "INTEREST_STRIP_FROM_ANY_BOND_OR_NOTE"},
+		{"TIPS", "TREASURY_INFLATION_PROTECTED_SECURITIES"},
+		{"TCAL", "PRINCIPAL_STRIP_OF_A_CALLABLE_BOND_OR_NOTE"},
+		{"TPRN", "PRINCIPAL_STRIP_FROM_A_NON_CALLABLE_BOND_OR_NOTE"},
+		{"UST", "US_TREASURY_NOTE_UST"},
+		{"USTB", "US_TREASURY_BILL_USTB"},
+		{"TNOTE", "US_TREASURY_NOTE"},
+		{"TBILL", "US_TREASURY_BILL"},
+		{"REPO", "REPURCHASE"},
+		{"FORWARD", "FORWARD"},
+		{"BUYSELL", "BUY_SELLBACK"},
+		{"SECLOAN", "SECURITIES_LOAN"},
+		{"SECPLEDGE", "SECURITIES_PLEDGE"},
+		{"TERM", "TERM_LOAN"},
+		{"RVLV", "REVOLVER_LOAN"},
+		{"RVLVTRM", "REVOLVER_TERM_LOAN"},
+		{"BRIDGE", "BRIDGE_LOAN"},
+		{"LOFC", "LETTER_OF_CREDIT"},
+		{"SWING", "SWING_LINE_FACILITY"},
+		{"DINP", "DEBTOR_IN_POSSESSION"},
+		{"DEFLTED", "DEFAULTED"},
+		{"WITHDRN", "WITHDRAWN"},
+		{"REPLACD", "REPLACED"},
+		{"MATURED", "MATURED"},
+		{"AMENDED", "AMENDED_AND_RESTATED"},
+		{"RETIRED", "RETIRED"},
+		{"BA", "BANKERS_ACCEPTANCE"},
+		{"BN", "BANK_NOTES"},
+		{"BOX", "BILL_OF_EXCHANGES"},
+		{"CD", "CERTIFICATE_OF_DEPOSIT"},
+		{"CL", "CALL_LOANS"},
+		{"CP", "COMMERCIAL_PAPER"},
+		{"DN", "DEPOSIT_NOTES"},
+		{"EUCD", "EURO_CERTIFICATE_OF_DEPOSIT"},
+		{"EUCP", "EURO_COMMERCIAL_PAPER"},
+		{"LQN", "LIQUIDITY_NOTE"},
+		{"MTN", "MEDIUM_TERM_NOTES"},
+		{"ONITE", "OVERNIGHT"},
+		{"PN", "PROMISSORY_NOTE"},
+		{"PZFJ", "PLAZOS_FIJOS"},
+		{"STN", "SHORT_TERM_LOAN_NOTE"},
+		{"TD", "TIME_DEPOSIT"},
+		{"XCN", "EXTENDED_COMM_NOTE"},
+		{"YCD", "YANKEE_CERTIFICATE_OF_DEPOSIT"},
+		{"ABS", "ASSET_BACKED_SECURITIES"},
+		{"CMBS", "CORP_MORTGAGE_BACKED_SECURITIES"},
+		{"CMO", "COLLATERALIZED_MORTGAGE_OBLIGATION"},
+		{"IET", "IOETTE_MORTGAGE"},
+		{"MBS", "MORTGAGE_BACKED_SECURITIES"},
+		{"MIO", "MORTGAGE_INTEREST_ONLY"},
+		{"MPO", "MORTGAGE_PRINCIPAL_ONLY"},
+		{"MPP", "MORTGAGE_PRIVATE_PLACEMENT"},
+		{"MPT", "MISCELLANEOUS_PASS_THROUGH"},
+		{"PFAND", "PFANDBRIEFE"},
+		{"TBA", "TO_BE_ANNOUNCED"},
+		{"AN", "OTHER_ANTICIPATION_NOTES"},
+		{"COFO", "CERTIFICATE_OF_OBLIGATION"},
+		{"COFP", "CERTIFICATE_OF_PARTICIPATION"},
+		{"GO", "GENERAL_OBLIGATION_BONDS"},
+		{"MT", "MANDATORY_TENDER"},
+		{"RAN", "REVENUE_ANTICIPATION_NOTE"},
+		{"REV", "REVENUE_BONDS"},
+		{"SPCLA", "SPECIAL_ASSESSMENT"},
+		{"SPCLO", "SPECIAL_OBLIGATION"},
+		{"SPCLT", "SPECIAL_TAX"},
+		{"TAN", "TAX_ANTICIPATION_NOTE"},
+		{"TAXA", "TAX_ALLOCATION"},
+		{"TECP", "TAX_EXEMPT_COMMERCIAL_PAPER"},
+		{"TRAN", "TAX_AND_REVENUE_ANTICIPATION_NOTE"},
+		{"VRDN", "VARIABLE_RATE_DEMAND_NOTE"},
+		{"WAR", "WARRANT"},
+		{"MF", "MUTUAL_FUND"},
+		{"MLEG", "MULTI_LEG_INSTRUMENT"},
+		{"NONE", "NO_SECURITY_TYPE"},
+		{"?", "WILDCARD"},
+	},
+	tag.SessionRejectReason: {
+		{"0", "INVALID_TAG_NUMBER"},
+		{"1", "REQUIRED_TAG_MISSING"},
+		{"2", "TAG_NOT_DEFINED_FOR_THIS_MESSAGE_TYPE"},
+		{"3", "UNDEFINED_TAG"},
+		{"4", "TAG_SPECIFIED_WITHOUT_A_VALUE"},
+		{"5", "VALUE_IS_INCORRECT"},
+		{"6", "INCORRECT_DATA_FORMAT_FOR_VALUE"},
+		{"7", "DECRYPTION_PROBLEM"},
+		{"8", "SIGNATURE_PROBLEM"},
+		{"9", "COMPID_PROBLEM"},
+		{"10", "SENDINGTIME_ACCURACY_PROBLEM"},
+		{"11", "INVALID_MSGTYPE"},
+		{"12", "XML_VALIDATION_ERROR"},
+		{"13", "TAG_APPEARS_MORE_THAN_ONCE"},
+		{"14", "TAG_SPECIFIED_OUT_OF_REQUIRED_ORDER"},
+		{"15", "REPEATING_GROUP_FIELDS_OUT_OF_ORDER"},
+		{"16", "INCORRECT_NUMINGROUP_COUNT_FOR_REPEATING_GROUP"},
+		{"17", "NON_DATA_VALUE_INCLUDES_FIELD_DELIMITER"},
+		{"99", "OTHER"},
+	},
+	tag.SettlCurrFxRateCalc: {
+		{"M", "MULTIPLY"},
+		{"D", "DIVIDE"},
+	},
+	tag.SettlDeliveryType: {
+		{"0", "VERSUS_PAYMENT"},
+		{"1", "FREE"},
+		{"2", "TRI_PARTY"},
+		{"3", "HOLD_IN_CUSTODY"},
+	},
+	tag.SettlInstMode: {
+		{"0", "DEFAULT"},
+		{"1", "STANDING_INSTRUCTIONS_PROVIDED"},
+		{"4", "SPECIFIC_ORDER_FOR_A_SINGLE_ACCOUNT"},
+		{"5", "REQUEST_REJECT"},
+	},
+	tag.SettlInstReqRejCode: {
+		{"0", "UNABLE_TO_PROCESS_REQUEST"},
+		{"1", "UNKNOWN_ACCOUNT"},
+		{"2", "NO_MATCHING_SETTLEMENT_INSTRUCTIONS_FOUND"},
+		{"99", "OTHER"},
+	},
+	tag.SettlInstSource: {
+		{"1", "BROKERS_INSTRUCTIONS"},
+		{"2", "INSTITUTIONS_INSTRUCTIONS"},
+		{"3", "INVESTOR"},
+	},
+	tag.SettlInstTransType: {
+		{"N", "NEW"},
+		{"C", "CANCEL"},
+		{"R", "REPLACE"},
+		{"T", "RESTATE"},
+	},
+	tag.SettlPriceType: {
+		{"1", "FINAL"},
+		{"2", "THEORETICAL"},
+	},
+	tag.SettlSessID: {
+		{"ITD", "INTRADAY"},
+		{"RTH", "REGULAR_TRADING_HOURS"},
+		{"ETH", "ELECTRONIC_TRADING_HOURS"},
+	},
+	tag.SettlType: {
+		{"0", "REGULAR"},
+		{"1", "CASH"},
+		{"2", "NEXT_DAY"},
+		{"3", "T_PLUS_2"},
+		{"4", "T_PLUS_3"},
+		{"5", "T_PLUS_4"},
+		{"6", "FUTURE"},
+		{"7", "WHEN_AND_IF_ISSUED"},
+		{"8", "SELLERS_OPTION"},
+		{"9", "T_PLUS_5"},
+	},
+	tag.ShortSaleReason: {
+		{"0", "DEALER_SOLD_SHORT"},
+		{"1", "DEALER_SOLD_SHORT_EXEMPT"},
+		{"2", "SELLING_CUSTOMER_SOLD_SHORT"},
+		{"3", "SELLING_CUSTOMER_SOLD_SHORT_EXEMPT"},
+		{"4", "QUALIFED_SERVICE_REPRESENTATIVE_OR_AUTOMATIC_GIVEUP_CONTRA_SIDE_SOLD_SHORT"},
+		{"5", "QSR_OR_AGU_CONTRA_SIDE_SOLD_SHORT_EXEMPT"},
+	},
+	tag.Side: {
+		{"1", "BUY"},
+		{"2", "SELL"},
+		{"3", "BUY_MINUS"},
+		{"4", "SELL_PLUS"},
+		{"5", "SELL_SHORT"},
+		{"6", "SELL_SHORT_EXEMPT"},
+		{"7", "UNDISCLOSED"},
+		{"8", "CROSS"},
+		{"9", "CROSS_SHORT"},
+		{"A", "CROSS_SHORT_EXEMPT"},
+		{"B", "AS_DEFINED"},
+		{"C", "OPPOSITE"},
+		{"D", "SUBSCRIBE"},
+		{"E", "REDEEM"},
+		{"F", "LEND"},
+		{"G", "BORROW"},
+	},
+	tag.SideMultiLegReportingType: {
+		{"1", "SINGLE_SECURITY"},
+		{"2", "INDIVIDUAL_LEG_OF_A_MULTI_LEG_SECURITY"},
+		{"3", "MULTI_LEG_SECURITY"},
+	},
+	tag.SideValueInd: {
+		{"1", "SIDEVALUE1"},
+		{"2", "SIDEVALUE_2"},
+	},
+	tag.StandInstDbType: {
+		{"0", "OTHER"},
+		{"1", "DTC_SID"},
+		{"2", "THOMSON_ALERT"},
+		{"3", "A_GLOBAL_CUSTODIAN"},
+		{"4", "ACCOUNTNET"},
+	},
+	tag.StatusValue: {
+		{"1", "CONNECTED"},
+		{"2", "NOT_CONNECTED_DOWN_EXPECTED_UP"},
+		{"3", "NOT_CONNECTED_DOWN_EXPECTED_DOWN"},
+		{"4", "IN_PROCESS"},
+	},
+	tag.StipulationType: {
+		{"AMT", "ALTERNATIVE_MINIMUM_TAX"},
+		{"AUTOREINV", "AUTO_REINVESTMENT_AT_OR_BETTER"},
+		{"BANKQUAL", "BANK_QUALIFIED"},
+		{"BGNCON", "BARGAIN_CONDITIONS"},
+		{"COUPON", "COUPON_RANGE"},
+		{"CURRENCY", "ISO_CURRENCY_CODE"},
+		{"CUSTOMDATE", "CUSTOM_START_END_DATE"},
+		{"GEOG", "GEOGRAPHICS_AND_PERCENT_RANGE"},
+		{"HAIRCUT", "VALUATION_DISCOUNT"},
+		{"INSURED", "INSURED"},
+		{"ISSUE", "YEAR_OR_YEAR_MONTH_OF_ISSUE"},
+		{"ISSUER", "ISSUERS_TICKER"},
+		{"ISSUESIZE", "ISSUE_SIZE_RANGE"},
+		{"LOOKBACK", "LOOKBACK_DAYS"},
+		{"LOT", "EXPLICIT_LOT_IDENTIFIER"},
+		{"LOTVAR", "LOT_VARIANCE"},
+		{"MAT", "MATURITY_YEAR_AND_MONTH"},
+		{"MATURITY", "MATURITY_RANGE"},
+		{"MAXSUBS", "MAXIMUM_SUBSTITUTIONS"},
+		{"MINQTY", "MINIMUM_QUANTITY"},
+		{"MININCR", "MINIMUM_INCREMENT"},
+		{"MINDNOM", "MINIMUM_DENOMINATION"},
+		{"PAYFREQ", "PAYMENT_FREQUENCY_CALENDAR"},
+		{"PIECES", "NUMBER_OF_PIECES"},
+		{"PMAX", "POOLS_MAXIMUM"},
+		{"PPM", "POOLS_PER_MILLION"},
+		{"PPL", "POOLS_PER_LOT"},
+		{"PPT", "POOLS_PER_TRADE"},
+		{"PRICE", "PRICE_RANGE"},
+		{"PRICEFREQ", "PRICING_FREQUENCY"},
+		{"PROD", "PRODUCTION_YEAR"},
+		{"PROTECT", "CALL_PROTECTION"},
+		{"PURPOSE", "PURPOSE"},
+		{"PXSOURCE", "BENCHMARK_PRICE_SOURCE"},
+		{"RATING", "RATING_SOURCE_AND_RANGE"},
+		{"REDEMPTION", "TYPE_OF_REDEMPTION"},
+		{"RESTRICTED", "RESTRICTED"},
+		{"SECTOR", "MARKET_SECTOR"},
+		{"SECTYPE", "SECURITYTYPE_INCLUDED_OR_EXCLUDED"},
+		{"STRUCT", "STRUCTURE"},
+		{"SUBSFREQ", "SUBSTITUTIONS_FREQUENCY"},
+		{"SUBSLEFT", "SUBSTITUTIONS_LEFT"},
+		{"TEXT", "FREEFORM_TEXT"},
+		{"TRDVAR", "TRADE_VARIANCE"},
+		{"WAC", "WEIGHTED_AVERAGE_COUPON"},
+		{"WAL", "WEIGHTED_AVERAGE_LIFE_COUPON"},
+		{"WALA", "WEIGHTED_AVERAGE_LOAN_AGE"},
+		{"WAM", "WEIGHTED_AVERAGE_MATURITY"},
+		{"WHOLE", "WHOLE_POOL"},
+		{"YIELD", "YIELD_RANGE"},
+		{"SMM", "SINGLE_MONTHLY_MORTALITY"},
+		{"CPR", "CONSTANT_PREPAYMENT_RATE"},
+		{"CPY", "CONSTANT_PREPAYMENT_YIELD"},
+		{"CPP", "CONSTANT_PREPAYMENT_PENALTY"},
+		{"ABS", "ABSOLUTE_PREPAYMENT_SPEED"},
+		{"MPR", "MONTHLY_PREPAYMENT_RATE"},
+		{"PSA", "PERCENT_OF_BMA_PREPAYMENT_CURVE"},
+		{"PPC", "PERCENT_OF_PROSPECTUS_PREPAYMENT_CURVE"},
+		{"MHP", "PERCENT_OF_MANUFACTURED_HOUSING_PREPAYMENT_CURVE"},
+		{"HEP", "FINAL_CPR_OF_HOME_EQUITY_PREPAYMENT_CURVE"},
+	},
+	tag.SubscriptionRequestType: {
+		{"0", "SNAPSHOT"},
+		{"1", "SNAPSHOT_PLUS_UPDATES"},
+		{"2", "DISABLE_PREVIOUS_SNAPSHOT_PLUS_UPDATE_REQUEST"},
+	},
+	tag.SymbolSfx: {
+		{"WI", "WHEN_ISSUED"},
+		{"CD", "EUCP_WITH_LUMP_SUM_INTEREST"},
+	},
+	tag.TargetStrategy: {
+		{"1", "VWAP"},
+		{"2", "PARTICIPATE"},
+		{"3", "MININIZE_MARKET_IMPACT"},
+	},
+	tag.TaxAdvantageType: {
+		{"0", "NONE_NOT_APPLICABLE"},
+		{"1", "MAXI_ISA"},
+		{"2", "TESSA"},
+		{"3", "MINI_CASH_ISA"},
+		{"4", "MINI_STOCKS_AND_SHARES_ISA"},
+		{"5", "MINI_INSURANCE_ISA"},
+		{"6", "CURRENT_YEAR_PAYMENT"},
+		{"7", "PRIOR_YEAR_PAYMENT"},
+		{"8", "ASSET_TRANSFER"},
+		{"9", "EMPLOYEE_PRIOR_YEAR"},
+		{"10", "EMPLOYEE_CURRENT_YEAR"},
+		{"11", "EMPLOYER_PRIOR_YEAR"},
+		{"12", "EMPLOYER_CURRENT_YEAR"},
+		{"13", "NON_FUND_PROTOTYPE_IRA"},
+		{"14", "NON_FUND_QUALIFIED_PLAN"},
+		{"15", "DEFINED_CONTRIBUTION_PLAN"},
+		{"16", "INDIVIDUAL_RETIREMENT_ACCOUNT"},
+		{"17", "INDIVIDUAL_RETIREMENT_ACCOUNT_ROLLOVER"},
+		{"18", "KEOGH"},
+		{"19", "PROFIT_SHARING_PLAN"},
+		{"20", "401K"},
+		{"21", "SELF_DIRECTED_IRA"},
+		{"22", "403"},
+		{"23", "457"},
+		{"24", "ROTH_IRA_24"},
+		{"25", "ROTH_IRA_25"},
+		{"26", "ROTH_CONVERSION_IRA_26"},
+		{"27", "ROTH_CONVERSION_IRA_27"},
+		{"28", "EDUCATION_IRA_28"},
+		{"29", "EDUCATION_IRA_29"},
+		{"999", "OTHER"},
+	},
+	tag.TerminationType: {
+		{"1", "OVERNIGHT"},
+		{"2", "TERM"},
+		{"3", "FLEXIBLE"},
+		{"4", "OPEN"},
+	},
+	tag.TickDirection: {
+		{"0", "PLUS_TICK"},
+		{"1", "ZERO_PLUS_TICK"},
+		{"2", "MINUS_TICK"},
+		{"3", "ZERO_MINUS_TICK"},
+	},
+	tag.TimeInForce: {
+		{"0", "DAY"},
+		{"1", "GOOD_TILL_CANCEL"},
+		{"2", "AT_THE_OPENING"},
+		{"3", "IMMEDIATE_OR_CANCEL"},
+		{"4", "FILL_OR_KILL"},
+		{"5", "GOOD_TILL_CROSSING"},
+		{"6", "GOOD_TILL_DATE"},
+		{"7", "AT_THE_CLOSE"},
+	},
+	tag.TradSesMethod: {
+		{"1", "ELECTRONIC"},
+		{"2", "OPEN_OUTCRY"},
+		{"3", "TWO_PARTY"},
+	},
+	tag.TradSesMode: {
+		{"1", "TESTING"},
+		{"2", "SIMULATED"},
+		{"3", "PRODUCTION"},
+	},
+	tag.TradSesStatus: {
+		{"0", "UNKNOWN"},
+		{"1", "HALTED"},
+		{"2", "OPEN"},
+		{"3", "CLOSED"},
+		{"4", "PRE_OPEN"},
+		{"5", "PRE_CLOSE"},
+		{"6", "REQUEST_REJECTED"},
+	},
+	tag.TradSesStatusRejReason: {
+		{"1", "UNKNOWN_OR_INVALID_TRADINGSESSIONID"},
+		{"99", "OTHER"},
+	},
+	tag.TradeAllocIndicator: {
+		{"0", "ALLOCATION_NOT_REQUIRED"},
+		{"1", "ALLOCATION_REQUIRED"},
+		{"2", "USE_ALLOCATION_PROVIDED_WITH_THE_TRADE"},
+	},
+	tag.TradeCondition: {
+		{"A", "CASH"},
+		{"B", "AVERAGE_PRICE_TRADE"},
+		{"C", "CASH_TRADE"},
+		{"D", "NEXT_DAY"},
+		{"E", "OPENING_REOPENING_TRADE_DETAIL"},
+		{"F", "INTRADAY_TRADE_DETAIL"},
+		{"G", "RULE_127_TRADE"},
+		{"H", "RULE_155_TRADE"},
+		{"I", "SOLD_LAST"},
+		{"J", "NEXT_DAY_TRADE"},
+		{"K", "OPENED"},
+		{"L", "SELLER"},
+		{"M", "SOLD"},
+		{"N", "STOPPED_STOCK"},
+		{"P", "IMBALANCE_MORE_BUYERS"},
+		{"Q", "IMBALANCE_MORE_SELLERS"},
+		{"R", "OPENING_PRICE"},
+	},
+	tag.TradeReportRejectReason: {
+		{"0", "SUCCESSFUL"},
+		{"1", "INVALID_PARTY_INFORMATION"},
+		{"2", "UNKNOWN_INSTRUMENT"},
+		{"3", "UNAUTHORIZED_TO_REPORT_TRADES"},
+		{"4", "INVALID_TRADE_TYPE"},
+		{"99", "OTHER"},
+	},
+	tag.TradeReportTransType: {
+		{"0", "NEW"},
+		{"1", "CANCEL"},
+		{"2", "REPLACE"},
+		{"3", "RELEASE"},
+		{"4", "REVERSE"},
+	},
+	tag.TradeReportType: {
+		{"0", "SUBMIT"},
+		{"1", "ALLEGED"},
+		{"2", "ACCEPT"},
+		{"3", "DECLINE"},
+		{"4", "ADDENDUM"},
+		{"5", "NO_WAS"},
+		{"6", "TRADE_REPORT_CANCEL"},
+		{"7", "LOCKED_IN_TRADE_BREAK"},
+	},
+	tag.TradeRequestResult: {
+		{"0", "SUCCESSFUL"},
+		{"1", "INVALID_OR_UNKNOWN_INSTRUMENT"},
+		{"2", "INVALID_TYPE_OF_TRADE_REQUESTED"},
+		{"3", "INVALID_PARTIES"},
+		{"4", "INVALID_TRANSPORT_TYPE_REQUESTED"},
+		{"5", "INVALID_DESTINATION_REQUESTED"},
+		{"8", "TRADEREQUESTTYPE_NOT_SUPPORTED"},
+		{"9", "UNAUTHORIZED_FOR_TRADE_CAPTURE_REPORT_REQUEST"},
+		{"99", "OTHER"},
+	},
+	tag.TradeRequestStatus: {
+		{"0", "ACCEPTED"},
+		{"1", "COMPLETED"},
+		{"2", "REJECTED"},
+	},
+	tag.TradeRequestType: {
+		{"0", "ALL_TRADES"},
+		{"1", "MATCHED_TRADES_MATCHING_CRITERIA_PROVIDED_ON_REQUEST"},
+		{"2", "UNMATCHED_TRADES_THAT_MATCH_CRITERIA"},
+		{"3", "UNREPORTED_TRADES_THAT_MATCH_CRITERIA"},
+		{"4", "ADVISORIES_THAT_MATCH_CRITERIA"},
+	},
+	tag.TrdRegTimestampType: {
+		{"1", "EXECUTION_TIME"},
+		{"2", "TIME_IN"},
+		{"3", "TIME_OUT"},
+		{"4", "BROKER_RECEIPT"},
+		{"5", "BROKER_EXECUTION"},
+	},
+	tag.TrdRptStatus: {
+		{"0", "ACCEPTED"},
+		{"1", "REJECTED"},
+	},
+	tag.TrdType: {
+		{"0", "REGULAR_TRADE"},
+		{"1", "BLOCK_TRADE"},
+		{"2", "EFP"},
+		{"3", "TRANSFER"},
+		{"4", "LATE_TRADE"},
+		{"5", "T_TRADE"},
+		{"6", "WEIGHTED_AVERAGE_PRICE_TRADE"},
+		{"7", "BUNCHED_TRADE"},
+		{"8", "LATE_BUNCHED_TRADE"},
+		{"9", "PRIOR_REFERENCE_PRICE_TRADE"},
+		{"10", "AFTER_HOURS_TRADE"},
+	},
+	tag.Urgency: {
+		{"0", "NORMAL"},
+		{"1", "FLASH"},
+		{"2", "BACKGROUND"},
+	},
+	tag.UserRequestType: {
+		{"1", "LOGONUSER"},
+		{"2", "LOGOFFUSER"},
+		{"3", "CHANGEPASSWORDFORUSER"},
+		{"4", "REQUEST_INDIVIDUAL_USER_STATUS"},
+	},
+	tag.UserStatus: {
+		{"1", "LOGGED_IN"},
+		{"2", "NOT_LOGGED_IN"},
+		{"3", "USER_NOT_RECOGNISED"},
+		{"4", "PASSWORD_INCORRECT"},
+		{"5", "PASSWORD_CHANGED"},
+		{"6", "OTHER"},
+	},
+	tag.YieldType: {
+		{"AFTERTAX", "AFTER_TAX_YIELD"},
+		{"ANNUAL", "ANNUAL_YIELD"},
+		{"ATISSUE", "YIELD_AT_ISSUE"},
+		{"AVGMATURITY", "YIELD_TO_AVERAGE_MATURITY"},
+		{"BOOK", "BOOK_YIELD"},
+		{"CALL", "YIELD_TO_NEXT_CALL"},
+		{"CHANGE", "YIELD_CHANGE_SINCE_CLOSE"},
+		{"CLOSE", "CLOSING_YIELD"},
+		{"COMPOUND", "COMPOUND_YIELD"},
+		{"CURRENT", "CURRENT_YIELD"},
+		{"GROSS", "TRUE_GROSS_YIELD"},
+		{"GOVTEQUIV", "GOVERNMENT_EQUIVALENT_YIELD"},
+		{"INFLATION", "YIELD_WITH_INFLATION_ASSUMPTION"},
+		{"INVERSEFLOATER", "INVERSE_FLOATER_BOND_YIELD"},
+		{"LASTCLOSE", "MOST_RECENT_CLOSING_YIELD"},
+		{"LASTMONTH", "CLOSING_YIELD_MOST_RECENT_MONTH"},
+		{"LASTQUARTER", "CLOSING_YIELD_MOST_RECENT_QUARTER"},
+		{"LASTYEAR", "CLOSING_YIELD_MOST_RECENT_YEAR"},
+		{"LONGAVGLIFE", "YIELD_TO_LONGEST_AVERAGE_LIFE"},
+		{"MARK", "MARK_TO_MARKET_YIELD"},
+		{"MATURITY", "YIELD_TO_MATURITY"},
+		{"NEXTREFUND", "YIELD_TO_NEXT_REFUND"},
+		{"OPENAVG", "OPEN_AVERAGE_YIELD"},
+		{"PUT", "YIELD_TO_NEXT_PUT"},
+		{"PREVCLOSE", "PREVIOUS_CLOSE_YIELD"},
+		{"PROCEEDS", "PROCEEDS_YIELD"},
+		{"SEMIANNUAL", "SEMI_ANNUAL_YIELD"},
+		{"SHORTAVGLIFE", "YIELD_TO_SHORTEST_AVERAGE_LIFE"},
+		{"SIMPLE", "SIMPLE_YIELD"},
+		{"TAXEQUIV", "TAX_EQUIVALENT_YIELD"},
+		{"TENDER", "YIELD_TO_TENDER_DATE"},
+		{"TRUE", "TRUE_YIELD"},
+		{"VALUE1_32", "YIELD_VALUE_OF_1_32"},
+		{"WORST", "YIELD_TO_WORST"},
+	},
+}
+
+//multipleValueFields holds the enumerated fields of type MultipleValueString, their value is a space separated
+//list of enumerated values
+var multipleValueFields = map[quickfix.Tag]bool{
+	tag.CorporateAction:    true,
+	tag.ExecInst:           true,
+	tag.FinancialStatus:    true,
+	tag.OpenCloseSettlFlag: true,
+	tag.OrderRestrictions:  true,
+	tag.QuoteCondition:     true,
+	tag.Scope:              true,
+	tag.TradeCondition:     true,
+}
@@ -0,0 +1,40 @@
+package fix44
+
+import "github.com/terracefi/quickfix"
+
+//EnumValue is one of the values defined by FIX 4.4 for an enumerated field
+type EnumValue struct {
+	Value string
+	Name  string
+}
+
+//EnumValues returns the FIX 4.4 values of the enumerated field with tag t. ok is false for the fields that are not
+//enumerated, and for ExDestination, TradingSessionID, TradingSessionSubID and TrdSubType, whose values FIX 4.4 leaves
+//to the counterparties.
+func EnumValues(t quickfix.Tag) (values []EnumValue, ok bool) {
+	values, ok = enumValues[t]
+	return
+}
+
+//EnumName returns the name of value v of the enumerated field with tag t. It is false for the fields EnumValues
+//does not know, whatever the value. The value of a MultipleValueString field such as ExecInst is looked up whole, name
+//each of its space separated values instead.
+func EnumName(t quickfix.Tag, v string) (string, bool) {
+	for _, e := range enumValues[t] {
+		if e.Value == v {
+			return e.Name, true
+		}
+	}
+	return "", false
+}
+
+//EnumValueOf returns the value of the enumerated field with tag t that has the given name. Like EnumName it only
+//knows the fields listed by EnumValues.
+func EnumValueOf(t quickfix.Tag, name string) (string, bool) {
+	for _, e := range enumValues[t] {
+		if e.Name == name {
+			return e.Value, true
+		}
+	}
+	return "", false
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/terracefi/enum"
 	"github.com/terracefi/field"
 	"github.com/terracefi/fix44"
+	"github.com/terracefi/fix44/components"
 	"github.com/terracefi/quickfix"
 	"github.com/terracefi/tag"
 )
@@ -66,6 +67,26 @@ func Route(router RouteOut) (string, string, quickfix.MessageRoute) {
 	return "FIX.4.4", "8", r
 }
 
+//Validate checks m against the FIX 4.4 definition of ExecutionReport, see fix44.MessageDef.Validate
+func (m ExecutionReport) Validate() fix44.ValidationErrors {
+	return fix44.MessageDefs["8"].Validate(&m.Body.FieldMap)
+}
+
+//MarshalJSON encodes the ExecutionReport as JSON with fields keyed by name, see fix44.MarshalMessageJSON
+func (m ExecutionReport) MarshalJSON() ([]byte, error) {
+	return fix44.MarshalMessageJSON(m.Message)
+}
+
+//UnmarshalJSON builds the ExecutionReport from JSON produced by MarshalJSON
+func (m *ExecutionReport) UnmarshalJSON(b []byte) error {
+	msg, err := fix44.UnmarshalMessageJSON(b, "8")
+	if err != nil {
+		return err
+	}
+	*m = FromMessage(msg)
+	return nil
+}
+
 //SetAccount sets Account, Tag 1
 func (m ExecutionReport) SetAccount(v string) {
 	m.Set(field.NewAccount(v))
@@ -3556,6 +3577,21 @@ func (m ExecutionReport) HasEncodedIssuer() bool {
 	return m.Has(tag.EncodedIssuer)
 }
 
+//SetEncodedIssuerBytes sets EncodedIssuer, Tag 349, and EncodedIssuerLen, Tag 348, from v, which may hold any bytes including SOH
+func (m ExecutionReport) SetEncodedIssuerBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedIssuerLen, tag.EncodedIssuer, v)
+}
+
+//GetEncodedIssuerBytes gets EncodedIssuer, Tag 349, checking its length against EncodedIssuerLen, Tag 348
+func (m ExecutionReport) GetEncodedIssuerBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedIssuerLen, tag.EncodedIssuer)
+}
+
+//GetEncodedIssuerDecoded gets EncodedIssuer, Tag 349, decoded to UTF-8 using the MessageEncoding of the Header
+func (m ExecutionReport) GetEncodedIssuerDecoded() (string, error) {
+	return fix44.DecodeRawData(m.Header, m, tag.EncodedIssuerLen, tag.EncodedIssuer)
+}
+
 //HasEncodedSecurityDescLen returns true if EncodedSecurityDescLen is present, Tag 350
 func (m ExecutionReport) HasEncodedSecurityDescLen() bool {
 	return m.Has(tag.EncodedSecurityDescLen)
@@ -3566,6 +3602,21 @@ func (m ExecutionReport) HasEncodedSecurityDesc() bool {
 	return m.Has(tag.EncodedSecurityDesc)
 }
 
+//SetEncodedSecurityDescBytes sets EncodedSecurityDesc, Tag 351, and EncodedSecurityDescLen, Tag 350, from v, which may hold any bytes including SOH
+func (m ExecutionReport) SetEncodedSecurityDescBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedSecurityDescLen, tag.EncodedSecurityDesc, v)
+}
+
+//GetEncodedSecurityDescBytes gets EncodedSecurityDesc, Tag 351, checking its length against EncodedSecurityDescLen, Tag 350
+func (m ExecutionReport) GetEncodedSecurityDescBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedSecurityDescLen, tag.EncodedSecurityDesc)
+}
+
+//GetEncodedSecurityDescDecoded gets EncodedSecurityDesc, Tag 351, decoded to UTF-8 using the MessageEncoding of the Header
+func (m ExecutionReport) GetEncodedSecurityDescDecoded() (string, error) {
+	return fix44.DecodeRawData(m.Header, m, tag.EncodedSecurityDescLen, tag.EncodedSecurityDesc)
+}
+
 //HasEncodedTextLen returns true if EncodedTextLen is present, Tag 354
 func (m ExecutionReport) HasEncodedTextLen() bool {
 	return m.Has(tag.EncodedTextLen)
@@ -3576,6 +3627,21 @@ func (m ExecutionReport) HasEncodedText() bool {
 	return m.Has(tag.EncodedText)
 }
 
+//SetEncodedTextBytes sets EncodedText, Tag 355, and EncodedTextLen, Tag 354, from v, which may hold any bytes including SOH
+func (m ExecutionReport) SetEncodedTextBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedTextLen, tag.EncodedText, v)
+}
+
+//GetEncodedTextBytes gets EncodedText, Tag 355, checking its length against EncodedTextLen, Tag 354
+func (m ExecutionReport) GetEncodedTextBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedTextLen, tag.EncodedText)
+}
+
+//GetEncodedTextDecoded gets EncodedText, Tag 355, decoded to UTF-8 using the MessageEncoding of the Header
+func (m ExecutionReport) GetEncodedTextDecoded() (string, error) {
+	return fix44.DecodeRawData(m.Header, m, tag.EncodedTextLen, tag.EncodedText)
+}
+
 //HasComplianceID returns true if ComplianceID is present, Tag 376
 func (m ExecutionReport) HasComplianceID() bool {
 	return m.Has(tag.ComplianceID)
@@ -4171,6 +4237,66 @@ func (m ExecutionReport) HasStrikeCurrency() bool {
 	return m.Has(tag.StrikeCurrency)
 }
 
+//GetInstrument gets the Instrument component
+func (m ExecutionReport) GetInstrument() components.Instrument {
+	return components.Instrument{&m.Body.FieldMap}
+}
+
+//SetInstrument sets the Instrument component, copying the fields present in c
+func (m ExecutionReport) SetInstrument(c components.Instrument) quickfix.MessageRejectError {
+	return c.CopyInto(&m.Body.FieldMap)
+}
+
+//GetParties gets the Parties component
+func (m ExecutionReport) GetParties() components.Parties {
+	return components.Parties{&m.Body.FieldMap}
+}
+
+//SetParties sets the Parties component, copying the fields present in c
+func (m ExecutionReport) SetParties(c components.Parties) quickfix.MessageRejectError {
+	return c.CopyInto(&m.Body.FieldMap)
+}
+
+//GetOrderQtyData gets the OrderQtyData component
+func (m ExecutionReport) GetOrderQtyData() components.OrderQtyData {
+	return components.OrderQtyData{&m.Body.FieldMap}
+}
+
+//SetOrderQtyData sets the OrderQtyData component, copying the fields present in c
+func (m ExecutionReport) SetOrderQtyData(c components.OrderQtyData) quickfix.MessageRejectError {
+	return c.CopyInto(&m.Body.FieldMap)
+}
+
+//GetCommissionData gets the CommissionData component
+func (m ExecutionReport) GetCommissionData() components.CommissionData {
+	return components.CommissionData{&m.Body.FieldMap}
+}
+
+//SetCommissionData sets the CommissionData component, copying the fields present in c
+func (m ExecutionReport) SetCommissionData(c components.CommissionData) quickfix.MessageRejectError {
+	return c.CopyInto(&m.Body.FieldMap)
+}
+
+//GetStipulations gets the Stipulations component
+func (m ExecutionReport) GetStipulations() components.Stipulations {
+	return components.Stipulations{&m.Body.FieldMap}
+}
+
+//SetStipulations sets the Stipulations component, copying the fields present in c
+func (m ExecutionReport) SetStipulations(c components.Stipulations) quickfix.MessageRejectError {
+	return c.CopyInto(&m.Body.FieldMap)
+}
+
+//GetSpreadOrBenchmarkCurveData gets the SpreadOrBenchmarkCurveData component
+func (m ExecutionReport) GetSpreadOrBenchmarkCurveData() components.SpreadOrBenchmarkCurveData {
+	return components.SpreadOrBenchmarkCurveData{&m.Body.FieldMap}
+}
+
+//SetSpreadOrBenchmarkCurveData sets the SpreadOrBenchmarkCurveData component, copying the fields present in c
+func (m ExecutionReport) SetSpreadOrBenchmarkCurveData(c components.SpreadOrBenchmarkCurveData) quickfix.MessageRejectError {
+	return c.CopyInto(&m.Body.FieldMap)
+}
+
 //NoMiscFees is a repeating group element, Tag 136
 type NoMiscFees struct {
 	*quickfix.Group
@@ -4276,70 +4402,18 @@ func (m NoMiscFeesRepeatingGroup) Get(i int) NoMiscFees {
 }
 
 //NoStipulations is a repeating group element, Tag 232
-type NoStipulations struct {
-	*quickfix.Group
-}
-
-//SetStipulationType sets StipulationType, Tag 233
-func (m NoStipulations) SetStipulationType(v enum.StipulationType) {
-	m.Set(field.NewStipulationType(v))
-}
-
-//SetStipulationValue sets StipulationValue, Tag 234
-func (m NoStipulations) SetStipulationValue(v string) {
-	m.Set(field.NewStipulationValue(v))
-}
-
-//GetStipulationType gets StipulationType, Tag 233
-func (m NoStipulations) GetStipulationType() (v enum.StipulationType, err quickfix.MessageRejectError) {
-	var f field.StipulationTypeField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetStipulationValue gets StipulationValue, Tag 234
-func (m NoStipulations) GetStipulationValue() (v string, err quickfix.MessageRejectError) {
-	var f field.StipulationValueField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//HasStipulationType returns true if StipulationType is present, Tag 233
-func (m NoStipulations) HasStipulationType() bool {
-	return m.Has(tag.StipulationType)
-}
-
-//HasStipulationValue returns true if StipulationValue is present, Tag 234
-func (m NoStipulations) HasStipulationValue() bool {
-	return m.Has(tag.StipulationValue)
-}
+type NoStipulations = components.NoStipulations
 
 //NoStipulationsRepeatingGroup is a repeating group, Tag 232
-type NoStipulationsRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
+type NoStipulationsRepeatingGroup = components.NoStipulationsRepeatingGroup
 
 //NewNoStipulationsRepeatingGroup returns an initialized, NoStipulationsRepeatingGroup
 func NewNoStipulationsRepeatingGroup() NoStipulationsRepeatingGroup {
-	return NoStipulationsRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoStipulations,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.StipulationType), quickfix.GroupElement(tag.StipulationValue)})}
-}
-
-//Add create and append a new NoStipulations to this group
-func (m NoStipulationsRepeatingGroup) Add() NoStipulations {
-	g := m.RepeatingGroup.Add()
-	return NoStipulations{g}
+	return components.NewNoStipulationsRepeatingGroup()
 }
 
-//Get returns the ith NoStipulations in the NoStipulationsRepeatinGroup
-func (m NoStipulationsRepeatingGroup) Get(i int) NoStipulations {
-	return NoStipulations{m.RepeatingGroup.Get(i)}
-}
+//NoStipulationsStruct is a plain Go representation of a NoStipulations group element
+type NoStipulationsStruct = components.NoStipulationsStruct
 
 //NoContraBrokers is a repeating group element, Tag 382
 type NoContraBrokers struct {
@@ -4465,238 +4539,46 @@ func (m NoContraBrokersRepeatingGroup) Get(i int) NoContraBrokers {
 }
 
 //NoPartyIDs is a repeating group element, Tag 453
-type NoPartyIDs struct {
-	*quickfix.Group
-}
-
-//SetPartyID sets PartyID, Tag 448
-func (m NoPartyIDs) SetPartyID(v string) {
-	m.Set(field.NewPartyID(v))
-}
-
-//SetPartyIDSource sets PartyIDSource, Tag 447
-func (m NoPartyIDs) SetPartyIDSource(v enum.PartyIDSource) {
-	m.Set(field.NewPartyIDSource(v))
-}
-
-//SetPartyRole sets PartyRole, Tag 452
-func (m NoPartyIDs) SetPartyRole(v enum.PartyRole) {
-	m.Set(field.NewPartyRole(v))
-}
-
-//SetNoPartySubIDs sets NoPartySubIDs, Tag 802
-func (m NoPartyIDs) SetNoPartySubIDs(f NoPartySubIDsRepeatingGroup) {
-	m.SetGroup(f)
-}
-
-//GetPartyID gets PartyID, Tag 448
-func (m NoPartyIDs) GetPartyID() (v string, err quickfix.MessageRejectError) {
-	var f field.PartyIDField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetPartyIDSource gets PartyIDSource, Tag 447
-func (m NoPartyIDs) GetPartyIDSource() (v enum.PartyIDSource, err quickfix.MessageRejectError) {
-	var f field.PartyIDSourceField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetPartyRole gets PartyRole, Tag 452
-func (m NoPartyIDs) GetPartyRole() (v enum.PartyRole, err quickfix.MessageRejectError) {
-	var f field.PartyRoleField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetNoPartySubIDs gets NoPartySubIDs, Tag 802
-func (m NoPartyIDs) GetNoPartySubIDs() (NoPartySubIDsRepeatingGroup, quickfix.MessageRejectError) {
-	f := NewNoPartySubIDsRepeatingGroup()
-	err := m.GetGroup(f)
-	return f, err
-}
-
-//HasPartyID returns true if PartyID is present, Tag 448
-func (m NoPartyIDs) HasPartyID() bool {
-	return m.Has(tag.PartyID)
-}
+type NoPartyIDs = components.NoPartyIDs
 
-//HasPartyIDSource returns true if PartyIDSource is present, Tag 447
-func (m NoPartyIDs) HasPartyIDSource() bool {
-	return m.Has(tag.PartyIDSource)
-}
+//NoPartyIDsRepeatingGroup is a repeating group, Tag 453
+type NoPartyIDsRepeatingGroup = components.NoPartyIDsRepeatingGroup
 
-//HasPartyRole returns true if PartyRole is present, Tag 452
-func (m NoPartyIDs) HasPartyRole() bool {
-	return m.Has(tag.PartyRole)
+//NewNoPartyIDsRepeatingGroup returns an initialized, NoPartyIDsRepeatingGroup
+func NewNoPartyIDsRepeatingGroup() NoPartyIDsRepeatingGroup {
+	return components.NewNoPartyIDsRepeatingGroup()
 }
 
-//HasNoPartySubIDs returns true if NoPartySubIDs is present, Tag 802
-func (m NoPartyIDs) HasNoPartySubIDs() bool {
-	return m.Has(tag.NoPartySubIDs)
-}
+//NoPartyIDsStruct is a plain Go representation of a NoPartyIDs group element
+type NoPartyIDsStruct = components.NoPartyIDsStruct
 
 //NoPartySubIDs is a repeating group element, Tag 802
-type NoPartySubIDs struct {
-	*quickfix.Group
-}
-
-//SetPartySubID sets PartySubID, Tag 523
-func (m NoPartySubIDs) SetPartySubID(v string) {
-	m.Set(field.NewPartySubID(v))
-}
-
-//SetPartySubIDType sets PartySubIDType, Tag 803
-func (m NoPartySubIDs) SetPartySubIDType(v enum.PartySubIDType) {
-	m.Set(field.NewPartySubIDType(v))
-}
-
-//GetPartySubID gets PartySubID, Tag 523
-func (m NoPartySubIDs) GetPartySubID() (v string, err quickfix.MessageRejectError) {
-	var f field.PartySubIDField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetPartySubIDType gets PartySubIDType, Tag 803
-func (m NoPartySubIDs) GetPartySubIDType() (v enum.PartySubIDType, err quickfix.MessageRejectError) {
-	var f field.PartySubIDTypeField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//HasPartySubID returns true if PartySubID is present, Tag 523
-func (m NoPartySubIDs) HasPartySubID() bool {
-	return m.Has(tag.PartySubID)
-}
-
-//HasPartySubIDType returns true if PartySubIDType is present, Tag 803
-func (m NoPartySubIDs) HasPartySubIDType() bool {
-	return m.Has(tag.PartySubIDType)
-}
+type NoPartySubIDs = components.NoPartySubIDs
 
 //NoPartySubIDsRepeatingGroup is a repeating group, Tag 802
-type NoPartySubIDsRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
+type NoPartySubIDsRepeatingGroup = components.NoPartySubIDsRepeatingGroup
 
 //NewNoPartySubIDsRepeatingGroup returns an initialized, NoPartySubIDsRepeatingGroup
 func NewNoPartySubIDsRepeatingGroup() NoPartySubIDsRepeatingGroup {
-	return NoPartySubIDsRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoPartySubIDs,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.PartySubID), quickfix.GroupElement(tag.PartySubIDType)})}
-}
-
-//Add create and append a new NoPartySubIDs to this group
-func (m NoPartySubIDsRepeatingGroup) Add() NoPartySubIDs {
-	g := m.RepeatingGroup.Add()
-	return NoPartySubIDs{g}
+	return components.NewNoPartySubIDsRepeatingGroup()
 }
 
-//Get returns the ith NoPartySubIDs in the NoPartySubIDsRepeatinGroup
-func (m NoPartySubIDsRepeatingGroup) Get(i int) NoPartySubIDs {
-	return NoPartySubIDs{m.RepeatingGroup.Get(i)}
-}
-
-//NoPartyIDsRepeatingGroup is a repeating group, Tag 453
-type NoPartyIDsRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
-
-//NewNoPartyIDsRepeatingGroup returns an initialized, NoPartyIDsRepeatingGroup
-func NewNoPartyIDsRepeatingGroup() NoPartyIDsRepeatingGroup {
-	return NoPartyIDsRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoPartyIDs,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.PartyID), quickfix.GroupElement(tag.PartyIDSource), quickfix.GroupElement(tag.PartyRole), NewNoPartySubIDsRepeatingGroup()})}
-}
-
-//Add create and append a new NoPartyIDs to this group
-func (m NoPartyIDsRepeatingGroup) Add() NoPartyIDs {
-	g := m.RepeatingGroup.Add()
-	return NoPartyIDs{g}
-}
-
-//Get returns the ith NoPartyIDs in the NoPartyIDsRepeatinGroup
-func (m NoPartyIDsRepeatingGroup) Get(i int) NoPartyIDs {
-	return NoPartyIDs{m.RepeatingGroup.Get(i)}
-}
+//NoPartySubIDsStruct is a plain Go representation of a NoPartySubIDs group element
+type NoPartySubIDsStruct = components.NoPartySubIDsStruct
 
 //NoSecurityAltID is a repeating group element, Tag 454
-type NoSecurityAltID struct {
-	*quickfix.Group
-}
-
-//SetSecurityAltID sets SecurityAltID, Tag 455
-func (m NoSecurityAltID) SetSecurityAltID(v string) {
-	m.Set(field.NewSecurityAltID(v))
-}
-
-//SetSecurityAltIDSource sets SecurityAltIDSource, Tag 456
-func (m NoSecurityAltID) SetSecurityAltIDSource(v string) {
-	m.Set(field.NewSecurityAltIDSource(v))
-}
-
-//GetSecurityAltID gets SecurityAltID, Tag 455
-func (m NoSecurityAltID) GetSecurityAltID() (v string, err quickfix.MessageRejectError) {
-	var f field.SecurityAltIDField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetSecurityAltIDSource gets SecurityAltIDSource, Tag 456
-func (m NoSecurityAltID) GetSecurityAltIDSource() (v string, err quickfix.MessageRejectError) {
-	var f field.SecurityAltIDSourceField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//HasSecurityAltID returns true if SecurityAltID is present, Tag 455
-func (m NoSecurityAltID) HasSecurityAltID() bool {
-	return m.Has(tag.SecurityAltID)
-}
-
-//HasSecurityAltIDSource returns true if SecurityAltIDSource is present, Tag 456
-func (m NoSecurityAltID) HasSecurityAltIDSource() bool {
-	return m.Has(tag.SecurityAltIDSource)
-}
+type NoSecurityAltID = components.NoSecurityAltID
 
 //NoSecurityAltIDRepeatingGroup is a repeating group, Tag 454
-type NoSecurityAltIDRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
+type NoSecurityAltIDRepeatingGroup = components.NoSecurityAltIDRepeatingGroup
 
 //NewNoSecurityAltIDRepeatingGroup returns an initialized, NoSecurityAltIDRepeatingGroup
 func NewNoSecurityAltIDRepeatingGroup() NoSecurityAltIDRepeatingGroup {
-	return NoSecurityAltIDRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoSecurityAltID,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.SecurityAltID), quickfix.GroupElement(tag.SecurityAltIDSource)})}
-}
-
-//Add create and append a new NoSecurityAltID to this group
-func (m NoSecurityAltIDRepeatingGroup) Add() NoSecurityAltID {
-	g := m.RepeatingGroup.Add()
-	return NoSecurityAltID{g}
+	return components.NewNoSecurityAltIDRepeatingGroup()
 }
 
-//Get returns the ith NoSecurityAltID in the NoSecurityAltIDRepeatinGroup
-func (m NoSecurityAltIDRepeatingGroup) Get(i int) NoSecurityAltID {
-	return NoSecurityAltID{m.RepeatingGroup.Get(i)}
-}
+//NoSecurityAltIDStruct is a plain Go representation of a NoSecurityAltID group element
+type NoSecurityAltIDStruct = components.NoSecurityAltIDStruct
 
 //NoContAmts is a repeating group element, Tag 518
 type NoContAmts struct {
@@ -5684,6 +5566,21 @@ func (m NoLegs) HasEncodedLegIssuer() bool {
 	return m.Has(tag.EncodedLegIssuer)
 }
 
+//SetEncodedLegIssuerBytes sets EncodedLegIssuer, Tag 619, and EncodedLegIssuerLen, Tag 618, from v, which may hold any bytes including SOH
+func (m NoLegs) SetEncodedLegIssuerBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedLegIssuerLen, tag.EncodedLegIssuer, v)
+}
+
+//GetEncodedLegIssuerBytes gets EncodedLegIssuer, Tag 619, checking its length against EncodedLegIssuerLen, Tag 618
+func (m NoLegs) GetEncodedLegIssuerBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedLegIssuerLen, tag.EncodedLegIssuer)
+}
+
+//GetEncodedLegIssuerDecoded gets EncodedLegIssuer, Tag 619, decoded to UTF-8 using the MessageEncoding of h, the Header of the message holding it
+func (m NoLegs) GetEncodedLegIssuerDecoded(h fix44.Header) (string, error) {
+	return fix44.DecodeRawData(h, m, tag.EncodedLegIssuerLen, tag.EncodedLegIssuer)
+}
+
 //HasLegSecurityDesc returns true if LegSecurityDesc is present, Tag 620
 func (m NoLegs) HasLegSecurityDesc() bool {
 	return m.Has(tag.LegSecurityDesc)
@@ -5699,6 +5596,21 @@ func (m NoLegs) HasEncodedLegSecurityDesc() bool {
 	return m.Has(tag.EncodedLegSecurityDesc)
 }
 
+//SetEncodedLegSecurityDescBytes sets EncodedLegSecurityDesc, Tag 622, and EncodedLegSecurityDescLen, Tag 621, from v, which may hold any bytes including SOH
+func (m NoLegs) SetEncodedLegSecurityDescBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedLegSecurityDescLen, tag.EncodedLegSecurityDesc, v)
+}
+
+//GetEncodedLegSecurityDescBytes gets EncodedLegSecurityDesc, Tag 622, checking its length against EncodedLegSecurityDescLen, Tag 621
+func (m NoLegs) GetEncodedLegSecurityDescBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedLegSecurityDescLen, tag.EncodedLegSecurityDesc)
+}
+
+//GetEncodedLegSecurityDescDecoded gets EncodedLegSecurityDesc, Tag 622, decoded to UTF-8 using the MessageEncoding of h, the Header of the message holding it
+func (m NoLegs) GetEncodedLegSecurityDescDecoded(h fix44.Header) (string, error) {
+	return fix44.DecodeRawData(h, m, tag.EncodedLegSecurityDescLen, tag.EncodedLegSecurityDesc)
+}
+
 //HasLegRatioQty returns true if LegRatioQty is present, Tag 623
 func (m NoLegs) HasLegRatioQty() bool {
 	return m.Has(tag.LegRatioQty)
@@ -5789,71 +5701,39 @@ func (m NoLegs) HasLegLastPx() bool {
 	return m.Has(tag.LegLastPx)
 }
 
-//NoLegSecurityAltID is a repeating group element, Tag 604
-type NoLegSecurityAltID struct {
-	*quickfix.Group
-}
-
-//SetLegSecurityAltID sets LegSecurityAltID, Tag 605
-func (m NoLegSecurityAltID) SetLegSecurityAltID(v string) {
-	m.Set(field.NewLegSecurityAltID(v))
-}
-
-//SetLegSecurityAltIDSource sets LegSecurityAltIDSource, Tag 606
-func (m NoLegSecurityAltID) SetLegSecurityAltIDSource(v string) {
-	m.Set(field.NewLegSecurityAltIDSource(v))
+//GetInstrumentLeg gets the InstrumentLeg component
+func (m NoLegs) GetInstrumentLeg() components.InstrumentLeg {
+	return components.InstrumentLeg{&m.Group.FieldMap}
 }
 
-//GetLegSecurityAltID gets LegSecurityAltID, Tag 605
-func (m NoLegSecurityAltID) GetLegSecurityAltID() (v string, err quickfix.MessageRejectError) {
-	var f field.LegSecurityAltIDField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
+//SetInstrumentLeg sets the InstrumentLeg component, copying the fields present in c
+func (m NoLegs) SetInstrumentLeg(c components.InstrumentLeg) quickfix.MessageRejectError {
+	return c.CopyInto(&m.Group.FieldMap)
 }
 
-//GetLegSecurityAltIDSource gets LegSecurityAltIDSource, Tag 606
-func (m NoLegSecurityAltID) GetLegSecurityAltIDSource() (v string, err quickfix.MessageRejectError) {
-	var f field.LegSecurityAltIDSourceField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
+//GetNestedParties gets the NestedParties component
+func (m NoLegs) GetNestedParties() components.NestedParties {
+	return components.NestedParties{&m.Group.FieldMap}
 }
 
-//HasLegSecurityAltID returns true if LegSecurityAltID is present, Tag 605
-func (m NoLegSecurityAltID) HasLegSecurityAltID() bool {
-	return m.Has(tag.LegSecurityAltID)
+//SetNestedParties sets the NestedParties component, copying the fields present in c
+func (m NoLegs) SetNestedParties(c components.NestedParties) quickfix.MessageRejectError {
+	return c.CopyInto(&m.Group.FieldMap)
 }
 
-//HasLegSecurityAltIDSource returns true if LegSecurityAltIDSource is present, Tag 606
-func (m NoLegSecurityAltID) HasLegSecurityAltIDSource() bool {
-	return m.Has(tag.LegSecurityAltIDSource)
-}
+//NoLegSecurityAltID is a repeating group element, Tag 604
+type NoLegSecurityAltID = components.NoLegSecurityAltID
 
 //NoLegSecurityAltIDRepeatingGroup is a repeating group, Tag 604
-type NoLegSecurityAltIDRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
+type NoLegSecurityAltIDRepeatingGroup = components.NoLegSecurityAltIDRepeatingGroup
 
 //NewNoLegSecurityAltIDRepeatingGroup returns an initialized, NoLegSecurityAltIDRepeatingGroup
 func NewNoLegSecurityAltIDRepeatingGroup() NoLegSecurityAltIDRepeatingGroup {
-	return NoLegSecurityAltIDRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoLegSecurityAltID,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.LegSecurityAltID), quickfix.GroupElement(tag.LegSecurityAltIDSource)})}
+	return components.NewNoLegSecurityAltIDRepeatingGroup()
 }
 
-//Add create and append a new NoLegSecurityAltID to this group
-func (m NoLegSecurityAltIDRepeatingGroup) Add() NoLegSecurityAltID {
-	g := m.RepeatingGroup.Add()
-	return NoLegSecurityAltID{g}
-}
-
-//Get returns the ith NoLegSecurityAltID in the NoLegSecurityAltIDRepeatinGroup
-func (m NoLegSecurityAltIDRepeatingGroup) Get(i int) NoLegSecurityAltID {
-	return NoLegSecurityAltID{m.RepeatingGroup.Get(i)}
-}
+//NoLegSecurityAltIDStruct is a plain Go representation of a NoLegSecurityAltID group element
+type NoLegSecurityAltIDStruct = components.NoLegSecurityAltIDStruct
 
 //NoLegStipulations is a repeating group element, Tag 683
 type NoLegStipulations struct {
@@ -5922,176 +5802,36 @@ func (m NoLegStipulationsRepeatingGroup) Get(i int) NoLegStipulations {
 }
 
 //NoNestedPartyIDs is a repeating group element, Tag 539
-type NoNestedPartyIDs struct {
-	*quickfix.Group
-}
-
-//SetNestedPartyID sets NestedPartyID, Tag 524
-func (m NoNestedPartyIDs) SetNestedPartyID(v string) {
-	m.Set(field.NewNestedPartyID(v))
-}
+type NoNestedPartyIDs = components.NoNestedPartyIDs
 
-//SetNestedPartyIDSource sets NestedPartyIDSource, Tag 525
-func (m NoNestedPartyIDs) SetNestedPartyIDSource(v string) {
-	m.Set(field.NewNestedPartyIDSource(v))
-}
-
-//SetNestedPartyRole sets NestedPartyRole, Tag 538
-func (m NoNestedPartyIDs) SetNestedPartyRole(v int) {
-	m.Set(field.NewNestedPartyRole(v))
-}
+//NoNestedPartyIDsRepeatingGroup is a repeating group, Tag 539
+type NoNestedPartyIDsRepeatingGroup = components.NoNestedPartyIDsRepeatingGroup
 
-//SetNoNestedPartySubIDs sets NoNestedPartySubIDs, Tag 804
-func (m NoNestedPartyIDs) SetNoNestedPartySubIDs(f NoNestedPartySubIDsRepeatingGroup) {
-	m.SetGroup(f)
+//NewNoNestedPartyIDsRepeatingGroup returns an initialized, NoNestedPartyIDsRepeatingGroup
+func NewNoNestedPartyIDsRepeatingGroup() NoNestedPartyIDsRepeatingGroup {
+	return components.NewNoNestedPartyIDsRepeatingGroup()
 }
 
-//GetNestedPartyID gets NestedPartyID, Tag 524
-func (m NoNestedPartyIDs) GetNestedPartyID() (v string, err quickfix.MessageRejectError) {
-	var f field.NestedPartyIDField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
+//NoNestedPartyIDsStruct is a plain Go representation of a NoNestedPartyIDs group element
+type NoNestedPartyIDsStruct = components.NoNestedPartyIDsStruct
 
-//GetNestedPartyIDSource gets NestedPartyIDSource, Tag 525
-func (m NoNestedPartyIDs) GetNestedPartyIDSource() (v string, err quickfix.MessageRejectError) {
-	var f field.NestedPartyIDSourceField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
+//NoNestedPartySubIDs is a repeating group element, Tag 804
+type NoNestedPartySubIDs = components.NoNestedPartySubIDs
 
-//GetNestedPartyRole gets NestedPartyRole, Tag 538
-func (m NoNestedPartyIDs) GetNestedPartyRole() (v int, err quickfix.MessageRejectError) {
-	var f field.NestedPartyRoleField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
+//NoNestedPartySubIDsRepeatingGroup is a repeating group, Tag 804
+type NoNestedPartySubIDsRepeatingGroup = components.NoNestedPartySubIDsRepeatingGroup
 
-//GetNoNestedPartySubIDs gets NoNestedPartySubIDs, Tag 804
-func (m NoNestedPartyIDs) GetNoNestedPartySubIDs() (NoNestedPartySubIDsRepeatingGroup, quickfix.MessageRejectError) {
-	f := NewNoNestedPartySubIDsRepeatingGroup()
-	err := m.GetGroup(f)
-	return f, err
+//NewNoNestedPartySubIDsRepeatingGroup returns an initialized, NoNestedPartySubIDsRepeatingGroup
+func NewNoNestedPartySubIDsRepeatingGroup() NoNestedPartySubIDsRepeatingGroup {
+	return components.NewNoNestedPartySubIDsRepeatingGroup()
 }
 
-//HasNestedPartyID returns true if NestedPartyID is present, Tag 524
-func (m NoNestedPartyIDs) HasNestedPartyID() bool {
-	return m.Has(tag.NestedPartyID)
-}
+//NoNestedPartySubIDsStruct is a plain Go representation of a NoNestedPartySubIDs group element
+type NoNestedPartySubIDsStruct = components.NoNestedPartySubIDsStruct
 
-//HasNestedPartyIDSource returns true if NestedPartyIDSource is present, Tag 525
-func (m NoNestedPartyIDs) HasNestedPartyIDSource() bool {
-	return m.Has(tag.NestedPartyIDSource)
-}
-
-//HasNestedPartyRole returns true if NestedPartyRole is present, Tag 538
-func (m NoNestedPartyIDs) HasNestedPartyRole() bool {
-	return m.Has(tag.NestedPartyRole)
-}
-
-//HasNoNestedPartySubIDs returns true if NoNestedPartySubIDs is present, Tag 804
-func (m NoNestedPartyIDs) HasNoNestedPartySubIDs() bool {
-	return m.Has(tag.NoNestedPartySubIDs)
-}
-
-//NoNestedPartySubIDs is a repeating group element, Tag 804
-type NoNestedPartySubIDs struct {
-	*quickfix.Group
-}
-
-//SetNestedPartySubID sets NestedPartySubID, Tag 545
-func (m NoNestedPartySubIDs) SetNestedPartySubID(v string) {
-	m.Set(field.NewNestedPartySubID(v))
-}
-
-//SetNestedPartySubIDType sets NestedPartySubIDType, Tag 805
-func (m NoNestedPartySubIDs) SetNestedPartySubIDType(v int) {
-	m.Set(field.NewNestedPartySubIDType(v))
-}
-
-//GetNestedPartySubID gets NestedPartySubID, Tag 545
-func (m NoNestedPartySubIDs) GetNestedPartySubID() (v string, err quickfix.MessageRejectError) {
-	var f field.NestedPartySubIDField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetNestedPartySubIDType gets NestedPartySubIDType, Tag 805
-func (m NoNestedPartySubIDs) GetNestedPartySubIDType() (v int, err quickfix.MessageRejectError) {
-	var f field.NestedPartySubIDTypeField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//HasNestedPartySubID returns true if NestedPartySubID is present, Tag 545
-func (m NoNestedPartySubIDs) HasNestedPartySubID() bool {
-	return m.Has(tag.NestedPartySubID)
-}
-
-//HasNestedPartySubIDType returns true if NestedPartySubIDType is present, Tag 805
-func (m NoNestedPartySubIDs) HasNestedPartySubIDType() bool {
-	return m.Has(tag.NestedPartySubIDType)
-}
-
-//NoNestedPartySubIDsRepeatingGroup is a repeating group, Tag 804
-type NoNestedPartySubIDsRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
-
-//NewNoNestedPartySubIDsRepeatingGroup returns an initialized, NoNestedPartySubIDsRepeatingGroup
-func NewNoNestedPartySubIDsRepeatingGroup() NoNestedPartySubIDsRepeatingGroup {
-	return NoNestedPartySubIDsRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoNestedPartySubIDs,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.NestedPartySubID), quickfix.GroupElement(tag.NestedPartySubIDType)})}
-}
-
-//Add create and append a new NoNestedPartySubIDs to this group
-func (m NoNestedPartySubIDsRepeatingGroup) Add() NoNestedPartySubIDs {
-	g := m.RepeatingGroup.Add()
-	return NoNestedPartySubIDs{g}
-}
-
-//Get returns the ith NoNestedPartySubIDs in the NoNestedPartySubIDsRepeatinGroup
-func (m NoNestedPartySubIDsRepeatingGroup) Get(i int) NoNestedPartySubIDs {
-	return NoNestedPartySubIDs{m.RepeatingGroup.Get(i)}
-}
-
-//NoNestedPartyIDsRepeatingGroup is a repeating group, Tag 539
-type NoNestedPartyIDsRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
-
-//NewNoNestedPartyIDsRepeatingGroup returns an initialized, NoNestedPartyIDsRepeatingGroup
-func NewNoNestedPartyIDsRepeatingGroup() NoNestedPartyIDsRepeatingGroup {
-	return NoNestedPartyIDsRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoNestedPartyIDs,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.NestedPartyID), quickfix.GroupElement(tag.NestedPartyIDSource), quickfix.GroupElement(tag.NestedPartyRole), NewNoNestedPartySubIDsRepeatingGroup()})}
-}
-
-//Add create and append a new NoNestedPartyIDs to this group
-func (m NoNestedPartyIDsRepeatingGroup) Add() NoNestedPartyIDs {
-	g := m.RepeatingGroup.Add()
-	return NoNestedPartyIDs{g}
-}
-
-//Get returns the ith NoNestedPartyIDs in the NoNestedPartyIDsRepeatinGroup
-func (m NoNestedPartyIDsRepeatingGroup) Get(i int) NoNestedPartyIDs {
-	return NoNestedPartyIDs{m.RepeatingGroup.Get(i)}
-}
-
-//NoLegsRepeatingGroup is a repeating group, Tag 555
-type NoLegsRepeatingGroup struct {
-	*quickfix.RepeatingGroup
+//NoLegsRepeatingGroup is a repeating group, Tag 555
+type NoLegsRepeatingGroup struct {
+	*quickfix.RepeatingGroup
 }
 
 //NewNoLegsRepeatingGroup returns an initialized, NoLegsRepeatingGroup
@@ -6917,6 +6657,21 @@ func (m NoUnderlyings) HasEncodedUnderlyingIssuer() bool {
 	return m.Has(tag.EncodedUnderlyingIssuer)
 }
 
+//SetEncodedUnderlyingIssuerBytes sets EncodedUnderlyingIssuer, Tag 363, and EncodedUnderlyingIssuerLen, Tag 362, from v, which may hold any bytes including SOH
+func (m NoUnderlyings) SetEncodedUnderlyingIssuerBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedUnderlyingIssuerLen, tag.EncodedUnderlyingIssuer, v)
+}
+
+//GetEncodedUnderlyingIssuerBytes gets EncodedUnderlyingIssuer, Tag 363, checking its length against EncodedUnderlyingIssuerLen, Tag 362
+func (m NoUnderlyings) GetEncodedUnderlyingIssuerBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedUnderlyingIssuerLen, tag.EncodedUnderlyingIssuer)
+}
+
+//GetEncodedUnderlyingIssuerDecoded gets EncodedUnderlyingIssuer, Tag 363, decoded to UTF-8 using the MessageEncoding of h, the Header of the message holding it
+func (m NoUnderlyings) GetEncodedUnderlyingIssuerDecoded(h fix44.Header) (string, error) {
+	return fix44.DecodeRawData(h, m, tag.EncodedUnderlyingIssuerLen, tag.EncodedUnderlyingIssuer)
+}
+
 //HasUnderlyingSecurityDesc returns true if UnderlyingSecurityDesc is present, Tag 307
 func (m NoUnderlyings) HasUnderlyingSecurityDesc() bool {
 	return m.Has(tag.UnderlyingSecurityDesc)
@@ -6932,6 +6687,21 @@ func (m NoUnderlyings) HasEncodedUnderlyingSecurityDesc() bool {
 	return m.Has(tag.EncodedUnderlyingSecurityDesc)
 }
 
+//SetEncodedUnderlyingSecurityDescBytes sets EncodedUnderlyingSecurityDesc, Tag 365, and EncodedUnderlyingSecurityDescLen, Tag 364, from v, which may hold any bytes including SOH
+func (m NoUnderlyings) SetEncodedUnderlyingSecurityDescBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedUnderlyingSecurityDescLen, tag.EncodedUnderlyingSecurityDesc, v)
+}
+
+//GetEncodedUnderlyingSecurityDescBytes gets EncodedUnderlyingSecurityDesc, Tag 365, checking its length against EncodedUnderlyingSecurityDescLen, Tag 364
+func (m NoUnderlyings) GetEncodedUnderlyingSecurityDescBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedUnderlyingSecurityDescLen, tag.EncodedUnderlyingSecurityDesc)
+}
+
+//GetEncodedUnderlyingSecurityDescDecoded gets EncodedUnderlyingSecurityDesc, Tag 365, decoded to UTF-8 using the MessageEncoding of h, the Header of the message holding it
+func (m NoUnderlyings) GetEncodedUnderlyingSecurityDescDecoded(h fix44.Header) (string, error) {
+	return fix44.DecodeRawData(h, m, tag.EncodedUnderlyingSecurityDescLen, tag.EncodedUnderlyingSecurityDesc)
+}
+
 //HasUnderlyingCPProgram returns true if UnderlyingCPProgram is present, Tag 877
 func (m NoUnderlyings) HasUnderlyingCPProgram() bool {
 	return m.Has(tag.UnderlyingCPProgram)
@@ -6987,137 +6757,43 @@ func (m NoUnderlyings) HasNoUnderlyingStips() bool {
 	return m.Has(tag.NoUnderlyingStips)
 }
 
-//NoUnderlyingSecurityAltID is a repeating group element, Tag 457
-type NoUnderlyingSecurityAltID struct {
-	*quickfix.Group
-}
-
-//SetUnderlyingSecurityAltID sets UnderlyingSecurityAltID, Tag 458
-func (m NoUnderlyingSecurityAltID) SetUnderlyingSecurityAltID(v string) {
-	m.Set(field.NewUnderlyingSecurityAltID(v))
+//GetUnderlyingInstrument gets the UnderlyingInstrument component
+func (m NoUnderlyings) GetUnderlyingInstrument() components.UnderlyingInstrument {
+	return components.UnderlyingInstrument{&m.Group.FieldMap}
 }
 
-//SetUnderlyingSecurityAltIDSource sets UnderlyingSecurityAltIDSource, Tag 459
-func (m NoUnderlyingSecurityAltID) SetUnderlyingSecurityAltIDSource(v string) {
-	m.Set(field.NewUnderlyingSecurityAltIDSource(v))
-}
-
-//GetUnderlyingSecurityAltID gets UnderlyingSecurityAltID, Tag 458
-func (m NoUnderlyingSecurityAltID) GetUnderlyingSecurityAltID() (v string, err quickfix.MessageRejectError) {
-	var f field.UnderlyingSecurityAltIDField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
+//SetUnderlyingInstrument sets the UnderlyingInstrument component, copying the fields present in c
+func (m NoUnderlyings) SetUnderlyingInstrument(c components.UnderlyingInstrument) quickfix.MessageRejectError {
+	return c.CopyInto(&m.Group.FieldMap)
 }
 
-//GetUnderlyingSecurityAltIDSource gets UnderlyingSecurityAltIDSource, Tag 459
-func (m NoUnderlyingSecurityAltID) GetUnderlyingSecurityAltIDSource() (v string, err quickfix.MessageRejectError) {
-	var f field.UnderlyingSecurityAltIDSourceField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//HasUnderlyingSecurityAltID returns true if UnderlyingSecurityAltID is present, Tag 458
-func (m NoUnderlyingSecurityAltID) HasUnderlyingSecurityAltID() bool {
-	return m.Has(tag.UnderlyingSecurityAltID)
-}
-
-//HasUnderlyingSecurityAltIDSource returns true if UnderlyingSecurityAltIDSource is present, Tag 459
-func (m NoUnderlyingSecurityAltID) HasUnderlyingSecurityAltIDSource() bool {
-	return m.Has(tag.UnderlyingSecurityAltIDSource)
-}
+//NoUnderlyingSecurityAltID is a repeating group element, Tag 457
+type NoUnderlyingSecurityAltID = components.NoUnderlyingSecurityAltID
 
 //NoUnderlyingSecurityAltIDRepeatingGroup is a repeating group, Tag 457
-type NoUnderlyingSecurityAltIDRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
+type NoUnderlyingSecurityAltIDRepeatingGroup = components.NoUnderlyingSecurityAltIDRepeatingGroup
 
 //NewNoUnderlyingSecurityAltIDRepeatingGroup returns an initialized, NoUnderlyingSecurityAltIDRepeatingGroup
 func NewNoUnderlyingSecurityAltIDRepeatingGroup() NoUnderlyingSecurityAltIDRepeatingGroup {
-	return NoUnderlyingSecurityAltIDRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoUnderlyingSecurityAltID,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.UnderlyingSecurityAltID), quickfix.GroupElement(tag.UnderlyingSecurityAltIDSource)})}
+	return components.NewNoUnderlyingSecurityAltIDRepeatingGroup()
 }
 
-//Add create and append a new NoUnderlyingSecurityAltID to this group
-func (m NoUnderlyingSecurityAltIDRepeatingGroup) Add() NoUnderlyingSecurityAltID {
-	g := m.RepeatingGroup.Add()
-	return NoUnderlyingSecurityAltID{g}
-}
-
-//Get returns the ith NoUnderlyingSecurityAltID in the NoUnderlyingSecurityAltIDRepeatinGroup
-func (m NoUnderlyingSecurityAltIDRepeatingGroup) Get(i int) NoUnderlyingSecurityAltID {
-	return NoUnderlyingSecurityAltID{m.RepeatingGroup.Get(i)}
-}
+//NoUnderlyingSecurityAltIDStruct is a plain Go representation of a NoUnderlyingSecurityAltID group element
+type NoUnderlyingSecurityAltIDStruct = components.NoUnderlyingSecurityAltIDStruct
 
 //NoUnderlyingStips is a repeating group element, Tag 887
-type NoUnderlyingStips struct {
-	*quickfix.Group
-}
-
-//SetUnderlyingStipType sets UnderlyingStipType, Tag 888
-func (m NoUnderlyingStips) SetUnderlyingStipType(v string) {
-	m.Set(field.NewUnderlyingStipType(v))
-}
-
-//SetUnderlyingStipValue sets UnderlyingStipValue, Tag 889
-func (m NoUnderlyingStips) SetUnderlyingStipValue(v string) {
-	m.Set(field.NewUnderlyingStipValue(v))
-}
-
-//GetUnderlyingStipType gets UnderlyingStipType, Tag 888
-func (m NoUnderlyingStips) GetUnderlyingStipType() (v string, err quickfix.MessageRejectError) {
-	var f field.UnderlyingStipTypeField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetUnderlyingStipValue gets UnderlyingStipValue, Tag 889
-func (m NoUnderlyingStips) GetUnderlyingStipValue() (v string, err quickfix.MessageRejectError) {
-	var f field.UnderlyingStipValueField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//HasUnderlyingStipType returns true if UnderlyingStipType is present, Tag 888
-func (m NoUnderlyingStips) HasUnderlyingStipType() bool {
-	return m.Has(tag.UnderlyingStipType)
-}
-
-//HasUnderlyingStipValue returns true if UnderlyingStipValue is present, Tag 889
-func (m NoUnderlyingStips) HasUnderlyingStipValue() bool {
-	return m.Has(tag.UnderlyingStipValue)
-}
+type NoUnderlyingStips = components.NoUnderlyingStips
 
 //NoUnderlyingStipsRepeatingGroup is a repeating group, Tag 887
-type NoUnderlyingStipsRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
+type NoUnderlyingStipsRepeatingGroup = components.NoUnderlyingStipsRepeatingGroup
 
 //NewNoUnderlyingStipsRepeatingGroup returns an initialized, NoUnderlyingStipsRepeatingGroup
 func NewNoUnderlyingStipsRepeatingGroup() NoUnderlyingStipsRepeatingGroup {
-	return NoUnderlyingStipsRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoUnderlyingStips,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.UnderlyingStipType), quickfix.GroupElement(tag.UnderlyingStipValue)})}
-}
-
-//Add create and append a new NoUnderlyingStips to this group
-func (m NoUnderlyingStipsRepeatingGroup) Add() NoUnderlyingStips {
-	g := m.RepeatingGroup.Add()
-	return NoUnderlyingStips{g}
+	return components.NewNoUnderlyingStipsRepeatingGroup()
 }
 
-//Get returns the ith NoUnderlyingStips in the NoUnderlyingStipsRepeatinGroup
-func (m NoUnderlyingStipsRepeatingGroup) Get(i int) NoUnderlyingStips {
-	return NoUnderlyingStips{m.RepeatingGroup.Get(i)}
-}
+//NoUnderlyingStipsStruct is a plain Go representation of a NoUnderlyingStips group element
+type NoUnderlyingStipsStruct = components.NoUnderlyingStipsStruct
 
 //NoUnderlyingsRepeatingGroup is a repeating group, Tag 711
 type NoUnderlyingsRepeatingGroup struct {
@@ -7143,105 +6819,3797 @@ func (m NoUnderlyingsRepeatingGroup) Get(i int) NoUnderlyings {
 }
 
 //NoEvents is a repeating group element, Tag 864
-type NoEvents struct {
-	*quickfix.Group
-}
-
-//SetEventType sets EventType, Tag 865
-func (m NoEvents) SetEventType(v enum.EventType) {
-	m.Set(field.NewEventType(v))
-}
-
-//SetEventDate sets EventDate, Tag 866
-func (m NoEvents) SetEventDate(v string) {
-	m.Set(field.NewEventDate(v))
-}
+type NoEvents = components.NoEvents
 
-//SetEventPx sets EventPx, Tag 867
-func (m NoEvents) SetEventPx(value decimal.Decimal, scale int32) {
-	m.Set(field.NewEventPx(value, scale))
-}
-
-//SetEventText sets EventText, Tag 868
-func (m NoEvents) SetEventText(v string) {
-	m.Set(field.NewEventText(v))
-}
+//NoEventsRepeatingGroup is a repeating group, Tag 864
+type NoEventsRepeatingGroup = components.NoEventsRepeatingGroup
 
-//GetEventType gets EventType, Tag 865
-func (m NoEvents) GetEventType() (v enum.EventType, err quickfix.MessageRejectError) {
-	var f field.EventTypeField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
+//NewNoEventsRepeatingGroup returns an initialized, NoEventsRepeatingGroup
+func NewNoEventsRepeatingGroup() NoEventsRepeatingGroup {
+	return components.NewNoEventsRepeatingGroup()
+}
+
+//NoEventsStruct is a plain Go representation of a NoEvents group element
+type NoEventsStruct = components.NoEventsStruct
+
+//Struct is a plain Go representation of the ExecutionReport body, optional fields are nil when absent
+type Struct struct {
+	Account                    *string
+	AvgPx                      decimal.Decimal
+	ClOrdID                    *string
+	Commission                 *decimal.Decimal
+	CommType                   *enum.CommType
+	CumQty                     decimal.Decimal
+	Currency                   *string
+	ExecID                     string
+	ExecInst                   *enum.ExecInst
+	ExecRefID                  *string
+	HandlInst                  *enum.HandlInst
+	SecurityIDSource           *enum.SecurityIDSource
+	LastCapacity               *enum.LastCapacity
+	LastMkt                    *string
+	LastPx                     *decimal.Decimal
+	LastQty                    *decimal.Decimal
+	OrderID                    string
+	OrderQty                   *decimal.Decimal
+	OrdStatus                  enum.OrdStatus
+	OrdType                    *enum.OrdType
+	OrigClOrdID                *string
+	Price                      *decimal.Decimal
+	SecurityID                 *string
+	Side                       enum.Side
+	Symbol                     *string
+	Text                       *string
+	TimeInForce                *enum.TimeInForce
+	TransactTime               *time.Time
+	SettlType                  *enum.SettlType
+	SettlDate                  *string
+	SymbolSfx                  *enum.SymbolSfx
+	ListID                     *string
+	TradeDate                  *string
+	PositionEffect             *enum.PositionEffect
+	StopPx                     *decimal.Decimal
+	OrdRejReason               *enum.OrdRejReason
+	Issuer                     *string
+	SecurityDesc               *string
+	MinQty                     *decimal.Decimal
+	MaxFloor                   *decimal.Decimal
+	ReportToExch               *bool
+	NetMoney                   *decimal.Decimal
+	SettlCurrAmt               *decimal.Decimal
+	SettlCurrency              *string
+	ExpireTime                 *time.Time
+	NoMiscFees                 []NoMiscFeesStruct
+	ExecType                   enum.ExecType
+	LeavesQty                  decimal.Decimal
+	CashOrderQty               *decimal.Decimal
+	SettlCurrFxRate            *decimal.Decimal
+	SettlCurrFxRateCalc        *enum.SettlCurrFxRateCalc
+	NumDaysInterest            *int
+	AccruedInterestRate        *decimal.Decimal
+	AccruedInterestAmt         *decimal.Decimal
+	SecurityType               *enum.SecurityType
+	EffectiveTime              *time.Time
+	OrderQty2                  *decimal.Decimal
+	SettlDate2                 *string
+	LastSpotRate               *decimal.Decimal
+	LastForwardPoints          *decimal.Decimal
+	SecondaryOrderID           *string
+	MaturityMonthYear          *string
+	StrikePrice                *decimal.Decimal
+	OptAttribute               *string
+	SecurityExchange           *string
+	MaxShow                    *decimal.Decimal
+	PegOffsetValue             *decimal.Decimal
+	Spread                     *decimal.Decimal
+	BenchmarkCurveCurrency     *string
+	BenchmarkCurveName         *enum.BenchmarkCurveName
+	BenchmarkCurvePoint        *string
+	CouponRate                 *decimal.Decimal
+	CouponPaymentDate          *string
+	IssueDate                  *string
+	RepurchaseTerm             *int
+	RepurchaseRate             *decimal.Decimal
+	Factor                     *decimal.Decimal
+	TradeOriginationDate       *string
+	ExDate                     *string
+	ContractMultiplier         *decimal.Decimal
+	NoStipulations             []NoStipulationsStruct
+	YieldType                  *enum.YieldType
+	Yield                      *decimal.Decimal
+	TotalTakedown              *decimal.Decimal
+	Concession                 *decimal.Decimal
+	RepoCollateralSecurityType *int
+	RedemptionDate             *string
+	CreditRating               *string
+	TradedFlatSwitch           *bool
+	BasisFeatureDate           *string
+	BasisFeaturePrice          *decimal.Decimal
+	TradingSessionID           *enum.TradingSessionID
+	EncodedIssuerLen           *int
+	EncodedIssuer              *string
+	EncodedSecurityDescLen     *int
+	EncodedSecurityDesc        *string
+	EncodedTextLen             *int
+	EncodedText                *string
+	ComplianceID               *string
+	SolicitedFlag              *bool
+	ExecRestatementReason      *enum.ExecRestatementReason
+	GrossTradeAmt              *decimal.Decimal
+	NoContraBrokers            []NoContraBrokersStruct
+	DiscretionInst             *enum.DiscretionInst
+	DiscretionOffsetValue      *decimal.Decimal
+	PriceType                  *enum.PriceType
+	DayOrderQty                *decimal.Decimal
+	DayCumQty                  *decimal.Decimal
+	DayAvgPx                   *decimal.Decimal
+	GTBookingInst              *enum.GTBookingInst
+	ExpireDate                 *string
+	MultiLegReportingType      *enum.MultiLegReportingType
+	NoPartyIDs                 []NoPartyIDsStruct
+	NoSecurityAltID            []NoSecurityAltIDStruct
+	Product                    *enum.Product
+	CFICode                    *string
+	RoundingDirection          *enum.RoundingDirection
+	RoundingModulus            *decimal.Decimal
+	CountryOfIssue             *string
+	StateOrProvinceOfIssue     *string
+	LocaleOfIssue              *string
+	CommCurrency               *string
+	CancellationRights         *enum.CancellationRights
+	MoneyLaunderingStatus      *enum.MoneyLaunderingStatus
+	TransBkdTime               *time.Time
+	ExecPriceType              *enum.ExecPriceType
+	ExecPriceAdjustment        *decimal.Decimal
+	Designation                *string
+	FundRenewWaiv              *enum.FundRenewWaiv
+	RegistID                   *string
+	ExecValuationPoint         *time.Time
+	OrderPercent               *decimal.Decimal
+	NoContAmts                 []NoContAmtsStruct
+	SecondaryClOrdID           *string
+	SecondaryExecID            *string
+	OrderCapacity              *enum.OrderCapacity
+	OrderRestrictions          *enum.OrderRestrictions
+	MaturityDate               *string
+	InstrRegistry              *enum.InstrRegistry
+	CashMargin                 *enum.CashMargin
+	CrossID                    *string
+	CrossType                  *enum.CrossType
+	OrigCrossID                *string
+	NoLegs                     []NoLegsStruct
+	AccountType                *enum.AccountType
+	CustOrderCapacity          *enum.CustOrderCapacity
+	ClOrdLinkID                *string
+	MassStatusReqID            *string
+	DayBookingInst             *enum.DayBookingInst
+	BookingUnit                *enum.BookingUnit
+	PreallocMethod             *enum.PreallocMethod
+	TradingSessionSubID        *enum.TradingSessionSubID
+	ClearingFeeIndicator       *enum.ClearingFeeIndicator
+	WorkingIndicator           *bool
+	PriorityIndicator          *enum.PriorityIndicator
+	PriceImprovement           *decimal.Decimal
+	LastForwardPoints2         *decimal.Decimal
+	UnderlyingLastPx           *decimal.Decimal
+	UnderlyingLastQty          *decimal.Decimal
+	AcctIDSource               *enum.AcctIDSource
+	BenchmarkPrice             *decimal.Decimal
+	BenchmarkPriceType         *int
+	ContractSettlMonth         *string
+	LastParPx                  *decimal.Decimal
+	Pool                       *string
+	QuoteRespID                *string
+	YieldRedemptionDate        *string
+	YieldRedemptionPrice       *decimal.Decimal
+	YieldRedemptionPriceType   *int
+	BenchmarkSecurityID        *string
+	YieldCalcDate              *string
+	NoUnderlyings              []NoUnderlyingsStruct
+	InterestAtMaturity         *decimal.Decimal
+	BenchmarkSecurityIDSource  *string
+	SecuritySubType            *string
+	BookingType                *enum.BookingType
+	TerminationType            *enum.TerminationType
+	OrdStatusReqID             *string
+	CopyMsgIndicator           *bool
+	PegMoveType                *enum.PegMoveType
+	PegOffsetType              *enum.PegOffsetType
+	PegLimitType               *enum.PegLimitType
+	PegRoundDirection          *enum.PegRoundDirection
+	PeggedPrice                *decimal.Decimal
+	PegScope                   *enum.PegScope
+	DiscretionMoveType         *enum.DiscretionMoveType
+	DiscretionOffsetType       *enum.DiscretionOffsetType
+	DiscretionLimitType        *enum.DiscretionLimitType
+	DiscretionRoundDirection   *enum.DiscretionRoundDirection
+	DiscretionPrice            *decimal.Decimal
+	DiscretionScope            *enum.DiscretionScope
+	TargetStrategy             *enum.TargetStrategy
+	TargetStrategyParameters   *string
+	ParticipationRate          *decimal.Decimal
+	TargetStrategyPerformance  *decimal.Decimal
+	LastLiquidityInd           *enum.LastLiquidityInd
+	QtyType                    *enum.QtyType
+	NoEvents                   []NoEventsStruct
+	DatedDate                  *string
+	InterestAccrualDate        *string
+	CPProgram                  *enum.CPProgram
+	CPRegType                  *string
+	MarginRatio                *decimal.Decimal
+	TotNumReports              *int
+	LastRptRequested           *bool
+	AgreementDesc              *string
+	AgreementID                *string
+	AgreementDate              *string
+	StartDate                  *string
+	EndDate                    *string
+	AgreementCurrency          *string
+	DeliveryType               *enum.DeliveryType
+	EndAccruedInterestAmt      *decimal.Decimal
+	StartCash                  *decimal.Decimal
+	EndCash                    *decimal.Decimal
+	TimeBracket                *string
+	StrikeCurrency             *string
+}
+
+//Marshal copies the fields present in msg into a Struct
+func Marshal(msg ExecutionReport) (Struct, quickfix.MessageRejectError) {
+	var s Struct
+	if msg.HasAccount() {
+		v, err := msg.GetAccount()
+		if err != nil {
+			return s, err
+		}
+		s.Account = &v
+	}
+	if msg.HasAvgPx() {
+		v, err := msg.GetAvgPx()
+		if err != nil {
+			return s, err
+		}
+		s.AvgPx = v
+	}
+	if msg.HasClOrdID() {
+		v, err := msg.GetClOrdID()
+		if err != nil {
+			return s, err
+		}
+		s.ClOrdID = &v
+	}
+	if msg.HasCommission() {
+		v, err := msg.GetCommission()
+		if err != nil {
+			return s, err
+		}
+		s.Commission = &v
+	}
+	if msg.HasCommType() {
+		v, err := msg.GetCommType()
+		if err != nil {
+			return s, err
+		}
+		s.CommType = &v
+	}
+	if msg.HasCumQty() {
+		v, err := msg.GetCumQty()
+		if err != nil {
+			return s, err
+		}
+		s.CumQty = v
+	}
+	if msg.HasCurrency() {
+		v, err := msg.GetCurrency()
+		if err != nil {
+			return s, err
+		}
+		s.Currency = &v
+	}
+	if msg.HasExecID() {
+		v, err := msg.GetExecID()
+		if err != nil {
+			return s, err
+		}
+		s.ExecID = v
+	}
+	if msg.HasExecInst() {
+		v, err := msg.GetExecInst()
+		if err != nil {
+			return s, err
+		}
+		s.ExecInst = &v
+	}
+	if msg.HasExecRefID() {
+		v, err := msg.GetExecRefID()
+		if err != nil {
+			return s, err
+		}
+		s.ExecRefID = &v
+	}
+	if msg.HasHandlInst() {
+		v, err := msg.GetHandlInst()
+		if err != nil {
+			return s, err
+		}
+		s.HandlInst = &v
+	}
+	if msg.HasSecurityIDSource() {
+		v, err := msg.GetSecurityIDSource()
+		if err != nil {
+			return s, err
+		}
+		s.SecurityIDSource = &v
+	}
+	if msg.HasLastCapacity() {
+		v, err := msg.GetLastCapacity()
+		if err != nil {
+			return s, err
+		}
+		s.LastCapacity = &v
+	}
+	if msg.HasLastMkt() {
+		v, err := msg.GetLastMkt()
+		if err != nil {
+			return s, err
+		}
+		s.LastMkt = &v
+	}
+	if msg.HasLastPx() {
+		v, err := msg.GetLastPx()
+		if err != nil {
+			return s, err
+		}
+		s.LastPx = &v
+	}
+	if msg.HasLastQty() {
+		v, err := msg.GetLastQty()
+		if err != nil {
+			return s, err
+		}
+		s.LastQty = &v
+	}
+	if msg.HasOrderID() {
+		v, err := msg.GetOrderID()
+		if err != nil {
+			return s, err
+		}
+		s.OrderID = v
+	}
+	if msg.HasOrderQty() {
+		v, err := msg.GetOrderQty()
+		if err != nil {
+			return s, err
+		}
+		s.OrderQty = &v
+	}
+	if msg.HasOrdStatus() {
+		v, err := msg.GetOrdStatus()
+		if err != nil {
+			return s, err
+		}
+		s.OrdStatus = v
+	}
+	if msg.HasOrdType() {
+		v, err := msg.GetOrdType()
+		if err != nil {
+			return s, err
+		}
+		s.OrdType = &v
+	}
+	if msg.HasOrigClOrdID() {
+		v, err := msg.GetOrigClOrdID()
+		if err != nil {
+			return s, err
+		}
+		s.OrigClOrdID = &v
+	}
+	if msg.HasPrice() {
+		v, err := msg.GetPrice()
+		if err != nil {
+			return s, err
+		}
+		s.Price = &v
+	}
+	if msg.HasSecurityID() {
+		v, err := msg.GetSecurityID()
+		if err != nil {
+			return s, err
+		}
+		s.SecurityID = &v
+	}
+	if msg.HasSide() {
+		v, err := msg.GetSide()
+		if err != nil {
+			return s, err
+		}
+		s.Side = v
+	}
+	if msg.HasSymbol() {
+		v, err := msg.GetSymbol()
+		if err != nil {
+			return s, err
+		}
+		s.Symbol = &v
+	}
+	if msg.HasText() {
+		v, err := msg.GetText()
+		if err != nil {
+			return s, err
+		}
+		s.Text = &v
+	}
+	if msg.HasTimeInForce() {
+		v, err := msg.GetTimeInForce()
+		if err != nil {
+			return s, err
+		}
+		s.TimeInForce = &v
+	}
+	if msg.HasTransactTime() {
+		v, err := msg.GetTransactTime()
+		if err != nil {
+			return s, err
+		}
+		s.TransactTime = &v
+	}
+	if msg.HasSettlType() {
+		v, err := msg.GetSettlType()
+		if err != nil {
+			return s, err
+		}
+		s.SettlType = &v
+	}
+	if msg.HasSettlDate() {
+		v, err := msg.GetSettlDate()
+		if err != nil {
+			return s, err
+		}
+		s.SettlDate = &v
+	}
+	if msg.HasSymbolSfx() {
+		v, err := msg.GetSymbolSfx()
+		if err != nil {
+			return s, err
+		}
+		s.SymbolSfx = &v
+	}
+	if msg.HasListID() {
+		v, err := msg.GetListID()
+		if err != nil {
+			return s, err
+		}
+		s.ListID = &v
+	}
+	if msg.HasTradeDate() {
+		v, err := msg.GetTradeDate()
+		if err != nil {
+			return s, err
+		}
+		s.TradeDate = &v
+	}
+	if msg.HasPositionEffect() {
+		v, err := msg.GetPositionEffect()
+		if err != nil {
+			return s, err
+		}
+		s.PositionEffect = &v
+	}
+	if msg.HasStopPx() {
+		v, err := msg.GetStopPx()
+		if err != nil {
+			return s, err
+		}
+		s.StopPx = &v
+	}
+	if msg.HasOrdRejReason() {
+		v, err := msg.GetOrdRejReason()
+		if err != nil {
+			return s, err
+		}
+		s.OrdRejReason = &v
+	}
+	if msg.HasIssuer() {
+		v, err := msg.GetIssuer()
+		if err != nil {
+			return s, err
+		}
+		s.Issuer = &v
+	}
+	if msg.HasSecurityDesc() {
+		v, err := msg.GetSecurityDesc()
+		if err != nil {
+			return s, err
+		}
+		s.SecurityDesc = &v
+	}
+	if msg.HasMinQty() {
+		v, err := msg.GetMinQty()
+		if err != nil {
+			return s, err
+		}
+		s.MinQty = &v
+	}
+	if msg.HasMaxFloor() {
+		v, err := msg.GetMaxFloor()
+		if err != nil {
+			return s, err
+		}
+		s.MaxFloor = &v
+	}
+	if msg.HasReportToExch() {
+		v, err := msg.GetReportToExch()
+		if err != nil {
+			return s, err
+		}
+		s.ReportToExch = &v
+	}
+	if msg.HasNetMoney() {
+		v, err := msg.GetNetMoney()
+		if err != nil {
+			return s, err
+		}
+		s.NetMoney = &v
+	}
+	if msg.HasSettlCurrAmt() {
+		v, err := msg.GetSettlCurrAmt()
+		if err != nil {
+			return s, err
+		}
+		s.SettlCurrAmt = &v
+	}
+	if msg.HasSettlCurrency() {
+		v, err := msg.GetSettlCurrency()
+		if err != nil {
+			return s, err
+		}
+		s.SettlCurrency = &v
+	}
+	if msg.HasExpireTime() {
+		v, err := msg.GetExpireTime()
+		if err != nil {
+			return s, err
+		}
+		s.ExpireTime = &v
+	}
+	if msg.HasNoMiscFees() {
+		g, err := msg.GetNoMiscFees()
+		if err != nil {
+			return s, err
+		}
+		if s.NoMiscFees, err = MarshalNoMiscFees(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasExecType() {
+		v, err := msg.GetExecType()
+		if err != nil {
+			return s, err
+		}
+		s.ExecType = v
+	}
+	if msg.HasLeavesQty() {
+		v, err := msg.GetLeavesQty()
+		if err != nil {
+			return s, err
+		}
+		s.LeavesQty = v
+	}
+	if msg.HasCashOrderQty() {
+		v, err := msg.GetCashOrderQty()
+		if err != nil {
+			return s, err
+		}
+		s.CashOrderQty = &v
+	}
+	if msg.HasSettlCurrFxRate() {
+		v, err := msg.GetSettlCurrFxRate()
+		if err != nil {
+			return s, err
+		}
+		s.SettlCurrFxRate = &v
+	}
+	if msg.HasSettlCurrFxRateCalc() {
+		v, err := msg.GetSettlCurrFxRateCalc()
+		if err != nil {
+			return s, err
+		}
+		s.SettlCurrFxRateCalc = &v
+	}
+	if msg.HasNumDaysInterest() {
+		v, err := msg.GetNumDaysInterest()
+		if err != nil {
+			return s, err
+		}
+		s.NumDaysInterest = &v
+	}
+	if msg.HasAccruedInterestRate() {
+		v, err := msg.GetAccruedInterestRate()
+		if err != nil {
+			return s, err
+		}
+		s.AccruedInterestRate = &v
+	}
+	if msg.HasAccruedInterestAmt() {
+		v, err := msg.GetAccruedInterestAmt()
+		if err != nil {
+			return s, err
+		}
+		s.AccruedInterestAmt = &v
+	}
+	if msg.HasSecurityType() {
+		v, err := msg.GetSecurityType()
+		if err != nil {
+			return s, err
+		}
+		s.SecurityType = &v
+	}
+	if msg.HasEffectiveTime() {
+		v, err := msg.GetEffectiveTime()
+		if err != nil {
+			return s, err
+		}
+		s.EffectiveTime = &v
+	}
+	if msg.HasOrderQty2() {
+		v, err := msg.GetOrderQty2()
+		if err != nil {
+			return s, err
+		}
+		s.OrderQty2 = &v
+	}
+	if msg.HasSettlDate2() {
+		v, err := msg.GetSettlDate2()
+		if err != nil {
+			return s, err
+		}
+		s.SettlDate2 = &v
+	}
+	if msg.HasLastSpotRate() {
+		v, err := msg.GetLastSpotRate()
+		if err != nil {
+			return s, err
+		}
+		s.LastSpotRate = &v
+	}
+	if msg.HasLastForwardPoints() {
+		v, err := msg.GetLastForwardPoints()
+		if err != nil {
+			return s, err
+		}
+		s.LastForwardPoints = &v
+	}
+	if msg.HasSecondaryOrderID() {
+		v, err := msg.GetSecondaryOrderID()
+		if err != nil {
+			return s, err
+		}
+		s.SecondaryOrderID = &v
+	}
+	if msg.HasMaturityMonthYear() {
+		v, err := msg.GetMaturityMonthYear()
+		if err != nil {
+			return s, err
+		}
+		s.MaturityMonthYear = &v
+	}
+	if msg.HasStrikePrice() {
+		v, err := msg.GetStrikePrice()
+		if err != nil {
+			return s, err
+		}
+		s.StrikePrice = &v
+	}
+	if msg.HasOptAttribute() {
+		v, err := msg.GetOptAttribute()
+		if err != nil {
+			return s, err
+		}
+		s.OptAttribute = &v
+	}
+	if msg.HasSecurityExchange() {
+		v, err := msg.GetSecurityExchange()
+		if err != nil {
+			return s, err
+		}
+		s.SecurityExchange = &v
+	}
+	if msg.HasMaxShow() {
+		v, err := msg.GetMaxShow()
+		if err != nil {
+			return s, err
+		}
+		s.MaxShow = &v
+	}
+	if msg.HasPegOffsetValue() {
+		v, err := msg.GetPegOffsetValue()
+		if err != nil {
+			return s, err
+		}
+		s.PegOffsetValue = &v
+	}
+	if msg.HasSpread() {
+		v, err := msg.GetSpread()
+		if err != nil {
+			return s, err
+		}
+		s.Spread = &v
+	}
+	if msg.HasBenchmarkCurveCurrency() {
+		v, err := msg.GetBenchmarkCurveCurrency()
+		if err != nil {
+			return s, err
+		}
+		s.BenchmarkCurveCurrency = &v
+	}
+	if msg.HasBenchmarkCurveName() {
+		v, err := msg.GetBenchmarkCurveName()
+		if err != nil {
+			return s, err
+		}
+		s.BenchmarkCurveName = &v
+	}
+	if msg.HasBenchmarkCurvePoint() {
+		v, err := msg.GetBenchmarkCurvePoint()
+		if err != nil {
+			return s, err
+		}
+		s.BenchmarkCurvePoint = &v
+	}
+	if msg.HasCouponRate() {
+		v, err := msg.GetCouponRate()
+		if err != nil {
+			return s, err
+		}
+		s.CouponRate = &v
+	}
+	if msg.HasCouponPaymentDate() {
+		v, err := msg.GetCouponPaymentDate()
+		if err != nil {
+			return s, err
+		}
+		s.CouponPaymentDate = &v
+	}
+	if msg.HasIssueDate() {
+		v, err := msg.GetIssueDate()
+		if err != nil {
+			return s, err
+		}
+		s.IssueDate = &v
+	}
+	if msg.HasRepurchaseTerm() {
+		v, err := msg.GetRepurchaseTerm()
+		if err != nil {
+			return s, err
+		}
+		s.RepurchaseTerm = &v
+	}
+	if msg.HasRepurchaseRate() {
+		v, err := msg.GetRepurchaseRate()
+		if err != nil {
+			return s, err
+		}
+		s.RepurchaseRate = &v
+	}
+	if msg.HasFactor() {
+		v, err := msg.GetFactor()
+		if err != nil {
+			return s, err
+		}
+		s.Factor = &v
+	}
+	if msg.HasTradeOriginationDate() {
+		v, err := msg.GetTradeOriginationDate()
+		if err != nil {
+			return s, err
+		}
+		s.TradeOriginationDate = &v
+	}
+	if msg.HasExDate() {
+		v, err := msg.GetExDate()
+		if err != nil {
+			return s, err
+		}
+		s.ExDate = &v
+	}
+	if msg.HasContractMultiplier() {
+		v, err := msg.GetContractMultiplier()
+		if err != nil {
+			return s, err
+		}
+		s.ContractMultiplier = &v
+	}
+	if msg.HasNoStipulations() {
+		g, err := msg.GetNoStipulations()
+		if err != nil {
+			return s, err
+		}
+		if s.NoStipulations, err = components.MarshalNoStipulations(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasYieldType() {
+		v, err := msg.GetYieldType()
+		if err != nil {
+			return s, err
+		}
+		s.YieldType = &v
+	}
+	if msg.HasYield() {
+		v, err := msg.GetYield()
+		if err != nil {
+			return s, err
+		}
+		s.Yield = &v
+	}
+	if msg.HasTotalTakedown() {
+		v, err := msg.GetTotalTakedown()
+		if err != nil {
+			return s, err
+		}
+		s.TotalTakedown = &v
+	}
+	if msg.HasConcession() {
+		v, err := msg.GetConcession()
+		if err != nil {
+			return s, err
+		}
+		s.Concession = &v
+	}
+	if msg.HasRepoCollateralSecurityType() {
+		v, err := msg.GetRepoCollateralSecurityType()
+		if err != nil {
+			return s, err
+		}
+		s.RepoCollateralSecurityType = &v
+	}
+	if msg.HasRedemptionDate() {
+		v, err := msg.GetRedemptionDate()
+		if err != nil {
+			return s, err
+		}
+		s.RedemptionDate = &v
+	}
+	if msg.HasCreditRating() {
+		v, err := msg.GetCreditRating()
+		if err != nil {
+			return s, err
+		}
+		s.CreditRating = &v
+	}
+	if msg.HasTradedFlatSwitch() {
+		v, err := msg.GetTradedFlatSwitch()
+		if err != nil {
+			return s, err
+		}
+		s.TradedFlatSwitch = &v
+	}
+	if msg.HasBasisFeatureDate() {
+		v, err := msg.GetBasisFeatureDate()
+		if err != nil {
+			return s, err
+		}
+		s.BasisFeatureDate = &v
+	}
+	if msg.HasBasisFeaturePrice() {
+		v, err := msg.GetBasisFeaturePrice()
+		if err != nil {
+			return s, err
+		}
+		s.BasisFeaturePrice = &v
+	}
+	if msg.HasTradingSessionID() {
+		v, err := msg.GetTradingSessionID()
+		if err != nil {
+			return s, err
+		}
+		s.TradingSessionID = &v
+	}
+	if msg.HasEncodedIssuerLen() {
+		v, err := msg.GetEncodedIssuerLen()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedIssuerLen = &v
+	}
+	if msg.HasEncodedIssuer() {
+		v, err := msg.GetEncodedIssuer()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedIssuer = &v
+	}
+	if msg.HasEncodedSecurityDescLen() {
+		v, err := msg.GetEncodedSecurityDescLen()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedSecurityDescLen = &v
+	}
+	if msg.HasEncodedSecurityDesc() {
+		v, err := msg.GetEncodedSecurityDesc()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedSecurityDesc = &v
+	}
+	if msg.HasEncodedTextLen() {
+		v, err := msg.GetEncodedTextLen()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedTextLen = &v
+	}
+	if msg.HasEncodedText() {
+		v, err := msg.GetEncodedText()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedText = &v
+	}
+	if msg.HasComplianceID() {
+		v, err := msg.GetComplianceID()
+		if err != nil {
+			return s, err
+		}
+		s.ComplianceID = &v
+	}
+	if msg.HasSolicitedFlag() {
+		v, err := msg.GetSolicitedFlag()
+		if err != nil {
+			return s, err
+		}
+		s.SolicitedFlag = &v
+	}
+	if msg.HasExecRestatementReason() {
+		v, err := msg.GetExecRestatementReason()
+		if err != nil {
+			return s, err
+		}
+		s.ExecRestatementReason = &v
+	}
+	if msg.HasGrossTradeAmt() {
+		v, err := msg.GetGrossTradeAmt()
+		if err != nil {
+			return s, err
+		}
+		s.GrossTradeAmt = &v
+	}
+	if msg.HasNoContraBrokers() {
+		g, err := msg.GetNoContraBrokers()
+		if err != nil {
+			return s, err
+		}
+		if s.NoContraBrokers, err = MarshalNoContraBrokers(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasDiscretionInst() {
+		v, err := msg.GetDiscretionInst()
+		if err != nil {
+			return s, err
+		}
+		s.DiscretionInst = &v
+	}
+	if msg.HasDiscretionOffsetValue() {
+		v, err := msg.GetDiscretionOffsetValue()
+		if err != nil {
+			return s, err
+		}
+		s.DiscretionOffsetValue = &v
+	}
+	if msg.HasPriceType() {
+		v, err := msg.GetPriceType()
+		if err != nil {
+			return s, err
+		}
+		s.PriceType = &v
+	}
+	if msg.HasDayOrderQty() {
+		v, err := msg.GetDayOrderQty()
+		if err != nil {
+			return s, err
+		}
+		s.DayOrderQty = &v
+	}
+	if msg.HasDayCumQty() {
+		v, err := msg.GetDayCumQty()
+		if err != nil {
+			return s, err
+		}
+		s.DayCumQty = &v
+	}
+	if msg.HasDayAvgPx() {
+		v, err := msg.GetDayAvgPx()
+		if err != nil {
+			return s, err
+		}
+		s.DayAvgPx = &v
+	}
+	if msg.HasGTBookingInst() {
+		v, err := msg.GetGTBookingInst()
+		if err != nil {
+			return s, err
+		}
+		s.GTBookingInst = &v
+	}
+	if msg.HasExpireDate() {
+		v, err := msg.GetExpireDate()
+		if err != nil {
+			return s, err
+		}
+		s.ExpireDate = &v
+	}
+	if msg.HasMultiLegReportingType() {
+		v, err := msg.GetMultiLegReportingType()
+		if err != nil {
+			return s, err
+		}
+		s.MultiLegReportingType = &v
+	}
+	if msg.HasNoPartyIDs() {
+		g, err := msg.GetNoPartyIDs()
+		if err != nil {
+			return s, err
+		}
+		if s.NoPartyIDs, err = components.MarshalNoPartyIDs(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasNoSecurityAltID() {
+		g, err := msg.GetNoSecurityAltID()
+		if err != nil {
+			return s, err
+		}
+		if s.NoSecurityAltID, err = components.MarshalNoSecurityAltID(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasProduct() {
+		v, err := msg.GetProduct()
+		if err != nil {
+			return s, err
+		}
+		s.Product = &v
+	}
+	if msg.HasCFICode() {
+		v, err := msg.GetCFICode()
+		if err != nil {
+			return s, err
+		}
+		s.CFICode = &v
+	}
+	if msg.HasRoundingDirection() {
+		v, err := msg.GetRoundingDirection()
+		if err != nil {
+			return s, err
+		}
+		s.RoundingDirection = &v
+	}
+	if msg.HasRoundingModulus() {
+		v, err := msg.GetRoundingModulus()
+		if err != nil {
+			return s, err
+		}
+		s.RoundingModulus = &v
+	}
+	if msg.HasCountryOfIssue() {
+		v, err := msg.GetCountryOfIssue()
+		if err != nil {
+			return s, err
+		}
+		s.CountryOfIssue = &v
+	}
+	if msg.HasStateOrProvinceOfIssue() {
+		v, err := msg.GetStateOrProvinceOfIssue()
+		if err != nil {
+			return s, err
+		}
+		s.StateOrProvinceOfIssue = &v
+	}
+	if msg.HasLocaleOfIssue() {
+		v, err := msg.GetLocaleOfIssue()
+		if err != nil {
+			return s, err
+		}
+		s.LocaleOfIssue = &v
+	}
+	if msg.HasCommCurrency() {
+		v, err := msg.GetCommCurrency()
+		if err != nil {
+			return s, err
+		}
+		s.CommCurrency = &v
+	}
+	if msg.HasCancellationRights() {
+		v, err := msg.GetCancellationRights()
+		if err != nil {
+			return s, err
+		}
+		s.CancellationRights = &v
+	}
+	if msg.HasMoneyLaunderingStatus() {
+		v, err := msg.GetMoneyLaunderingStatus()
+		if err != nil {
+			return s, err
+		}
+		s.MoneyLaunderingStatus = &v
+	}
+	if msg.HasTransBkdTime() {
+		v, err := msg.GetTransBkdTime()
+		if err != nil {
+			return s, err
+		}
+		s.TransBkdTime = &v
+	}
+	if msg.HasExecPriceType() {
+		v, err := msg.GetExecPriceType()
+		if err != nil {
+			return s, err
+		}
+		s.ExecPriceType = &v
+	}
+	if msg.HasExecPriceAdjustment() {
+		v, err := msg.GetExecPriceAdjustment()
+		if err != nil {
+			return s, err
+		}
+		s.ExecPriceAdjustment = &v
+	}
+	if msg.HasDesignation() {
+		v, err := msg.GetDesignation()
+		if err != nil {
+			return s, err
+		}
+		s.Designation = &v
+	}
+	if msg.HasFundRenewWaiv() {
+		v, err := msg.GetFundRenewWaiv()
+		if err != nil {
+			return s, err
+		}
+		s.FundRenewWaiv = &v
+	}
+	if msg.HasRegistID() {
+		v, err := msg.GetRegistID()
+		if err != nil {
+			return s, err
+		}
+		s.RegistID = &v
+	}
+	if msg.HasExecValuationPoint() {
+		v, err := msg.GetExecValuationPoint()
+		if err != nil {
+			return s, err
+		}
+		s.ExecValuationPoint = &v
+	}
+	if msg.HasOrderPercent() {
+		v, err := msg.GetOrderPercent()
+		if err != nil {
+			return s, err
+		}
+		s.OrderPercent = &v
+	}
+	if msg.HasNoContAmts() {
+		g, err := msg.GetNoContAmts()
+		if err != nil {
+			return s, err
+		}
+		if s.NoContAmts, err = MarshalNoContAmts(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasSecondaryClOrdID() {
+		v, err := msg.GetSecondaryClOrdID()
+		if err != nil {
+			return s, err
+		}
+		s.SecondaryClOrdID = &v
+	}
+	if msg.HasSecondaryExecID() {
+		v, err := msg.GetSecondaryExecID()
+		if err != nil {
+			return s, err
+		}
+		s.SecondaryExecID = &v
+	}
+	if msg.HasOrderCapacity() {
+		v, err := msg.GetOrderCapacity()
+		if err != nil {
+			return s, err
+		}
+		s.OrderCapacity = &v
+	}
+	if msg.HasOrderRestrictions() {
+		v, err := msg.GetOrderRestrictions()
+		if err != nil {
+			return s, err
+		}
+		s.OrderRestrictions = &v
+	}
+	if msg.HasMaturityDate() {
+		v, err := msg.GetMaturityDate()
+		if err != nil {
+			return s, err
+		}
+		s.MaturityDate = &v
+	}
+	if msg.HasInstrRegistry() {
+		v, err := msg.GetInstrRegistry()
+		if err != nil {
+			return s, err
+		}
+		s.InstrRegistry = &v
+	}
+	if msg.HasCashMargin() {
+		v, err := msg.GetCashMargin()
+		if err != nil {
+			return s, err
+		}
+		s.CashMargin = &v
+	}
+	if msg.HasCrossID() {
+		v, err := msg.GetCrossID()
+		if err != nil {
+			return s, err
+		}
+		s.CrossID = &v
+	}
+	if msg.HasCrossType() {
+		v, err := msg.GetCrossType()
+		if err != nil {
+			return s, err
+		}
+		s.CrossType = &v
+	}
+	if msg.HasOrigCrossID() {
+		v, err := msg.GetOrigCrossID()
+		if err != nil {
+			return s, err
+		}
+		s.OrigCrossID = &v
+	}
+	if msg.HasNoLegs() {
+		g, err := msg.GetNoLegs()
+		if err != nil {
+			return s, err
+		}
+		if s.NoLegs, err = MarshalNoLegs(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasAccountType() {
+		v, err := msg.GetAccountType()
+		if err != nil {
+			return s, err
+		}
+		s.AccountType = &v
+	}
+	if msg.HasCustOrderCapacity() {
+		v, err := msg.GetCustOrderCapacity()
+		if err != nil {
+			return s, err
+		}
+		s.CustOrderCapacity = &v
+	}
+	if msg.HasClOrdLinkID() {
+		v, err := msg.GetClOrdLinkID()
+		if err != nil {
+			return s, err
+		}
+		s.ClOrdLinkID = &v
+	}
+	if msg.HasMassStatusReqID() {
+		v, err := msg.GetMassStatusReqID()
+		if err != nil {
+			return s, err
+		}
+		s.MassStatusReqID = &v
+	}
+	if msg.HasDayBookingInst() {
+		v, err := msg.GetDayBookingInst()
+		if err != nil {
+			return s, err
+		}
+		s.DayBookingInst = &v
+	}
+	if msg.HasBookingUnit() {
+		v, err := msg.GetBookingUnit()
+		if err != nil {
+			return s, err
+		}
+		s.BookingUnit = &v
+	}
+	if msg.HasPreallocMethod() {
+		v, err := msg.GetPreallocMethod()
+		if err != nil {
+			return s, err
+		}
+		s.PreallocMethod = &v
+	}
+	if msg.HasTradingSessionSubID() {
+		v, err := msg.GetTradingSessionSubID()
+		if err != nil {
+			return s, err
+		}
+		s.TradingSessionSubID = &v
+	}
+	if msg.HasClearingFeeIndicator() {
+		v, err := msg.GetClearingFeeIndicator()
+		if err != nil {
+			return s, err
+		}
+		s.ClearingFeeIndicator = &v
+	}
+	if msg.HasWorkingIndicator() {
+		v, err := msg.GetWorkingIndicator()
+		if err != nil {
+			return s, err
+		}
+		s.WorkingIndicator = &v
+	}
+	if msg.HasPriorityIndicator() {
+		v, err := msg.GetPriorityIndicator()
+		if err != nil {
+			return s, err
+		}
+		s.PriorityIndicator = &v
+	}
+	if msg.HasPriceImprovement() {
+		v, err := msg.GetPriceImprovement()
+		if err != nil {
+			return s, err
+		}
+		s.PriceImprovement = &v
+	}
+	if msg.HasLastForwardPoints2() {
+		v, err := msg.GetLastForwardPoints2()
+		if err != nil {
+			return s, err
+		}
+		s.LastForwardPoints2 = &v
+	}
+	if msg.HasUnderlyingLastPx() {
+		v, err := msg.GetUnderlyingLastPx()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingLastPx = &v
+	}
+	if msg.HasUnderlyingLastQty() {
+		v, err := msg.GetUnderlyingLastQty()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingLastQty = &v
+	}
+	if msg.HasAcctIDSource() {
+		v, err := msg.GetAcctIDSource()
+		if err != nil {
+			return s, err
+		}
+		s.AcctIDSource = &v
+	}
+	if msg.HasBenchmarkPrice() {
+		v, err := msg.GetBenchmarkPrice()
+		if err != nil {
+			return s, err
+		}
+		s.BenchmarkPrice = &v
+	}
+	if msg.HasBenchmarkPriceType() {
+		v, err := msg.GetBenchmarkPriceType()
+		if err != nil {
+			return s, err
+		}
+		s.BenchmarkPriceType = &v
+	}
+	if msg.HasContractSettlMonth() {
+		v, err := msg.GetContractSettlMonth()
+		if err != nil {
+			return s, err
+		}
+		s.ContractSettlMonth = &v
+	}
+	if msg.HasLastParPx() {
+		v, err := msg.GetLastParPx()
+		if err != nil {
+			return s, err
+		}
+		s.LastParPx = &v
+	}
+	if msg.HasPool() {
+		v, err := msg.GetPool()
+		if err != nil {
+			return s, err
+		}
+		s.Pool = &v
+	}
+	if msg.HasQuoteRespID() {
+		v, err := msg.GetQuoteRespID()
+		if err != nil {
+			return s, err
+		}
+		s.QuoteRespID = &v
+	}
+	if msg.HasYieldRedemptionDate() {
+		v, err := msg.GetYieldRedemptionDate()
+		if err != nil {
+			return s, err
+		}
+		s.YieldRedemptionDate = &v
+	}
+	if msg.HasYieldRedemptionPrice() {
+		v, err := msg.GetYieldRedemptionPrice()
+		if err != nil {
+			return s, err
+		}
+		s.YieldRedemptionPrice = &v
+	}
+	if msg.HasYieldRedemptionPriceType() {
+		v, err := msg.GetYieldRedemptionPriceType()
+		if err != nil {
+			return s, err
+		}
+		s.YieldRedemptionPriceType = &v
+	}
+	if msg.HasBenchmarkSecurityID() {
+		v, err := msg.GetBenchmarkSecurityID()
+		if err != nil {
+			return s, err
+		}
+		s.BenchmarkSecurityID = &v
+	}
+	if msg.HasYieldCalcDate() {
+		v, err := msg.GetYieldCalcDate()
+		if err != nil {
+			return s, err
+		}
+		s.YieldCalcDate = &v
+	}
+	if msg.HasNoUnderlyings() {
+		g, err := msg.GetNoUnderlyings()
+		if err != nil {
+			return s, err
+		}
+		if s.NoUnderlyings, err = MarshalNoUnderlyings(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasInterestAtMaturity() {
+		v, err := msg.GetInterestAtMaturity()
+		if err != nil {
+			return s, err
+		}
+		s.InterestAtMaturity = &v
+	}
+	if msg.HasBenchmarkSecurityIDSource() {
+		v, err := msg.GetBenchmarkSecurityIDSource()
+		if err != nil {
+			return s, err
+		}
+		s.BenchmarkSecurityIDSource = &v
+	}
+	if msg.HasSecuritySubType() {
+		v, err := msg.GetSecuritySubType()
+		if err != nil {
+			return s, err
+		}
+		s.SecuritySubType = &v
+	}
+	if msg.HasBookingType() {
+		v, err := msg.GetBookingType()
+		if err != nil {
+			return s, err
+		}
+		s.BookingType = &v
+	}
+	if msg.HasTerminationType() {
+		v, err := msg.GetTerminationType()
+		if err != nil {
+			return s, err
+		}
+		s.TerminationType = &v
+	}
+	if msg.HasOrdStatusReqID() {
+		v, err := msg.GetOrdStatusReqID()
+		if err != nil {
+			return s, err
+		}
+		s.OrdStatusReqID = &v
+	}
+	if msg.HasCopyMsgIndicator() {
+		v, err := msg.GetCopyMsgIndicator()
+		if err != nil {
+			return s, err
+		}
+		s.CopyMsgIndicator = &v
+	}
+	if msg.HasPegMoveType() {
+		v, err := msg.GetPegMoveType()
+		if err != nil {
+			return s, err
+		}
+		s.PegMoveType = &v
+	}
+	if msg.HasPegOffsetType() {
+		v, err := msg.GetPegOffsetType()
+		if err != nil {
+			return s, err
+		}
+		s.PegOffsetType = &v
+	}
+	if msg.HasPegLimitType() {
+		v, err := msg.GetPegLimitType()
+		if err != nil {
+			return s, err
+		}
+		s.PegLimitType = &v
+	}
+	if msg.HasPegRoundDirection() {
+		v, err := msg.GetPegRoundDirection()
+		if err != nil {
+			return s, err
+		}
+		s.PegRoundDirection = &v
+	}
+	if msg.HasPeggedPrice() {
+		v, err := msg.GetPeggedPrice()
+		if err != nil {
+			return s, err
+		}
+		s.PeggedPrice = &v
+	}
+	if msg.HasPegScope() {
+		v, err := msg.GetPegScope()
+		if err != nil {
+			return s, err
+		}
+		s.PegScope = &v
+	}
+	if msg.HasDiscretionMoveType() {
+		v, err := msg.GetDiscretionMoveType()
+		if err != nil {
+			return s, err
+		}
+		s.DiscretionMoveType = &v
+	}
+	if msg.HasDiscretionOffsetType() {
+		v, err := msg.GetDiscretionOffsetType()
+		if err != nil {
+			return s, err
+		}
+		s.DiscretionOffsetType = &v
+	}
+	if msg.HasDiscretionLimitType() {
+		v, err := msg.GetDiscretionLimitType()
+		if err != nil {
+			return s, err
+		}
+		s.DiscretionLimitType = &v
+	}
+	if msg.HasDiscretionRoundDirection() {
+		v, err := msg.GetDiscretionRoundDirection()
+		if err != nil {
+			return s, err
+		}
+		s.DiscretionRoundDirection = &v
+	}
+	if msg.HasDiscretionPrice() {
+		v, err := msg.GetDiscretionPrice()
+		if err != nil {
+			return s, err
+		}
+		s.DiscretionPrice = &v
+	}
+	if msg.HasDiscretionScope() {
+		v, err := msg.GetDiscretionScope()
+		if err != nil {
+			return s, err
+		}
+		s.DiscretionScope = &v
+	}
+	if msg.HasTargetStrategy() {
+		v, err := msg.GetTargetStrategy()
+		if err != nil {
+			return s, err
+		}
+		s.TargetStrategy = &v
+	}
+	if msg.HasTargetStrategyParameters() {
+		v, err := msg.GetTargetStrategyParameters()
+		if err != nil {
+			return s, err
+		}
+		s.TargetStrategyParameters = &v
+	}
+	if msg.HasParticipationRate() {
+		v, err := msg.GetParticipationRate()
+		if err != nil {
+			return s, err
+		}
+		s.ParticipationRate = &v
+	}
+	if msg.HasTargetStrategyPerformance() {
+		v, err := msg.GetTargetStrategyPerformance()
+		if err != nil {
+			return s, err
+		}
+		s.TargetStrategyPerformance = &v
+	}
+	if msg.HasLastLiquidityInd() {
+		v, err := msg.GetLastLiquidityInd()
+		if err != nil {
+			return s, err
+		}
+		s.LastLiquidityInd = &v
+	}
+	if msg.HasQtyType() {
+		v, err := msg.GetQtyType()
+		if err != nil {
+			return s, err
+		}
+		s.QtyType = &v
+	}
+	if msg.HasNoEvents() {
+		g, err := msg.GetNoEvents()
+		if err != nil {
+			return s, err
+		}
+		if s.NoEvents, err = components.MarshalNoEvents(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasDatedDate() {
+		v, err := msg.GetDatedDate()
+		if err != nil {
+			return s, err
+		}
+		s.DatedDate = &v
+	}
+	if msg.HasInterestAccrualDate() {
+		v, err := msg.GetInterestAccrualDate()
+		if err != nil {
+			return s, err
+		}
+		s.InterestAccrualDate = &v
+	}
+	if msg.HasCPProgram() {
+		v, err := msg.GetCPProgram()
+		if err != nil {
+			return s, err
+		}
+		s.CPProgram = &v
+	}
+	if msg.HasCPRegType() {
+		v, err := msg.GetCPRegType()
+		if err != nil {
+			return s, err
+		}
+		s.CPRegType = &v
+	}
+	if msg.HasMarginRatio() {
+		v, err := msg.GetMarginRatio()
+		if err != nil {
+			return s, err
+		}
+		s.MarginRatio = &v
+	}
+	if msg.HasTotNumReports() {
+		v, err := msg.GetTotNumReports()
+		if err != nil {
+			return s, err
+		}
+		s.TotNumReports = &v
+	}
+	if msg.HasLastRptRequested() {
+		v, err := msg.GetLastRptRequested()
+		if err != nil {
+			return s, err
+		}
+		s.LastRptRequested = &v
+	}
+	if msg.HasAgreementDesc() {
+		v, err := msg.GetAgreementDesc()
+		if err != nil {
+			return s, err
+		}
+		s.AgreementDesc = &v
+	}
+	if msg.HasAgreementID() {
+		v, err := msg.GetAgreementID()
+		if err != nil {
+			return s, err
+		}
+		s.AgreementID = &v
+	}
+	if msg.HasAgreementDate() {
+		v, err := msg.GetAgreementDate()
+		if err != nil {
+			return s, err
+		}
+		s.AgreementDate = &v
+	}
+	if msg.HasStartDate() {
+		v, err := msg.GetStartDate()
+		if err != nil {
+			return s, err
+		}
+		s.StartDate = &v
+	}
+	if msg.HasEndDate() {
+		v, err := msg.GetEndDate()
+		if err != nil {
+			return s, err
+		}
+		s.EndDate = &v
+	}
+	if msg.HasAgreementCurrency() {
+		v, err := msg.GetAgreementCurrency()
+		if err != nil {
+			return s, err
+		}
+		s.AgreementCurrency = &v
+	}
+	if msg.HasDeliveryType() {
+		v, err := msg.GetDeliveryType()
+		if err != nil {
+			return s, err
+		}
+		s.DeliveryType = &v
+	}
+	if msg.HasEndAccruedInterestAmt() {
+		v, err := msg.GetEndAccruedInterestAmt()
+		if err != nil {
+			return s, err
+		}
+		s.EndAccruedInterestAmt = &v
+	}
+	if msg.HasStartCash() {
+		v, err := msg.GetStartCash()
+		if err != nil {
+			return s, err
+		}
+		s.StartCash = &v
+	}
+	if msg.HasEndCash() {
+		v, err := msg.GetEndCash()
+		if err != nil {
+			return s, err
+		}
+		s.EndCash = &v
+	}
+	if msg.HasTimeBracket() {
+		v, err := msg.GetTimeBracket()
+		if err != nil {
+			return s, err
+		}
+		s.TimeBracket = &v
+	}
+	if msg.HasStrikeCurrency() {
+		v, err := msg.GetStrikeCurrency()
+		if err != nil {
+			return s, err
+		}
+		s.StrikeCurrency = &v
+	}
+	return s, nil
+}
+
+//Unmarshal builds a ExecutionReport from s
+func Unmarshal(s Struct) ExecutionReport {
+	m := New(field.NewOrderID(s.OrderID), field.NewExecID(s.ExecID), field.NewExecType(s.ExecType), field.NewOrdStatus(s.OrdStatus), field.NewSide(s.Side), field.NewLeavesQty(s.LeavesQty, fix44.DecimalScale(s.LeavesQty)), field.NewCumQty(s.CumQty, fix44.DecimalScale(s.CumQty)), field.NewAvgPx(s.AvgPx, fix44.DecimalScale(s.AvgPx)))
+	if s.Account != nil {
+		m.SetAccount(*s.Account)
 	}
-	return
-}
-
-//GetEventDate gets EventDate, Tag 866
-func (m NoEvents) GetEventDate() (v string, err quickfix.MessageRejectError) {
-	var f field.EventDateField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
+	if s.ClOrdID != nil {
+		m.SetClOrdID(*s.ClOrdID)
 	}
-	return
-}
-
-//GetEventPx gets EventPx, Tag 867
-func (m NoEvents) GetEventPx() (v decimal.Decimal, err quickfix.MessageRejectError) {
-	var f field.EventPxField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
+	if s.Commission != nil {
+		m.SetCommission(*s.Commission, fix44.DecimalScale(*s.Commission))
 	}
-	return
-}
-
-//GetEventText gets EventText, Tag 868
-func (m NoEvents) GetEventText() (v string, err quickfix.MessageRejectError) {
-	var f field.EventTextField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
+	if s.CommType != nil {
+		m.SetCommType(*s.CommType)
 	}
-	return
-}
-
-//HasEventType returns true if EventType is present, Tag 865
-func (m NoEvents) HasEventType() bool {
-	return m.Has(tag.EventType)
-}
-
-//HasEventDate returns true if EventDate is present, Tag 866
-func (m NoEvents) HasEventDate() bool {
-	return m.Has(tag.EventDate)
-}
-
-//HasEventPx returns true if EventPx is present, Tag 867
-func (m NoEvents) HasEventPx() bool {
-	return m.Has(tag.EventPx)
-}
-
-//HasEventText returns true if EventText is present, Tag 868
-func (m NoEvents) HasEventText() bool {
-	return m.Has(tag.EventText)
-}
-
-//NoEventsRepeatingGroup is a repeating group, Tag 864
-type NoEventsRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
-
-//NewNoEventsRepeatingGroup returns an initialized, NoEventsRepeatingGroup
-func NewNoEventsRepeatingGroup() NoEventsRepeatingGroup {
-	return NoEventsRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoEvents,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.EventType), quickfix.GroupElement(tag.EventDate), quickfix.GroupElement(tag.EventPx), quickfix.GroupElement(tag.EventText)})}
-}
-
-//Add create and append a new NoEvents to this group
-func (m NoEventsRepeatingGroup) Add() NoEvents {
-	g := m.RepeatingGroup.Add()
-	return NoEvents{g}
-}
-
-//Get returns the ith NoEvents in the NoEventsRepeatinGroup
-func (m NoEventsRepeatingGroup) Get(i int) NoEvents {
-	return NoEvents{m.RepeatingGroup.Get(i)}
+	if s.Currency != nil {
+		m.SetCurrency(*s.Currency)
+	}
+	if s.ExecInst != nil {
+		m.SetExecInst(*s.ExecInst)
+	}
+	if s.ExecRefID != nil {
+		m.SetExecRefID(*s.ExecRefID)
+	}
+	if s.HandlInst != nil {
+		m.SetHandlInst(*s.HandlInst)
+	}
+	if s.SecurityIDSource != nil {
+		m.SetSecurityIDSource(*s.SecurityIDSource)
+	}
+	if s.LastCapacity != nil {
+		m.SetLastCapacity(*s.LastCapacity)
+	}
+	if s.LastMkt != nil {
+		m.SetLastMkt(*s.LastMkt)
+	}
+	if s.LastPx != nil {
+		m.SetLastPx(*s.LastPx, fix44.DecimalScale(*s.LastPx))
+	}
+	if s.LastQty != nil {
+		m.SetLastQty(*s.LastQty, fix44.DecimalScale(*s.LastQty))
+	}
+	if s.OrderQty != nil {
+		m.SetOrderQty(*s.OrderQty, fix44.DecimalScale(*s.OrderQty))
+	}
+	if s.OrdType != nil {
+		m.SetOrdType(*s.OrdType)
+	}
+	if s.OrigClOrdID != nil {
+		m.SetOrigClOrdID(*s.OrigClOrdID)
+	}
+	if s.Price != nil {
+		m.SetPrice(*s.Price, fix44.DecimalScale(*s.Price))
+	}
+	if s.SecurityID != nil {
+		m.SetSecurityID(*s.SecurityID)
+	}
+	if s.Symbol != nil {
+		m.SetSymbol(*s.Symbol)
+	}
+	if s.Text != nil {
+		m.SetText(*s.Text)
+	}
+	if s.TimeInForce != nil {
+		m.SetTimeInForce(*s.TimeInForce)
+	}
+	if s.TransactTime != nil {
+		m.SetTransactTime(*s.TransactTime)
+	}
+	if s.SettlType != nil {
+		m.SetSettlType(*s.SettlType)
+	}
+	if s.SettlDate != nil {
+		m.SetSettlDate(*s.SettlDate)
+	}
+	if s.SymbolSfx != nil {
+		m.SetSymbolSfx(*s.SymbolSfx)
+	}
+	if s.ListID != nil {
+		m.SetListID(*s.ListID)
+	}
+	if s.TradeDate != nil {
+		m.SetTradeDate(*s.TradeDate)
+	}
+	if s.PositionEffect != nil {
+		m.SetPositionEffect(*s.PositionEffect)
+	}
+	if s.StopPx != nil {
+		m.SetStopPx(*s.StopPx, fix44.DecimalScale(*s.StopPx))
+	}
+	if s.OrdRejReason != nil {
+		m.SetOrdRejReason(*s.OrdRejReason)
+	}
+	if s.Issuer != nil {
+		m.SetIssuer(*s.Issuer)
+	}
+	if s.SecurityDesc != nil {
+		m.SetSecurityDesc(*s.SecurityDesc)
+	}
+	if s.MinQty != nil {
+		m.SetMinQty(*s.MinQty, fix44.DecimalScale(*s.MinQty))
+	}
+	if s.MaxFloor != nil {
+		m.SetMaxFloor(*s.MaxFloor, fix44.DecimalScale(*s.MaxFloor))
+	}
+	if s.ReportToExch != nil {
+		m.SetReportToExch(*s.ReportToExch)
+	}
+	if s.NetMoney != nil {
+		m.SetNetMoney(*s.NetMoney, fix44.DecimalScale(*s.NetMoney))
+	}
+	if s.SettlCurrAmt != nil {
+		m.SetSettlCurrAmt(*s.SettlCurrAmt, fix44.DecimalScale(*s.SettlCurrAmt))
+	}
+	if s.SettlCurrency != nil {
+		m.SetSettlCurrency(*s.SettlCurrency)
+	}
+	if s.ExpireTime != nil {
+		m.SetExpireTime(*s.ExpireTime)
+	}
+	if s.NoMiscFees != nil {
+		m.SetNoMiscFees(UnmarshalNoMiscFees(s.NoMiscFees))
+	}
+	if s.CashOrderQty != nil {
+		m.SetCashOrderQty(*s.CashOrderQty, fix44.DecimalScale(*s.CashOrderQty))
+	}
+	if s.SettlCurrFxRate != nil {
+		m.SetSettlCurrFxRate(*s.SettlCurrFxRate, fix44.DecimalScale(*s.SettlCurrFxRate))
+	}
+	if s.SettlCurrFxRateCalc != nil {
+		m.SetSettlCurrFxRateCalc(*s.SettlCurrFxRateCalc)
+	}
+	if s.NumDaysInterest != nil {
+		m.SetNumDaysInterest(*s.NumDaysInterest)
+	}
+	if s.AccruedInterestRate != nil {
+		m.SetAccruedInterestRate(*s.AccruedInterestRate, fix44.DecimalScale(*s.AccruedInterestRate))
+	}
+	if s.AccruedInterestAmt != nil {
+		m.SetAccruedInterestAmt(*s.AccruedInterestAmt, fix44.DecimalScale(*s.AccruedInterestAmt))
+	}
+	if s.SecurityType != nil {
+		m.SetSecurityType(*s.SecurityType)
+	}
+	if s.EffectiveTime != nil {
+		m.SetEffectiveTime(*s.EffectiveTime)
+	}
+	if s.OrderQty2 != nil {
+		m.SetOrderQty2(*s.OrderQty2, fix44.DecimalScale(*s.OrderQty2))
+	}
+	if s.SettlDate2 != nil {
+		m.SetSettlDate2(*s.SettlDate2)
+	}
+	if s.LastSpotRate != nil {
+		m.SetLastSpotRate(*s.LastSpotRate, fix44.DecimalScale(*s.LastSpotRate))
+	}
+	if s.LastForwardPoints != nil {
+		m.SetLastForwardPoints(*s.LastForwardPoints, fix44.DecimalScale(*s.LastForwardPoints))
+	}
+	if s.SecondaryOrderID != nil {
+		m.SetSecondaryOrderID(*s.SecondaryOrderID)
+	}
+	if s.MaturityMonthYear != nil {
+		m.SetMaturityMonthYear(*s.MaturityMonthYear)
+	}
+	if s.StrikePrice != nil {
+		m.SetStrikePrice(*s.StrikePrice, fix44.DecimalScale(*s.StrikePrice))
+	}
+	if s.OptAttribute != nil {
+		m.SetOptAttribute(*s.OptAttribute)
+	}
+	if s.SecurityExchange != nil {
+		m.SetSecurityExchange(*s.SecurityExchange)
+	}
+	if s.MaxShow != nil {
+		m.SetMaxShow(*s.MaxShow, fix44.DecimalScale(*s.MaxShow))
+	}
+	if s.PegOffsetValue != nil {
+		m.SetPegOffsetValue(*s.PegOffsetValue, fix44.DecimalScale(*s.PegOffsetValue))
+	}
+	if s.Spread != nil {
+		m.SetSpread(*s.Spread, fix44.DecimalScale(*s.Spread))
+	}
+	if s.BenchmarkCurveCurrency != nil {
+		m.SetBenchmarkCurveCurrency(*s.BenchmarkCurveCurrency)
+	}
+	if s.BenchmarkCurveName != nil {
+		m.SetBenchmarkCurveName(*s.BenchmarkCurveName)
+	}
+	if s.BenchmarkCurvePoint != nil {
+		m.SetBenchmarkCurvePoint(*s.BenchmarkCurvePoint)
+	}
+	if s.CouponRate != nil {
+		m.SetCouponRate(*s.CouponRate, fix44.DecimalScale(*s.CouponRate))
+	}
+	if s.CouponPaymentDate != nil {
+		m.SetCouponPaymentDate(*s.CouponPaymentDate)
+	}
+	if s.IssueDate != nil {
+		m.SetIssueDate(*s.IssueDate)
+	}
+	if s.RepurchaseTerm != nil {
+		m.SetRepurchaseTerm(*s.RepurchaseTerm)
+	}
+	if s.RepurchaseRate != nil {
+		m.SetRepurchaseRate(*s.RepurchaseRate, fix44.DecimalScale(*s.RepurchaseRate))
+	}
+	if s.Factor != nil {
+		m.SetFactor(*s.Factor, fix44.DecimalScale(*s.Factor))
+	}
+	if s.TradeOriginationDate != nil {
+		m.SetTradeOriginationDate(*s.TradeOriginationDate)
+	}
+	if s.ExDate != nil {
+		m.SetExDate(*s.ExDate)
+	}
+	if s.ContractMultiplier != nil {
+		m.SetContractMultiplier(*s.ContractMultiplier, fix44.DecimalScale(*s.ContractMultiplier))
+	}
+	if s.NoStipulations != nil {
+		m.SetNoStipulations(components.UnmarshalNoStipulations(s.NoStipulations))
+	}
+	if s.YieldType != nil {
+		m.SetYieldType(*s.YieldType)
+	}
+	if s.Yield != nil {
+		m.SetYield(*s.Yield, fix44.DecimalScale(*s.Yield))
+	}
+	if s.TotalTakedown != nil {
+		m.SetTotalTakedown(*s.TotalTakedown, fix44.DecimalScale(*s.TotalTakedown))
+	}
+	if s.Concession != nil {
+		m.SetConcession(*s.Concession, fix44.DecimalScale(*s.Concession))
+	}
+	if s.RepoCollateralSecurityType != nil {
+		m.SetRepoCollateralSecurityType(*s.RepoCollateralSecurityType)
+	}
+	if s.RedemptionDate != nil {
+		m.SetRedemptionDate(*s.RedemptionDate)
+	}
+	if s.CreditRating != nil {
+		m.SetCreditRating(*s.CreditRating)
+	}
+	if s.TradedFlatSwitch != nil {
+		m.SetTradedFlatSwitch(*s.TradedFlatSwitch)
+	}
+	if s.BasisFeatureDate != nil {
+		m.SetBasisFeatureDate(*s.BasisFeatureDate)
+	}
+	if s.BasisFeaturePrice != nil {
+		m.SetBasisFeaturePrice(*s.BasisFeaturePrice, fix44.DecimalScale(*s.BasisFeaturePrice))
+	}
+	if s.TradingSessionID != nil {
+		m.SetTradingSessionID(*s.TradingSessionID)
+	}
+	if s.EncodedIssuerLen != nil {
+		m.SetEncodedIssuerLen(*s.EncodedIssuerLen)
+	}
+	if s.EncodedIssuer != nil {
+		m.SetEncodedIssuer(*s.EncodedIssuer)
+	}
+	if s.EncodedSecurityDescLen != nil {
+		m.SetEncodedSecurityDescLen(*s.EncodedSecurityDescLen)
+	}
+	if s.EncodedSecurityDesc != nil {
+		m.SetEncodedSecurityDesc(*s.EncodedSecurityDesc)
+	}
+	if s.EncodedTextLen != nil {
+		m.SetEncodedTextLen(*s.EncodedTextLen)
+	}
+	if s.EncodedText != nil {
+		m.SetEncodedText(*s.EncodedText)
+	}
+	if s.ComplianceID != nil {
+		m.SetComplianceID(*s.ComplianceID)
+	}
+	if s.SolicitedFlag != nil {
+		m.SetSolicitedFlag(*s.SolicitedFlag)
+	}
+	if s.ExecRestatementReason != nil {
+		m.SetExecRestatementReason(*s.ExecRestatementReason)
+	}
+	if s.GrossTradeAmt != nil {
+		m.SetGrossTradeAmt(*s.GrossTradeAmt, fix44.DecimalScale(*s.GrossTradeAmt))
+	}
+	if s.NoContraBrokers != nil {
+		m.SetNoContraBrokers(UnmarshalNoContraBrokers(s.NoContraBrokers))
+	}
+	if s.DiscretionInst != nil {
+		m.SetDiscretionInst(*s.DiscretionInst)
+	}
+	if s.DiscretionOffsetValue != nil {
+		m.SetDiscretionOffsetValue(*s.DiscretionOffsetValue, fix44.DecimalScale(*s.DiscretionOffsetValue))
+	}
+	if s.PriceType != nil {
+		m.SetPriceType(*s.PriceType)
+	}
+	if s.DayOrderQty != nil {
+		m.SetDayOrderQty(*s.DayOrderQty, fix44.DecimalScale(*s.DayOrderQty))
+	}
+	if s.DayCumQty != nil {
+		m.SetDayCumQty(*s.DayCumQty, fix44.DecimalScale(*s.DayCumQty))
+	}
+	if s.DayAvgPx != nil {
+		m.SetDayAvgPx(*s.DayAvgPx, fix44.DecimalScale(*s.DayAvgPx))
+	}
+	if s.GTBookingInst != nil {
+		m.SetGTBookingInst(*s.GTBookingInst)
+	}
+	if s.ExpireDate != nil {
+		m.SetExpireDate(*s.ExpireDate)
+	}
+	if s.MultiLegReportingType != nil {
+		m.SetMultiLegReportingType(*s.MultiLegReportingType)
+	}
+	if s.NoPartyIDs != nil {
+		m.SetNoPartyIDs(components.UnmarshalNoPartyIDs(s.NoPartyIDs))
+	}
+	if s.NoSecurityAltID != nil {
+		m.SetNoSecurityAltID(components.UnmarshalNoSecurityAltID(s.NoSecurityAltID))
+	}
+	if s.Product != nil {
+		m.SetProduct(*s.Product)
+	}
+	if s.CFICode != nil {
+		m.SetCFICode(*s.CFICode)
+	}
+	if s.RoundingDirection != nil {
+		m.SetRoundingDirection(*s.RoundingDirection)
+	}
+	if s.RoundingModulus != nil {
+		m.SetRoundingModulus(*s.RoundingModulus, fix44.DecimalScale(*s.RoundingModulus))
+	}
+	if s.CountryOfIssue != nil {
+		m.SetCountryOfIssue(*s.CountryOfIssue)
+	}
+	if s.StateOrProvinceOfIssue != nil {
+		m.SetStateOrProvinceOfIssue(*s.StateOrProvinceOfIssue)
+	}
+	if s.LocaleOfIssue != nil {
+		m.SetLocaleOfIssue(*s.LocaleOfIssue)
+	}
+	if s.CommCurrency != nil {
+		m.SetCommCurrency(*s.CommCurrency)
+	}
+	if s.CancellationRights != nil {
+		m.SetCancellationRights(*s.CancellationRights)
+	}
+	if s.MoneyLaunderingStatus != nil {
+		m.SetMoneyLaunderingStatus(*s.MoneyLaunderingStatus)
+	}
+	if s.TransBkdTime != nil {
+		m.SetTransBkdTime(*s.TransBkdTime)
+	}
+	if s.ExecPriceType != nil {
+		m.SetExecPriceType(*s.ExecPriceType)
+	}
+	if s.ExecPriceAdjustment != nil {
+		m.SetExecPriceAdjustment(*s.ExecPriceAdjustment, fix44.DecimalScale(*s.ExecPriceAdjustment))
+	}
+	if s.Designation != nil {
+		m.SetDesignation(*s.Designation)
+	}
+	if s.FundRenewWaiv != nil {
+		m.SetFundRenewWaiv(*s.FundRenewWaiv)
+	}
+	if s.RegistID != nil {
+		m.SetRegistID(*s.RegistID)
+	}
+	if s.ExecValuationPoint != nil {
+		m.SetExecValuationPoint(*s.ExecValuationPoint)
+	}
+	if s.OrderPercent != nil {
+		m.SetOrderPercent(*s.OrderPercent, fix44.DecimalScale(*s.OrderPercent))
+	}
+	if s.NoContAmts != nil {
+		m.SetNoContAmts(UnmarshalNoContAmts(s.NoContAmts))
+	}
+	if s.SecondaryClOrdID != nil {
+		m.SetSecondaryClOrdID(*s.SecondaryClOrdID)
+	}
+	if s.SecondaryExecID != nil {
+		m.SetSecondaryExecID(*s.SecondaryExecID)
+	}
+	if s.OrderCapacity != nil {
+		m.SetOrderCapacity(*s.OrderCapacity)
+	}
+	if s.OrderRestrictions != nil {
+		m.SetOrderRestrictions(*s.OrderRestrictions)
+	}
+	if s.MaturityDate != nil {
+		m.SetMaturityDate(*s.MaturityDate)
+	}
+	if s.InstrRegistry != nil {
+		m.SetInstrRegistry(*s.InstrRegistry)
+	}
+	if s.CashMargin != nil {
+		m.SetCashMargin(*s.CashMargin)
+	}
+	if s.CrossID != nil {
+		m.SetCrossID(*s.CrossID)
+	}
+	if s.CrossType != nil {
+		m.SetCrossType(*s.CrossType)
+	}
+	if s.OrigCrossID != nil {
+		m.SetOrigCrossID(*s.OrigCrossID)
+	}
+	if s.NoLegs != nil {
+		m.SetNoLegs(UnmarshalNoLegs(s.NoLegs))
+	}
+	if s.AccountType != nil {
+		m.SetAccountType(*s.AccountType)
+	}
+	if s.CustOrderCapacity != nil {
+		m.SetCustOrderCapacity(*s.CustOrderCapacity)
+	}
+	if s.ClOrdLinkID != nil {
+		m.SetClOrdLinkID(*s.ClOrdLinkID)
+	}
+	if s.MassStatusReqID != nil {
+		m.SetMassStatusReqID(*s.MassStatusReqID)
+	}
+	if s.DayBookingInst != nil {
+		m.SetDayBookingInst(*s.DayBookingInst)
+	}
+	if s.BookingUnit != nil {
+		m.SetBookingUnit(*s.BookingUnit)
+	}
+	if s.PreallocMethod != nil {
+		m.SetPreallocMethod(*s.PreallocMethod)
+	}
+	if s.TradingSessionSubID != nil {
+		m.SetTradingSessionSubID(*s.TradingSessionSubID)
+	}
+	if s.ClearingFeeIndicator != nil {
+		m.SetClearingFeeIndicator(*s.ClearingFeeIndicator)
+	}
+	if s.WorkingIndicator != nil {
+		m.SetWorkingIndicator(*s.WorkingIndicator)
+	}
+	if s.PriorityIndicator != nil {
+		m.SetPriorityIndicator(*s.PriorityIndicator)
+	}
+	if s.PriceImprovement != nil {
+		m.SetPriceImprovement(*s.PriceImprovement, fix44.DecimalScale(*s.PriceImprovement))
+	}
+	if s.LastForwardPoints2 != nil {
+		m.SetLastForwardPoints2(*s.LastForwardPoints2, fix44.DecimalScale(*s.LastForwardPoints2))
+	}
+	if s.UnderlyingLastPx != nil {
+		m.SetUnderlyingLastPx(*s.UnderlyingLastPx, fix44.DecimalScale(*s.UnderlyingLastPx))
+	}
+	if s.UnderlyingLastQty != nil {
+		m.SetUnderlyingLastQty(*s.UnderlyingLastQty, fix44.DecimalScale(*s.UnderlyingLastQty))
+	}
+	if s.AcctIDSource != nil {
+		m.SetAcctIDSource(*s.AcctIDSource)
+	}
+	if s.BenchmarkPrice != nil {
+		m.SetBenchmarkPrice(*s.BenchmarkPrice, fix44.DecimalScale(*s.BenchmarkPrice))
+	}
+	if s.BenchmarkPriceType != nil {
+		m.SetBenchmarkPriceType(*s.BenchmarkPriceType)
+	}
+	if s.ContractSettlMonth != nil {
+		m.SetContractSettlMonth(*s.ContractSettlMonth)
+	}
+	if s.LastParPx != nil {
+		m.SetLastParPx(*s.LastParPx, fix44.DecimalScale(*s.LastParPx))
+	}
+	if s.Pool != nil {
+		m.SetPool(*s.Pool)
+	}
+	if s.QuoteRespID != nil {
+		m.SetQuoteRespID(*s.QuoteRespID)
+	}
+	if s.YieldRedemptionDate != nil {
+		m.SetYieldRedemptionDate(*s.YieldRedemptionDate)
+	}
+	if s.YieldRedemptionPrice != nil {
+		m.SetYieldRedemptionPrice(*s.YieldRedemptionPrice, fix44.DecimalScale(*s.YieldRedemptionPrice))
+	}
+	if s.YieldRedemptionPriceType != nil {
+		m.SetYieldRedemptionPriceType(*s.YieldRedemptionPriceType)
+	}
+	if s.BenchmarkSecurityID != nil {
+		m.SetBenchmarkSecurityID(*s.BenchmarkSecurityID)
+	}
+	if s.YieldCalcDate != nil {
+		m.SetYieldCalcDate(*s.YieldCalcDate)
+	}
+	if s.NoUnderlyings != nil {
+		m.SetNoUnderlyings(UnmarshalNoUnderlyings(s.NoUnderlyings))
+	}
+	if s.InterestAtMaturity != nil {
+		m.SetInterestAtMaturity(*s.InterestAtMaturity, fix44.DecimalScale(*s.InterestAtMaturity))
+	}
+	if s.BenchmarkSecurityIDSource != nil {
+		m.SetBenchmarkSecurityIDSource(*s.BenchmarkSecurityIDSource)
+	}
+	if s.SecuritySubType != nil {
+		m.SetSecuritySubType(*s.SecuritySubType)
+	}
+	if s.BookingType != nil {
+		m.SetBookingType(*s.BookingType)
+	}
+	if s.TerminationType != nil {
+		m.SetTerminationType(*s.TerminationType)
+	}
+	if s.OrdStatusReqID != nil {
+		m.SetOrdStatusReqID(*s.OrdStatusReqID)
+	}
+	if s.CopyMsgIndicator != nil {
+		m.SetCopyMsgIndicator(*s.CopyMsgIndicator)
+	}
+	if s.PegMoveType != nil {
+		m.SetPegMoveType(*s.PegMoveType)
+	}
+	if s.PegOffsetType != nil {
+		m.SetPegOffsetType(*s.PegOffsetType)
+	}
+	if s.PegLimitType != nil {
+		m.SetPegLimitType(*s.PegLimitType)
+	}
+	if s.PegRoundDirection != nil {
+		m.SetPegRoundDirection(*s.PegRoundDirection)
+	}
+	if s.PeggedPrice != nil {
+		m.SetPeggedPrice(*s.PeggedPrice, fix44.DecimalScale(*s.PeggedPrice))
+	}
+	if s.PegScope != nil {
+		m.SetPegScope(*s.PegScope)
+	}
+	if s.DiscretionMoveType != nil {
+		m.SetDiscretionMoveType(*s.DiscretionMoveType)
+	}
+	if s.DiscretionOffsetType != nil {
+		m.SetDiscretionOffsetType(*s.DiscretionOffsetType)
+	}
+	if s.DiscretionLimitType != nil {
+		m.SetDiscretionLimitType(*s.DiscretionLimitType)
+	}
+	if s.DiscretionRoundDirection != nil {
+		m.SetDiscretionRoundDirection(*s.DiscretionRoundDirection)
+	}
+	if s.DiscretionPrice != nil {
+		m.SetDiscretionPrice(*s.DiscretionPrice, fix44.DecimalScale(*s.DiscretionPrice))
+	}
+	if s.DiscretionScope != nil {
+		m.SetDiscretionScope(*s.DiscretionScope)
+	}
+	if s.TargetStrategy != nil {
+		m.SetTargetStrategy(*s.TargetStrategy)
+	}
+	if s.TargetStrategyParameters != nil {
+		m.SetTargetStrategyParameters(*s.TargetStrategyParameters)
+	}
+	if s.ParticipationRate != nil {
+		m.SetParticipationRate(*s.ParticipationRate, fix44.DecimalScale(*s.ParticipationRate))
+	}
+	if s.TargetStrategyPerformance != nil {
+		m.SetTargetStrategyPerformance(*s.TargetStrategyPerformance, fix44.DecimalScale(*s.TargetStrategyPerformance))
+	}
+	if s.LastLiquidityInd != nil {
+		m.SetLastLiquidityInd(*s.LastLiquidityInd)
+	}
+	if s.QtyType != nil {
+		m.SetQtyType(*s.QtyType)
+	}
+	if s.NoEvents != nil {
+		m.SetNoEvents(components.UnmarshalNoEvents(s.NoEvents))
+	}
+	if s.DatedDate != nil {
+		m.SetDatedDate(*s.DatedDate)
+	}
+	if s.InterestAccrualDate != nil {
+		m.SetInterestAccrualDate(*s.InterestAccrualDate)
+	}
+	if s.CPProgram != nil {
+		m.SetCPProgram(*s.CPProgram)
+	}
+	if s.CPRegType != nil {
+		m.SetCPRegType(*s.CPRegType)
+	}
+	if s.MarginRatio != nil {
+		m.SetMarginRatio(*s.MarginRatio, fix44.DecimalScale(*s.MarginRatio))
+	}
+	if s.TotNumReports != nil {
+		m.SetTotNumReports(*s.TotNumReports)
+	}
+	if s.LastRptRequested != nil {
+		m.SetLastRptRequested(*s.LastRptRequested)
+	}
+	if s.AgreementDesc != nil {
+		m.SetAgreementDesc(*s.AgreementDesc)
+	}
+	if s.AgreementID != nil {
+		m.SetAgreementID(*s.AgreementID)
+	}
+	if s.AgreementDate != nil {
+		m.SetAgreementDate(*s.AgreementDate)
+	}
+	if s.StartDate != nil {
+		m.SetStartDate(*s.StartDate)
+	}
+	if s.EndDate != nil {
+		m.SetEndDate(*s.EndDate)
+	}
+	if s.AgreementCurrency != nil {
+		m.SetAgreementCurrency(*s.AgreementCurrency)
+	}
+	if s.DeliveryType != nil {
+		m.SetDeliveryType(*s.DeliveryType)
+	}
+	if s.EndAccruedInterestAmt != nil {
+		m.SetEndAccruedInterestAmt(*s.EndAccruedInterestAmt, fix44.DecimalScale(*s.EndAccruedInterestAmt))
+	}
+	if s.StartCash != nil {
+		m.SetStartCash(*s.StartCash, fix44.DecimalScale(*s.StartCash))
+	}
+	if s.EndCash != nil {
+		m.SetEndCash(*s.EndCash, fix44.DecimalScale(*s.EndCash))
+	}
+	if s.TimeBracket != nil {
+		m.SetTimeBracket(*s.TimeBracket)
+	}
+	if s.StrikeCurrency != nil {
+		m.SetStrikeCurrency(*s.StrikeCurrency)
+	}
+	return m
+}
+
+//NoMiscFeesStruct is a plain Go representation of a NoMiscFees group element, optional fields are nil when absent
+type NoMiscFeesStruct struct {
+	MiscFeeAmt   *decimal.Decimal
+	MiscFeeCurr  *string
+	MiscFeeType  *enum.MiscFeeType
+	MiscFeeBasis *enum.MiscFeeBasis
+}
+
+//MarshalNoMiscFees copies the elements of g into a slice of NoMiscFeesStruct
+func MarshalNoMiscFees(g NoMiscFeesRepeatingGroup) ([]NoMiscFeesStruct, quickfix.MessageRejectError) {
+	s := make([]NoMiscFeesStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasMiscFeeAmt() {
+			v, err := m.GetMiscFeeAmt()
+			if err != nil {
+				return nil, err
+			}
+			s[i].MiscFeeAmt = &v
+		}
+		if m.HasMiscFeeCurr() {
+			v, err := m.GetMiscFeeCurr()
+			if err != nil {
+				return nil, err
+			}
+			s[i].MiscFeeCurr = &v
+		}
+		if m.HasMiscFeeType() {
+			v, err := m.GetMiscFeeType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].MiscFeeType = &v
+		}
+		if m.HasMiscFeeBasis() {
+			v, err := m.GetMiscFeeBasis()
+			if err != nil {
+				return nil, err
+			}
+			s[i].MiscFeeBasis = &v
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoMiscFees builds a NoMiscFeesRepeatingGroup from s
+func UnmarshalNoMiscFees(s []NoMiscFeesStruct) NoMiscFeesRepeatingGroup {
+	g := NewNoMiscFeesRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.MiscFeeAmt != nil {
+			m.SetMiscFeeAmt(*e.MiscFeeAmt, fix44.DecimalScale(*e.MiscFeeAmt))
+		}
+		if e.MiscFeeCurr != nil {
+			m.SetMiscFeeCurr(*e.MiscFeeCurr)
+		}
+		if e.MiscFeeType != nil {
+			m.SetMiscFeeType(*e.MiscFeeType)
+		}
+		if e.MiscFeeBasis != nil {
+			m.SetMiscFeeBasis(*e.MiscFeeBasis)
+		}
+	}
+	return g
+}
+
+//NoContraBrokersStruct is a plain Go representation of a NoContraBrokers group element, optional fields are nil when absent
+type NoContraBrokersStruct struct {
+	ContraBroker    *string
+	ContraTrader    *string
+	ContraTradeQty  *decimal.Decimal
+	ContraTradeTime *time.Time
+	ContraLegRefID  *string
+}
+
+//MarshalNoContraBrokers copies the elements of g into a slice of NoContraBrokersStruct
+func MarshalNoContraBrokers(g NoContraBrokersRepeatingGroup) ([]NoContraBrokersStruct, quickfix.MessageRejectError) {
+	s := make([]NoContraBrokersStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasContraBroker() {
+			v, err := m.GetContraBroker()
+			if err != nil {
+				return nil, err
+			}
+			s[i].ContraBroker = &v
+		}
+		if m.HasContraTrader() {
+			v, err := m.GetContraTrader()
+			if err != nil {
+				return nil, err
+			}
+			s[i].ContraTrader = &v
+		}
+		if m.HasContraTradeQty() {
+			v, err := m.GetContraTradeQty()
+			if err != nil {
+				return nil, err
+			}
+			s[i].ContraTradeQty = &v
+		}
+		if m.HasContraTradeTime() {
+			v, err := m.GetContraTradeTime()
+			if err != nil {
+				return nil, err
+			}
+			s[i].ContraTradeTime = &v
+		}
+		if m.HasContraLegRefID() {
+			v, err := m.GetContraLegRefID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].ContraLegRefID = &v
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoContraBrokers builds a NoContraBrokersRepeatingGroup from s
+func UnmarshalNoContraBrokers(s []NoContraBrokersStruct) NoContraBrokersRepeatingGroup {
+	g := NewNoContraBrokersRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.ContraBroker != nil {
+			m.SetContraBroker(*e.ContraBroker)
+		}
+		if e.ContraTrader != nil {
+			m.SetContraTrader(*e.ContraTrader)
+		}
+		if e.ContraTradeQty != nil {
+			m.SetContraTradeQty(*e.ContraTradeQty, fix44.DecimalScale(*e.ContraTradeQty))
+		}
+		if e.ContraTradeTime != nil {
+			m.SetContraTradeTime(*e.ContraTradeTime)
+		}
+		if e.ContraLegRefID != nil {
+			m.SetContraLegRefID(*e.ContraLegRefID)
+		}
+	}
+	return g
+}
+
+//NoContAmtsStruct is a plain Go representation of a NoContAmts group element, optional fields are nil when absent
+type NoContAmtsStruct struct {
+	ContAmtType  *enum.ContAmtType
+	ContAmtValue *decimal.Decimal
+	ContAmtCurr  *string
+}
+
+//MarshalNoContAmts copies the elements of g into a slice of NoContAmtsStruct
+func MarshalNoContAmts(g NoContAmtsRepeatingGroup) ([]NoContAmtsStruct, quickfix.MessageRejectError) {
+	s := make([]NoContAmtsStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasContAmtType() {
+			v, err := m.GetContAmtType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].ContAmtType = &v
+		}
+		if m.HasContAmtValue() {
+			v, err := m.GetContAmtValue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].ContAmtValue = &v
+		}
+		if m.HasContAmtCurr() {
+			v, err := m.GetContAmtCurr()
+			if err != nil {
+				return nil, err
+			}
+			s[i].ContAmtCurr = &v
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoContAmts builds a NoContAmtsRepeatingGroup from s
+func UnmarshalNoContAmts(s []NoContAmtsStruct) NoContAmtsRepeatingGroup {
+	g := NewNoContAmtsRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.ContAmtType != nil {
+			m.SetContAmtType(*e.ContAmtType)
+		}
+		if e.ContAmtValue != nil {
+			m.SetContAmtValue(*e.ContAmtValue, fix44.DecimalScale(*e.ContAmtValue))
+		}
+		if e.ContAmtCurr != nil {
+			m.SetContAmtCurr(*e.ContAmtCurr)
+		}
+	}
+	return g
+}
+
+//NoLegsStruct is a plain Go representation of a NoLegs group element, optional fields are nil when absent
+type NoLegsStruct struct {
+	LegSymbol                     *string
+	LegSymbolSfx                  *string
+	LegSecurityID                 *string
+	LegSecurityIDSource           *string
+	NoLegSecurityAltID            []NoLegSecurityAltIDStruct
+	LegProduct                    *int
+	LegCFICode                    *string
+	LegSecurityType               *string
+	LegSecuritySubType            *string
+	LegMaturityMonthYear          *string
+	LegMaturityDate               *string
+	LegCouponPaymentDate          *string
+	LegIssueDate                  *string
+	LegRepoCollateralSecurityType *int
+	LegRepurchaseTerm             *int
+	LegRepurchaseRate             *decimal.Decimal
+	LegFactor                     *decimal.Decimal
+	LegCreditRating               *string
+	LegInstrRegistry              *string
+	LegCountryOfIssue             *string
+	LegStateOrProvinceOfIssue     *string
+	LegLocaleOfIssue              *string
+	LegRedemptionDate             *string
+	LegStrikePrice                *decimal.Decimal
+	LegStrikeCurrency             *string
+	LegOptAttribute               *string
+	LegContractMultiplier         *decimal.Decimal
+	LegCouponRate                 *decimal.Decimal
+	LegSecurityExchange           *string
+	LegIssuer                     *string
+	EncodedLegIssuerLen           *int
+	EncodedLegIssuer              *string
+	LegSecurityDesc               *string
+	EncodedLegSecurityDescLen     *int
+	EncodedLegSecurityDesc        *string
+	LegRatioQty                   *decimal.Decimal
+	LegSide                       *string
+	LegCurrency                   *string
+	LegPool                       *string
+	LegDatedDate                  *string
+	LegContractSettlMonth         *string
+	LegInterestAccrualDate        *string
+	LegQty                        *decimal.Decimal
+	LegSwapType                   *enum.LegSwapType
+	NoLegStipulations             []NoLegStipulationsStruct
+	LegPositionEffect             *string
+	LegCoveredOrUncovered         *int
+	NoNestedPartyIDs              []NoNestedPartyIDsStruct
+	LegRefID                      *string
+	LegPrice                      *decimal.Decimal
+	LegSettlType                  *string
+	LegSettlDate                  *string
+	LegLastPx                     *decimal.Decimal
+}
+
+//MarshalNoLegs copies the elements of g into a slice of NoLegsStruct
+func MarshalNoLegs(g NoLegsRepeatingGroup) ([]NoLegsStruct, quickfix.MessageRejectError) {
+	s := make([]NoLegsStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasLegSymbol() {
+			v, err := m.GetLegSymbol()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSymbol = &v
+		}
+		if m.HasLegSymbolSfx() {
+			v, err := m.GetLegSymbolSfx()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSymbolSfx = &v
+		}
+		if m.HasLegSecurityID() {
+			v, err := m.GetLegSecurityID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSecurityID = &v
+		}
+		if m.HasLegSecurityIDSource() {
+			v, err := m.GetLegSecurityIDSource()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSecurityIDSource = &v
+		}
+		if m.HasNoLegSecurityAltID() {
+			g, err := m.GetNoLegSecurityAltID()
+			if err != nil {
+				return nil, err
+			}
+			if s[i].NoLegSecurityAltID, err = components.MarshalNoLegSecurityAltID(g); err != nil {
+				return nil, err
+			}
+		}
+		if m.HasLegProduct() {
+			v, err := m.GetLegProduct()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegProduct = &v
+		}
+		if m.HasLegCFICode() {
+			v, err := m.GetLegCFICode()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegCFICode = &v
+		}
+		if m.HasLegSecurityType() {
+			v, err := m.GetLegSecurityType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSecurityType = &v
+		}
+		if m.HasLegSecuritySubType() {
+			v, err := m.GetLegSecuritySubType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSecuritySubType = &v
+		}
+		if m.HasLegMaturityMonthYear() {
+			v, err := m.GetLegMaturityMonthYear()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegMaturityMonthYear = &v
+		}
+		if m.HasLegMaturityDate() {
+			v, err := m.GetLegMaturityDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegMaturityDate = &v
+		}
+		if m.HasLegCouponPaymentDate() {
+			v, err := m.GetLegCouponPaymentDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegCouponPaymentDate = &v
+		}
+		if m.HasLegIssueDate() {
+			v, err := m.GetLegIssueDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegIssueDate = &v
+		}
+		if m.HasLegRepoCollateralSecurityType() {
+			v, err := m.GetLegRepoCollateralSecurityType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegRepoCollateralSecurityType = &v
+		}
+		if m.HasLegRepurchaseTerm() {
+			v, err := m.GetLegRepurchaseTerm()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegRepurchaseTerm = &v
+		}
+		if m.HasLegRepurchaseRate() {
+			v, err := m.GetLegRepurchaseRate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegRepurchaseRate = &v
+		}
+		if m.HasLegFactor() {
+			v, err := m.GetLegFactor()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegFactor = &v
+		}
+		if m.HasLegCreditRating() {
+			v, err := m.GetLegCreditRating()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegCreditRating = &v
+		}
+		if m.HasLegInstrRegistry() {
+			v, err := m.GetLegInstrRegistry()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegInstrRegistry = &v
+		}
+		if m.HasLegCountryOfIssue() {
+			v, err := m.GetLegCountryOfIssue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegCountryOfIssue = &v
+		}
+		if m.HasLegStateOrProvinceOfIssue() {
+			v, err := m.GetLegStateOrProvinceOfIssue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegStateOrProvinceOfIssue = &v
+		}
+		if m.HasLegLocaleOfIssue() {
+			v, err := m.GetLegLocaleOfIssue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegLocaleOfIssue = &v
+		}
+		if m.HasLegRedemptionDate() {
+			v, err := m.GetLegRedemptionDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegRedemptionDate = &v
+		}
+		if m.HasLegStrikePrice() {
+			v, err := m.GetLegStrikePrice()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegStrikePrice = &v
+		}
+		if m.HasLegStrikeCurrency() {
+			v, err := m.GetLegStrikeCurrency()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegStrikeCurrency = &v
+		}
+		if m.HasLegOptAttribute() {
+			v, err := m.GetLegOptAttribute()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegOptAttribute = &v
+		}
+		if m.HasLegContractMultiplier() {
+			v, err := m.GetLegContractMultiplier()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegContractMultiplier = &v
+		}
+		if m.HasLegCouponRate() {
+			v, err := m.GetLegCouponRate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegCouponRate = &v
+		}
+		if m.HasLegSecurityExchange() {
+			v, err := m.GetLegSecurityExchange()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSecurityExchange = &v
+		}
+		if m.HasLegIssuer() {
+			v, err := m.GetLegIssuer()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegIssuer = &v
+		}
+		if m.HasEncodedLegIssuerLen() {
+			v, err := m.GetEncodedLegIssuerLen()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedLegIssuerLen = &v
+		}
+		if m.HasEncodedLegIssuer() {
+			v, err := m.GetEncodedLegIssuer()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedLegIssuer = &v
+		}
+		if m.HasLegSecurityDesc() {
+			v, err := m.GetLegSecurityDesc()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSecurityDesc = &v
+		}
+		if m.HasEncodedLegSecurityDescLen() {
+			v, err := m.GetEncodedLegSecurityDescLen()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedLegSecurityDescLen = &v
+		}
+		if m.HasEncodedLegSecurityDesc() {
+			v, err := m.GetEncodedLegSecurityDesc()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedLegSecurityDesc = &v
+		}
+		if m.HasLegRatioQty() {
+			v, err := m.GetLegRatioQty()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegRatioQty = &v
+		}
+		if m.HasLegSide() {
+			v, err := m.GetLegSide()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSide = &v
+		}
+		if m.HasLegCurrency() {
+			v, err := m.GetLegCurrency()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegCurrency = &v
+		}
+		if m.HasLegPool() {
+			v, err := m.GetLegPool()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegPool = &v
+		}
+		if m.HasLegDatedDate() {
+			v, err := m.GetLegDatedDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegDatedDate = &v
+		}
+		if m.HasLegContractSettlMonth() {
+			v, err := m.GetLegContractSettlMonth()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegContractSettlMonth = &v
+		}
+		if m.HasLegInterestAccrualDate() {
+			v, err := m.GetLegInterestAccrualDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegInterestAccrualDate = &v
+		}
+		if m.HasLegQty() {
+			v, err := m.GetLegQty()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegQty = &v
+		}
+		if m.HasLegSwapType() {
+			v, err := m.GetLegSwapType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSwapType = &v
+		}
+		if m.HasNoLegStipulations() {
+			g, err := m.GetNoLegStipulations()
+			if err != nil {
+				return nil, err
+			}
+			if s[i].NoLegStipulations, err = MarshalNoLegStipulations(g); err != nil {
+				return nil, err
+			}
+		}
+		if m.HasLegPositionEffect() {
+			v, err := m.GetLegPositionEffect()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegPositionEffect = &v
+		}
+		if m.HasLegCoveredOrUncovered() {
+			v, err := m.GetLegCoveredOrUncovered()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegCoveredOrUncovered = &v
+		}
+		if m.HasNoNestedPartyIDs() {
+			g, err := m.GetNoNestedPartyIDs()
+			if err != nil {
+				return nil, err
+			}
+			if s[i].NoNestedPartyIDs, err = components.MarshalNoNestedPartyIDs(g); err != nil {
+				return nil, err
+			}
+		}
+		if m.HasLegRefID() {
+			v, err := m.GetLegRefID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegRefID = &v
+		}
+		if m.HasLegPrice() {
+			v, err := m.GetLegPrice()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegPrice = &v
+		}
+		if m.HasLegSettlType() {
+			v, err := m.GetLegSettlType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSettlType = &v
+		}
+		if m.HasLegSettlDate() {
+			v, err := m.GetLegSettlDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSettlDate = &v
+		}
+		if m.HasLegLastPx() {
+			v, err := m.GetLegLastPx()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegLastPx = &v
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoLegs builds a NoLegsRepeatingGroup from s
+func UnmarshalNoLegs(s []NoLegsStruct) NoLegsRepeatingGroup {
+	g := NewNoLegsRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.LegSymbol != nil {
+			m.SetLegSymbol(*e.LegSymbol)
+		}
+		if e.LegSymbolSfx != nil {
+			m.SetLegSymbolSfx(*e.LegSymbolSfx)
+		}
+		if e.LegSecurityID != nil {
+			m.SetLegSecurityID(*e.LegSecurityID)
+		}
+		if e.LegSecurityIDSource != nil {
+			m.SetLegSecurityIDSource(*e.LegSecurityIDSource)
+		}
+		if e.NoLegSecurityAltID != nil {
+			m.SetNoLegSecurityAltID(components.UnmarshalNoLegSecurityAltID(e.NoLegSecurityAltID))
+		}
+		if e.LegProduct != nil {
+			m.SetLegProduct(*e.LegProduct)
+		}
+		if e.LegCFICode != nil {
+			m.SetLegCFICode(*e.LegCFICode)
+		}
+		if e.LegSecurityType != nil {
+			m.SetLegSecurityType(*e.LegSecurityType)
+		}
+		if e.LegSecuritySubType != nil {
+			m.SetLegSecuritySubType(*e.LegSecuritySubType)
+		}
+		if e.LegMaturityMonthYear != nil {
+			m.SetLegMaturityMonthYear(*e.LegMaturityMonthYear)
+		}
+		if e.LegMaturityDate != nil {
+			m.SetLegMaturityDate(*e.LegMaturityDate)
+		}
+		if e.LegCouponPaymentDate != nil {
+			m.SetLegCouponPaymentDate(*e.LegCouponPaymentDate)
+		}
+		if e.LegIssueDate != nil {
+			m.SetLegIssueDate(*e.LegIssueDate)
+		}
+		if e.LegRepoCollateralSecurityType != nil {
+			m.SetLegRepoCollateralSecurityType(*e.LegRepoCollateralSecurityType)
+		}
+		if e.LegRepurchaseTerm != nil {
+			m.SetLegRepurchaseTerm(*e.LegRepurchaseTerm)
+		}
+		if e.LegRepurchaseRate != nil {
+			m.SetLegRepurchaseRate(*e.LegRepurchaseRate, fix44.DecimalScale(*e.LegRepurchaseRate))
+		}
+		if e.LegFactor != nil {
+			m.SetLegFactor(*e.LegFactor, fix44.DecimalScale(*e.LegFactor))
+		}
+		if e.LegCreditRating != nil {
+			m.SetLegCreditRating(*e.LegCreditRating)
+		}
+		if e.LegInstrRegistry != nil {
+			m.SetLegInstrRegistry(*e.LegInstrRegistry)
+		}
+		if e.LegCountryOfIssue != nil {
+			m.SetLegCountryOfIssue(*e.LegCountryOfIssue)
+		}
+		if e.LegStateOrProvinceOfIssue != nil {
+			m.SetLegStateOrProvinceOfIssue(*e.LegStateOrProvinceOfIssue)
+		}
+		if e.LegLocaleOfIssue != nil {
+			m.SetLegLocaleOfIssue(*e.LegLocaleOfIssue)
+		}
+		if e.LegRedemptionDate != nil {
+			m.SetLegRedemptionDate(*e.LegRedemptionDate)
+		}
+		if e.LegStrikePrice != nil {
+			m.SetLegStrikePrice(*e.LegStrikePrice, fix44.DecimalScale(*e.LegStrikePrice))
+		}
+		if e.LegStrikeCurrency != nil {
+			m.SetLegStrikeCurrency(*e.LegStrikeCurrency)
+		}
+		if e.LegOptAttribute != nil {
+			m.SetLegOptAttribute(*e.LegOptAttribute)
+		}
+		if e.LegContractMultiplier != nil {
+			m.SetLegContractMultiplier(*e.LegContractMultiplier, fix44.DecimalScale(*e.LegContractMultiplier))
+		}
+		if e.LegCouponRate != nil {
+			m.SetLegCouponRate(*e.LegCouponRate, fix44.DecimalScale(*e.LegCouponRate))
+		}
+		if e.LegSecurityExchange != nil {
+			m.SetLegSecurityExchange(*e.LegSecurityExchange)
+		}
+		if e.LegIssuer != nil {
+			m.SetLegIssuer(*e.LegIssuer)
+		}
+		if e.EncodedLegIssuerLen != nil {
+			m.SetEncodedLegIssuerLen(*e.EncodedLegIssuerLen)
+		}
+		if e.EncodedLegIssuer != nil {
+			m.SetEncodedLegIssuer(*e.EncodedLegIssuer)
+		}
+		if e.LegSecurityDesc != nil {
+			m.SetLegSecurityDesc(*e.LegSecurityDesc)
+		}
+		if e.EncodedLegSecurityDescLen != nil {
+			m.SetEncodedLegSecurityDescLen(*e.EncodedLegSecurityDescLen)
+		}
+		if e.EncodedLegSecurityDesc != nil {
+			m.SetEncodedLegSecurityDesc(*e.EncodedLegSecurityDesc)
+		}
+		if e.LegRatioQty != nil {
+			m.SetLegRatioQty(*e.LegRatioQty, fix44.DecimalScale(*e.LegRatioQty))
+		}
+		if e.LegSide != nil {
+			m.SetLegSide(*e.LegSide)
+		}
+		if e.LegCurrency != nil {
+			m.SetLegCurrency(*e.LegCurrency)
+		}
+		if e.LegPool != nil {
+			m.SetLegPool(*e.LegPool)
+		}
+		if e.LegDatedDate != nil {
+			m.SetLegDatedDate(*e.LegDatedDate)
+		}
+		if e.LegContractSettlMonth != nil {
+			m.SetLegContractSettlMonth(*e.LegContractSettlMonth)
+		}
+		if e.LegInterestAccrualDate != nil {
+			m.SetLegInterestAccrualDate(*e.LegInterestAccrualDate)
+		}
+		if e.LegQty != nil {
+			m.SetLegQty(*e.LegQty, fix44.DecimalScale(*e.LegQty))
+		}
+		if e.LegSwapType != nil {
+			m.SetLegSwapType(*e.LegSwapType)
+		}
+		if e.NoLegStipulations != nil {
+			m.SetNoLegStipulations(UnmarshalNoLegStipulations(e.NoLegStipulations))
+		}
+		if e.LegPositionEffect != nil {
+			m.SetLegPositionEffect(*e.LegPositionEffect)
+		}
+		if e.LegCoveredOrUncovered != nil {
+			m.SetLegCoveredOrUncovered(*e.LegCoveredOrUncovered)
+		}
+		if e.NoNestedPartyIDs != nil {
+			m.SetNoNestedPartyIDs(components.UnmarshalNoNestedPartyIDs(e.NoNestedPartyIDs))
+		}
+		if e.LegRefID != nil {
+			m.SetLegRefID(*e.LegRefID)
+		}
+		if e.LegPrice != nil {
+			m.SetLegPrice(*e.LegPrice, fix44.DecimalScale(*e.LegPrice))
+		}
+		if e.LegSettlType != nil {
+			m.SetLegSettlType(*e.LegSettlType)
+		}
+		if e.LegSettlDate != nil {
+			m.SetLegSettlDate(*e.LegSettlDate)
+		}
+		if e.LegLastPx != nil {
+			m.SetLegLastPx(*e.LegLastPx, fix44.DecimalScale(*e.LegLastPx))
+		}
+	}
+	return g
+}
+
+//NoLegStipulationsStruct is a plain Go representation of a NoLegStipulations group element, optional fields are nil when absent
+type NoLegStipulationsStruct struct {
+	LegStipulationType  *string
+	LegStipulationValue *string
+}
+
+//MarshalNoLegStipulations copies the elements of g into a slice of NoLegStipulationsStruct
+func MarshalNoLegStipulations(g NoLegStipulationsRepeatingGroup) ([]NoLegStipulationsStruct, quickfix.MessageRejectError) {
+	s := make([]NoLegStipulationsStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasLegStipulationType() {
+			v, err := m.GetLegStipulationType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegStipulationType = &v
+		}
+		if m.HasLegStipulationValue() {
+			v, err := m.GetLegStipulationValue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegStipulationValue = &v
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoLegStipulations builds a NoLegStipulationsRepeatingGroup from s
+func UnmarshalNoLegStipulations(s []NoLegStipulationsStruct) NoLegStipulationsRepeatingGroup {
+	g := NewNoLegStipulationsRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.LegStipulationType != nil {
+			m.SetLegStipulationType(*e.LegStipulationType)
+		}
+		if e.LegStipulationValue != nil {
+			m.SetLegStipulationValue(*e.LegStipulationValue)
+		}
+	}
+	return g
+}
+
+//NoUnderlyingsStruct is a plain Go representation of a NoUnderlyings group element, optional fields are nil when absent
+type NoUnderlyingsStruct struct {
+	UnderlyingSymbol                     *string
+	UnderlyingSymbolSfx                  *string
+	UnderlyingSecurityID                 *string
+	UnderlyingSecurityIDSource           *string
+	NoUnderlyingSecurityAltID            []NoUnderlyingSecurityAltIDStruct
+	UnderlyingProduct                    *int
+	UnderlyingCFICode                    *string
+	UnderlyingSecurityType               *string
+	UnderlyingSecuritySubType            *string
+	UnderlyingMaturityMonthYear          *string
+	UnderlyingMaturityDate               *string
+	UnderlyingCouponPaymentDate          *string
+	UnderlyingIssueDate                  *string
+	UnderlyingRepoCollateralSecurityType *int
+	UnderlyingRepurchaseTerm             *int
+	UnderlyingRepurchaseRate             *decimal.Decimal
+	UnderlyingFactor                     *decimal.Decimal
+	UnderlyingCreditRating               *string
+	UnderlyingInstrRegistry              *string
+	UnderlyingCountryOfIssue             *string
+	UnderlyingStateOrProvinceOfIssue     *string
+	UnderlyingLocaleOfIssue              *string
+	UnderlyingRedemptionDate             *string
+	UnderlyingStrikePrice                *decimal.Decimal
+	UnderlyingStrikeCurrency             *string
+	UnderlyingOptAttribute               *string
+	UnderlyingContractMultiplier         *decimal.Decimal
+	UnderlyingCouponRate                 *decimal.Decimal
+	UnderlyingSecurityExchange           *string
+	UnderlyingIssuer                     *string
+	EncodedUnderlyingIssuerLen           *int
+	EncodedUnderlyingIssuer              *string
+	UnderlyingSecurityDesc               *string
+	EncodedUnderlyingSecurityDescLen     *int
+	EncodedUnderlyingSecurityDesc        *string
+	UnderlyingCPProgram                  *string
+	UnderlyingCPRegType                  *string
+	UnderlyingCurrency                   *string
+	UnderlyingQty                        *decimal.Decimal
+	UnderlyingPx                         *decimal.Decimal
+	UnderlyingDirtyPrice                 *decimal.Decimal
+	UnderlyingEndPrice                   *decimal.Decimal
+	UnderlyingStartValue                 *decimal.Decimal
+	UnderlyingCurrentValue               *decimal.Decimal
+	UnderlyingEndValue                   *decimal.Decimal
+	NoUnderlyingStips                    []NoUnderlyingStipsStruct
+}
+
+//MarshalNoUnderlyings copies the elements of g into a slice of NoUnderlyingsStruct
+func MarshalNoUnderlyings(g NoUnderlyingsRepeatingGroup) ([]NoUnderlyingsStruct, quickfix.MessageRejectError) {
+	s := make([]NoUnderlyingsStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasUnderlyingSymbol() {
+			v, err := m.GetUnderlyingSymbol()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingSymbol = &v
+		}
+		if m.HasUnderlyingSymbolSfx() {
+			v, err := m.GetUnderlyingSymbolSfx()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingSymbolSfx = &v
+		}
+		if m.HasUnderlyingSecurityID() {
+			v, err := m.GetUnderlyingSecurityID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingSecurityID = &v
+		}
+		if m.HasUnderlyingSecurityIDSource() {
+			v, err := m.GetUnderlyingSecurityIDSource()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingSecurityIDSource = &v
+		}
+		if m.HasNoUnderlyingSecurityAltID() {
+			g, err := m.GetNoUnderlyingSecurityAltID()
+			if err != nil {
+				return nil, err
+			}
+			if s[i].NoUnderlyingSecurityAltID, err = components.MarshalNoUnderlyingSecurityAltID(g); err != nil {
+				return nil, err
+			}
+		}
+		if m.HasUnderlyingProduct() {
+			v, err := m.GetUnderlyingProduct()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingProduct = &v
+		}
+		if m.HasUnderlyingCFICode() {
+			v, err := m.GetUnderlyingCFICode()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCFICode = &v
+		}
+		if m.HasUnderlyingSecurityType() {
+			v, err := m.GetUnderlyingSecurityType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingSecurityType = &v
+		}
+		if m.HasUnderlyingSecuritySubType() {
+			v, err := m.GetUnderlyingSecuritySubType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingSecuritySubType = &v
+		}
+		if m.HasUnderlyingMaturityMonthYear() {
+			v, err := m.GetUnderlyingMaturityMonthYear()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingMaturityMonthYear = &v
+		}
+		if m.HasUnderlyingMaturityDate() {
+			v, err := m.GetUnderlyingMaturityDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingMaturityDate = &v
+		}
+		if m.HasUnderlyingCouponPaymentDate() {
+			v, err := m.GetUnderlyingCouponPaymentDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCouponPaymentDate = &v
+		}
+		if m.HasUnderlyingIssueDate() {
+			v, err := m.GetUnderlyingIssueDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingIssueDate = &v
+		}
+		if m.HasUnderlyingRepoCollateralSecurityType() {
+			v, err := m.GetUnderlyingRepoCollateralSecurityType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingRepoCollateralSecurityType = &v
+		}
+		if m.HasUnderlyingRepurchaseTerm() {
+			v, err := m.GetUnderlyingRepurchaseTerm()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingRepurchaseTerm = &v
+		}
+		if m.HasUnderlyingRepurchaseRate() {
+			v, err := m.GetUnderlyingRepurchaseRate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingRepurchaseRate = &v
+		}
+		if m.HasUnderlyingFactor() {
+			v, err := m.GetUnderlyingFactor()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingFactor = &v
+		}
+		if m.HasUnderlyingCreditRating() {
+			v, err := m.GetUnderlyingCreditRating()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCreditRating = &v
+		}
+		if m.HasUnderlyingInstrRegistry() {
+			v, err := m.GetUnderlyingInstrRegistry()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingInstrRegistry = &v
+		}
+		if m.HasUnderlyingCountryOfIssue() {
+			v, err := m.GetUnderlyingCountryOfIssue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCountryOfIssue = &v
+		}
+		if m.HasUnderlyingStateOrProvinceOfIssue() {
+			v, err := m.GetUnderlyingStateOrProvinceOfIssue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingStateOrProvinceOfIssue = &v
+		}
+		if m.HasUnderlyingLocaleOfIssue() {
+			v, err := m.GetUnderlyingLocaleOfIssue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingLocaleOfIssue = &v
+		}
+		if m.HasUnderlyingRedemptionDate() {
+			v, err := m.GetUnderlyingRedemptionDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingRedemptionDate = &v
+		}
+		if m.HasUnderlyingStrikePrice() {
+			v, err := m.GetUnderlyingStrikePrice()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingStrikePrice = &v
+		}
+		if m.HasUnderlyingStrikeCurrency() {
+			v, err := m.GetUnderlyingStrikeCurrency()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingStrikeCurrency = &v
+		}
+		if m.HasUnderlyingOptAttribute() {
+			v, err := m.GetUnderlyingOptAttribute()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingOptAttribute = &v
+		}
+		if m.HasUnderlyingContractMultiplier() {
+			v, err := m.GetUnderlyingContractMultiplier()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingContractMultiplier = &v
+		}
+		if m.HasUnderlyingCouponRate() {
+			v, err := m.GetUnderlyingCouponRate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCouponRate = &v
+		}
+		if m.HasUnderlyingSecurityExchange() {
+			v, err := m.GetUnderlyingSecurityExchange()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingSecurityExchange = &v
+		}
+		if m.HasUnderlyingIssuer() {
+			v, err := m.GetUnderlyingIssuer()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingIssuer = &v
+		}
+		if m.HasEncodedUnderlyingIssuerLen() {
+			v, err := m.GetEncodedUnderlyingIssuerLen()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedUnderlyingIssuerLen = &v
+		}
+		if m.HasEncodedUnderlyingIssuer() {
+			v, err := m.GetEncodedUnderlyingIssuer()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedUnderlyingIssuer = &v
+		}
+		if m.HasUnderlyingSecurityDesc() {
+			v, err := m.GetUnderlyingSecurityDesc()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingSecurityDesc = &v
+		}
+		if m.HasEncodedUnderlyingSecurityDescLen() {
+			v, err := m.GetEncodedUnderlyingSecurityDescLen()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedUnderlyingSecurityDescLen = &v
+		}
+		if m.HasEncodedUnderlyingSecurityDesc() {
+			v, err := m.GetEncodedUnderlyingSecurityDesc()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedUnderlyingSecurityDesc = &v
+		}
+		if m.HasUnderlyingCPProgram() {
+			v, err := m.GetUnderlyingCPProgram()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCPProgram = &v
+		}
+		if m.HasUnderlyingCPRegType() {
+			v, err := m.GetUnderlyingCPRegType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCPRegType = &v
+		}
+		if m.HasUnderlyingCurrency() {
+			v, err := m.GetUnderlyingCurrency()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCurrency = &v
+		}
+		if m.HasUnderlyingQty() {
+			v, err := m.GetUnderlyingQty()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingQty = &v
+		}
+		if m.HasUnderlyingPx() {
+			v, err := m.GetUnderlyingPx()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingPx = &v
+		}
+		if m.HasUnderlyingDirtyPrice() {
+			v, err := m.GetUnderlyingDirtyPrice()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingDirtyPrice = &v
+		}
+		if m.HasUnderlyingEndPrice() {
+			v, err := m.GetUnderlyingEndPrice()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingEndPrice = &v
+		}
+		if m.HasUnderlyingStartValue() {
+			v, err := m.GetUnderlyingStartValue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingStartValue = &v
+		}
+		if m.HasUnderlyingCurrentValue() {
+			v, err := m.GetUnderlyingCurrentValue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCurrentValue = &v
+		}
+		if m.HasUnderlyingEndValue() {
+			v, err := m.GetUnderlyingEndValue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingEndValue = &v
+		}
+		if m.HasNoUnderlyingStips() {
+			g, err := m.GetNoUnderlyingStips()
+			if err != nil {
+				return nil, err
+			}
+			if s[i].NoUnderlyingStips, err = components.MarshalNoUnderlyingStips(g); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoUnderlyings builds a NoUnderlyingsRepeatingGroup from s
+func UnmarshalNoUnderlyings(s []NoUnderlyingsStruct) NoUnderlyingsRepeatingGroup {
+	g := NewNoUnderlyingsRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.UnderlyingSymbol != nil {
+			m.SetUnderlyingSymbol(*e.UnderlyingSymbol)
+		}
+		if e.UnderlyingSymbolSfx != nil {
+			m.SetUnderlyingSymbolSfx(*e.UnderlyingSymbolSfx)
+		}
+		if e.UnderlyingSecurityID != nil {
+			m.SetUnderlyingSecurityID(*e.UnderlyingSecurityID)
+		}
+		if e.UnderlyingSecurityIDSource != nil {
+			m.SetUnderlyingSecurityIDSource(*e.UnderlyingSecurityIDSource)
+		}
+		if e.NoUnderlyingSecurityAltID != nil {
+			m.SetNoUnderlyingSecurityAltID(components.UnmarshalNoUnderlyingSecurityAltID(e.NoUnderlyingSecurityAltID))
+		}
+		if e.UnderlyingProduct != nil {
+			m.SetUnderlyingProduct(*e.UnderlyingProduct)
+		}
+		if e.UnderlyingCFICode != nil {
+			m.SetUnderlyingCFICode(*e.UnderlyingCFICode)
+		}
+		if e.UnderlyingSecurityType != nil {
+			m.SetUnderlyingSecurityType(*e.UnderlyingSecurityType)
+		}
+		if e.UnderlyingSecuritySubType != nil {
+			m.SetUnderlyingSecuritySubType(*e.UnderlyingSecuritySubType)
+		}
+		if e.UnderlyingMaturityMonthYear != nil {
+			m.SetUnderlyingMaturityMonthYear(*e.UnderlyingMaturityMonthYear)
+		}
+		if e.UnderlyingMaturityDate != nil {
+			m.SetUnderlyingMaturityDate(*e.UnderlyingMaturityDate)
+		}
+		if e.UnderlyingCouponPaymentDate != nil {
+			m.SetUnderlyingCouponPaymentDate(*e.UnderlyingCouponPaymentDate)
+		}
+		if e.UnderlyingIssueDate != nil {
+			m.SetUnderlyingIssueDate(*e.UnderlyingIssueDate)
+		}
+		if e.UnderlyingRepoCollateralSecurityType != nil {
+			m.SetUnderlyingRepoCollateralSecurityType(*e.UnderlyingRepoCollateralSecurityType)
+		}
+		if e.UnderlyingRepurchaseTerm != nil {
+			m.SetUnderlyingRepurchaseTerm(*e.UnderlyingRepurchaseTerm)
+		}
+		if e.UnderlyingRepurchaseRate != nil {
+			m.SetUnderlyingRepurchaseRate(*e.UnderlyingRepurchaseRate, fix44.DecimalScale(*e.UnderlyingRepurchaseRate))
+		}
+		if e.UnderlyingFactor != nil {
+			m.SetUnderlyingFactor(*e.UnderlyingFactor, fix44.DecimalScale(*e.UnderlyingFactor))
+		}
+		if e.UnderlyingCreditRating != nil {
+			m.SetUnderlyingCreditRating(*e.UnderlyingCreditRating)
+		}
+		if e.UnderlyingInstrRegistry != nil {
+			m.SetUnderlyingInstrRegistry(*e.UnderlyingInstrRegistry)
+		}
+		if e.UnderlyingCountryOfIssue != nil {
+			m.SetUnderlyingCountryOfIssue(*e.UnderlyingCountryOfIssue)
+		}
+		if e.UnderlyingStateOrProvinceOfIssue != nil {
+			m.SetUnderlyingStateOrProvinceOfIssue(*e.UnderlyingStateOrProvinceOfIssue)
+		}
+		if e.UnderlyingLocaleOfIssue != nil {
+			m.SetUnderlyingLocaleOfIssue(*e.UnderlyingLocaleOfIssue)
+		}
+		if e.UnderlyingRedemptionDate != nil {
+			m.SetUnderlyingRedemptionDate(*e.UnderlyingRedemptionDate)
+		}
+		if e.UnderlyingStrikePrice != nil {
+			m.SetUnderlyingStrikePrice(*e.UnderlyingStrikePrice, fix44.DecimalScale(*e.UnderlyingStrikePrice))
+		}
+		if e.UnderlyingStrikeCurrency != nil {
+			m.SetUnderlyingStrikeCurrency(*e.UnderlyingStrikeCurrency)
+		}
+		if e.UnderlyingOptAttribute != nil {
+			m.SetUnderlyingOptAttribute(*e.UnderlyingOptAttribute)
+		}
+		if e.UnderlyingContractMultiplier != nil {
+			m.SetUnderlyingContractMultiplier(*e.UnderlyingContractMultiplier, fix44.DecimalScale(*e.UnderlyingContractMultiplier))
+		}
+		if e.UnderlyingCouponRate != nil {
+			m.SetUnderlyingCouponRate(*e.UnderlyingCouponRate, fix44.DecimalScale(*e.UnderlyingCouponRate))
+		}
+		if e.UnderlyingSecurityExchange != nil {
+			m.SetUnderlyingSecurityExchange(*e.UnderlyingSecurityExchange)
+		}
+		if e.UnderlyingIssuer != nil {
+			m.SetUnderlyingIssuer(*e.UnderlyingIssuer)
+		}
+		if e.EncodedUnderlyingIssuerLen != nil {
+			m.SetEncodedUnderlyingIssuerLen(*e.EncodedUnderlyingIssuerLen)
+		}
+		if e.EncodedUnderlyingIssuer != nil {
+			m.SetEncodedUnderlyingIssuer(*e.EncodedUnderlyingIssuer)
+		}
+		if e.UnderlyingSecurityDesc != nil {
+			m.SetUnderlyingSecurityDesc(*e.UnderlyingSecurityDesc)
+		}
+		if e.EncodedUnderlyingSecurityDescLen != nil {
+			m.SetEncodedUnderlyingSecurityDescLen(*e.EncodedUnderlyingSecurityDescLen)
+		}
+		if e.EncodedUnderlyingSecurityDesc != nil {
+			m.SetEncodedUnderlyingSecurityDesc(*e.EncodedUnderlyingSecurityDesc)
+		}
+		if e.UnderlyingCPProgram != nil {
+			m.SetUnderlyingCPProgram(*e.UnderlyingCPProgram)
+		}
+		if e.UnderlyingCPRegType != nil {
+			m.SetUnderlyingCPRegType(*e.UnderlyingCPRegType)
+		}
+		if e.UnderlyingCurrency != nil {
+			m.SetUnderlyingCurrency(*e.UnderlyingCurrency)
+		}
+		if e.UnderlyingQty != nil {
+			m.SetUnderlyingQty(*e.UnderlyingQty, fix44.DecimalScale(*e.UnderlyingQty))
+		}
+		if e.UnderlyingPx != nil {
+			m.SetUnderlyingPx(*e.UnderlyingPx, fix44.DecimalScale(*e.UnderlyingPx))
+		}
+		if e.UnderlyingDirtyPrice != nil {
+			m.SetUnderlyingDirtyPrice(*e.UnderlyingDirtyPrice, fix44.DecimalScale(*e.UnderlyingDirtyPrice))
+		}
+		if e.UnderlyingEndPrice != nil {
+			m.SetUnderlyingEndPrice(*e.UnderlyingEndPrice, fix44.DecimalScale(*e.UnderlyingEndPrice))
+		}
+		if e.UnderlyingStartValue != nil {
+			m.SetUnderlyingStartValue(*e.UnderlyingStartValue, fix44.DecimalScale(*e.UnderlyingStartValue))
+		}
+		if e.UnderlyingCurrentValue != nil {
+			m.SetUnderlyingCurrentValue(*e.UnderlyingCurrentValue, fix44.DecimalScale(*e.UnderlyingCurrentValue))
+		}
+		if e.UnderlyingEndValue != nil {
+			m.SetUnderlyingEndValue(*e.UnderlyingEndValue, fix44.DecimalScale(*e.UnderlyingEndValue))
+		}
+		if e.NoUnderlyingStips != nil {
+			m.SetNoUnderlyingStips(components.UnmarshalNoUnderlyingStips(e.NoUnderlyingStips))
+		}
+	}
+	return g
 }
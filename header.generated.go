@@ -457,6 +457,16 @@ func (h Header) HasSecureData() bool {
 	return h.Has(tag.SecureData)
 }
 
+//SetSecureDataBytes sets SecureData, Tag 91, and SecureDataLen, Tag 90, from v, which may hold any bytes including SOH
+func (h Header) SetSecureDataBytes(v []byte) {
+	SetRawData(h, tag.SecureDataLen, tag.SecureData, v)
+}
+
+//GetSecureDataBytes gets SecureData, Tag 91, checking its length against SecureDataLen, Tag 90
+func (h Header) GetSecureDataBytes() ([]byte, quickfix.MessageRejectError) {
+	return GetRawData(h, tag.SecureDataLen, tag.SecureData)
+}
+
 //HasPossResend returns true if PossResend is present, Tag 97
 func (h Header) HasPossResend() bool {
 	return h.Has(tag.PossResend)
@@ -517,6 +527,16 @@ func (h Header) HasXmlData() bool {
 	return h.Has(tag.XmlData)
 }
 
+//SetXmlDataBytes sets XmlData, Tag 213, and XmlDataLen, Tag 212, from v, which may hold any bytes including SOH
+func (h Header) SetXmlDataBytes(v []byte) {
+	SetRawData(h, tag.XmlDataLen, tag.XmlData, v)
+}
+
+//GetXmlDataBytes gets XmlData, Tag 213, checking its length against XmlDataLen, Tag 212
+func (h Header) GetXmlDataBytes() ([]byte, quickfix.MessageRejectError) {
+	return GetRawData(h, tag.XmlDataLen, tag.XmlData)
+}
+
 //HasMessageEncoding returns true if MessageEncoding is present, Tag 347
 func (h Header) HasMessageEncoding() bool {
 	return h.Has(tag.MessageEncoding)
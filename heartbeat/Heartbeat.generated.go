@@ -53,6 +53,26 @@ func Route(router RouteOut) (string, string, quickfix.MessageRoute) {
 	return "FIX.4.4", "0", r
 }
 
+//Validate checks m against the FIX 4.4 definition of Heartbeat, see fix44.MessageDef.Validate
+func (m Heartbeat) Validate() fix44.ValidationErrors {
+	return fix44.MessageDefs["0"].Validate(&m.Body.FieldMap)
+}
+
+//MarshalJSON encodes the Heartbeat as JSON with fields keyed by name, see fix44.MarshalMessageJSON
+func (m Heartbeat) MarshalJSON() ([]byte, error) {
+	return fix44.MarshalMessageJSON(m.Message)
+}
+
+//UnmarshalJSON builds the Heartbeat from JSON produced by MarshalJSON
+func (m *Heartbeat) UnmarshalJSON(b []byte) error {
+	msg, err := fix44.UnmarshalMessageJSON(b, "0")
+	if err != nil {
+		return err
+	}
+	*m = FromMessage(msg)
+	return nil
+}
+
 //SetTestReqID sets TestReqID, Tag 112
 func (m Heartbeat) SetTestReqID(v string) {
 	m.Set(field.NewTestReqID(v))
@@ -71,3 +91,30 @@ func (m Heartbeat) GetTestReqID() (v string, err quickfix.MessageRejectError) {
 func (m Heartbeat) HasTestReqID() bool {
 	return m.Has(tag.TestReqID)
 }
+
+//Struct is a plain Go representation of the Heartbeat body, optional fields are nil when absent
+type Struct struct {
+	TestReqID *string
+}
+
+//Marshal copies the fields present in msg into a Struct
+func Marshal(msg Heartbeat) (Struct, quickfix.MessageRejectError) {
+	var s Struct
+	if msg.HasTestReqID() {
+		v, err := msg.GetTestReqID()
+		if err != nil {
+			return s, err
+		}
+		s.TestReqID = &v
+	}
+	return s, nil
+}
+
+//Unmarshal builds a Heartbeat from s
+func Unmarshal(s Struct) Heartbeat {
+	m := New()
+	if s.TestReqID != nil {
+		m.SetTestReqID(*s.TestReqID)
+	}
+	return m
+}
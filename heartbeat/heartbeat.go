@@ -0,0 +1,31 @@
+package heartbeat
+
+import (
+	"github.com/terracefi/fix44/testrequest"
+	"github.com/terracefi/quickfix"
+)
+
+//FromTestRequest returns the Heartbeat that answers req, echoing its TestReqID
+func FromTestRequest(req testrequest.TestRequest) (Heartbeat, quickfix.MessageRejectError) {
+	testReqID, err := req.GetTestReqID()
+	if err != nil {
+		return Heartbeat{}, err
+	}
+
+	m := New()
+	m.SetTestReqID(testReqID)
+	return m, nil
+}
+
+//Answers returns true if m is the Heartbeat that answers req, that is if it echoes its TestReqID
+func (m Heartbeat) Answers(req testrequest.TestRequest) bool {
+	if !m.HasTestReqID() {
+		return false
+	}
+	want, err := req.GetTestReqID()
+	if err != nil {
+		return false
+	}
+	got, err := m.GetTestReqID()
+	return err == nil && got == want
+}
@@ -0,0 +1,50 @@
+package heartbeat
+
+import (
+	"testing"
+
+	"github.com/terracefi/field"
+	"github.com/terracefi/fix44/testrequest"
+	"github.com/terracefi/tag"
+)
+
+func TestFromTestRequest(t *testing.T) {
+	req := testrequest.New(field.NewTestReqID("T1"))
+	m, err := FromTestRequest(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, err := m.GetTestReqID(); err != nil || v != "T1" {
+		t.Errorf("TestReqID = %q, %v, want T1", v, err)
+	}
+
+	req.Body.Remove(tag.TestReqID)
+	if _, err := FromTestRequest(req); err == nil {
+		t.Error("FromTestRequest() error = nil for a TestRequest without TestReqID")
+	}
+}
+
+func TestAnswers(t *testing.T) {
+	req := testrequest.New(field.NewTestReqID("T1"))
+	answer, err := FromTestRequest(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other := New()
+	other.SetTestReqID("T2")
+
+	tests := []struct {
+		name string
+		m    Heartbeat
+		want bool
+	}{
+		{"answer", answer, true},
+		{"other TestReqID", other, false},
+		{"no TestReqID", New(), false},
+	}
+	for _, tt := range tests {
+		if got := tt.m.Answers(req); got != tt.want {
+			t.Errorf("%v: Answers() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,6 @@
+/*
+Package fixutil holds the helpers shared by the packages of fix44 that send requests and track their answers: the
+default ID generator and sender of their managers, the dereferencing and setting of the optional fields of the
+generated Structs, and the SubscriptionRequestType values used by the packages that subscribe.
+*/
+package fixutil
@@ -0,0 +1,89 @@
+package fixutil
+
+import (
+	"reflect"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/terracefi/enum"
+	"github.com/terracefi/quickfix"
+)
+
+//SubscriptionRequestType values, FIX 4.4
+const (
+	SubscriptionSnapshot           enum.SubscriptionRequestType = "0"
+	SubscriptionSnapshotAndUpdates enum.SubscriptionRequestType = "1"
+	SubscriptionDisable            enum.SubscriptionRequestType = "2"
+)
+
+//NewIDs returns a func that generates IDs: a sequence number prefixed with the time NewIDs was called, so that IDs
+//are not reused after a restart. The func is safe for concurrent use.
+func NewIDs() func() string {
+	prefix := strconv.FormatInt(time.Now().UnixNano(), 36) + "-"
+	var seq int
+	var mu sync.Mutex
+
+	return func() string {
+		mu.Lock()
+		defer mu.Unlock()
+		seq++
+		return prefix + strconv.Itoa(seq)
+	}
+}
+
+//SendOn returns a func that sends messages on sessionID with quickfix.SendToTarget
+func SendOn(sessionID quickfix.SessionID) func(msg quickfix.Messagable) error {
+	return func(msg quickfix.Messagable) error {
+		return quickfix.SendToTarget(msg, sessionID)
+	}
+}
+
+//Deref returns the value p points to, the zero value if p is nil
+func Deref[T any](p *T) T {
+	if p == nil {
+		var zero T
+		return zero
+	}
+	return *p
+}
+
+//Ptr returns a pointer to a copy of v, to set the optional fields of the generated Structs
+func Ptr[T any](v T) *T {
+	return &v
+}
+
+//Clone returns a deep copy of v, usually a generated Struct, so that a copy handed to a caller shares no pointer or
+//slice with the state of a manager. Fields that are not exported, such as those of decimal.Decimal and time.Time, are
+//copied as they are.
+func Clone[T any](v T) T {
+	var c T
+	deepCopy(reflect.ValueOf(&c).Elem(), reflect.ValueOf(&v).Elem())
+	return c
+}
+
+func deepCopy(dst, src reflect.Value) {
+	switch src.Kind() {
+	case reflect.Pointer:
+		if !src.IsNil() {
+			dst.Set(reflect.New(src.Type().Elem()))
+			deepCopy(dst.Elem(), src.Elem())
+		}
+	case reflect.Slice:
+		if !src.IsNil() {
+			dst.Set(reflect.MakeSlice(src.Type(), src.Len(), src.Len()))
+			for i := 0; i < src.Len(); i++ {
+				deepCopy(dst.Index(i), src.Index(i))
+			}
+		}
+	case reflect.Struct:
+		dst.Set(src)
+		for i := 0; i < src.NumField(); i++ {
+			if src.Type().Field(i).IsExported() {
+				deepCopy(dst.Field(i), src.Field(i))
+			}
+		}
+	default:
+		dst.Set(src)
+	}
+}
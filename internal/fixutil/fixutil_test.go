@@ -0,0 +1,98 @@
+package fixutil
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestNewIDs(t *testing.T) {
+	a, b := NewIDs(), NewIDs()
+
+	seen := make(map[string]bool)
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				id := a()
+				mu.Lock()
+				if seen[id] {
+					t.Errorf("ID %v generated twice", id)
+				}
+				seen[id] = true
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if id := b(); seen[id] {
+		t.Errorf("second generator repeated ID %v", id)
+	}
+}
+
+func TestDeref(t *testing.T) {
+	s, n := "x", 3
+	tests := []struct {
+		name string
+		got  interface{}
+		want interface{}
+	}{
+		{"string", Deref(&s), "x"},
+		{"nil string", Deref((*string)(nil)), ""},
+		{"int", Deref(&n), 3},
+		{"nil int", Deref((*int)(nil)), 0},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%v: Deref() = %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
+func TestPtr(t *testing.T) {
+	v := "x"
+	p := Ptr(v)
+	v = "y"
+	if *p != "x" {
+		t.Errorf("*Ptr() = %v, want x", *p)
+	}
+	if Ptr(v) == Ptr(v) {
+		t.Error("Ptr() returned the same pointer twice")
+	}
+}
+
+func TestClone(t *testing.T) {
+	type elem struct {
+		ID   *string
+		Subs []elem
+	}
+	type report struct {
+		ID    string
+		Qty   *decimal.Decimal
+		Elems []elem
+	}
+	r := report{ID: "R", Qty: Ptr(decimal.RequireFromString("1.50")),
+		Elems: []elem{{ID: Ptr("A"), Subs: []elem{{ID: Ptr("A1")}}}, {ID: Ptr("B")}}}
+
+	c := Clone(r)
+	if !reflect.DeepEqual(c, r) {
+		t.Fatalf("Clone() = %+v, want %+v", c, r)
+	}
+	*c.Qty = decimal.Zero
+	*c.Elems[0].ID = "X"
+	*c.Elems[0].Subs[0].ID = "X1"
+	c.Elems[1] = elem{}
+	if !r.Qty.Equal(decimal.RequireFromString("1.50")) || *r.Elems[0].ID != "A" || *r.Elems[0].Subs[0].ID != "A1" ||
+		*r.Elems[1].ID != "B" {
+		t.Errorf("changing the clone changed the original: %+v", r)
+	}
+	if c := Clone(report{}); c.Qty != nil || c.Elems != nil {
+		t.Errorf("Clone() of a zero value = %+v, want the zero value", c)
+	}
+}
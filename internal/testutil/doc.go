@@ -0,0 +1,5 @@
+/*
+Package testutil holds the fixtures shared by the tests of the fix44 packages that send messages: a Sender that
+records what is sent and can fail a given message, and a generator of predictable IDs.
+*/
+package testutil
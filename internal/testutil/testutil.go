@@ -0,0 +1,42 @@
+package testutil
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/terracefi/quickfix"
+)
+
+//ErrSend is returned by a Sender for the message it fails
+var ErrSend = errors.New("testutil: send failed")
+
+//Sender records the messages sent and fails the FailAt'th one, counting from 1. A FailAt of 0 fails none.
+type Sender struct {
+	Sent       []quickfix.Messagable
+	SessionIDs []quickfix.SessionID
+	FailAt     int
+}
+
+//Send records msg, it is meant for the SendMessage field of the managers
+func (s *Sender) Send(msg quickfix.Messagable) error {
+	return s.SendTo(msg, quickfix.SessionID{})
+}
+
+//SendTo records msg and the session it is sent on, it is meant for the fields that default to quickfix.SendToTarget
+func (s *Sender) SendTo(msg quickfix.Messagable, sessionID quickfix.SessionID) error {
+	s.Sent = append(s.Sent, msg)
+	s.SessionIDs = append(s.SessionIDs, sessionID)
+	if len(s.Sent) == s.FailAt {
+		return ErrSend
+	}
+	return nil
+}
+
+//IDs returns a func that generates the IDs prefix1, prefix2, ...
+func IDs(prefix string) func() string {
+	var n int
+	return func() string {
+		n++
+		return fmt.Sprintf("%v%v", prefix, n)
+	}
+}
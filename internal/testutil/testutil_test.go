@@ -0,0 +1,44 @@
+package testutil
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/terracefi/quickfix"
+)
+
+func TestSender(t *testing.T) {
+	tests := []struct {
+		name   string
+		failAt int
+		errs   []error
+	}{
+		{"none failed", 0, []error{nil, nil, nil}},
+		{"first failed", 1, []error{ErrSend, nil, nil}},
+		{"last failed", 3, []error{nil, nil, ErrSend}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Sender{FailAt: tt.failAt}
+			sessionID := quickfix.SessionID{TargetCompID: "B"}
+			errs := []error{s.Send(quickfix.NewMessage()), s.SendTo(quickfix.NewMessage(), sessionID),
+				s.Send(quickfix.NewMessage())}
+			if !reflect.DeepEqual(errs, tt.errs) {
+				t.Errorf("errors = %v, want %v", errs, tt.errs)
+			}
+			if len(s.Sent) != 3 {
+				t.Errorf("Sent %v messages, want 3", len(s.Sent))
+			}
+			if want := []quickfix.SessionID{{}, sessionID, {}}; !reflect.DeepEqual(s.SessionIDs, want) {
+				t.Errorf("SessionIDs = %v, want %v", s.SessionIDs, want)
+			}
+		})
+	}
+}
+
+func TestIDs(t *testing.T) {
+	a, b := IDs("R"), IDs("R")
+	if got := []string{a(), a(), b()}; !reflect.DeepEqual(got, []string{"R1", "R2", "R1"}) {
+		t.Errorf("IDs() = %v, want [R1 R2 R1]", got)
+	}
+}
@@ -0,0 +1,289 @@
+package fix44
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/terracefi/quickfix"
+	"github.com/terracefi/tag"
+)
+
+//MarshalFieldsJSON encodes the fields of fm as a JSON object keyed by field name, in the order given by fields.
+//Repeating groups are encoded as arrays of objects, the values of enumerated fields by their name (see EnumName, the
+//fields it does not know keep their wire value), decimals and ints as JSON numbers holding the exact wire text and
+//bools as true or false. Fields of fm that are not in fields are keyed by their tag number, in the elements of
+//repeating groups as well, so nothing is lost.
+func MarshalFieldsJSON(fm *quickfix.FieldMap, fields []FieldDef) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := marshalFields(&buf, fm, fields); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+//UnmarshalFieldsJSON sets the fields of a JSON object produced by MarshalFieldsJSON on fm
+func UnmarshalFieldsJSON(b []byte, fm *quickfix.FieldMap, fields []FieldDef) error {
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(b, &obj); err != nil {
+		return err
+	}
+	return unmarshalFields(obj, fm, fields)
+}
+
+//MarshalMessageJSON encodes msg as a JSON object with Header, Body and Trailer members, see MarshalFieldsJSON. The
+//Body is encoded using the MessageDef of the MsgType of msg. Passwords are kept so that UnmarshalMessageJSON gets msg
+//back, pretty.JSON redacts them for logs.
+func MarshalMessageJSON(msg *quickfix.Message) ([]byte, error) {
+	msgType, err := msg.Header.GetString(tag.MsgType)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(`{"Header":`)
+	if err := marshalFields(&buf, &msg.Header.FieldMap, HeaderFields); err != nil {
+		return nil, err
+	}
+	buf.WriteString(`,"Body":`)
+	if err := marshalFields(&buf, &msg.Body.FieldMap, MessageDefs[msgType].Fields); err != nil {
+		return nil, err
+	}
+	buf.WriteString(`,"Trailer":`)
+	if err := marshalFields(&buf, &msg.Trailer.FieldMap, TrailerFields); err != nil {
+		return nil, err
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+//UnmarshalMessageJSON builds a message from a JSON object produced by MarshalMessageJSON. msgType is used when the
+//Header has no MsgType, and must match it otherwise.
+func UnmarshalMessageJSON(b []byte, msgType string) (*quickfix.Message, error) {
+	var obj struct {
+		Header  map[string]json.RawMessage
+		Body    map[string]json.RawMessage
+		Trailer map[string]json.RawMessage
+	}
+	if err := json.Unmarshal(b, &obj); err != nil {
+		return nil, err
+	}
+
+	msg := quickfix.NewMessage()
+	if err := unmarshalFields(obj.Header, &msg.Header.FieldMap, HeaderFields); err != nil {
+		return nil, err
+	}
+	if v, err := msg.Header.GetString(tag.MsgType); err != nil {
+		msg.Header.SetString(tag.MsgType, msgType)
+	} else if v != msgType {
+		return nil, fmt.Errorf("fix44: MsgType %v is not %v", v, msgType)
+	}
+	if err := unmarshalFields(obj.Body, &msg.Body.FieldMap, MessageDefs[msgType].Fields); err != nil {
+		return nil, err
+	}
+	if err := unmarshalFields(obj.Trailer, &msg.Trailer.FieldMap, TrailerFields); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+//MarshalJSON encodes the Header as a JSON object keyed by field name, see MarshalFieldsJSON
+func (h Header) MarshalJSON() ([]byte, error) {
+	return MarshalFieldsJSON(&h.Header.FieldMap, HeaderFields)
+}
+
+//UnmarshalJSON sets the fields of a JSON object produced by MarshalJSON on the Header
+func (h *Header) UnmarshalJSON(b []byte) error {
+	if h.Header == nil {
+		*h = NewHeader(&quickfix.NewMessage().Header)
+	}
+	return UnmarshalFieldsJSON(b, &h.Header.FieldMap, HeaderFields)
+}
+
+//MarshalJSON encodes the Trailer as a JSON object keyed by field name, see MarshalFieldsJSON
+func (t Trailer) MarshalJSON() ([]byte, error) {
+	return MarshalFieldsJSON(&t.Trailer.FieldMap, TrailerFields)
+}
+
+//UnmarshalJSON sets the fields of a JSON object produced by MarshalJSON on the Trailer
+func (t *Trailer) UnmarshalJSON(b []byte) error {
+	if t.Trailer == nil {
+		t.Trailer = &quickfix.NewMessage().Trailer
+	}
+	return UnmarshalFieldsJSON(b, &t.Trailer.FieldMap, TrailerFields)
+}
+
+func marshalFields(buf *bytes.Buffer, fm *quickfix.FieldMap, fields []FieldDef) error {
+	buf.WriteByte('{')
+	first := true
+	key := func(k string) {
+		if !first {
+			buf.WriteByte(',')
+		}
+		first = false
+		b, _ := json.Marshal(k)
+		buf.Write(b)
+		buf.WriteByte(':')
+	}
+
+	known := make(map[quickfix.Tag]bool, len(fields))
+	for _, f := range fields {
+		known[f.Tag] = true
+		if !fm.Has(f.Tag) {
+			continue
+		}
+		key(f.Name)
+
+		if f.IsGroup() {
+			g := f.NewRepeatingGroup()
+			if err := fm.GetGroup(g); err != nil {
+				return err
+			}
+			buf.WriteByte('[')
+			for i := 0; i < g.Len(); i++ {
+				if i > 0 {
+					buf.WriteByte(',')
+				}
+				if err := marshalFields(buf, &g.Get(i).FieldMap, f.Fields); err != nil {
+					return err
+				}
+			}
+			buf.WriteByte(']')
+			continue
+		}
+
+		v, err := fm.GetString(f.Tag)
+		if err != nil {
+			return err
+		}
+		buf.Write(marshalValue(f, v))
+	}
+
+	var unknown []int
+	for _, t := range fm.Tags() {
+		if !known[t] {
+			unknown = append(unknown, int(t))
+		}
+	}
+	sort.Ints(unknown)
+	for _, t := range unknown {
+		v, err := fm.GetString(quickfix.Tag(t))
+		if err != nil {
+			return err
+		}
+		key(strconv.Itoa(t))
+		b, _ := json.Marshal(v)
+		buf.Write(b)
+	}
+
+	buf.WriteByte('}')
+	return nil
+}
+
+func marshalValue(f FieldDef, v string) []byte {
+	if name, ok := EnumName(f.Tag, v); ok {
+		v = name
+	} else {
+		switch f.Type {
+		case FieldTypeInt, FieldTypeDecimal, FieldTypeNumInGroup:
+			if isJSONNumber(v) {
+				return []byte(v)
+			}
+		case FieldTypeBool:
+			switch v {
+			case "Y":
+				return []byte("true")
+			case "N":
+				return []byte("false")
+			}
+		}
+	}
+
+	b, _ := json.Marshal(v)
+	return b
+}
+
+//isJSONNumber returns true if the wire text v can be written as a JSON number without changing it
+func isJSONNumber(v string) bool {
+	var n json.Number
+	if err := json.Unmarshal([]byte(v), &n); err != nil {
+		return false
+	}
+	return n.String() == v
+}
+
+//unmarshalFields sets the members of obj on fm, the body or an element of a repeating group. Members are keyed by the
+//name of one of fields or by a tag number, which may also be the tag of one of fields.
+func unmarshalFields(obj map[string]json.RawMessage, fm *quickfix.FieldMap, fields []FieldDef) error {
+	byName := make(map[string]FieldDef, len(fields))
+	byTag := make(map[quickfix.Tag]FieldDef, len(fields))
+	for _, f := range fields {
+		byName[f.Name] = f
+		byTag[f.Tag] = f
+	}
+
+	for k, raw := range obj {
+		f, ok := byName[k]
+		if !ok {
+			t, err := strconv.Atoi(k)
+			if err != nil {
+				return fmt.Errorf("fix44: unknown field %v", k)
+			}
+			if f, ok = byTag[quickfix.Tag(t)]; !ok {
+				f = FieldDef{Tag: quickfix.Tag(t), Name: k}
+			}
+		}
+
+		if f.IsGroup() {
+			var elems []map[string]json.RawMessage
+			if err := json.Unmarshal(raw, &elems); err != nil {
+				return fmt.Errorf("fix44: %v: %v", f.Name, err)
+			}
+			g := f.NewRepeatingGroup()
+			for _, e := range elems {
+				if err := unmarshalFields(e, &g.Add().FieldMap, f.Fields); err != nil {
+					return err
+				}
+			}
+			fm.SetGroup(g)
+			continue
+		}
+
+		v, isNull, err := unmarshalValue(f, raw)
+		if err != nil {
+			return fmt.Errorf("fix44: %v: %v", f.Name, err)
+		}
+		if !isNull {
+			fm.SetString(f.Tag, v)
+		}
+	}
+	return nil
+}
+
+func unmarshalValue(f FieldDef, raw json.RawMessage) (v string, isNull bool, err error) {
+	raw = bytes.TrimSpace(raw)
+	switch {
+	case bytes.Equal(raw, []byte("null")):
+		return "", true, nil
+	case bytes.Equal(raw, []byte("true")):
+		return "Y", false, nil
+	case bytes.Equal(raw, []byte("false")):
+		return "N", false, nil
+	case len(raw) > 0 && raw[0] == '"':
+		if err = json.Unmarshal(raw, &v); err != nil {
+			return
+		}
+		if value, ok := EnumValueOf(f.Tag, v); ok {
+			v = value
+		}
+		return
+	}
+
+	var n json.Number
+	if err = json.Unmarshal(raw, &n); err != nil {
+		return
+	}
+	return n.String(), false, nil
+}
@@ -0,0 +1,188 @@
+package fix44
+
+import (
+	"testing"
+
+	"github.com/terracefi/quickfix"
+	"github.com/terracefi/tag"
+)
+
+func newOrderMessage() *quickfix.Message {
+	msg := quickfix.NewMessage()
+	NewHeader(&msg.Header).SetMsgType("D")
+	msg.Header.SetString(tag.SenderCompID, "A")
+	msg.Header.SetString(tag.TargetCompID, "B")
+	msg.Header.SetInt(tag.MsgSeqNum, 7)
+	msg.Header.SetBool(tag.PossDupFlag, false)
+	for t, v := range map[quickfix.Tag]string{tag.ClOrdID: "A", tag.Side: "1", tag.OrdType: "2",
+		tag.TransactTime: "20240301-09:30:00.000", tag.OrderQty: "100", tag.Price: "10.250", tag.Symbol: "ABC"} {
+		msg.Body.SetString(t, v)
+	}
+	msg.Trailer.SetString(tag.CheckSum, "123")
+	return msg
+}
+
+func TestMessageJSONRoundTrip(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(msg *quickfix.Message)
+	}{
+		{"fields", func(*quickfix.Message) {}},
+		{"unknown tag", func(msg *quickfix.Message) { msg.Body.SetString(9999, "x") }},
+		{"multiple value field", func(msg *quickfix.Message) { msg.Body.SetString(tag.ExecInst, "1 G") }},
+		{"repeating group", func(msg *quickfix.Message) {
+			msg.Body.SetGroup(partyIDs(map[quickfix.Tag]string{tag.PartyID: "BRKR", tag.PartyRole: "1"},
+				map[quickfix.Tag]string{tag.PartyID: "ACC", tag.PartyIDSource: "D"}))
+		}},
+		{"nested repeating group", func(msg *quickfix.Message) {
+			g := partyIDs(map[quickfix.Tag]string{tag.PartyID: "BRKR"})
+			sub := FieldDef{Tag: tag.NoPartySubIDs, Fields: noPartySubIDsFields}.NewRepeatingGroup()
+			sub.Add().SetString(tag.PartySubID, "DESK1").SetString(tag.PartySubIDType, "24")
+			sub.Add().SetString(tag.PartySubID, "J SMITH")
+			g.Get(0).SetGroup(sub)
+			msg.Body.SetGroup(g)
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := newOrderMessage()
+			tt.modify(msg)
+			b, err := MarshalMessageJSON(msg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := UnmarshalMessageJSON(b, "D")
+			if err != nil {
+				t.Fatalf("UnmarshalMessageJSON(%s) error = %v", b, err)
+			}
+			for _, section := range []struct {
+				name      string
+				got, want *quickfix.FieldMap
+				fields    []FieldDef
+			}{
+				{"Header", &got.Header.FieldMap, &msg.Header.FieldMap, HeaderFields},
+				{"Body", &got.Body.FieldMap, &msg.Body.FieldMap, MessageDefs["D"].Fields},
+				{"Trailer", &got.Trailer.FieldMap, &msg.Trailer.FieldMap, TrailerFields},
+			} {
+				assertSameFields(t, section.name, section.got, section.want, section.fields)
+			}
+		})
+	}
+}
+
+//assertSameFields compares the wire values of got and want, reading the groups defined by fields
+func assertSameFields(t *testing.T, name string, got, want *quickfix.FieldMap, fields []FieldDef) {
+	t.Helper()
+	if got.Len() != want.Len() {
+		t.Errorf("%v has %v fields, want %v", name, got.Len(), want.Len())
+	}
+	for _, tg := range want.Tags() {
+		f, ok := fieldDef(fields, tg)
+		if ok && f.IsGroup() {
+			gg, wg := f.NewRepeatingGroup(), f.NewRepeatingGroup()
+			if err := want.GetGroup(wg); err != nil {
+				t.Fatal(err)
+			}
+			if err := got.GetGroup(gg); err != nil {
+				t.Errorf("%v.%v: %v", name, f.Name, err)
+				continue
+			}
+			if gg.Len() != wg.Len() {
+				t.Errorf("%v.%v has %v elements, want %v", name, f.Name, gg.Len(), wg.Len())
+				continue
+			}
+			for i := 0; i < wg.Len(); i++ {
+				assertSameFields(t, f.Name, &gg.Get(i).FieldMap, &wg.Get(i).FieldMap, f.Fields)
+			}
+			continue
+		}
+		wv, _ := want.GetString(tg)
+		if gv, err := got.GetString(tg); err != nil || gv != wv {
+			t.Errorf("%v tag %v = %q, %v, want %q", name, tg, gv, err, wv)
+		}
+	}
+}
+
+func fieldDef(fields []FieldDef, t quickfix.Tag) (FieldDef, bool) {
+	for _, f := range fields {
+		if f.Tag == t {
+			return f, true
+		}
+	}
+	return FieldDef{}, false
+}
+
+func TestMarshalFieldsJSON(t *testing.T) {
+	msg := newOrderMessage()
+	b, err := MarshalFieldsJSON(&msg.Header.FieldMap, HeaderFields)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"BeginString":"FIX.4.4","MsgSeqNum":7,"MsgType":"ORDER_SINGLE","PossDupFlag":false,"SenderCompID":"A",` +
+		`"TargetCompID":"B"}`
+	if string(b) != want {
+		t.Errorf("MarshalFieldsJSON(Header) = %s, want %s", b, want)
+	}
+
+	g := partyIDs(map[quickfix.Tag]string{tag.PartyID: "BRKR", tag.PartyRole: "1"})
+	g.Get(0).SetString(9999, "x")
+	if b, err = MarshalFieldsJSON(&g.Get(0).FieldMap, noPartyIDsFields); err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"PartyID":"BRKR","PartyRole":"EXECUTING_FIRM","9999":"x"}`; string(b) != want {
+		t.Errorf("MarshalFieldsJSON(NoPartyIDs element) = %s, want %s", b, want)
+	}
+}
+
+func TestUnmarshalFieldsJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want map[quickfix.Tag]string
+	}{
+		{"names", `{"PartyID":"BRKR","PartyRole":"EXECUTING_FIRM"}`,
+			map[quickfix.Tag]string{tag.PartyID: "BRKR", tag.PartyRole: "1"}},
+		{"wire values", `{"PartyID":"BRKR","PartyRole":"1"}`,
+			map[quickfix.Tag]string{tag.PartyID: "BRKR", tag.PartyRole: "1"}},
+		{"tag numbers", `{"448":"BRKR","452":"1","9999":"x"}`,
+			map[quickfix.Tag]string{tag.PartyID: "BRKR", tag.PartyRole: "1", 9999: "x"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fm quickfix.FieldMap
+			if err := UnmarshalFieldsJSON([]byte(tt.in), &fm, noPartyIDsFields); err != nil {
+				t.Fatal(err)
+			}
+			if fm.Len() != len(tt.want) {
+				t.Errorf("got %v fields, want %v", fm.Len(), len(tt.want))
+			}
+			for tg, want := range tt.want {
+				if v, err := fm.GetString(tg); err != nil || v != want {
+					t.Errorf("tag %v = %q, %v, want %q", tg, v, err, want)
+				}
+			}
+		})
+	}
+}
+
+func TestUnmarshalGroupByTagNumber(t *testing.T) {
+	var fm quickfix.FieldMap
+	in := `{"453":[{"448":"BRKR","802":[{"523":"DESK1"}]}]}`
+	if err := UnmarshalFieldsJSON([]byte(in), &fm, MessageDefs["D"].Fields); err != nil {
+		t.Fatal(err)
+	}
+	g := partyIDs()
+	if err := fm.GetGroup(g); err != nil {
+		t.Fatal(err)
+	}
+	if g.Len() != 1 {
+		t.Fatalf("NoPartyIDs has %v elements, want 1", g.Len())
+	}
+	sub := FieldDef{Tag: tag.NoPartySubIDs, Fields: noPartySubIDsFields}.NewRepeatingGroup()
+	if err := g.Get(0).GetGroup(sub); err != nil {
+		t.Fatal(err)
+	}
+	if v, err := sub.Get(0).GetString(tag.PartySubID); err != nil || v != "DESK1" {
+		t.Errorf("PartySubID = %q, %v, want DESK1", v, err)
+	}
+}
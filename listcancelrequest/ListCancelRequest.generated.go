@@ -57,6 +57,26 @@ func Route(router RouteOut) (string, string, quickfix.MessageRoute) {
 	return "FIX.4.4", "K", r
 }
 
+//Validate checks m against the FIX 4.4 definition of ListCancelRequest, see fix44.MessageDef.Validate
+func (m ListCancelRequest) Validate() fix44.ValidationErrors {
+	return fix44.MessageDefs["K"].Validate(&m.Body.FieldMap)
+}
+
+//MarshalJSON encodes the ListCancelRequest as JSON with fields keyed by name, see fix44.MarshalMessageJSON
+func (m ListCancelRequest) MarshalJSON() ([]byte, error) {
+	return fix44.MarshalMessageJSON(m.Message)
+}
+
+//UnmarshalJSON builds the ListCancelRequest from JSON produced by MarshalJSON
+func (m *ListCancelRequest) UnmarshalJSON(b []byte) error {
+	msg, err := fix44.UnmarshalMessageJSON(b, "K")
+	if err != nil {
+		return err
+	}
+	*m = FromMessage(msg)
+	return nil
+}
+
 //SetText sets Text, Tag 58
 func (m ListCancelRequest) SetText(v string) {
 	m.Set(field.NewText(v))
@@ -189,3 +209,105 @@ func (m ListCancelRequest) HasEncodedTextLen() bool {
 func (m ListCancelRequest) HasEncodedText() bool {
 	return m.Has(tag.EncodedText)
 }
+
+//SetEncodedTextBytes sets EncodedText, Tag 355, and EncodedTextLen, Tag 354, from v, which may hold any bytes including SOH
+func (m ListCancelRequest) SetEncodedTextBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedTextLen, tag.EncodedText, v)
+}
+
+//GetEncodedTextBytes gets EncodedText, Tag 355, checking its length against EncodedTextLen, Tag 354
+func (m ListCancelRequest) GetEncodedTextBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedTextLen, tag.EncodedText)
+}
+
+//GetEncodedTextDecoded gets EncodedText, Tag 355, decoded to UTF-8 using the MessageEncoding of the Header
+func (m ListCancelRequest) GetEncodedTextDecoded() (string, error) {
+	return fix44.DecodeRawData(m.Header, m, tag.EncodedTextLen, tag.EncodedText)
+}
+
+//Struct is a plain Go representation of the ListCancelRequest body, optional fields are nil when absent
+type Struct struct {
+	Text                 *string
+	TransactTime         time.Time
+	ListID               string
+	TradeDate            *string
+	TradeOriginationDate *string
+	EncodedTextLen       *int
+	EncodedText          *string
+}
+
+//Marshal copies the fields present in msg into a Struct
+func Marshal(msg ListCancelRequest) (Struct, quickfix.MessageRejectError) {
+	var s Struct
+	if msg.HasText() {
+		v, err := msg.GetText()
+		if err != nil {
+			return s, err
+		}
+		s.Text = &v
+	}
+	if msg.HasTransactTime() {
+		v, err := msg.GetTransactTime()
+		if err != nil {
+			return s, err
+		}
+		s.TransactTime = v
+	}
+	if msg.HasListID() {
+		v, err := msg.GetListID()
+		if err != nil {
+			return s, err
+		}
+		s.ListID = v
+	}
+	if msg.HasTradeDate() {
+		v, err := msg.GetTradeDate()
+		if err != nil {
+			return s, err
+		}
+		s.TradeDate = &v
+	}
+	if msg.HasTradeOriginationDate() {
+		v, err := msg.GetTradeOriginationDate()
+		if err != nil {
+			return s, err
+		}
+		s.TradeOriginationDate = &v
+	}
+	if msg.HasEncodedTextLen() {
+		v, err := msg.GetEncodedTextLen()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedTextLen = &v
+	}
+	if msg.HasEncodedText() {
+		v, err := msg.GetEncodedText()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedText = &v
+	}
+	return s, nil
+}
+
+//Unmarshal builds a ListCancelRequest from s
+func Unmarshal(s Struct) ListCancelRequest {
+	m := New(field.NewListID(s.ListID), field.NewTransactTime(s.TransactTime))
+	if s.Text != nil {
+		m.SetText(*s.Text)
+	}
+	if s.TradeDate != nil {
+		m.SetTradeDate(*s.TradeDate)
+	}
+	if s.TradeOriginationDate != nil {
+		m.SetTradeOriginationDate(*s.TradeOriginationDate)
+	}
+	if s.EncodedTextLen != nil {
+		m.SetEncodedTextLen(*s.EncodedTextLen)
+	}
+	if s.EncodedText != nil {
+		m.SetEncodedText(*s.EncodedText)
+	}
+	return m
+}
@@ -57,6 +57,26 @@ func Route(router RouteOut) (string, string, quickfix.MessageRoute) {
 	return "FIX.4.4", "L", r
 }
 
+//Validate checks m against the FIX 4.4 definition of ListExecute, see fix44.MessageDef.Validate
+func (m ListExecute) Validate() fix44.ValidationErrors {
+	return fix44.MessageDefs["L"].Validate(&m.Body.FieldMap)
+}
+
+//MarshalJSON encodes the ListExecute as JSON with fields keyed by name, see fix44.MarshalMessageJSON
+func (m ListExecute) MarshalJSON() ([]byte, error) {
+	return fix44.MarshalMessageJSON(m.Message)
+}
+
+//UnmarshalJSON builds the ListExecute from JSON produced by MarshalJSON
+func (m *ListExecute) UnmarshalJSON(b []byte) error {
+	msg, err := fix44.UnmarshalMessageJSON(b, "L")
+	if err != nil {
+		return err
+	}
+	*m = FromMessage(msg)
+	return nil
+}
+
 //SetText sets Text, Tag 58
 func (m ListExecute) SetText(v string) {
 	m.Set(field.NewText(v))
@@ -180,6 +200,21 @@ func (m ListExecute) HasEncodedText() bool {
 	return m.Has(tag.EncodedText)
 }
 
+//SetEncodedTextBytes sets EncodedText, Tag 355, and EncodedTextLen, Tag 354, from v, which may hold any bytes including SOH
+func (m ListExecute) SetEncodedTextBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedTextLen, tag.EncodedText, v)
+}
+
+//GetEncodedTextBytes gets EncodedText, Tag 355, checking its length against EncodedTextLen, Tag 354
+func (m ListExecute) GetEncodedTextBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedTextLen, tag.EncodedText)
+}
+
+//GetEncodedTextDecoded gets EncodedText, Tag 355, decoded to UTF-8 using the MessageEncoding of the Header
+func (m ListExecute) GetEncodedTextDecoded() (string, error) {
+	return fix44.DecodeRawData(m.Header, m, tag.EncodedTextLen, tag.EncodedText)
+}
+
 //HasBidID returns true if BidID is present, Tag 390
 func (m ListExecute) HasBidID() bool {
 	return m.Has(tag.BidID)
@@ -189,3 +224,90 @@ func (m ListExecute) HasBidID() bool {
 func (m ListExecute) HasClientBidID() bool {
 	return m.Has(tag.ClientBidID)
 }
+
+//Struct is a plain Go representation of the ListExecute body, optional fields are nil when absent
+type Struct struct {
+	Text           *string
+	TransactTime   time.Time
+	ListID         string
+	EncodedTextLen *int
+	EncodedText    *string
+	BidID          *string
+	ClientBidID    *string
+}
+
+//Marshal copies the fields present in msg into a Struct
+func Marshal(msg ListExecute) (Struct, quickfix.MessageRejectError) {
+	var s Struct
+	if msg.HasText() {
+		v, err := msg.GetText()
+		if err != nil {
+			return s, err
+		}
+		s.Text = &v
+	}
+	if msg.HasTransactTime() {
+		v, err := msg.GetTransactTime()
+		if err != nil {
+			return s, err
+		}
+		s.TransactTime = v
+	}
+	if msg.HasListID() {
+		v, err := msg.GetListID()
+		if err != nil {
+			return s, err
+		}
+		s.ListID = v
+	}
+	if msg.HasEncodedTextLen() {
+		v, err := msg.GetEncodedTextLen()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedTextLen = &v
+	}
+	if msg.HasEncodedText() {
+		v, err := msg.GetEncodedText()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedText = &v
+	}
+	if msg.HasBidID() {
+		v, err := msg.GetBidID()
+		if err != nil {
+			return s, err
+		}
+		s.BidID = &v
+	}
+	if msg.HasClientBidID() {
+		v, err := msg.GetClientBidID()
+		if err != nil {
+			return s, err
+		}
+		s.ClientBidID = &v
+	}
+	return s, nil
+}
+
+//Unmarshal builds a ListExecute from s
+func Unmarshal(s Struct) ListExecute {
+	m := New(field.NewListID(s.ListID), field.NewTransactTime(s.TransactTime))
+	if s.Text != nil {
+		m.SetText(*s.Text)
+	}
+	if s.EncodedTextLen != nil {
+		m.SetEncodedTextLen(*s.EncodedTextLen)
+	}
+	if s.EncodedText != nil {
+		m.SetEncodedText(*s.EncodedText)
+	}
+	if s.BidID != nil {
+		m.SetBidID(*s.BidID)
+	}
+	if s.ClientBidID != nil {
+		m.SetClientBidID(*s.ClientBidID)
+	}
+	return m
+}
@@ -0,0 +1,15 @@
+/*
+Package listorders manages the buy side of FIX 4.4 program trading.
+
+A Manager sends each NewOrderList in as many fragments as MaxOrders requires, every fragment carrying the TotNoOrders
+of the whole list and the last one LastFragment = Y. It follows the ListOrderStatus of each list from ListStatus
+messages, and the status of each order of the list both from the NoOrders of ListStatus and from ExecutionReports
+that carry the ListID. ListExecute, ListCancelRequest and ListStatusRequest are sent with Execute, Cancel and
+RequestStatus.
+
+Bids follow the BidType of their lists. In the non-disclosed flow the BidRequest describes the program with
+NoBidDescriptors, and lists can only be sent once the BidResponse has given the BidID. In the disclosed flow the
+lists are sent first, named by the NoBidComponents of the BidRequest, and can only be executed once the bid has
+been answered. Lists with BidType NoBiddingProcess need no bid.
+*/
+package listorders
@@ -0,0 +1,53 @@
+package listorders
+
+import (
+	"fmt"
+
+	"github.com/terracefi/enum"
+)
+
+//UnknownListError is returned for a message that refers to a ListID the Manager does not know
+type UnknownListError struct {
+	ListID string
+}
+
+func (e *UnknownListError) Error() string {
+	return fmt.Sprintf("listorders: unknown ListID %v", e.ListID)
+}
+
+//DuplicateListError is returned for a list that reuses a ListID
+type DuplicateListError struct {
+	ListID string
+}
+
+func (e *DuplicateListError) Error() string {
+	return fmt.Sprintf("listorders: duplicate ListID %v", e.ListID)
+}
+
+//UnknownBidError is returned for a message that refers to a ClientBidID or BidID the Manager does not know
+type UnknownBidError struct {
+	ID string
+}
+
+func (e *UnknownBidError) Error() string {
+	return fmt.Sprintf("listorders: unknown bid %v", e.ID)
+}
+
+//DuplicateBidError is returned for a BidRequest that reuses a ClientBidID
+type DuplicateBidError struct {
+	ClientBidID string
+}
+
+func (e *DuplicateBidError) Error() string {
+	return fmt.Sprintf("listorders: duplicate ClientBidID %v", e.ClientBidID)
+}
+
+//BidFlowError is returned for a message that does not follow the bid flow of its BidType
+type BidFlowError struct {
+	BidType enum.BidType
+	Message string
+}
+
+func (e *BidFlowError) Error() string {
+	return fmt.Sprintf("listorders: BidType %v: %v", e.BidType, e.Message)
+}
@@ -0,0 +1,157 @@
+package listorders
+
+import (
+	"github.com/shopspring/decimal"
+
+	"github.com/terracefi/enum"
+	"github.com/terracefi/fix44/bidrequest"
+	"github.com/terracefi/fix44/bidresponse"
+	"github.com/terracefi/fix44/internal/fixutil"
+	"github.com/terracefi/fix44/neworderlist"
+	"github.com/terracefi/fix44/orderstate"
+)
+
+//BidType values, FIX 4.4
+const (
+	BidNonDisclosed     enum.BidType = "1"
+	BidDisclosed        enum.BidType = "2"
+	BidNoBiddingProcess enum.BidType = "3"
+)
+
+//BidRequestTransType values, FIX 4.4
+const (
+	BidTransNew    enum.BidRequestTransType = "N"
+	BidTransCancel enum.BidRequestTransType = "C"
+)
+
+//ListOrderStatus values, FIX 4.4
+const (
+	ListInBiddingProcess     enum.ListOrderStatus = "1"
+	ListReceivedForExecution enum.ListOrderStatus = "2"
+	ListExecuting            enum.ListOrderStatus = "3"
+	ListCancelling           enum.ListOrderStatus = "4"
+	ListAlert                enum.ListOrderStatus = "5"
+	ListAllDone              enum.ListOrderStatus = "6"
+	ListReject               enum.ListOrderStatus = "7"
+)
+
+//OrderStatus is the status of one order of a list
+type OrderStatus struct {
+	ClOrdID   string
+	OrdStatus enum.OrdStatus
+	CumQty    decimal.Decimal
+	LeavesQty decimal.Decimal
+	AvgPx     decimal.Decimal
+	//CxlQty is only reported by ListStatus
+	CxlQty       *decimal.Decimal
+	OrdRejReason *enum.OrdRejReason
+	Text         string
+}
+
+//Done returns true if the order can no longer be filled
+func (o OrderStatus) Done() bool {
+	switch o.OrdStatus {
+	case orderstate.StatusFilled, orderstate.StatusCanceled, orderstate.StatusRejected, orderstate.StatusExpired:
+		return true
+	}
+	return false
+}
+
+//List is the state of a list order as seen by a Manager
+type List struct {
+	ListID      string
+	BidType     enum.BidType
+	BidID       string
+	ClientBidID string
+
+	//Orders holds the orders of the list as sent
+	Orders []neworderlist.NoOrdersStruct
+
+	//ListOrderStatus, ListStatusType and ListStatusText are set from the last ListStatus, ListOrderStatus is empty
+	//until the first one arrives
+	ListOrderStatus enum.ListOrderStatus
+	ListStatusType  enum.ListStatusType
+	ListStatusText  string
+
+	//Executed is true once a ListExecute has been sent, CancelRequested once a ListCancelRequest has
+	Executed        bool
+	CancelRequested bool
+
+	//Statuses holds the status of each order that has been reported, keyed by ClOrdID
+	Statuses map[string]OrderStatus
+}
+
+//Done returns true if the list is all done or rejected, or every order of the list is done
+func (l List) Done() bool {
+	if l.ListOrderStatus == ListAllDone || l.ListOrderStatus == ListReject {
+		return true
+	}
+	if len(l.Orders) == 0 {
+		return false
+	}
+	for _, o := range l.Orders {
+		if s, ok := l.Statuses[fixutil.Deref(o.ClOrdID)]; !ok || !s.Done() {
+			return false
+		}
+	}
+	return true
+}
+
+func (l *List) clone() List {
+	c := *l
+	c.Orders = append([]neworderlist.NoOrdersStruct(nil), l.Orders...)
+	c.Statuses = make(map[string]OrderStatus, len(l.Statuses))
+	for k, v := range l.Statuses {
+		c.Statuses[k] = v
+	}
+	return c
+}
+
+//setStatus reconciles an order status with the one known. A status never moves the order back to a smaller CumQty,
+//and a done order stays done.
+func (l *List) setStatus(s OrderStatus) {
+	if cur, ok := l.Statuses[s.ClOrdID]; ok {
+		if s.CumQty.LessThan(cur.CumQty) || (cur.Done() && !s.Done()) {
+			return
+		}
+		if s.CxlQty == nil {
+			s.CxlQty = cur.CxlQty
+		}
+	}
+	l.Statuses[s.ClOrdID] = s
+}
+
+//Bid is a bid request and the responses to it
+type Bid struct {
+	ClientBidID string
+	//BidID is set from the first BidResponse
+	BidID    string
+	BidType  enum.BidType
+	Canceled bool
+
+	Request bidrequest.Struct
+	//Components holds the NoBidComponents of every BidResponse, in the order they arrived
+	Components []bidresponse.NoBidComponentsStruct
+}
+
+//Answered returns true once a BidResponse has given the bid a BidID
+func (b Bid) Answered() bool {
+	return b.BidID != ""
+}
+
+//ListIDs returns the ListIDs the BidRequest names in its NoBidComponents
+func (b Bid) ListIDs() []string {
+	var ids []string
+	for _, c := range b.Request.NoBidComponents {
+		if c.ListID != nil {
+			ids = append(ids, *c.ListID)
+		}
+	}
+	return ids
+}
+
+func (b *Bid) clone() Bid {
+	c := *b
+	c.Components = append([]bidresponse.NoBidComponentsStruct(nil), b.Components...)
+	return c
+}
@@ -0,0 +1,392 @@
+package listorders
+
+import (
+	"sync"
+	"time"
+
+	"github.com/terracefi/fix44/bidrequest"
+	"github.com/terracefi/fix44/bidresponse"
+	"github.com/terracefi/fix44/executionreport"
+	"github.com/terracefi/fix44/internal/fixutil"
+	"github.com/terracefi/fix44/listcancelrequest"
+	"github.com/terracefi/fix44/listexecute"
+	"github.com/terracefi/fix44/liststatus"
+	"github.com/terracefi/fix44/liststatusrequest"
+	"github.com/terracefi/fix44/neworderlist"
+	"github.com/terracefi/quickfix"
+)
+
+//Manager sends list orders and bids on one session and tracks their status. It is safe for concurrent use.
+type Manager struct {
+	//MaxOrders is the largest number of orders sent in one NewOrderList, zero for no limit
+	MaxOrders int
+
+	//SendMessage sends the NewOrderList fragments, by default on the session given to New
+	SendMessage func(msg quickfix.Messagable) error
+
+	mu     sync.Mutex
+	lists  map[string]*List
+	bids   map[string]*Bid
+	bidIDs map[string]*Bid
+}
+
+//New returns a Manager that sends on sessionID
+func New(sessionID quickfix.SessionID, maxOrders int) *Manager {
+	return &Manager{
+		MaxOrders:   maxOrders,
+		SendMessage: fixutil.SendOn(sessionID),
+		lists:       make(map[string]*List),
+		bids:        make(map[string]*Bid),
+		bidIDs:      make(map[string]*Bid),
+	}
+}
+
+//List returns the list with the given ListID
+func (m *Manager) List(listID string) (List, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	l, ok := m.lists[listID]
+	if !ok {
+		return List{}, false
+	}
+	return l.clone(), true
+}
+
+//Bid returns the bid with the given ClientBidID
+func (m *Manager) Bid(clientBidID string) (Bid, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	b, ok := m.bids[clientBidID]
+	if !ok {
+		return Bid{}, false
+	}
+	return b.clone(), true
+}
+
+//Send sends the list s in as many NewOrderList messages as MaxOrders requires. A non-disclosed list must name an
+//answered bid by its ClientBidID or BidID, and is sent with both. The list is dropped if its first message cannot be
+//sent, a later failure leaves it tracked since the counterparty has received part of it.
+func (m *Manager) Send(s neworderlist.Struct) error {
+	m.mu.Lock()
+	if _, ok := m.lists[s.ListID]; ok {
+		m.mu.Unlock()
+		return &DuplicateListError{s.ListID}
+	}
+
+	if s.BidType == BidNonDisclosed {
+		b, err := m.answeredBid(s.ClientBidID, s.BidID)
+		if err != nil {
+			m.mu.Unlock()
+			return err
+		}
+		bidID, clientBidID := b.BidID, b.ClientBidID
+		s.BidID, s.ClientBidID = &bidID, &clientBidID
+	}
+
+	m.lists[s.ListID] = &List{
+		ListID:      s.ListID,
+		BidType:     s.BidType,
+		BidID:       fixutil.Deref(s.BidID),
+		ClientBidID: fixutil.Deref(s.ClientBidID),
+		Orders:      s.NoOrders,
+		Statuses:    make(map[string]OrderStatus),
+	}
+	m.mu.Unlock()
+
+	for i, f := range fragment(s, m.MaxOrders) {
+		if err := m.SendMessage(neworderlist.Unmarshal(f)); err != nil {
+			if i == 0 {
+				m.mu.Lock()
+				delete(m.lists, s.ListID)
+				m.mu.Unlock()
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+//fragment splits s into messages of at most max orders each. Every fragment carries the TotNoOrders of the whole
+//list, and a list split in several carries LastFragment on each.
+func fragment(s neworderlist.Struct, max int) []neworderlist.Struct {
+	s.TotNoOrders = len(s.NoOrders)
+	if max <= 0 || len(s.NoOrders) <= max {
+		return []neworderlist.Struct{s}
+	}
+
+	var out []neworderlist.Struct
+	for orders := s.NoOrders; len(orders) > 0; {
+		n := len(orders)
+		if n > max {
+			n = max
+		}
+		f := s
+		f.NoOrders, orders = orders[:n], orders[n:]
+		last := len(orders) == 0
+		f.LastFragment = &last
+		out = append(out, f)
+	}
+	return out
+}
+
+//answeredBid returns the bid named by clientBidID or bidID, which must have been answered
+func (m *Manager) answeredBid(clientBidID, bidID *string) (*Bid, error) {
+	var b *Bid
+	switch {
+	case clientBidID != nil:
+		b = m.bids[*clientBidID]
+	case bidID != nil:
+		b = m.bidIDs[*bidID]
+	default:
+		return nil, &BidFlowError{BidNonDisclosed, "NewOrderList names no bid"}
+	}
+	if b == nil {
+		return nil, &UnknownBidError{fixutil.Deref(clientBidID) + fixutil.Deref(bidID)}
+	}
+	if !b.Answered() || b.Canceled {
+		return nil, &BidFlowError{b.BidType, "bid " + b.ClientBidID + " has not been answered"}
+	}
+	return b, nil
+}
+
+//Execute sends a ListExecute for the list. A disclosed list must be a component of an answered bid, whose BidID and
+//ClientBidID the ListExecute carries.
+func (m *Manager) Execute(listID string) error {
+	m.mu.Lock()
+	l, ok := m.lists[listID]
+	if !ok {
+		m.mu.Unlock()
+		return &UnknownListError{listID}
+	}
+
+	if l.BidType == BidDisclosed {
+		var bid *Bid
+		for _, b := range m.bids {
+			for _, id := range b.ListIDs() {
+				if id == listID && !b.Canceled && (bid == nil || b.Answered()) {
+					bid = b
+				}
+			}
+		}
+		if bid == nil || !bid.Answered() {
+			m.mu.Unlock()
+			return &BidFlowError{BidDisclosed, "list " + listID + " is not a component of an answered bid"}
+		}
+		l.BidID, l.ClientBidID = bid.BidID, bid.ClientBidID
+	}
+	l.Executed = true
+
+	s := listexecute.Struct{ListID: listID, TransactTime: time.Now()}
+	if l.BidID != "" {
+		bidID := l.BidID
+		s.BidID = &bidID
+	}
+	if l.ClientBidID != "" {
+		clientBidID := l.ClientBidID
+		s.ClientBidID = &clientBidID
+	}
+	m.mu.Unlock()
+
+	return m.SendMessage(listexecute.Unmarshal(s))
+}
+
+//Cancel sends a ListCancelRequest for the list
+func (m *Manager) Cancel(listID string) error {
+	m.mu.Lock()
+	l, ok := m.lists[listID]
+	if !ok {
+		m.mu.Unlock()
+		return &UnknownListError{listID}
+	}
+	l.CancelRequested = true
+	m.mu.Unlock()
+
+	return m.SendMessage(listcancelrequest.Unmarshal(listcancelrequest.Struct{
+		ListID:       listID,
+		TransactTime: time.Now(),
+	}))
+}
+
+//RequestStatus sends a ListStatusRequest for the list
+func (m *Manager) RequestStatus(listID string) error {
+	m.mu.Lock()
+	_, ok := m.lists[listID]
+	m.mu.Unlock()
+	if !ok {
+		return &UnknownListError{listID}
+	}
+
+	return m.SendMessage(liststatusrequest.Unmarshal(liststatusrequest.Struct{ListID: listID}))
+}
+
+//RequestBid sends a new BidRequest. A non-disclosed bid must describe the program with NoBidDescriptors, a
+//disclosed bid must name lists already sent with BidType Disclosed in its NoBidComponents.
+func (m *Manager) RequestBid(s bidrequest.Struct) error {
+	m.mu.Lock()
+	if _, ok := m.bids[s.ClientBidID]; ok {
+		m.mu.Unlock()
+		return &DuplicateBidError{s.ClientBidID}
+	}
+	s.BidRequestTransType = BidTransNew
+
+	b := &Bid{ClientBidID: s.ClientBidID, BidType: s.BidType, Request: s}
+	if err := m.checkBid(b); err != nil {
+		m.mu.Unlock()
+		return err
+	}
+	m.bids[s.ClientBidID] = b
+	m.mu.Unlock()
+
+	return m.SendMessage(bidrequest.Unmarshal(s))
+}
+
+func (m *Manager) checkBid(b *Bid) error {
+	switch b.BidType {
+	case BidNonDisclosed:
+		if len(b.Request.NoBidDescriptors) == 0 {
+			return &BidFlowError{b.BidType, "BidRequest has no NoBidDescriptors"}
+		}
+	case BidDisclosed:
+		ids := b.ListIDs()
+		if len(ids) == 0 {
+			return &BidFlowError{b.BidType, "BidRequest names no lists in NoBidComponents"}
+		}
+		for _, id := range ids {
+			l, ok := m.lists[id]
+			if !ok {
+				return &UnknownListError{id}
+			}
+			if l.BidType != BidDisclosed {
+				return &BidFlowError{b.BidType, "list " + id + " was not sent for a disclosed bid"}
+			}
+		}
+	default:
+		return &BidFlowError{b.BidType, "BidType takes no BidRequest"}
+	}
+	return nil
+}
+
+//CancelBid sends a BidRequest canceling the bid with the given ClientBidID
+func (m *Manager) CancelBid(clientBidID string) error {
+	m.mu.Lock()
+	b, ok := m.bids[clientBidID]
+	if !ok {
+		m.mu.Unlock()
+		return &UnknownBidError{clientBidID}
+	}
+	b.Canceled = true
+
+	s := b.Request
+	s.BidRequestTransType = BidTransCancel
+	if b.BidID != "" {
+		bidID := b.BidID
+		s.BidID = &bidID
+	}
+	m.mu.Unlock()
+
+	return m.SendMessage(bidrequest.Unmarshal(s))
+}
+
+//OnBidResponse applies a BidResponse to the bid of its ClientBidID or BidID. The first response gives the bid its
+//BidID.
+func (m *Manager) OnBidResponse(msg bidresponse.BidResponse) (Bid, error) {
+	s, err := bidresponse.Marshal(msg)
+	if err != nil {
+		return Bid{}, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var b *Bid
+	if s.ClientBidID != nil {
+		b = m.bids[*s.ClientBidID]
+	} else if s.BidID != nil {
+		b = m.bidIDs[*s.BidID]
+	}
+	if b == nil {
+		return Bid{}, &UnknownBidError{fixutil.Deref(s.ClientBidID) + fixutil.Deref(s.BidID)}
+	}
+
+	if b.BidID == "" && s.BidID != nil {
+		b.BidID = *s.BidID
+		m.bidIDs[b.BidID] = b
+	}
+	b.Components = append(b.Components, s.NoBidComponents...)
+	return b.clone(), nil
+}
+
+//OnListStatus applies a ListStatus to its list, and the status of each of its NoOrders to the order of the list
+func (m *Manager) OnListStatus(msg liststatus.ListStatus) (List, error) {
+	s, err := liststatus.Marshal(msg)
+	if err != nil {
+		return List{}, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	l, ok := m.lists[s.ListID]
+	if !ok {
+		return List{}, &UnknownListError{s.ListID}
+	}
+	l.ListOrderStatus = s.ListOrderStatus
+	l.ListStatusType = s.ListStatusType
+	l.ListStatusText = fixutil.Deref(s.ListStatusText)
+
+	for _, o := range s.NoOrders {
+		if o.ClOrdID == nil || o.OrdStatus == nil {
+			continue
+		}
+		os := OrderStatus{
+			ClOrdID:      *o.ClOrdID,
+			OrdStatus:    *o.OrdStatus,
+			CxlQty:       o.CxlQty,
+			OrdRejReason: o.OrdRejReason,
+			Text:         fixutil.Deref(o.Text),
+		}
+		if o.CumQty != nil {
+			os.CumQty = *o.CumQty
+		}
+		if o.LeavesQty != nil {
+			os.LeavesQty = *o.LeavesQty
+		}
+		if o.AvgPx != nil {
+			os.AvgPx = *o.AvgPx
+		}
+		l.setStatus(os)
+	}
+	return l.clone(), nil
+}
+
+//OnExecutionReport applies an ExecutionReport to the order of the list named by its ListID. Reports without a
+//ListID or ClOrdID are ignored.
+func (m *Manager) OnExecutionReport(msg executionreport.ExecutionReport) (List, error) {
+	s, err := executionreport.Marshal(msg)
+	if err != nil {
+		return List{}, err
+	}
+	if s.ListID == nil || s.ClOrdID == nil {
+		return List{}, nil
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	l, ok := m.lists[*s.ListID]
+	if !ok {
+		return List{}, &UnknownListError{*s.ListID}
+	}
+	l.setStatus(OrderStatus{
+		ClOrdID:      *s.ClOrdID,
+		OrdStatus:    s.OrdStatus,
+		CumQty:       s.CumQty,
+		LeavesQty:    s.LeavesQty,
+		AvgPx:        s.AvgPx,
+		OrdRejReason: s.OrdRejReason,
+		Text:         fixutil.Deref(s.Text),
+	})
+	return l.clone(), nil
+}
@@ -0,0 +1,326 @@
+package listorders
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/terracefi/enum"
+	"github.com/terracefi/field"
+	"github.com/terracefi/fix44/bidrequest"
+	"github.com/terracefi/fix44/bidresponse"
+	"github.com/terracefi/fix44/executionreport"
+	"github.com/terracefi/fix44/internal/fixutil"
+	"github.com/terracefi/fix44/internal/testutil"
+	"github.com/terracefi/fix44/listexecute"
+	"github.com/terracefi/fix44/liststatus"
+	"github.com/terracefi/fix44/neworderlist"
+	"github.com/terracefi/quickfix"
+)
+
+func newManager(maxOrders int) (*Manager, *testutil.Sender) {
+	m := New(quickfix.SessionID{}, maxOrders)
+	s := &testutil.Sender{}
+	m.SendMessage = s.Send
+	return m, s
+}
+
+//list returns a list of orders named listID-1, listID-2, ...
+func list(listID string, bidType enum.BidType, orders int) neworderlist.Struct {
+	s := neworderlist.Struct{ListID: listID, BidType: bidType}
+	for i := 1; i <= orders; i++ {
+		s.NoOrders = append(s.NoOrders, neworderlist.NoOrdersStruct{
+			ClOrdID:   fixutil.Ptr(fmt.Sprintf("%v-%v", listID, i)),
+			ListSeqNo: fixutil.Ptr(i),
+		})
+	}
+	return s
+}
+
+func TestSendFragments(t *testing.T) {
+	tests := []struct {
+		name      string
+		maxOrders int
+		orders    int
+		want      []string
+	}{
+		{"no limit", 0, 5, []string{"5/5"}},
+		{"fits", 5, 5, []string{"5/5"}},
+		{"split", 2, 5, []string{"2/5N", "2/5N", "1/5Y"}},
+		{"split evenly", 2, 4, []string{"2/4N", "2/4Y"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, s := newManager(tt.maxOrders)
+			if err := m.Send(list("L1", BidNoBiddingProcess, tt.orders)); err != nil {
+				t.Fatal(err)
+			}
+
+			var got []string
+			for _, msg := range s.Sent {
+				f, err := neworderlist.Marshal(msg.(neworderlist.NewOrderList))
+				if err != nil {
+					t.Fatal(err)
+				}
+				v := fmt.Sprintf("%v/%v", len(f.NoOrders), f.TotNoOrders)
+				if f.LastFragment != nil {
+					v += map[bool]string{true: "Y", false: "N"}[*f.LastFragment]
+				}
+				got = append(got, v)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("sent %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSendErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		first   bool
+		failAt  int
+		wantErr error
+		known   bool
+	}{
+		{"duplicate ListID", true, 0, &DuplicateListError{}, true},
+		{"first fragment not sent", false, 1, testutil.ErrSend, false},
+		{"later fragment not sent", false, 2, testutil.ErrSend, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, s := newManager(2)
+			if tt.first {
+				if err := m.Send(list("L1", BidNoBiddingProcess, 4)); err != nil {
+					t.Fatal(err)
+				}
+			}
+			s.FailAt = len(s.Sent) + tt.failAt
+
+			err := m.Send(list("L1", BidNoBiddingProcess, 4))
+			if reflect.TypeOf(err) != reflect.TypeOf(tt.wantErr) {
+				t.Errorf("Send() error = %v, want %T", err, tt.wantErr)
+			}
+			if _, ok := m.List("L1"); ok != tt.known {
+				t.Errorf("List() found = %v, want %v", ok, tt.known)
+			}
+		})
+	}
+}
+
+func nonDisclosedBid(clientBidID string) bidrequest.Struct {
+	return bidrequest.Struct{
+		ClientBidID:      clientBidID,
+		BidType:          BidNonDisclosed,
+		BidTradeType:     "R",
+		BasisPxType:      "2",
+		NoBidDescriptors: []bidrequest.NoBidDescriptorsStruct{{BidDescriptor: fixutil.Ptr("FTSE")}},
+	}
+}
+
+func disclosedBid(clientBidID string, listIDs ...string) bidrequest.Struct {
+	s := bidrequest.Struct{ClientBidID: clientBidID, BidType: BidDisclosed, BidTradeType: "R", BasisPxType: "2"}
+	for _, id := range listIDs {
+		s.NoBidComponents = append(s.NoBidComponents, bidrequest.NoBidComponentsStruct{ListID: fixutil.Ptr(id)})
+	}
+	return s
+}
+
+func bidResponse(clientBidID, bidID string) bidresponse.BidResponse {
+	return bidresponse.Unmarshal(bidresponse.Struct{ClientBidID: fixutil.Ptr(clientBidID), BidID: fixutil.Ptr(bidID)})
+}
+
+func TestNonDisclosedBid(t *testing.T) {
+	tests := []struct {
+		name        string
+		answer      bool
+		cancel      bool
+		clientBidID *string
+		bidID       *string
+		wantErr     error
+	}{
+		{"by ClientBidID", true, false, fixutil.Ptr("B1"), nil, nil},
+		{"by BidID", true, false, nil, fixutil.Ptr("X1"), nil},
+		{"no bid", true, false, nil, nil, &BidFlowError{}},
+		{"unknown bid", true, false, fixutil.Ptr("B2"), nil, &UnknownBidError{}},
+		{"not answered", false, false, fixutil.Ptr("B1"), nil, &BidFlowError{}},
+		{"canceled", true, true, fixutil.Ptr("B1"), nil, &BidFlowError{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, _ := newManager(0)
+			if err := m.RequestBid(nonDisclosedBid("B1")); err != nil {
+				t.Fatal(err)
+			}
+			if tt.answer {
+				if _, err := m.OnBidResponse(bidResponse("B1", "X1")); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if tt.cancel {
+				if err := m.CancelBid("B1"); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			l := list("L1", BidNonDisclosed, 2)
+			l.ClientBidID, l.BidID = tt.clientBidID, tt.bidID
+			err := m.Send(l)
+			if reflect.TypeOf(err) != reflect.TypeOf(tt.wantErr) {
+				t.Fatalf("Send() error = %v, want %T", err, tt.wantErr)
+			}
+			if got, ok := m.List("L1"); ok && (got.BidID != "X1" || got.ClientBidID != "B1") {
+				t.Errorf("List() BidID = %v, ClientBidID = %v, want X1 and B1", got.BidID, got.ClientBidID)
+			}
+		})
+	}
+}
+
+func TestDisclosedBid(t *testing.T) {
+	tests := []struct {
+		name       string
+		listType   enum.BidType
+		bid        *bidrequest.Struct
+		answer     bool
+		bidErr     error
+		executeErr error
+	}{
+		{"answered", BidDisclosed, fixutil.Ptr(disclosedBid("B1", "L1")), true, nil, nil},
+		{"no bid", BidDisclosed, nil, false, nil, &BidFlowError{}},
+		{"not answered", BidDisclosed, fixutil.Ptr(disclosedBid("B1", "L1")), false, nil, &BidFlowError{}},
+		{"unknown list", BidDisclosed, fixutil.Ptr(disclosedBid("B1", "L2")), false, &UnknownListError{},
+			&BidFlowError{}},
+		{"no lists", BidDisclosed, fixutil.Ptr(disclosedBid("B1")), false, &BidFlowError{}, &BidFlowError{}},
+		{"list not disclosed", BidNoBiddingProcess, fixutil.Ptr(disclosedBid("B1", "L1")), false, &BidFlowError{}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, s := newManager(0)
+			if err := m.Send(list("L1", tt.listType, 2)); err != nil {
+				t.Fatal(err)
+			}
+			if tt.bid != nil {
+				err := m.RequestBid(*tt.bid)
+				if reflect.TypeOf(err) != reflect.TypeOf(tt.bidErr) {
+					t.Fatalf("RequestBid() error = %v, want %T", err, tt.bidErr)
+				}
+			}
+			if tt.answer {
+				if _, err := m.OnBidResponse(bidResponse("B1", "X1")); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			err := m.Execute("L1")
+			if reflect.TypeOf(err) != reflect.TypeOf(tt.executeErr) {
+				t.Fatalf("Execute() error = %v, want %T", err, tt.executeErr)
+			}
+			if err != nil || tt.listType != BidDisclosed {
+				return
+			}
+			e, merr := listexecute.Marshal(s.Sent[len(s.Sent)-1].(listexecute.ListExecute))
+			if merr != nil {
+				t.Fatal(merr)
+			}
+			if e.BidID == nil || *e.BidID != "X1" || e.ClientBidID == nil || *e.ClientBidID != "B1" {
+				t.Errorf("ListExecute BidID = %v, ClientBidID = %v, want X1 and B1", e.BidID, e.ClientBidID)
+			}
+		})
+	}
+}
+
+func executionReport(listID, clOrdID string, status enum.OrdStatus,
+	cumQty, leavesQty int64) executionreport.ExecutionReport {
+	msg := executionreport.New(field.NewOrderID("O-"+clOrdID), field.NewExecID(fmt.Sprint(cumQty, status)),
+		field.NewExecType("F"), field.NewOrdStatus(status), field.NewSide("1"),
+		field.NewLeavesQty(decimal.NewFromInt(leavesQty), 0), field.NewCumQty(decimal.NewFromInt(cumQty), 0),
+		field.NewAvgPx(decimal.NewFromInt(10), 0))
+	msg.SetListID(listID)
+	msg.SetClOrdID(clOrdID)
+	return msg
+}
+
+func TestOrderStatuses(t *testing.T) {
+	type report struct {
+		clOrdID   string
+		status    enum.OrdStatus
+		cumQty    int64
+		leavesQty int64
+	}
+
+	tests := []struct {
+		name       string
+		reports    []report
+		listStatus enum.ListOrderStatus
+		status     enum.OrdStatus
+		cumQty     int64
+		done       bool
+	}{
+		{"partially filled", []report{{"L1-1", "1", 40, 60}}, "", "1", 40, false},
+		{"one order filled", []report{{"L1-1", "2", 100, 0}}, "", "2", 100, false},
+		{"every order done", []report{{"L1-1", "2", 100, 0}, {"L1-2", "4", 0, 0}}, "", "2", 100, true},
+		{"late report", []report{{"L1-1", "1", 60, 40}, {"L1-1", "1", 40, 60}}, "", "1", 60, false},
+		{"done stays done", []report{{"L1-1", "4", 40, 0}, {"L1-1", "1", 40, 60}}, "", "4", 40, false},
+		{"all done", []report{{"L1-1", "1", 40, 60}}, ListAllDone, "1", 40, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, _ := newManager(0)
+			if err := m.Send(list("L1", BidNoBiddingProcess, 2)); err != nil {
+				t.Fatal(err)
+			}
+			for _, r := range tt.reports {
+				_, err := m.OnExecutionReport(executionReport("L1", r.clOrdID, r.status, r.cumQty, r.leavesQty))
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+			if tt.listStatus != "" {
+				_, err := m.OnListStatus(liststatus.Unmarshal(liststatus.Struct{ListID: "L1", ListStatusType: "5",
+					ListOrderStatus: tt.listStatus, NoRpts: 1, RptSeq: 1, TotNoOrders: 2}))
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			l, _ := m.List("L1")
+			s := l.Statuses["L1-1"]
+			if s.OrdStatus != tt.status || !s.CumQty.Equal(decimal.NewFromInt(tt.cumQty)) {
+				t.Errorf("Statuses[L1-1] = %v %v, want %v %v", s.OrdStatus, s.CumQty, tt.status, tt.cumQty)
+			}
+			if l.Done() != tt.done {
+				t.Errorf("Done() = %v, want %v", l.Done(), tt.done)
+			}
+		})
+	}
+}
+
+func TestListStatusOrders(t *testing.T) {
+	m, _ := newManager(0)
+	m.Send(list("L1", BidNoBiddingProcess, 2))
+
+	l, err := m.OnListStatus(liststatus.Unmarshal(liststatus.Struct{ListID: "L1", ListStatusType: "2",
+		ListOrderStatus: ListExecuting, NoRpts: 1, RptSeq: 1, TotNoOrders: 2,
+		NoOrders: []liststatus.NoOrdersStruct{
+			{ClOrdID: fixutil.Ptr("L1-1"), OrdStatus: fixutil.Ptr(enum.OrdStatus("2")),
+				CumQty: fixutil.Ptr(decimal.NewFromInt(100))},
+			{ClOrdID: fixutil.Ptr("L1-2"), OrdStatus: fixutil.Ptr(enum.OrdStatus("4")),
+				CxlQty: fixutil.Ptr(decimal.NewFromInt(100))},
+		}}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.ListOrderStatus != ListExecuting || !l.Done() {
+		t.Errorf("List() = %v, Done() = %v, want Executing and done", l.ListOrderStatus, l.Done())
+	}
+	if c := l.Statuses["L1-2"].CxlQty; c == nil || !c.Equal(decimal.NewFromInt(100)) {
+		t.Errorf("CxlQty = %v, want 100", c)
+	}
+
+	_, err = m.OnListStatus(liststatus.Unmarshal(liststatus.Struct{ListID: "L2", ListStatusType: "2",
+		ListOrderStatus: ListExecuting, NoRpts: 1, RptSeq: 1}))
+	if _, ok := err.(*UnknownListError); !ok {
+		t.Errorf("OnListStatus() error = %v, want *UnknownListError", err)
+	}
+}
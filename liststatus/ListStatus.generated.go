@@ -64,6 +64,26 @@ func Route(router RouteOut) (string, string, quickfix.MessageRoute) {
 	return "FIX.4.4", "N", r
 }
 
+//Validate checks m against the FIX 4.4 definition of ListStatus, see fix44.MessageDef.Validate
+func (m ListStatus) Validate() fix44.ValidationErrors {
+	return fix44.MessageDefs["N"].Validate(&m.Body.FieldMap)
+}
+
+//MarshalJSON encodes the ListStatus as JSON with fields keyed by name, see fix44.MarshalMessageJSON
+func (m ListStatus) MarshalJSON() ([]byte, error) {
+	return fix44.MarshalMessageJSON(m.Message)
+}
+
+//UnmarshalJSON builds the ListStatus from JSON produced by MarshalJSON
+func (m *ListStatus) UnmarshalJSON(b []byte) error {
+	msg, err := fix44.UnmarshalMessageJSON(b, "N")
+	if err != nil {
+		return err
+	}
+	*m = FromMessage(msg)
+	return nil
+}
+
 //SetTransactTime sets TransactTime, Tag 60
 func (m ListStatus) SetTransactTime(v time.Time) {
 	m.Set(field.NewTransactTime(v))
@@ -285,6 +305,21 @@ func (m ListStatus) HasEncodedListStatusText() bool {
 	return m.Has(tag.EncodedListStatusText)
 }
 
+//SetEncodedListStatusTextBytes sets EncodedListStatusText, Tag 446, and EncodedListStatusTextLen, Tag 445, from v, which may hold any bytes including SOH
+func (m ListStatus) SetEncodedListStatusTextBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedListStatusTextLen, tag.EncodedListStatusText, v)
+}
+
+//GetEncodedListStatusTextBytes gets EncodedListStatusText, Tag 446, checking its length against EncodedListStatusTextLen, Tag 445
+func (m ListStatus) GetEncodedListStatusTextBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedListStatusTextLen, tag.EncodedListStatusText)
+}
+
+//GetEncodedListStatusTextDecoded gets EncodedListStatusText, Tag 446, decoded to UTF-8 using the MessageEncoding of the Header
+func (m ListStatus) GetEncodedListStatusTextDecoded() (string, error) {
+	return fix44.DecodeRawData(m.Header, m, tag.EncodedListStatusTextLen, tag.EncodedListStatusText)
+}
+
 //HasLastFragment returns true if LastFragment is present, Tag 893
 func (m ListStatus) HasLastFragment() bool {
 	return m.Has(tag.LastFragment)
@@ -523,6 +558,21 @@ func (m NoOrders) HasEncodedText() bool {
 	return m.Has(tag.EncodedText)
 }
 
+//SetEncodedTextBytes sets EncodedText, Tag 355, and EncodedTextLen, Tag 354, from v, which may hold any bytes including SOH
+func (m NoOrders) SetEncodedTextBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedTextLen, tag.EncodedText, v)
+}
+
+//GetEncodedTextBytes gets EncodedText, Tag 355, checking its length against EncodedTextLen, Tag 354
+func (m NoOrders) GetEncodedTextBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedTextLen, tag.EncodedText)
+}
+
+//GetEncodedTextDecoded gets EncodedText, Tag 355, decoded to UTF-8 using the MessageEncoding of h, the Header of the message holding it
+func (m NoOrders) GetEncodedTextDecoded(h fix44.Header) (string, error) {
+	return fix44.DecodeRawData(h, m, tag.EncodedTextLen, tag.EncodedText)
+}
+
 //NoOrdersRepeatingGroup is a repeating group, Tag 73
 type NoOrdersRepeatingGroup struct {
 	*quickfix.RepeatingGroup
@@ -545,3 +595,289 @@ func (m NoOrdersRepeatingGroup) Add() NoOrders {
 func (m NoOrdersRepeatingGroup) Get(i int) NoOrders {
 	return NoOrders{m.RepeatingGroup.Get(i)}
 }
+
+//Struct is a plain Go representation of the ListStatus body, optional fields are nil when absent
+type Struct struct {
+	TransactTime             *time.Time
+	ListID                   string
+	TotNoOrders              int
+	NoOrders                 []NoOrdersStruct
+	NoRpts                   int
+	RptSeq                   int
+	ListStatusType           enum.ListStatusType
+	ListOrderStatus          enum.ListOrderStatus
+	ListStatusText           *string
+	EncodedListStatusTextLen *int
+	EncodedListStatusText    *string
+	LastFragment             *bool
+}
+
+//Marshal copies the fields present in msg into a Struct
+func Marshal(msg ListStatus) (Struct, quickfix.MessageRejectError) {
+	var s Struct
+	if msg.HasTransactTime() {
+		v, err := msg.GetTransactTime()
+		if err != nil {
+			return s, err
+		}
+		s.TransactTime = &v
+	}
+	if msg.HasListID() {
+		v, err := msg.GetListID()
+		if err != nil {
+			return s, err
+		}
+		s.ListID = v
+	}
+	if msg.HasTotNoOrders() {
+		v, err := msg.GetTotNoOrders()
+		if err != nil {
+			return s, err
+		}
+		s.TotNoOrders = v
+	}
+	if msg.HasNoOrders() {
+		g, err := msg.GetNoOrders()
+		if err != nil {
+			return s, err
+		}
+		if s.NoOrders, err = MarshalNoOrders(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasNoRpts() {
+		v, err := msg.GetNoRpts()
+		if err != nil {
+			return s, err
+		}
+		s.NoRpts = v
+	}
+	if msg.HasRptSeq() {
+		v, err := msg.GetRptSeq()
+		if err != nil {
+			return s, err
+		}
+		s.RptSeq = v
+	}
+	if msg.HasListStatusType() {
+		v, err := msg.GetListStatusType()
+		if err != nil {
+			return s, err
+		}
+		s.ListStatusType = v
+	}
+	if msg.HasListOrderStatus() {
+		v, err := msg.GetListOrderStatus()
+		if err != nil {
+			return s, err
+		}
+		s.ListOrderStatus = v
+	}
+	if msg.HasListStatusText() {
+		v, err := msg.GetListStatusText()
+		if err != nil {
+			return s, err
+		}
+		s.ListStatusText = &v
+	}
+	if msg.HasEncodedListStatusTextLen() {
+		v, err := msg.GetEncodedListStatusTextLen()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedListStatusTextLen = &v
+	}
+	if msg.HasEncodedListStatusText() {
+		v, err := msg.GetEncodedListStatusText()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedListStatusText = &v
+	}
+	if msg.HasLastFragment() {
+		v, err := msg.GetLastFragment()
+		if err != nil {
+			return s, err
+		}
+		s.LastFragment = &v
+	}
+	return s, nil
+}
+
+//Unmarshal builds a ListStatus from s
+func Unmarshal(s Struct) ListStatus {
+	m := New(field.NewListID(s.ListID), field.NewListStatusType(s.ListStatusType), field.NewNoRpts(s.NoRpts), field.NewListOrderStatus(s.ListOrderStatus), field.NewRptSeq(s.RptSeq), field.NewTotNoOrders(s.TotNoOrders))
+	if s.TransactTime != nil {
+		m.SetTransactTime(*s.TransactTime)
+	}
+	if s.NoOrders != nil {
+		m.SetNoOrders(UnmarshalNoOrders(s.NoOrders))
+	}
+	if s.ListStatusText != nil {
+		m.SetListStatusText(*s.ListStatusText)
+	}
+	if s.EncodedListStatusTextLen != nil {
+		m.SetEncodedListStatusTextLen(*s.EncodedListStatusTextLen)
+	}
+	if s.EncodedListStatusText != nil {
+		m.SetEncodedListStatusText(*s.EncodedListStatusText)
+	}
+	if s.LastFragment != nil {
+		m.SetLastFragment(*s.LastFragment)
+	}
+	return m
+}
+
+//NoOrdersStruct is a plain Go representation of a NoOrders group element, optional fields are nil when absent
+type NoOrdersStruct struct {
+	ClOrdID          *string
+	SecondaryClOrdID *string
+	CumQty           *decimal.Decimal
+	OrdStatus        *enum.OrdStatus
+	WorkingIndicator *bool
+	LeavesQty        *decimal.Decimal
+	CxlQty           *decimal.Decimal
+	AvgPx            *decimal.Decimal
+	OrdRejReason     *enum.OrdRejReason
+	Text             *string
+	EncodedTextLen   *int
+	EncodedText      *string
+}
+
+//MarshalNoOrders copies the elements of g into a slice of NoOrdersStruct
+func MarshalNoOrders(g NoOrdersRepeatingGroup) ([]NoOrdersStruct, quickfix.MessageRejectError) {
+	s := make([]NoOrdersStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasClOrdID() {
+			v, err := m.GetClOrdID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].ClOrdID = &v
+		}
+		if m.HasSecondaryClOrdID() {
+			v, err := m.GetSecondaryClOrdID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SecondaryClOrdID = &v
+		}
+		if m.HasCumQty() {
+			v, err := m.GetCumQty()
+			if err != nil {
+				return nil, err
+			}
+			s[i].CumQty = &v
+		}
+		if m.HasOrdStatus() {
+			v, err := m.GetOrdStatus()
+			if err != nil {
+				return nil, err
+			}
+			s[i].OrdStatus = &v
+		}
+		if m.HasWorkingIndicator() {
+			v, err := m.GetWorkingIndicator()
+			if err != nil {
+				return nil, err
+			}
+			s[i].WorkingIndicator = &v
+		}
+		if m.HasLeavesQty() {
+			v, err := m.GetLeavesQty()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LeavesQty = &v
+		}
+		if m.HasCxlQty() {
+			v, err := m.GetCxlQty()
+			if err != nil {
+				return nil, err
+			}
+			s[i].CxlQty = &v
+		}
+		if m.HasAvgPx() {
+			v, err := m.GetAvgPx()
+			if err != nil {
+				return nil, err
+			}
+			s[i].AvgPx = &v
+		}
+		if m.HasOrdRejReason() {
+			v, err := m.GetOrdRejReason()
+			if err != nil {
+				return nil, err
+			}
+			s[i].OrdRejReason = &v
+		}
+		if m.HasText() {
+			v, err := m.GetText()
+			if err != nil {
+				return nil, err
+			}
+			s[i].Text = &v
+		}
+		if m.HasEncodedTextLen() {
+			v, err := m.GetEncodedTextLen()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedTextLen = &v
+		}
+		if m.HasEncodedText() {
+			v, err := m.GetEncodedText()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedText = &v
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoOrders builds a NoOrdersRepeatingGroup from s
+func UnmarshalNoOrders(s []NoOrdersStruct) NoOrdersRepeatingGroup {
+	g := NewNoOrdersRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.ClOrdID != nil {
+			m.SetClOrdID(*e.ClOrdID)
+		}
+		if e.SecondaryClOrdID != nil {
+			m.SetSecondaryClOrdID(*e.SecondaryClOrdID)
+		}
+		if e.CumQty != nil {
+			m.SetCumQty(*e.CumQty, fix44.DecimalScale(*e.CumQty))
+		}
+		if e.OrdStatus != nil {
+			m.SetOrdStatus(*e.OrdStatus)
+		}
+		if e.WorkingIndicator != nil {
+			m.SetWorkingIndicator(*e.WorkingIndicator)
+		}
+		if e.LeavesQty != nil {
+			m.SetLeavesQty(*e.LeavesQty, fix44.DecimalScale(*e.LeavesQty))
+		}
+		if e.CxlQty != nil {
+			m.SetCxlQty(*e.CxlQty, fix44.DecimalScale(*e.CxlQty))
+		}
+		if e.AvgPx != nil {
+			m.SetAvgPx(*e.AvgPx, fix44.DecimalScale(*e.AvgPx))
+		}
+		if e.OrdRejReason != nil {
+			m.SetOrdRejReason(*e.OrdRejReason)
+		}
+		if e.Text != nil {
+			m.SetText(*e.Text)
+		}
+		if e.EncodedTextLen != nil {
+			m.SetEncodedTextLen(*e.EncodedTextLen)
+		}
+		if e.EncodedText != nil {
+			m.SetEncodedText(*e.EncodedText)
+		}
+	}
+	return g
+}
@@ -54,6 +54,26 @@ func Route(router RouteOut) (string, string, quickfix.MessageRoute) {
 	return "FIX.4.4", "M", r
 }
 
+//Validate checks m against the FIX 4.4 definition of ListStatusRequest, see fix44.MessageDef.Validate
+func (m ListStatusRequest) Validate() fix44.ValidationErrors {
+	return fix44.MessageDefs["M"].Validate(&m.Body.FieldMap)
+}
+
+//MarshalJSON encodes the ListStatusRequest as JSON with fields keyed by name, see fix44.MarshalMessageJSON
+func (m ListStatusRequest) MarshalJSON() ([]byte, error) {
+	return fix44.MarshalMessageJSON(m.Message)
+}
+
+//UnmarshalJSON builds the ListStatusRequest from JSON produced by MarshalJSON
+func (m *ListStatusRequest) UnmarshalJSON(b []byte) error {
+	msg, err := fix44.UnmarshalMessageJSON(b, "M")
+	if err != nil {
+		return err
+	}
+	*m = FromMessage(msg)
+	return nil
+}
+
 //SetText sets Text, Tag 58
 func (m ListStatusRequest) SetText(v string) {
 	m.Set(field.NewText(v))
@@ -129,3 +149,75 @@ func (m ListStatusRequest) HasEncodedTextLen() bool {
 func (m ListStatusRequest) HasEncodedText() bool {
 	return m.Has(tag.EncodedText)
 }
+
+//SetEncodedTextBytes sets EncodedText, Tag 355, and EncodedTextLen, Tag 354, from v, which may hold any bytes including SOH
+func (m ListStatusRequest) SetEncodedTextBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedTextLen, tag.EncodedText, v)
+}
+
+//GetEncodedTextBytes gets EncodedText, Tag 355, checking its length against EncodedTextLen, Tag 354
+func (m ListStatusRequest) GetEncodedTextBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedTextLen, tag.EncodedText)
+}
+
+//GetEncodedTextDecoded gets EncodedText, Tag 355, decoded to UTF-8 using the MessageEncoding of the Header
+func (m ListStatusRequest) GetEncodedTextDecoded() (string, error) {
+	return fix44.DecodeRawData(m.Header, m, tag.EncodedTextLen, tag.EncodedText)
+}
+
+//Struct is a plain Go representation of the ListStatusRequest body, optional fields are nil when absent
+type Struct struct {
+	Text           *string
+	ListID         string
+	EncodedTextLen *int
+	EncodedText    *string
+}
+
+//Marshal copies the fields present in msg into a Struct
+func Marshal(msg ListStatusRequest) (Struct, quickfix.MessageRejectError) {
+	var s Struct
+	if msg.HasText() {
+		v, err := msg.GetText()
+		if err != nil {
+			return s, err
+		}
+		s.Text = &v
+	}
+	if msg.HasListID() {
+		v, err := msg.GetListID()
+		if err != nil {
+			return s, err
+		}
+		s.ListID = v
+	}
+	if msg.HasEncodedTextLen() {
+		v, err := msg.GetEncodedTextLen()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedTextLen = &v
+	}
+	if msg.HasEncodedText() {
+		v, err := msg.GetEncodedText()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedText = &v
+	}
+	return s, nil
+}
+
+//Unmarshal builds a ListStatusRequest from s
+func Unmarshal(s Struct) ListStatusRequest {
+	m := New(field.NewListID(s.ListID))
+	if s.Text != nil {
+		m.SetText(*s.Text)
+	}
+	if s.EncodedTextLen != nil {
+		m.SetEncodedTextLen(*s.EncodedTextLen)
+	}
+	if s.EncodedText != nil {
+		m.SetEncodedText(*s.EncodedText)
+	}
+	return m
+}
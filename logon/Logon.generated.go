@@ -56,6 +56,26 @@ func Route(router RouteOut) (string, string, quickfix.MessageRoute) {
 	return "FIX.4.4", "A", r
 }
 
+//Validate checks m against the FIX 4.4 definition of Logon, see fix44.MessageDef.Validate
+func (m Logon) Validate() fix44.ValidationErrors {
+	return fix44.MessageDefs["A"].Validate(&m.Body.FieldMap)
+}
+
+//MarshalJSON encodes the Logon as JSON with fields keyed by name, see fix44.MarshalMessageJSON
+func (m Logon) MarshalJSON() ([]byte, error) {
+	return fix44.MarshalMessageJSON(m.Message)
+}
+
+//UnmarshalJSON builds the Logon from JSON produced by MarshalJSON
+func (m *Logon) UnmarshalJSON(b []byte) error {
+	msg, err := fix44.UnmarshalMessageJSON(b, "A")
+	if err != nil {
+		return err
+	}
+	*m = FromMessage(msg)
+	return nil
+}
+
 //SetRawDataLength sets RawDataLength, Tag 95
 func (m Logon) SetRawDataLength(v int) {
 	m.Set(field.NewRawDataLength(v))
@@ -218,6 +238,16 @@ func (m Logon) HasRawData() bool {
 	return m.Has(tag.RawData)
 }
 
+//SetRawDataBytes sets RawData, Tag 96, and RawDataLength, Tag 95, from v, which may hold any bytes including SOH
+func (m Logon) SetRawDataBytes(v []byte) {
+	fix44.SetRawData(m, tag.RawDataLength, tag.RawData, v)
+}
+
+//GetRawDataBytes gets RawData, Tag 96, checking its length against RawDataLength, Tag 95
+func (m Logon) GetRawDataBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.RawDataLength, tag.RawData)
+}
+
 //HasEncryptMethod returns true if EncryptMethod is present, Tag 98
 func (m Logon) HasEncryptMethod() bool {
 	return m.Has(tag.EncryptMethod)
@@ -328,3 +358,180 @@ func (m NoMsgTypesRepeatingGroup) Add() NoMsgTypes {
 func (m NoMsgTypesRepeatingGroup) Get(i int) NoMsgTypes {
 	return NoMsgTypes{m.RepeatingGroup.Get(i)}
 }
+
+//Struct is a plain Go representation of the Logon body, optional fields are nil when absent
+type Struct struct {
+	RawDataLength         *int
+	RawData               *string
+	EncryptMethod         enum.EncryptMethod
+	HeartBtInt            int
+	ResetSeqNumFlag       *bool
+	MaxMessageSize        *int
+	NoMsgTypes            []NoMsgTypesStruct
+	TestMessageIndicator  *bool
+	Username              *string
+	Password              *string
+	NextExpectedMsgSeqNum *int
+}
+
+//Marshal copies the fields present in msg into a Struct
+func Marshal(msg Logon) (Struct, quickfix.MessageRejectError) {
+	var s Struct
+	if msg.HasRawDataLength() {
+		v, err := msg.GetRawDataLength()
+		if err != nil {
+			return s, err
+		}
+		s.RawDataLength = &v
+	}
+	if msg.HasRawData() {
+		v, err := msg.GetRawData()
+		if err != nil {
+			return s, err
+		}
+		s.RawData = &v
+	}
+	if msg.HasEncryptMethod() {
+		v, err := msg.GetEncryptMethod()
+		if err != nil {
+			return s, err
+		}
+		s.EncryptMethod = v
+	}
+	if msg.HasHeartBtInt() {
+		v, err := msg.GetHeartBtInt()
+		if err != nil {
+			return s, err
+		}
+		s.HeartBtInt = v
+	}
+	if msg.HasResetSeqNumFlag() {
+		v, err := msg.GetResetSeqNumFlag()
+		if err != nil {
+			return s, err
+		}
+		s.ResetSeqNumFlag = &v
+	}
+	if msg.HasMaxMessageSize() {
+		v, err := msg.GetMaxMessageSize()
+		if err != nil {
+			return s, err
+		}
+		s.MaxMessageSize = &v
+	}
+	if msg.HasNoMsgTypes() {
+		g, err := msg.GetNoMsgTypes()
+		if err != nil {
+			return s, err
+		}
+		if s.NoMsgTypes, err = MarshalNoMsgTypes(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasTestMessageIndicator() {
+		v, err := msg.GetTestMessageIndicator()
+		if err != nil {
+			return s, err
+		}
+		s.TestMessageIndicator = &v
+	}
+	if msg.HasUsername() {
+		v, err := msg.GetUsername()
+		if err != nil {
+			return s, err
+		}
+		s.Username = &v
+	}
+	if msg.HasPassword() {
+		v, err := msg.GetPassword()
+		if err != nil {
+			return s, err
+		}
+		s.Password = &v
+	}
+	if msg.HasNextExpectedMsgSeqNum() {
+		v, err := msg.GetNextExpectedMsgSeqNum()
+		if err != nil {
+			return s, err
+		}
+		s.NextExpectedMsgSeqNum = &v
+	}
+	return s, nil
+}
+
+//Unmarshal builds a Logon from s
+func Unmarshal(s Struct) Logon {
+	m := New(field.NewEncryptMethod(s.EncryptMethod), field.NewHeartBtInt(s.HeartBtInt))
+	if s.RawDataLength != nil {
+		m.SetRawDataLength(*s.RawDataLength)
+	}
+	if s.RawData != nil {
+		m.SetRawData(*s.RawData)
+	}
+	if s.ResetSeqNumFlag != nil {
+		m.SetResetSeqNumFlag(*s.ResetSeqNumFlag)
+	}
+	if s.MaxMessageSize != nil {
+		m.SetMaxMessageSize(*s.MaxMessageSize)
+	}
+	if s.NoMsgTypes != nil {
+		m.SetNoMsgTypes(UnmarshalNoMsgTypes(s.NoMsgTypes))
+	}
+	if s.TestMessageIndicator != nil {
+		m.SetTestMessageIndicator(*s.TestMessageIndicator)
+	}
+	if s.Username != nil {
+		m.SetUsername(*s.Username)
+	}
+	if s.Password != nil {
+		m.SetPassword(*s.Password)
+	}
+	if s.NextExpectedMsgSeqNum != nil {
+		m.SetNextExpectedMsgSeqNum(*s.NextExpectedMsgSeqNum)
+	}
+	return m
+}
+
+//NoMsgTypesStruct is a plain Go representation of a NoMsgTypes group element, optional fields are nil when absent
+type NoMsgTypesStruct struct {
+	RefMsgType   *string
+	MsgDirection *enum.MsgDirection
+}
+
+//MarshalNoMsgTypes copies the elements of g into a slice of NoMsgTypesStruct
+func MarshalNoMsgTypes(g NoMsgTypesRepeatingGroup) ([]NoMsgTypesStruct, quickfix.MessageRejectError) {
+	s := make([]NoMsgTypesStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasRefMsgType() {
+			v, err := m.GetRefMsgType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].RefMsgType = &v
+		}
+		if m.HasMsgDirection() {
+			v, err := m.GetMsgDirection()
+			if err != nil {
+				return nil, err
+			}
+			s[i].MsgDirection = &v
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoMsgTypes builds a NoMsgTypesRepeatingGroup from s
+func UnmarshalNoMsgTypes(s []NoMsgTypesStruct) NoMsgTypesRepeatingGroup {
+	g := NewNoMsgTypesRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.RefMsgType != nil {
+			m.SetRefMsgType(*e.RefMsgType)
+		}
+		if e.MsgDirection != nil {
+			m.SetMsgDirection(*e.MsgDirection)
+		}
+	}
+	return g
+}
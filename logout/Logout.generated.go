@@ -53,6 +53,26 @@ func Route(router RouteOut) (string, string, quickfix.MessageRoute) {
 	return "FIX.4.4", "5", r
 }
 
+//Validate checks m against the FIX 4.4 definition of Logout, see fix44.MessageDef.Validate
+func (m Logout) Validate() fix44.ValidationErrors {
+	return fix44.MessageDefs["5"].Validate(&m.Body.FieldMap)
+}
+
+//MarshalJSON encodes the Logout as JSON with fields keyed by name, see fix44.MarshalMessageJSON
+func (m Logout) MarshalJSON() ([]byte, error) {
+	return fix44.MarshalMessageJSON(m.Message)
+}
+
+//UnmarshalJSON builds the Logout from JSON produced by MarshalJSON
+func (m *Logout) UnmarshalJSON(b []byte) error {
+	msg, err := fix44.UnmarshalMessageJSON(b, "5")
+	if err != nil {
+		return err
+	}
+	*m = FromMessage(msg)
+	return nil
+}
+
 //SetText sets Text, Tag 58
 func (m Logout) SetText(v string) {
 	m.Set(field.NewText(v))
@@ -109,3 +129,67 @@ func (m Logout) HasEncodedTextLen() bool {
 func (m Logout) HasEncodedText() bool {
 	return m.Has(tag.EncodedText)
 }
+
+//SetEncodedTextBytes sets EncodedText, Tag 355, and EncodedTextLen, Tag 354, from v, which may hold any bytes including SOH
+func (m Logout) SetEncodedTextBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedTextLen, tag.EncodedText, v)
+}
+
+//GetEncodedTextBytes gets EncodedText, Tag 355, checking its length against EncodedTextLen, Tag 354
+func (m Logout) GetEncodedTextBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedTextLen, tag.EncodedText)
+}
+
+//GetEncodedTextDecoded gets EncodedText, Tag 355, decoded to UTF-8 using the MessageEncoding of the Header
+func (m Logout) GetEncodedTextDecoded() (string, error) {
+	return fix44.DecodeRawData(m.Header, m, tag.EncodedTextLen, tag.EncodedText)
+}
+
+//Struct is a plain Go representation of the Logout body, optional fields are nil when absent
+type Struct struct {
+	Text           *string
+	EncodedTextLen *int
+	EncodedText    *string
+}
+
+//Marshal copies the fields present in msg into a Struct
+func Marshal(msg Logout) (Struct, quickfix.MessageRejectError) {
+	var s Struct
+	if msg.HasText() {
+		v, err := msg.GetText()
+		if err != nil {
+			return s, err
+		}
+		s.Text = &v
+	}
+	if msg.HasEncodedTextLen() {
+		v, err := msg.GetEncodedTextLen()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedTextLen = &v
+	}
+	if msg.HasEncodedText() {
+		v, err := msg.GetEncodedText()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedText = &v
+	}
+	return s, nil
+}
+
+//Unmarshal builds a Logout from s
+func Unmarshal(s Struct) Logout {
+	m := New()
+	if s.Text != nil {
+		m.SetText(*s.Text)
+	}
+	if s.EncodedTextLen != nil {
+		m.SetEncodedTextLen(*s.EncodedTextLen)
+	}
+	if s.EncodedText != nil {
+		m.SetEncodedText(*s.EncodedText)
+	}
+	return m
+}
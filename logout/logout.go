@@ -0,0 +1,18 @@
+package logout
+
+import (
+	"fmt"
+)
+
+//NewWithText returns a Logout carrying text as the reason for the logout
+func NewWithText(text string) Logout {
+	m := New()
+	m.SetText(text)
+	return m
+}
+
+//NewSeqNumTooLow returns the Logout to send before disconnecting when a message arrives with a MsgSeqNum lower
+//than expected and without PossDupFlag = Y
+func NewSeqNumTooLow(expected, received int) Logout {
+	return NewWithText(fmt.Sprintf("MsgSeqNum too low, expecting %d but received %d", expected, received))
+}
@@ -0,0 +1,16 @@
+package logout
+
+import "testing"
+
+func TestNewWithText(t *testing.T) {
+	if v, err := NewWithText("bye").GetText(); err != nil || v != "bye" {
+		t.Errorf("Text = %q, %v, want bye", v, err)
+	}
+}
+
+func TestNewSeqNumTooLow(t *testing.T) {
+	want := "MsgSeqNum too low, expecting 10 but received 7"
+	if v, err := NewSeqNumTooLow(10, 7).GetText(); err != nil || v != want {
+		t.Errorf("Text = %q, %v, want %q", v, err, want)
+	}
+}
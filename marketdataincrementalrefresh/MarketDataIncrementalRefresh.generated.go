@@ -8,6 +8,7 @@ import (
 	"github.com/terracefi/enum"
 	"github.com/terracefi/field"
 	"github.com/terracefi/fix44"
+	"github.com/terracefi/fix44/components"
 	"github.com/terracefi/quickfix"
 	"github.com/terracefi/tag"
 )
@@ -58,6 +59,26 @@ func Route(router RouteOut) (string, string, quickfix.MessageRoute) {
 	return "FIX.4.4", "X", r
 }
 
+//Validate checks m against the FIX 4.4 definition of MarketDataIncrementalRefresh, see fix44.MessageDef.Validate
+func (m MarketDataIncrementalRefresh) Validate() fix44.ValidationErrors {
+	return fix44.MessageDefs["X"].Validate(&m.Body.FieldMap)
+}
+
+//MarshalJSON encodes the MarketDataIncrementalRefresh as JSON with fields keyed by name, see fix44.MarshalMessageJSON
+func (m MarketDataIncrementalRefresh) MarshalJSON() ([]byte, error) {
+	return fix44.MarshalMessageJSON(m.Message)
+}
+
+//UnmarshalJSON builds the MarketDataIncrementalRefresh from JSON produced by MarshalJSON
+func (m *MarketDataIncrementalRefresh) UnmarshalJSON(b []byte) error {
+	msg, err := fix44.UnmarshalMessageJSON(b, "X")
+	if err != nil {
+		return err
+	}
+	*m = FromMessage(msg)
+	return nil
+}
+
 //SetMDReqID sets MDReqID, Tag 262
 func (m MarketDataIncrementalRefresh) SetMDReqID(v string) {
 	m.Set(field.NewMDReqID(v))
@@ -557,6 +578,11 @@ func (m NoMDEntries) SetEncodedText(v string) {
 	m.Set(field.NewEncodedText(v))
 }
 
+//SetRptSeq sets RptSeq, Tag 83
+func (m NoMDEntries) SetRptSeq(v int) {
+	m.Set(field.NewRptSeq(v))
+}
+
 //GetMDUpdateAction gets MDUpdateAction, Tag 279
 func (m NoMDEntries) GetMDUpdateAction() (v enum.MDUpdateAction, err quickfix.MessageRejectError) {
 	var f field.MDUpdateActionField
@@ -1305,6 +1331,15 @@ func (m NoMDEntries) GetEncodedText() (v string, err quickfix.MessageRejectError
 	return
 }
 
+//GetRptSeq gets RptSeq, Tag 83
+func (m NoMDEntries) GetRptSeq() (v int, err quickfix.MessageRejectError) {
+	var f field.RptSeqField
+	if err = m.Get(&f); err == nil {
+		v = f.Value()
+	}
+	return
+}
+
 //HasMDUpdateAction returns true if MDUpdateAction is present, Tag 279
 func (m NoMDEntries) HasMDUpdateAction() bool {
 	return m.Has(tag.MDUpdateAction)
@@ -1490,6 +1525,21 @@ func (m NoMDEntries) HasEncodedIssuer() bool {
 	return m.Has(tag.EncodedIssuer)
 }
 
+//SetEncodedIssuerBytes sets EncodedIssuer, Tag 349, and EncodedIssuerLen, Tag 348, from v, which may hold any bytes including SOH
+func (m NoMDEntries) SetEncodedIssuerBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedIssuerLen, tag.EncodedIssuer, v)
+}
+
+//GetEncodedIssuerBytes gets EncodedIssuer, Tag 349, checking its length against EncodedIssuerLen, Tag 348
+func (m NoMDEntries) GetEncodedIssuerBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedIssuerLen, tag.EncodedIssuer)
+}
+
+//GetEncodedIssuerDecoded gets EncodedIssuer, Tag 349, decoded to UTF-8 using the MessageEncoding of h, the Header of the message holding it
+func (m NoMDEntries) GetEncodedIssuerDecoded(h fix44.Header) (string, error) {
+	return fix44.DecodeRawData(h, m, tag.EncodedIssuerLen, tag.EncodedIssuer)
+}
+
 //HasSecurityDesc returns true if SecurityDesc is present, Tag 107
 func (m NoMDEntries) HasSecurityDesc() bool {
 	return m.Has(tag.SecurityDesc)
@@ -1505,6 +1555,21 @@ func (m NoMDEntries) HasEncodedSecurityDesc() bool {
 	return m.Has(tag.EncodedSecurityDesc)
 }
 
+//SetEncodedSecurityDescBytes sets EncodedSecurityDesc, Tag 351, and EncodedSecurityDescLen, Tag 350, from v, which may hold any bytes including SOH
+func (m NoMDEntries) SetEncodedSecurityDescBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedSecurityDescLen, tag.EncodedSecurityDesc, v)
+}
+
+//GetEncodedSecurityDescBytes gets EncodedSecurityDesc, Tag 351, checking its length against EncodedSecurityDescLen, Tag 350
+func (m NoMDEntries) GetEncodedSecurityDescBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedSecurityDescLen, tag.EncodedSecurityDesc)
+}
+
+//GetEncodedSecurityDescDecoded gets EncodedSecurityDesc, Tag 351, decoded to UTF-8 using the MessageEncoding of h, the Header of the message holding it
+func (m NoMDEntries) GetEncodedSecurityDescDecoded(h fix44.Header) (string, error) {
+	return fix44.DecodeRawData(h, m, tag.EncodedSecurityDescLen, tag.EncodedSecurityDesc)
+}
+
 //HasPool returns true if Pool is present, Tag 691
 func (m NoMDEntries) HasPool() bool {
 	return m.Has(tag.Pool)
@@ -1725,175 +1790,63 @@ func (m NoMDEntries) HasEncodedText() bool {
 	return m.Has(tag.EncodedText)
 }
 
-//NoSecurityAltID is a repeating group element, Tag 454
-type NoSecurityAltID struct {
-	*quickfix.Group
+//SetEncodedTextBytes sets EncodedText, Tag 355, and EncodedTextLen, Tag 354, from v, which may hold any bytes including SOH
+func (m NoMDEntries) SetEncodedTextBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedTextLen, tag.EncodedText, v)
 }
 
-//SetSecurityAltID sets SecurityAltID, Tag 455
-func (m NoSecurityAltID) SetSecurityAltID(v string) {
-	m.Set(field.NewSecurityAltID(v))
+//GetEncodedTextBytes gets EncodedText, Tag 355, checking its length against EncodedTextLen, Tag 354
+func (m NoMDEntries) GetEncodedTextBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedTextLen, tag.EncodedText)
 }
 
-//SetSecurityAltIDSource sets SecurityAltIDSource, Tag 456
-func (m NoSecurityAltID) SetSecurityAltIDSource(v string) {
-	m.Set(field.NewSecurityAltIDSource(v))
+//GetEncodedTextDecoded gets EncodedText, Tag 355, decoded to UTF-8 using the MessageEncoding of h, the Header of the message holding it
+func (m NoMDEntries) GetEncodedTextDecoded(h fix44.Header) (string, error) {
+	return fix44.DecodeRawData(h, m, tag.EncodedTextLen, tag.EncodedText)
 }
 
-//GetSecurityAltID gets SecurityAltID, Tag 455
-func (m NoSecurityAltID) GetSecurityAltID() (v string, err quickfix.MessageRejectError) {
-	var f field.SecurityAltIDField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
+//HasRptSeq returns true if RptSeq is present, Tag 83
+func (m NoMDEntries) HasRptSeq() bool {
+	return m.Has(tag.RptSeq)
 }
 
-//GetSecurityAltIDSource gets SecurityAltIDSource, Tag 456
-func (m NoSecurityAltID) GetSecurityAltIDSource() (v string, err quickfix.MessageRejectError) {
-	var f field.SecurityAltIDSourceField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
+//GetInstrument gets the Instrument component
+func (m NoMDEntries) GetInstrument() components.Instrument {
+	return components.Instrument{&m.Group.FieldMap}
 }
 
-//HasSecurityAltID returns true if SecurityAltID is present, Tag 455
-func (m NoSecurityAltID) HasSecurityAltID() bool {
-	return m.Has(tag.SecurityAltID)
+//SetInstrument sets the Instrument component, copying the fields present in c
+func (m NoMDEntries) SetInstrument(c components.Instrument) quickfix.MessageRejectError {
+	return c.CopyInto(&m.Group.FieldMap)
 }
 
-//HasSecurityAltIDSource returns true if SecurityAltIDSource is present, Tag 456
-func (m NoSecurityAltID) HasSecurityAltIDSource() bool {
-	return m.Has(tag.SecurityAltIDSource)
-}
+//NoSecurityAltID is a repeating group element, Tag 454
+type NoSecurityAltID = components.NoSecurityAltID
 
 //NoSecurityAltIDRepeatingGroup is a repeating group, Tag 454
-type NoSecurityAltIDRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
+type NoSecurityAltIDRepeatingGroup = components.NoSecurityAltIDRepeatingGroup
 
 //NewNoSecurityAltIDRepeatingGroup returns an initialized, NoSecurityAltIDRepeatingGroup
 func NewNoSecurityAltIDRepeatingGroup() NoSecurityAltIDRepeatingGroup {
-	return NoSecurityAltIDRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoSecurityAltID,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.SecurityAltID), quickfix.GroupElement(tag.SecurityAltIDSource)})}
-}
-
-//Add create and append a new NoSecurityAltID to this group
-func (m NoSecurityAltIDRepeatingGroup) Add() NoSecurityAltID {
-	g := m.RepeatingGroup.Add()
-	return NoSecurityAltID{g}
+	return components.NewNoSecurityAltIDRepeatingGroup()
 }
 
-//Get returns the ith NoSecurityAltID in the NoSecurityAltIDRepeatinGroup
-func (m NoSecurityAltIDRepeatingGroup) Get(i int) NoSecurityAltID {
-	return NoSecurityAltID{m.RepeatingGroup.Get(i)}
-}
+//NoSecurityAltIDStruct is a plain Go representation of a NoSecurityAltID group element
+type NoSecurityAltIDStruct = components.NoSecurityAltIDStruct
 
 //NoEvents is a repeating group element, Tag 864
-type NoEvents struct {
-	*quickfix.Group
-}
-
-//SetEventType sets EventType, Tag 865
-func (m NoEvents) SetEventType(v enum.EventType) {
-	m.Set(field.NewEventType(v))
-}
-
-//SetEventDate sets EventDate, Tag 866
-func (m NoEvents) SetEventDate(v string) {
-	m.Set(field.NewEventDate(v))
-}
-
-//SetEventPx sets EventPx, Tag 867
-func (m NoEvents) SetEventPx(value decimal.Decimal, scale int32) {
-	m.Set(field.NewEventPx(value, scale))
-}
-
-//SetEventText sets EventText, Tag 868
-func (m NoEvents) SetEventText(v string) {
-	m.Set(field.NewEventText(v))
-}
-
-//GetEventType gets EventType, Tag 865
-func (m NoEvents) GetEventType() (v enum.EventType, err quickfix.MessageRejectError) {
-	var f field.EventTypeField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetEventDate gets EventDate, Tag 866
-func (m NoEvents) GetEventDate() (v string, err quickfix.MessageRejectError) {
-	var f field.EventDateField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetEventPx gets EventPx, Tag 867
-func (m NoEvents) GetEventPx() (v decimal.Decimal, err quickfix.MessageRejectError) {
-	var f field.EventPxField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetEventText gets EventText, Tag 868
-func (m NoEvents) GetEventText() (v string, err quickfix.MessageRejectError) {
-	var f field.EventTextField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//HasEventType returns true if EventType is present, Tag 865
-func (m NoEvents) HasEventType() bool {
-	return m.Has(tag.EventType)
-}
-
-//HasEventDate returns true if EventDate is present, Tag 866
-func (m NoEvents) HasEventDate() bool {
-	return m.Has(tag.EventDate)
-}
-
-//HasEventPx returns true if EventPx is present, Tag 867
-func (m NoEvents) HasEventPx() bool {
-	return m.Has(tag.EventPx)
-}
-
-//HasEventText returns true if EventText is present, Tag 868
-func (m NoEvents) HasEventText() bool {
-	return m.Has(tag.EventText)
-}
+type NoEvents = components.NoEvents
 
 //NoEventsRepeatingGroup is a repeating group, Tag 864
-type NoEventsRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
+type NoEventsRepeatingGroup = components.NoEventsRepeatingGroup
 
 //NewNoEventsRepeatingGroup returns an initialized, NoEventsRepeatingGroup
 func NewNoEventsRepeatingGroup() NoEventsRepeatingGroup {
-	return NoEventsRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoEvents,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.EventType), quickfix.GroupElement(tag.EventDate), quickfix.GroupElement(tag.EventPx), quickfix.GroupElement(tag.EventText)})}
-}
-
-//Add create and append a new NoEvents to this group
-func (m NoEventsRepeatingGroup) Add() NoEvents {
-	g := m.RepeatingGroup.Add()
-	return NoEvents{g}
+	return components.NewNoEventsRepeatingGroup()
 }
 
-//Get returns the ith NoEvents in the NoEventsRepeatinGroup
-func (m NoEventsRepeatingGroup) Get(i int) NoEvents {
-	return NoEvents{m.RepeatingGroup.Get(i)}
-}
+//NoEventsStruct is a plain Go representation of a NoEvents group element
+type NoEventsStruct = components.NoEventsStruct
 
 //NoUnderlyings is a repeating group element, Tag 711
 type NoUnderlyings struct {
@@ -2700,6 +2653,21 @@ func (m NoUnderlyings) HasEncodedUnderlyingIssuer() bool {
 	return m.Has(tag.EncodedUnderlyingIssuer)
 }
 
+//SetEncodedUnderlyingIssuerBytes sets EncodedUnderlyingIssuer, Tag 363, and EncodedUnderlyingIssuerLen, Tag 362, from v, which may hold any bytes including SOH
+func (m NoUnderlyings) SetEncodedUnderlyingIssuerBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedUnderlyingIssuerLen, tag.EncodedUnderlyingIssuer, v)
+}
+
+//GetEncodedUnderlyingIssuerBytes gets EncodedUnderlyingIssuer, Tag 363, checking its length against EncodedUnderlyingIssuerLen, Tag 362
+func (m NoUnderlyings) GetEncodedUnderlyingIssuerBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedUnderlyingIssuerLen, tag.EncodedUnderlyingIssuer)
+}
+
+//GetEncodedUnderlyingIssuerDecoded gets EncodedUnderlyingIssuer, Tag 363, decoded to UTF-8 using the MessageEncoding of h, the Header of the message holding it
+func (m NoUnderlyings) GetEncodedUnderlyingIssuerDecoded(h fix44.Header) (string, error) {
+	return fix44.DecodeRawData(h, m, tag.EncodedUnderlyingIssuerLen, tag.EncodedUnderlyingIssuer)
+}
+
 //HasUnderlyingSecurityDesc returns true if UnderlyingSecurityDesc is present, Tag 307
 func (m NoUnderlyings) HasUnderlyingSecurityDesc() bool {
 	return m.Has(tag.UnderlyingSecurityDesc)
@@ -2715,6 +2683,21 @@ func (m NoUnderlyings) HasEncodedUnderlyingSecurityDesc() bool {
 	return m.Has(tag.EncodedUnderlyingSecurityDesc)
 }
 
+//SetEncodedUnderlyingSecurityDescBytes sets EncodedUnderlyingSecurityDesc, Tag 365, and EncodedUnderlyingSecurityDescLen, Tag 364, from v, which may hold any bytes including SOH
+func (m NoUnderlyings) SetEncodedUnderlyingSecurityDescBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedUnderlyingSecurityDescLen, tag.EncodedUnderlyingSecurityDesc, v)
+}
+
+//GetEncodedUnderlyingSecurityDescBytes gets EncodedUnderlyingSecurityDesc, Tag 365, checking its length against EncodedUnderlyingSecurityDescLen, Tag 364
+func (m NoUnderlyings) GetEncodedUnderlyingSecurityDescBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedUnderlyingSecurityDescLen, tag.EncodedUnderlyingSecurityDesc)
+}
+
+//GetEncodedUnderlyingSecurityDescDecoded gets EncodedUnderlyingSecurityDesc, Tag 365, decoded to UTF-8 using the MessageEncoding of h, the Header of the message holding it
+func (m NoUnderlyings) GetEncodedUnderlyingSecurityDescDecoded(h fix44.Header) (string, error) {
+	return fix44.DecodeRawData(h, m, tag.EncodedUnderlyingSecurityDescLen, tag.EncodedUnderlyingSecurityDesc)
+}
+
 //HasUnderlyingCPProgram returns true if UnderlyingCPProgram is present, Tag 877
 func (m NoUnderlyings) HasUnderlyingCPProgram() bool {
 	return m.Has(tag.UnderlyingCPProgram)
@@ -2770,137 +2753,43 @@ func (m NoUnderlyings) HasNoUnderlyingStips() bool {
 	return m.Has(tag.NoUnderlyingStips)
 }
 
-//NoUnderlyingSecurityAltID is a repeating group element, Tag 457
-type NoUnderlyingSecurityAltID struct {
-	*quickfix.Group
+//GetUnderlyingInstrument gets the UnderlyingInstrument component
+func (m NoUnderlyings) GetUnderlyingInstrument() components.UnderlyingInstrument {
+	return components.UnderlyingInstrument{&m.Group.FieldMap}
 }
 
-//SetUnderlyingSecurityAltID sets UnderlyingSecurityAltID, Tag 458
-func (m NoUnderlyingSecurityAltID) SetUnderlyingSecurityAltID(v string) {
-	m.Set(field.NewUnderlyingSecurityAltID(v))
+//SetUnderlyingInstrument sets the UnderlyingInstrument component, copying the fields present in c
+func (m NoUnderlyings) SetUnderlyingInstrument(c components.UnderlyingInstrument) quickfix.MessageRejectError {
+	return c.CopyInto(&m.Group.FieldMap)
 }
 
-//SetUnderlyingSecurityAltIDSource sets UnderlyingSecurityAltIDSource, Tag 459
-func (m NoUnderlyingSecurityAltID) SetUnderlyingSecurityAltIDSource(v string) {
-	m.Set(field.NewUnderlyingSecurityAltIDSource(v))
-}
-
-//GetUnderlyingSecurityAltID gets UnderlyingSecurityAltID, Tag 458
-func (m NoUnderlyingSecurityAltID) GetUnderlyingSecurityAltID() (v string, err quickfix.MessageRejectError) {
-	var f field.UnderlyingSecurityAltIDField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetUnderlyingSecurityAltIDSource gets UnderlyingSecurityAltIDSource, Tag 459
-func (m NoUnderlyingSecurityAltID) GetUnderlyingSecurityAltIDSource() (v string, err quickfix.MessageRejectError) {
-	var f field.UnderlyingSecurityAltIDSourceField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//HasUnderlyingSecurityAltID returns true if UnderlyingSecurityAltID is present, Tag 458
-func (m NoUnderlyingSecurityAltID) HasUnderlyingSecurityAltID() bool {
-	return m.Has(tag.UnderlyingSecurityAltID)
-}
-
-//HasUnderlyingSecurityAltIDSource returns true if UnderlyingSecurityAltIDSource is present, Tag 459
-func (m NoUnderlyingSecurityAltID) HasUnderlyingSecurityAltIDSource() bool {
-	return m.Has(tag.UnderlyingSecurityAltIDSource)
-}
+//NoUnderlyingSecurityAltID is a repeating group element, Tag 457
+type NoUnderlyingSecurityAltID = components.NoUnderlyingSecurityAltID
 
 //NoUnderlyingSecurityAltIDRepeatingGroup is a repeating group, Tag 457
-type NoUnderlyingSecurityAltIDRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
+type NoUnderlyingSecurityAltIDRepeatingGroup = components.NoUnderlyingSecurityAltIDRepeatingGroup
 
 //NewNoUnderlyingSecurityAltIDRepeatingGroup returns an initialized, NoUnderlyingSecurityAltIDRepeatingGroup
 func NewNoUnderlyingSecurityAltIDRepeatingGroup() NoUnderlyingSecurityAltIDRepeatingGroup {
-	return NoUnderlyingSecurityAltIDRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoUnderlyingSecurityAltID,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.UnderlyingSecurityAltID), quickfix.GroupElement(tag.UnderlyingSecurityAltIDSource)})}
+	return components.NewNoUnderlyingSecurityAltIDRepeatingGroup()
 }
 
-//Add create and append a new NoUnderlyingSecurityAltID to this group
-func (m NoUnderlyingSecurityAltIDRepeatingGroup) Add() NoUnderlyingSecurityAltID {
-	g := m.RepeatingGroup.Add()
-	return NoUnderlyingSecurityAltID{g}
-}
-
-//Get returns the ith NoUnderlyingSecurityAltID in the NoUnderlyingSecurityAltIDRepeatinGroup
-func (m NoUnderlyingSecurityAltIDRepeatingGroup) Get(i int) NoUnderlyingSecurityAltID {
-	return NoUnderlyingSecurityAltID{m.RepeatingGroup.Get(i)}
-}
+//NoUnderlyingSecurityAltIDStruct is a plain Go representation of a NoUnderlyingSecurityAltID group element
+type NoUnderlyingSecurityAltIDStruct = components.NoUnderlyingSecurityAltIDStruct
 
 //NoUnderlyingStips is a repeating group element, Tag 887
-type NoUnderlyingStips struct {
-	*quickfix.Group
-}
-
-//SetUnderlyingStipType sets UnderlyingStipType, Tag 888
-func (m NoUnderlyingStips) SetUnderlyingStipType(v string) {
-	m.Set(field.NewUnderlyingStipType(v))
-}
-
-//SetUnderlyingStipValue sets UnderlyingStipValue, Tag 889
-func (m NoUnderlyingStips) SetUnderlyingStipValue(v string) {
-	m.Set(field.NewUnderlyingStipValue(v))
-}
-
-//GetUnderlyingStipType gets UnderlyingStipType, Tag 888
-func (m NoUnderlyingStips) GetUnderlyingStipType() (v string, err quickfix.MessageRejectError) {
-	var f field.UnderlyingStipTypeField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetUnderlyingStipValue gets UnderlyingStipValue, Tag 889
-func (m NoUnderlyingStips) GetUnderlyingStipValue() (v string, err quickfix.MessageRejectError) {
-	var f field.UnderlyingStipValueField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//HasUnderlyingStipType returns true if UnderlyingStipType is present, Tag 888
-func (m NoUnderlyingStips) HasUnderlyingStipType() bool {
-	return m.Has(tag.UnderlyingStipType)
-}
-
-//HasUnderlyingStipValue returns true if UnderlyingStipValue is present, Tag 889
-func (m NoUnderlyingStips) HasUnderlyingStipValue() bool {
-	return m.Has(tag.UnderlyingStipValue)
-}
+type NoUnderlyingStips = components.NoUnderlyingStips
 
 //NoUnderlyingStipsRepeatingGroup is a repeating group, Tag 887
-type NoUnderlyingStipsRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
+type NoUnderlyingStipsRepeatingGroup = components.NoUnderlyingStipsRepeatingGroup
 
 //NewNoUnderlyingStipsRepeatingGroup returns an initialized, NoUnderlyingStipsRepeatingGroup
 func NewNoUnderlyingStipsRepeatingGroup() NoUnderlyingStipsRepeatingGroup {
-	return NoUnderlyingStipsRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoUnderlyingStips,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.UnderlyingStipType), quickfix.GroupElement(tag.UnderlyingStipValue)})}
+	return components.NewNoUnderlyingStipsRepeatingGroup()
 }
 
-//Add create and append a new NoUnderlyingStips to this group
-func (m NoUnderlyingStipsRepeatingGroup) Add() NoUnderlyingStips {
-	g := m.RepeatingGroup.Add()
-	return NoUnderlyingStips{g}
-}
-
-//Get returns the ith NoUnderlyingStips in the NoUnderlyingStipsRepeatinGroup
-func (m NoUnderlyingStipsRepeatingGroup) Get(i int) NoUnderlyingStips {
-	return NoUnderlyingStips{m.RepeatingGroup.Get(i)}
-}
+//NoUnderlyingStipsStruct is a plain Go representation of a NoUnderlyingStips group element
+type NoUnderlyingStipsStruct = components.NoUnderlyingStipsStruct
 
 //NoUnderlyingsRepeatingGroup is a repeating group, Tag 711
 type NoUnderlyingsRepeatingGroup struct {
@@ -3676,6 +3565,21 @@ func (m NoLegs) HasEncodedLegIssuer() bool {
 	return m.Has(tag.EncodedLegIssuer)
 }
 
+//SetEncodedLegIssuerBytes sets EncodedLegIssuer, Tag 619, and EncodedLegIssuerLen, Tag 618, from v, which may hold any bytes including SOH
+func (m NoLegs) SetEncodedLegIssuerBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedLegIssuerLen, tag.EncodedLegIssuer, v)
+}
+
+//GetEncodedLegIssuerBytes gets EncodedLegIssuer, Tag 619, checking its length against EncodedLegIssuerLen, Tag 618
+func (m NoLegs) GetEncodedLegIssuerBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedLegIssuerLen, tag.EncodedLegIssuer)
+}
+
+//GetEncodedLegIssuerDecoded gets EncodedLegIssuer, Tag 619, decoded to UTF-8 using the MessageEncoding of h, the Header of the message holding it
+func (m NoLegs) GetEncodedLegIssuerDecoded(h fix44.Header) (string, error) {
+	return fix44.DecodeRawData(h, m, tag.EncodedLegIssuerLen, tag.EncodedLegIssuer)
+}
+
 //HasLegSecurityDesc returns true if LegSecurityDesc is present, Tag 620
 func (m NoLegs) HasLegSecurityDesc() bool {
 	return m.Has(tag.LegSecurityDesc)
@@ -3691,6 +3595,21 @@ func (m NoLegs) HasEncodedLegSecurityDesc() bool {
 	return m.Has(tag.EncodedLegSecurityDesc)
 }
 
+//SetEncodedLegSecurityDescBytes sets EncodedLegSecurityDesc, Tag 622, and EncodedLegSecurityDescLen, Tag 621, from v, which may hold any bytes including SOH
+func (m NoLegs) SetEncodedLegSecurityDescBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedLegSecurityDescLen, tag.EncodedLegSecurityDesc, v)
+}
+
+//GetEncodedLegSecurityDescBytes gets EncodedLegSecurityDesc, Tag 622, checking its length against EncodedLegSecurityDescLen, Tag 621
+func (m NoLegs) GetEncodedLegSecurityDescBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedLegSecurityDescLen, tag.EncodedLegSecurityDesc)
+}
+
+//GetEncodedLegSecurityDescDecoded gets EncodedLegSecurityDesc, Tag 622, decoded to UTF-8 using the MessageEncoding of h, the Header of the message holding it
+func (m NoLegs) GetEncodedLegSecurityDescDecoded(h fix44.Header) (string, error) {
+	return fix44.DecodeRawData(h, m, tag.EncodedLegSecurityDescLen, tag.EncodedLegSecurityDesc)
+}
+
 //HasLegRatioQty returns true if LegRatioQty is present, Tag 623
 func (m NoLegs) HasLegRatioQty() bool {
 	return m.Has(tag.LegRatioQty)
@@ -3726,71 +3645,29 @@ func (m NoLegs) HasLegInterestAccrualDate() bool {
 	return m.Has(tag.LegInterestAccrualDate)
 }
 
-//NoLegSecurityAltID is a repeating group element, Tag 604
-type NoLegSecurityAltID struct {
-	*quickfix.Group
-}
-
-//SetLegSecurityAltID sets LegSecurityAltID, Tag 605
-func (m NoLegSecurityAltID) SetLegSecurityAltID(v string) {
-	m.Set(field.NewLegSecurityAltID(v))
+//GetInstrumentLeg gets the InstrumentLeg component
+func (m NoLegs) GetInstrumentLeg() components.InstrumentLeg {
+	return components.InstrumentLeg{&m.Group.FieldMap}
 }
 
-//SetLegSecurityAltIDSource sets LegSecurityAltIDSource, Tag 606
-func (m NoLegSecurityAltID) SetLegSecurityAltIDSource(v string) {
-	m.Set(field.NewLegSecurityAltIDSource(v))
+//SetInstrumentLeg sets the InstrumentLeg component, copying the fields present in c
+func (m NoLegs) SetInstrumentLeg(c components.InstrumentLeg) quickfix.MessageRejectError {
+	return c.CopyInto(&m.Group.FieldMap)
 }
 
-//GetLegSecurityAltID gets LegSecurityAltID, Tag 605
-func (m NoLegSecurityAltID) GetLegSecurityAltID() (v string, err quickfix.MessageRejectError) {
-	var f field.LegSecurityAltIDField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetLegSecurityAltIDSource gets LegSecurityAltIDSource, Tag 606
-func (m NoLegSecurityAltID) GetLegSecurityAltIDSource() (v string, err quickfix.MessageRejectError) {
-	var f field.LegSecurityAltIDSourceField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//HasLegSecurityAltID returns true if LegSecurityAltID is present, Tag 605
-func (m NoLegSecurityAltID) HasLegSecurityAltID() bool {
-	return m.Has(tag.LegSecurityAltID)
-}
-
-//HasLegSecurityAltIDSource returns true if LegSecurityAltIDSource is present, Tag 606
-func (m NoLegSecurityAltID) HasLegSecurityAltIDSource() bool {
-	return m.Has(tag.LegSecurityAltIDSource)
-}
+//NoLegSecurityAltID is a repeating group element, Tag 604
+type NoLegSecurityAltID = components.NoLegSecurityAltID
 
 //NoLegSecurityAltIDRepeatingGroup is a repeating group, Tag 604
-type NoLegSecurityAltIDRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
+type NoLegSecurityAltIDRepeatingGroup = components.NoLegSecurityAltIDRepeatingGroup
 
 //NewNoLegSecurityAltIDRepeatingGroup returns an initialized, NoLegSecurityAltIDRepeatingGroup
 func NewNoLegSecurityAltIDRepeatingGroup() NoLegSecurityAltIDRepeatingGroup {
-	return NoLegSecurityAltIDRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoLegSecurityAltID,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.LegSecurityAltID), quickfix.GroupElement(tag.LegSecurityAltIDSource)})}
+	return components.NewNoLegSecurityAltIDRepeatingGroup()
 }
 
-//Add create and append a new NoLegSecurityAltID to this group
-func (m NoLegSecurityAltIDRepeatingGroup) Add() NoLegSecurityAltID {
-	g := m.RepeatingGroup.Add()
-	return NoLegSecurityAltID{g}
-}
-
-//Get returns the ith NoLegSecurityAltID in the NoLegSecurityAltIDRepeatinGroup
-func (m NoLegSecurityAltIDRepeatingGroup) Get(i int) NoLegSecurityAltID {
-	return NoLegSecurityAltID{m.RepeatingGroup.Get(i)}
-}
+//NoLegSecurityAltIDStruct is a plain Go representation of a NoLegSecurityAltID group element
+type NoLegSecurityAltIDStruct = components.NoLegSecurityAltIDStruct
 
 //NoLegsRepeatingGroup is a repeating group, Tag 555
 type NoLegsRepeatingGroup struct {
@@ -3824,7 +3701,7 @@ type NoMDEntriesRepeatingGroup struct {
 func NewNoMDEntriesRepeatingGroup() NoMDEntriesRepeatingGroup {
 	return NoMDEntriesRepeatingGroup{
 		quickfix.NewRepeatingGroup(tag.NoMDEntries,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.MDUpdateAction), quickfix.GroupElement(tag.DeleteReason), quickfix.GroupElement(tag.MDEntryType), quickfix.GroupElement(tag.MDEntryID), quickfix.GroupElement(tag.MDEntryRefID), quickfix.GroupElement(tag.Symbol), quickfix.GroupElement(tag.SymbolSfx), quickfix.GroupElement(tag.SecurityID), quickfix.GroupElement(tag.SecurityIDSource), NewNoSecurityAltIDRepeatingGroup(), quickfix.GroupElement(tag.Product), quickfix.GroupElement(tag.CFICode), quickfix.GroupElement(tag.SecurityType), quickfix.GroupElement(tag.SecuritySubType), quickfix.GroupElement(tag.MaturityMonthYear), quickfix.GroupElement(tag.MaturityDate), quickfix.GroupElement(tag.CouponPaymentDate), quickfix.GroupElement(tag.IssueDate), quickfix.GroupElement(tag.RepoCollateralSecurityType), quickfix.GroupElement(tag.RepurchaseTerm), quickfix.GroupElement(tag.RepurchaseRate), quickfix.GroupElement(tag.Factor), quickfix.GroupElement(tag.CreditRating), quickfix.GroupElement(tag.InstrRegistry), quickfix.GroupElement(tag.CountryOfIssue), quickfix.GroupElement(tag.StateOrProvinceOfIssue), quickfix.GroupElement(tag.LocaleOfIssue), quickfix.GroupElement(tag.RedemptionDate), quickfix.GroupElement(tag.StrikePrice), quickfix.GroupElement(tag.StrikeCurrency), quickfix.GroupElement(tag.OptAttribute), quickfix.GroupElement(tag.ContractMultiplier), quickfix.GroupElement(tag.CouponRate), quickfix.GroupElement(tag.SecurityExchange), quickfix.GroupElement(tag.Issuer), quickfix.GroupElement(tag.EncodedIssuerLen), quickfix.GroupElement(tag.EncodedIssuer), quickfix.GroupElement(tag.SecurityDesc), quickfix.GroupElement(tag.EncodedSecurityDescLen), quickfix.GroupElement(tag.EncodedSecurityDesc), quickfix.GroupElement(tag.Pool), quickfix.GroupElement(tag.ContractSettlMonth), quickfix.GroupElement(tag.CPProgram), quickfix.GroupElement(tag.CPRegType), NewNoEventsRepeatingGroup(), quickfix.GroupElement(tag.DatedDate), quickfix.GroupElement(tag.InterestAccrualDate), NewNoUnderlyingsRepeatingGroup(), NewNoLegsRepeatingGroup(), quickfix.GroupElement(tag.FinancialStatus), quickfix.GroupElement(tag.CorporateAction), quickfix.GroupElement(tag.MDEntryPx), quickfix.GroupElement(tag.Currency), quickfix.GroupElement(tag.MDEntrySize), quickfix.GroupElement(tag.MDEntryDate), quickfix.GroupElement(tag.MDEntryTime), quickfix.GroupElement(tag.TickDirection), quickfix.GroupElement(tag.MDMkt), quickfix.GroupElement(tag.TradingSessionID), quickfix.GroupElement(tag.TradingSessionSubID), quickfix.GroupElement(tag.QuoteCondition), quickfix.GroupElement(tag.TradeCondition), quickfix.GroupElement(tag.MDEntryOriginator), quickfix.GroupElement(tag.LocationID), quickfix.GroupElement(tag.DeskID), quickfix.GroupElement(tag.OpenCloseSettlFlag), quickfix.GroupElement(tag.TimeInForce), quickfix.GroupElement(tag.ExpireDate), quickfix.GroupElement(tag.ExpireTime), quickfix.GroupElement(tag.MinQty), quickfix.GroupElement(tag.ExecInst), quickfix.GroupElement(tag.SellerDays), quickfix.GroupElement(tag.OrderID), quickfix.GroupElement(tag.QuoteEntryID), quickfix.GroupElement(tag.MDEntryBuyer), quickfix.GroupElement(tag.MDEntrySeller), quickfix.GroupElement(tag.NumberOfOrders), quickfix.GroupElement(tag.MDEntryPositionNo), quickfix.GroupElement(tag.Scope), quickfix.GroupElement(tag.PriceDelta), quickfix.GroupElement(tag.NetChgPrevDay), quickfix.GroupElement(tag.Text), quickfix.GroupElement(tag.EncodedTextLen), quickfix.GroupElement(tag.EncodedText)})}
+			quickfix.GroupTemplate{quickfix.GroupElement(tag.MDUpdateAction), quickfix.GroupElement(tag.DeleteReason), quickfix.GroupElement(tag.MDEntryType), quickfix.GroupElement(tag.MDEntryID), quickfix.GroupElement(tag.MDEntryRefID), quickfix.GroupElement(tag.Symbol), quickfix.GroupElement(tag.SymbolSfx), quickfix.GroupElement(tag.SecurityID), quickfix.GroupElement(tag.SecurityIDSource), NewNoSecurityAltIDRepeatingGroup(), quickfix.GroupElement(tag.Product), quickfix.GroupElement(tag.CFICode), quickfix.GroupElement(tag.SecurityType), quickfix.GroupElement(tag.SecuritySubType), quickfix.GroupElement(tag.MaturityMonthYear), quickfix.GroupElement(tag.MaturityDate), quickfix.GroupElement(tag.CouponPaymentDate), quickfix.GroupElement(tag.IssueDate), quickfix.GroupElement(tag.RepoCollateralSecurityType), quickfix.GroupElement(tag.RepurchaseTerm), quickfix.GroupElement(tag.RepurchaseRate), quickfix.GroupElement(tag.Factor), quickfix.GroupElement(tag.CreditRating), quickfix.GroupElement(tag.InstrRegistry), quickfix.GroupElement(tag.CountryOfIssue), quickfix.GroupElement(tag.StateOrProvinceOfIssue), quickfix.GroupElement(tag.LocaleOfIssue), quickfix.GroupElement(tag.RedemptionDate), quickfix.GroupElement(tag.StrikePrice), quickfix.GroupElement(tag.StrikeCurrency), quickfix.GroupElement(tag.OptAttribute), quickfix.GroupElement(tag.ContractMultiplier), quickfix.GroupElement(tag.CouponRate), quickfix.GroupElement(tag.SecurityExchange), quickfix.GroupElement(tag.Issuer), quickfix.GroupElement(tag.EncodedIssuerLen), quickfix.GroupElement(tag.EncodedIssuer), quickfix.GroupElement(tag.SecurityDesc), quickfix.GroupElement(tag.EncodedSecurityDescLen), quickfix.GroupElement(tag.EncodedSecurityDesc), quickfix.GroupElement(tag.Pool), quickfix.GroupElement(tag.ContractSettlMonth), quickfix.GroupElement(tag.CPProgram), quickfix.GroupElement(tag.CPRegType), NewNoEventsRepeatingGroup(), quickfix.GroupElement(tag.DatedDate), quickfix.GroupElement(tag.InterestAccrualDate), NewNoUnderlyingsRepeatingGroup(), NewNoLegsRepeatingGroup(), quickfix.GroupElement(tag.FinancialStatus), quickfix.GroupElement(tag.CorporateAction), quickfix.GroupElement(tag.MDEntryPx), quickfix.GroupElement(tag.Currency), quickfix.GroupElement(tag.MDEntrySize), quickfix.GroupElement(tag.MDEntryDate), quickfix.GroupElement(tag.MDEntryTime), quickfix.GroupElement(tag.TickDirection), quickfix.GroupElement(tag.MDMkt), quickfix.GroupElement(tag.TradingSessionID), quickfix.GroupElement(tag.TradingSessionSubID), quickfix.GroupElement(tag.QuoteCondition), quickfix.GroupElement(tag.TradeCondition), quickfix.GroupElement(tag.MDEntryOriginator), quickfix.GroupElement(tag.LocationID), quickfix.GroupElement(tag.DeskID), quickfix.GroupElement(tag.OpenCloseSettlFlag), quickfix.GroupElement(tag.TimeInForce), quickfix.GroupElement(tag.ExpireDate), quickfix.GroupElement(tag.ExpireTime), quickfix.GroupElement(tag.MinQty), quickfix.GroupElement(tag.ExecInst), quickfix.GroupElement(tag.SellerDays), quickfix.GroupElement(tag.OrderID), quickfix.GroupElement(tag.QuoteEntryID), quickfix.GroupElement(tag.MDEntryBuyer), quickfix.GroupElement(tag.MDEntrySeller), quickfix.GroupElement(tag.NumberOfOrders), quickfix.GroupElement(tag.MDEntryPositionNo), quickfix.GroupElement(tag.Scope), quickfix.GroupElement(tag.PriceDelta), quickfix.GroupElement(tag.NetChgPrevDay), quickfix.GroupElement(tag.Text), quickfix.GroupElement(tag.EncodedTextLen), quickfix.GroupElement(tag.EncodedText), quickfix.GroupElement(tag.RptSeq)})}
 }
 
 //Add create and append a new NoMDEntries to this group
@@ -3837,3 +3714,2048 @@ func (m NoMDEntriesRepeatingGroup) Add() NoMDEntries {
 func (m NoMDEntriesRepeatingGroup) Get(i int) NoMDEntries {
 	return NoMDEntries{m.RepeatingGroup.Get(i)}
 }
+
+//Struct is a plain Go representation of the MarketDataIncrementalRefresh body, optional fields are nil when absent
+type Struct struct {
+	MDReqID             *string
+	NoMDEntries         []NoMDEntriesStruct
+	ApplQueueDepth      *int
+	ApplQueueResolution *enum.ApplQueueResolution
+}
+
+//Marshal copies the fields present in msg into a Struct
+func Marshal(msg MarketDataIncrementalRefresh) (Struct, quickfix.MessageRejectError) {
+	var s Struct
+	if msg.HasMDReqID() {
+		v, err := msg.GetMDReqID()
+		if err != nil {
+			return s, err
+		}
+		s.MDReqID = &v
+	}
+	if msg.HasNoMDEntries() {
+		g, err := msg.GetNoMDEntries()
+		if err != nil {
+			return s, err
+		}
+		if s.NoMDEntries, err = MarshalNoMDEntries(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasApplQueueDepth() {
+		v, err := msg.GetApplQueueDepth()
+		if err != nil {
+			return s, err
+		}
+		s.ApplQueueDepth = &v
+	}
+	if msg.HasApplQueueResolution() {
+		v, err := msg.GetApplQueueResolution()
+		if err != nil {
+			return s, err
+		}
+		s.ApplQueueResolution = &v
+	}
+	return s, nil
+}
+
+//Unmarshal builds a MarketDataIncrementalRefresh from s
+func Unmarshal(s Struct) MarketDataIncrementalRefresh {
+	m := New()
+	if s.MDReqID != nil {
+		m.SetMDReqID(*s.MDReqID)
+	}
+	if s.NoMDEntries != nil {
+		m.SetNoMDEntries(UnmarshalNoMDEntries(s.NoMDEntries))
+	}
+	if s.ApplQueueDepth != nil {
+		m.SetApplQueueDepth(*s.ApplQueueDepth)
+	}
+	if s.ApplQueueResolution != nil {
+		m.SetApplQueueResolution(*s.ApplQueueResolution)
+	}
+	return m
+}
+
+//NoMDEntriesStruct is a plain Go representation of a NoMDEntries group element, optional fields are nil when absent
+type NoMDEntriesStruct struct {
+	MDUpdateAction             *enum.MDUpdateAction
+	DeleteReason               *enum.DeleteReason
+	MDEntryType                *enum.MDEntryType
+	MDEntryID                  *string
+	MDEntryRefID               *string
+	Symbol                     *string
+	SymbolSfx                  *enum.SymbolSfx
+	SecurityID                 *string
+	SecurityIDSource           *enum.SecurityIDSource
+	NoSecurityAltID            []NoSecurityAltIDStruct
+	Product                    *enum.Product
+	CFICode                    *string
+	SecurityType               *enum.SecurityType
+	SecuritySubType            *string
+	MaturityMonthYear          *string
+	MaturityDate               *string
+	CouponPaymentDate          *string
+	IssueDate                  *string
+	RepoCollateralSecurityType *int
+	RepurchaseTerm             *int
+	RepurchaseRate             *decimal.Decimal
+	Factor                     *decimal.Decimal
+	CreditRating               *string
+	InstrRegistry              *enum.InstrRegistry
+	CountryOfIssue             *string
+	StateOrProvinceOfIssue     *string
+	LocaleOfIssue              *string
+	RedemptionDate             *string
+	StrikePrice                *decimal.Decimal
+	StrikeCurrency             *string
+	OptAttribute               *string
+	ContractMultiplier         *decimal.Decimal
+	CouponRate                 *decimal.Decimal
+	SecurityExchange           *string
+	Issuer                     *string
+	EncodedIssuerLen           *int
+	EncodedIssuer              *string
+	SecurityDesc               *string
+	EncodedSecurityDescLen     *int
+	EncodedSecurityDesc        *string
+	Pool                       *string
+	ContractSettlMonth         *string
+	CPProgram                  *enum.CPProgram
+	CPRegType                  *string
+	NoEvents                   []NoEventsStruct
+	DatedDate                  *string
+	InterestAccrualDate        *string
+	NoUnderlyings              []NoUnderlyingsStruct
+	NoLegs                     []NoLegsStruct
+	FinancialStatus            *enum.FinancialStatus
+	CorporateAction            *enum.CorporateAction
+	MDEntryPx                  *decimal.Decimal
+	Currency                   *string
+	MDEntrySize                *decimal.Decimal
+	MDEntryDate                *string
+	MDEntryTime                *string
+	TickDirection              *enum.TickDirection
+	MDMkt                      *string
+	TradingSessionID           *enum.TradingSessionID
+	TradingSessionSubID        *enum.TradingSessionSubID
+	QuoteCondition             *enum.QuoteCondition
+	TradeCondition             *enum.TradeCondition
+	MDEntryOriginator          *string
+	LocationID                 *string
+	DeskID                     *string
+	OpenCloseSettlFlag         *enum.OpenCloseSettlFlag
+	TimeInForce                *enum.TimeInForce
+	ExpireDate                 *string
+	ExpireTime                 *time.Time
+	MinQty                     *decimal.Decimal
+	ExecInst                   *enum.ExecInst
+	SellerDays                 *int
+	OrderID                    *string
+	QuoteEntryID               *string
+	MDEntryBuyer               *string
+	MDEntrySeller              *string
+	NumberOfOrders             *int
+	MDEntryPositionNo          *int
+	Scope                      *enum.Scope
+	PriceDelta                 *decimal.Decimal
+	NetChgPrevDay              *decimal.Decimal
+	Text                       *string
+	EncodedTextLen             *int
+	EncodedText                *string
+	RptSeq                     *int
+}
+
+//MarshalNoMDEntries copies the elements of g into a slice of NoMDEntriesStruct
+func MarshalNoMDEntries(g NoMDEntriesRepeatingGroup) ([]NoMDEntriesStruct, quickfix.MessageRejectError) {
+	s := make([]NoMDEntriesStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasMDUpdateAction() {
+			v, err := m.GetMDUpdateAction()
+			if err != nil {
+				return nil, err
+			}
+			s[i].MDUpdateAction = &v
+		}
+		if m.HasDeleteReason() {
+			v, err := m.GetDeleteReason()
+			if err != nil {
+				return nil, err
+			}
+			s[i].DeleteReason = &v
+		}
+		if m.HasMDEntryType() {
+			v, err := m.GetMDEntryType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].MDEntryType = &v
+		}
+		if m.HasMDEntryID() {
+			v, err := m.GetMDEntryID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].MDEntryID = &v
+		}
+		if m.HasMDEntryRefID() {
+			v, err := m.GetMDEntryRefID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].MDEntryRefID = &v
+		}
+		if m.HasSymbol() {
+			v, err := m.GetSymbol()
+			if err != nil {
+				return nil, err
+			}
+			s[i].Symbol = &v
+		}
+		if m.HasSymbolSfx() {
+			v, err := m.GetSymbolSfx()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SymbolSfx = &v
+		}
+		if m.HasSecurityID() {
+			v, err := m.GetSecurityID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SecurityID = &v
+		}
+		if m.HasSecurityIDSource() {
+			v, err := m.GetSecurityIDSource()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SecurityIDSource = &v
+		}
+		if m.HasNoSecurityAltID() {
+			g, err := m.GetNoSecurityAltID()
+			if err != nil {
+				return nil, err
+			}
+			if s[i].NoSecurityAltID, err = components.MarshalNoSecurityAltID(g); err != nil {
+				return nil, err
+			}
+		}
+		if m.HasProduct() {
+			v, err := m.GetProduct()
+			if err != nil {
+				return nil, err
+			}
+			s[i].Product = &v
+		}
+		if m.HasCFICode() {
+			v, err := m.GetCFICode()
+			if err != nil {
+				return nil, err
+			}
+			s[i].CFICode = &v
+		}
+		if m.HasSecurityType() {
+			v, err := m.GetSecurityType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SecurityType = &v
+		}
+		if m.HasSecuritySubType() {
+			v, err := m.GetSecuritySubType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SecuritySubType = &v
+		}
+		if m.HasMaturityMonthYear() {
+			v, err := m.GetMaturityMonthYear()
+			if err != nil {
+				return nil, err
+			}
+			s[i].MaturityMonthYear = &v
+		}
+		if m.HasMaturityDate() {
+			v, err := m.GetMaturityDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].MaturityDate = &v
+		}
+		if m.HasCouponPaymentDate() {
+			v, err := m.GetCouponPaymentDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].CouponPaymentDate = &v
+		}
+		if m.HasIssueDate() {
+			v, err := m.GetIssueDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].IssueDate = &v
+		}
+		if m.HasRepoCollateralSecurityType() {
+			v, err := m.GetRepoCollateralSecurityType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].RepoCollateralSecurityType = &v
+		}
+		if m.HasRepurchaseTerm() {
+			v, err := m.GetRepurchaseTerm()
+			if err != nil {
+				return nil, err
+			}
+			s[i].RepurchaseTerm = &v
+		}
+		if m.HasRepurchaseRate() {
+			v, err := m.GetRepurchaseRate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].RepurchaseRate = &v
+		}
+		if m.HasFactor() {
+			v, err := m.GetFactor()
+			if err != nil {
+				return nil, err
+			}
+			s[i].Factor = &v
+		}
+		if m.HasCreditRating() {
+			v, err := m.GetCreditRating()
+			if err != nil {
+				return nil, err
+			}
+			s[i].CreditRating = &v
+		}
+		if m.HasInstrRegistry() {
+			v, err := m.GetInstrRegistry()
+			if err != nil {
+				return nil, err
+			}
+			s[i].InstrRegistry = &v
+		}
+		if m.HasCountryOfIssue() {
+			v, err := m.GetCountryOfIssue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].CountryOfIssue = &v
+		}
+		if m.HasStateOrProvinceOfIssue() {
+			v, err := m.GetStateOrProvinceOfIssue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].StateOrProvinceOfIssue = &v
+		}
+		if m.HasLocaleOfIssue() {
+			v, err := m.GetLocaleOfIssue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LocaleOfIssue = &v
+		}
+		if m.HasRedemptionDate() {
+			v, err := m.GetRedemptionDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].RedemptionDate = &v
+		}
+		if m.HasStrikePrice() {
+			v, err := m.GetStrikePrice()
+			if err != nil {
+				return nil, err
+			}
+			s[i].StrikePrice = &v
+		}
+		if m.HasStrikeCurrency() {
+			v, err := m.GetStrikeCurrency()
+			if err != nil {
+				return nil, err
+			}
+			s[i].StrikeCurrency = &v
+		}
+		if m.HasOptAttribute() {
+			v, err := m.GetOptAttribute()
+			if err != nil {
+				return nil, err
+			}
+			s[i].OptAttribute = &v
+		}
+		if m.HasContractMultiplier() {
+			v, err := m.GetContractMultiplier()
+			if err != nil {
+				return nil, err
+			}
+			s[i].ContractMultiplier = &v
+		}
+		if m.HasCouponRate() {
+			v, err := m.GetCouponRate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].CouponRate = &v
+		}
+		if m.HasSecurityExchange() {
+			v, err := m.GetSecurityExchange()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SecurityExchange = &v
+		}
+		if m.HasIssuer() {
+			v, err := m.GetIssuer()
+			if err != nil {
+				return nil, err
+			}
+			s[i].Issuer = &v
+		}
+		if m.HasEncodedIssuerLen() {
+			v, err := m.GetEncodedIssuerLen()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedIssuerLen = &v
+		}
+		if m.HasEncodedIssuer() {
+			v, err := m.GetEncodedIssuer()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedIssuer = &v
+		}
+		if m.HasSecurityDesc() {
+			v, err := m.GetSecurityDesc()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SecurityDesc = &v
+		}
+		if m.HasEncodedSecurityDescLen() {
+			v, err := m.GetEncodedSecurityDescLen()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedSecurityDescLen = &v
+		}
+		if m.HasEncodedSecurityDesc() {
+			v, err := m.GetEncodedSecurityDesc()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedSecurityDesc = &v
+		}
+		if m.HasPool() {
+			v, err := m.GetPool()
+			if err != nil {
+				return nil, err
+			}
+			s[i].Pool = &v
+		}
+		if m.HasContractSettlMonth() {
+			v, err := m.GetContractSettlMonth()
+			if err != nil {
+				return nil, err
+			}
+			s[i].ContractSettlMonth = &v
+		}
+		if m.HasCPProgram() {
+			v, err := m.GetCPProgram()
+			if err != nil {
+				return nil, err
+			}
+			s[i].CPProgram = &v
+		}
+		if m.HasCPRegType() {
+			v, err := m.GetCPRegType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].CPRegType = &v
+		}
+		if m.HasNoEvents() {
+			g, err := m.GetNoEvents()
+			if err != nil {
+				return nil, err
+			}
+			if s[i].NoEvents, err = components.MarshalNoEvents(g); err != nil {
+				return nil, err
+			}
+		}
+		if m.HasDatedDate() {
+			v, err := m.GetDatedDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].DatedDate = &v
+		}
+		if m.HasInterestAccrualDate() {
+			v, err := m.GetInterestAccrualDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].InterestAccrualDate = &v
+		}
+		if m.HasNoUnderlyings() {
+			g, err := m.GetNoUnderlyings()
+			if err != nil {
+				return nil, err
+			}
+			if s[i].NoUnderlyings, err = MarshalNoUnderlyings(g); err != nil {
+				return nil, err
+			}
+		}
+		if m.HasNoLegs() {
+			g, err := m.GetNoLegs()
+			if err != nil {
+				return nil, err
+			}
+			if s[i].NoLegs, err = MarshalNoLegs(g); err != nil {
+				return nil, err
+			}
+		}
+		if m.HasFinancialStatus() {
+			v, err := m.GetFinancialStatus()
+			if err != nil {
+				return nil, err
+			}
+			s[i].FinancialStatus = &v
+		}
+		if m.HasCorporateAction() {
+			v, err := m.GetCorporateAction()
+			if err != nil {
+				return nil, err
+			}
+			s[i].CorporateAction = &v
+		}
+		if m.HasMDEntryPx() {
+			v, err := m.GetMDEntryPx()
+			if err != nil {
+				return nil, err
+			}
+			s[i].MDEntryPx = &v
+		}
+		if m.HasCurrency() {
+			v, err := m.GetCurrency()
+			if err != nil {
+				return nil, err
+			}
+			s[i].Currency = &v
+		}
+		if m.HasMDEntrySize() {
+			v, err := m.GetMDEntrySize()
+			if err != nil {
+				return nil, err
+			}
+			s[i].MDEntrySize = &v
+		}
+		if m.HasMDEntryDate() {
+			v, err := m.GetMDEntryDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].MDEntryDate = &v
+		}
+		if m.HasMDEntryTime() {
+			v, err := m.GetMDEntryTime()
+			if err != nil {
+				return nil, err
+			}
+			s[i].MDEntryTime = &v
+		}
+		if m.HasTickDirection() {
+			v, err := m.GetTickDirection()
+			if err != nil {
+				return nil, err
+			}
+			s[i].TickDirection = &v
+		}
+		if m.HasMDMkt() {
+			v, err := m.GetMDMkt()
+			if err != nil {
+				return nil, err
+			}
+			s[i].MDMkt = &v
+		}
+		if m.HasTradingSessionID() {
+			v, err := m.GetTradingSessionID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].TradingSessionID = &v
+		}
+		if m.HasTradingSessionSubID() {
+			v, err := m.GetTradingSessionSubID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].TradingSessionSubID = &v
+		}
+		if m.HasQuoteCondition() {
+			v, err := m.GetQuoteCondition()
+			if err != nil {
+				return nil, err
+			}
+			s[i].QuoteCondition = &v
+		}
+		if m.HasTradeCondition() {
+			v, err := m.GetTradeCondition()
+			if err != nil {
+				return nil, err
+			}
+			s[i].TradeCondition = &v
+		}
+		if m.HasMDEntryOriginator() {
+			v, err := m.GetMDEntryOriginator()
+			if err != nil {
+				return nil, err
+			}
+			s[i].MDEntryOriginator = &v
+		}
+		if m.HasLocationID() {
+			v, err := m.GetLocationID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LocationID = &v
+		}
+		if m.HasDeskID() {
+			v, err := m.GetDeskID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].DeskID = &v
+		}
+		if m.HasOpenCloseSettlFlag() {
+			v, err := m.GetOpenCloseSettlFlag()
+			if err != nil {
+				return nil, err
+			}
+			s[i].OpenCloseSettlFlag = &v
+		}
+		if m.HasTimeInForce() {
+			v, err := m.GetTimeInForce()
+			if err != nil {
+				return nil, err
+			}
+			s[i].TimeInForce = &v
+		}
+		if m.HasExpireDate() {
+			v, err := m.GetExpireDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].ExpireDate = &v
+		}
+		if m.HasExpireTime() {
+			v, err := m.GetExpireTime()
+			if err != nil {
+				return nil, err
+			}
+			s[i].ExpireTime = &v
+		}
+		if m.HasMinQty() {
+			v, err := m.GetMinQty()
+			if err != nil {
+				return nil, err
+			}
+			s[i].MinQty = &v
+		}
+		if m.HasExecInst() {
+			v, err := m.GetExecInst()
+			if err != nil {
+				return nil, err
+			}
+			s[i].ExecInst = &v
+		}
+		if m.HasSellerDays() {
+			v, err := m.GetSellerDays()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SellerDays = &v
+		}
+		if m.HasOrderID() {
+			v, err := m.GetOrderID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].OrderID = &v
+		}
+		if m.HasQuoteEntryID() {
+			v, err := m.GetQuoteEntryID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].QuoteEntryID = &v
+		}
+		if m.HasMDEntryBuyer() {
+			v, err := m.GetMDEntryBuyer()
+			if err != nil {
+				return nil, err
+			}
+			s[i].MDEntryBuyer = &v
+		}
+		if m.HasMDEntrySeller() {
+			v, err := m.GetMDEntrySeller()
+			if err != nil {
+				return nil, err
+			}
+			s[i].MDEntrySeller = &v
+		}
+		if m.HasNumberOfOrders() {
+			v, err := m.GetNumberOfOrders()
+			if err != nil {
+				return nil, err
+			}
+			s[i].NumberOfOrders = &v
+		}
+		if m.HasMDEntryPositionNo() {
+			v, err := m.GetMDEntryPositionNo()
+			if err != nil {
+				return nil, err
+			}
+			s[i].MDEntryPositionNo = &v
+		}
+		if m.HasScope() {
+			v, err := m.GetScope()
+			if err != nil {
+				return nil, err
+			}
+			s[i].Scope = &v
+		}
+		if m.HasPriceDelta() {
+			v, err := m.GetPriceDelta()
+			if err != nil {
+				return nil, err
+			}
+			s[i].PriceDelta = &v
+		}
+		if m.HasNetChgPrevDay() {
+			v, err := m.GetNetChgPrevDay()
+			if err != nil {
+				return nil, err
+			}
+			s[i].NetChgPrevDay = &v
+		}
+		if m.HasText() {
+			v, err := m.GetText()
+			if err != nil {
+				return nil, err
+			}
+			s[i].Text = &v
+		}
+		if m.HasEncodedTextLen() {
+			v, err := m.GetEncodedTextLen()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedTextLen = &v
+		}
+		if m.HasEncodedText() {
+			v, err := m.GetEncodedText()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedText = &v
+		}
+		if m.HasRptSeq() {
+			v, err := m.GetRptSeq()
+			if err != nil {
+				return nil, err
+			}
+			s[i].RptSeq = &v
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoMDEntries builds a NoMDEntriesRepeatingGroup from s
+func UnmarshalNoMDEntries(s []NoMDEntriesStruct) NoMDEntriesRepeatingGroup {
+	g := NewNoMDEntriesRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.MDUpdateAction != nil {
+			m.SetMDUpdateAction(*e.MDUpdateAction)
+		}
+		if e.DeleteReason != nil {
+			m.SetDeleteReason(*e.DeleteReason)
+		}
+		if e.MDEntryType != nil {
+			m.SetMDEntryType(*e.MDEntryType)
+		}
+		if e.MDEntryID != nil {
+			m.SetMDEntryID(*e.MDEntryID)
+		}
+		if e.MDEntryRefID != nil {
+			m.SetMDEntryRefID(*e.MDEntryRefID)
+		}
+		if e.Symbol != nil {
+			m.SetSymbol(*e.Symbol)
+		}
+		if e.SymbolSfx != nil {
+			m.SetSymbolSfx(*e.SymbolSfx)
+		}
+		if e.SecurityID != nil {
+			m.SetSecurityID(*e.SecurityID)
+		}
+		if e.SecurityIDSource != nil {
+			m.SetSecurityIDSource(*e.SecurityIDSource)
+		}
+		if e.NoSecurityAltID != nil {
+			m.SetNoSecurityAltID(components.UnmarshalNoSecurityAltID(e.NoSecurityAltID))
+		}
+		if e.Product != nil {
+			m.SetProduct(*e.Product)
+		}
+		if e.CFICode != nil {
+			m.SetCFICode(*e.CFICode)
+		}
+		if e.SecurityType != nil {
+			m.SetSecurityType(*e.SecurityType)
+		}
+		if e.SecuritySubType != nil {
+			m.SetSecuritySubType(*e.SecuritySubType)
+		}
+		if e.MaturityMonthYear != nil {
+			m.SetMaturityMonthYear(*e.MaturityMonthYear)
+		}
+		if e.MaturityDate != nil {
+			m.SetMaturityDate(*e.MaturityDate)
+		}
+		if e.CouponPaymentDate != nil {
+			m.SetCouponPaymentDate(*e.CouponPaymentDate)
+		}
+		if e.IssueDate != nil {
+			m.SetIssueDate(*e.IssueDate)
+		}
+		if e.RepoCollateralSecurityType != nil {
+			m.SetRepoCollateralSecurityType(*e.RepoCollateralSecurityType)
+		}
+		if e.RepurchaseTerm != nil {
+			m.SetRepurchaseTerm(*e.RepurchaseTerm)
+		}
+		if e.RepurchaseRate != nil {
+			m.SetRepurchaseRate(*e.RepurchaseRate, fix44.DecimalScale(*e.RepurchaseRate))
+		}
+		if e.Factor != nil {
+			m.SetFactor(*e.Factor, fix44.DecimalScale(*e.Factor))
+		}
+		if e.CreditRating != nil {
+			m.SetCreditRating(*e.CreditRating)
+		}
+		if e.InstrRegistry != nil {
+			m.SetInstrRegistry(*e.InstrRegistry)
+		}
+		if e.CountryOfIssue != nil {
+			m.SetCountryOfIssue(*e.CountryOfIssue)
+		}
+		if e.StateOrProvinceOfIssue != nil {
+			m.SetStateOrProvinceOfIssue(*e.StateOrProvinceOfIssue)
+		}
+		if e.LocaleOfIssue != nil {
+			m.SetLocaleOfIssue(*e.LocaleOfIssue)
+		}
+		if e.RedemptionDate != nil {
+			m.SetRedemptionDate(*e.RedemptionDate)
+		}
+		if e.StrikePrice != nil {
+			m.SetStrikePrice(*e.StrikePrice, fix44.DecimalScale(*e.StrikePrice))
+		}
+		if e.StrikeCurrency != nil {
+			m.SetStrikeCurrency(*e.StrikeCurrency)
+		}
+		if e.OptAttribute != nil {
+			m.SetOptAttribute(*e.OptAttribute)
+		}
+		if e.ContractMultiplier != nil {
+			m.SetContractMultiplier(*e.ContractMultiplier, fix44.DecimalScale(*e.ContractMultiplier))
+		}
+		if e.CouponRate != nil {
+			m.SetCouponRate(*e.CouponRate, fix44.DecimalScale(*e.CouponRate))
+		}
+		if e.SecurityExchange != nil {
+			m.SetSecurityExchange(*e.SecurityExchange)
+		}
+		if e.Issuer != nil {
+			m.SetIssuer(*e.Issuer)
+		}
+		if e.EncodedIssuerLen != nil {
+			m.SetEncodedIssuerLen(*e.EncodedIssuerLen)
+		}
+		if e.EncodedIssuer != nil {
+			m.SetEncodedIssuer(*e.EncodedIssuer)
+		}
+		if e.SecurityDesc != nil {
+			m.SetSecurityDesc(*e.SecurityDesc)
+		}
+		if e.EncodedSecurityDescLen != nil {
+			m.SetEncodedSecurityDescLen(*e.EncodedSecurityDescLen)
+		}
+		if e.EncodedSecurityDesc != nil {
+			m.SetEncodedSecurityDesc(*e.EncodedSecurityDesc)
+		}
+		if e.Pool != nil {
+			m.SetPool(*e.Pool)
+		}
+		if e.ContractSettlMonth != nil {
+			m.SetContractSettlMonth(*e.ContractSettlMonth)
+		}
+		if e.CPProgram != nil {
+			m.SetCPProgram(*e.CPProgram)
+		}
+		if e.CPRegType != nil {
+			m.SetCPRegType(*e.CPRegType)
+		}
+		if e.NoEvents != nil {
+			m.SetNoEvents(components.UnmarshalNoEvents(e.NoEvents))
+		}
+		if e.DatedDate != nil {
+			m.SetDatedDate(*e.DatedDate)
+		}
+		if e.InterestAccrualDate != nil {
+			m.SetInterestAccrualDate(*e.InterestAccrualDate)
+		}
+		if e.NoUnderlyings != nil {
+			m.SetNoUnderlyings(UnmarshalNoUnderlyings(e.NoUnderlyings))
+		}
+		if e.NoLegs != nil {
+			m.SetNoLegs(UnmarshalNoLegs(e.NoLegs))
+		}
+		if e.FinancialStatus != nil {
+			m.SetFinancialStatus(*e.FinancialStatus)
+		}
+		if e.CorporateAction != nil {
+			m.SetCorporateAction(*e.CorporateAction)
+		}
+		if e.MDEntryPx != nil {
+			m.SetMDEntryPx(*e.MDEntryPx, fix44.DecimalScale(*e.MDEntryPx))
+		}
+		if e.Currency != nil {
+			m.SetCurrency(*e.Currency)
+		}
+		if e.MDEntrySize != nil {
+			m.SetMDEntrySize(*e.MDEntrySize, fix44.DecimalScale(*e.MDEntrySize))
+		}
+		if e.MDEntryDate != nil {
+			m.SetMDEntryDate(*e.MDEntryDate)
+		}
+		if e.MDEntryTime != nil {
+			m.SetMDEntryTime(*e.MDEntryTime)
+		}
+		if e.TickDirection != nil {
+			m.SetTickDirection(*e.TickDirection)
+		}
+		if e.MDMkt != nil {
+			m.SetMDMkt(*e.MDMkt)
+		}
+		if e.TradingSessionID != nil {
+			m.SetTradingSessionID(*e.TradingSessionID)
+		}
+		if e.TradingSessionSubID != nil {
+			m.SetTradingSessionSubID(*e.TradingSessionSubID)
+		}
+		if e.QuoteCondition != nil {
+			m.SetQuoteCondition(*e.QuoteCondition)
+		}
+		if e.TradeCondition != nil {
+			m.SetTradeCondition(*e.TradeCondition)
+		}
+		if e.MDEntryOriginator != nil {
+			m.SetMDEntryOriginator(*e.MDEntryOriginator)
+		}
+		if e.LocationID != nil {
+			m.SetLocationID(*e.LocationID)
+		}
+		if e.DeskID != nil {
+			m.SetDeskID(*e.DeskID)
+		}
+		if e.OpenCloseSettlFlag != nil {
+			m.SetOpenCloseSettlFlag(*e.OpenCloseSettlFlag)
+		}
+		if e.TimeInForce != nil {
+			m.SetTimeInForce(*e.TimeInForce)
+		}
+		if e.ExpireDate != nil {
+			m.SetExpireDate(*e.ExpireDate)
+		}
+		if e.ExpireTime != nil {
+			m.SetExpireTime(*e.ExpireTime)
+		}
+		if e.MinQty != nil {
+			m.SetMinQty(*e.MinQty, fix44.DecimalScale(*e.MinQty))
+		}
+		if e.ExecInst != nil {
+			m.SetExecInst(*e.ExecInst)
+		}
+		if e.SellerDays != nil {
+			m.SetSellerDays(*e.SellerDays)
+		}
+		if e.OrderID != nil {
+			m.SetOrderID(*e.OrderID)
+		}
+		if e.QuoteEntryID != nil {
+			m.SetQuoteEntryID(*e.QuoteEntryID)
+		}
+		if e.MDEntryBuyer != nil {
+			m.SetMDEntryBuyer(*e.MDEntryBuyer)
+		}
+		if e.MDEntrySeller != nil {
+			m.SetMDEntrySeller(*e.MDEntrySeller)
+		}
+		if e.NumberOfOrders != nil {
+			m.SetNumberOfOrders(*e.NumberOfOrders)
+		}
+		if e.MDEntryPositionNo != nil {
+			m.SetMDEntryPositionNo(*e.MDEntryPositionNo)
+		}
+		if e.Scope != nil {
+			m.SetScope(*e.Scope)
+		}
+		if e.PriceDelta != nil {
+			m.SetPriceDelta(*e.PriceDelta, fix44.DecimalScale(*e.PriceDelta))
+		}
+		if e.NetChgPrevDay != nil {
+			m.SetNetChgPrevDay(*e.NetChgPrevDay, fix44.DecimalScale(*e.NetChgPrevDay))
+		}
+		if e.Text != nil {
+			m.SetText(*e.Text)
+		}
+		if e.EncodedTextLen != nil {
+			m.SetEncodedTextLen(*e.EncodedTextLen)
+		}
+		if e.EncodedText != nil {
+			m.SetEncodedText(*e.EncodedText)
+		}
+		if e.RptSeq != nil {
+			m.SetRptSeq(*e.RptSeq)
+		}
+	}
+	return g
+}
+
+//NoUnderlyingsStruct is a plain Go representation of a NoUnderlyings group element, optional fields are nil when absent
+type NoUnderlyingsStruct struct {
+	UnderlyingSymbol                     *string
+	UnderlyingSymbolSfx                  *string
+	UnderlyingSecurityID                 *string
+	UnderlyingSecurityIDSource           *string
+	NoUnderlyingSecurityAltID            []NoUnderlyingSecurityAltIDStruct
+	UnderlyingProduct                    *int
+	UnderlyingCFICode                    *string
+	UnderlyingSecurityType               *string
+	UnderlyingSecuritySubType            *string
+	UnderlyingMaturityMonthYear          *string
+	UnderlyingMaturityDate               *string
+	UnderlyingCouponPaymentDate          *string
+	UnderlyingIssueDate                  *string
+	UnderlyingRepoCollateralSecurityType *int
+	UnderlyingRepurchaseTerm             *int
+	UnderlyingRepurchaseRate             *decimal.Decimal
+	UnderlyingFactor                     *decimal.Decimal
+	UnderlyingCreditRating               *string
+	UnderlyingInstrRegistry              *string
+	UnderlyingCountryOfIssue             *string
+	UnderlyingStateOrProvinceOfIssue     *string
+	UnderlyingLocaleOfIssue              *string
+	UnderlyingRedemptionDate             *string
+	UnderlyingStrikePrice                *decimal.Decimal
+	UnderlyingStrikeCurrency             *string
+	UnderlyingOptAttribute               *string
+	UnderlyingContractMultiplier         *decimal.Decimal
+	UnderlyingCouponRate                 *decimal.Decimal
+	UnderlyingSecurityExchange           *string
+	UnderlyingIssuer                     *string
+	EncodedUnderlyingIssuerLen           *int
+	EncodedUnderlyingIssuer              *string
+	UnderlyingSecurityDesc               *string
+	EncodedUnderlyingSecurityDescLen     *int
+	EncodedUnderlyingSecurityDesc        *string
+	UnderlyingCPProgram                  *string
+	UnderlyingCPRegType                  *string
+	UnderlyingCurrency                   *string
+	UnderlyingQty                        *decimal.Decimal
+	UnderlyingPx                         *decimal.Decimal
+	UnderlyingDirtyPrice                 *decimal.Decimal
+	UnderlyingEndPrice                   *decimal.Decimal
+	UnderlyingStartValue                 *decimal.Decimal
+	UnderlyingCurrentValue               *decimal.Decimal
+	UnderlyingEndValue                   *decimal.Decimal
+	NoUnderlyingStips                    []NoUnderlyingStipsStruct
+}
+
+//MarshalNoUnderlyings copies the elements of g into a slice of NoUnderlyingsStruct
+func MarshalNoUnderlyings(g NoUnderlyingsRepeatingGroup) ([]NoUnderlyingsStruct, quickfix.MessageRejectError) {
+	s := make([]NoUnderlyingsStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasUnderlyingSymbol() {
+			v, err := m.GetUnderlyingSymbol()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingSymbol = &v
+		}
+		if m.HasUnderlyingSymbolSfx() {
+			v, err := m.GetUnderlyingSymbolSfx()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingSymbolSfx = &v
+		}
+		if m.HasUnderlyingSecurityID() {
+			v, err := m.GetUnderlyingSecurityID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingSecurityID = &v
+		}
+		if m.HasUnderlyingSecurityIDSource() {
+			v, err := m.GetUnderlyingSecurityIDSource()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingSecurityIDSource = &v
+		}
+		if m.HasNoUnderlyingSecurityAltID() {
+			g, err := m.GetNoUnderlyingSecurityAltID()
+			if err != nil {
+				return nil, err
+			}
+			if s[i].NoUnderlyingSecurityAltID, err = components.MarshalNoUnderlyingSecurityAltID(g); err != nil {
+				return nil, err
+			}
+		}
+		if m.HasUnderlyingProduct() {
+			v, err := m.GetUnderlyingProduct()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingProduct = &v
+		}
+		if m.HasUnderlyingCFICode() {
+			v, err := m.GetUnderlyingCFICode()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCFICode = &v
+		}
+		if m.HasUnderlyingSecurityType() {
+			v, err := m.GetUnderlyingSecurityType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingSecurityType = &v
+		}
+		if m.HasUnderlyingSecuritySubType() {
+			v, err := m.GetUnderlyingSecuritySubType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingSecuritySubType = &v
+		}
+		if m.HasUnderlyingMaturityMonthYear() {
+			v, err := m.GetUnderlyingMaturityMonthYear()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingMaturityMonthYear = &v
+		}
+		if m.HasUnderlyingMaturityDate() {
+			v, err := m.GetUnderlyingMaturityDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingMaturityDate = &v
+		}
+		if m.HasUnderlyingCouponPaymentDate() {
+			v, err := m.GetUnderlyingCouponPaymentDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCouponPaymentDate = &v
+		}
+		if m.HasUnderlyingIssueDate() {
+			v, err := m.GetUnderlyingIssueDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingIssueDate = &v
+		}
+		if m.HasUnderlyingRepoCollateralSecurityType() {
+			v, err := m.GetUnderlyingRepoCollateralSecurityType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingRepoCollateralSecurityType = &v
+		}
+		if m.HasUnderlyingRepurchaseTerm() {
+			v, err := m.GetUnderlyingRepurchaseTerm()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingRepurchaseTerm = &v
+		}
+		if m.HasUnderlyingRepurchaseRate() {
+			v, err := m.GetUnderlyingRepurchaseRate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingRepurchaseRate = &v
+		}
+		if m.HasUnderlyingFactor() {
+			v, err := m.GetUnderlyingFactor()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingFactor = &v
+		}
+		if m.HasUnderlyingCreditRating() {
+			v, err := m.GetUnderlyingCreditRating()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCreditRating = &v
+		}
+		if m.HasUnderlyingInstrRegistry() {
+			v, err := m.GetUnderlyingInstrRegistry()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingInstrRegistry = &v
+		}
+		if m.HasUnderlyingCountryOfIssue() {
+			v, err := m.GetUnderlyingCountryOfIssue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCountryOfIssue = &v
+		}
+		if m.HasUnderlyingStateOrProvinceOfIssue() {
+			v, err := m.GetUnderlyingStateOrProvinceOfIssue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingStateOrProvinceOfIssue = &v
+		}
+		if m.HasUnderlyingLocaleOfIssue() {
+			v, err := m.GetUnderlyingLocaleOfIssue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingLocaleOfIssue = &v
+		}
+		if m.HasUnderlyingRedemptionDate() {
+			v, err := m.GetUnderlyingRedemptionDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingRedemptionDate = &v
+		}
+		if m.HasUnderlyingStrikePrice() {
+			v, err := m.GetUnderlyingStrikePrice()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingStrikePrice = &v
+		}
+		if m.HasUnderlyingStrikeCurrency() {
+			v, err := m.GetUnderlyingStrikeCurrency()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingStrikeCurrency = &v
+		}
+		if m.HasUnderlyingOptAttribute() {
+			v, err := m.GetUnderlyingOptAttribute()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingOptAttribute = &v
+		}
+		if m.HasUnderlyingContractMultiplier() {
+			v, err := m.GetUnderlyingContractMultiplier()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingContractMultiplier = &v
+		}
+		if m.HasUnderlyingCouponRate() {
+			v, err := m.GetUnderlyingCouponRate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCouponRate = &v
+		}
+		if m.HasUnderlyingSecurityExchange() {
+			v, err := m.GetUnderlyingSecurityExchange()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingSecurityExchange = &v
+		}
+		if m.HasUnderlyingIssuer() {
+			v, err := m.GetUnderlyingIssuer()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingIssuer = &v
+		}
+		if m.HasEncodedUnderlyingIssuerLen() {
+			v, err := m.GetEncodedUnderlyingIssuerLen()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedUnderlyingIssuerLen = &v
+		}
+		if m.HasEncodedUnderlyingIssuer() {
+			v, err := m.GetEncodedUnderlyingIssuer()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedUnderlyingIssuer = &v
+		}
+		if m.HasUnderlyingSecurityDesc() {
+			v, err := m.GetUnderlyingSecurityDesc()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingSecurityDesc = &v
+		}
+		if m.HasEncodedUnderlyingSecurityDescLen() {
+			v, err := m.GetEncodedUnderlyingSecurityDescLen()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedUnderlyingSecurityDescLen = &v
+		}
+		if m.HasEncodedUnderlyingSecurityDesc() {
+			v, err := m.GetEncodedUnderlyingSecurityDesc()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedUnderlyingSecurityDesc = &v
+		}
+		if m.HasUnderlyingCPProgram() {
+			v, err := m.GetUnderlyingCPProgram()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCPProgram = &v
+		}
+		if m.HasUnderlyingCPRegType() {
+			v, err := m.GetUnderlyingCPRegType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCPRegType = &v
+		}
+		if m.HasUnderlyingCurrency() {
+			v, err := m.GetUnderlyingCurrency()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCurrency = &v
+		}
+		if m.HasUnderlyingQty() {
+			v, err := m.GetUnderlyingQty()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingQty = &v
+		}
+		if m.HasUnderlyingPx() {
+			v, err := m.GetUnderlyingPx()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingPx = &v
+		}
+		if m.HasUnderlyingDirtyPrice() {
+			v, err := m.GetUnderlyingDirtyPrice()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingDirtyPrice = &v
+		}
+		if m.HasUnderlyingEndPrice() {
+			v, err := m.GetUnderlyingEndPrice()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingEndPrice = &v
+		}
+		if m.HasUnderlyingStartValue() {
+			v, err := m.GetUnderlyingStartValue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingStartValue = &v
+		}
+		if m.HasUnderlyingCurrentValue() {
+			v, err := m.GetUnderlyingCurrentValue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCurrentValue = &v
+		}
+		if m.HasUnderlyingEndValue() {
+			v, err := m.GetUnderlyingEndValue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingEndValue = &v
+		}
+		if m.HasNoUnderlyingStips() {
+			g, err := m.GetNoUnderlyingStips()
+			if err != nil {
+				return nil, err
+			}
+			if s[i].NoUnderlyingStips, err = components.MarshalNoUnderlyingStips(g); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoUnderlyings builds a NoUnderlyingsRepeatingGroup from s
+func UnmarshalNoUnderlyings(s []NoUnderlyingsStruct) NoUnderlyingsRepeatingGroup {
+	g := NewNoUnderlyingsRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.UnderlyingSymbol != nil {
+			m.SetUnderlyingSymbol(*e.UnderlyingSymbol)
+		}
+		if e.UnderlyingSymbolSfx != nil {
+			m.SetUnderlyingSymbolSfx(*e.UnderlyingSymbolSfx)
+		}
+		if e.UnderlyingSecurityID != nil {
+			m.SetUnderlyingSecurityID(*e.UnderlyingSecurityID)
+		}
+		if e.UnderlyingSecurityIDSource != nil {
+			m.SetUnderlyingSecurityIDSource(*e.UnderlyingSecurityIDSource)
+		}
+		if e.NoUnderlyingSecurityAltID != nil {
+			m.SetNoUnderlyingSecurityAltID(components.UnmarshalNoUnderlyingSecurityAltID(e.NoUnderlyingSecurityAltID))
+		}
+		if e.UnderlyingProduct != nil {
+			m.SetUnderlyingProduct(*e.UnderlyingProduct)
+		}
+		if e.UnderlyingCFICode != nil {
+			m.SetUnderlyingCFICode(*e.UnderlyingCFICode)
+		}
+		if e.UnderlyingSecurityType != nil {
+			m.SetUnderlyingSecurityType(*e.UnderlyingSecurityType)
+		}
+		if e.UnderlyingSecuritySubType != nil {
+			m.SetUnderlyingSecuritySubType(*e.UnderlyingSecuritySubType)
+		}
+		if e.UnderlyingMaturityMonthYear != nil {
+			m.SetUnderlyingMaturityMonthYear(*e.UnderlyingMaturityMonthYear)
+		}
+		if e.UnderlyingMaturityDate != nil {
+			m.SetUnderlyingMaturityDate(*e.UnderlyingMaturityDate)
+		}
+		if e.UnderlyingCouponPaymentDate != nil {
+			m.SetUnderlyingCouponPaymentDate(*e.UnderlyingCouponPaymentDate)
+		}
+		if e.UnderlyingIssueDate != nil {
+			m.SetUnderlyingIssueDate(*e.UnderlyingIssueDate)
+		}
+		if e.UnderlyingRepoCollateralSecurityType != nil {
+			m.SetUnderlyingRepoCollateralSecurityType(*e.UnderlyingRepoCollateralSecurityType)
+		}
+		if e.UnderlyingRepurchaseTerm != nil {
+			m.SetUnderlyingRepurchaseTerm(*e.UnderlyingRepurchaseTerm)
+		}
+		if e.UnderlyingRepurchaseRate != nil {
+			m.SetUnderlyingRepurchaseRate(*e.UnderlyingRepurchaseRate, fix44.DecimalScale(*e.UnderlyingRepurchaseRate))
+		}
+		if e.UnderlyingFactor != nil {
+			m.SetUnderlyingFactor(*e.UnderlyingFactor, fix44.DecimalScale(*e.UnderlyingFactor))
+		}
+		if e.UnderlyingCreditRating != nil {
+			m.SetUnderlyingCreditRating(*e.UnderlyingCreditRating)
+		}
+		if e.UnderlyingInstrRegistry != nil {
+			m.SetUnderlyingInstrRegistry(*e.UnderlyingInstrRegistry)
+		}
+		if e.UnderlyingCountryOfIssue != nil {
+			m.SetUnderlyingCountryOfIssue(*e.UnderlyingCountryOfIssue)
+		}
+		if e.UnderlyingStateOrProvinceOfIssue != nil {
+			m.SetUnderlyingStateOrProvinceOfIssue(*e.UnderlyingStateOrProvinceOfIssue)
+		}
+		if e.UnderlyingLocaleOfIssue != nil {
+			m.SetUnderlyingLocaleOfIssue(*e.UnderlyingLocaleOfIssue)
+		}
+		if e.UnderlyingRedemptionDate != nil {
+			m.SetUnderlyingRedemptionDate(*e.UnderlyingRedemptionDate)
+		}
+		if e.UnderlyingStrikePrice != nil {
+			m.SetUnderlyingStrikePrice(*e.UnderlyingStrikePrice, fix44.DecimalScale(*e.UnderlyingStrikePrice))
+		}
+		if e.UnderlyingStrikeCurrency != nil {
+			m.SetUnderlyingStrikeCurrency(*e.UnderlyingStrikeCurrency)
+		}
+		if e.UnderlyingOptAttribute != nil {
+			m.SetUnderlyingOptAttribute(*e.UnderlyingOptAttribute)
+		}
+		if e.UnderlyingContractMultiplier != nil {
+			m.SetUnderlyingContractMultiplier(*e.UnderlyingContractMultiplier, fix44.DecimalScale(*e.UnderlyingContractMultiplier))
+		}
+		if e.UnderlyingCouponRate != nil {
+			m.SetUnderlyingCouponRate(*e.UnderlyingCouponRate, fix44.DecimalScale(*e.UnderlyingCouponRate))
+		}
+		if e.UnderlyingSecurityExchange != nil {
+			m.SetUnderlyingSecurityExchange(*e.UnderlyingSecurityExchange)
+		}
+		if e.UnderlyingIssuer != nil {
+			m.SetUnderlyingIssuer(*e.UnderlyingIssuer)
+		}
+		if e.EncodedUnderlyingIssuerLen != nil {
+			m.SetEncodedUnderlyingIssuerLen(*e.EncodedUnderlyingIssuerLen)
+		}
+		if e.EncodedUnderlyingIssuer != nil {
+			m.SetEncodedUnderlyingIssuer(*e.EncodedUnderlyingIssuer)
+		}
+		if e.UnderlyingSecurityDesc != nil {
+			m.SetUnderlyingSecurityDesc(*e.UnderlyingSecurityDesc)
+		}
+		if e.EncodedUnderlyingSecurityDescLen != nil {
+			m.SetEncodedUnderlyingSecurityDescLen(*e.EncodedUnderlyingSecurityDescLen)
+		}
+		if e.EncodedUnderlyingSecurityDesc != nil {
+			m.SetEncodedUnderlyingSecurityDesc(*e.EncodedUnderlyingSecurityDesc)
+		}
+		if e.UnderlyingCPProgram != nil {
+			m.SetUnderlyingCPProgram(*e.UnderlyingCPProgram)
+		}
+		if e.UnderlyingCPRegType != nil {
+			m.SetUnderlyingCPRegType(*e.UnderlyingCPRegType)
+		}
+		if e.UnderlyingCurrency != nil {
+			m.SetUnderlyingCurrency(*e.UnderlyingCurrency)
+		}
+		if e.UnderlyingQty != nil {
+			m.SetUnderlyingQty(*e.UnderlyingQty, fix44.DecimalScale(*e.UnderlyingQty))
+		}
+		if e.UnderlyingPx != nil {
+			m.SetUnderlyingPx(*e.UnderlyingPx, fix44.DecimalScale(*e.UnderlyingPx))
+		}
+		if e.UnderlyingDirtyPrice != nil {
+			m.SetUnderlyingDirtyPrice(*e.UnderlyingDirtyPrice, fix44.DecimalScale(*e.UnderlyingDirtyPrice))
+		}
+		if e.UnderlyingEndPrice != nil {
+			m.SetUnderlyingEndPrice(*e.UnderlyingEndPrice, fix44.DecimalScale(*e.UnderlyingEndPrice))
+		}
+		if e.UnderlyingStartValue != nil {
+			m.SetUnderlyingStartValue(*e.UnderlyingStartValue, fix44.DecimalScale(*e.UnderlyingStartValue))
+		}
+		if e.UnderlyingCurrentValue != nil {
+			m.SetUnderlyingCurrentValue(*e.UnderlyingCurrentValue, fix44.DecimalScale(*e.UnderlyingCurrentValue))
+		}
+		if e.UnderlyingEndValue != nil {
+			m.SetUnderlyingEndValue(*e.UnderlyingEndValue, fix44.DecimalScale(*e.UnderlyingEndValue))
+		}
+		if e.NoUnderlyingStips != nil {
+			m.SetNoUnderlyingStips(components.UnmarshalNoUnderlyingStips(e.NoUnderlyingStips))
+		}
+	}
+	return g
+}
+
+//NoLegsStruct is a plain Go representation of a NoLegs group element, optional fields are nil when absent
+type NoLegsStruct struct {
+	LegSymbol                     *string
+	LegSymbolSfx                  *string
+	LegSecurityID                 *string
+	LegSecurityIDSource           *string
+	NoLegSecurityAltID            []NoLegSecurityAltIDStruct
+	LegProduct                    *int
+	LegCFICode                    *string
+	LegSecurityType               *string
+	LegSecuritySubType            *string
+	LegMaturityMonthYear          *string
+	LegMaturityDate               *string
+	LegCouponPaymentDate          *string
+	LegIssueDate                  *string
+	LegRepoCollateralSecurityType *int
+	LegRepurchaseTerm             *int
+	LegRepurchaseRate             *decimal.Decimal
+	LegFactor                     *decimal.Decimal
+	LegCreditRating               *string
+	LegInstrRegistry              *string
+	LegCountryOfIssue             *string
+	LegStateOrProvinceOfIssue     *string
+	LegLocaleOfIssue              *string
+	LegRedemptionDate             *string
+	LegStrikePrice                *decimal.Decimal
+	LegStrikeCurrency             *string
+	LegOptAttribute               *string
+	LegContractMultiplier         *decimal.Decimal
+	LegCouponRate                 *decimal.Decimal
+	LegSecurityExchange           *string
+	LegIssuer                     *string
+	EncodedLegIssuerLen           *int
+	EncodedLegIssuer              *string
+	LegSecurityDesc               *string
+	EncodedLegSecurityDescLen     *int
+	EncodedLegSecurityDesc        *string
+	LegRatioQty                   *decimal.Decimal
+	LegSide                       *string
+	LegCurrency                   *string
+	LegPool                       *string
+	LegDatedDate                  *string
+	LegContractSettlMonth         *string
+	LegInterestAccrualDate        *string
+}
+
+//MarshalNoLegs copies the elements of g into a slice of NoLegsStruct
+func MarshalNoLegs(g NoLegsRepeatingGroup) ([]NoLegsStruct, quickfix.MessageRejectError) {
+	s := make([]NoLegsStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasLegSymbol() {
+			v, err := m.GetLegSymbol()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSymbol = &v
+		}
+		if m.HasLegSymbolSfx() {
+			v, err := m.GetLegSymbolSfx()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSymbolSfx = &v
+		}
+		if m.HasLegSecurityID() {
+			v, err := m.GetLegSecurityID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSecurityID = &v
+		}
+		if m.HasLegSecurityIDSource() {
+			v, err := m.GetLegSecurityIDSource()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSecurityIDSource = &v
+		}
+		if m.HasNoLegSecurityAltID() {
+			g, err := m.GetNoLegSecurityAltID()
+			if err != nil {
+				return nil, err
+			}
+			if s[i].NoLegSecurityAltID, err = components.MarshalNoLegSecurityAltID(g); err != nil {
+				return nil, err
+			}
+		}
+		if m.HasLegProduct() {
+			v, err := m.GetLegProduct()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegProduct = &v
+		}
+		if m.HasLegCFICode() {
+			v, err := m.GetLegCFICode()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegCFICode = &v
+		}
+		if m.HasLegSecurityType() {
+			v, err := m.GetLegSecurityType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSecurityType = &v
+		}
+		if m.HasLegSecuritySubType() {
+			v, err := m.GetLegSecuritySubType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSecuritySubType = &v
+		}
+		if m.HasLegMaturityMonthYear() {
+			v, err := m.GetLegMaturityMonthYear()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegMaturityMonthYear = &v
+		}
+		if m.HasLegMaturityDate() {
+			v, err := m.GetLegMaturityDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegMaturityDate = &v
+		}
+		if m.HasLegCouponPaymentDate() {
+			v, err := m.GetLegCouponPaymentDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegCouponPaymentDate = &v
+		}
+		if m.HasLegIssueDate() {
+			v, err := m.GetLegIssueDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegIssueDate = &v
+		}
+		if m.HasLegRepoCollateralSecurityType() {
+			v, err := m.GetLegRepoCollateralSecurityType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegRepoCollateralSecurityType = &v
+		}
+		if m.HasLegRepurchaseTerm() {
+			v, err := m.GetLegRepurchaseTerm()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegRepurchaseTerm = &v
+		}
+		if m.HasLegRepurchaseRate() {
+			v, err := m.GetLegRepurchaseRate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegRepurchaseRate = &v
+		}
+		if m.HasLegFactor() {
+			v, err := m.GetLegFactor()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegFactor = &v
+		}
+		if m.HasLegCreditRating() {
+			v, err := m.GetLegCreditRating()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegCreditRating = &v
+		}
+		if m.HasLegInstrRegistry() {
+			v, err := m.GetLegInstrRegistry()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegInstrRegistry = &v
+		}
+		if m.HasLegCountryOfIssue() {
+			v, err := m.GetLegCountryOfIssue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegCountryOfIssue = &v
+		}
+		if m.HasLegStateOrProvinceOfIssue() {
+			v, err := m.GetLegStateOrProvinceOfIssue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegStateOrProvinceOfIssue = &v
+		}
+		if m.HasLegLocaleOfIssue() {
+			v, err := m.GetLegLocaleOfIssue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegLocaleOfIssue = &v
+		}
+		if m.HasLegRedemptionDate() {
+			v, err := m.GetLegRedemptionDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegRedemptionDate = &v
+		}
+		if m.HasLegStrikePrice() {
+			v, err := m.GetLegStrikePrice()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegStrikePrice = &v
+		}
+		if m.HasLegStrikeCurrency() {
+			v, err := m.GetLegStrikeCurrency()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegStrikeCurrency = &v
+		}
+		if m.HasLegOptAttribute() {
+			v, err := m.GetLegOptAttribute()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegOptAttribute = &v
+		}
+		if m.HasLegContractMultiplier() {
+			v, err := m.GetLegContractMultiplier()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegContractMultiplier = &v
+		}
+		if m.HasLegCouponRate() {
+			v, err := m.GetLegCouponRate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegCouponRate = &v
+		}
+		if m.HasLegSecurityExchange() {
+			v, err := m.GetLegSecurityExchange()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSecurityExchange = &v
+		}
+		if m.HasLegIssuer() {
+			v, err := m.GetLegIssuer()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegIssuer = &v
+		}
+		if m.HasEncodedLegIssuerLen() {
+			v, err := m.GetEncodedLegIssuerLen()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedLegIssuerLen = &v
+		}
+		if m.HasEncodedLegIssuer() {
+			v, err := m.GetEncodedLegIssuer()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedLegIssuer = &v
+		}
+		if m.HasLegSecurityDesc() {
+			v, err := m.GetLegSecurityDesc()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSecurityDesc = &v
+		}
+		if m.HasEncodedLegSecurityDescLen() {
+			v, err := m.GetEncodedLegSecurityDescLen()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedLegSecurityDescLen = &v
+		}
+		if m.HasEncodedLegSecurityDesc() {
+			v, err := m.GetEncodedLegSecurityDesc()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedLegSecurityDesc = &v
+		}
+		if m.HasLegRatioQty() {
+			v, err := m.GetLegRatioQty()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegRatioQty = &v
+		}
+		if m.HasLegSide() {
+			v, err := m.GetLegSide()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegSide = &v
+		}
+		if m.HasLegCurrency() {
+			v, err := m.GetLegCurrency()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegCurrency = &v
+		}
+		if m.HasLegPool() {
+			v, err := m.GetLegPool()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegPool = &v
+		}
+		if m.HasLegDatedDate() {
+			v, err := m.GetLegDatedDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegDatedDate = &v
+		}
+		if m.HasLegContractSettlMonth() {
+			v, err := m.GetLegContractSettlMonth()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegContractSettlMonth = &v
+		}
+		if m.HasLegInterestAccrualDate() {
+			v, err := m.GetLegInterestAccrualDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LegInterestAccrualDate = &v
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoLegs builds a NoLegsRepeatingGroup from s
+func UnmarshalNoLegs(s []NoLegsStruct) NoLegsRepeatingGroup {
+	g := NewNoLegsRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.LegSymbol != nil {
+			m.SetLegSymbol(*e.LegSymbol)
+		}
+		if e.LegSymbolSfx != nil {
+			m.SetLegSymbolSfx(*e.LegSymbolSfx)
+		}
+		if e.LegSecurityID != nil {
+			m.SetLegSecurityID(*e.LegSecurityID)
+		}
+		if e.LegSecurityIDSource != nil {
+			m.SetLegSecurityIDSource(*e.LegSecurityIDSource)
+		}
+		if e.NoLegSecurityAltID != nil {
+			m.SetNoLegSecurityAltID(components.UnmarshalNoLegSecurityAltID(e.NoLegSecurityAltID))
+		}
+		if e.LegProduct != nil {
+			m.SetLegProduct(*e.LegProduct)
+		}
+		if e.LegCFICode != nil {
+			m.SetLegCFICode(*e.LegCFICode)
+		}
+		if e.LegSecurityType != nil {
+			m.SetLegSecurityType(*e.LegSecurityType)
+		}
+		if e.LegSecuritySubType != nil {
+			m.SetLegSecuritySubType(*e.LegSecuritySubType)
+		}
+		if e.LegMaturityMonthYear != nil {
+			m.SetLegMaturityMonthYear(*e.LegMaturityMonthYear)
+		}
+		if e.LegMaturityDate != nil {
+			m.SetLegMaturityDate(*e.LegMaturityDate)
+		}
+		if e.LegCouponPaymentDate != nil {
+			m.SetLegCouponPaymentDate(*e.LegCouponPaymentDate)
+		}
+		if e.LegIssueDate != nil {
+			m.SetLegIssueDate(*e.LegIssueDate)
+		}
+		if e.LegRepoCollateralSecurityType != nil {
+			m.SetLegRepoCollateralSecurityType(*e.LegRepoCollateralSecurityType)
+		}
+		if e.LegRepurchaseTerm != nil {
+			m.SetLegRepurchaseTerm(*e.LegRepurchaseTerm)
+		}
+		if e.LegRepurchaseRate != nil {
+			m.SetLegRepurchaseRate(*e.LegRepurchaseRate, fix44.DecimalScale(*e.LegRepurchaseRate))
+		}
+		if e.LegFactor != nil {
+			m.SetLegFactor(*e.LegFactor, fix44.DecimalScale(*e.LegFactor))
+		}
+		if e.LegCreditRating != nil {
+			m.SetLegCreditRating(*e.LegCreditRating)
+		}
+		if e.LegInstrRegistry != nil {
+			m.SetLegInstrRegistry(*e.LegInstrRegistry)
+		}
+		if e.LegCountryOfIssue != nil {
+			m.SetLegCountryOfIssue(*e.LegCountryOfIssue)
+		}
+		if e.LegStateOrProvinceOfIssue != nil {
+			m.SetLegStateOrProvinceOfIssue(*e.LegStateOrProvinceOfIssue)
+		}
+		if e.LegLocaleOfIssue != nil {
+			m.SetLegLocaleOfIssue(*e.LegLocaleOfIssue)
+		}
+		if e.LegRedemptionDate != nil {
+			m.SetLegRedemptionDate(*e.LegRedemptionDate)
+		}
+		if e.LegStrikePrice != nil {
+			m.SetLegStrikePrice(*e.LegStrikePrice, fix44.DecimalScale(*e.LegStrikePrice))
+		}
+		if e.LegStrikeCurrency != nil {
+			m.SetLegStrikeCurrency(*e.LegStrikeCurrency)
+		}
+		if e.LegOptAttribute != nil {
+			m.SetLegOptAttribute(*e.LegOptAttribute)
+		}
+		if e.LegContractMultiplier != nil {
+			m.SetLegContractMultiplier(*e.LegContractMultiplier, fix44.DecimalScale(*e.LegContractMultiplier))
+		}
+		if e.LegCouponRate != nil {
+			m.SetLegCouponRate(*e.LegCouponRate, fix44.DecimalScale(*e.LegCouponRate))
+		}
+		if e.LegSecurityExchange != nil {
+			m.SetLegSecurityExchange(*e.LegSecurityExchange)
+		}
+		if e.LegIssuer != nil {
+			m.SetLegIssuer(*e.LegIssuer)
+		}
+		if e.EncodedLegIssuerLen != nil {
+			m.SetEncodedLegIssuerLen(*e.EncodedLegIssuerLen)
+		}
+		if e.EncodedLegIssuer != nil {
+			m.SetEncodedLegIssuer(*e.EncodedLegIssuer)
+		}
+		if e.LegSecurityDesc != nil {
+			m.SetLegSecurityDesc(*e.LegSecurityDesc)
+		}
+		if e.EncodedLegSecurityDescLen != nil {
+			m.SetEncodedLegSecurityDescLen(*e.EncodedLegSecurityDescLen)
+		}
+		if e.EncodedLegSecurityDesc != nil {
+			m.SetEncodedLegSecurityDesc(*e.EncodedLegSecurityDesc)
+		}
+		if e.LegRatioQty != nil {
+			m.SetLegRatioQty(*e.LegRatioQty, fix44.DecimalScale(*e.LegRatioQty))
+		}
+		if e.LegSide != nil {
+			m.SetLegSide(*e.LegSide)
+		}
+		if e.LegCurrency != nil {
+			m.SetLegCurrency(*e.LegCurrency)
+		}
+		if e.LegPool != nil {
+			m.SetLegPool(*e.LegPool)
+		}
+		if e.LegDatedDate != nil {
+			m.SetLegDatedDate(*e.LegDatedDate)
+		}
+		if e.LegContractSettlMonth != nil {
+			m.SetLegContractSettlMonth(*e.LegContractSettlMonth)
+		}
+		if e.LegInterestAccrualDate != nil {
+			m.SetLegInterestAccrualDate(*e.LegInterestAccrualDate)
+		}
+	}
+	return g
+}
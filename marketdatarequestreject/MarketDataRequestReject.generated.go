@@ -55,6 +55,26 @@ func Route(router RouteOut) (string, string, quickfix.MessageRoute) {
 	return "FIX.4.4", "Y", r
 }
 
+//Validate checks m against the FIX 4.4 definition of MarketDataRequestReject, see fix44.MessageDef.Validate
+func (m MarketDataRequestReject) Validate() fix44.ValidationErrors {
+	return fix44.MessageDefs["Y"].Validate(&m.Body.FieldMap)
+}
+
+//MarshalJSON encodes the MarketDataRequestReject as JSON with fields keyed by name, see fix44.MarshalMessageJSON
+func (m MarketDataRequestReject) MarshalJSON() ([]byte, error) {
+	return fix44.MarshalMessageJSON(m.Message)
+}
+
+//UnmarshalJSON builds the MarketDataRequestReject from JSON produced by MarshalJSON
+func (m *MarketDataRequestReject) UnmarshalJSON(b []byte) error {
+	msg, err := fix44.UnmarshalMessageJSON(b, "Y")
+	if err != nil {
+		return err
+	}
+	*m = FromMessage(msg)
+	return nil
+}
+
 //SetText sets Text, Tag 58
 func (m MarketDataRequestReject) SetText(v string) {
 	m.Set(field.NewText(v))
@@ -162,6 +182,21 @@ func (m MarketDataRequestReject) HasEncodedText() bool {
 	return m.Has(tag.EncodedText)
 }
 
+//SetEncodedTextBytes sets EncodedText, Tag 355, and EncodedTextLen, Tag 354, from v, which may hold any bytes including SOH
+func (m MarketDataRequestReject) SetEncodedTextBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedTextLen, tag.EncodedText, v)
+}
+
+//GetEncodedTextBytes gets EncodedText, Tag 355, checking its length against EncodedTextLen, Tag 354
+func (m MarketDataRequestReject) GetEncodedTextBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedTextLen, tag.EncodedText)
+}
+
+//GetEncodedTextDecoded gets EncodedText, Tag 355, decoded to UTF-8 using the MessageEncoding of the Header
+func (m MarketDataRequestReject) GetEncodedTextDecoded() (string, error) {
+	return fix44.DecodeRawData(m.Header, m, tag.EncodedTextLen, tag.EncodedText)
+}
+
 //HasNoAltMDSource returns true if NoAltMDSource is present, Tag 816
 func (m MarketDataRequestReject) HasNoAltMDSource() bool {
 	return m.Has(tag.NoAltMDSource)
@@ -213,3 +248,117 @@ func (m NoAltMDSourceRepeatingGroup) Add() NoAltMDSource {
 func (m NoAltMDSourceRepeatingGroup) Get(i int) NoAltMDSource {
 	return NoAltMDSource{m.RepeatingGroup.Get(i)}
 }
+
+//Struct is a plain Go representation of the MarketDataRequestReject body, optional fields are nil when absent
+type Struct struct {
+	Text           *string
+	MDReqID        string
+	MDReqRejReason *enum.MDReqRejReason
+	EncodedTextLen *int
+	EncodedText    *string
+	NoAltMDSource  []NoAltMDSourceStruct
+}
+
+//Marshal copies the fields present in msg into a Struct
+func Marshal(msg MarketDataRequestReject) (Struct, quickfix.MessageRejectError) {
+	var s Struct
+	if msg.HasText() {
+		v, err := msg.GetText()
+		if err != nil {
+			return s, err
+		}
+		s.Text = &v
+	}
+	if msg.HasMDReqID() {
+		v, err := msg.GetMDReqID()
+		if err != nil {
+			return s, err
+		}
+		s.MDReqID = v
+	}
+	if msg.HasMDReqRejReason() {
+		v, err := msg.GetMDReqRejReason()
+		if err != nil {
+			return s, err
+		}
+		s.MDReqRejReason = &v
+	}
+	if msg.HasEncodedTextLen() {
+		v, err := msg.GetEncodedTextLen()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedTextLen = &v
+	}
+	if msg.HasEncodedText() {
+		v, err := msg.GetEncodedText()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedText = &v
+	}
+	if msg.HasNoAltMDSource() {
+		g, err := msg.GetNoAltMDSource()
+		if err != nil {
+			return s, err
+		}
+		if s.NoAltMDSource, err = MarshalNoAltMDSource(g); err != nil {
+			return s, err
+		}
+	}
+	return s, nil
+}
+
+//Unmarshal builds a MarketDataRequestReject from s
+func Unmarshal(s Struct) MarketDataRequestReject {
+	m := New(field.NewMDReqID(s.MDReqID))
+	if s.Text != nil {
+		m.SetText(*s.Text)
+	}
+	if s.MDReqRejReason != nil {
+		m.SetMDReqRejReason(*s.MDReqRejReason)
+	}
+	if s.EncodedTextLen != nil {
+		m.SetEncodedTextLen(*s.EncodedTextLen)
+	}
+	if s.EncodedText != nil {
+		m.SetEncodedText(*s.EncodedText)
+	}
+	if s.NoAltMDSource != nil {
+		m.SetNoAltMDSource(UnmarshalNoAltMDSource(s.NoAltMDSource))
+	}
+	return m
+}
+
+//NoAltMDSourceStruct is a plain Go representation of a NoAltMDSource group element, optional fields are nil when absent
+type NoAltMDSourceStruct struct {
+	AltMDSourceID *string
+}
+
+//MarshalNoAltMDSource copies the elements of g into a slice of NoAltMDSourceStruct
+func MarshalNoAltMDSource(g NoAltMDSourceRepeatingGroup) ([]NoAltMDSourceStruct, quickfix.MessageRejectError) {
+	s := make([]NoAltMDSourceStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasAltMDSourceID() {
+			v, err := m.GetAltMDSourceID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].AltMDSourceID = &v
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoAltMDSource builds a NoAltMDSourceRepeatingGroup from s
+func UnmarshalNoAltMDSource(s []NoAltMDSourceStruct) NoAltMDSourceRepeatingGroup {
+	g := NewNoAltMDSourceRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.AltMDSourceID != nil {
+			m.SetAltMDSourceID(*e.AltMDSourceID)
+		}
+	}
+	return g
+}
@@ -0,0 +1,11 @@
+/*
+Package massquoting manages the two-sided quotes of a market maker sent with MassQuote.
+
+A Manager sends quote sets with Send, splitting sets that are larger than MaxEntries into fragments that carry
+TotNoQuoteEntries and LastFragment, and keeps every QuoteSetID / QuoteEntryID it has sent. The
+MassQuoteAcknowledgement messages given to OnAck accept or reject the pending entries, per entry through
+QuoteEntryRejectReason or for the whole MassQuote through QuoteStatus, so that Book always returns the quotes that
+are live at the counterparty. CancelSymbols, CancelSecurityType and CancelAll send QuoteCancel messages and drop the
+matching quotes once the cancel is acknowledged.
+*/
+package massquoting
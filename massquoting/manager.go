@@ -0,0 +1,308 @@
+package massquoting
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/terracefi/enum"
+	"github.com/terracefi/field"
+	"github.com/terracefi/fix44/internal/fixutil"
+	"github.com/terracefi/fix44/massquote"
+	"github.com/terracefi/fix44/massquoteacknowledgement"
+	"github.com/terracefi/fix44/quotecancel"
+	"github.com/terracefi/quickfix"
+)
+
+//Manager sends mass quotes and cancels on one session and tracks the resulting quote book. It is safe for concurrent
+//use.
+type Manager struct {
+	//MaxEntries is the largest number of quote entries sent in one MassQuote, zero for no limit
+	MaxEntries int
+
+	//SendMessage sends the MassQuote and QuoteCancel messages, by default on the session given to New
+	SendMessage func(msg quickfix.Messagable) error
+
+	mu      sync.Mutex
+	quotes  map[quoteKey]*Quote
+	cancels map[string]cancel
+}
+
+type cancel struct {
+	cancelType    enum.QuoteCancelType
+	symbols       []string
+	securityTypes []enum.SecurityType
+}
+
+//New returns a Manager that sends on sessionID
+func New(sessionID quickfix.SessionID, maxEntries int) *Manager {
+	return &Manager{
+		MaxEntries:  maxEntries,
+		SendMessage: fixutil.SendOn(sessionID),
+		quotes:      make(map[quoteKey]*Quote),
+		cancels:     make(map[string]cancel),
+	}
+}
+
+//Send sends the quote sets with QuoteID quoteID, in as many MassQuote messages as MaxEntries requires, and marks
+//their entries pending. A set split over several messages carries its total number of entries in TotNoQuoteEntries
+//and LastFragment = Y on its last fragment. The entries of the messages that cannot be sent are not left pending.
+func (m *Manager) Send(quoteID string, sets []massquote.NoQuoteSetsStruct) error {
+	msgs := fragment(sets, m.MaxEntries)
+
+	m.mu.Lock()
+	for _, s := range sets {
+		for _, e := range s.NoQuoteEntries {
+			e := e
+			q := m.quote(fixutil.Deref(s.QuoteSetID), fixutil.Deref(e.QuoteEntryID))
+			q.Pending = &e
+			q.PendingQuoteID = quoteID
+		}
+	}
+	m.mu.Unlock()
+
+	for i, fragments := range msgs {
+		msg := massquote.New(field.NewQuoteID(quoteID))
+		msg.SetNoQuoteSets(massquote.UnmarshalNoQuoteSets(fragments))
+		if err := m.SendMessage(msg); err != nil {
+			m.unsent(quoteID, msgs[i:])
+			return err
+		}
+	}
+	return nil
+}
+
+//unsent drops the pending entries of the messages of quoteID that could not be sent, and the quotes that only had
+//such an entry
+func (m *Manager) unsent(quoteID string, msgs [][]massquote.NoQuoteSetsStruct) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, sets := range msgs {
+		for _, s := range sets {
+			for _, e := range s.NoQuoteEntries {
+				k := quoteKey{fixutil.Deref(s.QuoteSetID), fixutil.Deref(e.QuoteEntryID)}
+				q, ok := m.quotes[k]
+				if !ok || q.PendingQuoteID != quoteID {
+					continue
+				}
+				q.Pending, q.PendingQuoteID = nil, ""
+				if q.Live == nil && q.RejectReason == nil {
+					delete(m.quotes, k)
+				}
+			}
+		}
+	}
+}
+
+//fragment splits sets into the NoQuoteSets of successive messages of at most max entries each
+func fragment(sets []massquote.NoQuoteSetsStruct, max int) [][]massquote.NoQuoteSetsStruct {
+	var msgs [][]massquote.NoQuoteSetsStruct
+	var cur []massquote.NoQuoteSetsStruct
+	room := max
+
+	for _, s := range sets {
+		total := len(s.NoQuoteEntries)
+		entries := s.NoQuoteEntries
+		split := max > 0 && total > room
+		for {
+			n := len(entries)
+			if max > 0 && n > room {
+				n = room
+			}
+
+			f := s
+			f.NoQuoteEntries = entries[:n]
+			if split {
+				tot, last := total, n == len(entries)
+				f.TotNoQuoteEntries = &tot
+				f.LastFragment = &last
+			}
+			cur = append(cur, f)
+			entries = entries[n:]
+			room -= n
+
+			if max > 0 && room == 0 {
+				msgs = append(msgs, cur)
+				cur, room = nil, max
+			}
+			if len(entries) == 0 {
+				break
+			}
+		}
+	}
+	if len(cur) > 0 {
+		msgs = append(msgs, cur)
+	}
+	return msgs
+}
+
+//OnAck applies a MassQuoteAcknowledgement. Entries listed with a QuoteEntryRejectReason are rejected, other listed
+//entries are accepted, and the entries of the MassQuote that are not listed are accepted or rejected according to
+//QuoteStatus. An ack of a QuoteCancel removes the cancelled quotes from the book.
+func (m *Manager) OnAck(msg massquoteacknowledgement.MassQuoteAcknowledgement) error {
+	ack, err := massquoteacknowledgement.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	quoteID := fixutil.Deref(ack.QuoteID)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if c, ok := m.cancels[quoteID]; ok {
+		delete(m.cancels, quoteID)
+		if ack.QuoteStatus != StatusRejected {
+			m.applyCancel(c)
+		}
+		return nil
+	}
+
+	for _, s := range ack.NoQuoteSets {
+		for _, e := range s.NoQuoteEntries {
+			q, ok := m.quotes[quoteKey{fixutil.Deref(s.QuoteSetID), fixutil.Deref(e.QuoteEntryID)}]
+			if !ok || q.PendingQuoteID != quoteID {
+				continue
+			}
+			if e.QuoteEntryRejectReason != nil {
+				q.reject(e.QuoteEntryRejectReason)
+			} else {
+				q.accept()
+			}
+		}
+	}
+
+	switch ack.QuoteStatus {
+	case StatusAccepted, StatusRejected:
+		for _, q := range m.quotes {
+			if q.PendingQuoteID != quoteID {
+				continue
+			}
+			if ack.QuoteStatus == StatusAccepted {
+				q.accept()
+			} else {
+				q.reject(nil)
+			}
+		}
+	case StatusCanceledAll:
+		m.applyCancel(cancel{cancelType: CancelAllQuotes})
+	}
+	return nil
+}
+
+//CancelSymbols sends a QuoteCancel for the quotes on the given symbols
+func (m *Manager) CancelSymbols(quoteID string, symbols ...string) error {
+	return m.sendCancel(quoteID, cancel{cancelType: CancelForSymbols, symbols: symbols})
+}
+
+//CancelSecurityType sends a QuoteCancel for the quotes on the given security types
+func (m *Manager) CancelSecurityType(quoteID string, securityTypes ...enum.SecurityType) error {
+	return m.sendCancel(quoteID, cancel{cancelType: CancelForSecurityType, securityTypes: securityTypes})
+}
+
+//CancelAll sends a QuoteCancel for all quotes
+func (m *Manager) CancelAll(quoteID string) error {
+	return m.sendCancel(quoteID, cancel{cancelType: CancelAllQuotes})
+}
+
+func (m *Manager) sendCancel(quoteID string, c cancel) error {
+	msg := quotecancel.New(field.NewQuoteID(quoteID), field.NewQuoteCancelType(c.cancelType))
+
+	g := quotecancel.NewNoQuoteEntriesRepeatingGroup()
+	for _, s := range c.symbols {
+		g.Add().SetSymbol(s)
+	}
+	for _, t := range c.securityTypes {
+		g.Add().SetSecurityType(t)
+	}
+	if g.Len() > 0 {
+		msg.SetNoQuoteEntries(g)
+	}
+
+	m.mu.Lock()
+	m.cancels[quoteID] = c
+	m.mu.Unlock()
+
+	if err := m.SendMessage(msg); err != nil {
+		m.mu.Lock()
+		delete(m.cancels, quoteID)
+		m.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+func (m *Manager) applyCancel(c cancel) {
+	for k, q := range m.quotes {
+		if c.selects(q) {
+			delete(m.quotes, k)
+		}
+	}
+}
+
+//selects returns true if q is one of the quotes cancelled by c
+func (c cancel) selects(q *Quote) bool {
+	e := q.entry()
+	if e == nil {
+		return false
+	}
+
+	switch c.cancelType {
+	case CancelAllQuotes:
+		return true
+	case CancelForSymbols:
+		for _, s := range c.symbols {
+			if e.Symbol != nil && *e.Symbol == s {
+				return true
+			}
+		}
+	case CancelForSecurityType:
+		for _, t := range c.securityTypes {
+			if e.SecurityType != nil && *e.SecurityType == t {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+//Quote returns the state of the given QuoteSetID / QuoteEntryID
+func (m *Manager) Quote(quoteSetID, quoteEntryID string) (Quote, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	q, ok := m.quotes[quoteKey{quoteSetID, quoteEntryID}]
+	if !ok {
+		return Quote{}, false
+	}
+	return *q, true
+}
+
+//Book returns the quotes that are live at the counterparty, ordered by QuoteSetID and QuoteEntryID
+func (m *Manager) Book() []Quote {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var book []Quote
+	for _, q := range m.quotes {
+		if q.Live != nil {
+			book = append(book, *q)
+		}
+	}
+	sort.Slice(book, func(i, j int) bool {
+		if book[i].QuoteSetID != book[j].QuoteSetID {
+			return book[i].QuoteSetID < book[j].QuoteSetID
+		}
+		return book[i].QuoteEntryID < book[j].QuoteEntryID
+	})
+	return book
+}
+
+func (m *Manager) quote(setID, entryID string) *Quote {
+	k := quoteKey{setID, entryID}
+	q, ok := m.quotes[k]
+	if !ok {
+		q = &Quote{QuoteSetID: setID, QuoteEntryID: entryID}
+		m.quotes[k] = q
+	}
+	return q
+}
@@ -0,0 +1,232 @@
+package massquoting
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/terracefi/enum"
+	"github.com/terracefi/fix44/internal/fixutil"
+	"github.com/terracefi/fix44/internal/testutil"
+	"github.com/terracefi/fix44/massquote"
+	"github.com/terracefi/fix44/massquoteacknowledgement"
+	"github.com/terracefi/quickfix"
+)
+
+//set returns a quote set with entries named setID1, setID2, ... on the given symbol
+func set(setID, symbol string, entries int) massquote.NoQuoteSetsStruct {
+	s := massquote.NoQuoteSetsStruct{QuoteSetID: fixutil.Ptr(setID)}
+	for i := 1; i <= entries; i++ {
+		s.NoQuoteEntries = append(s.NoQuoteEntries, massquote.NoQuoteEntriesStruct{
+			QuoteEntryID: fixutil.Ptr(fmt.Sprintf("%v%v", setID, i)),
+			Symbol:       fixutil.Ptr(symbol),
+		})
+	}
+	return s
+}
+
+func newManager(maxEntries, failAt int) (*Manager, *testutil.Sender) {
+	m := New(quickfix.SessionID{}, maxEntries)
+	s := &testutil.Sender{FailAt: failAt}
+	m.SendMessage = s.Send
+	return m, s
+}
+
+//describe lists the sets of a sent MassQuote as "setID:entries" or "setID:entries/total" followed by Y or N for
+//LastFragment
+func describe(t *testing.T, msg quickfix.Messagable) []string {
+	q, err := massquote.Marshal(msg.(massquote.MassQuote))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var d []string
+	for _, s := range q.NoQuoteSets {
+		v := fmt.Sprintf("%v:%v", *s.QuoteSetID, len(s.NoQuoteEntries))
+		if s.TotNoQuoteEntries != nil {
+			last := "N"
+			if s.LastFragment != nil && *s.LastFragment {
+				last = "Y"
+			}
+			v += fmt.Sprintf("/%v%v", *s.TotNoQuoteEntries, last)
+		}
+		d = append(d, v)
+	}
+	return d
+}
+
+func TestSendFragments(t *testing.T) {
+	tests := []struct {
+		name       string
+		maxEntries int
+		sets       []massquote.NoQuoteSetsStruct
+		want       [][]string
+	}{
+		{"no limit", 0, []massquote.NoQuoteSetsStruct{set("A", "X", 3), set("B", "X", 3)},
+			[][]string{{"A:3", "B:3"}}},
+		{"fits", 6, []massquote.NoQuoteSetsStruct{set("A", "X", 3), set("B", "X", 3)},
+			[][]string{{"A:3", "B:3"}}},
+		{"set per message", 3, []massquote.NoQuoteSetsStruct{set("A", "X", 3), set("B", "X", 3)},
+			[][]string{{"A:3"}, {"B:3"}}},
+		{"set split across messages", 3, []massquote.NoQuoteSetsStruct{set("A", "X", 2), set("B", "X", 2)},
+			[][]string{{"A:2", "B:1/2N"}, {"B:1/2Y"}}},
+		{"set split in three", 2, []massquote.NoQuoteSetsStruct{set("A", "X", 5)},
+			[][]string{{"A:2/5N"}, {"A:2/5N"}, {"A:1/5Y"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, s := newManager(tt.maxEntries, 0)
+			if err := m.Send("Q1", tt.sets); err != nil {
+				t.Fatal(err)
+			}
+			var got [][]string
+			for _, msg := range s.Sent {
+				got = append(got, describe(t, msg))
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("sent %v, want %v", got, tt.want)
+			}
+			for _, q := range []string{"A1", "A2"} {
+				if quote, ok := m.Quote("A", q); !ok || quote.PendingQuoteID != "Q1" {
+					t.Errorf("Quote(A, %v) = %+v, %v, want pending on Q1", q, quote, ok)
+				}
+			}
+		})
+	}
+}
+
+func ack(quoteID string, status enum.QuoteStatus,
+	rejected ...string) massquoteacknowledgement.MassQuoteAcknowledgement {
+	a := massquoteacknowledgement.Struct{QuoteID: fixutil.Ptr(quoteID), QuoteStatus: status}
+	for _, id := range rejected {
+		a.NoQuoteSets = append(a.NoQuoteSets, massquoteacknowledgement.NoQuoteSetsStruct{
+			QuoteSetID: fixutil.Ptr(id[:1]),
+			NoQuoteEntries: []massquoteacknowledgement.NoQuoteEntriesStruct{{
+				QuoteEntryID:           fixutil.Ptr(id),
+				QuoteEntryRejectReason: fixutil.Ptr(enum.QuoteEntryRejectReason("1")),
+			}},
+		})
+	}
+	return massquoteacknowledgement.Unmarshal(a)
+}
+
+func TestOnAck(t *testing.T) {
+	tests := []struct {
+		name string
+		ack  massquoteacknowledgement.MassQuoteAcknowledgement
+		want []string
+	}{
+		{"accepted", ack("Q1", StatusAccepted), []string{"live", "live"}},
+		{"rejected", ack("Q1", StatusRejected), []string{"dropped", "dropped"}},
+		{"one entry rejected", ack("Q1", StatusAccepted, "A2"), []string{"live", "rejected"}},
+		{"only the listed entry", ack("Q1", "16", "A2"), []string{"pending", "rejected"}},
+		{"other QuoteID", ack("Q2", StatusAccepted), []string{"pending", "pending"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, _ := newManager(0, 0)
+			if err := m.Send("Q1", []massquote.NoQuoteSetsStruct{set("A", "X", 2)}); err != nil {
+				t.Fatal(err)
+			}
+			if err := m.OnAck(tt.ack); err != nil {
+				t.Fatal(err)
+			}
+
+			var got []string
+			for _, id := range []string{"A1", "A2"} {
+				q, _ := m.Quote("A", id)
+				switch {
+				case q.Live != nil:
+					got = append(got, "live")
+				case q.Pending != nil:
+					got = append(got, "pending")
+				case q.RejectReason != nil:
+					got = append(got, "rejected")
+				default:
+					got = append(got, "dropped")
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("quotes %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSendError(t *testing.T) {
+	m, s := newManager(2, 0)
+	m.Send("Q1", []massquote.NoQuoteSetsStruct{set("A", "X", 2)})
+	m.OnAck(ack("Q1", StatusAccepted))
+
+	s.FailAt = len(s.Sent) + 2
+	err := m.Send("Q2", []massquote.NoQuoteSetsStruct{set("A", "X", 2), set("B", "X", 2)})
+	if err != testutil.ErrSend {
+		t.Fatalf("Send() error = %v, want %v", err, testutil.ErrSend)
+	}
+
+	tests := []struct {
+		setID, entryID string
+		found          bool
+		live           bool
+		pending        bool
+	}{
+		{"A", "A1", true, true, true},
+		{"A", "A2", true, true, true},
+		{"B", "B1", false, false, false},
+		{"B", "B2", false, false, false},
+	}
+	for _, tt := range tests {
+		q, ok := m.Quote(tt.setID, tt.entryID)
+		if ok != tt.found || (q.Live != nil) != tt.live || (q.Pending != nil) != tt.pending {
+			t.Errorf("Quote(%v, %v) = %+v, %v, want found %v, live %v, pending %v", tt.setID, tt.entryID, q, ok,
+				tt.found, tt.live, tt.pending)
+		}
+	}
+
+	s.FailAt = len(s.Sent) + 1
+	m.Send("Q3", []massquote.NoQuoteSetsStruct{set("A", "X", 2)})
+	if q, _ := m.Quote("A", "A1"); q.Live == nil || q.Pending != nil {
+		t.Errorf("Quote(A, A1) = %+v, want live and not pending after a failed replace", q)
+	}
+}
+
+func TestCancel(t *testing.T) {
+	tests := []struct {
+		name   string
+		cancel func(m *Manager) error
+		status enum.QuoteStatus
+		failAt int
+		want   []string
+	}{
+		{"symbol", func(m *Manager) error { return m.CancelSymbols("C1", "X") }, StatusAccepted, 0, []string{"B1"}},
+		{"security type", func(m *Manager) error { return m.CancelSecurityType("C1", "CS") }, StatusAccepted, 0,
+			[]string{"A1"}},
+		{"all", func(m *Manager) error { return m.CancelAll("C1") }, StatusAccepted, 0, nil},
+		{"rejected", func(m *Manager) error { return m.CancelAll("C1") }, StatusRejected, 0, []string{"A1", "B1"}},
+		{"not sent", func(m *Manager) error { return m.CancelAll("C1") }, StatusAccepted, 1, []string{"A1", "B1"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, s := newManager(0, 0)
+			b := set("B", "Y", 1)
+			b.NoQuoteEntries[0].SecurityType = fixutil.Ptr(enum.SecurityType("CS"))
+			m.Send("Q1", []massquote.NoQuoteSetsStruct{set("A", "X", 1), b})
+			m.OnAck(ack("Q1", StatusAccepted))
+
+			if tt.failAt > 0 {
+				s.FailAt = len(s.Sent) + tt.failAt
+			}
+			if err := tt.cancel(m); (err != nil) != (tt.failAt > 0) {
+				t.Fatalf("cancel error = %v", err)
+			}
+			m.OnAck(ack("C1", tt.status))
+
+			var got []string
+			for _, q := range m.Book() {
+				got = append(got, q.QuoteEntryID)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Book() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
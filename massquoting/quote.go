@@ -0,0 +1,68 @@
+package massquoting
+
+import (
+	"github.com/terracefi/enum"
+	"github.com/terracefi/fix44/massquote"
+)
+
+//QuoteStatus values, FIX 4.4
+const (
+	StatusAccepted             enum.QuoteStatus = "0"
+	StatusCanceledForSymbol    enum.QuoteStatus = "1"
+	StatusCanceledSecurityType enum.QuoteStatus = "2"
+	StatusCanceledUnderlying   enum.QuoteStatus = "3"
+	StatusCanceledAll          enum.QuoteStatus = "4"
+	StatusRejected             enum.QuoteStatus = "5"
+)
+
+//QuoteCancelType values, FIX 4.4
+const (
+	CancelForSymbols      enum.QuoteCancelType = "1"
+	CancelForSecurityType enum.QuoteCancelType = "2"
+	CancelForUnderlying   enum.QuoteCancelType = "3"
+	CancelAllQuotes       enum.QuoteCancelType = "4"
+)
+
+//Quote is the state of one QuoteSetID / QuoteEntryID
+type Quote struct {
+	QuoteSetID   string
+	QuoteEntryID string
+
+	//Live is the entry accepted by the counterparty, nil until an accepting ack has been received
+	Live *massquote.NoQuoteEntriesStruct
+
+	//Pending is the entry sent with the MassQuote PendingQuoteID and awaiting its ack, if any
+	Pending        *massquote.NoQuoteEntriesStruct
+	PendingQuoteID string
+
+	//RejectReason is set when the last entry sent was rejected
+	RejectReason *enum.QuoteEntryRejectReason
+}
+
+type quoteKey struct {
+	setID   string
+	entryID string
+}
+
+func (q *Quote) accept() {
+	if q.Pending != nil {
+		q.Live = q.Pending
+	}
+	q.Pending = nil
+	q.PendingQuoteID = ""
+	q.RejectReason = nil
+}
+
+func (q *Quote) reject(reason *enum.QuoteEntryRejectReason) {
+	q.Pending = nil
+	q.PendingQuoteID = ""
+	q.RejectReason = reason
+}
+
+//entry returns the live entry of q, or the pending one if q is not live yet
+func (q *Quote) entry() *massquote.NoQuoteEntriesStruct {
+	if q.Live != nil {
+		return q.Live
+	}
+	return q.Pending
+}
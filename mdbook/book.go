@@ -0,0 +1,184 @@
+package mdbook
+
+import (
+	"github.com/shopspring/decimal"
+	"github.com/terracefi/enum"
+)
+
+//MDEntryType values, FIX 4.4
+const (
+	EntryBid   enum.MDEntryType = "0"
+	EntryOffer enum.MDEntryType = "1"
+	EntryTrade enum.MDEntryType = "2"
+)
+
+//MDUpdateAction values. DeleteThru and DeleteFrom were added after FIX 4.4.
+const (
+	ActionNew        enum.MDUpdateAction = "0"
+	ActionChange     enum.MDUpdateAction = "1"
+	ActionDelete     enum.MDUpdateAction = "2"
+	ActionDeleteThru enum.MDUpdateAction = "3"
+	ActionDeleteFrom enum.MDUpdateAction = "4"
+)
+
+//Entry is a market data entry. In a Book side it is an order when ID is set and a price level otherwise.
+type Entry struct {
+	ID             string
+	Price          decimal.Decimal
+	Size           decimal.Decimal
+	NumberOfOrders int
+	Date           string
+	Time           string
+}
+
+//Level is the aggregate of the entries of a Book side at one price
+type Level struct {
+	Price          decimal.Decimal
+	Size           decimal.Decimal
+	NumberOfOrders int
+}
+
+//Trade is a trade entry of an incremental refresh
+type Trade struct {
+	Entry
+	Buyer  string
+	Seller string
+}
+
+//Book is the order book of one instrument on one market data subscription
+type Book struct {
+	MDReqID string
+	Symbol  string
+
+	//Bids and Offers hold the entries of each side, best first
+	Bids   []Entry
+	Offers []Entry
+
+	//Stats holds the latest entry of every MDEntryType other than bids and offers
+	Stats map[enum.MDEntryType]Entry
+
+	//RptSeq is the RptSeq of the last incremental entry applied, zero if none has been applied since the last snapshot
+	RptSeq int
+	//Stale is true after a RptSeq gap, until the next snapshot
+	Stale bool
+}
+
+func newBook(mdReqID, symbol string) *Book {
+	return &Book{MDReqID: mdReqID, Symbol: symbol, Stats: make(map[enum.MDEntryType]Entry)}
+}
+
+func (b *Book) reset() {
+	b.Bids = nil
+	b.Offers = nil
+	b.Stats = make(map[enum.MDEntryType]Entry)
+	b.RptSeq = 0
+	b.Stale = false
+}
+
+//Top returns the best bid and offer levels, nil for an empty side
+func (b *Book) Top() (bid, offer *Level) {
+	if l := levels(b.Bids, 1); len(l) > 0 {
+		bid = &l[0]
+	}
+	if l := levels(b.Offers, 1); len(l) > 0 {
+		offer = &l[0]
+	}
+	return
+}
+
+//Depth returns up to n levels of each side, best first. All levels are returned if n is not positive.
+func (b *Book) Depth(n int) (bids, offers []Level) {
+	return levels(b.Bids, n), levels(b.Offers, n)
+}
+
+func levels(entries []Entry, n int) []Level {
+	var l []Level
+	for _, e := range entries {
+		orders := e.NumberOfOrders
+		if orders == 0 {
+			orders = 1
+		}
+
+		if len(l) > 0 && l[len(l)-1].Price.Equal(e.Price) {
+			last := &l[len(l)-1]
+			last.Size = last.Size.Add(e.Size)
+			last.NumberOfOrders += orders
+			continue
+		}
+		if n > 0 && len(l) == n {
+			break
+		}
+		l = append(l, Level{Price: e.Price, Size: e.Size, NumberOfOrders: orders})
+	}
+	return l
+}
+
+func (b *Book) side(t enum.MDEntryType) *[]Entry {
+	switch t {
+	case EntryBid:
+		return &b.Bids
+	case EntryOffer:
+		return &b.Offers
+	}
+	return nil
+}
+
+//better returns true if price p ranks ahead of q on the side of entry type t
+func better(t enum.MDEntryType, p, q decimal.Decimal) bool {
+	if t == EntryBid {
+		return p.GreaterThan(q)
+	}
+	return p.LessThan(q)
+}
+
+//insert adds e at 1-based position pos, or after the entries at the same or a better price if pos is zero
+func insert(s []Entry, t enum.MDEntryType, e Entry, pos int) []Entry {
+	i := len(s)
+	if pos > 0 {
+		if pos-1 < i {
+			i = pos - 1
+		}
+	} else {
+		for j, o := range s {
+			if better(t, e.Price, o.Price) {
+				i = j
+				break
+			}
+		}
+	}
+
+	s = append(s, Entry{})
+	copy(s[i+1:], s[i:])
+	s[i] = e
+	return s
+}
+
+//find returns the index of the entry with the given id, at the given 1-based position, or at the given price, in
+//that order of preference, or -1
+func find(s []Entry, id string, pos int, price decimal.Decimal) int {
+	switch {
+	case id != "":
+		for i, e := range s {
+			if e.ID == id {
+				return i
+			}
+		}
+		return -1
+	case pos > 0:
+		if pos <= len(s) {
+			return pos - 1
+		}
+		return -1
+	}
+
+	for i, e := range s {
+		if e.Price.Equal(price) {
+			return i
+		}
+	}
+	return -1
+}
+
+func remove(s []Entry, i int) []Entry {
+	return append(s[:i], s[i+1:]...)
+}
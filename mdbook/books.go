@@ -0,0 +1,288 @@
+package mdbook
+
+import (
+	"errors"
+
+	"github.com/terracefi/enum"
+	"github.com/terracefi/fix44/internal/fixutil"
+	"github.com/terracefi/fix44/marketdataincrementalrefresh"
+	"github.com/terracefi/fix44/marketdatarequest"
+	"github.com/terracefi/fix44/marketdatasnapshotfullrefresh"
+)
+
+//ErrNotIncremental is returned for an incremental refresh received on a subscription that asked for full refreshes
+var ErrNotIncremental = errors.New("mdbook: incremental refresh on a full refresh subscription")
+
+//updateFullRefresh is the MDUpdateType value for full refreshes, FIX 4.4
+const updateFullRefresh enum.MDUpdateType = "0"
+
+type bookKey struct {
+	mdReqID string
+	symbol  string
+}
+
+//Books maintains the Book of every MDReqID and Symbol from the market data messages it is given. It is not safe for
+//concurrent use, messages should be fed from the session callbacks in the order they are received.
+type Books struct {
+	//OnTopOfBook is called when the best bid or offer of a book changes. bid or offer is nil if the side is empty.
+	OnTopOfBook func(b *Book, bid, offer *Level)
+	//OnDepth is called after each message that changed a book
+	OnDepth func(b *Book)
+	//OnTrade is called for each trade entry of an incremental refresh
+	OnTrade func(b *Book, t Trade)
+	//OnGap is called when a RptSeq gap is detected on a book, the application should request a new snapshot
+	OnGap func(b *Book, expected, received int)
+
+	books      map[bookKey]*Book
+	fullOnly   map[string]bool
+	lastSymbol map[string]string
+}
+
+//New returns an empty Books
+func New() *Books {
+	return &Books{
+		books:      make(map[bookKey]*Book),
+		fullOnly:   make(map[string]bool),
+		lastSymbol: make(map[string]string),
+	}
+}
+
+//Book returns the Book of symbol on the subscription with the given MDReqID
+func (bs *Books) Book(mdReqID, symbol string) (*Book, bool) {
+	b, ok := bs.books[bookKey{mdReqID, symbol}]
+	return b, ok
+}
+
+func (bs *Books) book(mdReqID, symbol string) *Book {
+	k := bookKey{mdReqID, symbol}
+	b, ok := bs.books[k]
+	if !ok {
+		b = newBook(mdReqID, symbol)
+		bs.books[k] = b
+	}
+	return b
+}
+
+//OnMarketDataRequest records the subscription style of an outgoing MarketDataRequest. A request with
+//SubscriptionRequestType = Snapshot or MDUpdateType = FullRefresh is served with full refreshes only. A request with
+//SubscriptionRequestType = DisablePreviousSnapshotPlusUpdateRequest drops the books of its MDReqID.
+func (bs *Books) OnMarketDataRequest(msg marketdatarequest.MarketDataRequest) error {
+	mdReqID, err := msg.GetMDReqID()
+	if err != nil {
+		return err
+	}
+	subType, err := msg.GetSubscriptionRequestType()
+	if err != nil {
+		return err
+	}
+
+	if subType == fixutil.SubscriptionDisable {
+		for k := range bs.books {
+			if k.mdReqID == mdReqID {
+				delete(bs.books, k)
+			}
+		}
+		delete(bs.fullOnly, mdReqID)
+		delete(bs.lastSymbol, mdReqID)
+		return nil
+	}
+
+	fullOnly := subType == fixutil.SubscriptionSnapshot
+	if msg.HasMDUpdateType() {
+		updateType, err := msg.GetMDUpdateType()
+		if err != nil {
+			return err
+		}
+		fullOnly = fullOnly || updateType == updateFullRefresh
+	}
+	bs.fullOnly[mdReqID] = fullOnly
+	return nil
+}
+
+//OnSnapshot replaces the Book of the MDReqID and Symbol of msg with its entries
+func (bs *Books) OnSnapshot(msg marketdatasnapshotfullrefresh.MarketDataSnapshotFullRefresh) error {
+	s, err := marketdatasnapshotfullrefresh.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	mdReqID, symbol := fixutil.Deref(s.MDReqID), fixutil.Deref(s.Symbol)
+	b := bs.book(mdReqID, symbol)
+	bs.lastSymbol[mdReqID] = symbol
+	bid, offer := b.Top()
+
+	b.reset()
+	for _, e := range s.NoMDEntries {
+		if e.MDEntryType == nil {
+			continue
+		}
+		entry := Entry{
+			ID:             fixutil.Deref(e.OrderID),
+			Price:          fixutil.Deref(e.MDEntryPx),
+			Size:           fixutil.Deref(e.MDEntrySize),
+			NumberOfOrders: fixutil.Deref(e.NumberOfOrders),
+			Date:           fixutil.Deref(e.MDEntryDate),
+			Time:           fixutil.Deref(e.MDEntryTime),
+		}
+		if side := b.side(*e.MDEntryType); side != nil {
+			*side = insert(*side, *e.MDEntryType, entry, fixutil.Deref(e.MDEntryPositionNo))
+		} else {
+			b.Stats[*e.MDEntryType] = entry
+		}
+	}
+
+	bs.changed(b, bid, offer)
+	return nil
+}
+
+//OnIncremental applies the entries of msg to the Books they refer to. Entries without a Symbol belong to the
+//instrument of the previous entry, or of the last snapshot, of the same MDReqID.
+func (bs *Books) OnIncremental(msg marketdataincrementalrefresh.MarketDataIncrementalRefresh) error {
+	s, err := marketdataincrementalrefresh.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	mdReqID := fixutil.Deref(s.MDReqID)
+	if bs.fullOnly[mdReqID] {
+		return ErrNotIncremental
+	}
+
+	type top struct{ bid, offer *Level }
+	touched := make(map[*Book]top)
+	var order []*Book
+
+	for _, e := range s.NoMDEntries {
+		symbol := bs.lastSymbol[mdReqID]
+		if e.Symbol != nil {
+			symbol = *e.Symbol
+			bs.lastSymbol[mdReqID] = symbol
+		}
+
+		b := bs.book(mdReqID, symbol)
+		if b.Stale {
+			continue
+		}
+		if e.RptSeq != nil {
+			if b.RptSeq != 0 && *e.RptSeq <= b.RptSeq {
+				continue
+			}
+			if b.RptSeq != 0 && *e.RptSeq != b.RptSeq+1 {
+				b.Stale = true
+				if bs.OnGap != nil {
+					bs.OnGap(b, b.RptSeq+1, *e.RptSeq)
+				}
+				continue
+			}
+			b.RptSeq = *e.RptSeq
+		}
+
+		if _, ok := touched[b]; !ok {
+			bid, offer := b.Top()
+			touched[b] = top{bid, offer}
+			order = append(order, b)
+		}
+		bs.apply(b, e)
+	}
+
+	for _, b := range order {
+		t := touched[b]
+		bs.changed(b, t.bid, t.offer)
+	}
+	return nil
+}
+
+func (bs *Books) apply(b *Book, e marketdataincrementalrefresh.NoMDEntriesStruct) {
+	if e.MDEntryType == nil {
+		return
+	}
+	t := *e.MDEntryType
+	action := ActionNew
+	if e.MDUpdateAction != nil {
+		action = *e.MDUpdateAction
+	}
+
+	id := fixutil.Deref(e.MDEntryID)
+	if id == "" {
+		id = fixutil.Deref(e.OrderID)
+	}
+	entry := Entry{
+		ID:             id,
+		Price:          fixutil.Deref(e.MDEntryPx),
+		Size:           fixutil.Deref(e.MDEntrySize),
+		NumberOfOrders: fixutil.Deref(e.NumberOfOrders),
+		Date:           fixutil.Deref(e.MDEntryDate),
+		Time:           fixutil.Deref(e.MDEntryTime),
+	}
+	pos := fixutil.Deref(e.MDEntryPositionNo)
+
+	side := b.side(t)
+	if side == nil {
+		if action != ActionDelete {
+			b.Stats[t] = entry
+		} else {
+			delete(b.Stats, t)
+		}
+		if t == EntryTrade && action == ActionNew && bs.OnTrade != nil {
+			bs.OnTrade(b, Trade{Entry: entry, Buyer: fixutil.Deref(e.MDEntryBuyer), Seller: fixutil.Deref(e.MDEntrySeller)})
+		}
+		return
+	}
+
+	switch action {
+	case ActionNew:
+		*side = insert(*side, t, entry, pos)
+
+	case ActionChange:
+		ref := id
+		if e.MDEntryRefID != nil {
+			ref = *e.MDEntryRefID
+		}
+		i := find(*side, ref, pos, entry.Price)
+		if i < 0 {
+			*side = insert(*side, t, entry, pos)
+			return
+		}
+		if pos == 0 && !(*side)[i].Price.Equal(entry.Price) {
+			*side = insert(remove(*side, i), t, entry, 0)
+			return
+		}
+		(*side)[i] = entry
+
+	case ActionDelete:
+		if i := find(*side, id, pos, entry.Price); i >= 0 {
+			*side = remove(*side, i)
+		}
+
+	case ActionDeleteThru:
+		if pos <= 0 || pos >= len(*side) {
+			*side = nil
+		} else {
+			*side = append([]Entry(nil), (*side)[pos:]...)
+		}
+
+	case ActionDeleteFrom:
+		if pos <= 1 {
+			*side = nil
+		} else if pos <= len(*side) {
+			*side = (*side)[:pos-1]
+		}
+	}
+}
+
+func (bs *Books) changed(b *Book, bid, offer *Level) {
+	newBid, newOffer := b.Top()
+	if bs.OnTopOfBook != nil && (!sameLevel(bid, newBid) || !sameLevel(offer, newOffer)) {
+		bs.OnTopOfBook(b, newBid, newOffer)
+	}
+	if bs.OnDepth != nil {
+		bs.OnDepth(b)
+	}
+}
+
+func sameLevel(a, b *Level) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Price.Equal(b.Price) && a.Size.Equal(b.Size) && a.NumberOfOrders == b.NumberOfOrders
+}
@@ -0,0 +1,352 @@
+package mdbook
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/terracefi/enum"
+	"github.com/terracefi/field"
+	"github.com/terracefi/fix44/internal/fixutil"
+	"github.com/terracefi/fix44/marketdataincrementalrefresh"
+	"github.com/terracefi/fix44/marketdatarequest"
+	"github.com/terracefi/fix44/marketdatasnapshotfullrefresh"
+)
+
+func snapshot(mdReqID, symbol string, entries ...marketdatasnapshotfullrefresh.NoMDEntriesStruct,
+) marketdatasnapshotfullrefresh.MarketDataSnapshotFullRefresh {
+	return marketdatasnapshotfullrefresh.Unmarshal(marketdatasnapshotfullrefresh.Struct{
+		MDReqID:     fixutil.Ptr(mdReqID),
+		Symbol:      fixutil.Ptr(symbol),
+		NoMDEntries: entries,
+	})
+}
+
+func level(t enum.MDEntryType, px, size int64) marketdatasnapshotfullrefresh.NoMDEntriesStruct {
+	return marketdatasnapshotfullrefresh.NoMDEntriesStruct{
+		MDEntryType: fixutil.Ptr(t),
+		MDEntryPx:   fixutil.Ptr(decimal.NewFromInt(px)),
+		MDEntrySize: fixutil.Ptr(decimal.NewFromInt(size)),
+	}
+}
+
+func incremental(mdReqID string, entries ...marketdataincrementalrefresh.NoMDEntriesStruct,
+) marketdataincrementalrefresh.MarketDataIncrementalRefresh {
+	return marketdataincrementalrefresh.Unmarshal(marketdataincrementalrefresh.Struct{
+		MDReqID:     fixutil.Ptr(mdReqID),
+		NoMDEntries: entries,
+	})
+}
+
+type update struct {
+	action  enum.MDUpdateAction
+	typ     enum.MDEntryType
+	id      string
+	px      int64
+	size    int64
+	pos     int
+	rptSeq  int
+	symbol  string
+	noPrice bool
+}
+
+func (u update) entry() marketdataincrementalrefresh.NoMDEntriesStruct {
+	e := marketdataincrementalrefresh.NoMDEntriesStruct{
+		MDUpdateAction: fixutil.Ptr(u.action),
+		MDEntryType:    fixutil.Ptr(u.typ),
+		MDEntrySize:    fixutil.Ptr(decimal.NewFromInt(u.size)),
+	}
+	if !u.noPrice {
+		e.MDEntryPx = fixutil.Ptr(decimal.NewFromInt(u.px))
+	}
+	if u.id != "" {
+		e.MDEntryID = fixutil.Ptr(u.id)
+	}
+	if u.pos != 0 {
+		e.MDEntryPositionNo = fixutil.Ptr(u.pos)
+	}
+	if u.rptSeq != 0 {
+		e.RptSeq = fixutil.Ptr(u.rptSeq)
+	}
+	if u.symbol != "" {
+		e.Symbol = fixutil.Ptr(u.symbol)
+	}
+	return e
+}
+
+func entries(updates []update) []marketdataincrementalrefresh.NoMDEntriesStruct {
+	var e []marketdataincrementalrefresh.NoMDEntriesStruct
+	for _, u := range updates {
+		e = append(e, u.entry())
+	}
+	return e
+}
+
+func prices(s []Entry) []int64 {
+	var p []int64
+	for _, e := range s {
+		p = append(p, e.Price.IntPart())
+	}
+	return p
+}
+
+func equal(a, b []int64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestBooksIncremental(t *testing.T) {
+	base := []marketdatasnapshotfullrefresh.NoMDEntriesStruct{
+		level(EntryBid, 99, 10), level(EntryBid, 98, 10), level(EntryBid, 97, 10),
+		level(EntryOffer, 101, 10), level(EntryOffer, 102, 10),
+	}
+
+	tests := []struct {
+		name    string
+		updates []update
+		bids    []int64
+		offers  []int64
+		stale   bool
+		gaps    int
+	}{
+		{
+			name: "new levels in price order",
+			updates: []update{{action: ActionNew, typ: EntryBid, px: 100, size: 5},
+				{action: ActionNew, typ: EntryOffer, px: 103, size: 5}},
+			bids:   []int64{100, 99, 98, 97},
+			offers: []int64{101, 102, 103},
+		},
+		{
+			name:    "new level at a position",
+			updates: []update{{action: ActionNew, typ: EntryBid, px: 96, size: 5, pos: 2}},
+			bids:    []int64{99, 96, 98, 97},
+			offers:  []int64{101, 102},
+		},
+		{
+			name:    "change by price",
+			updates: []update{{action: ActionChange, typ: EntryBid, px: 98, size: 30}},
+			bids:    []int64{99, 98, 97},
+			offers:  []int64{101, 102},
+		},
+		{
+			name:    "delete by price",
+			updates: []update{{action: ActionDelete, typ: EntryOffer, px: 101}},
+			bids:    []int64{99, 98, 97},
+			offers:  []int64{102},
+		},
+		{
+			name:    "delete by position",
+			updates: []update{{action: ActionDelete, typ: EntryBid, pos: 2, noPrice: true}},
+			bids:    []int64{99, 97},
+			offers:  []int64{101, 102},
+		},
+		{
+			name:    "delete thru",
+			updates: []update{{action: ActionDeleteThru, typ: EntryBid, pos: 2, noPrice: true}},
+			bids:    []int64{97},
+			offers:  []int64{101, 102},
+		},
+		{
+			name:    "delete from",
+			updates: []update{{action: ActionDeleteFrom, typ: EntryBid, pos: 2, noPrice: true}},
+			bids:    []int64{99},
+			offers:  []int64{101, 102},
+		},
+		{
+			name: "in sequence",
+			updates: []update{{action: ActionNew, typ: EntryBid, px: 100, size: 5, rptSeq: 1},
+				{action: ActionDelete, typ: EntryBid, px: 97, rptSeq: 2}},
+			bids:   []int64{100, 99, 98},
+			offers: []int64{101, 102},
+		},
+		{
+			name: "repeated RptSeq is ignored",
+			updates: []update{{action: ActionNew, typ: EntryBid, px: 100, size: 5, rptSeq: 1},
+				{action: ActionNew, typ: EntryBid, px: 100, size: 5, rptSeq: 1}},
+			bids:   []int64{100, 99, 98, 97},
+			offers: []int64{101, 102},
+		},
+		{
+			name: "gap",
+			updates: []update{{action: ActionNew, typ: EntryBid, px: 100, size: 5, rptSeq: 1},
+				{action: ActionDelete, typ: EntryBid, px: 99, rptSeq: 3},
+				{action: ActionDelete, typ: EntryBid, px: 98, rptSeq: 4}},
+			bids:   []int64{100, 99, 98, 97},
+			offers: []int64{101, 102},
+			stale:  true,
+			gaps:   1,
+		},
+		{
+			name:    "other symbol",
+			updates: []update{{action: ActionNew, typ: EntryBid, px: 100, size: 5, symbol: "XYZ"}},
+			bids:    []int64{99, 98, 97},
+			offers:  []int64{101, 102},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bs := New()
+			gaps := 0
+			bs.OnGap = func(b *Book, expected, received int) { gaps++ }
+
+			if err := bs.OnSnapshot(snapshot("M1", "ABC", base...)); err != nil {
+				t.Fatal(err)
+			}
+			if err := bs.OnIncremental(incremental("M1", entries(tt.updates)...)); err != nil {
+				t.Fatal(err)
+			}
+
+			b, ok := bs.Book("M1", "ABC")
+			if !ok {
+				t.Fatal("Book() not found")
+			}
+			if got := prices(b.Bids); !equal(got, tt.bids) {
+				t.Errorf("Bids = %v, want %v", got, tt.bids)
+			}
+			if got := prices(b.Offers); !equal(got, tt.offers) {
+				t.Errorf("Offers = %v, want %v", got, tt.offers)
+			}
+			if b.Stale != tt.stale {
+				t.Errorf("Stale = %v, want %v", b.Stale, tt.stale)
+			}
+			if gaps != tt.gaps {
+				t.Errorf("OnGap called %v times, want %v", gaps, tt.gaps)
+			}
+		})
+	}
+}
+
+func TestBooksSymbolCarriesOver(t *testing.T) {
+	bs := New()
+	err := bs.OnIncremental(incremental("M1", entries([]update{
+		{action: ActionNew, typ: EntryBid, px: 10, size: 1, symbol: "XYZ"},
+		{action: ActionNew, typ: EntryBid, px: 11, size: 1},
+	})...))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, ok := bs.Book("M1", "XYZ")
+	if !ok {
+		t.Fatal("Book() not found")
+	}
+	if got := prices(b.Bids); !equal(got, []int64{11, 10}) {
+		t.Errorf("Bids = %v, want [11 10]", got)
+	}
+}
+
+func TestBooksSnapshotClearsStale(t *testing.T) {
+	bs := New()
+	bs.OnIncremental(incremental("M1", entries([]update{
+		{action: ActionNew, typ: EntryBid, px: 10, size: 1, rptSeq: 1, symbol: "ABC"},
+		{action: ActionNew, typ: EntryBid, px: 11, size: 1, rptSeq: 5},
+	})...))
+	if b, _ := bs.Book("M1", "ABC"); !b.Stale {
+		t.Fatal("Stale = false after a gap")
+	}
+
+	bs.OnSnapshot(snapshot("M1", "ABC", level(EntryBid, 12, 1)))
+	b, _ := bs.Book("M1", "ABC")
+	if b.Stale || b.RptSeq != 0 || !equal(prices(b.Bids), []int64{12}) {
+		t.Errorf("Book = %+v, want the snapshot only", b)
+	}
+}
+
+func TestBooksOrders(t *testing.T) {
+	bs := New()
+	bs.OnIncremental(incremental("M1", entries([]update{
+		{action: ActionNew, typ: EntryBid, id: "a", px: 10, size: 1, symbol: "ABC"},
+		{action: ActionNew, typ: EntryBid, id: "b", px: 10, size: 2},
+		{action: ActionNew, typ: EntryBid, id: "c", px: 9, size: 4},
+		{action: ActionChange, typ: EntryBid, id: "a", px: 9, size: 1},
+		{action: ActionDelete, typ: EntryBid, id: "c"},
+	})...))
+
+	b, _ := bs.Book("M1", "ABC")
+	bids, _ := b.Depth(0)
+	want := []Level{
+		{Price: decimal.NewFromInt(10), Size: decimal.NewFromInt(2), NumberOfOrders: 1},
+		{Price: decimal.NewFromInt(9), Size: decimal.NewFromInt(1), NumberOfOrders: 1},
+	}
+	if len(bids) != len(want) {
+		t.Fatalf("Depth() = %v, want %v", bids, want)
+	}
+	for i := range want {
+		if !sameLevel(&bids[i], &want[i]) {
+			t.Errorf("Depth()[%v] = %v, want %v", i, bids[i], want[i])
+		}
+	}
+}
+
+func TestBooksTopOfBook(t *testing.T) {
+	bs := New()
+	var tops []*Level
+	bs.OnTopOfBook = func(b *Book, bid, offer *Level) { tops = append(tops, bid) }
+	var trades []Trade
+	bs.OnTrade = func(b *Book, t Trade) { trades = append(trades, t) }
+
+	bs.OnSnapshot(snapshot("M1", "ABC", level(EntryBid, 10, 1)))
+	bs.OnIncremental(incremental("M1", entries([]update{{action: ActionNew, typ: EntryBid, px: 9, size: 1}})...))
+	bs.OnIncremental(incremental("M1", entries([]update{{action: ActionNew, typ: EntryTrade, px: 10, size: 1}})...))
+	bs.OnIncremental(incremental("M1", entries([]update{{action: ActionDelete, typ: EntryBid, px: 10}})...))
+
+	if len(tops) != 2 || !tops[0].Price.Equal(decimal.NewFromInt(10)) || !tops[1].Price.Equal(decimal.NewFromInt(9)) {
+		t.Errorf("OnTopOfBook bids = %v, want 10 then 9", tops)
+	}
+	if len(trades) != 1 || !trades[0].Price.Equal(decimal.NewFromInt(10)) {
+		t.Errorf("OnTrade = %v, want one trade at 10", trades)
+	}
+	if b, _ := bs.Book("M1", "ABC"); b.Stats[EntryTrade].Size.IntPart() != 1 {
+		t.Errorf("Stats[Trade] = %v, want the trade", b.Stats[EntryTrade])
+	}
+}
+
+func TestBooksMarketDataRequest(t *testing.T) {
+	request := func(subType enum.SubscriptionRequestType,
+		updateType enum.MDUpdateType) marketdatarequest.MarketDataRequest {
+		msg := marketdatarequest.New(field.NewMDReqID("M1"), field.NewSubscriptionRequestType(subType),
+			field.NewMarketDepth(0))
+		if updateType != "" {
+			msg.SetMDUpdateType(updateType)
+		}
+		return msg
+	}
+
+	tests := []struct {
+		name       string
+		subType    enum.SubscriptionRequestType
+		updateType enum.MDUpdateType
+		wantErr    error
+	}{
+		{"incremental", "1", "1", nil},
+		{"no update type", "1", "", nil},
+		{"full refresh", "1", "0", ErrNotIncremental},
+		{"snapshot", "0", "", ErrNotIncremental},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bs := New()
+			if err := bs.OnMarketDataRequest(request(tt.subType, tt.updateType)); err != nil {
+				t.Fatal(err)
+			}
+			bid := update{action: ActionNew, typ: EntryBid, px: 10, size: 1}
+			err := bs.OnIncremental(incremental("M1", bid.entry()))
+			if err != tt.wantErr {
+				t.Errorf("OnIncremental() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	bs := New()
+	bs.OnSnapshot(snapshot("M1", "ABC", level(EntryBid, 10, 1)))
+	bs.OnMarketDataRequest(request(fixutil.SubscriptionDisable, ""))
+	if _, ok := bs.Book("M1", "ABC"); ok {
+		t.Error("Book() found after the subscription was disabled")
+	}
+}
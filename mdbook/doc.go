@@ -0,0 +1,18 @@
+/*
+Package mdbook builds order books from MarketDataSnapshotFullRefresh (W) and MarketDataIncrementalRefresh (X)
+messages.
+
+A Books keeps one Book per MDReqID and Symbol. Each Book holds its bids and offers best first, either as price
+levels or, when the entries carry an MDEntryID or OrderID, as individual orders that Levels aggregates by price.
+Trades and the other MDEntryTypes (opening, closing and settlement prices, highs and lows, ...) are kept in Stats.
+
+Both subscription styles of MarketDataRequest are supported. With MDUpdateType = FullRefresh every W replaces the
+book, with MDUpdateType = IncrementalRefresh the X messages that follow a W, or that start from an empty book, are
+applied with MDUpdateAction New, Change, Delete, DeleteThru and DeleteFrom. DeleteThru (3) and DeleteFrom (4) are
+not FIX 4.4 values but are sent by many venues and are accepted.
+
+Incremental entries that carry RptSeq are checked for gaps. On a gap the Book is marked Stale, OnGap is called so
+the application can request a new snapshot, and further incremental updates for the Book are ignored until the next
+W arrives.
+*/
+package mdbook
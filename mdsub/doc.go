@@ -0,0 +1,14 @@
+/*
+Package mdsub manages market data subscriptions made with MarketDataRequest messages.
+
+A Manager builds and sends the MarketDataRequest of each subscription, with its NoRelatedSym and NoMDEntryTypes
+groups and a MDReqID of its own, and remembers the Subscriber that asked for it. The MarketDataSnapshotFullRefresh
+(W) and MarketDataIncrementalRefresh (X) messages received are routed to the Subscriber owning their MDReqID, and a
+MarketDataRequestReject ends the subscription and is reported to its Subscriber with the MDReqRejReason.
+
+Unsubscribe sends the MarketDataRequest with SubscriptionRequestType = DisablePreviousSnapshotPlusUpdateRequest
+that ends a subscription. Counterparties drop subscriptions when the session ends, so OnLogon should be called from
+the OnLogon callback of the application: it sends the MarketDataRequest of every live subscription again, under the
+same MDReqID.
+*/
+package mdsub
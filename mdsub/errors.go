@@ -0,0 +1,15 @@
+package mdsub
+
+import (
+	"fmt"
+)
+
+//UnknownSubscriptionError is returned for a message or call that refers to an MDReqID the Manager has no live
+//subscription for
+type UnknownSubscriptionError struct {
+	MDReqID string
+}
+
+func (e *UnknownSubscriptionError) Error() string {
+	return fmt.Sprintf("mdsub: no subscription %v", e.MDReqID)
+}
@@ -0,0 +1,233 @@
+package mdsub
+
+import (
+	"sync"
+
+	"github.com/terracefi/enum"
+	"github.com/terracefi/fix44/internal/fixutil"
+	"github.com/terracefi/fix44/marketdataincrementalrefresh"
+	"github.com/terracefi/fix44/marketdatarequest"
+	"github.com/terracefi/fix44/marketdatarequestreject"
+	"github.com/terracefi/fix44/marketdatasnapshotfullrefresh"
+	"github.com/terracefi/quickfix"
+)
+
+type subscription struct {
+	Subscription
+	subscriber Subscriber
+}
+
+//Manager makes market data subscriptions and routes the market data received to their subscribers. It is safe for
+//concurrent use.
+type Manager struct {
+	//SendMessage sends the MarketDataRequests, by default on the session given to New
+	SendMessage func(msg quickfix.Messagable) error
+	//NewMDReqID returns the MDReqID of a new subscription, it defaults to a sequence number prefixed with the time
+	//New was called
+	NewMDReqID func() string
+
+	mu            sync.Mutex
+	subscriptions map[string]*subscription
+}
+
+//New returns a Manager without subscriptions that sends on sessionID
+func New(sessionID quickfix.SessionID) *Manager {
+	return &Manager{
+		SendMessage:   fixutil.SendOn(sessionID),
+		NewMDReqID:    fixutil.NewIDs(),
+		subscriptions: make(map[string]*subscription),
+	}
+}
+
+//Subscription returns the subscription with the given MDReqID
+func (m *Manager) Subscription(mdReqID string) (Subscription, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	s, ok := m.subscriptions[mdReqID]
+	if !ok {
+		return Subscription{}, false
+	}
+	return s.clone(), true
+}
+
+//Subscriptions returns the live subscriptions, those that are Pending or Active
+func (m *Manager) Subscriptions() []Subscription {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var subs []Subscription
+	for _, s := range m.subscriptions {
+		if s.live() {
+			subs = append(subs, s.clone())
+		}
+	}
+	return subs
+}
+
+func (s *subscription) live() bool {
+	return s.State == Pending || s.State == Active
+}
+
+//Subscribe sends a MarketDataRequest for snapshots and updates of entryTypes on symbols, to be delivered to sub.
+//depth is the MarketDepth, 0 for the full book and 1 for the top of the book. The subscription is dropped if the
+//request cannot be sent.
+func (m *Manager) Subscribe(symbols []string, entryTypes []enum.MDEntryType, depth int, updateType enum.MDUpdateType,
+	sub Subscriber) (Subscription, error) {
+	s := &subscription{
+		Subscription: Subscription{
+			MDReqID:    m.NewMDReqID(),
+			Symbols:    append([]string(nil), symbols...),
+			EntryTypes: append([]enum.MDEntryType(nil), entryTypes...),
+			Depth:      depth,
+			UpdateType: updateType,
+			State:      Pending,
+		},
+		subscriber: sub,
+	}
+
+	m.mu.Lock()
+	m.subscriptions[s.MDReqID] = s
+	c := s.clone()
+	m.mu.Unlock()
+
+	if err := m.SendMessage(request(c, RequestSnapshotAndUpdates)); err != nil {
+		m.mu.Lock()
+		delete(m.subscriptions, s.MDReqID)
+		m.mu.Unlock()
+		return Subscription{}, err
+	}
+	return c, nil
+}
+
+//Unsubscribe ends the subscription with the given MDReqID and sends the MarketDataRequest that disables it
+func (m *Manager) Unsubscribe(mdReqID string) error {
+	m.mu.Lock()
+	s, ok := m.subscriptions[mdReqID]
+	if !ok || !s.live() {
+		m.mu.Unlock()
+		return &UnknownSubscriptionError{mdReqID}
+	}
+	s.State = Canceled
+	c := s.clone()
+	m.mu.Unlock()
+
+	return m.SendMessage(request(c, RequestDisable))
+}
+
+//OnLogon sends the MarketDataRequest of every live subscription again and puts them back in Pending state. It
+//should be called when the session logs on.
+func (m *Manager) OnLogon() error {
+	m.mu.Lock()
+	var subs []Subscription
+	for _, s := range m.subscriptions {
+		if s.live() {
+			s.State = Pending
+			subs = append(subs, s.clone())
+		}
+	}
+	m.mu.Unlock()
+
+	for _, s := range subs {
+		if err := m.SendMessage(request(s, RequestSnapshotAndUpdates)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func request(s Subscription, subType enum.SubscriptionRequestType) marketdatarequest.MarketDataRequest {
+	updateType := s.UpdateType
+	r := marketdatarequest.Struct{
+		MDReqID:                 s.MDReqID,
+		SubscriptionRequestType: subType,
+		MarketDepth:             s.Depth,
+		MDUpdateType:            &updateType,
+	}
+	for i := range s.EntryTypes {
+		r.NoMDEntryTypes = append(r.NoMDEntryTypes,
+			marketdatarequest.NoMDEntryTypesStruct{MDEntryType: &s.EntryTypes[i]})
+	}
+	for i := range s.Symbols {
+		r.NoRelatedSym = append(r.NoRelatedSym, marketdatarequest.NoRelatedSymStruct{Symbol: &s.Symbols[i]})
+	}
+	return marketdatarequest.Unmarshal(r)
+}
+
+//OnSnapshot routes a MarketDataSnapshotFullRefresh to the subscriber of its MDReqID, the subscription becomes Active
+func (m *Manager) OnSnapshot(msg marketdatasnapshotfullrefresh.MarketDataSnapshotFullRefresh) error {
+	var mdReqID string
+	if msg.HasMDReqID() {
+		var err error
+		if mdReqID, err = msg.GetMDReqID(); err != nil {
+			return err
+		}
+	}
+
+	sub, err := m.route(mdReqID)
+	if err != nil {
+		return err
+	}
+	if sub.OnSnapshot != nil {
+		sub.OnSnapshot(msg)
+	}
+	return nil
+}
+
+//OnIncremental routes a MarketDataIncrementalRefresh to the subscriber of its MDReqID, the subscription becomes
+//Active
+func (m *Manager) OnIncremental(msg marketdataincrementalrefresh.MarketDataIncrementalRefresh) error {
+	var mdReqID string
+	if msg.HasMDReqID() {
+		var err error
+		if mdReqID, err = msg.GetMDReqID(); err != nil {
+			return err
+		}
+	}
+
+	sub, err := m.route(mdReqID)
+	if err != nil {
+		return err
+	}
+	if sub.OnIncremental != nil {
+		sub.OnIncremental(msg)
+	}
+	return nil
+}
+
+func (m *Manager) route(mdReqID string) (Subscriber, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	s, ok := m.subscriptions[mdReqID]
+	if !ok || !s.live() {
+		return Subscriber{}, &UnknownSubscriptionError{mdReqID}
+	}
+	s.State = Active
+	return s.subscriber, nil
+}
+
+//OnMarketDataRequestReject ends the subscription of the MDReqID of msg and reports the reject to its subscriber
+func (m *Manager) OnMarketDataRequestReject(msg marketdatarequestreject.MarketDataRequestReject) (Subscription, error) {
+	r, err := marketdatarequestreject.Marshal(msg)
+	if err != nil {
+		return Subscription{}, err
+	}
+
+	m.mu.Lock()
+	s, ok := m.subscriptions[r.MDReqID]
+	if !ok || !s.live() {
+		m.mu.Unlock()
+		return Subscription{}, &UnknownSubscriptionError{r.MDReqID}
+	}
+	s.State = Rejected
+	s.RejReason = r.MDReqRejReason
+	s.Text = fixutil.Deref(r.Text)
+	c, onReject := s.clone(), s.subscriber.OnReject
+	m.mu.Unlock()
+
+	if onReject != nil {
+		onReject(c)
+	}
+	return c, nil
+}
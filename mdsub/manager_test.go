@@ -0,0 +1,267 @@
+package mdsub
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/terracefi/enum"
+	"github.com/terracefi/fix44/internal/fixutil"
+	"github.com/terracefi/fix44/internal/testutil"
+	"github.com/terracefi/fix44/marketdataincrementalrefresh"
+	"github.com/terracefi/fix44/marketdatarequest"
+	"github.com/terracefi/fix44/marketdatarequestreject"
+	"github.com/terracefi/fix44/marketdatasnapshotfullrefresh"
+	"github.com/terracefi/quickfix"
+)
+
+//requests returns the MDReqID and SubscriptionRequestType of each MarketDataRequest sent, as in "R1:1"
+func requests(t *testing.T, s *testutil.Sender) []string {
+	var d []string
+	for _, msg := range s.Sent {
+		r, err := marketdatarequest.Marshal(msg.(marketdatarequest.MarketDataRequest))
+		if err != nil {
+			t.Fatal(err)
+		}
+		d = append(d, fmt.Sprintf("%v:%v", r.MDReqID, r.SubscriptionRequestType))
+	}
+	return d
+}
+
+//newManager returns a Manager whose MDReqIDs are R1, R2, ...
+func newManager(failAt int) (*Manager, *testutil.Sender) {
+	m := New(quickfix.SessionID{})
+	s := &testutil.Sender{FailAt: failAt}
+	m.SendMessage = s.Send
+	m.NewMDReqID = testutil.IDs("R")
+	return m, s
+}
+
+//received counts the callbacks made on a Subscriber
+type received struct {
+	snapshots, incrementals, rejects int
+}
+
+func (r *received) subscriber() Subscriber {
+	return Subscriber{
+		OnSnapshot: func(marketdatasnapshotfullrefresh.MarketDataSnapshotFullRefresh) { r.snapshots++ },
+		OnIncremental: func(marketdataincrementalrefresh.MarketDataIncrementalRefresh) {
+			r.incrementals++
+		},
+		OnReject: func(Subscription) { r.rejects++ },
+	}
+}
+
+type step func(m *Manager) error
+
+func snapshot(mdReqID string) step {
+	return func(m *Manager) error {
+		return m.OnSnapshot(marketdatasnapshotfullrefresh.Unmarshal(marketdatasnapshotfullrefresh.Struct{
+			MDReqID: fixutil.Ptr(mdReqID), Symbol: fixutil.Ptr("ABC")}))
+	}
+}
+
+func incremental(mdReqID string) step {
+	return func(m *Manager) error {
+		return m.OnIncremental(marketdataincrementalrefresh.Unmarshal(marketdataincrementalrefresh.Struct{
+			MDReqID: fixutil.Ptr(mdReqID)}))
+	}
+}
+
+func reject(mdReqID string, reason *enum.MDReqRejReason) step {
+	return func(m *Manager) error {
+		_, err := m.OnMarketDataRequestReject(marketdatarequestreject.Unmarshal(marketdatarequestreject.Struct{
+			MDReqID: mdReqID, MDReqRejReason: reason, Text: fixutil.Ptr("no")}))
+		return err
+	}
+}
+
+func unsubscribe(mdReqID string) step {
+	return func(m *Manager) error { return m.Unsubscribe(mdReqID) }
+}
+
+func TestManager(t *testing.T) {
+	tests := []struct {
+		name     string
+		steps    []step
+		wantErr  error
+		state    State
+		received received
+		sent     []string
+		live     int
+	}{
+		{
+			name:  "pending",
+			state: Pending, sent: []string{"R1:1"}, live: 1,
+		},
+		{
+			name:  "snapshot",
+			steps: []step{snapshot("R1")},
+			state: Active, received: received{snapshots: 1}, sent: []string{"R1:1"}, live: 1,
+		},
+		{
+			name:  "updates",
+			steps: []step{snapshot("R1"), incremental("R1"), incremental("R1")},
+			state: Active, received: received{snapshots: 1, incrementals: 2}, sent: []string{"R1:1"}, live: 1,
+		},
+		{
+			name:    "other MDReqID",
+			steps:   []step{snapshot("R2")},
+			wantErr: &UnknownSubscriptionError{},
+			state:   Pending, sent: []string{"R1:1"}, live: 1,
+		},
+		{
+			name:  "rejected",
+			steps: []step{reject("R1", fixutil.Ptr(RejUnknownSymbol))},
+			state: Rejected, received: received{rejects: 1}, sent: []string{"R1:1"},
+		},
+		{
+			name:    "data after the reject",
+			steps:   []step{reject("R1", nil), incremental("R1")},
+			wantErr: &UnknownSubscriptionError{},
+			state:   Rejected, received: received{rejects: 1}, sent: []string{"R1:1"},
+		},
+		{
+			name:    "rejected twice",
+			steps:   []step{reject("R1", nil), reject("R1", nil)},
+			wantErr: &UnknownSubscriptionError{},
+			state:   Rejected, received: received{rejects: 1}, sent: []string{"R1:1"},
+		},
+		{
+			name:  "unsubscribed",
+			steps: []step{snapshot("R1"), unsubscribe("R1")},
+			state: Canceled, received: received{snapshots: 1}, sent: []string{"R1:1", "R1:2"},
+		},
+		{
+			name:    "unsubscribed twice",
+			steps:   []step{unsubscribe("R1"), unsubscribe("R1")},
+			wantErr: &UnknownSubscriptionError{},
+			state:   Canceled, sent: []string{"R1:1", "R1:2"},
+		},
+		{
+			name:    "unsubscribe of an unknown subscription",
+			steps:   []step{unsubscribe("R2")},
+			wantErr: &UnknownSubscriptionError{},
+			state:   Pending, sent: []string{"R1:1"}, live: 1,
+		},
+		{
+			name:    "data after unsubscribing",
+			steps:   []step{unsubscribe("R1"), snapshot("R1")},
+			wantErr: &UnknownSubscriptionError{},
+			state:   Canceled, sent: []string{"R1:1", "R1:2"},
+		},
+		{
+			name:  "logon",
+			steps: []step{snapshot("R1"), func(m *Manager) error { return m.OnLogon() }},
+			state: Pending, received: received{snapshots: 1}, sent: []string{"R1:1", "R1:1"}, live: 1,
+		},
+		{
+			name:  "logon after unsubscribing",
+			steps: []step{unsubscribe("R1"), func(m *Manager) error { return m.OnLogon() }},
+			state: Canceled, sent: []string{"R1:1", "R1:2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, s := newManager(0)
+			var r received
+			if _, err := m.Subscribe([]string{"ABC"}, []enum.MDEntryType{"0", "1"}, 1, UpdateIncrementalRefresh,
+				r.subscriber()); err != nil {
+				t.Fatal(err)
+			}
+
+			var err error
+			for i, st := range tt.steps {
+				err = st(m)
+				if i < len(tt.steps)-1 && err != nil {
+					t.Fatalf("step %v: %v", i, err)
+				}
+			}
+			if reflect.TypeOf(err) != reflect.TypeOf(tt.wantErr) {
+				t.Fatalf("error = %v, want %T", err, tt.wantErr)
+			}
+
+			sub, ok := m.Subscription("R1")
+			if !ok || sub.State != tt.state {
+				t.Errorf("Subscription() = %v, %v, want state %v", sub.State, ok, tt.state)
+			}
+			if r != tt.received {
+				t.Errorf("received %+v, want %+v", r, tt.received)
+			}
+			if got := requests(t, s); !reflect.DeepEqual(got, tt.sent) {
+				t.Errorf("sent %v, want %v", got, tt.sent)
+			}
+			if n := len(m.Subscriptions()); n != tt.live {
+				t.Errorf("len(Subscriptions()) = %v, want %v", n, tt.live)
+			}
+		})
+	}
+}
+
+func TestRejectReason(t *testing.T) {
+	m, _ := newManager(0)
+	m.Subscribe([]string{"ABC"}, []enum.MDEntryType{"0"}, 0, UpdateFullRefresh, Subscriber{})
+	if err := reject("R1", fixutil.Ptr(RejUnsupportedMarketDepth))(m); err != nil {
+		t.Fatal(err)
+	}
+	sub, _ := m.Subscription("R1")
+	if sub.RejReason == nil || *sub.RejReason != RejUnsupportedMarketDepth || sub.Text != "no" {
+		t.Errorf("RejReason, Text = %v, %q, want %v, no", sub.RejReason, sub.Text, RejUnsupportedMarketDepth)
+	}
+}
+
+func TestSubscribeSendError(t *testing.T) {
+	tests := []struct {
+		name   string
+		failAt int
+		live   []string
+	}{
+		{"sent", 0, []string{"R1", "R2"}},
+		{"first not sent", 1, []string{"R2"}},
+		{"second not sent", 2, []string{"R1"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, _ := newManager(tt.failAt)
+			for i := 1; i <= 2; i++ {
+				sub, err := m.Subscribe([]string{"ABC"}, []enum.MDEntryType{"0"}, 0, UpdateFullRefresh, Subscriber{})
+				if failed := i == tt.failAt; failed != (err != nil) || failed != (sub.MDReqID == "") {
+					t.Errorf("Subscribe() %v = %+v, %v", i, sub, err)
+				}
+			}
+
+			var live []string
+			for _, id := range []string{"R1", "R2"} {
+				if _, ok := m.Subscription(id); ok {
+					live = append(live, id)
+				}
+			}
+			if !reflect.DeepEqual(live, tt.live) {
+				t.Errorf("subscriptions %v, want %v", live, tt.live)
+			}
+			if tt.failAt == 0 {
+				return
+			}
+			if err := snapshot(fmt.Sprintf("R%v", tt.failAt))(m); err == nil {
+				t.Error("OnSnapshot() routed market data to a subscription that was not sent")
+			}
+		})
+	}
+}
+
+func TestOnLogonSendError(t *testing.T) {
+	m, s := newManager(0)
+	m.Subscribe([]string{"ABC"}, []enum.MDEntryType{"0"}, 0, UpdateFullRefresh, Subscriber{})
+	m.Subscribe([]string{"XYZ"}, []enum.MDEntryType{"0"}, 0, UpdateFullRefresh, Subscriber{})
+
+	s.FailAt = len(s.Sent) + 1
+	if err := m.OnLogon(); err != testutil.ErrSend {
+		t.Fatalf("OnLogon() = %v, want %v", err, testutil.ErrSend)
+	}
+	if len(s.Sent) != 3 {
+		t.Errorf("sent %v messages, want the resend to stop at the failed one", len(s.Sent))
+	}
+	if n := len(m.Subscriptions()); n != 2 {
+		t.Errorf("len(Subscriptions()) = %v, want 2 kept for the next logon", n)
+	}
+}
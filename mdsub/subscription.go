@@ -0,0 +1,102 @@
+package mdsub
+
+import (
+	"github.com/terracefi/enum"
+	"github.com/terracefi/fix44/internal/fixutil"
+	"github.com/terracefi/fix44/marketdataincrementalrefresh"
+	"github.com/terracefi/fix44/marketdatasnapshotfullrefresh"
+)
+
+//SubscriptionRequestType values, FIX 4.4
+const (
+	RequestSnapshot           = fixutil.SubscriptionSnapshot
+	RequestSnapshotAndUpdates = fixutil.SubscriptionSnapshotAndUpdates
+	RequestDisable            = fixutil.SubscriptionDisable
+)
+
+//MDUpdateType values, FIX 4.4
+const (
+	UpdateFullRefresh        enum.MDUpdateType = "0"
+	UpdateIncrementalRefresh enum.MDUpdateType = "1"
+)
+
+//MDReqRejReason values, FIX 4.4
+const (
+	RejUnknownSymbol                 enum.MDReqRejReason = "0"
+	RejDuplicateMDReqID              enum.MDReqRejReason = "1"
+	RejInsufficientBandwidth         enum.MDReqRejReason = "2"
+	RejInsufficientPermissions       enum.MDReqRejReason = "3"
+	RejUnsupportedSubscriptionType   enum.MDReqRejReason = "4"
+	RejUnsupportedMarketDepth        enum.MDReqRejReason = "5"
+	RejUnsupportedMDUpdateType       enum.MDReqRejReason = "6"
+	RejUnsupportedAggregatedBook     enum.MDReqRejReason = "7"
+	RejUnsupportedMDEntryType        enum.MDReqRejReason = "8"
+	RejUnsupportedTradingSessionID   enum.MDReqRejReason = "9"
+	RejUnsupportedScope              enum.MDReqRejReason = "A"
+	RejUnsupportedOpenCloseSettlFlag enum.MDReqRejReason = "B"
+	RejUnsupportedMDImplicitDelete   enum.MDReqRejReason = "C"
+)
+
+//State is the state of a subscription
+type State int
+
+//State values
+const (
+	//Pending is the state of a subscription whose MarketDataRequest has been sent and not answered yet
+	Pending State = iota
+	//Active is the state of a subscription that has received market data
+	Active
+	//Rejected is the state of a subscription ended by a MarketDataRequestReject
+	Rejected
+	//Canceled is the state of a subscription ended by Unsubscribe
+	Canceled
+)
+
+func (s State) String() string {
+	switch s {
+	case Pending:
+		return "Pending"
+	case Active:
+		return "Active"
+	case Rejected:
+		return "Rejected"
+	case Canceled:
+		return "Canceled"
+	}
+	return "Unknown"
+}
+
+//Subscription is a market data subscription made by a Manager
+type Subscription struct {
+	MDReqID    string
+	Symbols    []string
+	EntryTypes []enum.MDEntryType
+	//Depth is the MarketDepth of the subscription, 0 for the full book and 1 for the top of the book
+	Depth      int
+	UpdateType enum.MDUpdateType
+
+	State State
+	//RejReason and Text are those of the MarketDataRequestReject of a Rejected subscription, RejReason is nil if
+	//the reject had no MDReqRejReason
+	RejReason *enum.MDReqRejReason
+	Text      string
+}
+
+func (s *Subscription) clone() Subscription {
+	c := *s
+	c.Symbols = append([]string(nil), s.Symbols...)
+	c.EntryTypes = append([]enum.MDEntryType(nil), s.EntryTypes...)
+	if s.RejReason != nil {
+		r := *s.RejReason
+		c.RejReason = &r
+	}
+	return c
+}
+
+//Subscriber receives the market data of a subscription. Callbacks that are nil are not called.
+type Subscriber struct {
+	OnSnapshot    func(msg marketdatasnapshotfullrefresh.MarketDataSnapshotFullRefresh)
+	OnIncremental func(msg marketdataincrementalrefresh.MarketDataIncrementalRefresh)
+	//OnReject is called when the subscription is rejected, with the subscription in Rejected state
+	OnReject func(s Subscription)
+}
@@ -0,0 +1,108 @@
+package multileg
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/terracefi/enum"
+	"github.com/terracefi/fix44/multilegordercancelreplace"
+	"github.com/terracefi/fix44/newordermultileg"
+	"github.com/terracefi/quickfix"
+	"github.com/terracefi/tag"
+)
+
+//SessionRejectReason values used by Check
+const (
+	reasonRequiredTagMissing       = 1
+	reasonValueIsIncorrect         = 5
+	reasonIncorrectNumInGroupCount = 16
+)
+
+//legTerms holds the fields of a leg that Check looks at
+type legTerms struct {
+	RefID     *string
+	RatioQty  *decimal.Decimal
+	Side      *string
+	Qty       *decimal.Decimal
+	AllocQtys []*decimal.Decimal
+}
+
+//Check checks the legs of a NewOrderMultileg: there are at least two legs, each with a positive LegRatioQty and a
+//FIX 4.4 LegSide, LegRefIDs are not repeated, a LegQty is the OrderQty times the LegRatioQty, and the LegAllocQtys
+//of a leg add up to its LegQty.
+func Check(s newordermultileg.Struct) quickfix.MessageRejectError {
+	legs := make([]legTerms, len(s.NoLegs))
+	for i, l := range s.NoLegs {
+		legs[i] = legTerms{RefID: l.LegRefID, RatioQty: l.LegRatioQty, Side: l.LegSide, Qty: l.LegQty}
+		for _, a := range l.NoLegAllocs {
+			legs[i].AllocQtys = append(legs[i].AllocQtys, a.LegAllocQty)
+		}
+	}
+	return checkLegs(s.OrderQty, legs)
+}
+
+//CheckReplace checks the legs of a MultilegOrderCancelReplace, see Check
+func CheckReplace(s multilegordercancelreplace.Struct) quickfix.MessageRejectError {
+	legs := make([]legTerms, len(s.NoLegs))
+	for i, l := range s.NoLegs {
+		legs[i] = legTerms{RefID: l.LegRefID, RatioQty: l.LegRatioQty, Side: l.LegSide, Qty: l.LegQty}
+		for _, a := range l.NoLegAllocs {
+			legs[i].AllocQtys = append(legs[i].AllocQtys, a.LegAllocQty)
+		}
+	}
+	return checkLegs(s.OrderQty, legs)
+}
+
+func checkLegs(orderQty *decimal.Decimal, legs []legTerms) quickfix.MessageRejectError {
+	if len(legs) < 2 {
+		return reject(tag.NoLegs, reasonIncorrectNumInGroupCount, "order has %v legs", len(legs))
+	}
+
+	refIDs := make(map[string]bool)
+	for i, l := range legs {
+		switch {
+		case l.RatioQty == nil:
+			return reject(tag.LegRatioQty, reasonRequiredTagMissing, "leg %v has no LegRatioQty", i+1)
+		case !l.RatioQty.IsPositive():
+			return reject(tag.LegRatioQty, reasonValueIsIncorrect, "leg %v has LegRatioQty %v", i+1, *l.RatioQty)
+		case l.Side == nil:
+			return reject(tag.LegSide, reasonRequiredTagMissing, "leg %v has no LegSide", i+1)
+		case !validSide(enum.Side(*l.Side)):
+			return reject(tag.LegSide, reasonValueIsIncorrect, "leg %v has LegSide %v", i+1, *l.Side)
+		}
+
+		if l.RefID != nil {
+			if refIDs[*l.RefID] {
+				return reject(tag.LegRefID, reasonValueIsIncorrect, "LegRefID %v is repeated", *l.RefID)
+			}
+			refIDs[*l.RefID] = true
+		}
+
+		if l.Qty == nil {
+			continue
+		}
+		if orderQty != nil && !l.Qty.Equal(orderQty.Mul(*l.RatioQty)) {
+			return reject(tag.LegQty, reasonValueIsIncorrect,
+				"leg %v has LegQty %v, not OrderQty %v times LegRatioQty %v", i+1, *l.Qty, *orderQty, *l.RatioQty)
+		}
+		if len(l.AllocQtys) == 0 {
+			continue
+		}
+		allocated := decimal.Zero
+		for _, q := range l.AllocQtys {
+			if q != nil {
+				allocated = allocated.Add(*q)
+			}
+		}
+		if !allocated.Equal(*l.Qty) {
+			return reject(tag.LegAllocQty, reasonValueIsIncorrect, "leg %v allocates %v of LegQty %v", i+1, allocated,
+				*l.Qty)
+		}
+	}
+	return nil
+}
+
+func reject(t quickfix.Tag, reason int, format string, args ...interface{}) quickfix.MessageRejectError {
+	return quickfix.NewMessageRejectError("multileg: "+fmt.Sprintf(format, args...), reason, &t)
+}
@@ -0,0 +1,14 @@
+/*
+Package multileg builds multileg orders from strategy definitions and follows their executions leg by leg.
+
+A Strategy is read from a SecurityDefinition with legs. Its Order and Replace methods build the NewOrderMultileg and
+MultilegOrderCancelReplace for a quantity of the strategy: each leg is given a LegQty of the order quantity times its
+LegRatioQty, and the LegSide of the definition, which is the side taken when the strategy is bought, reversed when
+the strategy is sold. Check and CheckReplace check the legs of an order, however it was built.
+
+A Tracker follows multileg orders from the NewOrderMultileg and MultilegOrderCancelReplace messages that are sent
+and the ExecutionReports that are received. Reports with a MultiLegReportingType of individual leg update the fills
+of the leg they report on, the others update the order as a whole. When the counterparty only reports at the
+multileg level, the fills of the legs are implied from LastQty and the LegRatioQty of each leg.
+*/
+package multileg
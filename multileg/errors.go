@@ -0,0 +1,45 @@
+package multileg
+
+import (
+	"fmt"
+)
+
+//StrategyError is returned for a SecurityDefinition that does not define a strategy that can be traded as a multileg
+//order
+type StrategyError struct {
+	Symbol string
+	Reason string
+}
+
+func (e *StrategyError) Error() string {
+	return fmt.Sprintf("multileg: strategy %v: %v", e.Symbol, e.Reason)
+}
+
+//UnknownOrderError is returned for a message that refers to an order the Tracker does not know
+type UnknownOrderError struct {
+	ClOrdID string
+}
+
+func (e *UnknownOrderError) Error() string {
+	return fmt.Sprintf("multileg: unknown order %v", e.ClOrdID)
+}
+
+//DuplicateOrderError is returned when a NewOrderMultileg or MultilegOrderCancelReplace reuses a ClOrdID
+type DuplicateOrderError struct {
+	ClOrdID string
+}
+
+func (e *DuplicateOrderError) Error() string {
+	return fmt.Sprintf("multileg: duplicate ClOrdID %v", e.ClOrdID)
+}
+
+//UnknownLegError is returned for an ExecutionReport on an individual leg that matches no leg of the order. Leg is
+//the LegRefID, security or symbol the report names.
+type UnknownLegError struct {
+	ClOrdID string
+	Leg     string
+}
+
+func (e *UnknownLegError) Error() string {
+	return fmt.Sprintf("multileg: order %v has no leg %v", e.ClOrdID, e.Leg)
+}
@@ -0,0 +1,253 @@
+package multileg
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/terracefi/enum"
+	"github.com/terracefi/fix44/components"
+	"github.com/terracefi/fix44/internal/fixutil"
+	"github.com/terracefi/fix44/multilegordercancelreplace"
+	"github.com/terracefi/fix44/newordermultileg"
+	"github.com/terracefi/fix44/securitydefinition"
+)
+
+//Side values, FIX 4.4
+const (
+	SideBuy  enum.Side = "1"
+	SideSell enum.Side = "2"
+)
+
+//Strategy is a multileg instrument and the legs it is made of
+type Strategy struct {
+	Symbol           *string
+	SecurityID       *string
+	SecurityIDSource *enum.SecurityIDSource
+	SecurityType     *enum.SecurityType
+	AltIDs           []components.NoSecurityAltIDStruct
+
+	Legs []Leg
+}
+
+//Leg is a leg of a Strategy
+type Leg struct {
+	//RefID identifies the leg in orders and executions, it is the position of the leg in the definition counting
+	//from 1
+	RefID string
+
+	Symbol            *string
+	SymbolSfx         *string
+	SecurityID        *string
+	SecurityIDSource  *string
+	AltIDs            []components.NoLegSecurityAltIDStruct
+	Product           *int
+	CFICode           *string
+	SecurityType      *string
+	SecuritySubType   *string
+	MaturityMonthYear *string
+	MaturityDate      *string
+	StrikePrice       *decimal.Decimal
+	StrikeCurrency    *string
+	OptAttribute      *string
+	Multiplier        *decimal.Decimal
+	SecurityExchange  *string
+	SecurityDesc      *string
+	Currency          *string
+
+	//RatioQty is the quantity of the leg in one unit of the strategy
+	RatioQty decimal.Decimal
+	//Side is the side of the leg when the strategy is bought
+	Side enum.Side
+}
+
+//StrategyFromDefinition returns the Strategy defined by d. A leg without LegRatioQty has a ratio of 1 and a leg
+//without LegSide is bought when the strategy is bought.
+func StrategyFromDefinition(d securitydefinition.Struct) (Strategy, error) {
+	s := Strategy{
+		Symbol:           d.Symbol,
+		SecurityID:       d.SecurityID,
+		SecurityIDSource: d.SecurityIDSource,
+		SecurityType:     d.SecurityType,
+		AltIDs:           d.NoSecurityAltID,
+	}
+	if len(d.NoLegs) < 2 {
+		return Strategy{}, &StrategyError{s.name(), "fewer than two legs"}
+	}
+
+	for i, l := range d.NoLegs {
+		leg := Leg{
+			RefID:             strconv.Itoa(i + 1),
+			Symbol:            l.LegSymbol,
+			SymbolSfx:         l.LegSymbolSfx,
+			SecurityID:        l.LegSecurityID,
+			SecurityIDSource:  l.LegSecurityIDSource,
+			AltIDs:            l.NoLegSecurityAltID,
+			Product:           l.LegProduct,
+			CFICode:           l.LegCFICode,
+			SecurityType:      l.LegSecurityType,
+			SecuritySubType:   l.LegSecuritySubType,
+			MaturityMonthYear: l.LegMaturityMonthYear,
+			MaturityDate:      l.LegMaturityDate,
+			StrikePrice:       l.LegStrikePrice,
+			StrikeCurrency:    l.LegStrikeCurrency,
+			OptAttribute:      l.LegOptAttribute,
+			Multiplier:        l.LegContractMultiplier,
+			SecurityExchange:  l.LegSecurityExchange,
+			SecurityDesc:      l.LegSecurityDesc,
+			Currency:          l.LegCurrency,
+			RatioQty:          decimal.NewFromInt(1),
+			Side:              SideBuy,
+		}
+		if l.LegRatioQty != nil {
+			leg.RatioQty = *l.LegRatioQty
+		}
+		if l.LegSide != nil {
+			leg.Side = enum.Side(*l.LegSide)
+		}
+
+		switch {
+		case !leg.RatioQty.IsPositive():
+			return Strategy{}, &StrategyError{s.name(), "leg " + leg.RefID + " has a LegRatioQty that is not positive"}
+		case !validSide(leg.Side):
+			return Strategy{}, &StrategyError{s.name(), "leg " + leg.RefID + " has LegSide " + string(leg.Side)}
+		}
+		s.Legs = append(s.Legs, leg)
+	}
+	return s, nil
+}
+
+func (s Strategy) name() string {
+	if s.Symbol != nil {
+		return *s.Symbol
+	}
+	return fixutil.Deref(s.SecurityID)
+}
+
+//Order returns the NewOrderMultileg for qty units of the strategy, bought or sold according to side. Price, account
+//and the other order terms are left for the caller to set.
+func (s Strategy) Order(clOrdID string, side enum.Side, qty decimal.Decimal,
+	ordType enum.OrdType) newordermultileg.Struct {
+	o := newordermultileg.Struct{
+		ClOrdID:          clOrdID,
+		Side:             side,
+		OrdType:          ordType,
+		OrderQty:         &qty,
+		TransactTime:     time.Now(),
+		Symbol:           s.Symbol,
+		SecurityID:       s.SecurityID,
+		SecurityIDSource: s.SecurityIDSource,
+		SecurityType:     s.SecurityType,
+		NoSecurityAltID:  s.AltIDs,
+	}
+	for _, l := range s.Legs {
+		legSide, legQty := string(l.sideFor(side)), l.RatioQty.Mul(qty)
+		refID, ratio := l.RefID, l.RatioQty
+		o.NoLegs = append(o.NoLegs, newordermultileg.NoLegsStruct{
+			LegSymbol:             l.Symbol,
+			LegSymbolSfx:          l.SymbolSfx,
+			LegSecurityID:         l.SecurityID,
+			LegSecurityIDSource:   l.SecurityIDSource,
+			NoLegSecurityAltID:    l.AltIDs,
+			LegProduct:            l.Product,
+			LegCFICode:            l.CFICode,
+			LegSecurityType:       l.SecurityType,
+			LegSecuritySubType:    l.SecuritySubType,
+			LegMaturityMonthYear:  l.MaturityMonthYear,
+			LegMaturityDate:       l.MaturityDate,
+			LegStrikePrice:        l.StrikePrice,
+			LegStrikeCurrency:     l.StrikeCurrency,
+			LegOptAttribute:       l.OptAttribute,
+			LegContractMultiplier: l.Multiplier,
+			LegSecurityExchange:   l.SecurityExchange,
+			LegSecurityDesc:       l.SecurityDesc,
+			LegCurrency:           l.Currency,
+			LegRefID:              &refID,
+			LegRatioQty:           &ratio,
+			LegSide:               &legSide,
+			LegQty:                &legQty,
+		})
+	}
+	return o
+}
+
+//Replace returns the MultilegOrderCancelReplace that replaces the order origClOrdID by an order for qty units of
+//the strategy, see Order
+func (s Strategy) Replace(origClOrdID, clOrdID string, side enum.Side, qty decimal.Decimal,
+	ordType enum.OrdType) multilegordercancelreplace.Struct {
+	o := multilegordercancelreplace.Struct{
+		OrigClOrdID:      origClOrdID,
+		ClOrdID:          clOrdID,
+		Side:             side,
+		OrdType:          ordType,
+		OrderQty:         &qty,
+		TransactTime:     time.Now(),
+		Symbol:           s.Symbol,
+		SecurityID:       s.SecurityID,
+		SecurityIDSource: s.SecurityIDSource,
+		SecurityType:     s.SecurityType,
+		NoSecurityAltID:  s.AltIDs,
+	}
+	for _, l := range s.Legs {
+		legSide, legQty := string(l.sideFor(side)), l.RatioQty.Mul(qty)
+		refID, ratio := l.RefID, l.RatioQty
+		o.NoLegs = append(o.NoLegs, multilegordercancelreplace.NoLegsStruct{
+			LegSymbol:             l.Symbol,
+			LegSymbolSfx:          l.SymbolSfx,
+			LegSecurityID:         l.SecurityID,
+			LegSecurityIDSource:   l.SecurityIDSource,
+			NoLegSecurityAltID:    l.AltIDs,
+			LegProduct:            l.Product,
+			LegCFICode:            l.CFICode,
+			LegSecurityType:       l.SecurityType,
+			LegSecuritySubType:    l.SecuritySubType,
+			LegMaturityMonthYear:  l.MaturityMonthYear,
+			LegMaturityDate:       l.MaturityDate,
+			LegStrikePrice:        l.StrikePrice,
+			LegStrikeCurrency:     l.StrikeCurrency,
+			LegOptAttribute:       l.OptAttribute,
+			LegContractMultiplier: l.Multiplier,
+			LegSecurityExchange:   l.SecurityExchange,
+			LegSecurityDesc:       l.SecurityDesc,
+			LegCurrency:           l.Currency,
+			LegRefID:              &refID,
+			LegRatioQty:           &ratio,
+			LegSide:               &legSide,
+			LegQty:                &legQty,
+		})
+	}
+	return o
+}
+
+//sideFor returns the side of the leg when the strategy is traded on the given side
+func (l Leg) sideFor(side enum.Side) enum.Side {
+	if isBuy(side) {
+		return l.Side
+	}
+	return opposite(l.Side)
+}
+
+func isBuy(side enum.Side) bool {
+	switch side {
+	case SideBuy, enum.Side("3"):
+		return true
+	}
+	return false
+}
+
+func opposite(side enum.Side) enum.Side {
+	if isBuy(side) {
+		return SideSell
+	}
+	return SideBuy
+}
+
+//validSide returns true if side is a FIX 4.4 Side value
+func validSide(side enum.Side) bool {
+	switch side {
+	case "1", "2", "3", "4", "5", "6", "7", "8", "9", "A", "B", "C", "D", "E", "F", "G":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,351 @@
+package multileg
+
+import (
+	"strconv"
+	"sync"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/terracefi/enum"
+	"github.com/terracefi/fix44/executionreport"
+	"github.com/terracefi/fix44/internal/fixutil"
+	"github.com/terracefi/fix44/multilegordercancelreplace"
+	"github.com/terracefi/fix44/newordermultileg"
+	"github.com/terracefi/fix44/orderstate"
+)
+
+//MultiLegReportingType values, FIX 4.4
+const (
+	ReportSingleSecurity enum.MultiLegReportingType = "1"
+	ReportIndividualLeg  enum.MultiLegReportingType = "2"
+	ReportMultileg       enum.MultiLegReportingType = "3"
+)
+
+//Order is the state of a multileg order as seen by a Tracker
+type Order struct {
+	//ClOrdID is the ClOrdID of the order currently in force
+	ClOrdID string
+	//ClOrdIDs holds every ClOrdID the order has had, oldest first
+	ClOrdIDs []string
+	OrderID  string
+
+	Symbol   string
+	Side     enum.Side
+	OrderQty decimal.Decimal
+
+	//Status, CumQty, LeavesQty and AvgPx are those of the last report on the order as a whole
+	Status    enum.OrdStatus
+	CumQty    decimal.Decimal
+	LeavesQty decimal.Decimal
+	AvgPx     decimal.Decimal
+
+	Legs []LegFill
+
+	//Pending is the MultilegOrderCancelReplace awaiting a response, if any
+	Pending *Replace
+}
+
+//LegFill is the state of a leg of a multileg order
+type LegFill struct {
+	RefID      string
+	Symbol     string
+	SecurityID string
+	Side       enum.Side
+	RatioQty   decimal.Decimal
+	//Qty is the LegQty of the leg, zero if the order did not give one
+	Qty decimal.Decimal
+
+	CumQty decimal.Decimal
+	AvgPx  decimal.Decimal
+	LastPx decimal.Decimal
+}
+
+//Replace is a MultilegOrderCancelReplace sent for an order
+type Replace struct {
+	ClOrdID  string
+	OrderQty decimal.Decimal
+	Legs     []LegFill
+}
+
+//ImpliedCumQty returns the quantity of the strategy whose legs have all been filled, going by the fills of the legs
+func (o Order) ImpliedCumQty() decimal.Decimal {
+	var qty decimal.Decimal
+	for i, l := range o.Legs {
+		if q := l.CumQty.Div(l.RatioQty); i == 0 || q.LessThan(qty) {
+			qty = q
+		}
+	}
+	return qty
+}
+
+func (o *Order) clone() Order {
+	c := *o
+	c.ClOrdIDs = append([]string(nil), o.ClOrdIDs...)
+	c.Legs = append([]LegFill(nil), o.Legs...)
+	if o.Pending != nil {
+		p := *o.Pending
+		p.Legs = append([]LegFill(nil), o.Pending.Legs...)
+		c.Pending = &p
+	}
+	return c
+}
+
+//leg returns the leg identified by refID, or failing that by securityID or symbol
+func (o *Order) leg(refID, securityID, symbol string) *LegFill {
+	for i := range o.Legs {
+		if refID != "" && o.Legs[i].RefID == refID {
+			return &o.Legs[i]
+		}
+	}
+	for i := range o.Legs {
+		l := &o.Legs[i]
+		switch {
+		case securityID != "" && l.SecurityID == securityID:
+			return l
+		case securityID == "" && symbol != "" && l.Symbol == symbol:
+			return l
+		}
+	}
+	return nil
+}
+
+//Tracker follows multileg orders and the fills of their legs. It is safe for concurrent use.
+type Tracker struct {
+	mu       sync.Mutex
+	orders   map[string]*Order
+	orderIDs map[string]*Order
+	execIDs  map[string]bool
+	//legReports holds the orders that have had a report on an individual leg
+	legReports map[*Order]bool
+}
+
+//New returns an empty Tracker
+func New() *Tracker {
+	return &Tracker{
+		orders:     make(map[string]*Order),
+		orderIDs:   make(map[string]*Order),
+		execIDs:    make(map[string]bool),
+		legReports: make(map[*Order]bool),
+	}
+}
+
+//Order returns the order that has, or has had, the given ClOrdID
+func (t *Tracker) Order(clOrdID string) (Order, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	o, ok := t.orders[clOrdID]
+	if !ok {
+		return Order{}, false
+	}
+	return o.clone(), true
+}
+
+//OnNewOrderMultileg starts tracking the order, in PendingNew status. The order is checked with Check first.
+func (t *Tracker) OnNewOrderMultileg(msg newordermultileg.NewOrderMultileg) (Order, error) {
+	s, err := newordermultileg.Marshal(msg)
+	if err != nil {
+		return Order{}, err
+	}
+	if err := Check(s); err != nil {
+		return Order{}, err
+	}
+
+	o := &Order{
+		ClOrdID:  s.ClOrdID,
+		ClOrdIDs: []string{s.ClOrdID},
+		Symbol:   fixutil.Deref(s.Symbol),
+		Side:     s.Side,
+		Status:   orderstate.StatusPendingNew,
+	}
+	if s.OrderQty != nil {
+		o.OrderQty = *s.OrderQty
+	}
+	o.LeavesQty = o.OrderQty
+	for i, l := range s.NoLegs {
+		o.Legs = append(o.Legs, legFill(i, l.LegRefID, l.LegSymbol, l.LegSecurityID, l.LegSide, l.LegRatioQty,
+			l.LegQty))
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if _, ok := t.orders[s.ClOrdID]; ok {
+		return Order{}, &DuplicateOrderError{s.ClOrdID}
+	}
+	t.orders[s.ClOrdID] = o
+	return o.clone(), nil
+}
+
+//OnMultilegOrderCancelReplace records a replace request for the order identified by OrigClOrdID. The request is
+//checked with CheckReplace first.
+func (t *Tracker) OnMultilegOrderCancelReplace(
+	msg multilegordercancelreplace.MultilegOrderCancelReplace,
+) (Order, error) {
+	s, err := multilegordercancelreplace.Marshal(msg)
+	if err != nil {
+		return Order{}, err
+	}
+	if err := CheckReplace(s); err != nil {
+		return Order{}, err
+	}
+
+	r := &Replace{ClOrdID: s.ClOrdID}
+	if s.OrderQty != nil {
+		r.OrderQty = *s.OrderQty
+	}
+	for i, l := range s.NoLegs {
+		r.Legs = append(r.Legs, legFill(i, l.LegRefID, l.LegSymbol, l.LegSecurityID, l.LegSide, l.LegRatioQty,
+			l.LegQty))
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	o, ok := t.orders[s.OrigClOrdID]
+	if !ok {
+		return Order{}, &UnknownOrderError{s.OrigClOrdID}
+	}
+	if _, ok := t.orders[s.ClOrdID]; ok {
+		return o.clone(), &DuplicateOrderError{s.ClOrdID}
+	}
+	o.Pending = r
+	t.orders[s.ClOrdID] = o
+	return o.clone(), nil
+}
+
+//legFill returns the LegFill for the leg at index i of an order, whose LegRefID defaults to its position counting
+//from 1
+func legFill(i int, refID, symbol, securityID, side *string, ratioQty, qty *decimal.Decimal) LegFill {
+	l := LegFill{
+		RefID:      fixutil.Deref(refID),
+		Symbol:     fixutil.Deref(symbol),
+		SecurityID: fixutil.Deref(securityID),
+		Side:       enum.Side(fixutil.Deref(side)),
+	}
+	if l.RefID == "" {
+		l.RefID = strconv.Itoa(i + 1)
+	}
+	if ratioQty != nil {
+		l.RatioQty = *ratioQty
+	}
+	if qty != nil {
+		l.Qty = *qty
+	}
+	return l
+}
+
+//OnExecutionReport applies an ExecutionReport to the order it refers to, found by ClOrdID, OrigClOrdID or OrderID.
+//A report on an individual leg updates the fills of the leg, matched by the LegRefID, security or symbol of the
+//report. Other reports update the order, and the legs as well when the order has had no report on an individual
+//leg. Reports with an ExecID that has already been applied are ignored.
+func (t *Tracker) OnExecutionReport(msg executionreport.ExecutionReport) (Order, error) {
+	r, err := executionreport.Marshal(msg)
+	if err != nil {
+		return Order{}, err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	o := t.find(fixutil.Deref(r.ClOrdID), fixutil.Deref(r.OrigClOrdID), r.OrderID)
+	if o == nil {
+		id := fixutil.Deref(r.ClOrdID)
+		if id == "" {
+			id = r.OrderID
+		}
+		return Order{}, &UnknownOrderError{id}
+	}
+	if t.execIDs[r.ExecID] {
+		return o.clone(), nil
+	}
+
+	if r.MultiLegReportingType != nil && *r.MultiLegReportingType == ReportIndividualLeg {
+		refID, securityID, symbol := "", fixutil.Deref(r.SecurityID), fixutil.Deref(r.Symbol)
+		if len(r.NoLegs) > 0 {
+			l := r.NoLegs[0]
+			refID, securityID, symbol = fixutil.Deref(l.LegRefID), fixutil.Deref(l.LegSecurityID),
+				fixutil.Deref(l.LegSymbol)
+		}
+		l := o.leg(refID, securityID, symbol)
+		if l == nil {
+			return o.clone(), &UnknownLegError{o.ClOrdID, firstOf(refID, securityID, symbol)}
+		}
+		t.execIDs[r.ExecID] = true
+		t.legReports[o] = true
+
+		l.CumQty = r.CumQty
+		l.AvgPx = r.AvgPx
+		switch {
+		case len(r.NoLegs) > 0 && r.NoLegs[0].LegLastPx != nil:
+			l.LastPx = *r.NoLegs[0].LegLastPx
+		case r.LastPx != nil:
+			l.LastPx = *r.LastPx
+		}
+		return o.clone(), nil
+	}
+
+	t.execIDs[r.ExecID] = true
+	if p := o.Pending; p != nil && r.ExecType == orderstate.ExecReplaced && fixutil.Deref(r.ClOrdID) == p.ClOrdID {
+		o.ClOrdID = p.ClOrdID
+		o.ClOrdIDs = append(o.ClOrdIDs, p.ClOrdID)
+		o.OrderQty = p.OrderQty
+		for _, pl := range p.Legs {
+			if l := o.leg(pl.RefID, "", ""); l != nil {
+				l.Side, l.RatioQty, l.Qty = pl.Side, pl.RatioQty, pl.Qty
+			}
+		}
+		o.Pending = nil
+	}
+	if o.OrderID == "" && r.OrderID != "" {
+		o.OrderID = r.OrderID
+		t.orderIDs[r.OrderID] = o
+	}
+	o.Status = r.OrdStatus
+	o.CumQty = r.CumQty
+	o.LeavesQty = r.LeavesQty
+	o.AvgPx = r.AvgPx
+
+	if r.ExecType == orderstate.ExecTrade && r.LastQty != nil && !t.legReports[o] {
+		for i := range o.Legs {
+			l := &o.Legs[i]
+			qty := r.LastQty.Mul(l.RatioQty)
+			for _, rl := range r.NoLegs {
+				if fixutil.Deref(rl.LegRefID) == l.RefID && rl.LegLastPx != nil {
+					l.LastPx = *rl.LegLastPx
+					l.AvgPx = vwap(l.AvgPx, l.CumQty, l.LastPx, qty)
+				}
+			}
+			l.CumQty = l.CumQty.Add(qty)
+		}
+	}
+	return o.clone(), nil
+}
+
+func (t *Tracker) find(clOrdID, origClOrdID, orderID string) *Order {
+	if o, ok := t.orders[clOrdID]; ok {
+		return o
+	}
+	if o, ok := t.orders[origClOrdID]; ok {
+		return o
+	}
+	return t.orderIDs[orderID]
+}
+
+//vwap returns the average price of qty at px added to cumQty at avgPx
+func vwap(avgPx, cumQty, px, qty decimal.Decimal) decimal.Decimal {
+	total := cumQty.Add(qty)
+	if total.IsZero() {
+		return avgPx
+	}
+	return avgPx.Mul(cumQty).Add(px.Mul(qty)).Div(total)
+}
+
+func firstOf(ids ...string) string {
+	for _, id := range ids {
+		if id != "" {
+			return id
+		}
+	}
+	return ""
+}
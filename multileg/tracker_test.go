@@ -0,0 +1,364 @@
+package multileg
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/terracefi/enum"
+	"github.com/terracefi/fix44/executionreport"
+	"github.com/terracefi/fix44/internal/fixutil"
+	"github.com/terracefi/fix44/multilegordercancelreplace"
+	"github.com/terracefi/fix44/newordermultileg"
+	"github.com/terracefi/fix44/orderstate"
+	"github.com/terracefi/fix44/securitydefinition"
+	"github.com/terracefi/quickfix"
+	"github.com/terracefi/tag"
+)
+
+const limit enum.OrdType = "2"
+
+//definition defines the strategy SPR, which buys one L1 and sells two L2
+func definition() securitydefinition.Struct {
+	return securitydefinition.Struct{
+		Symbol: fixutil.Ptr("SPR"),
+		NoLegs: []securitydefinition.NoLegsStruct{
+			{LegSymbol: fixutil.Ptr("L1")},
+			{LegSymbol: fixutil.Ptr("L2"), LegRatioQty: fixutil.Ptr(decimal.NewFromInt(2)), LegSide: fixutil.Ptr("2")},
+		},
+	}
+}
+
+func strategy(t *testing.T) Strategy {
+	s, err := StrategyFromDefinition(definition())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestStrategyFromDefinition(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(d *securitydefinition.Struct)
+		wantErr bool
+	}{
+		{"valid", func(d *securitydefinition.Struct) {}, false},
+		{"one leg", func(d *securitydefinition.Struct) { d.NoLegs = d.NoLegs[:1] }, true},
+		{"zero LegRatioQty", func(d *securitydefinition.Struct) { d.NoLegs[1].LegRatioQty = fixutil.Ptr(decimal.Zero) },
+			true},
+		{"LegSide", func(d *securitydefinition.Struct) { d.NoLegs[1].LegSide = fixutil.Ptr("Z") }, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := definition()
+			tt.modify(&d)
+			s, err := StrategyFromDefinition(d)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("StrategyFromDefinition() error = %v, want error %v", err, tt.wantErr)
+			}
+			if err != nil {
+				if _, ok := err.(*StrategyError); !ok {
+					t.Errorf("error = %T, want *StrategyError", err)
+				}
+				return
+			}
+			want := []Leg{{RefID: "1", Symbol: fixutil.Ptr("L1"), RatioQty: decimal.NewFromInt(1), Side: SideBuy},
+				{RefID: "2", Symbol: fixutil.Ptr("L2"), RatioQty: decimal.NewFromInt(2), Side: SideSell}}
+			if !reflect.DeepEqual(s.Legs, want) {
+				t.Errorf("Legs = %+v, want %+v", s.Legs, want)
+			}
+		})
+	}
+}
+
+func TestStrategyOrder(t *testing.T) {
+	tests := []struct {
+		side  enum.Side
+		sides []string
+	}{
+		{SideBuy, []string{"1", "2"}},
+		{"3", []string{"1", "2"}},
+		{SideSell, []string{"2", "1"}},
+		{"5", []string{"2", "1"}},
+	}
+	for _, tt := range tests {
+		o := strategy(t).Order("A", tt.side, decimal.NewFromInt(10), limit)
+		if err := Check(o); err != nil {
+			t.Errorf("side %v: Check() = %v", tt.side, err)
+		}
+		var sides []string
+		for _, l := range o.NoLegs {
+			sides = append(sides, *l.LegSide)
+		}
+		if !reflect.DeepEqual(sides, tt.sides) {
+			t.Errorf("side %v: LegSides = %v, want %v", tt.side, sides, tt.sides)
+		}
+		if q := o.NoLegs[1].LegQty; q == nil || !q.Equal(decimal.NewFromInt(20)) {
+			t.Errorf("side %v: LegQty = %v, want 20", tt.side, q)
+		}
+	}
+}
+
+func TestCheck(t *testing.T) {
+	allocs := func(qtys ...int64) []newordermultileg.NoLegAllocsStruct {
+		var a []newordermultileg.NoLegAllocsStruct
+		for _, q := range qtys {
+			a = append(a, newordermultileg.NoLegAllocsStruct{LegAllocQty: fixutil.Ptr(decimal.NewFromInt(q))})
+		}
+		return a
+	}
+	tests := []struct {
+		name   string
+		modify func(s *newordermultileg.Struct)
+		tag    quickfix.Tag
+	}{
+		{"valid", func(s *newordermultileg.Struct) {}, 0},
+		{"one leg", func(s *newordermultileg.Struct) { s.NoLegs = s.NoLegs[:1] }, tag.NoLegs},
+		{"no LegRatioQty", func(s *newordermultileg.Struct) { s.NoLegs[0].LegRatioQty = nil }, tag.LegRatioQty},
+		{"negative LegRatioQty", func(s *newordermultileg.Struct) {
+			s.NoLegs[0].LegRatioQty = fixutil.Ptr(decimal.NewFromInt(-1))
+		}, tag.LegRatioQty},
+		{"no LegSide", func(s *newordermultileg.Struct) { s.NoLegs[1].LegSide = nil }, tag.LegSide},
+		{"LegSide", func(s *newordermultileg.Struct) { s.NoLegs[1].LegSide = fixutil.Ptr("Z") }, tag.LegSide},
+		{"repeated LegRefID", func(s *newordermultileg.Struct) { s.NoLegs[1].LegRefID = fixutil.Ptr("1") },
+			tag.LegRefID},
+		{"no LegRefID", func(s *newordermultileg.Struct) { s.NoLegs[0].LegRefID, s.NoLegs[1].LegRefID = nil, nil }, 0},
+		{"LegQty", func(s *newordermultileg.Struct) { s.NoLegs[1].LegQty = fixutil.Ptr(decimal.NewFromInt(10)) },
+			tag.LegQty},
+		{"no LegQty", func(s *newordermultileg.Struct) { s.NoLegs[1].LegQty = nil }, 0},
+		{"no OrderQty", func(s *newordermultileg.Struct) { s.OrderQty = nil }, 0},
+		{"LegAllocQtys", func(s *newordermultileg.Struct) { s.NoLegs[1].NoLegAllocs = allocs(5, 15) }, 0},
+		{"LegAllocQtys short", func(s *newordermultileg.Struct) { s.NoLegs[1].NoLegAllocs = allocs(5, 10) },
+			tag.LegAllocQty},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := strategy(t).Order("A", SideBuy, decimal.NewFromInt(10), limit)
+			tt.modify(&s)
+
+			err := Check(s)
+			switch {
+			case tt.tag == 0 && err != nil:
+				t.Errorf("Check() = %v, want nil", err)
+			case tt.tag != 0 && err == nil:
+				t.Errorf("Check() = nil, want a reject of tag %v", tt.tag)
+			case tt.tag != 0 && (err.RefTagID() == nil || *err.RefTagID() != tt.tag):
+				t.Errorf("Check() = %v, want a reject of tag %v", err, tt.tag)
+			}
+		})
+	}
+}
+
+type step func(t *Tracker) (Order, error)
+
+func newOrder(s Strategy, clOrdID string, qty int64) step {
+	return func(t *Tracker) (Order, error) {
+		return t.OnNewOrderMultileg(newordermultileg.Unmarshal(s.Order(clOrdID, SideBuy, decimal.NewFromInt(qty),
+			limit)))
+	}
+}
+
+func replace(s Strategy, origClOrdID, clOrdID string, qty int64, legs int) step {
+	return func(t *Tracker) (Order, error) {
+		r := s.Replace(origClOrdID, clOrdID, SideBuy, decimal.NewFromInt(qty), limit)
+		r.NoLegs = r.NoLegs[:legs]
+		return t.OnMultilegOrderCancelReplace(multilegordercancelreplace.Unmarshal(r))
+	}
+}
+
+//report is an ExecutionReport on the order as a whole, each leg of a trade being filled at LegLastPx 10
+type report struct {
+	clOrdID, execID string
+	execType        enum.ExecType
+	status          enum.OrdStatus
+	lastQty, cumQty int64
+}
+
+func (r report) step(t *Tracker) (Order, error) {
+	s := executionreport.Struct{OrderID: "O1", ExecID: r.execID, ExecType: r.execType, OrdStatus: r.status,
+		Side: SideBuy, ClOrdID: fixutil.Ptr(r.clOrdID), CumQty: decimal.NewFromInt(r.cumQty)}
+	if r.lastQty != 0 {
+		s.LastQty = fixutil.Ptr(decimal.NewFromInt(r.lastQty))
+		s.NoLegs = []executionreport.NoLegsStruct{
+			{LegRefID: fixutil.Ptr("1"), LegLastPx: fixutil.Ptr(decimal.NewFromInt(10))},
+			{LegRefID: fixutil.Ptr("2"), LegLastPx: fixutil.Ptr(decimal.NewFromInt(10))},
+		}
+	}
+	return t.OnExecutionReport(executionreport.Unmarshal(s))
+}
+
+//legReport is an ExecutionReport on an individual leg, identified by its LegRefID or else its Symbol
+func legReport(clOrdID, execID, refID, symbol string, cumQty int64) step {
+	return func(t *Tracker) (Order, error) {
+		s := executionreport.Struct{OrderID: "O1", ExecID: execID, ExecType: orderstate.ExecTrade, OrdStatus: "1",
+			Side: SideBuy, ClOrdID: fixutil.Ptr(clOrdID), CumQty: decimal.NewFromInt(cumQty),
+			MultiLegReportingType: fixutil.Ptr(ReportIndividualLeg)}
+		if refID != "" {
+			s.NoLegs = []executionreport.NoLegsStruct{{LegRefID: fixutil.Ptr(refID)}}
+		} else {
+			s.Symbol = fixutil.Ptr(symbol)
+		}
+		return t.OnExecutionReport(executionreport.Unmarshal(s))
+	}
+}
+
+func TestTracker(t *testing.T) {
+	s := strategy(t)
+	tests := []struct {
+		name     string
+		steps    []step
+		wantErr  error
+		clOrdIDs []string
+		orderQty int64
+		legQtys  []int64
+		legCums  []int64
+		implied  int64
+		pending  bool
+	}{
+		{
+			name:     "new",
+			steps:    []step{newOrder(s, "A", 10)},
+			clOrdIDs: []string{"A"}, orderQty: 10, legQtys: []int64{10, 20}, legCums: []int64{0, 0},
+		},
+		{
+			name:     "duplicate ClOrdID",
+			steps:    []step{newOrder(s, "A", 10), newOrder(s, "A", 5)},
+			wantErr:  &DuplicateOrderError{},
+			clOrdIDs: []string{"A"}, orderQty: 10, legQtys: []int64{10, 20}, legCums: []int64{0, 0},
+		},
+		{
+			name:     "fill",
+			steps:    []step{newOrder(s, "A", 10), report{"A", "E1", orderstate.ExecTrade, "1", 4, 4}.step},
+			clOrdIDs: []string{"A"}, orderQty: 10, legQtys: []int64{10, 20}, legCums: []int64{4, 8}, implied: 4,
+		},
+		{
+			name: "duplicate ExecID is ignored",
+			steps: []step{newOrder(s, "A", 10), report{"A", "E1", orderstate.ExecTrade, "1", 4, 4}.step,
+				report{"A", "E1", orderstate.ExecTrade, "1", 4, 4}.step},
+			clOrdIDs: []string{"A"}, orderQty: 10, legQtys: []int64{10, 20}, legCums: []int64{4, 8}, implied: 4,
+		},
+		{
+			name:     "pending replace",
+			steps:    []step{newOrder(s, "A", 10), replace(s, "A", "B", 20, 2)},
+			clOrdIDs: []string{"A"}, orderQty: 10, legQtys: []int64{10, 20}, legCums: []int64{0, 0}, pending: true,
+		},
+		{
+			name: "replaced",
+			steps: []step{newOrder(s, "A", 10), replace(s, "A", "B", 20, 2),
+				report{"B", "E1", orderstate.ExecReplaced, "0", 0, 0}.step},
+			clOrdIDs: []string{"A", "B"}, orderQty: 20, legQtys: []int64{20, 40}, legCums: []int64{0, 0},
+		},
+		{
+			name: "replaced after a fill",
+			steps: []step{newOrder(s, "A", 10), report{"A", "E1", orderstate.ExecTrade, "1", 4, 4}.step,
+				replace(s, "A", "B", 20, 2), report{"B", "E2", orderstate.ExecReplaced, "1", 0, 4}.step,
+				report{"B", "E3", orderstate.ExecTrade, "1", 2, 6}.step},
+			clOrdIDs: []string{"A", "B"}, orderQty: 20, legQtys: []int64{20, 40}, legCums: []int64{6, 12}, implied: 6,
+		},
+		{
+			name:     "replace reuses a ClOrdID",
+			steps:    []step{newOrder(s, "A", 10), replace(s, "A", "A", 20, 2)},
+			wantErr:  &DuplicateOrderError{},
+			clOrdIDs: []string{"A"}, orderQty: 10, legQtys: []int64{10, 20}, legCums: []int64{0, 0},
+		},
+		{
+			name:     "replace with one leg",
+			steps:    []step{newOrder(s, "A", 10), replace(s, "A", "B", 20, 1)},
+			wantErr:  Check(newordermultileg.Struct{}),
+			clOrdIDs: []string{"A"}, orderQty: 10, legQtys: []int64{10, 20}, legCums: []int64{0, 0},
+		},
+		{
+			name:    "replace of an unknown order",
+			steps:   []step{replace(s, "A", "B", 20, 2)},
+			wantErr: &UnknownOrderError{},
+		},
+		{
+			name:    "report on an unknown order",
+			steps:   []step{report{"A", "E1", orderstate.ExecTrade, "1", 4, 4}.step},
+			wantErr: &UnknownOrderError{},
+		},
+		{
+			name: "leg reports",
+			steps: []step{newOrder(s, "A", 10), legReport("A", "E1", "2", "", 6), legReport("A", "E2", "", "L1", 2),
+				report{"A", "E3", orderstate.ExecTrade, "1", 4, 4}.step},
+			clOrdIDs: []string{"A"}, orderQty: 10, legQtys: []int64{10, 20}, legCums: []int64{2, 6}, implied: 2,
+		},
+		{
+			name:     "report on an unknown leg",
+			steps:    []step{newOrder(s, "A", 10), legReport("A", "E1", "9", "", 6)},
+			wantErr:  &UnknownLegError{},
+			clOrdIDs: []string{"A"}, orderQty: 10, legQtys: []int64{10, 20}, legCums: []int64{0, 0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tr := New()
+			var err error
+			for i, s := range tt.steps {
+				_, err = s(tr)
+				if i < len(tt.steps)-1 && err != nil {
+					t.Fatalf("step %v: %v", i, err)
+				}
+			}
+			if reflect.TypeOf(err) != reflect.TypeOf(tt.wantErr) {
+				t.Fatalf("error = %v, want %T", err, tt.wantErr)
+			}
+
+			o, ok := tr.Order("A")
+			if ok != (tt.clOrdIDs != nil) {
+				t.Fatalf("Order() found = %v, want %v", ok, tt.clOrdIDs != nil)
+			}
+			if !ok {
+				return
+			}
+			if !reflect.DeepEqual(o.ClOrdIDs, tt.clOrdIDs) {
+				t.Errorf("ClOrdIDs = %v, want %v", o.ClOrdIDs, tt.clOrdIDs)
+			}
+			if !o.OrderQty.Equal(decimal.NewFromInt(tt.orderQty)) {
+				t.Errorf("OrderQty = %v, want %v", o.OrderQty, tt.orderQty)
+			}
+			var qtys, cums []int64
+			for _, l := range o.Legs {
+				qtys, cums = append(qtys, l.Qty.IntPart()), append(cums, l.CumQty.IntPart())
+			}
+			if !reflect.DeepEqual(qtys, tt.legQtys) || !reflect.DeepEqual(cums, tt.legCums) {
+				t.Errorf("leg Qty, CumQty = %v, %v, want %v, %v", qtys, cums, tt.legQtys, tt.legCums)
+			}
+			if !o.ImpliedCumQty().Equal(decimal.NewFromInt(tt.implied)) {
+				t.Errorf("ImpliedCumQty() = %v, want %v", o.ImpliedCumQty(), tt.implied)
+			}
+			if (o.Pending != nil) != tt.pending {
+				t.Errorf("Pending = %v, want pending %v", o.Pending, tt.pending)
+			}
+		})
+	}
+}
+
+func TestTrackerLegAvgPx(t *testing.T) {
+	tr := New()
+	steps := []step{newOrder(strategy(t), "A", 10), report{"A", "E1", orderstate.ExecTrade, "1", 4, 4}.step}
+	for i, s := range steps {
+		if _, err := s(tr); err != nil {
+			t.Fatalf("step %v: %v", i, err)
+		}
+	}
+	msg := executionreport.Unmarshal(executionreport.Struct{OrderID: "O1", ExecID: "E2", ExecType: orderstate.ExecTrade,
+		OrdStatus: "1", Side: SideBuy, ClOrdID: fixutil.Ptr("A"), CumQty: decimal.NewFromInt(6),
+		LastQty: fixutil.Ptr(decimal.NewFromInt(2)), NoLegs: []executionreport.NoLegsStruct{
+			{LegRefID: fixutil.Ptr("1"), LegLastPx: fixutil.Ptr(decimal.NewFromInt(16))},
+		}})
+	o, err := tr.OnExecutionReport(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l := o.Legs[0]; !l.AvgPx.Equal(decimal.NewFromInt(12)) || !l.LastPx.Equal(decimal.NewFromInt(16)) {
+		t.Errorf("leg 1 AvgPx, LastPx = %v, %v, want 12, 16", l.AvgPx, l.LastPx)
+	}
+	if l := o.Legs[1]; !l.AvgPx.Equal(decimal.NewFromInt(10)) || !l.CumQty.Equal(decimal.NewFromInt(12)) {
+		t.Errorf("leg 2 AvgPx, CumQty = %v, %v, want 10, 12", l.AvgPx, l.CumQty)
+	}
+	if _, ok := tr.Order("B"); ok {
+		t.Error("Order(B) found, want not found")
+	}
+}
@@ -0,0 +1,123 @@
+package netstatus
+
+import (
+	"github.com/terracefi/enum"
+	"github.com/terracefi/fix44/internal/fixutil"
+	"github.com/terracefi/fix44/networkcounterpartysystemstatusrequest"
+	"github.com/terracefi/fix44/networkcounterpartysystemstatusresponse"
+)
+
+//NetworkRequestType values, FIX 4.4
+const (
+	RequestSnapshot        enum.NetworkRequestType = "1"
+	RequestSubscribe       enum.NetworkRequestType = "2"
+	RequestStopSubscribing enum.NetworkRequestType = "4"
+	RequestLevelOfDetail   enum.NetworkRequestType = "8"
+)
+
+//NetworkStatusResponseType values, FIX 4.4
+const (
+	ResponseFull        enum.NetworkStatusResponseType = "1"
+	ResponseIncremental enum.NetworkStatusResponseType = "2"
+)
+
+//StatusValue values, FIX 4.4
+const (
+	StatusConnected        enum.StatusValue = "1"
+	StatusDownExpectedUp   enum.StatusValue = "2"
+	StatusDownExpectedDown enum.StatusValue = "3"
+	StatusInProcess        enum.StatusValue = "4"
+)
+
+//Key identifies a counterparty by its RefCompID and RefSubID
+type Key struct {
+	CompID string
+	SubID  string
+}
+
+//matches returns true if k selects c, a Key without SubID selects every SubID of its CompID
+func (k Key) matches(c Key) bool {
+	return k.CompID == c.CompID && (k.SubID == "" || k.SubID == c.SubID)
+}
+
+//covers returns true if a request for keys covers c, a request without keys covers every counterparty
+func covers(keys []Key, c Key) bool {
+	if len(keys) == 0 {
+		return true
+	}
+	for _, k := range keys {
+		if k.matches(c) {
+			return true
+		}
+	}
+	return false
+}
+
+//Counterparty is the status of a counterparty reported by the hub
+type Counterparty struct {
+	Key
+	LocationID string
+	DeskID     string
+	Status     enum.StatusValue
+	Text       string
+}
+
+//Online returns true if the counterparty is connected
+func (c Counterparty) Online() bool {
+	return c.Status == StatusConnected
+}
+
+func counterparty(e networkcounterpartysystemstatusresponse.NoCompIDsStruct) Counterparty {
+	c := Counterparty{
+		Key:        Key{fixutil.Deref(e.RefCompID), fixutil.Deref(e.RefSubID)},
+		LocationID: fixutil.Deref(e.LocationID),
+		DeskID:     fixutil.Deref(e.DeskID),
+		Text:       fixutil.Deref(e.StatusText),
+	}
+	if e.StatusValue != nil {
+		c.Status = *e.StatusValue
+	}
+	return c
+}
+
+func (c Counterparty) entry() networkcounterpartysystemstatusresponse.NoCompIDsStruct {
+	e := networkcounterpartysystemstatusresponse.NoCompIDsStruct{
+		RefCompID:   &c.CompID,
+		StatusValue: &c.Status,
+	}
+	if c.SubID != "" {
+		e.RefSubID = &c.SubID
+	}
+	if c.LocationID != "" {
+		e.LocationID = &c.LocationID
+	}
+	if c.DeskID != "" {
+		e.DeskID = &c.DeskID
+	}
+	if c.Text != "" {
+		e.StatusText = &c.Text
+	}
+	return e
+}
+
+func requestKeys(entries []networkcounterpartysystemstatusrequest.NoCompIDsStruct) []Key {
+	var keys []Key
+	for _, e := range entries {
+		if e.RefCompID != nil {
+			keys = append(keys, Key{*e.RefCompID, fixutil.Deref(e.RefSubID)})
+		}
+	}
+	return keys
+}
+
+func requestEntries(keys []Key) []networkcounterpartysystemstatusrequest.NoCompIDsStruct {
+	var entries []networkcounterpartysystemstatusrequest.NoCompIDsStruct
+	for i := range keys {
+		e := networkcounterpartysystemstatusrequest.NoCompIDsStruct{RefCompID: &keys[i].CompID}
+		if keys[i].SubID != "" {
+			e.RefSubID = &keys[i].SubID
+		}
+		entries = append(entries, e)
+	}
+	return entries
+}
@@ -0,0 +1,15 @@
+/*
+Package netstatus follows the status of the counterparties reachable through a network hub with
+NetworkCounterpartySystemStatusRequest and NetworkCounterpartySystemStatusResponse messages.
+
+A Monitor is the side connected to the hub. Snapshot and Subscribe send a request for all counterparties or for the
+given CompIDs, and OnResponse applies the responses to a map of Counterparty keyed by RefCompID and RefSubID. A
+full response replaces the counterparties the request covers, an incremental one only updates those it lists. The
+OnOffline callback is called when a counterparty that was connected is no longer, including when a full response
+leaves it out. OnLogon sends the live subscriptions again after a reconnect.
+
+A Registry is the hub side: it keeps the status of the counterparties set by the application, answers requests
+with full responses and sends incremental responses to the sessions subscribed when a status changes. Its
+OnRequest method is a callback for dispatch.Dispatcher.OnNetworkCounterpartySystemStatusRequest.
+*/
+package netstatus
@@ -0,0 +1,28 @@
+package netstatus
+
+import (
+	"fmt"
+)
+
+//UnknownRequestError is returned for a response or a cancel that refers to a NetworkRequestID the Monitor did not
+//send, or that is no longer live
+type UnknownRequestError struct {
+	NetworkRequestID string
+}
+
+func (e *UnknownRequestError) Error() string {
+	return fmt.Sprintf("netstatus: unknown NetworkRequestID %v", e.NetworkRequestID)
+}
+
+//GapError is returned when the LastNetworkResponseID of an incremental response is not the NetworkResponseID of the
+//previous response to the request, some updates may have been missed. The response is applied all the same.
+type GapError struct {
+	NetworkRequestID      string
+	LastNetworkResponseID string
+	Expected              string
+}
+
+func (e *GapError) Error() string {
+	return fmt.Sprintf("netstatus: request %v: LastNetworkResponseID %v, expected %v", e.NetworkRequestID,
+		e.LastNetworkResponseID, e.Expected)
+}
@@ -0,0 +1,243 @@
+package netstatus
+
+import (
+	"sync"
+
+	"github.com/terracefi/fix44/internal/fixutil"
+	"github.com/terracefi/fix44/networkcounterpartysystemstatusrequest"
+	"github.com/terracefi/fix44/networkcounterpartysystemstatusresponse"
+	"github.com/terracefi/quickfix"
+)
+
+type request struct {
+	id        string
+	keys      []Key
+	subscribe bool
+	//live is true until a snapshot has been answered or a subscription stopped
+	live           bool
+	lastResponseID string
+}
+
+//Monitor requests the status of counterparties from the hub and keeps the statuses reported. It is safe for
+//concurrent use.
+type Monitor struct {
+	//SendMessage sends a message to the hub, it defaults to quickfix.SendToTarget on the session given to New
+	SendMessage func(msg quickfix.Messagable) error
+	//NewNetworkRequestID returns the NetworkRequestID of a request, it defaults to a sequence number prefixed with
+	//the time New was called
+	NewNetworkRequestID func() string
+
+	//OnChange, if not nil, is called with each counterparty whose status changes
+	OnChange func(c Counterparty)
+	//OnOffline, if not nil, is called with each counterparty that was connected and no longer is. A counterparty
+	//left out of a full response is given with an empty Status.
+	OnOffline func(c Counterparty)
+
+	mu             sync.Mutex
+	counterparties map[Key]*Counterparty
+	requests       map[string]*request
+}
+
+//New returns a Monitor without counterparties that sends on sessionID
+func New(sessionID quickfix.SessionID) *Monitor {
+	return &Monitor{
+		SendMessage:         fixutil.SendOn(sessionID),
+		NewNetworkRequestID: fixutil.NewIDs(),
+		counterparties:      make(map[Key]*Counterparty),
+		requests:            make(map[string]*request),
+	}
+}
+
+//Counterparty returns the status of the counterparty with the given key
+func (m *Monitor) Counterparty(k Key) (Counterparty, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	c, ok := m.counterparties[k]
+	if !ok {
+		return Counterparty{}, false
+	}
+	return *c, true
+}
+
+//Counterparties returns the status of every counterparty reported
+func (m *Monitor) Counterparties() []Counterparty {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	out := make([]Counterparty, 0, len(m.counterparties))
+	for _, c := range m.counterparties {
+		out = append(out, *c)
+	}
+	return out
+}
+
+//Online returns true if the counterparty with the given key is reported as connected
+func (m *Monitor) Online(k Key) bool {
+	c, ok := m.Counterparty(k)
+	return ok && c.Online()
+}
+
+//Snapshot requests the status of the counterparties with the given keys, of all counterparties if there are none. It
+//returns the NetworkRequestID of the request.
+func (m *Monitor) Snapshot(keys ...Key) (string, error) {
+	return m.request(false, keys)
+}
+
+//Subscribe requests the status of the counterparties with the given keys, of all counterparties if there are none,
+//and the updates to it. It returns the NetworkRequestID of the subscription.
+func (m *Monitor) Subscribe(keys ...Key) (string, error) {
+	return m.request(true, keys)
+}
+
+//request sends a request for keys, it is only kept if it could be sent
+func (m *Monitor) request(subscribe bool, keys []Key) (string, error) {
+	r := &request{
+		id:        m.NewNetworkRequestID(),
+		keys:      append([]Key(nil), keys...),
+		subscribe: subscribe,
+		live:      true,
+	}
+
+	m.mu.Lock()
+	m.requests[r.id] = r
+	m.mu.Unlock()
+
+	if err := m.SendMessage(r.message()); err != nil {
+		m.mu.Lock()
+		delete(m.requests, r.id)
+		m.mu.Unlock()
+		return "", err
+	}
+	return r.id, nil
+}
+
+func (r *request) message() networkcounterpartysystemstatusrequest.NetworkCounterpartySystemStatusRequest {
+	t := RequestSnapshot
+	switch {
+	case !r.live:
+		t = RequestStopSubscribing
+	case r.subscribe:
+		t = RequestSubscribe
+	}
+	return networkcounterpartysystemstatusrequest.Unmarshal(networkcounterpartysystemstatusrequest.Struct{
+		NetworkRequestID:   r.id,
+		NetworkRequestType: t,
+		NoCompIDs:          requestEntries(r.keys),
+	})
+}
+
+//Unsubscribe stops the subscription with the given NetworkRequestID, the statuses it reported are kept
+func (m *Monitor) Unsubscribe(networkRequestID string) error {
+	m.mu.Lock()
+	r, ok := m.requests[networkRequestID]
+	if !ok || !r.live || !r.subscribe {
+		m.mu.Unlock()
+		return &UnknownRequestError{networkRequestID}
+	}
+	r.live = false
+	msg := r.message()
+	delete(m.requests, networkRequestID)
+	m.mu.Unlock()
+
+	return m.SendMessage(msg)
+}
+
+//OnLogon sends the request of every live subscription again. It should be called when the session logs on.
+func (m *Monitor) OnLogon() error {
+	m.mu.Lock()
+	var msgs []networkcounterpartysystemstatusrequest.NetworkCounterpartySystemStatusRequest
+	for _, r := range m.requests {
+		if r.live && r.subscribe {
+			r.lastResponseID = ""
+			msgs = append(msgs, r.message())
+		}
+	}
+	m.mu.Unlock()
+
+	for _, msg := range msgs {
+		if err := m.SendMessage(msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//OnResponse applies a NetworkCounterpartySystemStatusResponse. A full response replaces the counterparties covered
+//by its request, or all counterparties if it answers no request, an incremental response updates the counterparties
+//it lists. A *GapError is returned, after the response is applied, when an incremental response does not follow the
+//previous response to its request.
+func (m *Monitor) OnResponse(msg networkcounterpartysystemstatusresponse.NetworkCounterpartySystemStatusResponse) error {
+	s, err := networkcounterpartysystemstatusresponse.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	var r *request
+	if s.NetworkRequestID != nil {
+		var ok bool
+		if r, ok = m.requests[*s.NetworkRequestID]; !ok || !r.live {
+			m.mu.Unlock()
+			return &UnknownRequestError{*s.NetworkRequestID}
+		}
+	}
+
+	var changed, offline []Counterparty
+	update := func(old *Counterparty, c Counterparty) {
+		if old == nil || old.Status != c.Status || old.Text != c.Text {
+			changed = append(changed, c)
+		}
+		if old != nil && old.Online() && !c.Online() {
+			offline = append(offline, c)
+		}
+	}
+
+	seen := make(map[Key]bool, len(s.NoCompIDs))
+	for _, e := range s.NoCompIDs {
+		c := counterparty(e)
+		seen[c.Key] = true
+		update(m.counterparties[c.Key], c)
+		m.counterparties[c.Key] = &c
+	}
+
+	var gapErr error
+	switch s.NetworkStatusResponseType {
+	case ResponseFull:
+		var keys []Key
+		if r != nil {
+			keys = r.keys
+		}
+		for k, old := range m.counterparties {
+			if !seen[k] && covers(keys, k) {
+				delete(m.counterparties, k)
+				update(old, Counterparty{Key: k, LocationID: old.LocationID, DeskID: old.DeskID})
+			}
+		}
+	case ResponseIncremental:
+		if r != nil && r.lastResponseID != "" && fixutil.Deref(s.LastNetworkResponseID) != r.lastResponseID {
+			gapErr = &GapError{r.id, fixutil.Deref(s.LastNetworkResponseID), r.lastResponseID}
+		}
+	}
+
+	if r != nil {
+		r.lastResponseID = s.NetworkResponseID
+		if !r.subscribe {
+			delete(m.requests, r.id)
+		}
+	}
+	onChange, onOffline := m.OnChange, m.OnOffline
+	m.mu.Unlock()
+
+	if onChange != nil {
+		for _, c := range changed {
+			onChange(c)
+		}
+	}
+	if onOffline != nil {
+		for _, c := range offline {
+			onOffline(c)
+		}
+	}
+	return gapErr
+}
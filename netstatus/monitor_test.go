@@ -0,0 +1,326 @@
+package netstatus
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/terracefi/enum"
+	"github.com/terracefi/fix44/internal/testutil"
+	"github.com/terracefi/fix44/networkcounterpartysystemstatusrequest"
+	"github.com/terracefi/fix44/networkcounterpartysystemstatusresponse"
+	"github.com/terracefi/quickfix"
+)
+
+//requests returns the NetworkRequestID and NetworkRequestType of each request sent, as in "R1:1"
+func requests(t *testing.T, s *testutil.Sender) []string {
+	var d []string
+	for _, msg := range s.Sent {
+		r, err := networkcounterpartysystemstatusrequest.Marshal(
+			msg.(networkcounterpartysystemstatusrequest.NetworkCounterpartySystemStatusRequest))
+		if err != nil {
+			t.Fatal(err)
+		}
+		d = append(d, fmt.Sprintf("%v:%v", r.NetworkRequestID, r.NetworkRequestType))
+	}
+	return d
+}
+
+//newMonitor returns a Monitor whose NetworkRequestIDs are R1, R2, ...
+func newMonitor(failAt int) (*Monitor, *testutil.Sender) {
+	m := New(quickfix.SessionID{})
+	s := &testutil.Sender{FailAt: failAt}
+	m.SendMessage = s.Send
+	m.NewNetworkRequestID = testutil.IDs("R")
+	return m, s
+}
+
+func cp(compID, subID string, status enum.StatusValue) Counterparty {
+	return Counterparty{Key: Key{compID, subID}, Status: status}
+}
+
+//describe returns the counterparties as sorted "CompID/SubID:Status" strings
+func describe(cs []Counterparty) []string {
+	var d []string
+	for _, c := range cs {
+		d = append(d, fmt.Sprintf("%v/%v:%v", c.CompID, c.SubID, c.Status))
+	}
+	sort.Strings(d)
+	return d
+}
+
+type step func(m *Monitor) error
+
+func snapshot(keys ...Key) step {
+	return func(m *Monitor) error {
+		_, err := m.Snapshot(keys...)
+		return err
+	}
+}
+
+func subscribe(keys ...Key) step {
+	return func(m *Monitor) error {
+		_, err := m.Subscribe(keys...)
+		return err
+	}
+}
+
+func unsubscribe(networkRequestID string) step {
+	return func(m *Monitor) error { return m.Unsubscribe(networkRequestID) }
+}
+
+func logon(m *Monitor) error { return m.OnLogon() }
+
+//response answers networkRequestID, the response answers no request if it is empty
+func response(networkRequestID string, responseType enum.NetworkStatusResponseType, responseID, lastResponseID string,
+	cs ...Counterparty) step {
+	return func(m *Monitor) error {
+		s := networkcounterpartysystemstatusresponse.Struct{
+			NetworkResponseID:         responseID,
+			NetworkStatusResponseType: responseType,
+		}
+		if networkRequestID != "" {
+			s.NetworkRequestID = &networkRequestID
+		}
+		if lastResponseID != "" {
+			s.LastNetworkResponseID = &lastResponseID
+		}
+		for _, c := range cs {
+			s.NoCompIDs = append(s.NoCompIDs, c.entry())
+		}
+		return m.OnResponse(networkcounterpartysystemstatusresponse.Unmarshal(s))
+	}
+}
+
+func TestMonitor(t *testing.T) {
+	a, b := cp("A", "", StatusConnected), cp("B", "", StatusConnected)
+	b1, b2 := cp("B", "1", StatusConnected), cp("B", "2", StatusConnected)
+	aDown := cp("A", "", StatusDownExpectedUp)
+	tests := []struct {
+		name           string
+		failAt         int
+		steps          []step
+		wantErr        error
+		sent           []string
+		counterparties []string
+		changes        int
+		offline        []string
+	}{
+		{
+			name:           "snapshot",
+			steps:          []step{snapshot(), response("R1", ResponseFull, "N1", "", a, b)},
+			sent:           []string{"R1:1"},
+			counterparties: []string{"A/:1", "B/:1"},
+			changes:        2,
+		},
+		{
+			name: "response to an answered snapshot",
+			steps: []step{snapshot(), response("R1", ResponseFull, "N1", "", a),
+				response("R1", ResponseFull, "N2", "", b)},
+			wantErr:        &UnknownRequestError{},
+			sent:           []string{"R1:1"},
+			counterparties: []string{"A/:1"},
+			changes:        1,
+		},
+		{
+			name:    "response to an unknown request",
+			steps:   []step{response("R1", ResponseFull, "N1", "", a)},
+			wantErr: &UnknownRequestError{},
+		},
+		{
+			name:           "full response without NetworkRequestID",
+			steps:          []step{response("", ResponseFull, "N1", "", a), response("", ResponseFull, "N2", "", b)},
+			counterparties: []string{"B/:1"},
+			changes:        3,
+			offline:        []string{"A/:"},
+		},
+		{
+			name: "subscription",
+			steps: []step{subscribe(), response("R1", ResponseFull, "N1", "", a, b),
+				response("R1", ResponseIncremental, "N2", "N1", aDown)},
+			sent:           []string{"R1:2"},
+			counterparties: []string{"A/:2", "B/:1"},
+			changes:        3,
+			offline:        []string{"A/:2"},
+		},
+		{
+			name: "incremental response after a gap",
+			steps: []step{subscribe(), response("R1", ResponseFull, "N1", "", a, b),
+				response("R1", ResponseIncremental, "N3", "N2", aDown)},
+			wantErr:        &GapError{},
+			sent:           []string{"R1:2"},
+			counterparties: []string{"A/:2", "B/:1"},
+			changes:        3,
+			offline:        []string{"A/:2"},
+		},
+		{
+			name: "unchanged status",
+			steps: []step{subscribe(), response("R1", ResponseFull, "N1", "", a),
+				response("R1", ResponseIncremental, "N2", "N1", a)},
+			sent:           []string{"R1:2"},
+			counterparties: []string{"A/:1"},
+			changes:        1,
+		},
+		{
+			name: "full response leaves a counterparty out",
+			steps: []step{subscribe(), response("R1", ResponseFull, "N1", "", a, b),
+				response("R1", ResponseFull, "N2", "", b)},
+			sent:           []string{"R1:2"},
+			counterparties: []string{"B/:1"},
+			changes:        3,
+			offline:        []string{"A/:"},
+		},
+		{
+			name: "full response only replaces the counterparties requested",
+			steps: []step{snapshot(), response("R1", ResponseFull, "N1", "", a, b1, b2),
+				snapshot(Key{CompID: "B"}), response("R2", ResponseFull, "N2", "", b1)},
+			sent:           []string{"R1:1", "R2:1"},
+			counterparties: []string{"A/:1", "B/1:1"},
+			changes:        4,
+			offline:        []string{"B/2:"},
+		},
+		{
+			name: "full response for a SubID",
+			steps: []step{snapshot(), response("R1", ResponseFull, "N1", "", b1, b2),
+				snapshot(Key{"B", "1"}), response("R2", ResponseFull, "N2", "", cp("B", "1", StatusInProcess))},
+			sent:           []string{"R1:1", "R2:1"},
+			counterparties: []string{"B/1:4", "B/2:1"},
+			changes:        3,
+			offline:        []string{"B/1:4"},
+		},
+		{
+			name: "unsubscribe",
+			steps: []step{subscribe(), response("R1", ResponseFull, "N1", "", a), unsubscribe("R1"),
+				response("R1", ResponseIncremental, "N2", "N1", aDown)},
+			wantErr:        &UnknownRequestError{},
+			sent:           []string{"R1:2", "R1:4"},
+			counterparties: []string{"A/:1"},
+			changes:        1,
+		},
+		{
+			name:    "unsubscribe a snapshot",
+			steps:   []step{snapshot(), unsubscribe("R1")},
+			wantErr: &UnknownRequestError{},
+			sent:    []string{"R1:1"},
+		},
+		{
+			name:    "unsubscribe twice",
+			steps:   []step{subscribe(), unsubscribe("R1"), unsubscribe("R1")},
+			wantErr: &UnknownRequestError{},
+			sent:    []string{"R1:2", "R1:4"},
+		},
+		{
+			name:    "unsubscribe an unknown request",
+			steps:   []step{unsubscribe("R1")},
+			wantErr: &UnknownRequestError{},
+		},
+		{
+			name: "logon",
+			steps: []step{subscribe(), snapshot(), response("R1", ResponseFull, "N1", "", a), logon,
+				response("R1", ResponseIncremental, "M2", "M1", aDown)},
+			sent:           []string{"R1:2", "R2:1", "R1:2"},
+			counterparties: []string{"A/:2"},
+			changes:        2,
+			offline:        []string{"A/:2"},
+		},
+		{
+			name:    "snapshot not sent",
+			failAt:  1,
+			steps:   []step{snapshot(), response("R1", ResponseFull, "N1", "", a)},
+			wantErr: &UnknownRequestError{},
+			sent:    []string{"R1:1"},
+		},
+		{
+			name:   "subscription not sent",
+			failAt: 1,
+			steps:  []step{subscribe(), logon},
+			sent:   []string{"R1:2"},
+		},
+		{
+			name:    "logon not sent",
+			failAt:  2,
+			steps:   []step{subscribe(), logon},
+			wantErr: testutil.ErrSend,
+			sent:    []string{"R1:2", "R1:2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, s := newMonitor(tt.failAt)
+			var changes int
+			var offline []Counterparty
+			m.OnChange = func(Counterparty) { changes++ }
+			m.OnOffline = func(c Counterparty) { offline = append(offline, c) }
+
+			var err error
+			for i, st := range tt.steps {
+				err = st(m)
+				if i < len(tt.steps)-1 && err != nil && err != testutil.ErrSend {
+					t.Fatalf("step %v: %v", i, err)
+				}
+			}
+			if reflect.TypeOf(err) != reflect.TypeOf(tt.wantErr) {
+				t.Fatalf("error = %v, want %T", err, tt.wantErr)
+			}
+			if got := requests(t, s); !reflect.DeepEqual(got, tt.sent) {
+				t.Errorf("sent = %v, want %v", got, tt.sent)
+			}
+			if got := describe(m.Counterparties()); !reflect.DeepEqual(got, tt.counterparties) {
+				t.Errorf("Counterparties() = %v, want %v", got, tt.counterparties)
+			}
+			if changes != tt.changes {
+				t.Errorf("OnChange called %v times, want %v", changes, tt.changes)
+			}
+			if got := describe(offline); !reflect.DeepEqual(got, tt.offline) {
+				t.Errorf("OnOffline called with %v, want %v", got, tt.offline)
+			}
+		})
+	}
+}
+
+func TestMonitorRequestSendError(t *testing.T) {
+	tests := []struct {
+		name    string
+		request func(m *Monitor) (string, error)
+	}{
+		{"snapshot", func(m *Monitor) (string, error) { return m.Snapshot() }},
+		{"subscribe", func(m *Monitor) (string, error) { return m.Subscribe(Key{CompID: "A"}) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, _ := newMonitor(1)
+			if id, err := tt.request(m); id != "" || err != testutil.ErrSend {
+				t.Fatalf("request = %q, %v, want \"\", %v", id, err, testutil.ErrSend)
+			}
+			if err := m.Unsubscribe("R1"); reflect.TypeOf(err) != reflect.TypeOf(&UnknownRequestError{}) {
+				t.Errorf("Unsubscribe() = %v, want *UnknownRequestError", err)
+			}
+		})
+	}
+}
+
+func TestMonitorOnline(t *testing.T) {
+	m, _ := newMonitor(0)
+	steps := []step{subscribe(), response("R1", ResponseFull, "N1", "", cp("A", "", StatusConnected),
+		cp("B", "", StatusDownExpectedDown))}
+	for i, st := range steps {
+		if err := st(m); err != nil {
+			t.Fatalf("step %v: %v", i, err)
+		}
+	}
+	tests := []struct {
+		key    Key
+		online bool
+	}{
+		{Key{CompID: "A"}, true},
+		{Key{CompID: "B"}, false},
+		{Key{CompID: "C"}, false},
+		{Key{"A", "1"}, false},
+	}
+	for _, tt := range tests {
+		if got := m.Online(tt.key); got != tt.online {
+			t.Errorf("Online(%v) = %v, want %v", tt.key, got, tt.online)
+		}
+	}
+}
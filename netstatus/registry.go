@@ -0,0 +1,184 @@
+package netstatus
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/terracefi/fix44/internal/fixutil"
+	"github.com/terracefi/fix44/networkcounterpartysystemstatusrequest"
+	"github.com/terracefi/fix44/networkcounterpartysystemstatusresponse"
+	"github.com/terracefi/quickfix"
+	"github.com/terracefi/tag"
+)
+
+type subscriptionKey struct {
+	sessionID quickfix.SessionID
+	requestID string
+}
+
+type subscription struct {
+	keys           []Key
+	lastResponseID string
+}
+
+//Registry keeps the status of the counterparties of a hub and answers NetworkCounterpartySystemStatusRequests from
+//it. It is safe for concurrent use.
+type Registry struct {
+	//SendMessage sends a message on a session, it defaults to quickfix.SendToTarget
+	SendMessage func(msg quickfix.Messagable, sessionID quickfix.SessionID) error
+	//NewNetworkResponseID returns the NetworkResponseID of a response, it defaults to a sequence number prefixed
+	//with the time NewRegistry was called
+	NewNetworkResponseID func() string
+
+	mu             sync.Mutex
+	counterparties map[Key]*Counterparty
+	subscriptions  map[subscriptionKey]*subscription
+}
+
+//NewRegistry returns a Registry without counterparties
+func NewRegistry() *Registry {
+	return &Registry{
+		SendMessage:          quickfix.SendToTarget,
+		NewNetworkResponseID: fixutil.NewIDs(),
+		counterparties:       make(map[Key]*Counterparty),
+		subscriptions:        make(map[subscriptionKey]*subscription),
+	}
+}
+
+//Counterparty returns the status of the counterparty with the given key
+func (r *Registry) Counterparty(k Key) (Counterparty, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	c, ok := r.counterparties[k]
+	if !ok {
+		return Counterparty{}, false
+	}
+	return *c, true
+}
+
+//Set records the status of a counterparty and, if it changed, sends an incremental response to every subscription
+//that covers the counterparty. The first error of sending is returned.
+func (r *Registry) Set(c Counterparty) error {
+	type update struct {
+		sessionID quickfix.SessionID
+		msg       quickfix.Messagable
+	}
+
+	r.mu.Lock()
+	if old, ok := r.counterparties[c.Key]; ok && *old == c {
+		r.mu.Unlock()
+		return nil
+	}
+	r.counterparties[c.Key] = &c
+
+	var updates []update
+	for k, sub := range r.subscriptions {
+		if !covers(sub.keys, c.Key) {
+			continue
+		}
+		requestID := k.requestID
+		resp := networkcounterpartysystemstatusresponse.Struct{
+			NetworkResponseID:         r.NewNetworkResponseID(),
+			NetworkRequestID:          &requestID,
+			NetworkStatusResponseType: ResponseIncremental,
+			NoCompIDs:                 []networkcounterpartysystemstatusresponse.NoCompIDsStruct{c.entry()},
+		}
+		if sub.lastResponseID != "" {
+			last := sub.lastResponseID
+			resp.LastNetworkResponseID = &last
+		}
+		sub.lastResponseID = resp.NetworkResponseID
+		updates = append(updates, update{k.sessionID, networkcounterpartysystemstatusresponse.Unmarshal(resp)})
+	}
+	r.mu.Unlock()
+
+	var firstErr error
+	for _, u := range updates {
+		if err := r.SendMessage(u.msg, u.sessionID); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+//Answer returns the full response to req, listing the counterparties req covers ordered by RefCompID and RefSubID
+func (r *Registry) Answer(req networkcounterpartysystemstatusrequest.Struct) networkcounterpartysystemstatusresponse.Struct {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.answer(req)
+}
+
+func (r *Registry) answer(req networkcounterpartysystemstatusrequest.Struct) networkcounterpartysystemstatusresponse.Struct {
+	keys := requestKeys(req.NoCompIDs)
+	var cs []Counterparty
+	for k, c := range r.counterparties {
+		if covers(keys, k) {
+			cs = append(cs, *c)
+		}
+	}
+	sort.Slice(cs, func(i, j int) bool {
+		if cs[i].CompID != cs[j].CompID {
+			return cs[i].CompID < cs[j].CompID
+		}
+		return cs[i].SubID < cs[j].SubID
+	})
+
+	requestID := req.NetworkRequestID
+	resp := networkcounterpartysystemstatusresponse.Struct{
+		NetworkResponseID:         r.NewNetworkResponseID(),
+		NetworkRequestID:          &requestID,
+		NetworkStatusResponseType: ResponseFull,
+	}
+	for _, c := range cs {
+		resp.NoCompIDs = append(resp.NoCompIDs, c.entry())
+	}
+	return resp
+}
+
+//OnRequest answers a NetworkCounterpartySystemStatusRequest received on sessionID: a Snapshot with a full response,
+//a Subscribe with a full response followed by incremental ones as statuses change, see Set, and a Stop Subscribing
+//by ending the subscription. Other NetworkRequestTypes are rejected. A response that cannot be sent makes the session
+//reply with a BusinessMessageReject carrying BusinessRejectReason = ApplicationNotAvailable (4).
+func (r *Registry) OnRequest(msg networkcounterpartysystemstatusrequest.NetworkCounterpartySystemStatusRequest, sessionID quickfix.SessionID) quickfix.MessageRejectError {
+	req, err := networkcounterpartysystemstatusrequest.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	k := subscriptionKey{sessionID, req.NetworkRequestID}
+
+	r.mu.Lock()
+	var resp networkcounterpartysystemstatusresponse.Struct
+	switch req.NetworkRequestType {
+	case RequestSnapshot:
+		resp = r.answer(req)
+	case RequestSubscribe:
+		resp = r.answer(req)
+		r.subscriptions[k] = &subscription{keys: requestKeys(req.NoCompIDs), lastResponseID: resp.NetworkResponseID}
+	case RequestStopSubscribing:
+		delete(r.subscriptions, k)
+		r.mu.Unlock()
+		return nil
+	default:
+		r.mu.Unlock()
+		return quickfix.ValueIsIncorrect(tag.NetworkRequestType)
+	}
+	r.mu.Unlock()
+
+	if err := r.SendMessage(networkcounterpartysystemstatusresponse.Unmarshal(resp), sessionID); err != nil {
+		return quickfix.NewBusinessMessageRejectError("Application not available", 4, nil)
+	}
+	return nil
+}
+
+//OnLogout ends the subscriptions made on sessionID. It should be called when the session logs out.
+func (r *Registry) OnLogout(sessionID quickfix.SessionID) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for k := range r.subscriptions {
+		if k.sessionID == sessionID {
+			delete(r.subscriptions, k)
+		}
+	}
+}
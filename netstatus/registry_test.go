@@ -0,0 +1,202 @@
+package netstatus
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/terracefi/enum"
+	"github.com/terracefi/fix44/internal/fixutil"
+	"github.com/terracefi/fix44/internal/testutil"
+	"github.com/terracefi/fix44/networkcounterpartysystemstatusrequest"
+	"github.com/terracefi/fix44/networkcounterpartysystemstatusresponse"
+	"github.com/terracefi/quickfix"
+	"github.com/terracefi/tag"
+)
+
+//responses returns the session, NetworkRequestID, NetworkStatusResponseType, NetworkResponseID,
+//LastNetworkResponseID and counterparties of each response sent, as in "S1:R1:2:N2:N1:A/:1"
+func responses(t *testing.T, s *testutil.Sender) []string {
+	var d []string
+	for i, msg := range s.Sent {
+		r, err := networkcounterpartysystemstatusresponse.Marshal(
+			msg.(networkcounterpartysystemstatusresponse.NetworkCounterpartySystemStatusResponse))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var cs []Counterparty
+		for _, e := range r.NoCompIDs {
+			cs = append(cs, counterparty(e))
+		}
+		d = append(d, fmt.Sprintf("%v:%v:%v:%v:%v:%v", s.SessionIDs[i].TargetCompID,
+			fixutil.Deref(r.NetworkRequestID), r.NetworkStatusResponseType, r.NetworkResponseID,
+			fixutil.Deref(r.LastNetworkResponseID), strings.Join(describe(cs), ",")))
+	}
+	return d
+}
+
+//newRegistry returns a Registry whose NetworkResponseIDs are N1, N2, ...
+func newRegistry(failAt int) (*Registry, *testutil.Sender) {
+	r := NewRegistry()
+	s := &testutil.Sender{FailAt: failAt}
+	r.SendMessage = s.SendTo
+	r.NewNetworkResponseID = testutil.IDs("N")
+	return r, s
+}
+
+func session(targetCompID string) quickfix.SessionID {
+	return quickfix.SessionID{SenderCompID: "HUB", TargetCompID: targetCompID}
+}
+
+type registryStep func(r *Registry) error
+
+func set(c Counterparty) registryStep {
+	return func(r *Registry) error { return r.Set(c) }
+}
+
+func onRequest(targetCompID, networkRequestID string, requestType enum.NetworkRequestType, keys ...Key) registryStep {
+	return func(r *Registry) error {
+		msg := networkcounterpartysystemstatusrequest.Unmarshal(networkcounterpartysystemstatusrequest.Struct{
+			NetworkRequestID:   networkRequestID,
+			NetworkRequestType: requestType,
+			NoCompIDs:          requestEntries(keys),
+		})
+		return r.OnRequest(msg, session(targetCompID))
+	}
+}
+
+func logout(targetCompID string) registryStep {
+	return func(r *Registry) error {
+		r.OnLogout(session(targetCompID))
+		return nil
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	a, b := cp("A", "", StatusConnected), cp("B", "", StatusConnected)
+	b1 := cp("B", "1", StatusConnected)
+	aDown := cp("A", "", StatusDownExpectedUp)
+	tests := []struct {
+		name    string
+		failAt  int
+		steps   []registryStep
+		wantErr error
+		sent    []string
+	}{
+		{
+			name:  "snapshot",
+			steps: []registryStep{set(b), set(a), onRequest("S1", "R1", RequestSnapshot), set(aDown)},
+			sent:  []string{"S1:R1:1:N1::A/:1,B/:1"},
+		},
+		{
+			name:  "snapshot of a CompID",
+			steps: []registryStep{set(a), set(b), set(b1), onRequest("S1", "R1", RequestSnapshot, Key{CompID: "B"})},
+			sent:  []string{"S1:R1:1:N1::B/1:1,B/:1"},
+		},
+		{
+			name:  "snapshot of a SubID",
+			steps: []registryStep{set(a), set(b), set(b1), onRequest("S1", "R1", RequestSnapshot, Key{"B", "1"})},
+			sent:  []string{"S1:R1:1:N1::B/1:1"},
+		},
+		{
+			name:  "snapshot of an unknown CompID",
+			steps: []registryStep{set(a), onRequest("S1", "R1", RequestSnapshot, Key{CompID: "C"})},
+			sent:  []string{"S1:R1:1:N1::"},
+		},
+		{
+			name: "subscription",
+			steps: []registryStep{set(a), onRequest("S1", "R1", RequestSubscribe), set(b), set(aDown),
+				set(aDown)},
+			sent: []string{"S1:R1:1:N1::A/:1", "S1:R1:2:N2:N1:B/:1", "S1:R1:2:N3:N2:A/:2"},
+		},
+		{
+			name: "subscription to a CompID",
+			steps: []registryStep{onRequest("S1", "R1", RequestSubscribe, Key{CompID: "B"}), set(a), set(b1),
+				set(b)},
+			sent: []string{"S1:R1:1:N1::", "S1:R1:2:N2:N1:B/1:1", "S1:R1:2:N3:N2:B/:1"},
+		},
+		{
+			name: "subscriptions of two sessions",
+			steps: []registryStep{onRequest("S1", "R1", RequestSubscribe), onRequest("S2", "R1", RequestSubscribe),
+				onRequest("S2", "R1", RequestStopSubscribing), set(a)},
+			sent: []string{"S1:R1:1:N1::", "S2:R1:1:N2::", "S1:R1:2:N3:N1:A/:1"},
+		},
+		{
+			name: "stop subscribing",
+			steps: []registryStep{onRequest("S1", "R1", RequestSubscribe),
+				onRequest("S1", "R1", RequestStopSubscribing), set(a)},
+			sent: []string{"S1:R1:1:N1::"},
+		},
+		{
+			name:  "stop subscribing an unknown subscription",
+			steps: []registryStep{onRequest("S1", "R1", RequestStopSubscribing), set(a)},
+		},
+		{
+			name:  "logout",
+			steps: []registryStep{onRequest("S1", "R1", RequestSubscribe), logout("S1"), set(a)},
+			sent:  []string{"S1:R1:1:N1::"},
+		},
+		{
+			name:    "unsupported NetworkRequestType",
+			steps:   []registryStep{onRequest("S1", "R1", RequestLevelOfDetail)},
+			wantErr: quickfix.ValueIsIncorrect(tag.NetworkRequestType),
+		},
+		{
+			name:    "response not sent",
+			failAt:  1,
+			steps:   []registryStep{set(a), onRequest("S1", "R1", RequestSnapshot)},
+			wantErr: quickfix.NewBusinessMessageRejectError("Application not available", 4, nil),
+			sent:    []string{"S1:R1:1:N1::A/:1"},
+		},
+		{
+			name:    "update not sent",
+			failAt:  2,
+			steps:   []registryStep{onRequest("S1", "R1", RequestSubscribe), set(a)},
+			wantErr: testutil.ErrSend,
+			sent:    []string{"S1:R1:1:N1::", "S1:R1:2:N2:N1:A/:1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, s := newRegistry(tt.failAt)
+			var err error
+			for i, st := range tt.steps {
+				err = st(r)
+				if i < len(tt.steps)-1 && err != nil {
+					t.Fatalf("step %v: %v", i, err)
+				}
+			}
+			if !reflect.DeepEqual(err, tt.wantErr) {
+				t.Fatalf("error = %v, want %v", err, tt.wantErr)
+			}
+			if got := responses(t, s); !reflect.DeepEqual(got, tt.sent) {
+				t.Errorf("sent = %v, want %v", got, tt.sent)
+			}
+		})
+	}
+}
+
+func TestRegistryAnswer(t *testing.T) {
+	r, _ := newRegistry(0)
+	for _, c := range []Counterparty{cp("B", "", StatusConnected), cp("A", "2", StatusInProcess),
+		cp("A", "1", StatusDownExpectedDown)} {
+		if err := r.Set(c); err != nil {
+			t.Fatal(err)
+		}
+	}
+	resp := r.Answer(networkcounterpartysystemstatusrequest.Struct{NetworkRequestID: "R1",
+		NetworkRequestType: RequestSnapshot})
+	var cs []string
+	for _, e := range resp.NoCompIDs {
+		c := counterparty(e)
+		cs = append(cs, fmt.Sprintf("%v/%v:%v", c.CompID, c.SubID, c.Status))
+	}
+	if want := []string{"A/1:3", "A/2:4", "B/:1"}; !reflect.DeepEqual(cs, want) {
+		t.Errorf("Answer() NoCompIDs = %v, want %v in order", cs, want)
+	}
+	if resp.NetworkStatusResponseType != ResponseFull || fixutil.Deref(resp.NetworkRequestID) != "R1" {
+		t.Errorf("NetworkStatusResponseType, NetworkRequestID = %v, %v, want %v, R1",
+			resp.NetworkStatusResponseType, fixutil.Deref(resp.NetworkRequestID), ResponseFull)
+	}
+}
@@ -56,6 +56,26 @@ func Route(router RouteOut) (string, string, quickfix.MessageRoute) {
 	return "FIX.4.4", "BC", r
 }
 
+//Validate checks m against the FIX 4.4 definition of NetworkCounterpartySystemStatusRequest, see fix44.MessageDef.Validate
+func (m NetworkCounterpartySystemStatusRequest) Validate() fix44.ValidationErrors {
+	return fix44.MessageDefs["BC"].Validate(&m.Body.FieldMap)
+}
+
+//MarshalJSON encodes the NetworkCounterpartySystemStatusRequest as JSON with fields keyed by name, see fix44.MarshalMessageJSON
+func (m NetworkCounterpartySystemStatusRequest) MarshalJSON() ([]byte, error) {
+	return fix44.MarshalMessageJSON(m.Message)
+}
+
+//UnmarshalJSON builds the NetworkCounterpartySystemStatusRequest from JSON produced by MarshalJSON
+func (m *NetworkCounterpartySystemStatusRequest) UnmarshalJSON(b []byte) error {
+	msg, err := fix44.UnmarshalMessageJSON(b, "BC")
+	if err != nil {
+		return err
+	}
+	*m = FromMessage(msg)
+	return nil
+}
+
 //SetNetworkRequestID sets NetworkRequestID, Tag 933
 func (m NetworkCounterpartySystemStatusRequest) SetNetworkRequestID(v string) {
 	m.Set(field.NewNetworkRequestID(v))
@@ -214,3 +234,114 @@ func (m NoCompIDsRepeatingGroup) Add() NoCompIDs {
 func (m NoCompIDsRepeatingGroup) Get(i int) NoCompIDs {
 	return NoCompIDs{m.RepeatingGroup.Get(i)}
 }
+
+//Struct is a plain Go representation of the NetworkCounterpartySystemStatusRequest body, optional fields are nil when absent
+type Struct struct {
+	NetworkRequestID   string
+	NetworkRequestType enum.NetworkRequestType
+	NoCompIDs          []NoCompIDsStruct
+}
+
+//Marshal copies the fields present in msg into a Struct
+func Marshal(msg NetworkCounterpartySystemStatusRequest) (Struct, quickfix.MessageRejectError) {
+	var s Struct
+	if msg.HasNetworkRequestID() {
+		v, err := msg.GetNetworkRequestID()
+		if err != nil {
+			return s, err
+		}
+		s.NetworkRequestID = v
+	}
+	if msg.HasNetworkRequestType() {
+		v, err := msg.GetNetworkRequestType()
+		if err != nil {
+			return s, err
+		}
+		s.NetworkRequestType = v
+	}
+	if msg.HasNoCompIDs() {
+		g, err := msg.GetNoCompIDs()
+		if err != nil {
+			return s, err
+		}
+		if s.NoCompIDs, err = MarshalNoCompIDs(g); err != nil {
+			return s, err
+		}
+	}
+	return s, nil
+}
+
+//Unmarshal builds a NetworkCounterpartySystemStatusRequest from s
+func Unmarshal(s Struct) NetworkCounterpartySystemStatusRequest {
+	m := New(field.NewNetworkRequestType(s.NetworkRequestType), field.NewNetworkRequestID(s.NetworkRequestID))
+	if s.NoCompIDs != nil {
+		m.SetNoCompIDs(UnmarshalNoCompIDs(s.NoCompIDs))
+	}
+	return m
+}
+
+//NoCompIDsStruct is a plain Go representation of a NoCompIDs group element, optional fields are nil when absent
+type NoCompIDsStruct struct {
+	RefCompID  *string
+	RefSubID   *string
+	LocationID *string
+	DeskID     *string
+}
+
+//MarshalNoCompIDs copies the elements of g into a slice of NoCompIDsStruct
+func MarshalNoCompIDs(g NoCompIDsRepeatingGroup) ([]NoCompIDsStruct, quickfix.MessageRejectError) {
+	s := make([]NoCompIDsStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasRefCompID() {
+			v, err := m.GetRefCompID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].RefCompID = &v
+		}
+		if m.HasRefSubID() {
+			v, err := m.GetRefSubID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].RefSubID = &v
+		}
+		if m.HasLocationID() {
+			v, err := m.GetLocationID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LocationID = &v
+		}
+		if m.HasDeskID() {
+			v, err := m.GetDeskID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].DeskID = &v
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoCompIDs builds a NoCompIDsRepeatingGroup from s
+func UnmarshalNoCompIDs(s []NoCompIDsStruct) NoCompIDsRepeatingGroup {
+	g := NewNoCompIDsRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.RefCompID != nil {
+			m.SetRefCompID(*e.RefCompID)
+		}
+		if e.RefSubID != nil {
+			m.SetRefSubID(*e.RefSubID)
+		}
+		if e.LocationID != nil {
+			m.SetLocationID(*e.LocationID)
+		}
+		if e.DeskID != nil {
+			m.SetDeskID(*e.DeskID)
+		}
+	}
+	return g
+}
@@ -56,6 +56,26 @@ func Route(router RouteOut) (string, string, quickfix.MessageRoute) {
 	return "FIX.4.4", "BD", r
 }
 
+//Validate checks m against the FIX 4.4 definition of NetworkCounterpartySystemStatusResponse, see fix44.MessageDef.Validate
+func (m NetworkCounterpartySystemStatusResponse) Validate() fix44.ValidationErrors {
+	return fix44.MessageDefs["BD"].Validate(&m.Body.FieldMap)
+}
+
+//MarshalJSON encodes the NetworkCounterpartySystemStatusResponse as JSON with fields keyed by name, see fix44.MarshalMessageJSON
+func (m NetworkCounterpartySystemStatusResponse) MarshalJSON() ([]byte, error) {
+	return fix44.MarshalMessageJSON(m.Message)
+}
+
+//UnmarshalJSON builds the NetworkCounterpartySystemStatusResponse from JSON produced by MarshalJSON
+func (m *NetworkCounterpartySystemStatusResponse) UnmarshalJSON(b []byte) error {
+	msg, err := fix44.UnmarshalMessageJSON(b, "BD")
+	if err != nil {
+		return err
+	}
+	*m = FromMessage(msg)
+	return nil
+}
+
 //SetNetworkResponseID sets NetworkResponseID, Tag 932
 func (m NetworkCounterpartySystemStatusResponse) SetNetworkResponseID(v string) {
 	m.Set(field.NewNetworkResponseID(v))
@@ -290,3 +310,158 @@ func (m NoCompIDsRepeatingGroup) Add() NoCompIDs {
 func (m NoCompIDsRepeatingGroup) Get(i int) NoCompIDs {
 	return NoCompIDs{m.RepeatingGroup.Get(i)}
 }
+
+//Struct is a plain Go representation of the NetworkCounterpartySystemStatusResponse body, optional fields are nil when absent
+type Struct struct {
+	NetworkResponseID         string
+	NetworkRequestID          *string
+	LastNetworkResponseID     *string
+	NoCompIDs                 []NoCompIDsStruct
+	NetworkStatusResponseType enum.NetworkStatusResponseType
+}
+
+//Marshal copies the fields present in msg into a Struct
+func Marshal(msg NetworkCounterpartySystemStatusResponse) (Struct, quickfix.MessageRejectError) {
+	var s Struct
+	if msg.HasNetworkResponseID() {
+		v, err := msg.GetNetworkResponseID()
+		if err != nil {
+			return s, err
+		}
+		s.NetworkResponseID = v
+	}
+	if msg.HasNetworkRequestID() {
+		v, err := msg.GetNetworkRequestID()
+		if err != nil {
+			return s, err
+		}
+		s.NetworkRequestID = &v
+	}
+	if msg.HasLastNetworkResponseID() {
+		v, err := msg.GetLastNetworkResponseID()
+		if err != nil {
+			return s, err
+		}
+		s.LastNetworkResponseID = &v
+	}
+	if msg.HasNoCompIDs() {
+		g, err := msg.GetNoCompIDs()
+		if err != nil {
+			return s, err
+		}
+		if s.NoCompIDs, err = MarshalNoCompIDs(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasNetworkStatusResponseType() {
+		v, err := msg.GetNetworkStatusResponseType()
+		if err != nil {
+			return s, err
+		}
+		s.NetworkStatusResponseType = v
+	}
+	return s, nil
+}
+
+//Unmarshal builds a NetworkCounterpartySystemStatusResponse from s
+func Unmarshal(s Struct) NetworkCounterpartySystemStatusResponse {
+	m := New(field.NewNetworkStatusResponseType(s.NetworkStatusResponseType), field.NewNetworkResponseID(s.NetworkResponseID))
+	if s.NetworkRequestID != nil {
+		m.SetNetworkRequestID(*s.NetworkRequestID)
+	}
+	if s.LastNetworkResponseID != nil {
+		m.SetLastNetworkResponseID(*s.LastNetworkResponseID)
+	}
+	if s.NoCompIDs != nil {
+		m.SetNoCompIDs(UnmarshalNoCompIDs(s.NoCompIDs))
+	}
+	return m
+}
+
+//NoCompIDsStruct is a plain Go representation of a NoCompIDs group element, optional fields are nil when absent
+type NoCompIDsStruct struct {
+	RefCompID   *string
+	RefSubID    *string
+	LocationID  *string
+	DeskID      *string
+	StatusValue *enum.StatusValue
+	StatusText  *string
+}
+
+//MarshalNoCompIDs copies the elements of g into a slice of NoCompIDsStruct
+func MarshalNoCompIDs(g NoCompIDsRepeatingGroup) ([]NoCompIDsStruct, quickfix.MessageRejectError) {
+	s := make([]NoCompIDsStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasRefCompID() {
+			v, err := m.GetRefCompID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].RefCompID = &v
+		}
+		if m.HasRefSubID() {
+			v, err := m.GetRefSubID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].RefSubID = &v
+		}
+		if m.HasLocationID() {
+			v, err := m.GetLocationID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LocationID = &v
+		}
+		if m.HasDeskID() {
+			v, err := m.GetDeskID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].DeskID = &v
+		}
+		if m.HasStatusValue() {
+			v, err := m.GetStatusValue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].StatusValue = &v
+		}
+		if m.HasStatusText() {
+			v, err := m.GetStatusText()
+			if err != nil {
+				return nil, err
+			}
+			s[i].StatusText = &v
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoCompIDs builds a NoCompIDsRepeatingGroup from s
+func UnmarshalNoCompIDs(s []NoCompIDsStruct) NoCompIDsRepeatingGroup {
+	g := NewNoCompIDsRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.RefCompID != nil {
+			m.SetRefCompID(*e.RefCompID)
+		}
+		if e.RefSubID != nil {
+			m.SetRefSubID(*e.RefSubID)
+		}
+		if e.LocationID != nil {
+			m.SetLocationID(*e.LocationID)
+		}
+		if e.DeskID != nil {
+			m.SetDeskID(*e.DeskID)
+		}
+		if e.StatusValue != nil {
+			m.SetStatusValue(*e.StatusValue)
+		}
+		if e.StatusText != nil {
+			m.SetStatusText(*e.StatusText)
+		}
+	}
+	return g
+}
@@ -0,0 +1,192 @@
+package newordercross
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/terracefi/enum"
+	"github.com/terracefi/fix44/internal/fixutil"
+	"github.com/terracefi/fix44/newordersingle"
+	"github.com/terracefi/quickfix"
+	"github.com/terracefi/tag"
+)
+
+//CrossType values, FIX 4.4
+const (
+	CrossAllOrNone           enum.CrossType = "1"
+	CrossPartialRestCanceled enum.CrossType = "2"
+	CrossPartialRestActive   enum.CrossType = "3"
+	CrossWithExistingOrders  enum.CrossType = "4"
+)
+
+//CrossPrioritization values, FIX 4.4
+const (
+	PrioritizeNone enum.CrossPrioritization = "0"
+	PrioritizeBuy  enum.CrossPrioritization = "1"
+	PrioritizeSell enum.CrossPrioritization = "2"
+)
+
+//SessionRejectReason values used by CheckCross
+const (
+	reasonRequiredTagMissing       = 1
+	reasonValueIsIncorrect         = 5
+	reasonIncorrectNumInGroupCount = 16
+)
+
+//FromOrders returns the NewOrderCross crossing the orders a and b, one of which must buy and the other sell. Each
+//order becomes a side of the cross with its own ClOrdID, account, parties, allocations and quantity. The instrument,
+//OrdType, Price, StopPx, TimeInForce and Currency of the cross are taken from a, and b must not differ from a in
+//them. The cross is checked with CheckCross.
+func FromOrders(crossID string, crossType enum.CrossType, prioritization enum.CrossPrioritization, a, b newordersingle.Struct) (NewOrderCross, error) {
+	if err := sameTerms(a, b); err != nil {
+		return NewOrderCross{}, err
+	}
+
+	s := Struct{
+		CrossID:             crossID,
+		CrossType:           crossType,
+		CrossPrioritization: prioritization,
+		TransactTime:        time.Now(),
+		Symbol:              a.Symbol,
+		SecurityID:          a.SecurityID,
+		SecurityIDSource:    a.SecurityIDSource,
+		NoSecurityAltID:     a.NoSecurityAltID,
+		OrdType:             a.OrdType,
+		Price:               a.Price,
+		StopPx:              a.StopPx,
+		TimeInForce:         a.TimeInForce,
+		ExpireDate:          a.ExpireDate,
+		ExpireTime:          a.ExpireTime,
+		Currency:            a.Currency,
+		ExDestination:       a.ExDestination,
+		NoSides:             []NoSidesStruct{side(a), side(b)},
+	}
+	if err := CheckCross(s); err != nil {
+		return NewOrderCross{}, err
+	}
+	return Unmarshal(s), nil
+}
+
+func sameTerms(a, b newordersingle.Struct) error {
+	switch {
+	case fixutil.Deref(a.Symbol) != fixutil.Deref(b.Symbol):
+		return fmt.Errorf("newordercross: orders have Symbol %v and %v", fixutil.Deref(a.Symbol), fixutil.Deref(b.Symbol))
+	case fixutil.Deref(a.SecurityID) != fixutil.Deref(b.SecurityID):
+		return fmt.Errorf("newordercross: orders have SecurityID %v and %v", fixutil.Deref(a.SecurityID), fixutil.Deref(b.SecurityID))
+	case a.OrdType != b.OrdType:
+		return fmt.Errorf("newordercross: orders have OrdType %v and %v", a.OrdType, b.OrdType)
+	case !sameDecimal(a.Price, b.Price):
+		return fmt.Errorf("newordercross: orders have different Price")
+	case !sameDecimal(a.StopPx, b.StopPx):
+		return fmt.Errorf("newordercross: orders have different StopPx")
+	case a.TimeInForce != nil && b.TimeInForce != nil && *a.TimeInForce != *b.TimeInForce:
+		return fmt.Errorf("newordercross: orders have TimeInForce %v and %v", *a.TimeInForce, *b.TimeInForce)
+	case a.Currency != nil && b.Currency != nil && *a.Currency != *b.Currency:
+		return fmt.Errorf("newordercross: orders have Currency %v and %v", *a.Currency, *b.Currency)
+	}
+	return nil
+}
+
+//side returns the side of a cross placed by the order o
+func side(o newordersingle.Struct) NoSidesStruct {
+	clOrdID, sd := o.ClOrdID, o.Side
+	s := NoSidesStruct{
+		Side:              &sd,
+		ClOrdID:           &clOrdID,
+		SecondaryClOrdID:  o.SecondaryClOrdID,
+		ClOrdLinkID:       o.ClOrdLinkID,
+		NoPartyIDs:        o.NoPartyIDs,
+		TradeDate:         o.TradeDate,
+		Account:           o.Account,
+		AcctIDSource:      o.AcctIDSource,
+		AccountType:       o.AccountType,
+		DayBookingInst:    o.DayBookingInst,
+		BookingUnit:       o.BookingUnit,
+		PreallocMethod:    o.PreallocMethod,
+		AllocID:           o.AllocID,
+		OrderQty:          o.OrderQty,
+		CashOrderQty:      o.CashOrderQty,
+		OrderPercent:      o.OrderPercent,
+		OrderCapacity:     o.OrderCapacity,
+		OrderRestrictions: o.OrderRestrictions,
+		CustOrderCapacity: o.CustOrderCapacity,
+		PositionEffect:    o.PositionEffect,
+		CashMargin:        o.CashMargin,
+		Text:              o.Text,
+	}
+	for _, a := range o.NoAllocs {
+		s.NoAllocs = append(s.NoAllocs, NoAllocsStruct(a))
+	}
+	return s
+}
+
+//CheckCross checks the rules of a cross that Validate does not: the cross has exactly two sides, each with a Side
+//and its own ClOrdID, one side buys (Buy or Buy minus) and the other sells (Sell, Sell plus, Sell short or Sell
+//short exempt), CrossType and CrossPrioritization have FIX 4.4 values, and
+//the sides of an all-or-none cross have the same OrderQty.
+func CheckCross(s Struct) quickfix.MessageRejectError {
+	switch s.CrossType {
+	case CrossAllOrNone, CrossPartialRestCanceled, CrossPartialRestActive, CrossWithExistingOrders:
+	default:
+		return reject(tag.CrossType, reasonValueIsIncorrect, "CrossType %v is not a FIX 4.4 value", s.CrossType)
+	}
+	switch s.CrossPrioritization {
+	case PrioritizeNone, PrioritizeBuy, PrioritizeSell:
+	default:
+		return reject(tag.CrossPrioritization, reasonValueIsIncorrect, "CrossPrioritization %v is not a FIX 4.4 value",
+			s.CrossPrioritization)
+	}
+
+	if len(s.NoSides) != 2 {
+		return reject(tag.NoSides, reasonIncorrectNumInGroupCount, "cross has %v sides", len(s.NoSides))
+	}
+	var buys, sells int
+	for _, sd := range s.NoSides {
+		switch {
+		case sd.Side == nil:
+			return reject(tag.Side, reasonRequiredTagMissing, "cross side has no Side")
+		case sd.ClOrdID == nil:
+			return reject(tag.ClOrdID, reasonRequiredTagMissing, "cross side has no ClOrdID")
+		}
+		switch *sd.Side {
+		case enum.Side("1"), enum.Side("3"):
+			buys++
+		case enum.Side("2"), enum.Side("4"), enum.Side("5"), enum.Side("6"):
+			sells++
+		}
+	}
+	a, b := s.NoSides[0], s.NoSides[1]
+	if buys != 1 || sells != 1 {
+		return reject(tag.Side, reasonValueIsIncorrect, "cross sides are %v and %v, not a buy and a sell", *a.Side, *b.Side)
+	}
+	if err := CheckClOrdIDs(*a.ClOrdID, *b.ClOrdID); err != nil {
+		return err
+	}
+	if s.CrossType == CrossAllOrNone && a.OrderQty != nil && b.OrderQty != nil && !a.OrderQty.Equal(*b.OrderQty) {
+		return reject(tag.OrderQty, reasonValueIsIncorrect, "all-or-none cross sides have OrderQty %v and %v",
+			*a.OrderQty, *b.OrderQty)
+	}
+	return nil
+}
+
+//CheckClOrdIDs rejects the ClOrdIDs of two sides of a cross if they are the same, since each side is an order of its
+//own. CheckCross uses it, and so should the checks of the new ClOrdIDs of a cross cancel or replace request.
+func CheckClOrdIDs(a, b string) quickfix.MessageRejectError {
+	if a == b {
+		return reject(tag.ClOrdID, reasonValueIsIncorrect, "cross sides share ClOrdID %v", a)
+	}
+	return nil
+}
+
+func reject(t quickfix.Tag, reason int, format string, args ...interface{}) quickfix.MessageRejectError {
+	return quickfix.NewMessageRejectError("newordercross: "+fmt.Sprintf(format, args...), reason, &t)
+}
+
+func sameDecimal(a, b *decimal.Decimal) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
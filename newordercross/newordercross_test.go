@@ -0,0 +1,134 @@
+package newordercross
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/terracefi/enum"
+	"github.com/terracefi/fix44/internal/fixutil"
+	"github.com/terracefi/fix44/newordersingle"
+	"github.com/terracefi/quickfix"
+	"github.com/terracefi/tag"
+)
+
+func crossSide(side enum.Side, clOrdID string, qty int64) NoSidesStruct {
+	return NoSidesStruct{Side: fixutil.Ptr(side), ClOrdID: fixutil.Ptr(clOrdID),
+		OrderQty: fixutil.Ptr(decimal.NewFromInt(qty))}
+}
+
+func TestCheckCross(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(s *Struct)
+		tag    quickfix.Tag
+	}{
+		{"valid", func(s *Struct) {}, 0},
+		{"buy minus and sell short", func(s *Struct) {
+			s.NoSides = []NoSidesStruct{crossSide("3", "A", 100), crossSide("5", "B", 100)}
+		}, 0},
+		{"sell short exempt first", func(s *Struct) {
+			s.NoSides = []NoSidesStruct{crossSide("6", "A", 100), crossSide("1", "B", 100)}
+		}, 0},
+		{"CrossType", func(s *Struct) { s.CrossType = "5" }, tag.CrossType},
+		{"CrossPrioritization", func(s *Struct) { s.CrossPrioritization = "3" }, tag.CrossPrioritization},
+		{"one side", func(s *Struct) { s.NoSides = s.NoSides[:1] }, tag.NoSides},
+		{"three sides", func(s *Struct) { s.NoSides = append(s.NoSides, crossSide("2", "C", 100)) }, tag.NoSides},
+		{"no Side", func(s *Struct) { s.NoSides[1].Side = nil }, tag.Side},
+		{"no ClOrdID", func(s *Struct) { s.NoSides[0].ClOrdID = nil }, tag.ClOrdID},
+		{"two buys", func(s *Struct) { s.NoSides[1].Side = fixutil.Ptr(enum.Side("3")) }, tag.Side},
+		{"two sells", func(s *Struct) { s.NoSides[0].Side = fixutil.Ptr(enum.Side("4")) }, tag.Side},
+		{"side that neither buys nor sells", func(s *Struct) { s.NoSides[1].Side = fixutil.Ptr(enum.Side("8")) },
+			tag.Side},
+		{"shared ClOrdID", func(s *Struct) { s.NoSides[1].ClOrdID = fixutil.Ptr("A") }, tag.ClOrdID},
+		{"all-or-none OrderQty", func(s *Struct) { s.NoSides[1].OrderQty = fixutil.Ptr(decimal.NewFromInt(50)) },
+			tag.OrderQty},
+		{"partial cross OrderQty", func(s *Struct) {
+			s.CrossType = CrossPartialRestActive
+			s.NoSides[1].OrderQty = fixutil.Ptr(decimal.NewFromInt(50))
+		}, 0},
+		{"all-or-none without OrderQty", func(s *Struct) { s.NoSides[1].OrderQty = nil }, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := Struct{
+				CrossID:             "X1",
+				CrossType:           CrossAllOrNone,
+				CrossPrioritization: PrioritizeNone,
+				NoSides:             []NoSidesStruct{crossSide("1", "A", 100), crossSide("2", "B", 100)},
+			}
+			tt.modify(&s)
+
+			err := CheckCross(s)
+			switch {
+			case tt.tag == 0 && err != nil:
+				t.Errorf("CheckCross() = %v, want nil", err)
+			case tt.tag != 0 && err == nil:
+				t.Errorf("CheckCross() = nil, want a reject of tag %v", tt.tag)
+			case tt.tag != 0 && (err.RefTagID() == nil || *err.RefTagID() != tt.tag):
+				t.Errorf("CheckCross() = %v, want a reject of tag %v", err, tt.tag)
+			}
+		})
+	}
+}
+
+func TestCheckClOrdIDs(t *testing.T) {
+	tests := []struct {
+		a, b    string
+		wantErr bool
+	}{
+		{"A", "B", false},
+		{"A", "A", true},
+		{"", "", true},
+	}
+	for _, tt := range tests {
+		if err := CheckClOrdIDs(tt.a, tt.b); (err != nil) != tt.wantErr {
+			t.Errorf("CheckClOrdIDs(%q, %q) = %v, want error %v", tt.a, tt.b, err, tt.wantErr)
+		}
+	}
+}
+
+func TestFromOrders(t *testing.T) {
+	order := func(clOrdID string, side enum.Side) newordersingle.Struct {
+		return newordersingle.Struct{ClOrdID: clOrdID, Side: side, OrdType: "2", Symbol: fixutil.Ptr("ABC"),
+			Price: fixutil.Ptr(decimal.NewFromInt(10)), OrderQty: fixutil.Ptr(decimal.NewFromInt(100))}
+	}
+	tests := []struct {
+		name    string
+		modify  func(b *newordersingle.Struct)
+		wantErr bool
+	}{
+		{"crossed", func(b *newordersingle.Struct) {}, false},
+		{"other Symbol", func(b *newordersingle.Struct) { b.Symbol = fixutil.Ptr("XYZ") }, true},
+		{"other OrdType", func(b *newordersingle.Struct) { b.OrdType = "1" }, true},
+		{"other Price", func(b *newordersingle.Struct) { b.Price = fixutil.Ptr(decimal.NewFromInt(11)) }, true},
+		{"no Price", func(b *newordersingle.Struct) { b.Price = nil }, true},
+		{"other TimeInForce", func(b *newordersingle.Struct) { b.TimeInForce = fixutil.Ptr(enum.TimeInForce("3")) },
+			false},
+		{"same side", func(b *newordersingle.Struct) { b.Side = "1" }, true},
+		{"same ClOrdID", func(b *newordersingle.Struct) { b.ClOrdID = "A" }, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := order("A", "1"), order("B", "2")
+			tt.modify(&b)
+
+			msg, err := FromOrders("X1", CrossAllOrNone, PrioritizeNone, a, b)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FromOrders() error = %v, want error %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			s, err := Marshal(msg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(s.NoSides) != 2 || *s.NoSides[0].ClOrdID != "A" || *s.NoSides[1].ClOrdID != "B" {
+				t.Errorf("NoSides = %+v, want sides A and B", s.NoSides)
+			}
+			if s.Symbol == nil || *s.Symbol != "ABC" || s.Price == nil || !s.Price.Equal(decimal.NewFromInt(10)) {
+				t.Errorf("Symbol, Price = %v, %v, want ABC, 10", s.Symbol, s.Price)
+			}
+		})
+	}
+}
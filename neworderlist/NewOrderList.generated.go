@@ -8,6 +8,7 @@ import (
 	"github.com/terracefi/enum"
 	"github.com/terracefi/field"
 	"github.com/terracefi/fix44"
+	"github.com/terracefi/fix44/components"
 	"github.com/terracefi/quickfix"
 	"github.com/terracefi/tag"
 )
@@ -61,6 +62,26 @@ func Route(router RouteOut) (string, string, quickfix.MessageRoute) {
 	return "FIX.4.4", "E", r
 }
 
+//Validate checks m against the FIX 4.4 definition of NewOrderList, see fix44.MessageDef.Validate
+func (m NewOrderList) Validate() fix44.ValidationErrors {
+	return fix44.MessageDefs["E"].Validate(&m.Body.FieldMap)
+}
+
+//MarshalJSON encodes the NewOrderList as JSON with fields keyed by name, see fix44.MarshalMessageJSON
+func (m NewOrderList) MarshalJSON() ([]byte, error) {
+	return fix44.MarshalMessageJSON(m.Message)
+}
+
+//UnmarshalJSON builds the NewOrderList from JSON produced by MarshalJSON
+func (m *NewOrderList) UnmarshalJSON(b []byte) error {
+	msg, err := fix44.UnmarshalMessageJSON(b, "E")
+	if err != nil {
+		return err
+	}
+	*m = FromMessage(msg)
+	return nil
+}
+
 //SetListID sets ListID, Tag 66
 func (m NewOrderList) SetListID(v string) {
 	m.Set(field.NewListID(v))
@@ -355,6 +376,21 @@ func (m NewOrderList) HasEncodedListExecInst() bool {
 	return m.Has(tag.EncodedListExecInst)
 }
 
+//SetEncodedListExecInstBytes sets EncodedListExecInst, Tag 353, and EncodedListExecInstLen, Tag 352, from v, which may hold any bytes including SOH
+func (m NewOrderList) SetEncodedListExecInstBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedListExecInstLen, tag.EncodedListExecInst, v)
+}
+
+//GetEncodedListExecInstBytes gets EncodedListExecInst, Tag 353, checking its length against EncodedListExecInstLen, Tag 352
+func (m NewOrderList) GetEncodedListExecInstBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedListExecInstLen, tag.EncodedListExecInst)
+}
+
+//GetEncodedListExecInstDecoded gets EncodedListExecInst, Tag 353, decoded to UTF-8 using the MessageEncoding of the Header
+func (m NewOrderList) GetEncodedListExecInstDecoded() (string, error) {
+	return fix44.DecodeRawData(m.Header, m, tag.EncodedListExecInstLen, tag.EncodedListExecInst)
+}
+
 //HasBidID returns true if BidID is present, Tag 390
 func (m NewOrderList) HasBidID() bool {
 	return m.Has(tag.BidID)
@@ -2750,6 +2786,21 @@ func (m NoOrders) HasEncodedIssuer() bool {
 	return m.Has(tag.EncodedIssuer)
 }
 
+//SetEncodedIssuerBytes sets EncodedIssuer, Tag 349, and EncodedIssuerLen, Tag 348, from v, which may hold any bytes including SOH
+func (m NoOrders) SetEncodedIssuerBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedIssuerLen, tag.EncodedIssuer, v)
+}
+
+//GetEncodedIssuerBytes gets EncodedIssuer, Tag 349, checking its length against EncodedIssuerLen, Tag 348
+func (m NoOrders) GetEncodedIssuerBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedIssuerLen, tag.EncodedIssuer)
+}
+
+//GetEncodedIssuerDecoded gets EncodedIssuer, Tag 349, decoded to UTF-8 using the MessageEncoding of h, the Header of the message holding it
+func (m NoOrders) GetEncodedIssuerDecoded(h fix44.Header) (string, error) {
+	return fix44.DecodeRawData(h, m, tag.EncodedIssuerLen, tag.EncodedIssuer)
+}
+
 //HasSecurityDesc returns true if SecurityDesc is present, Tag 107
 func (m NoOrders) HasSecurityDesc() bool {
 	return m.Has(tag.SecurityDesc)
@@ -2765,6 +2816,21 @@ func (m NoOrders) HasEncodedSecurityDesc() bool {
 	return m.Has(tag.EncodedSecurityDesc)
 }
 
+//SetEncodedSecurityDescBytes sets EncodedSecurityDesc, Tag 351, and EncodedSecurityDescLen, Tag 350, from v, which may hold any bytes including SOH
+func (m NoOrders) SetEncodedSecurityDescBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedSecurityDescLen, tag.EncodedSecurityDesc, v)
+}
+
+//GetEncodedSecurityDescBytes gets EncodedSecurityDesc, Tag 351, checking its length against EncodedSecurityDescLen, Tag 350
+func (m NoOrders) GetEncodedSecurityDescBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedSecurityDescLen, tag.EncodedSecurityDesc)
+}
+
+//GetEncodedSecurityDescDecoded gets EncodedSecurityDesc, Tag 351, decoded to UTF-8 using the MessageEncoding of h, the Header of the message holding it
+func (m NoOrders) GetEncodedSecurityDescDecoded(h fix44.Header) (string, error) {
+	return fix44.DecodeRawData(h, m, tag.EncodedSecurityDescLen, tag.EncodedSecurityDesc)
+}
+
 //HasPool returns true if Pool is present, Tag 691
 func (m NoOrders) HasPool() bool {
 	return m.Has(tag.Pool)
@@ -3070,6 +3136,21 @@ func (m NoOrders) HasEncodedText() bool {
 	return m.Has(tag.EncodedText)
 }
 
+//SetEncodedTextBytes sets EncodedText, Tag 355, and EncodedTextLen, Tag 354, from v, which may hold any bytes including SOH
+func (m NoOrders) SetEncodedTextBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedTextLen, tag.EncodedText, v)
+}
+
+//GetEncodedTextBytes gets EncodedText, Tag 355, checking its length against EncodedTextLen, Tag 354
+func (m NoOrders) GetEncodedTextBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedTextLen, tag.EncodedText)
+}
+
+//GetEncodedTextDecoded gets EncodedText, Tag 355, decoded to UTF-8 using the MessageEncoding of h, the Header of the message holding it
+func (m NoOrders) GetEncodedTextDecoded(h fix44.Header) (string, error) {
+	return fix44.DecodeRawData(h, m, tag.EncodedTextLen, tag.EncodedText)
+}
+
 //HasSettlDate2 returns true if SettlDate2 is present, Tag 193
 func (m NoOrders) HasSettlDate2() bool {
 	return m.Has(tag.SettlDate2)
@@ -3185,173 +3266,93 @@ func (m NoOrders) HasDesignation() bool {
 	return m.Has(tag.Designation)
 }
 
-//NoPartyIDs is a repeating group element, Tag 453
-type NoPartyIDs struct {
-	*quickfix.Group
+//GetInstrument gets the Instrument component
+func (m NoOrders) GetInstrument() components.Instrument {
+	return components.Instrument{&m.Group.FieldMap}
 }
 
-//SetPartyID sets PartyID, Tag 448
-func (m NoPartyIDs) SetPartyID(v string) {
-	m.Set(field.NewPartyID(v))
+//SetInstrument sets the Instrument component, copying the fields present in c
+func (m NoOrders) SetInstrument(c components.Instrument) quickfix.MessageRejectError {
+	return c.CopyInto(&m.Group.FieldMap)
 }
 
-//SetPartyIDSource sets PartyIDSource, Tag 447
-func (m NoPartyIDs) SetPartyIDSource(v enum.PartyIDSource) {
-	m.Set(field.NewPartyIDSource(v))
-}
-
-//SetPartyRole sets PartyRole, Tag 452
-func (m NoPartyIDs) SetPartyRole(v enum.PartyRole) {
-	m.Set(field.NewPartyRole(v))
-}
-
-//SetNoPartySubIDs sets NoPartySubIDs, Tag 802
-func (m NoPartyIDs) SetNoPartySubIDs(f NoPartySubIDsRepeatingGroup) {
-	m.SetGroup(f)
-}
-
-//GetPartyID gets PartyID, Tag 448
-func (m NoPartyIDs) GetPartyID() (v string, err quickfix.MessageRejectError) {
-	var f field.PartyIDField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
+//GetParties gets the Parties component
+func (m NoOrders) GetParties() components.Parties {
+	return components.Parties{&m.Group.FieldMap}
 }
 
-//GetPartyIDSource gets PartyIDSource, Tag 447
-func (m NoPartyIDs) GetPartyIDSource() (v enum.PartyIDSource, err quickfix.MessageRejectError) {
-	var f field.PartyIDSourceField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
+//SetParties sets the Parties component, copying the fields present in c
+func (m NoOrders) SetParties(c components.Parties) quickfix.MessageRejectError {
+	return c.CopyInto(&m.Group.FieldMap)
 }
 
-//GetPartyRole gets PartyRole, Tag 452
-func (m NoPartyIDs) GetPartyRole() (v enum.PartyRole, err quickfix.MessageRejectError) {
-	var f field.PartyRoleField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
+//GetOrderQtyData gets the OrderQtyData component
+func (m NoOrders) GetOrderQtyData() components.OrderQtyData {
+	return components.OrderQtyData{&m.Group.FieldMap}
 }
 
-//GetNoPartySubIDs gets NoPartySubIDs, Tag 802
-func (m NoPartyIDs) GetNoPartySubIDs() (NoPartySubIDsRepeatingGroup, quickfix.MessageRejectError) {
-	f := NewNoPartySubIDsRepeatingGroup()
-	err := m.GetGroup(f)
-	return f, err
+//SetOrderQtyData sets the OrderQtyData component, copying the fields present in c
+func (m NoOrders) SetOrderQtyData(c components.OrderQtyData) quickfix.MessageRejectError {
+	return c.CopyInto(&m.Group.FieldMap)
 }
 
-//HasPartyID returns true if PartyID is present, Tag 448
-func (m NoPartyIDs) HasPartyID() bool {
-	return m.Has(tag.PartyID)
+//GetCommissionData gets the CommissionData component
+func (m NoOrders) GetCommissionData() components.CommissionData {
+	return components.CommissionData{&m.Group.FieldMap}
 }
 
-//HasPartyIDSource returns true if PartyIDSource is present, Tag 447
-func (m NoPartyIDs) HasPartyIDSource() bool {
-	return m.Has(tag.PartyIDSource)
+//SetCommissionData sets the CommissionData component, copying the fields present in c
+func (m NoOrders) SetCommissionData(c components.CommissionData) quickfix.MessageRejectError {
+	return c.CopyInto(&m.Group.FieldMap)
 }
 
-//HasPartyRole returns true if PartyRole is present, Tag 452
-func (m NoPartyIDs) HasPartyRole() bool {
-	return m.Has(tag.PartyRole)
+//GetStipulations gets the Stipulations component
+func (m NoOrders) GetStipulations() components.Stipulations {
+	return components.Stipulations{&m.Group.FieldMap}
 }
 
-//HasNoPartySubIDs returns true if NoPartySubIDs is present, Tag 802
-func (m NoPartyIDs) HasNoPartySubIDs() bool {
-	return m.Has(tag.NoPartySubIDs)
+//SetStipulations sets the Stipulations component, copying the fields present in c
+func (m NoOrders) SetStipulations(c components.Stipulations) quickfix.MessageRejectError {
+	return c.CopyInto(&m.Group.FieldMap)
 }
 
-//NoPartySubIDs is a repeating group element, Tag 802
-type NoPartySubIDs struct {
-	*quickfix.Group
+//GetSpreadOrBenchmarkCurveData gets the SpreadOrBenchmarkCurveData component
+func (m NoOrders) GetSpreadOrBenchmarkCurveData() components.SpreadOrBenchmarkCurveData {
+	return components.SpreadOrBenchmarkCurveData{&m.Group.FieldMap}
 }
 
-//SetPartySubID sets PartySubID, Tag 523
-func (m NoPartySubIDs) SetPartySubID(v string) {
-	m.Set(field.NewPartySubID(v))
+//SetSpreadOrBenchmarkCurveData sets the SpreadOrBenchmarkCurveData component, copying the fields present in c
+func (m NoOrders) SetSpreadOrBenchmarkCurveData(c components.SpreadOrBenchmarkCurveData) quickfix.MessageRejectError {
+	return c.CopyInto(&m.Group.FieldMap)
 }
 
-//SetPartySubIDType sets PartySubIDType, Tag 803
-func (m NoPartySubIDs) SetPartySubIDType(v enum.PartySubIDType) {
-	m.Set(field.NewPartySubIDType(v))
-}
+//NoPartyIDs is a repeating group element, Tag 453
+type NoPartyIDs = components.NoPartyIDs
 
-//GetPartySubID gets PartySubID, Tag 523
-func (m NoPartySubIDs) GetPartySubID() (v string, err quickfix.MessageRejectError) {
-	var f field.PartySubIDField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
+//NoPartyIDsRepeatingGroup is a repeating group, Tag 453
+type NoPartyIDsRepeatingGroup = components.NoPartyIDsRepeatingGroup
 
-//GetPartySubIDType gets PartySubIDType, Tag 803
-func (m NoPartySubIDs) GetPartySubIDType() (v enum.PartySubIDType, err quickfix.MessageRejectError) {
-	var f field.PartySubIDTypeField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
+//NewNoPartyIDsRepeatingGroup returns an initialized, NoPartyIDsRepeatingGroup
+func NewNoPartyIDsRepeatingGroup() NoPartyIDsRepeatingGroup {
+	return components.NewNoPartyIDsRepeatingGroup()
 }
 
-//HasPartySubID returns true if PartySubID is present, Tag 523
-func (m NoPartySubIDs) HasPartySubID() bool {
-	return m.Has(tag.PartySubID)
-}
+//NoPartyIDsStruct is a plain Go representation of a NoPartyIDs group element
+type NoPartyIDsStruct = components.NoPartyIDsStruct
 
-//HasPartySubIDType returns true if PartySubIDType is present, Tag 803
-func (m NoPartySubIDs) HasPartySubIDType() bool {
-	return m.Has(tag.PartySubIDType)
-}
+//NoPartySubIDs is a repeating group element, Tag 802
+type NoPartySubIDs = components.NoPartySubIDs
 
 //NoPartySubIDsRepeatingGroup is a repeating group, Tag 802
-type NoPartySubIDsRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
+type NoPartySubIDsRepeatingGroup = components.NoPartySubIDsRepeatingGroup
 
 //NewNoPartySubIDsRepeatingGroup returns an initialized, NoPartySubIDsRepeatingGroup
 func NewNoPartySubIDsRepeatingGroup() NoPartySubIDsRepeatingGroup {
-	return NoPartySubIDsRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoPartySubIDs,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.PartySubID), quickfix.GroupElement(tag.PartySubIDType)})}
-}
-
-//Add create and append a new NoPartySubIDs to this group
-func (m NoPartySubIDsRepeatingGroup) Add() NoPartySubIDs {
-	g := m.RepeatingGroup.Add()
-	return NoPartySubIDs{g}
-}
-
-//Get returns the ith NoPartySubIDs in the NoPartySubIDsRepeatinGroup
-func (m NoPartySubIDsRepeatingGroup) Get(i int) NoPartySubIDs {
-	return NoPartySubIDs{m.RepeatingGroup.Get(i)}
-}
-
-//NoPartyIDsRepeatingGroup is a repeating group, Tag 453
-type NoPartyIDsRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
-
-//NewNoPartyIDsRepeatingGroup returns an initialized, NoPartyIDsRepeatingGroup
-func NewNoPartyIDsRepeatingGroup() NoPartyIDsRepeatingGroup {
-	return NoPartyIDsRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoPartyIDs,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.PartyID), quickfix.GroupElement(tag.PartyIDSource), quickfix.GroupElement(tag.PartyRole), NewNoPartySubIDsRepeatingGroup()})}
-}
-
-//Add create and append a new NoPartyIDs to this group
-func (m NoPartyIDsRepeatingGroup) Add() NoPartyIDs {
-	g := m.RepeatingGroup.Add()
-	return NoPartyIDs{g}
+	return components.NewNoPartySubIDsRepeatingGroup()
 }
 
-//Get returns the ith NoPartyIDs in the NoPartyIDsRepeatinGroup
-func (m NoPartyIDsRepeatingGroup) Get(i int) NoPartyIDs {
-	return NoPartyIDs{m.RepeatingGroup.Get(i)}
-}
+//NoPartySubIDsStruct is a plain Go representation of a NoPartySubIDs group element
+type NoPartySubIDsStruct = components.NoPartySubIDsStruct
 
 //NoAllocs is a repeating group element, Tag 78
 type NoAllocs struct {
@@ -3470,173 +3471,43 @@ func (m NoAllocs) HasAllocQty() bool {
 	return m.Has(tag.AllocQty)
 }
 
-//NoNestedPartyIDs is a repeating group element, Tag 539
-type NoNestedPartyIDs struct {
-	*quickfix.Group
-}
-
-//SetNestedPartyID sets NestedPartyID, Tag 524
-func (m NoNestedPartyIDs) SetNestedPartyID(v string) {
-	m.Set(field.NewNestedPartyID(v))
-}
-
-//SetNestedPartyIDSource sets NestedPartyIDSource, Tag 525
-func (m NoNestedPartyIDs) SetNestedPartyIDSource(v string) {
-	m.Set(field.NewNestedPartyIDSource(v))
-}
-
-//SetNestedPartyRole sets NestedPartyRole, Tag 538
-func (m NoNestedPartyIDs) SetNestedPartyRole(v int) {
-	m.Set(field.NewNestedPartyRole(v))
+//GetNestedParties gets the NestedParties component
+func (m NoAllocs) GetNestedParties() components.NestedParties {
+	return components.NestedParties{&m.Group.FieldMap}
 }
 
-//SetNoNestedPartySubIDs sets NoNestedPartySubIDs, Tag 804
-func (m NoNestedPartyIDs) SetNoNestedPartySubIDs(f NoNestedPartySubIDsRepeatingGroup) {
-	m.SetGroup(f)
+//SetNestedParties sets the NestedParties component, copying the fields present in c
+func (m NoAllocs) SetNestedParties(c components.NestedParties) quickfix.MessageRejectError {
+	return c.CopyInto(&m.Group.FieldMap)
 }
 
-//GetNestedPartyID gets NestedPartyID, Tag 524
-func (m NoNestedPartyIDs) GetNestedPartyID() (v string, err quickfix.MessageRejectError) {
-	var f field.NestedPartyIDField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetNestedPartyIDSource gets NestedPartyIDSource, Tag 525
-func (m NoNestedPartyIDs) GetNestedPartyIDSource() (v string, err quickfix.MessageRejectError) {
-	var f field.NestedPartyIDSourceField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetNestedPartyRole gets NestedPartyRole, Tag 538
-func (m NoNestedPartyIDs) GetNestedPartyRole() (v int, err quickfix.MessageRejectError) {
-	var f field.NestedPartyRoleField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetNoNestedPartySubIDs gets NoNestedPartySubIDs, Tag 804
-func (m NoNestedPartyIDs) GetNoNestedPartySubIDs() (NoNestedPartySubIDsRepeatingGroup, quickfix.MessageRejectError) {
-	f := NewNoNestedPartySubIDsRepeatingGroup()
-	err := m.GetGroup(f)
-	return f, err
-}
-
-//HasNestedPartyID returns true if NestedPartyID is present, Tag 524
-func (m NoNestedPartyIDs) HasNestedPartyID() bool {
-	return m.Has(tag.NestedPartyID)
-}
+//NoNestedPartyIDs is a repeating group element, Tag 539
+type NoNestedPartyIDs = components.NoNestedPartyIDs
 
-//HasNestedPartyIDSource returns true if NestedPartyIDSource is present, Tag 525
-func (m NoNestedPartyIDs) HasNestedPartyIDSource() bool {
-	return m.Has(tag.NestedPartyIDSource)
-}
+//NoNestedPartyIDsRepeatingGroup is a repeating group, Tag 539
+type NoNestedPartyIDsRepeatingGroup = components.NoNestedPartyIDsRepeatingGroup
 
-//HasNestedPartyRole returns true if NestedPartyRole is present, Tag 538
-func (m NoNestedPartyIDs) HasNestedPartyRole() bool {
-	return m.Has(tag.NestedPartyRole)
+//NewNoNestedPartyIDsRepeatingGroup returns an initialized, NoNestedPartyIDsRepeatingGroup
+func NewNoNestedPartyIDsRepeatingGroup() NoNestedPartyIDsRepeatingGroup {
+	return components.NewNoNestedPartyIDsRepeatingGroup()
 }
 
-//HasNoNestedPartySubIDs returns true if NoNestedPartySubIDs is present, Tag 804
-func (m NoNestedPartyIDs) HasNoNestedPartySubIDs() bool {
-	return m.Has(tag.NoNestedPartySubIDs)
-}
+//NoNestedPartyIDsStruct is a plain Go representation of a NoNestedPartyIDs group element
+type NoNestedPartyIDsStruct = components.NoNestedPartyIDsStruct
 
 //NoNestedPartySubIDs is a repeating group element, Tag 804
-type NoNestedPartySubIDs struct {
-	*quickfix.Group
-}
-
-//SetNestedPartySubID sets NestedPartySubID, Tag 545
-func (m NoNestedPartySubIDs) SetNestedPartySubID(v string) {
-	m.Set(field.NewNestedPartySubID(v))
-}
-
-//SetNestedPartySubIDType sets NestedPartySubIDType, Tag 805
-func (m NoNestedPartySubIDs) SetNestedPartySubIDType(v int) {
-	m.Set(field.NewNestedPartySubIDType(v))
-}
-
-//GetNestedPartySubID gets NestedPartySubID, Tag 545
-func (m NoNestedPartySubIDs) GetNestedPartySubID() (v string, err quickfix.MessageRejectError) {
-	var f field.NestedPartySubIDField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetNestedPartySubIDType gets NestedPartySubIDType, Tag 805
-func (m NoNestedPartySubIDs) GetNestedPartySubIDType() (v int, err quickfix.MessageRejectError) {
-	var f field.NestedPartySubIDTypeField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//HasNestedPartySubID returns true if NestedPartySubID is present, Tag 545
-func (m NoNestedPartySubIDs) HasNestedPartySubID() bool {
-	return m.Has(tag.NestedPartySubID)
-}
-
-//HasNestedPartySubIDType returns true if NestedPartySubIDType is present, Tag 805
-func (m NoNestedPartySubIDs) HasNestedPartySubIDType() bool {
-	return m.Has(tag.NestedPartySubIDType)
-}
+type NoNestedPartySubIDs = components.NoNestedPartySubIDs
 
 //NoNestedPartySubIDsRepeatingGroup is a repeating group, Tag 804
-type NoNestedPartySubIDsRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
+type NoNestedPartySubIDsRepeatingGroup = components.NoNestedPartySubIDsRepeatingGroup
 
 //NewNoNestedPartySubIDsRepeatingGroup returns an initialized, NoNestedPartySubIDsRepeatingGroup
 func NewNoNestedPartySubIDsRepeatingGroup() NoNestedPartySubIDsRepeatingGroup {
-	return NoNestedPartySubIDsRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoNestedPartySubIDs,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.NestedPartySubID), quickfix.GroupElement(tag.NestedPartySubIDType)})}
-}
-
-//Add create and append a new NoNestedPartySubIDs to this group
-func (m NoNestedPartySubIDsRepeatingGroup) Add() NoNestedPartySubIDs {
-	g := m.RepeatingGroup.Add()
-	return NoNestedPartySubIDs{g}
+	return components.NewNoNestedPartySubIDsRepeatingGroup()
 }
 
-//Get returns the ith NoNestedPartySubIDs in the NoNestedPartySubIDsRepeatinGroup
-func (m NoNestedPartySubIDsRepeatingGroup) Get(i int) NoNestedPartySubIDs {
-	return NoNestedPartySubIDs{m.RepeatingGroup.Get(i)}
-}
-
-//NoNestedPartyIDsRepeatingGroup is a repeating group, Tag 539
-type NoNestedPartyIDsRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
-
-//NewNoNestedPartyIDsRepeatingGroup returns an initialized, NoNestedPartyIDsRepeatingGroup
-func NewNoNestedPartyIDsRepeatingGroup() NoNestedPartyIDsRepeatingGroup {
-	return NoNestedPartyIDsRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoNestedPartyIDs,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.NestedPartyID), quickfix.GroupElement(tag.NestedPartyIDSource), quickfix.GroupElement(tag.NestedPartyRole), NewNoNestedPartySubIDsRepeatingGroup()})}
-}
-
-//Add create and append a new NoNestedPartyIDs to this group
-func (m NoNestedPartyIDsRepeatingGroup) Add() NoNestedPartyIDs {
-	g := m.RepeatingGroup.Add()
-	return NoNestedPartyIDs{g}
-}
-
-//Get returns the ith NoNestedPartyIDs in the NoNestedPartyIDsRepeatinGroup
-func (m NoNestedPartyIDsRepeatingGroup) Get(i int) NoNestedPartyIDs {
-	return NoNestedPartyIDs{m.RepeatingGroup.Get(i)}
-}
+//NoNestedPartySubIDsStruct is a plain Go representation of a NoNestedPartySubIDs group element
+type NoNestedPartySubIDsStruct = components.NoNestedPartySubIDsStruct
 
 //NoAllocsRepeatingGroup is a repeating group, Tag 78
 type NoAllocsRepeatingGroup struct {
@@ -3728,174 +3599,32 @@ func (m NoTradingSessionsRepeatingGroup) Get(i int) NoTradingSessions {
 }
 
 //NoSecurityAltID is a repeating group element, Tag 454
-type NoSecurityAltID struct {
-	*quickfix.Group
-}
-
-//SetSecurityAltID sets SecurityAltID, Tag 455
-func (m NoSecurityAltID) SetSecurityAltID(v string) {
-	m.Set(field.NewSecurityAltID(v))
-}
-
-//SetSecurityAltIDSource sets SecurityAltIDSource, Tag 456
-func (m NoSecurityAltID) SetSecurityAltIDSource(v string) {
-	m.Set(field.NewSecurityAltIDSource(v))
-}
-
-//GetSecurityAltID gets SecurityAltID, Tag 455
-func (m NoSecurityAltID) GetSecurityAltID() (v string, err quickfix.MessageRejectError) {
-	var f field.SecurityAltIDField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetSecurityAltIDSource gets SecurityAltIDSource, Tag 456
-func (m NoSecurityAltID) GetSecurityAltIDSource() (v string, err quickfix.MessageRejectError) {
-	var f field.SecurityAltIDSourceField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//HasSecurityAltID returns true if SecurityAltID is present, Tag 455
-func (m NoSecurityAltID) HasSecurityAltID() bool {
-	return m.Has(tag.SecurityAltID)
-}
-
-//HasSecurityAltIDSource returns true if SecurityAltIDSource is present, Tag 456
-func (m NoSecurityAltID) HasSecurityAltIDSource() bool {
-	return m.Has(tag.SecurityAltIDSource)
-}
+type NoSecurityAltID = components.NoSecurityAltID
 
 //NoSecurityAltIDRepeatingGroup is a repeating group, Tag 454
-type NoSecurityAltIDRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
+type NoSecurityAltIDRepeatingGroup = components.NoSecurityAltIDRepeatingGroup
 
 //NewNoSecurityAltIDRepeatingGroup returns an initialized, NoSecurityAltIDRepeatingGroup
 func NewNoSecurityAltIDRepeatingGroup() NoSecurityAltIDRepeatingGroup {
-	return NoSecurityAltIDRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoSecurityAltID,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.SecurityAltID), quickfix.GroupElement(tag.SecurityAltIDSource)})}
-}
-
-//Add create and append a new NoSecurityAltID to this group
-func (m NoSecurityAltIDRepeatingGroup) Add() NoSecurityAltID {
-	g := m.RepeatingGroup.Add()
-	return NoSecurityAltID{g}
+	return components.NewNoSecurityAltIDRepeatingGroup()
 }
 
-//Get returns the ith NoSecurityAltID in the NoSecurityAltIDRepeatinGroup
-func (m NoSecurityAltIDRepeatingGroup) Get(i int) NoSecurityAltID {
-	return NoSecurityAltID{m.RepeatingGroup.Get(i)}
-}
+//NoSecurityAltIDStruct is a plain Go representation of a NoSecurityAltID group element
+type NoSecurityAltIDStruct = components.NoSecurityAltIDStruct
 
 //NoEvents is a repeating group element, Tag 864
-type NoEvents struct {
-	*quickfix.Group
-}
-
-//SetEventType sets EventType, Tag 865
-func (m NoEvents) SetEventType(v enum.EventType) {
-	m.Set(field.NewEventType(v))
-}
-
-//SetEventDate sets EventDate, Tag 866
-func (m NoEvents) SetEventDate(v string) {
-	m.Set(field.NewEventDate(v))
-}
-
-//SetEventPx sets EventPx, Tag 867
-func (m NoEvents) SetEventPx(value decimal.Decimal, scale int32) {
-	m.Set(field.NewEventPx(value, scale))
-}
-
-//SetEventText sets EventText, Tag 868
-func (m NoEvents) SetEventText(v string) {
-	m.Set(field.NewEventText(v))
-}
-
-//GetEventType gets EventType, Tag 865
-func (m NoEvents) GetEventType() (v enum.EventType, err quickfix.MessageRejectError) {
-	var f field.EventTypeField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetEventDate gets EventDate, Tag 866
-func (m NoEvents) GetEventDate() (v string, err quickfix.MessageRejectError) {
-	var f field.EventDateField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetEventPx gets EventPx, Tag 867
-func (m NoEvents) GetEventPx() (v decimal.Decimal, err quickfix.MessageRejectError) {
-	var f field.EventPxField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetEventText gets EventText, Tag 868
-func (m NoEvents) GetEventText() (v string, err quickfix.MessageRejectError) {
-	var f field.EventTextField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//HasEventType returns true if EventType is present, Tag 865
-func (m NoEvents) HasEventType() bool {
-	return m.Has(tag.EventType)
-}
-
-//HasEventDate returns true if EventDate is present, Tag 866
-func (m NoEvents) HasEventDate() bool {
-	return m.Has(tag.EventDate)
-}
-
-//HasEventPx returns true if EventPx is present, Tag 867
-func (m NoEvents) HasEventPx() bool {
-	return m.Has(tag.EventPx)
-}
-
-//HasEventText returns true if EventText is present, Tag 868
-func (m NoEvents) HasEventText() bool {
-	return m.Has(tag.EventText)
-}
+type NoEvents = components.NoEvents
 
 //NoEventsRepeatingGroup is a repeating group, Tag 864
-type NoEventsRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
+type NoEventsRepeatingGroup = components.NoEventsRepeatingGroup
 
 //NewNoEventsRepeatingGroup returns an initialized, NoEventsRepeatingGroup
 func NewNoEventsRepeatingGroup() NoEventsRepeatingGroup {
-	return NoEventsRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoEvents,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.EventType), quickfix.GroupElement(tag.EventDate), quickfix.GroupElement(tag.EventPx), quickfix.GroupElement(tag.EventText)})}
+	return components.NewNoEventsRepeatingGroup()
 }
 
-//Add create and append a new NoEvents to this group
-func (m NoEventsRepeatingGroup) Add() NoEvents {
-	g := m.RepeatingGroup.Add()
-	return NoEvents{g}
-}
-
-//Get returns the ith NoEvents in the NoEventsRepeatinGroup
-func (m NoEventsRepeatingGroup) Get(i int) NoEvents {
-	return NoEvents{m.RepeatingGroup.Get(i)}
-}
+//NoEventsStruct is a plain Go representation of a NoEvents group element
+type NoEventsStruct = components.NoEventsStruct
 
 //NoUnderlyings is a repeating group element, Tag 711
 type NoUnderlyings struct {
@@ -4702,6 +4431,21 @@ func (m NoUnderlyings) HasEncodedUnderlyingIssuer() bool {
 	return m.Has(tag.EncodedUnderlyingIssuer)
 }
 
+//SetEncodedUnderlyingIssuerBytes sets EncodedUnderlyingIssuer, Tag 363, and EncodedUnderlyingIssuerLen, Tag 362, from v, which may hold any bytes including SOH
+func (m NoUnderlyings) SetEncodedUnderlyingIssuerBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedUnderlyingIssuerLen, tag.EncodedUnderlyingIssuer, v)
+}
+
+//GetEncodedUnderlyingIssuerBytes gets EncodedUnderlyingIssuer, Tag 363, checking its length against EncodedUnderlyingIssuerLen, Tag 362
+func (m NoUnderlyings) GetEncodedUnderlyingIssuerBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedUnderlyingIssuerLen, tag.EncodedUnderlyingIssuer)
+}
+
+//GetEncodedUnderlyingIssuerDecoded gets EncodedUnderlyingIssuer, Tag 363, decoded to UTF-8 using the MessageEncoding of h, the Header of the message holding it
+func (m NoUnderlyings) GetEncodedUnderlyingIssuerDecoded(h fix44.Header) (string, error) {
+	return fix44.DecodeRawData(h, m, tag.EncodedUnderlyingIssuerLen, tag.EncodedUnderlyingIssuer)
+}
+
 //HasUnderlyingSecurityDesc returns true if UnderlyingSecurityDesc is present, Tag 307
 func (m NoUnderlyings) HasUnderlyingSecurityDesc() bool {
 	return m.Has(tag.UnderlyingSecurityDesc)
@@ -4717,6 +4461,21 @@ func (m NoUnderlyings) HasEncodedUnderlyingSecurityDesc() bool {
 	return m.Has(tag.EncodedUnderlyingSecurityDesc)
 }
 
+//SetEncodedUnderlyingSecurityDescBytes sets EncodedUnderlyingSecurityDesc, Tag 365, and EncodedUnderlyingSecurityDescLen, Tag 364, from v, which may hold any bytes including SOH
+func (m NoUnderlyings) SetEncodedUnderlyingSecurityDescBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedUnderlyingSecurityDescLen, tag.EncodedUnderlyingSecurityDesc, v)
+}
+
+//GetEncodedUnderlyingSecurityDescBytes gets EncodedUnderlyingSecurityDesc, Tag 365, checking its length against EncodedUnderlyingSecurityDescLen, Tag 364
+func (m NoUnderlyings) GetEncodedUnderlyingSecurityDescBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedUnderlyingSecurityDescLen, tag.EncodedUnderlyingSecurityDesc)
+}
+
+//GetEncodedUnderlyingSecurityDescDecoded gets EncodedUnderlyingSecurityDesc, Tag 365, decoded to UTF-8 using the MessageEncoding of h, the Header of the message holding it
+func (m NoUnderlyings) GetEncodedUnderlyingSecurityDescDecoded(h fix44.Header) (string, error) {
+	return fix44.DecodeRawData(h, m, tag.EncodedUnderlyingSecurityDescLen, tag.EncodedUnderlyingSecurityDesc)
+}
+
 //HasUnderlyingCPProgram returns true if UnderlyingCPProgram is present, Tag 877
 func (m NoUnderlyings) HasUnderlyingCPProgram() bool {
 	return m.Has(tag.UnderlyingCPProgram)
@@ -4772,137 +4531,43 @@ func (m NoUnderlyings) HasNoUnderlyingStips() bool {
 	return m.Has(tag.NoUnderlyingStips)
 }
 
-//NoUnderlyingSecurityAltID is a repeating group element, Tag 457
-type NoUnderlyingSecurityAltID struct {
-	*quickfix.Group
-}
-
-//SetUnderlyingSecurityAltID sets UnderlyingSecurityAltID, Tag 458
-func (m NoUnderlyingSecurityAltID) SetUnderlyingSecurityAltID(v string) {
-	m.Set(field.NewUnderlyingSecurityAltID(v))
-}
-
-//SetUnderlyingSecurityAltIDSource sets UnderlyingSecurityAltIDSource, Tag 459
-func (m NoUnderlyingSecurityAltID) SetUnderlyingSecurityAltIDSource(v string) {
-	m.Set(field.NewUnderlyingSecurityAltIDSource(v))
-}
-
-//GetUnderlyingSecurityAltID gets UnderlyingSecurityAltID, Tag 458
-func (m NoUnderlyingSecurityAltID) GetUnderlyingSecurityAltID() (v string, err quickfix.MessageRejectError) {
-	var f field.UnderlyingSecurityAltIDField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetUnderlyingSecurityAltIDSource gets UnderlyingSecurityAltIDSource, Tag 459
-func (m NoUnderlyingSecurityAltID) GetUnderlyingSecurityAltIDSource() (v string, err quickfix.MessageRejectError) {
-	var f field.UnderlyingSecurityAltIDSourceField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
+//GetUnderlyingInstrument gets the UnderlyingInstrument component
+func (m NoUnderlyings) GetUnderlyingInstrument() components.UnderlyingInstrument {
+	return components.UnderlyingInstrument{&m.Group.FieldMap}
 }
 
-//HasUnderlyingSecurityAltID returns true if UnderlyingSecurityAltID is present, Tag 458
-func (m NoUnderlyingSecurityAltID) HasUnderlyingSecurityAltID() bool {
-	return m.Has(tag.UnderlyingSecurityAltID)
+//SetUnderlyingInstrument sets the UnderlyingInstrument component, copying the fields present in c
+func (m NoUnderlyings) SetUnderlyingInstrument(c components.UnderlyingInstrument) quickfix.MessageRejectError {
+	return c.CopyInto(&m.Group.FieldMap)
 }
 
-//HasUnderlyingSecurityAltIDSource returns true if UnderlyingSecurityAltIDSource is present, Tag 459
-func (m NoUnderlyingSecurityAltID) HasUnderlyingSecurityAltIDSource() bool {
-	return m.Has(tag.UnderlyingSecurityAltIDSource)
-}
+//NoUnderlyingSecurityAltID is a repeating group element, Tag 457
+type NoUnderlyingSecurityAltID = components.NoUnderlyingSecurityAltID
 
 //NoUnderlyingSecurityAltIDRepeatingGroup is a repeating group, Tag 457
-type NoUnderlyingSecurityAltIDRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
+type NoUnderlyingSecurityAltIDRepeatingGroup = components.NoUnderlyingSecurityAltIDRepeatingGroup
 
 //NewNoUnderlyingSecurityAltIDRepeatingGroup returns an initialized, NoUnderlyingSecurityAltIDRepeatingGroup
 func NewNoUnderlyingSecurityAltIDRepeatingGroup() NoUnderlyingSecurityAltIDRepeatingGroup {
-	return NoUnderlyingSecurityAltIDRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoUnderlyingSecurityAltID,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.UnderlyingSecurityAltID), quickfix.GroupElement(tag.UnderlyingSecurityAltIDSource)})}
-}
-
-//Add create and append a new NoUnderlyingSecurityAltID to this group
-func (m NoUnderlyingSecurityAltIDRepeatingGroup) Add() NoUnderlyingSecurityAltID {
-	g := m.RepeatingGroup.Add()
-	return NoUnderlyingSecurityAltID{g}
+	return components.NewNoUnderlyingSecurityAltIDRepeatingGroup()
 }
 
-//Get returns the ith NoUnderlyingSecurityAltID in the NoUnderlyingSecurityAltIDRepeatinGroup
-func (m NoUnderlyingSecurityAltIDRepeatingGroup) Get(i int) NoUnderlyingSecurityAltID {
-	return NoUnderlyingSecurityAltID{m.RepeatingGroup.Get(i)}
-}
+//NoUnderlyingSecurityAltIDStruct is a plain Go representation of a NoUnderlyingSecurityAltID group element
+type NoUnderlyingSecurityAltIDStruct = components.NoUnderlyingSecurityAltIDStruct
 
 //NoUnderlyingStips is a repeating group element, Tag 887
-type NoUnderlyingStips struct {
-	*quickfix.Group
-}
-
-//SetUnderlyingStipType sets UnderlyingStipType, Tag 888
-func (m NoUnderlyingStips) SetUnderlyingStipType(v string) {
-	m.Set(field.NewUnderlyingStipType(v))
-}
-
-//SetUnderlyingStipValue sets UnderlyingStipValue, Tag 889
-func (m NoUnderlyingStips) SetUnderlyingStipValue(v string) {
-	m.Set(field.NewUnderlyingStipValue(v))
-}
-
-//GetUnderlyingStipType gets UnderlyingStipType, Tag 888
-func (m NoUnderlyingStips) GetUnderlyingStipType() (v string, err quickfix.MessageRejectError) {
-	var f field.UnderlyingStipTypeField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetUnderlyingStipValue gets UnderlyingStipValue, Tag 889
-func (m NoUnderlyingStips) GetUnderlyingStipValue() (v string, err quickfix.MessageRejectError) {
-	var f field.UnderlyingStipValueField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//HasUnderlyingStipType returns true if UnderlyingStipType is present, Tag 888
-func (m NoUnderlyingStips) HasUnderlyingStipType() bool {
-	return m.Has(tag.UnderlyingStipType)
-}
-
-//HasUnderlyingStipValue returns true if UnderlyingStipValue is present, Tag 889
-func (m NoUnderlyingStips) HasUnderlyingStipValue() bool {
-	return m.Has(tag.UnderlyingStipValue)
-}
+type NoUnderlyingStips = components.NoUnderlyingStips
 
 //NoUnderlyingStipsRepeatingGroup is a repeating group, Tag 887
-type NoUnderlyingStipsRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
+type NoUnderlyingStipsRepeatingGroup = components.NoUnderlyingStipsRepeatingGroup
 
 //NewNoUnderlyingStipsRepeatingGroup returns an initialized, NoUnderlyingStipsRepeatingGroup
 func NewNoUnderlyingStipsRepeatingGroup() NoUnderlyingStipsRepeatingGroup {
-	return NoUnderlyingStipsRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoUnderlyingStips,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.UnderlyingStipType), quickfix.GroupElement(tag.UnderlyingStipValue)})}
+	return components.NewNoUnderlyingStipsRepeatingGroup()
 }
 
-//Add create and append a new NoUnderlyingStips to this group
-func (m NoUnderlyingStipsRepeatingGroup) Add() NoUnderlyingStips {
-	g := m.RepeatingGroup.Add()
-	return NoUnderlyingStips{g}
-}
-
-//Get returns the ith NoUnderlyingStips in the NoUnderlyingStipsRepeatinGroup
-func (m NoUnderlyingStipsRepeatingGroup) Get(i int) NoUnderlyingStips {
-	return NoUnderlyingStips{m.RepeatingGroup.Get(i)}
-}
+//NoUnderlyingStipsStruct is a plain Go representation of a NoUnderlyingStips group element
+type NoUnderlyingStipsStruct = components.NoUnderlyingStipsStruct
 
 //NoUnderlyingsRepeatingGroup is a repeating group, Tag 711
 type NoUnderlyingsRepeatingGroup struct {
@@ -4928,70 +4593,18 @@ func (m NoUnderlyingsRepeatingGroup) Get(i int) NoUnderlyings {
 }
 
 //NoStipulations is a repeating group element, Tag 232
-type NoStipulations struct {
-	*quickfix.Group
-}
-
-//SetStipulationType sets StipulationType, Tag 233
-func (m NoStipulations) SetStipulationType(v enum.StipulationType) {
-	m.Set(field.NewStipulationType(v))
-}
-
-//SetStipulationValue sets StipulationValue, Tag 234
-func (m NoStipulations) SetStipulationValue(v string) {
-	m.Set(field.NewStipulationValue(v))
-}
-
-//GetStipulationType gets StipulationType, Tag 233
-func (m NoStipulations) GetStipulationType() (v enum.StipulationType, err quickfix.MessageRejectError) {
-	var f field.StipulationTypeField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetStipulationValue gets StipulationValue, Tag 234
-func (m NoStipulations) GetStipulationValue() (v string, err quickfix.MessageRejectError) {
-	var f field.StipulationValueField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//HasStipulationType returns true if StipulationType is present, Tag 233
-func (m NoStipulations) HasStipulationType() bool {
-	return m.Has(tag.StipulationType)
-}
-
-//HasStipulationValue returns true if StipulationValue is present, Tag 234
-func (m NoStipulations) HasStipulationValue() bool {
-	return m.Has(tag.StipulationValue)
-}
+type NoStipulations = components.NoStipulations
 
 //NoStipulationsRepeatingGroup is a repeating group, Tag 232
-type NoStipulationsRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
+type NoStipulationsRepeatingGroup = components.NoStipulationsRepeatingGroup
 
 //NewNoStipulationsRepeatingGroup returns an initialized, NoStipulationsRepeatingGroup
 func NewNoStipulationsRepeatingGroup() NoStipulationsRepeatingGroup {
-	return NoStipulationsRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoStipulations,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.StipulationType), quickfix.GroupElement(tag.StipulationValue)})}
-}
-
-//Add create and append a new NoStipulations to this group
-func (m NoStipulationsRepeatingGroup) Add() NoStipulations {
-	g := m.RepeatingGroup.Add()
-	return NoStipulations{g}
+	return components.NewNoStipulationsRepeatingGroup()
 }
 
-//Get returns the ith NoStipulations in the NoStipulationsRepeatinGroup
-func (m NoStipulationsRepeatingGroup) Get(i int) NoStipulations {
-	return NoStipulations{m.RepeatingGroup.Get(i)}
-}
+//NoStipulationsStruct is a plain Go representation of a NoStipulations group element
+type NoStipulationsStruct = components.NoStipulationsStruct
 
 //NoOrdersRepeatingGroup is a repeating group, Tag 73
 type NoOrdersRepeatingGroup struct {
@@ -5015,3 +4628,2529 @@ func (m NoOrdersRepeatingGroup) Add() NoOrders {
 func (m NoOrdersRepeatingGroup) Get(i int) NoOrders {
 	return NoOrders{m.RepeatingGroup.Get(i)}
 }
+
+//Struct is a plain Go representation of the NewOrderList body, optional fields are nil when absent
+type Struct struct {
+	ListID                     string
+	TotNoOrders                int
+	ListExecInst               *string
+	NoOrders                   []NoOrdersStruct
+	EncodedListExecInstLen     *int
+	EncodedListExecInst        *string
+	BidID                      *string
+	ClientBidID                *string
+	BidType                    enum.BidType
+	ProgRptReqs                *enum.ProgRptReqs
+	ProgPeriodInterval         *int
+	ListExecInstType           *enum.ListExecInstType
+	CancellationRights         *enum.CancellationRights
+	MoneyLaunderingStatus      *enum.MoneyLaunderingStatus
+	RegistID                   *string
+	AllowableOneSidednessPct   *decimal.Decimal
+	AllowableOneSidednessValue *decimal.Decimal
+	AllowableOneSidednessCurr  *string
+	LastFragment               *bool
+}
+
+//Marshal copies the fields present in msg into a Struct
+func Marshal(msg NewOrderList) (Struct, quickfix.MessageRejectError) {
+	var s Struct
+	if msg.HasListID() {
+		v, err := msg.GetListID()
+		if err != nil {
+			return s, err
+		}
+		s.ListID = v
+	}
+	if msg.HasTotNoOrders() {
+		v, err := msg.GetTotNoOrders()
+		if err != nil {
+			return s, err
+		}
+		s.TotNoOrders = v
+	}
+	if msg.HasListExecInst() {
+		v, err := msg.GetListExecInst()
+		if err != nil {
+			return s, err
+		}
+		s.ListExecInst = &v
+	}
+	if msg.HasNoOrders() {
+		g, err := msg.GetNoOrders()
+		if err != nil {
+			return s, err
+		}
+		if s.NoOrders, err = MarshalNoOrders(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasEncodedListExecInstLen() {
+		v, err := msg.GetEncodedListExecInstLen()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedListExecInstLen = &v
+	}
+	if msg.HasEncodedListExecInst() {
+		v, err := msg.GetEncodedListExecInst()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedListExecInst = &v
+	}
+	if msg.HasBidID() {
+		v, err := msg.GetBidID()
+		if err != nil {
+			return s, err
+		}
+		s.BidID = &v
+	}
+	if msg.HasClientBidID() {
+		v, err := msg.GetClientBidID()
+		if err != nil {
+			return s, err
+		}
+		s.ClientBidID = &v
+	}
+	if msg.HasBidType() {
+		v, err := msg.GetBidType()
+		if err != nil {
+			return s, err
+		}
+		s.BidType = v
+	}
+	if msg.HasProgRptReqs() {
+		v, err := msg.GetProgRptReqs()
+		if err != nil {
+			return s, err
+		}
+		s.ProgRptReqs = &v
+	}
+	if msg.HasProgPeriodInterval() {
+		v, err := msg.GetProgPeriodInterval()
+		if err != nil {
+			return s, err
+		}
+		s.ProgPeriodInterval = &v
+	}
+	if msg.HasListExecInstType() {
+		v, err := msg.GetListExecInstType()
+		if err != nil {
+			return s, err
+		}
+		s.ListExecInstType = &v
+	}
+	if msg.HasCancellationRights() {
+		v, err := msg.GetCancellationRights()
+		if err != nil {
+			return s, err
+		}
+		s.CancellationRights = &v
+	}
+	if msg.HasMoneyLaunderingStatus() {
+		v, err := msg.GetMoneyLaunderingStatus()
+		if err != nil {
+			return s, err
+		}
+		s.MoneyLaunderingStatus = &v
+	}
+	if msg.HasRegistID() {
+		v, err := msg.GetRegistID()
+		if err != nil {
+			return s, err
+		}
+		s.RegistID = &v
+	}
+	if msg.HasAllowableOneSidednessPct() {
+		v, err := msg.GetAllowableOneSidednessPct()
+		if err != nil {
+			return s, err
+		}
+		s.AllowableOneSidednessPct = &v
+	}
+	if msg.HasAllowableOneSidednessValue() {
+		v, err := msg.GetAllowableOneSidednessValue()
+		if err != nil {
+			return s, err
+		}
+		s.AllowableOneSidednessValue = &v
+	}
+	if msg.HasAllowableOneSidednessCurr() {
+		v, err := msg.GetAllowableOneSidednessCurr()
+		if err != nil {
+			return s, err
+		}
+		s.AllowableOneSidednessCurr = &v
+	}
+	if msg.HasLastFragment() {
+		v, err := msg.GetLastFragment()
+		if err != nil {
+			return s, err
+		}
+		s.LastFragment = &v
+	}
+	return s, nil
+}
+
+//Unmarshal builds a NewOrderList from s
+func Unmarshal(s Struct) NewOrderList {
+	m := New(field.NewListID(s.ListID), field.NewBidType(s.BidType), field.NewTotNoOrders(s.TotNoOrders))
+	if s.ListExecInst != nil {
+		m.SetListExecInst(*s.ListExecInst)
+	}
+	if s.NoOrders != nil {
+		m.SetNoOrders(UnmarshalNoOrders(s.NoOrders))
+	}
+	if s.EncodedListExecInstLen != nil {
+		m.SetEncodedListExecInstLen(*s.EncodedListExecInstLen)
+	}
+	if s.EncodedListExecInst != nil {
+		m.SetEncodedListExecInst(*s.EncodedListExecInst)
+	}
+	if s.BidID != nil {
+		m.SetBidID(*s.BidID)
+	}
+	if s.ClientBidID != nil {
+		m.SetClientBidID(*s.ClientBidID)
+	}
+	if s.ProgRptReqs != nil {
+		m.SetProgRptReqs(*s.ProgRptReqs)
+	}
+	if s.ProgPeriodInterval != nil {
+		m.SetProgPeriodInterval(*s.ProgPeriodInterval)
+	}
+	if s.ListExecInstType != nil {
+		m.SetListExecInstType(*s.ListExecInstType)
+	}
+	if s.CancellationRights != nil {
+		m.SetCancellationRights(*s.CancellationRights)
+	}
+	if s.MoneyLaunderingStatus != nil {
+		m.SetMoneyLaunderingStatus(*s.MoneyLaunderingStatus)
+	}
+	if s.RegistID != nil {
+		m.SetRegistID(*s.RegistID)
+	}
+	if s.AllowableOneSidednessPct != nil {
+		m.SetAllowableOneSidednessPct(*s.AllowableOneSidednessPct, fix44.DecimalScale(*s.AllowableOneSidednessPct))
+	}
+	if s.AllowableOneSidednessValue != nil {
+		m.SetAllowableOneSidednessValue(*s.AllowableOneSidednessValue, fix44.DecimalScale(*s.AllowableOneSidednessValue))
+	}
+	if s.AllowableOneSidednessCurr != nil {
+		m.SetAllowableOneSidednessCurr(*s.AllowableOneSidednessCurr)
+	}
+	if s.LastFragment != nil {
+		m.SetLastFragment(*s.LastFragment)
+	}
+	return m
+}
+
+//NoOrdersStruct is a plain Go representation of a NoOrders group element, optional fields are nil when absent
+type NoOrdersStruct struct {
+	ClOrdID                    *string
+	SecondaryClOrdID           *string
+	ListSeqNo                  *int
+	ClOrdLinkID                *string
+	SettlInstMode              *enum.SettlInstMode
+	NoPartyIDs                 []NoPartyIDsStruct
+	TradeOriginationDate       *string
+	TradeDate                  *string
+	Account                    *string
+	AcctIDSource               *enum.AcctIDSource
+	AccountType                *enum.AccountType
+	DayBookingInst             *enum.DayBookingInst
+	BookingUnit                *enum.BookingUnit
+	AllocID                    *string
+	PreallocMethod             *enum.PreallocMethod
+	NoAllocs                   []NoAllocsStruct
+	SettlType                  *enum.SettlType
+	SettlDate                  *string
+	CashMargin                 *enum.CashMargin
+	ClearingFeeIndicator       *enum.ClearingFeeIndicator
+	HandlInst                  *enum.HandlInst
+	ExecInst                   *enum.ExecInst
+	MinQty                     *decimal.Decimal
+	MaxFloor                   *decimal.Decimal
+	ExDestination              *enum.ExDestination
+	NoTradingSessions          []NoTradingSessionsStruct
+	ProcessCode                *enum.ProcessCode
+	Symbol                     *string
+	SymbolSfx                  *enum.SymbolSfx
+	SecurityID                 *string
+	SecurityIDSource           *enum.SecurityIDSource
+	NoSecurityAltID            []NoSecurityAltIDStruct
+	Product                    *enum.Product
+	CFICode                    *string
+	SecurityType               *enum.SecurityType
+	SecuritySubType            *string
+	MaturityMonthYear          *string
+	MaturityDate               *string
+	CouponPaymentDate          *string
+	IssueDate                  *string
+	RepoCollateralSecurityType *int
+	RepurchaseTerm             *int
+	RepurchaseRate             *decimal.Decimal
+	Factor                     *decimal.Decimal
+	CreditRating               *string
+	InstrRegistry              *enum.InstrRegistry
+	CountryOfIssue             *string
+	StateOrProvinceOfIssue     *string
+	LocaleOfIssue              *string
+	RedemptionDate             *string
+	StrikePrice                *decimal.Decimal
+	StrikeCurrency             *string
+	OptAttribute               *string
+	ContractMultiplier         *decimal.Decimal
+	CouponRate                 *decimal.Decimal
+	SecurityExchange           *string
+	Issuer                     *string
+	EncodedIssuerLen           *int
+	EncodedIssuer              *string
+	SecurityDesc               *string
+	EncodedSecurityDescLen     *int
+	EncodedSecurityDesc        *string
+	Pool                       *string
+	ContractSettlMonth         *string
+	CPProgram                  *enum.CPProgram
+	CPRegType                  *string
+	NoEvents                   []NoEventsStruct
+	DatedDate                  *string
+	InterestAccrualDate        *string
+	NoUnderlyings              []NoUnderlyingsStruct
+	PrevClosePx                *decimal.Decimal
+	Side                       *enum.Side
+	SideValueInd               *enum.SideValueInd
+	LocateReqd                 *bool
+	TransactTime               *time.Time
+	NoStipulations             []NoStipulationsStruct
+	QtyType                    *enum.QtyType
+	OrderQty                   *decimal.Decimal
+	CashOrderQty               *decimal.Decimal
+	OrderPercent               *decimal.Decimal
+	RoundingDirection          *enum.RoundingDirection
+	RoundingModulus            *decimal.Decimal
+	OrdType                    *enum.OrdType
+	PriceType                  *enum.PriceType
+	Price                      *decimal.Decimal
+	StopPx                     *decimal.Decimal
+	Spread                     *decimal.Decimal
+	BenchmarkCurveCurrency     *string
+	BenchmarkCurveName         *enum.BenchmarkCurveName
+	BenchmarkCurvePoint        *string
+	BenchmarkPrice             *decimal.Decimal
+	BenchmarkPriceType         *int
+	BenchmarkSecurityID        *string
+	BenchmarkSecurityIDSource  *string
+	YieldType                  *enum.YieldType
+	Yield                      *decimal.Decimal
+	YieldCalcDate              *string
+	YieldRedemptionDate        *string
+	YieldRedemptionPrice       *decimal.Decimal
+	YieldRedemptionPriceType   *int
+	Currency                   *string
+	ComplianceID               *string
+	SolicitedFlag              *bool
+	IOIID                      *string
+	QuoteID                    *string
+	TimeInForce                *enum.TimeInForce
+	EffectiveTime              *time.Time
+	ExpireDate                 *string
+	ExpireTime                 *time.Time
+	GTBookingInst              *enum.GTBookingInst
+	Commission                 *decimal.Decimal
+	CommType                   *enum.CommType
+	CommCurrency               *string
+	FundRenewWaiv              *enum.FundRenewWaiv
+	OrderCapacity              *enum.OrderCapacity
+	OrderRestrictions          *enum.OrderRestrictions
+	CustOrderCapacity          *enum.CustOrderCapacity
+	ForexReq                   *bool
+	SettlCurrency              *string
+	BookingType                *enum.BookingType
+	Text                       *string
+	EncodedTextLen             *int
+	EncodedText                *string
+	SettlDate2                 *string
+	OrderQty2                  *decimal.Decimal
+	Price2                     *decimal.Decimal
+	PositionEffect             *enum.PositionEffect
+	CoveredOrUncovered         *enum.CoveredOrUncovered
+	MaxShow                    *decimal.Decimal
+	PegOffsetValue             *decimal.Decimal
+	PegMoveType                *enum.PegMoveType
+	PegOffsetType              *enum.PegOffsetType
+	PegLimitType               *enum.PegLimitType
+	PegRoundDirection          *enum.PegRoundDirection
+	PegScope                   *enum.PegScope
+	DiscretionInst             *enum.DiscretionInst
+	DiscretionOffsetValue      *decimal.Decimal
+	DiscretionMoveType         *enum.DiscretionMoveType
+	DiscretionOffsetType       *enum.DiscretionOffsetType
+	DiscretionLimitType        *enum.DiscretionLimitType
+	DiscretionRoundDirection   *enum.DiscretionRoundDirection
+	DiscretionScope            *enum.DiscretionScope
+	TargetStrategy             *enum.TargetStrategy
+	TargetStrategyParameters   *string
+	ParticipationRate          *decimal.Decimal
+	Designation                *string
+}
+
+//MarshalNoOrders copies the elements of g into a slice of NoOrdersStruct
+func MarshalNoOrders(g NoOrdersRepeatingGroup) ([]NoOrdersStruct, quickfix.MessageRejectError) {
+	s := make([]NoOrdersStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasClOrdID() {
+			v, err := m.GetClOrdID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].ClOrdID = &v
+		}
+		if m.HasSecondaryClOrdID() {
+			v, err := m.GetSecondaryClOrdID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SecondaryClOrdID = &v
+		}
+		if m.HasListSeqNo() {
+			v, err := m.GetListSeqNo()
+			if err != nil {
+				return nil, err
+			}
+			s[i].ListSeqNo = &v
+		}
+		if m.HasClOrdLinkID() {
+			v, err := m.GetClOrdLinkID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].ClOrdLinkID = &v
+		}
+		if m.HasSettlInstMode() {
+			v, err := m.GetSettlInstMode()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SettlInstMode = &v
+		}
+		if m.HasNoPartyIDs() {
+			g, err := m.GetNoPartyIDs()
+			if err != nil {
+				return nil, err
+			}
+			if s[i].NoPartyIDs, err = components.MarshalNoPartyIDs(g); err != nil {
+				return nil, err
+			}
+		}
+		if m.HasTradeOriginationDate() {
+			v, err := m.GetTradeOriginationDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].TradeOriginationDate = &v
+		}
+		if m.HasTradeDate() {
+			v, err := m.GetTradeDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].TradeDate = &v
+		}
+		if m.HasAccount() {
+			v, err := m.GetAccount()
+			if err != nil {
+				return nil, err
+			}
+			s[i].Account = &v
+		}
+		if m.HasAcctIDSource() {
+			v, err := m.GetAcctIDSource()
+			if err != nil {
+				return nil, err
+			}
+			s[i].AcctIDSource = &v
+		}
+		if m.HasAccountType() {
+			v, err := m.GetAccountType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].AccountType = &v
+		}
+		if m.HasDayBookingInst() {
+			v, err := m.GetDayBookingInst()
+			if err != nil {
+				return nil, err
+			}
+			s[i].DayBookingInst = &v
+		}
+		if m.HasBookingUnit() {
+			v, err := m.GetBookingUnit()
+			if err != nil {
+				return nil, err
+			}
+			s[i].BookingUnit = &v
+		}
+		if m.HasAllocID() {
+			v, err := m.GetAllocID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].AllocID = &v
+		}
+		if m.HasPreallocMethod() {
+			v, err := m.GetPreallocMethod()
+			if err != nil {
+				return nil, err
+			}
+			s[i].PreallocMethod = &v
+		}
+		if m.HasNoAllocs() {
+			g, err := m.GetNoAllocs()
+			if err != nil {
+				return nil, err
+			}
+			if s[i].NoAllocs, err = MarshalNoAllocs(g); err != nil {
+				return nil, err
+			}
+		}
+		if m.HasSettlType() {
+			v, err := m.GetSettlType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SettlType = &v
+		}
+		if m.HasSettlDate() {
+			v, err := m.GetSettlDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SettlDate = &v
+		}
+		if m.HasCashMargin() {
+			v, err := m.GetCashMargin()
+			if err != nil {
+				return nil, err
+			}
+			s[i].CashMargin = &v
+		}
+		if m.HasClearingFeeIndicator() {
+			v, err := m.GetClearingFeeIndicator()
+			if err != nil {
+				return nil, err
+			}
+			s[i].ClearingFeeIndicator = &v
+		}
+		if m.HasHandlInst() {
+			v, err := m.GetHandlInst()
+			if err != nil {
+				return nil, err
+			}
+			s[i].HandlInst = &v
+		}
+		if m.HasExecInst() {
+			v, err := m.GetExecInst()
+			if err != nil {
+				return nil, err
+			}
+			s[i].ExecInst = &v
+		}
+		if m.HasMinQty() {
+			v, err := m.GetMinQty()
+			if err != nil {
+				return nil, err
+			}
+			s[i].MinQty = &v
+		}
+		if m.HasMaxFloor() {
+			v, err := m.GetMaxFloor()
+			if err != nil {
+				return nil, err
+			}
+			s[i].MaxFloor = &v
+		}
+		if m.HasExDestination() {
+			v, err := m.GetExDestination()
+			if err != nil {
+				return nil, err
+			}
+			s[i].ExDestination = &v
+		}
+		if m.HasNoTradingSessions() {
+			g, err := m.GetNoTradingSessions()
+			if err != nil {
+				return nil, err
+			}
+			if s[i].NoTradingSessions, err = MarshalNoTradingSessions(g); err != nil {
+				return nil, err
+			}
+		}
+		if m.HasProcessCode() {
+			v, err := m.GetProcessCode()
+			if err != nil {
+				return nil, err
+			}
+			s[i].ProcessCode = &v
+		}
+		if m.HasSymbol() {
+			v, err := m.GetSymbol()
+			if err != nil {
+				return nil, err
+			}
+			s[i].Symbol = &v
+		}
+		if m.HasSymbolSfx() {
+			v, err := m.GetSymbolSfx()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SymbolSfx = &v
+		}
+		if m.HasSecurityID() {
+			v, err := m.GetSecurityID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SecurityID = &v
+		}
+		if m.HasSecurityIDSource() {
+			v, err := m.GetSecurityIDSource()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SecurityIDSource = &v
+		}
+		if m.HasNoSecurityAltID() {
+			g, err := m.GetNoSecurityAltID()
+			if err != nil {
+				return nil, err
+			}
+			if s[i].NoSecurityAltID, err = components.MarshalNoSecurityAltID(g); err != nil {
+				return nil, err
+			}
+		}
+		if m.HasProduct() {
+			v, err := m.GetProduct()
+			if err != nil {
+				return nil, err
+			}
+			s[i].Product = &v
+		}
+		if m.HasCFICode() {
+			v, err := m.GetCFICode()
+			if err != nil {
+				return nil, err
+			}
+			s[i].CFICode = &v
+		}
+		if m.HasSecurityType() {
+			v, err := m.GetSecurityType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SecurityType = &v
+		}
+		if m.HasSecuritySubType() {
+			v, err := m.GetSecuritySubType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SecuritySubType = &v
+		}
+		if m.HasMaturityMonthYear() {
+			v, err := m.GetMaturityMonthYear()
+			if err != nil {
+				return nil, err
+			}
+			s[i].MaturityMonthYear = &v
+		}
+		if m.HasMaturityDate() {
+			v, err := m.GetMaturityDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].MaturityDate = &v
+		}
+		if m.HasCouponPaymentDate() {
+			v, err := m.GetCouponPaymentDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].CouponPaymentDate = &v
+		}
+		if m.HasIssueDate() {
+			v, err := m.GetIssueDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].IssueDate = &v
+		}
+		if m.HasRepoCollateralSecurityType() {
+			v, err := m.GetRepoCollateralSecurityType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].RepoCollateralSecurityType = &v
+		}
+		if m.HasRepurchaseTerm() {
+			v, err := m.GetRepurchaseTerm()
+			if err != nil {
+				return nil, err
+			}
+			s[i].RepurchaseTerm = &v
+		}
+		if m.HasRepurchaseRate() {
+			v, err := m.GetRepurchaseRate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].RepurchaseRate = &v
+		}
+		if m.HasFactor() {
+			v, err := m.GetFactor()
+			if err != nil {
+				return nil, err
+			}
+			s[i].Factor = &v
+		}
+		if m.HasCreditRating() {
+			v, err := m.GetCreditRating()
+			if err != nil {
+				return nil, err
+			}
+			s[i].CreditRating = &v
+		}
+		if m.HasInstrRegistry() {
+			v, err := m.GetInstrRegistry()
+			if err != nil {
+				return nil, err
+			}
+			s[i].InstrRegistry = &v
+		}
+		if m.HasCountryOfIssue() {
+			v, err := m.GetCountryOfIssue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].CountryOfIssue = &v
+		}
+		if m.HasStateOrProvinceOfIssue() {
+			v, err := m.GetStateOrProvinceOfIssue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].StateOrProvinceOfIssue = &v
+		}
+		if m.HasLocaleOfIssue() {
+			v, err := m.GetLocaleOfIssue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LocaleOfIssue = &v
+		}
+		if m.HasRedemptionDate() {
+			v, err := m.GetRedemptionDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].RedemptionDate = &v
+		}
+		if m.HasStrikePrice() {
+			v, err := m.GetStrikePrice()
+			if err != nil {
+				return nil, err
+			}
+			s[i].StrikePrice = &v
+		}
+		if m.HasStrikeCurrency() {
+			v, err := m.GetStrikeCurrency()
+			if err != nil {
+				return nil, err
+			}
+			s[i].StrikeCurrency = &v
+		}
+		if m.HasOptAttribute() {
+			v, err := m.GetOptAttribute()
+			if err != nil {
+				return nil, err
+			}
+			s[i].OptAttribute = &v
+		}
+		if m.HasContractMultiplier() {
+			v, err := m.GetContractMultiplier()
+			if err != nil {
+				return nil, err
+			}
+			s[i].ContractMultiplier = &v
+		}
+		if m.HasCouponRate() {
+			v, err := m.GetCouponRate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].CouponRate = &v
+		}
+		if m.HasSecurityExchange() {
+			v, err := m.GetSecurityExchange()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SecurityExchange = &v
+		}
+		if m.HasIssuer() {
+			v, err := m.GetIssuer()
+			if err != nil {
+				return nil, err
+			}
+			s[i].Issuer = &v
+		}
+		if m.HasEncodedIssuerLen() {
+			v, err := m.GetEncodedIssuerLen()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedIssuerLen = &v
+		}
+		if m.HasEncodedIssuer() {
+			v, err := m.GetEncodedIssuer()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedIssuer = &v
+		}
+		if m.HasSecurityDesc() {
+			v, err := m.GetSecurityDesc()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SecurityDesc = &v
+		}
+		if m.HasEncodedSecurityDescLen() {
+			v, err := m.GetEncodedSecurityDescLen()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedSecurityDescLen = &v
+		}
+		if m.HasEncodedSecurityDesc() {
+			v, err := m.GetEncodedSecurityDesc()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedSecurityDesc = &v
+		}
+		if m.HasPool() {
+			v, err := m.GetPool()
+			if err != nil {
+				return nil, err
+			}
+			s[i].Pool = &v
+		}
+		if m.HasContractSettlMonth() {
+			v, err := m.GetContractSettlMonth()
+			if err != nil {
+				return nil, err
+			}
+			s[i].ContractSettlMonth = &v
+		}
+		if m.HasCPProgram() {
+			v, err := m.GetCPProgram()
+			if err != nil {
+				return nil, err
+			}
+			s[i].CPProgram = &v
+		}
+		if m.HasCPRegType() {
+			v, err := m.GetCPRegType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].CPRegType = &v
+		}
+		if m.HasNoEvents() {
+			g, err := m.GetNoEvents()
+			if err != nil {
+				return nil, err
+			}
+			if s[i].NoEvents, err = components.MarshalNoEvents(g); err != nil {
+				return nil, err
+			}
+		}
+		if m.HasDatedDate() {
+			v, err := m.GetDatedDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].DatedDate = &v
+		}
+		if m.HasInterestAccrualDate() {
+			v, err := m.GetInterestAccrualDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].InterestAccrualDate = &v
+		}
+		if m.HasNoUnderlyings() {
+			g, err := m.GetNoUnderlyings()
+			if err != nil {
+				return nil, err
+			}
+			if s[i].NoUnderlyings, err = MarshalNoUnderlyings(g); err != nil {
+				return nil, err
+			}
+		}
+		if m.HasPrevClosePx() {
+			v, err := m.GetPrevClosePx()
+			if err != nil {
+				return nil, err
+			}
+			s[i].PrevClosePx = &v
+		}
+		if m.HasSide() {
+			v, err := m.GetSide()
+			if err != nil {
+				return nil, err
+			}
+			s[i].Side = &v
+		}
+		if m.HasSideValueInd() {
+			v, err := m.GetSideValueInd()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SideValueInd = &v
+		}
+		if m.HasLocateReqd() {
+			v, err := m.GetLocateReqd()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LocateReqd = &v
+		}
+		if m.HasTransactTime() {
+			v, err := m.GetTransactTime()
+			if err != nil {
+				return nil, err
+			}
+			s[i].TransactTime = &v
+		}
+		if m.HasNoStipulations() {
+			g, err := m.GetNoStipulations()
+			if err != nil {
+				return nil, err
+			}
+			if s[i].NoStipulations, err = components.MarshalNoStipulations(g); err != nil {
+				return nil, err
+			}
+		}
+		if m.HasQtyType() {
+			v, err := m.GetQtyType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].QtyType = &v
+		}
+		if m.HasOrderQty() {
+			v, err := m.GetOrderQty()
+			if err != nil {
+				return nil, err
+			}
+			s[i].OrderQty = &v
+		}
+		if m.HasCashOrderQty() {
+			v, err := m.GetCashOrderQty()
+			if err != nil {
+				return nil, err
+			}
+			s[i].CashOrderQty = &v
+		}
+		if m.HasOrderPercent() {
+			v, err := m.GetOrderPercent()
+			if err != nil {
+				return nil, err
+			}
+			s[i].OrderPercent = &v
+		}
+		if m.HasRoundingDirection() {
+			v, err := m.GetRoundingDirection()
+			if err != nil {
+				return nil, err
+			}
+			s[i].RoundingDirection = &v
+		}
+		if m.HasRoundingModulus() {
+			v, err := m.GetRoundingModulus()
+			if err != nil {
+				return nil, err
+			}
+			s[i].RoundingModulus = &v
+		}
+		if m.HasOrdType() {
+			v, err := m.GetOrdType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].OrdType = &v
+		}
+		if m.HasPriceType() {
+			v, err := m.GetPriceType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].PriceType = &v
+		}
+		if m.HasPrice() {
+			v, err := m.GetPrice()
+			if err != nil {
+				return nil, err
+			}
+			s[i].Price = &v
+		}
+		if m.HasStopPx() {
+			v, err := m.GetStopPx()
+			if err != nil {
+				return nil, err
+			}
+			s[i].StopPx = &v
+		}
+		if m.HasSpread() {
+			v, err := m.GetSpread()
+			if err != nil {
+				return nil, err
+			}
+			s[i].Spread = &v
+		}
+		if m.HasBenchmarkCurveCurrency() {
+			v, err := m.GetBenchmarkCurveCurrency()
+			if err != nil {
+				return nil, err
+			}
+			s[i].BenchmarkCurveCurrency = &v
+		}
+		if m.HasBenchmarkCurveName() {
+			v, err := m.GetBenchmarkCurveName()
+			if err != nil {
+				return nil, err
+			}
+			s[i].BenchmarkCurveName = &v
+		}
+		if m.HasBenchmarkCurvePoint() {
+			v, err := m.GetBenchmarkCurvePoint()
+			if err != nil {
+				return nil, err
+			}
+			s[i].BenchmarkCurvePoint = &v
+		}
+		if m.HasBenchmarkPrice() {
+			v, err := m.GetBenchmarkPrice()
+			if err != nil {
+				return nil, err
+			}
+			s[i].BenchmarkPrice = &v
+		}
+		if m.HasBenchmarkPriceType() {
+			v, err := m.GetBenchmarkPriceType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].BenchmarkPriceType = &v
+		}
+		if m.HasBenchmarkSecurityID() {
+			v, err := m.GetBenchmarkSecurityID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].BenchmarkSecurityID = &v
+		}
+		if m.HasBenchmarkSecurityIDSource() {
+			v, err := m.GetBenchmarkSecurityIDSource()
+			if err != nil {
+				return nil, err
+			}
+			s[i].BenchmarkSecurityIDSource = &v
+		}
+		if m.HasYieldType() {
+			v, err := m.GetYieldType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].YieldType = &v
+		}
+		if m.HasYield() {
+			v, err := m.GetYield()
+			if err != nil {
+				return nil, err
+			}
+			s[i].Yield = &v
+		}
+		if m.HasYieldCalcDate() {
+			v, err := m.GetYieldCalcDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].YieldCalcDate = &v
+		}
+		if m.HasYieldRedemptionDate() {
+			v, err := m.GetYieldRedemptionDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].YieldRedemptionDate = &v
+		}
+		if m.HasYieldRedemptionPrice() {
+			v, err := m.GetYieldRedemptionPrice()
+			if err != nil {
+				return nil, err
+			}
+			s[i].YieldRedemptionPrice = &v
+		}
+		if m.HasYieldRedemptionPriceType() {
+			v, err := m.GetYieldRedemptionPriceType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].YieldRedemptionPriceType = &v
+		}
+		if m.HasCurrency() {
+			v, err := m.GetCurrency()
+			if err != nil {
+				return nil, err
+			}
+			s[i].Currency = &v
+		}
+		if m.HasComplianceID() {
+			v, err := m.GetComplianceID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].ComplianceID = &v
+		}
+		if m.HasSolicitedFlag() {
+			v, err := m.GetSolicitedFlag()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SolicitedFlag = &v
+		}
+		if m.HasIOIID() {
+			v, err := m.GetIOIID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].IOIID = &v
+		}
+		if m.HasQuoteID() {
+			v, err := m.GetQuoteID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].QuoteID = &v
+		}
+		if m.HasTimeInForce() {
+			v, err := m.GetTimeInForce()
+			if err != nil {
+				return nil, err
+			}
+			s[i].TimeInForce = &v
+		}
+		if m.HasEffectiveTime() {
+			v, err := m.GetEffectiveTime()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EffectiveTime = &v
+		}
+		if m.HasExpireDate() {
+			v, err := m.GetExpireDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].ExpireDate = &v
+		}
+		if m.HasExpireTime() {
+			v, err := m.GetExpireTime()
+			if err != nil {
+				return nil, err
+			}
+			s[i].ExpireTime = &v
+		}
+		if m.HasGTBookingInst() {
+			v, err := m.GetGTBookingInst()
+			if err != nil {
+				return nil, err
+			}
+			s[i].GTBookingInst = &v
+		}
+		if m.HasCommission() {
+			v, err := m.GetCommission()
+			if err != nil {
+				return nil, err
+			}
+			s[i].Commission = &v
+		}
+		if m.HasCommType() {
+			v, err := m.GetCommType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].CommType = &v
+		}
+		if m.HasCommCurrency() {
+			v, err := m.GetCommCurrency()
+			if err != nil {
+				return nil, err
+			}
+			s[i].CommCurrency = &v
+		}
+		if m.HasFundRenewWaiv() {
+			v, err := m.GetFundRenewWaiv()
+			if err != nil {
+				return nil, err
+			}
+			s[i].FundRenewWaiv = &v
+		}
+		if m.HasOrderCapacity() {
+			v, err := m.GetOrderCapacity()
+			if err != nil {
+				return nil, err
+			}
+			s[i].OrderCapacity = &v
+		}
+		if m.HasOrderRestrictions() {
+			v, err := m.GetOrderRestrictions()
+			if err != nil {
+				return nil, err
+			}
+			s[i].OrderRestrictions = &v
+		}
+		if m.HasCustOrderCapacity() {
+			v, err := m.GetCustOrderCapacity()
+			if err != nil {
+				return nil, err
+			}
+			s[i].CustOrderCapacity = &v
+		}
+		if m.HasForexReq() {
+			v, err := m.GetForexReq()
+			if err != nil {
+				return nil, err
+			}
+			s[i].ForexReq = &v
+		}
+		if m.HasSettlCurrency() {
+			v, err := m.GetSettlCurrency()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SettlCurrency = &v
+		}
+		if m.HasBookingType() {
+			v, err := m.GetBookingType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].BookingType = &v
+		}
+		if m.HasText() {
+			v, err := m.GetText()
+			if err != nil {
+				return nil, err
+			}
+			s[i].Text = &v
+		}
+		if m.HasEncodedTextLen() {
+			v, err := m.GetEncodedTextLen()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedTextLen = &v
+		}
+		if m.HasEncodedText() {
+			v, err := m.GetEncodedText()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedText = &v
+		}
+		if m.HasSettlDate2() {
+			v, err := m.GetSettlDate2()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SettlDate2 = &v
+		}
+		if m.HasOrderQty2() {
+			v, err := m.GetOrderQty2()
+			if err != nil {
+				return nil, err
+			}
+			s[i].OrderQty2 = &v
+		}
+		if m.HasPrice2() {
+			v, err := m.GetPrice2()
+			if err != nil {
+				return nil, err
+			}
+			s[i].Price2 = &v
+		}
+		if m.HasPositionEffect() {
+			v, err := m.GetPositionEffect()
+			if err != nil {
+				return nil, err
+			}
+			s[i].PositionEffect = &v
+		}
+		if m.HasCoveredOrUncovered() {
+			v, err := m.GetCoveredOrUncovered()
+			if err != nil {
+				return nil, err
+			}
+			s[i].CoveredOrUncovered = &v
+		}
+		if m.HasMaxShow() {
+			v, err := m.GetMaxShow()
+			if err != nil {
+				return nil, err
+			}
+			s[i].MaxShow = &v
+		}
+		if m.HasPegOffsetValue() {
+			v, err := m.GetPegOffsetValue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].PegOffsetValue = &v
+		}
+		if m.HasPegMoveType() {
+			v, err := m.GetPegMoveType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].PegMoveType = &v
+		}
+		if m.HasPegOffsetType() {
+			v, err := m.GetPegOffsetType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].PegOffsetType = &v
+		}
+		if m.HasPegLimitType() {
+			v, err := m.GetPegLimitType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].PegLimitType = &v
+		}
+		if m.HasPegRoundDirection() {
+			v, err := m.GetPegRoundDirection()
+			if err != nil {
+				return nil, err
+			}
+			s[i].PegRoundDirection = &v
+		}
+		if m.HasPegScope() {
+			v, err := m.GetPegScope()
+			if err != nil {
+				return nil, err
+			}
+			s[i].PegScope = &v
+		}
+		if m.HasDiscretionInst() {
+			v, err := m.GetDiscretionInst()
+			if err != nil {
+				return nil, err
+			}
+			s[i].DiscretionInst = &v
+		}
+		if m.HasDiscretionOffsetValue() {
+			v, err := m.GetDiscretionOffsetValue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].DiscretionOffsetValue = &v
+		}
+		if m.HasDiscretionMoveType() {
+			v, err := m.GetDiscretionMoveType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].DiscretionMoveType = &v
+		}
+		if m.HasDiscretionOffsetType() {
+			v, err := m.GetDiscretionOffsetType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].DiscretionOffsetType = &v
+		}
+		if m.HasDiscretionLimitType() {
+			v, err := m.GetDiscretionLimitType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].DiscretionLimitType = &v
+		}
+		if m.HasDiscretionRoundDirection() {
+			v, err := m.GetDiscretionRoundDirection()
+			if err != nil {
+				return nil, err
+			}
+			s[i].DiscretionRoundDirection = &v
+		}
+		if m.HasDiscretionScope() {
+			v, err := m.GetDiscretionScope()
+			if err != nil {
+				return nil, err
+			}
+			s[i].DiscretionScope = &v
+		}
+		if m.HasTargetStrategy() {
+			v, err := m.GetTargetStrategy()
+			if err != nil {
+				return nil, err
+			}
+			s[i].TargetStrategy = &v
+		}
+		if m.HasTargetStrategyParameters() {
+			v, err := m.GetTargetStrategyParameters()
+			if err != nil {
+				return nil, err
+			}
+			s[i].TargetStrategyParameters = &v
+		}
+		if m.HasParticipationRate() {
+			v, err := m.GetParticipationRate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].ParticipationRate = &v
+		}
+		if m.HasDesignation() {
+			v, err := m.GetDesignation()
+			if err != nil {
+				return nil, err
+			}
+			s[i].Designation = &v
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoOrders builds a NoOrdersRepeatingGroup from s
+func UnmarshalNoOrders(s []NoOrdersStruct) NoOrdersRepeatingGroup {
+	g := NewNoOrdersRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.ClOrdID != nil {
+			m.SetClOrdID(*e.ClOrdID)
+		}
+		if e.SecondaryClOrdID != nil {
+			m.SetSecondaryClOrdID(*e.SecondaryClOrdID)
+		}
+		if e.ListSeqNo != nil {
+			m.SetListSeqNo(*e.ListSeqNo)
+		}
+		if e.ClOrdLinkID != nil {
+			m.SetClOrdLinkID(*e.ClOrdLinkID)
+		}
+		if e.SettlInstMode != nil {
+			m.SetSettlInstMode(*e.SettlInstMode)
+		}
+		if e.NoPartyIDs != nil {
+			m.SetNoPartyIDs(components.UnmarshalNoPartyIDs(e.NoPartyIDs))
+		}
+		if e.TradeOriginationDate != nil {
+			m.SetTradeOriginationDate(*e.TradeOriginationDate)
+		}
+		if e.TradeDate != nil {
+			m.SetTradeDate(*e.TradeDate)
+		}
+		if e.Account != nil {
+			m.SetAccount(*e.Account)
+		}
+		if e.AcctIDSource != nil {
+			m.SetAcctIDSource(*e.AcctIDSource)
+		}
+		if e.AccountType != nil {
+			m.SetAccountType(*e.AccountType)
+		}
+		if e.DayBookingInst != nil {
+			m.SetDayBookingInst(*e.DayBookingInst)
+		}
+		if e.BookingUnit != nil {
+			m.SetBookingUnit(*e.BookingUnit)
+		}
+		if e.AllocID != nil {
+			m.SetAllocID(*e.AllocID)
+		}
+		if e.PreallocMethod != nil {
+			m.SetPreallocMethod(*e.PreallocMethod)
+		}
+		if e.NoAllocs != nil {
+			m.SetNoAllocs(UnmarshalNoAllocs(e.NoAllocs))
+		}
+		if e.SettlType != nil {
+			m.SetSettlType(*e.SettlType)
+		}
+		if e.SettlDate != nil {
+			m.SetSettlDate(*e.SettlDate)
+		}
+		if e.CashMargin != nil {
+			m.SetCashMargin(*e.CashMargin)
+		}
+		if e.ClearingFeeIndicator != nil {
+			m.SetClearingFeeIndicator(*e.ClearingFeeIndicator)
+		}
+		if e.HandlInst != nil {
+			m.SetHandlInst(*e.HandlInst)
+		}
+		if e.ExecInst != nil {
+			m.SetExecInst(*e.ExecInst)
+		}
+		if e.MinQty != nil {
+			m.SetMinQty(*e.MinQty, fix44.DecimalScale(*e.MinQty))
+		}
+		if e.MaxFloor != nil {
+			m.SetMaxFloor(*e.MaxFloor, fix44.DecimalScale(*e.MaxFloor))
+		}
+		if e.ExDestination != nil {
+			m.SetExDestination(*e.ExDestination)
+		}
+		if e.NoTradingSessions != nil {
+			m.SetNoTradingSessions(UnmarshalNoTradingSessions(e.NoTradingSessions))
+		}
+		if e.ProcessCode != nil {
+			m.SetProcessCode(*e.ProcessCode)
+		}
+		if e.Symbol != nil {
+			m.SetSymbol(*e.Symbol)
+		}
+		if e.SymbolSfx != nil {
+			m.SetSymbolSfx(*e.SymbolSfx)
+		}
+		if e.SecurityID != nil {
+			m.SetSecurityID(*e.SecurityID)
+		}
+		if e.SecurityIDSource != nil {
+			m.SetSecurityIDSource(*e.SecurityIDSource)
+		}
+		if e.NoSecurityAltID != nil {
+			m.SetNoSecurityAltID(components.UnmarshalNoSecurityAltID(e.NoSecurityAltID))
+		}
+		if e.Product != nil {
+			m.SetProduct(*e.Product)
+		}
+		if e.CFICode != nil {
+			m.SetCFICode(*e.CFICode)
+		}
+		if e.SecurityType != nil {
+			m.SetSecurityType(*e.SecurityType)
+		}
+		if e.SecuritySubType != nil {
+			m.SetSecuritySubType(*e.SecuritySubType)
+		}
+		if e.MaturityMonthYear != nil {
+			m.SetMaturityMonthYear(*e.MaturityMonthYear)
+		}
+		if e.MaturityDate != nil {
+			m.SetMaturityDate(*e.MaturityDate)
+		}
+		if e.CouponPaymentDate != nil {
+			m.SetCouponPaymentDate(*e.CouponPaymentDate)
+		}
+		if e.IssueDate != nil {
+			m.SetIssueDate(*e.IssueDate)
+		}
+		if e.RepoCollateralSecurityType != nil {
+			m.SetRepoCollateralSecurityType(*e.RepoCollateralSecurityType)
+		}
+		if e.RepurchaseTerm != nil {
+			m.SetRepurchaseTerm(*e.RepurchaseTerm)
+		}
+		if e.RepurchaseRate != nil {
+			m.SetRepurchaseRate(*e.RepurchaseRate, fix44.DecimalScale(*e.RepurchaseRate))
+		}
+		if e.Factor != nil {
+			m.SetFactor(*e.Factor, fix44.DecimalScale(*e.Factor))
+		}
+		if e.CreditRating != nil {
+			m.SetCreditRating(*e.CreditRating)
+		}
+		if e.InstrRegistry != nil {
+			m.SetInstrRegistry(*e.InstrRegistry)
+		}
+		if e.CountryOfIssue != nil {
+			m.SetCountryOfIssue(*e.CountryOfIssue)
+		}
+		if e.StateOrProvinceOfIssue != nil {
+			m.SetStateOrProvinceOfIssue(*e.StateOrProvinceOfIssue)
+		}
+		if e.LocaleOfIssue != nil {
+			m.SetLocaleOfIssue(*e.LocaleOfIssue)
+		}
+		if e.RedemptionDate != nil {
+			m.SetRedemptionDate(*e.RedemptionDate)
+		}
+		if e.StrikePrice != nil {
+			m.SetStrikePrice(*e.StrikePrice, fix44.DecimalScale(*e.StrikePrice))
+		}
+		if e.StrikeCurrency != nil {
+			m.SetStrikeCurrency(*e.StrikeCurrency)
+		}
+		if e.OptAttribute != nil {
+			m.SetOptAttribute(*e.OptAttribute)
+		}
+		if e.ContractMultiplier != nil {
+			m.SetContractMultiplier(*e.ContractMultiplier, fix44.DecimalScale(*e.ContractMultiplier))
+		}
+		if e.CouponRate != nil {
+			m.SetCouponRate(*e.CouponRate, fix44.DecimalScale(*e.CouponRate))
+		}
+		if e.SecurityExchange != nil {
+			m.SetSecurityExchange(*e.SecurityExchange)
+		}
+		if e.Issuer != nil {
+			m.SetIssuer(*e.Issuer)
+		}
+		if e.EncodedIssuerLen != nil {
+			m.SetEncodedIssuerLen(*e.EncodedIssuerLen)
+		}
+		if e.EncodedIssuer != nil {
+			m.SetEncodedIssuer(*e.EncodedIssuer)
+		}
+		if e.SecurityDesc != nil {
+			m.SetSecurityDesc(*e.SecurityDesc)
+		}
+		if e.EncodedSecurityDescLen != nil {
+			m.SetEncodedSecurityDescLen(*e.EncodedSecurityDescLen)
+		}
+		if e.EncodedSecurityDesc != nil {
+			m.SetEncodedSecurityDesc(*e.EncodedSecurityDesc)
+		}
+		if e.Pool != nil {
+			m.SetPool(*e.Pool)
+		}
+		if e.ContractSettlMonth != nil {
+			m.SetContractSettlMonth(*e.ContractSettlMonth)
+		}
+		if e.CPProgram != nil {
+			m.SetCPProgram(*e.CPProgram)
+		}
+		if e.CPRegType != nil {
+			m.SetCPRegType(*e.CPRegType)
+		}
+		if e.NoEvents != nil {
+			m.SetNoEvents(components.UnmarshalNoEvents(e.NoEvents))
+		}
+		if e.DatedDate != nil {
+			m.SetDatedDate(*e.DatedDate)
+		}
+		if e.InterestAccrualDate != nil {
+			m.SetInterestAccrualDate(*e.InterestAccrualDate)
+		}
+		if e.NoUnderlyings != nil {
+			m.SetNoUnderlyings(UnmarshalNoUnderlyings(e.NoUnderlyings))
+		}
+		if e.PrevClosePx != nil {
+			m.SetPrevClosePx(*e.PrevClosePx, fix44.DecimalScale(*e.PrevClosePx))
+		}
+		if e.Side != nil {
+			m.SetSide(*e.Side)
+		}
+		if e.SideValueInd != nil {
+			m.SetSideValueInd(*e.SideValueInd)
+		}
+		if e.LocateReqd != nil {
+			m.SetLocateReqd(*e.LocateReqd)
+		}
+		if e.TransactTime != nil {
+			m.SetTransactTime(*e.TransactTime)
+		}
+		if e.NoStipulations != nil {
+			m.SetNoStipulations(components.UnmarshalNoStipulations(e.NoStipulations))
+		}
+		if e.QtyType != nil {
+			m.SetQtyType(*e.QtyType)
+		}
+		if e.OrderQty != nil {
+			m.SetOrderQty(*e.OrderQty, fix44.DecimalScale(*e.OrderQty))
+		}
+		if e.CashOrderQty != nil {
+			m.SetCashOrderQty(*e.CashOrderQty, fix44.DecimalScale(*e.CashOrderQty))
+		}
+		if e.OrderPercent != nil {
+			m.SetOrderPercent(*e.OrderPercent, fix44.DecimalScale(*e.OrderPercent))
+		}
+		if e.RoundingDirection != nil {
+			m.SetRoundingDirection(*e.RoundingDirection)
+		}
+		if e.RoundingModulus != nil {
+			m.SetRoundingModulus(*e.RoundingModulus, fix44.DecimalScale(*e.RoundingModulus))
+		}
+		if e.OrdType != nil {
+			m.SetOrdType(*e.OrdType)
+		}
+		if e.PriceType != nil {
+			m.SetPriceType(*e.PriceType)
+		}
+		if e.Price != nil {
+			m.SetPrice(*e.Price, fix44.DecimalScale(*e.Price))
+		}
+		if e.StopPx != nil {
+			m.SetStopPx(*e.StopPx, fix44.DecimalScale(*e.StopPx))
+		}
+		if e.Spread != nil {
+			m.SetSpread(*e.Spread, fix44.DecimalScale(*e.Spread))
+		}
+		if e.BenchmarkCurveCurrency != nil {
+			m.SetBenchmarkCurveCurrency(*e.BenchmarkCurveCurrency)
+		}
+		if e.BenchmarkCurveName != nil {
+			m.SetBenchmarkCurveName(*e.BenchmarkCurveName)
+		}
+		if e.BenchmarkCurvePoint != nil {
+			m.SetBenchmarkCurvePoint(*e.BenchmarkCurvePoint)
+		}
+		if e.BenchmarkPrice != nil {
+			m.SetBenchmarkPrice(*e.BenchmarkPrice, fix44.DecimalScale(*e.BenchmarkPrice))
+		}
+		if e.BenchmarkPriceType != nil {
+			m.SetBenchmarkPriceType(*e.BenchmarkPriceType)
+		}
+		if e.BenchmarkSecurityID != nil {
+			m.SetBenchmarkSecurityID(*e.BenchmarkSecurityID)
+		}
+		if e.BenchmarkSecurityIDSource != nil {
+			m.SetBenchmarkSecurityIDSource(*e.BenchmarkSecurityIDSource)
+		}
+		if e.YieldType != nil {
+			m.SetYieldType(*e.YieldType)
+		}
+		if e.Yield != nil {
+			m.SetYield(*e.Yield, fix44.DecimalScale(*e.Yield))
+		}
+		if e.YieldCalcDate != nil {
+			m.SetYieldCalcDate(*e.YieldCalcDate)
+		}
+		if e.YieldRedemptionDate != nil {
+			m.SetYieldRedemptionDate(*e.YieldRedemptionDate)
+		}
+		if e.YieldRedemptionPrice != nil {
+			m.SetYieldRedemptionPrice(*e.YieldRedemptionPrice, fix44.DecimalScale(*e.YieldRedemptionPrice))
+		}
+		if e.YieldRedemptionPriceType != nil {
+			m.SetYieldRedemptionPriceType(*e.YieldRedemptionPriceType)
+		}
+		if e.Currency != nil {
+			m.SetCurrency(*e.Currency)
+		}
+		if e.ComplianceID != nil {
+			m.SetComplianceID(*e.ComplianceID)
+		}
+		if e.SolicitedFlag != nil {
+			m.SetSolicitedFlag(*e.SolicitedFlag)
+		}
+		if e.IOIID != nil {
+			m.SetIOIID(*e.IOIID)
+		}
+		if e.QuoteID != nil {
+			m.SetQuoteID(*e.QuoteID)
+		}
+		if e.TimeInForce != nil {
+			m.SetTimeInForce(*e.TimeInForce)
+		}
+		if e.EffectiveTime != nil {
+			m.SetEffectiveTime(*e.EffectiveTime)
+		}
+		if e.ExpireDate != nil {
+			m.SetExpireDate(*e.ExpireDate)
+		}
+		if e.ExpireTime != nil {
+			m.SetExpireTime(*e.ExpireTime)
+		}
+		if e.GTBookingInst != nil {
+			m.SetGTBookingInst(*e.GTBookingInst)
+		}
+		if e.Commission != nil {
+			m.SetCommission(*e.Commission, fix44.DecimalScale(*e.Commission))
+		}
+		if e.CommType != nil {
+			m.SetCommType(*e.CommType)
+		}
+		if e.CommCurrency != nil {
+			m.SetCommCurrency(*e.CommCurrency)
+		}
+		if e.FundRenewWaiv != nil {
+			m.SetFundRenewWaiv(*e.FundRenewWaiv)
+		}
+		if e.OrderCapacity != nil {
+			m.SetOrderCapacity(*e.OrderCapacity)
+		}
+		if e.OrderRestrictions != nil {
+			m.SetOrderRestrictions(*e.OrderRestrictions)
+		}
+		if e.CustOrderCapacity != nil {
+			m.SetCustOrderCapacity(*e.CustOrderCapacity)
+		}
+		if e.ForexReq != nil {
+			m.SetForexReq(*e.ForexReq)
+		}
+		if e.SettlCurrency != nil {
+			m.SetSettlCurrency(*e.SettlCurrency)
+		}
+		if e.BookingType != nil {
+			m.SetBookingType(*e.BookingType)
+		}
+		if e.Text != nil {
+			m.SetText(*e.Text)
+		}
+		if e.EncodedTextLen != nil {
+			m.SetEncodedTextLen(*e.EncodedTextLen)
+		}
+		if e.EncodedText != nil {
+			m.SetEncodedText(*e.EncodedText)
+		}
+		if e.SettlDate2 != nil {
+			m.SetSettlDate2(*e.SettlDate2)
+		}
+		if e.OrderQty2 != nil {
+			m.SetOrderQty2(*e.OrderQty2, fix44.DecimalScale(*e.OrderQty2))
+		}
+		if e.Price2 != nil {
+			m.SetPrice2(*e.Price2, fix44.DecimalScale(*e.Price2))
+		}
+		if e.PositionEffect != nil {
+			m.SetPositionEffect(*e.PositionEffect)
+		}
+		if e.CoveredOrUncovered != nil {
+			m.SetCoveredOrUncovered(*e.CoveredOrUncovered)
+		}
+		if e.MaxShow != nil {
+			m.SetMaxShow(*e.MaxShow, fix44.DecimalScale(*e.MaxShow))
+		}
+		if e.PegOffsetValue != nil {
+			m.SetPegOffsetValue(*e.PegOffsetValue, fix44.DecimalScale(*e.PegOffsetValue))
+		}
+		if e.PegMoveType != nil {
+			m.SetPegMoveType(*e.PegMoveType)
+		}
+		if e.PegOffsetType != nil {
+			m.SetPegOffsetType(*e.PegOffsetType)
+		}
+		if e.PegLimitType != nil {
+			m.SetPegLimitType(*e.PegLimitType)
+		}
+		if e.PegRoundDirection != nil {
+			m.SetPegRoundDirection(*e.PegRoundDirection)
+		}
+		if e.PegScope != nil {
+			m.SetPegScope(*e.PegScope)
+		}
+		if e.DiscretionInst != nil {
+			m.SetDiscretionInst(*e.DiscretionInst)
+		}
+		if e.DiscretionOffsetValue != nil {
+			m.SetDiscretionOffsetValue(*e.DiscretionOffsetValue, fix44.DecimalScale(*e.DiscretionOffsetValue))
+		}
+		if e.DiscretionMoveType != nil {
+			m.SetDiscretionMoveType(*e.DiscretionMoveType)
+		}
+		if e.DiscretionOffsetType != nil {
+			m.SetDiscretionOffsetType(*e.DiscretionOffsetType)
+		}
+		if e.DiscretionLimitType != nil {
+			m.SetDiscretionLimitType(*e.DiscretionLimitType)
+		}
+		if e.DiscretionRoundDirection != nil {
+			m.SetDiscretionRoundDirection(*e.DiscretionRoundDirection)
+		}
+		if e.DiscretionScope != nil {
+			m.SetDiscretionScope(*e.DiscretionScope)
+		}
+		if e.TargetStrategy != nil {
+			m.SetTargetStrategy(*e.TargetStrategy)
+		}
+		if e.TargetStrategyParameters != nil {
+			m.SetTargetStrategyParameters(*e.TargetStrategyParameters)
+		}
+		if e.ParticipationRate != nil {
+			m.SetParticipationRate(*e.ParticipationRate, fix44.DecimalScale(*e.ParticipationRate))
+		}
+		if e.Designation != nil {
+			m.SetDesignation(*e.Designation)
+		}
+	}
+	return g
+}
+
+//NoAllocsStruct is a plain Go representation of a NoAllocs group element, optional fields are nil when absent
+type NoAllocsStruct struct {
+	AllocAccount       *string
+	AllocAcctIDSource  *int
+	AllocSettlCurrency *string
+	IndividualAllocID  *string
+	NoNestedPartyIDs   []NoNestedPartyIDsStruct
+	AllocQty           *decimal.Decimal
+}
+
+//MarshalNoAllocs copies the elements of g into a slice of NoAllocsStruct
+func MarshalNoAllocs(g NoAllocsRepeatingGroup) ([]NoAllocsStruct, quickfix.MessageRejectError) {
+	s := make([]NoAllocsStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasAllocAccount() {
+			v, err := m.GetAllocAccount()
+			if err != nil {
+				return nil, err
+			}
+			s[i].AllocAccount = &v
+		}
+		if m.HasAllocAcctIDSource() {
+			v, err := m.GetAllocAcctIDSource()
+			if err != nil {
+				return nil, err
+			}
+			s[i].AllocAcctIDSource = &v
+		}
+		if m.HasAllocSettlCurrency() {
+			v, err := m.GetAllocSettlCurrency()
+			if err != nil {
+				return nil, err
+			}
+			s[i].AllocSettlCurrency = &v
+		}
+		if m.HasIndividualAllocID() {
+			v, err := m.GetIndividualAllocID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].IndividualAllocID = &v
+		}
+		if m.HasNoNestedPartyIDs() {
+			g, err := m.GetNoNestedPartyIDs()
+			if err != nil {
+				return nil, err
+			}
+			if s[i].NoNestedPartyIDs, err = components.MarshalNoNestedPartyIDs(g); err != nil {
+				return nil, err
+			}
+		}
+		if m.HasAllocQty() {
+			v, err := m.GetAllocQty()
+			if err != nil {
+				return nil, err
+			}
+			s[i].AllocQty = &v
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoAllocs builds a NoAllocsRepeatingGroup from s
+func UnmarshalNoAllocs(s []NoAllocsStruct) NoAllocsRepeatingGroup {
+	g := NewNoAllocsRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.AllocAccount != nil {
+			m.SetAllocAccount(*e.AllocAccount)
+		}
+		if e.AllocAcctIDSource != nil {
+			m.SetAllocAcctIDSource(*e.AllocAcctIDSource)
+		}
+		if e.AllocSettlCurrency != nil {
+			m.SetAllocSettlCurrency(*e.AllocSettlCurrency)
+		}
+		if e.IndividualAllocID != nil {
+			m.SetIndividualAllocID(*e.IndividualAllocID)
+		}
+		if e.NoNestedPartyIDs != nil {
+			m.SetNoNestedPartyIDs(components.UnmarshalNoNestedPartyIDs(e.NoNestedPartyIDs))
+		}
+		if e.AllocQty != nil {
+			m.SetAllocQty(*e.AllocQty, fix44.DecimalScale(*e.AllocQty))
+		}
+	}
+	return g
+}
+
+//NoTradingSessionsStruct is a plain Go representation of a NoTradingSessions group element, optional fields are nil when absent
+type NoTradingSessionsStruct struct {
+	TradingSessionID    *enum.TradingSessionID
+	TradingSessionSubID *enum.TradingSessionSubID
+}
+
+//MarshalNoTradingSessions copies the elements of g into a slice of NoTradingSessionsStruct
+func MarshalNoTradingSessions(g NoTradingSessionsRepeatingGroup) ([]NoTradingSessionsStruct, quickfix.MessageRejectError) {
+	s := make([]NoTradingSessionsStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasTradingSessionID() {
+			v, err := m.GetTradingSessionID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].TradingSessionID = &v
+		}
+		if m.HasTradingSessionSubID() {
+			v, err := m.GetTradingSessionSubID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].TradingSessionSubID = &v
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoTradingSessions builds a NoTradingSessionsRepeatingGroup from s
+func UnmarshalNoTradingSessions(s []NoTradingSessionsStruct) NoTradingSessionsRepeatingGroup {
+	g := NewNoTradingSessionsRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.TradingSessionID != nil {
+			m.SetTradingSessionID(*e.TradingSessionID)
+		}
+		if e.TradingSessionSubID != nil {
+			m.SetTradingSessionSubID(*e.TradingSessionSubID)
+		}
+	}
+	return g
+}
+
+//NoUnderlyingsStruct is a plain Go representation of a NoUnderlyings group element, optional fields are nil when absent
+type NoUnderlyingsStruct struct {
+	UnderlyingSymbol                     *string
+	UnderlyingSymbolSfx                  *string
+	UnderlyingSecurityID                 *string
+	UnderlyingSecurityIDSource           *string
+	NoUnderlyingSecurityAltID            []NoUnderlyingSecurityAltIDStruct
+	UnderlyingProduct                    *int
+	UnderlyingCFICode                    *string
+	UnderlyingSecurityType               *string
+	UnderlyingSecuritySubType            *string
+	UnderlyingMaturityMonthYear          *string
+	UnderlyingMaturityDate               *string
+	UnderlyingCouponPaymentDate          *string
+	UnderlyingIssueDate                  *string
+	UnderlyingRepoCollateralSecurityType *int
+	UnderlyingRepurchaseTerm             *int
+	UnderlyingRepurchaseRate             *decimal.Decimal
+	UnderlyingFactor                     *decimal.Decimal
+	UnderlyingCreditRating               *string
+	UnderlyingInstrRegistry              *string
+	UnderlyingCountryOfIssue             *string
+	UnderlyingStateOrProvinceOfIssue     *string
+	UnderlyingLocaleOfIssue              *string
+	UnderlyingRedemptionDate             *string
+	UnderlyingStrikePrice                *decimal.Decimal
+	UnderlyingStrikeCurrency             *string
+	UnderlyingOptAttribute               *string
+	UnderlyingContractMultiplier         *decimal.Decimal
+	UnderlyingCouponRate                 *decimal.Decimal
+	UnderlyingSecurityExchange           *string
+	UnderlyingIssuer                     *string
+	EncodedUnderlyingIssuerLen           *int
+	EncodedUnderlyingIssuer              *string
+	UnderlyingSecurityDesc               *string
+	EncodedUnderlyingSecurityDescLen     *int
+	EncodedUnderlyingSecurityDesc        *string
+	UnderlyingCPProgram                  *string
+	UnderlyingCPRegType                  *string
+	UnderlyingCurrency                   *string
+	UnderlyingQty                        *decimal.Decimal
+	UnderlyingPx                         *decimal.Decimal
+	UnderlyingDirtyPrice                 *decimal.Decimal
+	UnderlyingEndPrice                   *decimal.Decimal
+	UnderlyingStartValue                 *decimal.Decimal
+	UnderlyingCurrentValue               *decimal.Decimal
+	UnderlyingEndValue                   *decimal.Decimal
+	NoUnderlyingStips                    []NoUnderlyingStipsStruct
+}
+
+//MarshalNoUnderlyings copies the elements of g into a slice of NoUnderlyingsStruct
+func MarshalNoUnderlyings(g NoUnderlyingsRepeatingGroup) ([]NoUnderlyingsStruct, quickfix.MessageRejectError) {
+	s := make([]NoUnderlyingsStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasUnderlyingSymbol() {
+			v, err := m.GetUnderlyingSymbol()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingSymbol = &v
+		}
+		if m.HasUnderlyingSymbolSfx() {
+			v, err := m.GetUnderlyingSymbolSfx()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingSymbolSfx = &v
+		}
+		if m.HasUnderlyingSecurityID() {
+			v, err := m.GetUnderlyingSecurityID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingSecurityID = &v
+		}
+		if m.HasUnderlyingSecurityIDSource() {
+			v, err := m.GetUnderlyingSecurityIDSource()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingSecurityIDSource = &v
+		}
+		if m.HasNoUnderlyingSecurityAltID() {
+			g, err := m.GetNoUnderlyingSecurityAltID()
+			if err != nil {
+				return nil, err
+			}
+			if s[i].NoUnderlyingSecurityAltID, err = components.MarshalNoUnderlyingSecurityAltID(g); err != nil {
+				return nil, err
+			}
+		}
+		if m.HasUnderlyingProduct() {
+			v, err := m.GetUnderlyingProduct()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingProduct = &v
+		}
+		if m.HasUnderlyingCFICode() {
+			v, err := m.GetUnderlyingCFICode()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCFICode = &v
+		}
+		if m.HasUnderlyingSecurityType() {
+			v, err := m.GetUnderlyingSecurityType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingSecurityType = &v
+		}
+		if m.HasUnderlyingSecuritySubType() {
+			v, err := m.GetUnderlyingSecuritySubType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingSecuritySubType = &v
+		}
+		if m.HasUnderlyingMaturityMonthYear() {
+			v, err := m.GetUnderlyingMaturityMonthYear()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingMaturityMonthYear = &v
+		}
+		if m.HasUnderlyingMaturityDate() {
+			v, err := m.GetUnderlyingMaturityDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingMaturityDate = &v
+		}
+		if m.HasUnderlyingCouponPaymentDate() {
+			v, err := m.GetUnderlyingCouponPaymentDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCouponPaymentDate = &v
+		}
+		if m.HasUnderlyingIssueDate() {
+			v, err := m.GetUnderlyingIssueDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingIssueDate = &v
+		}
+		if m.HasUnderlyingRepoCollateralSecurityType() {
+			v, err := m.GetUnderlyingRepoCollateralSecurityType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingRepoCollateralSecurityType = &v
+		}
+		if m.HasUnderlyingRepurchaseTerm() {
+			v, err := m.GetUnderlyingRepurchaseTerm()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingRepurchaseTerm = &v
+		}
+		if m.HasUnderlyingRepurchaseRate() {
+			v, err := m.GetUnderlyingRepurchaseRate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingRepurchaseRate = &v
+		}
+		if m.HasUnderlyingFactor() {
+			v, err := m.GetUnderlyingFactor()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingFactor = &v
+		}
+		if m.HasUnderlyingCreditRating() {
+			v, err := m.GetUnderlyingCreditRating()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCreditRating = &v
+		}
+		if m.HasUnderlyingInstrRegistry() {
+			v, err := m.GetUnderlyingInstrRegistry()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingInstrRegistry = &v
+		}
+		if m.HasUnderlyingCountryOfIssue() {
+			v, err := m.GetUnderlyingCountryOfIssue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCountryOfIssue = &v
+		}
+		if m.HasUnderlyingStateOrProvinceOfIssue() {
+			v, err := m.GetUnderlyingStateOrProvinceOfIssue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingStateOrProvinceOfIssue = &v
+		}
+		if m.HasUnderlyingLocaleOfIssue() {
+			v, err := m.GetUnderlyingLocaleOfIssue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingLocaleOfIssue = &v
+		}
+		if m.HasUnderlyingRedemptionDate() {
+			v, err := m.GetUnderlyingRedemptionDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingRedemptionDate = &v
+		}
+		if m.HasUnderlyingStrikePrice() {
+			v, err := m.GetUnderlyingStrikePrice()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingStrikePrice = &v
+		}
+		if m.HasUnderlyingStrikeCurrency() {
+			v, err := m.GetUnderlyingStrikeCurrency()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingStrikeCurrency = &v
+		}
+		if m.HasUnderlyingOptAttribute() {
+			v, err := m.GetUnderlyingOptAttribute()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingOptAttribute = &v
+		}
+		if m.HasUnderlyingContractMultiplier() {
+			v, err := m.GetUnderlyingContractMultiplier()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingContractMultiplier = &v
+		}
+		if m.HasUnderlyingCouponRate() {
+			v, err := m.GetUnderlyingCouponRate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCouponRate = &v
+		}
+		if m.HasUnderlyingSecurityExchange() {
+			v, err := m.GetUnderlyingSecurityExchange()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingSecurityExchange = &v
+		}
+		if m.HasUnderlyingIssuer() {
+			v, err := m.GetUnderlyingIssuer()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingIssuer = &v
+		}
+		if m.HasEncodedUnderlyingIssuerLen() {
+			v, err := m.GetEncodedUnderlyingIssuerLen()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedUnderlyingIssuerLen = &v
+		}
+		if m.HasEncodedUnderlyingIssuer() {
+			v, err := m.GetEncodedUnderlyingIssuer()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedUnderlyingIssuer = &v
+		}
+		if m.HasUnderlyingSecurityDesc() {
+			v, err := m.GetUnderlyingSecurityDesc()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingSecurityDesc = &v
+		}
+		if m.HasEncodedUnderlyingSecurityDescLen() {
+			v, err := m.GetEncodedUnderlyingSecurityDescLen()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedUnderlyingSecurityDescLen = &v
+		}
+		if m.HasEncodedUnderlyingSecurityDesc() {
+			v, err := m.GetEncodedUnderlyingSecurityDesc()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EncodedUnderlyingSecurityDesc = &v
+		}
+		if m.HasUnderlyingCPProgram() {
+			v, err := m.GetUnderlyingCPProgram()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCPProgram = &v
+		}
+		if m.HasUnderlyingCPRegType() {
+			v, err := m.GetUnderlyingCPRegType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCPRegType = &v
+		}
+		if m.HasUnderlyingCurrency() {
+			v, err := m.GetUnderlyingCurrency()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCurrency = &v
+		}
+		if m.HasUnderlyingQty() {
+			v, err := m.GetUnderlyingQty()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingQty = &v
+		}
+		if m.HasUnderlyingPx() {
+			v, err := m.GetUnderlyingPx()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingPx = &v
+		}
+		if m.HasUnderlyingDirtyPrice() {
+			v, err := m.GetUnderlyingDirtyPrice()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingDirtyPrice = &v
+		}
+		if m.HasUnderlyingEndPrice() {
+			v, err := m.GetUnderlyingEndPrice()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingEndPrice = &v
+		}
+		if m.HasUnderlyingStartValue() {
+			v, err := m.GetUnderlyingStartValue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingStartValue = &v
+		}
+		if m.HasUnderlyingCurrentValue() {
+			v, err := m.GetUnderlyingCurrentValue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingCurrentValue = &v
+		}
+		if m.HasUnderlyingEndValue() {
+			v, err := m.GetUnderlyingEndValue()
+			if err != nil {
+				return nil, err
+			}
+			s[i].UnderlyingEndValue = &v
+		}
+		if m.HasNoUnderlyingStips() {
+			g, err := m.GetNoUnderlyingStips()
+			if err != nil {
+				return nil, err
+			}
+			if s[i].NoUnderlyingStips, err = components.MarshalNoUnderlyingStips(g); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoUnderlyings builds a NoUnderlyingsRepeatingGroup from s
+func UnmarshalNoUnderlyings(s []NoUnderlyingsStruct) NoUnderlyingsRepeatingGroup {
+	g := NewNoUnderlyingsRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.UnderlyingSymbol != nil {
+			m.SetUnderlyingSymbol(*e.UnderlyingSymbol)
+		}
+		if e.UnderlyingSymbolSfx != nil {
+			m.SetUnderlyingSymbolSfx(*e.UnderlyingSymbolSfx)
+		}
+		if e.UnderlyingSecurityID != nil {
+			m.SetUnderlyingSecurityID(*e.UnderlyingSecurityID)
+		}
+		if e.UnderlyingSecurityIDSource != nil {
+			m.SetUnderlyingSecurityIDSource(*e.UnderlyingSecurityIDSource)
+		}
+		if e.NoUnderlyingSecurityAltID != nil {
+			m.SetNoUnderlyingSecurityAltID(components.UnmarshalNoUnderlyingSecurityAltID(e.NoUnderlyingSecurityAltID))
+		}
+		if e.UnderlyingProduct != nil {
+			m.SetUnderlyingProduct(*e.UnderlyingProduct)
+		}
+		if e.UnderlyingCFICode != nil {
+			m.SetUnderlyingCFICode(*e.UnderlyingCFICode)
+		}
+		if e.UnderlyingSecurityType != nil {
+			m.SetUnderlyingSecurityType(*e.UnderlyingSecurityType)
+		}
+		if e.UnderlyingSecuritySubType != nil {
+			m.SetUnderlyingSecuritySubType(*e.UnderlyingSecuritySubType)
+		}
+		if e.UnderlyingMaturityMonthYear != nil {
+			m.SetUnderlyingMaturityMonthYear(*e.UnderlyingMaturityMonthYear)
+		}
+		if e.UnderlyingMaturityDate != nil {
+			m.SetUnderlyingMaturityDate(*e.UnderlyingMaturityDate)
+		}
+		if e.UnderlyingCouponPaymentDate != nil {
+			m.SetUnderlyingCouponPaymentDate(*e.UnderlyingCouponPaymentDate)
+		}
+		if e.UnderlyingIssueDate != nil {
+			m.SetUnderlyingIssueDate(*e.UnderlyingIssueDate)
+		}
+		if e.UnderlyingRepoCollateralSecurityType != nil {
+			m.SetUnderlyingRepoCollateralSecurityType(*e.UnderlyingRepoCollateralSecurityType)
+		}
+		if e.UnderlyingRepurchaseTerm != nil {
+			m.SetUnderlyingRepurchaseTerm(*e.UnderlyingRepurchaseTerm)
+		}
+		if e.UnderlyingRepurchaseRate != nil {
+			m.SetUnderlyingRepurchaseRate(*e.UnderlyingRepurchaseRate, fix44.DecimalScale(*e.UnderlyingRepurchaseRate))
+		}
+		if e.UnderlyingFactor != nil {
+			m.SetUnderlyingFactor(*e.UnderlyingFactor, fix44.DecimalScale(*e.UnderlyingFactor))
+		}
+		if e.UnderlyingCreditRating != nil {
+			m.SetUnderlyingCreditRating(*e.UnderlyingCreditRating)
+		}
+		if e.UnderlyingInstrRegistry != nil {
+			m.SetUnderlyingInstrRegistry(*e.UnderlyingInstrRegistry)
+		}
+		if e.UnderlyingCountryOfIssue != nil {
+			m.SetUnderlyingCountryOfIssue(*e.UnderlyingCountryOfIssue)
+		}
+		if e.UnderlyingStateOrProvinceOfIssue != nil {
+			m.SetUnderlyingStateOrProvinceOfIssue(*e.UnderlyingStateOrProvinceOfIssue)
+		}
+		if e.UnderlyingLocaleOfIssue != nil {
+			m.SetUnderlyingLocaleOfIssue(*e.UnderlyingLocaleOfIssue)
+		}
+		if e.UnderlyingRedemptionDate != nil {
+			m.SetUnderlyingRedemptionDate(*e.UnderlyingRedemptionDate)
+		}
+		if e.UnderlyingStrikePrice != nil {
+			m.SetUnderlyingStrikePrice(*e.UnderlyingStrikePrice, fix44.DecimalScale(*e.UnderlyingStrikePrice))
+		}
+		if e.UnderlyingStrikeCurrency != nil {
+			m.SetUnderlyingStrikeCurrency(*e.UnderlyingStrikeCurrency)
+		}
+		if e.UnderlyingOptAttribute != nil {
+			m.SetUnderlyingOptAttribute(*e.UnderlyingOptAttribute)
+		}
+		if e.UnderlyingContractMultiplier != nil {
+			m.SetUnderlyingContractMultiplier(*e.UnderlyingContractMultiplier, fix44.DecimalScale(*e.UnderlyingContractMultiplier))
+		}
+		if e.UnderlyingCouponRate != nil {
+			m.SetUnderlyingCouponRate(*e.UnderlyingCouponRate, fix44.DecimalScale(*e.UnderlyingCouponRate))
+		}
+		if e.UnderlyingSecurityExchange != nil {
+			m.SetUnderlyingSecurityExchange(*e.UnderlyingSecurityExchange)
+		}
+		if e.UnderlyingIssuer != nil {
+			m.SetUnderlyingIssuer(*e.UnderlyingIssuer)
+		}
+		if e.EncodedUnderlyingIssuerLen != nil {
+			m.SetEncodedUnderlyingIssuerLen(*e.EncodedUnderlyingIssuerLen)
+		}
+		if e.EncodedUnderlyingIssuer != nil {
+			m.SetEncodedUnderlyingIssuer(*e.EncodedUnderlyingIssuer)
+		}
+		if e.UnderlyingSecurityDesc != nil {
+			m.SetUnderlyingSecurityDesc(*e.UnderlyingSecurityDesc)
+		}
+		if e.EncodedUnderlyingSecurityDescLen != nil {
+			m.SetEncodedUnderlyingSecurityDescLen(*e.EncodedUnderlyingSecurityDescLen)
+		}
+		if e.EncodedUnderlyingSecurityDesc != nil {
+			m.SetEncodedUnderlyingSecurityDesc(*e.EncodedUnderlyingSecurityDesc)
+		}
+		if e.UnderlyingCPProgram != nil {
+			m.SetUnderlyingCPProgram(*e.UnderlyingCPProgram)
+		}
+		if e.UnderlyingCPRegType != nil {
+			m.SetUnderlyingCPRegType(*e.UnderlyingCPRegType)
+		}
+		if e.UnderlyingCurrency != nil {
+			m.SetUnderlyingCurrency(*e.UnderlyingCurrency)
+		}
+		if e.UnderlyingQty != nil {
+			m.SetUnderlyingQty(*e.UnderlyingQty, fix44.DecimalScale(*e.UnderlyingQty))
+		}
+		if e.UnderlyingPx != nil {
+			m.SetUnderlyingPx(*e.UnderlyingPx, fix44.DecimalScale(*e.UnderlyingPx))
+		}
+		if e.UnderlyingDirtyPrice != nil {
+			m.SetUnderlyingDirtyPrice(*e.UnderlyingDirtyPrice, fix44.DecimalScale(*e.UnderlyingDirtyPrice))
+		}
+		if e.UnderlyingEndPrice != nil {
+			m.SetUnderlyingEndPrice(*e.UnderlyingEndPrice, fix44.DecimalScale(*e.UnderlyingEndPrice))
+		}
+		if e.UnderlyingStartValue != nil {
+			m.SetUnderlyingStartValue(*e.UnderlyingStartValue, fix44.DecimalScale(*e.UnderlyingStartValue))
+		}
+		if e.UnderlyingCurrentValue != nil {
+			m.SetUnderlyingCurrentValue(*e.UnderlyingCurrentValue, fix44.DecimalScale(*e.UnderlyingCurrentValue))
+		}
+		if e.UnderlyingEndValue != nil {
+			m.SetUnderlyingEndValue(*e.UnderlyingEndValue, fix44.DecimalScale(*e.UnderlyingEndValue))
+		}
+		if e.NoUnderlyingStips != nil {
+			m.SetNoUnderlyingStips(components.UnmarshalNoUnderlyingStips(e.NoUnderlyingStips))
+		}
+	}
+	return g
+}
@@ -0,0 +1,97 @@
+package newordersingle
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/terracefi/enum"
+	"github.com/terracefi/fix44/internal/fixutil"
+)
+
+func order() Struct {
+	return Struct{
+		ClOrdID:      "A",
+		Side:         "1",
+		OrdType:      "2",
+		TransactTime: time.Date(2024, 3, 1, 9, 30, 0, 0, time.UTC),
+		OrderQty:     fixutil.Ptr(decimal.RequireFromString("100")),
+		Price:        fixutil.Ptr(decimal.RequireFromString("10.25")),
+	}
+}
+
+func TestStructRoundTrip(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(s *Struct)
+	}{
+		{"required fields", func(s *Struct) { s.OrderQty, s.Price = nil, nil }},
+		{"optional fields", func(s *Struct) {
+			s.Account = fixutil.Ptr("ACC")
+			s.TimeInForce = fixutil.Ptr(enum.TimeInForce("6"))
+			s.ExpireTime = fixutil.Ptr(time.Date(2024, 3, 1, 16, 0, 0, 0, time.UTC))
+			s.LocateReqd = fixutil.Ptr(false)
+		}},
+		{"component fields", func(s *Struct) {
+			s.Symbol = fixutil.Ptr("ABC")
+			s.SecurityID = fixutil.Ptr("GB0000000001")
+			s.SecurityIDSource = fixutil.Ptr(enum.SecurityIDSource("4"))
+		}},
+		{"repeating group", func(s *Struct) {
+			s.NoTradingSessions = []NoTradingSessionsStruct{{TradingSessionID: fixutil.Ptr(enum.TradingSessionID("1"))},
+				{TradingSessionID: fixutil.Ptr(enum.TradingSessionID("2"))}}
+		}},
+		{"component repeating group", func(s *Struct) {
+			s.NoPartyIDs = []NoPartyIDsStruct{{PartyID: fixutil.Ptr("BRKR"),
+				PartyRole: fixutil.Ptr(enum.PartyRole("1"))}}
+			s.NoSecurityAltID = []NoSecurityAltIDStruct{{SecurityAltID: fixutil.Ptr("ABC.L"),
+				SecurityAltIDSource: fixutil.Ptr("5")}}
+		}},
+		{"nested repeating group", func(s *Struct) {
+			s.NoAllocs = []NoAllocsStruct{
+				{AllocAccount: fixutil.Ptr("A1"), AllocQty: fixutil.Ptr(decimal.RequireFromString("60")),
+					NoNestedPartyIDs: []NoNestedPartyIDsStruct{{NestedPartyID: fixutil.Ptr("C1"),
+						NestedPartyRole: fixutil.Ptr(3)}}},
+				{AllocAccount: fixutil.Ptr("A2"), AllocQty: fixutil.Ptr(decimal.RequireFromString("40"))},
+			}
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := order()
+			tt.modify(&want)
+			got, err := Marshal(Unmarshal(want))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("Marshal(Unmarshal(s)) = %+v, want %+v", got, want)
+			}
+		})
+	}
+}
+
+func TestUnmarshalAccessors(t *testing.T) {
+	s := order()
+	s.Symbol = fixutil.Ptr("ABC")
+	s.NoPartyIDs = []NoPartyIDsStruct{{PartyID: fixutil.Ptr("BRKR")}, {PartyID: fixutil.Ptr("ACC")}}
+	msg := Unmarshal(s)
+
+	if v, err := msg.GetInstrument().GetSymbol(); err != nil || v != "ABC" {
+		t.Errorf("GetInstrument().GetSymbol() = %q, %v, want ABC", v, err)
+	}
+	g, err := msg.GetParties().GetNoPartyIDs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g.Len() != 2 {
+		t.Fatalf("NoPartyIDs has %v elements, want 2", g.Len())
+	}
+	if v, err := g.Get(1).GetPartyID(); err != nil || v != "ACC" {
+		t.Errorf("NoPartyIDs[1].GetPartyID() = %q, %v, want ACC", v, err)
+	}
+	if v, err := msg.GetPrice(); err != nil || !v.Equal(decimal.RequireFromString("10.25")) {
+		t.Errorf("GetPrice() = %v, %v, want 10.25", v, err)
+	}
+}
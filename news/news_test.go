@@ -0,0 +1,36 @@
+package news
+
+import (
+	"testing"
+
+	"github.com/terracefi/field"
+	"github.com/terracefi/fix44"
+)
+
+//shiftJIS is 日本 in Shift_JIS, its second byte is 0x01, the SOH delimiter of FIX
+var shiftJIS = []byte{0x93, 0xfa, 0x96, 0x7b}
+
+func TestDecoded(t *testing.T) {
+	m := New(field.NewHeadline("headline"))
+	m.Header.SetMessageEncoding(fix44.EncodingShiftJIS)
+	m.SetEncodedHeadlineBytes(shiftJIS)
+	lines := NewNoLinesOfTextRepeatingGroup()
+	lines.Add().SetText("text")
+	lines.Get(0).SetEncodedTextBytes(shiftJIS)
+	m.SetNoLinesOfText(lines)
+
+	if got, err := m.GetEncodedHeadlineDecoded(); err != nil || got != "日本" {
+		t.Errorf("GetEncodedHeadlineDecoded() = %q, %v, want 日本", got, err)
+	}
+	g, err := m.GetNoLinesOfText()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, err := g.Get(0).GetEncodedTextDecoded(m.Header); err != nil || got != "日本" {
+		t.Errorf("NoLinesOfText GetEncodedTextDecoded() = %q, %v, want 日本", got, err)
+	}
+	noEncoding := New(field.NewHeadline("headline")).Header
+	if got, err := g.Get(0).GetEncodedTextDecoded(noEncoding); err == nil {
+		t.Errorf("GetEncodedTextDecoded() without a MessageEncoding = %q, want an error for invalid UTF-8", got)
+	}
+}
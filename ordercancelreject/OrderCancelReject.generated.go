@@ -61,6 +61,26 @@ func Route(router RouteOut) (string, string, quickfix.MessageRoute) {
 	return "FIX.4.4", "9", r
 }
 
+//Validate checks m against the FIX 4.4 definition of OrderCancelReject, see fix44.MessageDef.Validate
+func (m OrderCancelReject) Validate() fix44.ValidationErrors {
+	return fix44.MessageDefs["9"].Validate(&m.Body.FieldMap)
+}
+
+//MarshalJSON encodes the OrderCancelReject as JSON with fields keyed by name, see fix44.MarshalMessageJSON
+func (m OrderCancelReject) MarshalJSON() ([]byte, error) {
+	return fix44.MarshalMessageJSON(m.Message)
+}
+
+//UnmarshalJSON builds the OrderCancelReject from JSON produced by MarshalJSON
+func (m *OrderCancelReject) UnmarshalJSON(b []byte) error {
+	msg, err := fix44.UnmarshalMessageJSON(b, "9")
+	if err != nil {
+		return err
+	}
+	*m = FromMessage(msg)
+	return nil
+}
+
 //SetAccount sets Account, Tag 1
 func (m OrderCancelReject) SetAccount(v string) {
 	m.Set(field.NewAccount(v))
@@ -425,6 +445,21 @@ func (m OrderCancelReject) HasEncodedText() bool {
 	return m.Has(tag.EncodedText)
 }
 
+//SetEncodedTextBytes sets EncodedText, Tag 355, and EncodedTextLen, Tag 354, from v, which may hold any bytes including SOH
+func (m OrderCancelReject) SetEncodedTextBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedTextLen, tag.EncodedText, v)
+}
+
+//GetEncodedTextBytes gets EncodedText, Tag 355, checking its length against EncodedTextLen, Tag 354
+func (m OrderCancelReject) GetEncodedTextBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedTextLen, tag.EncodedText)
+}
+
+//GetEncodedTextDecoded gets EncodedText, Tag 355, decoded to UTF-8 using the MessageEncoding of the Header
+func (m OrderCancelReject) GetEncodedTextDecoded() (string, error) {
+	return fix44.DecodeRawData(m.Header, m, tag.EncodedTextLen, tag.EncodedText)
+}
+
 //HasCxlRejResponseTo returns true if CxlRejResponseTo is present, Tag 434
 func (m OrderCancelReject) HasCxlRejResponseTo() bool {
 	return m.Has(tag.CxlRejResponseTo)
@@ -459,3 +494,235 @@ func (m OrderCancelReject) HasWorkingIndicator() bool {
 func (m OrderCancelReject) HasAcctIDSource() bool {
 	return m.Has(tag.AcctIDSource)
 }
+
+//Struct is a plain Go representation of the OrderCancelReject body, optional fields are nil when absent
+type Struct struct {
+	Account              *string
+	ClOrdID              string
+	OrderID              string
+	OrdStatus            enum.OrdStatus
+	OrigClOrdID          string
+	Text                 *string
+	TransactTime         *time.Time
+	ListID               *string
+	TradeDate            *string
+	CxlRejReason         *enum.CxlRejReason
+	SecondaryOrderID     *string
+	TradeOriginationDate *string
+	EncodedTextLen       *int
+	EncodedText          *string
+	CxlRejResponseTo     enum.CxlRejResponseTo
+	SecondaryClOrdID     *string
+	AccountType          *enum.AccountType
+	ClOrdLinkID          *string
+	OrigOrdModTime       *time.Time
+	WorkingIndicator     *bool
+	AcctIDSource         *enum.AcctIDSource
+}
+
+//Marshal copies the fields present in msg into a Struct
+func Marshal(msg OrderCancelReject) (Struct, quickfix.MessageRejectError) {
+	var s Struct
+	if msg.HasAccount() {
+		v, err := msg.GetAccount()
+		if err != nil {
+			return s, err
+		}
+		s.Account = &v
+	}
+	if msg.HasClOrdID() {
+		v, err := msg.GetClOrdID()
+		if err != nil {
+			return s, err
+		}
+		s.ClOrdID = v
+	}
+	if msg.HasOrderID() {
+		v, err := msg.GetOrderID()
+		if err != nil {
+			return s, err
+		}
+		s.OrderID = v
+	}
+	if msg.HasOrdStatus() {
+		v, err := msg.GetOrdStatus()
+		if err != nil {
+			return s, err
+		}
+		s.OrdStatus = v
+	}
+	if msg.HasOrigClOrdID() {
+		v, err := msg.GetOrigClOrdID()
+		if err != nil {
+			return s, err
+		}
+		s.OrigClOrdID = v
+	}
+	if msg.HasText() {
+		v, err := msg.GetText()
+		if err != nil {
+			return s, err
+		}
+		s.Text = &v
+	}
+	if msg.HasTransactTime() {
+		v, err := msg.GetTransactTime()
+		if err != nil {
+			return s, err
+		}
+		s.TransactTime = &v
+	}
+	if msg.HasListID() {
+		v, err := msg.GetListID()
+		if err != nil {
+			return s, err
+		}
+		s.ListID = &v
+	}
+	if msg.HasTradeDate() {
+		v, err := msg.GetTradeDate()
+		if err != nil {
+			return s, err
+		}
+		s.TradeDate = &v
+	}
+	if msg.HasCxlRejReason() {
+		v, err := msg.GetCxlRejReason()
+		if err != nil {
+			return s, err
+		}
+		s.CxlRejReason = &v
+	}
+	if msg.HasSecondaryOrderID() {
+		v, err := msg.GetSecondaryOrderID()
+		if err != nil {
+			return s, err
+		}
+		s.SecondaryOrderID = &v
+	}
+	if msg.HasTradeOriginationDate() {
+		v, err := msg.GetTradeOriginationDate()
+		if err != nil {
+			return s, err
+		}
+		s.TradeOriginationDate = &v
+	}
+	if msg.HasEncodedTextLen() {
+		v, err := msg.GetEncodedTextLen()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedTextLen = &v
+	}
+	if msg.HasEncodedText() {
+		v, err := msg.GetEncodedText()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedText = &v
+	}
+	if msg.HasCxlRejResponseTo() {
+		v, err := msg.GetCxlRejResponseTo()
+		if err != nil {
+			return s, err
+		}
+		s.CxlRejResponseTo = v
+	}
+	if msg.HasSecondaryClOrdID() {
+		v, err := msg.GetSecondaryClOrdID()
+		if err != nil {
+			return s, err
+		}
+		s.SecondaryClOrdID = &v
+	}
+	if msg.HasAccountType() {
+		v, err := msg.GetAccountType()
+		if err != nil {
+			return s, err
+		}
+		s.AccountType = &v
+	}
+	if msg.HasClOrdLinkID() {
+		v, err := msg.GetClOrdLinkID()
+		if err != nil {
+			return s, err
+		}
+		s.ClOrdLinkID = &v
+	}
+	if msg.HasOrigOrdModTime() {
+		v, err := msg.GetOrigOrdModTime()
+		if err != nil {
+			return s, err
+		}
+		s.OrigOrdModTime = &v
+	}
+	if msg.HasWorkingIndicator() {
+		v, err := msg.GetWorkingIndicator()
+		if err != nil {
+			return s, err
+		}
+		s.WorkingIndicator = &v
+	}
+	if msg.HasAcctIDSource() {
+		v, err := msg.GetAcctIDSource()
+		if err != nil {
+			return s, err
+		}
+		s.AcctIDSource = &v
+	}
+	return s, nil
+}
+
+//Unmarshal builds a OrderCancelReject from s
+func Unmarshal(s Struct) OrderCancelReject {
+	m := New(field.NewOrderID(s.OrderID), field.NewClOrdID(s.ClOrdID), field.NewOrigClOrdID(s.OrigClOrdID), field.NewOrdStatus(s.OrdStatus), field.NewCxlRejResponseTo(s.CxlRejResponseTo))
+	if s.Account != nil {
+		m.SetAccount(*s.Account)
+	}
+	if s.Text != nil {
+		m.SetText(*s.Text)
+	}
+	if s.TransactTime != nil {
+		m.SetTransactTime(*s.TransactTime)
+	}
+	if s.ListID != nil {
+		m.SetListID(*s.ListID)
+	}
+	if s.TradeDate != nil {
+		m.SetTradeDate(*s.TradeDate)
+	}
+	if s.CxlRejReason != nil {
+		m.SetCxlRejReason(*s.CxlRejReason)
+	}
+	if s.SecondaryOrderID != nil {
+		m.SetSecondaryOrderID(*s.SecondaryOrderID)
+	}
+	if s.TradeOriginationDate != nil {
+		m.SetTradeOriginationDate(*s.TradeOriginationDate)
+	}
+	if s.EncodedTextLen != nil {
+		m.SetEncodedTextLen(*s.EncodedTextLen)
+	}
+	if s.EncodedText != nil {
+		m.SetEncodedText(*s.EncodedText)
+	}
+	if s.SecondaryClOrdID != nil {
+		m.SetSecondaryClOrdID(*s.SecondaryClOrdID)
+	}
+	if s.AccountType != nil {
+		m.SetAccountType(*s.AccountType)
+	}
+	if s.ClOrdLinkID != nil {
+		m.SetClOrdLinkID(*s.ClOrdLinkID)
+	}
+	if s.OrigOrdModTime != nil {
+		m.SetOrigOrdModTime(*s.OrigOrdModTime)
+	}
+	if s.WorkingIndicator != nil {
+		m.SetWorkingIndicator(*s.WorkingIndicator)
+	}
+	if s.AcctIDSource != nil {
+		m.SetAcctIDSource(*s.AcctIDSource)
+	}
+	return m
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/terracefi/enum"
 	"github.com/terracefi/field"
 	"github.com/terracefi/fix44"
+	"github.com/terracefi/fix44/components"
 	"github.com/terracefi/quickfix"
 	"github.com/terracefi/tag"
 )
@@ -58,6 +59,26 @@ func Route(router RouteOut) (string, string, quickfix.MessageRoute) {
 	return "FIX.4.4", "AF", r
 }
 
+//Validate checks m against the FIX 4.4 definition of OrderMassStatusRequest, see fix44.MessageDef.Validate
+func (m OrderMassStatusRequest) Validate() fix44.ValidationErrors {
+	return fix44.MessageDefs["AF"].Validate(&m.Body.FieldMap)
+}
+
+//MarshalJSON encodes the OrderMassStatusRequest as JSON with fields keyed by name, see fix44.MarshalMessageJSON
+func (m OrderMassStatusRequest) MarshalJSON() ([]byte, error) {
+	return fix44.MarshalMessageJSON(m.Message)
+}
+
+//UnmarshalJSON builds the OrderMassStatusRequest from JSON produced by MarshalJSON
+func (m *OrderMassStatusRequest) UnmarshalJSON(b []byte) error {
+	msg, err := fix44.UnmarshalMessageJSON(b, "AF")
+	if err != nil {
+		return err
+	}
+	*m = FromMessage(msg)
+	return nil
+}
+
 //SetAccount sets Account, Tag 1
 func (m OrderMassStatusRequest) SetAccount(v string) {
 	m.Set(field.NewAccount(v))
@@ -1622,6 +1643,21 @@ func (m OrderMassStatusRequest) HasEncodedIssuer() bool {
 	return m.Has(tag.EncodedIssuer)
 }
 
+//SetEncodedIssuerBytes sets EncodedIssuer, Tag 349, and EncodedIssuerLen, Tag 348, from v, which may hold any bytes including SOH
+func (m OrderMassStatusRequest) SetEncodedIssuerBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedIssuerLen, tag.EncodedIssuer, v)
+}
+
+//GetEncodedIssuerBytes gets EncodedIssuer, Tag 349, checking its length against EncodedIssuerLen, Tag 348
+func (m OrderMassStatusRequest) GetEncodedIssuerBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedIssuerLen, tag.EncodedIssuer)
+}
+
+//GetEncodedIssuerDecoded gets EncodedIssuer, Tag 349, decoded to UTF-8 using the MessageEncoding of the Header
+func (m OrderMassStatusRequest) GetEncodedIssuerDecoded() (string, error) {
+	return fix44.DecodeRawData(m.Header, m, tag.EncodedIssuerLen, tag.EncodedIssuer)
+}
+
 //HasEncodedSecurityDescLen returns true if EncodedSecurityDescLen is present, Tag 350
 func (m OrderMassStatusRequest) HasEncodedSecurityDescLen() bool {
 	return m.Has(tag.EncodedSecurityDescLen)
@@ -1632,6 +1668,21 @@ func (m OrderMassStatusRequest) HasEncodedSecurityDesc() bool {
 	return m.Has(tag.EncodedSecurityDesc)
 }
 
+//SetEncodedSecurityDescBytes sets EncodedSecurityDesc, Tag 351, and EncodedSecurityDescLen, Tag 350, from v, which may hold any bytes including SOH
+func (m OrderMassStatusRequest) SetEncodedSecurityDescBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedSecurityDescLen, tag.EncodedSecurityDesc, v)
+}
+
+//GetEncodedSecurityDescBytes gets EncodedSecurityDesc, Tag 351, checking its length against EncodedSecurityDescLen, Tag 350
+func (m OrderMassStatusRequest) GetEncodedSecurityDescBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedSecurityDescLen, tag.EncodedSecurityDesc)
+}
+
+//GetEncodedSecurityDescDecoded gets EncodedSecurityDesc, Tag 351, decoded to UTF-8 using the MessageEncoding of the Header
+func (m OrderMassStatusRequest) GetEncodedSecurityDescDecoded() (string, error) {
+	return fix44.DecodeRawData(m.Header, m, tag.EncodedSecurityDescLen, tag.EncodedSecurityDesc)
+}
+
 //HasEncodedUnderlyingIssuerLen returns true if EncodedUnderlyingIssuerLen is present, Tag 362
 func (m OrderMassStatusRequest) HasEncodedUnderlyingIssuerLen() bool {
 	return m.Has(tag.EncodedUnderlyingIssuerLen)
@@ -1642,6 +1693,21 @@ func (m OrderMassStatusRequest) HasEncodedUnderlyingIssuer() bool {
 	return m.Has(tag.EncodedUnderlyingIssuer)
 }
 
+//SetEncodedUnderlyingIssuerBytes sets EncodedUnderlyingIssuer, Tag 363, and EncodedUnderlyingIssuerLen, Tag 362, from v, which may hold any bytes including SOH
+func (m OrderMassStatusRequest) SetEncodedUnderlyingIssuerBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedUnderlyingIssuerLen, tag.EncodedUnderlyingIssuer, v)
+}
+
+//GetEncodedUnderlyingIssuerBytes gets EncodedUnderlyingIssuer, Tag 363, checking its length against EncodedUnderlyingIssuerLen, Tag 362
+func (m OrderMassStatusRequest) GetEncodedUnderlyingIssuerBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedUnderlyingIssuerLen, tag.EncodedUnderlyingIssuer)
+}
+
+//GetEncodedUnderlyingIssuerDecoded gets EncodedUnderlyingIssuer, Tag 363, decoded to UTF-8 using the MessageEncoding of the Header
+func (m OrderMassStatusRequest) GetEncodedUnderlyingIssuerDecoded() (string, error) {
+	return fix44.DecodeRawData(m.Header, m, tag.EncodedUnderlyingIssuerLen, tag.EncodedUnderlyingIssuer)
+}
+
 //HasEncodedUnderlyingSecurityDescLen returns true if EncodedUnderlyingSecurityDescLen is present, Tag 364
 func (m OrderMassStatusRequest) HasEncodedUnderlyingSecurityDescLen() bool {
 	return m.Has(tag.EncodedUnderlyingSecurityDescLen)
@@ -1652,6 +1718,21 @@ func (m OrderMassStatusRequest) HasEncodedUnderlyingSecurityDesc() bool {
 	return m.Has(tag.EncodedUnderlyingSecurityDesc)
 }
 
+//SetEncodedUnderlyingSecurityDescBytes sets EncodedUnderlyingSecurityDesc, Tag 365, and EncodedUnderlyingSecurityDescLen, Tag 364, from v, which may hold any bytes including SOH
+func (m OrderMassStatusRequest) SetEncodedUnderlyingSecurityDescBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedUnderlyingSecurityDescLen, tag.EncodedUnderlyingSecurityDesc, v)
+}
+
+//GetEncodedUnderlyingSecurityDescBytes gets EncodedUnderlyingSecurityDesc, Tag 365, checking its length against EncodedUnderlyingSecurityDescLen, Tag 364
+func (m OrderMassStatusRequest) GetEncodedUnderlyingSecurityDescBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedUnderlyingSecurityDescLen, tag.EncodedUnderlyingSecurityDesc)
+}
+
+//GetEncodedUnderlyingSecurityDescDecoded gets EncodedUnderlyingSecurityDesc, Tag 365, decoded to UTF-8 using the MessageEncoding of the Header
+func (m OrderMassStatusRequest) GetEncodedUnderlyingSecurityDescDecoded() (string, error) {
+	return fix44.DecodeRawData(m.Header, m, tag.EncodedUnderlyingSecurityDescLen, tag.EncodedUnderlyingSecurityDesc)
+}
+
 //HasUnderlyingCouponRate returns true if UnderlyingCouponRate is present, Tag 435
 func (m OrderMassStatusRequest) HasUnderlyingCouponRate() bool {
 	return m.Has(tag.UnderlyingCouponRate)
@@ -1872,472 +1953,1192 @@ func (m OrderMassStatusRequest) HasStrikeCurrency() bool {
 	return m.Has(tag.StrikeCurrency)
 }
 
-//NoPartyIDs is a repeating group element, Tag 453
-type NoPartyIDs struct {
-	*quickfix.Group
+//GetInstrument gets the Instrument component
+func (m OrderMassStatusRequest) GetInstrument() components.Instrument {
+	return components.Instrument{&m.Body.FieldMap}
 }
 
-//SetPartyID sets PartyID, Tag 448
-func (m NoPartyIDs) SetPartyID(v string) {
-	m.Set(field.NewPartyID(v))
-}
-
-//SetPartyIDSource sets PartyIDSource, Tag 447
-func (m NoPartyIDs) SetPartyIDSource(v enum.PartyIDSource) {
-	m.Set(field.NewPartyIDSource(v))
-}
-
-//SetPartyRole sets PartyRole, Tag 452
-func (m NoPartyIDs) SetPartyRole(v enum.PartyRole) {
-	m.Set(field.NewPartyRole(v))
-}
-
-//SetNoPartySubIDs sets NoPartySubIDs, Tag 802
-func (m NoPartyIDs) SetNoPartySubIDs(f NoPartySubIDsRepeatingGroup) {
-	m.SetGroup(f)
+//SetInstrument sets the Instrument component, copying the fields present in c
+func (m OrderMassStatusRequest) SetInstrument(c components.Instrument) quickfix.MessageRejectError {
+	return c.CopyInto(&m.Body.FieldMap)
 }
 
-//GetPartyID gets PartyID, Tag 448
-func (m NoPartyIDs) GetPartyID() (v string, err quickfix.MessageRejectError) {
-	var f field.PartyIDField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
+//GetUnderlyingInstrument gets the UnderlyingInstrument component
+func (m OrderMassStatusRequest) GetUnderlyingInstrument() components.UnderlyingInstrument {
+	return components.UnderlyingInstrument{&m.Body.FieldMap}
 }
 
-//GetPartyIDSource gets PartyIDSource, Tag 447
-func (m NoPartyIDs) GetPartyIDSource() (v enum.PartyIDSource, err quickfix.MessageRejectError) {
-	var f field.PartyIDSourceField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
+//SetUnderlyingInstrument sets the UnderlyingInstrument component, copying the fields present in c
+func (m OrderMassStatusRequest) SetUnderlyingInstrument(c components.UnderlyingInstrument) quickfix.MessageRejectError {
+	return c.CopyInto(&m.Body.FieldMap)
 }
 
-//GetPartyRole gets PartyRole, Tag 452
-func (m NoPartyIDs) GetPartyRole() (v enum.PartyRole, err quickfix.MessageRejectError) {
-	var f field.PartyRoleField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
+//GetParties gets the Parties component
+func (m OrderMassStatusRequest) GetParties() components.Parties {
+	return components.Parties{&m.Body.FieldMap}
 }
 
-//GetNoPartySubIDs gets NoPartySubIDs, Tag 802
-func (m NoPartyIDs) GetNoPartySubIDs() (NoPartySubIDsRepeatingGroup, quickfix.MessageRejectError) {
-	f := NewNoPartySubIDsRepeatingGroup()
-	err := m.GetGroup(f)
-	return f, err
+//SetParties sets the Parties component, copying the fields present in c
+func (m OrderMassStatusRequest) SetParties(c components.Parties) quickfix.MessageRejectError {
+	return c.CopyInto(&m.Body.FieldMap)
 }
 
-//HasPartyID returns true if PartyID is present, Tag 448
-func (m NoPartyIDs) HasPartyID() bool {
-	return m.Has(tag.PartyID)
-}
+//NoPartyIDs is a repeating group element, Tag 453
+type NoPartyIDs = components.NoPartyIDs
 
-//HasPartyIDSource returns true if PartyIDSource is present, Tag 447
-func (m NoPartyIDs) HasPartyIDSource() bool {
-	return m.Has(tag.PartyIDSource)
-}
+//NoPartyIDsRepeatingGroup is a repeating group, Tag 453
+type NoPartyIDsRepeatingGroup = components.NoPartyIDsRepeatingGroup
 
-//HasPartyRole returns true if PartyRole is present, Tag 452
-func (m NoPartyIDs) HasPartyRole() bool {
-	return m.Has(tag.PartyRole)
+//NewNoPartyIDsRepeatingGroup returns an initialized, NoPartyIDsRepeatingGroup
+func NewNoPartyIDsRepeatingGroup() NoPartyIDsRepeatingGroup {
+	return components.NewNoPartyIDsRepeatingGroup()
 }
 
-//HasNoPartySubIDs returns true if NoPartySubIDs is present, Tag 802
-func (m NoPartyIDs) HasNoPartySubIDs() bool {
-	return m.Has(tag.NoPartySubIDs)
-}
+//NoPartyIDsStruct is a plain Go representation of a NoPartyIDs group element
+type NoPartyIDsStruct = components.NoPartyIDsStruct
 
 //NoPartySubIDs is a repeating group element, Tag 802
-type NoPartySubIDs struct {
-	*quickfix.Group
-}
-
-//SetPartySubID sets PartySubID, Tag 523
-func (m NoPartySubIDs) SetPartySubID(v string) {
-	m.Set(field.NewPartySubID(v))
-}
-
-//SetPartySubIDType sets PartySubIDType, Tag 803
-func (m NoPartySubIDs) SetPartySubIDType(v enum.PartySubIDType) {
-	m.Set(field.NewPartySubIDType(v))
-}
-
-//GetPartySubID gets PartySubID, Tag 523
-func (m NoPartySubIDs) GetPartySubID() (v string, err quickfix.MessageRejectError) {
-	var f field.PartySubIDField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetPartySubIDType gets PartySubIDType, Tag 803
-func (m NoPartySubIDs) GetPartySubIDType() (v enum.PartySubIDType, err quickfix.MessageRejectError) {
-	var f field.PartySubIDTypeField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//HasPartySubID returns true if PartySubID is present, Tag 523
-func (m NoPartySubIDs) HasPartySubID() bool {
-	return m.Has(tag.PartySubID)
-}
-
-//HasPartySubIDType returns true if PartySubIDType is present, Tag 803
-func (m NoPartySubIDs) HasPartySubIDType() bool {
-	return m.Has(tag.PartySubIDType)
-}
+type NoPartySubIDs = components.NoPartySubIDs
 
 //NoPartySubIDsRepeatingGroup is a repeating group, Tag 802
-type NoPartySubIDsRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
+type NoPartySubIDsRepeatingGroup = components.NoPartySubIDsRepeatingGroup
 
 //NewNoPartySubIDsRepeatingGroup returns an initialized, NoPartySubIDsRepeatingGroup
 func NewNoPartySubIDsRepeatingGroup() NoPartySubIDsRepeatingGroup {
-	return NoPartySubIDsRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoPartySubIDs,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.PartySubID), quickfix.GroupElement(tag.PartySubIDType)})}
-}
-
-//Add create and append a new NoPartySubIDs to this group
-func (m NoPartySubIDsRepeatingGroup) Add() NoPartySubIDs {
-	g := m.RepeatingGroup.Add()
-	return NoPartySubIDs{g}
-}
-
-//Get returns the ith NoPartySubIDs in the NoPartySubIDsRepeatinGroup
-func (m NoPartySubIDsRepeatingGroup) Get(i int) NoPartySubIDs {
-	return NoPartySubIDs{m.RepeatingGroup.Get(i)}
-}
-
-//NoPartyIDsRepeatingGroup is a repeating group, Tag 453
-type NoPartyIDsRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
-
-//NewNoPartyIDsRepeatingGroup returns an initialized, NoPartyIDsRepeatingGroup
-func NewNoPartyIDsRepeatingGroup() NoPartyIDsRepeatingGroup {
-	return NoPartyIDsRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoPartyIDs,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.PartyID), quickfix.GroupElement(tag.PartyIDSource), quickfix.GroupElement(tag.PartyRole), NewNoPartySubIDsRepeatingGroup()})}
+	return components.NewNoPartySubIDsRepeatingGroup()
 }
 
-//Add create and append a new NoPartyIDs to this group
-func (m NoPartyIDsRepeatingGroup) Add() NoPartyIDs {
-	g := m.RepeatingGroup.Add()
-	return NoPartyIDs{g}
-}
-
-//Get returns the ith NoPartyIDs in the NoPartyIDsRepeatinGroup
-func (m NoPartyIDsRepeatingGroup) Get(i int) NoPartyIDs {
-	return NoPartyIDs{m.RepeatingGroup.Get(i)}
-}
+//NoPartySubIDsStruct is a plain Go representation of a NoPartySubIDs group element
+type NoPartySubIDsStruct = components.NoPartySubIDsStruct
 
 //NoSecurityAltID is a repeating group element, Tag 454
-type NoSecurityAltID struct {
-	*quickfix.Group
-}
-
-//SetSecurityAltID sets SecurityAltID, Tag 455
-func (m NoSecurityAltID) SetSecurityAltID(v string) {
-	m.Set(field.NewSecurityAltID(v))
-}
-
-//SetSecurityAltIDSource sets SecurityAltIDSource, Tag 456
-func (m NoSecurityAltID) SetSecurityAltIDSource(v string) {
-	m.Set(field.NewSecurityAltIDSource(v))
-}
-
-//GetSecurityAltID gets SecurityAltID, Tag 455
-func (m NoSecurityAltID) GetSecurityAltID() (v string, err quickfix.MessageRejectError) {
-	var f field.SecurityAltIDField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetSecurityAltIDSource gets SecurityAltIDSource, Tag 456
-func (m NoSecurityAltID) GetSecurityAltIDSource() (v string, err quickfix.MessageRejectError) {
-	var f field.SecurityAltIDSourceField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//HasSecurityAltID returns true if SecurityAltID is present, Tag 455
-func (m NoSecurityAltID) HasSecurityAltID() bool {
-	return m.Has(tag.SecurityAltID)
-}
-
-//HasSecurityAltIDSource returns true if SecurityAltIDSource is present, Tag 456
-func (m NoSecurityAltID) HasSecurityAltIDSource() bool {
-	return m.Has(tag.SecurityAltIDSource)
-}
+type NoSecurityAltID = components.NoSecurityAltID
 
 //NoSecurityAltIDRepeatingGroup is a repeating group, Tag 454
-type NoSecurityAltIDRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
+type NoSecurityAltIDRepeatingGroup = components.NoSecurityAltIDRepeatingGroup
 
 //NewNoSecurityAltIDRepeatingGroup returns an initialized, NoSecurityAltIDRepeatingGroup
 func NewNoSecurityAltIDRepeatingGroup() NoSecurityAltIDRepeatingGroup {
-	return NoSecurityAltIDRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoSecurityAltID,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.SecurityAltID), quickfix.GroupElement(tag.SecurityAltIDSource)})}
+	return components.NewNoSecurityAltIDRepeatingGroup()
 }
 
-//Add create and append a new NoSecurityAltID to this group
-func (m NoSecurityAltIDRepeatingGroup) Add() NoSecurityAltID {
-	g := m.RepeatingGroup.Add()
-	return NoSecurityAltID{g}
-}
-
-//Get returns the ith NoSecurityAltID in the NoSecurityAltIDRepeatinGroup
-func (m NoSecurityAltIDRepeatingGroup) Get(i int) NoSecurityAltID {
-	return NoSecurityAltID{m.RepeatingGroup.Get(i)}
-}
+//NoSecurityAltIDStruct is a plain Go representation of a NoSecurityAltID group element
+type NoSecurityAltIDStruct = components.NoSecurityAltIDStruct
 
 //NoUnderlyingSecurityAltID is a repeating group element, Tag 457
-type NoUnderlyingSecurityAltID struct {
-	*quickfix.Group
-}
-
-//SetUnderlyingSecurityAltID sets UnderlyingSecurityAltID, Tag 458
-func (m NoUnderlyingSecurityAltID) SetUnderlyingSecurityAltID(v string) {
-	m.Set(field.NewUnderlyingSecurityAltID(v))
-}
-
-//SetUnderlyingSecurityAltIDSource sets UnderlyingSecurityAltIDSource, Tag 459
-func (m NoUnderlyingSecurityAltID) SetUnderlyingSecurityAltIDSource(v string) {
-	m.Set(field.NewUnderlyingSecurityAltIDSource(v))
-}
-
-//GetUnderlyingSecurityAltID gets UnderlyingSecurityAltID, Tag 458
-func (m NoUnderlyingSecurityAltID) GetUnderlyingSecurityAltID() (v string, err quickfix.MessageRejectError) {
-	var f field.UnderlyingSecurityAltIDField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetUnderlyingSecurityAltIDSource gets UnderlyingSecurityAltIDSource, Tag 459
-func (m NoUnderlyingSecurityAltID) GetUnderlyingSecurityAltIDSource() (v string, err quickfix.MessageRejectError) {
-	var f field.UnderlyingSecurityAltIDSourceField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//HasUnderlyingSecurityAltID returns true if UnderlyingSecurityAltID is present, Tag 458
-func (m NoUnderlyingSecurityAltID) HasUnderlyingSecurityAltID() bool {
-	return m.Has(tag.UnderlyingSecurityAltID)
-}
-
-//HasUnderlyingSecurityAltIDSource returns true if UnderlyingSecurityAltIDSource is present, Tag 459
-func (m NoUnderlyingSecurityAltID) HasUnderlyingSecurityAltIDSource() bool {
-	return m.Has(tag.UnderlyingSecurityAltIDSource)
-}
+type NoUnderlyingSecurityAltID = components.NoUnderlyingSecurityAltID
 
 //NoUnderlyingSecurityAltIDRepeatingGroup is a repeating group, Tag 457
-type NoUnderlyingSecurityAltIDRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
+type NoUnderlyingSecurityAltIDRepeatingGroup = components.NoUnderlyingSecurityAltIDRepeatingGroup
 
 //NewNoUnderlyingSecurityAltIDRepeatingGroup returns an initialized, NoUnderlyingSecurityAltIDRepeatingGroup
 func NewNoUnderlyingSecurityAltIDRepeatingGroup() NoUnderlyingSecurityAltIDRepeatingGroup {
-	return NoUnderlyingSecurityAltIDRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoUnderlyingSecurityAltID,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.UnderlyingSecurityAltID), quickfix.GroupElement(tag.UnderlyingSecurityAltIDSource)})}
+	return components.NewNoUnderlyingSecurityAltIDRepeatingGroup()
 }
 
-//Add create and append a new NoUnderlyingSecurityAltID to this group
-func (m NoUnderlyingSecurityAltIDRepeatingGroup) Add() NoUnderlyingSecurityAltID {
-	g := m.RepeatingGroup.Add()
-	return NoUnderlyingSecurityAltID{g}
-}
-
-//Get returns the ith NoUnderlyingSecurityAltID in the NoUnderlyingSecurityAltIDRepeatinGroup
-func (m NoUnderlyingSecurityAltIDRepeatingGroup) Get(i int) NoUnderlyingSecurityAltID {
-	return NoUnderlyingSecurityAltID{m.RepeatingGroup.Get(i)}
-}
+//NoUnderlyingSecurityAltIDStruct is a plain Go representation of a NoUnderlyingSecurityAltID group element
+type NoUnderlyingSecurityAltIDStruct = components.NoUnderlyingSecurityAltIDStruct
 
 //NoEvents is a repeating group element, Tag 864
-type NoEvents struct {
-	*quickfix.Group
-}
-
-//SetEventType sets EventType, Tag 865
-func (m NoEvents) SetEventType(v enum.EventType) {
-	m.Set(field.NewEventType(v))
-}
-
-//SetEventDate sets EventDate, Tag 866
-func (m NoEvents) SetEventDate(v string) {
-	m.Set(field.NewEventDate(v))
-}
-
-//SetEventPx sets EventPx, Tag 867
-func (m NoEvents) SetEventPx(value decimal.Decimal, scale int32) {
-	m.Set(field.NewEventPx(value, scale))
-}
-
-//SetEventText sets EventText, Tag 868
-func (m NoEvents) SetEventText(v string) {
-	m.Set(field.NewEventText(v))
-}
-
-//GetEventType gets EventType, Tag 865
-func (m NoEvents) GetEventType() (v enum.EventType, err quickfix.MessageRejectError) {
-	var f field.EventTypeField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetEventDate gets EventDate, Tag 866
-func (m NoEvents) GetEventDate() (v string, err quickfix.MessageRejectError) {
-	var f field.EventDateField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetEventPx gets EventPx, Tag 867
-func (m NoEvents) GetEventPx() (v decimal.Decimal, err quickfix.MessageRejectError) {
-	var f field.EventPxField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetEventText gets EventText, Tag 868
-func (m NoEvents) GetEventText() (v string, err quickfix.MessageRejectError) {
-	var f field.EventTextField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//HasEventType returns true if EventType is present, Tag 865
-func (m NoEvents) HasEventType() bool {
-	return m.Has(tag.EventType)
-}
-
-//HasEventDate returns true if EventDate is present, Tag 866
-func (m NoEvents) HasEventDate() bool {
-	return m.Has(tag.EventDate)
-}
-
-//HasEventPx returns true if EventPx is present, Tag 867
-func (m NoEvents) HasEventPx() bool {
-	return m.Has(tag.EventPx)
-}
-
-//HasEventText returns true if EventText is present, Tag 868
-func (m NoEvents) HasEventText() bool {
-	return m.Has(tag.EventText)
-}
+type NoEvents = components.NoEvents
 
 //NoEventsRepeatingGroup is a repeating group, Tag 864
-type NoEventsRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
+type NoEventsRepeatingGroup = components.NoEventsRepeatingGroup
 
 //NewNoEventsRepeatingGroup returns an initialized, NoEventsRepeatingGroup
 func NewNoEventsRepeatingGroup() NoEventsRepeatingGroup {
-	return NoEventsRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoEvents,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.EventType), quickfix.GroupElement(tag.EventDate), quickfix.GroupElement(tag.EventPx), quickfix.GroupElement(tag.EventText)})}
+	return components.NewNoEventsRepeatingGroup()
 }
 
-//Add create and append a new NoEvents to this group
-func (m NoEventsRepeatingGroup) Add() NoEvents {
-	g := m.RepeatingGroup.Add()
-	return NoEvents{g}
-}
-
-//Get returns the ith NoEvents in the NoEventsRepeatinGroup
-func (m NoEventsRepeatingGroup) Get(i int) NoEvents {
-	return NoEvents{m.RepeatingGroup.Get(i)}
-}
+//NoEventsStruct is a plain Go representation of a NoEvents group element
+type NoEventsStruct = components.NoEventsStruct
 
 //NoUnderlyingStips is a repeating group element, Tag 887
-type NoUnderlyingStips struct {
-	*quickfix.Group
-}
-
-//SetUnderlyingStipType sets UnderlyingStipType, Tag 888
-func (m NoUnderlyingStips) SetUnderlyingStipType(v string) {
-	m.Set(field.NewUnderlyingStipType(v))
-}
-
-//SetUnderlyingStipValue sets UnderlyingStipValue, Tag 889
-func (m NoUnderlyingStips) SetUnderlyingStipValue(v string) {
-	m.Set(field.NewUnderlyingStipValue(v))
-}
-
-//GetUnderlyingStipType gets UnderlyingStipType, Tag 888
-func (m NoUnderlyingStips) GetUnderlyingStipType() (v string, err quickfix.MessageRejectError) {
-	var f field.UnderlyingStipTypeField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetUnderlyingStipValue gets UnderlyingStipValue, Tag 889
-func (m NoUnderlyingStips) GetUnderlyingStipValue() (v string, err quickfix.MessageRejectError) {
-	var f field.UnderlyingStipValueField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//HasUnderlyingStipType returns true if UnderlyingStipType is present, Tag 888
-func (m NoUnderlyingStips) HasUnderlyingStipType() bool {
-	return m.Has(tag.UnderlyingStipType)
-}
-
-//HasUnderlyingStipValue returns true if UnderlyingStipValue is present, Tag 889
-func (m NoUnderlyingStips) HasUnderlyingStipValue() bool {
-	return m.Has(tag.UnderlyingStipValue)
-}
+type NoUnderlyingStips = components.NoUnderlyingStips
 
 //NoUnderlyingStipsRepeatingGroup is a repeating group, Tag 887
-type NoUnderlyingStipsRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
+type NoUnderlyingStipsRepeatingGroup = components.NoUnderlyingStipsRepeatingGroup
 
 //NewNoUnderlyingStipsRepeatingGroup returns an initialized, NoUnderlyingStipsRepeatingGroup
 func NewNoUnderlyingStipsRepeatingGroup() NoUnderlyingStipsRepeatingGroup {
-	return NoUnderlyingStipsRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoUnderlyingStips,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.UnderlyingStipType), quickfix.GroupElement(tag.UnderlyingStipValue)})}
-}
-
-//Add create and append a new NoUnderlyingStips to this group
-func (m NoUnderlyingStipsRepeatingGroup) Add() NoUnderlyingStips {
-	g := m.RepeatingGroup.Add()
-	return NoUnderlyingStips{g}
-}
-
-//Get returns the ith NoUnderlyingStips in the NoUnderlyingStipsRepeatinGroup
-func (m NoUnderlyingStipsRepeatingGroup) Get(i int) NoUnderlyingStips {
-	return NoUnderlyingStips{m.RepeatingGroup.Get(i)}
+	return components.NewNoUnderlyingStipsRepeatingGroup()
+}
+
+//NoUnderlyingStipsStruct is a plain Go representation of a NoUnderlyingStips group element
+type NoUnderlyingStipsStruct = components.NoUnderlyingStipsStruct
+
+//Struct is a plain Go representation of the OrderMassStatusRequest body, optional fields are nil when absent
+type Struct struct {
+	Account                              *string
+	SecurityIDSource                     *enum.SecurityIDSource
+	SecurityID                           *string
+	Side                                 *enum.Side
+	Symbol                               *string
+	SymbolSfx                            *enum.SymbolSfx
+	Issuer                               *string
+	SecurityDesc                         *string
+	SecurityType                         *enum.SecurityType
+	MaturityMonthYear                    *string
+	StrikePrice                          *decimal.Decimal
+	OptAttribute                         *string
+	SecurityExchange                     *string
+	CouponRate                           *decimal.Decimal
+	CouponPaymentDate                    *string
+	IssueDate                            *string
+	RepurchaseTerm                       *int
+	RepurchaseRate                       *decimal.Decimal
+	Factor                               *decimal.Decimal
+	ContractMultiplier                   *decimal.Decimal
+	RepoCollateralSecurityType           *int
+	RedemptionDate                       *string
+	UnderlyingCouponPaymentDate          *string
+	UnderlyingIssueDate                  *string
+	UnderlyingRepoCollateralSecurityType *int
+	UnderlyingRepurchaseTerm             *int
+	UnderlyingRepurchaseRate             *decimal.Decimal
+	UnderlyingFactor                     *decimal.Decimal
+	UnderlyingRedemptionDate             *string
+	CreditRating                         *string
+	UnderlyingCreditRating               *string
+	UnderlyingSecurityIDSource           *string
+	UnderlyingIssuer                     *string
+	UnderlyingSecurityDesc               *string
+	UnderlyingSecurityExchange           *string
+	UnderlyingSecurityID                 *string
+	UnderlyingSecurityType               *string
+	UnderlyingSymbol                     *string
+	UnderlyingSymbolSfx                  *string
+	UnderlyingMaturityMonthYear          *string
+	UnderlyingStrikePrice                *decimal.Decimal
+	UnderlyingOptAttribute               *string
+	UnderlyingCurrency                   *string
+	TradingSessionID                     *enum.TradingSessionID
+	EncodedIssuerLen                     *int
+	EncodedIssuer                        *string
+	EncodedSecurityDescLen               *int
+	EncodedSecurityDesc                  *string
+	EncodedUnderlyingIssuerLen           *int
+	EncodedUnderlyingIssuer              *string
+	EncodedUnderlyingSecurityDescLen     *int
+	EncodedUnderlyingSecurityDesc        *string
+	UnderlyingCouponRate                 *decimal.Decimal
+	UnderlyingContractMultiplier         *decimal.Decimal
+	NoPartyIDs                           []NoPartyIDsStruct
+	NoSecurityAltID                      []NoSecurityAltIDStruct
+	NoUnderlyingSecurityAltID            []NoUnderlyingSecurityAltIDStruct
+	Product                              *enum.Product
+	CFICode                              *string
+	UnderlyingProduct                    *int
+	UnderlyingCFICode                    *string
+	CountryOfIssue                       *string
+	StateOrProvinceOfIssue               *string
+	LocaleOfIssue                        *string
+	MaturityDate                         *string
+	UnderlyingMaturityDate               *string
+	InstrRegistry                        *enum.InstrRegistry
+	MassStatusReqID                      string
+	MassStatusReqType                    enum.MassStatusReqType
+	UnderlyingCountryOfIssue             *string
+	UnderlyingStateOrProvinceOfIssue     *string
+	UnderlyingLocaleOfIssue              *string
+	UnderlyingInstrRegistry              *string
+	TradingSessionSubID                  *enum.TradingSessionSubID
+	AcctIDSource                         *enum.AcctIDSource
+	ContractSettlMonth                   *string
+	Pool                                 *string
+	SecuritySubType                      *string
+	UnderlyingSecuritySubType            *string
+	UnderlyingPx                         *decimal.Decimal
+	NoEvents                             []NoEventsStruct
+	DatedDate                            *string
+	InterestAccrualDate                  *string
+	CPProgram                            *enum.CPProgram
+	CPRegType                            *string
+	UnderlyingCPProgram                  *string
+	UnderlyingCPRegType                  *string
+	UnderlyingQty                        *decimal.Decimal
+	UnderlyingDirtyPrice                 *decimal.Decimal
+	UnderlyingEndPrice                   *decimal.Decimal
+	UnderlyingStartValue                 *decimal.Decimal
+	UnderlyingCurrentValue               *decimal.Decimal
+	UnderlyingEndValue                   *decimal.Decimal
+	NoUnderlyingStips                    []NoUnderlyingStipsStruct
+	UnderlyingStrikeCurrency             *string
+	StrikeCurrency                       *string
+}
+
+//Marshal copies the fields present in msg into a Struct
+func Marshal(msg OrderMassStatusRequest) (Struct, quickfix.MessageRejectError) {
+	var s Struct
+	if msg.HasAccount() {
+		v, err := msg.GetAccount()
+		if err != nil {
+			return s, err
+		}
+		s.Account = &v
+	}
+	if msg.HasSecurityIDSource() {
+		v, err := msg.GetSecurityIDSource()
+		if err != nil {
+			return s, err
+		}
+		s.SecurityIDSource = &v
+	}
+	if msg.HasSecurityID() {
+		v, err := msg.GetSecurityID()
+		if err != nil {
+			return s, err
+		}
+		s.SecurityID = &v
+	}
+	if msg.HasSide() {
+		v, err := msg.GetSide()
+		if err != nil {
+			return s, err
+		}
+		s.Side = &v
+	}
+	if msg.HasSymbol() {
+		v, err := msg.GetSymbol()
+		if err != nil {
+			return s, err
+		}
+		s.Symbol = &v
+	}
+	if msg.HasSymbolSfx() {
+		v, err := msg.GetSymbolSfx()
+		if err != nil {
+			return s, err
+		}
+		s.SymbolSfx = &v
+	}
+	if msg.HasIssuer() {
+		v, err := msg.GetIssuer()
+		if err != nil {
+			return s, err
+		}
+		s.Issuer = &v
+	}
+	if msg.HasSecurityDesc() {
+		v, err := msg.GetSecurityDesc()
+		if err != nil {
+			return s, err
+		}
+		s.SecurityDesc = &v
+	}
+	if msg.HasSecurityType() {
+		v, err := msg.GetSecurityType()
+		if err != nil {
+			return s, err
+		}
+		s.SecurityType = &v
+	}
+	if msg.HasMaturityMonthYear() {
+		v, err := msg.GetMaturityMonthYear()
+		if err != nil {
+			return s, err
+		}
+		s.MaturityMonthYear = &v
+	}
+	if msg.HasStrikePrice() {
+		v, err := msg.GetStrikePrice()
+		if err != nil {
+			return s, err
+		}
+		s.StrikePrice = &v
+	}
+	if msg.HasOptAttribute() {
+		v, err := msg.GetOptAttribute()
+		if err != nil {
+			return s, err
+		}
+		s.OptAttribute = &v
+	}
+	if msg.HasSecurityExchange() {
+		v, err := msg.GetSecurityExchange()
+		if err != nil {
+			return s, err
+		}
+		s.SecurityExchange = &v
+	}
+	if msg.HasCouponRate() {
+		v, err := msg.GetCouponRate()
+		if err != nil {
+			return s, err
+		}
+		s.CouponRate = &v
+	}
+	if msg.HasCouponPaymentDate() {
+		v, err := msg.GetCouponPaymentDate()
+		if err != nil {
+			return s, err
+		}
+		s.CouponPaymentDate = &v
+	}
+	if msg.HasIssueDate() {
+		v, err := msg.GetIssueDate()
+		if err != nil {
+			return s, err
+		}
+		s.IssueDate = &v
+	}
+	if msg.HasRepurchaseTerm() {
+		v, err := msg.GetRepurchaseTerm()
+		if err != nil {
+			return s, err
+		}
+		s.RepurchaseTerm = &v
+	}
+	if msg.HasRepurchaseRate() {
+		v, err := msg.GetRepurchaseRate()
+		if err != nil {
+			return s, err
+		}
+		s.RepurchaseRate = &v
+	}
+	if msg.HasFactor() {
+		v, err := msg.GetFactor()
+		if err != nil {
+			return s, err
+		}
+		s.Factor = &v
+	}
+	if msg.HasContractMultiplier() {
+		v, err := msg.GetContractMultiplier()
+		if err != nil {
+			return s, err
+		}
+		s.ContractMultiplier = &v
+	}
+	if msg.HasRepoCollateralSecurityType() {
+		v, err := msg.GetRepoCollateralSecurityType()
+		if err != nil {
+			return s, err
+		}
+		s.RepoCollateralSecurityType = &v
+	}
+	if msg.HasRedemptionDate() {
+		v, err := msg.GetRedemptionDate()
+		if err != nil {
+			return s, err
+		}
+		s.RedemptionDate = &v
+	}
+	if msg.HasUnderlyingCouponPaymentDate() {
+		v, err := msg.GetUnderlyingCouponPaymentDate()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingCouponPaymentDate = &v
+	}
+	if msg.HasUnderlyingIssueDate() {
+		v, err := msg.GetUnderlyingIssueDate()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingIssueDate = &v
+	}
+	if msg.HasUnderlyingRepoCollateralSecurityType() {
+		v, err := msg.GetUnderlyingRepoCollateralSecurityType()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingRepoCollateralSecurityType = &v
+	}
+	if msg.HasUnderlyingRepurchaseTerm() {
+		v, err := msg.GetUnderlyingRepurchaseTerm()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingRepurchaseTerm = &v
+	}
+	if msg.HasUnderlyingRepurchaseRate() {
+		v, err := msg.GetUnderlyingRepurchaseRate()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingRepurchaseRate = &v
+	}
+	if msg.HasUnderlyingFactor() {
+		v, err := msg.GetUnderlyingFactor()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingFactor = &v
+	}
+	if msg.HasUnderlyingRedemptionDate() {
+		v, err := msg.GetUnderlyingRedemptionDate()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingRedemptionDate = &v
+	}
+	if msg.HasCreditRating() {
+		v, err := msg.GetCreditRating()
+		if err != nil {
+			return s, err
+		}
+		s.CreditRating = &v
+	}
+	if msg.HasUnderlyingCreditRating() {
+		v, err := msg.GetUnderlyingCreditRating()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingCreditRating = &v
+	}
+	if msg.HasUnderlyingSecurityIDSource() {
+		v, err := msg.GetUnderlyingSecurityIDSource()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingSecurityIDSource = &v
+	}
+	if msg.HasUnderlyingIssuer() {
+		v, err := msg.GetUnderlyingIssuer()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingIssuer = &v
+	}
+	if msg.HasUnderlyingSecurityDesc() {
+		v, err := msg.GetUnderlyingSecurityDesc()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingSecurityDesc = &v
+	}
+	if msg.HasUnderlyingSecurityExchange() {
+		v, err := msg.GetUnderlyingSecurityExchange()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingSecurityExchange = &v
+	}
+	if msg.HasUnderlyingSecurityID() {
+		v, err := msg.GetUnderlyingSecurityID()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingSecurityID = &v
+	}
+	if msg.HasUnderlyingSecurityType() {
+		v, err := msg.GetUnderlyingSecurityType()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingSecurityType = &v
+	}
+	if msg.HasUnderlyingSymbol() {
+		v, err := msg.GetUnderlyingSymbol()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingSymbol = &v
+	}
+	if msg.HasUnderlyingSymbolSfx() {
+		v, err := msg.GetUnderlyingSymbolSfx()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingSymbolSfx = &v
+	}
+	if msg.HasUnderlyingMaturityMonthYear() {
+		v, err := msg.GetUnderlyingMaturityMonthYear()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingMaturityMonthYear = &v
+	}
+	if msg.HasUnderlyingStrikePrice() {
+		v, err := msg.GetUnderlyingStrikePrice()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingStrikePrice = &v
+	}
+	if msg.HasUnderlyingOptAttribute() {
+		v, err := msg.GetUnderlyingOptAttribute()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingOptAttribute = &v
+	}
+	if msg.HasUnderlyingCurrency() {
+		v, err := msg.GetUnderlyingCurrency()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingCurrency = &v
+	}
+	if msg.HasTradingSessionID() {
+		v, err := msg.GetTradingSessionID()
+		if err != nil {
+			return s, err
+		}
+		s.TradingSessionID = &v
+	}
+	if msg.HasEncodedIssuerLen() {
+		v, err := msg.GetEncodedIssuerLen()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedIssuerLen = &v
+	}
+	if msg.HasEncodedIssuer() {
+		v, err := msg.GetEncodedIssuer()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedIssuer = &v
+	}
+	if msg.HasEncodedSecurityDescLen() {
+		v, err := msg.GetEncodedSecurityDescLen()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedSecurityDescLen = &v
+	}
+	if msg.HasEncodedSecurityDesc() {
+		v, err := msg.GetEncodedSecurityDesc()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedSecurityDesc = &v
+	}
+	if msg.HasEncodedUnderlyingIssuerLen() {
+		v, err := msg.GetEncodedUnderlyingIssuerLen()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedUnderlyingIssuerLen = &v
+	}
+	if msg.HasEncodedUnderlyingIssuer() {
+		v, err := msg.GetEncodedUnderlyingIssuer()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedUnderlyingIssuer = &v
+	}
+	if msg.HasEncodedUnderlyingSecurityDescLen() {
+		v, err := msg.GetEncodedUnderlyingSecurityDescLen()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedUnderlyingSecurityDescLen = &v
+	}
+	if msg.HasEncodedUnderlyingSecurityDesc() {
+		v, err := msg.GetEncodedUnderlyingSecurityDesc()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedUnderlyingSecurityDesc = &v
+	}
+	if msg.HasUnderlyingCouponRate() {
+		v, err := msg.GetUnderlyingCouponRate()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingCouponRate = &v
+	}
+	if msg.HasUnderlyingContractMultiplier() {
+		v, err := msg.GetUnderlyingContractMultiplier()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingContractMultiplier = &v
+	}
+	if msg.HasNoPartyIDs() {
+		g, err := msg.GetNoPartyIDs()
+		if err != nil {
+			return s, err
+		}
+		if s.NoPartyIDs, err = components.MarshalNoPartyIDs(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasNoSecurityAltID() {
+		g, err := msg.GetNoSecurityAltID()
+		if err != nil {
+			return s, err
+		}
+		if s.NoSecurityAltID, err = components.MarshalNoSecurityAltID(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasNoUnderlyingSecurityAltID() {
+		g, err := msg.GetNoUnderlyingSecurityAltID()
+		if err != nil {
+			return s, err
+		}
+		if s.NoUnderlyingSecurityAltID, err = components.MarshalNoUnderlyingSecurityAltID(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasProduct() {
+		v, err := msg.GetProduct()
+		if err != nil {
+			return s, err
+		}
+		s.Product = &v
+	}
+	if msg.HasCFICode() {
+		v, err := msg.GetCFICode()
+		if err != nil {
+			return s, err
+		}
+		s.CFICode = &v
+	}
+	if msg.HasUnderlyingProduct() {
+		v, err := msg.GetUnderlyingProduct()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingProduct = &v
+	}
+	if msg.HasUnderlyingCFICode() {
+		v, err := msg.GetUnderlyingCFICode()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingCFICode = &v
+	}
+	if msg.HasCountryOfIssue() {
+		v, err := msg.GetCountryOfIssue()
+		if err != nil {
+			return s, err
+		}
+		s.CountryOfIssue = &v
+	}
+	if msg.HasStateOrProvinceOfIssue() {
+		v, err := msg.GetStateOrProvinceOfIssue()
+		if err != nil {
+			return s, err
+		}
+		s.StateOrProvinceOfIssue = &v
+	}
+	if msg.HasLocaleOfIssue() {
+		v, err := msg.GetLocaleOfIssue()
+		if err != nil {
+			return s, err
+		}
+		s.LocaleOfIssue = &v
+	}
+	if msg.HasMaturityDate() {
+		v, err := msg.GetMaturityDate()
+		if err != nil {
+			return s, err
+		}
+		s.MaturityDate = &v
+	}
+	if msg.HasUnderlyingMaturityDate() {
+		v, err := msg.GetUnderlyingMaturityDate()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingMaturityDate = &v
+	}
+	if msg.HasInstrRegistry() {
+		v, err := msg.GetInstrRegistry()
+		if err != nil {
+			return s, err
+		}
+		s.InstrRegistry = &v
+	}
+	if msg.HasMassStatusReqID() {
+		v, err := msg.GetMassStatusReqID()
+		if err != nil {
+			return s, err
+		}
+		s.MassStatusReqID = v
+	}
+	if msg.HasMassStatusReqType() {
+		v, err := msg.GetMassStatusReqType()
+		if err != nil {
+			return s, err
+		}
+		s.MassStatusReqType = v
+	}
+	if msg.HasUnderlyingCountryOfIssue() {
+		v, err := msg.GetUnderlyingCountryOfIssue()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingCountryOfIssue = &v
+	}
+	if msg.HasUnderlyingStateOrProvinceOfIssue() {
+		v, err := msg.GetUnderlyingStateOrProvinceOfIssue()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingStateOrProvinceOfIssue = &v
+	}
+	if msg.HasUnderlyingLocaleOfIssue() {
+		v, err := msg.GetUnderlyingLocaleOfIssue()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingLocaleOfIssue = &v
+	}
+	if msg.HasUnderlyingInstrRegistry() {
+		v, err := msg.GetUnderlyingInstrRegistry()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingInstrRegistry = &v
+	}
+	if msg.HasTradingSessionSubID() {
+		v, err := msg.GetTradingSessionSubID()
+		if err != nil {
+			return s, err
+		}
+		s.TradingSessionSubID = &v
+	}
+	if msg.HasAcctIDSource() {
+		v, err := msg.GetAcctIDSource()
+		if err != nil {
+			return s, err
+		}
+		s.AcctIDSource = &v
+	}
+	if msg.HasContractSettlMonth() {
+		v, err := msg.GetContractSettlMonth()
+		if err != nil {
+			return s, err
+		}
+		s.ContractSettlMonth = &v
+	}
+	if msg.HasPool() {
+		v, err := msg.GetPool()
+		if err != nil {
+			return s, err
+		}
+		s.Pool = &v
+	}
+	if msg.HasSecuritySubType() {
+		v, err := msg.GetSecuritySubType()
+		if err != nil {
+			return s, err
+		}
+		s.SecuritySubType = &v
+	}
+	if msg.HasUnderlyingSecuritySubType() {
+		v, err := msg.GetUnderlyingSecuritySubType()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingSecuritySubType = &v
+	}
+	if msg.HasUnderlyingPx() {
+		v, err := msg.GetUnderlyingPx()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingPx = &v
+	}
+	if msg.HasNoEvents() {
+		g, err := msg.GetNoEvents()
+		if err != nil {
+			return s, err
+		}
+		if s.NoEvents, err = components.MarshalNoEvents(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasDatedDate() {
+		v, err := msg.GetDatedDate()
+		if err != nil {
+			return s, err
+		}
+		s.DatedDate = &v
+	}
+	if msg.HasInterestAccrualDate() {
+		v, err := msg.GetInterestAccrualDate()
+		if err != nil {
+			return s, err
+		}
+		s.InterestAccrualDate = &v
+	}
+	if msg.HasCPProgram() {
+		v, err := msg.GetCPProgram()
+		if err != nil {
+			return s, err
+		}
+		s.CPProgram = &v
+	}
+	if msg.HasCPRegType() {
+		v, err := msg.GetCPRegType()
+		if err != nil {
+			return s, err
+		}
+		s.CPRegType = &v
+	}
+	if msg.HasUnderlyingCPProgram() {
+		v, err := msg.GetUnderlyingCPProgram()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingCPProgram = &v
+	}
+	if msg.HasUnderlyingCPRegType() {
+		v, err := msg.GetUnderlyingCPRegType()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingCPRegType = &v
+	}
+	if msg.HasUnderlyingQty() {
+		v, err := msg.GetUnderlyingQty()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingQty = &v
+	}
+	if msg.HasUnderlyingDirtyPrice() {
+		v, err := msg.GetUnderlyingDirtyPrice()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingDirtyPrice = &v
+	}
+	if msg.HasUnderlyingEndPrice() {
+		v, err := msg.GetUnderlyingEndPrice()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingEndPrice = &v
+	}
+	if msg.HasUnderlyingStartValue() {
+		v, err := msg.GetUnderlyingStartValue()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingStartValue = &v
+	}
+	if msg.HasUnderlyingCurrentValue() {
+		v, err := msg.GetUnderlyingCurrentValue()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingCurrentValue = &v
+	}
+	if msg.HasUnderlyingEndValue() {
+		v, err := msg.GetUnderlyingEndValue()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingEndValue = &v
+	}
+	if msg.HasNoUnderlyingStips() {
+		g, err := msg.GetNoUnderlyingStips()
+		if err != nil {
+			return s, err
+		}
+		if s.NoUnderlyingStips, err = components.MarshalNoUnderlyingStips(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasUnderlyingStrikeCurrency() {
+		v, err := msg.GetUnderlyingStrikeCurrency()
+		if err != nil {
+			return s, err
+		}
+		s.UnderlyingStrikeCurrency = &v
+	}
+	if msg.HasStrikeCurrency() {
+		v, err := msg.GetStrikeCurrency()
+		if err != nil {
+			return s, err
+		}
+		s.StrikeCurrency = &v
+	}
+	return s, nil
+}
+
+//Unmarshal builds a OrderMassStatusRequest from s
+func Unmarshal(s Struct) OrderMassStatusRequest {
+	m := New(field.NewMassStatusReqID(s.MassStatusReqID), field.NewMassStatusReqType(s.MassStatusReqType))
+	if s.Account != nil {
+		m.SetAccount(*s.Account)
+	}
+	if s.SecurityIDSource != nil {
+		m.SetSecurityIDSource(*s.SecurityIDSource)
+	}
+	if s.SecurityID != nil {
+		m.SetSecurityID(*s.SecurityID)
+	}
+	if s.Side != nil {
+		m.SetSide(*s.Side)
+	}
+	if s.Symbol != nil {
+		m.SetSymbol(*s.Symbol)
+	}
+	if s.SymbolSfx != nil {
+		m.SetSymbolSfx(*s.SymbolSfx)
+	}
+	if s.Issuer != nil {
+		m.SetIssuer(*s.Issuer)
+	}
+	if s.SecurityDesc != nil {
+		m.SetSecurityDesc(*s.SecurityDesc)
+	}
+	if s.SecurityType != nil {
+		m.SetSecurityType(*s.SecurityType)
+	}
+	if s.MaturityMonthYear != nil {
+		m.SetMaturityMonthYear(*s.MaturityMonthYear)
+	}
+	if s.StrikePrice != nil {
+		m.SetStrikePrice(*s.StrikePrice, fix44.DecimalScale(*s.StrikePrice))
+	}
+	if s.OptAttribute != nil {
+		m.SetOptAttribute(*s.OptAttribute)
+	}
+	if s.SecurityExchange != nil {
+		m.SetSecurityExchange(*s.SecurityExchange)
+	}
+	if s.CouponRate != nil {
+		m.SetCouponRate(*s.CouponRate, fix44.DecimalScale(*s.CouponRate))
+	}
+	if s.CouponPaymentDate != nil {
+		m.SetCouponPaymentDate(*s.CouponPaymentDate)
+	}
+	if s.IssueDate != nil {
+		m.SetIssueDate(*s.IssueDate)
+	}
+	if s.RepurchaseTerm != nil {
+		m.SetRepurchaseTerm(*s.RepurchaseTerm)
+	}
+	if s.RepurchaseRate != nil {
+		m.SetRepurchaseRate(*s.RepurchaseRate, fix44.DecimalScale(*s.RepurchaseRate))
+	}
+	if s.Factor != nil {
+		m.SetFactor(*s.Factor, fix44.DecimalScale(*s.Factor))
+	}
+	if s.ContractMultiplier != nil {
+		m.SetContractMultiplier(*s.ContractMultiplier, fix44.DecimalScale(*s.ContractMultiplier))
+	}
+	if s.RepoCollateralSecurityType != nil {
+		m.SetRepoCollateralSecurityType(*s.RepoCollateralSecurityType)
+	}
+	if s.RedemptionDate != nil {
+		m.SetRedemptionDate(*s.RedemptionDate)
+	}
+	if s.UnderlyingCouponPaymentDate != nil {
+		m.SetUnderlyingCouponPaymentDate(*s.UnderlyingCouponPaymentDate)
+	}
+	if s.UnderlyingIssueDate != nil {
+		m.SetUnderlyingIssueDate(*s.UnderlyingIssueDate)
+	}
+	if s.UnderlyingRepoCollateralSecurityType != nil {
+		m.SetUnderlyingRepoCollateralSecurityType(*s.UnderlyingRepoCollateralSecurityType)
+	}
+	if s.UnderlyingRepurchaseTerm != nil {
+		m.SetUnderlyingRepurchaseTerm(*s.UnderlyingRepurchaseTerm)
+	}
+	if s.UnderlyingRepurchaseRate != nil {
+		m.SetUnderlyingRepurchaseRate(*s.UnderlyingRepurchaseRate, fix44.DecimalScale(*s.UnderlyingRepurchaseRate))
+	}
+	if s.UnderlyingFactor != nil {
+		m.SetUnderlyingFactor(*s.UnderlyingFactor, fix44.DecimalScale(*s.UnderlyingFactor))
+	}
+	if s.UnderlyingRedemptionDate != nil {
+		m.SetUnderlyingRedemptionDate(*s.UnderlyingRedemptionDate)
+	}
+	if s.CreditRating != nil {
+		m.SetCreditRating(*s.CreditRating)
+	}
+	if s.UnderlyingCreditRating != nil {
+		m.SetUnderlyingCreditRating(*s.UnderlyingCreditRating)
+	}
+	if s.UnderlyingSecurityIDSource != nil {
+		m.SetUnderlyingSecurityIDSource(*s.UnderlyingSecurityIDSource)
+	}
+	if s.UnderlyingIssuer != nil {
+		m.SetUnderlyingIssuer(*s.UnderlyingIssuer)
+	}
+	if s.UnderlyingSecurityDesc != nil {
+		m.SetUnderlyingSecurityDesc(*s.UnderlyingSecurityDesc)
+	}
+	if s.UnderlyingSecurityExchange != nil {
+		m.SetUnderlyingSecurityExchange(*s.UnderlyingSecurityExchange)
+	}
+	if s.UnderlyingSecurityID != nil {
+		m.SetUnderlyingSecurityID(*s.UnderlyingSecurityID)
+	}
+	if s.UnderlyingSecurityType != nil {
+		m.SetUnderlyingSecurityType(*s.UnderlyingSecurityType)
+	}
+	if s.UnderlyingSymbol != nil {
+		m.SetUnderlyingSymbol(*s.UnderlyingSymbol)
+	}
+	if s.UnderlyingSymbolSfx != nil {
+		m.SetUnderlyingSymbolSfx(*s.UnderlyingSymbolSfx)
+	}
+	if s.UnderlyingMaturityMonthYear != nil {
+		m.SetUnderlyingMaturityMonthYear(*s.UnderlyingMaturityMonthYear)
+	}
+	if s.UnderlyingStrikePrice != nil {
+		m.SetUnderlyingStrikePrice(*s.UnderlyingStrikePrice, fix44.DecimalScale(*s.UnderlyingStrikePrice))
+	}
+	if s.UnderlyingOptAttribute != nil {
+		m.SetUnderlyingOptAttribute(*s.UnderlyingOptAttribute)
+	}
+	if s.UnderlyingCurrency != nil {
+		m.SetUnderlyingCurrency(*s.UnderlyingCurrency)
+	}
+	if s.TradingSessionID != nil {
+		m.SetTradingSessionID(*s.TradingSessionID)
+	}
+	if s.EncodedIssuerLen != nil {
+		m.SetEncodedIssuerLen(*s.EncodedIssuerLen)
+	}
+	if s.EncodedIssuer != nil {
+		m.SetEncodedIssuer(*s.EncodedIssuer)
+	}
+	if s.EncodedSecurityDescLen != nil {
+		m.SetEncodedSecurityDescLen(*s.EncodedSecurityDescLen)
+	}
+	if s.EncodedSecurityDesc != nil {
+		m.SetEncodedSecurityDesc(*s.EncodedSecurityDesc)
+	}
+	if s.EncodedUnderlyingIssuerLen != nil {
+		m.SetEncodedUnderlyingIssuerLen(*s.EncodedUnderlyingIssuerLen)
+	}
+	if s.EncodedUnderlyingIssuer != nil {
+		m.SetEncodedUnderlyingIssuer(*s.EncodedUnderlyingIssuer)
+	}
+	if s.EncodedUnderlyingSecurityDescLen != nil {
+		m.SetEncodedUnderlyingSecurityDescLen(*s.EncodedUnderlyingSecurityDescLen)
+	}
+	if s.EncodedUnderlyingSecurityDesc != nil {
+		m.SetEncodedUnderlyingSecurityDesc(*s.EncodedUnderlyingSecurityDesc)
+	}
+	if s.UnderlyingCouponRate != nil {
+		m.SetUnderlyingCouponRate(*s.UnderlyingCouponRate, fix44.DecimalScale(*s.UnderlyingCouponRate))
+	}
+	if s.UnderlyingContractMultiplier != nil {
+		m.SetUnderlyingContractMultiplier(*s.UnderlyingContractMultiplier, fix44.DecimalScale(*s.UnderlyingContractMultiplier))
+	}
+	if s.NoPartyIDs != nil {
+		m.SetNoPartyIDs(components.UnmarshalNoPartyIDs(s.NoPartyIDs))
+	}
+	if s.NoSecurityAltID != nil {
+		m.SetNoSecurityAltID(components.UnmarshalNoSecurityAltID(s.NoSecurityAltID))
+	}
+	if s.NoUnderlyingSecurityAltID != nil {
+		m.SetNoUnderlyingSecurityAltID(components.UnmarshalNoUnderlyingSecurityAltID(s.NoUnderlyingSecurityAltID))
+	}
+	if s.Product != nil {
+		m.SetProduct(*s.Product)
+	}
+	if s.CFICode != nil {
+		m.SetCFICode(*s.CFICode)
+	}
+	if s.UnderlyingProduct != nil {
+		m.SetUnderlyingProduct(*s.UnderlyingProduct)
+	}
+	if s.UnderlyingCFICode != nil {
+		m.SetUnderlyingCFICode(*s.UnderlyingCFICode)
+	}
+	if s.CountryOfIssue != nil {
+		m.SetCountryOfIssue(*s.CountryOfIssue)
+	}
+	if s.StateOrProvinceOfIssue != nil {
+		m.SetStateOrProvinceOfIssue(*s.StateOrProvinceOfIssue)
+	}
+	if s.LocaleOfIssue != nil {
+		m.SetLocaleOfIssue(*s.LocaleOfIssue)
+	}
+	if s.MaturityDate != nil {
+		m.SetMaturityDate(*s.MaturityDate)
+	}
+	if s.UnderlyingMaturityDate != nil {
+		m.SetUnderlyingMaturityDate(*s.UnderlyingMaturityDate)
+	}
+	if s.InstrRegistry != nil {
+		m.SetInstrRegistry(*s.InstrRegistry)
+	}
+	if s.UnderlyingCountryOfIssue != nil {
+		m.SetUnderlyingCountryOfIssue(*s.UnderlyingCountryOfIssue)
+	}
+	if s.UnderlyingStateOrProvinceOfIssue != nil {
+		m.SetUnderlyingStateOrProvinceOfIssue(*s.UnderlyingStateOrProvinceOfIssue)
+	}
+	if s.UnderlyingLocaleOfIssue != nil {
+		m.SetUnderlyingLocaleOfIssue(*s.UnderlyingLocaleOfIssue)
+	}
+	if s.UnderlyingInstrRegistry != nil {
+		m.SetUnderlyingInstrRegistry(*s.UnderlyingInstrRegistry)
+	}
+	if s.TradingSessionSubID != nil {
+		m.SetTradingSessionSubID(*s.TradingSessionSubID)
+	}
+	if s.AcctIDSource != nil {
+		m.SetAcctIDSource(*s.AcctIDSource)
+	}
+	if s.ContractSettlMonth != nil {
+		m.SetContractSettlMonth(*s.ContractSettlMonth)
+	}
+	if s.Pool != nil {
+		m.SetPool(*s.Pool)
+	}
+	if s.SecuritySubType != nil {
+		m.SetSecuritySubType(*s.SecuritySubType)
+	}
+	if s.UnderlyingSecuritySubType != nil {
+		m.SetUnderlyingSecuritySubType(*s.UnderlyingSecuritySubType)
+	}
+	if s.UnderlyingPx != nil {
+		m.SetUnderlyingPx(*s.UnderlyingPx, fix44.DecimalScale(*s.UnderlyingPx))
+	}
+	if s.NoEvents != nil {
+		m.SetNoEvents(components.UnmarshalNoEvents(s.NoEvents))
+	}
+	if s.DatedDate != nil {
+		m.SetDatedDate(*s.DatedDate)
+	}
+	if s.InterestAccrualDate != nil {
+		m.SetInterestAccrualDate(*s.InterestAccrualDate)
+	}
+	if s.CPProgram != nil {
+		m.SetCPProgram(*s.CPProgram)
+	}
+	if s.CPRegType != nil {
+		m.SetCPRegType(*s.CPRegType)
+	}
+	if s.UnderlyingCPProgram != nil {
+		m.SetUnderlyingCPProgram(*s.UnderlyingCPProgram)
+	}
+	if s.UnderlyingCPRegType != nil {
+		m.SetUnderlyingCPRegType(*s.UnderlyingCPRegType)
+	}
+	if s.UnderlyingQty != nil {
+		m.SetUnderlyingQty(*s.UnderlyingQty, fix44.DecimalScale(*s.UnderlyingQty))
+	}
+	if s.UnderlyingDirtyPrice != nil {
+		m.SetUnderlyingDirtyPrice(*s.UnderlyingDirtyPrice, fix44.DecimalScale(*s.UnderlyingDirtyPrice))
+	}
+	if s.UnderlyingEndPrice != nil {
+		m.SetUnderlyingEndPrice(*s.UnderlyingEndPrice, fix44.DecimalScale(*s.UnderlyingEndPrice))
+	}
+	if s.UnderlyingStartValue != nil {
+		m.SetUnderlyingStartValue(*s.UnderlyingStartValue, fix44.DecimalScale(*s.UnderlyingStartValue))
+	}
+	if s.UnderlyingCurrentValue != nil {
+		m.SetUnderlyingCurrentValue(*s.UnderlyingCurrentValue, fix44.DecimalScale(*s.UnderlyingCurrentValue))
+	}
+	if s.UnderlyingEndValue != nil {
+		m.SetUnderlyingEndValue(*s.UnderlyingEndValue, fix44.DecimalScale(*s.UnderlyingEndValue))
+	}
+	if s.NoUnderlyingStips != nil {
+		m.SetNoUnderlyingStips(components.UnmarshalNoUnderlyingStips(s.NoUnderlyingStips))
+	}
+	if s.UnderlyingStrikeCurrency != nil {
+		m.SetUnderlyingStrikeCurrency(*s.UnderlyingStrikeCurrency)
+	}
+	if s.StrikeCurrency != nil {
+		m.SetStrikeCurrency(*s.StrikeCurrency)
+	}
+	return m
 }
@@ -0,0 +1,350 @@
+package orderstate
+
+import (
+	"github.com/terracefi/enum"
+	"github.com/terracefi/fix44/crossordercancelreplacerequest"
+	"github.com/terracefi/fix44/crossordercancelrequest"
+	"github.com/terracefi/fix44/internal/fixutil"
+	"github.com/terracefi/fix44/newordercross"
+	"github.com/terracefi/quickfix"
+	"github.com/terracefi/tag"
+)
+
+//CrossState is the combined state of the sides of a cross
+type CrossState int
+
+//CrossState values
+const (
+	//CrossPending is the state of a cross with a side that has not been acknowledged yet
+	CrossPending CrossState = iota
+	//CrossActive is the state of a cross whose sides are working without executions
+	CrossActive
+	//CrossPartiallyExecuted is the state of a cross with executions on a side that is still working
+	CrossPartiallyExecuted
+	//CrossExecuted is the state of a cross whose sides are all filled
+	CrossExecuted
+	//CrossDone is the state of a cross whose sides are all closed, some with executions
+	CrossDone
+	//CrossCanceled is the state of a cross whose sides are all closed without executions
+	CrossCanceled
+	//CrossRejected is the state of a cross with a rejected side
+	CrossRejected
+)
+
+func (s CrossState) String() string {
+	switch s {
+	case CrossPending:
+		return "Pending"
+	case CrossActive:
+		return "Active"
+	case CrossPartiallyExecuted:
+		return "PartiallyExecuted"
+	case CrossExecuted:
+		return "Executed"
+	case CrossDone:
+		return "Done"
+	case CrossCanceled:
+		return "Canceled"
+	case CrossRejected:
+		return "Rejected"
+	}
+	return "Unknown"
+}
+
+//Cross is the state of a cross as seen by a Tracker
+type Cross struct {
+	//CrossID is the CrossID of the cross currently in force
+	CrossID string
+	//CrossIDs holds every CrossID the cross has had, oldest first
+	CrossIDs            []string
+	CrossType           enum.CrossType
+	CrossPrioritization enum.CrossPrioritization
+
+	//Sides holds the orders placed by the sides of the cross
+	Sides []Order
+	State CrossState
+
+	//PendingCrossID is the CrossID of the cross cancel or replace request awaiting a response, if any
+	PendingCrossID string
+}
+
+type cross struct {
+	crossIDs            []string
+	crossType           enum.CrossType
+	crossPrioritization enum.CrossPrioritization
+	sides               []*Order
+
+	pending  string
+	accepted bool
+}
+
+func (c *cross) crossID() string {
+	return c.crossIDs[len(c.crossIDs)-1]
+}
+
+func (c *cross) clone() Cross {
+	x := Cross{
+		CrossID:             c.crossID(),
+		CrossIDs:            append([]string(nil), c.crossIDs...),
+		CrossType:           c.crossType,
+		CrossPrioritization: c.crossPrioritization,
+		State:               c.state(),
+		PendingCrossID:      c.pending,
+	}
+	for _, o := range c.sides {
+		x.Sides = append(x.Sides, o.clone())
+	}
+	return x
+}
+
+func (c *cross) state() CrossState {
+	var filled, closed, executed, pending int
+	for _, o := range c.sides {
+		switch {
+		case o.Status == StatusRejected:
+			return CrossRejected
+		case o.Status == StatusPendingNew:
+			pending++
+		}
+		if o.Status == StatusFilled {
+			filled++
+		}
+		if IsTerminal(o.Status) || o.Status == StatusDoneForDay {
+			closed++
+		}
+		if o.CumQty.IsPositive() {
+			executed++
+		}
+	}
+
+	switch {
+	case filled == len(c.sides):
+		return CrossExecuted
+	case closed == len(c.sides) && executed > 0:
+		return CrossDone
+	case closed == len(c.sides):
+		return CrossCanceled
+	case executed > 0:
+		return CrossPartiallyExecuted
+	case pending > 0:
+		return CrossPending
+	}
+	return CrossActive
+}
+
+//Cross returns the cross that has, or has had, the given CrossID
+func (t *Tracker) Cross(crossID string) (Cross, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	c, ok := t.crosses[crossID]
+	if !ok {
+		return Cross{}, false
+	}
+	return c.clone(), true
+}
+
+//OnNewOrderCross starts tracking the cross, each side as an order in PendingNew status. The cross is checked with
+//newordercross.CheckCross first.
+func (t *Tracker) OnNewOrderCross(msg newordercross.NewOrderCross) (Cross, error) {
+	s, err := newordercross.Marshal(msg)
+	if err != nil {
+		return Cross{}, err
+	}
+	if err := newordercross.CheckCross(s); err != nil {
+		return Cross{}, err
+	}
+
+	c := &cross{
+		crossIDs:            []string{s.CrossID},
+		crossType:           s.CrossType,
+		crossPrioritization: s.CrossPrioritization,
+	}
+	for _, sd := range s.NoSides {
+		o := &Order{
+			ClOrdID:  *sd.ClOrdID,
+			ClOrdIDs: []string{*sd.ClOrdID},
+			CrossID:  s.CrossID,
+			Symbol:   fixutil.Deref(s.Symbol),
+			Side:     *sd.Side,
+			OrdType:  s.OrdType,
+			Status:   StatusPendingNew,
+		}
+		if sd.OrderQty != nil {
+			o.OrderQty = *sd.OrderQty
+		}
+		if s.Price != nil {
+			o.Price = *s.Price
+		}
+		o.LeavesQty = o.OrderQty
+		c.sides = append(c.sides, o)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if _, ok := t.crosses[s.CrossID]; ok {
+		return Cross{}, &DuplicateCrossError{s.CrossID}
+	}
+	for _, o := range c.sides {
+		if _, ok := t.orders[o.ClOrdID]; ok {
+			return Cross{}, &DuplicateOrderError{o.ClOrdID}
+		}
+	}
+	t.crosses[s.CrossID] = c
+	for _, o := range c.sides {
+		t.orders[o.ClOrdID] = o
+	}
+	return c.clone(), nil
+}
+
+//OnCrossOrderCancelReplaceRequest records a replace request for the cross identified by OrigCrossID, each side
+//replacing the order identified by its OrigClOrdID
+func (t *Tracker) OnCrossOrderCancelReplaceRequest(msg crossordercancelreplacerequest.CrossOrderCancelReplaceRequest) (Cross, error) {
+	s, err := crossordercancelreplacerequest.Marshal(msg)
+	if err != nil {
+		return Cross{}, err
+	}
+
+	var sides []crossSide
+	for _, sd := range s.NoSides {
+		cs, err := newCrossSide(sd.OrigClOrdID, sd.ClOrdID, false)
+		if err != nil {
+			return Cross{}, err
+		}
+		if sd.OrderQty != nil {
+			cs.OrderQty = *sd.OrderQty
+		}
+		if s.Price != nil {
+			cs.Price = *s.Price
+		}
+		sides = append(sides, cs)
+	}
+	return t.crossRequest(s.OrigCrossID, s.CrossID, sides, StatusPendingReplace)
+}
+
+//OnCrossOrderCancelRequest records a cancel request for the cross identified by OrigCrossID, each side canceling the
+//order identified by its OrigClOrdID
+func (t *Tracker) OnCrossOrderCancelRequest(msg crossordercancelrequest.CrossOrderCancelRequest) (Cross, error) {
+	s, err := crossordercancelrequest.Marshal(msg)
+	if err != nil {
+		return Cross{}, err
+	}
+
+	var sides []crossSide
+	for _, sd := range s.NoSides {
+		cs, err := newCrossSide(sd.OrigClOrdID, sd.ClOrdID, true)
+		if err != nil {
+			return Cross{}, err
+		}
+		sides = append(sides, cs)
+	}
+	return t.crossRequest(s.OrigCrossID, s.CrossID, sides, StatusPendingCancel)
+}
+
+//crossSide is the request made for one side of a cross
+type crossSide struct {
+	Request
+	OrigClOrdID string
+}
+
+func newCrossSide(origClOrdID, clOrdID *string, cancel bool) (crossSide, error) {
+	switch {
+	case origClOrdID == nil:
+		return crossSide{}, quickfix.RequiredTagMissing(tag.OrigClOrdID)
+	case clOrdID == nil:
+		return crossSide{}, quickfix.RequiredTagMissing(tag.ClOrdID)
+	}
+	return crossSide{Request: Request{ClOrdID: *clOrdID, Cancel: cancel}, OrigClOrdID: *origClOrdID}, nil
+}
+
+//crossRequest checks the requests for every side before recording any of them, so a request is recorded for all
+//sides of the cross or for none. The sides must not share their new ClOrdID, see newordercross.CheckClOrdIDs.
+func (t *Tracker) crossRequest(origCrossID, crossID string, sides []crossSide, pending enum.OrdStatus) (Cross, error) {
+	for i := range sides {
+		for _, sd := range sides[:i] {
+			if err := newordercross.CheckClOrdIDs(sd.ClOrdID, sides[i].ClOrdID); err != nil {
+				return Cross{}, err
+			}
+		}
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	c, ok := t.crosses[origCrossID]
+	if !ok {
+		return Cross{}, &UnknownCrossError{origCrossID}
+	}
+	if _, ok := t.crosses[crossID]; ok {
+		return c.clone(), &DuplicateCrossError{crossID}
+	}
+
+	orders := make([]*Order, len(sides))
+	for i, sd := range sides {
+		o, err := t.checkRequest(sd.OrigClOrdID, sd.Request, pending)
+		if err != nil {
+			return c.clone(), err
+		}
+		if !c.has(o) {
+			return c.clone(), &UnknownOrderError{sd.OrigClOrdID}
+		}
+		orders[i] = o
+	}
+
+	for i, sd := range sides {
+		t.addRequest(orders[i], sd.Request)
+	}
+	c.pending = crossID
+	c.accepted = false
+	t.crosses[crossID] = c
+	return c.clone(), nil
+}
+
+func (c *cross) has(o *Order) bool {
+	for _, sd := range c.sides {
+		if sd == o {
+			return true
+		}
+	}
+	return false
+}
+
+//findSide returns the side of the cross identified by crossID or origCrossID on the given side of the market
+func (t *Tracker) findSide(crossID, origCrossID string, side enum.Side) *Order {
+	c, ok := t.crosses[crossID]
+	if !ok {
+		if c, ok = t.crosses[origCrossID]; !ok {
+			return nil
+		}
+	}
+	for _, o := range c.sides {
+		if o.Side == side {
+			return o
+		}
+	}
+	return nil
+}
+
+//settle resolves the pending cross cancel or replace request once none of the sides has a pending request. The
+//pending CrossID comes into force if the request was accepted for any side, accepted being true if it just was.
+func (c *cross) settle(accepted bool) {
+	if c == nil || c.pending == "" {
+		return
+	}
+	c.accepted = c.accepted || accepted
+	for _, o := range c.sides {
+		if o.Pending != nil {
+			return
+		}
+	}
+
+	if c.accepted {
+		c.crossIDs = append(c.crossIDs, c.pending)
+		for _, o := range c.sides {
+			o.CrossID = c.pending
+		}
+	}
+	c.pending = ""
+	c.accepted = false
+}
@@ -0,0 +1,237 @@
+package orderstate
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/terracefi/enum"
+	"github.com/terracefi/fix44/crossordercancelreplacerequest"
+	"github.com/terracefi/fix44/crossordercancelrequest"
+	"github.com/terracefi/fix44/internal/fixutil"
+	"github.com/terracefi/fix44/newordercross"
+)
+
+const sell enum.Side = "2"
+
+//crossStep is a step of a cross test, the outcome being checked on the cross afterwards
+type crossStep func(t *Tracker) error
+
+func newCross(crossID, buyClOrdID, sellClOrdID string, sellSide enum.Side) crossStep {
+	return func(t *Tracker) error {
+		qty := decimal.NewFromInt(100)
+		_, err := t.OnNewOrderCross(newordercross.Unmarshal(newordercross.Struct{
+			CrossID:             crossID,
+			CrossType:           newordercross.CrossAllOrNone,
+			CrossPrioritization: newordercross.PrioritizeNone,
+			TransactTime:        time.Now(),
+			OrdType:             limit,
+			Symbol:              fixutil.Ptr("ABC"),
+			Price:               fixutil.Ptr(decimal.NewFromInt(10)),
+			NoSides: []newordercross.NoSidesStruct{
+				{Side: fixutil.Ptr(buy), ClOrdID: fixutil.Ptr(buyClOrdID), OrderQty: &qty},
+				{Side: fixutil.Ptr(sellSide), ClOrdID: fixutil.Ptr(sellClOrdID), OrderQty: &qty},
+			},
+		}))
+		return err
+	}
+}
+
+//crossCancel requests the cancel of the cross origCrossID, ids holding the OrigClOrdID and ClOrdID of each side
+func crossCancel(origCrossID, crossID string, ids ...[2]string) crossStep {
+	return func(t *Tracker) error {
+		s := crossordercancelrequest.Struct{OrigCrossID: origCrossID, CrossID: crossID, TransactTime: time.Now(),
+			CrossType: newordercross.CrossAllOrNone, CrossPrioritization: newordercross.PrioritizeNone}
+		for _, id := range ids {
+			s.NoSides = append(s.NoSides, crossordercancelrequest.NoSidesStruct{OrigClOrdID: fixutil.Ptr(id[0]),
+				ClOrdID: fixutil.Ptr(id[1])})
+		}
+		_, err := t.OnCrossOrderCancelRequest(crossordercancelrequest.Unmarshal(s))
+		return err
+	}
+}
+
+//crossReplace requests the replace of the cross origCrossID, ids holding the OrigClOrdID and ClOrdID of each side
+func crossReplace(origCrossID, crossID string, qty int64, ids ...[2]string) crossStep {
+	return func(t *Tracker) error {
+		s := crossordercancelreplacerequest.Struct{OrigCrossID: origCrossID, CrossID: crossID,
+			TransactTime: time.Now(), OrdType: limit, CrossType: newordercross.CrossAllOrNone,
+			CrossPrioritization: newordercross.PrioritizeNone}
+		for _, id := range ids {
+			s.NoSides = append(s.NoSides, crossordercancelreplacerequest.NoSidesStruct{OrigClOrdID: fixutil.Ptr(id[0]),
+				ClOrdID: fixutil.Ptr(id[1]), OrderQty: fixutil.Ptr(decimal.NewFromInt(qty))})
+		}
+		_, err := t.OnCrossOrderCancelReplaceRequest(crossordercancelreplacerequest.Unmarshal(s))
+		return err
+	}
+}
+
+func orderStep(s step) crossStep {
+	return func(t *Tracker) error {
+		_, err := s(t)
+		return err
+	}
+}
+
+func TestCross(t *testing.T) {
+	acked := []crossStep{newCross("X1", "A", "B", sell),
+		orderStep(report{"A", "", "E1", ExecNew, StatusNew, 0, 0, 100}.step),
+		orderStep(report{"B", "", "E2", ExecNew, StatusNew, 0, 0, 100}.step)}
+	then := func(steps ...crossStep) []crossStep {
+		return append(append([]crossStep(nil), acked...), steps...)
+	}
+	replaced := then(crossReplace("X1", "X2", 200, [2]string{"A", "C"}, [2]string{"B", "D"}),
+		orderStep(report{"C", "A", "E3", ExecReplaced, StatusNew, 0, 0, 200}.step),
+		orderStep(report{"D", "B", "E4", ExecReplaced, StatusNew, 0, 0, 200}.step))
+
+	tests := []struct {
+		name           string
+		steps          []crossStep
+		wantErr        error
+		crossIDs       []string
+		pendingCrossID string
+		state          CrossState
+		sidesPending   bool
+	}{
+		{
+			name:     "new",
+			steps:    []crossStep{newCross("X1", "A", "B", sell)},
+			crossIDs: []string{"X1"}, state: CrossPending,
+		},
+		{
+			name:     "acknowledged",
+			steps:    acked,
+			crossIDs: []string{"X1"}, state: CrossActive,
+		},
+		{
+			name:    "not a buy and a sell",
+			steps:   []crossStep{newCross("X1", "A", "B", buy)},
+			wantErr: newordercross.CheckClOrdIDs("A", "A"),
+		},
+		{
+			name:     "duplicate CrossID",
+			steps:    then(newCross("X1", "C", "D", sell)),
+			wantErr:  &DuplicateCrossError{},
+			crossIDs: []string{"X1"}, state: CrossActive,
+		},
+		{
+			name:    "side reuses a ClOrdID",
+			steps:   []crossStep{orderStep(newOrder("A", 100)), newCross("X1", "A", "B", sell)},
+			wantErr: &DuplicateOrderError{},
+		},
+		{
+			name:     "sides share the new ClOrdID",
+			steps:    then(crossCancel("X1", "X2", [2]string{"A", "C"}, [2]string{"B", "C"})),
+			wantErr:  newordercross.CheckClOrdIDs("C", "C"),
+			crossIDs: []string{"X1"}, state: CrossActive,
+		},
+		{
+			name:     "unknown cross",
+			steps:    then(crossCancel("X9", "X2", [2]string{"A", "C"}, [2]string{"B", "D"})),
+			wantErr:  &UnknownCrossError{},
+			crossIDs: []string{"X1"}, state: CrossActive,
+		},
+		{
+			name: "request for an order outside the cross",
+			steps: then(orderStep(newOrder("Z", 100)),
+				crossCancel("X1", "X2", [2]string{"A", "C"}, [2]string{"Z", "D"})),
+			wantErr:  &UnknownOrderError{},
+			crossIDs: []string{"X1"}, state: CrossActive,
+		},
+		{
+			name:     "pending cancel",
+			steps:    then(crossCancel("X1", "X2", [2]string{"A", "C"}, [2]string{"B", "D"})),
+			crossIDs: []string{"X1"}, pendingCrossID: "X2", state: CrossActive, sidesPending: true,
+		},
+		{
+			name:     "replaced",
+			steps:    replaced,
+			crossIDs: []string{"X1", "X2"}, state: CrossActive,
+		},
+		{
+			name: "replace then cancel",
+			steps: append(replaced, crossCancel("X2", "X3", [2]string{"C", "E"}, [2]string{"D", "F"}),
+				orderStep(report{"E", "C", "E5", ExecCanceled, StatusCanceled, 0, 0, 0}.step),
+				orderStep(report{"F", "D", "E6", ExecCanceled, StatusCanceled, 0, 0, 0}.step)),
+			crossIDs: []string{"X1", "X2", "X3"}, state: CrossCanceled,
+		},
+		{
+			name: "cancel rejected",
+			steps: then(crossCancel("X1", "X2", [2]string{"A", "C"}, [2]string{"B", "D"}),
+				orderStep(cancelReject("A", "C", StatusNew)), orderStep(cancelReject("B", "D", StatusNew))),
+			crossIDs: []string{"X1"}, state: CrossActive,
+		},
+		{
+			name: "cancel rejected on one side",
+			steps: then(crossCancel("X1", "X2", [2]string{"A", "C"}, [2]string{"B", "D"}),
+				orderStep(report{"C", "A", "E3", ExecCanceled, StatusCanceled, 0, 0, 0}.step),
+				orderStep(cancelReject("B", "D", StatusNew))),
+			crossIDs: []string{"X1", "X2"}, state: CrossActive,
+		},
+		{
+			name: "partially executed",
+			steps: then(orderStep(report{"A", "", "E3", ExecTrade, StatusPartiallyFilled, 40, 40, 60}.step),
+				orderStep(report{"B", "", "E4", ExecTrade, StatusPartiallyFilled, 40, 40, 60}.step)),
+			crossIDs: []string{"X1"}, state: CrossPartiallyExecuted,
+		},
+		{
+			name: "executed",
+			steps: then(orderStep(report{"A", "", "E3", ExecTrade, StatusFilled, 100, 100, 0}.step),
+				orderStep(report{"B", "", "E4", ExecTrade, StatusFilled, 100, 100, 0}.step)),
+			crossIDs: []string{"X1"}, state: CrossExecuted,
+		},
+		{
+			name: "side rejected",
+			steps: []crossStep{newCross("X1", "A", "B", sell),
+				orderStep(report{"A", "", "E1", ExecRejected, StatusRejected, 0, 0, 0}.step)},
+			crossIDs: []string{"X1"}, state: CrossRejected,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tr := New()
+			var err error
+			for i, s := range tt.steps {
+				err = s(tr)
+				if i < len(tt.steps)-1 && err != nil {
+					t.Fatalf("step %v: %v", i, err)
+				}
+			}
+			if reflect.TypeOf(err) != reflect.TypeOf(tt.wantErr) {
+				t.Fatalf("error = %v, want %T", err, tt.wantErr)
+			}
+
+			c, ok := tr.Cross("X1")
+			if ok != (tt.crossIDs != nil) {
+				t.Fatalf("Cross() found = %v, want %v", ok, tt.crossIDs != nil)
+			}
+			if !ok {
+				if n := len(tr.Orders()); n > 1 {
+					t.Errorf("len(Orders()) = %v, want no side tracked", n)
+				}
+				return
+			}
+			if !reflect.DeepEqual(c.CrossIDs, tt.crossIDs) {
+				t.Errorf("CrossIDs = %v, want %v", c.CrossIDs, tt.crossIDs)
+			}
+			if c.CrossID != tt.crossIDs[len(tt.crossIDs)-1] {
+				t.Errorf("CrossID = %v, want the last of %v", c.CrossID, tt.crossIDs)
+			}
+			if c.PendingCrossID != tt.pendingCrossID {
+				t.Errorf("PendingCrossID = %v, want %v", c.PendingCrossID, tt.pendingCrossID)
+			}
+			if c.State != tt.state {
+				t.Errorf("State = %v, want %v", c.State, tt.state)
+			}
+			for _, o := range c.Sides {
+				if (o.Pending != nil) != tt.sidesPending {
+					t.Errorf("side %v Pending = %v, want pending %v", o.ClOrdID, o.Pending, tt.sidesPending)
+				}
+				if o.CrossID != c.CrossID {
+					t.Errorf("side %v CrossID = %v, want %v", o.ClOrdID, o.CrossID, c.CrossID)
+				}
+			}
+		})
+	}
+}
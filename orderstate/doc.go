@@ -0,0 +1,18 @@
+/*
+Package orderstate tracks orders through the FIX 4.4 order life cycle.
+
+A Tracker is fed the NewOrderSingle, OrderCancelReplaceRequest and OrderCancelRequest messages that are sent and
+the ExecutionReport and OrderCancelReject messages that are received. It follows the ClOrdID / OrigClOrdID chain of
+each order, so an order can be looked up by any ClOrdID it has been known by, and keeps its OrdStatus, CumQty,
+LeavesQty and AvgPx up to date.
+
+Reports that move an order through an illegal OrdStatus transition, or whose quantities do not add up, are still
+applied, since the counterparty's view of the order is authoritative, but the Tracker returns a *TransitionError or
+*QuantityError describing the inconsistency.
+
+Crosses are tracked from the NewOrderCross, CrossOrderCancelReplaceRequest and CrossOrderCancelRequest messages that
+are sent. Each side of a cross is tracked as an order of its own, and the ExecutionReports for the sides are
+matched back to the cross, which follows its CrossID / OrigCrossID chain and combines the state of its sides into a
+CrossState.
+*/
+package orderstate
@@ -0,0 +1,73 @@
+package orderstate
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+	"github.com/terracefi/enum"
+)
+
+//UnknownOrderError is returned for a message that refers to an order the Tracker does not know
+type UnknownOrderError struct {
+	ClOrdID string
+}
+
+func (e *UnknownOrderError) Error() string {
+	return fmt.Sprintf("orderstate: unknown order %v", e.ClOrdID)
+}
+
+//DuplicateOrderError is returned when a NewOrderSingle or a cancel or replace request reuses a ClOrdID
+type DuplicateOrderError struct {
+	ClOrdID string
+}
+
+func (e *DuplicateOrderError) Error() string {
+	return fmt.Sprintf("orderstate: duplicate ClOrdID %v", e.ClOrdID)
+}
+
+//UnknownCrossError is returned for a message that refers to a cross the Tracker does not know
+type UnknownCrossError struct {
+	CrossID string
+}
+
+func (e *UnknownCrossError) Error() string {
+	return fmt.Sprintf("orderstate: unknown cross %v", e.CrossID)
+}
+
+//DuplicateCrossError is returned when a NewOrderCross or a cross cancel or replace request reuses a CrossID
+type DuplicateCrossError struct {
+	CrossID string
+}
+
+func (e *DuplicateCrossError) Error() string {
+	return fmt.Sprintf("orderstate: duplicate CrossID %v", e.CrossID)
+}
+
+//TransitionError is returned for an ExecutionReport that moves an order between two OrdStatus values that FIX 4.4
+//does not allow, or for a request on an order that is already in a terminal status
+type TransitionError struct {
+	ClOrdID  string
+	ExecType enum.ExecType
+	From     enum.OrdStatus
+	To       enum.OrdStatus
+}
+
+func (e *TransitionError) Error() string {
+	return fmt.Sprintf("orderstate: order %v: illegal transition from OrdStatus %v to %v (ExecType %v)", e.ClOrdID, e.From, e.To, e.ExecType)
+}
+
+//QuantityError is returned for an ExecutionReport whose quantities are inconsistent with each other or with the
+//order. Reason describes the inconsistency.
+type QuantityError struct {
+	ClOrdID   string
+	ExecID    string
+	Reason    string
+	OrderQty  decimal.Decimal
+	CumQty    decimal.Decimal
+	LeavesQty decimal.Decimal
+}
+
+func (e *QuantityError) Error() string {
+	return fmt.Sprintf("orderstate: order %v, ExecID %v: %v (OrderQty %v, CumQty %v, LeavesQty %v)",
+		e.ClOrdID, e.ExecID, e.Reason, e.OrderQty, e.CumQty, e.LeavesQty)
+}
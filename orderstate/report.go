@@ -0,0 +1,96 @@
+package orderstate
+
+import (
+	"github.com/shopspring/decimal"
+	"github.com/terracefi/enum"
+	"github.com/terracefi/fix44/executionreport"
+)
+
+//execReport holds the fields of an ExecutionReport the Tracker uses
+type execReport struct {
+	OrderID     string
+	ClOrdID     string
+	OrigClOrdID string
+	CrossID     string
+	OrigCrossID string
+	Side        enum.Side
+	ExecID      string
+	ExecType    enum.ExecType
+	OrdStatus   enum.OrdStatus
+	OrderQty    *decimal.Decimal
+	LastQty     decimal.Decimal
+	CumQty      decimal.Decimal
+	LeavesQty   decimal.Decimal
+	AvgPx       decimal.Decimal
+}
+
+func (r *execReport) read(msg executionreport.ExecutionReport) (err error) {
+	if r.OrderID, err = msg.GetOrderID(); err != nil {
+		return
+	}
+	if r.ExecID, err = msg.GetExecID(); err != nil {
+		return
+	}
+	if r.ExecType, err = msg.GetExecType(); err != nil {
+		return
+	}
+	if r.OrdStatus, err = msg.GetOrdStatus(); err != nil {
+		return
+	}
+	if r.CumQty, err = msg.GetCumQty(); err != nil {
+		return
+	}
+	if r.LeavesQty, err = msg.GetLeavesQty(); err != nil {
+		return
+	}
+	if r.AvgPx, err = msg.GetAvgPx(); err != nil {
+		return
+	}
+	if r.Side, err = msg.GetSide(); err != nil {
+		return
+	}
+	if msg.HasClOrdID() {
+		if r.ClOrdID, err = msg.GetClOrdID(); err != nil {
+			return
+		}
+	}
+	if msg.HasOrigClOrdID() {
+		if r.OrigClOrdID, err = msg.GetOrigClOrdID(); err != nil {
+			return
+		}
+	}
+	if msg.HasCrossID() {
+		if r.CrossID, err = msg.GetCrossID(); err != nil {
+			return
+		}
+	}
+	if msg.HasOrigCrossID() {
+		if r.OrigCrossID, err = msg.GetOrigCrossID(); err != nil {
+			return
+		}
+	}
+	if msg.HasLastQty() {
+		if r.LastQty, err = msg.GetLastQty(); err != nil {
+			return
+		}
+	}
+	if msg.HasOrderQty() {
+		var v decimal.Decimal
+		if v, err = msg.GetOrderQty(); err != nil {
+			return
+		}
+		r.OrderQty = &v
+	}
+	return nil
+}
+
+func (r execReport) quantityError(o *Order, reason string) *QuantityError {
+	return &QuantityError{
+		ClOrdID:   o.ClOrdID,
+		ExecID:    r.ExecID,
+		Reason:    reason,
+		OrderQty:  o.OrderQty,
+		CumQty:    r.CumQty,
+		LeavesQty: r.LeavesQty,
+	}
+}
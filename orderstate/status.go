@@ -0,0 +1,104 @@
+package orderstate
+
+import (
+	"github.com/terracefi/enum"
+)
+
+//OrdStatus values, FIX 4.4
+const (
+	StatusNew                enum.OrdStatus = "0"
+	StatusPartiallyFilled    enum.OrdStatus = "1"
+	StatusFilled             enum.OrdStatus = "2"
+	StatusDoneForDay         enum.OrdStatus = "3"
+	StatusCanceled           enum.OrdStatus = "4"
+	StatusReplaced           enum.OrdStatus = "5"
+	StatusPendingCancel      enum.OrdStatus = "6"
+	StatusStopped            enum.OrdStatus = "7"
+	StatusRejected           enum.OrdStatus = "8"
+	StatusSuspended          enum.OrdStatus = "9"
+	StatusPendingNew         enum.OrdStatus = "A"
+	StatusCalculated         enum.OrdStatus = "B"
+	StatusExpired            enum.OrdStatus = "C"
+	StatusAcceptedForBidding enum.OrdStatus = "D"
+	StatusPendingReplace     enum.OrdStatus = "E"
+)
+
+//ExecType values, FIX 4.4
+const (
+	ExecNew            enum.ExecType = "0"
+	ExecDoneForDay     enum.ExecType = "3"
+	ExecCanceled       enum.ExecType = "4"
+	ExecReplaced       enum.ExecType = "5"
+	ExecPendingCancel  enum.ExecType = "6"
+	ExecStopped        enum.ExecType = "7"
+	ExecRejected       enum.ExecType = "8"
+	ExecSuspended      enum.ExecType = "9"
+	ExecPendingNew     enum.ExecType = "A"
+	ExecCalculated     enum.ExecType = "B"
+	ExecExpired        enum.ExecType = "C"
+	ExecRestated       enum.ExecType = "D"
+	ExecPendingReplace enum.ExecType = "E"
+	ExecTrade          enum.ExecType = "F"
+	ExecTradeCorrect   enum.ExecType = "G"
+	ExecTradeCancel    enum.ExecType = "H"
+	ExecOrderStatus    enum.ExecType = "I"
+)
+
+//IsTerminal returns true if no further executions can take place on an order with status s
+func IsTerminal(s enum.OrdStatus) bool {
+	switch s {
+	case StatusFilled, StatusCanceled, StatusRejected, StatusExpired:
+		return true
+	}
+	return false
+}
+
+//transitions lists the OrdStatus an order may move to from each status through an ExecutionReport. Staying in the
+//same status is always legal and terminal statuses have no way out. Statuses that are not listed are not checked.
+var transitions = map[enum.OrdStatus][]enum.OrdStatus{
+	StatusPendingNew: {StatusNew, StatusPartiallyFilled, StatusFilled, StatusDoneForDay, StatusCanceled,
+		StatusPendingCancel, StatusStopped, StatusRejected, StatusSuspended, StatusExpired, StatusPendingReplace},
+	StatusNew: {StatusPartiallyFilled, StatusFilled, StatusDoneForDay, StatusCanceled, StatusPendingCancel,
+		StatusStopped, StatusSuspended, StatusExpired, StatusPendingReplace},
+	StatusPartiallyFilled: {StatusFilled, StatusDoneForDay, StatusCanceled, StatusPendingCancel, StatusStopped,
+		StatusSuspended, StatusExpired, StatusPendingReplace},
+	StatusPendingCancel: {StatusNew, StatusPartiallyFilled, StatusFilled, StatusDoneForDay, StatusCanceled,
+		StatusStopped, StatusSuspended, StatusExpired},
+	StatusPendingReplace: {StatusNew, StatusPartiallyFilled, StatusFilled, StatusDoneForDay, StatusCanceled,
+		StatusPendingCancel, StatusStopped, StatusSuspended, StatusExpired},
+	StatusDoneForDay: {StatusNew, StatusPartiallyFilled, StatusFilled, StatusCanceled, StatusPendingCancel,
+		StatusExpired, StatusPendingReplace},
+	StatusStopped: {StatusPartiallyFilled, StatusFilled, StatusDoneForDay, StatusCanceled, StatusPendingCancel, StatusExpired},
+	StatusSuspended: {StatusNew, StatusPartiallyFilled, StatusFilled, StatusDoneForDay, StatusCanceled, StatusPendingCancel,
+		StatusExpired, StatusPendingReplace},
+	StatusFilled:   {},
+	StatusCanceled: {},
+	StatusRejected: {},
+	StatusExpired:  {},
+}
+
+//isCorrection returns true for the ExecTypes that restate an order rather than progress it, they are exempt from
+//the transition checks
+func isCorrection(t enum.ExecType) bool {
+	switch t {
+	case ExecRestated, ExecTradeCorrect, ExecTradeCancel, ExecOrderStatus:
+		return true
+	}
+	return false
+}
+
+func legalTransition(from, to enum.OrdStatus) bool {
+	if from == to || from == "" {
+		return true
+	}
+	allowed, ok := transitions[from]
+	if !ok {
+		return true
+	}
+	for _, s := range allowed {
+		if s == to {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,385 @@
+package orderstate
+
+import (
+	"sync"
+
+	"github.com/shopspring/decimal"
+	"github.com/terracefi/enum"
+	"github.com/terracefi/fix44/executionreport"
+	"github.com/terracefi/fix44/newordersingle"
+	"github.com/terracefi/fix44/ordercancelreject"
+	"github.com/terracefi/fix44/ordercancelreplacerequest"
+	"github.com/terracefi/fix44/ordercancelrequest"
+)
+
+//Order is the state of an order as seen by a Tracker
+type Order struct {
+	//ClOrdID is the ClOrdID of the order currently in force
+	ClOrdID string
+	//ClOrdIDs holds every ClOrdID the order has had, oldest first
+	ClOrdIDs []string
+	OrderID  string
+	//CrossID is the CrossID currently in force of the cross the order is a side of, if any
+	CrossID string
+
+	Symbol   string
+	Side     enum.Side
+	OrdType  enum.OrdType
+	OrderQty decimal.Decimal
+	Price    decimal.Decimal
+
+	Status    enum.OrdStatus
+	CumQty    decimal.Decimal
+	LeavesQty decimal.Decimal
+	AvgPx     decimal.Decimal
+
+	//LastExecID is the ExecID of the last ExecutionReport applied to the order
+	LastExecID string
+
+	//Pending is the cancel or replace request awaiting a response, if any
+	Pending *Request
+
+	//execIDs holds the ExecIDs of the ExecutionReports applied to the order
+	execIDs map[string]bool
+}
+
+//Request is a cancel or replace request sent for an order
+type Request struct {
+	ClOrdID string
+	//Cancel is true for an OrderCancelRequest and false for an OrderCancelReplaceRequest
+	Cancel   bool
+	OrderQty decimal.Decimal
+	Price    decimal.Decimal
+}
+
+func (o *Order) clone() Order {
+	c := *o
+	c.ClOrdIDs = append([]string(nil), o.ClOrdIDs...)
+	c.execIDs = nil
+	if o.Pending != nil {
+		p := *o.Pending
+		c.Pending = &p
+	}
+	return c
+}
+
+//Tracker follows the state of orders from the messages exchanged about them. It is safe for concurrent use.
+type Tracker struct {
+	mu       sync.Mutex
+	orders   map[string]*Order
+	orderIDs map[string]*Order
+	crosses  map[string]*cross
+}
+
+//New returns an empty Tracker
+func New() *Tracker {
+	return &Tracker{
+		orders:   make(map[string]*Order),
+		orderIDs: make(map[string]*Order),
+		crosses:  make(map[string]*cross),
+	}
+}
+
+//Order returns the order that has, or has had, the given ClOrdID
+func (t *Tracker) Order(clOrdID string) (Order, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	o, ok := t.orders[clOrdID]
+	if !ok {
+		return Order{}, false
+	}
+	return o.clone(), true
+}
+
+//Orders returns every order known to the Tracker
+func (t *Tracker) Orders() []Order {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var orders []Order
+	for id, o := range t.orders {
+		if id == o.ClOrdIDs[0] {
+			orders = append(orders, o.clone())
+		}
+	}
+	return orders
+}
+
+//OnNewOrderSingle starts tracking the order, in PendingNew status
+func (t *Tracker) OnNewOrderSingle(msg newordersingle.NewOrderSingle) (Order, error) {
+	clOrdID, err := msg.GetClOrdID()
+	if err != nil {
+		return Order{}, err
+	}
+	side, err := msg.GetSide()
+	if err != nil {
+		return Order{}, err
+	}
+	ordType, err := msg.GetOrdType()
+	if err != nil {
+		return Order{}, err
+	}
+
+	o := &Order{
+		ClOrdID:  clOrdID,
+		ClOrdIDs: []string{clOrdID},
+		Side:     side,
+		OrdType:  ordType,
+		Status:   StatusPendingNew,
+	}
+	if msg.HasSymbol() {
+		if o.Symbol, err = msg.GetSymbol(); err != nil {
+			return Order{}, err
+		}
+	}
+	if msg.HasOrderQty() {
+		if o.OrderQty, err = msg.GetOrderQty(); err != nil {
+			return Order{}, err
+		}
+	}
+	if msg.HasPrice() {
+		if o.Price, err = msg.GetPrice(); err != nil {
+			return Order{}, err
+		}
+	}
+	o.LeavesQty = o.OrderQty
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if _, ok := t.orders[clOrdID]; ok {
+		return Order{}, &DuplicateOrderError{clOrdID}
+	}
+	t.orders[clOrdID] = o
+	return o.clone(), nil
+}
+
+//OnOrderCancelReplaceRequest records a replace request for the order identified by OrigClOrdID
+func (t *Tracker) OnOrderCancelReplaceRequest(msg ordercancelreplacerequest.OrderCancelReplaceRequest) (Order, error) {
+	origClOrdID, err := msg.GetOrigClOrdID()
+	if err != nil {
+		return Order{}, err
+	}
+	r := Request{}
+	if r.ClOrdID, err = msg.GetClOrdID(); err != nil {
+		return Order{}, err
+	}
+	if msg.HasOrderQty() {
+		if r.OrderQty, err = msg.GetOrderQty(); err != nil {
+			return Order{}, err
+		}
+	}
+	if msg.HasPrice() {
+		if r.Price, err = msg.GetPrice(); err != nil {
+			return Order{}, err
+		}
+	}
+	return t.request(origClOrdID, r, StatusPendingReplace)
+}
+
+//OnOrderCancelRequest records a cancel request for the order identified by OrigClOrdID
+func (t *Tracker) OnOrderCancelRequest(msg ordercancelrequest.OrderCancelRequest) (Order, error) {
+	origClOrdID, err := msg.GetOrigClOrdID()
+	if err != nil {
+		return Order{}, err
+	}
+	r := Request{Cancel: true}
+	if r.ClOrdID, err = msg.GetClOrdID(); err != nil {
+		return Order{}, err
+	}
+	return t.request(origClOrdID, r, StatusPendingCancel)
+}
+
+func (t *Tracker) request(origClOrdID string, r Request, pending enum.OrdStatus) (Order, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	o, err := t.checkRequest(origClOrdID, r, pending)
+	if err != nil {
+		if o == nil {
+			return Order{}, err
+		}
+		return o.clone(), err
+	}
+	t.addRequest(o, r)
+	return o.clone(), nil
+}
+
+//checkRequest returns the order identified by origClOrdID and an error if the request r cannot be made for it
+func (t *Tracker) checkRequest(origClOrdID string, r Request, pending enum.OrdStatus) (*Order, error) {
+	o, ok := t.orders[origClOrdID]
+	if !ok {
+		return nil, &UnknownOrderError{origClOrdID}
+	}
+	if _, ok := t.orders[r.ClOrdID]; ok {
+		return o, &DuplicateOrderError{r.ClOrdID}
+	}
+	if IsTerminal(o.Status) {
+		return o, &TransitionError{ClOrdID: o.ClOrdID, From: o.Status, To: pending}
+	}
+	return o, nil
+}
+
+func (t *Tracker) addRequest(o *Order, r Request) {
+	o.Pending = &r
+	t.orders[r.ClOrdID] = o
+}
+
+//OnExecutionReport applies an ExecutionReport to the order it refers to, found by ClOrdID, OrigClOrdID or OrderID,
+//or by CrossID and Side for a report on a side of a cross.
+//Reports with an ExecID that has already been applied to the same order are ignored. The report is applied even if it is inconsistent
+//with the order, in which case a *TransitionError or *QuantityError is returned along with the updated order.
+func (t *Tracker) OnExecutionReport(msg executionreport.ExecutionReport) (Order, error) {
+	var r execReport
+	if err := r.read(msg); err != nil {
+		return Order{}, err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	o := t.find(r.ClOrdID, r.OrigClOrdID, r.OrderID)
+	if o == nil {
+		o = t.findSide(r.CrossID, r.OrigCrossID, r.Side)
+	}
+	if o == nil {
+		id := r.ClOrdID
+		if id == "" {
+			id = r.OrderID
+		}
+		return Order{}, &UnknownOrderError{id}
+	}
+	if o.execIDs[r.ExecID] {
+		return o.clone(), nil
+	}
+	if o.execIDs == nil {
+		o.execIDs = make(map[string]bool)
+	}
+	o.execIDs[r.ExecID] = true
+
+	p := o.Pending
+	err := t.apply(o, r)
+	if o.CrossID != "" {
+		t.crosses[o.CrossID].settle(p != nil && o.Pending == nil && o.ClOrdID == p.ClOrdID)
+	}
+	return o.clone(), err
+}
+
+//OnOrderCancelReject clears the pending request that was rejected and restores the OrdStatus reported for the order
+func (t *Tracker) OnOrderCancelReject(msg ordercancelreject.OrderCancelReject) (Order, error) {
+	clOrdID, err := msg.GetClOrdID()
+	if err != nil {
+		return Order{}, err
+	}
+	origClOrdID, err := msg.GetOrigClOrdID()
+	if err != nil {
+		return Order{}, err
+	}
+	orderID, err := msg.GetOrderID()
+	if err != nil {
+		return Order{}, err
+	}
+	status, err := msg.GetOrdStatus()
+	if err != nil {
+		return Order{}, err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	o := t.find(clOrdID, origClOrdID, orderID)
+	if o == nil {
+		return Order{}, &UnknownOrderError{origClOrdID}
+	}
+	if o.Pending != nil && o.Pending.ClOrdID == clOrdID {
+		o.Pending = nil
+	}
+	o.Status = status
+	if o.CrossID != "" {
+		t.crosses[o.CrossID].settle(false)
+	}
+	return o.clone(), nil
+}
+
+func (t *Tracker) find(clOrdID, origClOrdID, orderID string) *Order {
+	if o, ok := t.orders[clOrdID]; ok {
+		return o
+	}
+	if o, ok := t.orders[origClOrdID]; ok {
+		return o
+	}
+	return t.orderIDs[orderID]
+}
+
+func (t *Tracker) apply(o *Order, r execReport) error {
+	var err error
+	if !isCorrection(r.ExecType) && r.OrdStatus != StatusReplaced && !legalTransition(o.Status, r.OrdStatus) {
+		err = &TransitionError{ClOrdID: o.ClOrdID, ExecType: r.ExecType, From: o.Status, To: r.OrdStatus}
+	}
+
+	switch {
+	case r.ExecType == ExecTrade && !r.LastQty.IsPositive():
+		err = firstError(err, r.quantityError(o, "trade without a positive LastQty"))
+	case r.ExecType == ExecTrade && !o.CumQty.Add(r.LastQty).Equal(r.CumQty):
+		err = firstError(err, r.quantityError(o, "CumQty does not equal previous CumQty plus LastQty"))
+	case !isCorrection(r.ExecType) && r.CumQty.LessThan(o.CumQty):
+		err = firstError(err, r.quantityError(o, "CumQty decreased"))
+	}
+
+	if p := o.Pending; p != nil && p.ClOrdID == r.ClOrdID {
+		switch {
+		case r.ExecType == ExecReplaced && !p.Cancel:
+			o.ClOrdID = p.ClOrdID
+			o.ClOrdIDs = append(o.ClOrdIDs, p.ClOrdID)
+			if p.OrderQty.IsPositive() {
+				o.OrderQty = p.OrderQty
+			}
+			if p.Price.IsPositive() {
+				o.Price = p.Price
+			}
+			o.Pending = nil
+		case r.ExecType == ExecCanceled && p.Cancel:
+			o.ClOrdID = p.ClOrdID
+			o.ClOrdIDs = append(o.ClOrdIDs, p.ClOrdID)
+			o.Pending = nil
+		}
+	}
+
+	if o.OrderID == "" && r.OrderID != "" {
+		o.OrderID = r.OrderID
+		t.orderIDs[r.OrderID] = o
+	}
+	if r.OrderQty != nil {
+		o.OrderQty = *r.OrderQty
+	}
+	if r.OrdStatus != StatusReplaced {
+		o.Status = r.OrdStatus
+	}
+	o.CumQty = r.CumQty
+	o.LeavesQty = r.LeavesQty
+	o.AvgPx = r.AvgPx
+	o.LastExecID = r.ExecID
+	if IsTerminal(o.Status) {
+		o.Pending = nil
+	}
+
+	switch {
+	case o.OrderQty.IsPositive() && o.CumQty.GreaterThan(o.OrderQty):
+		err = firstError(err, r.quantityError(o, "CumQty exceeds OrderQty"))
+	case (IsTerminal(o.Status) || o.Status == StatusDoneForDay) && !o.LeavesQty.IsZero():
+		err = firstError(err, r.quantityError(o, "LeavesQty is not zero on a closed order"))
+	case !IsTerminal(o.Status) && o.Status != StatusDoneForDay && o.OrderQty.IsPositive() &&
+		!o.CumQty.Add(o.LeavesQty).Equal(o.OrderQty):
+		err = firstError(err, r.quantityError(o, "CumQty plus LeavesQty does not equal OrderQty"))
+	}
+
+	return err
+}
+
+func firstError(err, next error) error {
+	if err != nil {
+		return err
+	}
+	return next
+}
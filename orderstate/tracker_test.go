@@ -0,0 +1,252 @@
+package orderstate
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/terracefi/enum"
+	"github.com/terracefi/field"
+	"github.com/terracefi/fix44/executionreport"
+	"github.com/terracefi/fix44/newordersingle"
+	"github.com/terracefi/fix44/ordercancelreject"
+	"github.com/terracefi/fix44/ordercancelreplacerequest"
+	"github.com/terracefi/fix44/ordercancelrequest"
+)
+
+const (
+	buy   enum.Side    = "1"
+	limit enum.OrdType = "2"
+)
+
+type step func(t *Tracker) (Order, error)
+
+func newOrder(clOrdID string, qty int64) step {
+	return func(t *Tracker) (Order, error) {
+		msg := newordersingle.New(field.NewClOrdID(clOrdID), field.NewSide(buy), field.NewTransactTime(time.Now()),
+			field.NewOrdType(limit))
+		msg.SetSymbol("ABC")
+		msg.SetOrderQty(decimal.NewFromInt(qty), 0)
+		return t.OnNewOrderSingle(msg)
+	}
+}
+
+func replace(origClOrdID, clOrdID string, qty int64) step {
+	return func(t *Tracker) (Order, error) {
+		msg := ordercancelreplacerequest.New(field.NewOrigClOrdID(origClOrdID), field.NewClOrdID(clOrdID),
+			field.NewSide(buy), field.NewTransactTime(time.Now()), field.NewOrdType(limit))
+		msg.SetOrderQty(decimal.NewFromInt(qty), 0)
+		return t.OnOrderCancelReplaceRequest(msg)
+	}
+}
+
+func cancel(origClOrdID, clOrdID string) step {
+	return func(t *Tracker) (Order, error) {
+		msg := ordercancelrequest.New(field.NewOrigClOrdID(origClOrdID), field.NewClOrdID(clOrdID),
+			field.NewSide(buy), field.NewTransactTime(time.Now()))
+		return t.OnOrderCancelRequest(msg)
+	}
+}
+
+func cancelReject(origClOrdID, clOrdID string, status enum.OrdStatus) step {
+	return func(t *Tracker) (Order, error) {
+		msg := ordercancelreject.New(field.NewOrderID("O1"), field.NewClOrdID(clOrdID),
+			field.NewOrigClOrdID(origClOrdID), field.NewOrdStatus(status), field.NewCxlRejResponseTo("1"))
+		return t.OnOrderCancelReject(msg)
+	}
+}
+
+type report struct {
+	clOrdID, origClOrdID, execID string
+	execType                     enum.ExecType
+	status                       enum.OrdStatus
+	lastQty, cumQty, leavesQty   int64
+}
+
+func (r report) step(t *Tracker) (Order, error) {
+	msg := executionreport.New(field.NewOrderID("O1"), field.NewExecID(r.execID), field.NewExecType(r.execType),
+		field.NewOrdStatus(r.status), field.NewSide(buy), field.NewLeavesQty(decimal.NewFromInt(r.leavesQty), 0),
+		field.NewCumQty(decimal.NewFromInt(r.cumQty), 0), field.NewAvgPx(decimal.NewFromInt(10), 0))
+	if r.clOrdID != "" {
+		msg.SetClOrdID(r.clOrdID)
+	}
+	if r.origClOrdID != "" {
+		msg.SetOrigClOrdID(r.origClOrdID)
+	}
+	if r.lastQty != 0 {
+		msg.SetLastQty(decimal.NewFromInt(r.lastQty), 0)
+	}
+	return t.OnExecutionReport(msg)
+}
+
+func TestTracker(t *testing.T) {
+	ack := report{clOrdID: "A", execID: "E1", execType: ExecNew, status: StatusNew, leavesQty: 100}.step
+
+	tests := []struct {
+		name      string
+		steps     []step
+		wantErr   error
+		clOrdIDs  []string
+		status    enum.OrdStatus
+		cumQty    int64
+		orderQty  int64
+		pending   bool
+		wantFound bool
+	}{
+		{
+			name: "fills",
+			steps: []step{newOrder("A", 100), ack,
+				report{"A", "", "E2", ExecTrade, StatusPartiallyFilled, 40, 40, 60}.step,
+				report{"A", "", "E3", ExecTrade, StatusFilled, 60, 100, 0}.step},
+			clOrdIDs: []string{"A"}, status: StatusFilled, cumQty: 100, orderQty: 100, wantFound: true,
+		},
+		{
+			name:     "duplicate new order",
+			steps:    []step{newOrder("A", 100), newOrder("A", 50)},
+			wantErr:  &DuplicateOrderError{},
+			clOrdIDs: []string{"A"}, status: StatusPendingNew, orderQty: 100, wantFound: true,
+		},
+		{
+			name: "duplicate exec id is ignored",
+			steps: []step{newOrder("A", 100), ack,
+				report{"A", "", "E2", ExecTrade, StatusPartiallyFilled, 40, 40, 60}.step,
+				report{"A", "", "E2", ExecTrade, StatusPartiallyFilled, 40, 40, 60}.step},
+			clOrdIDs: []string{"A"}, status: StatusPartiallyFilled, cumQty: 40, orderQty: 100, wantFound: true,
+		},
+		{
+			name: "exec id of another order is applied",
+			steps: []step{newOrder("B", 100), report{"B", "", "E1", ExecNew, StatusNew, 0, 0, 100}.step,
+				newOrder("A", 100), ack},
+			clOrdIDs: []string{"A"}, status: StatusNew, orderQty: 100, wantFound: true,
+		},
+		{
+			name: "replace then cancel",
+			steps: []step{newOrder("A", 100), ack, replace("A", "B", 200),
+				report{"B", "A", "E2", ExecReplaced, StatusNew, 0, 0, 200}.step,
+				cancel("B", "C"),
+				report{"C", "B", "E3", ExecCanceled, StatusCanceled, 0, 0, 0}.step},
+			clOrdIDs: []string{"A", "B", "C"}, status: StatusCanceled, orderQty: 200, wantFound: true,
+		},
+		{
+			name:     "pending replace",
+			steps:    []step{newOrder("A", 100), ack, replace("A", "B", 200)},
+			clOrdIDs: []string{"A"}, status: StatusNew, orderQty: 100, pending: true, wantFound: true,
+		},
+		{
+			name:     "replace reuses a ClOrdID",
+			steps:    []step{newOrder("A", 100), ack, replace("A", "A", 200)},
+			wantErr:  &DuplicateOrderError{},
+			clOrdIDs: []string{"A"}, status: StatusNew, orderQty: 100, wantFound: true,
+		},
+		{
+			name: "cancel rejected",
+			steps: []step{newOrder("A", 100), ack, cancel("A", "B"),
+				cancelReject("A", "B", StatusNew)},
+			clOrdIDs: []string{"A"}, status: StatusNew, orderQty: 100, wantFound: true,
+		},
+		{
+			name: "cancel a filled order",
+			steps: []step{newOrder("A", 100), ack,
+				report{"A", "", "E2", ExecTrade, StatusFilled, 100, 100, 0}.step,
+				cancel("A", "B")},
+			wantErr:  &TransitionError{},
+			clOrdIDs: []string{"A"}, status: StatusFilled, cumQty: 100, orderQty: 100, wantFound: true,
+		},
+		{
+			name:    "cancel an unknown order",
+			steps:   []step{cancel("A", "B")},
+			wantErr: &UnknownOrderError{},
+		},
+		{
+			name:    "report for an unknown order",
+			steps:   []step{report{"A", "", "E1", ExecNew, StatusNew, 0, 0, 100}.step},
+			wantErr: &UnknownOrderError{},
+		},
+		{
+			name: "illegal transition",
+			steps: []step{newOrder("A", 100), ack,
+				report{"A", "", "E2", ExecTrade, StatusFilled, 100, 100, 0}.step,
+				report{"A", "", "E3", ExecNew, StatusNew, 0, 100, 0}.step},
+			wantErr:  &TransitionError{},
+			clOrdIDs: []string{"A"}, status: StatusNew, cumQty: 100, orderQty: 100, wantFound: true,
+		},
+		{
+			name: "overfill",
+			steps: []step{newOrder("A", 100), ack,
+				report{"A", "", "E2", ExecTrade, StatusFilled, 150, 150, 0}.step},
+			wantErr:  &QuantityError{},
+			clOrdIDs: []string{"A"}, status: StatusFilled, cumQty: 150, orderQty: 100, wantFound: true,
+		},
+		{
+			name: "CumQty does not add up",
+			steps: []step{newOrder("A", 100), ack,
+				report{"A", "", "E2", ExecTrade, StatusPartiallyFilled, 40, 50, 50}.step},
+			wantErr:  &QuantityError{},
+			clOrdIDs: []string{"A"}, status: StatusPartiallyFilled, cumQty: 50, orderQty: 100, wantFound: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tr := New()
+			var err error
+			for i, s := range tt.steps {
+				_, err = s(tr)
+				if i < len(tt.steps)-1 && err != nil {
+					t.Fatalf("step %v: %v", i, err)
+				}
+			}
+			if reflect.TypeOf(err) != reflect.TypeOf(tt.wantErr) {
+				t.Fatalf("error = %v, want %T", err, tt.wantErr)
+			}
+
+			o, ok := tr.Order("A")
+			if ok != tt.wantFound {
+				t.Fatalf("Order() found = %v, want %v", ok, tt.wantFound)
+			}
+			if !ok {
+				return
+			}
+			if !reflect.DeepEqual(o.ClOrdIDs, tt.clOrdIDs) {
+				t.Errorf("ClOrdIDs = %v, want %v", o.ClOrdIDs, tt.clOrdIDs)
+			}
+			if o.ClOrdID != tt.clOrdIDs[len(tt.clOrdIDs)-1] {
+				t.Errorf("ClOrdID = %v, want the last of %v", o.ClOrdID, tt.clOrdIDs)
+			}
+			if o.Status != tt.status {
+				t.Errorf("Status = %v, want %v", o.Status, tt.status)
+			}
+			if !o.CumQty.Equal(decimal.NewFromInt(tt.cumQty)) {
+				t.Errorf("CumQty = %v, want %v", o.CumQty, tt.cumQty)
+			}
+			if !o.OrderQty.Equal(decimal.NewFromInt(tt.orderQty)) {
+				t.Errorf("OrderQty = %v, want %v", o.OrderQty, tt.orderQty)
+			}
+			if (o.Pending != nil) != tt.pending {
+				t.Errorf("Pending = %v, want pending %v", o.Pending, tt.pending)
+			}
+		})
+	}
+}
+
+func TestTrackerOrderByEveryClOrdID(t *testing.T) {
+	tr := New()
+	steps := []step{newOrder("A", 100),
+		report{"A", "", "E1", ExecNew, StatusNew, 0, 0, 100}.step,
+		replace("A", "B", 200),
+		report{"B", "A", "E2", ExecReplaced, StatusNew, 0, 0, 200}.step}
+	for i, s := range steps {
+		if _, err := s(tr); err != nil {
+			t.Fatalf("step %v: %v", i, err)
+		}
+	}
+
+	for _, id := range []string{"A", "B"} {
+		if o, ok := tr.Order(id); !ok || o.ClOrdID != "B" {
+			t.Errorf("Order(%v) = %v, %v, want the replaced order", id, o.ClOrdID, ok)
+		}
+	}
+	if n := len(tr.Orders()); n != 1 {
+		t.Errorf("len(Orders()) = %v, want 1", n)
+	}
+}
@@ -0,0 +1,157 @@
+package positions
+
+import (
+	"sort"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/terracefi/enum"
+)
+
+//QuantityChange is the change of the quantity of one PosType of a position between two business dates
+type QuantityChange struct {
+	Account    string
+	Instrument Instrument
+	PosType    enum.PosType
+	From, To   Quantity
+}
+
+//Net returns the change of the net quantity
+func (c QuantityChange) Net() decimal.Decimal {
+	return c.To.Net().Sub(c.From.Net())
+}
+
+//AmountChange is the change of one PosAmtType of a position between two business dates
+type AmountChange struct {
+	Account    string
+	Instrument Instrument
+	PosAmtType enum.PosAmtType
+	From, To   decimal.Decimal
+}
+
+//CollateralChange is the change of the TotalNetValue of the collateral of an account between two business dates
+type CollateralChange struct {
+	Account  string
+	From, To decimal.Decimal
+}
+
+//Changes is the difference between the views of two business dates, a value missing on one date counts as zero
+type Changes struct {
+	Quantities []QuantityChange
+	Amounts    []AmountChange
+	Collateral []CollateralChange
+}
+
+type positionID struct {
+	account    string
+	instrument Instrument
+}
+
+//Diff returns how the positions and collateral of business date to differ from those of business date from.
+//Changes are ordered by account and instrument, values that did not change are left out.
+func (k *Keeper) Diff(from, to string) Changes {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	positions := make(map[positionID][2]*Position)
+	for key, p := range k.positions {
+		var i int
+		switch key.ClearingBusinessDate {
+		case from:
+			i = 0
+		case to:
+			i = 1
+		default:
+			continue
+		}
+		id := positionID{key.Account, key.Instrument}
+		pair := positions[id]
+		pair[i] = p
+		positions[id] = pair
+	}
+
+	var c Changes
+	for id, pair := range positions {
+		var fromQty, toQty map[enum.PosType]Quantity
+		var fromAmt, toAmt map[enum.PosAmtType]decimal.Decimal
+		if pair[0] != nil {
+			fromQty, fromAmt = pair[0].Quantities, pair[0].Amounts
+		}
+		if pair[1] != nil {
+			toQty, toAmt = pair[1].Quantities, pair[1].Amounts
+		}
+
+		for t := range unionQty(fromQty, toQty) {
+			f, g := fromQty[t], toQty[t]
+			if !f.Long.Equal(g.Long) || !f.Short.Equal(g.Short) {
+				c.Quantities = append(c.Quantities, QuantityChange{id.account, id.instrument, t, f, g})
+			}
+		}
+		for t := range unionAmt(fromAmt, toAmt) {
+			f, g := fromAmt[t], toAmt[t]
+			if !f.Equal(g) {
+				c.Amounts = append(c.Amounts, AmountChange{id.account, id.instrument, t, f, g})
+			}
+		}
+	}
+
+	collateral := make(map[string][2]decimal.Decimal)
+	for key, coll := range k.collateral {
+		if coll.TotalNetValue == nil || (key.clearingBusinessDate != from && key.clearingBusinessDate != to) {
+			continue
+		}
+		pair := collateral[key.account]
+		if key.clearingBusinessDate == from {
+			pair[0] = *coll.TotalNetValue
+		} else {
+			pair[1] = *coll.TotalNetValue
+		}
+		collateral[key.account] = pair
+	}
+	for account, pair := range collateral {
+		if !pair[0].Equal(pair[1]) {
+			c.Collateral = append(c.Collateral, CollateralChange{account, pair[0], pair[1]})
+		}
+	}
+
+	sort.Slice(c.Quantities, func(i, j int) bool {
+		a, b := c.Quantities[i], c.Quantities[j]
+		if a.Account != b.Account || a.Instrument != b.Instrument {
+			return lessKey(Key{Account: a.Account, Instrument: a.Instrument},
+				Key{Account: b.Account, Instrument: b.Instrument})
+		}
+		return a.PosType < b.PosType
+	})
+	sort.Slice(c.Amounts, func(i, j int) bool {
+		a, b := c.Amounts[i], c.Amounts[j]
+		if a.Account != b.Account || a.Instrument != b.Instrument {
+			return lessKey(Key{Account: a.Account, Instrument: a.Instrument},
+				Key{Account: b.Account, Instrument: b.Instrument})
+		}
+		return a.PosAmtType < b.PosAmtType
+	})
+	sort.Slice(c.Collateral, func(i, j int) bool { return c.Collateral[i].Account < c.Collateral[j].Account })
+	return c
+}
+
+func unionQty(a, b map[enum.PosType]Quantity) map[enum.PosType]bool {
+	u := make(map[enum.PosType]bool, len(a)+len(b))
+	for t := range a {
+		u[t] = true
+	}
+	for t := range b {
+		u[t] = true
+	}
+	return u
+}
+
+func unionAmt(a, b map[enum.PosAmtType]decimal.Decimal) map[enum.PosAmtType]bool {
+	u := make(map[enum.PosAmtType]bool, len(a)+len(b))
+	for t := range a {
+		u[t] = true
+	}
+	for t := range b {
+		u[t] = true
+	}
+	return u
+}
@@ -0,0 +1,16 @@
+/*
+Package positions keeps position and collateral views from the position management and collateral messages of a
+clearing firm.
+
+A Keeper holds one Position per ClearingBusinessDate, account and instrument. A PositionReport replaces the
+quantities and amounts of its position, and a PositionMaintenanceReport adjusts them as told by its AdjustmentType.
+Maintenance is replaced or canceled by the reports whose OrigPosReqRefID names it, and maintenance for a business date
+without a position yet rolls the position of the latest earlier business date over.
+Collateral is held per ClearingBusinessDate and account and is replaced by each CollateralReport.
+
+Reports are matched to the requests that asked for them: PositionReports by PosReqID to a RequestForPositions,
+CollateralReports by CollInquiryID to a CollateralInquiry, and CollateralAssignments and CollateralResponses by
+CollReqID to a CollateralRequest. Reports without a request, such as unsolicited position reports, are applied the
+same way. Diff shows how the views of two business dates differ.
+*/
+package positions
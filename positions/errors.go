@@ -0,0 +1,25 @@
+package positions
+
+import (
+	"fmt"
+)
+
+//UnknownRequestError is returned for a message that refers to a request the Keeper does not know
+type UnknownRequestError struct {
+	Kind RequestKind
+	ID   string
+}
+
+func (e *UnknownRequestError) Error() string {
+	return fmt.Sprintf("positions: unknown %v %v", e.Kind, e.ID)
+}
+
+//DuplicateRequestError is returned for a request that reuses the ID of an earlier request
+type DuplicateRequestError struct {
+	Kind RequestKind
+	ID   string
+}
+
+func (e *DuplicateRequestError) Error() string {
+	return fmt.Sprintf("positions: duplicate %v %v", e.Kind, e.ID)
+}
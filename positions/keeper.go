@@ -0,0 +1,370 @@
+package positions
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/terracefi/fix44/collateralassignment"
+	"github.com/terracefi/fix44/collateralinquiry"
+	"github.com/terracefi/fix44/collateralinquiryack"
+	"github.com/terracefi/fix44/collateralreport"
+	"github.com/terracefi/fix44/collateralrequest"
+	"github.com/terracefi/fix44/collateralresponse"
+	"github.com/terracefi/fix44/internal/fixutil"
+	"github.com/terracefi/fix44/positionmaintenancereport"
+	"github.com/terracefi/fix44/positionreport"
+	"github.com/terracefi/fix44/requestforpositions"
+	"github.com/terracefi/fix44/requestforpositionsack"
+	"github.com/terracefi/quickfix"
+	"github.com/terracefi/tag"
+)
+
+//Keeper keeps positions, collateral and the requests for them. It is safe for concurrent use.
+type Keeper struct {
+	mu          sync.Mutex
+	positions   map[Key]*Position
+	collateral  map[collateralKey]*Collateral
+	requests    map[requestKey]*Request
+	assignments map[string]*Request
+}
+
+//New returns an empty Keeper
+func New() *Keeper {
+	return &Keeper{
+		positions:   make(map[Key]*Position),
+		collateral:  make(map[collateralKey]*Collateral),
+		requests:    make(map[requestKey]*Request),
+		assignments: make(map[string]*Request),
+	}
+}
+
+//Position returns the position with the given key
+func (k *Keeper) Position(key Key) (Position, bool) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	p, ok := k.positions[key]
+	if !ok {
+		return Position{}, false
+	}
+	return p.clone(), true
+}
+
+//Positions returns the positions of a business date, ordered by account and instrument
+func (k *Keeper) Positions(clearingBusinessDate string) []Position {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	var out []Position
+	for key, p := range k.positions {
+		if key.ClearingBusinessDate == clearingBusinessDate {
+			out = append(out, p.clone())
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return lessKey(out[i].Key, out[j].Key) })
+	return out
+}
+
+//Collateral returns the collateral of an account on a business date
+func (k *Keeper) Collateral(clearingBusinessDate, account string) (Collateral, bool) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	c, ok := k.collateral[collateralKey{clearingBusinessDate, account}]
+	if !ok {
+		return Collateral{}, false
+	}
+	return *c, true
+}
+
+//Collaterals returns the collateral of every account on a business date, ordered by account
+func (k *Keeper) Collaterals(clearingBusinessDate string) []Collateral {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	var out []Collateral
+	for key, c := range k.collateral {
+		if key.clearingBusinessDate == clearingBusinessDate {
+			out = append(out, *c)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Account < out[j].Account })
+	return out
+}
+
+//Request returns the request of the given kind and ID
+func (k *Keeper) Request(kind RequestKind, id string) (Request, bool) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	r, ok := k.requests[requestKey{kind, id}]
+	if !ok {
+		return Request{}, false
+	}
+	return r.clone(), true
+}
+
+func (k *Keeper) addRequest(kind RequestKind, id string) (Request, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	key := requestKey{kind, id}
+	if _, ok := k.requests[key]; ok {
+		return Request{}, &DuplicateRequestError{kind, id}
+	}
+	r := &Request{Kind: kind, ID: id}
+	k.requests[key] = r
+	return r.clone(), nil
+}
+
+//OnRequestForPositions records a RequestForPositions sent to the clearing firm
+func (k *Keeper) OnRequestForPositions(msg requestforpositions.RequestForPositions) (Request, error) {
+	id, err := msg.GetPosReqID()
+	if err != nil {
+		return Request{}, err
+	}
+	return k.addRequest(KindPositions, id)
+}
+
+//OnRequestForPositionsAck applies a RequestForPositionsAck to the request of its PosReqID
+func (k *Keeper) OnRequestForPositionsAck(msg requestforpositionsack.RequestForPositionsAck) (Request, error) {
+	ack, err := requestforpositionsack.Marshal(msg)
+	if err != nil {
+		return Request{}, err
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	id := fixutil.Deref(ack.PosReqID)
+	r, ok := k.requests[requestKey{KindPositions, id}]
+	if !ok {
+		return Request{}, &UnknownRequestError{KindPositions, id}
+	}
+	r.Acked = true
+	r.Result, r.Status = string(ack.PosReqResult), string(ack.PosReqStatus)
+	r.Text = fixutil.Deref(ack.Text)
+	r.TotalReports = ack.TotalNumPosReports
+	return r.clone(), nil
+}
+
+//OnPositionReport applies a PositionReport to the position it reports. A report with a PosReqID that is not
+//unsolicited must answer a known request, a report whose PosReqResult is not valid only counts towards it.
+func (k *Keeper) OnPositionReport(msg positionreport.PositionReport) (Position, error) {
+	r, err := positionreport.Marshal(msg)
+	if err != nil {
+		return Position{}, err
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	unsolicited := r.UnsolicitedIndicator != nil && *r.UnsolicitedIndicator
+	if r.PosReqID != nil && !unsolicited {
+		req, ok := k.requests[requestKey{KindPositions, *r.PosReqID}]
+		if !ok {
+			return Position{}, &UnknownRequestError{KindPositions, *r.PosReqID}
+		}
+		req.ReportIDs = append(req.ReportIDs, r.PosMaintRptID)
+	}
+
+	key := Key{r.ClearingBusinessDate, r.Account, instrument(r.Symbol, r.SecurityID, r.SecurityIDSource)}
+	p, ok := k.positions[key]
+	if r.PosReqResult != ResultValid {
+		if !ok {
+			return Position{Key: key}, nil
+		}
+		return p.clone(), nil
+	}
+	if !ok {
+		p = newPosition(key)
+		k.positions[key] = p
+	}
+	p.applyReport(r)
+	return p.clone(), nil
+}
+
+//OnPositionMaintenanceReport applies an accepted or completed PositionMaintenanceReport to the position it adjusts,
+//rejected reports are ignored. A New report adjusts the position, Replace and Cancel reports replace or undo the
+//adjustment of the report whose PosReqID is their OrigPosReqRefID. A business date without a position yet starts
+//from the position of the latest earlier business date.
+func (k *Keeper) OnPositionMaintenanceReport(
+	msg positionmaintenancereport.PositionMaintenanceReport,
+) (Position, error) {
+	r, err := positionmaintenancereport.Marshal(msg)
+	if err != nil {
+		return Position{}, err
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	key := Key{r.ClearingBusinessDate, r.Account, instrument(r.Symbol, r.SecurityID, r.SecurityIDSource)}
+	p, ok := k.positions[key]
+	if r.PosMaintStatus == MaintRejected {
+		if !ok {
+			return Position{Key: key}, nil
+		}
+		return p.clone(), nil
+	}
+	if !ok {
+		p = k.newPosition(key)
+		k.positions[key] = p
+	}
+	p.applyMaintenance(r)
+	return p.clone(), nil
+}
+
+//newPosition returns a position for key, carried over from the latest earlier business date if there is one
+func (k *Keeper) newPosition(key Key) *Position {
+	var prev *Position
+	for pk, p := range k.positions {
+		if pk.Account == key.Account && pk.Instrument == key.Instrument &&
+			pk.ClearingBusinessDate < key.ClearingBusinessDate &&
+			(prev == nil || pk.ClearingBusinessDate > prev.ClearingBusinessDate) {
+			prev = p
+		}
+	}
+	if prev == nil {
+		return newPosition(key)
+	}
+	return prev.carryOver(key)
+}
+
+//OnCollateralInquiry records a CollateralInquiry sent to the clearing firm
+func (k *Keeper) OnCollateralInquiry(msg collateralinquiry.CollateralInquiry) (Request, error) {
+	if !msg.HasCollInquiryID() {
+		return Request{}, quickfix.RequiredTagMissing(tag.CollInquiryID)
+	}
+	id, err := msg.GetCollInquiryID()
+	if err != nil {
+		return Request{}, err
+	}
+	return k.addRequest(KindCollateralInquiry, id)
+}
+
+//OnCollateralInquiryAck applies a CollateralInquiryAck to the inquiry of its CollInquiryID
+func (k *Keeper) OnCollateralInquiryAck(msg collateralinquiryack.CollateralInquiryAck) (Request, error) {
+	ack, err := collateralinquiryack.Marshal(msg)
+	if err != nil {
+		return Request{}, err
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	r, ok := k.requests[requestKey{KindCollateralInquiry, ack.CollInquiryID}]
+	if !ok {
+		return Request{}, &UnknownRequestError{KindCollateralInquiry, ack.CollInquiryID}
+	}
+	r.Acked = true
+	r.Status = string(ack.CollInquiryStatus)
+	if ack.CollInquiryResult != nil {
+		r.Result = string(*ack.CollInquiryResult)
+	}
+	r.Text = fixutil.Deref(ack.Text)
+	r.TotalReports = ack.TotNumReports
+	return r.clone(), nil
+}
+
+//OnCollateralReport replaces the collateral of the account and business date of a CollateralReport. A report with
+//a CollInquiryID must answer a known inquiry.
+func (k *Keeper) OnCollateralReport(msg collateralreport.CollateralReport) (Collateral, error) {
+	r, err := collateralreport.Marshal(msg)
+	if err != nil {
+		return Collateral{}, err
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	if r.CollInquiryID != nil {
+		req, ok := k.requests[requestKey{KindCollateralInquiry, *r.CollInquiryID}]
+		if !ok {
+			return Collateral{}, &UnknownRequestError{KindCollateralInquiry, *r.CollInquiryID}
+		}
+		req.ReportIDs = append(req.ReportIDs, r.CollRptID)
+		if r.LastRptRequested != nil && *r.LastRptRequested {
+			req.LastReceived = true
+		}
+	}
+
+	c := newCollateral(r)
+	k.collateral[collateralKey{c.ClearingBusinessDate, c.Account}] = c
+	return *c, nil
+}
+
+//OnCollateralRequest records a CollateralRequest received from the clearing firm
+func (k *Keeper) OnCollateralRequest(msg collateralrequest.CollateralRequest) (Request, error) {
+	id, err := msg.GetCollReqID()
+	if err != nil {
+		return Request{}, err
+	}
+	return k.addRequest(KindCollateralRequest, id)
+}
+
+//OnCollateralAssignment adds the CollAsgnID of a CollateralAssignment to the request of its CollReqID. Assignments
+//made without a CollateralRequest are not tracked.
+func (k *Keeper) OnCollateralAssignment(msg collateralassignment.CollateralAssignment) (Request, error) {
+	a, err := collateralassignment.Marshal(msg)
+	if err != nil {
+		return Request{}, err
+	}
+	if a.CollReqID == nil {
+		return Request{}, nil
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	r, ok := k.requests[requestKey{KindCollateralRequest, *a.CollReqID}]
+	if !ok {
+		return Request{}, &UnknownRequestError{KindCollateralRequest, *a.CollReqID}
+	}
+	r.ReportIDs = append(r.ReportIDs, a.CollAsgnID)
+	k.assignments[a.CollAsgnID] = r
+	return r.clone(), nil
+}
+
+//OnCollateralResponse applies a CollateralResponse to the request of its CollReqID, or of the assignment named by
+//its CollAsgnID
+func (k *Keeper) OnCollateralResponse(msg collateralresponse.CollateralResponse) (Request, error) {
+	resp, err := collateralresponse.Marshal(msg)
+	if err != nil {
+		return Request{}, err
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	r, ok := k.assignments[resp.CollAsgnID]
+	if resp.CollReqID != nil {
+		r, ok = k.requests[requestKey{KindCollateralRequest, *resp.CollReqID}]
+	}
+	if !ok {
+		if resp.CollReqID != nil {
+			return Request{}, &UnknownRequestError{KindCollateralRequest, *resp.CollReqID}
+		}
+		return Request{}, nil
+	}
+	r.Acked = true
+	r.Result = string(resp.CollAsgnRespType)
+	r.Status = ""
+	if resp.CollAsgnRejectReason != nil {
+		r.Status = string(*resp.CollAsgnRejectReason)
+	}
+	r.Text = fixutil.Deref(resp.Text)
+	return r.clone(), nil
+}
+
+func lessKey(a, b Key) bool {
+	switch {
+	case a.Account != b.Account:
+		return a.Account < b.Account
+	case a.Instrument.Symbol != b.Instrument.Symbol:
+		return a.Instrument.Symbol < b.Instrument.Symbol
+	case a.Instrument.SecurityID != b.Instrument.SecurityID:
+		return a.Instrument.SecurityID < b.Instrument.SecurityID
+	}
+	return a.Instrument.SecurityIDSource < b.Instrument.SecurityIDSource
+}
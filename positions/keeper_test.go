@@ -0,0 +1,188 @@
+package positions
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/terracefi/enum"
+	"github.com/terracefi/fix44/internal/fixutil"
+	"github.com/terracefi/fix44/positionmaintenancereport"
+	"github.com/terracefi/fix44/positionreport"
+)
+
+const (
+	tradeQty enum.PosType    = "TQ"
+	variance enum.PosAmtType = "FMTM"
+)
+
+var abc = Instrument{Symbol: "ABC"}
+
+type step func(k *Keeper) (Position, error)
+
+func reported(date, rptID string, long int64) step {
+	return func(k *Keeper) (Position, error) {
+		return k.OnPositionReport(positionreport.Unmarshal(positionreport.Struct{
+			Account:              "ACC",
+			AccountType:          "1",
+			ClearingBusinessDate: date,
+			PosMaintRptID:        rptID,
+			PosReqResult:         ResultValid,
+			SettlPrice:           decimal.NewFromInt(10),
+			SettlPriceType:       "1",
+			PriorSettlPrice:      decimal.NewFromInt(9),
+			Symbol:               fixutil.Ptr("ABC"),
+			NoPositions: []positionreport.NoPositionsStruct{
+				{PosType: fixutil.Ptr(tradeQty), LongQty: fixutil.Ptr(decimal.NewFromInt(long))}},
+			NoPosAmt: []positionreport.NoPosAmtStruct{
+				{PosAmtType: fixutil.Ptr(variance), PosAmt: fixutil.Ptr(decimal.NewFromInt(long * 10))}},
+		}))
+	}
+}
+
+type maintenance struct {
+	date, rptID, posReqID, origPosReqRefID string
+	action                                 enum.PosMaintAction
+	adjust                                 enum.AdjustmentType
+	long                                   int64
+	status                                 enum.PosMaintStatus
+}
+
+func (m maintenance) step(k *Keeper) (Position, error) {
+	return k.OnPositionMaintenanceReport(positionmaintenancereport.Unmarshal(positionmaintenancereport.Struct{
+		Account:              "ACC",
+		AccountType:          "1",
+		ClearingBusinessDate: m.date,
+		PosMaintRptID:        m.rptID,
+		PosReqID:             fixutil.Ptr(m.posReqID),
+		OrigPosReqRefID:      m.origPosReqRefID,
+		PosTransType:         "1",
+		PosMaintAction:       m.action,
+		PosMaintStatus:       m.status,
+		AdjustmentType:       fixutil.Ptr(m.adjust),
+		TransactTime:         time.Date(2024, 3, 1, 9, 30, 0, 0, time.UTC),
+		Symbol:               fixutil.Ptr("ABC"),
+		NoPositions: []positionmaintenancereport.NoPositionsStruct{
+			{PosType: fixutil.Ptr(tradeQty), LongQty: fixutil.Ptr(decimal.NewFromInt(m.long))}},
+	}))
+}
+
+func adjusted(rptID, posReqID string, adjust enum.AdjustmentType, long int64) step {
+	return maintenance{"D1", rptID, posReqID, posReqID, ActionNew, adjust, long, MaintAccepted}.step
+}
+
+func replaced(rptID, posReqID, origPosReqRefID string, long int64) step {
+	return maintenance{"D1", rptID, posReqID, origPosReqRefID, ActionReplace, AdjustDeltaPlus, long,
+		MaintCompleted}.step
+}
+
+func canceled(rptID, origPosReqRefID string) step {
+	return maintenance{"D1", rptID, rptID, origPosReqRefID, ActionCancel, AdjustDeltaPlus, 0, MaintAccepted}.step
+}
+
+func run(t *testing.T, k *Keeper, steps ...step) {
+	t.Helper()
+	for i, s := range steps {
+		if _, err := s(k); err != nil {
+			t.Fatalf("step %v: %v", i, err)
+		}
+	}
+}
+
+func longQty(t *testing.T, k *Keeper, date string) int64 {
+	t.Helper()
+	p, ok := k.Position(Key{date, "ACC", abc})
+	if !ok {
+		t.Fatalf("Position(%v) not found", date)
+	}
+	return p.Quantities[tradeQty].Long.IntPart()
+}
+
+func TestMaintenance(t *testing.T) {
+	tests := []struct {
+		name   string
+		steps  []step
+		want   int64
+		rptIDs int
+	}{
+		{"report", []step{reported("D1", "R1", 100)}, 100, 1},
+		{"report replaces report", []step{reported("D1", "R1", 100), reported("D1", "R2", 80)}, 80, 2},
+		{"delta plus", []step{reported("D1", "R1", 100), adjusted("M1", "P1", AdjustDeltaPlus, 10)}, 110, 2},
+		{"delta minus", []step{reported("D1", "R1", 100), adjusted("M1", "P1", AdjustDeltaMinus, 10)}, 90, 2},
+		{"final", []step{reported("D1", "R1", 100), adjusted("M1", "P1", AdjustFinal, 70)}, 70, 2},
+		{"margin disposition", []step{reported("D1", "R1", 100),
+			adjusted("M1", "P1", AdjustMarginDisposition, 10)}, 100, 2},
+		{"rejected", []step{reported("D1", "R1", 100),
+			maintenance{"D1", "M1", "P1", "P1", ActionNew, AdjustDeltaPlus, 10, MaintRejected}.step}, 100, 1},
+		{"replace", []step{reported("D1", "R1", 100), adjusted("M1", "P1", AdjustDeltaPlus, 10),
+			replaced("M2", "P2", "P1", 20)}, 120, 3},
+		{"replace before a final", []step{reported("D1", "R1", 100), adjusted("M1", "P1", AdjustDeltaPlus, 10),
+			adjusted("M2", "P2", AdjustFinal, 50), replaced("M3", "P3", "P1", 30)}, 50, 4},
+		{"cancel", []step{reported("D1", "R1", 100), adjusted("M1", "P1", AdjustDeltaPlus, 10),
+			adjusted("M2", "P2", AdjustDeltaPlus, 5), canceled("M3", "P1")}, 105, 4},
+		{"cancel a replacement", []step{reported("D1", "R1", 100), adjusted("M1", "P1", AdjustDeltaPlus, 10),
+			replaced("M2", "P2", "P1", 20), canceled("M3", "P2")}, 100, 4},
+		{"cancel of an unknown adjustment", []step{reported("D1", "R1", 100), canceled("M1", "P9")}, 100, 2},
+		{"cancel of an adjustment before the last report", []step{reported("D1", "R1", 100),
+			adjusted("M1", "P1", AdjustDeltaPlus, 10), reported("D1", "R2", 80), canceled("M2", "P1")}, 80, 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k := New()
+			run(t, k, tt.steps...)
+			if got := longQty(t, k, "D1"); got != tt.want {
+				t.Errorf("LongQty = %v, want %v", got, tt.want)
+			}
+			if p, _ := k.Position(Key{"D1", "ACC", abc}); len(p.PosMaintRptIDs) != tt.rptIDs {
+				t.Errorf("PosMaintRptIDs = %v, want %v of them", p.PosMaintRptIDs, tt.rptIDs)
+			}
+		})
+	}
+}
+
+func TestRollover(t *testing.T) {
+	k := New()
+	run(t, k, reported("D1", "R1", 100),
+		maintenance{"D2", "M1", "P1", "P1", ActionNew, AdjustDeltaPlus, 10, MaintAccepted}.step)
+
+	if got := longQty(t, k, "D1"); got != 100 {
+		t.Errorf("D1 LongQty = %v, want 100", got)
+	}
+	if got := longQty(t, k, "D2"); got != 110 {
+		t.Errorf("D2 LongQty = %v, want 110 rolled over from D1", got)
+	}
+	p, _ := k.Position(Key{"D2", "ACC", abc})
+	if !p.Amounts[variance].Equal(decimal.NewFromInt(1000)) {
+		t.Errorf("D2 amount = %v, want 1000 rolled over from D1", p.Amounts[variance])
+	}
+	if p.PriorSettlPrice == nil || !p.PriorSettlPrice.Equal(decimal.NewFromInt(10)) {
+		t.Errorf("D2 PriorSettlPrice = %v, want the D1 SettlPrice 10", p.PriorSettlPrice)
+	}
+
+	run(t, k, canceled("M2", "P1"),
+		maintenance{"D2", "M3", "P1", "P1", ActionCancel, AdjustDeltaPlus, 0, MaintAccepted}.step)
+	if got := longQty(t, k, "D2"); got != 100 {
+		t.Errorf("D2 LongQty after the cancel = %v, want 100", got)
+	}
+	if got := longQty(t, k, "D1"); got != 100 {
+		t.Errorf("D1 LongQty after a cancel for D2 = %v, want 100", got)
+	}
+
+	run(t, k, reported("D2", "R2", 90), reported("D3", "R3", 120),
+		maintenance{"D4", "M4", "P4", "P4", ActionNew, AdjustDeltaMinus, 20, MaintAccepted}.step,
+		maintenance{"D0", "M5", "P5", "P5", ActionNew, AdjustDeltaPlus, 5, MaintAccepted}.step)
+	for date, want := range map[string]int64{"D0": 5, "D1": 100, "D2": 90, "D3": 120, "D4": 100} {
+		if got := longQty(t, k, date); got != want {
+			t.Errorf("%v LongQty = %v, want %v", date, got, want)
+		}
+	}
+	if n := len(k.Positions("D4")); n != 1 {
+		t.Errorf("len(Positions(D4)) = %v, want 1", n)
+	}
+
+	c := k.Diff("D1", "D2")
+	if len(c.Quantities) != 1 || c.Quantities[0].Net().IntPart() != -10 {
+		t.Errorf("Diff(D1, D2).Quantities = %+v, want one change of -10", c.Quantities)
+	}
+}
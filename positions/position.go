@@ -0,0 +1,281 @@
+package positions
+
+import (
+	"github.com/shopspring/decimal"
+
+	"github.com/terracefi/enum"
+	"github.com/terracefi/fix44/collateralreport"
+	"github.com/terracefi/fix44/internal/fixutil"
+	"github.com/terracefi/fix44/positionmaintenancereport"
+	"github.com/terracefi/fix44/positionreport"
+)
+
+//PosReqResult values, FIX 4.4
+const (
+	ResultValid enum.PosReqResult = "0"
+)
+
+//PosMaintStatus values, FIX 4.4
+const (
+	MaintAccepted              enum.PosMaintStatus = "0"
+	MaintAcceptedWithWarnings  enum.PosMaintStatus = "1"
+	MaintRejected              enum.PosMaintStatus = "2"
+	MaintCompleted             enum.PosMaintStatus = "3"
+	MaintCompletedWithWarnings enum.PosMaintStatus = "4"
+)
+
+//PosMaintAction values, FIX 4.4
+const (
+	ActionNew     enum.PosMaintAction = "1"
+	ActionReplace enum.PosMaintAction = "2"
+	ActionCancel  enum.PosMaintAction = "3"
+)
+
+//AdjustmentType values, FIX 4.4
+const (
+	AdjustMarginDisposition enum.AdjustmentType = "0"
+	AdjustDeltaPlus         enum.AdjustmentType = "1"
+	AdjustDeltaMinus        enum.AdjustmentType = "2"
+	AdjustFinal             enum.AdjustmentType = "3"
+)
+
+//Instrument identifies the instrument of a position
+type Instrument struct {
+	Symbol           string
+	SecurityID       string
+	SecurityIDSource enum.SecurityIDSource
+}
+
+func instrument(symbol, securityID *string, securityIDSource *enum.SecurityIDSource) Instrument {
+	i := Instrument{Symbol: fixutil.Deref(symbol), SecurityID: fixutil.Deref(securityID)}
+	if securityIDSource != nil {
+		i.SecurityIDSource = *securityIDSource
+	}
+	return i
+}
+
+//Key identifies a position
+type Key struct {
+	ClearingBusinessDate string
+	Account              string
+	Instrument           Instrument
+}
+
+//Quantity is the long and short quantity of one PosType
+type Quantity struct {
+	Long  decimal.Decimal
+	Short decimal.Decimal
+}
+
+//Net returns Long less Short
+func (q Quantity) Net() decimal.Decimal {
+	return q.Long.Sub(q.Short)
+}
+
+//Position is the position of an account in an instrument on a business date
+type Position struct {
+	Key
+
+	Currency        string
+	SettlPrice      *decimal.Decimal
+	PriorSettlPrice *decimal.Decimal
+
+	//Quantities holds the quantities of the position keyed by PosType, Amounts its amounts keyed by PosAmtType
+	Quantities map[enum.PosType]Quantity
+	Amounts    map[enum.PosAmtType]decimal.Decimal
+
+	//PosMaintRptIDs holds the PosMaintRptIDs of the reports applied to the position, in the order they arrived
+	PosMaintRptIDs []string
+
+	//baseQuantities and baseAmounts are those of the last PositionReport, or of the position carried over from an
+	//earlier business date, adjustments the maintenance in force since, oldest first
+	baseQuantities map[enum.PosType]Quantity
+	baseAmounts    map[enum.PosAmtType]decimal.Decimal
+	adjustments    []positionmaintenancereport.Struct
+}
+
+func newPosition(k Key) *Position {
+	return &Position{
+		Key:            k,
+		Quantities:     make(map[enum.PosType]Quantity),
+		Amounts:        make(map[enum.PosAmtType]decimal.Decimal),
+		baseQuantities: make(map[enum.PosType]Quantity),
+		baseAmounts:    make(map[enum.PosAmtType]decimal.Decimal),
+	}
+}
+
+//carryOver returns a position for key that starts from the quantities and amounts of p, a position of an earlier
+//business date
+func (p *Position) carryOver(key Key) *Position {
+	c := newPosition(key)
+	c.Currency = p.Currency
+	c.PriorSettlPrice = p.SettlPrice
+	c.baseQuantities, c.baseAmounts = copyQuantities(p.Quantities), copyAmounts(p.Amounts)
+	c.recompute()
+	return c
+}
+
+func (p *Position) clone() Position {
+	c := *p
+	c.Quantities = copyQuantities(p.Quantities)
+	c.Amounts = copyAmounts(p.Amounts)
+	c.PosMaintRptIDs = append([]string(nil), p.PosMaintRptIDs...)
+	c.baseQuantities, c.baseAmounts, c.adjustments = nil, nil, nil
+	return c
+}
+
+func copyQuantities(m map[enum.PosType]Quantity) map[enum.PosType]Quantity {
+	c := make(map[enum.PosType]Quantity, len(m))
+	for k, v := range m {
+		c[k] = v
+	}
+	return c
+}
+
+func copyAmounts(m map[enum.PosAmtType]decimal.Decimal) map[enum.PosAmtType]decimal.Decimal {
+	c := make(map[enum.PosAmtType]decimal.Decimal, len(m))
+	for k, v := range m {
+		c[k] = v
+	}
+	return c
+}
+
+//applyReport makes the quantities and amounts of a PositionReport those of the position, dropping the maintenance
+//applied before it
+func (p *Position) applyReport(r positionreport.Struct) {
+	p.Currency = fixutil.Deref(r.Currency)
+	settl, prior := r.SettlPrice, r.PriorSettlPrice
+	p.SettlPrice, p.PriorSettlPrice = &settl, &prior
+
+	p.baseQuantities = make(map[enum.PosType]Quantity, len(r.NoPositions))
+	for _, n := range r.NoPositions {
+		if n.PosType != nil {
+			p.baseQuantities[*n.PosType] = quantity(n.LongQty, n.ShortQty)
+		}
+	}
+	p.baseAmounts = make(map[enum.PosAmtType]decimal.Decimal, len(r.NoPosAmt))
+	for _, n := range r.NoPosAmt {
+		if n.PosAmtType != nil && n.PosAmt != nil {
+			p.baseAmounts[*n.PosAmtType] = *n.PosAmt
+		}
+	}
+	p.adjustments = nil
+	p.recompute()
+	p.PosMaintRptIDs = append(p.PosMaintRptIDs, r.PosMaintRptID)
+}
+
+//applyMaintenance applies an accepted PositionMaintenanceReport. A New report adds its adjustment, a Replace report
+//takes the place of the adjustment whose PosReqID is its OrigPosReqRefID and a Cancel report removes that
+//adjustment. A Replace or Cancel of an adjustment that is not in force, for example one made before the last
+//PositionReport, is only recorded.
+func (p *Position) applyMaintenance(r positionmaintenancereport.Struct) {
+	p.PosMaintRptIDs = append(p.PosMaintRptIDs, r.PosMaintRptID)
+	if r.PosMaintAction == ActionNew {
+		p.adjustments = append(p.adjustments, r)
+		p.recompute()
+		return
+	}
+
+	for i, a := range p.adjustments {
+		if fixutil.Deref(a.PosReqID) != r.OrigPosReqRefID {
+			continue
+		}
+		if r.PosMaintAction == ActionReplace {
+			p.adjustments[i] = r
+		} else if r.PosMaintAction == ActionCancel {
+			p.adjustments = append(p.adjustments[:i:i], p.adjustments[i+1:]...)
+		}
+		p.recompute()
+		return
+	}
+}
+
+//recompute sets the quantities and amounts to the base ones adjusted by the maintenance in force
+func (p *Position) recompute() {
+	p.Quantities, p.Amounts = copyQuantities(p.baseQuantities), copyAmounts(p.baseAmounts)
+	for _, a := range p.adjustments {
+		p.adjust(a)
+	}
+}
+
+//adjust adjusts the position by a PositionMaintenanceReport. DeltaPlus adds its quantities and amounts, DeltaMinus
+//subtracts them and Final sets them, other adjustments leave the position as it is.
+func (p *Position) adjust(r positionmaintenancereport.Struct) {
+	if r.AdjustmentType == nil {
+		return
+	}
+
+	adjust := *r.AdjustmentType
+	for _, n := range r.NoPositions {
+		if n.PosType == nil {
+			continue
+		}
+		q, cur := quantity(n.LongQty, n.ShortQty), p.Quantities[*n.PosType]
+		switch adjust {
+		case AdjustDeltaPlus:
+			p.Quantities[*n.PosType] = Quantity{cur.Long.Add(q.Long), cur.Short.Add(q.Short)}
+		case AdjustDeltaMinus:
+			p.Quantities[*n.PosType] = Quantity{cur.Long.Sub(q.Long), cur.Short.Sub(q.Short)}
+		case AdjustFinal:
+			p.Quantities[*n.PosType] = q
+		}
+	}
+	for _, n := range r.NoPosAmt {
+		if n.PosAmtType == nil || n.PosAmt == nil {
+			continue
+		}
+		cur := p.Amounts[*n.PosAmtType]
+		switch adjust {
+		case AdjustDeltaPlus:
+			p.Amounts[*n.PosAmtType] = cur.Add(*n.PosAmt)
+		case AdjustDeltaMinus:
+			p.Amounts[*n.PosAmtType] = cur.Sub(*n.PosAmt)
+		case AdjustFinal:
+			p.Amounts[*n.PosAmtType] = *n.PosAmt
+		}
+	}
+}
+
+func quantity(long, short *decimal.Decimal) Quantity {
+	var q Quantity
+	if long != nil {
+		q.Long = *long
+	}
+	if short != nil {
+		q.Short = *short
+	}
+	return q
+}
+
+//Collateral is the collateral of an account on a business date, as told by the last CollateralReport
+type Collateral struct {
+	ClearingBusinessDate string
+	Account              string
+
+	CollRptID       string
+	CollStatus      enum.CollStatus
+	Currency        string
+	TotalNetValue   *decimal.Decimal
+	CashOutstanding *decimal.Decimal
+
+	//Report is the last CollateralReport, its NoUnderlyings holds the collateral instruments
+	Report collateralreport.Struct
+}
+
+type collateralKey struct {
+	clearingBusinessDate string
+	account              string
+}
+
+func newCollateral(r collateralreport.Struct) *Collateral {
+	return &Collateral{
+		ClearingBusinessDate: fixutil.Deref(r.ClearingBusinessDate),
+		Account:              fixutil.Deref(r.Account),
+		CollRptID:            r.CollRptID,
+		CollStatus:           r.CollStatus,
+		Currency:             fixutil.Deref(r.Currency),
+		TotalNetValue:        r.TotalNetValue,
+		CashOutstanding:      r.CashOutstanding,
+		Report:               r,
+	}
+}
@@ -0,0 +1,65 @@
+package positions
+
+//RequestKind tells which request a Request is
+type RequestKind int
+
+//RequestKind values
+const (
+	//KindPositions is a RequestForPositions, identified by PosReqID
+	KindPositions RequestKind = iota
+	//KindCollateralInquiry is a CollateralInquiry, identified by CollInquiryID
+	KindCollateralInquiry
+	//KindCollateralRequest is a CollateralRequest, identified by CollReqID
+	KindCollateralRequest
+)
+
+func (k RequestKind) String() string {
+	switch k {
+	case KindPositions:
+		return "PosReqID"
+	case KindCollateralInquiry:
+		return "CollInquiryID"
+	case KindCollateralRequest:
+		return "CollReqID"
+	}
+	return "Unknown"
+}
+
+type requestKey struct {
+	kind RequestKind
+	id   string
+}
+
+//Request is a request sent to the clearing firm and the answers received for it
+type Request struct {
+	Kind RequestKind
+	ID   string
+
+	//Acked is true once the request has been answered. Result and Status hold the wire values of PosReqResult and
+	//PosReqStatus of a RequestForPositionsAck, CollInquiryResult and CollInquiryStatus of a CollateralInquiryAck, or
+	//CollAsgnRespType and CollAsgnRejectReason of a CollateralResponse.
+	Acked  bool
+	Result string
+	Status string
+	Text   string
+	//TotalReports is the number of reports the ack announced, if it did
+	TotalReports *int
+
+	//ReportIDs holds the PosMaintRptIDs or CollRptIDs of the reports received for the request, or the CollAsgnIDs
+	//of the assignments made for a CollateralRequest, in the order they arrived
+	ReportIDs []string
+	//LastReceived is true once a report with LastRptRequested = Y has been received
+	LastReceived bool
+}
+
+//Complete returns true when every report of the request has been received, as told by LastRptRequested or by
+//TotalReports
+func (r Request) Complete() bool {
+	return r.LastReceived || (r.Acked && r.TotalReports != nil && len(r.ReportIDs) >= *r.TotalReports)
+}
+
+func (r *Request) clone() Request {
+	c := *r
+	c.ReportIDs = append([]string(nil), r.ReportIDs...)
+	return c
+}
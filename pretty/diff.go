@@ -0,0 +1,114 @@
+package pretty
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/terracefi/quickfix"
+)
+
+//ChangeKind tells how a field differs between two messages
+type ChangeKind int
+
+//ChangeKind values
+const (
+	Added ChangeKind = iota
+	Removed
+	Changed
+)
+
+func (k ChangeKind) String() string {
+	switch k {
+	case Added:
+		return "+"
+	case Removed:
+		return "-"
+	}
+	return "~"
+}
+
+//Change is a field that differs between two messages. Old is the field of the first message and New the field of
+//the second, the Field that is absent is the zero Field.
+type Change struct {
+	Kind ChangeKind
+	Old  Field
+	New  Field
+}
+
+//Path returns the path of the field that changed
+func (c Change) Path() string {
+	if c.Kind == Added {
+		return c.New.Path
+	}
+	return c.Old.Path
+}
+
+func (c Change) String() string {
+	switch c.Kind {
+	case Added:
+		return fmt.Sprintf("%v %v (%d) = %v", c.Kind, c.New.Path, c.New.Tag, displayValue(c.New))
+	case Removed:
+		return fmt.Sprintf("%v %v (%d) = %v", c.Kind, c.Old.Path, c.Old.Tag, displayValue(c.Old))
+	}
+	return fmt.Sprintf("%v %v (%d) = %v -> %v", c.Kind, c.Old.Path, c.Old.Tag, displayValue(c.Old), displayValue(c.New))
+}
+
+//Diff returns the fields that differ between a and b, matched by Path, in the order they appear in a followed by the
+//fields only found in b
+func Diff(a, b *quickfix.Message) ([]Change, error) {
+	af, err := Fields(a)
+	if err != nil {
+		return nil, err
+	}
+	bf, err := Fields(b)
+	if err != nil {
+		return nil, err
+	}
+
+	inB := make(map[string]Field, len(bf))
+	for _, f := range bf {
+		inB[f.Path] = f
+	}
+	inA := make(map[string]bool, len(af))
+
+	var changes []Change
+	for _, f := range af {
+		inA[f.Path] = true
+		g, ok := inB[f.Path]
+		switch {
+		case !ok:
+			changes = append(changes, Change{Kind: Removed, Old: f})
+		case g.Value != f.Value:
+			changes = append(changes, Change{Kind: Changed, Old: f, New: g})
+		}
+	}
+	for _, f := range bf {
+		if !inA[f.Path] {
+			changes = append(changes, Change{Kind: Added, New: f})
+		}
+	}
+	return changes, nil
+}
+
+//PrintDiff writes the changes between a and b to w, one per line, leaving out the fields whose Path starts with one
+//of the ignore prefixes, for example "Header.MsgSeqNum" or "Trailer."
+func PrintDiff(w io.Writer, a, b *quickfix.Message, ignore ...string) error {
+	changes, err := Diff(a, b)
+	if err != nil {
+		return err
+	}
+
+outer:
+	for _, c := range changes {
+		for _, p := range ignore {
+			if strings.HasPrefix(c.Path(), p) {
+				continue outer
+			}
+		}
+		if _, err := fmt.Fprintln(w, c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+/*
+Package pretty prints fix44 messages for humans and compares them field by field.
+
+Print renders a message as an indented tree of its Header, Body and Trailer, each of them is printed even when it has
+no fields. Every field is shown with its tag number, field name and value, enumerated values are followed by their
+name and repeating groups are nested under their NumInGroup field:
+
+	NewOrderSingle (D)
+	  Header
+	    8     BeginString = FIX.4.4
+	    35    MsgType = D (ORDER_SINGLE)
+	  Body
+	    11    ClOrdID = ord-1
+	    54    Side = 1 (BUY)
+	    453   NoPartyIDs = 1
+	      [0]
+	        448   PartyID = ACME
+	  Trailer
+
+Diff lists the fields that differ between two messages, which need not be of the same type, so an original
+NewOrderSingle can be compared with the OrderCancelReplaceRequest that replaces it.
+
+Both use the message definitions of fix44.MessageDefs, fix44.HeaderFields and fix44.TrailerFields.
+
+JSON encodes a message like fix44.MarshalMessageJSON for logging.
+
+The values of Password and NewPassword are replaced by fix44.Redacted, so Diff does not report a change of
+password and JSON can be logged.
+*/
+package pretty
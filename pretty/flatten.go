@@ -0,0 +1,118 @@
+package pretty
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/terracefi/fix44"
+	"github.com/terracefi/quickfix"
+	"github.com/terracefi/tag"
+)
+
+//Field is a field of a message, as listed by Fields
+type Field struct {
+	//Path locates the field in the message, for example Body.NoPartyIDs[1].PartyID
+	Path string
+	//Depth is the nesting level of the field, 0 for the fields of the Header, Body and Trailer
+	Depth int
+	Tag   quickfix.Tag
+	//Name is the field name, empty for a field that is not defined for the message type
+	Name string
+	//Value is the value of the field, fix44.Redacted for Password and NewPassword
+	Value string
+	//Index is the index of the group element that starts at this field, or -1
+	Index int
+}
+
+//Fields lists the fields of msg in Header, Body, Trailer order, the fields of each group element following their
+//NumInGroup field
+func Fields(msg *quickfix.Message) ([]Field, error) {
+	var def fix44.MessageDef
+	if msgType, err := msg.Header.GetString(tag.MsgType); err == nil {
+		def = fix44.MessageDefs[msgType]
+	}
+
+	var fields []Field
+	var err error
+	if fields, err = flatten(fields, &msg.Header.FieldMap, fix44.HeaderFields, "Header", 0); err != nil {
+		return nil, err
+	}
+	if fields, err = flatten(fields, &msg.Body.FieldMap, def.Fields, "Body", 0); err != nil {
+		return nil, err
+	}
+	return flatten(fields, &msg.Trailer.FieldMap, fix44.TrailerFields, "Trailer", 0)
+}
+
+func flatten(out []Field, fm *quickfix.FieldMap, defs []fix44.FieldDef, path string, depth int) ([]Field, error) {
+	known := make(map[quickfix.Tag]bool, len(defs))
+	for _, d := range defs {
+		known[d.Tag] = true
+		if !fm.Has(d.Tag) {
+			continue
+		}
+
+		v, err := value(fm, d.Tag)
+		if err != nil {
+			return nil, err
+		}
+		p := path + "." + d.Name
+		out = append(out, Field{Path: p, Depth: depth, Tag: d.Tag, Name: d.Name, Value: v, Index: -1})
+		if d.IsGroup() {
+			elems, err := flattenGroup(fm, d, p, depth)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, elems...)
+		}
+	}
+
+	var unknown []int
+	for _, t := range fm.Tags() {
+		if !known[t] {
+			unknown = append(unknown, int(t))
+		}
+	}
+	sort.Ints(unknown)
+	for _, t := range unknown {
+		v, err := value(fm, quickfix.Tag(t))
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, Field{Path: path + "." + strconv.Itoa(t), Depth: depth, Tag: quickfix.Tag(t), Value: v,
+			Index: -1})
+	}
+	return out, nil
+}
+
+//value returns the value of field t, fix44.Redacted for a secret field
+func value(fm *quickfix.FieldMap, t quickfix.Tag) (string, error) {
+	v, err := fm.GetString(t)
+	if err != nil {
+		return "", err
+	}
+	if fix44.IsSecret(t) {
+		return fix44.Redacted, nil
+	}
+	return v, nil
+}
+
+func flattenGroup(fm *quickfix.FieldMap, d fix44.FieldDef, path string, depth int) ([]Field, error) {
+	g := d.NewRepeatingGroup()
+	if err := fm.GetGroup(g); err != nil {
+		return nil, err
+	}
+
+	var out []Field
+	for i := 0; i < g.Len(); i++ {
+		elem, err := flatten(nil, &g.Get(i).FieldMap, d.Fields, fmt.Sprintf("%v[%d]", path, i), depth+1)
+		if err != nil {
+			return nil, err
+		}
+		if len(elem) > 0 {
+			elem[0].Index = i
+		}
+		out = append(out, elem...)
+	}
+	return out, nil
+}
@@ -0,0 +1,21 @@
+package pretty
+
+import (
+	"github.com/terracefi/fix44"
+	"github.com/terracefi/quickfix"
+)
+
+//JSON encodes msg like fix44.MarshalMessageJSON, with the values of Password and NewPassword replaced by
+//fix44.Redacted, so that it can be logged. msg itself is not changed.
+func JSON(msg *quickfix.Message) ([]byte, error) {
+	c := quickfix.NewMessage()
+	msg.CopyInto(c)
+	for _, fm := range []*quickfix.FieldMap{&c.Header.FieldMap, &c.Body.FieldMap, &c.Trailer.FieldMap} {
+		for _, t := range fm.Tags() {
+			if fix44.IsSecret(t) {
+				fm.SetString(t, fix44.Redacted)
+			}
+		}
+	}
+	return fix44.MarshalMessageJSON(c)
+}
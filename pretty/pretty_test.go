@@ -0,0 +1,225 @@
+package pretty
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/terracefi/enum"
+	"github.com/terracefi/fix44"
+	"github.com/terracefi/fix44/internal/fixutil"
+	"github.com/terracefi/fix44/newordersingle"
+	"github.com/terracefi/fix44/userrequest"
+	"github.com/terracefi/quickfix"
+	"github.com/terracefi/tag"
+)
+
+func newOrder(modify func(s *newordersingle.Struct)) *quickfix.Message {
+	s := newordersingle.Struct{
+		ClOrdID:      "ord-1",
+		Side:         "1",
+		OrdType:      "2",
+		TransactTime: time.Date(2024, 3, 1, 9, 30, 0, 0, time.UTC),
+		Price:        fixutil.Ptr(decimal.RequireFromString("10.5")),
+	}
+	if modify != nil {
+		modify(&s)
+	}
+	return newordersingle.Unmarshal(s).ToMessage()
+}
+
+func withParties(s *newordersingle.Struct) {
+	s.NoPartyIDs = []newordersingle.NoPartyIDsStruct{
+		{PartyID: fixutil.Ptr("ACME"), PartyRole: fixutil.Ptr(enum.PartyRole("1"))},
+		{PartyID: fixutil.Ptr("BRKR")},
+	}
+}
+
+func TestPrint(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  func() *quickfix.Message
+		want string
+	}{
+		{"fields", func() *quickfix.Message { return newOrder(nil) }, `NewOrderSingle (D)
+  Header
+    8     BeginString = FIX.4.4
+    35    MsgType = D (ORDER_SINGLE)
+  Body
+    11    ClOrdID = ord-1
+    40    OrdType = 2 (LIMIT)
+    44    Price = 10.5
+    54    Side = 1 (BUY)
+    60    TransactTime = 20240301-09:30:00.000
+  Trailer
+`},
+		{"groups and unknown tags", func() *quickfix.Message {
+			msg := newOrder(withParties)
+			msg.Body.SetString(9999, "x")
+			msg.Trailer.SetString(tag.CheckSum, "042")
+			return msg
+		}, `NewOrderSingle (D)
+  Header
+    8     BeginString = FIX.4.4
+    35    MsgType = D (ORDER_SINGLE)
+  Body
+    11    ClOrdID = ord-1
+    40    OrdType = 2 (LIMIT)
+    44    Price = 10.5
+    54    Side = 1 (BUY)
+    60    TransactTime = 20240301-09:30:00.000
+    453   NoPartyIDs = 2
+      [0]
+        448   PartyID = ACME
+        452   PartyRole = 1 (EXECUTING_FIRM)
+      [1]
+        448   PartyID = BRKR
+    9999  ? = x
+  Trailer
+    10    CheckSum = 042
+`},
+		{"unknown message type", func() *quickfix.Message {
+			msg := quickfix.NewMessage()
+			msg.Header.SetString(tag.MsgType, "ZZ")
+			msg.Body.SetString(tag.ClOrdID, "ord-1")
+			return msg
+		}, `Unknown (ZZ)
+  Header
+    35    MsgType = ZZ
+  Body
+    11    ? = ord-1
+  Trailer
+`},
+		{"empty", quickfix.NewMessage, `Unknown ()
+  Header
+  Body
+  Trailer
+`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b bytes.Buffer
+			if err := Print(&b, tt.msg()); err != nil {
+				t.Fatal(err)
+			}
+			if b.String() != tt.want {
+				t.Errorf("Print() =\n%v\nwant\n%v", b.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestFields(t *testing.T) {
+	msg := newOrder(withParties)
+	msg.Body.SetString(9999, "x")
+	fields, err := Fields(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var paths []string
+	for _, f := range fields {
+		paths = append(paths, f.Path)
+	}
+	want := []string{"Header.BeginString", "Header.MsgType", "Body.ClOrdID", "Body.OrdType", "Body.Price", "Body.Side",
+		"Body.TransactTime", "Body.NoPartyIDs", "Body.NoPartyIDs[0].PartyID", "Body.NoPartyIDs[0].PartyRole",
+		"Body.NoPartyIDs[1].PartyID", "Body.9999"}
+	if !reflect.DeepEqual(paths, want) {
+		t.Fatalf("Fields() paths = %v, want %v", paths, want)
+	}
+
+	wantFields := map[string]Field{
+		"Body.NoPartyIDs": {Path: "Body.NoPartyIDs", Tag: tag.NoPartyIDs, Name: "NoPartyIDs", Value: "2", Index: -1},
+		"Body.NoPartyIDs[0].PartyID": {Path: "Body.NoPartyIDs[0].PartyID", Depth: 1, Tag: tag.PartyID,
+			Name: "PartyID", Value: "ACME", Index: 0},
+		"Body.NoPartyIDs[0].PartyRole": {Path: "Body.NoPartyIDs[0].PartyRole", Depth: 1, Tag: tag.PartyRole,
+			Name: "PartyRole", Value: "1", Index: -1},
+		"Body.NoPartyIDs[1].PartyID": {Path: "Body.NoPartyIDs[1].PartyID", Depth: 1, Tag: tag.PartyID,
+			Name: "PartyID", Value: "BRKR", Index: 1},
+		"Body.9999": {Path: "Body.9999", Tag: 9999, Value: "x", Index: -1},
+	}
+	for _, f := range fields {
+		if w, ok := wantFields[f.Path]; ok && f != w {
+			t.Errorf("Fields() %v = %+v, want %+v", f.Path, f, w)
+		}
+	}
+}
+
+func TestDiff(t *testing.T) {
+	a := newOrder(withParties)
+	a.Body.SetString(9999, "x")
+	b := newOrder(func(s *newordersingle.Struct) {
+		withParties(s)
+		s.Price = fixutil.Ptr(decimal.RequireFromString("11"))
+		s.NoPartyIDs[1].PartyID = fixutil.Ptr("CLNT")
+		s.NoPartyIDs[1].PartyRole = fixutil.Ptr(enum.PartyRole("3"))
+		s.Account = fixutil.Ptr("ACC")
+	})
+	b.Header.SetInt(tag.MsgSeqNum, 2)
+
+	changes, err := Diff(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, c := range changes {
+		got = append(got, c.String())
+	}
+	want := []string{
+		"~ Body.Price (44) = 10.5 -> 11",
+		"~ Body.NoPartyIDs[1].PartyID (448) = BRKR -> CLNT",
+		"- Body.9999 (9999) = x",
+		"+ Header.MsgSeqNum (34) = 2",
+		"+ Body.Account (1) = ACC",
+		"+ Body.NoPartyIDs[1].PartyRole (452) = 3 (CLIENT_ID)",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Diff() =\n%v\nwant\n%v", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+
+	if changes, err := Diff(a, a); err != nil || len(changes) != 0 {
+		t.Errorf("Diff(a, a) = %v, %v, want no changes", changes, err)
+	}
+}
+
+func TestPrintDiff(t *testing.T) {
+	a := newOrder(nil)
+	b := newOrder(func(s *newordersingle.Struct) { s.Price = fixutil.Ptr(decimal.RequireFromString("11")) })
+	b.Header.SetInt(tag.MsgSeqNum, 2)
+
+	var out bytes.Buffer
+	if err := PrintDiff(&out, a, b, "Header."); err != nil {
+		t.Fatal(err)
+	}
+	if want := "~ Body.Price (44) = 10.5 -> 11\n"; out.String() != want {
+		t.Errorf("PrintDiff() = %q, want %q", out.String(), want)
+	}
+}
+
+func TestJSON(t *testing.T) {
+	msg := userrequest.Unmarshal(userrequest.Struct{
+		UserRequestID:   "1",
+		UserRequestType: "3",
+		Username:        "alice",
+		Password:        fixutil.Ptr("secret"),
+		NewPassword:     fixutil.Ptr("new"),
+	}).ToMessage()
+
+	b, err := JSON(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := string(b); strings.Contains(s, "secret") || strings.Contains(s, `"new"`) ||
+		strings.Count(s, fix44.Redacted) != 2 || !strings.Contains(s, "alice") {
+		t.Errorf("JSON() = %s, want Password and NewPassword redacted", b)
+	}
+	if got, _ := msg.Body.GetString(tag.Password); got != "secret" {
+		t.Errorf("Password of msg = %q after JSON(), want secret", got)
+	}
+	if b, err := fix44.MarshalMessageJSON(msg); err != nil || !strings.Contains(string(b), "secret") {
+		t.Errorf("MarshalMessageJSON() = %s, %v, want the Password kept", b, err)
+	}
+}
@@ -0,0 +1,83 @@
+package pretty
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/terracefi/fix44"
+	"github.com/terracefi/quickfix"
+	"github.com/terracefi/tag"
+)
+
+const indent = "  "
+
+//Print writes msg to w as an indented tree, see the package documentation
+func Print(w io.Writer, msg *quickfix.Message) error {
+	fields, err := Fields(msg)
+	if err != nil {
+		return err
+	}
+
+	msgType, _ := msg.Header.GetString(tag.MsgType)
+	name := fix44.MessageDefs[msgType].Name
+	if name == "" {
+		name = "Unknown"
+	}
+	if _, err := fmt.Fprintf(w, "%v (%v)\n", name, msgType); err != nil {
+		return err
+	}
+
+	for _, section := range sections {
+		if _, err := fmt.Fprintf(w, "%v%v\n", indent, section); err != nil {
+			return err
+		}
+		for _, f := range fields {
+			if !strings.HasPrefix(f.Path, section+".") {
+				continue
+			}
+			if err := printField(w, f); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+//sections are the parts of a message, Print writes each of them even if it has no fields
+var sections = []string{"Header", "Body", "Trailer"}
+
+func printField(w io.Writer, f Field) error {
+	if f.Index >= 0 {
+		if _, err := fmt.Fprintf(w, "%v[%d]\n", strings.Repeat(indent, 1+2*f.Depth), f.Index); err != nil {
+			return err
+		}
+	}
+	pad := strings.Repeat(indent, 2+2*f.Depth)
+	_, err := fmt.Fprintf(w, "%v%-5d %v = %v\n", pad, f.Tag, displayName(f), displayValue(f))
+	return err
+}
+
+//Sprint returns msg as an indented tree, see Print
+func Sprint(msg *quickfix.Message) string {
+	var b bytes.Buffer
+	if err := Print(&b, msg); err != nil {
+		return fmt.Sprintf("%v\n<error: %v>", msg, err)
+	}
+	return b.String()
+}
+
+func displayName(f Field) string {
+	if f.Name == "" {
+		return "?"
+	}
+	return f.Name
+}
+
+func displayValue(f Field) string {
+	if name, ok := fix44.EnumName(f.Tag, f.Value); ok {
+		return fmt.Sprintf("%v (%v)", f.Value, name)
+	}
+	return f.Value
+}
@@ -0,0 +1,154 @@
+package fix44
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/japanese"
+
+	"github.com/terracefi/enum"
+	"github.com/terracefi/quickfix"
+	"github.com/terracefi/tag"
+)
+
+//MessageEncoding values, FIX 4.4
+const (
+	EncodingISO2022JP enum.MessageEncoding = "ISO-2022-JP"
+	EncodingEUCJP     enum.MessageEncoding = "EUC-JP"
+	EncodingShiftJIS  enum.MessageEncoding = "Shift_JIS"
+	EncodingUTF8      enum.MessageEncoding = "UTF-8"
+)
+
+//RawDataFields is the part of a field map used to access a data field and its length field. The field maps of
+//messages, groups, components, the Header and the Trailer all implement it.
+type RawDataFields interface {
+	Has(t quickfix.Tag) bool
+	GetInt(t quickfix.Tag) (int, quickfix.MessageRejectError)
+	GetBytes(t quickfix.Tag) ([]byte, quickfix.MessageRejectError)
+	SetInt(t quickfix.Tag, v int) *quickfix.FieldMap
+	SetBytes(t quickfix.Tag, v []byte) *quickfix.FieldMap
+}
+
+//SetRawData sets the data field dataTag to v and the length field lenTag to the length of v. v may hold any bytes,
+//including SOH.
+func SetRawData(fm RawDataFields, lenTag, dataTag quickfix.Tag, v []byte) {
+	fm.SetInt(lenTag, len(v))
+	fm.SetBytes(dataTag, append([]byte(nil), v...))
+}
+
+//GetRawData gets the data field dataTag. When the length field lenTag is present it must give the length of the
+//data.
+func GetRawData(fm RawDataFields, lenTag, dataTag quickfix.Tag) ([]byte, quickfix.MessageRejectError) {
+	v, err := fm.GetBytes(dataTag)
+	if err != nil {
+		return nil, err
+	}
+	if fm.Has(lenTag) {
+		n, err := fm.GetInt(lenTag)
+		if err != nil {
+			return nil, err
+		}
+		if n != len(v) {
+			return nil, quickfix.ValueIsIncorrect(lenTag)
+		}
+	}
+	return append([]byte(nil), v...), nil
+}
+
+//DecodeRawData gets the data field dataTag, see GetRawData, and decodes it to UTF-8 according to the
+//MessageEncoding of h, see DecodeText. For a group or a component h is the Header of the message holding it.
+func DecodeRawData(h Header, fm RawDataFields, lenTag, dataTag quickfix.Tag) (string, error) {
+	var encoding enum.MessageEncoding
+	if h.Has(tag.MessageEncoding) {
+		var err error
+		if encoding, err = h.GetMessageEncoding(); err != nil {
+			return "", err
+		}
+	}
+
+	v, err := GetRawData(fm, lenTag, dataTag)
+	if err != nil {
+		return "", err
+	}
+	return DecodeText(encoding, v)
+}
+
+//A Decoder converts text in some encoding to UTF-8, the Bytes method of the Decoders of golang.org/x/text is one
+type Decoder func(b []byte) ([]byte, error)
+
+//UnsupportedEncodingError is returned by DecodeText for a MessageEncoding without a Decoder
+type UnsupportedEncodingError struct {
+	Encoding enum.MessageEncoding
+}
+
+func (e *UnsupportedEncodingError) Error() string {
+	return fmt.Sprintf("fix44: no decoder for MessageEncoding %v", e.Encoding)
+}
+
+var decoders = struct {
+	sync.RWMutex
+	m map[enum.MessageEncoding]Decoder
+}{m: make(map[enum.MessageEncoding]Decoder)}
+
+//RegisterDecoder sets the Decoder DecodeText uses for encoding, matched without regard to case. UTF-8, ISO-8859-1
+//and the Japanese encodings of FIX 4.4 are decoded without one, a registered Decoder takes their place. Other
+//encodings need a Decoder, for example
+//
+//	fix44.RegisterDecoder("GB2312", simplifiedchinese.HZGB2312.NewDecoder().Bytes)
+//
+//with the simplifiedchinese package of golang.org/x/text/encoding.
+func RegisterDecoder(encoding enum.MessageEncoding, d Decoder) {
+	decoders.Lock()
+	defer decoders.Unlock()
+	decoders.m[enum.MessageEncoding(strings.ToUpper(string(encoding)))] = d
+}
+
+//DecodeText converts b from encoding to UTF-8. Text without a MessageEncoding is taken to be UTF-8. A registered
+//Decoder is used when there is one, see RegisterDecoder, otherwise UTF-8, ISO-8859-1, Shift_JIS, EUC-JP and
+//ISO-2022-JP are decoded with the decoders of golang.org/x/text.
+func DecodeText(encoding enum.MessageEncoding, b []byte) (string, error) {
+	encoding = enum.MessageEncoding(strings.ToUpper(string(encoding)))
+
+	decoders.RLock()
+	d, ok := decoders.m[encoding]
+	decoders.RUnlock()
+	if ok {
+		v, err := d(b)
+		if err != nil {
+			return "", fmt.Errorf("fix44: decoding %v: %v", encoding, err)
+		}
+		return string(v), nil
+	}
+
+	switch encoding {
+	case "", EncodingUTF8:
+		if !utf8.Valid(b) {
+			return "", fmt.Errorf("fix44: text is not valid UTF-8")
+		}
+		return string(b), nil
+	case "ISO-8859-1", "LATIN1":
+		r := make([]rune, len(b))
+		for i, c := range b {
+			r[i] = rune(c)
+		}
+		return string(r), nil
+	}
+	if e, ok := japaneseEncodings[encoding]; ok {
+		v, err := e.NewDecoder().Bytes(b)
+		if err != nil {
+			return "", fmt.Errorf("fix44: decoding %v: %v", encoding, err)
+		}
+		return string(v), nil
+	}
+	return "", &UnsupportedEncodingError{encoding}
+}
+
+//japaneseEncodings are the Japanese encodings of FIX 4.4, keyed by their upper case name as DecodeText looks them up
+var japaneseEncodings = map[enum.MessageEncoding]encoding.Encoding{
+	"SHIFT_JIS":   japanese.ShiftJIS,
+	"EUC-JP":      japanese.EUCJP,
+	"ISO-2022-JP": japanese.ISO2022JP,
+}
@@ -0,0 +1,61 @@
+package fix44
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/terracefi/enum"
+)
+
+func TestDecodeText(t *testing.T) {
+	tests := []struct {
+		name     string
+		encoding enum.MessageEncoding
+		in       []byte
+		want     string
+		wantErr  bool
+	}{
+		{"no encoding", "", []byte("abc"), "abc", false},
+		{"utf8", EncodingUTF8, []byte("日本"), "日本", false},
+		{"invalid utf8", EncodingUTF8, []byte{0xff}, "", true},
+		{"latin1", "ISO-8859-1", []byte{'c', 0xe9}, "cé", false},
+		{"shift jis", EncodingShiftJIS, []byte{0x93, 0xfa, 0x96, 0x7b}, "日本", false},
+		{"shift jis any case", "SHIFT_JIS", []byte{0x93, 0xfa, 0x96, 0x7b}, "日本", false},
+		{"euc jp", EncodingEUCJP, []byte{0xc6, 0xfc, 0xcb, 0xdc}, "日本", false},
+		{"iso 2022 jp", EncodingISO2022JP, []byte("\x1b$BF|K\\\x1b(B"), "日本", false},
+		{"unsupported", "KOI8-R", []byte("abc"), "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DecodeText(tt.encoding, tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("DecodeText() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("DecodeText() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodeTextUnsupportedError(t *testing.T) {
+	_, err := DecodeText("KOI8-R", nil)
+	var u *UnsupportedEncodingError
+	if !errors.As(err, &u) || u.Encoding != "KOI8-R" {
+		t.Errorf("DecodeText() error = %v, want *UnsupportedEncodingError for KOI8-R", err)
+	}
+}
+
+func TestRegisterDecoderOverridesBuiltin(t *testing.T) {
+	RegisterDecoder("euc-jp", func(b []byte) ([]byte, error) { return []byte("registered"), nil })
+	defer func() {
+		decoders.Lock()
+		delete(decoders.m, "EUC-JP")
+		decoders.Unlock()
+	}()
+
+	got, err := DecodeText(EncodingEUCJP, []byte{0xc6, 0xfc})
+	if err != nil || got != "registered" {
+		t.Errorf("DecodeText() = %q, %v, want the registered decoder", got, err)
+	}
+}
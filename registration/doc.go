@@ -0,0 +1,16 @@
+/*
+Package registration builds and follows the account registrations exchanged with RegistrationInstructions and
+RegistrationInstructionsResponse messages.
+
+New, Replace and Cancel build the RegistrationInstructions of each RegistTransType, and Distribution the entries of
+its NoDistribInsts group. Check finds the problems that would make a counterparty reject the instructions, each with
+the field it concerns and its RegistRejReasonCode, among them NoDistribInsts whose DistribPercentage do not add up
+to 100. Accept and Reject build the RegistrationInstructionsResponse to the instructions, Reject from the problems
+found by Check, listing each of them with its field and RegistRejReasonCode in the RegistRejReasonText.
+
+A Tracker follows registrations through their RegistID / RegistRefID chain from the messages exchanged in either
+direction. Replace and Cancel instructions are pending until their response: an Accepted response brings them into
+force and a Rejected one drops them. The RegistStatus and reject reason of the last response are kept on the
+Registration.
+*/
+package registration
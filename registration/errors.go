@@ -0,0 +1,32 @@
+package registration
+
+import (
+	"fmt"
+)
+
+//UnknownRegistrationError is returned for a message that refers to a RegistID the Tracker does not know
+type UnknownRegistrationError struct {
+	RegistID string
+}
+
+func (e *UnknownRegistrationError) Error() string {
+	return fmt.Sprintf("registration: unknown RegistID %v", e.RegistID)
+}
+
+//DuplicateRegistrationError is returned for instructions that reuse a RegistID
+type DuplicateRegistrationError struct {
+	RegistID string
+}
+
+func (e *DuplicateRegistrationError) Error() string {
+	return fmt.Sprintf("registration: duplicate RegistID %v", e.RegistID)
+}
+
+//CanceledError is returned for instructions that refer to a registration that has been canceled
+type CanceledError struct {
+	RegistID string
+}
+
+func (e *CanceledError) Error() string {
+	return fmt.Sprintf("registration: registration %v is canceled", e.RegistID)
+}
@@ -0,0 +1,232 @@
+package registration
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/terracefi/enum"
+	"github.com/terracefi/fix44"
+	"github.com/terracefi/fix44/registrationinstructions"
+	"github.com/terracefi/fix44/registrationinstructionsresponse"
+	"github.com/terracefi/quickfix"
+	"github.com/terracefi/tag"
+)
+
+//RegistTransType values, FIX 4.4
+const (
+	TransNew     enum.RegistTransType = "0"
+	TransReplace enum.RegistTransType = "1"
+	TransCancel  enum.RegistTransType = "2"
+)
+
+//RegistStatus values, FIX 4.4
+const (
+	StatusAccepted enum.RegistStatus = "A"
+	StatusRejected enum.RegistStatus = "R"
+	StatusHeld     enum.RegistStatus = "H"
+	StatusReminder enum.RegistStatus = "N"
+)
+
+//RegistRejReasonCode values, FIX 4.4
+const (
+	RejAccountType            enum.RegistRejReasonCode = "1"
+	RejTaxExemptType          enum.RegistRejReasonCode = "2"
+	RejOwnershipType          enum.RegistRejReasonCode = "3"
+	RejNoRegDetails           enum.RegistRejReasonCode = "4"
+	RejRegSeqNo               enum.RegistRejReasonCode = "5"
+	RejRegDetails             enum.RegistRejReasonCode = "6"
+	RejMailingDetails         enum.RegistRejReasonCode = "7"
+	RejMailingInstructions    enum.RegistRejReasonCode = "8"
+	RejInvestorID             enum.RegistRejReasonCode = "9"
+	RejInvestorIDSource       enum.RegistRejReasonCode = "10"
+	RejDateOfBirth            enum.RegistRejReasonCode = "11"
+	RejCountryOfResidence     enum.RegistRejReasonCode = "12"
+	RejNoDistribInstructions  enum.RegistRejReasonCode = "13"
+	RejDistribPercentage      enum.RegistRejReasonCode = "14"
+	RejDistribPaymentMethod   enum.RegistRejReasonCode = "15"
+	RejCashDistribAgentName   enum.RegistRejReasonCode = "16"
+	RejCashDistribAgentCode   enum.RegistRejReasonCode = "17"
+	RejCashDistribAgentAcctNo enum.RegistRejReasonCode = "18"
+	RejOther                  enum.RegistRejReasonCode = "99"
+)
+
+var hundred = decimal.NewFromInt(100)
+
+//New returns the RegistrationInstructions registering account under registID. RegistRefID is required on the wire
+//even for a New registration, which has no earlier registration to refer to, so it is set to registID.
+func New(registID, account string, details []registrationinstructions.NoRegistDtlsStruct,
+	distributions []registrationinstructions.NoDistribInstsStruct) registrationinstructions.Struct {
+	return registrationinstructions.Struct{
+		RegistID:        registID,
+		RegistRefID:     registID,
+		RegistTransType: TransNew,
+		Account:         &account,
+		NoRegistDtls:    details,
+		NoDistribInsts:  distributions,
+	}
+}
+
+//Replace returns r as the RegistrationInstructions replacing the registration origRegistID under registID
+func Replace(origRegistID, registID string, r registrationinstructions.Struct) registrationinstructions.Struct {
+	r.RegistID = registID
+	r.RegistRefID = origRegistID
+	r.RegistTransType = TransReplace
+	return r
+}
+
+//Cancel returns the RegistrationInstructions canceling the registration origRegistID of account
+func Cancel(origRegistID, registID, account string) registrationinstructions.Struct {
+	return registrationinstructions.Struct{
+		RegistID:        registID,
+		RegistRefID:     origRegistID,
+		RegistTransType: TransCancel,
+		Account:         &account,
+	}
+}
+
+//Distribution returns the NoDistribInsts entry paying percentage of the distributions with method
+func Distribution(method enum.DistribPaymentMethod,
+	percentage decimal.Decimal) registrationinstructions.NoDistribInstsStruct {
+	return registrationinstructions.NoDistribInstsStruct{
+		DistribPaymentMethod: &method,
+		DistribPercentage:    &percentage,
+	}
+}
+
+//Problem is a field of RegistrationInstructions that a counterparty would reject, with the RegistRejReasonCode of
+//the reject
+type Problem struct {
+	Tag  quickfix.Tag
+	Code enum.RegistRejReasonCode
+	Text string
+}
+
+func (p Problem) Error() string {
+	return fmt.Sprintf("registration: tag %v: %v (RegistRejReasonCode %v)", p.Tag, p.Text, p.Code)
+}
+
+//Check returns the problems of r: a Replace or Cancel without RegistRefID, New or Replace instructions without
+//NoRegistDtls, details with a DateOfBirth that is not a YYYYMMDD date or an InvestorCountryOfResidence that is not
+//an ISO 3166 alpha-2 code, and NoDistribInsts without DistribPaymentMethod or whose DistribPercentage values are
+//not between 0 and 100 or do not add up to 100
+func Check(r registrationinstructions.Struct) []Problem {
+	var problems []Problem
+	add := func(t quickfix.Tag, code enum.RegistRejReasonCode, format string, args ...interface{}) {
+		problems = append(problems, Problem{t, code, fmt.Sprintf(format, args...)})
+	}
+
+	if r.RegistTransType != TransNew && r.RegistRefID == "" {
+		add(tag.RegistRefID, RejOther, "RegistTransType %v without RegistRefID", r.RegistTransType)
+	}
+	if r.RegistTransType == TransCancel {
+		return problems
+	}
+
+	if len(r.NoRegistDtls) == 0 {
+		add(tag.NoRegistDtls, RejNoRegDetails, "no registration details")
+	}
+	for i, d := range r.NoRegistDtls {
+		if d.DateOfBirth != nil {
+			if _, err := time.Parse("20060102", *d.DateOfBirth); err != nil {
+				add(tag.DateOfBirth, RejDateOfBirth, "details %v: DateOfBirth %v is not a YYYYMMDD date", i+1,
+					*d.DateOfBirth)
+			}
+		}
+		if c := d.InvestorCountryOfResidence; c != nil && !isCountryCode(*c) {
+			add(tag.InvestorCountryOfResidence, RejCountryOfResidence,
+				"details %v: InvestorCountryOfResidence %v is not an ISO 3166 code", i+1, *c)
+		}
+	}
+
+	if len(r.NoDistribInsts) == 0 {
+		return problems
+	}
+	total := decimal.Zero
+	for i, d := range r.NoDistribInsts {
+		if d.DistribPaymentMethod == nil {
+			add(tag.DistribPaymentMethod, RejDistribPaymentMethod, "distribution %v has no DistribPaymentMethod", i+1)
+		}
+		switch {
+		case d.DistribPercentage == nil:
+			add(tag.DistribPercentage, RejDistribPercentage, "distribution %v has no DistribPercentage", i+1)
+		case !d.DistribPercentage.IsPositive() || d.DistribPercentage.GreaterThan(hundred):
+			add(tag.DistribPercentage, RejDistribPercentage,
+				"distribution %v has DistribPercentage %v", i+1, *d.DistribPercentage)
+		default:
+			total = total.Add(*d.DistribPercentage)
+		}
+	}
+	if !total.Equal(hundred) {
+		add(tag.DistribPercentage, RejDistribPercentage, "DistribPercentage adds up to %v, not 100", total)
+	}
+	return problems
+}
+
+func isCountryCode(c string) bool {
+	if len(c) != 2 {
+		return false
+	}
+	for _, b := range []byte(c) {
+		if b < 'A' || b > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+//Accept returns the RegistrationInstructionsResponse accepting r
+func Accept(r registrationinstructions.Struct) registrationinstructionsresponse.Struct {
+	return respond(r, StatusAccepted)
+}
+
+//Reject returns the RegistrationInstructionsResponse rejecting r for the given problems. A response has a single
+//RegistRejReasonCode, the Code of the first problem, so the RegistRejReasonText lists every problem with the name of
+//its field and its own RegistRejReasonCode, for example
+//
+//	NoRegistDtls (4): no registration details; DistribPercentage (14): DistribPercentage adds up to 50, not 100
+func Reject(r registrationinstructions.Struct, problems []Problem) registrationinstructionsresponse.Struct {
+	resp := respond(r, StatusRejected)
+	if len(problems) == 0 {
+		return resp
+	}
+
+	code := problems[0].Code
+	texts := make([]string, len(problems))
+	for i, p := range problems {
+		texts[i] = fmt.Sprintf("%v (%v): %v", fieldName(p.Tag), p.Code, p.Text)
+	}
+	text := strings.Join(texts, "; ")
+	resp.RegistRejReasonCode = &code
+	resp.RegistRejReasonText = &text
+	return resp
+}
+
+//respond returns the response to r with the given status. Its RegistRefID, required like that of r, is the RegistID
+//of r when r has none.
+func respond(r registrationinstructions.Struct, status enum.RegistStatus) registrationinstructionsresponse.Struct {
+	refID := r.RegistRefID
+	if refID == "" {
+		refID = r.RegistID
+	}
+	return registrationinstructionsresponse.Struct{
+		RegistID:        r.RegistID,
+		RegistRefID:     refID,
+		RegistTransType: r.RegistTransType,
+		RegistStatus:    status,
+		Account:         r.Account,
+		AcctIDSource:    r.AcctIDSource,
+		ClOrdID:         r.ClOrdID,
+		NoPartyIDs:      r.NoPartyIDs,
+	}
+}
+
+//fieldName returns the name of the RegistrationInstructions field with tag t
+func fieldName(t quickfix.Tag) string {
+	if f, ok := fix44.MessageDefs["o"].Field(t); ok {
+		return f.Name
+	}
+	return fmt.Sprintf("tag %v", t)
+}
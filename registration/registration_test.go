@@ -0,0 +1,160 @@
+package registration
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/terracefi/enum"
+	"github.com/terracefi/fix44/internal/fixutil"
+	"github.com/terracefi/fix44/registrationinstructions"
+	"github.com/terracefi/quickfix"
+	"github.com/terracefi/tag"
+)
+
+const cash enum.DistribPaymentMethod = "1"
+
+func details() []registrationinstructions.NoRegistDtlsStruct {
+	return []registrationinstructions.NoRegistDtlsStruct{{RegistDtls: fixutil.Ptr("J SMITH"),
+		DateOfBirth:                fixutil.Ptr("19700101"),
+		InvestorCountryOfResidence: fixutil.Ptr("GB")}}
+}
+
+func distributions(percentages ...int64) []registrationinstructions.NoDistribInstsStruct {
+	var d []registrationinstructions.NoDistribInstsStruct
+	for _, p := range percentages {
+		d = append(d, Distribution(cash, decimal.NewFromInt(p)))
+	}
+	return d
+}
+
+func TestNew(t *testing.T) {
+	r := New("R1", "ACC", details(), distributions(100))
+	if r.RegistRefID != "R1" || r.RegistTransType != TransNew || r.Account == nil || *r.Account != "ACC" {
+		t.Errorf("New() = %+v, want RegistRefID R1, RegistTransType New and Account ACC", r)
+	}
+	if p := Check(r); p != nil {
+		t.Errorf("Check(New()) = %v, want no problems", p)
+	}
+	if _, err := registrationinstructions.Marshal(registrationinstructions.Unmarshal(r)); err != nil {
+		t.Errorf("Marshal() error = %v", err)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	type problem struct {
+		tag  quickfix.Tag
+		code enum.RegistRejReasonCode
+	}
+	tests := []struct {
+		name     string
+		r        registrationinstructions.Struct
+		problems []problem
+	}{
+		{"valid", New("R1", "ACC", details(), distributions(60, 40)), nil},
+		{"no distributions", New("R1", "ACC", details(), nil), nil},
+		{"no details", New("R1", "ACC", nil, nil), []problem{{tag.NoRegistDtls, RejNoRegDetails}}},
+		{"replace", Replace("R1", "R2", New("R1", "ACC", details(), nil)), nil},
+		{"replace without RegistRefID", Replace("", "R2", New("R1", "ACC", details(), nil)),
+			[]problem{{tag.RegistRefID, RejOther}}},
+		{"cancel", Cancel("R1", "R2", "ACC"), nil},
+		{"cancel without RegistRefID", Cancel("", "R2", "ACC"), []problem{{tag.RegistRefID, RejOther}}},
+		{"DateOfBirth", func() registrationinstructions.Struct {
+			r := New("R1", "ACC", details(), nil)
+			r.NoRegistDtls[0].DateOfBirth = fixutil.Ptr("1970-01-01")
+			return r
+		}(), []problem{{tag.DateOfBirth, RejDateOfBirth}}},
+		{"InvestorCountryOfResidence", func() registrationinstructions.Struct {
+			r := New("R1", "ACC", details(), nil)
+			r.NoRegistDtls[0].InvestorCountryOfResidence = fixutil.Ptr("gb")
+			return r
+		}(), []problem{{tag.InvestorCountryOfResidence, RejCountryOfResidence}}},
+		{"DistribPercentage short of 100", New("R1", "ACC", details(), distributions(60, 30)),
+			[]problem{{tag.DistribPercentage, RejDistribPercentage}}},
+		{"DistribPercentage over 100", New("R1", "ACC", details(), distributions(160, -60)),
+			[]problem{{tag.DistribPercentage, RejDistribPercentage}, {tag.DistribPercentage, RejDistribPercentage},
+				{tag.DistribPercentage, RejDistribPercentage}}},
+		{"no DistribPaymentMethod", func() registrationinstructions.Struct {
+			r := New("R1", "ACC", details(), distributions(100))
+			r.NoDistribInsts[0].DistribPaymentMethod = nil
+			return r
+		}(), []problem{{tag.DistribPaymentMethod, RejDistribPaymentMethod}}},
+		{"no DistribPercentage", func() registrationinstructions.Struct {
+			r := New("R1", "ACC", details(), distributions(100, 0))
+			r.NoDistribInsts[1].DistribPercentage = nil
+			return r
+		}(), []problem{{tag.DistribPercentage, RejDistribPercentage}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []problem
+			for _, p := range Check(tt.r) {
+				got = append(got, problem{p.Tag, p.Code})
+			}
+			if !reflect.DeepEqual(got, tt.problems) {
+				t.Errorf("Check() = %v, want %v", got, tt.problems)
+			}
+		})
+	}
+}
+
+func TestRespond(t *testing.T) {
+	tests := []struct {
+		name        string
+		r           registrationinstructions.Struct
+		reject      bool
+		registRefID string
+		code        *enum.RegistRejReasonCode
+		text        []string
+	}{
+		{"accept", New("R1", "ACC", details(), nil), false, "R1", nil, nil},
+		{"accept replace", Replace("R1", "R2", New("R1", "ACC", details(), nil)), false, "R1", nil, nil},
+		{"accept without RegistRefID", registrationinstructions.Struct{RegistID: "R1", RegistTransType: TransNew},
+			false, "R1", nil, nil},
+		{"reject", New("R1", "ACC", nil, distributions(50)), true, "R1", fixutil.Ptr(RejNoRegDetails),
+			[]string{"NoRegistDtls (4): ", "DistribPercentage (14): "}},
+		{"reject without problems", New("R1", "ACC", details(), nil), true, "R1", nil, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := Accept(tt.r)
+			status := StatusAccepted
+			if tt.reject {
+				resp, status = Reject(tt.r, Check(tt.r)), StatusRejected
+			}
+			if resp.RegistStatus != status || resp.RegistID != tt.r.RegistID || resp.RegistRefID != tt.registRefID {
+				t.Errorf("RegistStatus, RegistID, RegistRefID = %v, %v, %v, want %v, %v, %v", resp.RegistStatus,
+					resp.RegistID, resp.RegistRefID, status, tt.r.RegistID, tt.registRefID)
+			}
+			if !reflect.DeepEqual(resp.RegistRejReasonCode, tt.code) {
+				t.Errorf("RegistRejReasonCode = %v, want %v", resp.RegistRejReasonCode, tt.code)
+			}
+			if (resp.RegistRejReasonText != nil) != (tt.text != nil) {
+				t.Fatalf("RegistRejReasonText = %v, want text %v", resp.RegistRejReasonText, tt.text != nil)
+			}
+			for _, s := range tt.text {
+				if !strings.Contains(*resp.RegistRejReasonText, s) {
+					t.Errorf("RegistRejReasonText = %q, want it to contain %q", *resp.RegistRejReasonText, s)
+				}
+			}
+		})
+	}
+}
+
+func TestFieldName(t *testing.T) {
+	tests := []struct {
+		tag  quickfix.Tag
+		want string
+	}{
+		{tag.RegistRefID, "RegistRefID"},
+		{tag.DateOfBirth, "DateOfBirth"},
+		{tag.DistribPercentage, "DistribPercentage"},
+		{tag.Symbol, "tag 55"},
+	}
+	for _, tt := range tests {
+		if got := fieldName(tt.tag); got != tt.want {
+			t.Errorf("fieldName(%v) = %v, want %v", tt.tag, got, tt.want)
+		}
+	}
+}
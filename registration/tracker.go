@@ -0,0 +1,153 @@
+package registration
+
+import (
+	"sync"
+
+	"github.com/terracefi/enum"
+	"github.com/terracefi/fix44/internal/fixutil"
+	"github.com/terracefi/fix44/registrationinstructions"
+	"github.com/terracefi/fix44/registrationinstructionsresponse"
+)
+
+//Registration is an account registration followed by a Tracker
+type Registration struct {
+	//RegistID is the RegistID of the instructions in force
+	RegistID string
+	//RegistIDs holds the RegistIDs the registration has been in force under, oldest first
+	RegistIDs []string
+	Account   string
+	//Instructions are the instructions in force
+	Instructions registrationinstructions.Struct
+
+	//Status, RejReasonCode and RejReasonText are taken from the last response, Status is empty until the first one
+	Status        enum.RegistStatus
+	RejReasonCode *enum.RegistRejReasonCode
+	RejReasonText string
+
+	//Canceled is true once a Cancel has been accepted
+	Canceled bool
+	//Pending holds the Replace or Cancel instructions awaiting their response
+	Pending *registrationinstructions.Struct
+}
+
+func (r *Registration) clone() Registration {
+	c := *r
+	c.RegistIDs = append([]string(nil), r.RegistIDs...)
+	if r.Pending != nil {
+		p := *r.Pending
+		c.Pending = &p
+	}
+	return c
+}
+
+//Tracker follows registrations from the RegistrationInstructions and RegistrationInstructionsResponse messages
+//exchanged with a counterparty. It is safe for concurrent use.
+type Tracker struct {
+	mu sync.Mutex
+	//registrations is keyed by every RegistID of a registration, pending ones included
+	registrations map[string]*Registration
+}
+
+//NewTracker returns a Tracker without registrations
+func NewTracker() *Tracker {
+	return &Tracker{registrations: make(map[string]*Registration)}
+}
+
+//Registration returns the registration with the given RegistID, any RegistID the registration has been in force or
+//is pending under finds it
+func (t *Tracker) Registration(registID string) (Registration, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	r, ok := t.registrations[registID]
+	if !ok {
+		return Registration{}, false
+	}
+	return r.clone(), true
+}
+
+//OnRegistrationInstructions records instructions sent or received. New instructions start a registration, Replace
+//and Cancel instructions become pending on the registration of their RegistRefID.
+func (t *Tracker) OnRegistrationInstructions(
+	msg registrationinstructions.RegistrationInstructions,
+) (Registration, error) {
+	s, err := registrationinstructions.Marshal(msg)
+	if err != nil {
+		return Registration{}, err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if _, ok := t.registrations[s.RegistID]; ok {
+		return Registration{}, &DuplicateRegistrationError{s.RegistID}
+	}
+
+	if s.RegistTransType == TransNew {
+		r := &Registration{
+			RegistID:     s.RegistID,
+			RegistIDs:    []string{s.RegistID},
+			Account:      fixutil.Deref(s.Account),
+			Instructions: s,
+		}
+		t.registrations[s.RegistID] = r
+		return r.clone(), nil
+	}
+
+	r, ok := t.registrations[s.RegistRefID]
+	if !ok {
+		return Registration{}, &UnknownRegistrationError{s.RegistRefID}
+	}
+	if r.Canceled {
+		return Registration{}, &CanceledError{s.RegistRefID}
+	}
+	if r.Pending != nil {
+		delete(t.registrations, r.Pending.RegistID)
+	}
+	r.Pending = &s
+	t.registrations[s.RegistID] = r
+	return r.clone(), nil
+}
+
+//OnRegistrationInstructionsResponse records a response sent or received. An Accepted response to pending
+//instructions brings them into force, a Rejected one drops them, and Held or Reminder responses leave them pending.
+func (t *Tracker) OnRegistrationInstructionsResponse(
+	msg registrationinstructionsresponse.RegistrationInstructionsResponse,
+) (Registration, error) {
+	s, err := registrationinstructionsresponse.Marshal(msg)
+	if err != nil {
+		return Registration{}, err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	r, ok := t.registrations[s.RegistID]
+	if !ok {
+		return Registration{}, &UnknownRegistrationError{s.RegistID}
+	}
+	r.Status = s.RegistStatus
+	r.RejReasonCode = s.RegistRejReasonCode
+	r.RejReasonText = fixutil.Deref(s.RegistRejReasonText)
+
+	if p := r.Pending; p != nil && p.RegistID == s.RegistID {
+		switch s.RegistStatus {
+		case StatusAccepted:
+			r.Pending = nil
+			if p.RegistTransType == TransCancel {
+				r.Canceled = true
+				break
+			}
+			r.RegistID = p.RegistID
+			r.RegistIDs = append(r.RegistIDs, p.RegistID)
+			r.Instructions = *p
+			if p.Account != nil {
+				r.Account = *p.Account
+			}
+		case StatusRejected:
+			r.Pending = nil
+			delete(t.registrations, p.RegistID)
+		}
+	}
+	return r.clone(), nil
+}
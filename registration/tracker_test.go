@@ -0,0 +1,191 @@
+package registration
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/terracefi/enum"
+	"github.com/terracefi/fix44/registrationinstructions"
+	"github.com/terracefi/fix44/registrationinstructionsresponse"
+)
+
+type step func(t *Tracker) (Registration, error)
+
+func instructions(r registrationinstructions.Struct) step {
+	return func(t *Tracker) (Registration, error) {
+		return t.OnRegistrationInstructions(registrationinstructions.Unmarshal(r))
+	}
+}
+
+func newRegistration(registID, account string) step {
+	return instructions(New(registID, account, details(), nil))
+}
+
+func replace(origRegistID, registID, account string) step {
+	return instructions(Replace(origRegistID, registID, New(origRegistID, account, details(), nil)))
+}
+
+func cancel(origRegistID, registID string) step {
+	return instructions(Cancel(origRegistID, registID, "ACC"))
+}
+
+func response(registID string, status enum.RegistStatus) step {
+	return func(t *Tracker) (Registration, error) {
+		return t.OnRegistrationInstructionsResponse(registrationinstructionsresponse.Unmarshal(
+			registrationinstructionsresponse.Struct{RegistID: registID, RegistRefID: registID,
+				RegistTransType: TransNew, RegistStatus: status}))
+	}
+}
+
+func TestTracker(t *testing.T) {
+	tests := []struct {
+		name      string
+		steps     []step
+		wantErr   error
+		registIDs []string
+		account   string
+		status    enum.RegistStatus
+		pending   string
+		canceled  bool
+	}{
+		{
+			name:      "new",
+			steps:     []step{newRegistration("R1", "ACC")},
+			registIDs: []string{"R1"}, account: "ACC",
+		},
+		{
+			name:      "accepted",
+			steps:     []step{newRegistration("R1", "ACC"), response("R1", StatusAccepted)},
+			registIDs: []string{"R1"}, account: "ACC", status: StatusAccepted,
+		},
+		{
+			name:      "duplicate RegistID",
+			steps:     []step{newRegistration("R1", "ACC"), newRegistration("R1", "OTHER")},
+			wantErr:   &DuplicateRegistrationError{},
+			registIDs: []string{"R1"}, account: "ACC",
+		},
+		{
+			name:      "pending replace",
+			steps:     []step{newRegistration("R1", "ACC"), replace("R1", "R2", "ACC2")},
+			registIDs: []string{"R1"}, account: "ACC", pending: "R2",
+		},
+		{
+			name: "replace accepted",
+			steps: []step{newRegistration("R1", "ACC"), replace("R1", "R2", "ACC2"),
+				response("R2", StatusAccepted)},
+			registIDs: []string{"R1", "R2"}, account: "ACC2", status: StatusAccepted,
+		},
+		{
+			name: "replace held",
+			steps: []step{newRegistration("R1", "ACC"), replace("R1", "R2", "ACC2"),
+				response("R2", StatusHeld)},
+			registIDs: []string{"R1"}, account: "ACC", status: StatusHeld, pending: "R2",
+		},
+		{
+			name: "replace rejected",
+			steps: []step{newRegistration("R1", "ACC"), replace("R1", "R2", "ACC2"),
+				response("R2", StatusRejected)},
+			registIDs: []string{"R1"}, account: "ACC", status: StatusRejected,
+		},
+		{
+			name: "response to a rejected replace",
+			steps: []step{newRegistration("R1", "ACC"), replace("R1", "R2", "ACC2"),
+				response("R2", StatusRejected), response("R2", StatusAccepted)},
+			wantErr:   &UnknownRegistrationError{},
+			registIDs: []string{"R1"}, account: "ACC", status: StatusRejected,
+		},
+		{
+			name: "replace superseded",
+			steps: []step{newRegistration("R1", "ACC"), replace("R1", "R2", "ACC2"),
+				replace("R1", "R3", "ACC3"), response("R2", StatusAccepted)},
+			wantErr:   &UnknownRegistrationError{},
+			registIDs: []string{"R1"}, account: "ACC", pending: "R3",
+		},
+		{
+			name: "replace then cancel",
+			steps: []step{newRegistration("R1", "ACC"), replace("R1", "R2", "ACC2"),
+				response("R2", StatusAccepted), cancel("R2", "R3"), response("R3", StatusAccepted)},
+			registIDs: []string{"R1", "R2"}, account: "ACC2", status: StatusAccepted, canceled: true,
+		},
+		{
+			name: "replace of a canceled registration",
+			steps: []step{newRegistration("R1", "ACC"), cancel("R1", "R2"), response("R2", StatusAccepted),
+				replace("R1", "R3", "ACC3")},
+			wantErr:   &CanceledError{},
+			registIDs: []string{"R1"}, account: "ACC", status: StatusAccepted, canceled: true,
+		},
+		{
+			name:    "replace of an unknown registration",
+			steps:   []step{replace("R1", "R2", "ACC2")},
+			wantErr: &UnknownRegistrationError{},
+		},
+		{
+			name:    "response to unknown instructions",
+			steps:   []step{response("R1", StatusAccepted)},
+			wantErr: &UnknownRegistrationError{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tr := NewTracker()
+			var err error
+			for i, s := range tt.steps {
+				_, err = s(tr)
+				if i < len(tt.steps)-1 && err != nil {
+					t.Fatalf("step %v: %v", i, err)
+				}
+			}
+			if reflect.TypeOf(err) != reflect.TypeOf(tt.wantErr) {
+				t.Fatalf("error = %v, want %T", err, tt.wantErr)
+			}
+
+			r, ok := tr.Registration("R1")
+			if ok != (tt.registIDs != nil) {
+				t.Fatalf("Registration() found = %v, want %v", ok, tt.registIDs != nil)
+			}
+			if !ok {
+				return
+			}
+			if !reflect.DeepEqual(r.RegistIDs, tt.registIDs) {
+				t.Errorf("RegistIDs = %v, want %v", r.RegistIDs, tt.registIDs)
+			}
+			if r.RegistID != tt.registIDs[len(tt.registIDs)-1] || r.Instructions.RegistID != r.RegistID {
+				t.Errorf("RegistID, Instructions.RegistID = %v, %v, want the last of %v", r.RegistID,
+					r.Instructions.RegistID, tt.registIDs)
+			}
+			if r.Account != tt.account {
+				t.Errorf("Account = %v, want %v", r.Account, tt.account)
+			}
+			if r.Status != tt.status {
+				t.Errorf("Status = %v, want %v", r.Status, tt.status)
+			}
+			var pending string
+			if r.Pending != nil {
+				pending = r.Pending.RegistID
+			}
+			if pending != tt.pending {
+				t.Errorf("Pending = %v, want %v", pending, tt.pending)
+			}
+			if r.Canceled != tt.canceled {
+				t.Errorf("Canceled = %v, want %v", r.Canceled, tt.canceled)
+			}
+		})
+	}
+}
+
+func TestTrackerRejectReason(t *testing.T) {
+	tr := NewTracker()
+	r := New("R1", "ACC", nil, nil)
+	if _, err := instructions(r)(tr); err != nil {
+		t.Fatal(err)
+	}
+	reg, err := tr.OnRegistrationInstructionsResponse(registrationinstructionsresponse.Unmarshal(Reject(r, Check(r))))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reg.Status != StatusRejected || reg.RejReasonCode == nil || *reg.RejReasonCode != RejNoRegDetails ||
+		reg.RejReasonText == "" {
+		t.Errorf("Status, RejReasonCode, RejReasonText = %v, %v, %q, want %v, %v and a text", reg.Status,
+			reg.RejReasonCode, reg.RejReasonText, StatusRejected, RejNoRegDetails)
+	}
+}
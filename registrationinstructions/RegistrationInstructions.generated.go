@@ -6,6 +6,7 @@ import (
 	"github.com/terracefi/enum"
 	"github.com/terracefi/field"
 	"github.com/terracefi/fix44"
+	"github.com/terracefi/fix44/components"
 	"github.com/terracefi/quickfix"
 	"github.com/terracefi/tag"
 )
@@ -59,6 +60,26 @@ func Route(router RouteOut) (string, string, quickfix.MessageRoute) {
 	return "FIX.4.4", "o", r
 }
 
+//Validate checks m against the FIX 4.4 definition of RegistrationInstructions, see fix44.MessageDef.Validate
+func (m RegistrationInstructions) Validate() fix44.ValidationErrors {
+	return fix44.MessageDefs["o"].Validate(&m.Body.FieldMap)
+}
+
+//MarshalJSON encodes the RegistrationInstructions as JSON with fields keyed by name, see fix44.MarshalMessageJSON
+func (m RegistrationInstructions) MarshalJSON() ([]byte, error) {
+	return fix44.MarshalMessageJSON(m.Message)
+}
+
+//UnmarshalJSON builds the RegistrationInstructions from JSON produced by MarshalJSON
+func (m *RegistrationInstructions) UnmarshalJSON(b []byte) error {
+	msg, err := fix44.UnmarshalMessageJSON(b, "o")
+	if err != nil {
+		return err
+	}
+	*m = FromMessage(msg)
+	return nil
+}
+
 //SetAccount sets Account, Tag 1
 func (m RegistrationInstructions) SetAccount(v string) {
 	m.Set(field.NewAccount(v))
@@ -281,173 +302,43 @@ func (m RegistrationInstructions) HasAcctIDSource() bool {
 	return m.Has(tag.AcctIDSource)
 }
 
-//NoPartyIDs is a repeating group element, Tag 453
-type NoPartyIDs struct {
-	*quickfix.Group
-}
-
-//SetPartyID sets PartyID, Tag 448
-func (m NoPartyIDs) SetPartyID(v string) {
-	m.Set(field.NewPartyID(v))
-}
-
-//SetPartyIDSource sets PartyIDSource, Tag 447
-func (m NoPartyIDs) SetPartyIDSource(v enum.PartyIDSource) {
-	m.Set(field.NewPartyIDSource(v))
+//GetParties gets the Parties component
+func (m RegistrationInstructions) GetParties() components.Parties {
+	return components.Parties{&m.Body.FieldMap}
 }
 
-//SetPartyRole sets PartyRole, Tag 452
-func (m NoPartyIDs) SetPartyRole(v enum.PartyRole) {
-	m.Set(field.NewPartyRole(v))
+//SetParties sets the Parties component, copying the fields present in c
+func (m RegistrationInstructions) SetParties(c components.Parties) quickfix.MessageRejectError {
+	return c.CopyInto(&m.Body.FieldMap)
 }
 
-//SetNoPartySubIDs sets NoPartySubIDs, Tag 802
-func (m NoPartyIDs) SetNoPartySubIDs(f NoPartySubIDsRepeatingGroup) {
-	m.SetGroup(f)
-}
-
-//GetPartyID gets PartyID, Tag 448
-func (m NoPartyIDs) GetPartyID() (v string, err quickfix.MessageRejectError) {
-	var f field.PartyIDField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetPartyIDSource gets PartyIDSource, Tag 447
-func (m NoPartyIDs) GetPartyIDSource() (v enum.PartyIDSource, err quickfix.MessageRejectError) {
-	var f field.PartyIDSourceField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetPartyRole gets PartyRole, Tag 452
-func (m NoPartyIDs) GetPartyRole() (v enum.PartyRole, err quickfix.MessageRejectError) {
-	var f field.PartyRoleField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetNoPartySubIDs gets NoPartySubIDs, Tag 802
-func (m NoPartyIDs) GetNoPartySubIDs() (NoPartySubIDsRepeatingGroup, quickfix.MessageRejectError) {
-	f := NewNoPartySubIDsRepeatingGroup()
-	err := m.GetGroup(f)
-	return f, err
-}
-
-//HasPartyID returns true if PartyID is present, Tag 448
-func (m NoPartyIDs) HasPartyID() bool {
-	return m.Has(tag.PartyID)
-}
+//NoPartyIDs is a repeating group element, Tag 453
+type NoPartyIDs = components.NoPartyIDs
 
-//HasPartyIDSource returns true if PartyIDSource is present, Tag 447
-func (m NoPartyIDs) HasPartyIDSource() bool {
-	return m.Has(tag.PartyIDSource)
-}
+//NoPartyIDsRepeatingGroup is a repeating group, Tag 453
+type NoPartyIDsRepeatingGroup = components.NoPartyIDsRepeatingGroup
 
-//HasPartyRole returns true if PartyRole is present, Tag 452
-func (m NoPartyIDs) HasPartyRole() bool {
-	return m.Has(tag.PartyRole)
+//NewNoPartyIDsRepeatingGroup returns an initialized, NoPartyIDsRepeatingGroup
+func NewNoPartyIDsRepeatingGroup() NoPartyIDsRepeatingGroup {
+	return components.NewNoPartyIDsRepeatingGroup()
 }
 
-//HasNoPartySubIDs returns true if NoPartySubIDs is present, Tag 802
-func (m NoPartyIDs) HasNoPartySubIDs() bool {
-	return m.Has(tag.NoPartySubIDs)
-}
+//NoPartyIDsStruct is a plain Go representation of a NoPartyIDs group element
+type NoPartyIDsStruct = components.NoPartyIDsStruct
 
 //NoPartySubIDs is a repeating group element, Tag 802
-type NoPartySubIDs struct {
-	*quickfix.Group
-}
-
-//SetPartySubID sets PartySubID, Tag 523
-func (m NoPartySubIDs) SetPartySubID(v string) {
-	m.Set(field.NewPartySubID(v))
-}
-
-//SetPartySubIDType sets PartySubIDType, Tag 803
-func (m NoPartySubIDs) SetPartySubIDType(v enum.PartySubIDType) {
-	m.Set(field.NewPartySubIDType(v))
-}
-
-//GetPartySubID gets PartySubID, Tag 523
-func (m NoPartySubIDs) GetPartySubID() (v string, err quickfix.MessageRejectError) {
-	var f field.PartySubIDField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetPartySubIDType gets PartySubIDType, Tag 803
-func (m NoPartySubIDs) GetPartySubIDType() (v enum.PartySubIDType, err quickfix.MessageRejectError) {
-	var f field.PartySubIDTypeField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//HasPartySubID returns true if PartySubID is present, Tag 523
-func (m NoPartySubIDs) HasPartySubID() bool {
-	return m.Has(tag.PartySubID)
-}
-
-//HasPartySubIDType returns true if PartySubIDType is present, Tag 803
-func (m NoPartySubIDs) HasPartySubIDType() bool {
-	return m.Has(tag.PartySubIDType)
-}
+type NoPartySubIDs = components.NoPartySubIDs
 
 //NoPartySubIDsRepeatingGroup is a repeating group, Tag 802
-type NoPartySubIDsRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
+type NoPartySubIDsRepeatingGroup = components.NoPartySubIDsRepeatingGroup
 
 //NewNoPartySubIDsRepeatingGroup returns an initialized, NoPartySubIDsRepeatingGroup
 func NewNoPartySubIDsRepeatingGroup() NoPartySubIDsRepeatingGroup {
-	return NoPartySubIDsRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoPartySubIDs,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.PartySubID), quickfix.GroupElement(tag.PartySubIDType)})}
-}
-
-//Add create and append a new NoPartySubIDs to this group
-func (m NoPartySubIDsRepeatingGroup) Add() NoPartySubIDs {
-	g := m.RepeatingGroup.Add()
-	return NoPartySubIDs{g}
-}
-
-//Get returns the ith NoPartySubIDs in the NoPartySubIDsRepeatinGroup
-func (m NoPartySubIDsRepeatingGroup) Get(i int) NoPartySubIDs {
-	return NoPartySubIDs{m.RepeatingGroup.Get(i)}
-}
-
-//NoPartyIDsRepeatingGroup is a repeating group, Tag 453
-type NoPartyIDsRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
-
-//NewNoPartyIDsRepeatingGroup returns an initialized, NoPartyIDsRepeatingGroup
-func NewNoPartyIDsRepeatingGroup() NoPartyIDsRepeatingGroup {
-	return NoPartyIDsRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoPartyIDs,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.PartyID), quickfix.GroupElement(tag.PartyIDSource), quickfix.GroupElement(tag.PartyRole), NewNoPartySubIDsRepeatingGroup()})}
-}
-
-//Add create and append a new NoPartyIDs to this group
-func (m NoPartyIDsRepeatingGroup) Add() NoPartyIDs {
-	g := m.RepeatingGroup.Add()
-	return NoPartyIDs{g}
+	return components.NewNoPartySubIDsRepeatingGroup()
 }
 
-//Get returns the ith NoPartyIDs in the NoPartyIDsRepeatinGroup
-func (m NoPartyIDsRepeatingGroup) Get(i int) NoPartyIDs {
-	return NoPartyIDs{m.RepeatingGroup.Get(i)}
-}
+//NoPartySubIDsStruct is a plain Go representation of a NoPartySubIDs group element
+type NoPartySubIDsStruct = components.NoPartySubIDsStruct
 
 //NoRegistDtls is a repeating group element, Tag 473
 type NoRegistDtls struct {
@@ -604,173 +495,43 @@ func (m NoRegistDtls) HasInvestorCountryOfResidence() bool {
 	return m.Has(tag.InvestorCountryOfResidence)
 }
 
-//NoNestedPartyIDs is a repeating group element, Tag 539
-type NoNestedPartyIDs struct {
-	*quickfix.Group
-}
-
-//SetNestedPartyID sets NestedPartyID, Tag 524
-func (m NoNestedPartyIDs) SetNestedPartyID(v string) {
-	m.Set(field.NewNestedPartyID(v))
-}
-
-//SetNestedPartyIDSource sets NestedPartyIDSource, Tag 525
-func (m NoNestedPartyIDs) SetNestedPartyIDSource(v string) {
-	m.Set(field.NewNestedPartyIDSource(v))
-}
-
-//SetNestedPartyRole sets NestedPartyRole, Tag 538
-func (m NoNestedPartyIDs) SetNestedPartyRole(v int) {
-	m.Set(field.NewNestedPartyRole(v))
-}
-
-//SetNoNestedPartySubIDs sets NoNestedPartySubIDs, Tag 804
-func (m NoNestedPartyIDs) SetNoNestedPartySubIDs(f NoNestedPartySubIDsRepeatingGroup) {
-	m.SetGroup(f)
-}
-
-//GetNestedPartyID gets NestedPartyID, Tag 524
-func (m NoNestedPartyIDs) GetNestedPartyID() (v string, err quickfix.MessageRejectError) {
-	var f field.NestedPartyIDField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
+//GetNestedParties gets the NestedParties component
+func (m NoRegistDtls) GetNestedParties() components.NestedParties {
+	return components.NestedParties{&m.Group.FieldMap}
 }
 
-//GetNestedPartyIDSource gets NestedPartyIDSource, Tag 525
-func (m NoNestedPartyIDs) GetNestedPartyIDSource() (v string, err quickfix.MessageRejectError) {
-	var f field.NestedPartyIDSourceField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
+//SetNestedParties sets the NestedParties component, copying the fields present in c
+func (m NoRegistDtls) SetNestedParties(c components.NestedParties) quickfix.MessageRejectError {
+	return c.CopyInto(&m.Group.FieldMap)
 }
 
-//GetNestedPartyRole gets NestedPartyRole, Tag 538
-func (m NoNestedPartyIDs) GetNestedPartyRole() (v int, err quickfix.MessageRejectError) {
-	var f field.NestedPartyRoleField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetNoNestedPartySubIDs gets NoNestedPartySubIDs, Tag 804
-func (m NoNestedPartyIDs) GetNoNestedPartySubIDs() (NoNestedPartySubIDsRepeatingGroup, quickfix.MessageRejectError) {
-	f := NewNoNestedPartySubIDsRepeatingGroup()
-	err := m.GetGroup(f)
-	return f, err
-}
-
-//HasNestedPartyID returns true if NestedPartyID is present, Tag 524
-func (m NoNestedPartyIDs) HasNestedPartyID() bool {
-	return m.Has(tag.NestedPartyID)
-}
+//NoNestedPartyIDs is a repeating group element, Tag 539
+type NoNestedPartyIDs = components.NoNestedPartyIDs
 
-//HasNestedPartyIDSource returns true if NestedPartyIDSource is present, Tag 525
-func (m NoNestedPartyIDs) HasNestedPartyIDSource() bool {
-	return m.Has(tag.NestedPartyIDSource)
-}
+//NoNestedPartyIDsRepeatingGroup is a repeating group, Tag 539
+type NoNestedPartyIDsRepeatingGroup = components.NoNestedPartyIDsRepeatingGroup
 
-//HasNestedPartyRole returns true if NestedPartyRole is present, Tag 538
-func (m NoNestedPartyIDs) HasNestedPartyRole() bool {
-	return m.Has(tag.NestedPartyRole)
+//NewNoNestedPartyIDsRepeatingGroup returns an initialized, NoNestedPartyIDsRepeatingGroup
+func NewNoNestedPartyIDsRepeatingGroup() NoNestedPartyIDsRepeatingGroup {
+	return components.NewNoNestedPartyIDsRepeatingGroup()
 }
 
-//HasNoNestedPartySubIDs returns true if NoNestedPartySubIDs is present, Tag 804
-func (m NoNestedPartyIDs) HasNoNestedPartySubIDs() bool {
-	return m.Has(tag.NoNestedPartySubIDs)
-}
+//NoNestedPartyIDsStruct is a plain Go representation of a NoNestedPartyIDs group element
+type NoNestedPartyIDsStruct = components.NoNestedPartyIDsStruct
 
 //NoNestedPartySubIDs is a repeating group element, Tag 804
-type NoNestedPartySubIDs struct {
-	*quickfix.Group
-}
-
-//SetNestedPartySubID sets NestedPartySubID, Tag 545
-func (m NoNestedPartySubIDs) SetNestedPartySubID(v string) {
-	m.Set(field.NewNestedPartySubID(v))
-}
-
-//SetNestedPartySubIDType sets NestedPartySubIDType, Tag 805
-func (m NoNestedPartySubIDs) SetNestedPartySubIDType(v int) {
-	m.Set(field.NewNestedPartySubIDType(v))
-}
-
-//GetNestedPartySubID gets NestedPartySubID, Tag 545
-func (m NoNestedPartySubIDs) GetNestedPartySubID() (v string, err quickfix.MessageRejectError) {
-	var f field.NestedPartySubIDField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetNestedPartySubIDType gets NestedPartySubIDType, Tag 805
-func (m NoNestedPartySubIDs) GetNestedPartySubIDType() (v int, err quickfix.MessageRejectError) {
-	var f field.NestedPartySubIDTypeField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//HasNestedPartySubID returns true if NestedPartySubID is present, Tag 545
-func (m NoNestedPartySubIDs) HasNestedPartySubID() bool {
-	return m.Has(tag.NestedPartySubID)
-}
-
-//HasNestedPartySubIDType returns true if NestedPartySubIDType is present, Tag 805
-func (m NoNestedPartySubIDs) HasNestedPartySubIDType() bool {
-	return m.Has(tag.NestedPartySubIDType)
-}
+type NoNestedPartySubIDs = components.NoNestedPartySubIDs
 
 //NoNestedPartySubIDsRepeatingGroup is a repeating group, Tag 804
-type NoNestedPartySubIDsRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
+type NoNestedPartySubIDsRepeatingGroup = components.NoNestedPartySubIDsRepeatingGroup
 
 //NewNoNestedPartySubIDsRepeatingGroup returns an initialized, NoNestedPartySubIDsRepeatingGroup
 func NewNoNestedPartySubIDsRepeatingGroup() NoNestedPartySubIDsRepeatingGroup {
-	return NoNestedPartySubIDsRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoNestedPartySubIDs,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.NestedPartySubID), quickfix.GroupElement(tag.NestedPartySubIDType)})}
-}
-
-//Add create and append a new NoNestedPartySubIDs to this group
-func (m NoNestedPartySubIDsRepeatingGroup) Add() NoNestedPartySubIDs {
-	g := m.RepeatingGroup.Add()
-	return NoNestedPartySubIDs{g}
-}
-
-//Get returns the ith NoNestedPartySubIDs in the NoNestedPartySubIDsRepeatinGroup
-func (m NoNestedPartySubIDsRepeatingGroup) Get(i int) NoNestedPartySubIDs {
-	return NoNestedPartySubIDs{m.RepeatingGroup.Get(i)}
-}
-
-//NoNestedPartyIDsRepeatingGroup is a repeating group, Tag 539
-type NoNestedPartyIDsRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
-
-//NewNoNestedPartyIDsRepeatingGroup returns an initialized, NoNestedPartyIDsRepeatingGroup
-func NewNoNestedPartyIDsRepeatingGroup() NoNestedPartyIDsRepeatingGroup {
-	return NoNestedPartyIDsRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoNestedPartyIDs,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.NestedPartyID), quickfix.GroupElement(tag.NestedPartyIDSource), quickfix.GroupElement(tag.NestedPartyRole), NewNoNestedPartySubIDsRepeatingGroup()})}
-}
-
-//Add create and append a new NoNestedPartyIDs to this group
-func (m NoNestedPartyIDsRepeatingGroup) Add() NoNestedPartyIDs {
-	g := m.RepeatingGroup.Add()
-	return NoNestedPartyIDs{g}
+	return components.NewNoNestedPartySubIDsRepeatingGroup()
 }
 
-//Get returns the ith NoNestedPartyIDs in the NoNestedPartyIDsRepeatinGroup
-func (m NoNestedPartyIDsRepeatingGroup) Get(i int) NoNestedPartyIDs {
-	return NoNestedPartyIDs{m.RepeatingGroup.Get(i)}
-}
+//NoNestedPartySubIDsStruct is a plain Go representation of a NoNestedPartySubIDs group element
+type NoNestedPartySubIDsStruct = components.NoNestedPartySubIDsStruct
 
 //NoRegistDtlsRepeatingGroup is a repeating group, Tag 473
 type NoRegistDtlsRepeatingGroup struct {
@@ -974,3 +735,370 @@ func (m NoDistribInstsRepeatingGroup) Add() NoDistribInsts {
 func (m NoDistribInstsRepeatingGroup) Get(i int) NoDistribInsts {
 	return NoDistribInsts{m.RepeatingGroup.Get(i)}
 }
+
+//Struct is a plain Go representation of the RegistrationInstructions body, optional fields are nil when absent
+type Struct struct {
+	Account          *string
+	ClOrdID          *string
+	NoPartyIDs       []NoPartyIDsStruct
+	NoRegistDtls     []NoRegistDtlsStruct
+	RegistAcctType   *string
+	TaxAdvantageType *enum.TaxAdvantageType
+	RegistRefID      string
+	NoDistribInsts   []NoDistribInstsStruct
+	RegistID         string
+	RegistTransType  enum.RegistTransType
+	OwnershipType    *enum.OwnershipType
+	AcctIDSource     *enum.AcctIDSource
+}
+
+//Marshal copies the fields present in msg into a Struct
+func Marshal(msg RegistrationInstructions) (Struct, quickfix.MessageRejectError) {
+	var s Struct
+	if msg.HasAccount() {
+		v, err := msg.GetAccount()
+		if err != nil {
+			return s, err
+		}
+		s.Account = &v
+	}
+	if msg.HasClOrdID() {
+		v, err := msg.GetClOrdID()
+		if err != nil {
+			return s, err
+		}
+		s.ClOrdID = &v
+	}
+	if msg.HasNoPartyIDs() {
+		g, err := msg.GetNoPartyIDs()
+		if err != nil {
+			return s, err
+		}
+		if s.NoPartyIDs, err = components.MarshalNoPartyIDs(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasNoRegistDtls() {
+		g, err := msg.GetNoRegistDtls()
+		if err != nil {
+			return s, err
+		}
+		if s.NoRegistDtls, err = MarshalNoRegistDtls(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasRegistAcctType() {
+		v, err := msg.GetRegistAcctType()
+		if err != nil {
+			return s, err
+		}
+		s.RegistAcctType = &v
+	}
+	if msg.HasTaxAdvantageType() {
+		v, err := msg.GetTaxAdvantageType()
+		if err != nil {
+			return s, err
+		}
+		s.TaxAdvantageType = &v
+	}
+	if msg.HasRegistRefID() {
+		v, err := msg.GetRegistRefID()
+		if err != nil {
+			return s, err
+		}
+		s.RegistRefID = v
+	}
+	if msg.HasNoDistribInsts() {
+		g, err := msg.GetNoDistribInsts()
+		if err != nil {
+			return s, err
+		}
+		if s.NoDistribInsts, err = MarshalNoDistribInsts(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasRegistID() {
+		v, err := msg.GetRegistID()
+		if err != nil {
+			return s, err
+		}
+		s.RegistID = v
+	}
+	if msg.HasRegistTransType() {
+		v, err := msg.GetRegistTransType()
+		if err != nil {
+			return s, err
+		}
+		s.RegistTransType = v
+	}
+	if msg.HasOwnershipType() {
+		v, err := msg.GetOwnershipType()
+		if err != nil {
+			return s, err
+		}
+		s.OwnershipType = &v
+	}
+	if msg.HasAcctIDSource() {
+		v, err := msg.GetAcctIDSource()
+		if err != nil {
+			return s, err
+		}
+		s.AcctIDSource = &v
+	}
+	return s, nil
+}
+
+//Unmarshal builds a RegistrationInstructions from s
+func Unmarshal(s Struct) RegistrationInstructions {
+	m := New(field.NewRegistID(s.RegistID), field.NewRegistTransType(s.RegistTransType), field.NewRegistRefID(s.RegistRefID))
+	if s.Account != nil {
+		m.SetAccount(*s.Account)
+	}
+	if s.ClOrdID != nil {
+		m.SetClOrdID(*s.ClOrdID)
+	}
+	if s.NoPartyIDs != nil {
+		m.SetNoPartyIDs(components.UnmarshalNoPartyIDs(s.NoPartyIDs))
+	}
+	if s.NoRegistDtls != nil {
+		m.SetNoRegistDtls(UnmarshalNoRegistDtls(s.NoRegistDtls))
+	}
+	if s.RegistAcctType != nil {
+		m.SetRegistAcctType(*s.RegistAcctType)
+	}
+	if s.TaxAdvantageType != nil {
+		m.SetTaxAdvantageType(*s.TaxAdvantageType)
+	}
+	if s.NoDistribInsts != nil {
+		m.SetNoDistribInsts(UnmarshalNoDistribInsts(s.NoDistribInsts))
+	}
+	if s.OwnershipType != nil {
+		m.SetOwnershipType(*s.OwnershipType)
+	}
+	if s.AcctIDSource != nil {
+		m.SetAcctIDSource(*s.AcctIDSource)
+	}
+	return m
+}
+
+//NoRegistDtlsStruct is a plain Go representation of a NoRegistDtls group element, optional fields are nil when absent
+type NoRegistDtlsStruct struct {
+	RegistDtls                 *string
+	RegistEmail                *string
+	MailingDtls                *string
+	MailingInst                *string
+	NoNestedPartyIDs           []NoNestedPartyIDsStruct
+	OwnerType                  *enum.OwnerType
+	DateOfBirth                *string
+	InvestorCountryOfResidence *string
+}
+
+//MarshalNoRegistDtls copies the elements of g into a slice of NoRegistDtlsStruct
+func MarshalNoRegistDtls(g NoRegistDtlsRepeatingGroup) ([]NoRegistDtlsStruct, quickfix.MessageRejectError) {
+	s := make([]NoRegistDtlsStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasRegistDtls() {
+			v, err := m.GetRegistDtls()
+			if err != nil {
+				return nil, err
+			}
+			s[i].RegistDtls = &v
+		}
+		if m.HasRegistEmail() {
+			v, err := m.GetRegistEmail()
+			if err != nil {
+				return nil, err
+			}
+			s[i].RegistEmail = &v
+		}
+		if m.HasMailingDtls() {
+			v, err := m.GetMailingDtls()
+			if err != nil {
+				return nil, err
+			}
+			s[i].MailingDtls = &v
+		}
+		if m.HasMailingInst() {
+			v, err := m.GetMailingInst()
+			if err != nil {
+				return nil, err
+			}
+			s[i].MailingInst = &v
+		}
+		if m.HasNoNestedPartyIDs() {
+			g, err := m.GetNoNestedPartyIDs()
+			if err != nil {
+				return nil, err
+			}
+			if s[i].NoNestedPartyIDs, err = components.MarshalNoNestedPartyIDs(g); err != nil {
+				return nil, err
+			}
+		}
+		if m.HasOwnerType() {
+			v, err := m.GetOwnerType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].OwnerType = &v
+		}
+		if m.HasDateOfBirth() {
+			v, err := m.GetDateOfBirth()
+			if err != nil {
+				return nil, err
+			}
+			s[i].DateOfBirth = &v
+		}
+		if m.HasInvestorCountryOfResidence() {
+			v, err := m.GetInvestorCountryOfResidence()
+			if err != nil {
+				return nil, err
+			}
+			s[i].InvestorCountryOfResidence = &v
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoRegistDtls builds a NoRegistDtlsRepeatingGroup from s
+func UnmarshalNoRegistDtls(s []NoRegistDtlsStruct) NoRegistDtlsRepeatingGroup {
+	g := NewNoRegistDtlsRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.RegistDtls != nil {
+			m.SetRegistDtls(*e.RegistDtls)
+		}
+		if e.RegistEmail != nil {
+			m.SetRegistEmail(*e.RegistEmail)
+		}
+		if e.MailingDtls != nil {
+			m.SetMailingDtls(*e.MailingDtls)
+		}
+		if e.MailingInst != nil {
+			m.SetMailingInst(*e.MailingInst)
+		}
+		if e.NoNestedPartyIDs != nil {
+			m.SetNoNestedPartyIDs(components.UnmarshalNoNestedPartyIDs(e.NoNestedPartyIDs))
+		}
+		if e.OwnerType != nil {
+			m.SetOwnerType(*e.OwnerType)
+		}
+		if e.DateOfBirth != nil {
+			m.SetDateOfBirth(*e.DateOfBirth)
+		}
+		if e.InvestorCountryOfResidence != nil {
+			m.SetInvestorCountryOfResidence(*e.InvestorCountryOfResidence)
+		}
+	}
+	return g
+}
+
+//NoDistribInstsStruct is a plain Go representation of a NoDistribInsts group element, optional fields are nil when absent
+type NoDistribInstsStruct struct {
+	DistribPaymentMethod       *enum.DistribPaymentMethod
+	DistribPercentage          *decimal.Decimal
+	CashDistribCurr            *string
+	CashDistribAgentName       *string
+	CashDistribAgentCode       *string
+	CashDistribAgentAcctNumber *string
+	CashDistribPayRef          *string
+	CashDistribAgentAcctName   *string
+}
+
+//MarshalNoDistribInsts copies the elements of g into a slice of NoDistribInstsStruct
+func MarshalNoDistribInsts(g NoDistribInstsRepeatingGroup) ([]NoDistribInstsStruct, quickfix.MessageRejectError) {
+	s := make([]NoDistribInstsStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasDistribPaymentMethod() {
+			v, err := m.GetDistribPaymentMethod()
+			if err != nil {
+				return nil, err
+			}
+			s[i].DistribPaymentMethod = &v
+		}
+		if m.HasDistribPercentage() {
+			v, err := m.GetDistribPercentage()
+			if err != nil {
+				return nil, err
+			}
+			s[i].DistribPercentage = &v
+		}
+		if m.HasCashDistribCurr() {
+			v, err := m.GetCashDistribCurr()
+			if err != nil {
+				return nil, err
+			}
+			s[i].CashDistribCurr = &v
+		}
+		if m.HasCashDistribAgentName() {
+			v, err := m.GetCashDistribAgentName()
+			if err != nil {
+				return nil, err
+			}
+			s[i].CashDistribAgentName = &v
+		}
+		if m.HasCashDistribAgentCode() {
+			v, err := m.GetCashDistribAgentCode()
+			if err != nil {
+				return nil, err
+			}
+			s[i].CashDistribAgentCode = &v
+		}
+		if m.HasCashDistribAgentAcctNumber() {
+			v, err := m.GetCashDistribAgentAcctNumber()
+			if err != nil {
+				return nil, err
+			}
+			s[i].CashDistribAgentAcctNumber = &v
+		}
+		if m.HasCashDistribPayRef() {
+			v, err := m.GetCashDistribPayRef()
+			if err != nil {
+				return nil, err
+			}
+			s[i].CashDistribPayRef = &v
+		}
+		if m.HasCashDistribAgentAcctName() {
+			v, err := m.GetCashDistribAgentAcctName()
+			if err != nil {
+				return nil, err
+			}
+			s[i].CashDistribAgentAcctName = &v
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoDistribInsts builds a NoDistribInstsRepeatingGroup from s
+func UnmarshalNoDistribInsts(s []NoDistribInstsStruct) NoDistribInstsRepeatingGroup {
+	g := NewNoDistribInstsRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.DistribPaymentMethod != nil {
+			m.SetDistribPaymentMethod(*e.DistribPaymentMethod)
+		}
+		if e.DistribPercentage != nil {
+			m.SetDistribPercentage(*e.DistribPercentage, fix44.DecimalScale(*e.DistribPercentage))
+		}
+		if e.CashDistribCurr != nil {
+			m.SetCashDistribCurr(*e.CashDistribCurr)
+		}
+		if e.CashDistribAgentName != nil {
+			m.SetCashDistribAgentName(*e.CashDistribAgentName)
+		}
+		if e.CashDistribAgentCode != nil {
+			m.SetCashDistribAgentCode(*e.CashDistribAgentCode)
+		}
+		if e.CashDistribAgentAcctNumber != nil {
+			m.SetCashDistribAgentAcctNumber(*e.CashDistribAgentAcctNumber)
+		}
+		if e.CashDistribPayRef != nil {
+			m.SetCashDistribPayRef(*e.CashDistribPayRef)
+		}
+		if e.CashDistribAgentAcctName != nil {
+			m.SetCashDistribAgentAcctName(*e.CashDistribAgentAcctName)
+		}
+	}
+	return g
+}
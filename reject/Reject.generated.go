@@ -55,6 +55,26 @@ func Route(router RouteOut) (string, string, quickfix.MessageRoute) {
 	return "FIX.4.4", "3", r
 }
 
+//Validate checks m against the FIX 4.4 definition of Reject, see fix44.MessageDef.Validate
+func (m Reject) Validate() fix44.ValidationErrors {
+	return fix44.MessageDefs["3"].Validate(&m.Body.FieldMap)
+}
+
+//MarshalJSON encodes the Reject as JSON with fields keyed by name, see fix44.MarshalMessageJSON
+func (m Reject) MarshalJSON() ([]byte, error) {
+	return fix44.MarshalMessageJSON(m.Message)
+}
+
+//UnmarshalJSON builds the Reject from JSON produced by MarshalJSON
+func (m *Reject) UnmarshalJSON(b []byte) error {
+	msg, err := fix44.UnmarshalMessageJSON(b, "3")
+	if err != nil {
+		return err
+	}
+	*m = FromMessage(msg)
+	return nil
+}
+
 //SetRefSeqNum sets RefSeqNum, Tag 45
 func (m Reject) SetRefSeqNum(v int) {
 	m.Set(field.NewRefSeqNum(v))
@@ -173,6 +193,21 @@ func (m Reject) HasEncodedText() bool {
 	return m.Has(tag.EncodedText)
 }
 
+//SetEncodedTextBytes sets EncodedText, Tag 355, and EncodedTextLen, Tag 354, from v, which may hold any bytes including SOH
+func (m Reject) SetEncodedTextBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedTextLen, tag.EncodedText, v)
+}
+
+//GetEncodedTextBytes gets EncodedText, Tag 355, checking its length against EncodedTextLen, Tag 354
+func (m Reject) GetEncodedTextBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedTextLen, tag.EncodedText)
+}
+
+//GetEncodedTextDecoded gets EncodedText, Tag 355, decoded to UTF-8 using the MessageEncoding of the Header
+func (m Reject) GetEncodedTextDecoded() (string, error) {
+	return fix44.DecodeRawData(m.Header, m, tag.EncodedTextLen, tag.EncodedText)
+}
+
 //HasRefTagID returns true if RefTagID is present, Tag 371
 func (m Reject) HasRefTagID() bool {
 	return m.Has(tag.RefTagID)
@@ -187,3 +222,93 @@ func (m Reject) HasRefMsgType() bool {
 func (m Reject) HasSessionRejectReason() bool {
 	return m.Has(tag.SessionRejectReason)
 }
+
+//Struct is a plain Go representation of the Reject body, optional fields are nil when absent
+type Struct struct {
+	RefSeqNum           int
+	Text                *string
+	EncodedTextLen      *int
+	EncodedText         *string
+	RefTagID            *int
+	RefMsgType          *string
+	SessionRejectReason *enum.SessionRejectReason
+}
+
+//Marshal copies the fields present in msg into a Struct
+func Marshal(msg Reject) (Struct, quickfix.MessageRejectError) {
+	var s Struct
+	if msg.HasRefSeqNum() {
+		v, err := msg.GetRefSeqNum()
+		if err != nil {
+			return s, err
+		}
+		s.RefSeqNum = v
+	}
+	if msg.HasText() {
+		v, err := msg.GetText()
+		if err != nil {
+			return s, err
+		}
+		s.Text = &v
+	}
+	if msg.HasEncodedTextLen() {
+		v, err := msg.GetEncodedTextLen()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedTextLen = &v
+	}
+	if msg.HasEncodedText() {
+		v, err := msg.GetEncodedText()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedText = &v
+	}
+	if msg.HasRefTagID() {
+		v, err := msg.GetRefTagID()
+		if err != nil {
+			return s, err
+		}
+		s.RefTagID = &v
+	}
+	if msg.HasRefMsgType() {
+		v, err := msg.GetRefMsgType()
+		if err != nil {
+			return s, err
+		}
+		s.RefMsgType = &v
+	}
+	if msg.HasSessionRejectReason() {
+		v, err := msg.GetSessionRejectReason()
+		if err != nil {
+			return s, err
+		}
+		s.SessionRejectReason = &v
+	}
+	return s, nil
+}
+
+//Unmarshal builds a Reject from s
+func Unmarshal(s Struct) Reject {
+	m := New(field.NewRefSeqNum(s.RefSeqNum))
+	if s.Text != nil {
+		m.SetText(*s.Text)
+	}
+	if s.EncodedTextLen != nil {
+		m.SetEncodedTextLen(*s.EncodedTextLen)
+	}
+	if s.EncodedText != nil {
+		m.SetEncodedText(*s.EncodedText)
+	}
+	if s.RefTagID != nil {
+		m.SetRefTagID(*s.RefTagID)
+	}
+	if s.RefMsgType != nil {
+		m.SetRefMsgType(*s.RefMsgType)
+	}
+	if s.SessionRejectReason != nil {
+		m.SetSessionRejectReason(*s.SessionRejectReason)
+	}
+	return m
+}
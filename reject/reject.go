@@ -0,0 +1,33 @@
+package reject
+
+import (
+	"strconv"
+
+	"github.com/terracefi/enum"
+	"github.com/terracefi/field"
+	"github.com/terracefi/quickfix"
+	"github.com/terracefi/tag"
+)
+
+//FromError returns the Reject that answers msg for the session level error rej. RefSeqNum and RefMsgType are taken
+//from the Header of msg, RefTagID, SessionRejectReason and Text from rej. Errors for which rej.IsBusinessReject() is
+//true should be answered with businessmessagereject.FromError instead. RefSeqNum is required, so an error is returned
+//if msg has no MsgSeqNum.
+func FromError(msg *quickfix.Message, rej quickfix.MessageRejectError) (Reject, quickfix.MessageRejectError) {
+	refSeqNum, err := msg.Header.GetInt(tag.MsgSeqNum)
+	if err != nil {
+		return Reject{}, err
+	}
+	m := New(field.NewRefSeqNum(refSeqNum))
+
+	if refMsgType, err := msg.Header.GetString(tag.MsgType); err == nil {
+		m.SetRefMsgType(refMsgType)
+	}
+	if refTagID := rej.RefTagID(); refTagID != nil {
+		m.SetRefTagID(int(*refTagID))
+	}
+	m.SetSessionRejectReason(enum.SessionRejectReason(strconv.Itoa(rej.RejectReason())))
+	m.SetText(rej.Error())
+
+	return m, nil
+}
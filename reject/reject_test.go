@@ -0,0 +1,54 @@
+package reject
+
+import (
+	"testing"
+
+	"github.com/terracefi/enum"
+	"github.com/terracefi/quickfix"
+	"github.com/terracefi/tag"
+)
+
+func received(seqNum int) *quickfix.Message {
+	msg := quickfix.NewMessage()
+	msg.Header.SetString(tag.MsgType, "D")
+	if seqNum > 0 {
+		msg.Header.SetInt(tag.MsgSeqNum, seqNum)
+	}
+	return msg
+}
+
+func TestFromError(t *testing.T) {
+	m, err := FromError(received(7), quickfix.RequiredTagMissing(tag.ClOrdID))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, err := m.GetRefSeqNum(); err != nil || v != 7 {
+		t.Errorf("RefSeqNum = %v, %v, want 7", v, err)
+	}
+	if v, err := m.GetRefMsgType(); err != nil || v != "D" {
+		t.Errorf("RefMsgType = %q, %v, want D", v, err)
+	}
+	if v, err := m.GetRefTagID(); err != nil || v != int(tag.ClOrdID) {
+		t.Errorf("RefTagID = %v, %v, want %v", v, err, tag.ClOrdID)
+	}
+	if v, err := m.GetSessionRejectReason(); err != nil || v != enum.SessionRejectReason("1") {
+		t.Errorf("SessionRejectReason = %q, %v, want 1", v, err)
+	}
+	if v, err := m.GetText(); err != nil || v != quickfix.RequiredTagMissing(tag.ClOrdID).Error() {
+		t.Errorf("Text = %q, %v", v, err)
+	}
+
+	m, err = FromError(received(7), quickfix.NewMessageRejectError("bad", 99, nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.HasRefTagID() {
+		t.Error("RefTagID is set for an error without one")
+	}
+}
+
+func TestFromErrorWithoutMsgSeqNum(t *testing.T) {
+	if _, err := FromError(received(0), quickfix.RequiredTagMissing(tag.ClOrdID)); err == nil {
+		t.Error("FromError() error = nil for a message without MsgSeqNum")
+	}
+}
@@ -55,6 +55,26 @@ func Route(router RouteOut) (string, string, quickfix.MessageRoute) {
 	return "FIX.4.4", "2", r
 }
 
+//Validate checks m against the FIX 4.4 definition of ResendRequest, see fix44.MessageDef.Validate
+func (m ResendRequest) Validate() fix44.ValidationErrors {
+	return fix44.MessageDefs["2"].Validate(&m.Body.FieldMap)
+}
+
+//MarshalJSON encodes the ResendRequest as JSON with fields keyed by name, see fix44.MarshalMessageJSON
+func (m ResendRequest) MarshalJSON() ([]byte, error) {
+	return fix44.MarshalMessageJSON(m.Message)
+}
+
+//UnmarshalJSON builds the ResendRequest from JSON produced by MarshalJSON
+func (m *ResendRequest) UnmarshalJSON(b []byte) error {
+	msg, err := fix44.UnmarshalMessageJSON(b, "2")
+	if err != nil {
+		return err
+	}
+	*m = FromMessage(msg)
+	return nil
+}
+
 //SetBeginSeqNo sets BeginSeqNo, Tag 7
 func (m ResendRequest) SetBeginSeqNo(v int) {
 	m.Set(field.NewBeginSeqNo(v))
@@ -92,3 +112,35 @@ func (m ResendRequest) HasBeginSeqNo() bool {
 func (m ResendRequest) HasEndSeqNo() bool {
 	return m.Has(tag.EndSeqNo)
 }
+
+//Struct is a plain Go representation of the ResendRequest body, optional fields are nil when absent
+type Struct struct {
+	BeginSeqNo int
+	EndSeqNo   int
+}
+
+//Marshal copies the fields present in msg into a Struct
+func Marshal(msg ResendRequest) (Struct, quickfix.MessageRejectError) {
+	var s Struct
+	if msg.HasBeginSeqNo() {
+		v, err := msg.GetBeginSeqNo()
+		if err != nil {
+			return s, err
+		}
+		s.BeginSeqNo = v
+	}
+	if msg.HasEndSeqNo() {
+		v, err := msg.GetEndSeqNo()
+		if err != nil {
+			return s, err
+		}
+		s.EndSeqNo = v
+	}
+	return s, nil
+}
+
+//Unmarshal builds a ResendRequest from s
+func Unmarshal(s Struct) ResendRequest {
+	m := New(field.NewBeginSeqNo(s.BeginSeqNo), field.NewEndSeqNo(s.EndSeqNo))
+	return m
+}
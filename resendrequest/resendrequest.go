@@ -0,0 +1,27 @@
+package resendrequest
+
+import (
+	"github.com/terracefi/field"
+	"github.com/terracefi/quickfix"
+)
+
+//NewForGap returns the ResendRequest to send when a message arrives with a MsgSeqNum higher than expectedSeqNum. It
+//asks for every message from expectedSeqNum on, with EndSeqNo = 0 as recommended by FIX 4.4.
+func NewForGap(expectedSeqNum int) ResendRequest {
+	return New(field.NewBeginSeqNo(expectedSeqNum), field.NewEndSeqNo(0))
+}
+
+//Range returns the MsgSeqNums to resend for m. An EndSeqNo of 0, meaning infinity, or beyond lastSeqNum, the
+//MsgSeqNum of the last message sent, is replaced with lastSeqNum.
+func (m ResendRequest) Range(lastSeqNum int) (begin, end int, err quickfix.MessageRejectError) {
+	if begin, err = m.GetBeginSeqNo(); err != nil {
+		return
+	}
+	if end, err = m.GetEndSeqNo(); err != nil {
+		return
+	}
+	if end == 0 || end > lastSeqNum {
+		end = lastSeqNum
+	}
+	return
+}
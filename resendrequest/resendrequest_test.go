@@ -0,0 +1,39 @@
+package resendrequest
+
+import (
+	"testing"
+
+	"github.com/terracefi/field"
+)
+
+func TestNewForGap(t *testing.T) {
+	m := NewForGap(5)
+	if v, err := m.GetBeginSeqNo(); err != nil || v != 5 {
+		t.Errorf("BeginSeqNo = %v, %v, want 5", v, err)
+	}
+	if v, err := m.GetEndSeqNo(); err != nil || v != 0 {
+		t.Errorf("EndSeqNo = %v, %v, want 0", v, err)
+	}
+}
+
+func TestRange(t *testing.T) {
+	tests := []struct {
+		name             string
+		begin, end, last int
+		wantBegin        int
+		wantEnd          int
+	}{
+		{"infinity", 5, 0, 9, 5, 9},
+		{"within", 5, 7, 9, 5, 7},
+		{"beyond last", 5, 12, 9, 5, 9},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := New(field.NewBeginSeqNo(tt.begin), field.NewEndSeqNo(tt.end))
+			begin, end, err := m.Range(tt.last)
+			if err != nil || begin != tt.wantBegin || end != tt.wantEnd {
+				t.Errorf("Range(%v) = %v, %v, %v, want %v, %v", tt.last, begin, end, err, tt.wantBegin, tt.wantEnd)
+			}
+		})
+	}
+}
@@ -0,0 +1,20 @@
+/*
+Package rfq models quote negotiations, from the QuoteRequest to the QuoteResponse that ends them.
+
+A Negotiator keeps one Negotiation per QuoteReqID. Quotes join the negotiation of their QuoteReqID, or of the
+QuoteResponse named by their QuoteRespID when they answer a counter, and each new quote supersedes the one before
+it. QuoteResponse messages find their quote by QuoteID and move the negotiation on according to QuoteRespType:
+HitLift accepts the quote, Counter waits for a new quote and Expired, Cover, DoneAway and Pass end the negotiation.
+QuoteRequestReject and QuoteStatusReport messages are applied as well. An RFQRequest is kept by its RFQReqID and
+collects the QuoteRequest messages that carry it.
+
+The same messages flow in both directions, so a Negotiator serves the requester, which sends the QuoteRequest and
+QuoteResponse messages, as well as the dealer, which sends the quotes. Messages are given to the Negotiator whether
+they are sent or received, and since either side sees the same messages the Negotiator does not need to know which
+side it serves. Awaiting tells which side has to act next, so the requester acts on a negotiation awaiting
+SideRequester and the dealer on one awaiting SideDealer.
+
+Quotes expire at their ValidUntilTime and requests at the ExpireTime of their instruments. Expire should be called
+periodically to apply expiries, OnExpired and OnSuperseded report them.
+*/
+package rfq
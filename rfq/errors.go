@@ -0,0 +1,36 @@
+package rfq
+
+import (
+	"fmt"
+)
+
+//UnknownNegotiationError is returned for a message that cannot be linked to a known negotiation. ID is the QuoteReqID,
+//QuoteID or QuoteRespID the message refers to.
+type UnknownNegotiationError struct {
+	ID string
+}
+
+func (e *UnknownNegotiationError) Error() string {
+	return fmt.Sprintf("rfq: no negotiation for %v", e.ID)
+}
+
+//StateError is returned for a message that is not allowed in the current state of the negotiation, for example a
+//QuoteResponse to a quote that has expired
+type StateError struct {
+	QuoteReqID string
+	State      State
+	Message    string
+}
+
+func (e *StateError) Error() string {
+	return fmt.Sprintf("rfq: negotiation %v: %v not allowed in state %v", e.QuoteReqID, e.Message, e.State)
+}
+
+//DuplicateError is returned for a QuoteRequest, Quote or QuoteResponse that reuses an ID
+type DuplicateError struct {
+	ID string
+}
+
+func (e *DuplicateError) Error() string {
+	return fmt.Sprintf("rfq: duplicate ID %v", e.ID)
+}
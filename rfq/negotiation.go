@@ -0,0 +1,204 @@
+package rfq
+
+import (
+	"time"
+
+	"github.com/terracefi/enum"
+	"github.com/terracefi/fix44/internal/fixutil"
+	"github.com/terracefi/fix44/quote"
+	"github.com/terracefi/fix44/quoterequest"
+	"github.com/terracefi/fix44/quoteresponse"
+	"github.com/terracefi/fix44/rfqrequest"
+)
+
+//QuoteRespType values, FIX 4.4
+const (
+	RespHitLift  enum.QuoteRespType = "1"
+	RespCounter  enum.QuoteRespType = "2"
+	RespExpired  enum.QuoteRespType = "3"
+	RespCover    enum.QuoteRespType = "4"
+	RespDoneAway enum.QuoteRespType = "5"
+	RespPass     enum.QuoteRespType = "6"
+)
+
+//State is the state of a Negotiation
+type State int
+
+//State values
+const (
+	StateRequested State = iota
+	StateQuoted
+	StateCountered
+	StateAccepted
+	StateRejected
+	StateExpired
+	StateCovered
+	StateDoneAway
+	StatePassed
+)
+
+var stateNames = [...]string{"Requested", "Quoted", "Countered", "Accepted", "Rejected", "Expired", "Covered",
+	"DoneAway", "Passed"}
+
+func (s State) String() string {
+	if int(s) < len(stateNames) {
+		return stateNames[s]
+	}
+	return "Unknown"
+}
+
+//IsTerminal returns true if the negotiation has ended
+func (s State) IsTerminal() bool {
+	return s >= StateAccepted
+}
+
+//Side is a party to a negotiation
+type Side int
+
+//Side values
+const (
+	//SideRequester sends the QuoteRequest and answers quotes with QuoteResponse messages
+	SideRequester Side = iota
+	//SideDealer answers the QuoteRequest and counters with quotes
+	SideDealer
+)
+
+func (s Side) String() string {
+	switch s {
+	case SideRequester:
+		return "Requester"
+	case SideDealer:
+		return "Dealer"
+	}
+	return "Unknown"
+}
+
+//QuoteStatus is the state of one quote of a Negotiation
+type QuoteStatus int
+
+//QuoteStatus values
+const (
+	QuoteActive QuoteStatus = iota
+	QuoteSuperseded
+	QuoteExpired
+	QuoteHit
+	QuoteCountered
+	QuoteCanceled
+	QuoteRejected
+	QuoteEnded
+)
+
+var quoteStatusNames = [...]string{"Active", "Superseded", "Expired", "Hit", "Countered", "Canceled", "Rejected",
+	"Ended"}
+
+func (s QuoteStatus) String() string {
+	if int(s) < len(quoteStatusNames) {
+		return quoteStatusNames[s]
+	}
+	return "Unknown"
+}
+
+//Quote is a quote of a Negotiation and its status
+type Quote struct {
+	QuoteID string
+	Status  QuoteStatus
+	Quote   quote.Struct
+}
+
+//Negotiation is the state of the negotiation that started with a QuoteRequest
+type Negotiation struct {
+	QuoteReqID string
+	State      State
+
+	//Request is the QuoteRequest, it is zero for a negotiation started by an unsolicited quote
+	Request quoterequest.Struct
+	//RFQReqID is the RFQReqID of the RFQRequest the QuoteRequest answers, empty if it answers none
+	RFQReqID string
+	//ExpireTime is the earliest ExpireTime of the instruments of the request, zero if none is set
+	ExpireTime time.Time
+
+	//Quotes holds every quote of the negotiation, oldest first, at most one of them is QuoteActive
+	Quotes []Quote
+	//Responses holds every QuoteResponse of the negotiation, oldest first
+	Responses []quoteresponse.Struct
+
+	//RejectReason and Text are set by a QuoteRequestReject
+	RejectReason *enum.QuoteRequestRejectReason
+	Text         string
+}
+
+//ActiveQuote returns the quote that can be hit or countered, if any
+func (n Negotiation) ActiveQuote() (Quote, bool) {
+	for _, q := range n.Quotes {
+		if q.Status == QuoteActive {
+			return q, true
+		}
+	}
+	return Quote{}, false
+}
+
+//Awaiting returns the side that has to act next: the dealer while the negotiation waits for a quote and the requester
+//while a quote is active. It returns false once the negotiation has ended.
+func (n Negotiation) Awaiting() (Side, bool) {
+	switch {
+	case n.State.IsTerminal():
+		return 0, false
+	case n.State == StateQuoted:
+		return SideRequester, true
+	}
+	return SideDealer, true
+}
+
+func (n *Negotiation) clone() Negotiation {
+	return fixutil.Clone(*n)
+}
+
+//RFQ is an RFQRequest and the negotiations started by the QuoteRequest messages that answer it
+type RFQ struct {
+	RFQReqID string
+	Request  rfqrequest.Struct
+	//QuoteReqIDs holds the QuoteReqID of every QuoteRequest sent for the RFQRequest, oldest first
+	QuoteReqIDs []string
+	//Disabled is true once the RFQRequest has been sent again with SubscriptionRequestType Disable
+	Disabled bool
+}
+
+func (q *RFQ) clone() RFQ {
+	return fixutil.Clone(*q)
+}
+
+func (n *Negotiation) quote(quoteID string) *Quote {
+	for i := range n.Quotes {
+		if n.Quotes[i].QuoteID == quoteID {
+			return &n.Quotes[i]
+		}
+	}
+	return nil
+}
+
+func (n *Negotiation) active() *Quote {
+	for i := range n.Quotes {
+		if n.Quotes[i].Status == QuoteActive {
+			return &n.Quotes[i]
+		}
+	}
+	return nil
+}
+
+//end closes the active quote, if any, and moves the negotiation to a terminal state
+func (n *Negotiation) end(s State) {
+	if q := n.active(); q != nil {
+		q.Status = QuoteEnded
+	}
+	n.State = s
+}
+
+func expireTime(r quoterequest.Struct) time.Time {
+	var t time.Time
+	for _, s := range r.NoRelatedSym {
+		if s.ExpireTime != nil && (t.IsZero() || s.ExpireTime.Before(t)) {
+			t = *s.ExpireTime
+		}
+	}
+	return t
+}
@@ -0,0 +1,338 @@
+package rfq
+
+import (
+	"sync"
+	"time"
+
+	"github.com/terracefi/enum"
+	"github.com/terracefi/fix44/internal/fixutil"
+	"github.com/terracefi/fix44/quote"
+	"github.com/terracefi/fix44/quoterequest"
+	"github.com/terracefi/fix44/quoterequestreject"
+	"github.com/terracefi/fix44/quoteresponse"
+	"github.com/terracefi/fix44/quotestatusreport"
+	"github.com/terracefi/fix44/rfqrequest"
+)
+
+//QuoteStatus values of QuoteStatusReport, FIX 4.4
+const (
+	statusCanceledForSymbol  enum.QuoteStatus = "1"
+	statusCanceledForSecType enum.QuoteStatus = "2"
+	statusCanceledUnderlying enum.QuoteStatus = "3"
+	statusCanceledAll        enum.QuoteStatus = "4"
+	statusRejected           enum.QuoteStatus = "5"
+	statusExpired            enum.QuoteStatus = "7"
+)
+
+//Negotiator tracks RFQ negotiations, for the requester as well as for the dealer. It is safe for concurrent use, the
+//callbacks are called without any lock held.
+type Negotiator struct {
+	//OnExpired is called when a quote, or with a nil quote the request itself, expires
+	OnExpired func(n Negotiation, q *Quote)
+	//OnSuperseded is called when a new quote replaces the active quote of a negotiation
+	OnSuperseded func(n Negotiation, old, new Quote)
+
+	mu           sync.Mutex
+	negotiations map[string]*Negotiation
+	quotes       map[string]*Negotiation
+	responses    map[string]*Negotiation
+	rfqs         map[string]*RFQ
+}
+
+//New returns a Negotiator without negotiations
+func New() *Negotiator {
+	return &Negotiator{
+		negotiations: make(map[string]*Negotiation),
+		quotes:       make(map[string]*Negotiation),
+		responses:    make(map[string]*Negotiation),
+		rfqs:         make(map[string]*RFQ),
+	}
+}
+
+//Negotiation returns the negotiation with the given QuoteReqID, or the one holding the quote or response with the
+//given QuoteID or QuoteRespID
+func (r *Negotiator) Negotiation(id string) (Negotiation, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if n := r.find(id, id, id); n != nil {
+		return n.clone(), true
+	}
+	return Negotiation{}, false
+}
+
+func (r *Negotiator) find(quoteReqID, quoteID, quoteRespID string) *Negotiation {
+	if n, ok := r.negotiations[quoteReqID]; ok {
+		return n
+	}
+	if n, ok := r.quotes[quoteID]; ok {
+		return n
+	}
+	return r.responses[quoteRespID]
+}
+
+//RFQ returns the RFQRequest with the given RFQReqID
+func (r *Negotiator) RFQ(rfqReqID string) (RFQ, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	q, ok := r.rfqs[rfqReqID]
+	if !ok {
+		return RFQ{}, false
+	}
+	return q.clone(), true
+}
+
+//OnRFQRequest records an RFQRequest. Sending it again with SubscriptionRequestType Disable disables it, any other
+//RFQRequest that reuses an RFQReqID is a *DuplicateError.
+func (r *Negotiator) OnRFQRequest(msg rfqrequest.RFQRequest) (RFQ, error) {
+	req, err := rfqrequest.Marshal(msg)
+	if err != nil {
+		return RFQ{}, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	disable := fixutil.Deref(req.SubscriptionRequestType) == fixutil.SubscriptionDisable
+	q, ok := r.rfqs[req.RFQReqID]
+	switch {
+	case ok && disable:
+		q.Disabled = true
+		return q.clone(), nil
+	case ok:
+		return RFQ{}, &DuplicateError{req.RFQReqID}
+	case disable:
+		return RFQ{}, &UnknownNegotiationError{req.RFQReqID}
+	}
+	q = &RFQ{RFQReqID: req.RFQReqID, Request: req}
+	r.rfqs[req.RFQReqID] = q
+	return q.clone(), nil
+}
+
+//OnQuoteRequest starts a negotiation. A QuoteRequest with the RFQReqID of a known RFQRequest is added to it, the
+//RFQRequest may also have been sent by another party, so an unknown RFQReqID is only recorded on the negotiation.
+func (r *Negotiator) OnQuoteRequest(msg quoterequest.QuoteRequest) (Negotiation, error) {
+	req, err := quoterequest.Marshal(msg)
+	if err != nil {
+		return Negotiation{}, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.negotiations[req.QuoteReqID]; ok {
+		return Negotiation{}, &DuplicateError{req.QuoteReqID}
+	}
+	n := &Negotiation{
+		QuoteReqID: req.QuoteReqID,
+		State:      StateRequested,
+		Request:    req,
+		RFQReqID:   fixutil.Deref(req.RFQReqID),
+		ExpireTime: expireTime(req),
+	}
+	if q, ok := r.rfqs[n.RFQReqID]; ok {
+		q.QuoteReqIDs = append(q.QuoteReqIDs, n.QuoteReqID)
+	}
+	r.negotiations[req.QuoteReqID] = n
+	return n.clone(), nil
+}
+
+//OnQuote adds a quote to the negotiation of its QuoteReqID, or of its QuoteRespID when it answers a counter. A quote
+//that refers to neither starts a negotiation of its own. The active quote of the negotiation is superseded.
+func (r *Negotiator) OnQuote(msg quote.Quote) (Negotiation, error) {
+	q, err := quote.Marshal(msg)
+	if err != nil {
+		return Negotiation{}, err
+	}
+
+	r.mu.Lock()
+	if _, ok := r.quotes[q.QuoteID]; ok {
+		r.mu.Unlock()
+		return Negotiation{}, &DuplicateError{q.QuoteID}
+	}
+
+	n := r.find(fixutil.Deref(q.QuoteReqID), "", fixutil.Deref(q.QuoteRespID))
+	switch {
+	case n == nil && (q.QuoteReqID != nil || q.QuoteRespID != nil):
+		r.mu.Unlock()
+		return Negotiation{}, &UnknownNegotiationError{fixutil.Deref(q.QuoteReqID) + fixutil.Deref(q.QuoteRespID)}
+	case n == nil:
+		n = &Negotiation{}
+	case n.State.IsTerminal():
+		r.mu.Unlock()
+		return n.clone(), &StateError{n.QuoteReqID, n.State, "Quote"}
+	}
+
+	var superseded *Quote
+	if old := n.active(); old != nil {
+		old.Status = QuoteSuperseded
+		o := *old
+		superseded = &o
+	}
+	n.Quotes = append(n.Quotes, Quote{QuoteID: q.QuoteID, Status: QuoteActive, Quote: q})
+	n.State = StateQuoted
+	r.quotes[q.QuoteID] = n
+
+	c := n.clone()
+	r.mu.Unlock()
+
+	if superseded != nil && r.OnSuperseded != nil {
+		r.OnSuperseded(c, *superseded, c.Quotes[len(c.Quotes)-1])
+	}
+	return c, nil
+}
+
+//OnQuoteResponse applies a QuoteResponse to the quote named by its QuoteID
+func (r *Negotiator) OnQuoteResponse(msg quoteresponse.QuoteResponse) (Negotiation, error) {
+	resp, err := quoteresponse.Marshal(msg)
+	if err != nil {
+		return Negotiation{}, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.responses[resp.QuoteRespID]; ok {
+		return Negotiation{}, &DuplicateError{resp.QuoteRespID}
+	}
+	quoteID := fixutil.Deref(resp.QuoteID)
+	n := r.quotes[quoteID]
+	if n == nil {
+		return Negotiation{}, &UnknownNegotiationError{quoteID}
+	}
+	if n.State.IsTerminal() {
+		return n.clone(), &StateError{n.QuoteReqID, n.State, "QuoteResponse"}
+	}
+	q := n.quote(quoteID)
+	if q.Status != QuoteActive && resp.QuoteRespType != RespPass {
+		return n.clone(), &StateError{n.QuoteReqID, n.State, "QuoteResponse to a quote that is " + q.Status.String()}
+	}
+
+	n.Responses = append(n.Responses, resp)
+	r.responses[resp.QuoteRespID] = n
+
+	switch resp.QuoteRespType {
+	case RespHitLift:
+		q.Status = QuoteHit
+		n.State = StateAccepted
+	case RespCounter:
+		q.Status = QuoteCountered
+		n.State = StateCountered
+	case RespExpired:
+		q.Status = QuoteExpired
+		n.State = StateExpired
+	case RespCover:
+		n.end(StateCovered)
+	case RespDoneAway:
+		n.end(StateDoneAway)
+	case RespPass:
+		n.end(StatePassed)
+	}
+	return n.clone(), nil
+}
+
+//OnQuoteRequestReject ends the negotiation of the rejected QuoteReqID
+func (r *Negotiator) OnQuoteRequestReject(msg quoterequestreject.QuoteRequestReject) (Negotiation, error) {
+	rej, err := quoterequestreject.Marshal(msg)
+	if err != nil {
+		return Negotiation{}, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	n, ok := r.negotiations[rej.QuoteReqID]
+	if !ok {
+		return Negotiation{}, &UnknownNegotiationError{rej.QuoteReqID}
+	}
+	if n.State.IsTerminal() {
+		return n.clone(), &StateError{n.QuoteReqID, n.State, "QuoteRequestReject"}
+	}
+
+	n.end(StateRejected)
+	reason := rej.QuoteRequestRejectReason
+	n.RejectReason = &reason
+	n.Text = fixutil.Deref(rej.Text)
+	return n.clone(), nil
+}
+
+//OnQuoteStatusReport applies the QuoteStatus Expired, Rejected and Canceled to the quote the report refers to. The
+//negotiation then waits for a new quote.
+func (r *Negotiator) OnQuoteStatusReport(msg quotestatusreport.QuoteStatusReport) (Negotiation, error) {
+	rpt, err := quotestatusreport.Marshal(msg)
+	if err != nil {
+		return Negotiation{}, err
+	}
+
+	r.mu.Lock()
+	n := r.find(fixutil.Deref(rpt.QuoteReqID), rpt.QuoteID, fixutil.Deref(rpt.QuoteRespID))
+	if n == nil {
+		r.mu.Unlock()
+		return Negotiation{}, &UnknownNegotiationError{rpt.QuoteID}
+	}
+
+	var expired *Quote
+	if q := n.quote(rpt.QuoteID); q != nil && q.Status == QuoteActive && rpt.QuoteStatus != nil {
+		switch *rpt.QuoteStatus {
+		case statusExpired:
+			q.Status = QuoteExpired
+			e := *q
+			expired = &e
+		case statusRejected:
+			q.Status = QuoteRejected
+		case statusCanceledForSymbol, statusCanceledForSecType, statusCanceledUnderlying, statusCanceledAll:
+			q.Status = QuoteCanceled
+		}
+		if q.Status != QuoteActive && !n.State.IsTerminal() {
+			n.State = StateRequested
+		}
+	}
+
+	c := n.clone()
+	r.mu.Unlock()
+
+	if expired != nil && r.OnExpired != nil {
+		r.OnExpired(c, expired)
+	}
+	return c, nil
+}
+
+//Expire expires the active quotes whose ValidUntilTime has passed at now, and ends the negotiations whose request
+//ExpireTime has passed
+func (r *Negotiator) Expire(now time.Time) {
+	type event struct {
+		n Negotiation
+		q *Quote
+	}
+	var events []event
+
+	r.mu.Lock()
+	seen := make(map[*Negotiation]bool)
+	for _, m := range []map[string]*Negotiation{r.negotiations, r.quotes} {
+		for _, n := range m {
+			if seen[n] || n.State.IsTerminal() {
+				continue
+			}
+			seen[n] = true
+
+			if q := n.active(); q != nil && q.Quote.ValidUntilTime != nil && !now.Before(*q.Quote.ValidUntilTime) {
+				q.Status = QuoteExpired
+				n.State = StateRequested
+				e := *q
+				events = append(events, event{n.clone(), &e})
+			}
+			if !n.ExpireTime.IsZero() && !now.Before(n.ExpireTime) {
+				n.end(StateExpired)
+				events = append(events, event{n.clone(), nil})
+			}
+		}
+	}
+	r.mu.Unlock()
+
+	if r.OnExpired != nil {
+		for _, e := range events {
+			r.OnExpired(e.n, e.q)
+		}
+	}
+}
@@ -0,0 +1,396 @@
+package rfq
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/terracefi/enum"
+	"github.com/terracefi/fix44/internal/fixutil"
+	"github.com/terracefi/fix44/quote"
+	"github.com/terracefi/fix44/quoterequest"
+	"github.com/terracefi/fix44/quoterequestreject"
+	"github.com/terracefi/fix44/quoteresponse"
+	"github.com/terracefi/fix44/quotestatusreport"
+	"github.com/terracefi/fix44/rfqrequest"
+)
+
+var start = time.Date(2024, 3, 1, 9, 30, 0, 0, time.UTC)
+
+type step func(r *Negotiator) (Negotiation, error)
+
+func requested(id, rfqReqID string, expire *time.Time) step {
+	return func(r *Negotiator) (Negotiation, error) {
+		s := quoterequest.Struct{
+			QuoteReqID:   id,
+			NoRelatedSym: []quoterequest.NoRelatedSymStruct{{Symbol: fixutil.Ptr("ABC"), ExpireTime: expire}},
+		}
+		if rfqReqID != "" {
+			s.RFQReqID = fixutil.Ptr(rfqReqID)
+		}
+		return r.OnQuoteRequest(quoterequest.Unmarshal(s))
+	}
+}
+
+func quoted(id, quoteReqID, quoteRespID string, validUntil *time.Time) step {
+	return func(r *Negotiator) (Negotiation, error) {
+		s := quote.Struct{QuoteID: id, Symbol: fixutil.Ptr("ABC"), ValidUntilTime: validUntil}
+		if quoteReqID != "" {
+			s.QuoteReqID = fixutil.Ptr(quoteReqID)
+		}
+		if quoteRespID != "" {
+			s.QuoteRespID = fixutil.Ptr(quoteRespID)
+		}
+		return r.OnQuote(quote.Unmarshal(s))
+	}
+}
+
+func responded(id, quoteID string, respType enum.QuoteRespType) step {
+	return func(r *Negotiator) (Negotiation, error) {
+		return r.OnQuoteResponse(quoteresponse.Unmarshal(quoteresponse.Struct{
+			QuoteRespID:   id,
+			QuoteRespType: respType,
+			QuoteID:       fixutil.Ptr(quoteID),
+			Symbol:        fixutil.Ptr("ABC"),
+		}))
+	}
+}
+
+func rejected(quoteReqID string) step {
+	return func(r *Negotiator) (Negotiation, error) {
+		return r.OnQuoteRequestReject(quoterequestreject.Unmarshal(quoterequestreject.Struct{
+			QuoteReqID:               quoteReqID,
+			QuoteRequestRejectReason: "1",
+			NoRelatedSym:             []quoterequestreject.NoRelatedSymStruct{{Symbol: fixutil.Ptr("ABC")}},
+			Text:                     fixutil.Ptr("unknown symbol"),
+		}))
+	}
+}
+
+func reported(quoteID string, status enum.QuoteStatus) step {
+	return func(r *Negotiator) (Negotiation, error) {
+		return r.OnQuoteStatusReport(quotestatusreport.Unmarshal(quotestatusreport.Struct{
+			QuoteID:     quoteID,
+			QuoteStatus: fixutil.Ptr(status),
+			Symbol:      fixutil.Ptr("ABC"),
+		}))
+	}
+}
+
+func TestNegotiator(t *testing.T) {
+	tests := []struct {
+		name     string
+		steps    []step
+		wantErr  error
+		state    State
+		quotes   map[string]QuoteStatus
+		awaiting Side
+	}{
+		{
+			name:     "requested",
+			steps:    []step{requested("R1", "", nil)},
+			state:    StateRequested,
+			quotes:   map[string]QuoteStatus{},
+			awaiting: SideDealer,
+		},
+		{
+			name:     "quoted",
+			steps:    []step{requested("R1", "", nil), quoted("Q1", "R1", "", nil)},
+			state:    StateQuoted,
+			quotes:   map[string]QuoteStatus{"Q1": QuoteActive},
+			awaiting: SideRequester,
+		},
+		{
+			name:     "requoted",
+			steps:    []step{requested("R1", "", nil), quoted("Q1", "R1", "", nil), quoted("Q2", "R1", "", nil)},
+			state:    StateQuoted,
+			quotes:   map[string]QuoteStatus{"Q1": QuoteSuperseded, "Q2": QuoteActive},
+			awaiting: SideRequester,
+		},
+		{
+			name:   "hit",
+			steps:  []step{requested("R1", "", nil), quoted("Q1", "R1", "", nil), responded("P1", "Q1", RespHitLift)},
+			state:  StateAccepted,
+			quotes: map[string]QuoteStatus{"Q1": QuoteHit},
+		},
+		{
+			name: "countered",
+			steps: []step{requested("R1", "", nil), quoted("Q1", "R1", "", nil),
+				responded("P1", "Q1", RespCounter)},
+			state:    StateCountered,
+			quotes:   map[string]QuoteStatus{"Q1": QuoteCountered},
+			awaiting: SideDealer,
+		},
+		{
+			name: "quote answering a counter",
+			steps: []step{requested("R1", "", nil), quoted("Q1", "R1", "", nil),
+				responded("P1", "Q1", RespCounter), quoted("Q2", "", "P1", nil),
+				responded("P2", "Q2", RespHitLift)},
+			state:  StateAccepted,
+			quotes: map[string]QuoteStatus{"Q1": QuoteCountered, "Q2": QuoteHit},
+		},
+		{
+			name:   "passed",
+			steps:  []step{requested("R1", "", nil), quoted("Q1", "R1", "", nil), responded("P1", "Q1", RespPass)},
+			state:  StatePassed,
+			quotes: map[string]QuoteStatus{"Q1": QuoteEnded},
+		},
+		{
+			name:   "covered",
+			steps:  []step{requested("R1", "", nil), quoted("Q1", "R1", "", nil), responded("P1", "Q1", RespCover)},
+			state:  StateCovered,
+			quotes: map[string]QuoteStatus{"Q1": QuoteEnded},
+		},
+		{
+			name: "response to a superseded quote",
+			steps: []step{requested("R1", "", nil), quoted("Q1", "R1", "", nil), quoted("Q2", "R1", "", nil),
+				responded("P1", "Q1", RespHitLift)},
+			wantErr:  &StateError{},
+			state:    StateQuoted,
+			quotes:   map[string]QuoteStatus{"Q1": QuoteSuperseded, "Q2": QuoteActive},
+			awaiting: SideRequester,
+		},
+		{
+			name: "quote after the negotiation ended",
+			steps: []step{requested("R1", "", nil), quoted("Q1", "R1", "", nil),
+				responded("P1", "Q1", RespHitLift), quoted("Q2", "R1", "", nil)},
+			wantErr: &StateError{},
+			state:   StateAccepted,
+			quotes:  map[string]QuoteStatus{"Q1": QuoteHit},
+		},
+		{
+			name:   "rejected",
+			steps:  []step{requested("R1", "", nil), rejected("R1")},
+			state:  StateRejected,
+			quotes: map[string]QuoteStatus{},
+		},
+		{
+			name:    "rejected twice",
+			steps:   []step{requested("R1", "", nil), rejected("R1"), rejected("R1")},
+			wantErr: &StateError{},
+			state:   StateRejected,
+			quotes:  map[string]QuoteStatus{},
+		},
+		{
+			name:     "quote canceled",
+			steps:    []step{requested("R1", "", nil), quoted("Q1", "R1", "", nil), reported("Q1", "4")},
+			state:    StateRequested,
+			quotes:   map[string]QuoteStatus{"Q1": QuoteCanceled},
+			awaiting: SideDealer,
+		},
+		{
+			name:     "quote rejected",
+			steps:    []step{requested("R1", "", nil), quoted("Q1", "R1", "", nil), reported("Q1", "5")},
+			state:    StateRequested,
+			quotes:   map[string]QuoteStatus{"Q1": QuoteRejected},
+			awaiting: SideDealer,
+		},
+		{
+			name:     "duplicate QuoteReqID",
+			steps:    []step{requested("R1", "", nil), requested("R1", "", nil)},
+			wantErr:  &DuplicateError{},
+			state:    StateRequested,
+			quotes:   map[string]QuoteStatus{},
+			awaiting: SideDealer,
+		},
+		{
+			name:    "quote for an unknown request",
+			steps:   []step{quoted("Q1", "R1", "", nil)},
+			wantErr: &UnknownNegotiationError{},
+		},
+		{
+			name:    "reject of an unknown request",
+			steps:   []step{rejected("R1")},
+			wantErr: &UnknownNegotiationError{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := New()
+			var err error
+			for i, s := range tt.steps {
+				_, err = s(r)
+				if i < len(tt.steps)-1 && err != nil {
+					t.Fatalf("step %v: %v", i, err)
+				}
+			}
+			if reflect.TypeOf(err) != reflect.TypeOf(tt.wantErr) {
+				t.Fatalf("error = %v, want %T", err, tt.wantErr)
+			}
+
+			n, ok := r.Negotiation("R1")
+			if ok != (tt.quotes != nil) {
+				t.Fatalf("Negotiation() found = %v, want %v", ok, tt.quotes != nil)
+			}
+			if !ok {
+				return
+			}
+			if n.State != tt.state {
+				t.Errorf("State = %v, want %v", n.State, tt.state)
+			}
+			quotes := make(map[string]QuoteStatus)
+			for _, q := range n.Quotes {
+				quotes[q.QuoteID] = q.Status
+			}
+			if !reflect.DeepEqual(quotes, tt.quotes) {
+				t.Errorf("quotes = %v, want %v", quotes, tt.quotes)
+			}
+			side, ok := n.Awaiting()
+			if ok != !tt.state.IsTerminal() || (ok && side != tt.awaiting) {
+				t.Errorf("Awaiting() = %v, %v, want %v", side, ok, tt.awaiting)
+			}
+		})
+	}
+}
+
+func TestNegotiationByQuoteAndResponse(t *testing.T) {
+	r := New()
+	for i, s := range []step{requested("R1", "", nil), quoted("Q1", "R1", "", nil),
+		responded("P1", "Q1", RespCounter)} {
+		if _, err := s(r); err != nil {
+			t.Fatalf("step %v: %v", i, err)
+		}
+	}
+	for _, id := range []string{"R1", "Q1", "P1"} {
+		if n, ok := r.Negotiation(id); !ok || n.QuoteReqID != "R1" {
+			t.Errorf("Negotiation(%v) = %v, %v, want R1", id, n.QuoteReqID, ok)
+		}
+	}
+}
+
+func TestUnsolicitedQuote(t *testing.T) {
+	r := New()
+	n, err := quoted("Q1", "", "", nil)(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n.State != StateQuoted || n.QuoteReqID != "" {
+		t.Errorf("Negotiation = %v %q, want a quoted negotiation without QuoteReqID", n.State, n.QuoteReqID)
+	}
+	if n, err = responded("P1", "Q1", RespHitLift)(r); err != nil || n.State != StateAccepted {
+		t.Errorf("OnQuoteResponse() = %v, %v, want Accepted", n.State, err)
+	}
+}
+
+func TestOnSuperseded(t *testing.T) {
+	var got []string
+	r := New()
+	r.OnSuperseded = func(n Negotiation, old, new Quote) {
+		got = append(got, old.QuoteID+">"+new.QuoteID)
+	}
+	for i, s := range []step{requested("R1", "", nil), quoted("Q1", "R1", "", nil), quoted("Q2", "R1", "", nil),
+		quoted("Q3", "R1", "", nil)} {
+		if _, err := s(r); err != nil {
+			t.Fatalf("step %v: %v", i, err)
+		}
+	}
+	if want := []string{"Q1>Q2", "Q2>Q3"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("OnSuperseded calls = %v, want %v", got, want)
+	}
+}
+
+func TestExpire(t *testing.T) {
+	type expired struct {
+		quoteReqID, quoteID string
+	}
+	var got []expired
+	r := New()
+	r.OnExpired = func(n Negotiation, q *Quote) {
+		e := expired{quoteReqID: n.QuoteReqID}
+		if q != nil {
+			e.quoteID = q.QuoteID
+		}
+		got = append(got, e)
+	}
+	for i, s := range []step{
+		requested("R1", "", nil), quoted("Q1", "R1", "", fixutil.Ptr(start.Add(time.Minute))),
+		requested("R2", "", fixutil.Ptr(start.Add(time.Hour))),
+		requested("R3", "", nil), quoted("Q3", "R3", "", nil), reported("Q3", "7"),
+	} {
+		if _, err := s(r); err != nil {
+			t.Fatalf("step %v: %v", i, err)
+		}
+	}
+	if want := []expired{{"R3", "Q3"}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("OnExpired calls after the status report = %v, want %v", got, want)
+	}
+
+	got = nil
+	r.Expire(start)
+	if len(got) != 0 {
+		t.Errorf("Expire(start) expired %v, want nothing", got)
+	}
+	r.Expire(start.Add(time.Minute))
+	if want := []expired{{"R1", "Q1"}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("OnExpired calls = %v, want %v", got, want)
+	}
+	if n, _ := r.Negotiation("R1"); n.State != StateRequested {
+		t.Errorf("State after the quote expired = %v, want Requested", n.State)
+	}
+
+	got = nil
+	r.Expire(start.Add(time.Hour))
+	if want := []expired{{"R2", ""}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("OnExpired calls = %v, want %v", got, want)
+	}
+	if n, _ := r.Negotiation("R2"); n.State != StateExpired {
+		t.Errorf("State after the request expired = %v, want Expired", n.State)
+	}
+}
+
+func rfqRequest(id string, subType *enum.SubscriptionRequestType) rfqrequest.RFQRequest {
+	return rfqrequest.Unmarshal(rfqrequest.Struct{
+		RFQReqID:                id,
+		SubscriptionRequestType: subType,
+		NoRelatedSym:            []rfqrequest.NoRelatedSymStruct{{Symbol: fixutil.Ptr("ABC")}},
+	})
+}
+
+func TestRFQRequest(t *testing.T) {
+	r := New()
+	if _, err := r.OnRFQRequest(rfqRequest("F1", fixutil.Ptr(fixutil.SubscriptionSnapshotAndUpdates))); err != nil {
+		t.Fatal(err)
+	}
+	for i, s := range []step{requested("R1", "F1", nil), requested("R2", "F1", nil), requested("R3", "F2", nil)} {
+		if _, err := s(r); err != nil {
+			t.Fatalf("step %v: %v", i, err)
+		}
+	}
+
+	q, ok := r.RFQ("F1")
+	if !ok || !reflect.DeepEqual(q.QuoteReqIDs, []string{"R1", "R2"}) || q.Disabled {
+		t.Errorf("RFQ(F1) = %+v, %v, want QuoteReqIDs [R1 R2]", q, ok)
+	}
+	if n, _ := r.Negotiation("R3"); n.RFQReqID != "F2" {
+		t.Errorf("RFQReqID = %q, want F2 for an RFQRequest sent by another party", n.RFQReqID)
+	}
+	if _, ok := r.RFQ("F2"); ok {
+		t.Error("RFQ(F2) found, want only the RFQRequest given to the Negotiator")
+	}
+
+	if _, err := r.OnRFQRequest(rfqRequest("F1", nil)); reflect.TypeOf(err) != reflect.TypeOf(&DuplicateError{}) {
+		t.Errorf("OnRFQRequest() with a used RFQReqID error = %v, want *DuplicateError", err)
+	}
+	disable := fixutil.Ptr(fixutil.SubscriptionDisable)
+	if q, err := r.OnRFQRequest(rfqRequest("F1", disable)); err != nil || !q.Disabled {
+		t.Errorf("OnRFQRequest(Disable) = %+v, %v, want a disabled RFQ", q, err)
+	}
+	_, err := r.OnRFQRequest(rfqRequest("F3", disable))
+	if reflect.TypeOf(err) != reflect.TypeOf(&UnknownNegotiationError{}) {
+		t.Errorf("OnRFQRequest(Disable) of an unknown RFQReqID error = %v, want *UnknownNegotiationError", err)
+	}
+}
+
+func TestCloneIsolation(t *testing.T) {
+	r := New()
+	n, err := quoted("Q1", "", "", nil)(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	*n.Quotes[0].Quote.Symbol = "XYZ"
+	n.Quotes[0].Status = QuoteHit
+
+	if got, _ := r.Negotiation("Q1"); *got.Quotes[0].Quote.Symbol != "ABC" || got.Quotes[0].Status != QuoteActive {
+		t.Errorf("quote changed through a returned Negotiation: %+v", got.Quotes[0])
+	}
+}
@@ -0,0 +1,14 @@
+/*
+Package secmaster keeps an instrument store built from the security reference data messages.
+
+A Master sends SecurityDefinitionRequest, SecurityListRequest, DerivativeSecurityListRequest,
+SecurityStatusRequest and SecurityTypeRequest messages and applies the answers. SecurityList,
+DerivativeSecurityList and SecurityTypes responses split over several messages are gathered under their
+SecurityReqID until LastFragment = Y, or until TotNoRelatedSym or TotNoSecurityTypes entries have arrived, and are
+then applied at once. Responses to requests the Master did not send are applied the same way.
+
+Each Instrument holds a copy of the Instrument component it was last defined with, and can be looked up by Symbol,
+by SecurityID and SecurityIDSource, or by any of its NoSecurityAltID entries. SecurityStatus messages record the
+trading status of their instrument. Subscribe registers a callback that is given every change to the store.
+*/
+package secmaster
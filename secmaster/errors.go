@@ -0,0 +1,14 @@
+package secmaster
+
+import (
+	"fmt"
+)
+
+//DuplicateRequestError is returned for a request that reuses the ID of an earlier request
+type DuplicateRequestError struct {
+	ID string
+}
+
+func (e *DuplicateRequestError) Error() string {
+	return fmt.Sprintf("secmaster: duplicate request ID %v", e.ID)
+}
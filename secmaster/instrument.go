@@ -0,0 +1,110 @@
+package secmaster
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/terracefi/enum"
+	"github.com/terracefi/fix44/components"
+	"github.com/terracefi/fix44/internal/fixutil"
+	"github.com/terracefi/quickfix"
+)
+
+//Key identifies an instrument in the store, by SecurityID and SecurityIDSource when the instrument has a SecurityID
+//and by Symbol otherwise
+type Key struct {
+	Symbol           string
+	SecurityID       string
+	SecurityIDSource enum.SecurityIDSource
+}
+
+//AltID is a NoSecurityAltID entry
+type AltID struct {
+	SecurityAltID       string
+	SecurityAltIDSource string
+}
+
+//Instrument is an instrument of the store
+type Instrument struct {
+	Symbol           string
+	SecurityID       string
+	SecurityIDSource enum.SecurityIDSource
+	AltIDs           []AltID
+
+	//Definition is a copy of the Instrument component the instrument was last defined with
+	Definition  components.Instrument
+	Currency    *string
+	RoundLot    *decimal.Decimal
+	MinTradeVol *decimal.Decimal
+
+	//TradingStatus, HaltReason and StatusTime are set from the last SecurityStatus of the instrument
+	TradingStatus *enum.SecurityTradingStatus
+	HaltReason    *enum.HaltReasonChar
+	StatusTime    *time.Time
+}
+
+//Key returns the key of the instrument in the store
+func (in Instrument) Key() Key {
+	if in.SecurityID != "" {
+		return Key{SecurityID: in.SecurityID, SecurityIDSource: in.SecurityIDSource}
+	}
+	return Key{Symbol: in.Symbol}
+}
+
+func (in *Instrument) clone() Instrument {
+	c := *in
+	c.AltIDs = append([]AltID(nil), in.AltIDs...)
+	c.Definition = components.NewInstrument()
+	if in.Definition.FieldMap != nil {
+		in.Definition.CopyInto(c.Definition.FieldMap)
+	}
+	return c
+}
+
+//newInstrument returns an instrument defined by a copy of c
+func newInstrument(c components.Instrument) (*Instrument, quickfix.MessageRejectError) {
+	in := &Instrument{Definition: components.NewInstrument()}
+	if err := c.CopyInto(in.Definition.FieldMap); err != nil {
+		return nil, err
+	}
+
+	def := in.Definition
+	if def.HasSymbol() {
+		v, err := def.GetSymbol()
+		if err != nil {
+			return nil, err
+		}
+		in.Symbol = v
+	}
+	if def.HasSecurityID() {
+		v, err := def.GetSecurityID()
+		if err != nil {
+			return nil, err
+		}
+		in.SecurityID = v
+	}
+	if def.HasSecurityIDSource() {
+		v, err := def.GetSecurityIDSource()
+		if err != nil {
+			return nil, err
+		}
+		in.SecurityIDSource = v
+	}
+	if def.HasNoSecurityAltID() {
+		g, err := def.GetNoSecurityAltID()
+		if err != nil {
+			return nil, err
+		}
+		alts, err := components.MarshalNoSecurityAltID(g)
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range alts {
+			if a.SecurityAltID != nil {
+				in.AltIDs = append(in.AltIDs, AltID{*a.SecurityAltID, fixutil.Deref(a.SecurityAltIDSource)})
+			}
+		}
+	}
+	return in, nil
+}
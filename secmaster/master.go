@@ -0,0 +1,357 @@
+package secmaster
+
+import (
+	"sync"
+
+	"github.com/terracefi/enum"
+	"github.com/terracefi/fix44/components"
+	"github.com/terracefi/fix44/derivativesecuritylist"
+	"github.com/terracefi/fix44/derivativesecuritylistrequest"
+	"github.com/terracefi/fix44/internal/fixutil"
+	"github.com/terracefi/fix44/securitydefinition"
+	"github.com/terracefi/fix44/securitydefinitionrequest"
+	"github.com/terracefi/fix44/securitylist"
+	"github.com/terracefi/fix44/securitylistrequest"
+	"github.com/terracefi/fix44/securitystatus"
+	"github.com/terracefi/fix44/securitystatusrequest"
+	"github.com/terracefi/fix44/securitytyperequest"
+	"github.com/terracefi/fix44/securitytypes"
+	"github.com/terracefi/quickfix"
+)
+
+//SecurityResponseType values, FIX 4.4
+const (
+	RespReject      enum.SecurityResponseType = "5"
+	RespCannotMatch enum.SecurityResponseType = "6"
+)
+
+//Request is a request sent by the Master, or the responses received under a SecurityReqID the Master did not send
+type Request struct {
+	ID string
+
+	//Complete is true once the last fragment of the response has arrived
+	Complete bool
+	//Result is the wire value of the SecurityRequestResult or SecurityResponseType of the response
+	Result string
+	Text   string
+
+	//Keys holds the keys of the instruments of the last response, Types the NoSecurityTypes of the last
+	//SecurityTypes response
+	Keys  []Key
+	Types []securitytypes.NoSecurityTypesStruct
+
+	gathering bool
+	pending   []*Instrument
+}
+
+func (r *Request) clone() Request {
+	c := *r
+	c.Keys = append([]Key(nil), r.Keys...)
+	c.Types = append([]securitytypes.NoSecurityTypesStruct(nil), r.Types...)
+	c.pending = nil
+	return c
+}
+
+//start resets the request for a new response, unless fragments of a response are being gathered
+func (r *Request) start() {
+	if !r.gathering {
+		r.gathering = true
+		r.Complete = false
+		r.Keys, r.Types, r.pending = nil, nil, nil
+	}
+}
+
+//Master sends security reference data requests and keeps the instrument store built from the responses. It is safe
+//for concurrent use.
+type Master struct {
+	//SendMessage sends the reference data requests, by default on the session given to New
+	SendMessage func(msg quickfix.Messagable) error
+
+	mu             sync.Mutex
+	instruments    map[Key]*Instrument
+	bySymbol       map[string]map[Key]bool
+	byAltID        map[AltID]Key
+	requests       map[string]*Request
+	subscribers    map[int]func(Change)
+	nextSubscriber int
+}
+
+//New returns an empty Master that sends on sessionID
+func New(sessionID quickfix.SessionID) *Master {
+	return &Master{
+		SendMessage: fixutil.SendOn(sessionID),
+		instruments: make(map[Key]*Instrument),
+		bySymbol:    make(map[string]map[Key]bool),
+		byAltID:     make(map[AltID]Key),
+		requests:    make(map[string]*Request),
+		subscribers: make(map[int]func(Change)),
+	}
+}
+
+//Request returns the request with the given SecurityReqID or SecurityStatusReqID
+func (m *Master) Request(id string) (Request, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	r, ok := m.requests[id]
+	if !ok {
+		return Request{}, false
+	}
+	return r.clone(), true
+}
+
+//RequestDefinition sends a SecurityDefinitionRequest
+func (m *Master) RequestDefinition(s securitydefinitionrequest.Struct) error {
+	return m.send(s.SecurityReqID, securitydefinitionrequest.Unmarshal(s))
+}
+
+//RequestList sends a SecurityListRequest
+func (m *Master) RequestList(s securitylistrequest.Struct) error {
+	return m.send(s.SecurityReqID, securitylistrequest.Unmarshal(s))
+}
+
+//RequestDerivativeList sends a DerivativeSecurityListRequest
+func (m *Master) RequestDerivativeList(s derivativesecuritylistrequest.Struct) error {
+	return m.send(s.SecurityReqID, derivativesecuritylistrequest.Unmarshal(s))
+}
+
+//RequestStatus sends a SecurityStatusRequest
+func (m *Master) RequestStatus(s securitystatusrequest.Struct) error {
+	return m.send(s.SecurityStatusReqID, securitystatusrequest.Unmarshal(s))
+}
+
+//RequestTypes sends a SecurityTypeRequest
+func (m *Master) RequestTypes(s securitytyperequest.Struct) error {
+	return m.send(s.SecurityReqID, securitytyperequest.Unmarshal(s))
+}
+
+//send records the request with the given ID and sends msg, the request is forgotten if msg cannot be sent
+func (m *Master) send(id string, msg quickfix.Messagable) error {
+	m.mu.Lock()
+	if _, ok := m.requests[id]; ok {
+		m.mu.Unlock()
+		return &DuplicateRequestError{id}
+	}
+	m.requests[id] = &Request{ID: id}
+	m.mu.Unlock()
+
+	if err := m.SendMessage(msg); err != nil {
+		m.mu.Lock()
+		delete(m.requests, id)
+		m.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+//request returns the request with the given ID, adding it if the Master did not send it
+func (m *Master) request(id string) *Request {
+	r, ok := m.requests[id]
+	if !ok {
+		r = &Request{ID: id}
+		m.requests[id] = r
+	}
+	return r
+}
+
+//OnSecurityDefinition adds the instrument of a SecurityDefinition to the store, unless the definition rejects the
+//request
+func (m *Master) OnSecurityDefinition(msg securitydefinition.SecurityDefinition) (Request, error) {
+	s, err := securitydefinition.Marshal(msg)
+	if err != nil {
+		return Request{}, err
+	}
+
+	var in *Instrument
+	if s.SecurityResponseType != RespReject && s.SecurityResponseType != RespCannotMatch && (s.Symbol != nil || s.SecurityID != nil) {
+		if in, err = newInstrument(components.Instrument{&msg.Body.FieldMap}); err != nil {
+			return Request{}, err
+		}
+		in.Currency, in.RoundLot, in.MinTradeVol = s.Currency, s.RoundLot, s.MinTradeVol
+	}
+
+	m.mu.Lock()
+	r := m.request(s.SecurityReqID)
+	r.start()
+	r.gathering, r.Complete = false, true
+	r.Result, r.Text = string(s.SecurityResponseType), fixutil.Deref(s.Text)
+
+	var changes []Change
+	if in != nil {
+		changes = append(changes, m.put(in))
+		r.Keys = append(r.Keys, in.Key())
+	}
+	c := r.clone()
+	m.mu.Unlock()
+
+	m.notify(changes)
+	return c, nil
+}
+
+//OnSecurityList gathers the instruments of a SecurityList, and adds them to the store once the last fragment has
+//arrived
+func (m *Master) OnSecurityList(msg securitylist.SecurityList) (Request, error) {
+	s, err := securitylist.Marshal(msg)
+	if err != nil {
+		return Request{}, err
+	}
+
+	var ins []*Instrument
+	if len(s.NoRelatedSym) > 0 {
+		g, err := msg.GetNoRelatedSym()
+		if err != nil {
+			return Request{}, err
+		}
+		for i, e := range s.NoRelatedSym {
+			in, err := newInstrument(g.Get(i).GetInstrument())
+			if err != nil {
+				return Request{}, err
+			}
+			in.Currency, in.RoundLot, in.MinTradeVol = e.Currency, e.RoundLot, e.MinTradeVol
+			ins = append(ins, in)
+		}
+	}
+	return m.gather(s.SecurityReqID, string(s.SecurityRequestResult), ins, s.TotNoRelatedSym, s.LastFragment), nil
+}
+
+//OnDerivativeSecurityList gathers the instruments of a DerivativeSecurityList, and adds them to the store once the
+//last fragment has arrived
+func (m *Master) OnDerivativeSecurityList(msg derivativesecuritylist.DerivativeSecurityList) (Request, error) {
+	s, err := derivativesecuritylist.Marshal(msg)
+	if err != nil {
+		return Request{}, err
+	}
+
+	var ins []*Instrument
+	if len(s.NoRelatedSym) > 0 {
+		g, err := msg.GetNoRelatedSym()
+		if err != nil {
+			return Request{}, err
+		}
+		for i, e := range s.NoRelatedSym {
+			in, err := newInstrument(g.Get(i).GetInstrument())
+			if err != nil {
+				return Request{}, err
+			}
+			in.Currency = e.Currency
+			ins = append(ins, in)
+		}
+	}
+	return m.gather(s.SecurityReqID, string(s.SecurityRequestResult), ins, s.TotNoRelatedSym, s.LastFragment), nil
+}
+
+func (m *Master) gather(id, result string, ins []*Instrument, total *int, lastFragment *bool) Request {
+	m.mu.Lock()
+	r := m.request(id)
+	r.start()
+	r.Result = result
+	r.pending = append(r.pending, ins...)
+
+	var changes []Change
+	if complete(len(r.pending), total, lastFragment) {
+		for _, in := range r.pending {
+			changes = append(changes, m.put(in))
+			r.Keys = append(r.Keys, in.Key())
+		}
+		r.pending = nil
+		r.gathering, r.Complete = false, true
+	}
+	c := r.clone()
+	m.mu.Unlock()
+
+	m.notify(changes)
+	return c
+}
+
+//OnSecurityTypes gathers the NoSecurityTypes of a SecurityTypes response
+func (m *Master) OnSecurityTypes(msg securitytypes.SecurityTypes) (Request, error) {
+	s, err := securitytypes.Marshal(msg)
+	if err != nil {
+		return Request{}, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	r := m.request(s.SecurityReqID)
+	r.start()
+	r.Result, r.Text = string(s.SecurityResponseType), fixutil.Deref(s.Text)
+	r.Types = append(r.Types, s.NoSecurityTypes...)
+	if complete(len(r.Types), s.TotNoSecurityTypes, s.LastFragment) {
+		r.gathering, r.Complete = false, true
+	}
+	return r.clone(), nil
+}
+
+//OnSecurityStatus records the trading status of a SecurityStatus on its instrument, adding the instrument to the
+//store if it is not known. The instrument is looked up by key, then by its NoSecurityAltID entries and then by
+//Symbol, so a status that only gives the Symbol updates an instrument stored under its SecurityID.
+func (m *Master) OnSecurityStatus(msg securitystatus.SecurityStatus) (Instrument, error) {
+	s, err := securitystatus.Marshal(msg)
+	if err != nil {
+		return Instrument{}, err
+	}
+	st, err := newInstrument(components.Instrument{&msg.Body.FieldMap})
+	if err != nil {
+		return Instrument{}, err
+	}
+
+	m.mu.Lock()
+	in := m.find(st)
+	kind := StatusChanged
+	if in == nil {
+		in = st
+		m.instruments[in.Key()] = in
+		m.index(in)
+		kind = Added
+	}
+	in.TradingStatus, in.HaltReason, in.StatusTime = s.SecurityTradingStatus, s.HaltReasonChar, s.TransactTime
+
+	if s.SecurityStatusReqID != nil {
+		if r, ok := m.requests[*s.SecurityStatusReqID]; ok {
+			r.Complete = true
+			r.Keys = []Key{in.Key()}
+			r.Text = fixutil.Deref(s.Text)
+		}
+	}
+	c := in.clone()
+	m.mu.Unlock()
+
+	m.notify([]Change{{kind, c}})
+	return c, nil
+}
+
+//find returns the instrument of the store that st refers to: the one with its key, with one of its NoSecurityAltID
+//entries or, if it is the only one with the Symbol of st and their SecurityIDs do not differ, with its Symbol. It
+//returns nil if there is none.
+func (m *Master) find(st *Instrument) *Instrument {
+	if in, ok := m.instruments[st.Key()]; ok {
+		return in
+	}
+	for _, a := range st.AltIDs {
+		if key, ok := m.byAltID[a]; ok {
+			return m.instruments[key]
+		}
+	}
+	if keys := m.bySymbol[st.Symbol]; len(keys) == 1 {
+		for key := range keys {
+			in := m.instruments[key]
+			if st.SecurityID == "" || in.SecurityID == "" || st.SecurityID == in.SecurityID {
+				return in
+			}
+		}
+	}
+	return nil
+}
+
+//complete returns true if a response of which n entries have arrived is complete, as told by lastFragment or total.
+//A response with neither is not fragmented.
+func complete(n int, total *int, lastFragment *bool) bool {
+	switch {
+	case lastFragment != nil && *lastFragment:
+		return true
+	case total != nil && n >= *total:
+		return true
+	}
+	return lastFragment == nil && total == nil
+}
@@ -0,0 +1,225 @@
+package secmaster
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/terracefi/enum"
+	"github.com/terracefi/fix44/components"
+	"github.com/terracefi/fix44/internal/fixutil"
+	"github.com/terracefi/fix44/securitydefinition"
+	"github.com/terracefi/fix44/securitylist"
+	"github.com/terracefi/fix44/securitylistrequest"
+	"github.com/terracefi/fix44/securitystatus"
+	"github.com/terracefi/quickfix"
+)
+
+const isin enum.SecurityIDSource = "4"
+
+//ref is the identification of an instrument in a test message
+type ref struct {
+	symbol, securityID, altID string
+}
+
+func (r ref) fields() (symbol, securityID *string, source *enum.SecurityIDSource,
+	alts []components.NoSecurityAltIDStruct) {
+	if r.symbol != "" {
+		symbol = fixutil.Ptr(r.symbol)
+	}
+	if r.securityID != "" {
+		securityID, source = fixutil.Ptr(r.securityID), fixutil.Ptr(isin)
+	}
+	if r.altID != "" {
+		alts = []components.NoSecurityAltIDStruct{{SecurityAltID: fixutil.Ptr(r.altID),
+			SecurityAltIDSource: fixutil.Ptr("8")}}
+	}
+	return
+}
+
+func definition(r ref) securitydefinition.SecurityDefinition {
+	s := securitydefinition.Struct{SecurityReqID: "D-" + r.symbol + r.securityID, SecurityResponseID: "1",
+		SecurityResponseType: "1"}
+	s.Symbol, s.SecurityID, s.SecurityIDSource, s.NoSecurityAltID = r.fields()
+	return securitydefinition.Unmarshal(s)
+}
+
+func status(r ref, tradingStatus enum.SecurityTradingStatus) securitystatus.SecurityStatus {
+	s := securitystatus.Struct{SecurityTradingStatus: fixutil.Ptr(tradingStatus)}
+	s.Symbol, s.SecurityID, s.SecurityIDSource, s.NoSecurityAltID = r.fields()
+	return securitystatus.Unmarshal(s)
+}
+
+func newMaster(send func(quickfix.Messagable) error) *Master {
+	m := New(quickfix.SessionID{})
+	m.SendMessage = send
+	return m
+}
+
+func TestOnSecurityStatus(t *testing.T) {
+	tests := []struct {
+		name        string
+		defined     []ref
+		status      ref
+		kind        ChangeKind
+		key         Key
+		instruments int
+	}{
+		{"by key", []ref{{"ABC", "ID1", ""}}, ref{"", "ID1", ""}, StatusChanged, Key{SecurityID: "ID1",
+			SecurityIDSource: isin}, 1},
+		{"by symbol only", []ref{{"ABC", "ID1", ""}}, ref{"ABC", "", ""}, StatusChanged, Key{SecurityID: "ID1",
+			SecurityIDSource: isin}, 1},
+		{"by alt ID", []ref{{"ABC", "ID1", "ALT1"}}, ref{"", "ID9", "ALT1"}, StatusChanged, Key{SecurityID: "ID1",
+			SecurityIDSource: isin}, 1},
+		{"symbol-keyed by symbol", []ref{{"ABC", "", ""}}, ref{"ABC", "", ""}, StatusChanged, Key{Symbol: "ABC"}, 1},
+		{"ambiguous symbol", []ref{{"ABC", "ID1", ""}, {"ABC", "ID2", ""}}, ref{"ABC", "", ""}, Added,
+			Key{Symbol: "ABC"}, 3},
+		{"same symbol, other SecurityID", []ref{{"ABC", "ID1", ""}}, ref{"ABC", "ID2", ""}, Added,
+			Key{SecurityID: "ID2", SecurityIDSource: isin}, 2},
+		{"unknown", []ref{{"ABC", "ID1", ""}}, ref{"XYZ", "", ""}, Added, Key{Symbol: "XYZ"}, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newMaster(nil)
+			for _, d := range tt.defined {
+				if _, err := m.OnSecurityDefinition(definition(d)); err != nil {
+					t.Fatal(err)
+				}
+			}
+			var changes []Change
+			m.Subscribe(func(c Change) { changes = append(changes, c) })
+
+			in, err := m.OnSecurityStatus(status(tt.status, "17"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if in.Key() != tt.key {
+				t.Errorf("Key() = %+v, want %+v", in.Key(), tt.key)
+			}
+			if len(changes) != 1 || changes[0].Kind != tt.kind {
+				t.Errorf("changes = %v, want one %v", changes, tt.kind)
+			}
+			stored, ok := m.Instrument(tt.key)
+			if !ok || stored.TradingStatus == nil || *stored.TradingStatus != "17" {
+				t.Errorf("Instrument(%+v) = %+v, %v, want TradingStatus 17", tt.key, stored, ok)
+			}
+			if n := len(m.Instruments()); n != tt.instruments {
+				t.Errorf("len(Instruments()) = %v, want %v", n, tt.instruments)
+			}
+		})
+	}
+}
+
+func TestDefinitionKeepsStatus(t *testing.T) {
+	m := newMaster(nil)
+	abc := ref{"ABC", "ID1", "ALT1"}
+	m.OnSecurityDefinition(definition(abc))
+	m.OnSecurityStatus(status(abc, "2"))
+
+	var changes []Change
+	m.Subscribe(func(c Change) { changes = append(changes, c) })
+	m.OnSecurityDefinition(definition(abc))
+
+	if len(changes) != 1 || changes[0].Kind != Updated {
+		t.Fatalf("changes = %v, want one Updated", changes)
+	}
+	in, _ := m.ByAltID("ALT1", "8")
+	if in.TradingStatus == nil || *in.TradingStatus != "2" {
+		t.Errorf("TradingStatus = %v, want 2", in.TradingStatus)
+	}
+	if got := m.BySymbol("ABC"); len(got) != 1 {
+		t.Errorf("BySymbol() = %v, want one instrument", got)
+	}
+}
+
+//fragment is one SecurityList message of a response
+type fragment struct {
+	symbols      []string
+	total        *int
+	lastFragment *bool
+}
+
+func (f fragment) msg(reqID string) securitylist.SecurityList {
+	s := securitylist.Struct{SecurityReqID: reqID, SecurityResponseID: "1", SecurityRequestResult: "0",
+		TotNoRelatedSym: f.total, LastFragment: f.lastFragment}
+	for _, sym := range f.symbols {
+		s.NoRelatedSym = append(s.NoRelatedSym, securitylist.NoRelatedSymStruct{Symbol: fixutil.Ptr(sym)})
+	}
+	return securitylist.Unmarshal(s)
+}
+
+func TestOnSecurityListFragments(t *testing.T) {
+	tests := []struct {
+		name      string
+		fragments []fragment
+		complete  []bool
+		keys      []Key
+	}{
+		{"unfragmented", []fragment{{[]string{"A", "B"}, nil, nil}}, []bool{true},
+			[]Key{{Symbol: "A"}, {Symbol: "B"}}},
+		{"LastFragment", []fragment{{[]string{"A"}, nil, fixutil.Ptr(false)}, {[]string{"B"}, nil, fixutil.Ptr(true)}},
+			[]bool{false, true}, []Key{{Symbol: "A"}, {Symbol: "B"}}},
+		{"TotNoRelatedSym", []fragment{{[]string{"A", "B"}, fixutil.Ptr(3), nil}, {[]string{"C"}, fixutil.Ptr(3), nil}},
+			[]bool{false, true}, []Key{{Symbol: "A"}, {Symbol: "B"}, {Symbol: "C"}}},
+		{"short of TotNoRelatedSym", []fragment{{[]string{"A"}, fixutil.Ptr(3), nil}, {[]string{"B"}, fixutil.Ptr(3),
+			nil}},
+			[]bool{false, false}, nil},
+		{"empty", []fragment{{nil, fixutil.Ptr(0), nil}}, []bool{true}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newMaster(func(quickfix.Messagable) error { return nil })
+			err := m.RequestList(securitylistrequest.Struct{SecurityReqID: "L1", SecurityListRequestType: "4"})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var r Request
+			for i, f := range tt.fragments {
+				var err error
+				if r, err = m.OnSecurityList(f.msg("L1")); err != nil {
+					t.Fatal(err)
+				}
+				if r.Complete != tt.complete[i] {
+					t.Errorf("fragment %v: Complete = %v, want %v", i, r.Complete, tt.complete[i])
+				}
+			}
+			if !reflect.DeepEqual(r.Keys, tt.keys) {
+				t.Errorf("Keys = %v, want %v", r.Keys, tt.keys)
+			}
+			if n := len(m.Instruments()); n != len(tt.keys) {
+				t.Errorf("len(Instruments()) = %v, want %v", n, len(tt.keys))
+			}
+		})
+	}
+}
+
+func TestSend(t *testing.T) {
+	errSend := errors.New("send failed")
+	tests := []struct {
+		name    string
+		sendErr error
+		ids     []string
+		wantErr []error
+		known   bool
+	}{
+		{"sent", nil, []string{"L1"}, []error{nil}, true},
+		{"duplicate", nil, []string{"L1", "L1"}, []error{nil, &DuplicateRequestError{}}, true},
+		{"send error", errSend, []string{"L1"}, []error{errSend}, false},
+		{"resent after a send error", errSend, []string{"L1", "L1"}, []error{errSend, errSend}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newMaster(func(quickfix.Messagable) error { return tt.sendErr })
+			for i, id := range tt.ids {
+				err := m.RequestList(securitylistrequest.Struct{SecurityReqID: id, SecurityListRequestType: "4"})
+				if reflect.TypeOf(err) != reflect.TypeOf(tt.wantErr[i]) {
+					t.Errorf("RequestList() %v error = %v, want %T", i, err, tt.wantErr[i])
+				}
+			}
+			if _, ok := m.Request("L1"); ok != tt.known {
+				t.Errorf("Request() found = %v, want %v", ok, tt.known)
+			}
+		})
+	}
+}
@@ -0,0 +1,189 @@
+package secmaster
+
+import (
+	"sort"
+
+	"github.com/terracefi/enum"
+)
+
+//ChangeKind tells how the store changed
+type ChangeKind int
+
+//ChangeKind values
+const (
+	//Added is an instrument new to the store
+	Added ChangeKind = iota
+	//Updated is an instrument defined again
+	Updated
+	//StatusChanged is an instrument given a new trading status
+	StatusChanged
+)
+
+func (k ChangeKind) String() string {
+	switch k {
+	case Added:
+		return "Added"
+	case Updated:
+		return "Updated"
+	case StatusChanged:
+		return "StatusChanged"
+	}
+	return "Unknown"
+}
+
+//Change is a change to the store, Instrument is the instrument after the change
+type Change struct {
+	Kind       ChangeKind
+	Instrument Instrument
+}
+
+//Subscribe registers fn to be called with every change to the store, and returns a func that unregisters it. fn is
+//called without any lock held.
+func (m *Master) Subscribe(fn func(Change)) (unsubscribe func()) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	id := m.nextSubscriber
+	m.nextSubscriber++
+	m.subscribers[id] = fn
+	return func() {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		delete(m.subscribers, id)
+	}
+}
+
+//notify calls the subscribers with changes, the caller must not hold the lock
+func (m *Master) notify(changes []Change) {
+	if len(changes) == 0 {
+		return
+	}
+
+	m.mu.Lock()
+	ids := make([]int, 0, len(m.subscribers))
+	for id := range m.subscribers {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	subs := make([]func(Change), len(ids))
+	for i, id := range ids {
+		subs[i] = m.subscribers[id]
+	}
+	m.mu.Unlock()
+
+	for _, c := range changes {
+		for _, fn := range subs {
+			fn(c)
+		}
+	}
+}
+
+//Instrument returns the instrument with the given key
+func (m *Master) Instrument(key Key) (Instrument, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	in, ok := m.instruments[key]
+	if !ok {
+		return Instrument{}, false
+	}
+	return in.clone(), true
+}
+
+//Instruments returns every instrument of the store, ordered by key
+func (m *Master) Instruments() []Instrument {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	out := make([]Instrument, 0, len(m.instruments))
+	for _, in := range m.instruments {
+		out = append(out, in.clone())
+	}
+	sortInstruments(out)
+	return out
+}
+
+//BySymbol returns the instruments with the given Symbol, ordered by key
+func (m *Master) BySymbol(symbol string) []Instrument {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var out []Instrument
+	for key := range m.bySymbol[symbol] {
+		out = append(out, m.instruments[key].clone())
+	}
+	sortInstruments(out)
+	return out
+}
+
+//BySecurityID returns the instrument with the given SecurityID and SecurityIDSource
+func (m *Master) BySecurityID(securityID string, securityIDSource enum.SecurityIDSource) (Instrument, bool) {
+	return m.Instrument(Key{SecurityID: securityID, SecurityIDSource: securityIDSource})
+}
+
+//ByAltID returns the instrument with the given NoSecurityAltID entry
+func (m *Master) ByAltID(securityAltID, securityAltIDSource string) (Instrument, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key, ok := m.byAltID[AltID{securityAltID, securityAltIDSource}]
+	if !ok {
+		return Instrument{}, false
+	}
+	return m.instruments[key].clone(), true
+}
+
+//put adds in to the store, replacing the instrument with the same key but keeping its trading status
+func (m *Master) put(in *Instrument) Change {
+	key := in.Key()
+	kind := Added
+	if old, ok := m.instruments[key]; ok {
+		kind = Updated
+		in.TradingStatus, in.HaltReason, in.StatusTime = old.TradingStatus, old.HaltReason, old.StatusTime
+		m.unindex(old)
+	}
+	m.instruments[key] = in
+	m.index(in)
+	return Change{kind, in.clone()}
+}
+
+func (m *Master) index(in *Instrument) {
+	key := in.Key()
+	if in.Symbol != "" {
+		if m.bySymbol[in.Symbol] == nil {
+			m.bySymbol[in.Symbol] = make(map[Key]bool)
+		}
+		m.bySymbol[in.Symbol][key] = true
+	}
+	for _, a := range in.AltIDs {
+		m.byAltID[a] = key
+	}
+}
+
+func (m *Master) unindex(in *Instrument) {
+	key := in.Key()
+	if keys, ok := m.bySymbol[in.Symbol]; ok {
+		delete(keys, key)
+		if len(keys) == 0 {
+			delete(m.bySymbol, in.Symbol)
+		}
+	}
+	for _, a := range in.AltIDs {
+		if m.byAltID[a] == key {
+			delete(m.byAltID, a)
+		}
+	}
+}
+
+func sortInstruments(ins []Instrument) {
+	sort.Slice(ins, func(i, j int) bool {
+		a, b := ins[i].Key(), ins[j].Key()
+		switch {
+		case a.Symbol != b.Symbol:
+			return a.Symbol < b.Symbol
+		case a.SecurityIDSource != b.SecurityIDSource:
+			return a.SecurityIDSource < b.SecurityIDSource
+		}
+		return a.SecurityID < b.SecurityID
+	})
+}
@@ -55,6 +55,26 @@ func Route(router RouteOut) (string, string, quickfix.MessageRoute) {
 	return "FIX.4.4", "v", r
 }
 
+//Validate checks m against the FIX 4.4 definition of SecurityTypeRequest, see fix44.MessageDef.Validate
+func (m SecurityTypeRequest) Validate() fix44.ValidationErrors {
+	return fix44.MessageDefs["v"].Validate(&m.Body.FieldMap)
+}
+
+//MarshalJSON encodes the SecurityTypeRequest as JSON with fields keyed by name, see fix44.MarshalMessageJSON
+func (m SecurityTypeRequest) MarshalJSON() ([]byte, error) {
+	return fix44.MarshalMessageJSON(m.Message)
+}
+
+//UnmarshalJSON builds the SecurityTypeRequest from JSON produced by MarshalJSON
+func (m *SecurityTypeRequest) UnmarshalJSON(b []byte) error {
+	msg, err := fix44.UnmarshalMessageJSON(b, "v")
+	if err != nil {
+		return err
+	}
+	*m = FromMessage(msg)
+	return nil
+}
+
 //SetText sets Text, Tag 58
 func (m SecurityTypeRequest) SetText(v string) {
 	m.Set(field.NewText(v))
@@ -211,6 +231,21 @@ func (m SecurityTypeRequest) HasEncodedText() bool {
 	return m.Has(tag.EncodedText)
 }
 
+//SetEncodedTextBytes sets EncodedText, Tag 355, and EncodedTextLen, Tag 354, from v, which may hold any bytes including SOH
+func (m SecurityTypeRequest) SetEncodedTextBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedTextLen, tag.EncodedText, v)
+}
+
+//GetEncodedTextBytes gets EncodedText, Tag 355, checking its length against EncodedTextLen, Tag 354
+func (m SecurityTypeRequest) GetEncodedTextBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedTextLen, tag.EncodedText)
+}
+
+//GetEncodedTextDecoded gets EncodedText, Tag 355, decoded to UTF-8 using the MessageEncoding of the Header
+func (m SecurityTypeRequest) GetEncodedTextDecoded() (string, error) {
+	return fix44.DecodeRawData(m.Header, m, tag.EncodedTextLen, tag.EncodedText)
+}
+
 //HasProduct returns true if Product is present, Tag 460
 func (m SecurityTypeRequest) HasProduct() bool {
 	return m.Has(tag.Product)
@@ -225,3 +260,115 @@ func (m SecurityTypeRequest) HasTradingSessionSubID() bool {
 func (m SecurityTypeRequest) HasSecuritySubType() bool {
 	return m.Has(tag.SecuritySubType)
 }
+
+//Struct is a plain Go representation of the SecurityTypeRequest body, optional fields are nil when absent
+type Struct struct {
+	Text                *string
+	SecurityType        *enum.SecurityType
+	SecurityReqID       string
+	TradingSessionID    *enum.TradingSessionID
+	EncodedTextLen      *int
+	EncodedText         *string
+	Product             *enum.Product
+	TradingSessionSubID *enum.TradingSessionSubID
+	SecuritySubType     *string
+}
+
+//Marshal copies the fields present in msg into a Struct
+func Marshal(msg SecurityTypeRequest) (Struct, quickfix.MessageRejectError) {
+	var s Struct
+	if msg.HasText() {
+		v, err := msg.GetText()
+		if err != nil {
+			return s, err
+		}
+		s.Text = &v
+	}
+	if msg.HasSecurityType() {
+		v, err := msg.GetSecurityType()
+		if err != nil {
+			return s, err
+		}
+		s.SecurityType = &v
+	}
+	if msg.HasSecurityReqID() {
+		v, err := msg.GetSecurityReqID()
+		if err != nil {
+			return s, err
+		}
+		s.SecurityReqID = v
+	}
+	if msg.HasTradingSessionID() {
+		v, err := msg.GetTradingSessionID()
+		if err != nil {
+			return s, err
+		}
+		s.TradingSessionID = &v
+	}
+	if msg.HasEncodedTextLen() {
+		v, err := msg.GetEncodedTextLen()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedTextLen = &v
+	}
+	if msg.HasEncodedText() {
+		v, err := msg.GetEncodedText()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedText = &v
+	}
+	if msg.HasProduct() {
+		v, err := msg.GetProduct()
+		if err != nil {
+			return s, err
+		}
+		s.Product = &v
+	}
+	if msg.HasTradingSessionSubID() {
+		v, err := msg.GetTradingSessionSubID()
+		if err != nil {
+			return s, err
+		}
+		s.TradingSessionSubID = &v
+	}
+	if msg.HasSecuritySubType() {
+		v, err := msg.GetSecuritySubType()
+		if err != nil {
+			return s, err
+		}
+		s.SecuritySubType = &v
+	}
+	return s, nil
+}
+
+//Unmarshal builds a SecurityTypeRequest from s
+func Unmarshal(s Struct) SecurityTypeRequest {
+	m := New(field.NewSecurityReqID(s.SecurityReqID))
+	if s.Text != nil {
+		m.SetText(*s.Text)
+	}
+	if s.SecurityType != nil {
+		m.SetSecurityType(*s.SecurityType)
+	}
+	if s.TradingSessionID != nil {
+		m.SetTradingSessionID(*s.TradingSessionID)
+	}
+	if s.EncodedTextLen != nil {
+		m.SetEncodedTextLen(*s.EncodedTextLen)
+	}
+	if s.EncodedText != nil {
+		m.SetEncodedText(*s.EncodedText)
+	}
+	if s.Product != nil {
+		m.SetProduct(*s.Product)
+	}
+	if s.TradingSessionSubID != nil {
+		m.SetTradingSessionSubID(*s.TradingSessionSubID)
+	}
+	if s.SecuritySubType != nil {
+		m.SetSecuritySubType(*s.SecuritySubType)
+	}
+	return m
+}
@@ -57,6 +57,26 @@ func Route(router RouteOut) (string, string, quickfix.MessageRoute) {
 	return "FIX.4.4", "w", r
 }
 
+//Validate checks m against the FIX 4.4 definition of SecurityTypes, see fix44.MessageDef.Validate
+func (m SecurityTypes) Validate() fix44.ValidationErrors {
+	return fix44.MessageDefs["w"].Validate(&m.Body.FieldMap)
+}
+
+//MarshalJSON encodes the SecurityTypes as JSON with fields keyed by name, see fix44.MarshalMessageJSON
+func (m SecurityTypes) MarshalJSON() ([]byte, error) {
+	return fix44.MarshalMessageJSON(m.Message)
+}
+
+//UnmarshalJSON builds the SecurityTypes from JSON produced by MarshalJSON
+func (m *SecurityTypes) UnmarshalJSON(b []byte) error {
+	msg, err := fix44.UnmarshalMessageJSON(b, "w")
+	if err != nil {
+		return err
+	}
+	*m = FromMessage(msg)
+	return nil
+}
+
 //SetText sets Text, Tag 58
 func (m SecurityTypes) SetText(v string) {
 	m.Set(field.NewText(v))
@@ -263,6 +283,21 @@ func (m SecurityTypes) HasEncodedText() bool {
 	return m.Has(tag.EncodedText)
 }
 
+//SetEncodedTextBytes sets EncodedText, Tag 355, and EncodedTextLen, Tag 354, from v, which may hold any bytes including SOH
+func (m SecurityTypes) SetEncodedTextBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedTextLen, tag.EncodedText, v)
+}
+
+//GetEncodedTextBytes gets EncodedText, Tag 355, checking its length against EncodedTextLen, Tag 354
+func (m SecurityTypes) GetEncodedTextBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedTextLen, tag.EncodedText)
+}
+
+//GetEncodedTextDecoded gets EncodedText, Tag 355, decoded to UTF-8 using the MessageEncoding of the Header
+func (m SecurityTypes) GetEncodedTextDecoded() (string, error) {
+	return fix44.DecodeRawData(m.Header, m, tag.EncodedTextLen, tag.EncodedText)
+}
+
 //HasTotNoSecurityTypes returns true if TotNoSecurityTypes is present, Tag 557
 func (m SecurityTypes) HasTotNoSecurityTypes() bool {
 	return m.Has(tag.TotNoSecurityTypes)
@@ -386,3 +421,210 @@ func (m NoSecurityTypesRepeatingGroup) Add() NoSecurityTypes {
 func (m NoSecurityTypesRepeatingGroup) Get(i int) NoSecurityTypes {
 	return NoSecurityTypes{m.RepeatingGroup.Get(i)}
 }
+
+//Struct is a plain Go representation of the SecurityTypes body, optional fields are nil when absent
+type Struct struct {
+	Text                    *string
+	SubscriptionRequestType *enum.SubscriptionRequestType
+	SecurityReqID           string
+	SecurityResponseID      string
+	SecurityResponseType    enum.SecurityResponseType
+	TradingSessionID        *enum.TradingSessionID
+	EncodedTextLen          *int
+	EncodedText             *string
+	TotNoSecurityTypes      *int
+	NoSecurityTypes         []NoSecurityTypesStruct
+	TradingSessionSubID     *enum.TradingSessionSubID
+	LastFragment            *bool
+}
+
+//Marshal copies the fields present in msg into a Struct
+func Marshal(msg SecurityTypes) (Struct, quickfix.MessageRejectError) {
+	var s Struct
+	if msg.HasText() {
+		v, err := msg.GetText()
+		if err != nil {
+			return s, err
+		}
+		s.Text = &v
+	}
+	if msg.HasSubscriptionRequestType() {
+		v, err := msg.GetSubscriptionRequestType()
+		if err != nil {
+			return s, err
+		}
+		s.SubscriptionRequestType = &v
+	}
+	if msg.HasSecurityReqID() {
+		v, err := msg.GetSecurityReqID()
+		if err != nil {
+			return s, err
+		}
+		s.SecurityReqID = v
+	}
+	if msg.HasSecurityResponseID() {
+		v, err := msg.GetSecurityResponseID()
+		if err != nil {
+			return s, err
+		}
+		s.SecurityResponseID = v
+	}
+	if msg.HasSecurityResponseType() {
+		v, err := msg.GetSecurityResponseType()
+		if err != nil {
+			return s, err
+		}
+		s.SecurityResponseType = v
+	}
+	if msg.HasTradingSessionID() {
+		v, err := msg.GetTradingSessionID()
+		if err != nil {
+			return s, err
+		}
+		s.TradingSessionID = &v
+	}
+	if msg.HasEncodedTextLen() {
+		v, err := msg.GetEncodedTextLen()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedTextLen = &v
+	}
+	if msg.HasEncodedText() {
+		v, err := msg.GetEncodedText()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedText = &v
+	}
+	if msg.HasTotNoSecurityTypes() {
+		v, err := msg.GetTotNoSecurityTypes()
+		if err != nil {
+			return s, err
+		}
+		s.TotNoSecurityTypes = &v
+	}
+	if msg.HasNoSecurityTypes() {
+		g, err := msg.GetNoSecurityTypes()
+		if err != nil {
+			return s, err
+		}
+		if s.NoSecurityTypes, err = MarshalNoSecurityTypes(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasTradingSessionSubID() {
+		v, err := msg.GetTradingSessionSubID()
+		if err != nil {
+			return s, err
+		}
+		s.TradingSessionSubID = &v
+	}
+	if msg.HasLastFragment() {
+		v, err := msg.GetLastFragment()
+		if err != nil {
+			return s, err
+		}
+		s.LastFragment = &v
+	}
+	return s, nil
+}
+
+//Unmarshal builds a SecurityTypes from s
+func Unmarshal(s Struct) SecurityTypes {
+	m := New(field.NewSecurityReqID(s.SecurityReqID), field.NewSecurityResponseID(s.SecurityResponseID), field.NewSecurityResponseType(s.SecurityResponseType))
+	if s.Text != nil {
+		m.SetText(*s.Text)
+	}
+	if s.SubscriptionRequestType != nil {
+		m.SetSubscriptionRequestType(*s.SubscriptionRequestType)
+	}
+	if s.TradingSessionID != nil {
+		m.SetTradingSessionID(*s.TradingSessionID)
+	}
+	if s.EncodedTextLen != nil {
+		m.SetEncodedTextLen(*s.EncodedTextLen)
+	}
+	if s.EncodedText != nil {
+		m.SetEncodedText(*s.EncodedText)
+	}
+	if s.TotNoSecurityTypes != nil {
+		m.SetTotNoSecurityTypes(*s.TotNoSecurityTypes)
+	}
+	if s.NoSecurityTypes != nil {
+		m.SetNoSecurityTypes(UnmarshalNoSecurityTypes(s.NoSecurityTypes))
+	}
+	if s.TradingSessionSubID != nil {
+		m.SetTradingSessionSubID(*s.TradingSessionSubID)
+	}
+	if s.LastFragment != nil {
+		m.SetLastFragment(*s.LastFragment)
+	}
+	return m
+}
+
+//NoSecurityTypesStruct is a plain Go representation of a NoSecurityTypes group element, optional fields are nil when absent
+type NoSecurityTypesStruct struct {
+	SecurityType    *enum.SecurityType
+	SecuritySubType *string
+	Product         *enum.Product
+	CFICode         *string
+}
+
+//MarshalNoSecurityTypes copies the elements of g into a slice of NoSecurityTypesStruct
+func MarshalNoSecurityTypes(g NoSecurityTypesRepeatingGroup) ([]NoSecurityTypesStruct, quickfix.MessageRejectError) {
+	s := make([]NoSecurityTypesStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasSecurityType() {
+			v, err := m.GetSecurityType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SecurityType = &v
+		}
+		if m.HasSecuritySubType() {
+			v, err := m.GetSecuritySubType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SecuritySubType = &v
+		}
+		if m.HasProduct() {
+			v, err := m.GetProduct()
+			if err != nil {
+				return nil, err
+			}
+			s[i].Product = &v
+		}
+		if m.HasCFICode() {
+			v, err := m.GetCFICode()
+			if err != nil {
+				return nil, err
+			}
+			s[i].CFICode = &v
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoSecurityTypes builds a NoSecurityTypesRepeatingGroup from s
+func UnmarshalNoSecurityTypes(s []NoSecurityTypesStruct) NoSecurityTypesRepeatingGroup {
+	g := NewNoSecurityTypesRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.SecurityType != nil {
+			m.SetSecurityType(*e.SecurityType)
+		}
+		if e.SecuritySubType != nil {
+			m.SetSecuritySubType(*e.SecuritySubType)
+		}
+		if e.Product != nil {
+			m.SetProduct(*e.Product)
+		}
+		if e.CFICode != nil {
+			m.SetCFICode(*e.CFICode)
+		}
+	}
+	return g
+}
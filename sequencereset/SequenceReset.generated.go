@@ -54,6 +54,26 @@ func Route(router RouteOut) (string, string, quickfix.MessageRoute) {
 	return "FIX.4.4", "4", r
 }
 
+//Validate checks m against the FIX 4.4 definition of SequenceReset, see fix44.MessageDef.Validate
+func (m SequenceReset) Validate() fix44.ValidationErrors {
+	return fix44.MessageDefs["4"].Validate(&m.Body.FieldMap)
+}
+
+//MarshalJSON encodes the SequenceReset as JSON with fields keyed by name, see fix44.MarshalMessageJSON
+func (m SequenceReset) MarshalJSON() ([]byte, error) {
+	return fix44.MarshalMessageJSON(m.Message)
+}
+
+//UnmarshalJSON builds the SequenceReset from JSON produced by MarshalJSON
+func (m *SequenceReset) UnmarshalJSON(b []byte) error {
+	msg, err := fix44.UnmarshalMessageJSON(b, "4")
+	if err != nil {
+		return err
+	}
+	*m = FromMessage(msg)
+	return nil
+}
+
 //SetNewSeqNo sets NewSeqNo, Tag 36
 func (m SequenceReset) SetNewSeqNo(v int) {
 	m.Set(field.NewNewSeqNo(v))
@@ -91,3 +111,38 @@ func (m SequenceReset) HasNewSeqNo() bool {
 func (m SequenceReset) HasGapFillFlag() bool {
 	return m.Has(tag.GapFillFlag)
 }
+
+//Struct is a plain Go representation of the SequenceReset body, optional fields are nil when absent
+type Struct struct {
+	NewSeqNo    int
+	GapFillFlag *bool
+}
+
+//Marshal copies the fields present in msg into a Struct
+func Marshal(msg SequenceReset) (Struct, quickfix.MessageRejectError) {
+	var s Struct
+	if msg.HasNewSeqNo() {
+		v, err := msg.GetNewSeqNo()
+		if err != nil {
+			return s, err
+		}
+		s.NewSeqNo = v
+	}
+	if msg.HasGapFillFlag() {
+		v, err := msg.GetGapFillFlag()
+		if err != nil {
+			return s, err
+		}
+		s.GapFillFlag = &v
+	}
+	return s, nil
+}
+
+//Unmarshal builds a SequenceReset from s
+func Unmarshal(s Struct) SequenceReset {
+	m := New(field.NewNewSeqNo(s.NewSeqNo))
+	if s.GapFillFlag != nil {
+		m.SetGapFillFlag(*s.GapFillFlag)
+	}
+	return m
+}
@@ -0,0 +1,27 @@
+package sequencereset
+
+import (
+	"time"
+
+	"github.com/terracefi/field"
+)
+
+//NewGapFill returns a SequenceReset in Gap Fill mode that takes the place of the messages from msgSeqNum up to, but
+//not including, newSeqNo when answering a ResendRequest. It carries MsgSeqNum = msgSeqNum, GapFillFlag = Y,
+//PossDupFlag = Y and an OrigSendingTime of now.
+func NewGapFill(msgSeqNum, newSeqNo int) SequenceReset {
+	m := New(field.NewNewSeqNo(newSeqNo))
+	m.SetGapFillFlag(true)
+	m.Header.SetMsgSeqNum(msgSeqNum)
+	m.Header.SetPossDupFlag(true)
+	m.Header.SetOrigSendingTime(time.Now().UTC())
+	return m
+}
+
+//NewReset returns a SequenceReset in Reset mode, which sets the next expected MsgSeqNum of the counterparty to
+//newSeqNo regardless of the MsgSeqNum it is sent with
+func NewReset(newSeqNo int) SequenceReset {
+	m := New(field.NewNewSeqNo(newSeqNo))
+	m.SetGapFillFlag(false)
+	return m
+}
@@ -0,0 +1,39 @@
+package sequencereset
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewGapFill(t *testing.T) {
+	before := time.Now().UTC().Truncate(time.Millisecond)
+	m := NewGapFill(5, 9)
+	if v, err := m.GetNewSeqNo(); err != nil || v != 9 {
+		t.Errorf("NewSeqNo = %v, %v, want 9", v, err)
+	}
+	if v, err := m.GetGapFillFlag(); err != nil || !v {
+		t.Errorf("GapFillFlag = %v, %v, want true", v, err)
+	}
+	if v, err := m.Header.GetMsgSeqNum(); err != nil || v != 5 {
+		t.Errorf("MsgSeqNum = %v, %v, want 5", v, err)
+	}
+	if v, err := m.Header.GetPossDupFlag(); err != nil || !v {
+		t.Errorf("PossDupFlag = %v, %v, want true", v, err)
+	}
+	if v, err := m.Header.GetOrigSendingTime(); err != nil || v.Before(before) || v.After(time.Now()) {
+		t.Errorf("OrigSendingTime = %v, %v, want now", v, err)
+	}
+}
+
+func TestNewReset(t *testing.T) {
+	m := NewReset(9)
+	if v, err := m.GetNewSeqNo(); err != nil || v != 9 {
+		t.Errorf("NewSeqNo = %v, %v, want 9", v, err)
+	}
+	if v, err := m.GetGapFillFlag(); err != nil || v {
+		t.Errorf("GapFillFlag = %v, %v, want false", v, err)
+	}
+	if m.Header.HasMsgSeqNum() || m.Header.HasPossDupFlag() {
+		t.Error("NewReset() sets MsgSeqNum or PossDupFlag")
+	}
+}
@@ -0,0 +1,17 @@
+/*
+Package sessionstatus follows the trading session phase and the trading status of instruments from
+TradingSessionStatus and SecurityStatus messages.
+
+A Tracker subscribes with TradingSessionStatusRequest and SecurityStatusRequest messages and keeps the TradSesStatus
+of each TradingSessionID / TradingSessionSubID, and the SecurityTradingStatus of each instrument reduced to a Phase:
+pre-open, open, halted or closed. Statuses that do not change whether an instrument trades, such as price
+indications or imbalances, leave its Phase as it was. The instruments themselves, and the SecurityTradingStatus of
+their last SecurityStatus, are kept by the secmaster.Master given to New, so an instrument has the same identity in
+both packages.
+
+Order entry code asks Tradable whether an order can be sent for a symbol: the instrument must not be pre-open, halted or
+closed, and one of the trading sessions it trades in must be open. A symbol the Master does not know is not tradable,
+but an instrument without a status, or a session before its first status, does not prevent trading. The OnSessionHalt
+and OnInstrumentHalt callbacks are called when a session or an instrument is halted.
+*/
+package sessionstatus
@@ -0,0 +1,54 @@
+package sessionstatus
+
+import (
+	"fmt"
+
+	"github.com/terracefi/enum"
+)
+
+//UnknownRequestError is returned for a request ID that is not a live subscription of the Tracker
+type UnknownRequestError struct {
+	ReqID string
+}
+
+func (e *UnknownRequestError) Error() string {
+	return fmt.Sprintf("sessionstatus: unknown request %v", e.ReqID)
+}
+
+//UnknownInstrumentError is returned by Tracker.Tradable for a symbol the Master of the Tracker does not know
+type UnknownInstrumentError struct {
+	Symbol string
+}
+
+func (e *UnknownInstrumentError) Error() string {
+	return fmt.Sprintf("sessionstatus: unknown instrument %v", e.Symbol)
+}
+
+//RequestRejectedError is returned for a TradingSessionStatus that rejects a TradingSessionStatusRequest
+type RequestRejectedError struct {
+	TradSesReqID string
+	RejReason    *enum.TradSesStatusRejReason
+	Text         string
+}
+
+func (e *RequestRejectedError) Error() string {
+	msg := fmt.Sprintf("sessionstatus: request %v rejected", e.TradSesReqID)
+	if e.RejReason != nil {
+		msg += fmt.Sprintf(", TradSesStatusRejReason %v", *e.RejReason)
+	}
+	if e.Text != "" {
+		msg += ": " + e.Text
+	}
+	return msg
+}
+
+//NotTradableError is returned by Tracker.Tradable for a symbol an order cannot be sent for
+type NotTradableError struct {
+	Symbol string
+	//Reason tells why, for example "instrument Halted" or "session 1 is closed"
+	Reason string
+}
+
+func (e *NotTradableError) Error() string {
+	return fmt.Sprintf("sessionstatus: %v not tradable: %v", e.Symbol, e.Reason)
+}
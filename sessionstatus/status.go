@@ -0,0 +1,145 @@
+package sessionstatus
+
+import (
+	"time"
+
+	"github.com/terracefi/enum"
+	"github.com/terracefi/fix44/internal/fixutil"
+	"github.com/terracefi/fix44/secmaster"
+)
+
+//SubscriptionRequestType values, FIX 4.4
+const (
+	RequestSnapshot           = fixutil.SubscriptionSnapshot
+	RequestSnapshotAndUpdates = fixutil.SubscriptionSnapshotAndUpdates
+	RequestDisable            = fixutil.SubscriptionDisable
+)
+
+//TradSesStatus values, FIX 4.4
+const (
+	SessionUnknown         enum.TradSesStatus = "0"
+	SessionHalted          enum.TradSesStatus = "1"
+	SessionOpen            enum.TradSesStatus = "2"
+	SessionClosed          enum.TradSesStatus = "3"
+	SessionPreOpen         enum.TradSesStatus = "4"
+	SessionPreClose        enum.TradSesStatus = "5"
+	SessionRequestRejected enum.TradSesStatus = "6"
+)
+
+//SecurityTradingStatus values, FIX 4.4
+const (
+	TradingOpeningDelay  enum.SecurityTradingStatus = "1"
+	TradingHalt          enum.SecurityTradingStatus = "2"
+	TradingResume        enum.SecurityTradingStatus = "3"
+	TradingNoOpen        enum.SecurityTradingStatus = "4"
+	TradingITSPreOpening enum.SecurityTradingStatus = "14"
+	TradingReadyToTrade  enum.SecurityTradingStatus = "17"
+	//TradingNotAvailable is sent when the instrument stops trading at the end of the session, it closes the
+	//instrument rather than halting it
+	TradingNotAvailable          enum.SecurityTradingStatus = "18"
+	TradingNotTradedOnThisMarket enum.SecurityTradingStatus = "19"
+	TradingPreOpen               enum.SecurityTradingStatus = "21"
+	TradingOpeningRotation       enum.SecurityTradingStatus = "22"
+)
+
+//Phase is the trading phase of an instrument
+type Phase int
+
+//Phase values
+const (
+	//Unknown is the phase of an instrument whose statuses have not told whether it trades
+	Unknown Phase = iota
+	PreOpen
+	Open
+	Halted
+	Closed
+)
+
+func (p Phase) String() string {
+	switch p {
+	case PreOpen:
+		return "PreOpen"
+	case Open:
+		return "Open"
+	case Halted:
+		return "Halted"
+	case Closed:
+		return "Closed"
+	}
+	return "Unknown"
+}
+
+//phase returns the Phase a SecurityTradingStatus puts an instrument in, ok is false for statuses that leave the
+//phase as it was
+func phase(s enum.SecurityTradingStatus) (p Phase, ok bool) {
+	switch s {
+	case TradingOpeningDelay, TradingITSPreOpening, TradingPreOpen, TradingOpeningRotation:
+		return PreOpen, true
+	case TradingResume, TradingReadyToTrade:
+		return Open, true
+	case TradingHalt:
+		return Halted, true
+	case TradingNoOpen, TradingNotAvailable, TradingNotTradedOnThisMarket:
+		return Closed, true
+	}
+	return Unknown, false
+}
+
+//SessionKey identifies a trading session by its TradingSessionID and TradingSessionSubID
+type SessionKey struct {
+	ID    enum.TradingSessionID
+	SubID enum.TradingSessionSubID
+}
+
+//Session is the status of a trading session
+type Session struct {
+	SessionKey
+	Status enum.TradSesStatus
+	Method *enum.TradSesMethod
+	Mode   *enum.TradSesMode
+
+	StartTime    *time.Time
+	OpenTime     *time.Time
+	PreCloseTime *time.Time
+	CloseTime    *time.Time
+	EndTime      *time.Time
+	Text         string
+}
+
+//Open returns true if the session is open, orders may also be sent during the pre-close
+func (s Session) Open() bool {
+	return s.Status == SessionOpen || s.Status == SessionPreClose
+}
+
+//Instrument is the trading status of an instrument. The embedded secmaster.Instrument is the instrument as kept by
+//the Master of the Tracker, with the TradingStatus, HaltReason and StatusTime of its last SecurityStatus.
+type Instrument struct {
+	secmaster.Instrument
+	//TradingSessionID is the session of the last status, nil if it did not give one
+	TradingSessionID *enum.TradingSessionID
+	Phase            Phase
+	Text             string
+}
+
+//instrumentStatus is what a Tracker keeps for an instrument on top of the secmaster.Instrument
+type instrumentStatus struct {
+	tradingSessionID *enum.TradingSessionID
+	phase            Phase
+	text             string
+}
+
+func sessionStatusName(s enum.TradSesStatus) string {
+	switch s {
+	case SessionHalted:
+		return "halted"
+	case SessionOpen:
+		return "open"
+	case SessionClosed:
+		return "closed"
+	case SessionPreOpen:
+		return "pre-open"
+	case SessionPreClose:
+		return "pre-close"
+	}
+	return "in unknown state"
+}
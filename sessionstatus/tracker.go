@@ -0,0 +1,324 @@
+package sessionstatus
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/terracefi/enum"
+	"github.com/terracefi/fix44/internal/fixutil"
+	"github.com/terracefi/fix44/secmaster"
+	"github.com/terracefi/fix44/securitystatus"
+	"github.com/terracefi/fix44/securitystatusrequest"
+	"github.com/terracefi/fix44/tradingsessionstatus"
+	"github.com/terracefi/fix44/tradingsessionstatusrequest"
+	"github.com/terracefi/quickfix"
+)
+
+//request is a subscription of the Tracker, with either a session or an instrument request
+type request struct {
+	session    *tradingsessionstatusrequest.Struct
+	instrument *securitystatusrequest.Struct
+}
+
+//message returns the request with the given SubscriptionRequestType
+func (r request) message(t enum.SubscriptionRequestType) quickfix.Messagable {
+	if r.session != nil {
+		s := *r.session
+		s.SubscriptionRequestType = t
+		return tradingsessionstatusrequest.Unmarshal(s)
+	}
+	s := *r.instrument
+	s.SubscriptionRequestType = t
+	return securitystatusrequest.Unmarshal(s)
+}
+
+//Tracker subscribes to trading session and instrument statuses and keeps the current ones. The instruments and their
+//trading status are kept by a secmaster.Master, the Tracker adds their Phase. It is safe for concurrent use.
+type Tracker struct {
+	//SendMessage sends the status requests, by default on the session given to New
+	SendMessage func(msg quickfix.Messagable) error
+	//NewReqID returns the TradSesReqID or SecurityStatusReqID of a subscription, it defaults to a sequence number
+	//prefixed with the time New was called
+	NewReqID func() string
+
+	//OnSessionChange and OnInstrumentChange, if not nil, are called with every status received
+	OnSessionChange    func(s Session)
+	OnInstrumentChange func(in Instrument)
+	//OnSessionHalt and OnInstrumentHalt, if not nil, are called when a session or an instrument that was not halted
+	//is halted
+	OnSessionHalt    func(s Session)
+	OnInstrumentHalt func(in Instrument)
+
+	master      *secmaster.Master
+	mu          sync.Mutex
+	sessions    map[SessionKey]*Session
+	instruments map[secmaster.Key]*instrumentStatus
+	requests    map[string]request
+}
+
+//New returns a Tracker without statuses that sends on sessionID. The SecurityStatus messages given to the Tracker
+//are applied to master, usually the Master that keeps the reference data of the application.
+func New(sessionID quickfix.SessionID, master *secmaster.Master) *Tracker {
+	return &Tracker{
+		SendMessage: fixutil.SendOn(sessionID),
+		NewReqID:    fixutil.NewIDs(),
+		master:      master,
+		sessions:    make(map[SessionKey]*Session),
+		instruments: make(map[secmaster.Key]*instrumentStatus),
+		requests:    make(map[string]request),
+	}
+}
+
+//SubscribeSessions sends a TradingSessionStatusRequest for the status of tradingSessionID and its updates, or of
+//every trading session if tradingSessionID is nil. It returns the TradSesReqID of the subscription.
+func (t *Tracker) SubscribeSessions(tradingSessionID *enum.TradingSessionID) (string, error) {
+	s := &tradingsessionstatusrequest.Struct{
+		TradSesReqID:     t.NewReqID(),
+		TradingSessionID: tradingSessionID,
+	}
+	if err := t.subscribe(s.TradSesReqID, request{session: s}); err != nil {
+		return "", err
+	}
+	return s.TradSesReqID, nil
+}
+
+//SubscribeInstrument sends a SecurityStatusRequest for the trading status of symbol and its updates. It returns the
+//SecurityStatusReqID of the subscription.
+func (t *Tracker) SubscribeInstrument(symbol string) (string, error) {
+	s := &securitystatusrequest.Struct{
+		SecurityStatusReqID: t.NewReqID(),
+		Symbol:              &symbol,
+	}
+	if err := t.subscribe(s.SecurityStatusReqID, request{instrument: s}); err != nil {
+		return "", err
+	}
+	return s.SecurityStatusReqID, nil
+}
+
+//subscribe records the subscription and sends its request, the subscription is dropped if the request cannot be sent
+func (t *Tracker) subscribe(id string, r request) error {
+	t.mu.Lock()
+	t.requests[id] = r
+	t.mu.Unlock()
+
+	if err := t.SendMessage(r.message(RequestSnapshotAndUpdates)); err != nil {
+		t.mu.Lock()
+		delete(t.requests, id)
+		t.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+//Unsubscribe ends the subscription with the given request ID and sends the request that disables it, the statuses
+//received are kept
+func (t *Tracker) Unsubscribe(reqID string) error {
+	t.mu.Lock()
+	r, ok := t.requests[reqID]
+	delete(t.requests, reqID)
+	t.mu.Unlock()
+
+	if !ok {
+		return &UnknownRequestError{reqID}
+	}
+	return t.SendMessage(r.message(RequestDisable))
+}
+
+//OnLogon sends the request of every subscription again, a request that cannot be sent does not stop the others and
+//the errors are joined. It should be called when the session logs on.
+func (t *Tracker) OnLogon() error {
+	t.mu.Lock()
+	var msgs []quickfix.Messagable
+	for _, r := range t.requests {
+		msgs = append(msgs, r.message(RequestSnapshotAndUpdates))
+	}
+	t.mu.Unlock()
+
+	var errs []error
+	for _, msg := range msgs {
+		if err := t.SendMessage(msg); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+//Session returns the status of a trading session
+func (t *Tracker) Session(k SessionKey) (Session, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	s, ok := t.sessions[k]
+	if !ok {
+		return Session{}, false
+	}
+	return *s, true
+}
+
+//Sessions returns the status of every trading session, ordered by TradingSessionID and TradingSessionSubID
+func (t *Tracker) Sessions() []Session {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.sorted(func(*Session) bool { return true })
+}
+
+func (t *Tracker) sorted(keep func(s *Session) bool) []Session {
+	var out []Session
+	for _, s := range t.sessions {
+		if keep(s) {
+			out = append(out, *s)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].ID != out[j].ID {
+			return out[i].ID < out[j].ID
+		}
+		return out[i].SubID < out[j].SubID
+	})
+	return out
+}
+
+//Instrument returns the trading status of the instrument with the given symbol
+func (t *Tracker) Instrument(symbol string) (Instrument, bool) {
+	ins := t.master.BySymbol(symbol)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.instrument(ins)
+}
+
+//instrument returns the status of the first of ins the Tracker has received a status for
+func (t *Tracker) instrument(ins []secmaster.Instrument) (Instrument, bool) {
+	for _, in := range ins {
+		if st, ok := t.instruments[in.Key()]; ok {
+			return Instrument{in, st.tradingSessionID, st.phase, st.text}, true
+		}
+	}
+	return Instrument{}, false
+}
+
+//Tradable returns a *NotTradableError if an order cannot be sent for symbol now: the instrument is pre-open, halted
+//or closed, or none of the trading sessions it trades in is open. The sessions of an instrument are those with the
+//TradingSessionID of its last status, or all sessions if the status gave none. An *UnknownInstrumentError is
+//returned if the Master of the Tracker does not know symbol.
+func (t *Tracker) Tradable(symbol string) error {
+	ins := t.master.BySymbol(symbol)
+	if len(ins) == 0 {
+		return &UnknownInstrumentError{symbol}
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	in, ok := t.instrument(ins)
+	if ok {
+		switch in.Phase {
+		case PreOpen, Halted, Closed:
+			return &NotTradableError{symbol, "instrument " + in.Phase.String()}
+		}
+	}
+
+	sessions := t.sorted(func(s *Session) bool {
+		return !ok || in.TradingSessionID == nil || s.ID == *in.TradingSessionID
+	})
+	if len(sessions) == 0 {
+		return nil
+	}
+	for _, s := range sessions {
+		if s.Open() {
+			return nil
+		}
+	}
+	s := sessions[0]
+	return &NotTradableError{symbol, fmt.Sprintf("session %v is %v", s.ID, sessionStatusName(s.Status))}
+}
+
+//OnTradingSessionStatus records the status of a trading session. A TradingSessionStatus that rejects a request of
+//the Tracker ends the subscription and returns a *RequestRejectedError.
+func (t *Tracker) OnTradingSessionStatus(msg tradingsessionstatus.TradingSessionStatus) (Session, error) {
+	m, err := tradingsessionstatus.Marshal(msg)
+	if err != nil {
+		return Session{}, err
+	}
+
+	if m.TradSesStatus == SessionRequestRejected {
+		reqID := fixutil.Deref(m.TradSesReqID)
+		t.mu.Lock()
+		delete(t.requests, reqID)
+		t.mu.Unlock()
+		return Session{}, &RequestRejectedError{reqID, m.TradSesStatusRejReason, fixutil.Deref(m.Text)}
+	}
+
+	s := Session{
+		SessionKey:   SessionKey{ID: m.TradingSessionID},
+		Status:       m.TradSesStatus,
+		Method:       m.TradSesMethod,
+		Mode:         m.TradSesMode,
+		StartTime:    m.TradSesStartTime,
+		OpenTime:     m.TradSesOpenTime,
+		PreCloseTime: m.TradSesPreCloseTime,
+		CloseTime:    m.TradSesCloseTime,
+		EndTime:      m.TradSesEndTime,
+		Text:         fixutil.Deref(m.Text),
+	}
+	if m.TradingSessionSubID != nil {
+		s.SubID = *m.TradingSessionSubID
+	}
+
+	t.mu.Lock()
+	old, ok := t.sessions[s.SessionKey]
+	halted := s.Status == SessionHalted && (!ok || old.Status != SessionHalted)
+	t.sessions[s.SessionKey] = &s
+	onChange, onHalt := t.OnSessionChange, t.OnSessionHalt
+	t.mu.Unlock()
+
+	if onChange != nil {
+		onChange(s)
+	}
+	if halted && onHalt != nil {
+		onHalt(s)
+	}
+	return s, nil
+}
+
+//OnSecurityStatus records the trading status of an instrument on the Master of the Tracker, see
+//secmaster.Master.OnSecurityStatus, and updates its Phase
+func (t *Tracker) OnSecurityStatus(msg securitystatus.SecurityStatus) (Instrument, error) {
+	m, err := securitystatus.Marshal(msg)
+	if err != nil {
+		return Instrument{}, err
+	}
+	in, merr := t.master.OnSecurityStatus(msg)
+	if merr != nil {
+		return Instrument{}, merr
+	}
+
+	t.mu.Lock()
+	st, ok := t.instruments[in.Key()]
+	if !ok {
+		st = &instrumentStatus{}
+		t.instruments[in.Key()] = st
+	}
+	wasHalted := st.phase == Halted
+
+	st.tradingSessionID, st.text = m.TradingSessionID, fixutil.Deref(m.Text)
+	if m.SecurityTradingStatus != nil {
+		if p, ok := phase(*m.SecurityTradingStatus); ok {
+			st.phase = p
+		}
+	}
+
+	c := Instrument{in, st.tradingSessionID, st.phase, st.text}
+	onChange, onHalt := t.OnInstrumentChange, t.OnInstrumentHalt
+	t.mu.Unlock()
+
+	if onChange != nil {
+		onChange(c)
+	}
+	if c.Phase == Halted && !wasHalted && onHalt != nil {
+		onHalt(c)
+	}
+	return c, nil
+}
@@ -0,0 +1,413 @@
+package sessionstatus
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/terracefi/enum"
+	"github.com/terracefi/fix44/internal/fixutil"
+	"github.com/terracefi/fix44/internal/testutil"
+	"github.com/terracefi/fix44/secmaster"
+	"github.com/terracefi/fix44/securitydefinition"
+	"github.com/terracefi/fix44/securitystatus"
+	"github.com/terracefi/fix44/securitystatusrequest"
+	"github.com/terracefi/fix44/tradingsessionstatus"
+	"github.com/terracefi/fix44/tradingsessionstatusrequest"
+	"github.com/terracefi/quickfix"
+)
+
+//requests returns the request ID and SubscriptionRequestType of each request sent, as in "T1:1"
+func requests(t *testing.T, s *testutil.Sender) []string {
+	var d []string
+	for _, msg := range s.Sent {
+		switch msg := msg.(type) {
+		case tradingsessionstatusrequest.TradingSessionStatusRequest:
+			r, err := tradingsessionstatusrequest.Marshal(msg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			d = append(d, fmt.Sprintf("%v:%v", r.TradSesReqID, r.SubscriptionRequestType))
+		case securitystatusrequest.SecurityStatusRequest:
+			r, err := securitystatusrequest.Marshal(msg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			d = append(d, fmt.Sprintf("%v:%v", r.SecurityStatusReqID, r.SubscriptionRequestType))
+		default:
+			t.Fatalf("unexpected message %T", msg)
+		}
+	}
+	return d
+}
+
+//newTracker returns a Tracker on a new Master whose request IDs are T1, T2, ...
+func newTracker(failAt int) (*Tracker, *testutil.Sender) {
+	t := New(quickfix.SessionID{}, secmaster.New(quickfix.SessionID{}))
+	s := &testutil.Sender{FailAt: failAt}
+	t.SendMessage = s.Send
+	t.NewReqID = testutil.IDs("T")
+	return t, s
+}
+
+type step func(t *Tracker) error
+
+func subscribeSessions(t *Tracker) error {
+	_, err := t.SubscribeSessions(nil)
+	return err
+}
+
+func subscribeInstrument(symbol string) step {
+	return func(t *Tracker) error {
+		_, err := t.SubscribeInstrument(symbol)
+		return err
+	}
+}
+
+func unsubscribe(reqID string) step {
+	return func(t *Tracker) error { return t.Unsubscribe(reqID) }
+}
+
+func logon(t *Tracker) error { return t.OnLogon() }
+
+//known gives X a status that does not change its Phase, so that the Master knows it
+var known = securityStatus("X", "5", "")
+
+//unknown is the reason of TestTracker for an *UnknownInstrumentError
+const unknown = "unknown"
+
+func sessionStatus(id enum.TradingSessionID, status enum.TradSesStatus) step {
+	return func(t *Tracker) error {
+		_, err := t.OnTradingSessionStatus(tradingsessionstatus.Unmarshal(tradingsessionstatus.Struct{
+			TradingSessionID: id,
+			TradSesStatus:    status,
+		}))
+		return err
+	}
+}
+
+func sessionReject(reqID string) step {
+	return func(t *Tracker) error {
+		_, err := t.OnTradingSessionStatus(tradingsessionstatus.Unmarshal(tradingsessionstatus.Struct{
+			TradSesReqID:     &reqID,
+			TradingSessionID: "1",
+			TradSesStatus:    SessionRequestRejected,
+			Text:             fixutil.Ptr("not entitled"),
+		}))
+		return err
+	}
+}
+
+//securityStatus gives the status of symbol, in the session tradingSessionID unless it is empty
+func securityStatus(symbol string, status enum.SecurityTradingStatus, tradingSessionID enum.TradingSessionID) step {
+	return func(t *Tracker) error {
+		s := securitystatus.Struct{Symbol: &symbol, SecurityTradingStatus: &status}
+		if tradingSessionID != "" {
+			s.TradingSessionID = &tradingSessionID
+		}
+		_, err := t.OnSecurityStatus(securitystatus.Unmarshal(s))
+		return err
+	}
+}
+
+func TestPhase(t *testing.T) {
+	tests := []struct {
+		status enum.SecurityTradingStatus
+		phase  Phase
+		ok     bool
+	}{
+		{TradingOpeningDelay, PreOpen, true},
+		{TradingHalt, Halted, true},
+		{TradingResume, Open, true},
+		{TradingNoOpen, Closed, true},
+		{"5", Unknown, false},
+		{"12", Unknown, false},
+		{TradingITSPreOpening, PreOpen, true},
+		{TradingReadyToTrade, Open, true},
+		{TradingNotAvailable, Closed, true},
+		{TradingNotTradedOnThisMarket, Closed, true},
+		{TradingPreOpen, PreOpen, true},
+		{TradingOpeningRotation, PreOpen, true},
+	}
+	for _, tt := range tests {
+		if p, ok := phase(tt.status); p != tt.phase || ok != tt.ok {
+			t.Errorf("phase(%v) = %v, %v, want %v, %v", tt.status, p, ok, tt.phase, tt.ok)
+		}
+	}
+}
+
+func TestTracker(t *testing.T) {
+	tests := []struct {
+		name    string
+		failAt  int
+		steps   []step
+		wantErr error
+		sent    []string
+		//phase is the Phase of X, reason why it is not tradable, unknown for an *UnknownInstrumentError
+		phase  Phase
+		reason string
+		halts  []string
+	}{
+		{
+			name:   "no status",
+			reason: unknown,
+		},
+		{
+			name:  "session open",
+			steps: []step{known, sessionStatus("1", SessionOpen)},
+		},
+		{
+			name:  "session pre-close",
+			steps: []step{known, sessionStatus("1", SessionPreClose)},
+		},
+		{
+			name:   "session closed",
+			steps:  []step{known, sessionStatus("1", SessionClosed)},
+			reason: "session 1 is closed",
+		},
+		{
+			name:   "session halted",
+			steps:  []step{known, sessionStatus("1", SessionOpen), sessionStatus("1", SessionHalted)},
+			reason: "session 1 is halted",
+			halts:  []string{"session 1"},
+		},
+		{
+			name:   "session halted twice",
+			steps:  []step{known, sessionStatus("1", SessionHalted), sessionStatus("1", SessionHalted)},
+			reason: "session 1 is halted",
+			halts:  []string{"session 1"},
+		},
+		{
+			name:  "one session open",
+			steps: []step{known, sessionStatus("1", SessionClosed), sessionStatus("2", SessionOpen)},
+		},
+		{
+			name:  "instrument open",
+			steps: []step{securityStatus("X", TradingReadyToTrade, "")},
+			phase: Open,
+		},
+		{
+			name:   "instrument pre-open",
+			steps:  []step{securityStatus("X", TradingPreOpen, "")},
+			phase:  PreOpen,
+			reason: "instrument PreOpen",
+		},
+		{
+			name:   "instrument halted",
+			steps:  []step{securityStatus("X", TradingReadyToTrade, ""), securityStatus("X", TradingHalt, "")},
+			phase:  Halted,
+			reason: "instrument Halted",
+			halts:  []string{"X"},
+		},
+		{
+			name:   "instrument halted twice",
+			steps:  []step{securityStatus("X", TradingHalt, ""), securityStatus("X", TradingHalt, "")},
+			phase:  Halted,
+			reason: "instrument Halted",
+			halts:  []string{"X"},
+		},
+		{
+			name: "instrument resumed",
+			steps: []step{securityStatus("X", TradingHalt, ""), securityStatus("X", TradingResume, ""),
+				securityStatus("X", TradingHalt, "")},
+			phase:  Halted,
+			reason: "instrument Halted",
+			halts:  []string{"X", "X"},
+		},
+		{
+			name: "instrument not available",
+			steps: []step{securityStatus("X", TradingReadyToTrade, ""),
+				securityStatus("X", TradingNotAvailable, "")},
+			phase:  Closed,
+			reason: "instrument Closed",
+		},
+		{
+			name:   "price indication keeps the phase",
+			steps:  []step{securityStatus("X", TradingHalt, ""), securityStatus("X", "5", "")},
+			phase:  Halted,
+			reason: "instrument Halted",
+			halts:  []string{"X"},
+		},
+		{
+			name:  "price indication first",
+			steps: []step{known},
+		},
+		{
+			name:   "other instrument halted",
+			steps:  []step{securityStatus("Y", TradingHalt, "")},
+			reason: unknown,
+			halts:  []string{"Y"},
+		},
+		{
+			name: "session of the instrument closed",
+			steps: []step{sessionStatus("1", SessionClosed), sessionStatus("2", SessionOpen),
+				securityStatus("X", TradingReadyToTrade, "1")},
+			phase:  Open,
+			reason: "session 1 is closed",
+		},
+		{
+			name: "session of the instrument open",
+			steps: []step{sessionStatus("1", SessionClosed), sessionStatus("2", SessionOpen),
+				securityStatus("X", TradingReadyToTrade, "2")},
+			phase: Open,
+		},
+		{
+			name: "instrument without a session",
+			steps: []step{sessionStatus("1", SessionClosed), sessionStatus("2", SessionOpen),
+				securityStatus("X", TradingReadyToTrade, "")},
+			phase: Open,
+		},
+		{
+			name:  "instrument in an unknown session",
+			steps: []step{sessionStatus("1", SessionClosed), securityStatus("X", TradingReadyToTrade, "2")},
+			phase: Open,
+		},
+		{
+			name:   "subscriptions",
+			steps:  []step{subscribeSessions, subscribeInstrument("X")},
+			sent:   []string{"T1:1", "T2:1"},
+			reason: unknown,
+		},
+		{
+			name:   "unsubscribe",
+			steps:  []step{subscribeSessions, subscribeInstrument("X"), unsubscribe("T2"), logon},
+			sent:   []string{"T1:1", "T2:1", "T2:2", "T1:1"},
+			reason: unknown,
+		},
+		{
+			name:    "unsubscribe twice",
+			steps:   []step{subscribeSessions, unsubscribe("T1"), unsubscribe("T1")},
+			wantErr: &UnknownRequestError{},
+			sent:    []string{"T1:1", "T1:2"},
+			reason:  unknown,
+		},
+		{
+			name:    "unsubscribe an unknown request",
+			steps:   []step{unsubscribe("T1")},
+			wantErr: &UnknownRequestError{},
+			reason:  unknown,
+		},
+		{
+			name:    "request rejected",
+			steps:   []step{subscribeSessions, sessionReject("T1")},
+			wantErr: &RequestRejectedError{},
+			sent:    []string{"T1:1"},
+			reason:  unknown,
+		},
+		{
+			name:    "subscription not sent",
+			failAt:  1,
+			steps:   []step{subscribeInstrument("X")},
+			wantErr: testutil.ErrSend,
+			sent:    []string{"T1:1"},
+			reason:  unknown,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tr, s := newTracker(tt.failAt)
+			var halts []string
+			tr.OnSessionHalt = func(s Session) { halts = append(halts, fmt.Sprintf("session %v", s.ID)) }
+			tr.OnInstrumentHalt = func(in Instrument) { halts = append(halts, in.Symbol) }
+
+			var err error
+			for i, st := range tt.steps {
+				err = st(tr)
+				if i < len(tt.steps)-1 && err != nil {
+					t.Fatalf("step %v: %v", i, err)
+				}
+			}
+			if reflect.TypeOf(err) != reflect.TypeOf(tt.wantErr) {
+				t.Fatalf("error = %v, want %T", err, tt.wantErr)
+			}
+			if got := requests(t, s); !reflect.DeepEqual(got, tt.sent) {
+				t.Errorf("sent = %v, want %v", got, tt.sent)
+			}
+			if in, _ := tr.Instrument("X"); in.Phase != tt.phase {
+				t.Errorf("Phase = %v, want %v", in.Phase, tt.phase)
+			}
+			var reason string
+			switch err := tr.Tradable("X").(type) {
+			case *NotTradableError:
+				reason = err.Reason
+			case *UnknownInstrumentError:
+				reason = unknown
+			}
+			if reason != tt.reason {
+				t.Errorf("Tradable() reason = %q, want %q", reason, tt.reason)
+			}
+			if !reflect.DeepEqual(halts, tt.halts) {
+				t.Errorf("halts = %v, want %v", halts, tt.halts)
+			}
+		})
+	}
+}
+
+func TestTrackerOnLogon(t *testing.T) {
+	tr, s := newTracker(3)
+	for i, st := range []step{subscribeSessions, subscribeInstrument("X")} {
+		if err := st(tr); err != nil {
+			t.Fatalf("step %v: %v", i, err)
+		}
+	}
+
+	if err := tr.OnLogon(); !errors.Is(err, testutil.ErrSend) {
+		t.Errorf("OnLogon() = %v, want %v", err, testutil.ErrSend)
+	}
+	got := requests(t, s)[2:]
+	sort.Strings(got)
+	if want := []string{"T1:1", "T2:1"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("sent on logon = %v, want %v, the request after the failed one must be sent", got, want)
+	}
+}
+
+func TestTrackerRequestRejected(t *testing.T) {
+	tr, _ := newTracker(0)
+	if _, err := tr.SubscribeSessions(fixutil.Ptr[enum.TradingSessionID]("1")); err != nil {
+		t.Fatal(err)
+	}
+	_, err := tr.OnTradingSessionStatus(tradingsessionstatus.Unmarshal(tradingsessionstatus.Struct{
+		TradSesReqID:           fixutil.Ptr("T1"),
+		TradingSessionID:       "1",
+		TradSesStatus:          SessionRequestRejected,
+		TradSesStatusRejReason: fixutil.Ptr[enum.TradSesStatusRejReason]("1"),
+		Text:                   fixutil.Ptr("unknown session"),
+	}))
+	want := &RequestRejectedError{"T1", fixutil.Ptr[enum.TradSesStatusRejReason]("1"), "unknown session"}
+	if !reflect.DeepEqual(err, want) {
+		t.Errorf("error = %v, want %v", err, want)
+	}
+	if _, ok := tr.Session(SessionKey{ID: "1"}); ok {
+		t.Error("Session() found the session of a rejected request")
+	}
+	if err := tr.Unsubscribe("T1"); reflect.TypeOf(err) != reflect.TypeOf(&UnknownRequestError{}) {
+		t.Errorf("Unsubscribe() of the rejected request = %v, want *UnknownRequestError", err)
+	}
+}
+
+func TestTrackerSharesMaster(t *testing.T) {
+	master := secmaster.New(quickfix.SessionID{})
+	master.SendMessage = func(quickfix.Messagable) error { return nil }
+	tr := New(quickfix.SessionID{}, master)
+	_, err := master.OnSecurityDefinition(securitydefinition.Unmarshal(securitydefinition.Struct{SecurityReqID: "D1",
+		SecurityResponseID: "1", SecurityResponseType: "1", Symbol: fixutil.Ptr("X"),
+		SecurityID:       fixutil.Ptr("GB0000000001"),
+		SecurityIDSource: fixutil.Ptr[enum.SecurityIDSource]("4")}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := securityStatus("X", TradingHalt, "")(tr); err != nil {
+		t.Fatal(err)
+	}
+
+	in, ok := tr.Instrument("X")
+	if !ok || in.SecurityID != "GB0000000001" || in.Phase != Halted {
+		t.Errorf("Instrument() = %v, %v, %v, want GB0000000001, %v", in.SecurityID, in.Phase, ok, Halted)
+	}
+	ins := master.BySymbol("X")
+	if len(ins) != 1 || ins[0].TradingStatus == nil || *ins[0].TradingStatus != TradingHalt {
+		t.Errorf("Master.BySymbol() = %+v, want one instrument with TradingStatus %v", ins, TradingHalt)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/terracefi/enum"
 	"github.com/terracefi/field"
 	"github.com/terracefi/fix44"
+	"github.com/terracefi/fix44/components"
 	"github.com/terracefi/quickfix"
 	"github.com/terracefi/tag"
 )
@@ -59,6 +60,26 @@ func Route(router RouteOut) (string, string, quickfix.MessageRoute) {
 	return "FIX.4.4", "T", r
 }
 
+//Validate checks m against the FIX 4.4 definition of SettlementInstructions, see fix44.MessageDef.Validate
+func (m SettlementInstructions) Validate() fix44.ValidationErrors {
+	return fix44.MessageDefs["T"].Validate(&m.Body.FieldMap)
+}
+
+//MarshalJSON encodes the SettlementInstructions as JSON with fields keyed by name, see fix44.MarshalMessageJSON
+func (m SettlementInstructions) MarshalJSON() ([]byte, error) {
+	return fix44.MarshalMessageJSON(m.Message)
+}
+
+//UnmarshalJSON builds the SettlementInstructions from JSON produced by MarshalJSON
+func (m *SettlementInstructions) UnmarshalJSON(b []byte) error {
+	msg, err := fix44.UnmarshalMessageJSON(b, "T")
+	if err != nil {
+		return err
+	}
+	*m = FromMessage(msg)
+	return nil
+}
+
 //SetClOrdID sets ClOrdID, Tag 11
 func (m SettlementInstructions) SetClOrdID(v string) {
 	m.Set(field.NewClOrdID(v))
@@ -227,6 +248,21 @@ func (m SettlementInstructions) HasEncodedText() bool {
 	return m.Has(tag.EncodedText)
 }
 
+//SetEncodedTextBytes sets EncodedText, Tag 355, and EncodedTextLen, Tag 354, from v, which may hold any bytes including SOH
+func (m SettlementInstructions) SetEncodedTextBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedTextLen, tag.EncodedText, v)
+}
+
+//GetEncodedTextBytes gets EncodedText, Tag 355, checking its length against EncodedTextLen, Tag 354
+func (m SettlementInstructions) GetEncodedTextBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedTextLen, tag.EncodedText)
+}
+
+//GetEncodedTextDecoded gets EncodedText, Tag 355, decoded to UTF-8 using the MessageEncoding of the Header
+func (m SettlementInstructions) GetEncodedTextDecoded() (string, error) {
+	return fix44.DecodeRawData(m.Header, m, tag.EncodedTextLen, tag.EncodedText)
+}
+
 //HasSettlInstMsgID returns true if SettlInstMsgID is present, Tag 777
 func (m SettlementInstructions) HasSettlInstMsgID() bool {
 	return m.Has(tag.SettlInstMsgID)
@@ -723,173 +759,43 @@ func (m NoSettlInst) HasPaymentRemitterID() bool {
 	return m.Has(tag.PaymentRemitterID)
 }
 
-//NoPartyIDs is a repeating group element, Tag 453
-type NoPartyIDs struct {
-	*quickfix.Group
+//GetParties gets the Parties component
+func (m NoSettlInst) GetParties() components.Parties {
+	return components.Parties{&m.Group.FieldMap}
 }
 
-//SetPartyID sets PartyID, Tag 448
-func (m NoPartyIDs) SetPartyID(v string) {
-	m.Set(field.NewPartyID(v))
+//SetParties sets the Parties component, copying the fields present in c
+func (m NoSettlInst) SetParties(c components.Parties) quickfix.MessageRejectError {
+	return c.CopyInto(&m.Group.FieldMap)
 }
 
-//SetPartyIDSource sets PartyIDSource, Tag 447
-func (m NoPartyIDs) SetPartyIDSource(v enum.PartyIDSource) {
-	m.Set(field.NewPartyIDSource(v))
-}
-
-//SetPartyRole sets PartyRole, Tag 452
-func (m NoPartyIDs) SetPartyRole(v enum.PartyRole) {
-	m.Set(field.NewPartyRole(v))
-}
-
-//SetNoPartySubIDs sets NoPartySubIDs, Tag 802
-func (m NoPartyIDs) SetNoPartySubIDs(f NoPartySubIDsRepeatingGroup) {
-	m.SetGroup(f)
-}
-
-//GetPartyID gets PartyID, Tag 448
-func (m NoPartyIDs) GetPartyID() (v string, err quickfix.MessageRejectError) {
-	var f field.PartyIDField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetPartyIDSource gets PartyIDSource, Tag 447
-func (m NoPartyIDs) GetPartyIDSource() (v enum.PartyIDSource, err quickfix.MessageRejectError) {
-	var f field.PartyIDSourceField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetPartyRole gets PartyRole, Tag 452
-func (m NoPartyIDs) GetPartyRole() (v enum.PartyRole, err quickfix.MessageRejectError) {
-	var f field.PartyRoleField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetNoPartySubIDs gets NoPartySubIDs, Tag 802
-func (m NoPartyIDs) GetNoPartySubIDs() (NoPartySubIDsRepeatingGroup, quickfix.MessageRejectError) {
-	f := NewNoPartySubIDsRepeatingGroup()
-	err := m.GetGroup(f)
-	return f, err
-}
-
-//HasPartyID returns true if PartyID is present, Tag 448
-func (m NoPartyIDs) HasPartyID() bool {
-	return m.Has(tag.PartyID)
-}
+//NoPartyIDs is a repeating group element, Tag 453
+type NoPartyIDs = components.NoPartyIDs
 
-//HasPartyIDSource returns true if PartyIDSource is present, Tag 447
-func (m NoPartyIDs) HasPartyIDSource() bool {
-	return m.Has(tag.PartyIDSource)
-}
+//NoPartyIDsRepeatingGroup is a repeating group, Tag 453
+type NoPartyIDsRepeatingGroup = components.NoPartyIDsRepeatingGroup
 
-//HasPartyRole returns true if PartyRole is present, Tag 452
-func (m NoPartyIDs) HasPartyRole() bool {
-	return m.Has(tag.PartyRole)
+//NewNoPartyIDsRepeatingGroup returns an initialized, NoPartyIDsRepeatingGroup
+func NewNoPartyIDsRepeatingGroup() NoPartyIDsRepeatingGroup {
+	return components.NewNoPartyIDsRepeatingGroup()
 }
 
-//HasNoPartySubIDs returns true if NoPartySubIDs is present, Tag 802
-func (m NoPartyIDs) HasNoPartySubIDs() bool {
-	return m.Has(tag.NoPartySubIDs)
-}
+//NoPartyIDsStruct is a plain Go representation of a NoPartyIDs group element
+type NoPartyIDsStruct = components.NoPartyIDsStruct
 
 //NoPartySubIDs is a repeating group element, Tag 802
-type NoPartySubIDs struct {
-	*quickfix.Group
-}
-
-//SetPartySubID sets PartySubID, Tag 523
-func (m NoPartySubIDs) SetPartySubID(v string) {
-	m.Set(field.NewPartySubID(v))
-}
-
-//SetPartySubIDType sets PartySubIDType, Tag 803
-func (m NoPartySubIDs) SetPartySubIDType(v enum.PartySubIDType) {
-	m.Set(field.NewPartySubIDType(v))
-}
-
-//GetPartySubID gets PartySubID, Tag 523
-func (m NoPartySubIDs) GetPartySubID() (v string, err quickfix.MessageRejectError) {
-	var f field.PartySubIDField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//GetPartySubIDType gets PartySubIDType, Tag 803
-func (m NoPartySubIDs) GetPartySubIDType() (v enum.PartySubIDType, err quickfix.MessageRejectError) {
-	var f field.PartySubIDTypeField
-	if err = m.Get(&f); err == nil {
-		v = f.Value()
-	}
-	return
-}
-
-//HasPartySubID returns true if PartySubID is present, Tag 523
-func (m NoPartySubIDs) HasPartySubID() bool {
-	return m.Has(tag.PartySubID)
-}
-
-//HasPartySubIDType returns true if PartySubIDType is present, Tag 803
-func (m NoPartySubIDs) HasPartySubIDType() bool {
-	return m.Has(tag.PartySubIDType)
-}
+type NoPartySubIDs = components.NoPartySubIDs
 
 //NoPartySubIDsRepeatingGroup is a repeating group, Tag 802
-type NoPartySubIDsRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
+type NoPartySubIDsRepeatingGroup = components.NoPartySubIDsRepeatingGroup
 
 //NewNoPartySubIDsRepeatingGroup returns an initialized, NoPartySubIDsRepeatingGroup
 func NewNoPartySubIDsRepeatingGroup() NoPartySubIDsRepeatingGroup {
-	return NoPartySubIDsRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoPartySubIDs,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.PartySubID), quickfix.GroupElement(tag.PartySubIDType)})}
-}
-
-//Add create and append a new NoPartySubIDs to this group
-func (m NoPartySubIDsRepeatingGroup) Add() NoPartySubIDs {
-	g := m.RepeatingGroup.Add()
-	return NoPartySubIDs{g}
-}
-
-//Get returns the ith NoPartySubIDs in the NoPartySubIDsRepeatinGroup
-func (m NoPartySubIDsRepeatingGroup) Get(i int) NoPartySubIDs {
-	return NoPartySubIDs{m.RepeatingGroup.Get(i)}
-}
-
-//NoPartyIDsRepeatingGroup is a repeating group, Tag 453
-type NoPartyIDsRepeatingGroup struct {
-	*quickfix.RepeatingGroup
-}
-
-//NewNoPartyIDsRepeatingGroup returns an initialized, NoPartyIDsRepeatingGroup
-func NewNoPartyIDsRepeatingGroup() NoPartyIDsRepeatingGroup {
-	return NoPartyIDsRepeatingGroup{
-		quickfix.NewRepeatingGroup(tag.NoPartyIDs,
-			quickfix.GroupTemplate{quickfix.GroupElement(tag.PartyID), quickfix.GroupElement(tag.PartyIDSource), quickfix.GroupElement(tag.PartyRole), NewNoPartySubIDsRepeatingGroup()})}
-}
-
-//Add create and append a new NoPartyIDs to this group
-func (m NoPartyIDsRepeatingGroup) Add() NoPartyIDs {
-	g := m.RepeatingGroup.Add()
-	return NoPartyIDs{g}
+	return components.NewNoPartySubIDsRepeatingGroup()
 }
 
-//Get returns the ith NoPartyIDs in the NoPartyIDsRepeatinGroup
-func (m NoPartyIDsRepeatingGroup) Get(i int) NoPartyIDs {
-	return NoPartyIDs{m.RepeatingGroup.Get(i)}
-}
+//NoPartySubIDsStruct is a plain Go representation of a NoPartySubIDs group element
+type NoPartySubIDsStruct = components.NoPartySubIDsStruct
 
 //NoDlvyInst is a repeating group element, Tag 85
 type NoDlvyInst struct {
@@ -1164,3 +1070,592 @@ func (m NoSettlInstRepeatingGroup) Add() NoSettlInst {
 func (m NoSettlInstRepeatingGroup) Get(i int) NoSettlInst {
 	return NoSettlInst{m.RepeatingGroup.Get(i)}
 }
+
+//Struct is a plain Go representation of the SettlementInstructions body, optional fields are nil when absent
+type Struct struct {
+	ClOrdID             *string
+	Text                *string
+	TransactTime        time.Time
+	SettlInstMode       enum.SettlInstMode
+	EncodedTextLen      *int
+	EncodedText         *string
+	SettlInstMsgID      string
+	NoSettlInst         []NoSettlInstStruct
+	SettlInstReqID      *string
+	SettlInstReqRejCode *enum.SettlInstReqRejCode
+}
+
+//Marshal copies the fields present in msg into a Struct
+func Marshal(msg SettlementInstructions) (Struct, quickfix.MessageRejectError) {
+	var s Struct
+	if msg.HasClOrdID() {
+		v, err := msg.GetClOrdID()
+		if err != nil {
+			return s, err
+		}
+		s.ClOrdID = &v
+	}
+	if msg.HasText() {
+		v, err := msg.GetText()
+		if err != nil {
+			return s, err
+		}
+		s.Text = &v
+	}
+	if msg.HasTransactTime() {
+		v, err := msg.GetTransactTime()
+		if err != nil {
+			return s, err
+		}
+		s.TransactTime = v
+	}
+	if msg.HasSettlInstMode() {
+		v, err := msg.GetSettlInstMode()
+		if err != nil {
+			return s, err
+		}
+		s.SettlInstMode = v
+	}
+	if msg.HasEncodedTextLen() {
+		v, err := msg.GetEncodedTextLen()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedTextLen = &v
+	}
+	if msg.HasEncodedText() {
+		v, err := msg.GetEncodedText()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedText = &v
+	}
+	if msg.HasSettlInstMsgID() {
+		v, err := msg.GetSettlInstMsgID()
+		if err != nil {
+			return s, err
+		}
+		s.SettlInstMsgID = v
+	}
+	if msg.HasNoSettlInst() {
+		g, err := msg.GetNoSettlInst()
+		if err != nil {
+			return s, err
+		}
+		if s.NoSettlInst, err = MarshalNoSettlInst(g); err != nil {
+			return s, err
+		}
+	}
+	if msg.HasSettlInstReqID() {
+		v, err := msg.GetSettlInstReqID()
+		if err != nil {
+			return s, err
+		}
+		s.SettlInstReqID = &v
+	}
+	if msg.HasSettlInstReqRejCode() {
+		v, err := msg.GetSettlInstReqRejCode()
+		if err != nil {
+			return s, err
+		}
+		s.SettlInstReqRejCode = &v
+	}
+	return s, nil
+}
+
+//Unmarshal builds a SettlementInstructions from s
+func Unmarshal(s Struct) SettlementInstructions {
+	m := New(field.NewSettlInstMsgID(s.SettlInstMsgID), field.NewSettlInstMode(s.SettlInstMode), field.NewTransactTime(s.TransactTime))
+	if s.ClOrdID != nil {
+		m.SetClOrdID(*s.ClOrdID)
+	}
+	if s.Text != nil {
+		m.SetText(*s.Text)
+	}
+	if s.EncodedTextLen != nil {
+		m.SetEncodedTextLen(*s.EncodedTextLen)
+	}
+	if s.EncodedText != nil {
+		m.SetEncodedText(*s.EncodedText)
+	}
+	if s.NoSettlInst != nil {
+		m.SetNoSettlInst(UnmarshalNoSettlInst(s.NoSettlInst))
+	}
+	if s.SettlInstReqID != nil {
+		m.SetSettlInstReqID(*s.SettlInstReqID)
+	}
+	if s.SettlInstReqRejCode != nil {
+		m.SetSettlInstReqRejCode(*s.SettlInstReqRejCode)
+	}
+	return m
+}
+
+//NoSettlInstStruct is a plain Go representation of a NoSettlInst group element, optional fields are nil when absent
+type NoSettlInstStruct struct {
+	SettlInstID        *string
+	SettlInstTransType *enum.SettlInstTransType
+	SettlInstRefID     *string
+	NoPartyIDs         []NoPartyIDsStruct
+	Side               *enum.Side
+	Product            *enum.Product
+	SecurityType       *enum.SecurityType
+	CFICode            *string
+	EffectiveTime      *time.Time
+	ExpireTime         *time.Time
+	LastUpdateTime     *time.Time
+	SettlDeliveryType  *enum.SettlDeliveryType
+	StandInstDbType    *enum.StandInstDbType
+	StandInstDbName    *string
+	StandInstDbID      *string
+	NoDlvyInst         []NoDlvyInstStruct
+	PaymentMethod      *enum.PaymentMethod
+	PaymentRef         *string
+	CardHolderName     *string
+	CardNumber         *string
+	CardStartDate      *string
+	CardExpDate        *string
+	CardIssNum         *string
+	PaymentDate        *string
+	PaymentRemitterID  *string
+}
+
+//MarshalNoSettlInst copies the elements of g into a slice of NoSettlInstStruct
+func MarshalNoSettlInst(g NoSettlInstRepeatingGroup) ([]NoSettlInstStruct, quickfix.MessageRejectError) {
+	s := make([]NoSettlInstStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasSettlInstID() {
+			v, err := m.GetSettlInstID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SettlInstID = &v
+		}
+		if m.HasSettlInstTransType() {
+			v, err := m.GetSettlInstTransType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SettlInstTransType = &v
+		}
+		if m.HasSettlInstRefID() {
+			v, err := m.GetSettlInstRefID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SettlInstRefID = &v
+		}
+		if m.HasNoPartyIDs() {
+			g, err := m.GetNoPartyIDs()
+			if err != nil {
+				return nil, err
+			}
+			if s[i].NoPartyIDs, err = components.MarshalNoPartyIDs(g); err != nil {
+				return nil, err
+			}
+		}
+		if m.HasSide() {
+			v, err := m.GetSide()
+			if err != nil {
+				return nil, err
+			}
+			s[i].Side = &v
+		}
+		if m.HasProduct() {
+			v, err := m.GetProduct()
+			if err != nil {
+				return nil, err
+			}
+			s[i].Product = &v
+		}
+		if m.HasSecurityType() {
+			v, err := m.GetSecurityType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SecurityType = &v
+		}
+		if m.HasCFICode() {
+			v, err := m.GetCFICode()
+			if err != nil {
+				return nil, err
+			}
+			s[i].CFICode = &v
+		}
+		if m.HasEffectiveTime() {
+			v, err := m.GetEffectiveTime()
+			if err != nil {
+				return nil, err
+			}
+			s[i].EffectiveTime = &v
+		}
+		if m.HasExpireTime() {
+			v, err := m.GetExpireTime()
+			if err != nil {
+				return nil, err
+			}
+			s[i].ExpireTime = &v
+		}
+		if m.HasLastUpdateTime() {
+			v, err := m.GetLastUpdateTime()
+			if err != nil {
+				return nil, err
+			}
+			s[i].LastUpdateTime = &v
+		}
+		if m.HasSettlDeliveryType() {
+			v, err := m.GetSettlDeliveryType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SettlDeliveryType = &v
+		}
+		if m.HasStandInstDbType() {
+			v, err := m.GetStandInstDbType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].StandInstDbType = &v
+		}
+		if m.HasStandInstDbName() {
+			v, err := m.GetStandInstDbName()
+			if err != nil {
+				return nil, err
+			}
+			s[i].StandInstDbName = &v
+		}
+		if m.HasStandInstDbID() {
+			v, err := m.GetStandInstDbID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].StandInstDbID = &v
+		}
+		if m.HasNoDlvyInst() {
+			g, err := m.GetNoDlvyInst()
+			if err != nil {
+				return nil, err
+			}
+			if s[i].NoDlvyInst, err = MarshalNoDlvyInst(g); err != nil {
+				return nil, err
+			}
+		}
+		if m.HasPaymentMethod() {
+			v, err := m.GetPaymentMethod()
+			if err != nil {
+				return nil, err
+			}
+			s[i].PaymentMethod = &v
+		}
+		if m.HasPaymentRef() {
+			v, err := m.GetPaymentRef()
+			if err != nil {
+				return nil, err
+			}
+			s[i].PaymentRef = &v
+		}
+		if m.HasCardHolderName() {
+			v, err := m.GetCardHolderName()
+			if err != nil {
+				return nil, err
+			}
+			s[i].CardHolderName = &v
+		}
+		if m.HasCardNumber() {
+			v, err := m.GetCardNumber()
+			if err != nil {
+				return nil, err
+			}
+			s[i].CardNumber = &v
+		}
+		if m.HasCardStartDate() {
+			v, err := m.GetCardStartDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].CardStartDate = &v
+		}
+		if m.HasCardExpDate() {
+			v, err := m.GetCardExpDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].CardExpDate = &v
+		}
+		if m.HasCardIssNum() {
+			v, err := m.GetCardIssNum()
+			if err != nil {
+				return nil, err
+			}
+			s[i].CardIssNum = &v
+		}
+		if m.HasPaymentDate() {
+			v, err := m.GetPaymentDate()
+			if err != nil {
+				return nil, err
+			}
+			s[i].PaymentDate = &v
+		}
+		if m.HasPaymentRemitterID() {
+			v, err := m.GetPaymentRemitterID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].PaymentRemitterID = &v
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoSettlInst builds a NoSettlInstRepeatingGroup from s
+func UnmarshalNoSettlInst(s []NoSettlInstStruct) NoSettlInstRepeatingGroup {
+	g := NewNoSettlInstRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.SettlInstID != nil {
+			m.SetSettlInstID(*e.SettlInstID)
+		}
+		if e.SettlInstTransType != nil {
+			m.SetSettlInstTransType(*e.SettlInstTransType)
+		}
+		if e.SettlInstRefID != nil {
+			m.SetSettlInstRefID(*e.SettlInstRefID)
+		}
+		if e.NoPartyIDs != nil {
+			m.SetNoPartyIDs(components.UnmarshalNoPartyIDs(e.NoPartyIDs))
+		}
+		if e.Side != nil {
+			m.SetSide(*e.Side)
+		}
+		if e.Product != nil {
+			m.SetProduct(*e.Product)
+		}
+		if e.SecurityType != nil {
+			m.SetSecurityType(*e.SecurityType)
+		}
+		if e.CFICode != nil {
+			m.SetCFICode(*e.CFICode)
+		}
+		if e.EffectiveTime != nil {
+			m.SetEffectiveTime(*e.EffectiveTime)
+		}
+		if e.ExpireTime != nil {
+			m.SetExpireTime(*e.ExpireTime)
+		}
+		if e.LastUpdateTime != nil {
+			m.SetLastUpdateTime(*e.LastUpdateTime)
+		}
+		if e.SettlDeliveryType != nil {
+			m.SetSettlDeliveryType(*e.SettlDeliveryType)
+		}
+		if e.StandInstDbType != nil {
+			m.SetStandInstDbType(*e.StandInstDbType)
+		}
+		if e.StandInstDbName != nil {
+			m.SetStandInstDbName(*e.StandInstDbName)
+		}
+		if e.StandInstDbID != nil {
+			m.SetStandInstDbID(*e.StandInstDbID)
+		}
+		if e.NoDlvyInst != nil {
+			m.SetNoDlvyInst(UnmarshalNoDlvyInst(e.NoDlvyInst))
+		}
+		if e.PaymentMethod != nil {
+			m.SetPaymentMethod(*e.PaymentMethod)
+		}
+		if e.PaymentRef != nil {
+			m.SetPaymentRef(*e.PaymentRef)
+		}
+		if e.CardHolderName != nil {
+			m.SetCardHolderName(*e.CardHolderName)
+		}
+		if e.CardNumber != nil {
+			m.SetCardNumber(*e.CardNumber)
+		}
+		if e.CardStartDate != nil {
+			m.SetCardStartDate(*e.CardStartDate)
+		}
+		if e.CardExpDate != nil {
+			m.SetCardExpDate(*e.CardExpDate)
+		}
+		if e.CardIssNum != nil {
+			m.SetCardIssNum(*e.CardIssNum)
+		}
+		if e.PaymentDate != nil {
+			m.SetPaymentDate(*e.PaymentDate)
+		}
+		if e.PaymentRemitterID != nil {
+			m.SetPaymentRemitterID(*e.PaymentRemitterID)
+		}
+	}
+	return g
+}
+
+//NoDlvyInstStruct is a plain Go representation of a NoDlvyInst group element, optional fields are nil when absent
+type NoDlvyInstStruct struct {
+	SettlInstSource *enum.SettlInstSource
+	DlvyInstType    *enum.DlvyInstType
+	NoSettlPartyIDs []NoSettlPartyIDsStruct
+}
+
+//MarshalNoDlvyInst copies the elements of g into a slice of NoDlvyInstStruct
+func MarshalNoDlvyInst(g NoDlvyInstRepeatingGroup) ([]NoDlvyInstStruct, quickfix.MessageRejectError) {
+	s := make([]NoDlvyInstStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasSettlInstSource() {
+			v, err := m.GetSettlInstSource()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SettlInstSource = &v
+		}
+		if m.HasDlvyInstType() {
+			v, err := m.GetDlvyInstType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].DlvyInstType = &v
+		}
+		if m.HasNoSettlPartyIDs() {
+			g, err := m.GetNoSettlPartyIDs()
+			if err != nil {
+				return nil, err
+			}
+			if s[i].NoSettlPartyIDs, err = MarshalNoSettlPartyIDs(g); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoDlvyInst builds a NoDlvyInstRepeatingGroup from s
+func UnmarshalNoDlvyInst(s []NoDlvyInstStruct) NoDlvyInstRepeatingGroup {
+	g := NewNoDlvyInstRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.SettlInstSource != nil {
+			m.SetSettlInstSource(*e.SettlInstSource)
+		}
+		if e.DlvyInstType != nil {
+			m.SetDlvyInstType(*e.DlvyInstType)
+		}
+		if e.NoSettlPartyIDs != nil {
+			m.SetNoSettlPartyIDs(UnmarshalNoSettlPartyIDs(e.NoSettlPartyIDs))
+		}
+	}
+	return g
+}
+
+//NoSettlPartyIDsStruct is a plain Go representation of a NoSettlPartyIDs group element, optional fields are nil when absent
+type NoSettlPartyIDsStruct struct {
+	SettlPartyID       *string
+	SettlPartyIDSource *string
+	SettlPartyRole     *int
+	NoSettlPartySubIDs []NoSettlPartySubIDsStruct
+}
+
+//MarshalNoSettlPartyIDs copies the elements of g into a slice of NoSettlPartyIDsStruct
+func MarshalNoSettlPartyIDs(g NoSettlPartyIDsRepeatingGroup) ([]NoSettlPartyIDsStruct, quickfix.MessageRejectError) {
+	s := make([]NoSettlPartyIDsStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasSettlPartyID() {
+			v, err := m.GetSettlPartyID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SettlPartyID = &v
+		}
+		if m.HasSettlPartyIDSource() {
+			v, err := m.GetSettlPartyIDSource()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SettlPartyIDSource = &v
+		}
+		if m.HasSettlPartyRole() {
+			v, err := m.GetSettlPartyRole()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SettlPartyRole = &v
+		}
+		if m.HasNoSettlPartySubIDs() {
+			g, err := m.GetNoSettlPartySubIDs()
+			if err != nil {
+				return nil, err
+			}
+			if s[i].NoSettlPartySubIDs, err = MarshalNoSettlPartySubIDs(g); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoSettlPartyIDs builds a NoSettlPartyIDsRepeatingGroup from s
+func UnmarshalNoSettlPartyIDs(s []NoSettlPartyIDsStruct) NoSettlPartyIDsRepeatingGroup {
+	g := NewNoSettlPartyIDsRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.SettlPartyID != nil {
+			m.SetSettlPartyID(*e.SettlPartyID)
+		}
+		if e.SettlPartyIDSource != nil {
+			m.SetSettlPartyIDSource(*e.SettlPartyIDSource)
+		}
+		if e.SettlPartyRole != nil {
+			m.SetSettlPartyRole(*e.SettlPartyRole)
+		}
+		if e.NoSettlPartySubIDs != nil {
+			m.SetNoSettlPartySubIDs(UnmarshalNoSettlPartySubIDs(e.NoSettlPartySubIDs))
+		}
+	}
+	return g
+}
+
+//NoSettlPartySubIDsStruct is a plain Go representation of a NoSettlPartySubIDs group element, optional fields are nil when absent
+type NoSettlPartySubIDsStruct struct {
+	SettlPartySubID     *string
+	SettlPartySubIDType *int
+}
+
+//MarshalNoSettlPartySubIDs copies the elements of g into a slice of NoSettlPartySubIDsStruct
+func MarshalNoSettlPartySubIDs(g NoSettlPartySubIDsRepeatingGroup) ([]NoSettlPartySubIDsStruct, quickfix.MessageRejectError) {
+	s := make([]NoSettlPartySubIDsStruct, g.Len())
+	for i := range s {
+		m := g.Get(i)
+		if m.HasSettlPartySubID() {
+			v, err := m.GetSettlPartySubID()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SettlPartySubID = &v
+		}
+		if m.HasSettlPartySubIDType() {
+			v, err := m.GetSettlPartySubIDType()
+			if err != nil {
+				return nil, err
+			}
+			s[i].SettlPartySubIDType = &v
+		}
+	}
+	return s, nil
+}
+
+//UnmarshalNoSettlPartySubIDs builds a NoSettlPartySubIDsRepeatingGroup from s
+func UnmarshalNoSettlPartySubIDs(s []NoSettlPartySubIDsStruct) NoSettlPartySubIDsRepeatingGroup {
+	g := NewNoSettlPartySubIDsRepeatingGroup()
+	for _, e := range s {
+		m := g.Add()
+		if e.SettlPartySubID != nil {
+			m.SetSettlPartySubID(*e.SettlPartySubID)
+		}
+		if e.SettlPartySubIDType != nil {
+			m.SetSettlPartySubIDType(*e.SettlPartySubIDType)
+		}
+	}
+	return g
+}
@@ -0,0 +1,12234 @@
+package fix44
+
+import (
+	"github.com/terracefi/tag"
+)
+
+//MessageDefs holds the definition of every fix44 message type, by MsgType
+var MessageDefs = map[string]MessageDef{
+	"7": {
+		Name:    "Advertisement",
+		MsgType: "7",
+		Fields: []FieldDef{
+			{Tag: tag.AdvId, Name: "AdvId", Type: FieldTypeString, Required: true},
+			{Tag: tag.AdvRefID, Name: "AdvRefID", Type: FieldTypeString},
+			{Tag: tag.AdvSide, Name: "AdvSide", Type: FieldTypeString, Required: true},
+			{Tag: tag.AdvTransType, Name: "AdvTransType", Type: FieldTypeString, Required: true},
+			{Tag: tag.Currency, Name: "Currency", Type: FieldTypeString},
+			{Tag: tag.SecurityIDSource, Name: "SecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.LastMkt, Name: "LastMkt", Type: FieldTypeString},
+			{Tag: tag.Price, Name: "Price", Type: FieldTypeDecimal},
+			{Tag: tag.SecurityID, Name: "SecurityID", Type: FieldTypeString},
+			{Tag: tag.Quantity, Name: "Quantity", Type: FieldTypeDecimal, Required: true},
+			{Tag: tag.Symbol, Name: "Symbol", Type: FieldTypeString},
+			{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+			{Tag: tag.TransactTime, Name: "TransactTime", Type: FieldTypeUTCTimestamp},
+			{Tag: tag.SymbolSfx, Name: "SymbolSfx", Type: FieldTypeString},
+			{Tag: tag.TradeDate, Name: "TradeDate", Type: FieldTypeString},
+			{Tag: tag.Issuer, Name: "Issuer", Type: FieldTypeString},
+			{Tag: tag.SecurityDesc, Name: "SecurityDesc", Type: FieldTypeString},
+			{Tag: tag.URLLink, Name: "URLLink", Type: FieldTypeString},
+			{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+			{Tag: tag.MaturityMonthYear, Name: "MaturityMonthYear", Type: FieldTypeString},
+			{Tag: tag.StrikePrice, Name: "StrikePrice", Type: FieldTypeDecimal},
+			{Tag: tag.OptAttribute, Name: "OptAttribute", Type: FieldTypeString},
+			{Tag: tag.SecurityExchange, Name: "SecurityExchange", Type: FieldTypeString},
+			{Tag: tag.CouponRate, Name: "CouponRate", Type: FieldTypeDecimal},
+			{Tag: tag.CouponPaymentDate, Name: "CouponPaymentDate", Type: FieldTypeString},
+			{Tag: tag.IssueDate, Name: "IssueDate", Type: FieldTypeString},
+			{Tag: tag.RepurchaseTerm, Name: "RepurchaseTerm", Type: FieldTypeInt},
+			{Tag: tag.RepurchaseRate, Name: "RepurchaseRate", Type: FieldTypeDecimal},
+			{Tag: tag.Factor, Name: "Factor", Type: FieldTypeDecimal},
+			{Tag: tag.ContractMultiplier, Name: "ContractMultiplier", Type: FieldTypeDecimal},
+			{Tag: tag.RepoCollateralSecurityType, Name: "RepoCollateralSecurityType", Type: FieldTypeInt},
+			{Tag: tag.RedemptionDate, Name: "RedemptionDate", Type: FieldTypeString},
+			{Tag: tag.CreditRating, Name: "CreditRating", Type: FieldTypeString},
+			{Tag: tag.TradingSessionID, Name: "TradingSessionID", Type: FieldTypeString},
+			{Tag: tag.EncodedIssuerLen, Name: "EncodedIssuerLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedIssuer, Name: "EncodedIssuer", Type: FieldTypeString},
+			{Tag: tag.EncodedSecurityDescLen, Name: "EncodedSecurityDescLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedSecurityDesc, Name: "EncodedSecurityDesc", Type: FieldTypeString},
+			{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			{Tag: tag.NoSecurityAltID, Name: "NoSecurityAltID", Type: FieldTypeNumInGroup, Fields: noSecurityAltIDFields},
+			{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+			{Tag: tag.CFICode, Name: "CFICode", Type: FieldTypeString},
+			{Tag: tag.CountryOfIssue, Name: "CountryOfIssue", Type: FieldTypeString},
+			{Tag: tag.StateOrProvinceOfIssue, Name: "StateOrProvinceOfIssue", Type: FieldTypeString},
+			{Tag: tag.LocaleOfIssue, Name: "LocaleOfIssue", Type: FieldTypeString},
+			{Tag: tag.MaturityDate, Name: "MaturityDate", Type: FieldTypeString},
+			{Tag: tag.InstrRegistry, Name: "InstrRegistry", Type: FieldTypeString},
+			{Tag: tag.NoLegs, Name: "NoLegs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.LegSymbol, Name: "LegSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.LegSymbolSfx, Name: "LegSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.LegSecurityID, Name: "LegSecurityID", Type: FieldTypeString},
+				{Tag: tag.LegSecurityIDSource, Name: "LegSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoLegSecurityAltID, Name: "NoLegSecurityAltID", Type: FieldTypeNumInGroup, Fields: noLegSecurityAltIDFields},
+				{Tag: tag.LegProduct, Name: "LegProduct", Type: FieldTypeInt},
+				{Tag: tag.LegCFICode, Name: "LegCFICode", Type: FieldTypeString},
+				{Tag: tag.LegSecurityType, Name: "LegSecurityType", Type: FieldTypeString},
+				{Tag: tag.LegSecuritySubType, Name: "LegSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.LegMaturityMonthYear, Name: "LegMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.LegMaturityDate, Name: "LegMaturityDate", Type: FieldTypeString},
+				{Tag: tag.LegCouponPaymentDate, Name: "LegCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.LegIssueDate, Name: "LegIssueDate", Type: FieldTypeString},
+				{Tag: tag.LegRepoCollateralSecurityType, Name: "LegRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseTerm, Name: "LegRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseRate, Name: "LegRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegFactor, Name: "LegFactor", Type: FieldTypeDecimal},
+				{Tag: tag.LegCreditRating, Name: "LegCreditRating", Type: FieldTypeString},
+				{Tag: tag.LegInstrRegistry, Name: "LegInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.LegCountryOfIssue, Name: "LegCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegStateOrProvinceOfIssue, Name: "LegStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegLocaleOfIssue, Name: "LegLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegRedemptionDate, Name: "LegRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.LegStrikePrice, Name: "LegStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.LegStrikeCurrency, Name: "LegStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.LegOptAttribute, Name: "LegOptAttribute", Type: FieldTypeString},
+				{Tag: tag.LegContractMultiplier, Name: "LegContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.LegCouponRate, Name: "LegCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegSecurityExchange, Name: "LegSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.LegIssuer, Name: "LegIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedLegIssuerLen, Name: "EncodedLegIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegIssuer, Name: "EncodedLegIssuer", Type: FieldTypeString},
+				{Tag: tag.LegSecurityDesc, Name: "LegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedLegSecurityDescLen, Name: "EncodedLegSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegSecurityDesc, Name: "EncodedLegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.LegRatioQty, Name: "LegRatioQty", Type: FieldTypeDecimal},
+				{Tag: tag.LegSide, Name: "LegSide", Type: FieldTypeString},
+				{Tag: tag.LegCurrency, Name: "LegCurrency", Type: FieldTypeString},
+				{Tag: tag.LegPool, Name: "LegPool", Type: FieldTypeString},
+				{Tag: tag.LegDatedDate, Name: "LegDatedDate", Type: FieldTypeString},
+				{Tag: tag.LegContractSettlMonth, Name: "LegContractSettlMonth", Type: FieldTypeString},
+				{Tag: tag.LegInterestAccrualDate, Name: "LegInterestAccrualDate", Type: FieldTypeString},
+			}},
+			{Tag: tag.TradingSessionSubID, Name: "TradingSessionSubID", Type: FieldTypeString},
+			{Tag: tag.ContractSettlMonth, Name: "ContractSettlMonth", Type: FieldTypeString},
+			{Tag: tag.Pool, Name: "Pool", Type: FieldTypeString},
+			{Tag: tag.NoUnderlyings, Name: "NoUnderlyings", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.UnderlyingSymbol, Name: "UnderlyingSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.UnderlyingSymbolSfx, Name: "UnderlyingSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityID, Name: "UnderlyingSecurityID", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityIDSource, Name: "UnderlyingSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoUnderlyingSecurityAltID, Name: "NoUnderlyingSecurityAltID", Type: FieldTypeNumInGroup, Fields: noUnderlyingSecurityAltIDFields},
+				{Tag: tag.UnderlyingProduct, Name: "UnderlyingProduct", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingCFICode, Name: "UnderlyingCFICode", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityType, Name: "UnderlyingSecurityType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecuritySubType, Name: "UnderlyingSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityMonthYear, Name: "UnderlyingMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityDate, Name: "UnderlyingMaturityDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCouponPaymentDate, Name: "UnderlyingCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssueDate, Name: "UnderlyingIssueDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRepoCollateralSecurityType, Name: "UnderlyingRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseTerm, Name: "UnderlyingRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseRate, Name: "UnderlyingRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingFactor, Name: "UnderlyingFactor", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCreditRating, Name: "UnderlyingCreditRating", Type: FieldTypeString},
+				{Tag: tag.UnderlyingInstrRegistry, Name: "UnderlyingInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCountryOfIssue, Name: "UnderlyingCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStateOrProvinceOfIssue, Name: "UnderlyingStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingLocaleOfIssue, Name: "UnderlyingLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRedemptionDate, Name: "UnderlyingRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStrikePrice, Name: "UnderlyingStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStrikeCurrency, Name: "UnderlyingStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingOptAttribute, Name: "UnderlyingOptAttribute", Type: FieldTypeString},
+				{Tag: tag.UnderlyingContractMultiplier, Name: "UnderlyingContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCouponRate, Name: "UnderlyingCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingSecurityExchange, Name: "UnderlyingSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssuer, Name: "UnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingIssuerLen, Name: "EncodedUnderlyingIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingIssuer, Name: "EncodedUnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityDesc, Name: "UnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingSecurityDescLen, Name: "EncodedUnderlyingSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingSecurityDesc, Name: "EncodedUnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPProgram, Name: "UnderlyingCPProgram", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPRegType, Name: "UnderlyingCPRegType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCurrency, Name: "UnderlyingCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingQty, Name: "UnderlyingQty", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingPx, Name: "UnderlyingPx", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingDirtyPrice, Name: "UnderlyingDirtyPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndPrice, Name: "UnderlyingEndPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStartValue, Name: "UnderlyingStartValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCurrentValue, Name: "UnderlyingCurrentValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndValue, Name: "UnderlyingEndValue", Type: FieldTypeDecimal},
+				{Tag: tag.NoUnderlyingStips, Name: "NoUnderlyingStips", Type: FieldTypeNumInGroup, Fields: noUnderlyingStipsFields},
+			}},
+			{Tag: tag.SecuritySubType, Name: "SecuritySubType", Type: FieldTypeString},
+			{Tag: tag.QtyType, Name: "QtyType", Type: FieldTypeString},
+			{Tag: tag.NoEvents, Name: "NoEvents", Type: FieldTypeNumInGroup, Fields: noEventsFields},
+			{Tag: tag.DatedDate, Name: "DatedDate", Type: FieldTypeString},
+			{Tag: tag.InterestAccrualDate, Name: "InterestAccrualDate", Type: FieldTypeString},
+			{Tag: tag.CPProgram, Name: "CPProgram", Type: FieldTypeString},
+			{Tag: tag.CPRegType, Name: "CPRegType", Type: FieldTypeString},
+			{Tag: tag.StrikeCurrency, Name: "StrikeCurrency", Type: FieldTypeString},
+		},
+	},
+	"J": {
+		Name:    "AllocationInstruction",
+		MsgType: "J",
+		Fields: []FieldDef{
+			{Tag: tag.AvgPx, Name: "AvgPx", Type: FieldTypeDecimal, Required: true},
+			{Tag: tag.Currency, Name: "Currency", Type: FieldTypeString},
+			{Tag: tag.SecurityIDSource, Name: "SecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.LastMkt, Name: "LastMkt", Type: FieldTypeString},
+			{Tag: tag.SecurityID, Name: "SecurityID", Type: FieldTypeString},
+			{Tag: tag.Quantity, Name: "Quantity", Type: FieldTypeDecimal, Required: true},
+			{Tag: tag.Side, Name: "Side", Type: FieldTypeString, Required: true},
+			{Tag: tag.Symbol, Name: "Symbol", Type: FieldTypeString},
+			{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+			{Tag: tag.TransactTime, Name: "TransactTime", Type: FieldTypeUTCTimestamp},
+			{Tag: tag.SettlType, Name: "SettlType", Type: FieldTypeString},
+			{Tag: tag.SettlDate, Name: "SettlDate", Type: FieldTypeString},
+			{Tag: tag.SymbolSfx, Name: "SymbolSfx", Type: FieldTypeString},
+			{Tag: tag.AllocID, Name: "AllocID", Type: FieldTypeString, Required: true},
+			{Tag: tag.AllocTransType, Name: "AllocTransType", Type: FieldTypeString, Required: true},
+			{Tag: tag.RefAllocID, Name: "RefAllocID", Type: FieldTypeString},
+			{Tag: tag.NoOrders, Name: "NoOrders", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.ClOrdID, Name: "ClOrdID", Type: FieldTypeString, Required: true},
+				{Tag: tag.OrderID, Name: "OrderID", Type: FieldTypeString},
+				{Tag: tag.SecondaryOrderID, Name: "SecondaryOrderID", Type: FieldTypeString},
+				{Tag: tag.SecondaryClOrdID, Name: "SecondaryClOrdID", Type: FieldTypeString},
+				{Tag: tag.ListID, Name: "ListID", Type: FieldTypeString},
+				{Tag: tag.NoNested2PartyIDs, Name: "NoNested2PartyIDs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+					{Tag: tag.Nested2PartyID, Name: "Nested2PartyID", Type: FieldTypeString, Required: true},
+					{Tag: tag.Nested2PartyIDSource, Name: "Nested2PartyIDSource", Type: FieldTypeString},
+					{Tag: tag.Nested2PartyRole, Name: "Nested2PartyRole", Type: FieldTypeInt},
+					{Tag: tag.NoNested2PartySubIDs, Name: "NoNested2PartySubIDs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+						{Tag: tag.Nested2PartySubID, Name: "Nested2PartySubID", Type: FieldTypeString, Required: true},
+						{Tag: tag.Nested2PartySubIDType, Name: "Nested2PartySubIDType", Type: FieldTypeInt},
+					}},
+				}},
+				{Tag: tag.OrderQty, Name: "OrderQty", Type: FieldTypeDecimal},
+				{Tag: tag.OrderAvgPx, Name: "OrderAvgPx", Type: FieldTypeDecimal},
+				{Tag: tag.OrderBookingQty, Name: "OrderBookingQty", Type: FieldTypeDecimal},
+			}},
+			{Tag: tag.AvgPxPrecision, Name: "AvgPxPrecision", Type: FieldTypeInt},
+			{Tag: tag.TradeDate, Name: "TradeDate", Type: FieldTypeString, Required: true},
+			{Tag: tag.PositionEffect, Name: "PositionEffect", Type: FieldTypeString},
+			{Tag: tag.NoAllocs, Name: "NoAllocs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.AllocAccount, Name: "AllocAccount", Type: FieldTypeString, Required: true},
+				{Tag: tag.AllocAcctIDSource, Name: "AllocAcctIDSource", Type: FieldTypeInt},
+				{Tag: tag.MatchStatus, Name: "MatchStatus", Type: FieldTypeString},
+				{Tag: tag.AllocPrice, Name: "AllocPrice", Type: FieldTypeDecimal},
+				{Tag: tag.AllocQty, Name: "AllocQty", Type: FieldTypeDecimal},
+				{Tag: tag.IndividualAllocID, Name: "IndividualAllocID", Type: FieldTypeString},
+				{Tag: tag.ProcessCode, Name: "ProcessCode", Type: FieldTypeString},
+				{Tag: tag.NoNestedPartyIDs, Name: "NoNestedPartyIDs", Type: FieldTypeNumInGroup, Fields: noNestedPartyIDsFields},
+				{Tag: tag.NotifyBrokerOfCredit, Name: "NotifyBrokerOfCredit", Type: FieldTypeBool},
+				{Tag: tag.AllocHandlInst, Name: "AllocHandlInst", Type: FieldTypeString},
+				{Tag: tag.AllocText, Name: "AllocText", Type: FieldTypeString},
+				{Tag: tag.EncodedAllocTextLen, Name: "EncodedAllocTextLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedAllocText, Name: "EncodedAllocText", Type: FieldTypeString},
+				{Tag: tag.Commission, Name: "Commission", Type: FieldTypeDecimal},
+				{Tag: tag.CommType, Name: "CommType", Type: FieldTypeString},
+				{Tag: tag.CommCurrency, Name: "CommCurrency", Type: FieldTypeString},
+				{Tag: tag.FundRenewWaiv, Name: "FundRenewWaiv", Type: FieldTypeString},
+				{Tag: tag.AllocAvgPx, Name: "AllocAvgPx", Type: FieldTypeDecimal},
+				{Tag: tag.AllocNetMoney, Name: "AllocNetMoney", Type: FieldTypeDecimal},
+				{Tag: tag.SettlCurrAmt, Name: "SettlCurrAmt", Type: FieldTypeDecimal},
+				{Tag: tag.AllocSettlCurrAmt, Name: "AllocSettlCurrAmt", Type: FieldTypeDecimal},
+				{Tag: tag.SettlCurrency, Name: "SettlCurrency", Type: FieldTypeString},
+				{Tag: tag.AllocSettlCurrency, Name: "AllocSettlCurrency", Type: FieldTypeString},
+				{Tag: tag.SettlCurrFxRate, Name: "SettlCurrFxRate", Type: FieldTypeDecimal},
+				{Tag: tag.SettlCurrFxRateCalc, Name: "SettlCurrFxRateCalc", Type: FieldTypeString},
+				{Tag: tag.AllocAccruedInterestAmt, Name: "AllocAccruedInterestAmt", Type: FieldTypeDecimal},
+				{Tag: tag.AllocInterestAtMaturity, Name: "AllocInterestAtMaturity", Type: FieldTypeDecimal},
+				{Tag: tag.NoMiscFees, Name: "NoMiscFees", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+					{Tag: tag.MiscFeeAmt, Name: "MiscFeeAmt", Type: FieldTypeDecimal, Required: true},
+					{Tag: tag.MiscFeeCurr, Name: "MiscFeeCurr", Type: FieldTypeString},
+					{Tag: tag.MiscFeeType, Name: "MiscFeeType", Type: FieldTypeString},
+					{Tag: tag.MiscFeeBasis, Name: "MiscFeeBasis", Type: FieldTypeString},
+				}},
+				{Tag: tag.NoClearingInstructions, Name: "NoClearingInstructions", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+					{Tag: tag.ClearingInstruction, Name: "ClearingInstruction", Type: FieldTypeString, Required: true},
+				}},
+				{Tag: tag.ClearingFeeIndicator, Name: "ClearingFeeIndicator", Type: FieldTypeString},
+				{Tag: tag.AllocSettlInstType, Name: "AllocSettlInstType", Type: FieldTypeString},
+				{Tag: tag.SettlDeliveryType, Name: "SettlDeliveryType", Type: FieldTypeString},
+				{Tag: tag.StandInstDbType, Name: "StandInstDbType", Type: FieldTypeString},
+				{Tag: tag.StandInstDbName, Name: "StandInstDbName", Type: FieldTypeString},
+				{Tag: tag.StandInstDbID, Name: "StandInstDbID", Type: FieldTypeString},
+				{Tag: tag.NoDlvyInst, Name: "NoDlvyInst", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+					{Tag: tag.SettlInstSource, Name: "SettlInstSource", Type: FieldTypeString, Required: true},
+					{Tag: tag.DlvyInstType, Name: "DlvyInstType", Type: FieldTypeString},
+					{Tag: tag.NoSettlPartyIDs, Name: "NoSettlPartyIDs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+						{Tag: tag.SettlPartyID, Name: "SettlPartyID", Type: FieldTypeString, Required: true},
+						{Tag: tag.SettlPartyIDSource, Name: "SettlPartyIDSource", Type: FieldTypeString},
+						{Tag: tag.SettlPartyRole, Name: "SettlPartyRole", Type: FieldTypeInt},
+						{Tag: tag.NoSettlPartySubIDs, Name: "NoSettlPartySubIDs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+							{Tag: tag.SettlPartySubID, Name: "SettlPartySubID", Type: FieldTypeString, Required: true},
+							{Tag: tag.SettlPartySubIDType, Name: "SettlPartySubIDType", Type: FieldTypeInt},
+						}},
+					}},
+				}},
+			}},
+			{Tag: tag.Issuer, Name: "Issuer", Type: FieldTypeString},
+			{Tag: tag.SecurityDesc, Name: "SecurityDesc", Type: FieldTypeString},
+			{Tag: tag.NetMoney, Name: "NetMoney", Type: FieldTypeDecimal},
+			{Tag: tag.NoExecs, Name: "NoExecs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.LastQty, Name: "LastQty", Type: FieldTypeDecimal, Required: true},
+				{Tag: tag.ExecID, Name: "ExecID", Type: FieldTypeString},
+				{Tag: tag.SecondaryExecID, Name: "SecondaryExecID", Type: FieldTypeString},
+				{Tag: tag.LastPx, Name: "LastPx", Type: FieldTypeDecimal},
+				{Tag: tag.LastParPx, Name: "LastParPx", Type: FieldTypeDecimal},
+				{Tag: tag.LastCapacity, Name: "LastCapacity", Type: FieldTypeString},
+			}},
+			{Tag: tag.NumDaysInterest, Name: "NumDaysInterest", Type: FieldTypeInt},
+			{Tag: tag.AccruedInterestRate, Name: "AccruedInterestRate", Type: FieldTypeDecimal},
+			{Tag: tag.AccruedInterestAmt, Name: "AccruedInterestAmt", Type: FieldTypeDecimal},
+			{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+			{Tag: tag.AllocLinkID, Name: "AllocLinkID", Type: FieldTypeString},
+			{Tag: tag.AllocLinkType, Name: "AllocLinkType", Type: FieldTypeString},
+			{Tag: tag.MaturityMonthYear, Name: "MaturityMonthYear", Type: FieldTypeString},
+			{Tag: tag.StrikePrice, Name: "StrikePrice", Type: FieldTypeDecimal},
+			{Tag: tag.OptAttribute, Name: "OptAttribute", Type: FieldTypeString},
+			{Tag: tag.SecurityExchange, Name: "SecurityExchange", Type: FieldTypeString},
+			{Tag: tag.Spread, Name: "Spread", Type: FieldTypeDecimal},
+			{Tag: tag.BenchmarkCurveCurrency, Name: "BenchmarkCurveCurrency", Type: FieldTypeString},
+			{Tag: tag.BenchmarkCurveName, Name: "BenchmarkCurveName", Type: FieldTypeString},
+			{Tag: tag.BenchmarkCurvePoint, Name: "BenchmarkCurvePoint", Type: FieldTypeString},
+			{Tag: tag.CouponRate, Name: "CouponRate", Type: FieldTypeDecimal},
+			{Tag: tag.CouponPaymentDate, Name: "CouponPaymentDate", Type: FieldTypeString},
+			{Tag: tag.IssueDate, Name: "IssueDate", Type: FieldTypeString},
+			{Tag: tag.RepurchaseTerm, Name: "RepurchaseTerm", Type: FieldTypeInt},
+			{Tag: tag.RepurchaseRate, Name: "RepurchaseRate", Type: FieldTypeDecimal},
+			{Tag: tag.Factor, Name: "Factor", Type: FieldTypeDecimal},
+			{Tag: tag.TradeOriginationDate, Name: "TradeOriginationDate", Type: FieldTypeString},
+			{Tag: tag.ContractMultiplier, Name: "ContractMultiplier", Type: FieldTypeDecimal},
+			{Tag: tag.NoStipulations, Name: "NoStipulations", Type: FieldTypeNumInGroup, Fields: noStipulationsFields},
+			{Tag: tag.YieldType, Name: "YieldType", Type: FieldTypeString},
+			{Tag: tag.Yield, Name: "Yield", Type: FieldTypeDecimal},
+			{Tag: tag.TotalTakedown, Name: "TotalTakedown", Type: FieldTypeDecimal},
+			{Tag: tag.Concession, Name: "Concession", Type: FieldTypeDecimal},
+			{Tag: tag.RepoCollateralSecurityType, Name: "RepoCollateralSecurityType", Type: FieldTypeInt},
+			{Tag: tag.RedemptionDate, Name: "RedemptionDate", Type: FieldTypeString},
+			{Tag: tag.CreditRating, Name: "CreditRating", Type: FieldTypeString},
+			{Tag: tag.TradingSessionID, Name: "TradingSessionID", Type: FieldTypeString},
+			{Tag: tag.EncodedIssuerLen, Name: "EncodedIssuerLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedIssuer, Name: "EncodedIssuer", Type: FieldTypeString},
+			{Tag: tag.EncodedSecurityDescLen, Name: "EncodedSecurityDescLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedSecurityDesc, Name: "EncodedSecurityDesc", Type: FieldTypeString},
+			{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			{Tag: tag.GrossTradeAmt, Name: "GrossTradeAmt", Type: FieldTypeDecimal},
+			{Tag: tag.PriceType, Name: "PriceType", Type: FieldTypeString},
+			{Tag: tag.NoPartyIDs, Name: "NoPartyIDs", Type: FieldTypeNumInGroup, Fields: noPartyIDsFields},
+			{Tag: tag.NoSecurityAltID, Name: "NoSecurityAltID", Type: FieldTypeNumInGroup, Fields: noSecurityAltIDFields},
+			{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+			{Tag: tag.CFICode, Name: "CFICode", Type: FieldTypeString},
+			{Tag: tag.BookingRefID, Name: "BookingRefID", Type: FieldTypeString},
+			{Tag: tag.CountryOfIssue, Name: "CountryOfIssue", Type: FieldTypeString},
+			{Tag: tag.StateOrProvinceOfIssue, Name: "StateOrProvinceOfIssue", Type: FieldTypeString},
+			{Tag: tag.LocaleOfIssue, Name: "LocaleOfIssue", Type: FieldTypeString},
+			{Tag: tag.TotalAccruedInterestAmt, Name: "TotalAccruedInterestAmt", Type: FieldTypeDecimal},
+			{Tag: tag.MaturityDate, Name: "MaturityDate", Type: FieldTypeString},
+			{Tag: tag.InstrRegistry, Name: "InstrRegistry", Type: FieldTypeString},
+			{Tag: tag.NoLegs, Name: "NoLegs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.LegSymbol, Name: "LegSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.LegSymbolSfx, Name: "LegSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.LegSecurityID, Name: "LegSecurityID", Type: FieldTypeString},
+				{Tag: tag.LegSecurityIDSource, Name: "LegSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoLegSecurityAltID, Name: "NoLegSecurityAltID", Type: FieldTypeNumInGroup, Fields: noLegSecurityAltIDFields},
+				{Tag: tag.LegProduct, Name: "LegProduct", Type: FieldTypeInt},
+				{Tag: tag.LegCFICode, Name: "LegCFICode", Type: FieldTypeString},
+				{Tag: tag.LegSecurityType, Name: "LegSecurityType", Type: FieldTypeString},
+				{Tag: tag.LegSecuritySubType, Name: "LegSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.LegMaturityMonthYear, Name: "LegMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.LegMaturityDate, Name: "LegMaturityDate", Type: FieldTypeString},
+				{Tag: tag.LegCouponPaymentDate, Name: "LegCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.LegIssueDate, Name: "LegIssueDate", Type: FieldTypeString},
+				{Tag: tag.LegRepoCollateralSecurityType, Name: "LegRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseTerm, Name: "LegRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseRate, Name: "LegRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegFactor, Name: "LegFactor", Type: FieldTypeDecimal},
+				{Tag: tag.LegCreditRating, Name: "LegCreditRating", Type: FieldTypeString},
+				{Tag: tag.LegInstrRegistry, Name: "LegInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.LegCountryOfIssue, Name: "LegCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegStateOrProvinceOfIssue, Name: "LegStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegLocaleOfIssue, Name: "LegLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegRedemptionDate, Name: "LegRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.LegStrikePrice, Name: "LegStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.LegStrikeCurrency, Name: "LegStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.LegOptAttribute, Name: "LegOptAttribute", Type: FieldTypeString},
+				{Tag: tag.LegContractMultiplier, Name: "LegContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.LegCouponRate, Name: "LegCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegSecurityExchange, Name: "LegSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.LegIssuer, Name: "LegIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedLegIssuerLen, Name: "EncodedLegIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegIssuer, Name: "EncodedLegIssuer", Type: FieldTypeString},
+				{Tag: tag.LegSecurityDesc, Name: "LegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedLegSecurityDescLen, Name: "EncodedLegSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegSecurityDesc, Name: "EncodedLegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.LegRatioQty, Name: "LegRatioQty", Type: FieldTypeDecimal},
+				{Tag: tag.LegSide, Name: "LegSide", Type: FieldTypeString},
+				{Tag: tag.LegCurrency, Name: "LegCurrency", Type: FieldTypeString},
+				{Tag: tag.LegPool, Name: "LegPool", Type: FieldTypeString},
+				{Tag: tag.LegDatedDate, Name: "LegDatedDate", Type: FieldTypeString},
+				{Tag: tag.LegContractSettlMonth, Name: "LegContractSettlMonth", Type: FieldTypeString},
+				{Tag: tag.LegInterestAccrualDate, Name: "LegInterestAccrualDate", Type: FieldTypeString},
+			}},
+			{Tag: tag.PreviouslyReported, Name: "PreviouslyReported", Type: FieldTypeBool},
+			{Tag: tag.MatchType, Name: "MatchType", Type: FieldTypeString},
+			{Tag: tag.TradingSessionSubID, Name: "TradingSessionSubID", Type: FieldTypeString},
+			{Tag: tag.AllocType, Name: "AllocType", Type: FieldTypeString, Required: true},
+			{Tag: tag.LegalConfirm, Name: "LegalConfirm", Type: FieldTypeBool},
+			{Tag: tag.BenchmarkPrice, Name: "BenchmarkPrice", Type: FieldTypeDecimal},
+			{Tag: tag.BenchmarkPriceType, Name: "BenchmarkPriceType", Type: FieldTypeInt},
+			{Tag: tag.ContractSettlMonth, Name: "ContractSettlMonth", Type: FieldTypeString},
+			{Tag: tag.DeliveryForm, Name: "DeliveryForm", Type: FieldTypeString},
+			{Tag: tag.Pool, Name: "Pool", Type: FieldTypeString},
+			{Tag: tag.YieldRedemptionDate, Name: "YieldRedemptionDate", Type: FieldTypeString},
+			{Tag: tag.YieldRedemptionPrice, Name: "YieldRedemptionPrice", Type: FieldTypeDecimal},
+			{Tag: tag.YieldRedemptionPriceType, Name: "YieldRedemptionPriceType", Type: FieldTypeInt},
+			{Tag: tag.BenchmarkSecurityID, Name: "BenchmarkSecurityID", Type: FieldTypeString},
+			{Tag: tag.ReversalIndicator, Name: "ReversalIndicator", Type: FieldTypeBool},
+			{Tag: tag.YieldCalcDate, Name: "YieldCalcDate", Type: FieldTypeString},
+			{Tag: tag.NoUnderlyings, Name: "NoUnderlyings", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.UnderlyingSymbol, Name: "UnderlyingSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.UnderlyingSymbolSfx, Name: "UnderlyingSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityID, Name: "UnderlyingSecurityID", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityIDSource, Name: "UnderlyingSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoUnderlyingSecurityAltID, Name: "NoUnderlyingSecurityAltID", Type: FieldTypeNumInGroup, Fields: noUnderlyingSecurityAltIDFields},
+				{Tag: tag.UnderlyingProduct, Name: "UnderlyingProduct", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingCFICode, Name: "UnderlyingCFICode", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityType, Name: "UnderlyingSecurityType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecuritySubType, Name: "UnderlyingSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityMonthYear, Name: "UnderlyingMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityDate, Name: "UnderlyingMaturityDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCouponPaymentDate, Name: "UnderlyingCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssueDate, Name: "UnderlyingIssueDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRepoCollateralSecurityType, Name: "UnderlyingRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseTerm, Name: "UnderlyingRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseRate, Name: "UnderlyingRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingFactor, Name: "UnderlyingFactor", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCreditRating, Name: "UnderlyingCreditRating", Type: FieldTypeString},
+				{Tag: tag.UnderlyingInstrRegistry, Name: "UnderlyingInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCountryOfIssue, Name: "UnderlyingCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStateOrProvinceOfIssue, Name: "UnderlyingStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingLocaleOfIssue, Name: "UnderlyingLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRedemptionDate, Name: "UnderlyingRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStrikePrice, Name: "UnderlyingStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStrikeCurrency, Name: "UnderlyingStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingOptAttribute, Name: "UnderlyingOptAttribute", Type: FieldTypeString},
+				{Tag: tag.UnderlyingContractMultiplier, Name: "UnderlyingContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCouponRate, Name: "UnderlyingCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingSecurityExchange, Name: "UnderlyingSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssuer, Name: "UnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingIssuerLen, Name: "EncodedUnderlyingIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingIssuer, Name: "EncodedUnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityDesc, Name: "UnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingSecurityDescLen, Name: "EncodedUnderlyingSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingSecurityDesc, Name: "EncodedUnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPProgram, Name: "UnderlyingCPProgram", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPRegType, Name: "UnderlyingCPRegType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCurrency, Name: "UnderlyingCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingQty, Name: "UnderlyingQty", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingPx, Name: "UnderlyingPx", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingDirtyPrice, Name: "UnderlyingDirtyPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndPrice, Name: "UnderlyingEndPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStartValue, Name: "UnderlyingStartValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCurrentValue, Name: "UnderlyingCurrentValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndValue, Name: "UnderlyingEndValue", Type: FieldTypeDecimal},
+				{Tag: tag.NoUnderlyingStips, Name: "NoUnderlyingStips", Type: FieldTypeNumInGroup, Fields: noUnderlyingStipsFields},
+			}},
+			{Tag: tag.InterestAtMaturity, Name: "InterestAtMaturity", Type: FieldTypeDecimal},
+			{Tag: tag.AutoAcceptIndicator, Name: "AutoAcceptIndicator", Type: FieldTypeBool},
+			{Tag: tag.BenchmarkSecurityIDSource, Name: "BenchmarkSecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.SecuritySubType, Name: "SecuritySubType", Type: FieldTypeString},
+			{Tag: tag.BookingType, Name: "BookingType", Type: FieldTypeString},
+			{Tag: tag.TerminationType, Name: "TerminationType", Type: FieldTypeString},
+			{Tag: tag.SecondaryAllocID, Name: "SecondaryAllocID", Type: FieldTypeString},
+			{Tag: tag.AllocCancReplaceReason, Name: "AllocCancReplaceReason", Type: FieldTypeString},
+			{Tag: tag.AllocIntermedReqType, Name: "AllocIntermedReqType", Type: FieldTypeString},
+			{Tag: tag.QtyType, Name: "QtyType", Type: FieldTypeString},
+			{Tag: tag.AllocNoOrdersType, Name: "AllocNoOrdersType", Type: FieldTypeString, Required: true},
+			{Tag: tag.AvgParPx, Name: "AvgParPx", Type: FieldTypeDecimal},
+			{Tag: tag.NoEvents, Name: "NoEvents", Type: FieldTypeNumInGroup, Fields: noEventsFields},
+			{Tag: tag.PctAtRisk, Name: "PctAtRisk", Type: FieldTypeDecimal},
+			{Tag: tag.NoInstrAttrib, Name: "NoInstrAttrib", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.InstrAttribType, Name: "InstrAttribType", Type: FieldTypeString, Required: true},
+				{Tag: tag.InstrAttribValue, Name: "InstrAttribValue", Type: FieldTypeString},
+			}},
+			{Tag: tag.DatedDate, Name: "DatedDate", Type: FieldTypeString},
+			{Tag: tag.InterestAccrualDate, Name: "InterestAccrualDate", Type: FieldTypeString},
+			{Tag: tag.CPProgram, Name: "CPProgram", Type: FieldTypeString},
+			{Tag: tag.CPRegType, Name: "CPRegType", Type: FieldTypeString},
+			{Tag: tag.TotNoAllocs, Name: "TotNoAllocs", Type: FieldTypeInt},
+			{Tag: tag.LastFragment, Name: "LastFragment", Type: FieldTypeBool},
+			{Tag: tag.MarginRatio, Name: "MarginRatio", Type: FieldTypeDecimal},
+			{Tag: tag.AgreementDesc, Name: "AgreementDesc", Type: FieldTypeString},
+			{Tag: tag.AgreementID, Name: "AgreementID", Type: FieldTypeString},
+			{Tag: tag.AgreementDate, Name: "AgreementDate", Type: FieldTypeString},
+			{Tag: tag.StartDate, Name: "StartDate", Type: FieldTypeString},
+			{Tag: tag.EndDate, Name: "EndDate", Type: FieldTypeString},
+			{Tag: tag.AgreementCurrency, Name: "AgreementCurrency", Type: FieldTypeString},
+			{Tag: tag.DeliveryType, Name: "DeliveryType", Type: FieldTypeString},
+			{Tag: tag.EndAccruedInterestAmt, Name: "EndAccruedInterestAmt", Type: FieldTypeDecimal},
+			{Tag: tag.StartCash, Name: "StartCash", Type: FieldTypeDecimal},
+			{Tag: tag.EndCash, Name: "EndCash", Type: FieldTypeDecimal},
+			{Tag: tag.StrikeCurrency, Name: "StrikeCurrency", Type: FieldTypeString},
+		},
+	},
+	"P": {
+		Name:    "AllocationInstructionAck",
+		MsgType: "P",
+		Fields: []FieldDef{
+			{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+			{Tag: tag.TransactTime, Name: "TransactTime", Type: FieldTypeUTCTimestamp, Required: true},
+			{Tag: tag.AllocID, Name: "AllocID", Type: FieldTypeString, Required: true},
+			{Tag: tag.TradeDate, Name: "TradeDate", Type: FieldTypeString},
+			{Tag: tag.NoAllocs, Name: "NoAllocs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.AllocAccount, Name: "AllocAccount", Type: FieldTypeString, Required: true},
+				{Tag: tag.AllocAcctIDSource, Name: "AllocAcctIDSource", Type: FieldTypeInt},
+				{Tag: tag.AllocPrice, Name: "AllocPrice", Type: FieldTypeDecimal},
+				{Tag: tag.IndividualAllocID, Name: "IndividualAllocID", Type: FieldTypeString},
+				{Tag: tag.IndividualAllocRejCode, Name: "IndividualAllocRejCode", Type: FieldTypeInt},
+				{Tag: tag.AllocText, Name: "AllocText", Type: FieldTypeString},
+				{Tag: tag.EncodedAllocTextLen, Name: "EncodedAllocTextLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedAllocText, Name: "EncodedAllocText", Type: FieldTypeString},
+			}},
+			{Tag: tag.AllocStatus, Name: "AllocStatus", Type: FieldTypeString, Required: true},
+			{Tag: tag.AllocRejCode, Name: "AllocRejCode", Type: FieldTypeString},
+			{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+			{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			{Tag: tag.NoPartyIDs, Name: "NoPartyIDs", Type: FieldTypeNumInGroup, Fields: noPartyIDsFields},
+			{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+			{Tag: tag.MatchStatus, Name: "MatchStatus", Type: FieldTypeString},
+			{Tag: tag.AllocType, Name: "AllocType", Type: FieldTypeString},
+			{Tag: tag.SecondaryAllocID, Name: "SecondaryAllocID", Type: FieldTypeString},
+			{Tag: tag.AllocIntermedReqType, Name: "AllocIntermedReqType", Type: FieldTypeString},
+		},
+	},
+	"AS": {
+		Name:    "AllocationReport",
+		MsgType: "AS",
+		Fields: []FieldDef{
+			{Tag: tag.AvgPx, Name: "AvgPx", Type: FieldTypeDecimal, Required: true},
+			{Tag: tag.Currency, Name: "Currency", Type: FieldTypeString},
+			{Tag: tag.SecurityIDSource, Name: "SecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.LastMkt, Name: "LastMkt", Type: FieldTypeString},
+			{Tag: tag.SecurityID, Name: "SecurityID", Type: FieldTypeString},
+			{Tag: tag.Quantity, Name: "Quantity", Type: FieldTypeDecimal, Required: true},
+			{Tag: tag.Side, Name: "Side", Type: FieldTypeString, Required: true},
+			{Tag: tag.Symbol, Name: "Symbol", Type: FieldTypeString},
+			{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+			{Tag: tag.TransactTime, Name: "TransactTime", Type: FieldTypeUTCTimestamp},
+			{Tag: tag.SettlType, Name: "SettlType", Type: FieldTypeString},
+			{Tag: tag.SettlDate, Name: "SettlDate", Type: FieldTypeString},
+			{Tag: tag.SymbolSfx, Name: "SymbolSfx", Type: FieldTypeString},
+			{Tag: tag.AllocID, Name: "AllocID", Type: FieldTypeString},
+			{Tag: tag.AllocTransType, Name: "AllocTransType", Type: FieldTypeString, Required: true},
+			{Tag: tag.RefAllocID, Name: "RefAllocID", Type: FieldTypeString},
+			{Tag: tag.NoOrders, Name: "NoOrders", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.ClOrdID, Name: "ClOrdID", Type: FieldTypeString, Required: true},
+				{Tag: tag.OrderID, Name: "OrderID", Type: FieldTypeString},
+				{Tag: tag.SecondaryOrderID, Name: "SecondaryOrderID", Type: FieldTypeString},
+				{Tag: tag.SecondaryClOrdID, Name: "SecondaryClOrdID", Type: FieldTypeString},
+				{Tag: tag.ListID, Name: "ListID", Type: FieldTypeString},
+				{Tag: tag.NoNested2PartyIDs, Name: "NoNested2PartyIDs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+					{Tag: tag.Nested2PartyID, Name: "Nested2PartyID", Type: FieldTypeString, Required: true},
+					{Tag: tag.Nested2PartyIDSource, Name: "Nested2PartyIDSource", Type: FieldTypeString},
+					{Tag: tag.Nested2PartyRole, Name: "Nested2PartyRole", Type: FieldTypeInt},
+					{Tag: tag.NoNested2PartySubIDs, Name: "NoNested2PartySubIDs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+						{Tag: tag.Nested2PartySubID, Name: "Nested2PartySubID", Type: FieldTypeString, Required: true},
+						{Tag: tag.Nested2PartySubIDType, Name: "Nested2PartySubIDType", Type: FieldTypeInt},
+					}},
+				}},
+				{Tag: tag.OrderQty, Name: "OrderQty", Type: FieldTypeDecimal},
+				{Tag: tag.OrderAvgPx, Name: "OrderAvgPx", Type: FieldTypeDecimal},
+				{Tag: tag.OrderBookingQty, Name: "OrderBookingQty", Type: FieldTypeDecimal},
+			}},
+			{Tag: tag.AvgPxPrecision, Name: "AvgPxPrecision", Type: FieldTypeInt},
+			{Tag: tag.TradeDate, Name: "TradeDate", Type: FieldTypeString, Required: true},
+			{Tag: tag.PositionEffect, Name: "PositionEffect", Type: FieldTypeString},
+			{Tag: tag.NoAllocs, Name: "NoAllocs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.AllocAccount, Name: "AllocAccount", Type: FieldTypeString, Required: true},
+				{Tag: tag.AllocAcctIDSource, Name: "AllocAcctIDSource", Type: FieldTypeInt},
+				{Tag: tag.MatchStatus, Name: "MatchStatus", Type: FieldTypeString},
+				{Tag: tag.AllocPrice, Name: "AllocPrice", Type: FieldTypeDecimal},
+				{Tag: tag.AllocQty, Name: "AllocQty", Type: FieldTypeDecimal},
+				{Tag: tag.IndividualAllocID, Name: "IndividualAllocID", Type: FieldTypeString},
+				{Tag: tag.ProcessCode, Name: "ProcessCode", Type: FieldTypeString},
+				{Tag: tag.NoNestedPartyIDs, Name: "NoNestedPartyIDs", Type: FieldTypeNumInGroup, Fields: noNestedPartyIDsFields},
+				{Tag: tag.NotifyBrokerOfCredit, Name: "NotifyBrokerOfCredit", Type: FieldTypeBool},
+				{Tag: tag.AllocHandlInst, Name: "AllocHandlInst", Type: FieldTypeString},
+				{Tag: tag.AllocText, Name: "AllocText", Type: FieldTypeString},
+				{Tag: tag.EncodedAllocTextLen, Name: "EncodedAllocTextLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedAllocText, Name: "EncodedAllocText", Type: FieldTypeString},
+				{Tag: tag.Commission, Name: "Commission", Type: FieldTypeDecimal},
+				{Tag: tag.CommType, Name: "CommType", Type: FieldTypeString},
+				{Tag: tag.CommCurrency, Name: "CommCurrency", Type: FieldTypeString},
+				{Tag: tag.FundRenewWaiv, Name: "FundRenewWaiv", Type: FieldTypeString},
+				{Tag: tag.AllocAvgPx, Name: "AllocAvgPx", Type: FieldTypeDecimal},
+				{Tag: tag.AllocNetMoney, Name: "AllocNetMoney", Type: FieldTypeDecimal},
+				{Tag: tag.SettlCurrAmt, Name: "SettlCurrAmt", Type: FieldTypeDecimal},
+				{Tag: tag.AllocSettlCurrAmt, Name: "AllocSettlCurrAmt", Type: FieldTypeDecimal},
+				{Tag: tag.SettlCurrency, Name: "SettlCurrency", Type: FieldTypeString},
+				{Tag: tag.AllocSettlCurrency, Name: "AllocSettlCurrency", Type: FieldTypeString},
+				{Tag: tag.SettlCurrFxRate, Name: "SettlCurrFxRate", Type: FieldTypeDecimal},
+				{Tag: tag.SettlCurrFxRateCalc, Name: "SettlCurrFxRateCalc", Type: FieldTypeString},
+				{Tag: tag.AllocAccruedInterestAmt, Name: "AllocAccruedInterestAmt", Type: FieldTypeDecimal},
+				{Tag: tag.AllocInterestAtMaturity, Name: "AllocInterestAtMaturity", Type: FieldTypeDecimal},
+				{Tag: tag.NoMiscFees, Name: "NoMiscFees", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+					{Tag: tag.MiscFeeAmt, Name: "MiscFeeAmt", Type: FieldTypeDecimal, Required: true},
+					{Tag: tag.MiscFeeCurr, Name: "MiscFeeCurr", Type: FieldTypeString},
+					{Tag: tag.MiscFeeType, Name: "MiscFeeType", Type: FieldTypeString},
+					{Tag: tag.MiscFeeBasis, Name: "MiscFeeBasis", Type: FieldTypeString},
+				}},
+				{Tag: tag.NoClearingInstructions, Name: "NoClearingInstructions", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+					{Tag: tag.ClearingInstruction, Name: "ClearingInstruction", Type: FieldTypeString, Required: true},
+				}},
+				{Tag: tag.ClearingFeeIndicator, Name: "ClearingFeeIndicator", Type: FieldTypeString},
+				{Tag: tag.AllocSettlInstType, Name: "AllocSettlInstType", Type: FieldTypeString},
+				{Tag: tag.SettlDeliveryType, Name: "SettlDeliveryType", Type: FieldTypeString},
+				{Tag: tag.StandInstDbType, Name: "StandInstDbType", Type: FieldTypeString},
+				{Tag: tag.StandInstDbName, Name: "StandInstDbName", Type: FieldTypeString},
+				{Tag: tag.StandInstDbID, Name: "StandInstDbID", Type: FieldTypeString},
+				{Tag: tag.NoDlvyInst, Name: "NoDlvyInst", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+					{Tag: tag.SettlInstSource, Name: "SettlInstSource", Type: FieldTypeString, Required: true},
+					{Tag: tag.DlvyInstType, Name: "DlvyInstType", Type: FieldTypeString},
+					{Tag: tag.NoSettlPartyIDs, Name: "NoSettlPartyIDs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+						{Tag: tag.SettlPartyID, Name: "SettlPartyID", Type: FieldTypeString, Required: true},
+						{Tag: tag.SettlPartyIDSource, Name: "SettlPartyIDSource", Type: FieldTypeString},
+						{Tag: tag.SettlPartyRole, Name: "SettlPartyRole", Type: FieldTypeInt},
+						{Tag: tag.NoSettlPartySubIDs, Name: "NoSettlPartySubIDs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+							{Tag: tag.SettlPartySubID, Name: "SettlPartySubID", Type: FieldTypeString, Required: true},
+							{Tag: tag.SettlPartySubIDType, Name: "SettlPartySubIDType", Type: FieldTypeInt},
+						}},
+					}},
+				}},
+			}},
+			{Tag: tag.AllocStatus, Name: "AllocStatus", Type: FieldTypeString, Required: true},
+			{Tag: tag.AllocRejCode, Name: "AllocRejCode", Type: FieldTypeString},
+			{Tag: tag.Issuer, Name: "Issuer", Type: FieldTypeString},
+			{Tag: tag.SecurityDesc, Name: "SecurityDesc", Type: FieldTypeString},
+			{Tag: tag.NetMoney, Name: "NetMoney", Type: FieldTypeDecimal},
+			{Tag: tag.NoExecs, Name: "NoExecs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.LastQty, Name: "LastQty", Type: FieldTypeDecimal, Required: true},
+				{Tag: tag.ExecID, Name: "ExecID", Type: FieldTypeString},
+				{Tag: tag.SecondaryExecID, Name: "SecondaryExecID", Type: FieldTypeString},
+				{Tag: tag.LastPx, Name: "LastPx", Type: FieldTypeDecimal},
+				{Tag: tag.LastParPx, Name: "LastParPx", Type: FieldTypeDecimal},
+				{Tag: tag.LastCapacity, Name: "LastCapacity", Type: FieldTypeString},
+			}},
+			{Tag: tag.NumDaysInterest, Name: "NumDaysInterest", Type: FieldTypeInt},
+			{Tag: tag.AccruedInterestRate, Name: "AccruedInterestRate", Type: FieldTypeDecimal},
+			{Tag: tag.AccruedInterestAmt, Name: "AccruedInterestAmt", Type: FieldTypeDecimal},
+			{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+			{Tag: tag.AllocLinkID, Name: "AllocLinkID", Type: FieldTypeString},
+			{Tag: tag.AllocLinkType, Name: "AllocLinkType", Type: FieldTypeString},
+			{Tag: tag.MaturityMonthYear, Name: "MaturityMonthYear", Type: FieldTypeString},
+			{Tag: tag.StrikePrice, Name: "StrikePrice", Type: FieldTypeDecimal},
+			{Tag: tag.OptAttribute, Name: "OptAttribute", Type: FieldTypeString},
+			{Tag: tag.SecurityExchange, Name: "SecurityExchange", Type: FieldTypeString},
+			{Tag: tag.Spread, Name: "Spread", Type: FieldTypeDecimal},
+			{Tag: tag.BenchmarkCurveCurrency, Name: "BenchmarkCurveCurrency", Type: FieldTypeString},
+			{Tag: tag.BenchmarkCurveName, Name: "BenchmarkCurveName", Type: FieldTypeString},
+			{Tag: tag.BenchmarkCurvePoint, Name: "BenchmarkCurvePoint", Type: FieldTypeString},
+			{Tag: tag.CouponRate, Name: "CouponRate", Type: FieldTypeDecimal},
+			{Tag: tag.CouponPaymentDate, Name: "CouponPaymentDate", Type: FieldTypeString},
+			{Tag: tag.IssueDate, Name: "IssueDate", Type: FieldTypeString},
+			{Tag: tag.RepurchaseTerm, Name: "RepurchaseTerm", Type: FieldTypeInt},
+			{Tag: tag.RepurchaseRate, Name: "RepurchaseRate", Type: FieldTypeDecimal},
+			{Tag: tag.Factor, Name: "Factor", Type: FieldTypeDecimal},
+			{Tag: tag.TradeOriginationDate, Name: "TradeOriginationDate", Type: FieldTypeString},
+			{Tag: tag.ContractMultiplier, Name: "ContractMultiplier", Type: FieldTypeDecimal},
+			{Tag: tag.NoStipulations, Name: "NoStipulations", Type: FieldTypeNumInGroup, Fields: noStipulationsFields},
+			{Tag: tag.YieldType, Name: "YieldType", Type: FieldTypeString},
+			{Tag: tag.Yield, Name: "Yield", Type: FieldTypeDecimal},
+			{Tag: tag.TotalTakedown, Name: "TotalTakedown", Type: FieldTypeDecimal},
+			{Tag: tag.Concession, Name: "Concession", Type: FieldTypeDecimal},
+			{Tag: tag.RepoCollateralSecurityType, Name: "RepoCollateralSecurityType", Type: FieldTypeInt},
+			{Tag: tag.RedemptionDate, Name: "RedemptionDate", Type: FieldTypeString},
+			{Tag: tag.CreditRating, Name: "CreditRating", Type: FieldTypeString},
+			{Tag: tag.TradingSessionID, Name: "TradingSessionID", Type: FieldTypeString},
+			{Tag: tag.EncodedIssuerLen, Name: "EncodedIssuerLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedIssuer, Name: "EncodedIssuer", Type: FieldTypeString},
+			{Tag: tag.EncodedSecurityDescLen, Name: "EncodedSecurityDescLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedSecurityDesc, Name: "EncodedSecurityDesc", Type: FieldTypeString},
+			{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			{Tag: tag.GrossTradeAmt, Name: "GrossTradeAmt", Type: FieldTypeDecimal},
+			{Tag: tag.PriceType, Name: "PriceType", Type: FieldTypeString},
+			{Tag: tag.NoPartyIDs, Name: "NoPartyIDs", Type: FieldTypeNumInGroup, Fields: noPartyIDsFields},
+			{Tag: tag.NoSecurityAltID, Name: "NoSecurityAltID", Type: FieldTypeNumInGroup, Fields: noSecurityAltIDFields},
+			{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+			{Tag: tag.CFICode, Name: "CFICode", Type: FieldTypeString},
+			{Tag: tag.BookingRefID, Name: "BookingRefID", Type: FieldTypeString},
+			{Tag: tag.CountryOfIssue, Name: "CountryOfIssue", Type: FieldTypeString},
+			{Tag: tag.StateOrProvinceOfIssue, Name: "StateOrProvinceOfIssue", Type: FieldTypeString},
+			{Tag: tag.LocaleOfIssue, Name: "LocaleOfIssue", Type: FieldTypeString},
+			{Tag: tag.TotalAccruedInterestAmt, Name: "TotalAccruedInterestAmt", Type: FieldTypeDecimal},
+			{Tag: tag.MaturityDate, Name: "MaturityDate", Type: FieldTypeString},
+			{Tag: tag.InstrRegistry, Name: "InstrRegistry", Type: FieldTypeString},
+			{Tag: tag.NoLegs, Name: "NoLegs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.LegSymbol, Name: "LegSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.LegSymbolSfx, Name: "LegSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.LegSecurityID, Name: "LegSecurityID", Type: FieldTypeString},
+				{Tag: tag.LegSecurityIDSource, Name: "LegSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoLegSecurityAltID, Name: "NoLegSecurityAltID", Type: FieldTypeNumInGroup, Fields: noLegSecurityAltIDFields},
+				{Tag: tag.LegProduct, Name: "LegProduct", Type: FieldTypeInt},
+				{Tag: tag.LegCFICode, Name: "LegCFICode", Type: FieldTypeString},
+				{Tag: tag.LegSecurityType, Name: "LegSecurityType", Type: FieldTypeString},
+				{Tag: tag.LegSecuritySubType, Name: "LegSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.LegMaturityMonthYear, Name: "LegMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.LegMaturityDate, Name: "LegMaturityDate", Type: FieldTypeString},
+				{Tag: tag.LegCouponPaymentDate, Name: "LegCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.LegIssueDate, Name: "LegIssueDate", Type: FieldTypeString},
+				{Tag: tag.LegRepoCollateralSecurityType, Name: "LegRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseTerm, Name: "LegRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseRate, Name: "LegRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegFactor, Name: "LegFactor", Type: FieldTypeDecimal},
+				{Tag: tag.LegCreditRating, Name: "LegCreditRating", Type: FieldTypeString},
+				{Tag: tag.LegInstrRegistry, Name: "LegInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.LegCountryOfIssue, Name: "LegCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegStateOrProvinceOfIssue, Name: "LegStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegLocaleOfIssue, Name: "LegLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegRedemptionDate, Name: "LegRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.LegStrikePrice, Name: "LegStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.LegStrikeCurrency, Name: "LegStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.LegOptAttribute, Name: "LegOptAttribute", Type: FieldTypeString},
+				{Tag: tag.LegContractMultiplier, Name: "LegContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.LegCouponRate, Name: "LegCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegSecurityExchange, Name: "LegSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.LegIssuer, Name: "LegIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedLegIssuerLen, Name: "EncodedLegIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegIssuer, Name: "EncodedLegIssuer", Type: FieldTypeString},
+				{Tag: tag.LegSecurityDesc, Name: "LegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedLegSecurityDescLen, Name: "EncodedLegSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegSecurityDesc, Name: "EncodedLegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.LegRatioQty, Name: "LegRatioQty", Type: FieldTypeDecimal},
+				{Tag: tag.LegSide, Name: "LegSide", Type: FieldTypeString},
+				{Tag: tag.LegCurrency, Name: "LegCurrency", Type: FieldTypeString},
+				{Tag: tag.LegPool, Name: "LegPool", Type: FieldTypeString},
+				{Tag: tag.LegDatedDate, Name: "LegDatedDate", Type: FieldTypeString},
+				{Tag: tag.LegContractSettlMonth, Name: "LegContractSettlMonth", Type: FieldTypeString},
+				{Tag: tag.LegInterestAccrualDate, Name: "LegInterestAccrualDate", Type: FieldTypeString},
+			}},
+			{Tag: tag.PreviouslyReported, Name: "PreviouslyReported", Type: FieldTypeBool},
+			{Tag: tag.MatchType, Name: "MatchType", Type: FieldTypeString},
+			{Tag: tag.TradingSessionSubID, Name: "TradingSessionSubID", Type: FieldTypeString},
+			{Tag: tag.LegalConfirm, Name: "LegalConfirm", Type: FieldTypeBool},
+			{Tag: tag.BenchmarkPrice, Name: "BenchmarkPrice", Type: FieldTypeDecimal},
+			{Tag: tag.BenchmarkPriceType, Name: "BenchmarkPriceType", Type: FieldTypeInt},
+			{Tag: tag.ContractSettlMonth, Name: "ContractSettlMonth", Type: FieldTypeString},
+			{Tag: tag.DeliveryForm, Name: "DeliveryForm", Type: FieldTypeString},
+			{Tag: tag.Pool, Name: "Pool", Type: FieldTypeString},
+			{Tag: tag.YieldRedemptionDate, Name: "YieldRedemptionDate", Type: FieldTypeString},
+			{Tag: tag.YieldRedemptionPrice, Name: "YieldRedemptionPrice", Type: FieldTypeDecimal},
+			{Tag: tag.YieldRedemptionPriceType, Name: "YieldRedemptionPriceType", Type: FieldTypeInt},
+			{Tag: tag.BenchmarkSecurityID, Name: "BenchmarkSecurityID", Type: FieldTypeString},
+			{Tag: tag.ReversalIndicator, Name: "ReversalIndicator", Type: FieldTypeBool},
+			{Tag: tag.YieldCalcDate, Name: "YieldCalcDate", Type: FieldTypeString},
+			{Tag: tag.NoUnderlyings, Name: "NoUnderlyings", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.UnderlyingSymbol, Name: "UnderlyingSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.UnderlyingSymbolSfx, Name: "UnderlyingSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityID, Name: "UnderlyingSecurityID", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityIDSource, Name: "UnderlyingSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoUnderlyingSecurityAltID, Name: "NoUnderlyingSecurityAltID", Type: FieldTypeNumInGroup, Fields: noUnderlyingSecurityAltIDFields},
+				{Tag: tag.UnderlyingProduct, Name: "UnderlyingProduct", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingCFICode, Name: "UnderlyingCFICode", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityType, Name: "UnderlyingSecurityType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecuritySubType, Name: "UnderlyingSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityMonthYear, Name: "UnderlyingMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityDate, Name: "UnderlyingMaturityDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCouponPaymentDate, Name: "UnderlyingCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssueDate, Name: "UnderlyingIssueDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRepoCollateralSecurityType, Name: "UnderlyingRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseTerm, Name: "UnderlyingRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseRate, Name: "UnderlyingRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingFactor, Name: "UnderlyingFactor", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCreditRating, Name: "UnderlyingCreditRating", Type: FieldTypeString},
+				{Tag: tag.UnderlyingInstrRegistry, Name: "UnderlyingInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCountryOfIssue, Name: "UnderlyingCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStateOrProvinceOfIssue, Name: "UnderlyingStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingLocaleOfIssue, Name: "UnderlyingLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRedemptionDate, Name: "UnderlyingRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStrikePrice, Name: "UnderlyingStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStrikeCurrency, Name: "UnderlyingStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingOptAttribute, Name: "UnderlyingOptAttribute", Type: FieldTypeString},
+				{Tag: tag.UnderlyingContractMultiplier, Name: "UnderlyingContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCouponRate, Name: "UnderlyingCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingSecurityExchange, Name: "UnderlyingSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssuer, Name: "UnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingIssuerLen, Name: "EncodedUnderlyingIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingIssuer, Name: "EncodedUnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityDesc, Name: "UnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingSecurityDescLen, Name: "EncodedUnderlyingSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingSecurityDesc, Name: "EncodedUnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPProgram, Name: "UnderlyingCPProgram", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPRegType, Name: "UnderlyingCPRegType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCurrency, Name: "UnderlyingCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingQty, Name: "UnderlyingQty", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingPx, Name: "UnderlyingPx", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingDirtyPrice, Name: "UnderlyingDirtyPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndPrice, Name: "UnderlyingEndPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStartValue, Name: "UnderlyingStartValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCurrentValue, Name: "UnderlyingCurrentValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndValue, Name: "UnderlyingEndValue", Type: FieldTypeDecimal},
+				{Tag: tag.NoUnderlyingStips, Name: "NoUnderlyingStips", Type: FieldTypeNumInGroup, Fields: noUnderlyingStipsFields},
+			}},
+			{Tag: tag.InterestAtMaturity, Name: "InterestAtMaturity", Type: FieldTypeDecimal},
+			{Tag: tag.AutoAcceptIndicator, Name: "AutoAcceptIndicator", Type: FieldTypeBool},
+			{Tag: tag.AllocReportID, Name: "AllocReportID", Type: FieldTypeString, Required: true},
+			{Tag: tag.BenchmarkSecurityIDSource, Name: "BenchmarkSecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.SecuritySubType, Name: "SecuritySubType", Type: FieldTypeString},
+			{Tag: tag.BookingType, Name: "BookingType", Type: FieldTypeString},
+			{Tag: tag.TerminationType, Name: "TerminationType", Type: FieldTypeString},
+			{Tag: tag.SecondaryAllocID, Name: "SecondaryAllocID", Type: FieldTypeString},
+			{Tag: tag.AllocReportType, Name: "AllocReportType", Type: FieldTypeString, Required: true},
+			{Tag: tag.AllocReportRefID, Name: "AllocReportRefID", Type: FieldTypeString},
+			{Tag: tag.AllocCancReplaceReason, Name: "AllocCancReplaceReason", Type: FieldTypeString},
+			{Tag: tag.AllocIntermedReqType, Name: "AllocIntermedReqType", Type: FieldTypeString},
+			{Tag: tag.QtyType, Name: "QtyType", Type: FieldTypeString},
+			{Tag: tag.AllocNoOrdersType, Name: "AllocNoOrdersType", Type: FieldTypeString, Required: true},
+			{Tag: tag.AvgParPx, Name: "AvgParPx", Type: FieldTypeDecimal},
+			{Tag: tag.NoEvents, Name: "NoEvents", Type: FieldTypeNumInGroup, Fields: noEventsFields},
+			{Tag: tag.PctAtRisk, Name: "PctAtRisk", Type: FieldTypeDecimal},
+			{Tag: tag.NoInstrAttrib, Name: "NoInstrAttrib", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.InstrAttribType, Name: "InstrAttribType", Type: FieldTypeString, Required: true},
+				{Tag: tag.InstrAttribValue, Name: "InstrAttribValue", Type: FieldTypeString},
+			}},
+			{Tag: tag.DatedDate, Name: "DatedDate", Type: FieldTypeString},
+			{Tag: tag.InterestAccrualDate, Name: "InterestAccrualDate", Type: FieldTypeString},
+			{Tag: tag.CPProgram, Name: "CPProgram", Type: FieldTypeString},
+			{Tag: tag.CPRegType, Name: "CPRegType", Type: FieldTypeString},
+			{Tag: tag.TotNoAllocs, Name: "TotNoAllocs", Type: FieldTypeInt},
+			{Tag: tag.LastFragment, Name: "LastFragment", Type: FieldTypeBool},
+			{Tag: tag.MarginRatio, Name: "MarginRatio", Type: FieldTypeDecimal},
+			{Tag: tag.AgreementDesc, Name: "AgreementDesc", Type: FieldTypeString},
+			{Tag: tag.AgreementID, Name: "AgreementID", Type: FieldTypeString},
+			{Tag: tag.AgreementDate, Name: "AgreementDate", Type: FieldTypeString},
+			{Tag: tag.StartDate, Name: "StartDate", Type: FieldTypeString},
+			{Tag: tag.EndDate, Name: "EndDate", Type: FieldTypeString},
+			{Tag: tag.AgreementCurrency, Name: "AgreementCurrency", Type: FieldTypeString},
+			{Tag: tag.DeliveryType, Name: "DeliveryType", Type: FieldTypeString},
+			{Tag: tag.EndAccruedInterestAmt, Name: "EndAccruedInterestAmt", Type: FieldTypeDecimal},
+			{Tag: tag.StartCash, Name: "StartCash", Type: FieldTypeDecimal},
+			{Tag: tag.EndCash, Name: "EndCash", Type: FieldTypeDecimal},
+			{Tag: tag.StrikeCurrency, Name: "StrikeCurrency", Type: FieldTypeString},
+		},
+	},
+	"AT": {
+		Name:    "AllocationReportAck",
+		MsgType: "AT",
+		Fields: []FieldDef{
+			{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+			{Tag: tag.TransactTime, Name: "TransactTime", Type: FieldTypeUTCTimestamp, Required: true},
+			{Tag: tag.AllocID, Name: "AllocID", Type: FieldTypeString, Required: true},
+			{Tag: tag.TradeDate, Name: "TradeDate", Type: FieldTypeString},
+			{Tag: tag.NoAllocs, Name: "NoAllocs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.AllocAccount, Name: "AllocAccount", Type: FieldTypeString, Required: true},
+				{Tag: tag.AllocAcctIDSource, Name: "AllocAcctIDSource", Type: FieldTypeInt},
+				{Tag: tag.AllocPrice, Name: "AllocPrice", Type: FieldTypeDecimal},
+				{Tag: tag.IndividualAllocID, Name: "IndividualAllocID", Type: FieldTypeString},
+				{Tag: tag.IndividualAllocRejCode, Name: "IndividualAllocRejCode", Type: FieldTypeInt},
+				{Tag: tag.AllocText, Name: "AllocText", Type: FieldTypeString},
+				{Tag: tag.EncodedAllocTextLen, Name: "EncodedAllocTextLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedAllocText, Name: "EncodedAllocText", Type: FieldTypeString},
+			}},
+			{Tag: tag.AllocStatus, Name: "AllocStatus", Type: FieldTypeString, Required: true},
+			{Tag: tag.AllocRejCode, Name: "AllocRejCode", Type: FieldTypeString},
+			{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+			{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			{Tag: tag.NoPartyIDs, Name: "NoPartyIDs", Type: FieldTypeNumInGroup, Fields: noPartyIDsFields},
+			{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+			{Tag: tag.MatchStatus, Name: "MatchStatus", Type: FieldTypeString},
+			{Tag: tag.AllocReportID, Name: "AllocReportID", Type: FieldTypeString, Required: true},
+			{Tag: tag.SecondaryAllocID, Name: "SecondaryAllocID", Type: FieldTypeString},
+			{Tag: tag.AllocReportType, Name: "AllocReportType", Type: FieldTypeString},
+			{Tag: tag.AllocIntermedReqType, Name: "AllocIntermedReqType", Type: FieldTypeString},
+		},
+	},
+	"AW": {
+		Name:    "AssignmentReport",
+		MsgType: "AW",
+		Fields: []FieldDef{
+			{Tag: tag.Account, Name: "Account", Type: FieldTypeString},
+			{Tag: tag.Currency, Name: "Currency", Type: FieldTypeString},
+			{Tag: tag.SecurityIDSource, Name: "SecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.SecurityID, Name: "SecurityID", Type: FieldTypeString},
+			{Tag: tag.Symbol, Name: "Symbol", Type: FieldTypeString},
+			{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+			{Tag: tag.SymbolSfx, Name: "SymbolSfx", Type: FieldTypeString},
+			{Tag: tag.Issuer, Name: "Issuer", Type: FieldTypeString},
+			{Tag: tag.SecurityDesc, Name: "SecurityDesc", Type: FieldTypeString},
+			{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+			{Tag: tag.MaturityMonthYear, Name: "MaturityMonthYear", Type: FieldTypeString},
+			{Tag: tag.StrikePrice, Name: "StrikePrice", Type: FieldTypeDecimal},
+			{Tag: tag.OptAttribute, Name: "OptAttribute", Type: FieldTypeString},
+			{Tag: tag.SecurityExchange, Name: "SecurityExchange", Type: FieldTypeString},
+			{Tag: tag.CouponRate, Name: "CouponRate", Type: FieldTypeDecimal},
+			{Tag: tag.CouponPaymentDate, Name: "CouponPaymentDate", Type: FieldTypeString},
+			{Tag: tag.IssueDate, Name: "IssueDate", Type: FieldTypeString},
+			{Tag: tag.RepurchaseTerm, Name: "RepurchaseTerm", Type: FieldTypeInt},
+			{Tag: tag.RepurchaseRate, Name: "RepurchaseRate", Type: FieldTypeDecimal},
+			{Tag: tag.Factor, Name: "Factor", Type: FieldTypeDecimal},
+			{Tag: tag.ContractMultiplier, Name: "ContractMultiplier", Type: FieldTypeDecimal},
+			{Tag: tag.RepoCollateralSecurityType, Name: "RepoCollateralSecurityType", Type: FieldTypeInt},
+			{Tag: tag.RedemptionDate, Name: "RedemptionDate", Type: FieldTypeString},
+			{Tag: tag.CreditRating, Name: "CreditRating", Type: FieldTypeString},
+			{Tag: tag.EncodedIssuerLen, Name: "EncodedIssuerLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedIssuer, Name: "EncodedIssuer", Type: FieldTypeString},
+			{Tag: tag.EncodedSecurityDescLen, Name: "EncodedSecurityDescLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedSecurityDesc, Name: "EncodedSecurityDesc", Type: FieldTypeString},
+			{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			{Tag: tag.ExpireDate, Name: "ExpireDate", Type: FieldTypeString},
+			{Tag: tag.NoPartyIDs, Name: "NoPartyIDs", Type: FieldTypeNumInGroup, Fields: noPartyIDsFields},
+			{Tag: tag.NoSecurityAltID, Name: "NoSecurityAltID", Type: FieldTypeNumInGroup, Fields: noSecurityAltIDFields},
+			{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+			{Tag: tag.CFICode, Name: "CFICode", Type: FieldTypeString},
+			{Tag: tag.CountryOfIssue, Name: "CountryOfIssue", Type: FieldTypeString},
+			{Tag: tag.StateOrProvinceOfIssue, Name: "StateOrProvinceOfIssue", Type: FieldTypeString},
+			{Tag: tag.LocaleOfIssue, Name: "LocaleOfIssue", Type: FieldTypeString},
+			{Tag: tag.MaturityDate, Name: "MaturityDate", Type: FieldTypeString},
+			{Tag: tag.InstrRegistry, Name: "InstrRegistry", Type: FieldTypeString},
+			{Tag: tag.NoLegs, Name: "NoLegs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.LegSymbol, Name: "LegSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.LegSymbolSfx, Name: "LegSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.LegSecurityID, Name: "LegSecurityID", Type: FieldTypeString},
+				{Tag: tag.LegSecurityIDSource, Name: "LegSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoLegSecurityAltID, Name: "NoLegSecurityAltID", Type: FieldTypeNumInGroup, Fields: noLegSecurityAltIDFields},
+				{Tag: tag.LegProduct, Name: "LegProduct", Type: FieldTypeInt},
+				{Tag: tag.LegCFICode, Name: "LegCFICode", Type: FieldTypeString},
+				{Tag: tag.LegSecurityType, Name: "LegSecurityType", Type: FieldTypeString},
+				{Tag: tag.LegSecuritySubType, Name: "LegSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.LegMaturityMonthYear, Name: "LegMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.LegMaturityDate, Name: "LegMaturityDate", Type: FieldTypeString},
+				{Tag: tag.LegCouponPaymentDate, Name: "LegCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.LegIssueDate, Name: "LegIssueDate", Type: FieldTypeString},
+				{Tag: tag.LegRepoCollateralSecurityType, Name: "LegRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseTerm, Name: "LegRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseRate, Name: "LegRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegFactor, Name: "LegFactor", Type: FieldTypeDecimal},
+				{Tag: tag.LegCreditRating, Name: "LegCreditRating", Type: FieldTypeString},
+				{Tag: tag.LegInstrRegistry, Name: "LegInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.LegCountryOfIssue, Name: "LegCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegStateOrProvinceOfIssue, Name: "LegStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegLocaleOfIssue, Name: "LegLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegRedemptionDate, Name: "LegRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.LegStrikePrice, Name: "LegStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.LegStrikeCurrency, Name: "LegStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.LegOptAttribute, Name: "LegOptAttribute", Type: FieldTypeString},
+				{Tag: tag.LegContractMultiplier, Name: "LegContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.LegCouponRate, Name: "LegCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegSecurityExchange, Name: "LegSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.LegIssuer, Name: "LegIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedLegIssuerLen, Name: "EncodedLegIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegIssuer, Name: "EncodedLegIssuer", Type: FieldTypeString},
+				{Tag: tag.LegSecurityDesc, Name: "LegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedLegSecurityDescLen, Name: "EncodedLegSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegSecurityDesc, Name: "EncodedLegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.LegRatioQty, Name: "LegRatioQty", Type: FieldTypeDecimal},
+				{Tag: tag.LegSide, Name: "LegSide", Type: FieldTypeString},
+				{Tag: tag.LegCurrency, Name: "LegCurrency", Type: FieldTypeString},
+				{Tag: tag.LegPool, Name: "LegPool", Type: FieldTypeString},
+				{Tag: tag.LegDatedDate, Name: "LegDatedDate", Type: FieldTypeString},
+				{Tag: tag.LegContractSettlMonth, Name: "LegContractSettlMonth", Type: FieldTypeString},
+				{Tag: tag.LegInterestAccrualDate, Name: "LegInterestAccrualDate", Type: FieldTypeString},
+			}},
+			{Tag: tag.AccountType, Name: "AccountType", Type: FieldTypeString, Required: true},
+			{Tag: tag.ContractSettlMonth, Name: "ContractSettlMonth", Type: FieldTypeString},
+			{Tag: tag.Pool, Name: "Pool", Type: FieldTypeString},
+			{Tag: tag.NoPositions, Name: "NoPositions", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.PosType, Name: "PosType", Type: FieldTypeString, Required: true},
+				{Tag: tag.LongQty, Name: "LongQty", Type: FieldTypeDecimal},
+				{Tag: tag.ShortQty, Name: "ShortQty", Type: FieldTypeDecimal},
+				{Tag: tag.PosQtyStatus, Name: "PosQtyStatus", Type: FieldTypeString},
+				{Tag: tag.NoNestedPartyIDs, Name: "NoNestedPartyIDs", Type: FieldTypeNumInGroup, Fields: noNestedPartyIDsFields},
+			}},
+			{Tag: tag.NoUnderlyings, Name: "NoUnderlyings", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.UnderlyingSymbol, Name: "UnderlyingSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.UnderlyingSymbolSfx, Name: "UnderlyingSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityID, Name: "UnderlyingSecurityID", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityIDSource, Name: "UnderlyingSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoUnderlyingSecurityAltID, Name: "NoUnderlyingSecurityAltID", Type: FieldTypeNumInGroup, Fields: noUnderlyingSecurityAltIDFields},
+				{Tag: tag.UnderlyingProduct, Name: "UnderlyingProduct", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingCFICode, Name: "UnderlyingCFICode", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityType, Name: "UnderlyingSecurityType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecuritySubType, Name: "UnderlyingSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityMonthYear, Name: "UnderlyingMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityDate, Name: "UnderlyingMaturityDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCouponPaymentDate, Name: "UnderlyingCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssueDate, Name: "UnderlyingIssueDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRepoCollateralSecurityType, Name: "UnderlyingRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseTerm, Name: "UnderlyingRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseRate, Name: "UnderlyingRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingFactor, Name: "UnderlyingFactor", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCreditRating, Name: "UnderlyingCreditRating", Type: FieldTypeString},
+				{Tag: tag.UnderlyingInstrRegistry, Name: "UnderlyingInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCountryOfIssue, Name: "UnderlyingCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStateOrProvinceOfIssue, Name: "UnderlyingStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingLocaleOfIssue, Name: "UnderlyingLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRedemptionDate, Name: "UnderlyingRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStrikePrice, Name: "UnderlyingStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStrikeCurrency, Name: "UnderlyingStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingOptAttribute, Name: "UnderlyingOptAttribute", Type: FieldTypeString},
+				{Tag: tag.UnderlyingContractMultiplier, Name: "UnderlyingContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCouponRate, Name: "UnderlyingCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingSecurityExchange, Name: "UnderlyingSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssuer, Name: "UnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingIssuerLen, Name: "EncodedUnderlyingIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingIssuer, Name: "EncodedUnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityDesc, Name: "UnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingSecurityDescLen, Name: "EncodedUnderlyingSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingSecurityDesc, Name: "EncodedUnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPProgram, Name: "UnderlyingCPProgram", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPRegType, Name: "UnderlyingCPRegType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCurrency, Name: "UnderlyingCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingQty, Name: "UnderlyingQty", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingPx, Name: "UnderlyingPx", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingDirtyPrice, Name: "UnderlyingDirtyPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndPrice, Name: "UnderlyingEndPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStartValue, Name: "UnderlyingStartValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCurrentValue, Name: "UnderlyingCurrentValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndValue, Name: "UnderlyingEndValue", Type: FieldTypeDecimal},
+				{Tag: tag.NoUnderlyingStips, Name: "NoUnderlyingStips", Type: FieldTypeNumInGroup, Fields: noUnderlyingStipsFields},
+			}},
+			{Tag: tag.ClearingBusinessDate, Name: "ClearingBusinessDate", Type: FieldTypeString, Required: true},
+			{Tag: tag.SettlSessID, Name: "SettlSessID", Type: FieldTypeString, Required: true},
+			{Tag: tag.SettlSessSubID, Name: "SettlSessSubID", Type: FieldTypeString, Required: true},
+			{Tag: tag.SettlPrice, Name: "SettlPrice", Type: FieldTypeDecimal, Required: true},
+			{Tag: tag.SettlPriceType, Name: "SettlPriceType", Type: FieldTypeString, Required: true},
+			{Tag: tag.UnderlyingSettlPrice, Name: "UnderlyingSettlPrice", Type: FieldTypeDecimal, Required: true},
+			{Tag: tag.AssignmentMethod, Name: "AssignmentMethod", Type: FieldTypeString, Required: true},
+			{Tag: tag.AssignmentUnit, Name: "AssignmentUnit", Type: FieldTypeDecimal},
+			{Tag: tag.OpenInterest, Name: "OpenInterest", Type: FieldTypeDecimal, Required: true},
+			{Tag: tag.ExerciseMethod, Name: "ExerciseMethod", Type: FieldTypeString, Required: true},
+			{Tag: tag.NoPosAmt, Name: "NoPosAmt", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.PosAmtType, Name: "PosAmtType", Type: FieldTypeString, Required: true},
+				{Tag: tag.PosAmt, Name: "PosAmt", Type: FieldTypeDecimal},
+			}},
+			{Tag: tag.SecuritySubType, Name: "SecuritySubType", Type: FieldTypeString},
+			{Tag: tag.TotNumAssignmentReports, Name: "TotNumAssignmentReports", Type: FieldTypeInt},
+			{Tag: tag.AsgnRptID, Name: "AsgnRptID", Type: FieldTypeString, Required: true},
+			{Tag: tag.ThresholdAmount, Name: "ThresholdAmount", Type: FieldTypeDecimal},
+			{Tag: tag.NoEvents, Name: "NoEvents", Type: FieldTypeNumInGroup, Fields: noEventsFields},
+			{Tag: tag.DatedDate, Name: "DatedDate", Type: FieldTypeString},
+			{Tag: tag.InterestAccrualDate, Name: "InterestAccrualDate", Type: FieldTypeString},
+			{Tag: tag.CPProgram, Name: "CPProgram", Type: FieldTypeString},
+			{Tag: tag.CPRegType, Name: "CPRegType", Type: FieldTypeString},
+			{Tag: tag.LastRptRequested, Name: "LastRptRequested", Type: FieldTypeBool},
+			{Tag: tag.StrikeCurrency, Name: "StrikeCurrency", Type: FieldTypeString},
+		},
+	},
+	"k": {
+		Name:    "BidRequest",
+		MsgType: "k",
+		Fields: []FieldDef{
+			{Tag: tag.Currency, Name: "Currency", Type: FieldTypeString},
+			{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+			{Tag: tag.TradeDate, Name: "TradeDate", Type: FieldTypeString},
+			{Tag: tag.ForexReq, Name: "ForexReq", Type: FieldTypeBool},
+			{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			{Tag: tag.BidRequestTransType, Name: "BidRequestTransType", Type: FieldTypeString, Required: true},
+			{Tag: tag.BidID, Name: "BidID", Type: FieldTypeString},
+			{Tag: tag.ClientBidID, Name: "ClientBidID", Type: FieldTypeString, Required: true},
+			{Tag: tag.ListName, Name: "ListName", Type: FieldTypeString},
+			{Tag: tag.TotNoRelatedSym, Name: "TotNoRelatedSym", Type: FieldTypeInt, Required: true},
+			{Tag: tag.BidType, Name: "BidType", Type: FieldTypeString, Required: true},
+			{Tag: tag.NumTickets, Name: "NumTickets", Type: FieldTypeInt},
+			{Tag: tag.SideValue1, Name: "SideValue1", Type: FieldTypeDecimal},
+			{Tag: tag.SideValue2, Name: "SideValue2", Type: FieldTypeDecimal},
+			{Tag: tag.NoBidDescriptors, Name: "NoBidDescriptors", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.BidDescriptorType, Name: "BidDescriptorType", Type: FieldTypeString, Required: true},
+				{Tag: tag.BidDescriptor, Name: "BidDescriptor", Type: FieldTypeString},
+				{Tag: tag.SideValueInd, Name: "SideValueInd", Type: FieldTypeString},
+				{Tag: tag.LiquidityValue, Name: "LiquidityValue", Type: FieldTypeDecimal},
+				{Tag: tag.LiquidityNumSecurities, Name: "LiquidityNumSecurities", Type: FieldTypeInt},
+				{Tag: tag.LiquidityPctLow, Name: "LiquidityPctLow", Type: FieldTypeDecimal},
+				{Tag: tag.LiquidityPctHigh, Name: "LiquidityPctHigh", Type: FieldTypeDecimal},
+				{Tag: tag.EFPTrackingError, Name: "EFPTrackingError", Type: FieldTypeDecimal},
+				{Tag: tag.FairValue, Name: "FairValue", Type: FieldTypeDecimal},
+				{Tag: tag.OutsideIndexPct, Name: "OutsideIndexPct", Type: FieldTypeDecimal},
+				{Tag: tag.ValueOfFutures, Name: "ValueOfFutures", Type: FieldTypeDecimal},
+			}},
+			{Tag: tag.LiquidityIndType, Name: "LiquidityIndType", Type: FieldTypeString},
+			{Tag: tag.WtAverageLiquidity, Name: "WtAverageLiquidity", Type: FieldTypeDecimal},
+			{Tag: tag.ExchangeForPhysical, Name: "ExchangeForPhysical", Type: FieldTypeBool},
+			{Tag: tag.OutMainCntryUIndex, Name: "OutMainCntryUIndex", Type: FieldTypeDecimal},
+			{Tag: tag.CrossPercent, Name: "CrossPercent", Type: FieldTypeDecimal},
+			{Tag: tag.ProgRptReqs, Name: "ProgRptReqs", Type: FieldTypeString},
+			{Tag: tag.ProgPeriodInterval, Name: "ProgPeriodInterval", Type: FieldTypeInt},
+			{Tag: tag.IncTaxInd, Name: "IncTaxInd", Type: FieldTypeString},
+			{Tag: tag.NumBidders, Name: "NumBidders", Type: FieldTypeInt},
+			{Tag: tag.BidTradeType, Name: "BidTradeType", Type: FieldTypeString, Required: true},
+			{Tag: tag.BasisPxType, Name: "BasisPxType", Type: FieldTypeString, Required: true},
+			{Tag: tag.NoBidComponents, Name: "NoBidComponents", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.ListID, Name: "ListID", Type: FieldTypeString, Required: true},
+				{Tag: tag.Side, Name: "Side", Type: FieldTypeString},
+				{Tag: tag.TradingSessionID, Name: "TradingSessionID", Type: FieldTypeString},
+				{Tag: tag.TradingSessionSubID, Name: "TradingSessionSubID", Type: FieldTypeString},
+				{Tag: tag.NetGrossInd, Name: "NetGrossInd", Type: FieldTypeString},
+				{Tag: tag.SettlType, Name: "SettlType", Type: FieldTypeString},
+				{Tag: tag.SettlDate, Name: "SettlDate", Type: FieldTypeString},
+				{Tag: tag.Account, Name: "Account", Type: FieldTypeString},
+				{Tag: tag.AcctIDSource, Name: "AcctIDSource", Type: FieldTypeString},
+			}},
+			{Tag: tag.StrikeTime, Name: "StrikeTime", Type: FieldTypeUTCTimestamp},
+		},
+	},
+	"l": {
+		Name:    "BidResponse",
+		MsgType: "l",
+		Fields: []FieldDef{
+			{Tag: tag.BidID, Name: "BidID", Type: FieldTypeString},
+			{Tag: tag.ClientBidID, Name: "ClientBidID", Type: FieldTypeString},
+			{Tag: tag.NoBidComponents, Name: "NoBidComponents", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.Commission, Name: "Commission", Type: FieldTypeDecimal, Required: true},
+				{Tag: tag.CommType, Name: "CommType", Type: FieldTypeString},
+				{Tag: tag.CommCurrency, Name: "CommCurrency", Type: FieldTypeString},
+				{Tag: tag.FundRenewWaiv, Name: "FundRenewWaiv", Type: FieldTypeString},
+				{Tag: tag.ListID, Name: "ListID", Type: FieldTypeString},
+				{Tag: tag.Country, Name: "Country", Type: FieldTypeString},
+				{Tag: tag.Side, Name: "Side", Type: FieldTypeString},
+				{Tag: tag.Price, Name: "Price", Type: FieldTypeDecimal},
+				{Tag: tag.PriceType, Name: "PriceType", Type: FieldTypeString},
+				{Tag: tag.FairValue, Name: "FairValue", Type: FieldTypeDecimal},
+				{Tag: tag.NetGrossInd, Name: "NetGrossInd", Type: FieldTypeString},
+				{Tag: tag.SettlType, Name: "SettlType", Type: FieldTypeString},
+				{Tag: tag.SettlDate, Name: "SettlDate", Type: FieldTypeString},
+				{Tag: tag.TradingSessionID, Name: "TradingSessionID", Type: FieldTypeString},
+				{Tag: tag.TradingSessionSubID, Name: "TradingSessionSubID", Type: FieldTypeString},
+				{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+				{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			}},
+		},
+	},
+	"j": {
+		Name:    "BusinessMessageReject",
+		MsgType: "j",
+		Fields: []FieldDef{
+			{Tag: tag.RefSeqNum, Name: "RefSeqNum", Type: FieldTypeInt},
+			{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+			{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			{Tag: tag.RefMsgType, Name: "RefMsgType", Type: FieldTypeString, Required: true},
+			{Tag: tag.BusinessRejectRefID, Name: "BusinessRejectRefID", Type: FieldTypeString},
+			{Tag: tag.BusinessRejectReason, Name: "BusinessRejectReason", Type: FieldTypeString, Required: true},
+		},
+	},
+	"AY": {
+		Name:    "CollateralAssignment",
+		MsgType: "AY",
+		Fields: []FieldDef{
+			{Tag: tag.Account, Name: "Account", Type: FieldTypeString},
+			{Tag: tag.ClOrdID, Name: "ClOrdID", Type: FieldTypeString},
+			{Tag: tag.Currency, Name: "Currency", Type: FieldTypeString},
+			{Tag: tag.SecurityIDSource, Name: "SecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.OrderID, Name: "OrderID", Type: FieldTypeString},
+			{Tag: tag.Price, Name: "Price", Type: FieldTypeDecimal},
+			{Tag: tag.SecurityID, Name: "SecurityID", Type: FieldTypeString},
+			{Tag: tag.Quantity, Name: "Quantity", Type: FieldTypeDecimal},
+			{Tag: tag.Side, Name: "Side", Type: FieldTypeString},
+			{Tag: tag.Symbol, Name: "Symbol", Type: FieldTypeString},
+			{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+			{Tag: tag.TransactTime, Name: "TransactTime", Type: FieldTypeUTCTimestamp, Required: true},
+			{Tag: tag.SettlDate, Name: "SettlDate", Type: FieldTypeString},
+			{Tag: tag.SymbolSfx, Name: "SymbolSfx", Type: FieldTypeString},
+			{Tag: tag.NoDlvyInst, Name: "NoDlvyInst", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.SettlInstSource, Name: "SettlInstSource", Type: FieldTypeString, Required: true},
+				{Tag: tag.DlvyInstType, Name: "DlvyInstType", Type: FieldTypeString},
+				{Tag: tag.NoSettlPartyIDs, Name: "NoSettlPartyIDs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+					{Tag: tag.SettlPartyID, Name: "SettlPartyID", Type: FieldTypeString, Required: true},
+					{Tag: tag.SettlPartyIDSource, Name: "SettlPartyIDSource", Type: FieldTypeString},
+					{Tag: tag.SettlPartyRole, Name: "SettlPartyRole", Type: FieldTypeInt},
+					{Tag: tag.NoSettlPartySubIDs, Name: "NoSettlPartySubIDs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+						{Tag: tag.SettlPartySubID, Name: "SettlPartySubID", Type: FieldTypeString, Required: true},
+						{Tag: tag.SettlPartySubIDType, Name: "SettlPartySubIDType", Type: FieldTypeInt},
+					}},
+				}},
+			}},
+			{Tag: tag.Issuer, Name: "Issuer", Type: FieldTypeString},
+			{Tag: tag.SecurityDesc, Name: "SecurityDesc", Type: FieldTypeString},
+			{Tag: tag.NoExecs, Name: "NoExecs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.ExecID, Name: "ExecID", Type: FieldTypeString, Required: true},
+			}},
+			{Tag: tag.ExpireTime, Name: "ExpireTime", Type: FieldTypeUTCTimestamp},
+			{Tag: tag.NoMiscFees, Name: "NoMiscFees", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.MiscFeeAmt, Name: "MiscFeeAmt", Type: FieldTypeDecimal, Required: true},
+				{Tag: tag.MiscFeeCurr, Name: "MiscFeeCurr", Type: FieldTypeString},
+				{Tag: tag.MiscFeeType, Name: "MiscFeeType", Type: FieldTypeString},
+				{Tag: tag.MiscFeeBasis, Name: "MiscFeeBasis", Type: FieldTypeString},
+			}},
+			{Tag: tag.AccruedInterestAmt, Name: "AccruedInterestAmt", Type: FieldTypeDecimal},
+			{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+			{Tag: tag.StandInstDbType, Name: "StandInstDbType", Type: FieldTypeString},
+			{Tag: tag.StandInstDbName, Name: "StandInstDbName", Type: FieldTypeString},
+			{Tag: tag.StandInstDbID, Name: "StandInstDbID", Type: FieldTypeString},
+			{Tag: tag.SettlDeliveryType, Name: "SettlDeliveryType", Type: FieldTypeString},
+			{Tag: tag.SecondaryOrderID, Name: "SecondaryOrderID", Type: FieldTypeString},
+			{Tag: tag.MaturityMonthYear, Name: "MaturityMonthYear", Type: FieldTypeString},
+			{Tag: tag.StrikePrice, Name: "StrikePrice", Type: FieldTypeDecimal},
+			{Tag: tag.OptAttribute, Name: "OptAttribute", Type: FieldTypeString},
+			{Tag: tag.SecurityExchange, Name: "SecurityExchange", Type: FieldTypeString},
+			{Tag: tag.Spread, Name: "Spread", Type: FieldTypeDecimal},
+			{Tag: tag.BenchmarkCurveCurrency, Name: "BenchmarkCurveCurrency", Type: FieldTypeString},
+			{Tag: tag.BenchmarkCurveName, Name: "BenchmarkCurveName", Type: FieldTypeString},
+			{Tag: tag.BenchmarkCurvePoint, Name: "BenchmarkCurvePoint", Type: FieldTypeString},
+			{Tag: tag.CouponRate, Name: "CouponRate", Type: FieldTypeDecimal},
+			{Tag: tag.CouponPaymentDate, Name: "CouponPaymentDate", Type: FieldTypeString},
+			{Tag: tag.IssueDate, Name: "IssueDate", Type: FieldTypeString},
+			{Tag: tag.RepurchaseTerm, Name: "RepurchaseTerm", Type: FieldTypeInt},
+			{Tag: tag.RepurchaseRate, Name: "RepurchaseRate", Type: FieldTypeDecimal},
+			{Tag: tag.Factor, Name: "Factor", Type: FieldTypeDecimal},
+			{Tag: tag.ContractMultiplier, Name: "ContractMultiplier", Type: FieldTypeDecimal},
+			{Tag: tag.NoStipulations, Name: "NoStipulations", Type: FieldTypeNumInGroup, Fields: noStipulationsFields},
+			{Tag: tag.RepoCollateralSecurityType, Name: "RepoCollateralSecurityType", Type: FieldTypeInt},
+			{Tag: tag.RedemptionDate, Name: "RedemptionDate", Type: FieldTypeString},
+			{Tag: tag.CreditRating, Name: "CreditRating", Type: FieldTypeString},
+			{Tag: tag.TradingSessionID, Name: "TradingSessionID", Type: FieldTypeString},
+			{Tag: tag.EncodedIssuerLen, Name: "EncodedIssuerLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedIssuer, Name: "EncodedIssuer", Type: FieldTypeString},
+			{Tag: tag.EncodedSecurityDescLen, Name: "EncodedSecurityDescLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedSecurityDesc, Name: "EncodedSecurityDesc", Type: FieldTypeString},
+			{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			{Tag: tag.PriceType, Name: "PriceType", Type: FieldTypeString},
+			{Tag: tag.NoPartyIDs, Name: "NoPartyIDs", Type: FieldTypeNumInGroup, Fields: noPartyIDsFields},
+			{Tag: tag.NoSecurityAltID, Name: "NoSecurityAltID", Type: FieldTypeNumInGroup, Fields: noSecurityAltIDFields},
+			{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+			{Tag: tag.CFICode, Name: "CFICode", Type: FieldTypeString},
+			{Tag: tag.CountryOfIssue, Name: "CountryOfIssue", Type: FieldTypeString},
+			{Tag: tag.StateOrProvinceOfIssue, Name: "StateOrProvinceOfIssue", Type: FieldTypeString},
+			{Tag: tag.LocaleOfIssue, Name: "LocaleOfIssue", Type: FieldTypeString},
+			{Tag: tag.SecondaryClOrdID, Name: "SecondaryClOrdID", Type: FieldTypeString},
+			{Tag: tag.MaturityDate, Name: "MaturityDate", Type: FieldTypeString},
+			{Tag: tag.InstrRegistry, Name: "InstrRegistry", Type: FieldTypeString},
+			{Tag: tag.NoLegs, Name: "NoLegs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.LegSymbol, Name: "LegSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.LegSymbolSfx, Name: "LegSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.LegSecurityID, Name: "LegSecurityID", Type: FieldTypeString},
+				{Tag: tag.LegSecurityIDSource, Name: "LegSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoLegSecurityAltID, Name: "NoLegSecurityAltID", Type: FieldTypeNumInGroup, Fields: noLegSecurityAltIDFields},
+				{Tag: tag.LegProduct, Name: "LegProduct", Type: FieldTypeInt},
+				{Tag: tag.LegCFICode, Name: "LegCFICode", Type: FieldTypeString},
+				{Tag: tag.LegSecurityType, Name: "LegSecurityType", Type: FieldTypeString},
+				{Tag: tag.LegSecuritySubType, Name: "LegSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.LegMaturityMonthYear, Name: "LegMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.LegMaturityDate, Name: "LegMaturityDate", Type: FieldTypeString},
+				{Tag: tag.LegCouponPaymentDate, Name: "LegCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.LegIssueDate, Name: "LegIssueDate", Type: FieldTypeString},
+				{Tag: tag.LegRepoCollateralSecurityType, Name: "LegRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseTerm, Name: "LegRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseRate, Name: "LegRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegFactor, Name: "LegFactor", Type: FieldTypeDecimal},
+				{Tag: tag.LegCreditRating, Name: "LegCreditRating", Type: FieldTypeString},
+				{Tag: tag.LegInstrRegistry, Name: "LegInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.LegCountryOfIssue, Name: "LegCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegStateOrProvinceOfIssue, Name: "LegStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegLocaleOfIssue, Name: "LegLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegRedemptionDate, Name: "LegRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.LegStrikePrice, Name: "LegStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.LegStrikeCurrency, Name: "LegStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.LegOptAttribute, Name: "LegOptAttribute", Type: FieldTypeString},
+				{Tag: tag.LegContractMultiplier, Name: "LegContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.LegCouponRate, Name: "LegCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegSecurityExchange, Name: "LegSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.LegIssuer, Name: "LegIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedLegIssuerLen, Name: "EncodedLegIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegIssuer, Name: "EncodedLegIssuer", Type: FieldTypeString},
+				{Tag: tag.LegSecurityDesc, Name: "LegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedLegSecurityDescLen, Name: "EncodedLegSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegSecurityDesc, Name: "EncodedLegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.LegRatioQty, Name: "LegRatioQty", Type: FieldTypeDecimal},
+				{Tag: tag.LegSide, Name: "LegSide", Type: FieldTypeString},
+				{Tag: tag.LegCurrency, Name: "LegCurrency", Type: FieldTypeString},
+				{Tag: tag.LegPool, Name: "LegPool", Type: FieldTypeString},
+				{Tag: tag.LegDatedDate, Name: "LegDatedDate", Type: FieldTypeString},
+				{Tag: tag.LegContractSettlMonth, Name: "LegContractSettlMonth", Type: FieldTypeString},
+				{Tag: tag.LegInterestAccrualDate, Name: "LegInterestAccrualDate", Type: FieldTypeString},
+			}},
+			{Tag: tag.AccountType, Name: "AccountType", Type: FieldTypeString},
+			{Tag: tag.TradingSessionSubID, Name: "TradingSessionSubID", Type: FieldTypeString},
+			{Tag: tag.BenchmarkPrice, Name: "BenchmarkPrice", Type: FieldTypeDecimal},
+			{Tag: tag.BenchmarkPriceType, Name: "BenchmarkPriceType", Type: FieldTypeInt},
+			{Tag: tag.ContractSettlMonth, Name: "ContractSettlMonth", Type: FieldTypeString},
+			{Tag: tag.Pool, Name: "Pool", Type: FieldTypeString},
+			{Tag: tag.BenchmarkSecurityID, Name: "BenchmarkSecurityID", Type: FieldTypeString},
+			{Tag: tag.NoUnderlyings, Name: "NoUnderlyings", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.UnderlyingSymbol, Name: "UnderlyingSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.UnderlyingSymbolSfx, Name: "UnderlyingSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityID, Name: "UnderlyingSecurityID", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityIDSource, Name: "UnderlyingSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoUnderlyingSecurityAltID, Name: "NoUnderlyingSecurityAltID", Type: FieldTypeNumInGroup, Fields: noUnderlyingSecurityAltIDFields},
+				{Tag: tag.UnderlyingProduct, Name: "UnderlyingProduct", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingCFICode, Name: "UnderlyingCFICode", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityType, Name: "UnderlyingSecurityType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecuritySubType, Name: "UnderlyingSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityMonthYear, Name: "UnderlyingMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityDate, Name: "UnderlyingMaturityDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCouponPaymentDate, Name: "UnderlyingCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssueDate, Name: "UnderlyingIssueDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRepoCollateralSecurityType, Name: "UnderlyingRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseTerm, Name: "UnderlyingRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseRate, Name: "UnderlyingRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingFactor, Name: "UnderlyingFactor", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCreditRating, Name: "UnderlyingCreditRating", Type: FieldTypeString},
+				{Tag: tag.UnderlyingInstrRegistry, Name: "UnderlyingInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCountryOfIssue, Name: "UnderlyingCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStateOrProvinceOfIssue, Name: "UnderlyingStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingLocaleOfIssue, Name: "UnderlyingLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRedemptionDate, Name: "UnderlyingRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStrikePrice, Name: "UnderlyingStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStrikeCurrency, Name: "UnderlyingStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingOptAttribute, Name: "UnderlyingOptAttribute", Type: FieldTypeString},
+				{Tag: tag.UnderlyingContractMultiplier, Name: "UnderlyingContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCouponRate, Name: "UnderlyingCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingSecurityExchange, Name: "UnderlyingSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssuer, Name: "UnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingIssuerLen, Name: "EncodedUnderlyingIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingIssuer, Name: "EncodedUnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityDesc, Name: "UnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingSecurityDescLen, Name: "EncodedUnderlyingSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingSecurityDesc, Name: "EncodedUnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPProgram, Name: "UnderlyingCPProgram", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPRegType, Name: "UnderlyingCPRegType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCurrency, Name: "UnderlyingCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingQty, Name: "UnderlyingQty", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingPx, Name: "UnderlyingPx", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingDirtyPrice, Name: "UnderlyingDirtyPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndPrice, Name: "UnderlyingEndPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStartValue, Name: "UnderlyingStartValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCurrentValue, Name: "UnderlyingCurrentValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndValue, Name: "UnderlyingEndValue", Type: FieldTypeDecimal},
+				{Tag: tag.NoUnderlyingStips, Name: "NoUnderlyingStips", Type: FieldTypeNumInGroup, Fields: noUnderlyingStipsFields},
+				{Tag: tag.CollAction, Name: "CollAction", Type: FieldTypeString},
+			}},
+			{Tag: tag.ClearingBusinessDate, Name: "ClearingBusinessDate", Type: FieldTypeString},
+			{Tag: tag.SettlSessID, Name: "SettlSessID", Type: FieldTypeString},
+			{Tag: tag.SettlSessSubID, Name: "SettlSessSubID", Type: FieldTypeString},
+			{Tag: tag.BenchmarkSecurityIDSource, Name: "BenchmarkSecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.SecuritySubType, Name: "SecuritySubType", Type: FieldTypeString},
+			{Tag: tag.NoTrdRegTimestamps, Name: "NoTrdRegTimestamps", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.TrdRegTimestamp, Name: "TrdRegTimestamp", Type: FieldTypeUTCTimestamp, Required: true},
+				{Tag: tag.TrdRegTimestampType, Name: "TrdRegTimestampType", Type: FieldTypeString},
+				{Tag: tag.TrdRegTimestampOrigin, Name: "TrdRegTimestampOrigin", Type: FieldTypeString},
+			}},
+			{Tag: tag.TerminationType, Name: "TerminationType", Type: FieldTypeString},
+			{Tag: tag.QtyType, Name: "QtyType", Type: FieldTypeString},
+			{Tag: tag.NoEvents, Name: "NoEvents", Type: FieldTypeNumInGroup, Fields: noEventsFields},
+			{Tag: tag.DatedDate, Name: "DatedDate", Type: FieldTypeString},
+			{Tag: tag.InterestAccrualDate, Name: "InterestAccrualDate", Type: FieldTypeString},
+			{Tag: tag.CPProgram, Name: "CPProgram", Type: FieldTypeString},
+			{Tag: tag.CPRegType, Name: "CPRegType", Type: FieldTypeString},
+			{Tag: tag.CollReqID, Name: "CollReqID", Type: FieldTypeString},
+			{Tag: tag.CollAsgnReason, Name: "CollAsgnReason", Type: FieldTypeString, Required: true},
+			{Tag: tag.NoTrades, Name: "NoTrades", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.TradeReportID, Name: "TradeReportID", Type: FieldTypeString, Required: true},
+				{Tag: tag.SecondaryTradeReportID, Name: "SecondaryTradeReportID", Type: FieldTypeString},
+			}},
+			{Tag: tag.MarginRatio, Name: "MarginRatio", Type: FieldTypeDecimal},
+			{Tag: tag.MarginExcess, Name: "MarginExcess", Type: FieldTypeDecimal},
+			{Tag: tag.TotalNetValue, Name: "TotalNetValue", Type: FieldTypeDecimal},
+			{Tag: tag.CashOutstanding, Name: "CashOutstanding", Type: FieldTypeDecimal},
+			{Tag: tag.CollAsgnID, Name: "CollAsgnID", Type: FieldTypeString, Required: true},
+			{Tag: tag.CollAsgnTransType, Name: "CollAsgnTransType", Type: FieldTypeString, Required: true},
+			{Tag: tag.CollAsgnRefID, Name: "CollAsgnRefID", Type: FieldTypeString},
+			{Tag: tag.AgreementDesc, Name: "AgreementDesc", Type: FieldTypeString},
+			{Tag: tag.AgreementID, Name: "AgreementID", Type: FieldTypeString},
+			{Tag: tag.AgreementDate, Name: "AgreementDate", Type: FieldTypeString},
+			{Tag: tag.StartDate, Name: "StartDate", Type: FieldTypeString},
+			{Tag: tag.EndDate, Name: "EndDate", Type: FieldTypeString},
+			{Tag: tag.AgreementCurrency, Name: "AgreementCurrency", Type: FieldTypeString},
+			{Tag: tag.DeliveryType, Name: "DeliveryType", Type: FieldTypeString},
+			{Tag: tag.EndAccruedInterestAmt, Name: "EndAccruedInterestAmt", Type: FieldTypeDecimal},
+			{Tag: tag.StartCash, Name: "StartCash", Type: FieldTypeDecimal},
+			{Tag: tag.EndCash, Name: "EndCash", Type: FieldTypeDecimal},
+			{Tag: tag.StrikeCurrency, Name: "StrikeCurrency", Type: FieldTypeString},
+		},
+	},
+	"BB": {
+		Name:    "CollateralInquiry",
+		MsgType: "BB",
+		Fields: []FieldDef{
+			{Tag: tag.Account, Name: "Account", Type: FieldTypeString},
+			{Tag: tag.ClOrdID, Name: "ClOrdID", Type: FieldTypeString},
+			{Tag: tag.Currency, Name: "Currency", Type: FieldTypeString},
+			{Tag: tag.SecurityIDSource, Name: "SecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.OrderID, Name: "OrderID", Type: FieldTypeString},
+			{Tag: tag.Price, Name: "Price", Type: FieldTypeDecimal},
+			{Tag: tag.SecurityID, Name: "SecurityID", Type: FieldTypeString},
+			{Tag: tag.Quantity, Name: "Quantity", Type: FieldTypeDecimal},
+			{Tag: tag.Side, Name: "Side", Type: FieldTypeString},
+			{Tag: tag.Symbol, Name: "Symbol", Type: FieldTypeString},
+			{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+			{Tag: tag.SettlDate, Name: "SettlDate", Type: FieldTypeString},
+			{Tag: tag.SymbolSfx, Name: "SymbolSfx", Type: FieldTypeString},
+			{Tag: tag.NoDlvyInst, Name: "NoDlvyInst", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.SettlInstSource, Name: "SettlInstSource", Type: FieldTypeString, Required: true},
+				{Tag: tag.DlvyInstType, Name: "DlvyInstType", Type: FieldTypeString},
+				{Tag: tag.NoSettlPartyIDs, Name: "NoSettlPartyIDs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+					{Tag: tag.SettlPartyID, Name: "SettlPartyID", Type: FieldTypeString, Required: true},
+					{Tag: tag.SettlPartyIDSource, Name: "SettlPartyIDSource", Type: FieldTypeString},
+					{Tag: tag.SettlPartyRole, Name: "SettlPartyRole", Type: FieldTypeInt},
+					{Tag: tag.NoSettlPartySubIDs, Name: "NoSettlPartySubIDs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+						{Tag: tag.SettlPartySubID, Name: "SettlPartySubID", Type: FieldTypeString, Required: true},
+						{Tag: tag.SettlPartySubIDType, Name: "SettlPartySubIDType", Type: FieldTypeInt},
+					}},
+				}},
+			}},
+			{Tag: tag.Issuer, Name: "Issuer", Type: FieldTypeString},
+			{Tag: tag.SecurityDesc, Name: "SecurityDesc", Type: FieldTypeString},
+			{Tag: tag.NoExecs, Name: "NoExecs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.ExecID, Name: "ExecID", Type: FieldTypeString, Required: true},
+			}},
+			{Tag: tag.AccruedInterestAmt, Name: "AccruedInterestAmt", Type: FieldTypeDecimal},
+			{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+			{Tag: tag.StandInstDbType, Name: "StandInstDbType", Type: FieldTypeString},
+			{Tag: tag.StandInstDbName, Name: "StandInstDbName", Type: FieldTypeString},
+			{Tag: tag.StandInstDbID, Name: "StandInstDbID", Type: FieldTypeString},
+			{Tag: tag.SettlDeliveryType, Name: "SettlDeliveryType", Type: FieldTypeString},
+			{Tag: tag.SecondaryOrderID, Name: "SecondaryOrderID", Type: FieldTypeString},
+			{Tag: tag.MaturityMonthYear, Name: "MaturityMonthYear", Type: FieldTypeString},
+			{Tag: tag.StrikePrice, Name: "StrikePrice", Type: FieldTypeDecimal},
+			{Tag: tag.OptAttribute, Name: "OptAttribute", Type: FieldTypeString},
+			{Tag: tag.SecurityExchange, Name: "SecurityExchange", Type: FieldTypeString},
+			{Tag: tag.Spread, Name: "Spread", Type: FieldTypeDecimal},
+			{Tag: tag.BenchmarkCurveCurrency, Name: "BenchmarkCurveCurrency", Type: FieldTypeString},
+			{Tag: tag.BenchmarkCurveName, Name: "BenchmarkCurveName", Type: FieldTypeString},
+			{Tag: tag.BenchmarkCurvePoint, Name: "BenchmarkCurvePoint", Type: FieldTypeString},
+			{Tag: tag.CouponRate, Name: "CouponRate", Type: FieldTypeDecimal},
+			{Tag: tag.CouponPaymentDate, Name: "CouponPaymentDate", Type: FieldTypeString},
+			{Tag: tag.IssueDate, Name: "IssueDate", Type: FieldTypeString},
+			{Tag: tag.RepurchaseTerm, Name: "RepurchaseTerm", Type: FieldTypeInt},
+			{Tag: tag.RepurchaseRate, Name: "RepurchaseRate", Type: FieldTypeDecimal},
+			{Tag: tag.Factor, Name: "Factor", Type: FieldTypeDecimal},
+			{Tag: tag.ContractMultiplier, Name: "ContractMultiplier", Type: FieldTypeDecimal},
+			{Tag: tag.NoStipulations, Name: "NoStipulations", Type: FieldTypeNumInGroup, Fields: noStipulationsFields},
+			{Tag: tag.RepoCollateralSecurityType, Name: "RepoCollateralSecurityType", Type: FieldTypeInt},
+			{Tag: tag.RedemptionDate, Name: "RedemptionDate", Type: FieldTypeString},
+			{Tag: tag.CreditRating, Name: "CreditRating", Type: FieldTypeString},
+			{Tag: tag.SubscriptionRequestType, Name: "SubscriptionRequestType", Type: FieldTypeString},
+			{Tag: tag.TradingSessionID, Name: "TradingSessionID", Type: FieldTypeString},
+			{Tag: tag.EncodedIssuerLen, Name: "EncodedIssuerLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedIssuer, Name: "EncodedIssuer", Type: FieldTypeString},
+			{Tag: tag.EncodedSecurityDescLen, Name: "EncodedSecurityDescLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedSecurityDesc, Name: "EncodedSecurityDesc", Type: FieldTypeString},
+			{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			{Tag: tag.PriceType, Name: "PriceType", Type: FieldTypeString},
+			{Tag: tag.NoPartyIDs, Name: "NoPartyIDs", Type: FieldTypeNumInGroup, Fields: noPartyIDsFields},
+			{Tag: tag.NoSecurityAltID, Name: "NoSecurityAltID", Type: FieldTypeNumInGroup, Fields: noSecurityAltIDFields},
+			{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+			{Tag: tag.CFICode, Name: "CFICode", Type: FieldTypeString},
+			{Tag: tag.CountryOfIssue, Name: "CountryOfIssue", Type: FieldTypeString},
+			{Tag: tag.StateOrProvinceOfIssue, Name: "StateOrProvinceOfIssue", Type: FieldTypeString},
+			{Tag: tag.LocaleOfIssue, Name: "LocaleOfIssue", Type: FieldTypeString},
+			{Tag: tag.SecondaryClOrdID, Name: "SecondaryClOrdID", Type: FieldTypeString},
+			{Tag: tag.MaturityDate, Name: "MaturityDate", Type: FieldTypeString},
+			{Tag: tag.InstrRegistry, Name: "InstrRegistry", Type: FieldTypeString},
+			{Tag: tag.NoLegs, Name: "NoLegs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.LegSymbol, Name: "LegSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.LegSymbolSfx, Name: "LegSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.LegSecurityID, Name: "LegSecurityID", Type: FieldTypeString},
+				{Tag: tag.LegSecurityIDSource, Name: "LegSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoLegSecurityAltID, Name: "NoLegSecurityAltID", Type: FieldTypeNumInGroup, Fields: noLegSecurityAltIDFields},
+				{Tag: tag.LegProduct, Name: "LegProduct", Type: FieldTypeInt},
+				{Tag: tag.LegCFICode, Name: "LegCFICode", Type: FieldTypeString},
+				{Tag: tag.LegSecurityType, Name: "LegSecurityType", Type: FieldTypeString},
+				{Tag: tag.LegSecuritySubType, Name: "LegSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.LegMaturityMonthYear, Name: "LegMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.LegMaturityDate, Name: "LegMaturityDate", Type: FieldTypeString},
+				{Tag: tag.LegCouponPaymentDate, Name: "LegCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.LegIssueDate, Name: "LegIssueDate", Type: FieldTypeString},
+				{Tag: tag.LegRepoCollateralSecurityType, Name: "LegRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseTerm, Name: "LegRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseRate, Name: "LegRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegFactor, Name: "LegFactor", Type: FieldTypeDecimal},
+				{Tag: tag.LegCreditRating, Name: "LegCreditRating", Type: FieldTypeString},
+				{Tag: tag.LegInstrRegistry, Name: "LegInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.LegCountryOfIssue, Name: "LegCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegStateOrProvinceOfIssue, Name: "LegStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegLocaleOfIssue, Name: "LegLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegRedemptionDate, Name: "LegRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.LegStrikePrice, Name: "LegStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.LegStrikeCurrency, Name: "LegStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.LegOptAttribute, Name: "LegOptAttribute", Type: FieldTypeString},
+				{Tag: tag.LegContractMultiplier, Name: "LegContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.LegCouponRate, Name: "LegCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegSecurityExchange, Name: "LegSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.LegIssuer, Name: "LegIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedLegIssuerLen, Name: "EncodedLegIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegIssuer, Name: "EncodedLegIssuer", Type: FieldTypeString},
+				{Tag: tag.LegSecurityDesc, Name: "LegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedLegSecurityDescLen, Name: "EncodedLegSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegSecurityDesc, Name: "EncodedLegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.LegRatioQty, Name: "LegRatioQty", Type: FieldTypeDecimal},
+				{Tag: tag.LegSide, Name: "LegSide", Type: FieldTypeString},
+				{Tag: tag.LegCurrency, Name: "LegCurrency", Type: FieldTypeString},
+				{Tag: tag.LegPool, Name: "LegPool", Type: FieldTypeString},
+				{Tag: tag.LegDatedDate, Name: "LegDatedDate", Type: FieldTypeString},
+				{Tag: tag.LegContractSettlMonth, Name: "LegContractSettlMonth", Type: FieldTypeString},
+				{Tag: tag.LegInterestAccrualDate, Name: "LegInterestAccrualDate", Type: FieldTypeString},
+			}},
+			{Tag: tag.AccountType, Name: "AccountType", Type: FieldTypeString},
+			{Tag: tag.TradingSessionSubID, Name: "TradingSessionSubID", Type: FieldTypeString},
+			{Tag: tag.BenchmarkPrice, Name: "BenchmarkPrice", Type: FieldTypeDecimal},
+			{Tag: tag.BenchmarkPriceType, Name: "BenchmarkPriceType", Type: FieldTypeInt},
+			{Tag: tag.ContractSettlMonth, Name: "ContractSettlMonth", Type: FieldTypeString},
+			{Tag: tag.Pool, Name: "Pool", Type: FieldTypeString},
+			{Tag: tag.BenchmarkSecurityID, Name: "BenchmarkSecurityID", Type: FieldTypeString},
+			{Tag: tag.NoUnderlyings, Name: "NoUnderlyings", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.UnderlyingSymbol, Name: "UnderlyingSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.UnderlyingSymbolSfx, Name: "UnderlyingSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityID, Name: "UnderlyingSecurityID", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityIDSource, Name: "UnderlyingSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoUnderlyingSecurityAltID, Name: "NoUnderlyingSecurityAltID", Type: FieldTypeNumInGroup, Fields: noUnderlyingSecurityAltIDFields},
+				{Tag: tag.UnderlyingProduct, Name: "UnderlyingProduct", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingCFICode, Name: "UnderlyingCFICode", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityType, Name: "UnderlyingSecurityType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecuritySubType, Name: "UnderlyingSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityMonthYear, Name: "UnderlyingMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityDate, Name: "UnderlyingMaturityDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCouponPaymentDate, Name: "UnderlyingCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssueDate, Name: "UnderlyingIssueDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRepoCollateralSecurityType, Name: "UnderlyingRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseTerm, Name: "UnderlyingRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseRate, Name: "UnderlyingRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingFactor, Name: "UnderlyingFactor", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCreditRating, Name: "UnderlyingCreditRating", Type: FieldTypeString},
+				{Tag: tag.UnderlyingInstrRegistry, Name: "UnderlyingInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCountryOfIssue, Name: "UnderlyingCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStateOrProvinceOfIssue, Name: "UnderlyingStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingLocaleOfIssue, Name: "UnderlyingLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRedemptionDate, Name: "UnderlyingRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStrikePrice, Name: "UnderlyingStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStrikeCurrency, Name: "UnderlyingStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingOptAttribute, Name: "UnderlyingOptAttribute", Type: FieldTypeString},
+				{Tag: tag.UnderlyingContractMultiplier, Name: "UnderlyingContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCouponRate, Name: "UnderlyingCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingSecurityExchange, Name: "UnderlyingSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssuer, Name: "UnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingIssuerLen, Name: "EncodedUnderlyingIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingIssuer, Name: "EncodedUnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityDesc, Name: "UnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingSecurityDescLen, Name: "EncodedUnderlyingSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingSecurityDesc, Name: "EncodedUnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPProgram, Name: "UnderlyingCPProgram", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPRegType, Name: "UnderlyingCPRegType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCurrency, Name: "UnderlyingCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingQty, Name: "UnderlyingQty", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingPx, Name: "UnderlyingPx", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingDirtyPrice, Name: "UnderlyingDirtyPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndPrice, Name: "UnderlyingEndPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStartValue, Name: "UnderlyingStartValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCurrentValue, Name: "UnderlyingCurrentValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndValue, Name: "UnderlyingEndValue", Type: FieldTypeDecimal},
+				{Tag: tag.NoUnderlyingStips, Name: "NoUnderlyingStips", Type: FieldTypeNumInGroup, Fields: noUnderlyingStipsFields},
+			}},
+			{Tag: tag.ClearingBusinessDate, Name: "ClearingBusinessDate", Type: FieldTypeString},
+			{Tag: tag.SettlSessID, Name: "SettlSessID", Type: FieldTypeString},
+			{Tag: tag.SettlSessSubID, Name: "SettlSessSubID", Type: FieldTypeString},
+			{Tag: tag.ResponseTransportType, Name: "ResponseTransportType", Type: FieldTypeString},
+			{Tag: tag.ResponseDestination, Name: "ResponseDestination", Type: FieldTypeString},
+			{Tag: tag.BenchmarkSecurityIDSource, Name: "BenchmarkSecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.SecuritySubType, Name: "SecuritySubType", Type: FieldTypeString},
+			{Tag: tag.NoTrdRegTimestamps, Name: "NoTrdRegTimestamps", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.TrdRegTimestamp, Name: "TrdRegTimestamp", Type: FieldTypeUTCTimestamp, Required: true},
+				{Tag: tag.TrdRegTimestampType, Name: "TrdRegTimestampType", Type: FieldTypeString},
+				{Tag: tag.TrdRegTimestampOrigin, Name: "TrdRegTimestampOrigin", Type: FieldTypeString},
+			}},
+			{Tag: tag.TerminationType, Name: "TerminationType", Type: FieldTypeString},
+			{Tag: tag.QtyType, Name: "QtyType", Type: FieldTypeString},
+			{Tag: tag.NoEvents, Name: "NoEvents", Type: FieldTypeNumInGroup, Fields: noEventsFields},
+			{Tag: tag.DatedDate, Name: "DatedDate", Type: FieldTypeString},
+			{Tag: tag.InterestAccrualDate, Name: "InterestAccrualDate", Type: FieldTypeString},
+			{Tag: tag.CPProgram, Name: "CPProgram", Type: FieldTypeString},
+			{Tag: tag.CPRegType, Name: "CPRegType", Type: FieldTypeString},
+			{Tag: tag.NoTrades, Name: "NoTrades", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.TradeReportID, Name: "TradeReportID", Type: FieldTypeString, Required: true},
+				{Tag: tag.SecondaryTradeReportID, Name: "SecondaryTradeReportID", Type: FieldTypeString},
+			}},
+			{Tag: tag.MarginRatio, Name: "MarginRatio", Type: FieldTypeDecimal},
+			{Tag: tag.MarginExcess, Name: "MarginExcess", Type: FieldTypeDecimal},
+			{Tag: tag.TotalNetValue, Name: "TotalNetValue", Type: FieldTypeDecimal},
+			{Tag: tag.CashOutstanding, Name: "CashOutstanding", Type: FieldTypeDecimal},
+			{Tag: tag.CollInquiryID, Name: "CollInquiryID", Type: FieldTypeString},
+			{Tag: tag.AgreementDesc, Name: "AgreementDesc", Type: FieldTypeString},
+			{Tag: tag.AgreementID, Name: "AgreementID", Type: FieldTypeString},
+			{Tag: tag.AgreementDate, Name: "AgreementDate", Type: FieldTypeString},
+			{Tag: tag.StartDate, Name: "StartDate", Type: FieldTypeString},
+			{Tag: tag.EndDate, Name: "EndDate", Type: FieldTypeString},
+			{Tag: tag.AgreementCurrency, Name: "AgreementCurrency", Type: FieldTypeString},
+			{Tag: tag.DeliveryType, Name: "DeliveryType", Type: FieldTypeString},
+			{Tag: tag.EndAccruedInterestAmt, Name: "EndAccruedInterestAmt", Type: FieldTypeDecimal},
+			{Tag: tag.StartCash, Name: "StartCash", Type: FieldTypeDecimal},
+			{Tag: tag.EndCash, Name: "EndCash", Type: FieldTypeDecimal},
+			{Tag: tag.NoCollInquiryQualifier, Name: "NoCollInquiryQualifier", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.CollInquiryQualifier, Name: "CollInquiryQualifier", Type: FieldTypeString, Required: true},
+			}},
+			{Tag: tag.StrikeCurrency, Name: "StrikeCurrency", Type: FieldTypeString},
+		},
+	},
+	"BG": {
+		Name:    "CollateralInquiryAck",
+		MsgType: "BG",
+		Fields: []FieldDef{
+			{Tag: tag.Account, Name: "Account", Type: FieldTypeString},
+			{Tag: tag.ClOrdID, Name: "ClOrdID", Type: FieldTypeString},
+			{Tag: tag.Currency, Name: "Currency", Type: FieldTypeString},
+			{Tag: tag.SecurityIDSource, Name: "SecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.OrderID, Name: "OrderID", Type: FieldTypeString},
+			{Tag: tag.SecurityID, Name: "SecurityID", Type: FieldTypeString},
+			{Tag: tag.Quantity, Name: "Quantity", Type: FieldTypeDecimal},
+			{Tag: tag.Symbol, Name: "Symbol", Type: FieldTypeString},
+			{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+			{Tag: tag.SettlDate, Name: "SettlDate", Type: FieldTypeString},
+			{Tag: tag.SymbolSfx, Name: "SymbolSfx", Type: FieldTypeString},
+			{Tag: tag.Issuer, Name: "Issuer", Type: FieldTypeString},
+			{Tag: tag.SecurityDesc, Name: "SecurityDesc", Type: FieldTypeString},
+			{Tag: tag.NoExecs, Name: "NoExecs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.ExecID, Name: "ExecID", Type: FieldTypeString, Required: true},
+			}},
+			{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+			{Tag: tag.SecondaryOrderID, Name: "SecondaryOrderID", Type: FieldTypeString},
+			{Tag: tag.MaturityMonthYear, Name: "MaturityMonthYear", Type: FieldTypeString},
+			{Tag: tag.StrikePrice, Name: "StrikePrice", Type: FieldTypeDecimal},
+			{Tag: tag.OptAttribute, Name: "OptAttribute", Type: FieldTypeString},
+			{Tag: tag.SecurityExchange, Name: "SecurityExchange", Type: FieldTypeString},
+			{Tag: tag.CouponRate, Name: "CouponRate", Type: FieldTypeDecimal},
+			{Tag: tag.CouponPaymentDate, Name: "CouponPaymentDate", Type: FieldTypeString},
+			{Tag: tag.IssueDate, Name: "IssueDate", Type: FieldTypeString},
+			{Tag: tag.RepurchaseTerm, Name: "RepurchaseTerm", Type: FieldTypeInt},
+			{Tag: tag.RepurchaseRate, Name: "RepurchaseRate", Type: FieldTypeDecimal},
+			{Tag: tag.Factor, Name: "Factor", Type: FieldTypeDecimal},
+			{Tag: tag.ContractMultiplier, Name: "ContractMultiplier", Type: FieldTypeDecimal},
+			{Tag: tag.RepoCollateralSecurityType, Name: "RepoCollateralSecurityType", Type: FieldTypeInt},
+			{Tag: tag.RedemptionDate, Name: "RedemptionDate", Type: FieldTypeString},
+			{Tag: tag.CreditRating, Name: "CreditRating", Type: FieldTypeString},
+			{Tag: tag.TradingSessionID, Name: "TradingSessionID", Type: FieldTypeString},
+			{Tag: tag.EncodedIssuerLen, Name: "EncodedIssuerLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedIssuer, Name: "EncodedIssuer", Type: FieldTypeString},
+			{Tag: tag.EncodedSecurityDescLen, Name: "EncodedSecurityDescLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedSecurityDesc, Name: "EncodedSecurityDesc", Type: FieldTypeString},
+			{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			{Tag: tag.NoPartyIDs, Name: "NoPartyIDs", Type: FieldTypeNumInGroup, Fields: noPartyIDsFields},
+			{Tag: tag.NoSecurityAltID, Name: "NoSecurityAltID", Type: FieldTypeNumInGroup, Fields: noSecurityAltIDFields},
+			{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+			{Tag: tag.CFICode, Name: "CFICode", Type: FieldTypeString},
+			{Tag: tag.CountryOfIssue, Name: "CountryOfIssue", Type: FieldTypeString},
+			{Tag: tag.StateOrProvinceOfIssue, Name: "StateOrProvinceOfIssue", Type: FieldTypeString},
+			{Tag: tag.LocaleOfIssue, Name: "LocaleOfIssue", Type: FieldTypeString},
+			{Tag: tag.SecondaryClOrdID, Name: "SecondaryClOrdID", Type: FieldTypeString},
+			{Tag: tag.MaturityDate, Name: "MaturityDate", Type: FieldTypeString},
+			{Tag: tag.InstrRegistry, Name: "InstrRegistry", Type: FieldTypeString},
+			{Tag: tag.NoLegs, Name: "NoLegs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.LegSymbol, Name: "LegSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.LegSymbolSfx, Name: "LegSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.LegSecurityID, Name: "LegSecurityID", Type: FieldTypeString},
+				{Tag: tag.LegSecurityIDSource, Name: "LegSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoLegSecurityAltID, Name: "NoLegSecurityAltID", Type: FieldTypeNumInGroup, Fields: noLegSecurityAltIDFields},
+				{Tag: tag.LegProduct, Name: "LegProduct", Type: FieldTypeInt},
+				{Tag: tag.LegCFICode, Name: "LegCFICode", Type: FieldTypeString},
+				{Tag: tag.LegSecurityType, Name: "LegSecurityType", Type: FieldTypeString},
+				{Tag: tag.LegSecuritySubType, Name: "LegSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.LegMaturityMonthYear, Name: "LegMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.LegMaturityDate, Name: "LegMaturityDate", Type: FieldTypeString},
+				{Tag: tag.LegCouponPaymentDate, Name: "LegCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.LegIssueDate, Name: "LegIssueDate", Type: FieldTypeString},
+				{Tag: tag.LegRepoCollateralSecurityType, Name: "LegRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseTerm, Name: "LegRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseRate, Name: "LegRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegFactor, Name: "LegFactor", Type: FieldTypeDecimal},
+				{Tag: tag.LegCreditRating, Name: "LegCreditRating", Type: FieldTypeString},
+				{Tag: tag.LegInstrRegistry, Name: "LegInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.LegCountryOfIssue, Name: "LegCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegStateOrProvinceOfIssue, Name: "LegStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegLocaleOfIssue, Name: "LegLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegRedemptionDate, Name: "LegRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.LegStrikePrice, Name: "LegStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.LegStrikeCurrency, Name: "LegStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.LegOptAttribute, Name: "LegOptAttribute", Type: FieldTypeString},
+				{Tag: tag.LegContractMultiplier, Name: "LegContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.LegCouponRate, Name: "LegCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegSecurityExchange, Name: "LegSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.LegIssuer, Name: "LegIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedLegIssuerLen, Name: "EncodedLegIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegIssuer, Name: "EncodedLegIssuer", Type: FieldTypeString},
+				{Tag: tag.LegSecurityDesc, Name: "LegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedLegSecurityDescLen, Name: "EncodedLegSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegSecurityDesc, Name: "EncodedLegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.LegRatioQty, Name: "LegRatioQty", Type: FieldTypeDecimal},
+				{Tag: tag.LegSide, Name: "LegSide", Type: FieldTypeString},
+				{Tag: tag.LegCurrency, Name: "LegCurrency", Type: FieldTypeString},
+				{Tag: tag.LegPool, Name: "LegPool", Type: FieldTypeString},
+				{Tag: tag.LegDatedDate, Name: "LegDatedDate", Type: FieldTypeString},
+				{Tag: tag.LegContractSettlMonth, Name: "LegContractSettlMonth", Type: FieldTypeString},
+				{Tag: tag.LegInterestAccrualDate, Name: "LegInterestAccrualDate", Type: FieldTypeString},
+			}},
+			{Tag: tag.AccountType, Name: "AccountType", Type: FieldTypeString},
+			{Tag: tag.TradingSessionSubID, Name: "TradingSessionSubID", Type: FieldTypeString},
+			{Tag: tag.ContractSettlMonth, Name: "ContractSettlMonth", Type: FieldTypeString},
+			{Tag: tag.Pool, Name: "Pool", Type: FieldTypeString},
+			{Tag: tag.NoUnderlyings, Name: "NoUnderlyings", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.UnderlyingSymbol, Name: "UnderlyingSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.UnderlyingSymbolSfx, Name: "UnderlyingSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityID, Name: "UnderlyingSecurityID", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityIDSource, Name: "UnderlyingSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoUnderlyingSecurityAltID, Name: "NoUnderlyingSecurityAltID", Type: FieldTypeNumInGroup, Fields: noUnderlyingSecurityAltIDFields},
+				{Tag: tag.UnderlyingProduct, Name: "UnderlyingProduct", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingCFICode, Name: "UnderlyingCFICode", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityType, Name: "UnderlyingSecurityType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecuritySubType, Name: "UnderlyingSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityMonthYear, Name: "UnderlyingMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityDate, Name: "UnderlyingMaturityDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCouponPaymentDate, Name: "UnderlyingCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssueDate, Name: "UnderlyingIssueDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRepoCollateralSecurityType, Name: "UnderlyingRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseTerm, Name: "UnderlyingRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseRate, Name: "UnderlyingRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingFactor, Name: "UnderlyingFactor", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCreditRating, Name: "UnderlyingCreditRating", Type: FieldTypeString},
+				{Tag: tag.UnderlyingInstrRegistry, Name: "UnderlyingInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCountryOfIssue, Name: "UnderlyingCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStateOrProvinceOfIssue, Name: "UnderlyingStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingLocaleOfIssue, Name: "UnderlyingLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRedemptionDate, Name: "UnderlyingRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStrikePrice, Name: "UnderlyingStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStrikeCurrency, Name: "UnderlyingStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingOptAttribute, Name: "UnderlyingOptAttribute", Type: FieldTypeString},
+				{Tag: tag.UnderlyingContractMultiplier, Name: "UnderlyingContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCouponRate, Name: "UnderlyingCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingSecurityExchange, Name: "UnderlyingSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssuer, Name: "UnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingIssuerLen, Name: "EncodedUnderlyingIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingIssuer, Name: "EncodedUnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityDesc, Name: "UnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingSecurityDescLen, Name: "EncodedUnderlyingSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingSecurityDesc, Name: "EncodedUnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPProgram, Name: "UnderlyingCPProgram", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPRegType, Name: "UnderlyingCPRegType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCurrency, Name: "UnderlyingCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingQty, Name: "UnderlyingQty", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingPx, Name: "UnderlyingPx", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingDirtyPrice, Name: "UnderlyingDirtyPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndPrice, Name: "UnderlyingEndPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStartValue, Name: "UnderlyingStartValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCurrentValue, Name: "UnderlyingCurrentValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndValue, Name: "UnderlyingEndValue", Type: FieldTypeDecimal},
+				{Tag: tag.NoUnderlyingStips, Name: "NoUnderlyingStips", Type: FieldTypeNumInGroup, Fields: noUnderlyingStipsFields},
+			}},
+			{Tag: tag.ClearingBusinessDate, Name: "ClearingBusinessDate", Type: FieldTypeString},
+			{Tag: tag.SettlSessID, Name: "SettlSessID", Type: FieldTypeString},
+			{Tag: tag.SettlSessSubID, Name: "SettlSessSubID", Type: FieldTypeString},
+			{Tag: tag.ResponseTransportType, Name: "ResponseTransportType", Type: FieldTypeString},
+			{Tag: tag.ResponseDestination, Name: "ResponseDestination", Type: FieldTypeString},
+			{Tag: tag.SecuritySubType, Name: "SecuritySubType", Type: FieldTypeString},
+			{Tag: tag.TerminationType, Name: "TerminationType", Type: FieldTypeString},
+			{Tag: tag.QtyType, Name: "QtyType", Type: FieldTypeString},
+			{Tag: tag.NoEvents, Name: "NoEvents", Type: FieldTypeNumInGroup, Fields: noEventsFields},
+			{Tag: tag.DatedDate, Name: "DatedDate", Type: FieldTypeString},
+			{Tag: tag.InterestAccrualDate, Name: "InterestAccrualDate", Type: FieldTypeString},
+			{Tag: tag.CPProgram, Name: "CPProgram", Type: FieldTypeString},
+			{Tag: tag.CPRegType, Name: "CPRegType", Type: FieldTypeString},
+			{Tag: tag.NoTrades, Name: "NoTrades", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.TradeReportID, Name: "TradeReportID", Type: FieldTypeString, Required: true},
+				{Tag: tag.SecondaryTradeReportID, Name: "SecondaryTradeReportID", Type: FieldTypeString},
+			}},
+			{Tag: tag.MarginRatio, Name: "MarginRatio", Type: FieldTypeDecimal},
+			{Tag: tag.CollInquiryID, Name: "CollInquiryID", Type: FieldTypeString, Required: true},
+			{Tag: tag.TotNumReports, Name: "TotNumReports", Type: FieldTypeInt},
+			{Tag: tag.AgreementDesc, Name: "AgreementDesc", Type: FieldTypeString},
+			{Tag: tag.AgreementID, Name: "AgreementID", Type: FieldTypeString},
+			{Tag: tag.AgreementDate, Name: "AgreementDate", Type: FieldTypeString},
+			{Tag: tag.StartDate, Name: "StartDate", Type: FieldTypeString},
+			{Tag: tag.EndDate, Name: "EndDate", Type: FieldTypeString},
+			{Tag: tag.AgreementCurrency, Name: "AgreementCurrency", Type: FieldTypeString},
+			{Tag: tag.DeliveryType, Name: "DeliveryType", Type: FieldTypeString},
+			{Tag: tag.NoCollInquiryQualifier, Name: "NoCollInquiryQualifier", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.CollInquiryQualifier, Name: "CollInquiryQualifier", Type: FieldTypeString, Required: true},
+			}},
+			{Tag: tag.CollInquiryStatus, Name: "CollInquiryStatus", Type: FieldTypeString, Required: true},
+			{Tag: tag.CollInquiryResult, Name: "CollInquiryResult", Type: FieldTypeString},
+			{Tag: tag.StrikeCurrency, Name: "StrikeCurrency", Type: FieldTypeString},
+		},
+	},
+	"BA": {
+		Name:    "CollateralReport",
+		MsgType: "BA",
+		Fields: []FieldDef{
+			{Tag: tag.Account, Name: "Account", Type: FieldTypeString},
+			{Tag: tag.ClOrdID, Name: "ClOrdID", Type: FieldTypeString},
+			{Tag: tag.Currency, Name: "Currency", Type: FieldTypeString},
+			{Tag: tag.SecurityIDSource, Name: "SecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.OrderID, Name: "OrderID", Type: FieldTypeString},
+			{Tag: tag.Price, Name: "Price", Type: FieldTypeDecimal},
+			{Tag: tag.SecurityID, Name: "SecurityID", Type: FieldTypeString},
+			{Tag: tag.Quantity, Name: "Quantity", Type: FieldTypeDecimal},
+			{Tag: tag.Side, Name: "Side", Type: FieldTypeString},
+			{Tag: tag.Symbol, Name: "Symbol", Type: FieldTypeString},
+			{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+			{Tag: tag.SettlDate, Name: "SettlDate", Type: FieldTypeString},
+			{Tag: tag.SymbolSfx, Name: "SymbolSfx", Type: FieldTypeString},
+			{Tag: tag.NoDlvyInst, Name: "NoDlvyInst", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.SettlInstSource, Name: "SettlInstSource", Type: FieldTypeString, Required: true},
+				{Tag: tag.DlvyInstType, Name: "DlvyInstType", Type: FieldTypeString},
+				{Tag: tag.NoSettlPartyIDs, Name: "NoSettlPartyIDs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+					{Tag: tag.SettlPartyID, Name: "SettlPartyID", Type: FieldTypeString, Required: true},
+					{Tag: tag.SettlPartyIDSource, Name: "SettlPartyIDSource", Type: FieldTypeString},
+					{Tag: tag.SettlPartyRole, Name: "SettlPartyRole", Type: FieldTypeInt},
+					{Tag: tag.NoSettlPartySubIDs, Name: "NoSettlPartySubIDs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+						{Tag: tag.SettlPartySubID, Name: "SettlPartySubID", Type: FieldTypeString, Required: true},
+						{Tag: tag.SettlPartySubIDType, Name: "SettlPartySubIDType", Type: FieldTypeInt},
+					}},
+				}},
+			}},
+			{Tag: tag.Issuer, Name: "Issuer", Type: FieldTypeString},
+			{Tag: tag.SecurityDesc, Name: "SecurityDesc", Type: FieldTypeString},
+			{Tag: tag.NoExecs, Name: "NoExecs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.ExecID, Name: "ExecID", Type: FieldTypeString, Required: true},
+			}},
+			{Tag: tag.NoMiscFees, Name: "NoMiscFees", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.MiscFeeAmt, Name: "MiscFeeAmt", Type: FieldTypeDecimal, Required: true},
+				{Tag: tag.MiscFeeCurr, Name: "MiscFeeCurr", Type: FieldTypeString},
+				{Tag: tag.MiscFeeType, Name: "MiscFeeType", Type: FieldTypeString},
+				{Tag: tag.MiscFeeBasis, Name: "MiscFeeBasis", Type: FieldTypeString},
+			}},
+			{Tag: tag.AccruedInterestAmt, Name: "AccruedInterestAmt", Type: FieldTypeDecimal},
+			{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+			{Tag: tag.StandInstDbType, Name: "StandInstDbType", Type: FieldTypeString},
+			{Tag: tag.StandInstDbName, Name: "StandInstDbName", Type: FieldTypeString},
+			{Tag: tag.StandInstDbID, Name: "StandInstDbID", Type: FieldTypeString},
+			{Tag: tag.SettlDeliveryType, Name: "SettlDeliveryType", Type: FieldTypeString},
+			{Tag: tag.SecondaryOrderID, Name: "SecondaryOrderID", Type: FieldTypeString},
+			{Tag: tag.MaturityMonthYear, Name: "MaturityMonthYear", Type: FieldTypeString},
+			{Tag: tag.StrikePrice, Name: "StrikePrice", Type: FieldTypeDecimal},
+			{Tag: tag.OptAttribute, Name: "OptAttribute", Type: FieldTypeString},
+			{Tag: tag.SecurityExchange, Name: "SecurityExchange", Type: FieldTypeString},
+			{Tag: tag.Spread, Name: "Spread", Type: FieldTypeDecimal},
+			{Tag: tag.BenchmarkCurveCurrency, Name: "BenchmarkCurveCurrency", Type: FieldTypeString},
+			{Tag: tag.BenchmarkCurveName, Name: "BenchmarkCurveName", Type: FieldTypeString},
+			{Tag: tag.BenchmarkCurvePoint, Name: "BenchmarkCurvePoint", Type: FieldTypeString},
+			{Tag: tag.CouponRate, Name: "CouponRate", Type: FieldTypeDecimal},
+			{Tag: tag.CouponPaymentDate, Name: "CouponPaymentDate", Type: FieldTypeString},
+			{Tag: tag.IssueDate, Name: "IssueDate", Type: FieldTypeString},
+			{Tag: tag.RepurchaseTerm, Name: "RepurchaseTerm", Type: FieldTypeInt},
+			{Tag: tag.RepurchaseRate, Name: "RepurchaseRate", Type: FieldTypeDecimal},
+			{Tag: tag.Factor, Name: "Factor", Type: FieldTypeDecimal},
+			{Tag: tag.ContractMultiplier, Name: "ContractMultiplier", Type: FieldTypeDecimal},
+			{Tag: tag.NoStipulations, Name: "NoStipulations", Type: FieldTypeNumInGroup, Fields: noStipulationsFields},
+			{Tag: tag.RepoCollateralSecurityType, Name: "RepoCollateralSecurityType", Type: FieldTypeInt},
+			{Tag: tag.RedemptionDate, Name: "RedemptionDate", Type: FieldTypeString},
+			{Tag: tag.CreditRating, Name: "CreditRating", Type: FieldTypeString},
+			{Tag: tag.TradingSessionID, Name: "TradingSessionID", Type: FieldTypeString},
+			{Tag: tag.EncodedIssuerLen, Name: "EncodedIssuerLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedIssuer, Name: "EncodedIssuer", Type: FieldTypeString},
+			{Tag: tag.EncodedSecurityDescLen, Name: "EncodedSecurityDescLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedSecurityDesc, Name: "EncodedSecurityDesc", Type: FieldTypeString},
+			{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			{Tag: tag.PriceType, Name: "PriceType", Type: FieldTypeString},
+			{Tag: tag.NoPartyIDs, Name: "NoPartyIDs", Type: FieldTypeNumInGroup, Fields: noPartyIDsFields},
+			{Tag: tag.NoSecurityAltID, Name: "NoSecurityAltID", Type: FieldTypeNumInGroup, Fields: noSecurityAltIDFields},
+			{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+			{Tag: tag.CFICode, Name: "CFICode", Type: FieldTypeString},
+			{Tag: tag.CountryOfIssue, Name: "CountryOfIssue", Type: FieldTypeString},
+			{Tag: tag.StateOrProvinceOfIssue, Name: "StateOrProvinceOfIssue", Type: FieldTypeString},
+			{Tag: tag.LocaleOfIssue, Name: "LocaleOfIssue", Type: FieldTypeString},
+			{Tag: tag.SecondaryClOrdID, Name: "SecondaryClOrdID", Type: FieldTypeString},
+			{Tag: tag.MaturityDate, Name: "MaturityDate", Type: FieldTypeString},
+			{Tag: tag.InstrRegistry, Name: "InstrRegistry", Type: FieldTypeString},
+			{Tag: tag.NoLegs, Name: "NoLegs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.LegSymbol, Name: "LegSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.LegSymbolSfx, Name: "LegSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.LegSecurityID, Name: "LegSecurityID", Type: FieldTypeString},
+				{Tag: tag.LegSecurityIDSource, Name: "LegSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoLegSecurityAltID, Name: "NoLegSecurityAltID", Type: FieldTypeNumInGroup, Fields: noLegSecurityAltIDFields},
+				{Tag: tag.LegProduct, Name: "LegProduct", Type: FieldTypeInt},
+				{Tag: tag.LegCFICode, Name: "LegCFICode", Type: FieldTypeString},
+				{Tag: tag.LegSecurityType, Name: "LegSecurityType", Type: FieldTypeString},
+				{Tag: tag.LegSecuritySubType, Name: "LegSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.LegMaturityMonthYear, Name: "LegMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.LegMaturityDate, Name: "LegMaturityDate", Type: FieldTypeString},
+				{Tag: tag.LegCouponPaymentDate, Name: "LegCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.LegIssueDate, Name: "LegIssueDate", Type: FieldTypeString},
+				{Tag: tag.LegRepoCollateralSecurityType, Name: "LegRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseTerm, Name: "LegRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseRate, Name: "LegRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegFactor, Name: "LegFactor", Type: FieldTypeDecimal},
+				{Tag: tag.LegCreditRating, Name: "LegCreditRating", Type: FieldTypeString},
+				{Tag: tag.LegInstrRegistry, Name: "LegInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.LegCountryOfIssue, Name: "LegCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegStateOrProvinceOfIssue, Name: "LegStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegLocaleOfIssue, Name: "LegLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegRedemptionDate, Name: "LegRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.LegStrikePrice, Name: "LegStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.LegStrikeCurrency, Name: "LegStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.LegOptAttribute, Name: "LegOptAttribute", Type: FieldTypeString},
+				{Tag: tag.LegContractMultiplier, Name: "LegContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.LegCouponRate, Name: "LegCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegSecurityExchange, Name: "LegSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.LegIssuer, Name: "LegIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedLegIssuerLen, Name: "EncodedLegIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegIssuer, Name: "EncodedLegIssuer", Type: FieldTypeString},
+				{Tag: tag.LegSecurityDesc, Name: "LegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedLegSecurityDescLen, Name: "EncodedLegSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegSecurityDesc, Name: "EncodedLegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.LegRatioQty, Name: "LegRatioQty", Type: FieldTypeDecimal},
+				{Tag: tag.LegSide, Name: "LegSide", Type: FieldTypeString},
+				{Tag: tag.LegCurrency, Name: "LegCurrency", Type: FieldTypeString},
+				{Tag: tag.LegPool, Name: "LegPool", Type: FieldTypeString},
+				{Tag: tag.LegDatedDate, Name: "LegDatedDate", Type: FieldTypeString},
+				{Tag: tag.LegContractSettlMonth, Name: "LegContractSettlMonth", Type: FieldTypeString},
+				{Tag: tag.LegInterestAccrualDate, Name: "LegInterestAccrualDate", Type: FieldTypeString},
+			}},
+			{Tag: tag.AccountType, Name: "AccountType", Type: FieldTypeString},
+			{Tag: tag.TradingSessionSubID, Name: "TradingSessionSubID", Type: FieldTypeString},
+			{Tag: tag.BenchmarkPrice, Name: "BenchmarkPrice", Type: FieldTypeDecimal},
+			{Tag: tag.BenchmarkPriceType, Name: "BenchmarkPriceType", Type: FieldTypeInt},
+			{Tag: tag.ContractSettlMonth, Name: "ContractSettlMonth", Type: FieldTypeString},
+			{Tag: tag.Pool, Name: "Pool", Type: FieldTypeString},
+			{Tag: tag.BenchmarkSecurityID, Name: "BenchmarkSecurityID", Type: FieldTypeString},
+			{Tag: tag.NoUnderlyings, Name: "NoUnderlyings", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.UnderlyingSymbol, Name: "UnderlyingSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.UnderlyingSymbolSfx, Name: "UnderlyingSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityID, Name: "UnderlyingSecurityID", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityIDSource, Name: "UnderlyingSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoUnderlyingSecurityAltID, Name: "NoUnderlyingSecurityAltID", Type: FieldTypeNumInGroup, Fields: noUnderlyingSecurityAltIDFields},
+				{Tag: tag.UnderlyingProduct, Name: "UnderlyingProduct", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingCFICode, Name: "UnderlyingCFICode", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityType, Name: "UnderlyingSecurityType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecuritySubType, Name: "UnderlyingSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityMonthYear, Name: "UnderlyingMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityDate, Name: "UnderlyingMaturityDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCouponPaymentDate, Name: "UnderlyingCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssueDate, Name: "UnderlyingIssueDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRepoCollateralSecurityType, Name: "UnderlyingRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseTerm, Name: "UnderlyingRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseRate, Name: "UnderlyingRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingFactor, Name: "UnderlyingFactor", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCreditRating, Name: "UnderlyingCreditRating", Type: FieldTypeString},
+				{Tag: tag.UnderlyingInstrRegistry, Name: "UnderlyingInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCountryOfIssue, Name: "UnderlyingCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStateOrProvinceOfIssue, Name: "UnderlyingStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingLocaleOfIssue, Name: "UnderlyingLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRedemptionDate, Name: "UnderlyingRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStrikePrice, Name: "UnderlyingStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStrikeCurrency, Name: "UnderlyingStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingOptAttribute, Name: "UnderlyingOptAttribute", Type: FieldTypeString},
+				{Tag: tag.UnderlyingContractMultiplier, Name: "UnderlyingContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCouponRate, Name: "UnderlyingCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingSecurityExchange, Name: "UnderlyingSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssuer, Name: "UnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingIssuerLen, Name: "EncodedUnderlyingIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingIssuer, Name: "EncodedUnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityDesc, Name: "UnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingSecurityDescLen, Name: "EncodedUnderlyingSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingSecurityDesc, Name: "EncodedUnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPProgram, Name: "UnderlyingCPProgram", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPRegType, Name: "UnderlyingCPRegType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCurrency, Name: "UnderlyingCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingQty, Name: "UnderlyingQty", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingPx, Name: "UnderlyingPx", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingDirtyPrice, Name: "UnderlyingDirtyPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndPrice, Name: "UnderlyingEndPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStartValue, Name: "UnderlyingStartValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCurrentValue, Name: "UnderlyingCurrentValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndValue, Name: "UnderlyingEndValue", Type: FieldTypeDecimal},
+				{Tag: tag.NoUnderlyingStips, Name: "NoUnderlyingStips", Type: FieldTypeNumInGroup, Fields: noUnderlyingStipsFields},
+			}},
+			{Tag: tag.ClearingBusinessDate, Name: "ClearingBusinessDate", Type: FieldTypeString},
+			{Tag: tag.SettlSessID, Name: "SettlSessID", Type: FieldTypeString},
+			{Tag: tag.SettlSessSubID, Name: "SettlSessSubID", Type: FieldTypeString},
+			{Tag: tag.BenchmarkSecurityIDSource, Name: "BenchmarkSecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.SecuritySubType, Name: "SecuritySubType", Type: FieldTypeString},
+			{Tag: tag.NoTrdRegTimestamps, Name: "NoTrdRegTimestamps", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.TrdRegTimestamp, Name: "TrdRegTimestamp", Type: FieldTypeUTCTimestamp, Required: true},
+				{Tag: tag.TrdRegTimestampType, Name: "TrdRegTimestampType", Type: FieldTypeString},
+				{Tag: tag.TrdRegTimestampOrigin, Name: "TrdRegTimestampOrigin", Type: FieldTypeString},
+			}},
+			{Tag: tag.TerminationType, Name: "TerminationType", Type: FieldTypeString},
+			{Tag: tag.QtyType, Name: "QtyType", Type: FieldTypeString},
+			{Tag: tag.NoEvents, Name: "NoEvents", Type: FieldTypeNumInGroup, Fields: noEventsFields},
+			{Tag: tag.DatedDate, Name: "DatedDate", Type: FieldTypeString},
+			{Tag: tag.InterestAccrualDate, Name: "InterestAccrualDate", Type: FieldTypeString},
+			{Tag: tag.CPProgram, Name: "CPProgram", Type: FieldTypeString},
+			{Tag: tag.CPRegType, Name: "CPRegType", Type: FieldTypeString},
+			{Tag: tag.NoTrades, Name: "NoTrades", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.TradeReportID, Name: "TradeReportID", Type: FieldTypeString, Required: true},
+				{Tag: tag.SecondaryTradeReportID, Name: "SecondaryTradeReportID", Type: FieldTypeString},
+			}},
+			{Tag: tag.MarginRatio, Name: "MarginRatio", Type: FieldTypeDecimal},
+			{Tag: tag.MarginExcess, Name: "MarginExcess", Type: FieldTypeDecimal},
+			{Tag: tag.TotalNetValue, Name: "TotalNetValue", Type: FieldTypeDecimal},
+			{Tag: tag.CashOutstanding, Name: "CashOutstanding", Type: FieldTypeDecimal},
+			{Tag: tag.CollRptID, Name: "CollRptID", Type: FieldTypeString, Required: true},
+			{Tag: tag.CollInquiryID, Name: "CollInquiryID", Type: FieldTypeString},
+			{Tag: tag.CollStatus, Name: "CollStatus", Type: FieldTypeString, Required: true},
+			{Tag: tag.TotNumReports, Name: "TotNumReports", Type: FieldTypeInt},
+			{Tag: tag.LastRptRequested, Name: "LastRptRequested", Type: FieldTypeBool},
+			{Tag: tag.AgreementDesc, Name: "AgreementDesc", Type: FieldTypeString},
+			{Tag: tag.AgreementID, Name: "AgreementID", Type: FieldTypeString},
+			{Tag: tag.AgreementDate, Name: "AgreementDate", Type: FieldTypeString},
+			{Tag: tag.StartDate, Name: "StartDate", Type: FieldTypeString},
+			{Tag: tag.EndDate, Name: "EndDate", Type: FieldTypeString},
+			{Tag: tag.AgreementCurrency, Name: "AgreementCurrency", Type: FieldTypeString},
+			{Tag: tag.DeliveryType, Name: "DeliveryType", Type: FieldTypeString},
+			{Tag: tag.EndAccruedInterestAmt, Name: "EndAccruedInterestAmt", Type: FieldTypeDecimal},
+			{Tag: tag.StartCash, Name: "StartCash", Type: FieldTypeDecimal},
+			{Tag: tag.EndCash, Name: "EndCash", Type: FieldTypeDecimal},
+			{Tag: tag.StrikeCurrency, Name: "StrikeCurrency", Type: FieldTypeString},
+		},
+	},
+	"AX": {
+		Name:    "CollateralRequest",
+		MsgType: "AX",
+		Fields: []FieldDef{
+			{Tag: tag.Account, Name: "Account", Type: FieldTypeString},
+			{Tag: tag.ClOrdID, Name: "ClOrdID", Type: FieldTypeString},
+			{Tag: tag.Currency, Name: "Currency", Type: FieldTypeString},
+			{Tag: tag.SecurityIDSource, Name: "SecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.OrderID, Name: "OrderID", Type: FieldTypeString},
+			{Tag: tag.Price, Name: "Price", Type: FieldTypeDecimal},
+			{Tag: tag.SecurityID, Name: "SecurityID", Type: FieldTypeString},
+			{Tag: tag.Quantity, Name: "Quantity", Type: FieldTypeDecimal},
+			{Tag: tag.Side, Name: "Side", Type: FieldTypeString},
+			{Tag: tag.Symbol, Name: "Symbol", Type: FieldTypeString},
+			{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+			{Tag: tag.TransactTime, Name: "TransactTime", Type: FieldTypeUTCTimestamp, Required: true},
+			{Tag: tag.SettlDate, Name: "SettlDate", Type: FieldTypeString},
+			{Tag: tag.SymbolSfx, Name: "SymbolSfx", Type: FieldTypeString},
+			{Tag: tag.Issuer, Name: "Issuer", Type: FieldTypeString},
+			{Tag: tag.SecurityDesc, Name: "SecurityDesc", Type: FieldTypeString},
+			{Tag: tag.NoExecs, Name: "NoExecs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.ExecID, Name: "ExecID", Type: FieldTypeString, Required: true},
+			}},
+			{Tag: tag.ExpireTime, Name: "ExpireTime", Type: FieldTypeUTCTimestamp},
+			{Tag: tag.NoMiscFees, Name: "NoMiscFees", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.MiscFeeAmt, Name: "MiscFeeAmt", Type: FieldTypeDecimal, Required: true},
+				{Tag: tag.MiscFeeCurr, Name: "MiscFeeCurr", Type: FieldTypeString},
+				{Tag: tag.MiscFeeType, Name: "MiscFeeType", Type: FieldTypeString},
+				{Tag: tag.MiscFeeBasis, Name: "MiscFeeBasis", Type: FieldTypeString},
+			}},
+			{Tag: tag.AccruedInterestAmt, Name: "AccruedInterestAmt", Type: FieldTypeDecimal},
+			{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+			{Tag: tag.SecondaryOrderID, Name: "SecondaryOrderID", Type: FieldTypeString},
+			{Tag: tag.MaturityMonthYear, Name: "MaturityMonthYear", Type: FieldTypeString},
+			{Tag: tag.StrikePrice, Name: "StrikePrice", Type: FieldTypeDecimal},
+			{Tag: tag.OptAttribute, Name: "OptAttribute", Type: FieldTypeString},
+			{Tag: tag.SecurityExchange, Name: "SecurityExchange", Type: FieldTypeString},
+			{Tag: tag.Spread, Name: "Spread", Type: FieldTypeDecimal},
+			{Tag: tag.BenchmarkCurveCurrency, Name: "BenchmarkCurveCurrency", Type: FieldTypeString},
+			{Tag: tag.BenchmarkCurveName, Name: "BenchmarkCurveName", Type: FieldTypeString},
+			{Tag: tag.BenchmarkCurvePoint, Name: "BenchmarkCurvePoint", Type: FieldTypeString},
+			{Tag: tag.CouponRate, Name: "CouponRate", Type: FieldTypeDecimal},
+			{Tag: tag.CouponPaymentDate, Name: "CouponPaymentDate", Type: FieldTypeString},
+			{Tag: tag.IssueDate, Name: "IssueDate", Type: FieldTypeString},
+			{Tag: tag.RepurchaseTerm, Name: "RepurchaseTerm", Type: FieldTypeInt},
+			{Tag: tag.RepurchaseRate, Name: "RepurchaseRate", Type: FieldTypeDecimal},
+			{Tag: tag.Factor, Name: "Factor", Type: FieldTypeDecimal},
+			{Tag: tag.ContractMultiplier, Name: "ContractMultiplier", Type: FieldTypeDecimal},
+			{Tag: tag.NoStipulations, Name: "NoStipulations", Type: FieldTypeNumInGroup, Fields: noStipulationsFields},
+			{Tag: tag.RepoCollateralSecurityType, Name: "RepoCollateralSecurityType", Type: FieldTypeInt},
+			{Tag: tag.RedemptionDate, Name: "RedemptionDate", Type: FieldTypeString},
+			{Tag: tag.CreditRating, Name: "CreditRating", Type: FieldTypeString},
+			{Tag: tag.TradingSessionID, Name: "TradingSessionID", Type: FieldTypeString},
+			{Tag: tag.EncodedIssuerLen, Name: "EncodedIssuerLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedIssuer, Name: "EncodedIssuer", Type: FieldTypeString},
+			{Tag: tag.EncodedSecurityDescLen, Name: "EncodedSecurityDescLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedSecurityDesc, Name: "EncodedSecurityDesc", Type: FieldTypeString},
+			{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			{Tag: tag.PriceType, Name: "PriceType", Type: FieldTypeString},
+			{Tag: tag.NoPartyIDs, Name: "NoPartyIDs", Type: FieldTypeNumInGroup, Fields: noPartyIDsFields},
+			{Tag: tag.NoSecurityAltID, Name: "NoSecurityAltID", Type: FieldTypeNumInGroup, Fields: noSecurityAltIDFields},
+			{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+			{Tag: tag.CFICode, Name: "CFICode", Type: FieldTypeString},
+			{Tag: tag.CountryOfIssue, Name: "CountryOfIssue", Type: FieldTypeString},
+			{Tag: tag.StateOrProvinceOfIssue, Name: "StateOrProvinceOfIssue", Type: FieldTypeString},
+			{Tag: tag.LocaleOfIssue, Name: "LocaleOfIssue", Type: FieldTypeString},
+			{Tag: tag.SecondaryClOrdID, Name: "SecondaryClOrdID", Type: FieldTypeString},
+			{Tag: tag.MaturityDate, Name: "MaturityDate", Type: FieldTypeString},
+			{Tag: tag.InstrRegistry, Name: "InstrRegistry", Type: FieldTypeString},
+			{Tag: tag.NoLegs, Name: "NoLegs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.LegSymbol, Name: "LegSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.LegSymbolSfx, Name: "LegSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.LegSecurityID, Name: "LegSecurityID", Type: FieldTypeString},
+				{Tag: tag.LegSecurityIDSource, Name: "LegSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoLegSecurityAltID, Name: "NoLegSecurityAltID", Type: FieldTypeNumInGroup, Fields: noLegSecurityAltIDFields},
+				{Tag: tag.LegProduct, Name: "LegProduct", Type: FieldTypeInt},
+				{Tag: tag.LegCFICode, Name: "LegCFICode", Type: FieldTypeString},
+				{Tag: tag.LegSecurityType, Name: "LegSecurityType", Type: FieldTypeString},
+				{Tag: tag.LegSecuritySubType, Name: "LegSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.LegMaturityMonthYear, Name: "LegMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.LegMaturityDate, Name: "LegMaturityDate", Type: FieldTypeString},
+				{Tag: tag.LegCouponPaymentDate, Name: "LegCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.LegIssueDate, Name: "LegIssueDate", Type: FieldTypeString},
+				{Tag: tag.LegRepoCollateralSecurityType, Name: "LegRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseTerm, Name: "LegRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseRate, Name: "LegRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegFactor, Name: "LegFactor", Type: FieldTypeDecimal},
+				{Tag: tag.LegCreditRating, Name: "LegCreditRating", Type: FieldTypeString},
+				{Tag: tag.LegInstrRegistry, Name: "LegInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.LegCountryOfIssue, Name: "LegCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegStateOrProvinceOfIssue, Name: "LegStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegLocaleOfIssue, Name: "LegLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegRedemptionDate, Name: "LegRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.LegStrikePrice, Name: "LegStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.LegStrikeCurrency, Name: "LegStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.LegOptAttribute, Name: "LegOptAttribute", Type: FieldTypeString},
+				{Tag: tag.LegContractMultiplier, Name: "LegContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.LegCouponRate, Name: "LegCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegSecurityExchange, Name: "LegSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.LegIssuer, Name: "LegIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedLegIssuerLen, Name: "EncodedLegIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegIssuer, Name: "EncodedLegIssuer", Type: FieldTypeString},
+				{Tag: tag.LegSecurityDesc, Name: "LegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedLegSecurityDescLen, Name: "EncodedLegSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegSecurityDesc, Name: "EncodedLegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.LegRatioQty, Name: "LegRatioQty", Type: FieldTypeDecimal},
+				{Tag: tag.LegSide, Name: "LegSide", Type: FieldTypeString},
+				{Tag: tag.LegCurrency, Name: "LegCurrency", Type: FieldTypeString},
+				{Tag: tag.LegPool, Name: "LegPool", Type: FieldTypeString},
+				{Tag: tag.LegDatedDate, Name: "LegDatedDate", Type: FieldTypeString},
+				{Tag: tag.LegContractSettlMonth, Name: "LegContractSettlMonth", Type: FieldTypeString},
+				{Tag: tag.LegInterestAccrualDate, Name: "LegInterestAccrualDate", Type: FieldTypeString},
+			}},
+			{Tag: tag.AccountType, Name: "AccountType", Type: FieldTypeString},
+			{Tag: tag.TradingSessionSubID, Name: "TradingSessionSubID", Type: FieldTypeString},
+			{Tag: tag.BenchmarkPrice, Name: "BenchmarkPrice", Type: FieldTypeDecimal},
+			{Tag: tag.BenchmarkPriceType, Name: "BenchmarkPriceType", Type: FieldTypeInt},
+			{Tag: tag.ContractSettlMonth, Name: "ContractSettlMonth", Type: FieldTypeString},
+			{Tag: tag.Pool, Name: "Pool", Type: FieldTypeString},
+			{Tag: tag.BenchmarkSecurityID, Name: "BenchmarkSecurityID", Type: FieldTypeString},
+			{Tag: tag.NoUnderlyings, Name: "NoUnderlyings", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.UnderlyingSymbol, Name: "UnderlyingSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.UnderlyingSymbolSfx, Name: "UnderlyingSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityID, Name: "UnderlyingSecurityID", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityIDSource, Name: "UnderlyingSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoUnderlyingSecurityAltID, Name: "NoUnderlyingSecurityAltID", Type: FieldTypeNumInGroup, Fields: noUnderlyingSecurityAltIDFields},
+				{Tag: tag.UnderlyingProduct, Name: "UnderlyingProduct", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingCFICode, Name: "UnderlyingCFICode", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityType, Name: "UnderlyingSecurityType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecuritySubType, Name: "UnderlyingSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityMonthYear, Name: "UnderlyingMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityDate, Name: "UnderlyingMaturityDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCouponPaymentDate, Name: "UnderlyingCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssueDate, Name: "UnderlyingIssueDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRepoCollateralSecurityType, Name: "UnderlyingRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseTerm, Name: "UnderlyingRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseRate, Name: "UnderlyingRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingFactor, Name: "UnderlyingFactor", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCreditRating, Name: "UnderlyingCreditRating", Type: FieldTypeString},
+				{Tag: tag.UnderlyingInstrRegistry, Name: "UnderlyingInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCountryOfIssue, Name: "UnderlyingCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStateOrProvinceOfIssue, Name: "UnderlyingStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingLocaleOfIssue, Name: "UnderlyingLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRedemptionDate, Name: "UnderlyingRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStrikePrice, Name: "UnderlyingStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStrikeCurrency, Name: "UnderlyingStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingOptAttribute, Name: "UnderlyingOptAttribute", Type: FieldTypeString},
+				{Tag: tag.UnderlyingContractMultiplier, Name: "UnderlyingContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCouponRate, Name: "UnderlyingCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingSecurityExchange, Name: "UnderlyingSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssuer, Name: "UnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingIssuerLen, Name: "EncodedUnderlyingIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingIssuer, Name: "EncodedUnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityDesc, Name: "UnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingSecurityDescLen, Name: "EncodedUnderlyingSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingSecurityDesc, Name: "EncodedUnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPProgram, Name: "UnderlyingCPProgram", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPRegType, Name: "UnderlyingCPRegType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCurrency, Name: "UnderlyingCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingQty, Name: "UnderlyingQty", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingPx, Name: "UnderlyingPx", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingDirtyPrice, Name: "UnderlyingDirtyPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndPrice, Name: "UnderlyingEndPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStartValue, Name: "UnderlyingStartValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCurrentValue, Name: "UnderlyingCurrentValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndValue, Name: "UnderlyingEndValue", Type: FieldTypeDecimal},
+				{Tag: tag.NoUnderlyingStips, Name: "NoUnderlyingStips", Type: FieldTypeNumInGroup, Fields: noUnderlyingStipsFields},
+				{Tag: tag.CollAction, Name: "CollAction", Type: FieldTypeString},
+			}},
+			{Tag: tag.ClearingBusinessDate, Name: "ClearingBusinessDate", Type: FieldTypeString},
+			{Tag: tag.SettlSessID, Name: "SettlSessID", Type: FieldTypeString},
+			{Tag: tag.SettlSessSubID, Name: "SettlSessSubID", Type: FieldTypeString},
+			{Tag: tag.BenchmarkSecurityIDSource, Name: "BenchmarkSecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.SecuritySubType, Name: "SecuritySubType", Type: FieldTypeString},
+			{Tag: tag.NoTrdRegTimestamps, Name: "NoTrdRegTimestamps", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.TrdRegTimestamp, Name: "TrdRegTimestamp", Type: FieldTypeUTCTimestamp, Required: true},
+				{Tag: tag.TrdRegTimestampType, Name: "TrdRegTimestampType", Type: FieldTypeString},
+				{Tag: tag.TrdRegTimestampOrigin, Name: "TrdRegTimestampOrigin", Type: FieldTypeString},
+			}},
+			{Tag: tag.TerminationType, Name: "TerminationType", Type: FieldTypeString},
+			{Tag: tag.QtyType, Name: "QtyType", Type: FieldTypeString},
+			{Tag: tag.NoEvents, Name: "NoEvents", Type: FieldTypeNumInGroup, Fields: noEventsFields},
+			{Tag: tag.DatedDate, Name: "DatedDate", Type: FieldTypeString},
+			{Tag: tag.InterestAccrualDate, Name: "InterestAccrualDate", Type: FieldTypeString},
+			{Tag: tag.CPProgram, Name: "CPProgram", Type: FieldTypeString},
+			{Tag: tag.CPRegType, Name: "CPRegType", Type: FieldTypeString},
+			{Tag: tag.CollReqID, Name: "CollReqID", Type: FieldTypeString, Required: true},
+			{Tag: tag.CollAsgnReason, Name: "CollAsgnReason", Type: FieldTypeString, Required: true},
+			{Tag: tag.NoTrades, Name: "NoTrades", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.TradeReportID, Name: "TradeReportID", Type: FieldTypeString, Required: true},
+				{Tag: tag.SecondaryTradeReportID, Name: "SecondaryTradeReportID", Type: FieldTypeString},
+			}},
+			{Tag: tag.MarginRatio, Name: "MarginRatio", Type: FieldTypeDecimal},
+			{Tag: tag.MarginExcess, Name: "MarginExcess", Type: FieldTypeDecimal},
+			{Tag: tag.TotalNetValue, Name: "TotalNetValue", Type: FieldTypeDecimal},
+			{Tag: tag.CashOutstanding, Name: "CashOutstanding", Type: FieldTypeDecimal},
+			{Tag: tag.AgreementDesc, Name: "AgreementDesc", Type: FieldTypeString},
+			{Tag: tag.AgreementID, Name: "AgreementID", Type: FieldTypeString},
+			{Tag: tag.AgreementDate, Name: "AgreementDate", Type: FieldTypeString},
+			{Tag: tag.StartDate, Name: "StartDate", Type: FieldTypeString},
+			{Tag: tag.EndDate, Name: "EndDate", Type: FieldTypeString},
+			{Tag: tag.AgreementCurrency, Name: "AgreementCurrency", Type: FieldTypeString},
+			{Tag: tag.DeliveryType, Name: "DeliveryType", Type: FieldTypeString},
+			{Tag: tag.EndAccruedInterestAmt, Name: "EndAccruedInterestAmt", Type: FieldTypeDecimal},
+			{Tag: tag.StartCash, Name: "StartCash", Type: FieldTypeDecimal},
+			{Tag: tag.EndCash, Name: "EndCash", Type: FieldTypeDecimal},
+			{Tag: tag.StrikeCurrency, Name: "StrikeCurrency", Type: FieldTypeString},
+		},
+	},
+	"AZ": {
+		Name:    "CollateralResponse",
+		MsgType: "AZ",
+		Fields: []FieldDef{
+			{Tag: tag.Account, Name: "Account", Type: FieldTypeString},
+			{Tag: tag.ClOrdID, Name: "ClOrdID", Type: FieldTypeString},
+			{Tag: tag.Currency, Name: "Currency", Type: FieldTypeString},
+			{Tag: tag.SecurityIDSource, Name: "SecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.OrderID, Name: "OrderID", Type: FieldTypeString},
+			{Tag: tag.Price, Name: "Price", Type: FieldTypeDecimal},
+			{Tag: tag.SecurityID, Name: "SecurityID", Type: FieldTypeString},
+			{Tag: tag.Quantity, Name: "Quantity", Type: FieldTypeDecimal},
+			{Tag: tag.Side, Name: "Side", Type: FieldTypeString},
+			{Tag: tag.Symbol, Name: "Symbol", Type: FieldTypeString},
+			{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+			{Tag: tag.TransactTime, Name: "TransactTime", Type: FieldTypeUTCTimestamp, Required: true},
+			{Tag: tag.SettlDate, Name: "SettlDate", Type: FieldTypeString},
+			{Tag: tag.SymbolSfx, Name: "SymbolSfx", Type: FieldTypeString},
+			{Tag: tag.Issuer, Name: "Issuer", Type: FieldTypeString},
+			{Tag: tag.SecurityDesc, Name: "SecurityDesc", Type: FieldTypeString},
+			{Tag: tag.NoExecs, Name: "NoExecs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.ExecID, Name: "ExecID", Type: FieldTypeString, Required: true},
+			}},
+			{Tag: tag.NoMiscFees, Name: "NoMiscFees", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.MiscFeeAmt, Name: "MiscFeeAmt", Type: FieldTypeDecimal, Required: true},
+				{Tag: tag.MiscFeeCurr, Name: "MiscFeeCurr", Type: FieldTypeString},
+				{Tag: tag.MiscFeeType, Name: "MiscFeeType", Type: FieldTypeString},
+				{Tag: tag.MiscFeeBasis, Name: "MiscFeeBasis", Type: FieldTypeString},
+			}},
+			{Tag: tag.AccruedInterestAmt, Name: "AccruedInterestAmt", Type: FieldTypeDecimal},
+			{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+			{Tag: tag.SecondaryOrderID, Name: "SecondaryOrderID", Type: FieldTypeString},
+			{Tag: tag.MaturityMonthYear, Name: "MaturityMonthYear", Type: FieldTypeString},
+			{Tag: tag.StrikePrice, Name: "StrikePrice", Type: FieldTypeDecimal},
+			{Tag: tag.OptAttribute, Name: "OptAttribute", Type: FieldTypeString},
+			{Tag: tag.SecurityExchange, Name: "SecurityExchange", Type: FieldTypeString},
+			{Tag: tag.Spread, Name: "Spread", Type: FieldTypeDecimal},
+			{Tag: tag.BenchmarkCurveCurrency, Name: "BenchmarkCurveCurrency", Type: FieldTypeString},
+			{Tag: tag.BenchmarkCurveName, Name: "BenchmarkCurveName", Type: FieldTypeString},
+			{Tag: tag.BenchmarkCurvePoint, Name: "BenchmarkCurvePoint", Type: FieldTypeString},
+			{Tag: tag.CouponRate, Name: "CouponRate", Type: FieldTypeDecimal},
+			{Tag: tag.CouponPaymentDate, Name: "CouponPaymentDate", Type: FieldTypeString},
+			{Tag: tag.IssueDate, Name: "IssueDate", Type: FieldTypeString},
+			{Tag: tag.RepurchaseTerm, Name: "RepurchaseTerm", Type: FieldTypeInt},
+			{Tag: tag.RepurchaseRate, Name: "RepurchaseRate", Type: FieldTypeDecimal},
+			{Tag: tag.Factor, Name: "Factor", Type: FieldTypeDecimal},
+			{Tag: tag.ContractMultiplier, Name: "ContractMultiplier", Type: FieldTypeDecimal},
+			{Tag: tag.NoStipulations, Name: "NoStipulations", Type: FieldTypeNumInGroup, Fields: noStipulationsFields},
+			{Tag: tag.RepoCollateralSecurityType, Name: "RepoCollateralSecurityType", Type: FieldTypeInt},
+			{Tag: tag.RedemptionDate, Name: "RedemptionDate", Type: FieldTypeString},
+			{Tag: tag.CreditRating, Name: "CreditRating", Type: FieldTypeString},
+			{Tag: tag.EncodedIssuerLen, Name: "EncodedIssuerLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedIssuer, Name: "EncodedIssuer", Type: FieldTypeString},
+			{Tag: tag.EncodedSecurityDescLen, Name: "EncodedSecurityDescLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedSecurityDesc, Name: "EncodedSecurityDesc", Type: FieldTypeString},
+			{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			{Tag: tag.PriceType, Name: "PriceType", Type: FieldTypeString},
+			{Tag: tag.NoPartyIDs, Name: "NoPartyIDs", Type: FieldTypeNumInGroup, Fields: noPartyIDsFields},
+			{Tag: tag.NoSecurityAltID, Name: "NoSecurityAltID", Type: FieldTypeNumInGroup, Fields: noSecurityAltIDFields},
+			{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+			{Tag: tag.CFICode, Name: "CFICode", Type: FieldTypeString},
+			{Tag: tag.CountryOfIssue, Name: "CountryOfIssue", Type: FieldTypeString},
+			{Tag: tag.StateOrProvinceOfIssue, Name: "StateOrProvinceOfIssue", Type: FieldTypeString},
+			{Tag: tag.LocaleOfIssue, Name: "LocaleOfIssue", Type: FieldTypeString},
+			{Tag: tag.SecondaryClOrdID, Name: "SecondaryClOrdID", Type: FieldTypeString},
+			{Tag: tag.MaturityDate, Name: "MaturityDate", Type: FieldTypeString},
+			{Tag: tag.InstrRegistry, Name: "InstrRegistry", Type: FieldTypeString},
+			{Tag: tag.NoLegs, Name: "NoLegs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.LegSymbol, Name: "LegSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.LegSymbolSfx, Name: "LegSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.LegSecurityID, Name: "LegSecurityID", Type: FieldTypeString},
+				{Tag: tag.LegSecurityIDSource, Name: "LegSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoLegSecurityAltID, Name: "NoLegSecurityAltID", Type: FieldTypeNumInGroup, Fields: noLegSecurityAltIDFields},
+				{Tag: tag.LegProduct, Name: "LegProduct", Type: FieldTypeInt},
+				{Tag: tag.LegCFICode, Name: "LegCFICode", Type: FieldTypeString},
+				{Tag: tag.LegSecurityType, Name: "LegSecurityType", Type: FieldTypeString},
+				{Tag: tag.LegSecuritySubType, Name: "LegSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.LegMaturityMonthYear, Name: "LegMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.LegMaturityDate, Name: "LegMaturityDate", Type: FieldTypeString},
+				{Tag: tag.LegCouponPaymentDate, Name: "LegCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.LegIssueDate, Name: "LegIssueDate", Type: FieldTypeString},
+				{Tag: tag.LegRepoCollateralSecurityType, Name: "LegRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseTerm, Name: "LegRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseRate, Name: "LegRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegFactor, Name: "LegFactor", Type: FieldTypeDecimal},
+				{Tag: tag.LegCreditRating, Name: "LegCreditRating", Type: FieldTypeString},
+				{Tag: tag.LegInstrRegistry, Name: "LegInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.LegCountryOfIssue, Name: "LegCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegStateOrProvinceOfIssue, Name: "LegStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegLocaleOfIssue, Name: "LegLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegRedemptionDate, Name: "LegRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.LegStrikePrice, Name: "LegStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.LegStrikeCurrency, Name: "LegStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.LegOptAttribute, Name: "LegOptAttribute", Type: FieldTypeString},
+				{Tag: tag.LegContractMultiplier, Name: "LegContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.LegCouponRate, Name: "LegCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegSecurityExchange, Name: "LegSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.LegIssuer, Name: "LegIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedLegIssuerLen, Name: "EncodedLegIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegIssuer, Name: "EncodedLegIssuer", Type: FieldTypeString},
+				{Tag: tag.LegSecurityDesc, Name: "LegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedLegSecurityDescLen, Name: "EncodedLegSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegSecurityDesc, Name: "EncodedLegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.LegRatioQty, Name: "LegRatioQty", Type: FieldTypeDecimal},
+				{Tag: tag.LegSide, Name: "LegSide", Type: FieldTypeString},
+				{Tag: tag.LegCurrency, Name: "LegCurrency", Type: FieldTypeString},
+				{Tag: tag.LegPool, Name: "LegPool", Type: FieldTypeString},
+				{Tag: tag.LegDatedDate, Name: "LegDatedDate", Type: FieldTypeString},
+				{Tag: tag.LegContractSettlMonth, Name: "LegContractSettlMonth", Type: FieldTypeString},
+				{Tag: tag.LegInterestAccrualDate, Name: "LegInterestAccrualDate", Type: FieldTypeString},
+			}},
+			{Tag: tag.AccountType, Name: "AccountType", Type: FieldTypeString},
+			{Tag: tag.BenchmarkPrice, Name: "BenchmarkPrice", Type: FieldTypeDecimal},
+			{Tag: tag.BenchmarkPriceType, Name: "BenchmarkPriceType", Type: FieldTypeInt},
+			{Tag: tag.ContractSettlMonth, Name: "ContractSettlMonth", Type: FieldTypeString},
+			{Tag: tag.Pool, Name: "Pool", Type: FieldTypeString},
+			{Tag: tag.BenchmarkSecurityID, Name: "BenchmarkSecurityID", Type: FieldTypeString},
+			{Tag: tag.NoUnderlyings, Name: "NoUnderlyings", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.UnderlyingSymbol, Name: "UnderlyingSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.UnderlyingSymbolSfx, Name: "UnderlyingSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityID, Name: "UnderlyingSecurityID", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityIDSource, Name: "UnderlyingSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoUnderlyingSecurityAltID, Name: "NoUnderlyingSecurityAltID", Type: FieldTypeNumInGroup, Fields: noUnderlyingSecurityAltIDFields},
+				{Tag: tag.UnderlyingProduct, Name: "UnderlyingProduct", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingCFICode, Name: "UnderlyingCFICode", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityType, Name: "UnderlyingSecurityType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecuritySubType, Name: "UnderlyingSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityMonthYear, Name: "UnderlyingMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityDate, Name: "UnderlyingMaturityDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCouponPaymentDate, Name: "UnderlyingCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssueDate, Name: "UnderlyingIssueDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRepoCollateralSecurityType, Name: "UnderlyingRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseTerm, Name: "UnderlyingRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseRate, Name: "UnderlyingRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingFactor, Name: "UnderlyingFactor", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCreditRating, Name: "UnderlyingCreditRating", Type: FieldTypeString},
+				{Tag: tag.UnderlyingInstrRegistry, Name: "UnderlyingInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCountryOfIssue, Name: "UnderlyingCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStateOrProvinceOfIssue, Name: "UnderlyingStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingLocaleOfIssue, Name: "UnderlyingLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRedemptionDate, Name: "UnderlyingRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStrikePrice, Name: "UnderlyingStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStrikeCurrency, Name: "UnderlyingStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingOptAttribute, Name: "UnderlyingOptAttribute", Type: FieldTypeString},
+				{Tag: tag.UnderlyingContractMultiplier, Name: "UnderlyingContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCouponRate, Name: "UnderlyingCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingSecurityExchange, Name: "UnderlyingSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssuer, Name: "UnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingIssuerLen, Name: "EncodedUnderlyingIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingIssuer, Name: "EncodedUnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityDesc, Name: "UnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingSecurityDescLen, Name: "EncodedUnderlyingSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingSecurityDesc, Name: "EncodedUnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPProgram, Name: "UnderlyingCPProgram", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPRegType, Name: "UnderlyingCPRegType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCurrency, Name: "UnderlyingCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingQty, Name: "UnderlyingQty", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingPx, Name: "UnderlyingPx", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingDirtyPrice, Name: "UnderlyingDirtyPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndPrice, Name: "UnderlyingEndPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStartValue, Name: "UnderlyingStartValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCurrentValue, Name: "UnderlyingCurrentValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndValue, Name: "UnderlyingEndValue", Type: FieldTypeDecimal},
+				{Tag: tag.NoUnderlyingStips, Name: "NoUnderlyingStips", Type: FieldTypeNumInGroup, Fields: noUnderlyingStipsFields},
+				{Tag: tag.CollAction, Name: "CollAction", Type: FieldTypeString},
+			}},
+			{Tag: tag.BenchmarkSecurityIDSource, Name: "BenchmarkSecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.SecuritySubType, Name: "SecuritySubType", Type: FieldTypeString},
+			{Tag: tag.NoTrdRegTimestamps, Name: "NoTrdRegTimestamps", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.TrdRegTimestamp, Name: "TrdRegTimestamp", Type: FieldTypeUTCTimestamp, Required: true},
+				{Tag: tag.TrdRegTimestampType, Name: "TrdRegTimestampType", Type: FieldTypeString},
+				{Tag: tag.TrdRegTimestampOrigin, Name: "TrdRegTimestampOrigin", Type: FieldTypeString},
+			}},
+			{Tag: tag.TerminationType, Name: "TerminationType", Type: FieldTypeString},
+			{Tag: tag.QtyType, Name: "QtyType", Type: FieldTypeString},
+			{Tag: tag.NoEvents, Name: "NoEvents", Type: FieldTypeNumInGroup, Fields: noEventsFields},
+			{Tag: tag.DatedDate, Name: "DatedDate", Type: FieldTypeString},
+			{Tag: tag.InterestAccrualDate, Name: "InterestAccrualDate", Type: FieldTypeString},
+			{Tag: tag.CPProgram, Name: "CPProgram", Type: FieldTypeString},
+			{Tag: tag.CPRegType, Name: "CPRegType", Type: FieldTypeString},
+			{Tag: tag.CollReqID, Name: "CollReqID", Type: FieldTypeString},
+			{Tag: tag.CollAsgnReason, Name: "CollAsgnReason", Type: FieldTypeString, Required: true},
+			{Tag: tag.NoTrades, Name: "NoTrades", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.TradeReportID, Name: "TradeReportID", Type: FieldTypeString, Required: true},
+				{Tag: tag.SecondaryTradeReportID, Name: "SecondaryTradeReportID", Type: FieldTypeString},
+			}},
+			{Tag: tag.MarginRatio, Name: "MarginRatio", Type: FieldTypeDecimal},
+			{Tag: tag.MarginExcess, Name: "MarginExcess", Type: FieldTypeDecimal},
+			{Tag: tag.TotalNetValue, Name: "TotalNetValue", Type: FieldTypeDecimal},
+			{Tag: tag.CashOutstanding, Name: "CashOutstanding", Type: FieldTypeDecimal},
+			{Tag: tag.CollAsgnID, Name: "CollAsgnID", Type: FieldTypeString, Required: true},
+			{Tag: tag.CollAsgnTransType, Name: "CollAsgnTransType", Type: FieldTypeString},
+			{Tag: tag.CollRespID, Name: "CollRespID", Type: FieldTypeString, Required: true},
+			{Tag: tag.CollAsgnRespType, Name: "CollAsgnRespType", Type: FieldTypeString, Required: true},
+			{Tag: tag.CollAsgnRejectReason, Name: "CollAsgnRejectReason", Type: FieldTypeString},
+			{Tag: tag.AgreementDesc, Name: "AgreementDesc", Type: FieldTypeString},
+			{Tag: tag.AgreementID, Name: "AgreementID", Type: FieldTypeString},
+			{Tag: tag.AgreementDate, Name: "AgreementDate", Type: FieldTypeString},
+			{Tag: tag.StartDate, Name: "StartDate", Type: FieldTypeString},
+			{Tag: tag.EndDate, Name: "EndDate", Type: FieldTypeString},
+			{Tag: tag.AgreementCurrency, Name: "AgreementCurrency", Type: FieldTypeString},
+			{Tag: tag.DeliveryType, Name: "DeliveryType", Type: FieldTypeString},
+			{Tag: tag.EndAccruedInterestAmt, Name: "EndAccruedInterestAmt", Type: FieldTypeDecimal},
+			{Tag: tag.StartCash, Name: "StartCash", Type: FieldTypeDecimal},
+			{Tag: tag.EndCash, Name: "EndCash", Type: FieldTypeDecimal},
+			{Tag: tag.StrikeCurrency, Name: "StrikeCurrency", Type: FieldTypeString},
+		},
+	},
+	"AK": {
+		Name:    "Confirmation",
+		MsgType: "AK",
+		Fields: []FieldDef{
+			{Tag: tag.AvgPx, Name: "AvgPx", Type: FieldTypeDecimal, Required: true},
+			{Tag: tag.Commission, Name: "Commission", Type: FieldTypeDecimal},
+			{Tag: tag.CommType, Name: "CommType", Type: FieldTypeString},
+			{Tag: tag.Currency, Name: "Currency", Type: FieldTypeString},
+			{Tag: tag.SecurityIDSource, Name: "SecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.LastMkt, Name: "LastMkt", Type: FieldTypeString},
+			{Tag: tag.SecurityID, Name: "SecurityID", Type: FieldTypeString},
+			{Tag: tag.Side, Name: "Side", Type: FieldTypeString, Required: true},
+			{Tag: tag.Symbol, Name: "Symbol", Type: FieldTypeString},
+			{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+			{Tag: tag.TransactTime, Name: "TransactTime", Type: FieldTypeUTCTimestamp, Required: true},
+			{Tag: tag.SettlType, Name: "SettlType", Type: FieldTypeString},
+			{Tag: tag.SettlDate, Name: "SettlDate", Type: FieldTypeString},
+			{Tag: tag.SymbolSfx, Name: "SymbolSfx", Type: FieldTypeString},
+			{Tag: tag.AllocID, Name: "AllocID", Type: FieldTypeString},
+			{Tag: tag.NoOrders, Name: "NoOrders", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.ClOrdID, Name: "ClOrdID", Type: FieldTypeString, Required: true},
+				{Tag: tag.OrderID, Name: "OrderID", Type: FieldTypeString},
+				{Tag: tag.SecondaryOrderID, Name: "SecondaryOrderID", Type: FieldTypeString},
+				{Tag: tag.SecondaryClOrdID, Name: "SecondaryClOrdID", Type: FieldTypeString},
+				{Tag: tag.ListID, Name: "ListID", Type: FieldTypeString},
+				{Tag: tag.NoNested2PartyIDs, Name: "NoNested2PartyIDs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+					{Tag: tag.Nested2PartyID, Name: "Nested2PartyID", Type: FieldTypeString, Required: true},
+					{Tag: tag.Nested2PartyIDSource, Name: "Nested2PartyIDSource", Type: FieldTypeString},
+					{Tag: tag.Nested2PartyRole, Name: "Nested2PartyRole", Type: FieldTypeInt},
+					{Tag: tag.NoNested2PartySubIDs, Name: "NoNested2PartySubIDs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+						{Tag: tag.Nested2PartySubID, Name: "Nested2PartySubID", Type: FieldTypeString, Required: true},
+						{Tag: tag.Nested2PartySubIDType, Name: "Nested2PartySubIDType", Type: FieldTypeInt},
+					}},
+				}},
+				{Tag: tag.OrderQty, Name: "OrderQty", Type: FieldTypeDecimal},
+				{Tag: tag.OrderAvgPx, Name: "OrderAvgPx", Type: FieldTypeDecimal},
+				{Tag: tag.OrderBookingQty, Name: "OrderBookingQty", Type: FieldTypeDecimal},
+			}},
+			{Tag: tag.AvgPxPrecision, Name: "AvgPxPrecision", Type: FieldTypeInt},
+			{Tag: tag.TradeDate, Name: "TradeDate", Type: FieldTypeString, Required: true},
+			{Tag: tag.AllocAccount, Name: "AllocAccount", Type: FieldTypeString, Required: true},
+			{Tag: tag.AllocQty, Name: "AllocQty", Type: FieldTypeDecimal, Required: true},
+			{Tag: tag.ProcessCode, Name: "ProcessCode", Type: FieldTypeString},
+			{Tag: tag.NoDlvyInst, Name: "NoDlvyInst", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.SettlInstSource, Name: "SettlInstSource", Type: FieldTypeString, Required: true},
+				{Tag: tag.DlvyInstType, Name: "DlvyInstType", Type: FieldTypeString},
+				{Tag: tag.NoSettlPartyIDs, Name: "NoSettlPartyIDs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+					{Tag: tag.SettlPartyID, Name: "SettlPartyID", Type: FieldTypeString, Required: true},
+					{Tag: tag.SettlPartyIDSource, Name: "SettlPartyIDSource", Type: FieldTypeString},
+					{Tag: tag.SettlPartyRole, Name: "SettlPartyRole", Type: FieldTypeInt},
+					{Tag: tag.NoSettlPartySubIDs, Name: "NoSettlPartySubIDs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+						{Tag: tag.SettlPartySubID, Name: "SettlPartySubID", Type: FieldTypeString, Required: true},
+						{Tag: tag.SettlPartySubIDType, Name: "SettlPartySubIDType", Type: FieldTypeInt},
+					}},
+				}},
+			}},
+			{Tag: tag.Issuer, Name: "Issuer", Type: FieldTypeString},
+			{Tag: tag.SecurityDesc, Name: "SecurityDesc", Type: FieldTypeString},
+			{Tag: tag.NetMoney, Name: "NetMoney", Type: FieldTypeDecimal, Required: true},
+			{Tag: tag.SettlCurrAmt, Name: "SettlCurrAmt", Type: FieldTypeDecimal},
+			{Tag: tag.SettlCurrency, Name: "SettlCurrency", Type: FieldTypeString},
+			{Tag: tag.NoMiscFees, Name: "NoMiscFees", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.MiscFeeAmt, Name: "MiscFeeAmt", Type: FieldTypeDecimal, Required: true},
+				{Tag: tag.MiscFeeCurr, Name: "MiscFeeCurr", Type: FieldTypeString},
+				{Tag: tag.MiscFeeType, Name: "MiscFeeType", Type: FieldTypeString},
+				{Tag: tag.MiscFeeBasis, Name: "MiscFeeBasis", Type: FieldTypeString},
+			}},
+			{Tag: tag.SettlCurrFxRate, Name: "SettlCurrFxRate", Type: FieldTypeDecimal},
+			{Tag: tag.SettlCurrFxRateCalc, Name: "SettlCurrFxRateCalc", Type: FieldTypeString},
+			{Tag: tag.NumDaysInterest, Name: "NumDaysInterest", Type: FieldTypeInt},
+			{Tag: tag.AccruedInterestRate, Name: "AccruedInterestRate", Type: FieldTypeDecimal},
+			{Tag: tag.AccruedInterestAmt, Name: "AccruedInterestAmt", Type: FieldTypeDecimal},
+			{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+			{Tag: tag.StandInstDbType, Name: "StandInstDbType", Type: FieldTypeString},
+			{Tag: tag.StandInstDbName, Name: "StandInstDbName", Type: FieldTypeString},
+			{Tag: tag.StandInstDbID, Name: "StandInstDbID", Type: FieldTypeString},
+			{Tag: tag.SettlDeliveryType, Name: "SettlDeliveryType", Type: FieldTypeString},
+			{Tag: tag.MaturityMonthYear, Name: "MaturityMonthYear", Type: FieldTypeString},
+			{Tag: tag.StrikePrice, Name: "StrikePrice", Type: FieldTypeDecimal},
+			{Tag: tag.OptAttribute, Name: "OptAttribute", Type: FieldTypeString},
+			{Tag: tag.SecurityExchange, Name: "SecurityExchange", Type: FieldTypeString},
+			{Tag: tag.Spread, Name: "Spread", Type: FieldTypeDecimal},
+			{Tag: tag.BenchmarkCurveCurrency, Name: "BenchmarkCurveCurrency", Type: FieldTypeString},
+			{Tag: tag.BenchmarkCurveName, Name: "BenchmarkCurveName", Type: FieldTypeString},
+			{Tag: tag.BenchmarkCurvePoint, Name: "BenchmarkCurvePoint", Type: FieldTypeString},
+			{Tag: tag.CouponRate, Name: "CouponRate", Type: FieldTypeDecimal},
+			{Tag: tag.CouponPaymentDate, Name: "CouponPaymentDate", Type: FieldTypeString},
+			{Tag: tag.IssueDate, Name: "IssueDate", Type: FieldTypeString},
+			{Tag: tag.RepurchaseTerm, Name: "RepurchaseTerm", Type: FieldTypeInt},
+			{Tag: tag.RepurchaseRate, Name: "RepurchaseRate", Type: FieldTypeDecimal},
+			{Tag: tag.Factor, Name: "Factor", Type: FieldTypeDecimal},
+			{Tag: tag.ExDate, Name: "ExDate", Type: FieldTypeString},
+			{Tag: tag.ContractMultiplier, Name: "ContractMultiplier", Type: FieldTypeDecimal},
+			{Tag: tag.NoStipulations, Name: "NoStipulations", Type: FieldTypeNumInGroup, Fields: noStipulationsFields},
+			{Tag: tag.YieldType, Name: "YieldType", Type: FieldTypeString},
+			{Tag: tag.Yield, Name: "Yield", Type: FieldTypeDecimal},
+			{Tag: tag.TotalTakedown, Name: "TotalTakedown", Type: FieldTypeDecimal},
+			{Tag: tag.Concession, Name: "Concession", Type: FieldTypeDecimal},
+			{Tag: tag.RepoCollateralSecurityType, Name: "RepoCollateralSecurityType", Type: FieldTypeInt},
+			{Tag: tag.RedemptionDate, Name: "RedemptionDate", Type: FieldTypeString},
+			{Tag: tag.CreditRating, Name: "CreditRating", Type: FieldTypeString},
+			{Tag: tag.EncodedIssuerLen, Name: "EncodedIssuerLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedIssuer, Name: "EncodedIssuer", Type: FieldTypeString},
+			{Tag: tag.EncodedSecurityDescLen, Name: "EncodedSecurityDescLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedSecurityDesc, Name: "EncodedSecurityDesc", Type: FieldTypeString},
+			{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			{Tag: tag.GrossTradeAmt, Name: "GrossTradeAmt", Type: FieldTypeDecimal, Required: true},
+			{Tag: tag.PriceType, Name: "PriceType", Type: FieldTypeString},
+			{Tag: tag.NoPartyIDs, Name: "NoPartyIDs", Type: FieldTypeNumInGroup, Fields: noPartyIDsFields},
+			{Tag: tag.NoSecurityAltID, Name: "NoSecurityAltID", Type: FieldTypeNumInGroup, Fields: noSecurityAltIDFields},
+			{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+			{Tag: tag.CFICode, Name: "CFICode", Type: FieldTypeString},
+			{Tag: tag.IndividualAllocID, Name: "IndividualAllocID", Type: FieldTypeString},
+			{Tag: tag.CountryOfIssue, Name: "CountryOfIssue", Type: FieldTypeString},
+			{Tag: tag.StateOrProvinceOfIssue, Name: "StateOrProvinceOfIssue", Type: FieldTypeString},
+			{Tag: tag.LocaleOfIssue, Name: "LocaleOfIssue", Type: FieldTypeString},
+			{Tag: tag.CommCurrency, Name: "CommCurrency", Type: FieldTypeString},
+			{Tag: tag.FundRenewWaiv, Name: "FundRenewWaiv", Type: FieldTypeString},
+			{Tag: tag.MaturityDate, Name: "MaturityDate", Type: FieldTypeString},
+			{Tag: tag.InstrRegistry, Name: "InstrRegistry", Type: FieldTypeString},
+			{Tag: tag.NoLegs, Name: "NoLegs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.LegSymbol, Name: "LegSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.LegSymbolSfx, Name: "LegSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.LegSecurityID, Name: "LegSecurityID", Type: FieldTypeString},
+				{Tag: tag.LegSecurityIDSource, Name: "LegSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoLegSecurityAltID, Name: "NoLegSecurityAltID", Type: FieldTypeNumInGroup, Fields: noLegSecurityAltIDFields},
+				{Tag: tag.LegProduct, Name: "LegProduct", Type: FieldTypeInt},
+				{Tag: tag.LegCFICode, Name: "LegCFICode", Type: FieldTypeString},
+				{Tag: tag.LegSecurityType, Name: "LegSecurityType", Type: FieldTypeString},
+				{Tag: tag.LegSecuritySubType, Name: "LegSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.LegMaturityMonthYear, Name: "LegMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.LegMaturityDate, Name: "LegMaturityDate", Type: FieldTypeString},
+				{Tag: tag.LegCouponPaymentDate, Name: "LegCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.LegIssueDate, Name: "LegIssueDate", Type: FieldTypeString},
+				{Tag: tag.LegRepoCollateralSecurityType, Name: "LegRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseTerm, Name: "LegRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseRate, Name: "LegRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegFactor, Name: "LegFactor", Type: FieldTypeDecimal},
+				{Tag: tag.LegCreditRating, Name: "LegCreditRating", Type: FieldTypeString},
+				{Tag: tag.LegInstrRegistry, Name: "LegInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.LegCountryOfIssue, Name: "LegCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegStateOrProvinceOfIssue, Name: "LegStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegLocaleOfIssue, Name: "LegLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegRedemptionDate, Name: "LegRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.LegStrikePrice, Name: "LegStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.LegStrikeCurrency, Name: "LegStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.LegOptAttribute, Name: "LegOptAttribute", Type: FieldTypeString},
+				{Tag: tag.LegContractMultiplier, Name: "LegContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.LegCouponRate, Name: "LegCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegSecurityExchange, Name: "LegSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.LegIssuer, Name: "LegIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedLegIssuerLen, Name: "EncodedLegIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegIssuer, Name: "EncodedLegIssuer", Type: FieldTypeString},
+				{Tag: tag.LegSecurityDesc, Name: "LegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedLegSecurityDescLen, Name: "EncodedLegSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegSecurityDesc, Name: "EncodedLegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.LegRatioQty, Name: "LegRatioQty", Type: FieldTypeDecimal},
+				{Tag: tag.LegSide, Name: "LegSide", Type: FieldTypeString},
+				{Tag: tag.LegCurrency, Name: "LegCurrency", Type: FieldTypeString},
+				{Tag: tag.LegPool, Name: "LegPool", Type: FieldTypeString},
+				{Tag: tag.LegDatedDate, Name: "LegDatedDate", Type: FieldTypeString},
+				{Tag: tag.LegContractSettlMonth, Name: "LegContractSettlMonth", Type: FieldTypeString},
+				{Tag: tag.LegInterestAccrualDate, Name: "LegInterestAccrualDate", Type: FieldTypeString},
+			}},
+			{Tag: tag.LegalConfirm, Name: "LegalConfirm", Type: FieldTypeBool},
+			{Tag: tag.AllocAcctIDSource, Name: "AllocAcctIDSource", Type: FieldTypeInt},
+			{Tag: tag.BenchmarkPrice, Name: "BenchmarkPrice", Type: FieldTypeDecimal},
+			{Tag: tag.BenchmarkPriceType, Name: "BenchmarkPriceType", Type: FieldTypeInt},
+			{Tag: tag.ConfirmID, Name: "ConfirmID", Type: FieldTypeString, Required: true},
+			{Tag: tag.ConfirmStatus, Name: "ConfirmStatus", Type: FieldTypeString, Required: true},
+			{Tag: tag.ConfirmTransType, Name: "ConfirmTransType", Type: FieldTypeString, Required: true},
+			{Tag: tag.ContractSettlMonth, Name: "ContractSettlMonth", Type: FieldTypeString},
+			{Tag: tag.DeliveryForm, Name: "DeliveryForm", Type: FieldTypeString},
+			{Tag: tag.Pool, Name: "Pool", Type: FieldTypeString},
+			{Tag: tag.YieldRedemptionDate, Name: "YieldRedemptionDate", Type: FieldTypeString},
+			{Tag: tag.YieldRedemptionPrice, Name: "YieldRedemptionPrice", Type: FieldTypeDecimal},
+			{Tag: tag.YieldRedemptionPriceType, Name: "YieldRedemptionPriceType", Type: FieldTypeInt},
+			{Tag: tag.BenchmarkSecurityID, Name: "BenchmarkSecurityID", Type: FieldTypeString},
+			{Tag: tag.YieldCalcDate, Name: "YieldCalcDate", Type: FieldTypeString},
+			{Tag: tag.NoUnderlyings, Name: "NoUnderlyings", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.UnderlyingSymbol, Name: "UnderlyingSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.UnderlyingSymbolSfx, Name: "UnderlyingSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityID, Name: "UnderlyingSecurityID", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityIDSource, Name: "UnderlyingSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoUnderlyingSecurityAltID, Name: "NoUnderlyingSecurityAltID", Type: FieldTypeNumInGroup, Fields: noUnderlyingSecurityAltIDFields},
+				{Tag: tag.UnderlyingProduct, Name: "UnderlyingProduct", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingCFICode, Name: "UnderlyingCFICode", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityType, Name: "UnderlyingSecurityType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecuritySubType, Name: "UnderlyingSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityMonthYear, Name: "UnderlyingMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityDate, Name: "UnderlyingMaturityDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCouponPaymentDate, Name: "UnderlyingCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssueDate, Name: "UnderlyingIssueDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRepoCollateralSecurityType, Name: "UnderlyingRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseTerm, Name: "UnderlyingRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseRate, Name: "UnderlyingRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingFactor, Name: "UnderlyingFactor", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCreditRating, Name: "UnderlyingCreditRating", Type: FieldTypeString},
+				{Tag: tag.UnderlyingInstrRegistry, Name: "UnderlyingInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCountryOfIssue, Name: "UnderlyingCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStateOrProvinceOfIssue, Name: "UnderlyingStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingLocaleOfIssue, Name: "UnderlyingLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRedemptionDate, Name: "UnderlyingRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStrikePrice, Name: "UnderlyingStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStrikeCurrency, Name: "UnderlyingStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingOptAttribute, Name: "UnderlyingOptAttribute", Type: FieldTypeString},
+				{Tag: tag.UnderlyingContractMultiplier, Name: "UnderlyingContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCouponRate, Name: "UnderlyingCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingSecurityExchange, Name: "UnderlyingSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssuer, Name: "UnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingIssuerLen, Name: "EncodedUnderlyingIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingIssuer, Name: "EncodedUnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityDesc, Name: "UnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingSecurityDescLen, Name: "EncodedUnderlyingSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingSecurityDesc, Name: "EncodedUnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPProgram, Name: "UnderlyingCPProgram", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPRegType, Name: "UnderlyingCPRegType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCurrency, Name: "UnderlyingCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingQty, Name: "UnderlyingQty", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingPx, Name: "UnderlyingPx", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingDirtyPrice, Name: "UnderlyingDirtyPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndPrice, Name: "UnderlyingEndPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStartValue, Name: "UnderlyingStartValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCurrentValue, Name: "UnderlyingCurrentValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndValue, Name: "UnderlyingEndValue", Type: FieldTypeDecimal},
+				{Tag: tag.NoUnderlyingStips, Name: "NoUnderlyingStips", Type: FieldTypeNumInGroup, Fields: noUnderlyingStipsFields},
+			}},
+			{Tag: tag.InterestAtMaturity, Name: "InterestAtMaturity", Type: FieldTypeDecimal},
+			{Tag: tag.BenchmarkSecurityIDSource, Name: "BenchmarkSecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.SecuritySubType, Name: "SecuritySubType", Type: FieldTypeString},
+			{Tag: tag.NoTrdRegTimestamps, Name: "NoTrdRegTimestamps", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.TrdRegTimestamp, Name: "TrdRegTimestamp", Type: FieldTypeUTCTimestamp, Required: true},
+				{Tag: tag.TrdRegTimestampType, Name: "TrdRegTimestampType", Type: FieldTypeString},
+				{Tag: tag.TrdRegTimestampOrigin, Name: "TrdRegTimestampOrigin", Type: FieldTypeString},
+			}},
+			{Tag: tag.ConfirmRefID, Name: "ConfirmRefID", Type: FieldTypeString},
+			{Tag: tag.ConfirmType, Name: "ConfirmType", Type: FieldTypeString, Required: true},
+			{Tag: tag.TerminationType, Name: "TerminationType", Type: FieldTypeString},
+			{Tag: tag.SecondaryAllocID, Name: "SecondaryAllocID", Type: FieldTypeString},
+			{Tag: tag.CopyMsgIndicator, Name: "CopyMsgIndicator", Type: FieldTypeBool},
+			{Tag: tag.AllocAccountType, Name: "AllocAccountType", Type: FieldTypeString},
+			{Tag: tag.QtyType, Name: "QtyType", Type: FieldTypeString},
+			{Tag: tag.SharedCommission, Name: "SharedCommission", Type: FieldTypeDecimal},
+			{Tag: tag.ConfirmReqID, Name: "ConfirmReqID", Type: FieldTypeString},
+			{Tag: tag.AvgParPx, Name: "AvgParPx", Type: FieldTypeDecimal},
+			{Tag: tag.ReportedPx, Name: "ReportedPx", Type: FieldTypeDecimal},
+			{Tag: tag.NoCapacities, Name: "NoCapacities", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.OrderCapacity, Name: "OrderCapacity", Type: FieldTypeString, Required: true},
+				{Tag: tag.OrderRestrictions, Name: "OrderRestrictions", Type: FieldTypeString},
+				{Tag: tag.OrderCapacityQty, Name: "OrderCapacityQty", Type: FieldTypeDecimal},
+			}},
+			{Tag: tag.NoEvents, Name: "NoEvents", Type: FieldTypeNumInGroup, Fields: noEventsFields},
+			{Tag: tag.PctAtRisk, Name: "PctAtRisk", Type: FieldTypeDecimal},
+			{Tag: tag.NoInstrAttrib, Name: "NoInstrAttrib", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.InstrAttribType, Name: "InstrAttribType", Type: FieldTypeString, Required: true},
+				{Tag: tag.InstrAttribValue, Name: "InstrAttribValue", Type: FieldTypeString},
+			}},
+			{Tag: tag.DatedDate, Name: "DatedDate", Type: FieldTypeString},
+			{Tag: tag.InterestAccrualDate, Name: "InterestAccrualDate", Type: FieldTypeString},
+			{Tag: tag.CPProgram, Name: "CPProgram", Type: FieldTypeString},
+			{Tag: tag.CPRegType, Name: "CPRegType", Type: FieldTypeString},
+			{Tag: tag.MaturityNetMoney, Name: "MaturityNetMoney", Type: FieldTypeDecimal},
+			{Tag: tag.MarginRatio, Name: "MarginRatio", Type: FieldTypeDecimal},
+			{Tag: tag.AgreementDesc, Name: "AgreementDesc", Type: FieldTypeString},
+			{Tag: tag.AgreementID, Name: "AgreementID", Type: FieldTypeString},
+			{Tag: tag.AgreementDate, Name: "AgreementDate", Type: FieldTypeString},
+			{Tag: tag.StartDate, Name: "StartDate", Type: FieldTypeString},
+			{Tag: tag.EndDate, Name: "EndDate", Type: FieldTypeString},
+			{Tag: tag.AgreementCurrency, Name: "AgreementCurrency", Type: FieldTypeString},
+			{Tag: tag.DeliveryType, Name: "DeliveryType", Type: FieldTypeString},
+			{Tag: tag.EndAccruedInterestAmt, Name: "EndAccruedInterestAmt", Type: FieldTypeDecimal},
+			{Tag: tag.StartCash, Name: "StartCash", Type: FieldTypeDecimal},
+			{Tag: tag.EndCash, Name: "EndCash", Type: FieldTypeDecimal},
+			{Tag: tag.StrikeCurrency, Name: "StrikeCurrency", Type: FieldTypeString},
+		},
+	},
+	"AU": {
+		Name:    "ConfirmationAck",
+		MsgType: "AU",
+		Fields: []FieldDef{
+			{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+			{Tag: tag.TransactTime, Name: "TransactTime", Type: FieldTypeUTCTimestamp, Required: true},
+			{Tag: tag.TradeDate, Name: "TradeDate", Type: FieldTypeString, Required: true},
+			{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			{Tag: tag.MatchStatus, Name: "MatchStatus", Type: FieldTypeString},
+			{Tag: tag.ConfirmID, Name: "ConfirmID", Type: FieldTypeString, Required: true},
+			{Tag: tag.ConfirmRejReason, Name: "ConfirmRejReason", Type: FieldTypeString},
+			{Tag: tag.AffirmStatus, Name: "AffirmStatus", Type: FieldTypeString, Required: true},
+		},
+	},
+	"BH": {
+		Name:    "ConfirmationRequest",
+		MsgType: "BH",
+		Fields: []FieldDef{
+			{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+			{Tag: tag.TransactTime, Name: "TransactTime", Type: FieldTypeUTCTimestamp, Required: true},
+			{Tag: tag.AllocID, Name: "AllocID", Type: FieldTypeString},
+			{Tag: tag.NoOrders, Name: "NoOrders", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.ClOrdID, Name: "ClOrdID", Type: FieldTypeString, Required: true},
+				{Tag: tag.OrderID, Name: "OrderID", Type: FieldTypeString},
+				{Tag: tag.SecondaryOrderID, Name: "SecondaryOrderID", Type: FieldTypeString},
+				{Tag: tag.SecondaryClOrdID, Name: "SecondaryClOrdID", Type: FieldTypeString},
+				{Tag: tag.ListID, Name: "ListID", Type: FieldTypeString},
+				{Tag: tag.NoNested2PartyIDs, Name: "NoNested2PartyIDs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+					{Tag: tag.Nested2PartyID, Name: "Nested2PartyID", Type: FieldTypeString, Required: true},
+					{Tag: tag.Nested2PartyIDSource, Name: "Nested2PartyIDSource", Type: FieldTypeString},
+					{Tag: tag.Nested2PartyRole, Name: "Nested2PartyRole", Type: FieldTypeInt},
+					{Tag: tag.NoNested2PartySubIDs, Name: "NoNested2PartySubIDs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+						{Tag: tag.Nested2PartySubID, Name: "Nested2PartySubID", Type: FieldTypeString, Required: true},
+						{Tag: tag.Nested2PartySubIDType, Name: "Nested2PartySubIDType", Type: FieldTypeInt},
+					}},
+				}},
+				{Tag: tag.OrderQty, Name: "OrderQty", Type: FieldTypeDecimal},
+				{Tag: tag.OrderAvgPx, Name: "OrderAvgPx", Type: FieldTypeDecimal},
+				{Tag: tag.OrderBookingQty, Name: "OrderBookingQty", Type: FieldTypeDecimal},
+			}},
+			{Tag: tag.AllocAccount, Name: "AllocAccount", Type: FieldTypeString},
+			{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			{Tag: tag.IndividualAllocID, Name: "IndividualAllocID", Type: FieldTypeString},
+			{Tag: tag.AllocAcctIDSource, Name: "AllocAcctIDSource", Type: FieldTypeInt},
+			{Tag: tag.ConfirmType, Name: "ConfirmType", Type: FieldTypeString, Required: true},
+			{Tag: tag.SecondaryAllocID, Name: "SecondaryAllocID", Type: FieldTypeString},
+			{Tag: tag.AllocAccountType, Name: "AllocAccountType", Type: FieldTypeString},
+			{Tag: tag.ConfirmReqID, Name: "ConfirmReqID", Type: FieldTypeString, Required: true},
+		},
+	},
+	"t": {
+		Name:    "CrossOrderCancelReplaceRequest",
+		MsgType: "t",
+		Fields: []FieldDef{
+			{Tag: tag.Currency, Name: "Currency", Type: FieldTypeString},
+			{Tag: tag.ExecInst, Name: "ExecInst", Type: FieldTypeString},
+			{Tag: tag.HandlInst, Name: "HandlInst", Type: FieldTypeString},
+			{Tag: tag.SecurityIDSource, Name: "SecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.IOIID, Name: "IOIID", Type: FieldTypeString},
+			{Tag: tag.OrderID, Name: "OrderID", Type: FieldTypeString},
+			{Tag: tag.OrdType, Name: "OrdType", Type: FieldTypeString, Required: true},
+			{Tag: tag.Price, Name: "Price", Type: FieldTypeDecimal},
+			{Tag: tag.SecurityID, Name: "SecurityID", Type: FieldTypeString},
+			{Tag: tag.Symbol, Name: "Symbol", Type: FieldTypeString},
+			{Tag: tag.TimeInForce, Name: "TimeInForce", Type: FieldTypeString},
+			{Tag: tag.TransactTime, Name: "TransactTime", Type: FieldTypeUTCTimestamp, Required: true},
+			{Tag: tag.SettlType, Name: "SettlType", Type: FieldTypeString},
+			{Tag: tag.SettlDate, Name: "SettlDate", Type: FieldTypeString},
+			{Tag: tag.SymbolSfx, Name: "SymbolSfx", Type: FieldTypeString},
+			{Tag: tag.ProcessCode, Name: "ProcessCode", Type: FieldTypeString},
+			{Tag: tag.StopPx, Name: "StopPx", Type: FieldTypeDecimal},
+			{Tag: tag.ExDestination, Name: "ExDestination", Type: FieldTypeString},
+			{Tag: tag.Issuer, Name: "Issuer", Type: FieldTypeString},
+			{Tag: tag.SecurityDesc, Name: "SecurityDesc", Type: FieldTypeString},
+			{Tag: tag.MinQty, Name: "MinQty", Type: FieldTypeDecimal},
+			{Tag: tag.MaxFloor, Name: "MaxFloor", Type: FieldTypeDecimal},
+			{Tag: tag.LocateReqd, Name: "LocateReqd", Type: FieldTypeBool},
+			{Tag: tag.QuoteID, Name: "QuoteID", Type: FieldTypeString},
+			{Tag: tag.ExpireTime, Name: "ExpireTime", Type: FieldTypeUTCTimestamp},
+			{Tag: tag.PrevClosePx, Name: "PrevClosePx", Type: FieldTypeDecimal},
+			{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+			{Tag: tag.EffectiveTime, Name: "EffectiveTime", Type: FieldTypeUTCTimestamp},
+			{Tag: tag.MaturityMonthYear, Name: "MaturityMonthYear", Type: FieldTypeString},
+			{Tag: tag.StrikePrice, Name: "StrikePrice", Type: FieldTypeDecimal},
+			{Tag: tag.OptAttribute, Name: "OptAttribute", Type: FieldTypeString},
+			{Tag: tag.SecurityExchange, Name: "SecurityExchange", Type: FieldTypeString},
+			{Tag: tag.MaxShow, Name: "MaxShow", Type: FieldTypeDecimal},
+			{Tag: tag.PegOffsetValue, Name: "PegOffsetValue", Type: FieldTypeDecimal},
+			{Tag: tag.Spread, Name: "Spread", Type: FieldTypeDecimal},
+			{Tag: tag.BenchmarkCurveCurrency, Name: "BenchmarkCurveCurrency", Type: FieldTypeString},
+			{Tag: tag.BenchmarkCurveName, Name: "BenchmarkCurveName", Type: FieldTypeString},
+			{Tag: tag.BenchmarkCurvePoint, Name: "BenchmarkCurvePoint", Type: FieldTypeString},
+			{Tag: tag.CouponRate, Name: "CouponRate", Type: FieldTypeDecimal},
+			{Tag: tag.CouponPaymentDate, Name: "CouponPaymentDate", Type: FieldTypeString},
+			{Tag: tag.IssueDate, Name: "IssueDate", Type: FieldTypeString},
+			{Tag: tag.RepurchaseTerm, Name: "RepurchaseTerm", Type: FieldTypeInt},
+			{Tag: tag.RepurchaseRate, Name: "RepurchaseRate", Type: FieldTypeDecimal},
+			{Tag: tag.Factor, Name: "Factor", Type: FieldTypeDecimal},
+			{Tag: tag.ContractMultiplier, Name: "ContractMultiplier", Type: FieldTypeDecimal},
+			{Tag: tag.NoStipulations, Name: "NoStipulations", Type: FieldTypeNumInGroup, Fields: noStipulationsFields},
+			{Tag: tag.YieldType, Name: "YieldType", Type: FieldTypeString},
+			{Tag: tag.Yield, Name: "Yield", Type: FieldTypeDecimal},
+			{Tag: tag.RepoCollateralSecurityType, Name: "RepoCollateralSecurityType", Type: FieldTypeInt},
+			{Tag: tag.RedemptionDate, Name: "RedemptionDate", Type: FieldTypeString},
+			{Tag: tag.CreditRating, Name: "CreditRating", Type: FieldTypeString},
+			{Tag: tag.EncodedIssuerLen, Name: "EncodedIssuerLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedIssuer, Name: "EncodedIssuer", Type: FieldTypeString},
+			{Tag: tag.EncodedSecurityDescLen, Name: "EncodedSecurityDescLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedSecurityDesc, Name: "EncodedSecurityDesc", Type: FieldTypeString},
+			{Tag: tag.ComplianceID, Name: "ComplianceID", Type: FieldTypeString},
+			{Tag: tag.NoTradingSessions, Name: "NoTradingSessions", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.TradingSessionID, Name: "TradingSessionID", Type: FieldTypeString, Required: true},
+				{Tag: tag.TradingSessionSubID, Name: "TradingSessionSubID", Type: FieldTypeString},
+			}},
+			{Tag: tag.DiscretionInst, Name: "DiscretionInst", Type: FieldTypeString},
+			{Tag: tag.DiscretionOffsetValue, Name: "DiscretionOffsetValue", Type: FieldTypeDecimal},
+			{Tag: tag.PriceType, Name: "PriceType", Type: FieldTypeString},
+			{Tag: tag.GTBookingInst, Name: "GTBookingInst", Type: FieldTypeString},
+			{Tag: tag.ExpireDate, Name: "ExpireDate", Type: FieldTypeString},
+			{Tag: tag.NoSecurityAltID, Name: "NoSecurityAltID", Type: FieldTypeNumInGroup, Fields: noSecurityAltIDFields},
+			{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+			{Tag: tag.CFICode, Name: "CFICode", Type: FieldTypeString},
+			{Tag: tag.CountryOfIssue, Name: "CountryOfIssue", Type: FieldTypeString},
+			{Tag: tag.StateOrProvinceOfIssue, Name: "StateOrProvinceOfIssue", Type: FieldTypeString},
+			{Tag: tag.LocaleOfIssue, Name: "LocaleOfIssue", Type: FieldTypeString},
+			{Tag: tag.CancellationRights, Name: "CancellationRights", Type: FieldTypeString},
+			{Tag: tag.MoneyLaunderingStatus, Name: "MoneyLaunderingStatus", Type: FieldTypeString},
+			{Tag: tag.Designation, Name: "Designation", Type: FieldTypeString},
+			{Tag: tag.RegistID, Name: "RegistID", Type: FieldTypeString},
+			{Tag: tag.MaturityDate, Name: "MaturityDate", Type: FieldTypeString},
+			{Tag: tag.InstrRegistry, Name: "InstrRegistry", Type: FieldTypeString},
+			{Tag: tag.CrossID, Name: "CrossID", Type: FieldTypeString, Required: true},
+			{Tag: tag.CrossType, Name: "CrossType", Type: FieldTypeString, Required: true},
+			{Tag: tag.CrossPrioritization, Name: "CrossPrioritization", Type: FieldTypeString, Required: true},
+			{Tag: tag.OrigCrossID, Name: "OrigCrossID", Type: FieldTypeString, Required: true},
+			{Tag: tag.NoSides, Name: "NoSides", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.Side, Name: "Side", Type: FieldTypeString, Required: true},
+				{Tag: tag.OrigClOrdID, Name: "OrigClOrdID", Type: FieldTypeString},
+				{Tag: tag.ClOrdID, Name: "ClOrdID", Type: FieldTypeString},
+				{Tag: tag.SecondaryClOrdID, Name: "SecondaryClOrdID", Type: FieldTypeString},
+				{Tag: tag.ClOrdLinkID, Name: "ClOrdLinkID", Type: FieldTypeString},
+				{Tag: tag.OrigOrdModTime, Name: "OrigOrdModTime", Type: FieldTypeUTCTimestamp},
+				{Tag: tag.NoPartyIDs, Name: "NoPartyIDs", Type: FieldTypeNumInGroup, Fields: noPartyIDsFields},
+				{Tag: tag.TradeOriginationDate, Name: "TradeOriginationDate", Type: FieldTypeString},
+				{Tag: tag.TradeDate, Name: "TradeDate", Type: FieldTypeString},
+				{Tag: tag.Account, Name: "Account", Type: FieldTypeString},
+				{Tag: tag.AcctIDSource, Name: "AcctIDSource", Type: FieldTypeString},
+				{Tag: tag.AccountType, Name: "AccountType", Type: FieldTypeString},
+				{Tag: tag.DayBookingInst, Name: "DayBookingInst", Type: FieldTypeString},
+				{Tag: tag.BookingUnit, Name: "BookingUnit", Type: FieldTypeString},
+				{Tag: tag.PreallocMethod, Name: "PreallocMethod", Type: FieldTypeString},
+				{Tag: tag.AllocID, Name: "AllocID", Type: FieldTypeString},
+				{Tag: tag.NoAllocs, Name: "NoAllocs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+					{Tag: tag.AllocAccount, Name: "AllocAccount", Type: FieldTypeString, Required: true},
+					{Tag: tag.AllocAcctIDSource, Name: "AllocAcctIDSource", Type: FieldTypeInt},
+					{Tag: tag.AllocSettlCurrency, Name: "AllocSettlCurrency", Type: FieldTypeString},
+					{Tag: tag.IndividualAllocID, Name: "IndividualAllocID", Type: FieldTypeString},
+					{Tag: tag.NoNestedPartyIDs, Name: "NoNestedPartyIDs", Type: FieldTypeNumInGroup, Fields: noNestedPartyIDsFields},
+					{Tag: tag.AllocQty, Name: "AllocQty", Type: FieldTypeDecimal},
+				}},
+				{Tag: tag.QtyType, Name: "QtyType", Type: FieldTypeString},
+				{Tag: tag.OrderQty, Name: "OrderQty", Type: FieldTypeDecimal},
+				{Tag: tag.CashOrderQty, Name: "CashOrderQty", Type: FieldTypeDecimal},
+				{Tag: tag.OrderPercent, Name: "OrderPercent", Type: FieldTypeDecimal},
+				{Tag: tag.RoundingDirection, Name: "RoundingDirection", Type: FieldTypeString},
+				{Tag: tag.RoundingModulus, Name: "RoundingModulus", Type: FieldTypeDecimal},
+				{Tag: tag.Commission, Name: "Commission", Type: FieldTypeDecimal},
+				{Tag: tag.CommType, Name: "CommType", Type: FieldTypeString},
+				{Tag: tag.CommCurrency, Name: "CommCurrency", Type: FieldTypeString},
+				{Tag: tag.FundRenewWaiv, Name: "FundRenewWaiv", Type: FieldTypeString},
+				{Tag: tag.OrderCapacity, Name: "OrderCapacity", Type: FieldTypeString},
+				{Tag: tag.OrderRestrictions, Name: "OrderRestrictions", Type: FieldTypeString},
+				{Tag: tag.CustOrderCapacity, Name: "CustOrderCapacity", Type: FieldTypeString},
+				{Tag: tag.ForexReq, Name: "ForexReq", Type: FieldTypeBool},
+				{Tag: tag.SettlCurrency, Name: "SettlCurrency", Type: FieldTypeString},
+				{Tag: tag.BookingType, Name: "BookingType", Type: FieldTypeString},
+				{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+				{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+				{Tag: tag.PositionEffect, Name: "PositionEffect", Type: FieldTypeString},
+				{Tag: tag.CoveredOrUncovered, Name: "CoveredOrUncovered", Type: FieldTypeString},
+				{Tag: tag.CashMargin, Name: "CashMargin", Type: FieldTypeString},
+				{Tag: tag.ClearingFeeIndicator, Name: "ClearingFeeIndicator", Type: FieldTypeString},
+				{Tag: tag.SolicitedFlag, Name: "SolicitedFlag", Type: FieldTypeBool},
+				{Tag: tag.SideComplianceID, Name: "SideComplianceID", Type: FieldTypeString},
+			}},
+			{Tag: tag.NoLegs, Name: "NoLegs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.LegSymbol, Name: "LegSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.LegSymbolSfx, Name: "LegSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.LegSecurityID, Name: "LegSecurityID", Type: FieldTypeString},
+				{Tag: tag.LegSecurityIDSource, Name: "LegSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoLegSecurityAltID, Name: "NoLegSecurityAltID", Type: FieldTypeNumInGroup, Fields: noLegSecurityAltIDFields},
+				{Tag: tag.LegProduct, Name: "LegProduct", Type: FieldTypeInt},
+				{Tag: tag.LegCFICode, Name: "LegCFICode", Type: FieldTypeString},
+				{Tag: tag.LegSecurityType, Name: "LegSecurityType", Type: FieldTypeString},
+				{Tag: tag.LegSecuritySubType, Name: "LegSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.LegMaturityMonthYear, Name: "LegMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.LegMaturityDate, Name: "LegMaturityDate", Type: FieldTypeString},
+				{Tag: tag.LegCouponPaymentDate, Name: "LegCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.LegIssueDate, Name: "LegIssueDate", Type: FieldTypeString},
+				{Tag: tag.LegRepoCollateralSecurityType, Name: "LegRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseTerm, Name: "LegRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseRate, Name: "LegRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegFactor, Name: "LegFactor", Type: FieldTypeDecimal},
+				{Tag: tag.LegCreditRating, Name: "LegCreditRating", Type: FieldTypeString},
+				{Tag: tag.LegInstrRegistry, Name: "LegInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.LegCountryOfIssue, Name: "LegCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegStateOrProvinceOfIssue, Name: "LegStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegLocaleOfIssue, Name: "LegLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegRedemptionDate, Name: "LegRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.LegStrikePrice, Name: "LegStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.LegStrikeCurrency, Name: "LegStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.LegOptAttribute, Name: "LegOptAttribute", Type: FieldTypeString},
+				{Tag: tag.LegContractMultiplier, Name: "LegContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.LegCouponRate, Name: "LegCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegSecurityExchange, Name: "LegSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.LegIssuer, Name: "LegIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedLegIssuerLen, Name: "EncodedLegIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegIssuer, Name: "EncodedLegIssuer", Type: FieldTypeString},
+				{Tag: tag.LegSecurityDesc, Name: "LegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedLegSecurityDescLen, Name: "EncodedLegSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegSecurityDesc, Name: "EncodedLegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.LegRatioQty, Name: "LegRatioQty", Type: FieldTypeDecimal},
+				{Tag: tag.LegSide, Name: "LegSide", Type: FieldTypeString},
+				{Tag: tag.LegCurrency, Name: "LegCurrency", Type: FieldTypeString},
+				{Tag: tag.LegPool, Name: "LegPool", Type: FieldTypeString},
+				{Tag: tag.LegDatedDate, Name: "LegDatedDate", Type: FieldTypeString},
+				{Tag: tag.LegContractSettlMonth, Name: "LegContractSettlMonth", Type: FieldTypeString},
+				{Tag: tag.LegInterestAccrualDate, Name: "LegInterestAccrualDate", Type: FieldTypeString},
+			}},
+			{Tag: tag.BenchmarkPrice, Name: "BenchmarkPrice", Type: FieldTypeDecimal},
+			{Tag: tag.BenchmarkPriceType, Name: "BenchmarkPriceType", Type: FieldTypeInt},
+			{Tag: tag.ContractSettlMonth, Name: "ContractSettlMonth", Type: FieldTypeString},
+			{Tag: tag.Pool, Name: "Pool", Type: FieldTypeString},
+			{Tag: tag.YieldRedemptionDate, Name: "YieldRedemptionDate", Type: FieldTypeString},
+			{Tag: tag.YieldRedemptionPrice, Name: "YieldRedemptionPrice", Type: FieldTypeDecimal},
+			{Tag: tag.YieldRedemptionPriceType, Name: "YieldRedemptionPriceType", Type: FieldTypeInt},
+			{Tag: tag.BenchmarkSecurityID, Name: "BenchmarkSecurityID", Type: FieldTypeString},
+			{Tag: tag.YieldCalcDate, Name: "YieldCalcDate", Type: FieldTypeString},
+			{Tag: tag.NoUnderlyings, Name: "NoUnderlyings", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.UnderlyingSymbol, Name: "UnderlyingSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.UnderlyingSymbolSfx, Name: "UnderlyingSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityID, Name: "UnderlyingSecurityID", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityIDSource, Name: "UnderlyingSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoUnderlyingSecurityAltID, Name: "NoUnderlyingSecurityAltID", Type: FieldTypeNumInGroup, Fields: noUnderlyingSecurityAltIDFields},
+				{Tag: tag.UnderlyingProduct, Name: "UnderlyingProduct", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingCFICode, Name: "UnderlyingCFICode", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityType, Name: "UnderlyingSecurityType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecuritySubType, Name: "UnderlyingSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityMonthYear, Name: "UnderlyingMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityDate, Name: "UnderlyingMaturityDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCouponPaymentDate, Name: "UnderlyingCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssueDate, Name: "UnderlyingIssueDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRepoCollateralSecurityType, Name: "UnderlyingRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseTerm, Name: "UnderlyingRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseRate, Name: "UnderlyingRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingFactor, Name: "UnderlyingFactor", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCreditRating, Name: "UnderlyingCreditRating", Type: FieldTypeString},
+				{Tag: tag.UnderlyingInstrRegistry, Name: "UnderlyingInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCountryOfIssue, Name: "UnderlyingCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStateOrProvinceOfIssue, Name: "UnderlyingStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingLocaleOfIssue, Name: "UnderlyingLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRedemptionDate, Name: "UnderlyingRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStrikePrice, Name: "UnderlyingStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStrikeCurrency, Name: "UnderlyingStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingOptAttribute, Name: "UnderlyingOptAttribute", Type: FieldTypeString},
+				{Tag: tag.UnderlyingContractMultiplier, Name: "UnderlyingContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCouponRate, Name: "UnderlyingCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingSecurityExchange, Name: "UnderlyingSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssuer, Name: "UnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingIssuerLen, Name: "EncodedUnderlyingIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingIssuer, Name: "EncodedUnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityDesc, Name: "UnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingSecurityDescLen, Name: "EncodedUnderlyingSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingSecurityDesc, Name: "EncodedUnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPProgram, Name: "UnderlyingCPProgram", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPRegType, Name: "UnderlyingCPRegType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCurrency, Name: "UnderlyingCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingQty, Name: "UnderlyingQty", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingPx, Name: "UnderlyingPx", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingDirtyPrice, Name: "UnderlyingDirtyPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndPrice, Name: "UnderlyingEndPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStartValue, Name: "UnderlyingStartValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCurrentValue, Name: "UnderlyingCurrentValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndValue, Name: "UnderlyingEndValue", Type: FieldTypeDecimal},
+				{Tag: tag.NoUnderlyingStips, Name: "NoUnderlyingStips", Type: FieldTypeNumInGroup, Fields: noUnderlyingStipsFields},
+			}},
+			{Tag: tag.BenchmarkSecurityIDSource, Name: "BenchmarkSecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.SecuritySubType, Name: "SecuritySubType", Type: FieldTypeString},
+			{Tag: tag.PegMoveType, Name: "PegMoveType", Type: FieldTypeString},
+			{Tag: tag.PegOffsetType, Name: "PegOffsetType", Type: FieldTypeString},
+			{Tag: tag.PegLimitType, Name: "PegLimitType", Type: FieldTypeString},
+			{Tag: tag.PegRoundDirection, Name: "PegRoundDirection", Type: FieldTypeString},
+			{Tag: tag.PegScope, Name: "PegScope", Type: FieldTypeString},
+			{Tag: tag.DiscretionMoveType, Name: "DiscretionMoveType", Type: FieldTypeString},
+			{Tag: tag.DiscretionOffsetType, Name: "DiscretionOffsetType", Type: FieldTypeString},
+			{Tag: tag.DiscretionLimitType, Name: "DiscretionLimitType", Type: FieldTypeString},
+			{Tag: tag.DiscretionRoundDirection, Name: "DiscretionRoundDirection", Type: FieldTypeString},
+			{Tag: tag.DiscretionScope, Name: "DiscretionScope", Type: FieldTypeString},
+			{Tag: tag.TargetStrategy, Name: "TargetStrategy", Type: FieldTypeString},
+			{Tag: tag.TargetStrategyParameters, Name: "TargetStrategyParameters", Type: FieldTypeString},
+			{Tag: tag.ParticipationRate, Name: "ParticipationRate", Type: FieldTypeDecimal},
+			{Tag: tag.NoEvents, Name: "NoEvents", Type: FieldTypeNumInGroup, Fields: noEventsFields},
+			{Tag: tag.DatedDate, Name: "DatedDate", Type: FieldTypeString},
+			{Tag: tag.InterestAccrualDate, Name: "InterestAccrualDate", Type: FieldTypeString},
+			{Tag: tag.CPProgram, Name: "CPProgram", Type: FieldTypeString},
+			{Tag: tag.CPRegType, Name: "CPRegType", Type: FieldTypeString},
+			{Tag: tag.StrikeCurrency, Name: "StrikeCurrency", Type: FieldTypeString},
+		},
+	},
+	"u": {
+		Name:    "CrossOrderCancelRequest",
+		MsgType: "u",
+		Fields: []FieldDef{
+			{Tag: tag.SecurityIDSource, Name: "SecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.OrderID, Name: "OrderID", Type: FieldTypeString},
+			{Tag: tag.SecurityID, Name: "SecurityID", Type: FieldTypeString},
+			{Tag: tag.Symbol, Name: "Symbol", Type: FieldTypeString},
+			{Tag: tag.TransactTime, Name: "TransactTime", Type: FieldTypeUTCTimestamp, Required: true},
+			{Tag: tag.SymbolSfx, Name: "SymbolSfx", Type: FieldTypeString},
+			{Tag: tag.Issuer, Name: "Issuer", Type: FieldTypeString},
+			{Tag: tag.SecurityDesc, Name: "SecurityDesc", Type: FieldTypeString},
+			{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+			{Tag: tag.MaturityMonthYear, Name: "MaturityMonthYear", Type: FieldTypeString},
+			{Tag: tag.StrikePrice, Name: "StrikePrice", Type: FieldTypeDecimal},
+			{Tag: tag.OptAttribute, Name: "OptAttribute", Type: FieldTypeString},
+			{Tag: tag.SecurityExchange, Name: "SecurityExchange", Type: FieldTypeString},
+			{Tag: tag.CouponRate, Name: "CouponRate", Type: FieldTypeDecimal},
+			{Tag: tag.CouponPaymentDate, Name: "CouponPaymentDate", Type: FieldTypeString},
+			{Tag: tag.IssueDate, Name: "IssueDate", Type: FieldTypeString},
+			{Tag: tag.RepurchaseTerm, Name: "RepurchaseTerm", Type: FieldTypeInt},
+			{Tag: tag.RepurchaseRate, Name: "RepurchaseRate", Type: FieldTypeDecimal},
+			{Tag: tag.Factor, Name: "Factor", Type: FieldTypeDecimal},
+			{Tag: tag.ContractMultiplier, Name: "ContractMultiplier", Type: FieldTypeDecimal},
+			{Tag: tag.RepoCollateralSecurityType, Name: "RepoCollateralSecurityType", Type: FieldTypeInt},
+			{Tag: tag.RedemptionDate, Name: "RedemptionDate", Type: FieldTypeString},
+			{Tag: tag.CreditRating, Name: "CreditRating", Type: FieldTypeString},
+			{Tag: tag.EncodedIssuerLen, Name: "EncodedIssuerLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedIssuer, Name: "EncodedIssuer", Type: FieldTypeString},
+			{Tag: tag.EncodedSecurityDescLen, Name: "EncodedSecurityDescLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedSecurityDesc, Name: "EncodedSecurityDesc", Type: FieldTypeString},
+			{Tag: tag.NoSecurityAltID, Name: "NoSecurityAltID", Type: FieldTypeNumInGroup, Fields: noSecurityAltIDFields},
+			{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+			{Tag: tag.CFICode, Name: "CFICode", Type: FieldTypeString},
+			{Tag: tag.CountryOfIssue, Name: "CountryOfIssue", Type: FieldTypeString},
+			{Tag: tag.StateOrProvinceOfIssue, Name: "StateOrProvinceOfIssue", Type: FieldTypeString},
+			{Tag: tag.LocaleOfIssue, Name: "LocaleOfIssue", Type: FieldTypeString},
+			{Tag: tag.MaturityDate, Name: "MaturityDate", Type: FieldTypeString},
+			{Tag: tag.InstrRegistry, Name: "InstrRegistry", Type: FieldTypeString},
+			{Tag: tag.CrossID, Name: "CrossID", Type: FieldTypeString, Required: true},
+			{Tag: tag.CrossType, Name: "CrossType", Type: FieldTypeString, Required: true},
+			{Tag: tag.CrossPrioritization, Name: "CrossPrioritization", Type: FieldTypeString, Required: true},
+			{Tag: tag.OrigCrossID, Name: "OrigCrossID", Type: FieldTypeString, Required: true},
+			{Tag: tag.NoSides, Name: "NoSides", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.Side, Name: "Side", Type: FieldTypeString, Required: true},
+				{Tag: tag.OrigClOrdID, Name: "OrigClOrdID", Type: FieldTypeString},
+				{Tag: tag.ClOrdID, Name: "ClOrdID", Type: FieldTypeString},
+				{Tag: tag.SecondaryClOrdID, Name: "SecondaryClOrdID", Type: FieldTypeString},
+				{Tag: tag.ClOrdLinkID, Name: "ClOrdLinkID", Type: FieldTypeString},
+				{Tag: tag.OrigOrdModTime, Name: "OrigOrdModTime", Type: FieldTypeUTCTimestamp},
+				{Tag: tag.NoPartyIDs, Name: "NoPartyIDs", Type: FieldTypeNumInGroup, Fields: noPartyIDsFields},
+				{Tag: tag.TradeOriginationDate, Name: "TradeOriginationDate", Type: FieldTypeString},
+				{Tag: tag.TradeDate, Name: "TradeDate", Type: FieldTypeString},
+				{Tag: tag.OrderQty, Name: "OrderQty", Type: FieldTypeDecimal},
+				{Tag: tag.CashOrderQty, Name: "CashOrderQty", Type: FieldTypeDecimal},
+				{Tag: tag.OrderPercent, Name: "OrderPercent", Type: FieldTypeDecimal},
+				{Tag: tag.RoundingDirection, Name: "RoundingDirection", Type: FieldTypeString},
+				{Tag: tag.RoundingModulus, Name: "RoundingModulus", Type: FieldTypeDecimal},
+				{Tag: tag.ComplianceID, Name: "ComplianceID", Type: FieldTypeString},
+				{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+				{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			}},
+			{Tag: tag.NoLegs, Name: "NoLegs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.LegSymbol, Name: "LegSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.LegSymbolSfx, Name: "LegSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.LegSecurityID, Name: "LegSecurityID", Type: FieldTypeString},
+				{Tag: tag.LegSecurityIDSource, Name: "LegSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoLegSecurityAltID, Name: "NoLegSecurityAltID", Type: FieldTypeNumInGroup, Fields: noLegSecurityAltIDFields},
+				{Tag: tag.LegProduct, Name: "LegProduct", Type: FieldTypeInt},
+				{Tag: tag.LegCFICode, Name: "LegCFICode", Type: FieldTypeString},
+				{Tag: tag.LegSecurityType, Name: "LegSecurityType", Type: FieldTypeString},
+				{Tag: tag.LegSecuritySubType, Name: "LegSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.LegMaturityMonthYear, Name: "LegMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.LegMaturityDate, Name: "LegMaturityDate", Type: FieldTypeString},
+				{Tag: tag.LegCouponPaymentDate, Name: "LegCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.LegIssueDate, Name: "LegIssueDate", Type: FieldTypeString},
+				{Tag: tag.LegRepoCollateralSecurityType, Name: "LegRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseTerm, Name: "LegRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseRate, Name: "LegRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegFactor, Name: "LegFactor", Type: FieldTypeDecimal},
+				{Tag: tag.LegCreditRating, Name: "LegCreditRating", Type: FieldTypeString},
+				{Tag: tag.LegInstrRegistry, Name: "LegInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.LegCountryOfIssue, Name: "LegCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegStateOrProvinceOfIssue, Name: "LegStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegLocaleOfIssue, Name: "LegLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegRedemptionDate, Name: "LegRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.LegStrikePrice, Name: "LegStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.LegStrikeCurrency, Name: "LegStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.LegOptAttribute, Name: "LegOptAttribute", Type: FieldTypeString},
+				{Tag: tag.LegContractMultiplier, Name: "LegContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.LegCouponRate, Name: "LegCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegSecurityExchange, Name: "LegSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.LegIssuer, Name: "LegIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedLegIssuerLen, Name: "EncodedLegIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegIssuer, Name: "EncodedLegIssuer", Type: FieldTypeString},
+				{Tag: tag.LegSecurityDesc, Name: "LegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedLegSecurityDescLen, Name: "EncodedLegSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegSecurityDesc, Name: "EncodedLegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.LegRatioQty, Name: "LegRatioQty", Type: FieldTypeDecimal},
+				{Tag: tag.LegSide, Name: "LegSide", Type: FieldTypeString},
+				{Tag: tag.LegCurrency, Name: "LegCurrency", Type: FieldTypeString},
+				{Tag: tag.LegPool, Name: "LegPool", Type: FieldTypeString},
+				{Tag: tag.LegDatedDate, Name: "LegDatedDate", Type: FieldTypeString},
+				{Tag: tag.LegContractSettlMonth, Name: "LegContractSettlMonth", Type: FieldTypeString},
+				{Tag: tag.LegInterestAccrualDate, Name: "LegInterestAccrualDate", Type: FieldTypeString},
+			}},
+			{Tag: tag.ContractSettlMonth, Name: "ContractSettlMonth", Type: FieldTypeString},
+			{Tag: tag.Pool, Name: "Pool", Type: FieldTypeString},
+			{Tag: tag.NoUnderlyings, Name: "NoUnderlyings", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.UnderlyingSymbol, Name: "UnderlyingSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.UnderlyingSymbolSfx, Name: "UnderlyingSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityID, Name: "UnderlyingSecurityID", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityIDSource, Name: "UnderlyingSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoUnderlyingSecurityAltID, Name: "NoUnderlyingSecurityAltID", Type: FieldTypeNumInGroup, Fields: noUnderlyingSecurityAltIDFields},
+				{Tag: tag.UnderlyingProduct, Name: "UnderlyingProduct", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingCFICode, Name: "UnderlyingCFICode", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityType, Name: "UnderlyingSecurityType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecuritySubType, Name: "UnderlyingSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityMonthYear, Name: "UnderlyingMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityDate, Name: "UnderlyingMaturityDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCouponPaymentDate, Name: "UnderlyingCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssueDate, Name: "UnderlyingIssueDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRepoCollateralSecurityType, Name: "UnderlyingRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseTerm, Name: "UnderlyingRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseRate, Name: "UnderlyingRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingFactor, Name: "UnderlyingFactor", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCreditRating, Name: "UnderlyingCreditRating", Type: FieldTypeString},
+				{Tag: tag.UnderlyingInstrRegistry, Name: "UnderlyingInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCountryOfIssue, Name: "UnderlyingCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStateOrProvinceOfIssue, Name: "UnderlyingStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingLocaleOfIssue, Name: "UnderlyingLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRedemptionDate, Name: "UnderlyingRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStrikePrice, Name: "UnderlyingStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStrikeCurrency, Name: "UnderlyingStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingOptAttribute, Name: "UnderlyingOptAttribute", Type: FieldTypeString},
+				{Tag: tag.UnderlyingContractMultiplier, Name: "UnderlyingContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCouponRate, Name: "UnderlyingCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingSecurityExchange, Name: "UnderlyingSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssuer, Name: "UnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingIssuerLen, Name: "EncodedUnderlyingIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingIssuer, Name: "EncodedUnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityDesc, Name: "UnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingSecurityDescLen, Name: "EncodedUnderlyingSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingSecurityDesc, Name: "EncodedUnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPProgram, Name: "UnderlyingCPProgram", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPRegType, Name: "UnderlyingCPRegType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCurrency, Name: "UnderlyingCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingQty, Name: "UnderlyingQty", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingPx, Name: "UnderlyingPx", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingDirtyPrice, Name: "UnderlyingDirtyPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndPrice, Name: "UnderlyingEndPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStartValue, Name: "UnderlyingStartValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCurrentValue, Name: "UnderlyingCurrentValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndValue, Name: "UnderlyingEndValue", Type: FieldTypeDecimal},
+				{Tag: tag.NoUnderlyingStips, Name: "NoUnderlyingStips", Type: FieldTypeNumInGroup, Fields: noUnderlyingStipsFields},
+			}},
+			{Tag: tag.SecuritySubType, Name: "SecuritySubType", Type: FieldTypeString},
+			{Tag: tag.NoEvents, Name: "NoEvents", Type: FieldTypeNumInGroup, Fields: noEventsFields},
+			{Tag: tag.DatedDate, Name: "DatedDate", Type: FieldTypeString},
+			{Tag: tag.InterestAccrualDate, Name: "InterestAccrualDate", Type: FieldTypeString},
+			{Tag: tag.CPProgram, Name: "CPProgram", Type: FieldTypeString},
+			{Tag: tag.CPRegType, Name: "CPRegType", Type: FieldTypeString},
+			{Tag: tag.StrikeCurrency, Name: "StrikeCurrency", Type: FieldTypeString},
+		},
+	},
+	"AA": {
+		Name:    "DerivativeSecurityList",
+		MsgType: "AA",
+		Fields: []FieldDef{
+			{Tag: tag.NoRelatedSym, Name: "NoRelatedSym", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.Symbol, Name: "Symbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.SymbolSfx, Name: "SymbolSfx", Type: FieldTypeString},
+				{Tag: tag.SecurityID, Name: "SecurityID", Type: FieldTypeString},
+				{Tag: tag.SecurityIDSource, Name: "SecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoSecurityAltID, Name: "NoSecurityAltID", Type: FieldTypeNumInGroup, Fields: noSecurityAltIDFields},
+				{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+				{Tag: tag.CFICode, Name: "CFICode", Type: FieldTypeString},
+				{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+				{Tag: tag.SecuritySubType, Name: "SecuritySubType", Type: FieldTypeString},
+				{Tag: tag.MaturityMonthYear, Name: "MaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.MaturityDate, Name: "MaturityDate", Type: FieldTypeString},
+				{Tag: tag.CouponPaymentDate, Name: "CouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.IssueDate, Name: "IssueDate", Type: FieldTypeString},
+				{Tag: tag.RepoCollateralSecurityType, Name: "RepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.RepurchaseTerm, Name: "RepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.RepurchaseRate, Name: "RepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.Factor, Name: "Factor", Type: FieldTypeDecimal},
+				{Tag: tag.CreditRating, Name: "CreditRating", Type: FieldTypeString},
+				{Tag: tag.InstrRegistry, Name: "InstrRegistry", Type: FieldTypeString},
+				{Tag: tag.CountryOfIssue, Name: "CountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.StateOrProvinceOfIssue, Name: "StateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.LocaleOfIssue, Name: "LocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.RedemptionDate, Name: "RedemptionDate", Type: FieldTypeString},
+				{Tag: tag.StrikePrice, Name: "StrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.StrikeCurrency, Name: "StrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.OptAttribute, Name: "OptAttribute", Type: FieldTypeString},
+				{Tag: tag.ContractMultiplier, Name: "ContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.CouponRate, Name: "CouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.SecurityExchange, Name: "SecurityExchange", Type: FieldTypeString},
+				{Tag: tag.Issuer, Name: "Issuer", Type: FieldTypeString},
+				{Tag: tag.EncodedIssuerLen, Name: "EncodedIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedIssuer, Name: "EncodedIssuer", Type: FieldTypeString},
+				{Tag: tag.SecurityDesc, Name: "SecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedSecurityDescLen, Name: "EncodedSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedSecurityDesc, Name: "EncodedSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.Pool, Name: "Pool", Type: FieldTypeString},
+				{Tag: tag.ContractSettlMonth, Name: "ContractSettlMonth", Type: FieldTypeString},
+				{Tag: tag.CPProgram, Name: "CPProgram", Type: FieldTypeString},
+				{Tag: tag.CPRegType, Name: "CPRegType", Type: FieldTypeString},
+				{Tag: tag.NoEvents, Name: "NoEvents", Type: FieldTypeNumInGroup, Fields: noEventsFields},
+				{Tag: tag.DatedDate, Name: "DatedDate", Type: FieldTypeString},
+				{Tag: tag.InterestAccrualDate, Name: "InterestAccrualDate", Type: FieldTypeString},
+				{Tag: tag.Currency, Name: "Currency", Type: FieldTypeString},
+				{Tag: tag.ExpirationCycle, Name: "ExpirationCycle", Type: FieldTypeString},
+				{Tag: tag.DeliveryForm, Name: "DeliveryForm", Type: FieldTypeString},
+				{Tag: tag.PctAtRisk, Name: "PctAtRisk", Type: FieldTypeDecimal},
+				{Tag: tag.NoInstrAttrib, Name: "NoInstrAttrib", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+					{Tag: tag.InstrAttribType, Name: "InstrAttribType", Type: FieldTypeString, Required: true},
+					{Tag: tag.InstrAttribValue, Name: "InstrAttribValue", Type: FieldTypeString},
+				}},
+				{Tag: tag.NoLegs, Name: "NoLegs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+					{Tag: tag.LegSymbol, Name: "LegSymbol", Type: FieldTypeString, Required: true},
+					{Tag: tag.LegSymbolSfx, Name: "LegSymbolSfx", Type: FieldTypeString},
+					{Tag: tag.LegSecurityID, Name: "LegSecurityID", Type: FieldTypeString},
+					{Tag: tag.LegSecurityIDSource, Name: "LegSecurityIDSource", Type: FieldTypeString},
+					{Tag: tag.NoLegSecurityAltID, Name: "NoLegSecurityAltID", Type: FieldTypeNumInGroup, Fields: noLegSecurityAltIDFields},
+					{Tag: tag.LegProduct, Name: "LegProduct", Type: FieldTypeInt},
+					{Tag: tag.LegCFICode, Name: "LegCFICode", Type: FieldTypeString},
+					{Tag: tag.LegSecurityType, Name: "LegSecurityType", Type: FieldTypeString},
+					{Tag: tag.LegSecuritySubType, Name: "LegSecuritySubType", Type: FieldTypeString},
+					{Tag: tag.LegMaturityMonthYear, Name: "LegMaturityMonthYear", Type: FieldTypeString},
+					{Tag: tag.LegMaturityDate, Name: "LegMaturityDate", Type: FieldTypeString},
+					{Tag: tag.LegCouponPaymentDate, Name: "LegCouponPaymentDate", Type: FieldTypeString},
+					{Tag: tag.LegIssueDate, Name: "LegIssueDate", Type: FieldTypeString},
+					{Tag: tag.LegRepoCollateralSecurityType, Name: "LegRepoCollateralSecurityType", Type: FieldTypeInt},
+					{Tag: tag.LegRepurchaseTerm, Name: "LegRepurchaseTerm", Type: FieldTypeInt},
+					{Tag: tag.LegRepurchaseRate, Name: "LegRepurchaseRate", Type: FieldTypeDecimal},
+					{Tag: tag.LegFactor, Name: "LegFactor", Type: FieldTypeDecimal},
+					{Tag: tag.LegCreditRating, Name: "LegCreditRating", Type: FieldTypeString},
+					{Tag: tag.LegInstrRegistry, Name: "LegInstrRegistry", Type: FieldTypeString},
+					{Tag: tag.LegCountryOfIssue, Name: "LegCountryOfIssue", Type: FieldTypeString},
+					{Tag: tag.LegStateOrProvinceOfIssue, Name: "LegStateOrProvinceOfIssue", Type: FieldTypeString},
+					{Tag: tag.LegLocaleOfIssue, Name: "LegLocaleOfIssue", Type: FieldTypeString},
+					{Tag: tag.LegRedemptionDate, Name: "LegRedemptionDate", Type: FieldTypeString},
+					{Tag: tag.LegStrikePrice, Name: "LegStrikePrice", Type: FieldTypeDecimal},
+					{Tag: tag.LegStrikeCurrency, Name: "LegStrikeCurrency", Type: FieldTypeString},
+					{Tag: tag.LegOptAttribute, Name: "LegOptAttribute", Type: FieldTypeString},
+					{Tag: tag.LegContractMultiplier, Name: "LegContractMultiplier", Type: FieldTypeDecimal},
+					{Tag: tag.LegCouponRate, Name: "LegCouponRate", Type: FieldTypeDecimal},
+					{Tag: tag.LegSecurityExchange, Name: "LegSecurityExchange", Type: FieldTypeString},
+					{Tag: tag.LegIssuer, Name: "LegIssuer", Type: FieldTypeString},
+					{Tag: tag.EncodedLegIssuerLen, Name: "EncodedLegIssuerLen", Type: FieldTypeInt},
+					{Tag: tag.EncodedLegIssuer, Name: "EncodedLegIssuer", Type: FieldTypeString},
+					{Tag: tag.LegSecurityDesc, Name: "LegSecurityDesc", Type: FieldTypeString},
+					{Tag: tag.EncodedLegSecurityDescLen, Name: "EncodedLegSecurityDescLen", Type: FieldTypeInt},
+					{Tag: tag.EncodedLegSecurityDesc, Name: "EncodedLegSecurityDesc", Type: FieldTypeString},
+					{Tag: tag.LegRatioQty, Name: "LegRatioQty", Type: FieldTypeDecimal},
+					{Tag: tag.LegSide, Name: "LegSide", Type: FieldTypeString},
+					{Tag: tag.LegCurrency, Name: "LegCurrency", Type: FieldTypeString},
+					{Tag: tag.LegPool, Name: "LegPool", Type: FieldTypeString},
+					{Tag: tag.LegDatedDate, Name: "LegDatedDate", Type: FieldTypeString},
+					{Tag: tag.LegContractSettlMonth, Name: "LegContractSettlMonth", Type: FieldTypeString},
+					{Tag: tag.LegInterestAccrualDate, Name: "LegInterestAccrualDate", Type: FieldTypeString},
+				}},
+				{Tag: tag.TradingSessionID, Name: "TradingSessionID", Type: FieldTypeString},
+				{Tag: tag.TradingSessionSubID, Name: "TradingSessionSubID", Type: FieldTypeString},
+				{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+				{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			}},
+			{Tag: tag.UnderlyingCouponPaymentDate, Name: "UnderlyingCouponPaymentDate", Type: FieldTypeString},
+			{Tag: tag.UnderlyingIssueDate, Name: "UnderlyingIssueDate", Type: FieldTypeString},
+			{Tag: tag.UnderlyingRepoCollateralSecurityType, Name: "UnderlyingRepoCollateralSecurityType", Type: FieldTypeInt},
+			{Tag: tag.UnderlyingRepurchaseTerm, Name: "UnderlyingRepurchaseTerm", Type: FieldTypeInt},
+			{Tag: tag.UnderlyingRepurchaseRate, Name: "UnderlyingRepurchaseRate", Type: FieldTypeDecimal},
+			{Tag: tag.UnderlyingFactor, Name: "UnderlyingFactor", Type: FieldTypeDecimal},
+			{Tag: tag.UnderlyingRedemptionDate, Name: "UnderlyingRedemptionDate", Type: FieldTypeString},
+			{Tag: tag.UnderlyingCreditRating, Name: "UnderlyingCreditRating", Type: FieldTypeString},
+			{Tag: tag.UnderlyingSecurityIDSource, Name: "UnderlyingSecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.UnderlyingIssuer, Name: "UnderlyingIssuer", Type: FieldTypeString},
+			{Tag: tag.UnderlyingSecurityDesc, Name: "UnderlyingSecurityDesc", Type: FieldTypeString},
+			{Tag: tag.UnderlyingSecurityExchange, Name: "UnderlyingSecurityExchange", Type: FieldTypeString},
+			{Tag: tag.UnderlyingSecurityID, Name: "UnderlyingSecurityID", Type: FieldTypeString},
+			{Tag: tag.UnderlyingSecurityType, Name: "UnderlyingSecurityType", Type: FieldTypeString},
+			{Tag: tag.UnderlyingSymbol, Name: "UnderlyingSymbol", Type: FieldTypeString},
+			{Tag: tag.UnderlyingSymbolSfx, Name: "UnderlyingSymbolSfx", Type: FieldTypeString},
+			{Tag: tag.UnderlyingMaturityMonthYear, Name: "UnderlyingMaturityMonthYear", Type: FieldTypeString},
+			{Tag: tag.UnderlyingStrikePrice, Name: "UnderlyingStrikePrice", Type: FieldTypeDecimal},
+			{Tag: tag.UnderlyingOptAttribute, Name: "UnderlyingOptAttribute", Type: FieldTypeString},
+			{Tag: tag.UnderlyingCurrency, Name: "UnderlyingCurrency", Type: FieldTypeString},
+			{Tag: tag.SecurityReqID, Name: "SecurityReqID", Type: FieldTypeString, Required: true},
+			{Tag: tag.SecurityResponseID, Name: "SecurityResponseID", Type: FieldTypeString, Required: true},
+			{Tag: tag.EncodedUnderlyingIssuerLen, Name: "EncodedUnderlyingIssuerLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedUnderlyingIssuer, Name: "EncodedUnderlyingIssuer", Type: FieldTypeString},
+			{Tag: tag.EncodedUnderlyingSecurityDescLen, Name: "EncodedUnderlyingSecurityDescLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedUnderlyingSecurityDesc, Name: "EncodedUnderlyingSecurityDesc", Type: FieldTypeString},
+			{Tag: tag.TotNoRelatedSym, Name: "TotNoRelatedSym", Type: FieldTypeInt},
+			{Tag: tag.UnderlyingCouponRate, Name: "UnderlyingCouponRate", Type: FieldTypeDecimal},
+			{Tag: tag.UnderlyingContractMultiplier, Name: "UnderlyingContractMultiplier", Type: FieldTypeDecimal},
+			{Tag: tag.NoUnderlyingSecurityAltID, Name: "NoUnderlyingSecurityAltID", Type: FieldTypeNumInGroup, Fields: noUnderlyingSecurityAltIDFields},
+			{Tag: tag.UnderlyingProduct, Name: "UnderlyingProduct", Type: FieldTypeInt},
+			{Tag: tag.UnderlyingCFICode, Name: "UnderlyingCFICode", Type: FieldTypeString},
+			{Tag: tag.UnderlyingMaturityDate, Name: "UnderlyingMaturityDate", Type: FieldTypeString},
+			{Tag: tag.SecurityRequestResult, Name: "SecurityRequestResult", Type: FieldTypeString, Required: true},
+			{Tag: tag.UnderlyingCountryOfIssue, Name: "UnderlyingCountryOfIssue", Type: FieldTypeString},
+			{Tag: tag.UnderlyingStateOrProvinceOfIssue, Name: "UnderlyingStateOrProvinceOfIssue", Type: FieldTypeString},
+			{Tag: tag.UnderlyingLocaleOfIssue, Name: "UnderlyingLocaleOfIssue", Type: FieldTypeString},
+			{Tag: tag.UnderlyingInstrRegistry, Name: "UnderlyingInstrRegistry", Type: FieldTypeString},
+			{Tag: tag.UnderlyingSecuritySubType, Name: "UnderlyingSecuritySubType", Type: FieldTypeString},
+			{Tag: tag.UnderlyingPx, Name: "UnderlyingPx", Type: FieldTypeDecimal},
+			{Tag: tag.UnderlyingCPProgram, Name: "UnderlyingCPProgram", Type: FieldTypeString},
+			{Tag: tag.UnderlyingCPRegType, Name: "UnderlyingCPRegType", Type: FieldTypeString},
+			{Tag: tag.UnderlyingQty, Name: "UnderlyingQty", Type: FieldTypeDecimal},
+			{Tag: tag.UnderlyingDirtyPrice, Name: "UnderlyingDirtyPrice", Type: FieldTypeDecimal},
+			{Tag: tag.UnderlyingEndPrice, Name: "UnderlyingEndPrice", Type: FieldTypeDecimal},
+			{Tag: tag.UnderlyingStartValue, Name: "UnderlyingStartValue", Type: FieldTypeDecimal},
+			{Tag: tag.UnderlyingCurrentValue, Name: "UnderlyingCurrentValue", Type: FieldTypeDecimal},
+			{Tag: tag.UnderlyingEndValue, Name: "UnderlyingEndValue", Type: FieldTypeDecimal},
+			{Tag: tag.NoUnderlyingStips, Name: "NoUnderlyingStips", Type: FieldTypeNumInGroup, Fields: noUnderlyingStipsFields},
+			{Tag: tag.LastFragment, Name: "LastFragment", Type: FieldTypeBool},
+			{Tag: tag.UnderlyingStrikeCurrency, Name: "UnderlyingStrikeCurrency", Type: FieldTypeString},
+		},
+	},
+	"z": {
+		Name:    "DerivativeSecurityListRequest",
+		MsgType: "z",
+		Fields: []FieldDef{
+			{Tag: tag.Currency, Name: "Currency", Type: FieldTypeString},
+			{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+			{Tag: tag.UnderlyingCouponPaymentDate, Name: "UnderlyingCouponPaymentDate", Type: FieldTypeString},
+			{Tag: tag.UnderlyingIssueDate, Name: "UnderlyingIssueDate", Type: FieldTypeString},
+			{Tag: tag.UnderlyingRepoCollateralSecurityType, Name: "UnderlyingRepoCollateralSecurityType", Type: FieldTypeInt},
+			{Tag: tag.UnderlyingRepurchaseTerm, Name: "UnderlyingRepurchaseTerm", Type: FieldTypeInt},
+			{Tag: tag.UnderlyingRepurchaseRate, Name: "UnderlyingRepurchaseRate", Type: FieldTypeDecimal},
+			{Tag: tag.UnderlyingFactor, Name: "UnderlyingFactor", Type: FieldTypeDecimal},
+			{Tag: tag.UnderlyingRedemptionDate, Name: "UnderlyingRedemptionDate", Type: FieldTypeString},
+			{Tag: tag.UnderlyingCreditRating, Name: "UnderlyingCreditRating", Type: FieldTypeString},
+			{Tag: tag.SubscriptionRequestType, Name: "SubscriptionRequestType", Type: FieldTypeString},
+			{Tag: tag.UnderlyingSecurityIDSource, Name: "UnderlyingSecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.UnderlyingIssuer, Name: "UnderlyingIssuer", Type: FieldTypeString},
+			{Tag: tag.UnderlyingSecurityDesc, Name: "UnderlyingSecurityDesc", Type: FieldTypeString},
+			{Tag: tag.UnderlyingSecurityExchange, Name: "UnderlyingSecurityExchange", Type: FieldTypeString},
+			{Tag: tag.UnderlyingSecurityID, Name: "UnderlyingSecurityID", Type: FieldTypeString},
+			{Tag: tag.UnderlyingSecurityType, Name: "UnderlyingSecurityType", Type: FieldTypeString},
+			{Tag: tag.UnderlyingSymbol, Name: "UnderlyingSymbol", Type: FieldTypeString},
+			{Tag: tag.UnderlyingSymbolSfx, Name: "UnderlyingSymbolSfx", Type: FieldTypeString},
+			{Tag: tag.UnderlyingMaturityMonthYear, Name: "UnderlyingMaturityMonthYear", Type: FieldTypeString},
+			{Tag: tag.UnderlyingStrikePrice, Name: "UnderlyingStrikePrice", Type: FieldTypeDecimal},
+			{Tag: tag.UnderlyingOptAttribute, Name: "UnderlyingOptAttribute", Type: FieldTypeString},
+			{Tag: tag.UnderlyingCurrency, Name: "UnderlyingCurrency", Type: FieldTypeString},
+			{Tag: tag.SecurityReqID, Name: "SecurityReqID", Type: FieldTypeString, Required: true},
+			{Tag: tag.TradingSessionID, Name: "TradingSessionID", Type: FieldTypeString},
+			{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			{Tag: tag.EncodedUnderlyingIssuerLen, Name: "EncodedUnderlyingIssuerLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedUnderlyingIssuer, Name: "EncodedUnderlyingIssuer", Type: FieldTypeString},
+			{Tag: tag.EncodedUnderlyingSecurityDescLen, Name: "EncodedUnderlyingSecurityDescLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedUnderlyingSecurityDesc, Name: "EncodedUnderlyingSecurityDesc", Type: FieldTypeString},
+			{Tag: tag.UnderlyingCouponRate, Name: "UnderlyingCouponRate", Type: FieldTypeDecimal},
+			{Tag: tag.UnderlyingContractMultiplier, Name: "UnderlyingContractMultiplier", Type: FieldTypeDecimal},
+			{Tag: tag.NoUnderlyingSecurityAltID, Name: "NoUnderlyingSecurityAltID", Type: FieldTypeNumInGroup, Fields: noUnderlyingSecurityAltIDFields},
+			{Tag: tag.UnderlyingProduct, Name: "UnderlyingProduct", Type: FieldTypeInt},
+			{Tag: tag.UnderlyingCFICode, Name: "UnderlyingCFICode", Type: FieldTypeString},
+			{Tag: tag.UnderlyingMaturityDate, Name: "UnderlyingMaturityDate", Type: FieldTypeString},
+			{Tag: tag.SecurityListRequestType, Name: "SecurityListRequestType", Type: FieldTypeString, Required: true},
+			{Tag: tag.UnderlyingCountryOfIssue, Name: "UnderlyingCountryOfIssue", Type: FieldTypeString},
+			{Tag: tag.UnderlyingStateOrProvinceOfIssue, Name: "UnderlyingStateOrProvinceOfIssue", Type: FieldTypeString},
+			{Tag: tag.UnderlyingLocaleOfIssue, Name: "UnderlyingLocaleOfIssue", Type: FieldTypeString},
+			{Tag: tag.UnderlyingInstrRegistry, Name: "UnderlyingInstrRegistry", Type: FieldTypeString},
+			{Tag: tag.TradingSessionSubID, Name: "TradingSessionSubID", Type: FieldTypeString},
+			{Tag: tag.SecuritySubType, Name: "SecuritySubType", Type: FieldTypeString},
+			{Tag: tag.UnderlyingSecuritySubType, Name: "UnderlyingSecuritySubType", Type: FieldTypeString},
+			{Tag: tag.UnderlyingPx, Name: "UnderlyingPx", Type: FieldTypeDecimal},
+			{Tag: tag.UnderlyingCPProgram, Name: "UnderlyingCPProgram", Type: FieldTypeString},
+			{Tag: tag.UnderlyingCPRegType, Name: "UnderlyingCPRegType", Type: FieldTypeString},
+			{Tag: tag.UnderlyingQty, Name: "UnderlyingQty", Type: FieldTypeDecimal},
+			{Tag: tag.UnderlyingDirtyPrice, Name: "UnderlyingDirtyPrice", Type: FieldTypeDecimal},
+			{Tag: tag.UnderlyingEndPrice, Name: "UnderlyingEndPrice", Type: FieldTypeDecimal},
+			{Tag: tag.UnderlyingStartValue, Name: "UnderlyingStartValue", Type: FieldTypeDecimal},
+			{Tag: tag.UnderlyingCurrentValue, Name: "UnderlyingCurrentValue", Type: FieldTypeDecimal},
+			{Tag: tag.UnderlyingEndValue, Name: "UnderlyingEndValue", Type: FieldTypeDecimal},
+			{Tag: tag.NoUnderlyingStips, Name: "NoUnderlyingStips", Type: FieldTypeNumInGroup, Fields: noUnderlyingStipsFields},
+			{Tag: tag.UnderlyingStrikeCurrency, Name: "UnderlyingStrikeCurrency", Type: FieldTypeString},
+		},
+	},
+	"Q": {
+		Name:    "DontKnowTrade",
+		MsgType: "Q",
+		Fields: []FieldDef{
+			{Tag: tag.ExecID, Name: "ExecID", Type: FieldTypeString, Required: true},
+			{Tag: tag.SecurityIDSource, Name: "SecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.LastPx, Name: "LastPx", Type: FieldTypeDecimal},
+			{Tag: tag.LastQty, Name: "LastQty", Type: FieldTypeDecimal},
+			{Tag: tag.OrderID, Name: "OrderID", Type: FieldTypeString, Required: true},
+			{Tag: tag.OrderQty, Name: "OrderQty", Type: FieldTypeDecimal},
+			{Tag: tag.SecurityID, Name: "SecurityID", Type: FieldTypeString},
+			{Tag: tag.Side, Name: "Side", Type: FieldTypeString, Required: true},
+			{Tag: tag.Symbol, Name: "Symbol", Type: FieldTypeString},
+			{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+			{Tag: tag.SymbolSfx, Name: "SymbolSfx", Type: FieldTypeString},
+			{Tag: tag.Issuer, Name: "Issuer", Type: FieldTypeString},
+			{Tag: tag.SecurityDesc, Name: "SecurityDesc", Type: FieldTypeString},
+			{Tag: tag.DKReason, Name: "DKReason", Type: FieldTypeString, Required: true},
+			{Tag: tag.CashOrderQty, Name: "CashOrderQty", Type: FieldTypeDecimal},
+			{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+			{Tag: tag.SecondaryOrderID, Name: "SecondaryOrderID", Type: FieldTypeString},
+			{Tag: tag.MaturityMonthYear, Name: "MaturityMonthYear", Type: FieldTypeString},
+			{Tag: tag.StrikePrice, Name: "StrikePrice", Type: FieldTypeDecimal},
+			{Tag: tag.OptAttribute, Name: "OptAttribute", Type: FieldTypeString},
+			{Tag: tag.SecurityExchange, Name: "SecurityExchange", Type: FieldTypeString},
+			{Tag: tag.CouponRate, Name: "CouponRate", Type: FieldTypeDecimal},
+			{Tag: tag.CouponPaymentDate, Name: "CouponPaymentDate", Type: FieldTypeString},
+			{Tag: tag.IssueDate, Name: "IssueDate", Type: FieldTypeString},
+			{Tag: tag.RepurchaseTerm, Name: "RepurchaseTerm", Type: FieldTypeInt},
+			{Tag: tag.RepurchaseRate, Name: "RepurchaseRate", Type: FieldTypeDecimal},
+			{Tag: tag.Factor, Name: "Factor", Type: FieldTypeDecimal},
+			{Tag: tag.ContractMultiplier, Name: "ContractMultiplier", Type: FieldTypeDecimal},
+			{Tag: tag.RepoCollateralSecurityType, Name: "RepoCollateralSecurityType", Type: FieldTypeInt},
+			{Tag: tag.RedemptionDate, Name: "RedemptionDate", Type: FieldTypeString},
+			{Tag: tag.CreditRating, Name: "CreditRating", Type: FieldTypeString},
+			{Tag: tag.EncodedIssuerLen, Name: "EncodedIssuerLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedIssuer, Name: "EncodedIssuer", Type: FieldTypeString},
+			{Tag: tag.EncodedSecurityDescLen, Name: "EncodedSecurityDescLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedSecurityDesc, Name: "EncodedSecurityDesc", Type: FieldTypeString},
+			{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			{Tag: tag.NoSecurityAltID, Name: "NoSecurityAltID", Type: FieldTypeNumInGroup, Fields: noSecurityAltIDFields},
+			{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+			{Tag: tag.CFICode, Name: "CFICode", Type: FieldTypeString},
+			{Tag: tag.RoundingDirection, Name: "RoundingDirection", Type: FieldTypeString},
+			{Tag: tag.RoundingModulus, Name: "RoundingModulus", Type: FieldTypeDecimal},
+			{Tag: tag.CountryOfIssue, Name: "CountryOfIssue", Type: FieldTypeString},
+			{Tag: tag.StateOrProvinceOfIssue, Name: "StateOrProvinceOfIssue", Type: FieldTypeString},
+			{Tag: tag.LocaleOfIssue, Name: "LocaleOfIssue", Type: FieldTypeString},
+			{Tag: tag.OrderPercent, Name: "OrderPercent", Type: FieldTypeDecimal},
+			{Tag: tag.MaturityDate, Name: "MaturityDate", Type: FieldTypeString},
+			{Tag: tag.InstrRegistry, Name: "InstrRegistry", Type: FieldTypeString},
+			{Tag: tag.NoLegs, Name: "NoLegs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.LegSymbol, Name: "LegSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.LegSymbolSfx, Name: "LegSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.LegSecurityID, Name: "LegSecurityID", Type: FieldTypeString},
+				{Tag: tag.LegSecurityIDSource, Name: "LegSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoLegSecurityAltID, Name: "NoLegSecurityAltID", Type: FieldTypeNumInGroup, Fields: noLegSecurityAltIDFields},
+				{Tag: tag.LegProduct, Name: "LegProduct", Type: FieldTypeInt},
+				{Tag: tag.LegCFICode, Name: "LegCFICode", Type: FieldTypeString},
+				{Tag: tag.LegSecurityType, Name: "LegSecurityType", Type: FieldTypeString},
+				{Tag: tag.LegSecuritySubType, Name: "LegSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.LegMaturityMonthYear, Name: "LegMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.LegMaturityDate, Name: "LegMaturityDate", Type: FieldTypeString},
+				{Tag: tag.LegCouponPaymentDate, Name: "LegCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.LegIssueDate, Name: "LegIssueDate", Type: FieldTypeString},
+				{Tag: tag.LegRepoCollateralSecurityType, Name: "LegRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseTerm, Name: "LegRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseRate, Name: "LegRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegFactor, Name: "LegFactor", Type: FieldTypeDecimal},
+				{Tag: tag.LegCreditRating, Name: "LegCreditRating", Type: FieldTypeString},
+				{Tag: tag.LegInstrRegistry, Name: "LegInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.LegCountryOfIssue, Name: "LegCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegStateOrProvinceOfIssue, Name: "LegStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegLocaleOfIssue, Name: "LegLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegRedemptionDate, Name: "LegRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.LegStrikePrice, Name: "LegStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.LegStrikeCurrency, Name: "LegStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.LegOptAttribute, Name: "LegOptAttribute", Type: FieldTypeString},
+				{Tag: tag.LegContractMultiplier, Name: "LegContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.LegCouponRate, Name: "LegCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegSecurityExchange, Name: "LegSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.LegIssuer, Name: "LegIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedLegIssuerLen, Name: "EncodedLegIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegIssuer, Name: "EncodedLegIssuer", Type: FieldTypeString},
+				{Tag: tag.LegSecurityDesc, Name: "LegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedLegSecurityDescLen, Name: "EncodedLegSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegSecurityDesc, Name: "EncodedLegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.LegRatioQty, Name: "LegRatioQty", Type: FieldTypeDecimal},
+				{Tag: tag.LegSide, Name: "LegSide", Type: FieldTypeString},
+				{Tag: tag.LegCurrency, Name: "LegCurrency", Type: FieldTypeString},
+				{Tag: tag.LegPool, Name: "LegPool", Type: FieldTypeString},
+				{Tag: tag.LegDatedDate, Name: "LegDatedDate", Type: FieldTypeString},
+				{Tag: tag.LegContractSettlMonth, Name: "LegContractSettlMonth", Type: FieldTypeString},
+				{Tag: tag.LegInterestAccrualDate, Name: "LegInterestAccrualDate", Type: FieldTypeString},
+			}},
+			{Tag: tag.ContractSettlMonth, Name: "ContractSettlMonth", Type: FieldTypeString},
+			{Tag: tag.Pool, Name: "Pool", Type: FieldTypeString},
+			{Tag: tag.NoUnderlyings, Name: "NoUnderlyings", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.UnderlyingSymbol, Name: "UnderlyingSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.UnderlyingSymbolSfx, Name: "UnderlyingSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityID, Name: "UnderlyingSecurityID", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityIDSource, Name: "UnderlyingSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoUnderlyingSecurityAltID, Name: "NoUnderlyingSecurityAltID", Type: FieldTypeNumInGroup, Fields: noUnderlyingSecurityAltIDFields},
+				{Tag: tag.UnderlyingProduct, Name: "UnderlyingProduct", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingCFICode, Name: "UnderlyingCFICode", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityType, Name: "UnderlyingSecurityType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecuritySubType, Name: "UnderlyingSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityMonthYear, Name: "UnderlyingMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityDate, Name: "UnderlyingMaturityDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCouponPaymentDate, Name: "UnderlyingCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssueDate, Name: "UnderlyingIssueDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRepoCollateralSecurityType, Name: "UnderlyingRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseTerm, Name: "UnderlyingRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseRate, Name: "UnderlyingRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingFactor, Name: "UnderlyingFactor", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCreditRating, Name: "UnderlyingCreditRating", Type: FieldTypeString},
+				{Tag: tag.UnderlyingInstrRegistry, Name: "UnderlyingInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCountryOfIssue, Name: "UnderlyingCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStateOrProvinceOfIssue, Name: "UnderlyingStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingLocaleOfIssue, Name: "UnderlyingLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRedemptionDate, Name: "UnderlyingRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStrikePrice, Name: "UnderlyingStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStrikeCurrency, Name: "UnderlyingStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingOptAttribute, Name: "UnderlyingOptAttribute", Type: FieldTypeString},
+				{Tag: tag.UnderlyingContractMultiplier, Name: "UnderlyingContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCouponRate, Name: "UnderlyingCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingSecurityExchange, Name: "UnderlyingSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssuer, Name: "UnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingIssuerLen, Name: "EncodedUnderlyingIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingIssuer, Name: "EncodedUnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityDesc, Name: "UnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingSecurityDescLen, Name: "EncodedUnderlyingSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingSecurityDesc, Name: "EncodedUnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPProgram, Name: "UnderlyingCPProgram", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPRegType, Name: "UnderlyingCPRegType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCurrency, Name: "UnderlyingCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingQty, Name: "UnderlyingQty", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingPx, Name: "UnderlyingPx", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingDirtyPrice, Name: "UnderlyingDirtyPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndPrice, Name: "UnderlyingEndPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStartValue, Name: "UnderlyingStartValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCurrentValue, Name: "UnderlyingCurrentValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndValue, Name: "UnderlyingEndValue", Type: FieldTypeDecimal},
+				{Tag: tag.NoUnderlyingStips, Name: "NoUnderlyingStips", Type: FieldTypeNumInGroup, Fields: noUnderlyingStipsFields},
+			}},
+			{Tag: tag.SecuritySubType, Name: "SecuritySubType", Type: FieldTypeString},
+			{Tag: tag.NoEvents, Name: "NoEvents", Type: FieldTypeNumInGroup, Fields: noEventsFields},
+			{Tag: tag.DatedDate, Name: "DatedDate", Type: FieldTypeString},
+			{Tag: tag.InterestAccrualDate, Name: "InterestAccrualDate", Type: FieldTypeString},
+			{Tag: tag.CPProgram, Name: "CPProgram", Type: FieldTypeString},
+			{Tag: tag.CPRegType, Name: "CPRegType", Type: FieldTypeString},
+			{Tag: tag.StrikeCurrency, Name: "StrikeCurrency", Type: FieldTypeString},
+		},
+	},
+	"C": {
+		Name:    "Email",
+		MsgType: "C",
+		Fields: []FieldDef{
+			{Tag: tag.ClOrdID, Name: "ClOrdID", Type: FieldTypeString},
+			{Tag: tag.NoLinesOfText, Name: "NoLinesOfText", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.Text, Name: "Text", Type: FieldTypeString, Required: true},
+				{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			}},
+			{Tag: tag.OrderID, Name: "OrderID", Type: FieldTypeString},
+			{Tag: tag.OrigTime, Name: "OrigTime", Type: FieldTypeUTCTimestamp},
+			{Tag: tag.EmailType, Name: "EmailType", Type: FieldTypeString, Required: true},
+			{Tag: tag.RawDataLength, Name: "RawDataLength", Type: FieldTypeInt},
+			{Tag: tag.RawData, Name: "RawData", Type: FieldTypeString},
+			{Tag: tag.NoRelatedSym, Name: "NoRelatedSym", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.Symbol, Name: "Symbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.SymbolSfx, Name: "SymbolSfx", Type: FieldTypeString},
+				{Tag: tag.SecurityID, Name: "SecurityID", Type: FieldTypeString},
+				{Tag: tag.SecurityIDSource, Name: "SecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoSecurityAltID, Name: "NoSecurityAltID", Type: FieldTypeNumInGroup, Fields: noSecurityAltIDFields},
+				{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+				{Tag: tag.CFICode, Name: "CFICode", Type: FieldTypeString},
+				{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+				{Tag: tag.SecuritySubType, Name: "SecuritySubType", Type: FieldTypeString},
+				{Tag: tag.MaturityMonthYear, Name: "MaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.MaturityDate, Name: "MaturityDate", Type: FieldTypeString},
+				{Tag: tag.CouponPaymentDate, Name: "CouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.IssueDate, Name: "IssueDate", Type: FieldTypeString},
+				{Tag: tag.RepoCollateralSecurityType, Name: "RepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.RepurchaseTerm, Name: "RepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.RepurchaseRate, Name: "RepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.Factor, Name: "Factor", Type: FieldTypeDecimal},
+				{Tag: tag.CreditRating, Name: "CreditRating", Type: FieldTypeString},
+				{Tag: tag.InstrRegistry, Name: "InstrRegistry", Type: FieldTypeString},
+				{Tag: tag.CountryOfIssue, Name: "CountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.StateOrProvinceOfIssue, Name: "StateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.LocaleOfIssue, Name: "LocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.RedemptionDate, Name: "RedemptionDate", Type: FieldTypeString},
+				{Tag: tag.StrikePrice, Name: "StrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.StrikeCurrency, Name: "StrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.OptAttribute, Name: "OptAttribute", Type: FieldTypeString},
+				{Tag: tag.ContractMultiplier, Name: "ContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.CouponRate, Name: "CouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.SecurityExchange, Name: "SecurityExchange", Type: FieldTypeString},
+				{Tag: tag.Issuer, Name: "Issuer", Type: FieldTypeString},
+				{Tag: tag.EncodedIssuerLen, Name: "EncodedIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedIssuer, Name: "EncodedIssuer", Type: FieldTypeString},
+				{Tag: tag.SecurityDesc, Name: "SecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedSecurityDescLen, Name: "EncodedSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedSecurityDesc, Name: "EncodedSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.Pool, Name: "Pool", Type: FieldTypeString},
+				{Tag: tag.ContractSettlMonth, Name: "ContractSettlMonth", Type: FieldTypeString},
+				{Tag: tag.CPProgram, Name: "CPProgram", Type: FieldTypeString},
+				{Tag: tag.CPRegType, Name: "CPRegType", Type: FieldTypeString},
+				{Tag: tag.NoEvents, Name: "NoEvents", Type: FieldTypeNumInGroup, Fields: noEventsFields},
+				{Tag: tag.DatedDate, Name: "DatedDate", Type: FieldTypeString},
+				{Tag: tag.InterestAccrualDate, Name: "InterestAccrualDate", Type: FieldTypeString},
+			}},
+			{Tag: tag.Subject, Name: "Subject", Type: FieldTypeString, Required: true},
+			{Tag: tag.EmailThreadID, Name: "EmailThreadID", Type: FieldTypeString, Required: true},
+			{Tag: tag.NoRoutingIDs, Name: "NoRoutingIDs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.RoutingType, Name: "RoutingType", Type: FieldTypeString, Required: true},
+				{Tag: tag.RoutingID, Name: "RoutingID", Type: FieldTypeString},
+			}},
+			{Tag: tag.EncodedSubjectLen, Name: "EncodedSubjectLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedSubject, Name: "EncodedSubject", Type: FieldTypeString},
+			{Tag: tag.NoLegs, Name: "NoLegs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.LegSymbol, Name: "LegSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.LegSymbolSfx, Name: "LegSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.LegSecurityID, Name: "LegSecurityID", Type: FieldTypeString},
+				{Tag: tag.LegSecurityIDSource, Name: "LegSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoLegSecurityAltID, Name: "NoLegSecurityAltID", Type: FieldTypeNumInGroup, Fields: noLegSecurityAltIDFields},
+				{Tag: tag.LegProduct, Name: "LegProduct", Type: FieldTypeInt},
+				{Tag: tag.LegCFICode, Name: "LegCFICode", Type: FieldTypeString},
+				{Tag: tag.LegSecurityType, Name: "LegSecurityType", Type: FieldTypeString},
+				{Tag: tag.LegSecuritySubType, Name: "LegSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.LegMaturityMonthYear, Name: "LegMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.LegMaturityDate, Name: "LegMaturityDate", Type: FieldTypeString},
+				{Tag: tag.LegCouponPaymentDate, Name: "LegCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.LegIssueDate, Name: "LegIssueDate", Type: FieldTypeString},
+				{Tag: tag.LegRepoCollateralSecurityType, Name: "LegRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseTerm, Name: "LegRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseRate, Name: "LegRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegFactor, Name: "LegFactor", Type: FieldTypeDecimal},
+				{Tag: tag.LegCreditRating, Name: "LegCreditRating", Type: FieldTypeString},
+				{Tag: tag.LegInstrRegistry, Name: "LegInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.LegCountryOfIssue, Name: "LegCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegStateOrProvinceOfIssue, Name: "LegStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegLocaleOfIssue, Name: "LegLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegRedemptionDate, Name: "LegRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.LegStrikePrice, Name: "LegStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.LegStrikeCurrency, Name: "LegStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.LegOptAttribute, Name: "LegOptAttribute", Type: FieldTypeString},
+				{Tag: tag.LegContractMultiplier, Name: "LegContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.LegCouponRate, Name: "LegCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegSecurityExchange, Name: "LegSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.LegIssuer, Name: "LegIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedLegIssuerLen, Name: "EncodedLegIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegIssuer, Name: "EncodedLegIssuer", Type: FieldTypeString},
+				{Tag: tag.LegSecurityDesc, Name: "LegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedLegSecurityDescLen, Name: "EncodedLegSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegSecurityDesc, Name: "EncodedLegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.LegRatioQty, Name: "LegRatioQty", Type: FieldTypeDecimal},
+				{Tag: tag.LegSide, Name: "LegSide", Type: FieldTypeString},
+				{Tag: tag.LegCurrency, Name: "LegCurrency", Type: FieldTypeString},
+				{Tag: tag.LegPool, Name: "LegPool", Type: FieldTypeString},
+				{Tag: tag.LegDatedDate, Name: "LegDatedDate", Type: FieldTypeString},
+				{Tag: tag.LegContractSettlMonth, Name: "LegContractSettlMonth", Type: FieldTypeString},
+				{Tag: tag.LegInterestAccrualDate, Name: "LegInterestAccrualDate", Type: FieldTypeString},
+			}},
+			{Tag: tag.NoUnderlyings, Name: "NoUnderlyings", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.UnderlyingSymbol, Name: "UnderlyingSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.UnderlyingSymbolSfx, Name: "UnderlyingSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityID, Name: "UnderlyingSecurityID", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityIDSource, Name: "UnderlyingSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoUnderlyingSecurityAltID, Name: "NoUnderlyingSecurityAltID", Type: FieldTypeNumInGroup, Fields: noUnderlyingSecurityAltIDFields},
+				{Tag: tag.UnderlyingProduct, Name: "UnderlyingProduct", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingCFICode, Name: "UnderlyingCFICode", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityType, Name: "UnderlyingSecurityType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecuritySubType, Name: "UnderlyingSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityMonthYear, Name: "UnderlyingMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityDate, Name: "UnderlyingMaturityDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCouponPaymentDate, Name: "UnderlyingCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssueDate, Name: "UnderlyingIssueDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRepoCollateralSecurityType, Name: "UnderlyingRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseTerm, Name: "UnderlyingRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseRate, Name: "UnderlyingRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingFactor, Name: "UnderlyingFactor", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCreditRating, Name: "UnderlyingCreditRating", Type: FieldTypeString},
+				{Tag: tag.UnderlyingInstrRegistry, Name: "UnderlyingInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCountryOfIssue, Name: "UnderlyingCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStateOrProvinceOfIssue, Name: "UnderlyingStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingLocaleOfIssue, Name: "UnderlyingLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRedemptionDate, Name: "UnderlyingRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStrikePrice, Name: "UnderlyingStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStrikeCurrency, Name: "UnderlyingStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingOptAttribute, Name: "UnderlyingOptAttribute", Type: FieldTypeString},
+				{Tag: tag.UnderlyingContractMultiplier, Name: "UnderlyingContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCouponRate, Name: "UnderlyingCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingSecurityExchange, Name: "UnderlyingSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssuer, Name: "UnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingIssuerLen, Name: "EncodedUnderlyingIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingIssuer, Name: "EncodedUnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityDesc, Name: "UnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingSecurityDescLen, Name: "EncodedUnderlyingSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingSecurityDesc, Name: "EncodedUnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPProgram, Name: "UnderlyingCPProgram", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPRegType, Name: "UnderlyingCPRegType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCurrency, Name: "UnderlyingCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingQty, Name: "UnderlyingQty", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingPx, Name: "UnderlyingPx", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingDirtyPrice, Name: "UnderlyingDirtyPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndPrice, Name: "UnderlyingEndPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStartValue, Name: "UnderlyingStartValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCurrentValue, Name: "UnderlyingCurrentValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndValue, Name: "UnderlyingEndValue", Type: FieldTypeDecimal},
+				{Tag: tag.NoUnderlyingStips, Name: "NoUnderlyingStips", Type: FieldTypeNumInGroup, Fields: noUnderlyingStipsFields},
+			}},
+		},
+	},
+	"8": {
+		Name:    "ExecutionReport",
+		MsgType: "8",
+		Fields: []FieldDef{
+			{Tag: tag.Account, Name: "Account", Type: FieldTypeString},
+			{Tag: tag.AvgPx, Name: "AvgPx", Type: FieldTypeDecimal, Required: true},
+			{Tag: tag.ClOrdID, Name: "ClOrdID", Type: FieldTypeString},
+			{Tag: tag.Commission, Name: "Commission", Type: FieldTypeDecimal},
+			{Tag: tag.CommType, Name: "CommType", Type: FieldTypeString},
+			{Tag: tag.CumQty, Name: "CumQty", Type: FieldTypeDecimal, Required: true},
+			{Tag: tag.Currency, Name: "Currency", Type: FieldTypeString},
+			{Tag: tag.ExecID, Name: "ExecID", Type: FieldTypeString, Required: true},
+			{Tag: tag.ExecInst, Name: "ExecInst", Type: FieldTypeString},
+			{Tag: tag.ExecRefID, Name: "ExecRefID", Type: FieldTypeString},
+			{Tag: tag.HandlInst, Name: "HandlInst", Type: FieldTypeString},
+			{Tag: tag.SecurityIDSource, Name: "SecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.LastCapacity, Name: "LastCapacity", Type: FieldTypeString},
+			{Tag: tag.LastMkt, Name: "LastMkt", Type: FieldTypeString},
+			{Tag: tag.LastPx, Name: "LastPx", Type: FieldTypeDecimal},
+			{Tag: tag.LastQty, Name: "LastQty", Type: FieldTypeDecimal},
+			{Tag: tag.OrderID, Name: "OrderID", Type: FieldTypeString, Required: true},
+			{Tag: tag.OrderQty, Name: "OrderQty", Type: FieldTypeDecimal},
+			{Tag: tag.OrdStatus, Name: "OrdStatus", Type: FieldTypeString, Required: true},
+			{Tag: tag.OrdType, Name: "OrdType", Type: FieldTypeString},
+			{Tag: tag.OrigClOrdID, Name: "OrigClOrdID", Type: FieldTypeString},
+			{Tag: tag.Price, Name: "Price", Type: FieldTypeDecimal},
+			{Tag: tag.SecurityID, Name: "SecurityID", Type: FieldTypeString},
+			{Tag: tag.Side, Name: "Side", Type: FieldTypeString, Required: true},
+			{Tag: tag.Symbol, Name: "Symbol", Type: FieldTypeString},
+			{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+			{Tag: tag.TimeInForce, Name: "TimeInForce", Type: FieldTypeString},
+			{Tag: tag.TransactTime, Name: "TransactTime", Type: FieldTypeUTCTimestamp},
+			{Tag: tag.SettlType, Name: "SettlType", Type: FieldTypeString},
+			{Tag: tag.SettlDate, Name: "SettlDate", Type: FieldTypeString},
+			{Tag: tag.SymbolSfx, Name: "SymbolSfx", Type: FieldTypeString},
+			{Tag: tag.ListID, Name: "ListID", Type: FieldTypeString},
+			{Tag: tag.TradeDate, Name: "TradeDate", Type: FieldTypeString},
+			{Tag: tag.PositionEffect, Name: "PositionEffect", Type: FieldTypeString},
+			{Tag: tag.StopPx, Name: "StopPx", Type: FieldTypeDecimal},
+			{Tag: tag.OrdRejReason, Name: "OrdRejReason", Type: FieldTypeString},
+			{Tag: tag.Issuer, Name: "Issuer", Type: FieldTypeString},
+			{Tag: tag.SecurityDesc, Name: "SecurityDesc", Type: FieldTypeString},
+			{Tag: tag.MinQty, Name: "MinQty", Type: FieldTypeDecimal},
+			{Tag: tag.MaxFloor, Name: "MaxFloor", Type: FieldTypeDecimal},
+			{Tag: tag.ReportToExch, Name: "ReportToExch", Type: FieldTypeBool},
+			{Tag: tag.NetMoney, Name: "NetMoney", Type: FieldTypeDecimal},
+			{Tag: tag.SettlCurrAmt, Name: "SettlCurrAmt", Type: FieldTypeDecimal},
+			{Tag: tag.SettlCurrency, Name: "SettlCurrency", Type: FieldTypeString},
+			{Tag: tag.ExpireTime, Name: "ExpireTime", Type: FieldTypeUTCTimestamp},
+			{Tag: tag.NoMiscFees, Name: "NoMiscFees", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.MiscFeeAmt, Name: "MiscFeeAmt", Type: FieldTypeDecimal, Required: true},
+				{Tag: tag.MiscFeeCurr, Name: "MiscFeeCurr", Type: FieldTypeString},
+				{Tag: tag.MiscFeeType, Name: "MiscFeeType", Type: FieldTypeString},
+				{Tag: tag.MiscFeeBasis, Name: "MiscFeeBasis", Type: FieldTypeString},
+			}},
+			{Tag: tag.ExecType, Name: "ExecType", Type: FieldTypeString, Required: true},
+			{Tag: tag.LeavesQty, Name: "LeavesQty", Type: FieldTypeDecimal, Required: true},
+			{Tag: tag.CashOrderQty, Name: "CashOrderQty", Type: FieldTypeDecimal},
+			{Tag: tag.SettlCurrFxRate, Name: "SettlCurrFxRate", Type: FieldTypeDecimal},
+			{Tag: tag.SettlCurrFxRateCalc, Name: "SettlCurrFxRateCalc", Type: FieldTypeString},
+			{Tag: tag.NumDaysInterest, Name: "NumDaysInterest", Type: FieldTypeInt},
+			{Tag: tag.AccruedInterestRate, Name: "AccruedInterestRate", Type: FieldTypeDecimal},
+			{Tag: tag.AccruedInterestAmt, Name: "AccruedInterestAmt", Type: FieldTypeDecimal},
+			{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+			{Tag: tag.EffectiveTime, Name: "EffectiveTime", Type: FieldTypeUTCTimestamp},
+			{Tag: tag.OrderQty2, Name: "OrderQty2", Type: FieldTypeDecimal},
+			{Tag: tag.SettlDate2, Name: "SettlDate2", Type: FieldTypeString},
+			{Tag: tag.LastSpotRate, Name: "LastSpotRate", Type: FieldTypeDecimal},
+			{Tag: tag.LastForwardPoints, Name: "LastForwardPoints", Type: FieldTypeDecimal},
+			{Tag: tag.SecondaryOrderID, Name: "SecondaryOrderID", Type: FieldTypeString},
+			{Tag: tag.MaturityMonthYear, Name: "MaturityMonthYear", Type: FieldTypeString},
+			{Tag: tag.StrikePrice, Name: "StrikePrice", Type: FieldTypeDecimal},
+			{Tag: tag.OptAttribute, Name: "OptAttribute", Type: FieldTypeString},
+			{Tag: tag.SecurityExchange, Name: "SecurityExchange", Type: FieldTypeString},
+			{Tag: tag.MaxShow, Name: "MaxShow", Type: FieldTypeDecimal},
+			{Tag: tag.PegOffsetValue, Name: "PegOffsetValue", Type: FieldTypeDecimal},
+			{Tag: tag.Spread, Name: "Spread", Type: FieldTypeDecimal},
+			{Tag: tag.BenchmarkCurveCurrency, Name: "BenchmarkCurveCurrency", Type: FieldTypeString},
+			{Tag: tag.BenchmarkCurveName, Name: "BenchmarkCurveName", Type: FieldTypeString},
+			{Tag: tag.BenchmarkCurvePoint, Name: "BenchmarkCurvePoint", Type: FieldTypeString},
+			{Tag: tag.CouponRate, Name: "CouponRate", Type: FieldTypeDecimal},
+			{Tag: tag.CouponPaymentDate, Name: "CouponPaymentDate", Type: FieldTypeString},
+			{Tag: tag.IssueDate, Name: "IssueDate", Type: FieldTypeString},
+			{Tag: tag.RepurchaseTerm, Name: "RepurchaseTerm", Type: FieldTypeInt},
+			{Tag: tag.RepurchaseRate, Name: "RepurchaseRate", Type: FieldTypeDecimal},
+			{Tag: tag.Factor, Name: "Factor", Type: FieldTypeDecimal},
+			{Tag: tag.TradeOriginationDate, Name: "TradeOriginationDate", Type: FieldTypeString},
+			{Tag: tag.ExDate, Name: "ExDate", Type: FieldTypeString},
+			{Tag: tag.ContractMultiplier, Name: "ContractMultiplier", Type: FieldTypeDecimal},
+			{Tag: tag.NoStipulations, Name: "NoStipulations", Type: FieldTypeNumInGroup, Fields: noStipulationsFields},
+			{Tag: tag.YieldType, Name: "YieldType", Type: FieldTypeString},
+			{Tag: tag.Yield, Name: "Yield", Type: FieldTypeDecimal},
+			{Tag: tag.TotalTakedown, Name: "TotalTakedown", Type: FieldTypeDecimal},
+			{Tag: tag.Concession, Name: "Concession", Type: FieldTypeDecimal},
+			{Tag: tag.RepoCollateralSecurityType, Name: "RepoCollateralSecurityType", Type: FieldTypeInt},
+			{Tag: tag.RedemptionDate, Name: "RedemptionDate", Type: FieldTypeString},
+			{Tag: tag.CreditRating, Name: "CreditRating", Type: FieldTypeString},
+			{Tag: tag.TradedFlatSwitch, Name: "TradedFlatSwitch", Type: FieldTypeBool},
+			{Tag: tag.BasisFeatureDate, Name: "BasisFeatureDate", Type: FieldTypeString},
+			{Tag: tag.BasisFeaturePrice, Name: "BasisFeaturePrice", Type: FieldTypeDecimal},
+			{Tag: tag.TradingSessionID, Name: "TradingSessionID", Type: FieldTypeString},
+			{Tag: tag.EncodedIssuerLen, Name: "EncodedIssuerLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedIssuer, Name: "EncodedIssuer", Type: FieldTypeString},
+			{Tag: tag.EncodedSecurityDescLen, Name: "EncodedSecurityDescLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedSecurityDesc, Name: "EncodedSecurityDesc", Type: FieldTypeString},
+			{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			{Tag: tag.ComplianceID, Name: "ComplianceID", Type: FieldTypeString},
+			{Tag: tag.SolicitedFlag, Name: "SolicitedFlag", Type: FieldTypeBool},
+			{Tag: tag.ExecRestatementReason, Name: "ExecRestatementReason", Type: FieldTypeString},
+			{Tag: tag.GrossTradeAmt, Name: "GrossTradeAmt", Type: FieldTypeDecimal},
+			{Tag: tag.NoContraBrokers, Name: "NoContraBrokers", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.ContraBroker, Name: "ContraBroker", Type: FieldTypeString, Required: true},
+				{Tag: tag.ContraTrader, Name: "ContraTrader", Type: FieldTypeString},
+				{Tag: tag.ContraTradeQty, Name: "ContraTradeQty", Type: FieldTypeDecimal},
+				{Tag: tag.ContraTradeTime, Name: "ContraTradeTime", Type: FieldTypeUTCTimestamp},
+				{Tag: tag.ContraLegRefID, Name: "ContraLegRefID", Type: FieldTypeString},
+			}},
+			{Tag: tag.DiscretionInst, Name: "DiscretionInst", Type: FieldTypeString},
+			{Tag: tag.DiscretionOffsetValue, Name: "DiscretionOffsetValue", Type: FieldTypeDecimal},
+			{Tag: tag.PriceType, Name: "PriceType", Type: FieldTypeString},
+			{Tag: tag.DayOrderQty, Name: "DayOrderQty", Type: FieldTypeDecimal},
+			{Tag: tag.DayCumQty, Name: "DayCumQty", Type: FieldTypeDecimal},
+			{Tag: tag.DayAvgPx, Name: "DayAvgPx", Type: FieldTypeDecimal},
+			{Tag: tag.GTBookingInst, Name: "GTBookingInst", Type: FieldTypeString},
+			{Tag: tag.ExpireDate, Name: "ExpireDate", Type: FieldTypeString},
+			{Tag: tag.MultiLegReportingType, Name: "MultiLegReportingType", Type: FieldTypeString},
+			{Tag: tag.NoPartyIDs, Name: "NoPartyIDs", Type: FieldTypeNumInGroup, Fields: noPartyIDsFields},
+			{Tag: tag.NoSecurityAltID, Name: "NoSecurityAltID", Type: FieldTypeNumInGroup, Fields: noSecurityAltIDFields},
+			{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+			{Tag: tag.CFICode, Name: "CFICode", Type: FieldTypeString},
+			{Tag: tag.RoundingDirection, Name: "RoundingDirection", Type: FieldTypeString},
+			{Tag: tag.RoundingModulus, Name: "RoundingModulus", Type: FieldTypeDecimal},
+			{Tag: tag.CountryOfIssue, Name: "CountryOfIssue", Type: FieldTypeString},
+			{Tag: tag.StateOrProvinceOfIssue, Name: "StateOrProvinceOfIssue", Type: FieldTypeString},
+			{Tag: tag.LocaleOfIssue, Name: "LocaleOfIssue", Type: FieldTypeString},
+			{Tag: tag.CommCurrency, Name: "CommCurrency", Type: FieldTypeString},
+			{Tag: tag.CancellationRights, Name: "CancellationRights", Type: FieldTypeString},
+			{Tag: tag.MoneyLaunderingStatus, Name: "MoneyLaunderingStatus", Type: FieldTypeString},
+			{Tag: tag.TransBkdTime, Name: "TransBkdTime", Type: FieldTypeUTCTimestamp},
+			{Tag: tag.ExecPriceType, Name: "ExecPriceType", Type: FieldTypeString},
+			{Tag: tag.ExecPriceAdjustment, Name: "ExecPriceAdjustment", Type: FieldTypeDecimal},
+			{Tag: tag.Designation, Name: "Designation", Type: FieldTypeString},
+			{Tag: tag.FundRenewWaiv, Name: "FundRenewWaiv", Type: FieldTypeString},
+			{Tag: tag.RegistID, Name: "RegistID", Type: FieldTypeString},
+			{Tag: tag.ExecValuationPoint, Name: "ExecValuationPoint", Type: FieldTypeUTCTimestamp},
+			{Tag: tag.OrderPercent, Name: "OrderPercent", Type: FieldTypeDecimal},
+			{Tag: tag.NoContAmts, Name: "NoContAmts", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.ContAmtType, Name: "ContAmtType", Type: FieldTypeString, Required: true},
+				{Tag: tag.ContAmtValue, Name: "ContAmtValue", Type: FieldTypeDecimal},
+				{Tag: tag.ContAmtCurr, Name: "ContAmtCurr", Type: FieldTypeString},
+			}},
+			{Tag: tag.SecondaryClOrdID, Name: "SecondaryClOrdID", Type: FieldTypeString},
+			{Tag: tag.SecondaryExecID, Name: "SecondaryExecID", Type: FieldTypeString},
+			{Tag: tag.OrderCapacity, Name: "OrderCapacity", Type: FieldTypeString},
+			{Tag: tag.OrderRestrictions, Name: "OrderRestrictions", Type: FieldTypeString},
+			{Tag: tag.MaturityDate, Name: "MaturityDate", Type: FieldTypeString},
+			{Tag: tag.InstrRegistry, Name: "InstrRegistry", Type: FieldTypeString},
+			{Tag: tag.CashMargin, Name: "CashMargin", Type: FieldTypeString},
+			{Tag: tag.CrossID, Name: "CrossID", Type: FieldTypeString},
+			{Tag: tag.CrossType, Name: "CrossType", Type: FieldTypeString},
+			{Tag: tag.OrigCrossID, Name: "OrigCrossID", Type: FieldTypeString},
+			{Tag: tag.NoLegs, Name: "NoLegs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.LegSymbol, Name: "LegSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.LegSymbolSfx, Name: "LegSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.LegSecurityID, Name: "LegSecurityID", Type: FieldTypeString},
+				{Tag: tag.LegSecurityIDSource, Name: "LegSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoLegSecurityAltID, Name: "NoLegSecurityAltID", Type: FieldTypeNumInGroup, Fields: noLegSecurityAltIDFields},
+				{Tag: tag.LegProduct, Name: "LegProduct", Type: FieldTypeInt},
+				{Tag: tag.LegCFICode, Name: "LegCFICode", Type: FieldTypeString},
+				{Tag: tag.LegSecurityType, Name: "LegSecurityType", Type: FieldTypeString},
+				{Tag: tag.LegSecuritySubType, Name: "LegSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.LegMaturityMonthYear, Name: "LegMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.LegMaturityDate, Name: "LegMaturityDate", Type: FieldTypeString},
+				{Tag: tag.LegCouponPaymentDate, Name: "LegCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.LegIssueDate, Name: "LegIssueDate", Type: FieldTypeString},
+				{Tag: tag.LegRepoCollateralSecurityType, Name: "LegRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseTerm, Name: "LegRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseRate, Name: "LegRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegFactor, Name: "LegFactor", Type: FieldTypeDecimal},
+				{Tag: tag.LegCreditRating, Name: "LegCreditRating", Type: FieldTypeString},
+				{Tag: tag.LegInstrRegistry, Name: "LegInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.LegCountryOfIssue, Name: "LegCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegStateOrProvinceOfIssue, Name: "LegStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegLocaleOfIssue, Name: "LegLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegRedemptionDate, Name: "LegRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.LegStrikePrice, Name: "LegStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.LegStrikeCurrency, Name: "LegStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.LegOptAttribute, Name: "LegOptAttribute", Type: FieldTypeString},
+				{Tag: tag.LegContractMultiplier, Name: "LegContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.LegCouponRate, Name: "LegCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegSecurityExchange, Name: "LegSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.LegIssuer, Name: "LegIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedLegIssuerLen, Name: "EncodedLegIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegIssuer, Name: "EncodedLegIssuer", Type: FieldTypeString},
+				{Tag: tag.LegSecurityDesc, Name: "LegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedLegSecurityDescLen, Name: "EncodedLegSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegSecurityDesc, Name: "EncodedLegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.LegRatioQty, Name: "LegRatioQty", Type: FieldTypeDecimal},
+				{Tag: tag.LegSide, Name: "LegSide", Type: FieldTypeString},
+				{Tag: tag.LegCurrency, Name: "LegCurrency", Type: FieldTypeString},
+				{Tag: tag.LegPool, Name: "LegPool", Type: FieldTypeString},
+				{Tag: tag.LegDatedDate, Name: "LegDatedDate", Type: FieldTypeString},
+				{Tag: tag.LegContractSettlMonth, Name: "LegContractSettlMonth", Type: FieldTypeString},
+				{Tag: tag.LegInterestAccrualDate, Name: "LegInterestAccrualDate", Type: FieldTypeString},
+				{Tag: tag.LegQty, Name: "LegQty", Type: FieldTypeDecimal},
+				{Tag: tag.LegSwapType, Name: "LegSwapType", Type: FieldTypeString},
+				{Tag: tag.NoLegStipulations, Name: "NoLegStipulations", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+					{Tag: tag.LegStipulationType, Name: "LegStipulationType", Type: FieldTypeString, Required: true},
+					{Tag: tag.LegStipulationValue, Name: "LegStipulationValue", Type: FieldTypeString},
+				}},
+				{Tag: tag.LegPositionEffect, Name: "LegPositionEffect", Type: FieldTypeString},
+				{Tag: tag.LegCoveredOrUncovered, Name: "LegCoveredOrUncovered", Type: FieldTypeInt},
+				{Tag: tag.NoNestedPartyIDs, Name: "NoNestedPartyIDs", Type: FieldTypeNumInGroup, Fields: noNestedPartyIDsFields},
+				{Tag: tag.LegRefID, Name: "LegRefID", Type: FieldTypeString},
+				{Tag: tag.LegPrice, Name: "LegPrice", Type: FieldTypeDecimal},
+				{Tag: tag.LegSettlType, Name: "LegSettlType", Type: FieldTypeString},
+				{Tag: tag.LegSettlDate, Name: "LegSettlDate", Type: FieldTypeString},
+				{Tag: tag.LegLastPx, Name: "LegLastPx", Type: FieldTypeDecimal},
+			}},
+			{Tag: tag.AccountType, Name: "AccountType", Type: FieldTypeString},
+			{Tag: tag.CustOrderCapacity, Name: "CustOrderCapacity", Type: FieldTypeString},
+			{Tag: tag.ClOrdLinkID, Name: "ClOrdLinkID", Type: FieldTypeString},
+			{Tag: tag.MassStatusReqID, Name: "MassStatusReqID", Type: FieldTypeString},
+			{Tag: tag.DayBookingInst, Name: "DayBookingInst", Type: FieldTypeString},
+			{Tag: tag.BookingUnit, Name: "BookingUnit", Type: FieldTypeString},
+			{Tag: tag.PreallocMethod, Name: "PreallocMethod", Type: FieldTypeString},
+			{Tag: tag.TradingSessionSubID, Name: "TradingSessionSubID", Type: FieldTypeString},
+			{Tag: tag.ClearingFeeIndicator, Name: "ClearingFeeIndicator", Type: FieldTypeString},
+			{Tag: tag.WorkingIndicator, Name: "WorkingIndicator", Type: FieldTypeBool},
+			{Tag: tag.PriorityIndicator, Name: "PriorityIndicator", Type: FieldTypeString},
+			{Tag: tag.PriceImprovement, Name: "PriceImprovement", Type: FieldTypeDecimal},
+			{Tag: tag.LastForwardPoints2, Name: "LastForwardPoints2", Type: FieldTypeDecimal},
+			{Tag: tag.UnderlyingLastPx, Name: "UnderlyingLastPx", Type: FieldTypeDecimal},
+			{Tag: tag.UnderlyingLastQty, Name: "UnderlyingLastQty", Type: FieldTypeDecimal},
+			{Tag: tag.AcctIDSource, Name: "AcctIDSource", Type: FieldTypeString},
+			{Tag: tag.BenchmarkPrice, Name: "BenchmarkPrice", Type: FieldTypeDecimal},
+			{Tag: tag.BenchmarkPriceType, Name: "BenchmarkPriceType", Type: FieldTypeInt},
+			{Tag: tag.ContractSettlMonth, Name: "ContractSettlMonth", Type: FieldTypeString},
+			{Tag: tag.LastParPx, Name: "LastParPx", Type: FieldTypeDecimal},
+			{Tag: tag.Pool, Name: "Pool", Type: FieldTypeString},
+			{Tag: tag.QuoteRespID, Name: "QuoteRespID", Type: FieldTypeString},
+			{Tag: tag.YieldRedemptionDate, Name: "YieldRedemptionDate", Type: FieldTypeString},
+			{Tag: tag.YieldRedemptionPrice, Name: "YieldRedemptionPrice", Type: FieldTypeDecimal},
+			{Tag: tag.YieldRedemptionPriceType, Name: "YieldRedemptionPriceType", Type: FieldTypeInt},
+			{Tag: tag.BenchmarkSecurityID, Name: "BenchmarkSecurityID", Type: FieldTypeString},
+			{Tag: tag.YieldCalcDate, Name: "YieldCalcDate", Type: FieldTypeString},
+			{Tag: tag.NoUnderlyings, Name: "NoUnderlyings", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.UnderlyingSymbol, Name: "UnderlyingSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.UnderlyingSymbolSfx, Name: "UnderlyingSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityID, Name: "UnderlyingSecurityID", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityIDSource, Name: "UnderlyingSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoUnderlyingSecurityAltID, Name: "NoUnderlyingSecurityAltID", Type: FieldTypeNumInGroup, Fields: noUnderlyingSecurityAltIDFields},
+				{Tag: tag.UnderlyingProduct, Name: "UnderlyingProduct", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingCFICode, Name: "UnderlyingCFICode", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityType, Name: "UnderlyingSecurityType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecuritySubType, Name: "UnderlyingSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityMonthYear, Name: "UnderlyingMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityDate, Name: "UnderlyingMaturityDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCouponPaymentDate, Name: "UnderlyingCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssueDate, Name: "UnderlyingIssueDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRepoCollateralSecurityType, Name: "UnderlyingRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseTerm, Name: "UnderlyingRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseRate, Name: "UnderlyingRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingFactor, Name: "UnderlyingFactor", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCreditRating, Name: "UnderlyingCreditRating", Type: FieldTypeString},
+				{Tag: tag.UnderlyingInstrRegistry, Name: "UnderlyingInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCountryOfIssue, Name: "UnderlyingCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStateOrProvinceOfIssue, Name: "UnderlyingStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingLocaleOfIssue, Name: "UnderlyingLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRedemptionDate, Name: "UnderlyingRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStrikePrice, Name: "UnderlyingStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStrikeCurrency, Name: "UnderlyingStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingOptAttribute, Name: "UnderlyingOptAttribute", Type: FieldTypeString},
+				{Tag: tag.UnderlyingContractMultiplier, Name: "UnderlyingContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCouponRate, Name: "UnderlyingCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingSecurityExchange, Name: "UnderlyingSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssuer, Name: "UnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingIssuerLen, Name: "EncodedUnderlyingIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingIssuer, Name: "EncodedUnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityDesc, Name: "UnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingSecurityDescLen, Name: "EncodedUnderlyingSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingSecurityDesc, Name: "EncodedUnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPProgram, Name: "UnderlyingCPProgram", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPRegType, Name: "UnderlyingCPRegType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCurrency, Name: "UnderlyingCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingQty, Name: "UnderlyingQty", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingPx, Name: "UnderlyingPx", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingDirtyPrice, Name: "UnderlyingDirtyPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndPrice, Name: "UnderlyingEndPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStartValue, Name: "UnderlyingStartValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCurrentValue, Name: "UnderlyingCurrentValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndValue, Name: "UnderlyingEndValue", Type: FieldTypeDecimal},
+				{Tag: tag.NoUnderlyingStips, Name: "NoUnderlyingStips", Type: FieldTypeNumInGroup, Fields: noUnderlyingStipsFields},
+			}},
+			{Tag: tag.InterestAtMaturity, Name: "InterestAtMaturity", Type: FieldTypeDecimal},
+			{Tag: tag.BenchmarkSecurityIDSource, Name: "BenchmarkSecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.SecuritySubType, Name: "SecuritySubType", Type: FieldTypeString},
+			{Tag: tag.BookingType, Name: "BookingType", Type: FieldTypeString},
+			{Tag: tag.TerminationType, Name: "TerminationType", Type: FieldTypeString},
+			{Tag: tag.OrdStatusReqID, Name: "OrdStatusReqID", Type: FieldTypeString},
+			{Tag: tag.CopyMsgIndicator, Name: "CopyMsgIndicator", Type: FieldTypeBool},
+			{Tag: tag.PegMoveType, Name: "PegMoveType", Type: FieldTypeString},
+			{Tag: tag.PegOffsetType, Name: "PegOffsetType", Type: FieldTypeString},
+			{Tag: tag.PegLimitType, Name: "PegLimitType", Type: FieldTypeString},
+			{Tag: tag.PegRoundDirection, Name: "PegRoundDirection", Type: FieldTypeString},
+			{Tag: tag.PeggedPrice, Name: "PeggedPrice", Type: FieldTypeDecimal},
+			{Tag: tag.PegScope, Name: "PegScope", Type: FieldTypeString},
+			{Tag: tag.DiscretionMoveType, Name: "DiscretionMoveType", Type: FieldTypeString},
+			{Tag: tag.DiscretionOffsetType, Name: "DiscretionOffsetType", Type: FieldTypeString},
+			{Tag: tag.DiscretionLimitType, Name: "DiscretionLimitType", Type: FieldTypeString},
+			{Tag: tag.DiscretionRoundDirection, Name: "DiscretionRoundDirection", Type: FieldTypeString},
+			{Tag: tag.DiscretionPrice, Name: "DiscretionPrice", Type: FieldTypeDecimal},
+			{Tag: tag.DiscretionScope, Name: "DiscretionScope", Type: FieldTypeString},
+			{Tag: tag.TargetStrategy, Name: "TargetStrategy", Type: FieldTypeString},
+			{Tag: tag.TargetStrategyParameters, Name: "TargetStrategyParameters", Type: FieldTypeString},
+			{Tag: tag.ParticipationRate, Name: "ParticipationRate", Type: FieldTypeDecimal},
+			{Tag: tag.TargetStrategyPerformance, Name: "TargetStrategyPerformance", Type: FieldTypeDecimal},
+			{Tag: tag.LastLiquidityInd, Name: "LastLiquidityInd", Type: FieldTypeString},
+			{Tag: tag.QtyType, Name: "QtyType", Type: FieldTypeString},
+			{Tag: tag.NoEvents, Name: "NoEvents", Type: FieldTypeNumInGroup, Fields: noEventsFields},
+			{Tag: tag.DatedDate, Name: "DatedDate", Type: FieldTypeString},
+			{Tag: tag.InterestAccrualDate, Name: "InterestAccrualDate", Type: FieldTypeString},
+			{Tag: tag.CPProgram, Name: "CPProgram", Type: FieldTypeString},
+			{Tag: tag.CPRegType, Name: "CPRegType", Type: FieldTypeString},
+			{Tag: tag.MarginRatio, Name: "MarginRatio", Type: FieldTypeDecimal},
+			{Tag: tag.TotNumReports, Name: "TotNumReports", Type: FieldTypeInt},
+			{Tag: tag.LastRptRequested, Name: "LastRptRequested", Type: FieldTypeBool},
+			{Tag: tag.AgreementDesc, Name: "AgreementDesc", Type: FieldTypeString},
+			{Tag: tag.AgreementID, Name: "AgreementID", Type: FieldTypeString},
+			{Tag: tag.AgreementDate, Name: "AgreementDate", Type: FieldTypeString},
+			{Tag: tag.StartDate, Name: "StartDate", Type: FieldTypeString},
+			{Tag: tag.EndDate, Name: "EndDate", Type: FieldTypeString},
+			{Tag: tag.AgreementCurrency, Name: "AgreementCurrency", Type: FieldTypeString},
+			{Tag: tag.DeliveryType, Name: "DeliveryType", Type: FieldTypeString},
+			{Tag: tag.EndAccruedInterestAmt, Name: "EndAccruedInterestAmt", Type: FieldTypeDecimal},
+			{Tag: tag.StartCash, Name: "StartCash", Type: FieldTypeDecimal},
+			{Tag: tag.EndCash, Name: "EndCash", Type: FieldTypeDecimal},
+			{Tag: tag.TimeBracket, Name: "TimeBracket", Type: FieldTypeString},
+			{Tag: tag.StrikeCurrency, Name: "StrikeCurrency", Type: FieldTypeString},
+		},
+	},
+	"0": {
+		Name:    "Heartbeat",
+		MsgType: "0",
+		Fields: []FieldDef{
+			{Tag: tag.TestReqID, Name: "TestReqID", Type: FieldTypeString},
+		},
+	},
+	"6": {
+		Name:    "IOI",
+		MsgType: "6",
+		Fields: []FieldDef{
+			{Tag: tag.Currency, Name: "Currency", Type: FieldTypeString},
+			{Tag: tag.SecurityIDSource, Name: "SecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.IOIID, Name: "IOIID", Type: FieldTypeString, Required: true},
+			{Tag: tag.IOIQltyInd, Name: "IOIQltyInd", Type: FieldTypeString},
+			{Tag: tag.IOIRefID, Name: "IOIRefID", Type: FieldTypeString},
+			{Tag: tag.IOIQty, Name: "IOIQty", Type: FieldTypeString, Required: true},
+			{Tag: tag.IOITransType, Name: "IOITransType", Type: FieldTypeString, Required: true},
+			{Tag: tag.OrderQty, Name: "OrderQty", Type: FieldTypeDecimal},
+			{Tag: tag.Price, Name: "Price", Type: FieldTypeDecimal},
+			{Tag: tag.SecurityID, Name: "SecurityID", Type: FieldTypeString},
+			{Tag: tag.Side, Name: "Side", Type: FieldTypeString, Required: true},
+			{Tag: tag.Symbol, Name: "Symbol", Type: FieldTypeString},
+			{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+			{Tag: tag.TransactTime, Name: "TransactTime", Type: FieldTypeUTCTimestamp},
+			{Tag: tag.ValidUntilTime, Name: "ValidUntilTime", Type: FieldTypeUTCTimestamp},
+			{Tag: tag.SymbolSfx, Name: "SymbolSfx", Type: FieldTypeString},
+			{Tag: tag.Issuer, Name: "Issuer", Type: FieldTypeString},
+			{Tag: tag.SecurityDesc, Name: "SecurityDesc", Type: FieldTypeString},
+			{Tag: tag.IOINaturalFlag, Name: "IOINaturalFlag", Type: FieldTypeBool},
+			{Tag: tag.URLLink, Name: "URLLink", Type: FieldTypeString},
+			{Tag: tag.CashOrderQty, Name: "CashOrderQty", Type: FieldTypeDecimal},
+			{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+			{Tag: tag.NoIOIQualifiers, Name: "NoIOIQualifiers", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.IOIQualifier, Name: "IOIQualifier", Type: FieldTypeString, Required: true},
+			}},
+			{Tag: tag.MaturityMonthYear, Name: "MaturityMonthYear", Type: FieldTypeString},
+			{Tag: tag.StrikePrice, Name: "StrikePrice", Type: FieldTypeDecimal},
+			{Tag: tag.OptAttribute, Name: "OptAttribute", Type: FieldTypeString},
+			{Tag: tag.SecurityExchange, Name: "SecurityExchange", Type: FieldTypeString},
+			{Tag: tag.NoRoutingIDs, Name: "NoRoutingIDs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.RoutingType, Name: "RoutingType", Type: FieldTypeString, Required: true},
+				{Tag: tag.RoutingID, Name: "RoutingID", Type: FieldTypeString},
+			}},
+			{Tag: tag.Spread, Name: "Spread", Type: FieldTypeDecimal},
+			{Tag: tag.BenchmarkCurveCurrency, Name: "BenchmarkCurveCurrency", Type: FieldTypeString},
+			{Tag: tag.BenchmarkCurveName, Name: "BenchmarkCurveName", Type: FieldTypeString},
+			{Tag: tag.BenchmarkCurvePoint, Name: "BenchmarkCurvePoint", Type: FieldTypeString},
+			{Tag: tag.CouponRate, Name: "CouponRate", Type: FieldTypeDecimal},
+			{Tag: tag.CouponPaymentDate, Name: "CouponPaymentDate", Type: FieldTypeString},
+			{Tag: tag.IssueDate, Name: "IssueDate", Type: FieldTypeString},
+			{Tag: tag.RepurchaseTerm, Name: "RepurchaseTerm", Type: FieldTypeInt},
+			{Tag: tag.RepurchaseRate, Name: "RepurchaseRate", Type: FieldTypeDecimal},
+			{Tag: tag.Factor, Name: "Factor", Type: FieldTypeDecimal},
+			{Tag: tag.ContractMultiplier, Name: "ContractMultiplier", Type: FieldTypeDecimal},
+			{Tag: tag.NoStipulations, Name: "NoStipulations", Type: FieldTypeNumInGroup, Fields: noStipulationsFields},
+			{Tag: tag.YieldType, Name: "YieldType", Type: FieldTypeString},
+			{Tag: tag.Yield, Name: "Yield", Type: FieldTypeDecimal},
+			{Tag: tag.RepoCollateralSecurityType, Name: "RepoCollateralSecurityType", Type: FieldTypeInt},
+			{Tag: tag.RedemptionDate, Name: "RedemptionDate", Type: FieldTypeString},
+			{Tag: tag.CreditRating, Name: "CreditRating", Type: FieldTypeString},
+			{Tag: tag.EncodedIssuerLen, Name: "EncodedIssuerLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedIssuer, Name: "EncodedIssuer", Type: FieldTypeString},
+			{Tag: tag.EncodedSecurityDescLen, Name: "EncodedSecurityDescLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedSecurityDesc, Name: "EncodedSecurityDesc", Type: FieldTypeString},
+			{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			{Tag: tag.PriceType, Name: "PriceType", Type: FieldTypeString},
+			{Tag: tag.NoSecurityAltID, Name: "NoSecurityAltID", Type: FieldTypeNumInGroup, Fields: noSecurityAltIDFields},
+			{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+			{Tag: tag.CFICode, Name: "CFICode", Type: FieldTypeString},
+			{Tag: tag.RoundingDirection, Name: "RoundingDirection", Type: FieldTypeString},
+			{Tag: tag.RoundingModulus, Name: "RoundingModulus", Type: FieldTypeDecimal},
+			{Tag: tag.CountryOfIssue, Name: "CountryOfIssue", Type: FieldTypeString},
+			{Tag: tag.StateOrProvinceOfIssue, Name: "StateOrProvinceOfIssue", Type: FieldTypeString},
+			{Tag: tag.LocaleOfIssue, Name: "LocaleOfIssue", Type: FieldTypeString},
+			{Tag: tag.OrderPercent, Name: "OrderPercent", Type: FieldTypeDecimal},
+			{Tag: tag.MaturityDate, Name: "MaturityDate", Type: FieldTypeString},
+			{Tag: tag.InstrRegistry, Name: "InstrRegistry", Type: FieldTypeString},
+			{Tag: tag.NoLegs, Name: "NoLegs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.LegSymbol, Name: "LegSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.LegSymbolSfx, Name: "LegSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.LegSecurityID, Name: "LegSecurityID", Type: FieldTypeString},
+				{Tag: tag.LegSecurityIDSource, Name: "LegSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoLegSecurityAltID, Name: "NoLegSecurityAltID", Type: FieldTypeNumInGroup, Fields: noLegSecurityAltIDFields},
+				{Tag: tag.LegProduct, Name: "LegProduct", Type: FieldTypeInt},
+				{Tag: tag.LegCFICode, Name: "LegCFICode", Type: FieldTypeString},
+				{Tag: tag.LegSecurityType, Name: "LegSecurityType", Type: FieldTypeString},
+				{Tag: tag.LegSecuritySubType, Name: "LegSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.LegMaturityMonthYear, Name: "LegMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.LegMaturityDate, Name: "LegMaturityDate", Type: FieldTypeString},
+				{Tag: tag.LegCouponPaymentDate, Name: "LegCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.LegIssueDate, Name: "LegIssueDate", Type: FieldTypeString},
+				{Tag: tag.LegRepoCollateralSecurityType, Name: "LegRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseTerm, Name: "LegRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseRate, Name: "LegRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegFactor, Name: "LegFactor", Type: FieldTypeDecimal},
+				{Tag: tag.LegCreditRating, Name: "LegCreditRating", Type: FieldTypeString},
+				{Tag: tag.LegInstrRegistry, Name: "LegInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.LegCountryOfIssue, Name: "LegCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegStateOrProvinceOfIssue, Name: "LegStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegLocaleOfIssue, Name: "LegLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegRedemptionDate, Name: "LegRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.LegStrikePrice, Name: "LegStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.LegStrikeCurrency, Name: "LegStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.LegOptAttribute, Name: "LegOptAttribute", Type: FieldTypeString},
+				{Tag: tag.LegContractMultiplier, Name: "LegContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.LegCouponRate, Name: "LegCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegSecurityExchange, Name: "LegSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.LegIssuer, Name: "LegIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedLegIssuerLen, Name: "EncodedLegIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegIssuer, Name: "EncodedLegIssuer", Type: FieldTypeString},
+				{Tag: tag.LegSecurityDesc, Name: "LegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedLegSecurityDescLen, Name: "EncodedLegSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegSecurityDesc, Name: "EncodedLegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.LegRatioQty, Name: "LegRatioQty", Type: FieldTypeDecimal},
+				{Tag: tag.LegSide, Name: "LegSide", Type: FieldTypeString},
+				{Tag: tag.LegCurrency, Name: "LegCurrency", Type: FieldTypeString},
+				{Tag: tag.LegPool, Name: "LegPool", Type: FieldTypeString},
+				{Tag: tag.LegDatedDate, Name: "LegDatedDate", Type: FieldTypeString},
+				{Tag: tag.LegContractSettlMonth, Name: "LegContractSettlMonth", Type: FieldTypeString},
+				{Tag: tag.LegInterestAccrualDate, Name: "LegInterestAccrualDate", Type: FieldTypeString},
+				{Tag: tag.LegIOIQty, Name: "LegIOIQty", Type: FieldTypeString},
+				{Tag: tag.NoLegStipulations, Name: "NoLegStipulations", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+					{Tag: tag.LegStipulationType, Name: "LegStipulationType", Type: FieldTypeString, Required: true},
+					{Tag: tag.LegStipulationValue, Name: "LegStipulationValue", Type: FieldTypeString},
+				}},
+			}},
+			{Tag: tag.BenchmarkPrice, Name: "BenchmarkPrice", Type: FieldTypeDecimal},
+			{Tag: tag.BenchmarkPriceType, Name: "BenchmarkPriceType", Type: FieldTypeInt},
+			{Tag: tag.ContractSettlMonth, Name: "ContractSettlMonth", Type: FieldTypeString},
+			{Tag: tag.Pool, Name: "Pool", Type: FieldTypeString},
+			{Tag: tag.YieldRedemptionDate, Name: "YieldRedemptionDate", Type: FieldTypeString},
+			{Tag: tag.YieldRedemptionPrice, Name: "YieldRedemptionPrice", Type: FieldTypeDecimal},
+			{Tag: tag.YieldRedemptionPriceType, Name: "YieldRedemptionPriceType", Type: FieldTypeInt},
+			{Tag: tag.BenchmarkSecurityID, Name: "BenchmarkSecurityID", Type: FieldTypeString},
+			{Tag: tag.YieldCalcDate, Name: "YieldCalcDate", Type: FieldTypeString},
+			{Tag: tag.NoUnderlyings, Name: "NoUnderlyings", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.UnderlyingSymbol, Name: "UnderlyingSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.UnderlyingSymbolSfx, Name: "UnderlyingSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityID, Name: "UnderlyingSecurityID", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityIDSource, Name: "UnderlyingSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoUnderlyingSecurityAltID, Name: "NoUnderlyingSecurityAltID", Type: FieldTypeNumInGroup, Fields: noUnderlyingSecurityAltIDFields},
+				{Tag: tag.UnderlyingProduct, Name: "UnderlyingProduct", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingCFICode, Name: "UnderlyingCFICode", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityType, Name: "UnderlyingSecurityType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecuritySubType, Name: "UnderlyingSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityMonthYear, Name: "UnderlyingMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityDate, Name: "UnderlyingMaturityDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCouponPaymentDate, Name: "UnderlyingCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssueDate, Name: "UnderlyingIssueDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRepoCollateralSecurityType, Name: "UnderlyingRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseTerm, Name: "UnderlyingRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseRate, Name: "UnderlyingRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingFactor, Name: "UnderlyingFactor", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCreditRating, Name: "UnderlyingCreditRating", Type: FieldTypeString},
+				{Tag: tag.UnderlyingInstrRegistry, Name: "UnderlyingInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCountryOfIssue, Name: "UnderlyingCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStateOrProvinceOfIssue, Name: "UnderlyingStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingLocaleOfIssue, Name: "UnderlyingLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRedemptionDate, Name: "UnderlyingRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStrikePrice, Name: "UnderlyingStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStrikeCurrency, Name: "UnderlyingStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingOptAttribute, Name: "UnderlyingOptAttribute", Type: FieldTypeString},
+				{Tag: tag.UnderlyingContractMultiplier, Name: "UnderlyingContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCouponRate, Name: "UnderlyingCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingSecurityExchange, Name: "UnderlyingSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssuer, Name: "UnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingIssuerLen, Name: "EncodedUnderlyingIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingIssuer, Name: "EncodedUnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityDesc, Name: "UnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingSecurityDescLen, Name: "EncodedUnderlyingSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingSecurityDesc, Name: "EncodedUnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPProgram, Name: "UnderlyingCPProgram", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPRegType, Name: "UnderlyingCPRegType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCurrency, Name: "UnderlyingCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingQty, Name: "UnderlyingQty", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingPx, Name: "UnderlyingPx", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingDirtyPrice, Name: "UnderlyingDirtyPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndPrice, Name: "UnderlyingEndPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStartValue, Name: "UnderlyingStartValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCurrentValue, Name: "UnderlyingCurrentValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndValue, Name: "UnderlyingEndValue", Type: FieldTypeDecimal},
+				{Tag: tag.NoUnderlyingStips, Name: "NoUnderlyingStips", Type: FieldTypeNumInGroup, Fields: noUnderlyingStipsFields},
+			}},
+			{Tag: tag.BenchmarkSecurityIDSource, Name: "BenchmarkSecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.SecuritySubType, Name: "SecuritySubType", Type: FieldTypeString},
+			{Tag: tag.TerminationType, Name: "TerminationType", Type: FieldTypeString},
+			{Tag: tag.QtyType, Name: "QtyType", Type: FieldTypeString},
+			{Tag: tag.NoEvents, Name: "NoEvents", Type: FieldTypeNumInGroup, Fields: noEventsFields},
+			{Tag: tag.DatedDate, Name: "DatedDate", Type: FieldTypeString},
+			{Tag: tag.InterestAccrualDate, Name: "InterestAccrualDate", Type: FieldTypeString},
+			{Tag: tag.CPProgram, Name: "CPProgram", Type: FieldTypeString},
+			{Tag: tag.CPRegType, Name: "CPRegType", Type: FieldTypeString},
+			{Tag: tag.MarginRatio, Name: "MarginRatio", Type: FieldTypeDecimal},
+			{Tag: tag.AgreementDesc, Name: "AgreementDesc", Type: FieldTypeString},
+			{Tag: tag.AgreementID, Name: "AgreementID", Type: FieldTypeString},
+			{Tag: tag.AgreementDate, Name: "AgreementDate", Type: FieldTypeString},
+			{Tag: tag.StartDate, Name: "StartDate", Type: FieldTypeString},
+			{Tag: tag.EndDate, Name: "EndDate", Type: FieldTypeString},
+			{Tag: tag.AgreementCurrency, Name: "AgreementCurrency", Type: FieldTypeString},
+			{Tag: tag.DeliveryType, Name: "DeliveryType", Type: FieldTypeString},
+			{Tag: tag.StrikeCurrency, Name: "StrikeCurrency", Type: FieldTypeString},
+		},
+	},
+	"K": {
+		Name:    "ListCancelRequest",
+		MsgType: "K",
+		Fields: []FieldDef{
+			{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+			{Tag: tag.TransactTime, Name: "TransactTime", Type: FieldTypeUTCTimestamp, Required: true},
+			{Tag: tag.ListID, Name: "ListID", Type: FieldTypeString, Required: true},
+			{Tag: tag.TradeDate, Name: "TradeDate", Type: FieldTypeString},
+			{Tag: tag.TradeOriginationDate, Name: "TradeOriginationDate", Type: FieldTypeString},
+			{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+		},
+	},
+	"L": {
+		Name:    "ListExecute",
+		MsgType: "L",
+		Fields: []FieldDef{
+			{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+			{Tag: tag.TransactTime, Name: "TransactTime", Type: FieldTypeUTCTimestamp, Required: true},
+			{Tag: tag.ListID, Name: "ListID", Type: FieldTypeString, Required: true},
+			{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			{Tag: tag.BidID, Name: "BidID", Type: FieldTypeString},
+			{Tag: tag.ClientBidID, Name: "ClientBidID", Type: FieldTypeString},
+		},
+	},
+	"N": {
+		Name:    "ListStatus",
+		MsgType: "N",
+		Fields: []FieldDef{
+			{Tag: tag.TransactTime, Name: "TransactTime", Type: FieldTypeUTCTimestamp},
+			{Tag: tag.ListID, Name: "ListID", Type: FieldTypeString, Required: true},
+			{Tag: tag.TotNoOrders, Name: "TotNoOrders", Type: FieldTypeInt, Required: true},
+			{Tag: tag.NoOrders, Name: "NoOrders", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.ClOrdID, Name: "ClOrdID", Type: FieldTypeString, Required: true},
+				{Tag: tag.SecondaryClOrdID, Name: "SecondaryClOrdID", Type: FieldTypeString},
+				{Tag: tag.CumQty, Name: "CumQty", Type: FieldTypeDecimal},
+				{Tag: tag.OrdStatus, Name: "OrdStatus", Type: FieldTypeString},
+				{Tag: tag.WorkingIndicator, Name: "WorkingIndicator", Type: FieldTypeBool},
+				{Tag: tag.LeavesQty, Name: "LeavesQty", Type: FieldTypeDecimal},
+				{Tag: tag.CxlQty, Name: "CxlQty", Type: FieldTypeDecimal},
+				{Tag: tag.AvgPx, Name: "AvgPx", Type: FieldTypeDecimal},
+				{Tag: tag.OrdRejReason, Name: "OrdRejReason", Type: FieldTypeString},
+				{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+				{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			}},
+			{Tag: tag.NoRpts, Name: "NoRpts", Type: FieldTypeInt, Required: true},
+			{Tag: tag.RptSeq, Name: "RptSeq", Type: FieldTypeInt, Required: true},
+			{Tag: tag.ListStatusType, Name: "ListStatusType", Type: FieldTypeString, Required: true},
+			{Tag: tag.ListOrderStatus, Name: "ListOrderStatus", Type: FieldTypeString, Required: true},
+			{Tag: tag.ListStatusText, Name: "ListStatusText", Type: FieldTypeString},
+			{Tag: tag.EncodedListStatusTextLen, Name: "EncodedListStatusTextLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedListStatusText, Name: "EncodedListStatusText", Type: FieldTypeString},
+			{Tag: tag.LastFragment, Name: "LastFragment", Type: FieldTypeBool},
+		},
+	},
+	"M": {
+		Name:    "ListStatusRequest",
+		MsgType: "M",
+		Fields: []FieldDef{
+			{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+			{Tag: tag.ListID, Name: "ListID", Type: FieldTypeString, Required: true},
+			{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+		},
+	},
+	"m": {
+		Name:    "ListStrikePrice",
+		MsgType: "m",
+		Fields: []FieldDef{
+			{Tag: tag.ListID, Name: "ListID", Type: FieldTypeString, Required: true},
+			{Tag: tag.TotNoStrikes, Name: "TotNoStrikes", Type: FieldTypeInt, Required: true},
+			{Tag: tag.NoStrikes, Name: "NoStrikes", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.Symbol, Name: "Symbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.SymbolSfx, Name: "SymbolSfx", Type: FieldTypeString},
+				{Tag: tag.SecurityID, Name: "SecurityID", Type: FieldTypeString},
+				{Tag: tag.SecurityIDSource, Name: "SecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoSecurityAltID, Name: "NoSecurityAltID", Type: FieldTypeNumInGroup, Fields: noSecurityAltIDFields},
+				{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+				{Tag: tag.CFICode, Name: "CFICode", Type: FieldTypeString},
+				{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+				{Tag: tag.SecuritySubType, Name: "SecuritySubType", Type: FieldTypeString},
+				{Tag: tag.MaturityMonthYear, Name: "MaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.MaturityDate, Name: "MaturityDate", Type: FieldTypeString},
+				{Tag: tag.CouponPaymentDate, Name: "CouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.IssueDate, Name: "IssueDate", Type: FieldTypeString},
+				{Tag: tag.RepoCollateralSecurityType, Name: "RepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.RepurchaseTerm, Name: "RepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.RepurchaseRate, Name: "RepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.Factor, Name: "Factor", Type: FieldTypeDecimal},
+				{Tag: tag.CreditRating, Name: "CreditRating", Type: FieldTypeString},
+				{Tag: tag.InstrRegistry, Name: "InstrRegistry", Type: FieldTypeString},
+				{Tag: tag.CountryOfIssue, Name: "CountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.StateOrProvinceOfIssue, Name: "StateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.LocaleOfIssue, Name: "LocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.RedemptionDate, Name: "RedemptionDate", Type: FieldTypeString},
+				{Tag: tag.StrikePrice, Name: "StrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.StrikeCurrency, Name: "StrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.OptAttribute, Name: "OptAttribute", Type: FieldTypeString},
+				{Tag: tag.ContractMultiplier, Name: "ContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.CouponRate, Name: "CouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.SecurityExchange, Name: "SecurityExchange", Type: FieldTypeString},
+				{Tag: tag.Issuer, Name: "Issuer", Type: FieldTypeString},
+				{Tag: tag.EncodedIssuerLen, Name: "EncodedIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedIssuer, Name: "EncodedIssuer", Type: FieldTypeString},
+				{Tag: tag.SecurityDesc, Name: "SecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedSecurityDescLen, Name: "EncodedSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedSecurityDesc, Name: "EncodedSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.Pool, Name: "Pool", Type: FieldTypeString},
+				{Tag: tag.ContractSettlMonth, Name: "ContractSettlMonth", Type: FieldTypeString},
+				{Tag: tag.CPProgram, Name: "CPProgram", Type: FieldTypeString},
+				{Tag: tag.CPRegType, Name: "CPRegType", Type: FieldTypeString},
+				{Tag: tag.NoEvents, Name: "NoEvents", Type: FieldTypeNumInGroup, Fields: noEventsFields},
+				{Tag: tag.DatedDate, Name: "DatedDate", Type: FieldTypeString},
+				{Tag: tag.InterestAccrualDate, Name: "InterestAccrualDate", Type: FieldTypeString},
+			}},
+			{Tag: tag.NoUnderlyings, Name: "NoUnderlyings", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.UnderlyingSymbol, Name: "UnderlyingSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.UnderlyingSymbolSfx, Name: "UnderlyingSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityID, Name: "UnderlyingSecurityID", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityIDSource, Name: "UnderlyingSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoUnderlyingSecurityAltID, Name: "NoUnderlyingSecurityAltID", Type: FieldTypeNumInGroup, Fields: noUnderlyingSecurityAltIDFields},
+				{Tag: tag.UnderlyingProduct, Name: "UnderlyingProduct", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingCFICode, Name: "UnderlyingCFICode", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityType, Name: "UnderlyingSecurityType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecuritySubType, Name: "UnderlyingSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityMonthYear, Name: "UnderlyingMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityDate, Name: "UnderlyingMaturityDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCouponPaymentDate, Name: "UnderlyingCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssueDate, Name: "UnderlyingIssueDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRepoCollateralSecurityType, Name: "UnderlyingRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseTerm, Name: "UnderlyingRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseRate, Name: "UnderlyingRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingFactor, Name: "UnderlyingFactor", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCreditRating, Name: "UnderlyingCreditRating", Type: FieldTypeString},
+				{Tag: tag.UnderlyingInstrRegistry, Name: "UnderlyingInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCountryOfIssue, Name: "UnderlyingCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStateOrProvinceOfIssue, Name: "UnderlyingStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingLocaleOfIssue, Name: "UnderlyingLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRedemptionDate, Name: "UnderlyingRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStrikePrice, Name: "UnderlyingStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStrikeCurrency, Name: "UnderlyingStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingOptAttribute, Name: "UnderlyingOptAttribute", Type: FieldTypeString},
+				{Tag: tag.UnderlyingContractMultiplier, Name: "UnderlyingContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCouponRate, Name: "UnderlyingCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingSecurityExchange, Name: "UnderlyingSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssuer, Name: "UnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingIssuerLen, Name: "EncodedUnderlyingIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingIssuer, Name: "EncodedUnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityDesc, Name: "UnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingSecurityDescLen, Name: "EncodedUnderlyingSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingSecurityDesc, Name: "EncodedUnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPProgram, Name: "UnderlyingCPProgram", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPRegType, Name: "UnderlyingCPRegType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCurrency, Name: "UnderlyingCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingQty, Name: "UnderlyingQty", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingPx, Name: "UnderlyingPx", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingDirtyPrice, Name: "UnderlyingDirtyPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndPrice, Name: "UnderlyingEndPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStartValue, Name: "UnderlyingStartValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCurrentValue, Name: "UnderlyingCurrentValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndValue, Name: "UnderlyingEndValue", Type: FieldTypeDecimal},
+				{Tag: tag.NoUnderlyingStips, Name: "NoUnderlyingStips", Type: FieldTypeNumInGroup, Fields: noUnderlyingStipsFields},
+				{Tag: tag.PrevClosePx, Name: "PrevClosePx", Type: FieldTypeDecimal},
+				{Tag: tag.ClOrdID, Name: "ClOrdID", Type: FieldTypeString},
+				{Tag: tag.SecondaryClOrdID, Name: "SecondaryClOrdID", Type: FieldTypeString},
+				{Tag: tag.Side, Name: "Side", Type: FieldTypeString},
+				{Tag: tag.Price, Name: "Price", Type: FieldTypeDecimal},
+				{Tag: tag.Currency, Name: "Currency", Type: FieldTypeString},
+				{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+				{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			}},
+			{Tag: tag.LastFragment, Name: "LastFragment", Type: FieldTypeBool},
+		},
+	},
+	"A": {
+		Name:    "Logon",
+		MsgType: "A",
+		Fields: []FieldDef{
+			{Tag: tag.RawDataLength, Name: "RawDataLength", Type: FieldTypeInt},
+			{Tag: tag.RawData, Name: "RawData", Type: FieldTypeString},
+			{Tag: tag.EncryptMethod, Name: "EncryptMethod", Type: FieldTypeString, Required: true},
+			{Tag: tag.HeartBtInt, Name: "HeartBtInt", Type: FieldTypeInt, Required: true},
+			{Tag: tag.ResetSeqNumFlag, Name: "ResetSeqNumFlag", Type: FieldTypeBool},
+			{Tag: tag.MaxMessageSize, Name: "MaxMessageSize", Type: FieldTypeInt},
+			{Tag: tag.NoMsgTypes, Name: "NoMsgTypes", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.RefMsgType, Name: "RefMsgType", Type: FieldTypeString, Required: true},
+				{Tag: tag.MsgDirection, Name: "MsgDirection", Type: FieldTypeString},
+			}},
+			{Tag: tag.TestMessageIndicator, Name: "TestMessageIndicator", Type: FieldTypeBool},
+			{Tag: tag.Username, Name: "Username", Type: FieldTypeString},
+			{Tag: tag.Password, Name: "Password", Type: FieldTypeString},
+			{Tag: tag.NextExpectedMsgSeqNum, Name: "NextExpectedMsgSeqNum", Type: FieldTypeInt},
+		},
+	},
+	"5": {
+		Name:    "Logout",
+		MsgType: "5",
+		Fields: []FieldDef{
+			{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+			{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+		},
+	},
+	"X": {
+		Name:    "MarketDataIncrementalRefresh",
+		MsgType: "X",
+		Fields: []FieldDef{
+			{Tag: tag.MDReqID, Name: "MDReqID", Type: FieldTypeString},
+			{Tag: tag.NoMDEntries, Name: "NoMDEntries", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.MDUpdateAction, Name: "MDUpdateAction", Type: FieldTypeString, Required: true},
+				{Tag: tag.DeleteReason, Name: "DeleteReason", Type: FieldTypeString},
+				{Tag: tag.MDEntryType, Name: "MDEntryType", Type: FieldTypeString},
+				{Tag: tag.MDEntryID, Name: "MDEntryID", Type: FieldTypeString},
+				{Tag: tag.MDEntryRefID, Name: "MDEntryRefID", Type: FieldTypeString},
+				{Tag: tag.Symbol, Name: "Symbol", Type: FieldTypeString},
+				{Tag: tag.SymbolSfx, Name: "SymbolSfx", Type: FieldTypeString},
+				{Tag: tag.SecurityID, Name: "SecurityID", Type: FieldTypeString},
+				{Tag: tag.SecurityIDSource, Name: "SecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoSecurityAltID, Name: "NoSecurityAltID", Type: FieldTypeNumInGroup, Fields: noSecurityAltIDFields},
+				{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+				{Tag: tag.CFICode, Name: "CFICode", Type: FieldTypeString},
+				{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+				{Tag: tag.SecuritySubType, Name: "SecuritySubType", Type: FieldTypeString},
+				{Tag: tag.MaturityMonthYear, Name: "MaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.MaturityDate, Name: "MaturityDate", Type: FieldTypeString},
+				{Tag: tag.CouponPaymentDate, Name: "CouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.IssueDate, Name: "IssueDate", Type: FieldTypeString},
+				{Tag: tag.RepoCollateralSecurityType, Name: "RepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.RepurchaseTerm, Name: "RepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.RepurchaseRate, Name: "RepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.Factor, Name: "Factor", Type: FieldTypeDecimal},
+				{Tag: tag.CreditRating, Name: "CreditRating", Type: FieldTypeString},
+				{Tag: tag.InstrRegistry, Name: "InstrRegistry", Type: FieldTypeString},
+				{Tag: tag.CountryOfIssue, Name: "CountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.StateOrProvinceOfIssue, Name: "StateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.LocaleOfIssue, Name: "LocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.RedemptionDate, Name: "RedemptionDate", Type: FieldTypeString},
+				{Tag: tag.StrikePrice, Name: "StrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.StrikeCurrency, Name: "StrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.OptAttribute, Name: "OptAttribute", Type: FieldTypeString},
+				{Tag: tag.ContractMultiplier, Name: "ContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.CouponRate, Name: "CouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.SecurityExchange, Name: "SecurityExchange", Type: FieldTypeString},
+				{Tag: tag.Issuer, Name: "Issuer", Type: FieldTypeString},
+				{Tag: tag.EncodedIssuerLen, Name: "EncodedIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedIssuer, Name: "EncodedIssuer", Type: FieldTypeString},
+				{Tag: tag.SecurityDesc, Name: "SecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedSecurityDescLen, Name: "EncodedSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedSecurityDesc, Name: "EncodedSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.Pool, Name: "Pool", Type: FieldTypeString},
+				{Tag: tag.ContractSettlMonth, Name: "ContractSettlMonth", Type: FieldTypeString},
+				{Tag: tag.CPProgram, Name: "CPProgram", Type: FieldTypeString},
+				{Tag: tag.CPRegType, Name: "CPRegType", Type: FieldTypeString},
+				{Tag: tag.NoEvents, Name: "NoEvents", Type: FieldTypeNumInGroup, Fields: noEventsFields},
+				{Tag: tag.DatedDate, Name: "DatedDate", Type: FieldTypeString},
+				{Tag: tag.InterestAccrualDate, Name: "InterestAccrualDate", Type: FieldTypeString},
+				{Tag: tag.NoUnderlyings, Name: "NoUnderlyings", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+					{Tag: tag.UnderlyingSymbol, Name: "UnderlyingSymbol", Type: FieldTypeString, Required: true},
+					{Tag: tag.UnderlyingSymbolSfx, Name: "UnderlyingSymbolSfx", Type: FieldTypeString},
+					{Tag: tag.UnderlyingSecurityID, Name: "UnderlyingSecurityID", Type: FieldTypeString},
+					{Tag: tag.UnderlyingSecurityIDSource, Name: "UnderlyingSecurityIDSource", Type: FieldTypeString},
+					{Tag: tag.NoUnderlyingSecurityAltID, Name: "NoUnderlyingSecurityAltID", Type: FieldTypeNumInGroup, Fields: noUnderlyingSecurityAltIDFields},
+					{Tag: tag.UnderlyingProduct, Name: "UnderlyingProduct", Type: FieldTypeInt},
+					{Tag: tag.UnderlyingCFICode, Name: "UnderlyingCFICode", Type: FieldTypeString},
+					{Tag: tag.UnderlyingSecurityType, Name: "UnderlyingSecurityType", Type: FieldTypeString},
+					{Tag: tag.UnderlyingSecuritySubType, Name: "UnderlyingSecuritySubType", Type: FieldTypeString},
+					{Tag: tag.UnderlyingMaturityMonthYear, Name: "UnderlyingMaturityMonthYear", Type: FieldTypeString},
+					{Tag: tag.UnderlyingMaturityDate, Name: "UnderlyingMaturityDate", Type: FieldTypeString},
+					{Tag: tag.UnderlyingCouponPaymentDate, Name: "UnderlyingCouponPaymentDate", Type: FieldTypeString},
+					{Tag: tag.UnderlyingIssueDate, Name: "UnderlyingIssueDate", Type: FieldTypeString},
+					{Tag: tag.UnderlyingRepoCollateralSecurityType, Name: "UnderlyingRepoCollateralSecurityType", Type: FieldTypeInt},
+					{Tag: tag.UnderlyingRepurchaseTerm, Name: "UnderlyingRepurchaseTerm", Type: FieldTypeInt},
+					{Tag: tag.UnderlyingRepurchaseRate, Name: "UnderlyingRepurchaseRate", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingFactor, Name: "UnderlyingFactor", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingCreditRating, Name: "UnderlyingCreditRating", Type: FieldTypeString},
+					{Tag: tag.UnderlyingInstrRegistry, Name: "UnderlyingInstrRegistry", Type: FieldTypeString},
+					{Tag: tag.UnderlyingCountryOfIssue, Name: "UnderlyingCountryOfIssue", Type: FieldTypeString},
+					{Tag: tag.UnderlyingStateOrProvinceOfIssue, Name: "UnderlyingStateOrProvinceOfIssue", Type: FieldTypeString},
+					{Tag: tag.UnderlyingLocaleOfIssue, Name: "UnderlyingLocaleOfIssue", Type: FieldTypeString},
+					{Tag: tag.UnderlyingRedemptionDate, Name: "UnderlyingRedemptionDate", Type: FieldTypeString},
+					{Tag: tag.UnderlyingStrikePrice, Name: "UnderlyingStrikePrice", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingStrikeCurrency, Name: "UnderlyingStrikeCurrency", Type: FieldTypeString},
+					{Tag: tag.UnderlyingOptAttribute, Name: "UnderlyingOptAttribute", Type: FieldTypeString},
+					{Tag: tag.UnderlyingContractMultiplier, Name: "UnderlyingContractMultiplier", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingCouponRate, Name: "UnderlyingCouponRate", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingSecurityExchange, Name: "UnderlyingSecurityExchange", Type: FieldTypeString},
+					{Tag: tag.UnderlyingIssuer, Name: "UnderlyingIssuer", Type: FieldTypeString},
+					{Tag: tag.EncodedUnderlyingIssuerLen, Name: "EncodedUnderlyingIssuerLen", Type: FieldTypeInt},
+					{Tag: tag.EncodedUnderlyingIssuer, Name: "EncodedUnderlyingIssuer", Type: FieldTypeString},
+					{Tag: tag.UnderlyingSecurityDesc, Name: "UnderlyingSecurityDesc", Type: FieldTypeString},
+					{Tag: tag.EncodedUnderlyingSecurityDescLen, Name: "EncodedUnderlyingSecurityDescLen", Type: FieldTypeInt},
+					{Tag: tag.EncodedUnderlyingSecurityDesc, Name: "EncodedUnderlyingSecurityDesc", Type: FieldTypeString},
+					{Tag: tag.UnderlyingCPProgram, Name: "UnderlyingCPProgram", Type: FieldTypeString},
+					{Tag: tag.UnderlyingCPRegType, Name: "UnderlyingCPRegType", Type: FieldTypeString},
+					{Tag: tag.UnderlyingCurrency, Name: "UnderlyingCurrency", Type: FieldTypeString},
+					{Tag: tag.UnderlyingQty, Name: "UnderlyingQty", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingPx, Name: "UnderlyingPx", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingDirtyPrice, Name: "UnderlyingDirtyPrice", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingEndPrice, Name: "UnderlyingEndPrice", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingStartValue, Name: "UnderlyingStartValue", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingCurrentValue, Name: "UnderlyingCurrentValue", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingEndValue, Name: "UnderlyingEndValue", Type: FieldTypeDecimal},
+					{Tag: tag.NoUnderlyingStips, Name: "NoUnderlyingStips", Type: FieldTypeNumInGroup, Fields: noUnderlyingStipsFields},
+				}},
+				{Tag: tag.NoLegs, Name: "NoLegs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+					{Tag: tag.LegSymbol, Name: "LegSymbol", Type: FieldTypeString, Required: true},
+					{Tag: tag.LegSymbolSfx, Name: "LegSymbolSfx", Type: FieldTypeString},
+					{Tag: tag.LegSecurityID, Name: "LegSecurityID", Type: FieldTypeString},
+					{Tag: tag.LegSecurityIDSource, Name: "LegSecurityIDSource", Type: FieldTypeString},
+					{Tag: tag.NoLegSecurityAltID, Name: "NoLegSecurityAltID", Type: FieldTypeNumInGroup, Fields: noLegSecurityAltIDFields},
+					{Tag: tag.LegProduct, Name: "LegProduct", Type: FieldTypeInt},
+					{Tag: tag.LegCFICode, Name: "LegCFICode", Type: FieldTypeString},
+					{Tag: tag.LegSecurityType, Name: "LegSecurityType", Type: FieldTypeString},
+					{Tag: tag.LegSecuritySubType, Name: "LegSecuritySubType", Type: FieldTypeString},
+					{Tag: tag.LegMaturityMonthYear, Name: "LegMaturityMonthYear", Type: FieldTypeString},
+					{Tag: tag.LegMaturityDate, Name: "LegMaturityDate", Type: FieldTypeString},
+					{Tag: tag.LegCouponPaymentDate, Name: "LegCouponPaymentDate", Type: FieldTypeString},
+					{Tag: tag.LegIssueDate, Name: "LegIssueDate", Type: FieldTypeString},
+					{Tag: tag.LegRepoCollateralSecurityType, Name: "LegRepoCollateralSecurityType", Type: FieldTypeInt},
+					{Tag: tag.LegRepurchaseTerm, Name: "LegRepurchaseTerm", Type: FieldTypeInt},
+					{Tag: tag.LegRepurchaseRate, Name: "LegRepurchaseRate", Type: FieldTypeDecimal},
+					{Tag: tag.LegFactor, Name: "LegFactor", Type: FieldTypeDecimal},
+					{Tag: tag.LegCreditRating, Name: "LegCreditRating", Type: FieldTypeString},
+					{Tag: tag.LegInstrRegistry, Name: "LegInstrRegistry", Type: FieldTypeString},
+					{Tag: tag.LegCountryOfIssue, Name: "LegCountryOfIssue", Type: FieldTypeString},
+					{Tag: tag.LegStateOrProvinceOfIssue, Name: "LegStateOrProvinceOfIssue", Type: FieldTypeString},
+					{Tag: tag.LegLocaleOfIssue, Name: "LegLocaleOfIssue", Type: FieldTypeString},
+					{Tag: tag.LegRedemptionDate, Name: "LegRedemptionDate", Type: FieldTypeString},
+					{Tag: tag.LegStrikePrice, Name: "LegStrikePrice", Type: FieldTypeDecimal},
+					{Tag: tag.LegStrikeCurrency, Name: "LegStrikeCurrency", Type: FieldTypeString},
+					{Tag: tag.LegOptAttribute, Name: "LegOptAttribute", Type: FieldTypeString},
+					{Tag: tag.LegContractMultiplier, Name: "LegContractMultiplier", Type: FieldTypeDecimal},
+					{Tag: tag.LegCouponRate, Name: "LegCouponRate", Type: FieldTypeDecimal},
+					{Tag: tag.LegSecurityExchange, Name: "LegSecurityExchange", Type: FieldTypeString},
+					{Tag: tag.LegIssuer, Name: "LegIssuer", Type: FieldTypeString},
+					{Tag: tag.EncodedLegIssuerLen, Name: "EncodedLegIssuerLen", Type: FieldTypeInt},
+					{Tag: tag.EncodedLegIssuer, Name: "EncodedLegIssuer", Type: FieldTypeString},
+					{Tag: tag.LegSecurityDesc, Name: "LegSecurityDesc", Type: FieldTypeString},
+					{Tag: tag.EncodedLegSecurityDescLen, Name: "EncodedLegSecurityDescLen", Type: FieldTypeInt},
+					{Tag: tag.EncodedLegSecurityDesc, Name: "EncodedLegSecurityDesc", Type: FieldTypeString},
+					{Tag: tag.LegRatioQty, Name: "LegRatioQty", Type: FieldTypeDecimal},
+					{Tag: tag.LegSide, Name: "LegSide", Type: FieldTypeString},
+					{Tag: tag.LegCurrency, Name: "LegCurrency", Type: FieldTypeString},
+					{Tag: tag.LegPool, Name: "LegPool", Type: FieldTypeString},
+					{Tag: tag.LegDatedDate, Name: "LegDatedDate", Type: FieldTypeString},
+					{Tag: tag.LegContractSettlMonth, Name: "LegContractSettlMonth", Type: FieldTypeString},
+					{Tag: tag.LegInterestAccrualDate, Name: "LegInterestAccrualDate", Type: FieldTypeString},
+				}},
+				{Tag: tag.FinancialStatus, Name: "FinancialStatus", Type: FieldTypeString},
+				{Tag: tag.CorporateAction, Name: "CorporateAction", Type: FieldTypeString},
+				{Tag: tag.MDEntryPx, Name: "MDEntryPx", Type: FieldTypeDecimal},
+				{Tag: tag.Currency, Name: "Currency", Type: FieldTypeString},
+				{Tag: tag.MDEntrySize, Name: "MDEntrySize", Type: FieldTypeDecimal},
+				{Tag: tag.MDEntryDate, Name: "MDEntryDate", Type: FieldTypeString},
+				{Tag: tag.MDEntryTime, Name: "MDEntryTime", Type: FieldTypeString},
+				{Tag: tag.TickDirection, Name: "TickDirection", Type: FieldTypeString},
+				{Tag: tag.MDMkt, Name: "MDMkt", Type: FieldTypeString},
+				{Tag: tag.TradingSessionID, Name: "TradingSessionID", Type: FieldTypeString},
+				{Tag: tag.TradingSessionSubID, Name: "TradingSessionSubID", Type: FieldTypeString},
+				{Tag: tag.QuoteCondition, Name: "QuoteCondition", Type: FieldTypeString},
+				{Tag: tag.TradeCondition, Name: "TradeCondition", Type: FieldTypeString},
+				{Tag: tag.MDEntryOriginator, Name: "MDEntryOriginator", Type: FieldTypeString},
+				{Tag: tag.LocationID, Name: "LocationID", Type: FieldTypeString},
+				{Tag: tag.DeskID, Name: "DeskID", Type: FieldTypeString},
+				{Tag: tag.OpenCloseSettlFlag, Name: "OpenCloseSettlFlag", Type: FieldTypeString},
+				{Tag: tag.TimeInForce, Name: "TimeInForce", Type: FieldTypeString},
+				{Tag: tag.ExpireDate, Name: "ExpireDate", Type: FieldTypeString},
+				{Tag: tag.ExpireTime, Name: "ExpireTime", Type: FieldTypeUTCTimestamp},
+				{Tag: tag.MinQty, Name: "MinQty", Type: FieldTypeDecimal},
+				{Tag: tag.ExecInst, Name: "ExecInst", Type: FieldTypeString},
+				{Tag: tag.SellerDays, Name: "SellerDays", Type: FieldTypeInt},
+				{Tag: tag.OrderID, Name: "OrderID", Type: FieldTypeString},
+				{Tag: tag.QuoteEntryID, Name: "QuoteEntryID", Type: FieldTypeString},
+				{Tag: tag.MDEntryBuyer, Name: "MDEntryBuyer", Type: FieldTypeString},
+				{Tag: tag.MDEntrySeller, Name: "MDEntrySeller", Type: FieldTypeString},
+				{Tag: tag.NumberOfOrders, Name: "NumberOfOrders", Type: FieldTypeInt},
+				{Tag: tag.MDEntryPositionNo, Name: "MDEntryPositionNo", Type: FieldTypeInt},
+				{Tag: tag.Scope, Name: "Scope", Type: FieldTypeString},
+				{Tag: tag.PriceDelta, Name: "PriceDelta", Type: FieldTypeDecimal},
+				{Tag: tag.NetChgPrevDay, Name: "NetChgPrevDay", Type: FieldTypeDecimal},
+				{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+				{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+				{Tag: tag.RptSeq, Name: "RptSeq", Type: FieldTypeInt},
+			}},
+			{Tag: tag.ApplQueueDepth, Name: "ApplQueueDepth", Type: FieldTypeInt},
+			{Tag: tag.ApplQueueResolution, Name: "ApplQueueResolution", Type: FieldTypeString},
+		},
+	},
+	"V": {
+		Name:    "MarketDataRequest",
+		MsgType: "V",
+		Fields: []FieldDef{
+			{Tag: tag.NoRelatedSym, Name: "NoRelatedSym", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.Symbol, Name: "Symbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.SymbolSfx, Name: "SymbolSfx", Type: FieldTypeString},
+				{Tag: tag.SecurityID, Name: "SecurityID", Type: FieldTypeString},
+				{Tag: tag.SecurityIDSource, Name: "SecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoSecurityAltID, Name: "NoSecurityAltID", Type: FieldTypeNumInGroup, Fields: noSecurityAltIDFields},
+				{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+				{Tag: tag.CFICode, Name: "CFICode", Type: FieldTypeString},
+				{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+				{Tag: tag.SecuritySubType, Name: "SecuritySubType", Type: FieldTypeString},
+				{Tag: tag.MaturityMonthYear, Name: "MaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.MaturityDate, Name: "MaturityDate", Type: FieldTypeString},
+				{Tag: tag.CouponPaymentDate, Name: "CouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.IssueDate, Name: "IssueDate", Type: FieldTypeString},
+				{Tag: tag.RepoCollateralSecurityType, Name: "RepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.RepurchaseTerm, Name: "RepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.RepurchaseRate, Name: "RepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.Factor, Name: "Factor", Type: FieldTypeDecimal},
+				{Tag: tag.CreditRating, Name: "CreditRating", Type: FieldTypeString},
+				{Tag: tag.InstrRegistry, Name: "InstrRegistry", Type: FieldTypeString},
+				{Tag: tag.CountryOfIssue, Name: "CountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.StateOrProvinceOfIssue, Name: "StateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.LocaleOfIssue, Name: "LocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.RedemptionDate, Name: "RedemptionDate", Type: FieldTypeString},
+				{Tag: tag.StrikePrice, Name: "StrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.StrikeCurrency, Name: "StrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.OptAttribute, Name: "OptAttribute", Type: FieldTypeString},
+				{Tag: tag.ContractMultiplier, Name: "ContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.CouponRate, Name: "CouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.SecurityExchange, Name: "SecurityExchange", Type: FieldTypeString},
+				{Tag: tag.Issuer, Name: "Issuer", Type: FieldTypeString},
+				{Tag: tag.EncodedIssuerLen, Name: "EncodedIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedIssuer, Name: "EncodedIssuer", Type: FieldTypeString},
+				{Tag: tag.SecurityDesc, Name: "SecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedSecurityDescLen, Name: "EncodedSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedSecurityDesc, Name: "EncodedSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.Pool, Name: "Pool", Type: FieldTypeString},
+				{Tag: tag.ContractSettlMonth, Name: "ContractSettlMonth", Type: FieldTypeString},
+				{Tag: tag.CPProgram, Name: "CPProgram", Type: FieldTypeString},
+				{Tag: tag.CPRegType, Name: "CPRegType", Type: FieldTypeString},
+				{Tag: tag.NoEvents, Name: "NoEvents", Type: FieldTypeNumInGroup, Fields: noEventsFields},
+				{Tag: tag.DatedDate, Name: "DatedDate", Type: FieldTypeString},
+				{Tag: tag.InterestAccrualDate, Name: "InterestAccrualDate", Type: FieldTypeString},
+				{Tag: tag.NoUnderlyings, Name: "NoUnderlyings", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+					{Tag: tag.UnderlyingSymbol, Name: "UnderlyingSymbol", Type: FieldTypeString, Required: true},
+					{Tag: tag.UnderlyingSymbolSfx, Name: "UnderlyingSymbolSfx", Type: FieldTypeString},
+					{Tag: tag.UnderlyingSecurityID, Name: "UnderlyingSecurityID", Type: FieldTypeString},
+					{Tag: tag.UnderlyingSecurityIDSource, Name: "UnderlyingSecurityIDSource", Type: FieldTypeString},
+					{Tag: tag.NoUnderlyingSecurityAltID, Name: "NoUnderlyingSecurityAltID", Type: FieldTypeNumInGroup, Fields: noUnderlyingSecurityAltIDFields},
+					{Tag: tag.UnderlyingProduct, Name: "UnderlyingProduct", Type: FieldTypeInt},
+					{Tag: tag.UnderlyingCFICode, Name: "UnderlyingCFICode", Type: FieldTypeString},
+					{Tag: tag.UnderlyingSecurityType, Name: "UnderlyingSecurityType", Type: FieldTypeString},
+					{Tag: tag.UnderlyingSecuritySubType, Name: "UnderlyingSecuritySubType", Type: FieldTypeString},
+					{Tag: tag.UnderlyingMaturityMonthYear, Name: "UnderlyingMaturityMonthYear", Type: FieldTypeString},
+					{Tag: tag.UnderlyingMaturityDate, Name: "UnderlyingMaturityDate", Type: FieldTypeString},
+					{Tag: tag.UnderlyingCouponPaymentDate, Name: "UnderlyingCouponPaymentDate", Type: FieldTypeString},
+					{Tag: tag.UnderlyingIssueDate, Name: "UnderlyingIssueDate", Type: FieldTypeString},
+					{Tag: tag.UnderlyingRepoCollateralSecurityType, Name: "UnderlyingRepoCollateralSecurityType", Type: FieldTypeInt},
+					{Tag: tag.UnderlyingRepurchaseTerm, Name: "UnderlyingRepurchaseTerm", Type: FieldTypeInt},
+					{Tag: tag.UnderlyingRepurchaseRate, Name: "UnderlyingRepurchaseRate", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingFactor, Name: "UnderlyingFactor", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingCreditRating, Name: "UnderlyingCreditRating", Type: FieldTypeString},
+					{Tag: tag.UnderlyingInstrRegistry, Name: "UnderlyingInstrRegistry", Type: FieldTypeString},
+					{Tag: tag.UnderlyingCountryOfIssue, Name: "UnderlyingCountryOfIssue", Type: FieldTypeString},
+					{Tag: tag.UnderlyingStateOrProvinceOfIssue, Name: "UnderlyingStateOrProvinceOfIssue", Type: FieldTypeString},
+					{Tag: tag.UnderlyingLocaleOfIssue, Name: "UnderlyingLocaleOfIssue", Type: FieldTypeString},
+					{Tag: tag.UnderlyingRedemptionDate, Name: "UnderlyingRedemptionDate", Type: FieldTypeString},
+					{Tag: tag.UnderlyingStrikePrice, Name: "UnderlyingStrikePrice", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingStrikeCurrency, Name: "UnderlyingStrikeCurrency", Type: FieldTypeString},
+					{Tag: tag.UnderlyingOptAttribute, Name: "UnderlyingOptAttribute", Type: FieldTypeString},
+					{Tag: tag.UnderlyingContractMultiplier, Name: "UnderlyingContractMultiplier", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingCouponRate, Name: "UnderlyingCouponRate", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingSecurityExchange, Name: "UnderlyingSecurityExchange", Type: FieldTypeString},
+					{Tag: tag.UnderlyingIssuer, Name: "UnderlyingIssuer", Type: FieldTypeString},
+					{Tag: tag.EncodedUnderlyingIssuerLen, Name: "EncodedUnderlyingIssuerLen", Type: FieldTypeInt},
+					{Tag: tag.EncodedUnderlyingIssuer, Name: "EncodedUnderlyingIssuer", Type: FieldTypeString},
+					{Tag: tag.UnderlyingSecurityDesc, Name: "UnderlyingSecurityDesc", Type: FieldTypeString},
+					{Tag: tag.EncodedUnderlyingSecurityDescLen, Name: "EncodedUnderlyingSecurityDescLen", Type: FieldTypeInt},
+					{Tag: tag.EncodedUnderlyingSecurityDesc, Name: "EncodedUnderlyingSecurityDesc", Type: FieldTypeString},
+					{Tag: tag.UnderlyingCPProgram, Name: "UnderlyingCPProgram", Type: FieldTypeString},
+					{Tag: tag.UnderlyingCPRegType, Name: "UnderlyingCPRegType", Type: FieldTypeString},
+					{Tag: tag.UnderlyingCurrency, Name: "UnderlyingCurrency", Type: FieldTypeString},
+					{Tag: tag.UnderlyingQty, Name: "UnderlyingQty", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingPx, Name: "UnderlyingPx", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingDirtyPrice, Name: "UnderlyingDirtyPrice", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingEndPrice, Name: "UnderlyingEndPrice", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingStartValue, Name: "UnderlyingStartValue", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingCurrentValue, Name: "UnderlyingCurrentValue", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingEndValue, Name: "UnderlyingEndValue", Type: FieldTypeDecimal},
+					{Tag: tag.NoUnderlyingStips, Name: "NoUnderlyingStips", Type: FieldTypeNumInGroup, Fields: noUnderlyingStipsFields},
+				}},
+				{Tag: tag.NoLegs, Name: "NoLegs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+					{Tag: tag.LegSymbol, Name: "LegSymbol", Type: FieldTypeString, Required: true},
+					{Tag: tag.LegSymbolSfx, Name: "LegSymbolSfx", Type: FieldTypeString},
+					{Tag: tag.LegSecurityID, Name: "LegSecurityID", Type: FieldTypeString},
+					{Tag: tag.LegSecurityIDSource, Name: "LegSecurityIDSource", Type: FieldTypeString},
+					{Tag: tag.NoLegSecurityAltID, Name: "NoLegSecurityAltID", Type: FieldTypeNumInGroup, Fields: noLegSecurityAltIDFields},
+					{Tag: tag.LegProduct, Name: "LegProduct", Type: FieldTypeInt},
+					{Tag: tag.LegCFICode, Name: "LegCFICode", Type: FieldTypeString},
+					{Tag: tag.LegSecurityType, Name: "LegSecurityType", Type: FieldTypeString},
+					{Tag: tag.LegSecuritySubType, Name: "LegSecuritySubType", Type: FieldTypeString},
+					{Tag: tag.LegMaturityMonthYear, Name: "LegMaturityMonthYear", Type: FieldTypeString},
+					{Tag: tag.LegMaturityDate, Name: "LegMaturityDate", Type: FieldTypeString},
+					{Tag: tag.LegCouponPaymentDate, Name: "LegCouponPaymentDate", Type: FieldTypeString},
+					{Tag: tag.LegIssueDate, Name: "LegIssueDate", Type: FieldTypeString},
+					{Tag: tag.LegRepoCollateralSecurityType, Name: "LegRepoCollateralSecurityType", Type: FieldTypeInt},
+					{Tag: tag.LegRepurchaseTerm, Name: "LegRepurchaseTerm", Type: FieldTypeInt},
+					{Tag: tag.LegRepurchaseRate, Name: "LegRepurchaseRate", Type: FieldTypeDecimal},
+					{Tag: tag.LegFactor, Name: "LegFactor", Type: FieldTypeDecimal},
+					{Tag: tag.LegCreditRating, Name: "LegCreditRating", Type: FieldTypeString},
+					{Tag: tag.LegInstrRegistry, Name: "LegInstrRegistry", Type: FieldTypeString},
+					{Tag: tag.LegCountryOfIssue, Name: "LegCountryOfIssue", Type: FieldTypeString},
+					{Tag: tag.LegStateOrProvinceOfIssue, Name: "LegStateOrProvinceOfIssue", Type: FieldTypeString},
+					{Tag: tag.LegLocaleOfIssue, Name: "LegLocaleOfIssue", Type: FieldTypeString},
+					{Tag: tag.LegRedemptionDate, Name: "LegRedemptionDate", Type: FieldTypeString},
+					{Tag: tag.LegStrikePrice, Name: "LegStrikePrice", Type: FieldTypeDecimal},
+					{Tag: tag.LegStrikeCurrency, Name: "LegStrikeCurrency", Type: FieldTypeString},
+					{Tag: tag.LegOptAttribute, Name: "LegOptAttribute", Type: FieldTypeString},
+					{Tag: tag.LegContractMultiplier, Name: "LegContractMultiplier", Type: FieldTypeDecimal},
+					{Tag: tag.LegCouponRate, Name: "LegCouponRate", Type: FieldTypeDecimal},
+					{Tag: tag.LegSecurityExchange, Name: "LegSecurityExchange", Type: FieldTypeString},
+					{Tag: tag.LegIssuer, Name: "LegIssuer", Type: FieldTypeString},
+					{Tag: tag.EncodedLegIssuerLen, Name: "EncodedLegIssuerLen", Type: FieldTypeInt},
+					{Tag: tag.EncodedLegIssuer, Name: "EncodedLegIssuer", Type: FieldTypeString},
+					{Tag: tag.LegSecurityDesc, Name: "LegSecurityDesc", Type: FieldTypeString},
+					{Tag: tag.EncodedLegSecurityDescLen, Name: "EncodedLegSecurityDescLen", Type: FieldTypeInt},
+					{Tag: tag.EncodedLegSecurityDesc, Name: "EncodedLegSecurityDesc", Type: FieldTypeString},
+					{Tag: tag.LegRatioQty, Name: "LegRatioQty", Type: FieldTypeDecimal},
+					{Tag: tag.LegSide, Name: "LegSide", Type: FieldTypeString},
+					{Tag: tag.LegCurrency, Name: "LegCurrency", Type: FieldTypeString},
+					{Tag: tag.LegPool, Name: "LegPool", Type: FieldTypeString},
+					{Tag: tag.LegDatedDate, Name: "LegDatedDate", Type: FieldTypeString},
+					{Tag: tag.LegContractSettlMonth, Name: "LegContractSettlMonth", Type: FieldTypeString},
+					{Tag: tag.LegInterestAccrualDate, Name: "LegInterestAccrualDate", Type: FieldTypeString},
+				}},
+				{Tag: tag.NoTradingSessions, Name: "NoTradingSessions", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+					{Tag: tag.TradingSessionID, Name: "TradingSessionID", Type: FieldTypeString, Required: true},
+					{Tag: tag.TradingSessionSubID, Name: "TradingSessionSubID", Type: FieldTypeString},
+				}},
+				{Tag: tag.ApplQueueAction, Name: "ApplQueueAction", Type: FieldTypeString},
+				{Tag: tag.ApplQueueMax, Name: "ApplQueueMax", Type: FieldTypeInt},
+			}},
+			{Tag: tag.MDReqID, Name: "MDReqID", Type: FieldTypeString, Required: true},
+			{Tag: tag.SubscriptionRequestType, Name: "SubscriptionRequestType", Type: FieldTypeString, Required: true},
+			{Tag: tag.MarketDepth, Name: "MarketDepth", Type: FieldTypeInt, Required: true},
+			{Tag: tag.MDUpdateType, Name: "MDUpdateType", Type: FieldTypeString},
+			{Tag: tag.AggregatedBook, Name: "AggregatedBook", Type: FieldTypeBool},
+			{Tag: tag.NoMDEntryTypes, Name: "NoMDEntryTypes", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.MDEntryType, Name: "MDEntryType", Type: FieldTypeString, Required: true},
+			}},
+			{Tag: tag.OpenCloseSettlFlag, Name: "OpenCloseSettlFlag", Type: FieldTypeString},
+			{Tag: tag.Scope, Name: "Scope", Type: FieldTypeString},
+			{Tag: tag.MDImplicitDelete, Name: "MDImplicitDelete", Type: FieldTypeBool},
+		},
+	},
+	"Y": {
+		Name:    "MarketDataRequestReject",
+		MsgType: "Y",
+		Fields: []FieldDef{
+			{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+			{Tag: tag.MDReqID, Name: "MDReqID", Type: FieldTypeString, Required: true},
+			{Tag: tag.MDReqRejReason, Name: "MDReqRejReason", Type: FieldTypeString},
+			{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			{Tag: tag.NoAltMDSource, Name: "NoAltMDSource", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.AltMDSourceID, Name: "AltMDSourceID", Type: FieldTypeString, Required: true},
+			}},
+		},
+	},
+	"W": {
+		Name:    "MarketDataSnapshotFullRefresh",
+		MsgType: "W",
+		Fields: []FieldDef{
+			{Tag: tag.SecurityIDSource, Name: "SecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.SecurityID, Name: "SecurityID", Type: FieldTypeString},
+			{Tag: tag.Symbol, Name: "Symbol", Type: FieldTypeString},
+			{Tag: tag.SymbolSfx, Name: "SymbolSfx", Type: FieldTypeString},
+			{Tag: tag.Issuer, Name: "Issuer", Type: FieldTypeString},
+			{Tag: tag.SecurityDesc, Name: "SecurityDesc", Type: FieldTypeString},
+			{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+			{Tag: tag.MaturityMonthYear, Name: "MaturityMonthYear", Type: FieldTypeString},
+			{Tag: tag.StrikePrice, Name: "StrikePrice", Type: FieldTypeDecimal},
+			{Tag: tag.OptAttribute, Name: "OptAttribute", Type: FieldTypeString},
+			{Tag: tag.SecurityExchange, Name: "SecurityExchange", Type: FieldTypeString},
+			{Tag: tag.CouponRate, Name: "CouponRate", Type: FieldTypeDecimal},
+			{Tag: tag.CouponPaymentDate, Name: "CouponPaymentDate", Type: FieldTypeString},
+			{Tag: tag.IssueDate, Name: "IssueDate", Type: FieldTypeString},
+			{Tag: tag.RepurchaseTerm, Name: "RepurchaseTerm", Type: FieldTypeInt},
+			{Tag: tag.RepurchaseRate, Name: "RepurchaseRate", Type: FieldTypeDecimal},
+			{Tag: tag.Factor, Name: "Factor", Type: FieldTypeDecimal},
+			{Tag: tag.ContractMultiplier, Name: "ContractMultiplier", Type: FieldTypeDecimal},
+			{Tag: tag.RepoCollateralSecurityType, Name: "RepoCollateralSecurityType", Type: FieldTypeInt},
+			{Tag: tag.RedemptionDate, Name: "RedemptionDate", Type: FieldTypeString},
+			{Tag: tag.CreditRating, Name: "CreditRating", Type: FieldTypeString},
+			{Tag: tag.MDReqID, Name: "MDReqID", Type: FieldTypeString},
+			{Tag: tag.NoMDEntries, Name: "NoMDEntries", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.MDEntryType, Name: "MDEntryType", Type: FieldTypeString, Required: true},
+				{Tag: tag.MDEntryPx, Name: "MDEntryPx", Type: FieldTypeDecimal},
+				{Tag: tag.Currency, Name: "Currency", Type: FieldTypeString},
+				{Tag: tag.MDEntrySize, Name: "MDEntrySize", Type: FieldTypeDecimal},
+				{Tag: tag.MDEntryDate, Name: "MDEntryDate", Type: FieldTypeString},
+				{Tag: tag.MDEntryTime, Name: "MDEntryTime", Type: FieldTypeString},
+				{Tag: tag.TickDirection, Name: "TickDirection", Type: FieldTypeString},
+				{Tag: tag.MDMkt, Name: "MDMkt", Type: FieldTypeString},
+				{Tag: tag.TradingSessionID, Name: "TradingSessionID", Type: FieldTypeString},
+				{Tag: tag.TradingSessionSubID, Name: "TradingSessionSubID", Type: FieldTypeString},
+				{Tag: tag.QuoteCondition, Name: "QuoteCondition", Type: FieldTypeString},
+				{Tag: tag.TradeCondition, Name: "TradeCondition", Type: FieldTypeString},
+				{Tag: tag.MDEntryOriginator, Name: "MDEntryOriginator", Type: FieldTypeString},
+				{Tag: tag.LocationID, Name: "LocationID", Type: FieldTypeString},
+				{Tag: tag.DeskID, Name: "DeskID", Type: FieldTypeString},
+				{Tag: tag.OpenCloseSettlFlag, Name: "OpenCloseSettlFlag", Type: FieldTypeString},
+				{Tag: tag.TimeInForce, Name: "TimeInForce", Type: FieldTypeString},
+				{Tag: tag.ExpireDate, Name: "ExpireDate", Type: FieldTypeString},
+				{Tag: tag.ExpireTime, Name: "ExpireTime", Type: FieldTypeUTCTimestamp},
+				{Tag: tag.MinQty, Name: "MinQty", Type: FieldTypeDecimal},
+				{Tag: tag.ExecInst, Name: "ExecInst", Type: FieldTypeString},
+				{Tag: tag.SellerDays, Name: "SellerDays", Type: FieldTypeInt},
+				{Tag: tag.OrderID, Name: "OrderID", Type: FieldTypeString},
+				{Tag: tag.QuoteEntryID, Name: "QuoteEntryID", Type: FieldTypeString},
+				{Tag: tag.MDEntryBuyer, Name: "MDEntryBuyer", Type: FieldTypeString},
+				{Tag: tag.MDEntrySeller, Name: "MDEntrySeller", Type: FieldTypeString},
+				{Tag: tag.NumberOfOrders, Name: "NumberOfOrders", Type: FieldTypeInt},
+				{Tag: tag.MDEntryPositionNo, Name: "MDEntryPositionNo", Type: FieldTypeInt},
+				{Tag: tag.Scope, Name: "Scope", Type: FieldTypeString},
+				{Tag: tag.PriceDelta, Name: "PriceDelta", Type: FieldTypeDecimal},
+				{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+				{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			}},
+			{Tag: tag.FinancialStatus, Name: "FinancialStatus", Type: FieldTypeString},
+			{Tag: tag.CorporateAction, Name: "CorporateAction", Type: FieldTypeString},
+			{Tag: tag.EncodedIssuerLen, Name: "EncodedIssuerLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedIssuer, Name: "EncodedIssuer", Type: FieldTypeString},
+			{Tag: tag.EncodedSecurityDescLen, Name: "EncodedSecurityDescLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedSecurityDesc, Name: "EncodedSecurityDesc", Type: FieldTypeString},
+			{Tag: tag.NetChgPrevDay, Name: "NetChgPrevDay", Type: FieldTypeDecimal},
+			{Tag: tag.NoSecurityAltID, Name: "NoSecurityAltID", Type: FieldTypeNumInGroup, Fields: noSecurityAltIDFields},
+			{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+			{Tag: tag.CFICode, Name: "CFICode", Type: FieldTypeString},
+			{Tag: tag.CountryOfIssue, Name: "CountryOfIssue", Type: FieldTypeString},
+			{Tag: tag.StateOrProvinceOfIssue, Name: "StateOrProvinceOfIssue", Type: FieldTypeString},
+			{Tag: tag.LocaleOfIssue, Name: "LocaleOfIssue", Type: FieldTypeString},
+			{Tag: tag.MaturityDate, Name: "MaturityDate", Type: FieldTypeString},
+			{Tag: tag.InstrRegistry, Name: "InstrRegistry", Type: FieldTypeString},
+			{Tag: tag.NoLegs, Name: "NoLegs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.LegSymbol, Name: "LegSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.LegSymbolSfx, Name: "LegSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.LegSecurityID, Name: "LegSecurityID", Type: FieldTypeString},
+				{Tag: tag.LegSecurityIDSource, Name: "LegSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoLegSecurityAltID, Name: "NoLegSecurityAltID", Type: FieldTypeNumInGroup, Fields: noLegSecurityAltIDFields},
+				{Tag: tag.LegProduct, Name: "LegProduct", Type: FieldTypeInt},
+				{Tag: tag.LegCFICode, Name: "LegCFICode", Type: FieldTypeString},
+				{Tag: tag.LegSecurityType, Name: "LegSecurityType", Type: FieldTypeString},
+				{Tag: tag.LegSecuritySubType, Name: "LegSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.LegMaturityMonthYear, Name: "LegMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.LegMaturityDate, Name: "LegMaturityDate", Type: FieldTypeString},
+				{Tag: tag.LegCouponPaymentDate, Name: "LegCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.LegIssueDate, Name: "LegIssueDate", Type: FieldTypeString},
+				{Tag: tag.LegRepoCollateralSecurityType, Name: "LegRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseTerm, Name: "LegRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseRate, Name: "LegRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegFactor, Name: "LegFactor", Type: FieldTypeDecimal},
+				{Tag: tag.LegCreditRating, Name: "LegCreditRating", Type: FieldTypeString},
+				{Tag: tag.LegInstrRegistry, Name: "LegInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.LegCountryOfIssue, Name: "LegCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegStateOrProvinceOfIssue, Name: "LegStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegLocaleOfIssue, Name: "LegLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegRedemptionDate, Name: "LegRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.LegStrikePrice, Name: "LegStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.LegStrikeCurrency, Name: "LegStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.LegOptAttribute, Name: "LegOptAttribute", Type: FieldTypeString},
+				{Tag: tag.LegContractMultiplier, Name: "LegContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.LegCouponRate, Name: "LegCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegSecurityExchange, Name: "LegSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.LegIssuer, Name: "LegIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedLegIssuerLen, Name: "EncodedLegIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegIssuer, Name: "EncodedLegIssuer", Type: FieldTypeString},
+				{Tag: tag.LegSecurityDesc, Name: "LegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedLegSecurityDescLen, Name: "EncodedLegSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegSecurityDesc, Name: "EncodedLegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.LegRatioQty, Name: "LegRatioQty", Type: FieldTypeDecimal},
+				{Tag: tag.LegSide, Name: "LegSide", Type: FieldTypeString},
+				{Tag: tag.LegCurrency, Name: "LegCurrency", Type: FieldTypeString},
+				{Tag: tag.LegPool, Name: "LegPool", Type: FieldTypeString},
+				{Tag: tag.LegDatedDate, Name: "LegDatedDate", Type: FieldTypeString},
+				{Tag: tag.LegContractSettlMonth, Name: "LegContractSettlMonth", Type: FieldTypeString},
+				{Tag: tag.LegInterestAccrualDate, Name: "LegInterestAccrualDate", Type: FieldTypeString},
+			}},
+			{Tag: tag.ContractSettlMonth, Name: "ContractSettlMonth", Type: FieldTypeString},
+			{Tag: tag.Pool, Name: "Pool", Type: FieldTypeString},
+			{Tag: tag.NoUnderlyings, Name: "NoUnderlyings", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.UnderlyingSymbol, Name: "UnderlyingSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.UnderlyingSymbolSfx, Name: "UnderlyingSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityID, Name: "UnderlyingSecurityID", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityIDSource, Name: "UnderlyingSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoUnderlyingSecurityAltID, Name: "NoUnderlyingSecurityAltID", Type: FieldTypeNumInGroup, Fields: noUnderlyingSecurityAltIDFields},
+				{Tag: tag.UnderlyingProduct, Name: "UnderlyingProduct", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingCFICode, Name: "UnderlyingCFICode", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityType, Name: "UnderlyingSecurityType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecuritySubType, Name: "UnderlyingSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityMonthYear, Name: "UnderlyingMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityDate, Name: "UnderlyingMaturityDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCouponPaymentDate, Name: "UnderlyingCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssueDate, Name: "UnderlyingIssueDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRepoCollateralSecurityType, Name: "UnderlyingRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseTerm, Name: "UnderlyingRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseRate, Name: "UnderlyingRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingFactor, Name: "UnderlyingFactor", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCreditRating, Name: "UnderlyingCreditRating", Type: FieldTypeString},
+				{Tag: tag.UnderlyingInstrRegistry, Name: "UnderlyingInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCountryOfIssue, Name: "UnderlyingCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStateOrProvinceOfIssue, Name: "UnderlyingStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingLocaleOfIssue, Name: "UnderlyingLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRedemptionDate, Name: "UnderlyingRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStrikePrice, Name: "UnderlyingStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStrikeCurrency, Name: "UnderlyingStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingOptAttribute, Name: "UnderlyingOptAttribute", Type: FieldTypeString},
+				{Tag: tag.UnderlyingContractMultiplier, Name: "UnderlyingContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCouponRate, Name: "UnderlyingCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingSecurityExchange, Name: "UnderlyingSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssuer, Name: "UnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingIssuerLen, Name: "EncodedUnderlyingIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingIssuer, Name: "EncodedUnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityDesc, Name: "UnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingSecurityDescLen, Name: "EncodedUnderlyingSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingSecurityDesc, Name: "EncodedUnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPProgram, Name: "UnderlyingCPProgram", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPRegType, Name: "UnderlyingCPRegType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCurrency, Name: "UnderlyingCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingQty, Name: "UnderlyingQty", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingPx, Name: "UnderlyingPx", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingDirtyPrice, Name: "UnderlyingDirtyPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndPrice, Name: "UnderlyingEndPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStartValue, Name: "UnderlyingStartValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCurrentValue, Name: "UnderlyingCurrentValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndValue, Name: "UnderlyingEndValue", Type: FieldTypeDecimal},
+				{Tag: tag.NoUnderlyingStips, Name: "NoUnderlyingStips", Type: FieldTypeNumInGroup, Fields: noUnderlyingStipsFields},
+			}},
+			{Tag: tag.SecuritySubType, Name: "SecuritySubType", Type: FieldTypeString},
+			{Tag: tag.ApplQueueDepth, Name: "ApplQueueDepth", Type: FieldTypeInt},
+			{Tag: tag.ApplQueueResolution, Name: "ApplQueueResolution", Type: FieldTypeString},
+			{Tag: tag.NoEvents, Name: "NoEvents", Type: FieldTypeNumInGroup, Fields: noEventsFields},
+			{Tag: tag.DatedDate, Name: "DatedDate", Type: FieldTypeString},
+			{Tag: tag.InterestAccrualDate, Name: "InterestAccrualDate", Type: FieldTypeString},
+			{Tag: tag.CPProgram, Name: "CPProgram", Type: FieldTypeString},
+			{Tag: tag.CPRegType, Name: "CPRegType", Type: FieldTypeString},
+			{Tag: tag.StrikeCurrency, Name: "StrikeCurrency", Type: FieldTypeString},
+		},
+	},
+	"i": {
+		Name:    "MassQuote",
+		MsgType: "i",
+		Fields: []FieldDef{
+			{Tag: tag.Account, Name: "Account", Type: FieldTypeString},
+			{Tag: tag.QuoteID, Name: "QuoteID", Type: FieldTypeString, Required: true},
+			{Tag: tag.QuoteReqID, Name: "QuoteReqID", Type: FieldTypeString},
+			{Tag: tag.DefBidSize, Name: "DefBidSize", Type: FieldTypeDecimal},
+			{Tag: tag.DefOfferSize, Name: "DefOfferSize", Type: FieldTypeDecimal},
+			{Tag: tag.NoQuoteSets, Name: "NoQuoteSets", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.QuoteSetID, Name: "QuoteSetID", Type: FieldTypeString, Required: true},
+				{Tag: tag.UnderlyingSymbol, Name: "UnderlyingSymbol", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSymbolSfx, Name: "UnderlyingSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityID, Name: "UnderlyingSecurityID", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityIDSource, Name: "UnderlyingSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoUnderlyingSecurityAltID, Name: "NoUnderlyingSecurityAltID", Type: FieldTypeNumInGroup, Fields: noUnderlyingSecurityAltIDFields},
+				{Tag: tag.UnderlyingProduct, Name: "UnderlyingProduct", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingCFICode, Name: "UnderlyingCFICode", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityType, Name: "UnderlyingSecurityType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecuritySubType, Name: "UnderlyingSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityMonthYear, Name: "UnderlyingMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityDate, Name: "UnderlyingMaturityDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCouponPaymentDate, Name: "UnderlyingCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssueDate, Name: "UnderlyingIssueDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRepoCollateralSecurityType, Name: "UnderlyingRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseTerm, Name: "UnderlyingRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseRate, Name: "UnderlyingRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingFactor, Name: "UnderlyingFactor", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCreditRating, Name: "UnderlyingCreditRating", Type: FieldTypeString},
+				{Tag: tag.UnderlyingInstrRegistry, Name: "UnderlyingInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCountryOfIssue, Name: "UnderlyingCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStateOrProvinceOfIssue, Name: "UnderlyingStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingLocaleOfIssue, Name: "UnderlyingLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRedemptionDate, Name: "UnderlyingRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStrikePrice, Name: "UnderlyingStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStrikeCurrency, Name: "UnderlyingStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingOptAttribute, Name: "UnderlyingOptAttribute", Type: FieldTypeString},
+				{Tag: tag.UnderlyingContractMultiplier, Name: "UnderlyingContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCouponRate, Name: "UnderlyingCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingSecurityExchange, Name: "UnderlyingSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssuer, Name: "UnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingIssuerLen, Name: "EncodedUnderlyingIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingIssuer, Name: "EncodedUnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityDesc, Name: "UnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingSecurityDescLen, Name: "EncodedUnderlyingSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingSecurityDesc, Name: "EncodedUnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPProgram, Name: "UnderlyingCPProgram", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPRegType, Name: "UnderlyingCPRegType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCurrency, Name: "UnderlyingCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingQty, Name: "UnderlyingQty", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingPx, Name: "UnderlyingPx", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingDirtyPrice, Name: "UnderlyingDirtyPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndPrice, Name: "UnderlyingEndPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStartValue, Name: "UnderlyingStartValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCurrentValue, Name: "UnderlyingCurrentValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndValue, Name: "UnderlyingEndValue", Type: FieldTypeDecimal},
+				{Tag: tag.NoUnderlyingStips, Name: "NoUnderlyingStips", Type: FieldTypeNumInGroup, Fields: noUnderlyingStipsFields},
+				{Tag: tag.QuoteSetValidUntilTime, Name: "QuoteSetValidUntilTime", Type: FieldTypeUTCTimestamp},
+				{Tag: tag.TotNoQuoteEntries, Name: "TotNoQuoteEntries", Type: FieldTypeInt},
+				{Tag: tag.LastFragment, Name: "LastFragment", Type: FieldTypeBool},
+				{Tag: tag.NoQuoteEntries, Name: "NoQuoteEntries", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+					{Tag: tag.QuoteEntryID, Name: "QuoteEntryID", Type: FieldTypeString, Required: true},
+					{Tag: tag.Symbol, Name: "Symbol", Type: FieldTypeString},
+					{Tag: tag.SymbolSfx, Name: "SymbolSfx", Type: FieldTypeString},
+					{Tag: tag.SecurityID, Name: "SecurityID", Type: FieldTypeString},
+					{Tag: tag.SecurityIDSource, Name: "SecurityIDSource", Type: FieldTypeString},
+					{Tag: tag.NoSecurityAltID, Name: "NoSecurityAltID", Type: FieldTypeNumInGroup, Fields: noSecurityAltIDFields},
+					{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+					{Tag: tag.CFICode, Name: "CFICode", Type: FieldTypeString},
+					{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+					{Tag: tag.SecuritySubType, Name: "SecuritySubType", Type: FieldTypeString},
+					{Tag: tag.MaturityMonthYear, Name: "MaturityMonthYear", Type: FieldTypeString},
+					{Tag: tag.MaturityDate, Name: "MaturityDate", Type: FieldTypeString},
+					{Tag: tag.CouponPaymentDate, Name: "CouponPaymentDate", Type: FieldTypeString},
+					{Tag: tag.IssueDate, Name: "IssueDate", Type: FieldTypeString},
+					{Tag: tag.RepoCollateralSecurityType, Name: "RepoCollateralSecurityType", Type: FieldTypeInt},
+					{Tag: tag.RepurchaseTerm, Name: "RepurchaseTerm", Type: FieldTypeInt},
+					{Tag: tag.RepurchaseRate, Name: "RepurchaseRate", Type: FieldTypeDecimal},
+					{Tag: tag.Factor, Name: "Factor", Type: FieldTypeDecimal},
+					{Tag: tag.CreditRating, Name: "CreditRating", Type: FieldTypeString},
+					{Tag: tag.InstrRegistry, Name: "InstrRegistry", Type: FieldTypeString},
+					{Tag: tag.CountryOfIssue, Name: "CountryOfIssue", Type: FieldTypeString},
+					{Tag: tag.StateOrProvinceOfIssue, Name: "StateOrProvinceOfIssue", Type: FieldTypeString},
+					{Tag: tag.LocaleOfIssue, Name: "LocaleOfIssue", Type: FieldTypeString},
+					{Tag: tag.RedemptionDate, Name: "RedemptionDate", Type: FieldTypeString},
+					{Tag: tag.StrikePrice, Name: "StrikePrice", Type: FieldTypeDecimal},
+					{Tag: tag.StrikeCurrency, Name: "StrikeCurrency", Type: FieldTypeString},
+					{Tag: tag.OptAttribute, Name: "OptAttribute", Type: FieldTypeString},
+					{Tag: tag.ContractMultiplier, Name: "ContractMultiplier", Type: FieldTypeDecimal},
+					{Tag: tag.CouponRate, Name: "CouponRate", Type: FieldTypeDecimal},
+					{Tag: tag.SecurityExchange, Name: "SecurityExchange", Type: FieldTypeString},
+					{Tag: tag.Issuer, Name: "Issuer", Type: FieldTypeString},
+					{Tag: tag.EncodedIssuerLen, Name: "EncodedIssuerLen", Type: FieldTypeInt},
+					{Tag: tag.EncodedIssuer, Name: "EncodedIssuer", Type: FieldTypeString},
+					{Tag: tag.SecurityDesc, Name: "SecurityDesc", Type: FieldTypeString},
+					{Tag: tag.EncodedSecurityDescLen, Name: "EncodedSecurityDescLen", Type: FieldTypeInt},
+					{Tag: tag.EncodedSecurityDesc, Name: "EncodedSecurityDesc", Type: FieldTypeString},
+					{Tag: tag.Pool, Name: "Pool", Type: FieldTypeString},
+					{Tag: tag.ContractSettlMonth, Name: "ContractSettlMonth", Type: FieldTypeString},
+					{Tag: tag.CPProgram, Name: "CPProgram", Type: FieldTypeString},
+					{Tag: tag.CPRegType, Name: "CPRegType", Type: FieldTypeString},
+					{Tag: tag.NoEvents, Name: "NoEvents", Type: FieldTypeNumInGroup, Fields: noEventsFields},
+					{Tag: tag.DatedDate, Name: "DatedDate", Type: FieldTypeString},
+					{Tag: tag.InterestAccrualDate, Name: "InterestAccrualDate", Type: FieldTypeString},
+					{Tag: tag.NoLegs, Name: "NoLegs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+						{Tag: tag.LegSymbol, Name: "LegSymbol", Type: FieldTypeString, Required: true},
+						{Tag: tag.LegSymbolSfx, Name: "LegSymbolSfx", Type: FieldTypeString},
+						{Tag: tag.LegSecurityID, Name: "LegSecurityID", Type: FieldTypeString},
+						{Tag: tag.LegSecurityIDSource, Name: "LegSecurityIDSource", Type: FieldTypeString},
+						{Tag: tag.NoLegSecurityAltID, Name: "NoLegSecurityAltID", Type: FieldTypeNumInGroup, Fields: noLegSecurityAltIDFields},
+						{Tag: tag.LegProduct, Name: "LegProduct", Type: FieldTypeInt},
+						{Tag: tag.LegCFICode, Name: "LegCFICode", Type: FieldTypeString},
+						{Tag: tag.LegSecurityType, Name: "LegSecurityType", Type: FieldTypeString},
+						{Tag: tag.LegSecuritySubType, Name: "LegSecuritySubType", Type: FieldTypeString},
+						{Tag: tag.LegMaturityMonthYear, Name: "LegMaturityMonthYear", Type: FieldTypeString},
+						{Tag: tag.LegMaturityDate, Name: "LegMaturityDate", Type: FieldTypeString},
+						{Tag: tag.LegCouponPaymentDate, Name: "LegCouponPaymentDate", Type: FieldTypeString},
+						{Tag: tag.LegIssueDate, Name: "LegIssueDate", Type: FieldTypeString},
+						{Tag: tag.LegRepoCollateralSecurityType, Name: "LegRepoCollateralSecurityType", Type: FieldTypeInt},
+						{Tag: tag.LegRepurchaseTerm, Name: "LegRepurchaseTerm", Type: FieldTypeInt},
+						{Tag: tag.LegRepurchaseRate, Name: "LegRepurchaseRate", Type: FieldTypeDecimal},
+						{Tag: tag.LegFactor, Name: "LegFactor", Type: FieldTypeDecimal},
+						{Tag: tag.LegCreditRating, Name: "LegCreditRating", Type: FieldTypeString},
+						{Tag: tag.LegInstrRegistry, Name: "LegInstrRegistry", Type: FieldTypeString},
+						{Tag: tag.LegCountryOfIssue, Name: "LegCountryOfIssue", Type: FieldTypeString},
+						{Tag: tag.LegStateOrProvinceOfIssue, Name: "LegStateOrProvinceOfIssue", Type: FieldTypeString},
+						{Tag: tag.LegLocaleOfIssue, Name: "LegLocaleOfIssue", Type: FieldTypeString},
+						{Tag: tag.LegRedemptionDate, Name: "LegRedemptionDate", Type: FieldTypeString},
+						{Tag: tag.LegStrikePrice, Name: "LegStrikePrice", Type: FieldTypeDecimal},
+						{Tag: tag.LegStrikeCurrency, Name: "LegStrikeCurrency", Type: FieldTypeString},
+						{Tag: tag.LegOptAttribute, Name: "LegOptAttribute", Type: FieldTypeString},
+						{Tag: tag.LegContractMultiplier, Name: "LegContractMultiplier", Type: FieldTypeDecimal},
+						{Tag: tag.LegCouponRate, Name: "LegCouponRate", Type: FieldTypeDecimal},
+						{Tag: tag.LegSecurityExchange, Name: "LegSecurityExchange", Type: FieldTypeString},
+						{Tag: tag.LegIssuer, Name: "LegIssuer", Type: FieldTypeString},
+						{Tag: tag.EncodedLegIssuerLen, Name: "EncodedLegIssuerLen", Type: FieldTypeInt},
+						{Tag: tag.EncodedLegIssuer, Name: "EncodedLegIssuer", Type: FieldTypeString},
+						{Tag: tag.LegSecurityDesc, Name: "LegSecurityDesc", Type: FieldTypeString},
+						{Tag: tag.EncodedLegSecurityDescLen, Name: "EncodedLegSecurityDescLen", Type: FieldTypeInt},
+						{Tag: tag.EncodedLegSecurityDesc, Name: "EncodedLegSecurityDesc", Type: FieldTypeString},
+						{Tag: tag.LegRatioQty, Name: "LegRatioQty", Type: FieldTypeDecimal},
+						{Tag: tag.LegSide, Name: "LegSide", Type: FieldTypeString},
+						{Tag: tag.LegCurrency, Name: "LegCurrency", Type: FieldTypeString},
+						{Tag: tag.LegPool, Name: "LegPool", Type: FieldTypeString},
+						{Tag: tag.LegDatedDate, Name: "LegDatedDate", Type: FieldTypeString},
+						{Tag: tag.LegContractSettlMonth, Name: "LegContractSettlMonth", Type: FieldTypeString},
+						{Tag: tag.LegInterestAccrualDate, Name: "LegInterestAccrualDate", Type: FieldTypeString},
+					}},
+					{Tag: tag.BidPx, Name: "BidPx", Type: FieldTypeDecimal},
+					{Tag: tag.OfferPx, Name: "OfferPx", Type: FieldTypeDecimal},
+					{Tag: tag.BidSize, Name: "BidSize", Type: FieldTypeDecimal},
+					{Tag: tag.OfferSize, Name: "OfferSize", Type: FieldTypeDecimal},
+					{Tag: tag.ValidUntilTime, Name: "ValidUntilTime", Type: FieldTypeUTCTimestamp},
+					{Tag: tag.BidSpotRate, Name: "BidSpotRate", Type: FieldTypeDecimal},
+					{Tag: tag.OfferSpotRate, Name: "OfferSpotRate", Type: FieldTypeDecimal},
+					{Tag: tag.BidForwardPoints, Name: "BidForwardPoints", Type: FieldTypeDecimal},
+					{Tag: tag.OfferForwardPoints, Name: "OfferForwardPoints", Type: FieldTypeDecimal},
+					{Tag: tag.MidPx, Name: "MidPx", Type: FieldTypeDecimal},
+					{Tag: tag.BidYield, Name: "BidYield", Type: FieldTypeDecimal},
+					{Tag: tag.MidYield, Name: "MidYield", Type: FieldTypeDecimal},
+					{Tag: tag.OfferYield, Name: "OfferYield", Type: FieldTypeDecimal},
+					{Tag: tag.TransactTime, Name: "TransactTime", Type: FieldTypeUTCTimestamp},
+					{Tag: tag.TradingSessionID, Name: "TradingSessionID", Type: FieldTypeString},
+					{Tag: tag.TradingSessionSubID, Name: "TradingSessionSubID", Type: FieldTypeString},
+					{Tag: tag.SettlDate, Name: "SettlDate", Type: FieldTypeString},
+					{Tag: tag.OrdType, Name: "OrdType", Type: FieldTypeString},
+					{Tag: tag.SettlDate2, Name: "SettlDate2", Type: FieldTypeString},
+					{Tag: tag.OrderQty2, Name: "OrderQty2", Type: FieldTypeDecimal},
+					{Tag: tag.BidForwardPoints2, Name: "BidForwardPoints2", Type: FieldTypeDecimal},
+					{Tag: tag.OfferForwardPoints2, Name: "OfferForwardPoints2", Type: FieldTypeDecimal},
+					{Tag: tag.Currency, Name: "Currency", Type: FieldTypeString},
+				}},
+			}},
+			{Tag: tag.QuoteResponseLevel, Name: "QuoteResponseLevel", Type: FieldTypeString},
+			{Tag: tag.NoPartyIDs, Name: "NoPartyIDs", Type: FieldTypeNumInGroup, Fields: noPartyIDsFields},
+			{Tag: tag.QuoteType, Name: "QuoteType", Type: FieldTypeString},
+			{Tag: tag.AccountType, Name: "AccountType", Type: FieldTypeString},
+			{Tag: tag.AcctIDSource, Name: "AcctIDSource", Type: FieldTypeString},
+		},
+	},
+	"b": {
+		Name:    "MassQuoteAcknowledgement",
+		MsgType: "b",
+		Fields: []FieldDef{
+			{Tag: tag.Account, Name: "Account", Type: FieldTypeString},
+			{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+			{Tag: tag.QuoteID, Name: "QuoteID", Type: FieldTypeString},
+			{Tag: tag.QuoteReqID, Name: "QuoteReqID", Type: FieldTypeString},
+			{Tag: tag.NoQuoteSets, Name: "NoQuoteSets", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.QuoteSetID, Name: "QuoteSetID", Type: FieldTypeString, Required: true},
+				{Tag: tag.UnderlyingSymbol, Name: "UnderlyingSymbol", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSymbolSfx, Name: "UnderlyingSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityID, Name: "UnderlyingSecurityID", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityIDSource, Name: "UnderlyingSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoUnderlyingSecurityAltID, Name: "NoUnderlyingSecurityAltID", Type: FieldTypeNumInGroup, Fields: noUnderlyingSecurityAltIDFields},
+				{Tag: tag.UnderlyingProduct, Name: "UnderlyingProduct", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingCFICode, Name: "UnderlyingCFICode", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityType, Name: "UnderlyingSecurityType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecuritySubType, Name: "UnderlyingSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityMonthYear, Name: "UnderlyingMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityDate, Name: "UnderlyingMaturityDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCouponPaymentDate, Name: "UnderlyingCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssueDate, Name: "UnderlyingIssueDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRepoCollateralSecurityType, Name: "UnderlyingRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseTerm, Name: "UnderlyingRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseRate, Name: "UnderlyingRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingFactor, Name: "UnderlyingFactor", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCreditRating, Name: "UnderlyingCreditRating", Type: FieldTypeString},
+				{Tag: tag.UnderlyingInstrRegistry, Name: "UnderlyingInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCountryOfIssue, Name: "UnderlyingCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStateOrProvinceOfIssue, Name: "UnderlyingStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingLocaleOfIssue, Name: "UnderlyingLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRedemptionDate, Name: "UnderlyingRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStrikePrice, Name: "UnderlyingStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStrikeCurrency, Name: "UnderlyingStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingOptAttribute, Name: "UnderlyingOptAttribute", Type: FieldTypeString},
+				{Tag: tag.UnderlyingContractMultiplier, Name: "UnderlyingContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCouponRate, Name: "UnderlyingCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingSecurityExchange, Name: "UnderlyingSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssuer, Name: "UnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingIssuerLen, Name: "EncodedUnderlyingIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingIssuer, Name: "EncodedUnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityDesc, Name: "UnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingSecurityDescLen, Name: "EncodedUnderlyingSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingSecurityDesc, Name: "EncodedUnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPProgram, Name: "UnderlyingCPProgram", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPRegType, Name: "UnderlyingCPRegType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCurrency, Name: "UnderlyingCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingQty, Name: "UnderlyingQty", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingPx, Name: "UnderlyingPx", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingDirtyPrice, Name: "UnderlyingDirtyPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndPrice, Name: "UnderlyingEndPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStartValue, Name: "UnderlyingStartValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCurrentValue, Name: "UnderlyingCurrentValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndValue, Name: "UnderlyingEndValue", Type: FieldTypeDecimal},
+				{Tag: tag.NoUnderlyingStips, Name: "NoUnderlyingStips", Type: FieldTypeNumInGroup, Fields: noUnderlyingStipsFields},
+				{Tag: tag.TotNoQuoteEntries, Name: "TotNoQuoteEntries", Type: FieldTypeInt},
+				{Tag: tag.LastFragment, Name: "LastFragment", Type: FieldTypeBool},
+				{Tag: tag.NoQuoteEntries, Name: "NoQuoteEntries", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+					{Tag: tag.QuoteEntryID, Name: "QuoteEntryID", Type: FieldTypeString, Required: true},
+					{Tag: tag.Symbol, Name: "Symbol", Type: FieldTypeString},
+					{Tag: tag.SymbolSfx, Name: "SymbolSfx", Type: FieldTypeString},
+					{Tag: tag.SecurityID, Name: "SecurityID", Type: FieldTypeString},
+					{Tag: tag.SecurityIDSource, Name: "SecurityIDSource", Type: FieldTypeString},
+					{Tag: tag.NoSecurityAltID, Name: "NoSecurityAltID", Type: FieldTypeNumInGroup, Fields: noSecurityAltIDFields},
+					{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+					{Tag: tag.CFICode, Name: "CFICode", Type: FieldTypeString},
+					{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+					{Tag: tag.SecuritySubType, Name: "SecuritySubType", Type: FieldTypeString},
+					{Tag: tag.MaturityMonthYear, Name: "MaturityMonthYear", Type: FieldTypeString},
+					{Tag: tag.MaturityDate, Name: "MaturityDate", Type: FieldTypeString},
+					{Tag: tag.CouponPaymentDate, Name: "CouponPaymentDate", Type: FieldTypeString},
+					{Tag: tag.IssueDate, Name: "IssueDate", Type: FieldTypeString},
+					{Tag: tag.RepoCollateralSecurityType, Name: "RepoCollateralSecurityType", Type: FieldTypeInt},
+					{Tag: tag.RepurchaseTerm, Name: "RepurchaseTerm", Type: FieldTypeInt},
+					{Tag: tag.RepurchaseRate, Name: "RepurchaseRate", Type: FieldTypeDecimal},
+					{Tag: tag.Factor, Name: "Factor", Type: FieldTypeDecimal},
+					{Tag: tag.CreditRating, Name: "CreditRating", Type: FieldTypeString},
+					{Tag: tag.InstrRegistry, Name: "InstrRegistry", Type: FieldTypeString},
+					{Tag: tag.CountryOfIssue, Name: "CountryOfIssue", Type: FieldTypeString},
+					{Tag: tag.StateOrProvinceOfIssue, Name: "StateOrProvinceOfIssue", Type: FieldTypeString},
+					{Tag: tag.LocaleOfIssue, Name: "LocaleOfIssue", Type: FieldTypeString},
+					{Tag: tag.RedemptionDate, Name: "RedemptionDate", Type: FieldTypeString},
+					{Tag: tag.StrikePrice, Name: "StrikePrice", Type: FieldTypeDecimal},
+					{Tag: tag.StrikeCurrency, Name: "StrikeCurrency", Type: FieldTypeString},
+					{Tag: tag.OptAttribute, Name: "OptAttribute", Type: FieldTypeString},
+					{Tag: tag.ContractMultiplier, Name: "ContractMultiplier", Type: FieldTypeDecimal},
+					{Tag: tag.CouponRate, Name: "CouponRate", Type: FieldTypeDecimal},
+					{Tag: tag.SecurityExchange, Name: "SecurityExchange", Type: FieldTypeString},
+					{Tag: tag.Issuer, Name: "Issuer", Type: FieldTypeString},
+					{Tag: tag.EncodedIssuerLen, Name: "EncodedIssuerLen", Type: FieldTypeInt},
+					{Tag: tag.EncodedIssuer, Name: "EncodedIssuer", Type: FieldTypeString},
+					{Tag: tag.SecurityDesc, Name: "SecurityDesc", Type: FieldTypeString},
+					{Tag: tag.EncodedSecurityDescLen, Name: "EncodedSecurityDescLen", Type: FieldTypeInt},
+					{Tag: tag.EncodedSecurityDesc, Name: "EncodedSecurityDesc", Type: FieldTypeString},
+					{Tag: tag.Pool, Name: "Pool", Type: FieldTypeString},
+					{Tag: tag.ContractSettlMonth, Name: "ContractSettlMonth", Type: FieldTypeString},
+					{Tag: tag.CPProgram, Name: "CPProgram", Type: FieldTypeString},
+					{Tag: tag.CPRegType, Name: "CPRegType", Type: FieldTypeString},
+					{Tag: tag.NoEvents, Name: "NoEvents", Type: FieldTypeNumInGroup, Fields: noEventsFields},
+					{Tag: tag.DatedDate, Name: "DatedDate", Type: FieldTypeString},
+					{Tag: tag.InterestAccrualDate, Name: "InterestAccrualDate", Type: FieldTypeString},
+					{Tag: tag.NoLegs, Name: "NoLegs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+						{Tag: tag.LegSymbol, Name: "LegSymbol", Type: FieldTypeString, Required: true},
+						{Tag: tag.LegSymbolSfx, Name: "LegSymbolSfx", Type: FieldTypeString},
+						{Tag: tag.LegSecurityID, Name: "LegSecurityID", Type: FieldTypeString},
+						{Tag: tag.LegSecurityIDSource, Name: "LegSecurityIDSource", Type: FieldTypeString},
+						{Tag: tag.NoLegSecurityAltID, Name: "NoLegSecurityAltID", Type: FieldTypeNumInGroup, Fields: noLegSecurityAltIDFields},
+						{Tag: tag.LegProduct, Name: "LegProduct", Type: FieldTypeInt},
+						{Tag: tag.LegCFICode, Name: "LegCFICode", Type: FieldTypeString},
+						{Tag: tag.LegSecurityType, Name: "LegSecurityType", Type: FieldTypeString},
+						{Tag: tag.LegSecuritySubType, Name: "LegSecuritySubType", Type: FieldTypeString},
+						{Tag: tag.LegMaturityMonthYear, Name: "LegMaturityMonthYear", Type: FieldTypeString},
+						{Tag: tag.LegMaturityDate, Name: "LegMaturityDate", Type: FieldTypeString},
+						{Tag: tag.LegCouponPaymentDate, Name: "LegCouponPaymentDate", Type: FieldTypeString},
+						{Tag: tag.LegIssueDate, Name: "LegIssueDate", Type: FieldTypeString},
+						{Tag: tag.LegRepoCollateralSecurityType, Name: "LegRepoCollateralSecurityType", Type: FieldTypeInt},
+						{Tag: tag.LegRepurchaseTerm, Name: "LegRepurchaseTerm", Type: FieldTypeInt},
+						{Tag: tag.LegRepurchaseRate, Name: "LegRepurchaseRate", Type: FieldTypeDecimal},
+						{Tag: tag.LegFactor, Name: "LegFactor", Type: FieldTypeDecimal},
+						{Tag: tag.LegCreditRating, Name: "LegCreditRating", Type: FieldTypeString},
+						{Tag: tag.LegInstrRegistry, Name: "LegInstrRegistry", Type: FieldTypeString},
+						{Tag: tag.LegCountryOfIssue, Name: "LegCountryOfIssue", Type: FieldTypeString},
+						{Tag: tag.LegStateOrProvinceOfIssue, Name: "LegStateOrProvinceOfIssue", Type: FieldTypeString},
+						{Tag: tag.LegLocaleOfIssue, Name: "LegLocaleOfIssue", Type: FieldTypeString},
+						{Tag: tag.LegRedemptionDate, Name: "LegRedemptionDate", Type: FieldTypeString},
+						{Tag: tag.LegStrikePrice, Name: "LegStrikePrice", Type: FieldTypeDecimal},
+						{Tag: tag.LegStrikeCurrency, Name: "LegStrikeCurrency", Type: FieldTypeString},
+						{Tag: tag.LegOptAttribute, Name: "LegOptAttribute", Type: FieldTypeString},
+						{Tag: tag.LegContractMultiplier, Name: "LegContractMultiplier", Type: FieldTypeDecimal},
+						{Tag: tag.LegCouponRate, Name: "LegCouponRate", Type: FieldTypeDecimal},
+						{Tag: tag.LegSecurityExchange, Name: "LegSecurityExchange", Type: FieldTypeString},
+						{Tag: tag.LegIssuer, Name: "LegIssuer", Type: FieldTypeString},
+						{Tag: tag.EncodedLegIssuerLen, Name: "EncodedLegIssuerLen", Type: FieldTypeInt},
+						{Tag: tag.EncodedLegIssuer, Name: "EncodedLegIssuer", Type: FieldTypeString},
+						{Tag: tag.LegSecurityDesc, Name: "LegSecurityDesc", Type: FieldTypeString},
+						{Tag: tag.EncodedLegSecurityDescLen, Name: "EncodedLegSecurityDescLen", Type: FieldTypeInt},
+						{Tag: tag.EncodedLegSecurityDesc, Name: "EncodedLegSecurityDesc", Type: FieldTypeString},
+						{Tag: tag.LegRatioQty, Name: "LegRatioQty", Type: FieldTypeDecimal},
+						{Tag: tag.LegSide, Name: "LegSide", Type: FieldTypeString},
+						{Tag: tag.LegCurrency, Name: "LegCurrency", Type: FieldTypeString},
+						{Tag: tag.LegPool, Name: "LegPool", Type: FieldTypeString},
+						{Tag: tag.LegDatedDate, Name: "LegDatedDate", Type: FieldTypeString},
+						{Tag: tag.LegContractSettlMonth, Name: "LegContractSettlMonth", Type: FieldTypeString},
+						{Tag: tag.LegInterestAccrualDate, Name: "LegInterestAccrualDate", Type: FieldTypeString},
+					}},
+					{Tag: tag.BidPx, Name: "BidPx", Type: FieldTypeDecimal},
+					{Tag: tag.OfferPx, Name: "OfferPx", Type: FieldTypeDecimal},
+					{Tag: tag.BidSize, Name: "BidSize", Type: FieldTypeDecimal},
+					{Tag: tag.OfferSize, Name: "OfferSize", Type: FieldTypeDecimal},
+					{Tag: tag.ValidUntilTime, Name: "ValidUntilTime", Type: FieldTypeUTCTimestamp},
+					{Tag: tag.BidSpotRate, Name: "BidSpotRate", Type: FieldTypeDecimal},
+					{Tag: tag.OfferSpotRate, Name: "OfferSpotRate", Type: FieldTypeDecimal},
+					{Tag: tag.BidForwardPoints, Name: "BidForwardPoints", Type: FieldTypeDecimal},
+					{Tag: tag.OfferForwardPoints, Name: "OfferForwardPoints", Type: FieldTypeDecimal},
+					{Tag: tag.MidPx, Name: "MidPx", Type: FieldTypeDecimal},
+					{Tag: tag.BidYield, Name: "BidYield", Type: FieldTypeDecimal},
+					{Tag: tag.MidYield, Name: "MidYield", Type: FieldTypeDecimal},
+					{Tag: tag.OfferYield, Name: "OfferYield", Type: FieldTypeDecimal},
+					{Tag: tag.TransactTime, Name: "TransactTime", Type: FieldTypeUTCTimestamp},
+					{Tag: tag.TradingSessionID, Name: "TradingSessionID", Type: FieldTypeString},
+					{Tag: tag.TradingSessionSubID, Name: "TradingSessionSubID", Type: FieldTypeString},
+					{Tag: tag.SettlDate, Name: "SettlDate", Type: FieldTypeString},
+					{Tag: tag.OrdType, Name: "OrdType", Type: FieldTypeString},
+					{Tag: tag.SettlDate2, Name: "SettlDate2", Type: FieldTypeString},
+					{Tag: tag.OrderQty2, Name: "OrderQty2", Type: FieldTypeDecimal},
+					{Tag: tag.BidForwardPoints2, Name: "BidForwardPoints2", Type: FieldTypeDecimal},
+					{Tag: tag.OfferForwardPoints2, Name: "OfferForwardPoints2", Type: FieldTypeDecimal},
+					{Tag: tag.Currency, Name: "Currency", Type: FieldTypeString},
+					{Tag: tag.QuoteEntryRejectReason, Name: "QuoteEntryRejectReason", Type: FieldTypeString},
+				}},
+			}},
+			{Tag: tag.QuoteStatus, Name: "QuoteStatus", Type: FieldTypeString, Required: true},
+			{Tag: tag.QuoteRejectReason, Name: "QuoteRejectReason", Type: FieldTypeString},
+			{Tag: tag.QuoteResponseLevel, Name: "QuoteResponseLevel", Type: FieldTypeString},
+			{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			{Tag: tag.NoPartyIDs, Name: "NoPartyIDs", Type: FieldTypeNumInGroup, Fields: noPartyIDsFields},
+			{Tag: tag.QuoteType, Name: "QuoteType", Type: FieldTypeString},
+			{Tag: tag.AccountType, Name: "AccountType", Type: FieldTypeString},
+			{Tag: tag.AcctIDSource, Name: "AcctIDSource", Type: FieldTypeString},
+		},
+	},
+	"AC": {
+		Name:    "MultilegOrderCancelReplace",
+		MsgType: "AC",
+		Fields: []FieldDef{
+			{Tag: tag.Account, Name: "Account", Type: FieldTypeString},
+			{Tag: tag.ClOrdID, Name: "ClOrdID", Type: FieldTypeString, Required: true},
+			{Tag: tag.Commission, Name: "Commission", Type: FieldTypeDecimal},
+			{Tag: tag.CommType, Name: "CommType", Type: FieldTypeString},
+			{Tag: tag.Currency, Name: "Currency", Type: FieldTypeString},
+			{Tag: tag.ExecInst, Name: "ExecInst", Type: FieldTypeString},
+			{Tag: tag.HandlInst, Name: "HandlInst", Type: FieldTypeString},
+			{Tag: tag.SecurityIDSource, Name: "SecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.IOIID, Name: "IOIID", Type: FieldTypeString},
+			{Tag: tag.OrderID, Name: "OrderID", Type: FieldTypeString},
+			{Tag: tag.OrderQty, Name: "OrderQty", Type: FieldTypeDecimal},
+			{Tag: tag.OrdType, Name: "OrdType", Type: FieldTypeString, Required: true},
+			{Tag: tag.OrigClOrdID, Name: "OrigClOrdID", Type: FieldTypeString, Required: true},
+			{Tag: tag.Price, Name: "Price", Type: FieldTypeDecimal},
+			{Tag: tag.SecurityID, Name: "SecurityID", Type: FieldTypeString},
+			{Tag: tag.Side, Name: "Side", Type: FieldTypeString, Required: true},
+			{Tag: tag.Symbol, Name: "Symbol", Type: FieldTypeString},
+			{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+			{Tag: tag.TimeInForce, Name: "TimeInForce", Type: FieldTypeString},
+			{Tag: tag.TransactTime, Name: "TransactTime", Type: FieldTypeUTCTimestamp, Required: true},
+			{Tag: tag.SettlType, Name: "SettlType", Type: FieldTypeString},
+			{Tag: tag.SettlDate, Name: "SettlDate", Type: FieldTypeString},
+			{Tag: tag.SymbolSfx, Name: "SymbolSfx", Type: FieldTypeString},
+			{Tag: tag.AllocID, Name: "AllocID", Type: FieldTypeString},
+			{Tag: tag.TradeDate, Name: "TradeDate", Type: FieldTypeString},
+			{Tag: tag.PositionEffect, Name: "PositionEffect", Type: FieldTypeString},
+			{Tag: tag.NoAllocs, Name: "NoAllocs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.AllocAccount, Name: "AllocAccount", Type: FieldTypeString, Required: true},
+				{Tag: tag.AllocAcctIDSource, Name: "AllocAcctIDSource", Type: FieldTypeInt},
+				{Tag: tag.AllocSettlCurrency, Name: "AllocSettlCurrency", Type: FieldTypeString},
+				{Tag: tag.IndividualAllocID, Name: "IndividualAllocID", Type: FieldTypeString},
+				{Tag: tag.NoNested3PartyIDs, Name: "NoNested3PartyIDs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+					{Tag: tag.Nested3PartyID, Name: "Nested3PartyID", Type: FieldTypeString, Required: true},
+					{Tag: tag.Nested3PartyIDSource, Name: "Nested3PartyIDSource", Type: FieldTypeString},
+					{Tag: tag.Nested3PartyRole, Name: "Nested3PartyRole", Type: FieldTypeInt},
+					{Tag: tag.NoNested3PartySubIDs, Name: "NoNested3PartySubIDs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+						{Tag: tag.Nested3PartySubID, Name: "Nested3PartySubID", Type: FieldTypeString, Required: true},
+						{Tag: tag.Nested3PartySubIDType, Name: "Nested3PartySubIDType", Type: FieldTypeInt},
+					}},
+				}},
+				{Tag: tag.AllocQty, Name: "AllocQty", Type: FieldTypeDecimal},
+			}},
+			{Tag: tag.ProcessCode, Name: "ProcessCode", Type: FieldTypeString},
+			{Tag: tag.StopPx, Name: "StopPx", Type: FieldTypeDecimal},
+			{Tag: tag.ExDestination, Name: "ExDestination", Type: FieldTypeString},
+			{Tag: tag.Issuer, Name: "Issuer", Type: FieldTypeString},
+			{Tag: tag.SecurityDesc, Name: "SecurityDesc", Type: FieldTypeString},
+			{Tag: tag.MinQty, Name: "MinQty", Type: FieldTypeDecimal},
+			{Tag: tag.MaxFloor, Name: "MaxFloor", Type: FieldTypeDecimal},
+			{Tag: tag.LocateReqd, Name: "LocateReqd", Type: FieldTypeBool},
+			{Tag: tag.QuoteID, Name: "QuoteID", Type: FieldTypeString},
+			{Tag: tag.SettlCurrency, Name: "SettlCurrency", Type: FieldTypeString},
+			{Tag: tag.ForexReq, Name: "ForexReq", Type: FieldTypeBool},
+			{Tag: tag.ExpireTime, Name: "ExpireTime", Type: FieldTypeUTCTimestamp},
+			{Tag: tag.PrevClosePx, Name: "PrevClosePx", Type: FieldTypeDecimal},
+			{Tag: tag.CashOrderQty, Name: "CashOrderQty", Type: FieldTypeDecimal},
+			{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+			{Tag: tag.EffectiveTime, Name: "EffectiveTime", Type: FieldTypeUTCTimestamp},
+			{Tag: tag.MaturityMonthYear, Name: "MaturityMonthYear", Type: FieldTypeString},
+			{Tag: tag.StrikePrice, Name: "StrikePrice", Type: FieldTypeDecimal},
+			{Tag: tag.CoveredOrUncovered, Name: "CoveredOrUncovered", Type: FieldTypeString},
+			{Tag: tag.OptAttribute, Name: "OptAttribute", Type: FieldTypeString},
+			{Tag: tag.SecurityExchange, Name: "SecurityExchange", Type: FieldTypeString},
+			{Tag: tag.MaxShow, Name: "MaxShow", Type: FieldTypeDecimal},
+			{Tag: tag.PegOffsetValue, Name: "PegOffsetValue", Type: FieldTypeDecimal},
+			{Tag: tag.CouponRate, Name: "CouponRate", Type: FieldTypeDecimal},
+			{Tag: tag.CouponPaymentDate, Name: "CouponPaymentDate", Type: FieldTypeString},
+			{Tag: tag.IssueDate, Name: "IssueDate", Type: FieldTypeString},
+			{Tag: tag.RepurchaseTerm, Name: "RepurchaseTerm", Type: FieldTypeInt},
+			{Tag: tag.RepurchaseRate, Name: "RepurchaseRate", Type: FieldTypeDecimal},
+			{Tag: tag.Factor, Name: "Factor", Type: FieldTypeDecimal},
+			{Tag: tag.TradeOriginationDate, Name: "TradeOriginationDate", Type: FieldTypeString},
+			{Tag: tag.ContractMultiplier, Name: "ContractMultiplier", Type: FieldTypeDecimal},
+			{Tag: tag.RepoCollateralSecurityType, Name: "RepoCollateralSecurityType", Type: FieldTypeInt},
+			{Tag: tag.RedemptionDate, Name: "RedemptionDate", Type: FieldTypeString},
+			{Tag: tag.CreditRating, Name: "CreditRating", Type: FieldTypeString},
+			{Tag: tag.EncodedIssuerLen, Name: "EncodedIssuerLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedIssuer, Name: "EncodedIssuer", Type: FieldTypeString},
+			{Tag: tag.EncodedSecurityDescLen, Name: "EncodedSecurityDescLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedSecurityDesc, Name: "EncodedSecurityDesc", Type: FieldTypeString},
+			{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			{Tag: tag.ComplianceID, Name: "ComplianceID", Type: FieldTypeString},
+			{Tag: tag.SolicitedFlag, Name: "SolicitedFlag", Type: FieldTypeBool},
+			{Tag: tag.NoTradingSessions, Name: "NoTradingSessions", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.TradingSessionID, Name: "TradingSessionID", Type: FieldTypeString, Required: true},
+				{Tag: tag.TradingSessionSubID, Name: "TradingSessionSubID", Type: FieldTypeString},
+			}},
+			{Tag: tag.DiscretionInst, Name: "DiscretionInst", Type: FieldTypeString},
+			{Tag: tag.DiscretionOffsetValue, Name: "DiscretionOffsetValue", Type: FieldTypeDecimal},
+			{Tag: tag.PriceType, Name: "PriceType", Type: FieldTypeString},
+			{Tag: tag.GTBookingInst, Name: "GTBookingInst", Type: FieldTypeString},
+			{Tag: tag.ExpireDate, Name: "ExpireDate", Type: FieldTypeString},
+			{Tag: tag.NoPartyIDs, Name: "NoPartyIDs", Type: FieldTypeNumInGroup, Fields: noPartyIDsFields},
+			{Tag: tag.NoSecurityAltID, Name: "NoSecurityAltID", Type: FieldTypeNumInGroup, Fields: noSecurityAltIDFields},
+			{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+			{Tag: tag.CFICode, Name: "CFICode", Type: FieldTypeString},
+			{Tag: tag.RoundingDirection, Name: "RoundingDirection", Type: FieldTypeString},
+			{Tag: tag.RoundingModulus, Name: "RoundingModulus", Type: FieldTypeDecimal},
+			{Tag: tag.CountryOfIssue, Name: "CountryOfIssue", Type: FieldTypeString},
+			{Tag: tag.StateOrProvinceOfIssue, Name: "StateOrProvinceOfIssue", Type: FieldTypeString},
+			{Tag: tag.LocaleOfIssue, Name: "LocaleOfIssue", Type: FieldTypeString},
+			{Tag: tag.CommCurrency, Name: "CommCurrency", Type: FieldTypeString},
+			{Tag: tag.CancellationRights, Name: "CancellationRights", Type: FieldTypeString},
+			{Tag: tag.MoneyLaunderingStatus, Name: "MoneyLaunderingStatus", Type: FieldTypeString},
+			{Tag: tag.Designation, Name: "Designation", Type: FieldTypeString},
+			{Tag: tag.FundRenewWaiv, Name: "FundRenewWaiv", Type: FieldTypeString},
+			{Tag: tag.RegistID, Name: "RegistID", Type: FieldTypeString},
+			{Tag: tag.OrderPercent, Name: "OrderPercent", Type: FieldTypeDecimal},
+			{Tag: tag.SecondaryClOrdID, Name: "SecondaryClOrdID", Type: FieldTypeString},
+			{Tag: tag.OrderCapacity, Name: "OrderCapacity", Type: FieldTypeString},
+			{Tag: tag.OrderRestrictions, Name: "OrderRestrictions", Type: FieldTypeString},
+			{Tag: tag.MaturityDate, Name: "MaturityDate", Type: FieldTypeString},
+			{Tag: tag.InstrRegistry, Name: "InstrRegistry", Type: FieldTypeString},
+			{Tag: tag.CashMargin, Name: "CashMargin", Type: FieldTypeString},
+			{Tag: tag.NoLegs, Name: "NoLegs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.LegSymbol, Name: "LegSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.LegSymbolSfx, Name: "LegSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.LegSecurityID, Name: "LegSecurityID", Type: FieldTypeString},
+				{Tag: tag.LegSecurityIDSource, Name: "LegSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoLegSecurityAltID, Name: "NoLegSecurityAltID", Type: FieldTypeNumInGroup, Fields: noLegSecurityAltIDFields},
+				{Tag: tag.LegProduct, Name: "LegProduct", Type: FieldTypeInt},
+				{Tag: tag.LegCFICode, Name: "LegCFICode", Type: FieldTypeString},
+				{Tag: tag.LegSecurityType, Name: "LegSecurityType", Type: FieldTypeString},
+				{Tag: tag.LegSecuritySubType, Name: "LegSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.LegMaturityMonthYear, Name: "LegMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.LegMaturityDate, Name: "LegMaturityDate", Type: FieldTypeString},
+				{Tag: tag.LegCouponPaymentDate, Name: "LegCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.LegIssueDate, Name: "LegIssueDate", Type: FieldTypeString},
+				{Tag: tag.LegRepoCollateralSecurityType, Name: "LegRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseTerm, Name: "LegRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseRate, Name: "LegRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegFactor, Name: "LegFactor", Type: FieldTypeDecimal},
+				{Tag: tag.LegCreditRating, Name: "LegCreditRating", Type: FieldTypeString},
+				{Tag: tag.LegInstrRegistry, Name: "LegInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.LegCountryOfIssue, Name: "LegCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegStateOrProvinceOfIssue, Name: "LegStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegLocaleOfIssue, Name: "LegLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegRedemptionDate, Name: "LegRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.LegStrikePrice, Name: "LegStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.LegStrikeCurrency, Name: "LegStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.LegOptAttribute, Name: "LegOptAttribute", Type: FieldTypeString},
+				{Tag: tag.LegContractMultiplier, Name: "LegContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.LegCouponRate, Name: "LegCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegSecurityExchange, Name: "LegSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.LegIssuer, Name: "LegIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedLegIssuerLen, Name: "EncodedLegIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegIssuer, Name: "EncodedLegIssuer", Type: FieldTypeString},
+				{Tag: tag.LegSecurityDesc, Name: "LegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedLegSecurityDescLen, Name: "EncodedLegSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegSecurityDesc, Name: "EncodedLegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.LegRatioQty, Name: "LegRatioQty", Type: FieldTypeDecimal},
+				{Tag: tag.LegSide, Name: "LegSide", Type: FieldTypeString},
+				{Tag: tag.LegCurrency, Name: "LegCurrency", Type: FieldTypeString},
+				{Tag: tag.LegPool, Name: "LegPool", Type: FieldTypeString},
+				{Tag: tag.LegDatedDate, Name: "LegDatedDate", Type: FieldTypeString},
+				{Tag: tag.LegContractSettlMonth, Name: "LegContractSettlMonth", Type: FieldTypeString},
+				{Tag: tag.LegInterestAccrualDate, Name: "LegInterestAccrualDate", Type: FieldTypeString},
+				{Tag: tag.LegQty, Name: "LegQty", Type: FieldTypeDecimal},
+				{Tag: tag.LegSwapType, Name: "LegSwapType", Type: FieldTypeString},
+				{Tag: tag.NoLegStipulations, Name: "NoLegStipulations", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+					{Tag: tag.LegStipulationType, Name: "LegStipulationType", Type: FieldTypeString, Required: true},
+					{Tag: tag.LegStipulationValue, Name: "LegStipulationValue", Type: FieldTypeString},
+				}},
+				{Tag: tag.NoLegAllocs, Name: "NoLegAllocs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+					{Tag: tag.LegAllocAccount, Name: "LegAllocAccount", Type: FieldTypeString, Required: true},
+					{Tag: tag.LegIndividualAllocID, Name: "LegIndividualAllocID", Type: FieldTypeString},
+					{Tag: tag.NoNested2PartyIDs, Name: "NoNested2PartyIDs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+						{Tag: tag.Nested2PartyID, Name: "Nested2PartyID", Type: FieldTypeString, Required: true},
+						{Tag: tag.Nested2PartyIDSource, Name: "Nested2PartyIDSource", Type: FieldTypeString},
+						{Tag: tag.Nested2PartyRole, Name: "Nested2PartyRole", Type: FieldTypeInt},
+						{Tag: tag.NoNested2PartySubIDs, Name: "NoNested2PartySubIDs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+							{Tag: tag.Nested2PartySubID, Name: "Nested2PartySubID", Type: FieldTypeString, Required: true},
+							{Tag: tag.Nested2PartySubIDType, Name: "Nested2PartySubIDType", Type: FieldTypeInt},
+						}},
+					}},
+					{Tag: tag.LegAllocQty, Name: "LegAllocQty", Type: FieldTypeDecimal},
+					{Tag: tag.LegAllocAcctIDSource, Name: "LegAllocAcctIDSource", Type: FieldTypeString},
+					{Tag: tag.LegSettlCurrency, Name: "LegSettlCurrency", Type: FieldTypeString},
+				}},
+				{Tag: tag.LegPositionEffect, Name: "LegPositionEffect", Type: FieldTypeString},
+				{Tag: tag.LegCoveredOrUncovered, Name: "LegCoveredOrUncovered", Type: FieldTypeInt},
+				{Tag: tag.NoNestedPartyIDs, Name: "NoNestedPartyIDs", Type: FieldTypeNumInGroup, Fields: noNestedPartyIDsFields},
+				{Tag: tag.LegRefID, Name: "LegRefID", Type: FieldTypeString},
+				{Tag: tag.LegPrice, Name: "LegPrice", Type: FieldTypeDecimal},
+				{Tag: tag.LegSettlType, Name: "LegSettlType", Type: FieldTypeString},
+				{Tag: tag.LegSettlDate, Name: "LegSettlDate", Type: FieldTypeString},
+			}},
+			{Tag: tag.MultiLegRptTypeReq, Name: "MultiLegRptTypeReq", Type: FieldTypeString},
+			{Tag: tag.AccountType, Name: "AccountType", Type: FieldTypeString},
+			{Tag: tag.CustOrderCapacity, Name: "CustOrderCapacity", Type: FieldTypeString},
+			{Tag: tag.ClOrdLinkID, Name: "ClOrdLinkID", Type: FieldTypeString},
+			{Tag: tag.OrigOrdModTime, Name: "OrigOrdModTime", Type: FieldTypeUTCTimestamp},
+			{Tag: tag.DayBookingInst, Name: "DayBookingInst", Type: FieldTypeString},
+			{Tag: tag.BookingUnit, Name: "BookingUnit", Type: FieldTypeString},
+			{Tag: tag.PreallocMethod, Name: "PreallocMethod", Type: FieldTypeString},
+			{Tag: tag.ClearingFeeIndicator, Name: "ClearingFeeIndicator", Type: FieldTypeString},
+			{Tag: tag.AcctIDSource, Name: "AcctIDSource", Type: FieldTypeString},
+			{Tag: tag.ContractSettlMonth, Name: "ContractSettlMonth", Type: FieldTypeString},
+			{Tag: tag.Pool, Name: "Pool", Type: FieldTypeString},
+			{Tag: tag.NoUnderlyings, Name: "NoUnderlyings", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.UnderlyingSymbol, Name: "UnderlyingSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.UnderlyingSymbolSfx, Name: "UnderlyingSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityID, Name: "UnderlyingSecurityID", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityIDSource, Name: "UnderlyingSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoUnderlyingSecurityAltID, Name: "NoUnderlyingSecurityAltID", Type: FieldTypeNumInGroup, Fields: noUnderlyingSecurityAltIDFields},
+				{Tag: tag.UnderlyingProduct, Name: "UnderlyingProduct", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingCFICode, Name: "UnderlyingCFICode", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityType, Name: "UnderlyingSecurityType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecuritySubType, Name: "UnderlyingSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityMonthYear, Name: "UnderlyingMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityDate, Name: "UnderlyingMaturityDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCouponPaymentDate, Name: "UnderlyingCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssueDate, Name: "UnderlyingIssueDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRepoCollateralSecurityType, Name: "UnderlyingRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseTerm, Name: "UnderlyingRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseRate, Name: "UnderlyingRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingFactor, Name: "UnderlyingFactor", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCreditRating, Name: "UnderlyingCreditRating", Type: FieldTypeString},
+				{Tag: tag.UnderlyingInstrRegistry, Name: "UnderlyingInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCountryOfIssue, Name: "UnderlyingCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStateOrProvinceOfIssue, Name: "UnderlyingStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingLocaleOfIssue, Name: "UnderlyingLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRedemptionDate, Name: "UnderlyingRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStrikePrice, Name: "UnderlyingStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStrikeCurrency, Name: "UnderlyingStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingOptAttribute, Name: "UnderlyingOptAttribute", Type: FieldTypeString},
+				{Tag: tag.UnderlyingContractMultiplier, Name: "UnderlyingContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCouponRate, Name: "UnderlyingCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingSecurityExchange, Name: "UnderlyingSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssuer, Name: "UnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingIssuerLen, Name: "EncodedUnderlyingIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingIssuer, Name: "EncodedUnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityDesc, Name: "UnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingSecurityDescLen, Name: "EncodedUnderlyingSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingSecurityDesc, Name: "EncodedUnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPProgram, Name: "UnderlyingCPProgram", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPRegType, Name: "UnderlyingCPRegType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCurrency, Name: "UnderlyingCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingQty, Name: "UnderlyingQty", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingPx, Name: "UnderlyingPx", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingDirtyPrice, Name: "UnderlyingDirtyPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndPrice, Name: "UnderlyingEndPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStartValue, Name: "UnderlyingStartValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCurrentValue, Name: "UnderlyingCurrentValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndValue, Name: "UnderlyingEndValue", Type: FieldTypeDecimal},
+				{Tag: tag.NoUnderlyingStips, Name: "NoUnderlyingStips", Type: FieldTypeNumInGroup, Fields: noUnderlyingStipsFields},
+			}},
+			{Tag: tag.SecuritySubType, Name: "SecuritySubType", Type: FieldTypeString},
+			{Tag: tag.BookingType, Name: "BookingType", Type: FieldTypeString},
+			{Tag: tag.PegMoveType, Name: "PegMoveType", Type: FieldTypeString},
+			{Tag: tag.PegOffsetType, Name: "PegOffsetType", Type: FieldTypeString},
+			{Tag: tag.PegLimitType, Name: "PegLimitType", Type: FieldTypeString},
+			{Tag: tag.PegRoundDirection, Name: "PegRoundDirection", Type: FieldTypeString},
+			{Tag: tag.PegScope, Name: "PegScope", Type: FieldTypeString},
+			{Tag: tag.DiscretionMoveType, Name: "DiscretionMoveType", Type: FieldTypeString},
+			{Tag: tag.DiscretionOffsetType, Name: "DiscretionOffsetType", Type: FieldTypeString},
+			{Tag: tag.DiscretionLimitType, Name: "DiscretionLimitType", Type: FieldTypeString},
+			{Tag: tag.DiscretionRoundDirection, Name: "DiscretionRoundDirection", Type: FieldTypeString},
+			{Tag: tag.DiscretionScope, Name: "DiscretionScope", Type: FieldTypeString},
+			{Tag: tag.TargetStrategy, Name: "TargetStrategy", Type: FieldTypeString},
+			{Tag: tag.TargetStrategyParameters, Name: "TargetStrategyParameters", Type: FieldTypeString},
+			{Tag: tag.ParticipationRate, Name: "ParticipationRate", Type: FieldTypeDecimal},
+			{Tag: tag.QtyType, Name: "QtyType", Type: FieldTypeString},
+			{Tag: tag.NoEvents, Name: "NoEvents", Type: FieldTypeNumInGroup, Fields: noEventsFields},
+			{Tag: tag.DatedDate, Name: "DatedDate", Type: FieldTypeString},
+			{Tag: tag.InterestAccrualDate, Name: "InterestAccrualDate", Type: FieldTypeString},
+			{Tag: tag.CPProgram, Name: "CPProgram", Type: FieldTypeString},
+			{Tag: tag.CPRegType, Name: "CPRegType", Type: FieldTypeString},
+			{Tag: tag.StrikeCurrency, Name: "StrikeCurrency", Type: FieldTypeString},
+		},
+	},
+	"BC": {
+		Name:    "NetworkCounterpartySystemStatusRequest",
+		MsgType: "BC",
+		Fields: []FieldDef{
+			{Tag: tag.NetworkRequestID, Name: "NetworkRequestID", Type: FieldTypeString, Required: true},
+			{Tag: tag.NetworkRequestType, Name: "NetworkRequestType", Type: FieldTypeString, Required: true},
+			{Tag: tag.NoCompIDs, Name: "NoCompIDs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.RefCompID, Name: "RefCompID", Type: FieldTypeString, Required: true},
+				{Tag: tag.RefSubID, Name: "RefSubID", Type: FieldTypeString},
+				{Tag: tag.LocationID, Name: "LocationID", Type: FieldTypeString},
+				{Tag: tag.DeskID, Name: "DeskID", Type: FieldTypeString},
+			}},
+		},
+	},
+	"BD": {
+		Name:    "NetworkCounterpartySystemStatusResponse",
+		MsgType: "BD",
+		Fields: []FieldDef{
+			{Tag: tag.NetworkResponseID, Name: "NetworkResponseID", Type: FieldTypeString, Required: true},
+			{Tag: tag.NetworkRequestID, Name: "NetworkRequestID", Type: FieldTypeString},
+			{Tag: tag.LastNetworkResponseID, Name: "LastNetworkResponseID", Type: FieldTypeString},
+			{Tag: tag.NoCompIDs, Name: "NoCompIDs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.RefCompID, Name: "RefCompID", Type: FieldTypeString, Required: true},
+				{Tag: tag.RefSubID, Name: "RefSubID", Type: FieldTypeString},
+				{Tag: tag.LocationID, Name: "LocationID", Type: FieldTypeString},
+				{Tag: tag.DeskID, Name: "DeskID", Type: FieldTypeString},
+				{Tag: tag.StatusValue, Name: "StatusValue", Type: FieldTypeString},
+				{Tag: tag.StatusText, Name: "StatusText", Type: FieldTypeString},
+			}},
+			{Tag: tag.NetworkStatusResponseType, Name: "NetworkStatusResponseType", Type: FieldTypeString, Required: true},
+		},
+	},
+	"s": {
+		Name:    "NewOrderCross",
+		MsgType: "s",
+		Fields: []FieldDef{
+			{Tag: tag.Currency, Name: "Currency", Type: FieldTypeString},
+			{Tag: tag.ExecInst, Name: "ExecInst", Type: FieldTypeString},
+			{Tag: tag.HandlInst, Name: "HandlInst", Type: FieldTypeString},
+			{Tag: tag.SecurityIDSource, Name: "SecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.IOIID, Name: "IOIID", Type: FieldTypeString},
+			{Tag: tag.OrdType, Name: "OrdType", Type: FieldTypeString, Required: true},
+			{Tag: tag.Price, Name: "Price", Type: FieldTypeDecimal},
+			{Tag: tag.SecurityID, Name: "SecurityID", Type: FieldTypeString},
+			{Tag: tag.Symbol, Name: "Symbol", Type: FieldTypeString},
+			{Tag: tag.TimeInForce, Name: "TimeInForce", Type: FieldTypeString},
+			{Tag: tag.TransactTime, Name: "TransactTime", Type: FieldTypeUTCTimestamp, Required: true},
+			{Tag: tag.SettlType, Name: "SettlType", Type: FieldTypeString},
+			{Tag: tag.SettlDate, Name: "SettlDate", Type: FieldTypeString},
+			{Tag: tag.SymbolSfx, Name: "SymbolSfx", Type: FieldTypeString},
+			{Tag: tag.ProcessCode, Name: "ProcessCode", Type: FieldTypeString},
+			{Tag: tag.StopPx, Name: "StopPx", Type: FieldTypeDecimal},
+			{Tag: tag.ExDestination, Name: "ExDestination", Type: FieldTypeString},
+			{Tag: tag.Issuer, Name: "Issuer", Type: FieldTypeString},
+			{Tag: tag.SecurityDesc, Name: "SecurityDesc", Type: FieldTypeString},
+			{Tag: tag.MinQty, Name: "MinQty", Type: FieldTypeDecimal},
+			{Tag: tag.MaxFloor, Name: "MaxFloor", Type: FieldTypeDecimal},
+			{Tag: tag.LocateReqd, Name: "LocateReqd", Type: FieldTypeBool},
+			{Tag: tag.QuoteID, Name: "QuoteID", Type: FieldTypeString},
+			{Tag: tag.ExpireTime, Name: "ExpireTime", Type: FieldTypeUTCTimestamp},
+			{Tag: tag.PrevClosePx, Name: "PrevClosePx", Type: FieldTypeDecimal},
+			{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+			{Tag: tag.EffectiveTime, Name: "EffectiveTime", Type: FieldTypeUTCTimestamp},
+			{Tag: tag.MaturityMonthYear, Name: "MaturityMonthYear", Type: FieldTypeString},
+			{Tag: tag.StrikePrice, Name: "StrikePrice", Type: FieldTypeDecimal},
+			{Tag: tag.OptAttribute, Name: "OptAttribute", Type: FieldTypeString},
+			{Tag: tag.SecurityExchange, Name: "SecurityExchange", Type: FieldTypeString},
+			{Tag: tag.MaxShow, Name: "MaxShow", Type: FieldTypeDecimal},
+			{Tag: tag.PegOffsetValue, Name: "PegOffsetValue", Type: FieldTypeDecimal},
+			{Tag: tag.Spread, Name: "Spread", Type: FieldTypeDecimal},
+			{Tag: tag.BenchmarkCurveCurrency, Name: "BenchmarkCurveCurrency", Type: FieldTypeString},
+			{Tag: tag.BenchmarkCurveName, Name: "BenchmarkCurveName", Type: FieldTypeString},
+			{Tag: tag.BenchmarkCurvePoint, Name: "BenchmarkCurvePoint", Type: FieldTypeString},
+			{Tag: tag.CouponRate, Name: "CouponRate", Type: FieldTypeDecimal},
+			{Tag: tag.CouponPaymentDate, Name: "CouponPaymentDate", Type: FieldTypeString},
+			{Tag: tag.IssueDate, Name: "IssueDate", Type: FieldTypeString},
+			{Tag: tag.RepurchaseTerm, Name: "RepurchaseTerm", Type: FieldTypeInt},
+			{Tag: tag.RepurchaseRate, Name: "RepurchaseRate", Type: FieldTypeDecimal},
+			{Tag: tag.Factor, Name: "Factor", Type: FieldTypeDecimal},
+			{Tag: tag.ContractMultiplier, Name: "ContractMultiplier", Type: FieldTypeDecimal},
+			{Tag: tag.NoStipulations, Name: "NoStipulations", Type: FieldTypeNumInGroup, Fields: noStipulationsFields},
+			{Tag: tag.YieldType, Name: "YieldType", Type: FieldTypeString},
+			{Tag: tag.Yield, Name: "Yield", Type: FieldTypeDecimal},
+			{Tag: tag.RepoCollateralSecurityType, Name: "RepoCollateralSecurityType", Type: FieldTypeInt},
+			{Tag: tag.RedemptionDate, Name: "RedemptionDate", Type: FieldTypeString},
+			{Tag: tag.CreditRating, Name: "CreditRating", Type: FieldTypeString},
+			{Tag: tag.EncodedIssuerLen, Name: "EncodedIssuerLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedIssuer, Name: "EncodedIssuer", Type: FieldTypeString},
+			{Tag: tag.EncodedSecurityDescLen, Name: "EncodedSecurityDescLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedSecurityDesc, Name: "EncodedSecurityDesc", Type: FieldTypeString},
+			{Tag: tag.ComplianceID, Name: "ComplianceID", Type: FieldTypeString},
+			{Tag: tag.NoTradingSessions, Name: "NoTradingSessions", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.TradingSessionID, Name: "TradingSessionID", Type: FieldTypeString, Required: true},
+				{Tag: tag.TradingSessionSubID, Name: "TradingSessionSubID", Type: FieldTypeString},
+			}},
+			{Tag: tag.DiscretionInst, Name: "DiscretionInst", Type: FieldTypeString},
+			{Tag: tag.DiscretionOffsetValue, Name: "DiscretionOffsetValue", Type: FieldTypeDecimal},
+			{Tag: tag.PriceType, Name: "PriceType", Type: FieldTypeString},
+			{Tag: tag.GTBookingInst, Name: "GTBookingInst", Type: FieldTypeString},
+			{Tag: tag.ExpireDate, Name: "ExpireDate", Type: FieldTypeString},
+			{Tag: tag.NoSecurityAltID, Name: "NoSecurityAltID", Type: FieldTypeNumInGroup, Fields: noSecurityAltIDFields},
+			{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+			{Tag: tag.CFICode, Name: "CFICode", Type: FieldTypeString},
+			{Tag: tag.CountryOfIssue, Name: "CountryOfIssue", Type: FieldTypeString},
+			{Tag: tag.StateOrProvinceOfIssue, Name: "StateOrProvinceOfIssue", Type: FieldTypeString},
+			{Tag: tag.LocaleOfIssue, Name: "LocaleOfIssue", Type: FieldTypeString},
+			{Tag: tag.CancellationRights, Name: "CancellationRights", Type: FieldTypeString},
+			{Tag: tag.MoneyLaunderingStatus, Name: "MoneyLaunderingStatus", Type: FieldTypeString},
+			{Tag: tag.Designation, Name: "Designation", Type: FieldTypeString},
+			{Tag: tag.RegistID, Name: "RegistID", Type: FieldTypeString},
+			{Tag: tag.MaturityDate, Name: "MaturityDate", Type: FieldTypeString},
+			{Tag: tag.InstrRegistry, Name: "InstrRegistry", Type: FieldTypeString},
+			{Tag: tag.CrossID, Name: "CrossID", Type: FieldTypeString, Required: true},
+			{Tag: tag.CrossType, Name: "CrossType", Type: FieldTypeString, Required: true},
+			{Tag: tag.CrossPrioritization, Name: "CrossPrioritization", Type: FieldTypeString, Required: true},
+			{Tag: tag.NoSides, Name: "NoSides", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.Side, Name: "Side", Type: FieldTypeString, Required: true},
+				{Tag: tag.ClOrdID, Name: "ClOrdID", Type: FieldTypeString},
+				{Tag: tag.SecondaryClOrdID, Name: "SecondaryClOrdID", Type: FieldTypeString},
+				{Tag: tag.ClOrdLinkID, Name: "ClOrdLinkID", Type: FieldTypeString},
+				{Tag: tag.NoPartyIDs, Name: "NoPartyIDs", Type: FieldTypeNumInGroup, Fields: noPartyIDsFields},
+				{Tag: tag.TradeOriginationDate, Name: "TradeOriginationDate", Type: FieldTypeString},
+				{Tag: tag.TradeDate, Name: "TradeDate", Type: FieldTypeString},
+				{Tag: tag.Account, Name: "Account", Type: FieldTypeString},
+				{Tag: tag.AcctIDSource, Name: "AcctIDSource", Type: FieldTypeString},
+				{Tag: tag.AccountType, Name: "AccountType", Type: FieldTypeString},
+				{Tag: tag.DayBookingInst, Name: "DayBookingInst", Type: FieldTypeString},
+				{Tag: tag.BookingUnit, Name: "BookingUnit", Type: FieldTypeString},
+				{Tag: tag.PreallocMethod, Name: "PreallocMethod", Type: FieldTypeString},
+				{Tag: tag.AllocID, Name: "AllocID", Type: FieldTypeString},
+				{Tag: tag.NoAllocs, Name: "NoAllocs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+					{Tag: tag.AllocAccount, Name: "AllocAccount", Type: FieldTypeString, Required: true},
+					{Tag: tag.AllocAcctIDSource, Name: "AllocAcctIDSource", Type: FieldTypeInt},
+					{Tag: tag.AllocSettlCurrency, Name: "AllocSettlCurrency", Type: FieldTypeString},
+					{Tag: tag.IndividualAllocID, Name: "IndividualAllocID", Type: FieldTypeString},
+					{Tag: tag.NoNestedPartyIDs, Name: "NoNestedPartyIDs", Type: FieldTypeNumInGroup, Fields: noNestedPartyIDsFields},
+					{Tag: tag.AllocQty, Name: "AllocQty", Type: FieldTypeDecimal},
+				}},
+				{Tag: tag.QtyType, Name: "QtyType", Type: FieldTypeString},
+				{Tag: tag.OrderQty, Name: "OrderQty", Type: FieldTypeDecimal},
+				{Tag: tag.CashOrderQty, Name: "CashOrderQty", Type: FieldTypeDecimal},
+				{Tag: tag.OrderPercent, Name: "OrderPercent", Type: FieldTypeDecimal},
+				{Tag: tag.RoundingDirection, Name: "RoundingDirection", Type: FieldTypeString},
+				{Tag: tag.RoundingModulus, Name: "RoundingModulus", Type: FieldTypeDecimal},
+				{Tag: tag.Commission, Name: "Commission", Type: FieldTypeDecimal},
+				{Tag: tag.CommType, Name: "CommType", Type: FieldTypeString},
+				{Tag: tag.CommCurrency, Name: "CommCurrency", Type: FieldTypeString},
+				{Tag: tag.FundRenewWaiv, Name: "FundRenewWaiv", Type: FieldTypeString},
+				{Tag: tag.OrderCapacity, Name: "OrderCapacity", Type: FieldTypeString},
+				{Tag: tag.OrderRestrictions, Name: "OrderRestrictions", Type: FieldTypeString},
+				{Tag: tag.CustOrderCapacity, Name: "CustOrderCapacity", Type: FieldTypeString},
+				{Tag: tag.ForexReq, Name: "ForexReq", Type: FieldTypeBool},
+				{Tag: tag.SettlCurrency, Name: "SettlCurrency", Type: FieldTypeString},
+				{Tag: tag.BookingType, Name: "BookingType", Type: FieldTypeString},
+				{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+				{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+				{Tag: tag.PositionEffect, Name: "PositionEffect", Type: FieldTypeString},
+				{Tag: tag.CoveredOrUncovered, Name: "CoveredOrUncovered", Type: FieldTypeString},
+				{Tag: tag.CashMargin, Name: "CashMargin", Type: FieldTypeString},
+				{Tag: tag.ClearingFeeIndicator, Name: "ClearingFeeIndicator", Type: FieldTypeString},
+				{Tag: tag.SolicitedFlag, Name: "SolicitedFlag", Type: FieldTypeBool},
+				{Tag: tag.SideComplianceID, Name: "SideComplianceID", Type: FieldTypeString},
+			}},
+			{Tag: tag.NoLegs, Name: "NoLegs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.LegSymbol, Name: "LegSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.LegSymbolSfx, Name: "LegSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.LegSecurityID, Name: "LegSecurityID", Type: FieldTypeString},
+				{Tag: tag.LegSecurityIDSource, Name: "LegSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoLegSecurityAltID, Name: "NoLegSecurityAltID", Type: FieldTypeNumInGroup, Fields: noLegSecurityAltIDFields},
+				{Tag: tag.LegProduct, Name: "LegProduct", Type: FieldTypeInt},
+				{Tag: tag.LegCFICode, Name: "LegCFICode", Type: FieldTypeString},
+				{Tag: tag.LegSecurityType, Name: "LegSecurityType", Type: FieldTypeString},
+				{Tag: tag.LegSecuritySubType, Name: "LegSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.LegMaturityMonthYear, Name: "LegMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.LegMaturityDate, Name: "LegMaturityDate", Type: FieldTypeString},
+				{Tag: tag.LegCouponPaymentDate, Name: "LegCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.LegIssueDate, Name: "LegIssueDate", Type: FieldTypeString},
+				{Tag: tag.LegRepoCollateralSecurityType, Name: "LegRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseTerm, Name: "LegRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseRate, Name: "LegRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegFactor, Name: "LegFactor", Type: FieldTypeDecimal},
+				{Tag: tag.LegCreditRating, Name: "LegCreditRating", Type: FieldTypeString},
+				{Tag: tag.LegInstrRegistry, Name: "LegInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.LegCountryOfIssue, Name: "LegCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegStateOrProvinceOfIssue, Name: "LegStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegLocaleOfIssue, Name: "LegLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegRedemptionDate, Name: "LegRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.LegStrikePrice, Name: "LegStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.LegStrikeCurrency, Name: "LegStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.LegOptAttribute, Name: "LegOptAttribute", Type: FieldTypeString},
+				{Tag: tag.LegContractMultiplier, Name: "LegContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.LegCouponRate, Name: "LegCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegSecurityExchange, Name: "LegSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.LegIssuer, Name: "LegIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedLegIssuerLen, Name: "EncodedLegIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegIssuer, Name: "EncodedLegIssuer", Type: FieldTypeString},
+				{Tag: tag.LegSecurityDesc, Name: "LegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedLegSecurityDescLen, Name: "EncodedLegSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegSecurityDesc, Name: "EncodedLegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.LegRatioQty, Name: "LegRatioQty", Type: FieldTypeDecimal},
+				{Tag: tag.LegSide, Name: "LegSide", Type: FieldTypeString},
+				{Tag: tag.LegCurrency, Name: "LegCurrency", Type: FieldTypeString},
+				{Tag: tag.LegPool, Name: "LegPool", Type: FieldTypeString},
+				{Tag: tag.LegDatedDate, Name: "LegDatedDate", Type: FieldTypeString},
+				{Tag: tag.LegContractSettlMonth, Name: "LegContractSettlMonth", Type: FieldTypeString},
+				{Tag: tag.LegInterestAccrualDate, Name: "LegInterestAccrualDate", Type: FieldTypeString},
+			}},
+			{Tag: tag.BenchmarkPrice, Name: "BenchmarkPrice", Type: FieldTypeDecimal},
+			{Tag: tag.BenchmarkPriceType, Name: "BenchmarkPriceType", Type: FieldTypeInt},
+			{Tag: tag.ContractSettlMonth, Name: "ContractSettlMonth", Type: FieldTypeString},
+			{Tag: tag.Pool, Name: "Pool", Type: FieldTypeString},
+			{Tag: tag.YieldRedemptionDate, Name: "YieldRedemptionDate", Type: FieldTypeString},
+			{Tag: tag.YieldRedemptionPrice, Name: "YieldRedemptionPrice", Type: FieldTypeDecimal},
+			{Tag: tag.YieldRedemptionPriceType, Name: "YieldRedemptionPriceType", Type: FieldTypeInt},
+			{Tag: tag.BenchmarkSecurityID, Name: "BenchmarkSecurityID", Type: FieldTypeString},
+			{Tag: tag.YieldCalcDate, Name: "YieldCalcDate", Type: FieldTypeString},
+			{Tag: tag.NoUnderlyings, Name: "NoUnderlyings", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.UnderlyingSymbol, Name: "UnderlyingSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.UnderlyingSymbolSfx, Name: "UnderlyingSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityID, Name: "UnderlyingSecurityID", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityIDSource, Name: "UnderlyingSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoUnderlyingSecurityAltID, Name: "NoUnderlyingSecurityAltID", Type: FieldTypeNumInGroup, Fields: noUnderlyingSecurityAltIDFields},
+				{Tag: tag.UnderlyingProduct, Name: "UnderlyingProduct", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingCFICode, Name: "UnderlyingCFICode", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityType, Name: "UnderlyingSecurityType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecuritySubType, Name: "UnderlyingSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityMonthYear, Name: "UnderlyingMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityDate, Name: "UnderlyingMaturityDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCouponPaymentDate, Name: "UnderlyingCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssueDate, Name: "UnderlyingIssueDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRepoCollateralSecurityType, Name: "UnderlyingRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseTerm, Name: "UnderlyingRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseRate, Name: "UnderlyingRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingFactor, Name: "UnderlyingFactor", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCreditRating, Name: "UnderlyingCreditRating", Type: FieldTypeString},
+				{Tag: tag.UnderlyingInstrRegistry, Name: "UnderlyingInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCountryOfIssue, Name: "UnderlyingCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStateOrProvinceOfIssue, Name: "UnderlyingStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingLocaleOfIssue, Name: "UnderlyingLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRedemptionDate, Name: "UnderlyingRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStrikePrice, Name: "UnderlyingStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStrikeCurrency, Name: "UnderlyingStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingOptAttribute, Name: "UnderlyingOptAttribute", Type: FieldTypeString},
+				{Tag: tag.UnderlyingContractMultiplier, Name: "UnderlyingContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCouponRate, Name: "UnderlyingCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingSecurityExchange, Name: "UnderlyingSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssuer, Name: "UnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingIssuerLen, Name: "EncodedUnderlyingIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingIssuer, Name: "EncodedUnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityDesc, Name: "UnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingSecurityDescLen, Name: "EncodedUnderlyingSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingSecurityDesc, Name: "EncodedUnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPProgram, Name: "UnderlyingCPProgram", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPRegType, Name: "UnderlyingCPRegType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCurrency, Name: "UnderlyingCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingQty, Name: "UnderlyingQty", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingPx, Name: "UnderlyingPx", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingDirtyPrice, Name: "UnderlyingDirtyPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndPrice, Name: "UnderlyingEndPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStartValue, Name: "UnderlyingStartValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCurrentValue, Name: "UnderlyingCurrentValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndValue, Name: "UnderlyingEndValue", Type: FieldTypeDecimal},
+				{Tag: tag.NoUnderlyingStips, Name: "NoUnderlyingStips", Type: FieldTypeNumInGroup, Fields: noUnderlyingStipsFields},
+			}},
+			{Tag: tag.BenchmarkSecurityIDSource, Name: "BenchmarkSecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.SecuritySubType, Name: "SecuritySubType", Type: FieldTypeString},
+			{Tag: tag.PegMoveType, Name: "PegMoveType", Type: FieldTypeString},
+			{Tag: tag.PegOffsetType, Name: "PegOffsetType", Type: FieldTypeString},
+			{Tag: tag.PegLimitType, Name: "PegLimitType", Type: FieldTypeString},
+			{Tag: tag.PegRoundDirection, Name: "PegRoundDirection", Type: FieldTypeString},
+			{Tag: tag.PegScope, Name: "PegScope", Type: FieldTypeString},
+			{Tag: tag.DiscretionMoveType, Name: "DiscretionMoveType", Type: FieldTypeString},
+			{Tag: tag.DiscretionOffsetType, Name: "DiscretionOffsetType", Type: FieldTypeString},
+			{Tag: tag.DiscretionLimitType, Name: "DiscretionLimitType", Type: FieldTypeString},
+			{Tag: tag.DiscretionRoundDirection, Name: "DiscretionRoundDirection", Type: FieldTypeString},
+			{Tag: tag.DiscretionScope, Name: "DiscretionScope", Type: FieldTypeString},
+			{Tag: tag.TargetStrategy, Name: "TargetStrategy", Type: FieldTypeString},
+			{Tag: tag.TargetStrategyParameters, Name: "TargetStrategyParameters", Type: FieldTypeString},
+			{Tag: tag.ParticipationRate, Name: "ParticipationRate", Type: FieldTypeDecimal},
+			{Tag: tag.NoEvents, Name: "NoEvents", Type: FieldTypeNumInGroup, Fields: noEventsFields},
+			{Tag: tag.DatedDate, Name: "DatedDate", Type: FieldTypeString},
+			{Tag: tag.InterestAccrualDate, Name: "InterestAccrualDate", Type: FieldTypeString},
+			{Tag: tag.CPProgram, Name: "CPProgram", Type: FieldTypeString},
+			{Tag: tag.CPRegType, Name: "CPRegType", Type: FieldTypeString},
+			{Tag: tag.StrikeCurrency, Name: "StrikeCurrency", Type: FieldTypeString},
+		},
+	},
+	"E": {
+		Name:    "NewOrderList",
+		MsgType: "E",
+		Fields: []FieldDef{
+			{Tag: tag.ListID, Name: "ListID", Type: FieldTypeString, Required: true},
+			{Tag: tag.TotNoOrders, Name: "TotNoOrders", Type: FieldTypeInt, Required: true},
+			{Tag: tag.ListExecInst, Name: "ListExecInst", Type: FieldTypeString},
+			{Tag: tag.NoOrders, Name: "NoOrders", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.ClOrdID, Name: "ClOrdID", Type: FieldTypeString, Required: true},
+				{Tag: tag.SecondaryClOrdID, Name: "SecondaryClOrdID", Type: FieldTypeString},
+				{Tag: tag.ListSeqNo, Name: "ListSeqNo", Type: FieldTypeInt},
+				{Tag: tag.ClOrdLinkID, Name: "ClOrdLinkID", Type: FieldTypeString},
+				{Tag: tag.SettlInstMode, Name: "SettlInstMode", Type: FieldTypeString},
+				{Tag: tag.NoPartyIDs, Name: "NoPartyIDs", Type: FieldTypeNumInGroup, Fields: noPartyIDsFields},
+				{Tag: tag.TradeOriginationDate, Name: "TradeOriginationDate", Type: FieldTypeString},
+				{Tag: tag.TradeDate, Name: "TradeDate", Type: FieldTypeString},
+				{Tag: tag.Account, Name: "Account", Type: FieldTypeString},
+				{Tag: tag.AcctIDSource, Name: "AcctIDSource", Type: FieldTypeString},
+				{Tag: tag.AccountType, Name: "AccountType", Type: FieldTypeString},
+				{Tag: tag.DayBookingInst, Name: "DayBookingInst", Type: FieldTypeString},
+				{Tag: tag.BookingUnit, Name: "BookingUnit", Type: FieldTypeString},
+				{Tag: tag.AllocID, Name: "AllocID", Type: FieldTypeString},
+				{Tag: tag.PreallocMethod, Name: "PreallocMethod", Type: FieldTypeString},
+				{Tag: tag.NoAllocs, Name: "NoAllocs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+					{Tag: tag.AllocAccount, Name: "AllocAccount", Type: FieldTypeString, Required: true},
+					{Tag: tag.AllocAcctIDSource, Name: "AllocAcctIDSource", Type: FieldTypeInt},
+					{Tag: tag.AllocSettlCurrency, Name: "AllocSettlCurrency", Type: FieldTypeString},
+					{Tag: tag.IndividualAllocID, Name: "IndividualAllocID", Type: FieldTypeString},
+					{Tag: tag.NoNestedPartyIDs, Name: "NoNestedPartyIDs", Type: FieldTypeNumInGroup, Fields: noNestedPartyIDsFields},
+					{Tag: tag.AllocQty, Name: "AllocQty", Type: FieldTypeDecimal},
+				}},
+				{Tag: tag.SettlType, Name: "SettlType", Type: FieldTypeString},
+				{Tag: tag.SettlDate, Name: "SettlDate", Type: FieldTypeString},
+				{Tag: tag.CashMargin, Name: "CashMargin", Type: FieldTypeString},
+				{Tag: tag.ClearingFeeIndicator, Name: "ClearingFeeIndicator", Type: FieldTypeString},
+				{Tag: tag.HandlInst, Name: "HandlInst", Type: FieldTypeString},
+				{Tag: tag.ExecInst, Name: "ExecInst", Type: FieldTypeString},
+				{Tag: tag.MinQty, Name: "MinQty", Type: FieldTypeDecimal},
+				{Tag: tag.MaxFloor, Name: "MaxFloor", Type: FieldTypeDecimal},
+				{Tag: tag.ExDestination, Name: "ExDestination", Type: FieldTypeString},
+				{Tag: tag.NoTradingSessions, Name: "NoTradingSessions", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+					{Tag: tag.TradingSessionID, Name: "TradingSessionID", Type: FieldTypeString, Required: true},
+					{Tag: tag.TradingSessionSubID, Name: "TradingSessionSubID", Type: FieldTypeString},
+				}},
+				{Tag: tag.ProcessCode, Name: "ProcessCode", Type: FieldTypeString},
+				{Tag: tag.Symbol, Name: "Symbol", Type: FieldTypeString},
+				{Tag: tag.SymbolSfx, Name: "SymbolSfx", Type: FieldTypeString},
+				{Tag: tag.SecurityID, Name: "SecurityID", Type: FieldTypeString},
+				{Tag: tag.SecurityIDSource, Name: "SecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoSecurityAltID, Name: "NoSecurityAltID", Type: FieldTypeNumInGroup, Fields: noSecurityAltIDFields},
+				{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+				{Tag: tag.CFICode, Name: "CFICode", Type: FieldTypeString},
+				{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+				{Tag: tag.SecuritySubType, Name: "SecuritySubType", Type: FieldTypeString},
+				{Tag: tag.MaturityMonthYear, Name: "MaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.MaturityDate, Name: "MaturityDate", Type: FieldTypeString},
+				{Tag: tag.CouponPaymentDate, Name: "CouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.IssueDate, Name: "IssueDate", Type: FieldTypeString},
+				{Tag: tag.RepoCollateralSecurityType, Name: "RepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.RepurchaseTerm, Name: "RepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.RepurchaseRate, Name: "RepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.Factor, Name: "Factor", Type: FieldTypeDecimal},
+				{Tag: tag.CreditRating, Name: "CreditRating", Type: FieldTypeString},
+				{Tag: tag.InstrRegistry, Name: "InstrRegistry", Type: FieldTypeString},
+				{Tag: tag.CountryOfIssue, Name: "CountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.StateOrProvinceOfIssue, Name: "StateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.LocaleOfIssue, Name: "LocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.RedemptionDate, Name: "RedemptionDate", Type: FieldTypeString},
+				{Tag: tag.StrikePrice, Name: "StrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.StrikeCurrency, Name: "StrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.OptAttribute, Name: "OptAttribute", Type: FieldTypeString},
+				{Tag: tag.ContractMultiplier, Name: "ContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.CouponRate, Name: "CouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.SecurityExchange, Name: "SecurityExchange", Type: FieldTypeString},
+				{Tag: tag.Issuer, Name: "Issuer", Type: FieldTypeString},
+				{Tag: tag.EncodedIssuerLen, Name: "EncodedIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedIssuer, Name: "EncodedIssuer", Type: FieldTypeString},
+				{Tag: tag.SecurityDesc, Name: "SecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedSecurityDescLen, Name: "EncodedSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedSecurityDesc, Name: "EncodedSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.Pool, Name: "Pool", Type: FieldTypeString},
+				{Tag: tag.ContractSettlMonth, Name: "ContractSettlMonth", Type: FieldTypeString},
+				{Tag: tag.CPProgram, Name: "CPProgram", Type: FieldTypeString},
+				{Tag: tag.CPRegType, Name: "CPRegType", Type: FieldTypeString},
+				{Tag: tag.NoEvents, Name: "NoEvents", Type: FieldTypeNumInGroup, Fields: noEventsFields},
+				{Tag: tag.DatedDate, Name: "DatedDate", Type: FieldTypeString},
+				{Tag: tag.InterestAccrualDate, Name: "InterestAccrualDate", Type: FieldTypeString},
+				{Tag: tag.NoUnderlyings, Name: "NoUnderlyings", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+					{Tag: tag.UnderlyingSymbol, Name: "UnderlyingSymbol", Type: FieldTypeString, Required: true},
+					{Tag: tag.UnderlyingSymbolSfx, Name: "UnderlyingSymbolSfx", Type: FieldTypeString},
+					{Tag: tag.UnderlyingSecurityID, Name: "UnderlyingSecurityID", Type: FieldTypeString},
+					{Tag: tag.UnderlyingSecurityIDSource, Name: "UnderlyingSecurityIDSource", Type: FieldTypeString},
+					{Tag: tag.NoUnderlyingSecurityAltID, Name: "NoUnderlyingSecurityAltID", Type: FieldTypeNumInGroup, Fields: noUnderlyingSecurityAltIDFields},
+					{Tag: tag.UnderlyingProduct, Name: "UnderlyingProduct", Type: FieldTypeInt},
+					{Tag: tag.UnderlyingCFICode, Name: "UnderlyingCFICode", Type: FieldTypeString},
+					{Tag: tag.UnderlyingSecurityType, Name: "UnderlyingSecurityType", Type: FieldTypeString},
+					{Tag: tag.UnderlyingSecuritySubType, Name: "UnderlyingSecuritySubType", Type: FieldTypeString},
+					{Tag: tag.UnderlyingMaturityMonthYear, Name: "UnderlyingMaturityMonthYear", Type: FieldTypeString},
+					{Tag: tag.UnderlyingMaturityDate, Name: "UnderlyingMaturityDate", Type: FieldTypeString},
+					{Tag: tag.UnderlyingCouponPaymentDate, Name: "UnderlyingCouponPaymentDate", Type: FieldTypeString},
+					{Tag: tag.UnderlyingIssueDate, Name: "UnderlyingIssueDate", Type: FieldTypeString},
+					{Tag: tag.UnderlyingRepoCollateralSecurityType, Name: "UnderlyingRepoCollateralSecurityType", Type: FieldTypeInt},
+					{Tag: tag.UnderlyingRepurchaseTerm, Name: "UnderlyingRepurchaseTerm", Type: FieldTypeInt},
+					{Tag: tag.UnderlyingRepurchaseRate, Name: "UnderlyingRepurchaseRate", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingFactor, Name: "UnderlyingFactor", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingCreditRating, Name: "UnderlyingCreditRating", Type: FieldTypeString},
+					{Tag: tag.UnderlyingInstrRegistry, Name: "UnderlyingInstrRegistry", Type: FieldTypeString},
+					{Tag: tag.UnderlyingCountryOfIssue, Name: "UnderlyingCountryOfIssue", Type: FieldTypeString},
+					{Tag: tag.UnderlyingStateOrProvinceOfIssue, Name: "UnderlyingStateOrProvinceOfIssue", Type: FieldTypeString},
+					{Tag: tag.UnderlyingLocaleOfIssue, Name: "UnderlyingLocaleOfIssue", Type: FieldTypeString},
+					{Tag: tag.UnderlyingRedemptionDate, Name: "UnderlyingRedemptionDate", Type: FieldTypeString},
+					{Tag: tag.UnderlyingStrikePrice, Name: "UnderlyingStrikePrice", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingStrikeCurrency, Name: "UnderlyingStrikeCurrency", Type: FieldTypeString},
+					{Tag: tag.UnderlyingOptAttribute, Name: "UnderlyingOptAttribute", Type: FieldTypeString},
+					{Tag: tag.UnderlyingContractMultiplier, Name: "UnderlyingContractMultiplier", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingCouponRate, Name: "UnderlyingCouponRate", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingSecurityExchange, Name: "UnderlyingSecurityExchange", Type: FieldTypeString},
+					{Tag: tag.UnderlyingIssuer, Name: "UnderlyingIssuer", Type: FieldTypeString},
+					{Tag: tag.EncodedUnderlyingIssuerLen, Name: "EncodedUnderlyingIssuerLen", Type: FieldTypeInt},
+					{Tag: tag.EncodedUnderlyingIssuer, Name: "EncodedUnderlyingIssuer", Type: FieldTypeString},
+					{Tag: tag.UnderlyingSecurityDesc, Name: "UnderlyingSecurityDesc", Type: FieldTypeString},
+					{Tag: tag.EncodedUnderlyingSecurityDescLen, Name: "EncodedUnderlyingSecurityDescLen", Type: FieldTypeInt},
+					{Tag: tag.EncodedUnderlyingSecurityDesc, Name: "EncodedUnderlyingSecurityDesc", Type: FieldTypeString},
+					{Tag: tag.UnderlyingCPProgram, Name: "UnderlyingCPProgram", Type: FieldTypeString},
+					{Tag: tag.UnderlyingCPRegType, Name: "UnderlyingCPRegType", Type: FieldTypeString},
+					{Tag: tag.UnderlyingCurrency, Name: "UnderlyingCurrency", Type: FieldTypeString},
+					{Tag: tag.UnderlyingQty, Name: "UnderlyingQty", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingPx, Name: "UnderlyingPx", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingDirtyPrice, Name: "UnderlyingDirtyPrice", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingEndPrice, Name: "UnderlyingEndPrice", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingStartValue, Name: "UnderlyingStartValue", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingCurrentValue, Name: "UnderlyingCurrentValue", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingEndValue, Name: "UnderlyingEndValue", Type: FieldTypeDecimal},
+					{Tag: tag.NoUnderlyingStips, Name: "NoUnderlyingStips", Type: FieldTypeNumInGroup, Fields: noUnderlyingStipsFields},
+				}},
+				{Tag: tag.PrevClosePx, Name: "PrevClosePx", Type: FieldTypeDecimal},
+				{Tag: tag.Side, Name: "Side", Type: FieldTypeString},
+				{Tag: tag.SideValueInd, Name: "SideValueInd", Type: FieldTypeString},
+				{Tag: tag.LocateReqd, Name: "LocateReqd", Type: FieldTypeBool},
+				{Tag: tag.TransactTime, Name: "TransactTime", Type: FieldTypeUTCTimestamp},
+				{Tag: tag.NoStipulations, Name: "NoStipulations", Type: FieldTypeNumInGroup, Fields: noStipulationsFields},
+				{Tag: tag.QtyType, Name: "QtyType", Type: FieldTypeString},
+				{Tag: tag.OrderQty, Name: "OrderQty", Type: FieldTypeDecimal},
+				{Tag: tag.CashOrderQty, Name: "CashOrderQty", Type: FieldTypeDecimal},
+				{Tag: tag.OrderPercent, Name: "OrderPercent", Type: FieldTypeDecimal},
+				{Tag: tag.RoundingDirection, Name: "RoundingDirection", Type: FieldTypeString},
+				{Tag: tag.RoundingModulus, Name: "RoundingModulus", Type: FieldTypeDecimal},
+				{Tag: tag.OrdType, Name: "OrdType", Type: FieldTypeString},
+				{Tag: tag.PriceType, Name: "PriceType", Type: FieldTypeString},
+				{Tag: tag.Price, Name: "Price", Type: FieldTypeDecimal},
+				{Tag: tag.StopPx, Name: "StopPx", Type: FieldTypeDecimal},
+				{Tag: tag.Spread, Name: "Spread", Type: FieldTypeDecimal},
+				{Tag: tag.BenchmarkCurveCurrency, Name: "BenchmarkCurveCurrency", Type: FieldTypeString},
+				{Tag: tag.BenchmarkCurveName, Name: "BenchmarkCurveName", Type: FieldTypeString},
+				{Tag: tag.BenchmarkCurvePoint, Name: "BenchmarkCurvePoint", Type: FieldTypeString},
+				{Tag: tag.BenchmarkPrice, Name: "BenchmarkPrice", Type: FieldTypeDecimal},
+				{Tag: tag.BenchmarkPriceType, Name: "BenchmarkPriceType", Type: FieldTypeInt},
+				{Tag: tag.BenchmarkSecurityID, Name: "BenchmarkSecurityID", Type: FieldTypeString},
+				{Tag: tag.BenchmarkSecurityIDSource, Name: "BenchmarkSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.YieldType, Name: "YieldType", Type: FieldTypeString},
+				{Tag: tag.Yield, Name: "Yield", Type: FieldTypeDecimal},
+				{Tag: tag.YieldCalcDate, Name: "YieldCalcDate", Type: FieldTypeString},
+				{Tag: tag.YieldRedemptionDate, Name: "YieldRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.YieldRedemptionPrice, Name: "YieldRedemptionPrice", Type: FieldTypeDecimal},
+				{Tag: tag.YieldRedemptionPriceType, Name: "YieldRedemptionPriceType", Type: FieldTypeInt},
+				{Tag: tag.Currency, Name: "Currency", Type: FieldTypeString},
+				{Tag: tag.ComplianceID, Name: "ComplianceID", Type: FieldTypeString},
+				{Tag: tag.SolicitedFlag, Name: "SolicitedFlag", Type: FieldTypeBool},
+				{Tag: tag.IOIID, Name: "IOIID", Type: FieldTypeString},
+				{Tag: tag.QuoteID, Name: "QuoteID", Type: FieldTypeString},
+				{Tag: tag.TimeInForce, Name: "TimeInForce", Type: FieldTypeString},
+				{Tag: tag.EffectiveTime, Name: "EffectiveTime", Type: FieldTypeUTCTimestamp},
+				{Tag: tag.ExpireDate, Name: "ExpireDate", Type: FieldTypeString},
+				{Tag: tag.ExpireTime, Name: "ExpireTime", Type: FieldTypeUTCTimestamp},
+				{Tag: tag.GTBookingInst, Name: "GTBookingInst", Type: FieldTypeString},
+				{Tag: tag.Commission, Name: "Commission", Type: FieldTypeDecimal},
+				{Tag: tag.CommType, Name: "CommType", Type: FieldTypeString},
+				{Tag: tag.CommCurrency, Name: "CommCurrency", Type: FieldTypeString},
+				{Tag: tag.FundRenewWaiv, Name: "FundRenewWaiv", Type: FieldTypeString},
+				{Tag: tag.OrderCapacity, Name: "OrderCapacity", Type: FieldTypeString},
+				{Tag: tag.OrderRestrictions, Name: "OrderRestrictions", Type: FieldTypeString},
+				{Tag: tag.CustOrderCapacity, Name: "CustOrderCapacity", Type: FieldTypeString},
+				{Tag: tag.ForexReq, Name: "ForexReq", Type: FieldTypeBool},
+				{Tag: tag.SettlCurrency, Name: "SettlCurrency", Type: FieldTypeString},
+				{Tag: tag.BookingType, Name: "BookingType", Type: FieldTypeString},
+				{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+				{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+				{Tag: tag.SettlDate2, Name: "SettlDate2", Type: FieldTypeString},
+				{Tag: tag.OrderQty2, Name: "OrderQty2", Type: FieldTypeDecimal},
+				{Tag: tag.Price2, Name: "Price2", Type: FieldTypeDecimal},
+				{Tag: tag.PositionEffect, Name: "PositionEffect", Type: FieldTypeString},
+				{Tag: tag.CoveredOrUncovered, Name: "CoveredOrUncovered", Type: FieldTypeString},
+				{Tag: tag.MaxShow, Name: "MaxShow", Type: FieldTypeDecimal},
+				{Tag: tag.PegOffsetValue, Name: "PegOffsetValue", Type: FieldTypeDecimal},
+				{Tag: tag.PegMoveType, Name: "PegMoveType", Type: FieldTypeString},
+				{Tag: tag.PegOffsetType, Name: "PegOffsetType", Type: FieldTypeString},
+				{Tag: tag.PegLimitType, Name: "PegLimitType", Type: FieldTypeString},
+				{Tag: tag.PegRoundDirection, Name: "PegRoundDirection", Type: FieldTypeString},
+				{Tag: tag.PegScope, Name: "PegScope", Type: FieldTypeString},
+				{Tag: tag.DiscretionInst, Name: "DiscretionInst", Type: FieldTypeString},
+				{Tag: tag.DiscretionOffsetValue, Name: "DiscretionOffsetValue", Type: FieldTypeDecimal},
+				{Tag: tag.DiscretionMoveType, Name: "DiscretionMoveType", Type: FieldTypeString},
+				{Tag: tag.DiscretionOffsetType, Name: "DiscretionOffsetType", Type: FieldTypeString},
+				{Tag: tag.DiscretionLimitType, Name: "DiscretionLimitType", Type: FieldTypeString},
+				{Tag: tag.DiscretionRoundDirection, Name: "DiscretionRoundDirection", Type: FieldTypeString},
+				{Tag: tag.DiscretionScope, Name: "DiscretionScope", Type: FieldTypeString},
+				{Tag: tag.TargetStrategy, Name: "TargetStrategy", Type: FieldTypeString},
+				{Tag: tag.TargetStrategyParameters, Name: "TargetStrategyParameters", Type: FieldTypeString},
+				{Tag: tag.ParticipationRate, Name: "ParticipationRate", Type: FieldTypeDecimal},
+				{Tag: tag.Designation, Name: "Designation", Type: FieldTypeString},
+			}},
+			{Tag: tag.EncodedListExecInstLen, Name: "EncodedListExecInstLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedListExecInst, Name: "EncodedListExecInst", Type: FieldTypeString},
+			{Tag: tag.BidID, Name: "BidID", Type: FieldTypeString},
+			{Tag: tag.ClientBidID, Name: "ClientBidID", Type: FieldTypeString},
+			{Tag: tag.BidType, Name: "BidType", Type: FieldTypeString, Required: true},
+			{Tag: tag.ProgRptReqs, Name: "ProgRptReqs", Type: FieldTypeString},
+			{Tag: tag.ProgPeriodInterval, Name: "ProgPeriodInterval", Type: FieldTypeInt},
+			{Tag: tag.ListExecInstType, Name: "ListExecInstType", Type: FieldTypeString},
+			{Tag: tag.CancellationRights, Name: "CancellationRights", Type: FieldTypeString},
+			{Tag: tag.MoneyLaunderingStatus, Name: "MoneyLaunderingStatus", Type: FieldTypeString},
+			{Tag: tag.RegistID, Name: "RegistID", Type: FieldTypeString},
+			{Tag: tag.AllowableOneSidednessPct, Name: "AllowableOneSidednessPct", Type: FieldTypeDecimal},
+			{Tag: tag.AllowableOneSidednessValue, Name: "AllowableOneSidednessValue", Type: FieldTypeDecimal},
+			{Tag: tag.AllowableOneSidednessCurr, Name: "AllowableOneSidednessCurr", Type: FieldTypeString},
+			{Tag: tag.LastFragment, Name: "LastFragment", Type: FieldTypeBool},
+		},
+	},
+	"AB": {
+		Name:    "NewOrderMultileg",
+		MsgType: "AB",
+		Fields: []FieldDef{
+			{Tag: tag.Account, Name: "Account", Type: FieldTypeString},
+			{Tag: tag.ClOrdID, Name: "ClOrdID", Type: FieldTypeString, Required: true},
+			{Tag: tag.Commission, Name: "Commission", Type: FieldTypeDecimal},
+			{Tag: tag.CommType, Name: "CommType", Type: FieldTypeString},
+			{Tag: tag.Currency, Name: "Currency", Type: FieldTypeString},
+			{Tag: tag.ExecInst, Name: "ExecInst", Type: FieldTypeString},
+			{Tag: tag.HandlInst, Name: "HandlInst", Type: FieldTypeString},
+			{Tag: tag.SecurityIDSource, Name: "SecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.IOIID, Name: "IOIID", Type: FieldTypeString},
+			{Tag: tag.OrderQty, Name: "OrderQty", Type: FieldTypeDecimal},
+			{Tag: tag.OrdType, Name: "OrdType", Type: FieldTypeString, Required: true},
+			{Tag: tag.Price, Name: "Price", Type: FieldTypeDecimal},
+			{Tag: tag.SecurityID, Name: "SecurityID", Type: FieldTypeString},
+			{Tag: tag.Side, Name: "Side", Type: FieldTypeString, Required: true},
+			{Tag: tag.Symbol, Name: "Symbol", Type: FieldTypeString},
+			{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+			{Tag: tag.TimeInForce, Name: "TimeInForce", Type: FieldTypeString},
+			{Tag: tag.TransactTime, Name: "TransactTime", Type: FieldTypeUTCTimestamp, Required: true},
+			{Tag: tag.SettlType, Name: "SettlType", Type: FieldTypeString},
+			{Tag: tag.SettlDate, Name: "SettlDate", Type: FieldTypeString},
+			{Tag: tag.SymbolSfx, Name: "SymbolSfx", Type: FieldTypeString},
+			{Tag: tag.AllocID, Name: "AllocID", Type: FieldTypeString},
+			{Tag: tag.TradeDate, Name: "TradeDate", Type: FieldTypeString},
+			{Tag: tag.PositionEffect, Name: "PositionEffect", Type: FieldTypeString},
+			{Tag: tag.NoAllocs, Name: "NoAllocs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.AllocAccount, Name: "AllocAccount", Type: FieldTypeString, Required: true},
+				{Tag: tag.AllocAcctIDSource, Name: "AllocAcctIDSource", Type: FieldTypeInt},
+				{Tag: tag.AllocSettlCurrency, Name: "AllocSettlCurrency", Type: FieldTypeString},
+				{Tag: tag.IndividualAllocID, Name: "IndividualAllocID", Type: FieldTypeString},
+				{Tag: tag.NoNested3PartyIDs, Name: "NoNested3PartyIDs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+					{Tag: tag.Nested3PartyID, Name: "Nested3PartyID", Type: FieldTypeString, Required: true},
+					{Tag: tag.Nested3PartyIDSource, Name: "Nested3PartyIDSource", Type: FieldTypeString},
+					{Tag: tag.Nested3PartyRole, Name: "Nested3PartyRole", Type: FieldTypeInt},
+					{Tag: tag.NoNested3PartySubIDs, Name: "NoNested3PartySubIDs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+						{Tag: tag.Nested3PartySubID, Name: "Nested3PartySubID", Type: FieldTypeString, Required: true},
+						{Tag: tag.Nested3PartySubIDType, Name: "Nested3PartySubIDType", Type: FieldTypeInt},
+					}},
+				}},
+				{Tag: tag.AllocQty, Name: "AllocQty", Type: FieldTypeDecimal},
+			}},
+			{Tag: tag.ProcessCode, Name: "ProcessCode", Type: FieldTypeString},
+			{Tag: tag.StopPx, Name: "StopPx", Type: FieldTypeDecimal},
+			{Tag: tag.ExDestination, Name: "ExDestination", Type: FieldTypeString},
+			{Tag: tag.Issuer, Name: "Issuer", Type: FieldTypeString},
+			{Tag: tag.SecurityDesc, Name: "SecurityDesc", Type: FieldTypeString},
+			{Tag: tag.MinQty, Name: "MinQty", Type: FieldTypeDecimal},
+			{Tag: tag.MaxFloor, Name: "MaxFloor", Type: FieldTypeDecimal},
+			{Tag: tag.LocateReqd, Name: "LocateReqd", Type: FieldTypeBool},
+			{Tag: tag.QuoteID, Name: "QuoteID", Type: FieldTypeString},
+			{Tag: tag.SettlCurrency, Name: "SettlCurrency", Type: FieldTypeString},
+			{Tag: tag.ForexReq, Name: "ForexReq", Type: FieldTypeBool},
+			{Tag: tag.ExpireTime, Name: "ExpireTime", Type: FieldTypeUTCTimestamp},
+			{Tag: tag.PrevClosePx, Name: "PrevClosePx", Type: FieldTypeDecimal},
+			{Tag: tag.CashOrderQty, Name: "CashOrderQty", Type: FieldTypeDecimal},
+			{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+			{Tag: tag.EffectiveTime, Name: "EffectiveTime", Type: FieldTypeUTCTimestamp},
+			{Tag: tag.MaturityMonthYear, Name: "MaturityMonthYear", Type: FieldTypeString},
+			{Tag: tag.StrikePrice, Name: "StrikePrice", Type: FieldTypeDecimal},
+			{Tag: tag.CoveredOrUncovered, Name: "CoveredOrUncovered", Type: FieldTypeString},
+			{Tag: tag.OptAttribute, Name: "OptAttribute", Type: FieldTypeString},
+			{Tag: tag.SecurityExchange, Name: "SecurityExchange", Type: FieldTypeString},
+			{Tag: tag.MaxShow, Name: "MaxShow", Type: FieldTypeDecimal},
+			{Tag: tag.PegOffsetValue, Name: "PegOffsetValue", Type: FieldTypeDecimal},
+			{Tag: tag.CouponRate, Name: "CouponRate", Type: FieldTypeDecimal},
+			{Tag: tag.CouponPaymentDate, Name: "CouponPaymentDate", Type: FieldTypeString},
+			{Tag: tag.IssueDate, Name: "IssueDate", Type: FieldTypeString},
+			{Tag: tag.RepurchaseTerm, Name: "RepurchaseTerm", Type: FieldTypeInt},
+			{Tag: tag.RepurchaseRate, Name: "RepurchaseRate", Type: FieldTypeDecimal},
+			{Tag: tag.Factor, Name: "Factor", Type: FieldTypeDecimal},
+			{Tag: tag.TradeOriginationDate, Name: "TradeOriginationDate", Type: FieldTypeString},
+			{Tag: tag.ContractMultiplier, Name: "ContractMultiplier", Type: FieldTypeDecimal},
+			{Tag: tag.RepoCollateralSecurityType, Name: "RepoCollateralSecurityType", Type: FieldTypeInt},
+			{Tag: tag.RedemptionDate, Name: "RedemptionDate", Type: FieldTypeString},
+			{Tag: tag.CreditRating, Name: "CreditRating", Type: FieldTypeString},
+			{Tag: tag.EncodedIssuerLen, Name: "EncodedIssuerLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedIssuer, Name: "EncodedIssuer", Type: FieldTypeString},
+			{Tag: tag.EncodedSecurityDescLen, Name: "EncodedSecurityDescLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedSecurityDesc, Name: "EncodedSecurityDesc", Type: FieldTypeString},
+			{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			{Tag: tag.ComplianceID, Name: "ComplianceID", Type: FieldTypeString},
+			{Tag: tag.SolicitedFlag, Name: "SolicitedFlag", Type: FieldTypeBool},
+			{Tag: tag.NoTradingSessions, Name: "NoTradingSessions", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.TradingSessionID, Name: "TradingSessionID", Type: FieldTypeString, Required: true},
+				{Tag: tag.TradingSessionSubID, Name: "TradingSessionSubID", Type: FieldTypeString},
+			}},
+			{Tag: tag.DiscretionInst, Name: "DiscretionInst", Type: FieldTypeString},
+			{Tag: tag.DiscretionOffsetValue, Name: "DiscretionOffsetValue", Type: FieldTypeDecimal},
+			{Tag: tag.PriceType, Name: "PriceType", Type: FieldTypeString},
+			{Tag: tag.GTBookingInst, Name: "GTBookingInst", Type: FieldTypeString},
+			{Tag: tag.ExpireDate, Name: "ExpireDate", Type: FieldTypeString},
+			{Tag: tag.NoPartyIDs, Name: "NoPartyIDs", Type: FieldTypeNumInGroup, Fields: noPartyIDsFields},
+			{Tag: tag.NoSecurityAltID, Name: "NoSecurityAltID", Type: FieldTypeNumInGroup, Fields: noSecurityAltIDFields},
+			{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+			{Tag: tag.CFICode, Name: "CFICode", Type: FieldTypeString},
+			{Tag: tag.RoundingDirection, Name: "RoundingDirection", Type: FieldTypeString},
+			{Tag: tag.RoundingModulus, Name: "RoundingModulus", Type: FieldTypeDecimal},
+			{Tag: tag.CountryOfIssue, Name: "CountryOfIssue", Type: FieldTypeString},
+			{Tag: tag.StateOrProvinceOfIssue, Name: "StateOrProvinceOfIssue", Type: FieldTypeString},
+			{Tag: tag.LocaleOfIssue, Name: "LocaleOfIssue", Type: FieldTypeString},
+			{Tag: tag.CommCurrency, Name: "CommCurrency", Type: FieldTypeString},
+			{Tag: tag.CancellationRights, Name: "CancellationRights", Type: FieldTypeString},
+			{Tag: tag.MoneyLaunderingStatus, Name: "MoneyLaunderingStatus", Type: FieldTypeString},
+			{Tag: tag.Designation, Name: "Designation", Type: FieldTypeString},
+			{Tag: tag.FundRenewWaiv, Name: "FundRenewWaiv", Type: FieldTypeString},
+			{Tag: tag.RegistID, Name: "RegistID", Type: FieldTypeString},
+			{Tag: tag.OrderPercent, Name: "OrderPercent", Type: FieldTypeDecimal},
+			{Tag: tag.SecondaryClOrdID, Name: "SecondaryClOrdID", Type: FieldTypeString},
+			{Tag: tag.OrderCapacity, Name: "OrderCapacity", Type: FieldTypeString},
+			{Tag: tag.OrderRestrictions, Name: "OrderRestrictions", Type: FieldTypeString},
+			{Tag: tag.MaturityDate, Name: "MaturityDate", Type: FieldTypeString},
+			{Tag: tag.InstrRegistry, Name: "InstrRegistry", Type: FieldTypeString},
+			{Tag: tag.CashMargin, Name: "CashMargin", Type: FieldTypeString},
+			{Tag: tag.NoLegs, Name: "NoLegs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.LegSymbol, Name: "LegSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.LegSymbolSfx, Name: "LegSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.LegSecurityID, Name: "LegSecurityID", Type: FieldTypeString},
+				{Tag: tag.LegSecurityIDSource, Name: "LegSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoLegSecurityAltID, Name: "NoLegSecurityAltID", Type: FieldTypeNumInGroup, Fields: noLegSecurityAltIDFields},
+				{Tag: tag.LegProduct, Name: "LegProduct", Type: FieldTypeInt},
+				{Tag: tag.LegCFICode, Name: "LegCFICode", Type: FieldTypeString},
+				{Tag: tag.LegSecurityType, Name: "LegSecurityType", Type: FieldTypeString},
+				{Tag: tag.LegSecuritySubType, Name: "LegSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.LegMaturityMonthYear, Name: "LegMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.LegMaturityDate, Name: "LegMaturityDate", Type: FieldTypeString},
+				{Tag: tag.LegCouponPaymentDate, Name: "LegCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.LegIssueDate, Name: "LegIssueDate", Type: FieldTypeString},
+				{Tag: tag.LegRepoCollateralSecurityType, Name: "LegRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseTerm, Name: "LegRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseRate, Name: "LegRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegFactor, Name: "LegFactor", Type: FieldTypeDecimal},
+				{Tag: tag.LegCreditRating, Name: "LegCreditRating", Type: FieldTypeString},
+				{Tag: tag.LegInstrRegistry, Name: "LegInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.LegCountryOfIssue, Name: "LegCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegStateOrProvinceOfIssue, Name: "LegStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegLocaleOfIssue, Name: "LegLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegRedemptionDate, Name: "LegRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.LegStrikePrice, Name: "LegStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.LegStrikeCurrency, Name: "LegStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.LegOptAttribute, Name: "LegOptAttribute", Type: FieldTypeString},
+				{Tag: tag.LegContractMultiplier, Name: "LegContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.LegCouponRate, Name: "LegCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegSecurityExchange, Name: "LegSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.LegIssuer, Name: "LegIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedLegIssuerLen, Name: "EncodedLegIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegIssuer, Name: "EncodedLegIssuer", Type: FieldTypeString},
+				{Tag: tag.LegSecurityDesc, Name: "LegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedLegSecurityDescLen, Name: "EncodedLegSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegSecurityDesc, Name: "EncodedLegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.LegRatioQty, Name: "LegRatioQty", Type: FieldTypeDecimal},
+				{Tag: tag.LegSide, Name: "LegSide", Type: FieldTypeString},
+				{Tag: tag.LegCurrency, Name: "LegCurrency", Type: FieldTypeString},
+				{Tag: tag.LegPool, Name: "LegPool", Type: FieldTypeString},
+				{Tag: tag.LegDatedDate, Name: "LegDatedDate", Type: FieldTypeString},
+				{Tag: tag.LegContractSettlMonth, Name: "LegContractSettlMonth", Type: FieldTypeString},
+				{Tag: tag.LegInterestAccrualDate, Name: "LegInterestAccrualDate", Type: FieldTypeString},
+				{Tag: tag.LegQty, Name: "LegQty", Type: FieldTypeDecimal},
+				{Tag: tag.LegSwapType, Name: "LegSwapType", Type: FieldTypeString},
+				{Tag: tag.NoLegStipulations, Name: "NoLegStipulations", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+					{Tag: tag.LegStipulationType, Name: "LegStipulationType", Type: FieldTypeString, Required: true},
+					{Tag: tag.LegStipulationValue, Name: "LegStipulationValue", Type: FieldTypeString},
+				}},
+				{Tag: tag.NoLegAllocs, Name: "NoLegAllocs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+					{Tag: tag.LegAllocAccount, Name: "LegAllocAccount", Type: FieldTypeString, Required: true},
+					{Tag: tag.LegIndividualAllocID, Name: "LegIndividualAllocID", Type: FieldTypeString},
+					{Tag: tag.NoNested2PartyIDs, Name: "NoNested2PartyIDs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+						{Tag: tag.Nested2PartyID, Name: "Nested2PartyID", Type: FieldTypeString, Required: true},
+						{Tag: tag.Nested2PartyIDSource, Name: "Nested2PartyIDSource", Type: FieldTypeString},
+						{Tag: tag.Nested2PartyRole, Name: "Nested2PartyRole", Type: FieldTypeInt},
+						{Tag: tag.NoNested2PartySubIDs, Name: "NoNested2PartySubIDs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+							{Tag: tag.Nested2PartySubID, Name: "Nested2PartySubID", Type: FieldTypeString, Required: true},
+							{Tag: tag.Nested2PartySubIDType, Name: "Nested2PartySubIDType", Type: FieldTypeInt},
+						}},
+					}},
+					{Tag: tag.LegAllocQty, Name: "LegAllocQty", Type: FieldTypeDecimal},
+					{Tag: tag.LegAllocAcctIDSource, Name: "LegAllocAcctIDSource", Type: FieldTypeString},
+					{Tag: tag.LegSettlCurrency, Name: "LegSettlCurrency", Type: FieldTypeString},
+				}},
+				{Tag: tag.LegPositionEffect, Name: "LegPositionEffect", Type: FieldTypeString},
+				{Tag: tag.LegCoveredOrUncovered, Name: "LegCoveredOrUncovered", Type: FieldTypeInt},
+				{Tag: tag.NoNestedPartyIDs, Name: "NoNestedPartyIDs", Type: FieldTypeNumInGroup, Fields: noNestedPartyIDsFields},
+				{Tag: tag.LegRefID, Name: "LegRefID", Type: FieldTypeString},
+				{Tag: tag.LegPrice, Name: "LegPrice", Type: FieldTypeDecimal},
+				{Tag: tag.LegSettlType, Name: "LegSettlType", Type: FieldTypeString},
+				{Tag: tag.LegSettlDate, Name: "LegSettlDate", Type: FieldTypeString},
+			}},
+			{Tag: tag.MultiLegRptTypeReq, Name: "MultiLegRptTypeReq", Type: FieldTypeString},
+			{Tag: tag.AccountType, Name: "AccountType", Type: FieldTypeString},
+			{Tag: tag.CustOrderCapacity, Name: "CustOrderCapacity", Type: FieldTypeString},
+			{Tag: tag.ClOrdLinkID, Name: "ClOrdLinkID", Type: FieldTypeString},
+			{Tag: tag.DayBookingInst, Name: "DayBookingInst", Type: FieldTypeString},
+			{Tag: tag.BookingUnit, Name: "BookingUnit", Type: FieldTypeString},
+			{Tag: tag.PreallocMethod, Name: "PreallocMethod", Type: FieldTypeString},
+			{Tag: tag.ClearingFeeIndicator, Name: "ClearingFeeIndicator", Type: FieldTypeString},
+			{Tag: tag.AcctIDSource, Name: "AcctIDSource", Type: FieldTypeString},
+			{Tag: tag.ContractSettlMonth, Name: "ContractSettlMonth", Type: FieldTypeString},
+			{Tag: tag.Pool, Name: "Pool", Type: FieldTypeString},
+			{Tag: tag.NoUnderlyings, Name: "NoUnderlyings", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.UnderlyingSymbol, Name: "UnderlyingSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.UnderlyingSymbolSfx, Name: "UnderlyingSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityID, Name: "UnderlyingSecurityID", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityIDSource, Name: "UnderlyingSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoUnderlyingSecurityAltID, Name: "NoUnderlyingSecurityAltID", Type: FieldTypeNumInGroup, Fields: noUnderlyingSecurityAltIDFields},
+				{Tag: tag.UnderlyingProduct, Name: "UnderlyingProduct", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingCFICode, Name: "UnderlyingCFICode", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityType, Name: "UnderlyingSecurityType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecuritySubType, Name: "UnderlyingSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityMonthYear, Name: "UnderlyingMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityDate, Name: "UnderlyingMaturityDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCouponPaymentDate, Name: "UnderlyingCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssueDate, Name: "UnderlyingIssueDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRepoCollateralSecurityType, Name: "UnderlyingRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseTerm, Name: "UnderlyingRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseRate, Name: "UnderlyingRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingFactor, Name: "UnderlyingFactor", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCreditRating, Name: "UnderlyingCreditRating", Type: FieldTypeString},
+				{Tag: tag.UnderlyingInstrRegistry, Name: "UnderlyingInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCountryOfIssue, Name: "UnderlyingCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStateOrProvinceOfIssue, Name: "UnderlyingStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingLocaleOfIssue, Name: "UnderlyingLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRedemptionDate, Name: "UnderlyingRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStrikePrice, Name: "UnderlyingStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStrikeCurrency, Name: "UnderlyingStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingOptAttribute, Name: "UnderlyingOptAttribute", Type: FieldTypeString},
+				{Tag: tag.UnderlyingContractMultiplier, Name: "UnderlyingContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCouponRate, Name: "UnderlyingCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingSecurityExchange, Name: "UnderlyingSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssuer, Name: "UnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingIssuerLen, Name: "EncodedUnderlyingIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingIssuer, Name: "EncodedUnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityDesc, Name: "UnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingSecurityDescLen, Name: "EncodedUnderlyingSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingSecurityDesc, Name: "EncodedUnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPProgram, Name: "UnderlyingCPProgram", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPRegType, Name: "UnderlyingCPRegType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCurrency, Name: "UnderlyingCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingQty, Name: "UnderlyingQty", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingPx, Name: "UnderlyingPx", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingDirtyPrice, Name: "UnderlyingDirtyPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndPrice, Name: "UnderlyingEndPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStartValue, Name: "UnderlyingStartValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCurrentValue, Name: "UnderlyingCurrentValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndValue, Name: "UnderlyingEndValue", Type: FieldTypeDecimal},
+				{Tag: tag.NoUnderlyingStips, Name: "NoUnderlyingStips", Type: FieldTypeNumInGroup, Fields: noUnderlyingStipsFields},
+			}},
+			{Tag: tag.SecuritySubType, Name: "SecuritySubType", Type: FieldTypeString},
+			{Tag: tag.BookingType, Name: "BookingType", Type: FieldTypeString},
+			{Tag: tag.PegMoveType, Name: "PegMoveType", Type: FieldTypeString},
+			{Tag: tag.PegOffsetType, Name: "PegOffsetType", Type: FieldTypeString},
+			{Tag: tag.PegLimitType, Name: "PegLimitType", Type: FieldTypeString},
+			{Tag: tag.PegRoundDirection, Name: "PegRoundDirection", Type: FieldTypeString},
+			{Tag: tag.PegScope, Name: "PegScope", Type: FieldTypeString},
+			{Tag: tag.DiscretionMoveType, Name: "DiscretionMoveType", Type: FieldTypeString},
+			{Tag: tag.DiscretionOffsetType, Name: "DiscretionOffsetType", Type: FieldTypeString},
+			{Tag: tag.DiscretionLimitType, Name: "DiscretionLimitType", Type: FieldTypeString},
+			{Tag: tag.DiscretionRoundDirection, Name: "DiscretionRoundDirection", Type: FieldTypeString},
+			{Tag: tag.DiscretionScope, Name: "DiscretionScope", Type: FieldTypeString},
+			{Tag: tag.TargetStrategy, Name: "TargetStrategy", Type: FieldTypeString},
+			{Tag: tag.TargetStrategyParameters, Name: "TargetStrategyParameters", Type: FieldTypeString},
+			{Tag: tag.ParticipationRate, Name: "ParticipationRate", Type: FieldTypeDecimal},
+			{Tag: tag.QtyType, Name: "QtyType", Type: FieldTypeString},
+			{Tag: tag.NoEvents, Name: "NoEvents", Type: FieldTypeNumInGroup, Fields: noEventsFields},
+			{Tag: tag.DatedDate, Name: "DatedDate", Type: FieldTypeString},
+			{Tag: tag.InterestAccrualDate, Name: "InterestAccrualDate", Type: FieldTypeString},
+			{Tag: tag.CPProgram, Name: "CPProgram", Type: FieldTypeString},
+			{Tag: tag.CPRegType, Name: "CPRegType", Type: FieldTypeString},
+			{Tag: tag.StrikeCurrency, Name: "StrikeCurrency", Type: FieldTypeString},
+		},
+	},
+	"D": {
+		Name:    "NewOrderSingle",
+		MsgType: "D",
+		Fields: []FieldDef{
+			{Tag: tag.Account, Name: "Account", Type: FieldTypeString},
+			{Tag: tag.ClOrdID, Name: "ClOrdID", Type: FieldTypeString, Required: true},
+			{Tag: tag.Commission, Name: "Commission", Type: FieldTypeDecimal},
+			{Tag: tag.CommType, Name: "CommType", Type: FieldTypeString},
+			{Tag: tag.Currency, Name: "Currency", Type: FieldTypeString},
+			{Tag: tag.ExecInst, Name: "ExecInst", Type: FieldTypeString},
+			{Tag: tag.HandlInst, Name: "HandlInst", Type: FieldTypeString},
+			{Tag: tag.SecurityIDSource, Name: "SecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.IOIID, Name: "IOIID", Type: FieldTypeString},
+			{Tag: tag.OrderQty, Name: "OrderQty", Type: FieldTypeDecimal},
+			{Tag: tag.OrdType, Name: "OrdType", Type: FieldTypeString, Required: true},
+			{Tag: tag.Price, Name: "Price", Type: FieldTypeDecimal},
+			{Tag: tag.SecurityID, Name: "SecurityID", Type: FieldTypeString},
+			{Tag: tag.Side, Name: "Side", Type: FieldTypeString, Required: true},
+			{Tag: tag.Symbol, Name: "Symbol", Type: FieldTypeString},
+			{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+			{Tag: tag.TimeInForce, Name: "TimeInForce", Type: FieldTypeString},
+			{Tag: tag.TransactTime, Name: "TransactTime", Type: FieldTypeUTCTimestamp, Required: true},
+			{Tag: tag.SettlType, Name: "SettlType", Type: FieldTypeString},
+			{Tag: tag.SettlDate, Name: "SettlDate", Type: FieldTypeString},
+			{Tag: tag.SymbolSfx, Name: "SymbolSfx", Type: FieldTypeString},
+			{Tag: tag.AllocID, Name: "AllocID", Type: FieldTypeString},
+			{Tag: tag.TradeDate, Name: "TradeDate", Type: FieldTypeString},
+			{Tag: tag.PositionEffect, Name: "PositionEffect", Type: FieldTypeString},
+			{Tag: tag.NoAllocs, Name: "NoAllocs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.AllocAccount, Name: "AllocAccount", Type: FieldTypeString, Required: true},
+				{Tag: tag.AllocAcctIDSource, Name: "AllocAcctIDSource", Type: FieldTypeInt},
+				{Tag: tag.AllocSettlCurrency, Name: "AllocSettlCurrency", Type: FieldTypeString},
+				{Tag: tag.IndividualAllocID, Name: "IndividualAllocID", Type: FieldTypeString},
+				{Tag: tag.NoNestedPartyIDs, Name: "NoNestedPartyIDs", Type: FieldTypeNumInGroup, Fields: noNestedPartyIDsFields},
+				{Tag: tag.AllocQty, Name: "AllocQty", Type: FieldTypeDecimal},
+			}},
+			{Tag: tag.ProcessCode, Name: "ProcessCode", Type: FieldTypeString},
+			{Tag: tag.StopPx, Name: "StopPx", Type: FieldTypeDecimal},
+			{Tag: tag.ExDestination, Name: "ExDestination", Type: FieldTypeString},
+			{Tag: tag.Issuer, Name: "Issuer", Type: FieldTypeString},
+			{Tag: tag.SecurityDesc, Name: "SecurityDesc", Type: FieldTypeString},
+			{Tag: tag.MinQty, Name: "MinQty", Type: FieldTypeDecimal},
+			{Tag: tag.MaxFloor, Name: "MaxFloor", Type: FieldTypeDecimal},
+			{Tag: tag.LocateReqd, Name: "LocateReqd", Type: FieldTypeBool},
+			{Tag: tag.QuoteID, Name: "QuoteID", Type: FieldTypeString},
+			{Tag: tag.SettlCurrency, Name: "SettlCurrency", Type: FieldTypeString},
+			{Tag: tag.ForexReq, Name: "ForexReq", Type: FieldTypeBool},
+			{Tag: tag.ExpireTime, Name: "ExpireTime", Type: FieldTypeUTCTimestamp},
+			{Tag: tag.PrevClosePx, Name: "PrevClosePx", Type: FieldTypeDecimal},
+			{Tag: tag.CashOrderQty, Name: "CashOrderQty", Type: FieldTypeDecimal},
+			{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+			{Tag: tag.EffectiveTime, Name: "EffectiveTime", Type: FieldTypeUTCTimestamp},
+			{Tag: tag.OrderQty2, Name: "OrderQty2", Type: FieldTypeDecimal},
+			{Tag: tag.SettlDate2, Name: "SettlDate2", Type: FieldTypeString},
+			{Tag: tag.MaturityMonthYear, Name: "MaturityMonthYear", Type: FieldTypeString},
+			{Tag: tag.StrikePrice, Name: "StrikePrice", Type: FieldTypeDecimal},
+			{Tag: tag.CoveredOrUncovered, Name: "CoveredOrUncovered", Type: FieldTypeString},
+			{Tag: tag.OptAttribute, Name: "OptAttribute", Type: FieldTypeString},
+			{Tag: tag.SecurityExchange, Name: "SecurityExchange", Type: FieldTypeString},
+			{Tag: tag.MaxShow, Name: "MaxShow", Type: FieldTypeDecimal},
+			{Tag: tag.PegOffsetValue, Name: "PegOffsetValue", Type: FieldTypeDecimal},
+			{Tag: tag.Spread, Name: "Spread", Type: FieldTypeDecimal},
+			{Tag: tag.BenchmarkCurveCurrency, Name: "BenchmarkCurveCurrency", Type: FieldTypeString},
+			{Tag: tag.BenchmarkCurveName, Name: "BenchmarkCurveName", Type: FieldTypeString},
+			{Tag: tag.BenchmarkCurvePoint, Name: "BenchmarkCurvePoint", Type: FieldTypeString},
+			{Tag: tag.CouponRate, Name: "CouponRate", Type: FieldTypeDecimal},
+			{Tag: tag.CouponPaymentDate, Name: "CouponPaymentDate", Type: FieldTypeString},
+			{Tag: tag.IssueDate, Name: "IssueDate", Type: FieldTypeString},
+			{Tag: tag.RepurchaseTerm, Name: "RepurchaseTerm", Type: FieldTypeInt},
+			{Tag: tag.RepurchaseRate, Name: "RepurchaseRate", Type: FieldTypeDecimal},
+			{Tag: tag.Factor, Name: "Factor", Type: FieldTypeDecimal},
+			{Tag: tag.TradeOriginationDate, Name: "TradeOriginationDate", Type: FieldTypeString},
+			{Tag: tag.ContractMultiplier, Name: "ContractMultiplier", Type: FieldTypeDecimal},
+			{Tag: tag.NoStipulations, Name: "NoStipulations", Type: FieldTypeNumInGroup, Fields: noStipulationsFields},
+			{Tag: tag.YieldType, Name: "YieldType", Type: FieldTypeString},
+			{Tag: tag.Yield, Name: "Yield", Type: FieldTypeDecimal},
+			{Tag: tag.RepoCollateralSecurityType, Name: "RepoCollateralSecurityType", Type: FieldTypeInt},
+			{Tag: tag.RedemptionDate, Name: "RedemptionDate", Type: FieldTypeString},
+			{Tag: tag.CreditRating, Name: "CreditRating", Type: FieldTypeString},
+			{Tag: tag.EncodedIssuerLen, Name: "EncodedIssuerLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedIssuer, Name: "EncodedIssuer", Type: FieldTypeString},
+			{Tag: tag.EncodedSecurityDescLen, Name: "EncodedSecurityDescLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedSecurityDesc, Name: "EncodedSecurityDesc", Type: FieldTypeString},
+			{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			{Tag: tag.ComplianceID, Name: "ComplianceID", Type: FieldTypeString},
+			{Tag: tag.SolicitedFlag, Name: "SolicitedFlag", Type: FieldTypeBool},
+			{Tag: tag.NoTradingSessions, Name: "NoTradingSessions", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.TradingSessionID, Name: "TradingSessionID", Type: FieldTypeString, Required: true},
+				{Tag: tag.TradingSessionSubID, Name: "TradingSessionSubID", Type: FieldTypeString},
+			}},
+			{Tag: tag.DiscretionInst, Name: "DiscretionInst", Type: FieldTypeString},
+			{Tag: tag.DiscretionOffsetValue, Name: "DiscretionOffsetValue", Type: FieldTypeDecimal},
+			{Tag: tag.PriceType, Name: "PriceType", Type: FieldTypeString},
+			{Tag: tag.GTBookingInst, Name: "GTBookingInst", Type: FieldTypeString},
+			{Tag: tag.ExpireDate, Name: "ExpireDate", Type: FieldTypeString},
+			{Tag: tag.NoPartyIDs, Name: "NoPartyIDs", Type: FieldTypeNumInGroup, Fields: noPartyIDsFields},
+			{Tag: tag.NoSecurityAltID, Name: "NoSecurityAltID", Type: FieldTypeNumInGroup, Fields: noSecurityAltIDFields},
+			{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+			{Tag: tag.CFICode, Name: "CFICode", Type: FieldTypeString},
+			{Tag: tag.RoundingDirection, Name: "RoundingDirection", Type: FieldTypeString},
+			{Tag: tag.RoundingModulus, Name: "RoundingModulus", Type: FieldTypeDecimal},
+			{Tag: tag.CountryOfIssue, Name: "CountryOfIssue", Type: FieldTypeString},
+			{Tag: tag.StateOrProvinceOfIssue, Name: "StateOrProvinceOfIssue", Type: FieldTypeString},
+			{Tag: tag.LocaleOfIssue, Name: "LocaleOfIssue", Type: FieldTypeString},
+			{Tag: tag.CommCurrency, Name: "CommCurrency", Type: FieldTypeString},
+			{Tag: tag.CancellationRights, Name: "CancellationRights", Type: FieldTypeString},
+			{Tag: tag.MoneyLaunderingStatus, Name: "MoneyLaunderingStatus", Type: FieldTypeString},
+			{Tag: tag.Designation, Name: "Designation", Type: FieldTypeString},
+			{Tag: tag.FundRenewWaiv, Name: "FundRenewWaiv", Type: FieldTypeString},
+			{Tag: tag.RegistID, Name: "RegistID", Type: FieldTypeString},
+			{Tag: tag.OrderPercent, Name: "OrderPercent", Type: FieldTypeDecimal},
+			{Tag: tag.SecondaryClOrdID, Name: "SecondaryClOrdID", Type: FieldTypeString},
+			{Tag: tag.OrderCapacity, Name: "OrderCapacity", Type: FieldTypeString},
+			{Tag: tag.OrderRestrictions, Name: "OrderRestrictions", Type: FieldTypeString},
+			{Tag: tag.MaturityDate, Name: "MaturityDate", Type: FieldTypeString},
+			{Tag: tag.InstrRegistry, Name: "InstrRegistry", Type: FieldTypeString},
+			{Tag: tag.CashMargin, Name: "CashMargin", Type: FieldTypeString},
+			{Tag: tag.AccountType, Name: "AccountType", Type: FieldTypeString},
+			{Tag: tag.CustOrderCapacity, Name: "CustOrderCapacity", Type: FieldTypeString},
+			{Tag: tag.ClOrdLinkID, Name: "ClOrdLinkID", Type: FieldTypeString},
+			{Tag: tag.DayBookingInst, Name: "DayBookingInst", Type: FieldTypeString},
+			{Tag: tag.BookingUnit, Name: "BookingUnit", Type: FieldTypeString},
+			{Tag: tag.PreallocMethod, Name: "PreallocMethod", Type: FieldTypeString},
+			{Tag: tag.ClearingFeeIndicator, Name: "ClearingFeeIndicator", Type: FieldTypeString},
+			{Tag: tag.Price2, Name: "Price2", Type: FieldTypeDecimal},
+			{Tag: tag.AcctIDSource, Name: "AcctIDSource", Type: FieldTypeString},
+			{Tag: tag.BenchmarkPrice, Name: "BenchmarkPrice", Type: FieldTypeDecimal},
+			{Tag: tag.BenchmarkPriceType, Name: "BenchmarkPriceType", Type: FieldTypeInt},
+			{Tag: tag.ContractSettlMonth, Name: "ContractSettlMonth", Type: FieldTypeString},
+			{Tag: tag.Pool, Name: "Pool", Type: FieldTypeString},
+			{Tag: tag.YieldRedemptionDate, Name: "YieldRedemptionDate", Type: FieldTypeString},
+			{Tag: tag.YieldRedemptionPrice, Name: "YieldRedemptionPrice", Type: FieldTypeDecimal},
+			{Tag: tag.YieldRedemptionPriceType, Name: "YieldRedemptionPriceType", Type: FieldTypeInt},
+			{Tag: tag.BenchmarkSecurityID, Name: "BenchmarkSecurityID", Type: FieldTypeString},
+			{Tag: tag.YieldCalcDate, Name: "YieldCalcDate", Type: FieldTypeString},
+			{Tag: tag.NoUnderlyings, Name: "NoUnderlyings", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.UnderlyingSymbol, Name: "UnderlyingSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.UnderlyingSymbolSfx, Name: "UnderlyingSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityID, Name: "UnderlyingSecurityID", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityIDSource, Name: "UnderlyingSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoUnderlyingSecurityAltID, Name: "NoUnderlyingSecurityAltID", Type: FieldTypeNumInGroup, Fields: noUnderlyingSecurityAltIDFields},
+				{Tag: tag.UnderlyingProduct, Name: "UnderlyingProduct", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingCFICode, Name: "UnderlyingCFICode", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityType, Name: "UnderlyingSecurityType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecuritySubType, Name: "UnderlyingSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityMonthYear, Name: "UnderlyingMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityDate, Name: "UnderlyingMaturityDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCouponPaymentDate, Name: "UnderlyingCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssueDate, Name: "UnderlyingIssueDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRepoCollateralSecurityType, Name: "UnderlyingRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseTerm, Name: "UnderlyingRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseRate, Name: "UnderlyingRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingFactor, Name: "UnderlyingFactor", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCreditRating, Name: "UnderlyingCreditRating", Type: FieldTypeString},
+				{Tag: tag.UnderlyingInstrRegistry, Name: "UnderlyingInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCountryOfIssue, Name: "UnderlyingCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStateOrProvinceOfIssue, Name: "UnderlyingStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingLocaleOfIssue, Name: "UnderlyingLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRedemptionDate, Name: "UnderlyingRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStrikePrice, Name: "UnderlyingStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStrikeCurrency, Name: "UnderlyingStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingOptAttribute, Name: "UnderlyingOptAttribute", Type: FieldTypeString},
+				{Tag: tag.UnderlyingContractMultiplier, Name: "UnderlyingContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCouponRate, Name: "UnderlyingCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingSecurityExchange, Name: "UnderlyingSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssuer, Name: "UnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingIssuerLen, Name: "EncodedUnderlyingIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingIssuer, Name: "EncodedUnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityDesc, Name: "UnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingSecurityDescLen, Name: "EncodedUnderlyingSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingSecurityDesc, Name: "EncodedUnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPProgram, Name: "UnderlyingCPProgram", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPRegType, Name: "UnderlyingCPRegType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCurrency, Name: "UnderlyingCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingQty, Name: "UnderlyingQty", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingPx, Name: "UnderlyingPx", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingDirtyPrice, Name: "UnderlyingDirtyPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndPrice, Name: "UnderlyingEndPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStartValue, Name: "UnderlyingStartValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCurrentValue, Name: "UnderlyingCurrentValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndValue, Name: "UnderlyingEndValue", Type: FieldTypeDecimal},
+				{Tag: tag.NoUnderlyingStips, Name: "NoUnderlyingStips", Type: FieldTypeNumInGroup, Fields: noUnderlyingStipsFields},
+			}},
+			{Tag: tag.BenchmarkSecurityIDSource, Name: "BenchmarkSecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.SecuritySubType, Name: "SecuritySubType", Type: FieldTypeString},
+			{Tag: tag.BookingType, Name: "BookingType", Type: FieldTypeString},
+			{Tag: tag.TerminationType, Name: "TerminationType", Type: FieldTypeString},
+			{Tag: tag.PegMoveType, Name: "PegMoveType", Type: FieldTypeString},
+			{Tag: tag.PegOffsetType, Name: "PegOffsetType", Type: FieldTypeString},
+			{Tag: tag.PegLimitType, Name: "PegLimitType", Type: FieldTypeString},
+			{Tag: tag.PegRoundDirection, Name: "PegRoundDirection", Type: FieldTypeString},
+			{Tag: tag.PegScope, Name: "PegScope", Type: FieldTypeString},
+			{Tag: tag.DiscretionMoveType, Name: "DiscretionMoveType", Type: FieldTypeString},
+			{Tag: tag.DiscretionOffsetType, Name: "DiscretionOffsetType", Type: FieldTypeString},
+			{Tag: tag.DiscretionLimitType, Name: "DiscretionLimitType", Type: FieldTypeString},
+			{Tag: tag.DiscretionRoundDirection, Name: "DiscretionRoundDirection", Type: FieldTypeString},
+			{Tag: tag.DiscretionScope, Name: "DiscretionScope", Type: FieldTypeString},
+			{Tag: tag.TargetStrategy, Name: "TargetStrategy", Type: FieldTypeString},
+			{Tag: tag.TargetStrategyParameters, Name: "TargetStrategyParameters", Type: FieldTypeString},
+			{Tag: tag.ParticipationRate, Name: "ParticipationRate", Type: FieldTypeDecimal},
+			{Tag: tag.QtyType, Name: "QtyType", Type: FieldTypeString},
+			{Tag: tag.NoEvents, Name: "NoEvents", Type: FieldTypeNumInGroup, Fields: noEventsFields},
+			{Tag: tag.DatedDate, Name: "DatedDate", Type: FieldTypeString},
+			{Tag: tag.InterestAccrualDate, Name: "InterestAccrualDate", Type: FieldTypeString},
+			{Tag: tag.CPProgram, Name: "CPProgram", Type: FieldTypeString},
+			{Tag: tag.CPRegType, Name: "CPRegType", Type: FieldTypeString},
+			{Tag: tag.MarginRatio, Name: "MarginRatio", Type: FieldTypeDecimal},
+			{Tag: tag.AgreementDesc, Name: "AgreementDesc", Type: FieldTypeString},
+			{Tag: tag.AgreementID, Name: "AgreementID", Type: FieldTypeString},
+			{Tag: tag.AgreementDate, Name: "AgreementDate", Type: FieldTypeString},
+			{Tag: tag.StartDate, Name: "StartDate", Type: FieldTypeString},
+			{Tag: tag.EndDate, Name: "EndDate", Type: FieldTypeString},
+			{Tag: tag.AgreementCurrency, Name: "AgreementCurrency", Type: FieldTypeString},
+			{Tag: tag.DeliveryType, Name: "DeliveryType", Type: FieldTypeString},
+			{Tag: tag.StrikeCurrency, Name: "StrikeCurrency", Type: FieldTypeString},
+		},
+	},
+	"B": {
+		Name:    "News",
+		MsgType: "B",
+		Fields: []FieldDef{
+			{Tag: tag.NoLinesOfText, Name: "NoLinesOfText", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.Text, Name: "Text", Type: FieldTypeString, Required: true},
+				{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			}},
+			{Tag: tag.OrigTime, Name: "OrigTime", Type: FieldTypeUTCTimestamp},
+			{Tag: tag.Urgency, Name: "Urgency", Type: FieldTypeString},
+			{Tag: tag.RawDataLength, Name: "RawDataLength", Type: FieldTypeInt},
+			{Tag: tag.RawData, Name: "RawData", Type: FieldTypeString},
+			{Tag: tag.NoRelatedSym, Name: "NoRelatedSym", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.Symbol, Name: "Symbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.SymbolSfx, Name: "SymbolSfx", Type: FieldTypeString},
+				{Tag: tag.SecurityID, Name: "SecurityID", Type: FieldTypeString},
+				{Tag: tag.SecurityIDSource, Name: "SecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoSecurityAltID, Name: "NoSecurityAltID", Type: FieldTypeNumInGroup, Fields: noSecurityAltIDFields},
+				{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+				{Tag: tag.CFICode, Name: "CFICode", Type: FieldTypeString},
+				{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+				{Tag: tag.SecuritySubType, Name: "SecuritySubType", Type: FieldTypeString},
+				{Tag: tag.MaturityMonthYear, Name: "MaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.MaturityDate, Name: "MaturityDate", Type: FieldTypeString},
+				{Tag: tag.CouponPaymentDate, Name: "CouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.IssueDate, Name: "IssueDate", Type: FieldTypeString},
+				{Tag: tag.RepoCollateralSecurityType, Name: "RepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.RepurchaseTerm, Name: "RepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.RepurchaseRate, Name: "RepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.Factor, Name: "Factor", Type: FieldTypeDecimal},
+				{Tag: tag.CreditRating, Name: "CreditRating", Type: FieldTypeString},
+				{Tag: tag.InstrRegistry, Name: "InstrRegistry", Type: FieldTypeString},
+				{Tag: tag.CountryOfIssue, Name: "CountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.StateOrProvinceOfIssue, Name: "StateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.LocaleOfIssue, Name: "LocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.RedemptionDate, Name: "RedemptionDate", Type: FieldTypeString},
+				{Tag: tag.StrikePrice, Name: "StrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.StrikeCurrency, Name: "StrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.OptAttribute, Name: "OptAttribute", Type: FieldTypeString},
+				{Tag: tag.ContractMultiplier, Name: "ContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.CouponRate, Name: "CouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.SecurityExchange, Name: "SecurityExchange", Type: FieldTypeString},
+				{Tag: tag.Issuer, Name: "Issuer", Type: FieldTypeString},
+				{Tag: tag.EncodedIssuerLen, Name: "EncodedIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedIssuer, Name: "EncodedIssuer", Type: FieldTypeString},
+				{Tag: tag.SecurityDesc, Name: "SecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedSecurityDescLen, Name: "EncodedSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedSecurityDesc, Name: "EncodedSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.Pool, Name: "Pool", Type: FieldTypeString},
+				{Tag: tag.ContractSettlMonth, Name: "ContractSettlMonth", Type: FieldTypeString},
+				{Tag: tag.CPProgram, Name: "CPProgram", Type: FieldTypeString},
+				{Tag: tag.CPRegType, Name: "CPRegType", Type: FieldTypeString},
+				{Tag: tag.NoEvents, Name: "NoEvents", Type: FieldTypeNumInGroup, Fields: noEventsFields},
+				{Tag: tag.DatedDate, Name: "DatedDate", Type: FieldTypeString},
+				{Tag: tag.InterestAccrualDate, Name: "InterestAccrualDate", Type: FieldTypeString},
+			}},
+			{Tag: tag.Headline, Name: "Headline", Type: FieldTypeString, Required: true},
+			{Tag: tag.URLLink, Name: "URLLink", Type: FieldTypeString},
+			{Tag: tag.NoRoutingIDs, Name: "NoRoutingIDs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.RoutingType, Name: "RoutingType", Type: FieldTypeString, Required: true},
+				{Tag: tag.RoutingID, Name: "RoutingID", Type: FieldTypeString},
+			}},
+			{Tag: tag.EncodedHeadlineLen, Name: "EncodedHeadlineLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedHeadline, Name: "EncodedHeadline", Type: FieldTypeString},
+			{Tag: tag.NoLegs, Name: "NoLegs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.LegSymbol, Name: "LegSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.LegSymbolSfx, Name: "LegSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.LegSecurityID, Name: "LegSecurityID", Type: FieldTypeString},
+				{Tag: tag.LegSecurityIDSource, Name: "LegSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoLegSecurityAltID, Name: "NoLegSecurityAltID", Type: FieldTypeNumInGroup, Fields: noLegSecurityAltIDFields},
+				{Tag: tag.LegProduct, Name: "LegProduct", Type: FieldTypeInt},
+				{Tag: tag.LegCFICode, Name: "LegCFICode", Type: FieldTypeString},
+				{Tag: tag.LegSecurityType, Name: "LegSecurityType", Type: FieldTypeString},
+				{Tag: tag.LegSecuritySubType, Name: "LegSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.LegMaturityMonthYear, Name: "LegMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.LegMaturityDate, Name: "LegMaturityDate", Type: FieldTypeString},
+				{Tag: tag.LegCouponPaymentDate, Name: "LegCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.LegIssueDate, Name: "LegIssueDate", Type: FieldTypeString},
+				{Tag: tag.LegRepoCollateralSecurityType, Name: "LegRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseTerm, Name: "LegRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseRate, Name: "LegRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegFactor, Name: "LegFactor", Type: FieldTypeDecimal},
+				{Tag: tag.LegCreditRating, Name: "LegCreditRating", Type: FieldTypeString},
+				{Tag: tag.LegInstrRegistry, Name: "LegInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.LegCountryOfIssue, Name: "LegCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegStateOrProvinceOfIssue, Name: "LegStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegLocaleOfIssue, Name: "LegLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegRedemptionDate, Name: "LegRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.LegStrikePrice, Name: "LegStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.LegStrikeCurrency, Name: "LegStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.LegOptAttribute, Name: "LegOptAttribute", Type: FieldTypeString},
+				{Tag: tag.LegContractMultiplier, Name: "LegContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.LegCouponRate, Name: "LegCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegSecurityExchange, Name: "LegSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.LegIssuer, Name: "LegIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedLegIssuerLen, Name: "EncodedLegIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegIssuer, Name: "EncodedLegIssuer", Type: FieldTypeString},
+				{Tag: tag.LegSecurityDesc, Name: "LegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedLegSecurityDescLen, Name: "EncodedLegSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegSecurityDesc, Name: "EncodedLegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.LegRatioQty, Name: "LegRatioQty", Type: FieldTypeDecimal},
+				{Tag: tag.LegSide, Name: "LegSide", Type: FieldTypeString},
+				{Tag: tag.LegCurrency, Name: "LegCurrency", Type: FieldTypeString},
+				{Tag: tag.LegPool, Name: "LegPool", Type: FieldTypeString},
+				{Tag: tag.LegDatedDate, Name: "LegDatedDate", Type: FieldTypeString},
+				{Tag: tag.LegContractSettlMonth, Name: "LegContractSettlMonth", Type: FieldTypeString},
+				{Tag: tag.LegInterestAccrualDate, Name: "LegInterestAccrualDate", Type: FieldTypeString},
+			}},
+			{Tag: tag.NoUnderlyings, Name: "NoUnderlyings", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.UnderlyingSymbol, Name: "UnderlyingSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.UnderlyingSymbolSfx, Name: "UnderlyingSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityID, Name: "UnderlyingSecurityID", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityIDSource, Name: "UnderlyingSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoUnderlyingSecurityAltID, Name: "NoUnderlyingSecurityAltID", Type: FieldTypeNumInGroup, Fields: noUnderlyingSecurityAltIDFields},
+				{Tag: tag.UnderlyingProduct, Name: "UnderlyingProduct", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingCFICode, Name: "UnderlyingCFICode", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityType, Name: "UnderlyingSecurityType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecuritySubType, Name: "UnderlyingSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityMonthYear, Name: "UnderlyingMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityDate, Name: "UnderlyingMaturityDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCouponPaymentDate, Name: "UnderlyingCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssueDate, Name: "UnderlyingIssueDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRepoCollateralSecurityType, Name: "UnderlyingRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseTerm, Name: "UnderlyingRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseRate, Name: "UnderlyingRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingFactor, Name: "UnderlyingFactor", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCreditRating, Name: "UnderlyingCreditRating", Type: FieldTypeString},
+				{Tag: tag.UnderlyingInstrRegistry, Name: "UnderlyingInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCountryOfIssue, Name: "UnderlyingCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStateOrProvinceOfIssue, Name: "UnderlyingStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingLocaleOfIssue, Name: "UnderlyingLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRedemptionDate, Name: "UnderlyingRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStrikePrice, Name: "UnderlyingStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStrikeCurrency, Name: "UnderlyingStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingOptAttribute, Name: "UnderlyingOptAttribute", Type: FieldTypeString},
+				{Tag: tag.UnderlyingContractMultiplier, Name: "UnderlyingContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCouponRate, Name: "UnderlyingCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingSecurityExchange, Name: "UnderlyingSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssuer, Name: "UnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingIssuerLen, Name: "EncodedUnderlyingIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingIssuer, Name: "EncodedUnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityDesc, Name: "UnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingSecurityDescLen, Name: "EncodedUnderlyingSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingSecurityDesc, Name: "EncodedUnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPProgram, Name: "UnderlyingCPProgram", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPRegType, Name: "UnderlyingCPRegType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCurrency, Name: "UnderlyingCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingQty, Name: "UnderlyingQty", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingPx, Name: "UnderlyingPx", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingDirtyPrice, Name: "UnderlyingDirtyPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndPrice, Name: "UnderlyingEndPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStartValue, Name: "UnderlyingStartValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCurrentValue, Name: "UnderlyingCurrentValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndValue, Name: "UnderlyingEndValue", Type: FieldTypeDecimal},
+				{Tag: tag.NoUnderlyingStips, Name: "NoUnderlyingStips", Type: FieldTypeNumInGroup, Fields: noUnderlyingStipsFields},
+			}},
+		},
+	},
+	"9": {
+		Name:    "OrderCancelReject",
+		MsgType: "9",
+		Fields: []FieldDef{
+			{Tag: tag.Account, Name: "Account", Type: FieldTypeString},
+			{Tag: tag.ClOrdID, Name: "ClOrdID", Type: FieldTypeString, Required: true},
+			{Tag: tag.OrderID, Name: "OrderID", Type: FieldTypeString, Required: true},
+			{Tag: tag.OrdStatus, Name: "OrdStatus", Type: FieldTypeString, Required: true},
+			{Tag: tag.OrigClOrdID, Name: "OrigClOrdID", Type: FieldTypeString, Required: true},
+			{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+			{Tag: tag.TransactTime, Name: "TransactTime", Type: FieldTypeUTCTimestamp},
+			{Tag: tag.ListID, Name: "ListID", Type: FieldTypeString},
+			{Tag: tag.TradeDate, Name: "TradeDate", Type: FieldTypeString},
+			{Tag: tag.CxlRejReason, Name: "CxlRejReason", Type: FieldTypeString},
+			{Tag: tag.SecondaryOrderID, Name: "SecondaryOrderID", Type: FieldTypeString},
+			{Tag: tag.TradeOriginationDate, Name: "TradeOriginationDate", Type: FieldTypeString},
+			{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			{Tag: tag.CxlRejResponseTo, Name: "CxlRejResponseTo", Type: FieldTypeString, Required: true},
+			{Tag: tag.SecondaryClOrdID, Name: "SecondaryClOrdID", Type: FieldTypeString},
+			{Tag: tag.AccountType, Name: "AccountType", Type: FieldTypeString},
+			{Tag: tag.ClOrdLinkID, Name: "ClOrdLinkID", Type: FieldTypeString},
+			{Tag: tag.OrigOrdModTime, Name: "OrigOrdModTime", Type: FieldTypeUTCTimestamp},
+			{Tag: tag.WorkingIndicator, Name: "WorkingIndicator", Type: FieldTypeBool},
+			{Tag: tag.AcctIDSource, Name: "AcctIDSource", Type: FieldTypeString},
+		},
+	},
+	"G": {
+		Name:    "OrderCancelReplaceRequest",
+		MsgType: "G",
+		Fields: []FieldDef{
+			{Tag: tag.Account, Name: "Account", Type: FieldTypeString},
+			{Tag: tag.ClOrdID, Name: "ClOrdID", Type: FieldTypeString, Required: true},
+			{Tag: tag.Commission, Name: "Commission", Type: FieldTypeDecimal},
+			{Tag: tag.CommType, Name: "CommType", Type: FieldTypeString},
+			{Tag: tag.Currency, Name: "Currency", Type: FieldTypeString},
+			{Tag: tag.ExecInst, Name: "ExecInst", Type: FieldTypeString},
+			{Tag: tag.HandlInst, Name: "HandlInst", Type: FieldTypeString},
+			{Tag: tag.SecurityIDSource, Name: "SecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.OrderID, Name: "OrderID", Type: FieldTypeString},
+			{Tag: tag.OrderQty, Name: "OrderQty", Type: FieldTypeDecimal},
+			{Tag: tag.OrdType, Name: "OrdType", Type: FieldTypeString, Required: true},
+			{Tag: tag.OrigClOrdID, Name: "OrigClOrdID", Type: FieldTypeString, Required: true},
+			{Tag: tag.Price, Name: "Price", Type: FieldTypeDecimal},
+			{Tag: tag.SecurityID, Name: "SecurityID", Type: FieldTypeString},
+			{Tag: tag.Side, Name: "Side", Type: FieldTypeString, Required: true},
+			{Tag: tag.Symbol, Name: "Symbol", Type: FieldTypeString},
+			{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+			{Tag: tag.TimeInForce, Name: "TimeInForce", Type: FieldTypeString},
+			{Tag: tag.TransactTime, Name: "TransactTime", Type: FieldTypeUTCTimestamp, Required: true},
+			{Tag: tag.SettlType, Name: "SettlType", Type: FieldTypeString},
+			{Tag: tag.SettlDate, Name: "SettlDate", Type: FieldTypeString},
+			{Tag: tag.SymbolSfx, Name: "SymbolSfx", Type: FieldTypeString},
+			{Tag: tag.ListID, Name: "ListID", Type: FieldTypeString},
+			{Tag: tag.AllocID, Name: "AllocID", Type: FieldTypeString},
+			{Tag: tag.TradeDate, Name: "TradeDate", Type: FieldTypeString},
+			{Tag: tag.PositionEffect, Name: "PositionEffect", Type: FieldTypeString},
+			{Tag: tag.NoAllocs, Name: "NoAllocs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.AllocAccount, Name: "AllocAccount", Type: FieldTypeString, Required: true},
+				{Tag: tag.AllocAcctIDSource, Name: "AllocAcctIDSource", Type: FieldTypeInt},
+				{Tag: tag.AllocSettlCurrency, Name: "AllocSettlCurrency", Type: FieldTypeString},
+				{Tag: tag.IndividualAllocID, Name: "IndividualAllocID", Type: FieldTypeString},
+				{Tag: tag.NoNestedPartyIDs, Name: "NoNestedPartyIDs", Type: FieldTypeNumInGroup, Fields: noNestedPartyIDsFields},
+				{Tag: tag.AllocQty, Name: "AllocQty", Type: FieldTypeDecimal},
+			}},
+			{Tag: tag.StopPx, Name: "StopPx", Type: FieldTypeDecimal},
+			{Tag: tag.ExDestination, Name: "ExDestination", Type: FieldTypeString},
+			{Tag: tag.Issuer, Name: "Issuer", Type: FieldTypeString},
+			{Tag: tag.SecurityDesc, Name: "SecurityDesc", Type: FieldTypeString},
+			{Tag: tag.MinQty, Name: "MinQty", Type: FieldTypeDecimal},
+			{Tag: tag.MaxFloor, Name: "MaxFloor", Type: FieldTypeDecimal},
+			{Tag: tag.LocateReqd, Name: "LocateReqd", Type: FieldTypeBool},
+			{Tag: tag.SettlCurrency, Name: "SettlCurrency", Type: FieldTypeString},
+			{Tag: tag.ForexReq, Name: "ForexReq", Type: FieldTypeBool},
+			{Tag: tag.ExpireTime, Name: "ExpireTime", Type: FieldTypeUTCTimestamp},
+			{Tag: tag.CashOrderQty, Name: "CashOrderQty", Type: FieldTypeDecimal},
+			{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+			{Tag: tag.EffectiveTime, Name: "EffectiveTime", Type: FieldTypeUTCTimestamp},
+			{Tag: tag.OrderQty2, Name: "OrderQty2", Type: FieldTypeDecimal},
+			{Tag: tag.SettlDate2, Name: "SettlDate2", Type: FieldTypeString},
+			{Tag: tag.MaturityMonthYear, Name: "MaturityMonthYear", Type: FieldTypeString},
+			{Tag: tag.StrikePrice, Name: "StrikePrice", Type: FieldTypeDecimal},
+			{Tag: tag.CoveredOrUncovered, Name: "CoveredOrUncovered", Type: FieldTypeString},
+			{Tag: tag.OptAttribute, Name: "OptAttribute", Type: FieldTypeString},
+			{Tag: tag.SecurityExchange, Name: "SecurityExchange", Type: FieldTypeString},
+			{Tag: tag.MaxShow, Name: "MaxShow", Type: FieldTypeDecimal},
+			{Tag: tag.PegOffsetValue, Name: "PegOffsetValue", Type: FieldTypeDecimal},
+			{Tag: tag.Spread, Name: "Spread", Type: FieldTypeDecimal},
+			{Tag: tag.BenchmarkCurveCurrency, Name: "BenchmarkCurveCurrency", Type: FieldTypeString},
+			{Tag: tag.BenchmarkCurveName, Name: "BenchmarkCurveName", Type: FieldTypeString},
+			{Tag: tag.BenchmarkCurvePoint, Name: "BenchmarkCurvePoint", Type: FieldTypeString},
+			{Tag: tag.CouponRate, Name: "CouponRate", Type: FieldTypeDecimal},
+			{Tag: tag.CouponPaymentDate, Name: "CouponPaymentDate", Type: FieldTypeString},
+			{Tag: tag.IssueDate, Name: "IssueDate", Type: FieldTypeString},
+			{Tag: tag.RepurchaseTerm, Name: "RepurchaseTerm", Type: FieldTypeInt},
+			{Tag: tag.RepurchaseRate, Name: "RepurchaseRate", Type: FieldTypeDecimal},
+			{Tag: tag.Factor, Name: "Factor", Type: FieldTypeDecimal},
+			{Tag: tag.TradeOriginationDate, Name: "TradeOriginationDate", Type: FieldTypeString},
+			{Tag: tag.ContractMultiplier, Name: "ContractMultiplier", Type: FieldTypeDecimal},
+			{Tag: tag.YieldType, Name: "YieldType", Type: FieldTypeString},
+			{Tag: tag.Yield, Name: "Yield", Type: FieldTypeDecimal},
+			{Tag: tag.RepoCollateralSecurityType, Name: "RepoCollateralSecurityType", Type: FieldTypeInt},
+			{Tag: tag.RedemptionDate, Name: "RedemptionDate", Type: FieldTypeString},
+			{Tag: tag.CreditRating, Name: "CreditRating", Type: FieldTypeString},
+			{Tag: tag.EncodedIssuerLen, Name: "EncodedIssuerLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedIssuer, Name: "EncodedIssuer", Type: FieldTypeString},
+			{Tag: tag.EncodedSecurityDescLen, Name: "EncodedSecurityDescLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedSecurityDesc, Name: "EncodedSecurityDesc", Type: FieldTypeString},
+			{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			{Tag: tag.ComplianceID, Name: "ComplianceID", Type: FieldTypeString},
+			{Tag: tag.SolicitedFlag, Name: "SolicitedFlag", Type: FieldTypeBool},
+			{Tag: tag.NoTradingSessions, Name: "NoTradingSessions", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.TradingSessionID, Name: "TradingSessionID", Type: FieldTypeString, Required: true},
+				{Tag: tag.TradingSessionSubID, Name: "TradingSessionSubID", Type: FieldTypeString},
+			}},
+			{Tag: tag.DiscretionInst, Name: "DiscretionInst", Type: FieldTypeString},
+			{Tag: tag.DiscretionOffsetValue, Name: "DiscretionOffsetValue", Type: FieldTypeDecimal},
+			{Tag: tag.PriceType, Name: "PriceType", Type: FieldTypeString},
+			{Tag: tag.GTBookingInst, Name: "GTBookingInst", Type: FieldTypeString},
+			{Tag: tag.ExpireDate, Name: "ExpireDate", Type: FieldTypeString},
+			{Tag: tag.NoPartyIDs, Name: "NoPartyIDs", Type: FieldTypeNumInGroup, Fields: noPartyIDsFields},
+			{Tag: tag.NoSecurityAltID, Name: "NoSecurityAltID", Type: FieldTypeNumInGroup, Fields: noSecurityAltIDFields},
+			{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+			{Tag: tag.CFICode, Name: "CFICode", Type: FieldTypeString},
+			{Tag: tag.RoundingDirection, Name: "RoundingDirection", Type: FieldTypeString},
+			{Tag: tag.RoundingModulus, Name: "RoundingModulus", Type: FieldTypeDecimal},
+			{Tag: tag.CountryOfIssue, Name: "CountryOfIssue", Type: FieldTypeString},
+			{Tag: tag.StateOrProvinceOfIssue, Name: "StateOrProvinceOfIssue", Type: FieldTypeString},
+			{Tag: tag.LocaleOfIssue, Name: "LocaleOfIssue", Type: FieldTypeString},
+			{Tag: tag.CommCurrency, Name: "CommCurrency", Type: FieldTypeString},
+			{Tag: tag.CancellationRights, Name: "CancellationRights", Type: FieldTypeString},
+			{Tag: tag.MoneyLaunderingStatus, Name: "MoneyLaunderingStatus", Type: FieldTypeString},
+			{Tag: tag.Designation, Name: "Designation", Type: FieldTypeString},
+			{Tag: tag.FundRenewWaiv, Name: "FundRenewWaiv", Type: FieldTypeString},
+			{Tag: tag.RegistID, Name: "RegistID", Type: FieldTypeString},
+			{Tag: tag.OrderPercent, Name: "OrderPercent", Type: FieldTypeDecimal},
+			{Tag: tag.SecondaryClOrdID, Name: "SecondaryClOrdID", Type: FieldTypeString},
+			{Tag: tag.OrderCapacity, Name: "OrderCapacity", Type: FieldTypeString},
+			{Tag: tag.OrderRestrictions, Name: "OrderRestrictions", Type: FieldTypeString},
+			{Tag: tag.MaturityDate, Name: "MaturityDate", Type: FieldTypeString},
+			{Tag: tag.InstrRegistry, Name: "InstrRegistry", Type: FieldTypeString},
+			{Tag: tag.CashMargin, Name: "CashMargin", Type: FieldTypeString},
+			{Tag: tag.AccountType, Name: "AccountType", Type: FieldTypeString},
+			{Tag: tag.CustOrderCapacity, Name: "CustOrderCapacity", Type: FieldTypeString},
+			{Tag: tag.ClOrdLinkID, Name: "ClOrdLinkID", Type: FieldTypeString},
+			{Tag: tag.OrigOrdModTime, Name: "OrigOrdModTime", Type: FieldTypeUTCTimestamp},
+			{Tag: tag.DayBookingInst, Name: "DayBookingInst", Type: FieldTypeString},
+			{Tag: tag.BookingUnit, Name: "BookingUnit", Type: FieldTypeString},
+			{Tag: tag.PreallocMethod, Name: "PreallocMethod", Type: FieldTypeString},
+			{Tag: tag.ClearingFeeIndicator, Name: "ClearingFeeIndicator", Type: FieldTypeString},
+			{Tag: tag.Price2, Name: "Price2", Type: FieldTypeDecimal},
+			{Tag: tag.AcctIDSource, Name: "AcctIDSource", Type: FieldTypeString},
+			{Tag: tag.BenchmarkPrice, Name: "BenchmarkPrice", Type: FieldTypeDecimal},
+			{Tag: tag.BenchmarkPriceType, Name: "BenchmarkPriceType", Type: FieldTypeInt},
+			{Tag: tag.ContractSettlMonth, Name: "ContractSettlMonth", Type: FieldTypeString},
+			{Tag: tag.Pool, Name: "Pool", Type: FieldTypeString},
+			{Tag: tag.YieldRedemptionDate, Name: "YieldRedemptionDate", Type: FieldTypeString},
+			{Tag: tag.YieldRedemptionPrice, Name: "YieldRedemptionPrice", Type: FieldTypeDecimal},
+			{Tag: tag.YieldRedemptionPriceType, Name: "YieldRedemptionPriceType", Type: FieldTypeInt},
+			{Tag: tag.BenchmarkSecurityID, Name: "BenchmarkSecurityID", Type: FieldTypeString},
+			{Tag: tag.YieldCalcDate, Name: "YieldCalcDate", Type: FieldTypeString},
+			{Tag: tag.NoUnderlyings, Name: "NoUnderlyings", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.UnderlyingSymbol, Name: "UnderlyingSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.UnderlyingSymbolSfx, Name: "UnderlyingSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityID, Name: "UnderlyingSecurityID", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityIDSource, Name: "UnderlyingSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoUnderlyingSecurityAltID, Name: "NoUnderlyingSecurityAltID", Type: FieldTypeNumInGroup, Fields: noUnderlyingSecurityAltIDFields},
+				{Tag: tag.UnderlyingProduct, Name: "UnderlyingProduct", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingCFICode, Name: "UnderlyingCFICode", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityType, Name: "UnderlyingSecurityType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecuritySubType, Name: "UnderlyingSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityMonthYear, Name: "UnderlyingMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityDate, Name: "UnderlyingMaturityDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCouponPaymentDate, Name: "UnderlyingCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssueDate, Name: "UnderlyingIssueDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRepoCollateralSecurityType, Name: "UnderlyingRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseTerm, Name: "UnderlyingRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseRate, Name: "UnderlyingRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingFactor, Name: "UnderlyingFactor", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCreditRating, Name: "UnderlyingCreditRating", Type: FieldTypeString},
+				{Tag: tag.UnderlyingInstrRegistry, Name: "UnderlyingInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCountryOfIssue, Name: "UnderlyingCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStateOrProvinceOfIssue, Name: "UnderlyingStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingLocaleOfIssue, Name: "UnderlyingLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRedemptionDate, Name: "UnderlyingRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStrikePrice, Name: "UnderlyingStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStrikeCurrency, Name: "UnderlyingStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingOptAttribute, Name: "UnderlyingOptAttribute", Type: FieldTypeString},
+				{Tag: tag.UnderlyingContractMultiplier, Name: "UnderlyingContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCouponRate, Name: "UnderlyingCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingSecurityExchange, Name: "UnderlyingSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssuer, Name: "UnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingIssuerLen, Name: "EncodedUnderlyingIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingIssuer, Name: "EncodedUnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityDesc, Name: "UnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingSecurityDescLen, Name: "EncodedUnderlyingSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingSecurityDesc, Name: "EncodedUnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPProgram, Name: "UnderlyingCPProgram", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPRegType, Name: "UnderlyingCPRegType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCurrency, Name: "UnderlyingCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingQty, Name: "UnderlyingQty", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingPx, Name: "UnderlyingPx", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingDirtyPrice, Name: "UnderlyingDirtyPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndPrice, Name: "UnderlyingEndPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStartValue, Name: "UnderlyingStartValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCurrentValue, Name: "UnderlyingCurrentValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndValue, Name: "UnderlyingEndValue", Type: FieldTypeDecimal},
+				{Tag: tag.NoUnderlyingStips, Name: "NoUnderlyingStips", Type: FieldTypeNumInGroup, Fields: noUnderlyingStipsFields},
+			}},
+			{Tag: tag.BenchmarkSecurityIDSource, Name: "BenchmarkSecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.SecuritySubType, Name: "SecuritySubType", Type: FieldTypeString},
+			{Tag: tag.BookingType, Name: "BookingType", Type: FieldTypeString},
+			{Tag: tag.TerminationType, Name: "TerminationType", Type: FieldTypeString},
+			{Tag: tag.PegMoveType, Name: "PegMoveType", Type: FieldTypeString},
+			{Tag: tag.PegOffsetType, Name: "PegOffsetType", Type: FieldTypeString},
+			{Tag: tag.PegLimitType, Name: "PegLimitType", Type: FieldTypeString},
+			{Tag: tag.PegRoundDirection, Name: "PegRoundDirection", Type: FieldTypeString},
+			{Tag: tag.PegScope, Name: "PegScope", Type: FieldTypeString},
+			{Tag: tag.DiscretionMoveType, Name: "DiscretionMoveType", Type: FieldTypeString},
+			{Tag: tag.DiscretionOffsetType, Name: "DiscretionOffsetType", Type: FieldTypeString},
+			{Tag: tag.DiscretionLimitType, Name: "DiscretionLimitType", Type: FieldTypeString},
+			{Tag: tag.DiscretionRoundDirection, Name: "DiscretionRoundDirection", Type: FieldTypeString},
+			{Tag: tag.DiscretionScope, Name: "DiscretionScope", Type: FieldTypeString},
+			{Tag: tag.TargetStrategy, Name: "TargetStrategy", Type: FieldTypeString},
+			{Tag: tag.TargetStrategyParameters, Name: "TargetStrategyParameters", Type: FieldTypeString},
+			{Tag: tag.ParticipationRate, Name: "ParticipationRate", Type: FieldTypeDecimal},
+			{Tag: tag.QtyType, Name: "QtyType", Type: FieldTypeString},
+			{Tag: tag.NoEvents, Name: "NoEvents", Type: FieldTypeNumInGroup, Fields: noEventsFields},
+			{Tag: tag.DatedDate, Name: "DatedDate", Type: FieldTypeString},
+			{Tag: tag.InterestAccrualDate, Name: "InterestAccrualDate", Type: FieldTypeString},
+			{Tag: tag.CPProgram, Name: "CPProgram", Type: FieldTypeString},
+			{Tag: tag.CPRegType, Name: "CPRegType", Type: FieldTypeString},
+			{Tag: tag.MarginRatio, Name: "MarginRatio", Type: FieldTypeDecimal},
+			{Tag: tag.AgreementDesc, Name: "AgreementDesc", Type: FieldTypeString},
+			{Tag: tag.AgreementID, Name: "AgreementID", Type: FieldTypeString},
+			{Tag: tag.AgreementDate, Name: "AgreementDate", Type: FieldTypeString},
+			{Tag: tag.StartDate, Name: "StartDate", Type: FieldTypeString},
+			{Tag: tag.EndDate, Name: "EndDate", Type: FieldTypeString},
+			{Tag: tag.AgreementCurrency, Name: "AgreementCurrency", Type: FieldTypeString},
+			{Tag: tag.DeliveryType, Name: "DeliveryType", Type: FieldTypeString},
+			{Tag: tag.StrikeCurrency, Name: "StrikeCurrency", Type: FieldTypeString},
+		},
+	},
+	"F": {
+		Name:    "OrderCancelRequest",
+		MsgType: "F",
+		Fields: []FieldDef{
+			{Tag: tag.Account, Name: "Account", Type: FieldTypeString},
+			{Tag: tag.ClOrdID, Name: "ClOrdID", Type: FieldTypeString, Required: true},
+			{Tag: tag.SecurityIDSource, Name: "SecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.OrderID, Name: "OrderID", Type: FieldTypeString},
+			{Tag: tag.OrderQty, Name: "OrderQty", Type: FieldTypeDecimal},
+			{Tag: tag.OrigClOrdID, Name: "OrigClOrdID", Type: FieldTypeString, Required: true},
+			{Tag: tag.SecurityID, Name: "SecurityID", Type: FieldTypeString},
+			{Tag: tag.Side, Name: "Side", Type: FieldTypeString, Required: true},
+			{Tag: tag.Symbol, Name: "Symbol", Type: FieldTypeString},
+			{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+			{Tag: tag.TransactTime, Name: "TransactTime", Type: FieldTypeUTCTimestamp, Required: true},
+			{Tag: tag.SymbolSfx, Name: "SymbolSfx", Type: FieldTypeString},
+			{Tag: tag.ListID, Name: "ListID", Type: FieldTypeString},
+			{Tag: tag.Issuer, Name: "Issuer", Type: FieldTypeString},
+			{Tag: tag.SecurityDesc, Name: "SecurityDesc", Type: FieldTypeString},
+			{Tag: tag.CashOrderQty, Name: "CashOrderQty", Type: FieldTypeDecimal},
+			{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+			{Tag: tag.MaturityMonthYear, Name: "MaturityMonthYear", Type: FieldTypeString},
+			{Tag: tag.StrikePrice, Name: "StrikePrice", Type: FieldTypeDecimal},
+			{Tag: tag.OptAttribute, Name: "OptAttribute", Type: FieldTypeString},
+			{Tag: tag.SecurityExchange, Name: "SecurityExchange", Type: FieldTypeString},
+			{Tag: tag.CouponRate, Name: "CouponRate", Type: FieldTypeDecimal},
+			{Tag: tag.CouponPaymentDate, Name: "CouponPaymentDate", Type: FieldTypeString},
+			{Tag: tag.IssueDate, Name: "IssueDate", Type: FieldTypeString},
+			{Tag: tag.RepurchaseTerm, Name: "RepurchaseTerm", Type: FieldTypeInt},
+			{Tag: tag.RepurchaseRate, Name: "RepurchaseRate", Type: FieldTypeDecimal},
+			{Tag: tag.Factor, Name: "Factor", Type: FieldTypeDecimal},
+			{Tag: tag.ContractMultiplier, Name: "ContractMultiplier", Type: FieldTypeDecimal},
+			{Tag: tag.RepoCollateralSecurityType, Name: "RepoCollateralSecurityType", Type: FieldTypeInt},
+			{Tag: tag.RedemptionDate, Name: "RedemptionDate", Type: FieldTypeString},
+			{Tag: tag.CreditRating, Name: "CreditRating", Type: FieldTypeString},
+			{Tag: tag.EncodedIssuerLen, Name: "EncodedIssuerLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedIssuer, Name: "EncodedIssuer", Type: FieldTypeString},
+			{Tag: tag.EncodedSecurityDescLen, Name: "EncodedSecurityDescLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedSecurityDesc, Name: "EncodedSecurityDesc", Type: FieldTypeString},
+			{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			{Tag: tag.ComplianceID, Name: "ComplianceID", Type: FieldTypeString},
+			{Tag: tag.NoPartyIDs, Name: "NoPartyIDs", Type: FieldTypeNumInGroup, Fields: noPartyIDsFields},
+			{Tag: tag.NoSecurityAltID, Name: "NoSecurityAltID", Type: FieldTypeNumInGroup, Fields: noSecurityAltIDFields},
+			{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+			{Tag: tag.CFICode, Name: "CFICode", Type: FieldTypeString},
+			{Tag: tag.RoundingDirection, Name: "RoundingDirection", Type: FieldTypeString},
+			{Tag: tag.RoundingModulus, Name: "RoundingModulus", Type: FieldTypeDecimal},
+			{Tag: tag.CountryOfIssue, Name: "CountryOfIssue", Type: FieldTypeString},
+			{Tag: tag.StateOrProvinceOfIssue, Name: "StateOrProvinceOfIssue", Type: FieldTypeString},
+			{Tag: tag.LocaleOfIssue, Name: "LocaleOfIssue", Type: FieldTypeString},
+			{Tag: tag.OrderPercent, Name: "OrderPercent", Type: FieldTypeDecimal},
+			{Tag: tag.SecondaryClOrdID, Name: "SecondaryClOrdID", Type: FieldTypeString},
+			{Tag: tag.MaturityDate, Name: "MaturityDate", Type: FieldTypeString},
+			{Tag: tag.InstrRegistry, Name: "InstrRegistry", Type: FieldTypeString},
+			{Tag: tag.AccountType, Name: "AccountType", Type: FieldTypeString},
+			{Tag: tag.ClOrdLinkID, Name: "ClOrdLinkID", Type: FieldTypeString},
+			{Tag: tag.OrigOrdModTime, Name: "OrigOrdModTime", Type: FieldTypeUTCTimestamp},
+			{Tag: tag.AcctIDSource, Name: "AcctIDSource", Type: FieldTypeString},
+			{Tag: tag.ContractSettlMonth, Name: "ContractSettlMonth", Type: FieldTypeString},
+			{Tag: tag.Pool, Name: "Pool", Type: FieldTypeString},
+			{Tag: tag.NoUnderlyings, Name: "NoUnderlyings", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.UnderlyingSymbol, Name: "UnderlyingSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.UnderlyingSymbolSfx, Name: "UnderlyingSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityID, Name: "UnderlyingSecurityID", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityIDSource, Name: "UnderlyingSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoUnderlyingSecurityAltID, Name: "NoUnderlyingSecurityAltID", Type: FieldTypeNumInGroup, Fields: noUnderlyingSecurityAltIDFields},
+				{Tag: tag.UnderlyingProduct, Name: "UnderlyingProduct", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingCFICode, Name: "UnderlyingCFICode", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityType, Name: "UnderlyingSecurityType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecuritySubType, Name: "UnderlyingSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityMonthYear, Name: "UnderlyingMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityDate, Name: "UnderlyingMaturityDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCouponPaymentDate, Name: "UnderlyingCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssueDate, Name: "UnderlyingIssueDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRepoCollateralSecurityType, Name: "UnderlyingRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseTerm, Name: "UnderlyingRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseRate, Name: "UnderlyingRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingFactor, Name: "UnderlyingFactor", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCreditRating, Name: "UnderlyingCreditRating", Type: FieldTypeString},
+				{Tag: tag.UnderlyingInstrRegistry, Name: "UnderlyingInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCountryOfIssue, Name: "UnderlyingCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStateOrProvinceOfIssue, Name: "UnderlyingStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingLocaleOfIssue, Name: "UnderlyingLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRedemptionDate, Name: "UnderlyingRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStrikePrice, Name: "UnderlyingStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStrikeCurrency, Name: "UnderlyingStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingOptAttribute, Name: "UnderlyingOptAttribute", Type: FieldTypeString},
+				{Tag: tag.UnderlyingContractMultiplier, Name: "UnderlyingContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCouponRate, Name: "UnderlyingCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingSecurityExchange, Name: "UnderlyingSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssuer, Name: "UnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingIssuerLen, Name: "EncodedUnderlyingIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingIssuer, Name: "EncodedUnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityDesc, Name: "UnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingSecurityDescLen, Name: "EncodedUnderlyingSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingSecurityDesc, Name: "EncodedUnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPProgram, Name: "UnderlyingCPProgram", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPRegType, Name: "UnderlyingCPRegType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCurrency, Name: "UnderlyingCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingQty, Name: "UnderlyingQty", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingPx, Name: "UnderlyingPx", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingDirtyPrice, Name: "UnderlyingDirtyPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndPrice, Name: "UnderlyingEndPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStartValue, Name: "UnderlyingStartValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCurrentValue, Name: "UnderlyingCurrentValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndValue, Name: "UnderlyingEndValue", Type: FieldTypeDecimal},
+				{Tag: tag.NoUnderlyingStips, Name: "NoUnderlyingStips", Type: FieldTypeNumInGroup, Fields: noUnderlyingStipsFields},
+			}},
+			{Tag: tag.SecuritySubType, Name: "SecuritySubType", Type: FieldTypeString},
+			{Tag: tag.TerminationType, Name: "TerminationType", Type: FieldTypeString},
+			{Tag: tag.NoEvents, Name: "NoEvents", Type: FieldTypeNumInGroup, Fields: noEventsFields},
+			{Tag: tag.DatedDate, Name: "DatedDate", Type: FieldTypeString},
+			{Tag: tag.InterestAccrualDate, Name: "InterestAccrualDate", Type: FieldTypeString},
+			{Tag: tag.CPProgram, Name: "CPProgram", Type: FieldTypeString},
+			{Tag: tag.CPRegType, Name: "CPRegType", Type: FieldTypeString},
+			{Tag: tag.MarginRatio, Name: "MarginRatio", Type: FieldTypeDecimal},
+			{Tag: tag.AgreementDesc, Name: "AgreementDesc", Type: FieldTypeString},
+			{Tag: tag.AgreementID, Name: "AgreementID", Type: FieldTypeString},
+			{Tag: tag.AgreementDate, Name: "AgreementDate", Type: FieldTypeString},
+			{Tag: tag.StartDate, Name: "StartDate", Type: FieldTypeString},
+			{Tag: tag.EndDate, Name: "EndDate", Type: FieldTypeString},
+			{Tag: tag.AgreementCurrency, Name: "AgreementCurrency", Type: FieldTypeString},
+			{Tag: tag.DeliveryType, Name: "DeliveryType", Type: FieldTypeString},
+			{Tag: tag.StrikeCurrency, Name: "StrikeCurrency", Type: FieldTypeString},
+		},
+	},
+	"r": {
+		Name:    "OrderMassCancelReport",
+		MsgType: "r",
+		Fields: []FieldDef{
+			{Tag: tag.ClOrdID, Name: "ClOrdID", Type: FieldTypeString},
+			{Tag: tag.SecurityIDSource, Name: "SecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.OrderID, Name: "OrderID", Type: FieldTypeString, Required: true},
+			{Tag: tag.SecurityID, Name: "SecurityID", Type: FieldTypeString},
+			{Tag: tag.Side, Name: "Side", Type: FieldTypeString},
+			{Tag: tag.Symbol, Name: "Symbol", Type: FieldTypeString},
+			{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+			{Tag: tag.TransactTime, Name: "TransactTime", Type: FieldTypeUTCTimestamp},
+			{Tag: tag.SymbolSfx, Name: "SymbolSfx", Type: FieldTypeString},
+			{Tag: tag.Issuer, Name: "Issuer", Type: FieldTypeString},
+			{Tag: tag.SecurityDesc, Name: "SecurityDesc", Type: FieldTypeString},
+			{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+			{Tag: tag.SecondaryOrderID, Name: "SecondaryOrderID", Type: FieldTypeString},
+			{Tag: tag.MaturityMonthYear, Name: "MaturityMonthYear", Type: FieldTypeString},
+			{Tag: tag.StrikePrice, Name: "StrikePrice", Type: FieldTypeDecimal},
+			{Tag: tag.OptAttribute, Name: "OptAttribute", Type: FieldTypeString},
+			{Tag: tag.SecurityExchange, Name: "SecurityExchange", Type: FieldTypeString},
+			{Tag: tag.CouponRate, Name: "CouponRate", Type: FieldTypeDecimal},
+			{Tag: tag.CouponPaymentDate, Name: "CouponPaymentDate", Type: FieldTypeString},
+			{Tag: tag.IssueDate, Name: "IssueDate", Type: FieldTypeString},
+			{Tag: tag.RepurchaseTerm, Name: "RepurchaseTerm", Type: FieldTypeInt},
+			{Tag: tag.RepurchaseRate, Name: "RepurchaseRate", Type: FieldTypeDecimal},
+			{Tag: tag.Factor, Name: "Factor", Type: FieldTypeDecimal},
+			{Tag: tag.ContractMultiplier, Name: "ContractMultiplier", Type: FieldTypeDecimal},
+			{Tag: tag.RepoCollateralSecurityType, Name: "RepoCollateralSecurityType", Type: FieldTypeInt},
+			{Tag: tag.RedemptionDate, Name: "RedemptionDate", Type: FieldTypeString},
+			{Tag: tag.UnderlyingCouponPaymentDate, Name: "UnderlyingCouponPaymentDate", Type: FieldTypeString},
+			{Tag: tag.UnderlyingIssueDate, Name: "UnderlyingIssueDate", Type: FieldTypeString},
+			{Tag: tag.UnderlyingRepoCollateralSecurityType, Name: "UnderlyingRepoCollateralSecurityType", Type: FieldTypeInt},
+			{Tag: tag.UnderlyingRepurchaseTerm, Name: "UnderlyingRepurchaseTerm", Type: FieldTypeInt},
+			{Tag: tag.UnderlyingRepurchaseRate, Name: "UnderlyingRepurchaseRate", Type: FieldTypeDecimal},
+			{Tag: tag.UnderlyingFactor, Name: "UnderlyingFactor", Type: FieldTypeDecimal},
+			{Tag: tag.UnderlyingRedemptionDate, Name: "UnderlyingRedemptionDate", Type: FieldTypeString},
+			{Tag: tag.CreditRating, Name: "CreditRating", Type: FieldTypeString},
+			{Tag: tag.UnderlyingCreditRating, Name: "UnderlyingCreditRating", Type: FieldTypeString},
+			{Tag: tag.UnderlyingSecurityIDSource, Name: "UnderlyingSecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.UnderlyingIssuer, Name: "UnderlyingIssuer", Type: FieldTypeString},
+			{Tag: tag.UnderlyingSecurityDesc, Name: "UnderlyingSecurityDesc", Type: FieldTypeString},
+			{Tag: tag.UnderlyingSecurityExchange, Name: "UnderlyingSecurityExchange", Type: FieldTypeString},
+			{Tag: tag.UnderlyingSecurityID, Name: "UnderlyingSecurityID", Type: FieldTypeString},
+			{Tag: tag.UnderlyingSecurityType, Name: "UnderlyingSecurityType", Type: FieldTypeString},
+			{Tag: tag.UnderlyingSymbol, Name: "UnderlyingSymbol", Type: FieldTypeString},
+			{Tag: tag.UnderlyingSymbolSfx, Name: "UnderlyingSymbolSfx", Type: FieldTypeString},
+			{Tag: tag.UnderlyingMaturityMonthYear, Name: "UnderlyingMaturityMonthYear", Type: FieldTypeString},
+			{Tag: tag.UnderlyingStrikePrice, Name: "UnderlyingStrikePrice", Type: FieldTypeDecimal},
+			{Tag: tag.UnderlyingOptAttribute, Name: "UnderlyingOptAttribute", Type: FieldTypeString},
+			{Tag: tag.UnderlyingCurrency, Name: "UnderlyingCurrency", Type: FieldTypeString},
+			{Tag: tag.TradingSessionID, Name: "TradingSessionID", Type: FieldTypeString},
+			{Tag: tag.EncodedIssuerLen, Name: "EncodedIssuerLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedIssuer, Name: "EncodedIssuer", Type: FieldTypeString},
+			{Tag: tag.EncodedSecurityDescLen, Name: "EncodedSecurityDescLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedSecurityDesc, Name: "EncodedSecurityDesc", Type: FieldTypeString},
+			{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			{Tag: tag.EncodedUnderlyingIssuerLen, Name: "EncodedUnderlyingIssuerLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedUnderlyingIssuer, Name: "EncodedUnderlyingIssuer", Type: FieldTypeString},
+			{Tag: tag.EncodedUnderlyingSecurityDescLen, Name: "EncodedUnderlyingSecurityDescLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedUnderlyingSecurityDesc, Name: "EncodedUnderlyingSecurityDesc", Type: FieldTypeString},
+			{Tag: tag.UnderlyingCouponRate, Name: "UnderlyingCouponRate", Type: FieldTypeDecimal},
+			{Tag: tag.UnderlyingContractMultiplier, Name: "UnderlyingContractMultiplier", Type: FieldTypeDecimal},
+			{Tag: tag.NoSecurityAltID, Name: "NoSecurityAltID", Type: FieldTypeNumInGroup, Fields: noSecurityAltIDFields},
+			{Tag: tag.NoUnderlyingSecurityAltID, Name: "NoUnderlyingSecurityAltID", Type: FieldTypeNumInGroup, Fields: noUnderlyingSecurityAltIDFields},
+			{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+			{Tag: tag.CFICode, Name: "CFICode", Type: FieldTypeString},
+			{Tag: tag.UnderlyingProduct, Name: "UnderlyingProduct", Type: FieldTypeInt},
+			{Tag: tag.UnderlyingCFICode, Name: "UnderlyingCFICode", Type: FieldTypeString},
+			{Tag: tag.CountryOfIssue, Name: "CountryOfIssue", Type: FieldTypeString},
+			{Tag: tag.StateOrProvinceOfIssue, Name: "StateOrProvinceOfIssue", Type: FieldTypeString},
+			{Tag: tag.LocaleOfIssue, Name: "LocaleOfIssue", Type: FieldTypeString},
+			{Tag: tag.SecondaryClOrdID, Name: "SecondaryClOrdID", Type: FieldTypeString},
+			{Tag: tag.MassCancelRequestType, Name: "MassCancelRequestType", Type: FieldTypeString, Required: true},
+			{Tag: tag.MassCancelResponse, Name: "MassCancelResponse", Type: FieldTypeString, Required: true},
+			{Tag: tag.MassCancelRejectReason, Name: "MassCancelRejectReason", Type: FieldTypeString},
+			{Tag: tag.TotalAffectedOrders, Name: "TotalAffectedOrders", Type: FieldTypeInt},
+			{Tag: tag.NoAffectedOrders, Name: "NoAffectedOrders", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.OrigClOrdID, Name: "OrigClOrdID", Type: FieldTypeString, Required: true},
+				{Tag: tag.AffectedOrderID, Name: "AffectedOrderID", Type: FieldTypeString},
+				{Tag: tag.AffectedSecondaryOrderID, Name: "AffectedSecondaryOrderID", Type: FieldTypeString},
+			}},
+			{Tag: tag.MaturityDate, Name: "MaturityDate", Type: FieldTypeString},
+			{Tag: tag.UnderlyingMaturityDate, Name: "UnderlyingMaturityDate", Type: FieldTypeString},
+			{Tag: tag.InstrRegistry, Name: "InstrRegistry", Type: FieldTypeString},
+			{Tag: tag.UnderlyingCountryOfIssue, Name: "UnderlyingCountryOfIssue", Type: FieldTypeString},
+			{Tag: tag.UnderlyingStateOrProvinceOfIssue, Name: "UnderlyingStateOrProvinceOfIssue", Type: FieldTypeString},
+			{Tag: tag.UnderlyingLocaleOfIssue, Name: "UnderlyingLocaleOfIssue", Type: FieldTypeString},
+			{Tag: tag.UnderlyingInstrRegistry, Name: "UnderlyingInstrRegistry", Type: FieldTypeString},
+			{Tag: tag.TradingSessionSubID, Name: "TradingSessionSubID", Type: FieldTypeString},
+			{Tag: tag.ContractSettlMonth, Name: "ContractSettlMonth", Type: FieldTypeString},
+			{Tag: tag.Pool, Name: "Pool", Type: FieldTypeString},
+			{Tag: tag.SecuritySubType, Name: "SecuritySubType", Type: FieldTypeString},
+			{Tag: tag.UnderlyingSecuritySubType, Name: "UnderlyingSecuritySubType", Type: FieldTypeString},
+			{Tag: tag.UnderlyingPx, Name: "UnderlyingPx", Type: FieldTypeDecimal},
+			{Tag: tag.NoEvents, Name: "NoEvents", Type: FieldTypeNumInGroup, Fields: noEventsFields},
+			{Tag: tag.DatedDate, Name: "DatedDate", Type: FieldTypeString},
+			{Tag: tag.InterestAccrualDate, Name: "InterestAccrualDate", Type: FieldTypeString},
+			{Tag: tag.CPProgram, Name: "CPProgram", Type: FieldTypeString},
+			{Tag: tag.CPRegType, Name: "CPRegType", Type: FieldTypeString},
+			{Tag: tag.UnderlyingCPProgram, Name: "UnderlyingCPProgram", Type: FieldTypeString},
+			{Tag: tag.UnderlyingCPRegType, Name: "UnderlyingCPRegType", Type: FieldTypeString},
+			{Tag: tag.UnderlyingQty, Name: "UnderlyingQty", Type: FieldTypeDecimal},
+			{Tag: tag.UnderlyingDirtyPrice, Name: "UnderlyingDirtyPrice", Type: FieldTypeDecimal},
+			{Tag: tag.UnderlyingEndPrice, Name: "UnderlyingEndPrice", Type: FieldTypeDecimal},
+			{Tag: tag.UnderlyingStartValue, Name: "UnderlyingStartValue", Type: FieldTypeDecimal},
+			{Tag: tag.UnderlyingCurrentValue, Name: "UnderlyingCurrentValue", Type: FieldTypeDecimal},
+			{Tag: tag.UnderlyingEndValue, Name: "UnderlyingEndValue", Type: FieldTypeDecimal},
+			{Tag: tag.NoUnderlyingStips, Name: "NoUnderlyingStips", Type: FieldTypeNumInGroup, Fields: noUnderlyingStipsFields},
+			{Tag: tag.UnderlyingStrikeCurrency, Name: "UnderlyingStrikeCurrency", Type: FieldTypeString},
+			{Tag: tag.StrikeCurrency, Name: "StrikeCurrency", Type: FieldTypeString},
+		},
+	},
+	"q": {
+		Name:    "OrderMassCancelRequest",
+		MsgType: "q",
+		Fields: []FieldDef{
+			{Tag: tag.ClOrdID, Name: "ClOrdID", Type: FieldTypeString, Required: true},
+			{Tag: tag.SecurityIDSource, Name: "SecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.SecurityID, Name: "SecurityID", Type: FieldTypeString},
+			{Tag: tag.Side, Name: "Side", Type: FieldTypeString},
+			{Tag: tag.Symbol, Name: "Symbol", Type: FieldTypeString},
+			{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+			{Tag: tag.TransactTime, Name: "TransactTime", Type: FieldTypeUTCTimestamp, Required: true},
+			{Tag: tag.SymbolSfx, Name: "SymbolSfx", Type: FieldTypeString},
+			{Tag: tag.Issuer, Name: "Issuer", Type: FieldTypeString},
+			{Tag: tag.SecurityDesc, Name: "SecurityDesc", Type: FieldTypeString},
+			{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+			{Tag: tag.MaturityMonthYear, Name: "MaturityMonthYear", Type: FieldTypeString},
+			{Tag: tag.StrikePrice, Name: "StrikePrice", Type: FieldTypeDecimal},
+			{Tag: tag.OptAttribute, Name: "OptAttribute", Type: FieldTypeString},
+			{Tag: tag.SecurityExchange, Name: "SecurityExchange", Type: FieldTypeString},
+			{Tag: tag.CouponRate, Name: "CouponRate", Type: FieldTypeDecimal},
+			{Tag: tag.CouponPaymentDate, Name: "CouponPaymentDate", Type: FieldTypeString},
+			{Tag: tag.IssueDate, Name: "IssueDate", Type: FieldTypeString},
+			{Tag: tag.RepurchaseTerm, Name: "RepurchaseTerm", Type: FieldTypeInt},
+			{Tag: tag.RepurchaseRate, Name: "RepurchaseRate", Type: FieldTypeDecimal},
+			{Tag: tag.Factor, Name: "Factor", Type: FieldTypeDecimal},
+			{Tag: tag.ContractMultiplier, Name: "ContractMultiplier", Type: FieldTypeDecimal},
+			{Tag: tag.RepoCollateralSecurityType, Name: "RepoCollateralSecurityType", Type: FieldTypeInt},
+			{Tag: tag.RedemptionDate, Name: "RedemptionDate", Type: FieldTypeString},
+			{Tag: tag.UnderlyingCouponPaymentDate, Name: "UnderlyingCouponPaymentDate", Type: FieldTypeString},
+			{Tag: tag.UnderlyingIssueDate, Name: "UnderlyingIssueDate", Type: FieldTypeString},
+			{Tag: tag.UnderlyingRepoCollateralSecurityType, Name: "UnderlyingRepoCollateralSecurityType", Type: FieldTypeInt},
+			{Tag: tag.UnderlyingRepurchaseTerm, Name: "UnderlyingRepurchaseTerm", Type: FieldTypeInt},
+			{Tag: tag.UnderlyingRepurchaseRate, Name: "UnderlyingRepurchaseRate", Type: FieldTypeDecimal},
+			{Tag: tag.UnderlyingFactor, Name: "UnderlyingFactor", Type: FieldTypeDecimal},
+			{Tag: tag.UnderlyingRedemptionDate, Name: "UnderlyingRedemptionDate", Type: FieldTypeString},
+			{Tag: tag.CreditRating, Name: "CreditRating", Type: FieldTypeString},
+			{Tag: tag.UnderlyingCreditRating, Name: "UnderlyingCreditRating", Type: FieldTypeString},
+			{Tag: tag.UnderlyingSecurityIDSource, Name: "UnderlyingSecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.UnderlyingIssuer, Name: "UnderlyingIssuer", Type: FieldTypeString},
+			{Tag: tag.UnderlyingSecurityDesc, Name: "UnderlyingSecurityDesc", Type: FieldTypeString},
+			{Tag: tag.UnderlyingSecurityExchange, Name: "UnderlyingSecurityExchange", Type: FieldTypeString},
+			{Tag: tag.UnderlyingSecurityID, Name: "UnderlyingSecurityID", Type: FieldTypeString},
+			{Tag: tag.UnderlyingSecurityType, Name: "UnderlyingSecurityType", Type: FieldTypeString},
+			{Tag: tag.UnderlyingSymbol, Name: "UnderlyingSymbol", Type: FieldTypeString},
+			{Tag: tag.UnderlyingSymbolSfx, Name: "UnderlyingSymbolSfx", Type: FieldTypeString},
+			{Tag: tag.UnderlyingMaturityMonthYear, Name: "UnderlyingMaturityMonthYear", Type: FieldTypeString},
+			{Tag: tag.UnderlyingStrikePrice, Name: "UnderlyingStrikePrice", Type: FieldTypeDecimal},
+			{Tag: tag.UnderlyingOptAttribute, Name: "UnderlyingOptAttribute", Type: FieldTypeString},
+			{Tag: tag.UnderlyingCurrency, Name: "UnderlyingCurrency", Type: FieldTypeString},
+			{Tag: tag.TradingSessionID, Name: "TradingSessionID", Type: FieldTypeString},
+			{Tag: tag.EncodedIssuerLen, Name: "EncodedIssuerLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedIssuer, Name: "EncodedIssuer", Type: FieldTypeString},
+			{Tag: tag.EncodedSecurityDescLen, Name: "EncodedSecurityDescLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedSecurityDesc, Name: "EncodedSecurityDesc", Type: FieldTypeString},
+			{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			{Tag: tag.EncodedUnderlyingIssuerLen, Name: "EncodedUnderlyingIssuerLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedUnderlyingIssuer, Name: "EncodedUnderlyingIssuer", Type: FieldTypeString},
+			{Tag: tag.EncodedUnderlyingSecurityDescLen, Name: "EncodedUnderlyingSecurityDescLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedUnderlyingSecurityDesc, Name: "EncodedUnderlyingSecurityDesc", Type: FieldTypeString},
+			{Tag: tag.UnderlyingCouponRate, Name: "UnderlyingCouponRate", Type: FieldTypeDecimal},
+			{Tag: tag.UnderlyingContractMultiplier, Name: "UnderlyingContractMultiplier", Type: FieldTypeDecimal},
+			{Tag: tag.NoSecurityAltID, Name: "NoSecurityAltID", Type: FieldTypeNumInGroup, Fields: noSecurityAltIDFields},
+			{Tag: tag.NoUnderlyingSecurityAltID, Name: "NoUnderlyingSecurityAltID", Type: FieldTypeNumInGroup, Fields: noUnderlyingSecurityAltIDFields},
+			{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+			{Tag: tag.CFICode, Name: "CFICode", Type: FieldTypeString},
+			{Tag: tag.UnderlyingProduct, Name: "UnderlyingProduct", Type: FieldTypeInt},
+			{Tag: tag.UnderlyingCFICode, Name: "UnderlyingCFICode", Type: FieldTypeString},
+			{Tag: tag.CountryOfIssue, Name: "CountryOfIssue", Type: FieldTypeString},
+			{Tag: tag.StateOrProvinceOfIssue, Name: "StateOrProvinceOfIssue", Type: FieldTypeString},
+			{Tag: tag.LocaleOfIssue, Name: "LocaleOfIssue", Type: FieldTypeString},
+			{Tag: tag.SecondaryClOrdID, Name: "SecondaryClOrdID", Type: FieldTypeString},
+			{Tag: tag.MassCancelRequestType, Name: "MassCancelRequestType", Type: FieldTypeString, Required: true},
+			{Tag: tag.MaturityDate, Name: "MaturityDate", Type: FieldTypeString},
+			{Tag: tag.UnderlyingMaturityDate, Name: "UnderlyingMaturityDate", Type: FieldTypeString},
+			{Tag: tag.InstrRegistry, Name: "InstrRegistry", Type: FieldTypeString},
+			{Tag: tag.UnderlyingCountryOfIssue, Name: "UnderlyingCountryOfIssue", Type: FieldTypeString},
+			{Tag: tag.UnderlyingStateOrProvinceOfIssue, Name: "UnderlyingStateOrProvinceOfIssue", Type: FieldTypeString},
+			{Tag: tag.UnderlyingLocaleOfIssue, Name: "UnderlyingLocaleOfIssue", Type: FieldTypeString},
+			{Tag: tag.UnderlyingInstrRegistry, Name: "UnderlyingInstrRegistry", Type: FieldTypeString},
+			{Tag: tag.TradingSessionSubID, Name: "TradingSessionSubID", Type: FieldTypeString},
+			{Tag: tag.ContractSettlMonth, Name: "ContractSettlMonth", Type: FieldTypeString},
+			{Tag: tag.Pool, Name: "Pool", Type: FieldTypeString},
+			{Tag: tag.SecuritySubType, Name: "SecuritySubType", Type: FieldTypeString},
+			{Tag: tag.UnderlyingSecuritySubType, Name: "UnderlyingSecuritySubType", Type: FieldTypeString},
+			{Tag: tag.UnderlyingPx, Name: "UnderlyingPx", Type: FieldTypeDecimal},
+			{Tag: tag.NoEvents, Name: "NoEvents", Type: FieldTypeNumInGroup, Fields: noEventsFields},
+			{Tag: tag.DatedDate, Name: "DatedDate", Type: FieldTypeString},
+			{Tag: tag.InterestAccrualDate, Name: "InterestAccrualDate", Type: FieldTypeString},
+			{Tag: tag.CPProgram, Name: "CPProgram", Type: FieldTypeString},
+			{Tag: tag.CPRegType, Name: "CPRegType", Type: FieldTypeString},
+			{Tag: tag.UnderlyingCPProgram, Name: "UnderlyingCPProgram", Type: FieldTypeString},
+			{Tag: tag.UnderlyingCPRegType, Name: "UnderlyingCPRegType", Type: FieldTypeString},
+			{Tag: tag.UnderlyingQty, Name: "UnderlyingQty", Type: FieldTypeDecimal},
+			{Tag: tag.UnderlyingDirtyPrice, Name: "UnderlyingDirtyPrice", Type: FieldTypeDecimal},
+			{Tag: tag.UnderlyingEndPrice, Name: "UnderlyingEndPrice", Type: FieldTypeDecimal},
+			{Tag: tag.UnderlyingStartValue, Name: "UnderlyingStartValue", Type: FieldTypeDecimal},
+			{Tag: tag.UnderlyingCurrentValue, Name: "UnderlyingCurrentValue", Type: FieldTypeDecimal},
+			{Tag: tag.UnderlyingEndValue, Name: "UnderlyingEndValue", Type: FieldTypeDecimal},
+			{Tag: tag.NoUnderlyingStips, Name: "NoUnderlyingStips", Type: FieldTypeNumInGroup, Fields: noUnderlyingStipsFields},
+			{Tag: tag.UnderlyingStrikeCurrency, Name: "UnderlyingStrikeCurrency", Type: FieldTypeString},
+			{Tag: tag.StrikeCurrency, Name: "StrikeCurrency", Type: FieldTypeString},
+		},
+	},
+	"AF": {
+		Name:    "OrderMassStatusRequest",
+		MsgType: "AF",
+		Fields: []FieldDef{
+			{Tag: tag.Account, Name: "Account", Type: FieldTypeString},
+			{Tag: tag.SecurityIDSource, Name: "SecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.SecurityID, Name: "SecurityID", Type: FieldTypeString},
+			{Tag: tag.Side, Name: "Side", Type: FieldTypeString},
+			{Tag: tag.Symbol, Name: "Symbol", Type: FieldTypeString},
+			{Tag: tag.SymbolSfx, Name: "SymbolSfx", Type: FieldTypeString},
+			{Tag: tag.Issuer, Name: "Issuer", Type: FieldTypeString},
+			{Tag: tag.SecurityDesc, Name: "SecurityDesc", Type: FieldTypeString},
+			{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+			{Tag: tag.MaturityMonthYear, Name: "MaturityMonthYear", Type: FieldTypeString},
+			{Tag: tag.StrikePrice, Name: "StrikePrice", Type: FieldTypeDecimal},
+			{Tag: tag.OptAttribute, Name: "OptAttribute", Type: FieldTypeString},
+			{Tag: tag.SecurityExchange, Name: "SecurityExchange", Type: FieldTypeString},
+			{Tag: tag.CouponRate, Name: "CouponRate", Type: FieldTypeDecimal},
+			{Tag: tag.CouponPaymentDate, Name: "CouponPaymentDate", Type: FieldTypeString},
+			{Tag: tag.IssueDate, Name: "IssueDate", Type: FieldTypeString},
+			{Tag: tag.RepurchaseTerm, Name: "RepurchaseTerm", Type: FieldTypeInt},
+			{Tag: tag.RepurchaseRate, Name: "RepurchaseRate", Type: FieldTypeDecimal},
+			{Tag: tag.Factor, Name: "Factor", Type: FieldTypeDecimal},
+			{Tag: tag.ContractMultiplier, Name: "ContractMultiplier", Type: FieldTypeDecimal},
+			{Tag: tag.RepoCollateralSecurityType, Name: "RepoCollateralSecurityType", Type: FieldTypeInt},
+			{Tag: tag.RedemptionDate, Name: "RedemptionDate", Type: FieldTypeString},
+			{Tag: tag.UnderlyingCouponPaymentDate, Name: "UnderlyingCouponPaymentDate", Type: FieldTypeString},
+			{Tag: tag.UnderlyingIssueDate, Name: "UnderlyingIssueDate", Type: FieldTypeString},
+			{Tag: tag.UnderlyingRepoCollateralSecurityType, Name: "UnderlyingRepoCollateralSecurityType", Type: FieldTypeInt},
+			{Tag: tag.UnderlyingRepurchaseTerm, Name: "UnderlyingRepurchaseTerm", Type: FieldTypeInt},
+			{Tag: tag.UnderlyingRepurchaseRate, Name: "UnderlyingRepurchaseRate", Type: FieldTypeDecimal},
+			{Tag: tag.UnderlyingFactor, Name: "UnderlyingFactor", Type: FieldTypeDecimal},
+			{Tag: tag.UnderlyingRedemptionDate, Name: "UnderlyingRedemptionDate", Type: FieldTypeString},
+			{Tag: tag.CreditRating, Name: "CreditRating", Type: FieldTypeString},
+			{Tag: tag.UnderlyingCreditRating, Name: "UnderlyingCreditRating", Type: FieldTypeString},
+			{Tag: tag.UnderlyingSecurityIDSource, Name: "UnderlyingSecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.UnderlyingIssuer, Name: "UnderlyingIssuer", Type: FieldTypeString},
+			{Tag: tag.UnderlyingSecurityDesc, Name: "UnderlyingSecurityDesc", Type: FieldTypeString},
+			{Tag: tag.UnderlyingSecurityExchange, Name: "UnderlyingSecurityExchange", Type: FieldTypeString},
+			{Tag: tag.UnderlyingSecurityID, Name: "UnderlyingSecurityID", Type: FieldTypeString},
+			{Tag: tag.UnderlyingSecurityType, Name: "UnderlyingSecurityType", Type: FieldTypeString},
+			{Tag: tag.UnderlyingSymbol, Name: "UnderlyingSymbol", Type: FieldTypeString},
+			{Tag: tag.UnderlyingSymbolSfx, Name: "UnderlyingSymbolSfx", Type: FieldTypeString},
+			{Tag: tag.UnderlyingMaturityMonthYear, Name: "UnderlyingMaturityMonthYear", Type: FieldTypeString},
+			{Tag: tag.UnderlyingStrikePrice, Name: "UnderlyingStrikePrice", Type: FieldTypeDecimal},
+			{Tag: tag.UnderlyingOptAttribute, Name: "UnderlyingOptAttribute", Type: FieldTypeString},
+			{Tag: tag.UnderlyingCurrency, Name: "UnderlyingCurrency", Type: FieldTypeString},
+			{Tag: tag.TradingSessionID, Name: "TradingSessionID", Type: FieldTypeString},
+			{Tag: tag.EncodedIssuerLen, Name: "EncodedIssuerLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedIssuer, Name: "EncodedIssuer", Type: FieldTypeString},
+			{Tag: tag.EncodedSecurityDescLen, Name: "EncodedSecurityDescLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedSecurityDesc, Name: "EncodedSecurityDesc", Type: FieldTypeString},
+			{Tag: tag.EncodedUnderlyingIssuerLen, Name: "EncodedUnderlyingIssuerLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedUnderlyingIssuer, Name: "EncodedUnderlyingIssuer", Type: FieldTypeString},
+			{Tag: tag.EncodedUnderlyingSecurityDescLen, Name: "EncodedUnderlyingSecurityDescLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedUnderlyingSecurityDesc, Name: "EncodedUnderlyingSecurityDesc", Type: FieldTypeString},
+			{Tag: tag.UnderlyingCouponRate, Name: "UnderlyingCouponRate", Type: FieldTypeDecimal},
+			{Tag: tag.UnderlyingContractMultiplier, Name: "UnderlyingContractMultiplier", Type: FieldTypeDecimal},
+			{Tag: tag.NoPartyIDs, Name: "NoPartyIDs", Type: FieldTypeNumInGroup, Fields: noPartyIDsFields},
+			{Tag: tag.NoSecurityAltID, Name: "NoSecurityAltID", Type: FieldTypeNumInGroup, Fields: noSecurityAltIDFields},
+			{Tag: tag.NoUnderlyingSecurityAltID, Name: "NoUnderlyingSecurityAltID", Type: FieldTypeNumInGroup, Fields: noUnderlyingSecurityAltIDFields},
+			{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+			{Tag: tag.CFICode, Name: "CFICode", Type: FieldTypeString},
+			{Tag: tag.UnderlyingProduct, Name: "UnderlyingProduct", Type: FieldTypeInt},
+			{Tag: tag.UnderlyingCFICode, Name: "UnderlyingCFICode", Type: FieldTypeString},
+			{Tag: tag.CountryOfIssue, Name: "CountryOfIssue", Type: FieldTypeString},
+			{Tag: tag.StateOrProvinceOfIssue, Name: "StateOrProvinceOfIssue", Type: FieldTypeString},
+			{Tag: tag.LocaleOfIssue, Name: "LocaleOfIssue", Type: FieldTypeString},
+			{Tag: tag.MaturityDate, Name: "MaturityDate", Type: FieldTypeString},
+			{Tag: tag.UnderlyingMaturityDate, Name: "UnderlyingMaturityDate", Type: FieldTypeString},
+			{Tag: tag.InstrRegistry, Name: "InstrRegistry", Type: FieldTypeString},
+			{Tag: tag.MassStatusReqID, Name: "MassStatusReqID", Type: FieldTypeString, Required: true},
+			{Tag: tag.MassStatusReqType, Name: "MassStatusReqType", Type: FieldTypeString, Required: true},
+			{Tag: tag.UnderlyingCountryOfIssue, Name: "UnderlyingCountryOfIssue", Type: FieldTypeString},
+			{Tag: tag.UnderlyingStateOrProvinceOfIssue, Name: "UnderlyingStateOrProvinceOfIssue", Type: FieldTypeString},
+			{Tag: tag.UnderlyingLocaleOfIssue, Name: "UnderlyingLocaleOfIssue", Type: FieldTypeString},
+			{Tag: tag.UnderlyingInstrRegistry, Name: "UnderlyingInstrRegistry", Type: FieldTypeString},
+			{Tag: tag.TradingSessionSubID, Name: "TradingSessionSubID", Type: FieldTypeString},
+			{Tag: tag.AcctIDSource, Name: "AcctIDSource", Type: FieldTypeString},
+			{Tag: tag.ContractSettlMonth, Name: "ContractSettlMonth", Type: FieldTypeString},
+			{Tag: tag.Pool, Name: "Pool", Type: FieldTypeString},
+			{Tag: tag.SecuritySubType, Name: "SecuritySubType", Type: FieldTypeString},
+			{Tag: tag.UnderlyingSecuritySubType, Name: "UnderlyingSecuritySubType", Type: FieldTypeString},
+			{Tag: tag.UnderlyingPx, Name: "UnderlyingPx", Type: FieldTypeDecimal},
+			{Tag: tag.NoEvents, Name: "NoEvents", Type: FieldTypeNumInGroup, Fields: noEventsFields},
+			{Tag: tag.DatedDate, Name: "DatedDate", Type: FieldTypeString},
+			{Tag: tag.InterestAccrualDate, Name: "InterestAccrualDate", Type: FieldTypeString},
+			{Tag: tag.CPProgram, Name: "CPProgram", Type: FieldTypeString},
+			{Tag: tag.CPRegType, Name: "CPRegType", Type: FieldTypeString},
+			{Tag: tag.UnderlyingCPProgram, Name: "UnderlyingCPProgram", Type: FieldTypeString},
+			{Tag: tag.UnderlyingCPRegType, Name: "UnderlyingCPRegType", Type: FieldTypeString},
+			{Tag: tag.UnderlyingQty, Name: "UnderlyingQty", Type: FieldTypeDecimal},
+			{Tag: tag.UnderlyingDirtyPrice, Name: "UnderlyingDirtyPrice", Type: FieldTypeDecimal},
+			{Tag: tag.UnderlyingEndPrice, Name: "UnderlyingEndPrice", Type: FieldTypeDecimal},
+			{Tag: tag.UnderlyingStartValue, Name: "UnderlyingStartValue", Type: FieldTypeDecimal},
+			{Tag: tag.UnderlyingCurrentValue, Name: "UnderlyingCurrentValue", Type: FieldTypeDecimal},
+			{Tag: tag.UnderlyingEndValue, Name: "UnderlyingEndValue", Type: FieldTypeDecimal},
+			{Tag: tag.NoUnderlyingStips, Name: "NoUnderlyingStips", Type: FieldTypeNumInGroup, Fields: noUnderlyingStipsFields},
+			{Tag: tag.UnderlyingStrikeCurrency, Name: "UnderlyingStrikeCurrency", Type: FieldTypeString},
+			{Tag: tag.StrikeCurrency, Name: "StrikeCurrency", Type: FieldTypeString},
+		},
+	},
+	"H": {
+		Name:    "OrderStatusRequest",
+		MsgType: "H",
+		Fields: []FieldDef{
+			{Tag: tag.Account, Name: "Account", Type: FieldTypeString},
+			{Tag: tag.ClOrdID, Name: "ClOrdID", Type: FieldTypeString, Required: true},
+			{Tag: tag.SecurityIDSource, Name: "SecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.OrderID, Name: "OrderID", Type: FieldTypeString},
+			{Tag: tag.SecurityID, Name: "SecurityID", Type: FieldTypeString},
+			{Tag: tag.Side, Name: "Side", Type: FieldTypeString, Required: true},
+			{Tag: tag.Symbol, Name: "Symbol", Type: FieldTypeString},
+			{Tag: tag.SymbolSfx, Name: "SymbolSfx", Type: FieldTypeString},
+			{Tag: tag.Issuer, Name: "Issuer", Type: FieldTypeString},
+			{Tag: tag.SecurityDesc, Name: "SecurityDesc", Type: FieldTypeString},
+			{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+			{Tag: tag.MaturityMonthYear, Name: "MaturityMonthYear", Type: FieldTypeString},
+			{Tag: tag.StrikePrice, Name: "StrikePrice", Type: FieldTypeDecimal},
+			{Tag: tag.OptAttribute, Name: "OptAttribute", Type: FieldTypeString},
+			{Tag: tag.SecurityExchange, Name: "SecurityExchange", Type: FieldTypeString},
+			{Tag: tag.CouponRate, Name: "CouponRate", Type: FieldTypeDecimal},
+			{Tag: tag.CouponPaymentDate, Name: "CouponPaymentDate", Type: FieldTypeString},
+			{Tag: tag.IssueDate, Name: "IssueDate", Type: FieldTypeString},
+			{Tag: tag.RepurchaseTerm, Name: "RepurchaseTerm", Type: FieldTypeInt},
+			{Tag: tag.RepurchaseRate, Name: "RepurchaseRate", Type: FieldTypeDecimal},
+			{Tag: tag.Factor, Name: "Factor", Type: FieldTypeDecimal},
+			{Tag: tag.ContractMultiplier, Name: "ContractMultiplier", Type: FieldTypeDecimal},
+			{Tag: tag.RepoCollateralSecurityType, Name: "RepoCollateralSecurityType", Type: FieldTypeInt},
+			{Tag: tag.RedemptionDate, Name: "RedemptionDate", Type: FieldTypeString},
+			{Tag: tag.CreditRating, Name: "CreditRating", Type: FieldTypeString},
+			{Tag: tag.EncodedIssuerLen, Name: "EncodedIssuerLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedIssuer, Name: "EncodedIssuer", Type: FieldTypeString},
+			{Tag: tag.EncodedSecurityDescLen, Name: "EncodedSecurityDescLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedSecurityDesc, Name: "EncodedSecurityDesc", Type: FieldTypeString},
+			{Tag: tag.NoPartyIDs, Name: "NoPartyIDs", Type: FieldTypeNumInGroup, Fields: noPartyIDsFields},
+			{Tag: tag.NoSecurityAltID, Name: "NoSecurityAltID", Type: FieldTypeNumInGroup, Fields: noSecurityAltIDFields},
+			{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+			{Tag: tag.CFICode, Name: "CFICode", Type: FieldTypeString},
+			{Tag: tag.CountryOfIssue, Name: "CountryOfIssue", Type: FieldTypeString},
+			{Tag: tag.StateOrProvinceOfIssue, Name: "StateOrProvinceOfIssue", Type: FieldTypeString},
+			{Tag: tag.LocaleOfIssue, Name: "LocaleOfIssue", Type: FieldTypeString},
+			{Tag: tag.SecondaryClOrdID, Name: "SecondaryClOrdID", Type: FieldTypeString},
+			{Tag: tag.MaturityDate, Name: "MaturityDate", Type: FieldTypeString},
+			{Tag: tag.InstrRegistry, Name: "InstrRegistry", Type: FieldTypeString},
+			{Tag: tag.ClOrdLinkID, Name: "ClOrdLinkID", Type: FieldTypeString},
+			{Tag: tag.AcctIDSource, Name: "AcctIDSource", Type: FieldTypeString},
+			{Tag: tag.ContractSettlMonth, Name: "ContractSettlMonth", Type: FieldTypeString},
+			{Tag: tag.Pool, Name: "Pool", Type: FieldTypeString},
+			{Tag: tag.NoUnderlyings, Name: "NoUnderlyings", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.UnderlyingSymbol, Name: "UnderlyingSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.UnderlyingSymbolSfx, Name: "UnderlyingSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityID, Name: "UnderlyingSecurityID", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityIDSource, Name: "UnderlyingSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoUnderlyingSecurityAltID, Name: "NoUnderlyingSecurityAltID", Type: FieldTypeNumInGroup, Fields: noUnderlyingSecurityAltIDFields},
+				{Tag: tag.UnderlyingProduct, Name: "UnderlyingProduct", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingCFICode, Name: "UnderlyingCFICode", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityType, Name: "UnderlyingSecurityType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecuritySubType, Name: "UnderlyingSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityMonthYear, Name: "UnderlyingMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityDate, Name: "UnderlyingMaturityDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCouponPaymentDate, Name: "UnderlyingCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssueDate, Name: "UnderlyingIssueDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRepoCollateralSecurityType, Name: "UnderlyingRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseTerm, Name: "UnderlyingRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseRate, Name: "UnderlyingRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingFactor, Name: "UnderlyingFactor", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCreditRating, Name: "UnderlyingCreditRating", Type: FieldTypeString},
+				{Tag: tag.UnderlyingInstrRegistry, Name: "UnderlyingInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCountryOfIssue, Name: "UnderlyingCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStateOrProvinceOfIssue, Name: "UnderlyingStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingLocaleOfIssue, Name: "UnderlyingLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRedemptionDate, Name: "UnderlyingRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStrikePrice, Name: "UnderlyingStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStrikeCurrency, Name: "UnderlyingStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingOptAttribute, Name: "UnderlyingOptAttribute", Type: FieldTypeString},
+				{Tag: tag.UnderlyingContractMultiplier, Name: "UnderlyingContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCouponRate, Name: "UnderlyingCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingSecurityExchange, Name: "UnderlyingSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssuer, Name: "UnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingIssuerLen, Name: "EncodedUnderlyingIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingIssuer, Name: "EncodedUnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityDesc, Name: "UnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingSecurityDescLen, Name: "EncodedUnderlyingSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingSecurityDesc, Name: "EncodedUnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPProgram, Name: "UnderlyingCPProgram", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPRegType, Name: "UnderlyingCPRegType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCurrency, Name: "UnderlyingCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingQty, Name: "UnderlyingQty", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingPx, Name: "UnderlyingPx", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingDirtyPrice, Name: "UnderlyingDirtyPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndPrice, Name: "UnderlyingEndPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStartValue, Name: "UnderlyingStartValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCurrentValue, Name: "UnderlyingCurrentValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndValue, Name: "UnderlyingEndValue", Type: FieldTypeDecimal},
+				{Tag: tag.NoUnderlyingStips, Name: "NoUnderlyingStips", Type: FieldTypeNumInGroup, Fields: noUnderlyingStipsFields},
+			}},
+			{Tag: tag.SecuritySubType, Name: "SecuritySubType", Type: FieldTypeString},
+			{Tag: tag.TerminationType, Name: "TerminationType", Type: FieldTypeString},
+			{Tag: tag.OrdStatusReqID, Name: "OrdStatusReqID", Type: FieldTypeString},
+			{Tag: tag.NoEvents, Name: "NoEvents", Type: FieldTypeNumInGroup, Fields: noEventsFields},
+			{Tag: tag.DatedDate, Name: "DatedDate", Type: FieldTypeString},
+			{Tag: tag.InterestAccrualDate, Name: "InterestAccrualDate", Type: FieldTypeString},
+			{Tag: tag.CPProgram, Name: "CPProgram", Type: FieldTypeString},
+			{Tag: tag.CPRegType, Name: "CPRegType", Type: FieldTypeString},
+			{Tag: tag.MarginRatio, Name: "MarginRatio", Type: FieldTypeDecimal},
+			{Tag: tag.AgreementDesc, Name: "AgreementDesc", Type: FieldTypeString},
+			{Tag: tag.AgreementID, Name: "AgreementID", Type: FieldTypeString},
+			{Tag: tag.AgreementDate, Name: "AgreementDate", Type: FieldTypeString},
+			{Tag: tag.StartDate, Name: "StartDate", Type: FieldTypeString},
+			{Tag: tag.EndDate, Name: "EndDate", Type: FieldTypeString},
+			{Tag: tag.AgreementCurrency, Name: "AgreementCurrency", Type: FieldTypeString},
+			{Tag: tag.DeliveryType, Name: "DeliveryType", Type: FieldTypeString},
+			{Tag: tag.StrikeCurrency, Name: "StrikeCurrency", Type: FieldTypeString},
+		},
+	},
+	"AM": {
+		Name:    "PositionMaintenanceReport",
+		MsgType: "AM",
+		Fields: []FieldDef{
+			{Tag: tag.Account, Name: "Account", Type: FieldTypeString, Required: true},
+			{Tag: tag.Currency, Name: "Currency", Type: FieldTypeString},
+			{Tag: tag.SecurityIDSource, Name: "SecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.SecurityID, Name: "SecurityID", Type: FieldTypeString},
+			{Tag: tag.Symbol, Name: "Symbol", Type: FieldTypeString},
+			{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+			{Tag: tag.TransactTime, Name: "TransactTime", Type: FieldTypeUTCTimestamp, Required: true},
+			{Tag: tag.SymbolSfx, Name: "SymbolSfx", Type: FieldTypeString},
+			{Tag: tag.Issuer, Name: "Issuer", Type: FieldTypeString},
+			{Tag: tag.SecurityDesc, Name: "SecurityDesc", Type: FieldTypeString},
+			{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+			{Tag: tag.MaturityMonthYear, Name: "MaturityMonthYear", Type: FieldTypeString},
+			{Tag: tag.StrikePrice, Name: "StrikePrice", Type: FieldTypeDecimal},
+			{Tag: tag.OptAttribute, Name: "OptAttribute", Type: FieldTypeString},
+			{Tag: tag.SecurityExchange, Name: "SecurityExchange", Type: FieldTypeString},
+			{Tag: tag.CouponRate, Name: "CouponRate", Type: FieldTypeDecimal},
+			{Tag: tag.CouponPaymentDate, Name: "CouponPaymentDate", Type: FieldTypeString},
+			{Tag: tag.IssueDate, Name: "IssueDate", Type: FieldTypeString},
+			{Tag: tag.RepurchaseTerm, Name: "RepurchaseTerm", Type: FieldTypeInt},
+			{Tag: tag.RepurchaseRate, Name: "RepurchaseRate", Type: FieldTypeDecimal},
+			{Tag: tag.Factor, Name: "Factor", Type: FieldTypeDecimal},
+			{Tag: tag.ContractMultiplier, Name: "ContractMultiplier", Type: FieldTypeDecimal},
+			{Tag: tag.RepoCollateralSecurityType, Name: "RepoCollateralSecurityType", Type: FieldTypeInt},
+			{Tag: tag.RedemptionDate, Name: "RedemptionDate", Type: FieldTypeString},
+			{Tag: tag.CreditRating, Name: "CreditRating", Type: FieldTypeString},
+			{Tag: tag.EncodedIssuerLen, Name: "EncodedIssuerLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedIssuer, Name: "EncodedIssuer", Type: FieldTypeString},
+			{Tag: tag.EncodedSecurityDescLen, Name: "EncodedSecurityDescLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedSecurityDesc, Name: "EncodedSecurityDesc", Type: FieldTypeString},
+			{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			{Tag: tag.NoTradingSessions, Name: "NoTradingSessions", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.TradingSessionID, Name: "TradingSessionID", Type: FieldTypeString, Required: true},
+				{Tag: tag.TradingSessionSubID, Name: "TradingSessionSubID", Type: FieldTypeString},
+			}},
+			{Tag: tag.NoPartyIDs, Name: "NoPartyIDs", Type: FieldTypeNumInGroup, Fields: noPartyIDsFields},
+			{Tag: tag.NoSecurityAltID, Name: "NoSecurityAltID", Type: FieldTypeNumInGroup, Fields: noSecurityAltIDFields},
+			{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+			{Tag: tag.CFICode, Name: "CFICode", Type: FieldTypeString},
+			{Tag: tag.CountryOfIssue, Name: "CountryOfIssue", Type: FieldTypeString},
+			{Tag: tag.StateOrProvinceOfIssue, Name: "StateOrProvinceOfIssue", Type: FieldTypeString},
+			{Tag: tag.LocaleOfIssue, Name: "LocaleOfIssue", Type: FieldTypeString},
+			{Tag: tag.MaturityDate, Name: "MaturityDate", Type: FieldTypeString},
+			{Tag: tag.InstrRegistry, Name: "InstrRegistry", Type: FieldTypeString},
+			{Tag: tag.NoLegs, Name: "NoLegs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.LegSymbol, Name: "LegSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.LegSymbolSfx, Name: "LegSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.LegSecurityID, Name: "LegSecurityID", Type: FieldTypeString},
+				{Tag: tag.LegSecurityIDSource, Name: "LegSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoLegSecurityAltID, Name: "NoLegSecurityAltID", Type: FieldTypeNumInGroup, Fields: noLegSecurityAltIDFields},
+				{Tag: tag.LegProduct, Name: "LegProduct", Type: FieldTypeInt},
+				{Tag: tag.LegCFICode, Name: "LegCFICode", Type: FieldTypeString},
+				{Tag: tag.LegSecurityType, Name: "LegSecurityType", Type: FieldTypeString},
+				{Tag: tag.LegSecuritySubType, Name: "LegSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.LegMaturityMonthYear, Name: "LegMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.LegMaturityDate, Name: "LegMaturityDate", Type: FieldTypeString},
+				{Tag: tag.LegCouponPaymentDate, Name: "LegCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.LegIssueDate, Name: "LegIssueDate", Type: FieldTypeString},
+				{Tag: tag.LegRepoCollateralSecurityType, Name: "LegRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseTerm, Name: "LegRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseRate, Name: "LegRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegFactor, Name: "LegFactor", Type: FieldTypeDecimal},
+				{Tag: tag.LegCreditRating, Name: "LegCreditRating", Type: FieldTypeString},
+				{Tag: tag.LegInstrRegistry, Name: "LegInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.LegCountryOfIssue, Name: "LegCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegStateOrProvinceOfIssue, Name: "LegStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegLocaleOfIssue, Name: "LegLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegRedemptionDate, Name: "LegRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.LegStrikePrice, Name: "LegStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.LegStrikeCurrency, Name: "LegStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.LegOptAttribute, Name: "LegOptAttribute", Type: FieldTypeString},
+				{Tag: tag.LegContractMultiplier, Name: "LegContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.LegCouponRate, Name: "LegCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegSecurityExchange, Name: "LegSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.LegIssuer, Name: "LegIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedLegIssuerLen, Name: "EncodedLegIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegIssuer, Name: "EncodedLegIssuer", Type: FieldTypeString},
+				{Tag: tag.LegSecurityDesc, Name: "LegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedLegSecurityDescLen, Name: "EncodedLegSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegSecurityDesc, Name: "EncodedLegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.LegRatioQty, Name: "LegRatioQty", Type: FieldTypeDecimal},
+				{Tag: tag.LegSide, Name: "LegSide", Type: FieldTypeString},
+				{Tag: tag.LegCurrency, Name: "LegCurrency", Type: FieldTypeString},
+				{Tag: tag.LegPool, Name: "LegPool", Type: FieldTypeString},
+				{Tag: tag.LegDatedDate, Name: "LegDatedDate", Type: FieldTypeString},
+				{Tag: tag.LegContractSettlMonth, Name: "LegContractSettlMonth", Type: FieldTypeString},
+				{Tag: tag.LegInterestAccrualDate, Name: "LegInterestAccrualDate", Type: FieldTypeString},
+			}},
+			{Tag: tag.AccountType, Name: "AccountType", Type: FieldTypeString, Required: true},
+			{Tag: tag.AcctIDSource, Name: "AcctIDSource", Type: FieldTypeString},
+			{Tag: tag.ContractSettlMonth, Name: "ContractSettlMonth", Type: FieldTypeString},
+			{Tag: tag.Pool, Name: "Pool", Type: FieldTypeString},
+			{Tag: tag.NoPositions, Name: "NoPositions", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.PosType, Name: "PosType", Type: FieldTypeString, Required: true},
+				{Tag: tag.LongQty, Name: "LongQty", Type: FieldTypeDecimal},
+				{Tag: tag.ShortQty, Name: "ShortQty", Type: FieldTypeDecimal},
+				{Tag: tag.PosQtyStatus, Name: "PosQtyStatus", Type: FieldTypeString},
+				{Tag: tag.NoNestedPartyIDs, Name: "NoNestedPartyIDs", Type: FieldTypeNumInGroup, Fields: noNestedPartyIDsFields},
+			}},
+			{Tag: tag.PosTransType, Name: "PosTransType", Type: FieldTypeString, Required: true},
+			{Tag: tag.PosReqID, Name: "PosReqID", Type: FieldTypeString},
+			{Tag: tag.NoUnderlyings, Name: "NoUnderlyings", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.UnderlyingSymbol, Name: "UnderlyingSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.UnderlyingSymbolSfx, Name: "UnderlyingSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityID, Name: "UnderlyingSecurityID", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityIDSource, Name: "UnderlyingSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoUnderlyingSecurityAltID, Name: "NoUnderlyingSecurityAltID", Type: FieldTypeNumInGroup, Fields: noUnderlyingSecurityAltIDFields},
+				{Tag: tag.UnderlyingProduct, Name: "UnderlyingProduct", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingCFICode, Name: "UnderlyingCFICode", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityType, Name: "UnderlyingSecurityType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecuritySubType, Name: "UnderlyingSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityMonthYear, Name: "UnderlyingMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityDate, Name: "UnderlyingMaturityDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCouponPaymentDate, Name: "UnderlyingCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssueDate, Name: "UnderlyingIssueDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRepoCollateralSecurityType, Name: "UnderlyingRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseTerm, Name: "UnderlyingRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseRate, Name: "UnderlyingRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingFactor, Name: "UnderlyingFactor", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCreditRating, Name: "UnderlyingCreditRating", Type: FieldTypeString},
+				{Tag: tag.UnderlyingInstrRegistry, Name: "UnderlyingInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCountryOfIssue, Name: "UnderlyingCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStateOrProvinceOfIssue, Name: "UnderlyingStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingLocaleOfIssue, Name: "UnderlyingLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRedemptionDate, Name: "UnderlyingRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStrikePrice, Name: "UnderlyingStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStrikeCurrency, Name: "UnderlyingStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingOptAttribute, Name: "UnderlyingOptAttribute", Type: FieldTypeString},
+				{Tag: tag.UnderlyingContractMultiplier, Name: "UnderlyingContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCouponRate, Name: "UnderlyingCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingSecurityExchange, Name: "UnderlyingSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssuer, Name: "UnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingIssuerLen, Name: "EncodedUnderlyingIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingIssuer, Name: "EncodedUnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityDesc, Name: "UnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingSecurityDescLen, Name: "EncodedUnderlyingSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingSecurityDesc, Name: "EncodedUnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPProgram, Name: "UnderlyingCPProgram", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPRegType, Name: "UnderlyingCPRegType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCurrency, Name: "UnderlyingCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingQty, Name: "UnderlyingQty", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingPx, Name: "UnderlyingPx", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingDirtyPrice, Name: "UnderlyingDirtyPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndPrice, Name: "UnderlyingEndPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStartValue, Name: "UnderlyingStartValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCurrentValue, Name: "UnderlyingCurrentValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndValue, Name: "UnderlyingEndValue", Type: FieldTypeDecimal},
+				{Tag: tag.NoUnderlyingStips, Name: "NoUnderlyingStips", Type: FieldTypeNumInGroup, Fields: noUnderlyingStipsFields},
+			}},
+			{Tag: tag.PosMaintAction, Name: "PosMaintAction", Type: FieldTypeString, Required: true},
+			{Tag: tag.OrigPosReqRefID, Name: "OrigPosReqRefID", Type: FieldTypeString, Required: true},
+			{Tag: tag.ClearingBusinessDate, Name: "ClearingBusinessDate", Type: FieldTypeString, Required: true},
+			{Tag: tag.SettlSessID, Name: "SettlSessID", Type: FieldTypeString},
+			{Tag: tag.SettlSessSubID, Name: "SettlSessSubID", Type: FieldTypeString},
+			{Tag: tag.AdjustmentType, Name: "AdjustmentType", Type: FieldTypeString},
+			{Tag: tag.PosMaintRptID, Name: "PosMaintRptID", Type: FieldTypeString, Required: true},
+			{Tag: tag.PosMaintStatus, Name: "PosMaintStatus", Type: FieldTypeString, Required: true},
+			{Tag: tag.PosMaintResult, Name: "PosMaintResult", Type: FieldTypeString},
+			{Tag: tag.NoPosAmt, Name: "NoPosAmt", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.PosAmtType, Name: "PosAmtType", Type: FieldTypeString, Required: true},
+				{Tag: tag.PosAmt, Name: "PosAmt", Type: FieldTypeDecimal},
+			}},
+			{Tag: tag.SecuritySubType, Name: "SecuritySubType", Type: FieldTypeString},
+			{Tag: tag.ThresholdAmount, Name: "ThresholdAmount", Type: FieldTypeDecimal},
+			{Tag: tag.NoEvents, Name: "NoEvents", Type: FieldTypeNumInGroup, Fields: noEventsFields},
+			{Tag: tag.DatedDate, Name: "DatedDate", Type: FieldTypeString},
+			{Tag: tag.InterestAccrualDate, Name: "InterestAccrualDate", Type: FieldTypeString},
+			{Tag: tag.CPProgram, Name: "CPProgram", Type: FieldTypeString},
+			{Tag: tag.CPRegType, Name: "CPRegType", Type: FieldTypeString},
+			{Tag: tag.StrikeCurrency, Name: "StrikeCurrency", Type: FieldTypeString},
+		},
+	},
+	"AL": {
+		Name:    "PositionMaintenanceRequest",
+		MsgType: "AL",
+		Fields: []FieldDef{
+			{Tag: tag.Account, Name: "Account", Type: FieldTypeString, Required: true},
+			{Tag: tag.Currency, Name: "Currency", Type: FieldTypeString},
+			{Tag: tag.SecurityIDSource, Name: "SecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.SecurityID, Name: "SecurityID", Type: FieldTypeString},
+			{Tag: tag.Symbol, Name: "Symbol", Type: FieldTypeString},
+			{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+			{Tag: tag.TransactTime, Name: "TransactTime", Type: FieldTypeUTCTimestamp, Required: true},
+			{Tag: tag.SymbolSfx, Name: "SymbolSfx", Type: FieldTypeString},
+			{Tag: tag.Issuer, Name: "Issuer", Type: FieldTypeString},
+			{Tag: tag.SecurityDesc, Name: "SecurityDesc", Type: FieldTypeString},
+			{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+			{Tag: tag.MaturityMonthYear, Name: "MaturityMonthYear", Type: FieldTypeString},
+			{Tag: tag.StrikePrice, Name: "StrikePrice", Type: FieldTypeDecimal},
+			{Tag: tag.OptAttribute, Name: "OptAttribute", Type: FieldTypeString},
+			{Tag: tag.SecurityExchange, Name: "SecurityExchange", Type: FieldTypeString},
+			{Tag: tag.CouponRate, Name: "CouponRate", Type: FieldTypeDecimal},
+			{Tag: tag.CouponPaymentDate, Name: "CouponPaymentDate", Type: FieldTypeString},
+			{Tag: tag.IssueDate, Name: "IssueDate", Type: FieldTypeString},
+			{Tag: tag.RepurchaseTerm, Name: "RepurchaseTerm", Type: FieldTypeInt},
+			{Tag: tag.RepurchaseRate, Name: "RepurchaseRate", Type: FieldTypeDecimal},
+			{Tag: tag.Factor, Name: "Factor", Type: FieldTypeDecimal},
+			{Tag: tag.ContractMultiplier, Name: "ContractMultiplier", Type: FieldTypeDecimal},
+			{Tag: tag.RepoCollateralSecurityType, Name: "RepoCollateralSecurityType", Type: FieldTypeInt},
+			{Tag: tag.RedemptionDate, Name: "RedemptionDate", Type: FieldTypeString},
+			{Tag: tag.CreditRating, Name: "CreditRating", Type: FieldTypeString},
+			{Tag: tag.EncodedIssuerLen, Name: "EncodedIssuerLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedIssuer, Name: "EncodedIssuer", Type: FieldTypeString},
+			{Tag: tag.EncodedSecurityDescLen, Name: "EncodedSecurityDescLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedSecurityDesc, Name: "EncodedSecurityDesc", Type: FieldTypeString},
+			{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			{Tag: tag.NoTradingSessions, Name: "NoTradingSessions", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.TradingSessionID, Name: "TradingSessionID", Type: FieldTypeString, Required: true},
+				{Tag: tag.TradingSessionSubID, Name: "TradingSessionSubID", Type: FieldTypeString},
+			}},
+			{Tag: tag.NoPartyIDs, Name: "NoPartyIDs", Type: FieldTypeNumInGroup, Fields: noPartyIDsFields},
+			{Tag: tag.NoSecurityAltID, Name: "NoSecurityAltID", Type: FieldTypeNumInGroup, Fields: noSecurityAltIDFields},
+			{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+			{Tag: tag.CFICode, Name: "CFICode", Type: FieldTypeString},
+			{Tag: tag.CountryOfIssue, Name: "CountryOfIssue", Type: FieldTypeString},
+			{Tag: tag.StateOrProvinceOfIssue, Name: "StateOrProvinceOfIssue", Type: FieldTypeString},
+			{Tag: tag.LocaleOfIssue, Name: "LocaleOfIssue", Type: FieldTypeString},
+			{Tag: tag.MaturityDate, Name: "MaturityDate", Type: FieldTypeString},
+			{Tag: tag.InstrRegistry, Name: "InstrRegistry", Type: FieldTypeString},
+			{Tag: tag.NoLegs, Name: "NoLegs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.LegSymbol, Name: "LegSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.LegSymbolSfx, Name: "LegSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.LegSecurityID, Name: "LegSecurityID", Type: FieldTypeString},
+				{Tag: tag.LegSecurityIDSource, Name: "LegSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoLegSecurityAltID, Name: "NoLegSecurityAltID", Type: FieldTypeNumInGroup, Fields: noLegSecurityAltIDFields},
+				{Tag: tag.LegProduct, Name: "LegProduct", Type: FieldTypeInt},
+				{Tag: tag.LegCFICode, Name: "LegCFICode", Type: FieldTypeString},
+				{Tag: tag.LegSecurityType, Name: "LegSecurityType", Type: FieldTypeString},
+				{Tag: tag.LegSecuritySubType, Name: "LegSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.LegMaturityMonthYear, Name: "LegMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.LegMaturityDate, Name: "LegMaturityDate", Type: FieldTypeString},
+				{Tag: tag.LegCouponPaymentDate, Name: "LegCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.LegIssueDate, Name: "LegIssueDate", Type: FieldTypeString},
+				{Tag: tag.LegRepoCollateralSecurityType, Name: "LegRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseTerm, Name: "LegRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseRate, Name: "LegRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegFactor, Name: "LegFactor", Type: FieldTypeDecimal},
+				{Tag: tag.LegCreditRating, Name: "LegCreditRating", Type: FieldTypeString},
+				{Tag: tag.LegInstrRegistry, Name: "LegInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.LegCountryOfIssue, Name: "LegCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegStateOrProvinceOfIssue, Name: "LegStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegLocaleOfIssue, Name: "LegLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegRedemptionDate, Name: "LegRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.LegStrikePrice, Name: "LegStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.LegStrikeCurrency, Name: "LegStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.LegOptAttribute, Name: "LegOptAttribute", Type: FieldTypeString},
+				{Tag: tag.LegContractMultiplier, Name: "LegContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.LegCouponRate, Name: "LegCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegSecurityExchange, Name: "LegSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.LegIssuer, Name: "LegIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedLegIssuerLen, Name: "EncodedLegIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegIssuer, Name: "EncodedLegIssuer", Type: FieldTypeString},
+				{Tag: tag.LegSecurityDesc, Name: "LegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedLegSecurityDescLen, Name: "EncodedLegSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegSecurityDesc, Name: "EncodedLegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.LegRatioQty, Name: "LegRatioQty", Type: FieldTypeDecimal},
+				{Tag: tag.LegSide, Name: "LegSide", Type: FieldTypeString},
+				{Tag: tag.LegCurrency, Name: "LegCurrency", Type: FieldTypeString},
+				{Tag: tag.LegPool, Name: "LegPool", Type: FieldTypeString},
+				{Tag: tag.LegDatedDate, Name: "LegDatedDate", Type: FieldTypeString},
+				{Tag: tag.LegContractSettlMonth, Name: "LegContractSettlMonth", Type: FieldTypeString},
+				{Tag: tag.LegInterestAccrualDate, Name: "LegInterestAccrualDate", Type: FieldTypeString},
+			}},
+			{Tag: tag.AccountType, Name: "AccountType", Type: FieldTypeString, Required: true},
+			{Tag: tag.AcctIDSource, Name: "AcctIDSource", Type: FieldTypeString},
+			{Tag: tag.ContractSettlMonth, Name: "ContractSettlMonth", Type: FieldTypeString},
+			{Tag: tag.Pool, Name: "Pool", Type: FieldTypeString},
+			{Tag: tag.NoPositions, Name: "NoPositions", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.PosType, Name: "PosType", Type: FieldTypeString, Required: true},
+				{Tag: tag.LongQty, Name: "LongQty", Type: FieldTypeDecimal},
+				{Tag: tag.ShortQty, Name: "ShortQty", Type: FieldTypeDecimal},
+				{Tag: tag.PosQtyStatus, Name: "PosQtyStatus", Type: FieldTypeString},
+				{Tag: tag.NoNestedPartyIDs, Name: "NoNestedPartyIDs", Type: FieldTypeNumInGroup, Fields: noNestedPartyIDsFields},
+			}},
+			{Tag: tag.PosTransType, Name: "PosTransType", Type: FieldTypeString, Required: true},
+			{Tag: tag.PosReqID, Name: "PosReqID", Type: FieldTypeString, Required: true},
+			{Tag: tag.NoUnderlyings, Name: "NoUnderlyings", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.UnderlyingSymbol, Name: "UnderlyingSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.UnderlyingSymbolSfx, Name: "UnderlyingSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityID, Name: "UnderlyingSecurityID", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityIDSource, Name: "UnderlyingSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoUnderlyingSecurityAltID, Name: "NoUnderlyingSecurityAltID", Type: FieldTypeNumInGroup, Fields: noUnderlyingSecurityAltIDFields},
+				{Tag: tag.UnderlyingProduct, Name: "UnderlyingProduct", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingCFICode, Name: "UnderlyingCFICode", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityType, Name: "UnderlyingSecurityType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecuritySubType, Name: "UnderlyingSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityMonthYear, Name: "UnderlyingMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityDate, Name: "UnderlyingMaturityDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCouponPaymentDate, Name: "UnderlyingCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssueDate, Name: "UnderlyingIssueDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRepoCollateralSecurityType, Name: "UnderlyingRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseTerm, Name: "UnderlyingRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseRate, Name: "UnderlyingRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingFactor, Name: "UnderlyingFactor", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCreditRating, Name: "UnderlyingCreditRating", Type: FieldTypeString},
+				{Tag: tag.UnderlyingInstrRegistry, Name: "UnderlyingInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCountryOfIssue, Name: "UnderlyingCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStateOrProvinceOfIssue, Name: "UnderlyingStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingLocaleOfIssue, Name: "UnderlyingLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRedemptionDate, Name: "UnderlyingRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStrikePrice, Name: "UnderlyingStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStrikeCurrency, Name: "UnderlyingStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingOptAttribute, Name: "UnderlyingOptAttribute", Type: FieldTypeString},
+				{Tag: tag.UnderlyingContractMultiplier, Name: "UnderlyingContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCouponRate, Name: "UnderlyingCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingSecurityExchange, Name: "UnderlyingSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssuer, Name: "UnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingIssuerLen, Name: "EncodedUnderlyingIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingIssuer, Name: "EncodedUnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityDesc, Name: "UnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingSecurityDescLen, Name: "EncodedUnderlyingSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingSecurityDesc, Name: "EncodedUnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPProgram, Name: "UnderlyingCPProgram", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPRegType, Name: "UnderlyingCPRegType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCurrency, Name: "UnderlyingCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingQty, Name: "UnderlyingQty", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingPx, Name: "UnderlyingPx", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingDirtyPrice, Name: "UnderlyingDirtyPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndPrice, Name: "UnderlyingEndPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStartValue, Name: "UnderlyingStartValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCurrentValue, Name: "UnderlyingCurrentValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndValue, Name: "UnderlyingEndValue", Type: FieldTypeDecimal},
+				{Tag: tag.NoUnderlyingStips, Name: "NoUnderlyingStips", Type: FieldTypeNumInGroup, Fields: noUnderlyingStipsFields},
+			}},
+			{Tag: tag.PosMaintAction, Name: "PosMaintAction", Type: FieldTypeString, Required: true},
+			{Tag: tag.OrigPosReqRefID, Name: "OrigPosReqRefID", Type: FieldTypeString},
+			{Tag: tag.PosMaintRptRefID, Name: "PosMaintRptRefID", Type: FieldTypeString},
+			{Tag: tag.ClearingBusinessDate, Name: "ClearingBusinessDate", Type: FieldTypeString, Required: true},
+			{Tag: tag.SettlSessID, Name: "SettlSessID", Type: FieldTypeString},
+			{Tag: tag.SettlSessSubID, Name: "SettlSessSubID", Type: FieldTypeString},
+			{Tag: tag.AdjustmentType, Name: "AdjustmentType", Type: FieldTypeString},
+			{Tag: tag.ContraryInstructionIndicator, Name: "ContraryInstructionIndicator", Type: FieldTypeBool},
+			{Tag: tag.PriorSpreadIndicator, Name: "PriorSpreadIndicator", Type: FieldTypeBool},
+			{Tag: tag.SecuritySubType, Name: "SecuritySubType", Type: FieldTypeString},
+			{Tag: tag.ThresholdAmount, Name: "ThresholdAmount", Type: FieldTypeDecimal},
+			{Tag: tag.NoEvents, Name: "NoEvents", Type: FieldTypeNumInGroup, Fields: noEventsFields},
+			{Tag: tag.DatedDate, Name: "DatedDate", Type: FieldTypeString},
+			{Tag: tag.InterestAccrualDate, Name: "InterestAccrualDate", Type: FieldTypeString},
+			{Tag: tag.CPProgram, Name: "CPProgram", Type: FieldTypeString},
+			{Tag: tag.CPRegType, Name: "CPRegType", Type: FieldTypeString},
+			{Tag: tag.StrikeCurrency, Name: "StrikeCurrency", Type: FieldTypeString},
+		},
+	},
+	"AP": {
+		Name:    "PositionReport",
+		MsgType: "AP",
+		Fields: []FieldDef{
+			{Tag: tag.Account, Name: "Account", Type: FieldTypeString, Required: true},
+			{Tag: tag.Currency, Name: "Currency", Type: FieldTypeString},
+			{Tag: tag.SecurityIDSource, Name: "SecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.SecurityID, Name: "SecurityID", Type: FieldTypeString},
+			{Tag: tag.Symbol, Name: "Symbol", Type: FieldTypeString},
+			{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+			{Tag: tag.SymbolSfx, Name: "SymbolSfx", Type: FieldTypeString},
+			{Tag: tag.Issuer, Name: "Issuer", Type: FieldTypeString},
+			{Tag: tag.SecurityDesc, Name: "SecurityDesc", Type: FieldTypeString},
+			{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+			{Tag: tag.MaturityMonthYear, Name: "MaturityMonthYear", Type: FieldTypeString},
+			{Tag: tag.StrikePrice, Name: "StrikePrice", Type: FieldTypeDecimal},
+			{Tag: tag.OptAttribute, Name: "OptAttribute", Type: FieldTypeString},
+			{Tag: tag.SecurityExchange, Name: "SecurityExchange", Type: FieldTypeString},
+			{Tag: tag.CouponRate, Name: "CouponRate", Type: FieldTypeDecimal},
+			{Tag: tag.CouponPaymentDate, Name: "CouponPaymentDate", Type: FieldTypeString},
+			{Tag: tag.IssueDate, Name: "IssueDate", Type: FieldTypeString},
+			{Tag: tag.RepurchaseTerm, Name: "RepurchaseTerm", Type: FieldTypeInt},
+			{Tag: tag.RepurchaseRate, Name: "RepurchaseRate", Type: FieldTypeDecimal},
+			{Tag: tag.Factor, Name: "Factor", Type: FieldTypeDecimal},
+			{Tag: tag.ContractMultiplier, Name: "ContractMultiplier", Type: FieldTypeDecimal},
+			{Tag: tag.RepoCollateralSecurityType, Name: "RepoCollateralSecurityType", Type: FieldTypeInt},
+			{Tag: tag.RedemptionDate, Name: "RedemptionDate", Type: FieldTypeString},
+			{Tag: tag.CreditRating, Name: "CreditRating", Type: FieldTypeString},
+			{Tag: tag.SubscriptionRequestType, Name: "SubscriptionRequestType", Type: FieldTypeString},
+			{Tag: tag.UnsolicitedIndicator, Name: "UnsolicitedIndicator", Type: FieldTypeBool},
+			{Tag: tag.EncodedIssuerLen, Name: "EncodedIssuerLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedIssuer, Name: "EncodedIssuer", Type: FieldTypeString},
+			{Tag: tag.EncodedSecurityDescLen, Name: "EncodedSecurityDescLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedSecurityDesc, Name: "EncodedSecurityDesc", Type: FieldTypeString},
+			{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			{Tag: tag.NoPartyIDs, Name: "NoPartyIDs", Type: FieldTypeNumInGroup, Fields: noPartyIDsFields},
+			{Tag: tag.NoSecurityAltID, Name: "NoSecurityAltID", Type: FieldTypeNumInGroup, Fields: noSecurityAltIDFields},
+			{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+			{Tag: tag.CFICode, Name: "CFICode", Type: FieldTypeString},
+			{Tag: tag.CountryOfIssue, Name: "CountryOfIssue", Type: FieldTypeString},
+			{Tag: tag.StateOrProvinceOfIssue, Name: "StateOrProvinceOfIssue", Type: FieldTypeString},
+			{Tag: tag.LocaleOfIssue, Name: "LocaleOfIssue", Type: FieldTypeString},
+			{Tag: tag.RegistStatus, Name: "RegistStatus", Type: FieldTypeString},
+			{Tag: tag.MaturityDate, Name: "MaturityDate", Type: FieldTypeString},
+			{Tag: tag.InstrRegistry, Name: "InstrRegistry", Type: FieldTypeString},
+			{Tag: tag.NoLegs, Name: "NoLegs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.LegSymbol, Name: "LegSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.LegSymbolSfx, Name: "LegSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.LegSecurityID, Name: "LegSecurityID", Type: FieldTypeString},
+				{Tag: tag.LegSecurityIDSource, Name: "LegSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoLegSecurityAltID, Name: "NoLegSecurityAltID", Type: FieldTypeNumInGroup, Fields: noLegSecurityAltIDFields},
+				{Tag: tag.LegProduct, Name: "LegProduct", Type: FieldTypeInt},
+				{Tag: tag.LegCFICode, Name: "LegCFICode", Type: FieldTypeString},
+				{Tag: tag.LegSecurityType, Name: "LegSecurityType", Type: FieldTypeString},
+				{Tag: tag.LegSecuritySubType, Name: "LegSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.LegMaturityMonthYear, Name: "LegMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.LegMaturityDate, Name: "LegMaturityDate", Type: FieldTypeString},
+				{Tag: tag.LegCouponPaymentDate, Name: "LegCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.LegIssueDate, Name: "LegIssueDate", Type: FieldTypeString},
+				{Tag: tag.LegRepoCollateralSecurityType, Name: "LegRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseTerm, Name: "LegRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseRate, Name: "LegRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegFactor, Name: "LegFactor", Type: FieldTypeDecimal},
+				{Tag: tag.LegCreditRating, Name: "LegCreditRating", Type: FieldTypeString},
+				{Tag: tag.LegInstrRegistry, Name: "LegInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.LegCountryOfIssue, Name: "LegCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegStateOrProvinceOfIssue, Name: "LegStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegLocaleOfIssue, Name: "LegLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegRedemptionDate, Name: "LegRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.LegStrikePrice, Name: "LegStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.LegStrikeCurrency, Name: "LegStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.LegOptAttribute, Name: "LegOptAttribute", Type: FieldTypeString},
+				{Tag: tag.LegContractMultiplier, Name: "LegContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.LegCouponRate, Name: "LegCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegSecurityExchange, Name: "LegSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.LegIssuer, Name: "LegIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedLegIssuerLen, Name: "EncodedLegIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegIssuer, Name: "EncodedLegIssuer", Type: FieldTypeString},
+				{Tag: tag.LegSecurityDesc, Name: "LegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedLegSecurityDescLen, Name: "EncodedLegSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegSecurityDesc, Name: "EncodedLegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.LegRatioQty, Name: "LegRatioQty", Type: FieldTypeDecimal},
+				{Tag: tag.LegSide, Name: "LegSide", Type: FieldTypeString},
+				{Tag: tag.LegCurrency, Name: "LegCurrency", Type: FieldTypeString},
+				{Tag: tag.LegPool, Name: "LegPool", Type: FieldTypeString},
+				{Tag: tag.LegDatedDate, Name: "LegDatedDate", Type: FieldTypeString},
+				{Tag: tag.LegContractSettlMonth, Name: "LegContractSettlMonth", Type: FieldTypeString},
+				{Tag: tag.LegInterestAccrualDate, Name: "LegInterestAccrualDate", Type: FieldTypeString},
+			}},
+			{Tag: tag.AccountType, Name: "AccountType", Type: FieldTypeString, Required: true},
+			{Tag: tag.AcctIDSource, Name: "AcctIDSource", Type: FieldTypeString},
+			{Tag: tag.ContractSettlMonth, Name: "ContractSettlMonth", Type: FieldTypeString},
+			{Tag: tag.Pool, Name: "Pool", Type: FieldTypeString},
+			{Tag: tag.NoPositions, Name: "NoPositions", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.PosType, Name: "PosType", Type: FieldTypeString, Required: true},
+				{Tag: tag.LongQty, Name: "LongQty", Type: FieldTypeDecimal},
+				{Tag: tag.ShortQty, Name: "ShortQty", Type: FieldTypeDecimal},
+				{Tag: tag.PosQtyStatus, Name: "PosQtyStatus", Type: FieldTypeString},
+				{Tag: tag.NoNestedPartyIDs, Name: "NoNestedPartyIDs", Type: FieldTypeNumInGroup, Fields: noNestedPartyIDsFields},
+			}},
+			{Tag: tag.PosReqID, Name: "PosReqID", Type: FieldTypeString},
+			{Tag: tag.NoUnderlyings, Name: "NoUnderlyings", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.UnderlyingSymbol, Name: "UnderlyingSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.UnderlyingSymbolSfx, Name: "UnderlyingSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityID, Name: "UnderlyingSecurityID", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityIDSource, Name: "UnderlyingSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoUnderlyingSecurityAltID, Name: "NoUnderlyingSecurityAltID", Type: FieldTypeNumInGroup, Fields: noUnderlyingSecurityAltIDFields},
+				{Tag: tag.UnderlyingProduct, Name: "UnderlyingProduct", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingCFICode, Name: "UnderlyingCFICode", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityType, Name: "UnderlyingSecurityType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecuritySubType, Name: "UnderlyingSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityMonthYear, Name: "UnderlyingMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityDate, Name: "UnderlyingMaturityDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCouponPaymentDate, Name: "UnderlyingCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssueDate, Name: "UnderlyingIssueDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRepoCollateralSecurityType, Name: "UnderlyingRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseTerm, Name: "UnderlyingRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseRate, Name: "UnderlyingRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingFactor, Name: "UnderlyingFactor", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCreditRating, Name: "UnderlyingCreditRating", Type: FieldTypeString},
+				{Tag: tag.UnderlyingInstrRegistry, Name: "UnderlyingInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCountryOfIssue, Name: "UnderlyingCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStateOrProvinceOfIssue, Name: "UnderlyingStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingLocaleOfIssue, Name: "UnderlyingLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRedemptionDate, Name: "UnderlyingRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStrikePrice, Name: "UnderlyingStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStrikeCurrency, Name: "UnderlyingStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingOptAttribute, Name: "UnderlyingOptAttribute", Type: FieldTypeString},
+				{Tag: tag.UnderlyingContractMultiplier, Name: "UnderlyingContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCouponRate, Name: "UnderlyingCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingSecurityExchange, Name: "UnderlyingSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssuer, Name: "UnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingIssuerLen, Name: "EncodedUnderlyingIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingIssuer, Name: "EncodedUnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityDesc, Name: "UnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingSecurityDescLen, Name: "EncodedUnderlyingSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingSecurityDesc, Name: "EncodedUnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPProgram, Name: "UnderlyingCPProgram", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPRegType, Name: "UnderlyingCPRegType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCurrency, Name: "UnderlyingCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingQty, Name: "UnderlyingQty", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingPx, Name: "UnderlyingPx", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingDirtyPrice, Name: "UnderlyingDirtyPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndPrice, Name: "UnderlyingEndPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStartValue, Name: "UnderlyingStartValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCurrentValue, Name: "UnderlyingCurrentValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndValue, Name: "UnderlyingEndValue", Type: FieldTypeDecimal},
+				{Tag: tag.NoUnderlyingStips, Name: "NoUnderlyingStips", Type: FieldTypeNumInGroup, Fields: noUnderlyingStipsFields},
+				{Tag: tag.UnderlyingSettlPrice, Name: "UnderlyingSettlPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingSettlPriceType, Name: "UnderlyingSettlPriceType", Type: FieldTypeInt},
+			}},
+			{Tag: tag.ClearingBusinessDate, Name: "ClearingBusinessDate", Type: FieldTypeString, Required: true},
+			{Tag: tag.SettlSessID, Name: "SettlSessID", Type: FieldTypeString},
+			{Tag: tag.SettlSessSubID, Name: "SettlSessSubID", Type: FieldTypeString},
+			{Tag: tag.PosMaintRptID, Name: "PosMaintRptID", Type: FieldTypeString, Required: true},
+			{Tag: tag.PosReqType, Name: "PosReqType", Type: FieldTypeString},
+			{Tag: tag.TotalNumPosReports, Name: "TotalNumPosReports", Type: FieldTypeInt},
+			{Tag: tag.PosReqResult, Name: "PosReqResult", Type: FieldTypeString, Required: true},
+			{Tag: tag.SettlPrice, Name: "SettlPrice", Type: FieldTypeDecimal, Required: true},
+			{Tag: tag.SettlPriceType, Name: "SettlPriceType", Type: FieldTypeString, Required: true},
+			{Tag: tag.PriorSettlPrice, Name: "PriorSettlPrice", Type: FieldTypeDecimal, Required: true},
+			{Tag: tag.DeliveryDate, Name: "DeliveryDate", Type: FieldTypeString},
+			{Tag: tag.NoPosAmt, Name: "NoPosAmt", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.PosAmtType, Name: "PosAmtType", Type: FieldTypeString, Required: true},
+				{Tag: tag.PosAmt, Name: "PosAmt", Type: FieldTypeDecimal},
+			}},
+			{Tag: tag.SecuritySubType, Name: "SecuritySubType", Type: FieldTypeString},
+			{Tag: tag.NoEvents, Name: "NoEvents", Type: FieldTypeNumInGroup, Fields: noEventsFields},
+			{Tag: tag.DatedDate, Name: "DatedDate", Type: FieldTypeString},
+			{Tag: tag.InterestAccrualDate, Name: "InterestAccrualDate", Type: FieldTypeString},
+			{Tag: tag.CPProgram, Name: "CPProgram", Type: FieldTypeString},
+			{Tag: tag.CPRegType, Name: "CPRegType", Type: FieldTypeString},
+			{Tag: tag.StrikeCurrency, Name: "StrikeCurrency", Type: FieldTypeString},
+		},
+	},
+	"S": {
+		Name:    "Quote",
+		MsgType: "S",
+		Fields: []FieldDef{
+			{Tag: tag.Account, Name: "Account", Type: FieldTypeString},
+			{Tag: tag.Commission, Name: "Commission", Type: FieldTypeDecimal},
+			{Tag: tag.CommType, Name: "CommType", Type: FieldTypeString},
+			{Tag: tag.Currency, Name: "Currency", Type: FieldTypeString},
+			{Tag: tag.SecurityIDSource, Name: "SecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.OrderQty, Name: "OrderQty", Type: FieldTypeDecimal},
+			{Tag: tag.OrdType, Name: "OrdType", Type: FieldTypeString},
+			{Tag: tag.SecurityID, Name: "SecurityID", Type: FieldTypeString},
+			{Tag: tag.Side, Name: "Side", Type: FieldTypeString},
+			{Tag: tag.Symbol, Name: "Symbol", Type: FieldTypeString},
+			{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+			{Tag: tag.TransactTime, Name: "TransactTime", Type: FieldTypeUTCTimestamp},
+			{Tag: tag.ValidUntilTime, Name: "ValidUntilTime", Type: FieldTypeUTCTimestamp},
+			{Tag: tag.SettlType, Name: "SettlType", Type: FieldTypeString},
+			{Tag: tag.SettlDate, Name: "SettlDate", Type: FieldTypeString},
+			{Tag: tag.SymbolSfx, Name: "SymbolSfx", Type: FieldTypeString},
+			{Tag: tag.ExDestination, Name: "ExDestination", Type: FieldTypeString},
+			{Tag: tag.Issuer, Name: "Issuer", Type: FieldTypeString},
+			{Tag: tag.SecurityDesc, Name: "SecurityDesc", Type: FieldTypeString},
+			{Tag: tag.QuoteID, Name: "QuoteID", Type: FieldTypeString, Required: true},
+			{Tag: tag.QuoteReqID, Name: "QuoteReqID", Type: FieldTypeString},
+			{Tag: tag.BidPx, Name: "BidPx", Type: FieldTypeDecimal},
+			{Tag: tag.OfferPx, Name: "OfferPx", Type: FieldTypeDecimal},
+			{Tag: tag.BidSize, Name: "BidSize", Type: FieldTypeDecimal},
+			{Tag: tag.OfferSize, Name: "OfferSize", Type: FieldTypeDecimal},
+			{Tag: tag.CashOrderQty, Name: "CashOrderQty", Type: FieldTypeDecimal},
+			{Tag: tag.SettlCurrFxRateCalc, Name: "SettlCurrFxRateCalc", Type: FieldTypeString},
+			{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+			{Tag: tag.BidSpotRate, Name: "BidSpotRate", Type: FieldTypeDecimal},
+			{Tag: tag.BidForwardPoints, Name: "BidForwardPoints", Type: FieldTypeDecimal},
+			{Tag: tag.OfferSpotRate, Name: "OfferSpotRate", Type: FieldTypeDecimal},
+			{Tag: tag.OfferForwardPoints, Name: "OfferForwardPoints", Type: FieldTypeDecimal},
+			{Tag: tag.OrderQty2, Name: "OrderQty2", Type: FieldTypeDecimal},
+			{Tag: tag.SettlDate2, Name: "SettlDate2", Type: FieldTypeString},
+			{Tag: tag.MaturityMonthYear, Name: "MaturityMonthYear", Type: FieldTypeString},
+			{Tag: tag.StrikePrice, Name: "StrikePrice", Type: FieldTypeDecimal},
+			{Tag: tag.OptAttribute, Name: "OptAttribute", Type: FieldTypeString},
+			{Tag: tag.SecurityExchange, Name: "SecurityExchange", Type: FieldTypeString},
+			{Tag: tag.Spread, Name: "Spread", Type: FieldTypeDecimal},
+			{Tag: tag.BenchmarkCurveCurrency, Name: "BenchmarkCurveCurrency", Type: FieldTypeString},
+			{Tag: tag.BenchmarkCurveName, Name: "BenchmarkCurveName", Type: FieldTypeString},
+			{Tag: tag.BenchmarkCurvePoint, Name: "BenchmarkCurvePoint", Type: FieldTypeString},
+			{Tag: tag.CouponRate, Name: "CouponRate", Type: FieldTypeDecimal},
+			{Tag: tag.CouponPaymentDate, Name: "CouponPaymentDate", Type: FieldTypeString},
+			{Tag: tag.IssueDate, Name: "IssueDate", Type: FieldTypeString},
+			{Tag: tag.RepurchaseTerm, Name: "RepurchaseTerm", Type: FieldTypeInt},
+			{Tag: tag.RepurchaseRate, Name: "RepurchaseRate", Type: FieldTypeDecimal},
+			{Tag: tag.Factor, Name: "Factor", Type: FieldTypeDecimal},
+			{Tag: tag.ContractMultiplier, Name: "ContractMultiplier", Type: FieldTypeDecimal},
+			{Tag: tag.NoStipulations, Name: "NoStipulations", Type: FieldTypeNumInGroup, Fields: noStipulationsFields},
+			{Tag: tag.YieldType, Name: "YieldType", Type: FieldTypeString},
+			{Tag: tag.Yield, Name: "Yield", Type: FieldTypeDecimal},
+			{Tag: tag.RepoCollateralSecurityType, Name: "RepoCollateralSecurityType", Type: FieldTypeInt},
+			{Tag: tag.RedemptionDate, Name: "RedemptionDate", Type: FieldTypeString},
+			{Tag: tag.CreditRating, Name: "CreditRating", Type: FieldTypeString},
+			{Tag: tag.QuoteResponseLevel, Name: "QuoteResponseLevel", Type: FieldTypeString},
+			{Tag: tag.TradingSessionID, Name: "TradingSessionID", Type: FieldTypeString},
+			{Tag: tag.EncodedIssuerLen, Name: "EncodedIssuerLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedIssuer, Name: "EncodedIssuer", Type: FieldTypeString},
+			{Tag: tag.EncodedSecurityDescLen, Name: "EncodedSecurityDescLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedSecurityDesc, Name: "EncodedSecurityDesc", Type: FieldTypeString},
+			{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			{Tag: tag.PriceType, Name: "PriceType", Type: FieldTypeString},
+			{Tag: tag.NoPartyIDs, Name: "NoPartyIDs", Type: FieldTypeNumInGroup, Fields: noPartyIDsFields},
+			{Tag: tag.NoSecurityAltID, Name: "NoSecurityAltID", Type: FieldTypeNumInGroup, Fields: noSecurityAltIDFields},
+			{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+			{Tag: tag.CFICode, Name: "CFICode", Type: FieldTypeString},
+			{Tag: tag.RoundingDirection, Name: "RoundingDirection", Type: FieldTypeString},
+			{Tag: tag.RoundingModulus, Name: "RoundingModulus", Type: FieldTypeDecimal},
+			{Tag: tag.CountryOfIssue, Name: "CountryOfIssue", Type: FieldTypeString},
+			{Tag: tag.StateOrProvinceOfIssue, Name: "StateOrProvinceOfIssue", Type: FieldTypeString},
+			{Tag: tag.LocaleOfIssue, Name: "LocaleOfIssue", Type: FieldTypeString},
+			{Tag: tag.OrderPercent, Name: "OrderPercent", Type: FieldTypeDecimal},
+			{Tag: tag.OrderCapacity, Name: "OrderCapacity", Type: FieldTypeString},
+			{Tag: tag.QuoteType, Name: "QuoteType", Type: FieldTypeString},
+			{Tag: tag.MaturityDate, Name: "MaturityDate", Type: FieldTypeString},
+			{Tag: tag.InstrRegistry, Name: "InstrRegistry", Type: FieldTypeString},
+			{Tag: tag.NoLegs, Name: "NoLegs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.LegSymbol, Name: "LegSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.LegSymbolSfx, Name: "LegSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.LegSecurityID, Name: "LegSecurityID", Type: FieldTypeString},
+				{Tag: tag.LegSecurityIDSource, Name: "LegSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoLegSecurityAltID, Name: "NoLegSecurityAltID", Type: FieldTypeNumInGroup, Fields: noLegSecurityAltIDFields},
+				{Tag: tag.LegProduct, Name: "LegProduct", Type: FieldTypeInt},
+				{Tag: tag.LegCFICode, Name: "LegCFICode", Type: FieldTypeString},
+				{Tag: tag.LegSecurityType, Name: "LegSecurityType", Type: FieldTypeString},
+				{Tag: tag.LegSecuritySubType, Name: "LegSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.LegMaturityMonthYear, Name: "LegMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.LegMaturityDate, Name: "LegMaturityDate", Type: FieldTypeString},
+				{Tag: tag.LegCouponPaymentDate, Name: "LegCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.LegIssueDate, Name: "LegIssueDate", Type: FieldTypeString},
+				{Tag: tag.LegRepoCollateralSecurityType, Name: "LegRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseTerm, Name: "LegRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseRate, Name: "LegRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegFactor, Name: "LegFactor", Type: FieldTypeDecimal},
+				{Tag: tag.LegCreditRating, Name: "LegCreditRating", Type: FieldTypeString},
+				{Tag: tag.LegInstrRegistry, Name: "LegInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.LegCountryOfIssue, Name: "LegCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegStateOrProvinceOfIssue, Name: "LegStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegLocaleOfIssue, Name: "LegLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegRedemptionDate, Name: "LegRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.LegStrikePrice, Name: "LegStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.LegStrikeCurrency, Name: "LegStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.LegOptAttribute, Name: "LegOptAttribute", Type: FieldTypeString},
+				{Tag: tag.LegContractMultiplier, Name: "LegContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.LegCouponRate, Name: "LegCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegSecurityExchange, Name: "LegSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.LegIssuer, Name: "LegIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedLegIssuerLen, Name: "EncodedLegIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegIssuer, Name: "EncodedLegIssuer", Type: FieldTypeString},
+				{Tag: tag.LegSecurityDesc, Name: "LegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedLegSecurityDescLen, Name: "EncodedLegSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegSecurityDesc, Name: "EncodedLegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.LegRatioQty, Name: "LegRatioQty", Type: FieldTypeDecimal},
+				{Tag: tag.LegSide, Name: "LegSide", Type: FieldTypeString},
+				{Tag: tag.LegCurrency, Name: "LegCurrency", Type: FieldTypeString},
+				{Tag: tag.LegPool, Name: "LegPool", Type: FieldTypeString},
+				{Tag: tag.LegDatedDate, Name: "LegDatedDate", Type: FieldTypeString},
+				{Tag: tag.LegContractSettlMonth, Name: "LegContractSettlMonth", Type: FieldTypeString},
+				{Tag: tag.LegInterestAccrualDate, Name: "LegInterestAccrualDate", Type: FieldTypeString},
+				{Tag: tag.LegQty, Name: "LegQty", Type: FieldTypeDecimal},
+				{Tag: tag.LegSwapType, Name: "LegSwapType", Type: FieldTypeString},
+				{Tag: tag.LegSettlType, Name: "LegSettlType", Type: FieldTypeString},
+				{Tag: tag.LegSettlDate, Name: "LegSettlDate", Type: FieldTypeString},
+				{Tag: tag.NoLegStipulations, Name: "NoLegStipulations", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+					{Tag: tag.LegStipulationType, Name: "LegStipulationType", Type: FieldTypeString, Required: true},
+					{Tag: tag.LegStipulationValue, Name: "LegStipulationValue", Type: FieldTypeString},
+				}},
+				{Tag: tag.NoNestedPartyIDs, Name: "NoNestedPartyIDs", Type: FieldTypeNumInGroup, Fields: noNestedPartyIDsFields},
+				{Tag: tag.LegPriceType, Name: "LegPriceType", Type: FieldTypeInt},
+				{Tag: tag.LegBidPx, Name: "LegBidPx", Type: FieldTypeDecimal},
+				{Tag: tag.LegOfferPx, Name: "LegOfferPx", Type: FieldTypeDecimal},
+				{Tag: tag.LegBenchmarkCurveCurrency, Name: "LegBenchmarkCurveCurrency", Type: FieldTypeString},
+				{Tag: tag.LegBenchmarkCurveName, Name: "LegBenchmarkCurveName", Type: FieldTypeString},
+				{Tag: tag.LegBenchmarkCurvePoint, Name: "LegBenchmarkCurvePoint", Type: FieldTypeString},
+				{Tag: tag.LegBenchmarkPrice, Name: "LegBenchmarkPrice", Type: FieldTypeDecimal},
+				{Tag: tag.LegBenchmarkPriceType, Name: "LegBenchmarkPriceType", Type: FieldTypeInt},
+			}},
+			{Tag: tag.AccountType, Name: "AccountType", Type: FieldTypeString},
+			{Tag: tag.CustOrderCapacity, Name: "CustOrderCapacity", Type: FieldTypeString},
+			{Tag: tag.TradingSessionSubID, Name: "TradingSessionSubID", Type: FieldTypeString},
+			{Tag: tag.MidPx, Name: "MidPx", Type: FieldTypeDecimal},
+			{Tag: tag.BidYield, Name: "BidYield", Type: FieldTypeDecimal},
+			{Tag: tag.MidYield, Name: "MidYield", Type: FieldTypeDecimal},
+			{Tag: tag.OfferYield, Name: "OfferYield", Type: FieldTypeDecimal},
+			{Tag: tag.BidForwardPoints2, Name: "BidForwardPoints2", Type: FieldTypeDecimal},
+			{Tag: tag.OfferForwardPoints2, Name: "OfferForwardPoints2", Type: FieldTypeDecimal},
+			{Tag: tag.MktBidPx, Name: "MktBidPx", Type: FieldTypeDecimal},
+			{Tag: tag.MktOfferPx, Name: "MktOfferPx", Type: FieldTypeDecimal},
+			{Tag: tag.MinBidSize, Name: "MinBidSize", Type: FieldTypeDecimal},
+			{Tag: tag.MinOfferSize, Name: "MinOfferSize", Type: FieldTypeDecimal},
+			{Tag: tag.SettlCurrBidFxRate, Name: "SettlCurrBidFxRate", Type: FieldTypeDecimal},
+			{Tag: tag.SettlCurrOfferFxRate, Name: "SettlCurrOfferFxRate", Type: FieldTypeDecimal},
+			{Tag: tag.AcctIDSource, Name: "AcctIDSource", Type: FieldTypeString},
+			{Tag: tag.BenchmarkPrice, Name: "BenchmarkPrice", Type: FieldTypeDecimal},
+			{Tag: tag.BenchmarkPriceType, Name: "BenchmarkPriceType", Type: FieldTypeInt},
+			{Tag: tag.ContractSettlMonth, Name: "ContractSettlMonth", Type: FieldTypeString},
+			{Tag: tag.Pool, Name: "Pool", Type: FieldTypeString},
+			{Tag: tag.QuoteRespID, Name: "QuoteRespID", Type: FieldTypeString},
+			{Tag: tag.YieldRedemptionDate, Name: "YieldRedemptionDate", Type: FieldTypeString},
+			{Tag: tag.YieldRedemptionPrice, Name: "YieldRedemptionPrice", Type: FieldTypeDecimal},
+			{Tag: tag.YieldRedemptionPriceType, Name: "YieldRedemptionPriceType", Type: FieldTypeInt},
+			{Tag: tag.BenchmarkSecurityID, Name: "BenchmarkSecurityID", Type: FieldTypeString},
+			{Tag: tag.YieldCalcDate, Name: "YieldCalcDate", Type: FieldTypeString},
+			{Tag: tag.NoUnderlyings, Name: "NoUnderlyings", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.UnderlyingSymbol, Name: "UnderlyingSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.UnderlyingSymbolSfx, Name: "UnderlyingSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityID, Name: "UnderlyingSecurityID", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityIDSource, Name: "UnderlyingSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoUnderlyingSecurityAltID, Name: "NoUnderlyingSecurityAltID", Type: FieldTypeNumInGroup, Fields: noUnderlyingSecurityAltIDFields},
+				{Tag: tag.UnderlyingProduct, Name: "UnderlyingProduct", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingCFICode, Name: "UnderlyingCFICode", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityType, Name: "UnderlyingSecurityType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecuritySubType, Name: "UnderlyingSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityMonthYear, Name: "UnderlyingMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityDate, Name: "UnderlyingMaturityDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCouponPaymentDate, Name: "UnderlyingCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssueDate, Name: "UnderlyingIssueDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRepoCollateralSecurityType, Name: "UnderlyingRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseTerm, Name: "UnderlyingRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseRate, Name: "UnderlyingRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingFactor, Name: "UnderlyingFactor", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCreditRating, Name: "UnderlyingCreditRating", Type: FieldTypeString},
+				{Tag: tag.UnderlyingInstrRegistry, Name: "UnderlyingInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCountryOfIssue, Name: "UnderlyingCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStateOrProvinceOfIssue, Name: "UnderlyingStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingLocaleOfIssue, Name: "UnderlyingLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRedemptionDate, Name: "UnderlyingRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStrikePrice, Name: "UnderlyingStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStrikeCurrency, Name: "UnderlyingStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingOptAttribute, Name: "UnderlyingOptAttribute", Type: FieldTypeString},
+				{Tag: tag.UnderlyingContractMultiplier, Name: "UnderlyingContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCouponRate, Name: "UnderlyingCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingSecurityExchange, Name: "UnderlyingSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssuer, Name: "UnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingIssuerLen, Name: "EncodedUnderlyingIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingIssuer, Name: "EncodedUnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityDesc, Name: "UnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingSecurityDescLen, Name: "EncodedUnderlyingSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingSecurityDesc, Name: "EncodedUnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPProgram, Name: "UnderlyingCPProgram", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPRegType, Name: "UnderlyingCPRegType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCurrency, Name: "UnderlyingCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingQty, Name: "UnderlyingQty", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingPx, Name: "UnderlyingPx", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingDirtyPrice, Name: "UnderlyingDirtyPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndPrice, Name: "UnderlyingEndPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStartValue, Name: "UnderlyingStartValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCurrentValue, Name: "UnderlyingCurrentValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndValue, Name: "UnderlyingEndValue", Type: FieldTypeDecimal},
+				{Tag: tag.NoUnderlyingStips, Name: "NoUnderlyingStips", Type: FieldTypeNumInGroup, Fields: noUnderlyingStipsFields},
+			}},
+			{Tag: tag.NoQuoteQualifiers, Name: "NoQuoteQualifiers", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.QuoteQualifier, Name: "QuoteQualifier", Type: FieldTypeString, Required: true},
+			}},
+			{Tag: tag.BenchmarkSecurityIDSource, Name: "BenchmarkSecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.SecuritySubType, Name: "SecuritySubType", Type: FieldTypeString},
+			{Tag: tag.TerminationType, Name: "TerminationType", Type: FieldTypeString},
+			{Tag: tag.NoEvents, Name: "NoEvents", Type: FieldTypeNumInGroup, Fields: noEventsFields},
+			{Tag: tag.DatedDate, Name: "DatedDate", Type: FieldTypeString},
+			{Tag: tag.InterestAccrualDate, Name: "InterestAccrualDate", Type: FieldTypeString},
+			{Tag: tag.CPProgram, Name: "CPProgram", Type: FieldTypeString},
+			{Tag: tag.CPRegType, Name: "CPRegType", Type: FieldTypeString},
+			{Tag: tag.MarginRatio, Name: "MarginRatio", Type: FieldTypeDecimal},
+			{Tag: tag.AgreementDesc, Name: "AgreementDesc", Type: FieldTypeString},
+			{Tag: tag.AgreementID, Name: "AgreementID", Type: FieldTypeString},
+			{Tag: tag.AgreementDate, Name: "AgreementDate", Type: FieldTypeString},
+			{Tag: tag.StartDate, Name: "StartDate", Type: FieldTypeString},
+			{Tag: tag.EndDate, Name: "EndDate", Type: FieldTypeString},
+			{Tag: tag.AgreementCurrency, Name: "AgreementCurrency", Type: FieldTypeString},
+			{Tag: tag.DeliveryType, Name: "DeliveryType", Type: FieldTypeString},
+			{Tag: tag.StrikeCurrency, Name: "StrikeCurrency", Type: FieldTypeString},
+		},
+	},
+	"Z": {
+		Name:    "QuoteCancel",
+		MsgType: "Z",
+		Fields: []FieldDef{
+			{Tag: tag.Account, Name: "Account", Type: FieldTypeString},
+			{Tag: tag.QuoteID, Name: "QuoteID", Type: FieldTypeString, Required: true},
+			{Tag: tag.QuoteReqID, Name: "QuoteReqID", Type: FieldTypeString},
+			{Tag: tag.NoQuoteEntries, Name: "NoQuoteEntries", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.Symbol, Name: "Symbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.SymbolSfx, Name: "SymbolSfx", Type: FieldTypeString},
+				{Tag: tag.SecurityID, Name: "SecurityID", Type: FieldTypeString},
+				{Tag: tag.SecurityIDSource, Name: "SecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoSecurityAltID, Name: "NoSecurityAltID", Type: FieldTypeNumInGroup, Fields: noSecurityAltIDFields},
+				{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+				{Tag: tag.CFICode, Name: "CFICode", Type: FieldTypeString},
+				{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+				{Tag: tag.SecuritySubType, Name: "SecuritySubType", Type: FieldTypeString},
+				{Tag: tag.MaturityMonthYear, Name: "MaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.MaturityDate, Name: "MaturityDate", Type: FieldTypeString},
+				{Tag: tag.CouponPaymentDate, Name: "CouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.IssueDate, Name: "IssueDate", Type: FieldTypeString},
+				{Tag: tag.RepoCollateralSecurityType, Name: "RepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.RepurchaseTerm, Name: "RepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.RepurchaseRate, Name: "RepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.Factor, Name: "Factor", Type: FieldTypeDecimal},
+				{Tag: tag.CreditRating, Name: "CreditRating", Type: FieldTypeString},
+				{Tag: tag.InstrRegistry, Name: "InstrRegistry", Type: FieldTypeString},
+				{Tag: tag.CountryOfIssue, Name: "CountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.StateOrProvinceOfIssue, Name: "StateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.LocaleOfIssue, Name: "LocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.RedemptionDate, Name: "RedemptionDate", Type: FieldTypeString},
+				{Tag: tag.StrikePrice, Name: "StrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.StrikeCurrency, Name: "StrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.OptAttribute, Name: "OptAttribute", Type: FieldTypeString},
+				{Tag: tag.ContractMultiplier, Name: "ContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.CouponRate, Name: "CouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.SecurityExchange, Name: "SecurityExchange", Type: FieldTypeString},
+				{Tag: tag.Issuer, Name: "Issuer", Type: FieldTypeString},
+				{Tag: tag.EncodedIssuerLen, Name: "EncodedIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedIssuer, Name: "EncodedIssuer", Type: FieldTypeString},
+				{Tag: tag.SecurityDesc, Name: "SecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedSecurityDescLen, Name: "EncodedSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedSecurityDesc, Name: "EncodedSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.Pool, Name: "Pool", Type: FieldTypeString},
+				{Tag: tag.ContractSettlMonth, Name: "ContractSettlMonth", Type: FieldTypeString},
+				{Tag: tag.CPProgram, Name: "CPProgram", Type: FieldTypeString},
+				{Tag: tag.CPRegType, Name: "CPRegType", Type: FieldTypeString},
+				{Tag: tag.NoEvents, Name: "NoEvents", Type: FieldTypeNumInGroup, Fields: noEventsFields},
+				{Tag: tag.DatedDate, Name: "DatedDate", Type: FieldTypeString},
+				{Tag: tag.InterestAccrualDate, Name: "InterestAccrualDate", Type: FieldTypeString},
+				{Tag: tag.AgreementDesc, Name: "AgreementDesc", Type: FieldTypeString},
+				{Tag: tag.AgreementID, Name: "AgreementID", Type: FieldTypeString},
+				{Tag: tag.AgreementDate, Name: "AgreementDate", Type: FieldTypeString},
+				{Tag: tag.AgreementCurrency, Name: "AgreementCurrency", Type: FieldTypeString},
+				{Tag: tag.TerminationType, Name: "TerminationType", Type: FieldTypeString},
+				{Tag: tag.StartDate, Name: "StartDate", Type: FieldTypeString},
+				{Tag: tag.EndDate, Name: "EndDate", Type: FieldTypeString},
+				{Tag: tag.DeliveryType, Name: "DeliveryType", Type: FieldTypeString},
+				{Tag: tag.MarginRatio, Name: "MarginRatio", Type: FieldTypeDecimal},
+				{Tag: tag.NoUnderlyings, Name: "NoUnderlyings", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+					{Tag: tag.UnderlyingSymbol, Name: "UnderlyingSymbol", Type: FieldTypeString, Required: true},
+					{Tag: tag.UnderlyingSymbolSfx, Name: "UnderlyingSymbolSfx", Type: FieldTypeString},
+					{Tag: tag.UnderlyingSecurityID, Name: "UnderlyingSecurityID", Type: FieldTypeString},
+					{Tag: tag.UnderlyingSecurityIDSource, Name: "UnderlyingSecurityIDSource", Type: FieldTypeString},
+					{Tag: tag.NoUnderlyingSecurityAltID, Name: "NoUnderlyingSecurityAltID", Type: FieldTypeNumInGroup, Fields: noUnderlyingSecurityAltIDFields},
+					{Tag: tag.UnderlyingProduct, Name: "UnderlyingProduct", Type: FieldTypeInt},
+					{Tag: tag.UnderlyingCFICode, Name: "UnderlyingCFICode", Type: FieldTypeString},
+					{Tag: tag.UnderlyingSecurityType, Name: "UnderlyingSecurityType", Type: FieldTypeString},
+					{Tag: tag.UnderlyingSecuritySubType, Name: "UnderlyingSecuritySubType", Type: FieldTypeString},
+					{Tag: tag.UnderlyingMaturityMonthYear, Name: "UnderlyingMaturityMonthYear", Type: FieldTypeString},
+					{Tag: tag.UnderlyingMaturityDate, Name: "UnderlyingMaturityDate", Type: FieldTypeString},
+					{Tag: tag.UnderlyingCouponPaymentDate, Name: "UnderlyingCouponPaymentDate", Type: FieldTypeString},
+					{Tag: tag.UnderlyingIssueDate, Name: "UnderlyingIssueDate", Type: FieldTypeString},
+					{Tag: tag.UnderlyingRepoCollateralSecurityType, Name: "UnderlyingRepoCollateralSecurityType", Type: FieldTypeInt},
+					{Tag: tag.UnderlyingRepurchaseTerm, Name: "UnderlyingRepurchaseTerm", Type: FieldTypeInt},
+					{Tag: tag.UnderlyingRepurchaseRate, Name: "UnderlyingRepurchaseRate", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingFactor, Name: "UnderlyingFactor", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingCreditRating, Name: "UnderlyingCreditRating", Type: FieldTypeString},
+					{Tag: tag.UnderlyingInstrRegistry, Name: "UnderlyingInstrRegistry", Type: FieldTypeString},
+					{Tag: tag.UnderlyingCountryOfIssue, Name: "UnderlyingCountryOfIssue", Type: FieldTypeString},
+					{Tag: tag.UnderlyingStateOrProvinceOfIssue, Name: "UnderlyingStateOrProvinceOfIssue", Type: FieldTypeString},
+					{Tag: tag.UnderlyingLocaleOfIssue, Name: "UnderlyingLocaleOfIssue", Type: FieldTypeString},
+					{Tag: tag.UnderlyingRedemptionDate, Name: "UnderlyingRedemptionDate", Type: FieldTypeString},
+					{Tag: tag.UnderlyingStrikePrice, Name: "UnderlyingStrikePrice", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingStrikeCurrency, Name: "UnderlyingStrikeCurrency", Type: FieldTypeString},
+					{Tag: tag.UnderlyingOptAttribute, Name: "UnderlyingOptAttribute", Type: FieldTypeString},
+					{Tag: tag.UnderlyingContractMultiplier, Name: "UnderlyingContractMultiplier", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingCouponRate, Name: "UnderlyingCouponRate", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingSecurityExchange, Name: "UnderlyingSecurityExchange", Type: FieldTypeString},
+					{Tag: tag.UnderlyingIssuer, Name: "UnderlyingIssuer", Type: FieldTypeString},
+					{Tag: tag.EncodedUnderlyingIssuerLen, Name: "EncodedUnderlyingIssuerLen", Type: FieldTypeInt},
+					{Tag: tag.EncodedUnderlyingIssuer, Name: "EncodedUnderlyingIssuer", Type: FieldTypeString},
+					{Tag: tag.UnderlyingSecurityDesc, Name: "UnderlyingSecurityDesc", Type: FieldTypeString},
+					{Tag: tag.EncodedUnderlyingSecurityDescLen, Name: "EncodedUnderlyingSecurityDescLen", Type: FieldTypeInt},
+					{Tag: tag.EncodedUnderlyingSecurityDesc, Name: "EncodedUnderlyingSecurityDesc", Type: FieldTypeString},
+					{Tag: tag.UnderlyingCPProgram, Name: "UnderlyingCPProgram", Type: FieldTypeString},
+					{Tag: tag.UnderlyingCPRegType, Name: "UnderlyingCPRegType", Type: FieldTypeString},
+					{Tag: tag.UnderlyingCurrency, Name: "UnderlyingCurrency", Type: FieldTypeString},
+					{Tag: tag.UnderlyingQty, Name: "UnderlyingQty", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingPx, Name: "UnderlyingPx", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingDirtyPrice, Name: "UnderlyingDirtyPrice", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingEndPrice, Name: "UnderlyingEndPrice", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingStartValue, Name: "UnderlyingStartValue", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingCurrentValue, Name: "UnderlyingCurrentValue", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingEndValue, Name: "UnderlyingEndValue", Type: FieldTypeDecimal},
+					{Tag: tag.NoUnderlyingStips, Name: "NoUnderlyingStips", Type: FieldTypeNumInGroup, Fields: noUnderlyingStipsFields},
+				}},
+				{Tag: tag.NoLegs, Name: "NoLegs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+					{Tag: tag.LegSymbol, Name: "LegSymbol", Type: FieldTypeString, Required: true},
+					{Tag: tag.LegSymbolSfx, Name: "LegSymbolSfx", Type: FieldTypeString},
+					{Tag: tag.LegSecurityID, Name: "LegSecurityID", Type: FieldTypeString},
+					{Tag: tag.LegSecurityIDSource, Name: "LegSecurityIDSource", Type: FieldTypeString},
+					{Tag: tag.NoLegSecurityAltID, Name: "NoLegSecurityAltID", Type: FieldTypeNumInGroup, Fields: noLegSecurityAltIDFields},
+					{Tag: tag.LegProduct, Name: "LegProduct", Type: FieldTypeInt},
+					{Tag: tag.LegCFICode, Name: "LegCFICode", Type: FieldTypeString},
+					{Tag: tag.LegSecurityType, Name: "LegSecurityType", Type: FieldTypeString},
+					{Tag: tag.LegSecuritySubType, Name: "LegSecuritySubType", Type: FieldTypeString},
+					{Tag: tag.LegMaturityMonthYear, Name: "LegMaturityMonthYear", Type: FieldTypeString},
+					{Tag: tag.LegMaturityDate, Name: "LegMaturityDate", Type: FieldTypeString},
+					{Tag: tag.LegCouponPaymentDate, Name: "LegCouponPaymentDate", Type: FieldTypeString},
+					{Tag: tag.LegIssueDate, Name: "LegIssueDate", Type: FieldTypeString},
+					{Tag: tag.LegRepoCollateralSecurityType, Name: "LegRepoCollateralSecurityType", Type: FieldTypeInt},
+					{Tag: tag.LegRepurchaseTerm, Name: "LegRepurchaseTerm", Type: FieldTypeInt},
+					{Tag: tag.LegRepurchaseRate, Name: "LegRepurchaseRate", Type: FieldTypeDecimal},
+					{Tag: tag.LegFactor, Name: "LegFactor", Type: FieldTypeDecimal},
+					{Tag: tag.LegCreditRating, Name: "LegCreditRating", Type: FieldTypeString},
+					{Tag: tag.LegInstrRegistry, Name: "LegInstrRegistry", Type: FieldTypeString},
+					{Tag: tag.LegCountryOfIssue, Name: "LegCountryOfIssue", Type: FieldTypeString},
+					{Tag: tag.LegStateOrProvinceOfIssue, Name: "LegStateOrProvinceOfIssue", Type: FieldTypeString},
+					{Tag: tag.LegLocaleOfIssue, Name: "LegLocaleOfIssue", Type: FieldTypeString},
+					{Tag: tag.LegRedemptionDate, Name: "LegRedemptionDate", Type: FieldTypeString},
+					{Tag: tag.LegStrikePrice, Name: "LegStrikePrice", Type: FieldTypeDecimal},
+					{Tag: tag.LegStrikeCurrency, Name: "LegStrikeCurrency", Type: FieldTypeString},
+					{Tag: tag.LegOptAttribute, Name: "LegOptAttribute", Type: FieldTypeString},
+					{Tag: tag.LegContractMultiplier, Name: "LegContractMultiplier", Type: FieldTypeDecimal},
+					{Tag: tag.LegCouponRate, Name: "LegCouponRate", Type: FieldTypeDecimal},
+					{Tag: tag.LegSecurityExchange, Name: "LegSecurityExchange", Type: FieldTypeString},
+					{Tag: tag.LegIssuer, Name: "LegIssuer", Type: FieldTypeString},
+					{Tag: tag.EncodedLegIssuerLen, Name: "EncodedLegIssuerLen", Type: FieldTypeInt},
+					{Tag: tag.EncodedLegIssuer, Name: "EncodedLegIssuer", Type: FieldTypeString},
+					{Tag: tag.LegSecurityDesc, Name: "LegSecurityDesc", Type: FieldTypeString},
+					{Tag: tag.EncodedLegSecurityDescLen, Name: "EncodedLegSecurityDescLen", Type: FieldTypeInt},
+					{Tag: tag.EncodedLegSecurityDesc, Name: "EncodedLegSecurityDesc", Type: FieldTypeString},
+					{Tag: tag.LegRatioQty, Name: "LegRatioQty", Type: FieldTypeDecimal},
+					{Tag: tag.LegSide, Name: "LegSide", Type: FieldTypeString},
+					{Tag: tag.LegCurrency, Name: "LegCurrency", Type: FieldTypeString},
+					{Tag: tag.LegPool, Name: "LegPool", Type: FieldTypeString},
+					{Tag: tag.LegDatedDate, Name: "LegDatedDate", Type: FieldTypeString},
+					{Tag: tag.LegContractSettlMonth, Name: "LegContractSettlMonth", Type: FieldTypeString},
+					{Tag: tag.LegInterestAccrualDate, Name: "LegInterestAccrualDate", Type: FieldTypeString},
+				}},
+			}},
+			{Tag: tag.QuoteCancelType, Name: "QuoteCancelType", Type: FieldTypeString, Required: true},
+			{Tag: tag.QuoteResponseLevel, Name: "QuoteResponseLevel", Type: FieldTypeString},
+			{Tag: tag.TradingSessionID, Name: "TradingSessionID", Type: FieldTypeString},
+			{Tag: tag.NoPartyIDs, Name: "NoPartyIDs", Type: FieldTypeNumInGroup, Fields: noPartyIDsFields},
+			{Tag: tag.AccountType, Name: "AccountType", Type: FieldTypeString},
+			{Tag: tag.TradingSessionSubID, Name: "TradingSessionSubID", Type: FieldTypeString},
+			{Tag: tag.AcctIDSource, Name: "AcctIDSource", Type: FieldTypeString},
+		},
+	},
+	"R": {
+		Name:    "QuoteRequest",
+		MsgType: "R",
+		Fields: []FieldDef{
+			{Tag: tag.ClOrdID, Name: "ClOrdID", Type: FieldTypeString},
+			{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+			{Tag: tag.QuoteReqID, Name: "QuoteReqID", Type: FieldTypeString, Required: true},
+			{Tag: tag.NoRelatedSym, Name: "NoRelatedSym", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.Symbol, Name: "Symbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.SymbolSfx, Name: "SymbolSfx", Type: FieldTypeString},
+				{Tag: tag.SecurityID, Name: "SecurityID", Type: FieldTypeString},
+				{Tag: tag.SecurityIDSource, Name: "SecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoSecurityAltID, Name: "NoSecurityAltID", Type: FieldTypeNumInGroup, Fields: noSecurityAltIDFields},
+				{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+				{Tag: tag.CFICode, Name: "CFICode", Type: FieldTypeString},
+				{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+				{Tag: tag.SecuritySubType, Name: "SecuritySubType", Type: FieldTypeString},
+				{Tag: tag.MaturityMonthYear, Name: "MaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.MaturityDate, Name: "MaturityDate", Type: FieldTypeString},
+				{Tag: tag.CouponPaymentDate, Name: "CouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.IssueDate, Name: "IssueDate", Type: FieldTypeString},
+				{Tag: tag.RepoCollateralSecurityType, Name: "RepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.RepurchaseTerm, Name: "RepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.RepurchaseRate, Name: "RepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.Factor, Name: "Factor", Type: FieldTypeDecimal},
+				{Tag: tag.CreditRating, Name: "CreditRating", Type: FieldTypeString},
+				{Tag: tag.InstrRegistry, Name: "InstrRegistry", Type: FieldTypeString},
+				{Tag: tag.CountryOfIssue, Name: "CountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.StateOrProvinceOfIssue, Name: "StateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.LocaleOfIssue, Name: "LocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.RedemptionDate, Name: "RedemptionDate", Type: FieldTypeString},
+				{Tag: tag.StrikePrice, Name: "StrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.StrikeCurrency, Name: "StrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.OptAttribute, Name: "OptAttribute", Type: FieldTypeString},
+				{Tag: tag.ContractMultiplier, Name: "ContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.CouponRate, Name: "CouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.SecurityExchange, Name: "SecurityExchange", Type: FieldTypeString},
+				{Tag: tag.Issuer, Name: "Issuer", Type: FieldTypeString},
+				{Tag: tag.EncodedIssuerLen, Name: "EncodedIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedIssuer, Name: "EncodedIssuer", Type: FieldTypeString},
+				{Tag: tag.SecurityDesc, Name: "SecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedSecurityDescLen, Name: "EncodedSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedSecurityDesc, Name: "EncodedSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.Pool, Name: "Pool", Type: FieldTypeString},
+				{Tag: tag.ContractSettlMonth, Name: "ContractSettlMonth", Type: FieldTypeString},
+				{Tag: tag.CPProgram, Name: "CPProgram", Type: FieldTypeString},
+				{Tag: tag.CPRegType, Name: "CPRegType", Type: FieldTypeString},
+				{Tag: tag.NoEvents, Name: "NoEvents", Type: FieldTypeNumInGroup, Fields: noEventsFields},
+				{Tag: tag.DatedDate, Name: "DatedDate", Type: FieldTypeString},
+				{Tag: tag.InterestAccrualDate, Name: "InterestAccrualDate", Type: FieldTypeString},
+				{Tag: tag.AgreementDesc, Name: "AgreementDesc", Type: FieldTypeString},
+				{Tag: tag.AgreementID, Name: "AgreementID", Type: FieldTypeString},
+				{Tag: tag.AgreementDate, Name: "AgreementDate", Type: FieldTypeString},
+				{Tag: tag.AgreementCurrency, Name: "AgreementCurrency", Type: FieldTypeString},
+				{Tag: tag.TerminationType, Name: "TerminationType", Type: FieldTypeString},
+				{Tag: tag.StartDate, Name: "StartDate", Type: FieldTypeString},
+				{Tag: tag.EndDate, Name: "EndDate", Type: FieldTypeString},
+				{Tag: tag.DeliveryType, Name: "DeliveryType", Type: FieldTypeString},
+				{Tag: tag.MarginRatio, Name: "MarginRatio", Type: FieldTypeDecimal},
+				{Tag: tag.NoUnderlyings, Name: "NoUnderlyings", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+					{Tag: tag.UnderlyingSymbol, Name: "UnderlyingSymbol", Type: FieldTypeString, Required: true},
+					{Tag: tag.UnderlyingSymbolSfx, Name: "UnderlyingSymbolSfx", Type: FieldTypeString},
+					{Tag: tag.UnderlyingSecurityID, Name: "UnderlyingSecurityID", Type: FieldTypeString},
+					{Tag: tag.UnderlyingSecurityIDSource, Name: "UnderlyingSecurityIDSource", Type: FieldTypeString},
+					{Tag: tag.NoUnderlyingSecurityAltID, Name: "NoUnderlyingSecurityAltID", Type: FieldTypeNumInGroup, Fields: noUnderlyingSecurityAltIDFields},
+					{Tag: tag.UnderlyingProduct, Name: "UnderlyingProduct", Type: FieldTypeInt},
+					{Tag: tag.UnderlyingCFICode, Name: "UnderlyingCFICode", Type: FieldTypeString},
+					{Tag: tag.UnderlyingSecurityType, Name: "UnderlyingSecurityType", Type: FieldTypeString},
+					{Tag: tag.UnderlyingSecuritySubType, Name: "UnderlyingSecuritySubType", Type: FieldTypeString},
+					{Tag: tag.UnderlyingMaturityMonthYear, Name: "UnderlyingMaturityMonthYear", Type: FieldTypeString},
+					{Tag: tag.UnderlyingMaturityDate, Name: "UnderlyingMaturityDate", Type: FieldTypeString},
+					{Tag: tag.UnderlyingCouponPaymentDate, Name: "UnderlyingCouponPaymentDate", Type: FieldTypeString},
+					{Tag: tag.UnderlyingIssueDate, Name: "UnderlyingIssueDate", Type: FieldTypeString},
+					{Tag: tag.UnderlyingRepoCollateralSecurityType, Name: "UnderlyingRepoCollateralSecurityType", Type: FieldTypeInt},
+					{Tag: tag.UnderlyingRepurchaseTerm, Name: "UnderlyingRepurchaseTerm", Type: FieldTypeInt},
+					{Tag: tag.UnderlyingRepurchaseRate, Name: "UnderlyingRepurchaseRate", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingFactor, Name: "UnderlyingFactor", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingCreditRating, Name: "UnderlyingCreditRating", Type: FieldTypeString},
+					{Tag: tag.UnderlyingInstrRegistry, Name: "UnderlyingInstrRegistry", Type: FieldTypeString},
+					{Tag: tag.UnderlyingCountryOfIssue, Name: "UnderlyingCountryOfIssue", Type: FieldTypeString},
+					{Tag: tag.UnderlyingStateOrProvinceOfIssue, Name: "UnderlyingStateOrProvinceOfIssue", Type: FieldTypeString},
+					{Tag: tag.UnderlyingLocaleOfIssue, Name: "UnderlyingLocaleOfIssue", Type: FieldTypeString},
+					{Tag: tag.UnderlyingRedemptionDate, Name: "UnderlyingRedemptionDate", Type: FieldTypeString},
+					{Tag: tag.UnderlyingStrikePrice, Name: "UnderlyingStrikePrice", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingStrikeCurrency, Name: "UnderlyingStrikeCurrency", Type: FieldTypeString},
+					{Tag: tag.UnderlyingOptAttribute, Name: "UnderlyingOptAttribute", Type: FieldTypeString},
+					{Tag: tag.UnderlyingContractMultiplier, Name: "UnderlyingContractMultiplier", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingCouponRate, Name: "UnderlyingCouponRate", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingSecurityExchange, Name: "UnderlyingSecurityExchange", Type: FieldTypeString},
+					{Tag: tag.UnderlyingIssuer, Name: "UnderlyingIssuer", Type: FieldTypeString},
+					{Tag: tag.EncodedUnderlyingIssuerLen, Name: "EncodedUnderlyingIssuerLen", Type: FieldTypeInt},
+					{Tag: tag.EncodedUnderlyingIssuer, Name: "EncodedUnderlyingIssuer", Type: FieldTypeString},
+					{Tag: tag.UnderlyingSecurityDesc, Name: "UnderlyingSecurityDesc", Type: FieldTypeString},
+					{Tag: tag.EncodedUnderlyingSecurityDescLen, Name: "EncodedUnderlyingSecurityDescLen", Type: FieldTypeInt},
+					{Tag: tag.EncodedUnderlyingSecurityDesc, Name: "EncodedUnderlyingSecurityDesc", Type: FieldTypeString},
+					{Tag: tag.UnderlyingCPProgram, Name: "UnderlyingCPProgram", Type: FieldTypeString},
+					{Tag: tag.UnderlyingCPRegType, Name: "UnderlyingCPRegType", Type: FieldTypeString},
+					{Tag: tag.UnderlyingCurrency, Name: "UnderlyingCurrency", Type: FieldTypeString},
+					{Tag: tag.UnderlyingQty, Name: "UnderlyingQty", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingPx, Name: "UnderlyingPx", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingDirtyPrice, Name: "UnderlyingDirtyPrice", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingEndPrice, Name: "UnderlyingEndPrice", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingStartValue, Name: "UnderlyingStartValue", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingCurrentValue, Name: "UnderlyingCurrentValue", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingEndValue, Name: "UnderlyingEndValue", Type: FieldTypeDecimal},
+					{Tag: tag.NoUnderlyingStips, Name: "NoUnderlyingStips", Type: FieldTypeNumInGroup, Fields: noUnderlyingStipsFields},
+				}},
+				{Tag: tag.PrevClosePx, Name: "PrevClosePx", Type: FieldTypeDecimal},
+				{Tag: tag.QuoteRequestType, Name: "QuoteRequestType", Type: FieldTypeString},
+				{Tag: tag.QuoteType, Name: "QuoteType", Type: FieldTypeString},
+				{Tag: tag.TradingSessionID, Name: "TradingSessionID", Type: FieldTypeString},
+				{Tag: tag.TradingSessionSubID, Name: "TradingSessionSubID", Type: FieldTypeString},
+				{Tag: tag.TradeOriginationDate, Name: "TradeOriginationDate", Type: FieldTypeString},
+				{Tag: tag.Side, Name: "Side", Type: FieldTypeString},
+				{Tag: tag.QtyType, Name: "QtyType", Type: FieldTypeString},
+				{Tag: tag.OrderQty, Name: "OrderQty", Type: FieldTypeDecimal},
+				{Tag: tag.CashOrderQty, Name: "CashOrderQty", Type: FieldTypeDecimal},
+				{Tag: tag.OrderPercent, Name: "OrderPercent", Type: FieldTypeDecimal},
+				{Tag: tag.RoundingDirection, Name: "RoundingDirection", Type: FieldTypeString},
+				{Tag: tag.RoundingModulus, Name: "RoundingModulus", Type: FieldTypeDecimal},
+				{Tag: tag.SettlType, Name: "SettlType", Type: FieldTypeString},
+				{Tag: tag.SettlDate, Name: "SettlDate", Type: FieldTypeString},
+				{Tag: tag.SettlDate2, Name: "SettlDate2", Type: FieldTypeString},
+				{Tag: tag.OrderQty2, Name: "OrderQty2", Type: FieldTypeDecimal},
+				{Tag: tag.Currency, Name: "Currency", Type: FieldTypeString},
+				{Tag: tag.NoStipulations, Name: "NoStipulations", Type: FieldTypeNumInGroup, Fields: noStipulationsFields},
+				{Tag: tag.Account, Name: "Account", Type: FieldTypeString},
+				{Tag: tag.AcctIDSource, Name: "AcctIDSource", Type: FieldTypeString},
+				{Tag: tag.AccountType, Name: "AccountType", Type: FieldTypeString},
+				{Tag: tag.NoLegs, Name: "NoLegs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+					{Tag: tag.LegSymbol, Name: "LegSymbol", Type: FieldTypeString, Required: true},
+					{Tag: tag.LegSymbolSfx, Name: "LegSymbolSfx", Type: FieldTypeString},
+					{Tag: tag.LegSecurityID, Name: "LegSecurityID", Type: FieldTypeString},
+					{Tag: tag.LegSecurityIDSource, Name: "LegSecurityIDSource", Type: FieldTypeString},
+					{Tag: tag.NoLegSecurityAltID, Name: "NoLegSecurityAltID", Type: FieldTypeNumInGroup, Fields: noLegSecurityAltIDFields},
+					{Tag: tag.LegProduct, Name: "LegProduct", Type: FieldTypeInt},
+					{Tag: tag.LegCFICode, Name: "LegCFICode", Type: FieldTypeString},
+					{Tag: tag.LegSecurityType, Name: "LegSecurityType", Type: FieldTypeString},
+					{Tag: tag.LegSecuritySubType, Name: "LegSecuritySubType", Type: FieldTypeString},
+					{Tag: tag.LegMaturityMonthYear, Name: "LegMaturityMonthYear", Type: FieldTypeString},
+					{Tag: tag.LegMaturityDate, Name: "LegMaturityDate", Type: FieldTypeString},
+					{Tag: tag.LegCouponPaymentDate, Name: "LegCouponPaymentDate", Type: FieldTypeString},
+					{Tag: tag.LegIssueDate, Name: "LegIssueDate", Type: FieldTypeString},
+					{Tag: tag.LegRepoCollateralSecurityType, Name: "LegRepoCollateralSecurityType", Type: FieldTypeInt},
+					{Tag: tag.LegRepurchaseTerm, Name: "LegRepurchaseTerm", Type: FieldTypeInt},
+					{Tag: tag.LegRepurchaseRate, Name: "LegRepurchaseRate", Type: FieldTypeDecimal},
+					{Tag: tag.LegFactor, Name: "LegFactor", Type: FieldTypeDecimal},
+					{Tag: tag.LegCreditRating, Name: "LegCreditRating", Type: FieldTypeString},
+					{Tag: tag.LegInstrRegistry, Name: "LegInstrRegistry", Type: FieldTypeString},
+					{Tag: tag.LegCountryOfIssue, Name: "LegCountryOfIssue", Type: FieldTypeString},
+					{Tag: tag.LegStateOrProvinceOfIssue, Name: "LegStateOrProvinceOfIssue", Type: FieldTypeString},
+					{Tag: tag.LegLocaleOfIssue, Name: "LegLocaleOfIssue", Type: FieldTypeString},
+					{Tag: tag.LegRedemptionDate, Name: "LegRedemptionDate", Type: FieldTypeString},
+					{Tag: tag.LegStrikePrice, Name: "LegStrikePrice", Type: FieldTypeDecimal},
+					{Tag: tag.LegStrikeCurrency, Name: "LegStrikeCurrency", Type: FieldTypeString},
+					{Tag: tag.LegOptAttribute, Name: "LegOptAttribute", Type: FieldTypeString},
+					{Tag: tag.LegContractMultiplier, Name: "LegContractMultiplier", Type: FieldTypeDecimal},
+					{Tag: tag.LegCouponRate, Name: "LegCouponRate", Type: FieldTypeDecimal},
+					{Tag: tag.LegSecurityExchange, Name: "LegSecurityExchange", Type: FieldTypeString},
+					{Tag: tag.LegIssuer, Name: "LegIssuer", Type: FieldTypeString},
+					{Tag: tag.EncodedLegIssuerLen, Name: "EncodedLegIssuerLen", Type: FieldTypeInt},
+					{Tag: tag.EncodedLegIssuer, Name: "EncodedLegIssuer", Type: FieldTypeString},
+					{Tag: tag.LegSecurityDesc, Name: "LegSecurityDesc", Type: FieldTypeString},
+					{Tag: tag.EncodedLegSecurityDescLen, Name: "EncodedLegSecurityDescLen", Type: FieldTypeInt},
+					{Tag: tag.EncodedLegSecurityDesc, Name: "EncodedLegSecurityDesc", Type: FieldTypeString},
+					{Tag: tag.LegRatioQty, Name: "LegRatioQty", Type: FieldTypeDecimal},
+					{Tag: tag.LegSide, Name: "LegSide", Type: FieldTypeString},
+					{Tag: tag.LegCurrency, Name: "LegCurrency", Type: FieldTypeString},
+					{Tag: tag.LegPool, Name: "LegPool", Type: FieldTypeString},
+					{Tag: tag.LegDatedDate, Name: "LegDatedDate", Type: FieldTypeString},
+					{Tag: tag.LegContractSettlMonth, Name: "LegContractSettlMonth", Type: FieldTypeString},
+					{Tag: tag.LegInterestAccrualDate, Name: "LegInterestAccrualDate", Type: FieldTypeString},
+					{Tag: tag.LegQty, Name: "LegQty", Type: FieldTypeDecimal},
+					{Tag: tag.LegSwapType, Name: "LegSwapType", Type: FieldTypeString},
+					{Tag: tag.LegSettlType, Name: "LegSettlType", Type: FieldTypeString},
+					{Tag: tag.LegSettlDate, Name: "LegSettlDate", Type: FieldTypeString},
+					{Tag: tag.NoLegStipulations, Name: "NoLegStipulations", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+						{Tag: tag.LegStipulationType, Name: "LegStipulationType", Type: FieldTypeString, Required: true},
+						{Tag: tag.LegStipulationValue, Name: "LegStipulationValue", Type: FieldTypeString},
+					}},
+					{Tag: tag.NoNestedPartyIDs, Name: "NoNestedPartyIDs", Type: FieldTypeNumInGroup, Fields: noNestedPartyIDsFields},
+					{Tag: tag.LegBenchmarkCurveCurrency, Name: "LegBenchmarkCurveCurrency", Type: FieldTypeString},
+					{Tag: tag.LegBenchmarkCurveName, Name: "LegBenchmarkCurveName", Type: FieldTypeString},
+					{Tag: tag.LegBenchmarkCurvePoint, Name: "LegBenchmarkCurvePoint", Type: FieldTypeString},
+					{Tag: tag.LegBenchmarkPrice, Name: "LegBenchmarkPrice", Type: FieldTypeDecimal},
+					{Tag: tag.LegBenchmarkPriceType, Name: "LegBenchmarkPriceType", Type: FieldTypeInt},
+				}},
+				{Tag: tag.NoQuoteQualifiers, Name: "NoQuoteQualifiers", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+					{Tag: tag.QuoteQualifier, Name: "QuoteQualifier", Type: FieldTypeString, Required: true},
+				}},
+				{Tag: tag.QuotePriceType, Name: "QuotePriceType", Type: FieldTypeString},
+				{Tag: tag.OrdType, Name: "OrdType", Type: FieldTypeString},
+				{Tag: tag.ValidUntilTime, Name: "ValidUntilTime", Type: FieldTypeUTCTimestamp},
+				{Tag: tag.ExpireTime, Name: "ExpireTime", Type: FieldTypeUTCTimestamp},
+				{Tag: tag.TransactTime, Name: "TransactTime", Type: FieldTypeUTCTimestamp},
+				{Tag: tag.Spread, Name: "Spread", Type: FieldTypeDecimal},
+				{Tag: tag.BenchmarkCurveCurrency, Name: "BenchmarkCurveCurrency", Type: FieldTypeString},
+				{Tag: tag.BenchmarkCurveName, Name: "BenchmarkCurveName", Type: FieldTypeString},
+				{Tag: tag.BenchmarkCurvePoint, Name: "BenchmarkCurvePoint", Type: FieldTypeString},
+				{Tag: tag.BenchmarkPrice, Name: "BenchmarkPrice", Type: FieldTypeDecimal},
+				{Tag: tag.BenchmarkPriceType, Name: "BenchmarkPriceType", Type: FieldTypeInt},
+				{Tag: tag.BenchmarkSecurityID, Name: "BenchmarkSecurityID", Type: FieldTypeString},
+				{Tag: tag.BenchmarkSecurityIDSource, Name: "BenchmarkSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.PriceType, Name: "PriceType", Type: FieldTypeString},
+				{Tag: tag.Price, Name: "Price", Type: FieldTypeDecimal},
+				{Tag: tag.Price2, Name: "Price2", Type: FieldTypeDecimal},
+				{Tag: tag.YieldType, Name: "YieldType", Type: FieldTypeString},
+				{Tag: tag.Yield, Name: "Yield", Type: FieldTypeDecimal},
+				{Tag: tag.YieldCalcDate, Name: "YieldCalcDate", Type: FieldTypeString},
+				{Tag: tag.YieldRedemptionDate, Name: "YieldRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.YieldRedemptionPrice, Name: "YieldRedemptionPrice", Type: FieldTypeDecimal},
+				{Tag: tag.YieldRedemptionPriceType, Name: "YieldRedemptionPriceType", Type: FieldTypeInt},
+				{Tag: tag.NoPartyIDs, Name: "NoPartyIDs", Type: FieldTypeNumInGroup, Fields: noPartyIDsFields},
+			}},
+			{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			{Tag: tag.OrderCapacity, Name: "OrderCapacity", Type: FieldTypeString},
+			{Tag: tag.RFQReqID, Name: "RFQReqID", Type: FieldTypeString},
+		},
+	},
+	"AG": {
+		Name:    "QuoteRequestReject",
+		MsgType: "AG",
+		Fields: []FieldDef{
+			{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+			{Tag: tag.QuoteReqID, Name: "QuoteReqID", Type: FieldTypeString, Required: true},
+			{Tag: tag.NoRelatedSym, Name: "NoRelatedSym", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.Symbol, Name: "Symbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.SymbolSfx, Name: "SymbolSfx", Type: FieldTypeString},
+				{Tag: tag.SecurityID, Name: "SecurityID", Type: FieldTypeString},
+				{Tag: tag.SecurityIDSource, Name: "SecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoSecurityAltID, Name: "NoSecurityAltID", Type: FieldTypeNumInGroup, Fields: noSecurityAltIDFields},
+				{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+				{Tag: tag.CFICode, Name: "CFICode", Type: FieldTypeString},
+				{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+				{Tag: tag.SecuritySubType, Name: "SecuritySubType", Type: FieldTypeString},
+				{Tag: tag.MaturityMonthYear, Name: "MaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.MaturityDate, Name: "MaturityDate", Type: FieldTypeString},
+				{Tag: tag.CouponPaymentDate, Name: "CouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.IssueDate, Name: "IssueDate", Type: FieldTypeString},
+				{Tag: tag.RepoCollateralSecurityType, Name: "RepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.RepurchaseTerm, Name: "RepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.RepurchaseRate, Name: "RepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.Factor, Name: "Factor", Type: FieldTypeDecimal},
+				{Tag: tag.CreditRating, Name: "CreditRating", Type: FieldTypeString},
+				{Tag: tag.InstrRegistry, Name: "InstrRegistry", Type: FieldTypeString},
+				{Tag: tag.CountryOfIssue, Name: "CountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.StateOrProvinceOfIssue, Name: "StateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.LocaleOfIssue, Name: "LocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.RedemptionDate, Name: "RedemptionDate", Type: FieldTypeString},
+				{Tag: tag.StrikePrice, Name: "StrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.StrikeCurrency, Name: "StrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.OptAttribute, Name: "OptAttribute", Type: FieldTypeString},
+				{Tag: tag.ContractMultiplier, Name: "ContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.CouponRate, Name: "CouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.SecurityExchange, Name: "SecurityExchange", Type: FieldTypeString},
+				{Tag: tag.Issuer, Name: "Issuer", Type: FieldTypeString},
+				{Tag: tag.EncodedIssuerLen, Name: "EncodedIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedIssuer, Name: "EncodedIssuer", Type: FieldTypeString},
+				{Tag: tag.SecurityDesc, Name: "SecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedSecurityDescLen, Name: "EncodedSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedSecurityDesc, Name: "EncodedSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.Pool, Name: "Pool", Type: FieldTypeString},
+				{Tag: tag.ContractSettlMonth, Name: "ContractSettlMonth", Type: FieldTypeString},
+				{Tag: tag.CPProgram, Name: "CPProgram", Type: FieldTypeString},
+				{Tag: tag.CPRegType, Name: "CPRegType", Type: FieldTypeString},
+				{Tag: tag.NoEvents, Name: "NoEvents", Type: FieldTypeNumInGroup, Fields: noEventsFields},
+				{Tag: tag.DatedDate, Name: "DatedDate", Type: FieldTypeString},
+				{Tag: tag.InterestAccrualDate, Name: "InterestAccrualDate", Type: FieldTypeString},
+				{Tag: tag.AgreementDesc, Name: "AgreementDesc", Type: FieldTypeString},
+				{Tag: tag.AgreementID, Name: "AgreementID", Type: FieldTypeString},
+				{Tag: tag.AgreementDate, Name: "AgreementDate", Type: FieldTypeString},
+				{Tag: tag.AgreementCurrency, Name: "AgreementCurrency", Type: FieldTypeString},
+				{Tag: tag.TerminationType, Name: "TerminationType", Type: FieldTypeString},
+				{Tag: tag.StartDate, Name: "StartDate", Type: FieldTypeString},
+				{Tag: tag.EndDate, Name: "EndDate", Type: FieldTypeString},
+				{Tag: tag.DeliveryType, Name: "DeliveryType", Type: FieldTypeString},
+				{Tag: tag.MarginRatio, Name: "MarginRatio", Type: FieldTypeDecimal},
+				{Tag: tag.NoUnderlyings, Name: "NoUnderlyings", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+					{Tag: tag.UnderlyingSymbol, Name: "UnderlyingSymbol", Type: FieldTypeString, Required: true},
+					{Tag: tag.UnderlyingSymbolSfx, Name: "UnderlyingSymbolSfx", Type: FieldTypeString},
+					{Tag: tag.UnderlyingSecurityID, Name: "UnderlyingSecurityID", Type: FieldTypeString},
+					{Tag: tag.UnderlyingSecurityIDSource, Name: "UnderlyingSecurityIDSource", Type: FieldTypeString},
+					{Tag: tag.NoUnderlyingSecurityAltID, Name: "NoUnderlyingSecurityAltID", Type: FieldTypeNumInGroup, Fields: noUnderlyingSecurityAltIDFields},
+					{Tag: tag.UnderlyingProduct, Name: "UnderlyingProduct", Type: FieldTypeInt},
+					{Tag: tag.UnderlyingCFICode, Name: "UnderlyingCFICode", Type: FieldTypeString},
+					{Tag: tag.UnderlyingSecurityType, Name: "UnderlyingSecurityType", Type: FieldTypeString},
+					{Tag: tag.UnderlyingSecuritySubType, Name: "UnderlyingSecuritySubType", Type: FieldTypeString},
+					{Tag: tag.UnderlyingMaturityMonthYear, Name: "UnderlyingMaturityMonthYear", Type: FieldTypeString},
+					{Tag: tag.UnderlyingMaturityDate, Name: "UnderlyingMaturityDate", Type: FieldTypeString},
+					{Tag: tag.UnderlyingCouponPaymentDate, Name: "UnderlyingCouponPaymentDate", Type: FieldTypeString},
+					{Tag: tag.UnderlyingIssueDate, Name: "UnderlyingIssueDate", Type: FieldTypeString},
+					{Tag: tag.UnderlyingRepoCollateralSecurityType, Name: "UnderlyingRepoCollateralSecurityType", Type: FieldTypeInt},
+					{Tag: tag.UnderlyingRepurchaseTerm, Name: "UnderlyingRepurchaseTerm", Type: FieldTypeInt},
+					{Tag: tag.UnderlyingRepurchaseRate, Name: "UnderlyingRepurchaseRate", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingFactor, Name: "UnderlyingFactor", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingCreditRating, Name: "UnderlyingCreditRating", Type: FieldTypeString},
+					{Tag: tag.UnderlyingInstrRegistry, Name: "UnderlyingInstrRegistry", Type: FieldTypeString},
+					{Tag: tag.UnderlyingCountryOfIssue, Name: "UnderlyingCountryOfIssue", Type: FieldTypeString},
+					{Tag: tag.UnderlyingStateOrProvinceOfIssue, Name: "UnderlyingStateOrProvinceOfIssue", Type: FieldTypeString},
+					{Tag: tag.UnderlyingLocaleOfIssue, Name: "UnderlyingLocaleOfIssue", Type: FieldTypeString},
+					{Tag: tag.UnderlyingRedemptionDate, Name: "UnderlyingRedemptionDate", Type: FieldTypeString},
+					{Tag: tag.UnderlyingStrikePrice, Name: "UnderlyingStrikePrice", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingStrikeCurrency, Name: "UnderlyingStrikeCurrency", Type: FieldTypeString},
+					{Tag: tag.UnderlyingOptAttribute, Name: "UnderlyingOptAttribute", Type: FieldTypeString},
+					{Tag: tag.UnderlyingContractMultiplier, Name: "UnderlyingContractMultiplier", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingCouponRate, Name: "UnderlyingCouponRate", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingSecurityExchange, Name: "UnderlyingSecurityExchange", Type: FieldTypeString},
+					{Tag: tag.UnderlyingIssuer, Name: "UnderlyingIssuer", Type: FieldTypeString},
+					{Tag: tag.EncodedUnderlyingIssuerLen, Name: "EncodedUnderlyingIssuerLen", Type: FieldTypeInt},
+					{Tag: tag.EncodedUnderlyingIssuer, Name: "EncodedUnderlyingIssuer", Type: FieldTypeString},
+					{Tag: tag.UnderlyingSecurityDesc, Name: "UnderlyingSecurityDesc", Type: FieldTypeString},
+					{Tag: tag.EncodedUnderlyingSecurityDescLen, Name: "EncodedUnderlyingSecurityDescLen", Type: FieldTypeInt},
+					{Tag: tag.EncodedUnderlyingSecurityDesc, Name: "EncodedUnderlyingSecurityDesc", Type: FieldTypeString},
+					{Tag: tag.UnderlyingCPProgram, Name: "UnderlyingCPProgram", Type: FieldTypeString},
+					{Tag: tag.UnderlyingCPRegType, Name: "UnderlyingCPRegType", Type: FieldTypeString},
+					{Tag: tag.UnderlyingCurrency, Name: "UnderlyingCurrency", Type: FieldTypeString},
+					{Tag: tag.UnderlyingQty, Name: "UnderlyingQty", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingPx, Name: "UnderlyingPx", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingDirtyPrice, Name: "UnderlyingDirtyPrice", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingEndPrice, Name: "UnderlyingEndPrice", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingStartValue, Name: "UnderlyingStartValue", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingCurrentValue, Name: "UnderlyingCurrentValue", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingEndValue, Name: "UnderlyingEndValue", Type: FieldTypeDecimal},
+					{Tag: tag.NoUnderlyingStips, Name: "NoUnderlyingStips", Type: FieldTypeNumInGroup, Fields: noUnderlyingStipsFields},
+				}},
+				{Tag: tag.PrevClosePx, Name: "PrevClosePx", Type: FieldTypeDecimal},
+				{Tag: tag.QuoteRequestType, Name: "QuoteRequestType", Type: FieldTypeString},
+				{Tag: tag.QuoteType, Name: "QuoteType", Type: FieldTypeString},
+				{Tag: tag.TradingSessionID, Name: "TradingSessionID", Type: FieldTypeString},
+				{Tag: tag.TradingSessionSubID, Name: "TradingSessionSubID", Type: FieldTypeString},
+				{Tag: tag.TradeOriginationDate, Name: "TradeOriginationDate", Type: FieldTypeString},
+				{Tag: tag.Side, Name: "Side", Type: FieldTypeString},
+				{Tag: tag.QtyType, Name: "QtyType", Type: FieldTypeString},
+				{Tag: tag.OrderQty, Name: "OrderQty", Type: FieldTypeDecimal},
+				{Tag: tag.CashOrderQty, Name: "CashOrderQty", Type: FieldTypeDecimal},
+				{Tag: tag.OrderPercent, Name: "OrderPercent", Type: FieldTypeDecimal},
+				{Tag: tag.RoundingDirection, Name: "RoundingDirection", Type: FieldTypeString},
+				{Tag: tag.RoundingModulus, Name: "RoundingModulus", Type: FieldTypeDecimal},
+				{Tag: tag.SettlType, Name: "SettlType", Type: FieldTypeString},
+				{Tag: tag.SettlDate, Name: "SettlDate", Type: FieldTypeString},
+				{Tag: tag.SettlDate2, Name: "SettlDate2", Type: FieldTypeString},
+				{Tag: tag.OrderQty2, Name: "OrderQty2", Type: FieldTypeDecimal},
+				{Tag: tag.Currency, Name: "Currency", Type: FieldTypeString},
+				{Tag: tag.NoStipulations, Name: "NoStipulations", Type: FieldTypeNumInGroup, Fields: noStipulationsFields},
+				{Tag: tag.Account, Name: "Account", Type: FieldTypeString},
+				{Tag: tag.AcctIDSource, Name: "AcctIDSource", Type: FieldTypeString},
+				{Tag: tag.AccountType, Name: "AccountType", Type: FieldTypeString},
+				{Tag: tag.NoLegs, Name: "NoLegs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+					{Tag: tag.LegSymbol, Name: "LegSymbol", Type: FieldTypeString, Required: true},
+					{Tag: tag.LegSymbolSfx, Name: "LegSymbolSfx", Type: FieldTypeString},
+					{Tag: tag.LegSecurityID, Name: "LegSecurityID", Type: FieldTypeString},
+					{Tag: tag.LegSecurityIDSource, Name: "LegSecurityIDSource", Type: FieldTypeString},
+					{Tag: tag.NoLegSecurityAltID, Name: "NoLegSecurityAltID", Type: FieldTypeNumInGroup, Fields: noLegSecurityAltIDFields},
+					{Tag: tag.LegProduct, Name: "LegProduct", Type: FieldTypeInt},
+					{Tag: tag.LegCFICode, Name: "LegCFICode", Type: FieldTypeString},
+					{Tag: tag.LegSecurityType, Name: "LegSecurityType", Type: FieldTypeString},
+					{Tag: tag.LegSecuritySubType, Name: "LegSecuritySubType", Type: FieldTypeString},
+					{Tag: tag.LegMaturityMonthYear, Name: "LegMaturityMonthYear", Type: FieldTypeString},
+					{Tag: tag.LegMaturityDate, Name: "LegMaturityDate", Type: FieldTypeString},
+					{Tag: tag.LegCouponPaymentDate, Name: "LegCouponPaymentDate", Type: FieldTypeString},
+					{Tag: tag.LegIssueDate, Name: "LegIssueDate", Type: FieldTypeString},
+					{Tag: tag.LegRepoCollateralSecurityType, Name: "LegRepoCollateralSecurityType", Type: FieldTypeInt},
+					{Tag: tag.LegRepurchaseTerm, Name: "LegRepurchaseTerm", Type: FieldTypeInt},
+					{Tag: tag.LegRepurchaseRate, Name: "LegRepurchaseRate", Type: FieldTypeDecimal},
+					{Tag: tag.LegFactor, Name: "LegFactor", Type: FieldTypeDecimal},
+					{Tag: tag.LegCreditRating, Name: "LegCreditRating", Type: FieldTypeString},
+					{Tag: tag.LegInstrRegistry, Name: "LegInstrRegistry", Type: FieldTypeString},
+					{Tag: tag.LegCountryOfIssue, Name: "LegCountryOfIssue", Type: FieldTypeString},
+					{Tag: tag.LegStateOrProvinceOfIssue, Name: "LegStateOrProvinceOfIssue", Type: FieldTypeString},
+					{Tag: tag.LegLocaleOfIssue, Name: "LegLocaleOfIssue", Type: FieldTypeString},
+					{Tag: tag.LegRedemptionDate, Name: "LegRedemptionDate", Type: FieldTypeString},
+					{Tag: tag.LegStrikePrice, Name: "LegStrikePrice", Type: FieldTypeDecimal},
+					{Tag: tag.LegStrikeCurrency, Name: "LegStrikeCurrency", Type: FieldTypeString},
+					{Tag: tag.LegOptAttribute, Name: "LegOptAttribute", Type: FieldTypeString},
+					{Tag: tag.LegContractMultiplier, Name: "LegContractMultiplier", Type: FieldTypeDecimal},
+					{Tag: tag.LegCouponRate, Name: "LegCouponRate", Type: FieldTypeDecimal},
+					{Tag: tag.LegSecurityExchange, Name: "LegSecurityExchange", Type: FieldTypeString},
+					{Tag: tag.LegIssuer, Name: "LegIssuer", Type: FieldTypeString},
+					{Tag: tag.EncodedLegIssuerLen, Name: "EncodedLegIssuerLen", Type: FieldTypeInt},
+					{Tag: tag.EncodedLegIssuer, Name: "EncodedLegIssuer", Type: FieldTypeString},
+					{Tag: tag.LegSecurityDesc, Name: "LegSecurityDesc", Type: FieldTypeString},
+					{Tag: tag.EncodedLegSecurityDescLen, Name: "EncodedLegSecurityDescLen", Type: FieldTypeInt},
+					{Tag: tag.EncodedLegSecurityDesc, Name: "EncodedLegSecurityDesc", Type: FieldTypeString},
+					{Tag: tag.LegRatioQty, Name: "LegRatioQty", Type: FieldTypeDecimal},
+					{Tag: tag.LegSide, Name: "LegSide", Type: FieldTypeString},
+					{Tag: tag.LegCurrency, Name: "LegCurrency", Type: FieldTypeString},
+					{Tag: tag.LegPool, Name: "LegPool", Type: FieldTypeString},
+					{Tag: tag.LegDatedDate, Name: "LegDatedDate", Type: FieldTypeString},
+					{Tag: tag.LegContractSettlMonth, Name: "LegContractSettlMonth", Type: FieldTypeString},
+					{Tag: tag.LegInterestAccrualDate, Name: "LegInterestAccrualDate", Type: FieldTypeString},
+					{Tag: tag.LegQty, Name: "LegQty", Type: FieldTypeDecimal},
+					{Tag: tag.LegSwapType, Name: "LegSwapType", Type: FieldTypeString},
+					{Tag: tag.LegSettlType, Name: "LegSettlType", Type: FieldTypeString},
+					{Tag: tag.LegSettlDate, Name: "LegSettlDate", Type: FieldTypeString},
+					{Tag: tag.NoLegStipulations, Name: "NoLegStipulations", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+						{Tag: tag.LegStipulationType, Name: "LegStipulationType", Type: FieldTypeString, Required: true},
+						{Tag: tag.LegStipulationValue, Name: "LegStipulationValue", Type: FieldTypeString},
+					}},
+					{Tag: tag.NoNestedPartyIDs, Name: "NoNestedPartyIDs", Type: FieldTypeNumInGroup, Fields: noNestedPartyIDsFields},
+					{Tag: tag.LegBenchmarkCurveCurrency, Name: "LegBenchmarkCurveCurrency", Type: FieldTypeString},
+					{Tag: tag.LegBenchmarkCurveName, Name: "LegBenchmarkCurveName", Type: FieldTypeString},
+					{Tag: tag.LegBenchmarkCurvePoint, Name: "LegBenchmarkCurvePoint", Type: FieldTypeString},
+					{Tag: tag.LegBenchmarkPrice, Name: "LegBenchmarkPrice", Type: FieldTypeDecimal},
+					{Tag: tag.LegBenchmarkPriceType, Name: "LegBenchmarkPriceType", Type: FieldTypeInt},
+				}},
+				{Tag: tag.NoQuoteQualifiers, Name: "NoQuoteQualifiers", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+					{Tag: tag.QuoteQualifier, Name: "QuoteQualifier", Type: FieldTypeString, Required: true},
+				}},
+				{Tag: tag.QuotePriceType, Name: "QuotePriceType", Type: FieldTypeString},
+				{Tag: tag.OrdType, Name: "OrdType", Type: FieldTypeString},
+				{Tag: tag.ExpireTime, Name: "ExpireTime", Type: FieldTypeUTCTimestamp},
+				{Tag: tag.TransactTime, Name: "TransactTime", Type: FieldTypeUTCTimestamp},
+				{Tag: tag.Spread, Name: "Spread", Type: FieldTypeDecimal},
+				{Tag: tag.BenchmarkCurveCurrency, Name: "BenchmarkCurveCurrency", Type: FieldTypeString},
+				{Tag: tag.BenchmarkCurveName, Name: "BenchmarkCurveName", Type: FieldTypeString},
+				{Tag: tag.BenchmarkCurvePoint, Name: "BenchmarkCurvePoint", Type: FieldTypeString},
+				{Tag: tag.BenchmarkPrice, Name: "BenchmarkPrice", Type: FieldTypeDecimal},
+				{Tag: tag.BenchmarkPriceType, Name: "BenchmarkPriceType", Type: FieldTypeInt},
+				{Tag: tag.BenchmarkSecurityID, Name: "BenchmarkSecurityID", Type: FieldTypeString},
+				{Tag: tag.BenchmarkSecurityIDSource, Name: "BenchmarkSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.PriceType, Name: "PriceType", Type: FieldTypeString},
+				{Tag: tag.Price, Name: "Price", Type: FieldTypeDecimal},
+				{Tag: tag.Price2, Name: "Price2", Type: FieldTypeDecimal},
+				{Tag: tag.YieldType, Name: "YieldType", Type: FieldTypeString},
+				{Tag: tag.Yield, Name: "Yield", Type: FieldTypeDecimal},
+				{Tag: tag.YieldCalcDate, Name: "YieldCalcDate", Type: FieldTypeString},
+				{Tag: tag.YieldRedemptionDate, Name: "YieldRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.YieldRedemptionPrice, Name: "YieldRedemptionPrice", Type: FieldTypeDecimal},
+				{Tag: tag.YieldRedemptionPriceType, Name: "YieldRedemptionPriceType", Type: FieldTypeInt},
+				{Tag: tag.NoPartyIDs, Name: "NoPartyIDs", Type: FieldTypeNumInGroup, Fields: noPartyIDsFields},
+			}},
+			{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			{Tag: tag.RFQReqID, Name: "RFQReqID", Type: FieldTypeString},
+			{Tag: tag.QuoteRequestRejectReason, Name: "QuoteRequestRejectReason", Type: FieldTypeString, Required: true},
+		},
+	},
+	"AJ": {
+		Name:    "QuoteResponse",
+		MsgType: "AJ",
+		Fields: []FieldDef{
+			{Tag: tag.Account, Name: "Account", Type: FieldTypeString},
+			{Tag: tag.ClOrdID, Name: "ClOrdID", Type: FieldTypeString},
+			{Tag: tag.Commission, Name: "Commission", Type: FieldTypeDecimal},
+			{Tag: tag.CommType, Name: "CommType", Type: FieldTypeString},
+			{Tag: tag.Currency, Name: "Currency", Type: FieldTypeString},
+			{Tag: tag.SecurityIDSource, Name: "SecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.IOIID, Name: "IOIID", Type: FieldTypeString},
+			{Tag: tag.OrderQty, Name: "OrderQty", Type: FieldTypeDecimal},
+			{Tag: tag.OrdType, Name: "OrdType", Type: FieldTypeString},
+			{Tag: tag.Price, Name: "Price", Type: FieldTypeDecimal},
+			{Tag: tag.SecurityID, Name: "SecurityID", Type: FieldTypeString},
+			{Tag: tag.Side, Name: "Side", Type: FieldTypeString},
+			{Tag: tag.Symbol, Name: "Symbol", Type: FieldTypeString},
+			{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+			{Tag: tag.TransactTime, Name: "TransactTime", Type: FieldTypeUTCTimestamp},
+			{Tag: tag.ValidUntilTime, Name: "ValidUntilTime", Type: FieldTypeUTCTimestamp},
+			{Tag: tag.SettlType, Name: "SettlType", Type: FieldTypeString},
+			{Tag: tag.SettlDate, Name: "SettlDate", Type: FieldTypeString},
+			{Tag: tag.SymbolSfx, Name: "SymbolSfx", Type: FieldTypeString},
+			{Tag: tag.ExDestination, Name: "ExDestination", Type: FieldTypeString},
+			{Tag: tag.Issuer, Name: "Issuer", Type: FieldTypeString},
+			{Tag: tag.SecurityDesc, Name: "SecurityDesc", Type: FieldTypeString},
+			{Tag: tag.QuoteID, Name: "QuoteID", Type: FieldTypeString},
+			{Tag: tag.BidPx, Name: "BidPx", Type: FieldTypeDecimal},
+			{Tag: tag.OfferPx, Name: "OfferPx", Type: FieldTypeDecimal},
+			{Tag: tag.BidSize, Name: "BidSize", Type: FieldTypeDecimal},
+			{Tag: tag.OfferSize, Name: "OfferSize", Type: FieldTypeDecimal},
+			{Tag: tag.CashOrderQty, Name: "CashOrderQty", Type: FieldTypeDecimal},
+			{Tag: tag.SettlCurrFxRateCalc, Name: "SettlCurrFxRateCalc", Type: FieldTypeString},
+			{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+			{Tag: tag.BidSpotRate, Name: "BidSpotRate", Type: FieldTypeDecimal},
+			{Tag: tag.BidForwardPoints, Name: "BidForwardPoints", Type: FieldTypeDecimal},
+			{Tag: tag.OfferSpotRate, Name: "OfferSpotRate", Type: FieldTypeDecimal},
+			{Tag: tag.OfferForwardPoints, Name: "OfferForwardPoints", Type: FieldTypeDecimal},
+			{Tag: tag.OrderQty2, Name: "OrderQty2", Type: FieldTypeDecimal},
+			{Tag: tag.SettlDate2, Name: "SettlDate2", Type: FieldTypeString},
+			{Tag: tag.MaturityMonthYear, Name: "MaturityMonthYear", Type: FieldTypeString},
+			{Tag: tag.StrikePrice, Name: "StrikePrice", Type: FieldTypeDecimal},
+			{Tag: tag.OptAttribute, Name: "OptAttribute", Type: FieldTypeString},
+			{Tag: tag.SecurityExchange, Name: "SecurityExchange", Type: FieldTypeString},
+			{Tag: tag.Spread, Name: "Spread", Type: FieldTypeDecimal},
+			{Tag: tag.BenchmarkCurveCurrency, Name: "BenchmarkCurveCurrency", Type: FieldTypeString},
+			{Tag: tag.BenchmarkCurveName, Name: "BenchmarkCurveName", Type: FieldTypeString},
+			{Tag: tag.BenchmarkCurvePoint, Name: "BenchmarkCurvePoint", Type: FieldTypeString},
+			{Tag: tag.CouponRate, Name: "CouponRate", Type: FieldTypeDecimal},
+			{Tag: tag.CouponPaymentDate, Name: "CouponPaymentDate", Type: FieldTypeString},
+			{Tag: tag.IssueDate, Name: "IssueDate", Type: FieldTypeString},
+			{Tag: tag.RepurchaseTerm, Name: "RepurchaseTerm", Type: FieldTypeInt},
+			{Tag: tag.RepurchaseRate, Name: "RepurchaseRate", Type: FieldTypeDecimal},
+			{Tag: tag.Factor, Name: "Factor", Type: FieldTypeDecimal},
+			{Tag: tag.ContractMultiplier, Name: "ContractMultiplier", Type: FieldTypeDecimal},
+			{Tag: tag.NoStipulations, Name: "NoStipulations", Type: FieldTypeNumInGroup, Fields: noStipulationsFields},
+			{Tag: tag.YieldType, Name: "YieldType", Type: FieldTypeString},
+			{Tag: tag.Yield, Name: "Yield", Type: FieldTypeDecimal},
+			{Tag: tag.RepoCollateralSecurityType, Name: "RepoCollateralSecurityType", Type: FieldTypeInt},
+			{Tag: tag.RedemptionDate, Name: "RedemptionDate", Type: FieldTypeString},
+			{Tag: tag.CreditRating, Name: "CreditRating", Type: FieldTypeString},
+			{Tag: tag.TradingSessionID, Name: "TradingSessionID", Type: FieldTypeString},
+			{Tag: tag.EncodedIssuerLen, Name: "EncodedIssuerLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedIssuer, Name: "EncodedIssuer", Type: FieldTypeString},
+			{Tag: tag.EncodedSecurityDescLen, Name: "EncodedSecurityDescLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedSecurityDesc, Name: "EncodedSecurityDesc", Type: FieldTypeString},
+			{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			{Tag: tag.PriceType, Name: "PriceType", Type: FieldTypeString},
+			{Tag: tag.NoPartyIDs, Name: "NoPartyIDs", Type: FieldTypeNumInGroup, Fields: noPartyIDsFields},
+			{Tag: tag.NoSecurityAltID, Name: "NoSecurityAltID", Type: FieldTypeNumInGroup, Fields: noSecurityAltIDFields},
+			{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+			{Tag: tag.CFICode, Name: "CFICode", Type: FieldTypeString},
+			{Tag: tag.RoundingDirection, Name: "RoundingDirection", Type: FieldTypeString},
+			{Tag: tag.RoundingModulus, Name: "RoundingModulus", Type: FieldTypeDecimal},
+			{Tag: tag.CountryOfIssue, Name: "CountryOfIssue", Type: FieldTypeString},
+			{Tag: tag.StateOrProvinceOfIssue, Name: "StateOrProvinceOfIssue", Type: FieldTypeString},
+			{Tag: tag.LocaleOfIssue, Name: "LocaleOfIssue", Type: FieldTypeString},
+			{Tag: tag.OrderPercent, Name: "OrderPercent", Type: FieldTypeDecimal},
+			{Tag: tag.OrderCapacity, Name: "OrderCapacity", Type: FieldTypeString},
+			{Tag: tag.QuoteType, Name: "QuoteType", Type: FieldTypeString},
+			{Tag: tag.MaturityDate, Name: "MaturityDate", Type: FieldTypeString},
+			{Tag: tag.InstrRegistry, Name: "InstrRegistry", Type: FieldTypeString},
+			{Tag: tag.NoLegs, Name: "NoLegs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.LegSymbol, Name: "LegSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.LegSymbolSfx, Name: "LegSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.LegSecurityID, Name: "LegSecurityID", Type: FieldTypeString},
+				{Tag: tag.LegSecurityIDSource, Name: "LegSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoLegSecurityAltID, Name: "NoLegSecurityAltID", Type: FieldTypeNumInGroup, Fields: noLegSecurityAltIDFields},
+				{Tag: tag.LegProduct, Name: "LegProduct", Type: FieldTypeInt},
+				{Tag: tag.LegCFICode, Name: "LegCFICode", Type: FieldTypeString},
+				{Tag: tag.LegSecurityType, Name: "LegSecurityType", Type: FieldTypeString},
+				{Tag: tag.LegSecuritySubType, Name: "LegSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.LegMaturityMonthYear, Name: "LegMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.LegMaturityDate, Name: "LegMaturityDate", Type: FieldTypeString},
+				{Tag: tag.LegCouponPaymentDate, Name: "LegCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.LegIssueDate, Name: "LegIssueDate", Type: FieldTypeString},
+				{Tag: tag.LegRepoCollateralSecurityType, Name: "LegRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseTerm, Name: "LegRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseRate, Name: "LegRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegFactor, Name: "LegFactor", Type: FieldTypeDecimal},
+				{Tag: tag.LegCreditRating, Name: "LegCreditRating", Type: FieldTypeString},
+				{Tag: tag.LegInstrRegistry, Name: "LegInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.LegCountryOfIssue, Name: "LegCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegStateOrProvinceOfIssue, Name: "LegStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegLocaleOfIssue, Name: "LegLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegRedemptionDate, Name: "LegRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.LegStrikePrice, Name: "LegStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.LegStrikeCurrency, Name: "LegStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.LegOptAttribute, Name: "LegOptAttribute", Type: FieldTypeString},
+				{Tag: tag.LegContractMultiplier, Name: "LegContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.LegCouponRate, Name: "LegCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegSecurityExchange, Name: "LegSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.LegIssuer, Name: "LegIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedLegIssuerLen, Name: "EncodedLegIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegIssuer, Name: "EncodedLegIssuer", Type: FieldTypeString},
+				{Tag: tag.LegSecurityDesc, Name: "LegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedLegSecurityDescLen, Name: "EncodedLegSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegSecurityDesc, Name: "EncodedLegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.LegRatioQty, Name: "LegRatioQty", Type: FieldTypeDecimal},
+				{Tag: tag.LegSide, Name: "LegSide", Type: FieldTypeString},
+				{Tag: tag.LegCurrency, Name: "LegCurrency", Type: FieldTypeString},
+				{Tag: tag.LegPool, Name: "LegPool", Type: FieldTypeString},
+				{Tag: tag.LegDatedDate, Name: "LegDatedDate", Type: FieldTypeString},
+				{Tag: tag.LegContractSettlMonth, Name: "LegContractSettlMonth", Type: FieldTypeString},
+				{Tag: tag.LegInterestAccrualDate, Name: "LegInterestAccrualDate", Type: FieldTypeString},
+				{Tag: tag.LegQty, Name: "LegQty", Type: FieldTypeDecimal},
+				{Tag: tag.LegSwapType, Name: "LegSwapType", Type: FieldTypeString},
+				{Tag: tag.LegSettlType, Name: "LegSettlType", Type: FieldTypeString},
+				{Tag: tag.LegSettlDate, Name: "LegSettlDate", Type: FieldTypeString},
+				{Tag: tag.NoLegStipulations, Name: "NoLegStipulations", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+					{Tag: tag.LegStipulationType, Name: "LegStipulationType", Type: FieldTypeString, Required: true},
+					{Tag: tag.LegStipulationValue, Name: "LegStipulationValue", Type: FieldTypeString},
+				}},
+				{Tag: tag.NoNestedPartyIDs, Name: "NoNestedPartyIDs", Type: FieldTypeNumInGroup, Fields: noNestedPartyIDsFields},
+				{Tag: tag.LegPriceType, Name: "LegPriceType", Type: FieldTypeInt},
+				{Tag: tag.LegBidPx, Name: "LegBidPx", Type: FieldTypeDecimal},
+				{Tag: tag.LegOfferPx, Name: "LegOfferPx", Type: FieldTypeDecimal},
+				{Tag: tag.LegBenchmarkCurveCurrency, Name: "LegBenchmarkCurveCurrency", Type: FieldTypeString},
+				{Tag: tag.LegBenchmarkCurveName, Name: "LegBenchmarkCurveName", Type: FieldTypeString},
+				{Tag: tag.LegBenchmarkCurvePoint, Name: "LegBenchmarkCurvePoint", Type: FieldTypeString},
+				{Tag: tag.LegBenchmarkPrice, Name: "LegBenchmarkPrice", Type: FieldTypeDecimal},
+				{Tag: tag.LegBenchmarkPriceType, Name: "LegBenchmarkPriceType", Type: FieldTypeInt},
+			}},
+			{Tag: tag.AccountType, Name: "AccountType", Type: FieldTypeString},
+			{Tag: tag.CustOrderCapacity, Name: "CustOrderCapacity", Type: FieldTypeString},
+			{Tag: tag.TradingSessionSubID, Name: "TradingSessionSubID", Type: FieldTypeString},
+			{Tag: tag.MidPx, Name: "MidPx", Type: FieldTypeDecimal},
+			{Tag: tag.BidYield, Name: "BidYield", Type: FieldTypeDecimal},
+			{Tag: tag.MidYield, Name: "MidYield", Type: FieldTypeDecimal},
+			{Tag: tag.OfferYield, Name: "OfferYield", Type: FieldTypeDecimal},
+			{Tag: tag.BidForwardPoints2, Name: "BidForwardPoints2", Type: FieldTypeDecimal},
+			{Tag: tag.OfferForwardPoints2, Name: "OfferForwardPoints2", Type: FieldTypeDecimal},
+			{Tag: tag.MktBidPx, Name: "MktBidPx", Type: FieldTypeDecimal},
+			{Tag: tag.MktOfferPx, Name: "MktOfferPx", Type: FieldTypeDecimal},
+			{Tag: tag.MinBidSize, Name: "MinBidSize", Type: FieldTypeDecimal},
+			{Tag: tag.MinOfferSize, Name: "MinOfferSize", Type: FieldTypeDecimal},
+			{Tag: tag.SettlCurrBidFxRate, Name: "SettlCurrBidFxRate", Type: FieldTypeDecimal},
+			{Tag: tag.SettlCurrOfferFxRate, Name: "SettlCurrOfferFxRate", Type: FieldTypeDecimal},
+			{Tag: tag.AcctIDSource, Name: "AcctIDSource", Type: FieldTypeString},
+			{Tag: tag.BenchmarkPrice, Name: "BenchmarkPrice", Type: FieldTypeDecimal},
+			{Tag: tag.BenchmarkPriceType, Name: "BenchmarkPriceType", Type: FieldTypeInt},
+			{Tag: tag.ContractSettlMonth, Name: "ContractSettlMonth", Type: FieldTypeString},
+			{Tag: tag.Pool, Name: "Pool", Type: FieldTypeString},
+			{Tag: tag.QuoteRespID, Name: "QuoteRespID", Type: FieldTypeString, Required: true},
+			{Tag: tag.QuoteRespType, Name: "QuoteRespType", Type: FieldTypeString, Required: true},
+			{Tag: tag.YieldRedemptionDate, Name: "YieldRedemptionDate", Type: FieldTypeString},
+			{Tag: tag.YieldRedemptionPrice, Name: "YieldRedemptionPrice", Type: FieldTypeDecimal},
+			{Tag: tag.YieldRedemptionPriceType, Name: "YieldRedemptionPriceType", Type: FieldTypeInt},
+			{Tag: tag.BenchmarkSecurityID, Name: "BenchmarkSecurityID", Type: FieldTypeString},
+			{Tag: tag.YieldCalcDate, Name: "YieldCalcDate", Type: FieldTypeString},
+			{Tag: tag.NoUnderlyings, Name: "NoUnderlyings", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.UnderlyingSymbol, Name: "UnderlyingSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.UnderlyingSymbolSfx, Name: "UnderlyingSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityID, Name: "UnderlyingSecurityID", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityIDSource, Name: "UnderlyingSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoUnderlyingSecurityAltID, Name: "NoUnderlyingSecurityAltID", Type: FieldTypeNumInGroup, Fields: noUnderlyingSecurityAltIDFields},
+				{Tag: tag.UnderlyingProduct, Name: "UnderlyingProduct", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingCFICode, Name: "UnderlyingCFICode", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityType, Name: "UnderlyingSecurityType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecuritySubType, Name: "UnderlyingSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityMonthYear, Name: "UnderlyingMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityDate, Name: "UnderlyingMaturityDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCouponPaymentDate, Name: "UnderlyingCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssueDate, Name: "UnderlyingIssueDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRepoCollateralSecurityType, Name: "UnderlyingRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseTerm, Name: "UnderlyingRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseRate, Name: "UnderlyingRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingFactor, Name: "UnderlyingFactor", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCreditRating, Name: "UnderlyingCreditRating", Type: FieldTypeString},
+				{Tag: tag.UnderlyingInstrRegistry, Name: "UnderlyingInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCountryOfIssue, Name: "UnderlyingCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStateOrProvinceOfIssue, Name: "UnderlyingStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingLocaleOfIssue, Name: "UnderlyingLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRedemptionDate, Name: "UnderlyingRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStrikePrice, Name: "UnderlyingStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStrikeCurrency, Name: "UnderlyingStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingOptAttribute, Name: "UnderlyingOptAttribute", Type: FieldTypeString},
+				{Tag: tag.UnderlyingContractMultiplier, Name: "UnderlyingContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCouponRate, Name: "UnderlyingCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingSecurityExchange, Name: "UnderlyingSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssuer, Name: "UnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingIssuerLen, Name: "EncodedUnderlyingIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingIssuer, Name: "EncodedUnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityDesc, Name: "UnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingSecurityDescLen, Name: "EncodedUnderlyingSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingSecurityDesc, Name: "EncodedUnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPProgram, Name: "UnderlyingCPProgram", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPRegType, Name: "UnderlyingCPRegType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCurrency, Name: "UnderlyingCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingQty, Name: "UnderlyingQty", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingPx, Name: "UnderlyingPx", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingDirtyPrice, Name: "UnderlyingDirtyPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndPrice, Name: "UnderlyingEndPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStartValue, Name: "UnderlyingStartValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCurrentValue, Name: "UnderlyingCurrentValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndValue, Name: "UnderlyingEndValue", Type: FieldTypeDecimal},
+				{Tag: tag.NoUnderlyingStips, Name: "NoUnderlyingStips", Type: FieldTypeNumInGroup, Fields: noUnderlyingStipsFields},
+			}},
+			{Tag: tag.NoQuoteQualifiers, Name: "NoQuoteQualifiers", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.QuoteQualifier, Name: "QuoteQualifier", Type: FieldTypeString, Required: true},
+			}},
+			{Tag: tag.BenchmarkSecurityIDSource, Name: "BenchmarkSecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.SecuritySubType, Name: "SecuritySubType", Type: FieldTypeString},
+			{Tag: tag.TerminationType, Name: "TerminationType", Type: FieldTypeString},
+			{Tag: tag.NoEvents, Name: "NoEvents", Type: FieldTypeNumInGroup, Fields: noEventsFields},
+			{Tag: tag.DatedDate, Name: "DatedDate", Type: FieldTypeString},
+			{Tag: tag.InterestAccrualDate, Name: "InterestAccrualDate", Type: FieldTypeString},
+			{Tag: tag.CPProgram, Name: "CPProgram", Type: FieldTypeString},
+			{Tag: tag.CPRegType, Name: "CPRegType", Type: FieldTypeString},
+			{Tag: tag.MarginRatio, Name: "MarginRatio", Type: FieldTypeDecimal},
+			{Tag: tag.AgreementDesc, Name: "AgreementDesc", Type: FieldTypeString},
+			{Tag: tag.AgreementID, Name: "AgreementID", Type: FieldTypeString},
+			{Tag: tag.AgreementDate, Name: "AgreementDate", Type: FieldTypeString},
+			{Tag: tag.StartDate, Name: "StartDate", Type: FieldTypeString},
+			{Tag: tag.EndDate, Name: "EndDate", Type: FieldTypeString},
+			{Tag: tag.AgreementCurrency, Name: "AgreementCurrency", Type: FieldTypeString},
+			{Tag: tag.DeliveryType, Name: "DeliveryType", Type: FieldTypeString},
+			{Tag: tag.StrikeCurrency, Name: "StrikeCurrency", Type: FieldTypeString},
+		},
+	},
+	"AI": {
+		Name:    "QuoteStatusReport",
+		MsgType: "AI",
+		Fields: []FieldDef{
+			{Tag: tag.Account, Name: "Account", Type: FieldTypeString},
+			{Tag: tag.Commission, Name: "Commission", Type: FieldTypeDecimal},
+			{Tag: tag.CommType, Name: "CommType", Type: FieldTypeString},
+			{Tag: tag.Currency, Name: "Currency", Type: FieldTypeString},
+			{Tag: tag.SecurityIDSource, Name: "SecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.OrderQty, Name: "OrderQty", Type: FieldTypeDecimal},
+			{Tag: tag.OrdType, Name: "OrdType", Type: FieldTypeString},
+			{Tag: tag.Price, Name: "Price", Type: FieldTypeDecimal},
+			{Tag: tag.SecurityID, Name: "SecurityID", Type: FieldTypeString},
+			{Tag: tag.Side, Name: "Side", Type: FieldTypeString},
+			{Tag: tag.Symbol, Name: "Symbol", Type: FieldTypeString},
+			{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+			{Tag: tag.TransactTime, Name: "TransactTime", Type: FieldTypeUTCTimestamp},
+			{Tag: tag.ValidUntilTime, Name: "ValidUntilTime", Type: FieldTypeUTCTimestamp},
+			{Tag: tag.SettlType, Name: "SettlType", Type: FieldTypeString},
+			{Tag: tag.SettlDate, Name: "SettlDate", Type: FieldTypeString},
+			{Tag: tag.SymbolSfx, Name: "SymbolSfx", Type: FieldTypeString},
+			{Tag: tag.ExDestination, Name: "ExDestination", Type: FieldTypeString},
+			{Tag: tag.Issuer, Name: "Issuer", Type: FieldTypeString},
+			{Tag: tag.SecurityDesc, Name: "SecurityDesc", Type: FieldTypeString},
+			{Tag: tag.QuoteID, Name: "QuoteID", Type: FieldTypeString, Required: true},
+			{Tag: tag.ExpireTime, Name: "ExpireTime", Type: FieldTypeUTCTimestamp},
+			{Tag: tag.QuoteReqID, Name: "QuoteReqID", Type: FieldTypeString},
+			{Tag: tag.BidPx, Name: "BidPx", Type: FieldTypeDecimal},
+			{Tag: tag.OfferPx, Name: "OfferPx", Type: FieldTypeDecimal},
+			{Tag: tag.BidSize, Name: "BidSize", Type: FieldTypeDecimal},
+			{Tag: tag.OfferSize, Name: "OfferSize", Type: FieldTypeDecimal},
+			{Tag: tag.CashOrderQty, Name: "CashOrderQty", Type: FieldTypeDecimal},
+			{Tag: tag.SettlCurrFxRateCalc, Name: "SettlCurrFxRateCalc", Type: FieldTypeString},
+			{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+			{Tag: tag.BidSpotRate, Name: "BidSpotRate", Type: FieldTypeDecimal},
+			{Tag: tag.BidForwardPoints, Name: "BidForwardPoints", Type: FieldTypeDecimal},
+			{Tag: tag.OfferSpotRate, Name: "OfferSpotRate", Type: FieldTypeDecimal},
+			{Tag: tag.OfferForwardPoints, Name: "OfferForwardPoints", Type: FieldTypeDecimal},
+			{Tag: tag.OrderQty2, Name: "OrderQty2", Type: FieldTypeDecimal},
+			{Tag: tag.SettlDate2, Name: "SettlDate2", Type: FieldTypeString},
+			{Tag: tag.MaturityMonthYear, Name: "MaturityMonthYear", Type: FieldTypeString},
+			{Tag: tag.StrikePrice, Name: "StrikePrice", Type: FieldTypeDecimal},
+			{Tag: tag.OptAttribute, Name: "OptAttribute", Type: FieldTypeString},
+			{Tag: tag.SecurityExchange, Name: "SecurityExchange", Type: FieldTypeString},
+			{Tag: tag.Spread, Name: "Spread", Type: FieldTypeDecimal},
+			{Tag: tag.BenchmarkCurveCurrency, Name: "BenchmarkCurveCurrency", Type: FieldTypeString},
+			{Tag: tag.BenchmarkCurveName, Name: "BenchmarkCurveName", Type: FieldTypeString},
+			{Tag: tag.BenchmarkCurvePoint, Name: "BenchmarkCurvePoint", Type: FieldTypeString},
+			{Tag: tag.CouponRate, Name: "CouponRate", Type: FieldTypeDecimal},
+			{Tag: tag.CouponPaymentDate, Name: "CouponPaymentDate", Type: FieldTypeString},
+			{Tag: tag.IssueDate, Name: "IssueDate", Type: FieldTypeString},
+			{Tag: tag.RepurchaseTerm, Name: "RepurchaseTerm", Type: FieldTypeInt},
+			{Tag: tag.RepurchaseRate, Name: "RepurchaseRate", Type: FieldTypeDecimal},
+			{Tag: tag.Factor, Name: "Factor", Type: FieldTypeDecimal},
+			{Tag: tag.ContractMultiplier, Name: "ContractMultiplier", Type: FieldTypeDecimal},
+			{Tag: tag.NoStipulations, Name: "NoStipulations", Type: FieldTypeNumInGroup, Fields: noStipulationsFields},
+			{Tag: tag.YieldType, Name: "YieldType", Type: FieldTypeString},
+			{Tag: tag.Yield, Name: "Yield", Type: FieldTypeDecimal},
+			{Tag: tag.RepoCollateralSecurityType, Name: "RepoCollateralSecurityType", Type: FieldTypeInt},
+			{Tag: tag.RedemptionDate, Name: "RedemptionDate", Type: FieldTypeString},
+			{Tag: tag.CreditRating, Name: "CreditRating", Type: FieldTypeString},
+			{Tag: tag.QuoteStatus, Name: "QuoteStatus", Type: FieldTypeString},
+			{Tag: tag.TradingSessionID, Name: "TradingSessionID", Type: FieldTypeString},
+			{Tag: tag.EncodedIssuerLen, Name: "EncodedIssuerLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedIssuer, Name: "EncodedIssuer", Type: FieldTypeString},
+			{Tag: tag.EncodedSecurityDescLen, Name: "EncodedSecurityDescLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedSecurityDesc, Name: "EncodedSecurityDesc", Type: FieldTypeString},
+			{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			{Tag: tag.PriceType, Name: "PriceType", Type: FieldTypeString},
+			{Tag: tag.NoPartyIDs, Name: "NoPartyIDs", Type: FieldTypeNumInGroup, Fields: noPartyIDsFields},
+			{Tag: tag.NoSecurityAltID, Name: "NoSecurityAltID", Type: FieldTypeNumInGroup, Fields: noSecurityAltIDFields},
+			{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+			{Tag: tag.CFICode, Name: "CFICode", Type: FieldTypeString},
+			{Tag: tag.RoundingDirection, Name: "RoundingDirection", Type: FieldTypeString},
+			{Tag: tag.RoundingModulus, Name: "RoundingModulus", Type: FieldTypeDecimal},
+			{Tag: tag.CountryOfIssue, Name: "CountryOfIssue", Type: FieldTypeString},
+			{Tag: tag.StateOrProvinceOfIssue, Name: "StateOrProvinceOfIssue", Type: FieldTypeString},
+			{Tag: tag.LocaleOfIssue, Name: "LocaleOfIssue", Type: FieldTypeString},
+			{Tag: tag.OrderPercent, Name: "OrderPercent", Type: FieldTypeDecimal},
+			{Tag: tag.QuoteType, Name: "QuoteType", Type: FieldTypeString},
+			{Tag: tag.MaturityDate, Name: "MaturityDate", Type: FieldTypeString},
+			{Tag: tag.InstrRegistry, Name: "InstrRegistry", Type: FieldTypeString},
+			{Tag: tag.NoLegs, Name: "NoLegs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.LegSymbol, Name: "LegSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.LegSymbolSfx, Name: "LegSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.LegSecurityID, Name: "LegSecurityID", Type: FieldTypeString},
+				{Tag: tag.LegSecurityIDSource, Name: "LegSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoLegSecurityAltID, Name: "NoLegSecurityAltID", Type: FieldTypeNumInGroup, Fields: noLegSecurityAltIDFields},
+				{Tag: tag.LegProduct, Name: "LegProduct", Type: FieldTypeInt},
+				{Tag: tag.LegCFICode, Name: "LegCFICode", Type: FieldTypeString},
+				{Tag: tag.LegSecurityType, Name: "LegSecurityType", Type: FieldTypeString},
+				{Tag: tag.LegSecuritySubType, Name: "LegSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.LegMaturityMonthYear, Name: "LegMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.LegMaturityDate, Name: "LegMaturityDate", Type: FieldTypeString},
+				{Tag: tag.LegCouponPaymentDate, Name: "LegCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.LegIssueDate, Name: "LegIssueDate", Type: FieldTypeString},
+				{Tag: tag.LegRepoCollateralSecurityType, Name: "LegRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseTerm, Name: "LegRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseRate, Name: "LegRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegFactor, Name: "LegFactor", Type: FieldTypeDecimal},
+				{Tag: tag.LegCreditRating, Name: "LegCreditRating", Type: FieldTypeString},
+				{Tag: tag.LegInstrRegistry, Name: "LegInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.LegCountryOfIssue, Name: "LegCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegStateOrProvinceOfIssue, Name: "LegStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegLocaleOfIssue, Name: "LegLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegRedemptionDate, Name: "LegRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.LegStrikePrice, Name: "LegStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.LegStrikeCurrency, Name: "LegStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.LegOptAttribute, Name: "LegOptAttribute", Type: FieldTypeString},
+				{Tag: tag.LegContractMultiplier, Name: "LegContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.LegCouponRate, Name: "LegCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegSecurityExchange, Name: "LegSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.LegIssuer, Name: "LegIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedLegIssuerLen, Name: "EncodedLegIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegIssuer, Name: "EncodedLegIssuer", Type: FieldTypeString},
+				{Tag: tag.LegSecurityDesc, Name: "LegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedLegSecurityDescLen, Name: "EncodedLegSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegSecurityDesc, Name: "EncodedLegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.LegRatioQty, Name: "LegRatioQty", Type: FieldTypeDecimal},
+				{Tag: tag.LegSide, Name: "LegSide", Type: FieldTypeString},
+				{Tag: tag.LegCurrency, Name: "LegCurrency", Type: FieldTypeString},
+				{Tag: tag.LegPool, Name: "LegPool", Type: FieldTypeString},
+				{Tag: tag.LegDatedDate, Name: "LegDatedDate", Type: FieldTypeString},
+				{Tag: tag.LegContractSettlMonth, Name: "LegContractSettlMonth", Type: FieldTypeString},
+				{Tag: tag.LegInterestAccrualDate, Name: "LegInterestAccrualDate", Type: FieldTypeString},
+				{Tag: tag.LegQty, Name: "LegQty", Type: FieldTypeDecimal},
+				{Tag: tag.LegSwapType, Name: "LegSwapType", Type: FieldTypeString},
+				{Tag: tag.LegSettlType, Name: "LegSettlType", Type: FieldTypeString},
+				{Tag: tag.LegSettlDate, Name: "LegSettlDate", Type: FieldTypeString},
+				{Tag: tag.NoLegStipulations, Name: "NoLegStipulations", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+					{Tag: tag.LegStipulationType, Name: "LegStipulationType", Type: FieldTypeString, Required: true},
+					{Tag: tag.LegStipulationValue, Name: "LegStipulationValue", Type: FieldTypeString},
+				}},
+				{Tag: tag.NoNestedPartyIDs, Name: "NoNestedPartyIDs", Type: FieldTypeNumInGroup, Fields: noNestedPartyIDsFields},
+			}},
+			{Tag: tag.AccountType, Name: "AccountType", Type: FieldTypeString},
+			{Tag: tag.CustOrderCapacity, Name: "CustOrderCapacity", Type: FieldTypeString},
+			{Tag: tag.TradingSessionSubID, Name: "TradingSessionSubID", Type: FieldTypeString},
+			{Tag: tag.MidPx, Name: "MidPx", Type: FieldTypeDecimal},
+			{Tag: tag.BidYield, Name: "BidYield", Type: FieldTypeDecimal},
+			{Tag: tag.MidYield, Name: "MidYield", Type: FieldTypeDecimal},
+			{Tag: tag.OfferYield, Name: "OfferYield", Type: FieldTypeDecimal},
+			{Tag: tag.BidForwardPoints2, Name: "BidForwardPoints2", Type: FieldTypeDecimal},
+			{Tag: tag.OfferForwardPoints2, Name: "OfferForwardPoints2", Type: FieldTypeDecimal},
+			{Tag: tag.MktBidPx, Name: "MktBidPx", Type: FieldTypeDecimal},
+			{Tag: tag.MktOfferPx, Name: "MktOfferPx", Type: FieldTypeDecimal},
+			{Tag: tag.MinBidSize, Name: "MinBidSize", Type: FieldTypeDecimal},
+			{Tag: tag.MinOfferSize, Name: "MinOfferSize", Type: FieldTypeDecimal},
+			{Tag: tag.QuoteStatusReqID, Name: "QuoteStatusReqID", Type: FieldTypeString},
+			{Tag: tag.SettlCurrBidFxRate, Name: "SettlCurrBidFxRate", Type: FieldTypeDecimal},
+			{Tag: tag.SettlCurrOfferFxRate, Name: "SettlCurrOfferFxRate", Type: FieldTypeDecimal},
+			{Tag: tag.AcctIDSource, Name: "AcctIDSource", Type: FieldTypeString},
+			{Tag: tag.BenchmarkPrice, Name: "BenchmarkPrice", Type: FieldTypeDecimal},
+			{Tag: tag.BenchmarkPriceType, Name: "BenchmarkPriceType", Type: FieldTypeInt},
+			{Tag: tag.ContractSettlMonth, Name: "ContractSettlMonth", Type: FieldTypeString},
+			{Tag: tag.Pool, Name: "Pool", Type: FieldTypeString},
+			{Tag: tag.QuoteRespID, Name: "QuoteRespID", Type: FieldTypeString},
+			{Tag: tag.YieldRedemptionDate, Name: "YieldRedemptionDate", Type: FieldTypeString},
+			{Tag: tag.YieldRedemptionPrice, Name: "YieldRedemptionPrice", Type: FieldTypeDecimal},
+			{Tag: tag.YieldRedemptionPriceType, Name: "YieldRedemptionPriceType", Type: FieldTypeInt},
+			{Tag: tag.BenchmarkSecurityID, Name: "BenchmarkSecurityID", Type: FieldTypeString},
+			{Tag: tag.YieldCalcDate, Name: "YieldCalcDate", Type: FieldTypeString},
+			{Tag: tag.NoUnderlyings, Name: "NoUnderlyings", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.UnderlyingSymbol, Name: "UnderlyingSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.UnderlyingSymbolSfx, Name: "UnderlyingSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityID, Name: "UnderlyingSecurityID", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityIDSource, Name: "UnderlyingSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoUnderlyingSecurityAltID, Name: "NoUnderlyingSecurityAltID", Type: FieldTypeNumInGroup, Fields: noUnderlyingSecurityAltIDFields},
+				{Tag: tag.UnderlyingProduct, Name: "UnderlyingProduct", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingCFICode, Name: "UnderlyingCFICode", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityType, Name: "UnderlyingSecurityType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecuritySubType, Name: "UnderlyingSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityMonthYear, Name: "UnderlyingMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityDate, Name: "UnderlyingMaturityDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCouponPaymentDate, Name: "UnderlyingCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssueDate, Name: "UnderlyingIssueDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRepoCollateralSecurityType, Name: "UnderlyingRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseTerm, Name: "UnderlyingRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseRate, Name: "UnderlyingRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingFactor, Name: "UnderlyingFactor", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCreditRating, Name: "UnderlyingCreditRating", Type: FieldTypeString},
+				{Tag: tag.UnderlyingInstrRegistry, Name: "UnderlyingInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCountryOfIssue, Name: "UnderlyingCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStateOrProvinceOfIssue, Name: "UnderlyingStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingLocaleOfIssue, Name: "UnderlyingLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRedemptionDate, Name: "UnderlyingRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStrikePrice, Name: "UnderlyingStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStrikeCurrency, Name: "UnderlyingStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingOptAttribute, Name: "UnderlyingOptAttribute", Type: FieldTypeString},
+				{Tag: tag.UnderlyingContractMultiplier, Name: "UnderlyingContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCouponRate, Name: "UnderlyingCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingSecurityExchange, Name: "UnderlyingSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssuer, Name: "UnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingIssuerLen, Name: "EncodedUnderlyingIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingIssuer, Name: "EncodedUnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityDesc, Name: "UnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingSecurityDescLen, Name: "EncodedUnderlyingSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingSecurityDesc, Name: "EncodedUnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPProgram, Name: "UnderlyingCPProgram", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPRegType, Name: "UnderlyingCPRegType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCurrency, Name: "UnderlyingCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingQty, Name: "UnderlyingQty", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingPx, Name: "UnderlyingPx", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingDirtyPrice, Name: "UnderlyingDirtyPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndPrice, Name: "UnderlyingEndPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStartValue, Name: "UnderlyingStartValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCurrentValue, Name: "UnderlyingCurrentValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndValue, Name: "UnderlyingEndValue", Type: FieldTypeDecimal},
+				{Tag: tag.NoUnderlyingStips, Name: "NoUnderlyingStips", Type: FieldTypeNumInGroup, Fields: noUnderlyingStipsFields},
+			}},
+			{Tag: tag.NoQuoteQualifiers, Name: "NoQuoteQualifiers", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.QuoteQualifier, Name: "QuoteQualifier", Type: FieldTypeString, Required: true},
+			}},
+			{Tag: tag.BenchmarkSecurityIDSource, Name: "BenchmarkSecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.SecuritySubType, Name: "SecuritySubType", Type: FieldTypeString},
+			{Tag: tag.TerminationType, Name: "TerminationType", Type: FieldTypeString},
+			{Tag: tag.NoEvents, Name: "NoEvents", Type: FieldTypeNumInGroup, Fields: noEventsFields},
+			{Tag: tag.DatedDate, Name: "DatedDate", Type: FieldTypeString},
+			{Tag: tag.InterestAccrualDate, Name: "InterestAccrualDate", Type: FieldTypeString},
+			{Tag: tag.CPProgram, Name: "CPProgram", Type: FieldTypeString},
+			{Tag: tag.CPRegType, Name: "CPRegType", Type: FieldTypeString},
+			{Tag: tag.MarginRatio, Name: "MarginRatio", Type: FieldTypeDecimal},
+			{Tag: tag.AgreementDesc, Name: "AgreementDesc", Type: FieldTypeString},
+			{Tag: tag.AgreementID, Name: "AgreementID", Type: FieldTypeString},
+			{Tag: tag.AgreementDate, Name: "AgreementDate", Type: FieldTypeString},
+			{Tag: tag.StartDate, Name: "StartDate", Type: FieldTypeString},
+			{Tag: tag.EndDate, Name: "EndDate", Type: FieldTypeString},
+			{Tag: tag.AgreementCurrency, Name: "AgreementCurrency", Type: FieldTypeString},
+			{Tag: tag.DeliveryType, Name: "DeliveryType", Type: FieldTypeString},
+			{Tag: tag.StrikeCurrency, Name: "StrikeCurrency", Type: FieldTypeString},
+		},
+	},
+	"a": {
+		Name:    "QuoteStatusRequest",
+		MsgType: "a",
+		Fields: []FieldDef{
+			{Tag: tag.Account, Name: "Account", Type: FieldTypeString},
+			{Tag: tag.SecurityIDSource, Name: "SecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.SecurityID, Name: "SecurityID", Type: FieldTypeString},
+			{Tag: tag.Symbol, Name: "Symbol", Type: FieldTypeString},
+			{Tag: tag.SymbolSfx, Name: "SymbolSfx", Type: FieldTypeString},
+			{Tag: tag.Issuer, Name: "Issuer", Type: FieldTypeString},
+			{Tag: tag.SecurityDesc, Name: "SecurityDesc", Type: FieldTypeString},
+			{Tag: tag.QuoteID, Name: "QuoteID", Type: FieldTypeString},
+			{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+			{Tag: tag.MaturityMonthYear, Name: "MaturityMonthYear", Type: FieldTypeString},
+			{Tag: tag.StrikePrice, Name: "StrikePrice", Type: FieldTypeDecimal},
+			{Tag: tag.OptAttribute, Name: "OptAttribute", Type: FieldTypeString},
+			{Tag: tag.SecurityExchange, Name: "SecurityExchange", Type: FieldTypeString},
+			{Tag: tag.CouponRate, Name: "CouponRate", Type: FieldTypeDecimal},
+			{Tag: tag.CouponPaymentDate, Name: "CouponPaymentDate", Type: FieldTypeString},
+			{Tag: tag.IssueDate, Name: "IssueDate", Type: FieldTypeString},
+			{Tag: tag.RepurchaseTerm, Name: "RepurchaseTerm", Type: FieldTypeInt},
+			{Tag: tag.RepurchaseRate, Name: "RepurchaseRate", Type: FieldTypeDecimal},
+			{Tag: tag.Factor, Name: "Factor", Type: FieldTypeDecimal},
+			{Tag: tag.ContractMultiplier, Name: "ContractMultiplier", Type: FieldTypeDecimal},
+			{Tag: tag.RepoCollateralSecurityType, Name: "RepoCollateralSecurityType", Type: FieldTypeInt},
+			{Tag: tag.RedemptionDate, Name: "RedemptionDate", Type: FieldTypeString},
+			{Tag: tag.CreditRating, Name: "CreditRating", Type: FieldTypeString},
+			{Tag: tag.SubscriptionRequestType, Name: "SubscriptionRequestType", Type: FieldTypeString},
+			{Tag: tag.TradingSessionID, Name: "TradingSessionID", Type: FieldTypeString},
+			{Tag: tag.EncodedIssuerLen, Name: "EncodedIssuerLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedIssuer, Name: "EncodedIssuer", Type: FieldTypeString},
+			{Tag: tag.EncodedSecurityDescLen, Name: "EncodedSecurityDescLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedSecurityDesc, Name: "EncodedSecurityDesc", Type: FieldTypeString},
+			{Tag: tag.NoPartyIDs, Name: "NoPartyIDs", Type: FieldTypeNumInGroup, Fields: noPartyIDsFields},
+			{Tag: tag.NoSecurityAltID, Name: "NoSecurityAltID", Type: FieldTypeNumInGroup, Fields: noSecurityAltIDFields},
+			{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+			{Tag: tag.CFICode, Name: "CFICode", Type: FieldTypeString},
+			{Tag: tag.CountryOfIssue, Name: "CountryOfIssue", Type: FieldTypeString},
+			{Tag: tag.StateOrProvinceOfIssue, Name: "StateOrProvinceOfIssue", Type: FieldTypeString},
+			{Tag: tag.LocaleOfIssue, Name: "LocaleOfIssue", Type: FieldTypeString},
+			{Tag: tag.MaturityDate, Name: "MaturityDate", Type: FieldTypeString},
+			{Tag: tag.InstrRegistry, Name: "InstrRegistry", Type: FieldTypeString},
+			{Tag: tag.NoLegs, Name: "NoLegs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.LegSymbol, Name: "LegSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.LegSymbolSfx, Name: "LegSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.LegSecurityID, Name: "LegSecurityID", Type: FieldTypeString},
+				{Tag: tag.LegSecurityIDSource, Name: "LegSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoLegSecurityAltID, Name: "NoLegSecurityAltID", Type: FieldTypeNumInGroup, Fields: noLegSecurityAltIDFields},
+				{Tag: tag.LegProduct, Name: "LegProduct", Type: FieldTypeInt},
+				{Tag: tag.LegCFICode, Name: "LegCFICode", Type: FieldTypeString},
+				{Tag: tag.LegSecurityType, Name: "LegSecurityType", Type: FieldTypeString},
+				{Tag: tag.LegSecuritySubType, Name: "LegSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.LegMaturityMonthYear, Name: "LegMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.LegMaturityDate, Name: "LegMaturityDate", Type: FieldTypeString},
+				{Tag: tag.LegCouponPaymentDate, Name: "LegCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.LegIssueDate, Name: "LegIssueDate", Type: FieldTypeString},
+				{Tag: tag.LegRepoCollateralSecurityType, Name: "LegRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseTerm, Name: "LegRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseRate, Name: "LegRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegFactor, Name: "LegFactor", Type: FieldTypeDecimal},
+				{Tag: tag.LegCreditRating, Name: "LegCreditRating", Type: FieldTypeString},
+				{Tag: tag.LegInstrRegistry, Name: "LegInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.LegCountryOfIssue, Name: "LegCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegStateOrProvinceOfIssue, Name: "LegStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegLocaleOfIssue, Name: "LegLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegRedemptionDate, Name: "LegRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.LegStrikePrice, Name: "LegStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.LegStrikeCurrency, Name: "LegStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.LegOptAttribute, Name: "LegOptAttribute", Type: FieldTypeString},
+				{Tag: tag.LegContractMultiplier, Name: "LegContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.LegCouponRate, Name: "LegCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegSecurityExchange, Name: "LegSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.LegIssuer, Name: "LegIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedLegIssuerLen, Name: "EncodedLegIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegIssuer, Name: "EncodedLegIssuer", Type: FieldTypeString},
+				{Tag: tag.LegSecurityDesc, Name: "LegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedLegSecurityDescLen, Name: "EncodedLegSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegSecurityDesc, Name: "EncodedLegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.LegRatioQty, Name: "LegRatioQty", Type: FieldTypeDecimal},
+				{Tag: tag.LegSide, Name: "LegSide", Type: FieldTypeString},
+				{Tag: tag.LegCurrency, Name: "LegCurrency", Type: FieldTypeString},
+				{Tag: tag.LegPool, Name: "LegPool", Type: FieldTypeString},
+				{Tag: tag.LegDatedDate, Name: "LegDatedDate", Type: FieldTypeString},
+				{Tag: tag.LegContractSettlMonth, Name: "LegContractSettlMonth", Type: FieldTypeString},
+				{Tag: tag.LegInterestAccrualDate, Name: "LegInterestAccrualDate", Type: FieldTypeString},
+			}},
+			{Tag: tag.AccountType, Name: "AccountType", Type: FieldTypeString},
+			{Tag: tag.TradingSessionSubID, Name: "TradingSessionSubID", Type: FieldTypeString},
+			{Tag: tag.QuoteStatusReqID, Name: "QuoteStatusReqID", Type: FieldTypeString},
+			{Tag: tag.AcctIDSource, Name: "AcctIDSource", Type: FieldTypeString},
+			{Tag: tag.ContractSettlMonth, Name: "ContractSettlMonth", Type: FieldTypeString},
+			{Tag: tag.Pool, Name: "Pool", Type: FieldTypeString},
+			{Tag: tag.NoUnderlyings, Name: "NoUnderlyings", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.UnderlyingSymbol, Name: "UnderlyingSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.UnderlyingSymbolSfx, Name: "UnderlyingSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityID, Name: "UnderlyingSecurityID", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityIDSource, Name: "UnderlyingSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoUnderlyingSecurityAltID, Name: "NoUnderlyingSecurityAltID", Type: FieldTypeNumInGroup, Fields: noUnderlyingSecurityAltIDFields},
+				{Tag: tag.UnderlyingProduct, Name: "UnderlyingProduct", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingCFICode, Name: "UnderlyingCFICode", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityType, Name: "UnderlyingSecurityType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecuritySubType, Name: "UnderlyingSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityMonthYear, Name: "UnderlyingMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityDate, Name: "UnderlyingMaturityDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCouponPaymentDate, Name: "UnderlyingCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssueDate, Name: "UnderlyingIssueDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRepoCollateralSecurityType, Name: "UnderlyingRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseTerm, Name: "UnderlyingRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseRate, Name: "UnderlyingRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingFactor, Name: "UnderlyingFactor", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCreditRating, Name: "UnderlyingCreditRating", Type: FieldTypeString},
+				{Tag: tag.UnderlyingInstrRegistry, Name: "UnderlyingInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCountryOfIssue, Name: "UnderlyingCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStateOrProvinceOfIssue, Name: "UnderlyingStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingLocaleOfIssue, Name: "UnderlyingLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRedemptionDate, Name: "UnderlyingRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStrikePrice, Name: "UnderlyingStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStrikeCurrency, Name: "UnderlyingStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingOptAttribute, Name: "UnderlyingOptAttribute", Type: FieldTypeString},
+				{Tag: tag.UnderlyingContractMultiplier, Name: "UnderlyingContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCouponRate, Name: "UnderlyingCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingSecurityExchange, Name: "UnderlyingSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssuer, Name: "UnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingIssuerLen, Name: "EncodedUnderlyingIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingIssuer, Name: "EncodedUnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityDesc, Name: "UnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingSecurityDescLen, Name: "EncodedUnderlyingSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingSecurityDesc, Name: "EncodedUnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPProgram, Name: "UnderlyingCPProgram", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPRegType, Name: "UnderlyingCPRegType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCurrency, Name: "UnderlyingCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingQty, Name: "UnderlyingQty", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingPx, Name: "UnderlyingPx", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingDirtyPrice, Name: "UnderlyingDirtyPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndPrice, Name: "UnderlyingEndPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStartValue, Name: "UnderlyingStartValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCurrentValue, Name: "UnderlyingCurrentValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndValue, Name: "UnderlyingEndValue", Type: FieldTypeDecimal},
+				{Tag: tag.NoUnderlyingStips, Name: "NoUnderlyingStips", Type: FieldTypeNumInGroup, Fields: noUnderlyingStipsFields},
+			}},
+			{Tag: tag.SecuritySubType, Name: "SecuritySubType", Type: FieldTypeString},
+			{Tag: tag.TerminationType, Name: "TerminationType", Type: FieldTypeString},
+			{Tag: tag.NoEvents, Name: "NoEvents", Type: FieldTypeNumInGroup, Fields: noEventsFields},
+			{Tag: tag.DatedDate, Name: "DatedDate", Type: FieldTypeString},
+			{Tag: tag.InterestAccrualDate, Name: "InterestAccrualDate", Type: FieldTypeString},
+			{Tag: tag.CPProgram, Name: "CPProgram", Type: FieldTypeString},
+			{Tag: tag.CPRegType, Name: "CPRegType", Type: FieldTypeString},
+			{Tag: tag.MarginRatio, Name: "MarginRatio", Type: FieldTypeDecimal},
+			{Tag: tag.AgreementDesc, Name: "AgreementDesc", Type: FieldTypeString},
+			{Tag: tag.AgreementID, Name: "AgreementID", Type: FieldTypeString},
+			{Tag: tag.AgreementDate, Name: "AgreementDate", Type: FieldTypeString},
+			{Tag: tag.StartDate, Name: "StartDate", Type: FieldTypeString},
+			{Tag: tag.EndDate, Name: "EndDate", Type: FieldTypeString},
+			{Tag: tag.AgreementCurrency, Name: "AgreementCurrency", Type: FieldTypeString},
+			{Tag: tag.DeliveryType, Name: "DeliveryType", Type: FieldTypeString},
+			{Tag: tag.StrikeCurrency, Name: "StrikeCurrency", Type: FieldTypeString},
+		},
+	},
+	"AH": {
+		Name:    "RFQRequest",
+		MsgType: "AH",
+		Fields: []FieldDef{
+			{Tag: tag.NoRelatedSym, Name: "NoRelatedSym", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.Symbol, Name: "Symbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.SymbolSfx, Name: "SymbolSfx", Type: FieldTypeString},
+				{Tag: tag.SecurityID, Name: "SecurityID", Type: FieldTypeString},
+				{Tag: tag.SecurityIDSource, Name: "SecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoSecurityAltID, Name: "NoSecurityAltID", Type: FieldTypeNumInGroup, Fields: noSecurityAltIDFields},
+				{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+				{Tag: tag.CFICode, Name: "CFICode", Type: FieldTypeString},
+				{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+				{Tag: tag.SecuritySubType, Name: "SecuritySubType", Type: FieldTypeString},
+				{Tag: tag.MaturityMonthYear, Name: "MaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.MaturityDate, Name: "MaturityDate", Type: FieldTypeString},
+				{Tag: tag.CouponPaymentDate, Name: "CouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.IssueDate, Name: "IssueDate", Type: FieldTypeString},
+				{Tag: tag.RepoCollateralSecurityType, Name: "RepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.RepurchaseTerm, Name: "RepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.RepurchaseRate, Name: "RepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.Factor, Name: "Factor", Type: FieldTypeDecimal},
+				{Tag: tag.CreditRating, Name: "CreditRating", Type: FieldTypeString},
+				{Tag: tag.InstrRegistry, Name: "InstrRegistry", Type: FieldTypeString},
+				{Tag: tag.CountryOfIssue, Name: "CountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.StateOrProvinceOfIssue, Name: "StateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.LocaleOfIssue, Name: "LocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.RedemptionDate, Name: "RedemptionDate", Type: FieldTypeString},
+				{Tag: tag.StrikePrice, Name: "StrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.StrikeCurrency, Name: "StrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.OptAttribute, Name: "OptAttribute", Type: FieldTypeString},
+				{Tag: tag.ContractMultiplier, Name: "ContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.CouponRate, Name: "CouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.SecurityExchange, Name: "SecurityExchange", Type: FieldTypeString},
+				{Tag: tag.Issuer, Name: "Issuer", Type: FieldTypeString},
+				{Tag: tag.EncodedIssuerLen, Name: "EncodedIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedIssuer, Name: "EncodedIssuer", Type: FieldTypeString},
+				{Tag: tag.SecurityDesc, Name: "SecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedSecurityDescLen, Name: "EncodedSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedSecurityDesc, Name: "EncodedSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.Pool, Name: "Pool", Type: FieldTypeString},
+				{Tag: tag.ContractSettlMonth, Name: "ContractSettlMonth", Type: FieldTypeString},
+				{Tag: tag.CPProgram, Name: "CPProgram", Type: FieldTypeString},
+				{Tag: tag.CPRegType, Name: "CPRegType", Type: FieldTypeString},
+				{Tag: tag.NoEvents, Name: "NoEvents", Type: FieldTypeNumInGroup, Fields: noEventsFields},
+				{Tag: tag.DatedDate, Name: "DatedDate", Type: FieldTypeString},
+				{Tag: tag.InterestAccrualDate, Name: "InterestAccrualDate", Type: FieldTypeString},
+				{Tag: tag.NoUnderlyings, Name: "NoUnderlyings", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+					{Tag: tag.UnderlyingSymbol, Name: "UnderlyingSymbol", Type: FieldTypeString, Required: true},
+					{Tag: tag.UnderlyingSymbolSfx, Name: "UnderlyingSymbolSfx", Type: FieldTypeString},
+					{Tag: tag.UnderlyingSecurityID, Name: "UnderlyingSecurityID", Type: FieldTypeString},
+					{Tag: tag.UnderlyingSecurityIDSource, Name: "UnderlyingSecurityIDSource", Type: FieldTypeString},
+					{Tag: tag.NoUnderlyingSecurityAltID, Name: "NoUnderlyingSecurityAltID", Type: FieldTypeNumInGroup, Fields: noUnderlyingSecurityAltIDFields},
+					{Tag: tag.UnderlyingProduct, Name: "UnderlyingProduct", Type: FieldTypeInt},
+					{Tag: tag.UnderlyingCFICode, Name: "UnderlyingCFICode", Type: FieldTypeString},
+					{Tag: tag.UnderlyingSecurityType, Name: "UnderlyingSecurityType", Type: FieldTypeString},
+					{Tag: tag.UnderlyingSecuritySubType, Name: "UnderlyingSecuritySubType", Type: FieldTypeString},
+					{Tag: tag.UnderlyingMaturityMonthYear, Name: "UnderlyingMaturityMonthYear", Type: FieldTypeString},
+					{Tag: tag.UnderlyingMaturityDate, Name: "UnderlyingMaturityDate", Type: FieldTypeString},
+					{Tag: tag.UnderlyingCouponPaymentDate, Name: "UnderlyingCouponPaymentDate", Type: FieldTypeString},
+					{Tag: tag.UnderlyingIssueDate, Name: "UnderlyingIssueDate", Type: FieldTypeString},
+					{Tag: tag.UnderlyingRepoCollateralSecurityType, Name: "UnderlyingRepoCollateralSecurityType", Type: FieldTypeInt},
+					{Tag: tag.UnderlyingRepurchaseTerm, Name: "UnderlyingRepurchaseTerm", Type: FieldTypeInt},
+					{Tag: tag.UnderlyingRepurchaseRate, Name: "UnderlyingRepurchaseRate", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingFactor, Name: "UnderlyingFactor", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingCreditRating, Name: "UnderlyingCreditRating", Type: FieldTypeString},
+					{Tag: tag.UnderlyingInstrRegistry, Name: "UnderlyingInstrRegistry", Type: FieldTypeString},
+					{Tag: tag.UnderlyingCountryOfIssue, Name: "UnderlyingCountryOfIssue", Type: FieldTypeString},
+					{Tag: tag.UnderlyingStateOrProvinceOfIssue, Name: "UnderlyingStateOrProvinceOfIssue", Type: FieldTypeString},
+					{Tag: tag.UnderlyingLocaleOfIssue, Name: "UnderlyingLocaleOfIssue", Type: FieldTypeString},
+					{Tag: tag.UnderlyingRedemptionDate, Name: "UnderlyingRedemptionDate", Type: FieldTypeString},
+					{Tag: tag.UnderlyingStrikePrice, Name: "UnderlyingStrikePrice", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingStrikeCurrency, Name: "UnderlyingStrikeCurrency", Type: FieldTypeString},
+					{Tag: tag.UnderlyingOptAttribute, Name: "UnderlyingOptAttribute", Type: FieldTypeString},
+					{Tag: tag.UnderlyingContractMultiplier, Name: "UnderlyingContractMultiplier", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingCouponRate, Name: "UnderlyingCouponRate", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingSecurityExchange, Name: "UnderlyingSecurityExchange", Type: FieldTypeString},
+					{Tag: tag.UnderlyingIssuer, Name: "UnderlyingIssuer", Type: FieldTypeString},
+					{Tag: tag.EncodedUnderlyingIssuerLen, Name: "EncodedUnderlyingIssuerLen", Type: FieldTypeInt},
+					{Tag: tag.EncodedUnderlyingIssuer, Name: "EncodedUnderlyingIssuer", Type: FieldTypeString},
+					{Tag: tag.UnderlyingSecurityDesc, Name: "UnderlyingSecurityDesc", Type: FieldTypeString},
+					{Tag: tag.EncodedUnderlyingSecurityDescLen, Name: "EncodedUnderlyingSecurityDescLen", Type: FieldTypeInt},
+					{Tag: tag.EncodedUnderlyingSecurityDesc, Name: "EncodedUnderlyingSecurityDesc", Type: FieldTypeString},
+					{Tag: tag.UnderlyingCPProgram, Name: "UnderlyingCPProgram", Type: FieldTypeString},
+					{Tag: tag.UnderlyingCPRegType, Name: "UnderlyingCPRegType", Type: FieldTypeString},
+					{Tag: tag.UnderlyingCurrency, Name: "UnderlyingCurrency", Type: FieldTypeString},
+					{Tag: tag.UnderlyingQty, Name: "UnderlyingQty", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingPx, Name: "UnderlyingPx", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingDirtyPrice, Name: "UnderlyingDirtyPrice", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingEndPrice, Name: "UnderlyingEndPrice", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingStartValue, Name: "UnderlyingStartValue", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingCurrentValue, Name: "UnderlyingCurrentValue", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingEndValue, Name: "UnderlyingEndValue", Type: FieldTypeDecimal},
+					{Tag: tag.NoUnderlyingStips, Name: "NoUnderlyingStips", Type: FieldTypeNumInGroup, Fields: noUnderlyingStipsFields},
+				}},
+				{Tag: tag.NoLegs, Name: "NoLegs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+					{Tag: tag.LegSymbol, Name: "LegSymbol", Type: FieldTypeString, Required: true},
+					{Tag: tag.LegSymbolSfx, Name: "LegSymbolSfx", Type: FieldTypeString},
+					{Tag: tag.LegSecurityID, Name: "LegSecurityID", Type: FieldTypeString},
+					{Tag: tag.LegSecurityIDSource, Name: "LegSecurityIDSource", Type: FieldTypeString},
+					{Tag: tag.NoLegSecurityAltID, Name: "NoLegSecurityAltID", Type: FieldTypeNumInGroup, Fields: noLegSecurityAltIDFields},
+					{Tag: tag.LegProduct, Name: "LegProduct", Type: FieldTypeInt},
+					{Tag: tag.LegCFICode, Name: "LegCFICode", Type: FieldTypeString},
+					{Tag: tag.LegSecurityType, Name: "LegSecurityType", Type: FieldTypeString},
+					{Tag: tag.LegSecuritySubType, Name: "LegSecuritySubType", Type: FieldTypeString},
+					{Tag: tag.LegMaturityMonthYear, Name: "LegMaturityMonthYear", Type: FieldTypeString},
+					{Tag: tag.LegMaturityDate, Name: "LegMaturityDate", Type: FieldTypeString},
+					{Tag: tag.LegCouponPaymentDate, Name: "LegCouponPaymentDate", Type: FieldTypeString},
+					{Tag: tag.LegIssueDate, Name: "LegIssueDate", Type: FieldTypeString},
+					{Tag: tag.LegRepoCollateralSecurityType, Name: "LegRepoCollateralSecurityType", Type: FieldTypeInt},
+					{Tag: tag.LegRepurchaseTerm, Name: "LegRepurchaseTerm", Type: FieldTypeInt},
+					{Tag: tag.LegRepurchaseRate, Name: "LegRepurchaseRate", Type: FieldTypeDecimal},
+					{Tag: tag.LegFactor, Name: "LegFactor", Type: FieldTypeDecimal},
+					{Tag: tag.LegCreditRating, Name: "LegCreditRating", Type: FieldTypeString},
+					{Tag: tag.LegInstrRegistry, Name: "LegInstrRegistry", Type: FieldTypeString},
+					{Tag: tag.LegCountryOfIssue, Name: "LegCountryOfIssue", Type: FieldTypeString},
+					{Tag: tag.LegStateOrProvinceOfIssue, Name: "LegStateOrProvinceOfIssue", Type: FieldTypeString},
+					{Tag: tag.LegLocaleOfIssue, Name: "LegLocaleOfIssue", Type: FieldTypeString},
+					{Tag: tag.LegRedemptionDate, Name: "LegRedemptionDate", Type: FieldTypeString},
+					{Tag: tag.LegStrikePrice, Name: "LegStrikePrice", Type: FieldTypeDecimal},
+					{Tag: tag.LegStrikeCurrency, Name: "LegStrikeCurrency", Type: FieldTypeString},
+					{Tag: tag.LegOptAttribute, Name: "LegOptAttribute", Type: FieldTypeString},
+					{Tag: tag.LegContractMultiplier, Name: "LegContractMultiplier", Type: FieldTypeDecimal},
+					{Tag: tag.LegCouponRate, Name: "LegCouponRate", Type: FieldTypeDecimal},
+					{Tag: tag.LegSecurityExchange, Name: "LegSecurityExchange", Type: FieldTypeString},
+					{Tag: tag.LegIssuer, Name: "LegIssuer", Type: FieldTypeString},
+					{Tag: tag.EncodedLegIssuerLen, Name: "EncodedLegIssuerLen", Type: FieldTypeInt},
+					{Tag: tag.EncodedLegIssuer, Name: "EncodedLegIssuer", Type: FieldTypeString},
+					{Tag: tag.LegSecurityDesc, Name: "LegSecurityDesc", Type: FieldTypeString},
+					{Tag: tag.EncodedLegSecurityDescLen, Name: "EncodedLegSecurityDescLen", Type: FieldTypeInt},
+					{Tag: tag.EncodedLegSecurityDesc, Name: "EncodedLegSecurityDesc", Type: FieldTypeString},
+					{Tag: tag.LegRatioQty, Name: "LegRatioQty", Type: FieldTypeDecimal},
+					{Tag: tag.LegSide, Name: "LegSide", Type: FieldTypeString},
+					{Tag: tag.LegCurrency, Name: "LegCurrency", Type: FieldTypeString},
+					{Tag: tag.LegPool, Name: "LegPool", Type: FieldTypeString},
+					{Tag: tag.LegDatedDate, Name: "LegDatedDate", Type: FieldTypeString},
+					{Tag: tag.LegContractSettlMonth, Name: "LegContractSettlMonth", Type: FieldTypeString},
+					{Tag: tag.LegInterestAccrualDate, Name: "LegInterestAccrualDate", Type: FieldTypeString},
+				}},
+				{Tag: tag.PrevClosePx, Name: "PrevClosePx", Type: FieldTypeDecimal},
+				{Tag: tag.QuoteRequestType, Name: "QuoteRequestType", Type: FieldTypeString},
+				{Tag: tag.QuoteType, Name: "QuoteType", Type: FieldTypeString},
+				{Tag: tag.TradingSessionID, Name: "TradingSessionID", Type: FieldTypeString},
+				{Tag: tag.TradingSessionSubID, Name: "TradingSessionSubID", Type: FieldTypeString},
+			}},
+			{Tag: tag.SubscriptionRequestType, Name: "SubscriptionRequestType", Type: FieldTypeString},
+			{Tag: tag.RFQReqID, Name: "RFQReqID", Type: FieldTypeString, Required: true},
+		},
+	},
+	"o": {
+		Name:    "RegistrationInstructions",
+		MsgType: "o",
+		Fields: []FieldDef{
+			{Tag: tag.Account, Name: "Account", Type: FieldTypeString},
+			{Tag: tag.ClOrdID, Name: "ClOrdID", Type: FieldTypeString},
+			{Tag: tag.NoPartyIDs, Name: "NoPartyIDs", Type: FieldTypeNumInGroup, Fields: noPartyIDsFields},
+			{Tag: tag.NoRegistDtls, Name: "NoRegistDtls", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.RegistDtls, Name: "RegistDtls", Type: FieldTypeString, Required: true},
+				{Tag: tag.RegistEmail, Name: "RegistEmail", Type: FieldTypeString},
+				{Tag: tag.MailingDtls, Name: "MailingDtls", Type: FieldTypeString},
+				{Tag: tag.MailingInst, Name: "MailingInst", Type: FieldTypeString},
+				{Tag: tag.NoNestedPartyIDs, Name: "NoNestedPartyIDs", Type: FieldTypeNumInGroup, Fields: noNestedPartyIDsFields},
+				{Tag: tag.OwnerType, Name: "OwnerType", Type: FieldTypeString},
+				{Tag: tag.DateOfBirth, Name: "DateOfBirth", Type: FieldTypeString},
+				{Tag: tag.InvestorCountryOfResidence, Name: "InvestorCountryOfResidence", Type: FieldTypeString},
+			}},
+			{Tag: tag.RegistAcctType, Name: "RegistAcctType", Type: FieldTypeString},
+			{Tag: tag.TaxAdvantageType, Name: "TaxAdvantageType", Type: FieldTypeString},
+			{Tag: tag.RegistRefID, Name: "RegistRefID", Type: FieldTypeString, Required: true},
+			{Tag: tag.NoDistribInsts, Name: "NoDistribInsts", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.DistribPaymentMethod, Name: "DistribPaymentMethod", Type: FieldTypeString, Required: true},
+				{Tag: tag.DistribPercentage, Name: "DistribPercentage", Type: FieldTypeDecimal},
+				{Tag: tag.CashDistribCurr, Name: "CashDistribCurr", Type: FieldTypeString},
+				{Tag: tag.CashDistribAgentName, Name: "CashDistribAgentName", Type: FieldTypeString},
+				{Tag: tag.CashDistribAgentCode, Name: "CashDistribAgentCode", Type: FieldTypeString},
+				{Tag: tag.CashDistribAgentAcctNumber, Name: "CashDistribAgentAcctNumber", Type: FieldTypeString},
+				{Tag: tag.CashDistribPayRef, Name: "CashDistribPayRef", Type: FieldTypeString},
+				{Tag: tag.CashDistribAgentAcctName, Name: "CashDistribAgentAcctName", Type: FieldTypeString},
+			}},
+			{Tag: tag.RegistID, Name: "RegistID", Type: FieldTypeString, Required: true},
+			{Tag: tag.RegistTransType, Name: "RegistTransType", Type: FieldTypeString, Required: true},
+			{Tag: tag.OwnershipType, Name: "OwnershipType", Type: FieldTypeString},
+			{Tag: tag.AcctIDSource, Name: "AcctIDSource", Type: FieldTypeString},
+		},
+	},
+	"p": {
+		Name:    "RegistrationInstructionsResponse",
+		MsgType: "p",
+		Fields: []FieldDef{
+			{Tag: tag.Account, Name: "Account", Type: FieldTypeString},
+			{Tag: tag.ClOrdID, Name: "ClOrdID", Type: FieldTypeString},
+			{Tag: tag.NoPartyIDs, Name: "NoPartyIDs", Type: FieldTypeNumInGroup, Fields: noPartyIDsFields},
+			{Tag: tag.RegistRejReasonText, Name: "RegistRejReasonText", Type: FieldTypeString},
+			{Tag: tag.RegistStatus, Name: "RegistStatus", Type: FieldTypeString, Required: true},
+			{Tag: tag.RegistRejReasonCode, Name: "RegistRejReasonCode", Type: FieldTypeString},
+			{Tag: tag.RegistRefID, Name: "RegistRefID", Type: FieldTypeString, Required: true},
+			{Tag: tag.RegistID, Name: "RegistID", Type: FieldTypeString, Required: true},
+			{Tag: tag.RegistTransType, Name: "RegistTransType", Type: FieldTypeString, Required: true},
+			{Tag: tag.AcctIDSource, Name: "AcctIDSource", Type: FieldTypeString},
+		},
+	},
+	"3": {
+		Name:    "Reject",
+		MsgType: "3",
+		Fields: []FieldDef{
+			{Tag: tag.RefSeqNum, Name: "RefSeqNum", Type: FieldTypeInt, Required: true},
+			{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+			{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			{Tag: tag.RefTagID, Name: "RefTagID", Type: FieldTypeInt},
+			{Tag: tag.RefMsgType, Name: "RefMsgType", Type: FieldTypeString},
+			{Tag: tag.SessionRejectReason, Name: "SessionRejectReason", Type: FieldTypeString},
+		},
+	},
+	"AN": {
+		Name:    "RequestForPositions",
+		MsgType: "AN",
+		Fields: []FieldDef{
+			{Tag: tag.Account, Name: "Account", Type: FieldTypeString, Required: true},
+			{Tag: tag.Currency, Name: "Currency", Type: FieldTypeString},
+			{Tag: tag.SecurityIDSource, Name: "SecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.SecurityID, Name: "SecurityID", Type: FieldTypeString},
+			{Tag: tag.Symbol, Name: "Symbol", Type: FieldTypeString},
+			{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+			{Tag: tag.TransactTime, Name: "TransactTime", Type: FieldTypeUTCTimestamp, Required: true},
+			{Tag: tag.SymbolSfx, Name: "SymbolSfx", Type: FieldTypeString},
+			{Tag: tag.Issuer, Name: "Issuer", Type: FieldTypeString},
+			{Tag: tag.SecurityDesc, Name: "SecurityDesc", Type: FieldTypeString},
+			{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+			{Tag: tag.MaturityMonthYear, Name: "MaturityMonthYear", Type: FieldTypeString},
+			{Tag: tag.StrikePrice, Name: "StrikePrice", Type: FieldTypeDecimal},
+			{Tag: tag.OptAttribute, Name: "OptAttribute", Type: FieldTypeString},
+			{Tag: tag.SecurityExchange, Name: "SecurityExchange", Type: FieldTypeString},
+			{Tag: tag.CouponRate, Name: "CouponRate", Type: FieldTypeDecimal},
+			{Tag: tag.CouponPaymentDate, Name: "CouponPaymentDate", Type: FieldTypeString},
+			{Tag: tag.IssueDate, Name: "IssueDate", Type: FieldTypeString},
+			{Tag: tag.RepurchaseTerm, Name: "RepurchaseTerm", Type: FieldTypeInt},
+			{Tag: tag.RepurchaseRate, Name: "RepurchaseRate", Type: FieldTypeDecimal},
+			{Tag: tag.Factor, Name: "Factor", Type: FieldTypeDecimal},
+			{Tag: tag.ContractMultiplier, Name: "ContractMultiplier", Type: FieldTypeDecimal},
+			{Tag: tag.RepoCollateralSecurityType, Name: "RepoCollateralSecurityType", Type: FieldTypeInt},
+			{Tag: tag.RedemptionDate, Name: "RedemptionDate", Type: FieldTypeString},
+			{Tag: tag.CreditRating, Name: "CreditRating", Type: FieldTypeString},
+			{Tag: tag.SubscriptionRequestType, Name: "SubscriptionRequestType", Type: FieldTypeString},
+			{Tag: tag.EncodedIssuerLen, Name: "EncodedIssuerLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedIssuer, Name: "EncodedIssuer", Type: FieldTypeString},
+			{Tag: tag.EncodedSecurityDescLen, Name: "EncodedSecurityDescLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedSecurityDesc, Name: "EncodedSecurityDesc", Type: FieldTypeString},
+			{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			{Tag: tag.NoTradingSessions, Name: "NoTradingSessions", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.TradingSessionID, Name: "TradingSessionID", Type: FieldTypeString, Required: true},
+				{Tag: tag.TradingSessionSubID, Name: "TradingSessionSubID", Type: FieldTypeString},
+			}},
+			{Tag: tag.NoPartyIDs, Name: "NoPartyIDs", Type: FieldTypeNumInGroup, Fields: noPartyIDsFields},
+			{Tag: tag.NoSecurityAltID, Name: "NoSecurityAltID", Type: FieldTypeNumInGroup, Fields: noSecurityAltIDFields},
+			{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+			{Tag: tag.CFICode, Name: "CFICode", Type: FieldTypeString},
+			{Tag: tag.CountryOfIssue, Name: "CountryOfIssue", Type: FieldTypeString},
+			{Tag: tag.StateOrProvinceOfIssue, Name: "StateOrProvinceOfIssue", Type: FieldTypeString},
+			{Tag: tag.LocaleOfIssue, Name: "LocaleOfIssue", Type: FieldTypeString},
+			{Tag: tag.MaturityDate, Name: "MaturityDate", Type: FieldTypeString},
+			{Tag: tag.InstrRegistry, Name: "InstrRegistry", Type: FieldTypeString},
+			{Tag: tag.NoLegs, Name: "NoLegs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.LegSymbol, Name: "LegSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.LegSymbolSfx, Name: "LegSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.LegSecurityID, Name: "LegSecurityID", Type: FieldTypeString},
+				{Tag: tag.LegSecurityIDSource, Name: "LegSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoLegSecurityAltID, Name: "NoLegSecurityAltID", Type: FieldTypeNumInGroup, Fields: noLegSecurityAltIDFields},
+				{Tag: tag.LegProduct, Name: "LegProduct", Type: FieldTypeInt},
+				{Tag: tag.LegCFICode, Name: "LegCFICode", Type: FieldTypeString},
+				{Tag: tag.LegSecurityType, Name: "LegSecurityType", Type: FieldTypeString},
+				{Tag: tag.LegSecuritySubType, Name: "LegSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.LegMaturityMonthYear, Name: "LegMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.LegMaturityDate, Name: "LegMaturityDate", Type: FieldTypeString},
+				{Tag: tag.LegCouponPaymentDate, Name: "LegCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.LegIssueDate, Name: "LegIssueDate", Type: FieldTypeString},
+				{Tag: tag.LegRepoCollateralSecurityType, Name: "LegRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseTerm, Name: "LegRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseRate, Name: "LegRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegFactor, Name: "LegFactor", Type: FieldTypeDecimal},
+				{Tag: tag.LegCreditRating, Name: "LegCreditRating", Type: FieldTypeString},
+				{Tag: tag.LegInstrRegistry, Name: "LegInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.LegCountryOfIssue, Name: "LegCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegStateOrProvinceOfIssue, Name: "LegStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegLocaleOfIssue, Name: "LegLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegRedemptionDate, Name: "LegRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.LegStrikePrice, Name: "LegStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.LegStrikeCurrency, Name: "LegStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.LegOptAttribute, Name: "LegOptAttribute", Type: FieldTypeString},
+				{Tag: tag.LegContractMultiplier, Name: "LegContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.LegCouponRate, Name: "LegCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegSecurityExchange, Name: "LegSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.LegIssuer, Name: "LegIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedLegIssuerLen, Name: "EncodedLegIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegIssuer, Name: "EncodedLegIssuer", Type: FieldTypeString},
+				{Tag: tag.LegSecurityDesc, Name: "LegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedLegSecurityDescLen, Name: "EncodedLegSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegSecurityDesc, Name: "EncodedLegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.LegRatioQty, Name: "LegRatioQty", Type: FieldTypeDecimal},
+				{Tag: tag.LegSide, Name: "LegSide", Type: FieldTypeString},
+				{Tag: tag.LegCurrency, Name: "LegCurrency", Type: FieldTypeString},
+				{Tag: tag.LegPool, Name: "LegPool", Type: FieldTypeString},
+				{Tag: tag.LegDatedDate, Name: "LegDatedDate", Type: FieldTypeString},
+				{Tag: tag.LegContractSettlMonth, Name: "LegContractSettlMonth", Type: FieldTypeString},
+				{Tag: tag.LegInterestAccrualDate, Name: "LegInterestAccrualDate", Type: FieldTypeString},
+			}},
+			{Tag: tag.MatchStatus, Name: "MatchStatus", Type: FieldTypeString},
+			{Tag: tag.AccountType, Name: "AccountType", Type: FieldTypeString, Required: true},
+			{Tag: tag.AcctIDSource, Name: "AcctIDSource", Type: FieldTypeString},
+			{Tag: tag.ContractSettlMonth, Name: "ContractSettlMonth", Type: FieldTypeString},
+			{Tag: tag.Pool, Name: "Pool", Type: FieldTypeString},
+			{Tag: tag.PosReqID, Name: "PosReqID", Type: FieldTypeString, Required: true},
+			{Tag: tag.NoUnderlyings, Name: "NoUnderlyings", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.UnderlyingSymbol, Name: "UnderlyingSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.UnderlyingSymbolSfx, Name: "UnderlyingSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityID, Name: "UnderlyingSecurityID", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityIDSource, Name: "UnderlyingSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoUnderlyingSecurityAltID, Name: "NoUnderlyingSecurityAltID", Type: FieldTypeNumInGroup, Fields: noUnderlyingSecurityAltIDFields},
+				{Tag: tag.UnderlyingProduct, Name: "UnderlyingProduct", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingCFICode, Name: "UnderlyingCFICode", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityType, Name: "UnderlyingSecurityType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecuritySubType, Name: "UnderlyingSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityMonthYear, Name: "UnderlyingMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityDate, Name: "UnderlyingMaturityDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCouponPaymentDate, Name: "UnderlyingCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssueDate, Name: "UnderlyingIssueDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRepoCollateralSecurityType, Name: "UnderlyingRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseTerm, Name: "UnderlyingRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseRate, Name: "UnderlyingRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingFactor, Name: "UnderlyingFactor", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCreditRating, Name: "UnderlyingCreditRating", Type: FieldTypeString},
+				{Tag: tag.UnderlyingInstrRegistry, Name: "UnderlyingInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCountryOfIssue, Name: "UnderlyingCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStateOrProvinceOfIssue, Name: "UnderlyingStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingLocaleOfIssue, Name: "UnderlyingLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRedemptionDate, Name: "UnderlyingRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStrikePrice, Name: "UnderlyingStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStrikeCurrency, Name: "UnderlyingStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingOptAttribute, Name: "UnderlyingOptAttribute", Type: FieldTypeString},
+				{Tag: tag.UnderlyingContractMultiplier, Name: "UnderlyingContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCouponRate, Name: "UnderlyingCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingSecurityExchange, Name: "UnderlyingSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssuer, Name: "UnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingIssuerLen, Name: "EncodedUnderlyingIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingIssuer, Name: "EncodedUnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityDesc, Name: "UnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingSecurityDescLen, Name: "EncodedUnderlyingSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingSecurityDesc, Name: "EncodedUnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPProgram, Name: "UnderlyingCPProgram", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPRegType, Name: "UnderlyingCPRegType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCurrency, Name: "UnderlyingCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingQty, Name: "UnderlyingQty", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingPx, Name: "UnderlyingPx", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingDirtyPrice, Name: "UnderlyingDirtyPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndPrice, Name: "UnderlyingEndPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStartValue, Name: "UnderlyingStartValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCurrentValue, Name: "UnderlyingCurrentValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndValue, Name: "UnderlyingEndValue", Type: FieldTypeDecimal},
+				{Tag: tag.NoUnderlyingStips, Name: "NoUnderlyingStips", Type: FieldTypeNumInGroup, Fields: noUnderlyingStipsFields},
+			}},
+			{Tag: tag.ClearingBusinessDate, Name: "ClearingBusinessDate", Type: FieldTypeString, Required: true},
+			{Tag: tag.SettlSessID, Name: "SettlSessID", Type: FieldTypeString},
+			{Tag: tag.SettlSessSubID, Name: "SettlSessSubID", Type: FieldTypeString},
+			{Tag: tag.PosReqType, Name: "PosReqType", Type: FieldTypeString, Required: true},
+			{Tag: tag.ResponseTransportType, Name: "ResponseTransportType", Type: FieldTypeString},
+			{Tag: tag.ResponseDestination, Name: "ResponseDestination", Type: FieldTypeString},
+			{Tag: tag.SecuritySubType, Name: "SecuritySubType", Type: FieldTypeString},
+			{Tag: tag.NoEvents, Name: "NoEvents", Type: FieldTypeNumInGroup, Fields: noEventsFields},
+			{Tag: tag.DatedDate, Name: "DatedDate", Type: FieldTypeString},
+			{Tag: tag.InterestAccrualDate, Name: "InterestAccrualDate", Type: FieldTypeString},
+			{Tag: tag.CPProgram, Name: "CPProgram", Type: FieldTypeString},
+			{Tag: tag.CPRegType, Name: "CPRegType", Type: FieldTypeString},
+			{Tag: tag.StrikeCurrency, Name: "StrikeCurrency", Type: FieldTypeString},
+		},
+	},
+	"AO": {
+		Name:    "RequestForPositionsAck",
+		MsgType: "AO",
+		Fields: []FieldDef{
+			{Tag: tag.Account, Name: "Account", Type: FieldTypeString, Required: true},
+			{Tag: tag.Currency, Name: "Currency", Type: FieldTypeString},
+			{Tag: tag.SecurityIDSource, Name: "SecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.SecurityID, Name: "SecurityID", Type: FieldTypeString},
+			{Tag: tag.Symbol, Name: "Symbol", Type: FieldTypeString},
+			{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+			{Tag: tag.SymbolSfx, Name: "SymbolSfx", Type: FieldTypeString},
+			{Tag: tag.Issuer, Name: "Issuer", Type: FieldTypeString},
+			{Tag: tag.SecurityDesc, Name: "SecurityDesc", Type: FieldTypeString},
+			{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+			{Tag: tag.MaturityMonthYear, Name: "MaturityMonthYear", Type: FieldTypeString},
+			{Tag: tag.StrikePrice, Name: "StrikePrice", Type: FieldTypeDecimal},
+			{Tag: tag.OptAttribute, Name: "OptAttribute", Type: FieldTypeString},
+			{Tag: tag.SecurityExchange, Name: "SecurityExchange", Type: FieldTypeString},
+			{Tag: tag.CouponRate, Name: "CouponRate", Type: FieldTypeDecimal},
+			{Tag: tag.CouponPaymentDate, Name: "CouponPaymentDate", Type: FieldTypeString},
+			{Tag: tag.IssueDate, Name: "IssueDate", Type: FieldTypeString},
+			{Tag: tag.RepurchaseTerm, Name: "RepurchaseTerm", Type: FieldTypeInt},
+			{Tag: tag.RepurchaseRate, Name: "RepurchaseRate", Type: FieldTypeDecimal},
+			{Tag: tag.Factor, Name: "Factor", Type: FieldTypeDecimal},
+			{Tag: tag.ContractMultiplier, Name: "ContractMultiplier", Type: FieldTypeDecimal},
+			{Tag: tag.RepoCollateralSecurityType, Name: "RepoCollateralSecurityType", Type: FieldTypeInt},
+			{Tag: tag.RedemptionDate, Name: "RedemptionDate", Type: FieldTypeString},
+			{Tag: tag.CreditRating, Name: "CreditRating", Type: FieldTypeString},
+			{Tag: tag.UnsolicitedIndicator, Name: "UnsolicitedIndicator", Type: FieldTypeBool},
+			{Tag: tag.EncodedIssuerLen, Name: "EncodedIssuerLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedIssuer, Name: "EncodedIssuer", Type: FieldTypeString},
+			{Tag: tag.EncodedSecurityDescLen, Name: "EncodedSecurityDescLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedSecurityDesc, Name: "EncodedSecurityDesc", Type: FieldTypeString},
+			{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			{Tag: tag.NoPartyIDs, Name: "NoPartyIDs", Type: FieldTypeNumInGroup, Fields: noPartyIDsFields},
+			{Tag: tag.NoSecurityAltID, Name: "NoSecurityAltID", Type: FieldTypeNumInGroup, Fields: noSecurityAltIDFields},
+			{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+			{Tag: tag.CFICode, Name: "CFICode", Type: FieldTypeString},
+			{Tag: tag.CountryOfIssue, Name: "CountryOfIssue", Type: FieldTypeString},
+			{Tag: tag.StateOrProvinceOfIssue, Name: "StateOrProvinceOfIssue", Type: FieldTypeString},
+			{Tag: tag.LocaleOfIssue, Name: "LocaleOfIssue", Type: FieldTypeString},
+			{Tag: tag.MaturityDate, Name: "MaturityDate", Type: FieldTypeString},
+			{Tag: tag.InstrRegistry, Name: "InstrRegistry", Type: FieldTypeString},
+			{Tag: tag.NoLegs, Name: "NoLegs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.LegSymbol, Name: "LegSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.LegSymbolSfx, Name: "LegSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.LegSecurityID, Name: "LegSecurityID", Type: FieldTypeString},
+				{Tag: tag.LegSecurityIDSource, Name: "LegSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoLegSecurityAltID, Name: "NoLegSecurityAltID", Type: FieldTypeNumInGroup, Fields: noLegSecurityAltIDFields},
+				{Tag: tag.LegProduct, Name: "LegProduct", Type: FieldTypeInt},
+				{Tag: tag.LegCFICode, Name: "LegCFICode", Type: FieldTypeString},
+				{Tag: tag.LegSecurityType, Name: "LegSecurityType", Type: FieldTypeString},
+				{Tag: tag.LegSecuritySubType, Name: "LegSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.LegMaturityMonthYear, Name: "LegMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.LegMaturityDate, Name: "LegMaturityDate", Type: FieldTypeString},
+				{Tag: tag.LegCouponPaymentDate, Name: "LegCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.LegIssueDate, Name: "LegIssueDate", Type: FieldTypeString},
+				{Tag: tag.LegRepoCollateralSecurityType, Name: "LegRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseTerm, Name: "LegRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseRate, Name: "LegRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegFactor, Name: "LegFactor", Type: FieldTypeDecimal},
+				{Tag: tag.LegCreditRating, Name: "LegCreditRating", Type: FieldTypeString},
+				{Tag: tag.LegInstrRegistry, Name: "LegInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.LegCountryOfIssue, Name: "LegCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegStateOrProvinceOfIssue, Name: "LegStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegLocaleOfIssue, Name: "LegLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegRedemptionDate, Name: "LegRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.LegStrikePrice, Name: "LegStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.LegStrikeCurrency, Name: "LegStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.LegOptAttribute, Name: "LegOptAttribute", Type: FieldTypeString},
+				{Tag: tag.LegContractMultiplier, Name: "LegContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.LegCouponRate, Name: "LegCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegSecurityExchange, Name: "LegSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.LegIssuer, Name: "LegIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedLegIssuerLen, Name: "EncodedLegIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegIssuer, Name: "EncodedLegIssuer", Type: FieldTypeString},
+				{Tag: tag.LegSecurityDesc, Name: "LegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedLegSecurityDescLen, Name: "EncodedLegSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegSecurityDesc, Name: "EncodedLegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.LegRatioQty, Name: "LegRatioQty", Type: FieldTypeDecimal},
+				{Tag: tag.LegSide, Name: "LegSide", Type: FieldTypeString},
+				{Tag: tag.LegCurrency, Name: "LegCurrency", Type: FieldTypeString},
+				{Tag: tag.LegPool, Name: "LegPool", Type: FieldTypeString},
+				{Tag: tag.LegDatedDate, Name: "LegDatedDate", Type: FieldTypeString},
+				{Tag: tag.LegContractSettlMonth, Name: "LegContractSettlMonth", Type: FieldTypeString},
+				{Tag: tag.LegInterestAccrualDate, Name: "LegInterestAccrualDate", Type: FieldTypeString},
+			}},
+			{Tag: tag.AccountType, Name: "AccountType", Type: FieldTypeString, Required: true},
+			{Tag: tag.AcctIDSource, Name: "AcctIDSource", Type: FieldTypeString},
+			{Tag: tag.ContractSettlMonth, Name: "ContractSettlMonth", Type: FieldTypeString},
+			{Tag: tag.Pool, Name: "Pool", Type: FieldTypeString},
+			{Tag: tag.PosReqID, Name: "PosReqID", Type: FieldTypeString},
+			{Tag: tag.NoUnderlyings, Name: "NoUnderlyings", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.UnderlyingSymbol, Name: "UnderlyingSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.UnderlyingSymbolSfx, Name: "UnderlyingSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityID, Name: "UnderlyingSecurityID", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityIDSource, Name: "UnderlyingSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoUnderlyingSecurityAltID, Name: "NoUnderlyingSecurityAltID", Type: FieldTypeNumInGroup, Fields: noUnderlyingSecurityAltIDFields},
+				{Tag: tag.UnderlyingProduct, Name: "UnderlyingProduct", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingCFICode, Name: "UnderlyingCFICode", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityType, Name: "UnderlyingSecurityType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecuritySubType, Name: "UnderlyingSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityMonthYear, Name: "UnderlyingMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityDate, Name: "UnderlyingMaturityDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCouponPaymentDate, Name: "UnderlyingCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssueDate, Name: "UnderlyingIssueDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRepoCollateralSecurityType, Name: "UnderlyingRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseTerm, Name: "UnderlyingRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseRate, Name: "UnderlyingRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingFactor, Name: "UnderlyingFactor", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCreditRating, Name: "UnderlyingCreditRating", Type: FieldTypeString},
+				{Tag: tag.UnderlyingInstrRegistry, Name: "UnderlyingInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCountryOfIssue, Name: "UnderlyingCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStateOrProvinceOfIssue, Name: "UnderlyingStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingLocaleOfIssue, Name: "UnderlyingLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRedemptionDate, Name: "UnderlyingRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStrikePrice, Name: "UnderlyingStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStrikeCurrency, Name: "UnderlyingStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingOptAttribute, Name: "UnderlyingOptAttribute", Type: FieldTypeString},
+				{Tag: tag.UnderlyingContractMultiplier, Name: "UnderlyingContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCouponRate, Name: "UnderlyingCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingSecurityExchange, Name: "UnderlyingSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssuer, Name: "UnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingIssuerLen, Name: "EncodedUnderlyingIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingIssuer, Name: "EncodedUnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityDesc, Name: "UnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingSecurityDescLen, Name: "EncodedUnderlyingSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingSecurityDesc, Name: "EncodedUnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPProgram, Name: "UnderlyingCPProgram", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPRegType, Name: "UnderlyingCPRegType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCurrency, Name: "UnderlyingCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingQty, Name: "UnderlyingQty", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingPx, Name: "UnderlyingPx", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingDirtyPrice, Name: "UnderlyingDirtyPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndPrice, Name: "UnderlyingEndPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStartValue, Name: "UnderlyingStartValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCurrentValue, Name: "UnderlyingCurrentValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndValue, Name: "UnderlyingEndValue", Type: FieldTypeDecimal},
+				{Tag: tag.NoUnderlyingStips, Name: "NoUnderlyingStips", Type: FieldTypeNumInGroup, Fields: noUnderlyingStipsFields},
+			}},
+			{Tag: tag.PosMaintRptID, Name: "PosMaintRptID", Type: FieldTypeString, Required: true},
+			{Tag: tag.ResponseTransportType, Name: "ResponseTransportType", Type: FieldTypeString},
+			{Tag: tag.ResponseDestination, Name: "ResponseDestination", Type: FieldTypeString},
+			{Tag: tag.TotalNumPosReports, Name: "TotalNumPosReports", Type: FieldTypeInt},
+			{Tag: tag.PosReqResult, Name: "PosReqResult", Type: FieldTypeString, Required: true},
+			{Tag: tag.PosReqStatus, Name: "PosReqStatus", Type: FieldTypeString, Required: true},
+			{Tag: tag.SecuritySubType, Name: "SecuritySubType", Type: FieldTypeString},
+			{Tag: tag.NoEvents, Name: "NoEvents", Type: FieldTypeNumInGroup, Fields: noEventsFields},
+			{Tag: tag.DatedDate, Name: "DatedDate", Type: FieldTypeString},
+			{Tag: tag.InterestAccrualDate, Name: "InterestAccrualDate", Type: FieldTypeString},
+			{Tag: tag.CPProgram, Name: "CPProgram", Type: FieldTypeString},
+			{Tag: tag.CPRegType, Name: "CPRegType", Type: FieldTypeString},
+			{Tag: tag.StrikeCurrency, Name: "StrikeCurrency", Type: FieldTypeString},
+		},
+	},
+	"2": {
+		Name:    "ResendRequest",
+		MsgType: "2",
+		Fields: []FieldDef{
+			{Tag: tag.BeginSeqNo, Name: "BeginSeqNo", Type: FieldTypeInt, Required: true},
+			{Tag: tag.EndSeqNo, Name: "EndSeqNo", Type: FieldTypeInt, Required: true},
+		},
+	},
+	"d": {
+		Name:    "SecurityDefinition",
+		MsgType: "d",
+		Fields: []FieldDef{
+			{Tag: tag.Currency, Name: "Currency", Type: FieldTypeString},
+			{Tag: tag.SecurityIDSource, Name: "SecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.SecurityID, Name: "SecurityID", Type: FieldTypeString},
+			{Tag: tag.Symbol, Name: "Symbol", Type: FieldTypeString},
+			{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+			{Tag: tag.SymbolSfx, Name: "SymbolSfx", Type: FieldTypeString},
+			{Tag: tag.Issuer, Name: "Issuer", Type: FieldTypeString},
+			{Tag: tag.SecurityDesc, Name: "SecurityDesc", Type: FieldTypeString},
+			{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+			{Tag: tag.MaturityMonthYear, Name: "MaturityMonthYear", Type: FieldTypeString},
+			{Tag: tag.StrikePrice, Name: "StrikePrice", Type: FieldTypeDecimal},
+			{Tag: tag.OptAttribute, Name: "OptAttribute", Type: FieldTypeString},
+			{Tag: tag.SecurityExchange, Name: "SecurityExchange", Type: FieldTypeString},
+			{Tag: tag.CouponRate, Name: "CouponRate", Type: FieldTypeDecimal},
+			{Tag: tag.CouponPaymentDate, Name: "CouponPaymentDate", Type: FieldTypeString},
+			{Tag: tag.IssueDate, Name: "IssueDate", Type: FieldTypeString},
+			{Tag: tag.RepurchaseTerm, Name: "RepurchaseTerm", Type: FieldTypeInt},
+			{Tag: tag.RepurchaseRate, Name: "RepurchaseRate", Type: FieldTypeDecimal},
+			{Tag: tag.Factor, Name: "Factor", Type: FieldTypeDecimal},
+			{Tag: tag.ContractMultiplier, Name: "ContractMultiplier", Type: FieldTypeDecimal},
+			{Tag: tag.RepoCollateralSecurityType, Name: "RepoCollateralSecurityType", Type: FieldTypeInt},
+			{Tag: tag.RedemptionDate, Name: "RedemptionDate", Type: FieldTypeString},
+			{Tag: tag.CreditRating, Name: "CreditRating", Type: FieldTypeString},
+			{Tag: tag.SecurityReqID, Name: "SecurityReqID", Type: FieldTypeString, Required: true},
+			{Tag: tag.SecurityResponseID, Name: "SecurityResponseID", Type: FieldTypeString, Required: true},
+			{Tag: tag.SecurityResponseType, Name: "SecurityResponseType", Type: FieldTypeString, Required: true},
+			{Tag: tag.TradingSessionID, Name: "TradingSessionID", Type: FieldTypeString},
+			{Tag: tag.EncodedIssuerLen, Name: "EncodedIssuerLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedIssuer, Name: "EncodedIssuer", Type: FieldTypeString},
+			{Tag: tag.EncodedSecurityDescLen, Name: "EncodedSecurityDescLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedSecurityDesc, Name: "EncodedSecurityDesc", Type: FieldTypeString},
+			{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			{Tag: tag.NoSecurityAltID, Name: "NoSecurityAltID", Type: FieldTypeNumInGroup, Fields: noSecurityAltIDFields},
+			{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+			{Tag: tag.CFICode, Name: "CFICode", Type: FieldTypeString},
+			{Tag: tag.CountryOfIssue, Name: "CountryOfIssue", Type: FieldTypeString},
+			{Tag: tag.StateOrProvinceOfIssue, Name: "StateOrProvinceOfIssue", Type: FieldTypeString},
+			{Tag: tag.LocaleOfIssue, Name: "LocaleOfIssue", Type: FieldTypeString},
+			{Tag: tag.MaturityDate, Name: "MaturityDate", Type: FieldTypeString},
+			{Tag: tag.InstrRegistry, Name: "InstrRegistry", Type: FieldTypeString},
+			{Tag: tag.NoLegs, Name: "NoLegs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.LegSymbol, Name: "LegSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.LegSymbolSfx, Name: "LegSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.LegSecurityID, Name: "LegSecurityID", Type: FieldTypeString},
+				{Tag: tag.LegSecurityIDSource, Name: "LegSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoLegSecurityAltID, Name: "NoLegSecurityAltID", Type: FieldTypeNumInGroup, Fields: noLegSecurityAltIDFields},
+				{Tag: tag.LegProduct, Name: "LegProduct", Type: FieldTypeInt},
+				{Tag: tag.LegCFICode, Name: "LegCFICode", Type: FieldTypeString},
+				{Tag: tag.LegSecurityType, Name: "LegSecurityType", Type: FieldTypeString},
+				{Tag: tag.LegSecuritySubType, Name: "LegSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.LegMaturityMonthYear, Name: "LegMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.LegMaturityDate, Name: "LegMaturityDate", Type: FieldTypeString},
+				{Tag: tag.LegCouponPaymentDate, Name: "LegCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.LegIssueDate, Name: "LegIssueDate", Type: FieldTypeString},
+				{Tag: tag.LegRepoCollateralSecurityType, Name: "LegRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseTerm, Name: "LegRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseRate, Name: "LegRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegFactor, Name: "LegFactor", Type: FieldTypeDecimal},
+				{Tag: tag.LegCreditRating, Name: "LegCreditRating", Type: FieldTypeString},
+				{Tag: tag.LegInstrRegistry, Name: "LegInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.LegCountryOfIssue, Name: "LegCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegStateOrProvinceOfIssue, Name: "LegStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegLocaleOfIssue, Name: "LegLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegRedemptionDate, Name: "LegRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.LegStrikePrice, Name: "LegStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.LegStrikeCurrency, Name: "LegStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.LegOptAttribute, Name: "LegOptAttribute", Type: FieldTypeString},
+				{Tag: tag.LegContractMultiplier, Name: "LegContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.LegCouponRate, Name: "LegCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegSecurityExchange, Name: "LegSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.LegIssuer, Name: "LegIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedLegIssuerLen, Name: "EncodedLegIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegIssuer, Name: "EncodedLegIssuer", Type: FieldTypeString},
+				{Tag: tag.LegSecurityDesc, Name: "LegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedLegSecurityDescLen, Name: "EncodedLegSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegSecurityDesc, Name: "EncodedLegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.LegRatioQty, Name: "LegRatioQty", Type: FieldTypeDecimal},
+				{Tag: tag.LegSide, Name: "LegSide", Type: FieldTypeString},
+				{Tag: tag.LegCurrency, Name: "LegCurrency", Type: FieldTypeString},
+				{Tag: tag.LegPool, Name: "LegPool", Type: FieldTypeString},
+				{Tag: tag.LegDatedDate, Name: "LegDatedDate", Type: FieldTypeString},
+				{Tag: tag.LegContractSettlMonth, Name: "LegContractSettlMonth", Type: FieldTypeString},
+				{Tag: tag.LegInterestAccrualDate, Name: "LegInterestAccrualDate", Type: FieldTypeString},
+			}},
+			{Tag: tag.RoundLot, Name: "RoundLot", Type: FieldTypeDecimal},
+			{Tag: tag.MinTradeVol, Name: "MinTradeVol", Type: FieldTypeDecimal},
+			{Tag: tag.TradingSessionSubID, Name: "TradingSessionSubID", Type: FieldTypeString},
+			{Tag: tag.ContractSettlMonth, Name: "ContractSettlMonth", Type: FieldTypeString},
+			{Tag: tag.DeliveryForm, Name: "DeliveryForm", Type: FieldTypeString},
+			{Tag: tag.Pool, Name: "Pool", Type: FieldTypeString},
+			{Tag: tag.NoUnderlyings, Name: "NoUnderlyings", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.UnderlyingSymbol, Name: "UnderlyingSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.UnderlyingSymbolSfx, Name: "UnderlyingSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityID, Name: "UnderlyingSecurityID", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityIDSource, Name: "UnderlyingSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoUnderlyingSecurityAltID, Name: "NoUnderlyingSecurityAltID", Type: FieldTypeNumInGroup, Fields: noUnderlyingSecurityAltIDFields},
+				{Tag: tag.UnderlyingProduct, Name: "UnderlyingProduct", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingCFICode, Name: "UnderlyingCFICode", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityType, Name: "UnderlyingSecurityType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecuritySubType, Name: "UnderlyingSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityMonthYear, Name: "UnderlyingMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityDate, Name: "UnderlyingMaturityDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCouponPaymentDate, Name: "UnderlyingCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssueDate, Name: "UnderlyingIssueDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRepoCollateralSecurityType, Name: "UnderlyingRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseTerm, Name: "UnderlyingRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseRate, Name: "UnderlyingRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingFactor, Name: "UnderlyingFactor", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCreditRating, Name: "UnderlyingCreditRating", Type: FieldTypeString},
+				{Tag: tag.UnderlyingInstrRegistry, Name: "UnderlyingInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCountryOfIssue, Name: "UnderlyingCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStateOrProvinceOfIssue, Name: "UnderlyingStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingLocaleOfIssue, Name: "UnderlyingLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRedemptionDate, Name: "UnderlyingRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStrikePrice, Name: "UnderlyingStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStrikeCurrency, Name: "UnderlyingStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingOptAttribute, Name: "UnderlyingOptAttribute", Type: FieldTypeString},
+				{Tag: tag.UnderlyingContractMultiplier, Name: "UnderlyingContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCouponRate, Name: "UnderlyingCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingSecurityExchange, Name: "UnderlyingSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssuer, Name: "UnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingIssuerLen, Name: "EncodedUnderlyingIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingIssuer, Name: "EncodedUnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityDesc, Name: "UnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingSecurityDescLen, Name: "EncodedUnderlyingSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingSecurityDesc, Name: "EncodedUnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPProgram, Name: "UnderlyingCPProgram", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPRegType, Name: "UnderlyingCPRegType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCurrency, Name: "UnderlyingCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingQty, Name: "UnderlyingQty", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingPx, Name: "UnderlyingPx", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingDirtyPrice, Name: "UnderlyingDirtyPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndPrice, Name: "UnderlyingEndPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStartValue, Name: "UnderlyingStartValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCurrentValue, Name: "UnderlyingCurrentValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndValue, Name: "UnderlyingEndValue", Type: FieldTypeDecimal},
+				{Tag: tag.NoUnderlyingStips, Name: "NoUnderlyingStips", Type: FieldTypeNumInGroup, Fields: noUnderlyingStipsFields},
+			}},
+			{Tag: tag.SecuritySubType, Name: "SecuritySubType", Type: FieldTypeString},
+			{Tag: tag.ExpirationCycle, Name: "ExpirationCycle", Type: FieldTypeString},
+			{Tag: tag.NoEvents, Name: "NoEvents", Type: FieldTypeNumInGroup, Fields: noEventsFields},
+			{Tag: tag.PctAtRisk, Name: "PctAtRisk", Type: FieldTypeDecimal},
+			{Tag: tag.NoInstrAttrib, Name: "NoInstrAttrib", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.InstrAttribType, Name: "InstrAttribType", Type: FieldTypeString, Required: true},
+				{Tag: tag.InstrAttribValue, Name: "InstrAttribValue", Type: FieldTypeString},
+			}},
+			{Tag: tag.DatedDate, Name: "DatedDate", Type: FieldTypeString},
+			{Tag: tag.InterestAccrualDate, Name: "InterestAccrualDate", Type: FieldTypeString},
+			{Tag: tag.CPProgram, Name: "CPProgram", Type: FieldTypeString},
+			{Tag: tag.CPRegType, Name: "CPRegType", Type: FieldTypeString},
+			{Tag: tag.StrikeCurrency, Name: "StrikeCurrency", Type: FieldTypeString},
+		},
+	},
+	"c": {
+		Name:    "SecurityDefinitionRequest",
+		MsgType: "c",
+		Fields: []FieldDef{
+			{Tag: tag.Currency, Name: "Currency", Type: FieldTypeString},
+			{Tag: tag.SecurityIDSource, Name: "SecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.SecurityID, Name: "SecurityID", Type: FieldTypeString},
+			{Tag: tag.Symbol, Name: "Symbol", Type: FieldTypeString},
+			{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+			{Tag: tag.SymbolSfx, Name: "SymbolSfx", Type: FieldTypeString},
+			{Tag: tag.Issuer, Name: "Issuer", Type: FieldTypeString},
+			{Tag: tag.SecurityDesc, Name: "SecurityDesc", Type: FieldTypeString},
+			{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+			{Tag: tag.MaturityMonthYear, Name: "MaturityMonthYear", Type: FieldTypeString},
+			{Tag: tag.StrikePrice, Name: "StrikePrice", Type: FieldTypeDecimal},
+			{Tag: tag.OptAttribute, Name: "OptAttribute", Type: FieldTypeString},
+			{Tag: tag.SecurityExchange, Name: "SecurityExchange", Type: FieldTypeString},
+			{Tag: tag.CouponRate, Name: "CouponRate", Type: FieldTypeDecimal},
+			{Tag: tag.CouponPaymentDate, Name: "CouponPaymentDate", Type: FieldTypeString},
+			{Tag: tag.IssueDate, Name: "IssueDate", Type: FieldTypeString},
+			{Tag: tag.RepurchaseTerm, Name: "RepurchaseTerm", Type: FieldTypeInt},
+			{Tag: tag.RepurchaseRate, Name: "RepurchaseRate", Type: FieldTypeDecimal},
+			{Tag: tag.Factor, Name: "Factor", Type: FieldTypeDecimal},
+			{Tag: tag.ContractMultiplier, Name: "ContractMultiplier", Type: FieldTypeDecimal},
+			{Tag: tag.RepoCollateralSecurityType, Name: "RepoCollateralSecurityType", Type: FieldTypeInt},
+			{Tag: tag.RedemptionDate, Name: "RedemptionDate", Type: FieldTypeString},
+			{Tag: tag.CreditRating, Name: "CreditRating", Type: FieldTypeString},
+			{Tag: tag.SubscriptionRequestType, Name: "SubscriptionRequestType", Type: FieldTypeString},
+			{Tag: tag.SecurityReqID, Name: "SecurityReqID", Type: FieldTypeString, Required: true},
+			{Tag: tag.SecurityRequestType, Name: "SecurityRequestType", Type: FieldTypeString, Required: true},
+			{Tag: tag.TradingSessionID, Name: "TradingSessionID", Type: FieldTypeString},
+			{Tag: tag.EncodedIssuerLen, Name: "EncodedIssuerLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedIssuer, Name: "EncodedIssuer", Type: FieldTypeString},
+			{Tag: tag.EncodedSecurityDescLen, Name: "EncodedSecurityDescLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedSecurityDesc, Name: "EncodedSecurityDesc", Type: FieldTypeString},
+			{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			{Tag: tag.NoSecurityAltID, Name: "NoSecurityAltID", Type: FieldTypeNumInGroup, Fields: noSecurityAltIDFields},
+			{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+			{Tag: tag.CFICode, Name: "CFICode", Type: FieldTypeString},
+			{Tag: tag.CountryOfIssue, Name: "CountryOfIssue", Type: FieldTypeString},
+			{Tag: tag.StateOrProvinceOfIssue, Name: "StateOrProvinceOfIssue", Type: FieldTypeString},
+			{Tag: tag.LocaleOfIssue, Name: "LocaleOfIssue", Type: FieldTypeString},
+			{Tag: tag.MaturityDate, Name: "MaturityDate", Type: FieldTypeString},
+			{Tag: tag.InstrRegistry, Name: "InstrRegistry", Type: FieldTypeString},
+			{Tag: tag.NoLegs, Name: "NoLegs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.LegSymbol, Name: "LegSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.LegSymbolSfx, Name: "LegSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.LegSecurityID, Name: "LegSecurityID", Type: FieldTypeString},
+				{Tag: tag.LegSecurityIDSource, Name: "LegSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoLegSecurityAltID, Name: "NoLegSecurityAltID", Type: FieldTypeNumInGroup, Fields: noLegSecurityAltIDFields},
+				{Tag: tag.LegProduct, Name: "LegProduct", Type: FieldTypeInt},
+				{Tag: tag.LegCFICode, Name: "LegCFICode", Type: FieldTypeString},
+				{Tag: tag.LegSecurityType, Name: "LegSecurityType", Type: FieldTypeString},
+				{Tag: tag.LegSecuritySubType, Name: "LegSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.LegMaturityMonthYear, Name: "LegMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.LegMaturityDate, Name: "LegMaturityDate", Type: FieldTypeString},
+				{Tag: tag.LegCouponPaymentDate, Name: "LegCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.LegIssueDate, Name: "LegIssueDate", Type: FieldTypeString},
+				{Tag: tag.LegRepoCollateralSecurityType, Name: "LegRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseTerm, Name: "LegRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseRate, Name: "LegRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegFactor, Name: "LegFactor", Type: FieldTypeDecimal},
+				{Tag: tag.LegCreditRating, Name: "LegCreditRating", Type: FieldTypeString},
+				{Tag: tag.LegInstrRegistry, Name: "LegInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.LegCountryOfIssue, Name: "LegCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegStateOrProvinceOfIssue, Name: "LegStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegLocaleOfIssue, Name: "LegLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegRedemptionDate, Name: "LegRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.LegStrikePrice, Name: "LegStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.LegStrikeCurrency, Name: "LegStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.LegOptAttribute, Name: "LegOptAttribute", Type: FieldTypeString},
+				{Tag: tag.LegContractMultiplier, Name: "LegContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.LegCouponRate, Name: "LegCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegSecurityExchange, Name: "LegSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.LegIssuer, Name: "LegIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedLegIssuerLen, Name: "EncodedLegIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegIssuer, Name: "EncodedLegIssuer", Type: FieldTypeString},
+				{Tag: tag.LegSecurityDesc, Name: "LegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedLegSecurityDescLen, Name: "EncodedLegSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegSecurityDesc, Name: "EncodedLegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.LegRatioQty, Name: "LegRatioQty", Type: FieldTypeDecimal},
+				{Tag: tag.LegSide, Name: "LegSide", Type: FieldTypeString},
+				{Tag: tag.LegCurrency, Name: "LegCurrency", Type: FieldTypeString},
+				{Tag: tag.LegPool, Name: "LegPool", Type: FieldTypeString},
+				{Tag: tag.LegDatedDate, Name: "LegDatedDate", Type: FieldTypeString},
+				{Tag: tag.LegContractSettlMonth, Name: "LegContractSettlMonth", Type: FieldTypeString},
+				{Tag: tag.LegInterestAccrualDate, Name: "LegInterestAccrualDate", Type: FieldTypeString},
+			}},
+			{Tag: tag.TradingSessionSubID, Name: "TradingSessionSubID", Type: FieldTypeString},
+			{Tag: tag.ContractSettlMonth, Name: "ContractSettlMonth", Type: FieldTypeString},
+			{Tag: tag.DeliveryForm, Name: "DeliveryForm", Type: FieldTypeString},
+			{Tag: tag.Pool, Name: "Pool", Type: FieldTypeString},
+			{Tag: tag.NoUnderlyings, Name: "NoUnderlyings", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.UnderlyingSymbol, Name: "UnderlyingSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.UnderlyingSymbolSfx, Name: "UnderlyingSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityID, Name: "UnderlyingSecurityID", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityIDSource, Name: "UnderlyingSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoUnderlyingSecurityAltID, Name: "NoUnderlyingSecurityAltID", Type: FieldTypeNumInGroup, Fields: noUnderlyingSecurityAltIDFields},
+				{Tag: tag.UnderlyingProduct, Name: "UnderlyingProduct", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingCFICode, Name: "UnderlyingCFICode", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityType, Name: "UnderlyingSecurityType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecuritySubType, Name: "UnderlyingSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityMonthYear, Name: "UnderlyingMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityDate, Name: "UnderlyingMaturityDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCouponPaymentDate, Name: "UnderlyingCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssueDate, Name: "UnderlyingIssueDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRepoCollateralSecurityType, Name: "UnderlyingRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseTerm, Name: "UnderlyingRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseRate, Name: "UnderlyingRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingFactor, Name: "UnderlyingFactor", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCreditRating, Name: "UnderlyingCreditRating", Type: FieldTypeString},
+				{Tag: tag.UnderlyingInstrRegistry, Name: "UnderlyingInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCountryOfIssue, Name: "UnderlyingCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStateOrProvinceOfIssue, Name: "UnderlyingStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingLocaleOfIssue, Name: "UnderlyingLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRedemptionDate, Name: "UnderlyingRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStrikePrice, Name: "UnderlyingStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStrikeCurrency, Name: "UnderlyingStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingOptAttribute, Name: "UnderlyingOptAttribute", Type: FieldTypeString},
+				{Tag: tag.UnderlyingContractMultiplier, Name: "UnderlyingContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCouponRate, Name: "UnderlyingCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingSecurityExchange, Name: "UnderlyingSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssuer, Name: "UnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingIssuerLen, Name: "EncodedUnderlyingIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingIssuer, Name: "EncodedUnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityDesc, Name: "UnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingSecurityDescLen, Name: "EncodedUnderlyingSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingSecurityDesc, Name: "EncodedUnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPProgram, Name: "UnderlyingCPProgram", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPRegType, Name: "UnderlyingCPRegType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCurrency, Name: "UnderlyingCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingQty, Name: "UnderlyingQty", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingPx, Name: "UnderlyingPx", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingDirtyPrice, Name: "UnderlyingDirtyPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndPrice, Name: "UnderlyingEndPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStartValue, Name: "UnderlyingStartValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCurrentValue, Name: "UnderlyingCurrentValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndValue, Name: "UnderlyingEndValue", Type: FieldTypeDecimal},
+				{Tag: tag.NoUnderlyingStips, Name: "NoUnderlyingStips", Type: FieldTypeNumInGroup, Fields: noUnderlyingStipsFields},
+			}},
+			{Tag: tag.SecuritySubType, Name: "SecuritySubType", Type: FieldTypeString},
+			{Tag: tag.ExpirationCycle, Name: "ExpirationCycle", Type: FieldTypeString},
+			{Tag: tag.NoEvents, Name: "NoEvents", Type: FieldTypeNumInGroup, Fields: noEventsFields},
+			{Tag: tag.PctAtRisk, Name: "PctAtRisk", Type: FieldTypeDecimal},
+			{Tag: tag.NoInstrAttrib, Name: "NoInstrAttrib", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.InstrAttribType, Name: "InstrAttribType", Type: FieldTypeString, Required: true},
+				{Tag: tag.InstrAttribValue, Name: "InstrAttribValue", Type: FieldTypeString},
+			}},
+			{Tag: tag.DatedDate, Name: "DatedDate", Type: FieldTypeString},
+			{Tag: tag.InterestAccrualDate, Name: "InterestAccrualDate", Type: FieldTypeString},
+			{Tag: tag.CPProgram, Name: "CPProgram", Type: FieldTypeString},
+			{Tag: tag.CPRegType, Name: "CPRegType", Type: FieldTypeString},
+			{Tag: tag.StrikeCurrency, Name: "StrikeCurrency", Type: FieldTypeString},
+		},
+	},
+	"y": {
+		Name:    "SecurityList",
+		MsgType: "y",
+		Fields: []FieldDef{
+			{Tag: tag.NoRelatedSym, Name: "NoRelatedSym", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.Symbol, Name: "Symbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.SymbolSfx, Name: "SymbolSfx", Type: FieldTypeString},
+				{Tag: tag.SecurityID, Name: "SecurityID", Type: FieldTypeString},
+				{Tag: tag.SecurityIDSource, Name: "SecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoSecurityAltID, Name: "NoSecurityAltID", Type: FieldTypeNumInGroup, Fields: noSecurityAltIDFields},
+				{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+				{Tag: tag.CFICode, Name: "CFICode", Type: FieldTypeString},
+				{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+				{Tag: tag.SecuritySubType, Name: "SecuritySubType", Type: FieldTypeString},
+				{Tag: tag.MaturityMonthYear, Name: "MaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.MaturityDate, Name: "MaturityDate", Type: FieldTypeString},
+				{Tag: tag.CouponPaymentDate, Name: "CouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.IssueDate, Name: "IssueDate", Type: FieldTypeString},
+				{Tag: tag.RepoCollateralSecurityType, Name: "RepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.RepurchaseTerm, Name: "RepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.RepurchaseRate, Name: "RepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.Factor, Name: "Factor", Type: FieldTypeDecimal},
+				{Tag: tag.CreditRating, Name: "CreditRating", Type: FieldTypeString},
+				{Tag: tag.InstrRegistry, Name: "InstrRegistry", Type: FieldTypeString},
+				{Tag: tag.CountryOfIssue, Name: "CountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.StateOrProvinceOfIssue, Name: "StateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.LocaleOfIssue, Name: "LocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.RedemptionDate, Name: "RedemptionDate", Type: FieldTypeString},
+				{Tag: tag.StrikePrice, Name: "StrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.StrikeCurrency, Name: "StrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.OptAttribute, Name: "OptAttribute", Type: FieldTypeString},
+				{Tag: tag.ContractMultiplier, Name: "ContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.CouponRate, Name: "CouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.SecurityExchange, Name: "SecurityExchange", Type: FieldTypeString},
+				{Tag: tag.Issuer, Name: "Issuer", Type: FieldTypeString},
+				{Tag: tag.EncodedIssuerLen, Name: "EncodedIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedIssuer, Name: "EncodedIssuer", Type: FieldTypeString},
+				{Tag: tag.SecurityDesc, Name: "SecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedSecurityDescLen, Name: "EncodedSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedSecurityDesc, Name: "EncodedSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.Pool, Name: "Pool", Type: FieldTypeString},
+				{Tag: tag.ContractSettlMonth, Name: "ContractSettlMonth", Type: FieldTypeString},
+				{Tag: tag.CPProgram, Name: "CPProgram", Type: FieldTypeString},
+				{Tag: tag.CPRegType, Name: "CPRegType", Type: FieldTypeString},
+				{Tag: tag.NoEvents, Name: "NoEvents", Type: FieldTypeNumInGroup, Fields: noEventsFields},
+				{Tag: tag.DatedDate, Name: "DatedDate", Type: FieldTypeString},
+				{Tag: tag.InterestAccrualDate, Name: "InterestAccrualDate", Type: FieldTypeString},
+				{Tag: tag.DeliveryForm, Name: "DeliveryForm", Type: FieldTypeString},
+				{Tag: tag.PctAtRisk, Name: "PctAtRisk", Type: FieldTypeDecimal},
+				{Tag: tag.NoInstrAttrib, Name: "NoInstrAttrib", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+					{Tag: tag.InstrAttribType, Name: "InstrAttribType", Type: FieldTypeString, Required: true},
+					{Tag: tag.InstrAttribValue, Name: "InstrAttribValue", Type: FieldTypeString},
+				}},
+				{Tag: tag.AgreementDesc, Name: "AgreementDesc", Type: FieldTypeString},
+				{Tag: tag.AgreementID, Name: "AgreementID", Type: FieldTypeString},
+				{Tag: tag.AgreementDate, Name: "AgreementDate", Type: FieldTypeString},
+				{Tag: tag.AgreementCurrency, Name: "AgreementCurrency", Type: FieldTypeString},
+				{Tag: tag.TerminationType, Name: "TerminationType", Type: FieldTypeString},
+				{Tag: tag.StartDate, Name: "StartDate", Type: FieldTypeString},
+				{Tag: tag.EndDate, Name: "EndDate", Type: FieldTypeString},
+				{Tag: tag.DeliveryType, Name: "DeliveryType", Type: FieldTypeString},
+				{Tag: tag.MarginRatio, Name: "MarginRatio", Type: FieldTypeDecimal},
+				{Tag: tag.NoUnderlyings, Name: "NoUnderlyings", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+					{Tag: tag.UnderlyingSymbol, Name: "UnderlyingSymbol", Type: FieldTypeString, Required: true},
+					{Tag: tag.UnderlyingSymbolSfx, Name: "UnderlyingSymbolSfx", Type: FieldTypeString},
+					{Tag: tag.UnderlyingSecurityID, Name: "UnderlyingSecurityID", Type: FieldTypeString},
+					{Tag: tag.UnderlyingSecurityIDSource, Name: "UnderlyingSecurityIDSource", Type: FieldTypeString},
+					{Tag: tag.NoUnderlyingSecurityAltID, Name: "NoUnderlyingSecurityAltID", Type: FieldTypeNumInGroup, Fields: noUnderlyingSecurityAltIDFields},
+					{Tag: tag.UnderlyingProduct, Name: "UnderlyingProduct", Type: FieldTypeInt},
+					{Tag: tag.UnderlyingCFICode, Name: "UnderlyingCFICode", Type: FieldTypeString},
+					{Tag: tag.UnderlyingSecurityType, Name: "UnderlyingSecurityType", Type: FieldTypeString},
+					{Tag: tag.UnderlyingSecuritySubType, Name: "UnderlyingSecuritySubType", Type: FieldTypeString},
+					{Tag: tag.UnderlyingMaturityMonthYear, Name: "UnderlyingMaturityMonthYear", Type: FieldTypeString},
+					{Tag: tag.UnderlyingMaturityDate, Name: "UnderlyingMaturityDate", Type: FieldTypeString},
+					{Tag: tag.UnderlyingCouponPaymentDate, Name: "UnderlyingCouponPaymentDate", Type: FieldTypeString},
+					{Tag: tag.UnderlyingIssueDate, Name: "UnderlyingIssueDate", Type: FieldTypeString},
+					{Tag: tag.UnderlyingRepoCollateralSecurityType, Name: "UnderlyingRepoCollateralSecurityType", Type: FieldTypeInt},
+					{Tag: tag.UnderlyingRepurchaseTerm, Name: "UnderlyingRepurchaseTerm", Type: FieldTypeInt},
+					{Tag: tag.UnderlyingRepurchaseRate, Name: "UnderlyingRepurchaseRate", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingFactor, Name: "UnderlyingFactor", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingCreditRating, Name: "UnderlyingCreditRating", Type: FieldTypeString},
+					{Tag: tag.UnderlyingInstrRegistry, Name: "UnderlyingInstrRegistry", Type: FieldTypeString},
+					{Tag: tag.UnderlyingCountryOfIssue, Name: "UnderlyingCountryOfIssue", Type: FieldTypeString},
+					{Tag: tag.UnderlyingStateOrProvinceOfIssue, Name: "UnderlyingStateOrProvinceOfIssue", Type: FieldTypeString},
+					{Tag: tag.UnderlyingLocaleOfIssue, Name: "UnderlyingLocaleOfIssue", Type: FieldTypeString},
+					{Tag: tag.UnderlyingRedemptionDate, Name: "UnderlyingRedemptionDate", Type: FieldTypeString},
+					{Tag: tag.UnderlyingStrikePrice, Name: "UnderlyingStrikePrice", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingStrikeCurrency, Name: "UnderlyingStrikeCurrency", Type: FieldTypeString},
+					{Tag: tag.UnderlyingOptAttribute, Name: "UnderlyingOptAttribute", Type: FieldTypeString},
+					{Tag: tag.UnderlyingContractMultiplier, Name: "UnderlyingContractMultiplier", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingCouponRate, Name: "UnderlyingCouponRate", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingSecurityExchange, Name: "UnderlyingSecurityExchange", Type: FieldTypeString},
+					{Tag: tag.UnderlyingIssuer, Name: "UnderlyingIssuer", Type: FieldTypeString},
+					{Tag: tag.EncodedUnderlyingIssuerLen, Name: "EncodedUnderlyingIssuerLen", Type: FieldTypeInt},
+					{Tag: tag.EncodedUnderlyingIssuer, Name: "EncodedUnderlyingIssuer", Type: FieldTypeString},
+					{Tag: tag.UnderlyingSecurityDesc, Name: "UnderlyingSecurityDesc", Type: FieldTypeString},
+					{Tag: tag.EncodedUnderlyingSecurityDescLen, Name: "EncodedUnderlyingSecurityDescLen", Type: FieldTypeInt},
+					{Tag: tag.EncodedUnderlyingSecurityDesc, Name: "EncodedUnderlyingSecurityDesc", Type: FieldTypeString},
+					{Tag: tag.UnderlyingCPProgram, Name: "UnderlyingCPProgram", Type: FieldTypeString},
+					{Tag: tag.UnderlyingCPRegType, Name: "UnderlyingCPRegType", Type: FieldTypeString},
+					{Tag: tag.UnderlyingCurrency, Name: "UnderlyingCurrency", Type: FieldTypeString},
+					{Tag: tag.UnderlyingQty, Name: "UnderlyingQty", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingPx, Name: "UnderlyingPx", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingDirtyPrice, Name: "UnderlyingDirtyPrice", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingEndPrice, Name: "UnderlyingEndPrice", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingStartValue, Name: "UnderlyingStartValue", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingCurrentValue, Name: "UnderlyingCurrentValue", Type: FieldTypeDecimal},
+					{Tag: tag.UnderlyingEndValue, Name: "UnderlyingEndValue", Type: FieldTypeDecimal},
+					{Tag: tag.NoUnderlyingStips, Name: "NoUnderlyingStips", Type: FieldTypeNumInGroup, Fields: noUnderlyingStipsFields},
+				}},
+				{Tag: tag.Currency, Name: "Currency", Type: FieldTypeString},
+				{Tag: tag.NoStipulations, Name: "NoStipulations", Type: FieldTypeNumInGroup, Fields: noStipulationsFields},
+				{Tag: tag.NoLegs, Name: "NoLegs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+					{Tag: tag.LegSymbol, Name: "LegSymbol", Type: FieldTypeString, Required: true},
+					{Tag: tag.LegSymbolSfx, Name: "LegSymbolSfx", Type: FieldTypeString},
+					{Tag: tag.LegSecurityID, Name: "LegSecurityID", Type: FieldTypeString},
+					{Tag: tag.LegSecurityIDSource, Name: "LegSecurityIDSource", Type: FieldTypeString},
+					{Tag: tag.NoLegSecurityAltID, Name: "NoLegSecurityAltID", Type: FieldTypeNumInGroup, Fields: noLegSecurityAltIDFields},
+					{Tag: tag.LegProduct, Name: "LegProduct", Type: FieldTypeInt},
+					{Tag: tag.LegCFICode, Name: "LegCFICode", Type: FieldTypeString},
+					{Tag: tag.LegSecurityType, Name: "LegSecurityType", Type: FieldTypeString},
+					{Tag: tag.LegSecuritySubType, Name: "LegSecuritySubType", Type: FieldTypeString},
+					{Tag: tag.LegMaturityMonthYear, Name: "LegMaturityMonthYear", Type: FieldTypeString},
+					{Tag: tag.LegMaturityDate, Name: "LegMaturityDate", Type: FieldTypeString},
+					{Tag: tag.LegCouponPaymentDate, Name: "LegCouponPaymentDate", Type: FieldTypeString},
+					{Tag: tag.LegIssueDate, Name: "LegIssueDate", Type: FieldTypeString},
+					{Tag: tag.LegRepoCollateralSecurityType, Name: "LegRepoCollateralSecurityType", Type: FieldTypeInt},
+					{Tag: tag.LegRepurchaseTerm, Name: "LegRepurchaseTerm", Type: FieldTypeInt},
+					{Tag: tag.LegRepurchaseRate, Name: "LegRepurchaseRate", Type: FieldTypeDecimal},
+					{Tag: tag.LegFactor, Name: "LegFactor", Type: FieldTypeDecimal},
+					{Tag: tag.LegCreditRating, Name: "LegCreditRating", Type: FieldTypeString},
+					{Tag: tag.LegInstrRegistry, Name: "LegInstrRegistry", Type: FieldTypeString},
+					{Tag: tag.LegCountryOfIssue, Name: "LegCountryOfIssue", Type: FieldTypeString},
+					{Tag: tag.LegStateOrProvinceOfIssue, Name: "LegStateOrProvinceOfIssue", Type: FieldTypeString},
+					{Tag: tag.LegLocaleOfIssue, Name: "LegLocaleOfIssue", Type: FieldTypeString},
+					{Tag: tag.LegRedemptionDate, Name: "LegRedemptionDate", Type: FieldTypeString},
+					{Tag: tag.LegStrikePrice, Name: "LegStrikePrice", Type: FieldTypeDecimal},
+					{Tag: tag.LegStrikeCurrency, Name: "LegStrikeCurrency", Type: FieldTypeString},
+					{Tag: tag.LegOptAttribute, Name: "LegOptAttribute", Type: FieldTypeString},
+					{Tag: tag.LegContractMultiplier, Name: "LegContractMultiplier", Type: FieldTypeDecimal},
+					{Tag: tag.LegCouponRate, Name: "LegCouponRate", Type: FieldTypeDecimal},
+					{Tag: tag.LegSecurityExchange, Name: "LegSecurityExchange", Type: FieldTypeString},
+					{Tag: tag.LegIssuer, Name: "LegIssuer", Type: FieldTypeString},
+					{Tag: tag.EncodedLegIssuerLen, Name: "EncodedLegIssuerLen", Type: FieldTypeInt},
+					{Tag: tag.EncodedLegIssuer, Name: "EncodedLegIssuer", Type: FieldTypeString},
+					{Tag: tag.LegSecurityDesc, Name: "LegSecurityDesc", Type: FieldTypeString},
+					{Tag: tag.EncodedLegSecurityDescLen, Name: "EncodedLegSecurityDescLen", Type: FieldTypeInt},
+					{Tag: tag.EncodedLegSecurityDesc, Name: "EncodedLegSecurityDesc", Type: FieldTypeString},
+					{Tag: tag.LegRatioQty, Name: "LegRatioQty", Type: FieldTypeDecimal},
+					{Tag: tag.LegSide, Name: "LegSide", Type: FieldTypeString},
+					{Tag: tag.LegCurrency, Name: "LegCurrency", Type: FieldTypeString},
+					{Tag: tag.LegPool, Name: "LegPool", Type: FieldTypeString},
+					{Tag: tag.LegDatedDate, Name: "LegDatedDate", Type: FieldTypeString},
+					{Tag: tag.LegContractSettlMonth, Name: "LegContractSettlMonth", Type: FieldTypeString},
+					{Tag: tag.LegInterestAccrualDate, Name: "LegInterestAccrualDate", Type: FieldTypeString},
+					{Tag: tag.LegSwapType, Name: "LegSwapType", Type: FieldTypeString},
+					{Tag: tag.LegSettlType, Name: "LegSettlType", Type: FieldTypeString},
+					{Tag: tag.NoLegStipulations, Name: "NoLegStipulations", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+						{Tag: tag.LegStipulationType, Name: "LegStipulationType", Type: FieldTypeString, Required: true},
+						{Tag: tag.LegStipulationValue, Name: "LegStipulationValue", Type: FieldTypeString},
+					}},
+					{Tag: tag.LegBenchmarkCurveCurrency, Name: "LegBenchmarkCurveCurrency", Type: FieldTypeString},
+					{Tag: tag.LegBenchmarkCurveName, Name: "LegBenchmarkCurveName", Type: FieldTypeString},
+					{Tag: tag.LegBenchmarkCurvePoint, Name: "LegBenchmarkCurvePoint", Type: FieldTypeString},
+					{Tag: tag.LegBenchmarkPrice, Name: "LegBenchmarkPrice", Type: FieldTypeDecimal},
+					{Tag: tag.LegBenchmarkPriceType, Name: "LegBenchmarkPriceType", Type: FieldTypeInt},
+				}},
+				{Tag: tag.Spread, Name: "Spread", Type: FieldTypeDecimal},
+				{Tag: tag.BenchmarkCurveCurrency, Name: "BenchmarkCurveCurrency", Type: FieldTypeString},
+				{Tag: tag.BenchmarkCurveName, Name: "BenchmarkCurveName", Type: FieldTypeString},
+				{Tag: tag.BenchmarkCurvePoint, Name: "BenchmarkCurvePoint", Type: FieldTypeString},
+				{Tag: tag.BenchmarkPrice, Name: "BenchmarkPrice", Type: FieldTypeDecimal},
+				{Tag: tag.BenchmarkPriceType, Name: "BenchmarkPriceType", Type: FieldTypeInt},
+				{Tag: tag.BenchmarkSecurityID, Name: "BenchmarkSecurityID", Type: FieldTypeString},
+				{Tag: tag.BenchmarkSecurityIDSource, Name: "BenchmarkSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.YieldType, Name: "YieldType", Type: FieldTypeString},
+				{Tag: tag.Yield, Name: "Yield", Type: FieldTypeDecimal},
+				{Tag: tag.YieldCalcDate, Name: "YieldCalcDate", Type: FieldTypeString},
+				{Tag: tag.YieldRedemptionDate, Name: "YieldRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.YieldRedemptionPrice, Name: "YieldRedemptionPrice", Type: FieldTypeDecimal},
+				{Tag: tag.YieldRedemptionPriceType, Name: "YieldRedemptionPriceType", Type: FieldTypeInt},
+				{Tag: tag.RoundLot, Name: "RoundLot", Type: FieldTypeDecimal},
+				{Tag: tag.MinTradeVol, Name: "MinTradeVol", Type: FieldTypeDecimal},
+				{Tag: tag.TradingSessionID, Name: "TradingSessionID", Type: FieldTypeString},
+				{Tag: tag.TradingSessionSubID, Name: "TradingSessionSubID", Type: FieldTypeString},
+				{Tag: tag.ExpirationCycle, Name: "ExpirationCycle", Type: FieldTypeString},
+				{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+				{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			}},
+			{Tag: tag.SecurityReqID, Name: "SecurityReqID", Type: FieldTypeString, Required: true},
+			{Tag: tag.SecurityResponseID, Name: "SecurityResponseID", Type: FieldTypeString, Required: true},
+			{Tag: tag.TotNoRelatedSym, Name: "TotNoRelatedSym", Type: FieldTypeInt},
+			{Tag: tag.SecurityRequestResult, Name: "SecurityRequestResult", Type: FieldTypeString, Required: true},
+			{Tag: tag.LastFragment, Name: "LastFragment", Type: FieldTypeBool},
+		},
+	},
+	"x": {
+		Name:    "SecurityListRequest",
+		MsgType: "x",
+		Fields: []FieldDef{
+			{Tag: tag.Currency, Name: "Currency", Type: FieldTypeString},
+			{Tag: tag.SecurityIDSource, Name: "SecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.SecurityID, Name: "SecurityID", Type: FieldTypeString},
+			{Tag: tag.Symbol, Name: "Symbol", Type: FieldTypeString},
+			{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+			{Tag: tag.SymbolSfx, Name: "SymbolSfx", Type: FieldTypeString},
+			{Tag: tag.Issuer, Name: "Issuer", Type: FieldTypeString},
+			{Tag: tag.SecurityDesc, Name: "SecurityDesc", Type: FieldTypeString},
+			{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+			{Tag: tag.MaturityMonthYear, Name: "MaturityMonthYear", Type: FieldTypeString},
+			{Tag: tag.StrikePrice, Name: "StrikePrice", Type: FieldTypeDecimal},
+			{Tag: tag.OptAttribute, Name: "OptAttribute", Type: FieldTypeString},
+			{Tag: tag.SecurityExchange, Name: "SecurityExchange", Type: FieldTypeString},
+			{Tag: tag.CouponRate, Name: "CouponRate", Type: FieldTypeDecimal},
+			{Tag: tag.CouponPaymentDate, Name: "CouponPaymentDate", Type: FieldTypeString},
+			{Tag: tag.IssueDate, Name: "IssueDate", Type: FieldTypeString},
+			{Tag: tag.RepurchaseTerm, Name: "RepurchaseTerm", Type: FieldTypeInt},
+			{Tag: tag.RepurchaseRate, Name: "RepurchaseRate", Type: FieldTypeDecimal},
+			{Tag: tag.Factor, Name: "Factor", Type: FieldTypeDecimal},
+			{Tag: tag.ContractMultiplier, Name: "ContractMultiplier", Type: FieldTypeDecimal},
+			{Tag: tag.RepoCollateralSecurityType, Name: "RepoCollateralSecurityType", Type: FieldTypeInt},
+			{Tag: tag.RedemptionDate, Name: "RedemptionDate", Type: FieldTypeString},
+			{Tag: tag.CreditRating, Name: "CreditRating", Type: FieldTypeString},
+			{Tag: tag.SubscriptionRequestType, Name: "SubscriptionRequestType", Type: FieldTypeString},
+			{Tag: tag.SecurityReqID, Name: "SecurityReqID", Type: FieldTypeString, Required: true},
+			{Tag: tag.TradingSessionID, Name: "TradingSessionID", Type: FieldTypeString},
+			{Tag: tag.EncodedIssuerLen, Name: "EncodedIssuerLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedIssuer, Name: "EncodedIssuer", Type: FieldTypeString},
+			{Tag: tag.EncodedSecurityDescLen, Name: "EncodedSecurityDescLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedSecurityDesc, Name: "EncodedSecurityDesc", Type: FieldTypeString},
+			{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			{Tag: tag.NoSecurityAltID, Name: "NoSecurityAltID", Type: FieldTypeNumInGroup, Fields: noSecurityAltIDFields},
+			{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+			{Tag: tag.CFICode, Name: "CFICode", Type: FieldTypeString},
+			{Tag: tag.CountryOfIssue, Name: "CountryOfIssue", Type: FieldTypeString},
+			{Tag: tag.StateOrProvinceOfIssue, Name: "StateOrProvinceOfIssue", Type: FieldTypeString},
+			{Tag: tag.LocaleOfIssue, Name: "LocaleOfIssue", Type: FieldTypeString},
+			{Tag: tag.MaturityDate, Name: "MaturityDate", Type: FieldTypeString},
+			{Tag: tag.InstrRegistry, Name: "InstrRegistry", Type: FieldTypeString},
+			{Tag: tag.NoLegs, Name: "NoLegs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.LegSymbol, Name: "LegSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.LegSymbolSfx, Name: "LegSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.LegSecurityID, Name: "LegSecurityID", Type: FieldTypeString},
+				{Tag: tag.LegSecurityIDSource, Name: "LegSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoLegSecurityAltID, Name: "NoLegSecurityAltID", Type: FieldTypeNumInGroup, Fields: noLegSecurityAltIDFields},
+				{Tag: tag.LegProduct, Name: "LegProduct", Type: FieldTypeInt},
+				{Tag: tag.LegCFICode, Name: "LegCFICode", Type: FieldTypeString},
+				{Tag: tag.LegSecurityType, Name: "LegSecurityType", Type: FieldTypeString},
+				{Tag: tag.LegSecuritySubType, Name: "LegSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.LegMaturityMonthYear, Name: "LegMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.LegMaturityDate, Name: "LegMaturityDate", Type: FieldTypeString},
+				{Tag: tag.LegCouponPaymentDate, Name: "LegCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.LegIssueDate, Name: "LegIssueDate", Type: FieldTypeString},
+				{Tag: tag.LegRepoCollateralSecurityType, Name: "LegRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseTerm, Name: "LegRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseRate, Name: "LegRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegFactor, Name: "LegFactor", Type: FieldTypeDecimal},
+				{Tag: tag.LegCreditRating, Name: "LegCreditRating", Type: FieldTypeString},
+				{Tag: tag.LegInstrRegistry, Name: "LegInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.LegCountryOfIssue, Name: "LegCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegStateOrProvinceOfIssue, Name: "LegStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegLocaleOfIssue, Name: "LegLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegRedemptionDate, Name: "LegRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.LegStrikePrice, Name: "LegStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.LegStrikeCurrency, Name: "LegStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.LegOptAttribute, Name: "LegOptAttribute", Type: FieldTypeString},
+				{Tag: tag.LegContractMultiplier, Name: "LegContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.LegCouponRate, Name: "LegCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegSecurityExchange, Name: "LegSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.LegIssuer, Name: "LegIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedLegIssuerLen, Name: "EncodedLegIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegIssuer, Name: "EncodedLegIssuer", Type: FieldTypeString},
+				{Tag: tag.LegSecurityDesc, Name: "LegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedLegSecurityDescLen, Name: "EncodedLegSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegSecurityDesc, Name: "EncodedLegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.LegRatioQty, Name: "LegRatioQty", Type: FieldTypeDecimal},
+				{Tag: tag.LegSide, Name: "LegSide", Type: FieldTypeString},
+				{Tag: tag.LegCurrency, Name: "LegCurrency", Type: FieldTypeString},
+				{Tag: tag.LegPool, Name: "LegPool", Type: FieldTypeString},
+				{Tag: tag.LegDatedDate, Name: "LegDatedDate", Type: FieldTypeString},
+				{Tag: tag.LegContractSettlMonth, Name: "LegContractSettlMonth", Type: FieldTypeString},
+				{Tag: tag.LegInterestAccrualDate, Name: "LegInterestAccrualDate", Type: FieldTypeString},
+			}},
+			{Tag: tag.SecurityListRequestType, Name: "SecurityListRequestType", Type: FieldTypeString, Required: true},
+			{Tag: tag.TradingSessionSubID, Name: "TradingSessionSubID", Type: FieldTypeString},
+			{Tag: tag.ContractSettlMonth, Name: "ContractSettlMonth", Type: FieldTypeString},
+			{Tag: tag.DeliveryForm, Name: "DeliveryForm", Type: FieldTypeString},
+			{Tag: tag.Pool, Name: "Pool", Type: FieldTypeString},
+			{Tag: tag.NoUnderlyings, Name: "NoUnderlyings", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.UnderlyingSymbol, Name: "UnderlyingSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.UnderlyingSymbolSfx, Name: "UnderlyingSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityID, Name: "UnderlyingSecurityID", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityIDSource, Name: "UnderlyingSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoUnderlyingSecurityAltID, Name: "NoUnderlyingSecurityAltID", Type: FieldTypeNumInGroup, Fields: noUnderlyingSecurityAltIDFields},
+				{Tag: tag.UnderlyingProduct, Name: "UnderlyingProduct", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingCFICode, Name: "UnderlyingCFICode", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityType, Name: "UnderlyingSecurityType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecuritySubType, Name: "UnderlyingSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityMonthYear, Name: "UnderlyingMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityDate, Name: "UnderlyingMaturityDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCouponPaymentDate, Name: "UnderlyingCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssueDate, Name: "UnderlyingIssueDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRepoCollateralSecurityType, Name: "UnderlyingRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseTerm, Name: "UnderlyingRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseRate, Name: "UnderlyingRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingFactor, Name: "UnderlyingFactor", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCreditRating, Name: "UnderlyingCreditRating", Type: FieldTypeString},
+				{Tag: tag.UnderlyingInstrRegistry, Name: "UnderlyingInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCountryOfIssue, Name: "UnderlyingCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStateOrProvinceOfIssue, Name: "UnderlyingStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingLocaleOfIssue, Name: "UnderlyingLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRedemptionDate, Name: "UnderlyingRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStrikePrice, Name: "UnderlyingStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStrikeCurrency, Name: "UnderlyingStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingOptAttribute, Name: "UnderlyingOptAttribute", Type: FieldTypeString},
+				{Tag: tag.UnderlyingContractMultiplier, Name: "UnderlyingContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCouponRate, Name: "UnderlyingCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingSecurityExchange, Name: "UnderlyingSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssuer, Name: "UnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingIssuerLen, Name: "EncodedUnderlyingIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingIssuer, Name: "EncodedUnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityDesc, Name: "UnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingSecurityDescLen, Name: "EncodedUnderlyingSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingSecurityDesc, Name: "EncodedUnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPProgram, Name: "UnderlyingCPProgram", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPRegType, Name: "UnderlyingCPRegType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCurrency, Name: "UnderlyingCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingQty, Name: "UnderlyingQty", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingPx, Name: "UnderlyingPx", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingDirtyPrice, Name: "UnderlyingDirtyPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndPrice, Name: "UnderlyingEndPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStartValue, Name: "UnderlyingStartValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCurrentValue, Name: "UnderlyingCurrentValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndValue, Name: "UnderlyingEndValue", Type: FieldTypeDecimal},
+				{Tag: tag.NoUnderlyingStips, Name: "NoUnderlyingStips", Type: FieldTypeNumInGroup, Fields: noUnderlyingStipsFields},
+			}},
+			{Tag: tag.SecuritySubType, Name: "SecuritySubType", Type: FieldTypeString},
+			{Tag: tag.TerminationType, Name: "TerminationType", Type: FieldTypeString},
+			{Tag: tag.NoEvents, Name: "NoEvents", Type: FieldTypeNumInGroup, Fields: noEventsFields},
+			{Tag: tag.PctAtRisk, Name: "PctAtRisk", Type: FieldTypeDecimal},
+			{Tag: tag.NoInstrAttrib, Name: "NoInstrAttrib", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.InstrAttribType, Name: "InstrAttribType", Type: FieldTypeString, Required: true},
+				{Tag: tag.InstrAttribValue, Name: "InstrAttribValue", Type: FieldTypeString},
+			}},
+			{Tag: tag.DatedDate, Name: "DatedDate", Type: FieldTypeString},
+			{Tag: tag.InterestAccrualDate, Name: "InterestAccrualDate", Type: FieldTypeString},
+			{Tag: tag.CPProgram, Name: "CPProgram", Type: FieldTypeString},
+			{Tag: tag.CPRegType, Name: "CPRegType", Type: FieldTypeString},
+			{Tag: tag.MarginRatio, Name: "MarginRatio", Type: FieldTypeDecimal},
+			{Tag: tag.AgreementDesc, Name: "AgreementDesc", Type: FieldTypeString},
+			{Tag: tag.AgreementID, Name: "AgreementID", Type: FieldTypeString},
+			{Tag: tag.AgreementDate, Name: "AgreementDate", Type: FieldTypeString},
+			{Tag: tag.StartDate, Name: "StartDate", Type: FieldTypeString},
+			{Tag: tag.EndDate, Name: "EndDate", Type: FieldTypeString},
+			{Tag: tag.AgreementCurrency, Name: "AgreementCurrency", Type: FieldTypeString},
+			{Tag: tag.DeliveryType, Name: "DeliveryType", Type: FieldTypeString},
+			{Tag: tag.StrikeCurrency, Name: "StrikeCurrency", Type: FieldTypeString},
+		},
+	},
+	"f": {
+		Name:    "SecurityStatus",
+		MsgType: "f",
+		Fields: []FieldDef{
+			{Tag: tag.Currency, Name: "Currency", Type: FieldTypeString},
+			{Tag: tag.SecurityIDSource, Name: "SecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.LastPx, Name: "LastPx", Type: FieldTypeDecimal},
+			{Tag: tag.SecurityID, Name: "SecurityID", Type: FieldTypeString},
+			{Tag: tag.Symbol, Name: "Symbol", Type: FieldTypeString},
+			{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+			{Tag: tag.TransactTime, Name: "TransactTime", Type: FieldTypeUTCTimestamp},
+			{Tag: tag.SymbolSfx, Name: "SymbolSfx", Type: FieldTypeString},
+			{Tag: tag.Issuer, Name: "Issuer", Type: FieldTypeString},
+			{Tag: tag.SecurityDesc, Name: "SecurityDesc", Type: FieldTypeString},
+			{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+			{Tag: tag.MaturityMonthYear, Name: "MaturityMonthYear", Type: FieldTypeString},
+			{Tag: tag.StrikePrice, Name: "StrikePrice", Type: FieldTypeDecimal},
+			{Tag: tag.OptAttribute, Name: "OptAttribute", Type: FieldTypeString},
+			{Tag: tag.SecurityExchange, Name: "SecurityExchange", Type: FieldTypeString},
+			{Tag: tag.CouponRate, Name: "CouponRate", Type: FieldTypeDecimal},
+			{Tag: tag.CouponPaymentDate, Name: "CouponPaymentDate", Type: FieldTypeString},
+			{Tag: tag.IssueDate, Name: "IssueDate", Type: FieldTypeString},
+			{Tag: tag.RepurchaseTerm, Name: "RepurchaseTerm", Type: FieldTypeInt},
+			{Tag: tag.RepurchaseRate, Name: "RepurchaseRate", Type: FieldTypeDecimal},
+			{Tag: tag.Factor, Name: "Factor", Type: FieldTypeDecimal},
+			{Tag: tag.ContractMultiplier, Name: "ContractMultiplier", Type: FieldTypeDecimal},
+			{Tag: tag.RepoCollateralSecurityType, Name: "RepoCollateralSecurityType", Type: FieldTypeInt},
+			{Tag: tag.RedemptionDate, Name: "RedemptionDate", Type: FieldTypeString},
+			{Tag: tag.CreditRating, Name: "CreditRating", Type: FieldTypeString},
+			{Tag: tag.FinancialStatus, Name: "FinancialStatus", Type: FieldTypeString},
+			{Tag: tag.CorporateAction, Name: "CorporateAction", Type: FieldTypeString},
+			{Tag: tag.SecurityStatusReqID, Name: "SecurityStatusReqID", Type: FieldTypeString},
+			{Tag: tag.UnsolicitedIndicator, Name: "UnsolicitedIndicator", Type: FieldTypeBool},
+			{Tag: tag.SecurityTradingStatus, Name: "SecurityTradingStatus", Type: FieldTypeString},
+			{Tag: tag.HaltReasonChar, Name: "HaltReasonChar", Type: FieldTypeString},
+			{Tag: tag.InViewOfCommon, Name: "InViewOfCommon", Type: FieldTypeBool},
+			{Tag: tag.DueToRelated, Name: "DueToRelated", Type: FieldTypeBool},
+			{Tag: tag.BuyVolume, Name: "BuyVolume", Type: FieldTypeDecimal},
+			{Tag: tag.SellVolume, Name: "SellVolume", Type: FieldTypeDecimal},
+			{Tag: tag.HighPx, Name: "HighPx", Type: FieldTypeDecimal},
+			{Tag: tag.LowPx, Name: "LowPx", Type: FieldTypeDecimal},
+			{Tag: tag.Adjustment, Name: "Adjustment", Type: FieldTypeString},
+			{Tag: tag.TradingSessionID, Name: "TradingSessionID", Type: FieldTypeString},
+			{Tag: tag.EncodedIssuerLen, Name: "EncodedIssuerLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedIssuer, Name: "EncodedIssuer", Type: FieldTypeString},
+			{Tag: tag.EncodedSecurityDescLen, Name: "EncodedSecurityDescLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedSecurityDesc, Name: "EncodedSecurityDesc", Type: FieldTypeString},
+			{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			{Tag: tag.NoSecurityAltID, Name: "NoSecurityAltID", Type: FieldTypeNumInGroup, Fields: noSecurityAltIDFields},
+			{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+			{Tag: tag.CFICode, Name: "CFICode", Type: FieldTypeString},
+			{Tag: tag.CountryOfIssue, Name: "CountryOfIssue", Type: FieldTypeString},
+			{Tag: tag.StateOrProvinceOfIssue, Name: "StateOrProvinceOfIssue", Type: FieldTypeString},
+			{Tag: tag.LocaleOfIssue, Name: "LocaleOfIssue", Type: FieldTypeString},
+			{Tag: tag.MaturityDate, Name: "MaturityDate", Type: FieldTypeString},
+			{Tag: tag.InstrRegistry, Name: "InstrRegistry", Type: FieldTypeString},
+			{Tag: tag.NoLegs, Name: "NoLegs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.LegSymbol, Name: "LegSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.LegSymbolSfx, Name: "LegSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.LegSecurityID, Name: "LegSecurityID", Type: FieldTypeString},
+				{Tag: tag.LegSecurityIDSource, Name: "LegSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoLegSecurityAltID, Name: "NoLegSecurityAltID", Type: FieldTypeNumInGroup, Fields: noLegSecurityAltIDFields},
+				{Tag: tag.LegProduct, Name: "LegProduct", Type: FieldTypeInt},
+				{Tag: tag.LegCFICode, Name: "LegCFICode", Type: FieldTypeString},
+				{Tag: tag.LegSecurityType, Name: "LegSecurityType", Type: FieldTypeString},
+				{Tag: tag.LegSecuritySubType, Name: "LegSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.LegMaturityMonthYear, Name: "LegMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.LegMaturityDate, Name: "LegMaturityDate", Type: FieldTypeString},
+				{Tag: tag.LegCouponPaymentDate, Name: "LegCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.LegIssueDate, Name: "LegIssueDate", Type: FieldTypeString},
+				{Tag: tag.LegRepoCollateralSecurityType, Name: "LegRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseTerm, Name: "LegRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseRate, Name: "LegRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegFactor, Name: "LegFactor", Type: FieldTypeDecimal},
+				{Tag: tag.LegCreditRating, Name: "LegCreditRating", Type: FieldTypeString},
+				{Tag: tag.LegInstrRegistry, Name: "LegInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.LegCountryOfIssue, Name: "LegCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegStateOrProvinceOfIssue, Name: "LegStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegLocaleOfIssue, Name: "LegLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegRedemptionDate, Name: "LegRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.LegStrikePrice, Name: "LegStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.LegStrikeCurrency, Name: "LegStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.LegOptAttribute, Name: "LegOptAttribute", Type: FieldTypeString},
+				{Tag: tag.LegContractMultiplier, Name: "LegContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.LegCouponRate, Name: "LegCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegSecurityExchange, Name: "LegSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.LegIssuer, Name: "LegIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedLegIssuerLen, Name: "EncodedLegIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegIssuer, Name: "EncodedLegIssuer", Type: FieldTypeString},
+				{Tag: tag.LegSecurityDesc, Name: "LegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedLegSecurityDescLen, Name: "EncodedLegSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegSecurityDesc, Name: "EncodedLegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.LegRatioQty, Name: "LegRatioQty", Type: FieldTypeDecimal},
+				{Tag: tag.LegSide, Name: "LegSide", Type: FieldTypeString},
+				{Tag: tag.LegCurrency, Name: "LegCurrency", Type: FieldTypeString},
+				{Tag: tag.LegPool, Name: "LegPool", Type: FieldTypeString},
+				{Tag: tag.LegDatedDate, Name: "LegDatedDate", Type: FieldTypeString},
+				{Tag: tag.LegContractSettlMonth, Name: "LegContractSettlMonth", Type: FieldTypeString},
+				{Tag: tag.LegInterestAccrualDate, Name: "LegInterestAccrualDate", Type: FieldTypeString},
+			}},
+			{Tag: tag.TradingSessionSubID, Name: "TradingSessionSubID", Type: FieldTypeString},
+			{Tag: tag.ContractSettlMonth, Name: "ContractSettlMonth", Type: FieldTypeString},
+			{Tag: tag.DeliveryForm, Name: "DeliveryForm", Type: FieldTypeString},
+			{Tag: tag.Pool, Name: "Pool", Type: FieldTypeString},
+			{Tag: tag.NoUnderlyings, Name: "NoUnderlyings", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.UnderlyingSymbol, Name: "UnderlyingSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.UnderlyingSymbolSfx, Name: "UnderlyingSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityID, Name: "UnderlyingSecurityID", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityIDSource, Name: "UnderlyingSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoUnderlyingSecurityAltID, Name: "NoUnderlyingSecurityAltID", Type: FieldTypeNumInGroup, Fields: noUnderlyingSecurityAltIDFields},
+				{Tag: tag.UnderlyingProduct, Name: "UnderlyingProduct", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingCFICode, Name: "UnderlyingCFICode", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityType, Name: "UnderlyingSecurityType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecuritySubType, Name: "UnderlyingSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityMonthYear, Name: "UnderlyingMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityDate, Name: "UnderlyingMaturityDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCouponPaymentDate, Name: "UnderlyingCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssueDate, Name: "UnderlyingIssueDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRepoCollateralSecurityType, Name: "UnderlyingRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseTerm, Name: "UnderlyingRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseRate, Name: "UnderlyingRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingFactor, Name: "UnderlyingFactor", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCreditRating, Name: "UnderlyingCreditRating", Type: FieldTypeString},
+				{Tag: tag.UnderlyingInstrRegistry, Name: "UnderlyingInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCountryOfIssue, Name: "UnderlyingCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStateOrProvinceOfIssue, Name: "UnderlyingStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingLocaleOfIssue, Name: "UnderlyingLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRedemptionDate, Name: "UnderlyingRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStrikePrice, Name: "UnderlyingStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStrikeCurrency, Name: "UnderlyingStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingOptAttribute, Name: "UnderlyingOptAttribute", Type: FieldTypeString},
+				{Tag: tag.UnderlyingContractMultiplier, Name: "UnderlyingContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCouponRate, Name: "UnderlyingCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingSecurityExchange, Name: "UnderlyingSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssuer, Name: "UnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingIssuerLen, Name: "EncodedUnderlyingIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingIssuer, Name: "EncodedUnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityDesc, Name: "UnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingSecurityDescLen, Name: "EncodedUnderlyingSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingSecurityDesc, Name: "EncodedUnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPProgram, Name: "UnderlyingCPProgram", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPRegType, Name: "UnderlyingCPRegType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCurrency, Name: "UnderlyingCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingQty, Name: "UnderlyingQty", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingPx, Name: "UnderlyingPx", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingDirtyPrice, Name: "UnderlyingDirtyPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndPrice, Name: "UnderlyingEndPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStartValue, Name: "UnderlyingStartValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCurrentValue, Name: "UnderlyingCurrentValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndValue, Name: "UnderlyingEndValue", Type: FieldTypeDecimal},
+				{Tag: tag.NoUnderlyingStips, Name: "NoUnderlyingStips", Type: FieldTypeNumInGroup, Fields: noUnderlyingStipsFields},
+			}},
+			{Tag: tag.SecuritySubType, Name: "SecuritySubType", Type: FieldTypeString},
+			{Tag: tag.NoEvents, Name: "NoEvents", Type: FieldTypeNumInGroup, Fields: noEventsFields},
+			{Tag: tag.PctAtRisk, Name: "PctAtRisk", Type: FieldTypeDecimal},
+			{Tag: tag.NoInstrAttrib, Name: "NoInstrAttrib", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.InstrAttribType, Name: "InstrAttribType", Type: FieldTypeString, Required: true},
+				{Tag: tag.InstrAttribValue, Name: "InstrAttribValue", Type: FieldTypeString},
+			}},
+			{Tag: tag.DatedDate, Name: "DatedDate", Type: FieldTypeString},
+			{Tag: tag.InterestAccrualDate, Name: "InterestAccrualDate", Type: FieldTypeString},
+			{Tag: tag.CPProgram, Name: "CPProgram", Type: FieldTypeString},
+			{Tag: tag.CPRegType, Name: "CPRegType", Type: FieldTypeString},
+			{Tag: tag.StrikeCurrency, Name: "StrikeCurrency", Type: FieldTypeString},
+		},
+	},
+	"e": {
+		Name:    "SecurityStatusRequest",
+		MsgType: "e",
+		Fields: []FieldDef{
+			{Tag: tag.Currency, Name: "Currency", Type: FieldTypeString},
+			{Tag: tag.SecurityIDSource, Name: "SecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.SecurityID, Name: "SecurityID", Type: FieldTypeString},
+			{Tag: tag.Symbol, Name: "Symbol", Type: FieldTypeString},
+			{Tag: tag.SymbolSfx, Name: "SymbolSfx", Type: FieldTypeString},
+			{Tag: tag.Issuer, Name: "Issuer", Type: FieldTypeString},
+			{Tag: tag.SecurityDesc, Name: "SecurityDesc", Type: FieldTypeString},
+			{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+			{Tag: tag.MaturityMonthYear, Name: "MaturityMonthYear", Type: FieldTypeString},
+			{Tag: tag.StrikePrice, Name: "StrikePrice", Type: FieldTypeDecimal},
+			{Tag: tag.OptAttribute, Name: "OptAttribute", Type: FieldTypeString},
+			{Tag: tag.SecurityExchange, Name: "SecurityExchange", Type: FieldTypeString},
+			{Tag: tag.CouponRate, Name: "CouponRate", Type: FieldTypeDecimal},
+			{Tag: tag.CouponPaymentDate, Name: "CouponPaymentDate", Type: FieldTypeString},
+			{Tag: tag.IssueDate, Name: "IssueDate", Type: FieldTypeString},
+			{Tag: tag.RepurchaseTerm, Name: "RepurchaseTerm", Type: FieldTypeInt},
+			{Tag: tag.RepurchaseRate, Name: "RepurchaseRate", Type: FieldTypeDecimal},
+			{Tag: tag.Factor, Name: "Factor", Type: FieldTypeDecimal},
+			{Tag: tag.ContractMultiplier, Name: "ContractMultiplier", Type: FieldTypeDecimal},
+			{Tag: tag.RepoCollateralSecurityType, Name: "RepoCollateralSecurityType", Type: FieldTypeInt},
+			{Tag: tag.RedemptionDate, Name: "RedemptionDate", Type: FieldTypeString},
+			{Tag: tag.CreditRating, Name: "CreditRating", Type: FieldTypeString},
+			{Tag: tag.SubscriptionRequestType, Name: "SubscriptionRequestType", Type: FieldTypeString, Required: true},
+			{Tag: tag.SecurityStatusReqID, Name: "SecurityStatusReqID", Type: FieldTypeString, Required: true},
+			{Tag: tag.TradingSessionID, Name: "TradingSessionID", Type: FieldTypeString},
+			{Tag: tag.EncodedIssuerLen, Name: "EncodedIssuerLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedIssuer, Name: "EncodedIssuer", Type: FieldTypeString},
+			{Tag: tag.EncodedSecurityDescLen, Name: "EncodedSecurityDescLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedSecurityDesc, Name: "EncodedSecurityDesc", Type: FieldTypeString},
+			{Tag: tag.NoSecurityAltID, Name: "NoSecurityAltID", Type: FieldTypeNumInGroup, Fields: noSecurityAltIDFields},
+			{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+			{Tag: tag.CFICode, Name: "CFICode", Type: FieldTypeString},
+			{Tag: tag.CountryOfIssue, Name: "CountryOfIssue", Type: FieldTypeString},
+			{Tag: tag.StateOrProvinceOfIssue, Name: "StateOrProvinceOfIssue", Type: FieldTypeString},
+			{Tag: tag.LocaleOfIssue, Name: "LocaleOfIssue", Type: FieldTypeString},
+			{Tag: tag.MaturityDate, Name: "MaturityDate", Type: FieldTypeString},
+			{Tag: tag.InstrRegistry, Name: "InstrRegistry", Type: FieldTypeString},
+			{Tag: tag.NoLegs, Name: "NoLegs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.LegSymbol, Name: "LegSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.LegSymbolSfx, Name: "LegSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.LegSecurityID, Name: "LegSecurityID", Type: FieldTypeString},
+				{Tag: tag.LegSecurityIDSource, Name: "LegSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoLegSecurityAltID, Name: "NoLegSecurityAltID", Type: FieldTypeNumInGroup, Fields: noLegSecurityAltIDFields},
+				{Tag: tag.LegProduct, Name: "LegProduct", Type: FieldTypeInt},
+				{Tag: tag.LegCFICode, Name: "LegCFICode", Type: FieldTypeString},
+				{Tag: tag.LegSecurityType, Name: "LegSecurityType", Type: FieldTypeString},
+				{Tag: tag.LegSecuritySubType, Name: "LegSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.LegMaturityMonthYear, Name: "LegMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.LegMaturityDate, Name: "LegMaturityDate", Type: FieldTypeString},
+				{Tag: tag.LegCouponPaymentDate, Name: "LegCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.LegIssueDate, Name: "LegIssueDate", Type: FieldTypeString},
+				{Tag: tag.LegRepoCollateralSecurityType, Name: "LegRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseTerm, Name: "LegRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseRate, Name: "LegRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegFactor, Name: "LegFactor", Type: FieldTypeDecimal},
+				{Tag: tag.LegCreditRating, Name: "LegCreditRating", Type: FieldTypeString},
+				{Tag: tag.LegInstrRegistry, Name: "LegInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.LegCountryOfIssue, Name: "LegCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegStateOrProvinceOfIssue, Name: "LegStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegLocaleOfIssue, Name: "LegLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegRedemptionDate, Name: "LegRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.LegStrikePrice, Name: "LegStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.LegStrikeCurrency, Name: "LegStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.LegOptAttribute, Name: "LegOptAttribute", Type: FieldTypeString},
+				{Tag: tag.LegContractMultiplier, Name: "LegContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.LegCouponRate, Name: "LegCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegSecurityExchange, Name: "LegSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.LegIssuer, Name: "LegIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedLegIssuerLen, Name: "EncodedLegIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegIssuer, Name: "EncodedLegIssuer", Type: FieldTypeString},
+				{Tag: tag.LegSecurityDesc, Name: "LegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedLegSecurityDescLen, Name: "EncodedLegSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegSecurityDesc, Name: "EncodedLegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.LegRatioQty, Name: "LegRatioQty", Type: FieldTypeDecimal},
+				{Tag: tag.LegSide, Name: "LegSide", Type: FieldTypeString},
+				{Tag: tag.LegCurrency, Name: "LegCurrency", Type: FieldTypeString},
+				{Tag: tag.LegPool, Name: "LegPool", Type: FieldTypeString},
+				{Tag: tag.LegDatedDate, Name: "LegDatedDate", Type: FieldTypeString},
+				{Tag: tag.LegContractSettlMonth, Name: "LegContractSettlMonth", Type: FieldTypeString},
+				{Tag: tag.LegInterestAccrualDate, Name: "LegInterestAccrualDate", Type: FieldTypeString},
+			}},
+			{Tag: tag.TradingSessionSubID, Name: "TradingSessionSubID", Type: FieldTypeString},
+			{Tag: tag.ContractSettlMonth, Name: "ContractSettlMonth", Type: FieldTypeString},
+			{Tag: tag.DeliveryForm, Name: "DeliveryForm", Type: FieldTypeString},
+			{Tag: tag.Pool, Name: "Pool", Type: FieldTypeString},
+			{Tag: tag.NoUnderlyings, Name: "NoUnderlyings", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.UnderlyingSymbol, Name: "UnderlyingSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.UnderlyingSymbolSfx, Name: "UnderlyingSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityID, Name: "UnderlyingSecurityID", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityIDSource, Name: "UnderlyingSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoUnderlyingSecurityAltID, Name: "NoUnderlyingSecurityAltID", Type: FieldTypeNumInGroup, Fields: noUnderlyingSecurityAltIDFields},
+				{Tag: tag.UnderlyingProduct, Name: "UnderlyingProduct", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingCFICode, Name: "UnderlyingCFICode", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityType, Name: "UnderlyingSecurityType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecuritySubType, Name: "UnderlyingSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityMonthYear, Name: "UnderlyingMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityDate, Name: "UnderlyingMaturityDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCouponPaymentDate, Name: "UnderlyingCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssueDate, Name: "UnderlyingIssueDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRepoCollateralSecurityType, Name: "UnderlyingRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseTerm, Name: "UnderlyingRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseRate, Name: "UnderlyingRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingFactor, Name: "UnderlyingFactor", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCreditRating, Name: "UnderlyingCreditRating", Type: FieldTypeString},
+				{Tag: tag.UnderlyingInstrRegistry, Name: "UnderlyingInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCountryOfIssue, Name: "UnderlyingCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStateOrProvinceOfIssue, Name: "UnderlyingStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingLocaleOfIssue, Name: "UnderlyingLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRedemptionDate, Name: "UnderlyingRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStrikePrice, Name: "UnderlyingStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStrikeCurrency, Name: "UnderlyingStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingOptAttribute, Name: "UnderlyingOptAttribute", Type: FieldTypeString},
+				{Tag: tag.UnderlyingContractMultiplier, Name: "UnderlyingContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCouponRate, Name: "UnderlyingCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingSecurityExchange, Name: "UnderlyingSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssuer, Name: "UnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingIssuerLen, Name: "EncodedUnderlyingIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingIssuer, Name: "EncodedUnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityDesc, Name: "UnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingSecurityDescLen, Name: "EncodedUnderlyingSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingSecurityDesc, Name: "EncodedUnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPProgram, Name: "UnderlyingCPProgram", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPRegType, Name: "UnderlyingCPRegType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCurrency, Name: "UnderlyingCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingQty, Name: "UnderlyingQty", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingPx, Name: "UnderlyingPx", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingDirtyPrice, Name: "UnderlyingDirtyPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndPrice, Name: "UnderlyingEndPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStartValue, Name: "UnderlyingStartValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCurrentValue, Name: "UnderlyingCurrentValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndValue, Name: "UnderlyingEndValue", Type: FieldTypeDecimal},
+				{Tag: tag.NoUnderlyingStips, Name: "NoUnderlyingStips", Type: FieldTypeNumInGroup, Fields: noUnderlyingStipsFields},
+			}},
+			{Tag: tag.SecuritySubType, Name: "SecuritySubType", Type: FieldTypeString},
+			{Tag: tag.NoEvents, Name: "NoEvents", Type: FieldTypeNumInGroup, Fields: noEventsFields},
+			{Tag: tag.PctAtRisk, Name: "PctAtRisk", Type: FieldTypeDecimal},
+			{Tag: tag.NoInstrAttrib, Name: "NoInstrAttrib", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.InstrAttribType, Name: "InstrAttribType", Type: FieldTypeString, Required: true},
+				{Tag: tag.InstrAttribValue, Name: "InstrAttribValue", Type: FieldTypeString},
+			}},
+			{Tag: tag.DatedDate, Name: "DatedDate", Type: FieldTypeString},
+			{Tag: tag.InterestAccrualDate, Name: "InterestAccrualDate", Type: FieldTypeString},
+			{Tag: tag.CPProgram, Name: "CPProgram", Type: FieldTypeString},
+			{Tag: tag.CPRegType, Name: "CPRegType", Type: FieldTypeString},
+			{Tag: tag.StrikeCurrency, Name: "StrikeCurrency", Type: FieldTypeString},
+		},
+	},
+	"v": {
+		Name:    "SecurityTypeRequest",
+		MsgType: "v",
+		Fields: []FieldDef{
+			{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+			{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+			{Tag: tag.SecurityReqID, Name: "SecurityReqID", Type: FieldTypeString, Required: true},
+			{Tag: tag.TradingSessionID, Name: "TradingSessionID", Type: FieldTypeString},
+			{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+			{Tag: tag.TradingSessionSubID, Name: "TradingSessionSubID", Type: FieldTypeString},
+			{Tag: tag.SecuritySubType, Name: "SecuritySubType", Type: FieldTypeString},
+		},
+	},
+	"w": {
+		Name:    "SecurityTypes",
+		MsgType: "w",
+		Fields: []FieldDef{
+			{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+			{Tag: tag.SubscriptionRequestType, Name: "SubscriptionRequestType", Type: FieldTypeString},
+			{Tag: tag.SecurityReqID, Name: "SecurityReqID", Type: FieldTypeString, Required: true},
+			{Tag: tag.SecurityResponseID, Name: "SecurityResponseID", Type: FieldTypeString, Required: true},
+			{Tag: tag.SecurityResponseType, Name: "SecurityResponseType", Type: FieldTypeString, Required: true},
+			{Tag: tag.TradingSessionID, Name: "TradingSessionID", Type: FieldTypeString},
+			{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			{Tag: tag.TotNoSecurityTypes, Name: "TotNoSecurityTypes", Type: FieldTypeInt},
+			{Tag: tag.NoSecurityTypes, Name: "NoSecurityTypes", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString, Required: true},
+				{Tag: tag.SecuritySubType, Name: "SecuritySubType", Type: FieldTypeString},
+				{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+				{Tag: tag.CFICode, Name: "CFICode", Type: FieldTypeString},
+			}},
+			{Tag: tag.TradingSessionSubID, Name: "TradingSessionSubID", Type: FieldTypeString},
+			{Tag: tag.LastFragment, Name: "LastFragment", Type: FieldTypeBool},
+		},
+	},
+	"4": {
+		Name:    "SequenceReset",
+		MsgType: "4",
+		Fields: []FieldDef{
+			{Tag: tag.NewSeqNo, Name: "NewSeqNo", Type: FieldTypeInt, Required: true},
+			{Tag: tag.GapFillFlag, Name: "GapFillFlag", Type: FieldTypeBool},
+		},
+	},
+	"AV": {
+		Name:    "SettlementInstructionRequest",
+		MsgType: "AV",
+		Fields: []FieldDef{
+			{Tag: tag.Side, Name: "Side", Type: FieldTypeString},
+			{Tag: tag.TransactTime, Name: "TransactTime", Type: FieldTypeUTCTimestamp, Required: true},
+			{Tag: tag.AllocAccount, Name: "AllocAccount", Type: FieldTypeString},
+			{Tag: tag.ExpireTime, Name: "ExpireTime", Type: FieldTypeUTCTimestamp},
+			{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+			{Tag: tag.EffectiveTime, Name: "EffectiveTime", Type: FieldTypeUTCTimestamp},
+			{Tag: tag.StandInstDbType, Name: "StandInstDbType", Type: FieldTypeString},
+			{Tag: tag.StandInstDbName, Name: "StandInstDbName", Type: FieldTypeString},
+			{Tag: tag.StandInstDbID, Name: "StandInstDbID", Type: FieldTypeString},
+			{Tag: tag.NoPartyIDs, Name: "NoPartyIDs", Type: FieldTypeNumInGroup, Fields: noPartyIDsFields},
+			{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+			{Tag: tag.CFICode, Name: "CFICode", Type: FieldTypeString},
+			{Tag: tag.AllocAcctIDSource, Name: "AllocAcctIDSource", Type: FieldTypeInt},
+			{Tag: tag.LastUpdateTime, Name: "LastUpdateTime", Type: FieldTypeUTCTimestamp},
+			{Tag: tag.SettlInstReqID, Name: "SettlInstReqID", Type: FieldTypeString, Required: true},
+		},
+	},
+	"T": {
+		Name:    "SettlementInstructions",
+		MsgType: "T",
+		Fields: []FieldDef{
+			{Tag: tag.ClOrdID, Name: "ClOrdID", Type: FieldTypeString},
+			{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+			{Tag: tag.TransactTime, Name: "TransactTime", Type: FieldTypeUTCTimestamp, Required: true},
+			{Tag: tag.SettlInstMode, Name: "SettlInstMode", Type: FieldTypeString, Required: true},
+			{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			{Tag: tag.SettlInstMsgID, Name: "SettlInstMsgID", Type: FieldTypeString, Required: true},
+			{Tag: tag.NoSettlInst, Name: "NoSettlInst", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.SettlInstID, Name: "SettlInstID", Type: FieldTypeString, Required: true},
+				{Tag: tag.SettlInstTransType, Name: "SettlInstTransType", Type: FieldTypeString},
+				{Tag: tag.SettlInstRefID, Name: "SettlInstRefID", Type: FieldTypeString},
+				{Tag: tag.NoPartyIDs, Name: "NoPartyIDs", Type: FieldTypeNumInGroup, Fields: noPartyIDsFields},
+				{Tag: tag.Side, Name: "Side", Type: FieldTypeString},
+				{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+				{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+				{Tag: tag.CFICode, Name: "CFICode", Type: FieldTypeString},
+				{Tag: tag.EffectiveTime, Name: "EffectiveTime", Type: FieldTypeUTCTimestamp},
+				{Tag: tag.ExpireTime, Name: "ExpireTime", Type: FieldTypeUTCTimestamp},
+				{Tag: tag.LastUpdateTime, Name: "LastUpdateTime", Type: FieldTypeUTCTimestamp},
+				{Tag: tag.SettlDeliveryType, Name: "SettlDeliveryType", Type: FieldTypeString},
+				{Tag: tag.StandInstDbType, Name: "StandInstDbType", Type: FieldTypeString},
+				{Tag: tag.StandInstDbName, Name: "StandInstDbName", Type: FieldTypeString},
+				{Tag: tag.StandInstDbID, Name: "StandInstDbID", Type: FieldTypeString},
+				{Tag: tag.NoDlvyInst, Name: "NoDlvyInst", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+					{Tag: tag.SettlInstSource, Name: "SettlInstSource", Type: FieldTypeString, Required: true},
+					{Tag: tag.DlvyInstType, Name: "DlvyInstType", Type: FieldTypeString},
+					{Tag: tag.NoSettlPartyIDs, Name: "NoSettlPartyIDs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+						{Tag: tag.SettlPartyID, Name: "SettlPartyID", Type: FieldTypeString, Required: true},
+						{Tag: tag.SettlPartyIDSource, Name: "SettlPartyIDSource", Type: FieldTypeString},
+						{Tag: tag.SettlPartyRole, Name: "SettlPartyRole", Type: FieldTypeInt},
+						{Tag: tag.NoSettlPartySubIDs, Name: "NoSettlPartySubIDs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+							{Tag: tag.SettlPartySubID, Name: "SettlPartySubID", Type: FieldTypeString, Required: true},
+							{Tag: tag.SettlPartySubIDType, Name: "SettlPartySubIDType", Type: FieldTypeInt},
+						}},
+					}},
+				}},
+				{Tag: tag.PaymentMethod, Name: "PaymentMethod", Type: FieldTypeString},
+				{Tag: tag.PaymentRef, Name: "PaymentRef", Type: FieldTypeString},
+				{Tag: tag.CardHolderName, Name: "CardHolderName", Type: FieldTypeString},
+				{Tag: tag.CardNumber, Name: "CardNumber", Type: FieldTypeString},
+				{Tag: tag.CardStartDate, Name: "CardStartDate", Type: FieldTypeString},
+				{Tag: tag.CardExpDate, Name: "CardExpDate", Type: FieldTypeString},
+				{Tag: tag.CardIssNum, Name: "CardIssNum", Type: FieldTypeString},
+				{Tag: tag.PaymentDate, Name: "PaymentDate", Type: FieldTypeString},
+				{Tag: tag.PaymentRemitterID, Name: "PaymentRemitterID", Type: FieldTypeString},
+			}},
+			{Tag: tag.SettlInstReqID, Name: "SettlInstReqID", Type: FieldTypeString},
+			{Tag: tag.SettlInstReqRejCode, Name: "SettlInstReqRejCode", Type: FieldTypeString},
+		},
+	},
+	"1": {
+		Name:    "TestRequest",
+		MsgType: "1",
+		Fields: []FieldDef{
+			{Tag: tag.TestReqID, Name: "TestReqID", Type: FieldTypeString, Required: true},
+		},
+	},
+	"AE": {
+		Name:    "TradeCaptureReport",
+		MsgType: "AE",
+		Fields: []FieldDef{
+			{Tag: tag.AvgPx, Name: "AvgPx", Type: FieldTypeDecimal},
+			{Tag: tag.ExecID, Name: "ExecID", Type: FieldTypeString},
+			{Tag: tag.SecurityIDSource, Name: "SecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.LastMkt, Name: "LastMkt", Type: FieldTypeString},
+			{Tag: tag.LastPx, Name: "LastPx", Type: FieldTypeDecimal, Required: true},
+			{Tag: tag.LastQty, Name: "LastQty", Type: FieldTypeDecimal, Required: true},
+			{Tag: tag.OrderQty, Name: "OrderQty", Type: FieldTypeDecimal},
+			{Tag: tag.OrdStatus, Name: "OrdStatus", Type: FieldTypeString},
+			{Tag: tag.SecurityID, Name: "SecurityID", Type: FieldTypeString},
+			{Tag: tag.Symbol, Name: "Symbol", Type: FieldTypeString},
+			{Tag: tag.TransactTime, Name: "TransactTime", Type: FieldTypeUTCTimestamp, Required: true},
+			{Tag: tag.SettlType, Name: "SettlType", Type: FieldTypeString},
+			{Tag: tag.SettlDate, Name: "SettlDate", Type: FieldTypeString},
+			{Tag: tag.SymbolSfx, Name: "SymbolSfx", Type: FieldTypeString},
+			{Tag: tag.TradeDate, Name: "TradeDate", Type: FieldTypeString, Required: true},
+			{Tag: tag.Issuer, Name: "Issuer", Type: FieldTypeString},
+			{Tag: tag.SecurityDesc, Name: "SecurityDesc", Type: FieldTypeString},
+			{Tag: tag.ExecType, Name: "ExecType", Type: FieldTypeString},
+			{Tag: tag.CashOrderQty, Name: "CashOrderQty", Type: FieldTypeDecimal},
+			{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+			{Tag: tag.LastSpotRate, Name: "LastSpotRate", Type: FieldTypeDecimal},
+			{Tag: tag.LastForwardPoints, Name: "LastForwardPoints", Type: FieldTypeDecimal},
+			{Tag: tag.MaturityMonthYear, Name: "MaturityMonthYear", Type: FieldTypeString},
+			{Tag: tag.StrikePrice, Name: "StrikePrice", Type: FieldTypeDecimal},
+			{Tag: tag.OptAttribute, Name: "OptAttribute", Type: FieldTypeString},
+			{Tag: tag.SecurityExchange, Name: "SecurityExchange", Type: FieldTypeString},
+			{Tag: tag.Spread, Name: "Spread", Type: FieldTypeDecimal},
+			{Tag: tag.BenchmarkCurveCurrency, Name: "BenchmarkCurveCurrency", Type: FieldTypeString},
+			{Tag: tag.BenchmarkCurveName, Name: "BenchmarkCurveName", Type: FieldTypeString},
+			{Tag: tag.BenchmarkCurvePoint, Name: "BenchmarkCurvePoint", Type: FieldTypeString},
+			{Tag: tag.CouponRate, Name: "CouponRate", Type: FieldTypeDecimal},
+			{Tag: tag.CouponPaymentDate, Name: "CouponPaymentDate", Type: FieldTypeString},
+			{Tag: tag.IssueDate, Name: "IssueDate", Type: FieldTypeString},
+			{Tag: tag.RepurchaseTerm, Name: "RepurchaseTerm", Type: FieldTypeInt},
+			{Tag: tag.RepurchaseRate, Name: "RepurchaseRate", Type: FieldTypeDecimal},
+			{Tag: tag.Factor, Name: "Factor", Type: FieldTypeDecimal},
+			{Tag: tag.ContractMultiplier, Name: "ContractMultiplier", Type: FieldTypeDecimal},
+			{Tag: tag.YieldType, Name: "YieldType", Type: FieldTypeString},
+			{Tag: tag.Yield, Name: "Yield", Type: FieldTypeDecimal},
+			{Tag: tag.RepoCollateralSecurityType, Name: "RepoCollateralSecurityType", Type: FieldTypeInt},
+			{Tag: tag.RedemptionDate, Name: "RedemptionDate", Type: FieldTypeString},
+			{Tag: tag.CreditRating, Name: "CreditRating", Type: FieldTypeString},
+			{Tag: tag.SubscriptionRequestType, Name: "SubscriptionRequestType", Type: FieldTypeString},
+			{Tag: tag.UnsolicitedIndicator, Name: "UnsolicitedIndicator", Type: FieldTypeBool},
+			{Tag: tag.EncodedIssuerLen, Name: "EncodedIssuerLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedIssuer, Name: "EncodedIssuer", Type: FieldTypeString},
+			{Tag: tag.EncodedSecurityDescLen, Name: "EncodedSecurityDescLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedSecurityDesc, Name: "EncodedSecurityDesc", Type: FieldTypeString},
+			{Tag: tag.ExecRestatementReason, Name: "ExecRestatementReason", Type: FieldTypeString},
+			{Tag: tag.PriceType, Name: "PriceType", Type: FieldTypeString},
+			{Tag: tag.MultiLegReportingType, Name: "MultiLegReportingType", Type: FieldTypeString},
+			{Tag: tag.NoSecurityAltID, Name: "NoSecurityAltID", Type: FieldTypeNumInGroup, Fields: noSecurityAltIDFields},
+			{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+			{Tag: tag.CFICode, Name: "CFICode", Type: FieldTypeString},
+			{Tag: tag.RoundingDirection, Name: "RoundingDirection", Type: FieldTypeString},
+			{Tag: tag.RoundingModulus, Name: "RoundingModulus", Type: FieldTypeDecimal},
+			{Tag: tag.CountryOfIssue, Name: "CountryOfIssue", Type: FieldTypeString},
+			{Tag: tag.StateOrProvinceOfIssue, Name: "StateOrProvinceOfIssue", Type: FieldTypeString},
+			{Tag: tag.LocaleOfIssue, Name: "LocaleOfIssue", Type: FieldTypeString},
+			{Tag: tag.TradeReportTransType, Name: "TradeReportTransType", Type: FieldTypeString},
+			{Tag: tag.OrderPercent, Name: "OrderPercent", Type: FieldTypeDecimal},
+			{Tag: tag.SecondaryExecID, Name: "SecondaryExecID", Type: FieldTypeString},
+			{Tag: tag.MaturityDate, Name: "MaturityDate", Type: FieldTypeString},
+			{Tag: tag.InstrRegistry, Name: "InstrRegistry", Type: FieldTypeString},
+			{Tag: tag.NoSides, Name: "NoSides", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.Side, Name: "Side", Type: FieldTypeString, Required: true},
+				{Tag: tag.OrderID, Name: "OrderID", Type: FieldTypeString},
+				{Tag: tag.SecondaryOrderID, Name: "SecondaryOrderID", Type: FieldTypeString},
+				{Tag: tag.ClOrdID, Name: "ClOrdID", Type: FieldTypeString},
+				{Tag: tag.SecondaryClOrdID, Name: "SecondaryClOrdID", Type: FieldTypeString},
+				{Tag: tag.ListID, Name: "ListID", Type: FieldTypeString},
+				{Tag: tag.NoPartyIDs, Name: "NoPartyIDs", Type: FieldTypeNumInGroup, Fields: noPartyIDsFields},
+				{Tag: tag.Account, Name: "Account", Type: FieldTypeString},
+				{Tag: tag.AcctIDSource, Name: "AcctIDSource", Type: FieldTypeString},
+				{Tag: tag.AccountType, Name: "AccountType", Type: FieldTypeString},
+				{Tag: tag.ProcessCode, Name: "ProcessCode", Type: FieldTypeString},
+				{Tag: tag.OddLot, Name: "OddLot", Type: FieldTypeBool},
+				{Tag: tag.NoClearingInstructions, Name: "NoClearingInstructions", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+					{Tag: tag.ClearingInstruction, Name: "ClearingInstruction", Type: FieldTypeString, Required: true},
+				}},
+				{Tag: tag.ClearingFeeIndicator, Name: "ClearingFeeIndicator", Type: FieldTypeString},
+				{Tag: tag.TradeInputSource, Name: "TradeInputSource", Type: FieldTypeString},
+				{Tag: tag.TradeInputDevice, Name: "TradeInputDevice", Type: FieldTypeString},
+				{Tag: tag.OrderInputDevice, Name: "OrderInputDevice", Type: FieldTypeString},
+				{Tag: tag.Currency, Name: "Currency", Type: FieldTypeString},
+				{Tag: tag.ComplianceID, Name: "ComplianceID", Type: FieldTypeString},
+				{Tag: tag.SolicitedFlag, Name: "SolicitedFlag", Type: FieldTypeBool},
+				{Tag: tag.OrderCapacity, Name: "OrderCapacity", Type: FieldTypeString},
+				{Tag: tag.OrderRestrictions, Name: "OrderRestrictions", Type: FieldTypeString},
+				{Tag: tag.CustOrderCapacity, Name: "CustOrderCapacity", Type: FieldTypeString},
+				{Tag: tag.OrdType, Name: "OrdType", Type: FieldTypeString},
+				{Tag: tag.ExecInst, Name: "ExecInst", Type: FieldTypeString},
+				{Tag: tag.TransBkdTime, Name: "TransBkdTime", Type: FieldTypeUTCTimestamp},
+				{Tag: tag.TradingSessionID, Name: "TradingSessionID", Type: FieldTypeString},
+				{Tag: tag.TradingSessionSubID, Name: "TradingSessionSubID", Type: FieldTypeString},
+				{Tag: tag.TimeBracket, Name: "TimeBracket", Type: FieldTypeString},
+				{Tag: tag.Commission, Name: "Commission", Type: FieldTypeDecimal},
+				{Tag: tag.CommType, Name: "CommType", Type: FieldTypeString},
+				{Tag: tag.CommCurrency, Name: "CommCurrency", Type: FieldTypeString},
+				{Tag: tag.FundRenewWaiv, Name: "FundRenewWaiv", Type: FieldTypeString},
+				{Tag: tag.GrossTradeAmt, Name: "GrossTradeAmt", Type: FieldTypeDecimal},
+				{Tag: tag.NumDaysInterest, Name: "NumDaysInterest", Type: FieldTypeInt},
+				{Tag: tag.ExDate, Name: "ExDate", Type: FieldTypeString},
+				{Tag: tag.AccruedInterestRate, Name: "AccruedInterestRate", Type: FieldTypeDecimal},
+				{Tag: tag.AccruedInterestAmt, Name: "AccruedInterestAmt", Type: FieldTypeDecimal},
+				{Tag: tag.InterestAtMaturity, Name: "InterestAtMaturity", Type: FieldTypeDecimal},
+				{Tag: tag.EndAccruedInterestAmt, Name: "EndAccruedInterestAmt", Type: FieldTypeDecimal},
+				{Tag: tag.StartCash, Name: "StartCash", Type: FieldTypeDecimal},
+				{Tag: tag.EndCash, Name: "EndCash", Type: FieldTypeDecimal},
+				{Tag: tag.Concession, Name: "Concession", Type: FieldTypeDecimal},
+				{Tag: tag.TotalTakedown, Name: "TotalTakedown", Type: FieldTypeDecimal},
+				{Tag: tag.NetMoney, Name: "NetMoney", Type: FieldTypeDecimal},
+				{Tag: tag.SettlCurrAmt, Name: "SettlCurrAmt", Type: FieldTypeDecimal},
+				{Tag: tag.SettlCurrency, Name: "SettlCurrency", Type: FieldTypeString},
+				{Tag: tag.SettlCurrFxRate, Name: "SettlCurrFxRate", Type: FieldTypeDecimal},
+				{Tag: tag.SettlCurrFxRateCalc, Name: "SettlCurrFxRateCalc", Type: FieldTypeString},
+				{Tag: tag.PositionEffect, Name: "PositionEffect", Type: FieldTypeString},
+				{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+				{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+				{Tag: tag.SideMultiLegReportingType, Name: "SideMultiLegReportingType", Type: FieldTypeString},
+				{Tag: tag.NoContAmts, Name: "NoContAmts", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+					{Tag: tag.ContAmtType, Name: "ContAmtType", Type: FieldTypeString, Required: true},
+					{Tag: tag.ContAmtValue, Name: "ContAmtValue", Type: FieldTypeDecimal},
+					{Tag: tag.ContAmtCurr, Name: "ContAmtCurr", Type: FieldTypeString},
+				}},
+				{Tag: tag.NoStipulations, Name: "NoStipulations", Type: FieldTypeNumInGroup, Fields: noStipulationsFields},
+				{Tag: tag.NoMiscFees, Name: "NoMiscFees", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+					{Tag: tag.MiscFeeAmt, Name: "MiscFeeAmt", Type: FieldTypeDecimal, Required: true},
+					{Tag: tag.MiscFeeCurr, Name: "MiscFeeCurr", Type: FieldTypeString},
+					{Tag: tag.MiscFeeType, Name: "MiscFeeType", Type: FieldTypeString},
+					{Tag: tag.MiscFeeBasis, Name: "MiscFeeBasis", Type: FieldTypeString},
+				}},
+				{Tag: tag.ExchangeRule, Name: "ExchangeRule", Type: FieldTypeString},
+				{Tag: tag.TradeAllocIndicator, Name: "TradeAllocIndicator", Type: FieldTypeString},
+				{Tag: tag.PreallocMethod, Name: "PreallocMethod", Type: FieldTypeString},
+				{Tag: tag.AllocID, Name: "AllocID", Type: FieldTypeString},
+				{Tag: tag.NoAllocs, Name: "NoAllocs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+					{Tag: tag.AllocAccount, Name: "AllocAccount", Type: FieldTypeString, Required: true},
+					{Tag: tag.AllocAcctIDSource, Name: "AllocAcctIDSource", Type: FieldTypeInt},
+					{Tag: tag.AllocSettlCurrency, Name: "AllocSettlCurrency", Type: FieldTypeString},
+					{Tag: tag.IndividualAllocID, Name: "IndividualAllocID", Type: FieldTypeString},
+					{Tag: tag.NoNested2PartyIDs, Name: "NoNested2PartyIDs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+						{Tag: tag.Nested2PartyID, Name: "Nested2PartyID", Type: FieldTypeString, Required: true},
+						{Tag: tag.Nested2PartyIDSource, Name: "Nested2PartyIDSource", Type: FieldTypeString},
+						{Tag: tag.Nested2PartyRole, Name: "Nested2PartyRole", Type: FieldTypeInt},
+						{Tag: tag.NoNested2PartySubIDs, Name: "NoNested2PartySubIDs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+							{Tag: tag.Nested2PartySubID, Name: "Nested2PartySubID", Type: FieldTypeString, Required: true},
+							{Tag: tag.Nested2PartySubIDType, Name: "Nested2PartySubIDType", Type: FieldTypeInt},
+						}},
+					}},
+					{Tag: tag.AllocQty, Name: "AllocQty", Type: FieldTypeDecimal},
+				}},
+				{Tag: tag.CopyMsgIndicator, Name: "CopyMsgIndicator", Type: FieldTypeBool},
+				{Tag: tag.PublishTrdIndicator, Name: "PublishTrdIndicator", Type: FieldTypeBool},
+				{Tag: tag.ShortSaleReason, Name: "ShortSaleReason", Type: FieldTypeString},
+			}},
+			{Tag: tag.NoLegs, Name: "NoLegs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.LegSymbol, Name: "LegSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.LegSymbolSfx, Name: "LegSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.LegSecurityID, Name: "LegSecurityID", Type: FieldTypeString},
+				{Tag: tag.LegSecurityIDSource, Name: "LegSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoLegSecurityAltID, Name: "NoLegSecurityAltID", Type: FieldTypeNumInGroup, Fields: noLegSecurityAltIDFields},
+				{Tag: tag.LegProduct, Name: "LegProduct", Type: FieldTypeInt},
+				{Tag: tag.LegCFICode, Name: "LegCFICode", Type: FieldTypeString},
+				{Tag: tag.LegSecurityType, Name: "LegSecurityType", Type: FieldTypeString},
+				{Tag: tag.LegSecuritySubType, Name: "LegSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.LegMaturityMonthYear, Name: "LegMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.LegMaturityDate, Name: "LegMaturityDate", Type: FieldTypeString},
+				{Tag: tag.LegCouponPaymentDate, Name: "LegCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.LegIssueDate, Name: "LegIssueDate", Type: FieldTypeString},
+				{Tag: tag.LegRepoCollateralSecurityType, Name: "LegRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseTerm, Name: "LegRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseRate, Name: "LegRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegFactor, Name: "LegFactor", Type: FieldTypeDecimal},
+				{Tag: tag.LegCreditRating, Name: "LegCreditRating", Type: FieldTypeString},
+				{Tag: tag.LegInstrRegistry, Name: "LegInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.LegCountryOfIssue, Name: "LegCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegStateOrProvinceOfIssue, Name: "LegStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegLocaleOfIssue, Name: "LegLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegRedemptionDate, Name: "LegRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.LegStrikePrice, Name: "LegStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.LegStrikeCurrency, Name: "LegStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.LegOptAttribute, Name: "LegOptAttribute", Type: FieldTypeString},
+				{Tag: tag.LegContractMultiplier, Name: "LegContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.LegCouponRate, Name: "LegCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegSecurityExchange, Name: "LegSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.LegIssuer, Name: "LegIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedLegIssuerLen, Name: "EncodedLegIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegIssuer, Name: "EncodedLegIssuer", Type: FieldTypeString},
+				{Tag: tag.LegSecurityDesc, Name: "LegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedLegSecurityDescLen, Name: "EncodedLegSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegSecurityDesc, Name: "EncodedLegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.LegRatioQty, Name: "LegRatioQty", Type: FieldTypeDecimal},
+				{Tag: tag.LegSide, Name: "LegSide", Type: FieldTypeString},
+				{Tag: tag.LegCurrency, Name: "LegCurrency", Type: FieldTypeString},
+				{Tag: tag.LegPool, Name: "LegPool", Type: FieldTypeString},
+				{Tag: tag.LegDatedDate, Name: "LegDatedDate", Type: FieldTypeString},
+				{Tag: tag.LegContractSettlMonth, Name: "LegContractSettlMonth", Type: FieldTypeString},
+				{Tag: tag.LegInterestAccrualDate, Name: "LegInterestAccrualDate", Type: FieldTypeString},
+				{Tag: tag.LegQty, Name: "LegQty", Type: FieldTypeDecimal},
+				{Tag: tag.LegSwapType, Name: "LegSwapType", Type: FieldTypeString},
+				{Tag: tag.NoLegStipulations, Name: "NoLegStipulations", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+					{Tag: tag.LegStipulationType, Name: "LegStipulationType", Type: FieldTypeString, Required: true},
+					{Tag: tag.LegStipulationValue, Name: "LegStipulationValue", Type: FieldTypeString},
+				}},
+				{Tag: tag.LegPositionEffect, Name: "LegPositionEffect", Type: FieldTypeString},
+				{Tag: tag.LegCoveredOrUncovered, Name: "LegCoveredOrUncovered", Type: FieldTypeInt},
+				{Tag: tag.NoNestedPartyIDs, Name: "NoNestedPartyIDs", Type: FieldTypeNumInGroup, Fields: noNestedPartyIDsFields},
+				{Tag: tag.LegRefID, Name: "LegRefID", Type: FieldTypeString},
+				{Tag: tag.LegPrice, Name: "LegPrice", Type: FieldTypeDecimal},
+				{Tag: tag.LegSettlType, Name: "LegSettlType", Type: FieldTypeString},
+				{Tag: tag.LegSettlDate, Name: "LegSettlDate", Type: FieldTypeString},
+				{Tag: tag.LegLastPx, Name: "LegLastPx", Type: FieldTypeDecimal},
+			}},
+			{Tag: tag.TradeRequestID, Name: "TradeRequestID", Type: FieldTypeString},
+			{Tag: tag.PreviouslyReported, Name: "PreviouslyReported", Type: FieldTypeBool, Required: true},
+			{Tag: tag.TradeReportID, Name: "TradeReportID", Type: FieldTypeString, Required: true},
+			{Tag: tag.TradeReportRefID, Name: "TradeReportRefID", Type: FieldTypeString},
+			{Tag: tag.MatchStatus, Name: "MatchStatus", Type: FieldTypeString},
+			{Tag: tag.MatchType, Name: "MatchType", Type: FieldTypeString},
+			{Tag: tag.BenchmarkPrice, Name: "BenchmarkPrice", Type: FieldTypeDecimal},
+			{Tag: tag.BenchmarkPriceType, Name: "BenchmarkPriceType", Type: FieldTypeInt},
+			{Tag: tag.ContractSettlMonth, Name: "ContractSettlMonth", Type: FieldTypeString},
+			{Tag: tag.LastParPx, Name: "LastParPx", Type: FieldTypeDecimal},
+			{Tag: tag.Pool, Name: "Pool", Type: FieldTypeString},
+			{Tag: tag.YieldRedemptionDate, Name: "YieldRedemptionDate", Type: FieldTypeString},
+			{Tag: tag.YieldRedemptionPrice, Name: "YieldRedemptionPrice", Type: FieldTypeDecimal},
+			{Tag: tag.YieldRedemptionPriceType, Name: "YieldRedemptionPriceType", Type: FieldTypeInt},
+			{Tag: tag.BenchmarkSecurityID, Name: "BenchmarkSecurityID", Type: FieldTypeString},
+			{Tag: tag.YieldCalcDate, Name: "YieldCalcDate", Type: FieldTypeString},
+			{Tag: tag.NoUnderlyings, Name: "NoUnderlyings", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.UnderlyingSymbol, Name: "UnderlyingSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.UnderlyingSymbolSfx, Name: "UnderlyingSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityID, Name: "UnderlyingSecurityID", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityIDSource, Name: "UnderlyingSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoUnderlyingSecurityAltID, Name: "NoUnderlyingSecurityAltID", Type: FieldTypeNumInGroup, Fields: noUnderlyingSecurityAltIDFields},
+				{Tag: tag.UnderlyingProduct, Name: "UnderlyingProduct", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingCFICode, Name: "UnderlyingCFICode", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityType, Name: "UnderlyingSecurityType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecuritySubType, Name: "UnderlyingSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityMonthYear, Name: "UnderlyingMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityDate, Name: "UnderlyingMaturityDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCouponPaymentDate, Name: "UnderlyingCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssueDate, Name: "UnderlyingIssueDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRepoCollateralSecurityType, Name: "UnderlyingRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseTerm, Name: "UnderlyingRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseRate, Name: "UnderlyingRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingFactor, Name: "UnderlyingFactor", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCreditRating, Name: "UnderlyingCreditRating", Type: FieldTypeString},
+				{Tag: tag.UnderlyingInstrRegistry, Name: "UnderlyingInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCountryOfIssue, Name: "UnderlyingCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStateOrProvinceOfIssue, Name: "UnderlyingStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingLocaleOfIssue, Name: "UnderlyingLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRedemptionDate, Name: "UnderlyingRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStrikePrice, Name: "UnderlyingStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStrikeCurrency, Name: "UnderlyingStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingOptAttribute, Name: "UnderlyingOptAttribute", Type: FieldTypeString},
+				{Tag: tag.UnderlyingContractMultiplier, Name: "UnderlyingContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCouponRate, Name: "UnderlyingCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingSecurityExchange, Name: "UnderlyingSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssuer, Name: "UnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingIssuerLen, Name: "EncodedUnderlyingIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingIssuer, Name: "EncodedUnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityDesc, Name: "UnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingSecurityDescLen, Name: "EncodedUnderlyingSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingSecurityDesc, Name: "EncodedUnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPProgram, Name: "UnderlyingCPProgram", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPRegType, Name: "UnderlyingCPRegType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCurrency, Name: "UnderlyingCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingQty, Name: "UnderlyingQty", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingPx, Name: "UnderlyingPx", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingDirtyPrice, Name: "UnderlyingDirtyPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndPrice, Name: "UnderlyingEndPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStartValue, Name: "UnderlyingStartValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCurrentValue, Name: "UnderlyingCurrentValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndValue, Name: "UnderlyingEndValue", Type: FieldTypeDecimal},
+				{Tag: tag.NoUnderlyingStips, Name: "NoUnderlyingStips", Type: FieldTypeNumInGroup, Fields: noUnderlyingStipsFields},
+			}},
+			{Tag: tag.ClearingBusinessDate, Name: "ClearingBusinessDate", Type: FieldTypeString},
+			{Tag: tag.TotNumTradeReports, Name: "TotNumTradeReports", Type: FieldTypeInt},
+			{Tag: tag.NoPosAmt, Name: "NoPosAmt", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.PosAmtType, Name: "PosAmtType", Type: FieldTypeString, Required: true},
+				{Tag: tag.PosAmt, Name: "PosAmt", Type: FieldTypeDecimal},
+			}},
+			{Tag: tag.BenchmarkSecurityIDSource, Name: "BenchmarkSecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.SecuritySubType, Name: "SecuritySubType", Type: FieldTypeString},
+			{Tag: tag.NoTrdRegTimestamps, Name: "NoTrdRegTimestamps", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.TrdRegTimestamp, Name: "TrdRegTimestamp", Type: FieldTypeUTCTimestamp, Required: true},
+				{Tag: tag.TrdRegTimestampType, Name: "TrdRegTimestampType", Type: FieldTypeString},
+				{Tag: tag.TrdRegTimestampOrigin, Name: "TrdRegTimestampOrigin", Type: FieldTypeString},
+			}},
+			{Tag: tag.TerminationType, Name: "TerminationType", Type: FieldTypeString},
+			{Tag: tag.SecondaryTradeReportID, Name: "SecondaryTradeReportID", Type: FieldTypeString},
+			{Tag: tag.AvgPxIndicator, Name: "AvgPxIndicator", Type: FieldTypeString},
+			{Tag: tag.TradeLinkID, Name: "TradeLinkID", Type: FieldTypeString},
+			{Tag: tag.UnderlyingTradingSessionID, Name: "UnderlyingTradingSessionID", Type: FieldTypeString},
+			{Tag: tag.UnderlyingTradingSessionSubID, Name: "UnderlyingTradingSessionSubID", Type: FieldTypeString},
+			{Tag: tag.TradeLegRefID, Name: "TradeLegRefID", Type: FieldTypeString},
+			{Tag: tag.TrdType, Name: "TrdType", Type: FieldTypeString},
+			{Tag: tag.TrdSubType, Name: "TrdSubType", Type: FieldTypeString},
+			{Tag: tag.TransferReason, Name: "TransferReason", Type: FieldTypeString},
+			{Tag: tag.QtyType, Name: "QtyType", Type: FieldTypeString},
+			{Tag: tag.SecondaryTrdType, Name: "SecondaryTrdType", Type: FieldTypeInt},
+			{Tag: tag.TradeReportType, Name: "TradeReportType", Type: FieldTypeString},
+			{Tag: tag.NoEvents, Name: "NoEvents", Type: FieldTypeNumInGroup, Fields: noEventsFields},
+			{Tag: tag.DatedDate, Name: "DatedDate", Type: FieldTypeString},
+			{Tag: tag.InterestAccrualDate, Name: "InterestAccrualDate", Type: FieldTypeString},
+			{Tag: tag.CPProgram, Name: "CPProgram", Type: FieldTypeString},
+			{Tag: tag.CPRegType, Name: "CPRegType", Type: FieldTypeString},
+			{Tag: tag.TrdMatchID, Name: "TrdMatchID", Type: FieldTypeString},
+			{Tag: tag.SecondaryTradeReportRefID, Name: "SecondaryTradeReportRefID", Type: FieldTypeString},
+			{Tag: tag.MarginRatio, Name: "MarginRatio", Type: FieldTypeDecimal},
+			{Tag: tag.LastRptRequested, Name: "LastRptRequested", Type: FieldTypeBool},
+			{Tag: tag.AgreementDesc, Name: "AgreementDesc", Type: FieldTypeString},
+			{Tag: tag.AgreementID, Name: "AgreementID", Type: FieldTypeString},
+			{Tag: tag.AgreementDate, Name: "AgreementDate", Type: FieldTypeString},
+			{Tag: tag.StartDate, Name: "StartDate", Type: FieldTypeString},
+			{Tag: tag.EndDate, Name: "EndDate", Type: FieldTypeString},
+			{Tag: tag.AgreementCurrency, Name: "AgreementCurrency", Type: FieldTypeString},
+			{Tag: tag.DeliveryType, Name: "DeliveryType", Type: FieldTypeString},
+			{Tag: tag.StrikeCurrency, Name: "StrikeCurrency", Type: FieldTypeString},
+		},
+	},
+	"AR": {
+		Name:    "TradeCaptureReportAck",
+		MsgType: "AR",
+		Fields: []FieldDef{
+			{Tag: tag.Account, Name: "Account", Type: FieldTypeString},
+			{Tag: tag.ExecID, Name: "ExecID", Type: FieldTypeString},
+			{Tag: tag.SecurityIDSource, Name: "SecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.SecurityID, Name: "SecurityID", Type: FieldTypeString},
+			{Tag: tag.Symbol, Name: "Symbol", Type: FieldTypeString},
+			{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+			{Tag: tag.TransactTime, Name: "TransactTime", Type: FieldTypeUTCTimestamp},
+			{Tag: tag.SymbolSfx, Name: "SymbolSfx", Type: FieldTypeString},
+			{Tag: tag.PositionEffect, Name: "PositionEffect", Type: FieldTypeString},
+			{Tag: tag.NoAllocs, Name: "NoAllocs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.AllocAccount, Name: "AllocAccount", Type: FieldTypeString, Required: true},
+				{Tag: tag.AllocAcctIDSource, Name: "AllocAcctIDSource", Type: FieldTypeInt},
+				{Tag: tag.AllocSettlCurrency, Name: "AllocSettlCurrency", Type: FieldTypeString},
+				{Tag: tag.IndividualAllocID, Name: "IndividualAllocID", Type: FieldTypeString},
+				{Tag: tag.NoNested2PartyIDs, Name: "NoNested2PartyIDs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+					{Tag: tag.Nested2PartyID, Name: "Nested2PartyID", Type: FieldTypeString, Required: true},
+					{Tag: tag.Nested2PartyIDSource, Name: "Nested2PartyIDSource", Type: FieldTypeString},
+					{Tag: tag.Nested2PartyRole, Name: "Nested2PartyRole", Type: FieldTypeInt},
+					{Tag: tag.NoNested2PartySubIDs, Name: "NoNested2PartySubIDs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+						{Tag: tag.Nested2PartySubID, Name: "Nested2PartySubID", Type: FieldTypeString, Required: true},
+						{Tag: tag.Nested2PartySubIDType, Name: "Nested2PartySubIDType", Type: FieldTypeInt},
+					}},
+				}},
+				{Tag: tag.AllocQty, Name: "AllocQty", Type: FieldTypeDecimal},
+			}},
+			{Tag: tag.Issuer, Name: "Issuer", Type: FieldTypeString},
+			{Tag: tag.SecurityDesc, Name: "SecurityDesc", Type: FieldTypeString},
+			{Tag: tag.ExecType, Name: "ExecType", Type: FieldTypeString, Required: true},
+			{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+			{Tag: tag.MaturityMonthYear, Name: "MaturityMonthYear", Type: FieldTypeString},
+			{Tag: tag.StrikePrice, Name: "StrikePrice", Type: FieldTypeDecimal},
+			{Tag: tag.OptAttribute, Name: "OptAttribute", Type: FieldTypeString},
+			{Tag: tag.SecurityExchange, Name: "SecurityExchange", Type: FieldTypeString},
+			{Tag: tag.CouponRate, Name: "CouponRate", Type: FieldTypeDecimal},
+			{Tag: tag.CouponPaymentDate, Name: "CouponPaymentDate", Type: FieldTypeString},
+			{Tag: tag.IssueDate, Name: "IssueDate", Type: FieldTypeString},
+			{Tag: tag.RepurchaseTerm, Name: "RepurchaseTerm", Type: FieldTypeInt},
+			{Tag: tag.RepurchaseRate, Name: "RepurchaseRate", Type: FieldTypeDecimal},
+			{Tag: tag.Factor, Name: "Factor", Type: FieldTypeDecimal},
+			{Tag: tag.ContractMultiplier, Name: "ContractMultiplier", Type: FieldTypeDecimal},
+			{Tag: tag.RepoCollateralSecurityType, Name: "RepoCollateralSecurityType", Type: FieldTypeInt},
+			{Tag: tag.RedemptionDate, Name: "RedemptionDate", Type: FieldTypeString},
+			{Tag: tag.CreditRating, Name: "CreditRating", Type: FieldTypeString},
+			{Tag: tag.SubscriptionRequestType, Name: "SubscriptionRequestType", Type: FieldTypeString},
+			{Tag: tag.EncodedIssuerLen, Name: "EncodedIssuerLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedIssuer, Name: "EncodedIssuer", Type: FieldTypeString},
+			{Tag: tag.EncodedSecurityDescLen, Name: "EncodedSecurityDescLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedSecurityDesc, Name: "EncodedSecurityDesc", Type: FieldTypeString},
+			{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			{Tag: tag.NoSecurityAltID, Name: "NoSecurityAltID", Type: FieldTypeNumInGroup, Fields: noSecurityAltIDFields},
+			{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+			{Tag: tag.CFICode, Name: "CFICode", Type: FieldTypeString},
+			{Tag: tag.CountryOfIssue, Name: "CountryOfIssue", Type: FieldTypeString},
+			{Tag: tag.StateOrProvinceOfIssue, Name: "StateOrProvinceOfIssue", Type: FieldTypeString},
+			{Tag: tag.LocaleOfIssue, Name: "LocaleOfIssue", Type: FieldTypeString},
+			{Tag: tag.TradeReportTransType, Name: "TradeReportTransType", Type: FieldTypeString},
+			{Tag: tag.SecondaryExecID, Name: "SecondaryExecID", Type: FieldTypeString},
+			{Tag: tag.OrderCapacity, Name: "OrderCapacity", Type: FieldTypeString},
+			{Tag: tag.OrderRestrictions, Name: "OrderRestrictions", Type: FieldTypeString},
+			{Tag: tag.MaturityDate, Name: "MaturityDate", Type: FieldTypeString},
+			{Tag: tag.InstrRegistry, Name: "InstrRegistry", Type: FieldTypeString},
+			{Tag: tag.NoLegs, Name: "NoLegs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.LegSymbol, Name: "LegSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.LegSymbolSfx, Name: "LegSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.LegSecurityID, Name: "LegSecurityID", Type: FieldTypeString},
+				{Tag: tag.LegSecurityIDSource, Name: "LegSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoLegSecurityAltID, Name: "NoLegSecurityAltID", Type: FieldTypeNumInGroup, Fields: noLegSecurityAltIDFields},
+				{Tag: tag.LegProduct, Name: "LegProduct", Type: FieldTypeInt},
+				{Tag: tag.LegCFICode, Name: "LegCFICode", Type: FieldTypeString},
+				{Tag: tag.LegSecurityType, Name: "LegSecurityType", Type: FieldTypeString},
+				{Tag: tag.LegSecuritySubType, Name: "LegSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.LegMaturityMonthYear, Name: "LegMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.LegMaturityDate, Name: "LegMaturityDate", Type: FieldTypeString},
+				{Tag: tag.LegCouponPaymentDate, Name: "LegCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.LegIssueDate, Name: "LegIssueDate", Type: FieldTypeString},
+				{Tag: tag.LegRepoCollateralSecurityType, Name: "LegRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseTerm, Name: "LegRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseRate, Name: "LegRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegFactor, Name: "LegFactor", Type: FieldTypeDecimal},
+				{Tag: tag.LegCreditRating, Name: "LegCreditRating", Type: FieldTypeString},
+				{Tag: tag.LegInstrRegistry, Name: "LegInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.LegCountryOfIssue, Name: "LegCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegStateOrProvinceOfIssue, Name: "LegStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegLocaleOfIssue, Name: "LegLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegRedemptionDate, Name: "LegRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.LegStrikePrice, Name: "LegStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.LegStrikeCurrency, Name: "LegStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.LegOptAttribute, Name: "LegOptAttribute", Type: FieldTypeString},
+				{Tag: tag.LegContractMultiplier, Name: "LegContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.LegCouponRate, Name: "LegCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegSecurityExchange, Name: "LegSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.LegIssuer, Name: "LegIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedLegIssuerLen, Name: "EncodedLegIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegIssuer, Name: "EncodedLegIssuer", Type: FieldTypeString},
+				{Tag: tag.LegSecurityDesc, Name: "LegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedLegSecurityDescLen, Name: "EncodedLegSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegSecurityDesc, Name: "EncodedLegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.LegRatioQty, Name: "LegRatioQty", Type: FieldTypeDecimal},
+				{Tag: tag.LegSide, Name: "LegSide", Type: FieldTypeString},
+				{Tag: tag.LegCurrency, Name: "LegCurrency", Type: FieldTypeString},
+				{Tag: tag.LegPool, Name: "LegPool", Type: FieldTypeString},
+				{Tag: tag.LegDatedDate, Name: "LegDatedDate", Type: FieldTypeString},
+				{Tag: tag.LegContractSettlMonth, Name: "LegContractSettlMonth", Type: FieldTypeString},
+				{Tag: tag.LegInterestAccrualDate, Name: "LegInterestAccrualDate", Type: FieldTypeString},
+				{Tag: tag.LegQty, Name: "LegQty", Type: FieldTypeDecimal},
+				{Tag: tag.LegSwapType, Name: "LegSwapType", Type: FieldTypeString},
+				{Tag: tag.NoLegStipulations, Name: "NoLegStipulations", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+					{Tag: tag.LegStipulationType, Name: "LegStipulationType", Type: FieldTypeString, Required: true},
+					{Tag: tag.LegStipulationValue, Name: "LegStipulationValue", Type: FieldTypeString},
+				}},
+				{Tag: tag.LegPositionEffect, Name: "LegPositionEffect", Type: FieldTypeString},
+				{Tag: tag.LegCoveredOrUncovered, Name: "LegCoveredOrUncovered", Type: FieldTypeInt},
+				{Tag: tag.NoNestedPartyIDs, Name: "NoNestedPartyIDs", Type: FieldTypeNumInGroup, Fields: noNestedPartyIDsFields},
+				{Tag: tag.LegRefID, Name: "LegRefID", Type: FieldTypeString},
+				{Tag: tag.LegPrice, Name: "LegPrice", Type: FieldTypeDecimal},
+				{Tag: tag.LegSettlType, Name: "LegSettlType", Type: FieldTypeString},
+				{Tag: tag.LegSettlDate, Name: "LegSettlDate", Type: FieldTypeString},
+				{Tag: tag.LegLastPx, Name: "LegLastPx", Type: FieldTypeDecimal},
+			}},
+			{Tag: tag.TradeReportID, Name: "TradeReportID", Type: FieldTypeString, Required: true},
+			{Tag: tag.TradeReportRefID, Name: "TradeReportRefID", Type: FieldTypeString},
+			{Tag: tag.AccountType, Name: "AccountType", Type: FieldTypeString},
+			{Tag: tag.CustOrderCapacity, Name: "CustOrderCapacity", Type: FieldTypeString},
+			{Tag: tag.PreallocMethod, Name: "PreallocMethod", Type: FieldTypeString},
+			{Tag: tag.ClearingFeeIndicator, Name: "ClearingFeeIndicator", Type: FieldTypeString},
+			{Tag: tag.AcctIDSource, Name: "AcctIDSource", Type: FieldTypeString},
+			{Tag: tag.ContractSettlMonth, Name: "ContractSettlMonth", Type: FieldTypeString},
+			{Tag: tag.Pool, Name: "Pool", Type: FieldTypeString},
+			{Tag: tag.ResponseTransportType, Name: "ResponseTransportType", Type: FieldTypeString},
+			{Tag: tag.ResponseDestination, Name: "ResponseDestination", Type: FieldTypeString},
+			{Tag: tag.TradeReportRejectReason, Name: "TradeReportRejectReason", Type: FieldTypeString},
+			{Tag: tag.SecuritySubType, Name: "SecuritySubType", Type: FieldTypeString},
+			{Tag: tag.NoTrdRegTimestamps, Name: "NoTrdRegTimestamps", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.TrdRegTimestamp, Name: "TrdRegTimestamp", Type: FieldTypeUTCTimestamp, Required: true},
+				{Tag: tag.TrdRegTimestampType, Name: "TrdRegTimestampType", Type: FieldTypeString},
+				{Tag: tag.TrdRegTimestampOrigin, Name: "TrdRegTimestampOrigin", Type: FieldTypeString},
+			}},
+			{Tag: tag.SecondaryTradeReportID, Name: "SecondaryTradeReportID", Type: FieldTypeString},
+			{Tag: tag.TradeLinkID, Name: "TradeLinkID", Type: FieldTypeString},
+			{Tag: tag.TrdType, Name: "TrdType", Type: FieldTypeString},
+			{Tag: tag.TrdSubType, Name: "TrdSubType", Type: FieldTypeString},
+			{Tag: tag.TransferReason, Name: "TransferReason", Type: FieldTypeString},
+			{Tag: tag.SecondaryTrdType, Name: "SecondaryTrdType", Type: FieldTypeInt},
+			{Tag: tag.TradeReportType, Name: "TradeReportType", Type: FieldTypeString},
+			{Tag: tag.NoEvents, Name: "NoEvents", Type: FieldTypeNumInGroup, Fields: noEventsFields},
+			{Tag: tag.DatedDate, Name: "DatedDate", Type: FieldTypeString},
+			{Tag: tag.InterestAccrualDate, Name: "InterestAccrualDate", Type: FieldTypeString},
+			{Tag: tag.CPProgram, Name: "CPProgram", Type: FieldTypeString},
+			{Tag: tag.CPRegType, Name: "CPRegType", Type: FieldTypeString},
+			{Tag: tag.TrdMatchID, Name: "TrdMatchID", Type: FieldTypeString},
+			{Tag: tag.SecondaryTradeReportRefID, Name: "SecondaryTradeReportRefID", Type: FieldTypeString},
+			{Tag: tag.TrdRptStatus, Name: "TrdRptStatus", Type: FieldTypeString},
+			{Tag: tag.StrikeCurrency, Name: "StrikeCurrency", Type: FieldTypeString},
+		},
+	},
+	"AD": {
+		Name:    "TradeCaptureReportRequest",
+		MsgType: "AD",
+		Fields: []FieldDef{
+			{Tag: tag.ClOrdID, Name: "ClOrdID", Type: FieldTypeString},
+			{Tag: tag.ExecID, Name: "ExecID", Type: FieldTypeString},
+			{Tag: tag.SecurityIDSource, Name: "SecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.OrderID, Name: "OrderID", Type: FieldTypeString},
+			{Tag: tag.SecurityID, Name: "SecurityID", Type: FieldTypeString},
+			{Tag: tag.Side, Name: "Side", Type: FieldTypeString},
+			{Tag: tag.Symbol, Name: "Symbol", Type: FieldTypeString},
+			{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+			{Tag: tag.SymbolSfx, Name: "SymbolSfx", Type: FieldTypeString},
+			{Tag: tag.Issuer, Name: "Issuer", Type: FieldTypeString},
+			{Tag: tag.SecurityDesc, Name: "SecurityDesc", Type: FieldTypeString},
+			{Tag: tag.ExecType, Name: "ExecType", Type: FieldTypeString},
+			{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+			{Tag: tag.MaturityMonthYear, Name: "MaturityMonthYear", Type: FieldTypeString},
+			{Tag: tag.StrikePrice, Name: "StrikePrice", Type: FieldTypeDecimal},
+			{Tag: tag.OptAttribute, Name: "OptAttribute", Type: FieldTypeString},
+			{Tag: tag.SecurityExchange, Name: "SecurityExchange", Type: FieldTypeString},
+			{Tag: tag.CouponRate, Name: "CouponRate", Type: FieldTypeDecimal},
+			{Tag: tag.CouponPaymentDate, Name: "CouponPaymentDate", Type: FieldTypeString},
+			{Tag: tag.IssueDate, Name: "IssueDate", Type: FieldTypeString},
+			{Tag: tag.RepurchaseTerm, Name: "RepurchaseTerm", Type: FieldTypeInt},
+			{Tag: tag.RepurchaseRate, Name: "RepurchaseRate", Type: FieldTypeDecimal},
+			{Tag: tag.Factor, Name: "Factor", Type: FieldTypeDecimal},
+			{Tag: tag.ContractMultiplier, Name: "ContractMultiplier", Type: FieldTypeDecimal},
+			{Tag: tag.RepoCollateralSecurityType, Name: "RepoCollateralSecurityType", Type: FieldTypeInt},
+			{Tag: tag.RedemptionDate, Name: "RedemptionDate", Type: FieldTypeString},
+			{Tag: tag.CreditRating, Name: "CreditRating", Type: FieldTypeString},
+			{Tag: tag.SubscriptionRequestType, Name: "SubscriptionRequestType", Type: FieldTypeString},
+			{Tag: tag.TradingSessionID, Name: "TradingSessionID", Type: FieldTypeString},
+			{Tag: tag.EncodedIssuerLen, Name: "EncodedIssuerLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedIssuer, Name: "EncodedIssuer", Type: FieldTypeString},
+			{Tag: tag.EncodedSecurityDescLen, Name: "EncodedSecurityDescLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedSecurityDesc, Name: "EncodedSecurityDesc", Type: FieldTypeString},
+			{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			{Tag: tag.MultiLegReportingType, Name: "MultiLegReportingType", Type: FieldTypeString},
+			{Tag: tag.NoPartyIDs, Name: "NoPartyIDs", Type: FieldTypeNumInGroup, Fields: noPartyIDsFields},
+			{Tag: tag.NoSecurityAltID, Name: "NoSecurityAltID", Type: FieldTypeNumInGroup, Fields: noSecurityAltIDFields},
+			{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+			{Tag: tag.CFICode, Name: "CFICode", Type: FieldTypeString},
+			{Tag: tag.CountryOfIssue, Name: "CountryOfIssue", Type: FieldTypeString},
+			{Tag: tag.StateOrProvinceOfIssue, Name: "StateOrProvinceOfIssue", Type: FieldTypeString},
+			{Tag: tag.LocaleOfIssue, Name: "LocaleOfIssue", Type: FieldTypeString},
+			{Tag: tag.MaturityDate, Name: "MaturityDate", Type: FieldTypeString},
+			{Tag: tag.InstrRegistry, Name: "InstrRegistry", Type: FieldTypeString},
+			{Tag: tag.NoLegs, Name: "NoLegs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.LegSymbol, Name: "LegSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.LegSymbolSfx, Name: "LegSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.LegSecurityID, Name: "LegSecurityID", Type: FieldTypeString},
+				{Tag: tag.LegSecurityIDSource, Name: "LegSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoLegSecurityAltID, Name: "NoLegSecurityAltID", Type: FieldTypeNumInGroup, Fields: noLegSecurityAltIDFields},
+				{Tag: tag.LegProduct, Name: "LegProduct", Type: FieldTypeInt},
+				{Tag: tag.LegCFICode, Name: "LegCFICode", Type: FieldTypeString},
+				{Tag: tag.LegSecurityType, Name: "LegSecurityType", Type: FieldTypeString},
+				{Tag: tag.LegSecuritySubType, Name: "LegSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.LegMaturityMonthYear, Name: "LegMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.LegMaturityDate, Name: "LegMaturityDate", Type: FieldTypeString},
+				{Tag: tag.LegCouponPaymentDate, Name: "LegCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.LegIssueDate, Name: "LegIssueDate", Type: FieldTypeString},
+				{Tag: tag.LegRepoCollateralSecurityType, Name: "LegRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseTerm, Name: "LegRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseRate, Name: "LegRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegFactor, Name: "LegFactor", Type: FieldTypeDecimal},
+				{Tag: tag.LegCreditRating, Name: "LegCreditRating", Type: FieldTypeString},
+				{Tag: tag.LegInstrRegistry, Name: "LegInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.LegCountryOfIssue, Name: "LegCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegStateOrProvinceOfIssue, Name: "LegStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegLocaleOfIssue, Name: "LegLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegRedemptionDate, Name: "LegRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.LegStrikePrice, Name: "LegStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.LegStrikeCurrency, Name: "LegStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.LegOptAttribute, Name: "LegOptAttribute", Type: FieldTypeString},
+				{Tag: tag.LegContractMultiplier, Name: "LegContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.LegCouponRate, Name: "LegCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegSecurityExchange, Name: "LegSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.LegIssuer, Name: "LegIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedLegIssuerLen, Name: "EncodedLegIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegIssuer, Name: "EncodedLegIssuer", Type: FieldTypeString},
+				{Tag: tag.LegSecurityDesc, Name: "LegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedLegSecurityDescLen, Name: "EncodedLegSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegSecurityDesc, Name: "EncodedLegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.LegRatioQty, Name: "LegRatioQty", Type: FieldTypeDecimal},
+				{Tag: tag.LegSide, Name: "LegSide", Type: FieldTypeString},
+				{Tag: tag.LegCurrency, Name: "LegCurrency", Type: FieldTypeString},
+				{Tag: tag.LegPool, Name: "LegPool", Type: FieldTypeString},
+				{Tag: tag.LegDatedDate, Name: "LegDatedDate", Type: FieldTypeString},
+				{Tag: tag.LegContractSettlMonth, Name: "LegContractSettlMonth", Type: FieldTypeString},
+				{Tag: tag.LegInterestAccrualDate, Name: "LegInterestAccrualDate", Type: FieldTypeString},
+			}},
+			{Tag: tag.TradeRequestID, Name: "TradeRequestID", Type: FieldTypeString, Required: true},
+			{Tag: tag.TradeRequestType, Name: "TradeRequestType", Type: FieldTypeString, Required: true},
+			{Tag: tag.TradeReportID, Name: "TradeReportID", Type: FieldTypeString},
+			{Tag: tag.MatchStatus, Name: "MatchStatus", Type: FieldTypeString},
+			{Tag: tag.TradeInputSource, Name: "TradeInputSource", Type: FieldTypeString},
+			{Tag: tag.TradeInputDevice, Name: "TradeInputDevice", Type: FieldTypeString},
+			{Tag: tag.NoDates, Name: "NoDates", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.TradeDate, Name: "TradeDate", Type: FieldTypeString, Required: true},
+				{Tag: tag.TransactTime, Name: "TransactTime", Type: FieldTypeUTCTimestamp},
+			}},
+			{Tag: tag.TradingSessionSubID, Name: "TradingSessionSubID", Type: FieldTypeString},
+			{Tag: tag.ContractSettlMonth, Name: "ContractSettlMonth", Type: FieldTypeString},
+			{Tag: tag.DeliveryForm, Name: "DeliveryForm", Type: FieldTypeString},
+			{Tag: tag.Pool, Name: "Pool", Type: FieldTypeString},
+			{Tag: tag.NoUnderlyings, Name: "NoUnderlyings", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.UnderlyingSymbol, Name: "UnderlyingSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.UnderlyingSymbolSfx, Name: "UnderlyingSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityID, Name: "UnderlyingSecurityID", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityIDSource, Name: "UnderlyingSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoUnderlyingSecurityAltID, Name: "NoUnderlyingSecurityAltID", Type: FieldTypeNumInGroup, Fields: noUnderlyingSecurityAltIDFields},
+				{Tag: tag.UnderlyingProduct, Name: "UnderlyingProduct", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingCFICode, Name: "UnderlyingCFICode", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityType, Name: "UnderlyingSecurityType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecuritySubType, Name: "UnderlyingSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityMonthYear, Name: "UnderlyingMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityDate, Name: "UnderlyingMaturityDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCouponPaymentDate, Name: "UnderlyingCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssueDate, Name: "UnderlyingIssueDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRepoCollateralSecurityType, Name: "UnderlyingRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseTerm, Name: "UnderlyingRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseRate, Name: "UnderlyingRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingFactor, Name: "UnderlyingFactor", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCreditRating, Name: "UnderlyingCreditRating", Type: FieldTypeString},
+				{Tag: tag.UnderlyingInstrRegistry, Name: "UnderlyingInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCountryOfIssue, Name: "UnderlyingCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStateOrProvinceOfIssue, Name: "UnderlyingStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingLocaleOfIssue, Name: "UnderlyingLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRedemptionDate, Name: "UnderlyingRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStrikePrice, Name: "UnderlyingStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStrikeCurrency, Name: "UnderlyingStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingOptAttribute, Name: "UnderlyingOptAttribute", Type: FieldTypeString},
+				{Tag: tag.UnderlyingContractMultiplier, Name: "UnderlyingContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCouponRate, Name: "UnderlyingCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingSecurityExchange, Name: "UnderlyingSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssuer, Name: "UnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingIssuerLen, Name: "EncodedUnderlyingIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingIssuer, Name: "EncodedUnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityDesc, Name: "UnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingSecurityDescLen, Name: "EncodedUnderlyingSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingSecurityDesc, Name: "EncodedUnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPProgram, Name: "UnderlyingCPProgram", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPRegType, Name: "UnderlyingCPRegType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCurrency, Name: "UnderlyingCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingQty, Name: "UnderlyingQty", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingPx, Name: "UnderlyingPx", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingDirtyPrice, Name: "UnderlyingDirtyPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndPrice, Name: "UnderlyingEndPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStartValue, Name: "UnderlyingStartValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCurrentValue, Name: "UnderlyingCurrentValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndValue, Name: "UnderlyingEndValue", Type: FieldTypeDecimal},
+				{Tag: tag.NoUnderlyingStips, Name: "NoUnderlyingStips", Type: FieldTypeNumInGroup, Fields: noUnderlyingStipsFields},
+			}},
+			{Tag: tag.ClearingBusinessDate, Name: "ClearingBusinessDate", Type: FieldTypeString},
+			{Tag: tag.ResponseTransportType, Name: "ResponseTransportType", Type: FieldTypeString},
+			{Tag: tag.ResponseDestination, Name: "ResponseDestination", Type: FieldTypeString},
+			{Tag: tag.SecuritySubType, Name: "SecuritySubType", Type: FieldTypeString},
+			{Tag: tag.TerminationType, Name: "TerminationType", Type: FieldTypeString},
+			{Tag: tag.SecondaryTradeReportID, Name: "SecondaryTradeReportID", Type: FieldTypeString},
+			{Tag: tag.TradeLinkID, Name: "TradeLinkID", Type: FieldTypeString},
+			{Tag: tag.TrdType, Name: "TrdType", Type: FieldTypeString},
+			{Tag: tag.TrdSubType, Name: "TrdSubType", Type: FieldTypeString},
+			{Tag: tag.TransferReason, Name: "TransferReason", Type: FieldTypeString},
+			{Tag: tag.SecondaryTrdType, Name: "SecondaryTrdType", Type: FieldTypeInt},
+			{Tag: tag.NoEvents, Name: "NoEvents", Type: FieldTypeNumInGroup, Fields: noEventsFields},
+			{Tag: tag.PctAtRisk, Name: "PctAtRisk", Type: FieldTypeDecimal},
+			{Tag: tag.NoInstrAttrib, Name: "NoInstrAttrib", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.InstrAttribType, Name: "InstrAttribType", Type: FieldTypeString, Required: true},
+				{Tag: tag.InstrAttribValue, Name: "InstrAttribValue", Type: FieldTypeString},
+			}},
+			{Tag: tag.DatedDate, Name: "DatedDate", Type: FieldTypeString},
+			{Tag: tag.InterestAccrualDate, Name: "InterestAccrualDate", Type: FieldTypeString},
+			{Tag: tag.CPProgram, Name: "CPProgram", Type: FieldTypeString},
+			{Tag: tag.CPRegType, Name: "CPRegType", Type: FieldTypeString},
+			{Tag: tag.TrdMatchID, Name: "TrdMatchID", Type: FieldTypeString},
+			{Tag: tag.MarginRatio, Name: "MarginRatio", Type: FieldTypeDecimal},
+			{Tag: tag.AgreementDesc, Name: "AgreementDesc", Type: FieldTypeString},
+			{Tag: tag.AgreementID, Name: "AgreementID", Type: FieldTypeString},
+			{Tag: tag.AgreementDate, Name: "AgreementDate", Type: FieldTypeString},
+			{Tag: tag.StartDate, Name: "StartDate", Type: FieldTypeString},
+			{Tag: tag.EndDate, Name: "EndDate", Type: FieldTypeString},
+			{Tag: tag.AgreementCurrency, Name: "AgreementCurrency", Type: FieldTypeString},
+			{Tag: tag.DeliveryType, Name: "DeliveryType", Type: FieldTypeString},
+			{Tag: tag.TimeBracket, Name: "TimeBracket", Type: FieldTypeString},
+			{Tag: tag.StrikeCurrency, Name: "StrikeCurrency", Type: FieldTypeString},
+		},
+	},
+	"AQ": {
+		Name:    "TradeCaptureReportRequestAck",
+		MsgType: "AQ",
+		Fields: []FieldDef{
+			{Tag: tag.SecurityIDSource, Name: "SecurityIDSource", Type: FieldTypeString},
+			{Tag: tag.SecurityID, Name: "SecurityID", Type: FieldTypeString},
+			{Tag: tag.Symbol, Name: "Symbol", Type: FieldTypeString},
+			{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+			{Tag: tag.SymbolSfx, Name: "SymbolSfx", Type: FieldTypeString},
+			{Tag: tag.Issuer, Name: "Issuer", Type: FieldTypeString},
+			{Tag: tag.SecurityDesc, Name: "SecurityDesc", Type: FieldTypeString},
+			{Tag: tag.SecurityType, Name: "SecurityType", Type: FieldTypeString},
+			{Tag: tag.MaturityMonthYear, Name: "MaturityMonthYear", Type: FieldTypeString},
+			{Tag: tag.StrikePrice, Name: "StrikePrice", Type: FieldTypeDecimal},
+			{Tag: tag.OptAttribute, Name: "OptAttribute", Type: FieldTypeString},
+			{Tag: tag.SecurityExchange, Name: "SecurityExchange", Type: FieldTypeString},
+			{Tag: tag.CouponRate, Name: "CouponRate", Type: FieldTypeDecimal},
+			{Tag: tag.CouponPaymentDate, Name: "CouponPaymentDate", Type: FieldTypeString},
+			{Tag: tag.IssueDate, Name: "IssueDate", Type: FieldTypeString},
+			{Tag: tag.RepurchaseTerm, Name: "RepurchaseTerm", Type: FieldTypeInt},
+			{Tag: tag.RepurchaseRate, Name: "RepurchaseRate", Type: FieldTypeDecimal},
+			{Tag: tag.Factor, Name: "Factor", Type: FieldTypeDecimal},
+			{Tag: tag.ContractMultiplier, Name: "ContractMultiplier", Type: FieldTypeDecimal},
+			{Tag: tag.RepoCollateralSecurityType, Name: "RepoCollateralSecurityType", Type: FieldTypeInt},
+			{Tag: tag.RedemptionDate, Name: "RedemptionDate", Type: FieldTypeString},
+			{Tag: tag.CreditRating, Name: "CreditRating", Type: FieldTypeString},
+			{Tag: tag.SubscriptionRequestType, Name: "SubscriptionRequestType", Type: FieldTypeString},
+			{Tag: tag.EncodedIssuerLen, Name: "EncodedIssuerLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedIssuer, Name: "EncodedIssuer", Type: FieldTypeString},
+			{Tag: tag.EncodedSecurityDescLen, Name: "EncodedSecurityDescLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedSecurityDesc, Name: "EncodedSecurityDesc", Type: FieldTypeString},
+			{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			{Tag: tag.MultiLegReportingType, Name: "MultiLegReportingType", Type: FieldTypeString},
+			{Tag: tag.NoSecurityAltID, Name: "NoSecurityAltID", Type: FieldTypeNumInGroup, Fields: noSecurityAltIDFields},
+			{Tag: tag.Product, Name: "Product", Type: FieldTypeString},
+			{Tag: tag.CFICode, Name: "CFICode", Type: FieldTypeString},
+			{Tag: tag.CountryOfIssue, Name: "CountryOfIssue", Type: FieldTypeString},
+			{Tag: tag.StateOrProvinceOfIssue, Name: "StateOrProvinceOfIssue", Type: FieldTypeString},
+			{Tag: tag.LocaleOfIssue, Name: "LocaleOfIssue", Type: FieldTypeString},
+			{Tag: tag.MaturityDate, Name: "MaturityDate", Type: FieldTypeString},
+			{Tag: tag.InstrRegistry, Name: "InstrRegistry", Type: FieldTypeString},
+			{Tag: tag.NoLegs, Name: "NoLegs", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.LegSymbol, Name: "LegSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.LegSymbolSfx, Name: "LegSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.LegSecurityID, Name: "LegSecurityID", Type: FieldTypeString},
+				{Tag: tag.LegSecurityIDSource, Name: "LegSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoLegSecurityAltID, Name: "NoLegSecurityAltID", Type: FieldTypeNumInGroup, Fields: noLegSecurityAltIDFields},
+				{Tag: tag.LegProduct, Name: "LegProduct", Type: FieldTypeInt},
+				{Tag: tag.LegCFICode, Name: "LegCFICode", Type: FieldTypeString},
+				{Tag: tag.LegSecurityType, Name: "LegSecurityType", Type: FieldTypeString},
+				{Tag: tag.LegSecuritySubType, Name: "LegSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.LegMaturityMonthYear, Name: "LegMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.LegMaturityDate, Name: "LegMaturityDate", Type: FieldTypeString},
+				{Tag: tag.LegCouponPaymentDate, Name: "LegCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.LegIssueDate, Name: "LegIssueDate", Type: FieldTypeString},
+				{Tag: tag.LegRepoCollateralSecurityType, Name: "LegRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseTerm, Name: "LegRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.LegRepurchaseRate, Name: "LegRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegFactor, Name: "LegFactor", Type: FieldTypeDecimal},
+				{Tag: tag.LegCreditRating, Name: "LegCreditRating", Type: FieldTypeString},
+				{Tag: tag.LegInstrRegistry, Name: "LegInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.LegCountryOfIssue, Name: "LegCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegStateOrProvinceOfIssue, Name: "LegStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegLocaleOfIssue, Name: "LegLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.LegRedemptionDate, Name: "LegRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.LegStrikePrice, Name: "LegStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.LegStrikeCurrency, Name: "LegStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.LegOptAttribute, Name: "LegOptAttribute", Type: FieldTypeString},
+				{Tag: tag.LegContractMultiplier, Name: "LegContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.LegCouponRate, Name: "LegCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.LegSecurityExchange, Name: "LegSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.LegIssuer, Name: "LegIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedLegIssuerLen, Name: "EncodedLegIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegIssuer, Name: "EncodedLegIssuer", Type: FieldTypeString},
+				{Tag: tag.LegSecurityDesc, Name: "LegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedLegSecurityDescLen, Name: "EncodedLegSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedLegSecurityDesc, Name: "EncodedLegSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.LegRatioQty, Name: "LegRatioQty", Type: FieldTypeDecimal},
+				{Tag: tag.LegSide, Name: "LegSide", Type: FieldTypeString},
+				{Tag: tag.LegCurrency, Name: "LegCurrency", Type: FieldTypeString},
+				{Tag: tag.LegPool, Name: "LegPool", Type: FieldTypeString},
+				{Tag: tag.LegDatedDate, Name: "LegDatedDate", Type: FieldTypeString},
+				{Tag: tag.LegContractSettlMonth, Name: "LegContractSettlMonth", Type: FieldTypeString},
+				{Tag: tag.LegInterestAccrualDate, Name: "LegInterestAccrualDate", Type: FieldTypeString},
+			}},
+			{Tag: tag.TradeRequestID, Name: "TradeRequestID", Type: FieldTypeString, Required: true},
+			{Tag: tag.TradeRequestType, Name: "TradeRequestType", Type: FieldTypeString, Required: true},
+			{Tag: tag.ContractSettlMonth, Name: "ContractSettlMonth", Type: FieldTypeString},
+			{Tag: tag.Pool, Name: "Pool", Type: FieldTypeString},
+			{Tag: tag.NoUnderlyings, Name: "NoUnderlyings", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+				{Tag: tag.UnderlyingSymbol, Name: "UnderlyingSymbol", Type: FieldTypeString, Required: true},
+				{Tag: tag.UnderlyingSymbolSfx, Name: "UnderlyingSymbolSfx", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityID, Name: "UnderlyingSecurityID", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityIDSource, Name: "UnderlyingSecurityIDSource", Type: FieldTypeString},
+				{Tag: tag.NoUnderlyingSecurityAltID, Name: "NoUnderlyingSecurityAltID", Type: FieldTypeNumInGroup, Fields: noUnderlyingSecurityAltIDFields},
+				{Tag: tag.UnderlyingProduct, Name: "UnderlyingProduct", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingCFICode, Name: "UnderlyingCFICode", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityType, Name: "UnderlyingSecurityType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecuritySubType, Name: "UnderlyingSecuritySubType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityMonthYear, Name: "UnderlyingMaturityMonthYear", Type: FieldTypeString},
+				{Tag: tag.UnderlyingMaturityDate, Name: "UnderlyingMaturityDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCouponPaymentDate, Name: "UnderlyingCouponPaymentDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssueDate, Name: "UnderlyingIssueDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRepoCollateralSecurityType, Name: "UnderlyingRepoCollateralSecurityType", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseTerm, Name: "UnderlyingRepurchaseTerm", Type: FieldTypeInt},
+				{Tag: tag.UnderlyingRepurchaseRate, Name: "UnderlyingRepurchaseRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingFactor, Name: "UnderlyingFactor", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCreditRating, Name: "UnderlyingCreditRating", Type: FieldTypeString},
+				{Tag: tag.UnderlyingInstrRegistry, Name: "UnderlyingInstrRegistry", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCountryOfIssue, Name: "UnderlyingCountryOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStateOrProvinceOfIssue, Name: "UnderlyingStateOrProvinceOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingLocaleOfIssue, Name: "UnderlyingLocaleOfIssue", Type: FieldTypeString},
+				{Tag: tag.UnderlyingRedemptionDate, Name: "UnderlyingRedemptionDate", Type: FieldTypeString},
+				{Tag: tag.UnderlyingStrikePrice, Name: "UnderlyingStrikePrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStrikeCurrency, Name: "UnderlyingStrikeCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingOptAttribute, Name: "UnderlyingOptAttribute", Type: FieldTypeString},
+				{Tag: tag.UnderlyingContractMultiplier, Name: "UnderlyingContractMultiplier", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCouponRate, Name: "UnderlyingCouponRate", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingSecurityExchange, Name: "UnderlyingSecurityExchange", Type: FieldTypeString},
+				{Tag: tag.UnderlyingIssuer, Name: "UnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingIssuerLen, Name: "EncodedUnderlyingIssuerLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingIssuer, Name: "EncodedUnderlyingIssuer", Type: FieldTypeString},
+				{Tag: tag.UnderlyingSecurityDesc, Name: "UnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.EncodedUnderlyingSecurityDescLen, Name: "EncodedUnderlyingSecurityDescLen", Type: FieldTypeInt},
+				{Tag: tag.EncodedUnderlyingSecurityDesc, Name: "EncodedUnderlyingSecurityDesc", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPProgram, Name: "UnderlyingCPProgram", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCPRegType, Name: "UnderlyingCPRegType", Type: FieldTypeString},
+				{Tag: tag.UnderlyingCurrency, Name: "UnderlyingCurrency", Type: FieldTypeString},
+				{Tag: tag.UnderlyingQty, Name: "UnderlyingQty", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingPx, Name: "UnderlyingPx", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingDirtyPrice, Name: "UnderlyingDirtyPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndPrice, Name: "UnderlyingEndPrice", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingStartValue, Name: "UnderlyingStartValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingCurrentValue, Name: "UnderlyingCurrentValue", Type: FieldTypeDecimal},
+				{Tag: tag.UnderlyingEndValue, Name: "UnderlyingEndValue", Type: FieldTypeDecimal},
+				{Tag: tag.NoUnderlyingStips, Name: "NoUnderlyingStips", Type: FieldTypeNumInGroup, Fields: noUnderlyingStipsFields},
+			}},
+			{Tag: tag.ResponseTransportType, Name: "ResponseTransportType", Type: FieldTypeString},
+			{Tag: tag.ResponseDestination, Name: "ResponseDestination", Type: FieldTypeString},
+			{Tag: tag.TotNumTradeReports, Name: "TotNumTradeReports", Type: FieldTypeInt},
+			{Tag: tag.TradeRequestResult, Name: "TradeRequestResult", Type: FieldTypeString, Required: true},
+			{Tag: tag.TradeRequestStatus, Name: "TradeRequestStatus", Type: FieldTypeString, Required: true},
+			{Tag: tag.SecuritySubType, Name: "SecuritySubType", Type: FieldTypeString},
+			{Tag: tag.NoEvents, Name: "NoEvents", Type: FieldTypeNumInGroup, Fields: noEventsFields},
+			{Tag: tag.DatedDate, Name: "DatedDate", Type: FieldTypeString},
+			{Tag: tag.InterestAccrualDate, Name: "InterestAccrualDate", Type: FieldTypeString},
+			{Tag: tag.CPProgram, Name: "CPProgram", Type: FieldTypeString},
+			{Tag: tag.CPRegType, Name: "CPRegType", Type: FieldTypeString},
+			{Tag: tag.StrikeCurrency, Name: "StrikeCurrency", Type: FieldTypeString},
+		},
+	},
+	"h": {
+		Name:    "TradingSessionStatus",
+		MsgType: "h",
+		Fields: []FieldDef{
+			{Tag: tag.Text, Name: "Text", Type: FieldTypeString},
+			{Tag: tag.UnsolicitedIndicator, Name: "UnsolicitedIndicator", Type: FieldTypeBool},
+			{Tag: tag.TradSesReqID, Name: "TradSesReqID", Type: FieldTypeString},
+			{Tag: tag.TradingSessionID, Name: "TradingSessionID", Type: FieldTypeString, Required: true},
+			{Tag: tag.TradSesMethod, Name: "TradSesMethod", Type: FieldTypeString},
+			{Tag: tag.TradSesMode, Name: "TradSesMode", Type: FieldTypeString},
+			{Tag: tag.TradSesStatus, Name: "TradSesStatus", Type: FieldTypeString, Required: true},
+			{Tag: tag.TradSesStartTime, Name: "TradSesStartTime", Type: FieldTypeUTCTimestamp},
+			{Tag: tag.TradSesOpenTime, Name: "TradSesOpenTime", Type: FieldTypeUTCTimestamp},
+			{Tag: tag.TradSesPreCloseTime, Name: "TradSesPreCloseTime", Type: FieldTypeUTCTimestamp},
+			{Tag: tag.TradSesCloseTime, Name: "TradSesCloseTime", Type: FieldTypeUTCTimestamp},
+			{Tag: tag.TradSesEndTime, Name: "TradSesEndTime", Type: FieldTypeUTCTimestamp},
+			{Tag: tag.EncodedTextLen, Name: "EncodedTextLen", Type: FieldTypeInt},
+			{Tag: tag.EncodedText, Name: "EncodedText", Type: FieldTypeString},
+			{Tag: tag.TotalVolumeTraded, Name: "TotalVolumeTraded", Type: FieldTypeDecimal},
+			{Tag: tag.TradSesStatusRejReason, Name: "TradSesStatusRejReason", Type: FieldTypeString},
+			{Tag: tag.TradingSessionSubID, Name: "TradingSessionSubID", Type: FieldTypeString},
+		},
+	},
+	"g": {
+		Name:    "TradingSessionStatusRequest",
+		MsgType: "g",
+		Fields: []FieldDef{
+			{Tag: tag.SubscriptionRequestType, Name: "SubscriptionRequestType", Type: FieldTypeString, Required: true},
+			{Tag: tag.TradSesReqID, Name: "TradSesReqID", Type: FieldTypeString, Required: true},
+			{Tag: tag.TradingSessionID, Name: "TradingSessionID", Type: FieldTypeString},
+			{Tag: tag.TradSesMethod, Name: "TradSesMethod", Type: FieldTypeString},
+			{Tag: tag.TradSesMode, Name: "TradSesMode", Type: FieldTypeString},
+			{Tag: tag.TradingSessionSubID, Name: "TradingSessionSubID", Type: FieldTypeString},
+		},
+	},
+	"BE": {
+		Name:    "UserRequest",
+		MsgType: "BE",
+		Fields: []FieldDef{
+			{Tag: tag.RawDataLength, Name: "RawDataLength", Type: FieldTypeInt},
+			{Tag: tag.RawData, Name: "RawData", Type: FieldTypeString},
+			{Tag: tag.Username, Name: "Username", Type: FieldTypeString, Required: true},
+			{Tag: tag.Password, Name: "Password", Type: FieldTypeString},
+			{Tag: tag.UserRequestID, Name: "UserRequestID", Type: FieldTypeString, Required: true},
+			{Tag: tag.UserRequestType, Name: "UserRequestType", Type: FieldTypeString, Required: true},
+			{Tag: tag.NewPassword, Name: "NewPassword", Type: FieldTypeString},
+		},
+	},
+	"BF": {
+		Name:    "UserResponse",
+		MsgType: "BF",
+		Fields: []FieldDef{
+			{Tag: tag.Username, Name: "Username", Type: FieldTypeString, Required: true},
+			{Tag: tag.UserRequestID, Name: "UserRequestID", Type: FieldTypeString, Required: true},
+			{Tag: tag.UserStatus, Name: "UserStatus", Type: FieldTypeString},
+			{Tag: tag.UserStatusText, Name: "UserStatusText", Type: FieldTypeString},
+		},
+	},
+}
+
+//HeaderFields holds the definition of the fix44 Header fields
+var HeaderFields = []FieldDef{
+	{Tag: tag.BeginString, Name: "BeginString", Type: FieldTypeString, Required: true},
+	{Tag: tag.BodyLength, Name: "BodyLength", Type: FieldTypeInt, Required: true},
+	{Tag: tag.MsgSeqNum, Name: "MsgSeqNum", Type: FieldTypeInt, Required: true},
+	{Tag: tag.MsgType, Name: "MsgType", Type: FieldTypeString, Required: true},
+	{Tag: tag.PossDupFlag, Name: "PossDupFlag", Type: FieldTypeBool},
+	{Tag: tag.SenderCompID, Name: "SenderCompID", Type: FieldTypeString, Required: true},
+	{Tag: tag.SenderSubID, Name: "SenderSubID", Type: FieldTypeString},
+	{Tag: tag.SendingTime, Name: "SendingTime", Type: FieldTypeUTCTimestamp, Required: true},
+	{Tag: tag.TargetCompID, Name: "TargetCompID", Type: FieldTypeString, Required: true},
+	{Tag: tag.TargetSubID, Name: "TargetSubID", Type: FieldTypeString},
+	{Tag: tag.SecureDataLen, Name: "SecureDataLen", Type: FieldTypeInt},
+	{Tag: tag.SecureData, Name: "SecureData", Type: FieldTypeString},
+	{Tag: tag.PossResend, Name: "PossResend", Type: FieldTypeBool},
+	{Tag: tag.OnBehalfOfCompID, Name: "OnBehalfOfCompID", Type: FieldTypeString},
+	{Tag: tag.OnBehalfOfSubID, Name: "OnBehalfOfSubID", Type: FieldTypeString},
+	{Tag: tag.OrigSendingTime, Name: "OrigSendingTime", Type: FieldTypeUTCTimestamp},
+	{Tag: tag.DeliverToCompID, Name: "DeliverToCompID", Type: FieldTypeString},
+	{Tag: tag.DeliverToSubID, Name: "DeliverToSubID", Type: FieldTypeString},
+	{Tag: tag.SenderLocationID, Name: "SenderLocationID", Type: FieldTypeString},
+	{Tag: tag.TargetLocationID, Name: "TargetLocationID", Type: FieldTypeString},
+	{Tag: tag.OnBehalfOfLocationID, Name: "OnBehalfOfLocationID", Type: FieldTypeString},
+	{Tag: tag.DeliverToLocationID, Name: "DeliverToLocationID", Type: FieldTypeString},
+	{Tag: tag.XmlDataLen, Name: "XmlDataLen", Type: FieldTypeInt},
+	{Tag: tag.XmlData, Name: "XmlData", Type: FieldTypeString},
+	{Tag: tag.MessageEncoding, Name: "MessageEncoding", Type: FieldTypeString},
+	{Tag: tag.LastMsgSeqNumProcessed, Name: "LastMsgSeqNumProcessed", Type: FieldTypeInt},
+	{Tag: tag.NoHops, Name: "NoHops", Type: FieldTypeNumInGroup, Fields: []FieldDef{
+		{Tag: tag.HopCompID, Name: "HopCompID", Type: FieldTypeString, Required: true},
+		{Tag: tag.HopSendingTime, Name: "HopSendingTime", Type: FieldTypeUTCTimestamp},
+		{Tag: tag.HopRefID, Name: "HopRefID", Type: FieldTypeInt},
+	}},
+}
+
+//TrailerFields holds the definition of the fix44 Trailer fields
+var TrailerFields = []FieldDef{
+	{Tag: tag.CheckSum, Name: "CheckSum", Type: FieldTypeString, Required: true},
+	{Tag: tag.Signature, Name: "Signature", Type: FieldTypeString},
+	{Tag: tag.SignatureLength, Name: "SignatureLength", Type: FieldTypeInt},
+}
+
+var noSecurityAltIDFields = []FieldDef{
+	{Tag: tag.SecurityAltID, Name: "SecurityAltID", Type: FieldTypeString, Required: true},
+	{Tag: tag.SecurityAltIDSource, Name: "SecurityAltIDSource", Type: FieldTypeString},
+}
+
+var noEventsFields = []FieldDef{
+	{Tag: tag.EventType, Name: "EventType", Type: FieldTypeString, Required: true},
+	{Tag: tag.EventDate, Name: "EventDate", Type: FieldTypeString},
+	{Tag: tag.EventPx, Name: "EventPx", Type: FieldTypeDecimal},
+	{Tag: tag.EventText, Name: "EventText", Type: FieldTypeString},
+}
+
+var noLegSecurityAltIDFields = []FieldDef{
+	{Tag: tag.LegSecurityAltID, Name: "LegSecurityAltID", Type: FieldTypeString, Required: true},
+	{Tag: tag.LegSecurityAltIDSource, Name: "LegSecurityAltIDSource", Type: FieldTypeString},
+}
+
+var noUnderlyingSecurityAltIDFields = []FieldDef{
+	{Tag: tag.UnderlyingSecurityAltID, Name: "UnderlyingSecurityAltID", Type: FieldTypeString, Required: true},
+	{Tag: tag.UnderlyingSecurityAltIDSource, Name: "UnderlyingSecurityAltIDSource", Type: FieldTypeString},
+}
+
+var noUnderlyingStipsFields = []FieldDef{
+	{Tag: tag.UnderlyingStipType, Name: "UnderlyingStipType", Type: FieldTypeString, Required: true},
+	{Tag: tag.UnderlyingStipValue, Name: "UnderlyingStipValue", Type: FieldTypeString},
+}
+
+var noPartyIDsFields = []FieldDef{
+	{Tag: tag.PartyID, Name: "PartyID", Type: FieldTypeString, Required: true},
+	{Tag: tag.PartyIDSource, Name: "PartyIDSource", Type: FieldTypeString},
+	{Tag: tag.PartyRole, Name: "PartyRole", Type: FieldTypeString},
+	{Tag: tag.NoPartySubIDs, Name: "NoPartySubIDs", Type: FieldTypeNumInGroup, Fields: noPartySubIDsFields},
+}
+
+var noPartySubIDsFields = []FieldDef{
+	{Tag: tag.PartySubID, Name: "PartySubID", Type: FieldTypeString, Required: true},
+	{Tag: tag.PartySubIDType, Name: "PartySubIDType", Type: FieldTypeString},
+}
+
+var noNestedPartyIDsFields = []FieldDef{
+	{Tag: tag.NestedPartyID, Name: "NestedPartyID", Type: FieldTypeString, Required: true},
+	{Tag: tag.NestedPartyIDSource, Name: "NestedPartyIDSource", Type: FieldTypeString},
+	{Tag: tag.NestedPartyRole, Name: "NestedPartyRole", Type: FieldTypeInt},
+	{Tag: tag.NoNestedPartySubIDs, Name: "NoNestedPartySubIDs", Type: FieldTypeNumInGroup, Fields: noNestedPartySubIDsFields},
+}
+
+var noNestedPartySubIDsFields = []FieldDef{
+	{Tag: tag.NestedPartySubID, Name: "NestedPartySubID", Type: FieldTypeString, Required: true},
+	{Tag: tag.NestedPartySubIDType, Name: "NestedPartySubIDType", Type: FieldTypeInt},
+}
+
+var noStipulationsFields = []FieldDef{
+	{Tag: tag.StipulationType, Name: "StipulationType", Type: FieldTypeString, Required: true},
+	{Tag: tag.StipulationValue, Name: "StipulationValue", Type: FieldTypeString},
+}
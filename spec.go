@@ -0,0 +1,92 @@
+package fix44
+
+import (
+	"github.com/terracefi/quickfix"
+	"github.com/terracefi/tag"
+)
+
+//FieldType is the type of the value of a field, as used by the generated accessors
+type FieldType int
+
+//FieldType values
+const (
+	FieldTypeString FieldType = iota
+	FieldTypeInt
+	FieldTypeBool
+	FieldTypeUTCTimestamp
+	FieldTypeDecimal
+	FieldTypeNumInGroup
+)
+
+//FieldDef describes a field of a message type, repeating group, Header or Trailer
+type FieldDef struct {
+	Tag      quickfix.Tag
+	Name     string
+	Type     FieldType
+	Required bool
+
+	//Fields holds the fields of a group element, in order, if the field is the NumInGroup field of a repeating group
+	Fields []FieldDef
+}
+
+//IsGroup returns true if the field is the NumInGroup field of a repeating group
+func (f FieldDef) IsGroup() bool {
+	return f.Type == FieldTypeNumInGroup
+}
+
+//NewRepeatingGroup returns an empty RepeatingGroup for a group field, using the template given by its Fields
+func (f FieldDef) NewRepeatingGroup() *quickfix.RepeatingGroup {
+	template := make(quickfix.GroupTemplate, len(f.Fields))
+	for i, e := range f.Fields {
+		if e.IsGroup() {
+			template[i] = e.NewRepeatingGroup()
+		} else {
+			template[i] = quickfix.GroupElement(e.Tag)
+		}
+	}
+	return quickfix.NewRepeatingGroup(f.Tag, template)
+}
+
+//MessageDef describes a fix44 message type
+type MessageDef struct {
+	Name    string
+	MsgType string
+	Fields  []FieldDef
+}
+
+//Field returns the definition of the field with tag t, searching repeating groups as well
+func (d MessageDef) Field(t quickfix.Tag) (FieldDef, bool) {
+	return findField(d.Fields, t)
+}
+
+//HeaderField returns the definition of the Header field with tag t
+func HeaderField(t quickfix.Tag) (FieldDef, bool) {
+	return findField(HeaderFields, t)
+}
+
+//TrailerField returns the definition of the Trailer field with tag t
+func TrailerField(t quickfix.Tag) (FieldDef, bool) {
+	return findField(TrailerFields, t)
+}
+
+func findField(fields []FieldDef, t quickfix.Tag) (FieldDef, bool) {
+	for _, f := range fields {
+		if f.Tag == t {
+			return f, true
+		}
+		if f.IsGroup() {
+			if g, ok := findField(f.Fields, t); ok {
+				return g, true
+			}
+		}
+	}
+	return FieldDef{}, false
+}
+
+//Redacted is shown in place of the value of a secret field by the helpers that print messages
+const Redacted = "*****"
+
+//IsSecret returns true for the fields whose value must not be logged, Password and NewPassword
+func IsSecret(t quickfix.Tag) bool {
+	return t == tag.Password || t == tag.NewPassword
+}
@@ -0,0 +1,22 @@
+/*
+Package ssi keeps a database of standing settlement instructions and serves SettlementInstructionRequests from it.
+
+A Store applies the NoSettlInst entries of SettlementInstructions messages according to their SettlInstTransType:
+New adds an instruction, Replace and Cancel replace or remove the instruction named by SettlInstRefID, and Restate
+adds or overwrites the instruction with the same SettlInstID. The account of an instruction is given by its party
+with PartyRole = Customer Account. FIX 4.4 does not carry a settlement currency in either message, so instructions
+are not selected by currency.
+
+Both modes of SettlementInstructions are supported. In standing instruction mode the instructions are pushed to the
+Store unsolicited, with SettlInstMode = Standing Instructions Provided and no SettlInstReqID. In request/response
+mode RequestInstructions sends a SettlementInstructionRequest and the response, sent with the SettlInstReqID of the
+request, is recorded on the Request, including a response with SettlInstMode = Request Reject and its
+SettlInstReqRejCode. On the other side of the exchange, Answer builds the response to a request and
+OnSettlementInstructionRequest sends it.
+
+Match returns the instructions that fit a Query: an instruction matches unless one of its fields differs from the
+query, and a field the instruction leaves out matches anything. Resolve picks the most specific instruction, the one
+with most fields equal to the query, and ForAllocation and ForConfirmation resolve the instructions for the
+accounts of an AllocationInstruction or a Confirmation.
+*/
+package ssi
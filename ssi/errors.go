@@ -0,0 +1,50 @@
+package ssi
+
+import (
+	"fmt"
+)
+
+//UnknownInstructionError is returned for a Replace or Cancel that refers to an instruction the Store does not know
+type UnknownInstructionError struct {
+	SettlInstID string
+}
+
+func (e *UnknownInstructionError) Error() string {
+	return fmt.Sprintf("ssi: unknown settlement instruction %v", e.SettlInstID)
+}
+
+//DuplicateInstructionError is returned for a New or Replace that reuses the SettlInstID of a known instruction
+type DuplicateInstructionError struct {
+	SettlInstID string
+}
+
+func (e *DuplicateInstructionError) Error() string {
+	return fmt.Sprintf("ssi: duplicate SettlInstID %v", e.SettlInstID)
+}
+
+//NoInstructionError is returned when no instruction matches the account, side and instrument to settle
+type NoInstructionError struct {
+	Account string
+}
+
+func (e *NoInstructionError) Error() string {
+	return fmt.Sprintf("ssi: no settlement instruction for account %v", e.Account)
+}
+
+//UnknownRequestError is returned for a response to a SettlInstReqID the Store did not send
+type UnknownRequestError struct {
+	SettlInstReqID string
+}
+
+func (e *UnknownRequestError) Error() string {
+	return fmt.Sprintf("ssi: unknown SettlInstReqID %v", e.SettlInstReqID)
+}
+
+//DuplicateRequestError is returned for a request that reuses a SettlInstReqID
+type DuplicateRequestError struct {
+	SettlInstReqID string
+}
+
+func (e *DuplicateRequestError) Error() string {
+	return fmt.Sprintf("ssi: duplicate SettlInstReqID %v", e.SettlInstReqID)
+}
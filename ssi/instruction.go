@@ -0,0 +1,177 @@
+package ssi
+
+import (
+	"time"
+
+	"github.com/terracefi/enum"
+	"github.com/terracefi/fix44/components"
+	"github.com/terracefi/fix44/internal/fixutil"
+	"github.com/terracefi/fix44/settlementinstructionrequest"
+	"github.com/terracefi/fix44/settlementinstructions"
+)
+
+//SettlInstMode values, FIX 4.4
+const (
+	ModeDefault                           enum.SettlInstMode = "0"
+	ModeStandingInstructions              enum.SettlInstMode = "1"
+	ModeSpecificAllocationAccountOverride enum.SettlInstMode = "2"
+	ModeSpecificAllocationAccountStanding enum.SettlInstMode = "3"
+	ModeSpecificOrder                     enum.SettlInstMode = "4"
+	ModeRequestReject                     enum.SettlInstMode = "5"
+)
+
+//SettlInstTransType values, FIX 4.4
+const (
+	TransNew     enum.SettlInstTransType = "N"
+	TransCancel  enum.SettlInstTransType = "C"
+	TransReplace enum.SettlInstTransType = "R"
+	TransRestate enum.SettlInstTransType = "T"
+)
+
+//SettlInstReqRejCode values, FIX 4.4
+const (
+	RejUnableToProcess       enum.SettlInstReqRejCode = "0"
+	RejUnknownAccount        enum.SettlInstReqRejCode = "1"
+	RejNoMatchingInstruction enum.SettlInstReqRejCode = "2"
+	RejOther                 enum.SettlInstReqRejCode = "99"
+)
+
+//RoleCustomerAccount is the PartyRole of the party that gives the account of an instruction
+const RoleCustomerAccount enum.PartyRole = "24"
+
+//Instruction is a settlement instruction kept by a Store
+type Instruction struct {
+	//ID is the SettlInstID of the instruction
+	ID         string
+	Definition settlementinstructions.NoSettlInstStruct
+
+	//Mode, MsgID and ReqID are the SettlInstMode, SettlInstMsgID and SettlInstReqID of the message that last set
+	//the instruction
+	Mode  enum.SettlInstMode
+	MsgID string
+	ReqID string
+}
+
+//Account returns the account of the instruction, empty if it applies to any account
+func (i Instruction) Account() string {
+	return account(i.Definition.NoPartyIDs)
+}
+
+func account(parties []components.NoPartyIDsStruct) string {
+	for _, p := range parties {
+		if p.PartyRole != nil && *p.PartyRole == RoleCustomerAccount && p.PartyID != nil {
+			return *p.PartyID
+		}
+	}
+	return ""
+}
+
+func (i *Instruction) clone() Instruction {
+	c := *i
+	c.Definition.NoPartyIDs = append([]components.NoPartyIDsStruct(nil), i.Definition.NoPartyIDs...)
+	c.Definition.NoDlvyInst = append([]settlementinstructions.NoDlvyInstStruct(nil), i.Definition.NoDlvyInst...)
+	return c
+}
+
+//Query selects instructions, fields that are empty or nil select any value
+type Query struct {
+	Account      string
+	Side         *enum.Side
+	Product      *enum.Product
+	SecurityType *enum.SecurityType
+	CFICode      *string
+	//Parties must not contradict the parties of an instruction, a party of the query contradicts a party of the
+	//instruction with the same PartyRole and a different PartyID
+	Parties []components.NoPartyIDsStruct
+
+	//AsOf selects the instructions in effect at that time
+	AsOf time.Time
+	//UpdatedSince selects the instructions updated at or after that time
+	UpdatedSince time.Time
+
+	StandInstDbType *enum.StandInstDbType
+	StandInstDbName *string
+	StandInstDbID   *string
+}
+
+//RequestQuery returns the Query a SettlementInstructionRequest asks for
+func RequestQuery(r settlementinstructionrequest.Struct) Query {
+	q := Query{
+		Account:         fixutil.Deref(r.AllocAccount),
+		Side:            r.Side,
+		Product:         r.Product,
+		SecurityType:    r.SecurityType,
+		CFICode:         r.CFICode,
+		Parties:         r.NoPartyIDs,
+		StandInstDbType: r.StandInstDbType,
+		StandInstDbName: r.StandInstDbName,
+		StandInstDbID:   r.StandInstDbID,
+	}
+	if r.EffectiveTime != nil {
+		q.AsOf = *r.EffectiveTime
+	}
+	if r.LastUpdateTime != nil {
+		q.UpdatedSince = *r.LastUpdateTime
+	}
+	return q
+}
+
+//specificity returns the number of fields of the instruction equal to those of the query, or -1 if the instruction
+//does not match the query
+func (q Query) specificity(i *Instruction) int {
+	d := i.Definition
+	n := 0
+	same := func(ok, equal bool) bool {
+		if ok && equal {
+			n++
+		}
+		return !ok || equal
+	}
+
+	acct := i.Account()
+	if !same(q.Account != "" && acct != "", q.Account == acct) ||
+		!same(q.Side != nil && d.Side != nil, q.Side != nil && d.Side != nil && *q.Side == *d.Side) ||
+		!same(q.Product != nil && d.Product != nil, q.Product != nil && d.Product != nil && *q.Product == *d.Product) ||
+		!same(q.SecurityType != nil && d.SecurityType != nil,
+			q.SecurityType != nil && d.SecurityType != nil && *q.SecurityType == *d.SecurityType) ||
+		!same(q.CFICode != nil && d.CFICode != nil, q.CFICode != nil && d.CFICode != nil && *q.CFICode == *d.CFICode) ||
+		!sameDb(q.StandInstDbType, d.StandInstDbType) || !sameString(q.StandInstDbName, d.StandInstDbName) ||
+		!sameString(q.StandInstDbID, d.StandInstDbID) {
+		return -1
+	}
+
+	for _, qp := range q.Parties {
+		if qp.PartyRole == nil || qp.PartyID == nil {
+			continue
+		}
+		for _, p := range d.NoPartyIDs {
+			if p.PartyRole == nil || p.PartyID == nil || *p.PartyRole != *qp.PartyRole {
+				continue
+			}
+			if !same(true, *p.PartyID == *qp.PartyID) {
+				return -1
+			}
+		}
+	}
+
+	if !q.AsOf.IsZero() {
+		if d.EffectiveTime != nil && d.EffectiveTime.After(q.AsOf) {
+			return -1
+		}
+		if d.ExpireTime != nil && !d.ExpireTime.After(q.AsOf) {
+			return -1
+		}
+	}
+	if !q.UpdatedSince.IsZero() && d.LastUpdateTime != nil && d.LastUpdateTime.Before(q.UpdatedSince) {
+		return -1
+	}
+	return n
+}
+
+func sameDb(a, b *enum.StandInstDbType) bool {
+	return a == nil || b == nil || *a == *b
+}
+
+func sameString(a, b *string) bool {
+	return a == nil || b == nil || *a == *b
+}
@@ -0,0 +1,354 @@
+package ssi
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/terracefi/enum"
+	"github.com/terracefi/fix44/allocationinstruction"
+	"github.com/terracefi/fix44/confirmation"
+	"github.com/terracefi/fix44/internal/fixutil"
+	"github.com/terracefi/fix44/settlementinstructionrequest"
+	"github.com/terracefi/fix44/settlementinstructions"
+	"github.com/terracefi/quickfix"
+)
+
+//AllocSettlInstType values, FIX 4.4
+const (
+	allocUseDefault     enum.AllocSettlInstType = "0"
+	allocDeriveFromArgs enum.AllocSettlInstType = "1"
+)
+
+//Request is a SettlementInstructionRequest sent by the Store and the response it received
+type Request struct {
+	ID string
+
+	//Answered is true once the response has arrived
+	Answered bool
+	Mode     enum.SettlInstMode
+	//RejCode is the SettlInstReqRejCode of a response with SettlInstMode = Request Reject
+	RejCode *enum.SettlInstReqRejCode
+	Text    string
+	//IDs holds the SettlInstIDs of the instructions of the response
+	IDs []string
+}
+
+func (r *Request) clone() Request {
+	c := *r
+	c.IDs = append([]string(nil), r.IDs...)
+	return c
+}
+
+//Store keeps settlement instructions and the SettlementInstructionRequests sent for them. It is safe for concurrent
+//use.
+type Store struct {
+	//SendMessage sends the SettlementInstructionRequests, by default on the session given to New
+	SendMessage func(msg quickfix.Messagable) error
+	//NewMsgID returns the SettlInstMsgID of a response built by Answer, it defaults to a sequence number prefixed
+	//with the time New was called
+	NewMsgID func() string
+
+	mu           sync.Mutex
+	instructions map[string]*Instruction
+	requests     map[string]*Request
+}
+
+//New returns an empty Store that sends on sessionID
+func New(sessionID quickfix.SessionID) *Store {
+	return &Store{
+		SendMessage:  fixutil.SendOn(sessionID),
+		NewMsgID:     fixutil.NewIDs(),
+		instructions: make(map[string]*Instruction),
+		requests:     make(map[string]*Request),
+	}
+}
+
+//Instruction returns the instruction with the given SettlInstID
+func (s *Store) Instruction(id string) (Instruction, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i, ok := s.instructions[id]
+	if !ok {
+		return Instruction{}, false
+	}
+	return i.clone(), true
+}
+
+//Request returns the request with the given SettlInstReqID
+func (s *Store) Request(id string) (Request, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, ok := s.requests[id]
+	if !ok {
+		return Request{}, false
+	}
+	return r.clone(), true
+}
+
+//Match returns the instructions matching q, most specific first
+func (s *Store) Match(q Query) []Instruction {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var out []Instruction
+	for _, i := range s.match(q) {
+		out = append(out, i.clone())
+	}
+	return out
+}
+
+//match returns the instructions matching q, most specific first and, among equally specific ones, latest updated
+//first
+func (s *Store) match(q Query) []*Instruction {
+	type scored struct {
+		i *Instruction
+		n int
+	}
+	var found []scored
+	for _, i := range s.instructions {
+		if n := q.specificity(i); n >= 0 {
+			found = append(found, scored{i, n})
+		}
+	}
+	sort.Slice(found, func(a, b int) bool {
+		x, y := found[a], found[b]
+		if x.n != y.n {
+			return x.n > y.n
+		}
+		tx, ty := x.i.Definition.LastUpdateTime, y.i.Definition.LastUpdateTime
+		if tx != nil && ty != nil && !tx.Equal(*ty) {
+			return tx.After(*ty)
+		}
+		if (tx == nil) != (ty == nil) {
+			return tx != nil
+		}
+		return x.i.ID < y.i.ID
+	})
+
+	out := make([]*Instruction, len(found))
+	for k, f := range found {
+		out[k] = f.i
+	}
+	return out
+}
+
+//Resolve returns the most specific instruction matching q, see Match
+func (s *Store) Resolve(q Query) (Instruction, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	m := s.match(q)
+	if len(m) == 0 {
+		return Instruction{}, &NoInstructionError{q.Account}
+	}
+	return m[0].clone(), nil
+}
+
+//ForAllocation resolves the instruction of each account of an AllocationInstruction, keyed by AllocAccount.
+//Allocations that carry their own settlement details, with an AllocSettlInstType other than Use Default
+//Instructions or Derive From Parameters Provided, are left out.
+func (s *Store) ForAllocation(a allocationinstruction.Struct) (map[string]Instruction, error) {
+	out := make(map[string]Instruction)
+	for _, alloc := range a.NoAllocs {
+		if alloc.AllocAccount == nil {
+			continue
+		}
+		if t := alloc.AllocSettlInstType; t != nil && *t != allocUseDefault && *t != allocDeriveFromArgs {
+			continue
+		}
+
+		side := a.Side
+		q := Query{
+			Account:      *alloc.AllocAccount,
+			Side:         &side,
+			Product:      a.Product,
+			SecurityType: a.SecurityType,
+			CFICode:      a.CFICode,
+			Parties:      a.NoPartyIDs,
+		}
+		if a.TransactTime != nil {
+			q.AsOf = *a.TransactTime
+		}
+		i, err := s.Resolve(q)
+		if err != nil {
+			return out, err
+		}
+		out[*alloc.AllocAccount] = i
+	}
+	return out, nil
+}
+
+//ForConfirmation resolves the instruction for the AllocAccount of a Confirmation
+func (s *Store) ForConfirmation(c confirmation.Struct) (Instruction, error) {
+	side := c.Side
+	return s.Resolve(Query{
+		Account:      c.AllocAccount,
+		Side:         &side,
+		Product:      c.Product,
+		SecurityType: c.SecurityType,
+		CFICode:      c.CFICode,
+		Parties:      c.NoPartyIDs,
+		AsOf:         c.TransactTime,
+	})
+}
+
+//OnSettlementInstructions applies the instructions of msg to the Store and, when msg answers a request sent by the
+//Store, records the response on the request. It returns the instructions that were added or changed. An entry that
+//cannot be applied does not stop the others, the first error is returned.
+func (s *Store) OnSettlementInstructions(msg settlementinstructions.SettlementInstructions) ([]Instruction, error) {
+	m, err := settlementinstructions.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var r *Request
+	if m.SettlInstReqID != nil {
+		var ok bool
+		if r, ok = s.requests[*m.SettlInstReqID]; !ok {
+			return nil, &UnknownRequestError{*m.SettlInstReqID}
+		}
+		r.Answered = true
+		r.Mode = m.SettlInstMode
+		r.RejCode = m.SettlInstReqRejCode
+		r.Text = fixutil.Deref(m.Text)
+		r.IDs = nil
+	}
+	if m.SettlInstMode == ModeRequestReject {
+		return nil, nil
+	}
+
+	var changed []Instruction
+	var firstErr error
+	for _, d := range m.NoSettlInst {
+		i, err := s.apply(d, m)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		if r != nil {
+			r.IDs = append(r.IDs, i.ID)
+		}
+		changed = append(changed, i.clone())
+	}
+	return changed, firstErr
+}
+
+func (s *Store) apply(d settlementinstructions.NoSettlInstStruct, m settlementinstructions.Struct) (*Instruction, error) {
+	i := &Instruction{
+		ID:         fixutil.Deref(d.SettlInstID),
+		Definition: d,
+		Mode:       m.SettlInstMode,
+		MsgID:      m.SettlInstMsgID,
+		ReqID:      fixutil.Deref(m.SettlInstReqID),
+	}
+	transType := TransNew
+	if d.SettlInstTransType != nil {
+		transType = *d.SettlInstTransType
+	}
+
+	switch transType {
+	case TransReplace, TransCancel:
+		ref := fixutil.Deref(d.SettlInstRefID)
+		old, ok := s.instructions[ref]
+		if !ok {
+			return nil, &UnknownInstructionError{ref}
+		}
+		if transType == TransCancel {
+			delete(s.instructions, ref)
+			return old, nil
+		}
+		if _, ok := s.instructions[i.ID]; ok && i.ID != ref {
+			return nil, &DuplicateInstructionError{i.ID}
+		}
+		delete(s.instructions, ref)
+	case TransNew:
+		if _, ok := s.instructions[i.ID]; ok {
+			return nil, &DuplicateInstructionError{i.ID}
+		}
+	}
+	s.instructions[i.ID] = i
+	return i, nil
+}
+
+//RequestInstructions sends a SettlementInstructionRequest, the response is recorded by OnSettlementInstructions.
+//The request is forgotten if it cannot be sent.
+func (s *Store) RequestInstructions(r settlementinstructionrequest.Struct) error {
+	s.mu.Lock()
+	if _, ok := s.requests[r.SettlInstReqID]; ok {
+		s.mu.Unlock()
+		return &DuplicateRequestError{r.SettlInstReqID}
+	}
+	s.requests[r.SettlInstReqID] = &Request{ID: r.SettlInstReqID}
+	s.mu.Unlock()
+
+	if err := s.SendMessage(settlementinstructionrequest.Unmarshal(r)); err != nil {
+		s.mu.Lock()
+		delete(s.requests, r.SettlInstReqID)
+		s.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+//Answer builds the response to a SettlementInstructionRequest: the matching instructions, restated, with
+//SettlInstMode = Standing Instructions Provided, or SettlInstMode = Request Reject with Unknown Account when the
+//Store has no instruction for the account of the request and No Matching Settlement Instructions Found otherwise
+func (s *Store) Answer(r settlementinstructionrequest.Struct) settlementinstructions.Struct {
+	reqID := r.SettlInstReqID
+	resp := settlementinstructions.Struct{
+		SettlInstMsgID: s.NewMsgID(),
+		SettlInstReqID: &reqID,
+		SettlInstMode:  ModeStandingInstructions,
+		TransactTime:   time.Now(),
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	q := RequestQuery(r)
+	m := s.match(q)
+	if len(m) > 0 {
+		for _, i := range m {
+			d := i.clone().Definition
+			restate := TransRestate
+			d.SettlInstTransType = &restate
+			d.SettlInstRefID = nil
+			resp.NoSettlInst = append(resp.NoSettlInst, d)
+		}
+		return resp
+	}
+
+	code := RejNoMatchingInstruction
+	if q.Account != "" && !s.knowsAccount(q.Account) {
+		code = RejUnknownAccount
+	}
+	resp.SettlInstMode = ModeRequestReject
+	resp.SettlInstReqRejCode = &code
+	return resp
+}
+
+func (s *Store) knowsAccount(acct string) bool {
+	for _, i := range s.instructions {
+		if i.Account() == acct {
+			return true
+		}
+	}
+	return false
+}
+
+//OnSettlementInstructionRequest answers msg, see Answer, and sends the response
+func (s *Store) OnSettlementInstructionRequest(msg settlementinstructionrequest.SettlementInstructionRequest) error {
+	r, err := settlementinstructionrequest.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	return s.SendMessage(settlementinstructions.Unmarshal(s.Answer(r)))
+}
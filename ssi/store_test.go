@@ -0,0 +1,371 @@
+package ssi
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/terracefi/enum"
+	"github.com/terracefi/fix44/allocationinstruction"
+	"github.com/terracefi/fix44/components"
+	"github.com/terracefi/fix44/internal/fixutil"
+	"github.com/terracefi/fix44/settlementinstructionrequest"
+	"github.com/terracefi/fix44/settlementinstructions"
+	"github.com/terracefi/quickfix"
+)
+
+const (
+	buy  enum.Side = "1"
+	sell enum.Side = "2"
+)
+
+//entry is a settlement instruction of a SettlementInstructions message
+type entry struct {
+	id, refID string
+	transType enum.SettlInstTransType
+	account   string
+}
+
+func (e entry) Struct() settlementinstructions.NoSettlInstStruct {
+	d := settlementinstructions.NoSettlInstStruct{SettlInstID: fixutil.Ptr(e.id),
+		SettlInstTransType: fixutil.Ptr(e.transType)}
+	if e.refID != "" {
+		d.SettlInstRefID = fixutil.Ptr(e.refID)
+	}
+	if e.account != "" {
+		d.NoPartyIDs = parties(e.account)
+	}
+	return d
+}
+
+func parties(account string) []components.NoPartyIDsStruct {
+	return []components.NoPartyIDsStruct{{PartyID: fixutil.Ptr(account), PartyRole: fixutil.Ptr(RoleCustomerAccount)}}
+}
+
+func message(reqID string, mode enum.SettlInstMode, entries ...entry) settlementinstructions.SettlementInstructions {
+	m := settlementinstructions.Struct{SettlInstMsgID: "M1", SettlInstMode: mode, TransactTime: time.Now()}
+	if reqID != "" {
+		m.SettlInstReqID = fixutil.Ptr(reqID)
+	}
+	for _, e := range entries {
+		m.NoSettlInst = append(m.NoSettlInst, e.Struct())
+	}
+	return settlementinstructions.Unmarshal(m)
+}
+
+//ids returns the SettlInstIDs of the instructions of the Store, sorted
+func ids(s *Store) []string {
+	var out []string
+	for _, i := range s.Match(Query{}) {
+		out = append(out, i.ID)
+	}
+	sort.Strings(out)
+	return out
+}
+
+func TestOnSettlementInstructions(t *testing.T) {
+	tests := []struct {
+		name     string
+		messages [][]entry
+		wantErr  error
+		ids      []string
+		changed  []string
+	}{
+		{
+			name:     "new",
+			messages: [][]entry{{{"S1", "", TransNew, "ACC"}, {"S2", "", TransNew, ""}}},
+			ids:      []string{"S1", "S2"}, changed: []string{"S1", "S2"},
+		},
+		{
+			name:     "duplicate SettlInstID",
+			messages: [][]entry{{{"S1", "", TransNew, "ACC"}}, {{"S1", "", TransNew, "ACC"}}},
+			wantErr:  &DuplicateInstructionError{},
+			ids:      []string{"S1"},
+		},
+		{
+			name:     "replace",
+			messages: [][]entry{{{"S1", "", TransNew, "ACC"}}, {{"S2", "S1", TransReplace, "ACC"}}},
+			ids:      []string{"S2"}, changed: []string{"S2"},
+		},
+		{
+			name:     "replace keeping the SettlInstID",
+			messages: [][]entry{{{"S1", "", TransNew, "ACC"}}, {{"S1", "S1", TransReplace, "OTHER"}}},
+			ids:      []string{"S1"}, changed: []string{"S1"},
+		},
+		{
+			name: "replace onto another instruction",
+			messages: [][]entry{{{"S1", "", TransNew, "ACC"}, {"S2", "", TransNew, "ACC"}},
+				{{"S2", "S1", TransReplace, "ACC"}}},
+			wantErr: &DuplicateInstructionError{},
+			ids:     []string{"S1", "S2"},
+		},
+		{
+			name: "replace then cancel",
+			messages: [][]entry{{{"S1", "", TransNew, "ACC"}}, {{"S2", "S1", TransReplace, "ACC"}},
+				{{"S3", "S2", TransCancel, ""}}},
+			changed: []string{"S2"},
+		},
+		{
+			name:     "cancel of an unknown instruction",
+			messages: [][]entry{{{"S1", "", TransNew, "ACC"}}, {{"S3", "S2", TransCancel, ""}}},
+			wantErr:  &UnknownInstructionError{},
+			ids:      []string{"S1"},
+		},
+		{
+			name:     "replace of an unknown instruction",
+			messages: [][]entry{{{"S2", "S1", TransReplace, "ACC"}}},
+			wantErr:  &UnknownInstructionError{},
+		},
+		{
+			name: "failed entry does not stop the others",
+			messages: [][]entry{{{"S1", "", TransNew, "ACC"}},
+				{{"S1", "", TransNew, "ACC"}, {"S3", "S2", TransCancel, ""}, {"S2", "", TransNew, "ACC"}}},
+			wantErr: &DuplicateInstructionError{},
+			ids:     []string{"S1", "S2"}, changed: []string{"S2"},
+		},
+		{
+			name:     "restate",
+			messages: [][]entry{{{"S1", "", TransNew, "ACC"}}, {{"S1", "", TransRestate, "OTHER"}}},
+			ids:      []string{"S1"}, changed: []string{"S1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := New(quickfix.SessionID{})
+			var changed []Instruction
+			var err error
+			for i, entries := range tt.messages {
+				changed, err = s.OnSettlementInstructions(message("", ModeStandingInstructions, entries...))
+				if i < len(tt.messages)-1 && err != nil {
+					t.Fatalf("message %v: %v", i, err)
+				}
+			}
+			if reflect.TypeOf(err) != reflect.TypeOf(tt.wantErr) {
+				t.Fatalf("error = %v, want %T", err, tt.wantErr)
+			}
+
+			if got := ids(s); !reflect.DeepEqual(got, tt.ids) {
+				t.Errorf("instructions %v, want %v", got, tt.ids)
+			}
+			var got []string
+			for _, i := range changed {
+				got = append(got, i.ID)
+			}
+			if !reflect.DeepEqual(got, tt.changed) {
+				t.Errorf("changed %v, want %v", got, tt.changed)
+			}
+		})
+	}
+}
+
+func TestResolve(t *testing.T) {
+	now := time.Now()
+	instructions := []settlementinstructions.NoSettlInstStruct{
+		{SettlInstID: fixutil.Ptr("ANY")},
+		{SettlInstID: fixutil.Ptr("ACC"), NoPartyIDs: parties("ACC")},
+		{SettlInstID: fixutil.Ptr("ACC-SELL"), NoPartyIDs: parties("ACC"), Side: fixutil.Ptr(sell)},
+		{SettlInstID: fixutil.Ptr("OLD"), NoPartyIDs: parties("OLD"), ExpireTime: fixutil.Ptr(now.Add(-time.Hour))},
+		{SettlInstID: fixutil.Ptr("LATER"), NoPartyIDs: parties("LATER"),
+			EffectiveTime: fixutil.Ptr(now.Add(time.Hour))},
+		{SettlInstID: fixutil.Ptr("B1"), NoPartyIDs: parties("B"), LastUpdateTime: fixutil.Ptr(now.Add(-time.Hour))},
+		{SettlInstID: fixutil.Ptr("B2"), NoPartyIDs: parties("B"), LastUpdateTime: fixutil.Ptr(now)},
+		{SettlInstID: fixutil.Ptr("EX"), NoPartyIDs: append(parties("EX"),
+			components.NoPartyIDsStruct{PartyID: fixutil.Ptr("XCH"),
+				PartyRole: fixutil.Ptr(enum.PartyRole("22"))})},
+	}
+	tests := []struct {
+		name  string
+		query Query
+		want  string
+	}{
+		{"account", Query{Account: "ACC", Side: fixutil.Ptr(buy)}, "ACC"},
+		{"account and side", Query{Account: "ACC", Side: fixutil.Ptr(sell)}, "ACC-SELL"},
+		{"other account", Query{Account: "XYZ"}, "ANY"},
+		{"expired", Query{Account: "OLD", AsOf: now}, "ANY"},
+		{"expired, no AsOf", Query{Account: "OLD"}, "OLD"},
+		{"not yet effective", Query{Account: "LATER", AsOf: now}, "ANY"},
+		{"latest updated", Query{Account: "B"}, "B2"},
+		{"updated since", Query{Account: "B", UpdatedSince: now.Add(-time.Minute)}, "B2"},
+		{"same party", Query{Account: "EX", Parties: []components.NoPartyIDsStruct{{PartyID: fixutil.Ptr("XCH"),
+			PartyRole: fixutil.Ptr(enum.PartyRole("22"))}}}, "EX"},
+		{"contradicting party", Query{Account: "EX",
+			Parties: []components.NoPartyIDsStruct{{PartyID: fixutil.Ptr("OTH"),
+				PartyRole: fixutil.Ptr(enum.PartyRole("22"))}}}, "ANY"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := New(quickfix.SessionID{})
+			msg := settlementinstructions.Struct{SettlInstMsgID: "M1", SettlInstMode: ModeStandingInstructions,
+				NoSettlInst: instructions}
+			if _, err := s.OnSettlementInstructions(settlementinstructions.Unmarshal(msg)); err != nil {
+				t.Fatal(err)
+			}
+
+			i, err := s.Resolve(tt.query)
+			if err != nil || i.ID != tt.want {
+				t.Errorf("Resolve() = %v, %v, want %v", i.ID, err, tt.want)
+			}
+		})
+	}
+
+	if _, err := New(quickfix.SessionID{}).Resolve(Query{Account: "ACC"}); reflect.TypeOf(err) !=
+		reflect.TypeOf(&NoInstructionError{}) {
+		t.Errorf("Resolve() on an empty Store error = %v, want *NoInstructionError", err)
+	}
+}
+
+func TestForAllocation(t *testing.T) {
+	s := New(quickfix.SessionID{})
+	s.OnSettlementInstructions(message("", ModeStandingInstructions, entry{"S1", "", TransNew, "A1"},
+		entry{"S2", "", TransNew, "A2"}))
+
+	a := allocationinstruction.Struct{Side: buy, NoAllocs: []allocationinstruction.NoAllocsStruct{
+		{AllocAccount: fixutil.Ptr("A1")},
+		{AllocAccount: fixutil.Ptr("A2"), AllocSettlInstType: fixutil.Ptr(enum.AllocSettlInstType("2"))},
+		{AllocAccount: fixutil.Ptr("A3"), AllocSettlInstType: fixutil.Ptr(allocDeriveFromArgs)},
+	}}
+	got, err := s.ForAllocation(a)
+	if _, ok := err.(*NoInstructionError); !ok {
+		t.Fatalf("ForAllocation() error = %v, want *NoInstructionError for A3", err)
+	}
+	if len(got) != 1 || got["A1"].ID != "S1" {
+		t.Errorf("ForAllocation() = %v, want A1 settled by S1 only", got)
+	}
+
+	a.NoAllocs = a.NoAllocs[:2]
+	if got, err = s.ForAllocation(a); err != nil || len(got) != 1 {
+		t.Errorf("ForAllocation() = %v, %v, want A1 only", got, err)
+	}
+}
+
+func TestRequestInstructions(t *testing.T) {
+	errSend := errors.New("send failed")
+	tests := []struct {
+		name     string
+		sendErr  error
+		ids      []string
+		wantErr  []error
+		response settlementinstructions.SettlementInstructions
+		respErr  error
+		want     Request
+		known    bool
+	}{
+		{
+			name: "answered", ids: []string{"R1"}, wantErr: []error{nil},
+			response: message("R1", ModeStandingInstructions, entry{"S1", "", TransNew, "ACC"}),
+			want:     Request{ID: "R1", Answered: true, Mode: ModeStandingInstructions, IDs: []string{"S1"}},
+			known:    true,
+		},
+		{
+			name: "rejected", ids: []string{"R1"}, wantErr: []error{nil},
+			response: settlementinstructions.Unmarshal(settlementinstructions.Struct{SettlInstMsgID: "M1",
+				SettlInstMode: ModeRequestReject, SettlInstReqID: fixutil.Ptr("R1"),
+				SettlInstReqRejCode: fixutil.Ptr(RejUnknownAccount), Text: fixutil.Ptr("unknown")}),
+			want: Request{ID: "R1", Answered: true, Mode: ModeRequestReject, RejCode: fixutil.Ptr(RejUnknownAccount),
+				Text: "unknown"},
+			known: true,
+		},
+		{
+			name: "duplicate", ids: []string{"R1", "R1"}, wantErr: []error{nil, &DuplicateRequestError{}},
+			response: message("R1", ModeStandingInstructions),
+			want:     Request{ID: "R1", Answered: true, Mode: ModeStandingInstructions},
+			known:    true,
+		},
+		{
+			name: "response to another request", ids: []string{"R1"}, wantErr: []error{nil},
+			response: message("R2", ModeStandingInstructions, entry{"S1", "", TransNew, "ACC"}),
+			respErr:  &UnknownRequestError{},
+			want:     Request{ID: "R1"},
+			known:    true,
+		},
+		{
+			name: "send error", sendErr: errSend, ids: []string{"R1"}, wantErr: []error{errSend},
+			response: message("R1", ModeStandingInstructions, entry{"S1", "", TransNew, "ACC"}),
+			respErr:  &UnknownRequestError{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := New(quickfix.SessionID{})
+			s.SendMessage = func(quickfix.Messagable) error { return tt.sendErr }
+			for i, id := range tt.ids {
+				err := s.RequestInstructions(settlementinstructionrequest.Struct{SettlInstReqID: id,
+					TransactTime: time.Now()})
+				if reflect.TypeOf(err) != reflect.TypeOf(tt.wantErr[i]) {
+					t.Errorf("RequestInstructions() %v error = %v, want %T", i, err, tt.wantErr[i])
+				}
+			}
+
+			_, err := s.OnSettlementInstructions(tt.response)
+			if reflect.TypeOf(err) != reflect.TypeOf(tt.respErr) {
+				t.Fatalf("OnSettlementInstructions() error = %v, want %T", err, tt.respErr)
+			}
+			r, ok := s.Request("R1")
+			if ok != tt.known {
+				t.Fatalf("Request() found = %v, want %v", ok, tt.known)
+			}
+			if !reflect.DeepEqual(r, tt.want) {
+				t.Errorf("Request() = %+v, want %+v", r, tt.want)
+			}
+			if _, ok := s.Instruction("S1"); ok && tt.respErr != nil {
+				t.Error("instruction S1 applied from a response to an unknown request")
+			}
+		})
+	}
+}
+
+func TestAnswer(t *testing.T) {
+	tests := []struct {
+		name    string
+		request settlementinstructionrequest.Struct
+		mode    enum.SettlInstMode
+		rejCode *enum.SettlInstReqRejCode
+		ids     []string
+	}{
+		{"account", settlementinstructionrequest.Struct{AllocAccount: fixutil.Ptr("ACC")}, ModeStandingInstructions,
+			nil,
+			[]string{"S1", "S2"}},
+		{"side", settlementinstructionrequest.Struct{AllocAccount: fixutil.Ptr("ACC"), Side: fixutil.Ptr(buy)},
+			ModeStandingInstructions, nil, []string{"S1"}},
+		{"unknown account", settlementinstructionrequest.Struct{AllocAccount: fixutil.Ptr("XYZ")}, ModeRequestReject,
+			fixutil.Ptr(RejUnknownAccount), nil},
+		{"no match", settlementinstructionrequest.Struct{AllocAccount: fixutil.Ptr("ACC"),
+			Side: fixutil.Ptr(enum.Side("5"))},
+			ModeRequestReject, fixutil.Ptr(RejNoMatchingInstruction), nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := New(quickfix.SessionID{})
+			s.NewMsgID = func() string { return "M9" }
+			s1, s2 := entry{"S1", "", TransNew, "ACC"}.Struct(), entry{"S2", "", TransNew, "ACC"}.Struct()
+			s1.Side, s2.Side = fixutil.Ptr(buy), fixutil.Ptr(sell)
+			s.OnSettlementInstructions(settlementinstructions.Unmarshal(settlementinstructions.Struct{
+				SettlInstMsgID: "M1", SettlInstMode: ModeStandingInstructions,
+				NoSettlInst: []settlementinstructions.NoSettlInstStruct{s1, s2}}))
+
+			tt.request.SettlInstReqID = "R1"
+			resp := s.Answer(tt.request)
+			if resp.SettlInstMode != tt.mode || !reflect.DeepEqual(resp.SettlInstReqRejCode, tt.rejCode) {
+				t.Errorf("SettlInstMode, SettlInstReqRejCode = %v, %v, want %v, %v", resp.SettlInstMode,
+					resp.SettlInstReqRejCode, tt.mode, tt.rejCode)
+			}
+			if resp.SettlInstReqID == nil || *resp.SettlInstReqID != "R1" || resp.SettlInstMsgID != "M9" {
+				t.Errorf("SettlInstReqID, SettlInstMsgID = %v, %v, want R1, M9", resp.SettlInstReqID,
+					resp.SettlInstMsgID)
+			}
+			var ids []string
+			for _, d := range resp.NoSettlInst {
+				ids = append(ids, *d.SettlInstID)
+				if d.SettlInstTransType == nil || *d.SettlInstTransType != TransRestate {
+					t.Errorf("%v SettlInstTransType = %v, want %v", *d.SettlInstID, d.SettlInstTransType,
+						TransRestate)
+				}
+			}
+			if !reflect.DeepEqual(ids, tt.ids) {
+				t.Errorf("instructions %v, want %v", ids, tt.ids)
+			}
+		})
+	}
+}
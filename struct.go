@@ -0,0 +1,13 @@
+package fix44
+
+import (
+	"github.com/shopspring/decimal"
+)
+
+//DecimalScale returns the number of digits after the decimal point of d, for use as the scale of a decimal field
+func DecimalScale(d decimal.Decimal) int32 {
+	if exp := d.Exponent(); exp < 0 {
+		return -exp
+	}
+	return 0
+}
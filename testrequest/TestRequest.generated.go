@@ -54,6 +54,26 @@ func Route(router RouteOut) (string, string, quickfix.MessageRoute) {
 	return "FIX.4.4", "1", r
 }
 
+//Validate checks m against the FIX 4.4 definition of TestRequest, see fix44.MessageDef.Validate
+func (m TestRequest) Validate() fix44.ValidationErrors {
+	return fix44.MessageDefs["1"].Validate(&m.Body.FieldMap)
+}
+
+//MarshalJSON encodes the TestRequest as JSON with fields keyed by name, see fix44.MarshalMessageJSON
+func (m TestRequest) MarshalJSON() ([]byte, error) {
+	return fix44.MarshalMessageJSON(m.Message)
+}
+
+//UnmarshalJSON builds the TestRequest from JSON produced by MarshalJSON
+func (m *TestRequest) UnmarshalJSON(b []byte) error {
+	msg, err := fix44.UnmarshalMessageJSON(b, "1")
+	if err != nil {
+		return err
+	}
+	*m = FromMessage(msg)
+	return nil
+}
+
 //SetTestReqID sets TestReqID, Tag 112
 func (m TestRequest) SetTestReqID(v string) {
 	m.Set(field.NewTestReqID(v))
@@ -72,3 +92,27 @@ func (m TestRequest) GetTestReqID() (v string, err quickfix.MessageRejectError)
 func (m TestRequest) HasTestReqID() bool {
 	return m.Has(tag.TestReqID)
 }
+
+//Struct is a plain Go representation of the TestRequest body, optional fields are nil when absent
+type Struct struct {
+	TestReqID string
+}
+
+//Marshal copies the fields present in msg into a Struct
+func Marshal(msg TestRequest) (Struct, quickfix.MessageRejectError) {
+	var s Struct
+	if msg.HasTestReqID() {
+		v, err := msg.GetTestReqID()
+		if err != nil {
+			return s, err
+		}
+		s.TestReqID = v
+	}
+	return s, nil
+}
+
+//Unmarshal builds a TestRequest from s
+func Unmarshal(s Struct) TestRequest {
+	m := New(field.NewTestReqID(s.TestReqID))
+	return m
+}
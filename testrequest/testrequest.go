@@ -0,0 +1,13 @@
+package testrequest
+
+import (
+	"time"
+
+	"github.com/terracefi/field"
+)
+
+//NewAt returns a TestRequest whose TestReqID is the UTC time t, a common choice that makes the round trip time of the
+//answering Heartbeat easy to measure
+func NewAt(t time.Time) TestRequest {
+	return New(field.NewTestReqID(t.UTC().Format("20060102-15:04:05.000")))
+}
@@ -0,0 +1,13 @@
+package testrequest
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewAt(t *testing.T) {
+	at := time.Date(2024, 3, 1, 10, 30, 0, 123456789, time.FixedZone("CET", 3600))
+	if v, err := NewAt(at).GetTestReqID(); err != nil || v != "20240301-09:30:00.123" {
+		t.Errorf("TestReqID = %q, %v, want 20240301-09:30:00.123", v, err)
+	}
+}
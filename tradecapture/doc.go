@@ -0,0 +1,13 @@
+/*
+Package tradecapture tracks trade capture reports and the requests for them.
+
+A Tracker follows each trade through its TradeReportTransType chain. A report with TradeReportTransType New starts
+a trade, and Cancel, Replace, Release and Reverse reports refer to the trade through TradeReportRefID, so a trade can
+be looked up by any TradeReportID it has been reported under. Reports submitted with OnSubmit stay pending until the
+TradeCaptureReportAck that answers them is given to OnAck, while reports received with OnReport are applied at
+once.
+
+Reports that carry a TradeRequestID are also collected on the Request they answer, together with the
+TradeCaptureReportRequestAck of the request, so a caller can tell when all the reports it asked for have arrived.
+*/
+package tradecapture
@@ -0,0 +1,41 @@
+package tradecapture
+
+import (
+	"fmt"
+)
+
+//UnknownTradeError is returned for a message that refers to a TradeReportID the Tracker does not know
+type UnknownTradeError struct {
+	TradeReportID string
+}
+
+func (e *UnknownTradeError) Error() string {
+	return fmt.Sprintf("tradecapture: unknown TradeReportID %v", e.TradeReportID)
+}
+
+//DuplicateTradeError is returned for a submitted report that reuses a TradeReportID
+type DuplicateTradeError struct {
+	TradeReportID string
+}
+
+func (e *DuplicateTradeError) Error() string {
+	return fmt.Sprintf("tradecapture: duplicate TradeReportID %v", e.TradeReportID)
+}
+
+//UnknownRequestError is returned for a message that refers to a TradeRequestID the Tracker does not know
+type UnknownRequestError struct {
+	TradeRequestID string
+}
+
+func (e *UnknownRequestError) Error() string {
+	return fmt.Sprintf("tradecapture: unknown TradeRequestID %v", e.TradeRequestID)
+}
+
+//DuplicateRequestError is returned for a request that reuses a TradeRequestID
+type DuplicateRequestError struct {
+	TradeRequestID string
+}
+
+func (e *DuplicateRequestError) Error() string {
+	return fmt.Sprintf("tradecapture: duplicate TradeRequestID %v", e.TradeRequestID)
+}
@@ -0,0 +1,258 @@
+package tradecapture
+
+import (
+	"sync"
+
+	"github.com/terracefi/enum"
+	"github.com/terracefi/fix44/internal/fixutil"
+	"github.com/terracefi/fix44/tradecapturereport"
+	"github.com/terracefi/fix44/tradecapturereportack"
+	"github.com/terracefi/fix44/tradecapturereportrequest"
+	"github.com/terracefi/fix44/tradecapturereportrequestack"
+)
+
+//Request is a TradeCaptureReportRequest and the reports received in response to it
+type Request struct {
+	TradeRequestID   string
+	TradeRequestType enum.TradeRequestType
+
+	//Acked is true once the TradeCaptureReportRequestAck has been received, Status, Result, TotNumTradeReports and
+	//Text are taken from it. TotNumTradeReports is nil if the ack did not give it.
+	Acked              bool
+	Status             enum.TradeRequestStatus
+	Result             enum.TradeRequestResult
+	TotNumTradeReports *int
+	Text               string
+
+	//Reports holds the reports received for the request, in the order they arrived
+	Reports []tradecapturereport.Struct
+	//LastReceived is true once a report with LastRptRequested = Y has been received
+	LastReceived bool
+}
+
+//Complete returns true when every report of the request has been received, as told by LastRptRequested or by the
+//TotNumTradeReports of the ack. A request whose ack has no TotNumTradeReports is only complete with LastRptRequested.
+func (r Request) Complete() bool {
+	return r.LastReceived || (r.Acked && r.TotNumTradeReports != nil && len(r.Reports) >= *r.TotNumTradeReports)
+}
+
+//add adds a report to the request unless a report with its TradeReportID was already received
+func (r *Request) add(report tradecapturereport.Struct) {
+	if report.LastRptRequested != nil && *report.LastRptRequested {
+		r.LastReceived = true
+	}
+	for _, p := range r.Reports {
+		if p.TradeReportID == report.TradeReportID {
+			return
+		}
+	}
+	r.Reports = append(r.Reports, report)
+}
+
+func (r *Request) clone() Request {
+	return fixutil.Clone(*r)
+}
+
+//Tracker follows trades and trade capture report requests. It is safe for concurrent use.
+type Tracker struct {
+	mu       sync.Mutex
+	trades   map[string]*Trade
+	requests map[string]*Request
+}
+
+//New returns an empty Tracker
+func New() *Tracker {
+	return &Tracker{
+		trades:   make(map[string]*Trade),
+		requests: make(map[string]*Request),
+	}
+}
+
+//Trade returns the trade that has, or has had, the given TradeReportID
+func (t *Tracker) Trade(tradeReportID string) (Trade, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	tr, ok := t.trades[tradeReportID]
+	if !ok {
+		return Trade{}, false
+	}
+	return tr.clone(), true
+}
+
+//Request returns the request with the given TradeRequestID
+func (t *Tracker) Request(tradeRequestID string) (Request, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	r, ok := t.requests[tradeRequestID]
+	if !ok {
+		return Request{}, false
+	}
+	return r.clone(), true
+}
+
+//OnSubmit records a report sent to the counterparty. A New report starts a pending trade, other reports become
+//pending on the trade named by their TradeReportRefID until they are acked.
+func (t *Tracker) OnSubmit(msg tradecapturereport.TradeCaptureReport) (Trade, error) {
+	r, err := tradecapturereport.Marshal(msg)
+	if err != nil {
+		return Trade{}, err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if _, ok := t.trades[r.TradeReportID]; ok {
+		return Trade{}, &DuplicateTradeError{r.TradeReportID}
+	}
+
+	var tr *Trade
+	if transType(r) == TransNew {
+		tr = &Trade{TradeReportID: r.TradeReportID, Report: r, Status: StatusPending}
+	} else {
+		if tr = t.ref(r); tr == nil {
+			return Trade{}, &UnknownTradeError{refID(r)}
+		}
+	}
+
+	tr.addID(r.TradeReportID)
+	tr.Pending = &r
+	t.trades[r.TradeReportID] = tr
+	return tr.clone(), nil
+}
+
+//OnAck applies a TradeCaptureReportAck to the submitted report it answers. An accepting ack applies the pending
+//report, a rejecting ack drops it, rejecting the trade if the report was its New report.
+func (t *Tracker) OnAck(msg tradecapturereportack.TradeCaptureReportAck) (Trade, error) {
+	ack, err := tradecapturereportack.Marshal(msg)
+	if err != nil {
+		return Trade{}, err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	tr, ok := t.trades[ack.TradeReportID]
+	if !ok {
+		return Trade{}, &UnknownTradeError{ack.TradeReportID}
+	}
+	p := tr.Pending
+	if p == nil || p.TradeReportID != ack.TradeReportID {
+		return tr.clone(), nil
+	}
+	tr.Pending = nil
+
+	if ack.TrdRptStatus != nil && *ack.TrdRptStatus == ReportRejected {
+		tr.RejectReason = ack.TradeReportRejectReason
+		if ack.Text != nil {
+			tr.Text = *ack.Text
+		}
+		if transType(*p) == TransNew {
+			tr.Status = StatusRejected
+		}
+		return tr.clone(), nil
+	}
+
+	tr.apply(*p)
+	return tr.clone(), nil
+}
+
+//OnReport applies a report received from the counterparty. A report with a TradeRequestID is also added to the
+//Request it answers. A report whose TradeReportID the Tracker already knows, such as a report sent again or one
+//answering a request for a known trade, does not change the trade and is added to a Request at most once.
+func (t *Tracker) OnReport(msg tradecapturereport.TradeCaptureReport) (Trade, error) {
+	r, err := tradecapturereport.Marshal(msg)
+	if err != nil {
+		return Trade{}, err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var req *Request
+	if r.TradeRequestID != nil {
+		var ok bool
+		if req, ok = t.requests[*r.TradeRequestID]; !ok {
+			return Trade{}, &UnknownRequestError{*r.TradeRequestID}
+		}
+	}
+
+	tr, known := t.trades[r.TradeReportID]
+	if !known && transType(r) != TransNew {
+		tr = t.ref(r)
+	}
+	if tr == nil {
+		if transType(r) != TransNew {
+			return Trade{}, &UnknownTradeError{refID(r)}
+		}
+		tr = &Trade{TradeReportID: r.TradeReportID}
+	}
+
+	if req != nil {
+		req.add(r)
+	}
+	if !known {
+		tr.apply(r)
+		t.trades[r.TradeReportID] = tr
+	}
+	return tr.clone(), nil
+}
+
+//OnRequest records a TradeCaptureReportRequest sent to the counterparty. A *DuplicateRequestError is returned if its
+//TradeRequestID is already in use.
+func (t *Tracker) OnRequest(msg tradecapturereportrequest.TradeCaptureReportRequest) (Request, error) {
+	id, err := msg.GetTradeRequestID()
+	if err != nil {
+		return Request{}, err
+	}
+	reqType, err := msg.GetTradeRequestType()
+	if err != nil {
+		return Request{}, err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if _, ok := t.requests[id]; ok {
+		return Request{}, &DuplicateRequestError{id}
+	}
+	r := &Request{TradeRequestID: id, TradeRequestType: reqType}
+	t.requests[id] = r
+	return r.clone(), nil
+}
+
+//OnRequestAck applies a TradeCaptureReportRequestAck to the request it answers
+func (t *Tracker) OnRequestAck(msg tradecapturereportrequestack.TradeCaptureReportRequestAck) (Request, error) {
+	ack, err := tradecapturereportrequestack.Marshal(msg)
+	if err != nil {
+		return Request{}, err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	r, ok := t.requests[ack.TradeRequestID]
+	if !ok {
+		return Request{}, &UnknownRequestError{ack.TradeRequestID}
+	}
+	r.Acked = true
+	r.Status = ack.TradeRequestStatus
+	r.Result = ack.TradeRequestResult
+	r.TotNumTradeReports = ack.TotNumTradeReports
+	if ack.Text != nil {
+		r.Text = *ack.Text
+	}
+	return r.clone(), nil
+}
+
+func (t *Tracker) ref(r tradecapturereport.Struct) *Trade {
+	return t.trades[refID(r)]
+}
+
+func refID(r tradecapturereport.Struct) string {
+	if r.TradeReportRefID == nil {
+		return ""
+	}
+	return *r.TradeReportRefID
+}
@@ -0,0 +1,326 @@
+package tradecapture
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/terracefi/enum"
+	"github.com/terracefi/field"
+	"github.com/terracefi/fix44/internal/fixutil"
+	"github.com/terracefi/fix44/tradecapturereport"
+	"github.com/terracefi/fix44/tradecapturereportack"
+	"github.com/terracefi/fix44/tradecapturereportrequest"
+	"github.com/terracefi/fix44/tradecapturereportrequestack"
+)
+
+func report(id, refID string, trans enum.TradeReportTransType) tradecapturereport.Struct {
+	r := tradecapturereport.Struct{
+		TradeReportID:        id,
+		TradeReportTransType: fixutil.Ptr(trans),
+		LastQty:              decimal.NewFromInt(100),
+		LastPx:               decimal.NewFromInt(10),
+		TransactTime:         time.Now(),
+		TradeDate:            "20240102",
+	}
+	if refID != "" {
+		r.TradeReportRefID = fixutil.Ptr(refID)
+	}
+	return r
+}
+
+func ack(id string, status enum.TrdRptStatus) tradecapturereportack.TradeCaptureReportAck {
+	return tradecapturereportack.Unmarshal(tradecapturereportack.Struct{
+		TradeReportID: id,
+		ExecType:      "F",
+		TrdRptStatus:  fixutil.Ptr(status),
+	})
+}
+
+type step func(t *Tracker) (Trade, error)
+
+func submit(id, refID string, trans enum.TradeReportTransType) step {
+	return func(t *Tracker) (Trade, error) {
+		return t.OnSubmit(tradecapturereport.Unmarshal(report(id, refID, trans)))
+	}
+}
+
+func acked(id string, status enum.TrdRptStatus) step {
+	return func(t *Tracker) (Trade, error) { return t.OnAck(ack(id, status)) }
+}
+
+func received(id, refID string, trans enum.TradeReportTransType) step {
+	return func(t *Tracker) (Trade, error) {
+		return t.OnReport(tradecapturereport.Unmarshal(report(id, refID, trans)))
+	}
+}
+
+func TestTracker(t *testing.T) {
+	tests := []struct {
+		name          string
+		steps         []step
+		wantErr       error
+		status        Status
+		tradeReportID string
+		ids           []string
+		pending       bool
+	}{
+		{
+			name:          "pending",
+			steps:         []step{submit("T1", "", TransNew)},
+			status:        StatusPending,
+			tradeReportID: "T1", ids: []string{"T1"}, pending: true,
+		},
+		{
+			name:          "accepted",
+			steps:         []step{submit("T1", "", TransNew), acked("T1", ReportAccepted)},
+			status:        StatusAccepted,
+			tradeReportID: "T1", ids: []string{"T1"},
+		},
+		{
+			name:          "rejected",
+			steps:         []step{submit("T1", "", TransNew), acked("T1", ReportRejected)},
+			status:        StatusRejected,
+			tradeReportID: "T1", ids: []string{"T1"},
+		},
+		{
+			name:          "duplicate TradeReportID",
+			steps:         []step{submit("T1", "", TransNew), submit("T1", "", TransNew)},
+			wantErr:       &DuplicateTradeError{},
+			status:        StatusPending,
+			tradeReportID: "T1", ids: []string{"T1"}, pending: true,
+		},
+		{
+			name: "replace then cancel",
+			steps: []step{submit("T1", "", TransNew), acked("T1", ReportAccepted),
+				submit("T2", "T1", TransReplace), acked("T2", ReportAccepted),
+				submit("T3", "T2", TransCancel), acked("T3", ReportAccepted)},
+			status:        StatusCanceled,
+			tradeReportID: "T2", ids: []string{"T1", "T2", "T3"},
+		},
+		{
+			name: "replace rejected",
+			steps: []step{submit("T1", "", TransNew), acked("T1", ReportAccepted),
+				submit("T2", "T1", TransReplace), acked("T2", ReportRejected)},
+			status:        StatusAccepted,
+			tradeReportID: "T1", ids: []string{"T1", "T2"},
+		},
+		{
+			name:    "replace of an unknown trade",
+			steps:   []step{submit("T2", "T1", TransReplace)},
+			wantErr: &UnknownTradeError{},
+		},
+		{
+			name:    "ack of an unknown trade",
+			steps:   []step{acked("T1", ReportAccepted)},
+			wantErr: &UnknownTradeError{},
+		},
+		{
+			name:          "received",
+			steps:         []step{received("T1", "", TransNew), received("T2", "T1", TransReplace)},
+			status:        StatusAccepted,
+			tradeReportID: "T2", ids: []string{"T1", "T2"},
+		},
+		{
+			name: "received twice",
+			steps: []step{received("T1", "", TransNew), received("T2", "T1", TransReplace),
+				received("T1", "", TransNew)},
+			status:        StatusAccepted,
+			tradeReportID: "T2", ids: []string{"T1", "T2"},
+		},
+		{
+			name:    "received cancel of an unknown trade",
+			steps:   []step{received("T2", "T1", TransCancel)},
+			wantErr: &UnknownTradeError{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tr := New()
+			var err error
+			for i, s := range tt.steps {
+				_, err = s(tr)
+				if i < len(tt.steps)-1 && err != nil {
+					t.Fatalf("step %v: %v", i, err)
+				}
+			}
+			if reflect.TypeOf(err) != reflect.TypeOf(tt.wantErr) {
+				t.Fatalf("error = %v, want %T", err, tt.wantErr)
+			}
+
+			trade, ok := tr.Trade("T1")
+			if ok != (tt.ids != nil) {
+				t.Fatalf("Trade() found = %v, want %v", ok, tt.ids != nil)
+			}
+			if !ok {
+				return
+			}
+			if trade.Status != tt.status {
+				t.Errorf("Status = %v, want %v", trade.Status, tt.status)
+			}
+			if trade.TradeReportID != tt.tradeReportID {
+				t.Errorf("TradeReportID = %v, want %v", trade.TradeReportID, tt.tradeReportID)
+			}
+			if !reflect.DeepEqual(trade.TradeReportIDs, tt.ids) {
+				t.Errorf("TradeReportIDs = %v, want %v", trade.TradeReportIDs, tt.ids)
+			}
+			if (trade.Pending != nil) != tt.pending {
+				t.Errorf("Pending = %v, want pending %v", trade.Pending, tt.pending)
+			}
+		})
+	}
+}
+
+func request(t *testing.T, tr *Tracker, id string) {
+	msg := tradecapturereportrequest.New(field.NewTradeRequestID(id), field.NewTradeRequestType("0"))
+	if _, err := tr.OnRequest(msg); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRequestComplete(t *testing.T) {
+	tests := []struct {
+		name    string
+		acked   bool
+		total   *int
+		reports int
+		last    bool
+		want    bool
+	}{
+		{"not acked", false, nil, 2, false, false},
+		{"last received", false, nil, 1, true, true},
+		{"all received", true, fixutil.Ptr(2), 2, false, true},
+		{"some received", true, fixutil.Ptr(2), 1, false, false},
+		{"no total", true, nil, 2, false, false},
+		{"no total, last received", true, nil, 2, true, true},
+		{"none to receive", true, fixutil.Ptr(0), 0, false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tr := New()
+			request(t, tr, "R1")
+			if tt.acked {
+				_, err := tr.OnRequestAck(tradecapturereportrequestack.Unmarshal(tradecapturereportrequestack.Struct{
+					TradeRequestID:     "R1",
+					TradeRequestType:   "0",
+					TradeRequestResult: "0",
+					TradeRequestStatus: "0",
+					TotNumTradeReports: tt.total,
+				}))
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+			for i := 0; i < tt.reports; i++ {
+				r := report(string(rune('A'+i)), "", TransNew)
+				r.TradeRequestID = fixutil.Ptr("R1")
+				if i == tt.reports-1 && tt.last {
+					r.LastRptRequested = fixutil.Ptr(true)
+				}
+				if _, err := tr.OnReport(tradecapturereport.Unmarshal(r)); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			req, _ := tr.Request("R1")
+			if got := req.Complete(); got != tt.want {
+				t.Errorf("Complete() = %v, want %v", got, tt.want)
+			}
+			if len(req.Reports) != tt.reports {
+				t.Errorf("len(Reports) = %v, want %v", len(req.Reports), tt.reports)
+			}
+		})
+	}
+}
+
+func TestOnReportErrorsLeaveRequest(t *testing.T) {
+	tests := []struct {
+		name      string
+		requestID string
+		wantErr   error
+	}{
+		{"unknown request", "R2", &UnknownRequestError{}},
+		{"unknown trade", "R1", &UnknownTradeError{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tr := New()
+			request(t, tr, "R1")
+
+			r := report("T2", "T1", TransCancel)
+			r.TradeRequestID = fixutil.Ptr(tt.requestID)
+			r.LastRptRequested = fixutil.Ptr(true)
+			_, err := tr.OnReport(tradecapturereport.Unmarshal(r))
+			if reflect.TypeOf(err) != reflect.TypeOf(tt.wantErr) {
+				t.Fatalf("OnReport() error = %v, want %T", err, tt.wantErr)
+			}
+
+			req, _ := tr.Request("R1")
+			if len(req.Reports) != 0 || req.LastReceived {
+				t.Errorf("Request = %+v, want no reports", req)
+			}
+			if _, ok := tr.Trade("T2"); ok {
+				t.Error("Trade() found a trade for the failed report")
+			}
+		})
+	}
+}
+
+func TestDuplicateRequest(t *testing.T) {
+	tr := New()
+	request(t, tr, "R1")
+	msg := tradecapturereportrequest.New(field.NewTradeRequestID("R1"), field.NewTradeRequestType("1"))
+	if _, err := tr.OnRequest(msg); reflect.TypeOf(err) != reflect.TypeOf(&DuplicateRequestError{}) {
+		t.Fatalf("OnRequest() error = %v, want *DuplicateRequestError", err)
+	}
+	if req, _ := tr.Request("R1"); req.TradeRequestType != "0" {
+		t.Errorf("TradeRequestType = %v, want the first request's 0", req.TradeRequestType)
+	}
+}
+
+func TestRequestReportReceivedTwice(t *testing.T) {
+	tr := New()
+	if _, err := submit("T1", "", TransNew)(tr); err != nil {
+		t.Fatal(err)
+	}
+	request(t, tr, "R1")
+	r := report("T1", "", TransNew)
+	r.TradeRequestID = fixutil.Ptr("R1")
+	for i := 0; i < 2; i++ {
+		if _, err := tr.OnReport(tradecapturereport.Unmarshal(r)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	req, _ := tr.Request("R1")
+	if len(req.Reports) != 1 {
+		t.Errorf("len(Reports) = %v, want 1", len(req.Reports))
+	}
+	if trade, _ := tr.Trade("T1"); trade.Status != StatusPending || trade.Pending == nil {
+		t.Errorf("Trade = %v, pending %v, want the submitted trade unchanged", trade.Status, trade.Pending)
+	}
+}
+
+func TestCloneIsolation(t *testing.T) {
+	tr := New()
+	r := report("T1", "", TransNew)
+	r.NoSides = []tradecapturereport.NoSidesStruct{{Side: fixutil.Ptr(enum.Side("1")), OrderID: fixutil.Ptr("O1")}}
+	if _, err := tr.OnSubmit(tradecapturereport.Unmarshal(r)); err != nil {
+		t.Fatal(err)
+	}
+
+	trade, _ := tr.Trade("T1")
+	*trade.Report.NoSides[0].OrderID = "X"
+	trade.Pending.NoSides[0].OrderID = fixutil.Ptr("X")
+	*trade.Pending.TradeReportTransType = TransCancel
+	trade.TradeReportIDs[0] = "X"
+
+	got, _ := tr.Trade("T1")
+	if *got.Report.NoSides[0].OrderID != "O1" || *got.Pending.NoSides[0].OrderID != "O1" {
+		t.Errorf("NoSides changed through a returned Trade: %v, %v", got.Report.NoSides, got.Pending.NoSides)
+	}
+	if *got.Pending.TradeReportTransType != TransNew || got.TradeReportIDs[0] != "T1" {
+		t.Errorf("Trade changed through a returned Trade: %+v", got)
+	}
+}
@@ -0,0 +1,120 @@
+package tradecapture
+
+import (
+	"github.com/terracefi/enum"
+	"github.com/terracefi/fix44/internal/fixutil"
+	"github.com/terracefi/fix44/tradecapturereport"
+)
+
+//TradeReportTransType values, FIX 4.4
+const (
+	TransNew     enum.TradeReportTransType = "0"
+	TransCancel  enum.TradeReportTransType = "1"
+	TransReplace enum.TradeReportTransType = "2"
+	TransRelease enum.TradeReportTransType = "3"
+	TransReverse enum.TradeReportTransType = "4"
+)
+
+//TrdRptStatus values, FIX 4.4
+const (
+	ReportAccepted enum.TrdRptStatus = "0"
+	ReportRejected enum.TrdRptStatus = "1"
+)
+
+//Status is the state of a trade
+type Status int
+
+//Status values
+const (
+	StatusPending Status = iota
+	StatusAccepted
+	StatusRejected
+	StatusCanceled
+	StatusReleased
+	StatusReversed
+)
+
+func (s Status) String() string {
+	switch s {
+	case StatusPending:
+		return "Pending"
+	case StatusAccepted:
+		return "Accepted"
+	case StatusRejected:
+		return "Rejected"
+	case StatusCanceled:
+		return "Canceled"
+	case StatusReleased:
+		return "Released"
+	case StatusReversed:
+		return "Reversed"
+	}
+	return "Unknown"
+}
+
+//Trade is the state of a trade as seen by a Tracker
+type Trade struct {
+	//TradeReportID is the TradeReportID of the report currently in force
+	TradeReportID string
+	//TradeReportIDs holds every TradeReportID the trade has been reported under, oldest first
+	TradeReportIDs []string
+
+	Status Status
+	//Report is the report currently in force, its NoSides holds both sides of the trade
+	Report tradecapturereport.Struct
+
+	//Pending is the submitted report awaiting its ack, if any
+	Pending *tradecapturereport.Struct
+
+	//RejectReason and Text are set from the last rejecting ack
+	RejectReason *enum.TradeReportRejectReason
+	Text         string
+}
+
+//Side returns the side of the trade with the given Side, for example "1" for the buyer
+func (t Trade) Side(side enum.Side) (tradecapturereport.NoSidesStruct, bool) {
+	for _, s := range t.Report.NoSides {
+		if s.Side != nil && *s.Side == side {
+			return s, true
+		}
+	}
+	return tradecapturereport.NoSidesStruct{}, false
+}
+
+func (t *Trade) clone() Trade {
+	return fixutil.Clone(*t)
+}
+
+func (t *Trade) addID(id string) {
+	for _, i := range t.TradeReportIDs {
+		if i == id {
+			return
+		}
+	}
+	t.TradeReportIDs = append(t.TradeReportIDs, id)
+}
+
+//apply makes r the state of the trade, as a report that has been accepted
+func (t *Trade) apply(r tradecapturereport.Struct) {
+	t.addID(r.TradeReportID)
+
+	switch transType(r) {
+	case TransNew, TransReplace:
+		t.TradeReportID = r.TradeReportID
+		t.Report = r
+		t.Status = StatusAccepted
+	case TransCancel:
+		t.Status = StatusCanceled
+	case TransRelease:
+		t.Status = StatusReleased
+	case TransReverse:
+		t.Status = StatusReversed
+	}
+}
+
+func transType(r tradecapturereport.Struct) enum.TradeReportTransType {
+	if r.TradeReportTransType == nil {
+		return TransNew
+	}
+	return *r.TradeReportTransType
+}
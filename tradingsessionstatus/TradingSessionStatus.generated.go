@@ -60,6 +60,26 @@ func Route(router RouteOut) (string, string, quickfix.MessageRoute) {
 	return "FIX.4.4", "h", r
 }
 
+//Validate checks m against the FIX 4.4 definition of TradingSessionStatus, see fix44.MessageDef.Validate
+func (m TradingSessionStatus) Validate() fix44.ValidationErrors {
+	return fix44.MessageDefs["h"].Validate(&m.Body.FieldMap)
+}
+
+//MarshalJSON encodes the TradingSessionStatus as JSON with fields keyed by name, see fix44.MarshalMessageJSON
+func (m TradingSessionStatus) MarshalJSON() ([]byte, error) {
+	return fix44.MarshalMessageJSON(m.Message)
+}
+
+//UnmarshalJSON builds the TradingSessionStatus from JSON produced by MarshalJSON
+func (m *TradingSessionStatus) UnmarshalJSON(b []byte) error {
+	msg, err := fix44.UnmarshalMessageJSON(b, "h")
+	if err != nil {
+		return err
+	}
+	*m = FromMessage(msg)
+	return nil
+}
+
 //SetText sets Text, Tag 58
 func (m TradingSessionStatus) SetText(v string) {
 	m.Set(field.NewText(v))
@@ -368,6 +388,21 @@ func (m TradingSessionStatus) HasEncodedText() bool {
 	return m.Has(tag.EncodedText)
 }
 
+//SetEncodedTextBytes sets EncodedText, Tag 355, and EncodedTextLen, Tag 354, from v, which may hold any bytes including SOH
+func (m TradingSessionStatus) SetEncodedTextBytes(v []byte) {
+	fix44.SetRawData(m, tag.EncodedTextLen, tag.EncodedText, v)
+}
+
+//GetEncodedTextBytes gets EncodedText, Tag 355, checking its length against EncodedTextLen, Tag 354
+func (m TradingSessionStatus) GetEncodedTextBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.EncodedTextLen, tag.EncodedText)
+}
+
+//GetEncodedTextDecoded gets EncodedText, Tag 355, decoded to UTF-8 using the MessageEncoding of the Header
+func (m TradingSessionStatus) GetEncodedTextDecoded() (string, error) {
+	return fix44.DecodeRawData(m.Header, m, tag.EncodedTextLen, tag.EncodedText)
+}
+
 //HasTotalVolumeTraded returns true if TotalVolumeTraded is present, Tag 387
 func (m TradingSessionStatus) HasTotalVolumeTraded() bool {
 	return m.Has(tag.TotalVolumeTraded)
@@ -382,3 +417,200 @@ func (m TradingSessionStatus) HasTradSesStatusRejReason() bool {
 func (m TradingSessionStatus) HasTradingSessionSubID() bool {
 	return m.Has(tag.TradingSessionSubID)
 }
+
+//Struct is a plain Go representation of the TradingSessionStatus body, optional fields are nil when absent
+type Struct struct {
+	Text                   *string
+	UnsolicitedIndicator   *bool
+	TradSesReqID           *string
+	TradingSessionID       enum.TradingSessionID
+	TradSesMethod          *enum.TradSesMethod
+	TradSesMode            *enum.TradSesMode
+	TradSesStatus          enum.TradSesStatus
+	TradSesStartTime       *time.Time
+	TradSesOpenTime        *time.Time
+	TradSesPreCloseTime    *time.Time
+	TradSesCloseTime       *time.Time
+	TradSesEndTime         *time.Time
+	EncodedTextLen         *int
+	EncodedText            *string
+	TotalVolumeTraded      *decimal.Decimal
+	TradSesStatusRejReason *enum.TradSesStatusRejReason
+	TradingSessionSubID    *enum.TradingSessionSubID
+}
+
+//Marshal copies the fields present in msg into a Struct
+func Marshal(msg TradingSessionStatus) (Struct, quickfix.MessageRejectError) {
+	var s Struct
+	if msg.HasText() {
+		v, err := msg.GetText()
+		if err != nil {
+			return s, err
+		}
+		s.Text = &v
+	}
+	if msg.HasUnsolicitedIndicator() {
+		v, err := msg.GetUnsolicitedIndicator()
+		if err != nil {
+			return s, err
+		}
+		s.UnsolicitedIndicator = &v
+	}
+	if msg.HasTradSesReqID() {
+		v, err := msg.GetTradSesReqID()
+		if err != nil {
+			return s, err
+		}
+		s.TradSesReqID = &v
+	}
+	if msg.HasTradingSessionID() {
+		v, err := msg.GetTradingSessionID()
+		if err != nil {
+			return s, err
+		}
+		s.TradingSessionID = v
+	}
+	if msg.HasTradSesMethod() {
+		v, err := msg.GetTradSesMethod()
+		if err != nil {
+			return s, err
+		}
+		s.TradSesMethod = &v
+	}
+	if msg.HasTradSesMode() {
+		v, err := msg.GetTradSesMode()
+		if err != nil {
+			return s, err
+		}
+		s.TradSesMode = &v
+	}
+	if msg.HasTradSesStatus() {
+		v, err := msg.GetTradSesStatus()
+		if err != nil {
+			return s, err
+		}
+		s.TradSesStatus = v
+	}
+	if msg.HasTradSesStartTime() {
+		v, err := msg.GetTradSesStartTime()
+		if err != nil {
+			return s, err
+		}
+		s.TradSesStartTime = &v
+	}
+	if msg.HasTradSesOpenTime() {
+		v, err := msg.GetTradSesOpenTime()
+		if err != nil {
+			return s, err
+		}
+		s.TradSesOpenTime = &v
+	}
+	if msg.HasTradSesPreCloseTime() {
+		v, err := msg.GetTradSesPreCloseTime()
+		if err != nil {
+			return s, err
+		}
+		s.TradSesPreCloseTime = &v
+	}
+	if msg.HasTradSesCloseTime() {
+		v, err := msg.GetTradSesCloseTime()
+		if err != nil {
+			return s, err
+		}
+		s.TradSesCloseTime = &v
+	}
+	if msg.HasTradSesEndTime() {
+		v, err := msg.GetTradSesEndTime()
+		if err != nil {
+			return s, err
+		}
+		s.TradSesEndTime = &v
+	}
+	if msg.HasEncodedTextLen() {
+		v, err := msg.GetEncodedTextLen()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedTextLen = &v
+	}
+	if msg.HasEncodedText() {
+		v, err := msg.GetEncodedText()
+		if err != nil {
+			return s, err
+		}
+		s.EncodedText = &v
+	}
+	if msg.HasTotalVolumeTraded() {
+		v, err := msg.GetTotalVolumeTraded()
+		if err != nil {
+			return s, err
+		}
+		s.TotalVolumeTraded = &v
+	}
+	if msg.HasTradSesStatusRejReason() {
+		v, err := msg.GetTradSesStatusRejReason()
+		if err != nil {
+			return s, err
+		}
+		s.TradSesStatusRejReason = &v
+	}
+	if msg.HasTradingSessionSubID() {
+		v, err := msg.GetTradingSessionSubID()
+		if err != nil {
+			return s, err
+		}
+		s.TradingSessionSubID = &v
+	}
+	return s, nil
+}
+
+//Unmarshal builds a TradingSessionStatus from s
+func Unmarshal(s Struct) TradingSessionStatus {
+	m := New(field.NewTradingSessionID(s.TradingSessionID), field.NewTradSesStatus(s.TradSesStatus))
+	if s.Text != nil {
+		m.SetText(*s.Text)
+	}
+	if s.UnsolicitedIndicator != nil {
+		m.SetUnsolicitedIndicator(*s.UnsolicitedIndicator)
+	}
+	if s.TradSesReqID != nil {
+		m.SetTradSesReqID(*s.TradSesReqID)
+	}
+	if s.TradSesMethod != nil {
+		m.SetTradSesMethod(*s.TradSesMethod)
+	}
+	if s.TradSesMode != nil {
+		m.SetTradSesMode(*s.TradSesMode)
+	}
+	if s.TradSesStartTime != nil {
+		m.SetTradSesStartTime(*s.TradSesStartTime)
+	}
+	if s.TradSesOpenTime != nil {
+		m.SetTradSesOpenTime(*s.TradSesOpenTime)
+	}
+	if s.TradSesPreCloseTime != nil {
+		m.SetTradSesPreCloseTime(*s.TradSesPreCloseTime)
+	}
+	if s.TradSesCloseTime != nil {
+		m.SetTradSesCloseTime(*s.TradSesCloseTime)
+	}
+	if s.TradSesEndTime != nil {
+		m.SetTradSesEndTime(*s.TradSesEndTime)
+	}
+	if s.EncodedTextLen != nil {
+		m.SetEncodedTextLen(*s.EncodedTextLen)
+	}
+	if s.EncodedText != nil {
+		m.SetEncodedText(*s.EncodedText)
+	}
+	if s.TotalVolumeTraded != nil {
+		m.SetTotalVolumeTraded(*s.TotalVolumeTraded, fix44.DecimalScale(*s.TotalVolumeTraded))
+	}
+	if s.TradSesStatusRejReason != nil {
+		m.SetTradSesStatusRejReason(*s.TradSesStatusRejReason)
+	}
+	if s.TradingSessionSubID != nil {
+		m.SetTradingSessionSubID(*s.TradingSessionSubID)
+	}
+	return m
+}
@@ -56,6 +56,26 @@ func Route(router RouteOut) (string, string, quickfix.MessageRoute) {
 	return "FIX.4.4", "g", r
 }
 
+//Validate checks m against the FIX 4.4 definition of TradingSessionStatusRequest, see fix44.MessageDef.Validate
+func (m TradingSessionStatusRequest) Validate() fix44.ValidationErrors {
+	return fix44.MessageDefs["g"].Validate(&m.Body.FieldMap)
+}
+
+//MarshalJSON encodes the TradingSessionStatusRequest as JSON with fields keyed by name, see fix44.MarshalMessageJSON
+func (m TradingSessionStatusRequest) MarshalJSON() ([]byte, error) {
+	return fix44.MarshalMessageJSON(m.Message)
+}
+
+//UnmarshalJSON builds the TradingSessionStatusRequest from JSON produced by MarshalJSON
+func (m *TradingSessionStatusRequest) UnmarshalJSON(b []byte) error {
+	msg, err := fix44.UnmarshalMessageJSON(b, "g")
+	if err != nil {
+		return err
+	}
+	*m = FromMessage(msg)
+	return nil
+}
+
 //SetSubscriptionRequestType sets SubscriptionRequestType, Tag 263
 func (m TradingSessionStatusRequest) SetSubscriptionRequestType(v enum.SubscriptionRequestType) {
 	m.Set(field.NewSubscriptionRequestType(v))
@@ -169,3 +189,79 @@ func (m TradingSessionStatusRequest) HasTradSesMode() bool {
 func (m TradingSessionStatusRequest) HasTradingSessionSubID() bool {
 	return m.Has(tag.TradingSessionSubID)
 }
+
+//Struct is a plain Go representation of the TradingSessionStatusRequest body, optional fields are nil when absent
+type Struct struct {
+	SubscriptionRequestType enum.SubscriptionRequestType
+	TradSesReqID            string
+	TradingSessionID        *enum.TradingSessionID
+	TradSesMethod           *enum.TradSesMethod
+	TradSesMode             *enum.TradSesMode
+	TradingSessionSubID     *enum.TradingSessionSubID
+}
+
+//Marshal copies the fields present in msg into a Struct
+func Marshal(msg TradingSessionStatusRequest) (Struct, quickfix.MessageRejectError) {
+	var s Struct
+	if msg.HasSubscriptionRequestType() {
+		v, err := msg.GetSubscriptionRequestType()
+		if err != nil {
+			return s, err
+		}
+		s.SubscriptionRequestType = v
+	}
+	if msg.HasTradSesReqID() {
+		v, err := msg.GetTradSesReqID()
+		if err != nil {
+			return s, err
+		}
+		s.TradSesReqID = v
+	}
+	if msg.HasTradingSessionID() {
+		v, err := msg.GetTradingSessionID()
+		if err != nil {
+			return s, err
+		}
+		s.TradingSessionID = &v
+	}
+	if msg.HasTradSesMethod() {
+		v, err := msg.GetTradSesMethod()
+		if err != nil {
+			return s, err
+		}
+		s.TradSesMethod = &v
+	}
+	if msg.HasTradSesMode() {
+		v, err := msg.GetTradSesMode()
+		if err != nil {
+			return s, err
+		}
+		s.TradSesMode = &v
+	}
+	if msg.HasTradingSessionSubID() {
+		v, err := msg.GetTradingSessionSubID()
+		if err != nil {
+			return s, err
+		}
+		s.TradingSessionSubID = &v
+	}
+	return s, nil
+}
+
+//Unmarshal builds a TradingSessionStatusRequest from s
+func Unmarshal(s Struct) TradingSessionStatusRequest {
+	m := New(field.NewTradSesReqID(s.TradSesReqID), field.NewSubscriptionRequestType(s.SubscriptionRequestType))
+	if s.TradingSessionID != nil {
+		m.SetTradingSessionID(*s.TradingSessionID)
+	}
+	if s.TradSesMethod != nil {
+		m.SetTradSesMethod(*s.TradSesMethod)
+	}
+	if s.TradSesMode != nil {
+		m.SetTradSesMode(*s.TradSesMode)
+	}
+	if s.TradingSessionSubID != nil {
+		m.SetTradingSessionSubID(*s.TradingSessionSubID)
+	}
+	return m
+}
@@ -63,6 +63,16 @@ func (t Trailer) HasSignature() bool {
 	return t.Has(tag.Signature)
 }
 
+//SetSignatureBytes sets Signature, Tag 89, and SignatureLength, Tag 93, from v, which may hold any bytes including SOH
+func (t Trailer) SetSignatureBytes(v []byte) {
+	SetRawData(t, tag.SignatureLength, tag.Signature, v)
+}
+
+//GetSignatureBytes gets Signature, Tag 89, checking its length against SignatureLength, Tag 93
+func (t Trailer) GetSignatureBytes() ([]byte, quickfix.MessageRejectError) {
+	return GetRawData(t, tag.SignatureLength, tag.Signature)
+}
+
 //HasSignatureLength returns true if SignatureLength is present, Tag 93
 func (t Trailer) HasSignatureLength() bool {
 	return t.Has(tag.SignatureLength)
@@ -0,0 +1,122 @@
+package usermgmt
+
+import (
+	"context"
+	"sync"
+
+	"github.com/terracefi/enum"
+	"github.com/terracefi/fix44/internal/fixutil"
+	"github.com/terracefi/fix44/userrequest"
+	"github.com/terracefi/fix44/userresponse"
+	"github.com/terracefi/quickfix"
+)
+
+//Client sends UserRequests and waits for their UserResponses. It is safe for concurrent use.
+type Client struct {
+	//SendMessage sends the UserRequests, by default on the session given to New
+	SendMessage func(msg quickfix.Messagable) error
+	//NewUserRequestID returns the UserRequestID of a request, it defaults to a sequence number prefixed with the
+	//time New was called
+	NewUserRequestID func() string
+
+	mu      sync.Mutex
+	waiting map[string]chan userresponse.Struct
+}
+
+//New returns a Client that sends on sessionID
+func New(sessionID quickfix.SessionID) *Client {
+	return &Client{
+		SendMessage:      fixutil.SendOn(sessionID),
+		NewUserRequestID: fixutil.NewIDs(),
+		waiting:          make(map[string]chan userresponse.Struct),
+	}
+}
+
+//LogonUser logs username on with password, the response must report the user as logged in
+func (c *Client) LogonUser(ctx context.Context, username, password string) (Result, error) {
+	return c.call(ctx, userrequest.Struct{
+		UserRequestType: RequestLogon,
+		Username:        username,
+		Password:        &password,
+	}, StatusLoggedIn)
+}
+
+//LogoffUser logs username off, the response must report the user as not logged in. The server only accepts it from
+//the session the user logged on from
+func (c *Client) LogoffUser(ctx context.Context, username string) (Result, error) {
+	return c.call(ctx, userrequest.Struct{
+		UserRequestType: RequestLogoff,
+		Username:        username,
+	}, StatusNotLoggedIn)
+}
+
+//ChangePassword changes the password of username from password to newPassword, the response must report the
+//password as changed
+func (c *Client) ChangePassword(ctx context.Context, username, password, newPassword string) (Result, error) {
+	return c.call(ctx, userrequest.Struct{
+		UserRequestType: RequestChangePassword,
+		Username:        username,
+		Password:        &password,
+		NewPassword:     &newPassword,
+	}, StatusPasswordChanged)
+}
+
+//UserStatus asks whether username is logged in, the response must report the user as logged in or not logged in,
+//see Result.LoggedIn. The server only answers it on a session some user is logged on from
+func (c *Client) UserStatus(ctx context.Context, username string) (Result, error) {
+	return c.call(ctx, userrequest.Struct{
+		UserRequestType: RequestStatus,
+		Username:        username,
+	}, StatusLoggedIn, StatusNotLoggedIn)
+}
+
+//call sends r under a new UserRequestID and waits for its response or for ctx to be done
+func (c *Client) call(ctx context.Context, r userrequest.Struct, want ...enum.UserStatus) (Result, error) {
+	r.UserRequestID = c.NewUserRequestID()
+	ch := make(chan userresponse.Struct, 1)
+
+	c.mu.Lock()
+	c.waiting[r.UserRequestID] = ch
+	c.mu.Unlock()
+	defer func() {
+		c.mu.Lock()
+		delete(c.waiting, r.UserRequestID)
+		c.mu.Unlock()
+	}()
+
+	if err := c.SendMessage(userrequest.Unmarshal(r)); err != nil {
+		return Result{}, err
+	}
+
+	select {
+	case resp := <-ch:
+		res := result(resp)
+		for _, s := range want {
+			if res.Status == s {
+				return res, nil
+			}
+		}
+		return res, &StatusError{res}
+	case <-ctx.Done():
+		return Result{}, ctx.Err()
+	}
+}
+
+//OnUserResponse passes msg to the call waiting for its UserRequestID
+func (c *Client) OnUserResponse(msg userresponse.UserResponse) error {
+	s, err := userresponse.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	ch, ok := c.waiting[s.UserRequestID]
+	delete(c.waiting, s.UserRequestID)
+	c.mu.Unlock()
+
+	if !ok {
+		return &UnknownRequestError{s.UserRequestID}
+	}
+	ch <- s
+	return nil
+}
@@ -0,0 +1,163 @@
+package usermgmt
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/terracefi/fix44/userrequest"
+	"github.com/terracefi/fix44/userresponse"
+	"github.com/terracefi/quickfix"
+)
+
+//newClient returns a Client whose requests are answered by a Server that knows the user alice, password secret
+func newClient(t *testing.T) *Client {
+	s := NewServer(newStore(t))
+	c := New(quickfix.SessionID{})
+	c.SendMessage = func(msg quickfix.Messagable) error {
+		r, err := userrequest.Marshal(msg.(userrequest.UserRequest))
+		if err != nil {
+			return err
+		}
+		return c.OnUserResponse(userresponse.Unmarshal(s.HandleUserRequest(r, quickfix.SessionID{})))
+	}
+	return c
+}
+
+func TestClient(t *testing.T) {
+	tests := []struct {
+		name     string
+		before   []func(c *Client) (Result, error)
+		call     func(c *Client) (Result, error)
+		wantErr  error
+		status   string
+		loggedIn bool
+	}{
+		{
+			name:   "logon",
+			call:   func(c *Client) (Result, error) { return c.LogonUser(context.Background(), "alice", "secret") },
+			status: "logged in", loggedIn: true,
+		},
+		{
+			name:    "logon with another password",
+			call:    func(c *Client) (Result, error) { return c.LogonUser(context.Background(), "alice", "Secret") },
+			wantErr: &StatusError{},
+			status:  "password incorrect",
+		},
+		{
+			name:    "logon of an unknown user",
+			call:    func(c *Client) (Result, error) { return c.LogonUser(context.Background(), "bob", "secret") },
+			wantErr: &StatusError{},
+			status:  "user not recognised",
+		},
+		{
+			name: "logoff",
+			before: []func(c *Client) (Result, error){
+				func(c *Client) (Result, error) { return c.LogonUser(context.Background(), "alice", "secret") },
+			},
+			call:   func(c *Client) (Result, error) { return c.LogoffUser(context.Background(), "alice") },
+			status: "not logged in",
+		},
+		{
+			name:    "status without a user logged on",
+			call:    func(c *Client) (Result, error) { return c.UserStatus(context.Background(), "alice") },
+			wantErr: &StatusError{},
+			status:  "password incorrect",
+		},
+		{
+			name: "status logged in",
+			before: []func(c *Client) (Result, error){
+				func(c *Client) (Result, error) { return c.LogonUser(context.Background(), "alice", "secret") },
+			},
+			call:   func(c *Client) (Result, error) { return c.UserStatus(context.Background(), "alice") },
+			status: "logged in", loggedIn: true,
+		},
+		{
+			name:    "status of an unknown user",
+			call:    func(c *Client) (Result, error) { return c.UserStatus(context.Background(), "bob") },
+			wantErr: &StatusError{},
+			status:  "user not recognised",
+		},
+		{
+			name: "change password",
+			call: func(c *Client) (Result, error) {
+				return c.ChangePassword(context.Background(), "alice", "secret", "new")
+			},
+			status: "password changed",
+		},
+		{
+			name: "logon with the changed password",
+			before: []func(c *Client) (Result, error){
+				func(c *Client) (Result, error) {
+					return c.ChangePassword(context.Background(), "alice", "secret", "new")
+				},
+			},
+			call:   func(c *Client) (Result, error) { return c.LogonUser(context.Background(), "alice", "new") },
+			status: "logged in", loggedIn: true,
+		},
+		{
+			name: "change password without NewPassword",
+			call: func(c *Client) (Result, error) {
+				return c.ChangePassword(context.Background(), "alice", "secret", "")
+			},
+			wantErr: &StatusError{},
+			status:  "other",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newClient(t)
+			for i, call := range tt.before {
+				if _, err := call(c); err != nil {
+					t.Fatalf("call %v: %v", i, err)
+				}
+			}
+
+			res, err := tt.call(c)
+			if reflect.TypeOf(err) != reflect.TypeOf(tt.wantErr) {
+				t.Fatalf("error = %v, want %T", err, tt.wantErr)
+			}
+			if got := statusName(res.Status); got != tt.status {
+				t.Errorf("Status = %v, want %v", got, tt.status)
+			}
+			if res.LoggedIn() != tt.loggedIn {
+				t.Errorf("LoggedIn() = %v, want %v", res.LoggedIn(), tt.loggedIn)
+			}
+			if res.UserRequestID == "" {
+				t.Error("UserRequestID is empty")
+			}
+		})
+	}
+}
+
+func TestClientErrors(t *testing.T) {
+	errSend := errors.New("send failed")
+	tests := []struct {
+		name    string
+		send    func(msg quickfix.Messagable) error
+		wantErr error
+	}{
+		{"send error", func(quickfix.Messagable) error { return errSend }, errSend},
+		{"no response", func(quickfix.Messagable) error { return nil }, context.DeadlineExceeded},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := New(quickfix.SessionID{})
+			c.NewUserRequestID = func() string { return "U1" }
+			c.SendMessage = tt.send
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+			defer cancel()
+			if _, err := c.LogonUser(ctx, "alice", "secret"); err != tt.wantErr {
+				t.Fatalf("LogonUser() error = %v, want %v", err, tt.wantErr)
+			}
+
+			late := userresponse.Unmarshal(userresponse.Struct{UserRequestID: "U1", Username: "alice"})
+			if err := c.OnUserResponse(late); reflect.TypeOf(err) != reflect.TypeOf(&UnknownRequestError{}) {
+				t.Errorf("OnUserResponse() after the call = %v, want *UnknownRequestError", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+/*
+Package usermgmt logs users on and off, changes their passwords and queries their status with UserRequest and
+UserResponse messages.
+
+On the client side a Client sends a UserRequest for each call, LogonUser, LogoffUser, ChangePassword or UserStatus,
+and waits for the UserResponse with the same UserRequestID, which the application passes to OnUserResponse. The
+UserStatus and UserStatusText of the response are returned as a Result, and a UserStatus other than the one the
+call asks for, for example Password Incorrect for LogonUser, as a *StatusError:
+
+	c := usermgmt.New(sessionID)
+	d.OnUserResponse = func(msg userresponse.UserResponse, sessionID quickfix.SessionID) quickfix.MessageRejectError {
+		c.OnUserResponse(msg)
+		return nil
+	}
+
+	if _, err := c.LogonUser(ctx, "trader1", password); err != nil {
+		...
+	}
+
+On the server side a Handler answers each UserRequest, and Serve turns it into the OnUserRequest callback of a
+dispatch.Dispatcher. Server is a Handler that checks passwords against a CredentialStore, MemoryStore being one that
+keeps them in memory, and remembers which users are logged on. A logoff must come from the session the user logged
+on from or give the password, and a status request from a session some user is logged on from or give the
+password, so LogoffUser and UserStatus work from the session of a logged on user.
+
+Passwords must not reach logs: Redact masks them in a UserRequest, and the pretty package prints Password and
+NewPassword as fix44.Redacted, pretty.JSON included. fix44.MarshalMessageJSON keeps them, so its output must not be
+logged.
+*/
+package usermgmt
@@ -0,0 +1,44 @@
+package usermgmt
+
+import (
+	"fmt"
+)
+
+//StatusError is returned by the calls of a Client when the UserStatus of the response is not the one asked for
+type StatusError struct {
+	Result Result
+}
+
+func (e *StatusError) Error() string {
+	if e.Result.Text != "" {
+		return fmt.Sprintf("usermgmt: user %v: %v: %v", e.Result.Username, statusName(e.Result.Status), e.Result.Text)
+	}
+	return fmt.Sprintf("usermgmt: user %v: %v", e.Result.Username, statusName(e.Result.Status))
+}
+
+//UnknownRequestError is returned for a UserResponse whose UserRequestID is not awaited by the Client
+type UnknownRequestError struct {
+	UserRequestID string
+}
+
+func (e *UnknownRequestError) Error() string {
+	return fmt.Sprintf("usermgmt: unknown UserRequestID %v", e.UserRequestID)
+}
+
+//UnknownUserError is returned by a CredentialStore for a user it does not know
+type UnknownUserError struct {
+	Username string
+}
+
+func (e *UnknownUserError) Error() string {
+	return fmt.Sprintf("usermgmt: unknown user %v", e.Username)
+}
+
+//PasswordError is returned by a CredentialStore for a password that is not the password of the user
+type PasswordError struct {
+	Username string
+}
+
+func (e *PasswordError) Error() string {
+	return fmt.Sprintf("usermgmt: incorrect password for user %v", e.Username)
+}
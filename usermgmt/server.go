@@ -0,0 +1,254 @@
+package usermgmt
+
+import (
+	"errors"
+	"sync"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/terracefi/enum"
+	"github.com/terracefi/fix44/userrequest"
+	"github.com/terracefi/fix44/userresponse"
+	"github.com/terracefi/quickfix"
+)
+
+//CredentialStore keeps the passwords of the users a Server accepts
+type CredentialStore interface {
+	//Known returns true if the store has the user
+	Known(username string) bool
+	//Authenticate returns nil if password is the password of the user, an *UnknownUserError for a user the store
+	//does not have and a *PasswordError for another password
+	Authenticate(username, password string) error
+	//SetPassword sets the password of a user the store has
+	SetPassword(username, password string) error
+}
+
+//Handler answers UserRequests
+type Handler interface {
+	HandleUserRequest(r userrequest.Struct, sessionID quickfix.SessionID) userresponse.Struct
+}
+
+//Serve returns a callback for dispatch.Dispatcher.OnUserRequest that answers each UserRequest with the response of h,
+//sent on the session the request came from. A response that cannot be sent makes the session reply with a
+//BusinessMessageReject carrying BusinessRejectReason = ApplicationNotAvailable (4).
+func Serve(h Handler) userrequest.RouteOut {
+	return func(msg userrequest.UserRequest, sessionID quickfix.SessionID) quickfix.MessageRejectError {
+		r, err := userrequest.Marshal(msg)
+		if err != nil {
+			return err
+		}
+		resp := userresponse.Unmarshal(h.HandleUserRequest(r, sessionID))
+		if err := quickfix.SendToTarget(resp, sessionID); err != nil {
+			return quickfix.NewBusinessMessageRejectError("Application not available", 4, nil)
+		}
+		return nil
+	}
+}
+
+//Server is a Handler that checks passwords against a CredentialStore and keeps track of the users logged on. It is
+//safe for concurrent use.
+type Server struct {
+	Credentials CredentialStore
+
+	mu       sync.Mutex
+	loggedIn map[string]quickfix.SessionID
+}
+
+//NewServer returns a Server without users logged on that checks passwords against credentials
+func NewServer(credentials CredentialStore) *Server {
+	return &Server{
+		Credentials: credentials,
+		loggedIn:    make(map[string]quickfix.SessionID),
+	}
+}
+
+//LoggedIn returns true if username is logged on, and the session it logged on from
+func (s *Server) LoggedIn(username string) (quickfix.SessionID, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sessionID, ok := s.loggedIn[username]
+	return sessionID, ok
+}
+
+//HandleUserRequest answers r. A logon needs the password of the user and a password change both the password and a
+//NewPassword. A logoff needs the password or must come from the session the user logged on from. A status request
+//must come from the session of the user or from a session some user is logged on from, or give the password of the
+//user.
+func (s *Server) HandleUserRequest(r userrequest.Struct, sessionID quickfix.SessionID) userresponse.Struct {
+	resp := userresponse.Struct{
+		Username:      r.Username,
+		UserRequestID: r.UserRequestID,
+	}
+	respond := func(a answer) userresponse.Struct {
+		resp.UserStatus = &a.Status
+		if a.Text != "" {
+			text := a.Text
+			resp.UserStatusText = &text
+		}
+		return resp
+	}
+
+	switch r.UserRequestType {
+	case RequestLogon:
+		if err := s.authenticate(r.Username, r.Password); err != nil {
+			return respond(answerOf(err))
+		}
+		s.mu.Lock()
+		s.loggedIn[r.Username] = sessionID
+		s.mu.Unlock()
+		return respond(answer{Status: StatusLoggedIn})
+
+	case RequestLogoff:
+		if err := s.authorize(r, sessionID, false); err != nil {
+			return respond(answerOf(err))
+		}
+		s.mu.Lock()
+		delete(s.loggedIn, r.Username)
+		s.mu.Unlock()
+		return respond(answer{Status: StatusNotLoggedIn})
+
+	case RequestChangePassword:
+		if err := s.authenticate(r.Username, r.Password); err != nil {
+			return respond(answerOf(err))
+		}
+		if r.NewPassword == nil || *r.NewPassword == "" {
+			return respond(answer{StatusOther, "no NewPassword"})
+		}
+		if err := s.Credentials.SetPassword(r.Username, *r.NewPassword); err != nil {
+			return respond(answerOf(err))
+		}
+		return respond(answer{Status: StatusPasswordChanged})
+
+	case RequestStatus:
+		if err := s.authorize(r, sessionID, true); err != nil {
+			return respond(answerOf(err))
+		}
+		if !s.Credentials.Known(r.Username) {
+			return respond(answer{Status: StatusUserNotRecognised})
+		}
+		if _, ok := s.LoggedIn(r.Username); ok {
+			return respond(answer{Status: StatusLoggedIn})
+		}
+		return respond(answer{Status: StatusNotLoggedIn})
+	}
+	return respond(answer{StatusOther, "unsupported UserRequestType " + string(r.UserRequestType)})
+}
+
+//authorize returns nil if the user of r logged on from sessionID, or, with anySession, if any user did, and otherwise
+//checks the password of r
+func (s *Server) authorize(r userrequest.Struct, sessionID quickfix.SessionID, anySession bool) error {
+	s.mu.Lock()
+	from, authorized := s.loggedIn[r.Username]
+	authorized = authorized && from == sessionID
+	if anySession {
+		for _, from := range s.loggedIn {
+			authorized = authorized || from == sessionID
+		}
+	}
+	s.mu.Unlock()
+
+	if authorized {
+		return nil
+	}
+	return s.authenticate(r.Username, r.Password)
+}
+
+func (s *Server) authenticate(username string, password *string) error {
+	if password == nil {
+		if !s.Credentials.Known(username) {
+			return &UnknownUserError{username}
+		}
+		return &PasswordError{username}
+	}
+	return s.Credentials.Authenticate(username, *password)
+}
+
+//answer is the UserStatus and UserStatusText of a UserResponse
+type answer struct {
+	Status enum.UserStatus
+	Text   string
+}
+
+func answerOf(err error) answer {
+	var unknown *UnknownUserError
+	var password *PasswordError
+	switch {
+	case errors.As(err, &unknown):
+		return answer{Status: StatusUserNotRecognised}
+	case errors.As(err, &password):
+		return answer{Status: StatusPasswordIncorrect}
+	}
+	return answer{StatusOther, err.Error()}
+}
+
+//MemoryStore is a CredentialStore that keeps bcrypt hashes of the passwords in memory. It is safe for concurrent
+//use.
+type MemoryStore struct {
+	//Cost is the bcrypt cost of the passwords set from now on, bcrypt.DefaultCost if zero
+	Cost int
+
+	mu    sync.Mutex
+	users map[string][]byte
+}
+
+//NewMemoryStore returns a MemoryStore without users
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{users: make(map[string][]byte)}
+}
+
+//AddUser adds a user to the store, or sets its password if the store has it. bcrypt limits passwords to 72 bytes,
+//a longer one is refused.
+func (m *MemoryStore) AddUser(username, password string) error {
+	cost := m.Cost
+	if cost == 0 {
+		cost = bcrypt.DefaultCost
+	}
+	h, err := bcrypt.GenerateFromPassword([]byte(password), cost)
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.users[username] = h
+	return nil
+}
+
+//RemoveUser removes a user from the store
+func (m *MemoryStore) RemoveUser(username string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.users, username)
+}
+
+//Known returns true if the store has the user
+func (m *MemoryStore) Known(username string) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	_, ok := m.users[username]
+	return ok
+}
+
+//Authenticate checks the password of a user, see CredentialStore
+func (m *MemoryStore) Authenticate(username, password string) error {
+	m.mu.Lock()
+	h, ok := m.users[username]
+	m.mu.Unlock()
+
+	if !ok {
+		return &UnknownUserError{username}
+	}
+	if bcrypt.CompareHashAndPassword(h, []byte(password)) != nil {
+		return &PasswordError{username}
+	}
+	return nil
+}
+
+//SetPassword sets the password of a user the store has
+func (m *MemoryStore) SetPassword(username, password string) error {
+	if !m.Known(username) {
+		return &UnknownUserError{username}
+	}
+	return m.AddUser(username, password)
+}
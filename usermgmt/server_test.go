@@ -0,0 +1,204 @@
+package usermgmt
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/terracefi/fix44/internal/fixutil"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/terracefi/enum"
+	"github.com/terracefi/fix44"
+	"github.com/terracefi/fix44/userrequest"
+	"github.com/terracefi/quickfix"
+)
+
+//newStore returns a MemoryStore with the user alice, password secret, hashed at the lowest bcrypt cost to keep the
+//tests fast
+func newStore(t *testing.T) *MemoryStore {
+	m := NewMemoryStore()
+	m.Cost = bcrypt.MinCost
+	if err := m.AddUser("alice", "secret"); err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+func TestMemoryStore(t *testing.T) {
+	tests := []struct {
+		name     string
+		change   func(m *MemoryStore) error
+		wantErr  error
+		username string
+		password string
+		authErr  error
+		known    bool
+	}{
+		{"password", nil, nil, "alice", "secret", nil, true},
+		{"other password", nil, nil, "alice", "Secret", &PasswordError{}, true},
+		{"unknown user", nil, nil, "bob", "secret", &UnknownUserError{}, false},
+		{"password changed", func(m *MemoryStore) error { return m.SetPassword("alice", "new") }, nil,
+			"alice", "new", nil, true},
+		{"old password", func(m *MemoryStore) error { return m.SetPassword("alice", "new") }, nil,
+			"alice", "secret", &PasswordError{}, true},
+		{"password of an unknown user", func(m *MemoryStore) error { return m.SetPassword("bob", "new") },
+			&UnknownUserError{}, "bob", "new", &UnknownUserError{}, false},
+		{"user added again", func(m *MemoryStore) error { return m.AddUser("alice", "new") }, nil,
+			"alice", "new", nil, true},
+		{"password over 72 bytes", func(m *MemoryStore) error {
+			return m.SetPassword("alice", strings.Repeat("x", 73))
+		}, bcrypt.ErrPasswordTooLong, "alice", "secret", nil, true},
+		{"user removed", func(m *MemoryStore) error {
+			m.RemoveUser("alice")
+			return nil
+		}, nil, "alice", "secret", &UnknownUserError{}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newStore(t)
+			if tt.change != nil {
+				if err := tt.change(m); reflect.TypeOf(err) != reflect.TypeOf(tt.wantErr) {
+					t.Fatalf("error = %v, want %v", err, tt.wantErr)
+				}
+			}
+			if err := m.Authenticate(tt.username, tt.password); reflect.TypeOf(err) != reflect.TypeOf(tt.authErr) {
+				t.Errorf("Authenticate() = %v, want %T", err, tt.authErr)
+			}
+			if known := m.Known(tt.username); known != tt.known {
+				t.Errorf("Known() = %v, want %v", known, tt.known)
+			}
+		})
+	}
+}
+
+func request(id string, requestType enum.UserRequestType, username string, passwords ...string) userrequest.Struct {
+	r := userrequest.Struct{UserRequestID: id, UserRequestType: requestType, Username: username}
+	if len(passwords) > 0 {
+		r.Password = &passwords[0]
+	}
+	if len(passwords) > 1 {
+		r.NewPassword = &passwords[1]
+	}
+	return r
+}
+
+func TestServer(t *testing.T) {
+	logon := request("0", RequestLogon, "alice", "secret")
+	logonCarol := request("0", RequestLogon, "carol", "pass")
+	tests := []struct {
+		name     string
+		before   []userrequest.Struct
+		request  userrequest.Struct
+		other    bool
+		status   enum.UserStatus
+		text     bool
+		loggedIn bool
+	}{
+		{"logon", nil, request("1", RequestLogon, "alice", "secret"), false, StatusLoggedIn, false, true},
+		{"logon with another password", nil, request("1", RequestLogon, "alice", "Secret"), false,
+			StatusPasswordIncorrect, false, false},
+		{"logon without a password", nil, request("1", RequestLogon, "alice"), false, StatusPasswordIncorrect, false,
+			false},
+		{"logon of an unknown user", nil, request("1", RequestLogon, "bob", "secret"), false,
+			StatusUserNotRecognised, false, false},
+		{"logon of an unknown user without a password", nil, request("1", RequestLogon, "bob"), false,
+			StatusUserNotRecognised, false, false},
+		{"logoff", []userrequest.Struct{logon}, request("1", RequestLogoff, "alice"), false, StatusNotLoggedIn,
+			false, false},
+		{"logoff from another session", []userrequest.Struct{logon}, request("1", RequestLogoff, "alice"), true,
+			StatusPasswordIncorrect, false, true},
+		{"logoff from another session with the password", []userrequest.Struct{logon},
+			request("1", RequestLogoff, "alice", "secret"), true, StatusNotLoggedIn, false, false},
+		{"logoff from another session with another password", []userrequest.Struct{logon},
+			request("1", RequestLogoff, "alice", "Secret"), true, StatusPasswordIncorrect, false, true},
+		{"logoff of another user", []userrequest.Struct{logonCarol}, request("1", RequestLogoff, "alice"), false,
+			StatusPasswordIncorrect, false, false},
+		{"logoff of an unknown user", nil, request("1", RequestLogoff, "bob"), false, StatusUserNotRecognised, false,
+			false},
+		{"status logged in", []userrequest.Struct{logon}, request("1", RequestStatus, "alice"), false,
+			StatusLoggedIn, false, true},
+		{"status from another session", []userrequest.Struct{logon}, request("1", RequestStatus, "alice"), true,
+			StatusPasswordIncorrect, false, true},
+		{"status from the session of another user", []userrequest.Struct{logonCarol},
+			request("1", RequestStatus, "alice"), false, StatusNotLoggedIn, false, false},
+		{"status with the password", nil, request("1", RequestStatus, "alice", "secret"), false, StatusNotLoggedIn,
+			false, false},
+		{"status without a user logged on", nil, request("1", RequestStatus, "alice"), false,
+			StatusPasswordIncorrect, false, false},
+		{"status of an unknown user", []userrequest.Struct{logon}, request("1", RequestStatus, "bob"), false,
+			StatusUserNotRecognised, false, false},
+		{"change password", nil, request("1", RequestChangePassword, "alice", "secret", "new"), false,
+			StatusPasswordChanged, false, false},
+		{"logon with the changed password", []userrequest.Struct{
+			request("0", RequestChangePassword, "alice", "secret", "new")}, request("1", RequestLogon, "alice", "new"),
+			false, StatusLoggedIn, false, true},
+		{"change password with another password", nil,
+			request("1", RequestChangePassword, "alice", "Secret", "new"), false, StatusPasswordIncorrect, false,
+			false},
+		{"change password without NewPassword", nil, request("1", RequestChangePassword, "alice", "secret"), false,
+			StatusOther, true, false},
+		{"change password to over 72 bytes", nil,
+			request("1", RequestChangePassword, "alice", "secret", strings.Repeat("x", 73)), false, StatusOther, true,
+			false},
+		{"unsupported UserRequestType", nil, request("1", "9", "alice", "secret"), false, StatusOther, true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newStore(t)
+			if err := store.AddUser("carol", "pass"); err != nil {
+				t.Fatal(err)
+			}
+			s := NewServer(store)
+			sessionID := quickfix.SessionID{SenderCompID: "CLIENT"}
+			for _, r := range tt.before {
+				s.HandleUserRequest(r, sessionID)
+			}
+
+			from := sessionID
+			if tt.other {
+				from = quickfix.SessionID{SenderCompID: "OTHER"}
+			}
+			resp := s.HandleUserRequest(tt.request, from)
+			if resp.UserRequestID != "1" || resp.Username != tt.request.Username {
+				t.Errorf("UserRequestID, Username = %v, %v, want 1, %v", resp.UserRequestID, resp.Username,
+					tt.request.Username)
+			}
+			if resp.UserStatus == nil || *resp.UserStatus != tt.status {
+				t.Errorf("UserStatus = %v, want %v", fixutil.Deref(resp.UserStatus), tt.status)
+			}
+			if (resp.UserStatusText != nil) != tt.text {
+				t.Errorf("UserStatusText = %v, want text %v", resp.UserStatusText, tt.text)
+			}
+			got, ok := s.LoggedIn(tt.request.Username)
+			if ok != tt.loggedIn || (ok && got != sessionID) {
+				t.Errorf("LoggedIn() = %v, %v, want %v", got, ok, tt.loggedIn)
+			}
+		})
+	}
+}
+
+func TestRedact(t *testing.T) {
+	tests := []struct {
+		name                  string
+		r                     userrequest.Struct
+		password, newPassword *string
+	}{
+		{"no passwords", request("1", RequestStatus, "alice"), nil, nil},
+		{"password", request("1", RequestLogon, "alice", "secret"), fixutil.Ptr(fix44.Redacted), nil},
+		{"both", request("1", RequestChangePassword, "alice", "secret", "new"), fixutil.Ptr(fix44.Redacted),
+			fixutil.Ptr(fix44.Redacted)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Redact(tt.r)
+			if !reflect.DeepEqual(got.Password, tt.password) || !reflect.DeepEqual(got.NewPassword, tt.newPassword) {
+				t.Errorf("Redact() Password, NewPassword = %v, %v, want %v, %v", got.Password, got.NewPassword,
+					tt.password, tt.newPassword)
+			}
+			if tt.r.Password != nil && *tt.r.Password != "secret" {
+				t.Errorf("Redact() changed the Password of the request to %v", *tt.r.Password)
+			}
+		})
+	}
+}
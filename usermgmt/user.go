@@ -0,0 +1,87 @@
+package usermgmt
+
+import (
+	"github.com/terracefi/enum"
+	"github.com/terracefi/fix44"
+	"github.com/terracefi/fix44/internal/fixutil"
+	"github.com/terracefi/fix44/userrequest"
+	"github.com/terracefi/fix44/userresponse"
+)
+
+//UserRequestType values, FIX 4.4
+const (
+	RequestLogon          enum.UserRequestType = "1"
+	RequestLogoff         enum.UserRequestType = "2"
+	RequestChangePassword enum.UserRequestType = "3"
+	RequestStatus         enum.UserRequestType = "4"
+)
+
+//UserStatus values, FIX 4.4
+const (
+	StatusLoggedIn          enum.UserStatus = "1"
+	StatusNotLoggedIn       enum.UserStatus = "2"
+	StatusUserNotRecognised enum.UserStatus = "3"
+	StatusPasswordIncorrect enum.UserStatus = "4"
+	StatusPasswordChanged   enum.UserStatus = "5"
+	StatusOther             enum.UserStatus = "6"
+)
+
+func statusName(s enum.UserStatus) string {
+	switch s {
+	case StatusLoggedIn:
+		return "logged in"
+	case StatusNotLoggedIn:
+		return "not logged in"
+	case StatusUserNotRecognised:
+		return "user not recognised"
+	case StatusPasswordIncorrect:
+		return "password incorrect"
+	case StatusPasswordChanged:
+		return "password changed"
+	case StatusOther:
+		return "other"
+	case "":
+		return "no UserStatus"
+	}
+	return "UserStatus " + string(s)
+}
+
+//Result is the outcome of a UserRequest, as given by its UserResponse
+type Result struct {
+	Username      string
+	UserRequestID string
+	//Status is the UserStatus of the response, empty if it had none
+	Status enum.UserStatus
+	//Text is the UserStatusText of the response
+	Text string
+}
+
+//LoggedIn returns true if the response reports the user as logged in
+func (r Result) LoggedIn() bool {
+	return r.Status == StatusLoggedIn
+}
+
+func result(s userresponse.Struct) Result {
+	r := Result{
+		Username:      s.Username,
+		UserRequestID: s.UserRequestID,
+		Text:          fixutil.Deref(s.UserStatusText),
+	}
+	if s.UserStatus != nil {
+		r.Status = *s.UserStatus
+	}
+	return r
+}
+
+//Redact returns r with its Password and NewPassword replaced by fix44.Redacted, for logging
+func Redact(r userrequest.Struct) userrequest.Struct {
+	if r.Password != nil {
+		p := fix44.Redacted
+		r.Password = &p
+	}
+	if r.NewPassword != nil {
+		p := fix44.Redacted
+		r.NewPassword = &p
+	}
+	return r
+}
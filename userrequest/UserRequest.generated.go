@@ -57,6 +57,26 @@ func Route(router RouteOut) (string, string, quickfix.MessageRoute) {
 	return "FIX.4.4", "BE", r
 }
 
+//Validate checks m against the FIX 4.4 definition of UserRequest, see fix44.MessageDef.Validate
+func (m UserRequest) Validate() fix44.ValidationErrors {
+	return fix44.MessageDefs["BE"].Validate(&m.Body.FieldMap)
+}
+
+//MarshalJSON encodes the UserRequest as JSON with fields keyed by name, see fix44.MarshalMessageJSON
+func (m UserRequest) MarshalJSON() ([]byte, error) {
+	return fix44.MarshalMessageJSON(m.Message)
+}
+
+//UnmarshalJSON builds the UserRequest from JSON produced by MarshalJSON
+func (m *UserRequest) UnmarshalJSON(b []byte) error {
+	msg, err := fix44.UnmarshalMessageJSON(b, "BE")
+	if err != nil {
+		return err
+	}
+	*m = FromMessage(msg)
+	return nil
+}
+
 //SetRawDataLength sets RawDataLength, Tag 95
 func (m UserRequest) SetRawDataLength(v int) {
 	m.Set(field.NewRawDataLength(v))
@@ -165,6 +185,16 @@ func (m UserRequest) HasRawData() bool {
 	return m.Has(tag.RawData)
 }
 
+//SetRawDataBytes sets RawData, Tag 96, and RawDataLength, Tag 95, from v, which may hold any bytes including SOH
+func (m UserRequest) SetRawDataBytes(v []byte) {
+	fix44.SetRawData(m, tag.RawDataLength, tag.RawData, v)
+}
+
+//GetRawDataBytes gets RawData, Tag 96, checking its length against RawDataLength, Tag 95
+func (m UserRequest) GetRawDataBytes() ([]byte, quickfix.MessageRejectError) {
+	return fix44.GetRawData(m, tag.RawDataLength, tag.RawData)
+}
+
 //HasUsername returns true if Username is present, Tag 553
 func (m UserRequest) HasUsername() bool {
 	return m.Has(tag.Username)
@@ -189,3 +219,87 @@ func (m UserRequest) HasUserRequestType() bool {
 func (m UserRequest) HasNewPassword() bool {
 	return m.Has(tag.NewPassword)
 }
+
+//Struct is a plain Go representation of the UserRequest body, optional fields are nil when absent
+type Struct struct {
+	RawDataLength   *int
+	RawData         *string
+	Username        string
+	Password        *string
+	UserRequestID   string
+	UserRequestType enum.UserRequestType
+	NewPassword     *string
+}
+
+//Marshal copies the fields present in msg into a Struct
+func Marshal(msg UserRequest) (Struct, quickfix.MessageRejectError) {
+	var s Struct
+	if msg.HasRawDataLength() {
+		v, err := msg.GetRawDataLength()
+		if err != nil {
+			return s, err
+		}
+		s.RawDataLength = &v
+	}
+	if msg.HasRawData() {
+		v, err := msg.GetRawData()
+		if err != nil {
+			return s, err
+		}
+		s.RawData = &v
+	}
+	if msg.HasUsername() {
+		v, err := msg.GetUsername()
+		if err != nil {
+			return s, err
+		}
+		s.Username = v
+	}
+	if msg.HasPassword() {
+		v, err := msg.GetPassword()
+		if err != nil {
+			return s, err
+		}
+		s.Password = &v
+	}
+	if msg.HasUserRequestID() {
+		v, err := msg.GetUserRequestID()
+		if err != nil {
+			return s, err
+		}
+		s.UserRequestID = v
+	}
+	if msg.HasUserRequestType() {
+		v, err := msg.GetUserRequestType()
+		if err != nil {
+			return s, err
+		}
+		s.UserRequestType = v
+	}
+	if msg.HasNewPassword() {
+		v, err := msg.GetNewPassword()
+		if err != nil {
+			return s, err
+		}
+		s.NewPassword = &v
+	}
+	return s, nil
+}
+
+//Unmarshal builds a UserRequest from s
+func Unmarshal(s Struct) UserRequest {
+	m := New(field.NewUserRequestID(s.UserRequestID), field.NewUserRequestType(s.UserRequestType), field.NewUsername(s.Username))
+	if s.RawDataLength != nil {
+		m.SetRawDataLength(*s.RawDataLength)
+	}
+	if s.RawData != nil {
+		m.SetRawData(*s.RawData)
+	}
+	if s.Password != nil {
+		m.SetPassword(*s.Password)
+	}
+	if s.NewPassword != nil {
+		m.SetNewPassword(*s.NewPassword)
+	}
+	return m
+}
@@ -56,6 +56,26 @@ func Route(router RouteOut) (string, string, quickfix.MessageRoute) {
 	return "FIX.4.4", "BF", r
 }
 
+//Validate checks m against the FIX 4.4 definition of UserResponse, see fix44.MessageDef.Validate
+func (m UserResponse) Validate() fix44.ValidationErrors {
+	return fix44.MessageDefs["BF"].Validate(&m.Body.FieldMap)
+}
+
+//MarshalJSON encodes the UserResponse as JSON with fields keyed by name, see fix44.MarshalMessageJSON
+func (m UserResponse) MarshalJSON() ([]byte, error) {
+	return fix44.MarshalMessageJSON(m.Message)
+}
+
+//UnmarshalJSON builds the UserResponse from JSON produced by MarshalJSON
+func (m *UserResponse) UnmarshalJSON(b []byte) error {
+	msg, err := fix44.UnmarshalMessageJSON(b, "BF")
+	if err != nil {
+		return err
+	}
+	*m = FromMessage(msg)
+	return nil
+}
+
 //SetUsername sets Username, Tag 553
 func (m UserResponse) SetUsername(v string) {
 	m.Set(field.NewUsername(v))
@@ -131,3 +151,57 @@ func (m UserResponse) HasUserStatus() bool {
 func (m UserResponse) HasUserStatusText() bool {
 	return m.Has(tag.UserStatusText)
 }
+
+//Struct is a plain Go representation of the UserResponse body, optional fields are nil when absent
+type Struct struct {
+	Username       string
+	UserRequestID  string
+	UserStatus     *enum.UserStatus
+	UserStatusText *string
+}
+
+//Marshal copies the fields present in msg into a Struct
+func Marshal(msg UserResponse) (Struct, quickfix.MessageRejectError) {
+	var s Struct
+	if msg.HasUsername() {
+		v, err := msg.GetUsername()
+		if err != nil {
+			return s, err
+		}
+		s.Username = v
+	}
+	if msg.HasUserRequestID() {
+		v, err := msg.GetUserRequestID()
+		if err != nil {
+			return s, err
+		}
+		s.UserRequestID = v
+	}
+	if msg.HasUserStatus() {
+		v, err := msg.GetUserStatus()
+		if err != nil {
+			return s, err
+		}
+		s.UserStatus = &v
+	}
+	if msg.HasUserStatusText() {
+		v, err := msg.GetUserStatusText()
+		if err != nil {
+			return s, err
+		}
+		s.UserStatusText = &v
+	}
+	return s, nil
+}
+
+//Unmarshal builds a UserResponse from s
+func Unmarshal(s Struct) UserResponse {
+	m := New(field.NewUserRequestID(s.UserRequestID), field.NewUsername(s.Username))
+	if s.UserStatus != nil {
+		m.SetUserStatus(*s.UserStatus)
+	}
+	if s.UserStatusText != nil {
+		m.SetUserStatusText(*s.UserStatusText)
+	}
+	return m
+}
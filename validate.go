@@ -0,0 +1,199 @@
+package fix44
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terracefi/quickfix"
+	"github.com/terracefi/tag"
+)
+
+//ValidationErrors lists the problems found by Validate. Each one is a quickfix.MessageRejectError carrying the
+//reject reason and RefTagID, so it can be returned from FromApp or used to build a Reject or BusinessMessageReject.
+type ValidationErrors []quickfix.MessageRejectError
+
+func (e ValidationErrors) Error() string {
+	s := make([]string, len(e))
+	for i, err := range e {
+		if t := err.RefTagID(); t != nil {
+			s[i] = fmt.Sprintf("%v, tag %d", err, *t)
+		} else {
+			s[i] = err.Error()
+		}
+	}
+	return strings.Join(s, "; ")
+}
+
+//Validate checks body against the definition of the message type. It reports every missing required field, every
+//field that is not defined for the message type, every repeating group that cannot be read, every value of an
+//enumerated field that is not one of those listed by EnumValues and every missing conditionally required field. The
+//fields of a repeating group are only defined inside its elements, found at the top level of body they are reported as
+//not defined. The result is nil if the body is valid.
+func (d MessageDef) Validate(body *quickfix.FieldMap) ValidationErrors {
+	var errs ValidationErrors
+
+	errs = append(errs, validateFields(body, d.Fields)...)
+
+	defined := definedTags(d.Fields)
+	for _, r := range append(commonRules, conditionalRules[d.MsgType]...) {
+		if err := r.check(body, defined); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errs
+}
+
+//definedTags returns the tags of fields, without those of the fields of its repeating groups
+func definedTags(fields []FieldDef) map[quickfix.Tag]bool {
+	tags := make(map[quickfix.Tag]bool, len(fields))
+	for _, f := range fields {
+		tags[f.Tag] = true
+	}
+	return tags
+}
+
+//validateFields checks fm, the body or an element of a repeating group, against the fields defined for it
+func validateFields(fm *quickfix.FieldMap, fields []FieldDef) (errs ValidationErrors) {
+	defined := definedTags(fields)
+	for _, t := range fm.Tags() {
+		if !defined[t] {
+			errs = append(errs, quickfix.TagNotDefinedForThisMessageType(t))
+		}
+	}
+
+	for _, f := range fields {
+		if !fm.Has(f.Tag) {
+			if f.Required {
+				errs = append(errs, quickfix.RequiredTagMissing(f.Tag))
+			}
+			continue
+		}
+
+		if f.IsGroup() {
+			g := f.NewRepeatingGroup()
+			if err := fm.GetGroup(g); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			for i := 0; i < g.Len(); i++ {
+				errs = append(errs, validateFields(&g.Get(i).FieldMap, f.Fields)...)
+			}
+			continue
+		}
+
+		values, ok := enumValues[f.Tag]
+		if !ok {
+			continue
+		}
+		v, err := fm.GetString(f.Tag)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if !isEnumValue(values, v, multipleValueFields[f.Tag]) {
+			errs = append(errs, quickfix.ValueIsIncorrect(f.Tag))
+		}
+	}
+	return
+}
+
+//isEnumValue reports whether v is one of values, or with multiple set a space separated list of them
+func isEnumValue(values []EnumValue, v string, multiple bool) bool {
+	if !multiple {
+		return hasEnumValue(values, v)
+	}
+	vs := strings.Fields(v)
+	for _, v := range vs {
+		if !hasEnumValue(values, v) {
+			return false
+		}
+	}
+	return len(vs) > 0
+}
+
+func hasEnumValue(values []EnumValue, v string) bool {
+	for _, e := range values {
+		if e.Value == v {
+			return true
+		}
+	}
+	return false
+}
+
+//conditionalRule requires at least one of Required to be present when the field Tag has one of Values. A rule with no
+//Values applies whenever Tag is present, a rule with a zero Tag always applies.
+type conditionalRule struct {
+	Tag      quickfix.Tag
+	Values   []string
+	Required []quickfix.Tag
+}
+
+func (r conditionalRule) check(body *quickfix.FieldMap, defined map[quickfix.Tag]bool) quickfix.MessageRejectError {
+	if !r.applies(body) {
+		return nil
+	}
+
+	var isDefined bool
+	for _, t := range r.Required {
+		if body.Has(t) {
+			return nil
+		}
+		isDefined = isDefined || defined[t]
+	}
+	if !isDefined {
+		return nil
+	}
+
+	return quickfix.ConditionallyRequiredFieldMissing(r.Required[0])
+}
+
+func (r conditionalRule) applies(body *quickfix.FieldMap) bool {
+	if r.Tag == 0 {
+		return true
+	}
+	if !body.Has(r.Tag) {
+		return false
+	}
+	if len(r.Values) == 0 {
+		return true
+	}
+
+	v, err := body.GetString(r.Tag)
+	if err != nil {
+		return false
+	}
+	for _, value := range r.Values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	limitPriceRule       = conditionalRule{tag.OrdType, []string{"2", "4", "7", "8"}, []quickfix.Tag{tag.Price}}
+	stopPxRule           = conditionalRule{tag.OrdType, []string{"3", "4"}, []quickfix.Tag{tag.StopPx}}
+	previouslyQuotedRule = conditionalRule{tag.OrdType, []string{"D"}, []quickfix.Tag{tag.QuoteID}}
+	goodTillDateRule     = conditionalRule{tag.TimeInForce, []string{"6"}, []quickfix.Tag{tag.ExpireDate, tag.ExpireTime}}
+	orderQtyDataRule     = conditionalRule{0, nil, []quickfix.Tag{tag.OrderQty, tag.CashOrderQty, tag.OrderPercent}}
+	tradeLastQtyRule     = conditionalRule{tag.ExecType, []string{"F"}, []quickfix.Tag{tag.LastQty}}
+	tradeLastPxRule      = conditionalRule{tag.ExecType, []string{"F"}, []quickfix.Tag{tag.LastPx}}
+	mdUpdateTypeRule     = conditionalRule{tag.SubscriptionRequestType, []string{"1"}, []quickfix.Tag{tag.MDUpdateType}}
+	securityIDSourceRule = conditionalRule{tag.SecurityID, nil, []quickfix.Tag{tag.SecurityIDSource}}
+)
+
+//commonRules apply to every message type that defines the required fields
+var commonRules = []conditionalRule{securityIDSourceRule}
+
+//conditionalRules holds the FIX 4.4 conditionally required fields, by MsgType
+var conditionalRules = map[string][]conditionalRule{
+	"D":  {limitPriceRule, stopPxRule, previouslyQuotedRule, goodTillDateRule, orderQtyDataRule},
+	"G":  {limitPriceRule, stopPxRule, previouslyQuotedRule, goodTillDateRule, orderQtyDataRule},
+	"AB": {limitPriceRule, stopPxRule, goodTillDateRule, orderQtyDataRule},
+	"AC": {limitPriceRule, stopPxRule, goodTillDateRule, orderQtyDataRule},
+	"s":  {limitPriceRule, stopPxRule, goodTillDateRule},
+	"t":  {limitPriceRule, stopPxRule, goodTillDateRule},
+	"8":  {tradeLastQtyRule, tradeLastPxRule},
+	"V":  {mdUpdateTypeRule},
+}
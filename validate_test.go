@@ -0,0 +1,124 @@
+package fix44
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/terracefi/quickfix"
+	"github.com/terracefi/tag"
+)
+
+//rejection is the reject reason and RefTagID of a quickfix.MessageRejectError
+type rejection struct {
+	reason int
+	tag    quickfix.Tag
+}
+
+func rejections(errs ValidationErrors) []rejection {
+	var r []rejection
+	for _, err := range errs {
+		var t quickfix.Tag
+		if ref := err.RefTagID(); ref != nil {
+			t = *ref
+		}
+		r = append(r, rejection{err.RejectReason(), t})
+	}
+	return r
+}
+
+func newOrderBody() *quickfix.FieldMap {
+	body := new(quickfix.FieldMap)
+	body.SetString(tag.ClOrdID, "A")
+	body.SetString(tag.Side, "1")
+	body.SetField(tag.TransactTime, quickfix.FIXUTCTimestamp{Time: time.Unix(0, 0).UTC()})
+	body.SetString(tag.OrdType, "1")
+	body.SetString(tag.OrderQty, "100")
+	return body
+}
+
+func partyIDs(parties ...map[quickfix.Tag]string) *quickfix.RepeatingGroup {
+	g := FieldDef{Tag: tag.NoPartyIDs, Fields: noPartyIDsFields}.NewRepeatingGroup()
+	for _, p := range parties {
+		e := g.Add()
+		for _, t := range []quickfix.Tag{tag.PartyID, tag.PartyIDSource, tag.PartyRole} {
+			if v, ok := p[t]; ok {
+				e.SetString(t, v)
+			}
+		}
+	}
+	return g
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(body *quickfix.FieldMap)
+		want   []rejection
+	}{
+		{"valid", func(*quickfix.FieldMap) {}, nil},
+		{"missing required tag", func(body *quickfix.FieldMap) { body.Remove(tag.ClOrdID) },
+			[]rejection{{quickfix.RequiredTagMissing(tag.ClOrdID).RejectReason(), tag.ClOrdID}}},
+		{"undefined tag", func(body *quickfix.FieldMap) { body.SetString(tag.MDReqID, "M") },
+			[]rejection{{quickfix.TagNotDefinedForThisMessageType(tag.MDReqID).RejectReason(), tag.MDReqID}}},
+		{"wrong enum value", func(body *quickfix.FieldMap) { body.SetString(tag.Side, "Z") },
+			[]rejection{{quickfix.ValueIsIncorrect(tag.Side).RejectReason(), tag.Side}}},
+		{"multiple value field", func(body *quickfix.FieldMap) { body.SetString(tag.ExecInst, "1 G") }, nil},
+		{"wrong multiple value", func(body *quickfix.FieldMap) { body.SetString(tag.ExecInst, "1 ZZ") },
+			[]rejection{{quickfix.ValueIsIncorrect(tag.ExecInst).RejectReason(), tag.ExecInst}}},
+		{"enum without values", func(body *quickfix.FieldMap) { body.SetString(tag.ExDestination, "XLON") },
+			nil},
+		{"conditionally required tag", func(body *quickfix.FieldMap) { body.SetString(tag.OrdType, "2") },
+			[]rejection{{quickfix.ConditionallyRequiredFieldMissing(tag.Price).RejectReason(), tag.Price}}},
+		{"repeating group", func(body *quickfix.FieldMap) {
+			body.SetGroup(partyIDs(map[quickfix.Tag]string{tag.PartyID: "BRKR", tag.PartyRole: "1"}))
+		}, nil},
+		{"group tag at the top level", func(body *quickfix.FieldMap) { body.SetString(tag.PartyID, "BRKR") },
+			[]rejection{{quickfix.TagNotDefinedForThisMessageType(tag.PartyID).RejectReason(), tag.PartyID}}},
+		{"group element without its first field", func(body *quickfix.FieldMap) {
+			body.SetGroup(partyIDs(map[quickfix.Tag]string{tag.PartyID: "BRKR"},
+				map[quickfix.Tag]string{tag.PartyRole: "1"}))
+		}, []rejection{{15, tag.NoPartyIDs}}},
+		{"group wrong enum value", func(body *quickfix.FieldMap) {
+			body.SetGroup(partyIDs(map[quickfix.Tag]string{tag.PartyID: "BRKR", tag.PartyRole: "999"}))
+		}, []rejection{{quickfix.ValueIsIncorrect(tag.PartyRole).RejectReason(), tag.PartyRole}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body := newOrderBody()
+			tt.modify(body)
+			errs := MessageDefs["D"].Validate(body)
+			if got := rejections(errs); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", errs, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnumNames(t *testing.T) {
+	tests := []struct {
+		tag   quickfix.Tag
+		value string
+		name  string
+		ok    bool
+	}{
+		{tag.Side, "1", "BUY", true},
+		{tag.MsgType, "D", "ORDER_SINGLE", true},
+		{tag.PartyRole, "1", "EXECUTING_FIRM", true},
+		{tag.Side, "Z", "", false},
+		{tag.ClOrdID, "A", "", false},
+		{tag.ExDestination, "XLON", "", false},
+	}
+	for _, tt := range tests {
+		name, ok := EnumName(tt.tag, tt.value)
+		if name != tt.name || ok != tt.ok {
+			t.Errorf("EnumName(%v, %q) = %q, %v, want %q, %v", tt.tag, tt.value, name, ok, tt.name, tt.ok)
+		}
+		if !tt.ok {
+			continue
+		}
+		if v, ok := EnumValueOf(tt.tag, tt.name); !ok || v != tt.value {
+			t.Errorf("EnumValueOf(%v, %q) = %q, %v, want %q", tt.tag, tt.name, v, ok, tt.value)
+		}
+	}
+}